@@ -20,15 +20,26 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 )
 
+// APIDiscoveryServiceIface is the interface implemented by APIDiscoveryService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type APIDiscoveryServiceIface interface {
+	NewListApisParams() *ListApisParams
+	ListApis(p *ListApisParams) (*ListApisResponse, error)
+	ListApisWithContext(ctx context.Context, p *ListApisParams) (*ListApisResponse, error)
+}
+
 type ListApisParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListApisParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListApisParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -39,6 +50,47 @@ func (p *ListApisParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListApisParams) Validate() error {
+	v := newParamValidator("listApis", p.p)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListApisParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListApisParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listApis", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listApis: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListApisParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -46,6 +98,15 @@ func (p *ListApisParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListApisParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListApisParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListApisParams instance,
 // as then you are sure you have configured all required params
 func (s *APIDiscoveryService) NewListApisParams() *ListApisParams {
@@ -56,7 +117,16 @@ func (s *APIDiscoveryService) NewListApisParams() *ListApisParams {
 
 // lists all available apis on the server, provided by the Api Discovery plugin
 func (s *APIDiscoveryService) ListApis(p *ListApisParams) (*ListApisResponse, error) {
-	resp, err := s.cs.newRequest("listApis", p.toURLValues())
+	return s.ListApisWithContext(context.Background(), p)
+}
+
+// ListApisWithContext is the same as ListApis, but uses ctx to cancel the request and any async job polling
+func (s *APIDiscoveryService) ListApisWithContext(ctx context.Context, p *ListApisParams) (*ListApisResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listApis", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
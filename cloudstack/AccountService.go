@@ -20,18 +20,86 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// AccountServiceIface is the interface implemented by AccountService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type AccountServiceIface interface {
+	NewAddAccountToProjectParams(projectid string) *AddAccountToProjectParams
+	AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error)
+	AddAccountToProjectWithContext(ctx context.Context, p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error)
+	AddAccountToProjectAsync(p *AddAccountToProjectParams) (*Job, error)
+	AddAccountToProjectAsyncWithContext(ctx context.Context, p *AddAccountToProjectParams) (*Job, error)
+	NewCreateAccountParams(email string, firstname string, lastname string, password string, username string) *CreateAccountParams
+	CreateAccount(p *CreateAccountParams) (*CreateAccountResponse, error)
+	CreateAccountWithContext(ctx context.Context, p *CreateAccountParams) (*CreateAccountResponse, error)
+	NewDeleteAccountParams(id string) *DeleteAccountParams
+	DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error)
+	DeleteAccountWithContext(ctx context.Context, p *DeleteAccountParams) (*DeleteAccountResponse, error)
+	DeleteAccountAsync(p *DeleteAccountParams) (*Job, error)
+	DeleteAccountAsyncWithContext(ctx context.Context, p *DeleteAccountParams) (*Job, error)
+	NewDeleteAccountFromProjectParams(account string, projectid string) *DeleteAccountFromProjectParams
+	DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error)
+	DeleteAccountFromProjectWithContext(ctx context.Context, p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error)
+	DeleteAccountFromProjectAsync(p *DeleteAccountFromProjectParams) (*Job, error)
+	DeleteAccountFromProjectAsyncWithContext(ctx context.Context, p *DeleteAccountFromProjectParams) (*Job, error)
+	NewDisableAccountParams(lock bool) *DisableAccountParams
+	DisableAccount(p *DisableAccountParams) (*DisableAccountResponse, error)
+	DisableAccountWithContext(ctx context.Context, p *DisableAccountParams) (*DisableAccountResponse, error)
+	DisableAccountAsync(p *DisableAccountParams) (*Job, error)
+	DisableAccountAsyncWithContext(ctx context.Context, p *DisableAccountParams) (*Job, error)
+	NewEnableAccountParams() *EnableAccountParams
+	EnableAccount(p *EnableAccountParams) (*EnableAccountResponse, error)
+	EnableAccountWithContext(ctx context.Context, p *EnableAccountParams) (*EnableAccountResponse, error)
+	NewGetSolidFireAccountIdParams(accountid string, storageid string) *GetSolidFireAccountIdParams
+	GetSolidFireAccountId(p *GetSolidFireAccountIdParams) (*GetSolidFireAccountIdResponse, error)
+	GetSolidFireAccountIdWithContext(ctx context.Context, p *GetSolidFireAccountIdParams) (*GetSolidFireAccountIdResponse, error)
+	NewListAccountsParams() *ListAccountsParams
+	GetAccountID(name string, opts ...OptionFunc) (string, int, error)
+	GetAccountIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetAccountByName(name string, opts ...OptionFunc) (*Account, int, error)
+	GetAccountByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Account, int, error)
+	GetAccountByID(id string, opts ...OptionFunc) (*Account, int, error)
+	GetAccountByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Account, int, error)
+	ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error)
+	ListAccountsWithContext(ctx context.Context, p *ListAccountsParams) (*ListAccountsResponse, error)
+	NewListAccountsPager(p *ListAccountsParams) *ListAccountsPager
+	ListAccountsAll(p *ListAccountsParams) (*ListAccountsResponse, error)
+	ListAccountsAllWithContext(ctx context.Context, p *ListAccountsParams) (*ListAccountsResponse, error)
+	NewListProjectAccountsParams(projectid string) *ListProjectAccountsParams
+	GetProjectAccountID(keyword string, projectid string, opts ...OptionFunc) (string, int, error)
+	GetProjectAccountIDWithContext(ctx context.Context, keyword string, projectid string, opts ...OptionFunc) (string, int, error)
+	ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error)
+	ListProjectAccountsWithContext(ctx context.Context, p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error)
+	NewListProjectAccountsPager(p *ListProjectAccountsParams) *ListProjectAccountsPager
+	ListProjectAccountsAll(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error)
+	ListProjectAccountsAllWithContext(ctx context.Context, p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error)
+	NewLockAccountParams(account string, domainid string) *LockAccountParams
+	LockAccount(p *LockAccountParams) (*LockAccountResponse, error)
+	LockAccountWithContext(ctx context.Context, p *LockAccountParams) (*LockAccountResponse, error)
+	NewMarkDefaultZoneForAccountParams(account string, domainid string, zoneid string) *MarkDefaultZoneForAccountParams
+	MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error)
+	MarkDefaultZoneForAccountWithContext(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error)
+	MarkDefaultZoneForAccountAsync(p *MarkDefaultZoneForAccountParams) (*Job, error)
+	MarkDefaultZoneForAccountAsyncWithContext(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*Job, error)
+	NewUpdateAccountParams() *UpdateAccountParams
+	UpdateAccount(p *UpdateAccountParams) (*UpdateAccountResponse, error)
+	UpdateAccountWithContext(ctx context.Context, p *UpdateAccountParams) (*UpdateAccountResponse, error)
+}
+
 type AddAccountToProjectParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddAccountToProjectParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddAccountToProjectParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -54,6 +122,51 @@ func (p *AddAccountToProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddAccountToProjectParams) Validate() error {
+	v := newParamValidator("addAccountToProject", p.p)
+	v.required("projectid")
+	v.ids("projectid", "projectroleid")
+	v.maxLength("account", 255)
+	v.maxLength("email", 255)
+	v.maxLength("roletype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddAccountToProjectParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddAccountToProjectParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "email", "projectid", "projectroleid", "roletype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addAccountToProject", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addAccountToProject: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddAccountToProjectParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -61,6 +174,15 @@ func (p *AddAccountToProjectParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *AddAccountToProjectParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *AddAccountToProjectParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *AddAccountToProjectParams) SetEmail(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -68,6 +190,15 @@ func (p *AddAccountToProjectParams) SetEmail(v string) {
 	p.p["email"] = v
 }
 
+func (p *AddAccountToProjectParams) ResetEmail() {
+	delete(p.p, "email")
+}
+
+func (p *AddAccountToProjectParams) GetEmail() (string, bool) {
+	value, ok := p.p["email"].(string)
+	return value, ok
+}
+
 func (p *AddAccountToProjectParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -75,6 +206,15 @@ func (p *AddAccountToProjectParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *AddAccountToProjectParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *AddAccountToProjectParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *AddAccountToProjectParams) SetProjectroleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -82,6 +222,15 @@ func (p *AddAccountToProjectParams) SetProjectroleid(v string) {
 	p.p["projectroleid"] = v
 }
 
+func (p *AddAccountToProjectParams) ResetProjectroleid() {
+	delete(p.p, "projectroleid")
+}
+
+func (p *AddAccountToProjectParams) GetProjectroleid() (string, bool) {
+	value, ok := p.p["projectroleid"].(string)
+	return value, ok
+}
+
 func (p *AddAccountToProjectParams) SetRoletype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -89,6 +238,15 @@ func (p *AddAccountToProjectParams) SetRoletype(v string) {
 	p.p["roletype"] = v
 }
 
+func (p *AddAccountToProjectParams) ResetRoletype() {
+	delete(p.p, "roletype")
+}
+
+func (p *AddAccountToProjectParams) GetRoletype() (string, bool) {
+	value, ok := p.p["roletype"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddAccountToProjectParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewAddAccountToProjectParams(projectid string) *AddAccountToProjectParams {
@@ -100,7 +258,16 @@ func (s *AccountService) NewAddAccountToProjectParams(projectid string) *AddAcco
 
 // Adds account to a project
 func (s *AccountService) AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
-	resp, err := s.cs.newRequest("addAccountToProject", p.toURLValues())
+	return s.AddAccountToProjectWithContext(context.Background(), p)
+}
+
+// AddAccountToProjectWithContext is the same as AddAccountToProject, but uses ctx to cancel the request and any async job polling
+func (s *AccountService) AddAccountToProjectWithContext(ctx context.Context, p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addAccountToProject", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +279,7 @@ func (s *AccountService) AddAccountToProject(p *AddAccountToProjectParams) (*Add
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -128,6 +295,31 @@ func (s *AccountService) AddAccountToProject(p *AddAccountToProjectParams) (*Add
 	return &r, nil
 }
 
+// AddAccountToProjectAsync starts the async job for AddAccountToProject and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddAccountToProjectResponse using Job.Result
+func (s *AccountService) AddAccountToProjectAsync(p *AddAccountToProjectParams) (*Job, error) {
+	return s.AddAccountToProjectAsyncWithContext(context.Background(), p)
+}
+
+// AddAccountToProjectAsyncWithContext is the same as AddAccountToProjectAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AccountService) AddAccountToProjectAsyncWithContext(ctx context.Context, p *AddAccountToProjectParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addAccountToProject", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddAccountToProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type AddAccountToProjectResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -139,7 +331,8 @@ type CreateAccountParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateAccountParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -194,6 +387,66 @@ func (p *CreateAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateAccountParams) Validate() error {
+	v := newParamValidator("createAccount", p.p)
+	v.required("email", "firstname", "lastname", "password", "username")
+	v.ids("domainid", "roleid")
+	v.maxLength("account", 255)
+	v.maxLength("accountid", 255)
+	v.maxLength("email", 255)
+	v.maxLength("firstname", 255)
+	v.maxLength("lastname", 255)
+	v.maxLength("networkdomain", 255)
+	v.maxLength("password", 255)
+	v.maxLength("timezone", 255)
+	v.maxLength("userid", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateAccountParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateAccountParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "accountid", "domainid", "email", "firstname", "lastname", "networkdomain", "password", "roleid", "timezone", "userid", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "accountdetails":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "accounttype":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createAccount", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createAccount: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -201,6 +454,15 @@ func (p *CreateAccountParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateAccountParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateAccountParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateAccountParams) SetAccountdetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -208,6 +470,15 @@ func (p *CreateAccountParams) SetAccountdetails(v map[string]string) {
 	p.p["accountdetails"] = v
 }
 
+func (p *CreateAccountParams) ResetAccountdetails() {
+	delete(p.p, "accountdetails")
+}
+
+func (p *CreateAccountParams) GetAccountdetails() (map[string]string, bool) {
+	value, ok := p.p["accountdetails"].(map[string]string)
+	return value, ok
+}
+
 func (p *CreateAccountParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -215,6 +486,15 @@ func (p *CreateAccountParams) SetAccountid(v string) {
 	p.p["accountid"] = v
 }
 
+func (p *CreateAccountParams) ResetAccountid() {
+	delete(p.p, "accountid")
+}
+
+func (p *CreateAccountParams) GetAccountid() (string, bool) {
+	value, ok := p.p["accountid"].(string)
+	return value, ok
+}
+
 func (p *CreateAccountParams) SetAccounttype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -222,6 +502,15 @@ func (p *CreateAccountParams) SetAccounttype(v int) {
 	p.p["accounttype"] = v
 }
 
+func (p *CreateAccountParams) ResetAccounttype() {
+	delete(p.p, "accounttype")
+}
+
+func (p *CreateAccountParams) GetAccounttype() (int, bool) {
+	value, ok := p.p["accounttype"].(int)
+	return value, ok
+}
+
 func (p *CreateAccountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -229,6 +518,15 @@ func (p *CreateAccountParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateAccountParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateAccountParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateAccountParams) SetEmail(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -236,6 +534,15 @@ func (p *CreateAccountParams) SetEmail(v string) {
 	p.p["email"] = v
 }
 
+func (p *CreateAccountParams) ResetEmail() {
+	delete(p.p, "email")
+}
+
+func (p *CreateAccountParams) GetEmail() (string, bool) {
+	value, ok := p.p["email"].(string)
+	return value, ok
+}
+
 func (p *CreateAccountParams) SetFirstname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -243,6 +550,15 @@ func (p *CreateAccountParams) SetFirstname(v string) {
 	p.p["firstname"] = v
 }
 
+func (p *CreateAccountParams) ResetFirstname() {
+	delete(p.p, "firstname")
+}
+
+func (p *CreateAccountParams) GetFirstname() (string, bool) {
+	value, ok := p.p["firstname"].(string)
+	return value, ok
+}
+
 func (p *CreateAccountParams) SetLastname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -250,6 +566,15 @@ func (p *CreateAccountParams) SetLastname(v string) {
 	p.p["lastname"] = v
 }
 
+func (p *CreateAccountParams) ResetLastname() {
+	delete(p.p, "lastname")
+}
+
+func (p *CreateAccountParams) GetLastname() (string, bool) {
+	value, ok := p.p["lastname"].(string)
+	return value, ok
+}
+
 func (p *CreateAccountParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -257,6 +582,15 @@ func (p *CreateAccountParams) SetNetworkdomain(v string) {
 	p.p["networkdomain"] = v
 }
 
+func (p *CreateAccountParams) ResetNetworkdomain() {
+	delete(p.p, "networkdomain")
+}
+
+func (p *CreateAccountParams) GetNetworkdomain() (string, bool) {
+	value, ok := p.p["networkdomain"].(string)
+	return value, ok
+}
+
 func (p *CreateAccountParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -264,6 +598,15 @@ func (p *CreateAccountParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *CreateAccountParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *CreateAccountParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *CreateAccountParams) SetRoleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -271,6 +614,15 @@ func (p *CreateAccountParams) SetRoleid(v string) {
 	p.p["roleid"] = v
 }
 
+func (p *CreateAccountParams) ResetRoleid() {
+	delete(p.p, "roleid")
+}
+
+func (p *CreateAccountParams) GetRoleid() (string, bool) {
+	value, ok := p.p["roleid"].(string)
+	return value, ok
+}
+
 func (p *CreateAccountParams) SetTimezone(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -278,6 +630,15 @@ func (p *CreateAccountParams) SetTimezone(v string) {
 	p.p["timezone"] = v
 }
 
+func (p *CreateAccountParams) ResetTimezone() {
+	delete(p.p, "timezone")
+}
+
+func (p *CreateAccountParams) GetTimezone() (string, bool) {
+	value, ok := p.p["timezone"].(string)
+	return value, ok
+}
+
 func (p *CreateAccountParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -285,6 +646,15 @@ func (p *CreateAccountParams) SetUserid(v string) {
 	p.p["userid"] = v
 }
 
+func (p *CreateAccountParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *CreateAccountParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
 func (p *CreateAccountParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -292,6 +662,15 @@ func (p *CreateAccountParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *CreateAccountParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *CreateAccountParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewCreateAccountParams(email string, firstname string, lastname string, password string, username string) *CreateAccountParams {
@@ -307,7 +686,16 @@ func (s *AccountService) NewCreateAccountParams(email string, firstname string,
 
 // Creates an account
 func (s *AccountService) CreateAccount(p *CreateAccountParams) (*CreateAccountResponse, error) {
-	resp, err := s.cs.newRequest("createAccount", p.toURLValues())
+	return s.CreateAccountWithContext(context.Background(), p)
+}
+
+// CreateAccountWithContext is the same as CreateAccount, but uses ctx to cancel the request and any async job polling
+func (s *AccountService) CreateAccountWithContext(ctx context.Context, p *CreateAccountParams) (*CreateAccountResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createAccount", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -414,7 +802,8 @@ type DeleteAccountParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteAccountParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -425,6 +814,48 @@ func (p *DeleteAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteAccountParams) Validate() error {
+	v := newParamValidator("deleteAccount", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteAccountParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteAccountParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteAccount", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteAccount: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteAccountParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -432,6 +863,15 @@ func (p *DeleteAccountParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteAccountParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteAccountParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewDeleteAccountParams(id string) *DeleteAccountParams {
@@ -443,7 +883,16 @@ func (s *AccountService) NewDeleteAccountParams(id string) *DeleteAccountParams
 
 // Deletes a account, and all users associated with this account
 func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error) {
-	resp, err := s.cs.newRequest("deleteAccount", p.toURLValues())
+	return s.DeleteAccountWithContext(context.Background(), p)
+}
+
+// DeleteAccountWithContext is the same as DeleteAccount, but uses ctx to cancel the request and any async job polling
+func (s *AccountService) DeleteAccountWithContext(ctx context.Context, p *DeleteAccountParams) (*DeleteAccountResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAccount", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -455,7 +904,7 @@ func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -471,6 +920,31 @@ func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountRe
 	return &r, nil
 }
 
+// DeleteAccountAsync starts the async job for DeleteAccount and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteAccountResponse using Job.Result
+func (s *AccountService) DeleteAccountAsync(p *DeleteAccountParams) (*Job, error) {
+	return s.DeleteAccountAsyncWithContext(context.Background(), p)
+}
+
+// DeleteAccountAsyncWithContext is the same as DeleteAccountAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AccountService) DeleteAccountAsyncWithContext(ctx context.Context, p *DeleteAccountParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAccount", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteAccountResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteAccountResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -482,7 +956,8 @@ type DeleteAccountFromProjectParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteAccountFromProjectParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteAccountFromProjectParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -496,6 +971,49 @@ func (p *DeleteAccountFromProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteAccountFromProjectParams) Validate() error {
+	v := newParamValidator("deleteAccountFromProject", p.p)
+	v.required("account", "projectid")
+	v.ids("projectid")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteAccountFromProjectParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteAccountFromProjectParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteAccountFromProject", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteAccountFromProject: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteAccountFromProjectParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -503,6 +1021,15 @@ func (p *DeleteAccountFromProjectParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *DeleteAccountFromProjectParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *DeleteAccountFromProjectParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *DeleteAccountFromProjectParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -510,6 +1037,15 @@ func (p *DeleteAccountFromProjectParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *DeleteAccountFromProjectParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *DeleteAccountFromProjectParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteAccountFromProjectParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewDeleteAccountFromProjectParams(account string, projectid string) *DeleteAccountFromProjectParams {
@@ -522,7 +1058,16 @@ func (s *AccountService) NewDeleteAccountFromProjectParams(account string, proje
 
 // Deletes account from the project
 func (s *AccountService) DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
-	resp, err := s.cs.newRequest("deleteAccountFromProject", p.toURLValues())
+	return s.DeleteAccountFromProjectWithContext(context.Background(), p)
+}
+
+// DeleteAccountFromProjectWithContext is the same as DeleteAccountFromProject, but uses ctx to cancel the request and any async job polling
+func (s *AccountService) DeleteAccountFromProjectWithContext(ctx context.Context, p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAccountFromProject", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -534,7 +1079,7 @@ func (s *AccountService) DeleteAccountFromProject(p *DeleteAccountFromProjectPar
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -550,6 +1095,31 @@ func (s *AccountService) DeleteAccountFromProject(p *DeleteAccountFromProjectPar
 	return &r, nil
 }
 
+// DeleteAccountFromProjectAsync starts the async job for DeleteAccountFromProject and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteAccountFromProjectResponse using Job.Result
+func (s *AccountService) DeleteAccountFromProjectAsync(p *DeleteAccountFromProjectParams) (*Job, error) {
+	return s.DeleteAccountFromProjectAsyncWithContext(context.Background(), p)
+}
+
+// DeleteAccountFromProjectAsyncWithContext is the same as DeleteAccountFromProjectAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AccountService) DeleteAccountFromProjectAsyncWithContext(ctx context.Context, p *DeleteAccountFromProjectParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAccountFromProject", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteAccountFromProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteAccountFromProjectResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -561,7 +1131,8 @@ type DisableAccountParams struct {
 	p map[string]interface{}
 }
 
-func (p *DisableAccountParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DisableAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -582,6 +1153,53 @@ func (p *DisableAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DisableAccountParams) Validate() error {
+	v := newParamValidator("disableAccount", p.p)
+	v.required("lock")
+	v.ids("domainid", "id")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DisableAccountParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DisableAccountParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "lock":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for disableAccount", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of disableAccount: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DisableAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -589,6 +1207,15 @@ func (p *DisableAccountParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *DisableAccountParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *DisableAccountParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *DisableAccountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -596,6 +1223,15 @@ func (p *DisableAccountParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *DisableAccountParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *DisableAccountParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *DisableAccountParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -603,6 +1239,15 @@ func (p *DisableAccountParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DisableAccountParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DisableAccountParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *DisableAccountParams) SetLock(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -610,6 +1255,15 @@ func (p *DisableAccountParams) SetLock(v bool) {
 	p.p["lock"] = v
 }
 
+func (p *DisableAccountParams) ResetLock() {
+	delete(p.p, "lock")
+}
+
+func (p *DisableAccountParams) GetLock() (bool, bool) {
+	value, ok := p.p["lock"].(bool)
+	return value, ok
+}
+
 // You should always use this function to get a new DisableAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewDisableAccountParams(lock bool) *DisableAccountParams {
@@ -621,7 +1275,16 @@ func (s *AccountService) NewDisableAccountParams(lock bool) *DisableAccountParam
 
 // Disables an account
 func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccountResponse, error) {
-	resp, err := s.cs.newRequest("disableAccount", p.toURLValues())
+	return s.DisableAccountWithContext(context.Background(), p)
+}
+
+// DisableAccountWithContext is the same as DisableAccount, but uses ctx to cancel the request and any async job polling
+func (s *AccountService) DisableAccountWithContext(ctx context.Context, p *DisableAccountParams) (*DisableAccountResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableAccount", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -633,7 +1296,7 @@ func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccoun
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -654,6 +1317,40 @@ func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccoun
 	return &r, nil
 }
 
+// DisableAccountAsync starts the async job for DisableAccount and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DisableAccountResponse using Job.Result
+func (s *AccountService) DisableAccountAsync(p *DisableAccountParams) (*Job, error) {
+	return s.DisableAccountAsyncWithContext(context.Background(), p)
+}
+
+// DisableAccountAsyncWithContext is the same as DisableAccountAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AccountService) DisableAccountAsyncWithContext(ctx context.Context, p *DisableAccountParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableAccount", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableAccountResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type DisableAccountResponse struct {
 	Accountdetails            map[string]string            `json:"accountdetails"`
 	Accounttype               int                          `json:"accounttype"`
@@ -744,7 +1441,8 @@ type EnableAccountParams struct {
 	p map[string]interface{}
 }
 
-func (p *EnableAccountParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *EnableAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -761,6 +1459,48 @@ func (p *EnableAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *EnableAccountParams) Validate() error {
+	v := newParamValidator("enableAccount", p.p)
+	v.ids("domainid", "id")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *EnableAccountParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *EnableAccountParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for enableAccount", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of enableAccount: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *EnableAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -768,6 +1508,15 @@ func (p *EnableAccountParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *EnableAccountParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *EnableAccountParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *EnableAccountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -775,6 +1524,15 @@ func (p *EnableAccountParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *EnableAccountParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *EnableAccountParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *EnableAccountParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -782,6 +1540,15 @@ func (p *EnableAccountParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *EnableAccountParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *EnableAccountParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new EnableAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewEnableAccountParams() *EnableAccountParams {
@@ -792,7 +1559,16 @@ func (s *AccountService) NewEnableAccountParams() *EnableAccountParams {
 
 // Enables an account
 func (s *AccountService) EnableAccount(p *EnableAccountParams) (*EnableAccountResponse, error) {
-	resp, err := s.cs.newRequest("enableAccount", p.toURLValues())
+	return s.EnableAccountWithContext(context.Background(), p)
+}
+
+// EnableAccountWithContext is the same as EnableAccount, but uses ctx to cancel the request and any async job polling
+func (s *AccountService) EnableAccountWithContext(ctx context.Context, p *EnableAccountParams) (*EnableAccountResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableAccount", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -895,7 +1671,8 @@ type GetSolidFireAccountIdParams struct {
 	p map[string]interface{}
 }
 
-func (p *GetSolidFireAccountIdParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetSolidFireAccountIdParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -909,6 +1686,49 @@ func (p *GetSolidFireAccountIdParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetSolidFireAccountIdParams) Validate() error {
+	v := newParamValidator("getSolidFireAccountId", p.p)
+	v.required("accountid", "storageid")
+	v.maxLength("accountid", 255)
+	v.maxLength("storageid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetSolidFireAccountIdParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetSolidFireAccountIdParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "accountid", "storageid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getSolidFireAccountId", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getSolidFireAccountId: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *GetSolidFireAccountIdParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -916,6 +1736,15 @@ func (p *GetSolidFireAccountIdParams) SetAccountid(v string) {
 	p.p["accountid"] = v
 }
 
+func (p *GetSolidFireAccountIdParams) ResetAccountid() {
+	delete(p.p, "accountid")
+}
+
+func (p *GetSolidFireAccountIdParams) GetAccountid() (string, bool) {
+	value, ok := p.p["accountid"].(string)
+	return value, ok
+}
+
 func (p *GetSolidFireAccountIdParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -923,6 +1752,15 @@ func (p *GetSolidFireAccountIdParams) SetStorageid(v string) {
 	p.p["storageid"] = v
 }
 
+func (p *GetSolidFireAccountIdParams) ResetStorageid() {
+	delete(p.p, "storageid")
+}
+
+func (p *GetSolidFireAccountIdParams) GetStorageid() (string, bool) {
+	value, ok := p.p["storageid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new GetSolidFireAccountIdParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewGetSolidFireAccountIdParams(accountid string, storageid string) *GetSolidFireAccountIdParams {
@@ -935,7 +1773,16 @@ func (s *AccountService) NewGetSolidFireAccountIdParams(accountid string, storag
 
 // Get SolidFire Account ID
 func (s *AccountService) GetSolidFireAccountId(p *GetSolidFireAccountIdParams) (*GetSolidFireAccountIdResponse, error) {
-	resp, err := s.cs.newRequest("getSolidFireAccountId", p.toURLValues())
+	return s.GetSolidFireAccountIdWithContext(context.Background(), p)
+}
+
+// GetSolidFireAccountIdWithContext is the same as GetSolidFireAccountId, but uses ctx to cancel the request and any async job polling
+func (s *AccountService) GetSolidFireAccountIdWithContext(ctx context.Context, p *GetSolidFireAccountIdParams) (*GetSolidFireAccountIdResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getSolidFireAccountId", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -958,7 +1805,8 @@ type ListAccountsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListAccountsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListAccountsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1009,6 +1857,66 @@ func (p *ListAccountsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListAccountsParams) Validate() error {
+	v := newParamValidator("listAccounts", p.p)
+	v.ids("domainid", "id")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListAccountsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListAccountsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "accounttype":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "domainid", "id", "keyword", "name", "state":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "iscleanuprequired", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listAccounts", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listAccounts: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListAccountsParams) SetAccounttype(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1016,6 +1924,15 @@ func (p *ListAccountsParams) SetAccounttype(v int64) {
 	p.p["accounttype"] = v
 }
 
+func (p *ListAccountsParams) ResetAccounttype() {
+	delete(p.p, "accounttype")
+}
+
+func (p *ListAccountsParams) GetAccounttype() (int64, bool) {
+	value, ok := p.p["accounttype"].(int64)
+	return value, ok
+}
+
 func (p *ListAccountsParams) SetDetails(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1023,6 +1940,15 @@ func (p *ListAccountsParams) SetDetails(v []string) {
 	p.p["details"] = v
 }
 
+func (p *ListAccountsParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *ListAccountsParams) GetDetails() ([]string, bool) {
+	value, ok := p.p["details"].([]string)
+	return value, ok
+}
+
 func (p *ListAccountsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1030,6 +1956,15 @@ func (p *ListAccountsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListAccountsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListAccountsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListAccountsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1037,6 +1972,15 @@ func (p *ListAccountsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListAccountsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListAccountsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListAccountsParams) SetIscleanuprequired(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1044,6 +1988,15 @@ func (p *ListAccountsParams) SetIscleanuprequired(v bool) {
 	p.p["iscleanuprequired"] = v
 }
 
+func (p *ListAccountsParams) ResetIscleanuprequired() {
+	delete(p.p, "iscleanuprequired")
+}
+
+func (p *ListAccountsParams) GetIscleanuprequired() (bool, bool) {
+	value, ok := p.p["iscleanuprequired"].(bool)
+	return value, ok
+}
+
 func (p *ListAccountsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1051,6 +2004,15 @@ func (p *ListAccountsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListAccountsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListAccountsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListAccountsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1058,6 +2020,15 @@ func (p *ListAccountsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListAccountsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListAccountsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListAccountsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1065,6 +2036,15 @@ func (p *ListAccountsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListAccountsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListAccountsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListAccountsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1072,6 +2052,15 @@ func (p *ListAccountsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListAccountsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListAccountsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListAccountsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1079,6 +2068,15 @@ func (p *ListAccountsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListAccountsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListAccountsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListAccountsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1086,6 +2084,15 @@ func (p *ListAccountsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListAccountsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListAccountsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListAccountsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1093,6 +2100,15 @@ func (p *ListAccountsParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListAccountsParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListAccountsParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListAccountsParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewListAccountsParams() *ListAccountsParams {
@@ -1103,6 +2119,11 @@ func (s *AccountService) NewListAccountsParams() *ListAccountsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AccountService) GetAccountID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetAccountIDWithContext(context.Background(), name, opts...)
+}
+
+// GetAccountIDWithContext is the same as GetAccountID, but uses ctx to cancel the request
+func (s *AccountService) GetAccountIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAccountsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1114,7 +2135,7 @@ func (s *AccountService) GetAccountID(name string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListAccounts(p)
+	l, err := s.ListAccountsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1139,12 +2160,17 @@ func (s *AccountService) GetAccountID(name string, opts ...OptionFunc) (string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AccountService) GetAccountByName(name string, opts ...OptionFunc) (*Account, int, error) {
-	id, count, err := s.GetAccountID(name, opts...)
+	return s.GetAccountByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetAccountByNameWithContext is the same as GetAccountByName, but uses ctx to cancel the requests
+func (s *AccountService) GetAccountByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Account, int, error) {
+	id, count, err := s.GetAccountIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetAccountByID(id, opts...)
+	r, count, err := s.GetAccountByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1153,6 +2179,11 @@ func (s *AccountService) GetAccountByName(name string, opts ...OptionFunc) (*Acc
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AccountService) GetAccountByID(id string, opts ...OptionFunc) (*Account, int, error) {
+	return s.GetAccountByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetAccountByIDWithContext is the same as GetAccountByID, but uses ctx to cancel the request
+func (s *AccountService) GetAccountByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Account, int, error) {
 	p := &ListAccountsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1164,18 +2195,16 @@ func (s *AccountService) GetAccountByID(id string, opts ...OptionFunc) (*Account
 		}
 	}
 
-	l, err := s.ListAccounts(p)
+	l, err := s.ListAccountsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1186,7 +2215,16 @@ func (s *AccountService) GetAccountByID(id string, opts ...OptionFunc) (*Account
 
 // Lists accounts and provides detailed account information for listed accounts
 func (s *AccountService) ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error) {
-	resp, err := s.cs.newRequest("listAccounts", p.toURLValues())
+	return s.ListAccountsWithContext(context.Background(), p)
+}
+
+// ListAccountsWithContext is the same as ListAccounts, but uses ctx to cancel the request and any async job polling
+func (s *AccountService) ListAccountsWithContext(ctx context.Context, p *ListAccountsParams) (*ListAccountsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listAccounts", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1199,6 +2237,92 @@ func (s *AccountService) ListAccounts(p *ListAccountsParams) (*ListAccountsRespo
 	return &r, nil
 }
 
+// ListAccountsPager iterates over all pages of a ListAccounts call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListAccountsPager struct {
+	pager
+
+	s *AccountService
+	p *ListAccountsParams
+	r *ListAccountsResponse
+}
+
+// NewListAccountsPager returns a pager for all pages of a ListAccounts call with the given params
+func (s *AccountService) NewListAccountsPager(p *ListAccountsParams) *ListAccountsPager {
+	return &ListAccountsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListAccountsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListAccountsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListAccountsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListAccountsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Accounts), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListAccountsPager) Page() *ListAccountsResponse {
+	return pg.r
+}
+
+// ListAccountsAll fetches all pages of a ListAccounts call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *AccountService) ListAccountsAll(p *ListAccountsParams) (*ListAccountsResponse, error) {
+	return s.ListAccountsAllWithContext(context.Background(), p)
+}
+
+// ListAccountsAllWithContext is the same as ListAccountsAll, but uses ctx to cancel the requests
+func (s *AccountService) ListAccountsAllWithContext(ctx context.Context, p *ListAccountsParams) (*ListAccountsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Account)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListAccountsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListAccountsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Accounts
+		mu.Unlock()
+
+		return len(l.Accounts), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListAccountsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Accounts = append(r.Accounts, pages[page]...)
+	}
+	r.Count = len(r.Accounts)
+
+	return r, nil
+}
+
 type ListAccountsResponse struct {
 	Count    int        `json:"count"`
 	Accounts []*Account `json:"account"`
@@ -1294,7 +2418,8 @@ type ListProjectAccountsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListProjectAccountsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListProjectAccountsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1328,6 +2453,55 @@ func (p *ListProjectAccountsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListProjectAccountsParams) Validate() error {
+	v := newParamValidator("listProjectAccounts", p.p)
+	v.required("projectid")
+	v.ids("projectid", "projectroleid", "userid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("role", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListProjectAccountsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListProjectAccountsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "keyword", "projectid", "projectroleid", "role", "userid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listProjectAccounts", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listProjectAccounts: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListProjectAccountsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1335,6 +2509,15 @@ func (p *ListProjectAccountsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListProjectAccountsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListProjectAccountsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListProjectAccountsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1342,6 +2525,15 @@ func (p *ListProjectAccountsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListProjectAccountsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListProjectAccountsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListProjectAccountsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1349,6 +2541,15 @@ func (p *ListProjectAccountsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListProjectAccountsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListProjectAccountsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListProjectAccountsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1356,6 +2557,15 @@ func (p *ListProjectAccountsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListProjectAccountsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListProjectAccountsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListProjectAccountsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1363,6 +2573,15 @@ func (p *ListProjectAccountsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListProjectAccountsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListProjectAccountsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListProjectAccountsParams) SetProjectroleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1370,6 +2589,15 @@ func (p *ListProjectAccountsParams) SetProjectroleid(v string) {
 	p.p["projectroleid"] = v
 }
 
+func (p *ListProjectAccountsParams) ResetProjectroleid() {
+	delete(p.p, "projectroleid")
+}
+
+func (p *ListProjectAccountsParams) GetProjectroleid() (string, bool) {
+	value, ok := p.p["projectroleid"].(string)
+	return value, ok
+}
+
 func (p *ListProjectAccountsParams) SetRole(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1377,6 +2605,15 @@ func (p *ListProjectAccountsParams) SetRole(v string) {
 	p.p["role"] = v
 }
 
+func (p *ListProjectAccountsParams) ResetRole() {
+	delete(p.p, "role")
+}
+
+func (p *ListProjectAccountsParams) GetRole() (string, bool) {
+	value, ok := p.p["role"].(string)
+	return value, ok
+}
+
 func (p *ListProjectAccountsParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1384,6 +2621,15 @@ func (p *ListProjectAccountsParams) SetUserid(v string) {
 	p.p["userid"] = v
 }
 
+func (p *ListProjectAccountsParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *ListProjectAccountsParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListProjectAccountsParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewListProjectAccountsParams(projectid string) *ListProjectAccountsParams {
@@ -1395,6 +2641,11 @@ func (s *AccountService) NewListProjectAccountsParams(projectid string) *ListPro
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AccountService) GetProjectAccountID(keyword string, projectid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetProjectAccountIDWithContext(context.Background(), keyword, projectid, opts...)
+}
+
+// GetProjectAccountIDWithContext is the same as GetProjectAccountID, but uses ctx to cancel the request
+func (s *AccountService) GetProjectAccountIDWithContext(ctx context.Context, keyword string, projectid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListProjectAccountsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1407,7 +2658,7 @@ func (s *AccountService) GetProjectAccountID(keyword string, projectid string, o
 		}
 	}
 
-	l, err := s.ListProjectAccounts(p)
+	l, err := s.ListProjectAccountsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1432,7 +2683,16 @@ func (s *AccountService) GetProjectAccountID(keyword string, projectid string, o
 
 // Lists project's accounts
 func (s *AccountService) ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
-	resp, err := s.cs.newRequest("listProjectAccounts", p.toURLValues())
+	return s.ListProjectAccountsWithContext(context.Background(), p)
+}
+
+// ListProjectAccountsWithContext is the same as ListProjectAccounts, but uses ctx to cancel the request and any async job polling
+func (s *AccountService) ListProjectAccountsWithContext(ctx context.Context, p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listProjectAccounts", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1445,6 +2705,92 @@ func (s *AccountService) ListProjectAccounts(p *ListProjectAccountsParams) (*Lis
 	return &r, nil
 }
 
+// ListProjectAccountsPager iterates over all pages of a ListProjectAccounts call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListProjectAccountsPager struct {
+	pager
+
+	s *AccountService
+	p *ListProjectAccountsParams
+	r *ListProjectAccountsResponse
+}
+
+// NewListProjectAccountsPager returns a pager for all pages of a ListProjectAccounts call with the given params
+func (s *AccountService) NewListProjectAccountsPager(p *ListProjectAccountsParams) *ListProjectAccountsPager {
+	return &ListProjectAccountsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListProjectAccountsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListProjectAccountsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListProjectAccountsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListProjectAccountsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.ProjectAccounts), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListProjectAccountsPager) Page() *ListProjectAccountsResponse {
+	return pg.r
+}
+
+// ListProjectAccountsAll fetches all pages of a ListProjectAccounts call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *AccountService) ListProjectAccountsAll(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
+	return s.ListProjectAccountsAllWithContext(context.Background(), p)
+}
+
+// ListProjectAccountsAllWithContext is the same as ListProjectAccountsAll, but uses ctx to cancel the requests
+func (s *AccountService) ListProjectAccountsAllWithContext(ctx context.Context, p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*ProjectAccount)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListProjectAccountsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListProjectAccountsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.ProjectAccounts
+		mu.Unlock()
+
+		return len(l.ProjectAccounts), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListProjectAccountsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.ProjectAccounts = append(r.ProjectAccounts, pages[page]...)
+	}
+	r.Count = len(r.ProjectAccounts)
+
+	return r, nil
+}
+
 type ListProjectAccountsResponse struct {
 	Count           int               `json:"count"`
 	ProjectAccounts []*ProjectAccount `json:"projectaccount"`
@@ -1516,7 +2862,8 @@ type LockAccountParams struct {
 	p map[string]interface{}
 }
 
-func (p *LockAccountParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *LockAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1530,6 +2877,49 @@ func (p *LockAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *LockAccountParams) Validate() error {
+	v := newParamValidator("lockAccount", p.p)
+	v.required("account", "domainid")
+	v.ids("domainid")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *LockAccountParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *LockAccountParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for lockAccount", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of lockAccount: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *LockAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1537,6 +2927,15 @@ func (p *LockAccountParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *LockAccountParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *LockAccountParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *LockAccountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1544,6 +2943,15 @@ func (p *LockAccountParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *LockAccountParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *LockAccountParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new LockAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewLockAccountParams(account string, domainid string) *LockAccountParams {
@@ -1556,7 +2964,16 @@ func (s *AccountService) NewLockAccountParams(account string, domainid string) *
 
 // This deprecated function used to locks an account. Look for the API DisableAccount instead
 func (s *AccountService) LockAccount(p *LockAccountParams) (*LockAccountResponse, error) {
-	resp, err := s.cs.newRequest("lockAccount", p.toURLValues())
+	return s.LockAccountWithContext(context.Background(), p)
+}
+
+// LockAccountWithContext is the same as LockAccount, but uses ctx to cancel the request and any async job polling
+func (s *AccountService) LockAccountWithContext(ctx context.Context, p *LockAccountParams) (*LockAccountResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "lockAccount", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1659,7 +3076,8 @@ type MarkDefaultZoneForAccountParams struct {
 	p map[string]interface{}
 }
 
-func (p *MarkDefaultZoneForAccountParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *MarkDefaultZoneForAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1676,6 +3094,49 @@ func (p *MarkDefaultZoneForAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *MarkDefaultZoneForAccountParams) Validate() error {
+	v := newParamValidator("markDefaultZoneForAccount", p.p)
+	v.required("account", "domainid", "zoneid")
+	v.ids("domainid", "zoneid")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *MarkDefaultZoneForAccountParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *MarkDefaultZoneForAccountParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for markDefaultZoneForAccount", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of markDefaultZoneForAccount: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *MarkDefaultZoneForAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1683,6 +3144,15 @@ func (p *MarkDefaultZoneForAccountParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *MarkDefaultZoneForAccountParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *MarkDefaultZoneForAccountParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *MarkDefaultZoneForAccountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1690,6 +3160,15 @@ func (p *MarkDefaultZoneForAccountParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *MarkDefaultZoneForAccountParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *MarkDefaultZoneForAccountParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *MarkDefaultZoneForAccountParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1697,6 +3176,15 @@ func (p *MarkDefaultZoneForAccountParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *MarkDefaultZoneForAccountParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *MarkDefaultZoneForAccountParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new MarkDefaultZoneForAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewMarkDefaultZoneForAccountParams(account string, domainid string, zoneid string) *MarkDefaultZoneForAccountParams {
@@ -1710,7 +3198,16 @@ func (s *AccountService) NewMarkDefaultZoneForAccountParams(account string, doma
 
 // Marks a default zone for this account
 func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
-	resp, err := s.cs.newRequest("markDefaultZoneForAccount", p.toURLValues())
+	return s.MarkDefaultZoneForAccountWithContext(context.Background(), p)
+}
+
+// MarkDefaultZoneForAccountWithContext is the same as MarkDefaultZoneForAccount, but uses ctx to cancel the request and any async job polling
+func (s *AccountService) MarkDefaultZoneForAccountWithContext(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "markDefaultZoneForAccount", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1722,7 +3219,7 @@ func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1743,6 +3240,40 @@ func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountP
 	return &r, nil
 }
 
+// MarkDefaultZoneForAccountAsync starts the async job for MarkDefaultZoneForAccount and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a MarkDefaultZoneForAccountResponse using Job.Result
+func (s *AccountService) MarkDefaultZoneForAccountAsync(p *MarkDefaultZoneForAccountParams) (*Job, error) {
+	return s.MarkDefaultZoneForAccountAsyncWithContext(context.Background(), p)
+}
+
+// MarkDefaultZoneForAccountAsyncWithContext is the same as MarkDefaultZoneForAccountAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AccountService) MarkDefaultZoneForAccountAsyncWithContext(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "markDefaultZoneForAccount", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MarkDefaultZoneForAccountResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type MarkDefaultZoneForAccountResponse struct {
 	Accountdetails            map[string]string                       `json:"accountdetails"`
 	Accounttype               int                                     `json:"accounttype"`
@@ -1833,7 +3364,8 @@ type UpdateAccountParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateAccountParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1866,6 +3398,54 @@ func (p *UpdateAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateAccountParams) Validate() error {
+	v := newParamValidator("updateAccount", p.p)
+	v.ids("domainid", "id", "roleid")
+	v.maxLength("account", 255)
+	v.maxLength("networkdomain", 255)
+	v.maxLength("newname", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateAccountParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateAccountParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "networkdomain", "newname", "roleid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "accountdetails":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateAccount", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateAccount: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1873,6 +3453,15 @@ func (p *UpdateAccountParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *UpdateAccountParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *UpdateAccountParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *UpdateAccountParams) SetAccountdetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1880,6 +3469,15 @@ func (p *UpdateAccountParams) SetAccountdetails(v map[string]string) {
 	p.p["accountdetails"] = v
 }
 
+func (p *UpdateAccountParams) ResetAccountdetails() {
+	delete(p.p, "accountdetails")
+}
+
+func (p *UpdateAccountParams) GetAccountdetails() (map[string]string, bool) {
+	value, ok := p.p["accountdetails"].(map[string]string)
+	return value, ok
+}
+
 func (p *UpdateAccountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1887,6 +3485,15 @@ func (p *UpdateAccountParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *UpdateAccountParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *UpdateAccountParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *UpdateAccountParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1894,6 +3501,15 @@ func (p *UpdateAccountParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateAccountParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateAccountParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateAccountParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1901,6 +3517,15 @@ func (p *UpdateAccountParams) SetNetworkdomain(v string) {
 	p.p["networkdomain"] = v
 }
 
+func (p *UpdateAccountParams) ResetNetworkdomain() {
+	delete(p.p, "networkdomain")
+}
+
+func (p *UpdateAccountParams) GetNetworkdomain() (string, bool) {
+	value, ok := p.p["networkdomain"].(string)
+	return value, ok
+}
+
 func (p *UpdateAccountParams) SetNewname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1908,6 +3533,15 @@ func (p *UpdateAccountParams) SetNewname(v string) {
 	p.p["newname"] = v
 }
 
+func (p *UpdateAccountParams) ResetNewname() {
+	delete(p.p, "newname")
+}
+
+func (p *UpdateAccountParams) GetNewname() (string, bool) {
+	value, ok := p.p["newname"].(string)
+	return value, ok
+}
+
 func (p *UpdateAccountParams) SetRoleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1915,6 +3549,15 @@ func (p *UpdateAccountParams) SetRoleid(v string) {
 	p.p["roleid"] = v
 }
 
+func (p *UpdateAccountParams) ResetRoleid() {
+	delete(p.p, "roleid")
+}
+
+func (p *UpdateAccountParams) GetRoleid() (string, bool) {
+	value, ok := p.p["roleid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewUpdateAccountParams() *UpdateAccountParams {
@@ -1925,7 +3568,16 @@ func (s *AccountService) NewUpdateAccountParams() *UpdateAccountParams {
 
 // Updates account information for the authenticated user
 func (s *AccountService) UpdateAccount(p *UpdateAccountParams) (*UpdateAccountResponse, error) {
-	resp, err := s.cs.newRequest("updateAccount", p.toURLValues())
+	return s.UpdateAccountWithContext(context.Background(), p)
+}
+
+// UpdateAccountWithContext is the same as UpdateAccount, but uses ctx to cancel the request and any async job polling
+func (s *AccountService) UpdateAccountWithContext(ctx context.Context, p *UpdateAccountParams) (*UpdateAccountResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateAccount", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
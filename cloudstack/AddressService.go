@@ -20,18 +20,48 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// AddressServiceIface is the interface implemented by AddressService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type AddressServiceIface interface {
+	NewAssociateIpAddressParams() *AssociateIpAddressParams
+	AssociateIpAddress(p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error)
+	AssociateIpAddressWithContext(ctx context.Context, p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error)
+	AssociateIpAddressAsync(p *AssociateIpAddressParams) (*Job, error)
+	AssociateIpAddressAsyncWithContext(ctx context.Context, p *AssociateIpAddressParams) (*Job, error)
+	NewDisassociateIpAddressParams(id string) *DisassociateIpAddressParams
+	DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error)
+	DisassociateIpAddressWithContext(ctx context.Context, p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error)
+	DisassociateIpAddressAsync(p *DisassociateIpAddressParams) (*Job, error)
+	DisassociateIpAddressAsyncWithContext(ctx context.Context, p *DisassociateIpAddressParams) (*Job, error)
+	NewListPublicIpAddressesParams() *ListPublicIpAddressesParams
+	GetPublicIpAddressByID(id string, opts ...OptionFunc) (*PublicIpAddress, int, error)
+	GetPublicIpAddressByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PublicIpAddress, int, error)
+	ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error)
+	ListPublicIpAddressesWithContext(ctx context.Context, p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error)
+	NewListPublicIpAddressesPager(p *ListPublicIpAddressesParams) *ListPublicIpAddressesPager
+	ListPublicIpAddressesAll(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error)
+	ListPublicIpAddressesAllWithContext(ctx context.Context, p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error)
+	NewUpdateIpAddressParams(id string) *UpdateIpAddressParams
+	UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error)
+	UpdateIpAddressWithContext(ctx context.Context, p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error)
+	UpdateIpAddressAsync(p *UpdateIpAddressParams) (*Job, error)
+	UpdateIpAddressAsyncWithContext(ctx context.Context, p *UpdateIpAddressParams) (*Job, error)
+}
+
 type AssociateIpAddressParams struct {
 	p map[string]interface{}
 }
 
-func (p *AssociateIpAddressParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AssociateIpAddressParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -72,6 +102,57 @@ func (p *AssociateIpAddressParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AssociateIpAddressParams) Validate() error {
+	v := newParamValidator("associateIpAddress", p.p)
+	v.ids("domainid", "networkid", "projectid", "vpcid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("ipaddress", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AssociateIpAddressParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AssociateIpAddressParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "ipaddress", "networkid", "projectid", "vpcid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isportable":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "regionid":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for associateIpAddress", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of associateIpAddress: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AssociateIpAddressParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +160,15 @@ func (p *AssociateIpAddressParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *AssociateIpAddressParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *AssociateIpAddressParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *AssociateIpAddressParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -86,6 +176,15 @@ func (p *AssociateIpAddressParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *AssociateIpAddressParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *AssociateIpAddressParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *AssociateIpAddressParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -93,6 +192,15 @@ func (p *AssociateIpAddressParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *AssociateIpAddressParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *AssociateIpAddressParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *AssociateIpAddressParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -100,6 +208,15 @@ func (p *AssociateIpAddressParams) SetIpaddress(v string) {
 	p.p["ipaddress"] = v
 }
 
+func (p *AssociateIpAddressParams) ResetIpaddress() {
+	delete(p.p, "ipaddress")
+}
+
+func (p *AssociateIpAddressParams) GetIpaddress() (string, bool) {
+	value, ok := p.p["ipaddress"].(string)
+	return value, ok
+}
+
 func (p *AssociateIpAddressParams) SetIsportable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -107,6 +224,15 @@ func (p *AssociateIpAddressParams) SetIsportable(v bool) {
 	p.p["isportable"] = v
 }
 
+func (p *AssociateIpAddressParams) ResetIsportable() {
+	delete(p.p, "isportable")
+}
+
+func (p *AssociateIpAddressParams) GetIsportable() (bool, bool) {
+	value, ok := p.p["isportable"].(bool)
+	return value, ok
+}
+
 func (p *AssociateIpAddressParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -114,6 +240,15 @@ func (p *AssociateIpAddressParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *AssociateIpAddressParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *AssociateIpAddressParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *AssociateIpAddressParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -121,6 +256,15 @@ func (p *AssociateIpAddressParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *AssociateIpAddressParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *AssociateIpAddressParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *AssociateIpAddressParams) SetRegionid(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -128,6 +272,15 @@ func (p *AssociateIpAddressParams) SetRegionid(v int) {
 	p.p["regionid"] = v
 }
 
+func (p *AssociateIpAddressParams) ResetRegionid() {
+	delete(p.p, "regionid")
+}
+
+func (p *AssociateIpAddressParams) GetRegionid() (int, bool) {
+	value, ok := p.p["regionid"].(int)
+	return value, ok
+}
+
 func (p *AssociateIpAddressParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -135,6 +288,15 @@ func (p *AssociateIpAddressParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *AssociateIpAddressParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *AssociateIpAddressParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 func (p *AssociateIpAddressParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -142,6 +304,15 @@ func (p *AssociateIpAddressParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *AssociateIpAddressParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *AssociateIpAddressParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AssociateIpAddressParams instance,
 // as then you are sure you have configured all required params
 func (s *AddressService) NewAssociateIpAddressParams() *AssociateIpAddressParams {
@@ -152,7 +323,16 @@ func (s *AddressService) NewAssociateIpAddressParams() *AssociateIpAddressParams
 
 // Acquires and associates a public IP to an account.
 func (s *AddressService) AssociateIpAddress(p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("associateIpAddress", p.toURLValues())
+	return s.AssociateIpAddressWithContext(context.Background(), p)
+}
+
+// AssociateIpAddressWithContext is the same as AssociateIpAddress, but uses ctx to cancel the request and any async job polling
+func (s *AddressService) AssociateIpAddressWithContext(ctx context.Context, p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "associateIpAddress", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +344,7 @@ func (s *AddressService) AssociateIpAddress(p *AssociateIpAddressParams) (*Assoc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -185,6 +365,40 @@ func (s *AddressService) AssociateIpAddress(p *AssociateIpAddressParams) (*Assoc
 	return &r, nil
 }
 
+// AssociateIpAddressAsync starts the async job for AssociateIpAddress and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AssociateIpAddressResponse using Job.Result
+func (s *AddressService) AssociateIpAddressAsync(p *AssociateIpAddressParams) (*Job, error) {
+	return s.AssociateIpAddressAsyncWithContext(context.Background(), p)
+}
+
+// AssociateIpAddressAsyncWithContext is the same as AssociateIpAddressAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AddressService) AssociateIpAddressAsyncWithContext(ctx context.Context, p *AssociateIpAddressParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "associateIpAddress", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AssociateIpAddressResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AssociateIpAddressResponse struct {
 	Account                   string `json:"account"`
 	Allocated                 string `json:"allocated"`
@@ -226,7 +440,8 @@ type DisassociateIpAddressParams struct {
 	p map[string]interface{}
 }
 
-func (p *DisassociateIpAddressParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DisassociateIpAddressParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -237,6 +452,48 @@ func (p *DisassociateIpAddressParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DisassociateIpAddressParams) Validate() error {
+	v := newParamValidator("disassociateIpAddress", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DisassociateIpAddressParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DisassociateIpAddressParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for disassociateIpAddress", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of disassociateIpAddress: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DisassociateIpAddressParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -244,6 +501,15 @@ func (p *DisassociateIpAddressParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DisassociateIpAddressParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DisassociateIpAddressParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DisassociateIpAddressParams instance,
 // as then you are sure you have configured all required params
 func (s *AddressService) NewDisassociateIpAddressParams(id string) *DisassociateIpAddressParams {
@@ -255,7 +521,16 @@ func (s *AddressService) NewDisassociateIpAddressParams(id string) *Disassociate
 
 // Disassociates an IP address from the account.
 func (s *AddressService) DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("disassociateIpAddress", p.toURLValues())
+	return s.DisassociateIpAddressWithContext(context.Background(), p)
+}
+
+// DisassociateIpAddressWithContext is the same as DisassociateIpAddress, but uses ctx to cancel the request and any async job polling
+func (s *AddressService) DisassociateIpAddressWithContext(ctx context.Context, p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disassociateIpAddress", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -267,7 +542,7 @@ func (s *AddressService) DisassociateIpAddress(p *DisassociateIpAddressParams) (
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -283,6 +558,31 @@ func (s *AddressService) DisassociateIpAddress(p *DisassociateIpAddressParams) (
 	return &r, nil
 }
 
+// DisassociateIpAddressAsync starts the async job for DisassociateIpAddress and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DisassociateIpAddressResponse using Job.Result
+func (s *AddressService) DisassociateIpAddressAsync(p *DisassociateIpAddressParams) (*Job, error) {
+	return s.DisassociateIpAddressAsyncWithContext(context.Background(), p)
+}
+
+// DisassociateIpAddressAsyncWithContext is the same as DisassociateIpAddressAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AddressService) DisassociateIpAddressAsyncWithContext(ctx context.Context, p *DisassociateIpAddressParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disassociateIpAddress", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisassociateIpAddressResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DisassociateIpAddressResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -294,7 +594,8 @@ type ListPublicIpAddressesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListPublicIpAddressesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListPublicIpAddressesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -388,6 +689,63 @@ func (p *ListPublicIpAddressesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListPublicIpAddressesParams) Validate() error {
+	v := newParamValidator("listPublicIpAddresses", p.p)
+	v.ids("associatednetworkid", "domainid", "id", "networkid", "physicalnetworkid", "projectid", "vlanid", "vpcid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("ipaddress", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListPublicIpAddressesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListPublicIpAddressesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "associatednetworkid", "domainid", "id", "ipaddress", "keyword", "networkid", "physicalnetworkid", "projectid", "state", "vlanid", "vpcid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "allocatedonly", "fordisplay", "forloadbalancing", "forvirtualnetwork", "isrecursive", "issourcenat", "isstaticnat", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listPublicIpAddresses", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listPublicIpAddresses: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListPublicIpAddressesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -395,6 +753,15 @@ func (p *ListPublicIpAddressesParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListPublicIpAddressesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetAllocatedonly(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -402,6 +769,15 @@ func (p *ListPublicIpAddressesParams) SetAllocatedonly(v bool) {
 	p.p["allocatedonly"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetAllocatedonly() {
+	delete(p.p, "allocatedonly")
+}
+
+func (p *ListPublicIpAddressesParams) GetAllocatedonly() (bool, bool) {
+	value, ok := p.p["allocatedonly"].(bool)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetAssociatednetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -409,6 +785,15 @@ func (p *ListPublicIpAddressesParams) SetAssociatednetworkid(v string) {
 	p.p["associatednetworkid"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetAssociatednetworkid() {
+	delete(p.p, "associatednetworkid")
+}
+
+func (p *ListPublicIpAddressesParams) GetAssociatednetworkid() (string, bool) {
+	value, ok := p.p["associatednetworkid"].(string)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -416,6 +801,15 @@ func (p *ListPublicIpAddressesParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListPublicIpAddressesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -423,6 +817,15 @@ func (p *ListPublicIpAddressesParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListPublicIpAddressesParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetForloadbalancing(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -430,6 +833,15 @@ func (p *ListPublicIpAddressesParams) SetForloadbalancing(v bool) {
 	p.p["forloadbalancing"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetForloadbalancing() {
+	delete(p.p, "forloadbalancing")
+}
+
+func (p *ListPublicIpAddressesParams) GetForloadbalancing() (bool, bool) {
+	value, ok := p.p["forloadbalancing"].(bool)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetForvirtualnetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -437,6 +849,15 @@ func (p *ListPublicIpAddressesParams) SetForvirtualnetwork(v bool) {
 	p.p["forvirtualnetwork"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetForvirtualnetwork() {
+	delete(p.p, "forvirtualnetwork")
+}
+
+func (p *ListPublicIpAddressesParams) GetForvirtualnetwork() (bool, bool) {
+	value, ok := p.p["forvirtualnetwork"].(bool)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -444,6 +865,15 @@ func (p *ListPublicIpAddressesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListPublicIpAddressesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -451,6 +881,15 @@ func (p *ListPublicIpAddressesParams) SetIpaddress(v string) {
 	p.p["ipaddress"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetIpaddress() {
+	delete(p.p, "ipaddress")
+}
+
+func (p *ListPublicIpAddressesParams) GetIpaddress() (string, bool) {
+	value, ok := p.p["ipaddress"].(string)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -458,6 +897,15 @@ func (p *ListPublicIpAddressesParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListPublicIpAddressesParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetIssourcenat(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -465,6 +913,15 @@ func (p *ListPublicIpAddressesParams) SetIssourcenat(v bool) {
 	p.p["issourcenat"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetIssourcenat() {
+	delete(p.p, "issourcenat")
+}
+
+func (p *ListPublicIpAddressesParams) GetIssourcenat() (bool, bool) {
+	value, ok := p.p["issourcenat"].(bool)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetIsstaticnat(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -472,6 +929,15 @@ func (p *ListPublicIpAddressesParams) SetIsstaticnat(v bool) {
 	p.p["isstaticnat"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetIsstaticnat() {
+	delete(p.p, "isstaticnat")
+}
+
+func (p *ListPublicIpAddressesParams) GetIsstaticnat() (bool, bool) {
+	value, ok := p.p["isstaticnat"].(bool)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -479,6 +945,15 @@ func (p *ListPublicIpAddressesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListPublicIpAddressesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -486,6 +961,15 @@ func (p *ListPublicIpAddressesParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListPublicIpAddressesParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -493,6 +977,15 @@ func (p *ListPublicIpAddressesParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListPublicIpAddressesParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -500,6 +993,15 @@ func (p *ListPublicIpAddressesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListPublicIpAddressesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -507,6 +1009,15 @@ func (p *ListPublicIpAddressesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListPublicIpAddressesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -514,6 +1025,15 @@ func (p *ListPublicIpAddressesParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListPublicIpAddressesParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -521,6 +1041,15 @@ func (p *ListPublicIpAddressesParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListPublicIpAddressesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -528,6 +1057,15 @@ func (p *ListPublicIpAddressesParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListPublicIpAddressesParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -535,6 +1073,15 @@ func (p *ListPublicIpAddressesParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListPublicIpAddressesParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetVlanid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -542,6 +1089,15 @@ func (p *ListPublicIpAddressesParams) SetVlanid(v string) {
 	p.p["vlanid"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetVlanid() {
+	delete(p.p, "vlanid")
+}
+
+func (p *ListPublicIpAddressesParams) GetVlanid() (string, bool) {
+	value, ok := p.p["vlanid"].(string)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -549,6 +1105,15 @@ func (p *ListPublicIpAddressesParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *ListPublicIpAddressesParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 func (p *ListPublicIpAddressesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -556,6 +1121,15 @@ func (p *ListPublicIpAddressesParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListPublicIpAddressesParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListPublicIpAddressesParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListPublicIpAddressesParams instance,
 // as then you are sure you have configured all required params
 func (s *AddressService) NewListPublicIpAddressesParams() *ListPublicIpAddressesParams {
@@ -566,6 +1140,11 @@ func (s *AddressService) NewListPublicIpAddressesParams() *ListPublicIpAddresses
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AddressService) GetPublicIpAddressByID(id string, opts ...OptionFunc) (*PublicIpAddress, int, error) {
+	return s.GetPublicIpAddressByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetPublicIpAddressByIDWithContext is the same as GetPublicIpAddressByID, but uses ctx to cancel the request
+func (s *AddressService) GetPublicIpAddressByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PublicIpAddress, int, error) {
 	p := &ListPublicIpAddressesParams{}
 	p.p = make(map[string]interface{})
 
@@ -577,18 +1156,16 @@ func (s *AddressService) GetPublicIpAddressByID(id string, opts ...OptionFunc) (
 		}
 	}
 
-	l, err := s.ListPublicIpAddresses(p)
+	l, err := s.ListPublicIpAddressesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -599,7 +1176,16 @@ func (s *AddressService) GetPublicIpAddressByID(id string, opts ...OptionFunc) (
 
 // Lists all public ip addresses
 func (s *AddressService) ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
-	resp, err := s.cs.newRequest("listPublicIpAddresses", p.toURLValues())
+	return s.ListPublicIpAddressesWithContext(context.Background(), p)
+}
+
+// ListPublicIpAddressesWithContext is the same as ListPublicIpAddresses, but uses ctx to cancel the request and any async job polling
+func (s *AddressService) ListPublicIpAddressesWithContext(ctx context.Context, p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listPublicIpAddresses", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -612,6 +1198,92 @@ func (s *AddressService) ListPublicIpAddresses(p *ListPublicIpAddressesParams) (
 	return &r, nil
 }
 
+// ListPublicIpAddressesPager iterates over all pages of a ListPublicIpAddresses call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListPublicIpAddressesPager struct {
+	pager
+
+	s *AddressService
+	p *ListPublicIpAddressesParams
+	r *ListPublicIpAddressesResponse
+}
+
+// NewListPublicIpAddressesPager returns a pager for all pages of a ListPublicIpAddresses call with the given params
+func (s *AddressService) NewListPublicIpAddressesPager(p *ListPublicIpAddressesParams) *ListPublicIpAddressesPager {
+	return &ListPublicIpAddressesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListPublicIpAddressesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListPublicIpAddressesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListPublicIpAddressesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListPublicIpAddressesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.PublicIpAddresses), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListPublicIpAddressesPager) Page() *ListPublicIpAddressesResponse {
+	return pg.r
+}
+
+// ListPublicIpAddressesAll fetches all pages of a ListPublicIpAddresses call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *AddressService) ListPublicIpAddressesAll(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
+	return s.ListPublicIpAddressesAllWithContext(context.Background(), p)
+}
+
+// ListPublicIpAddressesAllWithContext is the same as ListPublicIpAddressesAll, but uses ctx to cancel the requests
+func (s *AddressService) ListPublicIpAddressesAllWithContext(ctx context.Context, p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*PublicIpAddress)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListPublicIpAddressesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListPublicIpAddressesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.PublicIpAddresses
+		mu.Unlock()
+
+		return len(l.PublicIpAddresses), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListPublicIpAddressesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.PublicIpAddresses = append(r.PublicIpAddresses, pages[page]...)
+	}
+	r.Count = len(r.PublicIpAddresses)
+
+	return r, nil
+}
+
 type ListPublicIpAddressesResponse struct {
 	Count             int                `json:"count"`
 	PublicIpAddresses []*PublicIpAddress `json:"publicipaddress"`
@@ -658,7 +1330,8 @@ type UpdateIpAddressParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateIpAddressParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateIpAddressParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -676,6 +1349,53 @@ func (p *UpdateIpAddressParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateIpAddressParams) Validate() error {
+	v := newParamValidator("updateIpAddress", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("customid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateIpAddressParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateIpAddressParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateIpAddress", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateIpAddress: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateIpAddressParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -683,6 +1403,15 @@ func (p *UpdateIpAddressParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateIpAddressParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateIpAddressParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateIpAddressParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -690,6 +1419,15 @@ func (p *UpdateIpAddressParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateIpAddressParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateIpAddressParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateIpAddressParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -697,6 +1435,15 @@ func (p *UpdateIpAddressParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateIpAddressParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateIpAddressParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateIpAddressParams instance,
 // as then you are sure you have configured all required params
 func (s *AddressService) NewUpdateIpAddressParams(id string) *UpdateIpAddressParams {
@@ -708,7 +1455,16 @@ func (s *AddressService) NewUpdateIpAddressParams(id string) *UpdateIpAddressPar
 
 // Updates an IP address
 func (s *AddressService) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("updateIpAddress", p.toURLValues())
+	return s.UpdateIpAddressWithContext(context.Background(), p)
+}
+
+// UpdateIpAddressWithContext is the same as UpdateIpAddress, but uses ctx to cancel the request and any async job polling
+func (s *AddressService) UpdateIpAddressWithContext(ctx context.Context, p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateIpAddress", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -720,7 +1476,7 @@ func (s *AddressService) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAdd
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -741,6 +1497,40 @@ func (s *AddressService) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAdd
 	return &r, nil
 }
 
+// UpdateIpAddressAsync starts the async job for UpdateIpAddress and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateIpAddressResponse using Job.Result
+func (s *AddressService) UpdateIpAddressAsync(p *UpdateIpAddressParams) (*Job, error) {
+	return s.UpdateIpAddressAsyncWithContext(context.Background(), p)
+}
+
+// UpdateIpAddressAsyncWithContext is the same as UpdateIpAddressAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AddressService) UpdateIpAddressAsyncWithContext(ctx context.Context, p *UpdateIpAddressParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateIpAddress", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateIpAddressResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateIpAddressResponse struct {
 	Account                   string `json:"account"`
 	Allocated                 string `json:"allocated"`
@@ -20,18 +20,59 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// AffinityGroupServiceIface is the interface implemented by AffinityGroupService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type AffinityGroupServiceIface interface {
+	NewCreateAffinityGroupParams(name string, affinityGroupType string) *CreateAffinityGroupParams
+	CreateAffinityGroup(p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error)
+	CreateAffinityGroupWithContext(ctx context.Context, p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error)
+	CreateAffinityGroupAsync(p *CreateAffinityGroupParams) (*Job, error)
+	CreateAffinityGroupAsyncWithContext(ctx context.Context, p *CreateAffinityGroupParams) (*Job, error)
+	NewDeleteAffinityGroupParams() *DeleteAffinityGroupParams
+	DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error)
+	DeleteAffinityGroupWithContext(ctx context.Context, p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error)
+	DeleteAffinityGroupAsync(p *DeleteAffinityGroupParams) (*Job, error)
+	DeleteAffinityGroupAsyncWithContext(ctx context.Context, p *DeleteAffinityGroupParams) (*Job, error)
+	NewListAffinityGroupTypesParams() *ListAffinityGroupTypesParams
+	ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error)
+	ListAffinityGroupTypesWithContext(ctx context.Context, p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error)
+	NewListAffinityGroupTypesPager(p *ListAffinityGroupTypesParams) *ListAffinityGroupTypesPager
+	ListAffinityGroupTypesAll(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error)
+	ListAffinityGroupTypesAllWithContext(ctx context.Context, p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error)
+	NewListAffinityGroupsParams() *ListAffinityGroupsParams
+	GetAffinityGroupID(name string, opts ...OptionFunc) (string, int, error)
+	GetAffinityGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetAffinityGroupByName(name string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	GetAffinityGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	GetAffinityGroupByID(id string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	GetAffinityGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error)
+	ListAffinityGroupsWithContext(ctx context.Context, p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error)
+	NewListAffinityGroupsPager(p *ListAffinityGroupsParams) *ListAffinityGroupsPager
+	ListAffinityGroupsAll(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error)
+	ListAffinityGroupsAllWithContext(ctx context.Context, p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error)
+	NewUpdateVMAffinityGroupParams(id string) *UpdateVMAffinityGroupParams
+	UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error)
+	UpdateVMAffinityGroupWithContext(ctx context.Context, p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error)
+	UpdateVMAffinityGroupAsync(p *UpdateVMAffinityGroupParams) (*Job, error)
+	UpdateVMAffinityGroupAsyncWithContext(ctx context.Context, p *UpdateVMAffinityGroupParams) (*Job, error)
+}
+
 type CreateAffinityGroupParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateAffinityGroupParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateAffinityGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -57,6 +98,52 @@ func (p *CreateAffinityGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateAffinityGroupParams) Validate() error {
+	v := newParamValidator("createAffinityGroup", p.p)
+	v.required("name", "type")
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("description", 255)
+	v.maxLength("name", 255)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateAffinityGroupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateAffinityGroupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "description", "domainid", "name", "projectid", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createAffinityGroup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createAffinityGroup: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateAffinityGroupParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -64,6 +151,15 @@ func (p *CreateAffinityGroupParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateAffinityGroupParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateAffinityGroupParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateAffinityGroupParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +167,15 @@ func (p *CreateAffinityGroupParams) SetDescription(v string) {
 	p.p["description"] = v
 }
 
+func (p *CreateAffinityGroupParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *CreateAffinityGroupParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
 func (p *CreateAffinityGroupParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -78,6 +183,15 @@ func (p *CreateAffinityGroupParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateAffinityGroupParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateAffinityGroupParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateAffinityGroupParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -85,6 +199,15 @@ func (p *CreateAffinityGroupParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateAffinityGroupParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateAffinityGroupParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateAffinityGroupParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -92,6 +215,15 @@ func (p *CreateAffinityGroupParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *CreateAffinityGroupParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *CreateAffinityGroupParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *CreateAffinityGroupParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -99,6 +231,15 @@ func (p *CreateAffinityGroupParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *CreateAffinityGroupParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *CreateAffinityGroupParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateAffinityGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AffinityGroupService) NewCreateAffinityGroupParams(name string, affinityGroupType string) *CreateAffinityGroupParams {
@@ -111,7 +252,16 @@ func (s *AffinityGroupService) NewCreateAffinityGroupParams(name string, affinit
 
 // Creates an affinity/anti-affinity group
 func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("createAffinityGroup", p.toURLValues())
+	return s.CreateAffinityGroupWithContext(context.Background(), p)
+}
+
+// CreateAffinityGroupWithContext is the same as CreateAffinityGroup, but uses ctx to cancel the request and any async job polling
+func (s *AffinityGroupService) CreateAffinityGroupWithContext(ctx context.Context, p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createAffinityGroup", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +273,7 @@ func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -144,6 +294,40 @@ func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams)
 	return &r, nil
 }
 
+// CreateAffinityGroupAsync starts the async job for CreateAffinityGroup and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateAffinityGroupResponse using Job.Result
+func (s *AffinityGroupService) CreateAffinityGroupAsync(p *CreateAffinityGroupParams) (*Job, error) {
+	return s.CreateAffinityGroupAsyncWithContext(context.Background(), p)
+}
+
+// CreateAffinityGroupAsyncWithContext is the same as CreateAffinityGroupAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AffinityGroupService) CreateAffinityGroupAsyncWithContext(ctx context.Context, p *CreateAffinityGroupParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createAffinityGroup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateAffinityGroupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateAffinityGroupResponse struct {
 	Account           string   `json:"account"`
 	Description       string   `json:"description"`
@@ -163,7 +347,8 @@ type DeleteAffinityGroupParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteAffinityGroupParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteAffinityGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -186,6 +371,49 @@ func (p *DeleteAffinityGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteAffinityGroupParams) Validate() error {
+	v := newParamValidator("deleteAffinityGroup", p.p)
+	v.ids("domainid", "id", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteAffinityGroupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteAffinityGroupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "name", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteAffinityGroup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteAffinityGroup: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteAffinityGroupParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,6 +421,15 @@ func (p *DeleteAffinityGroupParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *DeleteAffinityGroupParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *DeleteAffinityGroupParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *DeleteAffinityGroupParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -200,6 +437,15 @@ func (p *DeleteAffinityGroupParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *DeleteAffinityGroupParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *DeleteAffinityGroupParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *DeleteAffinityGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -207,6 +453,15 @@ func (p *DeleteAffinityGroupParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteAffinityGroupParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteAffinityGroupParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *DeleteAffinityGroupParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -214,6 +469,15 @@ func (p *DeleteAffinityGroupParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *DeleteAffinityGroupParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *DeleteAffinityGroupParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *DeleteAffinityGroupParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -221,6 +485,15 @@ func (p *DeleteAffinityGroupParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *DeleteAffinityGroupParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *DeleteAffinityGroupParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteAffinityGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AffinityGroupService) NewDeleteAffinityGroupParams() *DeleteAffinityGroupParams {
@@ -231,7 +504,16 @@ func (s *AffinityGroupService) NewDeleteAffinityGroupParams() *DeleteAffinityGro
 
 // Deletes affinity group
 func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteAffinityGroup", p.toURLValues())
+	return s.DeleteAffinityGroupWithContext(context.Background(), p)
+}
+
+// DeleteAffinityGroupWithContext is the same as DeleteAffinityGroup, but uses ctx to cancel the request and any async job polling
+func (s *AffinityGroupService) DeleteAffinityGroupWithContext(ctx context.Context, p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAffinityGroup", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -243,7 +525,7 @@ func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -259,6 +541,31 @@ func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams)
 	return &r, nil
 }
 
+// DeleteAffinityGroupAsync starts the async job for DeleteAffinityGroup and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteAffinityGroupResponse using Job.Result
+func (s *AffinityGroupService) DeleteAffinityGroupAsync(p *DeleteAffinityGroupParams) (*Job, error) {
+	return s.DeleteAffinityGroupAsyncWithContext(context.Background(), p)
+}
+
+// DeleteAffinityGroupAsyncWithContext is the same as DeleteAffinityGroupAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AffinityGroupService) DeleteAffinityGroupAsyncWithContext(ctx context.Context, p *DeleteAffinityGroupParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAffinityGroup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteAffinityGroupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteAffinityGroupResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -270,7 +577,8 @@ type ListAffinityGroupTypesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListAffinityGroupTypesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListAffinityGroupTypesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -289,6 +597,51 @@ func (p *ListAffinityGroupTypesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListAffinityGroupTypesParams) Validate() error {
+	v := newParamValidator("listAffinityGroupTypes", p.p)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListAffinityGroupTypesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListAffinityGroupTypesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listAffinityGroupTypes", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listAffinityGroupTypes: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListAffinityGroupTypesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -296,6 +649,15 @@ func (p *ListAffinityGroupTypesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListAffinityGroupTypesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListAffinityGroupTypesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListAffinityGroupTypesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -303,6 +665,15 @@ func (p *ListAffinityGroupTypesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListAffinityGroupTypesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListAffinityGroupTypesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListAffinityGroupTypesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -310,6 +681,15 @@ func (p *ListAffinityGroupTypesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListAffinityGroupTypesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListAffinityGroupTypesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListAffinityGroupTypesParams instance,
 // as then you are sure you have configured all required params
 func (s *AffinityGroupService) NewListAffinityGroupTypesParams() *ListAffinityGroupTypesParams {
@@ -320,7 +700,16 @@ func (s *AffinityGroupService) NewListAffinityGroupTypesParams() *ListAffinityGr
 
 // Lists affinity group types available
 func (s *AffinityGroupService) ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
-	resp, err := s.cs.newRequest("listAffinityGroupTypes", p.toURLValues())
+	return s.ListAffinityGroupTypesWithContext(context.Background(), p)
+}
+
+// ListAffinityGroupTypesWithContext is the same as ListAffinityGroupTypes, but uses ctx to cancel the request and any async job polling
+func (s *AffinityGroupService) ListAffinityGroupTypesWithContext(ctx context.Context, p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listAffinityGroupTypes", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -333,6 +722,92 @@ func (s *AffinityGroupService) ListAffinityGroupTypes(p *ListAffinityGroupTypesP
 	return &r, nil
 }
 
+// ListAffinityGroupTypesPager iterates over all pages of a ListAffinityGroupTypes call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListAffinityGroupTypesPager struct {
+	pager
+
+	s *AffinityGroupService
+	p *ListAffinityGroupTypesParams
+	r *ListAffinityGroupTypesResponse
+}
+
+// NewListAffinityGroupTypesPager returns a pager for all pages of a ListAffinityGroupTypes call with the given params
+func (s *AffinityGroupService) NewListAffinityGroupTypesPager(p *ListAffinityGroupTypesParams) *ListAffinityGroupTypesPager {
+	return &ListAffinityGroupTypesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListAffinityGroupTypesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListAffinityGroupTypesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListAffinityGroupTypesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListAffinityGroupTypesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.AffinityGroupTypes), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListAffinityGroupTypesPager) Page() *ListAffinityGroupTypesResponse {
+	return pg.r
+}
+
+// ListAffinityGroupTypesAll fetches all pages of a ListAffinityGroupTypes call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *AffinityGroupService) ListAffinityGroupTypesAll(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
+	return s.ListAffinityGroupTypesAllWithContext(context.Background(), p)
+}
+
+// ListAffinityGroupTypesAllWithContext is the same as ListAffinityGroupTypesAll, but uses ctx to cancel the requests
+func (s *AffinityGroupService) ListAffinityGroupTypesAllWithContext(ctx context.Context, p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*AffinityGroupType)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListAffinityGroupTypesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListAffinityGroupTypesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.AffinityGroupTypes
+		mu.Unlock()
+
+		return len(l.AffinityGroupTypes), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListAffinityGroupTypesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.AffinityGroupTypes = append(r.AffinityGroupTypes, pages[page]...)
+	}
+	r.Count = len(r.AffinityGroupTypes)
+
+	return r, nil
+}
+
 type ListAffinityGroupTypesResponse struct {
 	Count              int                  `json:"count"`
 	AffinityGroupTypes []*AffinityGroupType `json:"affinitygrouptype"`
@@ -348,7 +823,8 @@ type ListAffinityGroupsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListAffinityGroupsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListAffinityGroupsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -396,6 +872,59 @@ func (p *ListAffinityGroupsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListAffinityGroupsParams) Validate() error {
+	v := newParamValidator("listAffinityGroups", p.p)
+	v.ids("domainid", "id", "projectid", "virtualmachineid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListAffinityGroupsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListAffinityGroupsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "name", "projectid", "type", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listAffinityGroups", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listAffinityGroups: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListAffinityGroupsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -403,6 +932,15 @@ func (p *ListAffinityGroupsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListAffinityGroupsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListAffinityGroupsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListAffinityGroupsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -410,6 +948,15 @@ func (p *ListAffinityGroupsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListAffinityGroupsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListAffinityGroupsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListAffinityGroupsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -417,6 +964,15 @@ func (p *ListAffinityGroupsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListAffinityGroupsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListAffinityGroupsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListAffinityGroupsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -424,6 +980,15 @@ func (p *ListAffinityGroupsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListAffinityGroupsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListAffinityGroupsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListAffinityGroupsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -431,6 +996,15 @@ func (p *ListAffinityGroupsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListAffinityGroupsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListAffinityGroupsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListAffinityGroupsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -438,6 +1012,15 @@ func (p *ListAffinityGroupsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListAffinityGroupsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListAffinityGroupsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListAffinityGroupsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -445,6 +1028,15 @@ func (p *ListAffinityGroupsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListAffinityGroupsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListAffinityGroupsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListAffinityGroupsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -452,6 +1044,15 @@ func (p *ListAffinityGroupsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListAffinityGroupsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListAffinityGroupsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListAffinityGroupsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -459,6 +1060,15 @@ func (p *ListAffinityGroupsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListAffinityGroupsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListAffinityGroupsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListAffinityGroupsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -466,6 +1076,15 @@ func (p *ListAffinityGroupsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListAffinityGroupsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListAffinityGroupsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListAffinityGroupsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -473,6 +1092,15 @@ func (p *ListAffinityGroupsParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *ListAffinityGroupsParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ListAffinityGroupsParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 func (p *ListAffinityGroupsParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -480,6 +1108,15 @@ func (p *ListAffinityGroupsParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *ListAffinityGroupsParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *ListAffinityGroupsParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListAffinityGroupsParams instance,
 // as then you are sure you have configured all required params
 func (s *AffinityGroupService) NewListAffinityGroupsParams() *ListAffinityGroupsParams {
@@ -490,6 +1127,11 @@ func (s *AffinityGroupService) NewListAffinityGroupsParams() *ListAffinityGroups
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetAffinityGroupIDWithContext(context.Background(), name, opts...)
+}
+
+// GetAffinityGroupIDWithContext is the same as GetAffinityGroupID, but uses ctx to cancel the request
+func (s *AffinityGroupService) GetAffinityGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAffinityGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -501,7 +1143,7 @@ func (s *AffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListAffinityGroups(p)
+	l, err := s.ListAffinityGroupsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -530,12 +1172,17 @@ func (s *AffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFun
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AffinityGroupService) GetAffinityGroupByName(name string, opts ...OptionFunc) (*AffinityGroup, int, error) {
-	id, count, err := s.GetAffinityGroupID(name, opts...)
+	return s.GetAffinityGroupByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetAffinityGroupByNameWithContext is the same as GetAffinityGroupByName, but uses ctx to cancel the requests
+func (s *AffinityGroupService) GetAffinityGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*AffinityGroup, int, error) {
+	id, count, err := s.GetAffinityGroupIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetAffinityGroupByID(id, opts...)
+	r, count, err := s.GetAffinityGroupByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -544,6 +1191,11 @@ func (s *AffinityGroupService) GetAffinityGroupByName(name string, opts ...Optio
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFunc) (*AffinityGroup, int, error) {
+	return s.GetAffinityGroupByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetAffinityGroupByIDWithContext is the same as GetAffinityGroupByID, but uses ctx to cancel the request
+func (s *AffinityGroupService) GetAffinityGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AffinityGroup, int, error) {
 	p := &ListAffinityGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -555,12 +1207,10 @@ func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListAffinityGroups(p)
+	l, err := s.ListAffinityGroupsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
@@ -570,7 +1220,7 @@ func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFun
 	l.Count = len(l.AffinityGroups)
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -581,7 +1231,16 @@ func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFun
 
 // Lists affinity groups
 func (s *AffinityGroupService) ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listAffinityGroups", p.toURLValues())
+	return s.ListAffinityGroupsWithContext(context.Background(), p)
+}
+
+// ListAffinityGroupsWithContext is the same as ListAffinityGroups, but uses ctx to cancel the request and any async job polling
+func (s *AffinityGroupService) ListAffinityGroupsWithContext(ctx context.Context, p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listAffinityGroups", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -594,6 +1253,92 @@ func (s *AffinityGroupService) ListAffinityGroups(p *ListAffinityGroupsParams) (
 	return &r, nil
 }
 
+// ListAffinityGroupsPager iterates over all pages of a ListAffinityGroups call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListAffinityGroupsPager struct {
+	pager
+
+	s *AffinityGroupService
+	p *ListAffinityGroupsParams
+	r *ListAffinityGroupsResponse
+}
+
+// NewListAffinityGroupsPager returns a pager for all pages of a ListAffinityGroups call with the given params
+func (s *AffinityGroupService) NewListAffinityGroupsPager(p *ListAffinityGroupsParams) *ListAffinityGroupsPager {
+	return &ListAffinityGroupsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListAffinityGroupsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListAffinityGroupsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListAffinityGroupsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListAffinityGroupsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.AffinityGroups), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListAffinityGroupsPager) Page() *ListAffinityGroupsResponse {
+	return pg.r
+}
+
+// ListAffinityGroupsAll fetches all pages of a ListAffinityGroups call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *AffinityGroupService) ListAffinityGroupsAll(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
+	return s.ListAffinityGroupsAllWithContext(context.Background(), p)
+}
+
+// ListAffinityGroupsAllWithContext is the same as ListAffinityGroupsAll, but uses ctx to cancel the requests
+func (s *AffinityGroupService) ListAffinityGroupsAllWithContext(ctx context.Context, p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*AffinityGroup)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListAffinityGroupsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListAffinityGroupsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.AffinityGroups
+		mu.Unlock()
+
+		return len(l.AffinityGroups), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListAffinityGroupsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.AffinityGroups = append(r.AffinityGroups, pages[page]...)
+	}
+	r.Count = len(r.AffinityGroups)
+
+	return r, nil
+}
+
 type ListAffinityGroupsResponse struct {
 	Count          int              `json:"count"`
 	AffinityGroups []*AffinityGroup `json:"affinitygroup"`
@@ -618,7 +1363,8 @@ type UpdateVMAffinityGroupParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateVMAffinityGroupParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateVMAffinityGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -637,6 +1383,52 @@ func (p *UpdateVMAffinityGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateVMAffinityGroupParams) Validate() error {
+	v := newParamValidator("updateVMAffinityGroup", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateVMAffinityGroupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateVMAffinityGroupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "affinitygroupids", "affinitygroupnames":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateVMAffinityGroup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateVMAffinityGroup: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVMAffinityGroupParams) SetAffinitygroupids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -644,6 +1436,15 @@ func (p *UpdateVMAffinityGroupParams) SetAffinitygroupids(v []string) {
 	p.p["affinitygroupids"] = v
 }
 
+func (p *UpdateVMAffinityGroupParams) ResetAffinitygroupids() {
+	delete(p.p, "affinitygroupids")
+}
+
+func (p *UpdateVMAffinityGroupParams) GetAffinitygroupids() ([]string, bool) {
+	value, ok := p.p["affinitygroupids"].([]string)
+	return value, ok
+}
+
 func (p *UpdateVMAffinityGroupParams) SetAffinitygroupnames(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -651,6 +1452,15 @@ func (p *UpdateVMAffinityGroupParams) SetAffinitygroupnames(v []string) {
 	p.p["affinitygroupnames"] = v
 }
 
+func (p *UpdateVMAffinityGroupParams) ResetAffinitygroupnames() {
+	delete(p.p, "affinitygroupnames")
+}
+
+func (p *UpdateVMAffinityGroupParams) GetAffinitygroupnames() ([]string, bool) {
+	value, ok := p.p["affinitygroupnames"].([]string)
+	return value, ok
+}
+
 func (p *UpdateVMAffinityGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -658,6 +1468,15 @@ func (p *UpdateVMAffinityGroupParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateVMAffinityGroupParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateVMAffinityGroupParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateVMAffinityGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AffinityGroupService) NewUpdateVMAffinityGroupParams(id string) *UpdateVMAffinityGroupParams {
@@ -669,7 +1488,16 @@ func (s *AffinityGroupService) NewUpdateVMAffinityGroupParams(id string) *Update
 
 // Updates the affinity/anti-affinity group associations of a virtual machine. The VM has to be stopped and restarted for the new properties to take effect.
 func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("updateVMAffinityGroup", p.toURLValues())
+	return s.UpdateVMAffinityGroupWithContext(context.Background(), p)
+}
+
+// UpdateVMAffinityGroupWithContext is the same as UpdateVMAffinityGroup, but uses ctx to cancel the request and any async job polling
+func (s *AffinityGroupService) UpdateVMAffinityGroupWithContext(ctx context.Context, p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVMAffinityGroup", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -681,7 +1509,7 @@ func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupPar
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -702,6 +1530,40 @@ func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupPar
 	return &r, nil
 }
 
+// UpdateVMAffinityGroupAsync starts the async job for UpdateVMAffinityGroup and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateVMAffinityGroupResponse using Job.Result
+func (s *AffinityGroupService) UpdateVMAffinityGroupAsync(p *UpdateVMAffinityGroupParams) (*Job, error) {
+	return s.UpdateVMAffinityGroupAsyncWithContext(context.Background(), p)
+}
+
+// UpdateVMAffinityGroupAsyncWithContext is the same as UpdateVMAffinityGroupAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AffinityGroupService) UpdateVMAffinityGroupAsyncWithContext(ctx context.Context, p *UpdateVMAffinityGroupParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVMAffinityGroup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVMAffinityGroupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateVMAffinityGroupResponse struct {
 	Account               string                                       `json:"account"`
 	Affinitygroup         []UpdateVMAffinityGroupResponseAffinitygroup `json:"affinitygroup"`
@@ -20,18 +20,49 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// AlertServiceIface is the interface implemented by AlertService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type AlertServiceIface interface {
+	NewArchiveAlertsParams() *ArchiveAlertsParams
+	ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error)
+	ArchiveAlertsWithContext(ctx context.Context, p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error)
+	NewDeleteAlertsParams() *DeleteAlertsParams
+	DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error)
+	DeleteAlertsWithContext(ctx context.Context, p *DeleteAlertsParams) (*DeleteAlertsResponse, error)
+	NewGenerateAlertParams(description string, name string, alertType int) *GenerateAlertParams
+	GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error)
+	GenerateAlertWithContext(ctx context.Context, p *GenerateAlertParams) (*GenerateAlertResponse, error)
+	GenerateAlertAsync(p *GenerateAlertParams) (*Job, error)
+	GenerateAlertAsyncWithContext(ctx context.Context, p *GenerateAlertParams) (*Job, error)
+	NewListAlertsParams() *ListAlertsParams
+	GetAlertID(name string, opts ...OptionFunc) (string, int, error)
+	GetAlertIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetAlertByName(name string, opts ...OptionFunc) (*Alert, int, error)
+	GetAlertByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Alert, int, error)
+	GetAlertByID(id string, opts ...OptionFunc) (*Alert, int, error)
+	GetAlertByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Alert, int, error)
+	ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error)
+	ListAlertsWithContext(ctx context.Context, p *ListAlertsParams) (*ListAlertsResponse, error)
+	NewListAlertsPager(p *ListAlertsParams) *ListAlertsPager
+	ListAlertsAll(p *ListAlertsParams) (*ListAlertsResponse, error)
+	ListAlertsAllWithContext(ctx context.Context, p *ListAlertsParams) (*ListAlertsResponse, error)
+}
+
 type ArchiveAlertsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ArchiveAlertsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ArchiveAlertsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -52,6 +83,51 @@ func (p *ArchiveAlertsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ArchiveAlertsParams) Validate() error {
+	v := newParamValidator("archiveAlerts", p.p)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ArchiveAlertsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ArchiveAlertsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "enddate", "startdate", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for archiveAlerts", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of archiveAlerts: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ArchiveAlertsParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -59,6 +135,15 @@ func (p *ArchiveAlertsParams) SetEnddate(v string) {
 	p.p["enddate"] = v
 }
 
+func (p *ArchiveAlertsParams) ResetEnddate() {
+	delete(p.p, "enddate")
+}
+
+func (p *ArchiveAlertsParams) GetEnddate() (string, bool) {
+	value, ok := p.p["enddate"].(string)
+	return value, ok
+}
+
 func (p *ArchiveAlertsParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -66,6 +151,15 @@ func (p *ArchiveAlertsParams) SetIds(v []string) {
 	p.p["ids"] = v
 }
 
+func (p *ArchiveAlertsParams) ResetIds() {
+	delete(p.p, "ids")
+}
+
+func (p *ArchiveAlertsParams) GetIds() ([]string, bool) {
+	value, ok := p.p["ids"].([]string)
+	return value, ok
+}
+
 func (p *ArchiveAlertsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -73,6 +167,15 @@ func (p *ArchiveAlertsParams) SetStartdate(v string) {
 	p.p["startdate"] = v
 }
 
+func (p *ArchiveAlertsParams) ResetStartdate() {
+	delete(p.p, "startdate")
+}
+
+func (p *ArchiveAlertsParams) GetStartdate() (string, bool) {
+	value, ok := p.p["startdate"].(string)
+	return value, ok
+}
+
 func (p *ArchiveAlertsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -80,6 +183,15 @@ func (p *ArchiveAlertsParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *ArchiveAlertsParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ArchiveAlertsParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ArchiveAlertsParams instance,
 // as then you are sure you have configured all required params
 func (s *AlertService) NewArchiveAlertsParams() *ArchiveAlertsParams {
@@ -90,7 +202,16 @@ func (s *AlertService) NewArchiveAlertsParams() *ArchiveAlertsParams {
 
 // Archive one or more alerts.
 func (s *AlertService) ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error) {
-	resp, err := s.cs.newRequest("archiveAlerts", p.toURLValues())
+	return s.ArchiveAlertsWithContext(context.Background(), p)
+}
+
+// ArchiveAlertsWithContext is the same as ArchiveAlerts, but uses ctx to cancel the request and any async job polling
+func (s *AlertService) ArchiveAlertsWithContext(ctx context.Context, p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "archiveAlerts", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +262,8 @@ type DeleteAlertsParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteAlertsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteAlertsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -162,6 +284,51 @@ func (p *DeleteAlertsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteAlertsParams) Validate() error {
+	v := newParamValidator("deleteAlerts", p.p)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteAlertsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteAlertsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "enddate", "startdate", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteAlerts", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteAlerts: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteAlertsParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -169,6 +336,15 @@ func (p *DeleteAlertsParams) SetEnddate(v string) {
 	p.p["enddate"] = v
 }
 
+func (p *DeleteAlertsParams) ResetEnddate() {
+	delete(p.p, "enddate")
+}
+
+func (p *DeleteAlertsParams) GetEnddate() (string, bool) {
+	value, ok := p.p["enddate"].(string)
+	return value, ok
+}
+
 func (p *DeleteAlertsParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -176,6 +352,15 @@ func (p *DeleteAlertsParams) SetIds(v []string) {
 	p.p["ids"] = v
 }
 
+func (p *DeleteAlertsParams) ResetIds() {
+	delete(p.p, "ids")
+}
+
+func (p *DeleteAlertsParams) GetIds() ([]string, bool) {
+	value, ok := p.p["ids"].([]string)
+	return value, ok
+}
+
 func (p *DeleteAlertsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -183,6 +368,15 @@ func (p *DeleteAlertsParams) SetStartdate(v string) {
 	p.p["startdate"] = v
 }
 
+func (p *DeleteAlertsParams) ResetStartdate() {
+	delete(p.p, "startdate")
+}
+
+func (p *DeleteAlertsParams) GetStartdate() (string, bool) {
+	value, ok := p.p["startdate"].(string)
+	return value, ok
+}
+
 func (p *DeleteAlertsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -190,6 +384,15 @@ func (p *DeleteAlertsParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *DeleteAlertsParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *DeleteAlertsParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteAlertsParams instance,
 // as then you are sure you have configured all required params
 func (s *AlertService) NewDeleteAlertsParams() *DeleteAlertsParams {
@@ -200,7 +403,16 @@ func (s *AlertService) NewDeleteAlertsParams() *DeleteAlertsParams {
 
 // Delete one or more alerts.
 func (s *AlertService) DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
-	resp, err := s.cs.newRequest("deleteAlerts", p.toURLValues())
+	return s.DeleteAlertsWithContext(context.Background(), p)
+}
+
+// DeleteAlertsWithContext is the same as DeleteAlerts, but uses ctx to cancel the request and any async job polling
+func (s *AlertService) DeleteAlertsWithContext(ctx context.Context, p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAlerts", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -251,7 +463,8 @@ type GenerateAlertParams struct {
 	p map[string]interface{}
 }
 
-func (p *GenerateAlertParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GenerateAlertParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -275,6 +488,54 @@ func (p *GenerateAlertParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GenerateAlertParams) Validate() error {
+	v := newParamValidator("generateAlert", p.p)
+	v.required("description", "name", "type")
+	v.ids("podid", "zoneid")
+	v.maxLength("description", 999)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GenerateAlertParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GenerateAlertParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "name", "podid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "type":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for generateAlert", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of generateAlert: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *GenerateAlertParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -282,6 +543,15 @@ func (p *GenerateAlertParams) SetDescription(v string) {
 	p.p["description"] = v
 }
 
+func (p *GenerateAlertParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *GenerateAlertParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
 func (p *GenerateAlertParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -289,6 +559,15 @@ func (p *GenerateAlertParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *GenerateAlertParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *GenerateAlertParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *GenerateAlertParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -296,6 +575,15 @@ func (p *GenerateAlertParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *GenerateAlertParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *GenerateAlertParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *GenerateAlertParams) SetType(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -303,6 +591,15 @@ func (p *GenerateAlertParams) SetType(v int) {
 	p.p["type"] = v
 }
 
+func (p *GenerateAlertParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *GenerateAlertParams) GetType() (int, bool) {
+	value, ok := p.p["type"].(int)
+	return value, ok
+}
+
 func (p *GenerateAlertParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -310,6 +607,15 @@ func (p *GenerateAlertParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *GenerateAlertParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *GenerateAlertParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new GenerateAlertParams instance,
 // as then you are sure you have configured all required params
 func (s *AlertService) NewGenerateAlertParams(description string, name string, alertType int) *GenerateAlertParams {
@@ -323,7 +629,16 @@ func (s *AlertService) NewGenerateAlertParams(description string, name string, a
 
 // Generates an alert
 func (s *AlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error) {
-	resp, err := s.cs.newRequest("generateAlert", p.toURLValues())
+	return s.GenerateAlertWithContext(context.Background(), p)
+}
+
+// GenerateAlertWithContext is the same as GenerateAlert, but uses ctx to cancel the request and any async job polling
+func (s *AlertService) GenerateAlertWithContext(ctx context.Context, p *GenerateAlertParams) (*GenerateAlertResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "generateAlert", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -335,7 +650,7 @@ func (s *AlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResp
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -351,6 +666,31 @@ func (s *AlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResp
 	return &r, nil
 }
 
+// GenerateAlertAsync starts the async job for GenerateAlert and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a GenerateAlertResponse using Job.Result
+func (s *AlertService) GenerateAlertAsync(p *GenerateAlertParams) (*Job, error) {
+	return s.GenerateAlertAsyncWithContext(context.Background(), p)
+}
+
+// GenerateAlertAsyncWithContext is the same as GenerateAlertAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AlertService) GenerateAlertAsyncWithContext(ctx context.Context, p *GenerateAlertParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "generateAlert", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r GenerateAlertResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type GenerateAlertResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -362,7 +702,8 @@ type ListAlertsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListAlertsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListAlertsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -390,6 +731,54 @@ func (p *ListAlertsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListAlertsParams) Validate() error {
+	v := newParamValidator("listAlerts", p.p)
+	v.ids("id")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListAlertsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListAlertsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword", "name", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listAlerts", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listAlerts: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListAlertsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -397,6 +786,15 @@ func (p *ListAlertsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListAlertsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListAlertsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListAlertsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -404,6 +802,15 @@ func (p *ListAlertsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListAlertsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListAlertsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListAlertsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -411,6 +818,15 @@ func (p *ListAlertsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListAlertsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListAlertsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListAlertsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -418,6 +834,15 @@ func (p *ListAlertsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListAlertsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListAlertsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListAlertsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -425,6 +850,15 @@ func (p *ListAlertsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListAlertsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListAlertsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListAlertsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -432,6 +866,15 @@ func (p *ListAlertsParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *ListAlertsParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ListAlertsParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListAlertsParams instance,
 // as then you are sure you have configured all required params
 func (s *AlertService) NewListAlertsParams() *ListAlertsParams {
@@ -442,6 +885,11 @@ func (s *AlertService) NewListAlertsParams() *ListAlertsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AlertService) GetAlertID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetAlertIDWithContext(context.Background(), name, opts...)
+}
+
+// GetAlertIDWithContext is the same as GetAlertID, but uses ctx to cancel the request
+func (s *AlertService) GetAlertIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAlertsParams{}
 	p.p = make(map[string]interface{})
 
@@ -453,7 +901,7 @@ func (s *AlertService) GetAlertID(name string, opts ...OptionFunc) (string, int,
 		}
 	}
 
-	l, err := s.ListAlerts(p)
+	l, err := s.ListAlertsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -478,12 +926,17 @@ func (s *AlertService) GetAlertID(name string, opts ...OptionFunc) (string, int,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AlertService) GetAlertByName(name string, opts ...OptionFunc) (*Alert, int, error) {
-	id, count, err := s.GetAlertID(name, opts...)
+	return s.GetAlertByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetAlertByNameWithContext is the same as GetAlertByName, but uses ctx to cancel the requests
+func (s *AlertService) GetAlertByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Alert, int, error) {
+	id, count, err := s.GetAlertIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetAlertByID(id, opts...)
+	r, count, err := s.GetAlertByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -492,6 +945,11 @@ func (s *AlertService) GetAlertByName(name string, opts ...OptionFunc) (*Alert,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AlertService) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int, error) {
+	return s.GetAlertByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetAlertByIDWithContext is the same as GetAlertByID, but uses ctx to cancel the request
+func (s *AlertService) GetAlertByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Alert, int, error) {
 	p := &ListAlertsParams{}
 	p.p = make(map[string]interface{})
 
@@ -503,18 +961,16 @@ func (s *AlertService) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int,
 		}
 	}
 
-	l, err := s.ListAlerts(p)
+	l, err := s.ListAlertsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -525,7 +981,16 @@ func (s *AlertService) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int,
 
 // Lists all alerts.
 func (s *AlertService) ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error) {
-	resp, err := s.cs.newRequest("listAlerts", p.toURLValues())
+	return s.ListAlertsWithContext(context.Background(), p)
+}
+
+// ListAlertsWithContext is the same as ListAlerts, but uses ctx to cancel the request and any async job polling
+func (s *AlertService) ListAlertsWithContext(ctx context.Context, p *ListAlertsParams) (*ListAlertsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listAlerts", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -538,6 +1003,92 @@ func (s *AlertService) ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, err
 	return &r, nil
 }
 
+// ListAlertsPager iterates over all pages of a ListAlerts call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListAlertsPager struct {
+	pager
+
+	s *AlertService
+	p *ListAlertsParams
+	r *ListAlertsResponse
+}
+
+// NewListAlertsPager returns a pager for all pages of a ListAlerts call with the given params
+func (s *AlertService) NewListAlertsPager(p *ListAlertsParams) *ListAlertsPager {
+	return &ListAlertsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListAlertsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListAlertsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListAlertsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListAlertsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Alerts), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListAlertsPager) Page() *ListAlertsResponse {
+	return pg.r
+}
+
+// ListAlertsAll fetches all pages of a ListAlerts call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *AlertService) ListAlertsAll(p *ListAlertsParams) (*ListAlertsResponse, error) {
+	return s.ListAlertsAllWithContext(context.Background(), p)
+}
+
+// ListAlertsAllWithContext is the same as ListAlertsAll, but uses ctx to cancel the requests
+func (s *AlertService) ListAlertsAllWithContext(ctx context.Context, p *ListAlertsParams) (*ListAlertsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Alert)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListAlertsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListAlertsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Alerts
+		mu.Unlock()
+
+		return len(l.Alerts), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListAlertsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Alerts = append(r.Alerts, pages[page]...)
+	}
+	r.Count = len(r.Alerts)
+
+	return r, nil
+}
+
 type ListAlertsResponse struct {
 	Count  int      `json:"count"`
 	Alerts []*Alert `json:"alert"`
@@ -0,0 +1,672 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// AnnotationServiceIface is the interface implemented by AnnotationService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type AnnotationServiceIface interface {
+	NewAddAnnotationParams() *AddAnnotationParams
+	AddAnnotation(p *AddAnnotationParams) (*AddAnnotationResponse, error)
+	AddAnnotationWithContext(ctx context.Context, p *AddAnnotationParams) (*AddAnnotationResponse, error)
+	NewListAnnotationsParams() *ListAnnotationsParams
+	GetAnnotationByID(id string, opts ...OptionFunc) (*Annotation, int, error)
+	GetAnnotationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Annotation, int, error)
+	ListAnnotations(p *ListAnnotationsParams) (*ListAnnotationsResponse, error)
+	ListAnnotationsWithContext(ctx context.Context, p *ListAnnotationsParams) (*ListAnnotationsResponse, error)
+	NewListAnnotationsPager(p *ListAnnotationsParams) *ListAnnotationsPager
+	ListAnnotationsAll(p *ListAnnotationsParams) (*ListAnnotationsResponse, error)
+	ListAnnotationsAllWithContext(ctx context.Context, p *ListAnnotationsParams) (*ListAnnotationsResponse, error)
+	NewRemoveAnnotationParams(id string) *RemoveAnnotationParams
+	RemoveAnnotation(p *RemoveAnnotationParams) (*RemoveAnnotationResponse, error)
+	RemoveAnnotationWithContext(ctx context.Context, p *RemoveAnnotationParams) (*RemoveAnnotationResponse, error)
+}
+
+type AddAnnotationParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddAnnotationParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["annotation"]; found {
+		u.Set("annotation", v.(string))
+	}
+	if v, found := p.p["entityid"]; found {
+		u.Set("entityid", v.(string))
+	}
+	if v, found := p.p["entitytype"]; found {
+		u.Set("entitytype", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddAnnotationParams) Validate() error {
+	v := newParamValidator("addAnnotation", p.p)
+	v.maxLength("annotation", 255)
+	v.maxLength("entityid", 255)
+	v.maxLength("entitytype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddAnnotationParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddAnnotationParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "annotation", "entityid", "entitytype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addAnnotation", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addAnnotation: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *AddAnnotationParams) SetAnnotation(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["annotation"] = v
+}
+
+func (p *AddAnnotationParams) ResetAnnotation() {
+	delete(p.p, "annotation")
+}
+
+func (p *AddAnnotationParams) GetAnnotation() (string, bool) {
+	value, ok := p.p["annotation"].(string)
+	return value, ok
+}
+
+func (p *AddAnnotationParams) SetEntityid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["entityid"] = v
+}
+
+func (p *AddAnnotationParams) ResetEntityid() {
+	delete(p.p, "entityid")
+}
+
+func (p *AddAnnotationParams) GetEntityid() (string, bool) {
+	value, ok := p.p["entityid"].(string)
+	return value, ok
+}
+
+func (p *AddAnnotationParams) SetEntitytype(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["entitytype"] = v
+}
+
+func (p *AddAnnotationParams) ResetEntitytype() {
+	delete(p.p, "entitytype")
+}
+
+func (p *AddAnnotationParams) GetEntitytype() (string, bool) {
+	value, ok := p.p["entitytype"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AddAnnotationParams instance,
+// as then you are sure you have configured all required params
+func (s *AnnotationService) NewAddAnnotationParams() *AddAnnotationParams {
+	p := &AddAnnotationParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// add an annotation.
+func (s *AnnotationService) AddAnnotation(p *AddAnnotationParams) (*AddAnnotationResponse, error) {
+	return s.AddAnnotationWithContext(context.Background(), p)
+}
+
+// AddAnnotationWithContext is the same as AddAnnotation, but uses ctx to cancel the request and any async job polling
+func (s *AnnotationService) AddAnnotationWithContext(ctx context.Context, p *AddAnnotationParams) (*AddAnnotationResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addAnnotation", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddAnnotationResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type AddAnnotationResponse struct {
+	Annotation string `json:"annotation"`
+	Created    string `json:"created"`
+	Entityid   string `json:"entityid"`
+	Entitytype string `json:"entitytype"`
+	Id         string `json:"id"`
+	JobID      string `json:"jobid"`
+	Jobstatus  int    `json:"jobstatus"`
+	Removed    string `json:"removed"`
+	Userid     string `json:"userid"`
+}
+
+type ListAnnotationsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListAnnotationsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["entityid"]; found {
+		u.Set("entityid", v.(string))
+	}
+	if v, found := p.p["entitytype"]; found {
+		u.Set("entitytype", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListAnnotationsParams) Validate() error {
+	v := newParamValidator("listAnnotations", p.p)
+	v.maxLength("entityid", 255)
+	v.maxLength("entitytype", 255)
+	v.maxLength("id", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListAnnotationsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListAnnotationsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "entityid", "entitytype", "id", "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listAnnotations", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listAnnotations: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListAnnotationsParams) SetEntityid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["entityid"] = v
+}
+
+func (p *ListAnnotationsParams) ResetEntityid() {
+	delete(p.p, "entityid")
+}
+
+func (p *ListAnnotationsParams) GetEntityid() (string, bool) {
+	value, ok := p.p["entityid"].(string)
+	return value, ok
+}
+
+func (p *ListAnnotationsParams) SetEntitytype(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["entitytype"] = v
+}
+
+func (p *ListAnnotationsParams) ResetEntitytype() {
+	delete(p.p, "entitytype")
+}
+
+func (p *ListAnnotationsParams) GetEntitytype() (string, bool) {
+	value, ok := p.p["entitytype"].(string)
+	return value, ok
+}
+
+func (p *ListAnnotationsParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListAnnotationsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListAnnotationsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListAnnotationsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListAnnotationsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListAnnotationsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListAnnotationsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListAnnotationsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListAnnotationsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListAnnotationsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListAnnotationsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListAnnotationsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+// You should always use this function to get a new ListAnnotationsParams instance,
+// as then you are sure you have configured all required params
+func (s *AnnotationService) NewListAnnotationsParams() *ListAnnotationsParams {
+	p := &ListAnnotationsParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *AnnotationService) GetAnnotationByID(id string, opts ...OptionFunc) (*Annotation, int, error) {
+	return s.GetAnnotationByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetAnnotationByIDWithContext is the same as GetAnnotationByID, but uses ctx to cancel the request
+func (s *AnnotationService) GetAnnotationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Annotation, int, error) {
+	p := &ListAnnotationsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListAnnotationsWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.Annotations[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for Annotation UUID: %s!", id)
+}
+
+// Lists annotations.
+func (s *AnnotationService) ListAnnotations(p *ListAnnotationsParams) (*ListAnnotationsResponse, error) {
+	return s.ListAnnotationsWithContext(context.Background(), p)
+}
+
+// ListAnnotationsWithContext is the same as ListAnnotations, but uses ctx to cancel the request and any async job polling
+func (s *AnnotationService) ListAnnotationsWithContext(ctx context.Context, p *ListAnnotationsParams) (*ListAnnotationsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listAnnotations", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListAnnotationsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListAnnotationsPager iterates over all pages of a ListAnnotations call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListAnnotationsPager struct {
+	pager
+
+	s *AnnotationService
+	p *ListAnnotationsParams
+	r *ListAnnotationsResponse
+}
+
+// NewListAnnotationsPager returns a pager for all pages of a ListAnnotations call with the given params
+func (s *AnnotationService) NewListAnnotationsPager(p *ListAnnotationsParams) *ListAnnotationsPager {
+	return &ListAnnotationsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListAnnotationsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListAnnotationsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListAnnotationsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListAnnotationsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Annotations), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListAnnotationsPager) Page() *ListAnnotationsResponse {
+	return pg.r
+}
+
+// ListAnnotationsAll fetches all pages of a ListAnnotations call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *AnnotationService) ListAnnotationsAll(p *ListAnnotationsParams) (*ListAnnotationsResponse, error) {
+	return s.ListAnnotationsAllWithContext(context.Background(), p)
+}
+
+// ListAnnotationsAllWithContext is the same as ListAnnotationsAll, but uses ctx to cancel the requests
+func (s *AnnotationService) ListAnnotationsAllWithContext(ctx context.Context, p *ListAnnotationsParams) (*ListAnnotationsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Annotation)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListAnnotationsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListAnnotationsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Annotations
+		mu.Unlock()
+
+		return len(l.Annotations), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListAnnotationsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Annotations = append(r.Annotations, pages[page]...)
+	}
+	r.Count = len(r.Annotations)
+
+	return r, nil
+}
+
+type ListAnnotationsResponse struct {
+	Count       int           `json:"count"`
+	Annotations []*Annotation `json:"annotation"`
+}
+
+type Annotation struct {
+	Annotation string `json:"annotation"`
+	Created    string `json:"created"`
+	Entityid   string `json:"entityid"`
+	Entitytype string `json:"entitytype"`
+	Id         string `json:"id"`
+	JobID      string `json:"jobid"`
+	Jobstatus  int    `json:"jobstatus"`
+	Removed    string `json:"removed"`
+	Userid     string `json:"userid"`
+}
+
+type RemoveAnnotationParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RemoveAnnotationParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RemoveAnnotationParams) Validate() error {
+	v := newParamValidator("removeAnnotation", p.p)
+	v.required("id")
+	v.maxLength("id", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RemoveAnnotationParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RemoveAnnotationParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for removeAnnotation", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of removeAnnotation: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *RemoveAnnotationParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *RemoveAnnotationParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RemoveAnnotationParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new RemoveAnnotationParams instance,
+// as then you are sure you have configured all required params
+func (s *AnnotationService) NewRemoveAnnotationParams(id string) *RemoveAnnotationParams {
+	p := &RemoveAnnotationParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// remove an annotation.
+func (s *AnnotationService) RemoveAnnotation(p *RemoveAnnotationParams) (*RemoveAnnotationResponse, error) {
+	return s.RemoveAnnotationWithContext(context.Background(), p)
+}
+
+// RemoveAnnotationWithContext is the same as RemoveAnnotation, but uses ctx to cancel the request and any async job polling
+func (s *AnnotationService) RemoveAnnotationWithContext(ctx context.Context, p *RemoveAnnotationParams) (*RemoveAnnotationResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeAnnotation", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveAnnotationResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type RemoveAnnotationResponse struct {
+	Annotation string `json:"annotation"`
+	Created    string `json:"created"`
+	Entityid   string `json:"entityid"`
+	Entitytype string `json:"entitytype"`
+	Id         string `json:"id"`
+	JobID      string `json:"jobid"`
+	Jobstatus  int    `json:"jobstatus"`
+	Removed    string `json:"removed"`
+	Userid     string `json:"userid"`
+}
@@ -0,0 +1,297 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+)
+
+// JobNotDoneErr is returned by Job.Result when the job has not finished yet
+var JobNotDoneErr = errors.New("Async job is not finished yet")
+
+// JobCancelledErr is returned by a Job that is no longer tracked because Cancel was called. Note
+// that this only stops the client from waiting, the job itself keeps running in CloudStack.
+var JobCancelledErr = errors.New("Stopped waiting for async job to finish")
+
+// Job is a handle to a running async job. It can be used to poll for the status of the job, to wait
+// until the job is finished and to decode the result of the job into the typed API response.
+type Job struct {
+	JobID string // The ID of the async job
+
+	cs      *CloudStackClient
+	convert func(json.RawMessage) (json.RawMessage, error) // Converts the job result before it is decoded
+
+	mu     sync.Mutex
+	once   sync.Once
+	done   chan struct{}
+	result json.RawMessage
+	err    error
+
+	parent context.Context // The context the job was started with
+	ctx    context.Context // Bounds the background polling, see Done()
+	cancel context.CancelFunc
+}
+
+// NewJob returns a handle to the async job with the given ID. The result of the job
+// will be decoded as is, so use the generated `...Async` functions where possible.
+func (cs *CloudStackClient) NewJob(jobid string) *Job {
+	return newJob(context.Background(), cs, jobid, nil)
+}
+
+// NewJobWithContext is the same as NewJob, but the job is only polled until ctx is done
+func (cs *CloudStackClient) NewJobWithContext(ctx context.Context, jobid string) *Job {
+	return newJob(ctx, cs, jobid, nil)
+}
+
+// Returns a job that is polled using a context derived from ctx, so the values of ctx
+// (like trace spans) are passed on to the polls. Polling stops when ctx is done, or
+// when the async timeout of the client has passed since the job was started.
+func newJob(ctx context.Context, cs *CloudStackClient, jobid string, convert func(json.RawMessage) (json.RawMessage, error)) *Job {
+	var jctx context.Context
+	var cancel context.CancelFunc
+	if cs.timeout > 0 {
+		jctx, cancel = context.WithTimeout(ctx, time.Duration(cs.timeout)*time.Second)
+	} else {
+		jctx, cancel = context.WithCancel(ctx)
+	}
+	return &Job{
+		JobID:   jobid,
+		cs:      cs,
+		convert: convert,
+		done:    make(chan struct{}),
+		parent:  ctx,
+		ctx:     jctx,
+		cancel:  cancel,
+	}
+}
+
+// Poll checks the status of the job once. It returns true if the job is finished, in which case
+// the returned error is the error of the job (if it failed). A false result with an error means the
+// status could not be retrieved.
+func (j *Job) Poll() (bool, error) {
+	return j.PollWithContext(context.Background())
+}
+
+// PollWithContext is the same as Poll, but uses ctx to cancel the request
+func (j *Job) PollWithContext(ctx context.Context) (bool, error) {
+	select {
+	case <-j.done:
+		return true, j.Err()
+	default:
+	}
+
+	p := j.cs.Asyncjob.NewQueryAsyncJobResultParams(j.JobID)
+	ctx = j.cs.traceJobPollStart(ctx, j.JobID)
+	r, err := j.cs.Asyncjob.QueryAsyncJobResultWithContext(ctx, p)
+	if err != nil {
+		j.cs.traceJobPollDone(ctx, &JobPollInfo{JobID: j.JobID, Err: err})
+		return false, err
+	}
+	j.cs.traceJobPollDone(ctx, &JobPollInfo{JobID: j.JobID, Status: r.Jobstatus})
+
+	switch r.Jobstatus {
+	case 1:
+		j.finish(r.Jobresult, nil)
+	case 2:
+		j.finish(nil, newAsyncJobError(r))
+	default:
+		return false, nil
+	}
+
+	return true, j.Err()
+}
+
+// Wait blocks until the job is finished or ctx is done. It returns the error of the job if it
+// failed, or the context error if ctx is done first. Waiting can be resumed with a new context.
+func (j *Job) Wait(ctx context.Context) error {
+	select {
+	case <-j.Done():
+		return j.Err()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Done returns a channel that is closed when the job is finished. Calling Done (or Wait) starts
+// polling the job in the background, until either the job is finished or Cancel is called. If
+// the context the job was started with is done first, the job finishes with the context error,
+// and if the async timeout of the client passes first, the job finishes with AsyncTimeoutErr.
+func (j *Job) Done() <-chan struct{} {
+	j.once.Do(func() {
+		go j.poll()
+	})
+	return j.done
+}
+
+// Cancel stops waiting for the job. Anyone waiting for the job will receive a JobCancelledErr,
+// but the job itself will not be cancelled in CloudStack as the API doesn't support that.
+func (j *Job) Cancel() {
+	j.finish(nil, JobCancelledErr)
+}
+
+// Err returns the error of a finished job, or nil if the job is still running or succeeded
+func (j *Job) Err() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.err
+}
+
+// Result decodes the result of a successfully finished job into v, which should be a pointer to the
+// response type of the API call that started the job. If the job is still running, a JobNotDoneErr
+// is returned, and if the job failed the error of the job is returned.
+func (j *Job) Result(v interface{}) error {
+	select {
+	case <-j.done:
+	default:
+		return JobNotDoneErr
+	}
+
+	j.mu.Lock()
+	b, err := j.result, j.err
+	j.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	if j.convert != nil {
+		if b, err = j.convert(b); err != nil {
+			return err
+		}
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+// WaitAll waits until all jobs are finished or ctx is done. It returns the first error that is
+// encountered, without waiting for the remaining jobs to finish. If ctx is done first, the jobs
+// that are not finished yet are cancelled, so they are no longer polled in the background.
+func WaitAll(ctx context.Context, jobs ...*Job) error {
+	// Start polling all jobs before waiting for any of them
+	for _, j := range jobs {
+		j.Done()
+	}
+
+	for _, j := range jobs {
+		if err := j.Wait(ctx); err != nil {
+			if ctx.Err() != nil {
+				for _, j := range jobs {
+					j.Cancel()
+				}
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Poll the job using the same (extremely simple) backoff as GetAsyncJobResult,
+// or let the JobWatcher of the client poll the job if it has one
+func (j *Job) poll() {
+	ctx := j.cs.traceJobWaitStart(j.ctx, j.JobID)
+	start := time.Now()
+
+	defer func() {
+		j.cs.traceJobWaitDone(ctx, &JobWaitInfo{JobID: j.JobID, Duration: time.Since(start), Err: j.Err()})
+	}()
+
+	if j.cs.watcher != nil {
+		j.watch(ctx)
+		return
+	}
+
+	var timer time.Duration
+
+	for {
+		done, err := j.PollWithContext(ctx)
+		if done {
+			return
+		}
+		if j.ctx.Err() != nil {
+			j.finish(nil, j.ctxErr())
+			return
+		}
+
+		// Errors while polling are most likely temporary, but give up when
+		// CloudStack tells us the job ID is unknown or we are not allowed to
+		// query the job.
+		if IsNotFound(err) || IsPermissionDenied(err) {
+			j.finish(nil, err)
+			return
+		}
+
+		if timer < 15 {
+			timer++
+		}
+
+		if err := sleepWithContext(ctx, timer*time.Second); err != nil {
+			j.finish(nil, j.ctxErr())
+			return
+		}
+	}
+}
+
+func (j *Job) watch(ctx context.Context) {
+	ch := j.cs.watcher.WatchWithContext(ctx, j.JobID)
+
+	select {
+	case r := <-ch:
+		switch {
+		case r.Err != nil:
+			j.finish(nil, r.Err)
+		case r.Job.Jobstatus == 2:
+			j.finish(nil, newAsyncJobError((*QueryAsyncJobResultResponse)(r.Job)))
+		default:
+			j.finish(r.Job.Jobresult, nil)
+		}
+	case <-j.ctx.Done():
+		j.cs.watcher.Unwatch(j.JobID, ch)
+		j.finish(nil, j.ctxErr())
+	}
+}
+
+// Returns the error of a job that stopped polling because its context is done
+func (j *Job) ctxErr() error {
+	if err := j.parent.Err(); err != nil {
+		return err
+	}
+	return AsyncTimeoutErr
+}
+
+func (j *Job) finish(result json.RawMessage, err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	select {
+	case <-j.done:
+		// The job is already finished
+		return
+	default:
+	}
+
+	j.result = result
+	j.err = err
+	close(j.done)
+	j.cancel()
+}
@@ -20,17 +20,34 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
-	"time"
+	"sync"
 )
 
+// AsyncjobServiceIface is the interface implemented by AsyncjobService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type AsyncjobServiceIface interface {
+	NewListAsyncJobsParams() *ListAsyncJobsParams
+	ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error)
+	ListAsyncJobsWithContext(ctx context.Context, p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error)
+	NewListAsyncJobsPager(p *ListAsyncJobsParams) *ListAsyncJobsPager
+	ListAsyncJobsAll(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error)
+	ListAsyncJobsAllWithContext(ctx context.Context, p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error)
+	NewQueryAsyncJobResultParams(jobid string) *QueryAsyncJobResultParams
+	QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error)
+	QueryAsyncJobResultWithContext(ctx context.Context, p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error)
+}
+
 type ListAsyncJobsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListAsyncJobsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListAsyncJobsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -66,6 +83,57 @@ func (p *ListAsyncJobsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListAsyncJobsParams) Validate() error {
+	v := newParamValidator("listAsyncJobs", p.p)
+	v.ids("domainid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListAsyncJobsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListAsyncJobsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "keyword", "startdate":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listAsyncJobs", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listAsyncJobs: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListAsyncJobsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -73,6 +141,15 @@ func (p *ListAsyncJobsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListAsyncJobsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListAsyncJobsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListAsyncJobsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -80,6 +157,15 @@ func (p *ListAsyncJobsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListAsyncJobsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListAsyncJobsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListAsyncJobsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -87,6 +173,15 @@ func (p *ListAsyncJobsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListAsyncJobsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListAsyncJobsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListAsyncJobsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -94,6 +189,15 @@ func (p *ListAsyncJobsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListAsyncJobsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListAsyncJobsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListAsyncJobsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -101,6 +205,15 @@ func (p *ListAsyncJobsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListAsyncJobsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListAsyncJobsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListAsyncJobsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -108,6 +221,15 @@ func (p *ListAsyncJobsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListAsyncJobsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListAsyncJobsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListAsyncJobsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -115,6 +237,15 @@ func (p *ListAsyncJobsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListAsyncJobsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListAsyncJobsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListAsyncJobsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -122,6 +253,15 @@ func (p *ListAsyncJobsParams) SetStartdate(v string) {
 	p.p["startdate"] = v
 }
 
+func (p *ListAsyncJobsParams) ResetStartdate() {
+	delete(p.p, "startdate")
+}
+
+func (p *ListAsyncJobsParams) GetStartdate() (string, bool) {
+	value, ok := p.p["startdate"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListAsyncJobsParams instance,
 // as then you are sure you have configured all required params
 func (s *AsyncjobService) NewListAsyncJobsParams() *ListAsyncJobsParams {
@@ -132,7 +272,16 @@ func (s *AsyncjobService) NewListAsyncJobsParams() *ListAsyncJobsParams {
 
 // Lists all pending asynchronous jobs for the account.
 func (s *AsyncjobService) ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
-	resp, err := s.cs.newRequest("listAsyncJobs", p.toURLValues())
+	return s.ListAsyncJobsWithContext(context.Background(), p)
+}
+
+// ListAsyncJobsWithContext is the same as ListAsyncJobs, but uses ctx to cancel the request and any async job polling
+func (s *AsyncjobService) ListAsyncJobsWithContext(ctx context.Context, p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listAsyncJobs", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +294,92 @@ func (s *AsyncjobService) ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsR
 	return &r, nil
 }
 
+// ListAsyncJobsPager iterates over all pages of a ListAsyncJobs call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListAsyncJobsPager struct {
+	pager
+
+	s *AsyncjobService
+	p *ListAsyncJobsParams
+	r *ListAsyncJobsResponse
+}
+
+// NewListAsyncJobsPager returns a pager for all pages of a ListAsyncJobs call with the given params
+func (s *AsyncjobService) NewListAsyncJobsPager(p *ListAsyncJobsParams) *ListAsyncJobsPager {
+	return &ListAsyncJobsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListAsyncJobsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListAsyncJobsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListAsyncJobsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListAsyncJobsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.AsyncJobs), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListAsyncJobsPager) Page() *ListAsyncJobsResponse {
+	return pg.r
+}
+
+// ListAsyncJobsAll fetches all pages of a ListAsyncJobs call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *AsyncjobService) ListAsyncJobsAll(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
+	return s.ListAsyncJobsAllWithContext(context.Background(), p)
+}
+
+// ListAsyncJobsAllWithContext is the same as ListAsyncJobsAll, but uses ctx to cancel the requests
+func (s *AsyncjobService) ListAsyncJobsAllWithContext(ctx context.Context, p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*AsyncJob)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListAsyncJobsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListAsyncJobsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.AsyncJobs
+		mu.Unlock()
+
+		return len(l.AsyncJobs), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListAsyncJobsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.AsyncJobs = append(r.AsyncJobs, pages[page]...)
+	}
+	r.Count = len(r.AsyncJobs)
+
+	return r, nil
+}
+
 type ListAsyncJobsResponse struct {
 	Count     int         `json:"count"`
 	AsyncJobs []*AsyncJob `json:"asyncjobs"`
@@ -170,7 +405,8 @@ type QueryAsyncJobResultParams struct {
 	p map[string]interface{}
 }
 
-func (p *QueryAsyncJobResultParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *QueryAsyncJobResultParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -181,6 +417,48 @@ func (p *QueryAsyncJobResultParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *QueryAsyncJobResultParams) Validate() error {
+	v := newParamValidator("queryAsyncJobResult", p.p)
+	v.required("jobid")
+	v.ids("jobid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *QueryAsyncJobResultParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *QueryAsyncJobResultParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "jobid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for queryAsyncJobResult", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of queryAsyncJobResult: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *QueryAsyncJobResultParams) SetJobID(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -188,6 +466,15 @@ func (p *QueryAsyncJobResultParams) SetJobID(v string) {
 	p.p["jobid"] = v
 }
 
+func (p *QueryAsyncJobResultParams) ResetJobID() {
+	delete(p.p, "jobid")
+}
+
+func (p *QueryAsyncJobResultParams) GetJobID() (string, bool) {
+	value, ok := p.p["jobid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new QueryAsyncJobResultParams instance,
 // as then you are sure you have configured all required params
 func (s *AsyncjobService) NewQueryAsyncJobResultParams(jobid string) *QueryAsyncJobResultParams {
@@ -199,17 +486,16 @@ func (s *AsyncjobService) NewQueryAsyncJobResultParams(jobid string) *QueryAsync
 
 // Retrieves the current status of asynchronous job.
 func (s *AsyncjobService) QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error) {
-	var resp json.RawMessage
-	var err error
-
-	// We should be able to retry on failure as this call is idempotent
-	for i := 0; i < 3; i++ {
-		resp, err = s.cs.newRequest("queryAsyncJobResult", p.toURLValues())
-		if err == nil {
-			break
-		}
-		time.Sleep(500 * time.Millisecond)
+	return s.QueryAsyncJobResultWithContext(context.Background(), p)
+}
+
+// QueryAsyncJobResultWithContext is the same as QueryAsyncJobResult, but uses ctx to cancel the request and any async job polling
+func (s *AsyncjobService) QueryAsyncJobResultWithContext(ctx context.Context, p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "queryAsyncJobResult", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
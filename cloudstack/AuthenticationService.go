@@ -20,16 +20,837 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// AuthenticationServiceIface is the interface implemented by AuthenticationService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type AuthenticationServiceIface interface {
+	NewAuthorizeSamlSsoParams(enable bool, userid string) *AuthorizeSamlSsoParams
+	AuthorizeSamlSso(p *AuthorizeSamlSsoParams) (*AuthorizeSamlSsoResponse, error)
+	AuthorizeSamlSsoWithContext(ctx context.Context, p *AuthorizeSamlSsoParams) (*AuthorizeSamlSsoResponse, error)
+	NewGetSPMetadataParams() *GetSPMetadataParams
+	GetSPMetadata(p *GetSPMetadataParams) (*GetSPMetadataResponse, error)
+	GetSPMetadataWithContext(ctx context.Context, p *GetSPMetadataParams) (*GetSPMetadataResponse, error)
+	NewListAndSwitchSamlAccountParams() *ListAndSwitchSamlAccountParams
+	ListAndSwitchSamlAccount(p *ListAndSwitchSamlAccountParams) (*ListAndSwitchSamlAccountResponse, error)
+	ListAndSwitchSamlAccountWithContext(ctx context.Context, p *ListAndSwitchSamlAccountParams) (*ListAndSwitchSamlAccountResponse, error)
+	NewListIdpsParams() *ListIdpsParams
+	ListIdps(p *ListIdpsParams) (*ListIdpsResponse, error)
+	ListIdpsWithContext(ctx context.Context, p *ListIdpsParams) (*ListIdpsResponse, error)
+	NewListSamlAuthorizationParams() *ListSamlAuthorizationParams
+	ListSamlAuthorization(p *ListSamlAuthorizationParams) (*ListSamlAuthorizationResponse, error)
+	ListSamlAuthorizationWithContext(ctx context.Context, p *ListSamlAuthorizationParams) (*ListSamlAuthorizationResponse, error)
+	NewListSamlAuthorizationPager(p *ListSamlAuthorizationParams) *ListSamlAuthorizationPager
+	ListSamlAuthorizationAll(p *ListSamlAuthorizationParams) (*ListSamlAuthorizationResponse, error)
+	ListSamlAuthorizationAllWithContext(ctx context.Context, p *ListSamlAuthorizationParams) (*ListSamlAuthorizationResponse, error)
+	NewLoginParams(password string, username string) *LoginParams
+	Login(p *LoginParams) (*LoginResponse, error)
+	LoginWithContext(ctx context.Context, p *LoginParams) (*LoginResponse, error)
+	NewLogoutParams() *LogoutParams
+	Logout(p *LogoutParams) (*LogoutResponse, error)
+	LogoutWithContext(ctx context.Context, p *LogoutParams) (*LogoutResponse, error)
+	NewSamlSloParams() *SamlSloParams
+	SamlSlo(p *SamlSloParams) (*SamlSloResponse, error)
+	SamlSloWithContext(ctx context.Context, p *SamlSloParams) (*SamlSloResponse, error)
+	NewSamlSsoParams(idpid string) *SamlSsoParams
+	SamlSso(p *SamlSsoParams) (*SamlSsoResponse, error)
+	SamlSsoWithContext(ctx context.Context, p *SamlSsoParams) (*SamlSsoResponse, error)
+}
+
+type AuthorizeSamlSsoParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AuthorizeSamlSsoParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["enable"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("enable", vv)
+	}
+	if v, found := p.p["entityid"]; found {
+		u.Set("entityid", v.(string))
+	}
+	if v, found := p.p["userid"]; found {
+		u.Set("userid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AuthorizeSamlSsoParams) Validate() error {
+	v := newParamValidator("authorizeSamlSso", p.p)
+	v.required("enable", "userid")
+	v.ids("userid")
+	v.maxLength("entityid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AuthorizeSamlSsoParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AuthorizeSamlSsoParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "enable":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "entityid", "userid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for authorizeSamlSso", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of authorizeSamlSso: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *AuthorizeSamlSsoParams) SetEnable(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["enable"] = v
+}
+
+func (p *AuthorizeSamlSsoParams) ResetEnable() {
+	delete(p.p, "enable")
+}
+
+func (p *AuthorizeSamlSsoParams) GetEnable() (bool, bool) {
+	value, ok := p.p["enable"].(bool)
+	return value, ok
+}
+
+func (p *AuthorizeSamlSsoParams) SetEntityid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["entityid"] = v
+}
+
+func (p *AuthorizeSamlSsoParams) ResetEntityid() {
+	delete(p.p, "entityid")
+}
+
+func (p *AuthorizeSamlSsoParams) GetEntityid() (string, bool) {
+	value, ok := p.p["entityid"].(string)
+	return value, ok
+}
+
+func (p *AuthorizeSamlSsoParams) SetUserid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["userid"] = v
+}
+
+func (p *AuthorizeSamlSsoParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *AuthorizeSamlSsoParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AuthorizeSamlSsoParams instance,
+// as then you are sure you have configured all required params
+func (s *AuthenticationService) NewAuthorizeSamlSsoParams(enable bool, userid string) *AuthorizeSamlSsoParams {
+	p := &AuthorizeSamlSsoParams{}
+	p.p = make(map[string]interface{})
+	p.p["enable"] = enable
+	p.p["userid"] = userid
+	return p
+}
+
+// Allow or disallow a user to use SAML SSO
+func (s *AuthenticationService) AuthorizeSamlSso(p *AuthorizeSamlSsoParams) (*AuthorizeSamlSsoResponse, error) {
+	return s.AuthorizeSamlSsoWithContext(context.Background(), p)
+}
+
+// AuthorizeSamlSsoWithContext is the same as AuthorizeSamlSso, but uses ctx to cancel the request and any async job polling
+func (s *AuthenticationService) AuthorizeSamlSsoWithContext(ctx context.Context, p *AuthorizeSamlSsoParams) (*AuthorizeSamlSsoResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "authorizeSamlSso", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AuthorizeSamlSsoResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type AuthorizeSamlSsoResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *AuthorizeSamlSsoResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias AuthorizeSamlSsoResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type GetSPMetadataParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetSPMetadataParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetSPMetadataParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetSPMetadataParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetSPMetadataParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k := range m {
+		return fmt.Errorf("Unknown param %s for getSPMetadata", k)
+	}
+	return nil
+}
+
+// You should always use this function to get a new GetSPMetadataParams instance,
+// as then you are sure you have configured all required params
+func (s *AuthenticationService) NewGetSPMetadataParams() *GetSPMetadataParams {
+	p := &GetSPMetadataParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Returns SAML2 CloudStack Service Provider MetaData
+func (s *AuthenticationService) GetSPMetadata(p *GetSPMetadataParams) (*GetSPMetadataResponse, error) {
+	return s.GetSPMetadataWithContext(context.Background(), p)
+}
+
+// GetSPMetadataWithContext is the same as GetSPMetadata, but uses ctx to cancel the request and any async job polling
+func (s *AuthenticationService) GetSPMetadataWithContext(ctx context.Context, p *GetSPMetadataParams) (*GetSPMetadataResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getSPMetadata", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r GetSPMetadataResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type GetSPMetadataResponse struct {
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Metadata  string `json:"metadata"`
+}
+
+type ListAndSwitchSamlAccountParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListAndSwitchSamlAccountParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["userid"]; found {
+		u.Set("userid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListAndSwitchSamlAccountParams) Validate() error {
+	v := newParamValidator("listAndSwitchSamlAccount", p.p)
+	v.ids("domainid", "userid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListAndSwitchSamlAccountParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListAndSwitchSamlAccountParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "domainid", "userid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listAndSwitchSamlAccount", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listAndSwitchSamlAccount: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListAndSwitchSamlAccountParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *ListAndSwitchSamlAccountParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListAndSwitchSamlAccountParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *ListAndSwitchSamlAccountParams) SetUserid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["userid"] = v
+}
+
+func (p *ListAndSwitchSamlAccountParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *ListAndSwitchSamlAccountParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListAndSwitchSamlAccountParams instance,
+// as then you are sure you have configured all required params
+func (s *AuthenticationService) NewListAndSwitchSamlAccountParams() *ListAndSwitchSamlAccountParams {
+	p := &ListAndSwitchSamlAccountParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Lists and switches to other SAML accounts owned by the SAML user
+func (s *AuthenticationService) ListAndSwitchSamlAccount(p *ListAndSwitchSamlAccountParams) (*ListAndSwitchSamlAccountResponse, error) {
+	return s.ListAndSwitchSamlAccountWithContext(context.Background(), p)
+}
+
+// ListAndSwitchSamlAccountWithContext is the same as ListAndSwitchSamlAccount, but uses ctx to cancel the request and any async job polling
+func (s *AuthenticationService) ListAndSwitchSamlAccountWithContext(ctx context.Context, p *ListAndSwitchSamlAccountParams) (*ListAndSwitchSamlAccountResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listAndSwitchSamlAccount", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListAndSwitchSamlAccountResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListAndSwitchSamlAccountResponse struct {
+	Count                int                     `json:"count"`
+	AndSwitchSamlAccount []*AndSwitchSamlAccount `json:"andswitchsamlaccount"`
+}
+
+type AndSwitchSamlAccount struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *AndSwitchSamlAccount) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias AndSwitchSamlAccount
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type ListIdpsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListIdpsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListIdpsParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListIdpsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListIdpsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k := range m {
+		return fmt.Errorf("Unknown param %s for listIdps", k)
+	}
+	return nil
+}
+
+// You should always use this function to get a new ListIdpsParams instance,
+// as then you are sure you have configured all required params
+func (s *AuthenticationService) NewListIdpsParams() *ListIdpsParams {
+	p := &ListIdpsParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Returns list of discovered SAML Identity Providers
+func (s *AuthenticationService) ListIdps(p *ListIdpsParams) (*ListIdpsResponse, error) {
+	return s.ListIdpsWithContext(context.Background(), p)
+}
+
+// ListIdpsWithContext is the same as ListIdps, but uses ctx to cancel the request and any async job polling
+func (s *AuthenticationService) ListIdpsWithContext(ctx context.Context, p *ListIdpsParams) (*ListIdpsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listIdps", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListIdpsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListIdpsResponse struct {
+	Count int    `json:"count"`
+	Idps  []*Idp `json:"idp"`
+}
+
+type Idp struct {
+	Id        string `json:"id"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	OrgName   string `json:"orgName"`
+	OrgUrl    string `json:"orgUrl"`
+}
+
+type ListSamlAuthorizationParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListSamlAuthorizationParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["userid"]; found {
+		u.Set("userid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListSamlAuthorizationParams) Validate() error {
+	v := newParamValidator("listSamlAuthorization", p.p)
+	v.ids("userid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListSamlAuthorizationParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListSamlAuthorizationParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "userid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listSamlAuthorization", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listSamlAuthorization: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListSamlAuthorizationParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListSamlAuthorizationParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListSamlAuthorizationParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListSamlAuthorizationParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListSamlAuthorizationParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListSamlAuthorizationParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListSamlAuthorizationParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListSamlAuthorizationParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListSamlAuthorizationParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListSamlAuthorizationParams) SetUserid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["userid"] = v
+}
+
+func (p *ListSamlAuthorizationParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *ListSamlAuthorizationParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListSamlAuthorizationParams instance,
+// as then you are sure you have configured all required params
+func (s *AuthenticationService) NewListSamlAuthorizationParams() *ListSamlAuthorizationParams {
+	p := &ListSamlAuthorizationParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Lists authorized users who can used SAML SSO
+func (s *AuthenticationService) ListSamlAuthorization(p *ListSamlAuthorizationParams) (*ListSamlAuthorizationResponse, error) {
+	return s.ListSamlAuthorizationWithContext(context.Background(), p)
+}
+
+// ListSamlAuthorizationWithContext is the same as ListSamlAuthorization, but uses ctx to cancel the request and any async job polling
+func (s *AuthenticationService) ListSamlAuthorizationWithContext(ctx context.Context, p *ListSamlAuthorizationParams) (*ListSamlAuthorizationResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listSamlAuthorization", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListSamlAuthorizationResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListSamlAuthorizationPager iterates over all pages of a ListSamlAuthorization call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListSamlAuthorizationPager struct {
+	pager
+
+	s *AuthenticationService
+	p *ListSamlAuthorizationParams
+	r *ListSamlAuthorizationResponse
+}
+
+// NewListSamlAuthorizationPager returns a pager for all pages of a ListSamlAuthorization call with the given params
+func (s *AuthenticationService) NewListSamlAuthorizationPager(p *ListSamlAuthorizationParams) *ListSamlAuthorizationPager {
+	return &ListSamlAuthorizationPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListSamlAuthorizationPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListSamlAuthorizationPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListSamlAuthorizationParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListSamlAuthorizationWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.SamlAuthorization), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListSamlAuthorizationPager) Page() *ListSamlAuthorizationResponse {
+	return pg.r
+}
+
+// ListSamlAuthorizationAll fetches all pages of a ListSamlAuthorization call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *AuthenticationService) ListSamlAuthorizationAll(p *ListSamlAuthorizationParams) (*ListSamlAuthorizationResponse, error) {
+	return s.ListSamlAuthorizationAllWithContext(context.Background(), p)
+}
+
+// ListSamlAuthorizationAllWithContext is the same as ListSamlAuthorizationAll, but uses ctx to cancel the requests
+func (s *AuthenticationService) ListSamlAuthorizationAllWithContext(ctx context.Context, p *ListSamlAuthorizationParams) (*ListSamlAuthorizationResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*SamlAuthorization)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListSamlAuthorizationParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListSamlAuthorizationWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.SamlAuthorization
+		mu.Unlock()
+
+		return len(l.SamlAuthorization), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListSamlAuthorizationResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.SamlAuthorization = append(r.SamlAuthorization, pages[page]...)
+	}
+	r.Count = len(r.SamlAuthorization)
+
+	return r, nil
+}
+
+type ListSamlAuthorizationResponse struct {
+	Count             int                  `json:"count"`
+	SamlAuthorization []*SamlAuthorization `json:"samlauthorization"`
+}
+
+type SamlAuthorization struct {
+	Idpid     string `json:"idpid"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Status    bool   `json:"status"`
+	Userid    string `json:"userid"`
+}
+
 type LoginParams struct {
 	p map[string]interface{}
 }
 
-func (p *LoginParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *LoginParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -50,6 +871,54 @@ func (p *LoginParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *LoginParams) Validate() error {
+	v := newParamValidator("login", p.p)
+	v.required("password", "username")
+	v.maxLength("domain", 255)
+	v.maxLength("password", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *LoginParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *LoginParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "domain", "password", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "domainId":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for login", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of login: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *LoginParams) SetDomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -57,6 +926,15 @@ func (p *LoginParams) SetDomain(v string) {
 	p.p["domain"] = v
 }
 
+func (p *LoginParams) ResetDomain() {
+	delete(p.p, "domain")
+}
+
+func (p *LoginParams) GetDomain() (string, bool) {
+	value, ok := p.p["domain"].(string)
+	return value, ok
+}
+
 func (p *LoginParams) SetDomainId(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -64,6 +942,15 @@ func (p *LoginParams) SetDomainId(v int64) {
 	p.p["domainId"] = v
 }
 
+func (p *LoginParams) ResetDomainId() {
+	delete(p.p, "domainId")
+}
+
+func (p *LoginParams) GetDomainId() (int64, bool) {
+	value, ok := p.p["domainId"].(int64)
+	return value, ok
+}
+
 func (p *LoginParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +958,15 @@ func (p *LoginParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *LoginParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *LoginParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *LoginParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -78,6 +974,15 @@ func (p *LoginParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *LoginParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *LoginParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new LoginParams instance,
 // as then you are sure you have configured all required params
 func (s *AuthenticationService) NewLoginParams(password string, username string) *LoginParams {
@@ -90,7 +995,16 @@ func (s *AuthenticationService) NewLoginParams(password string, username string)
 
 // Logs a user into the CloudStack. A successful login attempt will generate a JSESSIONID cookie value that can be passed in subsequent Query command calls until the "logout" command has been issued or the session has expired.
 func (s *AuthenticationService) Login(p *LoginParams) (*LoginResponse, error) {
-	resp, err := s.cs.newRequest("login", p.toURLValues())
+	return s.LoginWithContext(context.Background(), p)
+}
+
+// LoginWithContext is the same as Login, but uses ctx to cancel the request and any async job polling
+func (s *AuthenticationService) LoginWithContext(ctx context.Context, p *LoginParams) (*LoginResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "login", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +1038,8 @@ type LogoutParams struct {
 	p map[string]interface{}
 }
 
-func (p *LogoutParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *LogoutParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -132,6 +1047,34 @@ func (p *LogoutParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *LogoutParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *LogoutParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *LogoutParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k := range m {
+		return fmt.Errorf("Unknown param %s for logout", k)
+	}
+	return nil
+}
+
 // You should always use this function to get a new LogoutParams instance,
 // as then you are sure you have configured all required params
 func (s *AuthenticationService) NewLogoutParams() *LogoutParams {
@@ -142,7 +1085,16 @@ func (s *AuthenticationService) NewLogoutParams() *LogoutParams {
 
 // Logs out the user
 func (s *AuthenticationService) Logout(p *LogoutParams) (*LogoutResponse, error) {
-	resp, err := s.cs.newRequest("logout", p.toURLValues())
+	return s.LogoutWithContext(context.Background(), p)
+}
+
+// LogoutWithContext is the same as Logout, but uses ctx to cancel the request and any async job polling
+func (s *AuthenticationService) LogoutWithContext(ctx context.Context, p *LogoutParams) (*LogoutResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "logout", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -160,3 +1112,206 @@ type LogoutResponse struct {
 	JobID       string `json:"jobid"`
 	Jobstatus   int    `json:"jobstatus"`
 }
+
+type SamlSloParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *SamlSloParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *SamlSloParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *SamlSloParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *SamlSloParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k := range m {
+		return fmt.Errorf("Unknown param %s for samlSlo", k)
+	}
+	return nil
+}
+
+// You should always use this function to get a new SamlSloParams instance,
+// as then you are sure you have configured all required params
+func (s *AuthenticationService) NewSamlSloParams() *SamlSloParams {
+	p := &SamlSloParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// SAML Global Log Out API
+func (s *AuthenticationService) SamlSlo(p *SamlSloParams) (*SamlSloResponse, error) {
+	return s.SamlSloWithContext(context.Background(), p)
+}
+
+// SamlSloWithContext is the same as SamlSlo, but uses ctx to cancel the request and any async job polling
+func (s *AuthenticationService) SamlSloWithContext(ctx context.Context, p *SamlSloParams) (*SamlSloResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "samlSlo", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r SamlSloResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type SamlSloResponse struct {
+	Description string `json:"description"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+}
+
+type SamlSsoParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *SamlSsoParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["idpid"]; found {
+		u.Set("idpid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *SamlSsoParams) Validate() error {
+	v := newParamValidator("samlSso", p.p)
+	v.required("idpid")
+	v.maxLength("idpid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *SamlSsoParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *SamlSsoParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "idpid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for samlSso", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of samlSso: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *SamlSsoParams) SetIdpid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["idpid"] = v
+}
+
+func (p *SamlSsoParams) ResetIdpid() {
+	delete(p.p, "idpid")
+}
+
+func (p *SamlSsoParams) GetIdpid() (string, bool) {
+	value, ok := p.p["idpid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new SamlSsoParams instance,
+// as then you are sure you have configured all required params
+func (s *AuthenticationService) NewSamlSsoParams(idpid string) *SamlSsoParams {
+	p := &SamlSsoParams{}
+	p.p = make(map[string]interface{})
+	p.p["idpid"] = idpid
+	return p
+}
+
+// SP initiated SAML Single Sign On
+func (s *AuthenticationService) SamlSso(p *SamlSsoParams) (*SamlSsoResponse, error) {
+	return s.SamlSsoWithContext(context.Background(), p)
+}
+
+// SamlSsoWithContext is the same as SamlSso, but uses ctx to cancel the request and any async job polling
+func (s *AuthenticationService) SamlSsoWithContext(ctx context.Context, p *SamlSsoParams) (*SamlSsoResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "samlSso", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r SamlSsoResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type SamlSsoResponse struct {
+	Account        string `json:"account"`
+	Domainid       string `json:"domainid"`
+	Firstname      string `json:"firstname"`
+	JobID          string `json:"jobid"`
+	Jobstatus      int    `json:"jobstatus"`
+	Lastname       string `json:"lastname"`
+	Registered     string `json:"registered"`
+	Sessionkey     string `json:"sessionkey"`
+	Timeout        int    `json:"timeout"`
+	Timezone       string `json:"timezone"`
+	Timezoneoffset string `json:"timezoneoffset"`
+	Type           string `json:"type"`
+	Userid         string `json:"userid"`
+	Username       string `json:"username"`
+}
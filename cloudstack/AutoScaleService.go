@@ -20,18 +20,145 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// AutoScaleServiceIface is the interface implemented by AutoScaleService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type AutoScaleServiceIface interface {
+	NewCreateAutoScalePolicyParams(action string, conditionids []string, duration int) *CreateAutoScalePolicyParams
+	CreateAutoScalePolicy(p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error)
+	CreateAutoScalePolicyWithContext(ctx context.Context, p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error)
+	CreateAutoScalePolicyAsync(p *CreateAutoScalePolicyParams) (*Job, error)
+	CreateAutoScalePolicyAsyncWithContext(ctx context.Context, p *CreateAutoScalePolicyParams) (*Job, error)
+	NewCreateAutoScaleVmGroupParams(lbruleid string, maxmembers int, minmembers int, scaledownpolicyids []string, scaleuppolicyids []string, vmprofileid string) *CreateAutoScaleVmGroupParams
+	CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error)
+	CreateAutoScaleVmGroupWithContext(ctx context.Context, p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error)
+	CreateAutoScaleVmGroupAsync(p *CreateAutoScaleVmGroupParams) (*Job, error)
+	CreateAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *CreateAutoScaleVmGroupParams) (*Job, error)
+	NewCreateAutoScaleVmProfileParams(serviceofferingid string, templateid string, zoneid string) *CreateAutoScaleVmProfileParams
+	CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error)
+	CreateAutoScaleVmProfileWithContext(ctx context.Context, p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error)
+	CreateAutoScaleVmProfileAsync(p *CreateAutoScaleVmProfileParams) (*Job, error)
+	CreateAutoScaleVmProfileAsyncWithContext(ctx context.Context, p *CreateAutoScaleVmProfileParams) (*Job, error)
+	NewCreateConditionParams(counterid string, relationaloperator string, threshold int64) *CreateConditionParams
+	CreateCondition(p *CreateConditionParams) (*CreateConditionResponse, error)
+	CreateConditionWithContext(ctx context.Context, p *CreateConditionParams) (*CreateConditionResponse, error)
+	CreateConditionAsync(p *CreateConditionParams) (*Job, error)
+	CreateConditionAsyncWithContext(ctx context.Context, p *CreateConditionParams) (*Job, error)
+	NewCreateCounterParams(name string, source string, value string) *CreateCounterParams
+	CreateCounter(p *CreateCounterParams) (*CreateCounterResponse, error)
+	CreateCounterWithContext(ctx context.Context, p *CreateCounterParams) (*CreateCounterResponse, error)
+	CreateCounterAsync(p *CreateCounterParams) (*Job, error)
+	CreateCounterAsyncWithContext(ctx context.Context, p *CreateCounterParams) (*Job, error)
+	NewDeleteAutoScalePolicyParams(id string) *DeleteAutoScalePolicyParams
+	DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error)
+	DeleteAutoScalePolicyWithContext(ctx context.Context, p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error)
+	DeleteAutoScalePolicyAsync(p *DeleteAutoScalePolicyParams) (*Job, error)
+	DeleteAutoScalePolicyAsyncWithContext(ctx context.Context, p *DeleteAutoScalePolicyParams) (*Job, error)
+	NewDeleteAutoScaleVmGroupParams(id string) *DeleteAutoScaleVmGroupParams
+	DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error)
+	DeleteAutoScaleVmGroupWithContext(ctx context.Context, p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error)
+	DeleteAutoScaleVmGroupAsync(p *DeleteAutoScaleVmGroupParams) (*Job, error)
+	DeleteAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *DeleteAutoScaleVmGroupParams) (*Job, error)
+	NewDeleteAutoScaleVmProfileParams(id string) *DeleteAutoScaleVmProfileParams
+	DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error)
+	DeleteAutoScaleVmProfileWithContext(ctx context.Context, p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error)
+	DeleteAutoScaleVmProfileAsync(p *DeleteAutoScaleVmProfileParams) (*Job, error)
+	DeleteAutoScaleVmProfileAsyncWithContext(ctx context.Context, p *DeleteAutoScaleVmProfileParams) (*Job, error)
+	NewDeleteConditionParams(id string) *DeleteConditionParams
+	DeleteCondition(p *DeleteConditionParams) (*DeleteConditionResponse, error)
+	DeleteConditionWithContext(ctx context.Context, p *DeleteConditionParams) (*DeleteConditionResponse, error)
+	DeleteConditionAsync(p *DeleteConditionParams) (*Job, error)
+	DeleteConditionAsyncWithContext(ctx context.Context, p *DeleteConditionParams) (*Job, error)
+	NewDeleteCounterParams(id string) *DeleteCounterParams
+	DeleteCounter(p *DeleteCounterParams) (*DeleteCounterResponse, error)
+	DeleteCounterWithContext(ctx context.Context, p *DeleteCounterParams) (*DeleteCounterResponse, error)
+	DeleteCounterAsync(p *DeleteCounterParams) (*Job, error)
+	DeleteCounterAsyncWithContext(ctx context.Context, p *DeleteCounterParams) (*Job, error)
+	NewDisableAutoScaleVmGroupParams(id string) *DisableAutoScaleVmGroupParams
+	DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error)
+	DisableAutoScaleVmGroupWithContext(ctx context.Context, p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error)
+	DisableAutoScaleVmGroupAsync(p *DisableAutoScaleVmGroupParams) (*Job, error)
+	DisableAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *DisableAutoScaleVmGroupParams) (*Job, error)
+	NewEnableAutoScaleVmGroupParams(id string) *EnableAutoScaleVmGroupParams
+	EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error)
+	EnableAutoScaleVmGroupWithContext(ctx context.Context, p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error)
+	EnableAutoScaleVmGroupAsync(p *EnableAutoScaleVmGroupParams) (*Job, error)
+	EnableAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *EnableAutoScaleVmGroupParams) (*Job, error)
+	NewListAutoScalePoliciesParams() *ListAutoScalePoliciesParams
+	GetAutoScalePolicyByID(id string, opts ...OptionFunc) (*AutoScalePolicy, int, error)
+	GetAutoScalePolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScalePolicy, int, error)
+	ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error)
+	ListAutoScalePoliciesWithContext(ctx context.Context, p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error)
+	NewListAutoScalePoliciesPager(p *ListAutoScalePoliciesParams) *ListAutoScalePoliciesPager
+	ListAutoScalePoliciesAll(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error)
+	ListAutoScalePoliciesAllWithContext(ctx context.Context, p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error)
+	NewListAutoScaleVmGroupsParams() *ListAutoScaleVmGroupsParams
+	GetAutoScaleVmGroupByID(id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error)
+	GetAutoScaleVmGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error)
+	ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error)
+	ListAutoScaleVmGroupsWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error)
+	NewListAutoScaleVmGroupsPager(p *ListAutoScaleVmGroupsParams) *ListAutoScaleVmGroupsPager
+	ListAutoScaleVmGroupsAll(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error)
+	ListAutoScaleVmGroupsAllWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error)
+	NewListAutoScaleVmProfilesParams() *ListAutoScaleVmProfilesParams
+	GetAutoScaleVmProfileByID(id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error)
+	GetAutoScaleVmProfileByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error)
+	ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error)
+	ListAutoScaleVmProfilesWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error)
+	NewListAutoScaleVmProfilesPager(p *ListAutoScaleVmProfilesParams) *ListAutoScaleVmProfilesPager
+	ListAutoScaleVmProfilesAll(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error)
+	ListAutoScaleVmProfilesAllWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error)
+	NewListConditionsParams() *ListConditionsParams
+	GetConditionByID(id string, opts ...OptionFunc) (*Condition, int, error)
+	GetConditionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Condition, int, error)
+	ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error)
+	ListConditionsWithContext(ctx context.Context, p *ListConditionsParams) (*ListConditionsResponse, error)
+	NewListConditionsPager(p *ListConditionsParams) *ListConditionsPager
+	ListConditionsAll(p *ListConditionsParams) (*ListConditionsResponse, error)
+	ListConditionsAllWithContext(ctx context.Context, p *ListConditionsParams) (*ListConditionsResponse, error)
+	NewListCountersParams() *ListCountersParams
+	GetCounterID(name string, opts ...OptionFunc) (string, int, error)
+	GetCounterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetCounterByName(name string, opts ...OptionFunc) (*Counter, int, error)
+	GetCounterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Counter, int, error)
+	GetCounterByID(id string, opts ...OptionFunc) (*Counter, int, error)
+	GetCounterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Counter, int, error)
+	ListCounters(p *ListCountersParams) (*ListCountersResponse, error)
+	ListCountersWithContext(ctx context.Context, p *ListCountersParams) (*ListCountersResponse, error)
+	NewListCountersPager(p *ListCountersParams) *ListCountersPager
+	ListCountersAll(p *ListCountersParams) (*ListCountersResponse, error)
+	ListCountersAllWithContext(ctx context.Context, p *ListCountersParams) (*ListCountersResponse, error)
+	NewUpdateAutoScalePolicyParams(id string) *UpdateAutoScalePolicyParams
+	UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error)
+	UpdateAutoScalePolicyWithContext(ctx context.Context, p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error)
+	UpdateAutoScalePolicyAsync(p *UpdateAutoScalePolicyParams) (*Job, error)
+	UpdateAutoScalePolicyAsyncWithContext(ctx context.Context, p *UpdateAutoScalePolicyParams) (*Job, error)
+	NewUpdateAutoScaleVmGroupParams(id string) *UpdateAutoScaleVmGroupParams
+	UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error)
+	UpdateAutoScaleVmGroupWithContext(ctx context.Context, p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error)
+	UpdateAutoScaleVmGroupAsync(p *UpdateAutoScaleVmGroupParams) (*Job, error)
+	UpdateAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *UpdateAutoScaleVmGroupParams) (*Job, error)
+	NewUpdateAutoScaleVmProfileParams(id string) *UpdateAutoScaleVmProfileParams
+	UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error)
+	UpdateAutoScaleVmProfileWithContext(ctx context.Context, p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error)
+	UpdateAutoScaleVmProfileAsync(p *UpdateAutoScaleVmProfileParams) (*Job, error)
+	UpdateAutoScaleVmProfileAsyncWithContext(ctx context.Context, p *UpdateAutoScaleVmProfileParams) (*Job, error)
+}
+
 type CreateAutoScalePolicyParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateAutoScalePolicyParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateAutoScalePolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -54,6 +181,56 @@ func (p *CreateAutoScalePolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateAutoScalePolicyParams) Validate() error {
+	v := newParamValidator("createAutoScalePolicy", p.p)
+	v.required("action", "conditionids", "duration")
+	v.maxLength("action", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateAutoScalePolicyParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateAutoScalePolicyParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "action":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "conditionids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "duration", "quiettime":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createAutoScalePolicy", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createAutoScalePolicy: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateAutoScalePolicyParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -61,6 +238,15 @@ func (p *CreateAutoScalePolicyParams) SetAction(v string) {
 	p.p["action"] = v
 }
 
+func (p *CreateAutoScalePolicyParams) ResetAction() {
+	delete(p.p, "action")
+}
+
+func (p *CreateAutoScalePolicyParams) GetAction() (string, bool) {
+	value, ok := p.p["action"].(string)
+	return value, ok
+}
+
 func (p *CreateAutoScalePolicyParams) SetConditionids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -68,6 +254,15 @@ func (p *CreateAutoScalePolicyParams) SetConditionids(v []string) {
 	p.p["conditionids"] = v
 }
 
+func (p *CreateAutoScalePolicyParams) ResetConditionids() {
+	delete(p.p, "conditionids")
+}
+
+func (p *CreateAutoScalePolicyParams) GetConditionids() ([]string, bool) {
+	value, ok := p.p["conditionids"].([]string)
+	return value, ok
+}
+
 func (p *CreateAutoScalePolicyParams) SetDuration(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -75,6 +270,15 @@ func (p *CreateAutoScalePolicyParams) SetDuration(v int) {
 	p.p["duration"] = v
 }
 
+func (p *CreateAutoScalePolicyParams) ResetDuration() {
+	delete(p.p, "duration")
+}
+
+func (p *CreateAutoScalePolicyParams) GetDuration() (int, bool) {
+	value, ok := p.p["duration"].(int)
+	return value, ok
+}
+
 func (p *CreateAutoScalePolicyParams) SetQuiettime(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -82,6 +286,15 @@ func (p *CreateAutoScalePolicyParams) SetQuiettime(v int) {
 	p.p["quiettime"] = v
 }
 
+func (p *CreateAutoScalePolicyParams) ResetQuiettime() {
+	delete(p.p, "quiettime")
+}
+
+func (p *CreateAutoScalePolicyParams) GetQuiettime() (int, bool) {
+	value, ok := p.p["quiettime"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateAutoScalePolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewCreateAutoScalePolicyParams(action string, conditionids []string, duration int) *CreateAutoScalePolicyParams {
@@ -95,7 +308,16 @@ func (s *AutoScaleService) NewCreateAutoScalePolicyParams(action string, conditi
 
 // Creates an autoscale policy for a provision or deprovision action, the action is taken when the all the conditions evaluates to true for the specified duration. The policy is in effect once it is attached to a autscale vm group.
 func (s *AutoScaleService) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newRequest("createAutoScalePolicy", p.toURLValues())
+	return s.CreateAutoScalePolicyWithContext(context.Background(), p)
+}
+
+// CreateAutoScalePolicyWithContext is the same as CreateAutoScalePolicy, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) CreateAutoScalePolicyWithContext(ctx context.Context, p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createAutoScalePolicy", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +329,7 @@ func (s *AutoScaleService) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -128,6 +350,40 @@ func (s *AutoScaleService) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams)
 	return &r, nil
 }
 
+// CreateAutoScalePolicyAsync starts the async job for CreateAutoScalePolicy and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateAutoScalePolicyResponse using Job.Result
+func (s *AutoScaleService) CreateAutoScalePolicyAsync(p *CreateAutoScalePolicyParams) (*Job, error) {
+	return s.CreateAutoScalePolicyAsyncWithContext(context.Background(), p)
+}
+
+// CreateAutoScalePolicyAsyncWithContext is the same as CreateAutoScalePolicyAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) CreateAutoScalePolicyAsyncWithContext(ctx context.Context, p *CreateAutoScalePolicyParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createAutoScalePolicy", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateAutoScalePolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateAutoScalePolicyResponse struct {
 	Account    string   `json:"account"`
 	Action     string   `json:"action"`
@@ -147,7 +403,8 @@ type CreateAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateAutoScaleVmGroupParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateAutoScaleVmGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -185,6 +442,60 @@ func (p *CreateAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateAutoScaleVmGroupParams) Validate() error {
+	v := newParamValidator("createAutoScaleVmGroup", p.p)
+	v.required("lbruleid", "maxmembers", "minmembers", "scaledownpolicyids", "scaleuppolicyids", "vmprofileid")
+	v.ids("lbruleid", "vmprofileid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "interval", "maxmembers", "minmembers":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "lbruleid", "vmprofileid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "scaledownpolicyids", "scaleuppolicyids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createAutoScaleVmGroup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createAutoScaleVmGroup: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -192,6 +503,15 @@ func (p *CreateAutoScaleVmGroupParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *CreateAutoScaleVmGroupParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateAutoScaleVmGroupParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetInterval(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -199,6 +519,15 @@ func (p *CreateAutoScaleVmGroupParams) SetInterval(v int) {
 	p.p["interval"] = v
 }
 
+func (p *CreateAutoScaleVmGroupParams) ResetInterval() {
+	delete(p.p, "interval")
+}
+
+func (p *CreateAutoScaleVmGroupParams) GetInterval() (int, bool) {
+	value, ok := p.p["interval"].(int)
+	return value, ok
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -206,6 +535,15 @@ func (p *CreateAutoScaleVmGroupParams) SetLbruleid(v string) {
 	p.p["lbruleid"] = v
 }
 
+func (p *CreateAutoScaleVmGroupParams) ResetLbruleid() {
+	delete(p.p, "lbruleid")
+}
+
+func (p *CreateAutoScaleVmGroupParams) GetLbruleid() (string, bool) {
+	value, ok := p.p["lbruleid"].(string)
+	return value, ok
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetMaxmembers(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -213,6 +551,15 @@ func (p *CreateAutoScaleVmGroupParams) SetMaxmembers(v int) {
 	p.p["maxmembers"] = v
 }
 
+func (p *CreateAutoScaleVmGroupParams) ResetMaxmembers() {
+	delete(p.p, "maxmembers")
+}
+
+func (p *CreateAutoScaleVmGroupParams) GetMaxmembers() (int, bool) {
+	value, ok := p.p["maxmembers"].(int)
+	return value, ok
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetMinmembers(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -220,6 +567,15 @@ func (p *CreateAutoScaleVmGroupParams) SetMinmembers(v int) {
 	p.p["minmembers"] = v
 }
 
+func (p *CreateAutoScaleVmGroupParams) ResetMinmembers() {
+	delete(p.p, "minmembers")
+}
+
+func (p *CreateAutoScaleVmGroupParams) GetMinmembers() (int, bool) {
+	value, ok := p.p["minmembers"].(int)
+	return value, ok
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetScaledownpolicyids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -227,6 +583,15 @@ func (p *CreateAutoScaleVmGroupParams) SetScaledownpolicyids(v []string) {
 	p.p["scaledownpolicyids"] = v
 }
 
+func (p *CreateAutoScaleVmGroupParams) ResetScaledownpolicyids() {
+	delete(p.p, "scaledownpolicyids")
+}
+
+func (p *CreateAutoScaleVmGroupParams) GetScaledownpolicyids() ([]string, bool) {
+	value, ok := p.p["scaledownpolicyids"].([]string)
+	return value, ok
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetScaleuppolicyids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -234,6 +599,15 @@ func (p *CreateAutoScaleVmGroupParams) SetScaleuppolicyids(v []string) {
 	p.p["scaleuppolicyids"] = v
 }
 
+func (p *CreateAutoScaleVmGroupParams) ResetScaleuppolicyids() {
+	delete(p.p, "scaleuppolicyids")
+}
+
+func (p *CreateAutoScaleVmGroupParams) GetScaleuppolicyids() ([]string, bool) {
+	value, ok := p.p["scaleuppolicyids"].([]string)
+	return value, ok
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetVmprofileid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -241,6 +615,15 @@ func (p *CreateAutoScaleVmGroupParams) SetVmprofileid(v string) {
 	p.p["vmprofileid"] = v
 }
 
+func (p *CreateAutoScaleVmGroupParams) ResetVmprofileid() {
+	delete(p.p, "vmprofileid")
+}
+
+func (p *CreateAutoScaleVmGroupParams) GetVmprofileid() (string, bool) {
+	value, ok := p.p["vmprofileid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateAutoScaleVmGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewCreateAutoScaleVmGroupParams(lbruleid string, maxmembers int, minmembers int, scaledownpolicyids []string, scaleuppolicyids []string, vmprofileid string) *CreateAutoScaleVmGroupParams {
@@ -257,7 +640,16 @@ func (s *AutoScaleService) NewCreateAutoScaleVmGroupParams(lbruleid string, maxm
 
 // Creates and automatically starts a virtual machine based on a service offering, disk offering, and template.
 func (s *AutoScaleService) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("createAutoScaleVmGroup", p.toURLValues())
+	return s.CreateAutoScaleVmGroupWithContext(context.Background(), p)
+}
+
+// CreateAutoScaleVmGroupWithContext is the same as CreateAutoScaleVmGroup, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) CreateAutoScaleVmGroupWithContext(ctx context.Context, p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createAutoScaleVmGroup", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -269,7 +661,7 @@ func (s *AutoScaleService) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -290,6 +682,40 @@ func (s *AutoScaleService) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParam
 	return &r, nil
 }
 
+// CreateAutoScaleVmGroupAsync starts the async job for CreateAutoScaleVmGroup and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateAutoScaleVmGroupResponse using Job.Result
+func (s *AutoScaleService) CreateAutoScaleVmGroupAsync(p *CreateAutoScaleVmGroupParams) (*Job, error) {
+	return s.CreateAutoScaleVmGroupAsyncWithContext(context.Background(), p)
+}
+
+// CreateAutoScaleVmGroupAsyncWithContext is the same as CreateAutoScaleVmGroupAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) CreateAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *CreateAutoScaleVmGroupParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createAutoScaleVmGroup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateAutoScaleVmGroupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateAutoScaleVmGroupResponse struct {
 	Account           string   `json:"account"`
 	Domain            string   `json:"domain"`
@@ -314,7 +740,8 @@ type CreateAutoScaleVmProfileParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateAutoScaleVmProfileParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateAutoScaleVmProfileParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -323,10 +750,13 @@ func (p *CreateAutoScaleVmProfileParams) toURLValues() url.Values {
 		u.Set("autoscaleuserid", v.(string))
 	}
 	if v, found := p.p["counterparam"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("counterparam[%d].key", i), k)
-			u.Set(fmt.Sprintf("counterparam[%d].value", i), m[k])
+		for i, item := range v.([]CounterParam) {
+			if item.Name != "" {
+				u.Set(fmt.Sprintf("counterparam[%d].name", i), item.Name)
+			}
+			if item.Value != "" {
+				u.Set(fmt.Sprintf("counterparam[%d].value", i), item.Value)
+			}
 		}
 	}
 	if v, found := p.p["destroyvmgraceperiod"]; found {
@@ -352,6 +782,61 @@ func (p *CreateAutoScaleVmProfileParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateAutoScaleVmProfileParams) Validate() error {
+	v := newParamValidator("createAutoScaleVmProfile", p.p)
+	v.required("serviceofferingid", "templateid", "zoneid")
+	v.ids("autoscaleuserid", "serviceofferingid", "templateid", "zoneid")
+	v.maxLength("otherdeployparams", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateAutoScaleVmProfileParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateAutoScaleVmProfileParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "autoscaleuserid", "otherdeployparams", "serviceofferingid", "templateid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "counterparam":
+			var v []CounterParam
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "destroyvmgraceperiod":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createAutoScaleVmProfile", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createAutoScaleVmProfile: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -359,13 +844,31 @@ func (p *CreateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
 	p.p["autoscaleuserid"] = v
 }
 
-func (p *CreateAutoScaleVmProfileParams) SetCounterparam(v map[string]string) {
+func (p *CreateAutoScaleVmProfileParams) ResetAutoscaleuserid() {
+	delete(p.p, "autoscaleuserid")
+}
+
+func (p *CreateAutoScaleVmProfileParams) GetAutoscaleuserid() (string, bool) {
+	value, ok := p.p["autoscaleuserid"].(string)
+	return value, ok
+}
+
+func (p *CreateAutoScaleVmProfileParams) SetCounterparam(v []CounterParam) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["counterparam"] = v
 }
 
+func (p *CreateAutoScaleVmProfileParams) ResetCounterparam() {
+	delete(p.p, "counterparam")
+}
+
+func (p *CreateAutoScaleVmProfileParams) GetCounterparam() ([]CounterParam, bool) {
+	value, ok := p.p["counterparam"].([]CounterParam)
+	return value, ok
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetDestroyvmgraceperiod(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -373,6 +876,15 @@ func (p *CreateAutoScaleVmProfileParams) SetDestroyvmgraceperiod(v int) {
 	p.p["destroyvmgraceperiod"] = v
 }
 
+func (p *CreateAutoScaleVmProfileParams) ResetDestroyvmgraceperiod() {
+	delete(p.p, "destroyvmgraceperiod")
+}
+
+func (p *CreateAutoScaleVmProfileParams) GetDestroyvmgraceperiod() (int, bool) {
+	value, ok := p.p["destroyvmgraceperiod"].(int)
+	return value, ok
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -380,6 +892,15 @@ func (p *CreateAutoScaleVmProfileParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *CreateAutoScaleVmProfileParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateAutoScaleVmProfileParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetOtherdeployparams(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -387,6 +908,15 @@ func (p *CreateAutoScaleVmProfileParams) SetOtherdeployparams(v string) {
 	p.p["otherdeployparams"] = v
 }
 
+func (p *CreateAutoScaleVmProfileParams) ResetOtherdeployparams() {
+	delete(p.p, "otherdeployparams")
+}
+
+func (p *CreateAutoScaleVmProfileParams) GetOtherdeployparams() (string, bool) {
+	value, ok := p.p["otherdeployparams"].(string)
+	return value, ok
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -394,6 +924,15 @@ func (p *CreateAutoScaleVmProfileParams) SetServiceofferingid(v string) {
 	p.p["serviceofferingid"] = v
 }
 
+func (p *CreateAutoScaleVmProfileParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *CreateAutoScaleVmProfileParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -401,6 +940,15 @@ func (p *CreateAutoScaleVmProfileParams) SetTemplateid(v string) {
 	p.p["templateid"] = v
 }
 
+func (p *CreateAutoScaleVmProfileParams) ResetTemplateid() {
+	delete(p.p, "templateid")
+}
+
+func (p *CreateAutoScaleVmProfileParams) GetTemplateid() (string, bool) {
+	value, ok := p.p["templateid"].(string)
+	return value, ok
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -408,6 +956,15 @@ func (p *CreateAutoScaleVmProfileParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *CreateAutoScaleVmProfileParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreateAutoScaleVmProfileParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateAutoScaleVmProfileParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewCreateAutoScaleVmProfileParams(serviceofferingid string, templateid string, zoneid string) *CreateAutoScaleVmProfileParams {
@@ -421,7 +978,16 @@ func (s *AutoScaleService) NewCreateAutoScaleVmProfileParams(serviceofferingid s
 
 // Creates a profile that contains information about the virtual machine which will be provisioned automatically by autoscale feature.
 func (s *AutoScaleService) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newRequest("createAutoScaleVmProfile", p.toURLValues())
+	return s.CreateAutoScaleVmProfileWithContext(context.Background(), p)
+}
+
+// CreateAutoScaleVmProfileWithContext is the same as CreateAutoScaleVmProfile, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) CreateAutoScaleVmProfileWithContext(ctx context.Context, p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createAutoScaleVmProfile", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -433,7 +999,7 @@ func (s *AutoScaleService) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -454,6 +1020,40 @@ func (s *AutoScaleService) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileP
 	return &r, nil
 }
 
+// CreateAutoScaleVmProfileAsync starts the async job for CreateAutoScaleVmProfile and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateAutoScaleVmProfileResponse using Job.Result
+func (s *AutoScaleService) CreateAutoScaleVmProfileAsync(p *CreateAutoScaleVmProfileParams) (*Job, error) {
+	return s.CreateAutoScaleVmProfileAsyncWithContext(context.Background(), p)
+}
+
+// CreateAutoScaleVmProfileAsyncWithContext is the same as CreateAutoScaleVmProfileAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) CreateAutoScaleVmProfileAsyncWithContext(ctx context.Context, p *CreateAutoScaleVmProfileParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createAutoScaleVmProfile", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateAutoScaleVmProfileResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateAutoScaleVmProfileResponse struct {
 	Account              string `json:"account"`
 	Autoscaleuserid      string `json:"autoscaleuserid"`
@@ -476,7 +1076,8 @@ type CreateConditionParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateConditionParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateConditionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -500,6 +1101,54 @@ func (p *CreateConditionParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateConditionParams) Validate() error {
+	v := newParamValidator("createCondition", p.p)
+	v.required("counterid", "relationaloperator", "threshold")
+	v.ids("counterid", "domainid")
+	v.maxLength("account", 255)
+	v.maxLength("relationaloperator", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateConditionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateConditionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "counterid", "domainid", "relationaloperator":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "threshold":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createCondition", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createCondition: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateConditionParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -507,6 +1156,15 @@ func (p *CreateConditionParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateConditionParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateConditionParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateConditionParams) SetCounterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -514,6 +1172,15 @@ func (p *CreateConditionParams) SetCounterid(v string) {
 	p.p["counterid"] = v
 }
 
+func (p *CreateConditionParams) ResetCounterid() {
+	delete(p.p, "counterid")
+}
+
+func (p *CreateConditionParams) GetCounterid() (string, bool) {
+	value, ok := p.p["counterid"].(string)
+	return value, ok
+}
+
 func (p *CreateConditionParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -521,6 +1188,15 @@ func (p *CreateConditionParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateConditionParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateConditionParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateConditionParams) SetRelationaloperator(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -528,6 +1204,15 @@ func (p *CreateConditionParams) SetRelationaloperator(v string) {
 	p.p["relationaloperator"] = v
 }
 
+func (p *CreateConditionParams) ResetRelationaloperator() {
+	delete(p.p, "relationaloperator")
+}
+
+func (p *CreateConditionParams) GetRelationaloperator() (string, bool) {
+	value, ok := p.p["relationaloperator"].(string)
+	return value, ok
+}
+
 func (p *CreateConditionParams) SetThreshold(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -535,6 +1220,15 @@ func (p *CreateConditionParams) SetThreshold(v int64) {
 	p.p["threshold"] = v
 }
 
+func (p *CreateConditionParams) ResetThreshold() {
+	delete(p.p, "threshold")
+}
+
+func (p *CreateConditionParams) GetThreshold() (int64, bool) {
+	value, ok := p.p["threshold"].(int64)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateConditionParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewCreateConditionParams(counterid string, relationaloperator string, threshold int64) *CreateConditionParams {
@@ -548,7 +1242,16 @@ func (s *AutoScaleService) NewCreateConditionParams(counterid string, relational
 
 // Creates a condition
 func (s *AutoScaleService) CreateCondition(p *CreateConditionParams) (*CreateConditionResponse, error) {
-	resp, err := s.cs.newRequest("createCondition", p.toURLValues())
+	return s.CreateConditionWithContext(context.Background(), p)
+}
+
+// CreateConditionWithContext is the same as CreateCondition, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) CreateConditionWithContext(ctx context.Context, p *CreateConditionParams) (*CreateConditionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createCondition", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -560,7 +1263,7 @@ func (s *AutoScaleService) CreateCondition(p *CreateConditionParams) (*CreateCon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -581,6 +1284,40 @@ func (s *AutoScaleService) CreateCondition(p *CreateConditionParams) (*CreateCon
 	return &r, nil
 }
 
+// CreateConditionAsync starts the async job for CreateCondition and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateConditionResponse using Job.Result
+func (s *AutoScaleService) CreateConditionAsync(p *CreateConditionParams) (*Job, error) {
+	return s.CreateConditionAsyncWithContext(context.Background(), p)
+}
+
+// CreateConditionAsyncWithContext is the same as CreateConditionAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) CreateConditionAsyncWithContext(ctx context.Context, p *CreateConditionParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createCondition", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateConditionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateConditionResponse struct {
 	Account            string   `json:"account"`
 	Counter            []string `json:"counter"`
@@ -600,7 +1337,8 @@ type CreateCounterParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateCounterParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateCounterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -617,25 +1355,96 @@ func (p *CreateCounterParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *CreateCounterParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateCounterParams) Validate() error {
+	v := newParamValidator("createCounter", p.p)
+	v.required("name", "source", "value")
+	v.maxLength("name", 255)
+	v.maxLength("source", 255)
+	v.maxLength("value", 255)
+	return v.err()
 }
 
-func (p *CreateCounterParams) SetSource(v string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateCounterParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["source"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *CreateCounterParams) SetValue(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateCounterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["value"] = v
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "name", "source", "value":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createCounter", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createCounter: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *CreateCounterParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *CreateCounterParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateCounterParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *CreateCounterParams) SetSource(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["source"] = v
+}
+
+func (p *CreateCounterParams) ResetSource() {
+	delete(p.p, "source")
+}
+
+func (p *CreateCounterParams) GetSource() (string, bool) {
+	value, ok := p.p["source"].(string)
+	return value, ok
+}
+
+func (p *CreateCounterParams) SetValue(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["value"] = v
+}
+
+func (p *CreateCounterParams) ResetValue() {
+	delete(p.p, "value")
+}
+
+func (p *CreateCounterParams) GetValue() (string, bool) {
+	value, ok := p.p["value"].(string)
+	return value, ok
 }
 
 // You should always use this function to get a new CreateCounterParams instance,
@@ -651,7 +1460,16 @@ func (s *AutoScaleService) NewCreateCounterParams(name string, source string, va
 
 // Adds metric counter
 func (s *AutoScaleService) CreateCounter(p *CreateCounterParams) (*CreateCounterResponse, error) {
-	resp, err := s.cs.newRequest("createCounter", p.toURLValues())
+	return s.CreateCounterWithContext(context.Background(), p)
+}
+
+// CreateCounterWithContext is the same as CreateCounter, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) CreateCounterWithContext(ctx context.Context, p *CreateCounterParams) (*CreateCounterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createCounter", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -663,7 +1481,7 @@ func (s *AutoScaleService) CreateCounter(p *CreateCounterParams) (*CreateCounter
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -684,6 +1502,40 @@ func (s *AutoScaleService) CreateCounter(p *CreateCounterParams) (*CreateCounter
 	return &r, nil
 }
 
+// CreateCounterAsync starts the async job for CreateCounter and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateCounterResponse using Job.Result
+func (s *AutoScaleService) CreateCounterAsync(p *CreateCounterParams) (*Job, error) {
+	return s.CreateCounterAsyncWithContext(context.Background(), p)
+}
+
+// CreateCounterAsyncWithContext is the same as CreateCounterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) CreateCounterAsyncWithContext(ctx context.Context, p *CreateCounterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createCounter", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateCounterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateCounterResponse struct {
 	Id        string `json:"id"`
 	JobID     string `json:"jobid"`
@@ -698,7 +1550,8 @@ type DeleteAutoScalePolicyParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteAutoScalePolicyParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteAutoScalePolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -709,6 +1562,48 @@ func (p *DeleteAutoScalePolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteAutoScalePolicyParams) Validate() error {
+	v := newParamValidator("deleteAutoScalePolicy", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteAutoScalePolicyParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteAutoScalePolicyParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteAutoScalePolicy", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteAutoScalePolicy: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteAutoScalePolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -716,6 +1611,15 @@ func (p *DeleteAutoScalePolicyParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteAutoScalePolicyParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteAutoScalePolicyParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteAutoScalePolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewDeleteAutoScalePolicyParams(id string) *DeleteAutoScalePolicyParams {
@@ -727,7 +1631,16 @@ func (s *AutoScaleService) NewDeleteAutoScalePolicyParams(id string) *DeleteAuto
 
 // Deletes a autoscale policy.
 func (s *AutoScaleService) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newRequest("deleteAutoScalePolicy", p.toURLValues())
+	return s.DeleteAutoScalePolicyWithContext(context.Background(), p)
+}
+
+// DeleteAutoScalePolicyWithContext is the same as DeleteAutoScalePolicy, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) DeleteAutoScalePolicyWithContext(ctx context.Context, p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAutoScalePolicy", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -739,7 +1652,7 @@ func (s *AutoScaleService) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -755,6 +1668,31 @@ func (s *AutoScaleService) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams)
 	return &r, nil
 }
 
+// DeleteAutoScalePolicyAsync starts the async job for DeleteAutoScalePolicy and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteAutoScalePolicyResponse using Job.Result
+func (s *AutoScaleService) DeleteAutoScalePolicyAsync(p *DeleteAutoScalePolicyParams) (*Job, error) {
+	return s.DeleteAutoScalePolicyAsyncWithContext(context.Background(), p)
+}
+
+// DeleteAutoScalePolicyAsyncWithContext is the same as DeleteAutoScalePolicyAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) DeleteAutoScalePolicyAsyncWithContext(ctx context.Context, p *DeleteAutoScalePolicyParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAutoScalePolicy", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteAutoScalePolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteAutoScalePolicyResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -766,7 +1704,8 @@ type DeleteAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteAutoScaleVmGroupParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteAutoScaleVmGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -777,6 +1716,48 @@ func (p *DeleteAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteAutoScaleVmGroupParams) Validate() error {
+	v := newParamValidator("deleteAutoScaleVmGroup", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteAutoScaleVmGroup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteAutoScaleVmGroup: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteAutoScaleVmGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -784,6 +1765,15 @@ func (p *DeleteAutoScaleVmGroupParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteAutoScaleVmGroupParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteAutoScaleVmGroupParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteAutoScaleVmGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewDeleteAutoScaleVmGroupParams(id string) *DeleteAutoScaleVmGroupParams {
@@ -795,7 +1785,16 @@ func (s *AutoScaleService) NewDeleteAutoScaleVmGroupParams(id string) *DeleteAut
 
 // Deletes a autoscale vm group.
 func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteAutoScaleVmGroup", p.toURLValues())
+	return s.DeleteAutoScaleVmGroupWithContext(context.Background(), p)
+}
+
+// DeleteAutoScaleVmGroupWithContext is the same as DeleteAutoScaleVmGroup, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) DeleteAutoScaleVmGroupWithContext(ctx context.Context, p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAutoScaleVmGroup", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -807,7 +1806,7 @@ func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -823,6 +1822,31 @@ func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParam
 	return &r, nil
 }
 
+// DeleteAutoScaleVmGroupAsync starts the async job for DeleteAutoScaleVmGroup and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteAutoScaleVmGroupResponse using Job.Result
+func (s *AutoScaleService) DeleteAutoScaleVmGroupAsync(p *DeleteAutoScaleVmGroupParams) (*Job, error) {
+	return s.DeleteAutoScaleVmGroupAsyncWithContext(context.Background(), p)
+}
+
+// DeleteAutoScaleVmGroupAsyncWithContext is the same as DeleteAutoScaleVmGroupAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) DeleteAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *DeleteAutoScaleVmGroupParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAutoScaleVmGroup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteAutoScaleVmGroupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteAutoScaleVmGroupResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -834,7 +1858,8 @@ type DeleteAutoScaleVmProfileParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteAutoScaleVmProfileParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteAutoScaleVmProfileParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -845,6 +1870,48 @@ func (p *DeleteAutoScaleVmProfileParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteAutoScaleVmProfileParams) Validate() error {
+	v := newParamValidator("deleteAutoScaleVmProfile", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteAutoScaleVmProfileParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteAutoScaleVmProfileParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteAutoScaleVmProfile", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteAutoScaleVmProfile: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteAutoScaleVmProfileParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -852,6 +1919,15 @@ func (p *DeleteAutoScaleVmProfileParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteAutoScaleVmProfileParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteAutoScaleVmProfileParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteAutoScaleVmProfileParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewDeleteAutoScaleVmProfileParams(id string) *DeleteAutoScaleVmProfileParams {
@@ -863,7 +1939,16 @@ func (s *AutoScaleService) NewDeleteAutoScaleVmProfileParams(id string) *DeleteA
 
 // Deletes a autoscale vm profile.
 func (s *AutoScaleService) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newRequest("deleteAutoScaleVmProfile", p.toURLValues())
+	return s.DeleteAutoScaleVmProfileWithContext(context.Background(), p)
+}
+
+// DeleteAutoScaleVmProfileWithContext is the same as DeleteAutoScaleVmProfile, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) DeleteAutoScaleVmProfileWithContext(ctx context.Context, p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAutoScaleVmProfile", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -875,7 +1960,7 @@ func (s *AutoScaleService) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -891,6 +1976,31 @@ func (s *AutoScaleService) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileP
 	return &r, nil
 }
 
+// DeleteAutoScaleVmProfileAsync starts the async job for DeleteAutoScaleVmProfile and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteAutoScaleVmProfileResponse using Job.Result
+func (s *AutoScaleService) DeleteAutoScaleVmProfileAsync(p *DeleteAutoScaleVmProfileParams) (*Job, error) {
+	return s.DeleteAutoScaleVmProfileAsyncWithContext(context.Background(), p)
+}
+
+// DeleteAutoScaleVmProfileAsyncWithContext is the same as DeleteAutoScaleVmProfileAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) DeleteAutoScaleVmProfileAsyncWithContext(ctx context.Context, p *DeleteAutoScaleVmProfileParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteAutoScaleVmProfile", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteAutoScaleVmProfileResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteAutoScaleVmProfileResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -902,7 +2012,8 @@ type DeleteConditionParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteConditionParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteConditionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -913,6 +2024,48 @@ func (p *DeleteConditionParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteConditionParams) Validate() error {
+	v := newParamValidator("deleteCondition", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteConditionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteConditionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteCondition", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteCondition: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteConditionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -920,6 +2073,15 @@ func (p *DeleteConditionParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteConditionParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteConditionParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteConditionParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewDeleteConditionParams(id string) *DeleteConditionParams {
@@ -931,7 +2093,16 @@ func (s *AutoScaleService) NewDeleteConditionParams(id string) *DeleteConditionP
 
 // Removes a condition
 func (s *AutoScaleService) DeleteCondition(p *DeleteConditionParams) (*DeleteConditionResponse, error) {
-	resp, err := s.cs.newRequest("deleteCondition", p.toURLValues())
+	return s.DeleteConditionWithContext(context.Background(), p)
+}
+
+// DeleteConditionWithContext is the same as DeleteCondition, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) DeleteConditionWithContext(ctx context.Context, p *DeleteConditionParams) (*DeleteConditionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCondition", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -943,7 +2114,7 @@ func (s *AutoScaleService) DeleteCondition(p *DeleteConditionParams) (*DeleteCon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -959,6 +2130,31 @@ func (s *AutoScaleService) DeleteCondition(p *DeleteConditionParams) (*DeleteCon
 	return &r, nil
 }
 
+// DeleteConditionAsync starts the async job for DeleteCondition and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteConditionResponse using Job.Result
+func (s *AutoScaleService) DeleteConditionAsync(p *DeleteConditionParams) (*Job, error) {
+	return s.DeleteConditionAsyncWithContext(context.Background(), p)
+}
+
+// DeleteConditionAsyncWithContext is the same as DeleteConditionAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) DeleteConditionAsyncWithContext(ctx context.Context, p *DeleteConditionParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCondition", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteConditionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteConditionResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -970,7 +2166,8 @@ type DeleteCounterParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteCounterParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteCounterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -981,6 +2178,48 @@ func (p *DeleteCounterParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteCounterParams) Validate() error {
+	v := newParamValidator("deleteCounter", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteCounterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteCounterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteCounter", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteCounter: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteCounterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -988,6 +2227,15 @@ func (p *DeleteCounterParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteCounterParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteCounterParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteCounterParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewDeleteCounterParams(id string) *DeleteCounterParams {
@@ -999,7 +2247,16 @@ func (s *AutoScaleService) NewDeleteCounterParams(id string) *DeleteCounterParam
 
 // Deletes a counter
 func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams) (*DeleteCounterResponse, error) {
-	resp, err := s.cs.newRequest("deleteCounter", p.toURLValues())
+	return s.DeleteCounterWithContext(context.Background(), p)
+}
+
+// DeleteCounterWithContext is the same as DeleteCounter, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) DeleteCounterWithContext(ctx context.Context, p *DeleteCounterParams) (*DeleteCounterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCounter", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1011,7 +2268,7 @@ func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams) (*DeleteCounter
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1027,6 +2284,31 @@ func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams) (*DeleteCounter
 	return &r, nil
 }
 
+// DeleteCounterAsync starts the async job for DeleteCounter and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteCounterResponse using Job.Result
+func (s *AutoScaleService) DeleteCounterAsync(p *DeleteCounterParams) (*Job, error) {
+	return s.DeleteCounterAsyncWithContext(context.Background(), p)
+}
+
+// DeleteCounterAsyncWithContext is the same as DeleteCounterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) DeleteCounterAsyncWithContext(ctx context.Context, p *DeleteCounterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCounter", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteCounterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteCounterResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -1038,7 +2320,8 @@ type DisableAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
-func (p *DisableAutoScaleVmGroupParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DisableAutoScaleVmGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1049,6 +2332,48 @@ func (p *DisableAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DisableAutoScaleVmGroupParams) Validate() error {
+	v := newParamValidator("disableAutoScaleVmGroup", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DisableAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DisableAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for disableAutoScaleVmGroup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of disableAutoScaleVmGroup: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DisableAutoScaleVmGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1056,6 +2381,15 @@ func (p *DisableAutoScaleVmGroupParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DisableAutoScaleVmGroupParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DisableAutoScaleVmGroupParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DisableAutoScaleVmGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewDisableAutoScaleVmGroupParams(id string) *DisableAutoScaleVmGroupParams {
@@ -1067,7 +2401,16 @@ func (s *AutoScaleService) NewDisableAutoScaleVmGroupParams(id string) *DisableA
 
 // Disables an AutoScale Vm Group
 func (s *AutoScaleService) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("disableAutoScaleVmGroup", p.toURLValues())
+	return s.DisableAutoScaleVmGroupWithContext(context.Background(), p)
+}
+
+// DisableAutoScaleVmGroupWithContext is the same as DisableAutoScaleVmGroup, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) DisableAutoScaleVmGroupWithContext(ctx context.Context, p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableAutoScaleVmGroup", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1079,7 +2422,7 @@ func (s *AutoScaleService) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupPar
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1100,6 +2443,40 @@ func (s *AutoScaleService) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupPar
 	return &r, nil
 }
 
+// DisableAutoScaleVmGroupAsync starts the async job for DisableAutoScaleVmGroup and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DisableAutoScaleVmGroupResponse using Job.Result
+func (s *AutoScaleService) DisableAutoScaleVmGroupAsync(p *DisableAutoScaleVmGroupParams) (*Job, error) {
+	return s.DisableAutoScaleVmGroupAsyncWithContext(context.Background(), p)
+}
+
+// DisableAutoScaleVmGroupAsyncWithContext is the same as DisableAutoScaleVmGroupAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) DisableAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *DisableAutoScaleVmGroupParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableAutoScaleVmGroup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableAutoScaleVmGroupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type DisableAutoScaleVmGroupResponse struct {
 	Account           string   `json:"account"`
 	Domain            string   `json:"domain"`
@@ -1124,7 +2501,8 @@ type EnableAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
-func (p *EnableAutoScaleVmGroupParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *EnableAutoScaleVmGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1135,6 +2513,48 @@ func (p *EnableAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *EnableAutoScaleVmGroupParams) Validate() error {
+	v := newParamValidator("enableAutoScaleVmGroup", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *EnableAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *EnableAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for enableAutoScaleVmGroup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of enableAutoScaleVmGroup: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *EnableAutoScaleVmGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1142,6 +2562,15 @@ func (p *EnableAutoScaleVmGroupParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *EnableAutoScaleVmGroupParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *EnableAutoScaleVmGroupParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new EnableAutoScaleVmGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewEnableAutoScaleVmGroupParams(id string) *EnableAutoScaleVmGroupParams {
@@ -1153,7 +2582,16 @@ func (s *AutoScaleService) NewEnableAutoScaleVmGroupParams(id string) *EnableAut
 
 // Enables an AutoScale Vm Group
 func (s *AutoScaleService) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("enableAutoScaleVmGroup", p.toURLValues())
+	return s.EnableAutoScaleVmGroupWithContext(context.Background(), p)
+}
+
+// EnableAutoScaleVmGroupWithContext is the same as EnableAutoScaleVmGroup, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) EnableAutoScaleVmGroupWithContext(ctx context.Context, p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableAutoScaleVmGroup", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1165,7 +2603,7 @@ func (s *AutoScaleService) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1186,6 +2624,40 @@ func (s *AutoScaleService) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParam
 	return &r, nil
 }
 
+// EnableAutoScaleVmGroupAsync starts the async job for EnableAutoScaleVmGroup and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a EnableAutoScaleVmGroupResponse using Job.Result
+func (s *AutoScaleService) EnableAutoScaleVmGroupAsync(p *EnableAutoScaleVmGroupParams) (*Job, error) {
+	return s.EnableAutoScaleVmGroupAsyncWithContext(context.Background(), p)
+}
+
+// EnableAutoScaleVmGroupAsyncWithContext is the same as EnableAutoScaleVmGroupAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) EnableAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *EnableAutoScaleVmGroupParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableAutoScaleVmGroup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableAutoScaleVmGroupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type EnableAutoScaleVmGroupResponse struct {
 	Account           string   `json:"account"`
 	Domain            string   `json:"domain"`
@@ -1210,7 +2682,8 @@ type ListAutoScalePoliciesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListAutoScalePoliciesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListAutoScalePoliciesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1255,6 +2728,58 @@ func (p *ListAutoScalePoliciesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListAutoScalePoliciesParams) Validate() error {
+	v := newParamValidator("listAutoScalePolicies", p.p)
+	v.ids("conditionid", "domainid", "id", "vmgroupid")
+	v.maxLength("account", 255)
+	v.maxLength("action", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListAutoScalePoliciesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListAutoScalePoliciesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "action", "conditionid", "domainid", "id", "keyword", "vmgroupid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listAutoScalePolicies", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listAutoScalePolicies: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListAutoScalePoliciesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1262,6 +2787,15 @@ func (p *ListAutoScalePoliciesParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListAutoScalePoliciesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListAutoScalePoliciesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScalePoliciesParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1269,6 +2803,15 @@ func (p *ListAutoScalePoliciesParams) SetAction(v string) {
 	p.p["action"] = v
 }
 
+func (p *ListAutoScalePoliciesParams) ResetAction() {
+	delete(p.p, "action")
+}
+
+func (p *ListAutoScalePoliciesParams) GetAction() (string, bool) {
+	value, ok := p.p["action"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScalePoliciesParams) SetConditionid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1276,6 +2819,15 @@ func (p *ListAutoScalePoliciesParams) SetConditionid(v string) {
 	p.p["conditionid"] = v
 }
 
+func (p *ListAutoScalePoliciesParams) ResetConditionid() {
+	delete(p.p, "conditionid")
+}
+
+func (p *ListAutoScalePoliciesParams) GetConditionid() (string, bool) {
+	value, ok := p.p["conditionid"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScalePoliciesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1283,6 +2835,15 @@ func (p *ListAutoScalePoliciesParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListAutoScalePoliciesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListAutoScalePoliciesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScalePoliciesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1290,6 +2851,15 @@ func (p *ListAutoScalePoliciesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListAutoScalePoliciesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListAutoScalePoliciesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScalePoliciesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1297,6 +2867,15 @@ func (p *ListAutoScalePoliciesParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListAutoScalePoliciesParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListAutoScalePoliciesParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListAutoScalePoliciesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1304,6 +2883,15 @@ func (p *ListAutoScalePoliciesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListAutoScalePoliciesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListAutoScalePoliciesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScalePoliciesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1311,6 +2899,15 @@ func (p *ListAutoScalePoliciesParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListAutoScalePoliciesParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListAutoScalePoliciesParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListAutoScalePoliciesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1318,6 +2915,15 @@ func (p *ListAutoScalePoliciesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListAutoScalePoliciesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListAutoScalePoliciesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListAutoScalePoliciesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1325,6 +2931,15 @@ func (p *ListAutoScalePoliciesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListAutoScalePoliciesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListAutoScalePoliciesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListAutoScalePoliciesParams) SetVmgroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1332,6 +2947,15 @@ func (p *ListAutoScalePoliciesParams) SetVmgroupid(v string) {
 	p.p["vmgroupid"] = v
 }
 
+func (p *ListAutoScalePoliciesParams) ResetVmgroupid() {
+	delete(p.p, "vmgroupid")
+}
+
+func (p *ListAutoScalePoliciesParams) GetVmgroupid() (string, bool) {
+	value, ok := p.p["vmgroupid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListAutoScalePoliciesParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewListAutoScalePoliciesParams() *ListAutoScalePoliciesParams {
@@ -1342,6 +2966,11 @@ func (s *AutoScaleService) NewListAutoScalePoliciesParams() *ListAutoScalePolici
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScalePolicyByID(id string, opts ...OptionFunc) (*AutoScalePolicy, int, error) {
+	return s.GetAutoScalePolicyByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetAutoScalePolicyByIDWithContext is the same as GetAutoScalePolicyByID, but uses ctx to cancel the request
+func (s *AutoScaleService) GetAutoScalePolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScalePolicy, int, error) {
 	p := &ListAutoScalePoliciesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1353,18 +2982,16 @@ func (s *AutoScaleService) GetAutoScalePolicyByID(id string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListAutoScalePolicies(p)
+	l, err := s.ListAutoScalePoliciesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1375,7 +3002,16 @@ func (s *AutoScaleService) GetAutoScalePolicyByID(id string, opts ...OptionFunc)
 
 // Lists autoscale policies.
 func (s *AutoScaleService) ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScalePolicies", p.toURLValues())
+	return s.ListAutoScalePoliciesWithContext(context.Background(), p)
+}
+
+// ListAutoScalePoliciesWithContext is the same as ListAutoScalePolicies, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) ListAutoScalePoliciesWithContext(ctx context.Context, p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listAutoScalePolicies", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1388,6 +3024,92 @@ func (s *AutoScaleService) ListAutoScalePolicies(p *ListAutoScalePoliciesParams)
 	return &r, nil
 }
 
+// ListAutoScalePoliciesPager iterates over all pages of a ListAutoScalePolicies call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListAutoScalePoliciesPager struct {
+	pager
+
+	s *AutoScaleService
+	p *ListAutoScalePoliciesParams
+	r *ListAutoScalePoliciesResponse
+}
+
+// NewListAutoScalePoliciesPager returns a pager for all pages of a ListAutoScalePolicies call with the given params
+func (s *AutoScaleService) NewListAutoScalePoliciesPager(p *ListAutoScalePoliciesParams) *ListAutoScalePoliciesPager {
+	return &ListAutoScalePoliciesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListAutoScalePoliciesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListAutoScalePoliciesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListAutoScalePoliciesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListAutoScalePoliciesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.AutoScalePolicies), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListAutoScalePoliciesPager) Page() *ListAutoScalePoliciesResponse {
+	return pg.r
+}
+
+// ListAutoScalePoliciesAll fetches all pages of a ListAutoScalePolicies call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *AutoScaleService) ListAutoScalePoliciesAll(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
+	return s.ListAutoScalePoliciesAllWithContext(context.Background(), p)
+}
+
+// ListAutoScalePoliciesAllWithContext is the same as ListAutoScalePoliciesAll, but uses ctx to cancel the requests
+func (s *AutoScaleService) ListAutoScalePoliciesAllWithContext(ctx context.Context, p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*AutoScalePolicy)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListAutoScalePoliciesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListAutoScalePoliciesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.AutoScalePolicies
+		mu.Unlock()
+
+		return len(l.AutoScalePolicies), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListAutoScalePoliciesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.AutoScalePolicies = append(r.AutoScalePolicies, pages[page]...)
+	}
+	r.Count = len(r.AutoScalePolicies)
+
+	return r, nil
+}
+
 type ListAutoScalePoliciesResponse struct {
 	Count             int                `json:"count"`
 	AutoScalePolicies []*AutoScalePolicy `json:"autoscalepolicy"`
@@ -1412,7 +3134,8 @@ type ListAutoScaleVmGroupsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListAutoScaleVmGroupsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListAutoScaleVmGroupsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1467,6 +3190,57 @@ func (p *ListAutoScaleVmGroupsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListAutoScaleVmGroupsParams) Validate() error {
+	v := newParamValidator("listAutoScaleVmGroups", p.p)
+	v.ids("domainid", "id", "lbruleid", "policyid", "projectid", "vmprofileid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListAutoScaleVmGroupsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListAutoScaleVmGroupsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "lbruleid", "policyid", "projectid", "vmprofileid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listAutoScaleVmGroups", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listAutoScaleVmGroups: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1474,6 +3248,15 @@ func (p *ListAutoScaleVmGroupsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListAutoScaleVmGroupsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListAutoScaleVmGroupsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1481,6 +3264,15 @@ func (p *ListAutoScaleVmGroupsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListAutoScaleVmGroupsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListAutoScaleVmGroupsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1488,6 +3280,15 @@ func (p *ListAutoScaleVmGroupsParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListAutoScaleVmGroupsParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListAutoScaleVmGroupsParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1495,6 +3296,15 @@ func (p *ListAutoScaleVmGroupsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListAutoScaleVmGroupsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListAutoScaleVmGroupsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1502,6 +3312,15 @@ func (p *ListAutoScaleVmGroupsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListAutoScaleVmGroupsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListAutoScaleVmGroupsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1509,6 +3328,15 @@ func (p *ListAutoScaleVmGroupsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListAutoScaleVmGroupsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListAutoScaleVmGroupsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1516,6 +3344,15 @@ func (p *ListAutoScaleVmGroupsParams) SetLbruleid(v string) {
 	p.p["lbruleid"] = v
 }
 
+func (p *ListAutoScaleVmGroupsParams) ResetLbruleid() {
+	delete(p.p, "lbruleid")
+}
+
+func (p *ListAutoScaleVmGroupsParams) GetLbruleid() (string, bool) {
+	value, ok := p.p["lbruleid"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1523,6 +3360,15 @@ func (p *ListAutoScaleVmGroupsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListAutoScaleVmGroupsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListAutoScaleVmGroupsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1530,6 +3376,15 @@ func (p *ListAutoScaleVmGroupsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListAutoScaleVmGroupsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListAutoScaleVmGroupsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1537,6 +3392,15 @@ func (p *ListAutoScaleVmGroupsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListAutoScaleVmGroupsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListAutoScaleVmGroupsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetPolicyid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1544,6 +3408,15 @@ func (p *ListAutoScaleVmGroupsParams) SetPolicyid(v string) {
 	p.p["policyid"] = v
 }
 
+func (p *ListAutoScaleVmGroupsParams) ResetPolicyid() {
+	delete(p.p, "policyid")
+}
+
+func (p *ListAutoScaleVmGroupsParams) GetPolicyid() (string, bool) {
+	value, ok := p.p["policyid"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1551,6 +3424,15 @@ func (p *ListAutoScaleVmGroupsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListAutoScaleVmGroupsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListAutoScaleVmGroupsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetVmprofileid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1558,6 +3440,15 @@ func (p *ListAutoScaleVmGroupsParams) SetVmprofileid(v string) {
 	p.p["vmprofileid"] = v
 }
 
+func (p *ListAutoScaleVmGroupsParams) ResetVmprofileid() {
+	delete(p.p, "vmprofileid")
+}
+
+func (p *ListAutoScaleVmGroupsParams) GetVmprofileid() (string, bool) {
+	value, ok := p.p["vmprofileid"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1565,6 +3456,15 @@ func (p *ListAutoScaleVmGroupsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListAutoScaleVmGroupsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListAutoScaleVmGroupsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListAutoScaleVmGroupsParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewListAutoScaleVmGroupsParams() *ListAutoScaleVmGroupsParams {
@@ -1575,6 +3475,11 @@ func (s *AutoScaleService) NewListAutoScaleVmGroupsParams() *ListAutoScaleVmGrou
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScaleVmGroupByID(id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error) {
+	return s.GetAutoScaleVmGroupByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetAutoScaleVmGroupByIDWithContext is the same as GetAutoScaleVmGroupByID, but uses ctx to cancel the request
+func (s *AutoScaleService) GetAutoScaleVmGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error) {
 	p := &ListAutoScaleVmGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1586,18 +3491,16 @@ func (s *AutoScaleService) GetAutoScaleVmGroupByID(id string, opts ...OptionFunc
 		}
 	}
 
-	l, err := s.ListAutoScaleVmGroups(p)
+	l, err := s.ListAutoScaleVmGroupsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1608,7 +3511,16 @@ func (s *AutoScaleService) GetAutoScaleVmGroupByID(id string, opts ...OptionFunc
 
 // Lists autoscale vm groups.
 func (s *AutoScaleService) ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScaleVmGroups", p.toURLValues())
+	return s.ListAutoScaleVmGroupsWithContext(context.Background(), p)
+}
+
+// ListAutoScaleVmGroupsWithContext is the same as ListAutoScaleVmGroups, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) ListAutoScaleVmGroupsWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listAutoScaleVmGroups", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1621,6 +3533,92 @@ func (s *AutoScaleService) ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams)
 	return &r, nil
 }
 
+// ListAutoScaleVmGroupsPager iterates over all pages of a ListAutoScaleVmGroups call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListAutoScaleVmGroupsPager struct {
+	pager
+
+	s *AutoScaleService
+	p *ListAutoScaleVmGroupsParams
+	r *ListAutoScaleVmGroupsResponse
+}
+
+// NewListAutoScaleVmGroupsPager returns a pager for all pages of a ListAutoScaleVmGroups call with the given params
+func (s *AutoScaleService) NewListAutoScaleVmGroupsPager(p *ListAutoScaleVmGroupsParams) *ListAutoScaleVmGroupsPager {
+	return &ListAutoScaleVmGroupsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListAutoScaleVmGroupsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListAutoScaleVmGroupsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListAutoScaleVmGroupsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListAutoScaleVmGroupsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.AutoScaleVmGroups), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListAutoScaleVmGroupsPager) Page() *ListAutoScaleVmGroupsResponse {
+	return pg.r
+}
+
+// ListAutoScaleVmGroupsAll fetches all pages of a ListAutoScaleVmGroups call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *AutoScaleService) ListAutoScaleVmGroupsAll(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
+	return s.ListAutoScaleVmGroupsAllWithContext(context.Background(), p)
+}
+
+// ListAutoScaleVmGroupsAllWithContext is the same as ListAutoScaleVmGroupsAll, but uses ctx to cancel the requests
+func (s *AutoScaleService) ListAutoScaleVmGroupsAllWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*AutoScaleVmGroup)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListAutoScaleVmGroupsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListAutoScaleVmGroupsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.AutoScaleVmGroups
+		mu.Unlock()
+
+		return len(l.AutoScaleVmGroups), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListAutoScaleVmGroupsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.AutoScaleVmGroups = append(r.AutoScaleVmGroups, pages[page]...)
+	}
+	r.Count = len(r.AutoScaleVmGroups)
+
+	return r, nil
+}
+
 type ListAutoScaleVmGroupsResponse struct {
 	Count             int                 `json:"count"`
 	AutoScaleVmGroups []*AutoScaleVmGroup `json:"autoscalevmgroup"`
@@ -1650,7 +3648,8 @@ type ListAutoScaleVmProfilesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListAutoScaleVmProfilesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListAutoScaleVmProfilesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1705,6 +3704,58 @@ func (p *ListAutoScaleVmProfilesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListAutoScaleVmProfilesParams) Validate() error {
+	v := newParamValidator("listAutoScaleVmProfiles", p.p)
+	v.ids("domainid", "id", "projectid", "serviceofferingid", "templateid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("otherdeployparams", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListAutoScaleVmProfilesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListAutoScaleVmProfilesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "otherdeployparams", "projectid", "serviceofferingid", "templateid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listAutoScaleVmProfiles", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listAutoScaleVmProfiles: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1712,6 +3763,15 @@ func (p *ListAutoScaleVmProfilesParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListAutoScaleVmProfilesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1719,6 +3779,15 @@ func (p *ListAutoScaleVmProfilesParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListAutoScaleVmProfilesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1726,6 +3795,15 @@ func (p *ListAutoScaleVmProfilesParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListAutoScaleVmProfilesParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1733,6 +3811,15 @@ func (p *ListAutoScaleVmProfilesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListAutoScaleVmProfilesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1740,6 +3827,15 @@ func (p *ListAutoScaleVmProfilesParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListAutoScaleVmProfilesParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1747,6 +3843,15 @@ func (p *ListAutoScaleVmProfilesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListAutoScaleVmProfilesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1754,6 +3859,15 @@ func (p *ListAutoScaleVmProfilesParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListAutoScaleVmProfilesParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetOtherdeployparams(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1761,6 +3875,15 @@ func (p *ListAutoScaleVmProfilesParams) SetOtherdeployparams(v string) {
 	p.p["otherdeployparams"] = v
 }
 
+func (p *ListAutoScaleVmProfilesParams) ResetOtherdeployparams() {
+	delete(p.p, "otherdeployparams")
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetOtherdeployparams() (string, bool) {
+	value, ok := p.p["otherdeployparams"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1768,6 +3891,15 @@ func (p *ListAutoScaleVmProfilesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListAutoScaleVmProfilesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1775,6 +3907,15 @@ func (p *ListAutoScaleVmProfilesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListAutoScaleVmProfilesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1782,6 +3923,15 @@ func (p *ListAutoScaleVmProfilesParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListAutoScaleVmProfilesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1789,6 +3939,15 @@ func (p *ListAutoScaleVmProfilesParams) SetServiceofferingid(v string) {
 	p.p["serviceofferingid"] = v
 }
 
+func (p *ListAutoScaleVmProfilesParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1796,6 +3955,15 @@ func (p *ListAutoScaleVmProfilesParams) SetTemplateid(v string) {
 	p.p["templateid"] = v
 }
 
+func (p *ListAutoScaleVmProfilesParams) ResetTemplateid() {
+	delete(p.p, "templateid")
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetTemplateid() (string, bool) {
+	value, ok := p.p["templateid"].(string)
+	return value, ok
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1803,6 +3971,15 @@ func (p *ListAutoScaleVmProfilesParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListAutoScaleVmProfilesParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListAutoScaleVmProfilesParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewListAutoScaleVmProfilesParams() *ListAutoScaleVmProfilesParams {
@@ -1813,6 +3990,11 @@ func (s *AutoScaleService) NewListAutoScaleVmProfilesParams() *ListAutoScaleVmPr
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScaleVmProfileByID(id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error) {
+	return s.GetAutoScaleVmProfileByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetAutoScaleVmProfileByIDWithContext is the same as GetAutoScaleVmProfileByID, but uses ctx to cancel the request
+func (s *AutoScaleService) GetAutoScaleVmProfileByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error) {
 	p := &ListAutoScaleVmProfilesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1824,18 +4006,16 @@ func (s *AutoScaleService) GetAutoScaleVmProfileByID(id string, opts ...OptionFu
 		}
 	}
 
-	l, err := s.ListAutoScaleVmProfiles(p)
+	l, err := s.ListAutoScaleVmProfilesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1846,7 +4026,16 @@ func (s *AutoScaleService) GetAutoScaleVmProfileByID(id string, opts ...OptionFu
 
 // Lists autoscale vm profiles.
 func (s *AutoScaleService) ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScaleVmProfiles", p.toURLValues())
+	return s.ListAutoScaleVmProfilesWithContext(context.Background(), p)
+}
+
+// ListAutoScaleVmProfilesWithContext is the same as ListAutoScaleVmProfiles, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) ListAutoScaleVmProfilesWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listAutoScaleVmProfiles", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1859,6 +4048,92 @@ func (s *AutoScaleService) ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesPar
 	return &r, nil
 }
 
+// ListAutoScaleVmProfilesPager iterates over all pages of a ListAutoScaleVmProfiles call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListAutoScaleVmProfilesPager struct {
+	pager
+
+	s *AutoScaleService
+	p *ListAutoScaleVmProfilesParams
+	r *ListAutoScaleVmProfilesResponse
+}
+
+// NewListAutoScaleVmProfilesPager returns a pager for all pages of a ListAutoScaleVmProfiles call with the given params
+func (s *AutoScaleService) NewListAutoScaleVmProfilesPager(p *ListAutoScaleVmProfilesParams) *ListAutoScaleVmProfilesPager {
+	return &ListAutoScaleVmProfilesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListAutoScaleVmProfilesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListAutoScaleVmProfilesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListAutoScaleVmProfilesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListAutoScaleVmProfilesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.AutoScaleVmProfiles), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListAutoScaleVmProfilesPager) Page() *ListAutoScaleVmProfilesResponse {
+	return pg.r
+}
+
+// ListAutoScaleVmProfilesAll fetches all pages of a ListAutoScaleVmProfiles call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *AutoScaleService) ListAutoScaleVmProfilesAll(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
+	return s.ListAutoScaleVmProfilesAllWithContext(context.Background(), p)
+}
+
+// ListAutoScaleVmProfilesAllWithContext is the same as ListAutoScaleVmProfilesAll, but uses ctx to cancel the requests
+func (s *AutoScaleService) ListAutoScaleVmProfilesAllWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*AutoScaleVmProfile)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListAutoScaleVmProfilesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListAutoScaleVmProfilesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.AutoScaleVmProfiles
+		mu.Unlock()
+
+		return len(l.AutoScaleVmProfiles), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListAutoScaleVmProfilesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.AutoScaleVmProfiles = append(r.AutoScaleVmProfiles, pages[page]...)
+	}
+	r.Count = len(r.AutoScaleVmProfiles)
+
+	return r, nil
+}
+
 type ListAutoScaleVmProfilesResponse struct {
 	Count               int                   `json:"count"`
 	AutoScaleVmProfiles []*AutoScaleVmProfile `json:"autoscalevmprofile"`
@@ -1886,7 +4161,8 @@ type ListConditionsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListConditionsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListConditionsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1928,6 +4204,57 @@ func (p *ListConditionsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListConditionsParams) Validate() error {
+	v := newParamValidator("listConditions", p.p)
+	v.ids("counterid", "domainid", "id", "policyid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListConditionsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListConditionsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "counterid", "domainid", "id", "keyword", "policyid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listConditions", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listConditions: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListConditionsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1935,6 +4262,15 @@ func (p *ListConditionsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListConditionsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListConditionsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListConditionsParams) SetCounterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1942,6 +4278,15 @@ func (p *ListConditionsParams) SetCounterid(v string) {
 	p.p["counterid"] = v
 }
 
+func (p *ListConditionsParams) ResetCounterid() {
+	delete(p.p, "counterid")
+}
+
+func (p *ListConditionsParams) GetCounterid() (string, bool) {
+	value, ok := p.p["counterid"].(string)
+	return value, ok
+}
+
 func (p *ListConditionsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1949,6 +4294,15 @@ func (p *ListConditionsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListConditionsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListConditionsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListConditionsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1956,6 +4310,15 @@ func (p *ListConditionsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListConditionsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListConditionsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListConditionsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1963,6 +4326,15 @@ func (p *ListConditionsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListConditionsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListConditionsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListConditionsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1970,6 +4342,15 @@ func (p *ListConditionsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListConditionsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListConditionsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListConditionsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1977,6 +4358,15 @@ func (p *ListConditionsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListConditionsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListConditionsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListConditionsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1984,6 +4374,15 @@ func (p *ListConditionsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListConditionsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListConditionsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListConditionsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1991,6 +4390,15 @@ func (p *ListConditionsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListConditionsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListConditionsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListConditionsParams) SetPolicyid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1998,6 +4406,15 @@ func (p *ListConditionsParams) SetPolicyid(v string) {
 	p.p["policyid"] = v
 }
 
+func (p *ListConditionsParams) ResetPolicyid() {
+	delete(p.p, "policyid")
+}
+
+func (p *ListConditionsParams) GetPolicyid() (string, bool) {
+	value, ok := p.p["policyid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListConditionsParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewListConditionsParams() *ListConditionsParams {
@@ -2008,6 +4425,11 @@ func (s *AutoScaleService) NewListConditionsParams() *ListConditionsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetConditionByID(id string, opts ...OptionFunc) (*Condition, int, error) {
+	return s.GetConditionByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetConditionByIDWithContext is the same as GetConditionByID, but uses ctx to cancel the request
+func (s *AutoScaleService) GetConditionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Condition, int, error) {
 	p := &ListConditionsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2019,18 +4441,16 @@ func (s *AutoScaleService) GetConditionByID(id string, opts ...OptionFunc) (*Con
 		}
 	}
 
-	l, err := s.ListConditions(p)
+	l, err := s.ListConditionsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -2041,7 +4461,16 @@ func (s *AutoScaleService) GetConditionByID(id string, opts ...OptionFunc) (*Con
 
 // List Conditions for the specific user
 func (s *AutoScaleService) ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error) {
-	resp, err := s.cs.newRequest("listConditions", p.toURLValues())
+	return s.ListConditionsWithContext(context.Background(), p)
+}
+
+// ListConditionsWithContext is the same as ListConditions, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) ListConditionsWithContext(ctx context.Context, p *ListConditionsParams) (*ListConditionsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listConditions", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2054,6 +4483,92 @@ func (s *AutoScaleService) ListConditions(p *ListConditionsParams) (*ListConditi
 	return &r, nil
 }
 
+// ListConditionsPager iterates over all pages of a ListConditions call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListConditionsPager struct {
+	pager
+
+	s *AutoScaleService
+	p *ListConditionsParams
+	r *ListConditionsResponse
+}
+
+// NewListConditionsPager returns a pager for all pages of a ListConditions call with the given params
+func (s *AutoScaleService) NewListConditionsPager(p *ListConditionsParams) *ListConditionsPager {
+	return &ListConditionsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListConditionsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListConditionsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListConditionsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListConditionsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Conditions), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListConditionsPager) Page() *ListConditionsResponse {
+	return pg.r
+}
+
+// ListConditionsAll fetches all pages of a ListConditions call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *AutoScaleService) ListConditionsAll(p *ListConditionsParams) (*ListConditionsResponse, error) {
+	return s.ListConditionsAllWithContext(context.Background(), p)
+}
+
+// ListConditionsAllWithContext is the same as ListConditionsAll, but uses ctx to cancel the requests
+func (s *AutoScaleService) ListConditionsAllWithContext(ctx context.Context, p *ListConditionsParams) (*ListConditionsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Condition)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListConditionsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListConditionsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Conditions
+		mu.Unlock()
+
+		return len(l.Conditions), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListConditionsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Conditions = append(r.Conditions, pages[page]...)
+	}
+	r.Count = len(r.Conditions)
+
+	return r, nil
+}
+
 type ListConditionsResponse struct {
 	Count      int          `json:"count"`
 	Conditions []*Condition `json:"condition"`
@@ -2078,7 +4593,8 @@ type ListCountersParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListCountersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListCountersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2100,10 +4616,58 @@ func (p *ListCountersParams) toURLValues() url.Values {
 		vv := strconv.Itoa(v.(int))
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["source"]; found {
-		u.Set("source", v.(string))
+	if v, found := p.p["source"]; found {
+		u.Set("source", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListCountersParams) Validate() error {
+	v := newParamValidator("listCounters", p.p)
+	v.ids("id")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("source", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListCountersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListCountersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	return u
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword", "name", "source":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listCounters", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listCounters: %w", k, err)
+		}
+	}
+	return nil
 }
 
 func (p *ListCountersParams) SetId(v string) {
@@ -2113,6 +4677,15 @@ func (p *ListCountersParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListCountersParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListCountersParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListCountersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2120,6 +4693,15 @@ func (p *ListCountersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListCountersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListCountersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListCountersParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2127,6 +4709,15 @@ func (p *ListCountersParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListCountersParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListCountersParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListCountersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2134,6 +4725,15 @@ func (p *ListCountersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListCountersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListCountersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListCountersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2141,6 +4741,15 @@ func (p *ListCountersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListCountersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListCountersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListCountersParams) SetSource(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2148,6 +4757,15 @@ func (p *ListCountersParams) SetSource(v string) {
 	p.p["source"] = v
 }
 
+func (p *ListCountersParams) ResetSource() {
+	delete(p.p, "source")
+}
+
+func (p *ListCountersParams) GetSource() (string, bool) {
+	value, ok := p.p["source"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListCountersParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewListCountersParams() *ListCountersParams {
@@ -2158,6 +4776,11 @@ func (s *AutoScaleService) NewListCountersParams() *ListCountersParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetCounterID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetCounterIDWithContext(context.Background(), name, opts...)
+}
+
+// GetCounterIDWithContext is the same as GetCounterID, but uses ctx to cancel the request
+func (s *AutoScaleService) GetCounterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListCountersParams{}
 	p.p = make(map[string]interface{})
 
@@ -2169,7 +4792,7 @@ func (s *AutoScaleService) GetCounterID(name string, opts ...OptionFunc) (string
 		}
 	}
 
-	l, err := s.ListCounters(p)
+	l, err := s.ListCountersWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2194,12 +4817,17 @@ func (s *AutoScaleService) GetCounterID(name string, opts ...OptionFunc) (string
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetCounterByName(name string, opts ...OptionFunc) (*Counter, int, error) {
-	id, count, err := s.GetCounterID(name, opts...)
+	return s.GetCounterByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetCounterByNameWithContext is the same as GetCounterByName, but uses ctx to cancel the requests
+func (s *AutoScaleService) GetCounterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Counter, int, error) {
+	id, count, err := s.GetCounterIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetCounterByID(id, opts...)
+	r, count, err := s.GetCounterByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2208,6 +4836,11 @@ func (s *AutoScaleService) GetCounterByName(name string, opts ...OptionFunc) (*C
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetCounterByID(id string, opts ...OptionFunc) (*Counter, int, error) {
+	return s.GetCounterByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetCounterByIDWithContext is the same as GetCounterByID, but uses ctx to cancel the request
+func (s *AutoScaleService) GetCounterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Counter, int, error) {
 	p := &ListCountersParams{}
 	p.p = make(map[string]interface{})
 
@@ -2219,18 +4852,16 @@ func (s *AutoScaleService) GetCounterByID(id string, opts ...OptionFunc) (*Count
 		}
 	}
 
-	l, err := s.ListCounters(p)
+	l, err := s.ListCountersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -2241,7 +4872,16 @@ func (s *AutoScaleService) GetCounterByID(id string, opts ...OptionFunc) (*Count
 
 // List the counters
 func (s *AutoScaleService) ListCounters(p *ListCountersParams) (*ListCountersResponse, error) {
-	resp, err := s.cs.newRequest("listCounters", p.toURLValues())
+	return s.ListCountersWithContext(context.Background(), p)
+}
+
+// ListCountersWithContext is the same as ListCounters, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) ListCountersWithContext(ctx context.Context, p *ListCountersParams) (*ListCountersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listCounters", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2254,6 +4894,92 @@ func (s *AutoScaleService) ListCounters(p *ListCountersParams) (*ListCountersRes
 	return &r, nil
 }
 
+// ListCountersPager iterates over all pages of a ListCounters call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListCountersPager struct {
+	pager
+
+	s *AutoScaleService
+	p *ListCountersParams
+	r *ListCountersResponse
+}
+
+// NewListCountersPager returns a pager for all pages of a ListCounters call with the given params
+func (s *AutoScaleService) NewListCountersPager(p *ListCountersParams) *ListCountersPager {
+	return &ListCountersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListCountersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListCountersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListCountersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListCountersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Counters), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListCountersPager) Page() *ListCountersResponse {
+	return pg.r
+}
+
+// ListCountersAll fetches all pages of a ListCounters call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *AutoScaleService) ListCountersAll(p *ListCountersParams) (*ListCountersResponse, error) {
+	return s.ListCountersAllWithContext(context.Background(), p)
+}
+
+// ListCountersAllWithContext is the same as ListCountersAll, but uses ctx to cancel the requests
+func (s *AutoScaleService) ListCountersAllWithContext(ctx context.Context, p *ListCountersParams) (*ListCountersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Counter)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListCountersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListCountersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Counters
+		mu.Unlock()
+
+		return len(l.Counters), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListCountersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Counters = append(r.Counters, pages[page]...)
+	}
+	r.Count = len(r.Counters)
+
+	return r, nil
+}
+
 type ListCountersResponse struct {
 	Count    int        `json:"count"`
 	Counters []*Counter `json:"counter"`
@@ -2273,7 +4999,8 @@ type UpdateAutoScalePolicyParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateAutoScalePolicyParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateAutoScalePolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2296,6 +5023,56 @@ func (p *UpdateAutoScalePolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateAutoScalePolicyParams) Validate() error {
+	v := newParamValidator("updateAutoScalePolicy", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateAutoScalePolicyParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateAutoScalePolicyParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "conditionids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "duration", "quiettime":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateAutoScalePolicy", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateAutoScalePolicy: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateAutoScalePolicyParams) SetConditionids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2303,6 +5080,15 @@ func (p *UpdateAutoScalePolicyParams) SetConditionids(v []string) {
 	p.p["conditionids"] = v
 }
 
+func (p *UpdateAutoScalePolicyParams) ResetConditionids() {
+	delete(p.p, "conditionids")
+}
+
+func (p *UpdateAutoScalePolicyParams) GetConditionids() ([]string, bool) {
+	value, ok := p.p["conditionids"].([]string)
+	return value, ok
+}
+
 func (p *UpdateAutoScalePolicyParams) SetDuration(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2310,6 +5096,15 @@ func (p *UpdateAutoScalePolicyParams) SetDuration(v int) {
 	p.p["duration"] = v
 }
 
+func (p *UpdateAutoScalePolicyParams) ResetDuration() {
+	delete(p.p, "duration")
+}
+
+func (p *UpdateAutoScalePolicyParams) GetDuration() (int, bool) {
+	value, ok := p.p["duration"].(int)
+	return value, ok
+}
+
 func (p *UpdateAutoScalePolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2317,6 +5112,15 @@ func (p *UpdateAutoScalePolicyParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateAutoScalePolicyParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateAutoScalePolicyParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateAutoScalePolicyParams) SetQuiettime(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2324,6 +5128,15 @@ func (p *UpdateAutoScalePolicyParams) SetQuiettime(v int) {
 	p.p["quiettime"] = v
 }
 
+func (p *UpdateAutoScalePolicyParams) ResetQuiettime() {
+	delete(p.p, "quiettime")
+}
+
+func (p *UpdateAutoScalePolicyParams) GetQuiettime() (int, bool) {
+	value, ok := p.p["quiettime"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateAutoScalePolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewUpdateAutoScalePolicyParams(id string) *UpdateAutoScalePolicyParams {
@@ -2335,7 +5148,16 @@ func (s *AutoScaleService) NewUpdateAutoScalePolicyParams(id string) *UpdateAuto
 
 // Updates an existing autoscale policy.
 func (s *AutoScaleService) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateAutoScalePolicy", p.toURLValues())
+	return s.UpdateAutoScalePolicyWithContext(context.Background(), p)
+}
+
+// UpdateAutoScalePolicyWithContext is the same as UpdateAutoScalePolicy, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) UpdateAutoScalePolicyWithContext(ctx context.Context, p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateAutoScalePolicy", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2347,7 +5169,7 @@ func (s *AutoScaleService) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2368,6 +5190,40 @@ func (s *AutoScaleService) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams)
 	return &r, nil
 }
 
+// UpdateAutoScalePolicyAsync starts the async job for UpdateAutoScalePolicy and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateAutoScalePolicyResponse using Job.Result
+func (s *AutoScaleService) UpdateAutoScalePolicyAsync(p *UpdateAutoScalePolicyParams) (*Job, error) {
+	return s.UpdateAutoScalePolicyAsyncWithContext(context.Background(), p)
+}
+
+// UpdateAutoScalePolicyAsyncWithContext is the same as UpdateAutoScalePolicyAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) UpdateAutoScalePolicyAsyncWithContext(ctx context.Context, p *UpdateAutoScalePolicyParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateAutoScalePolicy", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateAutoScalePolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateAutoScalePolicyResponse struct {
 	Account    string   `json:"account"`
 	Action     string   `json:"action"`
@@ -2387,7 +5243,8 @@ type UpdateAutoScaleVmGroupParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateAutoScaleVmGroupParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateAutoScaleVmGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2425,6 +5282,61 @@ func (p *UpdateAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateAutoScaleVmGroupParams) Validate() error {
+	v := newParamValidator("updateAutoScaleVmGroup", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("customid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "interval", "maxmembers", "minmembers":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "scaledownpolicyids", "scaleuppolicyids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateAutoScaleVmGroup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateAutoScaleVmGroup: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2432,6 +5344,15 @@ func (p *UpdateAutoScaleVmGroupParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateAutoScaleVmGroupParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateAutoScaleVmGroupParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2439,6 +5360,15 @@ func (p *UpdateAutoScaleVmGroupParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateAutoScaleVmGroupParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateAutoScaleVmGroupParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2446,6 +5376,15 @@ func (p *UpdateAutoScaleVmGroupParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateAutoScaleVmGroupParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateAutoScaleVmGroupParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetInterval(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2453,6 +5392,15 @@ func (p *UpdateAutoScaleVmGroupParams) SetInterval(v int) {
 	p.p["interval"] = v
 }
 
+func (p *UpdateAutoScaleVmGroupParams) ResetInterval() {
+	delete(p.p, "interval")
+}
+
+func (p *UpdateAutoScaleVmGroupParams) GetInterval() (int, bool) {
+	value, ok := p.p["interval"].(int)
+	return value, ok
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetMaxmembers(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2460,6 +5408,15 @@ func (p *UpdateAutoScaleVmGroupParams) SetMaxmembers(v int) {
 	p.p["maxmembers"] = v
 }
 
+func (p *UpdateAutoScaleVmGroupParams) ResetMaxmembers() {
+	delete(p.p, "maxmembers")
+}
+
+func (p *UpdateAutoScaleVmGroupParams) GetMaxmembers() (int, bool) {
+	value, ok := p.p["maxmembers"].(int)
+	return value, ok
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetMinmembers(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2467,6 +5424,15 @@ func (p *UpdateAutoScaleVmGroupParams) SetMinmembers(v int) {
 	p.p["minmembers"] = v
 }
 
+func (p *UpdateAutoScaleVmGroupParams) ResetMinmembers() {
+	delete(p.p, "minmembers")
+}
+
+func (p *UpdateAutoScaleVmGroupParams) GetMinmembers() (int, bool) {
+	value, ok := p.p["minmembers"].(int)
+	return value, ok
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetScaledownpolicyids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2474,6 +5440,15 @@ func (p *UpdateAutoScaleVmGroupParams) SetScaledownpolicyids(v []string) {
 	p.p["scaledownpolicyids"] = v
 }
 
+func (p *UpdateAutoScaleVmGroupParams) ResetScaledownpolicyids() {
+	delete(p.p, "scaledownpolicyids")
+}
+
+func (p *UpdateAutoScaleVmGroupParams) GetScaledownpolicyids() ([]string, bool) {
+	value, ok := p.p["scaledownpolicyids"].([]string)
+	return value, ok
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetScaleuppolicyids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2481,6 +5456,15 @@ func (p *UpdateAutoScaleVmGroupParams) SetScaleuppolicyids(v []string) {
 	p.p["scaleuppolicyids"] = v
 }
 
+func (p *UpdateAutoScaleVmGroupParams) ResetScaleuppolicyids() {
+	delete(p.p, "scaleuppolicyids")
+}
+
+func (p *UpdateAutoScaleVmGroupParams) GetScaleuppolicyids() ([]string, bool) {
+	value, ok := p.p["scaleuppolicyids"].([]string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateAutoScaleVmGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewUpdateAutoScaleVmGroupParams(id string) *UpdateAutoScaleVmGroupParams {
@@ -2492,7 +5476,16 @@ func (s *AutoScaleService) NewUpdateAutoScaleVmGroupParams(id string) *UpdateAut
 
 // Updates an existing autoscale vm group.
 func (s *AutoScaleService) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("updateAutoScaleVmGroup", p.toURLValues())
+	return s.UpdateAutoScaleVmGroupWithContext(context.Background(), p)
+}
+
+// UpdateAutoScaleVmGroupWithContext is the same as UpdateAutoScaleVmGroup, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) UpdateAutoScaleVmGroupWithContext(ctx context.Context, p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateAutoScaleVmGroup", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2504,7 +5497,7 @@ func (s *AutoScaleService) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2525,6 +5518,40 @@ func (s *AutoScaleService) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParam
 	return &r, nil
 }
 
+// UpdateAutoScaleVmGroupAsync starts the async job for UpdateAutoScaleVmGroup and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateAutoScaleVmGroupResponse using Job.Result
+func (s *AutoScaleService) UpdateAutoScaleVmGroupAsync(p *UpdateAutoScaleVmGroupParams) (*Job, error) {
+	return s.UpdateAutoScaleVmGroupAsyncWithContext(context.Background(), p)
+}
+
+// UpdateAutoScaleVmGroupAsyncWithContext is the same as UpdateAutoScaleVmGroupAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) UpdateAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *UpdateAutoScaleVmGroupParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateAutoScaleVmGroup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateAutoScaleVmGroupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateAutoScaleVmGroupResponse struct {
 	Account           string   `json:"account"`
 	Domain            string   `json:"domain"`
@@ -2549,7 +5576,8 @@ type UpdateAutoScaleVmProfileParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateAutoScaleVmProfileParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateAutoScaleVmProfileParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2558,10 +5586,13 @@ func (p *UpdateAutoScaleVmProfileParams) toURLValues() url.Values {
 		u.Set("autoscaleuserid", v.(string))
 	}
 	if v, found := p.p["counterparam"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("counterparam[%d].key", i), k)
-			u.Set(fmt.Sprintf("counterparam[%d].value", i), m[k])
+		for i, item := range v.([]CounterParam) {
+			if item.Name != "" {
+				u.Set(fmt.Sprintf("counterparam[%d].name", i), item.Name)
+			}
+			if item.Value != "" {
+				u.Set(fmt.Sprintf("counterparam[%d].value", i), item.Value)
+			}
 		}
 	}
 	if v, found := p.p["customid"]; found {
@@ -2584,6 +5615,61 @@ func (p *UpdateAutoScaleVmProfileParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateAutoScaleVmProfileParams) Validate() error {
+	v := newParamValidator("updateAutoScaleVmProfile", p.p)
+	v.required("id")
+	v.ids("autoscaleuserid", "id", "templateid")
+	v.maxLength("customid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateAutoScaleVmProfileParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateAutoScaleVmProfileParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "autoscaleuserid", "customid", "id", "templateid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "counterparam":
+			var v []CounterParam
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "destroyvmgraceperiod":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateAutoScaleVmProfile", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateAutoScaleVmProfile: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2591,13 +5677,31 @@ func (p *UpdateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
 	p.p["autoscaleuserid"] = v
 }
 
-func (p *UpdateAutoScaleVmProfileParams) SetCounterparam(v map[string]string) {
+func (p *UpdateAutoScaleVmProfileParams) ResetAutoscaleuserid() {
+	delete(p.p, "autoscaleuserid")
+}
+
+func (p *UpdateAutoScaleVmProfileParams) GetAutoscaleuserid() (string, bool) {
+	value, ok := p.p["autoscaleuserid"].(string)
+	return value, ok
+}
+
+func (p *UpdateAutoScaleVmProfileParams) SetCounterparam(v []CounterParam) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["counterparam"] = v
 }
 
+func (p *UpdateAutoScaleVmProfileParams) ResetCounterparam() {
+	delete(p.p, "counterparam")
+}
+
+func (p *UpdateAutoScaleVmProfileParams) GetCounterparam() ([]CounterParam, bool) {
+	value, ok := p.p["counterparam"].([]CounterParam)
+	return value, ok
+}
+
 func (p *UpdateAutoScaleVmProfileParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2605,6 +5709,15 @@ func (p *UpdateAutoScaleVmProfileParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateAutoScaleVmProfileParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateAutoScaleVmProfileParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateAutoScaleVmProfileParams) SetDestroyvmgraceperiod(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2612,6 +5725,15 @@ func (p *UpdateAutoScaleVmProfileParams) SetDestroyvmgraceperiod(v int) {
 	p.p["destroyvmgraceperiod"] = v
 }
 
+func (p *UpdateAutoScaleVmProfileParams) ResetDestroyvmgraceperiod() {
+	delete(p.p, "destroyvmgraceperiod")
+}
+
+func (p *UpdateAutoScaleVmProfileParams) GetDestroyvmgraceperiod() (int, bool) {
+	value, ok := p.p["destroyvmgraceperiod"].(int)
+	return value, ok
+}
+
 func (p *UpdateAutoScaleVmProfileParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2619,6 +5741,15 @@ func (p *UpdateAutoScaleVmProfileParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateAutoScaleVmProfileParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateAutoScaleVmProfileParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateAutoScaleVmProfileParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2626,6 +5757,15 @@ func (p *UpdateAutoScaleVmProfileParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateAutoScaleVmProfileParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateAutoScaleVmProfileParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateAutoScaleVmProfileParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2633,6 +5773,15 @@ func (p *UpdateAutoScaleVmProfileParams) SetTemplateid(v string) {
 	p.p["templateid"] = v
 }
 
+func (p *UpdateAutoScaleVmProfileParams) ResetTemplateid() {
+	delete(p.p, "templateid")
+}
+
+func (p *UpdateAutoScaleVmProfileParams) GetTemplateid() (string, bool) {
+	value, ok := p.p["templateid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateAutoScaleVmProfileParams instance,
 // as then you are sure you have configured all required params
 func (s *AutoScaleService) NewUpdateAutoScaleVmProfileParams(id string) *UpdateAutoScaleVmProfileParams {
@@ -2644,7 +5793,16 @@ func (s *AutoScaleService) NewUpdateAutoScaleVmProfileParams(id string) *UpdateA
 
 // Updates an existing autoscale vm profile.
 func (s *AutoScaleService) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newRequest("updateAutoScaleVmProfile", p.toURLValues())
+	return s.UpdateAutoScaleVmProfileWithContext(context.Background(), p)
+}
+
+// UpdateAutoScaleVmProfileWithContext is the same as UpdateAutoScaleVmProfile, but uses ctx to cancel the request and any async job polling
+func (s *AutoScaleService) UpdateAutoScaleVmProfileWithContext(ctx context.Context, p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateAutoScaleVmProfile", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2656,7 +5814,7 @@ func (s *AutoScaleService) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2677,6 +5835,40 @@ func (s *AutoScaleService) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileP
 	return &r, nil
 }
 
+// UpdateAutoScaleVmProfileAsync starts the async job for UpdateAutoScaleVmProfile and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateAutoScaleVmProfileResponse using Job.Result
+func (s *AutoScaleService) UpdateAutoScaleVmProfileAsync(p *UpdateAutoScaleVmProfileParams) (*Job, error) {
+	return s.UpdateAutoScaleVmProfileAsyncWithContext(context.Background(), p)
+}
+
+// UpdateAutoScaleVmProfileAsyncWithContext is the same as UpdateAutoScaleVmProfileAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *AutoScaleService) UpdateAutoScaleVmProfileAsyncWithContext(ctx context.Context, p *UpdateAutoScaleVmProfileParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateAutoScaleVmProfile", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateAutoScaleVmProfileResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateAutoScaleVmProfileResponse struct {
 	Account              string `json:"account"`
 	Autoscaleuserid      string `json:"autoscaleuserid"`
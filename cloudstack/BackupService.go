@@ -0,0 +1,3488 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// BackupServiceIface is the interface implemented by BackupService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type BackupServiceIface interface {
+	NewAssignVirtualMachineToBackupOfferingParams(backupofferingid string, virtualmachineid string) *AssignVirtualMachineToBackupOfferingParams
+	AssignVirtualMachineToBackupOffering(p *AssignVirtualMachineToBackupOfferingParams) (*AssignVirtualMachineToBackupOfferingResponse, error)
+	AssignVirtualMachineToBackupOfferingWithContext(ctx context.Context, p *AssignVirtualMachineToBackupOfferingParams) (*AssignVirtualMachineToBackupOfferingResponse, error)
+	AssignVirtualMachineToBackupOfferingAsync(p *AssignVirtualMachineToBackupOfferingParams) (*Job, error)
+	AssignVirtualMachineToBackupOfferingAsyncWithContext(ctx context.Context, p *AssignVirtualMachineToBackupOfferingParams) (*Job, error)
+	NewCreateBackupParams(virtualmachineid string) *CreateBackupParams
+	CreateBackup(p *CreateBackupParams) (*CreateBackupResponse, error)
+	CreateBackupWithContext(ctx context.Context, p *CreateBackupParams) (*CreateBackupResponse, error)
+	CreateBackupAsync(p *CreateBackupParams) (*Job, error)
+	CreateBackupAsyncWithContext(ctx context.Context, p *CreateBackupParams) (*Job, error)
+	NewCreateBackupScheduleParams(intervaltype string, schedule string, timezone string, virtualmachineid string) *CreateBackupScheduleParams
+	CreateBackupSchedule(p *CreateBackupScheduleParams) (*CreateBackupScheduleResponse, error)
+	CreateBackupScheduleWithContext(ctx context.Context, p *CreateBackupScheduleParams) (*CreateBackupScheduleResponse, error)
+	NewDeleteBackupParams(id string) *DeleteBackupParams
+	DeleteBackup(p *DeleteBackupParams) (*DeleteBackupResponse, error)
+	DeleteBackupWithContext(ctx context.Context, p *DeleteBackupParams) (*DeleteBackupResponse, error)
+	DeleteBackupAsync(p *DeleteBackupParams) (*Job, error)
+	DeleteBackupAsyncWithContext(ctx context.Context, p *DeleteBackupParams) (*Job, error)
+	NewDeleteBackupOfferingParams(id string) *DeleteBackupOfferingParams
+	DeleteBackupOffering(p *DeleteBackupOfferingParams) (*DeleteBackupOfferingResponse, error)
+	DeleteBackupOfferingWithContext(ctx context.Context, p *DeleteBackupOfferingParams) (*DeleteBackupOfferingResponse, error)
+	NewDeleteBackupScheduleParams(virtualmachineid string) *DeleteBackupScheduleParams
+	DeleteBackupSchedule(p *DeleteBackupScheduleParams) (*DeleteBackupScheduleResponse, error)
+	DeleteBackupScheduleWithContext(ctx context.Context, p *DeleteBackupScheduleParams) (*DeleteBackupScheduleResponse, error)
+	NewImportBackupOfferingParams(allowuserdrivenbackups bool, description string, externalid string, name string, zoneid string) *ImportBackupOfferingParams
+	ImportBackupOffering(p *ImportBackupOfferingParams) (*ImportBackupOfferingResponse, error)
+	ImportBackupOfferingWithContext(ctx context.Context, p *ImportBackupOfferingParams) (*ImportBackupOfferingResponse, error)
+	ImportBackupOfferingAsync(p *ImportBackupOfferingParams) (*Job, error)
+	ImportBackupOfferingAsyncWithContext(ctx context.Context, p *ImportBackupOfferingParams) (*Job, error)
+	NewListBackupOfferingsParams() *ListBackupOfferingsParams
+	GetBackupOfferingID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetBackupOfferingIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
+	GetBackupOfferingByName(name string, opts ...OptionFunc) (*BackupOffering, int, error)
+	GetBackupOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*BackupOffering, int, error)
+	GetBackupOfferingByID(id string, opts ...OptionFunc) (*BackupOffering, int, error)
+	GetBackupOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*BackupOffering, int, error)
+	ListBackupOfferings(p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error)
+	ListBackupOfferingsWithContext(ctx context.Context, p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error)
+	NewListBackupOfferingsPager(p *ListBackupOfferingsParams) *ListBackupOfferingsPager
+	ListBackupOfferingsAll(p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error)
+	ListBackupOfferingsAllWithContext(ctx context.Context, p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error)
+	NewListBackupProviderOfferingsParams(zoneid string) *ListBackupProviderOfferingsParams
+	GetBackupProviderOfferingID(keyword string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetBackupProviderOfferingIDWithContext(ctx context.Context, keyword string, zoneid string, opts ...OptionFunc) (string, int, error)
+	ListBackupProviderOfferings(p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error)
+	ListBackupProviderOfferingsWithContext(ctx context.Context, p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error)
+	NewListBackupProviderOfferingsPager(p *ListBackupProviderOfferingsParams) *ListBackupProviderOfferingsPager
+	ListBackupProviderOfferingsAll(p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error)
+	ListBackupProviderOfferingsAllWithContext(ctx context.Context, p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error)
+	NewListBackupProvidersParams() *ListBackupProvidersParams
+	ListBackupProviders(p *ListBackupProvidersParams) (*ListBackupProvidersResponse, error)
+	ListBackupProvidersWithContext(ctx context.Context, p *ListBackupProvidersParams) (*ListBackupProvidersResponse, error)
+	NewListBackupScheduleParams(virtualmachineid string) *ListBackupScheduleParams
+	ListBackupSchedule(p *ListBackupScheduleParams) (*ListBackupScheduleResponse, error)
+	ListBackupScheduleWithContext(ctx context.Context, p *ListBackupScheduleParams) (*ListBackupScheduleResponse, error)
+	NewListBackupsParams() *ListBackupsParams
+	GetBackupByID(id string, opts ...OptionFunc) (*Backup, int, error)
+	GetBackupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Backup, int, error)
+	ListBackups(p *ListBackupsParams) (*ListBackupsResponse, error)
+	ListBackupsWithContext(ctx context.Context, p *ListBackupsParams) (*ListBackupsResponse, error)
+	NewListBackupsPager(p *ListBackupsParams) *ListBackupsPager
+	ListBackupsAll(p *ListBackupsParams) (*ListBackupsResponse, error)
+	ListBackupsAllWithContext(ctx context.Context, p *ListBackupsParams) (*ListBackupsResponse, error)
+	NewRemoveVirtualMachineFromBackupOfferingParams(virtualmachineid string) *RemoveVirtualMachineFromBackupOfferingParams
+	RemoveVirtualMachineFromBackupOffering(p *RemoveVirtualMachineFromBackupOfferingParams) (*RemoveVirtualMachineFromBackupOfferingResponse, error)
+	RemoveVirtualMachineFromBackupOfferingWithContext(ctx context.Context, p *RemoveVirtualMachineFromBackupOfferingParams) (*RemoveVirtualMachineFromBackupOfferingResponse, error)
+	RemoveVirtualMachineFromBackupOfferingAsync(p *RemoveVirtualMachineFromBackupOfferingParams) (*Job, error)
+	RemoveVirtualMachineFromBackupOfferingAsyncWithContext(ctx context.Context, p *RemoveVirtualMachineFromBackupOfferingParams) (*Job, error)
+	NewRestoreBackupParams(id string) *RestoreBackupParams
+	RestoreBackup(p *RestoreBackupParams) (*RestoreBackupResponse, error)
+	RestoreBackupWithContext(ctx context.Context, p *RestoreBackupParams) (*RestoreBackupResponse, error)
+	RestoreBackupAsync(p *RestoreBackupParams) (*Job, error)
+	RestoreBackupAsyncWithContext(ctx context.Context, p *RestoreBackupParams) (*Job, error)
+	NewRestoreVolumeFromBackupAndAttachToVMParams(backupid string, virtualmachineid string, volumeid string) *RestoreVolumeFromBackupAndAttachToVMParams
+	RestoreVolumeFromBackupAndAttachToVM(p *RestoreVolumeFromBackupAndAttachToVMParams) (*RestoreVolumeFromBackupAndAttachToVMResponse, error)
+	RestoreVolumeFromBackupAndAttachToVMWithContext(ctx context.Context, p *RestoreVolumeFromBackupAndAttachToVMParams) (*RestoreVolumeFromBackupAndAttachToVMResponse, error)
+	RestoreVolumeFromBackupAndAttachToVMAsync(p *RestoreVolumeFromBackupAndAttachToVMParams) (*Job, error)
+	RestoreVolumeFromBackupAndAttachToVMAsyncWithContext(ctx context.Context, p *RestoreVolumeFromBackupAndAttachToVMParams) (*Job, error)
+	NewUpdateBackupScheduleParams(intervaltype string, schedule string, timezone string, virtualmachineid string) *UpdateBackupScheduleParams
+	UpdateBackupSchedule(p *UpdateBackupScheduleParams) (*UpdateBackupScheduleResponse, error)
+	UpdateBackupScheduleWithContext(ctx context.Context, p *UpdateBackupScheduleParams) (*UpdateBackupScheduleResponse, error)
+}
+
+type AssignVirtualMachineToBackupOfferingParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AssignVirtualMachineToBackupOfferingParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["backupofferingid"]; found {
+		u.Set("backupofferingid", v.(string))
+	}
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AssignVirtualMachineToBackupOfferingParams) Validate() error {
+	v := newParamValidator("assignVirtualMachineToBackupOffering", p.p)
+	v.required("backupofferingid", "virtualmachineid")
+	v.ids("backupofferingid", "virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AssignVirtualMachineToBackupOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AssignVirtualMachineToBackupOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "backupofferingid", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for assignVirtualMachineToBackupOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of assignVirtualMachineToBackupOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *AssignVirtualMachineToBackupOfferingParams) SetBackupofferingid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["backupofferingid"] = v
+}
+
+func (p *AssignVirtualMachineToBackupOfferingParams) ResetBackupofferingid() {
+	delete(p.p, "backupofferingid")
+}
+
+func (p *AssignVirtualMachineToBackupOfferingParams) GetBackupofferingid() (string, bool) {
+	value, ok := p.p["backupofferingid"].(string)
+	return value, ok
+}
+
+func (p *AssignVirtualMachineToBackupOfferingParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+}
+
+func (p *AssignVirtualMachineToBackupOfferingParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *AssignVirtualMachineToBackupOfferingParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AssignVirtualMachineToBackupOfferingParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewAssignVirtualMachineToBackupOfferingParams(backupofferingid string, virtualmachineid string) *AssignVirtualMachineToBackupOfferingParams {
+	p := &AssignVirtualMachineToBackupOfferingParams{}
+	p.p = make(map[string]interface{})
+	p.p["backupofferingid"] = backupofferingid
+	p.p["virtualmachineid"] = virtualmachineid
+	return p
+}
+
+// Assigns a VM to a backup offering
+func (s *BackupService) AssignVirtualMachineToBackupOffering(p *AssignVirtualMachineToBackupOfferingParams) (*AssignVirtualMachineToBackupOfferingResponse, error) {
+	return s.AssignVirtualMachineToBackupOfferingWithContext(context.Background(), p)
+}
+
+// AssignVirtualMachineToBackupOfferingWithContext is the same as AssignVirtualMachineToBackupOffering, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) AssignVirtualMachineToBackupOfferingWithContext(ctx context.Context, p *AssignVirtualMachineToBackupOfferingParams) (*AssignVirtualMachineToBackupOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "assignVirtualMachineToBackupOffering", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AssignVirtualMachineToBackupOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// AssignVirtualMachineToBackupOfferingAsync starts the async job for AssignVirtualMachineToBackupOffering and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AssignVirtualMachineToBackupOfferingResponse using Job.Result
+func (s *BackupService) AssignVirtualMachineToBackupOfferingAsync(p *AssignVirtualMachineToBackupOfferingParams) (*Job, error) {
+	return s.AssignVirtualMachineToBackupOfferingAsyncWithContext(context.Background(), p)
+}
+
+// AssignVirtualMachineToBackupOfferingAsyncWithContext is the same as AssignVirtualMachineToBackupOfferingAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BackupService) AssignVirtualMachineToBackupOfferingAsyncWithContext(ctx context.Context, p *AssignVirtualMachineToBackupOfferingParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "assignVirtualMachineToBackupOffering", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AssignVirtualMachineToBackupOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type AssignVirtualMachineToBackupOfferingResponse struct {
+	Account            string `json:"account"`
+	Accountid          string `json:"accountid"`
+	Backupofferingid   string `json:"backupofferingid"`
+	Backupofferingname string `json:"backupofferingname"`
+	Created            string `json:"created"`
+	Domain             string `json:"domain"`
+	Domainid           string `json:"domainid"`
+	Externalid         string `json:"externalid"`
+	Id                 string `json:"id"`
+	JobID              string `json:"jobid"`
+	Jobstatus          int    `json:"jobstatus"`
+	Size               int64  `json:"size"`
+	Status             string `json:"status"`
+	Type               string `json:"type"`
+	Virtualmachineid   string `json:"virtualmachineid"`
+	Virtualmachinename string `json:"virtualmachinename"`
+	Virtualsize        int64  `json:"virtualsize"`
+	Volumes            string `json:"volumes"`
+	Zone               string `json:"zone"`
+	Zoneid             string `json:"zoneid"`
+}
+
+type CreateBackupParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateBackupParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateBackupParams) Validate() error {
+	v := newParamValidator("createBackup", p.p)
+	v.required("virtualmachineid")
+	v.ids("virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateBackupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateBackupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createBackup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createBackup: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *CreateBackupParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+}
+
+func (p *CreateBackupParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *CreateBackupParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateBackupParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewCreateBackupParams(virtualmachineid string) *CreateBackupParams {
+	p := &CreateBackupParams{}
+	p.p = make(map[string]interface{})
+	p.p["virtualmachineid"] = virtualmachineid
+	return p
+}
+
+// Create VM backup
+func (s *BackupService) CreateBackup(p *CreateBackupParams) (*CreateBackupResponse, error) {
+	return s.CreateBackupWithContext(context.Background(), p)
+}
+
+// CreateBackupWithContext is the same as CreateBackup, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) CreateBackupWithContext(ctx context.Context, p *CreateBackupParams) (*CreateBackupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createBackup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateBackupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// CreateBackupAsync starts the async job for CreateBackup and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateBackupResponse using Job.Result
+func (s *BackupService) CreateBackupAsync(p *CreateBackupParams) (*Job, error) {
+	return s.CreateBackupAsyncWithContext(context.Background(), p)
+}
+
+// CreateBackupAsyncWithContext is the same as CreateBackupAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BackupService) CreateBackupAsyncWithContext(ctx context.Context, p *CreateBackupParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createBackup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateBackupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type CreateBackupResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type CreateBackupScheduleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateBackupScheduleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["intervaltype"]; found {
+		u.Set("intervaltype", v.(string))
+	}
+	if v, found := p.p["schedule"]; found {
+		u.Set("schedule", v.(string))
+	}
+	if v, found := p.p["timezone"]; found {
+		u.Set("timezone", v.(string))
+	}
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateBackupScheduleParams) Validate() error {
+	v := newParamValidator("createBackupSchedule", p.p)
+	v.required("intervaltype", "schedule", "timezone", "virtualmachineid")
+	v.ids("virtualmachineid")
+	v.maxLength("intervaltype", 255)
+	v.oneOf("intervaltype", "HOURLY", "DAILY", "WEEKLY", "MONTHLY")
+	v.maxLength("schedule", 255)
+	v.maxLength("timezone", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateBackupScheduleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateBackupScheduleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "intervaltype", "schedule", "timezone", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createBackupSchedule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createBackupSchedule: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *CreateBackupScheduleParams) SetIntervaltype(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["intervaltype"] = v
+}
+
+func (p *CreateBackupScheduleParams) ResetIntervaltype() {
+	delete(p.p, "intervaltype")
+}
+
+func (p *CreateBackupScheduleParams) GetIntervaltype() (string, bool) {
+	value, ok := p.p["intervaltype"].(string)
+	return value, ok
+}
+
+func (p *CreateBackupScheduleParams) SetSchedule(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["schedule"] = v
+}
+
+func (p *CreateBackupScheduleParams) ResetSchedule() {
+	delete(p.p, "schedule")
+}
+
+func (p *CreateBackupScheduleParams) GetSchedule() (string, bool) {
+	value, ok := p.p["schedule"].(string)
+	return value, ok
+}
+
+func (p *CreateBackupScheduleParams) SetTimezone(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["timezone"] = v
+}
+
+func (p *CreateBackupScheduleParams) ResetTimezone() {
+	delete(p.p, "timezone")
+}
+
+func (p *CreateBackupScheduleParams) GetTimezone() (string, bool) {
+	value, ok := p.p["timezone"].(string)
+	return value, ok
+}
+
+func (p *CreateBackupScheduleParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+}
+
+func (p *CreateBackupScheduleParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *CreateBackupScheduleParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateBackupScheduleParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewCreateBackupScheduleParams(intervaltype string, schedule string, timezone string, virtualmachineid string) *CreateBackupScheduleParams {
+	p := &CreateBackupScheduleParams{}
+	p.p = make(map[string]interface{})
+	p.p["intervaltype"] = intervaltype
+	p.p["schedule"] = schedule
+	p.p["timezone"] = timezone
+	p.p["virtualmachineid"] = virtualmachineid
+	return p
+}
+
+// Creates a user-defined VM backup schedule
+func (s *BackupService) CreateBackupSchedule(p *CreateBackupScheduleParams) (*CreateBackupScheduleResponse, error) {
+	return s.CreateBackupScheduleWithContext(context.Background(), p)
+}
+
+// CreateBackupScheduleWithContext is the same as CreateBackupSchedule, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) CreateBackupScheduleWithContext(ctx context.Context, p *CreateBackupScheduleParams) (*CreateBackupScheduleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createBackupSchedule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateBackupScheduleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type CreateBackupScheduleResponse struct {
+	Account            string `json:"account"`
+	Accountid          string `json:"accountid"`
+	Backupofferingid   string `json:"backupofferingid"`
+	Backupofferingname string `json:"backupofferingname"`
+	Created            string `json:"created"`
+	Domain             string `json:"domain"`
+	Domainid           string `json:"domainid"`
+	Externalid         string `json:"externalid"`
+	Id                 string `json:"id"`
+	JobID              string `json:"jobid"`
+	Jobstatus          int    `json:"jobstatus"`
+	Size               int64  `json:"size"`
+	Status             string `json:"status"`
+	Type               string `json:"type"`
+	Virtualmachineid   string `json:"virtualmachineid"`
+	Virtualmachinename string `json:"virtualmachinename"`
+	Virtualsize        int64  `json:"virtualsize"`
+	Volumes            string `json:"volumes"`
+	Zone               string `json:"zone"`
+	Zoneid             string `json:"zoneid"`
+}
+
+type DeleteBackupParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteBackupParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteBackupParams) Validate() error {
+	v := newParamValidator("deleteBackup", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteBackupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteBackupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteBackup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteBackup: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteBackupParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteBackupParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteBackupParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteBackupParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewDeleteBackupParams(id string) *DeleteBackupParams {
+	p := &DeleteBackupParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Delete VM backup
+func (s *BackupService) DeleteBackup(p *DeleteBackupParams) (*DeleteBackupResponse, error) {
+	return s.DeleteBackupWithContext(context.Background(), p)
+}
+
+// DeleteBackupWithContext is the same as DeleteBackup, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) DeleteBackupWithContext(ctx context.Context, p *DeleteBackupParams) (*DeleteBackupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteBackup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteBackupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteBackupAsync starts the async job for DeleteBackup and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteBackupResponse using Job.Result
+func (s *BackupService) DeleteBackupAsync(p *DeleteBackupParams) (*Job, error) {
+	return s.DeleteBackupAsyncWithContext(context.Background(), p)
+}
+
+// DeleteBackupAsyncWithContext is the same as DeleteBackupAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BackupService) DeleteBackupAsyncWithContext(ctx context.Context, p *DeleteBackupParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteBackup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteBackupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteBackupResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteBackupOfferingParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteBackupOfferingParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteBackupOfferingParams) Validate() error {
+	v := newParamValidator("deleteBackupOffering", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteBackupOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteBackupOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteBackupOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteBackupOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteBackupOfferingParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteBackupOfferingParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteBackupOfferingParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteBackupOfferingParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewDeleteBackupOfferingParams(id string) *DeleteBackupOfferingParams {
+	p := &DeleteBackupOfferingParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes a backup offering
+func (s *BackupService) DeleteBackupOffering(p *DeleteBackupOfferingParams) (*DeleteBackupOfferingResponse, error) {
+	return s.DeleteBackupOfferingWithContext(context.Background(), p)
+}
+
+// DeleteBackupOfferingWithContext is the same as DeleteBackupOffering, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) DeleteBackupOfferingWithContext(ctx context.Context, p *DeleteBackupOfferingParams) (*DeleteBackupOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteBackupOffering", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteBackupOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteBackupOfferingResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteBackupOfferingResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteBackupOfferingResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type DeleteBackupScheduleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteBackupScheduleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteBackupScheduleParams) Validate() error {
+	v := newParamValidator("deleteBackupSchedule", p.p)
+	v.required("virtualmachineid")
+	v.ids("virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteBackupScheduleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteBackupScheduleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteBackupSchedule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteBackupSchedule: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteBackupScheduleParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+}
+
+func (p *DeleteBackupScheduleParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *DeleteBackupScheduleParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteBackupScheduleParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewDeleteBackupScheduleParams(virtualmachineid string) *DeleteBackupScheduleParams {
+	p := &DeleteBackupScheduleParams{}
+	p.p = make(map[string]interface{})
+	p.p["virtualmachineid"] = virtualmachineid
+	return p
+}
+
+// Deletes the backup schedule of a VM
+func (s *BackupService) DeleteBackupSchedule(p *DeleteBackupScheduleParams) (*DeleteBackupScheduleResponse, error) {
+	return s.DeleteBackupScheduleWithContext(context.Background(), p)
+}
+
+// DeleteBackupScheduleWithContext is the same as DeleteBackupSchedule, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) DeleteBackupScheduleWithContext(ctx context.Context, p *DeleteBackupScheduleParams) (*DeleteBackupScheduleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteBackupSchedule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteBackupScheduleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteBackupScheduleResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteBackupScheduleResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteBackupScheduleResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type ImportBackupOfferingParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ImportBackupOfferingParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["allowuserdrivenbackups"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("allowuserdrivenbackups", vv)
+	}
+	if v, found := p.p["description"]; found {
+		u.Set("description", v.(string))
+	}
+	if v, found := p.p["externalid"]; found {
+		u.Set("externalid", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ImportBackupOfferingParams) Validate() error {
+	v := newParamValidator("importBackupOffering", p.p)
+	v.required("allowuserdrivenbackups", "description", "externalid", "name", "zoneid")
+	v.ids("zoneid")
+	v.maxLength("description", 255)
+	v.maxLength("externalid", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ImportBackupOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ImportBackupOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "allowuserdrivenbackups":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "description", "externalid", "name", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for importBackupOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of importBackupOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ImportBackupOfferingParams) SetAllowuserdrivenbackups(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["allowuserdrivenbackups"] = v
+}
+
+func (p *ImportBackupOfferingParams) ResetAllowuserdrivenbackups() {
+	delete(p.p, "allowuserdrivenbackups")
+}
+
+func (p *ImportBackupOfferingParams) GetAllowuserdrivenbackups() (bool, bool) {
+	value, ok := p.p["allowuserdrivenbackups"].(bool)
+	return value, ok
+}
+
+func (p *ImportBackupOfferingParams) SetDescription(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["description"] = v
+}
+
+func (p *ImportBackupOfferingParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *ImportBackupOfferingParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
+func (p *ImportBackupOfferingParams) SetExternalid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["externalid"] = v
+}
+
+func (p *ImportBackupOfferingParams) ResetExternalid() {
+	delete(p.p, "externalid")
+}
+
+func (p *ImportBackupOfferingParams) GetExternalid() (string, bool) {
+	value, ok := p.p["externalid"].(string)
+	return value, ok
+}
+
+func (p *ImportBackupOfferingParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ImportBackupOfferingParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ImportBackupOfferingParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ImportBackupOfferingParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ImportBackupOfferingParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ImportBackupOfferingParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ImportBackupOfferingParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewImportBackupOfferingParams(allowuserdrivenbackups bool, description string, externalid string, name string, zoneid string) *ImportBackupOfferingParams {
+	p := &ImportBackupOfferingParams{}
+	p.p = make(map[string]interface{})
+	p.p["allowuserdrivenbackups"] = allowuserdrivenbackups
+	p.p["description"] = description
+	p.p["externalid"] = externalid
+	p.p["name"] = name
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// Imports a backup offering using a backup provider
+func (s *BackupService) ImportBackupOffering(p *ImportBackupOfferingParams) (*ImportBackupOfferingResponse, error) {
+	return s.ImportBackupOfferingWithContext(context.Background(), p)
+}
+
+// ImportBackupOfferingWithContext is the same as ImportBackupOffering, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) ImportBackupOfferingWithContext(ctx context.Context, p *ImportBackupOfferingParams) (*ImportBackupOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "importBackupOffering", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ImportBackupOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// ImportBackupOfferingAsync starts the async job for ImportBackupOffering and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ImportBackupOfferingResponse using Job.Result
+func (s *BackupService) ImportBackupOfferingAsync(p *ImportBackupOfferingParams) (*Job, error) {
+	return s.ImportBackupOfferingAsyncWithContext(context.Background(), p)
+}
+
+// ImportBackupOfferingAsyncWithContext is the same as ImportBackupOfferingAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BackupService) ImportBackupOfferingAsyncWithContext(ctx context.Context, p *ImportBackupOfferingParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "importBackupOffering", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ImportBackupOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type ImportBackupOfferingResponse struct {
+	Allowuserdrivenbackups bool   `json:"allowuserdrivenbackups"`
+	Created                string `json:"created"`
+	Description            string `json:"description"`
+	Externalid             string `json:"externalid"`
+	Id                     string `json:"id"`
+	JobID                  string `json:"jobid"`
+	Jobstatus              int    `json:"jobstatus"`
+	Name                   string `json:"name"`
+	Zoneid                 string `json:"zoneid"`
+	Zonename               string `json:"zonename"`
+}
+
+type ListBackupOfferingsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListBackupOfferingsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListBackupOfferingsParams) Validate() error {
+	v := newParamValidator("listBackupOfferings", p.p)
+	v.ids("id", "zoneid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListBackupOfferingsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListBackupOfferingsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listBackupOfferings", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listBackupOfferings: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListBackupOfferingsParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListBackupOfferingsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListBackupOfferingsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListBackupOfferingsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListBackupOfferingsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListBackupOfferingsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListBackupOfferingsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListBackupOfferingsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListBackupOfferingsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListBackupOfferingsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListBackupOfferingsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListBackupOfferingsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListBackupOfferingsParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ListBackupOfferingsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListBackupOfferingsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListBackupOfferingsParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewListBackupOfferingsParams() *ListBackupOfferingsParams {
+	p := &ListBackupOfferingsParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *BackupService) GetBackupOfferingID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetBackupOfferingIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetBackupOfferingIDWithContext is the same as GetBackupOfferingID, but uses ctx to cancel the request
+func (s *BackupService) GetBackupOfferingIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	p := &ListBackupOfferingsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["keyword"] = keyword
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListBackupOfferingsWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.BackupOfferings[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.BackupOfferings {
+			if v.Name == keyword {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *BackupService) GetBackupOfferingByName(name string, opts ...OptionFunc) (*BackupOffering, int, error) {
+	return s.GetBackupOfferingByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetBackupOfferingByNameWithContext is the same as GetBackupOfferingByName, but uses ctx to cancel the requests
+func (s *BackupService) GetBackupOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*BackupOffering, int, error) {
+	id, count, err := s.GetBackupOfferingIDWithContext(ctx, name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetBackupOfferingByIDWithContext(ctx, id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *BackupService) GetBackupOfferingByID(id string, opts ...OptionFunc) (*BackupOffering, int, error) {
+	return s.GetBackupOfferingByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetBackupOfferingByIDWithContext is the same as GetBackupOfferingByID, but uses ctx to cancel the request
+func (s *BackupService) GetBackupOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*BackupOffering, int, error) {
+	p := &ListBackupOfferingsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListBackupOfferingsWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.BackupOfferings[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for BackupOffering UUID: %s!", id)
+}
+
+// Lists backup offerings
+func (s *BackupService) ListBackupOfferings(p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error) {
+	return s.ListBackupOfferingsWithContext(context.Background(), p)
+}
+
+// ListBackupOfferingsWithContext is the same as ListBackupOfferings, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) ListBackupOfferingsWithContext(ctx context.Context, p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listBackupOfferings", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListBackupOfferingsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListBackupOfferingsPager iterates over all pages of a ListBackupOfferings call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListBackupOfferingsPager struct {
+	pager
+
+	s *BackupService
+	p *ListBackupOfferingsParams
+	r *ListBackupOfferingsResponse
+}
+
+// NewListBackupOfferingsPager returns a pager for all pages of a ListBackupOfferings call with the given params
+func (s *BackupService) NewListBackupOfferingsPager(p *ListBackupOfferingsParams) *ListBackupOfferingsPager {
+	return &ListBackupOfferingsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListBackupOfferingsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListBackupOfferingsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListBackupOfferingsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListBackupOfferingsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.BackupOfferings), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListBackupOfferingsPager) Page() *ListBackupOfferingsResponse {
+	return pg.r
+}
+
+// ListBackupOfferingsAll fetches all pages of a ListBackupOfferings call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *BackupService) ListBackupOfferingsAll(p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error) {
+	return s.ListBackupOfferingsAllWithContext(context.Background(), p)
+}
+
+// ListBackupOfferingsAllWithContext is the same as ListBackupOfferingsAll, but uses ctx to cancel the requests
+func (s *BackupService) ListBackupOfferingsAllWithContext(ctx context.Context, p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*BackupOffering)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListBackupOfferingsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListBackupOfferingsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.BackupOfferings
+		mu.Unlock()
+
+		return len(l.BackupOfferings), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListBackupOfferingsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.BackupOfferings = append(r.BackupOfferings, pages[page]...)
+	}
+	r.Count = len(r.BackupOfferings)
+
+	return r, nil
+}
+
+type ListBackupOfferingsResponse struct {
+	Count           int               `json:"count"`
+	BackupOfferings []*BackupOffering `json:"backupoffering"`
+}
+
+type BackupOffering struct {
+	Allowuserdrivenbackups bool   `json:"allowuserdrivenbackups"`
+	Created                string `json:"created"`
+	Description            string `json:"description"`
+	Externalid             string `json:"externalid"`
+	Id                     string `json:"id"`
+	JobID                  string `json:"jobid"`
+	Jobstatus              int    `json:"jobstatus"`
+	Name                   string `json:"name"`
+	Zoneid                 string `json:"zoneid"`
+	Zonename               string `json:"zonename"`
+}
+
+type ListBackupProviderOfferingsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListBackupProviderOfferingsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListBackupProviderOfferingsParams) Validate() error {
+	v := newParamValidator("listBackupProviderOfferings", p.p)
+	v.required("zoneid")
+	v.ids("zoneid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListBackupProviderOfferingsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListBackupProviderOfferingsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listBackupProviderOfferings", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listBackupProviderOfferings: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListBackupProviderOfferingsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListBackupProviderOfferingsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListBackupProviderOfferingsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListBackupProviderOfferingsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListBackupProviderOfferingsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListBackupProviderOfferingsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListBackupProviderOfferingsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListBackupProviderOfferingsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListBackupProviderOfferingsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListBackupProviderOfferingsParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ListBackupProviderOfferingsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListBackupProviderOfferingsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListBackupProviderOfferingsParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewListBackupProviderOfferingsParams(zoneid string) *ListBackupProviderOfferingsParams {
+	p := &ListBackupProviderOfferingsParams{}
+	p.p = make(map[string]interface{})
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *BackupService) GetBackupProviderOfferingID(keyword string, zoneid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetBackupProviderOfferingIDWithContext(context.Background(), keyword, zoneid, opts...)
+}
+
+// GetBackupProviderOfferingIDWithContext is the same as GetBackupProviderOfferingID, but uses ctx to cancel the request
+func (s *BackupService) GetBackupProviderOfferingIDWithContext(ctx context.Context, keyword string, zoneid string, opts ...OptionFunc) (string, int, error) {
+	p := &ListBackupProviderOfferingsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["keyword"] = keyword
+	p.p["zoneid"] = zoneid
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListBackupProviderOfferingsWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.BackupProviderOfferings[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.BackupProviderOfferings {
+			if v.Name == keyword {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// Lists external backup offerings of the provider
+func (s *BackupService) ListBackupProviderOfferings(p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error) {
+	return s.ListBackupProviderOfferingsWithContext(context.Background(), p)
+}
+
+// ListBackupProviderOfferingsWithContext is the same as ListBackupProviderOfferings, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) ListBackupProviderOfferingsWithContext(ctx context.Context, p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listBackupProviderOfferings", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListBackupProviderOfferingsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListBackupProviderOfferingsPager iterates over all pages of a ListBackupProviderOfferings call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListBackupProviderOfferingsPager struct {
+	pager
+
+	s *BackupService
+	p *ListBackupProviderOfferingsParams
+	r *ListBackupProviderOfferingsResponse
+}
+
+// NewListBackupProviderOfferingsPager returns a pager for all pages of a ListBackupProviderOfferings call with the given params
+func (s *BackupService) NewListBackupProviderOfferingsPager(p *ListBackupProviderOfferingsParams) *ListBackupProviderOfferingsPager {
+	return &ListBackupProviderOfferingsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListBackupProviderOfferingsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListBackupProviderOfferingsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListBackupProviderOfferingsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListBackupProviderOfferingsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.BackupProviderOfferings), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListBackupProviderOfferingsPager) Page() *ListBackupProviderOfferingsResponse {
+	return pg.r
+}
+
+// ListBackupProviderOfferingsAll fetches all pages of a ListBackupProviderOfferings call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *BackupService) ListBackupProviderOfferingsAll(p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error) {
+	return s.ListBackupProviderOfferingsAllWithContext(context.Background(), p)
+}
+
+// ListBackupProviderOfferingsAllWithContext is the same as ListBackupProviderOfferingsAll, but uses ctx to cancel the requests
+func (s *BackupService) ListBackupProviderOfferingsAllWithContext(ctx context.Context, p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*BackupProviderOffering)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListBackupProviderOfferingsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListBackupProviderOfferingsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.BackupProviderOfferings
+		mu.Unlock()
+
+		return len(l.BackupProviderOfferings), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListBackupProviderOfferingsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.BackupProviderOfferings = append(r.BackupProviderOfferings, pages[page]...)
+	}
+	r.Count = len(r.BackupProviderOfferings)
+
+	return r, nil
+}
+
+type ListBackupProviderOfferingsResponse struct {
+	Count                   int                       `json:"count"`
+	BackupProviderOfferings []*BackupProviderOffering `json:"backupoffering"`
+}
+
+type BackupProviderOffering struct {
+	Allowuserdrivenbackups bool   `json:"allowuserdrivenbackups"`
+	Created                string `json:"created"`
+	Description            string `json:"description"`
+	Externalid             string `json:"externalid"`
+	Id                     string `json:"id"`
+	JobID                  string `json:"jobid"`
+	Jobstatus              int    `json:"jobstatus"`
+	Name                   string `json:"name"`
+	Zoneid                 string `json:"zoneid"`
+	Zonename               string `json:"zonename"`
+}
+
+type ListBackupProvidersParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListBackupProvidersParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListBackupProvidersParams) Validate() error {
+	v := newParamValidator("listBackupProviders", p.p)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListBackupProvidersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListBackupProvidersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listBackupProviders", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listBackupProviders: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListBackupProvidersParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ListBackupProvidersParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListBackupProvidersParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListBackupProvidersParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewListBackupProvidersParams() *ListBackupProvidersParams {
+	p := &ListBackupProvidersParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Lists Backup and Recovery providers
+func (s *BackupService) ListBackupProviders(p *ListBackupProvidersParams) (*ListBackupProvidersResponse, error) {
+	return s.ListBackupProvidersWithContext(context.Background(), p)
+}
+
+// ListBackupProvidersWithContext is the same as ListBackupProviders, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) ListBackupProvidersWithContext(ctx context.Context, p *ListBackupProvidersParams) (*ListBackupProvidersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listBackupProviders", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListBackupProvidersResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListBackupProvidersResponse struct {
+	Count           int               `json:"count"`
+	BackupProviders []*BackupProvider `json:"providers"`
+}
+
+type BackupProvider struct {
+	Description string `json:"description"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Name        string `json:"name"`
+}
+
+type ListBackupScheduleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListBackupScheduleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListBackupScheduleParams) Validate() error {
+	v := newParamValidator("listBackupSchedule", p.p)
+	v.required("virtualmachineid")
+	v.ids("virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListBackupScheduleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListBackupScheduleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listBackupSchedule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listBackupSchedule: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListBackupScheduleParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+}
+
+func (p *ListBackupScheduleParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *ListBackupScheduleParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListBackupScheduleParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewListBackupScheduleParams(virtualmachineid string) *ListBackupScheduleParams {
+	p := &ListBackupScheduleParams{}
+	p.p = make(map[string]interface{})
+	p.p["virtualmachineid"] = virtualmachineid
+	return p
+}
+
+// List backup schedule of a VM
+func (s *BackupService) ListBackupSchedule(p *ListBackupScheduleParams) (*ListBackupScheduleResponse, error) {
+	return s.ListBackupScheduleWithContext(context.Background(), p)
+}
+
+// ListBackupScheduleWithContext is the same as ListBackupSchedule, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) ListBackupScheduleWithContext(ctx context.Context, p *ListBackupScheduleParams) (*ListBackupScheduleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listBackupSchedule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListBackupScheduleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListBackupScheduleResponse struct {
+	BackupSchedule *BackupSchedule `json:"backupschedule"`
+}
+
+type BackupSchedule struct {
+	Intervaltype       string `json:"intervaltype"`
+	JobID              string `json:"jobid"`
+	Jobstatus          int    `json:"jobstatus"`
+	Schedule           string `json:"schedule"`
+	Timezone           string `json:"timezone"`
+	Virtualmachineid   string `json:"virtualmachineid"`
+	Virtualmachinename string `json:"virtualmachinename"`
+}
+
+type ListBackupsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListBackupsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["isrecursive"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isrecursive", vv)
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["listall"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("listall", vv)
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListBackupsParams) Validate() error {
+	v := newParamValidator("listBackups", p.p)
+	v.ids("domainid", "id", "projectid", "virtualmachineid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListBackupsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListBackupsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "projectid", "virtualmachineid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listBackups", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listBackups: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListBackupsParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *ListBackupsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListBackupsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *ListBackupsParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *ListBackupsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListBackupsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *ListBackupsParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListBackupsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListBackupsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListBackupsParams) SetIsrecursive(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isrecursive"] = v
+}
+
+func (p *ListBackupsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListBackupsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
+func (p *ListBackupsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListBackupsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListBackupsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListBackupsParams) SetListall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["listall"] = v
+}
+
+func (p *ListBackupsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListBackupsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
+func (p *ListBackupsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListBackupsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListBackupsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListBackupsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListBackupsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListBackupsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListBackupsParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *ListBackupsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListBackupsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *ListBackupsParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+}
+
+func (p *ListBackupsParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *ListBackupsParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
+func (p *ListBackupsParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ListBackupsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListBackupsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListBackupsParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewListBackupsParams() *ListBackupsParams {
+	p := &ListBackupsParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *BackupService) GetBackupByID(id string, opts ...OptionFunc) (*Backup, int, error) {
+	return s.GetBackupByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetBackupByIDWithContext is the same as GetBackupByID, but uses ctx to cancel the request
+func (s *BackupService) GetBackupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Backup, int, error) {
+	p := &ListBackupsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListBackupsWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.Backups[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for Backup UUID: %s!", id)
+}
+
+// Lists VM backups
+func (s *BackupService) ListBackups(p *ListBackupsParams) (*ListBackupsResponse, error) {
+	return s.ListBackupsWithContext(context.Background(), p)
+}
+
+// ListBackupsWithContext is the same as ListBackups, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) ListBackupsWithContext(ctx context.Context, p *ListBackupsParams) (*ListBackupsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listBackups", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListBackupsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListBackupsPager iterates over all pages of a ListBackups call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListBackupsPager struct {
+	pager
+
+	s *BackupService
+	p *ListBackupsParams
+	r *ListBackupsResponse
+}
+
+// NewListBackupsPager returns a pager for all pages of a ListBackups call with the given params
+func (s *BackupService) NewListBackupsPager(p *ListBackupsParams) *ListBackupsPager {
+	return &ListBackupsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListBackupsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListBackupsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListBackupsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListBackupsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Backups), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListBackupsPager) Page() *ListBackupsResponse {
+	return pg.r
+}
+
+// ListBackupsAll fetches all pages of a ListBackups call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *BackupService) ListBackupsAll(p *ListBackupsParams) (*ListBackupsResponse, error) {
+	return s.ListBackupsAllWithContext(context.Background(), p)
+}
+
+// ListBackupsAllWithContext is the same as ListBackupsAll, but uses ctx to cancel the requests
+func (s *BackupService) ListBackupsAllWithContext(ctx context.Context, p *ListBackupsParams) (*ListBackupsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Backup)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListBackupsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListBackupsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Backups
+		mu.Unlock()
+
+		return len(l.Backups), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListBackupsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Backups = append(r.Backups, pages[page]...)
+	}
+	r.Count = len(r.Backups)
+
+	return r, nil
+}
+
+type ListBackupsResponse struct {
+	Count   int       `json:"count"`
+	Backups []*Backup `json:"backup"`
+}
+
+type Backup struct {
+	Account            string `json:"account"`
+	Accountid          string `json:"accountid"`
+	Backupofferingid   string `json:"backupofferingid"`
+	Backupofferingname string `json:"backupofferingname"`
+	Created            string `json:"created"`
+	Domain             string `json:"domain"`
+	Domainid           string `json:"domainid"`
+	Externalid         string `json:"externalid"`
+	Id                 string `json:"id"`
+	JobID              string `json:"jobid"`
+	Jobstatus          int    `json:"jobstatus"`
+	Size               int64  `json:"size"`
+	Status             string `json:"status"`
+	Type               string `json:"type"`
+	Virtualmachineid   string `json:"virtualmachineid"`
+	Virtualmachinename string `json:"virtualmachinename"`
+	Virtualsize        int64  `json:"virtualsize"`
+	Volumes            string `json:"volumes"`
+	Zone               string `json:"zone"`
+	Zoneid             string `json:"zoneid"`
+}
+
+type RemoveVirtualMachineFromBackupOfferingParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RemoveVirtualMachineFromBackupOfferingParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["forced"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("forced", vv)
+	}
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RemoveVirtualMachineFromBackupOfferingParams) Validate() error {
+	v := newParamValidator("removeVirtualMachineFromBackupOffering", p.p)
+	v.required("virtualmachineid")
+	v.ids("virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RemoveVirtualMachineFromBackupOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RemoveVirtualMachineFromBackupOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "forced":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for removeVirtualMachineFromBackupOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of removeVirtualMachineFromBackupOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *RemoveVirtualMachineFromBackupOfferingParams) SetForced(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["forced"] = v
+}
+
+func (p *RemoveVirtualMachineFromBackupOfferingParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *RemoveVirtualMachineFromBackupOfferingParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
+func (p *RemoveVirtualMachineFromBackupOfferingParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+}
+
+func (p *RemoveVirtualMachineFromBackupOfferingParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *RemoveVirtualMachineFromBackupOfferingParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new RemoveVirtualMachineFromBackupOfferingParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewRemoveVirtualMachineFromBackupOfferingParams(virtualmachineid string) *RemoveVirtualMachineFromBackupOfferingParams {
+	p := &RemoveVirtualMachineFromBackupOfferingParams{}
+	p.p = make(map[string]interface{})
+	p.p["virtualmachineid"] = virtualmachineid
+	return p
+}
+
+// Removes a VM from any existing backup offering
+func (s *BackupService) RemoveVirtualMachineFromBackupOffering(p *RemoveVirtualMachineFromBackupOfferingParams) (*RemoveVirtualMachineFromBackupOfferingResponse, error) {
+	return s.RemoveVirtualMachineFromBackupOfferingWithContext(context.Background(), p)
+}
+
+// RemoveVirtualMachineFromBackupOfferingWithContext is the same as RemoveVirtualMachineFromBackupOffering, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) RemoveVirtualMachineFromBackupOfferingWithContext(ctx context.Context, p *RemoveVirtualMachineFromBackupOfferingParams) (*RemoveVirtualMachineFromBackupOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeVirtualMachineFromBackupOffering", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveVirtualMachineFromBackupOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// RemoveVirtualMachineFromBackupOfferingAsync starts the async job for RemoveVirtualMachineFromBackupOffering and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RemoveVirtualMachineFromBackupOfferingResponse using Job.Result
+func (s *BackupService) RemoveVirtualMachineFromBackupOfferingAsync(p *RemoveVirtualMachineFromBackupOfferingParams) (*Job, error) {
+	return s.RemoveVirtualMachineFromBackupOfferingAsyncWithContext(context.Background(), p)
+}
+
+// RemoveVirtualMachineFromBackupOfferingAsyncWithContext is the same as RemoveVirtualMachineFromBackupOfferingAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BackupService) RemoveVirtualMachineFromBackupOfferingAsyncWithContext(ctx context.Context, p *RemoveVirtualMachineFromBackupOfferingParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeVirtualMachineFromBackupOffering", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveVirtualMachineFromBackupOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type RemoveVirtualMachineFromBackupOfferingResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type RestoreBackupParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RestoreBackupParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RestoreBackupParams) Validate() error {
+	v := newParamValidator("restoreBackup", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RestoreBackupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RestoreBackupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for restoreBackup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of restoreBackup: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *RestoreBackupParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *RestoreBackupParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RestoreBackupParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new RestoreBackupParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewRestoreBackupParams(id string) *RestoreBackupParams {
+	p := &RestoreBackupParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Restores an existing stopped or deleted VM using a VM backup
+func (s *BackupService) RestoreBackup(p *RestoreBackupParams) (*RestoreBackupResponse, error) {
+	return s.RestoreBackupWithContext(context.Background(), p)
+}
+
+// RestoreBackupWithContext is the same as RestoreBackup, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) RestoreBackupWithContext(ctx context.Context, p *RestoreBackupParams) (*RestoreBackupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "restoreBackup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RestoreBackupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// RestoreBackupAsync starts the async job for RestoreBackup and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RestoreBackupResponse using Job.Result
+func (s *BackupService) RestoreBackupAsync(p *RestoreBackupParams) (*Job, error) {
+	return s.RestoreBackupAsyncWithContext(context.Background(), p)
+}
+
+// RestoreBackupAsyncWithContext is the same as RestoreBackupAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BackupService) RestoreBackupAsyncWithContext(ctx context.Context, p *RestoreBackupParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "restoreBackup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RestoreBackupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type RestoreBackupResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type RestoreVolumeFromBackupAndAttachToVMParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["backupid"]; found {
+		u.Set("backupid", v.(string))
+	}
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
+	}
+	if v, found := p.p["volumeid"]; found {
+		u.Set("volumeid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) Validate() error {
+	v := newParamValidator("restoreVolumeFromBackupAndAttachToVM", p.p)
+	v.required("backupid", "virtualmachineid", "volumeid")
+	v.ids("backupid", "virtualmachineid")
+	v.maxLength("volumeid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "backupid", "virtualmachineid", "volumeid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for restoreVolumeFromBackupAndAttachToVM", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of restoreVolumeFromBackupAndAttachToVM: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) SetBackupid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["backupid"] = v
+}
+
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) ResetBackupid() {
+	delete(p.p, "backupid")
+}
+
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) GetBackupid() (string, bool) {
+	value, ok := p.p["backupid"].(string)
+	return value, ok
+}
+
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+}
+
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) SetVolumeid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["volumeid"] = v
+}
+
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) ResetVolumeid() {
+	delete(p.p, "volumeid")
+}
+
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) GetVolumeid() (string, bool) {
+	value, ok := p.p["volumeid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new RestoreVolumeFromBackupAndAttachToVMParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewRestoreVolumeFromBackupAndAttachToVMParams(backupid string, virtualmachineid string, volumeid string) *RestoreVolumeFromBackupAndAttachToVMParams {
+	p := &RestoreVolumeFromBackupAndAttachToVMParams{}
+	p.p = make(map[string]interface{})
+	p.p["backupid"] = backupid
+	p.p["virtualmachineid"] = virtualmachineid
+	p.p["volumeid"] = volumeid
+	return p
+}
+
+// Restore and attach a backed up volume to VM
+func (s *BackupService) RestoreVolumeFromBackupAndAttachToVM(p *RestoreVolumeFromBackupAndAttachToVMParams) (*RestoreVolumeFromBackupAndAttachToVMResponse, error) {
+	return s.RestoreVolumeFromBackupAndAttachToVMWithContext(context.Background(), p)
+}
+
+// RestoreVolumeFromBackupAndAttachToVMWithContext is the same as RestoreVolumeFromBackupAndAttachToVM, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) RestoreVolumeFromBackupAndAttachToVMWithContext(ctx context.Context, p *RestoreVolumeFromBackupAndAttachToVMParams) (*RestoreVolumeFromBackupAndAttachToVMResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "restoreVolumeFromBackupAndAttachToVM", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RestoreVolumeFromBackupAndAttachToVMResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// RestoreVolumeFromBackupAndAttachToVMAsync starts the async job for RestoreVolumeFromBackupAndAttachToVM and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RestoreVolumeFromBackupAndAttachToVMResponse using Job.Result
+func (s *BackupService) RestoreVolumeFromBackupAndAttachToVMAsync(p *RestoreVolumeFromBackupAndAttachToVMParams) (*Job, error) {
+	return s.RestoreVolumeFromBackupAndAttachToVMAsyncWithContext(context.Background(), p)
+}
+
+// RestoreVolumeFromBackupAndAttachToVMAsyncWithContext is the same as RestoreVolumeFromBackupAndAttachToVMAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BackupService) RestoreVolumeFromBackupAndAttachToVMAsyncWithContext(ctx context.Context, p *RestoreVolumeFromBackupAndAttachToVMParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "restoreVolumeFromBackupAndAttachToVM", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RestoreVolumeFromBackupAndAttachToVMResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type RestoreVolumeFromBackupAndAttachToVMResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type UpdateBackupScheduleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateBackupScheduleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["intervaltype"]; found {
+		u.Set("intervaltype", v.(string))
+	}
+	if v, found := p.p["schedule"]; found {
+		u.Set("schedule", v.(string))
+	}
+	if v, found := p.p["timezone"]; found {
+		u.Set("timezone", v.(string))
+	}
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateBackupScheduleParams) Validate() error {
+	v := newParamValidator("updateBackupSchedule", p.p)
+	v.required("intervaltype", "schedule", "timezone", "virtualmachineid")
+	v.ids("virtualmachineid")
+	v.maxLength("intervaltype", 255)
+	v.oneOf("intervaltype", "HOURLY", "DAILY", "WEEKLY", "MONTHLY")
+	v.maxLength("schedule", 255)
+	v.maxLength("timezone", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateBackupScheduleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateBackupScheduleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "intervaltype", "schedule", "timezone", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateBackupSchedule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateBackupSchedule: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *UpdateBackupScheduleParams) SetIntervaltype(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["intervaltype"] = v
+}
+
+func (p *UpdateBackupScheduleParams) ResetIntervaltype() {
+	delete(p.p, "intervaltype")
+}
+
+func (p *UpdateBackupScheduleParams) GetIntervaltype() (string, bool) {
+	value, ok := p.p["intervaltype"].(string)
+	return value, ok
+}
+
+func (p *UpdateBackupScheduleParams) SetSchedule(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["schedule"] = v
+}
+
+func (p *UpdateBackupScheduleParams) ResetSchedule() {
+	delete(p.p, "schedule")
+}
+
+func (p *UpdateBackupScheduleParams) GetSchedule() (string, bool) {
+	value, ok := p.p["schedule"].(string)
+	return value, ok
+}
+
+func (p *UpdateBackupScheduleParams) SetTimezone(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["timezone"] = v
+}
+
+func (p *UpdateBackupScheduleParams) ResetTimezone() {
+	delete(p.p, "timezone")
+}
+
+func (p *UpdateBackupScheduleParams) GetTimezone() (string, bool) {
+	value, ok := p.p["timezone"].(string)
+	return value, ok
+}
+
+func (p *UpdateBackupScheduleParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+}
+
+func (p *UpdateBackupScheduleParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *UpdateBackupScheduleParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new UpdateBackupScheduleParams instance,
+// as then you are sure you have configured all required params
+func (s *BackupService) NewUpdateBackupScheduleParams(intervaltype string, schedule string, timezone string, virtualmachineid string) *UpdateBackupScheduleParams {
+	p := &UpdateBackupScheduleParams{}
+	p.p = make(map[string]interface{})
+	p.p["intervaltype"] = intervaltype
+	p.p["schedule"] = schedule
+	p.p["timezone"] = timezone
+	p.p["virtualmachineid"] = virtualmachineid
+	return p
+}
+
+// Updates a user-defined VM backup schedule
+func (s *BackupService) UpdateBackupSchedule(p *UpdateBackupScheduleParams) (*UpdateBackupScheduleResponse, error) {
+	return s.UpdateBackupScheduleWithContext(context.Background(), p)
+}
+
+// UpdateBackupScheduleWithContext is the same as UpdateBackupSchedule, but uses ctx to cancel the request and any async job polling
+func (s *BackupService) UpdateBackupScheduleWithContext(ctx context.Context, p *UpdateBackupScheduleParams) (*UpdateBackupScheduleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateBackupSchedule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateBackupScheduleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type UpdateBackupScheduleResponse struct {
+	Account            string `json:"account"`
+	Accountid          string `json:"accountid"`
+	Backupofferingid   string `json:"backupofferingid"`
+	Backupofferingname string `json:"backupofferingname"`
+	Created            string `json:"created"`
+	Domain             string `json:"domain"`
+	Domainid           string `json:"domainid"`
+	Externalid         string `json:"externalid"`
+	Id                 string `json:"id"`
+	JobID              string `json:"jobid"`
+	Jobstatus          int    `json:"jobstatus"`
+	Size               int64  `json:"size"`
+	Status             string `json:"status"`
+	Type               string `json:"type"`
+	Virtualmachineid   string `json:"virtualmachineid"`
+	Virtualmachinename string `json:"virtualmachinename"`
+	Virtualsize        int64  `json:"virtualsize"`
+	Volumes            string `json:"volumes"`
+	Zone               string `json:"zone"`
+	Zoneid             string `json:"zoneid"`
+}
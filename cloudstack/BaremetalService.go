@@ -20,16 +20,73 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// BaremetalServiceIface is the interface implemented by BaremetalService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type BaremetalServiceIface interface {
+	NewAddBaremetalDhcpParams(dhcpservertype string, password string, physicalnetworkid string, url string, username string) *AddBaremetalDhcpParams
+	AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error)
+	AddBaremetalDhcpWithContext(ctx context.Context, p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error)
+	AddBaremetalDhcpAsync(p *AddBaremetalDhcpParams) (*Job, error)
+	AddBaremetalDhcpAsyncWithContext(ctx context.Context, p *AddBaremetalDhcpParams) (*Job, error)
+	NewAddBaremetalPxeKickStartServerParams(password string, physicalnetworkid string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxeKickStartServerParams
+	AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error)
+	AddBaremetalPxeKickStartServerWithContext(ctx context.Context, p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error)
+	AddBaremetalPxeKickStartServerAsync(p *AddBaremetalPxeKickStartServerParams) (*Job, error)
+	AddBaremetalPxeKickStartServerAsyncWithContext(ctx context.Context, p *AddBaremetalPxeKickStartServerParams) (*Job, error)
+	NewAddBaremetalPxePingServerParams(password string, physicalnetworkid string, pingdir string, pingstorageserverip string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxePingServerParams
+	AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error)
+	AddBaremetalPxePingServerWithContext(ctx context.Context, p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error)
+	AddBaremetalPxePingServerAsync(p *AddBaremetalPxePingServerParams) (*Job, error)
+	AddBaremetalPxePingServerAsyncWithContext(ctx context.Context, p *AddBaremetalPxePingServerParams) (*Job, error)
+	NewAddBaremetalRctParams(baremetalrcturl string) *AddBaremetalRctParams
+	AddBaremetalRct(p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error)
+	AddBaremetalRctWithContext(ctx context.Context, p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error)
+	AddBaremetalRctAsync(p *AddBaremetalRctParams) (*Job, error)
+	AddBaremetalRctAsyncWithContext(ctx context.Context, p *AddBaremetalRctParams) (*Job, error)
+	NewDeleteBaremetalRctParams(id string) *DeleteBaremetalRctParams
+	DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error)
+	DeleteBaremetalRctWithContext(ctx context.Context, p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error)
+	DeleteBaremetalRctAsync(p *DeleteBaremetalRctParams) (*Job, error)
+	DeleteBaremetalRctAsyncWithContext(ctx context.Context, p *DeleteBaremetalRctParams) (*Job, error)
+	NewListBaremetalDhcpParams(physicalnetworkid string) *ListBaremetalDhcpParams
+	ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error)
+	ListBaremetalDhcpWithContext(ctx context.Context, p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error)
+	NewListBaremetalDhcpPager(p *ListBaremetalDhcpParams) *ListBaremetalDhcpPager
+	ListBaremetalDhcpAll(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error)
+	ListBaremetalDhcpAllWithContext(ctx context.Context, p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error)
+	NewListBaremetalPxeServersParams(physicalnetworkid string) *ListBaremetalPxeServersParams
+	ListBaremetalPxeServers(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error)
+	ListBaremetalPxeServersWithContext(ctx context.Context, p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error)
+	NewListBaremetalPxeServersPager(p *ListBaremetalPxeServersParams) *ListBaremetalPxeServersPager
+	ListBaremetalPxeServersAll(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error)
+	ListBaremetalPxeServersAllWithContext(ctx context.Context, p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error)
+	NewListBaremetalRctParams() *ListBaremetalRctParams
+	ListBaremetalRct(p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error)
+	ListBaremetalRctWithContext(ctx context.Context, p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error)
+	NewListBaremetalRctPager(p *ListBaremetalRctParams) *ListBaremetalRctPager
+	ListBaremetalRctAll(p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error)
+	ListBaremetalRctAllWithContext(ctx context.Context, p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error)
+	NewNotifyBaremetalProvisionDoneParams(mac string) *NotifyBaremetalProvisionDoneParams
+	NotifyBaremetalProvisionDone(p *NotifyBaremetalProvisionDoneParams) (*NotifyBaremetalProvisionDoneResponse, error)
+	NotifyBaremetalProvisionDoneWithContext(ctx context.Context, p *NotifyBaremetalProvisionDoneParams) (*NotifyBaremetalProvisionDoneResponse, error)
+	NotifyBaremetalProvisionDoneAsync(p *NotifyBaremetalProvisionDoneParams) (*Job, error)
+	NotifyBaremetalProvisionDoneAsyncWithContext(ctx context.Context, p *NotifyBaremetalProvisionDoneParams) (*Job, error)
+}
+
 type AddBaremetalDhcpParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddBaremetalDhcpParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddBaremetalDhcpParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -52,6 +109,52 @@ func (p *AddBaremetalDhcpParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddBaremetalDhcpParams) Validate() error {
+	v := newParamValidator("addBaremetalDhcp", p.p)
+	v.required("dhcpservertype", "password", "physicalnetworkid", "url", "username")
+	v.ids("physicalnetworkid")
+	v.maxLength("dhcpservertype", 255)
+	v.maxLength("password", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddBaremetalDhcpParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddBaremetalDhcpParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "dhcpservertype", "password", "physicalnetworkid", "url", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addBaremetalDhcp", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addBaremetalDhcp: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddBaremetalDhcpParams) SetDhcpservertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -59,6 +162,15 @@ func (p *AddBaremetalDhcpParams) SetDhcpservertype(v string) {
 	p.p["dhcpservertype"] = v
 }
 
+func (p *AddBaremetalDhcpParams) ResetDhcpservertype() {
+	delete(p.p, "dhcpservertype")
+}
+
+func (p *AddBaremetalDhcpParams) GetDhcpservertype() (string, bool) {
+	value, ok := p.p["dhcpservertype"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalDhcpParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -66,6 +178,15 @@ func (p *AddBaremetalDhcpParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *AddBaremetalDhcpParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddBaremetalDhcpParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalDhcpParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -73,6 +194,15 @@ func (p *AddBaremetalDhcpParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *AddBaremetalDhcpParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddBaremetalDhcpParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalDhcpParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -80,6 +210,15 @@ func (p *AddBaremetalDhcpParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *AddBaremetalDhcpParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddBaremetalDhcpParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalDhcpParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -87,6 +226,15 @@ func (p *AddBaremetalDhcpParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddBaremetalDhcpParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddBaremetalDhcpParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddBaremetalDhcpParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewAddBaremetalDhcpParams(dhcpservertype string, password string, physicalnetworkid string, url string, username string) *AddBaremetalDhcpParams {
@@ -102,7 +250,16 @@ func (s *BaremetalService) NewAddBaremetalDhcpParams(dhcpservertype string, pass
 
 // adds a baremetal dhcp server
 func (s *BaremetalService) AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalDhcp", p.toURLValues())
+	return s.AddBaremetalDhcpWithContext(context.Background(), p)
+}
+
+// AddBaremetalDhcpWithContext is the same as AddBaremetalDhcp, but uses ctx to cancel the request and any async job polling
+func (s *BaremetalService) AddBaremetalDhcpWithContext(ctx context.Context, p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addBaremetalDhcp", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +271,7 @@ func (s *BaremetalService) AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBare
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -135,6 +292,40 @@ func (s *BaremetalService) AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBare
 	return &r, nil
 }
 
+// AddBaremetalDhcpAsync starts the async job for AddBaremetalDhcp and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddBaremetalDhcpResponse using Job.Result
+func (s *BaremetalService) AddBaremetalDhcpAsync(p *AddBaremetalDhcpParams) (*Job, error) {
+	return s.AddBaremetalDhcpAsyncWithContext(context.Background(), p)
+}
+
+// AddBaremetalDhcpAsyncWithContext is the same as AddBaremetalDhcpAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BaremetalService) AddBaremetalDhcpAsyncWithContext(ctx context.Context, p *AddBaremetalDhcpParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addBaremetalDhcp", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddBaremetalDhcpResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AddBaremetalDhcpResponse struct {
 	Dhcpservertype    string `json:"dhcpservertype"`
 	Id                string `json:"id"`
@@ -149,7 +340,8 @@ type AddBaremetalPxeKickStartServerParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddBaremetalPxeKickStartServerParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddBaremetalPxeKickStartServerParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -178,6 +370,53 @@ func (p *AddBaremetalPxeKickStartServerParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddBaremetalPxeKickStartServerParams) Validate() error {
+	v := newParamValidator("addBaremetalPxeKickStartServer", p.p)
+	v.required("password", "physicalnetworkid", "pxeservertype", "tftpdir", "url", "username")
+	v.ids("physicalnetworkid", "podid")
+	v.maxLength("password", 255)
+	v.maxLength("pxeservertype", 255)
+	v.maxLength("tftpdir", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddBaremetalPxeKickStartServerParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddBaremetalPxeKickStartServerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "password", "physicalnetworkid", "podid", "pxeservertype", "tftpdir", "url", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addBaremetalPxeKickStartServer", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addBaremetalPxeKickStartServer: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -185,6 +424,15 @@ func (p *AddBaremetalPxeKickStartServerParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *AddBaremetalPxeKickStartServerParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -192,6 +440,15 @@ func (p *AddBaremetalPxeKickStartServerParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *AddBaremetalPxeKickStartServerParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -199,6 +456,15 @@ func (p *AddBaremetalPxeKickStartServerParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *AddBaremetalPxeKickStartServerParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetPxeservertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -206,6 +472,15 @@ func (p *AddBaremetalPxeKickStartServerParams) SetPxeservertype(v string) {
 	p.p["pxeservertype"] = v
 }
 
+func (p *AddBaremetalPxeKickStartServerParams) ResetPxeservertype() {
+	delete(p.p, "pxeservertype")
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) GetPxeservertype() (string, bool) {
+	value, ok := p.p["pxeservertype"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetTftpdir(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -213,6 +488,15 @@ func (p *AddBaremetalPxeKickStartServerParams) SetTftpdir(v string) {
 	p.p["tftpdir"] = v
 }
 
+func (p *AddBaremetalPxeKickStartServerParams) ResetTftpdir() {
+	delete(p.p, "tftpdir")
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) GetTftpdir() (string, bool) {
+	value, ok := p.p["tftpdir"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -220,6 +504,15 @@ func (p *AddBaremetalPxeKickStartServerParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *AddBaremetalPxeKickStartServerParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -227,6 +520,15 @@ func (p *AddBaremetalPxeKickStartServerParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddBaremetalPxeKickStartServerParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddBaremetalPxeKickStartServerParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewAddBaremetalPxeKickStartServerParams(password string, physicalnetworkid string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxeKickStartServerParams {
@@ -243,7 +545,16 @@ func (s *BaremetalService) NewAddBaremetalPxeKickStartServerParams(password stri
 
 // add a baremetal pxe server
 func (s *BaremetalService) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalPxeKickStartServer", p.toURLValues())
+	return s.AddBaremetalPxeKickStartServerWithContext(context.Background(), p)
+}
+
+// AddBaremetalPxeKickStartServerWithContext is the same as AddBaremetalPxeKickStartServer, but uses ctx to cancel the request and any async job polling
+func (s *BaremetalService) AddBaremetalPxeKickStartServerWithContext(ctx context.Context, p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addBaremetalPxeKickStartServer", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -255,7 +566,7 @@ func (s *BaremetalService) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKick
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -276,6 +587,40 @@ func (s *BaremetalService) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKick
 	return &r, nil
 }
 
+// AddBaremetalPxeKickStartServerAsync starts the async job for AddBaremetalPxeKickStartServer and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddBaremetalPxeKickStartServerResponse using Job.Result
+func (s *BaremetalService) AddBaremetalPxeKickStartServerAsync(p *AddBaremetalPxeKickStartServerParams) (*Job, error) {
+	return s.AddBaremetalPxeKickStartServerAsyncWithContext(context.Background(), p)
+}
+
+// AddBaremetalPxeKickStartServerAsyncWithContext is the same as AddBaremetalPxeKickStartServerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BaremetalService) AddBaremetalPxeKickStartServerAsyncWithContext(ctx context.Context, p *AddBaremetalPxeKickStartServerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addBaremetalPxeKickStartServer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddBaremetalPxeKickStartServerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AddBaremetalPxeKickStartServerResponse struct {
 	Id                string `json:"id"`
 	JobID             string `json:"jobid"`
@@ -290,7 +635,8 @@ type AddBaremetalPxePingServerParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddBaremetalPxePingServerParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddBaremetalPxePingServerParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -331,6 +677,57 @@ func (p *AddBaremetalPxePingServerParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddBaremetalPxePingServerParams) Validate() error {
+	v := newParamValidator("addBaremetalPxePingServer", p.p)
+	v.required("password", "physicalnetworkid", "pingdir", "pingstorageserverip", "pxeservertype", "tftpdir", "url", "username")
+	v.ids("physicalnetworkid", "podid")
+	v.maxLength("password", 255)
+	v.maxLength("pingcifspassword", 255)
+	v.maxLength("pingcifsusername", 255)
+	v.maxLength("pingdir", 255)
+	v.maxLength("pingstorageserverip", 255)
+	v.maxLength("pxeservertype", 255)
+	v.maxLength("tftpdir", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddBaremetalPxePingServerParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddBaremetalPxePingServerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "password", "physicalnetworkid", "pingcifspassword", "pingcifsusername", "pingdir", "pingstorageserverip", "podid", "pxeservertype", "tftpdir", "url", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addBaremetalPxePingServer", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addBaremetalPxePingServer: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -338,6 +735,15 @@ func (p *AddBaremetalPxePingServerParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *AddBaremetalPxePingServerParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -345,6 +751,15 @@ func (p *AddBaremetalPxePingServerParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *AddBaremetalPxePingServerParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPingcifspassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -352,6 +767,15 @@ func (p *AddBaremetalPxePingServerParams) SetPingcifspassword(v string) {
 	p.p["pingcifspassword"] = v
 }
 
+func (p *AddBaremetalPxePingServerParams) ResetPingcifspassword() {
+	delete(p.p, "pingcifspassword")
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPingcifspassword() (string, bool) {
+	value, ok := p.p["pingcifspassword"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPingcifsusername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -359,6 +783,15 @@ func (p *AddBaremetalPxePingServerParams) SetPingcifsusername(v string) {
 	p.p["pingcifsusername"] = v
 }
 
+func (p *AddBaremetalPxePingServerParams) ResetPingcifsusername() {
+	delete(p.p, "pingcifsusername")
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPingcifsusername() (string, bool) {
+	value, ok := p.p["pingcifsusername"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPingdir(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -366,6 +799,15 @@ func (p *AddBaremetalPxePingServerParams) SetPingdir(v string) {
 	p.p["pingdir"] = v
 }
 
+func (p *AddBaremetalPxePingServerParams) ResetPingdir() {
+	delete(p.p, "pingdir")
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPingdir() (string, bool) {
+	value, ok := p.p["pingdir"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPingstorageserverip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -373,6 +815,15 @@ func (p *AddBaremetalPxePingServerParams) SetPingstorageserverip(v string) {
 	p.p["pingstorageserverip"] = v
 }
 
+func (p *AddBaremetalPxePingServerParams) ResetPingstorageserverip() {
+	delete(p.p, "pingstorageserverip")
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPingstorageserverip() (string, bool) {
+	value, ok := p.p["pingstorageserverip"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -380,6 +831,15 @@ func (p *AddBaremetalPxePingServerParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *AddBaremetalPxePingServerParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPxeservertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -387,6 +847,15 @@ func (p *AddBaremetalPxePingServerParams) SetPxeservertype(v string) {
 	p.p["pxeservertype"] = v
 }
 
+func (p *AddBaremetalPxePingServerParams) ResetPxeservertype() {
+	delete(p.p, "pxeservertype")
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPxeservertype() (string, bool) {
+	value, ok := p.p["pxeservertype"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxePingServerParams) SetTftpdir(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -394,6 +863,15 @@ func (p *AddBaremetalPxePingServerParams) SetTftpdir(v string) {
 	p.p["tftpdir"] = v
 }
 
+func (p *AddBaremetalPxePingServerParams) ResetTftpdir() {
+	delete(p.p, "tftpdir")
+}
+
+func (p *AddBaremetalPxePingServerParams) GetTftpdir() (string, bool) {
+	value, ok := p.p["tftpdir"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxePingServerParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -401,6 +879,15 @@ func (p *AddBaremetalPxePingServerParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *AddBaremetalPxePingServerParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddBaremetalPxePingServerParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalPxePingServerParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -408,6 +895,15 @@ func (p *AddBaremetalPxePingServerParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddBaremetalPxePingServerParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddBaremetalPxePingServerParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddBaremetalPxePingServerParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewAddBaremetalPxePingServerParams(password string, physicalnetworkid string, pingdir string, pingstorageserverip string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxePingServerParams {
@@ -426,7 +922,16 @@ func (s *BaremetalService) NewAddBaremetalPxePingServerParams(password string, p
 
 // add a baremetal ping pxe server
 func (s *BaremetalService) AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalPxePingServer", p.toURLValues())
+	return s.AddBaremetalPxePingServerWithContext(context.Background(), p)
+}
+
+// AddBaremetalPxePingServerWithContext is the same as AddBaremetalPxePingServer, but uses ctx to cancel the request and any async job polling
+func (s *BaremetalService) AddBaremetalPxePingServerWithContext(ctx context.Context, p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addBaremetalPxePingServer", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -438,7 +943,7 @@ func (s *BaremetalService) AddBaremetalPxePingServer(p *AddBaremetalPxePingServe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -459,6 +964,40 @@ func (s *BaremetalService) AddBaremetalPxePingServer(p *AddBaremetalPxePingServe
 	return &r, nil
 }
 
+// AddBaremetalPxePingServerAsync starts the async job for AddBaremetalPxePingServer and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddBaremetalPxePingServerResponse using Job.Result
+func (s *BaremetalService) AddBaremetalPxePingServerAsync(p *AddBaremetalPxePingServerParams) (*Job, error) {
+	return s.AddBaremetalPxePingServerAsyncWithContext(context.Background(), p)
+}
+
+// AddBaremetalPxePingServerAsyncWithContext is the same as AddBaremetalPxePingServerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BaremetalService) AddBaremetalPxePingServerAsyncWithContext(ctx context.Context, p *AddBaremetalPxePingServerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addBaremetalPxePingServer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddBaremetalPxePingServerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AddBaremetalPxePingServerResponse struct {
 	Id                  string `json:"id"`
 	JobID               string `json:"jobid"`
@@ -475,7 +1014,8 @@ type AddBaremetalRctParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddBaremetalRctParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddBaremetalRctParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -486,6 +1026,47 @@ func (p *AddBaremetalRctParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddBaremetalRctParams) Validate() error {
+	v := newParamValidator("addBaremetalRct", p.p)
+	v.required("baremetalrcturl")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddBaremetalRctParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddBaremetalRctParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "baremetalrcturl":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addBaremetalRct", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addBaremetalRct: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddBaremetalRctParams) SetBaremetalrcturl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -493,6 +1074,15 @@ func (p *AddBaremetalRctParams) SetBaremetalrcturl(v string) {
 	p.p["baremetalrcturl"] = v
 }
 
+func (p *AddBaremetalRctParams) ResetBaremetalrcturl() {
+	delete(p.p, "baremetalrcturl")
+}
+
+func (p *AddBaremetalRctParams) GetBaremetalrcturl() (string, bool) {
+	value, ok := p.p["baremetalrcturl"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddBaremetalRctParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewAddBaremetalRctParams(baremetalrcturl string) *AddBaremetalRctParams {
@@ -504,7 +1094,16 @@ func (s *BaremetalService) NewAddBaremetalRctParams(baremetalrcturl string) *Add
 
 // adds baremetal rack configuration text
 func (s *BaremetalService) AddBaremetalRct(p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalRct", p.toURLValues())
+	return s.AddBaremetalRctWithContext(context.Background(), p)
+}
+
+// AddBaremetalRctWithContext is the same as AddBaremetalRct, but uses ctx to cancel the request and any async job polling
+func (s *BaremetalService) AddBaremetalRctWithContext(ctx context.Context, p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addBaremetalRct", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -516,7 +1115,7 @@ func (s *BaremetalService) AddBaremetalRct(p *AddBaremetalRctParams) (*AddBareme
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -537,27 +1136,104 @@ func (s *BaremetalService) AddBaremetalRct(p *AddBaremetalRctParams) (*AddBareme
 	return &r, nil
 }
 
-type AddBaremetalRctResponse struct {
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Url       string `json:"url"`
-}
-
-type DeleteBaremetalRctParams struct {
-	p map[string]interface{}
+// AddBaremetalRctAsync starts the async job for AddBaremetalRct and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddBaremetalRctResponse using Job.Result
+func (s *BaremetalService) AddBaremetalRctAsync(p *AddBaremetalRctParams) (*Job, error) {
+	return s.AddBaremetalRctAsyncWithContext(context.Background(), p)
 }
 
-func (p *DeleteBaremetalRctParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+// AddBaremetalRctAsyncWithContext is the same as AddBaremetalRctAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BaremetalService) AddBaremetalRctAsyncWithContext(ctx context.Context, p *AddBaremetalRctParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
-	return u
-}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addBaremetalRct", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddBaremetalRctResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type AddBaremetalRctResponse struct {
+	Id        string `json:"id"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Url       string `json:"url"`
+}
+
+type DeleteBaremetalRctParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteBaremetalRctParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteBaremetalRctParams) Validate() error {
+	v := newParamValidator("deleteBaremetalRct", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteBaremetalRctParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteBaremetalRctParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteBaremetalRct", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteBaremetalRct: %w", k, err)
+		}
+	}
+	return nil
+}
 
 func (p *DeleteBaremetalRctParams) SetId(v string) {
 	if p.p == nil {
@@ -566,6 +1242,15 @@ func (p *DeleteBaremetalRctParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteBaremetalRctParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteBaremetalRctParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteBaremetalRctParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewDeleteBaremetalRctParams(id string) *DeleteBaremetalRctParams {
@@ -577,7 +1262,16 @@ func (s *BaremetalService) NewDeleteBaremetalRctParams(id string) *DeleteBaremet
 
 // deletes baremetal rack configuration text
 func (s *BaremetalService) DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error) {
-	resp, err := s.cs.newRequest("deleteBaremetalRct", p.toURLValues())
+	return s.DeleteBaremetalRctWithContext(context.Background(), p)
+}
+
+// DeleteBaremetalRctWithContext is the same as DeleteBaremetalRct, but uses ctx to cancel the request and any async job polling
+func (s *BaremetalService) DeleteBaremetalRctWithContext(ctx context.Context, p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteBaremetalRct", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -589,7 +1283,7 @@ func (s *BaremetalService) DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*Del
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -605,6 +1299,31 @@ func (s *BaremetalService) DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*Del
 	return &r, nil
 }
 
+// DeleteBaremetalRctAsync starts the async job for DeleteBaremetalRct and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteBaremetalRctResponse using Job.Result
+func (s *BaremetalService) DeleteBaremetalRctAsync(p *DeleteBaremetalRctParams) (*Job, error) {
+	return s.DeleteBaremetalRctAsyncWithContext(context.Background(), p)
+}
+
+// DeleteBaremetalRctAsyncWithContext is the same as DeleteBaremetalRctAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BaremetalService) DeleteBaremetalRctAsyncWithContext(ctx context.Context, p *DeleteBaremetalRctParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteBaremetalRct", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteBaremetalRctResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteBaremetalRctResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -616,7 +1335,8 @@ type ListBaremetalDhcpParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListBaremetalDhcpParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListBaremetalDhcpParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -645,6 +1365,58 @@ func (p *ListBaremetalDhcpParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListBaremetalDhcpParams) Validate() error {
+	v := newParamValidator("listBaremetalDhcp", p.p)
+	v.required("physicalnetworkid")
+	v.ids("physicalnetworkid")
+	v.maxLength("dhcpservertype", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListBaremetalDhcpParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListBaremetalDhcpParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "dhcpservertype", "keyword", "physicalnetworkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listBaremetalDhcp", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listBaremetalDhcp: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListBaremetalDhcpParams) SetDhcpservertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -652,6 +1424,15 @@ func (p *ListBaremetalDhcpParams) SetDhcpservertype(v string) {
 	p.p["dhcpservertype"] = v
 }
 
+func (p *ListBaremetalDhcpParams) ResetDhcpservertype() {
+	delete(p.p, "dhcpservertype")
+}
+
+func (p *ListBaremetalDhcpParams) GetDhcpservertype() (string, bool) {
+	value, ok := p.p["dhcpservertype"].(string)
+	return value, ok
+}
+
 func (p *ListBaremetalDhcpParams) SetId(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -659,6 +1440,15 @@ func (p *ListBaremetalDhcpParams) SetId(v int64) {
 	p.p["id"] = v
 }
 
+func (p *ListBaremetalDhcpParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListBaremetalDhcpParams) GetId() (int64, bool) {
+	value, ok := p.p["id"].(int64)
+	return value, ok
+}
+
 func (p *ListBaremetalDhcpParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -666,6 +1456,15 @@ func (p *ListBaremetalDhcpParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListBaremetalDhcpParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListBaremetalDhcpParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListBaremetalDhcpParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -673,6 +1472,15 @@ func (p *ListBaremetalDhcpParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListBaremetalDhcpParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListBaremetalDhcpParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListBaremetalDhcpParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -680,6 +1488,15 @@ func (p *ListBaremetalDhcpParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListBaremetalDhcpParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListBaremetalDhcpParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListBaremetalDhcpParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -687,6 +1504,15 @@ func (p *ListBaremetalDhcpParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *ListBaremetalDhcpParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListBaremetalDhcpParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListBaremetalDhcpParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewListBaremetalDhcpParams(physicalnetworkid string) *ListBaremetalDhcpParams {
@@ -698,7 +1524,16 @@ func (s *BaremetalService) NewListBaremetalDhcpParams(physicalnetworkid string)
 
 // list baremetal dhcp servers
 func (s *BaremetalService) ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error) {
-	resp, err := s.cs.newRequest("listBaremetalDhcp", p.toURLValues())
+	return s.ListBaremetalDhcpWithContext(context.Background(), p)
+}
+
+// ListBaremetalDhcpWithContext is the same as ListBaremetalDhcp, but uses ctx to cancel the request and any async job polling
+func (s *BaremetalService) ListBaremetalDhcpWithContext(ctx context.Context, p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listBaremetalDhcp", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -711,6 +1546,92 @@ func (s *BaremetalService) ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListB
 	return &r, nil
 }
 
+// ListBaremetalDhcpPager iterates over all pages of a ListBaremetalDhcp call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListBaremetalDhcpPager struct {
+	pager
+
+	s *BaremetalService
+	p *ListBaremetalDhcpParams
+	r *ListBaremetalDhcpResponse
+}
+
+// NewListBaremetalDhcpPager returns a pager for all pages of a ListBaremetalDhcp call with the given params
+func (s *BaremetalService) NewListBaremetalDhcpPager(p *ListBaremetalDhcpParams) *ListBaremetalDhcpPager {
+	return &ListBaremetalDhcpPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListBaremetalDhcpPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListBaremetalDhcpPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListBaremetalDhcpParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListBaremetalDhcpWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.BaremetalDhcp), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListBaremetalDhcpPager) Page() *ListBaremetalDhcpResponse {
+	return pg.r
+}
+
+// ListBaremetalDhcpAll fetches all pages of a ListBaremetalDhcp call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *BaremetalService) ListBaremetalDhcpAll(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error) {
+	return s.ListBaremetalDhcpAllWithContext(context.Background(), p)
+}
+
+// ListBaremetalDhcpAllWithContext is the same as ListBaremetalDhcpAll, but uses ctx to cancel the requests
+func (s *BaremetalService) ListBaremetalDhcpAllWithContext(ctx context.Context, p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*BaremetalDhcp)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListBaremetalDhcpParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListBaremetalDhcpWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.BaremetalDhcp
+		mu.Unlock()
+
+		return len(l.BaremetalDhcp), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListBaremetalDhcpResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.BaremetalDhcp = append(r.BaremetalDhcp, pages[page]...)
+	}
+	r.Count = len(r.BaremetalDhcp)
+
+	return r, nil
+}
+
 type ListBaremetalDhcpResponse struct {
 	Count         int              `json:"count"`
 	BaremetalDhcp []*BaremetalDhcp `json:"baremetaldhcp"`
@@ -730,7 +1651,8 @@ type ListBaremetalPxeServersParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListBaremetalPxeServersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListBaremetalPxeServersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -756,6 +1678,57 @@ func (p *ListBaremetalPxeServersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListBaremetalPxeServersParams) Validate() error {
+	v := newParamValidator("listBaremetalPxeServers", p.p)
+	v.required("physicalnetworkid")
+	v.ids("physicalnetworkid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListBaremetalPxeServersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListBaremetalPxeServersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "keyword", "physicalnetworkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listBaremetalPxeServers", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listBaremetalPxeServers: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListBaremetalPxeServersParams) SetId(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -763,6 +1736,15 @@ func (p *ListBaremetalPxeServersParams) SetId(v int64) {
 	p.p["id"] = v
 }
 
+func (p *ListBaremetalPxeServersParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListBaremetalPxeServersParams) GetId() (int64, bool) {
+	value, ok := p.p["id"].(int64)
+	return value, ok
+}
+
 func (p *ListBaremetalPxeServersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -770,6 +1752,15 @@ func (p *ListBaremetalPxeServersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListBaremetalPxeServersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListBaremetalPxeServersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListBaremetalPxeServersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -777,6 +1768,15 @@ func (p *ListBaremetalPxeServersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListBaremetalPxeServersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListBaremetalPxeServersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListBaremetalPxeServersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -784,6 +1784,15 @@ func (p *ListBaremetalPxeServersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListBaremetalPxeServersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListBaremetalPxeServersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListBaremetalPxeServersParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -791,6 +1800,15 @@ func (p *ListBaremetalPxeServersParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *ListBaremetalPxeServersParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListBaremetalPxeServersParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListBaremetalPxeServersParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewListBaremetalPxeServersParams(physicalnetworkid string) *ListBaremetalPxeServersParams {
@@ -802,7 +1820,16 @@ func (s *BaremetalService) NewListBaremetalPxeServersParams(physicalnetworkid st
 
 // list baremetal pxe server
 func (s *BaremetalService) ListBaremetalPxeServers(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error) {
-	resp, err := s.cs.newRequest("listBaremetalPxeServers", p.toURLValues())
+	return s.ListBaremetalPxeServersWithContext(context.Background(), p)
+}
+
+// ListBaremetalPxeServersWithContext is the same as ListBaremetalPxeServers, but uses ctx to cancel the request and any async job polling
+func (s *BaremetalService) ListBaremetalPxeServersWithContext(ctx context.Context, p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listBaremetalPxeServers", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -815,6 +1842,92 @@ func (s *BaremetalService) ListBaremetalPxeServers(p *ListBaremetalPxeServersPar
 	return &r, nil
 }
 
+// ListBaremetalPxeServersPager iterates over all pages of a ListBaremetalPxeServers call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListBaremetalPxeServersPager struct {
+	pager
+
+	s *BaremetalService
+	p *ListBaremetalPxeServersParams
+	r *ListBaremetalPxeServersResponse
+}
+
+// NewListBaremetalPxeServersPager returns a pager for all pages of a ListBaremetalPxeServers call with the given params
+func (s *BaremetalService) NewListBaremetalPxeServersPager(p *ListBaremetalPxeServersParams) *ListBaremetalPxeServersPager {
+	return &ListBaremetalPxeServersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListBaremetalPxeServersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListBaremetalPxeServersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListBaremetalPxeServersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListBaremetalPxeServersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.BaremetalPxeServers), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListBaremetalPxeServersPager) Page() *ListBaremetalPxeServersResponse {
+	return pg.r
+}
+
+// ListBaremetalPxeServersAll fetches all pages of a ListBaremetalPxeServers call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *BaremetalService) ListBaremetalPxeServersAll(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error) {
+	return s.ListBaremetalPxeServersAllWithContext(context.Background(), p)
+}
+
+// ListBaremetalPxeServersAllWithContext is the same as ListBaremetalPxeServersAll, but uses ctx to cancel the requests
+func (s *BaremetalService) ListBaremetalPxeServersAllWithContext(ctx context.Context, p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*BaremetalPxeServer)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListBaremetalPxeServersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListBaremetalPxeServersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.BaremetalPxeServers
+		mu.Unlock()
+
+		return len(l.BaremetalPxeServers), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListBaremetalPxeServersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.BaremetalPxeServers = append(r.BaremetalPxeServers, pages[page]...)
+	}
+	r.Count = len(r.BaremetalPxeServers)
+
+	return r, nil
+}
+
 type ListBaremetalPxeServersResponse struct {
 	Count               int                   `json:"count"`
 	BaremetalPxeServers []*BaremetalPxeServer `json:"baremetalpxeserver"`
@@ -833,7 +1946,8 @@ type ListBaremetalRctParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListBaremetalRctParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListBaremetalRctParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -852,6 +1966,51 @@ func (p *ListBaremetalRctParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListBaremetalRctParams) Validate() error {
+	v := newParamValidator("listBaremetalRct", p.p)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListBaremetalRctParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListBaremetalRctParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listBaremetalRct", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listBaremetalRct: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListBaremetalRctParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -859,6 +2018,15 @@ func (p *ListBaremetalRctParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListBaremetalRctParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListBaremetalRctParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListBaremetalRctParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -866,6 +2034,15 @@ func (p *ListBaremetalRctParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListBaremetalRctParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListBaremetalRctParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListBaremetalRctParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -873,6 +2050,15 @@ func (p *ListBaremetalRctParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListBaremetalRctParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListBaremetalRctParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListBaremetalRctParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewListBaremetalRctParams() *ListBaremetalRctParams {
@@ -883,7 +2069,16 @@ func (s *BaremetalService) NewListBaremetalRctParams() *ListBaremetalRctParams {
 
 // list baremetal rack configuration
 func (s *BaremetalService) ListBaremetalRct(p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error) {
-	resp, err := s.cs.newRequest("listBaremetalRct", p.toURLValues())
+	return s.ListBaremetalRctWithContext(context.Background(), p)
+}
+
+// ListBaremetalRctWithContext is the same as ListBaremetalRct, but uses ctx to cancel the request and any async job polling
+func (s *BaremetalService) ListBaremetalRctWithContext(ctx context.Context, p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listBaremetalRct", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -896,6 +2091,92 @@ func (s *BaremetalService) ListBaremetalRct(p *ListBaremetalRctParams) (*ListBar
 	return &r, nil
 }
 
+// ListBaremetalRctPager iterates over all pages of a ListBaremetalRct call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListBaremetalRctPager struct {
+	pager
+
+	s *BaremetalService
+	p *ListBaremetalRctParams
+	r *ListBaremetalRctResponse
+}
+
+// NewListBaremetalRctPager returns a pager for all pages of a ListBaremetalRct call with the given params
+func (s *BaremetalService) NewListBaremetalRctPager(p *ListBaremetalRctParams) *ListBaremetalRctPager {
+	return &ListBaremetalRctPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListBaremetalRctPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListBaremetalRctPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListBaremetalRctParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListBaremetalRctWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.BaremetalRct), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListBaremetalRctPager) Page() *ListBaremetalRctResponse {
+	return pg.r
+}
+
+// ListBaremetalRctAll fetches all pages of a ListBaremetalRct call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *BaremetalService) ListBaremetalRctAll(p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error) {
+	return s.ListBaremetalRctAllWithContext(context.Background(), p)
+}
+
+// ListBaremetalRctAllWithContext is the same as ListBaremetalRctAll, but uses ctx to cancel the requests
+func (s *BaremetalService) ListBaremetalRctAllWithContext(ctx context.Context, p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*BaremetalRct)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListBaremetalRctParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListBaremetalRctWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.BaremetalRct
+		mu.Unlock()
+
+		return len(l.BaremetalRct), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListBaremetalRctResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.BaremetalRct = append(r.BaremetalRct, pages[page]...)
+	}
+	r.Count = len(r.BaremetalRct)
+
+	return r, nil
+}
+
 type ListBaremetalRctResponse struct {
 	Count        int             `json:"count"`
 	BaremetalRct []*BaremetalRct `json:"baremetalrct"`
@@ -912,7 +2193,8 @@ type NotifyBaremetalProvisionDoneParams struct {
 	p map[string]interface{}
 }
 
-func (p *NotifyBaremetalProvisionDoneParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *NotifyBaremetalProvisionDoneParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -923,6 +2205,47 @@ func (p *NotifyBaremetalProvisionDoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *NotifyBaremetalProvisionDoneParams) Validate() error {
+	v := newParamValidator("notifyBaremetalProvisionDone", p.p)
+	v.required("mac")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *NotifyBaremetalProvisionDoneParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *NotifyBaremetalProvisionDoneParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "mac":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for notifyBaremetalProvisionDone", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of notifyBaremetalProvisionDone: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *NotifyBaremetalProvisionDoneParams) SetMac(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -930,6 +2253,15 @@ func (p *NotifyBaremetalProvisionDoneParams) SetMac(v string) {
 	p.p["mac"] = v
 }
 
+func (p *NotifyBaremetalProvisionDoneParams) ResetMac() {
+	delete(p.p, "mac")
+}
+
+func (p *NotifyBaremetalProvisionDoneParams) GetMac() (string, bool) {
+	value, ok := p.p["mac"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new NotifyBaremetalProvisionDoneParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewNotifyBaremetalProvisionDoneParams(mac string) *NotifyBaremetalProvisionDoneParams {
@@ -941,7 +2273,16 @@ func (s *BaremetalService) NewNotifyBaremetalProvisionDoneParams(mac string) *No
 
 // Notify provision has been done on a host. This api is for baremetal virtual router service, not for end user
 func (s *BaremetalService) NotifyBaremetalProvisionDone(p *NotifyBaremetalProvisionDoneParams) (*NotifyBaremetalProvisionDoneResponse, error) {
-	resp, err := s.cs.newRequest("notifyBaremetalProvisionDone", p.toURLValues())
+	return s.NotifyBaremetalProvisionDoneWithContext(context.Background(), p)
+}
+
+// NotifyBaremetalProvisionDoneWithContext is the same as NotifyBaremetalProvisionDone, but uses ctx to cancel the request and any async job polling
+func (s *BaremetalService) NotifyBaremetalProvisionDoneWithContext(ctx context.Context, p *NotifyBaremetalProvisionDoneParams) (*NotifyBaremetalProvisionDoneResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "notifyBaremetalProvisionDone", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -953,7 +2294,7 @@ func (s *BaremetalService) NotifyBaremetalProvisionDone(p *NotifyBaremetalProvis
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -969,6 +2310,31 @@ func (s *BaremetalService) NotifyBaremetalProvisionDone(p *NotifyBaremetalProvis
 	return &r, nil
 }
 
+// NotifyBaremetalProvisionDoneAsync starts the async job for NotifyBaremetalProvisionDone and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a NotifyBaremetalProvisionDoneResponse using Job.Result
+func (s *BaremetalService) NotifyBaremetalProvisionDoneAsync(p *NotifyBaremetalProvisionDoneParams) (*Job, error) {
+	return s.NotifyBaremetalProvisionDoneAsyncWithContext(context.Background(), p)
+}
+
+// NotifyBaremetalProvisionDoneAsyncWithContext is the same as NotifyBaremetalProvisionDoneAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BaremetalService) NotifyBaremetalProvisionDoneAsyncWithContext(ctx context.Context, p *NotifyBaremetalProvisionDoneParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "notifyBaremetalProvisionDone", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r NotifyBaremetalProvisionDoneResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type NotifyBaremetalProvisionDoneResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -20,16 +20,41 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// BigSwitchBCFServiceIface is the interface implemented by BigSwitchBCFService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type BigSwitchBCFServiceIface interface {
+	NewAddBigSwitchBcfDeviceParams(hostname string, nat bool, password string, physicalnetworkid string, username string) *AddBigSwitchBcfDeviceParams
+	AddBigSwitchBcfDevice(p *AddBigSwitchBcfDeviceParams) (*AddBigSwitchBcfDeviceResponse, error)
+	AddBigSwitchBcfDeviceWithContext(ctx context.Context, p *AddBigSwitchBcfDeviceParams) (*AddBigSwitchBcfDeviceResponse, error)
+	AddBigSwitchBcfDeviceAsync(p *AddBigSwitchBcfDeviceParams) (*Job, error)
+	AddBigSwitchBcfDeviceAsyncWithContext(ctx context.Context, p *AddBigSwitchBcfDeviceParams) (*Job, error)
+	NewDeleteBigSwitchBcfDeviceParams(bcfdeviceid string) *DeleteBigSwitchBcfDeviceParams
+	DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error)
+	DeleteBigSwitchBcfDeviceWithContext(ctx context.Context, p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error)
+	DeleteBigSwitchBcfDeviceAsync(p *DeleteBigSwitchBcfDeviceParams) (*Job, error)
+	DeleteBigSwitchBcfDeviceAsyncWithContext(ctx context.Context, p *DeleteBigSwitchBcfDeviceParams) (*Job, error)
+	NewListBigSwitchBcfDevicesParams() *ListBigSwitchBcfDevicesParams
+	ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error)
+	ListBigSwitchBcfDevicesWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error)
+	NewListBigSwitchBcfDevicesPager(p *ListBigSwitchBcfDevicesParams) *ListBigSwitchBcfDevicesPager
+	ListBigSwitchBcfDevicesAll(p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error)
+	ListBigSwitchBcfDevicesAllWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error)
+}
+
 type AddBigSwitchBcfDeviceParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddBigSwitchBcfDeviceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddBigSwitchBcfDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -53,6 +78,55 @@ func (p *AddBigSwitchBcfDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddBigSwitchBcfDeviceParams) Validate() error {
+	v := newParamValidator("addBigSwitchBcfDevice", p.p)
+	v.required("hostname", "nat", "password", "physicalnetworkid", "username")
+	v.ids("physicalnetworkid")
+	v.maxLength("hostname", 255)
+	v.maxLength("password", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddBigSwitchBcfDeviceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddBigSwitchBcfDeviceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostname", "password", "physicalnetworkid", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "nat":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addBigSwitchBcfDevice", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addBigSwitchBcfDevice: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddBigSwitchBcfDeviceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -60,6 +134,15 @@ func (p *AddBigSwitchBcfDeviceParams) SetHostname(v string) {
 	p.p["hostname"] = v
 }
 
+func (p *AddBigSwitchBcfDeviceParams) ResetHostname() {
+	delete(p.p, "hostname")
+}
+
+func (p *AddBigSwitchBcfDeviceParams) GetHostname() (string, bool) {
+	value, ok := p.p["hostname"].(string)
+	return value, ok
+}
+
 func (p *AddBigSwitchBcfDeviceParams) SetNat(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -67,6 +150,15 @@ func (p *AddBigSwitchBcfDeviceParams) SetNat(v bool) {
 	p.p["nat"] = v
 }
 
+func (p *AddBigSwitchBcfDeviceParams) ResetNat() {
+	delete(p.p, "nat")
+}
+
+func (p *AddBigSwitchBcfDeviceParams) GetNat() (bool, bool) {
+	value, ok := p.p["nat"].(bool)
+	return value, ok
+}
+
 func (p *AddBigSwitchBcfDeviceParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -74,6 +166,15 @@ func (p *AddBigSwitchBcfDeviceParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *AddBigSwitchBcfDeviceParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddBigSwitchBcfDeviceParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *AddBigSwitchBcfDeviceParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -81,6 +182,15 @@ func (p *AddBigSwitchBcfDeviceParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *AddBigSwitchBcfDeviceParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddBigSwitchBcfDeviceParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *AddBigSwitchBcfDeviceParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -88,6 +198,15 @@ func (p *AddBigSwitchBcfDeviceParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddBigSwitchBcfDeviceParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddBigSwitchBcfDeviceParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddBigSwitchBcfDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *BigSwitchBCFService) NewAddBigSwitchBcfDeviceParams(hostname string, nat bool, password string, physicalnetworkid string, username string) *AddBigSwitchBcfDeviceParams {
@@ -103,7 +222,16 @@ func (s *BigSwitchBCFService) NewAddBigSwitchBcfDeviceParams(hostname string, na
 
 // Adds a BigSwitch BCF Controller device
 func (s *BigSwitchBCFService) AddBigSwitchBcfDevice(p *AddBigSwitchBcfDeviceParams) (*AddBigSwitchBcfDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addBigSwitchBcfDevice", p.toURLValues())
+	return s.AddBigSwitchBcfDeviceWithContext(context.Background(), p)
+}
+
+// AddBigSwitchBcfDeviceWithContext is the same as AddBigSwitchBcfDevice, but uses ctx to cancel the request and any async job polling
+func (s *BigSwitchBCFService) AddBigSwitchBcfDeviceWithContext(ctx context.Context, p *AddBigSwitchBcfDeviceParams) (*AddBigSwitchBcfDeviceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addBigSwitchBcfDevice", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +243,7 @@ func (s *BigSwitchBCFService) AddBigSwitchBcfDevice(p *AddBigSwitchBcfDevicePara
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -136,6 +264,40 @@ func (s *BigSwitchBCFService) AddBigSwitchBcfDevice(p *AddBigSwitchBcfDevicePara
 	return &r, nil
 }
 
+// AddBigSwitchBcfDeviceAsync starts the async job for AddBigSwitchBcfDevice and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddBigSwitchBcfDeviceResponse using Job.Result
+func (s *BigSwitchBCFService) AddBigSwitchBcfDeviceAsync(p *AddBigSwitchBcfDeviceParams) (*Job, error) {
+	return s.AddBigSwitchBcfDeviceAsyncWithContext(context.Background(), p)
+}
+
+// AddBigSwitchBcfDeviceAsyncWithContext is the same as AddBigSwitchBcfDeviceAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BigSwitchBCFService) AddBigSwitchBcfDeviceAsyncWithContext(ctx context.Context, p *AddBigSwitchBcfDeviceParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addBigSwitchBcfDevice", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddBigSwitchBcfDeviceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AddBigSwitchBcfDeviceResponse struct {
 	Bcfdeviceid         string `json:"bcfdeviceid"`
 	Bigswitchdevicename string `json:"bigswitchdevicename"`
@@ -153,7 +315,8 @@ type DeleteBigSwitchBcfDeviceParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteBigSwitchBcfDeviceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteBigSwitchBcfDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -164,6 +327,48 @@ func (p *DeleteBigSwitchBcfDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteBigSwitchBcfDeviceParams) Validate() error {
+	v := newParamValidator("deleteBigSwitchBcfDevice", p.p)
+	v.required("bcfdeviceid")
+	v.ids("bcfdeviceid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteBigSwitchBcfDeviceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteBigSwitchBcfDeviceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "bcfdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteBigSwitchBcfDevice", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteBigSwitchBcfDevice: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteBigSwitchBcfDeviceParams) SetBcfdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -171,6 +376,15 @@ func (p *DeleteBigSwitchBcfDeviceParams) SetBcfdeviceid(v string) {
 	p.p["bcfdeviceid"] = v
 }
 
+func (p *DeleteBigSwitchBcfDeviceParams) ResetBcfdeviceid() {
+	delete(p.p, "bcfdeviceid")
+}
+
+func (p *DeleteBigSwitchBcfDeviceParams) GetBcfdeviceid() (string, bool) {
+	value, ok := p.p["bcfdeviceid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteBigSwitchBcfDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *BigSwitchBCFService) NewDeleteBigSwitchBcfDeviceParams(bcfdeviceid string) *DeleteBigSwitchBcfDeviceParams {
@@ -180,9 +394,18 @@ func (s *BigSwitchBCFService) NewDeleteBigSwitchBcfDeviceParams(bcfdeviceid stri
 	return p
 }
 
-//  delete a BigSwitch BCF Controller device
+// delete a BigSwitch BCF Controller device
 func (s *BigSwitchBCFService) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteBigSwitchBcfDevice", p.toURLValues())
+	return s.DeleteBigSwitchBcfDeviceWithContext(context.Background(), p)
+}
+
+// DeleteBigSwitchBcfDeviceWithContext is the same as DeleteBigSwitchBcfDevice, but uses ctx to cancel the request and any async job polling
+func (s *BigSwitchBCFService) DeleteBigSwitchBcfDeviceWithContext(ctx context.Context, p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteBigSwitchBcfDevice", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -194,7 +417,7 @@ func (s *BigSwitchBCFService) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDevi
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -210,6 +433,31 @@ func (s *BigSwitchBCFService) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDevi
 	return &r, nil
 }
 
+// DeleteBigSwitchBcfDeviceAsync starts the async job for DeleteBigSwitchBcfDevice and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteBigSwitchBcfDeviceResponse using Job.Result
+func (s *BigSwitchBCFService) DeleteBigSwitchBcfDeviceAsync(p *DeleteBigSwitchBcfDeviceParams) (*Job, error) {
+	return s.DeleteBigSwitchBcfDeviceAsyncWithContext(context.Background(), p)
+}
+
+// DeleteBigSwitchBcfDeviceAsyncWithContext is the same as DeleteBigSwitchBcfDeviceAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BigSwitchBCFService) DeleteBigSwitchBcfDeviceAsyncWithContext(ctx context.Context, p *DeleteBigSwitchBcfDeviceParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteBigSwitchBcfDevice", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteBigSwitchBcfDeviceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteBigSwitchBcfDeviceResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -221,7 +469,8 @@ type ListBigSwitchBcfDevicesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListBigSwitchBcfDevicesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListBigSwitchBcfDevicesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -246,6 +495,52 @@ func (p *ListBigSwitchBcfDevicesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListBigSwitchBcfDevicesParams) Validate() error {
+	v := newParamValidator("listBigSwitchBcfDevices", p.p)
+	v.ids("bcfdeviceid", "physicalnetworkid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListBigSwitchBcfDevicesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListBigSwitchBcfDevicesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "bcfdeviceid", "keyword", "physicalnetworkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listBigSwitchBcfDevices", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listBigSwitchBcfDevices: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListBigSwitchBcfDevicesParams) SetBcfdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -253,6 +548,15 @@ func (p *ListBigSwitchBcfDevicesParams) SetBcfdeviceid(v string) {
 	p.p["bcfdeviceid"] = v
 }
 
+func (p *ListBigSwitchBcfDevicesParams) ResetBcfdeviceid() {
+	delete(p.p, "bcfdeviceid")
+}
+
+func (p *ListBigSwitchBcfDevicesParams) GetBcfdeviceid() (string, bool) {
+	value, ok := p.p["bcfdeviceid"].(string)
+	return value, ok
+}
+
 func (p *ListBigSwitchBcfDevicesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -260,6 +564,15 @@ func (p *ListBigSwitchBcfDevicesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListBigSwitchBcfDevicesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListBigSwitchBcfDevicesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListBigSwitchBcfDevicesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -267,6 +580,15 @@ func (p *ListBigSwitchBcfDevicesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListBigSwitchBcfDevicesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListBigSwitchBcfDevicesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListBigSwitchBcfDevicesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -274,6 +596,15 @@ func (p *ListBigSwitchBcfDevicesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListBigSwitchBcfDevicesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListBigSwitchBcfDevicesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListBigSwitchBcfDevicesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -281,6 +612,15 @@ func (p *ListBigSwitchBcfDevicesParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *ListBigSwitchBcfDevicesParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListBigSwitchBcfDevicesParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListBigSwitchBcfDevicesParams instance,
 // as then you are sure you have configured all required params
 func (s *BigSwitchBCFService) NewListBigSwitchBcfDevicesParams() *ListBigSwitchBcfDevicesParams {
@@ -291,7 +631,16 @@ func (s *BigSwitchBCFService) NewListBigSwitchBcfDevicesParams() *ListBigSwitchB
 
 // Lists BigSwitch BCF Controller devices
 func (s *BigSwitchBCFService) ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listBigSwitchBcfDevices", p.toURLValues())
+	return s.ListBigSwitchBcfDevicesWithContext(context.Background(), p)
+}
+
+// ListBigSwitchBcfDevicesWithContext is the same as ListBigSwitchBcfDevices, but uses ctx to cancel the request and any async job polling
+func (s *BigSwitchBCFService) ListBigSwitchBcfDevicesWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listBigSwitchBcfDevices", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -304,6 +653,92 @@ func (s *BigSwitchBCFService) ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevices
 	return &r, nil
 }
 
+// ListBigSwitchBcfDevicesPager iterates over all pages of a ListBigSwitchBcfDevices call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListBigSwitchBcfDevicesPager struct {
+	pager
+
+	s *BigSwitchBCFService
+	p *ListBigSwitchBcfDevicesParams
+	r *ListBigSwitchBcfDevicesResponse
+}
+
+// NewListBigSwitchBcfDevicesPager returns a pager for all pages of a ListBigSwitchBcfDevices call with the given params
+func (s *BigSwitchBCFService) NewListBigSwitchBcfDevicesPager(p *ListBigSwitchBcfDevicesParams) *ListBigSwitchBcfDevicesPager {
+	return &ListBigSwitchBcfDevicesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListBigSwitchBcfDevicesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListBigSwitchBcfDevicesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListBigSwitchBcfDevicesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListBigSwitchBcfDevicesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.BigSwitchBcfDevices), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListBigSwitchBcfDevicesPager) Page() *ListBigSwitchBcfDevicesResponse {
+	return pg.r
+}
+
+// ListBigSwitchBcfDevicesAll fetches all pages of a ListBigSwitchBcfDevices call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *BigSwitchBCFService) ListBigSwitchBcfDevicesAll(p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error) {
+	return s.ListBigSwitchBcfDevicesAllWithContext(context.Background(), p)
+}
+
+// ListBigSwitchBcfDevicesAllWithContext is the same as ListBigSwitchBcfDevicesAll, but uses ctx to cancel the requests
+func (s *BigSwitchBCFService) ListBigSwitchBcfDevicesAllWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*BigSwitchBcfDevice)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListBigSwitchBcfDevicesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListBigSwitchBcfDevicesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.BigSwitchBcfDevices
+		mu.Unlock()
+
+		return len(l.BigSwitchBcfDevices), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListBigSwitchBcfDevicesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.BigSwitchBcfDevices = append(r.BigSwitchBcfDevices, pages[page]...)
+	}
+	r.Count = len(r.BigSwitchBcfDevices)
+
+	return r, nil
+}
+
 type ListBigSwitchBcfDevicesResponse struct {
 	Count               int                   `json:"count"`
 	BigSwitchBcfDevices []*BigSwitchBcfDevice `json:"bigswitchbcfdevice"`
@@ -20,17 +20,49 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// BrocadeVCSServiceIface is the interface implemented by BrocadeVCSService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type BrocadeVCSServiceIface interface {
+	NewAddBrocadeVcsDeviceParams(hostname string, password string, physicalnetworkid string, username string) *AddBrocadeVcsDeviceParams
+	AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams) (*AddBrocadeVcsDeviceResponse, error)
+	AddBrocadeVcsDeviceWithContext(ctx context.Context, p *AddBrocadeVcsDeviceParams) (*AddBrocadeVcsDeviceResponse, error)
+	AddBrocadeVcsDeviceAsync(p *AddBrocadeVcsDeviceParams) (*Job, error)
+	AddBrocadeVcsDeviceAsyncWithContext(ctx context.Context, p *AddBrocadeVcsDeviceParams) (*Job, error)
+	NewDeleteBrocadeVcsDeviceParams(vcsdeviceid string) *DeleteBrocadeVcsDeviceParams
+	DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error)
+	DeleteBrocadeVcsDeviceWithContext(ctx context.Context, p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error)
+	DeleteBrocadeVcsDeviceAsync(p *DeleteBrocadeVcsDeviceParams) (*Job, error)
+	DeleteBrocadeVcsDeviceAsyncWithContext(ctx context.Context, p *DeleteBrocadeVcsDeviceParams) (*Job, error)
+	NewListBrocadeVcsDeviceNetworksParams(vcsdeviceid string) *ListBrocadeVcsDeviceNetworksParams
+	GetBrocadeVcsDeviceNetworkID(keyword string, vcsdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetBrocadeVcsDeviceNetworkIDWithContext(ctx context.Context, keyword string, vcsdeviceid string, opts ...OptionFunc) (string, int, error)
+	ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error)
+	ListBrocadeVcsDeviceNetworksWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error)
+	NewListBrocadeVcsDeviceNetworksPager(p *ListBrocadeVcsDeviceNetworksParams) *ListBrocadeVcsDeviceNetworksPager
+	ListBrocadeVcsDeviceNetworksAll(p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error)
+	ListBrocadeVcsDeviceNetworksAllWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error)
+	NewListBrocadeVcsDevicesParams() *ListBrocadeVcsDevicesParams
+	ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error)
+	ListBrocadeVcsDevicesWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error)
+	NewListBrocadeVcsDevicesPager(p *ListBrocadeVcsDevicesParams) *ListBrocadeVcsDevicesPager
+	ListBrocadeVcsDevicesAll(p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error)
+	ListBrocadeVcsDevicesAllWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error)
+}
+
 type AddBrocadeVcsDeviceParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddBrocadeVcsDeviceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddBrocadeVcsDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -50,6 +82,51 @@ func (p *AddBrocadeVcsDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddBrocadeVcsDeviceParams) Validate() error {
+	v := newParamValidator("addBrocadeVcsDevice", p.p)
+	v.required("hostname", "password", "physicalnetworkid", "username")
+	v.ids("physicalnetworkid")
+	v.maxLength("hostname", 255)
+	v.maxLength("password", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddBrocadeVcsDeviceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddBrocadeVcsDeviceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostname", "password", "physicalnetworkid", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addBrocadeVcsDevice", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addBrocadeVcsDevice: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddBrocadeVcsDeviceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -57,6 +134,15 @@ func (p *AddBrocadeVcsDeviceParams) SetHostname(v string) {
 	p.p["hostname"] = v
 }
 
+func (p *AddBrocadeVcsDeviceParams) ResetHostname() {
+	delete(p.p, "hostname")
+}
+
+func (p *AddBrocadeVcsDeviceParams) GetHostname() (string, bool) {
+	value, ok := p.p["hostname"].(string)
+	return value, ok
+}
+
 func (p *AddBrocadeVcsDeviceParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -64,6 +150,15 @@ func (p *AddBrocadeVcsDeviceParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *AddBrocadeVcsDeviceParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddBrocadeVcsDeviceParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *AddBrocadeVcsDeviceParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +166,15 @@ func (p *AddBrocadeVcsDeviceParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *AddBrocadeVcsDeviceParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddBrocadeVcsDeviceParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *AddBrocadeVcsDeviceParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -78,6 +182,15 @@ func (p *AddBrocadeVcsDeviceParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddBrocadeVcsDeviceParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddBrocadeVcsDeviceParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddBrocadeVcsDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *BrocadeVCSService) NewAddBrocadeVcsDeviceParams(hostname string, password string, physicalnetworkid string, username string) *AddBrocadeVcsDeviceParams {
@@ -92,7 +205,16 @@ func (s *BrocadeVCSService) NewAddBrocadeVcsDeviceParams(hostname string, passwo
 
 // Adds a Brocade VCS Switch
 func (s *BrocadeVCSService) AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams) (*AddBrocadeVcsDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addBrocadeVcsDevice", p.toURLValues())
+	return s.AddBrocadeVcsDeviceWithContext(context.Background(), p)
+}
+
+// AddBrocadeVcsDeviceWithContext is the same as AddBrocadeVcsDevice, but uses ctx to cancel the request and any async job polling
+func (s *BrocadeVCSService) AddBrocadeVcsDeviceWithContext(ctx context.Context, p *AddBrocadeVcsDeviceParams) (*AddBrocadeVcsDeviceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addBrocadeVcsDevice", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +226,7 @@ func (s *BrocadeVCSService) AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -125,6 +247,40 @@ func (s *BrocadeVCSService) AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams) (*
 	return &r, nil
 }
 
+// AddBrocadeVcsDeviceAsync starts the async job for AddBrocadeVcsDevice and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddBrocadeVcsDeviceResponse using Job.Result
+func (s *BrocadeVCSService) AddBrocadeVcsDeviceAsync(p *AddBrocadeVcsDeviceParams) (*Job, error) {
+	return s.AddBrocadeVcsDeviceAsyncWithContext(context.Background(), p)
+}
+
+// AddBrocadeVcsDeviceAsyncWithContext is the same as AddBrocadeVcsDeviceAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BrocadeVCSService) AddBrocadeVcsDeviceAsyncWithContext(ctx context.Context, p *AddBrocadeVcsDeviceParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addBrocadeVcsDevice", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddBrocadeVcsDeviceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AddBrocadeVcsDeviceResponse struct {
 	Brocadedevicename string `json:"brocadedevicename"`
 	Hostname          string `json:"hostname"`
@@ -139,7 +295,8 @@ type DeleteBrocadeVcsDeviceParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteBrocadeVcsDeviceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteBrocadeVcsDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -150,6 +307,48 @@ func (p *DeleteBrocadeVcsDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteBrocadeVcsDeviceParams) Validate() error {
+	v := newParamValidator("deleteBrocadeVcsDevice", p.p)
+	v.required("vcsdeviceid")
+	v.ids("vcsdeviceid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteBrocadeVcsDeviceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteBrocadeVcsDeviceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "vcsdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteBrocadeVcsDevice", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteBrocadeVcsDevice: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteBrocadeVcsDeviceParams) SetVcsdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -157,6 +356,15 @@ func (p *DeleteBrocadeVcsDeviceParams) SetVcsdeviceid(v string) {
 	p.p["vcsdeviceid"] = v
 }
 
+func (p *DeleteBrocadeVcsDeviceParams) ResetVcsdeviceid() {
+	delete(p.p, "vcsdeviceid")
+}
+
+func (p *DeleteBrocadeVcsDeviceParams) GetVcsdeviceid() (string, bool) {
+	value, ok := p.p["vcsdeviceid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteBrocadeVcsDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *BrocadeVCSService) NewDeleteBrocadeVcsDeviceParams(vcsdeviceid string) *DeleteBrocadeVcsDeviceParams {
@@ -166,9 +374,18 @@ func (s *BrocadeVCSService) NewDeleteBrocadeVcsDeviceParams(vcsdeviceid string)
 	return p
 }
 
-//  delete a Brocade VCS Switch
+// delete a Brocade VCS Switch
 func (s *BrocadeVCSService) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteBrocadeVcsDevice", p.toURLValues())
+	return s.DeleteBrocadeVcsDeviceWithContext(context.Background(), p)
+}
+
+// DeleteBrocadeVcsDeviceWithContext is the same as DeleteBrocadeVcsDevice, but uses ctx to cancel the request and any async job polling
+func (s *BrocadeVCSService) DeleteBrocadeVcsDeviceWithContext(ctx context.Context, p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteBrocadeVcsDevice", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +397,7 @@ func (s *BrocadeVCSService) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDevicePara
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -196,6 +413,31 @@ func (s *BrocadeVCSService) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDevicePara
 	return &r, nil
 }
 
+// DeleteBrocadeVcsDeviceAsync starts the async job for DeleteBrocadeVcsDevice and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteBrocadeVcsDeviceResponse using Job.Result
+func (s *BrocadeVCSService) DeleteBrocadeVcsDeviceAsync(p *DeleteBrocadeVcsDeviceParams) (*Job, error) {
+	return s.DeleteBrocadeVcsDeviceAsyncWithContext(context.Background(), p)
+}
+
+// DeleteBrocadeVcsDeviceAsyncWithContext is the same as DeleteBrocadeVcsDeviceAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *BrocadeVCSService) DeleteBrocadeVcsDeviceAsyncWithContext(ctx context.Context, p *DeleteBrocadeVcsDeviceParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteBrocadeVcsDevice", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteBrocadeVcsDeviceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteBrocadeVcsDeviceResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -207,7 +449,8 @@ type ListBrocadeVcsDeviceNetworksParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListBrocadeVcsDeviceNetworksParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListBrocadeVcsDeviceNetworksParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -229,6 +472,53 @@ func (p *ListBrocadeVcsDeviceNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListBrocadeVcsDeviceNetworksParams) Validate() error {
+	v := newParamValidator("listBrocadeVcsDeviceNetworks", p.p)
+	v.required("vcsdeviceid")
+	v.ids("vcsdeviceid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListBrocadeVcsDeviceNetworksParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListBrocadeVcsDeviceNetworksParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "vcsdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listBrocadeVcsDeviceNetworks", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listBrocadeVcsDeviceNetworks: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListBrocadeVcsDeviceNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -236,6 +526,15 @@ func (p *ListBrocadeVcsDeviceNetworksParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListBrocadeVcsDeviceNetworksParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListBrocadeVcsDeviceNetworksParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListBrocadeVcsDeviceNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -243,6 +542,15 @@ func (p *ListBrocadeVcsDeviceNetworksParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListBrocadeVcsDeviceNetworksParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListBrocadeVcsDeviceNetworksParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListBrocadeVcsDeviceNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -250,6 +558,15 @@ func (p *ListBrocadeVcsDeviceNetworksParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListBrocadeVcsDeviceNetworksParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListBrocadeVcsDeviceNetworksParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListBrocadeVcsDeviceNetworksParams) SetVcsdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -257,6 +574,15 @@ func (p *ListBrocadeVcsDeviceNetworksParams) SetVcsdeviceid(v string) {
 	p.p["vcsdeviceid"] = v
 }
 
+func (p *ListBrocadeVcsDeviceNetworksParams) ResetVcsdeviceid() {
+	delete(p.p, "vcsdeviceid")
+}
+
+func (p *ListBrocadeVcsDeviceNetworksParams) GetVcsdeviceid() (string, bool) {
+	value, ok := p.p["vcsdeviceid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListBrocadeVcsDeviceNetworksParams instance,
 // as then you are sure you have configured all required params
 func (s *BrocadeVCSService) NewListBrocadeVcsDeviceNetworksParams(vcsdeviceid string) *ListBrocadeVcsDeviceNetworksParams {
@@ -268,6 +594,11 @@ func (s *BrocadeVCSService) NewListBrocadeVcsDeviceNetworksParams(vcsdeviceid st
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BrocadeVCSService) GetBrocadeVcsDeviceNetworkID(keyword string, vcsdeviceid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetBrocadeVcsDeviceNetworkIDWithContext(context.Background(), keyword, vcsdeviceid, opts...)
+}
+
+// GetBrocadeVcsDeviceNetworkIDWithContext is the same as GetBrocadeVcsDeviceNetworkID, but uses ctx to cancel the request
+func (s *BrocadeVCSService) GetBrocadeVcsDeviceNetworkIDWithContext(ctx context.Context, keyword string, vcsdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListBrocadeVcsDeviceNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -280,7 +611,7 @@ func (s *BrocadeVCSService) GetBrocadeVcsDeviceNetworkID(keyword string, vcsdevi
 		}
 	}
 
-	l, err := s.ListBrocadeVcsDeviceNetworks(p)
+	l, err := s.ListBrocadeVcsDeviceNetworksWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -305,7 +636,16 @@ func (s *BrocadeVCSService) GetBrocadeVcsDeviceNetworkID(keyword string, vcsdevi
 
 // lists network that are using a brocade vcs switch
 func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listBrocadeVcsDeviceNetworks", p.toURLValues())
+	return s.ListBrocadeVcsDeviceNetworksWithContext(context.Background(), p)
+}
+
+// ListBrocadeVcsDeviceNetworksWithContext is the same as ListBrocadeVcsDeviceNetworks, but uses ctx to cancel the request and any async job polling
+func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworksWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listBrocadeVcsDeviceNetworks", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -318,6 +658,92 @@ func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDevice
 	return &r, nil
 }
 
+// ListBrocadeVcsDeviceNetworksPager iterates over all pages of a ListBrocadeVcsDeviceNetworks call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListBrocadeVcsDeviceNetworksPager struct {
+	pager
+
+	s *BrocadeVCSService
+	p *ListBrocadeVcsDeviceNetworksParams
+	r *ListBrocadeVcsDeviceNetworksResponse
+}
+
+// NewListBrocadeVcsDeviceNetworksPager returns a pager for all pages of a ListBrocadeVcsDeviceNetworks call with the given params
+func (s *BrocadeVCSService) NewListBrocadeVcsDeviceNetworksPager(p *ListBrocadeVcsDeviceNetworksParams) *ListBrocadeVcsDeviceNetworksPager {
+	return &ListBrocadeVcsDeviceNetworksPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListBrocadeVcsDeviceNetworksPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListBrocadeVcsDeviceNetworksPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListBrocadeVcsDeviceNetworksParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListBrocadeVcsDeviceNetworksWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.BrocadeVcsDeviceNetworks), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListBrocadeVcsDeviceNetworksPager) Page() *ListBrocadeVcsDeviceNetworksResponse {
+	return pg.r
+}
+
+// ListBrocadeVcsDeviceNetworksAll fetches all pages of a ListBrocadeVcsDeviceNetworks call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworksAll(p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error) {
+	return s.ListBrocadeVcsDeviceNetworksAllWithContext(context.Background(), p)
+}
+
+// ListBrocadeVcsDeviceNetworksAllWithContext is the same as ListBrocadeVcsDeviceNetworksAll, but uses ctx to cancel the requests
+func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworksAllWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*BrocadeVcsDeviceNetwork)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListBrocadeVcsDeviceNetworksParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListBrocadeVcsDeviceNetworksWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.BrocadeVcsDeviceNetworks
+		mu.Unlock()
+
+		return len(l.BrocadeVcsDeviceNetworks), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListBrocadeVcsDeviceNetworksResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.BrocadeVcsDeviceNetworks = append(r.BrocadeVcsDeviceNetworks, pages[page]...)
+	}
+	r.Count = len(r.BrocadeVcsDeviceNetworks)
+
+	return r, nil
+}
+
 type ListBrocadeVcsDeviceNetworksResponse struct {
 	Count                    int                        `json:"count"`
 	BrocadeVcsDeviceNetworks []*BrocadeVcsDeviceNetwork `json:"brocadevcsdevicenetwork"`
@@ -407,7 +833,8 @@ type ListBrocadeVcsDevicesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListBrocadeVcsDevicesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListBrocadeVcsDevicesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -432,6 +859,52 @@ func (p *ListBrocadeVcsDevicesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListBrocadeVcsDevicesParams) Validate() error {
+	v := newParamValidator("listBrocadeVcsDevices", p.p)
+	v.ids("physicalnetworkid", "vcsdeviceid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListBrocadeVcsDevicesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListBrocadeVcsDevicesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "physicalnetworkid", "vcsdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listBrocadeVcsDevices", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listBrocadeVcsDevices: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListBrocadeVcsDevicesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -439,6 +912,15 @@ func (p *ListBrocadeVcsDevicesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListBrocadeVcsDevicesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListBrocadeVcsDevicesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListBrocadeVcsDevicesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -446,6 +928,15 @@ func (p *ListBrocadeVcsDevicesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListBrocadeVcsDevicesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListBrocadeVcsDevicesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListBrocadeVcsDevicesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -453,6 +944,15 @@ func (p *ListBrocadeVcsDevicesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListBrocadeVcsDevicesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListBrocadeVcsDevicesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListBrocadeVcsDevicesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -460,6 +960,15 @@ func (p *ListBrocadeVcsDevicesParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *ListBrocadeVcsDevicesParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListBrocadeVcsDevicesParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *ListBrocadeVcsDevicesParams) SetVcsdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -467,6 +976,15 @@ func (p *ListBrocadeVcsDevicesParams) SetVcsdeviceid(v string) {
 	p.p["vcsdeviceid"] = v
 }
 
+func (p *ListBrocadeVcsDevicesParams) ResetVcsdeviceid() {
+	delete(p.p, "vcsdeviceid")
+}
+
+func (p *ListBrocadeVcsDevicesParams) GetVcsdeviceid() (string, bool) {
+	value, ok := p.p["vcsdeviceid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListBrocadeVcsDevicesParams instance,
 // as then you are sure you have configured all required params
 func (s *BrocadeVCSService) NewListBrocadeVcsDevicesParams() *ListBrocadeVcsDevicesParams {
@@ -477,7 +995,16 @@ func (s *BrocadeVCSService) NewListBrocadeVcsDevicesParams() *ListBrocadeVcsDevi
 
 // Lists Brocade VCS Switches
 func (s *BrocadeVCSService) ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listBrocadeVcsDevices", p.toURLValues())
+	return s.ListBrocadeVcsDevicesWithContext(context.Background(), p)
+}
+
+// ListBrocadeVcsDevicesWithContext is the same as ListBrocadeVcsDevices, but uses ctx to cancel the request and any async job polling
+func (s *BrocadeVCSService) ListBrocadeVcsDevicesWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listBrocadeVcsDevices", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -490,6 +1017,92 @@ func (s *BrocadeVCSService) ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams
 	return &r, nil
 }
 
+// ListBrocadeVcsDevicesPager iterates over all pages of a ListBrocadeVcsDevices call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListBrocadeVcsDevicesPager struct {
+	pager
+
+	s *BrocadeVCSService
+	p *ListBrocadeVcsDevicesParams
+	r *ListBrocadeVcsDevicesResponse
+}
+
+// NewListBrocadeVcsDevicesPager returns a pager for all pages of a ListBrocadeVcsDevices call with the given params
+func (s *BrocadeVCSService) NewListBrocadeVcsDevicesPager(p *ListBrocadeVcsDevicesParams) *ListBrocadeVcsDevicesPager {
+	return &ListBrocadeVcsDevicesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListBrocadeVcsDevicesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListBrocadeVcsDevicesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListBrocadeVcsDevicesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListBrocadeVcsDevicesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.BrocadeVcsDevices), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListBrocadeVcsDevicesPager) Page() *ListBrocadeVcsDevicesResponse {
+	return pg.r
+}
+
+// ListBrocadeVcsDevicesAll fetches all pages of a ListBrocadeVcsDevices call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *BrocadeVCSService) ListBrocadeVcsDevicesAll(p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error) {
+	return s.ListBrocadeVcsDevicesAllWithContext(context.Background(), p)
+}
+
+// ListBrocadeVcsDevicesAllWithContext is the same as ListBrocadeVcsDevicesAll, but uses ctx to cancel the requests
+func (s *BrocadeVCSService) ListBrocadeVcsDevicesAllWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*BrocadeVcsDevice)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListBrocadeVcsDevicesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListBrocadeVcsDevicesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.BrocadeVcsDevices
+		mu.Unlock()
+
+		return len(l.BrocadeVcsDevices), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListBrocadeVcsDevicesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.BrocadeVcsDevices = append(r.BrocadeVcsDevices, pages[page]...)
+	}
+	r.Count = len(r.BrocadeVcsDevices)
+
+	return r, nil
+}
+
 type ListBrocadeVcsDevicesResponse struct {
 	Count             int                 `json:"count"`
 	BrocadeVcsDevices []*BrocadeVcsDevice `json:"brocadevcsdevice"`
@@ -20,16 +20,1136 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
+// CertificateServiceIface is the interface implemented by CertificateService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type CertificateServiceIface interface {
+	NewIssueCertificateParams() *IssueCertificateParams
+	IssueCertificate(p *IssueCertificateParams) (*IssueCertificateResponse, error)
+	IssueCertificateWithContext(ctx context.Context, p *IssueCertificateParams) (*IssueCertificateResponse, error)
+	IssueCertificateAsync(p *IssueCertificateParams) (*Job, error)
+	IssueCertificateAsyncWithContext(ctx context.Context, p *IssueCertificateParams) (*Job, error)
+	NewListCAProvidersParams() *ListCAProvidersParams
+	ListCAProviders(p *ListCAProvidersParams) (*ListCAProvidersResponse, error)
+	ListCAProvidersWithContext(ctx context.Context, p *ListCAProvidersParams) (*ListCAProvidersResponse, error)
+	NewListCaCertificateParams() *ListCaCertificateParams
+	ListCaCertificate(p *ListCaCertificateParams) (*ListCaCertificateResponse, error)
+	ListCaCertificateWithContext(ctx context.Context, p *ListCaCertificateParams) (*ListCaCertificateResponse, error)
+	NewProvisionCertificateParams(hostid string) *ProvisionCertificateParams
+	ProvisionCertificate(p *ProvisionCertificateParams) (*ProvisionCertificateResponse, error)
+	ProvisionCertificateWithContext(ctx context.Context, p *ProvisionCertificateParams) (*ProvisionCertificateResponse, error)
+	ProvisionCertificateAsync(p *ProvisionCertificateParams) (*Job, error)
+	ProvisionCertificateAsyncWithContext(ctx context.Context, p *ProvisionCertificateParams) (*Job, error)
+	NewRevokeCertificateParams(serial string) *RevokeCertificateParams
+	RevokeCertificate(p *RevokeCertificateParams) (*RevokeCertificateResponse, error)
+	RevokeCertificateWithContext(ctx context.Context, p *RevokeCertificateParams) (*RevokeCertificateResponse, error)
+	RevokeCertificateAsync(p *RevokeCertificateParams) (*Job, error)
+	RevokeCertificateAsyncWithContext(ctx context.Context, p *RevokeCertificateParams) (*Job, error)
+	NewRevokeTemplateDirectDownloadCertificateParams(hypervisor string, name string, zoneid string) *RevokeTemplateDirectDownloadCertificateParams
+	RevokeTemplateDirectDownloadCertificate(p *RevokeTemplateDirectDownloadCertificateParams) (*RevokeTemplateDirectDownloadCertificateResponse, error)
+	RevokeTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *RevokeTemplateDirectDownloadCertificateParams) (*RevokeTemplateDirectDownloadCertificateResponse, error)
+	NewUploadCustomCertificateParams(certificate string, domainsuffix string) *UploadCustomCertificateParams
+	UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error)
+	UploadCustomCertificateWithContext(ctx context.Context, p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error)
+	UploadCustomCertificateAsync(p *UploadCustomCertificateParams) (*Job, error)
+	UploadCustomCertificateAsyncWithContext(ctx context.Context, p *UploadCustomCertificateParams) (*Job, error)
+	NewUploadTemplateDirectDownloadCertificateParams(certificate string, hypervisor string, name string, zoneid string) *UploadTemplateDirectDownloadCertificateParams
+	UploadTemplateDirectDownloadCertificate(p *UploadTemplateDirectDownloadCertificateParams) (*UploadTemplateDirectDownloadCertificateResponse, error)
+	UploadTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *UploadTemplateDirectDownloadCertificateParams) (*UploadTemplateDirectDownloadCertificateResponse, error)
+}
+
+type IssueCertificateParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *IssueCertificateParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["csr"]; found {
+		u.Set("csr", v.(string))
+	}
+	if v, found := p.p["domain"]; found {
+		u.Set("domain", v.(string))
+	}
+	if v, found := p.p["duration"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("duration", vv)
+	}
+	if v, found := p.p["ipaddress"]; found {
+		u.Set("ipaddress", v.(string))
+	}
+	if v, found := p.p["provider"]; found {
+		u.Set("provider", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *IssueCertificateParams) Validate() error {
+	v := newParamValidator("issueCertificate", p.p)
+	v.maxLength("csr", 65535)
+	v.maxLength("domain", 255)
+	v.maxLength("ipaddress", 255)
+	v.maxLength("provider", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *IssueCertificateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *IssueCertificateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "csr", "domain", "ipaddress", "provider":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "duration":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for issueCertificate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of issueCertificate: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *IssueCertificateParams) SetCsr(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["csr"] = v
+}
+
+func (p *IssueCertificateParams) ResetCsr() {
+	delete(p.p, "csr")
+}
+
+func (p *IssueCertificateParams) GetCsr() (string, bool) {
+	value, ok := p.p["csr"].(string)
+	return value, ok
+}
+
+func (p *IssueCertificateParams) SetDomain(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domain"] = v
+}
+
+func (p *IssueCertificateParams) ResetDomain() {
+	delete(p.p, "domain")
+}
+
+func (p *IssueCertificateParams) GetDomain() (string, bool) {
+	value, ok := p.p["domain"].(string)
+	return value, ok
+}
+
+func (p *IssueCertificateParams) SetDuration(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["duration"] = v
+}
+
+func (p *IssueCertificateParams) ResetDuration() {
+	delete(p.p, "duration")
+}
+
+func (p *IssueCertificateParams) GetDuration() (int, bool) {
+	value, ok := p.p["duration"].(int)
+	return value, ok
+}
+
+func (p *IssueCertificateParams) SetIpaddress(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ipaddress"] = v
+}
+
+func (p *IssueCertificateParams) ResetIpaddress() {
+	delete(p.p, "ipaddress")
+}
+
+func (p *IssueCertificateParams) GetIpaddress() (string, bool) {
+	value, ok := p.p["ipaddress"].(string)
+	return value, ok
+}
+
+func (p *IssueCertificateParams) SetProvider(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["provider"] = v
+}
+
+func (p *IssueCertificateParams) ResetProvider() {
+	delete(p.p, "provider")
+}
+
+func (p *IssueCertificateParams) GetProvider() (string, bool) {
+	value, ok := p.p["provider"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new IssueCertificateParams instance,
+// as then you are sure you have configured all required params
+func (s *CertificateService) NewIssueCertificateParams() *IssueCertificateParams {
+	p := &IssueCertificateParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Issues a client certificate using configured or provided CA plugin
+func (s *CertificateService) IssueCertificate(p *IssueCertificateParams) (*IssueCertificateResponse, error) {
+	return s.IssueCertificateWithContext(context.Background(), p)
+}
+
+// IssueCertificateWithContext is the same as IssueCertificate, but uses ctx to cancel the request and any async job polling
+func (s *CertificateService) IssueCertificateWithContext(ctx context.Context, p *IssueCertificateParams) (*IssueCertificateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "issueCertificate", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r IssueCertificateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// IssueCertificateAsync starts the async job for IssueCertificate and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a IssueCertificateResponse using Job.Result
+func (s *CertificateService) IssueCertificateAsync(p *IssueCertificateParams) (*Job, error) {
+	return s.IssueCertificateAsyncWithContext(context.Background(), p)
+}
+
+// IssueCertificateAsyncWithContext is the same as IssueCertificateAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *CertificateService) IssueCertificateAsyncWithContext(ctx context.Context, p *IssueCertificateParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "issueCertificate", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r IssueCertificateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type IssueCertificateResponse struct {
+	Cacertificates string `json:"cacertificates"`
+	Certificate    string `json:"certificate"`
+	JobID          string `json:"jobid"`
+	Jobstatus      int    `json:"jobstatus"`
+	Privatekey     string `json:"privatekey"`
+}
+
+type ListCAProvidersParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListCAProvidersParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListCAProvidersParams) Validate() error {
+	v := newParamValidator("listCAProviders", p.p)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListCAProvidersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListCAProvidersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listCAProviders", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listCAProviders: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListCAProvidersParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ListCAProvidersParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListCAProvidersParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListCAProvidersParams instance,
+// as then you are sure you have configured all required params
+func (s *CertificateService) NewListCAProvidersParams() *ListCAProvidersParams {
+	p := &ListCAProvidersParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Lists available certificate authority providers in CloudStack
+func (s *CertificateService) ListCAProviders(p *ListCAProvidersParams) (*ListCAProvidersResponse, error) {
+	return s.ListCAProvidersWithContext(context.Background(), p)
+}
+
+// ListCAProvidersWithContext is the same as ListCAProviders, but uses ctx to cancel the request and any async job polling
+func (s *CertificateService) ListCAProvidersWithContext(ctx context.Context, p *ListCAProvidersParams) (*ListCAProvidersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listCAProviders", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListCAProvidersResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListCAProvidersResponse struct {
+	Count       int           `json:"count"`
+	CAProviders []*CAProvider `json:"caprovider"`
+}
+
+type CAProvider struct {
+	Description string `json:"description"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Name        string `json:"name"`
+}
+
+type ListCaCertificateParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListCaCertificateParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["provider"]; found {
+		u.Set("provider", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListCaCertificateParams) Validate() error {
+	v := newParamValidator("listCaCertificate", p.p)
+	v.maxLength("provider", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListCaCertificateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListCaCertificateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "provider":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listCaCertificate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listCaCertificate: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListCaCertificateParams) SetProvider(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["provider"] = v
+}
+
+func (p *ListCaCertificateParams) ResetProvider() {
+	delete(p.p, "provider")
+}
+
+func (p *ListCaCertificateParams) GetProvider() (string, bool) {
+	value, ok := p.p["provider"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListCaCertificateParams instance,
+// as then you are sure you have configured all required params
+func (s *CertificateService) NewListCaCertificateParams() *ListCaCertificateParams {
+	p := &ListCaCertificateParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Lists the CA public certificate(s) as support by the configured/provided CA plugin
+func (s *CertificateService) ListCaCertificate(p *ListCaCertificateParams) (*ListCaCertificateResponse, error) {
+	return s.ListCaCertificateWithContext(context.Background(), p)
+}
+
+// ListCaCertificateWithContext is the same as ListCaCertificate, but uses ctx to cancel the request and any async job polling
+func (s *CertificateService) ListCaCertificateWithContext(ctx context.Context, p *ListCaCertificateParams) (*ListCaCertificateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listCaCertificate", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListCaCertificateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListCaCertificateResponse struct {
+	Count         int              `json:"count"`
+	CaCertificate []*CaCertificate `json:"cacertificate"`
+}
+
+type CaCertificate struct {
+	Cacertificates string `json:"cacertificates"`
+	Certificate    string `json:"certificate"`
+	JobID          string `json:"jobid"`
+	Jobstatus      int    `json:"jobstatus"`
+	Privatekey     string `json:"privatekey"`
+}
+
+type ProvisionCertificateParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ProvisionCertificateParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["hostid"]; found {
+		u.Set("hostid", v.(string))
+	}
+	if v, found := p.p["provider"]; found {
+		u.Set("provider", v.(string))
+	}
+	if v, found := p.p["reconnect"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("reconnect", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ProvisionCertificateParams) Validate() error {
+	v := newParamValidator("provisionCertificate", p.p)
+	v.required("hostid")
+	v.ids("hostid")
+	v.maxLength("provider", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ProvisionCertificateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ProvisionCertificateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid", "provider":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "reconnect":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for provisionCertificate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of provisionCertificate: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ProvisionCertificateParams) SetHostid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostid"] = v
+}
+
+func (p *ProvisionCertificateParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ProvisionCertificateParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
+func (p *ProvisionCertificateParams) SetProvider(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["provider"] = v
+}
+
+func (p *ProvisionCertificateParams) ResetProvider() {
+	delete(p.p, "provider")
+}
+
+func (p *ProvisionCertificateParams) GetProvider() (string, bool) {
+	value, ok := p.p["provider"].(string)
+	return value, ok
+}
+
+func (p *ProvisionCertificateParams) SetReconnect(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["reconnect"] = v
+}
+
+func (p *ProvisionCertificateParams) ResetReconnect() {
+	delete(p.p, "reconnect")
+}
+
+func (p *ProvisionCertificateParams) GetReconnect() (bool, bool) {
+	value, ok := p.p["reconnect"].(bool)
+	return value, ok
+}
+
+// You should always use this function to get a new ProvisionCertificateParams instance,
+// as then you are sure you have configured all required params
+func (s *CertificateService) NewProvisionCertificateParams(hostid string) *ProvisionCertificateParams {
+	p := &ProvisionCertificateParams{}
+	p.p = make(map[string]interface{})
+	p.p["hostid"] = hostid
+	return p
+}
+
+// Issues and propagates client certificate on a connected host/agent using configured CA plugin
+func (s *CertificateService) ProvisionCertificate(p *ProvisionCertificateParams) (*ProvisionCertificateResponse, error) {
+	return s.ProvisionCertificateWithContext(context.Background(), p)
+}
+
+// ProvisionCertificateWithContext is the same as ProvisionCertificate, but uses ctx to cancel the request and any async job polling
+func (s *CertificateService) ProvisionCertificateWithContext(ctx context.Context, p *ProvisionCertificateParams) (*ProvisionCertificateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "provisionCertificate", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ProvisionCertificateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// ProvisionCertificateAsync starts the async job for ProvisionCertificate and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ProvisionCertificateResponse using Job.Result
+func (s *CertificateService) ProvisionCertificateAsync(p *ProvisionCertificateParams) (*Job, error) {
+	return s.ProvisionCertificateAsyncWithContext(context.Background(), p)
+}
+
+// ProvisionCertificateAsyncWithContext is the same as ProvisionCertificateAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *CertificateService) ProvisionCertificateAsyncWithContext(ctx context.Context, p *ProvisionCertificateParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "provisionCertificate", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ProvisionCertificateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type ProvisionCertificateResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type RevokeCertificateParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RevokeCertificateParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["cn"]; found {
+		u.Set("cn", v.(string))
+	}
+	if v, found := p.p["provider"]; found {
+		u.Set("provider", v.(string))
+	}
+	if v, found := p.p["serial"]; found {
+		u.Set("serial", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RevokeCertificateParams) Validate() error {
+	v := newParamValidator("revokeCertificate", p.p)
+	v.required("serial")
+	v.maxLength("cn", 255)
+	v.maxLength("provider", 255)
+	v.maxLength("serial", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RevokeCertificateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RevokeCertificateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "cn", "provider", "serial":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for revokeCertificate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of revokeCertificate: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *RevokeCertificateParams) SetCn(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["cn"] = v
+}
+
+func (p *RevokeCertificateParams) ResetCn() {
+	delete(p.p, "cn")
+}
+
+func (p *RevokeCertificateParams) GetCn() (string, bool) {
+	value, ok := p.p["cn"].(string)
+	return value, ok
+}
+
+func (p *RevokeCertificateParams) SetProvider(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["provider"] = v
+}
+
+func (p *RevokeCertificateParams) ResetProvider() {
+	delete(p.p, "provider")
+}
+
+func (p *RevokeCertificateParams) GetProvider() (string, bool) {
+	value, ok := p.p["provider"].(string)
+	return value, ok
+}
+
+func (p *RevokeCertificateParams) SetSerial(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["serial"] = v
+}
+
+func (p *RevokeCertificateParams) ResetSerial() {
+	delete(p.p, "serial")
+}
+
+func (p *RevokeCertificateParams) GetSerial() (string, bool) {
+	value, ok := p.p["serial"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new RevokeCertificateParams instance,
+// as then you are sure you have configured all required params
+func (s *CertificateService) NewRevokeCertificateParams(serial string) *RevokeCertificateParams {
+	p := &RevokeCertificateParams{}
+	p.p = make(map[string]interface{})
+	p.p["serial"] = serial
+	return p
+}
+
+// Revokes certificate using configured CA plugin
+func (s *CertificateService) RevokeCertificate(p *RevokeCertificateParams) (*RevokeCertificateResponse, error) {
+	return s.RevokeCertificateWithContext(context.Background(), p)
+}
+
+// RevokeCertificateWithContext is the same as RevokeCertificate, but uses ctx to cancel the request and any async job polling
+func (s *CertificateService) RevokeCertificateWithContext(ctx context.Context, p *RevokeCertificateParams) (*RevokeCertificateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "revokeCertificate", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RevokeCertificateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// RevokeCertificateAsync starts the async job for RevokeCertificate and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RevokeCertificateResponse using Job.Result
+func (s *CertificateService) RevokeCertificateAsync(p *RevokeCertificateParams) (*Job, error) {
+	return s.RevokeCertificateAsyncWithContext(context.Background(), p)
+}
+
+// RevokeCertificateAsyncWithContext is the same as RevokeCertificateAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *CertificateService) RevokeCertificateAsyncWithContext(ctx context.Context, p *RevokeCertificateParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "revokeCertificate", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RevokeCertificateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type RevokeCertificateResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type RevokeTemplateDirectDownloadCertificateParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RevokeTemplateDirectDownloadCertificateParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["hostid"]; found {
+		u.Set("hostid", v.(string))
+	}
+	if v, found := p.p["hypervisor"]; found {
+		u.Set("hypervisor", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RevokeTemplateDirectDownloadCertificateParams) Validate() error {
+	v := newParamValidator("revokeTemplateDirectDownloadCertificate", p.p)
+	v.required("hypervisor", "name", "zoneid")
+	v.ids("hostid", "zoneid")
+	v.maxLength("hypervisor", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RevokeTemplateDirectDownloadCertificateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RevokeTemplateDirectDownloadCertificateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid", "hypervisor", "name", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for revokeTemplateDirectDownloadCertificate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of revokeTemplateDirectDownloadCertificate: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *RevokeTemplateDirectDownloadCertificateParams) SetHostid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostid"] = v
+}
+
+func (p *RevokeTemplateDirectDownloadCertificateParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *RevokeTemplateDirectDownloadCertificateParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
+func (p *RevokeTemplateDirectDownloadCertificateParams) SetHypervisor(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hypervisor"] = v
+}
+
+func (p *RevokeTemplateDirectDownloadCertificateParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *RevokeTemplateDirectDownloadCertificateParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
+func (p *RevokeTemplateDirectDownloadCertificateParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *RevokeTemplateDirectDownloadCertificateParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *RevokeTemplateDirectDownloadCertificateParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *RevokeTemplateDirectDownloadCertificateParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *RevokeTemplateDirectDownloadCertificateParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *RevokeTemplateDirectDownloadCertificateParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new RevokeTemplateDirectDownloadCertificateParams instance,
+// as then you are sure you have configured all required params
+func (s *CertificateService) NewRevokeTemplateDirectDownloadCertificateParams(hypervisor string, name string, zoneid string) *RevokeTemplateDirectDownloadCertificateParams {
+	p := &RevokeTemplateDirectDownloadCertificateParams{}
+	p.p = make(map[string]interface{})
+	p.p["hypervisor"] = hypervisor
+	p.p["name"] = name
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// Revoke a certificate alias from a KVM host
+func (s *CertificateService) RevokeTemplateDirectDownloadCertificate(p *RevokeTemplateDirectDownloadCertificateParams) (*RevokeTemplateDirectDownloadCertificateResponse, error) {
+	return s.RevokeTemplateDirectDownloadCertificateWithContext(context.Background(), p)
+}
+
+// RevokeTemplateDirectDownloadCertificateWithContext is the same as RevokeTemplateDirectDownloadCertificate, but uses ctx to cancel the request and any async job polling
+func (s *CertificateService) RevokeTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *RevokeTemplateDirectDownloadCertificateParams) (*RevokeTemplateDirectDownloadCertificateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "revokeTemplateDirectDownloadCertificate", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RevokeTemplateDirectDownloadCertificateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type RevokeTemplateDirectDownloadCertificateResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *RevokeTemplateDirectDownloadCertificateResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias RevokeTemplateDirectDownloadCertificateResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
 type UploadCustomCertificateParams struct {
 	p map[string]interface{}
 }
 
-func (p *UploadCustomCertificateParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UploadCustomCertificateParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -53,6 +1173,55 @@ func (p *UploadCustomCertificateParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UploadCustomCertificateParams) Validate() error {
+	v := newParamValidator("uploadCustomCertificate", p.p)
+	v.required("certificate", "domainsuffix")
+	v.maxLength("certificate", 65535)
+	v.maxLength("domainsuffix", 255)
+	v.maxLength("name", 255)
+	v.maxLength("privatekey", 65535)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UploadCustomCertificateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UploadCustomCertificateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "certificate", "domainsuffix", "name", "privatekey":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for uploadCustomCertificate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of uploadCustomCertificate: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UploadCustomCertificateParams) SetCertificate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -60,6 +1229,15 @@ func (p *UploadCustomCertificateParams) SetCertificate(v string) {
 	p.p["certificate"] = v
 }
 
+func (p *UploadCustomCertificateParams) ResetCertificate() {
+	delete(p.p, "certificate")
+}
+
+func (p *UploadCustomCertificateParams) GetCertificate() (string, bool) {
+	value, ok := p.p["certificate"].(string)
+	return value, ok
+}
+
 func (p *UploadCustomCertificateParams) SetDomainsuffix(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -67,6 +1245,15 @@ func (p *UploadCustomCertificateParams) SetDomainsuffix(v string) {
 	p.p["domainsuffix"] = v
 }
 
+func (p *UploadCustomCertificateParams) ResetDomainsuffix() {
+	delete(p.p, "domainsuffix")
+}
+
+func (p *UploadCustomCertificateParams) GetDomainsuffix() (string, bool) {
+	value, ok := p.p["domainsuffix"].(string)
+	return value, ok
+}
+
 func (p *UploadCustomCertificateParams) SetId(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -74,6 +1261,15 @@ func (p *UploadCustomCertificateParams) SetId(v int) {
 	p.p["id"] = v
 }
 
+func (p *UploadCustomCertificateParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UploadCustomCertificateParams) GetId() (int, bool) {
+	value, ok := p.p["id"].(int)
+	return value, ok
+}
+
 func (p *UploadCustomCertificateParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -81,6 +1277,15 @@ func (p *UploadCustomCertificateParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UploadCustomCertificateParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UploadCustomCertificateParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UploadCustomCertificateParams) SetPrivatekey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -88,6 +1293,15 @@ func (p *UploadCustomCertificateParams) SetPrivatekey(v string) {
 	p.p["privatekey"] = v
 }
 
+func (p *UploadCustomCertificateParams) ResetPrivatekey() {
+	delete(p.p, "privatekey")
+}
+
+func (p *UploadCustomCertificateParams) GetPrivatekey() (string, bool) {
+	value, ok := p.p["privatekey"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UploadCustomCertificateParams instance,
 // as then you are sure you have configured all required params
 func (s *CertificateService) NewUploadCustomCertificateParams(certificate string, domainsuffix string) *UploadCustomCertificateParams {
@@ -100,7 +1314,16 @@ func (s *CertificateService) NewUploadCustomCertificateParams(certificate string
 
 // Uploads a custom certificate for the console proxy VMs to use for SSL. Can be used to upload a single certificate signed by a known CA. Can also be used, through multiple calls, to upload a chain of certificates from CA to the custom certificate itself.
 func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error) {
-	resp, err := s.cs.newRequest("uploadCustomCertificate", p.toURLValues())
+	return s.UploadCustomCertificateWithContext(context.Background(), p)
+}
+
+// UploadCustomCertificateWithContext is the same as UploadCustomCertificate, but uses ctx to cancel the request and any async job polling
+func (s *CertificateService) UploadCustomCertificateWithContext(ctx context.Context, p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "uploadCustomCertificate", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +1335,7 @@ func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -133,8 +1356,265 @@ func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateP
 	return &r, nil
 }
 
+// UploadCustomCertificateAsync starts the async job for UploadCustomCertificate and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UploadCustomCertificateResponse using Job.Result
+func (s *CertificateService) UploadCustomCertificateAsync(p *UploadCustomCertificateParams) (*Job, error) {
+	return s.UploadCustomCertificateAsyncWithContext(context.Background(), p)
+}
+
+// UploadCustomCertificateAsyncWithContext is the same as UploadCustomCertificateAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *CertificateService) UploadCustomCertificateAsyncWithContext(ctx context.Context, p *UploadCustomCertificateParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "uploadCustomCertificate", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UploadCustomCertificateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UploadCustomCertificateResponse struct {
 	JobID     string `json:"jobid"`
 	Jobstatus int    `json:"jobstatus"`
 	Message   string `json:"message"`
 }
+
+type UploadTemplateDirectDownloadCertificateParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UploadTemplateDirectDownloadCertificateParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["certificate"]; found {
+		u.Set("certificate", v.(string))
+	}
+	if v, found := p.p["hostid"]; found {
+		u.Set("hostid", v.(string))
+	}
+	if v, found := p.p["hypervisor"]; found {
+		u.Set("hypervisor", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UploadTemplateDirectDownloadCertificateParams) Validate() error {
+	v := newParamValidator("uploadTemplateDirectDownloadCertificate", p.p)
+	v.required("certificate", "hypervisor", "name", "zoneid")
+	v.ids("hostid", "zoneid")
+	v.maxLength("certificate", 65535)
+	v.maxLength("hypervisor", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UploadTemplateDirectDownloadCertificateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UploadTemplateDirectDownloadCertificateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "certificate", "hostid", "hypervisor", "name", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for uploadTemplateDirectDownloadCertificate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of uploadTemplateDirectDownloadCertificate: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) SetCertificate(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["certificate"] = v
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) ResetCertificate() {
+	delete(p.p, "certificate")
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) GetCertificate() (string, bool) {
+	value, ok := p.p["certificate"].(string)
+	return value, ok
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) SetHostid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostid"] = v
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) SetHypervisor(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hypervisor"] = v
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *UploadTemplateDirectDownloadCertificateParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new UploadTemplateDirectDownloadCertificateParams instance,
+// as then you are sure you have configured all required params
+func (s *CertificateService) NewUploadTemplateDirectDownloadCertificateParams(certificate string, hypervisor string, name string, zoneid string) *UploadTemplateDirectDownloadCertificateParams {
+	p := &UploadTemplateDirectDownloadCertificateParams{}
+	p.p = make(map[string]interface{})
+	p.p["certificate"] = certificate
+	p.p["hypervisor"] = hypervisor
+	p.p["name"] = name
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// Upload a certificate for HTTPS direct template download on KVM hosts
+func (s *CertificateService) UploadTemplateDirectDownloadCertificate(p *UploadTemplateDirectDownloadCertificateParams) (*UploadTemplateDirectDownloadCertificateResponse, error) {
+	return s.UploadTemplateDirectDownloadCertificateWithContext(context.Background(), p)
+}
+
+// UploadTemplateDirectDownloadCertificateWithContext is the same as UploadTemplateDirectDownloadCertificate, but uses ctx to cancel the request and any async job polling
+func (s *CertificateService) UploadTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *UploadTemplateDirectDownloadCertificateParams) (*UploadTemplateDirectDownloadCertificateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "uploadTemplateDirectDownloadCertificate", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UploadTemplateDirectDownloadCertificateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type UploadTemplateDirectDownloadCertificateResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *UploadTemplateDirectDownloadCertificateResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias UploadTemplateDirectDownloadCertificateResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
@@ -0,0 +1,214 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+)
+
+// ClientTrace is a set of hooks that are called while a client executes requests and waits for async
+// jobs, which can be used to add tracing, metrics or logging to a client. Any hook may be nil. The
+// context passed to the hooks is the context passed to the API call, or the context returned by one of
+// the start hooks, so the hooks can use it to find their parent span or to pass on state.
+type ClientTrace struct {
+	// RequestStart is called before a request is executed. The returned context is used to
+	// execute the request, and is passed to the other request hooks.
+	RequestStart func(ctx context.Context, command string, params url.Values) context.Context
+
+	// RequestRetry is called after a failed attempt that is going to be retried
+	RequestRetry func(ctx context.Context, info *RequestInfo)
+
+	// RequestDone is called once a request succeeded or failed, including all attempts
+	RequestDone func(ctx context.Context, info *RequestInfo)
+
+	// JobWaitStart is called before the client starts waiting for an async job. The returned
+	// context is used to poll the job, and is passed to the other job hooks.
+	JobWaitStart func(ctx context.Context, jobid string) context.Context
+
+	// JobPollStart is called before the status of an async job is polled. The returned context
+	// is used to poll the job, and is passed to JobPollDone.
+	JobPollStart func(ctx context.Context, jobid string) context.Context
+
+	// JobPollDone is called after the status of an async job is polled
+	JobPollDone func(ctx context.Context, info *JobPollInfo)
+
+	// JobWaitDone is called when the client stops waiting for an async job
+	JobWaitDone func(ctx context.Context, info *JobWaitInfo)
+}
+
+// RequestInfo contains the details of an executed request
+type RequestInfo struct {
+	Command     string          // The executed command
+	Params      url.Values      // The params of the request, which never contain any credentials or the signature
+	Method      string          // The HTTP method used for the request
+	StatusCode  int             // The HTTP status code of the response, or 0 if there was no response
+	ErrorCode   int             // The CloudStack error code if the request failed
+	CSErrorCode int             // The CS exception error code if the request failed
+	JobID       string          // The ID of the async job started by the request, if any
+	Attempt     int             // The attempt number, starting at 1
+	Duration    time.Duration   // The duration of the request including all attempts so far
+	Response    json.RawMessage // The raw response
+	Err         error           // The error returned by the request
+}
+
+// JobPollInfo contains the details of a single poll of an async job
+type JobPollInfo struct {
+	JobID  string // The ID of the async job
+	Status int    // The status of the job; 0 is pending, 1 is succeeded and 2 is failed
+	Err    error  // Set when the status of the job could not be retrieved
+}
+
+// JobWaitInfo contains the details of an async job the client waited for
+type JobWaitInfo struct {
+	JobID    string        // The ID of the async job
+	Duration time.Duration // The time the client waited for the job
+	Err      error         // The error of the job, or the error that stopped the client from waiting
+}
+
+// WithClientTrace adds a set of hooks to the client. Using the option multiple times adds more
+// traces, in which case the start hooks are called in the given order and the other hooks are
+// called in reverse order.
+func WithClientTrace(trace *ClientTrace) ClientOption {
+	return func(cs *CloudStackClient) {
+		if trace != nil {
+			cs.traces = append(cs.traces, trace)
+		}
+	}
+}
+
+// requestState is added to the context of a request, so the details of the last attempt that
+// are only known while sending the request can be passed back to the trace hooks
+type requestState struct {
+	method string // The HTTP method used for the last attempt
+}
+
+type requestStateKey struct{}
+
+// Returns ctx with a new requestState, which is filled by sendRequest
+func withRequestState(ctx context.Context) (context.Context, *requestState) {
+	state := &requestState{}
+	return context.WithValue(ctx, requestStateKey{}, state), state
+}
+
+// Records the HTTP method of the request in the requestState of ctx, if any
+func recordRequestMethod(ctx context.Context, method string) {
+	if state, ok := ctx.Value(requestStateKey{}).(*requestState); ok {
+		state.method = method
+	}
+}
+
+// Returns the details of an executed request, or nil if the client has no traces
+func newRequestInfo(cs *CloudStackClient, api string, params url.Values, state *requestState, attempt int, start time.Time, b json.RawMessage, err error) *RequestInfo {
+	if len(cs.traces) == 0 {
+		return nil
+	}
+
+	info := &RequestInfo{
+		Command:  api,
+		Params:   params,
+		Method:   state.method,
+		Attempt:  attempt,
+		Duration: time.Since(start),
+		Response: b,
+		Err:      err,
+	}
+
+	var cse *CSError
+	switch {
+	case err == nil:
+		info.StatusCode = 200
+
+		var r struct {
+			JobID string `json:"jobid"`
+		}
+		if json.Unmarshal(b, &r) == nil {
+			info.JobID = r.JobID
+		}
+	case errors.As(err, &cse):
+		info.StatusCode = cse.StatusCode
+		info.ErrorCode = cse.ErrorCode
+		info.CSErrorCode = cse.CSErrorCode
+	}
+
+	return info
+}
+
+func (cs *CloudStackClient) traceRequestStart(ctx context.Context, api string, params url.Values) context.Context {
+	for _, t := range cs.traces {
+		if t.RequestStart != nil {
+			ctx = t.RequestStart(ctx, api, params)
+		}
+	}
+	return ctx
+}
+
+func (cs *CloudStackClient) traceRequestRetry(ctx context.Context, info *RequestInfo) {
+	for i := len(cs.traces) - 1; i >= 0; i-- {
+		if t := cs.traces[i]; t.RequestRetry != nil {
+			t.RequestRetry(ctx, info)
+		}
+	}
+}
+
+func (cs *CloudStackClient) traceRequestDone(ctx context.Context, info *RequestInfo) {
+	for i := len(cs.traces) - 1; i >= 0; i-- {
+		if t := cs.traces[i]; t.RequestDone != nil {
+			t.RequestDone(ctx, info)
+		}
+	}
+}
+
+func (cs *CloudStackClient) traceJobWaitStart(ctx context.Context, jobid string) context.Context {
+	for _, t := range cs.traces {
+		if t.JobWaitStart != nil {
+			ctx = t.JobWaitStart(ctx, jobid)
+		}
+	}
+	return ctx
+}
+
+func (cs *CloudStackClient) traceJobPollStart(ctx context.Context, jobid string) context.Context {
+	for _, t := range cs.traces {
+		if t.JobPollStart != nil {
+			ctx = t.JobPollStart(ctx, jobid)
+		}
+	}
+	return ctx
+}
+
+func (cs *CloudStackClient) traceJobPollDone(ctx context.Context, info *JobPollInfo) {
+	for i := len(cs.traces) - 1; i >= 0; i-- {
+		if t := cs.traces[i]; t.JobPollDone != nil {
+			t.JobPollDone(ctx, info)
+		}
+	}
+}
+
+func (cs *CloudStackClient) traceJobWaitDone(ctx context.Context, info *JobWaitInfo) {
+	for i := len(cs.traces) - 1; i >= 0; i-- {
+		if t := cs.traces[i]; t.JobWaitDone != nil {
+			t.JobWaitDone(ctx, info)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRequestParamsWithoutCredentials(t *testing.T) {
+	newClient := map[string]func(apiurl string) (*CloudStackClient, error){
+		"api key": func(apiurl string) (*CloudStackClient, error) {
+			return NewClient(apiurl, "key", "secret", false, WithSignatureExpiry(time.Minute), WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond})), nil
+		},
+		"session": func(apiurl string) (*CloudStackClient, error) {
+			return NewSessionClient(apiurl, "admin", "password", "", false, WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}))
+		},
+	}
+
+	for name, fn := range newClient {
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			var failed bool
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				r.ParseForm()
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				switch {
+				case r.Form.Get("command") == "login":
+					fmt.Fprint(w, `{"loginresponse":{"sessionkey":"key1","timeout":1800}}`)
+				case !failed:
+					// Fail the first attempt, so the request is retried
+					failed = true
+					w.WriteHeader(http.StatusServiceUnavailable)
+				default:
+					fmt.Fprint(w, `{"listzonesresponse":{"count":0}}`)
+				}
+			}))
+			defer srv.Close()
+
+			cs, err := fn(srv.URL)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var seen []url.Values
+			record := func(params url.Values) {
+				mu.Lock()
+				defer mu.Unlock()
+				seen = append(seen, params)
+			}
+
+			WithMiddleware(func(next RequestFunc) RequestFunc {
+				return func(ctx context.Context, command string, params url.Values) (json.RawMessage, error) {
+					b, err := next(ctx, command, params)
+					record(params)
+					return b, err
+				}
+			})(cs)
+			WithClientTrace(&ClientTrace{
+				RequestRetry: func(ctx context.Context, info *RequestInfo) { record(info.Params) },
+				RequestDone:  func(ctx context.Context, info *RequestInfo) { record(info.Params) },
+			})(cs)
+
+			params := url.Values{}
+			params.Set("name", "zone1")
+
+			if _, err := cs.newRequest("listZones", params); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			want := url.Values{"name": {"zone1"}}
+			if !reflect.DeepEqual(params, want) {
+				t.Errorf("Expected the params of the caller to be unchanged, got %v", params)
+			}
+			if len(seen) != 3 {
+				t.Fatalf("Expected the params to be seen by a retry, the middleware and a done hook, got %v", seen)
+			}
+			for _, p := range seen {
+				if !reflect.DeepEqual(p, want) {
+					t.Errorf("Expected the params %v, got %v", want, p)
+				}
+			}
+		})
+	}
+}
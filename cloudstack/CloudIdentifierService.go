@@ -20,15 +20,26 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 )
 
+// CloudIdentifierServiceIface is the interface implemented by CloudIdentifierService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type CloudIdentifierServiceIface interface {
+	NewGetCloudIdentifierParams(userid string) *GetCloudIdentifierParams
+	GetCloudIdentifier(p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error)
+	GetCloudIdentifierWithContext(ctx context.Context, p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error)
+}
+
 type GetCloudIdentifierParams struct {
 	p map[string]interface{}
 }
 
-func (p *GetCloudIdentifierParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetCloudIdentifierParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -39,6 +50,48 @@ func (p *GetCloudIdentifierParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetCloudIdentifierParams) Validate() error {
+	v := newParamValidator("getCloudIdentifier", p.p)
+	v.required("userid")
+	v.ids("userid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetCloudIdentifierParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetCloudIdentifierParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "userid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getCloudIdentifier", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getCloudIdentifier: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *GetCloudIdentifierParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -46,6 +99,15 @@ func (p *GetCloudIdentifierParams) SetUserid(v string) {
 	p.p["userid"] = v
 }
 
+func (p *GetCloudIdentifierParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *GetCloudIdentifierParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new GetCloudIdentifierParams instance,
 // as then you are sure you have configured all required params
 func (s *CloudIdentifierService) NewGetCloudIdentifierParams(userid string) *GetCloudIdentifierParams {
@@ -57,7 +119,16 @@ func (s *CloudIdentifierService) NewGetCloudIdentifierParams(userid string) *Get
 
 // Retrieves a cloud identifier.
 func (s *CloudIdentifierService) GetCloudIdentifier(p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error) {
-	resp, err := s.cs.newRequest("getCloudIdentifier", p.toURLValues())
+	return s.GetCloudIdentifierWithContext(context.Background(), p)
+}
+
+// GetCloudIdentifierWithContext is the same as GetCloudIdentifier, but uses ctx to cancel the request and any async job polling
+func (s *CloudIdentifierService) GetCloudIdentifierWithContext(ctx context.Context, p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getCloudIdentifier", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
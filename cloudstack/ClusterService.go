@@ -20,18 +20,84 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// ClusterServiceIface is the interface implemented by ClusterService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type ClusterServiceIface interface {
+	NewAddClusterParams(clustername string, clustertype string, hypervisor string, podid string, zoneid string) *AddClusterParams
+	AddCluster(p *AddClusterParams) (*AddClusterResponse, error)
+	AddClusterWithContext(ctx context.Context, p *AddClusterParams) (*AddClusterResponse, error)
+	NewDedicateClusterParams(clusterid string, domainid string) *DedicateClusterParams
+	DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error)
+	DedicateClusterWithContext(ctx context.Context, p *DedicateClusterParams) (*DedicateClusterResponse, error)
+	DedicateClusterAsync(p *DedicateClusterParams) (*Job, error)
+	DedicateClusterAsyncWithContext(ctx context.Context, p *DedicateClusterParams) (*Job, error)
+	NewDeleteClusterParams(id string) *DeleteClusterParams
+	DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error)
+	DeleteClusterWithContext(ctx context.Context, p *DeleteClusterParams) (*DeleteClusterResponse, error)
+	NewDisableOutOfBandManagementForClusterParams(clusterid string) *DisableOutOfBandManagementForClusterParams
+	DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error)
+	DisableOutOfBandManagementForClusterWithContext(ctx context.Context, p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error)
+	DisableOutOfBandManagementForClusterAsync(p *DisableOutOfBandManagementForClusterParams) (*Job, error)
+	DisableOutOfBandManagementForClusterAsyncWithContext(ctx context.Context, p *DisableOutOfBandManagementForClusterParams) (*Job, error)
+	NewEnableOutOfBandManagementForClusterParams(clusterid string) *EnableOutOfBandManagementForClusterParams
+	EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error)
+	EnableOutOfBandManagementForClusterWithContext(ctx context.Context, p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error)
+	EnableOutOfBandManagementForClusterAsync(p *EnableOutOfBandManagementForClusterParams) (*Job, error)
+	EnableOutOfBandManagementForClusterAsyncWithContext(ctx context.Context, p *EnableOutOfBandManagementForClusterParams) (*Job, error)
+	NewListClustersParams() *ListClustersParams
+	GetClusterID(name string, opts ...OptionFunc) (string, int, error)
+	GetClusterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetClusterByName(name string, opts ...OptionFunc) (*Cluster, int, error)
+	GetClusterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Cluster, int, error)
+	GetClusterByID(id string, opts ...OptionFunc) (*Cluster, int, error)
+	GetClusterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Cluster, int, error)
+	ListClusters(p *ListClustersParams) (*ListClustersResponse, error)
+	ListClustersWithContext(ctx context.Context, p *ListClustersParams) (*ListClustersResponse, error)
+	NewListClustersPager(p *ListClustersParams) *ListClustersPager
+	ListClustersAll(p *ListClustersParams) (*ListClustersResponse, error)
+	ListClustersAllWithContext(ctx context.Context, p *ListClustersParams) (*ListClustersResponse, error)
+	NewListClustersMetricsParams() *ListClustersMetricsParams
+	GetClustersMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetClustersMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetClustersMetricByName(name string, opts ...OptionFunc) (*ClustersMetric, int, error)
+	GetClustersMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ClustersMetric, int, error)
+	GetClustersMetricByID(id string, opts ...OptionFunc) (*ClustersMetric, int, error)
+	GetClustersMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ClustersMetric, int, error)
+	ListClustersMetrics(p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error)
+	ListClustersMetricsWithContext(ctx context.Context, p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error)
+	NewListClustersMetricsPager(p *ListClustersMetricsParams) *ListClustersMetricsPager
+	ListClustersMetricsAll(p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error)
+	ListClustersMetricsAllWithContext(ctx context.Context, p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error)
+	NewListDedicatedClustersParams() *ListDedicatedClustersParams
+	ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error)
+	ListDedicatedClustersWithContext(ctx context.Context, p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error)
+	NewListDedicatedClustersPager(p *ListDedicatedClustersParams) *ListDedicatedClustersPager
+	ListDedicatedClustersAll(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error)
+	ListDedicatedClustersAllWithContext(ctx context.Context, p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error)
+	NewReleaseDedicatedClusterParams(clusterid string) *ReleaseDedicatedClusterParams
+	ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error)
+	ReleaseDedicatedClusterWithContext(ctx context.Context, p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error)
+	ReleaseDedicatedClusterAsync(p *ReleaseDedicatedClusterParams) (*Job, error)
+	ReleaseDedicatedClusterAsyncWithContext(ctx context.Context, p *ReleaseDedicatedClusterParams) (*Job, error)
+	NewUpdateClusterParams(id string) *UpdateClusterParams
+	UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error)
+	UpdateClusterWithContext(ctx context.Context, p *UpdateClusterParams) (*UpdateClusterResponse, error)
+}
+
 type AddClusterParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddClusterParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -96,6 +162,65 @@ func (p *AddClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddClusterParams) Validate() error {
+	v := newParamValidator("addCluster", p.p)
+	v.required("clustername", "clustertype", "hypervisor", "podid", "zoneid")
+	v.ids("podid", "zoneid")
+	v.maxLength("allocationstate", 255)
+	v.maxLength("clustername", 255)
+	v.maxLength("clustertype", 255)
+	v.maxLength("guestvswitchname", 255)
+	v.maxLength("guestvswitchtype", 255)
+	v.maxLength("hypervisor", 255)
+	v.maxLength("ovm3cluster", 255)
+	v.maxLength("ovm3pool", 255)
+	v.maxLength("ovm3vip", 255)
+	v.maxLength("password", 255)
+	v.maxLength("publicvswitchname", 255)
+	v.maxLength("publicvswitchtype", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	v.maxLength("vsmipaddress", 255)
+	v.maxLength("vsmpassword", 255)
+	v.maxLength("vsmusername", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "allocationstate", "clustername", "clustertype", "guestvswitchname", "guestvswitchtype", "hypervisor", "ovm3cluster", "ovm3pool", "ovm3vip", "password", "podid", "publicvswitchname", "publicvswitchtype", "url", "username", "vsmipaddress", "vsmpassword", "vsmusername", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddClusterParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -103,6 +228,15 @@ func (p *AddClusterParams) SetAllocationstate(v string) {
 	p.p["allocationstate"] = v
 }
 
+func (p *AddClusterParams) ResetAllocationstate() {
+	delete(p.p, "allocationstate")
+}
+
+func (p *AddClusterParams) GetAllocationstate() (string, bool) {
+	value, ok := p.p["allocationstate"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetClustername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -110,6 +244,15 @@ func (p *AddClusterParams) SetClustername(v string) {
 	p.p["clustername"] = v
 }
 
+func (p *AddClusterParams) ResetClustername() {
+	delete(p.p, "clustername")
+}
+
+func (p *AddClusterParams) GetClustername() (string, bool) {
+	value, ok := p.p["clustername"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetClustertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -117,6 +260,15 @@ func (p *AddClusterParams) SetClustertype(v string) {
 	p.p["clustertype"] = v
 }
 
+func (p *AddClusterParams) ResetClustertype() {
+	delete(p.p, "clustertype")
+}
+
+func (p *AddClusterParams) GetClustertype() (string, bool) {
+	value, ok := p.p["clustertype"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetGuestvswitchname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -124,6 +276,15 @@ func (p *AddClusterParams) SetGuestvswitchname(v string) {
 	p.p["guestvswitchname"] = v
 }
 
+func (p *AddClusterParams) ResetGuestvswitchname() {
+	delete(p.p, "guestvswitchname")
+}
+
+func (p *AddClusterParams) GetGuestvswitchname() (string, bool) {
+	value, ok := p.p["guestvswitchname"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetGuestvswitchtype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -131,6 +292,15 @@ func (p *AddClusterParams) SetGuestvswitchtype(v string) {
 	p.p["guestvswitchtype"] = v
 }
 
+func (p *AddClusterParams) ResetGuestvswitchtype() {
+	delete(p.p, "guestvswitchtype")
+}
+
+func (p *AddClusterParams) GetGuestvswitchtype() (string, bool) {
+	value, ok := p.p["guestvswitchtype"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -138,6 +308,15 @@ func (p *AddClusterParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *AddClusterParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *AddClusterParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetOvm3cluster(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -145,6 +324,15 @@ func (p *AddClusterParams) SetOvm3cluster(v string) {
 	p.p["ovm3cluster"] = v
 }
 
+func (p *AddClusterParams) ResetOvm3cluster() {
+	delete(p.p, "ovm3cluster")
+}
+
+func (p *AddClusterParams) GetOvm3cluster() (string, bool) {
+	value, ok := p.p["ovm3cluster"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetOvm3pool(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -152,6 +340,15 @@ func (p *AddClusterParams) SetOvm3pool(v string) {
 	p.p["ovm3pool"] = v
 }
 
+func (p *AddClusterParams) ResetOvm3pool() {
+	delete(p.p, "ovm3pool")
+}
+
+func (p *AddClusterParams) GetOvm3pool() (string, bool) {
+	value, ok := p.p["ovm3pool"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetOvm3vip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -159,6 +356,15 @@ func (p *AddClusterParams) SetOvm3vip(v string) {
 	p.p["ovm3vip"] = v
 }
 
+func (p *AddClusterParams) ResetOvm3vip() {
+	delete(p.p, "ovm3vip")
+}
+
+func (p *AddClusterParams) GetOvm3vip() (string, bool) {
+	value, ok := p.p["ovm3vip"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -166,6 +372,15 @@ func (p *AddClusterParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *AddClusterParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddClusterParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -173,6 +388,15 @@ func (p *AddClusterParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *AddClusterParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *AddClusterParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetPublicvswitchname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -180,6 +404,15 @@ func (p *AddClusterParams) SetPublicvswitchname(v string) {
 	p.p["publicvswitchname"] = v
 }
 
+func (p *AddClusterParams) ResetPublicvswitchname() {
+	delete(p.p, "publicvswitchname")
+}
+
+func (p *AddClusterParams) GetPublicvswitchname() (string, bool) {
+	value, ok := p.p["publicvswitchname"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetPublicvswitchtype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -187,6 +420,15 @@ func (p *AddClusterParams) SetPublicvswitchtype(v string) {
 	p.p["publicvswitchtype"] = v
 }
 
+func (p *AddClusterParams) ResetPublicvswitchtype() {
+	delete(p.p, "publicvswitchtype")
+}
+
+func (p *AddClusterParams) GetPublicvswitchtype() (string, bool) {
+	value, ok := p.p["publicvswitchtype"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -194,6 +436,15 @@ func (p *AddClusterParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *AddClusterParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddClusterParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -201,6 +452,15 @@ func (p *AddClusterParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddClusterParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddClusterParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetVsmipaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -208,6 +468,15 @@ func (p *AddClusterParams) SetVsmipaddress(v string) {
 	p.p["vsmipaddress"] = v
 }
 
+func (p *AddClusterParams) ResetVsmipaddress() {
+	delete(p.p, "vsmipaddress")
+}
+
+func (p *AddClusterParams) GetVsmipaddress() (string, bool) {
+	value, ok := p.p["vsmipaddress"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetVsmpassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -215,6 +484,15 @@ func (p *AddClusterParams) SetVsmpassword(v string) {
 	p.p["vsmpassword"] = v
 }
 
+func (p *AddClusterParams) ResetVsmpassword() {
+	delete(p.p, "vsmpassword")
+}
+
+func (p *AddClusterParams) GetVsmpassword() (string, bool) {
+	value, ok := p.p["vsmpassword"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetVsmusername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -222,6 +500,15 @@ func (p *AddClusterParams) SetVsmusername(v string) {
 	p.p["vsmusername"] = v
 }
 
+func (p *AddClusterParams) ResetVsmusername() {
+	delete(p.p, "vsmusername")
+}
+
+func (p *AddClusterParams) GetVsmusername() (string, bool) {
+	value, ok := p.p["vsmusername"].(string)
+	return value, ok
+}
+
 func (p *AddClusterParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -229,6 +516,15 @@ func (p *AddClusterParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *AddClusterParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *AddClusterParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewAddClusterParams(clustername string, clustertype string, hypervisor string, podid string, zoneid string) *AddClusterParams {
@@ -244,7 +540,16 @@ func (s *ClusterService) NewAddClusterParams(clustername string, clustertype str
 
 // Adds a new cluster
 func (s *ClusterService) AddCluster(p *AddClusterParams) (*AddClusterResponse, error) {
-	resp, err := s.cs.newRequest("addCluster", p.toURLValues())
+	return s.AddClusterWithContext(context.Background(), p)
+}
+
+// AddClusterWithContext is the same as AddCluster, but uses ctx to cancel the request and any async job polling
+func (s *ClusterService) AddClusterWithContext(ctx context.Context, p *AddClusterParams) (*AddClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addCluster", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -296,7 +601,8 @@ type DedicateClusterParams struct {
 	p map[string]interface{}
 }
 
-func (p *DedicateClusterParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DedicateClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -313,6 +619,49 @@ func (p *DedicateClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DedicateClusterParams) Validate() error {
+	v := newParamValidator("dedicateCluster", p.p)
+	v.required("clusterid", "domainid")
+	v.ids("clusterid", "domainid")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DedicateClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DedicateClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "clusterid", "domainid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for dedicateCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of dedicateCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DedicateClusterParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -320,6 +669,15 @@ func (p *DedicateClusterParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *DedicateClusterParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *DedicateClusterParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *DedicateClusterParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -327,6 +685,15 @@ func (p *DedicateClusterParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *DedicateClusterParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *DedicateClusterParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *DedicateClusterParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -334,6 +701,15 @@ func (p *DedicateClusterParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *DedicateClusterParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *DedicateClusterParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DedicateClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewDedicateClusterParams(clusterid string, domainid string) *DedicateClusterParams {
@@ -346,7 +722,16 @@ func (s *ClusterService) NewDedicateClusterParams(clusterid string, domainid str
 
 // Dedicate an existing cluster
 func (s *ClusterService) DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error) {
-	resp, err := s.cs.newRequest("dedicateCluster", p.toURLValues())
+	return s.DedicateClusterWithContext(context.Background(), p)
+}
+
+// DedicateClusterWithContext is the same as DedicateCluster, but uses ctx to cancel the request and any async job polling
+func (s *ClusterService) DedicateClusterWithContext(ctx context.Context, p *DedicateClusterParams) (*DedicateClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicateCluster", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -358,7 +743,7 @@ func (s *ClusterService) DedicateCluster(p *DedicateClusterParams) (*DedicateClu
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -379,6 +764,40 @@ func (s *ClusterService) DedicateCluster(p *DedicateClusterParams) (*DedicateClu
 	return &r, nil
 }
 
+// DedicateClusterAsync starts the async job for DedicateCluster and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DedicateClusterResponse using Job.Result
+func (s *ClusterService) DedicateClusterAsync(p *DedicateClusterParams) (*Job, error) {
+	return s.DedicateClusterAsyncWithContext(context.Background(), p)
+}
+
+// DedicateClusterAsyncWithContext is the same as DedicateClusterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ClusterService) DedicateClusterAsyncWithContext(ctx context.Context, p *DedicateClusterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicateCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DedicateClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type DedicateClusterResponse struct {
 	Accountid       string `json:"accountid"`
 	Affinitygroupid string `json:"affinitygroupid"`
@@ -394,7 +813,8 @@ type DeleteClusterParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteClusterParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -405,6 +825,48 @@ func (p *DeleteClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteClusterParams) Validate() error {
+	v := newParamValidator("deleteCluster", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteClusterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -412,6 +874,15 @@ func (p *DeleteClusterParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteClusterParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteClusterParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewDeleteClusterParams(id string) *DeleteClusterParams {
@@ -423,7 +894,16 @@ func (s *ClusterService) NewDeleteClusterParams(id string) *DeleteClusterParams
 
 // Deletes a cluster.
 func (s *ClusterService) DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error) {
-	resp, err := s.cs.newRequest("deleteCluster", p.toURLValues())
+	return s.DeleteClusterWithContext(context.Background(), p)
+}
+
+// DeleteClusterWithContext is the same as DeleteCluster, but uses ctx to cancel the request and any async job polling
+func (s *ClusterService) DeleteClusterWithContext(ctx context.Context, p *DeleteClusterParams) (*DeleteClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCluster", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -474,7 +954,8 @@ type DisableOutOfBandManagementForClusterParams struct {
 	p map[string]interface{}
 }
 
-func (p *DisableOutOfBandManagementForClusterParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DisableOutOfBandManagementForClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -485,6 +966,48 @@ func (p *DisableOutOfBandManagementForClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DisableOutOfBandManagementForClusterParams) Validate() error {
+	v := newParamValidator("disableOutOfBandManagementForCluster", p.p)
+	v.required("clusterid")
+	v.ids("clusterid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DisableOutOfBandManagementForClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DisableOutOfBandManagementForClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for disableOutOfBandManagementForCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of disableOutOfBandManagementForCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DisableOutOfBandManagementForClusterParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -492,6 +1015,15 @@ func (p *DisableOutOfBandManagementForClusterParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *DisableOutOfBandManagementForClusterParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *DisableOutOfBandManagementForClusterParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DisableOutOfBandManagementForClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewDisableOutOfBandManagementForClusterParams(clusterid string) *DisableOutOfBandManagementForClusterParams {
@@ -503,7 +1035,16 @@ func (s *ClusterService) NewDisableOutOfBandManagementForClusterParams(clusterid
 
 // Disables out-of-band management for a cluster
 func (s *ClusterService) DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error) {
-	resp, err := s.cs.newRequest("disableOutOfBandManagementForCluster", p.toURLValues())
+	return s.DisableOutOfBandManagementForClusterWithContext(context.Background(), p)
+}
+
+// DisableOutOfBandManagementForClusterWithContext is the same as DisableOutOfBandManagementForCluster, but uses ctx to cancel the request and any async job polling
+func (s *ClusterService) DisableOutOfBandManagementForClusterWithContext(ctx context.Context, p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableOutOfBandManagementForCluster", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -515,7 +1056,7 @@ func (s *ClusterService) DisableOutOfBandManagementForCluster(p *DisableOutOfBan
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -536,6 +1077,40 @@ func (s *ClusterService) DisableOutOfBandManagementForCluster(p *DisableOutOfBan
 	return &r, nil
 }
 
+// DisableOutOfBandManagementForClusterAsync starts the async job for DisableOutOfBandManagementForCluster and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DisableOutOfBandManagementForClusterResponse using Job.Result
+func (s *ClusterService) DisableOutOfBandManagementForClusterAsync(p *DisableOutOfBandManagementForClusterParams) (*Job, error) {
+	return s.DisableOutOfBandManagementForClusterAsyncWithContext(context.Background(), p)
+}
+
+// DisableOutOfBandManagementForClusterAsyncWithContext is the same as DisableOutOfBandManagementForClusterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ClusterService) DisableOutOfBandManagementForClusterAsyncWithContext(ctx context.Context, p *DisableOutOfBandManagementForClusterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableOutOfBandManagementForCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableOutOfBandManagementForClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type DisableOutOfBandManagementForClusterResponse struct {
 	Action      string `json:"action"`
 	Address     string `json:"address"`
@@ -556,7 +1131,8 @@ type EnableOutOfBandManagementForClusterParams struct {
 	p map[string]interface{}
 }
 
-func (p *EnableOutOfBandManagementForClusterParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *EnableOutOfBandManagementForClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -567,6 +1143,48 @@ func (p *EnableOutOfBandManagementForClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *EnableOutOfBandManagementForClusterParams) Validate() error {
+	v := newParamValidator("enableOutOfBandManagementForCluster", p.p)
+	v.required("clusterid")
+	v.ids("clusterid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *EnableOutOfBandManagementForClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *EnableOutOfBandManagementForClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for enableOutOfBandManagementForCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of enableOutOfBandManagementForCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *EnableOutOfBandManagementForClusterParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -574,6 +1192,15 @@ func (p *EnableOutOfBandManagementForClusterParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *EnableOutOfBandManagementForClusterParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *EnableOutOfBandManagementForClusterParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new EnableOutOfBandManagementForClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewEnableOutOfBandManagementForClusterParams(clusterid string) *EnableOutOfBandManagementForClusterParams {
@@ -585,7 +1212,16 @@ func (s *ClusterService) NewEnableOutOfBandManagementForClusterParams(clusterid
 
 // Enables out-of-band management for a cluster
 func (s *ClusterService) EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error) {
-	resp, err := s.cs.newRequest("enableOutOfBandManagementForCluster", p.toURLValues())
+	return s.EnableOutOfBandManagementForClusterWithContext(context.Background(), p)
+}
+
+// EnableOutOfBandManagementForClusterWithContext is the same as EnableOutOfBandManagementForCluster, but uses ctx to cancel the request and any async job polling
+func (s *ClusterService) EnableOutOfBandManagementForClusterWithContext(ctx context.Context, p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableOutOfBandManagementForCluster", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -597,7 +1233,7 @@ func (s *ClusterService) EnableOutOfBandManagementForCluster(p *EnableOutOfBandM
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -618,27 +1254,62 @@ func (s *ClusterService) EnableOutOfBandManagementForCluster(p *EnableOutOfBandM
 	return &r, nil
 }
 
-type EnableOutOfBandManagementForClusterResponse struct {
-	Action      string `json:"action"`
-	Address     string `json:"address"`
-	Description string `json:"description"`
-	Driver      string `json:"driver"`
-	Enabled     bool   `json:"enabled"`
-	Hostid      string `json:"hostid"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Password    string `json:"password"`
-	Port        string `json:"port"`
-	Powerstate  string `json:"powerstate"`
-	Status      bool   `json:"status"`
-	Username    string `json:"username"`
+// EnableOutOfBandManagementForClusterAsync starts the async job for EnableOutOfBandManagementForCluster and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a EnableOutOfBandManagementForClusterResponse using Job.Result
+func (s *ClusterService) EnableOutOfBandManagementForClusterAsync(p *EnableOutOfBandManagementForClusterParams) (*Job, error) {
+	return s.EnableOutOfBandManagementForClusterAsyncWithContext(context.Background(), p)
 }
 
-type ListClustersParams struct {
-	p map[string]interface{}
+// EnableOutOfBandManagementForClusterAsyncWithContext is the same as EnableOutOfBandManagementForClusterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ClusterService) EnableOutOfBandManagementForClusterAsyncWithContext(ctx context.Context, p *EnableOutOfBandManagementForClusterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableOutOfBandManagementForCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableOutOfBandManagementForClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
 }
 
-func (p *ListClustersParams) toURLValues() url.Values {
+type EnableOutOfBandManagementForClusterResponse struct {
+	Action      string `json:"action"`
+	Address     string `json:"address"`
+	Description string `json:"description"`
+	Driver      string `json:"driver"`
+	Enabled     bool   `json:"enabled"`
+	Hostid      string `json:"hostid"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Password    string `json:"password"`
+	Port        string `json:"port"`
+	Powerstate  string `json:"powerstate"`
+	Status      bool   `json:"status"`
+	Username    string `json:"username"`
+}
+
+type ListClustersParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListClustersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -685,6 +1356,61 @@ func (p *ListClustersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListClustersParams) Validate() error {
+	v := newParamValidator("listClusters", p.p)
+	v.ids("id", "podid", "zoneid")
+	v.maxLength("allocationstate", 255)
+	v.maxLength("clustertype", 255)
+	v.maxLength("hypervisor", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("managedstate", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListClustersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListClustersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "allocationstate", "clustertype", "hypervisor", "id", "keyword", "managedstate", "name", "podid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "showcapacities":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listClusters", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listClusters: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListClustersParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -692,6 +1418,15 @@ func (p *ListClustersParams) SetAllocationstate(v string) {
 	p.p["allocationstate"] = v
 }
 
+func (p *ListClustersParams) ResetAllocationstate() {
+	delete(p.p, "allocationstate")
+}
+
+func (p *ListClustersParams) GetAllocationstate() (string, bool) {
+	value, ok := p.p["allocationstate"].(string)
+	return value, ok
+}
+
 func (p *ListClustersParams) SetClustertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -699,6 +1434,15 @@ func (p *ListClustersParams) SetClustertype(v string) {
 	p.p["clustertype"] = v
 }
 
+func (p *ListClustersParams) ResetClustertype() {
+	delete(p.p, "clustertype")
+}
+
+func (p *ListClustersParams) GetClustertype() (string, bool) {
+	value, ok := p.p["clustertype"].(string)
+	return value, ok
+}
+
 func (p *ListClustersParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -706,6 +1450,15 @@ func (p *ListClustersParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *ListClustersParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *ListClustersParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *ListClustersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -713,6 +1466,15 @@ func (p *ListClustersParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListClustersParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListClustersParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListClustersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -720,6 +1482,15 @@ func (p *ListClustersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListClustersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListClustersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListClustersParams) SetManagedstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -727,6 +1498,15 @@ func (p *ListClustersParams) SetManagedstate(v string) {
 	p.p["managedstate"] = v
 }
 
+func (p *ListClustersParams) ResetManagedstate() {
+	delete(p.p, "managedstate")
+}
+
+func (p *ListClustersParams) GetManagedstate() (string, bool) {
+	value, ok := p.p["managedstate"].(string)
+	return value, ok
+}
+
 func (p *ListClustersParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -734,6 +1514,15 @@ func (p *ListClustersParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListClustersParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListClustersParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListClustersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -741,6 +1530,15 @@ func (p *ListClustersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListClustersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListClustersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListClustersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -748,6 +1546,15 @@ func (p *ListClustersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListClustersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListClustersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListClustersParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -755,6 +1562,15 @@ func (p *ListClustersParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *ListClustersParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListClustersParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *ListClustersParams) SetShowcapacities(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -762,6 +1578,15 @@ func (p *ListClustersParams) SetShowcapacities(v bool) {
 	p.p["showcapacities"] = v
 }
 
+func (p *ListClustersParams) ResetShowcapacities() {
+	delete(p.p, "showcapacities")
+}
+
+func (p *ListClustersParams) GetShowcapacities() (bool, bool) {
+	value, ok := p.p["showcapacities"].(bool)
+	return value, ok
+}
+
 func (p *ListClustersParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -769,6 +1594,15 @@ func (p *ListClustersParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListClustersParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListClustersParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListClustersParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewListClustersParams() *ListClustersParams {
@@ -779,6 +1613,11 @@ func (s *ClusterService) NewListClustersParams() *ListClustersParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClusterID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetClusterIDWithContext(context.Background(), name, opts...)
+}
+
+// GetClusterIDWithContext is the same as GetClusterID, but uses ctx to cancel the request
+func (s *ClusterService) GetClusterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListClustersParams{}
 	p.p = make(map[string]interface{})
 
@@ -790,7 +1629,7 @@ func (s *ClusterService) GetClusterID(name string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListClusters(p)
+	l, err := s.ListClustersWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -815,12 +1654,17 @@ func (s *ClusterService) GetClusterID(name string, opts ...OptionFunc) (string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClusterByName(name string, opts ...OptionFunc) (*Cluster, int, error) {
-	id, count, err := s.GetClusterID(name, opts...)
+	return s.GetClusterByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetClusterByNameWithContext is the same as GetClusterByName, but uses ctx to cancel the requests
+func (s *ClusterService) GetClusterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Cluster, int, error) {
+	id, count, err := s.GetClusterIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetClusterByID(id, opts...)
+	r, count, err := s.GetClusterByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -829,6 +1673,11 @@ func (s *ClusterService) GetClusterByName(name string, opts ...OptionFunc) (*Clu
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClusterByID(id string, opts ...OptionFunc) (*Cluster, int, error) {
+	return s.GetClusterByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetClusterByIDWithContext is the same as GetClusterByID, but uses ctx to cancel the request
+func (s *ClusterService) GetClusterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Cluster, int, error) {
 	p := &ListClustersParams{}
 	p.p = make(map[string]interface{})
 
@@ -840,18 +1689,16 @@ func (s *ClusterService) GetClusterByID(id string, opts ...OptionFunc) (*Cluster
 		}
 	}
 
-	l, err := s.ListClusters(p)
+	l, err := s.ListClustersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -862,7 +1709,16 @@ func (s *ClusterService) GetClusterByID(id string, opts ...OptionFunc) (*Cluster
 
 // Lists clusters.
 func (s *ClusterService) ListClusters(p *ListClustersParams) (*ListClustersResponse, error) {
-	resp, err := s.cs.newRequest("listClusters", p.toURLValues())
+	return s.ListClustersWithContext(context.Background(), p)
+}
+
+// ListClustersWithContext is the same as ListClusters, but uses ctx to cancel the request and any async job polling
+func (s *ClusterService) ListClustersWithContext(ctx context.Context, p *ListClustersParams) (*ListClustersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listClusters", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -875,6 +1731,92 @@ func (s *ClusterService) ListClusters(p *ListClustersParams) (*ListClustersRespo
 	return &r, nil
 }
 
+// ListClustersPager iterates over all pages of a ListClusters call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListClustersPager struct {
+	pager
+
+	s *ClusterService
+	p *ListClustersParams
+	r *ListClustersResponse
+}
+
+// NewListClustersPager returns a pager for all pages of a ListClusters call with the given params
+func (s *ClusterService) NewListClustersPager(p *ListClustersParams) *ListClustersPager {
+	return &ListClustersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListClustersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListClustersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListClustersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListClustersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Clusters), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListClustersPager) Page() *ListClustersResponse {
+	return pg.r
+}
+
+// ListClustersAll fetches all pages of a ListClusters call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ClusterService) ListClustersAll(p *ListClustersParams) (*ListClustersResponse, error) {
+	return s.ListClustersAllWithContext(context.Background(), p)
+}
+
+// ListClustersAllWithContext is the same as ListClustersAll, but uses ctx to cancel the requests
+func (s *ClusterService) ListClustersAllWithContext(ctx context.Context, p *ListClustersParams) (*ListClustersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Cluster)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListClustersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListClustersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Clusters
+		mu.Unlock()
+
+		return len(l.Clusters), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListClustersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Clusters = append(r.Clusters, pages[page]...)
+	}
+	r.Count = len(r.Clusters)
+
+	return r, nil
+}
+
 type ListClustersResponse struct {
 	Count    int        `json:"count"`
 	Clusters []*Cluster `json:"cluster"`
@@ -919,7 +1861,8 @@ type ListClustersMetricsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListClustersMetricsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListClustersMetricsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -966,6 +1909,61 @@ func (p *ListClustersMetricsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListClustersMetricsParams) Validate() error {
+	v := newParamValidator("listClustersMetrics", p.p)
+	v.ids("id", "podid", "zoneid")
+	v.maxLength("allocationstate", 255)
+	v.maxLength("clustertype", 255)
+	v.maxLength("hypervisor", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("managedstate", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListClustersMetricsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListClustersMetricsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "allocationstate", "clustertype", "hypervisor", "id", "keyword", "managedstate", "name", "podid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "showcapacities":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listClustersMetrics", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listClustersMetrics: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListClustersMetricsParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -973,6 +1971,15 @@ func (p *ListClustersMetricsParams) SetAllocationstate(v string) {
 	p.p["allocationstate"] = v
 }
 
+func (p *ListClustersMetricsParams) ResetAllocationstate() {
+	delete(p.p, "allocationstate")
+}
+
+func (p *ListClustersMetricsParams) GetAllocationstate() (string, bool) {
+	value, ok := p.p["allocationstate"].(string)
+	return value, ok
+}
+
 func (p *ListClustersMetricsParams) SetClustertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -980,6 +1987,15 @@ func (p *ListClustersMetricsParams) SetClustertype(v string) {
 	p.p["clustertype"] = v
 }
 
+func (p *ListClustersMetricsParams) ResetClustertype() {
+	delete(p.p, "clustertype")
+}
+
+func (p *ListClustersMetricsParams) GetClustertype() (string, bool) {
+	value, ok := p.p["clustertype"].(string)
+	return value, ok
+}
+
 func (p *ListClustersMetricsParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -987,6 +2003,15 @@ func (p *ListClustersMetricsParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *ListClustersMetricsParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *ListClustersMetricsParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *ListClustersMetricsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -994,6 +2019,15 @@ func (p *ListClustersMetricsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListClustersMetricsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListClustersMetricsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListClustersMetricsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1001,6 +2035,15 @@ func (p *ListClustersMetricsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListClustersMetricsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListClustersMetricsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListClustersMetricsParams) SetManagedstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1008,6 +2051,15 @@ func (p *ListClustersMetricsParams) SetManagedstate(v string) {
 	p.p["managedstate"] = v
 }
 
+func (p *ListClustersMetricsParams) ResetManagedstate() {
+	delete(p.p, "managedstate")
+}
+
+func (p *ListClustersMetricsParams) GetManagedstate() (string, bool) {
+	value, ok := p.p["managedstate"].(string)
+	return value, ok
+}
+
 func (p *ListClustersMetricsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1015,6 +2067,15 @@ func (p *ListClustersMetricsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListClustersMetricsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListClustersMetricsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListClustersMetricsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1022,6 +2083,15 @@ func (p *ListClustersMetricsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListClustersMetricsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListClustersMetricsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListClustersMetricsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1029,6 +2099,15 @@ func (p *ListClustersMetricsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListClustersMetricsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListClustersMetricsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListClustersMetricsParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1036,6 +2115,15 @@ func (p *ListClustersMetricsParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *ListClustersMetricsParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListClustersMetricsParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *ListClustersMetricsParams) SetShowcapacities(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1043,6 +2131,15 @@ func (p *ListClustersMetricsParams) SetShowcapacities(v bool) {
 	p.p["showcapacities"] = v
 }
 
+func (p *ListClustersMetricsParams) ResetShowcapacities() {
+	delete(p.p, "showcapacities")
+}
+
+func (p *ListClustersMetricsParams) GetShowcapacities() (bool, bool) {
+	value, ok := p.p["showcapacities"].(bool)
+	return value, ok
+}
+
 func (p *ListClustersMetricsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1050,6 +2147,15 @@ func (p *ListClustersMetricsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListClustersMetricsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListClustersMetricsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListClustersMetricsParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewListClustersMetricsParams() *ListClustersMetricsParams {
@@ -1060,6 +2166,11 @@ func (s *ClusterService) NewListClustersMetricsParams() *ListClustersMetricsPara
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClustersMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetClustersMetricIDWithContext(context.Background(), name, opts...)
+}
+
+// GetClustersMetricIDWithContext is the same as GetClustersMetricID, but uses ctx to cancel the request
+func (s *ClusterService) GetClustersMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListClustersMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1071,7 +2182,7 @@ func (s *ClusterService) GetClustersMetricID(name string, opts ...OptionFunc) (s
 		}
 	}
 
-	l, err := s.ListClustersMetrics(p)
+	l, err := s.ListClustersMetricsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1096,12 +2207,17 @@ func (s *ClusterService) GetClustersMetricID(name string, opts ...OptionFunc) (s
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClustersMetricByName(name string, opts ...OptionFunc) (*ClustersMetric, int, error) {
-	id, count, err := s.GetClustersMetricID(name, opts...)
+	return s.GetClustersMetricByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetClustersMetricByNameWithContext is the same as GetClustersMetricByName, but uses ctx to cancel the requests
+func (s *ClusterService) GetClustersMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ClustersMetric, int, error) {
+	id, count, err := s.GetClustersMetricIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetClustersMetricByID(id, opts...)
+	r, count, err := s.GetClustersMetricByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1110,6 +2226,11 @@ func (s *ClusterService) GetClustersMetricByName(name string, opts ...OptionFunc
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClustersMetricByID(id string, opts ...OptionFunc) (*ClustersMetric, int, error) {
+	return s.GetClustersMetricByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetClustersMetricByIDWithContext is the same as GetClustersMetricByID, but uses ctx to cancel the request
+func (s *ClusterService) GetClustersMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ClustersMetric, int, error) {
 	p := &ListClustersMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1121,18 +2242,16 @@ func (s *ClusterService) GetClustersMetricByID(id string, opts ...OptionFunc) (*
 		}
 	}
 
-	l, err := s.ListClustersMetrics(p)
+	l, err := s.ListClustersMetricsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1143,7 +2262,16 @@ func (s *ClusterService) GetClustersMetricByID(id string, opts ...OptionFunc) (*
 
 // Lists clusters metrics
 func (s *ClusterService) ListClustersMetrics(p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listClustersMetrics", p.toURLValues())
+	return s.ListClustersMetricsWithContext(context.Background(), p)
+}
+
+// ListClustersMetricsWithContext is the same as ListClustersMetrics, but uses ctx to cancel the request and any async job polling
+func (s *ClusterService) ListClustersMetricsWithContext(ctx context.Context, p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listClustersMetrics", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1156,6 +2284,92 @@ func (s *ClusterService) ListClustersMetrics(p *ListClustersMetricsParams) (*Lis
 	return &r, nil
 }
 
+// ListClustersMetricsPager iterates over all pages of a ListClustersMetrics call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListClustersMetricsPager struct {
+	pager
+
+	s *ClusterService
+	p *ListClustersMetricsParams
+	r *ListClustersMetricsResponse
+}
+
+// NewListClustersMetricsPager returns a pager for all pages of a ListClustersMetrics call with the given params
+func (s *ClusterService) NewListClustersMetricsPager(p *ListClustersMetricsParams) *ListClustersMetricsPager {
+	return &ListClustersMetricsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListClustersMetricsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListClustersMetricsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListClustersMetricsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListClustersMetricsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.ClustersMetrics), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListClustersMetricsPager) Page() *ListClustersMetricsResponse {
+	return pg.r
+}
+
+// ListClustersMetricsAll fetches all pages of a ListClustersMetrics call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ClusterService) ListClustersMetricsAll(p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error) {
+	return s.ListClustersMetricsAllWithContext(context.Background(), p)
+}
+
+// ListClustersMetricsAllWithContext is the same as ListClustersMetricsAll, but uses ctx to cancel the requests
+func (s *ClusterService) ListClustersMetricsAllWithContext(ctx context.Context, p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*ClustersMetric)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListClustersMetricsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListClustersMetricsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.ClustersMetrics
+		mu.Unlock()
+
+		return len(l.ClustersMetrics), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListClustersMetricsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.ClustersMetrics = append(r.ClustersMetrics, pages[page]...)
+	}
+	r.Count = len(r.ClustersMetrics)
+
+	return r, nil
+}
+
 type ListClustersMetricsResponse struct {
 	Count           int               `json:"count"`
 	ClustersMetrics []*ClustersMetric `json:"clustersmetric"`
@@ -1218,7 +2432,8 @@ type ListDedicatedClustersParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListDedicatedClustersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListDedicatedClustersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1249,6 +2464,53 @@ func (p *ListDedicatedClustersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListDedicatedClustersParams) Validate() error {
+	v := newParamValidator("listDedicatedClusters", p.p)
+	v.ids("affinitygroupid", "clusterid", "domainid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListDedicatedClustersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListDedicatedClustersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "affinitygroupid", "clusterid", "domainid", "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listDedicatedClusters", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listDedicatedClusters: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListDedicatedClustersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1256,6 +2518,15 @@ func (p *ListDedicatedClustersParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListDedicatedClustersParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListDedicatedClustersParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedClustersParams) SetAffinitygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1263,6 +2534,15 @@ func (p *ListDedicatedClustersParams) SetAffinitygroupid(v string) {
 	p.p["affinitygroupid"] = v
 }
 
+func (p *ListDedicatedClustersParams) ResetAffinitygroupid() {
+	delete(p.p, "affinitygroupid")
+}
+
+func (p *ListDedicatedClustersParams) GetAffinitygroupid() (string, bool) {
+	value, ok := p.p["affinitygroupid"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedClustersParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1270,6 +2550,15 @@ func (p *ListDedicatedClustersParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *ListDedicatedClustersParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ListDedicatedClustersParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedClustersParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1277,6 +2566,15 @@ func (p *ListDedicatedClustersParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListDedicatedClustersParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListDedicatedClustersParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedClustersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1284,6 +2582,15 @@ func (p *ListDedicatedClustersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListDedicatedClustersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListDedicatedClustersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedClustersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1291,6 +2598,15 @@ func (p *ListDedicatedClustersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListDedicatedClustersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListDedicatedClustersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListDedicatedClustersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1298,6 +2614,15 @@ func (p *ListDedicatedClustersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListDedicatedClustersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListDedicatedClustersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListDedicatedClustersParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewListDedicatedClustersParams() *ListDedicatedClustersParams {
@@ -1308,7 +2633,16 @@ func (s *ClusterService) NewListDedicatedClustersParams() *ListDedicatedClusters
 
 // Lists dedicated clusters.
 func (s *ClusterService) ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedClusters", p.toURLValues())
+	return s.ListDedicatedClustersWithContext(context.Background(), p)
+}
+
+// ListDedicatedClustersWithContext is the same as ListDedicatedClusters, but uses ctx to cancel the request and any async job polling
+func (s *ClusterService) ListDedicatedClustersWithContext(ctx context.Context, p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listDedicatedClusters", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1321,6 +2655,92 @@ func (s *ClusterService) ListDedicatedClusters(p *ListDedicatedClustersParams) (
 	return &r, nil
 }
 
+// ListDedicatedClustersPager iterates over all pages of a ListDedicatedClusters call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListDedicatedClustersPager struct {
+	pager
+
+	s *ClusterService
+	p *ListDedicatedClustersParams
+	r *ListDedicatedClustersResponse
+}
+
+// NewListDedicatedClustersPager returns a pager for all pages of a ListDedicatedClusters call with the given params
+func (s *ClusterService) NewListDedicatedClustersPager(p *ListDedicatedClustersParams) *ListDedicatedClustersPager {
+	return &ListDedicatedClustersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListDedicatedClustersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListDedicatedClustersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListDedicatedClustersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListDedicatedClustersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.DedicatedClusters), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListDedicatedClustersPager) Page() *ListDedicatedClustersResponse {
+	return pg.r
+}
+
+// ListDedicatedClustersAll fetches all pages of a ListDedicatedClusters call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ClusterService) ListDedicatedClustersAll(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
+	return s.ListDedicatedClustersAllWithContext(context.Background(), p)
+}
+
+// ListDedicatedClustersAllWithContext is the same as ListDedicatedClustersAll, but uses ctx to cancel the requests
+func (s *ClusterService) ListDedicatedClustersAllWithContext(ctx context.Context, p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*DedicatedCluster)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListDedicatedClustersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListDedicatedClustersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.DedicatedClusters
+		mu.Unlock()
+
+		return len(l.DedicatedClusters), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListDedicatedClustersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.DedicatedClusters = append(r.DedicatedClusters, pages[page]...)
+	}
+	r.Count = len(r.DedicatedClusters)
+
+	return r, nil
+}
+
 type ListDedicatedClustersResponse struct {
 	Count             int                 `json:"count"`
 	DedicatedClusters []*DedicatedCluster `json:"dedicatedcluster"`
@@ -1341,7 +2761,8 @@ type ReleaseDedicatedClusterParams struct {
 	p map[string]interface{}
 }
 
-func (p *ReleaseDedicatedClusterParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ReleaseDedicatedClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1352,6 +2773,48 @@ func (p *ReleaseDedicatedClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ReleaseDedicatedClusterParams) Validate() error {
+	v := newParamValidator("releaseDedicatedCluster", p.p)
+	v.required("clusterid")
+	v.ids("clusterid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ReleaseDedicatedClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ReleaseDedicatedClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for releaseDedicatedCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of releaseDedicatedCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ReleaseDedicatedClusterParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1359,6 +2822,15 @@ func (p *ReleaseDedicatedClusterParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *ReleaseDedicatedClusterParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ReleaseDedicatedClusterParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ReleaseDedicatedClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewReleaseDedicatedClusterParams(clusterid string) *ReleaseDedicatedClusterParams {
@@ -1370,7 +2842,16 @@ func (s *ClusterService) NewReleaseDedicatedClusterParams(clusterid string) *Rel
 
 // Release the dedication for cluster
 func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedCluster", p.toURLValues())
+	return s.ReleaseDedicatedClusterWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedClusterWithContext is the same as ReleaseDedicatedCluster, but uses ctx to cancel the request and any async job polling
+func (s *ClusterService) ReleaseDedicatedClusterWithContext(ctx context.Context, p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedCluster", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1382,7 +2863,7 @@ func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1398,6 +2879,31 @@ func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParam
 	return &r, nil
 }
 
+// ReleaseDedicatedClusterAsync starts the async job for ReleaseDedicatedCluster and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ReleaseDedicatedClusterResponse using Job.Result
+func (s *ClusterService) ReleaseDedicatedClusterAsync(p *ReleaseDedicatedClusterParams) (*Job, error) {
+	return s.ReleaseDedicatedClusterAsyncWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedClusterAsyncWithContext is the same as ReleaseDedicatedClusterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ClusterService) ReleaseDedicatedClusterAsyncWithContext(ctx context.Context, p *ReleaseDedicatedClusterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleaseDedicatedClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type ReleaseDedicatedClusterResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -1409,7 +2915,8 @@ type UpdateClusterParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateClusterParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1435,6 +2942,53 @@ func (p *UpdateClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateClusterParams) Validate() error {
+	v := newParamValidator("updateCluster", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("allocationstate", 255)
+	v.maxLength("clustername", 255)
+	v.maxLength("clustertype", 255)
+	v.maxLength("hypervisor", 255)
+	v.maxLength("managedstate", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "allocationstate", "clustername", "clustertype", "hypervisor", "id", "managedstate":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateClusterParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1442,6 +2996,15 @@ func (p *UpdateClusterParams) SetAllocationstate(v string) {
 	p.p["allocationstate"] = v
 }
 
+func (p *UpdateClusterParams) ResetAllocationstate() {
+	delete(p.p, "allocationstate")
+}
+
+func (p *UpdateClusterParams) GetAllocationstate() (string, bool) {
+	value, ok := p.p["allocationstate"].(string)
+	return value, ok
+}
+
 func (p *UpdateClusterParams) SetClustername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1449,6 +3012,15 @@ func (p *UpdateClusterParams) SetClustername(v string) {
 	p.p["clustername"] = v
 }
 
+func (p *UpdateClusterParams) ResetClustername() {
+	delete(p.p, "clustername")
+}
+
+func (p *UpdateClusterParams) GetClustername() (string, bool) {
+	value, ok := p.p["clustername"].(string)
+	return value, ok
+}
+
 func (p *UpdateClusterParams) SetClustertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1456,6 +3028,15 @@ func (p *UpdateClusterParams) SetClustertype(v string) {
 	p.p["clustertype"] = v
 }
 
+func (p *UpdateClusterParams) ResetClustertype() {
+	delete(p.p, "clustertype")
+}
+
+func (p *UpdateClusterParams) GetClustertype() (string, bool) {
+	value, ok := p.p["clustertype"].(string)
+	return value, ok
+}
+
 func (p *UpdateClusterParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1463,6 +3044,15 @@ func (p *UpdateClusterParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *UpdateClusterParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *UpdateClusterParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *UpdateClusterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1470,6 +3060,15 @@ func (p *UpdateClusterParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateClusterParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateClusterParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateClusterParams) SetManagedstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1477,6 +3076,15 @@ func (p *UpdateClusterParams) SetManagedstate(v string) {
 	p.p["managedstate"] = v
 }
 
+func (p *UpdateClusterParams) ResetManagedstate() {
+	delete(p.p, "managedstate")
+}
+
+func (p *UpdateClusterParams) GetManagedstate() (string, bool) {
+	value, ok := p.p["managedstate"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateClusterParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewUpdateClusterParams(id string) *UpdateClusterParams {
@@ -1488,7 +3096,16 @@ func (s *ClusterService) NewUpdateClusterParams(id string) *UpdateClusterParams
 
 // Updates an existing cluster
 func (s *ClusterService) UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error) {
-	resp, err := s.cs.newRequest("updateCluster", p.toURLValues())
+	return s.UpdateClusterWithContext(context.Background(), p)
+}
+
+// UpdateClusterWithContext is the same as UpdateCluster, but uses ctx to cancel the request and any async job polling
+func (s *ClusterService) UpdateClusterWithContext(ctx context.Context, p *UpdateClusterParams) (*UpdateClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateCluster", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
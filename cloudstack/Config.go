@@ -0,0 +1,255 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// The environment variables used by LoadProfile
+const (
+	EnvConfigFile = "CLOUDSTACK_CONFIG"     // The path of the config file
+	EnvProfile    = "CLOUDSTACK_PROFILE"    // The profile used when no profile name is given
+	EnvAPIURL     = "CLOUDSTACK_API_URL"    // Overrides the URL of the profile
+	EnvAPIKey     = "CLOUDSTACK_API_KEY"    // Overrides the API key of the profile
+	EnvSecretKey  = "CLOUDSTACK_SECRET_KEY" // Overrides the secret key of the profile
+)
+
+// Config contains the settings needed to create a client, which can be loaded from a
+// CloudMonkey (cmk) config file using LoadProfile or LoadConfigFile
+type Config struct {
+	Profile   string // The name of the loaded profile
+	URL       string // The URL of the API
+	APIKey    string // The API key, used together with SecretKey
+	SecretKey string // The secret key, used together with APIKey
+	Username  string // The username of a session client, used when there is no API key
+	Password  string // The password of a session client, used when there is no API key
+	Domain    string // The path of the domain of the user of a session client
+	VerifySSL bool   // Verify the certificate of the API; defaults to true
+	Async     bool   // Wait for async jobs to finish; defaults to true
+	Timeout   int64  // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds
+}
+
+// DefaultConfigFile returns the path of the CloudMonkey config file, which is ~/.cmk/config
+// unless the CLOUDSTACK_CONFIG environment variable is set
+func DefaultConfigFile() (string, error) {
+	if path := os.Getenv(EnvConfigFile); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".cmk", "config"), nil
+}
+
+// LoadProfile loads a profile from the CloudMonkey config file returned by DefaultConfigFile,
+// and applies the CLOUDSTACK_API_URL, CLOUDSTACK_API_KEY and CLOUDSTACK_SECRET_KEY environment
+// variables on top of it. If name is empty the profile set by the CLOUDSTACK_PROFILE environment
+// variable is used, or else the default profile of the config file. When no profile name is given,
+// the config file is optional so the client can also be configured using only the environment.
+func LoadProfile(name string) (*Config, error) {
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+
+	path, err := DefaultConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := LoadConfigFile(path, name)
+	if errors.Is(err, os.ErrNotExist) && name == "" {
+		cfg, err = &Config{VerifySSL: true, Async: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if v := os.Getenv(EnvAPIURL); v != "" {
+		cfg.URL = v
+	}
+	if v := os.Getenv(EnvAPIKey); v != "" {
+		cfg.APIKey = v
+	}
+	if v := os.Getenv(EnvSecretKey); v != "" {
+		cfg.SecretKey = v
+	}
+
+	return cfg, nil
+}
+
+// LoadConfigFile loads a profile from a CloudMonkey config file. If name is empty the
+// default profile of the config file is used.
+func LoadConfigFile(path string, name string) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	cfg, err := ParseConfig(f, name)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// ParseConfig parses a profile from a config in the CloudMonkey INI format. The settings outside
+// of any section (like the default profile, timeout, asyncblock and verifycert) apply to all
+// profiles, and can be overridden by a profile. If name is empty the default profile is used.
+func ParseConfig(r io.Reader, name string) (*Config, error) {
+	sections, err := parseINI(r)
+	if err != nil {
+		return nil, err
+	}
+
+	global := sections[""]
+	if name == "" {
+		name = global["profile"]
+	}
+	if name == "" {
+		return nil, errors.New("No profile given and no default profile set")
+	}
+
+	profile, ok := sections[name]
+	if !ok {
+		return nil, fmt.Errorf("Profile %q does not exist", name)
+	}
+
+	// Settings in the profile take precedence over the global settings
+	get := func(key string) string {
+		if v, ok := profile[key]; ok {
+			return v
+		}
+		return global[key]
+	}
+
+	cfg := &Config{
+		Profile:   name,
+		URL:       get("url"),
+		APIKey:    get("apikey"),
+		SecretKey: get("secretkey"),
+		Username:  get("username"),
+		Password:  get("password"),
+		Domain:    get("domain"),
+		VerifySSL: true,
+		Async:     true,
+	}
+
+	for _, key := range []string{"verifycert", "verifysslcert"} {
+		if v := get(key); v != "" {
+			if cfg.VerifySSL, err = strconv.ParseBool(v); err != nil {
+				return nil, fmt.Errorf("Invalid value for %s: %q", key, v)
+			}
+		}
+	}
+	if v := get("asyncblock"); v != "" {
+		if cfg.Async, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("Invalid value for asyncblock: %q", v)
+		}
+	}
+	if v := get("timeout"); v != "" {
+		if cfg.Timeout, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, fmt.Errorf("Invalid value for timeout: %q", v)
+		}
+	}
+
+	return cfg, nil
+}
+
+// NewClientFromConfig returns a client configured using cfg. The client authenticates using the
+// API key and secret key if they are set, and otherwise it logs in using the username and password
+// like a client created with NewSessionClient. The options are applied after the configured ones.
+func NewClientFromConfig(cfg *Config, options ...ClientOption) (*CloudStackClient, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("No API URL configured")
+	}
+
+	options = append([]ClientOption{WithAsyncTimeout(cfg.Timeout)}, options...)
+
+	switch {
+	case cfg.APIKey != "" && cfg.SecretKey != "":
+		return newClient(cfg.URL, cfg.APIKey, cfg.SecretKey, cfg.Async, cfg.VerifySSL, options...), nil
+	case cfg.Username != "" && cfg.Password != "":
+		cs, err := NewSessionClient(cfg.URL, cfg.Username, cfg.Password, cfg.Domain, cfg.VerifySSL, options...)
+		if err != nil {
+			return nil, err
+		}
+		cs.async = cfg.Async
+		return cs, nil
+	default:
+		return nil, errors.New("No API key and secret key, or username and password configured")
+	}
+}
+
+// Parses an INI file into a map of sections, where the keys outside of any section are stored
+// in the section with an empty name. Keys are lowercased, and lines starting with # or ; are
+// ignored.
+func parseINI(r io.Reader) (map[string]map[string]string, error) {
+	section := ""
+	sections := map[string]map[string]string{section: {}}
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := sections[section]; !ok {
+				sections[section] = map[string]string{}
+			}
+			continue
+		}
+
+		i := strings.IndexAny(line, "=:")
+		if i < 0 {
+			return nil, fmt.Errorf("Invalid line %d: %q", n, line)
+		}
+
+		key := strings.ToLower(strings.TrimSpace(line[:i]))
+		value := strings.TrimSpace(line[i+1:])
+		sections[section][key] = unquote(value)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return sections, nil
+}
+
+// Removes the quotes around a value, if any
+func unquote(v string) string {
+	if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+		return v[1 : len(v)-1]
+	}
+	return v
+}
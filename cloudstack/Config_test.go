@@ -0,0 +1,288 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testConfig = `
+# Global settings
+prompt = 🐵
+asyncblock = true
+timeout = 1800
+verifycert = true
+profile = local
+
+[local]
+url = http://localhost:8080/client/api
+username = admin
+password = "pass=word"
+domain = /
+
+; An API key profile that overrides the global settings
+[prod]
+url = https://cloud.example.com/client/api
+apikey = 'key'
+secretkey = secret
+verifycert = false
+asyncblock = false
+timeout = 60
+`
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		profile string
+		want    *Config
+		wantErr string
+	}{
+		{
+			name:   "default profile",
+			config: testConfig,
+			want: &Config{
+				Profile:   "local",
+				URL:       "http://localhost:8080/client/api",
+				Username:  "admin",
+				Password:  "pass=word",
+				Domain:    "/",
+				VerifySSL: true,
+				Async:     true,
+				Timeout:   1800,
+			},
+		},
+		{
+			name:    "named profile overriding the global settings",
+			config:  testConfig,
+			profile: "prod",
+			want: &Config{
+				Profile:   "prod",
+				URL:       "https://cloud.example.com/client/api",
+				APIKey:    "key",
+				SecretKey: "secret",
+				VerifySSL: false,
+				Async:     false,
+				Timeout:   60,
+			},
+		},
+		{
+			name:   "defaults",
+			config: "[p]\nURL: http://localhost\nverifysslcert = false",
+			want: &Config{
+				Profile:   "p",
+				URL:       "http://localhost",
+				VerifySSL: false,
+				Async:     true,
+			},
+			profile: "p",
+		},
+		{
+			name:    "unknown profile",
+			config:  testConfig,
+			profile: "test",
+			wantErr: `Profile "test" does not exist`,
+		},
+		{
+			name:    "no default profile",
+			config:  "[local]\nurl = http://localhost",
+			wantErr: "No profile given and no default profile set",
+		},
+		{
+			name:    "invalid line",
+			config:  "profile = local\n[local]\nurl",
+			wantErr: `Invalid line 3: "url"`,
+		},
+		{
+			name:    "invalid timeout",
+			config:  "[local]\ntimeout = never",
+			profile: "local",
+			wantErr: `Invalid value for timeout: "never"`,
+		},
+		{
+			name:    "invalid bool",
+			config:  "verifycert = maybe\n[local]",
+			profile: "local",
+			wantErr: `Invalid value for verifycert: "maybe"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := ParseConfig(strings.NewReader(tt.config), tt.profile)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(cfg, tt.want) {
+				t.Errorf("Expected %+v, got %+v", tt.want, cfg)
+			}
+		})
+	}
+}
+
+func TestLoadProfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cloudstack")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "config")
+	if err := ioutil.WriteFile(path, []byte(testConfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		profile string
+		env     map[string]string
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "default profile",
+			env:  map[string]string{EnvConfigFile: path},
+			want: &Config{
+				Profile:   "local",
+				URL:       "http://localhost:8080/client/api",
+				Username:  "admin",
+				Password:  "pass=word",
+				Domain:    "/",
+				VerifySSL: true,
+				Async:     true,
+				Timeout:   1800,
+			},
+		},
+		{
+			name: "profile from the environment with overrides",
+			env: map[string]string{
+				EnvConfigFile: path,
+				EnvProfile:    "prod",
+				EnvAPIURL:     "https://other.example.com/client/api",
+				EnvSecretKey:  "other",
+			},
+			want: &Config{
+				Profile:   "prod",
+				URL:       "https://other.example.com/client/api",
+				APIKey:    "key",
+				SecretKey: "other",
+				Timeout:   60,
+			},
+		},
+		{
+			name:    "profile argument takes precedence over the environment",
+			profile: "local",
+			env:     map[string]string{EnvConfigFile: path, EnvProfile: "prod"},
+			want: &Config{
+				Profile:   "local",
+				URL:       "http://localhost:8080/client/api",
+				Username:  "admin",
+				Password:  "pass=word",
+				Domain:    "/",
+				VerifySSL: true,
+				Async:     true,
+				Timeout:   1800,
+			},
+		},
+		{
+			name: "environment only",
+			env: map[string]string{
+				EnvConfigFile: filepath.Join(dir, "missing"),
+				EnvAPIURL:     "http://localhost:8080/client/api",
+				EnvAPIKey:     "key",
+				EnvSecretKey:  "secret",
+			},
+			want: &Config{
+				URL:       "http://localhost:8080/client/api",
+				APIKey:    "key",
+				SecretKey: "secret",
+				VerifySSL: true,
+				Async:     true,
+			},
+		},
+		{
+			name:    "missing config file with a profile",
+			profile: "local",
+			env:     map[string]string{EnvConfigFile: filepath.Join(dir, "missing")},
+			wantErr: os.ErrNotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer setenv(t, tt.env)()
+
+			cfg, err := LoadProfile(tt.profile)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(cfg, tt.want) {
+				t.Errorf("Expected %+v, got %+v", tt.want, cfg)
+			}
+		})
+	}
+}
+
+// Sets the environment variables used by LoadProfile to the given values, unsetting the
+// others, and returns a func that restores the original environment
+func setenv(t *testing.T, env map[string]string) func() {
+	keys := []string{EnvConfigFile, EnvProfile, EnvAPIURL, EnvAPIKey, EnvSecretKey}
+
+	original := make(map[string]string)
+	for _, key := range keys {
+		if v, ok := os.LookupEnv(key); ok {
+			original[key] = v
+		}
+		os.Unsetenv(key)
+	}
+	for key, v := range env {
+		if err := os.Setenv(key, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return func() {
+		for _, key := range keys {
+			if v, ok := original[key]; ok {
+				os.Setenv(key, v)
+			} else {
+				os.Unsetenv(key)
+			}
+		}
+	}
+}
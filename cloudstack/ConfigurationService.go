@@ -20,16 +20,126 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// ConfigurationServiceIface is the interface implemented by ConfigurationService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type ConfigurationServiceIface interface {
+	NewCloudianIsEnabledParams() *CloudianIsEnabledParams
+	CloudianIsEnabled(p *CloudianIsEnabledParams) (*CloudianIsEnabledResponse, error)
+	CloudianIsEnabledWithContext(ctx context.Context, p *CloudianIsEnabledParams) (*CloudianIsEnabledResponse, error)
+	NewListCapabilitiesParams() *ListCapabilitiesParams
+	ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error)
+	ListCapabilitiesWithContext(ctx context.Context, p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error)
+	NewListConfigurationsParams() *ListConfigurationsParams
+	ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error)
+	ListConfigurationsWithContext(ctx context.Context, p *ListConfigurationsParams) (*ListConfigurationsResponse, error)
+	NewListConfigurationsPager(p *ListConfigurationsParams) *ListConfigurationsPager
+	ListConfigurationsAll(p *ListConfigurationsParams) (*ListConfigurationsResponse, error)
+	ListConfigurationsAllWithContext(ctx context.Context, p *ListConfigurationsParams) (*ListConfigurationsResponse, error)
+	NewListDeploymentPlannersParams() *ListDeploymentPlannersParams
+	ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error)
+	ListDeploymentPlannersWithContext(ctx context.Context, p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error)
+	NewListDeploymentPlannersPager(p *ListDeploymentPlannersParams) *ListDeploymentPlannersPager
+	ListDeploymentPlannersAll(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error)
+	ListDeploymentPlannersAllWithContext(ctx context.Context, p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error)
+	NewUpdateConfigurationParams(name string) *UpdateConfigurationParams
+	UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error)
+	UpdateConfigurationWithContext(ctx context.Context, p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error)
+}
+
+type CloudianIsEnabledParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CloudianIsEnabledParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CloudianIsEnabledParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CloudianIsEnabledParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CloudianIsEnabledParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k := range m {
+		return fmt.Errorf("Unknown param %s for cloudianIsEnabled", k)
+	}
+	return nil
+}
+
+// You should always use this function to get a new CloudianIsEnabledParams instance,
+// as then you are sure you have configured all required params
+func (s *ConfigurationService) NewCloudianIsEnabledParams() *CloudianIsEnabledParams {
+	p := &CloudianIsEnabledParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Checks if the Cloudian Connector is enabled
+func (s *ConfigurationService) CloudianIsEnabled(p *CloudianIsEnabledParams) (*CloudianIsEnabledResponse, error) {
+	return s.CloudianIsEnabledWithContext(context.Background(), p)
+}
+
+// CloudianIsEnabledWithContext is the same as CloudianIsEnabled, but uses ctx to cancel the request and any async job polling
+func (s *ConfigurationService) CloudianIsEnabledWithContext(ctx context.Context, p *CloudianIsEnabledParams) (*CloudianIsEnabledResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "cloudianIsEnabled", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CloudianIsEnabledResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type CloudianIsEnabledResponse struct {
+	Enabled   bool   `json:"enabled"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Url       string `json:"url"`
+}
+
 type ListCapabilitiesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListCapabilitiesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListCapabilitiesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -37,6 +147,34 @@ func (p *ListCapabilitiesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListCapabilitiesParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListCapabilitiesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListCapabilitiesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k := range m {
+		return fmt.Errorf("Unknown param %s for listCapabilities", k)
+	}
+	return nil
+}
+
 // You should always use this function to get a new ListCapabilitiesParams instance,
 // as then you are sure you have configured all required params
 func (s *ConfigurationService) NewListCapabilitiesParams() *ListCapabilitiesParams {
@@ -47,7 +185,16 @@ func (s *ConfigurationService) NewListCapabilitiesParams() *ListCapabilitiesPara
 
 // Lists capabilities
 func (s *ConfigurationService) ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("listCapabilities", p.toURLValues())
+	return s.ListCapabilitiesWithContext(context.Background(), p)
+}
+
+// ListCapabilitiesWithContext is the same as ListCapabilities, but uses ctx to cancel the request and any async job polling
+func (s *ConfigurationService) ListCapabilitiesWithContext(ctx context.Context, p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listCapabilities", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +239,8 @@ type ListConfigurationsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListConfigurationsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListConfigurationsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -135,6 +283,54 @@ func (p *ListConfigurationsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListConfigurationsParams) Validate() error {
+	v := newParamValidator("listConfigurations", p.p)
+	v.ids("accountid", "clusterid", "domainid", "imagestoreuuid", "storageid", "zoneid")
+	v.maxLength("category", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListConfigurationsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListConfigurationsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "accountid", "category", "clusterid", "domainid", "imagestoreuuid", "keyword", "name", "storageid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listConfigurations", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listConfigurations: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListConfigurationsParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -142,6 +338,15 @@ func (p *ListConfigurationsParams) SetAccountid(v string) {
 	p.p["accountid"] = v
 }
 
+func (p *ListConfigurationsParams) ResetAccountid() {
+	delete(p.p, "accountid")
+}
+
+func (p *ListConfigurationsParams) GetAccountid() (string, bool) {
+	value, ok := p.p["accountid"].(string)
+	return value, ok
+}
+
 func (p *ListConfigurationsParams) SetCategory(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -149,6 +354,15 @@ func (p *ListConfigurationsParams) SetCategory(v string) {
 	p.p["category"] = v
 }
 
+func (p *ListConfigurationsParams) ResetCategory() {
+	delete(p.p, "category")
+}
+
+func (p *ListConfigurationsParams) GetCategory() (string, bool) {
+	value, ok := p.p["category"].(string)
+	return value, ok
+}
+
 func (p *ListConfigurationsParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -156,6 +370,15 @@ func (p *ListConfigurationsParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *ListConfigurationsParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ListConfigurationsParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *ListConfigurationsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -163,6 +386,15 @@ func (p *ListConfigurationsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListConfigurationsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListConfigurationsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListConfigurationsParams) SetImagestoreuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -170,6 +402,15 @@ func (p *ListConfigurationsParams) SetImagestoreuuid(v string) {
 	p.p["imagestoreuuid"] = v
 }
 
+func (p *ListConfigurationsParams) ResetImagestoreuuid() {
+	delete(p.p, "imagestoreuuid")
+}
+
+func (p *ListConfigurationsParams) GetImagestoreuuid() (string, bool) {
+	value, ok := p.p["imagestoreuuid"].(string)
+	return value, ok
+}
+
 func (p *ListConfigurationsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -177,6 +418,15 @@ func (p *ListConfigurationsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListConfigurationsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListConfigurationsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListConfigurationsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -184,6 +434,15 @@ func (p *ListConfigurationsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListConfigurationsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListConfigurationsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListConfigurationsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -191,6 +450,15 @@ func (p *ListConfigurationsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListConfigurationsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListConfigurationsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListConfigurationsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -198,6 +466,15 @@ func (p *ListConfigurationsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListConfigurationsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListConfigurationsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListConfigurationsParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -205,6 +482,15 @@ func (p *ListConfigurationsParams) SetStorageid(v string) {
 	p.p["storageid"] = v
 }
 
+func (p *ListConfigurationsParams) ResetStorageid() {
+	delete(p.p, "storageid")
+}
+
+func (p *ListConfigurationsParams) GetStorageid() (string, bool) {
+	value, ok := p.p["storageid"].(string)
+	return value, ok
+}
+
 func (p *ListConfigurationsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -212,6 +498,15 @@ func (p *ListConfigurationsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListConfigurationsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListConfigurationsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListConfigurationsParams instance,
 // as then you are sure you have configured all required params
 func (s *ConfigurationService) NewListConfigurationsParams() *ListConfigurationsParams {
@@ -222,7 +517,16 @@ func (s *ConfigurationService) NewListConfigurationsParams() *ListConfigurations
 
 // Lists all configurations.
 func (s *ConfigurationService) ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
-	resp, err := s.cs.newRequest("listConfigurations", p.toURLValues())
+	return s.ListConfigurationsWithContext(context.Background(), p)
+}
+
+// ListConfigurationsWithContext is the same as ListConfigurations, but uses ctx to cancel the request and any async job polling
+func (s *ConfigurationService) ListConfigurationsWithContext(ctx context.Context, p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listConfigurations", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +539,92 @@ func (s *ConfigurationService) ListConfigurations(p *ListConfigurationsParams) (
 	return &r, nil
 }
 
+// ListConfigurationsPager iterates over all pages of a ListConfigurations call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListConfigurationsPager struct {
+	pager
+
+	s *ConfigurationService
+	p *ListConfigurationsParams
+	r *ListConfigurationsResponse
+}
+
+// NewListConfigurationsPager returns a pager for all pages of a ListConfigurations call with the given params
+func (s *ConfigurationService) NewListConfigurationsPager(p *ListConfigurationsParams) *ListConfigurationsPager {
+	return &ListConfigurationsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListConfigurationsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListConfigurationsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListConfigurationsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListConfigurationsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Configurations), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListConfigurationsPager) Page() *ListConfigurationsResponse {
+	return pg.r
+}
+
+// ListConfigurationsAll fetches all pages of a ListConfigurations call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ConfigurationService) ListConfigurationsAll(p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
+	return s.ListConfigurationsAllWithContext(context.Background(), p)
+}
+
+// ListConfigurationsAllWithContext is the same as ListConfigurationsAll, but uses ctx to cancel the requests
+func (s *ConfigurationService) ListConfigurationsAllWithContext(ctx context.Context, p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Configuration)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListConfigurationsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListConfigurationsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Configurations
+		mu.Unlock()
+
+		return len(l.Configurations), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListConfigurationsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Configurations = append(r.Configurations, pages[page]...)
+	}
+	r.Count = len(r.Configurations)
+
+	return r, nil
+}
+
 type ListConfigurationsResponse struct {
 	Count          int              `json:"count"`
 	Configurations []*Configuration `json:"configuration"`
@@ -256,7 +646,8 @@ type ListDeploymentPlannersParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListDeploymentPlannersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListDeploymentPlannersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -275,6 +666,51 @@ func (p *ListDeploymentPlannersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListDeploymentPlannersParams) Validate() error {
+	v := newParamValidator("listDeploymentPlanners", p.p)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListDeploymentPlannersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListDeploymentPlannersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listDeploymentPlanners", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listDeploymentPlanners: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListDeploymentPlannersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -282,6 +718,15 @@ func (p *ListDeploymentPlannersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListDeploymentPlannersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListDeploymentPlannersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListDeploymentPlannersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -289,6 +734,15 @@ func (p *ListDeploymentPlannersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListDeploymentPlannersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListDeploymentPlannersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListDeploymentPlannersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -296,6 +750,15 @@ func (p *ListDeploymentPlannersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListDeploymentPlannersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListDeploymentPlannersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListDeploymentPlannersParams instance,
 // as then you are sure you have configured all required params
 func (s *ConfigurationService) NewListDeploymentPlannersParams() *ListDeploymentPlannersParams {
@@ -306,7 +769,16 @@ func (s *ConfigurationService) NewListDeploymentPlannersParams() *ListDeployment
 
 // Lists all DeploymentPlanners available.
 func (s *ConfigurationService) ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
-	resp, err := s.cs.newRequest("listDeploymentPlanners", p.toURLValues())
+	return s.ListDeploymentPlannersWithContext(context.Background(), p)
+}
+
+// ListDeploymentPlannersWithContext is the same as ListDeploymentPlanners, but uses ctx to cancel the request and any async job polling
+func (s *ConfigurationService) ListDeploymentPlannersWithContext(ctx context.Context, p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listDeploymentPlanners", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -319,6 +791,92 @@ func (s *ConfigurationService) ListDeploymentPlanners(p *ListDeploymentPlannersP
 	return &r, nil
 }
 
+// ListDeploymentPlannersPager iterates over all pages of a ListDeploymentPlanners call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListDeploymentPlannersPager struct {
+	pager
+
+	s *ConfigurationService
+	p *ListDeploymentPlannersParams
+	r *ListDeploymentPlannersResponse
+}
+
+// NewListDeploymentPlannersPager returns a pager for all pages of a ListDeploymentPlanners call with the given params
+func (s *ConfigurationService) NewListDeploymentPlannersPager(p *ListDeploymentPlannersParams) *ListDeploymentPlannersPager {
+	return &ListDeploymentPlannersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListDeploymentPlannersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListDeploymentPlannersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListDeploymentPlannersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListDeploymentPlannersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.DeploymentPlanners), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListDeploymentPlannersPager) Page() *ListDeploymentPlannersResponse {
+	return pg.r
+}
+
+// ListDeploymentPlannersAll fetches all pages of a ListDeploymentPlanners call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ConfigurationService) ListDeploymentPlannersAll(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
+	return s.ListDeploymentPlannersAllWithContext(context.Background(), p)
+}
+
+// ListDeploymentPlannersAllWithContext is the same as ListDeploymentPlannersAll, but uses ctx to cancel the requests
+func (s *ConfigurationService) ListDeploymentPlannersAllWithContext(ctx context.Context, p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*DeploymentPlanner)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListDeploymentPlannersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListDeploymentPlannersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.DeploymentPlanners
+		mu.Unlock()
+
+		return len(l.DeploymentPlanners), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListDeploymentPlannersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.DeploymentPlanners = append(r.DeploymentPlanners, pages[page]...)
+	}
+	r.Count = len(r.DeploymentPlanners)
+
+	return r, nil
+}
+
 type ListDeploymentPlannersResponse struct {
 	Count              int                  `json:"count"`
 	DeploymentPlanners []*DeploymentPlanner `json:"deploymentplanner"`
@@ -334,7 +892,8 @@ type UpdateConfigurationParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateConfigurationParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateConfigurationParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -366,6 +925,50 @@ func (p *UpdateConfigurationParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateConfigurationParams) Validate() error {
+	v := newParamValidator("updateConfiguration", p.p)
+	v.required("name")
+	v.ids("accountid", "clusterid", "domainid", "imagestoreuuid", "storageid", "zoneid")
+	v.maxLength("name", 255)
+	v.maxLength("value", 4095)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateConfigurationParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateConfigurationParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "accountid", "clusterid", "domainid", "imagestoreuuid", "name", "storageid", "value", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateConfiguration", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateConfiguration: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateConfigurationParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -373,6 +976,15 @@ func (p *UpdateConfigurationParams) SetAccountid(v string) {
 	p.p["accountid"] = v
 }
 
+func (p *UpdateConfigurationParams) ResetAccountid() {
+	delete(p.p, "accountid")
+}
+
+func (p *UpdateConfigurationParams) GetAccountid() (string, bool) {
+	value, ok := p.p["accountid"].(string)
+	return value, ok
+}
+
 func (p *UpdateConfigurationParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -380,6 +992,15 @@ func (p *UpdateConfigurationParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *UpdateConfigurationParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *UpdateConfigurationParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *UpdateConfigurationParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -387,6 +1008,15 @@ func (p *UpdateConfigurationParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *UpdateConfigurationParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *UpdateConfigurationParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *UpdateConfigurationParams) SetImagestoreuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -394,6 +1024,15 @@ func (p *UpdateConfigurationParams) SetImagestoreuuid(v string) {
 	p.p["imagestoreuuid"] = v
 }
 
+func (p *UpdateConfigurationParams) ResetImagestoreuuid() {
+	delete(p.p, "imagestoreuuid")
+}
+
+func (p *UpdateConfigurationParams) GetImagestoreuuid() (string, bool) {
+	value, ok := p.p["imagestoreuuid"].(string)
+	return value, ok
+}
+
 func (p *UpdateConfigurationParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -401,6 +1040,15 @@ func (p *UpdateConfigurationParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateConfigurationParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateConfigurationParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateConfigurationParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -408,6 +1056,15 @@ func (p *UpdateConfigurationParams) SetStorageid(v string) {
 	p.p["storageid"] = v
 }
 
+func (p *UpdateConfigurationParams) ResetStorageid() {
+	delete(p.p, "storageid")
+}
+
+func (p *UpdateConfigurationParams) GetStorageid() (string, bool) {
+	value, ok := p.p["storageid"].(string)
+	return value, ok
+}
+
 func (p *UpdateConfigurationParams) SetValue(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -415,6 +1072,15 @@ func (p *UpdateConfigurationParams) SetValue(v string) {
 	p.p["value"] = v
 }
 
+func (p *UpdateConfigurationParams) ResetValue() {
+	delete(p.p, "value")
+}
+
+func (p *UpdateConfigurationParams) GetValue() (string, bool) {
+	value, ok := p.p["value"].(string)
+	return value, ok
+}
+
 func (p *UpdateConfigurationParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -422,6 +1088,15 @@ func (p *UpdateConfigurationParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *UpdateConfigurationParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *UpdateConfigurationParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateConfigurationParams instance,
 // as then you are sure you have configured all required params
 func (s *ConfigurationService) NewUpdateConfigurationParams(name string) *UpdateConfigurationParams {
@@ -433,7 +1108,16 @@ func (s *ConfigurationService) NewUpdateConfigurationParams(name string) *Update
 
 // Updates a configuration.
 func (s *ConfigurationService) UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("updateConfiguration", p.toURLValues())
+	return s.UpdateConfigurationWithContext(context.Background(), p)
+}
+
+// UpdateConfigurationWithContext is the same as UpdateConfiguration, but uses ctx to cancel the request and any async job polling
+func (s *ConfigurationService) UpdateConfigurationWithContext(ctx context.Context, p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateConfiguration", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
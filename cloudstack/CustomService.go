@@ -20,6 +20,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -27,11 +28,19 @@ import (
 	"strings"
 )
 
+// CustomServiceIface is the interface implemented by CustomService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type CustomServiceIface interface {
+	CustomRequest(api string, p *CustomServiceParams, result interface{}) error
+	CustomRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error
+}
+
 type CustomServiceParams struct {
 	p map[string]interface{}
 }
 
-func (p *CustomServiceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CustomServiceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -70,7 +79,12 @@ func (p *CustomServiceParams) SetParam(param string, v interface{}) {
 }
 
 func (s *CustomService) CustomRequest(api string, p *CustomServiceParams, result interface{}) error {
-	resp, err := s.cs.newRequest(api, p.toURLValues())
+	return s.CustomRequestWithContext(context.Background(), api, p, result)
+}
+
+// CustomRequestWithContext is the same as CustomRequest, but uses ctx to cancel the request
+func (s *CustomService) CustomRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error {
+	resp, err := s.cs.newRequestWithContext(ctx, api, p.ToURLValues())
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,466 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// DiagnosticsServiceIface is the interface implemented by DiagnosticsService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type DiagnosticsServiceIface interface {
+	NewGetDiagnosticsDataParams(targetid string) *GetDiagnosticsDataParams
+	GetDiagnosticsData(p *GetDiagnosticsDataParams) (*GetDiagnosticsDataResponse, error)
+	GetDiagnosticsDataWithContext(ctx context.Context, p *GetDiagnosticsDataParams) (*GetDiagnosticsDataResponse, error)
+	GetDiagnosticsDataAsync(p *GetDiagnosticsDataParams) (*Job, error)
+	GetDiagnosticsDataAsyncWithContext(ctx context.Context, p *GetDiagnosticsDataParams) (*Job, error)
+	NewRunDiagnosticsParams(ipaddress string, targetid string, diagnosticsType string) *RunDiagnosticsParams
+	RunDiagnostics(p *RunDiagnosticsParams) (*RunDiagnosticsResponse, error)
+	RunDiagnosticsWithContext(ctx context.Context, p *RunDiagnosticsParams) (*RunDiagnosticsResponse, error)
+	RunDiagnosticsAsync(p *RunDiagnosticsParams) (*Job, error)
+	RunDiagnosticsAsyncWithContext(ctx context.Context, p *RunDiagnosticsParams) (*Job, error)
+}
+
+type GetDiagnosticsDataParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetDiagnosticsDataParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["files"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("files", vv)
+	}
+	if v, found := p.p["targetid"]; found {
+		u.Set("targetid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetDiagnosticsDataParams) Validate() error {
+	v := newParamValidator("getDiagnosticsData", p.p)
+	v.required("targetid")
+	v.ids("targetid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetDiagnosticsDataParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetDiagnosticsDataParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "files":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "targetid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getDiagnosticsData", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getDiagnosticsData: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *GetDiagnosticsDataParams) SetFiles(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["files"] = v
+}
+
+func (p *GetDiagnosticsDataParams) ResetFiles() {
+	delete(p.p, "files")
+}
+
+func (p *GetDiagnosticsDataParams) GetFiles() ([]string, bool) {
+	value, ok := p.p["files"].([]string)
+	return value, ok
+}
+
+func (p *GetDiagnosticsDataParams) SetTargetid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["targetid"] = v
+}
+
+func (p *GetDiagnosticsDataParams) ResetTargetid() {
+	delete(p.p, "targetid")
+}
+
+func (p *GetDiagnosticsDataParams) GetTargetid() (string, bool) {
+	value, ok := p.p["targetid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new GetDiagnosticsDataParams instance,
+// as then you are sure you have configured all required params
+func (s *DiagnosticsService) NewGetDiagnosticsDataParams(targetid string) *GetDiagnosticsDataParams {
+	p := &GetDiagnosticsDataParams{}
+	p.p = make(map[string]interface{})
+	p.p["targetid"] = targetid
+	return p
+}
+
+// Get diagnostics and files from system VMs
+func (s *DiagnosticsService) GetDiagnosticsData(p *GetDiagnosticsDataParams) (*GetDiagnosticsDataResponse, error) {
+	return s.GetDiagnosticsDataWithContext(context.Background(), p)
+}
+
+// GetDiagnosticsDataWithContext is the same as GetDiagnosticsData, but uses ctx to cancel the request and any async job polling
+func (s *DiagnosticsService) GetDiagnosticsDataWithContext(ctx context.Context, p *GetDiagnosticsDataParams) (*GetDiagnosticsDataResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getDiagnosticsData", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r GetDiagnosticsDataResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// GetDiagnosticsDataAsync starts the async job for GetDiagnosticsData and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a GetDiagnosticsDataResponse using Job.Result
+func (s *DiagnosticsService) GetDiagnosticsDataAsync(p *GetDiagnosticsDataParams) (*Job, error) {
+	return s.GetDiagnosticsDataAsyncWithContext(context.Background(), p)
+}
+
+// GetDiagnosticsDataAsyncWithContext is the same as GetDiagnosticsDataAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *DiagnosticsService) GetDiagnosticsDataAsyncWithContext(ctx context.Context, p *GetDiagnosticsDataParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getDiagnosticsData", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r GetDiagnosticsDataResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type GetDiagnosticsDataResponse struct {
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Url       string `json:"url"`
+}
+
+type RunDiagnosticsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RunDiagnosticsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["ipaddress"]; found {
+		u.Set("ipaddress", v.(string))
+	}
+	if v, found := p.p["params"]; found {
+		u.Set("params", v.(string))
+	}
+	if v, found := p.p["targetid"]; found {
+		u.Set("targetid", v.(string))
+	}
+	if v, found := p.p["type"]; found {
+		u.Set("type", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RunDiagnosticsParams) Validate() error {
+	v := newParamValidator("runDiagnostics", p.p)
+	v.required("ipaddress", "targetid", "type")
+	v.ids("targetid")
+	v.maxLength("ipaddress", 255)
+	v.maxLength("params", 255)
+	v.maxLength("type", 255)
+	v.oneOf("type", "ping", "traceroute", "arping")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RunDiagnosticsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RunDiagnosticsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "ipaddress", "params", "targetid", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for runDiagnostics", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of runDiagnostics: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *RunDiagnosticsParams) SetIpaddress(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ipaddress"] = v
+}
+
+func (p *RunDiagnosticsParams) ResetIpaddress() {
+	delete(p.p, "ipaddress")
+}
+
+func (p *RunDiagnosticsParams) GetIpaddress() (string, bool) {
+	value, ok := p.p["ipaddress"].(string)
+	return value, ok
+}
+
+func (p *RunDiagnosticsParams) SetParams(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["params"] = v
+}
+
+func (p *RunDiagnosticsParams) ResetParams() {
+	delete(p.p, "params")
+}
+
+func (p *RunDiagnosticsParams) GetParams() (string, bool) {
+	value, ok := p.p["params"].(string)
+	return value, ok
+}
+
+func (p *RunDiagnosticsParams) SetTargetid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["targetid"] = v
+}
+
+func (p *RunDiagnosticsParams) ResetTargetid() {
+	delete(p.p, "targetid")
+}
+
+func (p *RunDiagnosticsParams) GetTargetid() (string, bool) {
+	value, ok := p.p["targetid"].(string)
+	return value, ok
+}
+
+func (p *RunDiagnosticsParams) SetType(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["type"] = v
+}
+
+func (p *RunDiagnosticsParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *RunDiagnosticsParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new RunDiagnosticsParams instance,
+// as then you are sure you have configured all required params
+func (s *DiagnosticsService) NewRunDiagnosticsParams(ipaddress string, targetid string, diagnosticsType string) *RunDiagnosticsParams {
+	p := &RunDiagnosticsParams{}
+	p.p = make(map[string]interface{})
+	p.p["ipaddress"] = ipaddress
+	p.p["targetid"] = targetid
+	p.p["type"] = diagnosticsType
+	return p
+}
+
+// Execute network-utility command (ping/arping/tracert) on system VMs remotely
+func (s *DiagnosticsService) RunDiagnostics(p *RunDiagnosticsParams) (*RunDiagnosticsResponse, error) {
+	return s.RunDiagnosticsWithContext(context.Background(), p)
+}
+
+// RunDiagnosticsWithContext is the same as RunDiagnostics, but uses ctx to cancel the request and any async job polling
+func (s *DiagnosticsService) RunDiagnosticsWithContext(ctx context.Context, p *RunDiagnosticsParams) (*RunDiagnosticsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "runDiagnostics", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RunDiagnosticsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// RunDiagnosticsAsync starts the async job for RunDiagnostics and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RunDiagnosticsResponse using Job.Result
+func (s *DiagnosticsService) RunDiagnosticsAsync(p *RunDiagnosticsParams) (*Job, error) {
+	return s.RunDiagnosticsAsyncWithContext(context.Background(), p)
+}
+
+// RunDiagnosticsAsyncWithContext is the same as RunDiagnosticsAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *DiagnosticsService) RunDiagnosticsAsyncWithContext(ctx context.Context, p *RunDiagnosticsParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "runDiagnostics", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RunDiagnosticsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type RunDiagnosticsResponse struct {
+	Exitcode  string `json:"exitcode"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Stderr    string `json:"stderr"`
+	Stdout    string `json:"stdout"`
+}
@@ -20,18 +20,47 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// DiskOfferingServiceIface is the interface implemented by DiskOfferingService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type DiskOfferingServiceIface interface {
+	NewCreateDiskOfferingParams(displaytext string, name string) *CreateDiskOfferingParams
+	CreateDiskOffering(p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error)
+	CreateDiskOfferingWithContext(ctx context.Context, p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error)
+	NewDeleteDiskOfferingParams(id string) *DeleteDiskOfferingParams
+	DeleteDiskOffering(p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error)
+	DeleteDiskOfferingWithContext(ctx context.Context, p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error)
+	NewListDiskOfferingsParams() *ListDiskOfferingsParams
+	GetDiskOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetDiskOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetDiskOfferingByName(name string, opts ...OptionFunc) (*DiskOffering, int, error)
+	GetDiskOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*DiskOffering, int, error)
+	GetDiskOfferingByID(id string, opts ...OptionFunc) (*DiskOffering, int, error)
+	GetDiskOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DiskOffering, int, error)
+	ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error)
+	ListDiskOfferingsWithContext(ctx context.Context, p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error)
+	NewListDiskOfferingsPager(p *ListDiskOfferingsParams) *ListDiskOfferingsPager
+	ListDiskOfferingsAll(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error)
+	ListDiskOfferingsAllWithContext(ctx context.Context, p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error)
+	NewUpdateDiskOfferingParams(id string) *UpdateDiskOfferingParams
+	UpdateDiskOffering(p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error)
+	UpdateDiskOfferingWithContext(ctx context.Context, p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error)
+}
+
 type CreateDiskOfferingParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateDiskOfferingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateDiskOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -144,6 +173,72 @@ func (p *CreateDiskOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateDiskOfferingParams) Validate() error {
+	v := newParamValidator("createDiskOffering", p.p)
+	v.required("displaytext", "name")
+	v.ids("storagepolicy")
+	v.maxLength("cachemode", 255)
+	v.maxLength("displaytext", 4096)
+	v.maxLength("name", 255)
+	v.maxLength("provisioningtype", 255)
+	v.oneOf("provisioningtype", "thin", "sparse", "fat")
+	v.maxLength("storagetype", 255)
+	v.oneOf("storagetype", "local", "shared")
+	v.maxLength("tags", 4096)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateDiskOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateDiskOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "bytesreadrate", "bytesreadratemax", "bytesreadratemaxlength", "byteswriterate", "byteswriteratemax", "byteswriteratemaxlength", "disksize", "iopsreadrate", "iopsreadratemax", "iopsreadratemaxlength", "iopswriterate", "iopswriteratemax", "iopswriteratemaxlength", "maxiops", "miniops":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "cachemode", "displaytext", "name", "provisioningtype", "storagepolicy", "storagetype", "tags":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "customized", "customizediops", "displayoffering":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "domainid", "zoneid":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "hypervisorsnapshotreserve":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createDiskOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createDiskOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateDiskOfferingParams) SetBytesreadrate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -151,6 +246,15 @@ func (p *CreateDiskOfferingParams) SetBytesreadrate(v int64) {
 	p.p["bytesreadrate"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetBytesreadrate() {
+	delete(p.p, "bytesreadrate")
+}
+
+func (p *CreateDiskOfferingParams) GetBytesreadrate() (int64, bool) {
+	value, ok := p.p["bytesreadrate"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetBytesreadratemax(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -158,6 +262,15 @@ func (p *CreateDiskOfferingParams) SetBytesreadratemax(v int64) {
 	p.p["bytesreadratemax"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetBytesreadratemax() {
+	delete(p.p, "bytesreadratemax")
+}
+
+func (p *CreateDiskOfferingParams) GetBytesreadratemax() (int64, bool) {
+	value, ok := p.p["bytesreadratemax"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetBytesreadratemaxlength(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -165,6 +278,15 @@ func (p *CreateDiskOfferingParams) SetBytesreadratemaxlength(v int64) {
 	p.p["bytesreadratemaxlength"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetBytesreadratemaxlength() {
+	delete(p.p, "bytesreadratemaxlength")
+}
+
+func (p *CreateDiskOfferingParams) GetBytesreadratemaxlength() (int64, bool) {
+	value, ok := p.p["bytesreadratemaxlength"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetByteswriterate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -172,6 +294,15 @@ func (p *CreateDiskOfferingParams) SetByteswriterate(v int64) {
 	p.p["byteswriterate"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetByteswriterate() {
+	delete(p.p, "byteswriterate")
+}
+
+func (p *CreateDiskOfferingParams) GetByteswriterate() (int64, bool) {
+	value, ok := p.p["byteswriterate"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetByteswriteratemax(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -179,6 +310,15 @@ func (p *CreateDiskOfferingParams) SetByteswriteratemax(v int64) {
 	p.p["byteswriteratemax"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetByteswriteratemax() {
+	delete(p.p, "byteswriteratemax")
+}
+
+func (p *CreateDiskOfferingParams) GetByteswriteratemax() (int64, bool) {
+	value, ok := p.p["byteswriteratemax"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetByteswriteratemaxlength(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -186,6 +326,15 @@ func (p *CreateDiskOfferingParams) SetByteswriteratemaxlength(v int64) {
 	p.p["byteswriteratemaxlength"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetByteswriteratemaxlength() {
+	delete(p.p, "byteswriteratemaxlength")
+}
+
+func (p *CreateDiskOfferingParams) GetByteswriteratemaxlength() (int64, bool) {
+	value, ok := p.p["byteswriteratemaxlength"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetCachemode(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,6 +342,15 @@ func (p *CreateDiskOfferingParams) SetCachemode(v string) {
 	p.p["cachemode"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetCachemode() {
+	delete(p.p, "cachemode")
+}
+
+func (p *CreateDiskOfferingParams) GetCachemode() (string, bool) {
+	value, ok := p.p["cachemode"].(string)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetCustomized(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -200,6 +358,15 @@ func (p *CreateDiskOfferingParams) SetCustomized(v bool) {
 	p.p["customized"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetCustomized() {
+	delete(p.p, "customized")
+}
+
+func (p *CreateDiskOfferingParams) GetCustomized() (bool, bool) {
+	value, ok := p.p["customized"].(bool)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetCustomizediops(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -207,6 +374,15 @@ func (p *CreateDiskOfferingParams) SetCustomizediops(v bool) {
 	p.p["customizediops"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetCustomizediops() {
+	delete(p.p, "customizediops")
+}
+
+func (p *CreateDiskOfferingParams) GetCustomizediops() (bool, bool) {
+	value, ok := p.p["customizediops"].(bool)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetDisksize(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -214,6 +390,15 @@ func (p *CreateDiskOfferingParams) SetDisksize(v int64) {
 	p.p["disksize"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetDisksize() {
+	delete(p.p, "disksize")
+}
+
+func (p *CreateDiskOfferingParams) GetDisksize() (int64, bool) {
+	value, ok := p.p["disksize"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetDisplayoffering(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -221,6 +406,15 @@ func (p *CreateDiskOfferingParams) SetDisplayoffering(v bool) {
 	p.p["displayoffering"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetDisplayoffering() {
+	delete(p.p, "displayoffering")
+}
+
+func (p *CreateDiskOfferingParams) GetDisplayoffering() (bool, bool) {
+	value, ok := p.p["displayoffering"].(bool)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -228,6 +422,15 @@ func (p *CreateDiskOfferingParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *CreateDiskOfferingParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetDomainid(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -235,6 +438,15 @@ func (p *CreateDiskOfferingParams) SetDomainid(v []string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateDiskOfferingParams) GetDomainid() ([]string, bool) {
+	value, ok := p.p["domainid"].([]string)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetHypervisorsnapshotreserve(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -242,6 +454,15 @@ func (p *CreateDiskOfferingParams) SetHypervisorsnapshotreserve(v int) {
 	p.p["hypervisorsnapshotreserve"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetHypervisorsnapshotreserve() {
+	delete(p.p, "hypervisorsnapshotreserve")
+}
+
+func (p *CreateDiskOfferingParams) GetHypervisorsnapshotreserve() (int, bool) {
+	value, ok := p.p["hypervisorsnapshotreserve"].(int)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetIopsreadrate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -249,6 +470,15 @@ func (p *CreateDiskOfferingParams) SetIopsreadrate(v int64) {
 	p.p["iopsreadrate"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetIopsreadrate() {
+	delete(p.p, "iopsreadrate")
+}
+
+func (p *CreateDiskOfferingParams) GetIopsreadrate() (int64, bool) {
+	value, ok := p.p["iopsreadrate"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetIopsreadratemax(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -256,6 +486,15 @@ func (p *CreateDiskOfferingParams) SetIopsreadratemax(v int64) {
 	p.p["iopsreadratemax"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetIopsreadratemax() {
+	delete(p.p, "iopsreadratemax")
+}
+
+func (p *CreateDiskOfferingParams) GetIopsreadratemax() (int64, bool) {
+	value, ok := p.p["iopsreadratemax"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetIopsreadratemaxlength(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -263,6 +502,15 @@ func (p *CreateDiskOfferingParams) SetIopsreadratemaxlength(v int64) {
 	p.p["iopsreadratemaxlength"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetIopsreadratemaxlength() {
+	delete(p.p, "iopsreadratemaxlength")
+}
+
+func (p *CreateDiskOfferingParams) GetIopsreadratemaxlength() (int64, bool) {
+	value, ok := p.p["iopsreadratemaxlength"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetIopswriterate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -270,6 +518,15 @@ func (p *CreateDiskOfferingParams) SetIopswriterate(v int64) {
 	p.p["iopswriterate"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetIopswriterate() {
+	delete(p.p, "iopswriterate")
+}
+
+func (p *CreateDiskOfferingParams) GetIopswriterate() (int64, bool) {
+	value, ok := p.p["iopswriterate"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetIopswriteratemax(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -277,6 +534,15 @@ func (p *CreateDiskOfferingParams) SetIopswriteratemax(v int64) {
 	p.p["iopswriteratemax"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetIopswriteratemax() {
+	delete(p.p, "iopswriteratemax")
+}
+
+func (p *CreateDiskOfferingParams) GetIopswriteratemax() (int64, bool) {
+	value, ok := p.p["iopswriteratemax"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetIopswriteratemaxlength(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -284,6 +550,15 @@ func (p *CreateDiskOfferingParams) SetIopswriteratemaxlength(v int64) {
 	p.p["iopswriteratemaxlength"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetIopswriteratemaxlength() {
+	delete(p.p, "iopswriteratemaxlength")
+}
+
+func (p *CreateDiskOfferingParams) GetIopswriteratemaxlength() (int64, bool) {
+	value, ok := p.p["iopswriteratemaxlength"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetMaxiops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -291,6 +566,15 @@ func (p *CreateDiskOfferingParams) SetMaxiops(v int64) {
 	p.p["maxiops"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetMaxiops() {
+	delete(p.p, "maxiops")
+}
+
+func (p *CreateDiskOfferingParams) GetMaxiops() (int64, bool) {
+	value, ok := p.p["maxiops"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetMiniops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -298,6 +582,15 @@ func (p *CreateDiskOfferingParams) SetMiniops(v int64) {
 	p.p["miniops"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetMiniops() {
+	delete(p.p, "miniops")
+}
+
+func (p *CreateDiskOfferingParams) GetMiniops() (int64, bool) {
+	value, ok := p.p["miniops"].(int64)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -305,6 +598,15 @@ func (p *CreateDiskOfferingParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateDiskOfferingParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetProvisioningtype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -312,6 +614,15 @@ func (p *CreateDiskOfferingParams) SetProvisioningtype(v string) {
 	p.p["provisioningtype"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetProvisioningtype() {
+	delete(p.p, "provisioningtype")
+}
+
+func (p *CreateDiskOfferingParams) GetProvisioningtype() (string, bool) {
+	value, ok := p.p["provisioningtype"].(string)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetStoragepolicy(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -319,6 +630,15 @@ func (p *CreateDiskOfferingParams) SetStoragepolicy(v string) {
 	p.p["storagepolicy"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetStoragepolicy() {
+	delete(p.p, "storagepolicy")
+}
+
+func (p *CreateDiskOfferingParams) GetStoragepolicy() (string, bool) {
+	value, ok := p.p["storagepolicy"].(string)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetStoragetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -326,6 +646,15 @@ func (p *CreateDiskOfferingParams) SetStoragetype(v string) {
 	p.p["storagetype"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetStoragetype() {
+	delete(p.p, "storagetype")
+}
+
+func (p *CreateDiskOfferingParams) GetStoragetype() (string, bool) {
+	value, ok := p.p["storagetype"].(string)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetTags(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -333,6 +662,15 @@ func (p *CreateDiskOfferingParams) SetTags(v string) {
 	p.p["tags"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *CreateDiskOfferingParams) GetTags() (string, bool) {
+	value, ok := p.p["tags"].(string)
+	return value, ok
+}
+
 func (p *CreateDiskOfferingParams) SetZoneid(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -340,6 +678,15 @@ func (p *CreateDiskOfferingParams) SetZoneid(v []string) {
 	p.p["zoneid"] = v
 }
 
+func (p *CreateDiskOfferingParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreateDiskOfferingParams) GetZoneid() ([]string, bool) {
+	value, ok := p.p["zoneid"].([]string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateDiskOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *DiskOfferingService) NewCreateDiskOfferingParams(displaytext string, name string) *CreateDiskOfferingParams {
@@ -352,7 +699,16 @@ func (s *DiskOfferingService) NewCreateDiskOfferingParams(displaytext string, na
 
 // Creates a disk offering.
 func (s *DiskOfferingService) CreateDiskOffering(p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createDiskOffering", p.toURLValues())
+	return s.CreateDiskOfferingWithContext(context.Background(), p)
+}
+
+// CreateDiskOfferingWithContext is the same as CreateDiskOffering, but uses ctx to cancel the request and any async job polling
+func (s *DiskOfferingService) CreateDiskOfferingWithContext(ctx context.Context, p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createDiskOffering", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -406,7 +762,8 @@ type DeleteDiskOfferingParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteDiskOfferingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteDiskOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -417,6 +774,48 @@ func (p *DeleteDiskOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteDiskOfferingParams) Validate() error {
+	v := newParamValidator("deleteDiskOffering", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteDiskOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteDiskOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteDiskOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteDiskOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteDiskOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -424,6 +823,15 @@ func (p *DeleteDiskOfferingParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteDiskOfferingParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteDiskOfferingParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteDiskOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *DiskOfferingService) NewDeleteDiskOfferingParams(id string) *DeleteDiskOfferingParams {
@@ -435,7 +843,16 @@ func (s *DiskOfferingService) NewDeleteDiskOfferingParams(id string) *DeleteDisk
 
 // Updates a disk offering.
 func (s *DiskOfferingService) DeleteDiskOffering(p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteDiskOffering", p.toURLValues())
+	return s.DeleteDiskOfferingWithContext(context.Background(), p)
+}
+
+// DeleteDiskOfferingWithContext is the same as DeleteDiskOffering, but uses ctx to cancel the request and any async job polling
+func (s *DiskOfferingService) DeleteDiskOfferingWithContext(ctx context.Context, p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteDiskOffering", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -486,7 +903,8 @@ type ListDiskOfferingsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListDiskOfferingsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListDiskOfferingsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -525,6 +943,57 @@ func (p *ListDiskOfferingsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListDiskOfferingsParams) Validate() error {
+	v := newParamValidator("listDiskOfferings", p.p)
+	v.ids("domainid", "id", "zoneid")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListDiskOfferingsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListDiskOfferingsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "domainid", "id", "keyword", "name", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listDiskOfferings", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listDiskOfferings: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListDiskOfferingsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -532,6 +1001,15 @@ func (p *ListDiskOfferingsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListDiskOfferingsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListDiskOfferingsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListDiskOfferingsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -539,6 +1017,15 @@ func (p *ListDiskOfferingsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListDiskOfferingsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListDiskOfferingsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListDiskOfferingsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -546,6 +1033,15 @@ func (p *ListDiskOfferingsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListDiskOfferingsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListDiskOfferingsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListDiskOfferingsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -553,6 +1049,15 @@ func (p *ListDiskOfferingsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListDiskOfferingsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListDiskOfferingsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListDiskOfferingsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -560,6 +1065,15 @@ func (p *ListDiskOfferingsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListDiskOfferingsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListDiskOfferingsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListDiskOfferingsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -567,6 +1081,15 @@ func (p *ListDiskOfferingsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListDiskOfferingsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListDiskOfferingsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListDiskOfferingsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -574,6 +1097,15 @@ func (p *ListDiskOfferingsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListDiskOfferingsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListDiskOfferingsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListDiskOfferingsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -581,6 +1113,15 @@ func (p *ListDiskOfferingsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListDiskOfferingsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListDiskOfferingsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListDiskOfferingsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -588,6 +1129,15 @@ func (p *ListDiskOfferingsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListDiskOfferingsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListDiskOfferingsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListDiskOfferingsParams instance,
 // as then you are sure you have configured all required params
 func (s *DiskOfferingService) NewListDiskOfferingsParams() *ListDiskOfferingsParams {
@@ -598,6 +1148,11 @@ func (s *DiskOfferingService) NewListDiskOfferingsParams() *ListDiskOfferingsPar
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetDiskOfferingIDWithContext(context.Background(), name, opts...)
+}
+
+// GetDiskOfferingIDWithContext is the same as GetDiskOfferingID, but uses ctx to cancel the request
+func (s *DiskOfferingService) GetDiskOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListDiskOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -609,7 +1164,7 @@ func (s *DiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListDiskOfferings(p)
+	l, err := s.ListDiskOfferingsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -634,12 +1189,17 @@ func (s *DiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DiskOfferingService) GetDiskOfferingByName(name string, opts ...OptionFunc) (*DiskOffering, int, error) {
-	id, count, err := s.GetDiskOfferingID(name, opts...)
+	return s.GetDiskOfferingByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetDiskOfferingByNameWithContext is the same as GetDiskOfferingByName, but uses ctx to cancel the requests
+func (s *DiskOfferingService) GetDiskOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*DiskOffering, int, error) {
+	id, count, err := s.GetDiskOfferingIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetDiskOfferingByID(id, opts...)
+	r, count, err := s.GetDiskOfferingByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -648,6 +1208,11 @@ func (s *DiskOfferingService) GetDiskOfferingByName(name string, opts ...OptionF
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DiskOfferingService) GetDiskOfferingByID(id string, opts ...OptionFunc) (*DiskOffering, int, error) {
+	return s.GetDiskOfferingByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetDiskOfferingByIDWithContext is the same as GetDiskOfferingByID, but uses ctx to cancel the request
+func (s *DiskOfferingService) GetDiskOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DiskOffering, int, error) {
 	p := &ListDiskOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -659,18 +1224,16 @@ func (s *DiskOfferingService) GetDiskOfferingByID(id string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListDiskOfferings(p)
+	l, err := s.ListDiskOfferingsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -681,7 +1244,16 @@ func (s *DiskOfferingService) GetDiskOfferingByID(id string, opts ...OptionFunc)
 
 // Lists all available disk offerings.
 func (s *DiskOfferingService) ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listDiskOfferings", p.toURLValues())
+	return s.ListDiskOfferingsWithContext(context.Background(), p)
+}
+
+// ListDiskOfferingsWithContext is the same as ListDiskOfferings, but uses ctx to cancel the request and any async job polling
+func (s *DiskOfferingService) ListDiskOfferingsWithContext(ctx context.Context, p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listDiskOfferings", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -694,6 +1266,92 @@ func (s *DiskOfferingService) ListDiskOfferings(p *ListDiskOfferingsParams) (*Li
 	return &r, nil
 }
 
+// ListDiskOfferingsPager iterates over all pages of a ListDiskOfferings call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListDiskOfferingsPager struct {
+	pager
+
+	s *DiskOfferingService
+	p *ListDiskOfferingsParams
+	r *ListDiskOfferingsResponse
+}
+
+// NewListDiskOfferingsPager returns a pager for all pages of a ListDiskOfferings call with the given params
+func (s *DiskOfferingService) NewListDiskOfferingsPager(p *ListDiskOfferingsParams) *ListDiskOfferingsPager {
+	return &ListDiskOfferingsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListDiskOfferingsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListDiskOfferingsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListDiskOfferingsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListDiskOfferingsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.DiskOfferings), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListDiskOfferingsPager) Page() *ListDiskOfferingsResponse {
+	return pg.r
+}
+
+// ListDiskOfferingsAll fetches all pages of a ListDiskOfferings call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *DiskOfferingService) ListDiskOfferingsAll(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
+	return s.ListDiskOfferingsAllWithContext(context.Background(), p)
+}
+
+// ListDiskOfferingsAllWithContext is the same as ListDiskOfferingsAll, but uses ctx to cancel the requests
+func (s *DiskOfferingService) ListDiskOfferingsAllWithContext(ctx context.Context, p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*DiskOffering)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListDiskOfferingsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListDiskOfferingsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.DiskOfferings
+		mu.Unlock()
+
+		return len(l.DiskOfferings), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListDiskOfferingsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.DiskOfferings = append(r.DiskOfferings, pages[page]...)
+	}
+	r.Count = len(r.DiskOfferings)
+
+	return r, nil
+}
+
 type ListDiskOfferingsResponse struct {
 	Count         int             `json:"count"`
 	DiskOfferings []*DiskOffering `json:"diskoffering"`
@@ -740,7 +1398,8 @@ type UpdateDiskOfferingParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateDiskOfferingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateDiskOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -825,6 +1484,66 @@ func (p *UpdateDiskOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateDiskOfferingParams) Validate() error {
+	v := newParamValidator("updateDiskOffering", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("cachemode", 255)
+	v.maxLength("displaytext", 4096)
+	v.maxLength("domainid", 255)
+	v.maxLength("name", 255)
+	v.maxLength("tags", 255)
+	v.maxLength("zoneid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateDiskOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateDiskOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "bytesreadrate", "bytesreadratemax", "bytesreadratemaxlength", "byteswriterate", "byteswriteratemax", "byteswriteratemaxlength", "iopsreadrate", "iopsreadratemax", "iopsreadratemaxlength", "iopswriterate", "iopswriteratemax", "iopswriteratemaxlength":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "cachemode", "displaytext", "domainid", "id", "name", "tags", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "displayoffering":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "sortkey":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateDiskOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateDiskOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateDiskOfferingParams) SetBytesreadrate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -832,6 +1551,15 @@ func (p *UpdateDiskOfferingParams) SetBytesreadrate(v int64) {
 	p.p["bytesreadrate"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetBytesreadrate() {
+	delete(p.p, "bytesreadrate")
+}
+
+func (p *UpdateDiskOfferingParams) GetBytesreadrate() (int64, bool) {
+	value, ok := p.p["bytesreadrate"].(int64)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetBytesreadratemax(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -839,6 +1567,15 @@ func (p *UpdateDiskOfferingParams) SetBytesreadratemax(v int64) {
 	p.p["bytesreadratemax"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetBytesreadratemax() {
+	delete(p.p, "bytesreadratemax")
+}
+
+func (p *UpdateDiskOfferingParams) GetBytesreadratemax() (int64, bool) {
+	value, ok := p.p["bytesreadratemax"].(int64)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetBytesreadratemaxlength(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -846,6 +1583,15 @@ func (p *UpdateDiskOfferingParams) SetBytesreadratemaxlength(v int64) {
 	p.p["bytesreadratemaxlength"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetBytesreadratemaxlength() {
+	delete(p.p, "bytesreadratemaxlength")
+}
+
+func (p *UpdateDiskOfferingParams) GetBytesreadratemaxlength() (int64, bool) {
+	value, ok := p.p["bytesreadratemaxlength"].(int64)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetByteswriterate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -853,6 +1599,15 @@ func (p *UpdateDiskOfferingParams) SetByteswriterate(v int64) {
 	p.p["byteswriterate"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetByteswriterate() {
+	delete(p.p, "byteswriterate")
+}
+
+func (p *UpdateDiskOfferingParams) GetByteswriterate() (int64, bool) {
+	value, ok := p.p["byteswriterate"].(int64)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetByteswriteratemax(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -860,6 +1615,15 @@ func (p *UpdateDiskOfferingParams) SetByteswriteratemax(v int64) {
 	p.p["byteswriteratemax"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetByteswriteratemax() {
+	delete(p.p, "byteswriteratemax")
+}
+
+func (p *UpdateDiskOfferingParams) GetByteswriteratemax() (int64, bool) {
+	value, ok := p.p["byteswriteratemax"].(int64)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetByteswriteratemaxlength(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -867,6 +1631,15 @@ func (p *UpdateDiskOfferingParams) SetByteswriteratemaxlength(v int64) {
 	p.p["byteswriteratemaxlength"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetByteswriteratemaxlength() {
+	delete(p.p, "byteswriteratemaxlength")
+}
+
+func (p *UpdateDiskOfferingParams) GetByteswriteratemaxlength() (int64, bool) {
+	value, ok := p.p["byteswriteratemaxlength"].(int64)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetCachemode(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -874,6 +1647,15 @@ func (p *UpdateDiskOfferingParams) SetCachemode(v string) {
 	p.p["cachemode"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetCachemode() {
+	delete(p.p, "cachemode")
+}
+
+func (p *UpdateDiskOfferingParams) GetCachemode() (string, bool) {
+	value, ok := p.p["cachemode"].(string)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetDisplayoffering(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -881,6 +1663,15 @@ func (p *UpdateDiskOfferingParams) SetDisplayoffering(v bool) {
 	p.p["displayoffering"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetDisplayoffering() {
+	delete(p.p, "displayoffering")
+}
+
+func (p *UpdateDiskOfferingParams) GetDisplayoffering() (bool, bool) {
+	value, ok := p.p["displayoffering"].(bool)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -888,6 +1679,15 @@ func (p *UpdateDiskOfferingParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *UpdateDiskOfferingParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -895,6 +1695,15 @@ func (p *UpdateDiskOfferingParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *UpdateDiskOfferingParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -902,6 +1711,15 @@ func (p *UpdateDiskOfferingParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateDiskOfferingParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetIopsreadrate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -909,6 +1727,15 @@ func (p *UpdateDiskOfferingParams) SetIopsreadrate(v int64) {
 	p.p["iopsreadrate"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetIopsreadrate() {
+	delete(p.p, "iopsreadrate")
+}
+
+func (p *UpdateDiskOfferingParams) GetIopsreadrate() (int64, bool) {
+	value, ok := p.p["iopsreadrate"].(int64)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetIopsreadratemax(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -916,6 +1743,15 @@ func (p *UpdateDiskOfferingParams) SetIopsreadratemax(v int64) {
 	p.p["iopsreadratemax"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetIopsreadratemax() {
+	delete(p.p, "iopsreadratemax")
+}
+
+func (p *UpdateDiskOfferingParams) GetIopsreadratemax() (int64, bool) {
+	value, ok := p.p["iopsreadratemax"].(int64)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetIopsreadratemaxlength(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -923,6 +1759,15 @@ func (p *UpdateDiskOfferingParams) SetIopsreadratemaxlength(v int64) {
 	p.p["iopsreadratemaxlength"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetIopsreadratemaxlength() {
+	delete(p.p, "iopsreadratemaxlength")
+}
+
+func (p *UpdateDiskOfferingParams) GetIopsreadratemaxlength() (int64, bool) {
+	value, ok := p.p["iopsreadratemaxlength"].(int64)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetIopswriterate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -930,6 +1775,15 @@ func (p *UpdateDiskOfferingParams) SetIopswriterate(v int64) {
 	p.p["iopswriterate"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetIopswriterate() {
+	delete(p.p, "iopswriterate")
+}
+
+func (p *UpdateDiskOfferingParams) GetIopswriterate() (int64, bool) {
+	value, ok := p.p["iopswriterate"].(int64)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetIopswriteratemax(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -937,6 +1791,15 @@ func (p *UpdateDiskOfferingParams) SetIopswriteratemax(v int64) {
 	p.p["iopswriteratemax"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetIopswriteratemax() {
+	delete(p.p, "iopswriteratemax")
+}
+
+func (p *UpdateDiskOfferingParams) GetIopswriteratemax() (int64, bool) {
+	value, ok := p.p["iopswriteratemax"].(int64)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetIopswriteratemaxlength(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -944,6 +1807,15 @@ func (p *UpdateDiskOfferingParams) SetIopswriteratemaxlength(v int64) {
 	p.p["iopswriteratemaxlength"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetIopswriteratemaxlength() {
+	delete(p.p, "iopswriteratemaxlength")
+}
+
+func (p *UpdateDiskOfferingParams) GetIopswriteratemaxlength() (int64, bool) {
+	value, ok := p.p["iopswriteratemaxlength"].(int64)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -951,6 +1823,15 @@ func (p *UpdateDiskOfferingParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateDiskOfferingParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetSortkey(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -958,6 +1839,15 @@ func (p *UpdateDiskOfferingParams) SetSortkey(v int) {
 	p.p["sortkey"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetSortkey() {
+	delete(p.p, "sortkey")
+}
+
+func (p *UpdateDiskOfferingParams) GetSortkey() (int, bool) {
+	value, ok := p.p["sortkey"].(int)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetTags(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -965,6 +1855,15 @@ func (p *UpdateDiskOfferingParams) SetTags(v string) {
 	p.p["tags"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *UpdateDiskOfferingParams) GetTags() (string, bool) {
+	value, ok := p.p["tags"].(string)
+	return value, ok
+}
+
 func (p *UpdateDiskOfferingParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -972,6 +1871,15 @@ func (p *UpdateDiskOfferingParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *UpdateDiskOfferingParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *UpdateDiskOfferingParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateDiskOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *DiskOfferingService) NewUpdateDiskOfferingParams(id string) *UpdateDiskOfferingParams {
@@ -983,7 +1891,16 @@ func (s *DiskOfferingService) NewUpdateDiskOfferingParams(id string) *UpdateDisk
 
 // Updates a disk offering.
 func (s *DiskOfferingService) UpdateDiskOffering(p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateDiskOffering", p.toURLValues())
+	return s.UpdateDiskOfferingWithContext(context.Background(), p)
+}
+
+// UpdateDiskOfferingWithContext is the same as UpdateDiskOffering, but uses ctx to cancel the request and any async job polling
+func (s *DiskOfferingService) UpdateDiskOfferingWithContext(ctx context.Context, p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateDiskOffering", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
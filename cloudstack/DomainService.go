@@ -20,18 +20,61 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// DomainServiceIface is the interface implemented by DomainService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type DomainServiceIface interface {
+	NewCreateDomainParams(name string) *CreateDomainParams
+	CreateDomain(p *CreateDomainParams) (*CreateDomainResponse, error)
+	CreateDomainWithContext(ctx context.Context, p *CreateDomainParams) (*CreateDomainResponse, error)
+	NewDeleteDomainParams(id string) *DeleteDomainParams
+	DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error)
+	DeleteDomainWithContext(ctx context.Context, p *DeleteDomainParams) (*DeleteDomainResponse, error)
+	DeleteDomainAsync(p *DeleteDomainParams) (*Job, error)
+	DeleteDomainAsyncWithContext(ctx context.Context, p *DeleteDomainParams) (*Job, error)
+	NewListDomainChildrenParams() *ListDomainChildrenParams
+	GetDomainChildrenID(name string, opts ...OptionFunc) (string, int, error)
+	GetDomainChildrenIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetDomainChildrenByName(name string, opts ...OptionFunc) (*DomainChildren, int, error)
+	GetDomainChildrenByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*DomainChildren, int, error)
+	GetDomainChildrenByID(id string, opts ...OptionFunc) (*DomainChildren, int, error)
+	GetDomainChildrenByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DomainChildren, int, error)
+	ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error)
+	ListDomainChildrenWithContext(ctx context.Context, p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error)
+	NewListDomainChildrenPager(p *ListDomainChildrenParams) *ListDomainChildrenPager
+	ListDomainChildrenAll(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error)
+	ListDomainChildrenAllWithContext(ctx context.Context, p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error)
+	NewListDomainsParams() *ListDomainsParams
+	GetDomainID(name string, opts ...OptionFunc) (string, int, error)
+	GetDomainIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetDomainByName(name string, opts ...OptionFunc) (*Domain, int, error)
+	GetDomainByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Domain, int, error)
+	GetDomainByID(id string, opts ...OptionFunc) (*Domain, int, error)
+	GetDomainByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Domain, int, error)
+	ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error)
+	ListDomainsWithContext(ctx context.Context, p *ListDomainsParams) (*ListDomainsResponse, error)
+	NewListDomainsPager(p *ListDomainsParams) *ListDomainsPager
+	ListDomainsAll(p *ListDomainsParams) (*ListDomainsResponse, error)
+	ListDomainsAllWithContext(ctx context.Context, p *ListDomainsParams) (*ListDomainsResponse, error)
+	NewUpdateDomainParams(id string) *UpdateDomainParams
+	UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error)
+	UpdateDomainWithContext(ctx context.Context, p *UpdateDomainParams) (*UpdateDomainResponse, error)
+}
+
 type CreateDomainParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateDomainParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateDomainParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -51,6 +94,51 @@ func (p *CreateDomainParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateDomainParams) Validate() error {
+	v := newParamValidator("createDomain", p.p)
+	v.required("name")
+	v.ids("parentdomainid")
+	v.maxLength("domainid", 255)
+	v.maxLength("name", 255)
+	v.maxLength("networkdomain", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateDomainParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateDomainParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "domainid", "name", "networkdomain", "parentdomainid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createDomain", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createDomain: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateDomainParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -58,6 +146,15 @@ func (p *CreateDomainParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateDomainParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateDomainParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateDomainParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -65,6 +162,15 @@ func (p *CreateDomainParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateDomainParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateDomainParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateDomainParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -72,6 +178,15 @@ func (p *CreateDomainParams) SetNetworkdomain(v string) {
 	p.p["networkdomain"] = v
 }
 
+func (p *CreateDomainParams) ResetNetworkdomain() {
+	delete(p.p, "networkdomain")
+}
+
+func (p *CreateDomainParams) GetNetworkdomain() (string, bool) {
+	value, ok := p.p["networkdomain"].(string)
+	return value, ok
+}
+
 func (p *CreateDomainParams) SetParentdomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +194,15 @@ func (p *CreateDomainParams) SetParentdomainid(v string) {
 	p.p["parentdomainid"] = v
 }
 
+func (p *CreateDomainParams) ResetParentdomainid() {
+	delete(p.p, "parentdomainid")
+}
+
+func (p *CreateDomainParams) GetParentdomainid() (string, bool) {
+	value, ok := p.p["parentdomainid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateDomainParams instance,
 // as then you are sure you have configured all required params
 func (s *DomainService) NewCreateDomainParams(name string) *CreateDomainParams {
@@ -90,7 +214,16 @@ func (s *DomainService) NewCreateDomainParams(name string) *CreateDomainParams {
 
 // Creates a domain
 func (s *DomainService) CreateDomain(p *CreateDomainParams) (*CreateDomainResponse, error) {
-	resp, err := s.cs.newRequest("createDomain", p.toURLValues())
+	return s.CreateDomainWithContext(context.Background(), p)
+}
+
+// CreateDomainWithContext is the same as CreateDomain, but uses ctx to cancel the request and any async job polling
+func (s *DomainService) CreateDomainWithContext(ctx context.Context, p *CreateDomainParams) (*CreateDomainResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createDomain", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +290,8 @@ type DeleteDomainParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteDomainParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteDomainParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -172,6 +306,52 @@ func (p *DeleteDomainParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteDomainParams) Validate() error {
+	v := newParamValidator("deleteDomain", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteDomainParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteDomainParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "cleanup":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteDomain", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteDomain: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteDomainParams) SetCleanup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -179,6 +359,15 @@ func (p *DeleteDomainParams) SetCleanup(v bool) {
 	p.p["cleanup"] = v
 }
 
+func (p *DeleteDomainParams) ResetCleanup() {
+	delete(p.p, "cleanup")
+}
+
+func (p *DeleteDomainParams) GetCleanup() (bool, bool) {
+	value, ok := p.p["cleanup"].(bool)
+	return value, ok
+}
+
 func (p *DeleteDomainParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -186,6 +375,15 @@ func (p *DeleteDomainParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteDomainParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteDomainParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteDomainParams instance,
 // as then you are sure you have configured all required params
 func (s *DomainService) NewDeleteDomainParams(id string) *DeleteDomainParams {
@@ -197,7 +395,16 @@ func (s *DomainService) NewDeleteDomainParams(id string) *DeleteDomainParams {
 
 // Deletes a specified domain
 func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error) {
-	resp, err := s.cs.newRequest("deleteDomain", p.toURLValues())
+	return s.DeleteDomainWithContext(context.Background(), p)
+}
+
+// DeleteDomainWithContext is the same as DeleteDomain, but uses ctx to cancel the request and any async job polling
+func (s *DomainService) DeleteDomainWithContext(ctx context.Context, p *DeleteDomainParams) (*DeleteDomainResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteDomain", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +416,7 @@ func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -225,6 +432,31 @@ func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainRespon
 	return &r, nil
 }
 
+// DeleteDomainAsync starts the async job for DeleteDomain and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteDomainResponse using Job.Result
+func (s *DomainService) DeleteDomainAsync(p *DeleteDomainParams) (*Job, error) {
+	return s.DeleteDomainAsyncWithContext(context.Background(), p)
+}
+
+// DeleteDomainAsyncWithContext is the same as DeleteDomainAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *DomainService) DeleteDomainAsyncWithContext(ctx context.Context, p *DeleteDomainParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteDomain", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteDomainResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteDomainResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -236,7 +468,8 @@ type ListDomainChildrenParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListDomainChildrenParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListDomainChildrenParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -269,6 +502,57 @@ func (p *ListDomainChildrenParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListDomainChildrenParams) Validate() error {
+	v := newParamValidator("listDomainChildren", p.p)
+	v.ids("id")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListDomainChildrenParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListDomainChildrenParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listDomainChildren", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listDomainChildren: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListDomainChildrenParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -276,6 +560,15 @@ func (p *ListDomainChildrenParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListDomainChildrenParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListDomainChildrenParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListDomainChildrenParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -283,6 +576,15 @@ func (p *ListDomainChildrenParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListDomainChildrenParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListDomainChildrenParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListDomainChildrenParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -290,6 +592,15 @@ func (p *ListDomainChildrenParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListDomainChildrenParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListDomainChildrenParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListDomainChildrenParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -297,6 +608,15 @@ func (p *ListDomainChildrenParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListDomainChildrenParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListDomainChildrenParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListDomainChildrenParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -304,6 +624,15 @@ func (p *ListDomainChildrenParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListDomainChildrenParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListDomainChildrenParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListDomainChildrenParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -311,6 +640,15 @@ func (p *ListDomainChildrenParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListDomainChildrenParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListDomainChildrenParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListDomainChildrenParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -318,6 +656,15 @@ func (p *ListDomainChildrenParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListDomainChildrenParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListDomainChildrenParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListDomainChildrenParams instance,
 // as then you are sure you have configured all required params
 func (s *DomainService) NewListDomainChildrenParams() *ListDomainChildrenParams {
@@ -328,6 +675,11 @@ func (s *DomainService) NewListDomainChildrenParams() *ListDomainChildrenParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetDomainChildrenIDWithContext(context.Background(), name, opts...)
+}
+
+// GetDomainChildrenIDWithContext is the same as GetDomainChildrenID, but uses ctx to cancel the request
+func (s *DomainService) GetDomainChildrenIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListDomainChildrenParams{}
 	p.p = make(map[string]interface{})
 
@@ -339,7 +691,7 @@ func (s *DomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (st
 		}
 	}
 
-	l, err := s.ListDomainChildren(p)
+	l, err := s.ListDomainChildrenWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -364,12 +716,17 @@ func (s *DomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (st
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainChildrenByName(name string, opts ...OptionFunc) (*DomainChildren, int, error) {
-	id, count, err := s.GetDomainChildrenID(name, opts...)
+	return s.GetDomainChildrenByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetDomainChildrenByNameWithContext is the same as GetDomainChildrenByName, but uses ctx to cancel the requests
+func (s *DomainService) GetDomainChildrenByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*DomainChildren, int, error) {
+	id, count, err := s.GetDomainChildrenIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetDomainChildrenByID(id, opts...)
+	r, count, err := s.GetDomainChildrenByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -378,6 +735,11 @@ func (s *DomainService) GetDomainChildrenByName(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainChildrenByID(id string, opts ...OptionFunc) (*DomainChildren, int, error) {
+	return s.GetDomainChildrenByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetDomainChildrenByIDWithContext is the same as GetDomainChildrenByID, but uses ctx to cancel the request
+func (s *DomainService) GetDomainChildrenByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DomainChildren, int, error) {
 	p := &ListDomainChildrenParams{}
 	p.p = make(map[string]interface{})
 
@@ -389,18 +751,16 @@ func (s *DomainService) GetDomainChildrenByID(id string, opts ...OptionFunc) (*D
 		}
 	}
 
-	l, err := s.ListDomainChildren(p)
+	l, err := s.ListDomainChildrenWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -411,7 +771,16 @@ func (s *DomainService) GetDomainChildrenByID(id string, opts ...OptionFunc) (*D
 
 // Lists all children domains belonging to a specified domain
 func (s *DomainService) ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
-	resp, err := s.cs.newRequest("listDomainChildren", p.toURLValues())
+	return s.ListDomainChildrenWithContext(context.Background(), p)
+}
+
+// ListDomainChildrenWithContext is the same as ListDomainChildren, but uses ctx to cancel the request and any async job polling
+func (s *DomainService) ListDomainChildrenWithContext(ctx context.Context, p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listDomainChildren", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -424,6 +793,92 @@ func (s *DomainService) ListDomainChildren(p *ListDomainChildrenParams) (*ListDo
 	return &r, nil
 }
 
+// ListDomainChildrenPager iterates over all pages of a ListDomainChildren call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListDomainChildrenPager struct {
+	pager
+
+	s *DomainService
+	p *ListDomainChildrenParams
+	r *ListDomainChildrenResponse
+}
+
+// NewListDomainChildrenPager returns a pager for all pages of a ListDomainChildren call with the given params
+func (s *DomainService) NewListDomainChildrenPager(p *ListDomainChildrenParams) *ListDomainChildrenPager {
+	return &ListDomainChildrenPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListDomainChildrenPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListDomainChildrenPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListDomainChildrenParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListDomainChildrenWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.DomainChildren), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListDomainChildrenPager) Page() *ListDomainChildrenResponse {
+	return pg.r
+}
+
+// ListDomainChildrenAll fetches all pages of a ListDomainChildren call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *DomainService) ListDomainChildrenAll(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
+	return s.ListDomainChildrenAllWithContext(context.Background(), p)
+}
+
+// ListDomainChildrenAllWithContext is the same as ListDomainChildrenAll, but uses ctx to cancel the requests
+func (s *DomainService) ListDomainChildrenAllWithContext(ctx context.Context, p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*DomainChildren)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListDomainChildrenParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListDomainChildrenWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.DomainChildren
+		mu.Unlock()
+
+		return len(l.DomainChildren), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListDomainChildrenResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.DomainChildren = append(r.DomainChildren, pages[page]...)
+	}
+	r.Count = len(r.DomainChildren)
+
+	return r, nil
+}
+
 type ListDomainChildrenResponse struct {
 	Count          int               `json:"count"`
 	DomainChildren []*DomainChildren `json:"domainchildren"`
@@ -483,7 +938,8 @@ type ListDomainsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListDomainsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListDomainsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -520,6 +976,61 @@ func (p *ListDomainsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListDomainsParams) Validate() error {
+	v := newParamValidator("listDomains", p.p)
+	v.ids("id")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListDomainsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListDomainsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "details":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "keyword", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "level", "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listDomains", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listDomains: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListDomainsParams) SetDetails(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -527,6 +1038,15 @@ func (p *ListDomainsParams) SetDetails(v []string) {
 	p.p["details"] = v
 }
 
+func (p *ListDomainsParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *ListDomainsParams) GetDetails() ([]string, bool) {
+	value, ok := p.p["details"].([]string)
+	return value, ok
+}
+
 func (p *ListDomainsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -534,6 +1054,15 @@ func (p *ListDomainsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListDomainsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListDomainsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListDomainsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -541,6 +1070,15 @@ func (p *ListDomainsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListDomainsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListDomainsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListDomainsParams) SetLevel(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -548,6 +1086,15 @@ func (p *ListDomainsParams) SetLevel(v int) {
 	p.p["level"] = v
 }
 
+func (p *ListDomainsParams) ResetLevel() {
+	delete(p.p, "level")
+}
+
+func (p *ListDomainsParams) GetLevel() (int, bool) {
+	value, ok := p.p["level"].(int)
+	return value, ok
+}
+
 func (p *ListDomainsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -555,6 +1102,15 @@ func (p *ListDomainsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListDomainsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListDomainsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListDomainsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -562,6 +1118,15 @@ func (p *ListDomainsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListDomainsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListDomainsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListDomainsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -569,6 +1134,15 @@ func (p *ListDomainsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListDomainsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListDomainsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListDomainsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -576,6 +1150,15 @@ func (p *ListDomainsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListDomainsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListDomainsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListDomainsParams instance,
 // as then you are sure you have configured all required params
 func (s *DomainService) NewListDomainsParams() *ListDomainsParams {
@@ -586,6 +1169,11 @@ func (s *DomainService) NewListDomainsParams() *ListDomainsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetDomainIDWithContext(context.Background(), name, opts...)
+}
+
+// GetDomainIDWithContext is the same as GetDomainID, but uses ctx to cancel the request
+func (s *DomainService) GetDomainIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListDomainsParams{}
 	p.p = make(map[string]interface{})
 
@@ -597,7 +1185,7 @@ func (s *DomainService) GetDomainID(name string, opts ...OptionFunc) (string, in
 		}
 	}
 
-	l, err := s.ListDomains(p)
+	l, err := s.ListDomainsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -622,12 +1210,17 @@ func (s *DomainService) GetDomainID(name string, opts ...OptionFunc) (string, in
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainByName(name string, opts ...OptionFunc) (*Domain, int, error) {
-	id, count, err := s.GetDomainID(name, opts...)
+	return s.GetDomainByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetDomainByNameWithContext is the same as GetDomainByName, but uses ctx to cancel the requests
+func (s *DomainService) GetDomainByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Domain, int, error) {
+	id, count, err := s.GetDomainIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetDomainByID(id, opts...)
+	r, count, err := s.GetDomainByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -636,6 +1229,11 @@ func (s *DomainService) GetDomainByName(name string, opts ...OptionFunc) (*Domai
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainByID(id string, opts ...OptionFunc) (*Domain, int, error) {
+	return s.GetDomainByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetDomainByIDWithContext is the same as GetDomainByID, but uses ctx to cancel the request
+func (s *DomainService) GetDomainByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Domain, int, error) {
 	p := &ListDomainsParams{}
 	p.p = make(map[string]interface{})
 
@@ -647,18 +1245,16 @@ func (s *DomainService) GetDomainByID(id string, opts ...OptionFunc) (*Domain, i
 		}
 	}
 
-	l, err := s.ListDomains(p)
+	l, err := s.ListDomainsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -669,7 +1265,16 @@ func (s *DomainService) GetDomainByID(id string, opts ...OptionFunc) (*Domain, i
 
 // Lists domains and provides detailed information for listed domains
 func (s *DomainService) ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error) {
-	resp, err := s.cs.newRequest("listDomains", p.toURLValues())
+	return s.ListDomainsWithContext(context.Background(), p)
+}
+
+// ListDomainsWithContext is the same as ListDomains, but uses ctx to cancel the request and any async job polling
+func (s *DomainService) ListDomainsWithContext(ctx context.Context, p *ListDomainsParams) (*ListDomainsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listDomains", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -682,6 +1287,92 @@ func (s *DomainService) ListDomains(p *ListDomainsParams) (*ListDomainsResponse,
 	return &r, nil
 }
 
+// ListDomainsPager iterates over all pages of a ListDomains call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListDomainsPager struct {
+	pager
+
+	s *DomainService
+	p *ListDomainsParams
+	r *ListDomainsResponse
+}
+
+// NewListDomainsPager returns a pager for all pages of a ListDomains call with the given params
+func (s *DomainService) NewListDomainsPager(p *ListDomainsParams) *ListDomainsPager {
+	return &ListDomainsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListDomainsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListDomainsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListDomainsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListDomainsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Domains), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListDomainsPager) Page() *ListDomainsResponse {
+	return pg.r
+}
+
+// ListDomainsAll fetches all pages of a ListDomains call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *DomainService) ListDomainsAll(p *ListDomainsParams) (*ListDomainsResponse, error) {
+	return s.ListDomainsAllWithContext(context.Background(), p)
+}
+
+// ListDomainsAllWithContext is the same as ListDomainsAll, but uses ctx to cancel the requests
+func (s *DomainService) ListDomainsAllWithContext(ctx context.Context, p *ListDomainsParams) (*ListDomainsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Domain)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListDomainsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListDomainsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Domains
+		mu.Unlock()
+
+		return len(l.Domains), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListDomainsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Domains = append(r.Domains, pages[page]...)
+	}
+	r.Count = len(r.Domains)
+
+	return r, nil
+}
+
 type ListDomainsResponse struct {
 	Count   int       `json:"count"`
 	Domains []*Domain `json:"domain"`
@@ -741,7 +1432,8 @@ type UpdateDomainParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateDomainParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateDomainParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -758,6 +1450,50 @@ func (p *UpdateDomainParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateDomainParams) Validate() error {
+	v := newParamValidator("updateDomain", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("name", 255)
+	v.maxLength("networkdomain", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateDomainParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateDomainParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "name", "networkdomain":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateDomain", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateDomain: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateDomainParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -765,6 +1501,15 @@ func (p *UpdateDomainParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateDomainParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateDomainParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateDomainParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -772,6 +1517,15 @@ func (p *UpdateDomainParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateDomainParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateDomainParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateDomainParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -779,6 +1533,15 @@ func (p *UpdateDomainParams) SetNetworkdomain(v string) {
 	p.p["networkdomain"] = v
 }
 
+func (p *UpdateDomainParams) ResetNetworkdomain() {
+	delete(p.p, "networkdomain")
+}
+
+func (p *UpdateDomainParams) GetNetworkdomain() (string, bool) {
+	value, ok := p.p["networkdomain"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateDomainParams instance,
 // as then you are sure you have configured all required params
 func (s *DomainService) NewUpdateDomainParams(id string) *UpdateDomainParams {
@@ -790,7 +1553,16 @@ func (s *DomainService) NewUpdateDomainParams(id string) *UpdateDomainParams {
 
 // Updates a domain with a new name
 func (s *DomainService) UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error) {
-	resp, err := s.cs.newRequest("updateDomain", p.toURLValues())
+	return s.UpdateDomainWithContext(context.Background(), p)
+}
+
+// UpdateDomainWithContext is the same as UpdateDomain, but uses ctx to cancel the request and any async job polling
+func (s *DomainService) UpdateDomainWithContext(ctx context.Context, p *UpdateDomainParams) (*UpdateDomainResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateDomain", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
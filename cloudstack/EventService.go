@@ -20,18 +20,43 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// EventServiceIface is the interface implemented by EventService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type EventServiceIface interface {
+	NewArchiveEventsParams() *ArchiveEventsParams
+	ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error)
+	ArchiveEventsWithContext(ctx context.Context, p *ArchiveEventsParams) (*ArchiveEventsResponse, error)
+	NewDeleteEventsParams() *DeleteEventsParams
+	DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error)
+	DeleteEventsWithContext(ctx context.Context, p *DeleteEventsParams) (*DeleteEventsResponse, error)
+	NewListEventTypesParams() *ListEventTypesParams
+	ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error)
+	ListEventTypesWithContext(ctx context.Context, p *ListEventTypesParams) (*ListEventTypesResponse, error)
+	NewListEventsParams() *ListEventsParams
+	GetEventByID(id string, opts ...OptionFunc) (*Event, int, error)
+	GetEventByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Event, int, error)
+	ListEvents(p *ListEventsParams) (*ListEventsResponse, error)
+	ListEventsWithContext(ctx context.Context, p *ListEventsParams) (*ListEventsResponse, error)
+	NewListEventsPager(p *ListEventsParams) *ListEventsPager
+	ListEventsAll(p *ListEventsParams) (*ListEventsResponse, error)
+	ListEventsAllWithContext(ctx context.Context, p *ListEventsParams) (*ListEventsResponse, error)
+}
+
 type ArchiveEventsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ArchiveEventsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ArchiveEventsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -52,6 +77,51 @@ func (p *ArchiveEventsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ArchiveEventsParams) Validate() error {
+	v := newParamValidator("archiveEvents", p.p)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ArchiveEventsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ArchiveEventsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "enddate", "startdate", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for archiveEvents", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of archiveEvents: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ArchiveEventsParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -59,6 +129,15 @@ func (p *ArchiveEventsParams) SetEnddate(v string) {
 	p.p["enddate"] = v
 }
 
+func (p *ArchiveEventsParams) ResetEnddate() {
+	delete(p.p, "enddate")
+}
+
+func (p *ArchiveEventsParams) GetEnddate() (string, bool) {
+	value, ok := p.p["enddate"].(string)
+	return value, ok
+}
+
 func (p *ArchiveEventsParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -66,6 +145,15 @@ func (p *ArchiveEventsParams) SetIds(v []string) {
 	p.p["ids"] = v
 }
 
+func (p *ArchiveEventsParams) ResetIds() {
+	delete(p.p, "ids")
+}
+
+func (p *ArchiveEventsParams) GetIds() ([]string, bool) {
+	value, ok := p.p["ids"].([]string)
+	return value, ok
+}
+
 func (p *ArchiveEventsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -73,6 +161,15 @@ func (p *ArchiveEventsParams) SetStartdate(v string) {
 	p.p["startdate"] = v
 }
 
+func (p *ArchiveEventsParams) ResetStartdate() {
+	delete(p.p, "startdate")
+}
+
+func (p *ArchiveEventsParams) GetStartdate() (string, bool) {
+	value, ok := p.p["startdate"].(string)
+	return value, ok
+}
+
 func (p *ArchiveEventsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -80,6 +177,15 @@ func (p *ArchiveEventsParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *ArchiveEventsParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ArchiveEventsParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ArchiveEventsParams instance,
 // as then you are sure you have configured all required params
 func (s *EventService) NewArchiveEventsParams() *ArchiveEventsParams {
@@ -90,7 +196,16 @@ func (s *EventService) NewArchiveEventsParams() *ArchiveEventsParams {
 
 // Archive one or more events.
 func (s *EventService) ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error) {
-	resp, err := s.cs.newRequest("archiveEvents", p.toURLValues())
+	return s.ArchiveEventsWithContext(context.Background(), p)
+}
+
+// ArchiveEventsWithContext is the same as ArchiveEvents, but uses ctx to cancel the request and any async job polling
+func (s *EventService) ArchiveEventsWithContext(ctx context.Context, p *ArchiveEventsParams) (*ArchiveEventsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "archiveEvents", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +256,8 @@ type DeleteEventsParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteEventsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteEventsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -162,6 +278,51 @@ func (p *DeleteEventsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteEventsParams) Validate() error {
+	v := newParamValidator("deleteEvents", p.p)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteEventsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteEventsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "enddate", "startdate", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteEvents", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteEvents: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteEventsParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -169,6 +330,15 @@ func (p *DeleteEventsParams) SetEnddate(v string) {
 	p.p["enddate"] = v
 }
 
+func (p *DeleteEventsParams) ResetEnddate() {
+	delete(p.p, "enddate")
+}
+
+func (p *DeleteEventsParams) GetEnddate() (string, bool) {
+	value, ok := p.p["enddate"].(string)
+	return value, ok
+}
+
 func (p *DeleteEventsParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -176,6 +346,15 @@ func (p *DeleteEventsParams) SetIds(v []string) {
 	p.p["ids"] = v
 }
 
+func (p *DeleteEventsParams) ResetIds() {
+	delete(p.p, "ids")
+}
+
+func (p *DeleteEventsParams) GetIds() ([]string, bool) {
+	value, ok := p.p["ids"].([]string)
+	return value, ok
+}
+
 func (p *DeleteEventsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -183,6 +362,15 @@ func (p *DeleteEventsParams) SetStartdate(v string) {
 	p.p["startdate"] = v
 }
 
+func (p *DeleteEventsParams) ResetStartdate() {
+	delete(p.p, "startdate")
+}
+
+func (p *DeleteEventsParams) GetStartdate() (string, bool) {
+	value, ok := p.p["startdate"].(string)
+	return value, ok
+}
+
 func (p *DeleteEventsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -190,6 +378,15 @@ func (p *DeleteEventsParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *DeleteEventsParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *DeleteEventsParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteEventsParams instance,
 // as then you are sure you have configured all required params
 func (s *EventService) NewDeleteEventsParams() *DeleteEventsParams {
@@ -200,7 +397,16 @@ func (s *EventService) NewDeleteEventsParams() *DeleteEventsParams {
 
 // Delete one or more events.
 func (s *EventService) DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error) {
-	resp, err := s.cs.newRequest("deleteEvents", p.toURLValues())
+	return s.DeleteEventsWithContext(context.Background(), p)
+}
+
+// DeleteEventsWithContext is the same as DeleteEvents, but uses ctx to cancel the request and any async job polling
+func (s *EventService) DeleteEventsWithContext(ctx context.Context, p *DeleteEventsParams) (*DeleteEventsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteEvents", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -251,7 +457,8 @@ type ListEventTypesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListEventTypesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListEventTypesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -259,6 +466,34 @@ func (p *ListEventTypesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListEventTypesParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListEventTypesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListEventTypesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k := range m {
+		return fmt.Errorf("Unknown param %s for listEventTypes", k)
+	}
+	return nil
+}
+
 // You should always use this function to get a new ListEventTypesParams instance,
 // as then you are sure you have configured all required params
 func (s *EventService) NewListEventTypesParams() *ListEventTypesParams {
@@ -269,7 +504,16 @@ func (s *EventService) NewListEventTypesParams() *ListEventTypesParams {
 
 // List Event Types
 func (s *EventService) ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error) {
-	resp, err := s.cs.newRequest("listEventTypes", p.toURLValues())
+	return s.ListEventTypesWithContext(context.Background(), p)
+}
+
+// ListEventTypesWithContext is the same as ListEventTypes, but uses ctx to cancel the request and any async job polling
+func (s *EventService) ListEventTypesWithContext(ctx context.Context, p *ListEventTypesParams) (*ListEventTypesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listEventTypes", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -297,7 +541,8 @@ type ListEventsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListEventsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListEventsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -359,6 +604,59 @@ func (p *ListEventsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListEventsParams) Validate() error {
+	v := newParamValidator("listEvents", p.p)
+	v.ids("domainid", "id", "projectid", "startid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("level", 255)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListEventsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListEventsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "enddate", "id", "keyword", "level", "projectid", "startdate", "startid", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "duration", "entrytime", "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listEvents", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listEvents: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListEventsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -366,6 +664,15 @@ func (p *ListEventsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListEventsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListEventsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -373,6 +680,15 @@ func (p *ListEventsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListEventsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListEventsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetDuration(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -380,6 +696,15 @@ func (p *ListEventsParams) SetDuration(v int) {
 	p.p["duration"] = v
 }
 
+func (p *ListEventsParams) ResetDuration() {
+	delete(p.p, "duration")
+}
+
+func (p *ListEventsParams) GetDuration() (int, bool) {
+	value, ok := p.p["duration"].(int)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -387,6 +712,15 @@ func (p *ListEventsParams) SetEnddate(v string) {
 	p.p["enddate"] = v
 }
 
+func (p *ListEventsParams) ResetEnddate() {
+	delete(p.p, "enddate")
+}
+
+func (p *ListEventsParams) GetEnddate() (string, bool) {
+	value, ok := p.p["enddate"].(string)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetEntrytime(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -394,6 +728,15 @@ func (p *ListEventsParams) SetEntrytime(v int) {
 	p.p["entrytime"] = v
 }
 
+func (p *ListEventsParams) ResetEntrytime() {
+	delete(p.p, "entrytime")
+}
+
+func (p *ListEventsParams) GetEntrytime() (int, bool) {
+	value, ok := p.p["entrytime"].(int)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -401,6 +744,15 @@ func (p *ListEventsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListEventsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListEventsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -408,6 +760,15 @@ func (p *ListEventsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListEventsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListEventsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -415,6 +776,15 @@ func (p *ListEventsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListEventsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListEventsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetLevel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -422,6 +792,15 @@ func (p *ListEventsParams) SetLevel(v string) {
 	p.p["level"] = v
 }
 
+func (p *ListEventsParams) ResetLevel() {
+	delete(p.p, "level")
+}
+
+func (p *ListEventsParams) GetLevel() (string, bool) {
+	value, ok := p.p["level"].(string)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -429,6 +808,15 @@ func (p *ListEventsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListEventsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListEventsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -436,6 +824,15 @@ func (p *ListEventsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListEventsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListEventsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -443,6 +840,15 @@ func (p *ListEventsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListEventsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListEventsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -450,6 +856,15 @@ func (p *ListEventsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListEventsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListEventsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -457,6 +872,15 @@ func (p *ListEventsParams) SetStartdate(v string) {
 	p.p["startdate"] = v
 }
 
+func (p *ListEventsParams) ResetStartdate() {
+	delete(p.p, "startdate")
+}
+
+func (p *ListEventsParams) GetStartdate() (string, bool) {
+	value, ok := p.p["startdate"].(string)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetStartid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -464,6 +888,15 @@ func (p *ListEventsParams) SetStartid(v string) {
 	p.p["startid"] = v
 }
 
+func (p *ListEventsParams) ResetStartid() {
+	delete(p.p, "startid")
+}
+
+func (p *ListEventsParams) GetStartid() (string, bool) {
+	value, ok := p.p["startid"].(string)
+	return value, ok
+}
+
 func (p *ListEventsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -471,6 +904,15 @@ func (p *ListEventsParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *ListEventsParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ListEventsParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListEventsParams instance,
 // as then you are sure you have configured all required params
 func (s *EventService) NewListEventsParams() *ListEventsParams {
@@ -481,6 +923,11 @@ func (s *EventService) NewListEventsParams() *ListEventsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *EventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int, error) {
+	return s.GetEventByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetEventByIDWithContext is the same as GetEventByID, but uses ctx to cancel the request
+func (s *EventService) GetEventByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Event, int, error) {
 	p := &ListEventsParams{}
 	p.p = make(map[string]interface{})
 
@@ -492,18 +939,16 @@ func (s *EventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int,
 		}
 	}
 
-	l, err := s.ListEvents(p)
+	l, err := s.ListEventsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -514,7 +959,16 @@ func (s *EventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int,
 
 // A command to list events.
 func (s *EventService) ListEvents(p *ListEventsParams) (*ListEventsResponse, error) {
-	resp, err := s.cs.newRequest("listEvents", p.toURLValues())
+	return s.ListEventsWithContext(context.Background(), p)
+}
+
+// ListEventsWithContext is the same as ListEvents, but uses ctx to cancel the request and any async job polling
+func (s *EventService) ListEventsWithContext(ctx context.Context, p *ListEventsParams) (*ListEventsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listEvents", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -527,6 +981,92 @@ func (s *EventService) ListEvents(p *ListEventsParams) (*ListEventsResponse, err
 	return &r, nil
 }
 
+// ListEventsPager iterates over all pages of a ListEvents call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListEventsPager struct {
+	pager
+
+	s *EventService
+	p *ListEventsParams
+	r *ListEventsResponse
+}
+
+// NewListEventsPager returns a pager for all pages of a ListEvents call with the given params
+func (s *EventService) NewListEventsPager(p *ListEventsParams) *ListEventsPager {
+	return &ListEventsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListEventsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListEventsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListEventsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListEventsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Events), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListEventsPager) Page() *ListEventsResponse {
+	return pg.r
+}
+
+// ListEventsAll fetches all pages of a ListEvents call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *EventService) ListEventsAll(p *ListEventsParams) (*ListEventsResponse, error) {
+	return s.ListEventsAllWithContext(context.Background(), p)
+}
+
+// ListEventsAllWithContext is the same as ListEventsAll, but uses ctx to cancel the requests
+func (s *EventService) ListEventsAllWithContext(ctx context.Context, p *ListEventsParams) (*ListEventsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Event)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListEventsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListEventsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Events
+		mu.Unlock()
+
+		return len(l.Events), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListEventsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Events = append(r.Events, pages[page]...)
+	}
+	r.Count = len(r.Events)
+
+	return r, nil
+}
+
 type ListEventsResponse struct {
 	Count  int      `json:"count"`
 	Events []*Event `json:"event"`
@@ -20,13 +20,175 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// FirewallServiceIface is the interface implemented by FirewallService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type FirewallServiceIface interface {
+	NewAddCiscoAsa1000vResourceParams(clusterid string, hostname string, insideportprofile string, physicalnetworkid string) *AddCiscoAsa1000vResourceParams
+	AddCiscoAsa1000vResource(p *AddCiscoAsa1000vResourceParams) (*AddCiscoAsa1000vResourceResponse, error)
+	AddCiscoAsa1000vResourceWithContext(ctx context.Context, p *AddCiscoAsa1000vResourceParams) (*AddCiscoAsa1000vResourceResponse, error)
+	NewAddCiscoVnmcResourceParams(hostname string, password string, physicalnetworkid string, username string) *AddCiscoVnmcResourceParams
+	AddCiscoVnmcResource(p *AddCiscoVnmcResourceParams) (*AddCiscoVnmcResourceResponse, error)
+	AddCiscoVnmcResourceWithContext(ctx context.Context, p *AddCiscoVnmcResourceParams) (*AddCiscoVnmcResourceResponse, error)
+	NewAddExternalFirewallParams(password string, url string, username string, zoneid string) *AddExternalFirewallParams
+	AddExternalFirewall(p *AddExternalFirewallParams) (*AddExternalFirewallResponse, error)
+	AddExternalFirewallWithContext(ctx context.Context, p *AddExternalFirewallParams) (*AddExternalFirewallResponse, error)
+	NewAddPaloAltoFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddPaloAltoFirewallParams
+	AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error)
+	AddPaloAltoFirewallWithContext(ctx context.Context, p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error)
+	AddPaloAltoFirewallAsync(p *AddPaloAltoFirewallParams) (*Job, error)
+	AddPaloAltoFirewallAsyncWithContext(ctx context.Context, p *AddPaloAltoFirewallParams) (*Job, error)
+	NewAddSrxFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddSrxFirewallParams
+	AddSrxFirewall(p *AddSrxFirewallParams) (*AddSrxFirewallResponse, error)
+	AddSrxFirewallWithContext(ctx context.Context, p *AddSrxFirewallParams) (*AddSrxFirewallResponse, error)
+	AddSrxFirewallAsync(p *AddSrxFirewallParams) (*Job, error)
+	AddSrxFirewallAsyncWithContext(ctx context.Context, p *AddSrxFirewallParams) (*Job, error)
+	NewConfigurePaloAltoFirewallParams(fwdeviceid string) *ConfigurePaloAltoFirewallParams
+	ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error)
+	ConfigurePaloAltoFirewallWithContext(ctx context.Context, p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error)
+	ConfigurePaloAltoFirewallAsync(p *ConfigurePaloAltoFirewallParams) (*Job, error)
+	ConfigurePaloAltoFirewallAsyncWithContext(ctx context.Context, p *ConfigurePaloAltoFirewallParams) (*Job, error)
+	NewConfigureSrxFirewallParams(fwdeviceid string) *ConfigureSrxFirewallParams
+	ConfigureSrxFirewall(p *ConfigureSrxFirewallParams) (*SrxFirewallResponse, error)
+	ConfigureSrxFirewallWithContext(ctx context.Context, p *ConfigureSrxFirewallParams) (*SrxFirewallResponse, error)
+	ConfigureSrxFirewallAsync(p *ConfigureSrxFirewallParams) (*Job, error)
+	ConfigureSrxFirewallAsyncWithContext(ctx context.Context, p *ConfigureSrxFirewallParams) (*Job, error)
+	NewCreateEgressFirewallRuleParams(networkid string, protocol string) *CreateEgressFirewallRuleParams
+	CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error)
+	CreateEgressFirewallRuleWithContext(ctx context.Context, p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error)
+	CreateEgressFirewallRuleAsync(p *CreateEgressFirewallRuleParams) (*Job, error)
+	CreateEgressFirewallRuleAsyncWithContext(ctx context.Context, p *CreateEgressFirewallRuleParams) (*Job, error)
+	NewCreateFirewallRuleParams(ipaddressid string, protocol string) *CreateFirewallRuleParams
+	CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error)
+	CreateFirewallRuleWithContext(ctx context.Context, p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error)
+	CreateFirewallRuleAsync(p *CreateFirewallRuleParams) (*Job, error)
+	CreateFirewallRuleAsyncWithContext(ctx context.Context, p *CreateFirewallRuleParams) (*Job, error)
+	NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams
+	CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error)
+	CreatePortForwardingRuleWithContext(ctx context.Context, p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error)
+	CreatePortForwardingRuleAsync(p *CreatePortForwardingRuleParams) (*Job, error)
+	CreatePortForwardingRuleAsyncWithContext(ctx context.Context, p *CreatePortForwardingRuleParams) (*Job, error)
+	NewDeleteCiscoAsa1000vResourceParams(resourceid string) *DeleteCiscoAsa1000vResourceParams
+	DeleteCiscoAsa1000vResource(p *DeleteCiscoAsa1000vResourceParams) (*DeleteCiscoAsa1000vResourceResponse, error)
+	DeleteCiscoAsa1000vResourceWithContext(ctx context.Context, p *DeleteCiscoAsa1000vResourceParams) (*DeleteCiscoAsa1000vResourceResponse, error)
+	NewDeleteCiscoVnmcResourceParams(resourceid string) *DeleteCiscoVnmcResourceParams
+	DeleteCiscoVnmcResource(p *DeleteCiscoVnmcResourceParams) (*DeleteCiscoVnmcResourceResponse, error)
+	DeleteCiscoVnmcResourceWithContext(ctx context.Context, p *DeleteCiscoVnmcResourceParams) (*DeleteCiscoVnmcResourceResponse, error)
+	NewDeleteEgressFirewallRuleParams(id string) *DeleteEgressFirewallRuleParams
+	DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error)
+	DeleteEgressFirewallRuleWithContext(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error)
+	DeleteEgressFirewallRuleAsync(p *DeleteEgressFirewallRuleParams) (*Job, error)
+	DeleteEgressFirewallRuleAsyncWithContext(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*Job, error)
+	NewDeleteExternalFirewallParams(id string) *DeleteExternalFirewallParams
+	DeleteExternalFirewall(p *DeleteExternalFirewallParams) (*DeleteExternalFirewallResponse, error)
+	DeleteExternalFirewallWithContext(ctx context.Context, p *DeleteExternalFirewallParams) (*DeleteExternalFirewallResponse, error)
+	NewDeleteFirewallRuleParams(id string) *DeleteFirewallRuleParams
+	DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error)
+	DeleteFirewallRuleWithContext(ctx context.Context, p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error)
+	DeleteFirewallRuleAsync(p *DeleteFirewallRuleParams) (*Job, error)
+	DeleteFirewallRuleAsyncWithContext(ctx context.Context, p *DeleteFirewallRuleParams) (*Job, error)
+	NewDeletePaloAltoFirewallParams(fwdeviceid string) *DeletePaloAltoFirewallParams
+	DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error)
+	DeletePaloAltoFirewallWithContext(ctx context.Context, p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error)
+	DeletePaloAltoFirewallAsync(p *DeletePaloAltoFirewallParams) (*Job, error)
+	DeletePaloAltoFirewallAsyncWithContext(ctx context.Context, p *DeletePaloAltoFirewallParams) (*Job, error)
+	NewDeletePortForwardingRuleParams(id string) *DeletePortForwardingRuleParams
+	DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error)
+	DeletePortForwardingRuleWithContext(ctx context.Context, p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error)
+	DeletePortForwardingRuleAsync(p *DeletePortForwardingRuleParams) (*Job, error)
+	DeletePortForwardingRuleAsyncWithContext(ctx context.Context, p *DeletePortForwardingRuleParams) (*Job, error)
+	NewDeleteSrxFirewallParams(fwdeviceid string) *DeleteSrxFirewallParams
+	DeleteSrxFirewall(p *DeleteSrxFirewallParams) (*DeleteSrxFirewallResponse, error)
+	DeleteSrxFirewallWithContext(ctx context.Context, p *DeleteSrxFirewallParams) (*DeleteSrxFirewallResponse, error)
+	DeleteSrxFirewallAsync(p *DeleteSrxFirewallParams) (*Job, error)
+	DeleteSrxFirewallAsyncWithContext(ctx context.Context, p *DeleteSrxFirewallParams) (*Job, error)
+	NewListCiscoAsa1000vResourcesParams() *ListCiscoAsa1000vResourcesParams
+	ListCiscoAsa1000vResources(p *ListCiscoAsa1000vResourcesParams) (*ListCiscoAsa1000vResourcesResponse, error)
+	ListCiscoAsa1000vResourcesWithContext(ctx context.Context, p *ListCiscoAsa1000vResourcesParams) (*ListCiscoAsa1000vResourcesResponse, error)
+	NewListCiscoAsa1000vResourcesPager(p *ListCiscoAsa1000vResourcesParams) *ListCiscoAsa1000vResourcesPager
+	ListCiscoAsa1000vResourcesAll(p *ListCiscoAsa1000vResourcesParams) (*ListCiscoAsa1000vResourcesResponse, error)
+	ListCiscoAsa1000vResourcesAllWithContext(ctx context.Context, p *ListCiscoAsa1000vResourcesParams) (*ListCiscoAsa1000vResourcesResponse, error)
+	NewListCiscoVnmcResourcesParams() *ListCiscoVnmcResourcesParams
+	ListCiscoVnmcResources(p *ListCiscoVnmcResourcesParams) (*ListCiscoVnmcResourcesResponse, error)
+	ListCiscoVnmcResourcesWithContext(ctx context.Context, p *ListCiscoVnmcResourcesParams) (*ListCiscoVnmcResourcesResponse, error)
+	NewListCiscoVnmcResourcesPager(p *ListCiscoVnmcResourcesParams) *ListCiscoVnmcResourcesPager
+	ListCiscoVnmcResourcesAll(p *ListCiscoVnmcResourcesParams) (*ListCiscoVnmcResourcesResponse, error)
+	ListCiscoVnmcResourcesAllWithContext(ctx context.Context, p *ListCiscoVnmcResourcesParams) (*ListCiscoVnmcResourcesResponse, error)
+	NewListEgressFirewallRulesParams() *ListEgressFirewallRulesParams
+	GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error)
+	GetEgressFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*EgressFirewallRule, int, error)
+	ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error)
+	ListEgressFirewallRulesWithContext(ctx context.Context, p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error)
+	NewListEgressFirewallRulesPager(p *ListEgressFirewallRulesParams) *ListEgressFirewallRulesPager
+	ListEgressFirewallRulesAll(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error)
+	ListEgressFirewallRulesAllWithContext(ctx context.Context, p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error)
+	NewListExternalFirewallsParams(zoneid string) *ListExternalFirewallsParams
+	ListExternalFirewalls(p *ListExternalFirewallsParams) (*ListExternalFirewallsResponse, error)
+	ListExternalFirewallsWithContext(ctx context.Context, p *ListExternalFirewallsParams) (*ListExternalFirewallsResponse, error)
+	NewListExternalFirewallsPager(p *ListExternalFirewallsParams) *ListExternalFirewallsPager
+	ListExternalFirewallsAll(p *ListExternalFirewallsParams) (*ListExternalFirewallsResponse, error)
+	ListExternalFirewallsAllWithContext(ctx context.Context, p *ListExternalFirewallsParams) (*ListExternalFirewallsResponse, error)
+	NewListFirewallRulesParams() *ListFirewallRulesParams
+	GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error)
+	GetFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*FirewallRule, int, error)
+	ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error)
+	ListFirewallRulesWithContext(ctx context.Context, p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error)
+	NewListFirewallRulesPager(p *ListFirewallRulesParams) *ListFirewallRulesPager
+	ListFirewallRulesAll(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error)
+	ListFirewallRulesAllWithContext(ctx context.Context, p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error)
+	NewListPaloAltoFirewallsParams() *ListPaloAltoFirewallsParams
+	ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error)
+	ListPaloAltoFirewallsWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error)
+	NewListPaloAltoFirewallsPager(p *ListPaloAltoFirewallsParams) *ListPaloAltoFirewallsPager
+	ListPaloAltoFirewallsAll(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error)
+	ListPaloAltoFirewallsAllWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error)
+	NewListPortForwardingRulesParams() *ListPortForwardingRulesParams
+	GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error)
+	GetPortForwardingRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PortForwardingRule, int, error)
+	ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error)
+	ListPortForwardingRulesWithContext(ctx context.Context, p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error)
+	NewListPortForwardingRulesPager(p *ListPortForwardingRulesParams) *ListPortForwardingRulesPager
+	ListPortForwardingRulesAll(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error)
+	ListPortForwardingRulesAllWithContext(ctx context.Context, p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error)
+	NewListSrxFirewallNetworksParams(lbdeviceid string) *ListSrxFirewallNetworksParams
+	GetSrxFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetSrxFirewallNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	ListSrxFirewallNetworks(p *ListSrxFirewallNetworksParams) (*ListSrxFirewallNetworksResponse, error)
+	ListSrxFirewallNetworksWithContext(ctx context.Context, p *ListSrxFirewallNetworksParams) (*ListSrxFirewallNetworksResponse, error)
+	NewListSrxFirewallNetworksPager(p *ListSrxFirewallNetworksParams) *ListSrxFirewallNetworksPager
+	ListSrxFirewallNetworksAll(p *ListSrxFirewallNetworksParams) (*ListSrxFirewallNetworksResponse, error)
+	ListSrxFirewallNetworksAllWithContext(ctx context.Context, p *ListSrxFirewallNetworksParams) (*ListSrxFirewallNetworksResponse, error)
+	NewListSrxFirewallsParams() *ListSrxFirewallsParams
+	ListSrxFirewalls(p *ListSrxFirewallsParams) (*ListSrxFirewallsResponse, error)
+	ListSrxFirewallsWithContext(ctx context.Context, p *ListSrxFirewallsParams) (*ListSrxFirewallsResponse, error)
+	NewListSrxFirewallsPager(p *ListSrxFirewallsParams) *ListSrxFirewallsPager
+	ListSrxFirewallsAll(p *ListSrxFirewallsParams) (*ListSrxFirewallsResponse, error)
+	ListSrxFirewallsAllWithContext(ctx context.Context, p *ListSrxFirewallsParams) (*ListSrxFirewallsResponse, error)
+	NewUpdateEgressFirewallRuleParams(id string) *UpdateEgressFirewallRuleParams
+	UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error)
+	UpdateEgressFirewallRuleWithContext(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error)
+	UpdateEgressFirewallRuleAsync(p *UpdateEgressFirewallRuleParams) (*Job, error)
+	UpdateEgressFirewallRuleAsyncWithContext(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*Job, error)
+	NewUpdateFirewallRuleParams(id string) *UpdateFirewallRuleParams
+	UpdateFirewallRule(p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error)
+	UpdateFirewallRuleWithContext(ctx context.Context, p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error)
+	UpdateFirewallRuleAsync(p *UpdateFirewallRuleParams) (*Job, error)
+	UpdateFirewallRuleAsyncWithContext(ctx context.Context, p *UpdateFirewallRuleParams) (*Job, error)
+	NewUpdatePortForwardingRuleParams(id string) *UpdatePortForwardingRuleParams
+	UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error)
+	UpdatePortForwardingRuleWithContext(ctx context.Context, p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error)
+	UpdatePortForwardingRuleAsync(p *UpdatePortForwardingRuleParams) (*Job, error)
+	UpdatePortForwardingRuleAsyncWithContext(ctx context.Context, p *UpdatePortForwardingRuleParams) (*Job, error)
+}
+
 // Helper function for maintaining backwards compatibility
 func convertFirewallServiceResponse(b []byte) ([]byte, error) {
 	var raw map[string]interface{}
@@ -77,566 +239,747 @@ func convertFirewallServiceListResponse(b []byte) ([]byte, error) {
 	return json.Marshal(rawList)
 }
 
-type AddPaloAltoFirewallParams struct {
+type AddCiscoAsa1000vResourceParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddPaloAltoFirewallParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddCiscoAsa1000vResourceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["networkdevicetype"]; found {
-		u.Set("networkdevicetype", v.(string))
+	if v, found := p.p["clusterid"]; found {
+		u.Set("clusterid", v.(string))
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if v, found := p.p["hostname"]; found {
+		u.Set("hostname", v.(string))
+	}
+	if v, found := p.p["insideportprofile"]; found {
+		u.Set("insideportprofile", v.(string))
 	}
 	if v, found := p.p["physicalnetworkid"]; found {
 		u.Set("physicalnetworkid", v.(string))
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
-	}
 	return u
 }
 
-func (p *AddPaloAltoFirewallParams) SetNetworkdevicetype(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddCiscoAsa1000vResourceParams) Validate() error {
+	v := newParamValidator("addCiscoAsa1000vResource", p.p)
+	v.required("clusterid", "hostname", "insideportprofile", "physicalnetworkid")
+	v.ids("clusterid", "physicalnetworkid")
+	v.maxLength("hostname", 255)
+	v.maxLength("insideportprofile", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddCiscoAsa1000vResourceParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["networkdevicetype"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *AddPaloAltoFirewallParams) SetPassword(v string) {
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddCiscoAsa1000vResourceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid", "hostname", "insideportprofile", "physicalnetworkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addCiscoAsa1000vResource", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addCiscoAsa1000vResource: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *AddCiscoAsa1000vResourceParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["password"] = v
+	p.p["clusterid"] = v
 }
 
-func (p *AddPaloAltoFirewallParams) SetPhysicalnetworkid(v string) {
+func (p *AddCiscoAsa1000vResourceParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *AddCiscoAsa1000vResourceParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
+func (p *AddCiscoAsa1000vResourceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["physicalnetworkid"] = v
+	p.p["hostname"] = v
 }
 
-func (p *AddPaloAltoFirewallParams) SetUrl(v string) {
+func (p *AddCiscoAsa1000vResourceParams) ResetHostname() {
+	delete(p.p, "hostname")
+}
+
+func (p *AddCiscoAsa1000vResourceParams) GetHostname() (string, bool) {
+	value, ok := p.p["hostname"].(string)
+	return value, ok
+}
+
+func (p *AddCiscoAsa1000vResourceParams) SetInsideportprofile(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["url"] = v
+	p.p["insideportprofile"] = v
 }
 
-func (p *AddPaloAltoFirewallParams) SetUsername(v string) {
+func (p *AddCiscoAsa1000vResourceParams) ResetInsideportprofile() {
+	delete(p.p, "insideportprofile")
+}
+
+func (p *AddCiscoAsa1000vResourceParams) GetInsideportprofile() (string, bool) {
+	value, ok := p.p["insideportprofile"].(string)
+	return value, ok
+}
+
+func (p *AddCiscoAsa1000vResourceParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["username"] = v
+	p.p["physicalnetworkid"] = v
 }
 
-// You should always use this function to get a new AddPaloAltoFirewallParams instance,
+func (p *AddCiscoAsa1000vResourceParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddCiscoAsa1000vResourceParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AddCiscoAsa1000vResourceParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewAddPaloAltoFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddPaloAltoFirewallParams {
-	p := &AddPaloAltoFirewallParams{}
+func (s *FirewallService) NewAddCiscoAsa1000vResourceParams(clusterid string, hostname string, insideportprofile string, physicalnetworkid string) *AddCiscoAsa1000vResourceParams {
+	p := &AddCiscoAsa1000vResourceParams{}
 	p.p = make(map[string]interface{})
-	p.p["networkdevicetype"] = networkdevicetype
-	p.p["password"] = password
+	p.p["clusterid"] = clusterid
+	p.p["hostname"] = hostname
+	p.p["insideportprofile"] = insideportprofile
 	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["url"] = url
-	p.p["username"] = username
 	return p
 }
 
-// Adds a Palo Alto firewall device
-func (s *FirewallService) AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newRequest("addPaloAltoFirewall", p.toURLValues())
-	if err != nil {
+// Adds a Cisco Asa 1000v appliance
+func (s *FirewallService) AddCiscoAsa1000vResource(p *AddCiscoAsa1000vResourceParams) (*AddCiscoAsa1000vResourceResponse, error) {
+	return s.AddCiscoAsa1000vResourceWithContext(context.Background(), p)
+}
+
+// AddCiscoAsa1000vResourceWithContext is the same as AddCiscoAsa1000vResource, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) AddCiscoAsa1000vResourceWithContext(ctx context.Context, p *AddCiscoAsa1000vResourceParams) (*AddCiscoAsa1000vResourceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
 		return nil, err
 	}
 
-	var r AddPaloAltoFirewallResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequestWithContext(ctx, "addCiscoAsa1000vResource", p.ToURLValues())
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	resp, err = convertFirewallServiceResponse(resp)
+	if err != nil {
+		return nil, err
+	}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	var r AddCiscoAsa1000vResourceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
 
 	return &r, nil
 }
 
-type AddPaloAltoFirewallResponse struct {
-	Fwdevicecapacity  int64  `json:"fwdevicecapacity"`
-	Fwdeviceid        string `json:"fwdeviceid"`
-	Fwdevicename      string `json:"fwdevicename"`
-	Fwdevicestate     string `json:"fwdevicestate"`
-	Ipaddress         string `json:"ipaddress"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Numretries        string `json:"numretries"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Privateinterface  string `json:"privateinterface"`
-	Privatezone       string `json:"privatezone"`
-	Provider          string `json:"provider"`
-	Publicinterface   string `json:"publicinterface"`
-	Publiczone        string `json:"publiczone"`
-	Timeout           string `json:"timeout"`
-	Usageinterface    string `json:"usageinterface"`
-	Username          string `json:"username"`
-	Zoneid            string `json:"zoneid"`
+type AddCiscoAsa1000vResourceResponse struct {
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
 }
 
-type ConfigurePaloAltoFirewallParams struct {
+type AddCiscoVnmcResourceParams struct {
 	p map[string]interface{}
 }
 
-func (p *ConfigurePaloAltoFirewallParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddCiscoVnmcResourceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["fwdevicecapacity"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("fwdevicecapacity", vv)
+	if v, found := p.p["hostname"]; found {
+		u.Set("hostname", v.(string))
 	}
-	if v, found := p.p["fwdeviceid"]; found {
-		u.Set("fwdeviceid", v.(string))
+	if v, found := p.p["password"]; found {
+		u.Set("password", v.(string))
+	}
+	if v, found := p.p["physicalnetworkid"]; found {
+		u.Set("physicalnetworkid", v.(string))
+	}
+	if v, found := p.p["username"]; found {
+		u.Set("username", v.(string))
 	}
 	return u
 }
 
-func (p *ConfigurePaloAltoFirewallParams) SetFwdevicecapacity(v int64) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddCiscoVnmcResourceParams) Validate() error {
+	v := newParamValidator("addCiscoVnmcResource", p.p)
+	v.required("hostname", "password", "physicalnetworkid", "username")
+	v.ids("physicalnetworkid")
+	v.maxLength("hostname", 255)
+	v.maxLength("password", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddCiscoVnmcResourceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddCiscoVnmcResourceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostname", "password", "physicalnetworkid", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addCiscoVnmcResource", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addCiscoVnmcResource: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *AddCiscoVnmcResourceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["fwdevicecapacity"] = v
+	p.p["hostname"] = v
 }
 
-func (p *ConfigurePaloAltoFirewallParams) SetFwdeviceid(v string) {
+func (p *AddCiscoVnmcResourceParams) ResetHostname() {
+	delete(p.p, "hostname")
+}
+
+func (p *AddCiscoVnmcResourceParams) GetHostname() (string, bool) {
+	value, ok := p.p["hostname"].(string)
+	return value, ok
+}
+
+func (p *AddCiscoVnmcResourceParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["fwdeviceid"] = v
+	p.p["password"] = v
 }
 
-// You should always use this function to get a new ConfigurePaloAltoFirewallParams instance,
+func (p *AddCiscoVnmcResourceParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddCiscoVnmcResourceParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
+func (p *AddCiscoVnmcResourceParams) SetPhysicalnetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["physicalnetworkid"] = v
+}
+
+func (p *AddCiscoVnmcResourceParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddCiscoVnmcResourceParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
+func (p *AddCiscoVnmcResourceParams) SetUsername(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["username"] = v
+}
+
+func (p *AddCiscoVnmcResourceParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddCiscoVnmcResourceParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AddCiscoVnmcResourceParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewConfigurePaloAltoFirewallParams(fwdeviceid string) *ConfigurePaloAltoFirewallParams {
-	p := &ConfigurePaloAltoFirewallParams{}
+func (s *FirewallService) NewAddCiscoVnmcResourceParams(hostname string, password string, physicalnetworkid string, username string) *AddCiscoVnmcResourceParams {
+	p := &AddCiscoVnmcResourceParams{}
 	p.p = make(map[string]interface{})
-	p.p["fwdeviceid"] = fwdeviceid
+	p.p["hostname"] = hostname
+	p.p["password"] = password
+	p.p["physicalnetworkid"] = physicalnetworkid
+	p.p["username"] = username
 	return p
 }
 
-// Configures a Palo Alto firewall device
-func (s *FirewallService) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newRequest("configurePaloAltoFirewall", p.toURLValues())
-	if err != nil {
+// Adds a Cisco Vnmc Controller
+func (s *FirewallService) AddCiscoVnmcResource(p *AddCiscoVnmcResourceParams) (*AddCiscoVnmcResourceResponse, error) {
+	return s.AddCiscoVnmcResourceWithContext(context.Background(), p)
+}
+
+// AddCiscoVnmcResourceWithContext is the same as AddCiscoVnmcResource, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) AddCiscoVnmcResourceWithContext(ctx context.Context, p *AddCiscoVnmcResourceParams) (*AddCiscoVnmcResourceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
 		return nil, err
 	}
 
-	var r PaloAltoFirewallResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequestWithContext(ctx, "addCiscoVnmcResource", p.ToURLValues())
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	resp, err = convertFirewallServiceResponse(resp)
+	if err != nil {
+		return nil, err
+	}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	var r AddCiscoVnmcResourceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
 
 	return &r, nil
 }
 
-type PaloAltoFirewallResponse struct {
-	Fwdevicecapacity  int64  `json:"fwdevicecapacity"`
-	Fwdeviceid        string `json:"fwdeviceid"`
-	Fwdevicename      string `json:"fwdevicename"`
-	Fwdevicestate     string `json:"fwdevicestate"`
-	Ipaddress         string `json:"ipaddress"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Numretries        string `json:"numretries"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Privateinterface  string `json:"privateinterface"`
-	Privatezone       string `json:"privatezone"`
-	Provider          string `json:"provider"`
-	Publicinterface   string `json:"publicinterface"`
-	Publiczone        string `json:"publiczone"`
-	Timeout           string `json:"timeout"`
-	Usageinterface    string `json:"usageinterface"`
-	Username          string `json:"username"`
-	Zoneid            string `json:"zoneid"`
+type AddCiscoVnmcResourceResponse struct {
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
 }
 
-type CreateEgressFirewallRuleParams struct {
+type AddExternalFirewallParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateEgressFirewallRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddExternalFirewallParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
+	if v, found := p.p["password"]; found {
+		u.Set("password", v.(string))
 	}
-	if v, found := p.p["destcidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("destcidrlist", vv)
+	if v, found := p.p["url"]; found {
+		u.Set("url", v.(string))
 	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
+	if v, found := p.p["username"]; found {
+		u.Set("username", v.(string))
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmpcode", vv)
-	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmptype", vv)
-	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
-	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
-	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
-	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
 	}
 	return u
 }
 
-func (p *CreateEgressFirewallRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddExternalFirewallParams) Validate() error {
+	v := newParamValidator("addExternalFirewall", p.p)
+	v.required("password", "url", "username", "zoneid")
+	v.ids("zoneid")
+	v.maxLength("password", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
 }
 
-func (p *CreateEgressFirewallRuleParams) SetDestcidrlist(v []string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddExternalFirewallParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["destcidrlist"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *CreateEgressFirewallRuleParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddExternalFirewallParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["endport"] = v
-}
 
-func (p *CreateEgressFirewallRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "password", "url", "username", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addExternalFirewall", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addExternalFirewall: %w", k, err)
+		}
 	}
-	p.p["fordisplay"] = v
+	return nil
 }
 
-func (p *CreateEgressFirewallRuleParams) SetIcmpcode(v int) {
+func (p *AddExternalFirewallParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["icmpcode"] = v
+	p.p["password"] = v
 }
 
-func (p *CreateEgressFirewallRuleParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmptype"] = v
+func (p *AddExternalFirewallParams) ResetPassword() {
+	delete(p.p, "password")
 }
 
-func (p *CreateEgressFirewallRuleParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+func (p *AddExternalFirewallParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
 }
 
-func (p *CreateEgressFirewallRuleParams) SetProtocol(v string) {
+func (p *AddExternalFirewallParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["protocol"] = v
+	p.p["url"] = v
 }
 
-func (p *CreateEgressFirewallRuleParams) SetStartport(v int) {
+func (p *AddExternalFirewallParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddExternalFirewallParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
+func (p *AddExternalFirewallParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["startport"] = v
+	p.p["username"] = v
 }
 
-func (p *CreateEgressFirewallRuleParams) SetType(v string) {
+func (p *AddExternalFirewallParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddExternalFirewallParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
+func (p *AddExternalFirewallParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["type"] = v
+	p.p["zoneid"] = v
 }
 
-// You should always use this function to get a new CreateEgressFirewallRuleParams instance,
+func (p *AddExternalFirewallParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *AddExternalFirewallParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AddExternalFirewallParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewCreateEgressFirewallRuleParams(networkid string, protocol string) *CreateEgressFirewallRuleParams {
-	p := &CreateEgressFirewallRuleParams{}
+func (s *FirewallService) NewAddExternalFirewallParams(password string, url string, username string, zoneid string) *AddExternalFirewallParams {
+	p := &AddExternalFirewallParams{}
 	p.p = make(map[string]interface{})
-	p.p["networkid"] = networkid
-	p.p["protocol"] = protocol
+	p.p["password"] = password
+	p.p["url"] = url
+	p.p["username"] = username
+	p.p["zoneid"] = zoneid
 	return p
 }
 
-// Creates a egress firewall rule for a given network
-func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("createEgressFirewallRule", p.toURLValues())
-	if err != nil {
+// Adds an external firewall appliance
+func (s *FirewallService) AddExternalFirewall(p *AddExternalFirewallParams) (*AddExternalFirewallResponse, error) {
+	return s.AddExternalFirewallWithContext(context.Background(), p)
+}
+
+// AddExternalFirewallWithContext is the same as AddExternalFirewall, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) AddExternalFirewallWithContext(ctx context.Context, p *AddExternalFirewallParams) (*AddExternalFirewallResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
 		return nil, err
 	}
 
-	var r CreateEgressFirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequestWithContext(ctx, "addExternalFirewall", p.ToURLValues())
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	resp, err = convertFirewallServiceResponse(resp)
+	if err != nil {
+		return nil, err
+	}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	var r AddExternalFirewallResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
 
 	return &r, nil
 }
 
-type CreateEgressFirewallRuleResponse struct {
-	Cidrlist     string `json:"cidrlist"`
-	Destcidrlist string `json:"destcidrlist"`
-	Endport      int    `json:"endport"`
-	Fordisplay   bool   `json:"fordisplay"`
-	Icmpcode     int    `json:"icmpcode"`
-	Icmptype     int    `json:"icmptype"`
-	Id           string `json:"id"`
-	Ipaddress    string `json:"ipaddress"`
-	Ipaddressid  string `json:"ipaddressid"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Networkid    string `json:"networkid"`
-	Protocol     string `json:"protocol"`
-	Startport    int    `json:"startport"`
-	State        string `json:"state"`
-	Tags         []Tags `json:"tags"`
+type AddExternalFirewallResponse struct {
+	Id               string `json:"id"`
+	Ipaddress        string `json:"ipaddress"`
+	JobID            string `json:"jobid"`
+	Jobstatus        int    `json:"jobstatus"`
+	Numretries       string `json:"numretries"`
+	Privateinterface string `json:"privateinterface"`
+	Privatezone      string `json:"privatezone"`
+	Publicinterface  string `json:"publicinterface"`
+	Publiczone       string `json:"publiczone"`
+	Timeout          string `json:"timeout"`
+	Usageinterface   string `json:"usageinterface"`
+	Username         string `json:"username"`
+	Zoneid           string `json:"zoneid"`
 }
 
-type CreateFirewallRuleParams struct {
+type AddPaloAltoFirewallParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateFirewallRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddPaloAltoFirewallParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
-	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmpcode", vv)
-	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmptype", vv)
+	if v, found := p.p["networkdevicetype"]; found {
+		u.Set("networkdevicetype", v.(string))
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+	if v, found := p.p["password"]; found {
+		u.Set("password", v.(string))
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if v, found := p.p["physicalnetworkid"]; found {
+		u.Set("physicalnetworkid", v.(string))
 	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
+	if v, found := p.p["url"]; found {
+		u.Set("url", v.(string))
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if v, found := p.p["username"]; found {
+		u.Set("username", v.(string))
 	}
 	return u
 }
 
-func (p *CreateFirewallRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddPaloAltoFirewallParams) Validate() error {
+	v := newParamValidator("addPaloAltoFirewall", p.p)
+	v.required("networkdevicetype", "password", "physicalnetworkid", "url", "username")
+	v.ids("physicalnetworkid")
+	v.maxLength("networkdevicetype", 255)
+	v.maxLength("password", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
 }
 
-func (p *CreateFirewallRuleParams) SetEndport(v int) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddPaloAltoFirewallParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["endport"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *CreateFirewallRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddPaloAltoFirewallParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["fordisplay"] = v
-}
 
-func (p *CreateFirewallRuleParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "networkdevicetype", "password", "physicalnetworkid", "url", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addPaloAltoFirewall", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addPaloAltoFirewall: %w", k, err)
+		}
 	}
-	p.p["icmpcode"] = v
+	return nil
 }
 
-func (p *CreateFirewallRuleParams) SetIcmptype(v int) {
+func (p *AddPaloAltoFirewallParams) SetNetworkdevicetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["icmptype"] = v
+	p.p["networkdevicetype"] = v
 }
 
-func (p *CreateFirewallRuleParams) SetIpaddressid(v string) {
+func (p *AddPaloAltoFirewallParams) ResetNetworkdevicetype() {
+	delete(p.p, "networkdevicetype")
+}
+
+func (p *AddPaloAltoFirewallParams) GetNetworkdevicetype() (string, bool) {
+	value, ok := p.p["networkdevicetype"].(string)
+	return value, ok
+}
+
+func (p *AddPaloAltoFirewallParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["ipaddressid"] = v
+	p.p["password"] = v
 }
 
-func (p *CreateFirewallRuleParams) SetProtocol(v string) {
+func (p *AddPaloAltoFirewallParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddPaloAltoFirewallParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
+func (p *AddPaloAltoFirewallParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["protocol"] = v
+	p.p["physicalnetworkid"] = v
 }
 
-func (p *CreateFirewallRuleParams) SetStartport(v int) {
+func (p *AddPaloAltoFirewallParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddPaloAltoFirewallParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
+func (p *AddPaloAltoFirewallParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["startport"] = v
+	p.p["url"] = v
 }
 
-func (p *CreateFirewallRuleParams) SetType(v string) {
+func (p *AddPaloAltoFirewallParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddPaloAltoFirewallParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
+func (p *AddPaloAltoFirewallParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["type"] = v
+	p.p["username"] = v
 }
 
-// You should always use this function to get a new CreateFirewallRuleParams instance,
+func (p *AddPaloAltoFirewallParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddPaloAltoFirewallParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AddPaloAltoFirewallParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewCreateFirewallRuleParams(ipaddressid string, protocol string) *CreateFirewallRuleParams {
-	p := &CreateFirewallRuleParams{}
+func (s *FirewallService) NewAddPaloAltoFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddPaloAltoFirewallParams {
+	p := &AddPaloAltoFirewallParams{}
 	p.p = make(map[string]interface{})
-	p.p["ipaddressid"] = ipaddressid
-	p.p["protocol"] = protocol
+	p.p["networkdevicetype"] = networkdevicetype
+	p.p["password"] = password
+	p.p["physicalnetworkid"] = physicalnetworkid
+	p.p["url"] = url
+	p.p["username"] = username
 	return p
 }
 
-// Creates a firewall rule for a given IP address
-func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("createFirewallRule", p.toURLValues())
+// Adds a Palo Alto firewall device
+func (s *FirewallService) AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error) {
+	return s.AddPaloAltoFirewallWithContext(context.Background(), p)
+}
+
+// AddPaloAltoFirewallWithContext is the same as AddPaloAltoFirewall, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) AddPaloAltoFirewallWithContext(ctx context.Context, p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addPaloAltoFirewall", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateFirewallRuleResponse
+	var r AddPaloAltoFirewallResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -662,192 +1005,257 @@ func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*Crea
 	return &r, nil
 }
 
-type CreateFirewallRuleResponse struct {
-	Cidrlist     string `json:"cidrlist"`
-	Destcidrlist string `json:"destcidrlist"`
-	Endport      int    `json:"endport"`
-	Fordisplay   bool   `json:"fordisplay"`
-	Icmpcode     int    `json:"icmpcode"`
-	Icmptype     int    `json:"icmptype"`
-	Id           string `json:"id"`
-	Ipaddress    string `json:"ipaddress"`
-	Ipaddressid  string `json:"ipaddressid"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Networkid    string `json:"networkid"`
-	Protocol     string `json:"protocol"`
-	Startport    int    `json:"startport"`
-	State        string `json:"state"`
-	Tags         []Tags `json:"tags"`
+// AddPaloAltoFirewallAsync starts the async job for AddPaloAltoFirewall and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddPaloAltoFirewallResponse using Job.Result
+func (s *FirewallService) AddPaloAltoFirewallAsync(p *AddPaloAltoFirewallParams) (*Job, error) {
+	return s.AddPaloAltoFirewallAsyncWithContext(context.Background(), p)
 }
 
-type CreatePortForwardingRuleParams struct {
+// AddPaloAltoFirewallAsyncWithContext is the same as AddPaloAltoFirewallAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) AddPaloAltoFirewallAsyncWithContext(ctx context.Context, p *AddPaloAltoFirewallParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addPaloAltoFirewall", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddPaloAltoFirewallResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type AddPaloAltoFirewallResponse struct {
+	Fwdevicecapacity  int64  `json:"fwdevicecapacity"`
+	Fwdeviceid        string `json:"fwdeviceid"`
+	Fwdevicename      string `json:"fwdevicename"`
+	Fwdevicestate     string `json:"fwdevicestate"`
+	Ipaddress         string `json:"ipaddress"`
+	JobID             string `json:"jobid"`
+	Jobstatus         int    `json:"jobstatus"`
+	Numretries        string `json:"numretries"`
+	Physicalnetworkid string `json:"physicalnetworkid"`
+	Privateinterface  string `json:"privateinterface"`
+	Privatezone       string `json:"privatezone"`
+	Provider          string `json:"provider"`
+	Publicinterface   string `json:"publicinterface"`
+	Publiczone        string `json:"publiczone"`
+	Timeout           string `json:"timeout"`
+	Usageinterface    string `json:"usageinterface"`
+	Username          string `json:"username"`
+	Zoneid            string `json:"zoneid"`
+}
+
+type AddSrxFirewallParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreatePortForwardingRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddSrxFirewallParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
-	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
-	}
-	if v, found := p.p["openfirewall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("openfirewall", vv)
-	}
-	if v, found := p.p["privateendport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("privateendport", vv)
-	}
-	if v, found := p.p["privateport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("privateport", vv)
-	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if v, found := p.p["networkdevicetype"]; found {
+		u.Set("networkdevicetype", v.(string))
 	}
-	if v, found := p.p["publicendport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("publicendport", vv)
+	if v, found := p.p["password"]; found {
+		u.Set("password", v.(string))
 	}
-	if v, found := p.p["publicport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("publicport", vv)
+	if v, found := p.p["physicalnetworkid"]; found {
+		u.Set("physicalnetworkid", v.(string))
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v, found := p.p["url"]; found {
+		u.Set("url", v.(string))
 	}
-	if v, found := p.p["vmguestip"]; found {
-		u.Set("vmguestip", v.(string))
+	if v, found := p.p["username"]; found {
+		u.Set("username", v.(string))
 	}
 	return u
 }
 
-func (p *CreatePortForwardingRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddSrxFirewallParams) Validate() error {
+	v := newParamValidator("addSrxFirewall", p.p)
+	v.required("networkdevicetype", "password", "physicalnetworkid", "url", "username")
+	v.ids("physicalnetworkid")
+	v.maxLength("networkdevicetype", 255)
+	v.maxLength("password", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
 }
 
-func (p *CreatePortForwardingRuleParams) SetFordisplay(v bool) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddSrxFirewallParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["fordisplay"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *CreatePortForwardingRuleParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddSrxFirewallParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["ipaddressid"] = v
-}
 
-func (p *CreatePortForwardingRuleParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "networkdevicetype", "password", "physicalnetworkid", "url", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addSrxFirewall", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addSrxFirewall: %w", k, err)
+		}
 	}
-	p.p["networkid"] = v
+	return nil
 }
 
-func (p *CreatePortForwardingRuleParams) SetOpenfirewall(v bool) {
+func (p *AddSrxFirewallParams) SetNetworkdevicetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["openfirewall"] = v
+	p.p["networkdevicetype"] = v
 }
 
-func (p *CreatePortForwardingRuleParams) SetPrivateendport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privateendport"] = v
+func (p *AddSrxFirewallParams) ResetNetworkdevicetype() {
+	delete(p.p, "networkdevicetype")
 }
 
-func (p *CreatePortForwardingRuleParams) SetPrivateport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privateport"] = v
+func (p *AddSrxFirewallParams) GetNetworkdevicetype() (string, bool) {
+	value, ok := p.p["networkdevicetype"].(string)
+	return value, ok
 }
 
-func (p *CreatePortForwardingRuleParams) SetProtocol(v string) {
+func (p *AddSrxFirewallParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["protocol"] = v
+	p.p["password"] = v
 }
 
-func (p *CreatePortForwardingRuleParams) SetPublicendport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicendport"] = v
+func (p *AddSrxFirewallParams) ResetPassword() {
+	delete(p.p, "password")
 }
 
-func (p *CreatePortForwardingRuleParams) SetPublicport(v int) {
+func (p *AddSrxFirewallParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
+func (p *AddSrxFirewallParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["publicport"] = v
+	p.p["physicalnetworkid"] = v
 }
 
-func (p *CreatePortForwardingRuleParams) SetVirtualmachineid(v string) {
+func (p *AddSrxFirewallParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddSrxFirewallParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
+func (p *AddSrxFirewallParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["virtualmachineid"] = v
+	p.p["url"] = v
 }
 
-func (p *CreatePortForwardingRuleParams) SetVmguestip(v string) {
+func (p *AddSrxFirewallParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddSrxFirewallParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
+func (p *AddSrxFirewallParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["vmguestip"] = v
+	p.p["username"] = v
 }
 
-// You should always use this function to get a new CreatePortForwardingRuleParams instance,
+func (p *AddSrxFirewallParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddSrxFirewallParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AddSrxFirewallParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams {
-	p := &CreatePortForwardingRuleParams{}
+func (s *FirewallService) NewAddSrxFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddSrxFirewallParams {
+	p := &AddSrxFirewallParams{}
 	p.p = make(map[string]interface{})
-	p.p["ipaddressid"] = ipaddressid
-	p.p["privateport"] = privateport
-	p.p["protocol"] = protocol
-	p.p["publicport"] = publicport
-	p.p["virtualmachineid"] = virtualmachineid
+	p.p["networkdevicetype"] = networkdevicetype
+	p.p["password"] = password
+	p.p["physicalnetworkid"] = physicalnetworkid
+	p.p["url"] = url
+	p.p["username"] = username
 	return p
 }
 
-// Creates a port forwarding rule
-func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("createPortForwardingRule", p.toURLValues())
+// Adds a SRX firewall device
+func (s *FirewallService) AddSrxFirewall(p *AddSrxFirewallParams) (*AddSrxFirewallResponse, error) {
+	return s.AddSrxFirewallWithContext(context.Background(), p)
+}
+
+// AddSrxFirewallWithContext is the same as AddSrxFirewall, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) AddSrxFirewallWithContext(ctx context.Context, p *AddSrxFirewallParams) (*AddSrxFirewallResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addSrxFirewall", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreatePortForwardingRuleResponse
+	var r AddSrxFirewallResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -873,78 +1281,33 @@ func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRulePa
 	return &r, nil
 }
 
-type CreatePortForwardingRuleResponse struct {
-	Cidrlist                  string `json:"cidrlist"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Ipaddressid               string `json:"ipaddressid"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Privateendport            string `json:"privateendport"`
-	Privateport               string `json:"privateport"`
-	Protocol                  string `json:"protocol"`
-	Publicendport             string `json:"publicendport"`
-	Publicport                string `json:"publicport"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Vmguestip                 string `json:"vmguestip"`
-}
-
-type DeleteEgressFirewallRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteEgressFirewallRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+// AddSrxFirewallAsync starts the async job for AddSrxFirewall and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddSrxFirewallResponse using Job.Result
+func (s *FirewallService) AddSrxFirewallAsync(p *AddSrxFirewallParams) (*Job, error) {
+	return s.AddSrxFirewallAsyncWithContext(context.Background(), p)
 }
 
-func (p *DeleteEgressFirewallRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// AddSrxFirewallAsyncWithContext is the same as AddSrxFirewallAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) AddSrxFirewallAsyncWithContext(ctx context.Context, p *AddSrxFirewallParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
-}
-
-// You should always use this function to get a new DeleteEgressFirewallRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewDeleteEgressFirewallRuleParams(id string) *DeleteEgressFirewallRuleParams {
-	p := &DeleteEgressFirewallRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
 
-// Deletes an egress firewall rule
-func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteEgressFirewallRule", p.toURLValues())
+	resp, err := s.cs.newRequestWithContext(ctx, "addSrxFirewall", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteEgressFirewallRuleResponse
+	var r AddSrxFirewallResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
 		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
 			return nil, err
 		}
 
@@ -953,67 +1316,162 @@ func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRulePa
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-
-	return &r, nil
+		return b, nil
+	}), nil
 }
 
-type DeleteEgressFirewallRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+type AddSrxFirewallResponse struct {
+	Fwdevicecapacity  int64  `json:"fwdevicecapacity"`
+	Fwdeviceid        string `json:"fwdeviceid"`
+	Fwdevicename      string `json:"fwdevicename"`
+	Fwdevicestate     string `json:"fwdevicestate"`
+	Ipaddress         string `json:"ipaddress"`
+	JobID             string `json:"jobid"`
+	Jobstatus         int    `json:"jobstatus"`
+	Numretries        string `json:"numretries"`
+	Physicalnetworkid string `json:"physicalnetworkid"`
+	Privateinterface  string `json:"privateinterface"`
+	Privatezone       string `json:"privatezone"`
+	Provider          string `json:"provider"`
+	Publicinterface   string `json:"publicinterface"`
+	Publiczone        string `json:"publiczone"`
+	Timeout           string `json:"timeout"`
+	Usageinterface    string `json:"usageinterface"`
+	Username          string `json:"username"`
+	Zoneid            string `json:"zoneid"`
 }
 
-type DeleteFirewallRuleParams struct {
+type ConfigurePaloAltoFirewallParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteFirewallRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ConfigurePaloAltoFirewallParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v, found := p.p["fwdevicecapacity"]; found {
+		vv := strconv.FormatInt(v.(int64), 10)
+		u.Set("fwdevicecapacity", vv)
+	}
+	if v, found := p.p["fwdeviceid"]; found {
+		u.Set("fwdeviceid", v.(string))
 	}
 	return u
 }
 
-func (p *DeleteFirewallRuleParams) SetId(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ConfigurePaloAltoFirewallParams) Validate() error {
+	v := newParamValidator("configurePaloAltoFirewall", p.p)
+	v.required("fwdeviceid")
+	v.ids("fwdeviceid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ConfigurePaloAltoFirewallParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ConfigurePaloAltoFirewallParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "fwdevicecapacity":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fwdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for configurePaloAltoFirewall", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of configurePaloAltoFirewall: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ConfigurePaloAltoFirewallParams) SetFwdevicecapacity(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["fwdevicecapacity"] = v
 }
 
-// You should always use this function to get a new DeleteFirewallRuleParams instance,
+func (p *ConfigurePaloAltoFirewallParams) ResetFwdevicecapacity() {
+	delete(p.p, "fwdevicecapacity")
+}
+
+func (p *ConfigurePaloAltoFirewallParams) GetFwdevicecapacity() (int64, bool) {
+	value, ok := p.p["fwdevicecapacity"].(int64)
+	return value, ok
+}
+
+func (p *ConfigurePaloAltoFirewallParams) SetFwdeviceid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fwdeviceid"] = v
+}
+
+func (p *ConfigurePaloAltoFirewallParams) ResetFwdeviceid() {
+	delete(p.p, "fwdeviceid")
+}
+
+func (p *ConfigurePaloAltoFirewallParams) GetFwdeviceid() (string, bool) {
+	value, ok := p.p["fwdeviceid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ConfigurePaloAltoFirewallParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewDeleteFirewallRuleParams(id string) *DeleteFirewallRuleParams {
-	p := &DeleteFirewallRuleParams{}
+func (s *FirewallService) NewConfigurePaloAltoFirewallParams(fwdeviceid string) *ConfigurePaloAltoFirewallParams {
+	p := &ConfigurePaloAltoFirewallParams{}
 	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.p["fwdeviceid"] = fwdeviceid
 	return p
 }
 
-// Deletes a firewall rule
-func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteFirewallRule", p.toURLValues())
+// Configures a Palo Alto firewall device
+func (s *FirewallService) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error) {
+	return s.ConfigurePaloAltoFirewallWithContext(context.Background(), p)
+}
+
+// ConfigurePaloAltoFirewallWithContext is the same as ConfigurePaloAltoFirewall, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) ConfigurePaloAltoFirewallWithContext(ctx context.Context, p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configurePaloAltoFirewall", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteFirewallRuleResponse
+	var r PaloAltoFirewallResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1021,6 +1479,11 @@ func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*Dele
 			return nil, err
 		}
 
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
 		b, err = convertFirewallServiceResponse(b)
 		if err != nil {
 			return nil, err
@@ -1034,63 +1497,33 @@ func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*Dele
 	return &r, nil
 }
 
-type DeleteFirewallRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
-}
-
-type DeletePaloAltoFirewallParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeletePaloAltoFirewallParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["fwdeviceid"]; found {
-		u.Set("fwdeviceid", v.(string))
-	}
-	return u
+// ConfigurePaloAltoFirewallAsync starts the async job for ConfigurePaloAltoFirewall and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a PaloAltoFirewallResponse using Job.Result
+func (s *FirewallService) ConfigurePaloAltoFirewallAsync(p *ConfigurePaloAltoFirewallParams) (*Job, error) {
+	return s.ConfigurePaloAltoFirewallAsyncWithContext(context.Background(), p)
 }
 
-func (p *DeletePaloAltoFirewallParams) SetFwdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// ConfigurePaloAltoFirewallAsyncWithContext is the same as ConfigurePaloAltoFirewallAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) ConfigurePaloAltoFirewallAsyncWithContext(ctx context.Context, p *ConfigurePaloAltoFirewallParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
-	p.p["fwdeviceid"] = v
-}
-
-// You should always use this function to get a new DeletePaloAltoFirewallParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewDeletePaloAltoFirewallParams(fwdeviceid string) *DeletePaloAltoFirewallParams {
-	p := &DeletePaloAltoFirewallParams{}
-	p.p = make(map[string]interface{})
-	p.p["fwdeviceid"] = fwdeviceid
-	return p
-}
 
-//  delete a Palo Alto firewall device
-func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newRequest("deletePaloAltoFirewall", p.toURLValues())
+	resp, err := s.cs.newRequestWithContext(ctx, "configurePaloAltoFirewall", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeletePaloAltoFirewallResponse
+	var r PaloAltoFirewallResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
 		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
 			return nil, err
 		}
 
@@ -1099,67 +1532,162 @@ func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-
-	return &r, nil
+		return b, nil
+	}), nil
 }
 
-type DeletePaloAltoFirewallResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+type PaloAltoFirewallResponse struct {
+	Fwdevicecapacity  int64  `json:"fwdevicecapacity"`
+	Fwdeviceid        string `json:"fwdeviceid"`
+	Fwdevicename      string `json:"fwdevicename"`
+	Fwdevicestate     string `json:"fwdevicestate"`
+	Ipaddress         string `json:"ipaddress"`
+	JobID             string `json:"jobid"`
+	Jobstatus         int    `json:"jobstatus"`
+	Numretries        string `json:"numretries"`
+	Physicalnetworkid string `json:"physicalnetworkid"`
+	Privateinterface  string `json:"privateinterface"`
+	Privatezone       string `json:"privatezone"`
+	Provider          string `json:"provider"`
+	Publicinterface   string `json:"publicinterface"`
+	Publiczone        string `json:"publiczone"`
+	Timeout           string `json:"timeout"`
+	Usageinterface    string `json:"usageinterface"`
+	Username          string `json:"username"`
+	Zoneid            string `json:"zoneid"`
 }
 
-type DeletePortForwardingRuleParams struct {
+type ConfigureSrxFirewallParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeletePortForwardingRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ConfigureSrxFirewallParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v, found := p.p["fwdevicecapacity"]; found {
+		vv := strconv.FormatInt(v.(int64), 10)
+		u.Set("fwdevicecapacity", vv)
+	}
+	if v, found := p.p["fwdeviceid"]; found {
+		u.Set("fwdeviceid", v.(string))
 	}
 	return u
 }
 
-func (p *DeletePortForwardingRuleParams) SetId(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ConfigureSrxFirewallParams) Validate() error {
+	v := newParamValidator("configureSrxFirewall", p.p)
+	v.required("fwdeviceid")
+	v.ids("fwdeviceid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ConfigureSrxFirewallParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ConfigureSrxFirewallParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "fwdevicecapacity":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fwdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for configureSrxFirewall", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of configureSrxFirewall: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ConfigureSrxFirewallParams) SetFwdevicecapacity(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["fwdevicecapacity"] = v
 }
 
-// You should always use this function to get a new DeletePortForwardingRuleParams instance,
+func (p *ConfigureSrxFirewallParams) ResetFwdevicecapacity() {
+	delete(p.p, "fwdevicecapacity")
+}
+
+func (p *ConfigureSrxFirewallParams) GetFwdevicecapacity() (int64, bool) {
+	value, ok := p.p["fwdevicecapacity"].(int64)
+	return value, ok
+}
+
+func (p *ConfigureSrxFirewallParams) SetFwdeviceid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fwdeviceid"] = v
+}
+
+func (p *ConfigureSrxFirewallParams) ResetFwdeviceid() {
+	delete(p.p, "fwdeviceid")
+}
+
+func (p *ConfigureSrxFirewallParams) GetFwdeviceid() (string, bool) {
+	value, ok := p.p["fwdeviceid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ConfigureSrxFirewallParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewDeletePortForwardingRuleParams(id string) *DeletePortForwardingRuleParams {
-	p := &DeletePortForwardingRuleParams{}
+func (s *FirewallService) NewConfigureSrxFirewallParams(fwdeviceid string) *ConfigureSrxFirewallParams {
+	p := &ConfigureSrxFirewallParams{}
 	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.p["fwdeviceid"] = fwdeviceid
 	return p
 }
 
-// Deletes a port forwarding rule
-func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("deletePortForwardingRule", p.toURLValues())
+// Configures a SRX firewall device
+func (s *FirewallService) ConfigureSrxFirewall(p *ConfigureSrxFirewallParams) (*SrxFirewallResponse, error) {
+	return s.ConfigureSrxFirewallWithContext(context.Background(), p)
+}
+
+// ConfigureSrxFirewallWithContext is the same as ConfigureSrxFirewall, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) ConfigureSrxFirewallWithContext(ctx context.Context, p *ConfigureSrxFirewallParams) (*SrxFirewallResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureSrxFirewall", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeletePortForwardingRuleResponse
+	var r SrxFirewallResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1167,6 +1695,11 @@ func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRulePa
 			return nil, err
 		}
 
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
 		b, err = convertFirewallServiceResponse(b)
 		if err != nil {
 			return nil, err
@@ -1180,254 +1713,4723 @@ func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRulePa
 	return &r, nil
 }
 
-type DeletePortForwardingRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
-}
-
-type ListEgressFirewallRulesParams struct {
-	p map[string]interface{}
+// ConfigureSrxFirewallAsync starts the async job for ConfigureSrxFirewall and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a SrxFirewallResponse using Job.Result
+func (s *FirewallService) ConfigureSrxFirewallAsync(p *ConfigureSrxFirewallParams) (*Job, error) {
+	return s.ConfigureSrxFirewallAsyncWithContext(context.Background(), p)
 }
 
-func (p *ListEgressFirewallRulesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+// ConfigureSrxFirewallAsyncWithContext is the same as ConfigureSrxFirewallAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) ConfigureSrxFirewallAsyncWithContext(ctx context.Context, p *ConfigureSrxFirewallParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureSrxFirewall", p.ToURLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+
+	var r SrxFirewallResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type SrxFirewallResponse struct {
+	Fwdevicecapacity  int64  `json:"fwdevicecapacity"`
+	Fwdeviceid        string `json:"fwdeviceid"`
+	Fwdevicename      string `json:"fwdevicename"`
+	Fwdevicestate     string `json:"fwdevicestate"`
+	Ipaddress         string `json:"ipaddress"`
+	JobID             string `json:"jobid"`
+	Jobstatus         int    `json:"jobstatus"`
+	Numretries        string `json:"numretries"`
+	Physicalnetworkid string `json:"physicalnetworkid"`
+	Privateinterface  string `json:"privateinterface"`
+	Privatezone       string `json:"privatezone"`
+	Provider          string `json:"provider"`
+	Publicinterface   string `json:"publicinterface"`
+	Publiczone        string `json:"publiczone"`
+	Timeout           string `json:"timeout"`
+	Usageinterface    string `json:"usageinterface"`
+	Username          string `json:"username"`
+	Zoneid            string `json:"zoneid"`
+}
+
+type CreateEgressFirewallRuleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateEgressFirewallRuleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
 	}
-	if v, found := p.p["listall"]; found {
+	if v, found := p.p["cidrlist"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("cidrlist", vv)
+	}
+	if v, found := p.p["destcidrlist"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("destcidrlist", vv)
+	}
+	if v, found := p.p["endport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("endport", vv)
+	}
+	if v, found := p.p["fordisplay"]; found {
 		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+		u.Set("fordisplay", vv)
+	}
+	if v, found := p.p["icmpcode"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("icmpcode", vv)
+	}
+	if v, found := p.p["icmptype"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("icmptype", vv)
 	}
 	if v, found := p.p["networkid"]; found {
 		u.Set("networkid", v.(string))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if v, found := p.p["protocol"]; found {
+		u.Set("protocol", v.(string))
 	}
-	if v, found := p.p["pagesize"]; found {
+	if v, found := p.p["startport"]; found {
 		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+		u.Set("startport", vv)
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
-		}
+	if v, found := p.p["type"]; found {
+		u.Set("type", v.(string))
 	}
 	return u
 }
 
-func (p *ListEgressFirewallRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateEgressFirewallRuleParams) Validate() error {
+	v := newParamValidator("createEgressFirewallRule", p.p)
+	v.required("networkid", "protocol")
+	v.ids("networkid")
+	v.maxLength("protocol", 255)
+	v.maxLength("type", 255)
+	return v.err()
 }
 
-func (p *ListEgressFirewallRulesParams) SetDomainid(v string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateEgressFirewallRuleParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateEgressFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "cidrlist", "destcidrlist":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "endport", "icmpcode", "icmptype", "startport":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "networkid", "protocol", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createEgressFirewallRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createEgressFirewallRule: %w", k, err)
+		}
 	}
-	p.p["domainid"] = v
+	return nil
 }
 
-func (p *ListEgressFirewallRulesParams) SetFordisplay(v bool) {
+func (p *CreateEgressFirewallRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["fordisplay"] = v
+	p.p["cidrlist"] = v
 }
 
-func (p *ListEgressFirewallRulesParams) SetId(v string) {
+func (p *CreateEgressFirewallRuleParams) ResetCidrlist() {
+	delete(p.p, "cidrlist")
+}
+
+func (p *CreateEgressFirewallRuleParams) GetCidrlist() ([]string, bool) {
+	value, ok := p.p["cidrlist"].([]string)
+	return value, ok
+}
+
+func (p *CreateEgressFirewallRuleParams) SetDestcidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["destcidrlist"] = v
 }
 
-func (p *ListEgressFirewallRulesParams) SetIpaddressid(v string) {
+func (p *CreateEgressFirewallRuleParams) ResetDestcidrlist() {
+	delete(p.p, "destcidrlist")
+}
+
+func (p *CreateEgressFirewallRuleParams) GetDestcidrlist() ([]string, bool) {
+	value, ok := p.p["destcidrlist"].([]string)
+	return value, ok
+}
+
+func (p *CreateEgressFirewallRuleParams) SetEndport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["ipaddressid"] = v
+	p.p["endport"] = v
 }
 
-func (p *ListEgressFirewallRulesParams) SetIsrecursive(v bool) {
+func (p *CreateEgressFirewallRuleParams) ResetEndport() {
+	delete(p.p, "endport")
+}
+
+func (p *CreateEgressFirewallRuleParams) GetEndport() (int, bool) {
+	value, ok := p.p["endport"].(int)
+	return value, ok
+}
+
+func (p *CreateEgressFirewallRuleParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["isrecursive"] = v
+	p.p["fordisplay"] = v
 }
 
-func (p *ListEgressFirewallRulesParams) SetKeyword(v string) {
+func (p *CreateEgressFirewallRuleParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateEgressFirewallRuleParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
+func (p *CreateEgressFirewallRuleParams) SetIcmpcode(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["keyword"] = v
+	p.p["icmpcode"] = v
 }
 
-func (p *ListEgressFirewallRulesParams) SetListall(v bool) {
+func (p *CreateEgressFirewallRuleParams) ResetIcmpcode() {
+	delete(p.p, "icmpcode")
+}
+
+func (p *CreateEgressFirewallRuleParams) GetIcmpcode() (int, bool) {
+	value, ok := p.p["icmpcode"].(int)
+	return value, ok
+}
+
+func (p *CreateEgressFirewallRuleParams) SetIcmptype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["listall"] = v
+	p.p["icmptype"] = v
 }
 
-func (p *ListEgressFirewallRulesParams) SetNetworkid(v string) {
+func (p *CreateEgressFirewallRuleParams) ResetIcmptype() {
+	delete(p.p, "icmptype")
+}
+
+func (p *CreateEgressFirewallRuleParams) GetIcmptype() (int, bool) {
+	value, ok := p.p["icmptype"].(int)
+	return value, ok
+}
+
+func (p *CreateEgressFirewallRuleParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["networkid"] = v
 }
 
-func (p *ListEgressFirewallRulesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+func (p *CreateEgressFirewallRuleParams) ResetNetworkid() {
+	delete(p.p, "networkid")
 }
 
-func (p *ListEgressFirewallRulesParams) SetPagesize(v int) {
+func (p *CreateEgressFirewallRuleParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
+func (p *CreateEgressFirewallRuleParams) SetProtocol(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["pagesize"] = v
+	p.p["protocol"] = v
 }
 
-func (p *ListEgressFirewallRulesParams) SetProjectid(v string) {
+func (p *CreateEgressFirewallRuleParams) ResetProtocol() {
+	delete(p.p, "protocol")
+}
+
+func (p *CreateEgressFirewallRuleParams) GetProtocol() (string, bool) {
+	value, ok := p.p["protocol"].(string)
+	return value, ok
+}
+
+func (p *CreateEgressFirewallRuleParams) SetStartport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["projectid"] = v
+	p.p["startport"] = v
 }
 
-func (p *ListEgressFirewallRulesParams) SetTags(v map[string]string) {
+func (p *CreateEgressFirewallRuleParams) ResetStartport() {
+	delete(p.p, "startport")
+}
+
+func (p *CreateEgressFirewallRuleParams) GetStartport() (int, bool) {
+	value, ok := p.p["startport"].(int)
+	return value, ok
+}
+
+func (p *CreateEgressFirewallRuleParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["tags"] = v
+	p.p["type"] = v
 }
 
-// You should always use this function to get a new ListEgressFirewallRulesParams instance,
+func (p *CreateEgressFirewallRuleParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *CreateEgressFirewallRuleParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateEgressFirewallRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewListEgressFirewallRulesParams() *ListEgressFirewallRulesParams {
-	p := &ListEgressFirewallRulesParams{}
+func (s *FirewallService) NewCreateEgressFirewallRuleParams(networkid string, protocol string) *CreateEgressFirewallRuleParams {
+	p := &CreateEgressFirewallRuleParams{}
 	p.p = make(map[string]interface{})
+	p.p["networkid"] = networkid
+	p.p["protocol"] = protocol
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *FirewallService) GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error) {
-	p := &ListEgressFirewallRulesParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
+// Creates a egress firewall rule for a given network
+func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
+	return s.CreateEgressFirewallRuleWithContext(context.Background(), p)
+}
 
-	for _, fn := range append(s.cs.options, opts...) {
-		if err := fn(s.cs, p); err != nil {
-			return nil, -1, err
-		}
+// CreateEgressFirewallRuleWithContext is the same as CreateEgressFirewallRule, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) CreateEgressFirewallRuleWithContext(ctx context.Context, p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
 
-	l, err := s.ListEgressFirewallRules(p)
+	resp, err := s.cs.newRequestWithContext(ctx, "createEgressFirewallRule", p.ToURLValues())
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+		return nil, err
 	}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	var r CreateEgressFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
 
-	if l.Count == 1 {
-		return l.EgressFirewallRules[0], l.Count, nil
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for EgressFirewallRule UUID: %s!", id)
+
+	return &r, nil
+}
+
+// CreateEgressFirewallRuleAsync starts the async job for CreateEgressFirewallRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateEgressFirewallRuleResponse using Job.Result
+func (s *FirewallService) CreateEgressFirewallRuleAsync(p *CreateEgressFirewallRuleParams) (*Job, error) {
+	return s.CreateEgressFirewallRuleAsyncWithContext(context.Background(), p)
 }
 
-// Lists all egress firewall rules for network ID.
-func (s *FirewallService) ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
-	resp, err := s.cs.newRequest("listEgressFirewallRules", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// CreateEgressFirewallRuleAsyncWithContext is the same as CreateEgressFirewallRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) CreateEgressFirewallRuleAsyncWithContext(ctx context.Context, p *CreateEgressFirewallRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createEgressFirewallRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateEgressFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type CreateEgressFirewallRuleResponse struct {
+	Cidrlist     string `json:"cidrlist"`
+	Destcidrlist string `json:"destcidrlist"`
+	Endport      int    `json:"endport"`
+	Fordisplay   bool   `json:"fordisplay"`
+	Icmpcode     int    `json:"icmpcode"`
+	Icmptype     int    `json:"icmptype"`
+	Id           string `json:"id"`
+	Ipaddress    string `json:"ipaddress"`
+	Ipaddressid  string `json:"ipaddressid"`
+	JobID        string `json:"jobid"`
+	Jobstatus    int    `json:"jobstatus"`
+	Networkid    string `json:"networkid"`
+	Protocol     string `json:"protocol"`
+	Startport    int    `json:"startport"`
+	State        string `json:"state"`
+	Tags         []Tags `json:"tags"`
+}
+
+type CreateFirewallRuleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateFirewallRuleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["cidrlist"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("cidrlist", vv)
+	}
+	if v, found := p.p["endport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("endport", vv)
+	}
+	if v, found := p.p["fordisplay"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("fordisplay", vv)
+	}
+	if v, found := p.p["icmpcode"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("icmpcode", vv)
+	}
+	if v, found := p.p["icmptype"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("icmptype", vv)
+	}
+	if v, found := p.p["ipaddressid"]; found {
+		u.Set("ipaddressid", v.(string))
+	}
+	if v, found := p.p["protocol"]; found {
+		u.Set("protocol", v.(string))
+	}
+	if v, found := p.p["startport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("startport", vv)
+	}
+	if v, found := p.p["type"]; found {
+		u.Set("type", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateFirewallRuleParams) Validate() error {
+	v := newParamValidator("createFirewallRule", p.p)
+	v.required("ipaddressid", "protocol")
+	v.ids("ipaddressid")
+	v.maxLength("protocol", 255)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "cidrlist":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "endport", "icmpcode", "icmptype", "startport":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ipaddressid", "protocol", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createFirewallRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createFirewallRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *CreateFirewallRuleParams) SetCidrlist(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["cidrlist"] = v
+}
+
+func (p *CreateFirewallRuleParams) ResetCidrlist() {
+	delete(p.p, "cidrlist")
+}
+
+func (p *CreateFirewallRuleParams) GetCidrlist() ([]string, bool) {
+	value, ok := p.p["cidrlist"].([]string)
+	return value, ok
+}
+
+func (p *CreateFirewallRuleParams) SetEndport(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["endport"] = v
+}
+
+func (p *CreateFirewallRuleParams) ResetEndport() {
+	delete(p.p, "endport")
+}
+
+func (p *CreateFirewallRuleParams) GetEndport() (int, bool) {
+	value, ok := p.p["endport"].(int)
+	return value, ok
+}
+
+func (p *CreateFirewallRuleParams) SetFordisplay(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fordisplay"] = v
+}
+
+func (p *CreateFirewallRuleParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateFirewallRuleParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
+func (p *CreateFirewallRuleParams) SetIcmpcode(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["icmpcode"] = v
+}
+
+func (p *CreateFirewallRuleParams) ResetIcmpcode() {
+	delete(p.p, "icmpcode")
+}
+
+func (p *CreateFirewallRuleParams) GetIcmpcode() (int, bool) {
+	value, ok := p.p["icmpcode"].(int)
+	return value, ok
+}
+
+func (p *CreateFirewallRuleParams) SetIcmptype(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["icmptype"] = v
+}
+
+func (p *CreateFirewallRuleParams) ResetIcmptype() {
+	delete(p.p, "icmptype")
+}
+
+func (p *CreateFirewallRuleParams) GetIcmptype() (int, bool) {
+	value, ok := p.p["icmptype"].(int)
+	return value, ok
+}
+
+func (p *CreateFirewallRuleParams) SetIpaddressid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ipaddressid"] = v
+}
+
+func (p *CreateFirewallRuleParams) ResetIpaddressid() {
+	delete(p.p, "ipaddressid")
+}
+
+func (p *CreateFirewallRuleParams) GetIpaddressid() (string, bool) {
+	value, ok := p.p["ipaddressid"].(string)
+	return value, ok
+}
+
+func (p *CreateFirewallRuleParams) SetProtocol(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["protocol"] = v
+}
+
+func (p *CreateFirewallRuleParams) ResetProtocol() {
+	delete(p.p, "protocol")
+}
+
+func (p *CreateFirewallRuleParams) GetProtocol() (string, bool) {
+	value, ok := p.p["protocol"].(string)
+	return value, ok
+}
+
+func (p *CreateFirewallRuleParams) SetStartport(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["startport"] = v
+}
+
+func (p *CreateFirewallRuleParams) ResetStartport() {
+	delete(p.p, "startport")
+}
+
+func (p *CreateFirewallRuleParams) GetStartport() (int, bool) {
+	value, ok := p.p["startport"].(int)
+	return value, ok
+}
+
+func (p *CreateFirewallRuleParams) SetType(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["type"] = v
+}
+
+func (p *CreateFirewallRuleParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *CreateFirewallRuleParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateFirewallRuleParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewCreateFirewallRuleParams(ipaddressid string, protocol string) *CreateFirewallRuleParams {
+	p := &CreateFirewallRuleParams{}
+	p.p = make(map[string]interface{})
+	p.p["ipaddressid"] = ipaddressid
+	p.p["protocol"] = protocol
+	return p
+}
+
+// Creates a firewall rule for a given IP address
+func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
+	return s.CreateFirewallRuleWithContext(context.Background(), p)
+}
+
+// CreateFirewallRuleWithContext is the same as CreateFirewallRule, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) CreateFirewallRuleWithContext(ctx context.Context, p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createFirewallRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// CreateFirewallRuleAsync starts the async job for CreateFirewallRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateFirewallRuleResponse using Job.Result
+func (s *FirewallService) CreateFirewallRuleAsync(p *CreateFirewallRuleParams) (*Job, error) {
+	return s.CreateFirewallRuleAsyncWithContext(context.Background(), p)
+}
+
+// CreateFirewallRuleAsyncWithContext is the same as CreateFirewallRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) CreateFirewallRuleAsyncWithContext(ctx context.Context, p *CreateFirewallRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createFirewallRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type CreateFirewallRuleResponse struct {
+	Cidrlist     string `json:"cidrlist"`
+	Destcidrlist string `json:"destcidrlist"`
+	Endport      int    `json:"endport"`
+	Fordisplay   bool   `json:"fordisplay"`
+	Icmpcode     int    `json:"icmpcode"`
+	Icmptype     int    `json:"icmptype"`
+	Id           string `json:"id"`
+	Ipaddress    string `json:"ipaddress"`
+	Ipaddressid  string `json:"ipaddressid"`
+	JobID        string `json:"jobid"`
+	Jobstatus    int    `json:"jobstatus"`
+	Networkid    string `json:"networkid"`
+	Protocol     string `json:"protocol"`
+	Startport    int    `json:"startport"`
+	State        string `json:"state"`
+	Tags         []Tags `json:"tags"`
+}
+
+type CreatePortForwardingRuleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreatePortForwardingRuleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["cidrlist"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("cidrlist", vv)
+	}
+	if v, found := p.p["fordisplay"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("fordisplay", vv)
+	}
+	if v, found := p.p["ipaddressid"]; found {
+		u.Set("ipaddressid", v.(string))
+	}
+	if v, found := p.p["networkid"]; found {
+		u.Set("networkid", v.(string))
+	}
+	if v, found := p.p["openfirewall"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("openfirewall", vv)
+	}
+	if v, found := p.p["privateendport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("privateendport", vv)
+	}
+	if v, found := p.p["privateport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("privateport", vv)
+	}
+	if v, found := p.p["protocol"]; found {
+		u.Set("protocol", v.(string))
+	}
+	if v, found := p.p["publicendport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("publicendport", vv)
+	}
+	if v, found := p.p["publicport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("publicport", vv)
+	}
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
+	}
+	if v, found := p.p["vmguestip"]; found {
+		u.Set("vmguestip", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreatePortForwardingRuleParams) Validate() error {
+	v := newParamValidator("createPortForwardingRule", p.p)
+	v.required("ipaddressid", "privateport", "protocol", "publicport", "virtualmachineid")
+	v.ids("ipaddressid", "networkid", "virtualmachineid")
+	v.maxLength("protocol", 255)
+	v.maxLength("vmguestip", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreatePortForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreatePortForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "cidrlist":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "openfirewall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ipaddressid", "networkid", "protocol", "virtualmachineid", "vmguestip":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "privateendport", "privateport", "publicendport", "publicport":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createPortForwardingRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createPortForwardingRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *CreatePortForwardingRuleParams) SetCidrlist(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["cidrlist"] = v
+}
+
+func (p *CreatePortForwardingRuleParams) ResetCidrlist() {
+	delete(p.p, "cidrlist")
+}
+
+func (p *CreatePortForwardingRuleParams) GetCidrlist() ([]string, bool) {
+	value, ok := p.p["cidrlist"].([]string)
+	return value, ok
+}
+
+func (p *CreatePortForwardingRuleParams) SetFordisplay(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fordisplay"] = v
+}
+
+func (p *CreatePortForwardingRuleParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreatePortForwardingRuleParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
+func (p *CreatePortForwardingRuleParams) SetIpaddressid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ipaddressid"] = v
+}
+
+func (p *CreatePortForwardingRuleParams) ResetIpaddressid() {
+	delete(p.p, "ipaddressid")
+}
+
+func (p *CreatePortForwardingRuleParams) GetIpaddressid() (string, bool) {
+	value, ok := p.p["ipaddressid"].(string)
+	return value, ok
+}
+
+func (p *CreatePortForwardingRuleParams) SetNetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networkid"] = v
+}
+
+func (p *CreatePortForwardingRuleParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *CreatePortForwardingRuleParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
+func (p *CreatePortForwardingRuleParams) SetOpenfirewall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["openfirewall"] = v
+}
+
+func (p *CreatePortForwardingRuleParams) ResetOpenfirewall() {
+	delete(p.p, "openfirewall")
+}
+
+func (p *CreatePortForwardingRuleParams) GetOpenfirewall() (bool, bool) {
+	value, ok := p.p["openfirewall"].(bool)
+	return value, ok
+}
+
+func (p *CreatePortForwardingRuleParams) SetPrivateendport(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["privateendport"] = v
+}
+
+func (p *CreatePortForwardingRuleParams) ResetPrivateendport() {
+	delete(p.p, "privateendport")
+}
+
+func (p *CreatePortForwardingRuleParams) GetPrivateendport() (int, bool) {
+	value, ok := p.p["privateendport"].(int)
+	return value, ok
+}
+
+func (p *CreatePortForwardingRuleParams) SetPrivateport(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["privateport"] = v
+}
+
+func (p *CreatePortForwardingRuleParams) ResetPrivateport() {
+	delete(p.p, "privateport")
+}
+
+func (p *CreatePortForwardingRuleParams) GetPrivateport() (int, bool) {
+	value, ok := p.p["privateport"].(int)
+	return value, ok
+}
+
+func (p *CreatePortForwardingRuleParams) SetProtocol(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["protocol"] = v
+}
+
+func (p *CreatePortForwardingRuleParams) ResetProtocol() {
+	delete(p.p, "protocol")
+}
+
+func (p *CreatePortForwardingRuleParams) GetProtocol() (string, bool) {
+	value, ok := p.p["protocol"].(string)
+	return value, ok
+}
+
+func (p *CreatePortForwardingRuleParams) SetPublicendport(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["publicendport"] = v
+}
+
+func (p *CreatePortForwardingRuleParams) ResetPublicendport() {
+	delete(p.p, "publicendport")
+}
+
+func (p *CreatePortForwardingRuleParams) GetPublicendport() (int, bool) {
+	value, ok := p.p["publicendport"].(int)
+	return value, ok
+}
+
+func (p *CreatePortForwardingRuleParams) SetPublicport(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["publicport"] = v
+}
+
+func (p *CreatePortForwardingRuleParams) ResetPublicport() {
+	delete(p.p, "publicport")
+}
+
+func (p *CreatePortForwardingRuleParams) GetPublicport() (int, bool) {
+	value, ok := p.p["publicport"].(int)
+	return value, ok
+}
+
+func (p *CreatePortForwardingRuleParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+}
+
+func (p *CreatePortForwardingRuleParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *CreatePortForwardingRuleParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
+func (p *CreatePortForwardingRuleParams) SetVmguestip(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["vmguestip"] = v
+}
+
+func (p *CreatePortForwardingRuleParams) ResetVmguestip() {
+	delete(p.p, "vmguestip")
+}
+
+func (p *CreatePortForwardingRuleParams) GetVmguestip() (string, bool) {
+	value, ok := p.p["vmguestip"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new CreatePortForwardingRuleParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams {
+	p := &CreatePortForwardingRuleParams{}
+	p.p = make(map[string]interface{})
+	p.p["ipaddressid"] = ipaddressid
+	p.p["privateport"] = privateport
+	p.p["protocol"] = protocol
+	p.p["publicport"] = publicport
+	p.p["virtualmachineid"] = virtualmachineid
+	return p
+}
+
+// Creates a port forwarding rule
+func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
+	return s.CreatePortForwardingRuleWithContext(context.Background(), p)
+}
+
+// CreatePortForwardingRuleWithContext is the same as CreatePortForwardingRule, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) CreatePortForwardingRuleWithContext(ctx context.Context, p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createPortForwardingRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreatePortForwardingRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// CreatePortForwardingRuleAsync starts the async job for CreatePortForwardingRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreatePortForwardingRuleResponse using Job.Result
+func (s *FirewallService) CreatePortForwardingRuleAsync(p *CreatePortForwardingRuleParams) (*Job, error) {
+	return s.CreatePortForwardingRuleAsyncWithContext(context.Background(), p)
+}
+
+// CreatePortForwardingRuleAsyncWithContext is the same as CreatePortForwardingRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) CreatePortForwardingRuleAsyncWithContext(ctx context.Context, p *CreatePortForwardingRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createPortForwardingRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreatePortForwardingRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type CreatePortForwardingRuleResponse struct {
+	Cidrlist                  string `json:"cidrlist"`
+	Fordisplay                bool   `json:"fordisplay"`
+	Id                        string `json:"id"`
+	Ipaddress                 string `json:"ipaddress"`
+	Ipaddressid               string `json:"ipaddressid"`
+	JobID                     string `json:"jobid"`
+	Jobstatus                 int    `json:"jobstatus"`
+	Networkid                 string `json:"networkid"`
+	Privateendport            string `json:"privateendport"`
+	Privateport               string `json:"privateport"`
+	Protocol                  string `json:"protocol"`
+	Publicendport             string `json:"publicendport"`
+	Publicport                string `json:"publicport"`
+	State                     string `json:"state"`
+	Tags                      []Tags `json:"tags"`
+	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string `json:"virtualmachineid"`
+	Virtualmachinename        string `json:"virtualmachinename"`
+	Vmguestip                 string `json:"vmguestip"`
+}
+
+type DeleteCiscoAsa1000vResourceParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteCiscoAsa1000vResourceParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["resourceid"]; found {
+		u.Set("resourceid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteCiscoAsa1000vResourceParams) Validate() error {
+	v := newParamValidator("deleteCiscoAsa1000vResource", p.p)
+	v.required("resourceid")
+	v.ids("resourceid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteCiscoAsa1000vResourceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteCiscoAsa1000vResourceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "resourceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteCiscoAsa1000vResource", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteCiscoAsa1000vResource: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteCiscoAsa1000vResourceParams) SetResourceid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["resourceid"] = v
+}
+
+func (p *DeleteCiscoAsa1000vResourceParams) ResetResourceid() {
+	delete(p.p, "resourceid")
+}
+
+func (p *DeleteCiscoAsa1000vResourceParams) GetResourceid() (string, bool) {
+	value, ok := p.p["resourceid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteCiscoAsa1000vResourceParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewDeleteCiscoAsa1000vResourceParams(resourceid string) *DeleteCiscoAsa1000vResourceParams {
+	p := &DeleteCiscoAsa1000vResourceParams{}
+	p.p = make(map[string]interface{})
+	p.p["resourceid"] = resourceid
+	return p
+}
+
+// Deletes a Cisco ASA 1000v appliance
+func (s *FirewallService) DeleteCiscoAsa1000vResource(p *DeleteCiscoAsa1000vResourceParams) (*DeleteCiscoAsa1000vResourceResponse, error) {
+	return s.DeleteCiscoAsa1000vResourceWithContext(context.Background(), p)
+}
+
+// DeleteCiscoAsa1000vResourceWithContext is the same as DeleteCiscoAsa1000vResource, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) DeleteCiscoAsa1000vResourceWithContext(ctx context.Context, p *DeleteCiscoAsa1000vResourceParams) (*DeleteCiscoAsa1000vResourceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCiscoAsa1000vResource", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = convertFirewallServiceResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteCiscoAsa1000vResourceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteCiscoAsa1000vResourceResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteCiscoAsa1000vResourceResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteCiscoAsa1000vResourceResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type DeleteCiscoVnmcResourceParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteCiscoVnmcResourceParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["resourceid"]; found {
+		u.Set("resourceid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteCiscoVnmcResourceParams) Validate() error {
+	v := newParamValidator("deleteCiscoVnmcResource", p.p)
+	v.required("resourceid")
+	v.ids("resourceid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteCiscoVnmcResourceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteCiscoVnmcResourceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "resourceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteCiscoVnmcResource", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteCiscoVnmcResource: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteCiscoVnmcResourceParams) SetResourceid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["resourceid"] = v
+}
+
+func (p *DeleteCiscoVnmcResourceParams) ResetResourceid() {
+	delete(p.p, "resourceid")
+}
+
+func (p *DeleteCiscoVnmcResourceParams) GetResourceid() (string, bool) {
+	value, ok := p.p["resourceid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteCiscoVnmcResourceParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewDeleteCiscoVnmcResourceParams(resourceid string) *DeleteCiscoVnmcResourceParams {
+	p := &DeleteCiscoVnmcResourceParams{}
+	p.p = make(map[string]interface{})
+	p.p["resourceid"] = resourceid
+	return p
+}
+
+// Deletes a Cisco Vnmc controller
+func (s *FirewallService) DeleteCiscoVnmcResource(p *DeleteCiscoVnmcResourceParams) (*DeleteCiscoVnmcResourceResponse, error) {
+	return s.DeleteCiscoVnmcResourceWithContext(context.Background(), p)
+}
+
+// DeleteCiscoVnmcResourceWithContext is the same as DeleteCiscoVnmcResource, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) DeleteCiscoVnmcResourceWithContext(ctx context.Context, p *DeleteCiscoVnmcResourceParams) (*DeleteCiscoVnmcResourceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCiscoVnmcResource", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = convertFirewallServiceResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteCiscoVnmcResourceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteCiscoVnmcResourceResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteCiscoVnmcResourceResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteCiscoVnmcResourceResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type DeleteEgressFirewallRuleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteEgressFirewallRuleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteEgressFirewallRuleParams) Validate() error {
+	v := newParamValidator("deleteEgressFirewallRule", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteEgressFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteEgressFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteEgressFirewallRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteEgressFirewallRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteEgressFirewallRuleParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteEgressFirewallRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteEgressFirewallRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteEgressFirewallRuleParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewDeleteEgressFirewallRuleParams(id string) *DeleteEgressFirewallRuleParams {
+	p := &DeleteEgressFirewallRuleParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes an egress firewall rule
+func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
+	return s.DeleteEgressFirewallRuleWithContext(context.Background(), p)
+}
+
+// DeleteEgressFirewallRuleWithContext is the same as DeleteEgressFirewallRule, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) DeleteEgressFirewallRuleWithContext(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteEgressFirewallRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteEgressFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteEgressFirewallRuleAsync starts the async job for DeleteEgressFirewallRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteEgressFirewallRuleResponse using Job.Result
+func (s *FirewallService) DeleteEgressFirewallRuleAsync(p *DeleteEgressFirewallRuleParams) (*Job, error) {
+	return s.DeleteEgressFirewallRuleAsyncWithContext(context.Background(), p)
+}
+
+// DeleteEgressFirewallRuleAsyncWithContext is the same as DeleteEgressFirewallRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) DeleteEgressFirewallRuleAsyncWithContext(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteEgressFirewallRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteEgressFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type DeleteEgressFirewallRuleResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteExternalFirewallParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteExternalFirewallParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteExternalFirewallParams) Validate() error {
+	v := newParamValidator("deleteExternalFirewall", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteExternalFirewallParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteExternalFirewallParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteExternalFirewall", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteExternalFirewall: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteExternalFirewallParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteExternalFirewallParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteExternalFirewallParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteExternalFirewallParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewDeleteExternalFirewallParams(id string) *DeleteExternalFirewallParams {
+	p := &DeleteExternalFirewallParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes an external firewall appliance.
+func (s *FirewallService) DeleteExternalFirewall(p *DeleteExternalFirewallParams) (*DeleteExternalFirewallResponse, error) {
+	return s.DeleteExternalFirewallWithContext(context.Background(), p)
+}
+
+// DeleteExternalFirewallWithContext is the same as DeleteExternalFirewall, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) DeleteExternalFirewallWithContext(ctx context.Context, p *DeleteExternalFirewallParams) (*DeleteExternalFirewallResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteExternalFirewall", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = convertFirewallServiceResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteExternalFirewallResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteExternalFirewallResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteExternalFirewallResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteExternalFirewallResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type DeleteFirewallRuleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteFirewallRuleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteFirewallRuleParams) Validate() error {
+	v := newParamValidator("deleteFirewallRule", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteFirewallRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteFirewallRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteFirewallRuleParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteFirewallRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteFirewallRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteFirewallRuleParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewDeleteFirewallRuleParams(id string) *DeleteFirewallRuleParams {
+	p := &DeleteFirewallRuleParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes a firewall rule
+func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
+	return s.DeleteFirewallRuleWithContext(context.Background(), p)
+}
+
+// DeleteFirewallRuleWithContext is the same as DeleteFirewallRule, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) DeleteFirewallRuleWithContext(ctx context.Context, p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteFirewallRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteFirewallRuleAsync starts the async job for DeleteFirewallRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteFirewallRuleResponse using Job.Result
+func (s *FirewallService) DeleteFirewallRuleAsync(p *DeleteFirewallRuleParams) (*Job, error) {
+	return s.DeleteFirewallRuleAsyncWithContext(context.Background(), p)
+}
+
+// DeleteFirewallRuleAsyncWithContext is the same as DeleteFirewallRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) DeleteFirewallRuleAsyncWithContext(ctx context.Context, p *DeleteFirewallRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteFirewallRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type DeleteFirewallRuleResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeletePaloAltoFirewallParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeletePaloAltoFirewallParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["fwdeviceid"]; found {
+		u.Set("fwdeviceid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeletePaloAltoFirewallParams) Validate() error {
+	v := newParamValidator("deletePaloAltoFirewall", p.p)
+	v.required("fwdeviceid")
+	v.ids("fwdeviceid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeletePaloAltoFirewallParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeletePaloAltoFirewallParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "fwdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deletePaloAltoFirewall", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deletePaloAltoFirewall: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeletePaloAltoFirewallParams) SetFwdeviceid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fwdeviceid"] = v
+}
+
+func (p *DeletePaloAltoFirewallParams) ResetFwdeviceid() {
+	delete(p.p, "fwdeviceid")
+}
+
+func (p *DeletePaloAltoFirewallParams) GetFwdeviceid() (string, bool) {
+	value, ok := p.p["fwdeviceid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeletePaloAltoFirewallParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewDeletePaloAltoFirewallParams(fwdeviceid string) *DeletePaloAltoFirewallParams {
+	p := &DeletePaloAltoFirewallParams{}
+	p.p = make(map[string]interface{})
+	p.p["fwdeviceid"] = fwdeviceid
+	return p
+}
+
+// delete a Palo Alto firewall device
+func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error) {
+	return s.DeletePaloAltoFirewallWithContext(context.Background(), p)
+}
+
+// DeletePaloAltoFirewallWithContext is the same as DeletePaloAltoFirewall, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) DeletePaloAltoFirewallWithContext(ctx context.Context, p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePaloAltoFirewall", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeletePaloAltoFirewallResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeletePaloAltoFirewallAsync starts the async job for DeletePaloAltoFirewall and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeletePaloAltoFirewallResponse using Job.Result
+func (s *FirewallService) DeletePaloAltoFirewallAsync(p *DeletePaloAltoFirewallParams) (*Job, error) {
+	return s.DeletePaloAltoFirewallAsyncWithContext(context.Background(), p)
+}
+
+// DeletePaloAltoFirewallAsyncWithContext is the same as DeletePaloAltoFirewallAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) DeletePaloAltoFirewallAsyncWithContext(ctx context.Context, p *DeletePaloAltoFirewallParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePaloAltoFirewall", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeletePaloAltoFirewallResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type DeletePaloAltoFirewallResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeletePortForwardingRuleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeletePortForwardingRuleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeletePortForwardingRuleParams) Validate() error {
+	v := newParamValidator("deletePortForwardingRule", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeletePortForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeletePortForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deletePortForwardingRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deletePortForwardingRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeletePortForwardingRuleParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeletePortForwardingRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeletePortForwardingRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeletePortForwardingRuleParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewDeletePortForwardingRuleParams(id string) *DeletePortForwardingRuleParams {
+	p := &DeletePortForwardingRuleParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes a port forwarding rule
+func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
+	return s.DeletePortForwardingRuleWithContext(context.Background(), p)
+}
+
+// DeletePortForwardingRuleWithContext is the same as DeletePortForwardingRule, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) DeletePortForwardingRuleWithContext(ctx context.Context, p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePortForwardingRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeletePortForwardingRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeletePortForwardingRuleAsync starts the async job for DeletePortForwardingRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeletePortForwardingRuleResponse using Job.Result
+func (s *FirewallService) DeletePortForwardingRuleAsync(p *DeletePortForwardingRuleParams) (*Job, error) {
+	return s.DeletePortForwardingRuleAsyncWithContext(context.Background(), p)
+}
+
+// DeletePortForwardingRuleAsyncWithContext is the same as DeletePortForwardingRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) DeletePortForwardingRuleAsyncWithContext(ctx context.Context, p *DeletePortForwardingRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePortForwardingRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeletePortForwardingRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type DeletePortForwardingRuleResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteSrxFirewallParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteSrxFirewallParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["fwdeviceid"]; found {
+		u.Set("fwdeviceid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteSrxFirewallParams) Validate() error {
+	v := newParamValidator("deleteSrxFirewall", p.p)
+	v.required("fwdeviceid")
+	v.ids("fwdeviceid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteSrxFirewallParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteSrxFirewallParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "fwdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteSrxFirewall", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteSrxFirewall: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteSrxFirewallParams) SetFwdeviceid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fwdeviceid"] = v
+}
+
+func (p *DeleteSrxFirewallParams) ResetFwdeviceid() {
+	delete(p.p, "fwdeviceid")
+}
+
+func (p *DeleteSrxFirewallParams) GetFwdeviceid() (string, bool) {
+	value, ok := p.p["fwdeviceid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteSrxFirewallParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewDeleteSrxFirewallParams(fwdeviceid string) *DeleteSrxFirewallParams {
+	p := &DeleteSrxFirewallParams{}
+	p.p = make(map[string]interface{})
+	p.p["fwdeviceid"] = fwdeviceid
+	return p
+}
+
+// delete a SRX firewall device
+func (s *FirewallService) DeleteSrxFirewall(p *DeleteSrxFirewallParams) (*DeleteSrxFirewallResponse, error) {
+	return s.DeleteSrxFirewallWithContext(context.Background(), p)
+}
+
+// DeleteSrxFirewallWithContext is the same as DeleteSrxFirewall, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) DeleteSrxFirewallWithContext(ctx context.Context, p *DeleteSrxFirewallParams) (*DeleteSrxFirewallResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSrxFirewall", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteSrxFirewallResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteSrxFirewallAsync starts the async job for DeleteSrxFirewall and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteSrxFirewallResponse using Job.Result
+func (s *FirewallService) DeleteSrxFirewallAsync(p *DeleteSrxFirewallParams) (*Job, error) {
+	return s.DeleteSrxFirewallAsyncWithContext(context.Background(), p)
+}
+
+// DeleteSrxFirewallAsyncWithContext is the same as DeleteSrxFirewallAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) DeleteSrxFirewallAsyncWithContext(ctx context.Context, p *DeleteSrxFirewallParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSrxFirewall", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteSrxFirewallResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type DeleteSrxFirewallResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type ListCiscoAsa1000vResourcesParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListCiscoAsa1000vResourcesParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["hostname"]; found {
+		u.Set("hostname", v.(string))
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["physicalnetworkid"]; found {
+		u.Set("physicalnetworkid", v.(string))
+	}
+	if v, found := p.p["resourceid"]; found {
+		u.Set("resourceid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListCiscoAsa1000vResourcesParams) Validate() error {
+	v := newParamValidator("listCiscoAsa1000vResources", p.p)
+	v.ids("physicalnetworkid", "resourceid")
+	v.maxLength("hostname", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListCiscoAsa1000vResourcesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListCiscoAsa1000vResourcesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostname", "keyword", "physicalnetworkid", "resourceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listCiscoAsa1000vResources", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listCiscoAsa1000vResources: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) SetHostname(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostname"] = v
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) ResetHostname() {
+	delete(p.p, "hostname")
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) GetHostname() (string, bool) {
+	value, ok := p.p["hostname"].(string)
+	return value, ok
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) SetPhysicalnetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["physicalnetworkid"] = v
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) SetResourceid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["resourceid"] = v
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) ResetResourceid() {
+	delete(p.p, "resourceid")
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) GetResourceid() (string, bool) {
+	value, ok := p.p["resourceid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListCiscoAsa1000vResourcesParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewListCiscoAsa1000vResourcesParams() *ListCiscoAsa1000vResourcesParams {
+	p := &ListCiscoAsa1000vResourcesParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Lists Cisco ASA 1000v appliances
+func (s *FirewallService) ListCiscoAsa1000vResources(p *ListCiscoAsa1000vResourcesParams) (*ListCiscoAsa1000vResourcesResponse, error) {
+	return s.ListCiscoAsa1000vResourcesWithContext(context.Background(), p)
+}
+
+// ListCiscoAsa1000vResourcesWithContext is the same as ListCiscoAsa1000vResources, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) ListCiscoAsa1000vResourcesWithContext(ctx context.Context, p *ListCiscoAsa1000vResourcesParams) (*ListCiscoAsa1000vResourcesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listCiscoAsa1000vResources", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = convertFirewallServiceResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListCiscoAsa1000vResourcesResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListCiscoAsa1000vResourcesPager iterates over all pages of a ListCiscoAsa1000vResources call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListCiscoAsa1000vResourcesPager struct {
+	pager
+
+	s *FirewallService
+	p *ListCiscoAsa1000vResourcesParams
+	r *ListCiscoAsa1000vResourcesResponse
+}
+
+// NewListCiscoAsa1000vResourcesPager returns a pager for all pages of a ListCiscoAsa1000vResources call with the given params
+func (s *FirewallService) NewListCiscoAsa1000vResourcesPager(p *ListCiscoAsa1000vResourcesParams) *ListCiscoAsa1000vResourcesPager {
+	return &ListCiscoAsa1000vResourcesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListCiscoAsa1000vResourcesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListCiscoAsa1000vResourcesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListCiscoAsa1000vResourcesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListCiscoAsa1000vResourcesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.CiscoAsa1000vResources), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListCiscoAsa1000vResourcesPager) Page() *ListCiscoAsa1000vResourcesResponse {
+	return pg.r
+}
+
+// ListCiscoAsa1000vResourcesAll fetches all pages of a ListCiscoAsa1000vResources call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *FirewallService) ListCiscoAsa1000vResourcesAll(p *ListCiscoAsa1000vResourcesParams) (*ListCiscoAsa1000vResourcesResponse, error) {
+	return s.ListCiscoAsa1000vResourcesAllWithContext(context.Background(), p)
+}
+
+// ListCiscoAsa1000vResourcesAllWithContext is the same as ListCiscoAsa1000vResourcesAll, but uses ctx to cancel the requests
+func (s *FirewallService) ListCiscoAsa1000vResourcesAllWithContext(ctx context.Context, p *ListCiscoAsa1000vResourcesParams) (*ListCiscoAsa1000vResourcesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*CiscoAsa1000vResource)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListCiscoAsa1000vResourcesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListCiscoAsa1000vResourcesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.CiscoAsa1000vResources
+		mu.Unlock()
+
+		return len(l.CiscoAsa1000vResources), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListCiscoAsa1000vResourcesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.CiscoAsa1000vResources = append(r.CiscoAsa1000vResources, pages[page]...)
+	}
+	r.Count = len(r.CiscoAsa1000vResources)
+
+	return r, nil
+}
+
+type ListCiscoAsa1000vResourcesResponse struct {
+	Count                  int                      `json:"count"`
+	CiscoAsa1000vResources []*CiscoAsa1000vResource `json:"ciscoasa1000vresource"`
+}
+
+type CiscoAsa1000vResource struct {
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+}
+
+type ListCiscoVnmcResourcesParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListCiscoVnmcResourcesParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["physicalnetworkid"]; found {
+		u.Set("physicalnetworkid", v.(string))
+	}
+	if v, found := p.p["resourceid"]; found {
+		u.Set("resourceid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListCiscoVnmcResourcesParams) Validate() error {
+	v := newParamValidator("listCiscoVnmcResources", p.p)
+	v.ids("physicalnetworkid", "resourceid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListCiscoVnmcResourcesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListCiscoVnmcResourcesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "physicalnetworkid", "resourceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listCiscoVnmcResources", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listCiscoVnmcResources: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListCiscoVnmcResourcesParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListCiscoVnmcResourcesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListCiscoVnmcResourcesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListCiscoVnmcResourcesParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListCiscoVnmcResourcesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListCiscoVnmcResourcesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListCiscoVnmcResourcesParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListCiscoVnmcResourcesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListCiscoVnmcResourcesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListCiscoVnmcResourcesParams) SetPhysicalnetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["physicalnetworkid"] = v
+}
+
+func (p *ListCiscoVnmcResourcesParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListCiscoVnmcResourcesParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
+func (p *ListCiscoVnmcResourcesParams) SetResourceid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["resourceid"] = v
+}
+
+func (p *ListCiscoVnmcResourcesParams) ResetResourceid() {
+	delete(p.p, "resourceid")
+}
+
+func (p *ListCiscoVnmcResourcesParams) GetResourceid() (string, bool) {
+	value, ok := p.p["resourceid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListCiscoVnmcResourcesParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewListCiscoVnmcResourcesParams() *ListCiscoVnmcResourcesParams {
+	p := &ListCiscoVnmcResourcesParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Lists Cisco VNMC controllers
+func (s *FirewallService) ListCiscoVnmcResources(p *ListCiscoVnmcResourcesParams) (*ListCiscoVnmcResourcesResponse, error) {
+	return s.ListCiscoVnmcResourcesWithContext(context.Background(), p)
+}
+
+// ListCiscoVnmcResourcesWithContext is the same as ListCiscoVnmcResources, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) ListCiscoVnmcResourcesWithContext(ctx context.Context, p *ListCiscoVnmcResourcesParams) (*ListCiscoVnmcResourcesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listCiscoVnmcResources", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = convertFirewallServiceResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListCiscoVnmcResourcesResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListCiscoVnmcResourcesPager iterates over all pages of a ListCiscoVnmcResources call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListCiscoVnmcResourcesPager struct {
+	pager
+
+	s *FirewallService
+	p *ListCiscoVnmcResourcesParams
+	r *ListCiscoVnmcResourcesResponse
+}
+
+// NewListCiscoVnmcResourcesPager returns a pager for all pages of a ListCiscoVnmcResources call with the given params
+func (s *FirewallService) NewListCiscoVnmcResourcesPager(p *ListCiscoVnmcResourcesParams) *ListCiscoVnmcResourcesPager {
+	return &ListCiscoVnmcResourcesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListCiscoVnmcResourcesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListCiscoVnmcResourcesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListCiscoVnmcResourcesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListCiscoVnmcResourcesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.CiscoVnmcResources), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListCiscoVnmcResourcesPager) Page() *ListCiscoVnmcResourcesResponse {
+	return pg.r
+}
+
+// ListCiscoVnmcResourcesAll fetches all pages of a ListCiscoVnmcResources call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *FirewallService) ListCiscoVnmcResourcesAll(p *ListCiscoVnmcResourcesParams) (*ListCiscoVnmcResourcesResponse, error) {
+	return s.ListCiscoVnmcResourcesAllWithContext(context.Background(), p)
+}
+
+// ListCiscoVnmcResourcesAllWithContext is the same as ListCiscoVnmcResourcesAll, but uses ctx to cancel the requests
+func (s *FirewallService) ListCiscoVnmcResourcesAllWithContext(ctx context.Context, p *ListCiscoVnmcResourcesParams) (*ListCiscoVnmcResourcesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*CiscoVnmcResource)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListCiscoVnmcResourcesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListCiscoVnmcResourcesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.CiscoVnmcResources
+		mu.Unlock()
+
+		return len(l.CiscoVnmcResources), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListCiscoVnmcResourcesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.CiscoVnmcResources = append(r.CiscoVnmcResources, pages[page]...)
+	}
+	r.Count = len(r.CiscoVnmcResources)
+
+	return r, nil
+}
+
+type ListCiscoVnmcResourcesResponse struct {
+	Count              int                  `json:"count"`
+	CiscoVnmcResources []*CiscoVnmcResource `json:"ciscovnmcresource"`
+}
+
+type CiscoVnmcResource struct {
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+}
+
+type ListEgressFirewallRulesParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListEgressFirewallRulesParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["fordisplay"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("fordisplay", vv)
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["ipaddressid"]; found {
+		u.Set("ipaddressid", v.(string))
+	}
+	if v, found := p.p["isrecursive"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isrecursive", vv)
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["listall"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("listall", vv)
+	}
+	if v, found := p.p["networkid"]; found {
+		u.Set("networkid", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["tags"]; found {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
+		}
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListEgressFirewallRulesParams) Validate() error {
+	v := newParamValidator("listEgressFirewallRules", p.p)
+	v.ids("domainid", "id", "ipaddressid", "networkid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListEgressFirewallRulesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListEgressFirewallRulesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "ipaddressid", "keyword", "networkid", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listEgressFirewallRules", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listEgressFirewallRules: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListEgressFirewallRulesParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *ListEgressFirewallRulesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListEgressFirewallRulesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *ListEgressFirewallRulesParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *ListEgressFirewallRulesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListEgressFirewallRulesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *ListEgressFirewallRulesParams) SetFordisplay(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fordisplay"] = v
+}
+
+func (p *ListEgressFirewallRulesParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListEgressFirewallRulesParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
+func (p *ListEgressFirewallRulesParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListEgressFirewallRulesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListEgressFirewallRulesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListEgressFirewallRulesParams) SetIpaddressid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ipaddressid"] = v
+}
+
+func (p *ListEgressFirewallRulesParams) ResetIpaddressid() {
+	delete(p.p, "ipaddressid")
+}
+
+func (p *ListEgressFirewallRulesParams) GetIpaddressid() (string, bool) {
+	value, ok := p.p["ipaddressid"].(string)
+	return value, ok
+}
+
+func (p *ListEgressFirewallRulesParams) SetIsrecursive(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isrecursive"] = v
+}
+
+func (p *ListEgressFirewallRulesParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListEgressFirewallRulesParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
+func (p *ListEgressFirewallRulesParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListEgressFirewallRulesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListEgressFirewallRulesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListEgressFirewallRulesParams) SetListall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["listall"] = v
+}
+
+func (p *ListEgressFirewallRulesParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListEgressFirewallRulesParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
+func (p *ListEgressFirewallRulesParams) SetNetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networkid"] = v
+}
+
+func (p *ListEgressFirewallRulesParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListEgressFirewallRulesParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
+func (p *ListEgressFirewallRulesParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListEgressFirewallRulesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListEgressFirewallRulesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListEgressFirewallRulesParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListEgressFirewallRulesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListEgressFirewallRulesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListEgressFirewallRulesParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *ListEgressFirewallRulesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListEgressFirewallRulesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *ListEgressFirewallRulesParams) SetTags(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["tags"] = v
+}
+
+func (p *ListEgressFirewallRulesParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListEgressFirewallRulesParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListEgressFirewallRulesParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewListEgressFirewallRulesParams() *ListEgressFirewallRulesParams {
+	p := &ListEgressFirewallRulesParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *FirewallService) GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error) {
+	return s.GetEgressFirewallRuleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetEgressFirewallRuleByIDWithContext is the same as GetEgressFirewallRuleByID, but uses ctx to cancel the request
+func (s *FirewallService) GetEgressFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*EgressFirewallRule, int, error) {
+	p := &ListEgressFirewallRulesParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListEgressFirewallRulesWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.EgressFirewallRules[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for EgressFirewallRule UUID: %s!", id)
+}
+
+// Lists all egress firewall rules for network ID.
+func (s *FirewallService) ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
+	return s.ListEgressFirewallRulesWithContext(context.Background(), p)
+}
+
+// ListEgressFirewallRulesWithContext is the same as ListEgressFirewallRules, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) ListEgressFirewallRulesWithContext(ctx context.Context, p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listEgressFirewallRules", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = convertFirewallServiceResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListEgressFirewallRulesResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListEgressFirewallRulesPager iterates over all pages of a ListEgressFirewallRules call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListEgressFirewallRulesPager struct {
+	pager
+
+	s *FirewallService
+	p *ListEgressFirewallRulesParams
+	r *ListEgressFirewallRulesResponse
+}
+
+// NewListEgressFirewallRulesPager returns a pager for all pages of a ListEgressFirewallRules call with the given params
+func (s *FirewallService) NewListEgressFirewallRulesPager(p *ListEgressFirewallRulesParams) *ListEgressFirewallRulesPager {
+	return &ListEgressFirewallRulesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListEgressFirewallRulesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListEgressFirewallRulesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListEgressFirewallRulesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListEgressFirewallRulesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.EgressFirewallRules), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListEgressFirewallRulesPager) Page() *ListEgressFirewallRulesResponse {
+	return pg.r
+}
+
+// ListEgressFirewallRulesAll fetches all pages of a ListEgressFirewallRules call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *FirewallService) ListEgressFirewallRulesAll(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
+	return s.ListEgressFirewallRulesAllWithContext(context.Background(), p)
+}
+
+// ListEgressFirewallRulesAllWithContext is the same as ListEgressFirewallRulesAll, but uses ctx to cancel the requests
+func (s *FirewallService) ListEgressFirewallRulesAllWithContext(ctx context.Context, p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*EgressFirewallRule)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListEgressFirewallRulesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListEgressFirewallRulesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.EgressFirewallRules
+		mu.Unlock()
+
+		return len(l.EgressFirewallRules), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListEgressFirewallRulesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.EgressFirewallRules = append(r.EgressFirewallRules, pages[page]...)
+	}
+	r.Count = len(r.EgressFirewallRules)
+
+	return r, nil
+}
+
+type ListEgressFirewallRulesResponse struct {
+	Count               int                   `json:"count"`
+	EgressFirewallRules []*EgressFirewallRule `json:"firewallrule"`
+}
+
+type EgressFirewallRule struct {
+	Cidrlist     string `json:"cidrlist"`
+	Destcidrlist string `json:"destcidrlist"`
+	Endport      int    `json:"endport"`
+	Fordisplay   bool   `json:"fordisplay"`
+	Icmpcode     int    `json:"icmpcode"`
+	Icmptype     int    `json:"icmptype"`
+	Id           string `json:"id"`
+	Ipaddress    string `json:"ipaddress"`
+	Ipaddressid  string `json:"ipaddressid"`
+	JobID        string `json:"jobid"`
+	Jobstatus    int    `json:"jobstatus"`
+	Networkid    string `json:"networkid"`
+	Protocol     string `json:"protocol"`
+	Startport    int    `json:"startport"`
+	State        string `json:"state"`
+	Tags         []Tags `json:"tags"`
+}
+
+type ListExternalFirewallsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListExternalFirewallsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListExternalFirewallsParams) Validate() error {
+	v := newParamValidator("listExternalFirewalls", p.p)
+	v.required("zoneid")
+	v.ids("zoneid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListExternalFirewallsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListExternalFirewallsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listExternalFirewalls", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listExternalFirewalls: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListExternalFirewallsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListExternalFirewallsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListExternalFirewallsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListExternalFirewallsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListExternalFirewallsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListExternalFirewallsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListExternalFirewallsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListExternalFirewallsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListExternalFirewallsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListExternalFirewallsParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ListExternalFirewallsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListExternalFirewallsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListExternalFirewallsParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewListExternalFirewallsParams(zoneid string) *ListExternalFirewallsParams {
+	p := &ListExternalFirewallsParams{}
+	p.p = make(map[string]interface{})
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// List external firewall appliances.
+func (s *FirewallService) ListExternalFirewalls(p *ListExternalFirewallsParams) (*ListExternalFirewallsResponse, error) {
+	return s.ListExternalFirewallsWithContext(context.Background(), p)
+}
+
+// ListExternalFirewallsWithContext is the same as ListExternalFirewalls, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) ListExternalFirewallsWithContext(ctx context.Context, p *ListExternalFirewallsParams) (*ListExternalFirewallsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listExternalFirewalls", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = convertFirewallServiceResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListExternalFirewallsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListExternalFirewallsPager iterates over all pages of a ListExternalFirewalls call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListExternalFirewallsPager struct {
+	pager
+
+	s *FirewallService
+	p *ListExternalFirewallsParams
+	r *ListExternalFirewallsResponse
+}
+
+// NewListExternalFirewallsPager returns a pager for all pages of a ListExternalFirewalls call with the given params
+func (s *FirewallService) NewListExternalFirewallsPager(p *ListExternalFirewallsParams) *ListExternalFirewallsPager {
+	return &ListExternalFirewallsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListExternalFirewallsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListExternalFirewallsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListExternalFirewallsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListExternalFirewallsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.ExternalFirewalls), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListExternalFirewallsPager) Page() *ListExternalFirewallsResponse {
+	return pg.r
+}
+
+// ListExternalFirewallsAll fetches all pages of a ListExternalFirewalls call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *FirewallService) ListExternalFirewallsAll(p *ListExternalFirewallsParams) (*ListExternalFirewallsResponse, error) {
+	return s.ListExternalFirewallsAllWithContext(context.Background(), p)
+}
+
+// ListExternalFirewallsAllWithContext is the same as ListExternalFirewallsAll, but uses ctx to cancel the requests
+func (s *FirewallService) ListExternalFirewallsAllWithContext(ctx context.Context, p *ListExternalFirewallsParams) (*ListExternalFirewallsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*ExternalFirewall)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListExternalFirewallsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListExternalFirewallsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.ExternalFirewalls
+		mu.Unlock()
+
+		return len(l.ExternalFirewalls), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListExternalFirewallsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.ExternalFirewalls = append(r.ExternalFirewalls, pages[page]...)
+	}
+	r.Count = len(r.ExternalFirewalls)
+
+	return r, nil
+}
+
+type ListExternalFirewallsResponse struct {
+	Count             int                 `json:"count"`
+	ExternalFirewalls []*ExternalFirewall `json:"externalfirewall"`
+}
+
+type ExternalFirewall struct {
+	Id               string `json:"id"`
+	Ipaddress        string `json:"ipaddress"`
+	JobID            string `json:"jobid"`
+	Jobstatus        int    `json:"jobstatus"`
+	Numretries       string `json:"numretries"`
+	Privateinterface string `json:"privateinterface"`
+	Privatezone      string `json:"privatezone"`
+	Publicinterface  string `json:"publicinterface"`
+	Publiczone       string `json:"publiczone"`
+	Timeout          string `json:"timeout"`
+	Usageinterface   string `json:"usageinterface"`
+	Username         string `json:"username"`
+	Zoneid           string `json:"zoneid"`
+}
+
+type ListFirewallRulesParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListFirewallRulesParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["fordisplay"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("fordisplay", vv)
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["ipaddressid"]; found {
+		u.Set("ipaddressid", v.(string))
+	}
+	if v, found := p.p["isrecursive"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isrecursive", vv)
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["listall"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("listall", vv)
+	}
+	if v, found := p.p["networkid"]; found {
+		u.Set("networkid", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["tags"]; found {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
+		}
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListFirewallRulesParams) Validate() error {
+	v := newParamValidator("listFirewallRules", p.p)
+	v.ids("domainid", "id", "ipaddressid", "networkid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListFirewallRulesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListFirewallRulesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "ipaddressid", "keyword", "networkid", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listFirewallRules", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listFirewallRules: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListFirewallRulesParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *ListFirewallRulesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListFirewallRulesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *ListFirewallRulesParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *ListFirewallRulesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListFirewallRulesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *ListFirewallRulesParams) SetFordisplay(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fordisplay"] = v
+}
+
+func (p *ListFirewallRulesParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListFirewallRulesParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
+func (p *ListFirewallRulesParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListFirewallRulesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListFirewallRulesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListFirewallRulesParams) SetIpaddressid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ipaddressid"] = v
+}
+
+func (p *ListFirewallRulesParams) ResetIpaddressid() {
+	delete(p.p, "ipaddressid")
+}
+
+func (p *ListFirewallRulesParams) GetIpaddressid() (string, bool) {
+	value, ok := p.p["ipaddressid"].(string)
+	return value, ok
+}
+
+func (p *ListFirewallRulesParams) SetIsrecursive(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isrecursive"] = v
+}
+
+func (p *ListFirewallRulesParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListFirewallRulesParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
+func (p *ListFirewallRulesParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListFirewallRulesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListFirewallRulesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListFirewallRulesParams) SetListall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["listall"] = v
+}
+
+func (p *ListFirewallRulesParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListFirewallRulesParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
+func (p *ListFirewallRulesParams) SetNetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networkid"] = v
+}
+
+func (p *ListFirewallRulesParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListFirewallRulesParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
+func (p *ListFirewallRulesParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListFirewallRulesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListFirewallRulesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListFirewallRulesParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListFirewallRulesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListFirewallRulesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListFirewallRulesParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *ListFirewallRulesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListFirewallRulesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *ListFirewallRulesParams) SetTags(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["tags"] = v
+}
+
+func (p *ListFirewallRulesParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListFirewallRulesParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListFirewallRulesParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewListFirewallRulesParams() *ListFirewallRulesParams {
+	p := &ListFirewallRulesParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error) {
+	return s.GetFirewallRuleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetFirewallRuleByIDWithContext is the same as GetFirewallRuleByID, but uses ctx to cancel the request
+func (s *FirewallService) GetFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*FirewallRule, int, error) {
+	p := &ListFirewallRulesParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListFirewallRulesWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.FirewallRules[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for FirewallRule UUID: %s!", id)
+}
+
+// Lists all firewall rules for an IP address.
+func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+	return s.ListFirewallRulesWithContext(context.Background(), p)
+}
+
+// ListFirewallRulesWithContext is the same as ListFirewallRules, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) ListFirewallRulesWithContext(ctx context.Context, p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listFirewallRules", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = convertFirewallServiceResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListFirewallRulesResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListFirewallRulesPager iterates over all pages of a ListFirewallRules call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListFirewallRulesPager struct {
+	pager
+
+	s *FirewallService
+	p *ListFirewallRulesParams
+	r *ListFirewallRulesResponse
+}
+
+// NewListFirewallRulesPager returns a pager for all pages of a ListFirewallRules call with the given params
+func (s *FirewallService) NewListFirewallRulesPager(p *ListFirewallRulesParams) *ListFirewallRulesPager {
+	return &ListFirewallRulesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListFirewallRulesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListFirewallRulesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListFirewallRulesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListFirewallRulesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.FirewallRules), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListFirewallRulesPager) Page() *ListFirewallRulesResponse {
+	return pg.r
+}
+
+// ListFirewallRulesAll fetches all pages of a ListFirewallRules call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *FirewallService) ListFirewallRulesAll(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+	return s.ListFirewallRulesAllWithContext(context.Background(), p)
+}
+
+// ListFirewallRulesAllWithContext is the same as ListFirewallRulesAll, but uses ctx to cancel the requests
+func (s *FirewallService) ListFirewallRulesAllWithContext(ctx context.Context, p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*FirewallRule)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListFirewallRulesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListFirewallRulesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.FirewallRules
+		mu.Unlock()
+
+		return len(l.FirewallRules), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListFirewallRulesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.FirewallRules = append(r.FirewallRules, pages[page]...)
+	}
+	r.Count = len(r.FirewallRules)
+
+	return r, nil
+}
+
+type ListFirewallRulesResponse struct {
+	Count         int             `json:"count"`
+	FirewallRules []*FirewallRule `json:"firewallrule"`
+}
+
+type FirewallRule struct {
+	Cidrlist     string `json:"cidrlist"`
+	Destcidrlist string `json:"destcidrlist"`
+	Endport      int    `json:"endport"`
+	Fordisplay   bool   `json:"fordisplay"`
+	Icmpcode     int    `json:"icmpcode"`
+	Icmptype     int    `json:"icmptype"`
+	Id           string `json:"id"`
+	Ipaddress    string `json:"ipaddress"`
+	Ipaddressid  string `json:"ipaddressid"`
+	JobID        string `json:"jobid"`
+	Jobstatus    int    `json:"jobstatus"`
+	Networkid    string `json:"networkid"`
+	Protocol     string `json:"protocol"`
+	Startport    int    `json:"startport"`
+	State        string `json:"state"`
+	Tags         []Tags `json:"tags"`
+}
+
+type ListPaloAltoFirewallsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListPaloAltoFirewallsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["fwdeviceid"]; found {
+		u.Set("fwdeviceid", v.(string))
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["physicalnetworkid"]; found {
+		u.Set("physicalnetworkid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListPaloAltoFirewallsParams) Validate() error {
+	v := newParamValidator("listPaloAltoFirewalls", p.p)
+	v.ids("fwdeviceid", "physicalnetworkid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListPaloAltoFirewallsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListPaloAltoFirewallsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "fwdeviceid", "keyword", "physicalnetworkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listPaloAltoFirewalls", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listPaloAltoFirewalls: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListPaloAltoFirewallsParams) SetFwdeviceid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fwdeviceid"] = v
+}
+
+func (p *ListPaloAltoFirewallsParams) ResetFwdeviceid() {
+	delete(p.p, "fwdeviceid")
+}
+
+func (p *ListPaloAltoFirewallsParams) GetFwdeviceid() (string, bool) {
+	value, ok := p.p["fwdeviceid"].(string)
+	return value, ok
+}
+
+func (p *ListPaloAltoFirewallsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListPaloAltoFirewallsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListPaloAltoFirewallsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListPaloAltoFirewallsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListPaloAltoFirewallsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListPaloAltoFirewallsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListPaloAltoFirewallsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListPaloAltoFirewallsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListPaloAltoFirewallsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListPaloAltoFirewallsParams) SetPhysicalnetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["physicalnetworkid"] = v
+}
+
+func (p *ListPaloAltoFirewallsParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListPaloAltoFirewallsParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListPaloAltoFirewallsParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewListPaloAltoFirewallsParams() *ListPaloAltoFirewallsParams {
+	p := &ListPaloAltoFirewallsParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// lists Palo Alto firewall devices in a physical network
+func (s *FirewallService) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
+	return s.ListPaloAltoFirewallsWithContext(context.Background(), p)
+}
+
+// ListPaloAltoFirewallsWithContext is the same as ListPaloAltoFirewalls, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) ListPaloAltoFirewallsWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listPaloAltoFirewalls", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = convertFirewallServiceResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListPaloAltoFirewallsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListPaloAltoFirewallsPager iterates over all pages of a ListPaloAltoFirewalls call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListPaloAltoFirewallsPager struct {
+	pager
+
+	s *FirewallService
+	p *ListPaloAltoFirewallsParams
+	r *ListPaloAltoFirewallsResponse
+}
+
+// NewListPaloAltoFirewallsPager returns a pager for all pages of a ListPaloAltoFirewalls call with the given params
+func (s *FirewallService) NewListPaloAltoFirewallsPager(p *ListPaloAltoFirewallsParams) *ListPaloAltoFirewallsPager {
+	return &ListPaloAltoFirewallsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListPaloAltoFirewallsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListPaloAltoFirewallsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListPaloAltoFirewallsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListPaloAltoFirewallsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.PaloAltoFirewalls), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListPaloAltoFirewallsPager) Page() *ListPaloAltoFirewallsResponse {
+	return pg.r
+}
+
+// ListPaloAltoFirewallsAll fetches all pages of a ListPaloAltoFirewalls call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *FirewallService) ListPaloAltoFirewallsAll(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
+	return s.ListPaloAltoFirewallsAllWithContext(context.Background(), p)
+}
+
+// ListPaloAltoFirewallsAllWithContext is the same as ListPaloAltoFirewallsAll, but uses ctx to cancel the requests
+func (s *FirewallService) ListPaloAltoFirewallsAllWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*PaloAltoFirewall)
 
-	resp, err = convertFirewallServiceResponse(resp)
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListPaloAltoFirewallsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListPaloAltoFirewallsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.PaloAltoFirewalls
+		mu.Unlock()
+
+		return len(l.PaloAltoFirewalls), l.Count, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListEgressFirewallRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	r := &ListPaloAltoFirewallsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.PaloAltoFirewalls = append(r.PaloAltoFirewalls, pages[page]...)
 	}
+	r.Count = len(r.PaloAltoFirewalls)
 
-	return &r, nil
+	return r, nil
 }
 
-type ListEgressFirewallRulesResponse struct {
-	Count               int                   `json:"count"`
-	EgressFirewallRules []*EgressFirewallRule `json:"firewallrule"`
+type ListPaloAltoFirewallsResponse struct {
+	Count             int                 `json:"count"`
+	PaloAltoFirewalls []*PaloAltoFirewall `json:"paloaltofirewall"`
 }
 
-type EgressFirewallRule struct {
-	Cidrlist     string `json:"cidrlist"`
-	Destcidrlist string `json:"destcidrlist"`
-	Endport      int    `json:"endport"`
-	Fordisplay   bool   `json:"fordisplay"`
-	Icmpcode     int    `json:"icmpcode"`
-	Icmptype     int    `json:"icmptype"`
-	Id           string `json:"id"`
-	Ipaddress    string `json:"ipaddress"`
-	Ipaddressid  string `json:"ipaddressid"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Networkid    string `json:"networkid"`
-	Protocol     string `json:"protocol"`
-	Startport    int    `json:"startport"`
-	State        string `json:"state"`
-	Tags         []Tags `json:"tags"`
+type PaloAltoFirewall struct {
+	Fwdevicecapacity  int64  `json:"fwdevicecapacity"`
+	Fwdeviceid        string `json:"fwdeviceid"`
+	Fwdevicename      string `json:"fwdevicename"`
+	Fwdevicestate     string `json:"fwdevicestate"`
+	Ipaddress         string `json:"ipaddress"`
+	JobID             string `json:"jobid"`
+	Jobstatus         int    `json:"jobstatus"`
+	Numretries        string `json:"numretries"`
+	Physicalnetworkid string `json:"physicalnetworkid"`
+	Privateinterface  string `json:"privateinterface"`
+	Privatezone       string `json:"privatezone"`
+	Provider          string `json:"provider"`
+	Publicinterface   string `json:"publicinterface"`
+	Publiczone        string `json:"publiczone"`
+	Timeout           string `json:"timeout"`
+	Usageinterface    string `json:"usageinterface"`
+	Username          string `json:"username"`
+	Zoneid            string `json:"zoneid"`
 }
 
-type ListFirewallRulesParams struct {
+type ListPortForwardingRulesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListFirewallRulesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListPortForwardingRulesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1483,108 +6485,285 @@ func (p *ListFirewallRulesParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *ListFirewallRulesParams) SetAccount(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListPortForwardingRulesParams) Validate() error {
+	v := newParamValidator("listPortForwardingRules", p.p)
+	v.ids("domainid", "id", "ipaddressid", "networkid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListPortForwardingRulesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListPortForwardingRulesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "ipaddressid", "keyword", "networkid", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listPortForwardingRules", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listPortForwardingRules: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListPortForwardingRulesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["account"] = v
 }
 
-func (p *ListFirewallRulesParams) SetDomainid(v string) {
+func (p *ListPortForwardingRulesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListPortForwardingRulesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *ListPortForwardingRulesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["domainid"] = v
 }
 
-func (p *ListFirewallRulesParams) SetFordisplay(v bool) {
+func (p *ListPortForwardingRulesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListPortForwardingRulesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *ListPortForwardingRulesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["fordisplay"] = v
 }
 
-func (p *ListFirewallRulesParams) SetId(v string) {
+func (p *ListPortForwardingRulesParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListPortForwardingRulesParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
+func (p *ListPortForwardingRulesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["id"] = v
 }
 
-func (p *ListFirewallRulesParams) SetIpaddressid(v string) {
+func (p *ListPortForwardingRulesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListPortForwardingRulesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListPortForwardingRulesParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["ipaddressid"] = v
 }
 
-func (p *ListFirewallRulesParams) SetIsrecursive(v bool) {
+func (p *ListPortForwardingRulesParams) ResetIpaddressid() {
+	delete(p.p, "ipaddressid")
+}
+
+func (p *ListPortForwardingRulesParams) GetIpaddressid() (string, bool) {
+	value, ok := p.p["ipaddressid"].(string)
+	return value, ok
+}
+
+func (p *ListPortForwardingRulesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["isrecursive"] = v
 }
 
-func (p *ListFirewallRulesParams) SetKeyword(v string) {
+func (p *ListPortForwardingRulesParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListPortForwardingRulesParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
+func (p *ListPortForwardingRulesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["keyword"] = v
 }
 
-func (p *ListFirewallRulesParams) SetListall(v bool) {
+func (p *ListPortForwardingRulesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListPortForwardingRulesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListPortForwardingRulesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["listall"] = v
 }
 
-func (p *ListFirewallRulesParams) SetNetworkid(v string) {
+func (p *ListPortForwardingRulesParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListPortForwardingRulesParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
+func (p *ListPortForwardingRulesParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["networkid"] = v
 }
 
-func (p *ListFirewallRulesParams) SetPage(v int) {
+func (p *ListPortForwardingRulesParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListPortForwardingRulesParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
+func (p *ListPortForwardingRulesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["page"] = v
 }
 
-func (p *ListFirewallRulesParams) SetPagesize(v int) {
+func (p *ListPortForwardingRulesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListPortForwardingRulesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListPortForwardingRulesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["pagesize"] = v
 }
 
-func (p *ListFirewallRulesParams) SetProjectid(v string) {
+func (p *ListPortForwardingRulesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListPortForwardingRulesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListPortForwardingRulesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["projectid"] = v
 }
 
-func (p *ListFirewallRulesParams) SetTags(v map[string]string) {
+func (p *ListPortForwardingRulesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListPortForwardingRulesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *ListPortForwardingRulesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["tags"] = v
 }
 
-// You should always use this function to get a new ListFirewallRulesParams instance,
+func (p *ListPortForwardingRulesParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListPortForwardingRulesParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListPortForwardingRulesParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewListFirewallRulesParams() *ListFirewallRulesParams {
-	p := &ListFirewallRulesParams{}
+func (s *FirewallService) NewListPortForwardingRulesParams() *ListPortForwardingRulesParams {
+	p := &ListPortForwardingRulesParams{}
 	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error) {
-	p := &ListFirewallRulesParams{}
+func (s *FirewallService) GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error) {
+	return s.GetPortForwardingRuleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetPortForwardingRuleByIDWithContext is the same as GetPortForwardingRuleByID, but uses ctx to cancel the request
+func (s *FirewallService) GetPortForwardingRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PortForwardingRule, int, error) {
+	p := &ListPortForwardingRulesParams{}
 	p.p = make(map[string]interface{})
 
 	p.p["id"] = id
@@ -1595,29 +6774,36 @@ func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*F
 		}
 	}
 
-	l, err := s.ListFirewallRules(p)
+	l, err := s.ListPortForwardingRulesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
-		return l.FirewallRules[0], l.Count, nil
+		return l.PortForwardingRules[0], l.Count, nil
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for FirewallRule UUID: %s!", id)
+	return nil, l.Count, fmt.Errorf("There is more then one result for PortForwardingRule UUID: %s!", id)
 }
 
-// Lists all firewall rules for an IP address.
-func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
-	resp, err := s.cs.newRequest("listFirewallRules", p.toURLValues())
+// Lists all port forwarding rules for an IP address.
+func (s *FirewallService) ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
+	return s.ListPortForwardingRulesWithContext(context.Background(), p)
+}
+
+// ListPortForwardingRulesWithContext is the same as ListPortForwardingRules, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) ListPortForwardingRulesWithContext(ctx context.Context, p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listPortForwardingRules", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1627,7 +6813,7 @@ func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFi
 		return nil, err
 	}
 
-	var r ListFirewallRulesResponse
+	var r ListPortForwardingRulesResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
@@ -1635,45 +6821,135 @@ func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFi
 	return &r, nil
 }
 
-type ListFirewallRulesResponse struct {
-	Count         int             `json:"count"`
-	FirewallRules []*FirewallRule `json:"firewallrule"`
+// ListPortForwardingRulesPager iterates over all pages of a ListPortForwardingRules call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListPortForwardingRulesPager struct {
+	pager
+
+	s *FirewallService
+	p *ListPortForwardingRulesParams
+	r *ListPortForwardingRulesResponse
 }
 
-type FirewallRule struct {
-	Cidrlist     string `json:"cidrlist"`
-	Destcidrlist string `json:"destcidrlist"`
-	Endport      int    `json:"endport"`
-	Fordisplay   bool   `json:"fordisplay"`
-	Icmpcode     int    `json:"icmpcode"`
-	Icmptype     int    `json:"icmptype"`
-	Id           string `json:"id"`
-	Ipaddress    string `json:"ipaddress"`
-	Ipaddressid  string `json:"ipaddressid"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Networkid    string `json:"networkid"`
-	Protocol     string `json:"protocol"`
-	Startport    int    `json:"startport"`
-	State        string `json:"state"`
-	Tags         []Tags `json:"tags"`
+// NewListPortForwardingRulesPager returns a pager for all pages of a ListPortForwardingRules call with the given params
+func (s *FirewallService) NewListPortForwardingRulesPager(p *ListPortForwardingRulesParams) *ListPortForwardingRulesPager {
+	return &ListPortForwardingRulesPager{pager: newPager(p.p), s: s, p: p}
 }
 
-type ListPaloAltoFirewallsParams struct {
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListPortForwardingRulesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListPortForwardingRulesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListPortForwardingRulesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListPortForwardingRulesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.PortForwardingRules), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListPortForwardingRulesPager) Page() *ListPortForwardingRulesResponse {
+	return pg.r
+}
+
+// ListPortForwardingRulesAll fetches all pages of a ListPortForwardingRules call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *FirewallService) ListPortForwardingRulesAll(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
+	return s.ListPortForwardingRulesAllWithContext(context.Background(), p)
+}
+
+// ListPortForwardingRulesAllWithContext is the same as ListPortForwardingRulesAll, but uses ctx to cancel the requests
+func (s *FirewallService) ListPortForwardingRulesAllWithContext(ctx context.Context, p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*PortForwardingRule)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListPortForwardingRulesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListPortForwardingRulesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.PortForwardingRules
+		mu.Unlock()
+
+		return len(l.PortForwardingRules), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListPortForwardingRulesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.PortForwardingRules = append(r.PortForwardingRules, pages[page]...)
+	}
+	r.Count = len(r.PortForwardingRules)
+
+	return r, nil
+}
+
+type ListPortForwardingRulesResponse struct {
+	Count               int                   `json:"count"`
+	PortForwardingRules []*PortForwardingRule `json:"portforwardingrule"`
+}
+
+type PortForwardingRule struct {
+	Cidrlist                  string `json:"cidrlist"`
+	Fordisplay                bool   `json:"fordisplay"`
+	Id                        string `json:"id"`
+	Ipaddress                 string `json:"ipaddress"`
+	Ipaddressid               string `json:"ipaddressid"`
+	JobID                     string `json:"jobid"`
+	Jobstatus                 int    `json:"jobstatus"`
+	Networkid                 string `json:"networkid"`
+	Privateendport            string `json:"privateendport"`
+	Privateport               string `json:"privateport"`
+	Protocol                  string `json:"protocol"`
+	Publicendport             string `json:"publicendport"`
+	Publicport                string `json:"publicport"`
+	State                     string `json:"state"`
+	Tags                      []Tags `json:"tags"`
+	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string `json:"virtualmachineid"`
+	Virtualmachinename        string `json:"virtualmachinename"`
+	Vmguestip                 string `json:"vmguestip"`
+}
+
+type ListSrxFirewallNetworksParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListPaloAltoFirewallsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListSrxFirewallNetworksParams) ToURLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["fwdeviceid"]; found {
-		u.Set("fwdeviceid", v.(string))
+	if p.p == nil {
+		return u
 	}
 	if v, found := p.p["keyword"]; found {
 		u.Set("keyword", v.(string))
 	}
+	if v, found := p.p["lbdeviceid"]; found {
+		u.Set("lbdeviceid", v.(string))
+	}
 	if v, found := p.p["page"]; found {
 		vv := strconv.Itoa(v.(int))
 		u.Set("page", vv)
@@ -1682,58 +6958,183 @@ func (p *ListPaloAltoFirewallsParams) toURLValues() url.Values {
 		vv := strconv.Itoa(v.(int))
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
 	return u
 }
 
-func (p *ListPaloAltoFirewallsParams) SetFwdeviceid(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListSrxFirewallNetworksParams) Validate() error {
+	v := newParamValidator("listSrxFirewallNetworks", p.p)
+	v.required("lbdeviceid")
+	v.ids("lbdeviceid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListSrxFirewallNetworksParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListSrxFirewallNetworksParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "lbdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listSrxFirewallNetworks", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listSrxFirewallNetworks: %w", k, err)
+		}
 	}
-	p.p["fwdeviceid"] = v
+	return nil
 }
 
-func (p *ListPaloAltoFirewallsParams) SetKeyword(v string) {
+func (p *ListSrxFirewallNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["keyword"] = v
 }
 
-func (p *ListPaloAltoFirewallsParams) SetPage(v int) {
+func (p *ListSrxFirewallNetworksParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListSrxFirewallNetworksParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListSrxFirewallNetworksParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["page"] = v
+	p.p["lbdeviceid"] = v
 }
 
-func (p *ListPaloAltoFirewallsParams) SetPagesize(v int) {
+func (p *ListSrxFirewallNetworksParams) ResetLbdeviceid() {
+	delete(p.p, "lbdeviceid")
+}
+
+func (p *ListSrxFirewallNetworksParams) GetLbdeviceid() (string, bool) {
+	value, ok := p.p["lbdeviceid"].(string)
+	return value, ok
+}
+
+func (p *ListSrxFirewallNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["pagesize"] = v
+	p.p["page"] = v
 }
 
-func (p *ListPaloAltoFirewallsParams) SetPhysicalnetworkid(v string) {
+func (p *ListSrxFirewallNetworksParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListSrxFirewallNetworksParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListSrxFirewallNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["physicalnetworkid"] = v
+	p.p["pagesize"] = v
 }
 
-// You should always use this function to get a new ListPaloAltoFirewallsParams instance,
+func (p *ListSrxFirewallNetworksParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListSrxFirewallNetworksParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+// You should always use this function to get a new ListSrxFirewallNetworksParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewListPaloAltoFirewallsParams() *ListPaloAltoFirewallsParams {
-	p := &ListPaloAltoFirewallsParams{}
+func (s *FirewallService) NewListSrxFirewallNetworksParams(lbdeviceid string) *ListSrxFirewallNetworksParams {
+	p := &ListSrxFirewallNetworksParams{}
 	p.p = make(map[string]interface{})
+	p.p["lbdeviceid"] = lbdeviceid
 	return p
 }
 
-// lists Palo Alto firewall devices in a physical network
-func (s *FirewallService) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
-	resp, err := s.cs.newRequest("listPaloAltoFirewalls", p.toURLValues())
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *FirewallService) GetSrxFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSrxFirewallNetworkIDWithContext(context.Background(), keyword, lbdeviceid, opts...)
+}
+
+// GetSrxFirewallNetworkIDWithContext is the same as GetSrxFirewallNetworkID, but uses ctx to cancel the request
+func (s *FirewallService) GetSrxFirewallNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
+	p := &ListSrxFirewallNetworksParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["keyword"] = keyword
+	p.p["lbdeviceid"] = lbdeviceid
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListSrxFirewallNetworksWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.SrxFirewallNetworks[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.SrxFirewallNetworks {
+			if v.Name == keyword {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// lists network that are using SRX firewall device
+func (s *FirewallService) ListSrxFirewallNetworks(p *ListSrxFirewallNetworksParams) (*ListSrxFirewallNetworksResponse, error) {
+	return s.ListSrxFirewallNetworksWithContext(context.Background(), p)
+}
+
+// ListSrxFirewallNetworksWithContext is the same as ListSrxFirewallNetworks, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) ListSrxFirewallNetworksWithContext(ctx context.Context, p *ListSrxFirewallNetworksParams) (*ListSrxFirewallNetworksResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listSrxFirewallNetworks", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1743,7 +7144,7 @@ func (s *FirewallService) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams)
 		return nil, err
 	}
 
-	var r ListPaloAltoFirewallsResponse
+	var r ListSrxFirewallNetworksResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
@@ -1751,71 +7152,193 @@ func (s *FirewallService) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams)
 	return &r, nil
 }
 
-type ListPaloAltoFirewallsResponse struct {
-	Count             int                 `json:"count"`
-	PaloAltoFirewalls []*PaloAltoFirewall `json:"paloaltofirewall"`
+// ListSrxFirewallNetworksPager iterates over all pages of a ListSrxFirewallNetworks call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListSrxFirewallNetworksPager struct {
+	pager
+
+	s *FirewallService
+	p *ListSrxFirewallNetworksParams
+	r *ListSrxFirewallNetworksResponse
 }
 
-type PaloAltoFirewall struct {
-	Fwdevicecapacity  int64  `json:"fwdevicecapacity"`
-	Fwdeviceid        string `json:"fwdeviceid"`
-	Fwdevicename      string `json:"fwdevicename"`
-	Fwdevicestate     string `json:"fwdevicestate"`
-	Ipaddress         string `json:"ipaddress"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Numretries        string `json:"numretries"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Privateinterface  string `json:"privateinterface"`
-	Privatezone       string `json:"privatezone"`
-	Provider          string `json:"provider"`
-	Publicinterface   string `json:"publicinterface"`
-	Publiczone        string `json:"publiczone"`
-	Timeout           string `json:"timeout"`
-	Usageinterface    string `json:"usageinterface"`
-	Username          string `json:"username"`
-	Zoneid            string `json:"zoneid"`
+// NewListSrxFirewallNetworksPager returns a pager for all pages of a ListSrxFirewallNetworks call with the given params
+func (s *FirewallService) NewListSrxFirewallNetworksPager(p *ListSrxFirewallNetworksParams) *ListSrxFirewallNetworksPager {
+	return &ListSrxFirewallNetworksPager{pager: newPager(p.p), s: s, p: p}
 }
 
-type ListPortForwardingRulesParams struct {
-	p map[string]interface{}
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListSrxFirewallNetworksPager) Next() bool {
+	return pg.NextWithContext(context.Background())
 }
 
-func (p *ListPortForwardingRulesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListSrxFirewallNetworksPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+
+	p := &ListSrxFirewallNetworksParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListSrxFirewallNetworksWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	pg.r = r
+
+	return pg.update(len(r.SrxFirewallNetworks), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListSrxFirewallNetworksPager) Page() *ListSrxFirewallNetworksResponse {
+	return pg.r
+}
+
+// ListSrxFirewallNetworksAll fetches all pages of a ListSrxFirewallNetworks call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *FirewallService) ListSrxFirewallNetworksAll(p *ListSrxFirewallNetworksParams) (*ListSrxFirewallNetworksResponse, error) {
+	return s.ListSrxFirewallNetworksAllWithContext(context.Background(), p)
+}
+
+// ListSrxFirewallNetworksAllWithContext is the same as ListSrxFirewallNetworksAll, but uses ctx to cancel the requests
+func (s *FirewallService) ListSrxFirewallNetworksAllWithContext(ctx context.Context, p *ListSrxFirewallNetworksParams) (*ListSrxFirewallNetworksResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*SrxFirewallNetwork)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListSrxFirewallNetworksParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListSrxFirewallNetworksWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.SrxFirewallNetworks
+		mu.Unlock()
+
+		return len(l.SrxFirewallNetworks), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	r := &ListSrxFirewallNetworksResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.SrxFirewallNetworks = append(r.SrxFirewallNetworks, pages[page]...)
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+	r.Count = len(r.SrxFirewallNetworks)
+
+	return r, nil
+}
+
+type ListSrxFirewallNetworksResponse struct {
+	Count               int                   `json:"count"`
+	SrxFirewallNetworks []*SrxFirewallNetwork `json:"srxfirewallnetwork"`
+}
+
+type SrxFirewallNetwork struct {
+	Account                     string                      `json:"account"`
+	Aclid                       string                      `json:"aclid"`
+	Aclname                     string                      `json:"aclname"`
+	Acltype                     string                      `json:"acltype"`
+	Broadcastdomaintype         string                      `json:"broadcastdomaintype"`
+	Broadcasturi                string                      `json:"broadcasturi"`
+	Canusefordeploy             bool                        `json:"canusefordeploy"`
+	Cidr                        string                      `json:"cidr"`
+	Details                     map[string]string           `json:"details"`
+	Displaynetwork              bool                        `json:"displaynetwork"`
+	Displaytext                 string                      `json:"displaytext"`
+	Dns1                        string                      `json:"dns1"`
+	Dns2                        string                      `json:"dns2"`
+	Domain                      string                      `json:"domain"`
+	Domainid                    string                      `json:"domainid"`
+	Externalid                  string                      `json:"externalid"`
+	Gateway                     string                      `json:"gateway"`
+	Id                          string                      `json:"id"`
+	Ip6cidr                     string                      `json:"ip6cidr"`
+	Ip6gateway                  string                      `json:"ip6gateway"`
+	Isdefault                   bool                        `json:"isdefault"`
+	Ispersistent                bool                        `json:"ispersistent"`
+	Issystem                    bool                        `json:"issystem"`
+	JobID                       string                      `json:"jobid"`
+	Jobstatus                   int                         `json:"jobstatus"`
+	Name                        string                      `json:"name"`
+	Netmask                     string                      `json:"netmask"`
+	Networkcidr                 string                      `json:"networkcidr"`
+	Networkdomain               string                      `json:"networkdomain"`
+	Networkofferingavailability string                      `json:"networkofferingavailability"`
+	Networkofferingconservemode bool                        `json:"networkofferingconservemode"`
+	Networkofferingdisplaytext  string                      `json:"networkofferingdisplaytext"`
+	Networkofferingid           string                      `json:"networkofferingid"`
+	Networkofferingname         string                      `json:"networkofferingname"`
+	Physicalnetworkid           string                      `json:"physicalnetworkid"`
+	Project                     string                      `json:"project"`
+	Projectid                   string                      `json:"projectid"`
+	Redundantrouter             bool                        `json:"redundantrouter"`
+	Related                     string                      `json:"related"`
+	Reservediprange             string                      `json:"reservediprange"`
+	Restartrequired             bool                        `json:"restartrequired"`
+	Service                     []SrxFirewallNetworkService `json:"service"`
+	Specifyipranges             bool                        `json:"specifyipranges"`
+	State                       string                      `json:"state"`
+	Strechedl2subnet            bool                        `json:"strechedl2subnet"`
+	Subdomainaccess             bool                        `json:"subdomainaccess"`
+	Tags                        []Tags                      `json:"tags"`
+	Traffictype                 string                      `json:"traffictype"`
+	Type                        string                      `json:"type"`
+	Vlan                        string                      `json:"vlan"`
+	Vpcid                       string                      `json:"vpcid"`
+	Vpcname                     string                      `json:"vpcname"`
+	Zoneid                      string                      `json:"zoneid"`
+	Zonename                    string                      `json:"zonename"`
+	Zonesnetworkspans           []interface{}               `json:"zonesnetworkspans"`
+}
+
+type SrxFirewallNetworkService struct {
+	Capability []SrxFirewallNetworkServiceCapability `json:"capability"`
+	Name       string                                `json:"name"`
+	Provider   []SrxFirewallNetworkServiceProvider   `json:"provider"`
+}
+
+type SrxFirewallNetworkServiceProvider struct {
+	Canenableindividualservice   bool     `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
+	Id                           string   `json:"id"`
+	Name                         string   `json:"name"`
+	Physicalnetworkid            string   `json:"physicalnetworkid"`
+	Servicelist                  []string `json:"servicelist"`
+	State                        string   `json:"state"`
+}
+
+type SrxFirewallNetworkServiceCapability struct {
+	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
+	Name                       string `json:"name"`
+	Value                      string `json:"value"`
+}
+
+type ListSrxFirewallsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListSrxFirewallsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if v, found := p.p["fwdeviceid"]; found {
+		u.Set("fwdeviceid", v.(string))
 	}
 	if v, found := p.p["keyword"]; found {
 		u.Set("keyword", v.(string))
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
-	}
 	if v, found := p.p["page"]; found {
 		vv := strconv.Itoa(v.(int))
 		u.Set("page", vv)
@@ -1824,203 +7347,293 @@ func (p *ListPortForwardingRulesParams) toURLValues() url.Values {
 		vv := strconv.Itoa(v.(int))
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
-		}
+	if v, found := p.p["physicalnetworkid"]; found {
+		u.Set("physicalnetworkid", v.(string))
 	}
 	return u
 }
 
-func (p *ListPortForwardingRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-}
-
-func (p *ListPortForwardingRulesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListSrxFirewallsParams) Validate() error {
+	v := newParamValidator("listSrxFirewalls", p.p)
+	v.ids("fwdeviceid", "physicalnetworkid")
+	v.maxLength("keyword", 255)
+	return v.err()
 }
 
-func (p *ListPortForwardingRulesParams) SetFordisplay(v bool) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListSrxFirewallsParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListSrxFirewallsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "fwdeviceid", "keyword", "physicalnetworkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listSrxFirewalls", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listSrxFirewalls: %w", k, err)
+		}
 	}
-	p.p["fordisplay"] = v
+	return nil
 }
 
-func (p *ListPortForwardingRulesParams) SetId(v string) {
+func (p *ListSrxFirewallsParams) SetFwdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["fwdeviceid"] = v
 }
 
-func (p *ListPortForwardingRulesParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
+func (p *ListSrxFirewallsParams) ResetFwdeviceid() {
+	delete(p.p, "fwdeviceid")
 }
 
-func (p *ListPortForwardingRulesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+func (p *ListSrxFirewallsParams) GetFwdeviceid() (string, bool) {
+	value, ok := p.p["fwdeviceid"].(string)
+	return value, ok
 }
 
-func (p *ListPortForwardingRulesParams) SetKeyword(v string) {
+func (p *ListSrxFirewallsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["keyword"] = v
 }
 
-func (p *ListPortForwardingRulesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+func (p *ListSrxFirewallsParams) ResetKeyword() {
+	delete(p.p, "keyword")
 }
 
-func (p *ListPortForwardingRulesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+func (p *ListSrxFirewallsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
 }
 
-func (p *ListPortForwardingRulesParams) SetPage(v int) {
+func (p *ListSrxFirewallsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["page"] = v
 }
 
-func (p *ListPortForwardingRulesParams) SetPagesize(v int) {
+func (p *ListSrxFirewallsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListSrxFirewallsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListSrxFirewallsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["pagesize"] = v
 }
 
-func (p *ListPortForwardingRulesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+func (p *ListSrxFirewallsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
 }
 
-func (p *ListPortForwardingRulesParams) SetTags(v map[string]string) {
+func (p *ListSrxFirewallsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListSrxFirewallsParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["tags"] = v
+	p.p["physicalnetworkid"] = v
 }
 
-// You should always use this function to get a new ListPortForwardingRulesParams instance,
+func (p *ListSrxFirewallsParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListSrxFirewallsParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListSrxFirewallsParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewListPortForwardingRulesParams() *ListPortForwardingRulesParams {
-	p := &ListPortForwardingRulesParams{}
+func (s *FirewallService) NewListSrxFirewallsParams() *ListSrxFirewallsParams {
+	p := &ListSrxFirewallsParams{}
 	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *FirewallService) GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error) {
-	p := &ListPortForwardingRulesParams{}
-	p.p = make(map[string]interface{})
+// lists SRX firewall devices in a physical network
+func (s *FirewallService) ListSrxFirewalls(p *ListSrxFirewallsParams) (*ListSrxFirewallsResponse, error) {
+	return s.ListSrxFirewallsWithContext(context.Background(), p)
+}
+
+// ListSrxFirewallsWithContext is the same as ListSrxFirewalls, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) ListSrxFirewallsWithContext(ctx context.Context, p *ListSrxFirewallsParams) (*ListSrxFirewallsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listSrxFirewalls", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = convertFirewallServiceResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListSrxFirewallsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListSrxFirewallsPager iterates over all pages of a ListSrxFirewalls call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListSrxFirewallsPager struct {
+	pager
+
+	s *FirewallService
+	p *ListSrxFirewallsParams
+	r *ListSrxFirewallsResponse
+}
+
+// NewListSrxFirewallsPager returns a pager for all pages of a ListSrxFirewalls call with the given params
+func (s *FirewallService) NewListSrxFirewallsPager(p *ListSrxFirewallsParams) *ListSrxFirewallsPager {
+	return &ListSrxFirewallsPager{pager: newPager(p.p), s: s, p: p}
+}
 
-	p.p["id"] = id
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListSrxFirewallsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
 
-	for _, fn := range append(s.cs.options, opts...) {
-		if err := fn(s.cs, p); err != nil {
-			return nil, -1, err
-		}
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListSrxFirewallsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
 	}
 
-	l, err := s.ListPortForwardingRules(p)
+	p := &ListSrxFirewallsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListSrxFirewallsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+		return pg.update(0, err)
 	}
+	pg.r = r
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+	return pg.update(len(r.SrxFirewalls), nil)
+}
 
-	if l.Count == 1 {
-		return l.PortForwardingRules[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for PortForwardingRule UUID: %s!", id)
+// Page returns the page fetched by the last call to Next
+func (pg *ListSrxFirewallsPager) Page() *ListSrxFirewallsResponse {
+	return pg.r
 }
 
-// Lists all port forwarding rules for an IP address.
-func (s *FirewallService) ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
-	resp, err := s.cs.newRequest("listPortForwardingRules", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// ListSrxFirewallsAll fetches all pages of a ListSrxFirewalls call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *FirewallService) ListSrxFirewallsAll(p *ListSrxFirewallsParams) (*ListSrxFirewallsResponse, error) {
+	return s.ListSrxFirewallsAllWithContext(context.Background(), p)
+}
 
-	resp, err = convertFirewallServiceResponse(resp)
+// ListSrxFirewallsAllWithContext is the same as ListSrxFirewallsAll, but uses ctx to cancel the requests
+func (s *FirewallService) ListSrxFirewallsAllWithContext(ctx context.Context, p *ListSrxFirewallsParams) (*ListSrxFirewallsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*SrxFirewall)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListSrxFirewallsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListSrxFirewallsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.SrxFirewalls
+		mu.Unlock()
+
+		return len(l.SrxFirewalls), l.Count, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListPortForwardingRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	r := &ListSrxFirewallsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.SrxFirewalls = append(r.SrxFirewalls, pages[page]...)
 	}
+	r.Count = len(r.SrxFirewalls)
 
-	return &r, nil
+	return r, nil
 }
 
-type ListPortForwardingRulesResponse struct {
-	Count               int                   `json:"count"`
-	PortForwardingRules []*PortForwardingRule `json:"portforwardingrule"`
+type ListSrxFirewallsResponse struct {
+	Count        int            `json:"count"`
+	SrxFirewalls []*SrxFirewall `json:"srxfirewall"`
 }
 
-type PortForwardingRule struct {
-	Cidrlist                  string `json:"cidrlist"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Ipaddressid               string `json:"ipaddressid"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Privateendport            string `json:"privateendport"`
-	Privateport               string `json:"privateport"`
-	Protocol                  string `json:"protocol"`
-	Publicendport             string `json:"publicendport"`
-	Publicport                string `json:"publicport"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Vmguestip                 string `json:"vmguestip"`
+type SrxFirewall struct {
+	Fwdevicecapacity  int64  `json:"fwdevicecapacity"`
+	Fwdeviceid        string `json:"fwdeviceid"`
+	Fwdevicename      string `json:"fwdevicename"`
+	Fwdevicestate     string `json:"fwdevicestate"`
+	Ipaddress         string `json:"ipaddress"`
+	JobID             string `json:"jobid"`
+	Jobstatus         int    `json:"jobstatus"`
+	Numretries        string `json:"numretries"`
+	Physicalnetworkid string `json:"physicalnetworkid"`
+	Privateinterface  string `json:"privateinterface"`
+	Privatezone       string `json:"privatezone"`
+	Provider          string `json:"provider"`
+	Publicinterface   string `json:"publicinterface"`
+	Publiczone        string `json:"publiczone"`
+	Timeout           string `json:"timeout"`
+	Usageinterface    string `json:"usageinterface"`
+	Username          string `json:"username"`
+	Zoneid            string `json:"zoneid"`
 }
 
 type UpdateEgressFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateEgressFirewallRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateEgressFirewallRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2038,6 +7651,53 @@ func (p *UpdateEgressFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateEgressFirewallRuleParams) Validate() error {
+	v := newParamValidator("updateEgressFirewallRule", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("customid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateEgressFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateEgressFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateEgressFirewallRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateEgressFirewallRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateEgressFirewallRuleParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2045,6 +7705,15 @@ func (p *UpdateEgressFirewallRuleParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateEgressFirewallRuleParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateEgressFirewallRuleParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateEgressFirewallRuleParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2052,6 +7721,15 @@ func (p *UpdateEgressFirewallRuleParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateEgressFirewallRuleParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateEgressFirewallRuleParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateEgressFirewallRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2059,6 +7737,15 @@ func (p *UpdateEgressFirewallRuleParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateEgressFirewallRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateEgressFirewallRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateEgressFirewallRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewUpdateEgressFirewallRuleParams(id string) *UpdateEgressFirewallRuleParams {
@@ -2070,7 +7757,16 @@ func (s *FirewallService) NewUpdateEgressFirewallRuleParams(id string) *UpdateEg
 
 // Updates egress firewall rule
 func (s *FirewallService) UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateEgressFirewallRule", p.toURLValues())
+	return s.UpdateEgressFirewallRuleWithContext(context.Background(), p)
+}
+
+// UpdateEgressFirewallRuleWithContext is the same as UpdateEgressFirewallRule, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) UpdateEgressFirewallRuleWithContext(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateEgressFirewallRule", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2082,7 +7778,7 @@ func (s *FirewallService) UpdateEgressFirewallRule(p *UpdateEgressFirewallRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2108,6 +7804,45 @@ func (s *FirewallService) UpdateEgressFirewallRule(p *UpdateEgressFirewallRulePa
 	return &r, nil
 }
 
+// UpdateEgressFirewallRuleAsync starts the async job for UpdateEgressFirewallRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateEgressFirewallRuleResponse using Job.Result
+func (s *FirewallService) UpdateEgressFirewallRuleAsync(p *UpdateEgressFirewallRuleParams) (*Job, error) {
+	return s.UpdateEgressFirewallRuleAsyncWithContext(context.Background(), p)
+}
+
+// UpdateEgressFirewallRuleAsyncWithContext is the same as UpdateEgressFirewallRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) UpdateEgressFirewallRuleAsyncWithContext(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateEgressFirewallRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateEgressFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateEgressFirewallRuleResponse struct {
 	Cidrlist     string `json:"cidrlist"`
 	Destcidrlist string `json:"destcidrlist"`
@@ -2131,7 +7866,8 @@ type UpdateFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateFirewallRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateFirewallRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2149,6 +7885,53 @@ func (p *UpdateFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateFirewallRuleParams) Validate() error {
+	v := newParamValidator("updateFirewallRule", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("customid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateFirewallRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateFirewallRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateFirewallRuleParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2156,6 +7939,15 @@ func (p *UpdateFirewallRuleParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateFirewallRuleParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateFirewallRuleParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateFirewallRuleParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2163,6 +7955,15 @@ func (p *UpdateFirewallRuleParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateFirewallRuleParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateFirewallRuleParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateFirewallRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2170,6 +7971,15 @@ func (p *UpdateFirewallRuleParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateFirewallRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateFirewallRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateFirewallRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewUpdateFirewallRuleParams(id string) *UpdateFirewallRuleParams {
@@ -2181,7 +7991,16 @@ func (s *FirewallService) NewUpdateFirewallRuleParams(id string) *UpdateFirewall
 
 // Updates firewall rule
 func (s *FirewallService) UpdateFirewallRule(p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateFirewallRule", p.toURLValues())
+	return s.UpdateFirewallRuleWithContext(context.Background(), p)
+}
+
+// UpdateFirewallRuleWithContext is the same as UpdateFirewallRule, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) UpdateFirewallRuleWithContext(ctx context.Context, p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateFirewallRule", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2193,7 +8012,7 @@ func (s *FirewallService) UpdateFirewallRule(p *UpdateFirewallRuleParams) (*Upda
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2219,6 +8038,45 @@ func (s *FirewallService) UpdateFirewallRule(p *UpdateFirewallRuleParams) (*Upda
 	return &r, nil
 }
 
+// UpdateFirewallRuleAsync starts the async job for UpdateFirewallRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateFirewallRuleResponse using Job.Result
+func (s *FirewallService) UpdateFirewallRuleAsync(p *UpdateFirewallRuleParams) (*Job, error) {
+	return s.UpdateFirewallRuleAsyncWithContext(context.Background(), p)
+}
+
+// UpdateFirewallRuleAsyncWithContext is the same as UpdateFirewallRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) UpdateFirewallRuleAsyncWithContext(ctx context.Context, p *UpdateFirewallRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateFirewallRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateFirewallRuleResponse struct {
 	Cidrlist     string `json:"cidrlist"`
 	Destcidrlist string `json:"destcidrlist"`
@@ -2242,7 +8100,8 @@ type UpdatePortForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdatePortForwardingRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdatePortForwardingRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2274,6 +8133,58 @@ func (p *UpdatePortForwardingRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdatePortForwardingRuleParams) Validate() error {
+	v := newParamValidator("updatePortForwardingRule", p.p)
+	v.required("id")
+	v.ids("id", "virtualmachineid")
+	v.maxLength("customid", 255)
+	v.maxLength("vmguestip", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdatePortForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdatePortForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "id", "virtualmachineid", "vmguestip":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "privateendport", "privateport":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updatePortForwardingRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updatePortForwardingRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdatePortForwardingRuleParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2281,6 +8192,15 @@ func (p *UpdatePortForwardingRuleParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdatePortForwardingRuleParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdatePortForwardingRuleParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdatePortForwardingRuleParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2288,6 +8208,15 @@ func (p *UpdatePortForwardingRuleParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdatePortForwardingRuleParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdatePortForwardingRuleParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdatePortForwardingRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2295,6 +8224,15 @@ func (p *UpdatePortForwardingRuleParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdatePortForwardingRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdatePortForwardingRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdatePortForwardingRuleParams) SetPrivateendport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2302,6 +8240,15 @@ func (p *UpdatePortForwardingRuleParams) SetPrivateendport(v int) {
 	p.p["privateendport"] = v
 }
 
+func (p *UpdatePortForwardingRuleParams) ResetPrivateendport() {
+	delete(p.p, "privateendport")
+}
+
+func (p *UpdatePortForwardingRuleParams) GetPrivateendport() (int, bool) {
+	value, ok := p.p["privateendport"].(int)
+	return value, ok
+}
+
 func (p *UpdatePortForwardingRuleParams) SetPrivateport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2309,6 +8256,15 @@ func (p *UpdatePortForwardingRuleParams) SetPrivateport(v int) {
 	p.p["privateport"] = v
 }
 
+func (p *UpdatePortForwardingRuleParams) ResetPrivateport() {
+	delete(p.p, "privateport")
+}
+
+func (p *UpdatePortForwardingRuleParams) GetPrivateport() (int, bool) {
+	value, ok := p.p["privateport"].(int)
+	return value, ok
+}
+
 func (p *UpdatePortForwardingRuleParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2316,6 +8272,15 @@ func (p *UpdatePortForwardingRuleParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *UpdatePortForwardingRuleParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *UpdatePortForwardingRuleParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 func (p *UpdatePortForwardingRuleParams) SetVmguestip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2323,6 +8288,15 @@ func (p *UpdatePortForwardingRuleParams) SetVmguestip(v string) {
 	p.p["vmguestip"] = v
 }
 
+func (p *UpdatePortForwardingRuleParams) ResetVmguestip() {
+	delete(p.p, "vmguestip")
+}
+
+func (p *UpdatePortForwardingRuleParams) GetVmguestip() (string, bool) {
+	value, ok := p.p["vmguestip"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdatePortForwardingRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *FirewallService) NewUpdatePortForwardingRuleParams(id string) *UpdatePortForwardingRuleParams {
@@ -2334,7 +8308,16 @@ func (s *FirewallService) NewUpdatePortForwardingRuleParams(id string) *UpdatePo
 
 // Updates a port forwarding rule. Only the private port and the virtual machine can be updated.
 func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("updatePortForwardingRule", p.toURLValues())
+	return s.UpdatePortForwardingRuleWithContext(context.Background(), p)
+}
+
+// UpdatePortForwardingRuleWithContext is the same as UpdatePortForwardingRule, but uses ctx to cancel the request and any async job polling
+func (s *FirewallService) UpdatePortForwardingRuleWithContext(ctx context.Context, p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updatePortForwardingRule", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2346,7 +8329,7 @@ func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2372,6 +8355,45 @@ func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRulePa
 	return &r, nil
 }
 
+// UpdatePortForwardingRuleAsync starts the async job for UpdatePortForwardingRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdatePortForwardingRuleResponse using Job.Result
+func (s *FirewallService) UpdatePortForwardingRuleAsync(p *UpdatePortForwardingRuleParams) (*Job, error) {
+	return s.UpdatePortForwardingRuleAsyncWithContext(context.Background(), p)
+}
+
+// UpdatePortForwardingRuleAsyncWithContext is the same as UpdatePortForwardingRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *FirewallService) UpdatePortForwardingRuleAsyncWithContext(ctx context.Context, p *UpdatePortForwardingRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updatePortForwardingRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdatePortForwardingRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertFirewallServiceResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdatePortForwardingRuleResponse struct {
 	Cidrlist                  string `json:"cidrlist"`
 	Fordisplay                bool   `json:"fordisplay"`
@@ -20,18 +20,83 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// GuestOSServiceIface is the interface implemented by GuestOSService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type GuestOSServiceIface interface {
+	NewAddGuestOsParams(details map[string]string, oscategoryid string, osdisplayname string) *AddGuestOsParams
+	AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, error)
+	AddGuestOsWithContext(ctx context.Context, p *AddGuestOsParams) (*AddGuestOsResponse, error)
+	AddGuestOsAsync(p *AddGuestOsParams) (*Job, error)
+	AddGuestOsAsyncWithContext(ctx context.Context, p *AddGuestOsParams) (*Job, error)
+	NewAddGuestOsMappingParams(hypervisor string, hypervisorversion string, osnameforhypervisor string) *AddGuestOsMappingParams
+	AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error)
+	AddGuestOsMappingWithContext(ctx context.Context, p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error)
+	AddGuestOsMappingAsync(p *AddGuestOsMappingParams) (*Job, error)
+	AddGuestOsMappingAsyncWithContext(ctx context.Context, p *AddGuestOsMappingParams) (*Job, error)
+	NewListGuestOsMappingParams() *ListGuestOsMappingParams
+	GetGuestOsMappingByID(id string, opts ...OptionFunc) (*GuestOsMapping, int, error)
+	GetGuestOsMappingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GuestOsMapping, int, error)
+	ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error)
+	ListGuestOsMappingWithContext(ctx context.Context, p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error)
+	NewListGuestOsMappingPager(p *ListGuestOsMappingParams) *ListGuestOsMappingPager
+	ListGuestOsMappingAll(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error)
+	ListGuestOsMappingAllWithContext(ctx context.Context, p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error)
+	NewListOsCategoriesParams() *ListOsCategoriesParams
+	GetOsCategoryID(name string, opts ...OptionFunc) (string, int, error)
+	GetOsCategoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetOsCategoryByName(name string, opts ...OptionFunc) (*OsCategory, int, error)
+	GetOsCategoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*OsCategory, int, error)
+	GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCategory, int, error)
+	GetOsCategoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OsCategory, int, error)
+	ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error)
+	ListOsCategoriesWithContext(ctx context.Context, p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error)
+	NewListOsCategoriesPager(p *ListOsCategoriesParams) *ListOsCategoriesPager
+	ListOsCategoriesAll(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error)
+	ListOsCategoriesAllWithContext(ctx context.Context, p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error)
+	NewListOsTypesParams() *ListOsTypesParams
+	GetOsTypeByID(id string, opts ...OptionFunc) (*OsType, int, error)
+	GetOsTypeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OsType, int, error)
+	ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error)
+	ListOsTypesWithContext(ctx context.Context, p *ListOsTypesParams) (*ListOsTypesResponse, error)
+	NewListOsTypesPager(p *ListOsTypesParams) *ListOsTypesPager
+	ListOsTypesAll(p *ListOsTypesParams) (*ListOsTypesResponse, error)
+	ListOsTypesAllWithContext(ctx context.Context, p *ListOsTypesParams) (*ListOsTypesResponse, error)
+	NewRemoveGuestOsParams(id string) *RemoveGuestOsParams
+	RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error)
+	RemoveGuestOsWithContext(ctx context.Context, p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error)
+	RemoveGuestOsAsync(p *RemoveGuestOsParams) (*Job, error)
+	RemoveGuestOsAsyncWithContext(ctx context.Context, p *RemoveGuestOsParams) (*Job, error)
+	NewRemoveGuestOsMappingParams(id string) *RemoveGuestOsMappingParams
+	RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error)
+	RemoveGuestOsMappingWithContext(ctx context.Context, p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error)
+	RemoveGuestOsMappingAsync(p *RemoveGuestOsMappingParams) (*Job, error)
+	RemoveGuestOsMappingAsyncWithContext(ctx context.Context, p *RemoveGuestOsMappingParams) (*Job, error)
+	NewUpdateGuestOsParams(details map[string]string, id string, osdisplayname string) *UpdateGuestOsParams
+	UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error)
+	UpdateGuestOsWithContext(ctx context.Context, p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error)
+	UpdateGuestOsAsync(p *UpdateGuestOsParams) (*Job, error)
+	UpdateGuestOsAsyncWithContext(ctx context.Context, p *UpdateGuestOsParams) (*Job, error)
+	NewUpdateGuestOsMappingParams(id string, osnameforhypervisor string) *UpdateGuestOsMappingParams
+	UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error)
+	UpdateGuestOsMappingWithContext(ctx context.Context, p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error)
+	UpdateGuestOsMappingAsync(p *UpdateGuestOsMappingParams) (*Job, error)
+	UpdateGuestOsMappingAsyncWithContext(ctx context.Context, p *UpdateGuestOsMappingParams) (*Job, error)
+}
+
 type AddGuestOsParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddGuestOsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddGuestOsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -55,6 +120,54 @@ func (p *AddGuestOsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddGuestOsParams) Validate() error {
+	v := newParamValidator("addGuestOs", p.p)
+	v.required("details", "oscategoryid", "osdisplayname")
+	v.ids("oscategoryid")
+	v.maxLength("name", 255)
+	v.maxLength("osdisplayname", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddGuestOsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddGuestOsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "name", "oscategoryid", "osdisplayname":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addGuestOs", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addGuestOs: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddGuestOsParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -62,6 +175,15 @@ func (p *AddGuestOsParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *AddGuestOsParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *AddGuestOsParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *AddGuestOsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -69,6 +191,15 @@ func (p *AddGuestOsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *AddGuestOsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *AddGuestOsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *AddGuestOsParams) SetOscategoryid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -76,6 +207,15 @@ func (p *AddGuestOsParams) SetOscategoryid(v string) {
 	p.p["oscategoryid"] = v
 }
 
+func (p *AddGuestOsParams) ResetOscategoryid() {
+	delete(p.p, "oscategoryid")
+}
+
+func (p *AddGuestOsParams) GetOscategoryid() (string, bool) {
+	value, ok := p.p["oscategoryid"].(string)
+	return value, ok
+}
+
 func (p *AddGuestOsParams) SetOsdisplayname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -83,6 +223,15 @@ func (p *AddGuestOsParams) SetOsdisplayname(v string) {
 	p.p["osdisplayname"] = v
 }
 
+func (p *AddGuestOsParams) ResetOsdisplayname() {
+	delete(p.p, "osdisplayname")
+}
+
+func (p *AddGuestOsParams) GetOsdisplayname() (string, bool) {
+	value, ok := p.p["osdisplayname"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddGuestOsParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewAddGuestOsParams(details map[string]string, oscategoryid string, osdisplayname string) *AddGuestOsParams {
@@ -96,7 +245,16 @@ func (s *GuestOSService) NewAddGuestOsParams(details map[string]string, oscatego
 
 // Add a new guest OS type
 func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("addGuestOs", p.toURLValues())
+	return s.AddGuestOsWithContext(context.Background(), p)
+}
+
+// AddGuestOsWithContext is the same as AddGuestOs, but uses ctx to cancel the request and any async job polling
+func (s *GuestOSService) AddGuestOsWithContext(ctx context.Context, p *AddGuestOsParams) (*AddGuestOsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addGuestOs", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +266,7 @@ func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, e
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -129,6 +287,40 @@ func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, e
 	return &r, nil
 }
 
+// AddGuestOsAsync starts the async job for AddGuestOs and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddGuestOsResponse using Job.Result
+func (s *GuestOSService) AddGuestOsAsync(p *AddGuestOsParams) (*Job, error) {
+	return s.AddGuestOsAsyncWithContext(context.Background(), p)
+}
+
+// AddGuestOsAsyncWithContext is the same as AddGuestOsAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *GuestOSService) AddGuestOsAsyncWithContext(ctx context.Context, p *AddGuestOsParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addGuestOs", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddGuestOsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AddGuestOsResponse struct {
 	Description   string `json:"description"`
 	Id            string `json:"id"`
@@ -142,7 +334,8 @@ type AddGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddGuestOsMappingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddGuestOsMappingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -165,6 +358,52 @@ func (p *AddGuestOsMappingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddGuestOsMappingParams) Validate() error {
+	v := newParamValidator("addGuestOsMapping", p.p)
+	v.required("hypervisor", "hypervisorversion", "osnameforhypervisor")
+	v.ids("ostypeid")
+	v.maxLength("hypervisor", 255)
+	v.maxLength("hypervisorversion", 255)
+	v.maxLength("osdisplayname", 255)
+	v.maxLength("osnameforhypervisor", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddGuestOsMappingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddGuestOsMappingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hypervisor", "hypervisorversion", "osdisplayname", "osnameforhypervisor", "ostypeid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addGuestOsMapping", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addGuestOsMapping: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddGuestOsMappingParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -172,6 +411,15 @@ func (p *AddGuestOsMappingParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *AddGuestOsMappingParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *AddGuestOsMappingParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *AddGuestOsMappingParams) SetHypervisorversion(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -179,6 +427,15 @@ func (p *AddGuestOsMappingParams) SetHypervisorversion(v string) {
 	p.p["hypervisorversion"] = v
 }
 
+func (p *AddGuestOsMappingParams) ResetHypervisorversion() {
+	delete(p.p, "hypervisorversion")
+}
+
+func (p *AddGuestOsMappingParams) GetHypervisorversion() (string, bool) {
+	value, ok := p.p["hypervisorversion"].(string)
+	return value, ok
+}
+
 func (p *AddGuestOsMappingParams) SetOsdisplayname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -186,6 +443,15 @@ func (p *AddGuestOsMappingParams) SetOsdisplayname(v string) {
 	p.p["osdisplayname"] = v
 }
 
+func (p *AddGuestOsMappingParams) ResetOsdisplayname() {
+	delete(p.p, "osdisplayname")
+}
+
+func (p *AddGuestOsMappingParams) GetOsdisplayname() (string, bool) {
+	value, ok := p.p["osdisplayname"].(string)
+	return value, ok
+}
+
 func (p *AddGuestOsMappingParams) SetOsnameforhypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,6 +459,15 @@ func (p *AddGuestOsMappingParams) SetOsnameforhypervisor(v string) {
 	p.p["osnameforhypervisor"] = v
 }
 
+func (p *AddGuestOsMappingParams) ResetOsnameforhypervisor() {
+	delete(p.p, "osnameforhypervisor")
+}
+
+func (p *AddGuestOsMappingParams) GetOsnameforhypervisor() (string, bool) {
+	value, ok := p.p["osnameforhypervisor"].(string)
+	return value, ok
+}
+
 func (p *AddGuestOsMappingParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -200,6 +475,15 @@ func (p *AddGuestOsMappingParams) SetOstypeid(v string) {
 	p.p["ostypeid"] = v
 }
 
+func (p *AddGuestOsMappingParams) ResetOstypeid() {
+	delete(p.p, "ostypeid")
+}
+
+func (p *AddGuestOsMappingParams) GetOstypeid() (string, bool) {
+	value, ok := p.p["ostypeid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddGuestOsMappingParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewAddGuestOsMappingParams(hypervisor string, hypervisorversion string, osnameforhypervisor string) *AddGuestOsMappingParams {
@@ -213,7 +497,16 @@ func (s *GuestOSService) NewAddGuestOsMappingParams(hypervisor string, hyperviso
 
 // Adds a guest OS name to hypervisor OS name mapping
 func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("addGuestOsMapping", p.toURLValues())
+	return s.AddGuestOsMappingWithContext(context.Background(), p)
+}
+
+// AddGuestOsMappingWithContext is the same as AddGuestOsMapping, but uses ctx to cancel the request and any async job polling
+func (s *GuestOSService) AddGuestOsMappingWithContext(ctx context.Context, p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addGuestOsMapping", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -225,7 +518,7 @@ func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGues
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -246,6 +539,40 @@ func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGues
 	return &r, nil
 }
 
+// AddGuestOsMappingAsync starts the async job for AddGuestOsMapping and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddGuestOsMappingResponse using Job.Result
+func (s *GuestOSService) AddGuestOsMappingAsync(p *AddGuestOsMappingParams) (*Job, error) {
+	return s.AddGuestOsMappingAsyncWithContext(context.Background(), p)
+}
+
+// AddGuestOsMappingAsyncWithContext is the same as AddGuestOsMappingAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *GuestOSService) AddGuestOsMappingAsyncWithContext(ctx context.Context, p *AddGuestOsMappingParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addGuestOsMapping", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddGuestOsMappingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AddGuestOsMappingResponse struct {
 	Hypervisor          string `json:"hypervisor"`
 	Hypervisorversion   string `json:"hypervisorversion"`
@@ -289,7 +616,8 @@ type ListGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListGuestOsMappingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListGuestOsMappingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -320,6 +648,54 @@ func (p *ListGuestOsMappingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListGuestOsMappingParams) Validate() error {
+	v := newParamValidator("listGuestOsMapping", p.p)
+	v.ids("id", "ostypeid")
+	v.maxLength("hypervisor", 255)
+	v.maxLength("hypervisorversion", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListGuestOsMappingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListGuestOsMappingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hypervisor", "hypervisorversion", "id", "keyword", "ostypeid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listGuestOsMapping", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listGuestOsMapping: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListGuestOsMappingParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -327,6 +703,15 @@ func (p *ListGuestOsMappingParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *ListGuestOsMappingParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *ListGuestOsMappingParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *ListGuestOsMappingParams) SetHypervisorversion(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -334,6 +719,15 @@ func (p *ListGuestOsMappingParams) SetHypervisorversion(v string) {
 	p.p["hypervisorversion"] = v
 }
 
+func (p *ListGuestOsMappingParams) ResetHypervisorversion() {
+	delete(p.p, "hypervisorversion")
+}
+
+func (p *ListGuestOsMappingParams) GetHypervisorversion() (string, bool) {
+	value, ok := p.p["hypervisorversion"].(string)
+	return value, ok
+}
+
 func (p *ListGuestOsMappingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -341,6 +735,15 @@ func (p *ListGuestOsMappingParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListGuestOsMappingParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListGuestOsMappingParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListGuestOsMappingParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -348,6 +751,15 @@ func (p *ListGuestOsMappingParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListGuestOsMappingParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListGuestOsMappingParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListGuestOsMappingParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -355,6 +767,15 @@ func (p *ListGuestOsMappingParams) SetOstypeid(v string) {
 	p.p["ostypeid"] = v
 }
 
+func (p *ListGuestOsMappingParams) ResetOstypeid() {
+	delete(p.p, "ostypeid")
+}
+
+func (p *ListGuestOsMappingParams) GetOstypeid() (string, bool) {
+	value, ok := p.p["ostypeid"].(string)
+	return value, ok
+}
+
 func (p *ListGuestOsMappingParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -362,6 +783,15 @@ func (p *ListGuestOsMappingParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListGuestOsMappingParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListGuestOsMappingParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListGuestOsMappingParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -369,6 +799,15 @@ func (p *ListGuestOsMappingParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListGuestOsMappingParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListGuestOsMappingParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListGuestOsMappingParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewListGuestOsMappingParams() *ListGuestOsMappingParams {
@@ -379,6 +818,11 @@ func (s *GuestOSService) NewListGuestOsMappingParams() *ListGuestOsMappingParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*GuestOsMapping, int, error) {
+	return s.GetGuestOsMappingByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetGuestOsMappingByIDWithContext is the same as GetGuestOsMappingByID, but uses ctx to cancel the request
+func (s *GuestOSService) GetGuestOsMappingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GuestOsMapping, int, error) {
 	p := &ListGuestOsMappingParams{}
 	p.p = make(map[string]interface{})
 
@@ -390,18 +834,16 @@ func (s *GuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*
 		}
 	}
 
-	l, err := s.ListGuestOsMapping(p)
+	l, err := s.ListGuestOsMappingWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -412,7 +854,16 @@ func (s *GuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*
 
 // Lists all available OS mappings for given hypervisor
 func (s *GuestOSService) ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("listGuestOsMapping", p.toURLValues())
+	return s.ListGuestOsMappingWithContext(context.Background(), p)
+}
+
+// ListGuestOsMappingWithContext is the same as ListGuestOsMapping, but uses ctx to cancel the request and any async job polling
+func (s *GuestOSService) ListGuestOsMappingWithContext(ctx context.Context, p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listGuestOsMapping", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -425,6 +876,92 @@ func (s *GuestOSService) ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListG
 	return &r, nil
 }
 
+// ListGuestOsMappingPager iterates over all pages of a ListGuestOsMapping call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListGuestOsMappingPager struct {
+	pager
+
+	s *GuestOSService
+	p *ListGuestOsMappingParams
+	r *ListGuestOsMappingResponse
+}
+
+// NewListGuestOsMappingPager returns a pager for all pages of a ListGuestOsMapping call with the given params
+func (s *GuestOSService) NewListGuestOsMappingPager(p *ListGuestOsMappingParams) *ListGuestOsMappingPager {
+	return &ListGuestOsMappingPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListGuestOsMappingPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListGuestOsMappingPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListGuestOsMappingParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListGuestOsMappingWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.GuestOsMapping), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListGuestOsMappingPager) Page() *ListGuestOsMappingResponse {
+	return pg.r
+}
+
+// ListGuestOsMappingAll fetches all pages of a ListGuestOsMapping call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *GuestOSService) ListGuestOsMappingAll(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
+	return s.ListGuestOsMappingAllWithContext(context.Background(), p)
+}
+
+// ListGuestOsMappingAllWithContext is the same as ListGuestOsMappingAll, but uses ctx to cancel the requests
+func (s *GuestOSService) ListGuestOsMappingAllWithContext(ctx context.Context, p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*GuestOsMapping)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListGuestOsMappingParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListGuestOsMappingWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.GuestOsMapping
+		mu.Unlock()
+
+		return len(l.GuestOsMapping), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListGuestOsMappingResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.GuestOsMapping = append(r.GuestOsMapping, pages[page]...)
+	}
+	r.Count = len(r.GuestOsMapping)
+
+	return r, nil
+}
+
 type ListGuestOsMappingResponse struct {
 	Count          int               `json:"count"`
 	GuestOsMapping []*GuestOsMapping `json:"guestosmapping"`
@@ -473,7 +1010,8 @@ type ListOsCategoriesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListOsCategoriesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListOsCategoriesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -498,6 +1036,53 @@ func (p *ListOsCategoriesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListOsCategoriesParams) Validate() error {
+	v := newParamValidator("listOsCategories", p.p)
+	v.ids("id")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListOsCategoriesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListOsCategoriesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listOsCategories", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listOsCategories: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListOsCategoriesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -505,6 +1090,15 @@ func (p *ListOsCategoriesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListOsCategoriesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListOsCategoriesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListOsCategoriesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -512,6 +1106,15 @@ func (p *ListOsCategoriesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListOsCategoriesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListOsCategoriesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListOsCategoriesParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -519,6 +1122,15 @@ func (p *ListOsCategoriesParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListOsCategoriesParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListOsCategoriesParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListOsCategoriesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -526,6 +1138,15 @@ func (p *ListOsCategoriesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListOsCategoriesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListOsCategoriesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListOsCategoriesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -533,6 +1154,15 @@ func (p *ListOsCategoriesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListOsCategoriesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListOsCategoriesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListOsCategoriesParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewListOsCategoriesParams() *ListOsCategoriesParams {
@@ -543,6 +1173,11 @@ func (s *GuestOSService) NewListOsCategoriesParams() *ListOsCategoriesParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetOsCategoryIDWithContext(context.Background(), name, opts...)
+}
+
+// GetOsCategoryIDWithContext is the same as GetOsCategoryID, but uses ctx to cancel the request
+func (s *GuestOSService) GetOsCategoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListOsCategoriesParams{}
 	p.p = make(map[string]interface{})
 
@@ -554,7 +1189,7 @@ func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (strin
 		}
 	}
 
-	l, err := s.ListOsCategories(p)
+	l, err := s.ListOsCategoriesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -579,12 +1214,17 @@ func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (strin
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsCategoryByName(name string, opts ...OptionFunc) (*OsCategory, int, error) {
-	id, count, err := s.GetOsCategoryID(name, opts...)
+	return s.GetOsCategoryByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetOsCategoryByNameWithContext is the same as GetOsCategoryByName, but uses ctx to cancel the requests
+func (s *GuestOSService) GetOsCategoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*OsCategory, int, error) {
+	id, count, err := s.GetOsCategoryIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetOsCategoryByID(id, opts...)
+	r, count, err := s.GetOsCategoryByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -593,6 +1233,11 @@ func (s *GuestOSService) GetOsCategoryByName(name string, opts ...OptionFunc) (*
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCategory, int, error) {
+	return s.GetOsCategoryByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetOsCategoryByIDWithContext is the same as GetOsCategoryByID, but uses ctx to cancel the request
+func (s *GuestOSService) GetOsCategoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OsCategory, int, error) {
 	p := &ListOsCategoriesParams{}
 	p.p = make(map[string]interface{})
 
@@ -604,18 +1249,16 @@ func (s *GuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCa
 		}
 	}
 
-	l, err := s.ListOsCategories(p)
+	l, err := s.ListOsCategoriesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -626,7 +1269,16 @@ func (s *GuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCa
 
 // Lists all supported OS categories for this cloud.
 func (s *GuestOSService) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
-	resp, err := s.cs.newRequest("listOsCategories", p.toURLValues())
+	return s.ListOsCategoriesWithContext(context.Background(), p)
+}
+
+// ListOsCategoriesWithContext is the same as ListOsCategories, but uses ctx to cancel the request and any async job polling
+func (s *GuestOSService) ListOsCategoriesWithContext(ctx context.Context, p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listOsCategories", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -639,9 +1291,95 @@ func (s *GuestOSService) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCat
 	return &r, nil
 }
 
-type ListOsCategoriesResponse struct {
-	Count        int           `json:"count"`
-	OsCategories []*OsCategory `json:"oscategory"`
+// ListOsCategoriesPager iterates over all pages of a ListOsCategories call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListOsCategoriesPager struct {
+	pager
+
+	s *GuestOSService
+	p *ListOsCategoriesParams
+	r *ListOsCategoriesResponse
+}
+
+// NewListOsCategoriesPager returns a pager for all pages of a ListOsCategories call with the given params
+func (s *GuestOSService) NewListOsCategoriesPager(p *ListOsCategoriesParams) *ListOsCategoriesPager {
+	return &ListOsCategoriesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListOsCategoriesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListOsCategoriesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListOsCategoriesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListOsCategoriesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.OsCategories), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListOsCategoriesPager) Page() *ListOsCategoriesResponse {
+	return pg.r
+}
+
+// ListOsCategoriesAll fetches all pages of a ListOsCategories call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *GuestOSService) ListOsCategoriesAll(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
+	return s.ListOsCategoriesAllWithContext(context.Background(), p)
+}
+
+// ListOsCategoriesAllWithContext is the same as ListOsCategoriesAll, but uses ctx to cancel the requests
+func (s *GuestOSService) ListOsCategoriesAllWithContext(ctx context.Context, p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*OsCategory)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListOsCategoriesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListOsCategoriesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.OsCategories
+		mu.Unlock()
+
+		return len(l.OsCategories), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListOsCategoriesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.OsCategories = append(r.OsCategories, pages[page]...)
+	}
+	r.Count = len(r.OsCategories)
+
+	return r, nil
+}
+
+type ListOsCategoriesResponse struct {
+	Count        int           `json:"count"`
+	OsCategories []*OsCategory `json:"oscategory"`
 }
 
 type OsCategory struct {
@@ -655,7 +1393,8 @@ type ListOsTypesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListOsTypesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListOsTypesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -683,6 +1422,53 @@ func (p *ListOsTypesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListOsTypesParams) Validate() error {
+	v := newParamValidator("listOsTypes", p.p)
+	v.ids("id", "oscategoryid")
+	v.maxLength("description", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListOsTypesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListOsTypesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "id", "keyword", "oscategoryid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listOsTypes", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listOsTypes: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListOsTypesParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -690,6 +1476,15 @@ func (p *ListOsTypesParams) SetDescription(v string) {
 	p.p["description"] = v
 }
 
+func (p *ListOsTypesParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *ListOsTypesParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
 func (p *ListOsTypesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -697,6 +1492,15 @@ func (p *ListOsTypesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListOsTypesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListOsTypesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListOsTypesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -704,6 +1508,15 @@ func (p *ListOsTypesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListOsTypesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListOsTypesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListOsTypesParams) SetOscategoryid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -711,6 +1524,15 @@ func (p *ListOsTypesParams) SetOscategoryid(v string) {
 	p.p["oscategoryid"] = v
 }
 
+func (p *ListOsTypesParams) ResetOscategoryid() {
+	delete(p.p, "oscategoryid")
+}
+
+func (p *ListOsTypesParams) GetOscategoryid() (string, bool) {
+	value, ok := p.p["oscategoryid"].(string)
+	return value, ok
+}
+
 func (p *ListOsTypesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -718,6 +1540,15 @@ func (p *ListOsTypesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListOsTypesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListOsTypesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListOsTypesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -725,6 +1556,15 @@ func (p *ListOsTypesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListOsTypesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListOsTypesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListOsTypesParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewListOsTypesParams() *ListOsTypesParams {
@@ -735,6 +1575,11 @@ func (s *GuestOSService) NewListOsTypesParams() *ListOsTypesParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType, int, error) {
+	return s.GetOsTypeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetOsTypeByIDWithContext is the same as GetOsTypeByID, but uses ctx to cancel the request
+func (s *GuestOSService) GetOsTypeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OsType, int, error) {
 	p := &ListOsTypesParams{}
 	p.p = make(map[string]interface{})
 
@@ -746,18 +1591,16 @@ func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType,
 		}
 	}
 
-	l, err := s.ListOsTypes(p)
+	l, err := s.ListOsTypesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -768,7 +1611,16 @@ func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType,
 
 // Lists all supported OS types for this cloud.
 func (s *GuestOSService) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error) {
-	resp, err := s.cs.newRequest("listOsTypes", p.toURLValues())
+	return s.ListOsTypesWithContext(context.Background(), p)
+}
+
+// ListOsTypesWithContext is the same as ListOsTypes, but uses ctx to cancel the request and any async job polling
+func (s *GuestOSService) ListOsTypesWithContext(ctx context.Context, p *ListOsTypesParams) (*ListOsTypesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listOsTypes", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -781,6 +1633,92 @@ func (s *GuestOSService) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse
 	return &r, nil
 }
 
+// ListOsTypesPager iterates over all pages of a ListOsTypes call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListOsTypesPager struct {
+	pager
+
+	s *GuestOSService
+	p *ListOsTypesParams
+	r *ListOsTypesResponse
+}
+
+// NewListOsTypesPager returns a pager for all pages of a ListOsTypes call with the given params
+func (s *GuestOSService) NewListOsTypesPager(p *ListOsTypesParams) *ListOsTypesPager {
+	return &ListOsTypesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListOsTypesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListOsTypesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListOsTypesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListOsTypesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.OsTypes), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListOsTypesPager) Page() *ListOsTypesResponse {
+	return pg.r
+}
+
+// ListOsTypesAll fetches all pages of a ListOsTypes call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *GuestOSService) ListOsTypesAll(p *ListOsTypesParams) (*ListOsTypesResponse, error) {
+	return s.ListOsTypesAllWithContext(context.Background(), p)
+}
+
+// ListOsTypesAllWithContext is the same as ListOsTypesAll, but uses ctx to cancel the requests
+func (s *GuestOSService) ListOsTypesAllWithContext(ctx context.Context, p *ListOsTypesParams) (*ListOsTypesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*OsType)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListOsTypesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListOsTypesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.OsTypes
+		mu.Unlock()
+
+		return len(l.OsTypes), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListOsTypesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.OsTypes = append(r.OsTypes, pages[page]...)
+	}
+	r.Count = len(r.OsTypes)
+
+	return r, nil
+}
+
 type ListOsTypesResponse struct {
 	Count   int       `json:"count"`
 	OsTypes []*OsType `json:"ostype"`
@@ -799,7 +1737,8 @@ type RemoveGuestOsParams struct {
 	p map[string]interface{}
 }
 
-func (p *RemoveGuestOsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RemoveGuestOsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -810,6 +1749,48 @@ func (p *RemoveGuestOsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RemoveGuestOsParams) Validate() error {
+	v := newParamValidator("removeGuestOs", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RemoveGuestOsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RemoveGuestOsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for removeGuestOs", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of removeGuestOs: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveGuestOsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -817,6 +1798,15 @@ func (p *RemoveGuestOsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RemoveGuestOsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RemoveGuestOsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RemoveGuestOsParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewRemoveGuestOsParams(id string) *RemoveGuestOsParams {
@@ -828,7 +1818,16 @@ func (s *GuestOSService) NewRemoveGuestOsParams(id string) *RemoveGuestOsParams
 
 // Removes a Guest OS from listing.
 func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("removeGuestOs", p.toURLValues())
+	return s.RemoveGuestOsWithContext(context.Background(), p)
+}
+
+// RemoveGuestOsWithContext is the same as RemoveGuestOs, but uses ctx to cancel the request and any async job polling
+func (s *GuestOSService) RemoveGuestOsWithContext(ctx context.Context, p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeGuestOs", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -840,7 +1839,7 @@ func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -856,6 +1855,31 @@ func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsRe
 	return &r, nil
 }
 
+// RemoveGuestOsAsync starts the async job for RemoveGuestOs and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RemoveGuestOsResponse using Job.Result
+func (s *GuestOSService) RemoveGuestOsAsync(p *RemoveGuestOsParams) (*Job, error) {
+	return s.RemoveGuestOsAsyncWithContext(context.Background(), p)
+}
+
+// RemoveGuestOsAsyncWithContext is the same as RemoveGuestOsAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *GuestOSService) RemoveGuestOsAsyncWithContext(ctx context.Context, p *RemoveGuestOsParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeGuestOs", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveGuestOsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type RemoveGuestOsResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -867,7 +1891,8 @@ type RemoveGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
-func (p *RemoveGuestOsMappingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RemoveGuestOsMappingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -878,6 +1903,48 @@ func (p *RemoveGuestOsMappingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RemoveGuestOsMappingParams) Validate() error {
+	v := newParamValidator("removeGuestOsMapping", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RemoveGuestOsMappingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RemoveGuestOsMappingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for removeGuestOsMapping", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of removeGuestOsMapping: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveGuestOsMappingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -885,6 +1952,15 @@ func (p *RemoveGuestOsMappingParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RemoveGuestOsMappingParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RemoveGuestOsMappingParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RemoveGuestOsMappingParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewRemoveGuestOsMappingParams(id string) *RemoveGuestOsMappingParams {
@@ -896,7 +1972,16 @@ func (s *GuestOSService) NewRemoveGuestOsMappingParams(id string) *RemoveGuestOs
 
 // Removes a Guest OS Mapping.
 func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("removeGuestOsMapping", p.toURLValues())
+	return s.RemoveGuestOsMappingWithContext(context.Background(), p)
+}
+
+// RemoveGuestOsMappingWithContext is the same as RemoveGuestOsMapping, but uses ctx to cancel the request and any async job polling
+func (s *GuestOSService) RemoveGuestOsMappingWithContext(ctx context.Context, p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeGuestOsMapping", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -908,7 +1993,7 @@ func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*R
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -924,6 +2009,31 @@ func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*R
 	return &r, nil
 }
 
+// RemoveGuestOsMappingAsync starts the async job for RemoveGuestOsMapping and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RemoveGuestOsMappingResponse using Job.Result
+func (s *GuestOSService) RemoveGuestOsMappingAsync(p *RemoveGuestOsMappingParams) (*Job, error) {
+	return s.RemoveGuestOsMappingAsyncWithContext(context.Background(), p)
+}
+
+// RemoveGuestOsMappingAsyncWithContext is the same as RemoveGuestOsMappingAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *GuestOSService) RemoveGuestOsMappingAsyncWithContext(ctx context.Context, p *RemoveGuestOsMappingParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeGuestOsMapping", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveGuestOsMappingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type RemoveGuestOsMappingResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -935,7 +2045,8 @@ type UpdateGuestOsParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateGuestOsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateGuestOsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -956,6 +2067,53 @@ func (p *UpdateGuestOsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateGuestOsParams) Validate() error {
+	v := newParamValidator("updateGuestOs", p.p)
+	v.required("details", "id", "osdisplayname")
+	v.ids("id")
+	v.maxLength("osdisplayname", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateGuestOsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateGuestOsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "osdisplayname":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateGuestOs", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateGuestOs: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateGuestOsParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -963,6 +2121,15 @@ func (p *UpdateGuestOsParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *UpdateGuestOsParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *UpdateGuestOsParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *UpdateGuestOsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -970,6 +2137,15 @@ func (p *UpdateGuestOsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateGuestOsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateGuestOsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateGuestOsParams) SetOsdisplayname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -977,6 +2153,15 @@ func (p *UpdateGuestOsParams) SetOsdisplayname(v string) {
 	p.p["osdisplayname"] = v
 }
 
+func (p *UpdateGuestOsParams) ResetOsdisplayname() {
+	delete(p.p, "osdisplayname")
+}
+
+func (p *UpdateGuestOsParams) GetOsdisplayname() (string, bool) {
+	value, ok := p.p["osdisplayname"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateGuestOsParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewUpdateGuestOsParams(details map[string]string, id string, osdisplayname string) *UpdateGuestOsParams {
@@ -990,7 +2175,16 @@ func (s *GuestOSService) NewUpdateGuestOsParams(details map[string]string, id st
 
 // Updates the information about Guest OS
 func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("updateGuestOs", p.toURLValues())
+	return s.UpdateGuestOsWithContext(context.Background(), p)
+}
+
+// UpdateGuestOsWithContext is the same as UpdateGuestOs, but uses ctx to cancel the request and any async job polling
+func (s *GuestOSService) UpdateGuestOsWithContext(ctx context.Context, p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateGuestOs", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1002,7 +2196,7 @@ func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1023,6 +2217,40 @@ func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsRe
 	return &r, nil
 }
 
+// UpdateGuestOsAsync starts the async job for UpdateGuestOs and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateGuestOsResponse using Job.Result
+func (s *GuestOSService) UpdateGuestOsAsync(p *UpdateGuestOsParams) (*Job, error) {
+	return s.UpdateGuestOsAsyncWithContext(context.Background(), p)
+}
+
+// UpdateGuestOsAsyncWithContext is the same as UpdateGuestOsAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *GuestOSService) UpdateGuestOsAsyncWithContext(ctx context.Context, p *UpdateGuestOsParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateGuestOs", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateGuestOsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateGuestOsResponse struct {
 	Description   string `json:"description"`
 	Id            string `json:"id"`
@@ -1036,7 +2264,8 @@ type UpdateGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateGuestOsMappingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateGuestOsMappingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1050,6 +2279,49 @@ func (p *UpdateGuestOsMappingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateGuestOsMappingParams) Validate() error {
+	v := newParamValidator("updateGuestOsMapping", p.p)
+	v.required("id", "osnameforhypervisor")
+	v.ids("id")
+	v.maxLength("osnameforhypervisor", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateGuestOsMappingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateGuestOsMappingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "osnameforhypervisor":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateGuestOsMapping", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateGuestOsMapping: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateGuestOsMappingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1057,6 +2329,15 @@ func (p *UpdateGuestOsMappingParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateGuestOsMappingParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateGuestOsMappingParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateGuestOsMappingParams) SetOsnameforhypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1064,6 +2345,15 @@ func (p *UpdateGuestOsMappingParams) SetOsnameforhypervisor(v string) {
 	p.p["osnameforhypervisor"] = v
 }
 
+func (p *UpdateGuestOsMappingParams) ResetOsnameforhypervisor() {
+	delete(p.p, "osnameforhypervisor")
+}
+
+func (p *UpdateGuestOsMappingParams) GetOsnameforhypervisor() (string, bool) {
+	value, ok := p.p["osnameforhypervisor"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateGuestOsMappingParams instance,
 // as then you are sure you have configured all required params
 func (s *GuestOSService) NewUpdateGuestOsMappingParams(id string, osnameforhypervisor string) *UpdateGuestOsMappingParams {
@@ -1076,7 +2366,16 @@ func (s *GuestOSService) NewUpdateGuestOsMappingParams(id string, osnameforhyper
 
 // Updates the information about Guest OS to Hypervisor specific name mapping
 func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("updateGuestOsMapping", p.toURLValues())
+	return s.UpdateGuestOsMappingWithContext(context.Background(), p)
+}
+
+// UpdateGuestOsMappingWithContext is the same as UpdateGuestOsMapping, but uses ctx to cancel the request and any async job polling
+func (s *GuestOSService) UpdateGuestOsMappingWithContext(ctx context.Context, p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateGuestOsMapping", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1088,7 +2387,7 @@ func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*U
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1109,6 +2408,40 @@ func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*U
 	return &r, nil
 }
 
+// UpdateGuestOsMappingAsync starts the async job for UpdateGuestOsMapping and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateGuestOsMappingResponse using Job.Result
+func (s *GuestOSService) UpdateGuestOsMappingAsync(p *UpdateGuestOsMappingParams) (*Job, error) {
+	return s.UpdateGuestOsMappingAsyncWithContext(context.Background(), p)
+}
+
+// UpdateGuestOsMappingAsyncWithContext is the same as UpdateGuestOsMappingAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *GuestOSService) UpdateGuestOsMappingAsyncWithContext(ctx context.Context, p *UpdateGuestOsMappingParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateGuestOsMapping", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateGuestOsMappingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateGuestOsMappingResponse struct {
 	Hypervisor          string `json:"hypervisor"`
 	Hypervisorversion   string `json:"hypervisorversion"`
@@ -0,0 +1,59 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"net/http"
+	"strings"
+)
+
+// DefaultMaxGETQueryLength is the default max length of the encoded query of a GET request, which
+// stays well below the default max request header size of the servers used to run CloudStack
+const DefaultMaxGETQueryLength = 4096
+
+// WithHTTPMethod sets the HTTP method (http.MethodGet or http.MethodPost) used to execute the given
+// commands. Commands with params carrying secrets (like passwords, private keys or user data) are
+// always sent using POST, unless HTTPGETOnly is set.
+func WithHTTPMethod(method string, commands ...string) ClientOption {
+	return func(cs *CloudStackClient) {
+		method = strings.ToUpper(method)
+		if method != http.MethodGet && method != http.MethodPost {
+			return
+		}
+
+		if cs.methods == nil {
+			cs.methods = make(map[string]string, len(commands))
+		}
+		for _, c := range commands {
+			cs.methods[strings.ToLower(c)] = method
+		}
+	}
+}
+
+// WithMaxGETQueryLength sets the max length of the encoded query of a GET request; defaults to
+// DefaultMaxGETQueryLength. Requests with a longer query are sent using POST, unless the command
+// is explicitly set to use GET with WithHTTPMethod. A length of -1 disables switching to POST.
+func WithMaxGETQueryLength(length int) ClientOption {
+	return func(cs *CloudStackClient) {
+		if length != 0 {
+			cs.maxQueryLength = length
+		}
+	}
+}
@@ -20,18 +20,176 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// HostServiceIface is the interface implemented by HostService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type HostServiceIface interface {
+	NewAddBaremetalHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string) *AddBaremetalHostParams
+	AddBaremetalHost(p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error)
+	AddBaremetalHostWithContext(ctx context.Context, p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error)
+	NewAddGloboDnsHostParams(password string, physicalnetworkid string, url string, username string) *AddGloboDnsHostParams
+	AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error)
+	AddGloboDnsHostWithContext(ctx context.Context, p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error)
+	AddGloboDnsHostAsync(p *AddGloboDnsHostParams) (*Job, error)
+	AddGloboDnsHostAsyncWithContext(ctx context.Context, p *AddGloboDnsHostParams) (*Job, error)
+	NewAddHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string) *AddHostParams
+	AddHost(p *AddHostParams) (*AddHostResponse, error)
+	AddHostWithContext(ctx context.Context, p *AddHostParams) (*AddHostResponse, error)
+	NewAddSecondaryStorageParams(url string) *AddSecondaryStorageParams
+	AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error)
+	AddSecondaryStorageWithContext(ctx context.Context, p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error)
+	NewCancelHostMaintenanceParams(id string) *CancelHostMaintenanceParams
+	CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error)
+	CancelHostMaintenanceWithContext(ctx context.Context, p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error)
+	CancelHostMaintenanceAsync(p *CancelHostMaintenanceParams) (*Job, error)
+	CancelHostMaintenanceAsyncWithContext(ctx context.Context, p *CancelHostMaintenanceParams) (*Job, error)
+	NewConfigureHAForHostParams(hostid string, provider string) *ConfigureHAForHostParams
+	ConfigureHAForHost(p *ConfigureHAForHostParams) (*HAForHostResponse, error)
+	ConfigureHAForHostWithContext(ctx context.Context, p *ConfigureHAForHostParams) (*HAForHostResponse, error)
+	ConfigureHAForHostAsync(p *ConfigureHAForHostParams) (*Job, error)
+	ConfigureHAForHostAsyncWithContext(ctx context.Context, p *ConfigureHAForHostParams) (*Job, error)
+	NewDedicateHostParams(domainid string, hostid string) *DedicateHostParams
+	DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error)
+	DedicateHostWithContext(ctx context.Context, p *DedicateHostParams) (*DedicateHostResponse, error)
+	DedicateHostAsync(p *DedicateHostParams) (*Job, error)
+	DedicateHostAsyncWithContext(ctx context.Context, p *DedicateHostParams) (*Job, error)
+	NewDeleteHostParams(id string) *DeleteHostParams
+	DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error)
+	DeleteHostWithContext(ctx context.Context, p *DeleteHostParams) (*DeleteHostResponse, error)
+	NewDisableHAForClusterParams(clusterid string) *DisableHAForClusterParams
+	DisableHAForCluster(p *DisableHAForClusterParams) (*DisableHAForClusterResponse, error)
+	DisableHAForClusterWithContext(ctx context.Context, p *DisableHAForClusterParams) (*DisableHAForClusterResponse, error)
+	DisableHAForClusterAsync(p *DisableHAForClusterParams) (*Job, error)
+	DisableHAForClusterAsyncWithContext(ctx context.Context, p *DisableHAForClusterParams) (*Job, error)
+	NewDisableHAForHostParams(hostid string) *DisableHAForHostParams
+	DisableHAForHost(p *DisableHAForHostParams) (*DisableHAForHostResponse, error)
+	DisableHAForHostWithContext(ctx context.Context, p *DisableHAForHostParams) (*DisableHAForHostResponse, error)
+	DisableHAForHostAsync(p *DisableHAForHostParams) (*Job, error)
+	DisableHAForHostAsyncWithContext(ctx context.Context, p *DisableHAForHostParams) (*Job, error)
+	NewDisableHAForZoneParams(zoneid string) *DisableHAForZoneParams
+	DisableHAForZone(p *DisableHAForZoneParams) (*DisableHAForZoneResponse, error)
+	DisableHAForZoneWithContext(ctx context.Context, p *DisableHAForZoneParams) (*DisableHAForZoneResponse, error)
+	DisableHAForZoneAsync(p *DisableHAForZoneParams) (*Job, error)
+	DisableHAForZoneAsyncWithContext(ctx context.Context, p *DisableHAForZoneParams) (*Job, error)
+	NewDisableOutOfBandManagementForHostParams(hostid string) *DisableOutOfBandManagementForHostParams
+	DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error)
+	DisableOutOfBandManagementForHostWithContext(ctx context.Context, p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error)
+	DisableOutOfBandManagementForHostAsync(p *DisableOutOfBandManagementForHostParams) (*Job, error)
+	DisableOutOfBandManagementForHostAsyncWithContext(ctx context.Context, p *DisableOutOfBandManagementForHostParams) (*Job, error)
+	NewEnableHAForClusterParams(clusterid string) *EnableHAForClusterParams
+	EnableHAForCluster(p *EnableHAForClusterParams) (*EnableHAForClusterResponse, error)
+	EnableHAForClusterWithContext(ctx context.Context, p *EnableHAForClusterParams) (*EnableHAForClusterResponse, error)
+	EnableHAForClusterAsync(p *EnableHAForClusterParams) (*Job, error)
+	EnableHAForClusterAsyncWithContext(ctx context.Context, p *EnableHAForClusterParams) (*Job, error)
+	NewEnableHAForHostParams(hostid string) *EnableHAForHostParams
+	EnableHAForHost(p *EnableHAForHostParams) (*EnableHAForHostResponse, error)
+	EnableHAForHostWithContext(ctx context.Context, p *EnableHAForHostParams) (*EnableHAForHostResponse, error)
+	EnableHAForHostAsync(p *EnableHAForHostParams) (*Job, error)
+	EnableHAForHostAsyncWithContext(ctx context.Context, p *EnableHAForHostParams) (*Job, error)
+	NewEnableHAForZoneParams(zoneid string) *EnableHAForZoneParams
+	EnableHAForZone(p *EnableHAForZoneParams) (*EnableHAForZoneResponse, error)
+	EnableHAForZoneWithContext(ctx context.Context, p *EnableHAForZoneParams) (*EnableHAForZoneResponse, error)
+	EnableHAForZoneAsync(p *EnableHAForZoneParams) (*Job, error)
+	EnableHAForZoneAsyncWithContext(ctx context.Context, p *EnableHAForZoneParams) (*Job, error)
+	NewEnableOutOfBandManagementForHostParams(hostid string) *EnableOutOfBandManagementForHostParams
+	EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error)
+	EnableOutOfBandManagementForHostWithContext(ctx context.Context, p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error)
+	EnableOutOfBandManagementForHostAsync(p *EnableOutOfBandManagementForHostParams) (*Job, error)
+	EnableOutOfBandManagementForHostAsyncWithContext(ctx context.Context, p *EnableOutOfBandManagementForHostParams) (*Job, error)
+	NewFindHostsForMigrationParams(virtualmachineid string) *FindHostsForMigrationParams
+	FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error)
+	FindHostsForMigrationWithContext(ctx context.Context, p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error)
+	NewListDedicatedHostsParams() *ListDedicatedHostsParams
+	ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error)
+	ListDedicatedHostsWithContext(ctx context.Context, p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error)
+	NewListDedicatedHostsPager(p *ListDedicatedHostsParams) *ListDedicatedHostsPager
+	ListDedicatedHostsAll(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error)
+	ListDedicatedHostsAllWithContext(ctx context.Context, p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error)
+	NewListHostHAProvidersParams(hypervisor string) *ListHostHAProvidersParams
+	ListHostHAProviders(p *ListHostHAProvidersParams) (*ListHostHAProvidersResponse, error)
+	ListHostHAProvidersWithContext(ctx context.Context, p *ListHostHAProvidersParams) (*ListHostHAProvidersResponse, error)
+	NewListHostHAResourcesParams() *ListHostHAResourcesParams
+	ListHostHAResources(p *ListHostHAResourcesParams) (*ListHostHAResourcesResponse, error)
+	ListHostHAResourcesWithContext(ctx context.Context, p *ListHostHAResourcesParams) (*ListHostHAResourcesResponse, error)
+	NewListHostTagsParams() *ListHostTagsParams
+	GetHostTagID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetHostTagIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
+	ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error)
+	ListHostTagsWithContext(ctx context.Context, p *ListHostTagsParams) (*ListHostTagsResponse, error)
+	NewListHostTagsPager(p *ListHostTagsParams) *ListHostTagsPager
+	ListHostTagsAll(p *ListHostTagsParams) (*ListHostTagsResponse, error)
+	ListHostTagsAllWithContext(ctx context.Context, p *ListHostTagsParams) (*ListHostTagsResponse, error)
+	NewListHostsParams() *ListHostsParams
+	GetHostID(name string, opts ...OptionFunc) (string, int, error)
+	GetHostIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetHostByName(name string, opts ...OptionFunc) (*Host, int, error)
+	GetHostByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Host, int, error)
+	GetHostByID(id string, opts ...OptionFunc) (*Host, int, error)
+	GetHostByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Host, int, error)
+	ListHosts(p *ListHostsParams) (*ListHostsResponse, error)
+	ListHostsWithContext(ctx context.Context, p *ListHostsParams) (*ListHostsResponse, error)
+	NewListHostsPager(p *ListHostsParams) *ListHostsPager
+	ListHostsAll(p *ListHostsParams) (*ListHostsResponse, error)
+	ListHostsAllWithContext(ctx context.Context, p *ListHostsParams) (*ListHostsResponse, error)
+	NewListHostsMetricsParams() *ListHostsMetricsParams
+	GetHostsMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetHostsMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetHostsMetricByName(name string, opts ...OptionFunc) (*HostsMetric, int, error)
+	GetHostsMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*HostsMetric, int, error)
+	GetHostsMetricByID(id string, opts ...OptionFunc) (*HostsMetric, int, error)
+	GetHostsMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*HostsMetric, int, error)
+	ListHostsMetrics(p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error)
+	ListHostsMetricsWithContext(ctx context.Context, p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error)
+	NewListHostsMetricsPager(p *ListHostsMetricsParams) *ListHostsMetricsPager
+	ListHostsMetricsAll(p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error)
+	ListHostsMetricsAllWithContext(ctx context.Context, p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error)
+	NewPrepareHostForMaintenanceParams(id string) *PrepareHostForMaintenanceParams
+	PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error)
+	PrepareHostForMaintenanceWithContext(ctx context.Context, p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error)
+	PrepareHostForMaintenanceAsync(p *PrepareHostForMaintenanceParams) (*Job, error)
+	PrepareHostForMaintenanceAsyncWithContext(ctx context.Context, p *PrepareHostForMaintenanceParams) (*Job, error)
+	NewReconnectHostParams(id string) *ReconnectHostParams
+	ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error)
+	ReconnectHostWithContext(ctx context.Context, p *ReconnectHostParams) (*ReconnectHostResponse, error)
+	ReconnectHostAsync(p *ReconnectHostParams) (*Job, error)
+	ReconnectHostAsyncWithContext(ctx context.Context, p *ReconnectHostParams) (*Job, error)
+	NewReleaseDedicatedHostParams(hostid string) *ReleaseDedicatedHostParams
+	ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error)
+	ReleaseDedicatedHostWithContext(ctx context.Context, p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error)
+	ReleaseDedicatedHostAsync(p *ReleaseDedicatedHostParams) (*Job, error)
+	ReleaseDedicatedHostAsyncWithContext(ctx context.Context, p *ReleaseDedicatedHostParams) (*Job, error)
+	NewReleaseHostReservationParams(id string) *ReleaseHostReservationParams
+	ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error)
+	ReleaseHostReservationWithContext(ctx context.Context, p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error)
+	ReleaseHostReservationAsync(p *ReleaseHostReservationParams) (*Job, error)
+	ReleaseHostReservationAsyncWithContext(ctx context.Context, p *ReleaseHostReservationParams) (*Job, error)
+	NewStartRollingMaintenanceParams() *StartRollingMaintenanceParams
+	StartRollingMaintenance(p *StartRollingMaintenanceParams) (*StartRollingMaintenanceResponse, error)
+	StartRollingMaintenanceWithContext(ctx context.Context, p *StartRollingMaintenanceParams) (*StartRollingMaintenanceResponse, error)
+	StartRollingMaintenanceAsync(p *StartRollingMaintenanceParams) (*Job, error)
+	StartRollingMaintenanceAsyncWithContext(ctx context.Context, p *StartRollingMaintenanceParams) (*Job, error)
+	NewUpdateHostParams(id string) *UpdateHostParams
+	UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error)
+	UpdateHostWithContext(ctx context.Context, p *UpdateHostParams) (*UpdateHostResponse, error)
+	NewUpdateHostPasswordParams(password string, username string) *UpdateHostPasswordParams
+	UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error)
+	UpdateHostPasswordWithContext(ctx context.Context, p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error)
+}
+
 type AddBaremetalHostParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddBaremetalHostParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddBaremetalHostParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -73,6 +231,59 @@ func (p *AddBaremetalHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddBaremetalHostParams) Validate() error {
+	v := newParamValidator("addBaremetalHost", p.p)
+	v.required("hypervisor", "password", "podid", "url", "username", "zoneid")
+	v.ids("clusterid", "podid", "zoneid")
+	v.maxLength("allocationstate", 255)
+	v.maxLength("clustername", 255)
+	v.maxLength("hypervisor", 255)
+	v.maxLength("ipaddress", 255)
+	v.maxLength("password", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddBaremetalHostParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddBaremetalHostParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "allocationstate", "clusterid", "clustername", "hypervisor", "ipaddress", "password", "podid", "url", "username", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "hosttags":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addBaremetalHost", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addBaremetalHost: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddBaremetalHostParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -80,6 +291,15 @@ func (p *AddBaremetalHostParams) SetAllocationstate(v string) {
 	p.p["allocationstate"] = v
 }
 
+func (p *AddBaremetalHostParams) ResetAllocationstate() {
+	delete(p.p, "allocationstate")
+}
+
+func (p *AddBaremetalHostParams) GetAllocationstate() (string, bool) {
+	value, ok := p.p["allocationstate"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalHostParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -87,6 +307,15 @@ func (p *AddBaremetalHostParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *AddBaremetalHostParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *AddBaremetalHostParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalHostParams) SetClustername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -94,6 +323,15 @@ func (p *AddBaremetalHostParams) SetClustername(v string) {
 	p.p["clustername"] = v
 }
 
+func (p *AddBaremetalHostParams) ResetClustername() {
+	delete(p.p, "clustername")
+}
+
+func (p *AddBaremetalHostParams) GetClustername() (string, bool) {
+	value, ok := p.p["clustername"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalHostParams) SetHosttags(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -101,6 +339,15 @@ func (p *AddBaremetalHostParams) SetHosttags(v []string) {
 	p.p["hosttags"] = v
 }
 
+func (p *AddBaremetalHostParams) ResetHosttags() {
+	delete(p.p, "hosttags")
+}
+
+func (p *AddBaremetalHostParams) GetHosttags() ([]string, bool) {
+	value, ok := p.p["hosttags"].([]string)
+	return value, ok
+}
+
 func (p *AddBaremetalHostParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -108,6 +355,15 @@ func (p *AddBaremetalHostParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *AddBaremetalHostParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *AddBaremetalHostParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalHostParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -115,6 +371,15 @@ func (p *AddBaremetalHostParams) SetIpaddress(v string) {
 	p.p["ipaddress"] = v
 }
 
+func (p *AddBaremetalHostParams) ResetIpaddress() {
+	delete(p.p, "ipaddress")
+}
+
+func (p *AddBaremetalHostParams) GetIpaddress() (string, bool) {
+	value, ok := p.p["ipaddress"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalHostParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -122,6 +387,15 @@ func (p *AddBaremetalHostParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *AddBaremetalHostParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddBaremetalHostParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalHostParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -129,6 +403,15 @@ func (p *AddBaremetalHostParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *AddBaremetalHostParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *AddBaremetalHostParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalHostParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -136,6 +419,15 @@ func (p *AddBaremetalHostParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *AddBaremetalHostParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddBaremetalHostParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalHostParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -143,6 +435,15 @@ func (p *AddBaremetalHostParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddBaremetalHostParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddBaremetalHostParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 func (p *AddBaremetalHostParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -150,6 +451,15 @@ func (p *AddBaremetalHostParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *AddBaremetalHostParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *AddBaremetalHostParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddBaremetalHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewAddBaremetalHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string) *AddBaremetalHostParams {
@@ -166,7 +476,16 @@ func (s *HostService) NewAddBaremetalHostParams(hypervisor string, password stri
 
 // add a baremetal host
 func (s *HostService) AddBaremetalHost(p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalHost", p.toURLValues())
+	return s.AddBaremetalHostWithContext(context.Background(), p)
+}
+
+// AddBaremetalHostWithContext is the same as AddBaremetalHost, but uses ctx to cancel the request and any async job polling
+func (s *HostService) AddBaremetalHostWithContext(ctx context.Context, p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addBaremetalHost", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -262,7 +581,8 @@ type AddGloboDnsHostParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddGloboDnsHostParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddGloboDnsHostParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -282,6 +602,51 @@ func (p *AddGloboDnsHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddGloboDnsHostParams) Validate() error {
+	v := newParamValidator("addGloboDnsHost", p.p)
+	v.required("password", "physicalnetworkid", "url", "username")
+	v.ids("physicalnetworkid")
+	v.maxLength("password", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddGloboDnsHostParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddGloboDnsHostParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "password", "physicalnetworkid", "url", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addGloboDnsHost", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addGloboDnsHost: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddGloboDnsHostParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -289,6 +654,15 @@ func (p *AddGloboDnsHostParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *AddGloboDnsHostParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddGloboDnsHostParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *AddGloboDnsHostParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -296,6 +670,15 @@ func (p *AddGloboDnsHostParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *AddGloboDnsHostParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddGloboDnsHostParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *AddGloboDnsHostParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -303,6 +686,15 @@ func (p *AddGloboDnsHostParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *AddGloboDnsHostParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddGloboDnsHostParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *AddGloboDnsHostParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -310,6 +702,15 @@ func (p *AddGloboDnsHostParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddGloboDnsHostParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddGloboDnsHostParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddGloboDnsHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewAddGloboDnsHostParams(password string, physicalnetworkid string, url string, username string) *AddGloboDnsHostParams {
@@ -324,7 +725,16 @@ func (s *HostService) NewAddGloboDnsHostParams(password string, physicalnetworki
 
 // Adds the GloboDNS external host
 func (s *HostService) AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error) {
-	resp, err := s.cs.newRequest("addGloboDnsHost", p.toURLValues())
+	return s.AddGloboDnsHostWithContext(context.Background(), p)
+}
+
+// AddGloboDnsHostWithContext is the same as AddGloboDnsHost, but uses ctx to cancel the request and any async job polling
+func (s *HostService) AddGloboDnsHostWithContext(ctx context.Context, p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addGloboDnsHost", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -336,7 +746,7 @@ func (s *HostService) AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHos
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -352,6 +762,31 @@ func (s *HostService) AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHos
 	return &r, nil
 }
 
+// AddGloboDnsHostAsync starts the async job for AddGloboDnsHost and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddGloboDnsHostResponse using Job.Result
+func (s *HostService) AddGloboDnsHostAsync(p *AddGloboDnsHostParams) (*Job, error) {
+	return s.AddGloboDnsHostAsyncWithContext(context.Background(), p)
+}
+
+// AddGloboDnsHostAsyncWithContext is the same as AddGloboDnsHostAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) AddGloboDnsHostAsyncWithContext(ctx context.Context, p *AddGloboDnsHostParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addGloboDnsHost", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddGloboDnsHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type AddGloboDnsHostResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -363,7 +798,8 @@ type AddHostParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddHostParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddHostParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -402,6 +838,58 @@ func (p *AddHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddHostParams) Validate() error {
+	v := newParamValidator("addHost", p.p)
+	v.required("hypervisor", "password", "podid", "url", "username", "zoneid")
+	v.ids("clusterid", "podid", "zoneid")
+	v.maxLength("allocationstate", 255)
+	v.maxLength("clustername", 255)
+	v.maxLength("hypervisor", 255)
+	v.maxLength("password", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddHostParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddHostParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "allocationstate", "clusterid", "clustername", "hypervisor", "password", "podid", "url", "username", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "hosttags":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addHost", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addHost: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddHostParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -409,6 +897,15 @@ func (p *AddHostParams) SetAllocationstate(v string) {
 	p.p["allocationstate"] = v
 }
 
+func (p *AddHostParams) ResetAllocationstate() {
+	delete(p.p, "allocationstate")
+}
+
+func (p *AddHostParams) GetAllocationstate() (string, bool) {
+	value, ok := p.p["allocationstate"].(string)
+	return value, ok
+}
+
 func (p *AddHostParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -416,6 +913,15 @@ func (p *AddHostParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *AddHostParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *AddHostParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *AddHostParams) SetClustername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -423,6 +929,15 @@ func (p *AddHostParams) SetClustername(v string) {
 	p.p["clustername"] = v
 }
 
+func (p *AddHostParams) ResetClustername() {
+	delete(p.p, "clustername")
+}
+
+func (p *AddHostParams) GetClustername() (string, bool) {
+	value, ok := p.p["clustername"].(string)
+	return value, ok
+}
+
 func (p *AddHostParams) SetHosttags(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -430,6 +945,15 @@ func (p *AddHostParams) SetHosttags(v []string) {
 	p.p["hosttags"] = v
 }
 
+func (p *AddHostParams) ResetHosttags() {
+	delete(p.p, "hosttags")
+}
+
+func (p *AddHostParams) GetHosttags() ([]string, bool) {
+	value, ok := p.p["hosttags"].([]string)
+	return value, ok
+}
+
 func (p *AddHostParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -437,6 +961,15 @@ func (p *AddHostParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *AddHostParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *AddHostParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *AddHostParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -444,6 +977,15 @@ func (p *AddHostParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *AddHostParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddHostParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *AddHostParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -451,6 +993,15 @@ func (p *AddHostParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *AddHostParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *AddHostParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *AddHostParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -458,6 +1009,15 @@ func (p *AddHostParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *AddHostParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddHostParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *AddHostParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -465,6 +1025,15 @@ func (p *AddHostParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddHostParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddHostParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 func (p *AddHostParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -472,6 +1041,15 @@ func (p *AddHostParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *AddHostParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *AddHostParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewAddHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string) *AddHostParams {
@@ -488,7 +1066,16 @@ func (s *HostService) NewAddHostParams(hypervisor string, password string, podid
 
 // Adds a new host.
 func (s *HostService) AddHost(p *AddHostParams) (*AddHostResponse, error) {
-	resp, err := s.cs.newRequest("addHost", p.toURLValues())
+	return s.AddHostWithContext(context.Background(), p)
+}
+
+// AddHostWithContext is the same as AddHost, but uses ctx to cancel the request and any async job polling
+func (s *HostService) AddHostWithContext(ctx context.Context, p *AddHostParams) (*AddHostResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addHost", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -584,7 +1171,8 @@ type AddSecondaryStorageParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddSecondaryStorageParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddSecondaryStorageParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -598,6 +1186,49 @@ func (p *AddSecondaryStorageParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddSecondaryStorageParams) Validate() error {
+	v := newParamValidator("addSecondaryStorage", p.p)
+	v.required("url")
+	v.ids("zoneid")
+	v.maxLength("url", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddSecondaryStorageParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddSecondaryStorageParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "url", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addSecondaryStorage", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addSecondaryStorage: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddSecondaryStorageParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -605,6 +1236,15 @@ func (p *AddSecondaryStorageParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *AddSecondaryStorageParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddSecondaryStorageParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *AddSecondaryStorageParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -612,6 +1252,15 @@ func (p *AddSecondaryStorageParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *AddSecondaryStorageParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *AddSecondaryStorageParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddSecondaryStorageParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewAddSecondaryStorageParams(url string) *AddSecondaryStorageParams {
@@ -623,7 +1272,16 @@ func (s *HostService) NewAddSecondaryStorageParams(url string) *AddSecondaryStor
 
 // Adds secondary storage.
 func (s *HostService) AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
-	resp, err := s.cs.newRequest("addSecondaryStorage", p.toURLValues())
+	return s.AddSecondaryStorageWithContext(context.Background(), p)
+}
+
+// AddSecondaryStorageWithContext is the same as AddSecondaryStorage, but uses ctx to cancel the request and any async job polling
+func (s *HostService) AddSecondaryStorageWithContext(ctx context.Context, p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addSecondaryStorage", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -656,7 +1314,8 @@ type CancelHostMaintenanceParams struct {
 	p map[string]interface{}
 }
 
-func (p *CancelHostMaintenanceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CancelHostMaintenanceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -667,6 +1326,48 @@ func (p *CancelHostMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CancelHostMaintenanceParams) Validate() error {
+	v := newParamValidator("cancelHostMaintenance", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CancelHostMaintenanceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CancelHostMaintenanceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for cancelHostMaintenance", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of cancelHostMaintenance: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CancelHostMaintenanceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -674,6 +1375,15 @@ func (p *CancelHostMaintenanceParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *CancelHostMaintenanceParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *CancelHostMaintenanceParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CancelHostMaintenanceParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewCancelHostMaintenanceParams(id string) *CancelHostMaintenanceParams {
@@ -685,7 +1395,16 @@ func (s *HostService) NewCancelHostMaintenanceParams(id string) *CancelHostMaint
 
 // Cancels host maintenance.
 func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("cancelHostMaintenance", p.toURLValues())
+	return s.CancelHostMaintenanceWithContext(context.Background(), p)
+}
+
+// CancelHostMaintenanceWithContext is the same as CancelHostMaintenance, but uses ctx to cancel the request and any async job polling
+func (s *HostService) CancelHostMaintenanceWithContext(ctx context.Context, p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "cancelHostMaintenance", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -697,7 +1416,7 @@ func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*Ca
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -718,6 +1437,40 @@ func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*Ca
 	return &r, nil
 }
 
+// CancelHostMaintenanceAsync starts the async job for CancelHostMaintenance and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CancelHostMaintenanceResponse using Job.Result
+func (s *HostService) CancelHostMaintenanceAsync(p *CancelHostMaintenanceParams) (*Job, error) {
+	return s.CancelHostMaintenanceAsyncWithContext(context.Background(), p)
+}
+
+// CancelHostMaintenanceAsyncWithContext is the same as CancelHostMaintenanceAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) CancelHostMaintenanceAsyncWithContext(ctx context.Context, p *CancelHostMaintenanceParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "cancelHostMaintenance", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CancelHostMaintenanceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CancelHostMaintenanceResponse struct {
 	Annotation                       string                                  `json:"annotation"`
 	Capabilities                     string                                  `json:"capabilities"`
@@ -797,18 +1550,211 @@ type CancelHostMaintenanceResponseGpugroupVgpu struct {
 	Videoram          int64  `json:"videoram"`
 }
 
-type DedicateHostParams struct {
+type ConfigureHAForHostParams struct {
 	p map[string]interface{}
 }
 
-func (p *DedicateHostParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ConfigureHAForHostParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
+	if v, found := p.p["hostid"]; found {
+		u.Set("hostid", v.(string))
+	}
+	if v, found := p.p["provider"]; found {
+		u.Set("provider", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ConfigureHAForHostParams) Validate() error {
+	v := newParamValidator("configureHAForHost", p.p)
+	v.required("hostid", "provider")
+	v.ids("hostid")
+	v.maxLength("provider", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ConfigureHAForHostParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ConfigureHAForHostParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid", "provider":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for configureHAForHost", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of configureHAForHost: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ConfigureHAForHostParams) SetHostid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostid"] = v
+}
+
+func (p *ConfigureHAForHostParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ConfigureHAForHostParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
+func (p *ConfigureHAForHostParams) SetProvider(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["provider"] = v
+}
+
+func (p *ConfigureHAForHostParams) ResetProvider() {
+	delete(p.p, "provider")
+}
+
+func (p *ConfigureHAForHostParams) GetProvider() (string, bool) {
+	value, ok := p.p["provider"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ConfigureHAForHostParams instance,
+// as then you are sure you have configured all required params
+func (s *HostService) NewConfigureHAForHostParams(hostid string, provider string) *ConfigureHAForHostParams {
+	p := &ConfigureHAForHostParams{}
+	p.p = make(map[string]interface{})
+	p.p["hostid"] = hostid
+	p.p["provider"] = provider
+	return p
+}
+
+// Configures HA for a host
+func (s *HostService) ConfigureHAForHost(p *ConfigureHAForHostParams) (*HAForHostResponse, error) {
+	return s.ConfigureHAForHostWithContext(context.Background(), p)
+}
+
+// ConfigureHAForHostWithContext is the same as ConfigureHAForHost, but uses ctx to cancel the request and any async job polling
+func (s *HostService) ConfigureHAForHostWithContext(ctx context.Context, p *ConfigureHAForHostParams) (*HAForHostResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureHAForHost", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r HAForHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// ConfigureHAForHostAsync starts the async job for ConfigureHAForHost and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a HAForHostResponse using Job.Result
+func (s *HostService) ConfigureHAForHostAsync(p *ConfigureHAForHostParams) (*Job, error) {
+	return s.ConfigureHAForHostAsyncWithContext(context.Background(), p)
+}
+
+// ConfigureHAForHostAsyncWithContext is the same as ConfigureHAForHostAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) ConfigureHAForHostAsyncWithContext(ctx context.Context, p *ConfigureHAForHostParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureHAForHost", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r HAForHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type HAForHostResponse struct {
+	Haenable   bool   `json:"haenable"`
+	Haprovider string `json:"haprovider"`
+	Hastate    string `json:"hastate"`
+	Hostid     string `json:"hostid"`
+	JobID      string `json:"jobid"`
+	Jobstatus  int    `json:"jobstatus"`
+	Status     bool   `json:"status"`
+}
+
+type DedicateHostParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DedicateHostParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
 	if v, found := p.p["domainid"]; found {
 		u.Set("domainid", v.(string))
 	}
@@ -818,6 +1764,49 @@ func (p *DedicateHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DedicateHostParams) Validate() error {
+	v := newParamValidator("dedicateHost", p.p)
+	v.required("domainid", "hostid")
+	v.ids("domainid", "hostid")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DedicateHostParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DedicateHostParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "hostid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for dedicateHost", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of dedicateHost: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DedicateHostParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -825,6 +1814,15 @@ func (p *DedicateHostParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *DedicateHostParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *DedicateHostParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *DedicateHostParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -832,6 +1830,15 @@ func (p *DedicateHostParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *DedicateHostParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *DedicateHostParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *DedicateHostParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -839,6 +1846,15 @@ func (p *DedicateHostParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *DedicateHostParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *DedicateHostParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DedicateHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewDedicateHostParams(domainid string, hostid string) *DedicateHostParams {
@@ -851,7 +1867,16 @@ func (s *HostService) NewDedicateHostParams(domainid string, hostid string) *Ded
 
 // Dedicates a host.
 func (s *HostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error) {
-	resp, err := s.cs.newRequest("dedicateHost", p.toURLValues())
+	return s.DedicateHostWithContext(context.Background(), p)
+}
+
+// DedicateHostWithContext is the same as DedicateHost, but uses ctx to cancel the request and any async job polling
+func (s *HostService) DedicateHostWithContext(ctx context.Context, p *DedicateHostParams) (*DedicateHostResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicateHost", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -863,7 +1888,7 @@ func (s *HostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -884,6 +1909,40 @@ func (s *HostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse
 	return &r, nil
 }
 
+// DedicateHostAsync starts the async job for DedicateHost and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DedicateHostResponse using Job.Result
+func (s *HostService) DedicateHostAsync(p *DedicateHostParams) (*Job, error) {
+	return s.DedicateHostAsyncWithContext(context.Background(), p)
+}
+
+// DedicateHostAsyncWithContext is the same as DedicateHostAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) DedicateHostAsyncWithContext(ctx context.Context, p *DedicateHostParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicateHost", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DedicateHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type DedicateHostResponse struct {
 	Accountid       string `json:"accountid"`
 	Affinitygroupid string `json:"affinitygroupid"`
@@ -899,7 +1958,8 @@ type DeleteHostParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteHostParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteHostParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -918,6 +1978,52 @@ func (p *DeleteHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteHostParams) Validate() error {
+	v := newParamValidator("deleteHost", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteHostParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteHostParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "forced", "forcedestroylocalstorage":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteHost", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteHost: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteHostParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -925,6 +2031,15 @@ func (p *DeleteHostParams) SetForced(v bool) {
 	p.p["forced"] = v
 }
 
+func (p *DeleteHostParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *DeleteHostParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
 func (p *DeleteHostParams) SetForcedestroylocalstorage(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -932,6 +2047,15 @@ func (p *DeleteHostParams) SetForcedestroylocalstorage(v bool) {
 	p.p["forcedestroylocalstorage"] = v
 }
 
+func (p *DeleteHostParams) ResetForcedestroylocalstorage() {
+	delete(p.p, "forcedestroylocalstorage")
+}
+
+func (p *DeleteHostParams) GetForcedestroylocalstorage() (bool, bool) {
+	value, ok := p.p["forcedestroylocalstorage"].(bool)
+	return value, ok
+}
+
 func (p *DeleteHostParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -939,6 +2063,15 @@ func (p *DeleteHostParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteHostParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteHostParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewDeleteHostParams(id string) *DeleteHostParams {
@@ -950,7 +2083,16 @@ func (s *HostService) NewDeleteHostParams(id string) *DeleteHostParams {
 
 // Deletes a host.
 func (s *HostService) DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error) {
-	resp, err := s.cs.newRequest("deleteHost", p.toURLValues())
+	return s.DeleteHostWithContext(context.Background(), p)
+}
+
+// DeleteHostWithContext is the same as DeleteHost, but uses ctx to cancel the request and any async job polling
+func (s *HostService) DeleteHostWithContext(ctx context.Context, p *DeleteHostParams) (*DeleteHostResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteHost", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -997,52 +2139,113 @@ func (r *DeleteHostResponse) UnmarshalJSON(b []byte) error {
 	return json.Unmarshal(b, (*alias)(r))
 }
 
-type DisableOutOfBandManagementForHostParams struct {
+type DisableHAForClusterParams struct {
 	p map[string]interface{}
 }
 
-func (p *DisableOutOfBandManagementForHostParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DisableHAForClusterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if v, found := p.p["clusterid"]; found {
+		u.Set("clusterid", v.(string))
 	}
 	return u
 }
 
-func (p *DisableOutOfBandManagementForHostParams) SetHostid(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DisableHAForClusterParams) Validate() error {
+	v := newParamValidator("disableHAForCluster", p.p)
+	v.required("clusterid")
+	v.ids("clusterid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DisableHAForClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DisableHAForClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for disableHAForCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of disableHAForCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DisableHAForClusterParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["hostid"] = v
+	p.p["clusterid"] = v
 }
 
-// You should always use this function to get a new DisableOutOfBandManagementForHostParams instance,
+func (p *DisableHAForClusterParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *DisableHAForClusterParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DisableHAForClusterParams instance,
 // as then you are sure you have configured all required params
-func (s *HostService) NewDisableOutOfBandManagementForHostParams(hostid string) *DisableOutOfBandManagementForHostParams {
-	p := &DisableOutOfBandManagementForHostParams{}
+func (s *HostService) NewDisableHAForClusterParams(clusterid string) *DisableHAForClusterParams {
+	p := &DisableHAForClusterParams{}
 	p.p = make(map[string]interface{})
-	p.p["hostid"] = hostid
+	p.p["clusterid"] = clusterid
 	return p
 }
 
-// Disables out-of-band management for a host
-func (s *HostService) DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error) {
-	resp, err := s.cs.newRequest("disableOutOfBandManagementForHost", p.toURLValues())
+// Disables HA cluster-wide
+func (s *HostService) DisableHAForCluster(p *DisableHAForClusterParams) (*DisableHAForClusterResponse, error) {
+	return s.DisableHAForClusterWithContext(context.Background(), p)
+}
+
+// DisableHAForClusterWithContext is the same as DisableHAForCluster, but uses ctx to cancel the request and any async job polling
+func (s *HostService) DisableHAForClusterWithContext(ctx context.Context, p *DisableHAForClusterParams) (*DisableHAForClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableHAForCluster", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DisableOutOfBandManagementForHostResponse
+	var r DisableHAForClusterResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1050,11 +2253,6 @@ func (s *HostService) DisableOutOfBandManagementForHost(p *DisableOutOfBandManag
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
 		if err := json.Unmarshal(b, &r); err != nil {
 			return nil, err
 		}
@@ -1063,27 +2261,1025 @@ func (s *HostService) DisableOutOfBandManagementForHost(p *DisableOutOfBandManag
 	return &r, nil
 }
 
-type DisableOutOfBandManagementForHostResponse struct {
-	Action      string `json:"action"`
-	Address     string `json:"address"`
-	Description string `json:"description"`
-	Driver      string `json:"driver"`
-	Enabled     bool   `json:"enabled"`
-	Hostid      string `json:"hostid"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Password    string `json:"password"`
+// DisableHAForClusterAsync starts the async job for DisableHAForCluster and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DisableHAForClusterResponse using Job.Result
+func (s *HostService) DisableHAForClusterAsync(p *DisableHAForClusterParams) (*Job, error) {
+	return s.DisableHAForClusterAsyncWithContext(context.Background(), p)
+}
+
+// DisableHAForClusterAsyncWithContext is the same as DisableHAForClusterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) DisableHAForClusterAsyncWithContext(ctx context.Context, p *DisableHAForClusterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableHAForCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableHAForClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DisableHAForClusterResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DisableHAForHostParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DisableHAForHostParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["hostid"]; found {
+		u.Set("hostid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DisableHAForHostParams) Validate() error {
+	v := newParamValidator("disableHAForHost", p.p)
+	v.required("hostid")
+	v.ids("hostid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DisableHAForHostParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DisableHAForHostParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for disableHAForHost", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of disableHAForHost: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DisableHAForHostParams) SetHostid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostid"] = v
+}
+
+func (p *DisableHAForHostParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *DisableHAForHostParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DisableHAForHostParams instance,
+// as then you are sure you have configured all required params
+func (s *HostService) NewDisableHAForHostParams(hostid string) *DisableHAForHostParams {
+	p := &DisableHAForHostParams{}
+	p.p = make(map[string]interface{})
+	p.p["hostid"] = hostid
+	return p
+}
+
+// Disables HA for a host
+func (s *HostService) DisableHAForHost(p *DisableHAForHostParams) (*DisableHAForHostResponse, error) {
+	return s.DisableHAForHostWithContext(context.Background(), p)
+}
+
+// DisableHAForHostWithContext is the same as DisableHAForHost, but uses ctx to cancel the request and any async job polling
+func (s *HostService) DisableHAForHostWithContext(ctx context.Context, p *DisableHAForHostParams) (*DisableHAForHostResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableHAForHost", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableHAForHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DisableHAForHostAsync starts the async job for DisableHAForHost and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DisableHAForHostResponse using Job.Result
+func (s *HostService) DisableHAForHostAsync(p *DisableHAForHostParams) (*Job, error) {
+	return s.DisableHAForHostAsyncWithContext(context.Background(), p)
+}
+
+// DisableHAForHostAsyncWithContext is the same as DisableHAForHostAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) DisableHAForHostAsyncWithContext(ctx context.Context, p *DisableHAForHostParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableHAForHost", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableHAForHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type DisableHAForHostResponse struct {
+	Haenable   bool   `json:"haenable"`
+	Haprovider string `json:"haprovider"`
+	Hastate    string `json:"hastate"`
+	Hostid     string `json:"hostid"`
+	JobID      string `json:"jobid"`
+	Jobstatus  int    `json:"jobstatus"`
+	Status     bool   `json:"status"`
+}
+
+type DisableHAForZoneParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DisableHAForZoneParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DisableHAForZoneParams) Validate() error {
+	v := newParamValidator("disableHAForZone", p.p)
+	v.required("zoneid")
+	v.ids("zoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DisableHAForZoneParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DisableHAForZoneParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for disableHAForZone", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of disableHAForZone: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DisableHAForZoneParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *DisableHAForZoneParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *DisableHAForZoneParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DisableHAForZoneParams instance,
+// as then you are sure you have configured all required params
+func (s *HostService) NewDisableHAForZoneParams(zoneid string) *DisableHAForZoneParams {
+	p := &DisableHAForZoneParams{}
+	p.p = make(map[string]interface{})
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// Disables HA for a zone
+func (s *HostService) DisableHAForZone(p *DisableHAForZoneParams) (*DisableHAForZoneResponse, error) {
+	return s.DisableHAForZoneWithContext(context.Background(), p)
+}
+
+// DisableHAForZoneWithContext is the same as DisableHAForZone, but uses ctx to cancel the request and any async job polling
+func (s *HostService) DisableHAForZoneWithContext(ctx context.Context, p *DisableHAForZoneParams) (*DisableHAForZoneResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableHAForZone", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableHAForZoneResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DisableHAForZoneAsync starts the async job for DisableHAForZone and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DisableHAForZoneResponse using Job.Result
+func (s *HostService) DisableHAForZoneAsync(p *DisableHAForZoneParams) (*Job, error) {
+	return s.DisableHAForZoneAsyncWithContext(context.Background(), p)
+}
+
+// DisableHAForZoneAsyncWithContext is the same as DisableHAForZoneAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) DisableHAForZoneAsyncWithContext(ctx context.Context, p *DisableHAForZoneParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableHAForZone", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableHAForZoneResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DisableHAForZoneResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DisableOutOfBandManagementForHostParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DisableOutOfBandManagementForHostParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["hostid"]; found {
+		u.Set("hostid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DisableOutOfBandManagementForHostParams) Validate() error {
+	v := newParamValidator("disableOutOfBandManagementForHost", p.p)
+	v.required("hostid")
+	v.ids("hostid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DisableOutOfBandManagementForHostParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DisableOutOfBandManagementForHostParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for disableOutOfBandManagementForHost", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of disableOutOfBandManagementForHost: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DisableOutOfBandManagementForHostParams) SetHostid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostid"] = v
+}
+
+func (p *DisableOutOfBandManagementForHostParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *DisableOutOfBandManagementForHostParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DisableOutOfBandManagementForHostParams instance,
+// as then you are sure you have configured all required params
+func (s *HostService) NewDisableOutOfBandManagementForHostParams(hostid string) *DisableOutOfBandManagementForHostParams {
+	p := &DisableOutOfBandManagementForHostParams{}
+	p.p = make(map[string]interface{})
+	p.p["hostid"] = hostid
+	return p
+}
+
+// Disables out-of-band management for a host
+func (s *HostService) DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error) {
+	return s.DisableOutOfBandManagementForHostWithContext(context.Background(), p)
+}
+
+// DisableOutOfBandManagementForHostWithContext is the same as DisableOutOfBandManagementForHost, but uses ctx to cancel the request and any async job polling
+func (s *HostService) DisableOutOfBandManagementForHostWithContext(ctx context.Context, p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableOutOfBandManagementForHost", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableOutOfBandManagementForHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DisableOutOfBandManagementForHostAsync starts the async job for DisableOutOfBandManagementForHost and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DisableOutOfBandManagementForHostResponse using Job.Result
+func (s *HostService) DisableOutOfBandManagementForHostAsync(p *DisableOutOfBandManagementForHostParams) (*Job, error) {
+	return s.DisableOutOfBandManagementForHostAsyncWithContext(context.Background(), p)
+}
+
+// DisableOutOfBandManagementForHostAsyncWithContext is the same as DisableOutOfBandManagementForHostAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) DisableOutOfBandManagementForHostAsyncWithContext(ctx context.Context, p *DisableOutOfBandManagementForHostParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableOutOfBandManagementForHost", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableOutOfBandManagementForHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type DisableOutOfBandManagementForHostResponse struct {
+	Action      string `json:"action"`
+	Address     string `json:"address"`
+	Description string `json:"description"`
+	Driver      string `json:"driver"`
+	Enabled     bool   `json:"enabled"`
+	Hostid      string `json:"hostid"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Password    string `json:"password"`
 	Port        string `json:"port"`
 	Powerstate  string `json:"powerstate"`
 	Status      bool   `json:"status"`
 	Username    string `json:"username"`
 }
 
+type EnableHAForClusterParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *EnableHAForClusterParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["clusterid"]; found {
+		u.Set("clusterid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *EnableHAForClusterParams) Validate() error {
+	v := newParamValidator("enableHAForCluster", p.p)
+	v.required("clusterid")
+	v.ids("clusterid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *EnableHAForClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *EnableHAForClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for enableHAForCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of enableHAForCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *EnableHAForClusterParams) SetClusterid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["clusterid"] = v
+}
+
+func (p *EnableHAForClusterParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *EnableHAForClusterParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new EnableHAForClusterParams instance,
+// as then you are sure you have configured all required params
+func (s *HostService) NewEnableHAForClusterParams(clusterid string) *EnableHAForClusterParams {
+	p := &EnableHAForClusterParams{}
+	p.p = make(map[string]interface{})
+	p.p["clusterid"] = clusterid
+	return p
+}
+
+// Enables HA cluster-wide
+func (s *HostService) EnableHAForCluster(p *EnableHAForClusterParams) (*EnableHAForClusterResponse, error) {
+	return s.EnableHAForClusterWithContext(context.Background(), p)
+}
+
+// EnableHAForClusterWithContext is the same as EnableHAForCluster, but uses ctx to cancel the request and any async job polling
+func (s *HostService) EnableHAForClusterWithContext(ctx context.Context, p *EnableHAForClusterParams) (*EnableHAForClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableHAForCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableHAForClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// EnableHAForClusterAsync starts the async job for EnableHAForCluster and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a EnableHAForClusterResponse using Job.Result
+func (s *HostService) EnableHAForClusterAsync(p *EnableHAForClusterParams) (*Job, error) {
+	return s.EnableHAForClusterAsyncWithContext(context.Background(), p)
+}
+
+// EnableHAForClusterAsyncWithContext is the same as EnableHAForClusterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) EnableHAForClusterAsyncWithContext(ctx context.Context, p *EnableHAForClusterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableHAForCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableHAForClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type EnableHAForClusterResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type EnableHAForHostParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *EnableHAForHostParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["hostid"]; found {
+		u.Set("hostid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *EnableHAForHostParams) Validate() error {
+	v := newParamValidator("enableHAForHost", p.p)
+	v.required("hostid")
+	v.ids("hostid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *EnableHAForHostParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *EnableHAForHostParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for enableHAForHost", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of enableHAForHost: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *EnableHAForHostParams) SetHostid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostid"] = v
+}
+
+func (p *EnableHAForHostParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *EnableHAForHostParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new EnableHAForHostParams instance,
+// as then you are sure you have configured all required params
+func (s *HostService) NewEnableHAForHostParams(hostid string) *EnableHAForHostParams {
+	p := &EnableHAForHostParams{}
+	p.p = make(map[string]interface{})
+	p.p["hostid"] = hostid
+	return p
+}
+
+// Enables HA for a host
+func (s *HostService) EnableHAForHost(p *EnableHAForHostParams) (*EnableHAForHostResponse, error) {
+	return s.EnableHAForHostWithContext(context.Background(), p)
+}
+
+// EnableHAForHostWithContext is the same as EnableHAForHost, but uses ctx to cancel the request and any async job polling
+func (s *HostService) EnableHAForHostWithContext(ctx context.Context, p *EnableHAForHostParams) (*EnableHAForHostResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableHAForHost", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableHAForHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// EnableHAForHostAsync starts the async job for EnableHAForHost and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a EnableHAForHostResponse using Job.Result
+func (s *HostService) EnableHAForHostAsync(p *EnableHAForHostParams) (*Job, error) {
+	return s.EnableHAForHostAsyncWithContext(context.Background(), p)
+}
+
+// EnableHAForHostAsyncWithContext is the same as EnableHAForHostAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) EnableHAForHostAsyncWithContext(ctx context.Context, p *EnableHAForHostParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableHAForHost", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableHAForHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type EnableHAForHostResponse struct {
+	Haenable   bool   `json:"haenable"`
+	Haprovider string `json:"haprovider"`
+	Hastate    string `json:"hastate"`
+	Hostid     string `json:"hostid"`
+	JobID      string `json:"jobid"`
+	Jobstatus  int    `json:"jobstatus"`
+	Status     bool   `json:"status"`
+}
+
+type EnableHAForZoneParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *EnableHAForZoneParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *EnableHAForZoneParams) Validate() error {
+	v := newParamValidator("enableHAForZone", p.p)
+	v.required("zoneid")
+	v.ids("zoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *EnableHAForZoneParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *EnableHAForZoneParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for enableHAForZone", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of enableHAForZone: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *EnableHAForZoneParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *EnableHAForZoneParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *EnableHAForZoneParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new EnableHAForZoneParams instance,
+// as then you are sure you have configured all required params
+func (s *HostService) NewEnableHAForZoneParams(zoneid string) *EnableHAForZoneParams {
+	p := &EnableHAForZoneParams{}
+	p.p = make(map[string]interface{})
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// Enables HA for a zone
+func (s *HostService) EnableHAForZone(p *EnableHAForZoneParams) (*EnableHAForZoneResponse, error) {
+	return s.EnableHAForZoneWithContext(context.Background(), p)
+}
+
+// EnableHAForZoneWithContext is the same as EnableHAForZone, but uses ctx to cancel the request and any async job polling
+func (s *HostService) EnableHAForZoneWithContext(ctx context.Context, p *EnableHAForZoneParams) (*EnableHAForZoneResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableHAForZone", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableHAForZoneResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// EnableHAForZoneAsync starts the async job for EnableHAForZone and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a EnableHAForZoneResponse using Job.Result
+func (s *HostService) EnableHAForZoneAsync(p *EnableHAForZoneParams) (*Job, error) {
+	return s.EnableHAForZoneAsyncWithContext(context.Background(), p)
+}
+
+// EnableHAForZoneAsyncWithContext is the same as EnableHAForZoneAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) EnableHAForZoneAsyncWithContext(ctx context.Context, p *EnableHAForZoneParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableHAForZone", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableHAForZoneResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type EnableHAForZoneResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
 type EnableOutOfBandManagementForHostParams struct {
 	p map[string]interface{}
 }
 
-func (p *EnableOutOfBandManagementForHostParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *EnableOutOfBandManagementForHostParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1094,6 +3290,48 @@ func (p *EnableOutOfBandManagementForHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *EnableOutOfBandManagementForHostParams) Validate() error {
+	v := newParamValidator("enableOutOfBandManagementForHost", p.p)
+	v.required("hostid")
+	v.ids("hostid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *EnableOutOfBandManagementForHostParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *EnableOutOfBandManagementForHostParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for enableOutOfBandManagementForHost", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of enableOutOfBandManagementForHost: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *EnableOutOfBandManagementForHostParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1101,6 +3339,15 @@ func (p *EnableOutOfBandManagementForHostParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *EnableOutOfBandManagementForHostParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *EnableOutOfBandManagementForHostParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new EnableOutOfBandManagementForHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewEnableOutOfBandManagementForHostParams(hostid string) *EnableOutOfBandManagementForHostParams {
@@ -1112,7 +3359,16 @@ func (s *HostService) NewEnableOutOfBandManagementForHostParams(hostid string) *
 
 // Enables out-of-band management for a host
 func (s *HostService) EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error) {
-	resp, err := s.cs.newRequest("enableOutOfBandManagementForHost", p.toURLValues())
+	return s.EnableOutOfBandManagementForHostWithContext(context.Background(), p)
+}
+
+// EnableOutOfBandManagementForHostWithContext is the same as EnableOutOfBandManagementForHost, but uses ctx to cancel the request and any async job polling
+func (s *HostService) EnableOutOfBandManagementForHostWithContext(ctx context.Context, p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableOutOfBandManagementForHost", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1124,7 +3380,7 @@ func (s *HostService) EnableOutOfBandManagementForHost(p *EnableOutOfBandManagem
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1145,6 +3401,40 @@ func (s *HostService) EnableOutOfBandManagementForHost(p *EnableOutOfBandManagem
 	return &r, nil
 }
 
+// EnableOutOfBandManagementForHostAsync starts the async job for EnableOutOfBandManagementForHost and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a EnableOutOfBandManagementForHostResponse using Job.Result
+func (s *HostService) EnableOutOfBandManagementForHostAsync(p *EnableOutOfBandManagementForHostParams) (*Job, error) {
+	return s.EnableOutOfBandManagementForHostAsyncWithContext(context.Background(), p)
+}
+
+// EnableOutOfBandManagementForHostAsyncWithContext is the same as EnableOutOfBandManagementForHostAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) EnableOutOfBandManagementForHostAsyncWithContext(ctx context.Context, p *EnableOutOfBandManagementForHostParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableOutOfBandManagementForHost", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableOutOfBandManagementForHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type EnableOutOfBandManagementForHostResponse struct {
 	Action      string `json:"action"`
 	Address     string `json:"address"`
@@ -1165,7 +3455,8 @@ type FindHostsForMigrationParams struct {
 	p map[string]interface{}
 }
 
-func (p *FindHostsForMigrationParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *FindHostsForMigrationParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1187,6 +3478,53 @@ func (p *FindHostsForMigrationParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *FindHostsForMigrationParams) Validate() error {
+	v := newParamValidator("findHostsForMigration", p.p)
+	v.required("virtualmachineid")
+	v.ids("virtualmachineid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *FindHostsForMigrationParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *FindHostsForMigrationParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for findHostsForMigration", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of findHostsForMigration: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *FindHostsForMigrationParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1194,6 +3532,15 @@ func (p *FindHostsForMigrationParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *FindHostsForMigrationParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *FindHostsForMigrationParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *FindHostsForMigrationParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1201,6 +3548,15 @@ func (p *FindHostsForMigrationParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *FindHostsForMigrationParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *FindHostsForMigrationParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *FindHostsForMigrationParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1208,6 +3564,15 @@ func (p *FindHostsForMigrationParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *FindHostsForMigrationParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *FindHostsForMigrationParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *FindHostsForMigrationParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1215,6 +3580,15 @@ func (p *FindHostsForMigrationParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *FindHostsForMigrationParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *FindHostsForMigrationParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new FindHostsForMigrationParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewFindHostsForMigrationParams(virtualmachineid string) *FindHostsForMigrationParams {
@@ -1226,7 +3600,16 @@ func (s *HostService) NewFindHostsForMigrationParams(virtualmachineid string) *F
 
 // Find hosts suitable for migrating a virtual machine.
 func (s *HostService) FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
-	resp, err := s.cs.newRequest("findHostsForMigration", p.toURLValues())
+	return s.FindHostsForMigrationWithContext(context.Background(), p)
+}
+
+// FindHostsForMigrationWithContext is the same as FindHostsForMigration, but uses ctx to cancel the request and any async job polling
+func (s *HostService) FindHostsForMigrationWithContext(ctx context.Context, p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "findHostsForMigration", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1298,7 +3681,8 @@ type ListDedicatedHostsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListDedicatedHostsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListDedicatedHostsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1329,6 +3713,53 @@ func (p *ListDedicatedHostsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListDedicatedHostsParams) Validate() error {
+	v := newParamValidator("listDedicatedHosts", p.p)
+	v.ids("affinitygroupid", "domainid", "hostid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListDedicatedHostsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListDedicatedHostsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "affinitygroupid", "domainid", "hostid", "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listDedicatedHosts", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listDedicatedHosts: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListDedicatedHostsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1336,6 +3767,15 @@ func (p *ListDedicatedHostsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListDedicatedHostsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListDedicatedHostsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedHostsParams) SetAffinitygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1343,6 +3783,15 @@ func (p *ListDedicatedHostsParams) SetAffinitygroupid(v string) {
 	p.p["affinitygroupid"] = v
 }
 
+func (p *ListDedicatedHostsParams) ResetAffinitygroupid() {
+	delete(p.p, "affinitygroupid")
+}
+
+func (p *ListDedicatedHostsParams) GetAffinitygroupid() (string, bool) {
+	value, ok := p.p["affinitygroupid"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedHostsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1350,6 +3799,15 @@ func (p *ListDedicatedHostsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListDedicatedHostsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListDedicatedHostsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedHostsParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1357,6 +3815,15 @@ func (p *ListDedicatedHostsParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *ListDedicatedHostsParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ListDedicatedHostsParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedHostsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1364,6 +3831,15 @@ func (p *ListDedicatedHostsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListDedicatedHostsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListDedicatedHostsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedHostsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1371,6 +3847,15 @@ func (p *ListDedicatedHostsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListDedicatedHostsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListDedicatedHostsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListDedicatedHostsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1378,6 +3863,15 @@ func (p *ListDedicatedHostsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListDedicatedHostsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListDedicatedHostsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListDedicatedHostsParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewListDedicatedHostsParams() *ListDedicatedHostsParams {
@@ -1388,7 +3882,16 @@ func (s *HostService) NewListDedicatedHostsParams() *ListDedicatedHostsParams {
 
 // Lists dedicated hosts.
 func (s *HostService) ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedHosts", p.toURLValues())
+	return s.ListDedicatedHostsWithContext(context.Background(), p)
+}
+
+// ListDedicatedHostsWithContext is the same as ListDedicatedHosts, but uses ctx to cancel the request and any async job polling
+func (s *HostService) ListDedicatedHostsWithContext(ctx context.Context, p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listDedicatedHosts", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1401,6 +3904,92 @@ func (s *HostService) ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedi
 	return &r, nil
 }
 
+// ListDedicatedHostsPager iterates over all pages of a ListDedicatedHosts call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListDedicatedHostsPager struct {
+	pager
+
+	s *HostService
+	p *ListDedicatedHostsParams
+	r *ListDedicatedHostsResponse
+}
+
+// NewListDedicatedHostsPager returns a pager for all pages of a ListDedicatedHosts call with the given params
+func (s *HostService) NewListDedicatedHostsPager(p *ListDedicatedHostsParams) *ListDedicatedHostsPager {
+	return &ListDedicatedHostsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListDedicatedHostsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListDedicatedHostsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListDedicatedHostsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListDedicatedHostsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.DedicatedHosts), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListDedicatedHostsPager) Page() *ListDedicatedHostsResponse {
+	return pg.r
+}
+
+// ListDedicatedHostsAll fetches all pages of a ListDedicatedHosts call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *HostService) ListDedicatedHostsAll(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
+	return s.ListDedicatedHostsAllWithContext(context.Background(), p)
+}
+
+// ListDedicatedHostsAllWithContext is the same as ListDedicatedHostsAll, but uses ctx to cancel the requests
+func (s *HostService) ListDedicatedHostsAllWithContext(ctx context.Context, p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*DedicatedHost)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListDedicatedHostsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListDedicatedHostsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.DedicatedHosts
+		mu.Unlock()
+
+		return len(l.DedicatedHosts), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListDedicatedHostsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.DedicatedHosts = append(r.DedicatedHosts, pages[page]...)
+	}
+	r.Count = len(r.DedicatedHosts)
+
+	return r, nil
+}
+
 type ListDedicatedHostsResponse struct {
 	Count          int              `json:"count"`
 	DedicatedHosts []*DedicatedHost `json:"dedicatedhost"`
@@ -1417,11 +4006,254 @@ type DedicatedHost struct {
 	Jobstatus       int    `json:"jobstatus"`
 }
 
+type ListHostHAProvidersParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListHostHAProvidersParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["hypervisor"]; found {
+		u.Set("hypervisor", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListHostHAProvidersParams) Validate() error {
+	v := newParamValidator("listHostHAProviders", p.p)
+	v.required("hypervisor")
+	v.maxLength("hypervisor", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListHostHAProvidersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListHostHAProvidersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hypervisor":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listHostHAProviders", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listHostHAProviders: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListHostHAProvidersParams) SetHypervisor(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hypervisor"] = v
+}
+
+func (p *ListHostHAProvidersParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *ListHostHAProvidersParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListHostHAProvidersParams instance,
+// as then you are sure you have configured all required params
+func (s *HostService) NewListHostHAProvidersParams(hypervisor string) *ListHostHAProvidersParams {
+	p := &ListHostHAProvidersParams{}
+	p.p = make(map[string]interface{})
+	p.p["hypervisor"] = hypervisor
+	return p
+}
+
+// Lists HA providers
+func (s *HostService) ListHostHAProviders(p *ListHostHAProvidersParams) (*ListHostHAProvidersResponse, error) {
+	return s.ListHostHAProvidersWithContext(context.Background(), p)
+}
+
+// ListHostHAProvidersWithContext is the same as ListHostHAProviders, but uses ctx to cancel the request and any async job polling
+func (s *HostService) ListHostHAProvidersWithContext(ctx context.Context, p *ListHostHAProvidersParams) (*ListHostHAProvidersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listHostHAProviders", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListHostHAProvidersResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListHostHAProvidersResponse struct {
+	Count           int               `json:"count"`
+	HostHAProviders []*HostHAProvider `json:"hosthaprovider"`
+}
+
+type HostHAProvider struct {
+	Haenable   bool   `json:"haenable"`
+	Haprovider string `json:"haprovider"`
+	Hastate    string `json:"hastate"`
+	Hostid     string `json:"hostid"`
+	JobID      string `json:"jobid"`
+	Jobstatus  int    `json:"jobstatus"`
+	Status     bool   `json:"status"`
+}
+
+type ListHostHAResourcesParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListHostHAResourcesParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["hostid"]; found {
+		u.Set("hostid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListHostHAResourcesParams) Validate() error {
+	v := newParamValidator("listHostHAResources", p.p)
+	v.ids("hostid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListHostHAResourcesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListHostHAResourcesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listHostHAResources", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listHostHAResources: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListHostHAResourcesParams) SetHostid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostid"] = v
+}
+
+func (p *ListHostHAResourcesParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ListHostHAResourcesParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListHostHAResourcesParams instance,
+// as then you are sure you have configured all required params
+func (s *HostService) NewListHostHAResourcesParams() *ListHostHAResourcesParams {
+	p := &ListHostHAResourcesParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Lists host HA resources
+func (s *HostService) ListHostHAResources(p *ListHostHAResourcesParams) (*ListHostHAResourcesResponse, error) {
+	return s.ListHostHAResourcesWithContext(context.Background(), p)
+}
+
+// ListHostHAResourcesWithContext is the same as ListHostHAResources, but uses ctx to cancel the request and any async job polling
+func (s *HostService) ListHostHAResourcesWithContext(ctx context.Context, p *ListHostHAResourcesParams) (*ListHostHAResourcesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listHostHAResources", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListHostHAResourcesResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListHostHAResourcesResponse struct {
+	Count           int               `json:"count"`
+	HostHAResources []*HostHAResource `json:"hostharesource"`
+}
+
+type HostHAResource struct {
+	Haenable   bool   `json:"haenable"`
+	Haprovider string `json:"haprovider"`
+	Hastate    string `json:"hastate"`
+	Hostid     string `json:"hostid"`
+	JobID      string `json:"jobid"`
+	Jobstatus  int    `json:"jobstatus"`
+	Status     bool   `json:"status"`
+}
+
 type ListHostTagsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListHostTagsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListHostTagsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1440,6 +4272,51 @@ func (p *ListHostTagsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListHostTagsParams) Validate() error {
+	v := newParamValidator("listHostTags", p.p)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListHostTagsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListHostTagsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listHostTags", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listHostTags: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListHostTagsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1447,6 +4324,15 @@ func (p *ListHostTagsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListHostTagsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListHostTagsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListHostTagsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1454,6 +4340,15 @@ func (p *ListHostTagsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListHostTagsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListHostTagsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListHostTagsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1461,6 +4356,15 @@ func (p *ListHostTagsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListHostTagsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListHostTagsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListHostTagsParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewListHostTagsParams() *ListHostTagsParams {
@@ -1471,6 +4375,11 @@ func (s *HostService) NewListHostTagsParams() *ListHostTagsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostTagID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetHostTagIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetHostTagIDWithContext is the same as GetHostTagID, but uses ctx to cancel the request
+func (s *HostService) GetHostTagIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListHostTagsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1482,7 +4391,7 @@ func (s *HostService) GetHostTagID(keyword string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListHostTags(p)
+	l, err := s.ListHostTagsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1507,7 +4416,16 @@ func (s *HostService) GetHostTagID(keyword string, opts ...OptionFunc) (string,
 
 // Lists host tags
 func (s *HostService) ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error) {
-	resp, err := s.cs.newRequest("listHostTags", p.toURLValues())
+	return s.ListHostTagsWithContext(context.Background(), p)
+}
+
+// ListHostTagsWithContext is the same as ListHostTags, but uses ctx to cancel the request and any async job polling
+func (s *HostService) ListHostTagsWithContext(ctx context.Context, p *ListHostTagsParams) (*ListHostTagsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listHostTags", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1520,6 +4438,92 @@ func (s *HostService) ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse
 	return &r, nil
 }
 
+// ListHostTagsPager iterates over all pages of a ListHostTags call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListHostTagsPager struct {
+	pager
+
+	s *HostService
+	p *ListHostTagsParams
+	r *ListHostTagsResponse
+}
+
+// NewListHostTagsPager returns a pager for all pages of a ListHostTags call with the given params
+func (s *HostService) NewListHostTagsPager(p *ListHostTagsParams) *ListHostTagsPager {
+	return &ListHostTagsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListHostTagsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListHostTagsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListHostTagsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListHostTagsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.HostTags), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListHostTagsPager) Page() *ListHostTagsResponse {
+	return pg.r
+}
+
+// ListHostTagsAll fetches all pages of a ListHostTags call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *HostService) ListHostTagsAll(p *ListHostTagsParams) (*ListHostTagsResponse, error) {
+	return s.ListHostTagsAllWithContext(context.Background(), p)
+}
+
+// ListHostTagsAllWithContext is the same as ListHostTagsAll, but uses ctx to cancel the requests
+func (s *HostService) ListHostTagsAllWithContext(ctx context.Context, p *ListHostTagsParams) (*ListHostTagsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*HostTag)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListHostTagsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListHostTagsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.HostTags
+		mu.Unlock()
+
+		return len(l.HostTags), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListHostTagsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.HostTags = append(r.HostTags, pages[page]...)
+	}
+	r.Count = len(r.HostTags)
+
+	return r, nil
+}
+
 type ListHostTagsResponse struct {
 	Count    int        `json:"count"`
 	HostTags []*HostTag `json:"hosttag"`
@@ -1537,7 +4541,8 @@ type ListHostsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListHostsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListHostsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1601,6 +4606,66 @@ func (p *ListHostsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListHostsParams) Validate() error {
+	v := newParamValidator("listHosts", p.p)
+	v.ids("clusterid", "id", "podid", "virtualmachineid", "zoneid")
+	v.maxLength("hypervisor", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("outofbandmanagementpowerstate", 255)
+	v.maxLength("resourcestate", 255)
+	v.maxLength("state", 255)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListHostsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListHostsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid", "hypervisor", "id", "keyword", "name", "outofbandmanagementpowerstate", "podid", "resourcestate", "state", "type", "virtualmachineid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "hahost", "outofbandmanagementenabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listHosts", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listHosts: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListHostsParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1608,6 +4673,15 @@ func (p *ListHostsParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *ListHostsParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ListHostsParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetDetails(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1615,6 +4689,15 @@ func (p *ListHostsParams) SetDetails(v []string) {
 	p.p["details"] = v
 }
 
+func (p *ListHostsParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *ListHostsParams) GetDetails() ([]string, bool) {
+	value, ok := p.p["details"].([]string)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetHahost(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1622,6 +4705,15 @@ func (p *ListHostsParams) SetHahost(v bool) {
 	p.p["hahost"] = v
 }
 
+func (p *ListHostsParams) ResetHahost() {
+	delete(p.p, "hahost")
+}
+
+func (p *ListHostsParams) GetHahost() (bool, bool) {
+	value, ok := p.p["hahost"].(bool)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1629,6 +4721,15 @@ func (p *ListHostsParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *ListHostsParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *ListHostsParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1636,6 +4737,15 @@ func (p *ListHostsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListHostsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListHostsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1643,6 +4753,15 @@ func (p *ListHostsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListHostsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListHostsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1650,6 +4769,15 @@ func (p *ListHostsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListHostsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListHostsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetOutofbandmanagementenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1657,6 +4785,15 @@ func (p *ListHostsParams) SetOutofbandmanagementenabled(v bool) {
 	p.p["outofbandmanagementenabled"] = v
 }
 
+func (p *ListHostsParams) ResetOutofbandmanagementenabled() {
+	delete(p.p, "outofbandmanagementenabled")
+}
+
+func (p *ListHostsParams) GetOutofbandmanagementenabled() (bool, bool) {
+	value, ok := p.p["outofbandmanagementenabled"].(bool)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetOutofbandmanagementpowerstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1664,6 +4801,15 @@ func (p *ListHostsParams) SetOutofbandmanagementpowerstate(v string) {
 	p.p["outofbandmanagementpowerstate"] = v
 }
 
+func (p *ListHostsParams) ResetOutofbandmanagementpowerstate() {
+	delete(p.p, "outofbandmanagementpowerstate")
+}
+
+func (p *ListHostsParams) GetOutofbandmanagementpowerstate() (string, bool) {
+	value, ok := p.p["outofbandmanagementpowerstate"].(string)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1671,6 +4817,15 @@ func (p *ListHostsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListHostsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListHostsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1678,6 +4833,15 @@ func (p *ListHostsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListHostsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListHostsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1685,6 +4849,15 @@ func (p *ListHostsParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *ListHostsParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListHostsParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetResourcestate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1692,6 +4865,15 @@ func (p *ListHostsParams) SetResourcestate(v string) {
 	p.p["resourcestate"] = v
 }
 
+func (p *ListHostsParams) ResetResourcestate() {
+	delete(p.p, "resourcestate")
+}
+
+func (p *ListHostsParams) GetResourcestate() (string, bool) {
+	value, ok := p.p["resourcestate"].(string)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1699,6 +4881,15 @@ func (p *ListHostsParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListHostsParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListHostsParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1706,6 +4897,15 @@ func (p *ListHostsParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *ListHostsParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ListHostsParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1713,6 +4913,15 @@ func (p *ListHostsParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *ListHostsParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *ListHostsParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 func (p *ListHostsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1720,6 +4929,15 @@ func (p *ListHostsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListHostsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListHostsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListHostsParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewListHostsParams() *ListHostsParams {
@@ -1730,6 +4948,11 @@ func (s *HostService) NewListHostsParams() *ListHostsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetHostIDWithContext(context.Background(), name, opts...)
+}
+
+// GetHostIDWithContext is the same as GetHostID, but uses ctx to cancel the request
+func (s *HostService) GetHostIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListHostsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1741,7 +4964,7 @@ func (s *HostService) GetHostID(name string, opts ...OptionFunc) (string, int, e
 		}
 	}
 
-	l, err := s.ListHosts(p)
+	l, err := s.ListHostsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1766,12 +4989,17 @@ func (s *HostService) GetHostID(name string, opts ...OptionFunc) (string, int, e
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostByName(name string, opts ...OptionFunc) (*Host, int, error) {
-	id, count, err := s.GetHostID(name, opts...)
+	return s.GetHostByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetHostByNameWithContext is the same as GetHostByName, but uses ctx to cancel the requests
+func (s *HostService) GetHostByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Host, int, error) {
+	id, count, err := s.GetHostIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetHostByID(id, opts...)
+	r, count, err := s.GetHostByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1780,6 +5008,11 @@ func (s *HostService) GetHostByName(name string, opts ...OptionFunc) (*Host, int
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostByID(id string, opts ...OptionFunc) (*Host, int, error) {
+	return s.GetHostByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetHostByIDWithContext is the same as GetHostByID, but uses ctx to cancel the request
+func (s *HostService) GetHostByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Host, int, error) {
 	p := &ListHostsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1791,18 +5024,16 @@ func (s *HostService) GetHostByID(id string, opts ...OptionFunc) (*Host, int, er
 		}
 	}
 
-	l, err := s.ListHosts(p)
+	l, err := s.ListHostsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1813,7 +5044,16 @@ func (s *HostService) GetHostByID(id string, opts ...OptionFunc) (*Host, int, er
 
 // Lists hosts.
 func (s *HostService) ListHosts(p *ListHostsParams) (*ListHostsResponse, error) {
-	resp, err := s.cs.newRequest("listHosts", p.toURLValues())
+	return s.ListHostsWithContext(context.Background(), p)
+}
+
+// ListHostsWithContext is the same as ListHosts, but uses ctx to cancel the request and any async job polling
+func (s *HostService) ListHostsWithContext(ctx context.Context, p *ListHostsParams) (*ListHostsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listHosts", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1826,6 +5066,92 @@ func (s *HostService) ListHosts(p *ListHostsParams) (*ListHostsResponse, error)
 	return &r, nil
 }
 
+// ListHostsPager iterates over all pages of a ListHosts call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListHostsPager struct {
+	pager
+
+	s *HostService
+	p *ListHostsParams
+	r *ListHostsResponse
+}
+
+// NewListHostsPager returns a pager for all pages of a ListHosts call with the given params
+func (s *HostService) NewListHostsPager(p *ListHostsParams) *ListHostsPager {
+	return &ListHostsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListHostsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListHostsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListHostsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListHostsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Hosts), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListHostsPager) Page() *ListHostsResponse {
+	return pg.r
+}
+
+// ListHostsAll fetches all pages of a ListHosts call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *HostService) ListHostsAll(p *ListHostsParams) (*ListHostsResponse, error) {
+	return s.ListHostsAllWithContext(context.Background(), p)
+}
+
+// ListHostsAllWithContext is the same as ListHostsAll, but uses ctx to cancel the requests
+func (s *HostService) ListHostsAllWithContext(ctx context.Context, p *ListHostsParams) (*ListHostsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Host)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListHostsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListHostsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Hosts
+		mu.Unlock()
+
+		return len(l.Hosts), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListHostsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Hosts = append(r.Hosts, pages[page]...)
+	}
+	r.Count = len(r.Hosts)
+
+	return r, nil
+}
+
 type ListHostsResponse struct {
 	Count int     `json:"count"`
 	Hosts []*Host `json:"host"`
@@ -1914,7 +5240,8 @@ type ListHostsMetricsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListHostsMetricsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListHostsMetricsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1978,6 +5305,66 @@ func (p *ListHostsMetricsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListHostsMetricsParams) Validate() error {
+	v := newParamValidator("listHostsMetrics", p.p)
+	v.ids("clusterid", "id", "podid", "virtualmachineid", "zoneid")
+	v.maxLength("hypervisor", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("outofbandmanagementpowerstate", 255)
+	v.maxLength("resourcestate", 255)
+	v.maxLength("state", 255)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListHostsMetricsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListHostsMetricsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid", "hypervisor", "id", "keyword", "name", "outofbandmanagementpowerstate", "podid", "resourcestate", "state", "type", "virtualmachineid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "hahost", "outofbandmanagementenabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listHostsMetrics", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listHostsMetrics: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListHostsMetricsParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1985,6 +5372,15 @@ func (p *ListHostsMetricsParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ListHostsMetricsParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetDetails(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1992,6 +5388,15 @@ func (p *ListHostsMetricsParams) SetDetails(v []string) {
 	p.p["details"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *ListHostsMetricsParams) GetDetails() ([]string, bool) {
+	value, ok := p.p["details"].([]string)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetHahost(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1999,6 +5404,15 @@ func (p *ListHostsMetricsParams) SetHahost(v bool) {
 	p.p["hahost"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetHahost() {
+	delete(p.p, "hahost")
+}
+
+func (p *ListHostsMetricsParams) GetHahost() (bool, bool) {
+	value, ok := p.p["hahost"].(bool)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2006,6 +5420,15 @@ func (p *ListHostsMetricsParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *ListHostsMetricsParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2013,6 +5436,15 @@ func (p *ListHostsMetricsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListHostsMetricsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2020,6 +5452,15 @@ func (p *ListHostsMetricsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListHostsMetricsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2027,6 +5468,15 @@ func (p *ListHostsMetricsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListHostsMetricsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetOutofbandmanagementenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2034,6 +5484,15 @@ func (p *ListHostsMetricsParams) SetOutofbandmanagementenabled(v bool) {
 	p.p["outofbandmanagementenabled"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetOutofbandmanagementenabled() {
+	delete(p.p, "outofbandmanagementenabled")
+}
+
+func (p *ListHostsMetricsParams) GetOutofbandmanagementenabled() (bool, bool) {
+	value, ok := p.p["outofbandmanagementenabled"].(bool)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetOutofbandmanagementpowerstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2041,6 +5500,15 @@ func (p *ListHostsMetricsParams) SetOutofbandmanagementpowerstate(v string) {
 	p.p["outofbandmanagementpowerstate"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetOutofbandmanagementpowerstate() {
+	delete(p.p, "outofbandmanagementpowerstate")
+}
+
+func (p *ListHostsMetricsParams) GetOutofbandmanagementpowerstate() (string, bool) {
+	value, ok := p.p["outofbandmanagementpowerstate"].(string)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2048,6 +5516,15 @@ func (p *ListHostsMetricsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListHostsMetricsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2055,6 +5532,15 @@ func (p *ListHostsMetricsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListHostsMetricsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2062,6 +5548,15 @@ func (p *ListHostsMetricsParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListHostsMetricsParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetResourcestate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2069,6 +5564,15 @@ func (p *ListHostsMetricsParams) SetResourcestate(v string) {
 	p.p["resourcestate"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetResourcestate() {
+	delete(p.p, "resourcestate")
+}
+
+func (p *ListHostsMetricsParams) GetResourcestate() (string, bool) {
+	value, ok := p.p["resourcestate"].(string)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2076,6 +5580,15 @@ func (p *ListHostsMetricsParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListHostsMetricsParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2083,6 +5596,15 @@ func (p *ListHostsMetricsParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ListHostsMetricsParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2090,6 +5612,15 @@ func (p *ListHostsMetricsParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *ListHostsMetricsParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 func (p *ListHostsMetricsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2097,6 +5628,15 @@ func (p *ListHostsMetricsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListHostsMetricsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListHostsMetricsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListHostsMetricsParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewListHostsMetricsParams() *ListHostsMetricsParams {
@@ -2107,6 +5647,11 @@ func (s *HostService) NewListHostsMetricsParams() *ListHostsMetricsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostsMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetHostsMetricIDWithContext(context.Background(), name, opts...)
+}
+
+// GetHostsMetricIDWithContext is the same as GetHostsMetricID, but uses ctx to cancel the request
+func (s *HostService) GetHostsMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListHostsMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2118,7 +5663,7 @@ func (s *HostService) GetHostsMetricID(name string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListHostsMetrics(p)
+	l, err := s.ListHostsMetricsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2143,12 +5688,17 @@ func (s *HostService) GetHostsMetricID(name string, opts ...OptionFunc) (string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostsMetricByName(name string, opts ...OptionFunc) (*HostsMetric, int, error) {
-	id, count, err := s.GetHostsMetricID(name, opts...)
+	return s.GetHostsMetricByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetHostsMetricByNameWithContext is the same as GetHostsMetricByName, but uses ctx to cancel the requests
+func (s *HostService) GetHostsMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*HostsMetric, int, error) {
+	id, count, err := s.GetHostsMetricIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetHostsMetricByID(id, opts...)
+	r, count, err := s.GetHostsMetricByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2157,6 +5707,11 @@ func (s *HostService) GetHostsMetricByName(name string, opts ...OptionFunc) (*Ho
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostsMetricByID(id string, opts ...OptionFunc) (*HostsMetric, int, error) {
+	return s.GetHostsMetricByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetHostsMetricByIDWithContext is the same as GetHostsMetricByID, but uses ctx to cancel the request
+func (s *HostService) GetHostsMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*HostsMetric, int, error) {
 	p := &ListHostsMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2168,18 +5723,16 @@ func (s *HostService) GetHostsMetricByID(id string, opts ...OptionFunc) (*HostsM
 		}
 	}
 
-	l, err := s.ListHostsMetrics(p)
+	l, err := s.ListHostsMetricsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -2190,7 +5743,16 @@ func (s *HostService) GetHostsMetricByID(id string, opts ...OptionFunc) (*HostsM
 
 // Lists hosts metrics
 func (s *HostService) ListHostsMetrics(p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listHostsMetrics", p.toURLValues())
+	return s.ListHostsMetricsWithContext(context.Background(), p)
+}
+
+// ListHostsMetricsWithContext is the same as ListHostsMetrics, but uses ctx to cancel the request and any async job polling
+func (s *HostService) ListHostsMetricsWithContext(ctx context.Context, p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listHostsMetrics", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2203,6 +5765,92 @@ func (s *HostService) ListHostsMetrics(p *ListHostsMetricsParams) (*ListHostsMet
 	return &r, nil
 }
 
+// ListHostsMetricsPager iterates over all pages of a ListHostsMetrics call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListHostsMetricsPager struct {
+	pager
+
+	s *HostService
+	p *ListHostsMetricsParams
+	r *ListHostsMetricsResponse
+}
+
+// NewListHostsMetricsPager returns a pager for all pages of a ListHostsMetrics call with the given params
+func (s *HostService) NewListHostsMetricsPager(p *ListHostsMetricsParams) *ListHostsMetricsPager {
+	return &ListHostsMetricsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListHostsMetricsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListHostsMetricsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListHostsMetricsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListHostsMetricsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.HostsMetrics), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListHostsMetricsPager) Page() *ListHostsMetricsResponse {
+	return pg.r
+}
+
+// ListHostsMetricsAll fetches all pages of a ListHostsMetrics call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *HostService) ListHostsMetricsAll(p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error) {
+	return s.ListHostsMetricsAllWithContext(context.Background(), p)
+}
+
+// ListHostsMetricsAllWithContext is the same as ListHostsMetricsAll, but uses ctx to cancel the requests
+func (s *HostService) ListHostsMetricsAllWithContext(ctx context.Context, p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*HostsMetric)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListHostsMetricsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListHostsMetricsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.HostsMetrics
+		mu.Unlock()
+
+		return len(l.HostsMetrics), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListHostsMetricsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.HostsMetrics = append(r.HostsMetrics, pages[page]...)
+	}
+	r.Count = len(r.HostsMetrics)
+
+	return r, nil
+}
+
 type ListHostsMetricsResponse struct {
 	Count        int            `json:"count"`
 	HostsMetrics []*HostsMetric `json:"hostsmetric"`
@@ -2309,7 +5957,8 @@ type PrepareHostForMaintenanceParams struct {
 	p map[string]interface{}
 }
 
-func (p *PrepareHostForMaintenanceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *PrepareHostForMaintenanceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2320,6 +5969,48 @@ func (p *PrepareHostForMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *PrepareHostForMaintenanceParams) Validate() error {
+	v := newParamValidator("prepareHostForMaintenance", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *PrepareHostForMaintenanceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *PrepareHostForMaintenanceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for prepareHostForMaintenance", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of prepareHostForMaintenance: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *PrepareHostForMaintenanceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2327,6 +6018,15 @@ func (p *PrepareHostForMaintenanceParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *PrepareHostForMaintenanceParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *PrepareHostForMaintenanceParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new PrepareHostForMaintenanceParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewPrepareHostForMaintenanceParams(id string) *PrepareHostForMaintenanceParams {
@@ -2338,7 +6038,16 @@ func (s *HostService) NewPrepareHostForMaintenanceParams(id string) *PrepareHost
 
 // Prepares a host for maintenance.
 func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("prepareHostForMaintenance", p.toURLValues())
+	return s.PrepareHostForMaintenanceWithContext(context.Background(), p)
+}
+
+// PrepareHostForMaintenanceWithContext is the same as PrepareHostForMaintenance, but uses ctx to cancel the request and any async job polling
+func (s *HostService) PrepareHostForMaintenanceWithContext(ctx context.Context, p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "prepareHostForMaintenance", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2350,7 +6059,7 @@ func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenancePara
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2371,6 +6080,40 @@ func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenancePara
 	return &r, nil
 }
 
+// PrepareHostForMaintenanceAsync starts the async job for PrepareHostForMaintenance and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a PrepareHostForMaintenanceResponse using Job.Result
+func (s *HostService) PrepareHostForMaintenanceAsync(p *PrepareHostForMaintenanceParams) (*Job, error) {
+	return s.PrepareHostForMaintenanceAsyncWithContext(context.Background(), p)
+}
+
+// PrepareHostForMaintenanceAsyncWithContext is the same as PrepareHostForMaintenanceAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) PrepareHostForMaintenanceAsyncWithContext(ctx context.Context, p *PrepareHostForMaintenanceParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "prepareHostForMaintenance", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r PrepareHostForMaintenanceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type PrepareHostForMaintenanceResponse struct {
 	Annotation                       string                                      `json:"annotation"`
 	Capabilities                     string                                      `json:"capabilities"`
@@ -2454,7 +6197,8 @@ type ReconnectHostParams struct {
 	p map[string]interface{}
 }
 
-func (p *ReconnectHostParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ReconnectHostParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2465,6 +6209,48 @@ func (p *ReconnectHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ReconnectHostParams) Validate() error {
+	v := newParamValidator("reconnectHost", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ReconnectHostParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ReconnectHostParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for reconnectHost", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of reconnectHost: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ReconnectHostParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2472,6 +6258,15 @@ func (p *ReconnectHostParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ReconnectHostParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ReconnectHostParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ReconnectHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewReconnectHostParams(id string) *ReconnectHostParams {
@@ -2483,7 +6278,16 @@ func (s *HostService) NewReconnectHostParams(id string) *ReconnectHostParams {
 
 // Reconnects a host.
 func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error) {
-	resp, err := s.cs.newRequest("reconnectHost", p.toURLValues())
+	return s.ReconnectHostWithContext(context.Background(), p)
+}
+
+// ReconnectHostWithContext is the same as ReconnectHost, but uses ctx to cancel the request and any async job polling
+func (s *HostService) ReconnectHostWithContext(ctx context.Context, p *ReconnectHostParams) (*ReconnectHostResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "reconnectHost", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2495,7 +6299,7 @@ func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostRespo
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2516,6 +6320,40 @@ func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostRespo
 	return &r, nil
 }
 
+// ReconnectHostAsync starts the async job for ReconnectHost and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ReconnectHostResponse using Job.Result
+func (s *HostService) ReconnectHostAsync(p *ReconnectHostParams) (*Job, error) {
+	return s.ReconnectHostAsyncWithContext(context.Background(), p)
+}
+
+// ReconnectHostAsyncWithContext is the same as ReconnectHostAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) ReconnectHostAsyncWithContext(ctx context.Context, p *ReconnectHostParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "reconnectHost", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReconnectHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type ReconnectHostResponse struct {
 	Annotation                       string                          `json:"annotation"`
 	Capabilities                     string                          `json:"capabilities"`
@@ -2599,7 +6437,8 @@ type ReleaseDedicatedHostParams struct {
 	p map[string]interface{}
 }
 
-func (p *ReleaseDedicatedHostParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ReleaseDedicatedHostParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2610,6 +6449,48 @@ func (p *ReleaseDedicatedHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ReleaseDedicatedHostParams) Validate() error {
+	v := newParamValidator("releaseDedicatedHost", p.p)
+	v.required("hostid")
+	v.ids("hostid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ReleaseDedicatedHostParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ReleaseDedicatedHostParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for releaseDedicatedHost", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of releaseDedicatedHost: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ReleaseDedicatedHostParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2617,6 +6498,15 @@ func (p *ReleaseDedicatedHostParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *ReleaseDedicatedHostParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ReleaseDedicatedHostParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ReleaseDedicatedHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewReleaseDedicatedHostParams(hostid string) *ReleaseDedicatedHostParams {
@@ -2626,21 +6516,184 @@ func (s *HostService) NewReleaseDedicatedHostParams(hostid string) *ReleaseDedic
 	return p
 }
 
-// Release the dedication for host
-func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedHost", p.toURLValues())
+// Release the dedication for host
+func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
+	return s.ReleaseDedicatedHostWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedHostWithContext is the same as ReleaseDedicatedHost, but uses ctx to cancel the request and any async job polling
+func (s *HostService) ReleaseDedicatedHostWithContext(ctx context.Context, p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedHost", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleaseDedicatedHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// ReleaseDedicatedHostAsync starts the async job for ReleaseDedicatedHost and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ReleaseDedicatedHostResponse using Job.Result
+func (s *HostService) ReleaseDedicatedHostAsync(p *ReleaseDedicatedHostParams) (*Job, error) {
+	return s.ReleaseDedicatedHostAsyncWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedHostAsyncWithContext is the same as ReleaseDedicatedHostAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) ReleaseDedicatedHostAsyncWithContext(ctx context.Context, p *ReleaseDedicatedHostParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedHost", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleaseDedicatedHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type ReleaseDedicatedHostResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type ReleaseHostReservationParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ReleaseHostReservationParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ReleaseHostReservationParams) Validate() error {
+	v := newParamValidator("releaseHostReservation", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ReleaseHostReservationParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ReleaseHostReservationParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for releaseHostReservation", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of releaseHostReservation: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ReleaseHostReservationParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ReleaseHostReservationParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ReleaseHostReservationParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ReleaseHostReservationParams instance,
+// as then you are sure you have configured all required params
+func (s *HostService) NewReleaseHostReservationParams(id string) *ReleaseHostReservationParams {
+	p := &ReleaseHostReservationParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Releases host reservation.
+func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
+	return s.ReleaseHostReservationWithContext(context.Background(), p)
+}
+
+// ReleaseHostReservationWithContext is the same as ReleaseHostReservation, but uses ctx to cancel the request and any async job polling
+func (s *HostService) ReleaseHostReservationWithContext(ctx context.Context, p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseHostReservation", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ReleaseDedicatedHostResponse
+	var r ReleaseHostReservationResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2656,59 +6709,275 @@ func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*Rele
 	return &r, nil
 }
 
-type ReleaseDedicatedHostResponse struct {
+// ReleaseHostReservationAsync starts the async job for ReleaseHostReservation and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ReleaseHostReservationResponse using Job.Result
+func (s *HostService) ReleaseHostReservationAsync(p *ReleaseHostReservationParams) (*Job, error) {
+	return s.ReleaseHostReservationAsyncWithContext(context.Background(), p)
+}
+
+// ReleaseHostReservationAsyncWithContext is the same as ReleaseHostReservationAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) ReleaseHostReservationAsyncWithContext(ctx context.Context, p *ReleaseHostReservationParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseHostReservation", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleaseHostReservationResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type ReleaseHostReservationResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
 	Jobstatus   int    `json:"jobstatus"`
 	Success     bool   `json:"success"`
 }
 
-type ReleaseHostReservationParams struct {
+type StartRollingMaintenanceParams struct {
 	p map[string]interface{}
 }
 
-func (p *ReleaseHostReservationParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *StartRollingMaintenanceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v, found := p.p["clusterids"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("clusterids", vv)
+	}
+	if v, found := p.p["forced"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("forced", vv)
+	}
+	if v, found := p.p["hostids"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("hostids", vv)
+	}
+	if v, found := p.p["payload"]; found {
+		u.Set("payload", v.(string))
+	}
+	if v, found := p.p["podids"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("podids", vv)
+	}
+	if v, found := p.p["timeout"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("timeout", vv)
+	}
+	if v, found := p.p["zoneids"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("zoneids", vv)
 	}
 	return u
 }
 
-func (p *ReleaseHostReservationParams) SetId(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *StartRollingMaintenanceParams) Validate() error {
+	v := newParamValidator("startRollingMaintenance", p.p)
+	v.maxLength("payload", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *StartRollingMaintenanceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *StartRollingMaintenanceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterids", "hostids", "podids", "zoneids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "forced":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "payload":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "timeout":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for startRollingMaintenance", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of startRollingMaintenance: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *StartRollingMaintenanceParams) SetClusterids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["clusterids"] = v
 }
 
-// You should always use this function to get a new ReleaseHostReservationParams instance,
+func (p *StartRollingMaintenanceParams) ResetClusterids() {
+	delete(p.p, "clusterids")
+}
+
+func (p *StartRollingMaintenanceParams) GetClusterids() ([]string, bool) {
+	value, ok := p.p["clusterids"].([]string)
+	return value, ok
+}
+
+func (p *StartRollingMaintenanceParams) SetForced(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["forced"] = v
+}
+
+func (p *StartRollingMaintenanceParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *StartRollingMaintenanceParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
+func (p *StartRollingMaintenanceParams) SetHostids(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostids"] = v
+}
+
+func (p *StartRollingMaintenanceParams) ResetHostids() {
+	delete(p.p, "hostids")
+}
+
+func (p *StartRollingMaintenanceParams) GetHostids() ([]string, bool) {
+	value, ok := p.p["hostids"].([]string)
+	return value, ok
+}
+
+func (p *StartRollingMaintenanceParams) SetPayload(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["payload"] = v
+}
+
+func (p *StartRollingMaintenanceParams) ResetPayload() {
+	delete(p.p, "payload")
+}
+
+func (p *StartRollingMaintenanceParams) GetPayload() (string, bool) {
+	value, ok := p.p["payload"].(string)
+	return value, ok
+}
+
+func (p *StartRollingMaintenanceParams) SetPodids(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["podids"] = v
+}
+
+func (p *StartRollingMaintenanceParams) ResetPodids() {
+	delete(p.p, "podids")
+}
+
+func (p *StartRollingMaintenanceParams) GetPodids() ([]string, bool) {
+	value, ok := p.p["podids"].([]string)
+	return value, ok
+}
+
+func (p *StartRollingMaintenanceParams) SetTimeout(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["timeout"] = v
+}
+
+func (p *StartRollingMaintenanceParams) ResetTimeout() {
+	delete(p.p, "timeout")
+}
+
+func (p *StartRollingMaintenanceParams) GetTimeout() (int, bool) {
+	value, ok := p.p["timeout"].(int)
+	return value, ok
+}
+
+func (p *StartRollingMaintenanceParams) SetZoneids(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneids"] = v
+}
+
+func (p *StartRollingMaintenanceParams) ResetZoneids() {
+	delete(p.p, "zoneids")
+}
+
+func (p *StartRollingMaintenanceParams) GetZoneids() ([]string, bool) {
+	value, ok := p.p["zoneids"].([]string)
+	return value, ok
+}
+
+// You should always use this function to get a new StartRollingMaintenanceParams instance,
 // as then you are sure you have configured all required params
-func (s *HostService) NewReleaseHostReservationParams(id string) *ReleaseHostReservationParams {
-	p := &ReleaseHostReservationParams{}
+func (s *HostService) NewStartRollingMaintenanceParams() *StartRollingMaintenanceParams {
+	p := &StartRollingMaintenanceParams{}
 	p.p = make(map[string]interface{})
-	p.p["id"] = id
 	return p
 }
 
-// Releases host reservation.
-func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
-	resp, err := s.cs.newRequest("releaseHostReservation", p.toURLValues())
+// Start rolling maintenance
+func (s *HostService) StartRollingMaintenance(p *StartRollingMaintenanceParams) (*StartRollingMaintenanceResponse, error) {
+	return s.StartRollingMaintenanceWithContext(context.Background(), p)
+}
+
+// StartRollingMaintenanceWithContext is the same as StartRollingMaintenance, but uses ctx to cancel the request and any async job polling
+func (s *HostService) StartRollingMaintenanceWithContext(ctx context.Context, p *StartRollingMaintenanceParams) (*StartRollingMaintenanceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "startRollingMaintenance", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ReleaseHostReservationResponse
+	var r StartRollingMaintenanceResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2716,6 +6985,11 @@ func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*
 			return nil, err
 		}
 
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
 		if err := json.Unmarshal(b, &r); err != nil {
 			return nil, err
 		}
@@ -2724,18 +6998,69 @@ func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*
 	return &r, nil
 }
 
-type ReleaseHostReservationResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+// StartRollingMaintenanceAsync starts the async job for StartRollingMaintenance and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a StartRollingMaintenanceResponse using Job.Result
+func (s *HostService) StartRollingMaintenanceAsync(p *StartRollingMaintenanceParams) (*Job, error) {
+	return s.StartRollingMaintenanceAsyncWithContext(context.Background(), p)
+}
+
+// StartRollingMaintenanceAsyncWithContext is the same as StartRollingMaintenanceAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *HostService) StartRollingMaintenanceAsyncWithContext(ctx context.Context, p *StartRollingMaintenanceParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "startRollingMaintenance", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StartRollingMaintenanceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type StartRollingMaintenanceResponse struct {
+	Details      string                                        `json:"details"`
+	Hostsskipped []StartRollingMaintenanceResponseHostsskipped `json:"hostsskipped"`
+	Hostsupdated []StartRollingMaintenanceResponseHostsupdated `json:"hostsupdated"`
+	JobID        string                                        `json:"jobid"`
+	Jobstatus    int                                           `json:"jobstatus"`
+	Success      bool                                          `json:"success"`
+}
+
+type StartRollingMaintenanceResponseHostsupdated struct {
+	Enddate   string `json:"enddate"`
+	Hostid    string `json:"hostid"`
+	Hostname  string `json:"hostname"`
+	Output    string `json:"output"`
+	Startdate string `json:"startdate"`
+}
+
+type StartRollingMaintenanceResponseHostsskipped struct {
+	Hostid   string `json:"hostid"`
+	Hostname string `json:"hostname"`
+	Reason   string `json:"reason"`
 }
 
 type UpdateHostParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateHostParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateHostParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2765,6 +7090,56 @@ func (p *UpdateHostParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateHostParams) Validate() error {
+	v := newParamValidator("updateHost", p.p)
+	v.required("id")
+	v.ids("id", "oscategoryid")
+	v.maxLength("allocationstate", 255)
+	v.maxLength("annotation", 255)
+	v.maxLength("name", 255)
+	v.maxLength("url", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateHostParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateHostParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "allocationstate", "annotation", "id", "name", "oscategoryid", "url":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "hosttags":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateHost", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateHost: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateHostParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2772,6 +7147,15 @@ func (p *UpdateHostParams) SetAllocationstate(v string) {
 	p.p["allocationstate"] = v
 }
 
+func (p *UpdateHostParams) ResetAllocationstate() {
+	delete(p.p, "allocationstate")
+}
+
+func (p *UpdateHostParams) GetAllocationstate() (string, bool) {
+	value, ok := p.p["allocationstate"].(string)
+	return value, ok
+}
+
 func (p *UpdateHostParams) SetAnnotation(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2779,6 +7163,15 @@ func (p *UpdateHostParams) SetAnnotation(v string) {
 	p.p["annotation"] = v
 }
 
+func (p *UpdateHostParams) ResetAnnotation() {
+	delete(p.p, "annotation")
+}
+
+func (p *UpdateHostParams) GetAnnotation() (string, bool) {
+	value, ok := p.p["annotation"].(string)
+	return value, ok
+}
+
 func (p *UpdateHostParams) SetHosttags(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2786,6 +7179,15 @@ func (p *UpdateHostParams) SetHosttags(v []string) {
 	p.p["hosttags"] = v
 }
 
+func (p *UpdateHostParams) ResetHosttags() {
+	delete(p.p, "hosttags")
+}
+
+func (p *UpdateHostParams) GetHosttags() ([]string, bool) {
+	value, ok := p.p["hosttags"].([]string)
+	return value, ok
+}
+
 func (p *UpdateHostParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2793,6 +7195,15 @@ func (p *UpdateHostParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateHostParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateHostParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateHostParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2800,6 +7211,15 @@ func (p *UpdateHostParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateHostParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateHostParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateHostParams) SetOscategoryid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2807,6 +7227,15 @@ func (p *UpdateHostParams) SetOscategoryid(v string) {
 	p.p["oscategoryid"] = v
 }
 
+func (p *UpdateHostParams) ResetOscategoryid() {
+	delete(p.p, "oscategoryid")
+}
+
+func (p *UpdateHostParams) GetOscategoryid() (string, bool) {
+	value, ok := p.p["oscategoryid"].(string)
+	return value, ok
+}
+
 func (p *UpdateHostParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2814,6 +7243,15 @@ func (p *UpdateHostParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *UpdateHostParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *UpdateHostParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateHostParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewUpdateHostParams(id string) *UpdateHostParams {
@@ -2825,7 +7263,16 @@ func (s *HostService) NewUpdateHostParams(id string) *UpdateHostParams {
 
 // Updates a host.
 func (s *HostService) UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error) {
-	resp, err := s.cs.newRequest("updateHost", p.toURLValues())
+	return s.UpdateHostWithContext(context.Background(), p)
+}
+
+// UpdateHostWithContext is the same as UpdateHost, but uses ctx to cancel the request and any async job polling
+func (s *HostService) UpdateHostWithContext(ctx context.Context, p *UpdateHostParams) (*UpdateHostResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateHost", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2921,7 +7368,8 @@ type UpdateHostPasswordParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateHostPasswordParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateHostPasswordParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2945,6 +7393,54 @@ func (p *UpdateHostPasswordParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateHostPasswordParams) Validate() error {
+	v := newParamValidator("updateHostPassword", p.p)
+	v.required("password", "username")
+	v.ids("clusterid", "hostid")
+	v.maxLength("password", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateHostPasswordParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateHostPasswordParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid", "hostid", "password", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "update_passwd_on_host":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateHostPassword", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateHostPassword: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateHostPasswordParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2952,6 +7448,15 @@ func (p *UpdateHostPasswordParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *UpdateHostPasswordParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *UpdateHostPasswordParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *UpdateHostPasswordParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2959,6 +7464,15 @@ func (p *UpdateHostPasswordParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *UpdateHostPasswordParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *UpdateHostPasswordParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 func (p *UpdateHostPasswordParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2966,6 +7480,15 @@ func (p *UpdateHostPasswordParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *UpdateHostPasswordParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *UpdateHostPasswordParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *UpdateHostPasswordParams) SetUpdate_passwd_on_host(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2973,6 +7496,15 @@ func (p *UpdateHostPasswordParams) SetUpdate_passwd_on_host(v bool) {
 	p.p["update_passwd_on_host"] = v
 }
 
+func (p *UpdateHostPasswordParams) ResetUpdate_passwd_on_host() {
+	delete(p.p, "update_passwd_on_host")
+}
+
+func (p *UpdateHostPasswordParams) GetUpdate_passwd_on_host() (bool, bool) {
+	value, ok := p.p["update_passwd_on_host"].(bool)
+	return value, ok
+}
+
 func (p *UpdateHostPasswordParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2980,6 +7512,15 @@ func (p *UpdateHostPasswordParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *UpdateHostPasswordParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *UpdateHostPasswordParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateHostPasswordParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewUpdateHostPasswordParams(password string, username string) *UpdateHostPasswordParams {
@@ -2992,7 +7533,16 @@ func (s *HostService) NewUpdateHostPasswordParams(password string, username stri
 
 // Update password of a host/pool on management server.
 func (s *HostService) UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error) {
-	resp, err := s.cs.newRequest("updateHostPassword", p.toURLValues())
+	return s.UpdateHostPasswordWithContext(context.Background(), p)
+}
+
+// UpdateHostPasswordWithContext is the same as UpdateHostPassword, but uses ctx to cancel the request and any async job polling
+func (s *HostService) UpdateHostPasswordWithContext(ctx context.Context, p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateHostPassword", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
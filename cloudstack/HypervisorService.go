@@ -20,18 +20,39 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// HypervisorServiceIface is the interface implemented by HypervisorService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type HypervisorServiceIface interface {
+	NewListHypervisorCapabilitiesParams() *ListHypervisorCapabilitiesParams
+	GetHypervisorCapabilityByID(id string, opts ...OptionFunc) (*HypervisorCapability, int, error)
+	GetHypervisorCapabilityByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*HypervisorCapability, int, error)
+	ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error)
+	ListHypervisorCapabilitiesWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error)
+	NewListHypervisorCapabilitiesPager(p *ListHypervisorCapabilitiesParams) *ListHypervisorCapabilitiesPager
+	ListHypervisorCapabilitiesAll(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error)
+	ListHypervisorCapabilitiesAllWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error)
+	NewListHypervisorsParams() *ListHypervisorsParams
+	ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error)
+	ListHypervisorsWithContext(ctx context.Context, p *ListHypervisorsParams) (*ListHypervisorsResponse, error)
+	NewUpdateHypervisorCapabilitiesParams() *UpdateHypervisorCapabilitiesParams
+	UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error)
+	UpdateHypervisorCapabilitiesWithContext(ctx context.Context, p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error)
+}
+
 type ListHypervisorCapabilitiesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListHypervisorCapabilitiesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListHypervisorCapabilitiesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -56,6 +77,53 @@ func (p *ListHypervisorCapabilitiesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListHypervisorCapabilitiesParams) Validate() error {
+	v := newParamValidator("listHypervisorCapabilities", p.p)
+	v.ids("id")
+	v.maxLength("hypervisor", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListHypervisorCapabilitiesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListHypervisorCapabilitiesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hypervisor", "id", "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listHypervisorCapabilities", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listHypervisorCapabilities: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListHypervisorCapabilitiesParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -63,6 +131,15 @@ func (p *ListHypervisorCapabilitiesParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *ListHypervisorCapabilitiesParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *ListHypervisorCapabilitiesParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *ListHypervisorCapabilitiesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -70,6 +147,15 @@ func (p *ListHypervisorCapabilitiesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListHypervisorCapabilitiesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListHypervisorCapabilitiesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListHypervisorCapabilitiesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -77,6 +163,15 @@ func (p *ListHypervisorCapabilitiesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListHypervisorCapabilitiesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListHypervisorCapabilitiesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListHypervisorCapabilitiesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -84,6 +179,15 @@ func (p *ListHypervisorCapabilitiesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListHypervisorCapabilitiesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListHypervisorCapabilitiesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListHypervisorCapabilitiesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -91,6 +195,15 @@ func (p *ListHypervisorCapabilitiesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListHypervisorCapabilitiesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListHypervisorCapabilitiesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListHypervisorCapabilitiesParams instance,
 // as then you are sure you have configured all required params
 func (s *HypervisorService) NewListHypervisorCapabilitiesParams() *ListHypervisorCapabilitiesParams {
@@ -101,6 +214,11 @@ func (s *HypervisorService) NewListHypervisorCapabilitiesParams() *ListHyperviso
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HypervisorService) GetHypervisorCapabilityByID(id string, opts ...OptionFunc) (*HypervisorCapability, int, error) {
+	return s.GetHypervisorCapabilityByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetHypervisorCapabilityByIDWithContext is the same as GetHypervisorCapabilityByID, but uses ctx to cancel the request
+func (s *HypervisorService) GetHypervisorCapabilityByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*HypervisorCapability, int, error) {
 	p := &ListHypervisorCapabilitiesParams{}
 	p.p = make(map[string]interface{})
 
@@ -112,18 +230,16 @@ func (s *HypervisorService) GetHypervisorCapabilityByID(id string, opts ...Optio
 		}
 	}
 
-	l, err := s.ListHypervisorCapabilities(p)
+	l, err := s.ListHypervisorCapabilitiesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -134,7 +250,16 @@ func (s *HypervisorService) GetHypervisorCapabilityByID(id string, opts ...Optio
 
 // Lists all hypervisor capabilities.
 func (s *HypervisorService) ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("listHypervisorCapabilities", p.toURLValues())
+	return s.ListHypervisorCapabilitiesWithContext(context.Background(), p)
+}
+
+// ListHypervisorCapabilitiesWithContext is the same as ListHypervisorCapabilities, but uses ctx to cancel the request and any async job polling
+func (s *HypervisorService) ListHypervisorCapabilitiesWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listHypervisorCapabilities", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -147,6 +272,92 @@ func (s *HypervisorService) ListHypervisorCapabilities(p *ListHypervisorCapabili
 	return &r, nil
 }
 
+// ListHypervisorCapabilitiesPager iterates over all pages of a ListHypervisorCapabilities call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListHypervisorCapabilitiesPager struct {
+	pager
+
+	s *HypervisorService
+	p *ListHypervisorCapabilitiesParams
+	r *ListHypervisorCapabilitiesResponse
+}
+
+// NewListHypervisorCapabilitiesPager returns a pager for all pages of a ListHypervisorCapabilities call with the given params
+func (s *HypervisorService) NewListHypervisorCapabilitiesPager(p *ListHypervisorCapabilitiesParams) *ListHypervisorCapabilitiesPager {
+	return &ListHypervisorCapabilitiesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListHypervisorCapabilitiesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListHypervisorCapabilitiesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListHypervisorCapabilitiesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListHypervisorCapabilitiesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.HypervisorCapabilities), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListHypervisorCapabilitiesPager) Page() *ListHypervisorCapabilitiesResponse {
+	return pg.r
+}
+
+// ListHypervisorCapabilitiesAll fetches all pages of a ListHypervisorCapabilities call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *HypervisorService) ListHypervisorCapabilitiesAll(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
+	return s.ListHypervisorCapabilitiesAllWithContext(context.Background(), p)
+}
+
+// ListHypervisorCapabilitiesAllWithContext is the same as ListHypervisorCapabilitiesAll, but uses ctx to cancel the requests
+func (s *HypervisorService) ListHypervisorCapabilitiesAllWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*HypervisorCapability)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListHypervisorCapabilitiesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListHypervisorCapabilitiesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.HypervisorCapabilities
+		mu.Unlock()
+
+		return len(l.HypervisorCapabilities), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListHypervisorCapabilitiesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.HypervisorCapabilities = append(r.HypervisorCapabilities, pages[page]...)
+	}
+	r.Count = len(r.HypervisorCapabilities)
+
+	return r, nil
+}
+
 type ListHypervisorCapabilitiesResponse struct {
 	Count                  int                     `json:"count"`
 	HypervisorCapabilities []*HypervisorCapability `json:"hypervisorcapability"`
@@ -169,7 +380,8 @@ type ListHypervisorsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListHypervisorsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListHypervisorsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -180,6 +392,47 @@ func (p *ListHypervisorsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListHypervisorsParams) Validate() error {
+	v := newParamValidator("listHypervisors", p.p)
+	v.ids("zoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListHypervisorsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListHypervisorsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listHypervisors", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listHypervisors: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListHypervisorsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -187,6 +440,15 @@ func (p *ListHypervisorsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListHypervisorsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListHypervisorsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListHypervisorsParams instance,
 // as then you are sure you have configured all required params
 func (s *HypervisorService) NewListHypervisorsParams() *ListHypervisorsParams {
@@ -197,7 +459,16 @@ func (s *HypervisorService) NewListHypervisorsParams() *ListHypervisorsParams {
 
 // List hypervisors
 func (s *HypervisorService) ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
-	resp, err := s.cs.newRequest("listHypervisors", p.toURLValues())
+	return s.ListHypervisorsWithContext(context.Background(), p)
+}
+
+// ListHypervisorsWithContext is the same as ListHypervisors, but uses ctx to cancel the request and any async job polling
+func (s *HypervisorService) ListHypervisorsWithContext(ctx context.Context, p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listHypervisors", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -225,7 +496,8 @@ type UpdateHypervisorCapabilitiesParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateHypervisorCapabilitiesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateHypervisorCapabilitiesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -244,6 +516,55 @@ func (p *UpdateHypervisorCapabilitiesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateHypervisorCapabilitiesParams) Validate() error {
+	v := newParamValidator("updateHypervisorCapabilities", p.p)
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateHypervisorCapabilitiesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateHypervisorCapabilitiesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "maxguestslimit":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "securitygroupenabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateHypervisorCapabilities", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateHypervisorCapabilities: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateHypervisorCapabilitiesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -251,6 +572,15 @@ func (p *UpdateHypervisorCapabilitiesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateHypervisorCapabilitiesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateHypervisorCapabilitiesParams) SetMaxguestslimit(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -258,6 +588,15 @@ func (p *UpdateHypervisorCapabilitiesParams) SetMaxguestslimit(v int64) {
 	p.p["maxguestslimit"] = v
 }
 
+func (p *UpdateHypervisorCapabilitiesParams) ResetMaxguestslimit() {
+	delete(p.p, "maxguestslimit")
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) GetMaxguestslimit() (int64, bool) {
+	value, ok := p.p["maxguestslimit"].(int64)
+	return value, ok
+}
+
 func (p *UpdateHypervisorCapabilitiesParams) SetSecuritygroupenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -265,6 +604,15 @@ func (p *UpdateHypervisorCapabilitiesParams) SetSecuritygroupenabled(v bool) {
 	p.p["securitygroupenabled"] = v
 }
 
+func (p *UpdateHypervisorCapabilitiesParams) ResetSecuritygroupenabled() {
+	delete(p.p, "securitygroupenabled")
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) GetSecuritygroupenabled() (bool, bool) {
+	value, ok := p.p["securitygroupenabled"].(bool)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateHypervisorCapabilitiesParams instance,
 // as then you are sure you have configured all required params
 func (s *HypervisorService) NewUpdateHypervisorCapabilitiesParams() *UpdateHypervisorCapabilitiesParams {
@@ -275,7 +623,16 @@ func (s *HypervisorService) NewUpdateHypervisorCapabilitiesParams() *UpdateHyper
 
 // Updates a hypervisor capabilities.
 func (s *HypervisorService) UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("updateHypervisorCapabilities", p.toURLValues())
+	return s.UpdateHypervisorCapabilitiesWithContext(context.Background(), p)
+}
+
+// UpdateHypervisorCapabilitiesWithContext is the same as UpdateHypervisorCapabilities, but uses ctx to cancel the request and any async job polling
+func (s *HypervisorService) UpdateHypervisorCapabilitiesWithContext(ctx context.Context, p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateHypervisorCapabilities", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -20,18 +20,80 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// ISOServiceIface is the interface implemented by ISOService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type ISOServiceIface interface {
+	NewAttachIsoParams(id string, virtualmachineid string) *AttachIsoParams
+	AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error)
+	AttachIsoWithContext(ctx context.Context, p *AttachIsoParams) (*AttachIsoResponse, error)
+	AttachIsoAsync(p *AttachIsoParams) (*Job, error)
+	AttachIsoAsyncWithContext(ctx context.Context, p *AttachIsoParams) (*Job, error)
+	NewCopyIsoParams(id string) *CopyIsoParams
+	CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error)
+	CopyIsoWithContext(ctx context.Context, p *CopyIsoParams) (*CopyIsoResponse, error)
+	CopyIsoAsync(p *CopyIsoParams) (*Job, error)
+	CopyIsoAsyncWithContext(ctx context.Context, p *CopyIsoParams) (*Job, error)
+	NewDeleteIsoParams(id string) *DeleteIsoParams
+	DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error)
+	DeleteIsoWithContext(ctx context.Context, p *DeleteIsoParams) (*DeleteIsoResponse, error)
+	DeleteIsoAsync(p *DeleteIsoParams) (*Job, error)
+	DeleteIsoAsyncWithContext(ctx context.Context, p *DeleteIsoParams) (*Job, error)
+	NewDetachIsoParams(virtualmachineid string) *DetachIsoParams
+	DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error)
+	DetachIsoWithContext(ctx context.Context, p *DetachIsoParams) (*DetachIsoResponse, error)
+	DetachIsoAsync(p *DetachIsoParams) (*Job, error)
+	DetachIsoAsyncWithContext(ctx context.Context, p *DetachIsoParams) (*Job, error)
+	NewExtractIsoParams(id string, mode string) *ExtractIsoParams
+	ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error)
+	ExtractIsoWithContext(ctx context.Context, p *ExtractIsoParams) (*ExtractIsoResponse, error)
+	ExtractIsoAsync(p *ExtractIsoParams) (*Job, error)
+	ExtractIsoAsyncWithContext(ctx context.Context, p *ExtractIsoParams) (*Job, error)
+	NewGetUploadParamsForIsoParams(displaytext string, format string, name string, zoneid string) *GetUploadParamsForIsoParams
+	GetUploadParamsForIso(p *GetUploadParamsForIsoParams) (*GetUploadParamsForIsoResponse, error)
+	GetUploadParamsForIsoWithContext(ctx context.Context, p *GetUploadParamsForIsoParams) (*GetUploadParamsForIsoResponse, error)
+	NewListIsoPermissionsParams(id string) *ListIsoPermissionsParams
+	GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error)
+	GetIsoPermissionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*IsoPermission, int, error)
+	ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error)
+	ListIsoPermissionsWithContext(ctx context.Context, p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error)
+	NewListIsosParams() *ListIsosParams
+	GetIsoID(name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetIsoIDWithContext(ctx context.Context, name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetIsoByName(name string, isofilter string, zoneid string, opts ...OptionFunc) (*Iso, int, error)
+	GetIsoByNameWithContext(ctx context.Context, name string, isofilter string, zoneid string, opts ...OptionFunc) (*Iso, int, error)
+	GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error)
+	GetIsoByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Iso, int, error)
+	ListIsos(p *ListIsosParams) (*ListIsosResponse, error)
+	ListIsosWithContext(ctx context.Context, p *ListIsosParams) (*ListIsosResponse, error)
+	NewListIsosPager(p *ListIsosParams) *ListIsosPager
+	ListIsosAll(p *ListIsosParams) (*ListIsosResponse, error)
+	ListIsosAllWithContext(ctx context.Context, p *ListIsosParams) (*ListIsosResponse, error)
+	NewRegisterIsoParams(displaytext string, name string, url string, zoneid string) *RegisterIsoParams
+	RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, error)
+	RegisterIsoWithContext(ctx context.Context, p *RegisterIsoParams) (*RegisterIsoResponse, error)
+	NewUpdateIsoParams(id string) *UpdateIsoParams
+	UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error)
+	UpdateIsoWithContext(ctx context.Context, p *UpdateIsoParams) (*UpdateIsoResponse, error)
+	NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissionsParams
+	UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error)
+	UpdateIsoPermissionsWithContext(ctx context.Context, p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error)
+}
+
 type AttachIsoParams struct {
 	p map[string]interface{}
 }
 
-func (p *AttachIsoParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AttachIsoParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -49,6 +111,52 @@ func (p *AttachIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AttachIsoParams) Validate() error {
+	v := newParamValidator("attachIso", p.p)
+	v.required("id", "virtualmachineid")
+	v.ids("id", "virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AttachIsoParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AttachIsoParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "forced":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for attachIso", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of attachIso: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AttachIsoParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -56,6 +164,15 @@ func (p *AttachIsoParams) SetForced(v bool) {
 	p.p["forced"] = v
 }
 
+func (p *AttachIsoParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *AttachIsoParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
 func (p *AttachIsoParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -63,6 +180,15 @@ func (p *AttachIsoParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *AttachIsoParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *AttachIsoParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *AttachIsoParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -70,6 +196,15 @@ func (p *AttachIsoParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *AttachIsoParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *AttachIsoParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AttachIsoParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewAttachIsoParams(id string, virtualmachineid string) *AttachIsoParams {
@@ -82,7 +217,16 @@ func (s *ISOService) NewAttachIsoParams(id string, virtualmachineid string) *Att
 
 // Attaches an ISO to a virtual machine.
 func (s *ISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
-	resp, err := s.cs.newRequest("attachIso", p.toURLValues())
+	return s.AttachIsoWithContext(context.Background(), p)
+}
+
+// AttachIsoWithContext is the same as AttachIso, but uses ctx to cancel the request and any async job polling
+func (s *ISOService) AttachIsoWithContext(ctx context.Context, p *AttachIsoParams) (*AttachIsoResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "attachIso", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +238,7 @@ func (s *ISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -115,6 +259,40 @@ func (s *ISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
 	return &r, nil
 }
 
+// AttachIsoAsync starts the async job for AttachIso and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AttachIsoResponse using Job.Result
+func (s *ISOService) AttachIsoAsync(p *AttachIsoParams) (*Job, error) {
+	return s.AttachIsoAsyncWithContext(context.Background(), p)
+}
+
+// AttachIsoAsyncWithContext is the same as AttachIsoAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ISOService) AttachIsoAsyncWithContext(ctx context.Context, p *AttachIsoParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "attachIso", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AttachIsoResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AttachIsoResponse struct {
 	Account               string                           `json:"account"`
 	Affinitygroup         []AttachIsoResponseAffinitygroup `json:"affinitygroup"`
@@ -262,7 +440,8 @@ type CopyIsoParams struct {
 	p map[string]interface{}
 }
 
-func (p *CopyIsoParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CopyIsoParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -283,6 +462,52 @@ func (p *CopyIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CopyIsoParams) Validate() error {
+	v := newParamValidator("copyIso", p.p)
+	v.required("id")
+	v.ids("destzoneid", "id", "sourcezoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CopyIsoParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CopyIsoParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "destzoneid", "id", "sourcezoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "destzoneids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for copyIso", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of copyIso: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CopyIsoParams) SetDestzoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -290,6 +515,15 @@ func (p *CopyIsoParams) SetDestzoneid(v string) {
 	p.p["destzoneid"] = v
 }
 
+func (p *CopyIsoParams) ResetDestzoneid() {
+	delete(p.p, "destzoneid")
+}
+
+func (p *CopyIsoParams) GetDestzoneid() (string, bool) {
+	value, ok := p.p["destzoneid"].(string)
+	return value, ok
+}
+
 func (p *CopyIsoParams) SetDestzoneids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -297,6 +531,15 @@ func (p *CopyIsoParams) SetDestzoneids(v []string) {
 	p.p["destzoneids"] = v
 }
 
+func (p *CopyIsoParams) ResetDestzoneids() {
+	delete(p.p, "destzoneids")
+}
+
+func (p *CopyIsoParams) GetDestzoneids() ([]string, bool) {
+	value, ok := p.p["destzoneids"].([]string)
+	return value, ok
+}
+
 func (p *CopyIsoParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -304,6 +547,15 @@ func (p *CopyIsoParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *CopyIsoParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *CopyIsoParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *CopyIsoParams) SetSourcezoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -311,6 +563,15 @@ func (p *CopyIsoParams) SetSourcezoneid(v string) {
 	p.p["sourcezoneid"] = v
 }
 
+func (p *CopyIsoParams) ResetSourcezoneid() {
+	delete(p.p, "sourcezoneid")
+}
+
+func (p *CopyIsoParams) GetSourcezoneid() (string, bool) {
+	value, ok := p.p["sourcezoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CopyIsoParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewCopyIsoParams(id string) *CopyIsoParams {
@@ -322,7 +583,16 @@ func (s *ISOService) NewCopyIsoParams(id string) *CopyIsoParams {
 
 // Copies an iso from one zone to another.
 func (s *ISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
-	resp, err := s.cs.newRequest("copyIso", p.toURLValues())
+	return s.CopyIsoWithContext(context.Background(), p)
+}
+
+// CopyIsoWithContext is the same as CopyIso, but uses ctx to cancel the request and any async job polling
+func (s *ISOService) CopyIsoWithContext(ctx context.Context, p *CopyIsoParams) (*CopyIsoResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "copyIso", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -334,7 +604,7 @@ func (s *ISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -355,6 +625,40 @@ func (s *ISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
 	return &r, nil
 }
 
+// CopyIsoAsync starts the async job for CopyIso and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CopyIsoResponse using Job.Result
+func (s *ISOService) CopyIsoAsync(p *CopyIsoParams) (*Job, error) {
+	return s.CopyIsoAsyncWithContext(context.Background(), p)
+}
+
+// CopyIsoAsyncWithContext is the same as CopyIsoAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ISOService) CopyIsoAsyncWithContext(ctx context.Context, p *CopyIsoParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "copyIso", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CopyIsoResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CopyIsoResponse struct {
 	Account               string            `json:"account"`
 	Accountid             string            `json:"accountid"`
@@ -437,7 +741,8 @@ type DeleteIsoParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteIsoParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteIsoParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -451,6 +756,48 @@ func (p *DeleteIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteIsoParams) Validate() error {
+	v := newParamValidator("deleteIso", p.p)
+	v.required("id")
+	v.ids("id", "zoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteIsoParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteIsoParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteIso", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteIso: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteIsoParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -458,6 +805,15 @@ func (p *DeleteIsoParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteIsoParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteIsoParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *DeleteIsoParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -465,6 +821,15 @@ func (p *DeleteIsoParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *DeleteIsoParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *DeleteIsoParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteIsoParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewDeleteIsoParams(id string) *DeleteIsoParams {
@@ -476,7 +841,16 @@ func (s *ISOService) NewDeleteIsoParams(id string) *DeleteIsoParams {
 
 // Deletes an ISO file.
 func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
-	resp, err := s.cs.newRequest("deleteIso", p.toURLValues())
+	return s.DeleteIsoWithContext(context.Background(), p)
+}
+
+// DeleteIsoWithContext is the same as DeleteIso, but uses ctx to cancel the request and any async job polling
+func (s *ISOService) DeleteIsoWithContext(ctx context.Context, p *DeleteIsoParams) (*DeleteIsoResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteIso", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -488,7 +862,7 @@ func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -504,6 +878,31 @@ func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
 	return &r, nil
 }
 
+// DeleteIsoAsync starts the async job for DeleteIso and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteIsoResponse using Job.Result
+func (s *ISOService) DeleteIsoAsync(p *DeleteIsoParams) (*Job, error) {
+	return s.DeleteIsoAsyncWithContext(context.Background(), p)
+}
+
+// DeleteIsoAsyncWithContext is the same as DeleteIsoAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ISOService) DeleteIsoAsyncWithContext(ctx context.Context, p *DeleteIsoParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteIso", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteIsoResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteIsoResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -515,7 +914,8 @@ type DetachIsoParams struct {
 	p map[string]interface{}
 }
 
-func (p *DetachIsoParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DetachIsoParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -530,6 +930,52 @@ func (p *DetachIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DetachIsoParams) Validate() error {
+	v := newParamValidator("detachIso", p.p)
+	v.required("virtualmachineid")
+	v.ids("virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DetachIsoParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DetachIsoParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "forced":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for detachIso", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of detachIso: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DetachIsoParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -537,6 +983,15 @@ func (p *DetachIsoParams) SetForced(v bool) {
 	p.p["forced"] = v
 }
 
+func (p *DetachIsoParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *DetachIsoParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
 func (p *DetachIsoParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -544,6 +999,15 @@ func (p *DetachIsoParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *DetachIsoParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *DetachIsoParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DetachIsoParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewDetachIsoParams(virtualmachineid string) *DetachIsoParams {
@@ -555,7 +1019,16 @@ func (s *ISOService) NewDetachIsoParams(virtualmachineid string) *DetachIsoParam
 
 // Detaches any ISO file (if any) currently attached to a virtual machine.
 func (s *ISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
-	resp, err := s.cs.newRequest("detachIso", p.toURLValues())
+	return s.DetachIsoWithContext(context.Background(), p)
+}
+
+// DetachIsoWithContext is the same as DetachIso, but uses ctx to cancel the request and any async job polling
+func (s *ISOService) DetachIsoWithContext(ctx context.Context, p *DetachIsoParams) (*DetachIsoResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "detachIso", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -567,7 +1040,7 @@ func (s *ISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -588,6 +1061,40 @@ func (s *ISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
 	return &r, nil
 }
 
+// DetachIsoAsync starts the async job for DetachIso and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DetachIsoResponse using Job.Result
+func (s *ISOService) DetachIsoAsync(p *DetachIsoParams) (*Job, error) {
+	return s.DetachIsoAsyncWithContext(context.Background(), p)
+}
+
+// DetachIsoAsyncWithContext is the same as DetachIsoAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ISOService) DetachIsoAsyncWithContext(ctx context.Context, p *DetachIsoParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "detachIso", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DetachIsoResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type DetachIsoResponse struct {
 	Account               string                           `json:"account"`
 	Affinitygroup         []DetachIsoResponseAffinitygroup `json:"affinitygroup"`
@@ -735,7 +1242,8 @@ type ExtractIsoParams struct {
 	p map[string]interface{}
 }
 
-func (p *ExtractIsoParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ExtractIsoParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -755,6 +1263,50 @@ func (p *ExtractIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ExtractIsoParams) Validate() error {
+	v := newParamValidator("extractIso", p.p)
+	v.required("id", "mode")
+	v.ids("id", "zoneid")
+	v.maxLength("mode", 255)
+	v.maxLength("url", 2048)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ExtractIsoParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ExtractIsoParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "mode", "url", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for extractIso", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of extractIso: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ExtractIsoParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -762,6 +1314,15 @@ func (p *ExtractIsoParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ExtractIsoParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ExtractIsoParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ExtractIsoParams) SetMode(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -769,6 +1330,15 @@ func (p *ExtractIsoParams) SetMode(v string) {
 	p.p["mode"] = v
 }
 
+func (p *ExtractIsoParams) ResetMode() {
+	delete(p.p, "mode")
+}
+
+func (p *ExtractIsoParams) GetMode() (string, bool) {
+	value, ok := p.p["mode"].(string)
+	return value, ok
+}
+
 func (p *ExtractIsoParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -776,6 +1346,15 @@ func (p *ExtractIsoParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *ExtractIsoParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *ExtractIsoParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *ExtractIsoParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -783,6 +1362,15 @@ func (p *ExtractIsoParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ExtractIsoParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ExtractIsoParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ExtractIsoParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewExtractIsoParams(id string, mode string) *ExtractIsoParams {
@@ -795,7 +1383,16 @@ func (s *ISOService) NewExtractIsoParams(id string, mode string) *ExtractIsoPara
 
 // Extracts an ISO
 func (s *ISOService) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error) {
-	resp, err := s.cs.newRequest("extractIso", p.toURLValues())
+	return s.ExtractIsoWithContext(context.Background(), p)
+}
+
+// ExtractIsoWithContext is the same as ExtractIso, but uses ctx to cancel the request and any async job polling
+func (s *ISOService) ExtractIsoWithContext(ctx context.Context, p *ExtractIsoParams) (*ExtractIsoResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "extractIso", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -807,7 +1404,7 @@ func (s *ISOService) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -828,18 +1425,52 @@ func (s *ISOService) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error
 	return &r, nil
 }
 
-type ExtractIsoResponse struct {
-	Accountid        string `json:"accountid"`
-	Created          string `json:"created"`
-	ExtractId        string `json:"extractId"`
-	ExtractMode      string `json:"extractMode"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Name             string `json:"name"`
-	Resultstring     string `json:"resultstring"`
-	State            string `json:"state"`
-	Status           string `json:"status"`
+// ExtractIsoAsync starts the async job for ExtractIso and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ExtractIsoResponse using Job.Result
+func (s *ISOService) ExtractIsoAsync(p *ExtractIsoParams) (*Job, error) {
+	return s.ExtractIsoAsyncWithContext(context.Background(), p)
+}
+
+// ExtractIsoAsyncWithContext is the same as ExtractIsoAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ISOService) ExtractIsoAsyncWithContext(ctx context.Context, p *ExtractIsoParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "extractIso", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ExtractIsoResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type ExtractIsoResponse struct {
+	Accountid        string `json:"accountid"`
+	Created          string `json:"created"`
+	ExtractId        string `json:"extractId"`
+	ExtractMode      string `json:"extractMode"`
+	Id               string `json:"id"`
+	JobID            string `json:"jobid"`
+	Jobstatus        int    `json:"jobstatus"`
+	Name             string `json:"name"`
+	Resultstring     string `json:"resultstring"`
+	State            string `json:"state"`
+	Status           string `json:"status"`
 	Storagetype      string `json:"storagetype"`
 	Uploadpercentage int    `json:"uploadpercentage"`
 	Url              string `json:"url"`
@@ -847,11 +1478,373 @@ type ExtractIsoResponse struct {
 	Zonename         string `json:"zonename"`
 }
 
+type GetUploadParamsForIsoParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetUploadParamsForIsoParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["bootable"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("bootable", vv)
+	}
+	if v, found := p.p["checksum"]; found {
+		u.Set("checksum", v.(string))
+	}
+	if v, found := p.p["displaytext"]; found {
+		u.Set("displaytext", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["format"]; found {
+		u.Set("format", v.(string))
+	}
+	if v, found := p.p["isextractable"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isextractable", vv)
+	}
+	if v, found := p.p["isfeatured"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isfeatured", vv)
+	}
+	if v, found := p.p["ispublic"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("ispublic", vv)
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["ostypeid"]; found {
+		u.Set("ostypeid", v.(string))
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetUploadParamsForIsoParams) Validate() error {
+	v := newParamValidator("getUploadParamsForIso", p.p)
+	v.required("displaytext", "format", "name", "zoneid")
+	v.ids("domainid", "ostypeid", "projectid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("checksum", 255)
+	v.maxLength("displaytext", 4096)
+	v.maxLength("format", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetUploadParamsForIsoParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetUploadParamsForIsoParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "checksum", "displaytext", "domainid", "format", "name", "ostypeid", "projectid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "bootable", "isextractable", "isfeatured", "ispublic":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getUploadParamsForIso", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getUploadParamsForIso: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *GetUploadParamsForIsoParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *GetUploadParamsForIsoParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *GetUploadParamsForIsoParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForIsoParams) SetBootable(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["bootable"] = v
+}
+
+func (p *GetUploadParamsForIsoParams) ResetBootable() {
+	delete(p.p, "bootable")
+}
+
+func (p *GetUploadParamsForIsoParams) GetBootable() (bool, bool) {
+	value, ok := p.p["bootable"].(bool)
+	return value, ok
+}
+
+func (p *GetUploadParamsForIsoParams) SetChecksum(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["checksum"] = v
+}
+
+func (p *GetUploadParamsForIsoParams) ResetChecksum() {
+	delete(p.p, "checksum")
+}
+
+func (p *GetUploadParamsForIsoParams) GetChecksum() (string, bool) {
+	value, ok := p.p["checksum"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForIsoParams) SetDisplaytext(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["displaytext"] = v
+}
+
+func (p *GetUploadParamsForIsoParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *GetUploadParamsForIsoParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForIsoParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *GetUploadParamsForIsoParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *GetUploadParamsForIsoParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForIsoParams) SetFormat(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["format"] = v
+}
+
+func (p *GetUploadParamsForIsoParams) ResetFormat() {
+	delete(p.p, "format")
+}
+
+func (p *GetUploadParamsForIsoParams) GetFormat() (string, bool) {
+	value, ok := p.p["format"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForIsoParams) SetIsextractable(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isextractable"] = v
+}
+
+func (p *GetUploadParamsForIsoParams) ResetIsextractable() {
+	delete(p.p, "isextractable")
+}
+
+func (p *GetUploadParamsForIsoParams) GetIsextractable() (bool, bool) {
+	value, ok := p.p["isextractable"].(bool)
+	return value, ok
+}
+
+func (p *GetUploadParamsForIsoParams) SetIsfeatured(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isfeatured"] = v
+}
+
+func (p *GetUploadParamsForIsoParams) ResetIsfeatured() {
+	delete(p.p, "isfeatured")
+}
+
+func (p *GetUploadParamsForIsoParams) GetIsfeatured() (bool, bool) {
+	value, ok := p.p["isfeatured"].(bool)
+	return value, ok
+}
+
+func (p *GetUploadParamsForIsoParams) SetIspublic(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ispublic"] = v
+}
+
+func (p *GetUploadParamsForIsoParams) ResetIspublic() {
+	delete(p.p, "ispublic")
+}
+
+func (p *GetUploadParamsForIsoParams) GetIspublic() (bool, bool) {
+	value, ok := p.p["ispublic"].(bool)
+	return value, ok
+}
+
+func (p *GetUploadParamsForIsoParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *GetUploadParamsForIsoParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *GetUploadParamsForIsoParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForIsoParams) SetOstypeid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ostypeid"] = v
+}
+
+func (p *GetUploadParamsForIsoParams) ResetOstypeid() {
+	delete(p.p, "ostypeid")
+}
+
+func (p *GetUploadParamsForIsoParams) GetOstypeid() (string, bool) {
+	value, ok := p.p["ostypeid"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForIsoParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *GetUploadParamsForIsoParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *GetUploadParamsForIsoParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForIsoParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *GetUploadParamsForIsoParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *GetUploadParamsForIsoParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new GetUploadParamsForIsoParams instance,
+// as then you are sure you have configured all required params
+func (s *ISOService) NewGetUploadParamsForIsoParams(displaytext string, format string, name string, zoneid string) *GetUploadParamsForIsoParams {
+	p := &GetUploadParamsForIsoParams{}
+	p.p = make(map[string]interface{})
+	p.p["displaytext"] = displaytext
+	p.p["format"] = format
+	p.p["name"] = name
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// upload an existing ISO into the CloudStack cloud.
+func (s *ISOService) GetUploadParamsForIso(p *GetUploadParamsForIsoParams) (*GetUploadParamsForIsoResponse, error) {
+	return s.GetUploadParamsForIsoWithContext(context.Background(), p)
+}
+
+// GetUploadParamsForIsoWithContext is the same as GetUploadParamsForIso, but uses ctx to cancel the request and any async job polling
+func (s *ISOService) GetUploadParamsForIsoWithContext(ctx context.Context, p *GetUploadParamsForIsoParams) (*GetUploadParamsForIsoResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getUploadParamsForIso", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r GetUploadParamsForIsoResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type GetUploadParamsForIsoResponse struct {
+	Expires   string `json:"expires"`
+	Id        string `json:"id"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Metadata  string `json:"metadata"`
+	PostURL   string `json:"postURL"`
+	Signature string `json:"signature"`
+}
+
 type ListIsoPermissionsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListIsoPermissionsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListIsoPermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -862,6 +1855,48 @@ func (p *ListIsoPermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListIsoPermissionsParams) Validate() error {
+	v := newParamValidator("listIsoPermissions", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListIsoPermissionsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListIsoPermissionsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listIsoPermissions", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listIsoPermissions: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListIsoPermissionsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -869,6 +1904,15 @@ func (p *ListIsoPermissionsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListIsoPermissionsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListIsoPermissionsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListIsoPermissionsParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewListIsoPermissionsParams(id string) *ListIsoPermissionsParams {
@@ -880,6 +1924,11 @@ func (s *ISOService) NewListIsoPermissionsParams(id string) *ListIsoPermissionsP
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error) {
+	return s.GetIsoPermissionByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetIsoPermissionByIDWithContext is the same as GetIsoPermissionByID, but uses ctx to cancel the request
+func (s *ISOService) GetIsoPermissionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*IsoPermission, int, error) {
 	p := &ListIsoPermissionsParams{}
 	p.p = make(map[string]interface{})
 
@@ -891,18 +1940,16 @@ func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPe
 		}
 	}
 
-	l, err := s.ListIsoPermissions(p)
+	l, err := s.ListIsoPermissionsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -913,7 +1960,16 @@ func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPe
 
 // List iso visibility and all accounts that have permissions to view this iso.
 func (s *ISOService) ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listIsoPermissions", p.toURLValues())
+	return s.ListIsoPermissionsWithContext(context.Background(), p)
+}
+
+// ListIsoPermissionsWithContext is the same as ListIsoPermissions, but uses ctx to cancel the request and any async job polling
+func (s *ISOService) ListIsoPermissionsWithContext(ctx context.Context, p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listIsoPermissions", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -945,7 +2001,8 @@ type ListIsosParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListIsosParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListIsosParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1023,6 +2080,65 @@ func (p *ListIsosParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListIsosParams) Validate() error {
+	v := newParamValidator("listIsos", p.p)
+	v.ids("domainid", "id", "projectid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("hypervisor", 255)
+	v.maxLength("isofilter", 255)
+	v.oneOf("isofilter", "featured", "self", "selfexecutable", "sharedexecutable", "executable", "community", "all")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListIsosParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListIsosParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "hypervisor", "id", "isofilter", "keyword", "name", "projectid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "bootable", "ispublic", "isready", "isrecursive", "listall", "showremoved", "showunique":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listIsos", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listIsos: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListIsosParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1030,6 +2146,15 @@ func (p *ListIsosParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListIsosParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListIsosParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetBootable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1037,6 +2162,15 @@ func (p *ListIsosParams) SetBootable(v bool) {
 	p.p["bootable"] = v
 }
 
+func (p *ListIsosParams) ResetBootable() {
+	delete(p.p, "bootable")
+}
+
+func (p *ListIsosParams) GetBootable() (bool, bool) {
+	value, ok := p.p["bootable"].(bool)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1044,6 +2178,15 @@ func (p *ListIsosParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListIsosParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListIsosParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1051,6 +2194,15 @@ func (p *ListIsosParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *ListIsosParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *ListIsosParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1058,6 +2210,15 @@ func (p *ListIsosParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListIsosParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListIsosParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetIsofilter(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1065,6 +2226,15 @@ func (p *ListIsosParams) SetIsofilter(v string) {
 	p.p["isofilter"] = v
 }
 
+func (p *ListIsosParams) ResetIsofilter() {
+	delete(p.p, "isofilter")
+}
+
+func (p *ListIsosParams) GetIsofilter() (string, bool) {
+	value, ok := p.p["isofilter"].(string)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1072,6 +2242,15 @@ func (p *ListIsosParams) SetIspublic(v bool) {
 	p.p["ispublic"] = v
 }
 
+func (p *ListIsosParams) ResetIspublic() {
+	delete(p.p, "ispublic")
+}
+
+func (p *ListIsosParams) GetIspublic() (bool, bool) {
+	value, ok := p.p["ispublic"].(bool)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetIsready(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1079,6 +2258,15 @@ func (p *ListIsosParams) SetIsready(v bool) {
 	p.p["isready"] = v
 }
 
+func (p *ListIsosParams) ResetIsready() {
+	delete(p.p, "isready")
+}
+
+func (p *ListIsosParams) GetIsready() (bool, bool) {
+	value, ok := p.p["isready"].(bool)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1086,6 +2274,15 @@ func (p *ListIsosParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListIsosParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListIsosParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1093,6 +2290,15 @@ func (p *ListIsosParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListIsosParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListIsosParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1100,6 +2306,15 @@ func (p *ListIsosParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListIsosParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListIsosParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1107,6 +2322,15 @@ func (p *ListIsosParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListIsosParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListIsosParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1114,6 +2338,15 @@ func (p *ListIsosParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListIsosParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListIsosParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1121,6 +2354,15 @@ func (p *ListIsosParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListIsosParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListIsosParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1128,6 +2370,15 @@ func (p *ListIsosParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListIsosParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListIsosParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetShowremoved(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1135,6 +2386,15 @@ func (p *ListIsosParams) SetShowremoved(v bool) {
 	p.p["showremoved"] = v
 }
 
+func (p *ListIsosParams) ResetShowremoved() {
+	delete(p.p, "showremoved")
+}
+
+func (p *ListIsosParams) GetShowremoved() (bool, bool) {
+	value, ok := p.p["showremoved"].(bool)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetShowunique(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1142,6 +2402,15 @@ func (p *ListIsosParams) SetShowunique(v bool) {
 	p.p["showunique"] = v
 }
 
+func (p *ListIsosParams) ResetShowunique() {
+	delete(p.p, "showunique")
+}
+
+func (p *ListIsosParams) GetShowunique() (bool, bool) {
+	value, ok := p.p["showunique"].(bool)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1149,6 +2418,15 @@ func (p *ListIsosParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListIsosParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListIsosParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 func (p *ListIsosParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1156,6 +2434,15 @@ func (p *ListIsosParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListIsosParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListIsosParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListIsosParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewListIsosParams() *ListIsosParams {
@@ -1166,6 +2453,11 @@ func (s *ISOService) NewListIsosParams() *ListIsosParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ISOService) GetIsoID(name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetIsoIDWithContext(context.Background(), name, isofilter, zoneid, opts...)
+}
+
+// GetIsoIDWithContext is the same as GetIsoID, but uses ctx to cancel the request
+func (s *ISOService) GetIsoIDWithContext(ctx context.Context, name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListIsosParams{}
 	p.p = make(map[string]interface{})
 
@@ -1179,7 +2471,7 @@ func (s *ISOService) GetIsoID(name string, isofilter string, zoneid string, opts
 		}
 	}
 
-	l, err := s.ListIsos(p)
+	l, err := s.ListIsosWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1204,12 +2496,17 @@ func (s *ISOService) GetIsoID(name string, isofilter string, zoneid string, opts
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ISOService) GetIsoByName(name string, isofilter string, zoneid string, opts ...OptionFunc) (*Iso, int, error) {
-	id, count, err := s.GetIsoID(name, isofilter, zoneid, opts...)
+	return s.GetIsoByNameWithContext(context.Background(), name, isofilter, zoneid, opts...)
+}
+
+// GetIsoByNameWithContext is the same as GetIsoByName, but uses ctx to cancel the requests
+func (s *ISOService) GetIsoByNameWithContext(ctx context.Context, name string, isofilter string, zoneid string, opts ...OptionFunc) (*Iso, int, error) {
+	id, count, err := s.GetIsoIDWithContext(ctx, name, isofilter, zoneid, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetIsoByID(id, opts...)
+	r, count, err := s.GetIsoByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1218,6 +2515,11 @@ func (s *ISOService) GetIsoByName(name string, isofilter string, zoneid string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ISOService) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error) {
+	return s.GetIsoByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetIsoByIDWithContext is the same as GetIsoByID, but uses ctx to cancel the request
+func (s *ISOService) GetIsoByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Iso, int, error) {
 	p := &ListIsosParams{}
 	p.p = make(map[string]interface{})
 
@@ -1229,18 +2531,16 @@ func (s *ISOService) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error
 		}
 	}
 
-	l, err := s.ListIsos(p)
+	l, err := s.ListIsosWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1251,7 +2551,16 @@ func (s *ISOService) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error
 
 // Lists all available ISO files.
 func (s *ISOService) ListIsos(p *ListIsosParams) (*ListIsosResponse, error) {
-	resp, err := s.cs.newRequest("listIsos", p.toURLValues())
+	return s.ListIsosWithContext(context.Background(), p)
+}
+
+// ListIsosWithContext is the same as ListIsos, but uses ctx to cancel the request and any async job polling
+func (s *ISOService) ListIsosWithContext(ctx context.Context, p *ListIsosParams) (*ListIsosResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listIsos", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1264,6 +2573,92 @@ func (s *ISOService) ListIsos(p *ListIsosParams) (*ListIsosResponse, error) {
 	return &r, nil
 }
 
+// ListIsosPager iterates over all pages of a ListIsos call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListIsosPager struct {
+	pager
+
+	s *ISOService
+	p *ListIsosParams
+	r *ListIsosResponse
+}
+
+// NewListIsosPager returns a pager for all pages of a ListIsos call with the given params
+func (s *ISOService) NewListIsosPager(p *ListIsosParams) *ListIsosPager {
+	return &ListIsosPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListIsosPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListIsosPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListIsosParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListIsosWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Isos), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListIsosPager) Page() *ListIsosResponse {
+	return pg.r
+}
+
+// ListIsosAll fetches all pages of a ListIsos call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ISOService) ListIsosAll(p *ListIsosParams) (*ListIsosResponse, error) {
+	return s.ListIsosAllWithContext(context.Background(), p)
+}
+
+// ListIsosAllWithContext is the same as ListIsosAll, but uses ctx to cancel the requests
+func (s *ISOService) ListIsosAllWithContext(ctx context.Context, p *ListIsosParams) (*ListIsosResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Iso)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListIsosParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListIsosWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Isos
+		mu.Unlock()
+
+		return len(l.Isos), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListIsosResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Isos = append(r.Isos, pages[page]...)
+	}
+	r.Count = len(r.Isos)
+
+	return r, nil
+}
+
 type ListIsosResponse struct {
 	Count int    `json:"count"`
 	Isos  []*Iso `json:"iso"`
@@ -1351,7 +2746,8 @@ type RegisterIsoParams struct {
 	p map[string]interface{}
 }
 
-func (p *RegisterIsoParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RegisterIsoParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1417,6 +2813,58 @@ func (p *RegisterIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RegisterIsoParams) Validate() error {
+	v := newParamValidator("registerIso", p.p)
+	v.required("displaytext", "name", "url", "zoneid")
+	v.ids("domainid", "ostypeid", "projectid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("checksum", 255)
+	v.maxLength("displaytext", 4096)
+	v.maxLength("imagestoreuuid", 255)
+	v.maxLength("name", 255)
+	v.maxLength("url", 2048)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RegisterIsoParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RegisterIsoParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "checksum", "displaytext", "domainid", "imagestoreuuid", "name", "ostypeid", "projectid", "url", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "bootable", "directdownload", "isdynamicallyscalable", "isextractable", "isfeatured", "ispublic", "passwordenabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for registerIso", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of registerIso: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RegisterIsoParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1424,6 +2872,15 @@ func (p *RegisterIsoParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *RegisterIsoParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *RegisterIsoParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetBootable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1431,6 +2888,15 @@ func (p *RegisterIsoParams) SetBootable(v bool) {
 	p.p["bootable"] = v
 }
 
+func (p *RegisterIsoParams) ResetBootable() {
+	delete(p.p, "bootable")
+}
+
+func (p *RegisterIsoParams) GetBootable() (bool, bool) {
+	value, ok := p.p["bootable"].(bool)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetChecksum(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1438,6 +2904,15 @@ func (p *RegisterIsoParams) SetChecksum(v string) {
 	p.p["checksum"] = v
 }
 
+func (p *RegisterIsoParams) ResetChecksum() {
+	delete(p.p, "checksum")
+}
+
+func (p *RegisterIsoParams) GetChecksum() (string, bool) {
+	value, ok := p.p["checksum"].(string)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetDirectdownload(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1445,6 +2920,15 @@ func (p *RegisterIsoParams) SetDirectdownload(v bool) {
 	p.p["directdownload"] = v
 }
 
+func (p *RegisterIsoParams) ResetDirectdownload() {
+	delete(p.p, "directdownload")
+}
+
+func (p *RegisterIsoParams) GetDirectdownload() (bool, bool) {
+	value, ok := p.p["directdownload"].(bool)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1452,6 +2936,15 @@ func (p *RegisterIsoParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *RegisterIsoParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *RegisterIsoParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1459,6 +2952,15 @@ func (p *RegisterIsoParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *RegisterIsoParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *RegisterIsoParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetImagestoreuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1466,6 +2968,15 @@ func (p *RegisterIsoParams) SetImagestoreuuid(v string) {
 	p.p["imagestoreuuid"] = v
 }
 
+func (p *RegisterIsoParams) ResetImagestoreuuid() {
+	delete(p.p, "imagestoreuuid")
+}
+
+func (p *RegisterIsoParams) GetImagestoreuuid() (string, bool) {
+	value, ok := p.p["imagestoreuuid"].(string)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetIsdynamicallyscalable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1473,6 +2984,15 @@ func (p *RegisterIsoParams) SetIsdynamicallyscalable(v bool) {
 	p.p["isdynamicallyscalable"] = v
 }
 
+func (p *RegisterIsoParams) ResetIsdynamicallyscalable() {
+	delete(p.p, "isdynamicallyscalable")
+}
+
+func (p *RegisterIsoParams) GetIsdynamicallyscalable() (bool, bool) {
+	value, ok := p.p["isdynamicallyscalable"].(bool)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetIsextractable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1480,6 +3000,15 @@ func (p *RegisterIsoParams) SetIsextractable(v bool) {
 	p.p["isextractable"] = v
 }
 
+func (p *RegisterIsoParams) ResetIsextractable() {
+	delete(p.p, "isextractable")
+}
+
+func (p *RegisterIsoParams) GetIsextractable() (bool, bool) {
+	value, ok := p.p["isextractable"].(bool)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetIsfeatured(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1487,6 +3016,15 @@ func (p *RegisterIsoParams) SetIsfeatured(v bool) {
 	p.p["isfeatured"] = v
 }
 
+func (p *RegisterIsoParams) ResetIsfeatured() {
+	delete(p.p, "isfeatured")
+}
+
+func (p *RegisterIsoParams) GetIsfeatured() (bool, bool) {
+	value, ok := p.p["isfeatured"].(bool)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1494,6 +3032,15 @@ func (p *RegisterIsoParams) SetIspublic(v bool) {
 	p.p["ispublic"] = v
 }
 
+func (p *RegisterIsoParams) ResetIspublic() {
+	delete(p.p, "ispublic")
+}
+
+func (p *RegisterIsoParams) GetIspublic() (bool, bool) {
+	value, ok := p.p["ispublic"].(bool)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1501,6 +3048,15 @@ func (p *RegisterIsoParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *RegisterIsoParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *RegisterIsoParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1508,6 +3064,15 @@ func (p *RegisterIsoParams) SetOstypeid(v string) {
 	p.p["ostypeid"] = v
 }
 
+func (p *RegisterIsoParams) ResetOstypeid() {
+	delete(p.p, "ostypeid")
+}
+
+func (p *RegisterIsoParams) GetOstypeid() (string, bool) {
+	value, ok := p.p["ostypeid"].(string)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetPasswordenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1515,6 +3080,15 @@ func (p *RegisterIsoParams) SetPasswordenabled(v bool) {
 	p.p["passwordenabled"] = v
 }
 
+func (p *RegisterIsoParams) ResetPasswordenabled() {
+	delete(p.p, "passwordenabled")
+}
+
+func (p *RegisterIsoParams) GetPasswordenabled() (bool, bool) {
+	value, ok := p.p["passwordenabled"].(bool)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1522,6 +3096,15 @@ func (p *RegisterIsoParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *RegisterIsoParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *RegisterIsoParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1529,6 +3112,15 @@ func (p *RegisterIsoParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *RegisterIsoParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *RegisterIsoParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *RegisterIsoParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1536,6 +3128,15 @@ func (p *RegisterIsoParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *RegisterIsoParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *RegisterIsoParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RegisterIsoParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewRegisterIsoParams(displaytext string, name string, url string, zoneid string) *RegisterIsoParams {
@@ -1550,7 +3151,16 @@ func (s *ISOService) NewRegisterIsoParams(displaytext string, name string, url s
 
 // Registers an existing ISO into the CloudStack Cloud.
 func (s *ISOService) RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, error) {
-	resp, err := s.cs.newRequest("registerIso", p.toURLValues())
+	return s.RegisterIsoWithContext(context.Background(), p)
+}
+
+// RegisterIsoWithContext is the same as RegisterIso, but uses ctx to cancel the request and any async job polling
+func (s *ISOService) RegisterIsoWithContext(ctx context.Context, p *RegisterIsoParams) (*RegisterIsoResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "registerIso", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1645,7 +3255,8 @@ type UpdateIsoParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateIsoParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateIsoParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1706,6 +3317,63 @@ func (p *UpdateIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateIsoParams) Validate() error {
+	v := newParamValidator("updateIso", p.p)
+	v.required("id")
+	v.ids("id", "ostypeid")
+	v.maxLength("displaytext", 4096)
+	v.maxLength("format", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateIsoParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateIsoParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "bootable", "cleanupdetails", "isdynamicallyscalable", "isrouting", "passwordenabled", "requireshvm", "sshkeyenabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "displaytext", "format", "id", "name", "ostypeid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "sortkey":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateIso", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateIso: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateIsoParams) SetBootable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1713,6 +3381,15 @@ func (p *UpdateIsoParams) SetBootable(v bool) {
 	p.p["bootable"] = v
 }
 
+func (p *UpdateIsoParams) ResetBootable() {
+	delete(p.p, "bootable")
+}
+
+func (p *UpdateIsoParams) GetBootable() (bool, bool) {
+	value, ok := p.p["bootable"].(bool)
+	return value, ok
+}
+
 func (p *UpdateIsoParams) SetCleanupdetails(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1720,6 +3397,15 @@ func (p *UpdateIsoParams) SetCleanupdetails(v bool) {
 	p.p["cleanupdetails"] = v
 }
 
+func (p *UpdateIsoParams) ResetCleanupdetails() {
+	delete(p.p, "cleanupdetails")
+}
+
+func (p *UpdateIsoParams) GetCleanupdetails() (bool, bool) {
+	value, ok := p.p["cleanupdetails"].(bool)
+	return value, ok
+}
+
 func (p *UpdateIsoParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1727,6 +3413,15 @@ func (p *UpdateIsoParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *UpdateIsoParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *UpdateIsoParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *UpdateIsoParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1734,6 +3429,15 @@ func (p *UpdateIsoParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *UpdateIsoParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *UpdateIsoParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *UpdateIsoParams) SetFormat(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1741,6 +3445,15 @@ func (p *UpdateIsoParams) SetFormat(v string) {
 	p.p["format"] = v
 }
 
+func (p *UpdateIsoParams) ResetFormat() {
+	delete(p.p, "format")
+}
+
+func (p *UpdateIsoParams) GetFormat() (string, bool) {
+	value, ok := p.p["format"].(string)
+	return value, ok
+}
+
 func (p *UpdateIsoParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1748,6 +3461,15 @@ func (p *UpdateIsoParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateIsoParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateIsoParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateIsoParams) SetIsdynamicallyscalable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1755,6 +3477,15 @@ func (p *UpdateIsoParams) SetIsdynamicallyscalable(v bool) {
 	p.p["isdynamicallyscalable"] = v
 }
 
+func (p *UpdateIsoParams) ResetIsdynamicallyscalable() {
+	delete(p.p, "isdynamicallyscalable")
+}
+
+func (p *UpdateIsoParams) GetIsdynamicallyscalable() (bool, bool) {
+	value, ok := p.p["isdynamicallyscalable"].(bool)
+	return value, ok
+}
+
 func (p *UpdateIsoParams) SetIsrouting(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1762,6 +3493,15 @@ func (p *UpdateIsoParams) SetIsrouting(v bool) {
 	p.p["isrouting"] = v
 }
 
+func (p *UpdateIsoParams) ResetIsrouting() {
+	delete(p.p, "isrouting")
+}
+
+func (p *UpdateIsoParams) GetIsrouting() (bool, bool) {
+	value, ok := p.p["isrouting"].(bool)
+	return value, ok
+}
+
 func (p *UpdateIsoParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1769,6 +3509,15 @@ func (p *UpdateIsoParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateIsoParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateIsoParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateIsoParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1776,6 +3525,15 @@ func (p *UpdateIsoParams) SetOstypeid(v string) {
 	p.p["ostypeid"] = v
 }
 
+func (p *UpdateIsoParams) ResetOstypeid() {
+	delete(p.p, "ostypeid")
+}
+
+func (p *UpdateIsoParams) GetOstypeid() (string, bool) {
+	value, ok := p.p["ostypeid"].(string)
+	return value, ok
+}
+
 func (p *UpdateIsoParams) SetPasswordenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1783,6 +3541,15 @@ func (p *UpdateIsoParams) SetPasswordenabled(v bool) {
 	p.p["passwordenabled"] = v
 }
 
+func (p *UpdateIsoParams) ResetPasswordenabled() {
+	delete(p.p, "passwordenabled")
+}
+
+func (p *UpdateIsoParams) GetPasswordenabled() (bool, bool) {
+	value, ok := p.p["passwordenabled"].(bool)
+	return value, ok
+}
+
 func (p *UpdateIsoParams) SetRequireshvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1790,6 +3557,15 @@ func (p *UpdateIsoParams) SetRequireshvm(v bool) {
 	p.p["requireshvm"] = v
 }
 
+func (p *UpdateIsoParams) ResetRequireshvm() {
+	delete(p.p, "requireshvm")
+}
+
+func (p *UpdateIsoParams) GetRequireshvm() (bool, bool) {
+	value, ok := p.p["requireshvm"].(bool)
+	return value, ok
+}
+
 func (p *UpdateIsoParams) SetSortkey(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1797,6 +3573,15 @@ func (p *UpdateIsoParams) SetSortkey(v int) {
 	p.p["sortkey"] = v
 }
 
+func (p *UpdateIsoParams) ResetSortkey() {
+	delete(p.p, "sortkey")
+}
+
+func (p *UpdateIsoParams) GetSortkey() (int, bool) {
+	value, ok := p.p["sortkey"].(int)
+	return value, ok
+}
+
 func (p *UpdateIsoParams) SetSshkeyenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1804,6 +3589,15 @@ func (p *UpdateIsoParams) SetSshkeyenabled(v bool) {
 	p.p["sshkeyenabled"] = v
 }
 
+func (p *UpdateIsoParams) ResetSshkeyenabled() {
+	delete(p.p, "sshkeyenabled")
+}
+
+func (p *UpdateIsoParams) GetSshkeyenabled() (bool, bool) {
+	value, ok := p.p["sshkeyenabled"].(bool)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateIsoParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewUpdateIsoParams(id string) *UpdateIsoParams {
@@ -1815,7 +3609,16 @@ func (s *ISOService) NewUpdateIsoParams(id string) *UpdateIsoParams {
 
 // Updates an ISO file.
 func (s *ISOService) UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error) {
-	resp, err := s.cs.newRequest("updateIso", p.toURLValues())
+	return s.UpdateIsoWithContext(context.Background(), p)
+}
+
+// UpdateIsoWithContext is the same as UpdateIso, but uses ctx to cancel the request and any async job polling
+func (s *ISOService) UpdateIsoWithContext(ctx context.Context, p *UpdateIsoParams) (*UpdateIsoResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateIso", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1910,7 +3713,8 @@ type UpdateIsoPermissionsParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateIsoPermissionsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateIsoPermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1944,6 +3748,57 @@ func (p *UpdateIsoPermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateIsoPermissionsParams) Validate() error {
+	v := newParamValidator("updateIsoPermissions", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("op", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateIsoPermissionsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateIsoPermissionsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "accounts", "projectids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "op":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isextractable", "isfeatured", "ispublic":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateIsoPermissions", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateIsoPermissions: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateIsoPermissionsParams) SetAccounts(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1951,6 +3806,15 @@ func (p *UpdateIsoPermissionsParams) SetAccounts(v []string) {
 	p.p["accounts"] = v
 }
 
+func (p *UpdateIsoPermissionsParams) ResetAccounts() {
+	delete(p.p, "accounts")
+}
+
+func (p *UpdateIsoPermissionsParams) GetAccounts() ([]string, bool) {
+	value, ok := p.p["accounts"].([]string)
+	return value, ok
+}
+
 func (p *UpdateIsoPermissionsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1958,6 +3822,15 @@ func (p *UpdateIsoPermissionsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateIsoPermissionsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateIsoPermissionsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateIsoPermissionsParams) SetIsextractable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1965,6 +3838,15 @@ func (p *UpdateIsoPermissionsParams) SetIsextractable(v bool) {
 	p.p["isextractable"] = v
 }
 
+func (p *UpdateIsoPermissionsParams) ResetIsextractable() {
+	delete(p.p, "isextractable")
+}
+
+func (p *UpdateIsoPermissionsParams) GetIsextractable() (bool, bool) {
+	value, ok := p.p["isextractable"].(bool)
+	return value, ok
+}
+
 func (p *UpdateIsoPermissionsParams) SetIsfeatured(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1972,6 +3854,15 @@ func (p *UpdateIsoPermissionsParams) SetIsfeatured(v bool) {
 	p.p["isfeatured"] = v
 }
 
+func (p *UpdateIsoPermissionsParams) ResetIsfeatured() {
+	delete(p.p, "isfeatured")
+}
+
+func (p *UpdateIsoPermissionsParams) GetIsfeatured() (bool, bool) {
+	value, ok := p.p["isfeatured"].(bool)
+	return value, ok
+}
+
 func (p *UpdateIsoPermissionsParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1979,6 +3870,15 @@ func (p *UpdateIsoPermissionsParams) SetIspublic(v bool) {
 	p.p["ispublic"] = v
 }
 
+func (p *UpdateIsoPermissionsParams) ResetIspublic() {
+	delete(p.p, "ispublic")
+}
+
+func (p *UpdateIsoPermissionsParams) GetIspublic() (bool, bool) {
+	value, ok := p.p["ispublic"].(bool)
+	return value, ok
+}
+
 func (p *UpdateIsoPermissionsParams) SetOp(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1986,6 +3886,15 @@ func (p *UpdateIsoPermissionsParams) SetOp(v string) {
 	p.p["op"] = v
 }
 
+func (p *UpdateIsoPermissionsParams) ResetOp() {
+	delete(p.p, "op")
+}
+
+func (p *UpdateIsoPermissionsParams) GetOp() (string, bool) {
+	value, ok := p.p["op"].(string)
+	return value, ok
+}
+
 func (p *UpdateIsoPermissionsParams) SetProjectids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1993,6 +3902,15 @@ func (p *UpdateIsoPermissionsParams) SetProjectids(v []string) {
 	p.p["projectids"] = v
 }
 
+func (p *UpdateIsoPermissionsParams) ResetProjectids() {
+	delete(p.p, "projectids")
+}
+
+func (p *UpdateIsoPermissionsParams) GetProjectids() ([]string, bool) {
+	value, ok := p.p["projectids"].([]string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateIsoPermissionsParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissionsParams {
@@ -2004,7 +3922,16 @@ func (s *ISOService) NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissi
 
 // Updates ISO permissions
 func (s *ISOService) UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("updateIsoPermissions", p.toURLValues())
+	return s.UpdateIsoPermissionsWithContext(context.Background(), p)
+}
+
+// UpdateIsoPermissionsWithContext is the same as UpdateIsoPermissions, but uses ctx to cancel the request and any async job polling
+func (s *ISOService) UpdateIsoPermissionsWithContext(ctx context.Context, p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateIsoPermissions", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -20,18 +20,76 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// ImageStoreServiceIface is the interface implemented by ImageStoreService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type ImageStoreServiceIface interface {
+	NewAddImageStoreParams(provider string) *AddImageStoreParams
+	AddImageStore(p *AddImageStoreParams) (*AddImageStoreResponse, error)
+	AddImageStoreWithContext(ctx context.Context, p *AddImageStoreParams) (*AddImageStoreResponse, error)
+	NewAddImageStoreS3Params(accesskey string, bucket string, endpoint string, secretkey string) *AddImageStoreS3Params
+	AddImageStoreS3(p *AddImageStoreS3Params) (*AddImageStoreS3Response, error)
+	AddImageStoreS3WithContext(ctx context.Context, p *AddImageStoreS3Params) (*AddImageStoreS3Response, error)
+	NewCreateSecondaryStagingStoreParams(url string) *CreateSecondaryStagingStoreParams
+	CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error)
+	CreateSecondaryStagingStoreWithContext(ctx context.Context, p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error)
+	NewDeleteImageStoreParams(id string) *DeleteImageStoreParams
+	DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error)
+	DeleteImageStoreWithContext(ctx context.Context, p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error)
+	NewDeleteSecondaryStagingStoreParams(id string) *DeleteSecondaryStagingStoreParams
+	DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error)
+	DeleteSecondaryStagingStoreWithContext(ctx context.Context, p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error)
+	NewListImageStoresParams() *ListImageStoresParams
+	GetImageStoreID(name string, opts ...OptionFunc) (string, int, error)
+	GetImageStoreIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetImageStoreByName(name string, opts ...OptionFunc) (*ImageStore, int, error)
+	GetImageStoreByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ImageStore, int, error)
+	GetImageStoreByID(id string, opts ...OptionFunc) (*ImageStore, int, error)
+	GetImageStoreByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ImageStore, int, error)
+	ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error)
+	ListImageStoresWithContext(ctx context.Context, p *ListImageStoresParams) (*ListImageStoresResponse, error)
+	NewListImageStoresPager(p *ListImageStoresParams) *ListImageStoresPager
+	ListImageStoresAll(p *ListImageStoresParams) (*ListImageStoresResponse, error)
+	ListImageStoresAllWithContext(ctx context.Context, p *ListImageStoresParams) (*ListImageStoresResponse, error)
+	NewListSecondaryStagingStoresParams() *ListSecondaryStagingStoresParams
+	GetSecondaryStagingStoreID(name string, opts ...OptionFunc) (string, int, error)
+	GetSecondaryStagingStoreIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetSecondaryStagingStoreByName(name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	GetSecondaryStagingStoreByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	GetSecondaryStagingStoreByID(id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	GetSecondaryStagingStoreByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error)
+	ListSecondaryStagingStoresWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error)
+	NewListSecondaryStagingStoresPager(p *ListSecondaryStagingStoresParams) *ListSecondaryStagingStoresPager
+	ListSecondaryStagingStoresAll(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error)
+	ListSecondaryStagingStoresAllWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error)
+	NewMigrateSecondaryStorageDataParams(destpools []string, srcpool string) *MigrateSecondaryStorageDataParams
+	MigrateSecondaryStorageData(p *MigrateSecondaryStorageDataParams) (*MigrateSecondaryStorageDataResponse, error)
+	MigrateSecondaryStorageDataWithContext(ctx context.Context, p *MigrateSecondaryStorageDataParams) (*MigrateSecondaryStorageDataResponse, error)
+	MigrateSecondaryStorageDataAsync(p *MigrateSecondaryStorageDataParams) (*Job, error)
+	MigrateSecondaryStorageDataAsyncWithContext(ctx context.Context, p *MigrateSecondaryStorageDataParams) (*Job, error)
+	NewUpdateCloudToUseObjectStoreParams(provider string) *UpdateCloudToUseObjectStoreParams
+	UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error)
+	UpdateCloudToUseObjectStoreWithContext(ctx context.Context, p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error)
+	NewUpdateImageStoreParams(id string, readonly bool) *UpdateImageStoreParams
+	UpdateImageStore(p *UpdateImageStoreParams) (*UpdateImageStoreResponse, error)
+	UpdateImageStoreWithContext(ctx context.Context, p *UpdateImageStoreParams) (*UpdateImageStoreResponse, error)
+}
+
 type AddImageStoreParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddImageStoreParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddImageStoreParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -58,6 +116,55 @@ func (p *AddImageStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddImageStoreParams) Validate() error {
+	v := newParamValidator("addImageStore", p.p)
+	v.required("provider")
+	v.ids("zoneid")
+	v.maxLength("name", 255)
+	v.maxLength("provider", 255)
+	v.maxLength("url", 2048)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddImageStoreParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddImageStoreParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "name", "provider", "url", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addImageStore", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addImageStore: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddImageStoreParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -65,6 +172,15 @@ func (p *AddImageStoreParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *AddImageStoreParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *AddImageStoreParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *AddImageStoreParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -72,6 +188,15 @@ func (p *AddImageStoreParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *AddImageStoreParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *AddImageStoreParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *AddImageStoreParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +204,15 @@ func (p *AddImageStoreParams) SetProvider(v string) {
 	p.p["provider"] = v
 }
 
+func (p *AddImageStoreParams) ResetProvider() {
+	delete(p.p, "provider")
+}
+
+func (p *AddImageStoreParams) GetProvider() (string, bool) {
+	value, ok := p.p["provider"].(string)
+	return value, ok
+}
+
 func (p *AddImageStoreParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -86,6 +220,15 @@ func (p *AddImageStoreParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *AddImageStoreParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddImageStoreParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *AddImageStoreParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -93,6 +236,15 @@ func (p *AddImageStoreParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *AddImageStoreParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *AddImageStoreParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddImageStoreParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewAddImageStoreParams(provider string) *AddImageStoreParams {
@@ -104,7 +256,16 @@ func (s *ImageStoreService) NewAddImageStoreParams(provider string) *AddImageSto
 
 // Adds backup image store.
 func (s *ImageStoreService) AddImageStore(p *AddImageStoreParams) (*AddImageStoreResponse, error) {
-	resp, err := s.cs.newRequest("addImageStore", p.toURLValues())
+	return s.AddImageStoreWithContext(context.Background(), p)
+}
+
+// AddImageStoreWithContext is the same as AddImageStore, but uses ctx to cancel the request and any async job polling
+func (s *ImageStoreService) AddImageStoreWithContext(ctx context.Context, p *AddImageStoreParams) (*AddImageStoreResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addImageStore", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +298,8 @@ type AddImageStoreS3Params struct {
 	p map[string]interface{}
 }
 
-func (p *AddImageStoreS3Params) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddImageStoreS3Params) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -184,6 +346,60 @@ func (p *AddImageStoreS3Params) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddImageStoreS3Params) Validate() error {
+	v := newParamValidator("addImageStoreS3", p.p)
+	v.required("accesskey", "bucket", "endpoint", "secretkey")
+	v.maxLength("accesskey", 255)
+	v.maxLength("bucket", 255)
+	v.maxLength("endpoint", 255)
+	v.maxLength("s3signer", 255)
+	v.maxLength("secretkey", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddImageStoreS3Params) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddImageStoreS3Params) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "accesskey", "bucket", "endpoint", "s3signer", "secretkey":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "connectiontimeout", "connectionttl", "maxerrorretry", "sockettimeout":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "usehttps", "usetcpkeepalive":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addImageStoreS3", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addImageStoreS3: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddImageStoreS3Params) SetAccesskey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -191,6 +407,15 @@ func (p *AddImageStoreS3Params) SetAccesskey(v string) {
 	p.p["accesskey"] = v
 }
 
+func (p *AddImageStoreS3Params) ResetAccesskey() {
+	delete(p.p, "accesskey")
+}
+
+func (p *AddImageStoreS3Params) GetAccesskey() (string, bool) {
+	value, ok := p.p["accesskey"].(string)
+	return value, ok
+}
+
 func (p *AddImageStoreS3Params) SetBucket(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -198,6 +423,15 @@ func (p *AddImageStoreS3Params) SetBucket(v string) {
 	p.p["bucket"] = v
 }
 
+func (p *AddImageStoreS3Params) ResetBucket() {
+	delete(p.p, "bucket")
+}
+
+func (p *AddImageStoreS3Params) GetBucket() (string, bool) {
+	value, ok := p.p["bucket"].(string)
+	return value, ok
+}
+
 func (p *AddImageStoreS3Params) SetConnectiontimeout(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -205,6 +439,15 @@ func (p *AddImageStoreS3Params) SetConnectiontimeout(v int) {
 	p.p["connectiontimeout"] = v
 }
 
+func (p *AddImageStoreS3Params) ResetConnectiontimeout() {
+	delete(p.p, "connectiontimeout")
+}
+
+func (p *AddImageStoreS3Params) GetConnectiontimeout() (int, bool) {
+	value, ok := p.p["connectiontimeout"].(int)
+	return value, ok
+}
+
 func (p *AddImageStoreS3Params) SetConnectionttl(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -212,6 +455,15 @@ func (p *AddImageStoreS3Params) SetConnectionttl(v int) {
 	p.p["connectionttl"] = v
 }
 
+func (p *AddImageStoreS3Params) ResetConnectionttl() {
+	delete(p.p, "connectionttl")
+}
+
+func (p *AddImageStoreS3Params) GetConnectionttl() (int, bool) {
+	value, ok := p.p["connectionttl"].(int)
+	return value, ok
+}
+
 func (p *AddImageStoreS3Params) SetEndpoint(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -219,6 +471,15 @@ func (p *AddImageStoreS3Params) SetEndpoint(v string) {
 	p.p["endpoint"] = v
 }
 
+func (p *AddImageStoreS3Params) ResetEndpoint() {
+	delete(p.p, "endpoint")
+}
+
+func (p *AddImageStoreS3Params) GetEndpoint() (string, bool) {
+	value, ok := p.p["endpoint"].(string)
+	return value, ok
+}
+
 func (p *AddImageStoreS3Params) SetMaxerrorretry(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -226,6 +487,15 @@ func (p *AddImageStoreS3Params) SetMaxerrorretry(v int) {
 	p.p["maxerrorretry"] = v
 }
 
+func (p *AddImageStoreS3Params) ResetMaxerrorretry() {
+	delete(p.p, "maxerrorretry")
+}
+
+func (p *AddImageStoreS3Params) GetMaxerrorretry() (int, bool) {
+	value, ok := p.p["maxerrorretry"].(int)
+	return value, ok
+}
+
 func (p *AddImageStoreS3Params) SetS3signer(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -233,6 +503,15 @@ func (p *AddImageStoreS3Params) SetS3signer(v string) {
 	p.p["s3signer"] = v
 }
 
+func (p *AddImageStoreS3Params) ResetS3signer() {
+	delete(p.p, "s3signer")
+}
+
+func (p *AddImageStoreS3Params) GetS3signer() (string, bool) {
+	value, ok := p.p["s3signer"].(string)
+	return value, ok
+}
+
 func (p *AddImageStoreS3Params) SetSecretkey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -240,6 +519,15 @@ func (p *AddImageStoreS3Params) SetSecretkey(v string) {
 	p.p["secretkey"] = v
 }
 
+func (p *AddImageStoreS3Params) ResetSecretkey() {
+	delete(p.p, "secretkey")
+}
+
+func (p *AddImageStoreS3Params) GetSecretkey() (string, bool) {
+	value, ok := p.p["secretkey"].(string)
+	return value, ok
+}
+
 func (p *AddImageStoreS3Params) SetSockettimeout(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -247,6 +535,15 @@ func (p *AddImageStoreS3Params) SetSockettimeout(v int) {
 	p.p["sockettimeout"] = v
 }
 
+func (p *AddImageStoreS3Params) ResetSockettimeout() {
+	delete(p.p, "sockettimeout")
+}
+
+func (p *AddImageStoreS3Params) GetSockettimeout() (int, bool) {
+	value, ok := p.p["sockettimeout"].(int)
+	return value, ok
+}
+
 func (p *AddImageStoreS3Params) SetUsehttps(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -254,6 +551,15 @@ func (p *AddImageStoreS3Params) SetUsehttps(v bool) {
 	p.p["usehttps"] = v
 }
 
+func (p *AddImageStoreS3Params) ResetUsehttps() {
+	delete(p.p, "usehttps")
+}
+
+func (p *AddImageStoreS3Params) GetUsehttps() (bool, bool) {
+	value, ok := p.p["usehttps"].(bool)
+	return value, ok
+}
+
 func (p *AddImageStoreS3Params) SetUsetcpkeepalive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -261,6 +567,15 @@ func (p *AddImageStoreS3Params) SetUsetcpkeepalive(v bool) {
 	p.p["usetcpkeepalive"] = v
 }
 
+func (p *AddImageStoreS3Params) ResetUsetcpkeepalive() {
+	delete(p.p, "usetcpkeepalive")
+}
+
+func (p *AddImageStoreS3Params) GetUsetcpkeepalive() (bool, bool) {
+	value, ok := p.p["usetcpkeepalive"].(bool)
+	return value, ok
+}
+
 // You should always use this function to get a new AddImageStoreS3Params instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewAddImageStoreS3Params(accesskey string, bucket string, endpoint string, secretkey string) *AddImageStoreS3Params {
@@ -275,7 +590,16 @@ func (s *ImageStoreService) NewAddImageStoreS3Params(accesskey string, bucket st
 
 // Adds S3 Image Store
 func (s *ImageStoreService) AddImageStoreS3(p *AddImageStoreS3Params) (*AddImageStoreS3Response, error) {
-	resp, err := s.cs.newRequest("addImageStoreS3", p.toURLValues())
+	return s.AddImageStoreS3WithContext(context.Background(), p)
+}
+
+// AddImageStoreS3WithContext is the same as AddImageStoreS3, but uses ctx to cancel the request and any async job polling
+func (s *ImageStoreService) AddImageStoreS3WithContext(ctx context.Context, p *AddImageStoreS3Params) (*AddImageStoreS3Response, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addImageStoreS3", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -308,7 +632,8 @@ type CreateSecondaryStagingStoreParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateSecondaryStagingStoreParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateSecondaryStagingStoreParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -335,6 +660,55 @@ func (p *CreateSecondaryStagingStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateSecondaryStagingStoreParams) Validate() error {
+	v := newParamValidator("createSecondaryStagingStore", p.p)
+	v.required("url")
+	v.ids("zoneid")
+	v.maxLength("provider", 255)
+	v.maxLength("scope", 255)
+	v.maxLength("url", 2048)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateSecondaryStagingStoreParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateSecondaryStagingStoreParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "provider", "scope", "url", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createSecondaryStagingStore", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createSecondaryStagingStore: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateSecondaryStagingStoreParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -342,6 +716,15 @@ func (p *CreateSecondaryStagingStoreParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *CreateSecondaryStagingStoreParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *CreateSecondaryStagingStoreParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *CreateSecondaryStagingStoreParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -349,6 +732,15 @@ func (p *CreateSecondaryStagingStoreParams) SetProvider(v string) {
 	p.p["provider"] = v
 }
 
+func (p *CreateSecondaryStagingStoreParams) ResetProvider() {
+	delete(p.p, "provider")
+}
+
+func (p *CreateSecondaryStagingStoreParams) GetProvider() (string, bool) {
+	value, ok := p.p["provider"].(string)
+	return value, ok
+}
+
 func (p *CreateSecondaryStagingStoreParams) SetScope(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -356,6 +748,15 @@ func (p *CreateSecondaryStagingStoreParams) SetScope(v string) {
 	p.p["scope"] = v
 }
 
+func (p *CreateSecondaryStagingStoreParams) ResetScope() {
+	delete(p.p, "scope")
+}
+
+func (p *CreateSecondaryStagingStoreParams) GetScope() (string, bool) {
+	value, ok := p.p["scope"].(string)
+	return value, ok
+}
+
 func (p *CreateSecondaryStagingStoreParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -363,6 +764,15 @@ func (p *CreateSecondaryStagingStoreParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *CreateSecondaryStagingStoreParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *CreateSecondaryStagingStoreParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *CreateSecondaryStagingStoreParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -370,6 +780,15 @@ func (p *CreateSecondaryStagingStoreParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *CreateSecondaryStagingStoreParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreateSecondaryStagingStoreParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateSecondaryStagingStoreParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewCreateSecondaryStagingStoreParams(url string) *CreateSecondaryStagingStoreParams {
@@ -381,7 +800,16 @@ func (s *ImageStoreService) NewCreateSecondaryStagingStoreParams(url string) *Cr
 
 // create secondary staging store.
 func (s *ImageStoreService) CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error) {
-	resp, err := s.cs.newRequest("createSecondaryStagingStore", p.toURLValues())
+	return s.CreateSecondaryStagingStoreWithContext(context.Background(), p)
+}
+
+// CreateSecondaryStagingStoreWithContext is the same as CreateSecondaryStagingStore, but uses ctx to cancel the request and any async job polling
+func (s *ImageStoreService) CreateSecondaryStagingStoreWithContext(ctx context.Context, p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createSecondaryStagingStore", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -414,7 +842,8 @@ type DeleteImageStoreParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteImageStoreParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteImageStoreParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -425,6 +854,48 @@ func (p *DeleteImageStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteImageStoreParams) Validate() error {
+	v := newParamValidator("deleteImageStore", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteImageStoreParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteImageStoreParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteImageStore", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteImageStore: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteImageStoreParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -432,6 +903,15 @@ func (p *DeleteImageStoreParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteImageStoreParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteImageStoreParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteImageStoreParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewDeleteImageStoreParams(id string) *DeleteImageStoreParams {
@@ -443,7 +923,16 @@ func (s *ImageStoreService) NewDeleteImageStoreParams(id string) *DeleteImageSto
 
 // Deletes an image store or Secondary Storage.
 func (s *ImageStoreService) DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
-	resp, err := s.cs.newRequest("deleteImageStore", p.toURLValues())
+	return s.DeleteImageStoreWithContext(context.Background(), p)
+}
+
+// DeleteImageStoreWithContext is the same as DeleteImageStore, but uses ctx to cancel the request and any async job polling
+func (s *ImageStoreService) DeleteImageStoreWithContext(ctx context.Context, p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteImageStore", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -494,7 +983,8 @@ type DeleteSecondaryStagingStoreParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteSecondaryStagingStoreParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteSecondaryStagingStoreParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -505,6 +995,48 @@ func (p *DeleteSecondaryStagingStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteSecondaryStagingStoreParams) Validate() error {
+	v := newParamValidator("deleteSecondaryStagingStore", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteSecondaryStagingStoreParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteSecondaryStagingStoreParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteSecondaryStagingStore", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteSecondaryStagingStore: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteSecondaryStagingStoreParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -512,6 +1044,15 @@ func (p *DeleteSecondaryStagingStoreParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteSecondaryStagingStoreParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteSecondaryStagingStoreParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteSecondaryStagingStoreParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewDeleteSecondaryStagingStoreParams(id string) *DeleteSecondaryStagingStoreParams {
@@ -523,7 +1064,16 @@ func (s *ImageStoreService) NewDeleteSecondaryStagingStoreParams(id string) *Del
 
 // Deletes a secondary staging store .
 func (s *ImageStoreService) DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
-	resp, err := s.cs.newRequest("deleteSecondaryStagingStore", p.toURLValues())
+	return s.DeleteSecondaryStagingStoreWithContext(context.Background(), p)
+}
+
+// DeleteSecondaryStagingStoreWithContext is the same as DeleteSecondaryStagingStore, but uses ctx to cancel the request and any async job polling
+func (s *ImageStoreService) DeleteSecondaryStagingStoreWithContext(ctx context.Context, p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSecondaryStagingStore", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -574,7 +1124,8 @@ type ListImageStoresParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListImageStoresParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListImageStoresParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -612,6 +1163,59 @@ func (p *ListImageStoresParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListImageStoresParams) Validate() error {
+	v := newParamValidator("listImageStores", p.p)
+	v.ids("id", "zoneid")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("protocol", 255)
+	v.maxLength("provider", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListImageStoresParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListImageStoresParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword", "name", "protocol", "provider", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "readonly":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listImageStores", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listImageStores: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListImageStoresParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -619,6 +1223,15 @@ func (p *ListImageStoresParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListImageStoresParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListImageStoresParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListImageStoresParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -626,6 +1239,15 @@ func (p *ListImageStoresParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListImageStoresParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListImageStoresParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListImageStoresParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -633,6 +1255,15 @@ func (p *ListImageStoresParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListImageStoresParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListImageStoresParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListImageStoresParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -640,6 +1271,15 @@ func (p *ListImageStoresParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListImageStoresParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListImageStoresParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListImageStoresParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -647,6 +1287,15 @@ func (p *ListImageStoresParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListImageStoresParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListImageStoresParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListImageStoresParams) SetProtocol(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -654,6 +1303,15 @@ func (p *ListImageStoresParams) SetProtocol(v string) {
 	p.p["protocol"] = v
 }
 
+func (p *ListImageStoresParams) ResetProtocol() {
+	delete(p.p, "protocol")
+}
+
+func (p *ListImageStoresParams) GetProtocol() (string, bool) {
+	value, ok := p.p["protocol"].(string)
+	return value, ok
+}
+
 func (p *ListImageStoresParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -661,6 +1319,15 @@ func (p *ListImageStoresParams) SetProvider(v string) {
 	p.p["provider"] = v
 }
 
+func (p *ListImageStoresParams) ResetProvider() {
+	delete(p.p, "provider")
+}
+
+func (p *ListImageStoresParams) GetProvider() (string, bool) {
+	value, ok := p.p["provider"].(string)
+	return value, ok
+}
+
 func (p *ListImageStoresParams) SetReadonly(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -668,6 +1335,15 @@ func (p *ListImageStoresParams) SetReadonly(v bool) {
 	p.p["readonly"] = v
 }
 
+func (p *ListImageStoresParams) ResetReadonly() {
+	delete(p.p, "readonly")
+}
+
+func (p *ListImageStoresParams) GetReadonly() (bool, bool) {
+	value, ok := p.p["readonly"].(bool)
+	return value, ok
+}
+
 func (p *ListImageStoresParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -675,6 +1351,15 @@ func (p *ListImageStoresParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListImageStoresParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListImageStoresParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListImageStoresParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewListImageStoresParams() *ListImageStoresParams {
@@ -685,6 +1370,11 @@ func (s *ImageStoreService) NewListImageStoresParams() *ListImageStoresParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetImageStoreIDWithContext(context.Background(), name, opts...)
+}
+
+// GetImageStoreIDWithContext is the same as GetImageStoreID, but uses ctx to cancel the request
+func (s *ImageStoreService) GetImageStoreIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListImageStoresParams{}
 	p.p = make(map[string]interface{})
 
@@ -696,7 +1386,7 @@ func (s *ImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (st
 		}
 	}
 
-	l, err := s.ListImageStores(p)
+	l, err := s.ListImageStoresWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -721,12 +1411,17 @@ func (s *ImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (st
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetImageStoreByName(name string, opts ...OptionFunc) (*ImageStore, int, error) {
-	id, count, err := s.GetImageStoreID(name, opts...)
+	return s.GetImageStoreByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetImageStoreByNameWithContext is the same as GetImageStoreByName, but uses ctx to cancel the requests
+func (s *ImageStoreService) GetImageStoreByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ImageStore, int, error) {
+	id, count, err := s.GetImageStoreIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetImageStoreByID(id, opts...)
+	r, count, err := s.GetImageStoreByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -735,6 +1430,11 @@ func (s *ImageStoreService) GetImageStoreByName(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetImageStoreByID(id string, opts ...OptionFunc) (*ImageStore, int, error) {
+	return s.GetImageStoreByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetImageStoreByIDWithContext is the same as GetImageStoreByID, but uses ctx to cancel the request
+func (s *ImageStoreService) GetImageStoreByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ImageStore, int, error) {
 	p := &ListImageStoresParams{}
 	p.p = make(map[string]interface{})
 
@@ -746,18 +1446,16 @@ func (s *ImageStoreService) GetImageStoreByID(id string, opts ...OptionFunc) (*I
 		}
 	}
 
-	l, err := s.ListImageStores(p)
+	l, err := s.ListImageStoresWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -768,7 +1466,16 @@ func (s *ImageStoreService) GetImageStoreByID(id string, opts ...OptionFunc) (*I
 
 // Lists image stores.
 func (s *ImageStoreService) ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error) {
-	resp, err := s.cs.newRequest("listImageStores", p.toURLValues())
+	return s.ListImageStoresWithContext(context.Background(), p)
+}
+
+// ListImageStoresWithContext is the same as ListImageStores, but uses ctx to cancel the request and any async job polling
+func (s *ImageStoreService) ListImageStoresWithContext(ctx context.Context, p *ListImageStoresParams) (*ListImageStoresResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listImageStores", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -781,6 +1488,92 @@ func (s *ImageStoreService) ListImageStores(p *ListImageStoresParams) (*ListImag
 	return &r, nil
 }
 
+// ListImageStoresPager iterates over all pages of a ListImageStores call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListImageStoresPager struct {
+	pager
+
+	s *ImageStoreService
+	p *ListImageStoresParams
+	r *ListImageStoresResponse
+}
+
+// NewListImageStoresPager returns a pager for all pages of a ListImageStores call with the given params
+func (s *ImageStoreService) NewListImageStoresPager(p *ListImageStoresParams) *ListImageStoresPager {
+	return &ListImageStoresPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListImageStoresPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListImageStoresPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListImageStoresParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListImageStoresWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.ImageStores), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListImageStoresPager) Page() *ListImageStoresResponse {
+	return pg.r
+}
+
+// ListImageStoresAll fetches all pages of a ListImageStores call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ImageStoreService) ListImageStoresAll(p *ListImageStoresParams) (*ListImageStoresResponse, error) {
+	return s.ListImageStoresAllWithContext(context.Background(), p)
+}
+
+// ListImageStoresAllWithContext is the same as ListImageStoresAll, but uses ctx to cancel the requests
+func (s *ImageStoreService) ListImageStoresAllWithContext(ctx context.Context, p *ListImageStoresParams) (*ListImageStoresResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*ImageStore)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListImageStoresParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListImageStoresWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.ImageStores
+		mu.Unlock()
+
+		return len(l.ImageStores), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListImageStoresResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.ImageStores = append(r.ImageStores, pages[page]...)
+	}
+	r.Count = len(r.ImageStores)
+
+	return r, nil
+}
+
 type ListImageStoresResponse struct {
 	Count       int           `json:"count"`
 	ImageStores []*ImageStore `json:"imagestore"`
@@ -806,7 +1599,8 @@ type ListSecondaryStagingStoresParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListSecondaryStagingStoresParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListSecondaryStagingStoresParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -840,6 +1634,55 @@ func (p *ListSecondaryStagingStoresParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListSecondaryStagingStoresParams) Validate() error {
+	v := newParamValidator("listSecondaryStagingStores", p.p)
+	v.ids("id", "zoneid")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("protocol", 255)
+	v.maxLength("provider", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListSecondaryStagingStoresParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListSecondaryStagingStoresParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword", "name", "protocol", "provider", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listSecondaryStagingStores", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listSecondaryStagingStores: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListSecondaryStagingStoresParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -847,6 +1690,15 @@ func (p *ListSecondaryStagingStoresParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListSecondaryStagingStoresParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListSecondaryStagingStoresParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListSecondaryStagingStoresParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -854,6 +1706,15 @@ func (p *ListSecondaryStagingStoresParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListSecondaryStagingStoresParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListSecondaryStagingStoresParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListSecondaryStagingStoresParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -861,6 +1722,15 @@ func (p *ListSecondaryStagingStoresParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListSecondaryStagingStoresParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListSecondaryStagingStoresParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListSecondaryStagingStoresParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -868,6 +1738,15 @@ func (p *ListSecondaryStagingStoresParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListSecondaryStagingStoresParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListSecondaryStagingStoresParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListSecondaryStagingStoresParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -875,6 +1754,15 @@ func (p *ListSecondaryStagingStoresParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListSecondaryStagingStoresParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListSecondaryStagingStoresParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListSecondaryStagingStoresParams) SetProtocol(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -882,6 +1770,15 @@ func (p *ListSecondaryStagingStoresParams) SetProtocol(v string) {
 	p.p["protocol"] = v
 }
 
+func (p *ListSecondaryStagingStoresParams) ResetProtocol() {
+	delete(p.p, "protocol")
+}
+
+func (p *ListSecondaryStagingStoresParams) GetProtocol() (string, bool) {
+	value, ok := p.p["protocol"].(string)
+	return value, ok
+}
+
 func (p *ListSecondaryStagingStoresParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -889,6 +1786,15 @@ func (p *ListSecondaryStagingStoresParams) SetProvider(v string) {
 	p.p["provider"] = v
 }
 
+func (p *ListSecondaryStagingStoresParams) ResetProvider() {
+	delete(p.p, "provider")
+}
+
+func (p *ListSecondaryStagingStoresParams) GetProvider() (string, bool) {
+	value, ok := p.p["provider"].(string)
+	return value, ok
+}
+
 func (p *ListSecondaryStagingStoresParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -896,6 +1802,15 @@ func (p *ListSecondaryStagingStoresParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListSecondaryStagingStoresParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListSecondaryStagingStoresParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListSecondaryStagingStoresParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewListSecondaryStagingStoresParams() *ListSecondaryStagingStoresParams {
@@ -906,6 +1821,11 @@ func (s *ImageStoreService) NewListSecondaryStagingStoresParams() *ListSecondary
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetSecondaryStagingStoreID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSecondaryStagingStoreIDWithContext(context.Background(), name, opts...)
+}
+
+// GetSecondaryStagingStoreIDWithContext is the same as GetSecondaryStagingStoreID, but uses ctx to cancel the request
+func (s *ImageStoreService) GetSecondaryStagingStoreIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSecondaryStagingStoresParams{}
 	p.p = make(map[string]interface{})
 
@@ -917,7 +1837,7 @@ func (s *ImageStoreService) GetSecondaryStagingStoreID(name string, opts ...Opti
 		}
 	}
 
-	l, err := s.ListSecondaryStagingStores(p)
+	l, err := s.ListSecondaryStagingStoresWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -942,12 +1862,17 @@ func (s *ImageStoreService) GetSecondaryStagingStoreID(name string, opts ...Opti
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetSecondaryStagingStoreByName(name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
-	id, count, err := s.GetSecondaryStagingStoreID(name, opts...)
+	return s.GetSecondaryStagingStoreByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetSecondaryStagingStoreByNameWithContext is the same as GetSecondaryStagingStoreByName, but uses ctx to cancel the requests
+func (s *ImageStoreService) GetSecondaryStagingStoreByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
+	id, count, err := s.GetSecondaryStagingStoreIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetSecondaryStagingStoreByID(id, opts...)
+	r, count, err := s.GetSecondaryStagingStoreByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -956,6 +1881,11 @@ func (s *ImageStoreService) GetSecondaryStagingStoreByName(name string, opts ...
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
+	return s.GetSecondaryStagingStoreByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetSecondaryStagingStoreByIDWithContext is the same as GetSecondaryStagingStoreByID, but uses ctx to cancel the request
+func (s *ImageStoreService) GetSecondaryStagingStoreByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
 	p := &ListSecondaryStagingStoresParams{}
 	p.p = make(map[string]interface{})
 
@@ -967,18 +1897,16 @@ func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...Opti
 		}
 	}
 
-	l, err := s.ListSecondaryStagingStores(p)
+	l, err := s.ListSecondaryStagingStoresWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -989,7 +1917,16 @@ func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...Opti
 
 // Lists secondary staging stores.
 func (s *ImageStoreService) ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
-	resp, err := s.cs.newRequest("listSecondaryStagingStores", p.toURLValues())
+	return s.ListSecondaryStagingStoresWithContext(context.Background(), p)
+}
+
+// ListSecondaryStagingStoresWithContext is the same as ListSecondaryStagingStores, but uses ctx to cancel the request and any async job polling
+func (s *ImageStoreService) ListSecondaryStagingStoresWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listSecondaryStagingStores", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1002,6 +1939,92 @@ func (s *ImageStoreService) ListSecondaryStagingStores(p *ListSecondaryStagingSt
 	return &r, nil
 }
 
+// ListSecondaryStagingStoresPager iterates over all pages of a ListSecondaryStagingStores call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListSecondaryStagingStoresPager struct {
+	pager
+
+	s *ImageStoreService
+	p *ListSecondaryStagingStoresParams
+	r *ListSecondaryStagingStoresResponse
+}
+
+// NewListSecondaryStagingStoresPager returns a pager for all pages of a ListSecondaryStagingStores call with the given params
+func (s *ImageStoreService) NewListSecondaryStagingStoresPager(p *ListSecondaryStagingStoresParams) *ListSecondaryStagingStoresPager {
+	return &ListSecondaryStagingStoresPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListSecondaryStagingStoresPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListSecondaryStagingStoresPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListSecondaryStagingStoresParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListSecondaryStagingStoresWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.SecondaryStagingStores), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListSecondaryStagingStoresPager) Page() *ListSecondaryStagingStoresResponse {
+	return pg.r
+}
+
+// ListSecondaryStagingStoresAll fetches all pages of a ListSecondaryStagingStores call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ImageStoreService) ListSecondaryStagingStoresAll(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
+	return s.ListSecondaryStagingStoresAllWithContext(context.Background(), p)
+}
+
+// ListSecondaryStagingStoresAllWithContext is the same as ListSecondaryStagingStoresAll, but uses ctx to cancel the requests
+func (s *ImageStoreService) ListSecondaryStagingStoresAllWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*SecondaryStagingStore)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListSecondaryStagingStoresParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListSecondaryStagingStoresWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.SecondaryStagingStores
+		mu.Unlock()
+
+		return len(l.SecondaryStagingStores), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListSecondaryStagingStoresResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.SecondaryStagingStores = append(r.SecondaryStagingStores, pages[page]...)
+	}
+	r.Count = len(r.SecondaryStagingStores)
+
+	return r, nil
+}
+
 type ListSecondaryStagingStoresResponse struct {
 	Count                  int                      `json:"count"`
 	SecondaryStagingStores []*SecondaryStagingStore `json:"secondarystagingstore"`
@@ -1023,11 +2046,226 @@ type SecondaryStagingStore struct {
 	Zonename      string `json:"zonename"`
 }
 
+type MigrateSecondaryStorageDataParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *MigrateSecondaryStorageDataParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["destpools"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("destpools", vv)
+	}
+	if v, found := p.p["migrationtype"]; found {
+		u.Set("migrationtype", v.(string))
+	}
+	if v, found := p.p["srcpool"]; found {
+		u.Set("srcpool", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *MigrateSecondaryStorageDataParams) Validate() error {
+	v := newParamValidator("migrateSecondaryStorageData", p.p)
+	v.required("destpools", "srcpool")
+	v.ids("srcpool")
+	v.maxLength("migrationtype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *MigrateSecondaryStorageDataParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *MigrateSecondaryStorageDataParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "destpools":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "migrationtype", "srcpool":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for migrateSecondaryStorageData", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of migrateSecondaryStorageData: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *MigrateSecondaryStorageDataParams) SetDestpools(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["destpools"] = v
+}
+
+func (p *MigrateSecondaryStorageDataParams) ResetDestpools() {
+	delete(p.p, "destpools")
+}
+
+func (p *MigrateSecondaryStorageDataParams) GetDestpools() ([]string, bool) {
+	value, ok := p.p["destpools"].([]string)
+	return value, ok
+}
+
+func (p *MigrateSecondaryStorageDataParams) SetMigrationtype(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["migrationtype"] = v
+}
+
+func (p *MigrateSecondaryStorageDataParams) ResetMigrationtype() {
+	delete(p.p, "migrationtype")
+}
+
+func (p *MigrateSecondaryStorageDataParams) GetMigrationtype() (string, bool) {
+	value, ok := p.p["migrationtype"].(string)
+	return value, ok
+}
+
+func (p *MigrateSecondaryStorageDataParams) SetSrcpool(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["srcpool"] = v
+}
+
+func (p *MigrateSecondaryStorageDataParams) ResetSrcpool() {
+	delete(p.p, "srcpool")
+}
+
+func (p *MigrateSecondaryStorageDataParams) GetSrcpool() (string, bool) {
+	value, ok := p.p["srcpool"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new MigrateSecondaryStorageDataParams instance,
+// as then you are sure you have configured all required params
+func (s *ImageStoreService) NewMigrateSecondaryStorageDataParams(destpools []string, srcpool string) *MigrateSecondaryStorageDataParams {
+	p := &MigrateSecondaryStorageDataParams{}
+	p.p = make(map[string]interface{})
+	p.p["destpools"] = destpools
+	p.p["srcpool"] = srcpool
+	return p
+}
+
+// migrates data objects from one secondary storage to destination image store(s)
+func (s *ImageStoreService) MigrateSecondaryStorageData(p *MigrateSecondaryStorageDataParams) (*MigrateSecondaryStorageDataResponse, error) {
+	return s.MigrateSecondaryStorageDataWithContext(context.Background(), p)
+}
+
+// MigrateSecondaryStorageDataWithContext is the same as MigrateSecondaryStorageData, but uses ctx to cancel the request and any async job polling
+func (s *ImageStoreService) MigrateSecondaryStorageDataWithContext(ctx context.Context, p *MigrateSecondaryStorageDataParams) (*MigrateSecondaryStorageDataResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateSecondaryStorageData", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateSecondaryStorageDataResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// MigrateSecondaryStorageDataAsync starts the async job for MigrateSecondaryStorageData and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a MigrateSecondaryStorageDataResponse using Job.Result
+func (s *ImageStoreService) MigrateSecondaryStorageDataAsync(p *MigrateSecondaryStorageDataParams) (*Job, error) {
+	return s.MigrateSecondaryStorageDataAsyncWithContext(context.Background(), p)
+}
+
+// MigrateSecondaryStorageDataAsyncWithContext is the same as MigrateSecondaryStorageDataAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ImageStoreService) MigrateSecondaryStorageDataAsyncWithContext(ctx context.Context, p *MigrateSecondaryStorageDataParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateSecondaryStorageData", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateSecondaryStorageDataResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type MigrateSecondaryStorageDataResponse struct {
+	JobID         string `json:"jobid"`
+	Jobstatus     int    `json:"jobstatus"`
+	Message       string `json:"message"`
+	Migrationtype string `json:"migrationtype"`
+	Success       bool   `json:"success"`
+}
+
 type UpdateCloudToUseObjectStoreParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateCloudToUseObjectStoreParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateCloudToUseObjectStoreParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1051,6 +2289,54 @@ func (p *UpdateCloudToUseObjectStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateCloudToUseObjectStoreParams) Validate() error {
+	v := newParamValidator("updateCloudToUseObjectStore", p.p)
+	v.required("provider")
+	v.maxLength("name", 255)
+	v.maxLength("provider", 255)
+	v.maxLength("url", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateCloudToUseObjectStoreParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateCloudToUseObjectStoreParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "name", "provider", "url":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateCloudToUseObjectStore", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateCloudToUseObjectStore: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateCloudToUseObjectStoreParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1058,6 +2344,15 @@ func (p *UpdateCloudToUseObjectStoreParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *UpdateCloudToUseObjectStoreParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *UpdateCloudToUseObjectStoreParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1065,6 +2360,15 @@ func (p *UpdateCloudToUseObjectStoreParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateCloudToUseObjectStoreParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateCloudToUseObjectStoreParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1072,6 +2376,15 @@ func (p *UpdateCloudToUseObjectStoreParams) SetProvider(v string) {
 	p.p["provider"] = v
 }
 
+func (p *UpdateCloudToUseObjectStoreParams) ResetProvider() {
+	delete(p.p, "provider")
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) GetProvider() (string, bool) {
+	value, ok := p.p["provider"].(string)
+	return value, ok
+}
+
 func (p *UpdateCloudToUseObjectStoreParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1079,6 +2392,15 @@ func (p *UpdateCloudToUseObjectStoreParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *UpdateCloudToUseObjectStoreParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateCloudToUseObjectStoreParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewUpdateCloudToUseObjectStoreParams(provider string) *UpdateCloudToUseObjectStoreParams {
@@ -1090,7 +2412,16 @@ func (s *ImageStoreService) NewUpdateCloudToUseObjectStoreParams(provider string
 
 // Migrate current NFS secondary storages to use object store.
 func (s *ImageStoreService) UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error) {
-	resp, err := s.cs.newRequest("updateCloudToUseObjectStore", p.toURLValues())
+	return s.UpdateCloudToUseObjectStoreWithContext(context.Background(), p)
+}
+
+// UpdateCloudToUseObjectStoreWithContext is the same as UpdateCloudToUseObjectStore, but uses ctx to cancel the request and any async job polling
+func (s *ImageStoreService) UpdateCloudToUseObjectStoreWithContext(ctx context.Context, p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateCloudToUseObjectStore", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1118,3 +2449,151 @@ type UpdateCloudToUseObjectStoreResponse struct {
 	Zoneid        string `json:"zoneid"`
 	Zonename      string `json:"zonename"`
 }
+
+type UpdateImageStoreParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateImageStoreParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["readonly"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("readonly", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateImageStoreParams) Validate() error {
+	v := newParamValidator("updateImageStore", p.p)
+	v.required("id", "readonly")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateImageStoreParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateImageStoreParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "readonly":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateImageStore", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateImageStore: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *UpdateImageStoreParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *UpdateImageStoreParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateImageStoreParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *UpdateImageStoreParams) SetReadonly(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["readonly"] = v
+}
+
+func (p *UpdateImageStoreParams) ResetReadonly() {
+	delete(p.p, "readonly")
+}
+
+func (p *UpdateImageStoreParams) GetReadonly() (bool, bool) {
+	value, ok := p.p["readonly"].(bool)
+	return value, ok
+}
+
+// You should always use this function to get a new UpdateImageStoreParams instance,
+// as then you are sure you have configured all required params
+func (s *ImageStoreService) NewUpdateImageStoreParams(id string, readonly bool) *UpdateImageStoreParams {
+	p := &UpdateImageStoreParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	p.p["readonly"] = readonly
+	return p
+}
+
+// Updates image store read-only status
+func (s *ImageStoreService) UpdateImageStore(p *UpdateImageStoreParams) (*UpdateImageStoreResponse, error) {
+	return s.UpdateImageStoreWithContext(context.Background(), p)
+}
+
+// UpdateImageStoreWithContext is the same as UpdateImageStore, but uses ctx to cancel the request and any async job polling
+func (s *ImageStoreService) UpdateImageStoreWithContext(ctx context.Context, p *UpdateImageStoreParams) (*UpdateImageStoreResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateImageStore", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateImageStoreResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type UpdateImageStoreResponse struct {
+	Disksizetotal int64  `json:"disksizetotal"`
+	Disksizeused  int64  `json:"disksizeused"`
+	Id            string `json:"id"`
+	JobID         string `json:"jobid"`
+	Jobstatus     int    `json:"jobstatus"`
+	Name          string `json:"name"`
+	Protocol      string `json:"protocol"`
+	Providername  string `json:"providername"`
+	Readonly      bool   `json:"readonly"`
+	Scope         string `json:"scope"`
+	Url           string `json:"url"`
+	Zoneid        string `json:"zoneid"`
+	Zonename      string `json:"zonename"`
+}
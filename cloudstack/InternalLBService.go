@@ -20,18 +20,65 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// InternalLBServiceIface is the interface implemented by InternalLBService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type InternalLBServiceIface interface {
+	NewConfigureInternalLoadBalancerElementParams(enabled bool, id string) *ConfigureInternalLoadBalancerElementParams
+	ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams) (*InternalLoadBalancerElementResponse, error)
+	ConfigureInternalLoadBalancerElementWithContext(ctx context.Context, p *ConfigureInternalLoadBalancerElementParams) (*InternalLoadBalancerElementResponse, error)
+	ConfigureInternalLoadBalancerElementAsync(p *ConfigureInternalLoadBalancerElementParams) (*Job, error)
+	ConfigureInternalLoadBalancerElementAsyncWithContext(ctx context.Context, p *ConfigureInternalLoadBalancerElementParams) (*Job, error)
+	NewCreateInternalLoadBalancerElementParams(nspid string) *CreateInternalLoadBalancerElementParams
+	CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error)
+	CreateInternalLoadBalancerElementWithContext(ctx context.Context, p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error)
+	CreateInternalLoadBalancerElementAsync(p *CreateInternalLoadBalancerElementParams) (*Job, error)
+	CreateInternalLoadBalancerElementAsyncWithContext(ctx context.Context, p *CreateInternalLoadBalancerElementParams) (*Job, error)
+	NewListInternalLoadBalancerElementsParams() *ListInternalLoadBalancerElementsParams
+	GetInternalLoadBalancerElementByID(id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error)
+	GetInternalLoadBalancerElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error)
+	ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error)
+	ListInternalLoadBalancerElementsWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error)
+	NewListInternalLoadBalancerElementsPager(p *ListInternalLoadBalancerElementsParams) *ListInternalLoadBalancerElementsPager
+	ListInternalLoadBalancerElementsAll(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error)
+	ListInternalLoadBalancerElementsAllWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error)
+	NewListInternalLoadBalancerVMsParams() *ListInternalLoadBalancerVMsParams
+	GetInternalLoadBalancerVMID(name string, opts ...OptionFunc) (string, int, error)
+	GetInternalLoadBalancerVMIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetInternalLoadBalancerVMByName(name string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error)
+	GetInternalLoadBalancerVMByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error)
+	GetInternalLoadBalancerVMByID(id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error)
+	GetInternalLoadBalancerVMByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error)
+	ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error)
+	ListInternalLoadBalancerVMsWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error)
+	NewListInternalLoadBalancerVMsPager(p *ListInternalLoadBalancerVMsParams) *ListInternalLoadBalancerVMsPager
+	ListInternalLoadBalancerVMsAll(p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error)
+	ListInternalLoadBalancerVMsAllWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error)
+	NewStartInternalLoadBalancerVMParams(id string) *StartInternalLoadBalancerVMParams
+	StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error)
+	StartInternalLoadBalancerVMWithContext(ctx context.Context, p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error)
+	StartInternalLoadBalancerVMAsync(p *StartInternalLoadBalancerVMParams) (*Job, error)
+	StartInternalLoadBalancerVMAsyncWithContext(ctx context.Context, p *StartInternalLoadBalancerVMParams) (*Job, error)
+	NewStopInternalLoadBalancerVMParams(id string) *StopInternalLoadBalancerVMParams
+	StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error)
+	StopInternalLoadBalancerVMWithContext(ctx context.Context, p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error)
+	StopInternalLoadBalancerVMAsync(p *StopInternalLoadBalancerVMParams) (*Job, error)
+	StopInternalLoadBalancerVMAsyncWithContext(ctx context.Context, p *StopInternalLoadBalancerVMParams) (*Job, error)
+}
+
 type ConfigureInternalLoadBalancerElementParams struct {
 	p map[string]interface{}
 }
 
-func (p *ConfigureInternalLoadBalancerElementParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ConfigureInternalLoadBalancerElementParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -46,6 +93,52 @@ func (p *ConfigureInternalLoadBalancerElementParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ConfigureInternalLoadBalancerElementParams) Validate() error {
+	v := newParamValidator("configureInternalLoadBalancerElement", p.p)
+	v.required("enabled", "id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ConfigureInternalLoadBalancerElementParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ConfigureInternalLoadBalancerElementParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "enabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for configureInternalLoadBalancerElement", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of configureInternalLoadBalancerElement: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ConfigureInternalLoadBalancerElementParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -53,6 +146,15 @@ func (p *ConfigureInternalLoadBalancerElementParams) SetEnabled(v bool) {
 	p.p["enabled"] = v
 }
 
+func (p *ConfigureInternalLoadBalancerElementParams) ResetEnabled() {
+	delete(p.p, "enabled")
+}
+
+func (p *ConfigureInternalLoadBalancerElementParams) GetEnabled() (bool, bool) {
+	value, ok := p.p["enabled"].(bool)
+	return value, ok
+}
+
 func (p *ConfigureInternalLoadBalancerElementParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -60,6 +162,15 @@ func (p *ConfigureInternalLoadBalancerElementParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ConfigureInternalLoadBalancerElementParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ConfigureInternalLoadBalancerElementParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ConfigureInternalLoadBalancerElementParams instance,
 // as then you are sure you have configured all required params
 func (s *InternalLBService) NewConfigureInternalLoadBalancerElementParams(enabled bool, id string) *ConfigureInternalLoadBalancerElementParams {
@@ -72,7 +183,16 @@ func (s *InternalLBService) NewConfigureInternalLoadBalancerElementParams(enable
 
 // Configures an Internal Load Balancer element.
 func (s *InternalLBService) ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams) (*InternalLoadBalancerElementResponse, error) {
-	resp, err := s.cs.newRequest("configureInternalLoadBalancerElement", p.toURLValues())
+	return s.ConfigureInternalLoadBalancerElementWithContext(context.Background(), p)
+}
+
+// ConfigureInternalLoadBalancerElementWithContext is the same as ConfigureInternalLoadBalancerElement, but uses ctx to cancel the request and any async job polling
+func (s *InternalLBService) ConfigureInternalLoadBalancerElementWithContext(ctx context.Context, p *ConfigureInternalLoadBalancerElementParams) (*InternalLoadBalancerElementResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureInternalLoadBalancerElement", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +204,7 @@ func (s *InternalLBService) ConfigureInternalLoadBalancerElement(p *ConfigureInt
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -105,6 +225,40 @@ func (s *InternalLBService) ConfigureInternalLoadBalancerElement(p *ConfigureInt
 	return &r, nil
 }
 
+// ConfigureInternalLoadBalancerElementAsync starts the async job for ConfigureInternalLoadBalancerElement and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a InternalLoadBalancerElementResponse using Job.Result
+func (s *InternalLBService) ConfigureInternalLoadBalancerElementAsync(p *ConfigureInternalLoadBalancerElementParams) (*Job, error) {
+	return s.ConfigureInternalLoadBalancerElementAsyncWithContext(context.Background(), p)
+}
+
+// ConfigureInternalLoadBalancerElementAsyncWithContext is the same as ConfigureInternalLoadBalancerElementAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *InternalLBService) ConfigureInternalLoadBalancerElementAsyncWithContext(ctx context.Context, p *ConfigureInternalLoadBalancerElementParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureInternalLoadBalancerElement", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r InternalLoadBalancerElementResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type InternalLoadBalancerElementResponse struct {
 	Enabled   bool   `json:"enabled"`
 	Id        string `json:"id"`
@@ -117,7 +271,8 @@ type CreateInternalLoadBalancerElementParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateInternalLoadBalancerElementParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateInternalLoadBalancerElementParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -128,6 +283,48 @@ func (p *CreateInternalLoadBalancerElementParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateInternalLoadBalancerElementParams) Validate() error {
+	v := newParamValidator("createInternalLoadBalancerElement", p.p)
+	v.required("nspid")
+	v.ids("nspid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateInternalLoadBalancerElementParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateInternalLoadBalancerElementParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "nspid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createInternalLoadBalancerElement", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createInternalLoadBalancerElement: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateInternalLoadBalancerElementParams) SetNspid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -135,6 +332,15 @@ func (p *CreateInternalLoadBalancerElementParams) SetNspid(v string) {
 	p.p["nspid"] = v
 }
 
+func (p *CreateInternalLoadBalancerElementParams) ResetNspid() {
+	delete(p.p, "nspid")
+}
+
+func (p *CreateInternalLoadBalancerElementParams) GetNspid() (string, bool) {
+	value, ok := p.p["nspid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateInternalLoadBalancerElementParams instance,
 // as then you are sure you have configured all required params
 func (s *InternalLBService) NewCreateInternalLoadBalancerElementParams(nspid string) *CreateInternalLoadBalancerElementParams {
@@ -146,7 +352,16 @@ func (s *InternalLBService) NewCreateInternalLoadBalancerElementParams(nspid str
 
 // Create an Internal Load Balancer element.
 func (s *InternalLBService) CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error) {
-	resp, err := s.cs.newRequest("createInternalLoadBalancerElement", p.toURLValues())
+	return s.CreateInternalLoadBalancerElementWithContext(context.Background(), p)
+}
+
+// CreateInternalLoadBalancerElementWithContext is the same as CreateInternalLoadBalancerElement, but uses ctx to cancel the request and any async job polling
+func (s *InternalLBService) CreateInternalLoadBalancerElementWithContext(ctx context.Context, p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createInternalLoadBalancerElement", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +373,7 @@ func (s *InternalLBService) CreateInternalLoadBalancerElement(p *CreateInternalL
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -179,6 +394,40 @@ func (s *InternalLBService) CreateInternalLoadBalancerElement(p *CreateInternalL
 	return &r, nil
 }
 
+// CreateInternalLoadBalancerElementAsync starts the async job for CreateInternalLoadBalancerElement and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateInternalLoadBalancerElementResponse using Job.Result
+func (s *InternalLBService) CreateInternalLoadBalancerElementAsync(p *CreateInternalLoadBalancerElementParams) (*Job, error) {
+	return s.CreateInternalLoadBalancerElementAsyncWithContext(context.Background(), p)
+}
+
+// CreateInternalLoadBalancerElementAsyncWithContext is the same as CreateInternalLoadBalancerElementAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *InternalLBService) CreateInternalLoadBalancerElementAsyncWithContext(ctx context.Context, p *CreateInternalLoadBalancerElementParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createInternalLoadBalancerElement", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateInternalLoadBalancerElementResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateInternalLoadBalancerElementResponse struct {
 	Enabled   bool   `json:"enabled"`
 	Id        string `json:"id"`
@@ -191,7 +440,8 @@ type ListInternalLoadBalancerElementsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListInternalLoadBalancerElementsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListInternalLoadBalancerElementsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -220,6 +470,56 @@ func (p *ListInternalLoadBalancerElementsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListInternalLoadBalancerElementsParams) Validate() error {
+	v := newParamValidator("listInternalLoadBalancerElements", p.p)
+	v.ids("id", "nspid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListInternalLoadBalancerElementsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListInternalLoadBalancerElementsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "enabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "keyword", "nspid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listInternalLoadBalancerElements", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listInternalLoadBalancerElements: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListInternalLoadBalancerElementsParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -227,6 +527,15 @@ func (p *ListInternalLoadBalancerElementsParams) SetEnabled(v bool) {
 	p.p["enabled"] = v
 }
 
+func (p *ListInternalLoadBalancerElementsParams) ResetEnabled() {
+	delete(p.p, "enabled")
+}
+
+func (p *ListInternalLoadBalancerElementsParams) GetEnabled() (bool, bool) {
+	value, ok := p.p["enabled"].(bool)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerElementsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -234,6 +543,15 @@ func (p *ListInternalLoadBalancerElementsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListInternalLoadBalancerElementsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListInternalLoadBalancerElementsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerElementsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -241,6 +559,15 @@ func (p *ListInternalLoadBalancerElementsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListInternalLoadBalancerElementsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListInternalLoadBalancerElementsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerElementsParams) SetNspid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -248,6 +575,15 @@ func (p *ListInternalLoadBalancerElementsParams) SetNspid(v string) {
 	p.p["nspid"] = v
 }
 
+func (p *ListInternalLoadBalancerElementsParams) ResetNspid() {
+	delete(p.p, "nspid")
+}
+
+func (p *ListInternalLoadBalancerElementsParams) GetNspid() (string, bool) {
+	value, ok := p.p["nspid"].(string)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerElementsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -255,6 +591,15 @@ func (p *ListInternalLoadBalancerElementsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListInternalLoadBalancerElementsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListInternalLoadBalancerElementsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerElementsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -262,6 +607,15 @@ func (p *ListInternalLoadBalancerElementsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListInternalLoadBalancerElementsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListInternalLoadBalancerElementsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListInternalLoadBalancerElementsParams instance,
 // as then you are sure you have configured all required params
 func (s *InternalLBService) NewListInternalLoadBalancerElementsParams() *ListInternalLoadBalancerElementsParams {
@@ -272,6 +626,11 @@ func (s *InternalLBService) NewListInternalLoadBalancerElementsParams() *ListInt
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InternalLBService) GetInternalLoadBalancerElementByID(id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error) {
+	return s.GetInternalLoadBalancerElementByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetInternalLoadBalancerElementByIDWithContext is the same as GetInternalLoadBalancerElementByID, but uses ctx to cancel the request
+func (s *InternalLBService) GetInternalLoadBalancerElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error) {
 	p := &ListInternalLoadBalancerElementsParams{}
 	p.p = make(map[string]interface{})
 
@@ -283,18 +642,16 @@ func (s *InternalLBService) GetInternalLoadBalancerElementByID(id string, opts .
 		}
 	}
 
-	l, err := s.ListInternalLoadBalancerElements(p)
+	l, err := s.ListInternalLoadBalancerElementsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -305,7 +662,16 @@ func (s *InternalLBService) GetInternalLoadBalancerElementByID(id string, opts .
 
 // Lists all available Internal Load Balancer elements.
 func (s *InternalLBService) ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error) {
-	resp, err := s.cs.newRequest("listInternalLoadBalancerElements", p.toURLValues())
+	return s.ListInternalLoadBalancerElementsWithContext(context.Background(), p)
+}
+
+// ListInternalLoadBalancerElementsWithContext is the same as ListInternalLoadBalancerElements, but uses ctx to cancel the request and any async job polling
+func (s *InternalLBService) ListInternalLoadBalancerElementsWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listInternalLoadBalancerElements", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -318,6 +684,92 @@ func (s *InternalLBService) ListInternalLoadBalancerElements(p *ListInternalLoad
 	return &r, nil
 }
 
+// ListInternalLoadBalancerElementsPager iterates over all pages of a ListInternalLoadBalancerElements call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListInternalLoadBalancerElementsPager struct {
+	pager
+
+	s *InternalLBService
+	p *ListInternalLoadBalancerElementsParams
+	r *ListInternalLoadBalancerElementsResponse
+}
+
+// NewListInternalLoadBalancerElementsPager returns a pager for all pages of a ListInternalLoadBalancerElements call with the given params
+func (s *InternalLBService) NewListInternalLoadBalancerElementsPager(p *ListInternalLoadBalancerElementsParams) *ListInternalLoadBalancerElementsPager {
+	return &ListInternalLoadBalancerElementsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListInternalLoadBalancerElementsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListInternalLoadBalancerElementsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListInternalLoadBalancerElementsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListInternalLoadBalancerElementsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.InternalLoadBalancerElements), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListInternalLoadBalancerElementsPager) Page() *ListInternalLoadBalancerElementsResponse {
+	return pg.r
+}
+
+// ListInternalLoadBalancerElementsAll fetches all pages of a ListInternalLoadBalancerElements call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *InternalLBService) ListInternalLoadBalancerElementsAll(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error) {
+	return s.ListInternalLoadBalancerElementsAllWithContext(context.Background(), p)
+}
+
+// ListInternalLoadBalancerElementsAllWithContext is the same as ListInternalLoadBalancerElementsAll, but uses ctx to cancel the requests
+func (s *InternalLBService) ListInternalLoadBalancerElementsAllWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*InternalLoadBalancerElement)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListInternalLoadBalancerElementsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListInternalLoadBalancerElementsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.InternalLoadBalancerElements
+		mu.Unlock()
+
+		return len(l.InternalLoadBalancerElements), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListInternalLoadBalancerElementsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.InternalLoadBalancerElements = append(r.InternalLoadBalancerElements, pages[page]...)
+	}
+	r.Count = len(r.InternalLoadBalancerElements)
+
+	return r, nil
+}
+
 type ListInternalLoadBalancerElementsResponse struct {
 	Count                        int                            `json:"count"`
 	InternalLoadBalancerElements []*InternalLoadBalancerElement `json:"internalloadbalancerelement"`
@@ -335,7 +787,8 @@ type ListInternalLoadBalancerVMsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListInternalLoadBalancerVMsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListInternalLoadBalancerVMsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -403,6 +856,59 @@ func (p *ListInternalLoadBalancerVMsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListInternalLoadBalancerVMsParams) Validate() error {
+	v := newParamValidator("listInternalLoadBalancerVMs", p.p)
+	v.ids("domainid", "hostid", "id", "networkid", "podid", "projectid", "vpcid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListInternalLoadBalancerVMsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListInternalLoadBalancerVMsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "hostid", "id", "keyword", "name", "networkid", "podid", "projectid", "state", "vpcid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fetchhealthcheckresults", "forvpc", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listInternalLoadBalancerVMs", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listInternalLoadBalancerVMs: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -410,6 +916,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -417,6 +932,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetFetchhealthcheckresults(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -424,6 +948,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetFetchhealthcheckresults(v bool) {
 	p.p["fetchhealthcheckresults"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetFetchhealthcheckresults() {
+	delete(p.p, "fetchhealthcheckresults")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetFetchhealthcheckresults() (bool, bool) {
+	value, ok := p.p["fetchhealthcheckresults"].(bool)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetForvpc(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -431,6 +964,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetForvpc(v bool) {
 	p.p["forvpc"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetForvpc() {
+	delete(p.p, "forvpc")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetForvpc() (bool, bool) {
+	value, ok := p.p["forvpc"].(bool)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -438,6 +980,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -445,6 +996,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -452,6 +1012,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -459,6 +1028,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -466,6 +1044,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -473,6 +1060,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -480,6 +1076,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -487,6 +1092,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -494,6 +1108,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -501,6 +1124,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -508,6 +1140,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -515,6 +1156,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -522,6 +1172,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -529,6 +1188,15 @@ func (p *ListInternalLoadBalancerVMsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListInternalLoadBalancerVMsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListInternalLoadBalancerVMsParams instance,
 // as then you are sure you have configured all required params
 func (s *InternalLBService) NewListInternalLoadBalancerVMsParams() *ListInternalLoadBalancerVMsParams {
@@ -539,6 +1207,11 @@ func (s *InternalLBService) NewListInternalLoadBalancerVMsParams() *ListInternal
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InternalLBService) GetInternalLoadBalancerVMID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetInternalLoadBalancerVMIDWithContext(context.Background(), name, opts...)
+}
+
+// GetInternalLoadBalancerVMIDWithContext is the same as GetInternalLoadBalancerVMID, but uses ctx to cancel the request
+func (s *InternalLBService) GetInternalLoadBalancerVMIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListInternalLoadBalancerVMsParams{}
 	p.p = make(map[string]interface{})
 
@@ -550,7 +1223,7 @@ func (s *InternalLBService) GetInternalLoadBalancerVMID(name string, opts ...Opt
 		}
 	}
 
-	l, err := s.ListInternalLoadBalancerVMs(p)
+	l, err := s.ListInternalLoadBalancerVMsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -575,12 +1248,17 @@ func (s *InternalLBService) GetInternalLoadBalancerVMID(name string, opts ...Opt
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InternalLBService) GetInternalLoadBalancerVMByName(name string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
-	id, count, err := s.GetInternalLoadBalancerVMID(name, opts...)
+	return s.GetInternalLoadBalancerVMByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetInternalLoadBalancerVMByNameWithContext is the same as GetInternalLoadBalancerVMByName, but uses ctx to cancel the requests
+func (s *InternalLBService) GetInternalLoadBalancerVMByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
+	id, count, err := s.GetInternalLoadBalancerVMIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetInternalLoadBalancerVMByID(id, opts...)
+	r, count, err := s.GetInternalLoadBalancerVMByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -589,6 +1267,11 @@ func (s *InternalLBService) GetInternalLoadBalancerVMByName(name string, opts ..
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InternalLBService) GetInternalLoadBalancerVMByID(id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
+	return s.GetInternalLoadBalancerVMByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetInternalLoadBalancerVMByIDWithContext is the same as GetInternalLoadBalancerVMByID, but uses ctx to cancel the request
+func (s *InternalLBService) GetInternalLoadBalancerVMByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
 	p := &ListInternalLoadBalancerVMsParams{}
 	p.p = make(map[string]interface{})
 
@@ -600,18 +1283,16 @@ func (s *InternalLBService) GetInternalLoadBalancerVMByID(id string, opts ...Opt
 		}
 	}
 
-	l, err := s.ListInternalLoadBalancerVMs(p)
+	l, err := s.ListInternalLoadBalancerVMsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -622,7 +1303,16 @@ func (s *InternalLBService) GetInternalLoadBalancerVMByID(id string, opts ...Opt
 
 // List internal LB VMs.
 func (s *InternalLBService) ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error) {
-	resp, err := s.cs.newRequest("listInternalLoadBalancerVMs", p.toURLValues())
+	return s.ListInternalLoadBalancerVMsWithContext(context.Background(), p)
+}
+
+// ListInternalLoadBalancerVMsWithContext is the same as ListInternalLoadBalancerVMs, but uses ctx to cancel the request and any async job polling
+func (s *InternalLBService) ListInternalLoadBalancerVMsWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listInternalLoadBalancerVMs", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -635,6 +1325,92 @@ func (s *InternalLBService) ListInternalLoadBalancerVMs(p *ListInternalLoadBalan
 	return &r, nil
 }
 
+// ListInternalLoadBalancerVMsPager iterates over all pages of a ListInternalLoadBalancerVMs call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListInternalLoadBalancerVMsPager struct {
+	pager
+
+	s *InternalLBService
+	p *ListInternalLoadBalancerVMsParams
+	r *ListInternalLoadBalancerVMsResponse
+}
+
+// NewListInternalLoadBalancerVMsPager returns a pager for all pages of a ListInternalLoadBalancerVMs call with the given params
+func (s *InternalLBService) NewListInternalLoadBalancerVMsPager(p *ListInternalLoadBalancerVMsParams) *ListInternalLoadBalancerVMsPager {
+	return &ListInternalLoadBalancerVMsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListInternalLoadBalancerVMsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListInternalLoadBalancerVMsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListInternalLoadBalancerVMsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListInternalLoadBalancerVMsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.InternalLoadBalancerVMs), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListInternalLoadBalancerVMsPager) Page() *ListInternalLoadBalancerVMsResponse {
+	return pg.r
+}
+
+// ListInternalLoadBalancerVMsAll fetches all pages of a ListInternalLoadBalancerVMs call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *InternalLBService) ListInternalLoadBalancerVMsAll(p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error) {
+	return s.ListInternalLoadBalancerVMsAllWithContext(context.Background(), p)
+}
+
+// ListInternalLoadBalancerVMsAllWithContext is the same as ListInternalLoadBalancerVMsAll, but uses ctx to cancel the requests
+func (s *InternalLBService) ListInternalLoadBalancerVMsAllWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*InternalLoadBalancerVM)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListInternalLoadBalancerVMsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListInternalLoadBalancerVMsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.InternalLoadBalancerVMs
+		mu.Unlock()
+
+		return len(l.InternalLoadBalancerVMs), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListInternalLoadBalancerVMsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.InternalLoadBalancerVMs = append(r.InternalLoadBalancerVMs, pages[page]...)
+	}
+	r.Count = len(r.InternalLoadBalancerVMs)
+
+	return r, nil
+}
+
 type ListInternalLoadBalancerVMsResponse struct {
 	Count                   int                       `json:"count"`
 	InternalLoadBalancerVMs []*InternalLoadBalancerVM `json:"internalloadbalancervm"`
@@ -707,7 +1483,8 @@ type StartInternalLoadBalancerVMParams struct {
 	p map[string]interface{}
 }
 
-func (p *StartInternalLoadBalancerVMParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *StartInternalLoadBalancerVMParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -718,6 +1495,48 @@ func (p *StartInternalLoadBalancerVMParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *StartInternalLoadBalancerVMParams) Validate() error {
+	v := newParamValidator("startInternalLoadBalancerVM", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *StartInternalLoadBalancerVMParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *StartInternalLoadBalancerVMParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for startInternalLoadBalancerVM", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of startInternalLoadBalancerVM: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *StartInternalLoadBalancerVMParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -725,6 +1544,15 @@ func (p *StartInternalLoadBalancerVMParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *StartInternalLoadBalancerVMParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *StartInternalLoadBalancerVMParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new StartInternalLoadBalancerVMParams instance,
 // as then you are sure you have configured all required params
 func (s *InternalLBService) NewStartInternalLoadBalancerVMParams(id string) *StartInternalLoadBalancerVMParams {
@@ -736,7 +1564,16 @@ func (s *InternalLBService) NewStartInternalLoadBalancerVMParams(id string) *Sta
 
 // Starts an existing internal lb vm.
 func (s *InternalLBService) StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error) {
-	resp, err := s.cs.newRequest("startInternalLoadBalancerVM", p.toURLValues())
+	return s.StartInternalLoadBalancerVMWithContext(context.Background(), p)
+}
+
+// StartInternalLoadBalancerVMWithContext is the same as StartInternalLoadBalancerVM, but uses ctx to cancel the request and any async job polling
+func (s *InternalLBService) StartInternalLoadBalancerVMWithContext(ctx context.Context, p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "startInternalLoadBalancerVM", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -748,7 +1585,7 @@ func (s *InternalLBService) StartInternalLoadBalancerVM(p *StartInternalLoadBala
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -769,6 +1606,40 @@ func (s *InternalLBService) StartInternalLoadBalancerVM(p *StartInternalLoadBala
 	return &r, nil
 }
 
+// StartInternalLoadBalancerVMAsync starts the async job for StartInternalLoadBalancerVM and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a StartInternalLoadBalancerVMResponse using Job.Result
+func (s *InternalLBService) StartInternalLoadBalancerVMAsync(p *StartInternalLoadBalancerVMParams) (*Job, error) {
+	return s.StartInternalLoadBalancerVMAsyncWithContext(context.Background(), p)
+}
+
+// StartInternalLoadBalancerVMAsyncWithContext is the same as StartInternalLoadBalancerVMAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *InternalLBService) StartInternalLoadBalancerVMAsyncWithContext(ctx context.Context, p *StartInternalLoadBalancerVMParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "startInternalLoadBalancerVM", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StartInternalLoadBalancerVMResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type StartInternalLoadBalancerVMResponse struct {
 	Account             string                                                  `json:"account"`
 	Created             string                                                  `json:"created"`
@@ -836,7 +1707,8 @@ type StopInternalLoadBalancerVMParams struct {
 	p map[string]interface{}
 }
 
-func (p *StopInternalLoadBalancerVMParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *StopInternalLoadBalancerVMParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -851,6 +1723,52 @@ func (p *StopInternalLoadBalancerVMParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *StopInternalLoadBalancerVMParams) Validate() error {
+	v := newParamValidator("stopInternalLoadBalancerVM", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *StopInternalLoadBalancerVMParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *StopInternalLoadBalancerVMParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "forced":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for stopInternalLoadBalancerVM", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of stopInternalLoadBalancerVM: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *StopInternalLoadBalancerVMParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -858,6 +1776,15 @@ func (p *StopInternalLoadBalancerVMParams) SetForced(v bool) {
 	p.p["forced"] = v
 }
 
+func (p *StopInternalLoadBalancerVMParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *StopInternalLoadBalancerVMParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
 func (p *StopInternalLoadBalancerVMParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -865,6 +1792,15 @@ func (p *StopInternalLoadBalancerVMParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *StopInternalLoadBalancerVMParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *StopInternalLoadBalancerVMParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new StopInternalLoadBalancerVMParams instance,
 // as then you are sure you have configured all required params
 func (s *InternalLBService) NewStopInternalLoadBalancerVMParams(id string) *StopInternalLoadBalancerVMParams {
@@ -876,7 +1812,16 @@ func (s *InternalLBService) NewStopInternalLoadBalancerVMParams(id string) *Stop
 
 // Stops an Internal LB vm.
 func (s *InternalLBService) StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error) {
-	resp, err := s.cs.newRequest("stopInternalLoadBalancerVM", p.toURLValues())
+	return s.StopInternalLoadBalancerVMWithContext(context.Background(), p)
+}
+
+// StopInternalLoadBalancerVMWithContext is the same as StopInternalLoadBalancerVM, but uses ctx to cancel the request and any async job polling
+func (s *InternalLBService) StopInternalLoadBalancerVMWithContext(ctx context.Context, p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "stopInternalLoadBalancerVM", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -888,7 +1833,7 @@ func (s *InternalLBService) StopInternalLoadBalancerVM(p *StopInternalLoadBalanc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -909,6 +1854,40 @@ func (s *InternalLBService) StopInternalLoadBalancerVM(p *StopInternalLoadBalanc
 	return &r, nil
 }
 
+// StopInternalLoadBalancerVMAsync starts the async job for StopInternalLoadBalancerVM and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a StopInternalLoadBalancerVMResponse using Job.Result
+func (s *InternalLBService) StopInternalLoadBalancerVMAsync(p *StopInternalLoadBalancerVMParams) (*Job, error) {
+	return s.StopInternalLoadBalancerVMAsyncWithContext(context.Background(), p)
+}
+
+// StopInternalLoadBalancerVMAsyncWithContext is the same as StopInternalLoadBalancerVMAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *InternalLBService) StopInternalLoadBalancerVMAsyncWithContext(ctx context.Context, p *StopInternalLoadBalancerVMParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "stopInternalLoadBalancerVM", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StopInternalLoadBalancerVMResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type StopInternalLoadBalancerVMResponse struct {
 	Account             string                                                 `json:"account"`
 	Created             string                                                 `json:"created"`
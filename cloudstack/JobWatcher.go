@@ -0,0 +1,406 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// The layout of the `startdate` parameter of listAsyncJobs
+const asyncJobDateLayout = "2006-01-02T15:04:05-0700"
+
+// JobWatcherOption can be passed to NewJobWatcher to set custom options
+type JobWatcherOption func(*JobWatcher)
+
+// WithPollInterval sets the backoff used between two polls of the watched jobs. The first poll
+// happens after min, and every next poll waits min longer than the previous one until max is
+// reached. The defaults are 1 and 15 seconds, which is the same as used by GetAsyncJobResult.
+func WithPollInterval(min, max time.Duration) JobWatcherOption {
+	return func(w *JobWatcher) {
+		if min > 0 {
+			w.minInterval = min
+		}
+		if max >= w.minInterval {
+			w.maxInterval = max
+		}
+	}
+}
+
+// WithPageSize sets the page size used when listing the async jobs; defaults to 500
+func WithPageSize(size int) JobWatcherOption {
+	return func(w *JobWatcher) {
+		if size > 0 {
+			w.pageSize = size
+		}
+	}
+}
+
+// JobResult is send by a JobWatcher once a watched job is finished
+type JobResult struct {
+	Job *AsyncJob // The finished job
+	Err error     // Set when the status of the job could not be retrieved
+}
+
+// JobWatcher tracks any number of async jobs using a single listAsyncJobs call per poll, instead
+// of polling each job separately with queryAsyncJobResult. Only while there are jobs being watched
+// a goroutine is running to poll CloudStack, so a JobWatcher only needs to be stopped to interrupt
+// the jobs that are still being watched.
+type JobWatcher struct {
+	cs          *CloudStackClient
+	minInterval time.Duration
+	maxInterval time.Duration
+	pageSize    int
+
+	ctx  context.Context // Used for all the polls, done once the watcher is stopped
+	stop context.CancelFunc
+
+	mu      sync.Mutex
+	jobs    map[string]*watchedJob
+	running bool   // True while the poll goroutine is running
+	reset   bool   // True if the backoff should be reset
+	cancel  func() // Cancels the listAsyncJobs call in flight, if any
+}
+
+// A job that is being watched by one or more callers
+type watchedJob struct {
+	chs    []chan *JobResult
+	added  time.Time          // The time the job was added, close to its creation time
+	ctx    context.Context    // Used to poll the job, done once nobody watches the job anymore
+	cancel context.CancelFunc // Cancels ctx
+}
+
+// valuesContext has the values of one context, and the deadline and cancellation of another
+type valuesContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c valuesContext) Value(key interface{}) interface{} {
+	return c.values.Value(key)
+}
+
+// NewJobWatcher returns a new JobWatcher that uses cs to poll for the status of the watched jobs
+func NewJobWatcher(cs *CloudStackClient, opts ...JobWatcherOption) *JobWatcher {
+	ctx, stop := context.WithCancel(context.Background())
+
+	w := &JobWatcher{
+		cs:          cs,
+		minInterval: 1 * time.Second,
+		maxInterval: 15 * time.Second,
+		pageSize:    500,
+		ctx:         ctx,
+		stop:        stop,
+		jobs:        make(map[string]*watchedJob),
+	}
+
+	for _, fn := range opts {
+		fn(w)
+	}
+
+	return w
+}
+
+// WithJobWatcher makes the CloudStackClient use a JobWatcher when waiting for async jobs, so
+// all the jobs that the client is waiting for are polled together.
+func WithJobWatcher(opts ...JobWatcherOption) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.watcher = NewJobWatcher(cs, opts...)
+	}
+}
+
+// Watch starts watching the job with the given ID. The returned channel receives a single result
+// once the job is finished. Use Unwatch to stop watching the job before it is finished.
+func (w *JobWatcher) Watch(jobid string) <-chan *JobResult {
+	return w.WatchWithContext(context.Background(), jobid)
+}
+
+// WatchWithContext is the same as Watch, but the values of ctx (like trace spans) are passed on to
+// the polls of the job. When multiple callers watch the same job, the first one's ctx is used. Note
+// that ctx being done doesn't stop watching the job, so call Unwatch for that.
+func (w *JobWatcher) WatchWithContext(ctx context.Context, jobid string) <-chan *JobResult {
+	ch := make(chan *JobResult, 1)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err := w.ctx.Err(); err != nil {
+		ch <- &JobResult{Job: &AsyncJob{JobID: jobid}, Err: err}
+		return ch
+	}
+
+	j, ok := w.jobs[jobid]
+	if !ok {
+		jctx, cancel := context.WithCancel(w.ctx)
+		j = &watchedJob{added: time.Now(), ctx: valuesContext{Context: jctx, values: ctx}, cancel: cancel}
+		w.jobs[jobid] = j
+	}
+	j.chs = append(j.chs, ch)
+	w.reset = true
+
+	if !w.running {
+		w.running = true
+		go w.run()
+	}
+
+	return ch
+}
+
+// Unwatch stops watching a job for the given channel, as returned by Watch. Once nobody is
+// watching the job anymore, any request in flight for the job is cancelled.
+func (w *JobWatcher) Unwatch(jobid string, ch <-chan *JobResult) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	j, ok := w.jobs[jobid]
+	if !ok {
+		return
+	}
+
+	for i, c := range j.chs {
+		if c == ch {
+			j.chs = append(j.chs[:i], j.chs[i+1:]...)
+			break
+		}
+	}
+
+	if len(j.chs) == 0 {
+		delete(w.jobs, jobid)
+		j.cancel()
+	}
+	if len(w.jobs) == 0 && w.cancel != nil {
+		w.cancel()
+	}
+}
+
+// Stop stops polling, cancels any request in flight and sends context.Canceled to everyone that is
+// still watching a job. Jobs that are watched after the watcher is stopped fail immediately.
+func (w *JobWatcher) Stop() {
+	w.stop()
+
+	w.mu.Lock()
+	jobs := w.jobs
+	w.jobs = make(map[string]*watchedJob)
+	w.mu.Unlock()
+
+	for id, j := range jobs {
+		j.cancel()
+		for _, ch := range j.chs {
+			ch <- &JobResult{Job: &AsyncJob{JobID: id}, Err: context.Canceled}
+		}
+	}
+}
+
+// Wait watches the job with the given ID until it is finished, ctx is done or the timeout (in
+// seconds) is reached. It returns the same results as GetAsyncJobResult. A timeout of zero or less
+// polls the job once, and returns AsyncTimeoutErr if it is not finished yet.
+func (w *JobWatcher) Wait(ctx context.Context, jobid string, timeout int64) (json.RawMessage, error) {
+	if timeout <= 0 {
+		pctx := w.cs.traceJobPollStart(ctx, jobid)
+		r, err := w.cs.Asyncjob.QueryAsyncJobResultWithContext(pctx, w.cs.Asyncjob.NewQueryAsyncJobResultParams(jobid))
+		if err != nil {
+			w.cs.traceJobPollDone(pctx, &JobPollInfo{JobID: jobid, Err: err})
+			return nil, err
+		}
+		w.cs.traceJobPollDone(pctx, &JobPollInfo{JobID: jobid, Status: r.Jobstatus})
+
+		switch r.Jobstatus {
+		case 1:
+			return r.Jobresult, nil
+		case 2:
+			return nil, newAsyncJobError(r)
+		default:
+			return nil, AsyncTimeoutErr
+		}
+	}
+
+	ch := w.WatchWithContext(ctx, jobid)
+	defer w.Unwatch(jobid, ch)
+
+	t := time.NewTimer(time.Duration(timeout) * time.Second)
+	defer t.Stop()
+
+	select {
+	case r := <-ch:
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		if r.Job.Jobstatus == 2 {
+			return nil, newAsyncJobError((*QueryAsyncJobResultResponse)(r.Job))
+		}
+		return r.Job.Jobresult, nil
+	case <-t.C:
+		return nil, AsyncTimeoutErr
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (w *JobWatcher) run() {
+	interval := w.minInterval
+
+	for {
+		if err := sleepWithContext(w.ctx, interval); err != nil {
+			w.mu.Lock()
+			w.running = false
+			w.mu.Unlock()
+			return
+		}
+
+		w.mu.Lock()
+		if len(w.jobs) == 0 {
+			w.running = false
+			w.mu.Unlock()
+			return
+		}
+		// List the jobs started since the oldest job that is still being watched
+		jobs := make(map[string]context.Context, len(w.jobs))
+		since := time.Now()
+		for id, j := range w.jobs {
+			jobs[id] = j.ctx
+			if j.added.Before(since) {
+				since = j.added
+			}
+		}
+		ctx, cancel := context.WithCancel(w.ctx)
+		w.cancel = cancel
+		w.mu.Unlock()
+
+		results := w.poll(ctx, jobs, since)
+
+		w.mu.Lock()
+		w.cancel = nil
+		w.mu.Unlock()
+		cancel()
+
+		for _, r := range results {
+			w.deliver(r)
+		}
+
+		w.mu.Lock()
+		if w.reset {
+			interval = w.minInterval
+			w.reset = false
+		} else if interval < w.maxInterval {
+			interval += w.minInterval
+			if interval > w.maxInterval {
+				interval = w.maxInterval
+			}
+		}
+		w.mu.Unlock()
+	}
+}
+
+// Returns the results of all finished jobs with one of the given IDs. The jobs are listed using
+// ctx, and every job that has to be queried separately is queried using its own context. Every job
+// is traced as a separate poll, even though most jobs are polled using a single list.
+func (w *JobWatcher) poll(ctx context.Context, jobs map[string]context.Context, since time.Time) []*JobResult {
+	var results []*JobResult
+	seen := make(map[string]bool, len(jobs))
+
+	polls := make(map[string]context.Context, len(jobs))
+	infos := make(map[string]*JobPollInfo, len(jobs))
+	for id, jctx := range jobs {
+		polls[id] = w.cs.traceJobPollStart(jctx, id)
+		infos[id] = &JobPollInfo{JobID: id}
+	}
+	defer func() {
+		for id, pctx := range polls {
+			w.cs.traceJobPollDone(pctx, infos[id])
+		}
+	}()
+
+	// Subtract a few minutes to allow for some clock skew between us and CloudStack
+	p := w.cs.Asyncjob.NewListAsyncJobsParams()
+	p.SetStartdate(since.Add(-5 * time.Minute).Format(asyncJobDateLayout))
+	p.SetPagesize(w.pageSize)
+
+	for page := 1; ; page++ {
+		p.SetPage(page)
+
+		l, err := w.cs.Asyncjob.ListAsyncJobsWithContext(ctx, p)
+		if err != nil {
+			// Fall back to querying the jobs one by one
+			break
+		}
+
+		for _, j := range l.AsyncJobs {
+			if _, ok := jobs[j.JobID]; !ok || seen[j.JobID] {
+				continue
+			}
+			seen[j.JobID] = true
+			infos[j.JobID].Status = j.Jobstatus
+
+			if j.Jobstatus != 0 {
+				results = append(results, &JobResult{Job: j})
+			}
+		}
+
+		if len(seen) == len(jobs) || len(l.AsyncJobs) < w.pageSize || page*w.pageSize >= l.Count {
+			break
+		}
+	}
+
+	// Query any job that was not part of the list separately
+	for id, pctx := range polls {
+		if seen[id] {
+			continue
+		}
+		if err := pctx.Err(); err != nil {
+			infos[id].Err = err
+			continue
+		}
+
+		r, err := w.cs.Asyncjob.QueryAsyncJobResultWithContext(pctx, w.cs.Asyncjob.NewQueryAsyncJobResultParams(id))
+		if err != nil {
+			infos[id].Err = err
+			// Temporary errors are retried during the next poll
+			if IsNotFound(err) || IsPermissionDenied(err) {
+				results = append(results, &JobResult{Job: &AsyncJob{JobID: id}, Err: err})
+			}
+			continue
+		}
+
+		infos[id].Status = r.Jobstatus
+		if r.Jobstatus != 0 {
+			results = append(results, &JobResult{Job: (*AsyncJob)(r)})
+		}
+	}
+
+	return results
+}
+
+func (w *JobWatcher) deliver(r *JobResult) {
+	w.mu.Lock()
+	j, ok := w.jobs[r.Job.JobID]
+	delete(w.jobs, r.Job.JobID)
+	w.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	j.cancel()
+	for _, ch := range j.chs {
+		ch <- r
+	}
+}
@@ -0,0 +1,211 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+	"github.com/apache/cloudstack-go/v2/cloudstack/cloudstacktest"
+)
+
+// Counts the requests per command, and optionally rejects some commands like CloudStack does
+// for commands that are not available to the user
+type commandCounter struct {
+	mu       sync.Mutex
+	counts   map[string]int
+	rejected map[string]bool
+}
+
+func newCommandCounter(rejected ...string) *commandCounter {
+	c := &commandCounter{counts: make(map[string]int), rejected: make(map[string]bool)}
+	for _, command := range rejected {
+		c.rejected[command] = true
+	}
+	return c
+}
+
+func (c *commandCounter) middleware(next cloudstack.RequestFunc) cloudstack.RequestFunc {
+	return func(ctx context.Context, command string, params url.Values) (json.RawMessage, error) {
+		c.mu.Lock()
+		c.counts[command]++
+		c.mu.Unlock()
+
+		if c.rejected[command] {
+			return nil, &cloudstack.CSError{ErrorCode: 432, ErrorText: "The given command does not exist or it is not available for user"}
+		}
+		return next(ctx, command, params)
+	}
+}
+
+func (c *commandCounter) count(command string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.counts[command]
+}
+
+// Starts a server with the resources needed to deploy virtual machines, and returns a function
+// that deploys a virtual machine asynchronously using cs
+func newDeployServer(t *testing.T, opts ...cloudstacktest.Option) (*cloudstacktest.Server, func(ctx context.Context, cs *cloudstack.CloudStackClient) *cloudstack.Job) {
+	s := cloudstacktest.NewServer(opts...)
+	zone := s.AddZone("zone1")
+	offering := s.AddServiceOffering("small", 1, 1024)
+	template := s.AddTemplate("ubuntu", zone.Id)
+
+	deploy := func(ctx context.Context, cs *cloudstack.CloudStackClient) *cloudstack.Job {
+		p := cs.VirtualMachine.NewDeployVirtualMachineParams(offering.Id, template.Id, zone.Id)
+		j, err := cs.VirtualMachine.DeployVirtualMachineAsyncWithContext(ctx, p)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return j
+	}
+
+	return s, deploy
+}
+
+func TestJobWatcherBatchesPolls(t *testing.T) {
+	s, deploy := newDeployServer(t, cloudstacktest.WithJobDelay(200*time.Millisecond))
+	defer s.Close()
+
+	c := newCommandCounter()
+	cs := s.NewClient(
+		cloudstack.WithJobWatcher(cloudstack.WithPollInterval(50*time.Millisecond, 50*time.Millisecond)),
+		cloudstack.WithMiddleware(c.middleware),
+	)
+
+	var jobs []*cloudstack.Job
+	for i := 0; i < 5; i++ {
+		jobs = append(jobs, deploy(context.Background(), cs))
+	}
+
+	if err := cloudstack.WaitAll(context.Background(), jobs...); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if n := c.count("listAsyncJobs"); n == 0 {
+		t.Errorf("Expected the jobs to be polled using listAsyncJobs")
+	}
+	if n := c.count("queryAsyncJobResult"); n != 0 {
+		t.Errorf("Expected no queryAsyncJobResult calls, got %d", n)
+	}
+}
+
+func TestJobWatcherFallsBackToQueryAsyncJobResult(t *testing.T) {
+	s, deploy := newDeployServer(t, cloudstacktest.WithJobDelay(100*time.Millisecond))
+	defer s.Close()
+
+	c := newCommandCounter("listAsyncJobs")
+	cs := s.NewClient(
+		cloudstack.WithJobWatcher(cloudstack.WithPollInterval(50*time.Millisecond, 50*time.Millisecond)),
+		cloudstack.WithMiddleware(c.middleware),
+	)
+
+	jobs := []*cloudstack.Job{deploy(context.Background(), cs), deploy(context.Background(), cs)}
+	if err := cloudstack.WaitAll(context.Background(), jobs...); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, j := range jobs {
+		var vm cloudstack.DeployVirtualMachineResponse
+		if err := j.Result(&vm); err != nil || vm.Id == "" {
+			t.Errorf("Expected the deployed virtual machine, got %+v and %v", vm, err)
+		}
+	}
+	if n := c.count("queryAsyncJobResult"); n < len(jobs) {
+		t.Errorf("Expected every job to be queried separately, got %d queryAsyncJobResult calls", n)
+	}
+}
+
+func TestJobWatcherCancelsSingleJob(t *testing.T) {
+	s, deploy := newDeployServer(t, cloudstacktest.WithJobDelay(time.Hour))
+	defer s.Close()
+
+	cs := s.NewClient(cloudstack.WithJobWatcher(cloudstack.WithPollInterval(50*time.Millisecond, 50*time.Millisecond)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := deploy(ctx, cs)
+	cancelled.Done()
+
+	s.SetJobDelay(100 * time.Millisecond)
+	other := deploy(context.Background(), cs)
+	other.Done()
+
+	cancel()
+	if err := cancelled.Wait(context.Background()); err != context.Canceled {
+		t.Errorf("Expected the cancelled job to fail with %v, got %v", context.Canceled, err)
+	}
+
+	wctx, wcancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer wcancel()
+	if err := other.Wait(wctx); err != nil {
+		t.Errorf("Expected the other job to succeed, got %v", err)
+	}
+}
+
+func TestJobWatcherWaitTimeout(t *testing.T) {
+	s, deploy := newDeployServer(t, cloudstacktest.WithJobDelay(time.Hour))
+	defer s.Close()
+
+	c := newCommandCounter()
+	cs := s.NewClient(
+		cloudstack.WithJobWatcher(cloudstack.WithPollInterval(time.Minute, time.Minute)),
+		cloudstack.WithMiddleware(c.middleware),
+	)
+	j := deploy(context.Background(), cs)
+
+	start := time.Now()
+	if _, err := cs.GetAsyncJobResult(j.JobID, 1); err != cloudstack.AsyncTimeoutErr {
+		t.Fatalf("Expected %v, got %v", cloudstack.AsyncTimeoutErr, err)
+	}
+	if d := time.Since(start); d > 2*time.Second {
+		t.Errorf("Expected to return once the timeout passed, took %v", d)
+	}
+	if n := c.count("listAsyncJobs") + c.count("queryAsyncJobResult"); n != 0 {
+		t.Errorf("Expected no polls after the timeout passed, got %d", n)
+	}
+}
+
+func TestJobWatcherStop(t *testing.T) {
+	s, deploy := newDeployServer(t, cloudstacktest.WithJobDelay(time.Hour))
+	defer s.Close()
+
+	w := cloudstack.NewJobWatcher(s.NewClient(), cloudstack.WithPollInterval(50*time.Millisecond, 50*time.Millisecond))
+	ch := w.Watch(deploy(context.Background(), s.NewClient()).JobID)
+
+	w.Stop()
+	select {
+	case r := <-ch:
+		if r.Err != context.Canceled {
+			t.Errorf("Expected %v, got %v", context.Canceled, r.Err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected a result once the watcher is stopped")
+	}
+
+	if r := <-w.Watch("job"); r.Err != context.Canceled {
+		t.Errorf("Expected watching a job after stopping to fail with %v, got %v", context.Canceled, r.Err)
+	}
+}
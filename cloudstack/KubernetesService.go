@@ -0,0 +1,3251 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// KubernetesServiceIface is the interface implemented by KubernetesService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type KubernetesServiceIface interface {
+	GetKubernetesClusterKubeconfig(id string, opts ...OptionFunc) ([]byte, error)
+	GetKubernetesClusterKubeconfigWithContext(ctx context.Context, id string, opts ...OptionFunc) ([]byte, error)
+	NewAddKubernetesSupportedVersionParams(mincpunumber int, minmemory int, semanticversion string) *AddKubernetesSupportedVersionParams
+	AddKubernetesSupportedVersion(p *AddKubernetesSupportedVersionParams) (*AddKubernetesSupportedVersionResponse, error)
+	AddKubernetesSupportedVersionWithContext(ctx context.Context, p *AddKubernetesSupportedVersionParams) (*AddKubernetesSupportedVersionResponse, error)
+	NewCreateKubernetesClusterParams(description string, kubernetesversionid string, name string, serviceofferingid string, size int64, zoneid string) *CreateKubernetesClusterParams
+	CreateKubernetesCluster(p *CreateKubernetesClusterParams) (*CreateKubernetesClusterResponse, error)
+	CreateKubernetesClusterWithContext(ctx context.Context, p *CreateKubernetesClusterParams) (*CreateKubernetesClusterResponse, error)
+	CreateKubernetesClusterAsync(p *CreateKubernetesClusterParams) (*Job, error)
+	CreateKubernetesClusterAsyncWithContext(ctx context.Context, p *CreateKubernetesClusterParams) (*Job, error)
+	NewDeleteKubernetesClusterParams(id string) *DeleteKubernetesClusterParams
+	DeleteKubernetesCluster(p *DeleteKubernetesClusterParams) (*DeleteKubernetesClusterResponse, error)
+	DeleteKubernetesClusterWithContext(ctx context.Context, p *DeleteKubernetesClusterParams) (*DeleteKubernetesClusterResponse, error)
+	DeleteKubernetesClusterAsync(p *DeleteKubernetesClusterParams) (*Job, error)
+	DeleteKubernetesClusterAsyncWithContext(ctx context.Context, p *DeleteKubernetesClusterParams) (*Job, error)
+	NewDeleteKubernetesSupportedVersionParams(id string) *DeleteKubernetesSupportedVersionParams
+	DeleteKubernetesSupportedVersion(p *DeleteKubernetesSupportedVersionParams) (*DeleteKubernetesSupportedVersionResponse, error)
+	DeleteKubernetesSupportedVersionWithContext(ctx context.Context, p *DeleteKubernetesSupportedVersionParams) (*DeleteKubernetesSupportedVersionResponse, error)
+	DeleteKubernetesSupportedVersionAsync(p *DeleteKubernetesSupportedVersionParams) (*Job, error)
+	DeleteKubernetesSupportedVersionAsyncWithContext(ctx context.Context, p *DeleteKubernetesSupportedVersionParams) (*Job, error)
+	NewGetKubernetesClusterConfigParams() *GetKubernetesClusterConfigParams
+	GetKubernetesClusterConfig(p *GetKubernetesClusterConfigParams) (*GetKubernetesClusterConfigResponse, error)
+	GetKubernetesClusterConfigWithContext(ctx context.Context, p *GetKubernetesClusterConfigParams) (*GetKubernetesClusterConfigResponse, error)
+	NewListKubernetesClustersParams() *ListKubernetesClustersParams
+	GetKubernetesClusterID(name string, opts ...OptionFunc) (string, int, error)
+	GetKubernetesClusterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetKubernetesClusterByName(name string, opts ...OptionFunc) (*KubernetesCluster, int, error)
+	GetKubernetesClusterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*KubernetesCluster, int, error)
+	GetKubernetesClusterByID(id string, opts ...OptionFunc) (*KubernetesCluster, int, error)
+	GetKubernetesClusterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*KubernetesCluster, int, error)
+	ListKubernetesClusters(p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error)
+	ListKubernetesClustersWithContext(ctx context.Context, p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error)
+	NewListKubernetesClustersPager(p *ListKubernetesClustersParams) *ListKubernetesClustersPager
+	ListKubernetesClustersAll(p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error)
+	ListKubernetesClustersAllWithContext(ctx context.Context, p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error)
+	NewListKubernetesSupportedVersionsParams() *ListKubernetesSupportedVersionsParams
+	GetKubernetesSupportedVersionID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetKubernetesSupportedVersionIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
+	GetKubernetesSupportedVersionByName(name string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error)
+	GetKubernetesSupportedVersionByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error)
+	GetKubernetesSupportedVersionByID(id string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error)
+	GetKubernetesSupportedVersionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error)
+	ListKubernetesSupportedVersions(p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error)
+	ListKubernetesSupportedVersionsWithContext(ctx context.Context, p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error)
+	NewListKubernetesSupportedVersionsPager(p *ListKubernetesSupportedVersionsParams) *ListKubernetesSupportedVersionsPager
+	ListKubernetesSupportedVersionsAll(p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error)
+	ListKubernetesSupportedVersionsAllWithContext(ctx context.Context, p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error)
+	NewScaleKubernetesClusterParams(id string) *ScaleKubernetesClusterParams
+	ScaleKubernetesCluster(p *ScaleKubernetesClusterParams) (*ScaleKubernetesClusterResponse, error)
+	ScaleKubernetesClusterWithContext(ctx context.Context, p *ScaleKubernetesClusterParams) (*ScaleKubernetesClusterResponse, error)
+	ScaleKubernetesClusterAsync(p *ScaleKubernetesClusterParams) (*Job, error)
+	ScaleKubernetesClusterAsyncWithContext(ctx context.Context, p *ScaleKubernetesClusterParams) (*Job, error)
+	NewStartKubernetesClusterParams(id string) *StartKubernetesClusterParams
+	StartKubernetesCluster(p *StartKubernetesClusterParams) (*StartKubernetesClusterResponse, error)
+	StartKubernetesClusterWithContext(ctx context.Context, p *StartKubernetesClusterParams) (*StartKubernetesClusterResponse, error)
+	StartKubernetesClusterAsync(p *StartKubernetesClusterParams) (*Job, error)
+	StartKubernetesClusterAsyncWithContext(ctx context.Context, p *StartKubernetesClusterParams) (*Job, error)
+	NewStopKubernetesClusterParams(id string) *StopKubernetesClusterParams
+	StopKubernetesCluster(p *StopKubernetesClusterParams) (*StopKubernetesClusterResponse, error)
+	StopKubernetesClusterWithContext(ctx context.Context, p *StopKubernetesClusterParams) (*StopKubernetesClusterResponse, error)
+	StopKubernetesClusterAsync(p *StopKubernetesClusterParams) (*Job, error)
+	StopKubernetesClusterAsyncWithContext(ctx context.Context, p *StopKubernetesClusterParams) (*Job, error)
+	NewUpdateKubernetesSupportedVersionParams(id string, state string) *UpdateKubernetesSupportedVersionParams
+	UpdateKubernetesSupportedVersion(p *UpdateKubernetesSupportedVersionParams) (*UpdateKubernetesSupportedVersionResponse, error)
+	UpdateKubernetesSupportedVersionWithContext(ctx context.Context, p *UpdateKubernetesSupportedVersionParams) (*UpdateKubernetesSupportedVersionResponse, error)
+	NewUpgradeKubernetesClusterParams(id string, kubernetesversionid string) *UpgradeKubernetesClusterParams
+	UpgradeKubernetesCluster(p *UpgradeKubernetesClusterParams) (*UpgradeKubernetesClusterResponse, error)
+	UpgradeKubernetesClusterWithContext(ctx context.Context, p *UpgradeKubernetesClusterParams) (*UpgradeKubernetesClusterResponse, error)
+	UpgradeKubernetesClusterAsync(p *UpgradeKubernetesClusterParams) (*Job, error)
+	UpgradeKubernetesClusterAsyncWithContext(ctx context.Context, p *UpgradeKubernetesClusterParams) (*Job, error)
+}
+
+// GetKubernetesClusterKubeconfig returns the kubeconfig of the Kubernetes cluster with the given ID
+func (s *KubernetesService) GetKubernetesClusterKubeconfig(id string, opts ...OptionFunc) ([]byte, error) {
+	return s.GetKubernetesClusterKubeconfigWithContext(context.Background(), id, opts...)
+}
+
+// GetKubernetesClusterKubeconfigWithContext is the same as GetKubernetesClusterKubeconfig, but uses ctx to cancel the request
+func (s *KubernetesService) GetKubernetesClusterKubeconfigWithContext(ctx context.Context, id string, opts ...OptionFunc) ([]byte, error) {
+	p := s.NewGetKubernetesClusterConfigParams()
+	p.SetId(id)
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, err
+		}
+	}
+
+	r, err := s.GetKubernetesClusterConfigWithContext(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Configdata == "" {
+		return nil, fmt.Errorf("No kubeconfig found for Kubernetes cluster %s", id)
+	}
+
+	return []byte(r.Configdata), nil
+}
+
+type AddKubernetesSupportedVersionParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddKubernetesSupportedVersionParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["checksum"]; found {
+		u.Set("checksum", v.(string))
+	}
+	if v, found := p.p["mincpunumber"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("mincpunumber", vv)
+	}
+	if v, found := p.p["minmemory"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("minmemory", vv)
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["semanticversion"]; found {
+		u.Set("semanticversion", v.(string))
+	}
+	if v, found := p.p["url"]; found {
+		u.Set("url", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddKubernetesSupportedVersionParams) Validate() error {
+	v := newParamValidator("addKubernetesSupportedVersion", p.p)
+	v.required("mincpunumber", "minmemory", "semanticversion")
+	v.ids("zoneid")
+	v.maxLength("checksum", 255)
+	v.maxLength("name", 255)
+	v.maxLength("semanticversion", 255)
+	v.maxLength("url", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddKubernetesSupportedVersionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddKubernetesSupportedVersionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "checksum", "name", "semanticversion", "url", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "mincpunumber", "minmemory":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addKubernetesSupportedVersion", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addKubernetesSupportedVersion: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *AddKubernetesSupportedVersionParams) SetChecksum(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["checksum"] = v
+}
+
+func (p *AddKubernetesSupportedVersionParams) ResetChecksum() {
+	delete(p.p, "checksum")
+}
+
+func (p *AddKubernetesSupportedVersionParams) GetChecksum() (string, bool) {
+	value, ok := p.p["checksum"].(string)
+	return value, ok
+}
+
+func (p *AddKubernetesSupportedVersionParams) SetMincpunumber(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["mincpunumber"] = v
+}
+
+func (p *AddKubernetesSupportedVersionParams) ResetMincpunumber() {
+	delete(p.p, "mincpunumber")
+}
+
+func (p *AddKubernetesSupportedVersionParams) GetMincpunumber() (int, bool) {
+	value, ok := p.p["mincpunumber"].(int)
+	return value, ok
+}
+
+func (p *AddKubernetesSupportedVersionParams) SetMinmemory(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["minmemory"] = v
+}
+
+func (p *AddKubernetesSupportedVersionParams) ResetMinmemory() {
+	delete(p.p, "minmemory")
+}
+
+func (p *AddKubernetesSupportedVersionParams) GetMinmemory() (int, bool) {
+	value, ok := p.p["minmemory"].(int)
+	return value, ok
+}
+
+func (p *AddKubernetesSupportedVersionParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *AddKubernetesSupportedVersionParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *AddKubernetesSupportedVersionParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *AddKubernetesSupportedVersionParams) SetSemanticversion(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["semanticversion"] = v
+}
+
+func (p *AddKubernetesSupportedVersionParams) ResetSemanticversion() {
+	delete(p.p, "semanticversion")
+}
+
+func (p *AddKubernetesSupportedVersionParams) GetSemanticversion() (string, bool) {
+	value, ok := p.p["semanticversion"].(string)
+	return value, ok
+}
+
+func (p *AddKubernetesSupportedVersionParams) SetUrl(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["url"] = v
+}
+
+func (p *AddKubernetesSupportedVersionParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddKubernetesSupportedVersionParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
+func (p *AddKubernetesSupportedVersionParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *AddKubernetesSupportedVersionParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *AddKubernetesSupportedVersionParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AddKubernetesSupportedVersionParams instance,
+// as then you are sure you have configured all required params
+func (s *KubernetesService) NewAddKubernetesSupportedVersionParams(mincpunumber int, minmemory int, semanticversion string) *AddKubernetesSupportedVersionParams {
+	p := &AddKubernetesSupportedVersionParams{}
+	p.p = make(map[string]interface{})
+	p.p["mincpunumber"] = mincpunumber
+	p.p["minmemory"] = minmemory
+	p.p["semanticversion"] = semanticversion
+	return p
+}
+
+// Add a supported Kubernetes version
+func (s *KubernetesService) AddKubernetesSupportedVersion(p *AddKubernetesSupportedVersionParams) (*AddKubernetesSupportedVersionResponse, error) {
+	return s.AddKubernetesSupportedVersionWithContext(context.Background(), p)
+}
+
+// AddKubernetesSupportedVersionWithContext is the same as AddKubernetesSupportedVersion, but uses ctx to cancel the request and any async job polling
+func (s *KubernetesService) AddKubernetesSupportedVersionWithContext(ctx context.Context, p *AddKubernetesSupportedVersionParams) (*AddKubernetesSupportedVersionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addKubernetesSupportedVersion", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddKubernetesSupportedVersionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type AddKubernetesSupportedVersionResponse struct {
+	Id              string `json:"id"`
+	Isoid           string `json:"isoid"`
+	Isoname         string `json:"isoname"`
+	Isostate        string `json:"isostate"`
+	JobID           string `json:"jobid"`
+	Jobstatus       int    `json:"jobstatus"`
+	Mincpunumber    int    `json:"mincpunumber"`
+	Minmemory       int    `json:"minmemory"`
+	Name            string `json:"name"`
+	Semanticversion string `json:"semanticversion"`
+	State           string `json:"state"`
+	Supportsha      bool   `json:"supportsha"`
+	Zoneid          string `json:"zoneid"`
+	Zonename        string `json:"zonename"`
+}
+
+type CreateKubernetesClusterParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateKubernetesClusterParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["description"]; found {
+		u.Set("description", v.(string))
+	}
+	if v, found := p.p["dockerregistryemail"]; found {
+		u.Set("dockerregistryemail", v.(string))
+	}
+	if v, found := p.p["dockerregistrypassword"]; found {
+		u.Set("dockerregistrypassword", v.(string))
+	}
+	if v, found := p.p["dockerregistryurl"]; found {
+		u.Set("dockerregistryurl", v.(string))
+	}
+	if v, found := p.p["dockerregistryusername"]; found {
+		u.Set("dockerregistryusername", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["externalloadbalanceripaddress"]; found {
+		u.Set("externalloadbalanceripaddress", v.(string))
+	}
+	if v, found := p.p["keypair"]; found {
+		u.Set("keypair", v.(string))
+	}
+	if v, found := p.p["kubernetesversionid"]; found {
+		u.Set("kubernetesversionid", v.(string))
+	}
+	if v, found := p.p["masternodes"]; found {
+		vv := strconv.FormatInt(v.(int64), 10)
+		u.Set("masternodes", vv)
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["networkid"]; found {
+		u.Set("networkid", v.(string))
+	}
+	if v, found := p.p["noderootdisksize"]; found {
+		vv := strconv.FormatInt(v.(int64), 10)
+		u.Set("noderootdisksize", vv)
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["serviceofferingid"]; found {
+		u.Set("serviceofferingid", v.(string))
+	}
+	if v, found := p.p["size"]; found {
+		vv := strconv.FormatInt(v.(int64), 10)
+		u.Set("size", vv)
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateKubernetesClusterParams) Validate() error {
+	v := newParamValidator("createKubernetesCluster", p.p)
+	v.required("description", "kubernetesversionid", "name", "serviceofferingid", "size", "zoneid")
+	v.ids("domainid", "kubernetesversionid", "networkid", "projectid", "serviceofferingid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("description", 255)
+	v.maxLength("dockerregistryemail", 255)
+	v.maxLength("dockerregistrypassword", 255)
+	v.maxLength("dockerregistryurl", 255)
+	v.maxLength("dockerregistryusername", 255)
+	v.maxLength("externalloadbalanceripaddress", 255)
+	v.maxLength("keypair", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "description", "dockerregistryemail", "dockerregistrypassword", "dockerregistryurl", "dockerregistryusername", "domainid", "externalloadbalanceripaddress", "keypair", "kubernetesversionid", "name", "networkid", "projectid", "serviceofferingid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "masternodes", "noderootdisksize", "size":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createKubernetesCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createKubernetesCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *CreateKubernetesClusterParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateKubernetesClusterParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetDescription(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["description"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *CreateKubernetesClusterParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetDockerregistryemail(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["dockerregistryemail"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetDockerregistryemail() {
+	delete(p.p, "dockerregistryemail")
+}
+
+func (p *CreateKubernetesClusterParams) GetDockerregistryemail() (string, bool) {
+	value, ok := p.p["dockerregistryemail"].(string)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetDockerregistrypassword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["dockerregistrypassword"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetDockerregistrypassword() {
+	delete(p.p, "dockerregistrypassword")
+}
+
+func (p *CreateKubernetesClusterParams) GetDockerregistrypassword() (string, bool) {
+	value, ok := p.p["dockerregistrypassword"].(string)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetDockerregistryurl(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["dockerregistryurl"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetDockerregistryurl() {
+	delete(p.p, "dockerregistryurl")
+}
+
+func (p *CreateKubernetesClusterParams) GetDockerregistryurl() (string, bool) {
+	value, ok := p.p["dockerregistryurl"].(string)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetDockerregistryusername(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["dockerregistryusername"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetDockerregistryusername() {
+	delete(p.p, "dockerregistryusername")
+}
+
+func (p *CreateKubernetesClusterParams) GetDockerregistryusername() (string, bool) {
+	value, ok := p.p["dockerregistryusername"].(string)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateKubernetesClusterParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetExternalloadbalanceripaddress(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["externalloadbalanceripaddress"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetExternalloadbalanceripaddress() {
+	delete(p.p, "externalloadbalanceripaddress")
+}
+
+func (p *CreateKubernetesClusterParams) GetExternalloadbalanceripaddress() (string, bool) {
+	value, ok := p.p["externalloadbalanceripaddress"].(string)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetKeypair(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keypair"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetKeypair() {
+	delete(p.p, "keypair")
+}
+
+func (p *CreateKubernetesClusterParams) GetKeypair() (string, bool) {
+	value, ok := p.p["keypair"].(string)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetKubernetesversionid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["kubernetesversionid"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetKubernetesversionid() {
+	delete(p.p, "kubernetesversionid")
+}
+
+func (p *CreateKubernetesClusterParams) GetKubernetesversionid() (string, bool) {
+	value, ok := p.p["kubernetesversionid"].(string)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetMasternodes(v int64) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["masternodes"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetMasternodes() {
+	delete(p.p, "masternodes")
+}
+
+func (p *CreateKubernetesClusterParams) GetMasternodes() (int64, bool) {
+	value, ok := p.p["masternodes"].(int64)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateKubernetesClusterParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetNetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networkid"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *CreateKubernetesClusterParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetNoderootdisksize(v int64) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["noderootdisksize"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetNoderootdisksize() {
+	delete(p.p, "noderootdisksize")
+}
+
+func (p *CreateKubernetesClusterParams) GetNoderootdisksize() (int64, bool) {
+	value, ok := p.p["noderootdisksize"].(int64)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *CreateKubernetesClusterParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetServiceofferingid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["serviceofferingid"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *CreateKubernetesClusterParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetSize(v int64) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["size"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetSize() {
+	delete(p.p, "size")
+}
+
+func (p *CreateKubernetesClusterParams) GetSize() (int64, bool) {
+	value, ok := p.p["size"].(int64)
+	return value, ok
+}
+
+func (p *CreateKubernetesClusterParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *CreateKubernetesClusterParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreateKubernetesClusterParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateKubernetesClusterParams instance,
+// as then you are sure you have configured all required params
+func (s *KubernetesService) NewCreateKubernetesClusterParams(description string, kubernetesversionid string, name string, serviceofferingid string, size int64, zoneid string) *CreateKubernetesClusterParams {
+	p := &CreateKubernetesClusterParams{}
+	p.p = make(map[string]interface{})
+	p.p["description"] = description
+	p.p["kubernetesversionid"] = kubernetesversionid
+	p.p["name"] = name
+	p.p["serviceofferingid"] = serviceofferingid
+	p.p["size"] = size
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// Creates a Kubernetes cluster
+func (s *KubernetesService) CreateKubernetesCluster(p *CreateKubernetesClusterParams) (*CreateKubernetesClusterResponse, error) {
+	return s.CreateKubernetesClusterWithContext(context.Background(), p)
+}
+
+// CreateKubernetesClusterWithContext is the same as CreateKubernetesCluster, but uses ctx to cancel the request and any async job polling
+func (s *KubernetesService) CreateKubernetesClusterWithContext(ctx context.Context, p *CreateKubernetesClusterParams) (*CreateKubernetesClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createKubernetesCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateKubernetesClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// CreateKubernetesClusterAsync starts the async job for CreateKubernetesCluster and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateKubernetesClusterResponse using Job.Result
+func (s *KubernetesService) CreateKubernetesClusterAsync(p *CreateKubernetesClusterParams) (*Job, error) {
+	return s.CreateKubernetesClusterAsyncWithContext(context.Background(), p)
+}
+
+// CreateKubernetesClusterAsyncWithContext is the same as CreateKubernetesClusterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *KubernetesService) CreateKubernetesClusterAsyncWithContext(ctx context.Context, p *CreateKubernetesClusterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createKubernetesCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateKubernetesClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type CreateKubernetesClusterResponse struct {
+	Account               string   `json:"account"`
+	Associatednetworkname string   `json:"associatednetworkname"`
+	Consoleendpoint       string   `json:"consoleendpoint"`
+	Cpunumber             string   `json:"cpunumber"`
+	Description           string   `json:"description"`
+	Domain                string   `json:"domain"`
+	Domainid              string   `json:"domainid"`
+	Endpoint              string   `json:"endpoint"`
+	Id                    string   `json:"id"`
+	Ipaddress             string   `json:"ipaddress"`
+	Ipaddressid           string   `json:"ipaddressid"`
+	JobID                 string   `json:"jobid"`
+	Jobstatus             int      `json:"jobstatus"`
+	Keypair               string   `json:"keypair"`
+	Kubernetesversionid   string   `json:"kubernetesversionid"`
+	Kubernetesversionname string   `json:"kubernetesversionname"`
+	Masternodes           int64    `json:"masternodes"`
+	Memory                string   `json:"memory"`
+	Name                  string   `json:"name"`
+	Networkid             string   `json:"networkid"`
+	Project               string   `json:"project"`
+	Projectid             string   `json:"projectid"`
+	Serviceofferingid     string   `json:"serviceofferingid"`
+	Serviceofferingname   string   `json:"serviceofferingname"`
+	Size                  int64    `json:"size"`
+	State                 string   `json:"state"`
+	Templateid            string   `json:"templateid"`
+	Virtualmachines       []string `json:"virtualmachines"`
+	Zoneid                string   `json:"zoneid"`
+	Zonename              string   `json:"zonename"`
+}
+
+type DeleteKubernetesClusterParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteKubernetesClusterParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteKubernetesClusterParams) Validate() error {
+	v := newParamValidator("deleteKubernetesCluster", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteKubernetesCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteKubernetesCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteKubernetesClusterParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteKubernetesClusterParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteKubernetesClusterParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteKubernetesClusterParams instance,
+// as then you are sure you have configured all required params
+func (s *KubernetesService) NewDeleteKubernetesClusterParams(id string) *DeleteKubernetesClusterParams {
+	p := &DeleteKubernetesClusterParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes a Kubernetes cluster
+func (s *KubernetesService) DeleteKubernetesCluster(p *DeleteKubernetesClusterParams) (*DeleteKubernetesClusterResponse, error) {
+	return s.DeleteKubernetesClusterWithContext(context.Background(), p)
+}
+
+// DeleteKubernetesClusterWithContext is the same as DeleteKubernetesCluster, but uses ctx to cancel the request and any async job polling
+func (s *KubernetesService) DeleteKubernetesClusterWithContext(ctx context.Context, p *DeleteKubernetesClusterParams) (*DeleteKubernetesClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteKubernetesCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteKubernetesClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteKubernetesClusterAsync starts the async job for DeleteKubernetesCluster and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteKubernetesClusterResponse using Job.Result
+func (s *KubernetesService) DeleteKubernetesClusterAsync(p *DeleteKubernetesClusterParams) (*Job, error) {
+	return s.DeleteKubernetesClusterAsyncWithContext(context.Background(), p)
+}
+
+// DeleteKubernetesClusterAsyncWithContext is the same as DeleteKubernetesClusterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *KubernetesService) DeleteKubernetesClusterAsyncWithContext(ctx context.Context, p *DeleteKubernetesClusterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteKubernetesCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteKubernetesClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteKubernetesClusterResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteKubernetesSupportedVersionParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteKubernetesSupportedVersionParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteKubernetesSupportedVersionParams) Validate() error {
+	v := newParamValidator("deleteKubernetesSupportedVersion", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteKubernetesSupportedVersionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteKubernetesSupportedVersionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteKubernetesSupportedVersion", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteKubernetesSupportedVersion: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteKubernetesSupportedVersionParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteKubernetesSupportedVersionParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteKubernetesSupportedVersionParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteKubernetesSupportedVersionParams instance,
+// as then you are sure you have configured all required params
+func (s *KubernetesService) NewDeleteKubernetesSupportedVersionParams(id string) *DeleteKubernetesSupportedVersionParams {
+	p := &DeleteKubernetesSupportedVersionParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes a Kubernetes cluster
+func (s *KubernetesService) DeleteKubernetesSupportedVersion(p *DeleteKubernetesSupportedVersionParams) (*DeleteKubernetesSupportedVersionResponse, error) {
+	return s.DeleteKubernetesSupportedVersionWithContext(context.Background(), p)
+}
+
+// DeleteKubernetesSupportedVersionWithContext is the same as DeleteKubernetesSupportedVersion, but uses ctx to cancel the request and any async job polling
+func (s *KubernetesService) DeleteKubernetesSupportedVersionWithContext(ctx context.Context, p *DeleteKubernetesSupportedVersionParams) (*DeleteKubernetesSupportedVersionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteKubernetesSupportedVersion", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteKubernetesSupportedVersionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteKubernetesSupportedVersionAsync starts the async job for DeleteKubernetesSupportedVersion and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteKubernetesSupportedVersionResponse using Job.Result
+func (s *KubernetesService) DeleteKubernetesSupportedVersionAsync(p *DeleteKubernetesSupportedVersionParams) (*Job, error) {
+	return s.DeleteKubernetesSupportedVersionAsyncWithContext(context.Background(), p)
+}
+
+// DeleteKubernetesSupportedVersionAsyncWithContext is the same as DeleteKubernetesSupportedVersionAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *KubernetesService) DeleteKubernetesSupportedVersionAsyncWithContext(ctx context.Context, p *DeleteKubernetesSupportedVersionParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteKubernetesSupportedVersion", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteKubernetesSupportedVersionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteKubernetesSupportedVersionResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type GetKubernetesClusterConfigParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetKubernetesClusterConfigParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetKubernetesClusterConfigParams) Validate() error {
+	v := newParamValidator("getKubernetesClusterConfig", p.p)
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetKubernetesClusterConfigParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetKubernetesClusterConfigParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getKubernetesClusterConfig", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getKubernetesClusterConfig: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *GetKubernetesClusterConfigParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *GetKubernetesClusterConfigParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *GetKubernetesClusterConfigParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new GetKubernetesClusterConfigParams instance,
+// as then you are sure you have configured all required params
+func (s *KubernetesService) NewGetKubernetesClusterConfigParams() *GetKubernetesClusterConfigParams {
+	p := &GetKubernetesClusterConfigParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Get Kubernetes cluster config
+func (s *KubernetesService) GetKubernetesClusterConfig(p *GetKubernetesClusterConfigParams) (*GetKubernetesClusterConfigResponse, error) {
+	return s.GetKubernetesClusterConfigWithContext(context.Background(), p)
+}
+
+// GetKubernetesClusterConfigWithContext is the same as GetKubernetesClusterConfig, but uses ctx to cancel the request and any async job polling
+func (s *KubernetesService) GetKubernetesClusterConfigWithContext(ctx context.Context, p *GetKubernetesClusterConfigParams) (*GetKubernetesClusterConfigResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getKubernetesClusterConfig", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r GetKubernetesClusterConfigResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type GetKubernetesClusterConfigResponse struct {
+	Configdata string `json:"configdata"`
+	Id         string `json:"id"`
+	JobID      string `json:"jobid"`
+	Jobstatus  int    `json:"jobstatus"`
+	Name       string `json:"name"`
+}
+
+type ListKubernetesClustersParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListKubernetesClustersParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["isrecursive"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isrecursive", vv)
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["listall"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("listall", vv)
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["state"]; found {
+		u.Set("state", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListKubernetesClustersParams) Validate() error {
+	v := newParamValidator("listKubernetesClusters", p.p)
+	v.ids("domainid", "id", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListKubernetesClustersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListKubernetesClustersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "name", "projectid", "state":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listKubernetesClusters", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listKubernetesClusters: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListKubernetesClustersParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *ListKubernetesClustersParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListKubernetesClustersParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *ListKubernetesClustersParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *ListKubernetesClustersParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListKubernetesClustersParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *ListKubernetesClustersParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListKubernetesClustersParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListKubernetesClustersParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListKubernetesClustersParams) SetIsrecursive(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isrecursive"] = v
+}
+
+func (p *ListKubernetesClustersParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListKubernetesClustersParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
+func (p *ListKubernetesClustersParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListKubernetesClustersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListKubernetesClustersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListKubernetesClustersParams) SetListall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["listall"] = v
+}
+
+func (p *ListKubernetesClustersParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListKubernetesClustersParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
+func (p *ListKubernetesClustersParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ListKubernetesClustersParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListKubernetesClustersParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ListKubernetesClustersParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListKubernetesClustersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListKubernetesClustersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListKubernetesClustersParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListKubernetesClustersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListKubernetesClustersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListKubernetesClustersParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *ListKubernetesClustersParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListKubernetesClustersParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *ListKubernetesClustersParams) SetState(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["state"] = v
+}
+
+func (p *ListKubernetesClustersParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListKubernetesClustersParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListKubernetesClustersParams instance,
+// as then you are sure you have configured all required params
+func (s *KubernetesService) NewListKubernetesClustersParams() *ListKubernetesClustersParams {
+	p := &ListKubernetesClustersParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *KubernetesService) GetKubernetesClusterID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetKubernetesClusterIDWithContext(context.Background(), name, opts...)
+}
+
+// GetKubernetesClusterIDWithContext is the same as GetKubernetesClusterID, but uses ctx to cancel the request
+func (s *KubernetesService) GetKubernetesClusterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListKubernetesClustersParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["name"] = name
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListKubernetesClustersWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.KubernetesClusters[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.KubernetesClusters {
+			if v.Name == name {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *KubernetesService) GetKubernetesClusterByName(name string, opts ...OptionFunc) (*KubernetesCluster, int, error) {
+	return s.GetKubernetesClusterByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetKubernetesClusterByNameWithContext is the same as GetKubernetesClusterByName, but uses ctx to cancel the requests
+func (s *KubernetesService) GetKubernetesClusterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*KubernetesCluster, int, error) {
+	id, count, err := s.GetKubernetesClusterIDWithContext(ctx, name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetKubernetesClusterByIDWithContext(ctx, id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *KubernetesService) GetKubernetesClusterByID(id string, opts ...OptionFunc) (*KubernetesCluster, int, error) {
+	return s.GetKubernetesClusterByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetKubernetesClusterByIDWithContext is the same as GetKubernetesClusterByID, but uses ctx to cancel the request
+func (s *KubernetesService) GetKubernetesClusterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*KubernetesCluster, int, error) {
+	p := &ListKubernetesClustersParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListKubernetesClustersWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.KubernetesClusters[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for KubernetesCluster UUID: %s!", id)
+}
+
+// Lists Kubernetes clusters
+func (s *KubernetesService) ListKubernetesClusters(p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error) {
+	return s.ListKubernetesClustersWithContext(context.Background(), p)
+}
+
+// ListKubernetesClustersWithContext is the same as ListKubernetesClusters, but uses ctx to cancel the request and any async job polling
+func (s *KubernetesService) ListKubernetesClustersWithContext(ctx context.Context, p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listKubernetesClusters", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListKubernetesClustersResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListKubernetesClustersPager iterates over all pages of a ListKubernetesClusters call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListKubernetesClustersPager struct {
+	pager
+
+	s *KubernetesService
+	p *ListKubernetesClustersParams
+	r *ListKubernetesClustersResponse
+}
+
+// NewListKubernetesClustersPager returns a pager for all pages of a ListKubernetesClusters call with the given params
+func (s *KubernetesService) NewListKubernetesClustersPager(p *ListKubernetesClustersParams) *ListKubernetesClustersPager {
+	return &ListKubernetesClustersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListKubernetesClustersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListKubernetesClustersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListKubernetesClustersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListKubernetesClustersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.KubernetesClusters), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListKubernetesClustersPager) Page() *ListKubernetesClustersResponse {
+	return pg.r
+}
+
+// ListKubernetesClustersAll fetches all pages of a ListKubernetesClusters call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *KubernetesService) ListKubernetesClustersAll(p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error) {
+	return s.ListKubernetesClustersAllWithContext(context.Background(), p)
+}
+
+// ListKubernetesClustersAllWithContext is the same as ListKubernetesClustersAll, but uses ctx to cancel the requests
+func (s *KubernetesService) ListKubernetesClustersAllWithContext(ctx context.Context, p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*KubernetesCluster)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListKubernetesClustersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListKubernetesClustersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.KubernetesClusters
+		mu.Unlock()
+
+		return len(l.KubernetesClusters), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListKubernetesClustersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.KubernetesClusters = append(r.KubernetesClusters, pages[page]...)
+	}
+	r.Count = len(r.KubernetesClusters)
+
+	return r, nil
+}
+
+type ListKubernetesClustersResponse struct {
+	Count              int                  `json:"count"`
+	KubernetesClusters []*KubernetesCluster `json:"kubernetescluster"`
+}
+
+type KubernetesCluster struct {
+	Account               string   `json:"account"`
+	Associatednetworkname string   `json:"associatednetworkname"`
+	Consoleendpoint       string   `json:"consoleendpoint"`
+	Cpunumber             string   `json:"cpunumber"`
+	Description           string   `json:"description"`
+	Domain                string   `json:"domain"`
+	Domainid              string   `json:"domainid"`
+	Endpoint              string   `json:"endpoint"`
+	Id                    string   `json:"id"`
+	Ipaddress             string   `json:"ipaddress"`
+	Ipaddressid           string   `json:"ipaddressid"`
+	JobID                 string   `json:"jobid"`
+	Jobstatus             int      `json:"jobstatus"`
+	Keypair               string   `json:"keypair"`
+	Kubernetesversionid   string   `json:"kubernetesversionid"`
+	Kubernetesversionname string   `json:"kubernetesversionname"`
+	Masternodes           int64    `json:"masternodes"`
+	Memory                string   `json:"memory"`
+	Name                  string   `json:"name"`
+	Networkid             string   `json:"networkid"`
+	Project               string   `json:"project"`
+	Projectid             string   `json:"projectid"`
+	Serviceofferingid     string   `json:"serviceofferingid"`
+	Serviceofferingname   string   `json:"serviceofferingname"`
+	Size                  int64    `json:"size"`
+	State                 string   `json:"state"`
+	Templateid            string   `json:"templateid"`
+	Virtualmachines       []string `json:"virtualmachines"`
+	Zoneid                string   `json:"zoneid"`
+	Zonename              string   `json:"zonename"`
+}
+
+type ListKubernetesSupportedVersionsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListKubernetesSupportedVersionsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["minimumkubernetesversionid"]; found {
+		u.Set("minimumkubernetesversionid", v.(string))
+	}
+	if v, found := p.p["minimumsemanticversion"]; found {
+		u.Set("minimumsemanticversion", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListKubernetesSupportedVersionsParams) Validate() error {
+	v := newParamValidator("listKubernetesSupportedVersions", p.p)
+	v.ids("id", "minimumkubernetesversionid", "zoneid")
+	v.maxLength("keyword", 255)
+	v.maxLength("minimumsemanticversion", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListKubernetesSupportedVersionsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListKubernetesSupportedVersionsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword", "minimumkubernetesversionid", "minimumsemanticversion", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listKubernetesSupportedVersions", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listKubernetesSupportedVersions: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListKubernetesSupportedVersionsParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListKubernetesSupportedVersionsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListKubernetesSupportedVersionsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListKubernetesSupportedVersionsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListKubernetesSupportedVersionsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListKubernetesSupportedVersionsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListKubernetesSupportedVersionsParams) SetMinimumkubernetesversionid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["minimumkubernetesversionid"] = v
+}
+
+func (p *ListKubernetesSupportedVersionsParams) ResetMinimumkubernetesversionid() {
+	delete(p.p, "minimumkubernetesversionid")
+}
+
+func (p *ListKubernetesSupportedVersionsParams) GetMinimumkubernetesversionid() (string, bool) {
+	value, ok := p.p["minimumkubernetesversionid"].(string)
+	return value, ok
+}
+
+func (p *ListKubernetesSupportedVersionsParams) SetMinimumsemanticversion(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["minimumsemanticversion"] = v
+}
+
+func (p *ListKubernetesSupportedVersionsParams) ResetMinimumsemanticversion() {
+	delete(p.p, "minimumsemanticversion")
+}
+
+func (p *ListKubernetesSupportedVersionsParams) GetMinimumsemanticversion() (string, bool) {
+	value, ok := p.p["minimumsemanticversion"].(string)
+	return value, ok
+}
+
+func (p *ListKubernetesSupportedVersionsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListKubernetesSupportedVersionsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListKubernetesSupportedVersionsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListKubernetesSupportedVersionsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListKubernetesSupportedVersionsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListKubernetesSupportedVersionsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListKubernetesSupportedVersionsParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ListKubernetesSupportedVersionsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListKubernetesSupportedVersionsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListKubernetesSupportedVersionsParams instance,
+// as then you are sure you have configured all required params
+func (s *KubernetesService) NewListKubernetesSupportedVersionsParams() *ListKubernetesSupportedVersionsParams {
+	p := &ListKubernetesSupportedVersionsParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *KubernetesService) GetKubernetesSupportedVersionID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetKubernetesSupportedVersionIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetKubernetesSupportedVersionIDWithContext is the same as GetKubernetesSupportedVersionID, but uses ctx to cancel the request
+func (s *KubernetesService) GetKubernetesSupportedVersionIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	p := &ListKubernetesSupportedVersionsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["keyword"] = keyword
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListKubernetesSupportedVersionsWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.KubernetesSupportedVersions[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.KubernetesSupportedVersions {
+			if v.Name == keyword {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *KubernetesService) GetKubernetesSupportedVersionByName(name string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error) {
+	return s.GetKubernetesSupportedVersionByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetKubernetesSupportedVersionByNameWithContext is the same as GetKubernetesSupportedVersionByName, but uses ctx to cancel the requests
+func (s *KubernetesService) GetKubernetesSupportedVersionByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error) {
+	id, count, err := s.GetKubernetesSupportedVersionIDWithContext(ctx, name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetKubernetesSupportedVersionByIDWithContext(ctx, id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *KubernetesService) GetKubernetesSupportedVersionByID(id string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error) {
+	return s.GetKubernetesSupportedVersionByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetKubernetesSupportedVersionByIDWithContext is the same as GetKubernetesSupportedVersionByID, but uses ctx to cancel the request
+func (s *KubernetesService) GetKubernetesSupportedVersionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error) {
+	p := &ListKubernetesSupportedVersionsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListKubernetesSupportedVersionsWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.KubernetesSupportedVersions[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for KubernetesSupportedVersion UUID: %s!", id)
+}
+
+// Lists supported Kubernetes version
+func (s *KubernetesService) ListKubernetesSupportedVersions(p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error) {
+	return s.ListKubernetesSupportedVersionsWithContext(context.Background(), p)
+}
+
+// ListKubernetesSupportedVersionsWithContext is the same as ListKubernetesSupportedVersions, but uses ctx to cancel the request and any async job polling
+func (s *KubernetesService) ListKubernetesSupportedVersionsWithContext(ctx context.Context, p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listKubernetesSupportedVersions", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListKubernetesSupportedVersionsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListKubernetesSupportedVersionsPager iterates over all pages of a ListKubernetesSupportedVersions call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListKubernetesSupportedVersionsPager struct {
+	pager
+
+	s *KubernetesService
+	p *ListKubernetesSupportedVersionsParams
+	r *ListKubernetesSupportedVersionsResponse
+}
+
+// NewListKubernetesSupportedVersionsPager returns a pager for all pages of a ListKubernetesSupportedVersions call with the given params
+func (s *KubernetesService) NewListKubernetesSupportedVersionsPager(p *ListKubernetesSupportedVersionsParams) *ListKubernetesSupportedVersionsPager {
+	return &ListKubernetesSupportedVersionsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListKubernetesSupportedVersionsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListKubernetesSupportedVersionsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListKubernetesSupportedVersionsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListKubernetesSupportedVersionsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.KubernetesSupportedVersions), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListKubernetesSupportedVersionsPager) Page() *ListKubernetesSupportedVersionsResponse {
+	return pg.r
+}
+
+// ListKubernetesSupportedVersionsAll fetches all pages of a ListKubernetesSupportedVersions call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *KubernetesService) ListKubernetesSupportedVersionsAll(p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error) {
+	return s.ListKubernetesSupportedVersionsAllWithContext(context.Background(), p)
+}
+
+// ListKubernetesSupportedVersionsAllWithContext is the same as ListKubernetesSupportedVersionsAll, but uses ctx to cancel the requests
+func (s *KubernetesService) ListKubernetesSupportedVersionsAllWithContext(ctx context.Context, p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*KubernetesSupportedVersion)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListKubernetesSupportedVersionsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListKubernetesSupportedVersionsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.KubernetesSupportedVersions
+		mu.Unlock()
+
+		return len(l.KubernetesSupportedVersions), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListKubernetesSupportedVersionsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.KubernetesSupportedVersions = append(r.KubernetesSupportedVersions, pages[page]...)
+	}
+	r.Count = len(r.KubernetesSupportedVersions)
+
+	return r, nil
+}
+
+type ListKubernetesSupportedVersionsResponse struct {
+	Count                       int                           `json:"count"`
+	KubernetesSupportedVersions []*KubernetesSupportedVersion `json:"kubernetessupportedversion"`
+}
+
+type KubernetesSupportedVersion struct {
+	Id              string `json:"id"`
+	Isoid           string `json:"isoid"`
+	Isoname         string `json:"isoname"`
+	Isostate        string `json:"isostate"`
+	JobID           string `json:"jobid"`
+	Jobstatus       int    `json:"jobstatus"`
+	Mincpunumber    int    `json:"mincpunumber"`
+	Minmemory       int    `json:"minmemory"`
+	Name            string `json:"name"`
+	Semanticversion string `json:"semanticversion"`
+	State           string `json:"state"`
+	Supportsha      bool   `json:"supportsha"`
+	Zoneid          string `json:"zoneid"`
+	Zonename        string `json:"zonename"`
+}
+
+type ScaleKubernetesClusterParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ScaleKubernetesClusterParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["serviceofferingid"]; found {
+		u.Set("serviceofferingid", v.(string))
+	}
+	if v, found := p.p["size"]; found {
+		vv := strconv.FormatInt(v.(int64), 10)
+		u.Set("size", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ScaleKubernetesClusterParams) Validate() error {
+	v := newParamValidator("scaleKubernetesCluster", p.p)
+	v.required("id")
+	v.ids("id", "serviceofferingid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ScaleKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ScaleKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "serviceofferingid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "size":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for scaleKubernetesCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of scaleKubernetesCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ScaleKubernetesClusterParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ScaleKubernetesClusterParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ScaleKubernetesClusterParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ScaleKubernetesClusterParams) SetServiceofferingid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["serviceofferingid"] = v
+}
+
+func (p *ScaleKubernetesClusterParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *ScaleKubernetesClusterParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
+func (p *ScaleKubernetesClusterParams) SetSize(v int64) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["size"] = v
+}
+
+func (p *ScaleKubernetesClusterParams) ResetSize() {
+	delete(p.p, "size")
+}
+
+func (p *ScaleKubernetesClusterParams) GetSize() (int64, bool) {
+	value, ok := p.p["size"].(int64)
+	return value, ok
+}
+
+// You should always use this function to get a new ScaleKubernetesClusterParams instance,
+// as then you are sure you have configured all required params
+func (s *KubernetesService) NewScaleKubernetesClusterParams(id string) *ScaleKubernetesClusterParams {
+	p := &ScaleKubernetesClusterParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Scales a created, running or stopped Kubernetes cluster
+func (s *KubernetesService) ScaleKubernetesCluster(p *ScaleKubernetesClusterParams) (*ScaleKubernetesClusterResponse, error) {
+	return s.ScaleKubernetesClusterWithContext(context.Background(), p)
+}
+
+// ScaleKubernetesClusterWithContext is the same as ScaleKubernetesCluster, but uses ctx to cancel the request and any async job polling
+func (s *KubernetesService) ScaleKubernetesClusterWithContext(ctx context.Context, p *ScaleKubernetesClusterParams) (*ScaleKubernetesClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "scaleKubernetesCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ScaleKubernetesClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// ScaleKubernetesClusterAsync starts the async job for ScaleKubernetesCluster and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ScaleKubernetesClusterResponse using Job.Result
+func (s *KubernetesService) ScaleKubernetesClusterAsync(p *ScaleKubernetesClusterParams) (*Job, error) {
+	return s.ScaleKubernetesClusterAsyncWithContext(context.Background(), p)
+}
+
+// ScaleKubernetesClusterAsyncWithContext is the same as ScaleKubernetesClusterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *KubernetesService) ScaleKubernetesClusterAsyncWithContext(ctx context.Context, p *ScaleKubernetesClusterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "scaleKubernetesCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ScaleKubernetesClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type ScaleKubernetesClusterResponse struct {
+	Account               string   `json:"account"`
+	Associatednetworkname string   `json:"associatednetworkname"`
+	Consoleendpoint       string   `json:"consoleendpoint"`
+	Cpunumber             string   `json:"cpunumber"`
+	Description           string   `json:"description"`
+	Domain                string   `json:"domain"`
+	Domainid              string   `json:"domainid"`
+	Endpoint              string   `json:"endpoint"`
+	Id                    string   `json:"id"`
+	Ipaddress             string   `json:"ipaddress"`
+	Ipaddressid           string   `json:"ipaddressid"`
+	JobID                 string   `json:"jobid"`
+	Jobstatus             int      `json:"jobstatus"`
+	Keypair               string   `json:"keypair"`
+	Kubernetesversionid   string   `json:"kubernetesversionid"`
+	Kubernetesversionname string   `json:"kubernetesversionname"`
+	Masternodes           int64    `json:"masternodes"`
+	Memory                string   `json:"memory"`
+	Name                  string   `json:"name"`
+	Networkid             string   `json:"networkid"`
+	Project               string   `json:"project"`
+	Projectid             string   `json:"projectid"`
+	Serviceofferingid     string   `json:"serviceofferingid"`
+	Serviceofferingname   string   `json:"serviceofferingname"`
+	Size                  int64    `json:"size"`
+	State                 string   `json:"state"`
+	Templateid            string   `json:"templateid"`
+	Virtualmachines       []string `json:"virtualmachines"`
+	Zoneid                string   `json:"zoneid"`
+	Zonename              string   `json:"zonename"`
+}
+
+type StartKubernetesClusterParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *StartKubernetesClusterParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *StartKubernetesClusterParams) Validate() error {
+	v := newParamValidator("startKubernetesCluster", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *StartKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *StartKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for startKubernetesCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of startKubernetesCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *StartKubernetesClusterParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *StartKubernetesClusterParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *StartKubernetesClusterParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new StartKubernetesClusterParams instance,
+// as then you are sure you have configured all required params
+func (s *KubernetesService) NewStartKubernetesClusterParams(id string) *StartKubernetesClusterParams {
+	p := &StartKubernetesClusterParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Starts a stopped Kubernetes cluster
+func (s *KubernetesService) StartKubernetesCluster(p *StartKubernetesClusterParams) (*StartKubernetesClusterResponse, error) {
+	return s.StartKubernetesClusterWithContext(context.Background(), p)
+}
+
+// StartKubernetesClusterWithContext is the same as StartKubernetesCluster, but uses ctx to cancel the request and any async job polling
+func (s *KubernetesService) StartKubernetesClusterWithContext(ctx context.Context, p *StartKubernetesClusterParams) (*StartKubernetesClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "startKubernetesCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StartKubernetesClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// StartKubernetesClusterAsync starts the async job for StartKubernetesCluster and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a StartKubernetesClusterResponse using Job.Result
+func (s *KubernetesService) StartKubernetesClusterAsync(p *StartKubernetesClusterParams) (*Job, error) {
+	return s.StartKubernetesClusterAsyncWithContext(context.Background(), p)
+}
+
+// StartKubernetesClusterAsyncWithContext is the same as StartKubernetesClusterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *KubernetesService) StartKubernetesClusterAsyncWithContext(ctx context.Context, p *StartKubernetesClusterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "startKubernetesCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StartKubernetesClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type StartKubernetesClusterResponse struct {
+	Account               string   `json:"account"`
+	Associatednetworkname string   `json:"associatednetworkname"`
+	Consoleendpoint       string   `json:"consoleendpoint"`
+	Cpunumber             string   `json:"cpunumber"`
+	Description           string   `json:"description"`
+	Domain                string   `json:"domain"`
+	Domainid              string   `json:"domainid"`
+	Endpoint              string   `json:"endpoint"`
+	Id                    string   `json:"id"`
+	Ipaddress             string   `json:"ipaddress"`
+	Ipaddressid           string   `json:"ipaddressid"`
+	JobID                 string   `json:"jobid"`
+	Jobstatus             int      `json:"jobstatus"`
+	Keypair               string   `json:"keypair"`
+	Kubernetesversionid   string   `json:"kubernetesversionid"`
+	Kubernetesversionname string   `json:"kubernetesversionname"`
+	Masternodes           int64    `json:"masternodes"`
+	Memory                string   `json:"memory"`
+	Name                  string   `json:"name"`
+	Networkid             string   `json:"networkid"`
+	Project               string   `json:"project"`
+	Projectid             string   `json:"projectid"`
+	Serviceofferingid     string   `json:"serviceofferingid"`
+	Serviceofferingname   string   `json:"serviceofferingname"`
+	Size                  int64    `json:"size"`
+	State                 string   `json:"state"`
+	Templateid            string   `json:"templateid"`
+	Virtualmachines       []string `json:"virtualmachines"`
+	Zoneid                string   `json:"zoneid"`
+	Zonename              string   `json:"zonename"`
+}
+
+type StopKubernetesClusterParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *StopKubernetesClusterParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *StopKubernetesClusterParams) Validate() error {
+	v := newParamValidator("stopKubernetesCluster", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *StopKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *StopKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for stopKubernetesCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of stopKubernetesCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *StopKubernetesClusterParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *StopKubernetesClusterParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *StopKubernetesClusterParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new StopKubernetesClusterParams instance,
+// as then you are sure you have configured all required params
+func (s *KubernetesService) NewStopKubernetesClusterParams(id string) *StopKubernetesClusterParams {
+	p := &StopKubernetesClusterParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Stops a running Kubernetes cluster
+func (s *KubernetesService) StopKubernetesCluster(p *StopKubernetesClusterParams) (*StopKubernetesClusterResponse, error) {
+	return s.StopKubernetesClusterWithContext(context.Background(), p)
+}
+
+// StopKubernetesClusterWithContext is the same as StopKubernetesCluster, but uses ctx to cancel the request and any async job polling
+func (s *KubernetesService) StopKubernetesClusterWithContext(ctx context.Context, p *StopKubernetesClusterParams) (*StopKubernetesClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "stopKubernetesCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StopKubernetesClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// StopKubernetesClusterAsync starts the async job for StopKubernetesCluster and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a StopKubernetesClusterResponse using Job.Result
+func (s *KubernetesService) StopKubernetesClusterAsync(p *StopKubernetesClusterParams) (*Job, error) {
+	return s.StopKubernetesClusterAsyncWithContext(context.Background(), p)
+}
+
+// StopKubernetesClusterAsyncWithContext is the same as StopKubernetesClusterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *KubernetesService) StopKubernetesClusterAsyncWithContext(ctx context.Context, p *StopKubernetesClusterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "stopKubernetesCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StopKubernetesClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type StopKubernetesClusterResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type UpdateKubernetesSupportedVersionParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateKubernetesSupportedVersionParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["state"]; found {
+		u.Set("state", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateKubernetesSupportedVersionParams) Validate() error {
+	v := newParamValidator("updateKubernetesSupportedVersion", p.p)
+	v.required("id", "state")
+	v.ids("id")
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateKubernetesSupportedVersionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateKubernetesSupportedVersionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "state":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateKubernetesSupportedVersion", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateKubernetesSupportedVersion: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *UpdateKubernetesSupportedVersionParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *UpdateKubernetesSupportedVersionParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateKubernetesSupportedVersionParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *UpdateKubernetesSupportedVersionParams) SetState(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["state"] = v
+}
+
+func (p *UpdateKubernetesSupportedVersionParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *UpdateKubernetesSupportedVersionParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new UpdateKubernetesSupportedVersionParams instance,
+// as then you are sure you have configured all required params
+func (s *KubernetesService) NewUpdateKubernetesSupportedVersionParams(id string, state string) *UpdateKubernetesSupportedVersionParams {
+	p := &UpdateKubernetesSupportedVersionParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	p.p["state"] = state
+	return p
+}
+
+// Update a supported Kubernetes version
+func (s *KubernetesService) UpdateKubernetesSupportedVersion(p *UpdateKubernetesSupportedVersionParams) (*UpdateKubernetesSupportedVersionResponse, error) {
+	return s.UpdateKubernetesSupportedVersionWithContext(context.Background(), p)
+}
+
+// UpdateKubernetesSupportedVersionWithContext is the same as UpdateKubernetesSupportedVersion, but uses ctx to cancel the request and any async job polling
+func (s *KubernetesService) UpdateKubernetesSupportedVersionWithContext(ctx context.Context, p *UpdateKubernetesSupportedVersionParams) (*UpdateKubernetesSupportedVersionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateKubernetesSupportedVersion", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateKubernetesSupportedVersionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type UpdateKubernetesSupportedVersionResponse struct {
+	Id              string `json:"id"`
+	Isoid           string `json:"isoid"`
+	Isoname         string `json:"isoname"`
+	Isostate        string `json:"isostate"`
+	JobID           string `json:"jobid"`
+	Jobstatus       int    `json:"jobstatus"`
+	Mincpunumber    int    `json:"mincpunumber"`
+	Minmemory       int    `json:"minmemory"`
+	Name            string `json:"name"`
+	Semanticversion string `json:"semanticversion"`
+	State           string `json:"state"`
+	Supportsha      bool   `json:"supportsha"`
+	Zoneid          string `json:"zoneid"`
+	Zonename        string `json:"zonename"`
+}
+
+type UpgradeKubernetesClusterParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpgradeKubernetesClusterParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["kubernetesversionid"]; found {
+		u.Set("kubernetesversionid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpgradeKubernetesClusterParams) Validate() error {
+	v := newParamValidator("upgradeKubernetesCluster", p.p)
+	v.required("id", "kubernetesversionid")
+	v.ids("id", "kubernetesversionid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpgradeKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpgradeKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "kubernetesversionid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for upgradeKubernetesCluster", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of upgradeKubernetesCluster: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *UpgradeKubernetesClusterParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *UpgradeKubernetesClusterParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpgradeKubernetesClusterParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *UpgradeKubernetesClusterParams) SetKubernetesversionid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["kubernetesversionid"] = v
+}
+
+func (p *UpgradeKubernetesClusterParams) ResetKubernetesversionid() {
+	delete(p.p, "kubernetesversionid")
+}
+
+func (p *UpgradeKubernetesClusterParams) GetKubernetesversionid() (string, bool) {
+	value, ok := p.p["kubernetesversionid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new UpgradeKubernetesClusterParams instance,
+// as then you are sure you have configured all required params
+func (s *KubernetesService) NewUpgradeKubernetesClusterParams(id string, kubernetesversionid string) *UpgradeKubernetesClusterParams {
+	p := &UpgradeKubernetesClusterParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	p.p["kubernetesversionid"] = kubernetesversionid
+	return p
+}
+
+// Upgrades a running Kubernetes cluster
+func (s *KubernetesService) UpgradeKubernetesCluster(p *UpgradeKubernetesClusterParams) (*UpgradeKubernetesClusterResponse, error) {
+	return s.UpgradeKubernetesClusterWithContext(context.Background(), p)
+}
+
+// UpgradeKubernetesClusterWithContext is the same as UpgradeKubernetesCluster, but uses ctx to cancel the request and any async job polling
+func (s *KubernetesService) UpgradeKubernetesClusterWithContext(ctx context.Context, p *UpgradeKubernetesClusterParams) (*UpgradeKubernetesClusterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "upgradeKubernetesCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpgradeKubernetesClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// UpgradeKubernetesClusterAsync starts the async job for UpgradeKubernetesCluster and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpgradeKubernetesClusterResponse using Job.Result
+func (s *KubernetesService) UpgradeKubernetesClusterAsync(p *UpgradeKubernetesClusterParams) (*Job, error) {
+	return s.UpgradeKubernetesClusterAsyncWithContext(context.Background(), p)
+}
+
+// UpgradeKubernetesClusterAsyncWithContext is the same as UpgradeKubernetesClusterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *KubernetesService) UpgradeKubernetesClusterAsyncWithContext(ctx context.Context, p *UpgradeKubernetesClusterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "upgradeKubernetesCluster", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpgradeKubernetesClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type UpgradeKubernetesClusterResponse struct {
+	Account               string   `json:"account"`
+	Associatednetworkname string   `json:"associatednetworkname"`
+	Consoleendpoint       string   `json:"consoleendpoint"`
+	Cpunumber             string   `json:"cpunumber"`
+	Description           string   `json:"description"`
+	Domain                string   `json:"domain"`
+	Domainid              string   `json:"domainid"`
+	Endpoint              string   `json:"endpoint"`
+	Id                    string   `json:"id"`
+	Ipaddress             string   `json:"ipaddress"`
+	Ipaddressid           string   `json:"ipaddressid"`
+	JobID                 string   `json:"jobid"`
+	Jobstatus             int      `json:"jobstatus"`
+	Keypair               string   `json:"keypair"`
+	Kubernetesversionid   string   `json:"kubernetesversionid"`
+	Kubernetesversionname string   `json:"kubernetesversionname"`
+	Masternodes           int64    `json:"masternodes"`
+	Memory                string   `json:"memory"`
+	Name                  string   `json:"name"`
+	Networkid             string   `json:"networkid"`
+	Project               string   `json:"project"`
+	Projectid             string   `json:"projectid"`
+	Serviceofferingid     string   `json:"serviceofferingid"`
+	Serviceofferingname   string   `json:"serviceofferingname"`
+	Size                  int64    `json:"size"`
+	State                 string   `json:"state"`
+	Templateid            string   `json:"templateid"`
+	Virtualmachines       []string `json:"virtualmachines"`
+	Zoneid                string   `json:"zoneid"`
+	Zonename              string   `json:"zonename"`
+}
@@ -20,17 +20,64 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// LDAPServiceIface is the interface implemented by LDAPService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type LDAPServiceIface interface {
+	NewAddLdapConfigurationParams(hostname string, port int) *AddLdapConfigurationParams
+	AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error)
+	AddLdapConfigurationWithContext(ctx context.Context, p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error)
+	NewDeleteLdapConfigurationParams(hostname string) *DeleteLdapConfigurationParams
+	DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error)
+	DeleteLdapConfigurationWithContext(ctx context.Context, p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error)
+	NewImportLdapUsersParams() *ImportLdapUsersParams
+	ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error)
+	ImportLdapUsersWithContext(ctx context.Context, p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error)
+	NewLdapConfigParams() *LdapConfigParams
+	LdapConfig(p *LdapConfigParams) (*LdapConfigResponse, error)
+	LdapConfigWithContext(ctx context.Context, p *LdapConfigParams) (*LdapConfigResponse, error)
+	NewLdapCreateAccountParams(username string) *LdapCreateAccountParams
+	LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error)
+	LdapCreateAccountWithContext(ctx context.Context, p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error)
+	NewLdapRemoveParams() *LdapRemoveParams
+	LdapRemove(p *LdapRemoveParams) (*LdapRemoveResponse, error)
+	LdapRemoveWithContext(ctx context.Context, p *LdapRemoveParams) (*LdapRemoveResponse, error)
+	NewLinkAccountToLdapParams(account string, accounttype int, domainid string, ldapdomain string) *LinkAccountToLdapParams
+	LinkAccountToLdap(p *LinkAccountToLdapParams) (*LinkAccountToLdapResponse, error)
+	LinkAccountToLdapWithContext(ctx context.Context, p *LinkAccountToLdapParams) (*LinkAccountToLdapResponse, error)
+	NewLinkDomainToLdapParams(accounttype int, domainid string, lDAPType string) *LinkDomainToLdapParams
+	LinkDomainToLdap(p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error)
+	LinkDomainToLdapWithContext(ctx context.Context, p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error)
+	NewListLdapConfigurationsParams() *ListLdapConfigurationsParams
+	ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error)
+	ListLdapConfigurationsWithContext(ctx context.Context, p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error)
+	NewListLdapConfigurationsPager(p *ListLdapConfigurationsParams) *ListLdapConfigurationsPager
+	ListLdapConfigurationsAll(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error)
+	ListLdapConfigurationsAllWithContext(ctx context.Context, p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error)
+	NewListLdapUsersParams() *ListLdapUsersParams
+	ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error)
+	ListLdapUsersWithContext(ctx context.Context, p *ListLdapUsersParams) (*ListLdapUsersResponse, error)
+	NewListLdapUsersPager(p *ListLdapUsersParams) *ListLdapUsersPager
+	ListLdapUsersAll(p *ListLdapUsersParams) (*ListLdapUsersResponse, error)
+	ListLdapUsersAllWithContext(ctx context.Context, p *ListLdapUsersParams) (*ListLdapUsersResponse, error)
+	NewSearchLdapParams(query string) *SearchLdapParams
+	SearchLdap(p *SearchLdapParams) (*SearchLdapResponse, error)
+	SearchLdapWithContext(ctx context.Context, p *SearchLdapParams) (*SearchLdapResponse, error)
+}
+
 type AddLdapConfigurationParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddLdapConfigurationParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddLdapConfigurationParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -48,6 +95,53 @@ func (p *AddLdapConfigurationParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddLdapConfigurationParams) Validate() error {
+	v := newParamValidator("addLdapConfiguration", p.p)
+	v.required("hostname", "port")
+	v.ids("domainid")
+	v.maxLength("hostname", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddLdapConfigurationParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddLdapConfigurationParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "domainid", "hostname":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "port":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addLdapConfiguration", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addLdapConfiguration: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddLdapConfigurationParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -55,6 +149,15 @@ func (p *AddLdapConfigurationParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *AddLdapConfigurationParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *AddLdapConfigurationParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *AddLdapConfigurationParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -62,6 +165,15 @@ func (p *AddLdapConfigurationParams) SetHostname(v string) {
 	p.p["hostname"] = v
 }
 
+func (p *AddLdapConfigurationParams) ResetHostname() {
+	delete(p.p, "hostname")
+}
+
+func (p *AddLdapConfigurationParams) GetHostname() (string, bool) {
+	value, ok := p.p["hostname"].(string)
+	return value, ok
+}
+
 func (p *AddLdapConfigurationParams) SetPort(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -69,6 +181,15 @@ func (p *AddLdapConfigurationParams) SetPort(v int) {
 	p.p["port"] = v
 }
 
+func (p *AddLdapConfigurationParams) ResetPort() {
+	delete(p.p, "port")
+}
+
+func (p *AddLdapConfigurationParams) GetPort() (int, bool) {
+	value, ok := p.p["port"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new AddLdapConfigurationParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewAddLdapConfigurationParams(hostname string, port int) *AddLdapConfigurationParams {
@@ -81,7 +202,16 @@ func (s *LDAPService) NewAddLdapConfigurationParams(hostname string, port int) *
 
 // Add a new Ldap Configuration
 func (s *LDAPService) AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("addLdapConfiguration", p.toURLValues())
+	return s.AddLdapConfigurationWithContext(context.Background(), p)
+}
+
+// AddLdapConfigurationWithContext is the same as AddLdapConfiguration, but uses ctx to cancel the request and any async job polling
+func (s *LDAPService) AddLdapConfigurationWithContext(ctx context.Context, p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addLdapConfiguration", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +236,8 @@ type DeleteLdapConfigurationParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteLdapConfigurationParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteLdapConfigurationParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -124,6 +255,53 @@ func (p *DeleteLdapConfigurationParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteLdapConfigurationParams) Validate() error {
+	v := newParamValidator("deleteLdapConfiguration", p.p)
+	v.required("hostname")
+	v.ids("domainid")
+	v.maxLength("hostname", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteLdapConfigurationParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteLdapConfigurationParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "domainid", "hostname":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "port":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteLdapConfiguration", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteLdapConfiguration: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteLdapConfigurationParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -131,6 +309,15 @@ func (p *DeleteLdapConfigurationParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *DeleteLdapConfigurationParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *DeleteLdapConfigurationParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *DeleteLdapConfigurationParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -138,6 +325,15 @@ func (p *DeleteLdapConfigurationParams) SetHostname(v string) {
 	p.p["hostname"] = v
 }
 
+func (p *DeleteLdapConfigurationParams) ResetHostname() {
+	delete(p.p, "hostname")
+}
+
+func (p *DeleteLdapConfigurationParams) GetHostname() (string, bool) {
+	value, ok := p.p["hostname"].(string)
+	return value, ok
+}
+
 func (p *DeleteLdapConfigurationParams) SetPort(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -145,6 +341,15 @@ func (p *DeleteLdapConfigurationParams) SetPort(v int) {
 	p.p["port"] = v
 }
 
+func (p *DeleteLdapConfigurationParams) ResetPort() {
+	delete(p.p, "port")
+}
+
+func (p *DeleteLdapConfigurationParams) GetPort() (int, bool) {
+	value, ok := p.p["port"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteLdapConfigurationParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewDeleteLdapConfigurationParams(hostname string) *DeleteLdapConfigurationParams {
@@ -156,7 +361,16 @@ func (s *LDAPService) NewDeleteLdapConfigurationParams(hostname string) *DeleteL
 
 // Remove an Ldap Configuration
 func (s *LDAPService) DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("deleteLdapConfiguration", p.toURLValues())
+	return s.DeleteLdapConfigurationWithContext(context.Background(), p)
+}
+
+// DeleteLdapConfigurationWithContext is the same as DeleteLdapConfiguration, but uses ctx to cancel the request and any async job polling
+func (s *LDAPService) DeleteLdapConfigurationWithContext(ctx context.Context, p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteLdapConfiguration", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +395,8 @@ type ImportLdapUsersParams struct {
 	p map[string]interface{}
 }
 
-func (p *ImportLdapUsersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ImportLdapUsersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -226,6 +441,59 @@ func (p *ImportLdapUsersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ImportLdapUsersParams) Validate() error {
+	v := newParamValidator("importLdapUsers", p.p)
+	v.ids("domainid", "roleid")
+	v.maxLength("account", 255)
+	v.maxLength("group", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("timezone", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ImportLdapUsersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ImportLdapUsersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "group", "keyword", "roleid", "timezone":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "accountdetails":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "accounttype", "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for importLdapUsers", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of importLdapUsers: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ImportLdapUsersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -233,6 +501,15 @@ func (p *ImportLdapUsersParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ImportLdapUsersParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ImportLdapUsersParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ImportLdapUsersParams) SetAccountdetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -240,6 +517,15 @@ func (p *ImportLdapUsersParams) SetAccountdetails(v map[string]string) {
 	p.p["accountdetails"] = v
 }
 
+func (p *ImportLdapUsersParams) ResetAccountdetails() {
+	delete(p.p, "accountdetails")
+}
+
+func (p *ImportLdapUsersParams) GetAccountdetails() (map[string]string, bool) {
+	value, ok := p.p["accountdetails"].(map[string]string)
+	return value, ok
+}
+
 func (p *ImportLdapUsersParams) SetAccounttype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -247,6 +533,15 @@ func (p *ImportLdapUsersParams) SetAccounttype(v int) {
 	p.p["accounttype"] = v
 }
 
+func (p *ImportLdapUsersParams) ResetAccounttype() {
+	delete(p.p, "accounttype")
+}
+
+func (p *ImportLdapUsersParams) GetAccounttype() (int, bool) {
+	value, ok := p.p["accounttype"].(int)
+	return value, ok
+}
+
 func (p *ImportLdapUsersParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -254,6 +549,15 @@ func (p *ImportLdapUsersParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ImportLdapUsersParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ImportLdapUsersParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ImportLdapUsersParams) SetGroup(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -261,6 +565,15 @@ func (p *ImportLdapUsersParams) SetGroup(v string) {
 	p.p["group"] = v
 }
 
+func (p *ImportLdapUsersParams) ResetGroup() {
+	delete(p.p, "group")
+}
+
+func (p *ImportLdapUsersParams) GetGroup() (string, bool) {
+	value, ok := p.p["group"].(string)
+	return value, ok
+}
+
 func (p *ImportLdapUsersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -268,6 +581,15 @@ func (p *ImportLdapUsersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ImportLdapUsersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ImportLdapUsersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ImportLdapUsersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -275,6 +597,15 @@ func (p *ImportLdapUsersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ImportLdapUsersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ImportLdapUsersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ImportLdapUsersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -282,6 +613,15 @@ func (p *ImportLdapUsersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ImportLdapUsersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ImportLdapUsersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ImportLdapUsersParams) SetRoleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -289,6 +629,15 @@ func (p *ImportLdapUsersParams) SetRoleid(v string) {
 	p.p["roleid"] = v
 }
 
+func (p *ImportLdapUsersParams) ResetRoleid() {
+	delete(p.p, "roleid")
+}
+
+func (p *ImportLdapUsersParams) GetRoleid() (string, bool) {
+	value, ok := p.p["roleid"].(string)
+	return value, ok
+}
+
 func (p *ImportLdapUsersParams) SetTimezone(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -296,6 +645,15 @@ func (p *ImportLdapUsersParams) SetTimezone(v string) {
 	p.p["timezone"] = v
 }
 
+func (p *ImportLdapUsersParams) ResetTimezone() {
+	delete(p.p, "timezone")
+}
+
+func (p *ImportLdapUsersParams) GetTimezone() (string, bool) {
+	value, ok := p.p["timezone"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ImportLdapUsersParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewImportLdapUsersParams() *ImportLdapUsersParams {
@@ -306,7 +664,16 @@ func (s *LDAPService) NewImportLdapUsersParams() *ImportLdapUsersParams {
 
 // Import LDAP users
 func (s *LDAPService) ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error) {
-	resp, err := s.cs.newRequest("importLdapUsers", p.toURLValues())
+	return s.ImportLdapUsersWithContext(context.Background(), p)
+}
+
+// ImportLdapUsersWithContext is the same as ImportLdapUsers, but uses ctx to cancel the request and any async job polling
+func (s *LDAPService) ImportLdapUsersWithContext(ctx context.Context, p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "importLdapUsers", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -335,7 +702,8 @@ type LdapConfigParams struct {
 	p map[string]interface{}
 }
 
-func (p *LdapConfigParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *LdapConfigParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -376,6 +744,61 @@ func (p *LdapConfigParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *LdapConfigParams) Validate() error {
+	v := newParamValidator("ldapConfig", p.p)
+	v.maxLength("binddn", 255)
+	v.maxLength("bindpass", 255)
+	v.maxLength("hostname", 255)
+	v.maxLength("queryfilter", 255)
+	v.maxLength("searchbase", 255)
+	v.maxLength("truststore", 255)
+	v.maxLength("truststorepass", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *LdapConfigParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *LdapConfigParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "binddn", "bindpass", "hostname", "queryfilter", "searchbase", "truststore", "truststorepass":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "listall", "ssl":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "port":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for ldapConfig", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of ldapConfig: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *LdapConfigParams) SetBinddn(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -383,6 +806,15 @@ func (p *LdapConfigParams) SetBinddn(v string) {
 	p.p["binddn"] = v
 }
 
+func (p *LdapConfigParams) ResetBinddn() {
+	delete(p.p, "binddn")
+}
+
+func (p *LdapConfigParams) GetBinddn() (string, bool) {
+	value, ok := p.p["binddn"].(string)
+	return value, ok
+}
+
 func (p *LdapConfigParams) SetBindpass(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -390,6 +822,15 @@ func (p *LdapConfigParams) SetBindpass(v string) {
 	p.p["bindpass"] = v
 }
 
+func (p *LdapConfigParams) ResetBindpass() {
+	delete(p.p, "bindpass")
+}
+
+func (p *LdapConfigParams) GetBindpass() (string, bool) {
+	value, ok := p.p["bindpass"].(string)
+	return value, ok
+}
+
 func (p *LdapConfigParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -397,6 +838,15 @@ func (p *LdapConfigParams) SetHostname(v string) {
 	p.p["hostname"] = v
 }
 
+func (p *LdapConfigParams) ResetHostname() {
+	delete(p.p, "hostname")
+}
+
+func (p *LdapConfigParams) GetHostname() (string, bool) {
+	value, ok := p.p["hostname"].(string)
+	return value, ok
+}
+
 func (p *LdapConfigParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -404,6 +854,15 @@ func (p *LdapConfigParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *LdapConfigParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *LdapConfigParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *LdapConfigParams) SetPort(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -411,6 +870,15 @@ func (p *LdapConfigParams) SetPort(v int) {
 	p.p["port"] = v
 }
 
+func (p *LdapConfigParams) ResetPort() {
+	delete(p.p, "port")
+}
+
+func (p *LdapConfigParams) GetPort() (int, bool) {
+	value, ok := p.p["port"].(int)
+	return value, ok
+}
+
 func (p *LdapConfigParams) SetQueryfilter(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -418,6 +886,15 @@ func (p *LdapConfigParams) SetQueryfilter(v string) {
 	p.p["queryfilter"] = v
 }
 
+func (p *LdapConfigParams) ResetQueryfilter() {
+	delete(p.p, "queryfilter")
+}
+
+func (p *LdapConfigParams) GetQueryfilter() (string, bool) {
+	value, ok := p.p["queryfilter"].(string)
+	return value, ok
+}
+
 func (p *LdapConfigParams) SetSearchbase(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -425,6 +902,15 @@ func (p *LdapConfigParams) SetSearchbase(v string) {
 	p.p["searchbase"] = v
 }
 
+func (p *LdapConfigParams) ResetSearchbase() {
+	delete(p.p, "searchbase")
+}
+
+func (p *LdapConfigParams) GetSearchbase() (string, bool) {
+	value, ok := p.p["searchbase"].(string)
+	return value, ok
+}
+
 func (p *LdapConfigParams) SetSsl(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -432,6 +918,15 @@ func (p *LdapConfigParams) SetSsl(v bool) {
 	p.p["ssl"] = v
 }
 
+func (p *LdapConfigParams) ResetSsl() {
+	delete(p.p, "ssl")
+}
+
+func (p *LdapConfigParams) GetSsl() (bool, bool) {
+	value, ok := p.p["ssl"].(bool)
+	return value, ok
+}
+
 func (p *LdapConfigParams) SetTruststore(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -439,6 +934,15 @@ func (p *LdapConfigParams) SetTruststore(v string) {
 	p.p["truststore"] = v
 }
 
+func (p *LdapConfigParams) ResetTruststore() {
+	delete(p.p, "truststore")
+}
+
+func (p *LdapConfigParams) GetTruststore() (string, bool) {
+	value, ok := p.p["truststore"].(string)
+	return value, ok
+}
+
 func (p *LdapConfigParams) SetTruststorepass(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -446,6 +950,15 @@ func (p *LdapConfigParams) SetTruststorepass(v string) {
 	p.p["truststorepass"] = v
 }
 
+func (p *LdapConfigParams) ResetTruststorepass() {
+	delete(p.p, "truststorepass")
+}
+
+func (p *LdapConfigParams) GetTruststorepass() (string, bool) {
+	value, ok := p.p["truststorepass"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new LdapConfigParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewLdapConfigParams() *LdapConfigParams {
@@ -456,7 +969,16 @@ func (s *LDAPService) NewLdapConfigParams() *LdapConfigParams {
 
 // (Deprecated, use addLdapConfiguration) Configure the LDAP context for this site.
 func (s *LDAPService) LdapConfig(p *LdapConfigParams) (*LdapConfigResponse, error) {
-	resp, err := s.cs.newRequest("ldapConfig", p.toURLValues())
+	return s.LdapConfigWithContext(context.Background(), p)
+}
+
+// LdapConfigWithContext is the same as LdapConfig, but uses ctx to cancel the request and any async job polling
+func (s *LDAPService) LdapConfigWithContext(ctx context.Context, p *LdapConfigParams) (*LdapConfigResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "ldapConfig", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -485,7 +1007,8 @@ type LdapCreateAccountParams struct {
 	p map[string]interface{}
 }
 
-func (p *LdapCreateAccountParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *LdapCreateAccountParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -528,6 +1051,62 @@ func (p *LdapCreateAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *LdapCreateAccountParams) Validate() error {
+	v := newParamValidator("ldapCreateAccount", p.p)
+	v.required("username")
+	v.ids("domainid", "roleid")
+	v.maxLength("account", 255)
+	v.maxLength("accountid", 255)
+	v.maxLength("networkdomain", 255)
+	v.maxLength("timezone", 255)
+	v.maxLength("userid", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *LdapCreateAccountParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *LdapCreateAccountParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "accountid", "domainid", "networkdomain", "roleid", "timezone", "userid", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "accountdetails":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "accounttype":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for ldapCreateAccount", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of ldapCreateAccount: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *LdapCreateAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -535,6 +1114,15 @@ func (p *LdapCreateAccountParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *LdapCreateAccountParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *LdapCreateAccountParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *LdapCreateAccountParams) SetAccountdetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -542,6 +1130,15 @@ func (p *LdapCreateAccountParams) SetAccountdetails(v map[string]string) {
 	p.p["accountdetails"] = v
 }
 
+func (p *LdapCreateAccountParams) ResetAccountdetails() {
+	delete(p.p, "accountdetails")
+}
+
+func (p *LdapCreateAccountParams) GetAccountdetails() (map[string]string, bool) {
+	value, ok := p.p["accountdetails"].(map[string]string)
+	return value, ok
+}
+
 func (p *LdapCreateAccountParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -549,6 +1146,15 @@ func (p *LdapCreateAccountParams) SetAccountid(v string) {
 	p.p["accountid"] = v
 }
 
+func (p *LdapCreateAccountParams) ResetAccountid() {
+	delete(p.p, "accountid")
+}
+
+func (p *LdapCreateAccountParams) GetAccountid() (string, bool) {
+	value, ok := p.p["accountid"].(string)
+	return value, ok
+}
+
 func (p *LdapCreateAccountParams) SetAccounttype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -556,6 +1162,15 @@ func (p *LdapCreateAccountParams) SetAccounttype(v int) {
 	p.p["accounttype"] = v
 }
 
+func (p *LdapCreateAccountParams) ResetAccounttype() {
+	delete(p.p, "accounttype")
+}
+
+func (p *LdapCreateAccountParams) GetAccounttype() (int, bool) {
+	value, ok := p.p["accounttype"].(int)
+	return value, ok
+}
+
 func (p *LdapCreateAccountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -563,6 +1178,15 @@ func (p *LdapCreateAccountParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *LdapCreateAccountParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *LdapCreateAccountParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *LdapCreateAccountParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -570,6 +1194,15 @@ func (p *LdapCreateAccountParams) SetNetworkdomain(v string) {
 	p.p["networkdomain"] = v
 }
 
+func (p *LdapCreateAccountParams) ResetNetworkdomain() {
+	delete(p.p, "networkdomain")
+}
+
+func (p *LdapCreateAccountParams) GetNetworkdomain() (string, bool) {
+	value, ok := p.p["networkdomain"].(string)
+	return value, ok
+}
+
 func (p *LdapCreateAccountParams) SetRoleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -577,6 +1210,15 @@ func (p *LdapCreateAccountParams) SetRoleid(v string) {
 	p.p["roleid"] = v
 }
 
+func (p *LdapCreateAccountParams) ResetRoleid() {
+	delete(p.p, "roleid")
+}
+
+func (p *LdapCreateAccountParams) GetRoleid() (string, bool) {
+	value, ok := p.p["roleid"].(string)
+	return value, ok
+}
+
 func (p *LdapCreateAccountParams) SetTimezone(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -584,6 +1226,15 @@ func (p *LdapCreateAccountParams) SetTimezone(v string) {
 	p.p["timezone"] = v
 }
 
+func (p *LdapCreateAccountParams) ResetTimezone() {
+	delete(p.p, "timezone")
+}
+
+func (p *LdapCreateAccountParams) GetTimezone() (string, bool) {
+	value, ok := p.p["timezone"].(string)
+	return value, ok
+}
+
 func (p *LdapCreateAccountParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -591,6 +1242,15 @@ func (p *LdapCreateAccountParams) SetUserid(v string) {
 	p.p["userid"] = v
 }
 
+func (p *LdapCreateAccountParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *LdapCreateAccountParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
 func (p *LdapCreateAccountParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -598,6 +1258,15 @@ func (p *LdapCreateAccountParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *LdapCreateAccountParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *LdapCreateAccountParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new LdapCreateAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewLdapCreateAccountParams(username string) *LdapCreateAccountParams {
@@ -609,7 +1278,16 @@ func (s *LDAPService) NewLdapCreateAccountParams(username string) *LdapCreateAcc
 
 // Creates an account from an LDAP user
 func (s *LDAPService) LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error) {
-	resp, err := s.cs.newRequest("ldapCreateAccount", p.toURLValues())
+	return s.LdapCreateAccountWithContext(context.Background(), p)
+}
+
+// LdapCreateAccountWithContext is the same as LdapCreateAccount, but uses ctx to cancel the request and any async job polling
+func (s *LDAPService) LdapCreateAccountWithContext(ctx context.Context, p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "ldapCreateAccount", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -712,7 +1390,8 @@ type LdapRemoveParams struct {
 	p map[string]interface{}
 }
 
-func (p *LdapRemoveParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *LdapRemoveParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -720,6 +1399,34 @@ func (p *LdapRemoveParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *LdapRemoveParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *LdapRemoveParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *LdapRemoveParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k := range m {
+		return fmt.Errorf("Unknown param %s for ldapRemove", k)
+	}
+	return nil
+}
+
 // You should always use this function to get a new LdapRemoveParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewLdapRemoveParams() *LdapRemoveParams {
@@ -730,7 +1437,16 @@ func (s *LDAPService) NewLdapRemoveParams() *LdapRemoveParams {
 
 // (Deprecated , use deleteLdapConfiguration) Remove the LDAP context for this site.
 func (s *LDAPService) LdapRemove(p *LdapRemoveParams) (*LdapRemoveResponse, error) {
-	resp, err := s.cs.newRequest("ldapRemove", p.toURLValues())
+	return s.LdapRemoveWithContext(context.Background(), p)
+}
+
+// LdapRemoveWithContext is the same as LdapRemove, but uses ctx to cancel the request and any async job polling
+func (s *LDAPService) LdapRemoveWithContext(ctx context.Context, p *LdapRemoveParams) (*LdapRemoveResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "ldapRemove", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -755,15 +1471,19 @@ type LdapRemoveResponse struct {
 	Ssl         string `json:"ssl"`
 }
 
-type LinkDomainToLdapParams struct {
+type LinkAccountToLdapParams struct {
 	p map[string]interface{}
 }
 
-func (p *LinkDomainToLdapParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *LinkAccountToLdapParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
 	if v, found := p.p["accounttype"]; found {
 		vv := strconv.Itoa(v.(int))
 		u.Set("accounttype", vv)
@@ -777,43 +1497,351 @@ func (p *LinkDomainToLdapParams) toURLValues() url.Values {
 	if v, found := p.p["ldapdomain"]; found {
 		u.Set("ldapdomain", v.(string))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
 	if v, found := p.p["type"]; found {
 		u.Set("type", v.(string))
 	}
 	return u
 }
 
-func (p *LinkDomainToLdapParams) SetAccounttype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *LinkAccountToLdapParams) Validate() error {
+	v := newParamValidator("linkAccountToLdap", p.p)
+	v.required("account", "accounttype", "domainid", "ldapdomain")
+	v.ids("domainid")
+	v.maxLength("account", 255)
+	v.maxLength("admin", 255)
+	v.maxLength("ldapdomain", 255)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *LinkAccountToLdapParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *LinkAccountToLdapParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "admin", "domainid", "ldapdomain", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "accounttype":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for linkAccountToLdap", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of linkAccountToLdap: %w", k, err)
+		}
 	}
-	p.p["accounttype"] = v
+	return nil
 }
 
-func (p *LinkDomainToLdapParams) SetAdmin(v string) {
+func (p *LinkAccountToLdapParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["admin"] = v
+	p.p["account"] = v
 }
 
-func (p *LinkDomainToLdapParams) SetDomainid(v string) {
+func (p *LinkAccountToLdapParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *LinkAccountToLdapParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *LinkAccountToLdapParams) SetAccounttype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["domainid"] = v
+	p.p["accounttype"] = v
 }
 
-func (p *LinkDomainToLdapParams) SetLdapdomain(v string) {
+func (p *LinkAccountToLdapParams) ResetAccounttype() {
+	delete(p.p, "accounttype")
+}
+
+func (p *LinkAccountToLdapParams) GetAccounttype() (int, bool) {
+	value, ok := p.p["accounttype"].(int)
+	return value, ok
+}
+
+func (p *LinkAccountToLdapParams) SetAdmin(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["admin"] = v
+}
+
+func (p *LinkAccountToLdapParams) ResetAdmin() {
+	delete(p.p, "admin")
+}
+
+func (p *LinkAccountToLdapParams) GetAdmin() (string, bool) {
+	value, ok := p.p["admin"].(string)
+	return value, ok
+}
+
+func (p *LinkAccountToLdapParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *LinkAccountToLdapParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *LinkAccountToLdapParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *LinkAccountToLdapParams) SetLdapdomain(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ldapdomain"] = v
+}
+
+func (p *LinkAccountToLdapParams) ResetLdapdomain() {
+	delete(p.p, "ldapdomain")
+}
+
+func (p *LinkAccountToLdapParams) GetLdapdomain() (string, bool) {
+	value, ok := p.p["ldapdomain"].(string)
+	return value, ok
+}
+
+func (p *LinkAccountToLdapParams) SetType(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["type"] = v
+}
+
+func (p *LinkAccountToLdapParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *LinkAccountToLdapParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new LinkAccountToLdapParams instance,
+// as then you are sure you have configured all required params
+func (s *LDAPService) NewLinkAccountToLdapParams(account string, accounttype int, domainid string, ldapdomain string) *LinkAccountToLdapParams {
+	p := &LinkAccountToLdapParams{}
+	p.p = make(map[string]interface{})
+	p.p["account"] = account
+	p.p["accounttype"] = accounttype
+	p.p["domainid"] = domainid
+	p.p["ldapdomain"] = ldapdomain
+	return p
+}
+
+// link a cloudstack account to a group or OU in ldap
+func (s *LDAPService) LinkAccountToLdap(p *LinkAccountToLdapParams) (*LinkAccountToLdapResponse, error) {
+	return s.LinkAccountToLdapWithContext(context.Background(), p)
+}
+
+// LinkAccountToLdapWithContext is the same as LinkAccountToLdap, but uses ctx to cancel the request and any async job polling
+func (s *LDAPService) LinkAccountToLdapWithContext(ctx context.Context, p *LinkAccountToLdapParams) (*LinkAccountToLdapResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "linkAccountToLdap", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r LinkAccountToLdapResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type LinkAccountToLdapResponse struct {
+	Accountid   string `json:"accountid"`
+	Accounttype int    `json:"accounttype"`
+	Domainid    string `json:"domainid"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Ldapdomain  string `json:"ldapdomain"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+}
+
+type LinkDomainToLdapParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *LinkDomainToLdapParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["accounttype"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("accounttype", vv)
+	}
+	if v, found := p.p["admin"]; found {
+		u.Set("admin", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["ldapdomain"]; found {
+		u.Set("ldapdomain", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["type"]; found {
+		u.Set("type", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *LinkDomainToLdapParams) Validate() error {
+	v := newParamValidator("linkDomainToLdap", p.p)
+	v.required("accounttype", "domainid", "type")
+	v.ids("domainid")
+	v.maxLength("admin", 255)
+	v.maxLength("ldapdomain", 255)
+	v.maxLength("name", 255)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *LinkDomainToLdapParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *LinkDomainToLdapParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "accounttype":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "admin", "domainid", "ldapdomain", "name", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for linkDomainToLdap", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of linkDomainToLdap: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *LinkDomainToLdapParams) SetAccounttype(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["accounttype"] = v
+}
+
+func (p *LinkDomainToLdapParams) ResetAccounttype() {
+	delete(p.p, "accounttype")
+}
+
+func (p *LinkDomainToLdapParams) GetAccounttype() (int, bool) {
+	value, ok := p.p["accounttype"].(int)
+	return value, ok
+}
+
+func (p *LinkDomainToLdapParams) SetAdmin(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["admin"] = v
+}
+
+func (p *LinkDomainToLdapParams) ResetAdmin() {
+	delete(p.p, "admin")
+}
+
+func (p *LinkDomainToLdapParams) GetAdmin() (string, bool) {
+	value, ok := p.p["admin"].(string)
+	return value, ok
+}
+
+func (p *LinkDomainToLdapParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *LinkDomainToLdapParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *LinkDomainToLdapParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *LinkDomainToLdapParams) SetLdapdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["ldapdomain"] = v
 }
 
+func (p *LinkDomainToLdapParams) ResetLdapdomain() {
+	delete(p.p, "ldapdomain")
+}
+
+func (p *LinkDomainToLdapParams) GetLdapdomain() (string, bool) {
+	value, ok := p.p["ldapdomain"].(string)
+	return value, ok
+}
+
 func (p *LinkDomainToLdapParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -821,6 +1849,15 @@ func (p *LinkDomainToLdapParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *LinkDomainToLdapParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *LinkDomainToLdapParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *LinkDomainToLdapParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -828,6 +1865,15 @@ func (p *LinkDomainToLdapParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *LinkDomainToLdapParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *LinkDomainToLdapParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new LinkDomainToLdapParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewLinkDomainToLdapParams(accounttype int, domainid string, lDAPType string) *LinkDomainToLdapParams {
@@ -841,7 +1887,16 @@ func (s *LDAPService) NewLinkDomainToLdapParams(accounttype int, domainid string
 
 // link an existing cloudstack domain to group or OU in ldap
 func (s *LDAPService) LinkDomainToLdap(p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error) {
-	resp, err := s.cs.newRequest("linkDomainToLdap", p.toURLValues())
+	return s.LinkDomainToLdapWithContext(context.Background(), p)
+}
+
+// LinkDomainToLdapWithContext is the same as LinkDomainToLdap, but uses ctx to cancel the request and any async job polling
+func (s *LDAPService) LinkDomainToLdapWithContext(ctx context.Context, p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "linkDomainToLdap", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -869,7 +1924,8 @@ type ListLdapConfigurationsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListLdapConfigurationsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListLdapConfigurationsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -902,6 +1958,57 @@ func (p *ListLdapConfigurationsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListLdapConfigurationsParams) Validate() error {
+	v := newParamValidator("listLdapConfigurations", p.p)
+	v.ids("domainid")
+	v.maxLength("hostname", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListLdapConfigurationsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListLdapConfigurationsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "domainid", "hostname", "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize", "port":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listLdapConfigurations", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listLdapConfigurations: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListLdapConfigurationsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -909,6 +2016,15 @@ func (p *ListLdapConfigurationsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListLdapConfigurationsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListLdapConfigurationsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListLdapConfigurationsParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -916,6 +2032,15 @@ func (p *ListLdapConfigurationsParams) SetHostname(v string) {
 	p.p["hostname"] = v
 }
 
+func (p *ListLdapConfigurationsParams) ResetHostname() {
+	delete(p.p, "hostname")
+}
+
+func (p *ListLdapConfigurationsParams) GetHostname() (string, bool) {
+	value, ok := p.p["hostname"].(string)
+	return value, ok
+}
+
 func (p *ListLdapConfigurationsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -923,6 +2048,15 @@ func (p *ListLdapConfigurationsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListLdapConfigurationsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListLdapConfigurationsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListLdapConfigurationsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -930,6 +2064,15 @@ func (p *ListLdapConfigurationsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListLdapConfigurationsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListLdapConfigurationsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListLdapConfigurationsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -937,6 +2080,15 @@ func (p *ListLdapConfigurationsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListLdapConfigurationsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListLdapConfigurationsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListLdapConfigurationsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -944,6 +2096,15 @@ func (p *ListLdapConfigurationsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListLdapConfigurationsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListLdapConfigurationsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListLdapConfigurationsParams) SetPort(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -951,6 +2112,15 @@ func (p *ListLdapConfigurationsParams) SetPort(v int) {
 	p.p["port"] = v
 }
 
+func (p *ListLdapConfigurationsParams) ResetPort() {
+	delete(p.p, "port")
+}
+
+func (p *ListLdapConfigurationsParams) GetPort() (int, bool) {
+	value, ok := p.p["port"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListLdapConfigurationsParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewListLdapConfigurationsParams() *ListLdapConfigurationsParams {
@@ -961,7 +2131,16 @@ func (s *LDAPService) NewListLdapConfigurationsParams() *ListLdapConfigurationsP
 
 // Lists all LDAP configurations
 func (s *LDAPService) ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
-	resp, err := s.cs.newRequest("listLdapConfigurations", p.toURLValues())
+	return s.ListLdapConfigurationsWithContext(context.Background(), p)
+}
+
+// ListLdapConfigurationsWithContext is the same as ListLdapConfigurations, but uses ctx to cancel the request and any async job polling
+func (s *LDAPService) ListLdapConfigurationsWithContext(ctx context.Context, p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listLdapConfigurations", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -974,6 +2153,92 @@ func (s *LDAPService) ListLdapConfigurations(p *ListLdapConfigurationsParams) (*
 	return &r, nil
 }
 
+// ListLdapConfigurationsPager iterates over all pages of a ListLdapConfigurations call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListLdapConfigurationsPager struct {
+	pager
+
+	s *LDAPService
+	p *ListLdapConfigurationsParams
+	r *ListLdapConfigurationsResponse
+}
+
+// NewListLdapConfigurationsPager returns a pager for all pages of a ListLdapConfigurations call with the given params
+func (s *LDAPService) NewListLdapConfigurationsPager(p *ListLdapConfigurationsParams) *ListLdapConfigurationsPager {
+	return &ListLdapConfigurationsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListLdapConfigurationsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListLdapConfigurationsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListLdapConfigurationsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListLdapConfigurationsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.LdapConfigurations), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListLdapConfigurationsPager) Page() *ListLdapConfigurationsResponse {
+	return pg.r
+}
+
+// ListLdapConfigurationsAll fetches all pages of a ListLdapConfigurations call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *LDAPService) ListLdapConfigurationsAll(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
+	return s.ListLdapConfigurationsAllWithContext(context.Background(), p)
+}
+
+// ListLdapConfigurationsAllWithContext is the same as ListLdapConfigurationsAll, but uses ctx to cancel the requests
+func (s *LDAPService) ListLdapConfigurationsAllWithContext(ctx context.Context, p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*LdapConfiguration)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListLdapConfigurationsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListLdapConfigurationsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.LdapConfigurations
+		mu.Unlock()
+
+		return len(l.LdapConfigurations), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListLdapConfigurationsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.LdapConfigurations = append(r.LdapConfigurations, pages[page]...)
+	}
+	r.Count = len(r.LdapConfigurations)
+
+	return r, nil
+}
+
 type ListLdapConfigurationsResponse struct {
 	Count              int                  `json:"count"`
 	LdapConfigurations []*LdapConfiguration `json:"ldapconfiguration"`
@@ -991,7 +2256,8 @@ type ListLdapUsersParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListLdapUsersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListLdapUsersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1019,6 +2285,54 @@ func (p *ListLdapUsersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListLdapUsersParams) Validate() error {
+	v := newParamValidator("listLdapUsers", p.p)
+	v.ids("domainid")
+	v.maxLength("keyword", 255)
+	v.maxLength("listtype", 255)
+	v.maxLength("userfilter", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListLdapUsersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListLdapUsersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "domainid", "keyword", "listtype", "userfilter":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listLdapUsers", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listLdapUsers: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListLdapUsersParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1026,6 +2340,15 @@ func (p *ListLdapUsersParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListLdapUsersParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListLdapUsersParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListLdapUsersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1033,6 +2356,15 @@ func (p *ListLdapUsersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListLdapUsersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListLdapUsersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListLdapUsersParams) SetListtype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1040,6 +2372,15 @@ func (p *ListLdapUsersParams) SetListtype(v string) {
 	p.p["listtype"] = v
 }
 
+func (p *ListLdapUsersParams) ResetListtype() {
+	delete(p.p, "listtype")
+}
+
+func (p *ListLdapUsersParams) GetListtype() (string, bool) {
+	value, ok := p.p["listtype"].(string)
+	return value, ok
+}
+
 func (p *ListLdapUsersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1047,6 +2388,15 @@ func (p *ListLdapUsersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListLdapUsersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListLdapUsersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListLdapUsersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1054,6 +2404,15 @@ func (p *ListLdapUsersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListLdapUsersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListLdapUsersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListLdapUsersParams) SetUserfilter(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1061,6 +2420,15 @@ func (p *ListLdapUsersParams) SetUserfilter(v string) {
 	p.p["userfilter"] = v
 }
 
+func (p *ListLdapUsersParams) ResetUserfilter() {
+	delete(p.p, "userfilter")
+}
+
+func (p *ListLdapUsersParams) GetUserfilter() (string, bool) {
+	value, ok := p.p["userfilter"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListLdapUsersParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewListLdapUsersParams() *ListLdapUsersParams {
@@ -1071,7 +2439,16 @@ func (s *LDAPService) NewListLdapUsersParams() *ListLdapUsersParams {
 
 // Lists LDAP Users according to the specifications from the user request.
 func (s *LDAPService) ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
-	resp, err := s.cs.newRequest("listLdapUsers", p.toURLValues())
+	return s.ListLdapUsersWithContext(context.Background(), p)
+}
+
+// ListLdapUsersWithContext is the same as ListLdapUsers, but uses ctx to cancel the request and any async job polling
+func (s *LDAPService) ListLdapUsersWithContext(ctx context.Context, p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listLdapUsers", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1084,6 +2461,92 @@ func (s *LDAPService) ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersRespo
 	return &r, nil
 }
 
+// ListLdapUsersPager iterates over all pages of a ListLdapUsers call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListLdapUsersPager struct {
+	pager
+
+	s *LDAPService
+	p *ListLdapUsersParams
+	r *ListLdapUsersResponse
+}
+
+// NewListLdapUsersPager returns a pager for all pages of a ListLdapUsers call with the given params
+func (s *LDAPService) NewListLdapUsersPager(p *ListLdapUsersParams) *ListLdapUsersPager {
+	return &ListLdapUsersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListLdapUsersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListLdapUsersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListLdapUsersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListLdapUsersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.LdapUsers), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListLdapUsersPager) Page() *ListLdapUsersResponse {
+	return pg.r
+}
+
+// ListLdapUsersAll fetches all pages of a ListLdapUsers call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *LDAPService) ListLdapUsersAll(p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
+	return s.ListLdapUsersAllWithContext(context.Background(), p)
+}
+
+// ListLdapUsersAllWithContext is the same as ListLdapUsersAll, but uses ctx to cancel the requests
+func (s *LDAPService) ListLdapUsersAllWithContext(ctx context.Context, p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*LdapUser)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListLdapUsersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListLdapUsersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.LdapUsers
+		mu.Unlock()
+
+		return len(l.LdapUsers), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListLdapUsersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.LdapUsers = append(r.LdapUsers, pages[page]...)
+	}
+	r.Count = len(r.LdapUsers)
+
+	return r, nil
+}
+
 type ListLdapUsersResponse struct {
 	Count     int         `json:"count"`
 	LdapUsers []*LdapUser `json:"ldapuser"`
@@ -1105,7 +2568,8 @@ type SearchLdapParams struct {
 	p map[string]interface{}
 }
 
-func (p *SearchLdapParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *SearchLdapParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1127,6 +2591,53 @@ func (p *SearchLdapParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *SearchLdapParams) Validate() error {
+	v := newParamValidator("searchLdap", p.p)
+	v.required("query")
+	v.maxLength("keyword", 255)
+	v.maxLength("query", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *SearchLdapParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *SearchLdapParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "query":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for searchLdap", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of searchLdap: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *SearchLdapParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1134,6 +2645,15 @@ func (p *SearchLdapParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *SearchLdapParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *SearchLdapParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *SearchLdapParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1141,6 +2661,15 @@ func (p *SearchLdapParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *SearchLdapParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *SearchLdapParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *SearchLdapParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1148,6 +2677,15 @@ func (p *SearchLdapParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *SearchLdapParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *SearchLdapParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *SearchLdapParams) SetQuery(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1155,6 +2693,15 @@ func (p *SearchLdapParams) SetQuery(v string) {
 	p.p["query"] = v
 }
 
+func (p *SearchLdapParams) ResetQuery() {
+	delete(p.p, "query")
+}
+
+func (p *SearchLdapParams) GetQuery() (string, bool) {
+	value, ok := p.p["query"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new SearchLdapParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewSearchLdapParams(query string) *SearchLdapParams {
@@ -1166,7 +2713,16 @@ func (s *LDAPService) NewSearchLdapParams(query string) *SearchLdapParams {
 
 // Searches LDAP based on the username attribute
 func (s *LDAPService) SearchLdap(p *SearchLdapParams) (*SearchLdapResponse, error) {
-	resp, err := s.cs.newRequest("searchLdap", p.toURLValues())
+	return s.SearchLdapWithContext(context.Background(), p)
+}
+
+// SearchLdapWithContext is the same as SearchLdap, but uses ctx to cancel the request and any async job polling
+func (s *LDAPService) SearchLdapWithContext(ctx context.Context, p *SearchLdapParams) (*SearchLdapResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "searchLdap", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -20,16 +20,43 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// LimitServiceIface is the interface implemented by LimitService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type LimitServiceIface interface {
+	NewGetApiLimitParams() *GetApiLimitParams
+	GetApiLimit(p *GetApiLimitParams) (*GetApiLimitResponse, error)
+	GetApiLimitWithContext(ctx context.Context, p *GetApiLimitParams) (*GetApiLimitResponse, error)
+	NewListResourceLimitsParams() *ListResourceLimitsParams
+	ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error)
+	ListResourceLimitsWithContext(ctx context.Context, p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error)
+	NewListResourceLimitsPager(p *ListResourceLimitsParams) *ListResourceLimitsPager
+	ListResourceLimitsAll(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error)
+	ListResourceLimitsAllWithContext(ctx context.Context, p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error)
+	NewResetApiLimitParams() *ResetApiLimitParams
+	ResetApiLimit(p *ResetApiLimitParams) (*ResetApiLimitResponse, error)
+	ResetApiLimitWithContext(ctx context.Context, p *ResetApiLimitParams) (*ResetApiLimitResponse, error)
+	NewUpdateResourceCountParams(domainid string) *UpdateResourceCountParams
+	UpdateResourceCount(p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error)
+	UpdateResourceCountWithContext(ctx context.Context, p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error)
+	NewUpdateResourceLimitParams(resourcetype int) *UpdateResourceLimitParams
+	UpdateResourceLimit(p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error)
+	UpdateResourceLimitWithContext(ctx context.Context, p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error)
+}
+
 type GetApiLimitParams struct {
 	p map[string]interface{}
 }
 
-func (p *GetApiLimitParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetApiLimitParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -37,6 +64,34 @@ func (p *GetApiLimitParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetApiLimitParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetApiLimitParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetApiLimitParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k := range m {
+		return fmt.Errorf("Unknown param %s for getApiLimit", k)
+	}
+	return nil
+}
+
 // You should always use this function to get a new GetApiLimitParams instance,
 // as then you are sure you have configured all required params
 func (s *LimitService) NewGetApiLimitParams() *GetApiLimitParams {
@@ -47,11 +102,24 @@ func (s *LimitService) NewGetApiLimitParams() *GetApiLimitParams {
 
 // Get API limit count for the caller
 func (s *LimitService) GetApiLimit(p *GetApiLimitParams) (*GetApiLimitResponse, error) {
-	resp, err := s.cs.newRequest("getApiLimit", p.toURLValues())
+	return s.GetApiLimitWithContext(context.Background(), p)
+}
+
+// GetApiLimitWithContext is the same as GetApiLimit, but uses ctx to cancel the request and any async job polling
+func (s *LimitService) GetApiLimitWithContext(ctx context.Context, p *GetApiLimitParams) (*GetApiLimitResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getApiLimit", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
 	var r GetApiLimitResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
@@ -74,7 +142,8 @@ type ListResourceLimitsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListResourceLimitsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListResourceLimitsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -121,6 +190,62 @@ func (p *ListResourceLimitsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListResourceLimitsParams) Validate() error {
+	v := newParamValidator("listResourceLimits", p.p)
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("resourcetypename", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListResourceLimitsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListResourceLimitsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "keyword", "projectid", "resourcetypename":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize", "resourcetype":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listResourceLimits", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listResourceLimits: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListResourceLimitsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -128,6 +253,15 @@ func (p *ListResourceLimitsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListResourceLimitsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListResourceLimitsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListResourceLimitsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -135,6 +269,15 @@ func (p *ListResourceLimitsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListResourceLimitsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListResourceLimitsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListResourceLimitsParams) SetId(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -142,6 +285,15 @@ func (p *ListResourceLimitsParams) SetId(v int64) {
 	p.p["id"] = v
 }
 
+func (p *ListResourceLimitsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListResourceLimitsParams) GetId() (int64, bool) {
+	value, ok := p.p["id"].(int64)
+	return value, ok
+}
+
 func (p *ListResourceLimitsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -149,6 +301,15 @@ func (p *ListResourceLimitsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListResourceLimitsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListResourceLimitsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListResourceLimitsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -156,6 +317,15 @@ func (p *ListResourceLimitsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListResourceLimitsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListResourceLimitsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListResourceLimitsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -163,6 +333,15 @@ func (p *ListResourceLimitsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListResourceLimitsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListResourceLimitsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListResourceLimitsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -170,6 +349,15 @@ func (p *ListResourceLimitsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListResourceLimitsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListResourceLimitsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListResourceLimitsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -177,6 +365,15 @@ func (p *ListResourceLimitsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListResourceLimitsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListResourceLimitsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListResourceLimitsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -184,6 +381,15 @@ func (p *ListResourceLimitsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListResourceLimitsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListResourceLimitsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListResourceLimitsParams) SetResourcetype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -191,6 +397,15 @@ func (p *ListResourceLimitsParams) SetResourcetype(v int) {
 	p.p["resourcetype"] = v
 }
 
+func (p *ListResourceLimitsParams) ResetResourcetype() {
+	delete(p.p, "resourcetype")
+}
+
+func (p *ListResourceLimitsParams) GetResourcetype() (int, bool) {
+	value, ok := p.p["resourcetype"].(int)
+	return value, ok
+}
+
 func (p *ListResourceLimitsParams) SetResourcetypename(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -198,6 +413,15 @@ func (p *ListResourceLimitsParams) SetResourcetypename(v string) {
 	p.p["resourcetypename"] = v
 }
 
+func (p *ListResourceLimitsParams) ResetResourcetypename() {
+	delete(p.p, "resourcetypename")
+}
+
+func (p *ListResourceLimitsParams) GetResourcetypename() (string, bool) {
+	value, ok := p.p["resourcetypename"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListResourceLimitsParams instance,
 // as then you are sure you have configured all required params
 func (s *LimitService) NewListResourceLimitsParams() *ListResourceLimitsParams {
@@ -208,7 +432,16 @@ func (s *LimitService) NewListResourceLimitsParams() *ListResourceLimitsParams {
 
 // Lists resource limits.
 func (s *LimitService) ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
-	resp, err := s.cs.newRequest("listResourceLimits", p.toURLValues())
+	return s.ListResourceLimitsWithContext(context.Background(), p)
+}
+
+// ListResourceLimitsWithContext is the same as ListResourceLimits, but uses ctx to cancel the request and any async job polling
+func (s *LimitService) ListResourceLimitsWithContext(ctx context.Context, p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listResourceLimits", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -221,6 +454,92 @@ func (s *LimitService) ListResourceLimits(p *ListResourceLimitsParams) (*ListRes
 	return &r, nil
 }
 
+// ListResourceLimitsPager iterates over all pages of a ListResourceLimits call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListResourceLimitsPager struct {
+	pager
+
+	s *LimitService
+	p *ListResourceLimitsParams
+	r *ListResourceLimitsResponse
+}
+
+// NewListResourceLimitsPager returns a pager for all pages of a ListResourceLimits call with the given params
+func (s *LimitService) NewListResourceLimitsPager(p *ListResourceLimitsParams) *ListResourceLimitsPager {
+	return &ListResourceLimitsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListResourceLimitsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListResourceLimitsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListResourceLimitsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListResourceLimitsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.ResourceLimits), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListResourceLimitsPager) Page() *ListResourceLimitsResponse {
+	return pg.r
+}
+
+// ListResourceLimitsAll fetches all pages of a ListResourceLimits call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *LimitService) ListResourceLimitsAll(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
+	return s.ListResourceLimitsAllWithContext(context.Background(), p)
+}
+
+// ListResourceLimitsAllWithContext is the same as ListResourceLimitsAll, but uses ctx to cancel the requests
+func (s *LimitService) ListResourceLimitsAllWithContext(ctx context.Context, p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*ResourceLimit)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListResourceLimitsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListResourceLimitsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.ResourceLimits
+		mu.Unlock()
+
+		return len(l.ResourceLimits), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListResourceLimitsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.ResourceLimits = append(r.ResourceLimits, pages[page]...)
+	}
+	r.Count = len(r.ResourceLimits)
+
+	return r, nil
+}
+
 type ListResourceLimitsResponse struct {
 	Count          int              `json:"count"`
 	ResourceLimits []*ResourceLimit `json:"resourcelimit"`
@@ -243,7 +562,8 @@ type ResetApiLimitParams struct {
 	p map[string]interface{}
 }
 
-func (p *ResetApiLimitParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ResetApiLimitParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -254,6 +574,47 @@ func (p *ResetApiLimitParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ResetApiLimitParams) Validate() error {
+	v := newParamValidator("resetApiLimit", p.p)
+	v.ids("account")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ResetApiLimitParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ResetApiLimitParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for resetApiLimit", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of resetApiLimit: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ResetApiLimitParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -261,6 +622,15 @@ func (p *ResetApiLimitParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ResetApiLimitParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ResetApiLimitParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ResetApiLimitParams instance,
 // as then you are sure you have configured all required params
 func (s *LimitService) NewResetApiLimitParams() *ResetApiLimitParams {
@@ -271,7 +641,16 @@ func (s *LimitService) NewResetApiLimitParams() *ResetApiLimitParams {
 
 // Reset api count
 func (s *LimitService) ResetApiLimit(p *ResetApiLimitParams) (*ResetApiLimitResponse, error) {
-	resp, err := s.cs.newRequest("resetApiLimit", p.toURLValues())
+	return s.ResetApiLimitWithContext(context.Background(), p)
+}
+
+// ResetApiLimitWithContext is the same as ResetApiLimit, but uses ctx to cancel the request and any async job polling
+func (s *LimitService) ResetApiLimitWithContext(ctx context.Context, p *ResetApiLimitParams) (*ResetApiLimitResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "resetApiLimit", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -298,7 +677,8 @@ type UpdateResourceCountParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateResourceCountParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateResourceCountParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -319,6 +699,53 @@ func (p *UpdateResourceCountParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateResourceCountParams) Validate() error {
+	v := newParamValidator("updateResourceCount", p.p)
+	v.required("domainid")
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateResourceCountParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateResourceCountParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "resourcetype":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateResourceCount", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateResourceCount: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateResourceCountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -326,6 +753,15 @@ func (p *UpdateResourceCountParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *UpdateResourceCountParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *UpdateResourceCountParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *UpdateResourceCountParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -333,6 +769,15 @@ func (p *UpdateResourceCountParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *UpdateResourceCountParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *UpdateResourceCountParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *UpdateResourceCountParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -340,6 +785,15 @@ func (p *UpdateResourceCountParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *UpdateResourceCountParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *UpdateResourceCountParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *UpdateResourceCountParams) SetResourcetype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -347,6 +801,15 @@ func (p *UpdateResourceCountParams) SetResourcetype(v int) {
 	p.p["resourcetype"] = v
 }
 
+func (p *UpdateResourceCountParams) ResetResourcetype() {
+	delete(p.p, "resourcetype")
+}
+
+func (p *UpdateResourceCountParams) GetResourcetype() (int, bool) {
+	value, ok := p.p["resourcetype"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateResourceCountParams instance,
 // as then you are sure you have configured all required params
 func (s *LimitService) NewUpdateResourceCountParams(domainid string) *UpdateResourceCountParams {
@@ -358,7 +821,16 @@ func (s *LimitService) NewUpdateResourceCountParams(domainid string) *UpdateReso
 
 // Recalculate and update resource count for an account or domain.
 func (s *LimitService) UpdateResourceCount(p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error) {
-	resp, err := s.cs.newRequest("updateResourceCount", p.toURLValues())
+	return s.UpdateResourceCountWithContext(context.Background(), p)
+}
+
+// UpdateResourceCountWithContext is the same as UpdateResourceCount, but uses ctx to cancel the request and any async job polling
+func (s *LimitService) UpdateResourceCountWithContext(ctx context.Context, p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateResourceCount", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -388,7 +860,8 @@ type UpdateResourceLimitParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateResourceLimitParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateResourceLimitParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -413,6 +886,57 @@ func (p *UpdateResourceLimitParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateResourceLimitParams) Validate() error {
+	v := newParamValidator("updateResourceLimit", p.p)
+	v.required("resourcetype")
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateResourceLimitParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateResourceLimitParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "max":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "resourcetype":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateResourceLimit", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateResourceLimit: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateResourceLimitParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -420,6 +944,15 @@ func (p *UpdateResourceLimitParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *UpdateResourceLimitParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *UpdateResourceLimitParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *UpdateResourceLimitParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -427,6 +960,15 @@ func (p *UpdateResourceLimitParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *UpdateResourceLimitParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *UpdateResourceLimitParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *UpdateResourceLimitParams) SetMax(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -434,6 +976,15 @@ func (p *UpdateResourceLimitParams) SetMax(v int64) {
 	p.p["max"] = v
 }
 
+func (p *UpdateResourceLimitParams) ResetMax() {
+	delete(p.p, "max")
+}
+
+func (p *UpdateResourceLimitParams) GetMax() (int64, bool) {
+	value, ok := p.p["max"].(int64)
+	return value, ok
+}
+
 func (p *UpdateResourceLimitParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -441,6 +992,15 @@ func (p *UpdateResourceLimitParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *UpdateResourceLimitParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *UpdateResourceLimitParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *UpdateResourceLimitParams) SetResourcetype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -448,6 +1008,15 @@ func (p *UpdateResourceLimitParams) SetResourcetype(v int) {
 	p.p["resourcetype"] = v
 }
 
+func (p *UpdateResourceLimitParams) ResetResourcetype() {
+	delete(p.p, "resourcetype")
+}
+
+func (p *UpdateResourceLimitParams) GetResourcetype() (int, bool) {
+	value, ok := p.p["resourcetype"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateResourceLimitParams instance,
 // as then you are sure you have configured all required params
 func (s *LimitService) NewUpdateResourceLimitParams(resourcetype int) *UpdateResourceLimitParams {
@@ -459,7 +1028,16 @@ func (s *LimitService) NewUpdateResourceLimitParams(resourcetype int) *UpdateRes
 
 // Updates resource limits for an account or domain.
 func (s *LimitService) UpdateResourceLimit(p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error) {
-	resp, err := s.cs.newRequest("updateResourceLimit", p.toURLValues())
+	return s.UpdateResourceLimitWithContext(context.Background(), p)
+}
+
+// UpdateResourceLimitWithContext is the same as UpdateResourceLimit, but uses ctx to cancel the request and any async job polling
+func (s *LimitService) UpdateResourceLimitWithContext(ctx context.Context, p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateResourceLimit", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -20,336 +20,628 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
-type AddNetscalerLoadBalancerParams struct {
+// LoadBalancerServiceIface is the interface implemented by LoadBalancerService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type LoadBalancerServiceIface interface {
+	NewAddExternalLoadBalancerParams(password string, url string, username string, zoneid string) *AddExternalLoadBalancerParams
+	AddExternalLoadBalancer(p *AddExternalLoadBalancerParams) (*AddExternalLoadBalancerResponse, error)
+	AddExternalLoadBalancerWithContext(ctx context.Context, p *AddExternalLoadBalancerParams) (*AddExternalLoadBalancerResponse, error)
+	NewAddF5LoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddF5LoadBalancerParams
+	AddF5LoadBalancer(p *AddF5LoadBalancerParams) (*AddF5LoadBalancerResponse, error)
+	AddF5LoadBalancerWithContext(ctx context.Context, p *AddF5LoadBalancerParams) (*AddF5LoadBalancerResponse, error)
+	AddF5LoadBalancerAsync(p *AddF5LoadBalancerParams) (*Job, error)
+	AddF5LoadBalancerAsyncWithContext(ctx context.Context, p *AddF5LoadBalancerParams) (*Job, error)
+	NewAddNetscalerLoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddNetscalerLoadBalancerParams
+	AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error)
+	AddNetscalerLoadBalancerWithContext(ctx context.Context, p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error)
+	AddNetscalerLoadBalancerAsync(p *AddNetscalerLoadBalancerParams) (*Job, error)
+	AddNetscalerLoadBalancerAsyncWithContext(ctx context.Context, p *AddNetscalerLoadBalancerParams) (*Job, error)
+	NewAssignCertToLoadBalancerParams(certid string, lbruleid string) *AssignCertToLoadBalancerParams
+	AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error)
+	AssignCertToLoadBalancerWithContext(ctx context.Context, p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error)
+	AssignCertToLoadBalancerAsync(p *AssignCertToLoadBalancerParams) (*Job, error)
+	AssignCertToLoadBalancerAsyncWithContext(ctx context.Context, p *AssignCertToLoadBalancerParams) (*Job, error)
+	NewAssignToGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *AssignToGlobalLoadBalancerRuleParams
+	AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error)
+	AssignToGlobalLoadBalancerRuleWithContext(ctx context.Context, p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error)
+	AssignToGlobalLoadBalancerRuleAsync(p *AssignToGlobalLoadBalancerRuleParams) (*Job, error)
+	AssignToGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *AssignToGlobalLoadBalancerRuleParams) (*Job, error)
+	NewAssignToLoadBalancerRuleParams(id string) *AssignToLoadBalancerRuleParams
+	AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error)
+	AssignToLoadBalancerRuleWithContext(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error)
+	AssignToLoadBalancerRuleAsync(p *AssignToLoadBalancerRuleParams) (*Job, error)
+	AssignToLoadBalancerRuleAsyncWithContext(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*Job, error)
+	NewConfigureF5LoadBalancerParams(lbdeviceid string) *ConfigureF5LoadBalancerParams
+	ConfigureF5LoadBalancer(p *ConfigureF5LoadBalancerParams) (*F5LoadBalancerResponse, error)
+	ConfigureF5LoadBalancerWithContext(ctx context.Context, p *ConfigureF5LoadBalancerParams) (*F5LoadBalancerResponse, error)
+	ConfigureF5LoadBalancerAsync(p *ConfigureF5LoadBalancerParams) (*Job, error)
+	ConfigureF5LoadBalancerAsyncWithContext(ctx context.Context, p *ConfigureF5LoadBalancerParams) (*Job, error)
+	NewConfigureNetscalerLoadBalancerParams(lbdeviceid string) *ConfigureNetscalerLoadBalancerParams
+	ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error)
+	ConfigureNetscalerLoadBalancerWithContext(ctx context.Context, p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error)
+	ConfigureNetscalerLoadBalancerAsync(p *ConfigureNetscalerLoadBalancerParams) (*Job, error)
+	ConfigureNetscalerLoadBalancerAsyncWithContext(ctx context.Context, p *ConfigureNetscalerLoadBalancerParams) (*Job, error)
+	NewCreateGlobalLoadBalancerRuleParams(gslbdomainname string, gslbservicetype string, name string, regionid int) *CreateGlobalLoadBalancerRuleParams
+	CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error)
+	CreateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error)
+	CreateGlobalLoadBalancerRuleAsync(p *CreateGlobalLoadBalancerRuleParams) (*Job, error)
+	CreateGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *CreateGlobalLoadBalancerRuleParams) (*Job, error)
+	NewCreateLBHealthCheckPolicyParams(lbruleid string) *CreateLBHealthCheckPolicyParams
+	CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error)
+	CreateLBHealthCheckPolicyWithContext(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error)
+	CreateLBHealthCheckPolicyAsync(p *CreateLBHealthCheckPolicyParams) (*Job, error)
+	CreateLBHealthCheckPolicyAsyncWithContext(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*Job, error)
+	NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string) *CreateLBStickinessPolicyParams
+	CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error)
+	CreateLBStickinessPolicyWithContext(ctx context.Context, p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error)
+	CreateLBStickinessPolicyAsync(p *CreateLBStickinessPolicyParams) (*Job, error)
+	CreateLBStickinessPolicyAsyncWithContext(ctx context.Context, p *CreateLBStickinessPolicyParams) (*Job, error)
+	NewCreateLoadBalancerParams(algorithm string, instanceport int, name string, networkid string, scheme string, sourceipaddressnetworkid string, sourceport int) *CreateLoadBalancerParams
+	CreateLoadBalancer(p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error)
+	CreateLoadBalancerWithContext(ctx context.Context, p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error)
+	CreateLoadBalancerAsync(p *CreateLoadBalancerParams) (*Job, error)
+	CreateLoadBalancerAsyncWithContext(ctx context.Context, p *CreateLoadBalancerParams) (*Job, error)
+	NewCreateLoadBalancerRuleParams(algorithm string, name string, privateport int, publicport int) *CreateLoadBalancerRuleParams
+	CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error)
+	CreateLoadBalancerRuleWithContext(ctx context.Context, p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error)
+	CreateLoadBalancerRuleAsync(p *CreateLoadBalancerRuleParams) (*Job, error)
+	CreateLoadBalancerRuleAsyncWithContext(ctx context.Context, p *CreateLoadBalancerRuleParams) (*Job, error)
+	NewDeleteExternalLoadBalancerParams(id string) *DeleteExternalLoadBalancerParams
+	DeleteExternalLoadBalancer(p *DeleteExternalLoadBalancerParams) (*DeleteExternalLoadBalancerResponse, error)
+	DeleteExternalLoadBalancerWithContext(ctx context.Context, p *DeleteExternalLoadBalancerParams) (*DeleteExternalLoadBalancerResponse, error)
+	NewDeleteF5LoadBalancerParams(lbdeviceid string) *DeleteF5LoadBalancerParams
+	DeleteF5LoadBalancer(p *DeleteF5LoadBalancerParams) (*DeleteF5LoadBalancerResponse, error)
+	DeleteF5LoadBalancerWithContext(ctx context.Context, p *DeleteF5LoadBalancerParams) (*DeleteF5LoadBalancerResponse, error)
+	DeleteF5LoadBalancerAsync(p *DeleteF5LoadBalancerParams) (*Job, error)
+	DeleteF5LoadBalancerAsyncWithContext(ctx context.Context, p *DeleteF5LoadBalancerParams) (*Job, error)
+	NewDeleteGlobalLoadBalancerRuleParams(id string) *DeleteGlobalLoadBalancerRuleParams
+	DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error)
+	DeleteGlobalLoadBalancerRuleWithContext(ctx context.Context, p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error)
+	DeleteGlobalLoadBalancerRuleAsync(p *DeleteGlobalLoadBalancerRuleParams) (*Job, error)
+	DeleteGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *DeleteGlobalLoadBalancerRuleParams) (*Job, error)
+	NewDeleteLBHealthCheckPolicyParams(id string) *DeleteLBHealthCheckPolicyParams
+	DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error)
+	DeleteLBHealthCheckPolicyWithContext(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error)
+	DeleteLBHealthCheckPolicyAsync(p *DeleteLBHealthCheckPolicyParams) (*Job, error)
+	DeleteLBHealthCheckPolicyAsyncWithContext(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*Job, error)
+	NewDeleteLBStickinessPolicyParams(id string) *DeleteLBStickinessPolicyParams
+	DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error)
+	DeleteLBStickinessPolicyWithContext(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error)
+	DeleteLBStickinessPolicyAsync(p *DeleteLBStickinessPolicyParams) (*Job, error)
+	DeleteLBStickinessPolicyAsyncWithContext(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*Job, error)
+	NewDeleteLoadBalancerParams(id string) *DeleteLoadBalancerParams
+	DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error)
+	DeleteLoadBalancerWithContext(ctx context.Context, p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error)
+	DeleteLoadBalancerAsync(p *DeleteLoadBalancerParams) (*Job, error)
+	DeleteLoadBalancerAsyncWithContext(ctx context.Context, p *DeleteLoadBalancerParams) (*Job, error)
+	NewDeleteLoadBalancerRuleParams(id string) *DeleteLoadBalancerRuleParams
+	DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error)
+	DeleteLoadBalancerRuleWithContext(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error)
+	DeleteLoadBalancerRuleAsync(p *DeleteLoadBalancerRuleParams) (*Job, error)
+	DeleteLoadBalancerRuleAsyncWithContext(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*Job, error)
+	NewDeleteNetscalerLoadBalancerParams(lbdeviceid string) *DeleteNetscalerLoadBalancerParams
+	DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error)
+	DeleteNetscalerLoadBalancerWithContext(ctx context.Context, p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error)
+	DeleteNetscalerLoadBalancerAsync(p *DeleteNetscalerLoadBalancerParams) (*Job, error)
+	DeleteNetscalerLoadBalancerAsyncWithContext(ctx context.Context, p *DeleteNetscalerLoadBalancerParams) (*Job, error)
+	NewDeleteSslCertParams(id string) *DeleteSslCertParams
+	DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error)
+	DeleteSslCertWithContext(ctx context.Context, p *DeleteSslCertParams) (*DeleteSslCertResponse, error)
+	NewListExternalLoadBalancersParams() *ListExternalLoadBalancersParams
+	GetExternalLoadBalancerID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetExternalLoadBalancerIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
+	ListExternalLoadBalancers(p *ListExternalLoadBalancersParams) (*ListExternalLoadBalancersResponse, error)
+	ListExternalLoadBalancersWithContext(ctx context.Context, p *ListExternalLoadBalancersParams) (*ListExternalLoadBalancersResponse, error)
+	NewListExternalLoadBalancersPager(p *ListExternalLoadBalancersParams) *ListExternalLoadBalancersPager
+	ListExternalLoadBalancersAll(p *ListExternalLoadBalancersParams) (*ListExternalLoadBalancersResponse, error)
+	ListExternalLoadBalancersAllWithContext(ctx context.Context, p *ListExternalLoadBalancersParams) (*ListExternalLoadBalancersResponse, error)
+	NewListF5LoadBalancerNetworksParams(lbdeviceid string) *ListF5LoadBalancerNetworksParams
+	GetF5LoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetF5LoadBalancerNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	ListF5LoadBalancerNetworks(p *ListF5LoadBalancerNetworksParams) (*ListF5LoadBalancerNetworksResponse, error)
+	ListF5LoadBalancerNetworksWithContext(ctx context.Context, p *ListF5LoadBalancerNetworksParams) (*ListF5LoadBalancerNetworksResponse, error)
+	NewListF5LoadBalancerNetworksPager(p *ListF5LoadBalancerNetworksParams) *ListF5LoadBalancerNetworksPager
+	ListF5LoadBalancerNetworksAll(p *ListF5LoadBalancerNetworksParams) (*ListF5LoadBalancerNetworksResponse, error)
+	ListF5LoadBalancerNetworksAllWithContext(ctx context.Context, p *ListF5LoadBalancerNetworksParams) (*ListF5LoadBalancerNetworksResponse, error)
+	NewListF5LoadBalancersParams() *ListF5LoadBalancersParams
+	ListF5LoadBalancers(p *ListF5LoadBalancersParams) (*ListF5LoadBalancersResponse, error)
+	ListF5LoadBalancersWithContext(ctx context.Context, p *ListF5LoadBalancersParams) (*ListF5LoadBalancersResponse, error)
+	NewListF5LoadBalancersPager(p *ListF5LoadBalancersParams) *ListF5LoadBalancersPager
+	ListF5LoadBalancersAll(p *ListF5LoadBalancersParams) (*ListF5LoadBalancersResponse, error)
+	ListF5LoadBalancersAllWithContext(ctx context.Context, p *ListF5LoadBalancersParams) (*ListF5LoadBalancersResponse, error)
+	NewListGlobalLoadBalancerRulesParams() *ListGlobalLoadBalancerRulesParams
+	GetGlobalLoadBalancerRuleID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetGlobalLoadBalancerRuleIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
+	GetGlobalLoadBalancerRuleByName(name string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error)
+	GetGlobalLoadBalancerRuleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error)
+	GetGlobalLoadBalancerRuleByID(id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error)
+	GetGlobalLoadBalancerRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error)
+	ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error)
+	ListGlobalLoadBalancerRulesWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error)
+	NewListGlobalLoadBalancerRulesPager(p *ListGlobalLoadBalancerRulesParams) *ListGlobalLoadBalancerRulesPager
+	ListGlobalLoadBalancerRulesAll(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error)
+	ListGlobalLoadBalancerRulesAllWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error)
+	NewListLBHealthCheckPoliciesParams() *ListLBHealthCheckPoliciesParams
+	GetLBHealthCheckPolicyByID(id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error)
+	GetLBHealthCheckPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error)
+	ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error)
+	ListLBHealthCheckPoliciesWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error)
+	NewListLBHealthCheckPoliciesPager(p *ListLBHealthCheckPoliciesParams) *ListLBHealthCheckPoliciesPager
+	ListLBHealthCheckPoliciesAll(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error)
+	ListLBHealthCheckPoliciesAllWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error)
+	NewListLBStickinessPoliciesParams() *ListLBStickinessPoliciesParams
+	GetLBStickinessPolicyByID(id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error)
+	GetLBStickinessPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error)
+	ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error)
+	ListLBStickinessPoliciesWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error)
+	NewListLBStickinessPoliciesPager(p *ListLBStickinessPoliciesParams) *ListLBStickinessPoliciesPager
+	ListLBStickinessPoliciesAll(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error)
+	ListLBStickinessPoliciesAllWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error)
+	NewListLoadBalancerRuleInstancesParams(id string) *ListLoadBalancerRuleInstancesParams
+	GetLoadBalancerRuleInstanceByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	GetLoadBalancerRuleInstanceByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error)
+	ListLoadBalancerRuleInstancesWithContext(ctx context.Context, p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error)
+	NewListLoadBalancerRulesParams() *ListLoadBalancerRulesParams
+	GetLoadBalancerRuleID(name string, opts ...OptionFunc) (string, int, error)
+	GetLoadBalancerRuleIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetLoadBalancerRuleByName(name string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
+	GetLoadBalancerRuleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
+	GetLoadBalancerRuleByID(id string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
+	GetLoadBalancerRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
+	ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error)
+	ListLoadBalancerRulesWithContext(ctx context.Context, p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error)
+	NewListLoadBalancerRulesPager(p *ListLoadBalancerRulesParams) *ListLoadBalancerRulesPager
+	ListLoadBalancerRulesAll(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error)
+	ListLoadBalancerRulesAllWithContext(ctx context.Context, p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error)
+	NewListLoadBalancersParams() *ListLoadBalancersParams
+	GetLoadBalancerID(name string, opts ...OptionFunc) (string, int, error)
+	GetLoadBalancerIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetLoadBalancerByName(name string, opts ...OptionFunc) (*LoadBalancer, int, error)
+	GetLoadBalancerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*LoadBalancer, int, error)
+	GetLoadBalancerByID(id string, opts ...OptionFunc) (*LoadBalancer, int, error)
+	GetLoadBalancerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LoadBalancer, int, error)
+	ListLoadBalancers(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error)
+	ListLoadBalancersWithContext(ctx context.Context, p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error)
+	NewListLoadBalancersPager(p *ListLoadBalancersParams) *ListLoadBalancersPager
+	ListLoadBalancersAll(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error)
+	ListLoadBalancersAllWithContext(ctx context.Context, p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error)
+	NewListNetscalerLoadBalancersParams() *ListNetscalerLoadBalancersParams
+	ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error)
+	ListNetscalerLoadBalancersWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error)
+	NewListNetscalerLoadBalancersPager(p *ListNetscalerLoadBalancersParams) *ListNetscalerLoadBalancersPager
+	ListNetscalerLoadBalancersAll(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error)
+	ListNetscalerLoadBalancersAllWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error)
+	NewListSslCertsParams() *ListSslCertsParams
+	ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error)
+	ListSslCertsWithContext(ctx context.Context, p *ListSslCertsParams) (*ListSslCertsResponse, error)
+	NewRemoveCertFromLoadBalancerParams(lbruleid string) *RemoveCertFromLoadBalancerParams
+	RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error)
+	RemoveCertFromLoadBalancerWithContext(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error)
+	RemoveCertFromLoadBalancerAsync(p *RemoveCertFromLoadBalancerParams) (*Job, error)
+	RemoveCertFromLoadBalancerAsyncWithContext(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*Job, error)
+	NewRemoveFromGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *RemoveFromGlobalLoadBalancerRuleParams
+	RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error)
+	RemoveFromGlobalLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error)
+	RemoveFromGlobalLoadBalancerRuleAsync(p *RemoveFromGlobalLoadBalancerRuleParams) (*Job, error)
+	RemoveFromGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *RemoveFromGlobalLoadBalancerRuleParams) (*Job, error)
+	NewRemoveFromLoadBalancerRuleParams(id string) *RemoveFromLoadBalancerRuleParams
+	RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error)
+	RemoveFromLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error)
+	RemoveFromLoadBalancerRuleAsync(p *RemoveFromLoadBalancerRuleParams) (*Job, error)
+	RemoveFromLoadBalancerRuleAsyncWithContext(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*Job, error)
+	NewUpdateGlobalLoadBalancerRuleParams(id string) *UpdateGlobalLoadBalancerRuleParams
+	UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error)
+	UpdateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error)
+	UpdateGlobalLoadBalancerRuleAsync(p *UpdateGlobalLoadBalancerRuleParams) (*Job, error)
+	UpdateGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *UpdateGlobalLoadBalancerRuleParams) (*Job, error)
+	NewUpdateLBHealthCheckPolicyParams(id string) *UpdateLBHealthCheckPolicyParams
+	UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error)
+	UpdateLBHealthCheckPolicyWithContext(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error)
+	UpdateLBHealthCheckPolicyAsync(p *UpdateLBHealthCheckPolicyParams) (*Job, error)
+	UpdateLBHealthCheckPolicyAsyncWithContext(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*Job, error)
+	NewUpdateLBStickinessPolicyParams(id string) *UpdateLBStickinessPolicyParams
+	UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error)
+	UpdateLBStickinessPolicyWithContext(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error)
+	UpdateLBStickinessPolicyAsync(p *UpdateLBStickinessPolicyParams) (*Job, error)
+	UpdateLBStickinessPolicyAsyncWithContext(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*Job, error)
+	NewUpdateLoadBalancerParams(id string) *UpdateLoadBalancerParams
+	UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error)
+	UpdateLoadBalancerWithContext(ctx context.Context, p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error)
+	UpdateLoadBalancerAsync(p *UpdateLoadBalancerParams) (*Job, error)
+	UpdateLoadBalancerAsyncWithContext(ctx context.Context, p *UpdateLoadBalancerParams) (*Job, error)
+	NewUpdateLoadBalancerRuleParams(id string) *UpdateLoadBalancerRuleParams
+	UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error)
+	UpdateLoadBalancerRuleWithContext(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error)
+	UpdateLoadBalancerRuleAsync(p *UpdateLoadBalancerRuleParams) (*Job, error)
+	UpdateLoadBalancerRuleAsyncWithContext(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*Job, error)
+	NewUploadSslCertParams(certificate string, name string, privatekey string) *UploadSslCertParams
+	UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error)
+	UploadSslCertWithContext(ctx context.Context, p *UploadSslCertParams) (*UploadSslCertResponse, error)
+}
+
+type AddExternalLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddNetscalerLoadBalancerParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddExternalLoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["gslbprovider"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("gslbprovider", vv)
-	}
-	if v, found := p.p["gslbproviderprivateip"]; found {
-		u.Set("gslbproviderprivateip", v.(string))
-	}
-	if v, found := p.p["gslbproviderpublicip"]; found {
-		u.Set("gslbproviderpublicip", v.(string))
-	}
-	if v, found := p.p["isexclusivegslbprovider"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isexclusivegslbprovider", vv)
-	}
-	if v, found := p.p["networkdevicetype"]; found {
-		u.Set("networkdevicetype", v.(string))
-	}
 	if v, found := p.p["password"]; found {
 		u.Set("password", v.(string))
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
 	if v, found := p.p["url"]; found {
 		u.Set("url", v.(string))
 	}
 	if v, found := p.p["username"]; found {
 		u.Set("username", v.(string))
 	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
 	return u
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetGslbprovider(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslbprovider"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddExternalLoadBalancerParams) Validate() error {
+	v := newParamValidator("addExternalLoadBalancer", p.p)
+	v.required("password", "url", "username", "zoneid")
+	v.ids("zoneid")
+	v.maxLength("password", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetGslbproviderprivateip(v string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddExternalLoadBalancerParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["gslbproviderprivateip"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetGslbproviderpublicip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddExternalLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["gslbproviderpublicip"] = v
-}
 
-func (p *AddNetscalerLoadBalancerParams) SetIsexclusivegslbprovider(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "password", "url", "username", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addExternalLoadBalancer", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addExternalLoadBalancer: %w", k, err)
+		}
 	}
-	p.p["isexclusivegslbprovider"] = v
+	return nil
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetNetworkdevicetype(v string) {
+func (p *AddExternalLoadBalancerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["networkdevicetype"] = v
+	p.p["password"] = v
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+func (p *AddExternalLoadBalancerParams) ResetPassword() {
+	delete(p.p, "password")
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetPhysicalnetworkid(v string) {
+func (p *AddExternalLoadBalancerParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
+func (p *AddExternalLoadBalancerParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["physicalnetworkid"] = v
+	p.p["url"] = v
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetUrl(v string) {
+func (p *AddExternalLoadBalancerParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddExternalLoadBalancerParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
+func (p *AddExternalLoadBalancerParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["url"] = v
+	p.p["username"] = v
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetUsername(v string) {
+func (p *AddExternalLoadBalancerParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddExternalLoadBalancerParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
+func (p *AddExternalLoadBalancerParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["username"] = v
+	p.p["zoneid"] = v
 }
 
-// You should always use this function to get a new AddNetscalerLoadBalancerParams instance,
+func (p *AddExternalLoadBalancerParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *AddExternalLoadBalancerParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AddExternalLoadBalancerParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewAddNetscalerLoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddNetscalerLoadBalancerParams {
-	p := &AddNetscalerLoadBalancerParams{}
+func (s *LoadBalancerService) NewAddExternalLoadBalancerParams(password string, url string, username string, zoneid string) *AddExternalLoadBalancerParams {
+	p := &AddExternalLoadBalancerParams{}
 	p.p = make(map[string]interface{})
-	p.p["networkdevicetype"] = networkdevicetype
 	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
 	p.p["url"] = url
 	p.p["username"] = username
+	p.p["zoneid"] = zoneid
 	return p
 }
 
-// Adds a netscaler load balancer device
-func (s *LoadBalancerService) AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("addNetscalerLoadBalancer", p.toURLValues())
-	if err != nil {
+// Adds F5 external load balancer appliance.
+func (s *LoadBalancerService) AddExternalLoadBalancer(p *AddExternalLoadBalancerParams) (*AddExternalLoadBalancerResponse, error) {
+	return s.AddExternalLoadBalancerWithContext(context.Background(), p)
+}
+
+// AddExternalLoadBalancerWithContext is the same as AddExternalLoadBalancer, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) AddExternalLoadBalancerWithContext(ctx context.Context, p *AddExternalLoadBalancerParams) (*AddExternalLoadBalancerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
 		return nil, err
 	}
 
-	var r AddNetscalerLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequestWithContext(ctx, "addExternalLoadBalancer", p.ToURLValues())
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	var r AddExternalLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
 
 	return &r, nil
 }
 
-type AddNetscalerLoadBalancerResponse struct {
-	Gslbprovider            bool     `json:"gslbprovider"`
-	Gslbproviderprivateip   string   `json:"gslbproviderprivateip"`
-	Gslbproviderpublicip    string   `json:"gslbproviderpublicip"`
-	Ipaddress               string   `json:"ipaddress"`
-	Isexclusivegslbprovider bool     `json:"isexclusivegslbprovider"`
-	JobID                   string   `json:"jobid"`
-	Jobstatus               int      `json:"jobstatus"`
-	Lbdevicecapacity        int64    `json:"lbdevicecapacity"`
-	Lbdevicededicated       bool     `json:"lbdevicededicated"`
-	Lbdeviceid              string   `json:"lbdeviceid"`
-	Lbdevicename            string   `json:"lbdevicename"`
-	Lbdevicestate           string   `json:"lbdevicestate"`
-	Physicalnetworkid       string   `json:"physicalnetworkid"`
-	Podids                  []string `json:"podids"`
-	Privateinterface        string   `json:"privateinterface"`
-	Provider                string   `json:"provider"`
-	Publicinterface         string   `json:"publicinterface"`
+type AddExternalLoadBalancerResponse struct {
+	Id               string `json:"id"`
+	Ipaddress        string `json:"ipaddress"`
+	JobID            string `json:"jobid"`
+	Jobstatus        int    `json:"jobstatus"`
+	Numretries       string `json:"numretries"`
+	Privateinterface string `json:"privateinterface"`
+	Publicinterface  string `json:"publicinterface"`
+	Username         string `json:"username"`
+	Zoneid           string `json:"zoneid"`
 }
 
-type AssignCertToLoadBalancerParams struct {
+type AddF5LoadBalancerParams struct {
 	p map[string]interface{}
 }
 
-func (p *AssignCertToLoadBalancerParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddF5LoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["certid"]; found {
-		u.Set("certid", v.(string))
+	if v, found := p.p["networkdevicetype"]; found {
+		u.Set("networkdevicetype", v.(string))
 	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
+	if v, found := p.p["password"]; found {
+		u.Set("password", v.(string))
+	}
+	if v, found := p.p["physicalnetworkid"]; found {
+		u.Set("physicalnetworkid", v.(string))
+	}
+	if v, found := p.p["url"]; found {
+		u.Set("url", v.(string))
+	}
+	if v, found := p.p["username"]; found {
+		u.Set("username", v.(string))
 	}
 	return u
 }
 
-func (p *AssignCertToLoadBalancerParams) SetCertid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["certid"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddF5LoadBalancerParams) Validate() error {
+	v := newParamValidator("addF5LoadBalancer", p.p)
+	v.required("networkdevicetype", "password", "physicalnetworkid", "url", "username")
+	v.ids("physicalnetworkid")
+	v.maxLength("networkdevicetype", 255)
+	v.maxLength("password", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
 }
 
-func (p *AssignCertToLoadBalancerParams) SetLbruleid(v string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddF5LoadBalancerParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["lbruleid"] = v
-}
-
-// You should always use this function to get a new AssignCertToLoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewAssignCertToLoadBalancerParams(certid string, lbruleid string) *AssignCertToLoadBalancerParams {
-	p := &AssignCertToLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["certid"] = certid
-	p.p["lbruleid"] = lbruleid
-	return p
+	return json.Marshal(p.p)
 }
 
-// Assigns a certificate to a load balancer rule
-func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("assignCertToLoadBalancer", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r AssignCertToLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddF5LoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "networkdevicetype", "password", "physicalnetworkid", "url", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addF5LoadBalancer", k)
 		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addF5LoadBalancer: %w", k, err)
 		}
 	}
+	return nil
+}
 
-	return &r, nil
+func (p *AddF5LoadBalancerParams) SetNetworkdevicetype(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networkdevicetype"] = v
 }
 
-type AssignCertToLoadBalancerResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+func (p *AddF5LoadBalancerParams) ResetNetworkdevicetype() {
+	delete(p.p, "networkdevicetype")
 }
 
-type AssignToGlobalLoadBalancerRuleParams struct {
-	p map[string]interface{}
+func (p *AddF5LoadBalancerParams) GetNetworkdevicetype() (string, bool) {
+	value, ok := p.p["networkdevicetype"].(string)
+	return value, ok
 }
 
-func (p *AssignToGlobalLoadBalancerRuleParams) toURLValues() url.Values {
-	u := url.Values{}
+func (p *AddF5LoadBalancerParams) SetPassword(v string) {
 	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["gslblbruleweightsmap"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].key", i), k)
-			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].value", i), m[k])
-		}
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["loadbalancerrulelist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("loadbalancerrulelist", vv)
+		p.p = make(map[string]interface{})
 	}
-	return u
+	p.p["password"] = v
+}
+
+func (p *AddF5LoadBalancerParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddF5LoadBalancerParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
 }
 
-func (p *AssignToGlobalLoadBalancerRuleParams) SetGslblbruleweightsmap(v map[string]string) {
+func (p *AddF5LoadBalancerParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["gslblbruleweightsmap"] = v
+	p.p["physicalnetworkid"] = v
 }
 
-func (p *AssignToGlobalLoadBalancerRuleParams) SetId(v string) {
+func (p *AddF5LoadBalancerParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddF5LoadBalancerParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
+func (p *AddF5LoadBalancerParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["url"] = v
 }
 
-func (p *AssignToGlobalLoadBalancerRuleParams) SetLoadbalancerrulelist(v []string) {
+func (p *AddF5LoadBalancerParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddF5LoadBalancerParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
+func (p *AddF5LoadBalancerParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["loadbalancerrulelist"] = v
+	p.p["username"] = v
 }
 
-// You should always use this function to get a new AssignToGlobalLoadBalancerRuleParams instance,
+func (p *AddF5LoadBalancerParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddF5LoadBalancerParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AddF5LoadBalancerParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewAssignToGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *AssignToGlobalLoadBalancerRuleParams {
-	p := &AssignToGlobalLoadBalancerRuleParams{}
+func (s *LoadBalancerService) NewAddF5LoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddF5LoadBalancerParams {
+	p := &AddF5LoadBalancerParams{}
 	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["loadbalancerrulelist"] = loadbalancerrulelist
+	p.p["networkdevicetype"] = networkdevicetype
+	p.p["password"] = password
+	p.p["physicalnetworkid"] = physicalnetworkid
+	p.p["url"] = url
+	p.p["username"] = username
 	return p
 }
 
-// Assign load balancer rule or list of load balancer rules to a global load balancer rules.
-func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("assignToGlobalLoadBalancerRule", p.toURLValues())
+// Adds a F5 BigIP load balancer device
+func (s *LoadBalancerService) AddF5LoadBalancer(p *AddF5LoadBalancerParams) (*AddF5LoadBalancerResponse, error) {
+	return s.AddF5LoadBalancerWithContext(context.Background(), p)
+}
+
+// AddF5LoadBalancerWithContext is the same as AddF5LoadBalancer, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) AddF5LoadBalancerWithContext(ctx context.Context, p *AddF5LoadBalancerParams) (*AddF5LoadBalancerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addF5LoadBalancer", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r AssignToGlobalLoadBalancerRuleResponse
+	var r AddF5LoadBalancerResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -357,7 +649,12 @@ func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLo
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -365,196 +662,330 @@ func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLo
 	return &r, nil
 }
 
-type AssignToGlobalLoadBalancerRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+// AddF5LoadBalancerAsync starts the async job for AddF5LoadBalancer and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddF5LoadBalancerResponse using Job.Result
+func (s *LoadBalancerService) AddF5LoadBalancerAsync(p *AddF5LoadBalancerParams) (*Job, error) {
+	return s.AddF5LoadBalancerAsyncWithContext(context.Background(), p)
 }
 
-type AssignToLoadBalancerRuleParams struct {
+// AddF5LoadBalancerAsyncWithContext is the same as AddF5LoadBalancerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) AddF5LoadBalancerAsyncWithContext(ctx context.Context, p *AddF5LoadBalancerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addF5LoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddF5LoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type AddF5LoadBalancerResponse struct {
+	Ipaddress         string `json:"ipaddress"`
+	JobID             string `json:"jobid"`
+	Jobstatus         int    `json:"jobstatus"`
+	Lbdevicecapacity  int64  `json:"lbdevicecapacity"`
+	Lbdevicededicated bool   `json:"lbdevicededicated"`
+	Lbdeviceid        string `json:"lbdeviceid"`
+	Lbdevicename      string `json:"lbdevicename"`
+	Lbdevicestate     string `json:"lbdevicestate"`
+	Physicalnetworkid string `json:"physicalnetworkid"`
+	Privateinterface  string `json:"privateinterface"`
+	Provider          string `json:"provider"`
+	Publicinterface   string `json:"publicinterface"`
+}
+
+type AddNetscalerLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
-func (p *AssignToLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddNetscalerLoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v, found := p.p["gslbprovider"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("gslbprovider", vv)
 	}
-	if v, found := p.p["virtualmachineids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("virtualmachineids", vv)
+	if v, found := p.p["gslbproviderprivateip"]; found {
+		u.Set("gslbproviderprivateip", v.(string))
 	}
-	if v, found := p.p["vmidipmap"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("vmidipmap[%d].key", i), k)
-			u.Set(fmt.Sprintf("vmidipmap[%d].value", i), m[k])
-		}
+	if v, found := p.p["gslbproviderpublicip"]; found {
+		u.Set("gslbproviderpublicip", v.(string))
+	}
+	if v, found := p.p["isexclusivegslbprovider"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isexclusivegslbprovider", vv)
+	}
+	if v, found := p.p["networkdevicetype"]; found {
+		u.Set("networkdevicetype", v.(string))
+	}
+	if v, found := p.p["password"]; found {
+		u.Set("password", v.(string))
+	}
+	if v, found := p.p["physicalnetworkid"]; found {
+		u.Set("physicalnetworkid", v.(string))
+	}
+	if v, found := p.p["url"]; found {
+		u.Set("url", v.(string))
+	}
+	if v, found := p.p["username"]; found {
+		u.Set("username", v.(string))
 	}
 	return u
 }
 
-func (p *AssignToLoadBalancerRuleParams) SetId(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddNetscalerLoadBalancerParams) Validate() error {
+	v := newParamValidator("addNetscalerLoadBalancer", p.p)
+	v.required("networkdevicetype", "password", "physicalnetworkid", "url", "username")
+	v.ids("physicalnetworkid")
+	v.maxLength("gslbproviderprivateip", 255)
+	v.maxLength("gslbproviderpublicip", 255)
+	v.maxLength("networkdevicetype", 255)
+	v.maxLength("password", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddNetscalerLoadBalancerParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["id"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *AssignToLoadBalancerRuleParams) SetVirtualmachineids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddNetscalerLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["virtualmachineids"] = v
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "gslbprovider", "isexclusivegslbprovider":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "gslbproviderprivateip", "gslbproviderpublicip", "networkdevicetype", "password", "physicalnetworkid", "url", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addNetscalerLoadBalancer", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addNetscalerLoadBalancer: %w", k, err)
+		}
+	}
+	return nil
 }
 
-func (p *AssignToLoadBalancerRuleParams) SetVmidipmap(v map[string]string) {
+func (p *AddNetscalerLoadBalancerParams) SetGslbprovider(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["vmidipmap"] = v
+	p.p["gslbprovider"] = v
 }
 
-// You should always use this function to get a new AssignToLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewAssignToLoadBalancerRuleParams(id string) *AssignToLoadBalancerRuleParams {
-	p := &AssignToLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *AddNetscalerLoadBalancerParams) ResetGslbprovider() {
+	delete(p.p, "gslbprovider")
 }
 
-// Assigns virtual machine or a list of virtual machines to a load balancer rule.
-func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("assignToLoadBalancerRule", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *AddNetscalerLoadBalancerParams) GetGslbprovider() (bool, bool) {
+	value, ok := p.p["gslbprovider"].(bool)
+	return value, ok
+}
 
-	var r AssignToLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *AddNetscalerLoadBalancerParams) SetGslbproviderprivateip(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
+	p.p["gslbproviderprivateip"] = v
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
+func (p *AddNetscalerLoadBalancerParams) ResetGslbproviderprivateip() {
+	delete(p.p, "gslbproviderprivateip")
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
+func (p *AddNetscalerLoadBalancerParams) GetGslbproviderprivateip() (string, bool) {
+	value, ok := p.p["gslbproviderprivateip"].(string)
+	return value, ok
+}
 
-	return &r, nil
+func (p *AddNetscalerLoadBalancerParams) SetGslbproviderpublicip(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["gslbproviderpublicip"] = v
 }
 
-type AssignToLoadBalancerRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+func (p *AddNetscalerLoadBalancerParams) ResetGslbproviderpublicip() {
+	delete(p.p, "gslbproviderpublicip")
 }
 
-type ConfigureNetscalerLoadBalancerParams struct {
-	p map[string]interface{}
+func (p *AddNetscalerLoadBalancerParams) GetGslbproviderpublicip() (string, bool) {
+	value, ok := p.p["gslbproviderpublicip"].(string)
+	return value, ok
 }
 
-func (p *ConfigureNetscalerLoadBalancerParams) toURLValues() url.Values {
-	u := url.Values{}
+func (p *AddNetscalerLoadBalancerParams) SetIsexclusivegslbprovider(v bool) {
 	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["inline"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("inline", vv)
-	}
-	if v, found := p.p["lbdevicecapacity"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("lbdevicecapacity", vv)
-	}
-	if v, found := p.p["lbdevicededicated"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("lbdevicededicated", vv)
-	}
-	if v, found := p.p["lbdeviceid"]; found {
-		u.Set("lbdeviceid", v.(string))
-	}
-	if v, found := p.p["podids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("podids", vv)
+		p.p = make(map[string]interface{})
 	}
-	return u
+	p.p["isexclusivegslbprovider"] = v
 }
 
-func (p *ConfigureNetscalerLoadBalancerParams) SetInline(v bool) {
+func (p *AddNetscalerLoadBalancerParams) ResetIsexclusivegslbprovider() {
+	delete(p.p, "isexclusivegslbprovider")
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetIsexclusivegslbprovider() (bool, bool) {
+	value, ok := p.p["isexclusivegslbprovider"].(bool)
+	return value, ok
+}
+
+func (p *AddNetscalerLoadBalancerParams) SetNetworkdevicetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["inline"] = v
+	p.p["networkdevicetype"] = v
 }
 
-func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicecapacity(v int64) {
+func (p *AddNetscalerLoadBalancerParams) ResetNetworkdevicetype() {
+	delete(p.p, "networkdevicetype")
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetNetworkdevicetype() (string, bool) {
+	value, ok := p.p["networkdevicetype"].(string)
+	return value, ok
+}
+
+func (p *AddNetscalerLoadBalancerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["lbdevicecapacity"] = v
+	p.p["password"] = v
 }
 
-func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicededicated(v bool) {
+func (p *AddNetscalerLoadBalancerParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
+func (p *AddNetscalerLoadBalancerParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["lbdevicededicated"] = v
+	p.p["physicalnetworkid"] = v
 }
 
-func (p *ConfigureNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
+func (p *AddNetscalerLoadBalancerParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
+func (p *AddNetscalerLoadBalancerParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["lbdeviceid"] = v
+	p.p["url"] = v
 }
 
-func (p *ConfigureNetscalerLoadBalancerParams) SetPodids(v []string) {
+func (p *AddNetscalerLoadBalancerParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
+func (p *AddNetscalerLoadBalancerParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["podids"] = v
+	p.p["username"] = v
 }
 
-// You should always use this function to get a new ConfigureNetscalerLoadBalancerParams instance,
+func (p *AddNetscalerLoadBalancerParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AddNetscalerLoadBalancerParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewConfigureNetscalerLoadBalancerParams(lbdeviceid string) *ConfigureNetscalerLoadBalancerParams {
-	p := &ConfigureNetscalerLoadBalancerParams{}
+func (s *LoadBalancerService) NewAddNetscalerLoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddNetscalerLoadBalancerParams {
+	p := &AddNetscalerLoadBalancerParams{}
 	p.p = make(map[string]interface{})
-	p.p["lbdeviceid"] = lbdeviceid
+	p.p["networkdevicetype"] = networkdevicetype
+	p.p["password"] = password
+	p.p["physicalnetworkid"] = physicalnetworkid
+	p.p["url"] = url
+	p.p["username"] = username
 	return p
 }
 
-// configures a netscaler load balancer device
-func (s *LoadBalancerService) ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("configureNetscalerLoadBalancer", p.toURLValues())
+// Adds a netscaler load balancer device
+func (s *LoadBalancerService) AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error) {
+	return s.AddNetscalerLoadBalancerWithContext(context.Background(), p)
+}
+
+// AddNetscalerLoadBalancerWithContext is the same as AddNetscalerLoadBalancer, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) AddNetscalerLoadBalancerWithContext(ctx context.Context, p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addNetscalerLoadBalancer", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r NetscalerLoadBalancerResponse
+	var r AddNetscalerLoadBalancerResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -575,7 +1006,41 @@ func (s *LoadBalancerService) ConfigureNetscalerLoadBalancer(p *ConfigureNetscal
 	return &r, nil
 }
 
-type NetscalerLoadBalancerResponse struct {
+// AddNetscalerLoadBalancerAsync starts the async job for AddNetscalerLoadBalancer and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddNetscalerLoadBalancerResponse using Job.Result
+func (s *LoadBalancerService) AddNetscalerLoadBalancerAsync(p *AddNetscalerLoadBalancerParams) (*Job, error) {
+	return s.AddNetscalerLoadBalancerAsyncWithContext(context.Background(), p)
+}
+
+// AddNetscalerLoadBalancerAsyncWithContext is the same as AddNetscalerLoadBalancerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) AddNetscalerLoadBalancerAsyncWithContext(ctx context.Context, p *AddNetscalerLoadBalancerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addNetscalerLoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddNetscalerLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type AddNetscalerLoadBalancerResponse struct {
 	Gslbprovider            bool     `json:"gslbprovider"`
 	Gslbproviderprivateip   string   `json:"gslbproviderprivateip"`
 	Gslbproviderpublicip    string   `json:"gslbproviderpublicip"`
@@ -595,136 +1060,133 @@ type NetscalerLoadBalancerResponse struct {
 	Publicinterface         string   `json:"publicinterface"`
 }
 
-type CreateGlobalLoadBalancerRuleParams struct {
+type AssignCertToLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AssignCertToLoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["gslbdomainname"]; found {
-		u.Set("gslbdomainname", v.(string))
-	}
-	if v, found := p.p["gslblbmethod"]; found {
-		u.Set("gslblbmethod", v.(string))
-	}
-	if v, found := p.p["gslbservicetype"]; found {
-		u.Set("gslbservicetype", v.(string))
-	}
-	if v, found := p.p["gslbstickysessionmethodname"]; found {
-		u.Set("gslbstickysessionmethodname", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v, found := p.p["certid"]; found {
+		u.Set("certid", v.(string))
 	}
-	if v, found := p.p["regionid"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("regionid", vv)
+	if v, found := p.p["lbruleid"]; found {
+		u.Set("lbruleid", v.(string))
 	}
 	return u
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AssignCertToLoadBalancerParams) Validate() error {
+	v := newParamValidator("assignCertToLoadBalancer", p.p)
+	v.required("certid", "lbruleid")
+	v.ids("certid", "lbruleid")
+	return v.err()
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetDescription(v string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AssignCertToLoadBalancerParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["description"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AssignCertToLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["domainid"] = v
-}
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetGslbdomainname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "certid", "lbruleid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for assignCertToLoadBalancer", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of assignCertToLoadBalancer: %w", k, err)
+		}
 	}
-	p.p["gslbdomainname"] = v
+	return nil
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetGslblbmethod(v string) {
+func (p *AssignCertToLoadBalancerParams) SetCertid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["gslblbmethod"] = v
+	p.p["certid"] = v
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetGslbservicetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslbservicetype"] = v
+func (p *AssignCertToLoadBalancerParams) ResetCertid() {
+	delete(p.p, "certid")
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslbstickysessionmethodname"] = v
+func (p *AssignCertToLoadBalancerParams) GetCertid() (string, bool) {
+	value, ok := p.p["certid"].(string)
+	return value, ok
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetName(v string) {
+func (p *AssignCertToLoadBalancerParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["name"] = v
+	p.p["lbruleid"] = v
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetRegionid(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["regionid"] = v
+func (p *AssignCertToLoadBalancerParams) ResetLbruleid() {
+	delete(p.p, "lbruleid")
 }
 
-// You should always use this function to get a new CreateGlobalLoadBalancerRuleParams instance,
+func (p *AssignCertToLoadBalancerParams) GetLbruleid() (string, bool) {
+	value, ok := p.p["lbruleid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AssignCertToLoadBalancerParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateGlobalLoadBalancerRuleParams(gslbdomainname string, gslbservicetype string, name string, regionid int) *CreateGlobalLoadBalancerRuleParams {
-	p := &CreateGlobalLoadBalancerRuleParams{}
+func (s *LoadBalancerService) NewAssignCertToLoadBalancerParams(certid string, lbruleid string) *AssignCertToLoadBalancerParams {
+	p := &AssignCertToLoadBalancerParams{}
 	p.p = make(map[string]interface{})
-	p.p["gslbdomainname"] = gslbdomainname
-	p.p["gslbservicetype"] = gslbservicetype
-	p.p["name"] = name
-	p.p["regionid"] = regionid
+	p.p["certid"] = certid
+	p.p["lbruleid"] = lbruleid
 	return p
 }
 
-// Creates a global load balancer rule
-func (s *LoadBalancerService) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("createGlobalLoadBalancerRule", p.toURLValues())
+// Assigns a certificate to a load balancer rule
+func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
+	return s.AssignCertToLoadBalancerWithContext(context.Background(), p)
+}
+
+// AssignCertToLoadBalancerWithContext is the same as AssignCertToLoadBalancer, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) AssignCertToLoadBalancerWithContext(ctx context.Context, p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "assignCertToLoadBalancer", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateGlobalLoadBalancerRuleResponse
+	var r AssignCertToLoadBalancerResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -732,11 +1194,6 @@ func (s *LoadBalancerService) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBa
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
 		if err := json.Unmarshal(b, &r); err != nil {
 			return nil, err
 		}
@@ -745,170 +1202,200 @@ func (s *LoadBalancerService) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBa
 	return &r, nil
 }
 
-type CreateGlobalLoadBalancerRuleResponse struct {
-	Account                     string                                                 `json:"account"`
-	Description                 string                                                 `json:"description"`
-	Domain                      string                                                 `json:"domain"`
-	Domainid                    string                                                 `json:"domainid"`
-	Gslbdomainname              string                                                 `json:"gslbdomainname"`
-	Gslblbmethod                string                                                 `json:"gslblbmethod"`
-	Gslbservicetype             string                                                 `json:"gslbservicetype"`
-	Gslbstickysessionmethodname string                                                 `json:"gslbstickysessionmethodname"`
-	Id                          string                                                 `json:"id"`
-	JobID                       string                                                 `json:"jobid"`
-	Jobstatus                   int                                                    `json:"jobstatus"`
-	Loadbalancerrule            []CreateGlobalLoadBalancerRuleResponseLoadbalancerrule `json:"loadbalancerrule"`
-	Name                        string                                                 `json:"name"`
-	Project                     string                                                 `json:"project"`
-	Projectid                   string                                                 `json:"projectid"`
-	Regionid                    int                                                    `json:"regionid"`
+// AssignCertToLoadBalancerAsync starts the async job for AssignCertToLoadBalancer and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AssignCertToLoadBalancerResponse using Job.Result
+func (s *LoadBalancerService) AssignCertToLoadBalancerAsync(p *AssignCertToLoadBalancerParams) (*Job, error) {
+	return s.AssignCertToLoadBalancerAsyncWithContext(context.Background(), p)
 }
 
-type CreateGlobalLoadBalancerRuleResponseLoadbalancerrule struct {
-	Account     string `json:"account"`
-	Algorithm   string `json:"algorithm"`
-	Cidrlist    string `json:"cidrlist"`
-	Description string `json:"description"`
-	Domain      string `json:"domain"`
-	Domainid    string `json:"domainid"`
-	Fordisplay  bool   `json:"fordisplay"`
-	Id          string `json:"id"`
-	Name        string `json:"name"`
-	Networkid   string `json:"networkid"`
-	Privateport string `json:"privateport"`
-	Project     string `json:"project"`
-	Projectid   string `json:"projectid"`
-	Protocol    string `json:"protocol"`
-	Publicip    string `json:"publicip"`
-	Publicipid  string `json:"publicipid"`
-	Publicport  string `json:"publicport"`
-	State       string `json:"state"`
-	Tags        []Tags `json:"tags"`
-	Zoneid      string `json:"zoneid"`
-	Zonename    string `json:"zonename"`
+// AssignCertToLoadBalancerAsyncWithContext is the same as AssignCertToLoadBalancerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) AssignCertToLoadBalancerAsyncWithContext(ctx context.Context, p *AssignCertToLoadBalancerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "assignCertToLoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AssignCertToLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
 }
 
-type CreateLBHealthCheckPolicyParams struct {
+type AssignCertToLoadBalancerResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type AssignToGlobalLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateLBHealthCheckPolicyParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AssignToGlobalLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["healthythreshold"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("healthythreshold", vv)
-	}
-	if v, found := p.p["intervaltime"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("intervaltime", vv)
-	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
-	}
-	if v, found := p.p["pingpath"]; found {
-		u.Set("pingpath", v.(string))
+	if v, found := p.p["gslblbruleweightsmap"]; found {
+		for i, item := range v.([]GSLBLBRuleWeight) {
+			if item.LoadBalancerID != "" {
+				u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].loadbalancerid", i), item.LoadBalancerID)
+			}
+			if item.Weight != 0 {
+				u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].weight", i), strconv.Itoa(item.Weight))
+			}
+		}
 	}
-	if v, found := p.p["responsetimeout"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("responsetimeout", vv)
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
 	}
-	if v, found := p.p["unhealthythreshold"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("unhealthythreshold", vv)
+	if v, found := p.p["loadbalancerrulelist"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("loadbalancerrulelist", vv)
 	}
 	return u
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AssignToGlobalLoadBalancerRuleParams) Validate() error {
+	v := newParamValidator("assignToGlobalLoadBalancerRule", p.p)
+	v.required("id", "loadbalancerrulelist")
+	v.ids("id")
+	return v.err()
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetFordisplay(v bool) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AssignToGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["fordisplay"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetHealthythreshold(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AssignToGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["healthythreshold"] = v
-}
 
-func (p *CreateLBHealthCheckPolicyParams) SetIntervaltime(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "gslblbruleweightsmap":
+			var v []GSLBLBRuleWeight
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "loadbalancerrulelist":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for assignToGlobalLoadBalancerRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of assignToGlobalLoadBalancerRule: %w", k, err)
+		}
 	}
-	p.p["intervaltime"] = v
+	return nil
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetLbruleid(v string) {
+func (p *AssignToGlobalLoadBalancerRuleParams) SetGslblbruleweightsmap(v []GSLBLBRuleWeight) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["lbruleid"] = v
+	p.p["gslblbruleweightsmap"] = v
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetPingpath(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pingpath"] = v
+func (p *AssignToGlobalLoadBalancerRuleParams) ResetGslblbruleweightsmap() {
+	delete(p.p, "gslblbruleweightsmap")
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetResponsetimeout(v int) {
+func (p *AssignToGlobalLoadBalancerRuleParams) GetGslblbruleweightsmap() ([]GSLBLBRuleWeight, bool) {
+	value, ok := p.p["gslblbruleweightsmap"].([]GSLBLBRuleWeight)
+	return value, ok
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["responsetimeout"] = v
+	p.p["id"] = v
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetUnhealthythreshold(v int) {
+func (p *AssignToGlobalLoadBalancerRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) SetLoadbalancerrulelist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["unhealthythreshold"] = v
+	p.p["loadbalancerrulelist"] = v
 }
 
-// You should always use this function to get a new CreateLBHealthCheckPolicyParams instance,
+func (p *AssignToGlobalLoadBalancerRuleParams) ResetLoadbalancerrulelist() {
+	delete(p.p, "loadbalancerrulelist")
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) GetLoadbalancerrulelist() ([]string, bool) {
+	value, ok := p.p["loadbalancerrulelist"].([]string)
+	return value, ok
+}
+
+// You should always use this function to get a new AssignToGlobalLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateLBHealthCheckPolicyParams(lbruleid string) *CreateLBHealthCheckPolicyParams {
-	p := &CreateLBHealthCheckPolicyParams{}
+func (s *LoadBalancerService) NewAssignToGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *AssignToGlobalLoadBalancerRuleParams {
+	p := &AssignToGlobalLoadBalancerRuleParams{}
 	p.p = make(map[string]interface{})
-	p.p["lbruleid"] = lbruleid
+	p.p["id"] = id
+	p.p["loadbalancerrulelist"] = loadbalancerrulelist
 	return p
 }
 
-// Creates a load balancer health check policy
-func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("createLBHealthCheckPolicy", p.toURLValues())
-	if err != nil {
-		return nil, err
+// Assign load balancer rule or list of load balancer rules to a global load balancer rules.
+func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error) {
+	return s.AssignToGlobalLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// AssignToGlobalLoadBalancerRuleWithContext is the same as AssignToGlobalLoadBalancerRule, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) AssignToGlobalLoadBalancerRuleWithContext(ctx context.Context, p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
 
-	var r CreateLBHealthCheckPolicyResponse
+	resp, err := s.cs.newRequestWithContext(ctx, "assignToGlobalLoadBalancerRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AssignToGlobalLoadBalancerRuleResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -916,11 +1403,6 @@ func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPo
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
 		if err := json.Unmarshal(b, &r); err != nil {
 			return nil, err
 		}
@@ -929,132 +1411,199 @@ func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPo
 	return &r, nil
 }
 
-type CreateLBHealthCheckPolicyResponse struct {
-	Account           string                                               `json:"account"`
-	Domain            string                                               `json:"domain"`
-	Domainid          string                                               `json:"domainid"`
-	Healthcheckpolicy []CreateLBHealthCheckPolicyResponseHealthcheckpolicy `json:"healthcheckpolicy"`
-	JobID             string                                               `json:"jobid"`
-	Jobstatus         int                                                  `json:"jobstatus"`
-	Lbruleid          string                                               `json:"lbruleid"`
-	Zoneid            string                                               `json:"zoneid"`
+// AssignToGlobalLoadBalancerRuleAsync starts the async job for AssignToGlobalLoadBalancerRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AssignToGlobalLoadBalancerRuleResponse using Job.Result
+func (s *LoadBalancerService) AssignToGlobalLoadBalancerRuleAsync(p *AssignToGlobalLoadBalancerRuleParams) (*Job, error) {
+	return s.AssignToGlobalLoadBalancerRuleAsyncWithContext(context.Background(), p)
 }
 
-type CreateLBHealthCheckPolicyResponseHealthcheckpolicy struct {
-	Description             string `json:"description"`
-	Fordisplay              bool   `json:"fordisplay"`
-	Healthcheckinterval     int    `json:"healthcheckinterval"`
-	Healthcheckthresshold   int    `json:"healthcheckthresshold"`
-	Id                      string `json:"id"`
-	Pingpath                string `json:"pingpath"`
-	Responsetime            int    `json:"responsetime"`
-	State                   string `json:"state"`
-	Unhealthcheckthresshold int    `json:"unhealthcheckthresshold"`
+// AssignToGlobalLoadBalancerRuleAsyncWithContext is the same as AssignToGlobalLoadBalancerRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) AssignToGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *AssignToGlobalLoadBalancerRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "assignToGlobalLoadBalancerRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AssignToGlobalLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
 }
 
-type CreateLBStickinessPolicyParams struct {
+type AssignToGlobalLoadBalancerRuleResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type AssignToLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateLBStickinessPolicyParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AssignToLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
-	}
-	if v, found := p.p["methodname"]; found {
-		u.Set("methodname", v.(string))
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v, found := p.p["virtualmachineids"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("virtualmachineids", vv)
 	}
-	if v, found := p.p["param"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("param[%d].key", i), k)
-			u.Set(fmt.Sprintf("param[%d].value", i), m[k])
+	if v, found := p.p["vmidipmap"]; found {
+		for i, item := range v.([]VMIDIP) {
+			if item.VMID != "" {
+				u.Set(fmt.Sprintf("vmidipmap[%d].vmid", i), item.VMID)
+			}
+			if item.VMIP != "" {
+				u.Set(fmt.Sprintf("vmidipmap[%d].vmip", i), item.VMIP)
+			}
 		}
 	}
 	return u
 }
 
-func (p *CreateLBStickinessPolicyParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AssignToLoadBalancerRuleParams) Validate() error {
+	v := newParamValidator("assignToLoadBalancerRule", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
 }
 
-func (p *CreateLBStickinessPolicyParams) SetFordisplay(v bool) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AssignToLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["fordisplay"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *CreateLBStickinessPolicyParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AssignToLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["lbruleid"] = v
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "virtualmachineids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "vmidipmap":
+			var v []VMIDIP
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for assignToLoadBalancerRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of assignToLoadBalancerRule: %w", k, err)
+		}
+	}
+	return nil
 }
 
-func (p *CreateLBStickinessPolicyParams) SetMethodname(v string) {
+func (p *AssignToLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["methodname"] = v
+	p.p["id"] = v
 }
 
-func (p *CreateLBStickinessPolicyParams) SetName(v string) {
+func (p *AssignToLoadBalancerRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *AssignToLoadBalancerRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *AssignToLoadBalancerRuleParams) SetVirtualmachineids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["name"] = v
+	p.p["virtualmachineids"] = v
+}
+
+func (p *AssignToLoadBalancerRuleParams) ResetVirtualmachineids() {
+	delete(p.p, "virtualmachineids")
+}
+
+func (p *AssignToLoadBalancerRuleParams) GetVirtualmachineids() ([]string, bool) {
+	value, ok := p.p["virtualmachineids"].([]string)
+	return value, ok
 }
 
-func (p *CreateLBStickinessPolicyParams) SetParam(v map[string]string) {
+func (p *AssignToLoadBalancerRuleParams) SetVmidipmap(v []VMIDIP) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["param"] = v
+	p.p["vmidipmap"] = v
 }
 
-// You should always use this function to get a new CreateLBStickinessPolicyParams instance,
+func (p *AssignToLoadBalancerRuleParams) ResetVmidipmap() {
+	delete(p.p, "vmidipmap")
+}
+
+func (p *AssignToLoadBalancerRuleParams) GetVmidipmap() ([]VMIDIP, bool) {
+	value, ok := p.p["vmidipmap"].([]VMIDIP)
+	return value, ok
+}
+
+// You should always use this function to get a new AssignToLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string) *CreateLBStickinessPolicyParams {
-	p := &CreateLBStickinessPolicyParams{}
+func (s *LoadBalancerService) NewAssignToLoadBalancerRuleParams(id string) *AssignToLoadBalancerRuleParams {
+	p := &AssignToLoadBalancerRuleParams{}
 	p.p = make(map[string]interface{})
-	p.p["lbruleid"] = lbruleid
-	p.p["methodname"] = methodname
-	p.p["name"] = name
+	p.p["id"] = id
 	return p
 }
 
-// Creates a load balancer stickiness policy
-func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("createLBStickinessPolicy", p.toURLValues())
+// Assigns virtual machine or a list of virtual machines to a load balancer rule.
+func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
+	return s.AssignToLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// AssignToLoadBalancerRuleWithContext is the same as AssignToLoadBalancerRule, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) AssignToLoadBalancerRuleWithContext(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "assignToLoadBalancerRule", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateLBStickinessPolicyResponse
+	var r AssignToLoadBalancerRuleResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1062,11 +1611,6 @@ func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPoli
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
 		if err := json.Unmarshal(b, &r); err != nil {
 			return nil, err
 		}
@@ -1075,175 +1619,169 @@ func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPoli
 	return &r, nil
 }
 
-type CreateLBStickinessPolicyResponse struct {
-	Account          string                                             `json:"account"`
-	Description      string                                             `json:"description"`
-	Domain           string                                             `json:"domain"`
-	Domainid         string                                             `json:"domainid"`
-	JobID            string                                             `json:"jobid"`
-	Jobstatus        int                                                `json:"jobstatus"`
-	Lbruleid         string                                             `json:"lbruleid"`
-	Name             string                                             `json:"name"`
-	State            string                                             `json:"state"`
-	Stickinesspolicy []CreateLBStickinessPolicyResponseStickinesspolicy `json:"stickinesspolicy"`
-	Zoneid           string                                             `json:"zoneid"`
+// AssignToLoadBalancerRuleAsync starts the async job for AssignToLoadBalancerRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AssignToLoadBalancerRuleResponse using Job.Result
+func (s *LoadBalancerService) AssignToLoadBalancerRuleAsync(p *AssignToLoadBalancerRuleParams) (*Job, error) {
+	return s.AssignToLoadBalancerRuleAsyncWithContext(context.Background(), p)
 }
 
-type CreateLBStickinessPolicyResponseStickinesspolicy struct {
-	Description string            `json:"description"`
-	Fordisplay  bool              `json:"fordisplay"`
-	Id          string            `json:"id"`
-	Methodname  string            `json:"methodname"`
-	Name        string            `json:"name"`
-	Params      map[string]string `json:"params"`
-	State       string            `json:"state"`
+// AssignToLoadBalancerRuleAsyncWithContext is the same as AssignToLoadBalancerRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) AssignToLoadBalancerRuleAsyncWithContext(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "assignToLoadBalancerRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AssignToLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
 }
 
-type CreateLoadBalancerParams struct {
+type AssignToLoadBalancerRuleResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type ConfigureF5LoadBalancerParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateLoadBalancerParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ConfigureF5LoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["algorithm"]; found {
-		u.Set("algorithm", v.(string))
-	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["instanceport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("instanceport", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
-	}
-	if v, found := p.p["scheme"]; found {
-		u.Set("scheme", v.(string))
-	}
-	if v, found := p.p["sourceipaddress"]; found {
-		u.Set("sourceipaddress", v.(string))
-	}
-	if v, found := p.p["sourceipaddressnetworkid"]; found {
-		u.Set("sourceipaddressnetworkid", v.(string))
+	if v, found := p.p["lbdevicecapacity"]; found {
+		vv := strconv.FormatInt(v.(int64), 10)
+		u.Set("lbdevicecapacity", vv)
 	}
-	if v, found := p.p["sourceport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sourceport", vv)
+	if v, found := p.p["lbdeviceid"]; found {
+		u.Set("lbdeviceid", v.(string))
 	}
 	return u
 }
 
-func (p *CreateLoadBalancerParams) SetAlgorithm(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["algorithm"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ConfigureF5LoadBalancerParams) Validate() error {
+	v := newParamValidator("configureF5LoadBalancer", p.p)
+	v.required("lbdeviceid")
+	v.ids("lbdeviceid")
+	return v.err()
 }
 
-func (p *CreateLoadBalancerParams) SetDescription(v string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ConfigureF5LoadBalancerParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["description"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *CreateLoadBalancerParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ConfigureF5LoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["fordisplay"] = v
-}
 
-func (p *CreateLoadBalancerParams) SetInstanceport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "lbdevicecapacity":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "lbdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for configureF5LoadBalancer", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of configureF5LoadBalancer: %w", k, err)
+		}
 	}
-	p.p["instanceport"] = v
+	return nil
 }
 
-func (p *CreateLoadBalancerParams) SetName(v string) {
+func (p *ConfigureF5LoadBalancerParams) SetLbdevicecapacity(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["name"] = v
+	p.p["lbdevicecapacity"] = v
 }
 
-func (p *CreateLoadBalancerParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+func (p *ConfigureF5LoadBalancerParams) ResetLbdevicecapacity() {
+	delete(p.p, "lbdevicecapacity")
 }
 
-func (p *CreateLoadBalancerParams) SetScheme(v string) {
+func (p *ConfigureF5LoadBalancerParams) GetLbdevicecapacity() (int64, bool) {
+	value, ok := p.p["lbdevicecapacity"].(int64)
+	return value, ok
+}
+
+func (p *ConfigureF5LoadBalancerParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["scheme"] = v
-}
-
-func (p *CreateLoadBalancerParams) SetSourceipaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourceipaddress"] = v
+	p.p["lbdeviceid"] = v
 }
 
-func (p *CreateLoadBalancerParams) SetSourceipaddressnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourceipaddressnetworkid"] = v
+func (p *ConfigureF5LoadBalancerParams) ResetLbdeviceid() {
+	delete(p.p, "lbdeviceid")
 }
 
-func (p *CreateLoadBalancerParams) SetSourceport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourceport"] = v
+func (p *ConfigureF5LoadBalancerParams) GetLbdeviceid() (string, bool) {
+	value, ok := p.p["lbdeviceid"].(string)
+	return value, ok
 }
 
-// You should always use this function to get a new CreateLoadBalancerParams instance,
+// You should always use this function to get a new ConfigureF5LoadBalancerParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateLoadBalancerParams(algorithm string, instanceport int, name string, networkid string, scheme string, sourceipaddressnetworkid string, sourceport int) *CreateLoadBalancerParams {
-	p := &CreateLoadBalancerParams{}
+func (s *LoadBalancerService) NewConfigureF5LoadBalancerParams(lbdeviceid string) *ConfigureF5LoadBalancerParams {
+	p := &ConfigureF5LoadBalancerParams{}
 	p.p = make(map[string]interface{})
-	p.p["algorithm"] = algorithm
-	p.p["instanceport"] = instanceport
-	p.p["name"] = name
-	p.p["networkid"] = networkid
-	p.p["scheme"] = scheme
-	p.p["sourceipaddressnetworkid"] = sourceipaddressnetworkid
-	p.p["sourceport"] = sourceport
+	p.p["lbdeviceid"] = lbdeviceid
 	return p
 }
 
-// Creates a load balancer
-func (s *LoadBalancerService) CreateLoadBalancer(p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("createLoadBalancer", p.toURLValues())
+// configures a F5 load balancer device
+func (s *LoadBalancerService) ConfigureF5LoadBalancer(p *ConfigureF5LoadBalancerParams) (*F5LoadBalancerResponse, error) {
+	return s.ConfigureF5LoadBalancerWithContext(context.Background(), p)
+}
+
+// ConfigureF5LoadBalancerWithContext is the same as ConfigureF5LoadBalancer, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) ConfigureF5LoadBalancerWithContext(ctx context.Context, p *ConfigureF5LoadBalancerParams) (*F5LoadBalancerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureF5LoadBalancer", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateLoadBalancerResponse
+	var r F5LoadBalancerResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1264,224 +1802,254 @@ func (s *LoadBalancerService) CreateLoadBalancer(p *CreateLoadBalancerParams) (*
 	return &r, nil
 }
 
-type CreateLoadBalancerResponse struct {
-	Account                  string                                           `json:"account"`
-	Algorithm                string                                           `json:"algorithm"`
-	Description              string                                           `json:"description"`
-	Domain                   string                                           `json:"domain"`
-	Domainid                 string                                           `json:"domainid"`
-	Fordisplay               bool                                             `json:"fordisplay"`
-	Id                       string                                           `json:"id"`
-	JobID                    string                                           `json:"jobid"`
-	Jobstatus                int                                              `json:"jobstatus"`
-	Loadbalancerinstance     []CreateLoadBalancerResponseLoadbalancerinstance `json:"loadbalancerinstance"`
-	Loadbalancerrule         []CreateLoadBalancerResponseLoadbalancerrule     `json:"loadbalancerrule"`
-	Name                     string                                           `json:"name"`
-	Networkid                string                                           `json:"networkid"`
-	Project                  string                                           `json:"project"`
-	Projectid                string                                           `json:"projectid"`
-	Sourceipaddress          string                                           `json:"sourceipaddress"`
-	Sourceipaddressnetworkid string                                           `json:"sourceipaddressnetworkid"`
-	Tags                     []Tags                                           `json:"tags"`
+// ConfigureF5LoadBalancerAsync starts the async job for ConfigureF5LoadBalancer and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a F5LoadBalancerResponse using Job.Result
+func (s *LoadBalancerService) ConfigureF5LoadBalancerAsync(p *ConfigureF5LoadBalancerParams) (*Job, error) {
+	return s.ConfigureF5LoadBalancerAsyncWithContext(context.Background(), p)
 }
 
-type CreateLoadBalancerResponseLoadbalancerrule struct {
-	Instanceport int    `json:"instanceport"`
-	Sourceport   int    `json:"sourceport"`
-	State        string `json:"state"`
+// ConfigureF5LoadBalancerAsyncWithContext is the same as ConfigureF5LoadBalancerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) ConfigureF5LoadBalancerAsyncWithContext(ctx context.Context, p *ConfigureF5LoadBalancerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureF5LoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r F5LoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
 }
 
-type CreateLoadBalancerResponseLoadbalancerinstance struct {
-	Id        string `json:"id"`
-	Ipaddress string `json:"ipaddress"`
-	Name      string `json:"name"`
-	State     string `json:"state"`
+type F5LoadBalancerResponse struct {
+	Ipaddress         string `json:"ipaddress"`
+	JobID             string `json:"jobid"`
+	Jobstatus         int    `json:"jobstatus"`
+	Lbdevicecapacity  int64  `json:"lbdevicecapacity"`
+	Lbdevicededicated bool   `json:"lbdevicededicated"`
+	Lbdeviceid        string `json:"lbdeviceid"`
+	Lbdevicename      string `json:"lbdevicename"`
+	Lbdevicestate     string `json:"lbdevicestate"`
+	Physicalnetworkid string `json:"physicalnetworkid"`
+	Privateinterface  string `json:"privateinterface"`
+	Provider          string `json:"provider"`
+	Publicinterface   string `json:"publicinterface"`
 }
 
-type CreateLoadBalancerRuleParams struct {
+type ConfigureNetscalerLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ConfigureNetscalerLoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["algorithm"]; found {
-		u.Set("algorithm", v.(string))
-	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("cidrlist", vv)
-	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
+	if v, found := p.p["inline"]; found {
 		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+		u.Set("inline", vv)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v, found := p.p["lbdevicecapacity"]; found {
+		vv := strconv.FormatInt(v.(int64), 10)
+		u.Set("lbdevicecapacity", vv)
 	}
-	if v, found := p.p["openfirewall"]; found {
+	if v, found := p.p["lbdevicededicated"]; found {
 		vv := strconv.FormatBool(v.(bool))
-		u.Set("openfirewall", vv)
-	}
-	if v, found := p.p["privateport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("privateport", vv)
-	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
-	}
-	if v, found := p.p["publicipid"]; found {
-		u.Set("publicipid", v.(string))
+		u.Set("lbdevicededicated", vv)
 	}
-	if v, found := p.p["publicport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("publicport", vv)
+	if v, found := p.p["lbdeviceid"]; found {
+		u.Set("lbdeviceid", v.(string))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v, found := p.p["podids"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("podids", vv)
 	}
 	return u
 }
 
-func (p *CreateLoadBalancerRuleParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ConfigureNetscalerLoadBalancerParams) Validate() error {
+	v := newParamValidator("configureNetscalerLoadBalancer", p.p)
+	v.required("lbdeviceid")
+	v.ids("lbdeviceid")
+	return v.err()
 }
 
-func (p *CreateLoadBalancerRuleParams) SetAlgorithm(v string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ConfigureNetscalerLoadBalancerParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["algorithm"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *CreateLoadBalancerRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ConfigureNetscalerLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["cidrlist"] = v
-}
 
-func (p *CreateLoadBalancerRuleParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "inline", "lbdevicededicated":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "lbdevicecapacity":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "lbdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "podids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for configureNetscalerLoadBalancer", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of configureNetscalerLoadBalancer: %w", k, err)
+		}
 	}
-	p.p["description"] = v
+	return nil
 }
 
-func (p *CreateLoadBalancerRuleParams) SetDomainid(v string) {
+func (p *ConfigureNetscalerLoadBalancerParams) SetInline(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["domainid"] = v
+	p.p["inline"] = v
 }
 
-func (p *CreateLoadBalancerRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+func (p *ConfigureNetscalerLoadBalancerParams) ResetInline() {
+	delete(p.p, "inline")
 }
 
-func (p *CreateLoadBalancerRuleParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+func (p *ConfigureNetscalerLoadBalancerParams) GetInline() (bool, bool) {
+	value, ok := p.p["inline"].(bool)
+	return value, ok
 }
 
-func (p *CreateLoadBalancerRuleParams) SetNetworkid(v string) {
+func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicecapacity(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["networkid"] = v
+	p.p["lbdevicecapacity"] = v
 }
 
-func (p *CreateLoadBalancerRuleParams) SetOpenfirewall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["openfirewall"] = v
+func (p *ConfigureNetscalerLoadBalancerParams) ResetLbdevicecapacity() {
+	delete(p.p, "lbdevicecapacity")
 }
 
-func (p *CreateLoadBalancerRuleParams) SetPrivateport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privateport"] = v
+func (p *ConfigureNetscalerLoadBalancerParams) GetLbdevicecapacity() (int64, bool) {
+	value, ok := p.p["lbdevicecapacity"].(int64)
+	return value, ok
 }
 
-func (p *CreateLoadBalancerRuleParams) SetProtocol(v string) {
+func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicededicated(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["protocol"] = v
+	p.p["lbdevicededicated"] = v
 }
 
-func (p *CreateLoadBalancerRuleParams) SetPublicipid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicipid"] = v
+func (p *ConfigureNetscalerLoadBalancerParams) ResetLbdevicededicated() {
+	delete(p.p, "lbdevicededicated")
 }
 
-func (p *CreateLoadBalancerRuleParams) SetPublicport(v int) {
+func (p *ConfigureNetscalerLoadBalancerParams) GetLbdevicededicated() (bool, bool) {
+	value, ok := p.p["lbdevicededicated"].(bool)
+	return value, ok
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["publicport"] = v
+	p.p["lbdeviceid"] = v
 }
 
-func (p *CreateLoadBalancerRuleParams) SetZoneid(v string) {
+func (p *ConfigureNetscalerLoadBalancerParams) ResetLbdeviceid() {
+	delete(p.p, "lbdeviceid")
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) GetLbdeviceid() (string, bool) {
+	value, ok := p.p["lbdeviceid"].(string)
+	return value, ok
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) SetPodids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["zoneid"] = v
+	p.p["podids"] = v
 }
 
-// You should always use this function to get a new CreateLoadBalancerRuleParams instance,
+func (p *ConfigureNetscalerLoadBalancerParams) ResetPodids() {
+	delete(p.p, "podids")
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) GetPodids() ([]string, bool) {
+	value, ok := p.p["podids"].([]string)
+	return value, ok
+}
+
+// You should always use this function to get a new ConfigureNetscalerLoadBalancerParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateLoadBalancerRuleParams(algorithm string, name string, privateport int, publicport int) *CreateLoadBalancerRuleParams {
-	p := &CreateLoadBalancerRuleParams{}
+func (s *LoadBalancerService) NewConfigureNetscalerLoadBalancerParams(lbdeviceid string) *ConfigureNetscalerLoadBalancerParams {
+	p := &ConfigureNetscalerLoadBalancerParams{}
 	p.p = make(map[string]interface{})
-	p.p["algorithm"] = algorithm
-	p.p["name"] = name
-	p.p["privateport"] = privateport
-	p.p["publicport"] = publicport
+	p.p["lbdeviceid"] = lbdeviceid
 	return p
 }
 
-// Creates a load balancer rule
-func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("createLoadBalancerRule", p.toURLValues())
+// configures a netscaler load balancer device
+func (s *LoadBalancerService) ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error) {
+	return s.ConfigureNetscalerLoadBalancerWithContext(context.Background(), p)
+}
+
+// ConfigureNetscalerLoadBalancerWithContext is the same as ConfigureNetscalerLoadBalancer, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) ConfigureNetscalerLoadBalancerWithContext(ctx context.Context, p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureNetscalerLoadBalancer", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateLoadBalancerRuleResponse
+	var r NetscalerLoadBalancerResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1502,214 +2070,334 @@ func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRulePa
 	return &r, nil
 }
 
-type CreateLoadBalancerRuleResponse struct {
-	Account     string `json:"account"`
-	Algorithm   string `json:"algorithm"`
-	Cidrlist    string `json:"cidrlist"`
-	Description string `json:"description"`
-	Domain      string `json:"domain"`
-	Domainid    string `json:"domainid"`
-	Fordisplay  bool   `json:"fordisplay"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Networkid   string `json:"networkid"`
-	Privateport string `json:"privateport"`
-	Project     string `json:"project"`
-	Projectid   string `json:"projectid"`
-	Protocol    string `json:"protocol"`
-	Publicip    string `json:"publicip"`
-	Publicipid  string `json:"publicipid"`
-	Publicport  string `json:"publicport"`
-	State       string `json:"state"`
-	Tags        []Tags `json:"tags"`
-	Zoneid      string `json:"zoneid"`
-	Zonename    string `json:"zonename"`
+// ConfigureNetscalerLoadBalancerAsync starts the async job for ConfigureNetscalerLoadBalancer and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a NetscalerLoadBalancerResponse using Job.Result
+func (s *LoadBalancerService) ConfigureNetscalerLoadBalancerAsync(p *ConfigureNetscalerLoadBalancerParams) (*Job, error) {
+	return s.ConfigureNetscalerLoadBalancerAsyncWithContext(context.Background(), p)
 }
 
-type DeleteGlobalLoadBalancerRuleParams struct {
+// ConfigureNetscalerLoadBalancerAsyncWithContext is the same as ConfigureNetscalerLoadBalancerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) ConfigureNetscalerLoadBalancerAsyncWithContext(ctx context.Context, p *ConfigureNetscalerLoadBalancerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureNetscalerLoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r NetscalerLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type NetscalerLoadBalancerResponse struct {
+	Gslbprovider            bool     `json:"gslbprovider"`
+	Gslbproviderprivateip   string   `json:"gslbproviderprivateip"`
+	Gslbproviderpublicip    string   `json:"gslbproviderpublicip"`
+	Ipaddress               string   `json:"ipaddress"`
+	Isexclusivegslbprovider bool     `json:"isexclusivegslbprovider"`
+	JobID                   string   `json:"jobid"`
+	Jobstatus               int      `json:"jobstatus"`
+	Lbdevicecapacity        int64    `json:"lbdevicecapacity"`
+	Lbdevicededicated       bool     `json:"lbdevicededicated"`
+	Lbdeviceid              string   `json:"lbdeviceid"`
+	Lbdevicename            string   `json:"lbdevicename"`
+	Lbdevicestate           string   `json:"lbdevicestate"`
+	Physicalnetworkid       string   `json:"physicalnetworkid"`
+	Podids                  []string `json:"podids"`
+	Privateinterface        string   `json:"privateinterface"`
+	Provider                string   `json:"provider"`
+	Publicinterface         string   `json:"publicinterface"`
+}
+
+type CreateGlobalLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteGlobalLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateGlobalLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
 	}
-	return u
-}
-
-func (p *DeleteGlobalLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if v, found := p.p["description"]; found {
+		u.Set("description", v.(string))
 	}
-	p.p["id"] = v
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["gslbdomainname"]; found {
+		u.Set("gslbdomainname", v.(string))
+	}
+	if v, found := p.p["gslblbmethod"]; found {
+		u.Set("gslblbmethod", v.(string))
+	}
+	if v, found := p.p["gslbservicetype"]; found {
+		u.Set("gslbservicetype", v.(string))
+	}
+	if v, found := p.p["gslbstickysessionmethodname"]; found {
+		u.Set("gslbstickysessionmethodname", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["regionid"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("regionid", vv)
+	}
+	return u
 }
 
-// You should always use this function to get a new DeleteGlobalLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteGlobalLoadBalancerRuleParams(id string) *DeleteGlobalLoadBalancerRuleParams {
-	p := &DeleteGlobalLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateGlobalLoadBalancerRuleParams) Validate() error {
+	v := newParamValidator("createGlobalLoadBalancerRule", p.p)
+	v.required("gslbdomainname", "gslbservicetype", "name", "regionid")
+	v.ids("domainid")
+	v.maxLength("account", 255)
+	v.maxLength("description", 4096)
+	v.maxLength("gslbdomainname", 255)
+	v.maxLength("gslblbmethod", 255)
+	v.maxLength("gslbservicetype", 255)
+	v.maxLength("gslbstickysessionmethodname", 255)
+	v.maxLength("name", 255)
+	return v.err()
 }
 
-// Deletes a global load balancer rule.
-func (s *LoadBalancerService) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteGlobalLoadBalancerRule", p.toURLValues())
-	if err != nil {
-		return nil, err
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
 	}
+	return json.Marshal(p.p)
+}
 
-	var r DeleteGlobalLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "description", "domainid", "gslbdomainname", "gslblbmethod", "gslbservicetype", "gslbstickysessionmethodname", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "regionid":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createGlobalLoadBalancerRule", k)
 		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createGlobalLoadBalancerRule: %w", k, err)
 		}
 	}
+	return nil
+}
 
-	return &r, nil
+func (p *CreateGlobalLoadBalancerRuleParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
 }
 
-type DeleteGlobalLoadBalancerRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+func (p *CreateGlobalLoadBalancerRuleParams) ResetAccount() {
+	delete(p.p, "account")
 }
 
-type DeleteLBHealthCheckPolicyParams struct {
-	p map[string]interface{}
+func (p *CreateGlobalLoadBalancerRuleParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
 }
 
-func (p *DeleteLBHealthCheckPolicyParams) toURLValues() url.Values {
-	u := url.Values{}
+func (p *CreateGlobalLoadBalancerRuleParams) SetDescription(v string) {
 	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+		p.p = make(map[string]interface{})
 	}
-	return u
+	p.p["description"] = v
 }
 
-func (p *DeleteLBHealthCheckPolicyParams) SetId(v string) {
+func (p *CreateGlobalLoadBalancerRuleParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *CreateGlobalLoadBalancerRuleParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
+func (p *CreateGlobalLoadBalancerRuleParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["domainid"] = v
 }
 
-// You should always use this function to get a new DeleteLBHealthCheckPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteLBHealthCheckPolicyParams(id string) *DeleteLBHealthCheckPolicyParams {
-	p := &DeleteLBHealthCheckPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *CreateGlobalLoadBalancerRuleParams) ResetDomainid() {
+	delete(p.p, "domainid")
 }
 
-// Deletes a load balancer health check policy.
-func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("deleteLBHealthCheckPolicy", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *CreateGlobalLoadBalancerRuleParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
 
-	var r DeleteLBHealthCheckPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *CreateGlobalLoadBalancerRuleParams) SetGslbdomainname(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
+	p.p["gslbdomainname"] = v
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
+func (p *CreateGlobalLoadBalancerRuleParams) ResetGslbdomainname() {
+	delete(p.p, "gslbdomainname")
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *CreateGlobalLoadBalancerRuleParams) GetGslbdomainname() (string, bool) {
+	value, ok := p.p["gslbdomainname"].(string)
+	return value, ok
+}
+
+func (p *CreateGlobalLoadBalancerRuleParams) SetGslblbmethod(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
+	p.p["gslblbmethod"] = v
+}
 
-	return &r, nil
+func (p *CreateGlobalLoadBalancerRuleParams) ResetGslblbmethod() {
+	delete(p.p, "gslblbmethod")
 }
 
-type DeleteLBHealthCheckPolicyResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+func (p *CreateGlobalLoadBalancerRuleParams) GetGslblbmethod() (string, bool) {
+	value, ok := p.p["gslblbmethod"].(string)
+	return value, ok
 }
 
-type DeleteLBStickinessPolicyParams struct {
-	p map[string]interface{}
+func (p *CreateGlobalLoadBalancerRuleParams) SetGslbservicetype(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["gslbservicetype"] = v
 }
 
-func (p *DeleteLBStickinessPolicyParams) toURLValues() url.Values {
-	u := url.Values{}
+func (p *CreateGlobalLoadBalancerRuleParams) ResetGslbservicetype() {
+	delete(p.p, "gslbservicetype")
+}
+
+func (p *CreateGlobalLoadBalancerRuleParams) GetGslbservicetype() (string, bool) {
+	value, ok := p.p["gslbservicetype"].(string)
+	return value, ok
+}
+
+func (p *CreateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodname(v string) {
 	if p.p == nil {
-		return u
+		p.p = make(map[string]interface{})
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	p.p["gslbstickysessionmethodname"] = v
+}
+
+func (p *CreateGlobalLoadBalancerRuleParams) ResetGslbstickysessionmethodname() {
+	delete(p.p, "gslbstickysessionmethodname")
+}
+
+func (p *CreateGlobalLoadBalancerRuleParams) GetGslbstickysessionmethodname() (string, bool) {
+	value, ok := p.p["gslbstickysessionmethodname"].(string)
+	return value, ok
+}
+
+func (p *CreateGlobalLoadBalancerRuleParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
-	return u
+	p.p["name"] = v
 }
 
-func (p *DeleteLBStickinessPolicyParams) SetId(v string) {
+func (p *CreateGlobalLoadBalancerRuleParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateGlobalLoadBalancerRuleParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *CreateGlobalLoadBalancerRuleParams) SetRegionid(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["regionid"] = v
 }
 
-// You should always use this function to get a new DeleteLBStickinessPolicyParams instance,
+func (p *CreateGlobalLoadBalancerRuleParams) ResetRegionid() {
+	delete(p.p, "regionid")
+}
+
+func (p *CreateGlobalLoadBalancerRuleParams) GetRegionid() (int, bool) {
+	value, ok := p.p["regionid"].(int)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateGlobalLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteLBStickinessPolicyParams(id string) *DeleteLBStickinessPolicyParams {
-	p := &DeleteLBStickinessPolicyParams{}
+func (s *LoadBalancerService) NewCreateGlobalLoadBalancerRuleParams(gslbdomainname string, gslbservicetype string, name string, regionid int) *CreateGlobalLoadBalancerRuleParams {
+	p := &CreateGlobalLoadBalancerRuleParams{}
 	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.p["gslbdomainname"] = gslbdomainname
+	p.p["gslbservicetype"] = gslbservicetype
+	p.p["name"] = name
+	p.p["regionid"] = regionid
 	return p
 }
 
-// Deletes a load balancer stickiness policy.
-func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("deleteLBStickinessPolicy", p.toURLValues())
+// Creates a global load balancer rule
+func (s *LoadBalancerService) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error) {
+	return s.CreateGlobalLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// CreateGlobalLoadBalancerRuleWithContext is the same as CreateGlobalLoadBalancerRule, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) CreateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createGlobalLoadBalancerRule", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteLBStickinessPolicyResponse
+	var r CreateGlobalLoadBalancerRuleResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1717,6 +2405,11 @@ func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPoli
 			return nil, err
 		}
 
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
 		if err := json.Unmarshal(b, &r); err != nil {
 			return nil, err
 		}
@@ -1725,127 +2418,338 @@ func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPoli
 	return &r, nil
 }
 
-type DeleteLBStickinessPolicyResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+// CreateGlobalLoadBalancerRuleAsync starts the async job for CreateGlobalLoadBalancerRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateGlobalLoadBalancerRuleResponse using Job.Result
+func (s *LoadBalancerService) CreateGlobalLoadBalancerRuleAsync(p *CreateGlobalLoadBalancerRuleParams) (*Job, error) {
+	return s.CreateGlobalLoadBalancerRuleAsyncWithContext(context.Background(), p)
 }
 
-type DeleteLoadBalancerParams struct {
+// CreateGlobalLoadBalancerRuleAsyncWithContext is the same as CreateGlobalLoadBalancerRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) CreateGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *CreateGlobalLoadBalancerRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createGlobalLoadBalancerRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateGlobalLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type CreateGlobalLoadBalancerRuleResponse struct {
+	Account                     string                                                 `json:"account"`
+	Description                 string                                                 `json:"description"`
+	Domain                      string                                                 `json:"domain"`
+	Domainid                    string                                                 `json:"domainid"`
+	Gslbdomainname              string                                                 `json:"gslbdomainname"`
+	Gslblbmethod                string                                                 `json:"gslblbmethod"`
+	Gslbservicetype             string                                                 `json:"gslbservicetype"`
+	Gslbstickysessionmethodname string                                                 `json:"gslbstickysessionmethodname"`
+	Id                          string                                                 `json:"id"`
+	JobID                       string                                                 `json:"jobid"`
+	Jobstatus                   int                                                    `json:"jobstatus"`
+	Loadbalancerrule            []CreateGlobalLoadBalancerRuleResponseLoadbalancerrule `json:"loadbalancerrule"`
+	Name                        string                                                 `json:"name"`
+	Project                     string                                                 `json:"project"`
+	Projectid                   string                                                 `json:"projectid"`
+	Regionid                    int                                                    `json:"regionid"`
+}
+
+type CreateGlobalLoadBalancerRuleResponseLoadbalancerrule struct {
+	Account     string `json:"account"`
+	Algorithm   string `json:"algorithm"`
+	Cidrlist    string `json:"cidrlist"`
+	Description string `json:"description"`
+	Domain      string `json:"domain"`
+	Domainid    string `json:"domainid"`
+	Fordisplay  bool   `json:"fordisplay"`
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Networkid   string `json:"networkid"`
+	Privateport string `json:"privateport"`
+	Project     string `json:"project"`
+	Projectid   string `json:"projectid"`
+	Protocol    string `json:"protocol"`
+	Publicip    string `json:"publicip"`
+	Publicipid  string `json:"publicipid"`
+	Publicport  string `json:"publicport"`
+	State       string `json:"state"`
+	Tags        []Tags `json:"tags"`
+	Zoneid      string `json:"zoneid"`
+	Zonename    string `json:"zonename"`
+}
+
+type CreateLBHealthCheckPolicyParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteLoadBalancerParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateLBHealthCheckPolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v, found := p.p["description"]; found {
+		u.Set("description", v.(string))
+	}
+	if v, found := p.p["fordisplay"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("fordisplay", vv)
+	}
+	if v, found := p.p["healthythreshold"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("healthythreshold", vv)
+	}
+	if v, found := p.p["intervaltime"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("intervaltime", vv)
+	}
+	if v, found := p.p["lbruleid"]; found {
+		u.Set("lbruleid", v.(string))
+	}
+	if v, found := p.p["pingpath"]; found {
+		u.Set("pingpath", v.(string))
+	}
+	if v, found := p.p["responsetimeout"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("responsetimeout", vv)
+	}
+	if v, found := p.p["unhealthythreshold"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("unhealthythreshold", vv)
 	}
 	return u
 }
 
-func (p *DeleteLoadBalancerParams) SetId(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateLBHealthCheckPolicyParams) Validate() error {
+	v := newParamValidator("createLBHealthCheckPolicy", p.p)
+	v.required("lbruleid")
+	v.ids("lbruleid")
+	v.maxLength("description", 255)
+	v.maxLength("pingpath", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateLBHealthCheckPolicyParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateLBHealthCheckPolicyParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "lbruleid", "pingpath":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "healthythreshold", "intervaltime", "responsetimeout", "unhealthythreshold":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createLBHealthCheckPolicy", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createLBHealthCheckPolicy: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *CreateLBHealthCheckPolicyParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["description"] = v
 }
 
-// You should always use this function to get a new DeleteLoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteLoadBalancerParams(id string) *DeleteLoadBalancerParams {
-	p := &DeleteLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *CreateLBHealthCheckPolicyParams) ResetDescription() {
+	delete(p.p, "description")
 }
 
-// Deletes a load balancer
-func (s *LoadBalancerService) DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("deleteLoadBalancer", p.toURLValues())
-	if err != nil {
-		return nil, err
+func (p *CreateLBHealthCheckPolicyParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
+func (p *CreateLBHealthCheckPolicyParams) SetFordisplay(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
+	p.p["fordisplay"] = v
+}
 
-	var r DeleteLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *CreateLBHealthCheckPolicyParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateLBHealthCheckPolicyParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
+func (p *CreateLBHealthCheckPolicyParams) SetHealthythreshold(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
+	p.p["healthythreshold"] = v
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
+func (p *CreateLBHealthCheckPolicyParams) ResetHealthythreshold() {
+	delete(p.p, "healthythreshold")
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *CreateLBHealthCheckPolicyParams) GetHealthythreshold() (int, bool) {
+	value, ok := p.p["healthythreshold"].(int)
+	return value, ok
+}
+
+func (p *CreateLBHealthCheckPolicyParams) SetIntervaltime(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
+	p.p["intervaltime"] = v
+}
 
-	return &r, nil
+func (p *CreateLBHealthCheckPolicyParams) ResetIntervaltime() {
+	delete(p.p, "intervaltime")
 }
 
-type DeleteLoadBalancerResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+func (p *CreateLBHealthCheckPolicyParams) GetIntervaltime() (int, bool) {
+	value, ok := p.p["intervaltime"].(int)
+	return value, ok
 }
 
-type DeleteLoadBalancerRuleParams struct {
-	p map[string]interface{}
+func (p *CreateLBHealthCheckPolicyParams) SetLbruleid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["lbruleid"] = v
 }
 
-func (p *DeleteLoadBalancerRuleParams) toURLValues() url.Values {
-	u := url.Values{}
+func (p *CreateLBHealthCheckPolicyParams) ResetLbruleid() {
+	delete(p.p, "lbruleid")
+}
+
+func (p *CreateLBHealthCheckPolicyParams) GetLbruleid() (string, bool) {
+	value, ok := p.p["lbruleid"].(string)
+	return value, ok
+}
+
+func (p *CreateLBHealthCheckPolicyParams) SetPingpath(v string) {
 	if p.p == nil {
-		return u
+		p.p = make(map[string]interface{})
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	p.p["pingpath"] = v
+}
+
+func (p *CreateLBHealthCheckPolicyParams) ResetPingpath() {
+	delete(p.p, "pingpath")
+}
+
+func (p *CreateLBHealthCheckPolicyParams) GetPingpath() (string, bool) {
+	value, ok := p.p["pingpath"].(string)
+	return value, ok
+}
+
+func (p *CreateLBHealthCheckPolicyParams) SetResponsetimeout(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
-	return u
+	p.p["responsetimeout"] = v
 }
 
-func (p *DeleteLoadBalancerRuleParams) SetId(v string) {
+func (p *CreateLBHealthCheckPolicyParams) ResetResponsetimeout() {
+	delete(p.p, "responsetimeout")
+}
+
+func (p *CreateLBHealthCheckPolicyParams) GetResponsetimeout() (int, bool) {
+	value, ok := p.p["responsetimeout"].(int)
+	return value, ok
+}
+
+func (p *CreateLBHealthCheckPolicyParams) SetUnhealthythreshold(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["unhealthythreshold"] = v
 }
 
-// You should always use this function to get a new DeleteLoadBalancerRuleParams instance,
+func (p *CreateLBHealthCheckPolicyParams) ResetUnhealthythreshold() {
+	delete(p.p, "unhealthythreshold")
+}
+
+func (p *CreateLBHealthCheckPolicyParams) GetUnhealthythreshold() (int, bool) {
+	value, ok := p.p["unhealthythreshold"].(int)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateLBHealthCheckPolicyParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteLoadBalancerRuleParams(id string) *DeleteLoadBalancerRuleParams {
-	p := &DeleteLoadBalancerRuleParams{}
+func (s *LoadBalancerService) NewCreateLBHealthCheckPolicyParams(lbruleid string) *CreateLBHealthCheckPolicyParams {
+	p := &CreateLBHealthCheckPolicyParams{}
 	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.p["lbruleid"] = lbruleid
 	return p
 }
 
-// Deletes a load balancer rule.
-func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteLoadBalancerRule", p.toURLValues())
+// Creates a load balancer health check policy
+func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
+	return s.CreateLBHealthCheckPolicyWithContext(context.Background(), p)
+}
+
+// CreateLBHealthCheckPolicyWithContext is the same as CreateLBHealthCheckPolicy, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) CreateLBHealthCheckPolicyWithContext(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createLBHealthCheckPolicy", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteLoadBalancerRuleResponse
+	var r CreateLBHealthCheckPolicyResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1853,6 +2757,11 @@ func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRulePa
 			return nil, err
 		}
 
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
 		if err := json.Unmarshal(b, &r); err != nil {
 			return nil, err
 		}
@@ -1861,166 +2770,3635 @@ func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRulePa
 	return &r, nil
 }
 
-type DeleteLoadBalancerRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+// CreateLBHealthCheckPolicyAsync starts the async job for CreateLBHealthCheckPolicy and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateLBHealthCheckPolicyResponse using Job.Result
+func (s *LoadBalancerService) CreateLBHealthCheckPolicyAsync(p *CreateLBHealthCheckPolicyParams) (*Job, error) {
+	return s.CreateLBHealthCheckPolicyAsyncWithContext(context.Background(), p)
+}
+
+// CreateLBHealthCheckPolicyAsyncWithContext is the same as CreateLBHealthCheckPolicyAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) CreateLBHealthCheckPolicyAsyncWithContext(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createLBHealthCheckPolicy", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateLBHealthCheckPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type CreateLBHealthCheckPolicyResponse struct {
+	Account           string                                               `json:"account"`
+	Domain            string                                               `json:"domain"`
+	Domainid          string                                               `json:"domainid"`
+	Healthcheckpolicy []CreateLBHealthCheckPolicyResponseHealthcheckpolicy `json:"healthcheckpolicy"`
+	JobID             string                                               `json:"jobid"`
+	Jobstatus         int                                                  `json:"jobstatus"`
+	Lbruleid          string                                               `json:"lbruleid"`
+	Zoneid            string                                               `json:"zoneid"`
+}
+
+type CreateLBHealthCheckPolicyResponseHealthcheckpolicy struct {
+	Description             string `json:"description"`
+	Fordisplay              bool   `json:"fordisplay"`
+	Healthcheckinterval     int    `json:"healthcheckinterval"`
+	Healthcheckthresshold   int    `json:"healthcheckthresshold"`
+	Id                      string `json:"id"`
+	Pingpath                string `json:"pingpath"`
+	Responsetime            int    `json:"responsetime"`
+	State                   string `json:"state"`
+	Unhealthcheckthresshold int    `json:"unhealthcheckthresshold"`
+}
+
+type CreateLBStickinessPolicyParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateLBStickinessPolicyParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["description"]; found {
+		u.Set("description", v.(string))
+	}
+	if v, found := p.p["fordisplay"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("fordisplay", vv)
+	}
+	if v, found := p.p["lbruleid"]; found {
+		u.Set("lbruleid", v.(string))
+	}
+	if v, found := p.p["methodname"]; found {
+		u.Set("methodname", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["param"]; found {
+		for i, item := range v.([]StickinessPolicyParam) {
+			if item.Name != "" {
+				u.Set(fmt.Sprintf("param[%d].name", i), item.Name)
+			}
+			if item.Value != "" {
+				u.Set(fmt.Sprintf("param[%d].value", i), item.Value)
+			}
+		}
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateLBStickinessPolicyParams) Validate() error {
+	v := newParamValidator("createLBStickinessPolicy", p.p)
+	v.required("lbruleid", "methodname", "name")
+	v.ids("lbruleid")
+	v.maxLength("description", 255)
+	v.maxLength("methodname", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateLBStickinessPolicyParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateLBStickinessPolicyParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "lbruleid", "methodname", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "param":
+			var v []StickinessPolicyParam
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createLBStickinessPolicy", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createLBStickinessPolicy: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *CreateLBStickinessPolicyParams) SetDescription(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["description"] = v
+}
+
+func (p *CreateLBStickinessPolicyParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *CreateLBStickinessPolicyParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
+func (p *CreateLBStickinessPolicyParams) SetFordisplay(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fordisplay"] = v
+}
+
+func (p *CreateLBStickinessPolicyParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateLBStickinessPolicyParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
+func (p *CreateLBStickinessPolicyParams) SetLbruleid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["lbruleid"] = v
+}
+
+func (p *CreateLBStickinessPolicyParams) ResetLbruleid() {
+	delete(p.p, "lbruleid")
+}
+
+func (p *CreateLBStickinessPolicyParams) GetLbruleid() (string, bool) {
+	value, ok := p.p["lbruleid"].(string)
+	return value, ok
+}
+
+func (p *CreateLBStickinessPolicyParams) SetMethodname(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["methodname"] = v
+}
+
+func (p *CreateLBStickinessPolicyParams) ResetMethodname() {
+	delete(p.p, "methodname")
+}
+
+func (p *CreateLBStickinessPolicyParams) GetMethodname() (string, bool) {
+	value, ok := p.p["methodname"].(string)
+	return value, ok
+}
+
+func (p *CreateLBStickinessPolicyParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *CreateLBStickinessPolicyParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateLBStickinessPolicyParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *CreateLBStickinessPolicyParams) SetParam(v []StickinessPolicyParam) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["param"] = v
+}
+
+func (p *CreateLBStickinessPolicyParams) ResetParam() {
+	delete(p.p, "param")
+}
+
+func (p *CreateLBStickinessPolicyParams) GetParam() ([]StickinessPolicyParam, bool) {
+	value, ok := p.p["param"].([]StickinessPolicyParam)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateLBStickinessPolicyParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string) *CreateLBStickinessPolicyParams {
+	p := &CreateLBStickinessPolicyParams{}
+	p.p = make(map[string]interface{})
+	p.p["lbruleid"] = lbruleid
+	p.p["methodname"] = methodname
+	p.p["name"] = name
+	return p
+}
+
+// Creates a load balancer stickiness policy
+func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
+	return s.CreateLBStickinessPolicyWithContext(context.Background(), p)
+}
+
+// CreateLBStickinessPolicyWithContext is the same as CreateLBStickinessPolicy, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) CreateLBStickinessPolicyWithContext(ctx context.Context, p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createLBStickinessPolicy", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateLBStickinessPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// CreateLBStickinessPolicyAsync starts the async job for CreateLBStickinessPolicy and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateLBStickinessPolicyResponse using Job.Result
+func (s *LoadBalancerService) CreateLBStickinessPolicyAsync(p *CreateLBStickinessPolicyParams) (*Job, error) {
+	return s.CreateLBStickinessPolicyAsyncWithContext(context.Background(), p)
+}
+
+// CreateLBStickinessPolicyAsyncWithContext is the same as CreateLBStickinessPolicyAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) CreateLBStickinessPolicyAsyncWithContext(ctx context.Context, p *CreateLBStickinessPolicyParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createLBStickinessPolicy", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateLBStickinessPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type CreateLBStickinessPolicyResponse struct {
+	Account          string                                             `json:"account"`
+	Description      string                                             `json:"description"`
+	Domain           string                                             `json:"domain"`
+	Domainid         string                                             `json:"domainid"`
+	JobID            string                                             `json:"jobid"`
+	Jobstatus        int                                                `json:"jobstatus"`
+	Lbruleid         string                                             `json:"lbruleid"`
+	Name             string                                             `json:"name"`
+	State            string                                             `json:"state"`
+	Stickinesspolicy []CreateLBStickinessPolicyResponseStickinesspolicy `json:"stickinesspolicy"`
+	Zoneid           string                                             `json:"zoneid"`
+}
+
+type CreateLBStickinessPolicyResponseStickinesspolicy struct {
+	Description string            `json:"description"`
+	Fordisplay  bool              `json:"fordisplay"`
+	Id          string            `json:"id"`
+	Methodname  string            `json:"methodname"`
+	Name        string            `json:"name"`
+	Params      map[string]string `json:"params"`
+	State       string            `json:"state"`
+}
+
+type CreateLoadBalancerParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateLoadBalancerParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["algorithm"]; found {
+		u.Set("algorithm", v.(string))
+	}
+	if v, found := p.p["description"]; found {
+		u.Set("description", v.(string))
+	}
+	if v, found := p.p["fordisplay"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("fordisplay", vv)
+	}
+	if v, found := p.p["instanceport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("instanceport", vv)
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["networkid"]; found {
+		u.Set("networkid", v.(string))
+	}
+	if v, found := p.p["scheme"]; found {
+		u.Set("scheme", v.(string))
+	}
+	if v, found := p.p["sourceipaddress"]; found {
+		u.Set("sourceipaddress", v.(string))
+	}
+	if v, found := p.p["sourceipaddressnetworkid"]; found {
+		u.Set("sourceipaddressnetworkid", v.(string))
+	}
+	if v, found := p.p["sourceport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("sourceport", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateLoadBalancerParams) Validate() error {
+	v := newParamValidator("createLoadBalancer", p.p)
+	v.required("algorithm", "instanceport", "name", "networkid", "scheme", "sourceipaddressnetworkid", "sourceport")
+	v.ids("networkid", "sourceipaddressnetworkid")
+	v.maxLength("algorithm", 255)
+	v.maxLength("description", 4096)
+	v.maxLength("name", 255)
+	v.maxLength("scheme", 255)
+	v.maxLength("sourceipaddress", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "algorithm", "description", "name", "networkid", "scheme", "sourceipaddress", "sourceipaddressnetworkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "instanceport", "sourceport":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createLoadBalancer", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createLoadBalancer: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *CreateLoadBalancerParams) SetAlgorithm(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["algorithm"] = v
+}
+
+func (p *CreateLoadBalancerParams) ResetAlgorithm() {
+	delete(p.p, "algorithm")
+}
+
+func (p *CreateLoadBalancerParams) GetAlgorithm() (string, bool) {
+	value, ok := p.p["algorithm"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerParams) SetDescription(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["description"] = v
+}
+
+func (p *CreateLoadBalancerParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *CreateLoadBalancerParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerParams) SetFordisplay(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fordisplay"] = v
+}
+
+func (p *CreateLoadBalancerParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateLoadBalancerParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerParams) SetInstanceport(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["instanceport"] = v
+}
+
+func (p *CreateLoadBalancerParams) ResetInstanceport() {
+	delete(p.p, "instanceport")
+}
+
+func (p *CreateLoadBalancerParams) GetInstanceport() (int, bool) {
+	value, ok := p.p["instanceport"].(int)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *CreateLoadBalancerParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateLoadBalancerParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerParams) SetNetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networkid"] = v
+}
+
+func (p *CreateLoadBalancerParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *CreateLoadBalancerParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerParams) SetScheme(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["scheme"] = v
+}
+
+func (p *CreateLoadBalancerParams) ResetScheme() {
+	delete(p.p, "scheme")
+}
+
+func (p *CreateLoadBalancerParams) GetScheme() (string, bool) {
+	value, ok := p.p["scheme"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerParams) SetSourceipaddress(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["sourceipaddress"] = v
+}
+
+func (p *CreateLoadBalancerParams) ResetSourceipaddress() {
+	delete(p.p, "sourceipaddress")
+}
+
+func (p *CreateLoadBalancerParams) GetSourceipaddress() (string, bool) {
+	value, ok := p.p["sourceipaddress"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerParams) SetSourceipaddressnetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["sourceipaddressnetworkid"] = v
+}
+
+func (p *CreateLoadBalancerParams) ResetSourceipaddressnetworkid() {
+	delete(p.p, "sourceipaddressnetworkid")
+}
+
+func (p *CreateLoadBalancerParams) GetSourceipaddressnetworkid() (string, bool) {
+	value, ok := p.p["sourceipaddressnetworkid"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerParams) SetSourceport(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["sourceport"] = v
+}
+
+func (p *CreateLoadBalancerParams) ResetSourceport() {
+	delete(p.p, "sourceport")
+}
+
+func (p *CreateLoadBalancerParams) GetSourceport() (int, bool) {
+	value, ok := p.p["sourceport"].(int)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateLoadBalancerParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewCreateLoadBalancerParams(algorithm string, instanceport int, name string, networkid string, scheme string, sourceipaddressnetworkid string, sourceport int) *CreateLoadBalancerParams {
+	p := &CreateLoadBalancerParams{}
+	p.p = make(map[string]interface{})
+	p.p["algorithm"] = algorithm
+	p.p["instanceport"] = instanceport
+	p.p["name"] = name
+	p.p["networkid"] = networkid
+	p.p["scheme"] = scheme
+	p.p["sourceipaddressnetworkid"] = sourceipaddressnetworkid
+	p.p["sourceport"] = sourceport
+	return p
+}
+
+// Creates a load balancer
+func (s *LoadBalancerService) CreateLoadBalancer(p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error) {
+	return s.CreateLoadBalancerWithContext(context.Background(), p)
+}
+
+// CreateLoadBalancerWithContext is the same as CreateLoadBalancer, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) CreateLoadBalancerWithContext(ctx context.Context, p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createLoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// CreateLoadBalancerAsync starts the async job for CreateLoadBalancer and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateLoadBalancerResponse using Job.Result
+func (s *LoadBalancerService) CreateLoadBalancerAsync(p *CreateLoadBalancerParams) (*Job, error) {
+	return s.CreateLoadBalancerAsyncWithContext(context.Background(), p)
+}
+
+// CreateLoadBalancerAsyncWithContext is the same as CreateLoadBalancerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) CreateLoadBalancerAsyncWithContext(ctx context.Context, p *CreateLoadBalancerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createLoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type CreateLoadBalancerResponse struct {
+	Account                  string                                           `json:"account"`
+	Algorithm                string                                           `json:"algorithm"`
+	Description              string                                           `json:"description"`
+	Domain                   string                                           `json:"domain"`
+	Domainid                 string                                           `json:"domainid"`
+	Fordisplay               bool                                             `json:"fordisplay"`
+	Id                       string                                           `json:"id"`
+	JobID                    string                                           `json:"jobid"`
+	Jobstatus                int                                              `json:"jobstatus"`
+	Loadbalancerinstance     []CreateLoadBalancerResponseLoadbalancerinstance `json:"loadbalancerinstance"`
+	Loadbalancerrule         []CreateLoadBalancerResponseLoadbalancerrule     `json:"loadbalancerrule"`
+	Name                     string                                           `json:"name"`
+	Networkid                string                                           `json:"networkid"`
+	Project                  string                                           `json:"project"`
+	Projectid                string                                           `json:"projectid"`
+	Sourceipaddress          string                                           `json:"sourceipaddress"`
+	Sourceipaddressnetworkid string                                           `json:"sourceipaddressnetworkid"`
+	Tags                     []Tags                                           `json:"tags"`
+}
+
+type CreateLoadBalancerResponseLoadbalancerrule struct {
+	Instanceport int    `json:"instanceport"`
+	Sourceport   int    `json:"sourceport"`
+	State        string `json:"state"`
+}
+
+type CreateLoadBalancerResponseLoadbalancerinstance struct {
+	Id        string `json:"id"`
+	Ipaddress string `json:"ipaddress"`
+	Name      string `json:"name"`
+	State     string `json:"state"`
+}
+
+type CreateLoadBalancerRuleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateLoadBalancerRuleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["algorithm"]; found {
+		u.Set("algorithm", v.(string))
+	}
+	if v, found := p.p["cidrlist"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("cidrlist", vv)
+	}
+	if v, found := p.p["description"]; found {
+		u.Set("description", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["fordisplay"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("fordisplay", vv)
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["networkid"]; found {
+		u.Set("networkid", v.(string))
+	}
+	if v, found := p.p["openfirewall"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("openfirewall", vv)
+	}
+	if v, found := p.p["privateport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("privateport", vv)
+	}
+	if v, found := p.p["protocol"]; found {
+		u.Set("protocol", v.(string))
+	}
+	if v, found := p.p["publicipid"]; found {
+		u.Set("publicipid", v.(string))
+	}
+	if v, found := p.p["publicport"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("publicport", vv)
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateLoadBalancerRuleParams) Validate() error {
+	v := newParamValidator("createLoadBalancerRule", p.p)
+	v.required("algorithm", "name", "privateport", "publicport")
+	v.ids("domainid", "networkid", "publicipid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("algorithm", 255)
+	v.maxLength("description", 4096)
+	v.maxLength("name", 255)
+	v.maxLength("protocol", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "algorithm", "description", "domainid", "name", "networkid", "protocol", "publicipid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "cidrlist":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "openfirewall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "privateport", "publicport":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createLoadBalancerRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createLoadBalancerRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *CreateLoadBalancerRuleParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateLoadBalancerRuleParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerRuleParams) SetAlgorithm(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["algorithm"] = v
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetAlgorithm() {
+	delete(p.p, "algorithm")
+}
+
+func (p *CreateLoadBalancerRuleParams) GetAlgorithm() (string, bool) {
+	value, ok := p.p["algorithm"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerRuleParams) SetCidrlist(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["cidrlist"] = v
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetCidrlist() {
+	delete(p.p, "cidrlist")
+}
+
+func (p *CreateLoadBalancerRuleParams) GetCidrlist() ([]string, bool) {
+	value, ok := p.p["cidrlist"].([]string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerRuleParams) SetDescription(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["description"] = v
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *CreateLoadBalancerRuleParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerRuleParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateLoadBalancerRuleParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerRuleParams) SetFordisplay(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fordisplay"] = v
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateLoadBalancerRuleParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerRuleParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateLoadBalancerRuleParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerRuleParams) SetNetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networkid"] = v
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *CreateLoadBalancerRuleParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerRuleParams) SetOpenfirewall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["openfirewall"] = v
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetOpenfirewall() {
+	delete(p.p, "openfirewall")
+}
+
+func (p *CreateLoadBalancerRuleParams) GetOpenfirewall() (bool, bool) {
+	value, ok := p.p["openfirewall"].(bool)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerRuleParams) SetPrivateport(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["privateport"] = v
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetPrivateport() {
+	delete(p.p, "privateport")
+}
+
+func (p *CreateLoadBalancerRuleParams) GetPrivateport() (int, bool) {
+	value, ok := p.p["privateport"].(int)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerRuleParams) SetProtocol(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["protocol"] = v
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetProtocol() {
+	delete(p.p, "protocol")
+}
+
+func (p *CreateLoadBalancerRuleParams) GetProtocol() (string, bool) {
+	value, ok := p.p["protocol"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerRuleParams) SetPublicipid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["publicipid"] = v
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetPublicipid() {
+	delete(p.p, "publicipid")
+}
+
+func (p *CreateLoadBalancerRuleParams) GetPublicipid() (string, bool) {
+	value, ok := p.p["publicipid"].(string)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerRuleParams) SetPublicport(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["publicport"] = v
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetPublicport() {
+	delete(p.p, "publicport")
+}
+
+func (p *CreateLoadBalancerRuleParams) GetPublicport() (int, bool) {
+	value, ok := p.p["publicport"].(int)
+	return value, ok
+}
+
+func (p *CreateLoadBalancerRuleParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreateLoadBalancerRuleParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateLoadBalancerRuleParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewCreateLoadBalancerRuleParams(algorithm string, name string, privateport int, publicport int) *CreateLoadBalancerRuleParams {
+	p := &CreateLoadBalancerRuleParams{}
+	p.p = make(map[string]interface{})
+	p.p["algorithm"] = algorithm
+	p.p["name"] = name
+	p.p["privateport"] = privateport
+	p.p["publicport"] = publicport
+	return p
+}
+
+// Creates a load balancer rule
+func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
+	return s.CreateLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// CreateLoadBalancerRuleWithContext is the same as CreateLoadBalancerRule, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) CreateLoadBalancerRuleWithContext(ctx context.Context, p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createLoadBalancerRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// CreateLoadBalancerRuleAsync starts the async job for CreateLoadBalancerRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateLoadBalancerRuleResponse using Job.Result
+func (s *LoadBalancerService) CreateLoadBalancerRuleAsync(p *CreateLoadBalancerRuleParams) (*Job, error) {
+	return s.CreateLoadBalancerRuleAsyncWithContext(context.Background(), p)
+}
+
+// CreateLoadBalancerRuleAsyncWithContext is the same as CreateLoadBalancerRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) CreateLoadBalancerRuleAsyncWithContext(ctx context.Context, p *CreateLoadBalancerRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createLoadBalancerRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type CreateLoadBalancerRuleResponse struct {
+	Account     string `json:"account"`
+	Algorithm   string `json:"algorithm"`
+	Cidrlist    string `json:"cidrlist"`
+	Description string `json:"description"`
+	Domain      string `json:"domain"`
+	Domainid    string `json:"domainid"`
+	Fordisplay  bool   `json:"fordisplay"`
+	Id          string `json:"id"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Name        string `json:"name"`
+	Networkid   string `json:"networkid"`
+	Privateport string `json:"privateport"`
+	Project     string `json:"project"`
+	Projectid   string `json:"projectid"`
+	Protocol    string `json:"protocol"`
+	Publicip    string `json:"publicip"`
+	Publicipid  string `json:"publicipid"`
+	Publicport  string `json:"publicport"`
+	State       string `json:"state"`
+	Tags        []Tags `json:"tags"`
+	Zoneid      string `json:"zoneid"`
+	Zonename    string `json:"zonename"`
+}
+
+type DeleteExternalLoadBalancerParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteExternalLoadBalancerParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteExternalLoadBalancerParams) Validate() error {
+	v := newParamValidator("deleteExternalLoadBalancer", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteExternalLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteExternalLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteExternalLoadBalancer", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteExternalLoadBalancer: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteExternalLoadBalancerParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteExternalLoadBalancerParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteExternalLoadBalancerParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteExternalLoadBalancerParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewDeleteExternalLoadBalancerParams(id string) *DeleteExternalLoadBalancerParams {
+	p := &DeleteExternalLoadBalancerParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes a F5 external load balancer appliance added in a zone.
+func (s *LoadBalancerService) DeleteExternalLoadBalancer(p *DeleteExternalLoadBalancerParams) (*DeleteExternalLoadBalancerResponse, error) {
+	return s.DeleteExternalLoadBalancerWithContext(context.Background(), p)
+}
+
+// DeleteExternalLoadBalancerWithContext is the same as DeleteExternalLoadBalancer, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) DeleteExternalLoadBalancerWithContext(ctx context.Context, p *DeleteExternalLoadBalancerParams) (*DeleteExternalLoadBalancerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteExternalLoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteExternalLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteExternalLoadBalancerResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteExternalLoadBalancerResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteExternalLoadBalancerResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type DeleteF5LoadBalancerParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteF5LoadBalancerParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["lbdeviceid"]; found {
+		u.Set("lbdeviceid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteF5LoadBalancerParams) Validate() error {
+	v := newParamValidator("deleteF5LoadBalancer", p.p)
+	v.required("lbdeviceid")
+	v.ids("lbdeviceid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteF5LoadBalancerParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteF5LoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "lbdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteF5LoadBalancer", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteF5LoadBalancer: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteF5LoadBalancerParams) SetLbdeviceid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["lbdeviceid"] = v
+}
+
+func (p *DeleteF5LoadBalancerParams) ResetLbdeviceid() {
+	delete(p.p, "lbdeviceid")
+}
+
+func (p *DeleteF5LoadBalancerParams) GetLbdeviceid() (string, bool) {
+	value, ok := p.p["lbdeviceid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteF5LoadBalancerParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewDeleteF5LoadBalancerParams(lbdeviceid string) *DeleteF5LoadBalancerParams {
+	p := &DeleteF5LoadBalancerParams{}
+	p.p = make(map[string]interface{})
+	p.p["lbdeviceid"] = lbdeviceid
+	return p
+}
+
+// delete a F5 load balancer device
+func (s *LoadBalancerService) DeleteF5LoadBalancer(p *DeleteF5LoadBalancerParams) (*DeleteF5LoadBalancerResponse, error) {
+	return s.DeleteF5LoadBalancerWithContext(context.Background(), p)
+}
+
+// DeleteF5LoadBalancerWithContext is the same as DeleteF5LoadBalancer, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) DeleteF5LoadBalancerWithContext(ctx context.Context, p *DeleteF5LoadBalancerParams) (*DeleteF5LoadBalancerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteF5LoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteF5LoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteF5LoadBalancerAsync starts the async job for DeleteF5LoadBalancer and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteF5LoadBalancerResponse using Job.Result
+func (s *LoadBalancerService) DeleteF5LoadBalancerAsync(p *DeleteF5LoadBalancerParams) (*Job, error) {
+	return s.DeleteF5LoadBalancerAsyncWithContext(context.Background(), p)
+}
+
+// DeleteF5LoadBalancerAsyncWithContext is the same as DeleteF5LoadBalancerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) DeleteF5LoadBalancerAsyncWithContext(ctx context.Context, p *DeleteF5LoadBalancerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteF5LoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteF5LoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteF5LoadBalancerResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteGlobalLoadBalancerRuleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteGlobalLoadBalancerRuleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteGlobalLoadBalancerRuleParams) Validate() error {
+	v := newParamValidator("deleteGlobalLoadBalancerRule", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteGlobalLoadBalancerRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteGlobalLoadBalancerRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteGlobalLoadBalancerRuleParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteGlobalLoadBalancerRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteGlobalLoadBalancerRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteGlobalLoadBalancerRuleParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewDeleteGlobalLoadBalancerRuleParams(id string) *DeleteGlobalLoadBalancerRuleParams {
+	p := &DeleteGlobalLoadBalancerRuleParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes a global load balancer rule.
+func (s *LoadBalancerService) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error) {
+	return s.DeleteGlobalLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// DeleteGlobalLoadBalancerRuleWithContext is the same as DeleteGlobalLoadBalancerRule, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) DeleteGlobalLoadBalancerRuleWithContext(ctx context.Context, p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteGlobalLoadBalancerRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteGlobalLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteGlobalLoadBalancerRuleAsync starts the async job for DeleteGlobalLoadBalancerRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteGlobalLoadBalancerRuleResponse using Job.Result
+func (s *LoadBalancerService) DeleteGlobalLoadBalancerRuleAsync(p *DeleteGlobalLoadBalancerRuleParams) (*Job, error) {
+	return s.DeleteGlobalLoadBalancerRuleAsyncWithContext(context.Background(), p)
+}
+
+// DeleteGlobalLoadBalancerRuleAsyncWithContext is the same as DeleteGlobalLoadBalancerRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) DeleteGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *DeleteGlobalLoadBalancerRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteGlobalLoadBalancerRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteGlobalLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteGlobalLoadBalancerRuleResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteLBHealthCheckPolicyParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteLBHealthCheckPolicyParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteLBHealthCheckPolicyParams) Validate() error {
+	v := newParamValidator("deleteLBHealthCheckPolicy", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteLBHealthCheckPolicyParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteLBHealthCheckPolicyParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteLBHealthCheckPolicy", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteLBHealthCheckPolicy: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteLBHealthCheckPolicyParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteLBHealthCheckPolicyParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteLBHealthCheckPolicyParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteLBHealthCheckPolicyParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewDeleteLBHealthCheckPolicyParams(id string) *DeleteLBHealthCheckPolicyParams {
+	p := &DeleteLBHealthCheckPolicyParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes a load balancer health check policy.
+func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
+	return s.DeleteLBHealthCheckPolicyWithContext(context.Background(), p)
+}
+
+// DeleteLBHealthCheckPolicyWithContext is the same as DeleteLBHealthCheckPolicy, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) DeleteLBHealthCheckPolicyWithContext(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteLBHealthCheckPolicy", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteLBHealthCheckPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteLBHealthCheckPolicyAsync starts the async job for DeleteLBHealthCheckPolicy and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteLBHealthCheckPolicyResponse using Job.Result
+func (s *LoadBalancerService) DeleteLBHealthCheckPolicyAsync(p *DeleteLBHealthCheckPolicyParams) (*Job, error) {
+	return s.DeleteLBHealthCheckPolicyAsyncWithContext(context.Background(), p)
+}
+
+// DeleteLBHealthCheckPolicyAsyncWithContext is the same as DeleteLBHealthCheckPolicyAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) DeleteLBHealthCheckPolicyAsyncWithContext(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteLBHealthCheckPolicy", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteLBHealthCheckPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteLBHealthCheckPolicyResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteLBStickinessPolicyParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteLBStickinessPolicyParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteLBStickinessPolicyParams) Validate() error {
+	v := newParamValidator("deleteLBStickinessPolicy", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteLBStickinessPolicyParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteLBStickinessPolicyParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteLBStickinessPolicy", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteLBStickinessPolicy: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteLBStickinessPolicyParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteLBStickinessPolicyParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteLBStickinessPolicyParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteLBStickinessPolicyParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewDeleteLBStickinessPolicyParams(id string) *DeleteLBStickinessPolicyParams {
+	p := &DeleteLBStickinessPolicyParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes a load balancer stickiness policy.
+func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
+	return s.DeleteLBStickinessPolicyWithContext(context.Background(), p)
+}
+
+// DeleteLBStickinessPolicyWithContext is the same as DeleteLBStickinessPolicy, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) DeleteLBStickinessPolicyWithContext(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteLBStickinessPolicy", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteLBStickinessPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteLBStickinessPolicyAsync starts the async job for DeleteLBStickinessPolicy and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteLBStickinessPolicyResponse using Job.Result
+func (s *LoadBalancerService) DeleteLBStickinessPolicyAsync(p *DeleteLBStickinessPolicyParams) (*Job, error) {
+	return s.DeleteLBStickinessPolicyAsyncWithContext(context.Background(), p)
+}
+
+// DeleteLBStickinessPolicyAsyncWithContext is the same as DeleteLBStickinessPolicyAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) DeleteLBStickinessPolicyAsyncWithContext(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteLBStickinessPolicy", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteLBStickinessPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteLBStickinessPolicyResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteLoadBalancerParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteLoadBalancerParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteLoadBalancerParams) Validate() error {
+	v := newParamValidator("deleteLoadBalancer", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteLoadBalancer", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteLoadBalancer: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteLoadBalancerParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteLoadBalancerParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteLoadBalancerParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteLoadBalancerParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewDeleteLoadBalancerParams(id string) *DeleteLoadBalancerParams {
+	p := &DeleteLoadBalancerParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes a load balancer
+func (s *LoadBalancerService) DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error) {
+	return s.DeleteLoadBalancerWithContext(context.Background(), p)
+}
+
+// DeleteLoadBalancerWithContext is the same as DeleteLoadBalancer, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) DeleteLoadBalancerWithContext(ctx context.Context, p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteLoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteLoadBalancerAsync starts the async job for DeleteLoadBalancer and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteLoadBalancerResponse using Job.Result
+func (s *LoadBalancerService) DeleteLoadBalancerAsync(p *DeleteLoadBalancerParams) (*Job, error) {
+	return s.DeleteLoadBalancerAsyncWithContext(context.Background(), p)
+}
+
+// DeleteLoadBalancerAsyncWithContext is the same as DeleteLoadBalancerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) DeleteLoadBalancerAsyncWithContext(ctx context.Context, p *DeleteLoadBalancerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteLoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteLoadBalancerResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteLoadBalancerRuleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteLoadBalancerRuleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteLoadBalancerRuleParams) Validate() error {
+	v := newParamValidator("deleteLoadBalancerRule", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteLoadBalancerRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteLoadBalancerRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteLoadBalancerRuleParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteLoadBalancerRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteLoadBalancerRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteLoadBalancerRuleParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewDeleteLoadBalancerRuleParams(id string) *DeleteLoadBalancerRuleParams {
+	p := &DeleteLoadBalancerRuleParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes a load balancer rule.
+func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
+	return s.DeleteLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// DeleteLoadBalancerRuleWithContext is the same as DeleteLoadBalancerRule, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) DeleteLoadBalancerRuleWithContext(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteLoadBalancerRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteLoadBalancerRuleAsync starts the async job for DeleteLoadBalancerRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteLoadBalancerRuleResponse using Job.Result
+func (s *LoadBalancerService) DeleteLoadBalancerRuleAsync(p *DeleteLoadBalancerRuleParams) (*Job, error) {
+	return s.DeleteLoadBalancerRuleAsyncWithContext(context.Background(), p)
+}
+
+// DeleteLoadBalancerRuleAsyncWithContext is the same as DeleteLoadBalancerRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) DeleteLoadBalancerRuleAsyncWithContext(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteLoadBalancerRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteLoadBalancerRuleResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteNetscalerLoadBalancerParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteNetscalerLoadBalancerParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["lbdeviceid"]; found {
+		u.Set("lbdeviceid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteNetscalerLoadBalancerParams) Validate() error {
+	v := newParamValidator("deleteNetscalerLoadBalancer", p.p)
+	v.required("lbdeviceid")
+	v.ids("lbdeviceid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteNetscalerLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteNetscalerLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "lbdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteNetscalerLoadBalancer", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteNetscalerLoadBalancer: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["lbdeviceid"] = v
+}
+
+func (p *DeleteNetscalerLoadBalancerParams) ResetLbdeviceid() {
+	delete(p.p, "lbdeviceid")
+}
+
+func (p *DeleteNetscalerLoadBalancerParams) GetLbdeviceid() (string, bool) {
+	value, ok := p.p["lbdeviceid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteNetscalerLoadBalancerParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewDeleteNetscalerLoadBalancerParams(lbdeviceid string) *DeleteNetscalerLoadBalancerParams {
+	p := &DeleteNetscalerLoadBalancerParams{}
+	p.p = make(map[string]interface{})
+	p.p["lbdeviceid"] = lbdeviceid
+	return p
+}
+
+// delete a netscaler load balancer device
+func (s *LoadBalancerService) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error) {
+	return s.DeleteNetscalerLoadBalancerWithContext(context.Background(), p)
+}
+
+// DeleteNetscalerLoadBalancerWithContext is the same as DeleteNetscalerLoadBalancer, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) DeleteNetscalerLoadBalancerWithContext(ctx context.Context, p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetscalerLoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteNetscalerLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteNetscalerLoadBalancerAsync starts the async job for DeleteNetscalerLoadBalancer and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteNetscalerLoadBalancerResponse using Job.Result
+func (s *LoadBalancerService) DeleteNetscalerLoadBalancerAsync(p *DeleteNetscalerLoadBalancerParams) (*Job, error) {
+	return s.DeleteNetscalerLoadBalancerAsyncWithContext(context.Background(), p)
+}
+
+// DeleteNetscalerLoadBalancerAsyncWithContext is the same as DeleteNetscalerLoadBalancerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) DeleteNetscalerLoadBalancerAsyncWithContext(ctx context.Context, p *DeleteNetscalerLoadBalancerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetscalerLoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteNetscalerLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteNetscalerLoadBalancerResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteSslCertParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteSslCertParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteSslCertParams) Validate() error {
+	v := newParamValidator("deleteSslCert", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteSslCertParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteSslCertParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteSslCert", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteSslCert: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteSslCertParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteSslCertParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteSslCertParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteSslCertParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewDeleteSslCertParams(id string) *DeleteSslCertParams {
+	p := &DeleteSslCertParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Delete a certificate to CloudStack
+func (s *LoadBalancerService) DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
+	return s.DeleteSslCertWithContext(context.Background(), p)
+}
+
+// DeleteSslCertWithContext is the same as DeleteSslCert, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) DeleteSslCertWithContext(ctx context.Context, p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSslCert", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteSslCertResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteSslCertResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteSslCertResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteSslCertResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type ListExternalLoadBalancersParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListExternalLoadBalancersParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListExternalLoadBalancersParams) Validate() error {
+	v := newParamValidator("listExternalLoadBalancers", p.p)
+	v.ids("zoneid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListExternalLoadBalancersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListExternalLoadBalancersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listExternalLoadBalancers", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listExternalLoadBalancers: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListExternalLoadBalancersParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListExternalLoadBalancersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListExternalLoadBalancersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListExternalLoadBalancersParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListExternalLoadBalancersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListExternalLoadBalancersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListExternalLoadBalancersParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListExternalLoadBalancersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListExternalLoadBalancersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListExternalLoadBalancersParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ListExternalLoadBalancersParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListExternalLoadBalancersParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListExternalLoadBalancersParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewListExternalLoadBalancersParams() *ListExternalLoadBalancersParams {
+	p := &ListExternalLoadBalancersParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *LoadBalancerService) GetExternalLoadBalancerID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetExternalLoadBalancerIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetExternalLoadBalancerIDWithContext is the same as GetExternalLoadBalancerID, but uses ctx to cancel the request
+func (s *LoadBalancerService) GetExternalLoadBalancerIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	p := &ListExternalLoadBalancersParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["keyword"] = keyword
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListExternalLoadBalancersWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.ExternalLoadBalancers[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.ExternalLoadBalancers {
+			if v.Name == keyword {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// Lists F5 external load balancer appliances added in a zone.
+func (s *LoadBalancerService) ListExternalLoadBalancers(p *ListExternalLoadBalancersParams) (*ListExternalLoadBalancersResponse, error) {
+	return s.ListExternalLoadBalancersWithContext(context.Background(), p)
+}
+
+// ListExternalLoadBalancersWithContext is the same as ListExternalLoadBalancers, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) ListExternalLoadBalancersWithContext(ctx context.Context, p *ListExternalLoadBalancersParams) (*ListExternalLoadBalancersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listExternalLoadBalancers", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListExternalLoadBalancersResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListExternalLoadBalancersPager iterates over all pages of a ListExternalLoadBalancers call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListExternalLoadBalancersPager struct {
+	pager
+
+	s *LoadBalancerService
+	p *ListExternalLoadBalancersParams
+	r *ListExternalLoadBalancersResponse
+}
+
+// NewListExternalLoadBalancersPager returns a pager for all pages of a ListExternalLoadBalancers call with the given params
+func (s *LoadBalancerService) NewListExternalLoadBalancersPager(p *ListExternalLoadBalancersParams) *ListExternalLoadBalancersPager {
+	return &ListExternalLoadBalancersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListExternalLoadBalancersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListExternalLoadBalancersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListExternalLoadBalancersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListExternalLoadBalancersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.ExternalLoadBalancers), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListExternalLoadBalancersPager) Page() *ListExternalLoadBalancersResponse {
+	return pg.r
+}
+
+// ListExternalLoadBalancersAll fetches all pages of a ListExternalLoadBalancers call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *LoadBalancerService) ListExternalLoadBalancersAll(p *ListExternalLoadBalancersParams) (*ListExternalLoadBalancersResponse, error) {
+	return s.ListExternalLoadBalancersAllWithContext(context.Background(), p)
+}
+
+// ListExternalLoadBalancersAllWithContext is the same as ListExternalLoadBalancersAll, but uses ctx to cancel the requests
+func (s *LoadBalancerService) ListExternalLoadBalancersAllWithContext(ctx context.Context, p *ListExternalLoadBalancersParams) (*ListExternalLoadBalancersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*ExternalLoadBalancer)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListExternalLoadBalancersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListExternalLoadBalancersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.ExternalLoadBalancers
+		mu.Unlock()
+
+		return len(l.ExternalLoadBalancers), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListExternalLoadBalancersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.ExternalLoadBalancers = append(r.ExternalLoadBalancers, pages[page]...)
+	}
+	r.Count = len(r.ExternalLoadBalancers)
+
+	return r, nil
+}
+
+type ListExternalLoadBalancersResponse struct {
+	Count                 int                     `json:"count"`
+	ExternalLoadBalancers []*ExternalLoadBalancer `json:"externalloadbalancer"`
+}
+
+type ExternalLoadBalancer struct {
+	Annotation                       string                         `json:"annotation"`
+	Capabilities                     string                         `json:"capabilities"`
+	Clusterid                        string                         `json:"clusterid"`
+	Clustername                      string                         `json:"clustername"`
+	Clustertype                      string                         `json:"clustertype"`
+	Cpuallocated                     string                         `json:"cpuallocated"`
+	Cpuallocatedpercentage           string                         `json:"cpuallocatedpercentage"`
+	Cpuallocatedvalue                int64                          `json:"cpuallocatedvalue"`
+	Cpuallocatedwithoverprovisioning string                         `json:"cpuallocatedwithoverprovisioning"`
+	Cpuloadaverage                   string                         `json:"cpuloadaverage"`
+	Cpunumber                        int                            `json:"cpunumber"`
+	Cpusockets                       int                            `json:"cpusockets"`
+	Cpuspeed                         int64                          `json:"cpuspeed"`
+	Cpuused                          string                         `json:"cpuused"`
+	Cpuwithoverprovisioning          string                         `json:"cpuwithoverprovisioning"`
+	Created                          string                         `json:"created"`
+	Details                          map[string]string              `json:"details"`
+	Disconnected                     string                         `json:"disconnected"`
+	Disksizeallocated                int64                          `json:"disksizeallocated"`
+	Disksizetotal                    int64                          `json:"disksizetotal"`
+	Events                           string                         `json:"events"`
+	Gpugroup                         []ExternalLoadBalancerGpugroup `json:"gpugroup"`
+	Hahost                           bool                           `json:"hahost"`
+	Hasenoughcapacity                bool                           `json:"hasenoughcapacity"`
+	Hostha                           string                         `json:"hostha"`
+	Hosttags                         string                         `json:"hosttags"`
+	Hypervisor                       string                         `json:"hypervisor"`
+	Hypervisorversion                string                         `json:"hypervisorversion"`
+	Id                               string                         `json:"id"`
+	Ipaddress                        string                         `json:"ipaddress"`
+	Islocalstorageactive             bool                           `json:"islocalstorageactive"`
+	JobID                            string                         `json:"jobid"`
+	Jobstatus                        int                            `json:"jobstatus"`
+	Lastannotated                    string                         `json:"lastannotated"`
+	Lastpinged                       string                         `json:"lastpinged"`
+	Managementserverid               string                         `json:"managementserverid"`
+	Memoryallocated                  int64                          `json:"memoryallocated"`
+	Memoryallocatedbytes             int64                          `json:"memoryallocatedbytes"`
+	Memoryallocatedpercentage        string                         `json:"memoryallocatedpercentage"`
+	Memorytotal                      int64                          `json:"memorytotal"`
+	Memoryused                       int64                          `json:"memoryused"`
+	Memorywithoverprovisioning       string                         `json:"memorywithoverprovisioning"`
+	Name                             string                         `json:"name"`
+	Networkkbsread                   int64                          `json:"networkkbsread"`
+	Networkkbswrite                  int64                          `json:"networkkbswrite"`
+	Oscategoryid                     string                         `json:"oscategoryid"`
+	Oscategoryname                   string                         `json:"oscategoryname"`
+	Outofbandmanagement              OutOfBandManagementResponse    `json:"outofbandmanagement"`
+	Podid                            string                         `json:"podid"`
+	Podname                          string                         `json:"podname"`
+	Removed                          string                         `json:"removed"`
+	Resourcestate                    string                         `json:"resourcestate"`
+	State                            string                         `json:"state"`
+	Suitableformigration             bool                           `json:"suitableformigration"`
+	Type                             string                         `json:"type"`
+	Ueficapability                   bool                           `json:"ueficapability"`
+	Username                         string                         `json:"username"`
+	Version                          string                         `json:"version"`
+	Zoneid                           string                         `json:"zoneid"`
+	Zonename                         string                         `json:"zonename"`
+}
+
+type ExternalLoadBalancerGpugroup struct {
+	Gpugroupname string                             `json:"gpugroupname"`
+	Vgpu         []ExternalLoadBalancerGpugroupVgpu `json:"vgpu"`
+}
+
+type ExternalLoadBalancerGpugroupVgpu struct {
+	Maxcapacity       int64  `json:"maxcapacity"`
+	Maxheads          int64  `json:"maxheads"`
+	Maxresolutionx    int64  `json:"maxresolutionx"`
+	Maxresolutiony    int64  `json:"maxresolutiony"`
+	Maxvgpuperpgpu    int64  `json:"maxvgpuperpgpu"`
+	Remainingcapacity int64  `json:"remainingcapacity"`
+	Vgputype          string `json:"vgputype"`
+	Videoram          int64  `json:"videoram"`
+}
+
+type ListF5LoadBalancerNetworksParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListF5LoadBalancerNetworksParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["lbdeviceid"]; found {
+		u.Set("lbdeviceid", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListF5LoadBalancerNetworksParams) Validate() error {
+	v := newParamValidator("listF5LoadBalancerNetworks", p.p)
+	v.required("lbdeviceid")
+	v.ids("lbdeviceid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListF5LoadBalancerNetworksParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListF5LoadBalancerNetworksParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "lbdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listF5LoadBalancerNetworks", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listF5LoadBalancerNetworks: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListF5LoadBalancerNetworksParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListF5LoadBalancerNetworksParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListF5LoadBalancerNetworksParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListF5LoadBalancerNetworksParams) SetLbdeviceid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["lbdeviceid"] = v
+}
+
+func (p *ListF5LoadBalancerNetworksParams) ResetLbdeviceid() {
+	delete(p.p, "lbdeviceid")
+}
+
+func (p *ListF5LoadBalancerNetworksParams) GetLbdeviceid() (string, bool) {
+	value, ok := p.p["lbdeviceid"].(string)
+	return value, ok
+}
+
+func (p *ListF5LoadBalancerNetworksParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListF5LoadBalancerNetworksParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListF5LoadBalancerNetworksParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListF5LoadBalancerNetworksParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListF5LoadBalancerNetworksParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListF5LoadBalancerNetworksParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+// You should always use this function to get a new ListF5LoadBalancerNetworksParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewListF5LoadBalancerNetworksParams(lbdeviceid string) *ListF5LoadBalancerNetworksParams {
+	p := &ListF5LoadBalancerNetworksParams{}
+	p.p = make(map[string]interface{})
+	p.p["lbdeviceid"] = lbdeviceid
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *LoadBalancerService) GetF5LoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetF5LoadBalancerNetworkIDWithContext(context.Background(), keyword, lbdeviceid, opts...)
+}
+
+// GetF5LoadBalancerNetworkIDWithContext is the same as GetF5LoadBalancerNetworkID, but uses ctx to cancel the request
+func (s *LoadBalancerService) GetF5LoadBalancerNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
+	p := &ListF5LoadBalancerNetworksParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["keyword"] = keyword
+	p.p["lbdeviceid"] = lbdeviceid
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListF5LoadBalancerNetworksWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.F5LoadBalancerNetworks[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.F5LoadBalancerNetworks {
+			if v.Name == keyword {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// lists network that are using a F5 load balancer device
+func (s *LoadBalancerService) ListF5LoadBalancerNetworks(p *ListF5LoadBalancerNetworksParams) (*ListF5LoadBalancerNetworksResponse, error) {
+	return s.ListF5LoadBalancerNetworksWithContext(context.Background(), p)
+}
+
+// ListF5LoadBalancerNetworksWithContext is the same as ListF5LoadBalancerNetworks, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) ListF5LoadBalancerNetworksWithContext(ctx context.Context, p *ListF5LoadBalancerNetworksParams) (*ListF5LoadBalancerNetworksResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listF5LoadBalancerNetworks", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListF5LoadBalancerNetworksResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListF5LoadBalancerNetworksPager iterates over all pages of a ListF5LoadBalancerNetworks call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListF5LoadBalancerNetworksPager struct {
+	pager
+
+	s *LoadBalancerService
+	p *ListF5LoadBalancerNetworksParams
+	r *ListF5LoadBalancerNetworksResponse
+}
+
+// NewListF5LoadBalancerNetworksPager returns a pager for all pages of a ListF5LoadBalancerNetworks call with the given params
+func (s *LoadBalancerService) NewListF5LoadBalancerNetworksPager(p *ListF5LoadBalancerNetworksParams) *ListF5LoadBalancerNetworksPager {
+	return &ListF5LoadBalancerNetworksPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListF5LoadBalancerNetworksPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListF5LoadBalancerNetworksPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListF5LoadBalancerNetworksParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListF5LoadBalancerNetworksWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.F5LoadBalancerNetworks), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListF5LoadBalancerNetworksPager) Page() *ListF5LoadBalancerNetworksResponse {
+	return pg.r
+}
+
+// ListF5LoadBalancerNetworksAll fetches all pages of a ListF5LoadBalancerNetworks call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *LoadBalancerService) ListF5LoadBalancerNetworksAll(p *ListF5LoadBalancerNetworksParams) (*ListF5LoadBalancerNetworksResponse, error) {
+	return s.ListF5LoadBalancerNetworksAllWithContext(context.Background(), p)
+}
+
+// ListF5LoadBalancerNetworksAllWithContext is the same as ListF5LoadBalancerNetworksAll, but uses ctx to cancel the requests
+func (s *LoadBalancerService) ListF5LoadBalancerNetworksAllWithContext(ctx context.Context, p *ListF5LoadBalancerNetworksParams) (*ListF5LoadBalancerNetworksResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*F5LoadBalancerNetwork)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListF5LoadBalancerNetworksParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListF5LoadBalancerNetworksWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.F5LoadBalancerNetworks
+		mu.Unlock()
+
+		return len(l.F5LoadBalancerNetworks), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListF5LoadBalancerNetworksResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.F5LoadBalancerNetworks = append(r.F5LoadBalancerNetworks, pages[page]...)
+	}
+	r.Count = len(r.F5LoadBalancerNetworks)
+
+	return r, nil
+}
+
+type ListF5LoadBalancerNetworksResponse struct {
+	Count                  int                      `json:"count"`
+	F5LoadBalancerNetworks []*F5LoadBalancerNetwork `json:"f5loadbalancernetwork"`
+}
+
+type F5LoadBalancerNetwork struct {
+	Account                     string                         `json:"account"`
+	Aclid                       string                         `json:"aclid"`
+	Aclname                     string                         `json:"aclname"`
+	Acltype                     string                         `json:"acltype"`
+	Broadcastdomaintype         string                         `json:"broadcastdomaintype"`
+	Broadcasturi                string                         `json:"broadcasturi"`
+	Canusefordeploy             bool                           `json:"canusefordeploy"`
+	Cidr                        string                         `json:"cidr"`
+	Details                     map[string]string              `json:"details"`
+	Displaynetwork              bool                           `json:"displaynetwork"`
+	Displaytext                 string                         `json:"displaytext"`
+	Dns1                        string                         `json:"dns1"`
+	Dns2                        string                         `json:"dns2"`
+	Domain                      string                         `json:"domain"`
+	Domainid                    string                         `json:"domainid"`
+	Externalid                  string                         `json:"externalid"`
+	Gateway                     string                         `json:"gateway"`
+	Id                          string                         `json:"id"`
+	Ip6cidr                     string                         `json:"ip6cidr"`
+	Ip6gateway                  string                         `json:"ip6gateway"`
+	Isdefault                   bool                           `json:"isdefault"`
+	Ispersistent                bool                           `json:"ispersistent"`
+	Issystem                    bool                           `json:"issystem"`
+	JobID                       string                         `json:"jobid"`
+	Jobstatus                   int                            `json:"jobstatus"`
+	Name                        string                         `json:"name"`
+	Netmask                     string                         `json:"netmask"`
+	Networkcidr                 string                         `json:"networkcidr"`
+	Networkdomain               string                         `json:"networkdomain"`
+	Networkofferingavailability string                         `json:"networkofferingavailability"`
+	Networkofferingconservemode bool                           `json:"networkofferingconservemode"`
+	Networkofferingdisplaytext  string                         `json:"networkofferingdisplaytext"`
+	Networkofferingid           string                         `json:"networkofferingid"`
+	Networkofferingname         string                         `json:"networkofferingname"`
+	Physicalnetworkid           string                         `json:"physicalnetworkid"`
+	Project                     string                         `json:"project"`
+	Projectid                   string                         `json:"projectid"`
+	Redundantrouter             bool                           `json:"redundantrouter"`
+	Related                     string                         `json:"related"`
+	Reservediprange             string                         `json:"reservediprange"`
+	Restartrequired             bool                           `json:"restartrequired"`
+	Service                     []F5LoadBalancerNetworkService `json:"service"`
+	Specifyipranges             bool                           `json:"specifyipranges"`
+	State                       string                         `json:"state"`
+	Strechedl2subnet            bool                           `json:"strechedl2subnet"`
+	Subdomainaccess             bool                           `json:"subdomainaccess"`
+	Tags                        []Tags                         `json:"tags"`
+	Traffictype                 string                         `json:"traffictype"`
+	Type                        string                         `json:"type"`
+	Vlan                        string                         `json:"vlan"`
+	Vpcid                       string                         `json:"vpcid"`
+	Vpcname                     string                         `json:"vpcname"`
+	Zoneid                      string                         `json:"zoneid"`
+	Zonename                    string                         `json:"zonename"`
+	Zonesnetworkspans           []interface{}                  `json:"zonesnetworkspans"`
+}
+
+type F5LoadBalancerNetworkService struct {
+	Capability []F5LoadBalancerNetworkServiceCapability `json:"capability"`
+	Name       string                                   `json:"name"`
+	Provider   []F5LoadBalancerNetworkServiceProvider   `json:"provider"`
+}
+
+type F5LoadBalancerNetworkServiceProvider struct {
+	Canenableindividualservice   bool     `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
+	Id                           string   `json:"id"`
+	Name                         string   `json:"name"`
+	Physicalnetworkid            string   `json:"physicalnetworkid"`
+	Servicelist                  []string `json:"servicelist"`
+	State                        string   `json:"state"`
+}
+
+type F5LoadBalancerNetworkServiceCapability struct {
+	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
+	Name                       string `json:"name"`
+	Value                      string `json:"value"`
+}
+
+type ListF5LoadBalancersParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListF5LoadBalancersParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["lbdeviceid"]; found {
+		u.Set("lbdeviceid", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["physicalnetworkid"]; found {
+		u.Set("physicalnetworkid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListF5LoadBalancersParams) Validate() error {
+	v := newParamValidator("listF5LoadBalancers", p.p)
+	v.ids("lbdeviceid", "physicalnetworkid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListF5LoadBalancersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListF5LoadBalancersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "lbdeviceid", "physicalnetworkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listF5LoadBalancers", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listF5LoadBalancers: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListF5LoadBalancersParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListF5LoadBalancersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListF5LoadBalancersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListF5LoadBalancersParams) SetLbdeviceid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["lbdeviceid"] = v
+}
+
+func (p *ListF5LoadBalancersParams) ResetLbdeviceid() {
+	delete(p.p, "lbdeviceid")
+}
+
+func (p *ListF5LoadBalancersParams) GetLbdeviceid() (string, bool) {
+	value, ok := p.p["lbdeviceid"].(string)
+	return value, ok
+}
+
+func (p *ListF5LoadBalancersParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListF5LoadBalancersParams) ResetPage() {
+	delete(p.p, "page")
 }
 
-type DeleteNetscalerLoadBalancerParams struct {
-	p map[string]interface{}
+func (p *ListF5LoadBalancersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
 }
 
-func (p *DeleteNetscalerLoadBalancerParams) toURLValues() url.Values {
-	u := url.Values{}
+func (p *ListF5LoadBalancersParams) SetPagesize(v int) {
 	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["lbdeviceid"]; found {
-		u.Set("lbdeviceid", v.(string))
+		p.p = make(map[string]interface{})
 	}
-	return u
+	p.p["pagesize"] = v
 }
 
-func (p *DeleteNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
+func (p *ListF5LoadBalancersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListF5LoadBalancersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListF5LoadBalancersParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["lbdeviceid"] = v
+	p.p["physicalnetworkid"] = v
 }
 
-// You should always use this function to get a new DeleteNetscalerLoadBalancerParams instance,
+func (p *ListF5LoadBalancersParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListF5LoadBalancersParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListF5LoadBalancersParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteNetscalerLoadBalancerParams(lbdeviceid string) *DeleteNetscalerLoadBalancerParams {
-	p := &DeleteNetscalerLoadBalancerParams{}
+func (s *LoadBalancerService) NewListF5LoadBalancersParams() *ListF5LoadBalancersParams {
+	p := &ListF5LoadBalancersParams{}
 	p.p = make(map[string]interface{})
-	p.p["lbdeviceid"] = lbdeviceid
 	return p
 }
 
-//  delete a netscaler load balancer device
-func (s *LoadBalancerService) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetscalerLoadBalancer", p.toURLValues())
-	if err != nil {
+// lists F5 load balancer devices
+func (s *LoadBalancerService) ListF5LoadBalancers(p *ListF5LoadBalancersParams) (*ListF5LoadBalancersResponse, error) {
+	return s.ListF5LoadBalancersWithContext(context.Background(), p)
+}
+
+// ListF5LoadBalancersWithContext is the same as ListF5LoadBalancers, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) ListF5LoadBalancersWithContext(ctx context.Context, p *ListF5LoadBalancersParams) (*ListF5LoadBalancersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
 		return nil, err
 	}
 
-	var r DeleteNetscalerLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequestWithContext(ctx, "listF5LoadBalancers", p.ToURLValues())
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	var r ListF5LoadBalancersResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
 
 	return &r, nil
 }
 
-type DeleteNetscalerLoadBalancerResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+// ListF5LoadBalancersPager iterates over all pages of a ListF5LoadBalancers call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListF5LoadBalancersPager struct {
+	pager
+
+	s *LoadBalancerService
+	p *ListF5LoadBalancersParams
+	r *ListF5LoadBalancersResponse
 }
 
-type DeleteSslCertParams struct {
-	p map[string]interface{}
+// NewListF5LoadBalancersPager returns a pager for all pages of a ListF5LoadBalancers call with the given params
+func (s *LoadBalancerService) NewListF5LoadBalancersPager(p *ListF5LoadBalancersParams) *ListF5LoadBalancersPager {
+	return &ListF5LoadBalancersPager{pager: newPager(p.p), s: s, p: p}
 }
 
-func (p *DeleteSslCertParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListF5LoadBalancersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
 }
 
-func (p *DeleteSslCertParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListF5LoadBalancersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
 	}
-	p.p["id"] = v
-}
 
-// You should always use this function to get a new DeleteSslCertParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteSslCertParams(id string) *DeleteSslCertParams {
-	p := &DeleteSslCertParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
+	p := &ListF5LoadBalancersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
 
-// Delete a certificate to CloudStack
-func (s *LoadBalancerService) DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
-	resp, err := s.cs.newRequest("deleteSslCert", p.toURLValues())
+	r, err := pg.s.ListF5LoadBalancersWithContext(ctx, p)
 	if err != nil {
-		return nil, err
+		return pg.update(0, err)
 	}
+	pg.r = r
 
-	var r DeleteSslCertResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+	return pg.update(len(r.F5LoadBalancers), nil)
+}
 
-	return &r, nil
+// Page returns the page fetched by the last call to Next
+func (pg *ListF5LoadBalancersPager) Page() *ListF5LoadBalancersResponse {
+	return pg.r
 }
 
-type DeleteSslCertResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+// ListF5LoadBalancersAll fetches all pages of a ListF5LoadBalancers call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *LoadBalancerService) ListF5LoadBalancersAll(p *ListF5LoadBalancersParams) (*ListF5LoadBalancersResponse, error) {
+	return s.ListF5LoadBalancersAllWithContext(context.Background(), p)
 }
 
-func (r *DeleteSslCertResponse) UnmarshalJSON(b []byte) error {
-	var m map[string]interface{}
-	err := json.Unmarshal(b, &m)
-	if err != nil {
-		return err
-	}
+// ListF5LoadBalancersAllWithContext is the same as ListF5LoadBalancersAll, but uses ctx to cancel the requests
+func (s *LoadBalancerService) ListF5LoadBalancersAllWithContext(ctx context.Context, p *ListF5LoadBalancersParams) (*ListF5LoadBalancersResponse, error) {
+	pagesize := pageSize(p.p)
 
-	if success, ok := m["success"].(string); ok {
-		m["success"] = success == "true"
-		b, err = json.Marshal(m)
+	var mu sync.Mutex
+	pages := make(map[int][]*F5LoadBalancer)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListF5LoadBalancersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListF5LoadBalancersWithContext(ctx, pp)
 		if err != nil {
-			return err
+			return 0, 0, err
 		}
+
+		mu.Lock()
+		pages[page] = l.F5LoadBalancers
+		mu.Unlock()
+
+		return len(l.F5LoadBalancers), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if ostypeid, ok := m["ostypeid"].(float64); ok {
-		m["ostypeid"] = strconv.Itoa(int(ostypeid))
-		b, err = json.Marshal(m)
-		if err != nil {
-			return err
-		}
+	r := &ListF5LoadBalancersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.F5LoadBalancers = append(r.F5LoadBalancers, pages[page]...)
 	}
+	r.Count = len(r.F5LoadBalancers)
 
-	type alias DeleteSslCertResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return r, nil
+}
+
+type ListF5LoadBalancersResponse struct {
+	Count           int               `json:"count"`
+	F5LoadBalancers []*F5LoadBalancer `json:"f5loadbalancer"`
+}
+
+type F5LoadBalancer struct {
+	Ipaddress         string `json:"ipaddress"`
+	JobID             string `json:"jobid"`
+	Jobstatus         int    `json:"jobstatus"`
+	Lbdevicecapacity  int64  `json:"lbdevicecapacity"`
+	Lbdevicededicated bool   `json:"lbdevicededicated"`
+	Lbdeviceid        string `json:"lbdeviceid"`
+	Lbdevicename      string `json:"lbdevicename"`
+	Lbdevicestate     string `json:"lbdevicestate"`
+	Physicalnetworkid string `json:"physicalnetworkid"`
+	Privateinterface  string `json:"privateinterface"`
+	Provider          string `json:"provider"`
+	Publicinterface   string `json:"publicinterface"`
 }
 
 type ListGlobalLoadBalancerRulesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListGlobalLoadBalancerRulesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListGlobalLoadBalancerRulesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2070,6 +6448,61 @@ func (p *ListGlobalLoadBalancerRulesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListGlobalLoadBalancerRulesParams) Validate() error {
+	v := newParamValidator("listGlobalLoadBalancerRules", p.p)
+	v.ids("domainid", "id", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListGlobalLoadBalancerRulesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListGlobalLoadBalancerRulesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize", "regionid":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listGlobalLoadBalancerRules", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listGlobalLoadBalancerRules: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2077,6 +6510,15 @@ func (p *ListGlobalLoadBalancerRulesParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListGlobalLoadBalancerRulesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListGlobalLoadBalancerRulesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2084,6 +6526,15 @@ func (p *ListGlobalLoadBalancerRulesParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListGlobalLoadBalancerRulesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListGlobalLoadBalancerRulesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2091,6 +6542,15 @@ func (p *ListGlobalLoadBalancerRulesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListGlobalLoadBalancerRulesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListGlobalLoadBalancerRulesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2098,6 +6558,15 @@ func (p *ListGlobalLoadBalancerRulesParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListGlobalLoadBalancerRulesParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListGlobalLoadBalancerRulesParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2105,6 +6574,15 @@ func (p *ListGlobalLoadBalancerRulesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListGlobalLoadBalancerRulesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListGlobalLoadBalancerRulesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2112,6 +6590,15 @@ func (p *ListGlobalLoadBalancerRulesParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListGlobalLoadBalancerRulesParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListGlobalLoadBalancerRulesParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2119,6 +6606,15 @@ func (p *ListGlobalLoadBalancerRulesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListGlobalLoadBalancerRulesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListGlobalLoadBalancerRulesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2126,6 +6622,15 @@ func (p *ListGlobalLoadBalancerRulesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListGlobalLoadBalancerRulesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListGlobalLoadBalancerRulesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2133,6 +6638,15 @@ func (p *ListGlobalLoadBalancerRulesParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListGlobalLoadBalancerRulesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListGlobalLoadBalancerRulesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetRegionid(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2140,6 +6654,15 @@ func (p *ListGlobalLoadBalancerRulesParams) SetRegionid(v int) {
 	p.p["regionid"] = v
 }
 
+func (p *ListGlobalLoadBalancerRulesParams) ResetRegionid() {
+	delete(p.p, "regionid")
+}
+
+func (p *ListGlobalLoadBalancerRulesParams) GetRegionid() (int, bool) {
+	value, ok := p.p["regionid"].(int)
+	return value, ok
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2147,6 +6670,15 @@ func (p *ListGlobalLoadBalancerRulesParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListGlobalLoadBalancerRulesParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListGlobalLoadBalancerRulesParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListGlobalLoadBalancerRulesParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListGlobalLoadBalancerRulesParams() *ListGlobalLoadBalancerRulesParams {
@@ -2157,6 +6689,11 @@ func (s *LoadBalancerService) NewListGlobalLoadBalancerRulesParams() *ListGlobal
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetGlobalLoadBalancerRuleID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetGlobalLoadBalancerRuleIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetGlobalLoadBalancerRuleIDWithContext is the same as GetGlobalLoadBalancerRuleID, but uses ctx to cancel the request
+func (s *LoadBalancerService) GetGlobalLoadBalancerRuleIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListGlobalLoadBalancerRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2168,7 +6705,7 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleID(keyword string, opts .
 		}
 	}
 
-	l, err := s.ListGlobalLoadBalancerRules(p)
+	l, err := s.ListGlobalLoadBalancerRulesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2193,12 +6730,17 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleID(keyword string, opts .
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByName(name string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
-	id, count, err := s.GetGlobalLoadBalancerRuleID(name, opts...)
+	return s.GetGlobalLoadBalancerRuleByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetGlobalLoadBalancerRuleByNameWithContext is the same as GetGlobalLoadBalancerRuleByName, but uses ctx to cancel the requests
+func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
+	id, count, err := s.GetGlobalLoadBalancerRuleIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetGlobalLoadBalancerRuleByID(id, opts...)
+	r, count, err := s.GetGlobalLoadBalancerRuleByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2207,6 +6749,11 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByName(name string, opts
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByID(id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
+	return s.GetGlobalLoadBalancerRuleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetGlobalLoadBalancerRuleByIDWithContext is the same as GetGlobalLoadBalancerRuleByID, but uses ctx to cancel the request
+func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
 	p := &ListGlobalLoadBalancerRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2218,18 +6765,16 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByID(id string, opts ...O
 		}
 	}
 
-	l, err := s.ListGlobalLoadBalancerRules(p)
+	l, err := s.ListGlobalLoadBalancerRulesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -2240,7 +6785,16 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByID(id string, opts ...O
 
 // Lists load balancer rules.
 func (s *LoadBalancerService) ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
-	resp, err := s.cs.newRequest("listGlobalLoadBalancerRules", p.toURLValues())
+	return s.ListGlobalLoadBalancerRulesWithContext(context.Background(), p)
+}
+
+// ListGlobalLoadBalancerRulesWithContext is the same as ListGlobalLoadBalancerRules, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) ListGlobalLoadBalancerRulesWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listGlobalLoadBalancerRules", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2253,6 +6807,92 @@ func (s *LoadBalancerService) ListGlobalLoadBalancerRules(p *ListGlobalLoadBalan
 	return &r, nil
 }
 
+// ListGlobalLoadBalancerRulesPager iterates over all pages of a ListGlobalLoadBalancerRules call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListGlobalLoadBalancerRulesPager struct {
+	pager
+
+	s *LoadBalancerService
+	p *ListGlobalLoadBalancerRulesParams
+	r *ListGlobalLoadBalancerRulesResponse
+}
+
+// NewListGlobalLoadBalancerRulesPager returns a pager for all pages of a ListGlobalLoadBalancerRules call with the given params
+func (s *LoadBalancerService) NewListGlobalLoadBalancerRulesPager(p *ListGlobalLoadBalancerRulesParams) *ListGlobalLoadBalancerRulesPager {
+	return &ListGlobalLoadBalancerRulesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListGlobalLoadBalancerRulesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListGlobalLoadBalancerRulesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListGlobalLoadBalancerRulesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListGlobalLoadBalancerRulesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.GlobalLoadBalancerRules), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListGlobalLoadBalancerRulesPager) Page() *ListGlobalLoadBalancerRulesResponse {
+	return pg.r
+}
+
+// ListGlobalLoadBalancerRulesAll fetches all pages of a ListGlobalLoadBalancerRules call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *LoadBalancerService) ListGlobalLoadBalancerRulesAll(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
+	return s.ListGlobalLoadBalancerRulesAllWithContext(context.Background(), p)
+}
+
+// ListGlobalLoadBalancerRulesAllWithContext is the same as ListGlobalLoadBalancerRulesAll, but uses ctx to cancel the requests
+func (s *LoadBalancerService) ListGlobalLoadBalancerRulesAllWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*GlobalLoadBalancerRule)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListGlobalLoadBalancerRulesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListGlobalLoadBalancerRulesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.GlobalLoadBalancerRules
+		mu.Unlock()
+
+		return len(l.GlobalLoadBalancerRules), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListGlobalLoadBalancerRulesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.GlobalLoadBalancerRules = append(r.GlobalLoadBalancerRules, pages[page]...)
+	}
+	r.Count = len(r.GlobalLoadBalancerRules)
+
+	return r, nil
+}
+
 type ListGlobalLoadBalancerRulesResponse struct {
 	Count                   int                       `json:"count"`
 	GlobalLoadBalancerRules []*GlobalLoadBalancerRule `json:"globalloadbalancerrule"`
@@ -2305,7 +6945,8 @@ type ListLBHealthCheckPoliciesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListLBHealthCheckPoliciesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListLBHealthCheckPoliciesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2334,6 +6975,56 @@ func (p *ListLBHealthCheckPoliciesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListLBHealthCheckPoliciesParams) Validate() error {
+	v := newParamValidator("listLBHealthCheckPolicies", p.p)
+	v.ids("id", "lbruleid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListLBHealthCheckPoliciesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListLBHealthCheckPoliciesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "keyword", "lbruleid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listLBHealthCheckPolicies", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listLBHealthCheckPolicies: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListLBHealthCheckPoliciesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2341,6 +7032,15 @@ func (p *ListLBHealthCheckPoliciesParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListLBHealthCheckPoliciesParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListLBHealthCheckPoliciesParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListLBHealthCheckPoliciesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2348,6 +7048,15 @@ func (p *ListLBHealthCheckPoliciesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListLBHealthCheckPoliciesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListLBHealthCheckPoliciesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListLBHealthCheckPoliciesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2355,6 +7064,15 @@ func (p *ListLBHealthCheckPoliciesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListLBHealthCheckPoliciesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListLBHealthCheckPoliciesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListLBHealthCheckPoliciesParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2362,6 +7080,15 @@ func (p *ListLBHealthCheckPoliciesParams) SetLbruleid(v string) {
 	p.p["lbruleid"] = v
 }
 
+func (p *ListLBHealthCheckPoliciesParams) ResetLbruleid() {
+	delete(p.p, "lbruleid")
+}
+
+func (p *ListLBHealthCheckPoliciesParams) GetLbruleid() (string, bool) {
+	value, ok := p.p["lbruleid"].(string)
+	return value, ok
+}
+
 func (p *ListLBHealthCheckPoliciesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2369,6 +7096,15 @@ func (p *ListLBHealthCheckPoliciesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListLBHealthCheckPoliciesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListLBHealthCheckPoliciesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListLBHealthCheckPoliciesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2376,6 +7112,15 @@ func (p *ListLBHealthCheckPoliciesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListLBHealthCheckPoliciesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListLBHealthCheckPoliciesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListLBHealthCheckPoliciesParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListLBHealthCheckPoliciesParams() *ListLBHealthCheckPoliciesParams {
@@ -2386,6 +7131,11 @@ func (s *LoadBalancerService) NewListLBHealthCheckPoliciesParams() *ListLBHealth
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error) {
+	return s.GetLBHealthCheckPolicyByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetLBHealthCheckPolicyByIDWithContext is the same as GetLBHealthCheckPolicyByID, but uses ctx to cancel the request
+func (s *LoadBalancerService) GetLBHealthCheckPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error) {
 	p := &ListLBHealthCheckPoliciesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2397,18 +7147,16 @@ func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...Opti
 		}
 	}
 
-	l, err := s.ListLBHealthCheckPolicies(p)
+	l, err := s.ListLBHealthCheckPoliciesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -2419,7 +7167,16 @@ func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...Opti
 
 // Lists load balancer health check policies.
 func (s *LoadBalancerService) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listLBHealthCheckPolicies", p.toURLValues())
+	return s.ListLBHealthCheckPoliciesWithContext(context.Background(), p)
+}
+
+// ListLBHealthCheckPoliciesWithContext is the same as ListLBHealthCheckPolicies, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) ListLBHealthCheckPoliciesWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listLBHealthCheckPolicies", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2432,6 +7189,92 @@ func (s *LoadBalancerService) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoli
 	return &r, nil
 }
 
+// ListLBHealthCheckPoliciesPager iterates over all pages of a ListLBHealthCheckPolicies call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListLBHealthCheckPoliciesPager struct {
+	pager
+
+	s *LoadBalancerService
+	p *ListLBHealthCheckPoliciesParams
+	r *ListLBHealthCheckPoliciesResponse
+}
+
+// NewListLBHealthCheckPoliciesPager returns a pager for all pages of a ListLBHealthCheckPolicies call with the given params
+func (s *LoadBalancerService) NewListLBHealthCheckPoliciesPager(p *ListLBHealthCheckPoliciesParams) *ListLBHealthCheckPoliciesPager {
+	return &ListLBHealthCheckPoliciesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListLBHealthCheckPoliciesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListLBHealthCheckPoliciesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListLBHealthCheckPoliciesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListLBHealthCheckPoliciesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.LBHealthCheckPolicies), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListLBHealthCheckPoliciesPager) Page() *ListLBHealthCheckPoliciesResponse {
+	return pg.r
+}
+
+// ListLBHealthCheckPoliciesAll fetches all pages of a ListLBHealthCheckPolicies call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *LoadBalancerService) ListLBHealthCheckPoliciesAll(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
+	return s.ListLBHealthCheckPoliciesAllWithContext(context.Background(), p)
+}
+
+// ListLBHealthCheckPoliciesAllWithContext is the same as ListLBHealthCheckPoliciesAll, but uses ctx to cancel the requests
+func (s *LoadBalancerService) ListLBHealthCheckPoliciesAllWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*LBHealthCheckPolicy)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListLBHealthCheckPoliciesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListLBHealthCheckPoliciesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.LBHealthCheckPolicies
+		mu.Unlock()
+
+		return len(l.LBHealthCheckPolicies), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListLBHealthCheckPoliciesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.LBHealthCheckPolicies = append(r.LBHealthCheckPolicies, pages[page]...)
+	}
+	r.Count = len(r.LBHealthCheckPolicies)
+
+	return r, nil
+}
+
 type ListLBHealthCheckPoliciesResponse struct {
 	Count                 int                    `json:"count"`
 	LBHealthCheckPolicies []*LBHealthCheckPolicy `json:"lbhealthcheckpolicy"`
@@ -2464,7 +7307,8 @@ type ListLBStickinessPoliciesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListLBStickinessPoliciesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListLBStickinessPoliciesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2493,6 +7337,56 @@ func (p *ListLBStickinessPoliciesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListLBStickinessPoliciesParams) Validate() error {
+	v := newParamValidator("listLBStickinessPolicies", p.p)
+	v.ids("id", "lbruleid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListLBStickinessPoliciesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListLBStickinessPoliciesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "keyword", "lbruleid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listLBStickinessPolicies", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listLBStickinessPolicies: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListLBStickinessPoliciesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2500,6 +7394,15 @@ func (p *ListLBStickinessPoliciesParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListLBStickinessPoliciesParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListLBStickinessPoliciesParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListLBStickinessPoliciesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2507,6 +7410,15 @@ func (p *ListLBStickinessPoliciesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListLBStickinessPoliciesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListLBStickinessPoliciesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListLBStickinessPoliciesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2514,6 +7426,15 @@ func (p *ListLBStickinessPoliciesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListLBStickinessPoliciesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListLBStickinessPoliciesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListLBStickinessPoliciesParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2521,6 +7442,15 @@ func (p *ListLBStickinessPoliciesParams) SetLbruleid(v string) {
 	p.p["lbruleid"] = v
 }
 
+func (p *ListLBStickinessPoliciesParams) ResetLbruleid() {
+	delete(p.p, "lbruleid")
+}
+
+func (p *ListLBStickinessPoliciesParams) GetLbruleid() (string, bool) {
+	value, ok := p.p["lbruleid"].(string)
+	return value, ok
+}
+
 func (p *ListLBStickinessPoliciesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2528,6 +7458,15 @@ func (p *ListLBStickinessPoliciesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListLBStickinessPoliciesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListLBStickinessPoliciesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListLBStickinessPoliciesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2535,6 +7474,15 @@ func (p *ListLBStickinessPoliciesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListLBStickinessPoliciesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListLBStickinessPoliciesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListLBStickinessPoliciesParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListLBStickinessPoliciesParams() *ListLBStickinessPoliciesParams {
@@ -2545,6 +7493,11 @@ func (s *LoadBalancerService) NewListLBStickinessPoliciesParams() *ListLBStickin
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error) {
+	return s.GetLBStickinessPolicyByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetLBStickinessPolicyByIDWithContext is the same as GetLBStickinessPolicyByID, but uses ctx to cancel the request
+func (s *LoadBalancerService) GetLBStickinessPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error) {
 	p := &ListLBStickinessPoliciesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2556,18 +7509,16 @@ func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...Optio
 		}
 	}
 
-	l, err := s.ListLBStickinessPolicies(p)
+	l, err := s.ListLBStickinessPoliciesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -2578,7 +7529,16 @@ func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...Optio
 
 // Lists load balancer stickiness policies.
 func (s *LoadBalancerService) ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listLBStickinessPolicies", p.toURLValues())
+	return s.ListLBStickinessPoliciesWithContext(context.Background(), p)
+}
+
+// ListLBStickinessPoliciesWithContext is the same as ListLBStickinessPolicies, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) ListLBStickinessPoliciesWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listLBStickinessPolicies", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2591,6 +7551,92 @@ func (s *LoadBalancerService) ListLBStickinessPolicies(p *ListLBStickinessPolici
 	return &r, nil
 }
 
+// ListLBStickinessPoliciesPager iterates over all pages of a ListLBStickinessPolicies call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListLBStickinessPoliciesPager struct {
+	pager
+
+	s *LoadBalancerService
+	p *ListLBStickinessPoliciesParams
+	r *ListLBStickinessPoliciesResponse
+}
+
+// NewListLBStickinessPoliciesPager returns a pager for all pages of a ListLBStickinessPolicies call with the given params
+func (s *LoadBalancerService) NewListLBStickinessPoliciesPager(p *ListLBStickinessPoliciesParams) *ListLBStickinessPoliciesPager {
+	return &ListLBStickinessPoliciesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListLBStickinessPoliciesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListLBStickinessPoliciesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListLBStickinessPoliciesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListLBStickinessPoliciesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.LBStickinessPolicies), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListLBStickinessPoliciesPager) Page() *ListLBStickinessPoliciesResponse {
+	return pg.r
+}
+
+// ListLBStickinessPoliciesAll fetches all pages of a ListLBStickinessPolicies call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *LoadBalancerService) ListLBStickinessPoliciesAll(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
+	return s.ListLBStickinessPoliciesAllWithContext(context.Background(), p)
+}
+
+// ListLBStickinessPoliciesAllWithContext is the same as ListLBStickinessPoliciesAll, but uses ctx to cancel the requests
+func (s *LoadBalancerService) ListLBStickinessPoliciesAllWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*LBStickinessPolicy)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListLBStickinessPoliciesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListLBStickinessPoliciesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.LBStickinessPolicies
+		mu.Unlock()
+
+		return len(l.LBStickinessPolicies), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListLBStickinessPoliciesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.LBStickinessPolicies = append(r.LBStickinessPolicies, pages[page]...)
+	}
+	r.Count = len(r.LBStickinessPolicies)
+
+	return r, nil
+}
+
 type ListLBStickinessPoliciesResponse struct {
 	Count                int                   `json:"count"`
 	LBStickinessPolicies []*LBStickinessPolicy `json:"lbstickinesspolicy"`
@@ -2624,7 +7670,8 @@ type ListLoadBalancerRuleInstancesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListLoadBalancerRuleInstancesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListLoadBalancerRuleInstancesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2654,6 +7701,57 @@ func (p *ListLoadBalancerRuleInstancesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListLoadBalancerRuleInstancesParams) Validate() error {
+	v := newParamValidator("listLoadBalancerRuleInstances", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListLoadBalancerRuleInstancesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListLoadBalancerRuleInstancesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "applied", "lbvmips":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listLoadBalancerRuleInstances", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listLoadBalancerRuleInstances: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) SetApplied(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2661,6 +7759,15 @@ func (p *ListLoadBalancerRuleInstancesParams) SetApplied(v bool) {
 	p.p["applied"] = v
 }
 
+func (p *ListLoadBalancerRuleInstancesParams) ResetApplied() {
+	delete(p.p, "applied")
+}
+
+func (p *ListLoadBalancerRuleInstancesParams) GetApplied() (bool, bool) {
+	value, ok := p.p["applied"].(bool)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2668,6 +7775,15 @@ func (p *ListLoadBalancerRuleInstancesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListLoadBalancerRuleInstancesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListLoadBalancerRuleInstancesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2675,6 +7791,15 @@ func (p *ListLoadBalancerRuleInstancesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListLoadBalancerRuleInstancesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListLoadBalancerRuleInstancesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) SetLbvmips(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2682,6 +7807,15 @@ func (p *ListLoadBalancerRuleInstancesParams) SetLbvmips(v bool) {
 	p.p["lbvmips"] = v
 }
 
+func (p *ListLoadBalancerRuleInstancesParams) ResetLbvmips() {
+	delete(p.p, "lbvmips")
+}
+
+func (p *ListLoadBalancerRuleInstancesParams) GetLbvmips() (bool, bool) {
+	value, ok := p.p["lbvmips"].(bool)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2689,6 +7823,15 @@ func (p *ListLoadBalancerRuleInstancesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListLoadBalancerRuleInstancesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListLoadBalancerRuleInstancesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2696,6 +7839,15 @@ func (p *ListLoadBalancerRuleInstancesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListLoadBalancerRuleInstancesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListLoadBalancerRuleInstancesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListLoadBalancerRuleInstancesParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListLoadBalancerRuleInstancesParams(id string) *ListLoadBalancerRuleInstancesParams {
@@ -2707,6 +7859,11 @@ func (s *LoadBalancerService) NewListLoadBalancerRuleInstancesParams(id string)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	return s.GetLoadBalancerRuleInstanceByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetLoadBalancerRuleInstanceByIDWithContext is the same as GetLoadBalancerRuleInstanceByID, but uses ctx to cancel the request
+func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
 	p := &ListLoadBalancerRuleInstancesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2718,18 +7875,16 @@ func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ..
 		}
 	}
 
-	l, err := s.ListLoadBalancerRuleInstances(p)
+	l, err := s.ListLoadBalancerRuleInstancesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -2740,7 +7895,16 @@ func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ..
 
 // List all virtual machine instances that are assigned to a load balancer rule.
 func (s *LoadBalancerService) ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancerRuleInstances", p.toURLValues())
+	return s.ListLoadBalancerRuleInstancesWithContext(context.Background(), p)
+}
+
+// ListLoadBalancerRuleInstancesWithContext is the same as ListLoadBalancerRuleInstances, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) ListLoadBalancerRuleInstancesWithContext(ctx context.Context, p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listLoadBalancerRuleInstances", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2770,7 +7934,8 @@ type ListLoadBalancerRulesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListLoadBalancerRulesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListLoadBalancerRulesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2835,6 +8000,62 @@ func (p *ListLoadBalancerRulesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListLoadBalancerRulesParams) Validate() error {
+	v := newParamValidator("listLoadBalancerRules", p.p)
+	v.ids("domainid", "id", "networkid", "projectid", "publicipid", "virtualmachineid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListLoadBalancerRulesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListLoadBalancerRulesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "name", "networkid", "projectid", "publicipid", "virtualmachineid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listLoadBalancerRules", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listLoadBalancerRules: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListLoadBalancerRulesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2842,6 +8063,15 @@ func (p *ListLoadBalancerRulesParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListLoadBalancerRulesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2849,6 +8079,15 @@ func (p *ListLoadBalancerRulesParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListLoadBalancerRulesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2856,6 +8095,15 @@ func (p *ListLoadBalancerRulesParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListLoadBalancerRulesParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2863,6 +8111,15 @@ func (p *ListLoadBalancerRulesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListLoadBalancerRulesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2870,6 +8127,15 @@ func (p *ListLoadBalancerRulesParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListLoadBalancerRulesParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2877,6 +8143,15 @@ func (p *ListLoadBalancerRulesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListLoadBalancerRulesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2884,6 +8159,15 @@ func (p *ListLoadBalancerRulesParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListLoadBalancerRulesParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2891,6 +8175,15 @@ func (p *ListLoadBalancerRulesParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListLoadBalancerRulesParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2898,6 +8191,15 @@ func (p *ListLoadBalancerRulesParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListLoadBalancerRulesParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2905,6 +8207,15 @@ func (p *ListLoadBalancerRulesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListLoadBalancerRulesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2912,6 +8223,15 @@ func (p *ListLoadBalancerRulesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListLoadBalancerRulesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2919,6 +8239,15 @@ func (p *ListLoadBalancerRulesParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListLoadBalancerRulesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetPublicipid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2926,6 +8255,15 @@ func (p *ListLoadBalancerRulesParams) SetPublicipid(v string) {
 	p.p["publicipid"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetPublicipid() {
+	delete(p.p, "publicipid")
+}
+
+func (p *ListLoadBalancerRulesParams) GetPublicipid() (string, bool) {
+	value, ok := p.p["publicipid"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2933,6 +8271,15 @@ func (p *ListLoadBalancerRulesParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListLoadBalancerRulesParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2940,6 +8287,15 @@ func (p *ListLoadBalancerRulesParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *ListLoadBalancerRulesParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancerRulesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2947,6 +8303,15 @@ func (p *ListLoadBalancerRulesParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListLoadBalancerRulesParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListLoadBalancerRulesParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListLoadBalancerRulesParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListLoadBalancerRulesParams() *ListLoadBalancerRulesParams {
@@ -2957,6 +8322,11 @@ func (s *LoadBalancerService) NewListLoadBalancerRulesParams() *ListLoadBalancer
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetLoadBalancerRuleIDWithContext(context.Background(), name, opts...)
+}
+
+// GetLoadBalancerRuleIDWithContext is the same as GetLoadBalancerRuleID, but uses ctx to cancel the request
+func (s *LoadBalancerService) GetLoadBalancerRuleIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListLoadBalancerRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2968,7 +8338,7 @@ func (s *LoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionF
 		}
 	}
 
-	l, err := s.ListLoadBalancerRules(p)
+	l, err := s.ListLoadBalancerRulesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2993,12 +8363,17 @@ func (s *LoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionF
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerRuleByName(name string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
-	id, count, err := s.GetLoadBalancerRuleID(name, opts...)
+	return s.GetLoadBalancerRuleByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetLoadBalancerRuleByNameWithContext is the same as GetLoadBalancerRuleByName, but uses ctx to cancel the requests
+func (s *LoadBalancerService) GetLoadBalancerRuleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
+	id, count, err := s.GetLoadBalancerRuleIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetLoadBalancerRuleByID(id, opts...)
+	r, count, err := s.GetLoadBalancerRuleByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -3007,6 +8382,11 @@ func (s *LoadBalancerService) GetLoadBalancerRuleByName(name string, opts ...Opt
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
+	return s.GetLoadBalancerRuleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetLoadBalancerRuleByIDWithContext is the same as GetLoadBalancerRuleByID, but uses ctx to cancel the request
+func (s *LoadBalancerService) GetLoadBalancerRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
 	p := &ListLoadBalancerRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3018,18 +8398,16 @@ func (s *LoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...OptionF
 		}
 	}
 
-	l, err := s.ListLoadBalancerRules(p)
+	l, err := s.ListLoadBalancerRulesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -3040,7 +8418,16 @@ func (s *LoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...OptionF
 
 // Lists load balancer rules.
 func (s *LoadBalancerService) ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancerRules", p.toURLValues())
+	return s.ListLoadBalancerRulesWithContext(context.Background(), p)
+}
+
+// ListLoadBalancerRulesWithContext is the same as ListLoadBalancerRules, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) ListLoadBalancerRulesWithContext(ctx context.Context, p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listLoadBalancerRules", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3053,6 +8440,92 @@ func (s *LoadBalancerService) ListLoadBalancerRules(p *ListLoadBalancerRulesPara
 	return &r, nil
 }
 
+// ListLoadBalancerRulesPager iterates over all pages of a ListLoadBalancerRules call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListLoadBalancerRulesPager struct {
+	pager
+
+	s *LoadBalancerService
+	p *ListLoadBalancerRulesParams
+	r *ListLoadBalancerRulesResponse
+}
+
+// NewListLoadBalancerRulesPager returns a pager for all pages of a ListLoadBalancerRules call with the given params
+func (s *LoadBalancerService) NewListLoadBalancerRulesPager(p *ListLoadBalancerRulesParams) *ListLoadBalancerRulesPager {
+	return &ListLoadBalancerRulesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListLoadBalancerRulesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListLoadBalancerRulesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListLoadBalancerRulesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListLoadBalancerRulesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.LoadBalancerRules), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListLoadBalancerRulesPager) Page() *ListLoadBalancerRulesResponse {
+	return pg.r
+}
+
+// ListLoadBalancerRulesAll fetches all pages of a ListLoadBalancerRules call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *LoadBalancerService) ListLoadBalancerRulesAll(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
+	return s.ListLoadBalancerRulesAllWithContext(context.Background(), p)
+}
+
+// ListLoadBalancerRulesAllWithContext is the same as ListLoadBalancerRulesAll, but uses ctx to cancel the requests
+func (s *LoadBalancerService) ListLoadBalancerRulesAllWithContext(ctx context.Context, p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*LoadBalancerRule)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListLoadBalancerRulesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListLoadBalancerRulesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.LoadBalancerRules
+		mu.Unlock()
+
+		return len(l.LoadBalancerRules), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListLoadBalancerRulesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.LoadBalancerRules = append(r.LoadBalancerRules, pages[page]...)
+	}
+	r.Count = len(r.LoadBalancerRules)
+
+	return r, nil
+}
+
 type ListLoadBalancerRulesResponse struct {
 	Count             int                 `json:"count"`
 	LoadBalancerRules []*LoadBalancerRule `json:"loadbalancerrule"`
@@ -3088,7 +8561,8 @@ type ListLoadBalancersParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListLoadBalancersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListLoadBalancersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3153,6 +8627,64 @@ func (p *ListLoadBalancersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListLoadBalancersParams) Validate() error {
+	v := newParamValidator("listLoadBalancers", p.p)
+	v.ids("domainid", "id", "networkid", "projectid", "sourceipaddressnetworkid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("scheme", 255)
+	v.maxLength("sourceipaddress", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListLoadBalancersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListLoadBalancersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "name", "networkid", "projectid", "scheme", "sourceipaddress", "sourceipaddressnetworkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listLoadBalancers", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listLoadBalancers: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListLoadBalancersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3160,6 +8692,15 @@ func (p *ListLoadBalancersParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListLoadBalancersParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3167,6 +8708,15 @@ func (p *ListLoadBalancersParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListLoadBalancersParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3174,6 +8724,15 @@ func (p *ListLoadBalancersParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListLoadBalancersParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3181,6 +8740,15 @@ func (p *ListLoadBalancersParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListLoadBalancersParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3188,6 +8756,15 @@ func (p *ListLoadBalancersParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListLoadBalancersParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3195,6 +8772,15 @@ func (p *ListLoadBalancersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListLoadBalancersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3202,6 +8788,15 @@ func (p *ListLoadBalancersParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListLoadBalancersParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3209,6 +8804,15 @@ func (p *ListLoadBalancersParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListLoadBalancersParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3216,6 +8820,15 @@ func (p *ListLoadBalancersParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListLoadBalancersParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3223,6 +8836,15 @@ func (p *ListLoadBalancersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListLoadBalancersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3230,6 +8852,15 @@ func (p *ListLoadBalancersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListLoadBalancersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3237,6 +8868,15 @@ func (p *ListLoadBalancersParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListLoadBalancersParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetScheme(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3244,6 +8884,15 @@ func (p *ListLoadBalancersParams) SetScheme(v string) {
 	p.p["scheme"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetScheme() {
+	delete(p.p, "scheme")
+}
+
+func (p *ListLoadBalancersParams) GetScheme() (string, bool) {
+	value, ok := p.p["scheme"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetSourceipaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3251,6 +8900,15 @@ func (p *ListLoadBalancersParams) SetSourceipaddress(v string) {
 	p.p["sourceipaddress"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetSourceipaddress() {
+	delete(p.p, "sourceipaddress")
+}
+
+func (p *ListLoadBalancersParams) GetSourceipaddress() (string, bool) {
+	value, ok := p.p["sourceipaddress"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetSourceipaddressnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3258,6 +8916,15 @@ func (p *ListLoadBalancersParams) SetSourceipaddressnetworkid(v string) {
 	p.p["sourceipaddressnetworkid"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetSourceipaddressnetworkid() {
+	delete(p.p, "sourceipaddressnetworkid")
+}
+
+func (p *ListLoadBalancersParams) GetSourceipaddressnetworkid() (string, bool) {
+	value, ok := p.p["sourceipaddressnetworkid"].(string)
+	return value, ok
+}
+
 func (p *ListLoadBalancersParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3265,6 +8932,15 @@ func (p *ListLoadBalancersParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListLoadBalancersParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListLoadBalancersParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListLoadBalancersParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListLoadBalancersParams() *ListLoadBalancersParams {
@@ -3275,6 +8951,11 @@ func (s *LoadBalancerService) NewListLoadBalancersParams() *ListLoadBalancersPar
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetLoadBalancerIDWithContext(context.Background(), name, opts...)
+}
+
+// GetLoadBalancerIDWithContext is the same as GetLoadBalancerID, but uses ctx to cancel the request
+func (s *LoadBalancerService) GetLoadBalancerIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListLoadBalancersParams{}
 	p.p = make(map[string]interface{})
 
@@ -3286,7 +8967,7 @@ func (s *LoadBalancerService) GetLoadBalancerID(name string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListLoadBalancers(p)
+	l, err := s.ListLoadBalancersWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -3311,12 +8992,17 @@ func (s *LoadBalancerService) GetLoadBalancerID(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerByName(name string, opts ...OptionFunc) (*LoadBalancer, int, error) {
-	id, count, err := s.GetLoadBalancerID(name, opts...)
+	return s.GetLoadBalancerByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetLoadBalancerByNameWithContext is the same as GetLoadBalancerByName, but uses ctx to cancel the requests
+func (s *LoadBalancerService) GetLoadBalancerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*LoadBalancer, int, error) {
+	id, count, err := s.GetLoadBalancerIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetLoadBalancerByID(id, opts...)
+	r, count, err := s.GetLoadBalancerByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -3325,6 +9011,11 @@ func (s *LoadBalancerService) GetLoadBalancerByName(name string, opts ...OptionF
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerByID(id string, opts ...OptionFunc) (*LoadBalancer, int, error) {
+	return s.GetLoadBalancerByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetLoadBalancerByIDWithContext is the same as GetLoadBalancerByID, but uses ctx to cancel the request
+func (s *LoadBalancerService) GetLoadBalancerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LoadBalancer, int, error) {
 	p := &ListLoadBalancersParams{}
 	p.p = make(map[string]interface{})
 
@@ -3336,18 +9027,16 @@ func (s *LoadBalancerService) GetLoadBalancerByID(id string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListLoadBalancers(p)
+	l, err := s.ListLoadBalancersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -3358,7 +9047,16 @@ func (s *LoadBalancerService) GetLoadBalancerByID(id string, opts ...OptionFunc)
 
 // Lists load balancers
 func (s *LoadBalancerService) ListLoadBalancers(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancers", p.toURLValues())
+	return s.ListLoadBalancersWithContext(context.Background(), p)
+}
+
+// ListLoadBalancersWithContext is the same as ListLoadBalancers, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) ListLoadBalancersWithContext(ctx context.Context, p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listLoadBalancers", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3371,6 +9069,92 @@ func (s *LoadBalancerService) ListLoadBalancers(p *ListLoadBalancersParams) (*Li
 	return &r, nil
 }
 
+// ListLoadBalancersPager iterates over all pages of a ListLoadBalancers call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListLoadBalancersPager struct {
+	pager
+
+	s *LoadBalancerService
+	p *ListLoadBalancersParams
+	r *ListLoadBalancersResponse
+}
+
+// NewListLoadBalancersPager returns a pager for all pages of a ListLoadBalancers call with the given params
+func (s *LoadBalancerService) NewListLoadBalancersPager(p *ListLoadBalancersParams) *ListLoadBalancersPager {
+	return &ListLoadBalancersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListLoadBalancersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListLoadBalancersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListLoadBalancersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListLoadBalancersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.LoadBalancers), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListLoadBalancersPager) Page() *ListLoadBalancersResponse {
+	return pg.r
+}
+
+// ListLoadBalancersAll fetches all pages of a ListLoadBalancers call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *LoadBalancerService) ListLoadBalancersAll(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
+	return s.ListLoadBalancersAllWithContext(context.Background(), p)
+}
+
+// ListLoadBalancersAllWithContext is the same as ListLoadBalancersAll, but uses ctx to cancel the requests
+func (s *LoadBalancerService) ListLoadBalancersAllWithContext(ctx context.Context, p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*LoadBalancer)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListLoadBalancersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListLoadBalancersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.LoadBalancers
+		mu.Unlock()
+
+		return len(l.LoadBalancers), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListLoadBalancersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.LoadBalancers = append(r.LoadBalancers, pages[page]...)
+	}
+	r.Count = len(r.LoadBalancers)
+
+	return r, nil
+}
+
 type ListLoadBalancersResponse struct {
 	Count         int             `json:"count"`
 	LoadBalancers []*LoadBalancer `json:"loadbalancer"`
@@ -3414,7 +9198,8 @@ type ListNetscalerLoadBalancersParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListNetscalerLoadBalancersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListNetscalerLoadBalancersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3439,6 +9224,52 @@ func (p *ListNetscalerLoadBalancersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListNetscalerLoadBalancersParams) Validate() error {
+	v := newParamValidator("listNetscalerLoadBalancers", p.p)
+	v.ids("lbdeviceid", "physicalnetworkid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListNetscalerLoadBalancersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListNetscalerLoadBalancersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "lbdeviceid", "physicalnetworkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listNetscalerLoadBalancers", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listNetscalerLoadBalancers: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListNetscalerLoadBalancersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3446,6 +9277,15 @@ func (p *ListNetscalerLoadBalancersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListNetscalerLoadBalancersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListNetscalerLoadBalancersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListNetscalerLoadBalancersParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3453,6 +9293,15 @@ func (p *ListNetscalerLoadBalancersParams) SetLbdeviceid(v string) {
 	p.p["lbdeviceid"] = v
 }
 
+func (p *ListNetscalerLoadBalancersParams) ResetLbdeviceid() {
+	delete(p.p, "lbdeviceid")
+}
+
+func (p *ListNetscalerLoadBalancersParams) GetLbdeviceid() (string, bool) {
+	value, ok := p.p["lbdeviceid"].(string)
+	return value, ok
+}
+
 func (p *ListNetscalerLoadBalancersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3460,6 +9309,15 @@ func (p *ListNetscalerLoadBalancersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListNetscalerLoadBalancersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListNetscalerLoadBalancersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListNetscalerLoadBalancersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3467,6 +9325,15 @@ func (p *ListNetscalerLoadBalancersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListNetscalerLoadBalancersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListNetscalerLoadBalancersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListNetscalerLoadBalancersParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3474,6 +9341,15 @@ func (p *ListNetscalerLoadBalancersParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *ListNetscalerLoadBalancersParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListNetscalerLoadBalancersParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListNetscalerLoadBalancersParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListNetscalerLoadBalancersParams() *ListNetscalerLoadBalancersParams {
@@ -3484,7 +9360,16 @@ func (s *LoadBalancerService) NewListNetscalerLoadBalancersParams() *ListNetscal
 
 // lists netscaler load balancer devices
 func (s *LoadBalancerService) ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error) {
-	resp, err := s.cs.newRequest("listNetscalerLoadBalancers", p.toURLValues())
+	return s.ListNetscalerLoadBalancersWithContext(context.Background(), p)
+}
+
+// ListNetscalerLoadBalancersWithContext is the same as ListNetscalerLoadBalancers, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) ListNetscalerLoadBalancersWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetscalerLoadBalancers", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3497,6 +9382,92 @@ func (s *LoadBalancerService) ListNetscalerLoadBalancers(p *ListNetscalerLoadBal
 	return &r, nil
 }
 
+// ListNetscalerLoadBalancersPager iterates over all pages of a ListNetscalerLoadBalancers call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListNetscalerLoadBalancersPager struct {
+	pager
+
+	s *LoadBalancerService
+	p *ListNetscalerLoadBalancersParams
+	r *ListNetscalerLoadBalancersResponse
+}
+
+// NewListNetscalerLoadBalancersPager returns a pager for all pages of a ListNetscalerLoadBalancers call with the given params
+func (s *LoadBalancerService) NewListNetscalerLoadBalancersPager(p *ListNetscalerLoadBalancersParams) *ListNetscalerLoadBalancersPager {
+	return &ListNetscalerLoadBalancersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListNetscalerLoadBalancersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListNetscalerLoadBalancersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListNetscalerLoadBalancersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListNetscalerLoadBalancersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.NetscalerLoadBalancers), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListNetscalerLoadBalancersPager) Page() *ListNetscalerLoadBalancersResponse {
+	return pg.r
+}
+
+// ListNetscalerLoadBalancersAll fetches all pages of a ListNetscalerLoadBalancers call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *LoadBalancerService) ListNetscalerLoadBalancersAll(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error) {
+	return s.ListNetscalerLoadBalancersAllWithContext(context.Background(), p)
+}
+
+// ListNetscalerLoadBalancersAllWithContext is the same as ListNetscalerLoadBalancersAll, but uses ctx to cancel the requests
+func (s *LoadBalancerService) ListNetscalerLoadBalancersAllWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*NetscalerLoadBalancer)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListNetscalerLoadBalancersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListNetscalerLoadBalancersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.NetscalerLoadBalancers
+		mu.Unlock()
+
+		return len(l.NetscalerLoadBalancers), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListNetscalerLoadBalancersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.NetscalerLoadBalancers = append(r.NetscalerLoadBalancers, pages[page]...)
+	}
+	r.Count = len(r.NetscalerLoadBalancers)
+
+	return r, nil
+}
+
 type ListNetscalerLoadBalancersResponse struct {
 	Count                  int                      `json:"count"`
 	NetscalerLoadBalancers []*NetscalerLoadBalancer `json:"netscalerloadbalancer"`
@@ -3526,7 +9497,8 @@ type ListSslCertsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListSslCertsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListSslCertsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3540,10 +9512,51 @@ func (p *ListSslCertsParams) toURLValues() url.Values {
 	if v, found := p.p["lbruleid"]; found {
 		u.Set("lbruleid", v.(string))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListSslCertsParams) Validate() error {
+	v := newParamValidator("listSslCerts", p.p)
+	v.ids("accountid", "certid", "lbruleid", "projectid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListSslCertsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListSslCertsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "accountid", "certid", "lbruleid", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listSslCerts", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listSslCerts: %w", k, err)
+		}
 	}
-	return u
+	return nil
 }
 
 func (p *ListSslCertsParams) SetAccountid(v string) {
@@ -3553,6 +9566,15 @@ func (p *ListSslCertsParams) SetAccountid(v string) {
 	p.p["accountid"] = v
 }
 
+func (p *ListSslCertsParams) ResetAccountid() {
+	delete(p.p, "accountid")
+}
+
+func (p *ListSslCertsParams) GetAccountid() (string, bool) {
+	value, ok := p.p["accountid"].(string)
+	return value, ok
+}
+
 func (p *ListSslCertsParams) SetCertid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3560,6 +9582,15 @@ func (p *ListSslCertsParams) SetCertid(v string) {
 	p.p["certid"] = v
 }
 
+func (p *ListSslCertsParams) ResetCertid() {
+	delete(p.p, "certid")
+}
+
+func (p *ListSslCertsParams) GetCertid() (string, bool) {
+	value, ok := p.p["certid"].(string)
+	return value, ok
+}
+
 func (p *ListSslCertsParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3567,6 +9598,15 @@ func (p *ListSslCertsParams) SetLbruleid(v string) {
 	p.p["lbruleid"] = v
 }
 
+func (p *ListSslCertsParams) ResetLbruleid() {
+	delete(p.p, "lbruleid")
+}
+
+func (p *ListSslCertsParams) GetLbruleid() (string, bool) {
+	value, ok := p.p["lbruleid"].(string)
+	return value, ok
+}
+
 func (p *ListSslCertsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3574,6 +9614,15 @@ func (p *ListSslCertsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListSslCertsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListSslCertsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListSslCertsParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewListSslCertsParams() *ListSslCertsParams {
@@ -3584,7 +9633,16 @@ func (s *LoadBalancerService) NewListSslCertsParams() *ListSslCertsParams {
 
 // Lists SSL certificates
 func (s *LoadBalancerService) ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error) {
-	resp, err := s.cs.newRequest("listSslCerts", p.toURLValues())
+	return s.ListSslCertsWithContext(context.Background(), p)
+}
+
+// ListSslCertsWithContext is the same as ListSslCerts, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) ListSslCertsWithContext(ctx context.Context, p *ListSslCertsParams) (*ListSslCertsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listSslCerts", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3622,7 +9680,8 @@ type RemoveCertFromLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
-func (p *RemoveCertFromLoadBalancerParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RemoveCertFromLoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3633,6 +9692,48 @@ func (p *RemoveCertFromLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RemoveCertFromLoadBalancerParams) Validate() error {
+	v := newParamValidator("removeCertFromLoadBalancer", p.p)
+	v.required("lbruleid")
+	v.ids("lbruleid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RemoveCertFromLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RemoveCertFromLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "lbruleid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for removeCertFromLoadBalancer", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of removeCertFromLoadBalancer: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveCertFromLoadBalancerParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3640,6 +9741,15 @@ func (p *RemoveCertFromLoadBalancerParams) SetLbruleid(v string) {
 	p.p["lbruleid"] = v
 }
 
+func (p *RemoveCertFromLoadBalancerParams) ResetLbruleid() {
+	delete(p.p, "lbruleid")
+}
+
+func (p *RemoveCertFromLoadBalancerParams) GetLbruleid() (string, bool) {
+	value, ok := p.p["lbruleid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RemoveCertFromLoadBalancerParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewRemoveCertFromLoadBalancerParams(lbruleid string) *RemoveCertFromLoadBalancerParams {
@@ -3651,7 +9761,16 @@ func (s *LoadBalancerService) NewRemoveCertFromLoadBalancerParams(lbruleid strin
 
 // Removes a certificate from a load balancer rule
 func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("removeCertFromLoadBalancer", p.toURLValues())
+	return s.RemoveCertFromLoadBalancerWithContext(context.Background(), p)
+}
+
+// RemoveCertFromLoadBalancerWithContext is the same as RemoveCertFromLoadBalancer, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) RemoveCertFromLoadBalancerWithContext(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeCertFromLoadBalancer", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3663,7 +9782,7 @@ func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3679,6 +9798,31 @@ func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBa
 	return &r, nil
 }
 
+// RemoveCertFromLoadBalancerAsync starts the async job for RemoveCertFromLoadBalancer and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RemoveCertFromLoadBalancerResponse using Job.Result
+func (s *LoadBalancerService) RemoveCertFromLoadBalancerAsync(p *RemoveCertFromLoadBalancerParams) (*Job, error) {
+	return s.RemoveCertFromLoadBalancerAsyncWithContext(context.Background(), p)
+}
+
+// RemoveCertFromLoadBalancerAsyncWithContext is the same as RemoveCertFromLoadBalancerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) RemoveCertFromLoadBalancerAsyncWithContext(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeCertFromLoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveCertFromLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type RemoveCertFromLoadBalancerResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -3690,7 +9834,8 @@ type RemoveFromGlobalLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
-func (p *RemoveFromGlobalLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RemoveFromGlobalLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3705,6 +9850,52 @@ func (p *RemoveFromGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RemoveFromGlobalLoadBalancerRuleParams) Validate() error {
+	v := newParamValidator("removeFromGlobalLoadBalancerRule", p.p)
+	v.required("id", "loadbalancerrulelist")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RemoveFromGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RemoveFromGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "loadbalancerrulelist":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for removeFromGlobalLoadBalancerRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of removeFromGlobalLoadBalancerRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveFromGlobalLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3712,6 +9903,15 @@ func (p *RemoveFromGlobalLoadBalancerRuleParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RemoveFromGlobalLoadBalancerRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RemoveFromGlobalLoadBalancerRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *RemoveFromGlobalLoadBalancerRuleParams) SetLoadbalancerrulelist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3719,6 +9919,15 @@ func (p *RemoveFromGlobalLoadBalancerRuleParams) SetLoadbalancerrulelist(v []str
 	p.p["loadbalancerrulelist"] = v
 }
 
+func (p *RemoveFromGlobalLoadBalancerRuleParams) ResetLoadbalancerrulelist() {
+	delete(p.p, "loadbalancerrulelist")
+}
+
+func (p *RemoveFromGlobalLoadBalancerRuleParams) GetLoadbalancerrulelist() ([]string, bool) {
+	value, ok := p.p["loadbalancerrulelist"].([]string)
+	return value, ok
+}
+
 // You should always use this function to get a new RemoveFromGlobalLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewRemoveFromGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *RemoveFromGlobalLoadBalancerRuleParams {
@@ -3731,7 +9940,16 @@ func (s *LoadBalancerService) NewRemoveFromGlobalLoadBalancerRuleParams(id strin
 
 // Removes a load balancer rule association with global load balancer rule
 func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("removeFromGlobalLoadBalancerRule", p.toURLValues())
+	return s.RemoveFromGlobalLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// RemoveFromGlobalLoadBalancerRuleWithContext is the same as RemoveFromGlobalLoadBalancerRule, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeFromGlobalLoadBalancerRule", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3743,7 +9961,7 @@ func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlob
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3759,6 +9977,31 @@ func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlob
 	return &r, nil
 }
 
+// RemoveFromGlobalLoadBalancerRuleAsync starts the async job for RemoveFromGlobalLoadBalancerRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RemoveFromGlobalLoadBalancerRuleResponse using Job.Result
+func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRuleAsync(p *RemoveFromGlobalLoadBalancerRuleParams) (*Job, error) {
+	return s.RemoveFromGlobalLoadBalancerRuleAsyncWithContext(context.Background(), p)
+}
+
+// RemoveFromGlobalLoadBalancerRuleAsyncWithContext is the same as RemoveFromGlobalLoadBalancerRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *RemoveFromGlobalLoadBalancerRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeFromGlobalLoadBalancerRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveFromGlobalLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type RemoveFromGlobalLoadBalancerRuleResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -3770,7 +10013,8 @@ type RemoveFromLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
-func (p *RemoveFromLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RemoveFromLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3783,15 +10027,68 @@ func (p *RemoveFromLoadBalancerRuleParams) toURLValues() url.Values {
 		u.Set("virtualmachineids", vv)
 	}
 	if v, found := p.p["vmidipmap"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("vmidipmap[%d].key", i), k)
-			u.Set(fmt.Sprintf("vmidipmap[%d].value", i), m[k])
+		for i, item := range v.([]VMIDIP) {
+			if item.VMID != "" {
+				u.Set(fmt.Sprintf("vmidipmap[%d].vmid", i), item.VMID)
+			}
+			if item.VMIP != "" {
+				u.Set(fmt.Sprintf("vmidipmap[%d].vmip", i), item.VMIP)
+			}
 		}
 	}
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RemoveFromLoadBalancerRuleParams) Validate() error {
+	v := newParamValidator("removeFromLoadBalancerRule", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RemoveFromLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RemoveFromLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "virtualmachineids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "vmidipmap":
+			var v []VMIDIP
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for removeFromLoadBalancerRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of removeFromLoadBalancerRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveFromLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3799,6 +10096,15 @@ func (p *RemoveFromLoadBalancerRuleParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RemoveFromLoadBalancerRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *RemoveFromLoadBalancerRuleParams) SetVirtualmachineids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3806,13 +10112,31 @@ func (p *RemoveFromLoadBalancerRuleParams) SetVirtualmachineids(v []string) {
 	p.p["virtualmachineids"] = v
 }
 
-func (p *RemoveFromLoadBalancerRuleParams) SetVmidipmap(v map[string]string) {
+func (p *RemoveFromLoadBalancerRuleParams) ResetVirtualmachineids() {
+	delete(p.p, "virtualmachineids")
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) GetVirtualmachineids() ([]string, bool) {
+	value, ok := p.p["virtualmachineids"].([]string)
+	return value, ok
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) SetVmidipmap(v []VMIDIP) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["vmidipmap"] = v
 }
 
+func (p *RemoveFromLoadBalancerRuleParams) ResetVmidipmap() {
+	delete(p.p, "vmidipmap")
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) GetVmidipmap() ([]VMIDIP, bool) {
+	value, ok := p.p["vmidipmap"].([]VMIDIP)
+	return value, ok
+}
+
 // You should always use this function to get a new RemoveFromLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewRemoveFromLoadBalancerRuleParams(id string) *RemoveFromLoadBalancerRuleParams {
@@ -3824,7 +10148,16 @@ func (s *LoadBalancerService) NewRemoveFromLoadBalancerRuleParams(id string) *Re
 
 // Removes a virtual machine or a list of virtual machines from a load balancer rule.
 func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("removeFromLoadBalancerRule", p.toURLValues())
+	return s.RemoveFromLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// RemoveFromLoadBalancerRuleWithContext is the same as RemoveFromLoadBalancerRule, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) RemoveFromLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeFromLoadBalancerRule", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3836,7 +10169,7 @@ func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalanc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3852,6 +10185,31 @@ func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalanc
 	return &r, nil
 }
 
+// RemoveFromLoadBalancerRuleAsync starts the async job for RemoveFromLoadBalancerRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RemoveFromLoadBalancerRuleResponse using Job.Result
+func (s *LoadBalancerService) RemoveFromLoadBalancerRuleAsync(p *RemoveFromLoadBalancerRuleParams) (*Job, error) {
+	return s.RemoveFromLoadBalancerRuleAsyncWithContext(context.Background(), p)
+}
+
+// RemoveFromLoadBalancerRuleAsyncWithContext is the same as RemoveFromLoadBalancerRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) RemoveFromLoadBalancerRuleAsyncWithContext(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeFromLoadBalancerRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveFromLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type RemoveFromLoadBalancerRuleResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -3863,7 +10221,8 @@ type UpdateGlobalLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateGlobalLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3883,6 +10242,51 @@ func (p *UpdateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateGlobalLoadBalancerRuleParams) Validate() error {
+	v := newParamValidator("updateGlobalLoadBalancerRule", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("description", 4096)
+	v.maxLength("gslblbmethod", 255)
+	v.maxLength("gslbstickysessionmethodname", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "gslblbmethod", "gslbstickysessionmethodname", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateGlobalLoadBalancerRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateGlobalLoadBalancerRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateGlobalLoadBalancerRuleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3890,6 +10294,15 @@ func (p *UpdateGlobalLoadBalancerRuleParams) SetDescription(v string) {
 	p.p["description"] = v
 }
 
+func (p *UpdateGlobalLoadBalancerRuleParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
 func (p *UpdateGlobalLoadBalancerRuleParams) SetGslblbmethod(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3897,6 +10310,15 @@ func (p *UpdateGlobalLoadBalancerRuleParams) SetGslblbmethod(v string) {
 	p.p["gslblbmethod"] = v
 }
 
+func (p *UpdateGlobalLoadBalancerRuleParams) ResetGslblbmethod() {
+	delete(p.p, "gslblbmethod")
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) GetGslblbmethod() (string, bool) {
+	value, ok := p.p["gslblbmethod"].(string)
+	return value, ok
+}
+
 func (p *UpdateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3904,6 +10326,15 @@ func (p *UpdateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodname(v st
 	p.p["gslbstickysessionmethodname"] = v
 }
 
+func (p *UpdateGlobalLoadBalancerRuleParams) ResetGslbstickysessionmethodname() {
+	delete(p.p, "gslbstickysessionmethodname")
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) GetGslbstickysessionmethodname() (string, bool) {
+	value, ok := p.p["gslbstickysessionmethodname"].(string)
+	return value, ok
+}
+
 func (p *UpdateGlobalLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3911,6 +10342,15 @@ func (p *UpdateGlobalLoadBalancerRuleParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateGlobalLoadBalancerRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateGlobalLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewUpdateGlobalLoadBalancerRuleParams(id string) *UpdateGlobalLoadBalancerRuleParams {
@@ -3922,7 +10362,16 @@ func (s *LoadBalancerService) NewUpdateGlobalLoadBalancerRuleParams(id string) *
 
 // update global load balancer rules.
 func (s *LoadBalancerService) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateGlobalLoadBalancerRule", p.toURLValues())
+	return s.UpdateGlobalLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// UpdateGlobalLoadBalancerRuleWithContext is the same as UpdateGlobalLoadBalancerRule, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) UpdateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateGlobalLoadBalancerRule", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3934,7 +10383,7 @@ func (s *LoadBalancerService) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3955,6 +10404,40 @@ func (s *LoadBalancerService) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBa
 	return &r, nil
 }
 
+// UpdateGlobalLoadBalancerRuleAsync starts the async job for UpdateGlobalLoadBalancerRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateGlobalLoadBalancerRuleResponse using Job.Result
+func (s *LoadBalancerService) UpdateGlobalLoadBalancerRuleAsync(p *UpdateGlobalLoadBalancerRuleParams) (*Job, error) {
+	return s.UpdateGlobalLoadBalancerRuleAsyncWithContext(context.Background(), p)
+}
+
+// UpdateGlobalLoadBalancerRuleAsyncWithContext is the same as UpdateGlobalLoadBalancerRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) UpdateGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *UpdateGlobalLoadBalancerRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateGlobalLoadBalancerRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateGlobalLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateGlobalLoadBalancerRuleResponse struct {
 	Account                     string                                                 `json:"account"`
 	Description                 string                                                 `json:"description"`
@@ -4002,7 +10485,8 @@ type UpdateLBHealthCheckPolicyParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateLBHealthCheckPolicyParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateLBHealthCheckPolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -4020,6 +10504,53 @@ func (p *UpdateLBHealthCheckPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateLBHealthCheckPolicyParams) Validate() error {
+	v := newParamValidator("updateLBHealthCheckPolicy", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("customid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateLBHealthCheckPolicyParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateLBHealthCheckPolicyParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateLBHealthCheckPolicy", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateLBHealthCheckPolicy: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateLBHealthCheckPolicyParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4027,6 +10558,15 @@ func (p *UpdateLBHealthCheckPolicyParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateLBHealthCheckPolicyParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateLBHealthCheckPolicyParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateLBHealthCheckPolicyParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4034,6 +10574,15 @@ func (p *UpdateLBHealthCheckPolicyParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateLBHealthCheckPolicyParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateLBHealthCheckPolicyParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateLBHealthCheckPolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4041,6 +10590,15 @@ func (p *UpdateLBHealthCheckPolicyParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateLBHealthCheckPolicyParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateLBHealthCheckPolicyParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateLBHealthCheckPolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewUpdateLBHealthCheckPolicyParams(id string) *UpdateLBHealthCheckPolicyParams {
@@ -4052,7 +10610,16 @@ func (s *LoadBalancerService) NewUpdateLBHealthCheckPolicyParams(id string) *Upd
 
 // Updates load balancer health check policy
 func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateLBHealthCheckPolicy", p.toURLValues())
+	return s.UpdateLBHealthCheckPolicyWithContext(context.Background(), p)
+}
+
+// UpdateLBHealthCheckPolicyWithContext is the same as UpdateLBHealthCheckPolicy, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) UpdateLBHealthCheckPolicyWithContext(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateLBHealthCheckPolicy", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4064,7 +10631,7 @@ func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPo
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4085,6 +10652,40 @@ func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPo
 	return &r, nil
 }
 
+// UpdateLBHealthCheckPolicyAsync starts the async job for UpdateLBHealthCheckPolicy and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateLBHealthCheckPolicyResponse using Job.Result
+func (s *LoadBalancerService) UpdateLBHealthCheckPolicyAsync(p *UpdateLBHealthCheckPolicyParams) (*Job, error) {
+	return s.UpdateLBHealthCheckPolicyAsyncWithContext(context.Background(), p)
+}
+
+// UpdateLBHealthCheckPolicyAsyncWithContext is the same as UpdateLBHealthCheckPolicyAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) UpdateLBHealthCheckPolicyAsyncWithContext(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateLBHealthCheckPolicy", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateLBHealthCheckPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateLBHealthCheckPolicyResponse struct {
 	Account           string                                               `json:"account"`
 	Domain            string                                               `json:"domain"`
@@ -4112,7 +10713,8 @@ type UpdateLBStickinessPolicyParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateLBStickinessPolicyParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateLBStickinessPolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -4130,6 +10732,53 @@ func (p *UpdateLBStickinessPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateLBStickinessPolicyParams) Validate() error {
+	v := newParamValidator("updateLBStickinessPolicy", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("customid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateLBStickinessPolicyParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateLBStickinessPolicyParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateLBStickinessPolicy", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateLBStickinessPolicy: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateLBStickinessPolicyParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4137,6 +10786,15 @@ func (p *UpdateLBStickinessPolicyParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateLBStickinessPolicyParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateLBStickinessPolicyParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateLBStickinessPolicyParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4144,6 +10802,15 @@ func (p *UpdateLBStickinessPolicyParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateLBStickinessPolicyParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateLBStickinessPolicyParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateLBStickinessPolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4151,6 +10818,15 @@ func (p *UpdateLBStickinessPolicyParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateLBStickinessPolicyParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateLBStickinessPolicyParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateLBStickinessPolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewUpdateLBStickinessPolicyParams(id string) *UpdateLBStickinessPolicyParams {
@@ -4162,7 +10838,16 @@ func (s *LoadBalancerService) NewUpdateLBStickinessPolicyParams(id string) *Upda
 
 // Updates load balancer stickiness policy
 func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateLBStickinessPolicy", p.toURLValues())
+	return s.UpdateLBStickinessPolicyWithContext(context.Background(), p)
+}
+
+// UpdateLBStickinessPolicyWithContext is the same as UpdateLBStickinessPolicy, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) UpdateLBStickinessPolicyWithContext(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateLBStickinessPolicy", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4174,7 +10859,7 @@ func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPoli
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4195,6 +10880,40 @@ func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPoli
 	return &r, nil
 }
 
+// UpdateLBStickinessPolicyAsync starts the async job for UpdateLBStickinessPolicy and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateLBStickinessPolicyResponse using Job.Result
+func (s *LoadBalancerService) UpdateLBStickinessPolicyAsync(p *UpdateLBStickinessPolicyParams) (*Job, error) {
+	return s.UpdateLBStickinessPolicyAsyncWithContext(context.Background(), p)
+}
+
+// UpdateLBStickinessPolicyAsyncWithContext is the same as UpdateLBStickinessPolicyAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) UpdateLBStickinessPolicyAsyncWithContext(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateLBStickinessPolicy", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateLBStickinessPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateLBStickinessPolicyResponse struct {
 	Account          string                                             `json:"account"`
 	Description      string                                             `json:"description"`
@@ -4223,7 +10942,8 @@ type UpdateLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateLoadBalancerParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateLoadBalancerParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -4241,6 +10961,53 @@ func (p *UpdateLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateLoadBalancerParams) Validate() error {
+	v := newParamValidator("updateLoadBalancer", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("customid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateLoadBalancer", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateLoadBalancer: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateLoadBalancerParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4248,6 +11015,15 @@ func (p *UpdateLoadBalancerParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateLoadBalancerParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateLoadBalancerParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateLoadBalancerParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4255,6 +11031,15 @@ func (p *UpdateLoadBalancerParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateLoadBalancerParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateLoadBalancerParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateLoadBalancerParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4262,6 +11047,15 @@ func (p *UpdateLoadBalancerParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateLoadBalancerParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateLoadBalancerParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateLoadBalancerParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewUpdateLoadBalancerParams(id string) *UpdateLoadBalancerParams {
@@ -4273,7 +11067,16 @@ func (s *LoadBalancerService) NewUpdateLoadBalancerParams(id string) *UpdateLoad
 
 // Updates a load balancer
 func (s *LoadBalancerService) UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("updateLoadBalancer", p.toURLValues())
+	return s.UpdateLoadBalancerWithContext(context.Background(), p)
+}
+
+// UpdateLoadBalancerWithContext is the same as UpdateLoadBalancer, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) UpdateLoadBalancerWithContext(ctx context.Context, p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateLoadBalancer", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4285,7 +11088,7 @@ func (s *LoadBalancerService) UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4306,6 +11109,40 @@ func (s *LoadBalancerService) UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*
 	return &r, nil
 }
 
+// UpdateLoadBalancerAsync starts the async job for UpdateLoadBalancer and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateLoadBalancerResponse using Job.Result
+func (s *LoadBalancerService) UpdateLoadBalancerAsync(p *UpdateLoadBalancerParams) (*Job, error) {
+	return s.UpdateLoadBalancerAsyncWithContext(context.Background(), p)
+}
+
+// UpdateLoadBalancerAsyncWithContext is the same as UpdateLoadBalancerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) UpdateLoadBalancerAsyncWithContext(ctx context.Context, p *UpdateLoadBalancerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateLoadBalancer", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateLoadBalancerResponse struct {
 	Account                  string                                           `json:"account"`
 	Algorithm                string                                           `json:"algorithm"`
@@ -4344,7 +11181,8 @@ type UpdateLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateLoadBalancerRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateLoadBalancerRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -4374,6 +11212,57 @@ func (p *UpdateLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateLoadBalancerRuleParams) Validate() error {
+	v := newParamValidator("updateLoadBalancerRule", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("algorithm", 255)
+	v.maxLength("customid", 255)
+	v.maxLength("description", 4096)
+	v.maxLength("name", 255)
+	v.maxLength("protocol", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "algorithm", "customid", "description", "id", "name", "protocol":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateLoadBalancerRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateLoadBalancerRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateLoadBalancerRuleParams) SetAlgorithm(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4381,6 +11270,15 @@ func (p *UpdateLoadBalancerRuleParams) SetAlgorithm(v string) {
 	p.p["algorithm"] = v
 }
 
+func (p *UpdateLoadBalancerRuleParams) ResetAlgorithm() {
+	delete(p.p, "algorithm")
+}
+
+func (p *UpdateLoadBalancerRuleParams) GetAlgorithm() (string, bool) {
+	value, ok := p.p["algorithm"].(string)
+	return value, ok
+}
+
 func (p *UpdateLoadBalancerRuleParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4388,6 +11286,15 @@ func (p *UpdateLoadBalancerRuleParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateLoadBalancerRuleParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateLoadBalancerRuleParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateLoadBalancerRuleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4395,6 +11302,15 @@ func (p *UpdateLoadBalancerRuleParams) SetDescription(v string) {
 	p.p["description"] = v
 }
 
+func (p *UpdateLoadBalancerRuleParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *UpdateLoadBalancerRuleParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
 func (p *UpdateLoadBalancerRuleParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4402,6 +11318,15 @@ func (p *UpdateLoadBalancerRuleParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateLoadBalancerRuleParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateLoadBalancerRuleParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4409,6 +11334,15 @@ func (p *UpdateLoadBalancerRuleParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateLoadBalancerRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateLoadBalancerRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateLoadBalancerRuleParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4416,6 +11350,15 @@ func (p *UpdateLoadBalancerRuleParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateLoadBalancerRuleParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateLoadBalancerRuleParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateLoadBalancerRuleParams) SetProtocol(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4423,6 +11366,15 @@ func (p *UpdateLoadBalancerRuleParams) SetProtocol(v string) {
 	p.p["protocol"] = v
 }
 
+func (p *UpdateLoadBalancerRuleParams) ResetProtocol() {
+	delete(p.p, "protocol")
+}
+
+func (p *UpdateLoadBalancerRuleParams) GetProtocol() (string, bool) {
+	value, ok := p.p["protocol"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewUpdateLoadBalancerRuleParams(id string) *UpdateLoadBalancerRuleParams {
@@ -4434,7 +11386,16 @@ func (s *LoadBalancerService) NewUpdateLoadBalancerRuleParams(id string) *Update
 
 // Updates load balancer
 func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateLoadBalancerRule", p.toURLValues())
+	return s.UpdateLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// UpdateLoadBalancerRuleWithContext is the same as UpdateLoadBalancerRule, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) UpdateLoadBalancerRuleWithContext(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateLoadBalancerRule", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4446,7 +11407,7 @@ func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4467,6 +11428,40 @@ func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRulePa
 	return &r, nil
 }
 
+// UpdateLoadBalancerRuleAsync starts the async job for UpdateLoadBalancerRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateLoadBalancerRuleResponse using Job.Result
+func (s *LoadBalancerService) UpdateLoadBalancerRuleAsync(p *UpdateLoadBalancerRuleParams) (*Job, error) {
+	return s.UpdateLoadBalancerRuleAsyncWithContext(context.Background(), p)
+}
+
+// UpdateLoadBalancerRuleAsyncWithContext is the same as UpdateLoadBalancerRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *LoadBalancerService) UpdateLoadBalancerRuleAsyncWithContext(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateLoadBalancerRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateLoadBalancerRuleResponse struct {
 	Account     string `json:"account"`
 	Algorithm   string `json:"algorithm"`
@@ -4497,7 +11492,8 @@ type UploadSslCertParams struct {
 	p map[string]interface{}
 }
 
-func (p *UploadSslCertParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UploadSslCertParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -4533,6 +11529,58 @@ func (p *UploadSslCertParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UploadSslCertParams) Validate() error {
+	v := newParamValidator("uploadSslCert", p.p)
+	v.required("certificate", "name", "privatekey")
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("certchain", 2097152)
+	v.maxLength("certificate", 16384)
+	v.maxLength("name", 255)
+	v.maxLength("password", 255)
+	v.maxLength("privatekey", 16384)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UploadSslCertParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UploadSslCertParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "certchain", "certificate", "domainid", "name", "password", "privatekey", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "enabledrevocationcheck":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for uploadSslCert", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of uploadSslCert: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UploadSslCertParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4540,6 +11588,15 @@ func (p *UploadSslCertParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *UploadSslCertParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *UploadSslCertParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *UploadSslCertParams) SetCertchain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4547,6 +11604,15 @@ func (p *UploadSslCertParams) SetCertchain(v string) {
 	p.p["certchain"] = v
 }
 
+func (p *UploadSslCertParams) ResetCertchain() {
+	delete(p.p, "certchain")
+}
+
+func (p *UploadSslCertParams) GetCertchain() (string, bool) {
+	value, ok := p.p["certchain"].(string)
+	return value, ok
+}
+
 func (p *UploadSslCertParams) SetCertificate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4554,6 +11620,15 @@ func (p *UploadSslCertParams) SetCertificate(v string) {
 	p.p["certificate"] = v
 }
 
+func (p *UploadSslCertParams) ResetCertificate() {
+	delete(p.p, "certificate")
+}
+
+func (p *UploadSslCertParams) GetCertificate() (string, bool) {
+	value, ok := p.p["certificate"].(string)
+	return value, ok
+}
+
 func (p *UploadSslCertParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4561,6 +11636,15 @@ func (p *UploadSslCertParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *UploadSslCertParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *UploadSslCertParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *UploadSslCertParams) SetEnabledrevocationcheck(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4568,6 +11652,15 @@ func (p *UploadSslCertParams) SetEnabledrevocationcheck(v bool) {
 	p.p["enabledrevocationcheck"] = v
 }
 
+func (p *UploadSslCertParams) ResetEnabledrevocationcheck() {
+	delete(p.p, "enabledrevocationcheck")
+}
+
+func (p *UploadSslCertParams) GetEnabledrevocationcheck() (bool, bool) {
+	value, ok := p.p["enabledrevocationcheck"].(bool)
+	return value, ok
+}
+
 func (p *UploadSslCertParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4575,6 +11668,15 @@ func (p *UploadSslCertParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UploadSslCertParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UploadSslCertParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UploadSslCertParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4582,6 +11684,15 @@ func (p *UploadSslCertParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *UploadSslCertParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *UploadSslCertParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *UploadSslCertParams) SetPrivatekey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4589,6 +11700,15 @@ func (p *UploadSslCertParams) SetPrivatekey(v string) {
 	p.p["privatekey"] = v
 }
 
+func (p *UploadSslCertParams) ResetPrivatekey() {
+	delete(p.p, "privatekey")
+}
+
+func (p *UploadSslCertParams) GetPrivatekey() (string, bool) {
+	value, ok := p.p["privatekey"].(string)
+	return value, ok
+}
+
 func (p *UploadSslCertParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4596,6 +11716,15 @@ func (p *UploadSslCertParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *UploadSslCertParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *UploadSslCertParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UploadSslCertParams instance,
 // as then you are sure you have configured all required params
 func (s *LoadBalancerService) NewUploadSslCertParams(certificate string, name string, privatekey string) *UploadSslCertParams {
@@ -4609,7 +11738,16 @@ func (s *LoadBalancerService) NewUploadSslCertParams(certificate string, name st
 
 // Upload a certificate to CloudStack
 func (s *LoadBalancerService) UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error) {
-	resp, err := s.cs.newRequest("uploadSslCert", p.toURLValues())
+	return s.UploadSslCertWithContext(context.Background(), p)
+}
+
+// UploadSslCertWithContext is the same as UploadSslCert, but uses ctx to cancel the request and any async job polling
+func (s *LoadBalancerService) UploadSslCertWithContext(ctx context.Context, p *UploadSslCertParams) (*UploadSslCertResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "uploadSslCert", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,232 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// RedactedValue replaces the value of every redacted field in the logs
+const RedactedValue = "REDACTED"
+
+// DefaultRedactedFields contains the (parts of) param and response field names that are redacted
+// by default. A field is redacted if its lowercased name contains one of these values. The value
+// of a key/value item of a map param (like details[0].value) is redacted if its key is redacted.
+var DefaultRedactedFields = []string{
+	"apikey",
+	"ipsecpsk",
+	"password",
+	"privatekey",
+	"secret",
+	"sessionkey",
+	"signature",
+	"token",
+	"userdata",
+}
+
+// Logger is a minimal structured logger, where keyvals contains alternating keys and values. It
+// is satisfied by a *slog.Logger, and it is easy to adapt to most other logging libraries.
+type Logger interface {
+	Debug(msg string, keyvals ...interface{})
+}
+
+// LoggerOption can be passed to WithLogger to set custom options
+type LoggerOption func(*requestLogger)
+
+// WithBodyLogging makes the logger also log the complete response bodies, next to the params
+// which make up the request body. Any field that should be redacted is also redacted in the bodies.
+func WithBodyLogging() LoggerOption {
+	return func(l *requestLogger) {
+		l.bodies = true
+	}
+}
+
+// WithRedactedFields replaces the list of fields that are redacted, which defaults to the
+// DefaultRedactedFields. Names are matched case-insensitive on any part of the field name.
+func WithRedactedFields(fields ...string) LoggerOption {
+	return func(l *requestLogger) {
+		l.redacted = make([]string, len(fields))
+		for i, f := range fields {
+			l.redacted[i] = strings.ToLower(f)
+		}
+	}
+}
+
+// WithLogger makes the client log every request (the command, the redacted params, the response
+// size and the duration) and the progress of the async jobs it is waiting for, at debug level.
+func WithLogger(logger Logger, opts ...LoggerOption) ClientOption {
+	l := &requestLogger{
+		logger:   logger,
+		redacted: DefaultRedactedFields,
+	}
+
+	for _, fn := range opts {
+		fn(l)
+	}
+
+	return WithClientTrace(&ClientTrace{
+		RequestRetry: l.requestRetry,
+		RequestDone:  l.requestDone,
+		JobWaitStart: l.jobWaitStart,
+		JobPollDone:  l.jobPollDone,
+		JobWaitDone:  l.jobWaitDone,
+	})
+}
+
+type requestLogger struct {
+	logger   Logger
+	redacted []string
+	bodies   bool
+}
+
+func (l *requestLogger) requestRetry(ctx context.Context, info *RequestInfo) {
+	l.logger.Debug("CloudStack request failed, retrying",
+		"command", info.Command,
+		"attempt", info.Attempt,
+		"status", info.StatusCode,
+		"error", info.Err,
+	)
+}
+
+func (l *requestLogger) requestDone(ctx context.Context, info *RequestInfo) {
+	keyvals := []interface{}{
+		"command", info.Command,
+		"method", info.Method,
+		"params", l.redactParams(info.Params),
+		"status", info.StatusCode,
+		"size", len(info.Response),
+		"duration", info.Duration,
+		"attempts", info.Attempt,
+	}
+	if info.JobID != "" {
+		keyvals = append(keyvals, "jobid", info.JobID)
+	}
+	if info.Err != nil {
+		keyvals = append(keyvals, "error", info.Err)
+	}
+	if l.bodies {
+		keyvals = append(keyvals, "response", l.redactBody(info.Response))
+	}
+
+	l.logger.Debug("CloudStack request", keyvals...)
+}
+
+func (l *requestLogger) jobWaitStart(ctx context.Context, jobid string) context.Context {
+	l.logger.Debug("Waiting for async job", "jobid", jobid)
+	return ctx
+}
+
+func (l *requestLogger) jobPollDone(ctx context.Context, info *JobPollInfo) {
+	if info.Err != nil {
+		l.logger.Debug("Failed to poll async job", "jobid", info.JobID, "error", info.Err)
+		return
+	}
+	l.logger.Debug("Polled async job", "jobid", info.JobID, "jobstatus", info.Status)
+}
+
+func (l *requestLogger) jobWaitDone(ctx context.Context, info *JobWaitInfo) {
+	keyvals := []interface{}{"jobid", info.JobID, "duration", info.Duration}
+	if info.Err != nil {
+		keyvals = append(keyvals, "error", info.Err)
+	}
+	l.logger.Debug("Stopped waiting for async job", keyvals...)
+}
+
+// Returns true if the value of the field should be redacted
+func (l *requestLogger) isRedacted(field string) bool {
+	field = strings.ToLower(field)
+	for _, r := range l.redacted {
+		if strings.Contains(field, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the encoded params, with the values of all redacted fields replaced
+func (l *requestLogger) redactParams(params url.Values) string {
+	p := make(url.Values, len(params))
+	for k, vs := range params {
+		if l.isRedacted(k) || l.isRedactedItem(k, params) {
+			p[k] = []string{RedactedValue}
+		} else {
+			p[k] = vs
+		}
+	}
+	return p.Encode()
+}
+
+// Returns true if the field is the value of an item of a map param, like details[0].value,
+// of which the key (details[0].key or details[0].name) is redacted
+func (l *requestLogger) isRedactedItem(field string, params url.Values) bool {
+	if !strings.HasSuffix(strings.ToLower(field), ".value") {
+		return false
+	}
+
+	prefix := field[:len(field)-len(".value")]
+	for _, sibling := range []string{prefix + ".key", prefix + ".name"} {
+		if l.isRedacted(params.Get(sibling)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the body, with the values of all redacted fields replaced
+func (l *requestLogger) redactBody(b json.RawMessage) string {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		// A body that isn't JSON can't be redacted field by field, so only log its size
+		return fmt.Sprintf("%s (%d bytes)", RedactedValue, len(b))
+	}
+
+	b, err := json.Marshal(l.redactValue(v))
+	if err != nil {
+		return RedactedValue
+	}
+	return string(b)
+}
+
+func (l *requestLogger) redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		// A key/value object, like the items of a details list, is redacted based on its key
+		name, _ := v["key"].(string)
+		if name == "" {
+			name, _ = v["name"].(string)
+		}
+		for k, vv := range v {
+			if l.isRedacted(k) || (k == "value" && l.isRedacted(name)) {
+				v[k] = RedactedValue
+			} else {
+				v[k] = l.redactValue(vv)
+			}
+		}
+	case []interface{}:
+		for i, vv := range v {
+			v[i] = l.redactValue(vv)
+		}
+	}
+	return v
+}
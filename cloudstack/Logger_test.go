@@ -0,0 +1,60 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import "testing"
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "redacted field",
+			body: `{"user":{"apikey":"key","username":"admin"}}`,
+			want: `{"user":{"apikey":"REDACTED","username":"admin"}}`,
+		},
+		{
+			name: "redacted detail",
+			body: `{"details":[{"key":"password","value":"secret"}]}`,
+			want: `{"details":[{"key":"password","value":"REDACTED"}]}`,
+		},
+		{
+			name: "not JSON",
+			body: `password=secret`,
+			want: `REDACTED (15 bytes)`,
+		},
+		{
+			name: "empty",
+			want: `REDACTED (0 bytes)`,
+		},
+	}
+
+	l := &requestLogger{redacted: DefaultRedactedFields}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := l.redactBody([]byte(tt.body)); got != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,529 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// ManagementServiceIface is the interface implemented by ManagementService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type ManagementServiceIface interface {
+	NewListInfrastructureParams() *ListInfrastructureParams
+	ListInfrastructure(p *ListInfrastructureParams) (*ListInfrastructureResponse, error)
+	ListInfrastructureWithContext(ctx context.Context, p *ListInfrastructureParams) (*ListInfrastructureResponse, error)
+	NewListManagementServersParams() *ListManagementServersParams
+	GetManagementServerID(name string, opts ...OptionFunc) (string, int, error)
+	GetManagementServerIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetManagementServerByName(name string, opts ...OptionFunc) (*ManagementServer, int, error)
+	GetManagementServerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ManagementServer, int, error)
+	GetManagementServerByID(id string, opts ...OptionFunc) (*ManagementServer, int, error)
+	GetManagementServerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ManagementServer, int, error)
+	ListManagementServers(p *ListManagementServersParams) (*ListManagementServersResponse, error)
+	ListManagementServersWithContext(ctx context.Context, p *ListManagementServersParams) (*ListManagementServersResponse, error)
+	NewListManagementServersPager(p *ListManagementServersParams) *ListManagementServersPager
+	ListManagementServersAll(p *ListManagementServersParams) (*ListManagementServersResponse, error)
+	ListManagementServersAllWithContext(ctx context.Context, p *ListManagementServersParams) (*ListManagementServersResponse, error)
+}
+
+type ListInfrastructureParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListInfrastructureParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListInfrastructureParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListInfrastructureParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListInfrastructureParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k := range m {
+		return fmt.Errorf("Unknown param %s for listInfrastructure", k)
+	}
+	return nil
+}
+
+// You should always use this function to get a new ListInfrastructureParams instance,
+// as then you are sure you have configured all required params
+func (s *ManagementService) NewListInfrastructureParams() *ListInfrastructureParams {
+	p := &ListInfrastructureParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Lists infrastructure
+func (s *ManagementService) ListInfrastructure(p *ListInfrastructureParams) (*ListInfrastructureResponse, error) {
+	return s.ListInfrastructureWithContext(context.Background(), p)
+}
+
+// ListInfrastructureWithContext is the same as ListInfrastructure, but uses ctx to cancel the request and any async job polling
+func (s *ManagementService) ListInfrastructureWithContext(ctx context.Context, p *ListInfrastructureParams) (*ListInfrastructureResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listInfrastructure", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListInfrastructureResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListInfrastructureResponse struct {
+	Count          int               `json:"count"`
+	Infrastructure []*Infrastructure `json:"infrastructure"`
+}
+
+type Infrastructure struct {
+	Alerts            int    `json:"alerts"`
+	Clusters          int    `json:"clusters"`
+	Cpusockets        int    `json:"cpusockets"`
+	Hosts             int    `json:"hosts"`
+	Ilbvms            int    `json:"ilbvms"`
+	Imagestores       int    `json:"imagestores"`
+	JobID             string `json:"jobid"`
+	Jobstatus         int    `json:"jobstatus"`
+	Managementservers int    `json:"managementservers"`
+	Pods              int    `json:"pods"`
+	Routers           int    `json:"routers"`
+	Storagepools      int    `json:"storagepools"`
+	Systemvms         int    `json:"systemvms"`
+	Zones             int    `json:"zones"`
+}
+
+type ListManagementServersParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListManagementServersParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListManagementServersParams) Validate() error {
+	v := newParamValidator("listManagementServers", p.p)
+	v.ids("id")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListManagementServersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListManagementServersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listManagementServers", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listManagementServers: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListManagementServersParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListManagementServersParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListManagementServersParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListManagementServersParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListManagementServersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListManagementServersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListManagementServersParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ListManagementServersParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListManagementServersParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ListManagementServersParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListManagementServersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListManagementServersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListManagementServersParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListManagementServersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListManagementServersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+// You should always use this function to get a new ListManagementServersParams instance,
+// as then you are sure you have configured all required params
+func (s *ManagementService) NewListManagementServersParams() *ListManagementServersParams {
+	p := &ListManagementServersParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ManagementService) GetManagementServerID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetManagementServerIDWithContext(context.Background(), name, opts...)
+}
+
+// GetManagementServerIDWithContext is the same as GetManagementServerID, but uses ctx to cancel the request
+func (s *ManagementService) GetManagementServerIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListManagementServersParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["name"] = name
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListManagementServersWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.ManagementServers[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.ManagementServers {
+			if v.Name == name {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ManagementService) GetManagementServerByName(name string, opts ...OptionFunc) (*ManagementServer, int, error) {
+	return s.GetManagementServerByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetManagementServerByNameWithContext is the same as GetManagementServerByName, but uses ctx to cancel the requests
+func (s *ManagementService) GetManagementServerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ManagementServer, int, error) {
+	id, count, err := s.GetManagementServerIDWithContext(ctx, name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetManagementServerByIDWithContext(ctx, id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ManagementService) GetManagementServerByID(id string, opts ...OptionFunc) (*ManagementServer, int, error) {
+	return s.GetManagementServerByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetManagementServerByIDWithContext is the same as GetManagementServerByID, but uses ctx to cancel the request
+func (s *ManagementService) GetManagementServerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ManagementServer, int, error) {
+	p := &ListManagementServersParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListManagementServersWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.ManagementServers[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for ManagementServer UUID: %s!", id)
+}
+
+// Lists management servers.
+func (s *ManagementService) ListManagementServers(p *ListManagementServersParams) (*ListManagementServersResponse, error) {
+	return s.ListManagementServersWithContext(context.Background(), p)
+}
+
+// ListManagementServersWithContext is the same as ListManagementServers, but uses ctx to cancel the request and any async job polling
+func (s *ManagementService) ListManagementServersWithContext(ctx context.Context, p *ListManagementServersParams) (*ListManagementServersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listManagementServers", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListManagementServersResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListManagementServersPager iterates over all pages of a ListManagementServers call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListManagementServersPager struct {
+	pager
+
+	s *ManagementService
+	p *ListManagementServersParams
+	r *ListManagementServersResponse
+}
+
+// NewListManagementServersPager returns a pager for all pages of a ListManagementServers call with the given params
+func (s *ManagementService) NewListManagementServersPager(p *ListManagementServersParams) *ListManagementServersPager {
+	return &ListManagementServersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListManagementServersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListManagementServersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListManagementServersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListManagementServersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.ManagementServers), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListManagementServersPager) Page() *ListManagementServersResponse {
+	return pg.r
+}
+
+// ListManagementServersAll fetches all pages of a ListManagementServers call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ManagementService) ListManagementServersAll(p *ListManagementServersParams) (*ListManagementServersResponse, error) {
+	return s.ListManagementServersAllWithContext(context.Background(), p)
+}
+
+// ListManagementServersAllWithContext is the same as ListManagementServersAll, but uses ctx to cancel the requests
+func (s *ManagementService) ListManagementServersAllWithContext(ctx context.Context, p *ListManagementServersParams) (*ListManagementServersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*ManagementServer)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListManagementServersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListManagementServersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.ManagementServers
+		mu.Unlock()
+
+		return len(l.ManagementServers), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListManagementServersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.ManagementServers = append(r.ManagementServers, pages[page]...)
+	}
+	r.Count = len(r.ManagementServers)
+
+	return r, nil
+}
+
+type ListManagementServersResponse struct {
+	Count             int                 `json:"count"`
+	ManagementServers []*ManagementServer `json:"managementserver"`
+}
+
+type ManagementServer struct {
+	Id        string `json:"id"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Name      string `json:"name"`
+	State     string `json:"state"`
+	Version   string `json:"version"`
+}
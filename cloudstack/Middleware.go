@@ -0,0 +1,48 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// RequestFunc executes the API command with the given params, and returns the raw JSON data
+// returned by the API. A failing API call returns a *CSError, just like all API functions.
+type RequestFunc func(ctx context.Context, command string, params url.Values) (json.RawMessage, error)
+
+// Middleware wraps the execution of every request made by the client. A middleware receives the
+// command and the params before they are signed, so it can inspect or modify them before calling
+// next, and it can inspect or modify the raw response and error returned by next. It can also
+// short-circuit the request by returning without calling next.
+//
+// The middleware wraps the complete request including any retries, so next is called once per
+// API call. Note that async API calls of the async client consist of multiple requests, as the
+// job is polled using queryAsyncJobResult.
+type Middleware func(next RequestFunc) RequestFunc
+
+// WithMiddleware adds middleware to the client. The middleware is called in the given order, so
+// the first middleware is the outermost one. Using the option multiple times adds more middleware.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.middleware = append(cs.middleware, middleware...)
+	}
+}
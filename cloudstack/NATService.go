@@ -20,18 +20,52 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// NATServiceIface is the interface implemented by NATService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type NATServiceIface interface {
+	NewCreateIpForwardingRuleParams(ipaddressid string, protocol string, startport int) *CreateIpForwardingRuleParams
+	CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error)
+	CreateIpForwardingRuleWithContext(ctx context.Context, p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error)
+	CreateIpForwardingRuleAsync(p *CreateIpForwardingRuleParams) (*Job, error)
+	CreateIpForwardingRuleAsyncWithContext(ctx context.Context, p *CreateIpForwardingRuleParams) (*Job, error)
+	NewDeleteIpForwardingRuleParams(id string) *DeleteIpForwardingRuleParams
+	DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error)
+	DeleteIpForwardingRuleWithContext(ctx context.Context, p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error)
+	DeleteIpForwardingRuleAsync(p *DeleteIpForwardingRuleParams) (*Job, error)
+	DeleteIpForwardingRuleAsyncWithContext(ctx context.Context, p *DeleteIpForwardingRuleParams) (*Job, error)
+	NewDisableStaticNatParams(ipaddressid string) *DisableStaticNatParams
+	DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error)
+	DisableStaticNatWithContext(ctx context.Context, p *DisableStaticNatParams) (*DisableStaticNatResponse, error)
+	DisableStaticNatAsync(p *DisableStaticNatParams) (*Job, error)
+	DisableStaticNatAsyncWithContext(ctx context.Context, p *DisableStaticNatParams) (*Job, error)
+	NewEnableStaticNatParams(ipaddressid string, virtualmachineid string) *EnableStaticNatParams
+	EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error)
+	EnableStaticNatWithContext(ctx context.Context, p *EnableStaticNatParams) (*EnableStaticNatResponse, error)
+	NewListIpForwardingRulesParams() *ListIpForwardingRulesParams
+	GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*IpForwardingRule, int, error)
+	GetIpForwardingRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*IpForwardingRule, int, error)
+	ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error)
+	ListIpForwardingRulesWithContext(ctx context.Context, p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error)
+	NewListIpForwardingRulesPager(p *ListIpForwardingRulesParams) *ListIpForwardingRulesPager
+	ListIpForwardingRulesAll(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error)
+	ListIpForwardingRulesAllWithContext(ctx context.Context, p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error)
+}
+
 type CreateIpForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateIpForwardingRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateIpForwardingRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -61,6 +95,61 @@ func (p *CreateIpForwardingRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateIpForwardingRuleParams) Validate() error {
+	v := newParamValidator("createIpForwardingRule", p.p)
+	v.required("ipaddressid", "protocol", "startport")
+	v.ids("ipaddressid")
+	v.maxLength("protocol", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateIpForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateIpForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "cidrlist":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "endport", "startport":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ipaddressid", "protocol":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "openfirewall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createIpForwardingRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createIpForwardingRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateIpForwardingRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -68,6 +157,15 @@ func (p *CreateIpForwardingRuleParams) SetCidrlist(v []string) {
 	p.p["cidrlist"] = v
 }
 
+func (p *CreateIpForwardingRuleParams) ResetCidrlist() {
+	delete(p.p, "cidrlist")
+}
+
+func (p *CreateIpForwardingRuleParams) GetCidrlist() ([]string, bool) {
+	value, ok := p.p["cidrlist"].([]string)
+	return value, ok
+}
+
 func (p *CreateIpForwardingRuleParams) SetEndport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -75,6 +173,15 @@ func (p *CreateIpForwardingRuleParams) SetEndport(v int) {
 	p.p["endport"] = v
 }
 
+func (p *CreateIpForwardingRuleParams) ResetEndport() {
+	delete(p.p, "endport")
+}
+
+func (p *CreateIpForwardingRuleParams) GetEndport() (int, bool) {
+	value, ok := p.p["endport"].(int)
+	return value, ok
+}
+
 func (p *CreateIpForwardingRuleParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -82,6 +189,15 @@ func (p *CreateIpForwardingRuleParams) SetIpaddressid(v string) {
 	p.p["ipaddressid"] = v
 }
 
+func (p *CreateIpForwardingRuleParams) ResetIpaddressid() {
+	delete(p.p, "ipaddressid")
+}
+
+func (p *CreateIpForwardingRuleParams) GetIpaddressid() (string, bool) {
+	value, ok := p.p["ipaddressid"].(string)
+	return value, ok
+}
+
 func (p *CreateIpForwardingRuleParams) SetOpenfirewall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -89,6 +205,15 @@ func (p *CreateIpForwardingRuleParams) SetOpenfirewall(v bool) {
 	p.p["openfirewall"] = v
 }
 
+func (p *CreateIpForwardingRuleParams) ResetOpenfirewall() {
+	delete(p.p, "openfirewall")
+}
+
+func (p *CreateIpForwardingRuleParams) GetOpenfirewall() (bool, bool) {
+	value, ok := p.p["openfirewall"].(bool)
+	return value, ok
+}
+
 func (p *CreateIpForwardingRuleParams) SetProtocol(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -96,6 +221,15 @@ func (p *CreateIpForwardingRuleParams) SetProtocol(v string) {
 	p.p["protocol"] = v
 }
 
+func (p *CreateIpForwardingRuleParams) ResetProtocol() {
+	delete(p.p, "protocol")
+}
+
+func (p *CreateIpForwardingRuleParams) GetProtocol() (string, bool) {
+	value, ok := p.p["protocol"].(string)
+	return value, ok
+}
+
 func (p *CreateIpForwardingRuleParams) SetStartport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -103,6 +237,15 @@ func (p *CreateIpForwardingRuleParams) SetStartport(v int) {
 	p.p["startport"] = v
 }
 
+func (p *CreateIpForwardingRuleParams) ResetStartport() {
+	delete(p.p, "startport")
+}
+
+func (p *CreateIpForwardingRuleParams) GetStartport() (int, bool) {
+	value, ok := p.p["startport"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateIpForwardingRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *NATService) NewCreateIpForwardingRuleParams(ipaddressid string, protocol string, startport int) *CreateIpForwardingRuleParams {
@@ -116,7 +259,16 @@ func (s *NATService) NewCreateIpForwardingRuleParams(ipaddressid string, protoco
 
 // Creates an IP forwarding rule
 func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("createIpForwardingRule", p.toURLValues())
+	return s.CreateIpForwardingRuleWithContext(context.Background(), p)
+}
+
+// CreateIpForwardingRuleWithContext is the same as CreateIpForwardingRule, but uses ctx to cancel the request and any async job polling
+func (s *NATService) CreateIpForwardingRuleWithContext(ctx context.Context, p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createIpForwardingRule", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +280,7 @@ func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*C
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -149,6 +301,40 @@ func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*C
 	return &r, nil
 }
 
+// CreateIpForwardingRuleAsync starts the async job for CreateIpForwardingRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateIpForwardingRuleResponse using Job.Result
+func (s *NATService) CreateIpForwardingRuleAsync(p *CreateIpForwardingRuleParams) (*Job, error) {
+	return s.CreateIpForwardingRuleAsyncWithContext(context.Background(), p)
+}
+
+// CreateIpForwardingRuleAsyncWithContext is the same as CreateIpForwardingRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NATService) CreateIpForwardingRuleAsyncWithContext(ctx context.Context, p *CreateIpForwardingRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createIpForwardingRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateIpForwardingRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateIpForwardingRuleResponse struct {
 	Cidrlist                  string `json:"cidrlist"`
 	Fordisplay                bool   `json:"fordisplay"`
@@ -175,7 +361,8 @@ type DeleteIpForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteIpForwardingRuleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteIpForwardingRuleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -186,6 +373,48 @@ func (p *DeleteIpForwardingRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteIpForwardingRuleParams) Validate() error {
+	v := newParamValidator("deleteIpForwardingRule", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteIpForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteIpForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteIpForwardingRule", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteIpForwardingRule: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteIpForwardingRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,6 +422,15 @@ func (p *DeleteIpForwardingRuleParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteIpForwardingRuleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteIpForwardingRuleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteIpForwardingRuleParams instance,
 // as then you are sure you have configured all required params
 func (s *NATService) NewDeleteIpForwardingRuleParams(id string) *DeleteIpForwardingRuleParams {
@@ -204,7 +442,16 @@ func (s *NATService) NewDeleteIpForwardingRuleParams(id string) *DeleteIpForward
 
 // Deletes an IP forwarding rule
 func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteIpForwardingRule", p.toURLValues())
+	return s.DeleteIpForwardingRuleWithContext(context.Background(), p)
+}
+
+// DeleteIpForwardingRuleWithContext is the same as DeleteIpForwardingRule, but uses ctx to cancel the request and any async job polling
+func (s *NATService) DeleteIpForwardingRuleWithContext(ctx context.Context, p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteIpForwardingRule", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -216,7 +463,7 @@ func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*D
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -232,6 +479,31 @@ func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*D
 	return &r, nil
 }
 
+// DeleteIpForwardingRuleAsync starts the async job for DeleteIpForwardingRule and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteIpForwardingRuleResponse using Job.Result
+func (s *NATService) DeleteIpForwardingRuleAsync(p *DeleteIpForwardingRuleParams) (*Job, error) {
+	return s.DeleteIpForwardingRuleAsyncWithContext(context.Background(), p)
+}
+
+// DeleteIpForwardingRuleAsyncWithContext is the same as DeleteIpForwardingRuleAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NATService) DeleteIpForwardingRuleAsyncWithContext(ctx context.Context, p *DeleteIpForwardingRuleParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteIpForwardingRule", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteIpForwardingRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteIpForwardingRuleResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -243,7 +515,8 @@ type DisableStaticNatParams struct {
 	p map[string]interface{}
 }
 
-func (p *DisableStaticNatParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DisableStaticNatParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -254,6 +527,48 @@ func (p *DisableStaticNatParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DisableStaticNatParams) Validate() error {
+	v := newParamValidator("disableStaticNat", p.p)
+	v.required("ipaddressid")
+	v.ids("ipaddressid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DisableStaticNatParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DisableStaticNatParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "ipaddressid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for disableStaticNat", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of disableStaticNat: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DisableStaticNatParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -261,6 +576,15 @@ func (p *DisableStaticNatParams) SetIpaddressid(v string) {
 	p.p["ipaddressid"] = v
 }
 
+func (p *DisableStaticNatParams) ResetIpaddressid() {
+	delete(p.p, "ipaddressid")
+}
+
+func (p *DisableStaticNatParams) GetIpaddressid() (string, bool) {
+	value, ok := p.p["ipaddressid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DisableStaticNatParams instance,
 // as then you are sure you have configured all required params
 func (s *NATService) NewDisableStaticNatParams(ipaddressid string) *DisableStaticNatParams {
@@ -272,7 +596,16 @@ func (s *NATService) NewDisableStaticNatParams(ipaddressid string) *DisableStati
 
 // Disables static rule for given IP address
 func (s *NATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error) {
-	resp, err := s.cs.newRequest("disableStaticNat", p.toURLValues())
+	return s.DisableStaticNatWithContext(context.Background(), p)
+}
+
+// DisableStaticNatWithContext is the same as DisableStaticNat, but uses ctx to cancel the request and any async job polling
+func (s *NATService) DisableStaticNatWithContext(ctx context.Context, p *DisableStaticNatParams) (*DisableStaticNatResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableStaticNat", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -284,7 +617,7 @@ func (s *NATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStatic
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -300,6 +633,31 @@ func (s *NATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStatic
 	return &r, nil
 }
 
+// DisableStaticNatAsync starts the async job for DisableStaticNat and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DisableStaticNatResponse using Job.Result
+func (s *NATService) DisableStaticNatAsync(p *DisableStaticNatParams) (*Job, error) {
+	return s.DisableStaticNatAsyncWithContext(context.Background(), p)
+}
+
+// DisableStaticNatAsyncWithContext is the same as DisableStaticNatAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NATService) DisableStaticNatAsyncWithContext(ctx context.Context, p *DisableStaticNatParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableStaticNat", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableStaticNatResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DisableStaticNatResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -311,7 +669,8 @@ type EnableStaticNatParams struct {
 	p map[string]interface{}
 }
 
-func (p *EnableStaticNatParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *EnableStaticNatParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -331,6 +690,49 @@ func (p *EnableStaticNatParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *EnableStaticNatParams) Validate() error {
+	v := newParamValidator("enableStaticNat", p.p)
+	v.required("ipaddressid", "virtualmachineid")
+	v.ids("ipaddressid", "networkid", "virtualmachineid")
+	v.maxLength("vmguestip", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *EnableStaticNatParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *EnableStaticNatParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "ipaddressid", "networkid", "virtualmachineid", "vmguestip":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for enableStaticNat", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of enableStaticNat: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *EnableStaticNatParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -338,6 +740,15 @@ func (p *EnableStaticNatParams) SetIpaddressid(v string) {
 	p.p["ipaddressid"] = v
 }
 
+func (p *EnableStaticNatParams) ResetIpaddressid() {
+	delete(p.p, "ipaddressid")
+}
+
+func (p *EnableStaticNatParams) GetIpaddressid() (string, bool) {
+	value, ok := p.p["ipaddressid"].(string)
+	return value, ok
+}
+
 func (p *EnableStaticNatParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -345,6 +756,15 @@ func (p *EnableStaticNatParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *EnableStaticNatParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *EnableStaticNatParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *EnableStaticNatParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -352,6 +772,15 @@ func (p *EnableStaticNatParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *EnableStaticNatParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *EnableStaticNatParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 func (p *EnableStaticNatParams) SetVmguestip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -359,6 +788,15 @@ func (p *EnableStaticNatParams) SetVmguestip(v string) {
 	p.p["vmguestip"] = v
 }
 
+func (p *EnableStaticNatParams) ResetVmguestip() {
+	delete(p.p, "vmguestip")
+}
+
+func (p *EnableStaticNatParams) GetVmguestip() (string, bool) {
+	value, ok := p.p["vmguestip"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new EnableStaticNatParams instance,
 // as then you are sure you have configured all required params
 func (s *NATService) NewEnableStaticNatParams(ipaddressid string, virtualmachineid string) *EnableStaticNatParams {
@@ -371,7 +809,16 @@ func (s *NATService) NewEnableStaticNatParams(ipaddressid string, virtualmachine
 
 // Enables static NAT for given IP address
 func (s *NATService) EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
-	resp, err := s.cs.newRequest("enableStaticNat", p.toURLValues())
+	return s.EnableStaticNatWithContext(context.Background(), p)
+}
+
+// EnableStaticNatWithContext is the same as EnableStaticNat, but uses ctx to cancel the request and any async job polling
+func (s *NATService) EnableStaticNatWithContext(ctx context.Context, p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableStaticNat", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -422,7 +869,8 @@ type ListIpForwardingRulesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListIpForwardingRulesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListIpForwardingRulesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -467,6 +915,57 @@ func (p *ListIpForwardingRulesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListIpForwardingRulesParams) Validate() error {
+	v := newParamValidator("listIpForwardingRules", p.p)
+	v.ids("domainid", "id", "ipaddressid", "projectid", "virtualmachineid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListIpForwardingRulesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListIpForwardingRulesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "ipaddressid", "keyword", "projectid", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listIpForwardingRules", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listIpForwardingRules: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListIpForwardingRulesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -474,6 +973,15 @@ func (p *ListIpForwardingRulesParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListIpForwardingRulesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListIpForwardingRulesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListIpForwardingRulesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -481,6 +989,15 @@ func (p *ListIpForwardingRulesParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListIpForwardingRulesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListIpForwardingRulesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListIpForwardingRulesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -488,6 +1005,15 @@ func (p *ListIpForwardingRulesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListIpForwardingRulesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListIpForwardingRulesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListIpForwardingRulesParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -495,6 +1021,15 @@ func (p *ListIpForwardingRulesParams) SetIpaddressid(v string) {
 	p.p["ipaddressid"] = v
 }
 
+func (p *ListIpForwardingRulesParams) ResetIpaddressid() {
+	delete(p.p, "ipaddressid")
+}
+
+func (p *ListIpForwardingRulesParams) GetIpaddressid() (string, bool) {
+	value, ok := p.p["ipaddressid"].(string)
+	return value, ok
+}
+
 func (p *ListIpForwardingRulesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -502,6 +1037,15 @@ func (p *ListIpForwardingRulesParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListIpForwardingRulesParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListIpForwardingRulesParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListIpForwardingRulesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -509,6 +1053,15 @@ func (p *ListIpForwardingRulesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListIpForwardingRulesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListIpForwardingRulesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListIpForwardingRulesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -516,6 +1069,15 @@ func (p *ListIpForwardingRulesParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListIpForwardingRulesParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListIpForwardingRulesParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListIpForwardingRulesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -523,6 +1085,15 @@ func (p *ListIpForwardingRulesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListIpForwardingRulesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListIpForwardingRulesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListIpForwardingRulesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -530,6 +1101,15 @@ func (p *ListIpForwardingRulesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListIpForwardingRulesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListIpForwardingRulesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListIpForwardingRulesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -537,6 +1117,15 @@ func (p *ListIpForwardingRulesParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListIpForwardingRulesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListIpForwardingRulesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListIpForwardingRulesParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -544,6 +1133,15 @@ func (p *ListIpForwardingRulesParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *ListIpForwardingRulesParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *ListIpForwardingRulesParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListIpForwardingRulesParams instance,
 // as then you are sure you have configured all required params
 func (s *NATService) NewListIpForwardingRulesParams() *ListIpForwardingRulesParams {
@@ -554,6 +1152,11 @@ func (s *NATService) NewListIpForwardingRulesParams() *ListIpForwardingRulesPara
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*IpForwardingRule, int, error) {
+	return s.GetIpForwardingRuleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetIpForwardingRuleByIDWithContext is the same as GetIpForwardingRuleByID, but uses ctx to cancel the request
+func (s *NATService) GetIpForwardingRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*IpForwardingRule, int, error) {
 	p := &ListIpForwardingRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -565,18 +1168,16 @@ func (s *NATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*Ip
 		}
 	}
 
-	l, err := s.ListIpForwardingRules(p)
+	l, err := s.ListIpForwardingRulesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -587,7 +1188,16 @@ func (s *NATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*Ip
 
 // List the IP forwarding rules
 func (s *NATService) ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
-	resp, err := s.cs.newRequest("listIpForwardingRules", p.toURLValues())
+	return s.ListIpForwardingRulesWithContext(context.Background(), p)
+}
+
+// ListIpForwardingRulesWithContext is the same as ListIpForwardingRules, but uses ctx to cancel the request and any async job polling
+func (s *NATService) ListIpForwardingRulesWithContext(ctx context.Context, p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listIpForwardingRules", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -600,6 +1210,92 @@ func (s *NATService) ListIpForwardingRules(p *ListIpForwardingRulesParams) (*Lis
 	return &r, nil
 }
 
+// ListIpForwardingRulesPager iterates over all pages of a ListIpForwardingRules call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListIpForwardingRulesPager struct {
+	pager
+
+	s *NATService
+	p *ListIpForwardingRulesParams
+	r *ListIpForwardingRulesResponse
+}
+
+// NewListIpForwardingRulesPager returns a pager for all pages of a ListIpForwardingRules call with the given params
+func (s *NATService) NewListIpForwardingRulesPager(p *ListIpForwardingRulesParams) *ListIpForwardingRulesPager {
+	return &ListIpForwardingRulesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListIpForwardingRulesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListIpForwardingRulesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListIpForwardingRulesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListIpForwardingRulesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.IpForwardingRules), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListIpForwardingRulesPager) Page() *ListIpForwardingRulesResponse {
+	return pg.r
+}
+
+// ListIpForwardingRulesAll fetches all pages of a ListIpForwardingRules call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NATService) ListIpForwardingRulesAll(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
+	return s.ListIpForwardingRulesAllWithContext(context.Background(), p)
+}
+
+// ListIpForwardingRulesAllWithContext is the same as ListIpForwardingRulesAll, but uses ctx to cancel the requests
+func (s *NATService) ListIpForwardingRulesAllWithContext(ctx context.Context, p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*IpForwardingRule)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListIpForwardingRulesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListIpForwardingRulesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.IpForwardingRules
+		mu.Unlock()
+
+		return len(l.IpForwardingRules), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListIpForwardingRulesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.IpForwardingRules = append(r.IpForwardingRules, pages[page]...)
+	}
+	r.Count = len(r.IpForwardingRules)
+
+	return r, nil
+}
+
 type ListIpForwardingRulesResponse struct {
 	Count             int                 `json:"count"`
 	IpForwardingRules []*IpForwardingRule `json:"ipforwardingrule"`
@@ -0,0 +1,1765 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// NetscalerServiceIface is the interface implemented by NetscalerService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type NetscalerServiceIface interface {
+	NewDeleteNetscalerControlCenterParams(id string) *DeleteNetscalerControlCenterParams
+	DeleteNetscalerControlCenter(p *DeleteNetscalerControlCenterParams) (*DeleteNetscalerControlCenterResponse, error)
+	DeleteNetscalerControlCenterWithContext(ctx context.Context, p *DeleteNetscalerControlCenterParams) (*DeleteNetscalerControlCenterResponse, error)
+	NewDeleteServicePackageOfferingParams(id string) *DeleteServicePackageOfferingParams
+	DeleteServicePackageOffering(p *DeleteServicePackageOfferingParams) (*DeleteServicePackageOfferingResponse, error)
+	DeleteServicePackageOfferingWithContext(ctx context.Context, p *DeleteServicePackageOfferingParams) (*DeleteServicePackageOfferingResponse, error)
+	NewDeployNetscalerVpxParams(serviceofferingid string, templateid string, zoneid string) *DeployNetscalerVpxParams
+	DeployNetscalerVpx(p *DeployNetscalerVpxParams) (*DeployNetscalerVpxResponse, error)
+	DeployNetscalerVpxWithContext(ctx context.Context, p *DeployNetscalerVpxParams) (*DeployNetscalerVpxResponse, error)
+	DeployNetscalerVpxAsync(p *DeployNetscalerVpxParams) (*Job, error)
+	DeployNetscalerVpxAsyncWithContext(ctx context.Context, p *DeployNetscalerVpxParams) (*Job, error)
+	NewListNetscalerControlCenterParams() *ListNetscalerControlCenterParams
+	ListNetscalerControlCenter(p *ListNetscalerControlCenterParams) (*ListNetscalerControlCenterResponse, error)
+	ListNetscalerControlCenterWithContext(ctx context.Context, p *ListNetscalerControlCenterParams) (*ListNetscalerControlCenterResponse, error)
+	NewListNetscalerControlCenterPager(p *ListNetscalerControlCenterParams) *ListNetscalerControlCenterPager
+	ListNetscalerControlCenterAll(p *ListNetscalerControlCenterParams) (*ListNetscalerControlCenterResponse, error)
+	ListNetscalerControlCenterAllWithContext(ctx context.Context, p *ListNetscalerControlCenterParams) (*ListNetscalerControlCenterResponse, error)
+	NewListRegisteredServicePackagesParams() *ListRegisteredServicePackagesParams
+	GetRegisteredServicePackageID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetRegisteredServicePackageIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
+	ListRegisteredServicePackages(p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error)
+	ListRegisteredServicePackagesWithContext(ctx context.Context, p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error)
+	NewListRegisteredServicePackagesPager(p *ListRegisteredServicePackagesParams) *ListRegisteredServicePackagesPager
+	ListRegisteredServicePackagesAll(p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error)
+	ListRegisteredServicePackagesAllWithContext(ctx context.Context, p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error)
+	NewRegisterNetscalerControlCenterParams(ipaddress string, numretries int, password string, username string) *RegisterNetscalerControlCenterParams
+	RegisterNetscalerControlCenter(p *RegisterNetscalerControlCenterParams) (*RegisterNetscalerControlCenterResponse, error)
+	RegisterNetscalerControlCenterWithContext(ctx context.Context, p *RegisterNetscalerControlCenterParams) (*RegisterNetscalerControlCenterResponse, error)
+	RegisterNetscalerControlCenterAsync(p *RegisterNetscalerControlCenterParams) (*Job, error)
+	RegisterNetscalerControlCenterAsyncWithContext(ctx context.Context, p *RegisterNetscalerControlCenterParams) (*Job, error)
+	NewRegisterNetscalerServicePackageParams(description string, name string) *RegisterNetscalerServicePackageParams
+	RegisterNetscalerServicePackage(p *RegisterNetscalerServicePackageParams) (*RegisterNetscalerServicePackageResponse, error)
+	RegisterNetscalerServicePackageWithContext(ctx context.Context, p *RegisterNetscalerServicePackageParams) (*RegisterNetscalerServicePackageResponse, error)
+	NewStopNetScalerVpxParams(id string) *StopNetScalerVpxParams
+	StopNetScalerVpx(p *StopNetScalerVpxParams) (*StopNetScalerVpxResponse, error)
+	StopNetScalerVpxWithContext(ctx context.Context, p *StopNetScalerVpxParams) (*StopNetScalerVpxResponse, error)
+	StopNetScalerVpxAsync(p *StopNetScalerVpxParams) (*Job, error)
+	StopNetScalerVpxAsyncWithContext(ctx context.Context, p *StopNetScalerVpxParams) (*Job, error)
+}
+
+type DeleteNetscalerControlCenterParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteNetscalerControlCenterParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteNetscalerControlCenterParams) Validate() error {
+	v := newParamValidator("deleteNetscalerControlCenter", p.p)
+	v.required("id")
+	v.maxLength("id", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteNetscalerControlCenterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteNetscalerControlCenterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteNetscalerControlCenter", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteNetscalerControlCenter: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteNetscalerControlCenterParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteNetscalerControlCenterParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteNetscalerControlCenterParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteNetscalerControlCenterParams instance,
+// as then you are sure you have configured all required params
+func (s *NetscalerService) NewDeleteNetscalerControlCenterParams(id string) *DeleteNetscalerControlCenterParams {
+	p := &DeleteNetscalerControlCenterParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Delete Netscaler Control Center
+func (s *NetscalerService) DeleteNetscalerControlCenter(p *DeleteNetscalerControlCenterParams) (*DeleteNetscalerControlCenterResponse, error) {
+	return s.DeleteNetscalerControlCenterWithContext(context.Background(), p)
+}
+
+// DeleteNetscalerControlCenterWithContext is the same as DeleteNetscalerControlCenter, but uses ctx to cancel the request and any async job polling
+func (s *NetscalerService) DeleteNetscalerControlCenterWithContext(ctx context.Context, p *DeleteNetscalerControlCenterParams) (*DeleteNetscalerControlCenterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetscalerControlCenter", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteNetscalerControlCenterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteNetscalerControlCenterResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteNetscalerControlCenterResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteNetscalerControlCenterResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type DeleteServicePackageOfferingParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteServicePackageOfferingParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteServicePackageOfferingParams) Validate() error {
+	v := newParamValidator("deleteServicePackageOffering", p.p)
+	v.required("id")
+	v.maxLength("id", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteServicePackageOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteServicePackageOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteServicePackageOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteServicePackageOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteServicePackageOfferingParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteServicePackageOfferingParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteServicePackageOfferingParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteServicePackageOfferingParams instance,
+// as then you are sure you have configured all required params
+func (s *NetscalerService) NewDeleteServicePackageOfferingParams(id string) *DeleteServicePackageOfferingParams {
+	p := &DeleteServicePackageOfferingParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Delete Service Package
+func (s *NetscalerService) DeleteServicePackageOffering(p *DeleteServicePackageOfferingParams) (*DeleteServicePackageOfferingResponse, error) {
+	return s.DeleteServicePackageOfferingWithContext(context.Background(), p)
+}
+
+// DeleteServicePackageOfferingWithContext is the same as DeleteServicePackageOffering, but uses ctx to cancel the request and any async job polling
+func (s *NetscalerService) DeleteServicePackageOfferingWithContext(ctx context.Context, p *DeleteServicePackageOfferingParams) (*DeleteServicePackageOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteServicePackageOffering", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteServicePackageOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteServicePackageOfferingResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteServicePackageOfferingResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteServicePackageOfferingResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type DeployNetscalerVpxParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeployNetscalerVpxParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["networkid"]; found {
+		u.Set("networkid", v.(string))
+	}
+	if v, found := p.p["serviceofferingid"]; found {
+		u.Set("serviceofferingid", v.(string))
+	}
+	if v, found := p.p["templateid"]; found {
+		u.Set("templateid", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeployNetscalerVpxParams) Validate() error {
+	v := newParamValidator("deployNetscalerVpx", p.p)
+	v.required("serviceofferingid", "templateid", "zoneid")
+	v.ids("networkid", "serviceofferingid", "templateid", "zoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeployNetscalerVpxParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeployNetscalerVpxParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "networkid", "serviceofferingid", "templateid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deployNetscalerVpx", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deployNetscalerVpx: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeployNetscalerVpxParams) SetNetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networkid"] = v
+}
+
+func (p *DeployNetscalerVpxParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *DeployNetscalerVpxParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
+func (p *DeployNetscalerVpxParams) SetServiceofferingid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["serviceofferingid"] = v
+}
+
+func (p *DeployNetscalerVpxParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *DeployNetscalerVpxParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
+func (p *DeployNetscalerVpxParams) SetTemplateid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["templateid"] = v
+}
+
+func (p *DeployNetscalerVpxParams) ResetTemplateid() {
+	delete(p.p, "templateid")
+}
+
+func (p *DeployNetscalerVpxParams) GetTemplateid() (string, bool) {
+	value, ok := p.p["templateid"].(string)
+	return value, ok
+}
+
+func (p *DeployNetscalerVpxParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *DeployNetscalerVpxParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *DeployNetscalerVpxParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeployNetscalerVpxParams instance,
+// as then you are sure you have configured all required params
+func (s *NetscalerService) NewDeployNetscalerVpxParams(serviceofferingid string, templateid string, zoneid string) *DeployNetscalerVpxParams {
+	p := &DeployNetscalerVpxParams{}
+	p.p = make(map[string]interface{})
+	p.p["serviceofferingid"] = serviceofferingid
+	p.p["templateid"] = templateid
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// Creates new NS Vpx
+func (s *NetscalerService) DeployNetscalerVpx(p *DeployNetscalerVpxParams) (*DeployNetscalerVpxResponse, error) {
+	return s.DeployNetscalerVpxWithContext(context.Background(), p)
+}
+
+// DeployNetscalerVpxWithContext is the same as DeployNetscalerVpx, but uses ctx to cancel the request and any async job polling
+func (s *NetscalerService) DeployNetscalerVpxWithContext(ctx context.Context, p *DeployNetscalerVpxParams) (*DeployNetscalerVpxResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deployNetscalerVpx", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeployNetscalerVpxResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeployNetscalerVpxAsync starts the async job for DeployNetscalerVpx and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeployNetscalerVpxResponse using Job.Result
+func (s *NetscalerService) DeployNetscalerVpxAsync(p *DeployNetscalerVpxParams) (*Job, error) {
+	return s.DeployNetscalerVpxAsyncWithContext(context.Background(), p)
+}
+
+// DeployNetscalerVpxAsyncWithContext is the same as DeployNetscalerVpxAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetscalerService) DeployNetscalerVpxAsyncWithContext(ctx context.Context, p *DeployNetscalerVpxParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deployNetscalerVpx", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeployNetscalerVpxResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type DeployNetscalerVpxResponse struct {
+	Gslbprovider            bool     `json:"gslbprovider"`
+	Gslbproviderprivateip   string   `json:"gslbproviderprivateip"`
+	Gslbproviderpublicip    string   `json:"gslbproviderpublicip"`
+	Ipaddress               string   `json:"ipaddress"`
+	Isexclusivegslbprovider bool     `json:"isexclusivegslbprovider"`
+	JobID                   string   `json:"jobid"`
+	Jobstatus               int      `json:"jobstatus"`
+	Lbdevicecapacity        int64    `json:"lbdevicecapacity"`
+	Lbdevicededicated       bool     `json:"lbdevicededicated"`
+	Lbdeviceid              string   `json:"lbdeviceid"`
+	Lbdevicename            string   `json:"lbdevicename"`
+	Lbdevicestate           string   `json:"lbdevicestate"`
+	Physicalnetworkid       string   `json:"physicalnetworkid"`
+	Podids                  []string `json:"podids"`
+	Privateinterface        string   `json:"privateinterface"`
+	Provider                string   `json:"provider"`
+	Publicinterface         string   `json:"publicinterface"`
+}
+
+type ListNetscalerControlCenterParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListNetscalerControlCenterParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListNetscalerControlCenterParams) Validate() error {
+	v := newParamValidator("listNetscalerControlCenter", p.p)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListNetscalerControlCenterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListNetscalerControlCenterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listNetscalerControlCenter", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listNetscalerControlCenter: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListNetscalerControlCenterParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListNetscalerControlCenterParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListNetscalerControlCenterParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListNetscalerControlCenterParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListNetscalerControlCenterParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListNetscalerControlCenterParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListNetscalerControlCenterParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListNetscalerControlCenterParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListNetscalerControlCenterParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+// You should always use this function to get a new ListNetscalerControlCenterParams instance,
+// as then you are sure you have configured all required params
+func (s *NetscalerService) NewListNetscalerControlCenterParams() *ListNetscalerControlCenterParams {
+	p := &ListNetscalerControlCenterParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// list control center
+func (s *NetscalerService) ListNetscalerControlCenter(p *ListNetscalerControlCenterParams) (*ListNetscalerControlCenterResponse, error) {
+	return s.ListNetscalerControlCenterWithContext(context.Background(), p)
+}
+
+// ListNetscalerControlCenterWithContext is the same as ListNetscalerControlCenter, but uses ctx to cancel the request and any async job polling
+func (s *NetscalerService) ListNetscalerControlCenterWithContext(ctx context.Context, p *ListNetscalerControlCenterParams) (*ListNetscalerControlCenterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetscalerControlCenter", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListNetscalerControlCenterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListNetscalerControlCenterPager iterates over all pages of a ListNetscalerControlCenter call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListNetscalerControlCenterPager struct {
+	pager
+
+	s *NetscalerService
+	p *ListNetscalerControlCenterParams
+	r *ListNetscalerControlCenterResponse
+}
+
+// NewListNetscalerControlCenterPager returns a pager for all pages of a ListNetscalerControlCenter call with the given params
+func (s *NetscalerService) NewListNetscalerControlCenterPager(p *ListNetscalerControlCenterParams) *ListNetscalerControlCenterPager {
+	return &ListNetscalerControlCenterPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListNetscalerControlCenterPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListNetscalerControlCenterPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListNetscalerControlCenterParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListNetscalerControlCenterWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.NetscalerControlCenter), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListNetscalerControlCenterPager) Page() *ListNetscalerControlCenterResponse {
+	return pg.r
+}
+
+// ListNetscalerControlCenterAll fetches all pages of a ListNetscalerControlCenter call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetscalerService) ListNetscalerControlCenterAll(p *ListNetscalerControlCenterParams) (*ListNetscalerControlCenterResponse, error) {
+	return s.ListNetscalerControlCenterAllWithContext(context.Background(), p)
+}
+
+// ListNetscalerControlCenterAllWithContext is the same as ListNetscalerControlCenterAll, but uses ctx to cancel the requests
+func (s *NetscalerService) ListNetscalerControlCenterAllWithContext(ctx context.Context, p *ListNetscalerControlCenterParams) (*ListNetscalerControlCenterResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*NetscalerControlCenter)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListNetscalerControlCenterParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListNetscalerControlCenterWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.NetscalerControlCenter
+		mu.Unlock()
+
+		return len(l.NetscalerControlCenter), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListNetscalerControlCenterResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.NetscalerControlCenter = append(r.NetscalerControlCenter, pages[page]...)
+	}
+	r.Count = len(r.NetscalerControlCenter)
+
+	return r, nil
+}
+
+type ListNetscalerControlCenterResponse struct {
+	Count                  int                       `json:"count"`
+	NetscalerControlCenter []*NetscalerControlCenter `json:"netscalercontrolcenter"`
+}
+
+type NetscalerControlCenter struct {
+	Id         string `json:"id"`
+	Ipaddress  string `json:"ipaddress"`
+	JobID      string `json:"jobid"`
+	Jobstatus  int    `json:"jobstatus"`
+	Numretries string `json:"numretries"`
+	Username   string `json:"username"`
+	Uuid       string `json:"uuid"`
+}
+
+type ListRegisteredServicePackagesParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListRegisteredServicePackagesParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListRegisteredServicePackagesParams) Validate() error {
+	v := newParamValidator("listRegisteredServicePackages", p.p)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListRegisteredServicePackagesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListRegisteredServicePackagesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listRegisteredServicePackages", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listRegisteredServicePackages: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListRegisteredServicePackagesParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListRegisteredServicePackagesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListRegisteredServicePackagesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListRegisteredServicePackagesParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListRegisteredServicePackagesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListRegisteredServicePackagesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListRegisteredServicePackagesParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListRegisteredServicePackagesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListRegisteredServicePackagesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+// You should always use this function to get a new ListRegisteredServicePackagesParams instance,
+// as then you are sure you have configured all required params
+func (s *NetscalerService) NewListRegisteredServicePackagesParams() *ListRegisteredServicePackagesParams {
+	p := &ListRegisteredServicePackagesParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *NetscalerService) GetRegisteredServicePackageID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetRegisteredServicePackageIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetRegisteredServicePackageIDWithContext is the same as GetRegisteredServicePackageID, but uses ctx to cancel the request
+func (s *NetscalerService) GetRegisteredServicePackageIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	p := &ListRegisteredServicePackagesParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["keyword"] = keyword
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListRegisteredServicePackagesWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.RegisteredServicePackages[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.RegisteredServicePackages {
+			if v.Name == keyword {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// lists registered service packages
+func (s *NetscalerService) ListRegisteredServicePackages(p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error) {
+	return s.ListRegisteredServicePackagesWithContext(context.Background(), p)
+}
+
+// ListRegisteredServicePackagesWithContext is the same as ListRegisteredServicePackages, but uses ctx to cancel the request and any async job polling
+func (s *NetscalerService) ListRegisteredServicePackagesWithContext(ctx context.Context, p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listRegisteredServicePackages", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListRegisteredServicePackagesResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListRegisteredServicePackagesPager iterates over all pages of a ListRegisteredServicePackages call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListRegisteredServicePackagesPager struct {
+	pager
+
+	s *NetscalerService
+	p *ListRegisteredServicePackagesParams
+	r *ListRegisteredServicePackagesResponse
+}
+
+// NewListRegisteredServicePackagesPager returns a pager for all pages of a ListRegisteredServicePackages call with the given params
+func (s *NetscalerService) NewListRegisteredServicePackagesPager(p *ListRegisteredServicePackagesParams) *ListRegisteredServicePackagesPager {
+	return &ListRegisteredServicePackagesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListRegisteredServicePackagesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListRegisteredServicePackagesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListRegisteredServicePackagesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListRegisteredServicePackagesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.RegisteredServicePackages), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListRegisteredServicePackagesPager) Page() *ListRegisteredServicePackagesResponse {
+	return pg.r
+}
+
+// ListRegisteredServicePackagesAll fetches all pages of a ListRegisteredServicePackages call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetscalerService) ListRegisteredServicePackagesAll(p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error) {
+	return s.ListRegisteredServicePackagesAllWithContext(context.Background(), p)
+}
+
+// ListRegisteredServicePackagesAllWithContext is the same as ListRegisteredServicePackagesAll, but uses ctx to cancel the requests
+func (s *NetscalerService) ListRegisteredServicePackagesAllWithContext(ctx context.Context, p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*RegisteredServicePackage)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListRegisteredServicePackagesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListRegisteredServicePackagesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.RegisteredServicePackages
+		mu.Unlock()
+
+		return len(l.RegisteredServicePackages), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListRegisteredServicePackagesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.RegisteredServicePackages = append(r.RegisteredServicePackages, pages[page]...)
+	}
+	r.Count = len(r.RegisteredServicePackages)
+
+	return r, nil
+}
+
+type ListRegisteredServicePackagesResponse struct {
+	Count                     int                         `json:"count"`
+	RegisteredServicePackages []*RegisteredServicePackage `json:"registeredservicepackage"`
+}
+
+type RegisteredServicePackage struct {
+	Description string `json:"description"`
+	Id          string `json:"id"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Name        string `json:"name"`
+}
+
+type RegisterNetscalerControlCenterParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RegisterNetscalerControlCenterParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["ipaddress"]; found {
+		u.Set("ipaddress", v.(string))
+	}
+	if v, found := p.p["numretries"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("numretries", vv)
+	}
+	if v, found := p.p["password"]; found {
+		u.Set("password", v.(string))
+	}
+	if v, found := p.p["username"]; found {
+		u.Set("username", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RegisterNetscalerControlCenterParams) Validate() error {
+	v := newParamValidator("registerNetscalerControlCenter", p.p)
+	v.required("ipaddress", "numretries", "password", "username")
+	v.maxLength("ipaddress", 255)
+	v.maxLength("password", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RegisterNetscalerControlCenterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RegisterNetscalerControlCenterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "ipaddress", "password", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "numretries":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for registerNetscalerControlCenter", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of registerNetscalerControlCenter: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *RegisterNetscalerControlCenterParams) SetIpaddress(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ipaddress"] = v
+}
+
+func (p *RegisterNetscalerControlCenterParams) ResetIpaddress() {
+	delete(p.p, "ipaddress")
+}
+
+func (p *RegisterNetscalerControlCenterParams) GetIpaddress() (string, bool) {
+	value, ok := p.p["ipaddress"].(string)
+	return value, ok
+}
+
+func (p *RegisterNetscalerControlCenterParams) SetNumretries(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["numretries"] = v
+}
+
+func (p *RegisterNetscalerControlCenterParams) ResetNumretries() {
+	delete(p.p, "numretries")
+}
+
+func (p *RegisterNetscalerControlCenterParams) GetNumretries() (int, bool) {
+	value, ok := p.p["numretries"].(int)
+	return value, ok
+}
+
+func (p *RegisterNetscalerControlCenterParams) SetPassword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["password"] = v
+}
+
+func (p *RegisterNetscalerControlCenterParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *RegisterNetscalerControlCenterParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
+func (p *RegisterNetscalerControlCenterParams) SetUsername(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["username"] = v
+}
+
+func (p *RegisterNetscalerControlCenterParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *RegisterNetscalerControlCenterParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new RegisterNetscalerControlCenterParams instance,
+// as then you are sure you have configured all required params
+func (s *NetscalerService) NewRegisterNetscalerControlCenterParams(ipaddress string, numretries int, password string, username string) *RegisterNetscalerControlCenterParams {
+	p := &RegisterNetscalerControlCenterParams{}
+	p.p = make(map[string]interface{})
+	p.p["ipaddress"] = ipaddress
+	p.p["numretries"] = numretries
+	p.p["password"] = password
+	p.p["username"] = username
+	return p
+}
+
+// Adds a netscaler control center device
+func (s *NetscalerService) RegisterNetscalerControlCenter(p *RegisterNetscalerControlCenterParams) (*RegisterNetscalerControlCenterResponse, error) {
+	return s.RegisterNetscalerControlCenterWithContext(context.Background(), p)
+}
+
+// RegisterNetscalerControlCenterWithContext is the same as RegisterNetscalerControlCenter, but uses ctx to cancel the request and any async job polling
+func (s *NetscalerService) RegisterNetscalerControlCenterWithContext(ctx context.Context, p *RegisterNetscalerControlCenterParams) (*RegisterNetscalerControlCenterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "registerNetscalerControlCenter", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RegisterNetscalerControlCenterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// RegisterNetscalerControlCenterAsync starts the async job for RegisterNetscalerControlCenter and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RegisterNetscalerControlCenterResponse using Job.Result
+func (s *NetscalerService) RegisterNetscalerControlCenterAsync(p *RegisterNetscalerControlCenterParams) (*Job, error) {
+	return s.RegisterNetscalerControlCenterAsyncWithContext(context.Background(), p)
+}
+
+// RegisterNetscalerControlCenterAsyncWithContext is the same as RegisterNetscalerControlCenterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetscalerService) RegisterNetscalerControlCenterAsyncWithContext(ctx context.Context, p *RegisterNetscalerControlCenterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "registerNetscalerControlCenter", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RegisterNetscalerControlCenterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type RegisterNetscalerControlCenterResponse struct {
+	Gslbprovider            bool     `json:"gslbprovider"`
+	Gslbproviderprivateip   string   `json:"gslbproviderprivateip"`
+	Gslbproviderpublicip    string   `json:"gslbproviderpublicip"`
+	Ipaddress               string   `json:"ipaddress"`
+	Isexclusivegslbprovider bool     `json:"isexclusivegslbprovider"`
+	JobID                   string   `json:"jobid"`
+	Jobstatus               int      `json:"jobstatus"`
+	Lbdevicecapacity        int64    `json:"lbdevicecapacity"`
+	Lbdevicededicated       bool     `json:"lbdevicededicated"`
+	Lbdeviceid              string   `json:"lbdeviceid"`
+	Lbdevicename            string   `json:"lbdevicename"`
+	Lbdevicestate           string   `json:"lbdevicestate"`
+	Physicalnetworkid       string   `json:"physicalnetworkid"`
+	Podids                  []string `json:"podids"`
+	Privateinterface        string   `json:"privateinterface"`
+	Provider                string   `json:"provider"`
+	Publicinterface         string   `json:"publicinterface"`
+}
+
+type RegisterNetscalerServicePackageParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RegisterNetscalerServicePackageParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["description"]; found {
+		u.Set("description", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RegisterNetscalerServicePackageParams) Validate() error {
+	v := newParamValidator("registerNetscalerServicePackage", p.p)
+	v.required("description", "name")
+	v.maxLength("description", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RegisterNetscalerServicePackageParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RegisterNetscalerServicePackageParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for registerNetscalerServicePackage", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of registerNetscalerServicePackage: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *RegisterNetscalerServicePackageParams) SetDescription(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["description"] = v
+}
+
+func (p *RegisterNetscalerServicePackageParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *RegisterNetscalerServicePackageParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
+func (p *RegisterNetscalerServicePackageParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *RegisterNetscalerServicePackageParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *RegisterNetscalerServicePackageParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new RegisterNetscalerServicePackageParams instance,
+// as then you are sure you have configured all required params
+func (s *NetscalerService) NewRegisterNetscalerServicePackageParams(description string, name string) *RegisterNetscalerServicePackageParams {
+	p := &RegisterNetscalerServicePackageParams{}
+	p.p = make(map[string]interface{})
+	p.p["description"] = description
+	p.p["name"] = name
+	return p
+}
+
+// Registers NCC Service Package
+func (s *NetscalerService) RegisterNetscalerServicePackage(p *RegisterNetscalerServicePackageParams) (*RegisterNetscalerServicePackageResponse, error) {
+	return s.RegisterNetscalerServicePackageWithContext(context.Background(), p)
+}
+
+// RegisterNetscalerServicePackageWithContext is the same as RegisterNetscalerServicePackage, but uses ctx to cancel the request and any async job polling
+func (s *NetscalerService) RegisterNetscalerServicePackageWithContext(ctx context.Context, p *RegisterNetscalerServicePackageParams) (*RegisterNetscalerServicePackageResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "registerNetscalerServicePackage", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RegisterNetscalerServicePackageResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type RegisterNetscalerServicePackageResponse struct {
+	Description string `json:"description"`
+	Id          string `json:"id"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Name        string `json:"name"`
+}
+
+type StopNetScalerVpxParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *StopNetScalerVpxParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["forced"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("forced", vv)
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *StopNetScalerVpxParams) Validate() error {
+	v := newParamValidator("stopNetScalerVpx", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *StopNetScalerVpxParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *StopNetScalerVpxParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "forced":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for stopNetScalerVpx", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of stopNetScalerVpx: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *StopNetScalerVpxParams) SetForced(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["forced"] = v
+}
+
+func (p *StopNetScalerVpxParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *StopNetScalerVpxParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
+func (p *StopNetScalerVpxParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *StopNetScalerVpxParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *StopNetScalerVpxParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new StopNetScalerVpxParams instance,
+// as then you are sure you have configured all required params
+func (s *NetscalerService) NewStopNetScalerVpxParams(id string) *StopNetScalerVpxParams {
+	p := &StopNetScalerVpxParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Stops a NetScalervm.
+func (s *NetscalerService) StopNetScalerVpx(p *StopNetScalerVpxParams) (*StopNetScalerVpxResponse, error) {
+	return s.StopNetScalerVpxWithContext(context.Background(), p)
+}
+
+// StopNetScalerVpxWithContext is the same as StopNetScalerVpx, but uses ctx to cancel the request and any async job polling
+func (s *NetscalerService) StopNetScalerVpxWithContext(ctx context.Context, p *StopNetScalerVpxParams) (*StopNetScalerVpxResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "stopNetScalerVpx", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StopNetScalerVpxResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// StopNetScalerVpxAsync starts the async job for StopNetScalerVpx and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a StopNetScalerVpxResponse using Job.Result
+func (s *NetscalerService) StopNetScalerVpxAsync(p *StopNetScalerVpxParams) (*Job, error) {
+	return s.StopNetScalerVpxAsyncWithContext(context.Background(), p)
+}
+
+// StopNetScalerVpxAsyncWithContext is the same as StopNetScalerVpxAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetscalerService) StopNetScalerVpxAsyncWithContext(ctx context.Context, p *StopNetScalerVpxParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "stopNetScalerVpx", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StopNetScalerVpxResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type StopNetScalerVpxResponse struct {
+	Account             string                                       `json:"account"`
+	Created             string                                       `json:"created"`
+	Dns1                string                                       `json:"dns1"`
+	Dns2                string                                       `json:"dns2"`
+	Domain              string                                       `json:"domain"`
+	Domainid            string                                       `json:"domainid"`
+	Gateway             string                                       `json:"gateway"`
+	Guestipaddress      string                                       `json:"guestipaddress"`
+	Guestmacaddress     string                                       `json:"guestmacaddress"`
+	Guestnetmask        string                                       `json:"guestnetmask"`
+	Guestnetworkid      string                                       `json:"guestnetworkid"`
+	Guestnetworkname    string                                       `json:"guestnetworkname"`
+	Healthcheckresults  []StopNetScalerVpxResponseHealthcheckresults `json:"healthcheckresults"`
+	Healthchecksfailed  bool                                         `json:"healthchecksfailed"`
+	Hostid              string                                       `json:"hostid"`
+	Hostname            string                                       `json:"hostname"`
+	Hypervisor          string                                       `json:"hypervisor"`
+	Id                  string                                       `json:"id"`
+	Ip6dns1             string                                       `json:"ip6dns1"`
+	Ip6dns2             string                                       `json:"ip6dns2"`
+	Isredundantrouter   bool                                         `json:"isredundantrouter"`
+	JobID               string                                       `json:"jobid"`
+	Jobstatus           int                                          `json:"jobstatus"`
+	Linklocalip         string                                       `json:"linklocalip"`
+	Linklocalmacaddress string                                       `json:"linklocalmacaddress"`
+	Linklocalnetmask    string                                       `json:"linklocalnetmask"`
+	Linklocalnetworkid  string                                       `json:"linklocalnetworkid"`
+	Name                string                                       `json:"name"`
+	Networkdomain       string                                       `json:"networkdomain"`
+	Nic                 []Nic                                        `json:"nic"`
+	Podid               string                                       `json:"podid"`
+	Podname             string                                       `json:"podname"`
+	Project             string                                       `json:"project"`
+	Projectid           string                                       `json:"projectid"`
+	Publicip            string                                       `json:"publicip"`
+	Publicmacaddress    string                                       `json:"publicmacaddress"`
+	Publicnetmask       string                                       `json:"publicnetmask"`
+	Publicnetworkid     string                                       `json:"publicnetworkid"`
+	Redundantstate      string                                       `json:"redundantstate"`
+	Requiresupgrade     bool                                         `json:"requiresupgrade"`
+	Role                string                                       `json:"role"`
+	Scriptsversion      string                                       `json:"scriptsversion"`
+	Serviceofferingid   string                                       `json:"serviceofferingid"`
+	Serviceofferingname string                                       `json:"serviceofferingname"`
+	State               string                                       `json:"state"`
+	Templateid          string                                       `json:"templateid"`
+	Templatename        string                                       `json:"templatename"`
+	Version             string                                       `json:"version"`
+	Vpcid               string                                       `json:"vpcid"`
+	Vpcname             string                                       `json:"vpcname"`
+	Zoneid              string                                       `json:"zoneid"`
+	Zonename            string                                       `json:"zonename"`
+}
+
+type StopNetScalerVpxResponseHealthcheckresults struct {
+	Checkname   string `json:"checkname"`
+	Checktype   string `json:"checktype"`
+	Details     string `json:"details"`
+	Lastupdated string `json:"lastupdated"`
+	Success     bool   `json:"success"`
+}
@@ -20,18 +20,86 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// NetworkACLServiceIface is the interface implemented by NetworkACLService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type NetworkACLServiceIface interface {
+	NewCreateNetworkACLParams(protocol string) *CreateNetworkACLParams
+	CreateNetworkACL(p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error)
+	CreateNetworkACLWithContext(ctx context.Context, p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error)
+	CreateNetworkACLAsync(p *CreateNetworkACLParams) (*Job, error)
+	CreateNetworkACLAsyncWithContext(ctx context.Context, p *CreateNetworkACLParams) (*Job, error)
+	NewCreateNetworkACLListParams(name string, vpcid string) *CreateNetworkACLListParams
+	CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error)
+	CreateNetworkACLListWithContext(ctx context.Context, p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error)
+	CreateNetworkACLListAsync(p *CreateNetworkACLListParams) (*Job, error)
+	CreateNetworkACLListAsyncWithContext(ctx context.Context, p *CreateNetworkACLListParams) (*Job, error)
+	NewDeleteNetworkACLParams(id string) *DeleteNetworkACLParams
+	DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error)
+	DeleteNetworkACLWithContext(ctx context.Context, p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error)
+	DeleteNetworkACLAsync(p *DeleteNetworkACLParams) (*Job, error)
+	DeleteNetworkACLAsyncWithContext(ctx context.Context, p *DeleteNetworkACLParams) (*Job, error)
+	NewDeleteNetworkACLListParams(id string) *DeleteNetworkACLListParams
+	DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error)
+	DeleteNetworkACLListWithContext(ctx context.Context, p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error)
+	DeleteNetworkACLListAsync(p *DeleteNetworkACLListParams) (*Job, error)
+	DeleteNetworkACLListAsyncWithContext(ctx context.Context, p *DeleteNetworkACLListParams) (*Job, error)
+	NewListNetworkACLListsParams() *ListNetworkACLListsParams
+	GetNetworkACLListID(name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkACLListIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkACLListByName(name string, opts ...OptionFunc) (*NetworkACLList, int, error)
+	GetNetworkACLListByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*NetworkACLList, int, error)
+	GetNetworkACLListByID(id string, opts ...OptionFunc) (*NetworkACLList, int, error)
+	GetNetworkACLListByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkACLList, int, error)
+	ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error)
+	ListNetworkACLListsWithContext(ctx context.Context, p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error)
+	NewListNetworkACLListsPager(p *ListNetworkACLListsParams) *ListNetworkACLListsPager
+	ListNetworkACLListsAll(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error)
+	ListNetworkACLListsAllWithContext(ctx context.Context, p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error)
+	NewListNetworkACLsParams() *ListNetworkACLsParams
+	GetNetworkACLByID(id string, opts ...OptionFunc) (*NetworkACL, int, error)
+	GetNetworkACLByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkACL, int, error)
+	ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error)
+	ListNetworkACLsWithContext(ctx context.Context, p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error)
+	NewListNetworkACLsPager(p *ListNetworkACLsParams) *ListNetworkACLsPager
+	ListNetworkACLsAll(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error)
+	ListNetworkACLsAllWithContext(ctx context.Context, p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error)
+	NewMoveNetworkAclItemParams(id string) *MoveNetworkAclItemParams
+	MoveNetworkAclItem(p *MoveNetworkAclItemParams) (*MoveNetworkAclItemResponse, error)
+	MoveNetworkAclItemWithContext(ctx context.Context, p *MoveNetworkAclItemParams) (*MoveNetworkAclItemResponse, error)
+	MoveNetworkAclItemAsync(p *MoveNetworkAclItemParams) (*Job, error)
+	MoveNetworkAclItemAsyncWithContext(ctx context.Context, p *MoveNetworkAclItemParams) (*Job, error)
+	NewReplaceNetworkACLListParams(aclid string) *ReplaceNetworkACLListParams
+	ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error)
+	ReplaceNetworkACLListWithContext(ctx context.Context, p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error)
+	ReplaceNetworkACLListAsync(p *ReplaceNetworkACLListParams) (*Job, error)
+	ReplaceNetworkACLListAsyncWithContext(ctx context.Context, p *ReplaceNetworkACLListParams) (*Job, error)
+	NewUpdateNetworkACLItemParams(id string) *UpdateNetworkACLItemParams
+	UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error)
+	UpdateNetworkACLItemWithContext(ctx context.Context, p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error)
+	UpdateNetworkACLItemAsync(p *UpdateNetworkACLItemParams) (*Job, error)
+	UpdateNetworkACLItemAsyncWithContext(ctx context.Context, p *UpdateNetworkACLItemParams) (*Job, error)
+	NewUpdateNetworkACLListParams(id string) *UpdateNetworkACLListParams
+	UpdateNetworkACLList(p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error)
+	UpdateNetworkACLListWithContext(ctx context.Context, p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error)
+	UpdateNetworkACLListAsync(p *UpdateNetworkACLListParams) (*Job, error)
+	UpdateNetworkACLListAsyncWithContext(ctx context.Context, p *UpdateNetworkACLListParams) (*Job, error)
+}
+
 type CreateNetworkACLParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateNetworkACLParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateNetworkACLParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -85,6 +153,64 @@ func (p *CreateNetworkACLParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateNetworkACLParams) Validate() error {
+	v := newParamValidator("createNetworkACL", p.p)
+	v.required("protocol")
+	v.ids("aclid", "networkid")
+	v.maxLength("action", 255)
+	v.maxLength("protocol", 255)
+	v.maxLength("reason", 255)
+	v.maxLength("traffictype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateNetworkACLParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateNetworkACLParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "aclid", "action", "networkid", "protocol", "reason", "traffictype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "cidrlist":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "endport", "icmpcode", "icmptype", "number", "startport":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createNetworkACL", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createNetworkACL: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateNetworkACLParams) SetAclid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -92,6 +218,15 @@ func (p *CreateNetworkACLParams) SetAclid(v string) {
 	p.p["aclid"] = v
 }
 
+func (p *CreateNetworkACLParams) ResetAclid() {
+	delete(p.p, "aclid")
+}
+
+func (p *CreateNetworkACLParams) GetAclid() (string, bool) {
+	value, ok := p.p["aclid"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkACLParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -99,6 +234,15 @@ func (p *CreateNetworkACLParams) SetAction(v string) {
 	p.p["action"] = v
 }
 
+func (p *CreateNetworkACLParams) ResetAction() {
+	delete(p.p, "action")
+}
+
+func (p *CreateNetworkACLParams) GetAction() (string, bool) {
+	value, ok := p.p["action"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkACLParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -106,6 +250,15 @@ func (p *CreateNetworkACLParams) SetCidrlist(v []string) {
 	p.p["cidrlist"] = v
 }
 
+func (p *CreateNetworkACLParams) ResetCidrlist() {
+	delete(p.p, "cidrlist")
+}
+
+func (p *CreateNetworkACLParams) GetCidrlist() ([]string, bool) {
+	value, ok := p.p["cidrlist"].([]string)
+	return value, ok
+}
+
 func (p *CreateNetworkACLParams) SetEndport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -113,6 +266,15 @@ func (p *CreateNetworkACLParams) SetEndport(v int) {
 	p.p["endport"] = v
 }
 
+func (p *CreateNetworkACLParams) ResetEndport() {
+	delete(p.p, "endport")
+}
+
+func (p *CreateNetworkACLParams) GetEndport() (int, bool) {
+	value, ok := p.p["endport"].(int)
+	return value, ok
+}
+
 func (p *CreateNetworkACLParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -120,6 +282,15 @@ func (p *CreateNetworkACLParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *CreateNetworkACLParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateNetworkACLParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *CreateNetworkACLParams) SetIcmpcode(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -127,6 +298,15 @@ func (p *CreateNetworkACLParams) SetIcmpcode(v int) {
 	p.p["icmpcode"] = v
 }
 
+func (p *CreateNetworkACLParams) ResetIcmpcode() {
+	delete(p.p, "icmpcode")
+}
+
+func (p *CreateNetworkACLParams) GetIcmpcode() (int, bool) {
+	value, ok := p.p["icmpcode"].(int)
+	return value, ok
+}
+
 func (p *CreateNetworkACLParams) SetIcmptype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -134,6 +314,15 @@ func (p *CreateNetworkACLParams) SetIcmptype(v int) {
 	p.p["icmptype"] = v
 }
 
+func (p *CreateNetworkACLParams) ResetIcmptype() {
+	delete(p.p, "icmptype")
+}
+
+func (p *CreateNetworkACLParams) GetIcmptype() (int, bool) {
+	value, ok := p.p["icmptype"].(int)
+	return value, ok
+}
+
 func (p *CreateNetworkACLParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -141,6 +330,15 @@ func (p *CreateNetworkACLParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *CreateNetworkACLParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *CreateNetworkACLParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkACLParams) SetNumber(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -148,6 +346,15 @@ func (p *CreateNetworkACLParams) SetNumber(v int) {
 	p.p["number"] = v
 }
 
+func (p *CreateNetworkACLParams) ResetNumber() {
+	delete(p.p, "number")
+}
+
+func (p *CreateNetworkACLParams) GetNumber() (int, bool) {
+	value, ok := p.p["number"].(int)
+	return value, ok
+}
+
 func (p *CreateNetworkACLParams) SetProtocol(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -155,6 +362,15 @@ func (p *CreateNetworkACLParams) SetProtocol(v string) {
 	p.p["protocol"] = v
 }
 
+func (p *CreateNetworkACLParams) ResetProtocol() {
+	delete(p.p, "protocol")
+}
+
+func (p *CreateNetworkACLParams) GetProtocol() (string, bool) {
+	value, ok := p.p["protocol"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkACLParams) SetReason(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -162,6 +378,15 @@ func (p *CreateNetworkACLParams) SetReason(v string) {
 	p.p["reason"] = v
 }
 
+func (p *CreateNetworkACLParams) ResetReason() {
+	delete(p.p, "reason")
+}
+
+func (p *CreateNetworkACLParams) GetReason() (string, bool) {
+	value, ok := p.p["reason"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkACLParams) SetStartport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -169,6 +394,15 @@ func (p *CreateNetworkACLParams) SetStartport(v int) {
 	p.p["startport"] = v
 }
 
+func (p *CreateNetworkACLParams) ResetStartport() {
+	delete(p.p, "startport")
+}
+
+func (p *CreateNetworkACLParams) GetStartport() (int, bool) {
+	value, ok := p.p["startport"].(int)
+	return value, ok
+}
+
 func (p *CreateNetworkACLParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -176,6 +410,15 @@ func (p *CreateNetworkACLParams) SetTraffictype(v string) {
 	p.p["traffictype"] = v
 }
 
+func (p *CreateNetworkACLParams) ResetTraffictype() {
+	delete(p.p, "traffictype")
+}
+
+func (p *CreateNetworkACLParams) GetTraffictype() (string, bool) {
+	value, ok := p.p["traffictype"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateNetworkACLParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewCreateNetworkACLParams(protocol string) *CreateNetworkACLParams {
@@ -187,7 +430,16 @@ func (s *NetworkACLService) NewCreateNetworkACLParams(protocol string) *CreateNe
 
 // Creates a ACL rule in the given network (the network has to belong to VPC)
 func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkACL", p.toURLValues())
+	return s.CreateNetworkACLWithContext(context.Background(), p)
+}
+
+// CreateNetworkACLWithContext is the same as CreateNetworkACL, but uses ctx to cancel the request and any async job polling
+func (s *NetworkACLService) CreateNetworkACLWithContext(ctx context.Context, p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createNetworkACL", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -199,7 +451,7 @@ func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams) (*Create
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -220,6 +472,40 @@ func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams) (*Create
 	return &r, nil
 }
 
+// CreateNetworkACLAsync starts the async job for CreateNetworkACL and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateNetworkACLResponse using Job.Result
+func (s *NetworkACLService) CreateNetworkACLAsync(p *CreateNetworkACLParams) (*Job, error) {
+	return s.CreateNetworkACLAsyncWithContext(context.Background(), p)
+}
+
+// CreateNetworkACLAsyncWithContext is the same as CreateNetworkACLAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkACLService) CreateNetworkACLAsyncWithContext(ctx context.Context, p *CreateNetworkACLParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createNetworkACL", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateNetworkACLResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateNetworkACLResponse struct {
 	Aclid       string `json:"aclid"`
 	Aclname     string `json:"aclname"`
@@ -245,7 +531,8 @@ type CreateNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateNetworkACLListParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateNetworkACLListParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -266,6 +553,54 @@ func (p *CreateNetworkACLListParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateNetworkACLListParams) Validate() error {
+	v := newParamValidator("createNetworkACLList", p.p)
+	v.required("name", "vpcid")
+	v.ids("vpcid")
+	v.maxLength("description", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateNetworkACLListParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateNetworkACLListParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "name", "vpcid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createNetworkACLList", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createNetworkACLList: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateNetworkACLListParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -273,6 +608,15 @@ func (p *CreateNetworkACLListParams) SetDescription(v string) {
 	p.p["description"] = v
 }
 
+func (p *CreateNetworkACLListParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *CreateNetworkACLListParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkACLListParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -280,6 +624,15 @@ func (p *CreateNetworkACLListParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *CreateNetworkACLListParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateNetworkACLListParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *CreateNetworkACLListParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -287,6 +640,15 @@ func (p *CreateNetworkACLListParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateNetworkACLListParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateNetworkACLListParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkACLListParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -294,6 +656,15 @@ func (p *CreateNetworkACLListParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *CreateNetworkACLListParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *CreateNetworkACLListParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateNetworkACLListParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewCreateNetworkACLListParams(name string, vpcid string) *CreateNetworkACLListParams {
@@ -306,7 +677,16 @@ func (s *NetworkACLService) NewCreateNetworkACLListParams(name string, vpcid str
 
 // Creates a network ACL for the given VPC
 func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkACLList", p.toURLValues())
+	return s.CreateNetworkACLListWithContext(context.Background(), p)
+}
+
+// CreateNetworkACLListWithContext is the same as CreateNetworkACLList, but uses ctx to cancel the request and any async job polling
+func (s *NetworkACLService) CreateNetworkACLListWithContext(ctx context.Context, p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createNetworkACLList", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -318,7 +698,7 @@ func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -339,6 +719,40 @@ func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams)
 	return &r, nil
 }
 
+// CreateNetworkACLListAsync starts the async job for CreateNetworkACLList and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateNetworkACLListResponse using Job.Result
+func (s *NetworkACLService) CreateNetworkACLListAsync(p *CreateNetworkACLListParams) (*Job, error) {
+	return s.CreateNetworkACLListAsyncWithContext(context.Background(), p)
+}
+
+// CreateNetworkACLListAsyncWithContext is the same as CreateNetworkACLListAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkACLService) CreateNetworkACLListAsyncWithContext(ctx context.Context, p *CreateNetworkACLListParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createNetworkACLList", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateNetworkACLListResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateNetworkACLListResponse struct {
 	Description string `json:"description"`
 	Fordisplay  bool   `json:"fordisplay"`
@@ -353,7 +767,8 @@ type DeleteNetworkACLParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteNetworkACLParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteNetworkACLParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -364,6 +779,48 @@ func (p *DeleteNetworkACLParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteNetworkACLParams) Validate() error {
+	v := newParamValidator("deleteNetworkACL", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteNetworkACLParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteNetworkACLParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteNetworkACL", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteNetworkACL: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNetworkACLParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -371,6 +828,15 @@ func (p *DeleteNetworkACLParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteNetworkACLParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteNetworkACLParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteNetworkACLParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewDeleteNetworkACLParams(id string) *DeleteNetworkACLParams {
@@ -382,7 +848,16 @@ func (s *NetworkACLService) NewDeleteNetworkACLParams(id string) *DeleteNetworkA
 
 // Deletes a network ACL
 func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkACL", p.toURLValues())
+	return s.DeleteNetworkACLWithContext(context.Background(), p)
+}
+
+// DeleteNetworkACLWithContext is the same as DeleteNetworkACL, but uses ctx to cancel the request and any async job polling
+func (s *NetworkACLService) DeleteNetworkACLWithContext(ctx context.Context, p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetworkACL", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -394,7 +869,7 @@ func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*Delete
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -410,6 +885,31 @@ func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*Delete
 	return &r, nil
 }
 
+// DeleteNetworkACLAsync starts the async job for DeleteNetworkACL and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteNetworkACLResponse using Job.Result
+func (s *NetworkACLService) DeleteNetworkACLAsync(p *DeleteNetworkACLParams) (*Job, error) {
+	return s.DeleteNetworkACLAsyncWithContext(context.Background(), p)
+}
+
+// DeleteNetworkACLAsyncWithContext is the same as DeleteNetworkACLAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkACLService) DeleteNetworkACLAsyncWithContext(ctx context.Context, p *DeleteNetworkACLParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetworkACL", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteNetworkACLResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteNetworkACLResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -421,7 +921,8 @@ type DeleteNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteNetworkACLListParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteNetworkACLListParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -432,6 +933,48 @@ func (p *DeleteNetworkACLListParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteNetworkACLListParams) Validate() error {
+	v := newParamValidator("deleteNetworkACLList", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteNetworkACLListParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteNetworkACLListParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteNetworkACLList", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteNetworkACLList: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNetworkACLListParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -439,6 +982,15 @@ func (p *DeleteNetworkACLListParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteNetworkACLListParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteNetworkACLListParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteNetworkACLListParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewDeleteNetworkACLListParams(id string) *DeleteNetworkACLListParams {
@@ -450,7 +1002,16 @@ func (s *NetworkACLService) NewDeleteNetworkACLListParams(id string) *DeleteNetw
 
 // Deletes a network ACL
 func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkACLList", p.toURLValues())
+	return s.DeleteNetworkACLListWithContext(context.Background(), p)
+}
+
+// DeleteNetworkACLListWithContext is the same as DeleteNetworkACLList, but uses ctx to cancel the request and any async job polling
+func (s *NetworkACLService) DeleteNetworkACLListWithContext(ctx context.Context, p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetworkACLList", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -462,7 +1023,7 @@ func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -478,6 +1039,31 @@ func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams)
 	return &r, nil
 }
 
+// DeleteNetworkACLListAsync starts the async job for DeleteNetworkACLList and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteNetworkACLListResponse using Job.Result
+func (s *NetworkACLService) DeleteNetworkACLListAsync(p *DeleteNetworkACLListParams) (*Job, error) {
+	return s.DeleteNetworkACLListAsyncWithContext(context.Background(), p)
+}
+
+// DeleteNetworkACLListAsyncWithContext is the same as DeleteNetworkACLListAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkACLService) DeleteNetworkACLListAsyncWithContext(ctx context.Context, p *DeleteNetworkACLListParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetworkACLList", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteNetworkACLListResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteNetworkACLListResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -489,7 +1075,8 @@ type ListNetworkACLListsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListNetworkACLListsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListNetworkACLListsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -541,6 +1128,58 @@ func (p *ListNetworkACLListsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListNetworkACLListsParams) Validate() error {
+	v := newParamValidator("listNetworkACLLists", p.p)
+	v.ids("domainid", "id", "networkid", "projectid", "vpcid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListNetworkACLListsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListNetworkACLListsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "name", "networkid", "projectid", "vpcid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listNetworkACLLists", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listNetworkACLLists: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListNetworkACLListsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -548,6 +1187,15 @@ func (p *ListNetworkACLListsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListNetworkACLListsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListNetworkACLListsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLListsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -555,6 +1203,15 @@ func (p *ListNetworkACLListsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListNetworkACLListsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListNetworkACLListsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLListsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -562,6 +1219,15 @@ func (p *ListNetworkACLListsParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListNetworkACLListsParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListNetworkACLListsParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworkACLListsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -569,6 +1235,15 @@ func (p *ListNetworkACLListsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListNetworkACLListsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListNetworkACLListsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLListsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -576,6 +1251,15 @@ func (p *ListNetworkACLListsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListNetworkACLListsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListNetworkACLListsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworkACLListsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -583,6 +1267,15 @@ func (p *ListNetworkACLListsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListNetworkACLListsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListNetworkACLListsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLListsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -590,6 +1283,15 @@ func (p *ListNetworkACLListsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListNetworkACLListsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListNetworkACLListsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworkACLListsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -597,6 +1299,15 @@ func (p *ListNetworkACLListsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListNetworkACLListsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListNetworkACLListsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLListsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -604,6 +1315,15 @@ func (p *ListNetworkACLListsParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *ListNetworkACLListsParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListNetworkACLListsParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLListsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -611,6 +1331,15 @@ func (p *ListNetworkACLListsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListNetworkACLListsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListNetworkACLListsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListNetworkACLListsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -618,6 +1347,15 @@ func (p *ListNetworkACLListsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListNetworkACLListsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListNetworkACLListsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListNetworkACLListsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -625,6 +1363,15 @@ func (p *ListNetworkACLListsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListNetworkACLListsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListNetworkACLListsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLListsParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -632,6 +1379,15 @@ func (p *ListNetworkACLListsParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *ListNetworkACLListsParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *ListNetworkACLListsParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListNetworkACLListsParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewListNetworkACLListsParams() *ListNetworkACLListsParams {
@@ -642,6 +1398,11 @@ func (s *NetworkACLService) NewListNetworkACLListsParams() *ListNetworkACLListsP
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetNetworkACLListIDWithContext(context.Background(), name, opts...)
+}
+
+// GetNetworkACLListIDWithContext is the same as GetNetworkACLListID, but uses ctx to cancel the request
+func (s *NetworkACLService) GetNetworkACLListIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworkACLListsParams{}
 	p.p = make(map[string]interface{})
 
@@ -653,7 +1414,7 @@ func (s *NetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListNetworkACLLists(p)
+	l, err := s.ListNetworkACLListsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -678,12 +1439,17 @@ func (s *NetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkACLService) GetNetworkACLListByName(name string, opts ...OptionFunc) (*NetworkACLList, int, error) {
-	id, count, err := s.GetNetworkACLListID(name, opts...)
+	return s.GetNetworkACLListByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetNetworkACLListByNameWithContext is the same as GetNetworkACLListByName, but uses ctx to cancel the requests
+func (s *NetworkACLService) GetNetworkACLListByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*NetworkACLList, int, error) {
+	id, count, err := s.GetNetworkACLListIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetNetworkACLListByID(id, opts...)
+	r, count, err := s.GetNetworkACLListByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -692,6 +1458,11 @@ func (s *NetworkACLService) GetNetworkACLListByName(name string, opts ...OptionF
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkACLService) GetNetworkACLListByID(id string, opts ...OptionFunc) (*NetworkACLList, int, error) {
+	return s.GetNetworkACLListByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetNetworkACLListByIDWithContext is the same as GetNetworkACLListByID, but uses ctx to cancel the request
+func (s *NetworkACLService) GetNetworkACLListByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkACLList, int, error) {
 	p := &ListNetworkACLListsParams{}
 	p.p = make(map[string]interface{})
 
@@ -703,18 +1474,16 @@ func (s *NetworkACLService) GetNetworkACLListByID(id string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListNetworkACLLists(p)
+	l, err := s.ListNetworkACLListsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -725,7 +1494,16 @@ func (s *NetworkACLService) GetNetworkACLListByID(id string, opts ...OptionFunc)
 
 // Lists all network ACLs
 func (s *NetworkACLService) ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkACLLists", p.toURLValues())
+	return s.ListNetworkACLListsWithContext(context.Background(), p)
+}
+
+// ListNetworkACLListsWithContext is the same as ListNetworkACLLists, but uses ctx to cancel the request and any async job polling
+func (s *NetworkACLService) ListNetworkACLListsWithContext(ctx context.Context, p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworkACLLists", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -738,6 +1516,92 @@ func (s *NetworkACLService) ListNetworkACLLists(p *ListNetworkACLListsParams) (*
 	return &r, nil
 }
 
+// ListNetworkACLListsPager iterates over all pages of a ListNetworkACLLists call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListNetworkACLListsPager struct {
+	pager
+
+	s *NetworkACLService
+	p *ListNetworkACLListsParams
+	r *ListNetworkACLListsResponse
+}
+
+// NewListNetworkACLListsPager returns a pager for all pages of a ListNetworkACLLists call with the given params
+func (s *NetworkACLService) NewListNetworkACLListsPager(p *ListNetworkACLListsParams) *ListNetworkACLListsPager {
+	return &ListNetworkACLListsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListNetworkACLListsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListNetworkACLListsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListNetworkACLListsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListNetworkACLListsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.NetworkACLLists), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListNetworkACLListsPager) Page() *ListNetworkACLListsResponse {
+	return pg.r
+}
+
+// ListNetworkACLListsAll fetches all pages of a ListNetworkACLLists call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetworkACLService) ListNetworkACLListsAll(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
+	return s.ListNetworkACLListsAllWithContext(context.Background(), p)
+}
+
+// ListNetworkACLListsAllWithContext is the same as ListNetworkACLListsAll, but uses ctx to cancel the requests
+func (s *NetworkACLService) ListNetworkACLListsAllWithContext(ctx context.Context, p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*NetworkACLList)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListNetworkACLListsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListNetworkACLListsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.NetworkACLLists
+		mu.Unlock()
+
+		return len(l.NetworkACLLists), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListNetworkACLListsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.NetworkACLLists = append(r.NetworkACLLists, pages[page]...)
+	}
+	r.Count = len(r.NetworkACLLists)
+
+	return r, nil
+}
+
 type ListNetworkACLListsResponse struct {
 	Count           int               `json:"count"`
 	NetworkACLLists []*NetworkACLList `json:"networkacllist"`
@@ -757,7 +1621,8 @@ type ListNetworkACLsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListNetworkACLsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListNetworkACLsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -822,6 +1687,64 @@ func (p *ListNetworkACLsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListNetworkACLsParams) Validate() error {
+	v := newParamValidator("listNetworkACLs", p.p)
+	v.ids("aclid", "domainid", "id", "networkid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("action", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("protocol", 255)
+	v.maxLength("traffictype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListNetworkACLsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListNetworkACLsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "aclid", "action", "domainid", "id", "keyword", "networkid", "projectid", "protocol", "traffictype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listNetworkACLs", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listNetworkACLs: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListNetworkACLsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -829,6 +1752,15 @@ func (p *ListNetworkACLsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListNetworkACLsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetAclid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -836,6 +1768,15 @@ func (p *ListNetworkACLsParams) SetAclid(v string) {
 	p.p["aclid"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetAclid() {
+	delete(p.p, "aclid")
+}
+
+func (p *ListNetworkACLsParams) GetAclid() (string, bool) {
+	value, ok := p.p["aclid"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -843,6 +1784,15 @@ func (p *ListNetworkACLsParams) SetAction(v string) {
 	p.p["action"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetAction() {
+	delete(p.p, "action")
+}
+
+func (p *ListNetworkACLsParams) GetAction() (string, bool) {
+	value, ok := p.p["action"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -850,6 +1800,15 @@ func (p *ListNetworkACLsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListNetworkACLsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -857,6 +1816,15 @@ func (p *ListNetworkACLsParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListNetworkACLsParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -864,6 +1832,15 @@ func (p *ListNetworkACLsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListNetworkACLsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -871,6 +1848,15 @@ func (p *ListNetworkACLsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListNetworkACLsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -878,6 +1864,15 @@ func (p *ListNetworkACLsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListNetworkACLsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -885,6 +1880,15 @@ func (p *ListNetworkACLsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListNetworkACLsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -892,6 +1896,15 @@ func (p *ListNetworkACLsParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListNetworkACLsParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -899,6 +1912,15 @@ func (p *ListNetworkACLsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListNetworkACLsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -906,6 +1928,15 @@ func (p *ListNetworkACLsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListNetworkACLsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -913,6 +1944,15 @@ func (p *ListNetworkACLsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListNetworkACLsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetProtocol(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -920,6 +1960,15 @@ func (p *ListNetworkACLsParams) SetProtocol(v string) {
 	p.p["protocol"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetProtocol() {
+	delete(p.p, "protocol")
+}
+
+func (p *ListNetworkACLsParams) GetProtocol() (string, bool) {
+	value, ok := p.p["protocol"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -927,6 +1976,15 @@ func (p *ListNetworkACLsParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListNetworkACLsParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListNetworkACLsParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 func (p *ListNetworkACLsParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -934,68 +1992,437 @@ func (p *ListNetworkACLsParams) SetTraffictype(v string) {
 	p.p["traffictype"] = v
 }
 
-// You should always use this function to get a new ListNetworkACLsParams instance,
+func (p *ListNetworkACLsParams) ResetTraffictype() {
+	delete(p.p, "traffictype")
+}
+
+func (p *ListNetworkACLsParams) GetTraffictype() (string, bool) {
+	value, ok := p.p["traffictype"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListNetworkACLsParams instance,
+// as then you are sure you have configured all required params
+func (s *NetworkACLService) NewListNetworkACLsParams() *ListNetworkACLsParams {
+	p := &ListNetworkACLsParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *NetworkACLService) GetNetworkACLByID(id string, opts ...OptionFunc) (*NetworkACL, int, error) {
+	return s.GetNetworkACLByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetNetworkACLByIDWithContext is the same as GetNetworkACLByID, but uses ctx to cancel the request
+func (s *NetworkACLService) GetNetworkACLByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkACL, int, error) {
+	p := &ListNetworkACLsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListNetworkACLsWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.NetworkACLs[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for NetworkACL UUID: %s!", id)
+}
+
+// Lists all network ACL items
+func (s *NetworkACLService) ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
+	return s.ListNetworkACLsWithContext(context.Background(), p)
+}
+
+// ListNetworkACLsWithContext is the same as ListNetworkACLs, but uses ctx to cancel the request and any async job polling
+func (s *NetworkACLService) ListNetworkACLsWithContext(ctx context.Context, p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworkACLs", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListNetworkACLsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListNetworkACLsPager iterates over all pages of a ListNetworkACLs call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListNetworkACLsPager struct {
+	pager
+
+	s *NetworkACLService
+	p *ListNetworkACLsParams
+	r *ListNetworkACLsResponse
+}
+
+// NewListNetworkACLsPager returns a pager for all pages of a ListNetworkACLs call with the given params
+func (s *NetworkACLService) NewListNetworkACLsPager(p *ListNetworkACLsParams) *ListNetworkACLsPager {
+	return &ListNetworkACLsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListNetworkACLsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListNetworkACLsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListNetworkACLsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListNetworkACLsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.NetworkACLs), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListNetworkACLsPager) Page() *ListNetworkACLsResponse {
+	return pg.r
+}
+
+// ListNetworkACLsAll fetches all pages of a ListNetworkACLs call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetworkACLService) ListNetworkACLsAll(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
+	return s.ListNetworkACLsAllWithContext(context.Background(), p)
+}
+
+// ListNetworkACLsAllWithContext is the same as ListNetworkACLsAll, but uses ctx to cancel the requests
+func (s *NetworkACLService) ListNetworkACLsAllWithContext(ctx context.Context, p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*NetworkACL)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListNetworkACLsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListNetworkACLsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.NetworkACLs
+		mu.Unlock()
+
+		return len(l.NetworkACLs), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListNetworkACLsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.NetworkACLs = append(r.NetworkACLs, pages[page]...)
+	}
+	r.Count = len(r.NetworkACLs)
+
+	return r, nil
+}
+
+type ListNetworkACLsResponse struct {
+	Count       int           `json:"count"`
+	NetworkACLs []*NetworkACL `json:"networkacl"`
+}
+
+type NetworkACL struct {
+	Aclid       string `json:"aclid"`
+	Aclname     string `json:"aclname"`
+	Action      string `json:"action"`
+	Cidrlist    string `json:"cidrlist"`
+	Endport     string `json:"endport"`
+	Fordisplay  bool   `json:"fordisplay"`
+	Icmpcode    int    `json:"icmpcode"`
+	Icmptype    int    `json:"icmptype"`
+	Id          string `json:"id"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Number      int    `json:"number"`
+	Protocol    string `json:"protocol"`
+	Reason      string `json:"reason"`
+	Startport   string `json:"startport"`
+	State       string `json:"state"`
+	Tags        []Tags `json:"tags"`
+	Traffictype string `json:"traffictype"`
+}
+
+type MoveNetworkAclItemParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *MoveNetworkAclItemParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["aclconsistencyhash"]; found {
+		u.Set("aclconsistencyhash", v.(string))
+	}
+	if v, found := p.p["customid"]; found {
+		u.Set("customid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["nextaclruleid"]; found {
+		u.Set("nextaclruleid", v.(string))
+	}
+	if v, found := p.p["previousaclruleid"]; found {
+		u.Set("previousaclruleid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *MoveNetworkAclItemParams) Validate() error {
+	v := newParamValidator("moveNetworkAclItem", p.p)
+	v.required("id")
+	v.maxLength("aclconsistencyhash", 255)
+	v.maxLength("customid", 255)
+	v.maxLength("id", 255)
+	v.maxLength("nextaclruleid", 255)
+	v.maxLength("previousaclruleid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *MoveNetworkAclItemParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *MoveNetworkAclItemParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "aclconsistencyhash", "customid", "id", "nextaclruleid", "previousaclruleid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for moveNetworkAclItem", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of moveNetworkAclItem: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *MoveNetworkAclItemParams) SetAclconsistencyhash(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["aclconsistencyhash"] = v
+}
+
+func (p *MoveNetworkAclItemParams) ResetAclconsistencyhash() {
+	delete(p.p, "aclconsistencyhash")
+}
+
+func (p *MoveNetworkAclItemParams) GetAclconsistencyhash() (string, bool) {
+	value, ok := p.p["aclconsistencyhash"].(string)
+	return value, ok
+}
+
+func (p *MoveNetworkAclItemParams) SetCustomid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["customid"] = v
+}
+
+func (p *MoveNetworkAclItemParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *MoveNetworkAclItemParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
+func (p *MoveNetworkAclItemParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *MoveNetworkAclItemParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *MoveNetworkAclItemParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *MoveNetworkAclItemParams) SetNextaclruleid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["nextaclruleid"] = v
+}
+
+func (p *MoveNetworkAclItemParams) ResetNextaclruleid() {
+	delete(p.p, "nextaclruleid")
+}
+
+func (p *MoveNetworkAclItemParams) GetNextaclruleid() (string, bool) {
+	value, ok := p.p["nextaclruleid"].(string)
+	return value, ok
+}
+
+func (p *MoveNetworkAclItemParams) SetPreviousaclruleid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["previousaclruleid"] = v
+}
+
+func (p *MoveNetworkAclItemParams) ResetPreviousaclruleid() {
+	delete(p.p, "previousaclruleid")
+}
+
+func (p *MoveNetworkAclItemParams) GetPreviousaclruleid() (string, bool) {
+	value, ok := p.p["previousaclruleid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new MoveNetworkAclItemParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkACLService) NewListNetworkACLsParams() *ListNetworkACLsParams {
-	p := &ListNetworkACLsParams{}
+func (s *NetworkACLService) NewMoveNetworkAclItemParams(id string) *MoveNetworkAclItemParams {
+	p := &MoveNetworkAclItemParams{}
 	p.p = make(map[string]interface{})
+	p.p["id"] = id
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkACLService) GetNetworkACLByID(id string, opts ...OptionFunc) (*NetworkACL, int, error) {
-	p := &ListNetworkACLsParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
+// Move an ACL rule to a position bettwen two other ACL rules of the same ACL network list
+func (s *NetworkACLService) MoveNetworkAclItem(p *MoveNetworkAclItemParams) (*MoveNetworkAclItemResponse, error) {
+	return s.MoveNetworkAclItemWithContext(context.Background(), p)
+}
 
-	for _, fn := range append(s.cs.options, opts...) {
-		if err := fn(s.cs, p); err != nil {
-			return nil, -1, err
-		}
+// MoveNetworkAclItemWithContext is the same as MoveNetworkAclItem, but uses ctx to cancel the request and any async job polling
+func (s *NetworkACLService) MoveNetworkAclItemWithContext(ctx context.Context, p *MoveNetworkAclItemParams) (*MoveNetworkAclItemResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
 
-	l, err := s.ListNetworkACLs(p)
+	resp, err := s.cs.newRequestWithContext(ctx, "moveNetworkAclItem", p.ToURLValues())
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+		return nil, err
 	}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	var r MoveNetworkAclItemResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
 
-	if l.Count == 1 {
-		return l.NetworkACLs[0], l.Count, nil
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for NetworkACL UUID: %s!", id)
+
+	return &r, nil
 }
 
-// Lists all network ACL items
-func (s *NetworkACLService) ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkACLs", p.toURLValues())
+// MoveNetworkAclItemAsync starts the async job for MoveNetworkAclItem and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a MoveNetworkAclItemResponse using Job.Result
+func (s *NetworkACLService) MoveNetworkAclItemAsync(p *MoveNetworkAclItemParams) (*Job, error) {
+	return s.MoveNetworkAclItemAsyncWithContext(context.Background(), p)
+}
+
+// MoveNetworkAclItemAsyncWithContext is the same as MoveNetworkAclItemAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkACLService) MoveNetworkAclItemAsyncWithContext(ctx context.Context, p *MoveNetworkAclItemParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "moveNetworkAclItem", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListNetworkACLsResponse
+	var r MoveNetworkAclItemResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
-	return &r, nil
-}
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
 
-type ListNetworkACLsResponse struct {
-	Count       int           `json:"count"`
-	NetworkACLs []*NetworkACL `json:"networkacl"`
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
 }
 
-type NetworkACL struct {
+type MoveNetworkAclItemResponse struct {
 	Aclid       string `json:"aclid"`
 	Aclname     string `json:"aclname"`
 	Action      string `json:"action"`
@@ -1020,7 +2447,8 @@ type ReplaceNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
-func (p *ReplaceNetworkACLListParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ReplaceNetworkACLListParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1037,6 +2465,48 @@ func (p *ReplaceNetworkACLListParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ReplaceNetworkACLListParams) Validate() error {
+	v := newParamValidator("replaceNetworkACLList", p.p)
+	v.required("aclid")
+	v.ids("aclid", "gatewayid", "networkid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ReplaceNetworkACLListParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ReplaceNetworkACLListParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "aclid", "gatewayid", "networkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for replaceNetworkACLList", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of replaceNetworkACLList: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ReplaceNetworkACLListParams) SetAclid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1044,6 +2514,15 @@ func (p *ReplaceNetworkACLListParams) SetAclid(v string) {
 	p.p["aclid"] = v
 }
 
+func (p *ReplaceNetworkACLListParams) ResetAclid() {
+	delete(p.p, "aclid")
+}
+
+func (p *ReplaceNetworkACLListParams) GetAclid() (string, bool) {
+	value, ok := p.p["aclid"].(string)
+	return value, ok
+}
+
 func (p *ReplaceNetworkACLListParams) SetGatewayid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1051,6 +2530,15 @@ func (p *ReplaceNetworkACLListParams) SetGatewayid(v string) {
 	p.p["gatewayid"] = v
 }
 
+func (p *ReplaceNetworkACLListParams) ResetGatewayid() {
+	delete(p.p, "gatewayid")
+}
+
+func (p *ReplaceNetworkACLListParams) GetGatewayid() (string, bool) {
+	value, ok := p.p["gatewayid"].(string)
+	return value, ok
+}
+
 func (p *ReplaceNetworkACLListParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1058,6 +2546,15 @@ func (p *ReplaceNetworkACLListParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *ReplaceNetworkACLListParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ReplaceNetworkACLListParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ReplaceNetworkACLListParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewReplaceNetworkACLListParams(aclid string) *ReplaceNetworkACLListParams {
@@ -1069,7 +2566,16 @@ func (s *NetworkACLService) NewReplaceNetworkACLListParams(aclid string) *Replac
 
 // Replaces ACL associated with a network or private gateway
 func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("replaceNetworkACLList", p.toURLValues())
+	return s.ReplaceNetworkACLListWithContext(context.Background(), p)
+}
+
+// ReplaceNetworkACLListWithContext is the same as ReplaceNetworkACLList, but uses ctx to cancel the request and any async job polling
+func (s *NetworkACLService) ReplaceNetworkACLListWithContext(ctx context.Context, p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "replaceNetworkACLList", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1081,7 +2587,7 @@ func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1097,6 +2603,31 @@ func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams
 	return &r, nil
 }
 
+// ReplaceNetworkACLListAsync starts the async job for ReplaceNetworkACLList and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ReplaceNetworkACLListResponse using Job.Result
+func (s *NetworkACLService) ReplaceNetworkACLListAsync(p *ReplaceNetworkACLListParams) (*Job, error) {
+	return s.ReplaceNetworkACLListAsyncWithContext(context.Background(), p)
+}
+
+// ReplaceNetworkACLListAsyncWithContext is the same as ReplaceNetworkACLListAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkACLService) ReplaceNetworkACLListAsyncWithContext(ctx context.Context, p *ReplaceNetworkACLListParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "replaceNetworkACLList", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReplaceNetworkACLListResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type ReplaceNetworkACLListResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -1108,7 +2639,8 @@ type UpdateNetworkACLItemParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateNetworkACLItemParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateNetworkACLItemParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1166,6 +2698,65 @@ func (p *UpdateNetworkACLItemParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateNetworkACLItemParams) Validate() error {
+	v := newParamValidator("updateNetworkACLItem", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("action", 255)
+	v.maxLength("customid", 255)
+	v.maxLength("protocol", 255)
+	v.maxLength("reason", 255)
+	v.maxLength("traffictype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateNetworkACLItemParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateNetworkACLItemParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "action", "customid", "id", "protocol", "reason", "traffictype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "cidrlist":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "endport", "icmpcode", "icmptype", "number", "startport":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "partialupgrade":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateNetworkACLItem", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateNetworkACLItem: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateNetworkACLItemParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1173,6 +2764,15 @@ func (p *UpdateNetworkACLItemParams) SetAction(v string) {
 	p.p["action"] = v
 }
 
+func (p *UpdateNetworkACLItemParams) ResetAction() {
+	delete(p.p, "action")
+}
+
+func (p *UpdateNetworkACLItemParams) GetAction() (string, bool) {
+	value, ok := p.p["action"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLItemParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1180,6 +2780,15 @@ func (p *UpdateNetworkACLItemParams) SetCidrlist(v []string) {
 	p.p["cidrlist"] = v
 }
 
+func (p *UpdateNetworkACLItemParams) ResetCidrlist() {
+	delete(p.p, "cidrlist")
+}
+
+func (p *UpdateNetworkACLItemParams) GetCidrlist() ([]string, bool) {
+	value, ok := p.p["cidrlist"].([]string)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLItemParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1187,6 +2796,15 @@ func (p *UpdateNetworkACLItemParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateNetworkACLItemParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateNetworkACLItemParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLItemParams) SetEndport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1194,6 +2812,15 @@ func (p *UpdateNetworkACLItemParams) SetEndport(v int) {
 	p.p["endport"] = v
 }
 
+func (p *UpdateNetworkACLItemParams) ResetEndport() {
+	delete(p.p, "endport")
+}
+
+func (p *UpdateNetworkACLItemParams) GetEndport() (int, bool) {
+	value, ok := p.p["endport"].(int)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLItemParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1201,6 +2828,15 @@ func (p *UpdateNetworkACLItemParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateNetworkACLItemParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateNetworkACLItemParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLItemParams) SetIcmpcode(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1208,6 +2844,15 @@ func (p *UpdateNetworkACLItemParams) SetIcmpcode(v int) {
 	p.p["icmpcode"] = v
 }
 
+func (p *UpdateNetworkACLItemParams) ResetIcmpcode() {
+	delete(p.p, "icmpcode")
+}
+
+func (p *UpdateNetworkACLItemParams) GetIcmpcode() (int, bool) {
+	value, ok := p.p["icmpcode"].(int)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLItemParams) SetIcmptype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1215,6 +2860,15 @@ func (p *UpdateNetworkACLItemParams) SetIcmptype(v int) {
 	p.p["icmptype"] = v
 }
 
+func (p *UpdateNetworkACLItemParams) ResetIcmptype() {
+	delete(p.p, "icmptype")
+}
+
+func (p *UpdateNetworkACLItemParams) GetIcmptype() (int, bool) {
+	value, ok := p.p["icmptype"].(int)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLItemParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1222,6 +2876,15 @@ func (p *UpdateNetworkACLItemParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateNetworkACLItemParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateNetworkACLItemParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLItemParams) SetNumber(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1229,6 +2892,15 @@ func (p *UpdateNetworkACLItemParams) SetNumber(v int) {
 	p.p["number"] = v
 }
 
+func (p *UpdateNetworkACLItemParams) ResetNumber() {
+	delete(p.p, "number")
+}
+
+func (p *UpdateNetworkACLItemParams) GetNumber() (int, bool) {
+	value, ok := p.p["number"].(int)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLItemParams) SetPartialupgrade(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1236,6 +2908,15 @@ func (p *UpdateNetworkACLItemParams) SetPartialupgrade(v bool) {
 	p.p["partialupgrade"] = v
 }
 
+func (p *UpdateNetworkACLItemParams) ResetPartialupgrade() {
+	delete(p.p, "partialupgrade")
+}
+
+func (p *UpdateNetworkACLItemParams) GetPartialupgrade() (bool, bool) {
+	value, ok := p.p["partialupgrade"].(bool)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLItemParams) SetProtocol(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1243,6 +2924,15 @@ func (p *UpdateNetworkACLItemParams) SetProtocol(v string) {
 	p.p["protocol"] = v
 }
 
+func (p *UpdateNetworkACLItemParams) ResetProtocol() {
+	delete(p.p, "protocol")
+}
+
+func (p *UpdateNetworkACLItemParams) GetProtocol() (string, bool) {
+	value, ok := p.p["protocol"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLItemParams) SetReason(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1250,6 +2940,15 @@ func (p *UpdateNetworkACLItemParams) SetReason(v string) {
 	p.p["reason"] = v
 }
 
+func (p *UpdateNetworkACLItemParams) ResetReason() {
+	delete(p.p, "reason")
+}
+
+func (p *UpdateNetworkACLItemParams) GetReason() (string, bool) {
+	value, ok := p.p["reason"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLItemParams) SetStartport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1257,6 +2956,15 @@ func (p *UpdateNetworkACLItemParams) SetStartport(v int) {
 	p.p["startport"] = v
 }
 
+func (p *UpdateNetworkACLItemParams) ResetStartport() {
+	delete(p.p, "startport")
+}
+
+func (p *UpdateNetworkACLItemParams) GetStartport() (int, bool) {
+	value, ok := p.p["startport"].(int)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLItemParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1264,6 +2972,15 @@ func (p *UpdateNetworkACLItemParams) SetTraffictype(v string) {
 	p.p["traffictype"] = v
 }
 
+func (p *UpdateNetworkACLItemParams) ResetTraffictype() {
+	delete(p.p, "traffictype")
+}
+
+func (p *UpdateNetworkACLItemParams) GetTraffictype() (string, bool) {
+	value, ok := p.p["traffictype"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateNetworkACLItemParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewUpdateNetworkACLItemParams(id string) *UpdateNetworkACLItemParams {
@@ -1275,7 +2992,16 @@ func (s *NetworkACLService) NewUpdateNetworkACLItemParams(id string) *UpdateNetw
 
 // Updates ACL item with specified ID
 func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkACLItem", p.toURLValues())
+	return s.UpdateNetworkACLItemWithContext(context.Background(), p)
+}
+
+// UpdateNetworkACLItemWithContext is the same as UpdateNetworkACLItem, but uses ctx to cancel the request and any async job polling
+func (s *NetworkACLService) UpdateNetworkACLItemWithContext(ctx context.Context, p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNetworkACLItem", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1287,7 +3013,7 @@ func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1308,6 +3034,40 @@ func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams)
 	return &r, nil
 }
 
+// UpdateNetworkACLItemAsync starts the async job for UpdateNetworkACLItem and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateNetworkACLItemResponse using Job.Result
+func (s *NetworkACLService) UpdateNetworkACLItemAsync(p *UpdateNetworkACLItemParams) (*Job, error) {
+	return s.UpdateNetworkACLItemAsyncWithContext(context.Background(), p)
+}
+
+// UpdateNetworkACLItemAsyncWithContext is the same as UpdateNetworkACLItemAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkACLService) UpdateNetworkACLItemAsyncWithContext(ctx context.Context, p *UpdateNetworkACLItemParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNetworkACLItem", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateNetworkACLItemResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateNetworkACLItemResponse struct {
 	Aclid       string `json:"aclid"`
 	Aclname     string `json:"aclname"`
@@ -1333,7 +3093,8 @@ type UpdateNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateNetworkACLListParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateNetworkACLListParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1357,6 +3118,55 @@ func (p *UpdateNetworkACLListParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateNetworkACLListParams) Validate() error {
+	v := newParamValidator("updateNetworkACLList", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("customid", 255)
+	v.maxLength("description", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateNetworkACLListParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateNetworkACLListParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "description", "id", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateNetworkACLList", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateNetworkACLList: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateNetworkACLListParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1364,6 +3174,15 @@ func (p *UpdateNetworkACLListParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateNetworkACLListParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateNetworkACLListParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLListParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1371,6 +3190,15 @@ func (p *UpdateNetworkACLListParams) SetDescription(v string) {
 	p.p["description"] = v
 }
 
+func (p *UpdateNetworkACLListParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *UpdateNetworkACLListParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLListParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1378,6 +3206,15 @@ func (p *UpdateNetworkACLListParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateNetworkACLListParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateNetworkACLListParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLListParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1385,6 +3222,15 @@ func (p *UpdateNetworkACLListParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateNetworkACLListParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateNetworkACLListParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkACLListParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1392,6 +3238,15 @@ func (p *UpdateNetworkACLListParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateNetworkACLListParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateNetworkACLListParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateNetworkACLListParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewUpdateNetworkACLListParams(id string) *UpdateNetworkACLListParams {
@@ -1403,7 +3258,16 @@ func (s *NetworkACLService) NewUpdateNetworkACLListParams(id string) *UpdateNetw
 
 // Updates network ACL list
 func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkACLList", p.toURLValues())
+	return s.UpdateNetworkACLListWithContext(context.Background(), p)
+}
+
+// UpdateNetworkACLListWithContext is the same as UpdateNetworkACLList, but uses ctx to cancel the request and any async job polling
+func (s *NetworkACLService) UpdateNetworkACLListWithContext(ctx context.Context, p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNetworkACLList", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1415,7 +3279,7 @@ func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1431,6 +3295,31 @@ func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams)
 	return &r, nil
 }
 
+// UpdateNetworkACLListAsync starts the async job for UpdateNetworkACLList and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateNetworkACLListResponse using Job.Result
+func (s *NetworkACLService) UpdateNetworkACLListAsync(p *UpdateNetworkACLListParams) (*Job, error) {
+	return s.UpdateNetworkACLListAsyncWithContext(context.Background(), p)
+}
+
+// UpdateNetworkACLListAsyncWithContext is the same as UpdateNetworkACLListAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkACLService) UpdateNetworkACLListAsyncWithContext(ctx context.Context, p *UpdateNetworkACLListParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNetworkACLList", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateNetworkACLListResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type UpdateNetworkACLListResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -20,17 +20,58 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// NetworkDeviceServiceIface is the interface implemented by NetworkDeviceService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type NetworkDeviceServiceIface interface {
+	NewAddNetworkDeviceParams() *AddNetworkDeviceParams
+	AddNetworkDevice(p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error)
+	AddNetworkDeviceWithContext(ctx context.Context, p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error)
+	NewDeleteCiscoNexusVSMParams(id string) *DeleteCiscoNexusVSMParams
+	DeleteCiscoNexusVSM(p *DeleteCiscoNexusVSMParams) (*DeleteCiscoNexusVSMResponse, error)
+	DeleteCiscoNexusVSMWithContext(ctx context.Context, p *DeleteCiscoNexusVSMParams) (*DeleteCiscoNexusVSMResponse, error)
+	DeleteCiscoNexusVSMAsync(p *DeleteCiscoNexusVSMParams) (*Job, error)
+	DeleteCiscoNexusVSMAsyncWithContext(ctx context.Context, p *DeleteCiscoNexusVSMParams) (*Job, error)
+	NewDeleteNetworkDeviceParams(id string) *DeleteNetworkDeviceParams
+	DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error)
+	DeleteNetworkDeviceWithContext(ctx context.Context, p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error)
+	NewDisableCiscoNexusVSMParams(id string) *DisableCiscoNexusVSMParams
+	DisableCiscoNexusVSM(p *DisableCiscoNexusVSMParams) (*DisableCiscoNexusVSMResponse, error)
+	DisableCiscoNexusVSMWithContext(ctx context.Context, p *DisableCiscoNexusVSMParams) (*DisableCiscoNexusVSMResponse, error)
+	DisableCiscoNexusVSMAsync(p *DisableCiscoNexusVSMParams) (*Job, error)
+	DisableCiscoNexusVSMAsyncWithContext(ctx context.Context, p *DisableCiscoNexusVSMParams) (*Job, error)
+	NewEnableCiscoNexusVSMParams(id string) *EnableCiscoNexusVSMParams
+	EnableCiscoNexusVSM(p *EnableCiscoNexusVSMParams) (*EnableCiscoNexusVSMResponse, error)
+	EnableCiscoNexusVSMWithContext(ctx context.Context, p *EnableCiscoNexusVSMParams) (*EnableCiscoNexusVSMResponse, error)
+	EnableCiscoNexusVSMAsync(p *EnableCiscoNexusVSMParams) (*Job, error)
+	EnableCiscoNexusVSMAsyncWithContext(ctx context.Context, p *EnableCiscoNexusVSMParams) (*Job, error)
+	NewListCiscoNexusVSMsParams() *ListCiscoNexusVSMsParams
+	ListCiscoNexusVSMs(p *ListCiscoNexusVSMsParams) (*ListCiscoNexusVSMsResponse, error)
+	ListCiscoNexusVSMsWithContext(ctx context.Context, p *ListCiscoNexusVSMsParams) (*ListCiscoNexusVSMsResponse, error)
+	NewListCiscoNexusVSMsPager(p *ListCiscoNexusVSMsParams) *ListCiscoNexusVSMsPager
+	ListCiscoNexusVSMsAll(p *ListCiscoNexusVSMsParams) (*ListCiscoNexusVSMsResponse, error)
+	ListCiscoNexusVSMsAllWithContext(ctx context.Context, p *ListCiscoNexusVSMsParams) (*ListCiscoNexusVSMsResponse, error)
+	NewListNetworkDeviceParams() *ListNetworkDeviceParams
+	ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error)
+	ListNetworkDeviceWithContext(ctx context.Context, p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error)
+	NewListNetworkDevicePager(p *ListNetworkDeviceParams) *ListNetworkDevicePager
+	ListNetworkDeviceAll(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error)
+	ListNetworkDeviceAllWithContext(ctx context.Context, p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error)
+}
+
 type AddNetworkDeviceParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddNetworkDeviceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddNetworkDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -48,6 +89,51 @@ func (p *AddNetworkDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddNetworkDeviceParams) Validate() error {
+	v := newParamValidator("addNetworkDevice", p.p)
+	v.maxLength("networkdevicetype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddNetworkDeviceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddNetworkDeviceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "networkdeviceparameterlist":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "networkdevicetype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addNetworkDevice", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addNetworkDevice: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -55,6 +141,15 @@ func (p *AddNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]stri
 	p.p["networkdeviceparameterlist"] = v
 }
 
+func (p *AddNetworkDeviceParams) ResetNetworkdeviceparameterlist() {
+	delete(p.p, "networkdeviceparameterlist")
+}
+
+func (p *AddNetworkDeviceParams) GetNetworkdeviceparameterlist() (map[string]string, bool) {
+	value, ok := p.p["networkdeviceparameterlist"].(map[string]string)
+	return value, ok
+}
+
 func (p *AddNetworkDeviceParams) SetNetworkdevicetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -62,6 +157,15 @@ func (p *AddNetworkDeviceParams) SetNetworkdevicetype(v string) {
 	p.p["networkdevicetype"] = v
 }
 
+func (p *AddNetworkDeviceParams) ResetNetworkdevicetype() {
+	delete(p.p, "networkdevicetype")
+}
+
+func (p *AddNetworkDeviceParams) GetNetworkdevicetype() (string, bool) {
+	value, ok := p.p["networkdevicetype"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddNetworkDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkDeviceService) NewAddNetworkDeviceParams() *AddNetworkDeviceParams {
@@ -72,7 +176,16 @@ func (s *NetworkDeviceService) NewAddNetworkDeviceParams() *AddNetworkDevicePara
 
 // Adds a network device of one of the following types: ExternalDhcp, ExternalFirewall, ExternalLoadBalancer, PxeServer
 func (s *NetworkDeviceService) AddNetworkDevice(p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addNetworkDevice", p.toURLValues())
+	return s.AddNetworkDeviceWithContext(context.Background(), p)
+}
+
+// AddNetworkDeviceWithContext is the same as AddNetworkDevice, but uses ctx to cancel the request and any async job polling
+func (s *NetworkDeviceService) AddNetworkDeviceWithContext(ctx context.Context, p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addNetworkDevice", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -91,11 +204,166 @@ type AddNetworkDeviceResponse struct {
 	Jobstatus int    `json:"jobstatus"`
 }
 
+type DeleteCiscoNexusVSMParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteCiscoNexusVSMParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteCiscoNexusVSMParams) Validate() error {
+	v := newParamValidator("deleteCiscoNexusVSM", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteCiscoNexusVSMParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteCiscoNexusVSMParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteCiscoNexusVSM", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteCiscoNexusVSM: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteCiscoNexusVSMParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteCiscoNexusVSMParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteCiscoNexusVSMParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteCiscoNexusVSMParams instance,
+// as then you are sure you have configured all required params
+func (s *NetworkDeviceService) NewDeleteCiscoNexusVSMParams(id string) *DeleteCiscoNexusVSMParams {
+	p := &DeleteCiscoNexusVSMParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// delete a Cisco Nexus VSM device
+func (s *NetworkDeviceService) DeleteCiscoNexusVSM(p *DeleteCiscoNexusVSMParams) (*DeleteCiscoNexusVSMResponse, error) {
+	return s.DeleteCiscoNexusVSMWithContext(context.Background(), p)
+}
+
+// DeleteCiscoNexusVSMWithContext is the same as DeleteCiscoNexusVSM, but uses ctx to cancel the request and any async job polling
+func (s *NetworkDeviceService) DeleteCiscoNexusVSMWithContext(ctx context.Context, p *DeleteCiscoNexusVSMParams) (*DeleteCiscoNexusVSMResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCiscoNexusVSM", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteCiscoNexusVSMResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteCiscoNexusVSMAsync starts the async job for DeleteCiscoNexusVSM and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteCiscoNexusVSMResponse using Job.Result
+func (s *NetworkDeviceService) DeleteCiscoNexusVSMAsync(p *DeleteCiscoNexusVSMParams) (*Job, error) {
+	return s.DeleteCiscoNexusVSMAsyncWithContext(context.Background(), p)
+}
+
+// DeleteCiscoNexusVSMAsyncWithContext is the same as DeleteCiscoNexusVSMAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkDeviceService) DeleteCiscoNexusVSMAsyncWithContext(ctx context.Context, p *DeleteCiscoNexusVSMParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteCiscoNexusVSM", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteCiscoNexusVSMResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteCiscoNexusVSMResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
 type DeleteNetworkDeviceParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteNetworkDeviceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteNetworkDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -106,6 +374,48 @@ func (p *DeleteNetworkDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteNetworkDeviceParams) Validate() error {
+	v := newParamValidator("deleteNetworkDevice", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteNetworkDeviceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteNetworkDeviceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteNetworkDevice", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteNetworkDevice: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNetworkDeviceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -113,6 +423,15 @@ func (p *DeleteNetworkDeviceParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteNetworkDeviceParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteNetworkDeviceParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteNetworkDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkDeviceService) NewDeleteNetworkDeviceParams(id string) *DeleteNetworkDeviceParams {
@@ -124,7 +443,16 @@ func (s *NetworkDeviceService) NewDeleteNetworkDeviceParams(id string) *DeleteNe
 
 // Deletes network device.
 func (s *NetworkDeviceService) DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkDevice", p.toURLValues())
+	return s.DeleteNetworkDeviceWithContext(context.Background(), p)
+}
+
+// DeleteNetworkDeviceWithContext is the same as DeleteNetworkDevice, but uses ctx to cancel the request and any async job polling
+func (s *NetworkDeviceService) DeleteNetworkDeviceWithContext(ctx context.Context, p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetworkDevice", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -171,97 +499,937 @@ func (r *DeleteNetworkDeviceResponse) UnmarshalJSON(b []byte) error {
 	return json.Unmarshal(b, (*alias)(r))
 }
 
-type ListNetworkDeviceParams struct {
+type DisableCiscoNexusVSMParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListNetworkDeviceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DisableCiscoNexusVSMParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["networkdeviceparameterlist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].key", i), k)
-			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].value", i), m[k])
-		}
-	}
-	if v, found := p.p["networkdevicetype"]; found {
-		u.Set("networkdevicetype", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
 	}
 	return u
 }
 
-func (p *ListNetworkDeviceParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DisableCiscoNexusVSMParams) Validate() error {
+	v := newParamValidator("disableCiscoNexusVSM", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
 }
 
-func (p *ListNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DisableCiscoNexusVSMParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["networkdeviceparameterlist"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *ListNetworkDeviceParams) SetNetworkdevicetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DisableCiscoNexusVSMParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["networkdevicetype"] = v
-}
 
-func (p *ListNetworkDeviceParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for disableCiscoNexusVSM", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of disableCiscoNexusVSM: %w", k, err)
+		}
 	}
-	p.p["page"] = v
+	return nil
 }
 
-func (p *ListNetworkDeviceParams) SetPagesize(v int) {
+func (p *DisableCiscoNexusVSMParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["pagesize"] = v
+	p.p["id"] = v
 }
 
-// You should always use this function to get a new ListNetworkDeviceParams instance,
+func (p *DisableCiscoNexusVSMParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DisableCiscoNexusVSMParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DisableCiscoNexusVSMParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkDeviceService) NewListNetworkDeviceParams() *ListNetworkDeviceParams {
-	p := &ListNetworkDeviceParams{}
+func (s *NetworkDeviceService) NewDisableCiscoNexusVSMParams(id string) *DisableCiscoNexusVSMParams {
+	p := &DisableCiscoNexusVSMParams{}
 	p.p = make(map[string]interface{})
+	p.p["id"] = id
 	return p
 }
 
-// List network devices
-func (s *NetworkDeviceService) ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkDevice", p.toURLValues())
+// disable a Cisco Nexus VSM device
+func (s *NetworkDeviceService) DisableCiscoNexusVSM(p *DisableCiscoNexusVSMParams) (*DisableCiscoNexusVSMResponse, error) {
+	return s.DisableCiscoNexusVSMWithContext(context.Background(), p)
+}
+
+// DisableCiscoNexusVSMWithContext is the same as DisableCiscoNexusVSM, but uses ctx to cancel the request and any async job polling
+func (s *NetworkDeviceService) DisableCiscoNexusVSMWithContext(ctx context.Context, p *DisableCiscoNexusVSMParams) (*DisableCiscoNexusVSMResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableCiscoNexusVSM", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListNetworkDeviceResponse
+	var r DisableCiscoNexusVSMResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
 	return &r, nil
 }
 
+// DisableCiscoNexusVSMAsync starts the async job for DisableCiscoNexusVSM and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DisableCiscoNexusVSMResponse using Job.Result
+func (s *NetworkDeviceService) DisableCiscoNexusVSMAsync(p *DisableCiscoNexusVSMParams) (*Job, error) {
+	return s.DisableCiscoNexusVSMAsyncWithContext(context.Background(), p)
+}
+
+// DisableCiscoNexusVSMAsyncWithContext is the same as DisableCiscoNexusVSMAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkDeviceService) DisableCiscoNexusVSMAsyncWithContext(ctx context.Context, p *DisableCiscoNexusVSMParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableCiscoNexusVSM", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableCiscoNexusVSMResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type DisableCiscoNexusVSMResponse struct {
+	Ipaddress        string `json:"ipaddress"`
+	JobID            string `json:"jobid"`
+	Jobstatus        int    `json:"jobstatus"`
+	Vsmconfigmode    string `json:"vsmconfigmode"`
+	Vsmconfigstate   string `json:"vsmconfigstate"`
+	Vsmctrlvlanid    int    `json:"vsmctrlvlanid"`
+	Vsmdeviceid      string `json:"vsmdeviceid"`
+	Vsmdevicename    string `json:"vsmdevicename"`
+	Vsmdevicestate   string `json:"vsmdevicestate"`
+	Vsmdomainid      string `json:"vsmdomainid"`
+	Vsmmgmtvlanid    string `json:"vsmmgmtvlanid"`
+	Vsmpktvlanid     int    `json:"vsmpktvlanid"`
+	Vsmstoragevlanid int    `json:"vsmstoragevlanid"`
+}
+
+type EnableCiscoNexusVSMParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *EnableCiscoNexusVSMParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *EnableCiscoNexusVSMParams) Validate() error {
+	v := newParamValidator("enableCiscoNexusVSM", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *EnableCiscoNexusVSMParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *EnableCiscoNexusVSMParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for enableCiscoNexusVSM", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of enableCiscoNexusVSM: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *EnableCiscoNexusVSMParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *EnableCiscoNexusVSMParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *EnableCiscoNexusVSMParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new EnableCiscoNexusVSMParams instance,
+// as then you are sure you have configured all required params
+func (s *NetworkDeviceService) NewEnableCiscoNexusVSMParams(id string) *EnableCiscoNexusVSMParams {
+	p := &EnableCiscoNexusVSMParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Enable a Cisco Nexus VSM device
+func (s *NetworkDeviceService) EnableCiscoNexusVSM(p *EnableCiscoNexusVSMParams) (*EnableCiscoNexusVSMResponse, error) {
+	return s.EnableCiscoNexusVSMWithContext(context.Background(), p)
+}
+
+// EnableCiscoNexusVSMWithContext is the same as EnableCiscoNexusVSM, but uses ctx to cancel the request and any async job polling
+func (s *NetworkDeviceService) EnableCiscoNexusVSMWithContext(ctx context.Context, p *EnableCiscoNexusVSMParams) (*EnableCiscoNexusVSMResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableCiscoNexusVSM", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableCiscoNexusVSMResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// EnableCiscoNexusVSMAsync starts the async job for EnableCiscoNexusVSM and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a EnableCiscoNexusVSMResponse using Job.Result
+func (s *NetworkDeviceService) EnableCiscoNexusVSMAsync(p *EnableCiscoNexusVSMParams) (*Job, error) {
+	return s.EnableCiscoNexusVSMAsyncWithContext(context.Background(), p)
+}
+
+// EnableCiscoNexusVSMAsyncWithContext is the same as EnableCiscoNexusVSMAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkDeviceService) EnableCiscoNexusVSMAsyncWithContext(ctx context.Context, p *EnableCiscoNexusVSMParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableCiscoNexusVSM", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableCiscoNexusVSMResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type EnableCiscoNexusVSMResponse struct {
+	Ipaddress        string `json:"ipaddress"`
+	JobID            string `json:"jobid"`
+	Jobstatus        int    `json:"jobstatus"`
+	Vsmconfigmode    string `json:"vsmconfigmode"`
+	Vsmconfigstate   string `json:"vsmconfigstate"`
+	Vsmctrlvlanid    int    `json:"vsmctrlvlanid"`
+	Vsmdeviceid      string `json:"vsmdeviceid"`
+	Vsmdevicename    string `json:"vsmdevicename"`
+	Vsmdevicestate   string `json:"vsmdevicestate"`
+	Vsmdomainid      string `json:"vsmdomainid"`
+	Vsmmgmtvlanid    string `json:"vsmmgmtvlanid"`
+	Vsmpktvlanid     int    `json:"vsmpktvlanid"`
+	Vsmstoragevlanid int    `json:"vsmstoragevlanid"`
+}
+
+type ListCiscoNexusVSMsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListCiscoNexusVSMsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["clusterid"]; found {
+		u.Set("clusterid", v.(string))
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListCiscoNexusVSMsParams) Validate() error {
+	v := newParamValidator("listCiscoNexusVSMs", p.p)
+	v.ids("clusterid", "zoneid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListCiscoNexusVSMsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListCiscoNexusVSMsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid", "keyword", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listCiscoNexusVSMs", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listCiscoNexusVSMs: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListCiscoNexusVSMsParams) SetClusterid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["clusterid"] = v
+}
+
+func (p *ListCiscoNexusVSMsParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ListCiscoNexusVSMsParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
+func (p *ListCiscoNexusVSMsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListCiscoNexusVSMsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListCiscoNexusVSMsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListCiscoNexusVSMsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListCiscoNexusVSMsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListCiscoNexusVSMsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListCiscoNexusVSMsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListCiscoNexusVSMsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListCiscoNexusVSMsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListCiscoNexusVSMsParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ListCiscoNexusVSMsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListCiscoNexusVSMsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListCiscoNexusVSMsParams instance,
+// as then you are sure you have configured all required params
+func (s *NetworkDeviceService) NewListCiscoNexusVSMsParams() *ListCiscoNexusVSMsParams {
+	p := &ListCiscoNexusVSMsParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Retrieves a Cisco Nexus 1000v Virtual Switch Manager device associated with a Cluster
+func (s *NetworkDeviceService) ListCiscoNexusVSMs(p *ListCiscoNexusVSMsParams) (*ListCiscoNexusVSMsResponse, error) {
+	return s.ListCiscoNexusVSMsWithContext(context.Background(), p)
+}
+
+// ListCiscoNexusVSMsWithContext is the same as ListCiscoNexusVSMs, but uses ctx to cancel the request and any async job polling
+func (s *NetworkDeviceService) ListCiscoNexusVSMsWithContext(ctx context.Context, p *ListCiscoNexusVSMsParams) (*ListCiscoNexusVSMsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listCiscoNexusVSMs", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListCiscoNexusVSMsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListCiscoNexusVSMsPager iterates over all pages of a ListCiscoNexusVSMs call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListCiscoNexusVSMsPager struct {
+	pager
+
+	s *NetworkDeviceService
+	p *ListCiscoNexusVSMsParams
+	r *ListCiscoNexusVSMsResponse
+}
+
+// NewListCiscoNexusVSMsPager returns a pager for all pages of a ListCiscoNexusVSMs call with the given params
+func (s *NetworkDeviceService) NewListCiscoNexusVSMsPager(p *ListCiscoNexusVSMsParams) *ListCiscoNexusVSMsPager {
+	return &ListCiscoNexusVSMsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListCiscoNexusVSMsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListCiscoNexusVSMsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListCiscoNexusVSMsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListCiscoNexusVSMsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.CiscoNexusVSMs), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListCiscoNexusVSMsPager) Page() *ListCiscoNexusVSMsResponse {
+	return pg.r
+}
+
+// ListCiscoNexusVSMsAll fetches all pages of a ListCiscoNexusVSMs call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetworkDeviceService) ListCiscoNexusVSMsAll(p *ListCiscoNexusVSMsParams) (*ListCiscoNexusVSMsResponse, error) {
+	return s.ListCiscoNexusVSMsAllWithContext(context.Background(), p)
+}
+
+// ListCiscoNexusVSMsAllWithContext is the same as ListCiscoNexusVSMsAll, but uses ctx to cancel the requests
+func (s *NetworkDeviceService) ListCiscoNexusVSMsAllWithContext(ctx context.Context, p *ListCiscoNexusVSMsParams) (*ListCiscoNexusVSMsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*CiscoNexusVSM)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListCiscoNexusVSMsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListCiscoNexusVSMsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.CiscoNexusVSMs
+		mu.Unlock()
+
+		return len(l.CiscoNexusVSMs), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListCiscoNexusVSMsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.CiscoNexusVSMs = append(r.CiscoNexusVSMs, pages[page]...)
+	}
+	r.Count = len(r.CiscoNexusVSMs)
+
+	return r, nil
+}
+
+type ListCiscoNexusVSMsResponse struct {
+	Count          int              `json:"count"`
+	CiscoNexusVSMs []*CiscoNexusVSM `json:"cisconexusvsm"`
+}
+
+type CiscoNexusVSM struct {
+	Ipaddress        string `json:"ipaddress"`
+	JobID            string `json:"jobid"`
+	Jobstatus        int    `json:"jobstatus"`
+	Vsmconfigmode    string `json:"vsmconfigmode"`
+	Vsmconfigstate   string `json:"vsmconfigstate"`
+	Vsmctrlvlanid    int    `json:"vsmctrlvlanid"`
+	Vsmdeviceid      string `json:"vsmdeviceid"`
+	Vsmdevicename    string `json:"vsmdevicename"`
+	Vsmdevicestate   string `json:"vsmdevicestate"`
+	Vsmdomainid      string `json:"vsmdomainid"`
+	Vsmmgmtvlanid    string `json:"vsmmgmtvlanid"`
+	Vsmpktvlanid     int    `json:"vsmpktvlanid"`
+	Vsmstoragevlanid int    `json:"vsmstoragevlanid"`
+}
+
+type ListNetworkDeviceParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListNetworkDeviceParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["networkdeviceparameterlist"]; found {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].key", i), k)
+			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].value", i), m[k])
+		}
+	}
+	if v, found := p.p["networkdevicetype"]; found {
+		u.Set("networkdevicetype", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListNetworkDeviceParams) Validate() error {
+	v := newParamValidator("listNetworkDevice", p.p)
+	v.maxLength("keyword", 255)
+	v.maxLength("networkdevicetype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListNetworkDeviceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListNetworkDeviceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "networkdevicetype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "networkdeviceparameterlist":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listNetworkDevice", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listNetworkDevice: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListNetworkDeviceParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListNetworkDeviceParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListNetworkDeviceParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networkdeviceparameterlist"] = v
+}
+
+func (p *ListNetworkDeviceParams) ResetNetworkdeviceparameterlist() {
+	delete(p.p, "networkdeviceparameterlist")
+}
+
+func (p *ListNetworkDeviceParams) GetNetworkdeviceparameterlist() (map[string]string, bool) {
+	value, ok := p.p["networkdeviceparameterlist"].(map[string]string)
+	return value, ok
+}
+
+func (p *ListNetworkDeviceParams) SetNetworkdevicetype(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networkdevicetype"] = v
+}
+
+func (p *ListNetworkDeviceParams) ResetNetworkdevicetype() {
+	delete(p.p, "networkdevicetype")
+}
+
+func (p *ListNetworkDeviceParams) GetNetworkdevicetype() (string, bool) {
+	value, ok := p.p["networkdevicetype"].(string)
+	return value, ok
+}
+
+func (p *ListNetworkDeviceParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListNetworkDeviceParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListNetworkDeviceParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListNetworkDeviceParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListNetworkDeviceParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListNetworkDeviceParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+// You should always use this function to get a new ListNetworkDeviceParams instance,
+// as then you are sure you have configured all required params
+func (s *NetworkDeviceService) NewListNetworkDeviceParams() *ListNetworkDeviceParams {
+	p := &ListNetworkDeviceParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// List network devices
+func (s *NetworkDeviceService) ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
+	return s.ListNetworkDeviceWithContext(context.Background(), p)
+}
+
+// ListNetworkDeviceWithContext is the same as ListNetworkDevice, but uses ctx to cancel the request and any async job polling
+func (s *NetworkDeviceService) ListNetworkDeviceWithContext(ctx context.Context, p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworkDevice", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListNetworkDeviceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListNetworkDevicePager iterates over all pages of a ListNetworkDevice call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListNetworkDevicePager struct {
+	pager
+
+	s *NetworkDeviceService
+	p *ListNetworkDeviceParams
+	r *ListNetworkDeviceResponse
+}
+
+// NewListNetworkDevicePager returns a pager for all pages of a ListNetworkDevice call with the given params
+func (s *NetworkDeviceService) NewListNetworkDevicePager(p *ListNetworkDeviceParams) *ListNetworkDevicePager {
+	return &ListNetworkDevicePager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListNetworkDevicePager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListNetworkDevicePager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListNetworkDeviceParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListNetworkDeviceWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.NetworkDevice), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListNetworkDevicePager) Page() *ListNetworkDeviceResponse {
+	return pg.r
+}
+
+// ListNetworkDeviceAll fetches all pages of a ListNetworkDevice call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetworkDeviceService) ListNetworkDeviceAll(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
+	return s.ListNetworkDeviceAllWithContext(context.Background(), p)
+}
+
+// ListNetworkDeviceAllWithContext is the same as ListNetworkDeviceAll, but uses ctx to cancel the requests
+func (s *NetworkDeviceService) ListNetworkDeviceAllWithContext(ctx context.Context, p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*NetworkDevice)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListNetworkDeviceParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListNetworkDeviceWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.NetworkDevice
+		mu.Unlock()
+
+		return len(l.NetworkDevice), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListNetworkDeviceResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.NetworkDevice = append(r.NetworkDevice, pages[page]...)
+	}
+	r.Count = len(r.NetworkDevice)
+
+	return r, nil
+}
+
 type ListNetworkDeviceResponse struct {
 	Count         int              `json:"count"`
 	NetworkDevice []*NetworkDevice `json:"networkdevice"`
@@ -20,18 +20,47 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// NetworkOfferingServiceIface is the interface implemented by NetworkOfferingService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type NetworkOfferingServiceIface interface {
+	NewCreateNetworkOfferingParams(displaytext string, guestiptype string, name string, supportedservices []string, traffictype string) *CreateNetworkOfferingParams
+	CreateNetworkOffering(p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error)
+	CreateNetworkOfferingWithContext(ctx context.Context, p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error)
+	NewDeleteNetworkOfferingParams(id string) *DeleteNetworkOfferingParams
+	DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error)
+	DeleteNetworkOfferingWithContext(ctx context.Context, p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error)
+	NewListNetworkOfferingsParams() *ListNetworkOfferingsParams
+	GetNetworkOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkOfferingByName(name string, opts ...OptionFunc) (*NetworkOffering, int, error)
+	GetNetworkOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*NetworkOffering, int, error)
+	GetNetworkOfferingByID(id string, opts ...OptionFunc) (*NetworkOffering, int, error)
+	GetNetworkOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkOffering, int, error)
+	ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error)
+	ListNetworkOfferingsWithContext(ctx context.Context, p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error)
+	NewListNetworkOfferingsPager(p *ListNetworkOfferingsParams) *ListNetworkOfferingsPager
+	ListNetworkOfferingsAll(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error)
+	ListNetworkOfferingsAllWithContext(ctx context.Context, p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error)
+	NewUpdateNetworkOfferingParams() *UpdateNetworkOfferingParams
+	UpdateNetworkOffering(p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error)
+	UpdateNetworkOfferingWithContext(ctx context.Context, p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error)
+}
+
 type CreateNetworkOfferingParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateNetworkOfferingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateNetworkOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -87,20 +116,29 @@ func (p *CreateNetworkOfferingParams) toURLValues() url.Values {
 		u.Set("networkrate", vv)
 	}
 	if v, found := p.p["servicecapabilitylist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("servicecapabilitylist[%d].key", i), k)
-			u.Set(fmt.Sprintf("servicecapabilitylist[%d].value", i), m[k])
+		for i, item := range v.([]ServiceCapability) {
+			if item.Service != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].service", i), item.Service)
+			}
+			if item.CapabilityType != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].capabilitytype", i), item.CapabilityType)
+			}
+			if item.CapabilityValue != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].capabilityvalue", i), item.CapabilityValue)
+			}
 		}
 	}
 	if v, found := p.p["serviceofferingid"]; found {
 		u.Set("serviceofferingid", v.(string))
 	}
 	if v, found := p.p["serviceproviderlist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("serviceproviderlist[%d].service", i), k)
-			u.Set(fmt.Sprintf("serviceproviderlist[%d].provider", i), m[k])
+		for i, item := range v.([]ServiceProvider) {
+			if item.Service != "" {
+				u.Set(fmt.Sprintf("serviceproviderlist[%d].service", i), item.Service)
+			}
+			if item.Provider != "" {
+				u.Set(fmt.Sprintf("serviceproviderlist[%d].provider", i), item.Provider)
+			}
 		}
 	}
 	if v, found := p.p["specifyipranges"]; found {
@@ -128,6 +166,78 @@ func (p *CreateNetworkOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateNetworkOfferingParams) Validate() error {
+	v := newParamValidator("createNetworkOffering", p.p)
+	v.required("displaytext", "guestiptype", "name", "supportedservices", "traffictype")
+	v.ids("serviceofferingid")
+	v.maxLength("availability", 255)
+	v.maxLength("displaytext", 255)
+	v.maxLength("guestiptype", 255)
+	v.maxLength("name", 255)
+	v.maxLength("tags", 4096)
+	v.maxLength("traffictype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateNetworkOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateNetworkOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "availability", "displaytext", "guestiptype", "name", "serviceofferingid", "tags", "traffictype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "conservemode", "egressdefaultpolicy", "forvpc", "ispersistent", "keepaliveenabled", "specifyipranges", "specifyvlan":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "domainid", "supportedservices", "zoneid":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "maxconnections", "networkrate":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "servicecapabilitylist":
+			var v []ServiceCapability
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "serviceproviderlist":
+			var v []ServiceProvider
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createNetworkOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createNetworkOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateNetworkOfferingParams) SetAvailability(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -135,6 +245,15 @@ func (p *CreateNetworkOfferingParams) SetAvailability(v string) {
 	p.p["availability"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetAvailability() {
+	delete(p.p, "availability")
+}
+
+func (p *CreateNetworkOfferingParams) GetAvailability() (string, bool) {
+	value, ok := p.p["availability"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetConservemode(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -142,6 +261,15 @@ func (p *CreateNetworkOfferingParams) SetConservemode(v bool) {
 	p.p["conservemode"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetConservemode() {
+	delete(p.p, "conservemode")
+}
+
+func (p *CreateNetworkOfferingParams) GetConservemode() (bool, bool) {
+	value, ok := p.p["conservemode"].(bool)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -149,6 +277,15 @@ func (p *CreateNetworkOfferingParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *CreateNetworkOfferingParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -156,6 +293,15 @@ func (p *CreateNetworkOfferingParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *CreateNetworkOfferingParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetDomainid(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -163,6 +309,15 @@ func (p *CreateNetworkOfferingParams) SetDomainid(v []string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateNetworkOfferingParams) GetDomainid() ([]string, bool) {
+	value, ok := p.p["domainid"].([]string)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetEgressdefaultpolicy(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -170,6 +325,15 @@ func (p *CreateNetworkOfferingParams) SetEgressdefaultpolicy(v bool) {
 	p.p["egressdefaultpolicy"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetEgressdefaultpolicy() {
+	delete(p.p, "egressdefaultpolicy")
+}
+
+func (p *CreateNetworkOfferingParams) GetEgressdefaultpolicy() (bool, bool) {
+	value, ok := p.p["egressdefaultpolicy"].(bool)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetForvpc(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -177,6 +341,15 @@ func (p *CreateNetworkOfferingParams) SetForvpc(v bool) {
 	p.p["forvpc"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetForvpc() {
+	delete(p.p, "forvpc")
+}
+
+func (p *CreateNetworkOfferingParams) GetForvpc() (bool, bool) {
+	value, ok := p.p["forvpc"].(bool)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetGuestiptype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -184,6 +357,15 @@ func (p *CreateNetworkOfferingParams) SetGuestiptype(v string) {
 	p.p["guestiptype"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetGuestiptype() {
+	delete(p.p, "guestiptype")
+}
+
+func (p *CreateNetworkOfferingParams) GetGuestiptype() (string, bool) {
+	value, ok := p.p["guestiptype"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetIspersistent(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -191,6 +373,15 @@ func (p *CreateNetworkOfferingParams) SetIspersistent(v bool) {
 	p.p["ispersistent"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetIspersistent() {
+	delete(p.p, "ispersistent")
+}
+
+func (p *CreateNetworkOfferingParams) GetIspersistent() (bool, bool) {
+	value, ok := p.p["ispersistent"].(bool)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetKeepaliveenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -198,6 +389,15 @@ func (p *CreateNetworkOfferingParams) SetKeepaliveenabled(v bool) {
 	p.p["keepaliveenabled"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetKeepaliveenabled() {
+	delete(p.p, "keepaliveenabled")
+}
+
+func (p *CreateNetworkOfferingParams) GetKeepaliveenabled() (bool, bool) {
+	value, ok := p.p["keepaliveenabled"].(bool)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetMaxconnections(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -205,6 +405,15 @@ func (p *CreateNetworkOfferingParams) SetMaxconnections(v int) {
 	p.p["maxconnections"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetMaxconnections() {
+	delete(p.p, "maxconnections")
+}
+
+func (p *CreateNetworkOfferingParams) GetMaxconnections() (int, bool) {
+	value, ok := p.p["maxconnections"].(int)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -212,6 +421,15 @@ func (p *CreateNetworkOfferingParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateNetworkOfferingParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetNetworkrate(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -219,13 +437,31 @@ func (p *CreateNetworkOfferingParams) SetNetworkrate(v int) {
 	p.p["networkrate"] = v
 }
 
-func (p *CreateNetworkOfferingParams) SetServicecapabilitylist(v map[string]string) {
+func (p *CreateNetworkOfferingParams) ResetNetworkrate() {
+	delete(p.p, "networkrate")
+}
+
+func (p *CreateNetworkOfferingParams) GetNetworkrate() (int, bool) {
+	value, ok := p.p["networkrate"].(int)
+	return value, ok
+}
+
+func (p *CreateNetworkOfferingParams) SetServicecapabilitylist(v []ServiceCapability) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["servicecapabilitylist"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetServicecapabilitylist() {
+	delete(p.p, "servicecapabilitylist")
+}
+
+func (p *CreateNetworkOfferingParams) GetServicecapabilitylist() ([]ServiceCapability, bool) {
+	value, ok := p.p["servicecapabilitylist"].([]ServiceCapability)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -233,13 +469,31 @@ func (p *CreateNetworkOfferingParams) SetServiceofferingid(v string) {
 	p.p["serviceofferingid"] = v
 }
 
-func (p *CreateNetworkOfferingParams) SetServiceproviderlist(v map[string]string) {
+func (p *CreateNetworkOfferingParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *CreateNetworkOfferingParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
+func (p *CreateNetworkOfferingParams) SetServiceproviderlist(v []ServiceProvider) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["serviceproviderlist"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetServiceproviderlist() {
+	delete(p.p, "serviceproviderlist")
+}
+
+func (p *CreateNetworkOfferingParams) GetServiceproviderlist() ([]ServiceProvider, bool) {
+	value, ok := p.p["serviceproviderlist"].([]ServiceProvider)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetSpecifyipranges(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -247,6 +501,15 @@ func (p *CreateNetworkOfferingParams) SetSpecifyipranges(v bool) {
 	p.p["specifyipranges"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetSpecifyipranges() {
+	delete(p.p, "specifyipranges")
+}
+
+func (p *CreateNetworkOfferingParams) GetSpecifyipranges() (bool, bool) {
+	value, ok := p.p["specifyipranges"].(bool)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetSpecifyvlan(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -254,6 +517,15 @@ func (p *CreateNetworkOfferingParams) SetSpecifyvlan(v bool) {
 	p.p["specifyvlan"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetSpecifyvlan() {
+	delete(p.p, "specifyvlan")
+}
+
+func (p *CreateNetworkOfferingParams) GetSpecifyvlan() (bool, bool) {
+	value, ok := p.p["specifyvlan"].(bool)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetSupportedservices(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -261,6 +533,15 @@ func (p *CreateNetworkOfferingParams) SetSupportedservices(v []string) {
 	p.p["supportedservices"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetSupportedservices() {
+	delete(p.p, "supportedservices")
+}
+
+func (p *CreateNetworkOfferingParams) GetSupportedservices() ([]string, bool) {
+	value, ok := p.p["supportedservices"].([]string)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetTags(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -268,6 +549,15 @@ func (p *CreateNetworkOfferingParams) SetTags(v string) {
 	p.p["tags"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *CreateNetworkOfferingParams) GetTags() (string, bool) {
+	value, ok := p.p["tags"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -275,6 +565,15 @@ func (p *CreateNetworkOfferingParams) SetTraffictype(v string) {
 	p.p["traffictype"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetTraffictype() {
+	delete(p.p, "traffictype")
+}
+
+func (p *CreateNetworkOfferingParams) GetTraffictype() (string, bool) {
+	value, ok := p.p["traffictype"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkOfferingParams) SetZoneid(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -282,6 +581,15 @@ func (p *CreateNetworkOfferingParams) SetZoneid(v []string) {
 	p.p["zoneid"] = v
 }
 
+func (p *CreateNetworkOfferingParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreateNetworkOfferingParams) GetZoneid() ([]string, bool) {
+	value, ok := p.p["zoneid"].([]string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateNetworkOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkOfferingService) NewCreateNetworkOfferingParams(displaytext string, guestiptype string, name string, supportedservices []string, traffictype string) *CreateNetworkOfferingParams {
@@ -297,7 +605,16 @@ func (s *NetworkOfferingService) NewCreateNetworkOfferingParams(displaytext stri
 
 // Creates a network offering.
 func (s *NetworkOfferingService) CreateNetworkOffering(p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkOffering", p.toURLValues())
+	return s.CreateNetworkOfferingWithContext(context.Background(), p)
+}
+
+// CreateNetworkOfferingWithContext is the same as CreateNetworkOffering, but uses ctx to cancel the request and any async job polling
+func (s *NetworkOfferingService) CreateNetworkOfferingWithContext(ctx context.Context, p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createNetworkOffering", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -372,7 +689,8 @@ type DeleteNetworkOfferingParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteNetworkOfferingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteNetworkOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -383,6 +701,48 @@ func (p *DeleteNetworkOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteNetworkOfferingParams) Validate() error {
+	v := newParamValidator("deleteNetworkOffering", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteNetworkOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteNetworkOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteNetworkOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteNetworkOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNetworkOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -390,6 +750,15 @@ func (p *DeleteNetworkOfferingParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteNetworkOfferingParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteNetworkOfferingParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteNetworkOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkOfferingService) NewDeleteNetworkOfferingParams(id string) *DeleteNetworkOfferingParams {
@@ -401,7 +770,16 @@ func (s *NetworkOfferingService) NewDeleteNetworkOfferingParams(id string) *Dele
 
 // Deletes a network offering.
 func (s *NetworkOfferingService) DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkOffering", p.toURLValues())
+	return s.DeleteNetworkOfferingWithContext(context.Background(), p)
+}
+
+// DeleteNetworkOfferingWithContext is the same as DeleteNetworkOffering, but uses ctx to cancel the request and any async job polling
+func (s *NetworkOfferingService) DeleteNetworkOfferingWithContext(ctx context.Context, p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetworkOffering", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -452,7 +830,8 @@ type ListNetworkOfferingsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListNetworkOfferingsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListNetworkOfferingsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -532,6 +911,67 @@ func (p *ListNetworkOfferingsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListNetworkOfferingsParams) Validate() error {
+	v := newParamValidator("listNetworkOfferings", p.p)
+	v.ids("domainid", "id", "networkid", "zoneid")
+	v.maxLength("availability", 255)
+	v.maxLength("displaytext", 255)
+	v.maxLength("guestiptype", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	v.maxLength("tags", 4096)
+	v.maxLength("traffictype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListNetworkOfferingsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListNetworkOfferingsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "availability", "displaytext", "domainid", "guestiptype", "id", "keyword", "name", "networkid", "state", "tags", "traffictype", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "forvpc", "isdefault", "istagged", "sourcenatsupported", "specifyipranges", "specifyvlan":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "supportedservices":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listNetworkOfferings", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listNetworkOfferings: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListNetworkOfferingsParams) SetAvailability(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -539,6 +979,15 @@ func (p *ListNetworkOfferingsParams) SetAvailability(v string) {
 	p.p["availability"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetAvailability() {
+	delete(p.p, "availability")
+}
+
+func (p *ListNetworkOfferingsParams) GetAvailability() (string, bool) {
+	value, ok := p.p["availability"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -546,6 +995,15 @@ func (p *ListNetworkOfferingsParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *ListNetworkOfferingsParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -553,6 +1011,15 @@ func (p *ListNetworkOfferingsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListNetworkOfferingsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetForvpc(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -560,6 +1027,15 @@ func (p *ListNetworkOfferingsParams) SetForvpc(v bool) {
 	p.p["forvpc"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetForvpc() {
+	delete(p.p, "forvpc")
+}
+
+func (p *ListNetworkOfferingsParams) GetForvpc() (bool, bool) {
+	value, ok := p.p["forvpc"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetGuestiptype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -567,6 +1043,15 @@ func (p *ListNetworkOfferingsParams) SetGuestiptype(v string) {
 	p.p["guestiptype"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetGuestiptype() {
+	delete(p.p, "guestiptype")
+}
+
+func (p *ListNetworkOfferingsParams) GetGuestiptype() (string, bool) {
+	value, ok := p.p["guestiptype"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -574,6 +1059,15 @@ func (p *ListNetworkOfferingsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListNetworkOfferingsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetIsdefault(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -581,6 +1075,15 @@ func (p *ListNetworkOfferingsParams) SetIsdefault(v bool) {
 	p.p["isdefault"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetIsdefault() {
+	delete(p.p, "isdefault")
+}
+
+func (p *ListNetworkOfferingsParams) GetIsdefault() (bool, bool) {
+	value, ok := p.p["isdefault"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetIstagged(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -588,6 +1091,15 @@ func (p *ListNetworkOfferingsParams) SetIstagged(v bool) {
 	p.p["istagged"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetIstagged() {
+	delete(p.p, "istagged")
+}
+
+func (p *ListNetworkOfferingsParams) GetIstagged() (bool, bool) {
+	value, ok := p.p["istagged"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -595,6 +1107,15 @@ func (p *ListNetworkOfferingsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListNetworkOfferingsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -602,6 +1123,15 @@ func (p *ListNetworkOfferingsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListNetworkOfferingsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -609,6 +1139,15 @@ func (p *ListNetworkOfferingsParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListNetworkOfferingsParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -616,6 +1155,15 @@ func (p *ListNetworkOfferingsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListNetworkOfferingsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -623,6 +1171,15 @@ func (p *ListNetworkOfferingsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListNetworkOfferingsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetSourcenatsupported(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -630,6 +1187,15 @@ func (p *ListNetworkOfferingsParams) SetSourcenatsupported(v bool) {
 	p.p["sourcenatsupported"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetSourcenatsupported() {
+	delete(p.p, "sourcenatsupported")
+}
+
+func (p *ListNetworkOfferingsParams) GetSourcenatsupported() (bool, bool) {
+	value, ok := p.p["sourcenatsupported"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetSpecifyipranges(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -637,6 +1203,15 @@ func (p *ListNetworkOfferingsParams) SetSpecifyipranges(v bool) {
 	p.p["specifyipranges"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetSpecifyipranges() {
+	delete(p.p, "specifyipranges")
+}
+
+func (p *ListNetworkOfferingsParams) GetSpecifyipranges() (bool, bool) {
+	value, ok := p.p["specifyipranges"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetSpecifyvlan(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -644,6 +1219,15 @@ func (p *ListNetworkOfferingsParams) SetSpecifyvlan(v bool) {
 	p.p["specifyvlan"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetSpecifyvlan() {
+	delete(p.p, "specifyvlan")
+}
+
+func (p *ListNetworkOfferingsParams) GetSpecifyvlan() (bool, bool) {
+	value, ok := p.p["specifyvlan"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -651,6 +1235,15 @@ func (p *ListNetworkOfferingsParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListNetworkOfferingsParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetSupportedservices(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -658,6 +1251,15 @@ func (p *ListNetworkOfferingsParams) SetSupportedservices(v []string) {
 	p.p["supportedservices"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetSupportedservices() {
+	delete(p.p, "supportedservices")
+}
+
+func (p *ListNetworkOfferingsParams) GetSupportedservices() ([]string, bool) {
+	value, ok := p.p["supportedservices"].([]string)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetTags(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -665,6 +1267,15 @@ func (p *ListNetworkOfferingsParams) SetTags(v string) {
 	p.p["tags"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListNetworkOfferingsParams) GetTags() (string, bool) {
+	value, ok := p.p["tags"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -672,6 +1283,15 @@ func (p *ListNetworkOfferingsParams) SetTraffictype(v string) {
 	p.p["traffictype"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetTraffictype() {
+	delete(p.p, "traffictype")
+}
+
+func (p *ListNetworkOfferingsParams) GetTraffictype() (string, bool) {
+	value, ok := p.p["traffictype"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkOfferingsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -679,6 +1299,15 @@ func (p *ListNetworkOfferingsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListNetworkOfferingsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListNetworkOfferingsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListNetworkOfferingsParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkOfferingService) NewListNetworkOfferingsParams() *ListNetworkOfferingsParams {
@@ -689,6 +1318,11 @@ func (s *NetworkOfferingService) NewListNetworkOfferingsParams() *ListNetworkOff
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkOfferingService) GetNetworkOfferingID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetNetworkOfferingIDWithContext(context.Background(), name, opts...)
+}
+
+// GetNetworkOfferingIDWithContext is the same as GetNetworkOfferingID, but uses ctx to cancel the request
+func (s *NetworkOfferingService) GetNetworkOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworkOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -700,7 +1334,7 @@ func (s *NetworkOfferingService) GetNetworkOfferingID(name string, opts ...Optio
 		}
 	}
 
-	l, err := s.ListNetworkOfferings(p)
+	l, err := s.ListNetworkOfferingsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -725,12 +1359,17 @@ func (s *NetworkOfferingService) GetNetworkOfferingID(name string, opts ...Optio
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkOfferingService) GetNetworkOfferingByName(name string, opts ...OptionFunc) (*NetworkOffering, int, error) {
-	id, count, err := s.GetNetworkOfferingID(name, opts...)
+	return s.GetNetworkOfferingByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetNetworkOfferingByNameWithContext is the same as GetNetworkOfferingByName, but uses ctx to cancel the requests
+func (s *NetworkOfferingService) GetNetworkOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*NetworkOffering, int, error) {
+	id, count, err := s.GetNetworkOfferingIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetNetworkOfferingByID(id, opts...)
+	r, count, err := s.GetNetworkOfferingByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -739,6 +1378,11 @@ func (s *NetworkOfferingService) GetNetworkOfferingByName(name string, opts ...O
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkOfferingService) GetNetworkOfferingByID(id string, opts ...OptionFunc) (*NetworkOffering, int, error) {
+	return s.GetNetworkOfferingByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetNetworkOfferingByIDWithContext is the same as GetNetworkOfferingByID, but uses ctx to cancel the request
+func (s *NetworkOfferingService) GetNetworkOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkOffering, int, error) {
 	p := &ListNetworkOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -750,18 +1394,16 @@ func (s *NetworkOfferingService) GetNetworkOfferingByID(id string, opts ...Optio
 		}
 	}
 
-	l, err := s.ListNetworkOfferings(p)
+	l, err := s.ListNetworkOfferingsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -772,7 +1414,16 @@ func (s *NetworkOfferingService) GetNetworkOfferingByID(id string, opts ...Optio
 
 // Lists all available network offerings.
 func (s *NetworkOfferingService) ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkOfferings", p.toURLValues())
+	return s.ListNetworkOfferingsWithContext(context.Background(), p)
+}
+
+// ListNetworkOfferingsWithContext is the same as ListNetworkOfferings, but uses ctx to cancel the request and any async job polling
+func (s *NetworkOfferingService) ListNetworkOfferingsWithContext(ctx context.Context, p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworkOfferings", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -785,6 +1436,92 @@ func (s *NetworkOfferingService) ListNetworkOfferings(p *ListNetworkOfferingsPar
 	return &r, nil
 }
 
+// ListNetworkOfferingsPager iterates over all pages of a ListNetworkOfferings call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListNetworkOfferingsPager struct {
+	pager
+
+	s *NetworkOfferingService
+	p *ListNetworkOfferingsParams
+	r *ListNetworkOfferingsResponse
+}
+
+// NewListNetworkOfferingsPager returns a pager for all pages of a ListNetworkOfferings call with the given params
+func (s *NetworkOfferingService) NewListNetworkOfferingsPager(p *ListNetworkOfferingsParams) *ListNetworkOfferingsPager {
+	return &ListNetworkOfferingsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListNetworkOfferingsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListNetworkOfferingsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListNetworkOfferingsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListNetworkOfferingsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.NetworkOfferings), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListNetworkOfferingsPager) Page() *ListNetworkOfferingsResponse {
+	return pg.r
+}
+
+// ListNetworkOfferingsAll fetches all pages of a ListNetworkOfferings call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetworkOfferingService) ListNetworkOfferingsAll(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
+	return s.ListNetworkOfferingsAllWithContext(context.Background(), p)
+}
+
+// ListNetworkOfferingsAllWithContext is the same as ListNetworkOfferingsAll, but uses ctx to cancel the requests
+func (s *NetworkOfferingService) ListNetworkOfferingsAllWithContext(ctx context.Context, p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*NetworkOffering)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListNetworkOfferingsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListNetworkOfferingsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.NetworkOfferings
+		mu.Unlock()
+
+		return len(l.NetworkOfferings), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListNetworkOfferingsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.NetworkOfferings = append(r.NetworkOfferings, pages[page]...)
+	}
+	r.Count = len(r.NetworkOfferings)
+
+	return r, nil
+}
+
 type ListNetworkOfferingsResponse struct {
 	Count            int                `json:"count"`
 	NetworkOfferings []*NetworkOffering `json:"networkoffering"`
@@ -848,7 +1585,8 @@ type UpdateNetworkOfferingParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateNetworkOfferingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateNetworkOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -892,6 +1630,62 @@ func (p *UpdateNetworkOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateNetworkOfferingParams) Validate() error {
+	v := newParamValidator("updateNetworkOffering", p.p)
+	v.ids("id")
+	v.maxLength("availability", 255)
+	v.maxLength("displaytext", 255)
+	v.maxLength("domainid", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	v.maxLength("tags", 4096)
+	v.maxLength("zoneid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateNetworkOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateNetworkOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "availability", "displaytext", "domainid", "id", "name", "state", "tags", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "keepaliveenabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "maxconnections", "sortkey":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateNetworkOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateNetworkOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateNetworkOfferingParams) SetAvailability(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -899,6 +1693,15 @@ func (p *UpdateNetworkOfferingParams) SetAvailability(v string) {
 	p.p["availability"] = v
 }
 
+func (p *UpdateNetworkOfferingParams) ResetAvailability() {
+	delete(p.p, "availability")
+}
+
+func (p *UpdateNetworkOfferingParams) GetAvailability() (string, bool) {
+	value, ok := p.p["availability"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -906,6 +1709,15 @@ func (p *UpdateNetworkOfferingParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *UpdateNetworkOfferingParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *UpdateNetworkOfferingParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkOfferingParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -913,6 +1725,15 @@ func (p *UpdateNetworkOfferingParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *UpdateNetworkOfferingParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *UpdateNetworkOfferingParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -920,6 +1741,15 @@ func (p *UpdateNetworkOfferingParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateNetworkOfferingParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateNetworkOfferingParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkOfferingParams) SetKeepaliveenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -927,6 +1757,15 @@ func (p *UpdateNetworkOfferingParams) SetKeepaliveenabled(v bool) {
 	p.p["keepaliveenabled"] = v
 }
 
+func (p *UpdateNetworkOfferingParams) ResetKeepaliveenabled() {
+	delete(p.p, "keepaliveenabled")
+}
+
+func (p *UpdateNetworkOfferingParams) GetKeepaliveenabled() (bool, bool) {
+	value, ok := p.p["keepaliveenabled"].(bool)
+	return value, ok
+}
+
 func (p *UpdateNetworkOfferingParams) SetMaxconnections(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -934,6 +1773,15 @@ func (p *UpdateNetworkOfferingParams) SetMaxconnections(v int) {
 	p.p["maxconnections"] = v
 }
 
+func (p *UpdateNetworkOfferingParams) ResetMaxconnections() {
+	delete(p.p, "maxconnections")
+}
+
+func (p *UpdateNetworkOfferingParams) GetMaxconnections() (int, bool) {
+	value, ok := p.p["maxconnections"].(int)
+	return value, ok
+}
+
 func (p *UpdateNetworkOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -941,6 +1789,15 @@ func (p *UpdateNetworkOfferingParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateNetworkOfferingParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateNetworkOfferingParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkOfferingParams) SetSortkey(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -948,6 +1805,15 @@ func (p *UpdateNetworkOfferingParams) SetSortkey(v int) {
 	p.p["sortkey"] = v
 }
 
+func (p *UpdateNetworkOfferingParams) ResetSortkey() {
+	delete(p.p, "sortkey")
+}
+
+func (p *UpdateNetworkOfferingParams) GetSortkey() (int, bool) {
+	value, ok := p.p["sortkey"].(int)
+	return value, ok
+}
+
 func (p *UpdateNetworkOfferingParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -955,6 +1821,15 @@ func (p *UpdateNetworkOfferingParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *UpdateNetworkOfferingParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *UpdateNetworkOfferingParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkOfferingParams) SetTags(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -962,6 +1837,15 @@ func (p *UpdateNetworkOfferingParams) SetTags(v string) {
 	p.p["tags"] = v
 }
 
+func (p *UpdateNetworkOfferingParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *UpdateNetworkOfferingParams) GetTags() (string, bool) {
+	value, ok := p.p["tags"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkOfferingParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -969,6 +1853,15 @@ func (p *UpdateNetworkOfferingParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *UpdateNetworkOfferingParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *UpdateNetworkOfferingParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateNetworkOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkOfferingService) NewUpdateNetworkOfferingParams() *UpdateNetworkOfferingParams {
@@ -979,7 +1872,16 @@ func (s *NetworkOfferingService) NewUpdateNetworkOfferingParams() *UpdateNetwork
 
 // Updates a network offering.
 func (s *NetworkOfferingService) UpdateNetworkOffering(p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkOffering", p.toURLValues())
+	return s.UpdateNetworkOfferingWithContext(context.Background(), p)
+}
+
+// UpdateNetworkOfferingWithContext is the same as UpdateNetworkOffering, but uses ctx to cancel the request and any async job polling
+func (s *NetworkOfferingService) UpdateNetworkOfferingWithContext(ctx context.Context, p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNetworkOffering", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -20,18 +20,196 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// NetworkServiceIface is the interface implemented by NetworkService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type NetworkServiceIface interface {
+	NewAddNetworkServiceProviderParams(name string, physicalnetworkid string) *AddNetworkServiceProviderParams
+	AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error)
+	AddNetworkServiceProviderWithContext(ctx context.Context, p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error)
+	AddNetworkServiceProviderAsync(p *AddNetworkServiceProviderParams) (*Job, error)
+	AddNetworkServiceProviderAsyncWithContext(ctx context.Context, p *AddNetworkServiceProviderParams) (*Job, error)
+	NewAddOpenDaylightControllerParams(password string, physicalnetworkid string, url string, username string) *AddOpenDaylightControllerParams
+	AddOpenDaylightController(p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error)
+	AddOpenDaylightControllerWithContext(ctx context.Context, p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error)
+	AddOpenDaylightControllerAsync(p *AddOpenDaylightControllerParams) (*Job, error)
+	AddOpenDaylightControllerAsyncWithContext(ctx context.Context, p *AddOpenDaylightControllerParams) (*Job, error)
+	NewCreateNetworkParams(displaytext string, name string, networkofferingid string, zoneid string) *CreateNetworkParams
+	CreateNetwork(p *CreateNetworkParams) (*CreateNetworkResponse, error)
+	CreateNetworkWithContext(ctx context.Context, p *CreateNetworkParams) (*CreateNetworkResponse, error)
+	NewCreatePhysicalNetworkParams(name string, zoneid string) *CreatePhysicalNetworkParams
+	CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error)
+	CreatePhysicalNetworkWithContext(ctx context.Context, p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error)
+	CreatePhysicalNetworkAsync(p *CreatePhysicalNetworkParams) (*Job, error)
+	CreatePhysicalNetworkAsyncWithContext(ctx context.Context, p *CreatePhysicalNetworkParams) (*Job, error)
+	NewCreateServiceInstanceParams(leftnetworkid string, name string, rightnetworkid string, serviceofferingid string, templateid string, zoneid string) *CreateServiceInstanceParams
+	CreateServiceInstance(p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error)
+	CreateServiceInstanceWithContext(ctx context.Context, p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error)
+	CreateServiceInstanceAsync(p *CreateServiceInstanceParams) (*Job, error)
+	CreateServiceInstanceAsyncWithContext(ctx context.Context, p *CreateServiceInstanceParams) (*Job, error)
+	NewCreateStorageNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) *CreateStorageNetworkIpRangeParams
+	CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error)
+	CreateStorageNetworkIpRangeWithContext(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error)
+	CreateStorageNetworkIpRangeAsync(p *CreateStorageNetworkIpRangeParams) (*Job, error)
+	CreateStorageNetworkIpRangeAsyncWithContext(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*Job, error)
+	NewDedicatePublicIpRangeParams(domainid string, id string) *DedicatePublicIpRangeParams
+	DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error)
+	DedicatePublicIpRangeWithContext(ctx context.Context, p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error)
+	NewDeleteNetworkParams(id string) *DeleteNetworkParams
+	DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error)
+	DeleteNetworkWithContext(ctx context.Context, p *DeleteNetworkParams) (*DeleteNetworkResponse, error)
+	DeleteNetworkAsync(p *DeleteNetworkParams) (*Job, error)
+	DeleteNetworkAsyncWithContext(ctx context.Context, p *DeleteNetworkParams) (*Job, error)
+	NewDeleteNetworkServiceProviderParams(id string) *DeleteNetworkServiceProviderParams
+	DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error)
+	DeleteNetworkServiceProviderWithContext(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error)
+	DeleteNetworkServiceProviderAsync(p *DeleteNetworkServiceProviderParams) (*Job, error)
+	DeleteNetworkServiceProviderAsyncWithContext(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*Job, error)
+	NewDeleteOpenDaylightControllerParams(id string) *DeleteOpenDaylightControllerParams
+	DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error)
+	DeleteOpenDaylightControllerWithContext(ctx context.Context, p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error)
+	DeleteOpenDaylightControllerAsync(p *DeleteOpenDaylightControllerParams) (*Job, error)
+	DeleteOpenDaylightControllerAsyncWithContext(ctx context.Context, p *DeleteOpenDaylightControllerParams) (*Job, error)
+	NewDeletePhysicalNetworkParams(id string) *DeletePhysicalNetworkParams
+	DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error)
+	DeletePhysicalNetworkWithContext(ctx context.Context, p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error)
+	DeletePhysicalNetworkAsync(p *DeletePhysicalNetworkParams) (*Job, error)
+	DeletePhysicalNetworkAsyncWithContext(ctx context.Context, p *DeletePhysicalNetworkParams) (*Job, error)
+	NewDeleteStorageNetworkIpRangeParams(id string) *DeleteStorageNetworkIpRangeParams
+	DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error)
+	DeleteStorageNetworkIpRangeWithContext(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error)
+	DeleteStorageNetworkIpRangeAsync(p *DeleteStorageNetworkIpRangeParams) (*Job, error)
+	DeleteStorageNetworkIpRangeAsyncWithContext(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*Job, error)
+	NewListNetscalerLoadBalancerNetworksParams(lbdeviceid string) *ListNetscalerLoadBalancerNetworksParams
+	GetNetscalerLoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetNetscalerLoadBalancerNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error)
+	ListNetscalerLoadBalancerNetworksWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error)
+	NewListNetscalerLoadBalancerNetworksPager(p *ListNetscalerLoadBalancerNetworksParams) *ListNetscalerLoadBalancerNetworksPager
+	ListNetscalerLoadBalancerNetworksAll(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error)
+	ListNetscalerLoadBalancerNetworksAllWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error)
+	NewListNetworkIsolationMethodsParams() *ListNetworkIsolationMethodsParams
+	ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error)
+	ListNetworkIsolationMethodsWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error)
+	NewListNetworkIsolationMethodsPager(p *ListNetworkIsolationMethodsParams) *ListNetworkIsolationMethodsPager
+	ListNetworkIsolationMethodsAll(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error)
+	ListNetworkIsolationMethodsAllWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error)
+	NewListNetworkServiceProvidersParams() *ListNetworkServiceProvidersParams
+	GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkServiceProviderIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error)
+	ListNetworkServiceProvidersWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error)
+	NewListNetworkServiceProvidersPager(p *ListNetworkServiceProvidersParams) *ListNetworkServiceProvidersPager
+	ListNetworkServiceProvidersAll(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error)
+	ListNetworkServiceProvidersAllWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error)
+	NewListNetworksParams() *ListNetworksParams
+	GetNetworkID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetNetworkIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
+	GetNetworkByName(name string, opts ...OptionFunc) (*Network, int, error)
+	GetNetworkByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Network, int, error)
+	GetNetworkByID(id string, opts ...OptionFunc) (*Network, int, error)
+	GetNetworkByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Network, int, error)
+	ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error)
+	ListNetworksWithContext(ctx context.Context, p *ListNetworksParams) (*ListNetworksResponse, error)
+	NewListNetworksPager(p *ListNetworksParams) *ListNetworksPager
+	ListNetworksAll(p *ListNetworksParams) (*ListNetworksResponse, error)
+	ListNetworksAllWithContext(ctx context.Context, p *ListNetworksParams) (*ListNetworksResponse, error)
+	NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string) *ListNiciraNvpDeviceNetworksParams
+	GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetNiciraNvpDeviceNetworkIDWithContext(ctx context.Context, keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error)
+	ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error)
+	ListNiciraNvpDeviceNetworksWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error)
+	NewListNiciraNvpDeviceNetworksPager(p *ListNiciraNvpDeviceNetworksParams) *ListNiciraNvpDeviceNetworksPager
+	ListNiciraNvpDeviceNetworksAll(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error)
+	ListNiciraNvpDeviceNetworksAllWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error)
+	NewListOpenDaylightControllersParams() *ListOpenDaylightControllersParams
+	GetOpenDaylightControllerByID(id string, opts ...OptionFunc) (*OpenDaylightController, int, error)
+	GetOpenDaylightControllerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OpenDaylightController, int, error)
+	ListOpenDaylightControllers(p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error)
+	ListOpenDaylightControllersWithContext(ctx context.Context, p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error)
+	NewListPaloAltoFirewallNetworksParams(lbdeviceid string) *ListPaloAltoFirewallNetworksParams
+	GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetPaloAltoFirewallNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error)
+	ListPaloAltoFirewallNetworksWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error)
+	NewListPaloAltoFirewallNetworksPager(p *ListPaloAltoFirewallNetworksParams) *ListPaloAltoFirewallNetworksPager
+	ListPaloAltoFirewallNetworksAll(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error)
+	ListPaloAltoFirewallNetworksAllWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error)
+	NewListPhysicalNetworksParams() *ListPhysicalNetworksParams
+	GetPhysicalNetworkID(name string, opts ...OptionFunc) (string, int, error)
+	GetPhysicalNetworkIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetPhysicalNetworkByName(name string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	GetPhysicalNetworkByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	GetPhysicalNetworkByID(id string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	GetPhysicalNetworkByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error)
+	ListPhysicalNetworksWithContext(ctx context.Context, p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error)
+	NewListPhysicalNetworksPager(p *ListPhysicalNetworksParams) *ListPhysicalNetworksPager
+	ListPhysicalNetworksAll(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error)
+	ListPhysicalNetworksAllWithContext(ctx context.Context, p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error)
+	NewListStorageNetworkIpRangeParams() *ListStorageNetworkIpRangeParams
+	GetStorageNetworkIpRangeByID(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error)
+	GetStorageNetworkIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error)
+	ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error)
+	ListStorageNetworkIpRangeWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error)
+	NewListStorageNetworkIpRangePager(p *ListStorageNetworkIpRangeParams) *ListStorageNetworkIpRangePager
+	ListStorageNetworkIpRangeAll(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error)
+	ListStorageNetworkIpRangeAllWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error)
+	NewListSupportedNetworkServicesParams() *ListSupportedNetworkServicesParams
+	ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error)
+	ListSupportedNetworkServicesWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error)
+	NewListSupportedNetworkServicesPager(p *ListSupportedNetworkServicesParams) *ListSupportedNetworkServicesPager
+	ListSupportedNetworkServicesAll(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error)
+	ListSupportedNetworkServicesAllWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error)
+	NewMigrateNetworkParams(networkid string, networkofferingid string) *MigrateNetworkParams
+	MigrateNetwork(p *MigrateNetworkParams) (*MigrateNetworkResponse, error)
+	MigrateNetworkWithContext(ctx context.Context, p *MigrateNetworkParams) (*MigrateNetworkResponse, error)
+	MigrateNetworkAsync(p *MigrateNetworkParams) (*Job, error)
+	MigrateNetworkAsyncWithContext(ctx context.Context, p *MigrateNetworkParams) (*Job, error)
+	NewReleasePublicIpRangeParams(id string) *ReleasePublicIpRangeParams
+	ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error)
+	ReleasePublicIpRangeWithContext(ctx context.Context, p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error)
+	NewRestartNetworkParams(id string) *RestartNetworkParams
+	RestartNetwork(p *RestartNetworkParams) (*RestartNetworkResponse, error)
+	RestartNetworkWithContext(ctx context.Context, p *RestartNetworkParams) (*RestartNetworkResponse, error)
+	RestartNetworkAsync(p *RestartNetworkParams) (*Job, error)
+	RestartNetworkAsyncWithContext(ctx context.Context, p *RestartNetworkParams) (*Job, error)
+	NewUpdateNetworkParams(id string) *UpdateNetworkParams
+	UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkResponse, error)
+	UpdateNetworkWithContext(ctx context.Context, p *UpdateNetworkParams) (*UpdateNetworkResponse, error)
+	UpdateNetworkAsync(p *UpdateNetworkParams) (*Job, error)
+	UpdateNetworkAsyncWithContext(ctx context.Context, p *UpdateNetworkParams) (*Job, error)
+	NewUpdateNetworkServiceProviderParams(id string) *UpdateNetworkServiceProviderParams
+	UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error)
+	UpdateNetworkServiceProviderWithContext(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error)
+	UpdateNetworkServiceProviderAsync(p *UpdateNetworkServiceProviderParams) (*Job, error)
+	UpdateNetworkServiceProviderAsyncWithContext(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*Job, error)
+	NewUpdatePhysicalNetworkParams(id string) *UpdatePhysicalNetworkParams
+	UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error)
+	UpdatePhysicalNetworkWithContext(ctx context.Context, p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error)
+	UpdatePhysicalNetworkAsync(p *UpdatePhysicalNetworkParams) (*Job, error)
+	UpdatePhysicalNetworkAsyncWithContext(ctx context.Context, p *UpdatePhysicalNetworkParams) (*Job, error)
+	NewUpdateStorageNetworkIpRangeParams(id string) *UpdateStorageNetworkIpRangeParams
+	UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error)
+	UpdateStorageNetworkIpRangeWithContext(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error)
+	UpdateStorageNetworkIpRangeAsync(p *UpdateStorageNetworkIpRangeParams) (*Job, error)
+	UpdateStorageNetworkIpRangeAsyncWithContext(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*Job, error)
+}
+
 type AddNetworkServiceProviderParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddNetworkServiceProviderParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddNetworkServiceProviderParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -52,6 +230,53 @@ func (p *AddNetworkServiceProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddNetworkServiceProviderParams) Validate() error {
+	v := newParamValidator("addNetworkServiceProvider", p.p)
+	v.required("name", "physicalnetworkid")
+	v.ids("destinationphysicalnetworkid", "physicalnetworkid")
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddNetworkServiceProviderParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddNetworkServiceProviderParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "destinationphysicalnetworkid", "name", "physicalnetworkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "servicelist":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addNetworkServiceProvider", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addNetworkServiceProvider: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddNetworkServiceProviderParams) SetDestinationphysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -59,6 +284,15 @@ func (p *AddNetworkServiceProviderParams) SetDestinationphysicalnetworkid(v stri
 	p.p["destinationphysicalnetworkid"] = v
 }
 
+func (p *AddNetworkServiceProviderParams) ResetDestinationphysicalnetworkid() {
+	delete(p.p, "destinationphysicalnetworkid")
+}
+
+func (p *AddNetworkServiceProviderParams) GetDestinationphysicalnetworkid() (string, bool) {
+	value, ok := p.p["destinationphysicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *AddNetworkServiceProviderParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -66,6 +300,15 @@ func (p *AddNetworkServiceProviderParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *AddNetworkServiceProviderParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *AddNetworkServiceProviderParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *AddNetworkServiceProviderParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -73,6 +316,15 @@ func (p *AddNetworkServiceProviderParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *AddNetworkServiceProviderParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddNetworkServiceProviderParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *AddNetworkServiceProviderParams) SetServicelist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -80,6 +332,15 @@ func (p *AddNetworkServiceProviderParams) SetServicelist(v []string) {
 	p.p["servicelist"] = v
 }
 
+func (p *AddNetworkServiceProviderParams) ResetServicelist() {
+	delete(p.p, "servicelist")
+}
+
+func (p *AddNetworkServiceProviderParams) GetServicelist() ([]string, bool) {
+	value, ok := p.p["servicelist"].([]string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddNetworkServiceProviderParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewAddNetworkServiceProviderParams(name string, physicalnetworkid string) *AddNetworkServiceProviderParams {
@@ -92,7 +353,16 @@ func (s *NetworkService) NewAddNetworkServiceProviderParams(name string, physica
 
 // Adds a network serviceProvider to a physical network
 func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("addNetworkServiceProvider", p.toURLValues())
+	return s.AddNetworkServiceProviderWithContext(context.Background(), p)
+}
+
+// AddNetworkServiceProviderWithContext is the same as AddNetworkServiceProvider, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) AddNetworkServiceProviderWithContext(ctx context.Context, p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addNetworkServiceProvider", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +374,7 @@ func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -125,6 +395,40 @@ func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderP
 	return &r, nil
 }
 
+// AddNetworkServiceProviderAsync starts the async job for AddNetworkServiceProvider and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddNetworkServiceProviderResponse using Job.Result
+func (s *NetworkService) AddNetworkServiceProviderAsync(p *AddNetworkServiceProviderParams) (*Job, error) {
+	return s.AddNetworkServiceProviderAsyncWithContext(context.Background(), p)
+}
+
+// AddNetworkServiceProviderAsyncWithContext is the same as AddNetworkServiceProviderAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) AddNetworkServiceProviderAsyncWithContext(ctx context.Context, p *AddNetworkServiceProviderParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addNetworkServiceProvider", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddNetworkServiceProviderResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AddNetworkServiceProviderResponse struct {
 	Canenableindividualservice   bool     `json:"canenableindividualservice"`
 	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
@@ -141,7 +445,8 @@ type AddOpenDaylightControllerParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddOpenDaylightControllerParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddOpenDaylightControllerParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -161,6 +466,51 @@ func (p *AddOpenDaylightControllerParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddOpenDaylightControllerParams) Validate() error {
+	v := newParamValidator("addOpenDaylightController", p.p)
+	v.required("password", "physicalnetworkid", "url", "username")
+	v.ids("physicalnetworkid")
+	v.maxLength("password", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddOpenDaylightControllerParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddOpenDaylightControllerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "password", "physicalnetworkid", "url", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addOpenDaylightController", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addOpenDaylightController: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddOpenDaylightControllerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -168,6 +518,15 @@ func (p *AddOpenDaylightControllerParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *AddOpenDaylightControllerParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddOpenDaylightControllerParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *AddOpenDaylightControllerParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -175,6 +534,15 @@ func (p *AddOpenDaylightControllerParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *AddOpenDaylightControllerParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddOpenDaylightControllerParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *AddOpenDaylightControllerParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -182,6 +550,15 @@ func (p *AddOpenDaylightControllerParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *AddOpenDaylightControllerParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddOpenDaylightControllerParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *AddOpenDaylightControllerParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -189,6 +566,15 @@ func (p *AddOpenDaylightControllerParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddOpenDaylightControllerParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddOpenDaylightControllerParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddOpenDaylightControllerParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewAddOpenDaylightControllerParams(password string, physicalnetworkid string, url string, username string) *AddOpenDaylightControllerParams {
@@ -203,7 +589,16 @@ func (s *NetworkService) NewAddOpenDaylightControllerParams(password string, phy
 
 // Adds an OpenDyalight controler
 func (s *NetworkService) AddOpenDaylightController(p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error) {
-	resp, err := s.cs.newRequest("addOpenDaylightController", p.toURLValues())
+	return s.AddOpenDaylightControllerWithContext(context.Background(), p)
+}
+
+// AddOpenDaylightControllerWithContext is the same as AddOpenDaylightController, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) AddOpenDaylightControllerWithContext(ctx context.Context, p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addOpenDaylightController", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -215,7 +610,7 @@ func (s *NetworkService) AddOpenDaylightController(p *AddOpenDaylightControllerP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -236,6 +631,40 @@ func (s *NetworkService) AddOpenDaylightController(p *AddOpenDaylightControllerP
 	return &r, nil
 }
 
+// AddOpenDaylightControllerAsync starts the async job for AddOpenDaylightController and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddOpenDaylightControllerResponse using Job.Result
+func (s *NetworkService) AddOpenDaylightControllerAsync(p *AddOpenDaylightControllerParams) (*Job, error) {
+	return s.AddOpenDaylightControllerAsyncWithContext(context.Background(), p)
+}
+
+// AddOpenDaylightControllerAsyncWithContext is the same as AddOpenDaylightControllerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) AddOpenDaylightControllerAsyncWithContext(ctx context.Context, p *AddOpenDaylightControllerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addOpenDaylightController", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddOpenDaylightControllerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AddOpenDaylightControllerResponse struct {
 	Id                string `json:"id"`
 	JobID             string `json:"jobid"`
@@ -250,7 +679,8 @@ type CreateNetworkParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateNetworkParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateNetworkParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -346,6 +776,70 @@ func (p *CreateNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateNetworkParams) Validate() error {
+	v := newParamValidator("createNetwork", p.p)
+	v.required("displaytext", "name", "networkofferingid", "zoneid")
+	v.ids("aclid", "domainid", "networkofferingid", "physicalnetworkid", "projectid", "vpcid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("acltype", 255)
+	v.oneOf("acltype", "account", "domain")
+	v.maxLength("displaytext", 255)
+	v.maxLength("endip", 255)
+	v.maxLength("endipv6", 255)
+	v.maxLength("externalid", 255)
+	v.maxLength("gateway", 255)
+	v.maxLength("ip6cidr", 255)
+	v.maxLength("ip6gateway", 255)
+	v.maxLength("isolatedpvlan", 255)
+	v.maxLength("isolatedpvlantype", 255)
+	v.maxLength("name", 255)
+	v.maxLength("netmask", 255)
+	v.maxLength("networkdomain", 255)
+	v.maxLength("startip", 255)
+	v.maxLength("startipv6", 255)
+	v.maxLength("vlan", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateNetworkParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateNetworkParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "aclid", "acltype", "displaytext", "domainid", "endip", "endipv6", "externalid", "gateway", "ip6cidr", "ip6gateway", "isolatedpvlan", "isolatedpvlantype", "name", "netmask", "networkdomain", "networkofferingid", "physicalnetworkid", "projectid", "startip", "startipv6", "vlan", "vpcid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "bypassvlanoverlapcheck", "displaynetwork", "hideipaddressusage", "subdomainaccess":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createNetwork", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createNetwork: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateNetworkParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -353,6 +847,15 @@ func (p *CreateNetworkParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateNetworkParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateNetworkParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetAclid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -360,6 +863,15 @@ func (p *CreateNetworkParams) SetAclid(v string) {
 	p.p["aclid"] = v
 }
 
+func (p *CreateNetworkParams) ResetAclid() {
+	delete(p.p, "aclid")
+}
+
+func (p *CreateNetworkParams) GetAclid() (string, bool) {
+	value, ok := p.p["aclid"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetAcltype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -367,6 +879,15 @@ func (p *CreateNetworkParams) SetAcltype(v string) {
 	p.p["acltype"] = v
 }
 
+func (p *CreateNetworkParams) ResetAcltype() {
+	delete(p.p, "acltype")
+}
+
+func (p *CreateNetworkParams) GetAcltype() (string, bool) {
+	value, ok := p.p["acltype"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetBypassvlanoverlapcheck(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -374,6 +895,15 @@ func (p *CreateNetworkParams) SetBypassvlanoverlapcheck(v bool) {
 	p.p["bypassvlanoverlapcheck"] = v
 }
 
+func (p *CreateNetworkParams) ResetBypassvlanoverlapcheck() {
+	delete(p.p, "bypassvlanoverlapcheck")
+}
+
+func (p *CreateNetworkParams) GetBypassvlanoverlapcheck() (bool, bool) {
+	value, ok := p.p["bypassvlanoverlapcheck"].(bool)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetDisplaynetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -381,6 +911,15 @@ func (p *CreateNetworkParams) SetDisplaynetwork(v bool) {
 	p.p["displaynetwork"] = v
 }
 
+func (p *CreateNetworkParams) ResetDisplaynetwork() {
+	delete(p.p, "displaynetwork")
+}
+
+func (p *CreateNetworkParams) GetDisplaynetwork() (bool, bool) {
+	value, ok := p.p["displaynetwork"].(bool)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -388,6 +927,15 @@ func (p *CreateNetworkParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *CreateNetworkParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *CreateNetworkParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -395,6 +943,15 @@ func (p *CreateNetworkParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateNetworkParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateNetworkParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -402,6 +959,15 @@ func (p *CreateNetworkParams) SetEndip(v string) {
 	p.p["endip"] = v
 }
 
+func (p *CreateNetworkParams) ResetEndip() {
+	delete(p.p, "endip")
+}
+
+func (p *CreateNetworkParams) GetEndip() (string, bool) {
+	value, ok := p.p["endip"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetEndipv6(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -409,6 +975,15 @@ func (p *CreateNetworkParams) SetEndipv6(v string) {
 	p.p["endipv6"] = v
 }
 
+func (p *CreateNetworkParams) ResetEndipv6() {
+	delete(p.p, "endipv6")
+}
+
+func (p *CreateNetworkParams) GetEndipv6() (string, bool) {
+	value, ok := p.p["endipv6"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetExternalid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -416,6 +991,15 @@ func (p *CreateNetworkParams) SetExternalid(v string) {
 	p.p["externalid"] = v
 }
 
+func (p *CreateNetworkParams) ResetExternalid() {
+	delete(p.p, "externalid")
+}
+
+func (p *CreateNetworkParams) GetExternalid() (string, bool) {
+	value, ok := p.p["externalid"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -423,6 +1007,15 @@ func (p *CreateNetworkParams) SetGateway(v string) {
 	p.p["gateway"] = v
 }
 
+func (p *CreateNetworkParams) ResetGateway() {
+	delete(p.p, "gateway")
+}
+
+func (p *CreateNetworkParams) GetGateway() (string, bool) {
+	value, ok := p.p["gateway"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetHideipaddressusage(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -430,6 +1023,15 @@ func (p *CreateNetworkParams) SetHideipaddressusage(v bool) {
 	p.p["hideipaddressusage"] = v
 }
 
+func (p *CreateNetworkParams) ResetHideipaddressusage() {
+	delete(p.p, "hideipaddressusage")
+}
+
+func (p *CreateNetworkParams) GetHideipaddressusage() (bool, bool) {
+	value, ok := p.p["hideipaddressusage"].(bool)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetIp6cidr(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -437,6 +1039,15 @@ func (p *CreateNetworkParams) SetIp6cidr(v string) {
 	p.p["ip6cidr"] = v
 }
 
+func (p *CreateNetworkParams) ResetIp6cidr() {
+	delete(p.p, "ip6cidr")
+}
+
+func (p *CreateNetworkParams) GetIp6cidr() (string, bool) {
+	value, ok := p.p["ip6cidr"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetIp6gateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -444,6 +1055,15 @@ func (p *CreateNetworkParams) SetIp6gateway(v string) {
 	p.p["ip6gateway"] = v
 }
 
+func (p *CreateNetworkParams) ResetIp6gateway() {
+	delete(p.p, "ip6gateway")
+}
+
+func (p *CreateNetworkParams) GetIp6gateway() (string, bool) {
+	value, ok := p.p["ip6gateway"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetIsolatedpvlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -451,6 +1071,15 @@ func (p *CreateNetworkParams) SetIsolatedpvlan(v string) {
 	p.p["isolatedpvlan"] = v
 }
 
+func (p *CreateNetworkParams) ResetIsolatedpvlan() {
+	delete(p.p, "isolatedpvlan")
+}
+
+func (p *CreateNetworkParams) GetIsolatedpvlan() (string, bool) {
+	value, ok := p.p["isolatedpvlan"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetIsolatedpvlantype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -458,6 +1087,15 @@ func (p *CreateNetworkParams) SetIsolatedpvlantype(v string) {
 	p.p["isolatedpvlantype"] = v
 }
 
+func (p *CreateNetworkParams) ResetIsolatedpvlantype() {
+	delete(p.p, "isolatedpvlantype")
+}
+
+func (p *CreateNetworkParams) GetIsolatedpvlantype() (string, bool) {
+	value, ok := p.p["isolatedpvlantype"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -465,6 +1103,15 @@ func (p *CreateNetworkParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateNetworkParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateNetworkParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -472,6 +1119,15 @@ func (p *CreateNetworkParams) SetNetmask(v string) {
 	p.p["netmask"] = v
 }
 
+func (p *CreateNetworkParams) ResetNetmask() {
+	delete(p.p, "netmask")
+}
+
+func (p *CreateNetworkParams) GetNetmask() (string, bool) {
+	value, ok := p.p["netmask"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -479,6 +1135,15 @@ func (p *CreateNetworkParams) SetNetworkdomain(v string) {
 	p.p["networkdomain"] = v
 }
 
+func (p *CreateNetworkParams) ResetNetworkdomain() {
+	delete(p.p, "networkdomain")
+}
+
+func (p *CreateNetworkParams) GetNetworkdomain() (string, bool) {
+	value, ok := p.p["networkdomain"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetNetworkofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -486,6 +1151,15 @@ func (p *CreateNetworkParams) SetNetworkofferingid(v string) {
 	p.p["networkofferingid"] = v
 }
 
+func (p *CreateNetworkParams) ResetNetworkofferingid() {
+	delete(p.p, "networkofferingid")
+}
+
+func (p *CreateNetworkParams) GetNetworkofferingid() (string, bool) {
+	value, ok := p.p["networkofferingid"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -493,6 +1167,15 @@ func (p *CreateNetworkParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *CreateNetworkParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *CreateNetworkParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -500,6 +1183,15 @@ func (p *CreateNetworkParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *CreateNetworkParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *CreateNetworkParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetStartip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -507,6 +1199,15 @@ func (p *CreateNetworkParams) SetStartip(v string) {
 	p.p["startip"] = v
 }
 
+func (p *CreateNetworkParams) ResetStartip() {
+	delete(p.p, "startip")
+}
+
+func (p *CreateNetworkParams) GetStartip() (string, bool) {
+	value, ok := p.p["startip"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetStartipv6(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -514,6 +1215,15 @@ func (p *CreateNetworkParams) SetStartipv6(v string) {
 	p.p["startipv6"] = v
 }
 
+func (p *CreateNetworkParams) ResetStartipv6() {
+	delete(p.p, "startipv6")
+}
+
+func (p *CreateNetworkParams) GetStartipv6() (string, bool) {
+	value, ok := p.p["startipv6"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetSubdomainaccess(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -521,6 +1231,15 @@ func (p *CreateNetworkParams) SetSubdomainaccess(v bool) {
 	p.p["subdomainaccess"] = v
 }
 
+func (p *CreateNetworkParams) ResetSubdomainaccess() {
+	delete(p.p, "subdomainaccess")
+}
+
+func (p *CreateNetworkParams) GetSubdomainaccess() (bool, bool) {
+	value, ok := p.p["subdomainaccess"].(bool)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -528,6 +1247,15 @@ func (p *CreateNetworkParams) SetVlan(v string) {
 	p.p["vlan"] = v
 }
 
+func (p *CreateNetworkParams) ResetVlan() {
+	delete(p.p, "vlan")
+}
+
+func (p *CreateNetworkParams) GetVlan() (string, bool) {
+	value, ok := p.p["vlan"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -535,6 +1263,15 @@ func (p *CreateNetworkParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *CreateNetworkParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *CreateNetworkParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 func (p *CreateNetworkParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -542,6 +1279,15 @@ func (p *CreateNetworkParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *CreateNetworkParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreateNetworkParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateNetworkParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewCreateNetworkParams(displaytext string, name string, networkofferingid string, zoneid string) *CreateNetworkParams {
@@ -556,7 +1302,16 @@ func (s *NetworkService) NewCreateNetworkParams(displaytext string, name string,
 
 // Creates a network
 func (s *NetworkService) CreateNetwork(p *CreateNetworkParams) (*CreateNetworkResponse, error) {
-	resp, err := s.cs.newRequest("createNetwork", p.toURLValues())
+	return s.CreateNetworkWithContext(context.Background(), p)
+}
+
+// CreateNetworkWithContext is the same as CreateNetwork, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) CreateNetworkWithContext(ctx context.Context, p *CreateNetworkParams) (*CreateNetworkResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createNetwork", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -657,7 +1412,8 @@ type CreatePhysicalNetworkParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreatePhysicalNetworkParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreatePhysicalNetworkParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -691,27 +1447,104 @@ func (p *CreatePhysicalNetworkParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *CreatePhysicalNetworkParams) SetBroadcastdomainrange(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["broadcastdomainrange"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreatePhysicalNetworkParams) Validate() error {
+	v := newParamValidator("createPhysicalNetwork", p.p)
+	v.required("name", "zoneid")
+	v.ids("domainid", "zoneid")
+	v.maxLength("broadcastdomainrange", 255)
+	v.maxLength("name", 255)
+	v.maxLength("networkspeed", 255)
+	v.maxLength("vlan", 255)
+	return v.err()
 }
 
-func (p *CreatePhysicalNetworkParams) SetDomainid(v string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreatePhysicalNetworkParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["domainid"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *CreatePhysicalNetworkParams) SetIsolationmethods(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreatePhysicalNetworkParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "broadcastdomainrange", "domainid", "name", "networkspeed", "vlan", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isolationmethods", "tags":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createPhysicalNetwork", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createPhysicalNetwork: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *CreatePhysicalNetworkParams) SetBroadcastdomainrange(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["broadcastdomainrange"] = v
+}
+
+func (p *CreatePhysicalNetworkParams) ResetBroadcastdomainrange() {
+	delete(p.p, "broadcastdomainrange")
+}
+
+func (p *CreatePhysicalNetworkParams) GetBroadcastdomainrange() (string, bool) {
+	value, ok := p.p["broadcastdomainrange"].(string)
+	return value, ok
+}
+
+func (p *CreatePhysicalNetworkParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *CreatePhysicalNetworkParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreatePhysicalNetworkParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *CreatePhysicalNetworkParams) SetIsolationmethods(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
 	p.p["isolationmethods"] = v
 }
 
+func (p *CreatePhysicalNetworkParams) ResetIsolationmethods() {
+	delete(p.p, "isolationmethods")
+}
+
+func (p *CreatePhysicalNetworkParams) GetIsolationmethods() ([]string, bool) {
+	value, ok := p.p["isolationmethods"].([]string)
+	return value, ok
+}
+
 func (p *CreatePhysicalNetworkParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -719,6 +1552,15 @@ func (p *CreatePhysicalNetworkParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreatePhysicalNetworkParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreatePhysicalNetworkParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreatePhysicalNetworkParams) SetNetworkspeed(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -726,6 +1568,15 @@ func (p *CreatePhysicalNetworkParams) SetNetworkspeed(v string) {
 	p.p["networkspeed"] = v
 }
 
+func (p *CreatePhysicalNetworkParams) ResetNetworkspeed() {
+	delete(p.p, "networkspeed")
+}
+
+func (p *CreatePhysicalNetworkParams) GetNetworkspeed() (string, bool) {
+	value, ok := p.p["networkspeed"].(string)
+	return value, ok
+}
+
 func (p *CreatePhysicalNetworkParams) SetTags(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -733,6 +1584,15 @@ func (p *CreatePhysicalNetworkParams) SetTags(v []string) {
 	p.p["tags"] = v
 }
 
+func (p *CreatePhysicalNetworkParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *CreatePhysicalNetworkParams) GetTags() ([]string, bool) {
+	value, ok := p.p["tags"].([]string)
+	return value, ok
+}
+
 func (p *CreatePhysicalNetworkParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -740,6 +1600,15 @@ func (p *CreatePhysicalNetworkParams) SetVlan(v string) {
 	p.p["vlan"] = v
 }
 
+func (p *CreatePhysicalNetworkParams) ResetVlan() {
+	delete(p.p, "vlan")
+}
+
+func (p *CreatePhysicalNetworkParams) GetVlan() (string, bool) {
+	value, ok := p.p["vlan"].(string)
+	return value, ok
+}
+
 func (p *CreatePhysicalNetworkParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -747,6 +1616,15 @@ func (p *CreatePhysicalNetworkParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *CreatePhysicalNetworkParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreatePhysicalNetworkParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreatePhysicalNetworkParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewCreatePhysicalNetworkParams(name string, zoneid string) *CreatePhysicalNetworkParams {
@@ -759,7 +1637,16 @@ func (s *NetworkService) NewCreatePhysicalNetworkParams(name string, zoneid stri
 
 // Creates a physical network
 func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("createPhysicalNetwork", p.toURLValues())
+	return s.CreatePhysicalNetworkWithContext(context.Background(), p)
+}
+
+// CreatePhysicalNetworkWithContext is the same as CreatePhysicalNetwork, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) CreatePhysicalNetworkWithContext(ctx context.Context, p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createPhysicalNetwork", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -771,7 +1658,7 @@ func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -792,6 +1679,40 @@ func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (
 	return &r, nil
 }
 
+// CreatePhysicalNetworkAsync starts the async job for CreatePhysicalNetwork and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreatePhysicalNetworkResponse using Job.Result
+func (s *NetworkService) CreatePhysicalNetworkAsync(p *CreatePhysicalNetworkParams) (*Job, error) {
+	return s.CreatePhysicalNetworkAsyncWithContext(context.Background(), p)
+}
+
+// CreatePhysicalNetworkAsyncWithContext is the same as CreatePhysicalNetworkAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) CreatePhysicalNetworkAsyncWithContext(ctx context.Context, p *CreatePhysicalNetworkParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createPhysicalNetwork", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreatePhysicalNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreatePhysicalNetworkResponse struct {
 	Broadcastdomainrange string `json:"broadcastdomainrange"`
 	Domainid             string `json:"domainid"`
@@ -812,7 +1733,8 @@ type CreateServiceInstanceParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateServiceInstanceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateServiceInstanceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -847,6 +1769,50 @@ func (p *CreateServiceInstanceParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateServiceInstanceParams) Validate() error {
+	v := newParamValidator("createServiceInstance", p.p)
+	v.required("leftnetworkid", "name", "rightnetworkid", "serviceofferingid", "templateid", "zoneid")
+	v.ids("domainid", "leftnetworkid", "projectid", "rightnetworkid", "serviceofferingid", "templateid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateServiceInstanceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateServiceInstanceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "leftnetworkid", "name", "projectid", "rightnetworkid", "serviceofferingid", "templateid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createServiceInstance", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createServiceInstance: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateServiceInstanceParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -854,6 +1820,15 @@ func (p *CreateServiceInstanceParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateServiceInstanceParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateServiceInstanceParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceInstanceParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -861,6 +1836,15 @@ func (p *CreateServiceInstanceParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateServiceInstanceParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateServiceInstanceParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceInstanceParams) SetLeftnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -868,6 +1852,15 @@ func (p *CreateServiceInstanceParams) SetLeftnetworkid(v string) {
 	p.p["leftnetworkid"] = v
 }
 
+func (p *CreateServiceInstanceParams) ResetLeftnetworkid() {
+	delete(p.p, "leftnetworkid")
+}
+
+func (p *CreateServiceInstanceParams) GetLeftnetworkid() (string, bool) {
+	value, ok := p.p["leftnetworkid"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceInstanceParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -875,6 +1868,15 @@ func (p *CreateServiceInstanceParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateServiceInstanceParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateServiceInstanceParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceInstanceParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -882,6 +1884,15 @@ func (p *CreateServiceInstanceParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *CreateServiceInstanceParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *CreateServiceInstanceParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceInstanceParams) SetRightnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -889,6 +1900,15 @@ func (p *CreateServiceInstanceParams) SetRightnetworkid(v string) {
 	p.p["rightnetworkid"] = v
 }
 
+func (p *CreateServiceInstanceParams) ResetRightnetworkid() {
+	delete(p.p, "rightnetworkid")
+}
+
+func (p *CreateServiceInstanceParams) GetRightnetworkid() (string, bool) {
+	value, ok := p.p["rightnetworkid"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceInstanceParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -896,6 +1916,15 @@ func (p *CreateServiceInstanceParams) SetServiceofferingid(v string) {
 	p.p["serviceofferingid"] = v
 }
 
+func (p *CreateServiceInstanceParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *CreateServiceInstanceParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceInstanceParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -903,6 +1932,15 @@ func (p *CreateServiceInstanceParams) SetTemplateid(v string) {
 	p.p["templateid"] = v
 }
 
+func (p *CreateServiceInstanceParams) ResetTemplateid() {
+	delete(p.p, "templateid")
+}
+
+func (p *CreateServiceInstanceParams) GetTemplateid() (string, bool) {
+	value, ok := p.p["templateid"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceInstanceParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -910,6 +1948,15 @@ func (p *CreateServiceInstanceParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *CreateServiceInstanceParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreateServiceInstanceParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateServiceInstanceParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewCreateServiceInstanceParams(leftnetworkid string, name string, rightnetworkid string, serviceofferingid string, templateid string, zoneid string) *CreateServiceInstanceParams {
@@ -926,7 +1973,16 @@ func (s *NetworkService) NewCreateServiceInstanceParams(leftnetworkid string, na
 
 // Creates a system virtual-machine that implements network services
 func (s *NetworkService) CreateServiceInstance(p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error) {
-	resp, err := s.cs.newRequest("createServiceInstance", p.toURLValues())
+	return s.CreateServiceInstanceWithContext(context.Background(), p)
+}
+
+// CreateServiceInstanceWithContext is the same as CreateServiceInstance, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) CreateServiceInstanceWithContext(ctx context.Context, p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createServiceInstance", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -938,7 +1994,7 @@ func (s *NetworkService) CreateServiceInstance(p *CreateServiceInstanceParams) (
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -959,6 +2015,40 @@ func (s *NetworkService) CreateServiceInstance(p *CreateServiceInstanceParams) (
 	return &r, nil
 }
 
+// CreateServiceInstanceAsync starts the async job for CreateServiceInstance and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateServiceInstanceResponse using Job.Result
+func (s *NetworkService) CreateServiceInstanceAsync(p *CreateServiceInstanceParams) (*Job, error) {
+	return s.CreateServiceInstanceAsyncWithContext(context.Background(), p)
+}
+
+// CreateServiceInstanceAsyncWithContext is the same as CreateServiceInstanceAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) CreateServiceInstanceAsyncWithContext(ctx context.Context, p *CreateServiceInstanceParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createServiceInstance", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateServiceInstanceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateServiceInstanceResponse struct {
 	Account     string `json:"account"`
 	Displayname string `json:"displayname"`
@@ -976,7 +2066,8 @@ type CreateStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateStorageNetworkIpRangeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateStorageNetworkIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1003,6 +2094,56 @@ func (p *CreateStorageNetworkIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateStorageNetworkIpRangeParams) Validate() error {
+	v := newParamValidator("createStorageNetworkIpRange", p.p)
+	v.required("gateway", "netmask", "podid", "startip")
+	v.ids("podid")
+	v.maxLength("endip", 255)
+	v.maxLength("gateway", 255)
+	v.maxLength("netmask", 255)
+	v.maxLength("startip", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateStorageNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateStorageNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "endip", "gateway", "netmask", "podid", "startip":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "vlan":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createStorageNetworkIpRange", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createStorageNetworkIpRange: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateStorageNetworkIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1010,6 +2151,15 @@ func (p *CreateStorageNetworkIpRangeParams) SetEndip(v string) {
 	p.p["endip"] = v
 }
 
+func (p *CreateStorageNetworkIpRangeParams) ResetEndip() {
+	delete(p.p, "endip")
+}
+
+func (p *CreateStorageNetworkIpRangeParams) GetEndip() (string, bool) {
+	value, ok := p.p["endip"].(string)
+	return value, ok
+}
+
 func (p *CreateStorageNetworkIpRangeParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1017,6 +2167,15 @@ func (p *CreateStorageNetworkIpRangeParams) SetGateway(v string) {
 	p.p["gateway"] = v
 }
 
+func (p *CreateStorageNetworkIpRangeParams) ResetGateway() {
+	delete(p.p, "gateway")
+}
+
+func (p *CreateStorageNetworkIpRangeParams) GetGateway() (string, bool) {
+	value, ok := p.p["gateway"].(string)
+	return value, ok
+}
+
 func (p *CreateStorageNetworkIpRangeParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1024,6 +2183,15 @@ func (p *CreateStorageNetworkIpRangeParams) SetNetmask(v string) {
 	p.p["netmask"] = v
 }
 
+func (p *CreateStorageNetworkIpRangeParams) ResetNetmask() {
+	delete(p.p, "netmask")
+}
+
+func (p *CreateStorageNetworkIpRangeParams) GetNetmask() (string, bool) {
+	value, ok := p.p["netmask"].(string)
+	return value, ok
+}
+
 func (p *CreateStorageNetworkIpRangeParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1031,6 +2199,15 @@ func (p *CreateStorageNetworkIpRangeParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *CreateStorageNetworkIpRangeParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *CreateStorageNetworkIpRangeParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *CreateStorageNetworkIpRangeParams) SetStartip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1038,6 +2215,15 @@ func (p *CreateStorageNetworkIpRangeParams) SetStartip(v string) {
 	p.p["startip"] = v
 }
 
+func (p *CreateStorageNetworkIpRangeParams) ResetStartip() {
+	delete(p.p, "startip")
+}
+
+func (p *CreateStorageNetworkIpRangeParams) GetStartip() (string, bool) {
+	value, ok := p.p["startip"].(string)
+	return value, ok
+}
+
 func (p *CreateStorageNetworkIpRangeParams) SetVlan(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1045,6 +2231,15 @@ func (p *CreateStorageNetworkIpRangeParams) SetVlan(v int) {
 	p.p["vlan"] = v
 }
 
+func (p *CreateStorageNetworkIpRangeParams) ResetVlan() {
+	delete(p.p, "vlan")
+}
+
+func (p *CreateStorageNetworkIpRangeParams) GetVlan() (int, bool) {
+	value, ok := p.p["vlan"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateStorageNetworkIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewCreateStorageNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) *CreateStorageNetworkIpRangeParams {
@@ -1059,7 +2254,16 @@ func (s *NetworkService) NewCreateStorageNetworkIpRangeParams(gateway string, ne
 
 // Creates a Storage network IP range.
 func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("createStorageNetworkIpRange", p.toURLValues())
+	return s.CreateStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// CreateStorageNetworkIpRangeWithContext is the same as CreateStorageNetworkIpRange, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) CreateStorageNetworkIpRangeWithContext(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createStorageNetworkIpRange", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1071,7 +2275,7 @@ func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1092,6 +2296,40 @@ func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRa
 	return &r, nil
 }
 
+// CreateStorageNetworkIpRangeAsync starts the async job for CreateStorageNetworkIpRange and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateStorageNetworkIpRangeResponse using Job.Result
+func (s *NetworkService) CreateStorageNetworkIpRangeAsync(p *CreateStorageNetworkIpRangeParams) (*Job, error) {
+	return s.CreateStorageNetworkIpRangeAsyncWithContext(context.Background(), p)
+}
+
+// CreateStorageNetworkIpRangeAsyncWithContext is the same as CreateStorageNetworkIpRangeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) CreateStorageNetworkIpRangeAsyncWithContext(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createStorageNetworkIpRange", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateStorageNetworkIpRangeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateStorageNetworkIpRangeResponse struct {
 	Endip     string `json:"endip"`
 	Gateway   string `json:"gateway"`
@@ -1110,7 +2348,8 @@ type DedicatePublicIpRangeParams struct {
 	p map[string]interface{}
 }
 
-func (p *DedicatePublicIpRangeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DedicatePublicIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1130,6 +2369,49 @@ func (p *DedicatePublicIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DedicatePublicIpRangeParams) Validate() error {
+	v := newParamValidator("dedicatePublicIpRange", p.p)
+	v.required("domainid", "id")
+	v.ids("domainid", "id", "projectid")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DedicatePublicIpRangeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DedicatePublicIpRangeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for dedicatePublicIpRange", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of dedicatePublicIpRange: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DedicatePublicIpRangeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1137,6 +2419,15 @@ func (p *DedicatePublicIpRangeParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *DedicatePublicIpRangeParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *DedicatePublicIpRangeParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *DedicatePublicIpRangeParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1144,6 +2435,15 @@ func (p *DedicatePublicIpRangeParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *DedicatePublicIpRangeParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *DedicatePublicIpRangeParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *DedicatePublicIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1151,6 +2451,15 @@ func (p *DedicatePublicIpRangeParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DedicatePublicIpRangeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DedicatePublicIpRangeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *DedicatePublicIpRangeParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1158,7 +2467,16 @@ func (p *DedicatePublicIpRangeParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
-// You should always use this function to get a new DedicatePublicIpRangeParams instance,
+func (p *DedicatePublicIpRangeParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *DedicatePublicIpRangeParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DedicatePublicIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewDedicatePublicIpRangeParams(domainid string, id string) *DedicatePublicIpRangeParams {
 	p := &DedicatePublicIpRangeParams{}
@@ -1170,7 +2488,16 @@ func (s *NetworkService) NewDedicatePublicIpRangeParams(domainid string, id stri
 
 // Dedicates a Public IP range to an account
 func (s *NetworkService) DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("dedicatePublicIpRange", p.toURLValues())
+	return s.DedicatePublicIpRangeWithContext(context.Background(), p)
+}
+
+// DedicatePublicIpRangeWithContext is the same as DedicatePublicIpRange, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) DedicatePublicIpRangeWithContext(ctx context.Context, p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicatePublicIpRange", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1215,7 +2542,8 @@ type DeleteNetworkParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteNetworkParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteNetworkParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1230,6 +2558,52 @@ func (p *DeleteNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteNetworkParams) Validate() error {
+	v := newParamValidator("deleteNetwork", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteNetworkParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteNetworkParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "forced":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteNetwork", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteNetwork: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNetworkParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1237,6 +2611,15 @@ func (p *DeleteNetworkParams) SetForced(v bool) {
 	p.p["forced"] = v
 }
 
+func (p *DeleteNetworkParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *DeleteNetworkParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
 func (p *DeleteNetworkParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1244,6 +2627,15 @@ func (p *DeleteNetworkParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteNetworkParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteNetworkParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteNetworkParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewDeleteNetworkParams(id string) *DeleteNetworkParams {
@@ -1255,7 +2647,16 @@ func (s *NetworkService) NewDeleteNetworkParams(id string) *DeleteNetworkParams
 
 // Deletes a network
 func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetwork", p.toURLValues())
+	return s.DeleteNetworkWithContext(context.Background(), p)
+}
+
+// DeleteNetworkWithContext is the same as DeleteNetwork, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) DeleteNetworkWithContext(ctx context.Context, p *DeleteNetworkParams) (*DeleteNetworkResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetwork", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1267,7 +2668,7 @@ func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1283,6 +2684,31 @@ func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkRe
 	return &r, nil
 }
 
+// DeleteNetworkAsync starts the async job for DeleteNetwork and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteNetworkResponse using Job.Result
+func (s *NetworkService) DeleteNetworkAsync(p *DeleteNetworkParams) (*Job, error) {
+	return s.DeleteNetworkAsyncWithContext(context.Background(), p)
+}
+
+// DeleteNetworkAsyncWithContext is the same as DeleteNetworkAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) DeleteNetworkAsyncWithContext(ctx context.Context, p *DeleteNetworkParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetwork", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteNetworkResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -1294,7 +2720,8 @@ type DeleteNetworkServiceProviderParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteNetworkServiceProviderParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteNetworkServiceProviderParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1305,6 +2732,48 @@ func (p *DeleteNetworkServiceProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteNetworkServiceProviderParams) Validate() error {
+	v := newParamValidator("deleteNetworkServiceProvider", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteNetworkServiceProviderParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteNetworkServiceProviderParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteNetworkServiceProvider", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteNetworkServiceProvider: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNetworkServiceProviderParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1312,6 +2781,15 @@ func (p *DeleteNetworkServiceProviderParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteNetworkServiceProviderParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteNetworkServiceProviderParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteNetworkServiceProviderParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewDeleteNetworkServiceProviderParams(id string) *DeleteNetworkServiceProviderParams {
@@ -1323,7 +2801,16 @@ func (s *NetworkService) NewDeleteNetworkServiceProviderParams(id string) *Delet
 
 // Deletes a Network Service Provider.
 func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkServiceProvider", p.toURLValues())
+	return s.DeleteNetworkServiceProviderWithContext(context.Background(), p)
+}
+
+// DeleteNetworkServiceProviderWithContext is the same as DeleteNetworkServiceProvider, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) DeleteNetworkServiceProviderWithContext(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetworkServiceProvider", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1335,7 +2822,7 @@ func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServicePro
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1351,6 +2838,31 @@ func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServicePro
 	return &r, nil
 }
 
+// DeleteNetworkServiceProviderAsync starts the async job for DeleteNetworkServiceProvider and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteNetworkServiceProviderResponse using Job.Result
+func (s *NetworkService) DeleteNetworkServiceProviderAsync(p *DeleteNetworkServiceProviderParams) (*Job, error) {
+	return s.DeleteNetworkServiceProviderAsyncWithContext(context.Background(), p)
+}
+
+// DeleteNetworkServiceProviderAsyncWithContext is the same as DeleteNetworkServiceProviderAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) DeleteNetworkServiceProviderAsyncWithContext(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNetworkServiceProvider", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteNetworkServiceProviderResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteNetworkServiceProviderResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -1362,7 +2874,8 @@ type DeleteOpenDaylightControllerParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteOpenDaylightControllerParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteOpenDaylightControllerParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1373,6 +2886,48 @@ func (p *DeleteOpenDaylightControllerParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteOpenDaylightControllerParams) Validate() error {
+	v := newParamValidator("deleteOpenDaylightController", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteOpenDaylightControllerParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteOpenDaylightControllerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteOpenDaylightController", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteOpenDaylightController: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteOpenDaylightControllerParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1380,6 +2935,15 @@ func (p *DeleteOpenDaylightControllerParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteOpenDaylightControllerParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteOpenDaylightControllerParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteOpenDaylightControllerParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewDeleteOpenDaylightControllerParams(id string) *DeleteOpenDaylightControllerParams {
@@ -1391,7 +2955,16 @@ func (s *NetworkService) NewDeleteOpenDaylightControllerParams(id string) *Delet
 
 // Removes an OpenDyalight controler
 func (s *NetworkService) DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error) {
-	resp, err := s.cs.newRequest("deleteOpenDaylightController", p.toURLValues())
+	return s.DeleteOpenDaylightControllerWithContext(context.Background(), p)
+}
+
+// DeleteOpenDaylightControllerWithContext is the same as DeleteOpenDaylightController, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) DeleteOpenDaylightControllerWithContext(ctx context.Context, p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteOpenDaylightController", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1403,7 +2976,7 @@ func (s *NetworkService) DeleteOpenDaylightController(p *DeleteOpenDaylightContr
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1424,6 +2997,40 @@ func (s *NetworkService) DeleteOpenDaylightController(p *DeleteOpenDaylightContr
 	return &r, nil
 }
 
+// DeleteOpenDaylightControllerAsync starts the async job for DeleteOpenDaylightController and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteOpenDaylightControllerResponse using Job.Result
+func (s *NetworkService) DeleteOpenDaylightControllerAsync(p *DeleteOpenDaylightControllerParams) (*Job, error) {
+	return s.DeleteOpenDaylightControllerAsyncWithContext(context.Background(), p)
+}
+
+// DeleteOpenDaylightControllerAsyncWithContext is the same as DeleteOpenDaylightControllerAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) DeleteOpenDaylightControllerAsyncWithContext(ctx context.Context, p *DeleteOpenDaylightControllerParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteOpenDaylightController", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteOpenDaylightControllerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type DeleteOpenDaylightControllerResponse struct {
 	Id                string `json:"id"`
 	JobID             string `json:"jobid"`
@@ -1438,7 +3045,8 @@ type DeletePhysicalNetworkParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeletePhysicalNetworkParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeletePhysicalNetworkParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1449,6 +3057,48 @@ func (p *DeletePhysicalNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeletePhysicalNetworkParams) Validate() error {
+	v := newParamValidator("deletePhysicalNetwork", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeletePhysicalNetworkParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeletePhysicalNetworkParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deletePhysicalNetwork", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deletePhysicalNetwork: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeletePhysicalNetworkParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1456,6 +3106,15 @@ func (p *DeletePhysicalNetworkParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeletePhysicalNetworkParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeletePhysicalNetworkParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeletePhysicalNetworkParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewDeletePhysicalNetworkParams(id string) *DeletePhysicalNetworkParams {
@@ -1467,7 +3126,16 @@ func (s *NetworkService) NewDeletePhysicalNetworkParams(id string) *DeletePhysic
 
 // Deletes a Physical Network.
 func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("deletePhysicalNetwork", p.toURLValues())
+	return s.DeletePhysicalNetworkWithContext(context.Background(), p)
+}
+
+// DeletePhysicalNetworkWithContext is the same as DeletePhysicalNetwork, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) DeletePhysicalNetworkWithContext(ctx context.Context, p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePhysicalNetwork", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1479,7 +3147,7 @@ func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1495,6 +3163,31 @@ func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (
 	return &r, nil
 }
 
+// DeletePhysicalNetworkAsync starts the async job for DeletePhysicalNetwork and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeletePhysicalNetworkResponse using Job.Result
+func (s *NetworkService) DeletePhysicalNetworkAsync(p *DeletePhysicalNetworkParams) (*Job, error) {
+	return s.DeletePhysicalNetworkAsyncWithContext(context.Background(), p)
+}
+
+// DeletePhysicalNetworkAsyncWithContext is the same as DeletePhysicalNetworkAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) DeletePhysicalNetworkAsyncWithContext(ctx context.Context, p *DeletePhysicalNetworkParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePhysicalNetwork", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeletePhysicalNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeletePhysicalNetworkResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -1506,7 +3199,8 @@ type DeleteStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteStorageNetworkIpRangeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteStorageNetworkIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1517,6 +3211,48 @@ func (p *DeleteStorageNetworkIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteStorageNetworkIpRangeParams) Validate() error {
+	v := newParamValidator("deleteStorageNetworkIpRange", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteStorageNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteStorageNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteStorageNetworkIpRange", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteStorageNetworkIpRange: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteStorageNetworkIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1524,6 +3260,15 @@ func (p *DeleteStorageNetworkIpRangeParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteStorageNetworkIpRangeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteStorageNetworkIpRangeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteStorageNetworkIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewDeleteStorageNetworkIpRangeParams(id string) *DeleteStorageNetworkIpRangeParams {
@@ -1535,7 +3280,16 @@ func (s *NetworkService) NewDeleteStorageNetworkIpRangeParams(id string) *Delete
 
 // Deletes a storage network IP Range.
 func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("deleteStorageNetworkIpRange", p.toURLValues())
+	return s.DeleteStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// DeleteStorageNetworkIpRangeWithContext is the same as DeleteStorageNetworkIpRange, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) DeleteStorageNetworkIpRangeWithContext(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteStorageNetworkIpRange", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1547,7 +3301,7 @@ func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1563,6 +3317,31 @@ func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRa
 	return &r, nil
 }
 
+// DeleteStorageNetworkIpRangeAsync starts the async job for DeleteStorageNetworkIpRange and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteStorageNetworkIpRangeResponse using Job.Result
+func (s *NetworkService) DeleteStorageNetworkIpRangeAsync(p *DeleteStorageNetworkIpRangeParams) (*Job, error) {
+	return s.DeleteStorageNetworkIpRangeAsyncWithContext(context.Background(), p)
+}
+
+// DeleteStorageNetworkIpRangeAsyncWithContext is the same as DeleteStorageNetworkIpRangeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) DeleteStorageNetworkIpRangeAsyncWithContext(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteStorageNetworkIpRange", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteStorageNetworkIpRangeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteStorageNetworkIpRangeResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -1574,7 +3353,8 @@ type ListNetscalerLoadBalancerNetworksParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListNetscalerLoadBalancerNetworksParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListNetscalerLoadBalancerNetworksParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1596,6 +3376,53 @@ func (p *ListNetscalerLoadBalancerNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListNetscalerLoadBalancerNetworksParams) Validate() error {
+	v := newParamValidator("listNetscalerLoadBalancerNetworks", p.p)
+	v.required("lbdeviceid")
+	v.ids("lbdeviceid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListNetscalerLoadBalancerNetworksParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListNetscalerLoadBalancerNetworksParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "lbdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listNetscalerLoadBalancerNetworks", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listNetscalerLoadBalancerNetworks: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListNetscalerLoadBalancerNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1603,6 +3430,15 @@ func (p *ListNetscalerLoadBalancerNetworksParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListNetscalerLoadBalancerNetworksParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListNetscalerLoadBalancerNetworksParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1610,6 +3446,15 @@ func (p *ListNetscalerLoadBalancerNetworksParams) SetLbdeviceid(v string) {
 	p.p["lbdeviceid"] = v
 }
 
+func (p *ListNetscalerLoadBalancerNetworksParams) ResetLbdeviceid() {
+	delete(p.p, "lbdeviceid")
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) GetLbdeviceid() (string, bool) {
+	value, ok := p.p["lbdeviceid"].(string)
+	return value, ok
+}
+
 func (p *ListNetscalerLoadBalancerNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1617,6 +3462,15 @@ func (p *ListNetscalerLoadBalancerNetworksParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListNetscalerLoadBalancerNetworksParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListNetscalerLoadBalancerNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1624,6 +3478,15 @@ func (p *ListNetscalerLoadBalancerNetworksParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListNetscalerLoadBalancerNetworksParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListNetscalerLoadBalancerNetworksParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListNetscalerLoadBalancerNetworksParams(lbdeviceid string) *ListNetscalerLoadBalancerNetworksParams {
@@ -1635,6 +3498,11 @@ func (s *NetworkService) NewListNetscalerLoadBalancerNetworksParams(lbdeviceid s
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetscalerLoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetNetscalerLoadBalancerNetworkIDWithContext(context.Background(), keyword, lbdeviceid, opts...)
+}
+
+// GetNetscalerLoadBalancerNetworkIDWithContext is the same as GetNetscalerLoadBalancerNetworkID, but uses ctx to cancel the request
+func (s *NetworkService) GetNetscalerLoadBalancerNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetscalerLoadBalancerNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -1647,7 +3515,7 @@ func (s *NetworkService) GetNetscalerLoadBalancerNetworkID(keyword string, lbdev
 		}
 	}
 
-	l, err := s.ListNetscalerLoadBalancerNetworks(p)
+	l, err := s.ListNetscalerLoadBalancerNetworksWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1672,8 +3540,17 @@ func (s *NetworkService) GetNetscalerLoadBalancerNetworkID(keyword string, lbdev
 
 // lists network that are using a netscaler load balancer device
 func (s *NetworkService) ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNetscalerLoadBalancerNetworks", p.toURLValues())
-	if err != nil {
+	return s.ListNetscalerLoadBalancerNetworksWithContext(context.Background(), p)
+}
+
+// ListNetscalerLoadBalancerNetworksWithContext is the same as ListNetscalerLoadBalancerNetworks, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) ListNetscalerLoadBalancerNetworksWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetscalerLoadBalancerNetworks", p.ToURLValues())
+	if err != nil {
 		return nil, err
 	}
 
@@ -1685,6 +3562,92 @@ func (s *NetworkService) ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadB
 	return &r, nil
 }
 
+// ListNetscalerLoadBalancerNetworksPager iterates over all pages of a ListNetscalerLoadBalancerNetworks call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListNetscalerLoadBalancerNetworksPager struct {
+	pager
+
+	s *NetworkService
+	p *ListNetscalerLoadBalancerNetworksParams
+	r *ListNetscalerLoadBalancerNetworksResponse
+}
+
+// NewListNetscalerLoadBalancerNetworksPager returns a pager for all pages of a ListNetscalerLoadBalancerNetworks call with the given params
+func (s *NetworkService) NewListNetscalerLoadBalancerNetworksPager(p *ListNetscalerLoadBalancerNetworksParams) *ListNetscalerLoadBalancerNetworksPager {
+	return &ListNetscalerLoadBalancerNetworksPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListNetscalerLoadBalancerNetworksPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListNetscalerLoadBalancerNetworksPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListNetscalerLoadBalancerNetworksParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListNetscalerLoadBalancerNetworksWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.NetscalerLoadBalancerNetworks), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListNetscalerLoadBalancerNetworksPager) Page() *ListNetscalerLoadBalancerNetworksResponse {
+	return pg.r
+}
+
+// ListNetscalerLoadBalancerNetworksAll fetches all pages of a ListNetscalerLoadBalancerNetworks call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetworkService) ListNetscalerLoadBalancerNetworksAll(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error) {
+	return s.ListNetscalerLoadBalancerNetworksAllWithContext(context.Background(), p)
+}
+
+// ListNetscalerLoadBalancerNetworksAllWithContext is the same as ListNetscalerLoadBalancerNetworksAll, but uses ctx to cancel the requests
+func (s *NetworkService) ListNetscalerLoadBalancerNetworksAllWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*NetscalerLoadBalancerNetwork)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListNetscalerLoadBalancerNetworksParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListNetscalerLoadBalancerNetworksWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.NetscalerLoadBalancerNetworks
+		mu.Unlock()
+
+		return len(l.NetscalerLoadBalancerNetworks), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListNetscalerLoadBalancerNetworksResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.NetscalerLoadBalancerNetworks = append(r.NetscalerLoadBalancerNetworks, pages[page]...)
+	}
+	r.Count = len(r.NetscalerLoadBalancerNetworks)
+
+	return r, nil
+}
+
 type ListNetscalerLoadBalancerNetworksResponse struct {
 	Count                         int                             `json:"count"`
 	NetscalerLoadBalancerNetworks []*NetscalerLoadBalancerNetwork `json:"netscalerloadbalancernetwork"`
@@ -1774,7 +3737,8 @@ type ListNetworkIsolationMethodsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListNetworkIsolationMethodsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListNetworkIsolationMethodsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1793,6 +3757,51 @@ func (p *ListNetworkIsolationMethodsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListNetworkIsolationMethodsParams) Validate() error {
+	v := newParamValidator("listNetworkIsolationMethods", p.p)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListNetworkIsolationMethodsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListNetworkIsolationMethodsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listNetworkIsolationMethods", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listNetworkIsolationMethods: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListNetworkIsolationMethodsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1800,6 +3809,15 @@ func (p *ListNetworkIsolationMethodsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListNetworkIsolationMethodsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListNetworkIsolationMethodsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkIsolationMethodsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1807,6 +3825,15 @@ func (p *ListNetworkIsolationMethodsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListNetworkIsolationMethodsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListNetworkIsolationMethodsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListNetworkIsolationMethodsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1814,6 +3841,15 @@ func (p *ListNetworkIsolationMethodsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListNetworkIsolationMethodsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListNetworkIsolationMethodsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListNetworkIsolationMethodsParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListNetworkIsolationMethodsParams() *ListNetworkIsolationMethodsParams {
@@ -1824,7 +3860,16 @@ func (s *NetworkService) NewListNetworkIsolationMethodsParams() *ListNetworkIsol
 
 // Lists supported methods of network isolation
 func (s *NetworkService) ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkIsolationMethods", p.toURLValues())
+	return s.ListNetworkIsolationMethodsWithContext(context.Background(), p)
+}
+
+// ListNetworkIsolationMethodsWithContext is the same as ListNetworkIsolationMethods, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) ListNetworkIsolationMethodsWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworkIsolationMethods", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1837,6 +3882,92 @@ func (s *NetworkService) ListNetworkIsolationMethods(p *ListNetworkIsolationMeth
 	return &r, nil
 }
 
+// ListNetworkIsolationMethodsPager iterates over all pages of a ListNetworkIsolationMethods call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListNetworkIsolationMethodsPager struct {
+	pager
+
+	s *NetworkService
+	p *ListNetworkIsolationMethodsParams
+	r *ListNetworkIsolationMethodsResponse
+}
+
+// NewListNetworkIsolationMethodsPager returns a pager for all pages of a ListNetworkIsolationMethods call with the given params
+func (s *NetworkService) NewListNetworkIsolationMethodsPager(p *ListNetworkIsolationMethodsParams) *ListNetworkIsolationMethodsPager {
+	return &ListNetworkIsolationMethodsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListNetworkIsolationMethodsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListNetworkIsolationMethodsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListNetworkIsolationMethodsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListNetworkIsolationMethodsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.NetworkIsolationMethods), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListNetworkIsolationMethodsPager) Page() *ListNetworkIsolationMethodsResponse {
+	return pg.r
+}
+
+// ListNetworkIsolationMethodsAll fetches all pages of a ListNetworkIsolationMethods call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetworkService) ListNetworkIsolationMethodsAll(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
+	return s.ListNetworkIsolationMethodsAllWithContext(context.Background(), p)
+}
+
+// ListNetworkIsolationMethodsAllWithContext is the same as ListNetworkIsolationMethodsAll, but uses ctx to cancel the requests
+func (s *NetworkService) ListNetworkIsolationMethodsAllWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*NetworkIsolationMethod)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListNetworkIsolationMethodsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListNetworkIsolationMethodsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.NetworkIsolationMethods
+		mu.Unlock()
+
+		return len(l.NetworkIsolationMethods), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListNetworkIsolationMethodsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.NetworkIsolationMethods = append(r.NetworkIsolationMethods, pages[page]...)
+	}
+	r.Count = len(r.NetworkIsolationMethods)
+
+	return r, nil
+}
+
 type ListNetworkIsolationMethodsResponse struct {
 	Count                   int                       `json:"count"`
 	NetworkIsolationMethods []*NetworkIsolationMethod `json:"networkisolationmethod"`
@@ -1852,7 +3983,8 @@ type ListNetworkServiceProvidersParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListNetworkServiceProvidersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListNetworkServiceProvidersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1880,6 +4012,54 @@ func (p *ListNetworkServiceProvidersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListNetworkServiceProvidersParams) Validate() error {
+	v := newParamValidator("listNetworkServiceProviders", p.p)
+	v.ids("physicalnetworkid")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListNetworkServiceProvidersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListNetworkServiceProvidersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "name", "physicalnetworkid", "state":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listNetworkServiceProviders", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listNetworkServiceProviders: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListNetworkServiceProvidersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1887,6 +4067,15 @@ func (p *ListNetworkServiceProvidersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListNetworkServiceProvidersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListNetworkServiceProvidersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkServiceProvidersParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1894,6 +4083,15 @@ func (p *ListNetworkServiceProvidersParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListNetworkServiceProvidersParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListNetworkServiceProvidersParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkServiceProvidersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1901,6 +4099,15 @@ func (p *ListNetworkServiceProvidersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListNetworkServiceProvidersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListNetworkServiceProvidersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListNetworkServiceProvidersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1908,6 +4115,15 @@ func (p *ListNetworkServiceProvidersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListNetworkServiceProvidersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListNetworkServiceProvidersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListNetworkServiceProvidersParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1915,6 +4131,15 @@ func (p *ListNetworkServiceProvidersParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *ListNetworkServiceProvidersParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListNetworkServiceProvidersParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *ListNetworkServiceProvidersParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1922,6 +4147,15 @@ func (p *ListNetworkServiceProvidersParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListNetworkServiceProvidersParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListNetworkServiceProvidersParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListNetworkServiceProvidersParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListNetworkServiceProvidersParams() *ListNetworkServiceProvidersParams {
@@ -1932,6 +4166,11 @@ func (s *NetworkService) NewListNetworkServiceProvidersParams() *ListNetworkServ
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetNetworkServiceProviderIDWithContext(context.Background(), name, opts...)
+}
+
+// GetNetworkServiceProviderIDWithContext is the same as GetNetworkServiceProviderID, but uses ctx to cancel the request
+func (s *NetworkService) GetNetworkServiceProviderIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworkServiceProvidersParams{}
 	p.p = make(map[string]interface{})
 
@@ -1943,7 +4182,7 @@ func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...Option
 		}
 	}
 
-	l, err := s.ListNetworkServiceProviders(p)
+	l, err := s.ListNetworkServiceProvidersWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1968,7 +4207,16 @@ func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...Option
 
 // Lists network serviceproviders for a given physical network.
 func (s *NetworkService) ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkServiceProviders", p.toURLValues())
+	return s.ListNetworkServiceProvidersWithContext(context.Background(), p)
+}
+
+// ListNetworkServiceProvidersWithContext is the same as ListNetworkServiceProviders, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) ListNetworkServiceProvidersWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworkServiceProviders", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1981,6 +4229,92 @@ func (s *NetworkService) ListNetworkServiceProviders(p *ListNetworkServiceProvid
 	return &r, nil
 }
 
+// ListNetworkServiceProvidersPager iterates over all pages of a ListNetworkServiceProviders call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListNetworkServiceProvidersPager struct {
+	pager
+
+	s *NetworkService
+	p *ListNetworkServiceProvidersParams
+	r *ListNetworkServiceProvidersResponse
+}
+
+// NewListNetworkServiceProvidersPager returns a pager for all pages of a ListNetworkServiceProviders call with the given params
+func (s *NetworkService) NewListNetworkServiceProvidersPager(p *ListNetworkServiceProvidersParams) *ListNetworkServiceProvidersPager {
+	return &ListNetworkServiceProvidersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListNetworkServiceProvidersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListNetworkServiceProvidersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListNetworkServiceProvidersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListNetworkServiceProvidersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.NetworkServiceProviders), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListNetworkServiceProvidersPager) Page() *ListNetworkServiceProvidersResponse {
+	return pg.r
+}
+
+// ListNetworkServiceProvidersAll fetches all pages of a ListNetworkServiceProviders call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetworkService) ListNetworkServiceProvidersAll(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
+	return s.ListNetworkServiceProvidersAllWithContext(context.Background(), p)
+}
+
+// ListNetworkServiceProvidersAllWithContext is the same as ListNetworkServiceProvidersAll, but uses ctx to cancel the requests
+func (s *NetworkService) ListNetworkServiceProvidersAllWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*NetworkServiceProvider)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListNetworkServiceProvidersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListNetworkServiceProvidersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.NetworkServiceProviders
+		mu.Unlock()
+
+		return len(l.NetworkServiceProviders), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListNetworkServiceProvidersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.NetworkServiceProviders = append(r.NetworkServiceProviders, pages[page]...)
+	}
+	r.Count = len(r.NetworkServiceProviders)
+
+	return r, nil
+}
+
 type ListNetworkServiceProvidersResponse struct {
 	Count                   int                       `json:"count"`
 	NetworkServiceProviders []*NetworkServiceProvider `json:"networkserviceprovider"`
@@ -2002,7 +4336,8 @@ type ListNetworksParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListNetworksParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListNetworksParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2097,6 +4432,69 @@ func (p *ListNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListNetworksParams) Validate() error {
+	v := newParamValidator("listNetworks", p.p)
+	v.ids("domainid", "id", "networkofferingid", "physicalnetworkid", "projectid", "vpcid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("acltype", 255)
+	v.oneOf("acltype", "account", "domain")
+	v.maxLength("keyword", 255)
+	v.maxLength("traffictype", 255)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListNetworksParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListNetworksParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "acltype", "domainid", "id", "keyword", "networkofferingid", "physicalnetworkid", "projectid", "traffictype", "type", "vpcid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "canusefordeploy", "displaynetwork", "forvpc", "isrecursive", "issystem", "listall", "restartrequired", "specifyipranges":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "supportedservices":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listNetworks", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listNetworks: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListNetworksParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2104,6 +4502,15 @@ func (p *ListNetworksParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListNetworksParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListNetworksParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetAcltype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2111,6 +4518,15 @@ func (p *ListNetworksParams) SetAcltype(v string) {
 	p.p["acltype"] = v
 }
 
+func (p *ListNetworksParams) ResetAcltype() {
+	delete(p.p, "acltype")
+}
+
+func (p *ListNetworksParams) GetAcltype() (string, bool) {
+	value, ok := p.p["acltype"].(string)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetCanusefordeploy(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2118,6 +4534,15 @@ func (p *ListNetworksParams) SetCanusefordeploy(v bool) {
 	p.p["canusefordeploy"] = v
 }
 
+func (p *ListNetworksParams) ResetCanusefordeploy() {
+	delete(p.p, "canusefordeploy")
+}
+
+func (p *ListNetworksParams) GetCanusefordeploy() (bool, bool) {
+	value, ok := p.p["canusefordeploy"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetDisplaynetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2125,6 +4550,15 @@ func (p *ListNetworksParams) SetDisplaynetwork(v bool) {
 	p.p["displaynetwork"] = v
 }
 
+func (p *ListNetworksParams) ResetDisplaynetwork() {
+	delete(p.p, "displaynetwork")
+}
+
+func (p *ListNetworksParams) GetDisplaynetwork() (bool, bool) {
+	value, ok := p.p["displaynetwork"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2132,6 +4566,15 @@ func (p *ListNetworksParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListNetworksParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListNetworksParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetForvpc(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2139,6 +4582,15 @@ func (p *ListNetworksParams) SetForvpc(v bool) {
 	p.p["forvpc"] = v
 }
 
+func (p *ListNetworksParams) ResetForvpc() {
+	delete(p.p, "forvpc")
+}
+
+func (p *ListNetworksParams) GetForvpc() (bool, bool) {
+	value, ok := p.p["forvpc"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2146,6 +4598,15 @@ func (p *ListNetworksParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListNetworksParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListNetworksParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2153,6 +4614,15 @@ func (p *ListNetworksParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListNetworksParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListNetworksParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetIssystem(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2160,6 +4630,15 @@ func (p *ListNetworksParams) SetIssystem(v bool) {
 	p.p["issystem"] = v
 }
 
+func (p *ListNetworksParams) ResetIssystem() {
+	delete(p.p, "issystem")
+}
+
+func (p *ListNetworksParams) GetIssystem() (bool, bool) {
+	value, ok := p.p["issystem"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2167,6 +4646,15 @@ func (p *ListNetworksParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListNetworksParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListNetworksParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2174,6 +4662,15 @@ func (p *ListNetworksParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListNetworksParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListNetworksParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetNetworkofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2181,6 +4678,15 @@ func (p *ListNetworksParams) SetNetworkofferingid(v string) {
 	p.p["networkofferingid"] = v
 }
 
+func (p *ListNetworksParams) ResetNetworkofferingid() {
+	delete(p.p, "networkofferingid")
+}
+
+func (p *ListNetworksParams) GetNetworkofferingid() (string, bool) {
+	value, ok := p.p["networkofferingid"].(string)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2188,6 +4694,15 @@ func (p *ListNetworksParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListNetworksParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListNetworksParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2195,6 +4710,15 @@ func (p *ListNetworksParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListNetworksParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListNetworksParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2202,13 +4726,31 @@ func (p *ListNetworksParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
-func (p *ListNetworksParams) SetProjectid(v string) {
+func (p *ListNetworksParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListNetworksParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
+func (p *ListNetworksParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["projectid"] = v
 }
 
+func (p *ListNetworksParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListNetworksParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetRestartrequired(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2216,6 +4758,15 @@ func (p *ListNetworksParams) SetRestartrequired(v bool) {
 	p.p["restartrequired"] = v
 }
 
+func (p *ListNetworksParams) ResetRestartrequired() {
+	delete(p.p, "restartrequired")
+}
+
+func (p *ListNetworksParams) GetRestartrequired() (bool, bool) {
+	value, ok := p.p["restartrequired"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetSpecifyipranges(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2223,6 +4774,15 @@ func (p *ListNetworksParams) SetSpecifyipranges(v bool) {
 	p.p["specifyipranges"] = v
 }
 
+func (p *ListNetworksParams) ResetSpecifyipranges() {
+	delete(p.p, "specifyipranges")
+}
+
+func (p *ListNetworksParams) GetSpecifyipranges() (bool, bool) {
+	value, ok := p.p["specifyipranges"].(bool)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetSupportedservices(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2230,6 +4790,15 @@ func (p *ListNetworksParams) SetSupportedservices(v []string) {
 	p.p["supportedservices"] = v
 }
 
+func (p *ListNetworksParams) ResetSupportedservices() {
+	delete(p.p, "supportedservices")
+}
+
+func (p *ListNetworksParams) GetSupportedservices() ([]string, bool) {
+	value, ok := p.p["supportedservices"].([]string)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2237,6 +4806,15 @@ func (p *ListNetworksParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListNetworksParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListNetworksParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2244,6 +4822,15 @@ func (p *ListNetworksParams) SetTraffictype(v string) {
 	p.p["traffictype"] = v
 }
 
+func (p *ListNetworksParams) ResetTraffictype() {
+	delete(p.p, "traffictype")
+}
+
+func (p *ListNetworksParams) GetTraffictype() (string, bool) {
+	value, ok := p.p["traffictype"].(string)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2251,6 +4838,15 @@ func (p *ListNetworksParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *ListNetworksParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ListNetworksParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2258,6 +4854,15 @@ func (p *ListNetworksParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *ListNetworksParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *ListNetworksParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 func (p *ListNetworksParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2265,6 +4870,15 @@ func (p *ListNetworksParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListNetworksParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListNetworksParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListNetworksParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListNetworksParams() *ListNetworksParams {
@@ -2275,6 +4889,11 @@ func (s *NetworkService) NewListNetworksParams() *ListNetworksParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetworkID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetNetworkIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetNetworkIDWithContext is the same as GetNetworkID, but uses ctx to cancel the request
+func (s *NetworkService) GetNetworkIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -2286,7 +4905,7 @@ func (s *NetworkService) GetNetworkID(keyword string, opts ...OptionFunc) (strin
 		}
 	}
 
-	l, err := s.ListNetworks(p)
+	l, err := s.ListNetworksWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2311,12 +4930,17 @@ func (s *NetworkService) GetNetworkID(keyword string, opts ...OptionFunc) (strin
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetworkByName(name string, opts ...OptionFunc) (*Network, int, error) {
-	id, count, err := s.GetNetworkID(name, opts...)
+	return s.GetNetworkByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetNetworkByNameWithContext is the same as GetNetworkByName, but uses ctx to cancel the requests
+func (s *NetworkService) GetNetworkByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Network, int, error) {
+	id, count, err := s.GetNetworkIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetNetworkByID(id, opts...)
+	r, count, err := s.GetNetworkByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2325,6 +4949,11 @@ func (s *NetworkService) GetNetworkByName(name string, opts ...OptionFunc) (*Net
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network, int, error) {
+	return s.GetNetworkByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetNetworkByIDWithContext is the same as GetNetworkByID, but uses ctx to cancel the request
+func (s *NetworkService) GetNetworkByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Network, int, error) {
 	p := &ListNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -2336,18 +4965,16 @@ func (s *NetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network
 		}
 	}
 
-	l, err := s.ListNetworks(p)
+	l, err := s.ListNetworksWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -2358,7 +4985,16 @@ func (s *NetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network
 
 // Lists all available networks.
 func (s *NetworkService) ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNetworks", p.toURLValues())
+	return s.ListNetworksWithContext(context.Background(), p)
+}
+
+// ListNetworksWithContext is the same as ListNetworks, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) ListNetworksWithContext(ctx context.Context, p *ListNetworksParams) (*ListNetworksResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listNetworks", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2371,6 +5007,92 @@ func (s *NetworkService) ListNetworks(p *ListNetworksParams) (*ListNetworksRespo
 	return &r, nil
 }
 
+// ListNetworksPager iterates over all pages of a ListNetworks call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListNetworksPager struct {
+	pager
+
+	s *NetworkService
+	p *ListNetworksParams
+	r *ListNetworksResponse
+}
+
+// NewListNetworksPager returns a pager for all pages of a ListNetworks call with the given params
+func (s *NetworkService) NewListNetworksPager(p *ListNetworksParams) *ListNetworksPager {
+	return &ListNetworksPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListNetworksPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListNetworksPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListNetworksParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListNetworksWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Networks), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListNetworksPager) Page() *ListNetworksResponse {
+	return pg.r
+}
+
+// ListNetworksAll fetches all pages of a ListNetworks call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetworkService) ListNetworksAll(p *ListNetworksParams) (*ListNetworksResponse, error) {
+	return s.ListNetworksAllWithContext(context.Background(), p)
+}
+
+// ListNetworksAllWithContext is the same as ListNetworksAll, but uses ctx to cancel the requests
+func (s *NetworkService) ListNetworksAllWithContext(ctx context.Context, p *ListNetworksParams) (*ListNetworksResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Network)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListNetworksParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListNetworksWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Networks
+		mu.Unlock()
+
+		return len(l.Networks), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListNetworksResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Networks = append(r.Networks, pages[page]...)
+	}
+	r.Count = len(r.Networks)
+
+	return r, nil
+}
+
 type ListNetworksResponse struct {
 	Count    int        `json:"count"`
 	Networks []*Network `json:"network"`
@@ -2460,7 +5182,8 @@ type ListNiciraNvpDeviceNetworksParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListNiciraNvpDeviceNetworksParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListNiciraNvpDeviceNetworksParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2482,6 +5205,53 @@ func (p *ListNiciraNvpDeviceNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListNiciraNvpDeviceNetworksParams) Validate() error {
+	v := newParamValidator("listNiciraNvpDeviceNetworks", p.p)
+	v.required("nvpdeviceid")
+	v.ids("nvpdeviceid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListNiciraNvpDeviceNetworksParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListNiciraNvpDeviceNetworksParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "nvpdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listNiciraNvpDeviceNetworks", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listNiciraNvpDeviceNetworks: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListNiciraNvpDeviceNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2489,6 +5259,15 @@ func (p *ListNiciraNvpDeviceNetworksParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListNiciraNvpDeviceNetworksParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListNiciraNvpDeviceNetworksParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListNiciraNvpDeviceNetworksParams) SetNvpdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2496,6 +5275,15 @@ func (p *ListNiciraNvpDeviceNetworksParams) SetNvpdeviceid(v string) {
 	p.p["nvpdeviceid"] = v
 }
 
+func (p *ListNiciraNvpDeviceNetworksParams) ResetNvpdeviceid() {
+	delete(p.p, "nvpdeviceid")
+}
+
+func (p *ListNiciraNvpDeviceNetworksParams) GetNvpdeviceid() (string, bool) {
+	value, ok := p.p["nvpdeviceid"].(string)
+	return value, ok
+}
+
 func (p *ListNiciraNvpDeviceNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2503,6 +5291,15 @@ func (p *ListNiciraNvpDeviceNetworksParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListNiciraNvpDeviceNetworksParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListNiciraNvpDeviceNetworksParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListNiciraNvpDeviceNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2510,6 +5307,15 @@ func (p *ListNiciraNvpDeviceNetworksParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListNiciraNvpDeviceNetworksParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListNiciraNvpDeviceNetworksParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListNiciraNvpDeviceNetworksParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string) *ListNiciraNvpDeviceNetworksParams {
@@ -2521,6 +5327,11 @@ func (s *NetworkService) NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetNiciraNvpDeviceNetworkIDWithContext(context.Background(), keyword, nvpdeviceid, opts...)
+}
+
+// GetNiciraNvpDeviceNetworkIDWithContext is the same as GetNiciraNvpDeviceNetworkID, but uses ctx to cancel the request
+func (s *NetworkService) GetNiciraNvpDeviceNetworkIDWithContext(ctx context.Context, keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNiciraNvpDeviceNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -2533,7 +5344,7 @@ func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid
 		}
 	}
 
-	l, err := s.ListNiciraNvpDeviceNetworks(p)
+	l, err := s.ListNiciraNvpDeviceNetworksWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2558,7 +5369,16 @@ func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid
 
 // lists network that are using a nicira nvp device
 func (s *NetworkService) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNiciraNvpDeviceNetworks", p.toURLValues())
+	return s.ListNiciraNvpDeviceNetworksWithContext(context.Background(), p)
+}
+
+// ListNiciraNvpDeviceNetworksWithContext is the same as ListNiciraNvpDeviceNetworks, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) ListNiciraNvpDeviceNetworksWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listNiciraNvpDeviceNetworks", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2571,6 +5391,92 @@ func (s *NetworkService) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetwo
 	return &r, nil
 }
 
+// ListNiciraNvpDeviceNetworksPager iterates over all pages of a ListNiciraNvpDeviceNetworks call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListNiciraNvpDeviceNetworksPager struct {
+	pager
+
+	s *NetworkService
+	p *ListNiciraNvpDeviceNetworksParams
+	r *ListNiciraNvpDeviceNetworksResponse
+}
+
+// NewListNiciraNvpDeviceNetworksPager returns a pager for all pages of a ListNiciraNvpDeviceNetworks call with the given params
+func (s *NetworkService) NewListNiciraNvpDeviceNetworksPager(p *ListNiciraNvpDeviceNetworksParams) *ListNiciraNvpDeviceNetworksPager {
+	return &ListNiciraNvpDeviceNetworksPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListNiciraNvpDeviceNetworksPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListNiciraNvpDeviceNetworksPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListNiciraNvpDeviceNetworksParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListNiciraNvpDeviceNetworksWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.NiciraNvpDeviceNetworks), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListNiciraNvpDeviceNetworksPager) Page() *ListNiciraNvpDeviceNetworksResponse {
+	return pg.r
+}
+
+// ListNiciraNvpDeviceNetworksAll fetches all pages of a ListNiciraNvpDeviceNetworks call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetworkService) ListNiciraNvpDeviceNetworksAll(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
+	return s.ListNiciraNvpDeviceNetworksAllWithContext(context.Background(), p)
+}
+
+// ListNiciraNvpDeviceNetworksAllWithContext is the same as ListNiciraNvpDeviceNetworksAll, but uses ctx to cancel the requests
+func (s *NetworkService) ListNiciraNvpDeviceNetworksAllWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*NiciraNvpDeviceNetwork)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListNiciraNvpDeviceNetworksParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListNiciraNvpDeviceNetworksWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.NiciraNvpDeviceNetworks
+		mu.Unlock()
+
+		return len(l.NiciraNvpDeviceNetworks), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListNiciraNvpDeviceNetworksResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.NiciraNvpDeviceNetworks = append(r.NiciraNvpDeviceNetworks, pages[page]...)
+	}
+	r.Count = len(r.NiciraNvpDeviceNetworks)
+
+	return r, nil
+}
+
 type ListNiciraNvpDeviceNetworksResponse struct {
 	Count                   int                       `json:"count"`
 	NiciraNvpDeviceNetworks []*NiciraNvpDeviceNetwork `json:"niciranvpdevicenetwork"`
@@ -2660,7 +5566,8 @@ type ListOpenDaylightControllersParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListOpenDaylightControllersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListOpenDaylightControllersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2674,6 +5581,47 @@ func (p *ListOpenDaylightControllersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListOpenDaylightControllersParams) Validate() error {
+	v := newParamValidator("listOpenDaylightControllers", p.p)
+	v.ids("id", "physicalnetworkid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListOpenDaylightControllersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListOpenDaylightControllersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "physicalnetworkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listOpenDaylightControllers", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listOpenDaylightControllers: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListOpenDaylightControllersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2681,6 +5629,15 @@ func (p *ListOpenDaylightControllersParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListOpenDaylightControllersParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListOpenDaylightControllersParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListOpenDaylightControllersParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2688,6 +5645,15 @@ func (p *ListOpenDaylightControllersParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *ListOpenDaylightControllersParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListOpenDaylightControllersParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListOpenDaylightControllersParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListOpenDaylightControllersParams() *ListOpenDaylightControllersParams {
@@ -2698,6 +5664,11 @@ func (s *NetworkService) NewListOpenDaylightControllersParams() *ListOpenDayligh
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetOpenDaylightControllerByID(id string, opts ...OptionFunc) (*OpenDaylightController, int, error) {
+	return s.GetOpenDaylightControllerByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetOpenDaylightControllerByIDWithContext is the same as GetOpenDaylightControllerByID, but uses ctx to cancel the request
+func (s *NetworkService) GetOpenDaylightControllerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OpenDaylightController, int, error) {
 	p := &ListOpenDaylightControllersParams{}
 	p.p = make(map[string]interface{})
 
@@ -2709,18 +5680,16 @@ func (s *NetworkService) GetOpenDaylightControllerByID(id string, opts ...Option
 		}
 	}
 
-	l, err := s.ListOpenDaylightControllers(p)
+	l, err := s.ListOpenDaylightControllersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -2731,7 +5700,16 @@ func (s *NetworkService) GetOpenDaylightControllerByID(id string, opts ...Option
 
 // Lists OpenDyalight controllers
 func (s *NetworkService) ListOpenDaylightControllers(p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error) {
-	resp, err := s.cs.newRequest("listOpenDaylightControllers", p.toURLValues())
+	return s.ListOpenDaylightControllersWithContext(context.Background(), p)
+}
+
+// ListOpenDaylightControllersWithContext is the same as ListOpenDaylightControllers, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) ListOpenDaylightControllersWithContext(ctx context.Context, p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listOpenDaylightControllers", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2763,7 +5741,8 @@ type ListPaloAltoFirewallNetworksParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListPaloAltoFirewallNetworksParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListPaloAltoFirewallNetworksParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2785,6 +5764,53 @@ func (p *ListPaloAltoFirewallNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListPaloAltoFirewallNetworksParams) Validate() error {
+	v := newParamValidator("listPaloAltoFirewallNetworks", p.p)
+	v.required("lbdeviceid")
+	v.ids("lbdeviceid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListPaloAltoFirewallNetworksParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListPaloAltoFirewallNetworksParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "lbdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listPaloAltoFirewallNetworks", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listPaloAltoFirewallNetworks: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListPaloAltoFirewallNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2792,6 +5818,15 @@ func (p *ListPaloAltoFirewallNetworksParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListPaloAltoFirewallNetworksParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListPaloAltoFirewallNetworksParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListPaloAltoFirewallNetworksParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2799,6 +5834,15 @@ func (p *ListPaloAltoFirewallNetworksParams) SetLbdeviceid(v string) {
 	p.p["lbdeviceid"] = v
 }
 
+func (p *ListPaloAltoFirewallNetworksParams) ResetLbdeviceid() {
+	delete(p.p, "lbdeviceid")
+}
+
+func (p *ListPaloAltoFirewallNetworksParams) GetLbdeviceid() (string, bool) {
+	value, ok := p.p["lbdeviceid"].(string)
+	return value, ok
+}
+
 func (p *ListPaloAltoFirewallNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2806,6 +5850,15 @@ func (p *ListPaloAltoFirewallNetworksParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListPaloAltoFirewallNetworksParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListPaloAltoFirewallNetworksParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListPaloAltoFirewallNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2813,6 +5866,15 @@ func (p *ListPaloAltoFirewallNetworksParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListPaloAltoFirewallNetworksParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListPaloAltoFirewallNetworksParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListPaloAltoFirewallNetworksParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListPaloAltoFirewallNetworksParams(lbdeviceid string) *ListPaloAltoFirewallNetworksParams {
@@ -2824,6 +5886,11 @@ func (s *NetworkService) NewListPaloAltoFirewallNetworksParams(lbdeviceid string
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetPaloAltoFirewallNetworkIDWithContext(context.Background(), keyword, lbdeviceid, opts...)
+}
+
+// GetPaloAltoFirewallNetworkIDWithContext is the same as GetPaloAltoFirewallNetworkID, but uses ctx to cancel the request
+func (s *NetworkService) GetPaloAltoFirewallNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListPaloAltoFirewallNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -2836,7 +5903,7 @@ func (s *NetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid
 		}
 	}
 
-	l, err := s.ListPaloAltoFirewallNetworks(p)
+	l, err := s.ListPaloAltoFirewallNetworksWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2861,7 +5928,16 @@ func (s *NetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid
 
 // lists network that are using Palo Alto firewall device
 func (s *NetworkService) ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listPaloAltoFirewallNetworks", p.toURLValues())
+	return s.ListPaloAltoFirewallNetworksWithContext(context.Background(), p)
+}
+
+// ListPaloAltoFirewallNetworksWithContext is the same as ListPaloAltoFirewallNetworks, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) ListPaloAltoFirewallNetworksWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listPaloAltoFirewallNetworks", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2874,37 +5950,123 @@ func (s *NetworkService) ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNet
 	return &r, nil
 }
 
-type ListPaloAltoFirewallNetworksResponse struct {
-	Count                    int                        `json:"count"`
-	PaloAltoFirewallNetworks []*PaloAltoFirewallNetwork `json:"paloaltofirewallnetwork"`
+// ListPaloAltoFirewallNetworksPager iterates over all pages of a ListPaloAltoFirewallNetworks call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListPaloAltoFirewallNetworksPager struct {
+	pager
+
+	s *NetworkService
+	p *ListPaloAltoFirewallNetworksParams
+	r *ListPaloAltoFirewallNetworksResponse
 }
 
-type PaloAltoFirewallNetwork struct {
-	Account                     string                           `json:"account"`
-	Aclid                       string                           `json:"aclid"`
-	Aclname                     string                           `json:"aclname"`
-	Acltype                     string                           `json:"acltype"`
-	Broadcastdomaintype         string                           `json:"broadcastdomaintype"`
-	Broadcasturi                string                           `json:"broadcasturi"`
-	Canusefordeploy             bool                             `json:"canusefordeploy"`
-	Cidr                        string                           `json:"cidr"`
-	Details                     map[string]string                `json:"details"`
-	Displaynetwork              bool                             `json:"displaynetwork"`
-	Displaytext                 string                           `json:"displaytext"`
-	Dns1                        string                           `json:"dns1"`
-	Dns2                        string                           `json:"dns2"`
-	Domain                      string                           `json:"domain"`
-	Domainid                    string                           `json:"domainid"`
-	Externalid                  string                           `json:"externalid"`
-	Gateway                     string                           `json:"gateway"`
-	Id                          string                           `json:"id"`
-	Ip6cidr                     string                           `json:"ip6cidr"`
-	Ip6gateway                  string                           `json:"ip6gateway"`
-	Isdefault                   bool                             `json:"isdefault"`
-	Ispersistent                bool                             `json:"ispersistent"`
-	Issystem                    bool                             `json:"issystem"`
-	JobID                       string                           `json:"jobid"`
-	Jobstatus                   int                              `json:"jobstatus"`
+// NewListPaloAltoFirewallNetworksPager returns a pager for all pages of a ListPaloAltoFirewallNetworks call with the given params
+func (s *NetworkService) NewListPaloAltoFirewallNetworksPager(p *ListPaloAltoFirewallNetworksParams) *ListPaloAltoFirewallNetworksPager {
+	return &ListPaloAltoFirewallNetworksPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListPaloAltoFirewallNetworksPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListPaloAltoFirewallNetworksPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListPaloAltoFirewallNetworksParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListPaloAltoFirewallNetworksWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.PaloAltoFirewallNetworks), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListPaloAltoFirewallNetworksPager) Page() *ListPaloAltoFirewallNetworksResponse {
+	return pg.r
+}
+
+// ListPaloAltoFirewallNetworksAll fetches all pages of a ListPaloAltoFirewallNetworks call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetworkService) ListPaloAltoFirewallNetworksAll(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
+	return s.ListPaloAltoFirewallNetworksAllWithContext(context.Background(), p)
+}
+
+// ListPaloAltoFirewallNetworksAllWithContext is the same as ListPaloAltoFirewallNetworksAll, but uses ctx to cancel the requests
+func (s *NetworkService) ListPaloAltoFirewallNetworksAllWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*PaloAltoFirewallNetwork)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListPaloAltoFirewallNetworksParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListPaloAltoFirewallNetworksWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.PaloAltoFirewallNetworks
+		mu.Unlock()
+
+		return len(l.PaloAltoFirewallNetworks), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListPaloAltoFirewallNetworksResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.PaloAltoFirewallNetworks = append(r.PaloAltoFirewallNetworks, pages[page]...)
+	}
+	r.Count = len(r.PaloAltoFirewallNetworks)
+
+	return r, nil
+}
+
+type ListPaloAltoFirewallNetworksResponse struct {
+	Count                    int                        `json:"count"`
+	PaloAltoFirewallNetworks []*PaloAltoFirewallNetwork `json:"paloaltofirewallnetwork"`
+}
+
+type PaloAltoFirewallNetwork struct {
+	Account                     string                           `json:"account"`
+	Aclid                       string                           `json:"aclid"`
+	Aclname                     string                           `json:"aclname"`
+	Acltype                     string                           `json:"acltype"`
+	Broadcastdomaintype         string                           `json:"broadcastdomaintype"`
+	Broadcasturi                string                           `json:"broadcasturi"`
+	Canusefordeploy             bool                             `json:"canusefordeploy"`
+	Cidr                        string                           `json:"cidr"`
+	Details                     map[string]string                `json:"details"`
+	Displaynetwork              bool                             `json:"displaynetwork"`
+	Displaytext                 string                           `json:"displaytext"`
+	Dns1                        string                           `json:"dns1"`
+	Dns2                        string                           `json:"dns2"`
+	Domain                      string                           `json:"domain"`
+	Domainid                    string                           `json:"domainid"`
+	Externalid                  string                           `json:"externalid"`
+	Gateway                     string                           `json:"gateway"`
+	Id                          string                           `json:"id"`
+	Ip6cidr                     string                           `json:"ip6cidr"`
+	Ip6gateway                  string                           `json:"ip6gateway"`
+	Isdefault                   bool                             `json:"isdefault"`
+	Ispersistent                bool                             `json:"ispersistent"`
+	Issystem                    bool                             `json:"issystem"`
+	JobID                       string                           `json:"jobid"`
+	Jobstatus                   int                              `json:"jobstatus"`
 	Name                        string                           `json:"name"`
 	Netmask                     string                           `json:"netmask"`
 	Networkcidr                 string                           `json:"networkcidr"`
@@ -2963,7 +6125,8 @@ type ListPhysicalNetworksParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListPhysicalNetworksParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListPhysicalNetworksParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2991,6 +6154,53 @@ func (p *ListPhysicalNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListPhysicalNetworksParams) Validate() error {
+	v := newParamValidator("listPhysicalNetworks", p.p)
+	v.ids("id", "zoneid")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListPhysicalNetworksParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListPhysicalNetworksParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword", "name", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listPhysicalNetworks", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listPhysicalNetworks: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListPhysicalNetworksParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2998,6 +6208,15 @@ func (p *ListPhysicalNetworksParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListPhysicalNetworksParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListPhysicalNetworksParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListPhysicalNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3005,6 +6224,15 @@ func (p *ListPhysicalNetworksParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListPhysicalNetworksParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListPhysicalNetworksParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListPhysicalNetworksParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3012,6 +6240,15 @@ func (p *ListPhysicalNetworksParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListPhysicalNetworksParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListPhysicalNetworksParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListPhysicalNetworksParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3019,6 +6256,15 @@ func (p *ListPhysicalNetworksParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListPhysicalNetworksParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListPhysicalNetworksParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListPhysicalNetworksParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3026,6 +6272,15 @@ func (p *ListPhysicalNetworksParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListPhysicalNetworksParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListPhysicalNetworksParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListPhysicalNetworksParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3033,6 +6288,15 @@ func (p *ListPhysicalNetworksParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListPhysicalNetworksParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListPhysicalNetworksParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListPhysicalNetworksParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListPhysicalNetworksParams() *ListPhysicalNetworksParams {
@@ -3043,6 +6307,11 @@ func (s *NetworkService) NewListPhysicalNetworksParams() *ListPhysicalNetworksPa
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetPhysicalNetworkIDWithContext(context.Background(), name, opts...)
+}
+
+// GetPhysicalNetworkIDWithContext is the same as GetPhysicalNetworkID, but uses ctx to cancel the request
+func (s *NetworkService) GetPhysicalNetworkIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListPhysicalNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -3054,7 +6323,7 @@ func (s *NetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (
 		}
 	}
 
-	l, err := s.ListPhysicalNetworks(p)
+	l, err := s.ListPhysicalNetworksWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -3079,12 +6348,17 @@ func (s *NetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetPhysicalNetworkByName(name string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
-	id, count, err := s.GetPhysicalNetworkID(name, opts...)
+	return s.GetPhysicalNetworkByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetPhysicalNetworkByNameWithContext is the same as GetPhysicalNetworkByName, but uses ctx to cancel the requests
+func (s *NetworkService) GetPhysicalNetworkByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
+	id, count, err := s.GetPhysicalNetworkIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetPhysicalNetworkByID(id, opts...)
+	r, count, err := s.GetPhysicalNetworkByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -3093,6 +6367,11 @@ func (s *NetworkService) GetPhysicalNetworkByName(name string, opts ...OptionFun
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
+	return s.GetPhysicalNetworkByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetPhysicalNetworkByIDWithContext is the same as GetPhysicalNetworkByID, but uses ctx to cancel the request
+func (s *NetworkService) GetPhysicalNetworkByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
 	p := &ListPhysicalNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -3104,18 +6383,16 @@ func (s *NetworkService) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (
 		}
 	}
 
-	l, err := s.ListPhysicalNetworks(p)
+	l, err := s.ListPhysicalNetworksWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -3126,7 +6403,16 @@ func (s *NetworkService) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (
 
 // Lists physical networks
 func (s *NetworkService) ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listPhysicalNetworks", p.toURLValues())
+	return s.ListPhysicalNetworksWithContext(context.Background(), p)
+}
+
+// ListPhysicalNetworksWithContext is the same as ListPhysicalNetworks, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) ListPhysicalNetworksWithContext(ctx context.Context, p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listPhysicalNetworks", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3139,6 +6425,92 @@ func (s *NetworkService) ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*L
 	return &r, nil
 }
 
+// ListPhysicalNetworksPager iterates over all pages of a ListPhysicalNetworks call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListPhysicalNetworksPager struct {
+	pager
+
+	s *NetworkService
+	p *ListPhysicalNetworksParams
+	r *ListPhysicalNetworksResponse
+}
+
+// NewListPhysicalNetworksPager returns a pager for all pages of a ListPhysicalNetworks call with the given params
+func (s *NetworkService) NewListPhysicalNetworksPager(p *ListPhysicalNetworksParams) *ListPhysicalNetworksPager {
+	return &ListPhysicalNetworksPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListPhysicalNetworksPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListPhysicalNetworksPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListPhysicalNetworksParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListPhysicalNetworksWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.PhysicalNetworks), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListPhysicalNetworksPager) Page() *ListPhysicalNetworksResponse {
+	return pg.r
+}
+
+// ListPhysicalNetworksAll fetches all pages of a ListPhysicalNetworks call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetworkService) ListPhysicalNetworksAll(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
+	return s.ListPhysicalNetworksAllWithContext(context.Background(), p)
+}
+
+// ListPhysicalNetworksAllWithContext is the same as ListPhysicalNetworksAll, but uses ctx to cancel the requests
+func (s *NetworkService) ListPhysicalNetworksAllWithContext(ctx context.Context, p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*PhysicalNetwork)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListPhysicalNetworksParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListPhysicalNetworksWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.PhysicalNetworks
+		mu.Unlock()
+
+		return len(l.PhysicalNetworks), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListPhysicalNetworksResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.PhysicalNetworks = append(r.PhysicalNetworks, pages[page]...)
+	}
+	r.Count = len(r.PhysicalNetworks)
+
+	return r, nil
+}
+
 type ListPhysicalNetworksResponse struct {
 	Count            int                `json:"count"`
 	PhysicalNetworks []*PhysicalNetwork `json:"physicalnetwork"`
@@ -3164,7 +6536,8 @@ type ListStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListStorageNetworkIpRangeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListStorageNetworkIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3192,6 +6565,52 @@ func (p *ListStorageNetworkIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListStorageNetworkIpRangeParams) Validate() error {
+	v := newParamValidator("listStorageNetworkIpRange", p.p)
+	v.ids("id", "podid", "zoneid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListStorageNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListStorageNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword", "podid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listStorageNetworkIpRange", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listStorageNetworkIpRange: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListStorageNetworkIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3199,6 +6618,15 @@ func (p *ListStorageNetworkIpRangeParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListStorageNetworkIpRangeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListStorageNetworkIpRangeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListStorageNetworkIpRangeParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3206,6 +6634,15 @@ func (p *ListStorageNetworkIpRangeParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListStorageNetworkIpRangeParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListStorageNetworkIpRangeParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListStorageNetworkIpRangeParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3213,6 +6650,15 @@ func (p *ListStorageNetworkIpRangeParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListStorageNetworkIpRangeParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListStorageNetworkIpRangeParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListStorageNetworkIpRangeParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3220,6 +6666,15 @@ func (p *ListStorageNetworkIpRangeParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListStorageNetworkIpRangeParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListStorageNetworkIpRangeParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListStorageNetworkIpRangeParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3227,6 +6682,15 @@ func (p *ListStorageNetworkIpRangeParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *ListStorageNetworkIpRangeParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListStorageNetworkIpRangeParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *ListStorageNetworkIpRangeParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3234,6 +6698,15 @@ func (p *ListStorageNetworkIpRangeParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListStorageNetworkIpRangeParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListStorageNetworkIpRangeParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListStorageNetworkIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListStorageNetworkIpRangeParams() *ListStorageNetworkIpRangeParams {
@@ -3244,6 +6717,11 @@ func (s *NetworkService) NewListStorageNetworkIpRangeParams() *ListStorageNetwor
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error) {
+	return s.GetStorageNetworkIpRangeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetStorageNetworkIpRangeByIDWithContext is the same as GetStorageNetworkIpRangeByID, but uses ctx to cancel the request
+func (s *NetworkService) GetStorageNetworkIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error) {
 	p := &ListStorageNetworkIpRangeParams{}
 	p.p = make(map[string]interface{})
 
@@ -3255,18 +6733,16 @@ func (s *NetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionF
 		}
 	}
 
-	l, err := s.ListStorageNetworkIpRange(p)
+	l, err := s.ListStorageNetworkIpRangeWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -3277,7 +6753,16 @@ func (s *NetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionF
 
 // List a storage network IP range.
 func (s *NetworkService) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("listStorageNetworkIpRange", p.toURLValues())
+	return s.ListStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// ListStorageNetworkIpRangeWithContext is the same as ListStorageNetworkIpRange, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) ListStorageNetworkIpRangeWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listStorageNetworkIpRange", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3290,6 +6775,92 @@ func (s *NetworkService) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeP
 	return &r, nil
 }
 
+// ListStorageNetworkIpRangePager iterates over all pages of a ListStorageNetworkIpRange call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListStorageNetworkIpRangePager struct {
+	pager
+
+	s *NetworkService
+	p *ListStorageNetworkIpRangeParams
+	r *ListStorageNetworkIpRangeResponse
+}
+
+// NewListStorageNetworkIpRangePager returns a pager for all pages of a ListStorageNetworkIpRange call with the given params
+func (s *NetworkService) NewListStorageNetworkIpRangePager(p *ListStorageNetworkIpRangeParams) *ListStorageNetworkIpRangePager {
+	return &ListStorageNetworkIpRangePager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListStorageNetworkIpRangePager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListStorageNetworkIpRangePager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListStorageNetworkIpRangeParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListStorageNetworkIpRangeWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.StorageNetworkIpRange), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListStorageNetworkIpRangePager) Page() *ListStorageNetworkIpRangeResponse {
+	return pg.r
+}
+
+// ListStorageNetworkIpRangeAll fetches all pages of a ListStorageNetworkIpRange call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetworkService) ListStorageNetworkIpRangeAll(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
+	return s.ListStorageNetworkIpRangeAllWithContext(context.Background(), p)
+}
+
+// ListStorageNetworkIpRangeAllWithContext is the same as ListStorageNetworkIpRangeAll, but uses ctx to cancel the requests
+func (s *NetworkService) ListStorageNetworkIpRangeAllWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*StorageNetworkIpRange)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListStorageNetworkIpRangeParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListStorageNetworkIpRangeWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.StorageNetworkIpRange
+		mu.Unlock()
+
+		return len(l.StorageNetworkIpRange), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListStorageNetworkIpRangeResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.StorageNetworkIpRange = append(r.StorageNetworkIpRange, pages[page]...)
+	}
+	r.Count = len(r.StorageNetworkIpRange)
+
+	return r, nil
+}
+
 type ListStorageNetworkIpRangeResponse struct {
 	Count                 int                      `json:"count"`
 	StorageNetworkIpRange []*StorageNetworkIpRange `json:"storagenetworkiprange"`
@@ -3313,7 +6884,8 @@ type ListSupportedNetworkServicesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListSupportedNetworkServicesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListSupportedNetworkServicesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3338,6 +6910,53 @@ func (p *ListSupportedNetworkServicesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListSupportedNetworkServicesParams) Validate() error {
+	v := newParamValidator("listSupportedNetworkServices", p.p)
+	v.maxLength("keyword", 255)
+	v.maxLength("provider", 255)
+	v.maxLength("service", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListSupportedNetworkServicesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListSupportedNetworkServicesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "provider", "service":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listSupportedNetworkServices", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listSupportedNetworkServices: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListSupportedNetworkServicesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3345,6 +6964,15 @@ func (p *ListSupportedNetworkServicesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListSupportedNetworkServicesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListSupportedNetworkServicesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListSupportedNetworkServicesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3352,6 +6980,15 @@ func (p *ListSupportedNetworkServicesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListSupportedNetworkServicesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListSupportedNetworkServicesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListSupportedNetworkServicesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3359,6 +6996,15 @@ func (p *ListSupportedNetworkServicesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListSupportedNetworkServicesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListSupportedNetworkServicesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListSupportedNetworkServicesParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3366,13 +7012,31 @@ func (p *ListSupportedNetworkServicesParams) SetProvider(v string) {
 	p.p["provider"] = v
 }
 
-func (p *ListSupportedNetworkServicesParams) SetService(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSupportedNetworkServicesParams) ResetProvider() {
+	delete(p.p, "provider")
+}
+
+func (p *ListSupportedNetworkServicesParams) GetProvider() (string, bool) {
+	value, ok := p.p["provider"].(string)
+	return value, ok
+}
+
+func (p *ListSupportedNetworkServicesParams) SetService(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
 	p.p["service"] = v
 }
 
+func (p *ListSupportedNetworkServicesParams) ResetService() {
+	delete(p.p, "service")
+}
+
+func (p *ListSupportedNetworkServicesParams) GetService() (string, bool) {
+	value, ok := p.p["service"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListSupportedNetworkServicesParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListSupportedNetworkServicesParams() *ListSupportedNetworkServicesParams {
@@ -3383,7 +7047,16 @@ func (s *NetworkService) NewListSupportedNetworkServicesParams() *ListSupportedN
 
 // Lists all network services provided by CloudStack or for the given Provider.
 func (s *NetworkService) ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
-	resp, err := s.cs.newRequest("listSupportedNetworkServices", p.toURLValues())
+	return s.ListSupportedNetworkServicesWithContext(context.Background(), p)
+}
+
+// ListSupportedNetworkServicesWithContext is the same as ListSupportedNetworkServices, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) ListSupportedNetworkServicesWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listSupportedNetworkServices", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3396,6 +7069,92 @@ func (s *NetworkService) ListSupportedNetworkServices(p *ListSupportedNetworkSer
 	return &r, nil
 }
 
+// ListSupportedNetworkServicesPager iterates over all pages of a ListSupportedNetworkServices call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListSupportedNetworkServicesPager struct {
+	pager
+
+	s *NetworkService
+	p *ListSupportedNetworkServicesParams
+	r *ListSupportedNetworkServicesResponse
+}
+
+// NewListSupportedNetworkServicesPager returns a pager for all pages of a ListSupportedNetworkServices call with the given params
+func (s *NetworkService) NewListSupportedNetworkServicesPager(p *ListSupportedNetworkServicesParams) *ListSupportedNetworkServicesPager {
+	return &ListSupportedNetworkServicesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListSupportedNetworkServicesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListSupportedNetworkServicesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListSupportedNetworkServicesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListSupportedNetworkServicesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.SupportedNetworkServices), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListSupportedNetworkServicesPager) Page() *ListSupportedNetworkServicesResponse {
+	return pg.r
+}
+
+// ListSupportedNetworkServicesAll fetches all pages of a ListSupportedNetworkServices call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NetworkService) ListSupportedNetworkServicesAll(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
+	return s.ListSupportedNetworkServicesAllWithContext(context.Background(), p)
+}
+
+// ListSupportedNetworkServicesAllWithContext is the same as ListSupportedNetworkServicesAll, but uses ctx to cancel the requests
+func (s *NetworkService) ListSupportedNetworkServicesAllWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*SupportedNetworkService)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListSupportedNetworkServicesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListSupportedNetworkServicesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.SupportedNetworkServices
+		mu.Unlock()
+
+		return len(l.SupportedNetworkServices), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListSupportedNetworkServicesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.SupportedNetworkServices = append(r.SupportedNetworkServices, pages[page]...)
+	}
+	r.Count = len(r.SupportedNetworkServices)
+
+	return r, nil
+}
+
 type ListSupportedNetworkServicesResponse struct {
 	Count                    int                        `json:"count"`
 	SupportedNetworkServices []*SupportedNetworkService `json:"supportednetworkservice"`
@@ -3425,11 +7184,297 @@ type SupportedNetworkServiceCapability struct {
 	Value                      string `json:"value"`
 }
 
+type MigrateNetworkParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *MigrateNetworkParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["networkid"]; found {
+		u.Set("networkid", v.(string))
+	}
+	if v, found := p.p["networkofferingid"]; found {
+		u.Set("networkofferingid", v.(string))
+	}
+	if v, found := p.p["resume"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("resume", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *MigrateNetworkParams) Validate() error {
+	v := newParamValidator("migrateNetwork", p.p)
+	v.required("networkid", "networkofferingid")
+	v.ids("networkid", "networkofferingid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *MigrateNetworkParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *MigrateNetworkParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "networkid", "networkofferingid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "resume":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for migrateNetwork", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of migrateNetwork: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *MigrateNetworkParams) SetNetworkid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networkid"] = v
+}
+
+func (p *MigrateNetworkParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *MigrateNetworkParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
+func (p *MigrateNetworkParams) SetNetworkofferingid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networkofferingid"] = v
+}
+
+func (p *MigrateNetworkParams) ResetNetworkofferingid() {
+	delete(p.p, "networkofferingid")
+}
+
+func (p *MigrateNetworkParams) GetNetworkofferingid() (string, bool) {
+	value, ok := p.p["networkofferingid"].(string)
+	return value, ok
+}
+
+func (p *MigrateNetworkParams) SetResume(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["resume"] = v
+}
+
+func (p *MigrateNetworkParams) ResetResume() {
+	delete(p.p, "resume")
+}
+
+func (p *MigrateNetworkParams) GetResume() (bool, bool) {
+	value, ok := p.p["resume"].(bool)
+	return value, ok
+}
+
+// You should always use this function to get a new MigrateNetworkParams instance,
+// as then you are sure you have configured all required params
+func (s *NetworkService) NewMigrateNetworkParams(networkid string, networkofferingid string) *MigrateNetworkParams {
+	p := &MigrateNetworkParams{}
+	p.p = make(map[string]interface{})
+	p.p["networkid"] = networkid
+	p.p["networkofferingid"] = networkofferingid
+	return p
+}
+
+// moves a network to another physical network
+func (s *NetworkService) MigrateNetwork(p *MigrateNetworkParams) (*MigrateNetworkResponse, error) {
+	return s.MigrateNetworkWithContext(context.Background(), p)
+}
+
+// MigrateNetworkWithContext is the same as MigrateNetwork, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) MigrateNetworkWithContext(ctx context.Context, p *MigrateNetworkParams) (*MigrateNetworkResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateNetwork", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// MigrateNetworkAsync starts the async job for MigrateNetwork and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a MigrateNetworkResponse using Job.Result
+func (s *NetworkService) MigrateNetworkAsync(p *MigrateNetworkParams) (*Job, error) {
+	return s.MigrateNetworkAsyncWithContext(context.Background(), p)
+}
+
+// MigrateNetworkAsyncWithContext is the same as MigrateNetworkAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) MigrateNetworkAsyncWithContext(ctx context.Context, p *MigrateNetworkParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateNetwork", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type MigrateNetworkResponse struct {
+	Account                     string                          `json:"account"`
+	Aclid                       string                          `json:"aclid"`
+	Aclname                     string                          `json:"aclname"`
+	Acltype                     string                          `json:"acltype"`
+	Broadcastdomaintype         string                          `json:"broadcastdomaintype"`
+	Broadcasturi                string                          `json:"broadcasturi"`
+	Canusefordeploy             bool                            `json:"canusefordeploy"`
+	Cidr                        string                          `json:"cidr"`
+	Details                     map[string]string               `json:"details"`
+	Displaynetwork              bool                            `json:"displaynetwork"`
+	Displaytext                 string                          `json:"displaytext"`
+	Dns1                        string                          `json:"dns1"`
+	Dns2                        string                          `json:"dns2"`
+	Domain                      string                          `json:"domain"`
+	Domainid                    string                          `json:"domainid"`
+	Externalid                  string                          `json:"externalid"`
+	Gateway                     string                          `json:"gateway"`
+	Id                          string                          `json:"id"`
+	Ip6cidr                     string                          `json:"ip6cidr"`
+	Ip6gateway                  string                          `json:"ip6gateway"`
+	Isdefault                   bool                            `json:"isdefault"`
+	Ispersistent                bool                            `json:"ispersistent"`
+	Issystem                    bool                            `json:"issystem"`
+	JobID                       string                          `json:"jobid"`
+	Jobstatus                   int                             `json:"jobstatus"`
+	Name                        string                          `json:"name"`
+	Netmask                     string                          `json:"netmask"`
+	Networkcidr                 string                          `json:"networkcidr"`
+	Networkdomain               string                          `json:"networkdomain"`
+	Networkofferingavailability string                          `json:"networkofferingavailability"`
+	Networkofferingconservemode bool                            `json:"networkofferingconservemode"`
+	Networkofferingdisplaytext  string                          `json:"networkofferingdisplaytext"`
+	Networkofferingid           string                          `json:"networkofferingid"`
+	Networkofferingname         string                          `json:"networkofferingname"`
+	Physicalnetworkid           string                          `json:"physicalnetworkid"`
+	Project                     string                          `json:"project"`
+	Projectid                   string                          `json:"projectid"`
+	Redundantrouter             bool                            `json:"redundantrouter"`
+	Related                     string                          `json:"related"`
+	Reservediprange             string                          `json:"reservediprange"`
+	Restartrequired             bool                            `json:"restartrequired"`
+	Service                     []MigrateNetworkResponseService `json:"service"`
+	Specifyipranges             bool                            `json:"specifyipranges"`
+	State                       string                          `json:"state"`
+	Strechedl2subnet            bool                            `json:"strechedl2subnet"`
+	Subdomainaccess             bool                            `json:"subdomainaccess"`
+	Tags                        []Tags                          `json:"tags"`
+	Traffictype                 string                          `json:"traffictype"`
+	Type                        string                          `json:"type"`
+	Vlan                        string                          `json:"vlan"`
+	Vpcid                       string                          `json:"vpcid"`
+	Vpcname                     string                          `json:"vpcname"`
+	Zoneid                      string                          `json:"zoneid"`
+	Zonename                    string                          `json:"zonename"`
+	Zonesnetworkspans           []interface{}                   `json:"zonesnetworkspans"`
+}
+
+type MigrateNetworkResponseService struct {
+	Capability []MigrateNetworkResponseServiceCapability `json:"capability"`
+	Name       string                                    `json:"name"`
+	Provider   []MigrateNetworkResponseServiceProvider   `json:"provider"`
+}
+
+type MigrateNetworkResponseServiceProvider struct {
+	Canenableindividualservice   bool     `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
+	Id                           string   `json:"id"`
+	Name                         string   `json:"name"`
+	Physicalnetworkid            string   `json:"physicalnetworkid"`
+	Servicelist                  []string `json:"servicelist"`
+	State                        string   `json:"state"`
+}
+
+type MigrateNetworkResponseServiceCapability struct {
+	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
+	Name                       string `json:"name"`
+	Value                      string `json:"value"`
+}
+
 type ReleasePublicIpRangeParams struct {
 	p map[string]interface{}
 }
 
-func (p *ReleasePublicIpRangeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ReleasePublicIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3440,6 +7485,48 @@ func (p *ReleasePublicIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ReleasePublicIpRangeParams) Validate() error {
+	v := newParamValidator("releasePublicIpRange", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ReleasePublicIpRangeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ReleasePublicIpRangeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for releasePublicIpRange", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of releasePublicIpRange: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ReleasePublicIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3447,6 +7534,15 @@ func (p *ReleasePublicIpRangeParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ReleasePublicIpRangeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ReleasePublicIpRangeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ReleasePublicIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewReleasePublicIpRangeParams(id string) *ReleasePublicIpRangeParams {
@@ -3458,7 +7554,16 @@ func (s *NetworkService) NewReleasePublicIpRangeParams(id string) *ReleasePublic
 
 // Releases a Public IP range back to the system pool
 func (s *NetworkService) ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("releasePublicIpRange", p.toURLValues())
+	return s.ReleasePublicIpRangeWithContext(context.Background(), p)
+}
+
+// ReleasePublicIpRangeWithContext is the same as ReleasePublicIpRange, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) ReleasePublicIpRangeWithContext(ctx context.Context, p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "releasePublicIpRange", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3509,7 +7614,8 @@ type RestartNetworkParams struct {
 	p map[string]interface{}
 }
 
-func (p *RestartNetworkParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RestartNetworkParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3528,6 +7634,52 @@ func (p *RestartNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RestartNetworkParams) Validate() error {
+	v := newParamValidator("restartNetwork", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RestartNetworkParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RestartNetworkParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "cleanup", "makeredundant":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for restartNetwork", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of restartNetwork: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RestartNetworkParams) SetCleanup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3535,6 +7687,15 @@ func (p *RestartNetworkParams) SetCleanup(v bool) {
 	p.p["cleanup"] = v
 }
 
+func (p *RestartNetworkParams) ResetCleanup() {
+	delete(p.p, "cleanup")
+}
+
+func (p *RestartNetworkParams) GetCleanup() (bool, bool) {
+	value, ok := p.p["cleanup"].(bool)
+	return value, ok
+}
+
 func (p *RestartNetworkParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3542,6 +7703,15 @@ func (p *RestartNetworkParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RestartNetworkParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RestartNetworkParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *RestartNetworkParams) SetMakeredundant(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3549,6 +7719,15 @@ func (p *RestartNetworkParams) SetMakeredundant(v bool) {
 	p.p["makeredundant"] = v
 }
 
+func (p *RestartNetworkParams) ResetMakeredundant() {
+	delete(p.p, "makeredundant")
+}
+
+func (p *RestartNetworkParams) GetMakeredundant() (bool, bool) {
+	value, ok := p.p["makeredundant"].(bool)
+	return value, ok
+}
+
 // You should always use this function to get a new RestartNetworkParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewRestartNetworkParams(id string) *RestartNetworkParams {
@@ -3560,7 +7739,16 @@ func (s *NetworkService) NewRestartNetworkParams(id string) *RestartNetworkParam
 
 // Restarts the network; includes 1) restarting network elements - virtual routers, DHCP servers 2) reapplying all public IPs 3) reapplying loadBalancing/portForwarding rules
 func (s *NetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetworkResponse, error) {
-	resp, err := s.cs.newRequest("restartNetwork", p.toURLValues())
+	return s.RestartNetworkWithContext(context.Background(), p)
+}
+
+// RestartNetworkWithContext is the same as RestartNetwork, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) RestartNetworkWithContext(ctx context.Context, p *RestartNetworkParams) (*RestartNetworkResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "restartNetwork", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3572,7 +7760,7 @@ func (s *NetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetwor
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3593,6 +7781,40 @@ func (s *NetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetwor
 	return &r, nil
 }
 
+// RestartNetworkAsync starts the async job for RestartNetwork and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RestartNetworkResponse using Job.Result
+func (s *NetworkService) RestartNetworkAsync(p *RestartNetworkParams) (*Job, error) {
+	return s.RestartNetworkAsyncWithContext(context.Background(), p)
+}
+
+// RestartNetworkAsyncWithContext is the same as RestartNetworkAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) RestartNetworkAsyncWithContext(ctx context.Context, p *RestartNetworkParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "restartNetwork", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RestartNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type RestartNetworkResponse struct {
 	Account                   string `json:"account"`
 	Allocated                 string `json:"allocated"`
@@ -3634,7 +7856,8 @@ type UpdateNetworkParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateNetworkParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateNetworkParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3680,7 +7903,58 @@ func (p *UpdateNetworkParams) toURLValues() url.Values {
 		vv := strconv.FormatBool(v.(bool))
 		u.Set("updateinsequence", vv)
 	}
-	return u
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateNetworkParams) Validate() error {
+	v := newParamValidator("updateNetwork", p.p)
+	v.required("id")
+	v.ids("id", "networkofferingid")
+	v.maxLength("customid", 255)
+	v.maxLength("displaytext", 255)
+	v.maxLength("guestvmcidr", 255)
+	v.maxLength("name", 255)
+	v.maxLength("networkdomain", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateNetworkParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateNetworkParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "changecidr", "displaynetwork", "forced", "hideipaddressusage", "updateinsequence":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "customid", "displaytext", "guestvmcidr", "id", "name", "networkdomain", "networkofferingid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateNetwork", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateNetwork: %w", k, err)
+		}
+	}
+	return nil
 }
 
 func (p *UpdateNetworkParams) SetChangecidr(v bool) {
@@ -3690,6 +7964,15 @@ func (p *UpdateNetworkParams) SetChangecidr(v bool) {
 	p.p["changecidr"] = v
 }
 
+func (p *UpdateNetworkParams) ResetChangecidr() {
+	delete(p.p, "changecidr")
+}
+
+func (p *UpdateNetworkParams) GetChangecidr() (bool, bool) {
+	value, ok := p.p["changecidr"].(bool)
+	return value, ok
+}
+
 func (p *UpdateNetworkParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3697,6 +7980,15 @@ func (p *UpdateNetworkParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateNetworkParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateNetworkParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkParams) SetDisplaynetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3704,6 +7996,15 @@ func (p *UpdateNetworkParams) SetDisplaynetwork(v bool) {
 	p.p["displaynetwork"] = v
 }
 
+func (p *UpdateNetworkParams) ResetDisplaynetwork() {
+	delete(p.p, "displaynetwork")
+}
+
+func (p *UpdateNetworkParams) GetDisplaynetwork() (bool, bool) {
+	value, ok := p.p["displaynetwork"].(bool)
+	return value, ok
+}
+
 func (p *UpdateNetworkParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3711,6 +8012,15 @@ func (p *UpdateNetworkParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *UpdateNetworkParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *UpdateNetworkParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3718,6 +8028,15 @@ func (p *UpdateNetworkParams) SetForced(v bool) {
 	p.p["forced"] = v
 }
 
+func (p *UpdateNetworkParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *UpdateNetworkParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
 func (p *UpdateNetworkParams) SetGuestvmcidr(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3725,6 +8044,15 @@ func (p *UpdateNetworkParams) SetGuestvmcidr(v string) {
 	p.p["guestvmcidr"] = v
 }
 
+func (p *UpdateNetworkParams) ResetGuestvmcidr() {
+	delete(p.p, "guestvmcidr")
+}
+
+func (p *UpdateNetworkParams) GetGuestvmcidr() (string, bool) {
+	value, ok := p.p["guestvmcidr"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkParams) SetHideipaddressusage(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3732,6 +8060,15 @@ func (p *UpdateNetworkParams) SetHideipaddressusage(v bool) {
 	p.p["hideipaddressusage"] = v
 }
 
+func (p *UpdateNetworkParams) ResetHideipaddressusage() {
+	delete(p.p, "hideipaddressusage")
+}
+
+func (p *UpdateNetworkParams) GetHideipaddressusage() (bool, bool) {
+	value, ok := p.p["hideipaddressusage"].(bool)
+	return value, ok
+}
+
 func (p *UpdateNetworkParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3739,6 +8076,15 @@ func (p *UpdateNetworkParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateNetworkParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateNetworkParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3746,6 +8092,15 @@ func (p *UpdateNetworkParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateNetworkParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateNetworkParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3753,6 +8108,15 @@ func (p *UpdateNetworkParams) SetNetworkdomain(v string) {
 	p.p["networkdomain"] = v
 }
 
+func (p *UpdateNetworkParams) ResetNetworkdomain() {
+	delete(p.p, "networkdomain")
+}
+
+func (p *UpdateNetworkParams) GetNetworkdomain() (string, bool) {
+	value, ok := p.p["networkdomain"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkParams) SetNetworkofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3760,6 +8124,15 @@ func (p *UpdateNetworkParams) SetNetworkofferingid(v string) {
 	p.p["networkofferingid"] = v
 }
 
+func (p *UpdateNetworkParams) ResetNetworkofferingid() {
+	delete(p.p, "networkofferingid")
+}
+
+func (p *UpdateNetworkParams) GetNetworkofferingid() (string, bool) {
+	value, ok := p.p["networkofferingid"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkParams) SetUpdateinsequence(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3767,6 +8140,15 @@ func (p *UpdateNetworkParams) SetUpdateinsequence(v bool) {
 	p.p["updateinsequence"] = v
 }
 
+func (p *UpdateNetworkParams) ResetUpdateinsequence() {
+	delete(p.p, "updateinsequence")
+}
+
+func (p *UpdateNetworkParams) GetUpdateinsequence() (bool, bool) {
+	value, ok := p.p["updateinsequence"].(bool)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateNetworkParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewUpdateNetworkParams(id string) *UpdateNetworkParams {
@@ -3778,7 +8160,16 @@ func (s *NetworkService) NewUpdateNetworkParams(id string) *UpdateNetworkParams
 
 // Updates a network
 func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkResponse, error) {
-	resp, err := s.cs.newRequest("updateNetwork", p.toURLValues())
+	return s.UpdateNetworkWithContext(context.Background(), p)
+}
+
+// UpdateNetworkWithContext is the same as UpdateNetwork, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) UpdateNetworkWithContext(ctx context.Context, p *UpdateNetworkParams) (*UpdateNetworkResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNetwork", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3790,7 +8181,7 @@ func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3811,6 +8202,40 @@ func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkRe
 	return &r, nil
 }
 
+// UpdateNetworkAsync starts the async job for UpdateNetwork and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateNetworkResponse using Job.Result
+func (s *NetworkService) UpdateNetworkAsync(p *UpdateNetworkParams) (*Job, error) {
+	return s.UpdateNetworkAsyncWithContext(context.Background(), p)
+}
+
+// UpdateNetworkAsyncWithContext is the same as UpdateNetworkAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) UpdateNetworkAsyncWithContext(ctx context.Context, p *UpdateNetworkParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNetwork", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateNetworkResponse struct {
 	Account                     string                         `json:"account"`
 	Aclid                       string                         `json:"aclid"`
@@ -3895,7 +8320,8 @@ type UpdateNetworkServiceProviderParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateNetworkServiceProviderParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateNetworkServiceProviderParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3913,6 +8339,53 @@ func (p *UpdateNetworkServiceProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateNetworkServiceProviderParams) Validate() error {
+	v := newParamValidator("updateNetworkServiceProvider", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateNetworkServiceProviderParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateNetworkServiceProviderParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "state":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "servicelist":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateNetworkServiceProvider", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateNetworkServiceProvider: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateNetworkServiceProviderParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3920,6 +8393,15 @@ func (p *UpdateNetworkServiceProviderParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateNetworkServiceProviderParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateNetworkServiceProviderParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateNetworkServiceProviderParams) SetServicelist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3927,6 +8409,15 @@ func (p *UpdateNetworkServiceProviderParams) SetServicelist(v []string) {
 	p.p["servicelist"] = v
 }
 
+func (p *UpdateNetworkServiceProviderParams) ResetServicelist() {
+	delete(p.p, "servicelist")
+}
+
+func (p *UpdateNetworkServiceProviderParams) GetServicelist() ([]string, bool) {
+	value, ok := p.p["servicelist"].([]string)
+	return value, ok
+}
+
 func (p *UpdateNetworkServiceProviderParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3934,6 +8425,15 @@ func (p *UpdateNetworkServiceProviderParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *UpdateNetworkServiceProviderParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *UpdateNetworkServiceProviderParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateNetworkServiceProviderParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewUpdateNetworkServiceProviderParams(id string) *UpdateNetworkServiceProviderParams {
@@ -3945,7 +8445,16 @@ func (s *NetworkService) NewUpdateNetworkServiceProviderParams(id string) *Updat
 
 // Updates a network serviceProvider of a physical network
 func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkServiceProvider", p.toURLValues())
+	return s.UpdateNetworkServiceProviderWithContext(context.Background(), p)
+}
+
+// UpdateNetworkServiceProviderWithContext is the same as UpdateNetworkServiceProvider, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) UpdateNetworkServiceProviderWithContext(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNetworkServiceProvider", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3957,7 +8466,7 @@ func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServicePro
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3978,6 +8487,40 @@ func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServicePro
 	return &r, nil
 }
 
+// UpdateNetworkServiceProviderAsync starts the async job for UpdateNetworkServiceProvider and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateNetworkServiceProviderResponse using Job.Result
+func (s *NetworkService) UpdateNetworkServiceProviderAsync(p *UpdateNetworkServiceProviderParams) (*Job, error) {
+	return s.UpdateNetworkServiceProviderAsyncWithContext(context.Background(), p)
+}
+
+// UpdateNetworkServiceProviderAsyncWithContext is the same as UpdateNetworkServiceProviderAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) UpdateNetworkServiceProviderAsyncWithContext(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateNetworkServiceProvider", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateNetworkServiceProviderResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateNetworkServiceProviderResponse struct {
 	Canenableindividualservice   bool     `json:"canenableindividualservice"`
 	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
@@ -3994,7 +8537,8 @@ type UpdatePhysicalNetworkParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdatePhysicalNetworkParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdatePhysicalNetworkParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -4018,6 +8562,55 @@ func (p *UpdatePhysicalNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdatePhysicalNetworkParams) Validate() error {
+	v := newParamValidator("updatePhysicalNetwork", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("networkspeed", 255)
+	v.maxLength("state", 255)
+	v.maxLength("vlan", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdatePhysicalNetworkParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdatePhysicalNetworkParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "networkspeed", "state", "vlan":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updatePhysicalNetwork", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updatePhysicalNetwork: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdatePhysicalNetworkParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4025,6 +8618,15 @@ func (p *UpdatePhysicalNetworkParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdatePhysicalNetworkParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdatePhysicalNetworkParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdatePhysicalNetworkParams) SetNetworkspeed(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4032,6 +8634,15 @@ func (p *UpdatePhysicalNetworkParams) SetNetworkspeed(v string) {
 	p.p["networkspeed"] = v
 }
 
+func (p *UpdatePhysicalNetworkParams) ResetNetworkspeed() {
+	delete(p.p, "networkspeed")
+}
+
+func (p *UpdatePhysicalNetworkParams) GetNetworkspeed() (string, bool) {
+	value, ok := p.p["networkspeed"].(string)
+	return value, ok
+}
+
 func (p *UpdatePhysicalNetworkParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4039,6 +8650,15 @@ func (p *UpdatePhysicalNetworkParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *UpdatePhysicalNetworkParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *UpdatePhysicalNetworkParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *UpdatePhysicalNetworkParams) SetTags(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4046,6 +8666,15 @@ func (p *UpdatePhysicalNetworkParams) SetTags(v []string) {
 	p.p["tags"] = v
 }
 
+func (p *UpdatePhysicalNetworkParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *UpdatePhysicalNetworkParams) GetTags() ([]string, bool) {
+	value, ok := p.p["tags"].([]string)
+	return value, ok
+}
+
 func (p *UpdatePhysicalNetworkParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4053,6 +8682,15 @@ func (p *UpdatePhysicalNetworkParams) SetVlan(v string) {
 	p.p["vlan"] = v
 }
 
+func (p *UpdatePhysicalNetworkParams) ResetVlan() {
+	delete(p.p, "vlan")
+}
+
+func (p *UpdatePhysicalNetworkParams) GetVlan() (string, bool) {
+	value, ok := p.p["vlan"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdatePhysicalNetworkParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewUpdatePhysicalNetworkParams(id string) *UpdatePhysicalNetworkParams {
@@ -4064,7 +8702,16 @@ func (s *NetworkService) NewUpdatePhysicalNetworkParams(id string) *UpdatePhysic
 
 // Updates a physical network
 func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("updatePhysicalNetwork", p.toURLValues())
+	return s.UpdatePhysicalNetworkWithContext(context.Background(), p)
+}
+
+// UpdatePhysicalNetworkWithContext is the same as UpdatePhysicalNetwork, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) UpdatePhysicalNetworkWithContext(ctx context.Context, p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updatePhysicalNetwork", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4076,7 +8723,7 @@ func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4097,6 +8744,40 @@ func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (
 	return &r, nil
 }
 
+// UpdatePhysicalNetworkAsync starts the async job for UpdatePhysicalNetwork and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdatePhysicalNetworkResponse using Job.Result
+func (s *NetworkService) UpdatePhysicalNetworkAsync(p *UpdatePhysicalNetworkParams) (*Job, error) {
+	return s.UpdatePhysicalNetworkAsyncWithContext(context.Background(), p)
+}
+
+// UpdatePhysicalNetworkAsyncWithContext is the same as UpdatePhysicalNetworkAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) UpdatePhysicalNetworkAsyncWithContext(ctx context.Context, p *UpdatePhysicalNetworkParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updatePhysicalNetwork", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdatePhysicalNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdatePhysicalNetworkResponse struct {
 	Broadcastdomainrange string `json:"broadcastdomainrange"`
 	Domainid             string `json:"domainid"`
@@ -4117,7 +8798,8 @@ type UpdateStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateStorageNetworkIpRangeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateStorageNetworkIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -4141,6 +8823,55 @@ func (p *UpdateStorageNetworkIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateStorageNetworkIpRangeParams) Validate() error {
+	v := newParamValidator("updateStorageNetworkIpRange", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("endip", 255)
+	v.maxLength("netmask", 255)
+	v.maxLength("startip", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateStorageNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateStorageNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "endip", "id", "netmask", "startip":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "vlan":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateStorageNetworkIpRange", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateStorageNetworkIpRange: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateStorageNetworkIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4148,6 +8879,15 @@ func (p *UpdateStorageNetworkIpRangeParams) SetEndip(v string) {
 	p.p["endip"] = v
 }
 
+func (p *UpdateStorageNetworkIpRangeParams) ResetEndip() {
+	delete(p.p, "endip")
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) GetEndip() (string, bool) {
+	value, ok := p.p["endip"].(string)
+	return value, ok
+}
+
 func (p *UpdateStorageNetworkIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4155,6 +8895,15 @@ func (p *UpdateStorageNetworkIpRangeParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateStorageNetworkIpRangeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateStorageNetworkIpRangeParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4162,6 +8911,15 @@ func (p *UpdateStorageNetworkIpRangeParams) SetNetmask(v string) {
 	p.p["netmask"] = v
 }
 
+func (p *UpdateStorageNetworkIpRangeParams) ResetNetmask() {
+	delete(p.p, "netmask")
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) GetNetmask() (string, bool) {
+	value, ok := p.p["netmask"].(string)
+	return value, ok
+}
+
 func (p *UpdateStorageNetworkIpRangeParams) SetStartip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4169,6 +8927,15 @@ func (p *UpdateStorageNetworkIpRangeParams) SetStartip(v string) {
 	p.p["startip"] = v
 }
 
+func (p *UpdateStorageNetworkIpRangeParams) ResetStartip() {
+	delete(p.p, "startip")
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) GetStartip() (string, bool) {
+	value, ok := p.p["startip"].(string)
+	return value, ok
+}
+
 func (p *UpdateStorageNetworkIpRangeParams) SetVlan(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4176,6 +8943,15 @@ func (p *UpdateStorageNetworkIpRangeParams) SetVlan(v int) {
 	p.p["vlan"] = v
 }
 
+func (p *UpdateStorageNetworkIpRangeParams) ResetVlan() {
+	delete(p.p, "vlan")
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) GetVlan() (int, bool) {
+	value, ok := p.p["vlan"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateStorageNetworkIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewUpdateStorageNetworkIpRangeParams(id string) *UpdateStorageNetworkIpRangeParams {
@@ -4187,7 +8963,16 @@ func (s *NetworkService) NewUpdateStorageNetworkIpRangeParams(id string) *Update
 
 // Update a Storage network IP range, only allowed when no IPs in this range have been allocated.
 func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("updateStorageNetworkIpRange", p.toURLValues())
+	return s.UpdateStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// UpdateStorageNetworkIpRangeWithContext is the same as UpdateStorageNetworkIpRange, but uses ctx to cancel the request and any async job polling
+func (s *NetworkService) UpdateStorageNetworkIpRangeWithContext(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateStorageNetworkIpRange", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4199,7 +8984,7 @@ func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4220,6 +9005,40 @@ func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRa
 	return &r, nil
 }
 
+// UpdateStorageNetworkIpRangeAsync starts the async job for UpdateStorageNetworkIpRange and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateStorageNetworkIpRangeResponse using Job.Result
+func (s *NetworkService) UpdateStorageNetworkIpRangeAsync(p *UpdateStorageNetworkIpRangeParams) (*Job, error) {
+	return s.UpdateStorageNetworkIpRangeAsyncWithContext(context.Background(), p)
+}
+
+// UpdateStorageNetworkIpRangeAsyncWithContext is the same as UpdateStorageNetworkIpRangeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NetworkService) UpdateStorageNetworkIpRangeAsyncWithContext(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateStorageNetworkIpRange", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateStorageNetworkIpRangeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateStorageNetworkIpRangeResponse struct {
 	Endip     string `json:"endip"`
 	Gateway   string `json:"gateway"`
@@ -20,16 +20,46 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// NicServiceIface is the interface implemented by NicService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type NicServiceIface interface {
+	NewAddIpToNicParams(nicid string) *AddIpToNicParams
+	AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error)
+	AddIpToNicWithContext(ctx context.Context, p *AddIpToNicParams) (*AddIpToNicResponse, error)
+	AddIpToNicAsync(p *AddIpToNicParams) (*Job, error)
+	AddIpToNicAsyncWithContext(ctx context.Context, p *AddIpToNicParams) (*Job, error)
+	NewListNicsParams(virtualmachineid string) *ListNicsParams
+	ListNics(p *ListNicsParams) (*ListNicsResponse, error)
+	ListNicsWithContext(ctx context.Context, p *ListNicsParams) (*ListNicsResponse, error)
+	NewListNicsPager(p *ListNicsParams) *ListNicsPager
+	ListNicsAll(p *ListNicsParams) (*ListNicsResponse, error)
+	ListNicsAllWithContext(ctx context.Context, p *ListNicsParams) (*ListNicsResponse, error)
+	NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams
+	RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error)
+	RemoveIpFromNicWithContext(ctx context.Context, p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error)
+	RemoveIpFromNicAsync(p *RemoveIpFromNicParams) (*Job, error)
+	RemoveIpFromNicAsyncWithContext(ctx context.Context, p *RemoveIpFromNicParams) (*Job, error)
+	NewUpdateVmNicIpParams(nicid string) *UpdateVmNicIpParams
+	UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error)
+	UpdateVmNicIpWithContext(ctx context.Context, p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error)
+	UpdateVmNicIpAsync(p *UpdateVmNicIpParams) (*Job, error)
+	UpdateVmNicIpAsyncWithContext(ctx context.Context, p *UpdateVmNicIpParams) (*Job, error)
+}
+
 type AddIpToNicParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddIpToNicParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddIpToNicParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -43,6 +73,49 @@ func (p *AddIpToNicParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddIpToNicParams) Validate() error {
+	v := newParamValidator("addIpToNic", p.p)
+	v.required("nicid")
+	v.ids("nicid")
+	v.maxLength("ipaddress", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddIpToNicParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddIpToNicParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "ipaddress", "nicid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addIpToNic", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addIpToNic: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddIpToNicParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -50,6 +123,15 @@ func (p *AddIpToNicParams) SetIpaddress(v string) {
 	p.p["ipaddress"] = v
 }
 
+func (p *AddIpToNicParams) ResetIpaddress() {
+	delete(p.p, "ipaddress")
+}
+
+func (p *AddIpToNicParams) GetIpaddress() (string, bool) {
+	value, ok := p.p["ipaddress"].(string)
+	return value, ok
+}
+
 func (p *AddIpToNicParams) SetNicid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -57,6 +139,15 @@ func (p *AddIpToNicParams) SetNicid(v string) {
 	p.p["nicid"] = v
 }
 
+func (p *AddIpToNicParams) ResetNicid() {
+	delete(p.p, "nicid")
+}
+
+func (p *AddIpToNicParams) GetNicid() (string, bool) {
+	value, ok := p.p["nicid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddIpToNicParams instance,
 // as then you are sure you have configured all required params
 func (s *NicService) NewAddIpToNicParams(nicid string) *AddIpToNicParams {
@@ -68,7 +159,16 @@ func (s *NicService) NewAddIpToNicParams(nicid string) *AddIpToNicParams {
 
 // Assigns secondary IP to NIC
 func (s *NicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error) {
-	resp, err := s.cs.newRequest("addIpToNic", p.toURLValues())
+	return s.AddIpToNicWithContext(context.Background(), p)
+}
+
+// AddIpToNicWithContext is the same as AddIpToNic, but uses ctx to cancel the request and any async job polling
+func (s *NicService) AddIpToNicWithContext(ctx context.Context, p *AddIpToNicParams) (*AddIpToNicResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addIpToNic", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +180,7 @@ func (s *NicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -101,6 +201,40 @@ func (s *NicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error
 	return &r, nil
 }
 
+// AddIpToNicAsync starts the async job for AddIpToNic and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddIpToNicResponse using Job.Result
+func (s *NicService) AddIpToNicAsync(p *AddIpToNicParams) (*Job, error) {
+	return s.AddIpToNicAsyncWithContext(context.Background(), p)
+}
+
+// AddIpToNicAsyncWithContext is the same as AddIpToNicAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NicService) AddIpToNicAsyncWithContext(ctx context.Context, p *AddIpToNicParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addIpToNic", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddIpToNicResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AddIpToNicResponse struct {
 	Id          string `json:"id"`
 	Ipaddress   string `json:"ipaddress"`
@@ -119,7 +253,8 @@ type ListNicsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListNicsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListNicsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -151,6 +286,57 @@ func (p *ListNicsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListNicsParams) Validate() error {
+	v := newParamValidator("listNics", p.p)
+	v.required("virtualmachineid")
+	v.ids("networkid", "nicid", "virtualmachineid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListNicsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListNicsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "keyword", "networkid", "nicid", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listNics", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listNics: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListNicsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -158,6 +344,15 @@ func (p *ListNicsParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListNicsParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListNicsParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListNicsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -165,6 +360,15 @@ func (p *ListNicsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListNicsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListNicsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListNicsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -172,6 +376,15 @@ func (p *ListNicsParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *ListNicsParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListNicsParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *ListNicsParams) SetNicid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -179,6 +392,15 @@ func (p *ListNicsParams) SetNicid(v string) {
 	p.p["nicid"] = v
 }
 
+func (p *ListNicsParams) ResetNicid() {
+	delete(p.p, "nicid")
+}
+
+func (p *ListNicsParams) GetNicid() (string, bool) {
+	value, ok := p.p["nicid"].(string)
+	return value, ok
+}
+
 func (p *ListNicsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -186,6 +408,15 @@ func (p *ListNicsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListNicsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListNicsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListNicsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,6 +424,15 @@ func (p *ListNicsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListNicsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListNicsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListNicsParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -200,6 +440,15 @@ func (p *ListNicsParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *ListNicsParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *ListNicsParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListNicsParams instance,
 // as then you are sure you have configured all required params
 func (s *NicService) NewListNicsParams(virtualmachineid string) *ListNicsParams {
@@ -211,7 +460,16 @@ func (s *NicService) NewListNicsParams(virtualmachineid string) *ListNicsParams
 
 // list the vm nics  IP to NIC
 func (s *NicService) ListNics(p *ListNicsParams) (*ListNicsResponse, error) {
-	resp, err := s.cs.newRequest("listNics", p.toURLValues())
+	return s.ListNicsWithContext(context.Background(), p)
+}
+
+// ListNicsWithContext is the same as ListNics, but uses ctx to cancel the request and any async job polling
+func (s *NicService) ListNicsWithContext(ctx context.Context, p *ListNicsParams) (*ListNicsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listNics", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -224,6 +482,92 @@ func (s *NicService) ListNics(p *ListNicsParams) (*ListNicsResponse, error) {
 	return &r, nil
 }
 
+// ListNicsPager iterates over all pages of a ListNics call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListNicsPager struct {
+	pager
+
+	s *NicService
+	p *ListNicsParams
+	r *ListNicsResponse
+}
+
+// NewListNicsPager returns a pager for all pages of a ListNics call with the given params
+func (s *NicService) NewListNicsPager(p *ListNicsParams) *ListNicsPager {
+	return &ListNicsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListNicsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListNicsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListNicsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListNicsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Nics), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListNicsPager) Page() *ListNicsResponse {
+	return pg.r
+}
+
+// ListNicsAll fetches all pages of a ListNics call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NicService) ListNicsAll(p *ListNicsParams) (*ListNicsResponse, error) {
+	return s.ListNicsAllWithContext(context.Background(), p)
+}
+
+// ListNicsAllWithContext is the same as ListNicsAll, but uses ctx to cancel the requests
+func (s *NicService) ListNicsAllWithContext(ctx context.Context, p *ListNicsParams) (*ListNicsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Nic)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListNicsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListNicsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Nics
+		mu.Unlock()
+
+		return len(l.Nics), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListNicsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Nics = append(r.Nics, pages[page]...)
+	}
+	r.Count = len(r.Nics)
+
+	return r, nil
+}
+
 type ListNicsResponse struct {
 	Count int    `json:"count"`
 	Nics  []*Nic `json:"nic"`
@@ -267,7 +611,8 @@ type RemoveIpFromNicParams struct {
 	p map[string]interface{}
 }
 
-func (p *RemoveIpFromNicParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RemoveIpFromNicParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -278,6 +623,48 @@ func (p *RemoveIpFromNicParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RemoveIpFromNicParams) Validate() error {
+	v := newParamValidator("removeIpFromNic", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RemoveIpFromNicParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RemoveIpFromNicParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for removeIpFromNic", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of removeIpFromNic: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveIpFromNicParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -285,6 +672,15 @@ func (p *RemoveIpFromNicParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RemoveIpFromNicParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RemoveIpFromNicParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RemoveIpFromNicParams instance,
 // as then you are sure you have configured all required params
 func (s *NicService) NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams {
@@ -296,7 +692,16 @@ func (s *NicService) NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams
 
 // Removes secondary IP from the NIC.
 func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error) {
-	resp, err := s.cs.newRequest("removeIpFromNic", p.toURLValues())
+	return s.RemoveIpFromNicWithContext(context.Background(), p)
+}
+
+// RemoveIpFromNicWithContext is the same as RemoveIpFromNic, but uses ctx to cancel the request and any async job polling
+func (s *NicService) RemoveIpFromNicWithContext(ctx context.Context, p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeIpFromNic", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -308,7 +713,7 @@ func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNic
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -324,6 +729,31 @@ func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNic
 	return &r, nil
 }
 
+// RemoveIpFromNicAsync starts the async job for RemoveIpFromNic and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RemoveIpFromNicResponse using Job.Result
+func (s *NicService) RemoveIpFromNicAsync(p *RemoveIpFromNicParams) (*Job, error) {
+	return s.RemoveIpFromNicAsyncWithContext(context.Background(), p)
+}
+
+// RemoveIpFromNicAsyncWithContext is the same as RemoveIpFromNicAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NicService) RemoveIpFromNicAsyncWithContext(ctx context.Context, p *RemoveIpFromNicParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeIpFromNic", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveIpFromNicResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type RemoveIpFromNicResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -335,7 +765,8 @@ type UpdateVmNicIpParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateVmNicIpParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateVmNicIpParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -349,6 +780,49 @@ func (p *UpdateVmNicIpParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateVmNicIpParams) Validate() error {
+	v := newParamValidator("updateVmNicIp", p.p)
+	v.required("nicid")
+	v.ids("nicid")
+	v.maxLength("ipaddress", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateVmNicIpParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateVmNicIpParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "ipaddress", "nicid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateVmNicIp", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateVmNicIp: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVmNicIpParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -356,6 +830,15 @@ func (p *UpdateVmNicIpParams) SetIpaddress(v string) {
 	p.p["ipaddress"] = v
 }
 
+func (p *UpdateVmNicIpParams) ResetIpaddress() {
+	delete(p.p, "ipaddress")
+}
+
+func (p *UpdateVmNicIpParams) GetIpaddress() (string, bool) {
+	value, ok := p.p["ipaddress"].(string)
+	return value, ok
+}
+
 func (p *UpdateVmNicIpParams) SetNicid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -363,6 +846,15 @@ func (p *UpdateVmNicIpParams) SetNicid(v string) {
 	p.p["nicid"] = v
 }
 
+func (p *UpdateVmNicIpParams) ResetNicid() {
+	delete(p.p, "nicid")
+}
+
+func (p *UpdateVmNicIpParams) GetNicid() (string, bool) {
+	value, ok := p.p["nicid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateVmNicIpParams instance,
 // as then you are sure you have configured all required params
 func (s *NicService) NewUpdateVmNicIpParams(nicid string) *UpdateVmNicIpParams {
@@ -374,7 +866,16 @@ func (s *NicService) NewUpdateVmNicIpParams(nicid string) *UpdateVmNicIpParams {
 
 // Update the default Ip of a VM Nic
 func (s *NicService) UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error) {
-	resp, err := s.cs.newRequest("updateVmNicIp", p.toURLValues())
+	return s.UpdateVmNicIpWithContext(context.Background(), p)
+}
+
+// UpdateVmNicIpWithContext is the same as UpdateVmNicIp, but uses ctx to cancel the request and any async job polling
+func (s *NicService) UpdateVmNicIpWithContext(ctx context.Context, p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVmNicIp", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -386,7 +887,7 @@ func (s *NicService) UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -407,6 +908,40 @@ func (s *NicService) UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpRespon
 	return &r, nil
 }
 
+// UpdateVmNicIpAsync starts the async job for UpdateVmNicIp and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateVmNicIpResponse using Job.Result
+func (s *NicService) UpdateVmNicIpAsync(p *UpdateVmNicIpParams) (*Job, error) {
+	return s.UpdateVmNicIpAsyncWithContext(context.Background(), p)
+}
+
+// UpdateVmNicIpAsyncWithContext is the same as UpdateVmNicIpAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NicService) UpdateVmNicIpAsyncWithContext(ctx context.Context, p *UpdateVmNicIpParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVmNicIp", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVmNicIpResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateVmNicIpResponse struct {
 	Account               string                               `json:"account"`
 	Affinitygroup         []UpdateVmNicIpResponseAffinitygroup `json:"affinitygroup"`
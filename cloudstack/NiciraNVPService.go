@@ -20,16 +20,41 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// NiciraNVPServiceIface is the interface implemented by NiciraNVPService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type NiciraNVPServiceIface interface {
+	NewAddNiciraNvpDeviceParams(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string) *AddNiciraNvpDeviceParams
+	AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error)
+	AddNiciraNvpDeviceWithContext(ctx context.Context, p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error)
+	AddNiciraNvpDeviceAsync(p *AddNiciraNvpDeviceParams) (*Job, error)
+	AddNiciraNvpDeviceAsyncWithContext(ctx context.Context, p *AddNiciraNvpDeviceParams) (*Job, error)
+	NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *DeleteNiciraNvpDeviceParams
+	DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error)
+	DeleteNiciraNvpDeviceWithContext(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error)
+	DeleteNiciraNvpDeviceAsync(p *DeleteNiciraNvpDeviceParams) (*Job, error)
+	DeleteNiciraNvpDeviceAsyncWithContext(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*Job, error)
+	NewListNiciraNvpDevicesParams() *ListNiciraNvpDevicesParams
+	ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error)
+	ListNiciraNvpDevicesWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error)
+	NewListNiciraNvpDevicesPager(p *ListNiciraNvpDevicesParams) *ListNiciraNvpDevicesPager
+	ListNiciraNvpDevicesAll(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error)
+	ListNiciraNvpDevicesAllWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error)
+}
+
 type AddNiciraNvpDeviceParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddNiciraNvpDeviceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddNiciraNvpDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -58,6 +83,54 @@ func (p *AddNiciraNvpDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddNiciraNvpDeviceParams) Validate() error {
+	v := newParamValidator("addNiciraNvpDevice", p.p)
+	v.required("hostname", "password", "physicalnetworkid", "transportzoneuuid", "username")
+	v.ids("physicalnetworkid")
+	v.maxLength("hostname", 255)
+	v.maxLength("l2gatewayserviceuuid", 255)
+	v.maxLength("l3gatewayserviceuuid", 255)
+	v.maxLength("password", 255)
+	v.maxLength("transportzoneuuid", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddNiciraNvpDeviceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddNiciraNvpDeviceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostname", "l2gatewayserviceuuid", "l3gatewayserviceuuid", "password", "physicalnetworkid", "transportzoneuuid", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addNiciraNvpDevice", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addNiciraNvpDevice: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddNiciraNvpDeviceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -65,6 +138,15 @@ func (p *AddNiciraNvpDeviceParams) SetHostname(v string) {
 	p.p["hostname"] = v
 }
 
+func (p *AddNiciraNvpDeviceParams) ResetHostname() {
+	delete(p.p, "hostname")
+}
+
+func (p *AddNiciraNvpDeviceParams) GetHostname() (string, bool) {
+	value, ok := p.p["hostname"].(string)
+	return value, ok
+}
+
 func (p *AddNiciraNvpDeviceParams) SetL2gatewayserviceuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -72,6 +154,15 @@ func (p *AddNiciraNvpDeviceParams) SetL2gatewayserviceuuid(v string) {
 	p.p["l2gatewayserviceuuid"] = v
 }
 
+func (p *AddNiciraNvpDeviceParams) ResetL2gatewayserviceuuid() {
+	delete(p.p, "l2gatewayserviceuuid")
+}
+
+func (p *AddNiciraNvpDeviceParams) GetL2gatewayserviceuuid() (string, bool) {
+	value, ok := p.p["l2gatewayserviceuuid"].(string)
+	return value, ok
+}
+
 func (p *AddNiciraNvpDeviceParams) SetL3gatewayserviceuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +170,15 @@ func (p *AddNiciraNvpDeviceParams) SetL3gatewayserviceuuid(v string) {
 	p.p["l3gatewayserviceuuid"] = v
 }
 
+func (p *AddNiciraNvpDeviceParams) ResetL3gatewayserviceuuid() {
+	delete(p.p, "l3gatewayserviceuuid")
+}
+
+func (p *AddNiciraNvpDeviceParams) GetL3gatewayserviceuuid() (string, bool) {
+	value, ok := p.p["l3gatewayserviceuuid"].(string)
+	return value, ok
+}
+
 func (p *AddNiciraNvpDeviceParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -86,6 +186,15 @@ func (p *AddNiciraNvpDeviceParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *AddNiciraNvpDeviceParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddNiciraNvpDeviceParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *AddNiciraNvpDeviceParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -93,6 +202,15 @@ func (p *AddNiciraNvpDeviceParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *AddNiciraNvpDeviceParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddNiciraNvpDeviceParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *AddNiciraNvpDeviceParams) SetTransportzoneuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -100,6 +218,15 @@ func (p *AddNiciraNvpDeviceParams) SetTransportzoneuuid(v string) {
 	p.p["transportzoneuuid"] = v
 }
 
+func (p *AddNiciraNvpDeviceParams) ResetTransportzoneuuid() {
+	delete(p.p, "transportzoneuuid")
+}
+
+func (p *AddNiciraNvpDeviceParams) GetTransportzoneuuid() (string, bool) {
+	value, ok := p.p["transportzoneuuid"].(string)
+	return value, ok
+}
+
 func (p *AddNiciraNvpDeviceParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -107,6 +234,15 @@ func (p *AddNiciraNvpDeviceParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddNiciraNvpDeviceParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddNiciraNvpDeviceParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddNiciraNvpDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *NiciraNVPService) NewAddNiciraNvpDeviceParams(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string) *AddNiciraNvpDeviceParams {
@@ -122,7 +258,16 @@ func (s *NiciraNVPService) NewAddNiciraNvpDeviceParams(hostname string, password
 
 // Adds a Nicira NVP device
 func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addNiciraNvpDevice", p.toURLValues())
+	return s.AddNiciraNvpDeviceWithContext(context.Background(), p)
+}
+
+// AddNiciraNvpDeviceWithContext is the same as AddNiciraNvpDevice, but uses ctx to cancel the request and any async job polling
+func (s *NiciraNVPService) AddNiciraNvpDeviceWithContext(ctx context.Context, p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addNiciraNvpDevice", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +279,7 @@ func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*Add
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -155,6 +300,40 @@ func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*Add
 	return &r, nil
 }
 
+// AddNiciraNvpDeviceAsync starts the async job for AddNiciraNvpDevice and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddNiciraNvpDeviceResponse using Job.Result
+func (s *NiciraNVPService) AddNiciraNvpDeviceAsync(p *AddNiciraNvpDeviceParams) (*Job, error) {
+	return s.AddNiciraNvpDeviceAsyncWithContext(context.Background(), p)
+}
+
+// AddNiciraNvpDeviceAsyncWithContext is the same as AddNiciraNvpDeviceAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NiciraNVPService) AddNiciraNvpDeviceAsyncWithContext(ctx context.Context, p *AddNiciraNvpDeviceParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addNiciraNvpDevice", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddNiciraNvpDeviceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AddNiciraNvpDeviceResponse struct {
 	Hostname             string `json:"hostname"`
 	JobID                string `json:"jobid"`
@@ -172,7 +351,8 @@ type DeleteNiciraNvpDeviceParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteNiciraNvpDeviceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteNiciraNvpDeviceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -183,6 +363,48 @@ func (p *DeleteNiciraNvpDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteNiciraNvpDeviceParams) Validate() error {
+	v := newParamValidator("deleteNiciraNvpDevice", p.p)
+	v.required("nvpdeviceid")
+	v.ids("nvpdeviceid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteNiciraNvpDeviceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteNiciraNvpDeviceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "nvpdeviceid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteNiciraNvpDevice", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteNiciraNvpDevice: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteNiciraNvpDeviceParams) SetNvpdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -190,6 +412,15 @@ func (p *DeleteNiciraNvpDeviceParams) SetNvpdeviceid(v string) {
 	p.p["nvpdeviceid"] = v
 }
 
+func (p *DeleteNiciraNvpDeviceParams) ResetNvpdeviceid() {
+	delete(p.p, "nvpdeviceid")
+}
+
+func (p *DeleteNiciraNvpDeviceParams) GetNvpdeviceid() (string, bool) {
+	value, ok := p.p["nvpdeviceid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteNiciraNvpDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *NiciraNVPService) NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *DeleteNiciraNvpDeviceParams {
@@ -199,9 +430,18 @@ func (s *NiciraNVPService) NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *D
 	return p
 }
 
-//  delete a nicira nvp device
+// delete a nicira nvp device
 func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteNiciraNvpDevice", p.toURLValues())
+	return s.DeleteNiciraNvpDeviceWithContext(context.Background(), p)
+}
+
+// DeleteNiciraNvpDeviceWithContext is the same as DeleteNiciraNvpDevice, but uses ctx to cancel the request and any async job polling
+func (s *NiciraNVPService) DeleteNiciraNvpDeviceWithContext(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNiciraNvpDevice", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -213,7 +453,7 @@ func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -229,6 +469,31 @@ func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams)
 	return &r, nil
 }
 
+// DeleteNiciraNvpDeviceAsync starts the async job for DeleteNiciraNvpDevice and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteNiciraNvpDeviceResponse using Job.Result
+func (s *NiciraNVPService) DeleteNiciraNvpDeviceAsync(p *DeleteNiciraNvpDeviceParams) (*Job, error) {
+	return s.DeleteNiciraNvpDeviceAsyncWithContext(context.Background(), p)
+}
+
+// DeleteNiciraNvpDeviceAsyncWithContext is the same as DeleteNiciraNvpDeviceAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *NiciraNVPService) DeleteNiciraNvpDeviceAsyncWithContext(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteNiciraNvpDevice", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteNiciraNvpDeviceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteNiciraNvpDeviceResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -240,7 +505,8 @@ type ListNiciraNvpDevicesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListNiciraNvpDevicesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListNiciraNvpDevicesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -265,6 +531,52 @@ func (p *ListNiciraNvpDevicesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListNiciraNvpDevicesParams) Validate() error {
+	v := newParamValidator("listNiciraNvpDevices", p.p)
+	v.ids("nvpdeviceid", "physicalnetworkid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListNiciraNvpDevicesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListNiciraNvpDevicesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "nvpdeviceid", "physicalnetworkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listNiciraNvpDevices", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listNiciraNvpDevices: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListNiciraNvpDevicesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -272,6 +584,15 @@ func (p *ListNiciraNvpDevicesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListNiciraNvpDevicesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListNiciraNvpDevicesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListNiciraNvpDevicesParams) SetNvpdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -279,6 +600,15 @@ func (p *ListNiciraNvpDevicesParams) SetNvpdeviceid(v string) {
 	p.p["nvpdeviceid"] = v
 }
 
+func (p *ListNiciraNvpDevicesParams) ResetNvpdeviceid() {
+	delete(p.p, "nvpdeviceid")
+}
+
+func (p *ListNiciraNvpDevicesParams) GetNvpdeviceid() (string, bool) {
+	value, ok := p.p["nvpdeviceid"].(string)
+	return value, ok
+}
+
 func (p *ListNiciraNvpDevicesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -286,6 +616,15 @@ func (p *ListNiciraNvpDevicesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListNiciraNvpDevicesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListNiciraNvpDevicesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListNiciraNvpDevicesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -293,6 +632,15 @@ func (p *ListNiciraNvpDevicesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListNiciraNvpDevicesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListNiciraNvpDevicesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListNiciraNvpDevicesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -300,6 +648,15 @@ func (p *ListNiciraNvpDevicesParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *ListNiciraNvpDevicesParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListNiciraNvpDevicesParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListNiciraNvpDevicesParams instance,
 // as then you are sure you have configured all required params
 func (s *NiciraNVPService) NewListNiciraNvpDevicesParams() *ListNiciraNvpDevicesParams {
@@ -310,7 +667,16 @@ func (s *NiciraNVPService) NewListNiciraNvpDevicesParams() *ListNiciraNvpDevices
 
 // Lists Nicira NVP devices
 func (s *NiciraNVPService) ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listNiciraNvpDevices", p.toURLValues())
+	return s.ListNiciraNvpDevicesWithContext(context.Background(), p)
+}
+
+// ListNiciraNvpDevicesWithContext is the same as ListNiciraNvpDevices, but uses ctx to cancel the request and any async job polling
+func (s *NiciraNVPService) ListNiciraNvpDevicesWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listNiciraNvpDevices", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -323,6 +689,92 @@ func (s *NiciraNVPService) ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (
 	return &r, nil
 }
 
+// ListNiciraNvpDevicesPager iterates over all pages of a ListNiciraNvpDevices call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListNiciraNvpDevicesPager struct {
+	pager
+
+	s *NiciraNVPService
+	p *ListNiciraNvpDevicesParams
+	r *ListNiciraNvpDevicesResponse
+}
+
+// NewListNiciraNvpDevicesPager returns a pager for all pages of a ListNiciraNvpDevices call with the given params
+func (s *NiciraNVPService) NewListNiciraNvpDevicesPager(p *ListNiciraNvpDevicesParams) *ListNiciraNvpDevicesPager {
+	return &ListNiciraNvpDevicesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListNiciraNvpDevicesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListNiciraNvpDevicesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListNiciraNvpDevicesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListNiciraNvpDevicesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.NiciraNvpDevices), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListNiciraNvpDevicesPager) Page() *ListNiciraNvpDevicesResponse {
+	return pg.r
+}
+
+// ListNiciraNvpDevicesAll fetches all pages of a ListNiciraNvpDevices call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *NiciraNVPService) ListNiciraNvpDevicesAll(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
+	return s.ListNiciraNvpDevicesAllWithContext(context.Background(), p)
+}
+
+// ListNiciraNvpDevicesAllWithContext is the same as ListNiciraNvpDevicesAll, but uses ctx to cancel the requests
+func (s *NiciraNVPService) ListNiciraNvpDevicesAllWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*NiciraNvpDevice)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListNiciraNvpDevicesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListNiciraNvpDevicesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.NiciraNvpDevices
+		mu.Unlock()
+
+		return len(l.NiciraNvpDevices), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListNiciraNvpDevicesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.NiciraNvpDevices = append(r.NiciraNvpDevices, pages[page]...)
+	}
+	r.Count = len(r.NiciraNvpDevices)
+
+	return r, nil
+}
+
 type ListNiciraNvpDevicesResponse struct {
 	Count            int                `json:"count"`
 	NiciraNvpDevices []*NiciraNvpDevice `json:"niciranvpdevice"`
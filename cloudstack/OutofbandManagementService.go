@@ -20,16 +20,37 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
+// OutofbandManagementServiceIface is the interface implemented by OutofbandManagementService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type OutofbandManagementServiceIface interface {
+	NewChangeOutOfBandManagementPasswordParams(hostid string) *ChangeOutOfBandManagementPasswordParams
+	ChangeOutOfBandManagementPassword(p *ChangeOutOfBandManagementPasswordParams) (*ChangeOutOfBandManagementPasswordResponse, error)
+	ChangeOutOfBandManagementPasswordWithContext(ctx context.Context, p *ChangeOutOfBandManagementPasswordParams) (*ChangeOutOfBandManagementPasswordResponse, error)
+	ChangeOutOfBandManagementPasswordAsync(p *ChangeOutOfBandManagementPasswordParams) (*Job, error)
+	ChangeOutOfBandManagementPasswordAsyncWithContext(ctx context.Context, p *ChangeOutOfBandManagementPasswordParams) (*Job, error)
+	NewConfigureOutOfBandManagementParams(address string, driver string, hostid string, password string, port string, username string) *ConfigureOutOfBandManagementParams
+	ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error)
+	ConfigureOutOfBandManagementWithContext(ctx context.Context, p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error)
+	NewIssueOutOfBandManagementPowerActionParams(action string, hostid string) *IssueOutOfBandManagementPowerActionParams
+	IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error)
+	IssueOutOfBandManagementPowerActionWithContext(ctx context.Context, p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error)
+	IssueOutOfBandManagementPowerActionAsync(p *IssueOutOfBandManagementPowerActionParams) (*Job, error)
+	IssueOutOfBandManagementPowerActionAsyncWithContext(ctx context.Context, p *IssueOutOfBandManagementPowerActionParams) (*Job, error)
+}
+
 type ChangeOutOfBandManagementPasswordParams struct {
 	p map[string]interface{}
 }
 
-func (p *ChangeOutOfBandManagementPasswordParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ChangeOutOfBandManagementPasswordParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -43,6 +64,49 @@ func (p *ChangeOutOfBandManagementPasswordParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ChangeOutOfBandManagementPasswordParams) Validate() error {
+	v := newParamValidator("changeOutOfBandManagementPassword", p.p)
+	v.required("hostid")
+	v.ids("hostid")
+	v.maxLength("password", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ChangeOutOfBandManagementPasswordParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ChangeOutOfBandManagementPasswordParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid", "password":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for changeOutOfBandManagementPassword", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of changeOutOfBandManagementPassword: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ChangeOutOfBandManagementPasswordParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -50,6 +114,15 @@ func (p *ChangeOutOfBandManagementPasswordParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *ChangeOutOfBandManagementPasswordParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ChangeOutOfBandManagementPasswordParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 func (p *ChangeOutOfBandManagementPasswordParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -57,6 +130,15 @@ func (p *ChangeOutOfBandManagementPasswordParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *ChangeOutOfBandManagementPasswordParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *ChangeOutOfBandManagementPasswordParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ChangeOutOfBandManagementPasswordParams instance,
 // as then you are sure you have configured all required params
 func (s *OutofbandManagementService) NewChangeOutOfBandManagementPasswordParams(hostid string) *ChangeOutOfBandManagementPasswordParams {
@@ -68,7 +150,16 @@ func (s *OutofbandManagementService) NewChangeOutOfBandManagementPasswordParams(
 
 // Changes out-of-band management interface password on the host and updates the interface configuration in CloudStack if the operation succeeds, else reverts the old password
 func (s *OutofbandManagementService) ChangeOutOfBandManagementPassword(p *ChangeOutOfBandManagementPasswordParams) (*ChangeOutOfBandManagementPasswordResponse, error) {
-	resp, err := s.cs.newRequest("changeOutOfBandManagementPassword", p.toURLValues())
+	return s.ChangeOutOfBandManagementPasswordWithContext(context.Background(), p)
+}
+
+// ChangeOutOfBandManagementPasswordWithContext is the same as ChangeOutOfBandManagementPassword, but uses ctx to cancel the request and any async job polling
+func (s *OutofbandManagementService) ChangeOutOfBandManagementPasswordWithContext(ctx context.Context, p *ChangeOutOfBandManagementPasswordParams) (*ChangeOutOfBandManagementPasswordResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "changeOutOfBandManagementPassword", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +171,7 @@ func (s *OutofbandManagementService) ChangeOutOfBandManagementPassword(p *Change
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -101,6 +192,40 @@ func (s *OutofbandManagementService) ChangeOutOfBandManagementPassword(p *Change
 	return &r, nil
 }
 
+// ChangeOutOfBandManagementPasswordAsync starts the async job for ChangeOutOfBandManagementPassword and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ChangeOutOfBandManagementPasswordResponse using Job.Result
+func (s *OutofbandManagementService) ChangeOutOfBandManagementPasswordAsync(p *ChangeOutOfBandManagementPasswordParams) (*Job, error) {
+	return s.ChangeOutOfBandManagementPasswordAsyncWithContext(context.Background(), p)
+}
+
+// ChangeOutOfBandManagementPasswordAsyncWithContext is the same as ChangeOutOfBandManagementPasswordAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *OutofbandManagementService) ChangeOutOfBandManagementPasswordAsyncWithContext(ctx context.Context, p *ChangeOutOfBandManagementPasswordParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "changeOutOfBandManagementPassword", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ChangeOutOfBandManagementPasswordResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type ChangeOutOfBandManagementPasswordResponse struct {
 	Action      string `json:"action"`
 	Address     string `json:"address"`
@@ -121,7 +246,8 @@ type ConfigureOutOfBandManagementParams struct {
 	p map[string]interface{}
 }
 
-func (p *ConfigureOutOfBandManagementParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ConfigureOutOfBandManagementParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -147,6 +273,53 @@ func (p *ConfigureOutOfBandManagementParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ConfigureOutOfBandManagementParams) Validate() error {
+	v := newParamValidator("configureOutOfBandManagement", p.p)
+	v.required("address", "driver", "hostid", "password", "port", "username")
+	v.ids("hostid")
+	v.maxLength("address", 255)
+	v.maxLength("driver", 255)
+	v.maxLength("password", 255)
+	v.maxLength("port", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ConfigureOutOfBandManagementParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ConfigureOutOfBandManagementParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "address", "driver", "hostid", "password", "port", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for configureOutOfBandManagement", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of configureOutOfBandManagement: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ConfigureOutOfBandManagementParams) SetAddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -154,6 +327,15 @@ func (p *ConfigureOutOfBandManagementParams) SetAddress(v string) {
 	p.p["address"] = v
 }
 
+func (p *ConfigureOutOfBandManagementParams) ResetAddress() {
+	delete(p.p, "address")
+}
+
+func (p *ConfigureOutOfBandManagementParams) GetAddress() (string, bool) {
+	value, ok := p.p["address"].(string)
+	return value, ok
+}
+
 func (p *ConfigureOutOfBandManagementParams) SetDriver(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -161,6 +343,15 @@ func (p *ConfigureOutOfBandManagementParams) SetDriver(v string) {
 	p.p["driver"] = v
 }
 
+func (p *ConfigureOutOfBandManagementParams) ResetDriver() {
+	delete(p.p, "driver")
+}
+
+func (p *ConfigureOutOfBandManagementParams) GetDriver() (string, bool) {
+	value, ok := p.p["driver"].(string)
+	return value, ok
+}
+
 func (p *ConfigureOutOfBandManagementParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -168,6 +359,15 @@ func (p *ConfigureOutOfBandManagementParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *ConfigureOutOfBandManagementParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ConfigureOutOfBandManagementParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 func (p *ConfigureOutOfBandManagementParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -175,6 +375,15 @@ func (p *ConfigureOutOfBandManagementParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *ConfigureOutOfBandManagementParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *ConfigureOutOfBandManagementParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *ConfigureOutOfBandManagementParams) SetPort(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -182,6 +391,15 @@ func (p *ConfigureOutOfBandManagementParams) SetPort(v string) {
 	p.p["port"] = v
 }
 
+func (p *ConfigureOutOfBandManagementParams) ResetPort() {
+	delete(p.p, "port")
+}
+
+func (p *ConfigureOutOfBandManagementParams) GetPort() (string, bool) {
+	value, ok := p.p["port"].(string)
+	return value, ok
+}
+
 func (p *ConfigureOutOfBandManagementParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -189,6 +407,15 @@ func (p *ConfigureOutOfBandManagementParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *ConfigureOutOfBandManagementParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *ConfigureOutOfBandManagementParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ConfigureOutOfBandManagementParams instance,
 // as then you are sure you have configured all required params
 func (s *OutofbandManagementService) NewConfigureOutOfBandManagementParams(address string, driver string, hostid string, password string, port string, username string) *ConfigureOutOfBandManagementParams {
@@ -205,7 +432,16 @@ func (s *OutofbandManagementService) NewConfigureOutOfBandManagementParams(addre
 
 // Configures a host's out-of-band management interface
 func (s *OutofbandManagementService) ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error) {
-	resp, err := s.cs.newRequest("configureOutOfBandManagement", p.toURLValues())
+	return s.ConfigureOutOfBandManagementWithContext(context.Background(), p)
+}
+
+// ConfigureOutOfBandManagementWithContext is the same as ConfigureOutOfBandManagement, but uses ctx to cancel the request and any async job polling
+func (s *OutofbandManagementService) ConfigureOutOfBandManagementWithContext(ctx context.Context, p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureOutOfBandManagement", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -238,7 +474,8 @@ type IssueOutOfBandManagementPowerActionParams struct {
 	p map[string]interface{}
 }
 
-func (p *IssueOutOfBandManagementPowerActionParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *IssueOutOfBandManagementPowerActionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -256,6 +493,53 @@ func (p *IssueOutOfBandManagementPowerActionParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *IssueOutOfBandManagementPowerActionParams) Validate() error {
+	v := newParamValidator("issueOutOfBandManagementPowerAction", p.p)
+	v.required("action", "hostid")
+	v.ids("hostid")
+	v.maxLength("action", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *IssueOutOfBandManagementPowerActionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *IssueOutOfBandManagementPowerActionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "action", "hostid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "timeout":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for issueOutOfBandManagementPowerAction", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of issueOutOfBandManagementPowerAction: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *IssueOutOfBandManagementPowerActionParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -263,6 +547,15 @@ func (p *IssueOutOfBandManagementPowerActionParams) SetAction(v string) {
 	p.p["action"] = v
 }
 
+func (p *IssueOutOfBandManagementPowerActionParams) ResetAction() {
+	delete(p.p, "action")
+}
+
+func (p *IssueOutOfBandManagementPowerActionParams) GetAction() (string, bool) {
+	value, ok := p.p["action"].(string)
+	return value, ok
+}
+
 func (p *IssueOutOfBandManagementPowerActionParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -270,6 +563,15 @@ func (p *IssueOutOfBandManagementPowerActionParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *IssueOutOfBandManagementPowerActionParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *IssueOutOfBandManagementPowerActionParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 func (p *IssueOutOfBandManagementPowerActionParams) SetTimeout(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -277,6 +579,15 @@ func (p *IssueOutOfBandManagementPowerActionParams) SetTimeout(v int64) {
 	p.p["timeout"] = v
 }
 
+func (p *IssueOutOfBandManagementPowerActionParams) ResetTimeout() {
+	delete(p.p, "timeout")
+}
+
+func (p *IssueOutOfBandManagementPowerActionParams) GetTimeout() (int64, bool) {
+	value, ok := p.p["timeout"].(int64)
+	return value, ok
+}
+
 // You should always use this function to get a new IssueOutOfBandManagementPowerActionParams instance,
 // as then you are sure you have configured all required params
 func (s *OutofbandManagementService) NewIssueOutOfBandManagementPowerActionParams(action string, hostid string) *IssueOutOfBandManagementPowerActionParams {
@@ -289,7 +600,16 @@ func (s *OutofbandManagementService) NewIssueOutOfBandManagementPowerActionParam
 
 // Initiates the specified power action to the host's out-of-band management interface
 func (s *OutofbandManagementService) IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error) {
-	resp, err := s.cs.newRequest("issueOutOfBandManagementPowerAction", p.toURLValues())
+	return s.IssueOutOfBandManagementPowerActionWithContext(context.Background(), p)
+}
+
+// IssueOutOfBandManagementPowerActionWithContext is the same as IssueOutOfBandManagementPowerAction, but uses ctx to cancel the request and any async job polling
+func (s *OutofbandManagementService) IssueOutOfBandManagementPowerActionWithContext(ctx context.Context, p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "issueOutOfBandManagementPowerAction", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -301,7 +621,7 @@ func (s *OutofbandManagementService) IssueOutOfBandManagementPowerAction(p *Issu
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -322,6 +642,40 @@ func (s *OutofbandManagementService) IssueOutOfBandManagementPowerAction(p *Issu
 	return &r, nil
 }
 
+// IssueOutOfBandManagementPowerActionAsync starts the async job for IssueOutOfBandManagementPowerAction and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a IssueOutOfBandManagementPowerActionResponse using Job.Result
+func (s *OutofbandManagementService) IssueOutOfBandManagementPowerActionAsync(p *IssueOutOfBandManagementPowerActionParams) (*Job, error) {
+	return s.IssueOutOfBandManagementPowerActionAsyncWithContext(context.Background(), p)
+}
+
+// IssueOutOfBandManagementPowerActionAsyncWithContext is the same as IssueOutOfBandManagementPowerActionAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *OutofbandManagementService) IssueOutOfBandManagementPowerActionAsyncWithContext(ctx context.Context, p *IssueOutOfBandManagementPowerActionParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "issueOutOfBandManagementPowerAction", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r IssueOutOfBandManagementPowerActionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type IssueOutOfBandManagementPowerActionResponse struct {
 	Action      string `json:"action"`
 	Address     string `json:"address"`
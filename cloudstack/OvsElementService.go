@@ -20,18 +20,38 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// OvsElementServiceIface is the interface implemented by OvsElementService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type OvsElementServiceIface interface {
+	NewConfigureOvsElementParams(enabled bool, id string) *ConfigureOvsElementParams
+	ConfigureOvsElement(p *ConfigureOvsElementParams) (*OvsElementResponse, error)
+	ConfigureOvsElementWithContext(ctx context.Context, p *ConfigureOvsElementParams) (*OvsElementResponse, error)
+	ConfigureOvsElementAsync(p *ConfigureOvsElementParams) (*Job, error)
+	ConfigureOvsElementAsyncWithContext(ctx context.Context, p *ConfigureOvsElementParams) (*Job, error)
+	NewListOvsElementsParams() *ListOvsElementsParams
+	GetOvsElementByID(id string, opts ...OptionFunc) (*OvsElement, int, error)
+	GetOvsElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OvsElement, int, error)
+	ListOvsElements(p *ListOvsElementsParams) (*ListOvsElementsResponse, error)
+	ListOvsElementsWithContext(ctx context.Context, p *ListOvsElementsParams) (*ListOvsElementsResponse, error)
+	NewListOvsElementsPager(p *ListOvsElementsParams) *ListOvsElementsPager
+	ListOvsElementsAll(p *ListOvsElementsParams) (*ListOvsElementsResponse, error)
+	ListOvsElementsAllWithContext(ctx context.Context, p *ListOvsElementsParams) (*ListOvsElementsResponse, error)
+}
+
 type ConfigureOvsElementParams struct {
 	p map[string]interface{}
 }
 
-func (p *ConfigureOvsElementParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ConfigureOvsElementParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -46,6 +66,52 @@ func (p *ConfigureOvsElementParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ConfigureOvsElementParams) Validate() error {
+	v := newParamValidator("configureOvsElement", p.p)
+	v.required("enabled", "id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ConfigureOvsElementParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ConfigureOvsElementParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "enabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for configureOvsElement", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of configureOvsElement: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ConfigureOvsElementParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -53,6 +119,15 @@ func (p *ConfigureOvsElementParams) SetEnabled(v bool) {
 	p.p["enabled"] = v
 }
 
+func (p *ConfigureOvsElementParams) ResetEnabled() {
+	delete(p.p, "enabled")
+}
+
+func (p *ConfigureOvsElementParams) GetEnabled() (bool, bool) {
+	value, ok := p.p["enabled"].(bool)
+	return value, ok
+}
+
 func (p *ConfigureOvsElementParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -60,6 +135,15 @@ func (p *ConfigureOvsElementParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ConfigureOvsElementParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ConfigureOvsElementParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ConfigureOvsElementParams instance,
 // as then you are sure you have configured all required params
 func (s *OvsElementService) NewConfigureOvsElementParams(enabled bool, id string) *ConfigureOvsElementParams {
@@ -72,7 +156,16 @@ func (s *OvsElementService) NewConfigureOvsElementParams(enabled bool, id string
 
 // Configures an ovs element.
 func (s *OvsElementService) ConfigureOvsElement(p *ConfigureOvsElementParams) (*OvsElementResponse, error) {
-	resp, err := s.cs.newRequest("configureOvsElement", p.toURLValues())
+	return s.ConfigureOvsElementWithContext(context.Background(), p)
+}
+
+// ConfigureOvsElementWithContext is the same as ConfigureOvsElement, but uses ctx to cancel the request and any async job polling
+func (s *OvsElementService) ConfigureOvsElementWithContext(ctx context.Context, p *ConfigureOvsElementParams) (*OvsElementResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureOvsElement", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +177,7 @@ func (s *OvsElementService) ConfigureOvsElement(p *ConfigureOvsElementParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -105,6 +198,40 @@ func (s *OvsElementService) ConfigureOvsElement(p *ConfigureOvsElementParams) (*
 	return &r, nil
 }
 
+// ConfigureOvsElementAsync starts the async job for ConfigureOvsElement and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a OvsElementResponse using Job.Result
+func (s *OvsElementService) ConfigureOvsElementAsync(p *ConfigureOvsElementParams) (*Job, error) {
+	return s.ConfigureOvsElementAsyncWithContext(context.Background(), p)
+}
+
+// ConfigureOvsElementAsyncWithContext is the same as ConfigureOvsElementAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *OvsElementService) ConfigureOvsElementAsyncWithContext(ctx context.Context, p *ConfigureOvsElementParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureOvsElement", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r OvsElementResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type OvsElementResponse struct {
 	Account   string `json:"account"`
 	Domain    string `json:"domain"`
@@ -122,7 +249,8 @@ type ListOvsElementsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListOvsElementsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListOvsElementsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -151,6 +279,56 @@ func (p *ListOvsElementsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListOvsElementsParams) Validate() error {
+	v := newParamValidator("listOvsElements", p.p)
+	v.ids("id", "nspid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListOvsElementsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListOvsElementsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "enabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "keyword", "nspid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listOvsElements", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listOvsElements: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListOvsElementsParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -158,6 +336,15 @@ func (p *ListOvsElementsParams) SetEnabled(v bool) {
 	p.p["enabled"] = v
 }
 
+func (p *ListOvsElementsParams) ResetEnabled() {
+	delete(p.p, "enabled")
+}
+
+func (p *ListOvsElementsParams) GetEnabled() (bool, bool) {
+	value, ok := p.p["enabled"].(bool)
+	return value, ok
+}
+
 func (p *ListOvsElementsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -165,6 +352,15 @@ func (p *ListOvsElementsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListOvsElementsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListOvsElementsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListOvsElementsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -172,6 +368,15 @@ func (p *ListOvsElementsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListOvsElementsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListOvsElementsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListOvsElementsParams) SetNspid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -179,6 +384,15 @@ func (p *ListOvsElementsParams) SetNspid(v string) {
 	p.p["nspid"] = v
 }
 
+func (p *ListOvsElementsParams) ResetNspid() {
+	delete(p.p, "nspid")
+}
+
+func (p *ListOvsElementsParams) GetNspid() (string, bool) {
+	value, ok := p.p["nspid"].(string)
+	return value, ok
+}
+
 func (p *ListOvsElementsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -186,6 +400,15 @@ func (p *ListOvsElementsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListOvsElementsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListOvsElementsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListOvsElementsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,6 +416,15 @@ func (p *ListOvsElementsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListOvsElementsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListOvsElementsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListOvsElementsParams instance,
 // as then you are sure you have configured all required params
 func (s *OvsElementService) NewListOvsElementsParams() *ListOvsElementsParams {
@@ -203,6 +435,11 @@ func (s *OvsElementService) NewListOvsElementsParams() *ListOvsElementsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *OvsElementService) GetOvsElementByID(id string, opts ...OptionFunc) (*OvsElement, int, error) {
+	return s.GetOvsElementByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetOvsElementByIDWithContext is the same as GetOvsElementByID, but uses ctx to cancel the request
+func (s *OvsElementService) GetOvsElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OvsElement, int, error) {
 	p := &ListOvsElementsParams{}
 	p.p = make(map[string]interface{})
 
@@ -214,18 +451,16 @@ func (s *OvsElementService) GetOvsElementByID(id string, opts ...OptionFunc) (*O
 		}
 	}
 
-	l, err := s.ListOvsElements(p)
+	l, err := s.ListOvsElementsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -236,7 +471,16 @@ func (s *OvsElementService) GetOvsElementByID(id string, opts ...OptionFunc) (*O
 
 // Lists all available ovs elements.
 func (s *OvsElementService) ListOvsElements(p *ListOvsElementsParams) (*ListOvsElementsResponse, error) {
-	resp, err := s.cs.newRequest("listOvsElements", p.toURLValues())
+	return s.ListOvsElementsWithContext(context.Background(), p)
+}
+
+// ListOvsElementsWithContext is the same as ListOvsElements, but uses ctx to cancel the request and any async job polling
+func (s *OvsElementService) ListOvsElementsWithContext(ctx context.Context, p *ListOvsElementsParams) (*ListOvsElementsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listOvsElements", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -249,6 +493,92 @@ func (s *OvsElementService) ListOvsElements(p *ListOvsElementsParams) (*ListOvsE
 	return &r, nil
 }
 
+// ListOvsElementsPager iterates over all pages of a ListOvsElements call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListOvsElementsPager struct {
+	pager
+
+	s *OvsElementService
+	p *ListOvsElementsParams
+	r *ListOvsElementsResponse
+}
+
+// NewListOvsElementsPager returns a pager for all pages of a ListOvsElements call with the given params
+func (s *OvsElementService) NewListOvsElementsPager(p *ListOvsElementsParams) *ListOvsElementsPager {
+	return &ListOvsElementsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListOvsElementsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListOvsElementsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListOvsElementsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListOvsElementsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.OvsElements), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListOvsElementsPager) Page() *ListOvsElementsResponse {
+	return pg.r
+}
+
+// ListOvsElementsAll fetches all pages of a ListOvsElements call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *OvsElementService) ListOvsElementsAll(p *ListOvsElementsParams) (*ListOvsElementsResponse, error) {
+	return s.ListOvsElementsAllWithContext(context.Background(), p)
+}
+
+// ListOvsElementsAllWithContext is the same as ListOvsElementsAll, but uses ctx to cancel the requests
+func (s *OvsElementService) ListOvsElementsAllWithContext(ctx context.Context, p *ListOvsElementsParams) (*ListOvsElementsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*OvsElement)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListOvsElementsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListOvsElementsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.OvsElements
+		mu.Unlock()
+
+		return len(l.OvsElements), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListOvsElementsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.OvsElements = append(r.OvsElements, pages[page]...)
+	}
+	r.Count = len(r.OvsElements)
+
+	return r, nil
+}
+
 type ListOvsElementsResponse struct {
 	Count       int           `json:"count"`
 	OvsElements []*OvsElement `json:"ovselement"`
@@ -0,0 +1,187 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultPageSize is the page size used by the pagers and the `List...All` helpers, if no
+// page size is set on the params. It matches the default `default.page.size` of CloudStack.
+const DefaultPageSize = 500
+
+// WithListConcurrency sets the max number of pages the `List...All` helpers fetch concurrently.
+// By default all pages are fetched sequentially.
+func WithListConcurrency(concurrency int) ClientOption {
+	return func(cs *CloudStackClient) {
+		if concurrency > 0 {
+			cs.concurrency = concurrency
+		}
+	}
+}
+
+// pager contains the state shared by all generated `List...Pager` types
+type pager struct {
+	page     int
+	pagesize int
+	done     bool
+	err      error
+}
+
+func newPager(params map[string]interface{}) pager {
+	return pager{pagesize: pageSize(params)}
+}
+
+// Err returns the error that stopped the pager, if any
+func (pg *pager) Err() error {
+	return pg.err
+}
+
+// Returns false if there are no more pages to fetch, and else advances to the next page
+func (pg *pager) next() bool {
+	if pg.done {
+		return false
+	}
+	pg.page++
+	return true
+}
+
+// Updates the state after fetching a page of n items or failing with err
+func (pg *pager) update(n int, err error) bool {
+	if err != nil {
+		pg.err = err
+		pg.done = true
+		return false
+	}
+
+	// A short page means this was the last page
+	if n < pg.pagesize {
+		pg.done = true
+	}
+	return n > 0
+}
+
+// Returns the page size set in params, or the DefaultPageSize
+func pageSize(params map[string]interface{}) int {
+	if v, ok := params["pagesize"].(int); ok && v > 0 {
+		return v
+	}
+	return DefaultPageSize
+}
+
+// Returns a shallow copy of params, so the page can be changed without
+// modifying the params passed in by the caller
+func copyParams(params map[string]interface{}) map[string]interface{} {
+	c := make(map[string]interface{}, len(params))
+	for k, v := range params {
+		c[k] = v
+	}
+	return c
+}
+
+// pageFunc fetches a single page and returns the number of items on
+// the page, together with the total count reported by CloudStack
+type pageFunc func(ctx context.Context, page int) (n int, count int, err error)
+
+// Fetches all pages using fetch. The first page is always fetched on its own to find out how many
+// pages there are, after which the remaining pages are fetched using the given concurrency. Pages
+// are fetched until a short page is returned, so an incorrect count doesn't cause missing items.
+func fetchAllPages(ctx context.Context, concurrency, pagesize int, fetch pageFunc) error {
+	n, count, err := fetch(ctx, 1)
+	if err != nil {
+		return err
+	}
+	if n < pagesize {
+		return nil
+	}
+
+	last := 1
+	if concurrency > 1 {
+		pages := (count + pagesize - 1) / pagesize
+		if pages > 1 {
+			if n, err = fetchPagesConcurrently(ctx, concurrency, 2, pages, fetch); err != nil {
+				return err
+			}
+			if n < pagesize {
+				return nil
+			}
+			last = pages
+		}
+	}
+
+	for page := last + 1; ; page++ {
+		n, _, err := fetch(ctx, page)
+		if err != nil {
+			return err
+		}
+		if n < pagesize {
+			return nil
+		}
+	}
+}
+
+// Fetches the pages first up to and including last, and returns the number of items on the last page
+func fetchPagesConcurrently(ctx context.Context, concurrency, first, last int, fetch pageFunc) (int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var ferr error
+	var lastN int
+
+	sem := make(chan struct{}, concurrency)
+	for page := first; page <= last; page++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(page int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			n, _, err := fetch(ctx, page)
+			if err != nil {
+				once.Do(func() {
+					ferr = err
+					cancel()
+				})
+				return
+			}
+			if page == last {
+				lastN = n
+			}
+		}(page)
+	}
+	wg.Wait()
+
+	if ferr != nil {
+		return 0, ferr
+	}
+	return lastN, ctx.Err()
+}
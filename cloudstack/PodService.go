@@ -20,18 +20,537 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// PodServiceIface is the interface implemented by PodService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type PodServiceIface interface {
+	NewAcquirePodIpAddressParams(zoneid string) *AcquirePodIpAddressParams
+	AcquirePodIpAddress(p *AcquirePodIpAddressParams) (*AcquirePodIpAddressResponse, error)
+	AcquirePodIpAddressWithContext(ctx context.Context, p *AcquirePodIpAddressParams) (*AcquirePodIpAddressResponse, error)
+	NewCreateManagementNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) *CreateManagementNetworkIpRangeParams
+	CreateManagementNetworkIpRange(p *CreateManagementNetworkIpRangeParams) (*CreateManagementNetworkIpRangeResponse, error)
+	CreateManagementNetworkIpRangeWithContext(ctx context.Context, p *CreateManagementNetworkIpRangeParams) (*CreateManagementNetworkIpRangeResponse, error)
+	CreateManagementNetworkIpRangeAsync(p *CreateManagementNetworkIpRangeParams) (*Job, error)
+	CreateManagementNetworkIpRangeAsyncWithContext(ctx context.Context, p *CreateManagementNetworkIpRangeParams) (*Job, error)
+	NewCreatePodParams(gateway string, name string, netmask string, startip string, zoneid string) *CreatePodParams
+	CreatePod(p *CreatePodParams) (*CreatePodResponse, error)
+	CreatePodWithContext(ctx context.Context, p *CreatePodParams) (*CreatePodResponse, error)
+	NewDedicatePodParams(domainid string, podid string) *DedicatePodParams
+	DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error)
+	DedicatePodWithContext(ctx context.Context, p *DedicatePodParams) (*DedicatePodResponse, error)
+	DedicatePodAsync(p *DedicatePodParams) (*Job, error)
+	DedicatePodAsyncWithContext(ctx context.Context, p *DedicatePodParams) (*Job, error)
+	NewDeleteManagementNetworkIpRangeParams(endip string, podid string, startip string, vlan string) *DeleteManagementNetworkIpRangeParams
+	DeleteManagementNetworkIpRange(p *DeleteManagementNetworkIpRangeParams) (*DeleteManagementNetworkIpRangeResponse, error)
+	DeleteManagementNetworkIpRangeWithContext(ctx context.Context, p *DeleteManagementNetworkIpRangeParams) (*DeleteManagementNetworkIpRangeResponse, error)
+	DeleteManagementNetworkIpRangeAsync(p *DeleteManagementNetworkIpRangeParams) (*Job, error)
+	DeleteManagementNetworkIpRangeAsyncWithContext(ctx context.Context, p *DeleteManagementNetworkIpRangeParams) (*Job, error)
+	NewDeletePodParams(id string) *DeletePodParams
+	DeletePod(p *DeletePodParams) (*DeletePodResponse, error)
+	DeletePodWithContext(ctx context.Context, p *DeletePodParams) (*DeletePodResponse, error)
+	NewListDedicatedPodsParams() *ListDedicatedPodsParams
+	ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error)
+	ListDedicatedPodsWithContext(ctx context.Context, p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error)
+	NewListDedicatedPodsPager(p *ListDedicatedPodsParams) *ListDedicatedPodsPager
+	ListDedicatedPodsAll(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error)
+	ListDedicatedPodsAllWithContext(ctx context.Context, p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error)
+	NewListPodsParams() *ListPodsParams
+	GetPodID(name string, opts ...OptionFunc) (string, int, error)
+	GetPodIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetPodByName(name string, opts ...OptionFunc) (*Pod, int, error)
+	GetPodByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Pod, int, error)
+	GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error)
+	GetPodByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Pod, int, error)
+	ListPods(p *ListPodsParams) (*ListPodsResponse, error)
+	ListPodsWithContext(ctx context.Context, p *ListPodsParams) (*ListPodsResponse, error)
+	NewListPodsPager(p *ListPodsParams) *ListPodsPager
+	ListPodsAll(p *ListPodsParams) (*ListPodsResponse, error)
+	ListPodsAllWithContext(ctx context.Context, p *ListPodsParams) (*ListPodsResponse, error)
+	NewReleaseDedicatedPodParams(podid string) *ReleaseDedicatedPodParams
+	ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error)
+	ReleaseDedicatedPodWithContext(ctx context.Context, p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error)
+	ReleaseDedicatedPodAsync(p *ReleaseDedicatedPodParams) (*Job, error)
+	ReleaseDedicatedPodAsyncWithContext(ctx context.Context, p *ReleaseDedicatedPodParams) (*Job, error)
+	NewReleasePodIpAddressParams(id int64) *ReleasePodIpAddressParams
+	ReleasePodIpAddress(p *ReleasePodIpAddressParams) (*ReleasePodIpAddressResponse, error)
+	ReleasePodIpAddressWithContext(ctx context.Context, p *ReleasePodIpAddressParams) (*ReleasePodIpAddressResponse, error)
+	NewUpdatePodParams(id string) *UpdatePodParams
+	UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error)
+	UpdatePodWithContext(ctx context.Context, p *UpdatePodParams) (*UpdatePodResponse, error)
+}
+
+type AcquirePodIpAddressParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AcquirePodIpAddressParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["podid"]; found {
+		u.Set("podid", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AcquirePodIpAddressParams) Validate() error {
+	v := newParamValidator("acquirePodIpAddress", p.p)
+	v.required("zoneid")
+	v.maxLength("podid", 255)
+	v.maxLength("zoneid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AcquirePodIpAddressParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AcquirePodIpAddressParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "podid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for acquirePodIpAddress", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of acquirePodIpAddress: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *AcquirePodIpAddressParams) SetPodid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["podid"] = v
+}
+
+func (p *AcquirePodIpAddressParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *AcquirePodIpAddressParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
+func (p *AcquirePodIpAddressParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *AcquirePodIpAddressParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *AcquirePodIpAddressParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AcquirePodIpAddressParams instance,
+// as then you are sure you have configured all required params
+func (s *PodService) NewAcquirePodIpAddressParams(zoneid string) *AcquirePodIpAddressParams {
+	p := &AcquirePodIpAddressParams{}
+	p.p = make(map[string]interface{})
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// Allocates IP addresses in respective Pod of a Zone
+func (s *PodService) AcquirePodIpAddress(p *AcquirePodIpAddressParams) (*AcquirePodIpAddressResponse, error) {
+	return s.AcquirePodIpAddressWithContext(context.Background(), p)
+}
+
+// AcquirePodIpAddressWithContext is the same as AcquirePodIpAddress, but uses ctx to cancel the request and any async job polling
+func (s *PodService) AcquirePodIpAddressWithContext(ctx context.Context, p *AcquirePodIpAddressParams) (*AcquirePodIpAddressResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "acquirePodIpAddress", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AcquirePodIpAddressResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type AcquirePodIpAddressResponse struct {
+	Cidr      string `json:"cidr"`
+	Gateway   string `json:"gateway"`
+	Hostmac   int64  `json:"hostmac"`
+	Id        int64  `json:"id"`
+	Ipaddress string `json:"ipaddress"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Nicid     int64  `json:"nicid"`
+	Podid     int64  `json:"podid"`
+}
+
+type CreateManagementNetworkIpRangeParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateManagementNetworkIpRangeParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["endip"]; found {
+		u.Set("endip", v.(string))
+	}
+	if v, found := p.p["forsystemvms"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("forsystemvms", vv)
+	}
+	if v, found := p.p["gateway"]; found {
+		u.Set("gateway", v.(string))
+	}
+	if v, found := p.p["netmask"]; found {
+		u.Set("netmask", v.(string))
+	}
+	if v, found := p.p["podid"]; found {
+		u.Set("podid", v.(string))
+	}
+	if v, found := p.p["startip"]; found {
+		u.Set("startip", v.(string))
+	}
+	if v, found := p.p["vlan"]; found {
+		u.Set("vlan", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateManagementNetworkIpRangeParams) Validate() error {
+	v := newParamValidator("createManagementNetworkIpRange", p.p)
+	v.required("gateway", "netmask", "podid", "startip")
+	v.ids("podid")
+	v.maxLength("endip", 255)
+	v.maxLength("gateway", 255)
+	v.maxLength("netmask", 255)
+	v.maxLength("startip", 255)
+	v.maxLength("vlan", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateManagementNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateManagementNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "endip", "gateway", "netmask", "podid", "startip", "vlan":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "forsystemvms":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createManagementNetworkIpRange", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createManagementNetworkIpRange: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *CreateManagementNetworkIpRangeParams) SetEndip(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["endip"] = v
+}
+
+func (p *CreateManagementNetworkIpRangeParams) ResetEndip() {
+	delete(p.p, "endip")
+}
+
+func (p *CreateManagementNetworkIpRangeParams) GetEndip() (string, bool) {
+	value, ok := p.p["endip"].(string)
+	return value, ok
+}
+
+func (p *CreateManagementNetworkIpRangeParams) SetForsystemvms(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["forsystemvms"] = v
+}
+
+func (p *CreateManagementNetworkIpRangeParams) ResetForsystemvms() {
+	delete(p.p, "forsystemvms")
+}
+
+func (p *CreateManagementNetworkIpRangeParams) GetForsystemvms() (bool, bool) {
+	value, ok := p.p["forsystemvms"].(bool)
+	return value, ok
+}
+
+func (p *CreateManagementNetworkIpRangeParams) SetGateway(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["gateway"] = v
+}
+
+func (p *CreateManagementNetworkIpRangeParams) ResetGateway() {
+	delete(p.p, "gateway")
+}
+
+func (p *CreateManagementNetworkIpRangeParams) GetGateway() (string, bool) {
+	value, ok := p.p["gateway"].(string)
+	return value, ok
+}
+
+func (p *CreateManagementNetworkIpRangeParams) SetNetmask(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["netmask"] = v
+}
+
+func (p *CreateManagementNetworkIpRangeParams) ResetNetmask() {
+	delete(p.p, "netmask")
+}
+
+func (p *CreateManagementNetworkIpRangeParams) GetNetmask() (string, bool) {
+	value, ok := p.p["netmask"].(string)
+	return value, ok
+}
+
+func (p *CreateManagementNetworkIpRangeParams) SetPodid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["podid"] = v
+}
+
+func (p *CreateManagementNetworkIpRangeParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *CreateManagementNetworkIpRangeParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
+func (p *CreateManagementNetworkIpRangeParams) SetStartip(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["startip"] = v
+}
+
+func (p *CreateManagementNetworkIpRangeParams) ResetStartip() {
+	delete(p.p, "startip")
+}
+
+func (p *CreateManagementNetworkIpRangeParams) GetStartip() (string, bool) {
+	value, ok := p.p["startip"].(string)
+	return value, ok
+}
+
+func (p *CreateManagementNetworkIpRangeParams) SetVlan(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["vlan"] = v
+}
+
+func (p *CreateManagementNetworkIpRangeParams) ResetVlan() {
+	delete(p.p, "vlan")
+}
+
+func (p *CreateManagementNetworkIpRangeParams) GetVlan() (string, bool) {
+	value, ok := p.p["vlan"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateManagementNetworkIpRangeParams instance,
+// as then you are sure you have configured all required params
+func (s *PodService) NewCreateManagementNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) *CreateManagementNetworkIpRangeParams {
+	p := &CreateManagementNetworkIpRangeParams{}
+	p.p = make(map[string]interface{})
+	p.p["gateway"] = gateway
+	p.p["netmask"] = netmask
+	p.p["podid"] = podid
+	p.p["startip"] = startip
+	return p
+}
+
+// Creates a Management network IP range.
+func (s *PodService) CreateManagementNetworkIpRange(p *CreateManagementNetworkIpRangeParams) (*CreateManagementNetworkIpRangeResponse, error) {
+	return s.CreateManagementNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// CreateManagementNetworkIpRangeWithContext is the same as CreateManagementNetworkIpRange, but uses ctx to cancel the request and any async job polling
+func (s *PodService) CreateManagementNetworkIpRangeWithContext(ctx context.Context, p *CreateManagementNetworkIpRangeParams) (*CreateManagementNetworkIpRangeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createManagementNetworkIpRange", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateManagementNetworkIpRangeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// CreateManagementNetworkIpRangeAsync starts the async job for CreateManagementNetworkIpRange and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateManagementNetworkIpRangeResponse using Job.Result
+func (s *PodService) CreateManagementNetworkIpRangeAsync(p *CreateManagementNetworkIpRangeParams) (*Job, error) {
+	return s.CreateManagementNetworkIpRangeAsyncWithContext(context.Background(), p)
+}
+
+// CreateManagementNetworkIpRangeAsyncWithContext is the same as CreateManagementNetworkIpRangeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *PodService) CreateManagementNetworkIpRangeAsyncWithContext(ctx context.Context, p *CreateManagementNetworkIpRangeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createManagementNetworkIpRange", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateManagementNetworkIpRangeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type CreateManagementNetworkIpRangeResponse struct {
+	Allocationstate string                                           `json:"allocationstate"`
+	Capacity        []CreateManagementNetworkIpRangeResponseCapacity `json:"capacity"`
+	Endip           []string                                         `json:"endip"`
+	Forsystemvms    []string                                         `json:"forsystemvms"`
+	Gateway         string                                           `json:"gateway"`
+	Id              string                                           `json:"id"`
+	JobID           string                                           `json:"jobid"`
+	Jobstatus       int                                              `json:"jobstatus"`
+	Name            string                                           `json:"name"`
+	Netmask         string                                           `json:"netmask"`
+	Startip         []string                                         `json:"startip"`
+	Vlanid          []string                                         `json:"vlanid"`
+	Zoneid          string                                           `json:"zoneid"`
+	Zonename        string                                           `json:"zonename"`
+}
+
+type CreateManagementNetworkIpRangeResponseCapacity struct {
+	Capacityallocated int64  `json:"capacityallocated"`
+	Capacitytotal     int64  `json:"capacitytotal"`
+	Capacityused      int64  `json:"capacityused"`
+	Clusterid         string `json:"clusterid"`
+	Clustername       string `json:"clustername"`
+	Name              string `json:"name"`
+	Percentused       string `json:"percentused"`
+	Podid             string `json:"podid"`
+	Podname           string `json:"podname"`
+	Type              int    `json:"type"`
+	Zoneid            string `json:"zoneid"`
+	Zonename          string `json:"zonename"`
+}
+
 type CreatePodParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreatePodParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreatePodParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -60,6 +579,54 @@ func (p *CreatePodParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreatePodParams) Validate() error {
+	v := newParamValidator("createPod", p.p)
+	v.required("gateway", "name", "netmask", "startip", "zoneid")
+	v.ids("zoneid")
+	v.maxLength("allocationstate", 255)
+	v.maxLength("endip", 255)
+	v.maxLength("gateway", 255)
+	v.maxLength("name", 255)
+	v.maxLength("netmask", 255)
+	v.maxLength("startip", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreatePodParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreatePodParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "allocationstate", "endip", "gateway", "name", "netmask", "startip", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createPod", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createPod: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreatePodParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -67,6 +634,15 @@ func (p *CreatePodParams) SetAllocationstate(v string) {
 	p.p["allocationstate"] = v
 }
 
+func (p *CreatePodParams) ResetAllocationstate() {
+	delete(p.p, "allocationstate")
+}
+
+func (p *CreatePodParams) GetAllocationstate() (string, bool) {
+	value, ok := p.p["allocationstate"].(string)
+	return value, ok
+}
+
 func (p *CreatePodParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -74,6 +650,15 @@ func (p *CreatePodParams) SetEndip(v string) {
 	p.p["endip"] = v
 }
 
+func (p *CreatePodParams) ResetEndip() {
+	delete(p.p, "endip")
+}
+
+func (p *CreatePodParams) GetEndip() (string, bool) {
+	value, ok := p.p["endip"].(string)
+	return value, ok
+}
+
 func (p *CreatePodParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -81,6 +666,15 @@ func (p *CreatePodParams) SetGateway(v string) {
 	p.p["gateway"] = v
 }
 
+func (p *CreatePodParams) ResetGateway() {
+	delete(p.p, "gateway")
+}
+
+func (p *CreatePodParams) GetGateway() (string, bool) {
+	value, ok := p.p["gateway"].(string)
+	return value, ok
+}
+
 func (p *CreatePodParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -88,6 +682,15 @@ func (p *CreatePodParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreatePodParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreatePodParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreatePodParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -95,6 +698,15 @@ func (p *CreatePodParams) SetNetmask(v string) {
 	p.p["netmask"] = v
 }
 
+func (p *CreatePodParams) ResetNetmask() {
+	delete(p.p, "netmask")
+}
+
+func (p *CreatePodParams) GetNetmask() (string, bool) {
+	value, ok := p.p["netmask"].(string)
+	return value, ok
+}
+
 func (p *CreatePodParams) SetStartip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -102,6 +714,15 @@ func (p *CreatePodParams) SetStartip(v string) {
 	p.p["startip"] = v
 }
 
+func (p *CreatePodParams) ResetStartip() {
+	delete(p.p, "startip")
+}
+
+func (p *CreatePodParams) GetStartip() (string, bool) {
+	value, ok := p.p["startip"].(string)
+	return value, ok
+}
+
 func (p *CreatePodParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -109,6 +730,15 @@ func (p *CreatePodParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *CreatePodParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreatePodParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreatePodParams instance,
 // as then you are sure you have configured all required params
 func (s *PodService) NewCreatePodParams(gateway string, name string, netmask string, startip string, zoneid string) *CreatePodParams {
@@ -124,7 +754,16 @@ func (s *PodService) NewCreatePodParams(gateway string, name string, netmask str
 
 // Creates a new Pod.
 func (s *PodService) CreatePod(p *CreatePodParams) (*CreatePodResponse, error) {
-	resp, err := s.cs.newRequest("createPod", p.toURLValues())
+	return s.CreatePodWithContext(context.Background(), p)
+}
+
+// CreatePodWithContext is the same as CreatePod, but uses ctx to cancel the request and any async job polling
+func (s *PodService) CreatePodWithContext(ctx context.Context, p *CreatePodParams) (*CreatePodResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createPod", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -154,88 +793,403 @@ type CreatePodResponse struct {
 	Zonename        string                      `json:"zonename"`
 }
 
-type CreatePodResponseCapacity struct {
-	Capacityallocated int64  `json:"capacityallocated"`
-	Capacitytotal     int64  `json:"capacitytotal"`
-	Capacityused      int64  `json:"capacityused"`
-	Clusterid         string `json:"clusterid"`
-	Clustername       string `json:"clustername"`
-	Name              string `json:"name"`
-	Percentused       string `json:"percentused"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Type              int    `json:"type"`
-	Zoneid            string `json:"zoneid"`
-	Zonename          string `json:"zonename"`
+type CreatePodResponseCapacity struct {
+	Capacityallocated int64  `json:"capacityallocated"`
+	Capacitytotal     int64  `json:"capacitytotal"`
+	Capacityused      int64  `json:"capacityused"`
+	Clusterid         string `json:"clusterid"`
+	Clustername       string `json:"clustername"`
+	Name              string `json:"name"`
+	Percentused       string `json:"percentused"`
+	Podid             string `json:"podid"`
+	Podname           string `json:"podname"`
+	Type              int    `json:"type"`
+	Zoneid            string `json:"zoneid"`
+	Zonename          string `json:"zonename"`
+}
+
+type DedicatePodParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DedicatePodParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["podid"]; found {
+		u.Set("podid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DedicatePodParams) Validate() error {
+	v := newParamValidator("dedicatePod", p.p)
+	v.required("domainid", "podid")
+	v.ids("domainid", "podid")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DedicatePodParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DedicatePodParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "podid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for dedicatePod", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of dedicatePod: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DedicatePodParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *DedicatePodParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *DedicatePodParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *DedicatePodParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *DedicatePodParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *DedicatePodParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *DedicatePodParams) SetPodid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["podid"] = v
+}
+
+func (p *DedicatePodParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *DedicatePodParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DedicatePodParams instance,
+// as then you are sure you have configured all required params
+func (s *PodService) NewDedicatePodParams(domainid string, podid string) *DedicatePodParams {
+	p := &DedicatePodParams{}
+	p.p = make(map[string]interface{})
+	p.p["domainid"] = domainid
+	p.p["podid"] = podid
+	return p
+}
+
+// Dedicates a Pod.
+func (s *PodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error) {
+	return s.DedicatePodWithContext(context.Background(), p)
+}
+
+// DedicatePodWithContext is the same as DedicatePod, but uses ctx to cancel the request and any async job polling
+func (s *PodService) DedicatePodWithContext(ctx context.Context, p *DedicatePodParams) (*DedicatePodResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicatePod", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DedicatePodResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DedicatePodAsync starts the async job for DedicatePod and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DedicatePodResponse using Job.Result
+func (s *PodService) DedicatePodAsync(p *DedicatePodParams) (*Job, error) {
+	return s.DedicatePodAsyncWithContext(context.Background(), p)
+}
+
+// DedicatePodAsyncWithContext is the same as DedicatePodAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *PodService) DedicatePodAsyncWithContext(ctx context.Context, p *DedicatePodParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicatePod", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DedicatePodResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type DedicatePodResponse struct {
+	Accountid       string `json:"accountid"`
+	Affinitygroupid string `json:"affinitygroupid"`
+	Domainid        string `json:"domainid"`
+	Id              string `json:"id"`
+	JobID           string `json:"jobid"`
+	Jobstatus       int    `json:"jobstatus"`
+	Podid           string `json:"podid"`
+	Podname         string `json:"podname"`
 }
 
-type DedicatePodParams struct {
+type DeleteManagementNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
-func (p *DedicatePodParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteManagementNetworkIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v, found := p.p["endip"]; found {
+		u.Set("endip", v.(string))
 	}
 	if v, found := p.p["podid"]; found {
 		u.Set("podid", v.(string))
 	}
+	if v, found := p.p["startip"]; found {
+		u.Set("startip", v.(string))
+	}
+	if v, found := p.p["vlan"]; found {
+		u.Set("vlan", v.(string))
+	}
 	return u
 }
 
-func (p *DedicatePodParams) SetAccount(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteManagementNetworkIpRangeParams) Validate() error {
+	v := newParamValidator("deleteManagementNetworkIpRange", p.p)
+	v.required("endip", "podid", "startip", "vlan")
+	v.ids("podid")
+	v.maxLength("endip", 255)
+	v.maxLength("startip", 255)
+	v.maxLength("vlan", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteManagementNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["account"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *DedicatePodParams) SetDomainid(v string) {
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteManagementNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "endip", "podid", "startip", "vlan":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteManagementNetworkIpRange", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteManagementNetworkIpRange: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteManagementNetworkIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["domainid"] = v
+	p.p["endip"] = v
 }
 
-func (p *DedicatePodParams) SetPodid(v string) {
+func (p *DeleteManagementNetworkIpRangeParams) ResetEndip() {
+	delete(p.p, "endip")
+}
+
+func (p *DeleteManagementNetworkIpRangeParams) GetEndip() (string, bool) {
+	value, ok := p.p["endip"].(string)
+	return value, ok
+}
+
+func (p *DeleteManagementNetworkIpRangeParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["podid"] = v
 }
 
-// You should always use this function to get a new DedicatePodParams instance,
+func (p *DeleteManagementNetworkIpRangeParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *DeleteManagementNetworkIpRangeParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
+func (p *DeleteManagementNetworkIpRangeParams) SetStartip(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["startip"] = v
+}
+
+func (p *DeleteManagementNetworkIpRangeParams) ResetStartip() {
+	delete(p.p, "startip")
+}
+
+func (p *DeleteManagementNetworkIpRangeParams) GetStartip() (string, bool) {
+	value, ok := p.p["startip"].(string)
+	return value, ok
+}
+
+func (p *DeleteManagementNetworkIpRangeParams) SetVlan(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["vlan"] = v
+}
+
+func (p *DeleteManagementNetworkIpRangeParams) ResetVlan() {
+	delete(p.p, "vlan")
+}
+
+func (p *DeleteManagementNetworkIpRangeParams) GetVlan() (string, bool) {
+	value, ok := p.p["vlan"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteManagementNetworkIpRangeParams instance,
 // as then you are sure you have configured all required params
-func (s *PodService) NewDedicatePodParams(domainid string, podid string) *DedicatePodParams {
-	p := &DedicatePodParams{}
+func (s *PodService) NewDeleteManagementNetworkIpRangeParams(endip string, podid string, startip string, vlan string) *DeleteManagementNetworkIpRangeParams {
+	p := &DeleteManagementNetworkIpRangeParams{}
 	p.p = make(map[string]interface{})
-	p.p["domainid"] = domainid
+	p.p["endip"] = endip
 	p.p["podid"] = podid
+	p.p["startip"] = startip
+	p.p["vlan"] = vlan
 	return p
 }
 
-// Dedicates a Pod.
-func (s *PodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error) {
-	resp, err := s.cs.newRequest("dedicatePod", p.toURLValues())
+// Deletes a management network IP range. This action is only allowed when no IPs in this range are allocated.
+func (s *PodService) DeleteManagementNetworkIpRange(p *DeleteManagementNetworkIpRangeParams) (*DeleteManagementNetworkIpRangeResponse, error) {
+	return s.DeleteManagementNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// DeleteManagementNetworkIpRangeWithContext is the same as DeleteManagementNetworkIpRange, but uses ctx to cancel the request and any async job polling
+func (s *PodService) DeleteManagementNetworkIpRangeWithContext(ctx context.Context, p *DeleteManagementNetworkIpRangeParams) (*DeleteManagementNetworkIpRangeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteManagementNetworkIpRange", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DedicatePodResponse
+	var r DeleteManagementNetworkIpRangeResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -243,11 +1197,6 @@ func (s *PodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, er
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
 		if err := json.Unmarshal(b, &r); err != nil {
 			return nil, err
 		}
@@ -256,22 +1205,44 @@ func (s *PodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, er
 	return &r, nil
 }
 
-type DedicatePodResponse struct {
-	Accountid       string `json:"accountid"`
-	Affinitygroupid string `json:"affinitygroupid"`
-	Domainid        string `json:"domainid"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Podid           string `json:"podid"`
-	Podname         string `json:"podname"`
+// DeleteManagementNetworkIpRangeAsync starts the async job for DeleteManagementNetworkIpRange and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteManagementNetworkIpRangeResponse using Job.Result
+func (s *PodService) DeleteManagementNetworkIpRangeAsync(p *DeleteManagementNetworkIpRangeParams) (*Job, error) {
+	return s.DeleteManagementNetworkIpRangeAsyncWithContext(context.Background(), p)
+}
+
+// DeleteManagementNetworkIpRangeAsyncWithContext is the same as DeleteManagementNetworkIpRangeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *PodService) DeleteManagementNetworkIpRangeAsyncWithContext(ctx context.Context, p *DeleteManagementNetworkIpRangeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteManagementNetworkIpRange", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteManagementNetworkIpRangeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteManagementNetworkIpRangeResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
 }
 
 type DeletePodParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeletePodParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeletePodParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -282,6 +1253,48 @@ func (p *DeletePodParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeletePodParams) Validate() error {
+	v := newParamValidator("deletePod", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeletePodParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeletePodParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deletePod", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deletePod: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeletePodParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -289,6 +1302,15 @@ func (p *DeletePodParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeletePodParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeletePodParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeletePodParams instance,
 // as then you are sure you have configured all required params
 func (s *PodService) NewDeletePodParams(id string) *DeletePodParams {
@@ -300,7 +1322,16 @@ func (s *PodService) NewDeletePodParams(id string) *DeletePodParams {
 
 // Deletes a Pod.
 func (s *PodService) DeletePod(p *DeletePodParams) (*DeletePodResponse, error) {
-	resp, err := s.cs.newRequest("deletePod", p.toURLValues())
+	return s.DeletePodWithContext(context.Background(), p)
+}
+
+// DeletePodWithContext is the same as DeletePod, but uses ctx to cancel the request and any async job polling
+func (s *PodService) DeletePodWithContext(ctx context.Context, p *DeletePodParams) (*DeletePodResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePod", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -351,7 +1382,8 @@ type ListDedicatedPodsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListDedicatedPodsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListDedicatedPodsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -382,6 +1414,53 @@ func (p *ListDedicatedPodsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListDedicatedPodsParams) Validate() error {
+	v := newParamValidator("listDedicatedPods", p.p)
+	v.ids("affinitygroupid", "domainid", "podid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListDedicatedPodsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListDedicatedPodsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "affinitygroupid", "domainid", "keyword", "podid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listDedicatedPods", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listDedicatedPods: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListDedicatedPodsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -389,6 +1468,15 @@ func (p *ListDedicatedPodsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListDedicatedPodsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListDedicatedPodsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedPodsParams) SetAffinitygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -396,6 +1484,15 @@ func (p *ListDedicatedPodsParams) SetAffinitygroupid(v string) {
 	p.p["affinitygroupid"] = v
 }
 
+func (p *ListDedicatedPodsParams) ResetAffinitygroupid() {
+	delete(p.p, "affinitygroupid")
+}
+
+func (p *ListDedicatedPodsParams) GetAffinitygroupid() (string, bool) {
+	value, ok := p.p["affinitygroupid"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedPodsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -403,6 +1500,15 @@ func (p *ListDedicatedPodsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListDedicatedPodsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListDedicatedPodsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedPodsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -410,6 +1516,15 @@ func (p *ListDedicatedPodsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListDedicatedPodsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListDedicatedPodsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedPodsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -417,6 +1532,15 @@ func (p *ListDedicatedPodsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListDedicatedPodsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListDedicatedPodsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListDedicatedPodsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -424,6 +1548,15 @@ func (p *ListDedicatedPodsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListDedicatedPodsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListDedicatedPodsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListDedicatedPodsParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -431,6 +1564,15 @@ func (p *ListDedicatedPodsParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *ListDedicatedPodsParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListDedicatedPodsParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListDedicatedPodsParams instance,
 // as then you are sure you have configured all required params
 func (s *PodService) NewListDedicatedPodsParams() *ListDedicatedPodsParams {
@@ -441,7 +1583,16 @@ func (s *PodService) NewListDedicatedPodsParams() *ListDedicatedPodsParams {
 
 // Lists dedicated pods.
 func (s *PodService) ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedPods", p.toURLValues())
+	return s.ListDedicatedPodsWithContext(context.Background(), p)
+}
+
+// ListDedicatedPodsWithContext is the same as ListDedicatedPods, but uses ctx to cancel the request and any async job polling
+func (s *PodService) ListDedicatedPodsWithContext(ctx context.Context, p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listDedicatedPods", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -454,6 +1605,92 @@ func (s *PodService) ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicat
 	return &r, nil
 }
 
+// ListDedicatedPodsPager iterates over all pages of a ListDedicatedPods call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListDedicatedPodsPager struct {
+	pager
+
+	s *PodService
+	p *ListDedicatedPodsParams
+	r *ListDedicatedPodsResponse
+}
+
+// NewListDedicatedPodsPager returns a pager for all pages of a ListDedicatedPods call with the given params
+func (s *PodService) NewListDedicatedPodsPager(p *ListDedicatedPodsParams) *ListDedicatedPodsPager {
+	return &ListDedicatedPodsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListDedicatedPodsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListDedicatedPodsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListDedicatedPodsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListDedicatedPodsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.DedicatedPods), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListDedicatedPodsPager) Page() *ListDedicatedPodsResponse {
+	return pg.r
+}
+
+// ListDedicatedPodsAll fetches all pages of a ListDedicatedPods call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *PodService) ListDedicatedPodsAll(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
+	return s.ListDedicatedPodsAllWithContext(context.Background(), p)
+}
+
+// ListDedicatedPodsAllWithContext is the same as ListDedicatedPodsAll, but uses ctx to cancel the requests
+func (s *PodService) ListDedicatedPodsAllWithContext(ctx context.Context, p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*DedicatedPod)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListDedicatedPodsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListDedicatedPodsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.DedicatedPods
+		mu.Unlock()
+
+		return len(l.DedicatedPods), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListDedicatedPodsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.DedicatedPods = append(r.DedicatedPods, pages[page]...)
+	}
+	r.Count = len(r.DedicatedPods)
+
+	return r, nil
+}
+
 type ListDedicatedPodsResponse struct {
 	Count         int             `json:"count"`
 	DedicatedPods []*DedicatedPod `json:"dedicatedpod"`
@@ -474,7 +1711,8 @@ type ListPodsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListPodsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListPodsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -506,7 +1744,59 @@ func (p *ListPodsParams) toURLValues() url.Values {
 	if v, found := p.p["zoneid"]; found {
 		u.Set("zoneid", v.(string))
 	}
-	return u
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListPodsParams) Validate() error {
+	v := newParamValidator("listPods", p.p)
+	v.ids("id", "zoneid")
+	v.maxLength("allocationstate", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListPodsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListPodsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "allocationstate", "id", "keyword", "name", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "showcapacities":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listPods", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listPods: %w", k, err)
+		}
+	}
+	return nil
 }
 
 func (p *ListPodsParams) SetAllocationstate(v string) {
@@ -516,6 +1806,15 @@ func (p *ListPodsParams) SetAllocationstate(v string) {
 	p.p["allocationstate"] = v
 }
 
+func (p *ListPodsParams) ResetAllocationstate() {
+	delete(p.p, "allocationstate")
+}
+
+func (p *ListPodsParams) GetAllocationstate() (string, bool) {
+	value, ok := p.p["allocationstate"].(string)
+	return value, ok
+}
+
 func (p *ListPodsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -523,6 +1822,15 @@ func (p *ListPodsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListPodsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListPodsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListPodsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -530,6 +1838,15 @@ func (p *ListPodsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListPodsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListPodsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListPodsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -537,6 +1854,15 @@ func (p *ListPodsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListPodsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListPodsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListPodsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -544,6 +1870,15 @@ func (p *ListPodsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListPodsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListPodsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListPodsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -551,6 +1886,15 @@ func (p *ListPodsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListPodsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListPodsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListPodsParams) SetShowcapacities(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -558,6 +1902,15 @@ func (p *ListPodsParams) SetShowcapacities(v bool) {
 	p.p["showcapacities"] = v
 }
 
+func (p *ListPodsParams) ResetShowcapacities() {
+	delete(p.p, "showcapacities")
+}
+
+func (p *ListPodsParams) GetShowcapacities() (bool, bool) {
+	value, ok := p.p["showcapacities"].(bool)
+	return value, ok
+}
+
 func (p *ListPodsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -565,6 +1918,15 @@ func (p *ListPodsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListPodsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListPodsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListPodsParams instance,
 // as then you are sure you have configured all required params
 func (s *PodService) NewListPodsParams() *ListPodsParams {
@@ -575,6 +1937,11 @@ func (s *PodService) NewListPodsParams() *ListPodsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PodService) GetPodID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetPodIDWithContext(context.Background(), name, opts...)
+}
+
+// GetPodIDWithContext is the same as GetPodID, but uses ctx to cancel the request
+func (s *PodService) GetPodIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListPodsParams{}
 	p.p = make(map[string]interface{})
 
@@ -586,7 +1953,7 @@ func (s *PodService) GetPodID(name string, opts ...OptionFunc) (string, int, err
 		}
 	}
 
-	l, err := s.ListPods(p)
+	l, err := s.ListPodsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -611,12 +1978,17 @@ func (s *PodService) GetPodID(name string, opts ...OptionFunc) (string, int, err
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PodService) GetPodByName(name string, opts ...OptionFunc) (*Pod, int, error) {
-	id, count, err := s.GetPodID(name, opts...)
+	return s.GetPodByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetPodByNameWithContext is the same as GetPodByName, but uses ctx to cancel the requests
+func (s *PodService) GetPodByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Pod, int, error) {
+	id, count, err := s.GetPodIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetPodByID(id, opts...)
+	r, count, err := s.GetPodByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -625,6 +1997,11 @@ func (s *PodService) GetPodByName(name string, opts ...OptionFunc) (*Pod, int, e
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PodService) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error) {
+	return s.GetPodByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetPodByIDWithContext is the same as GetPodByID, but uses ctx to cancel the request
+func (s *PodService) GetPodByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Pod, int, error) {
 	p := &ListPodsParams{}
 	p.p = make(map[string]interface{})
 
@@ -636,18 +2013,16 @@ func (s *PodService) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error
 		}
 	}
 
-	l, err := s.ListPods(p)
+	l, err := s.ListPodsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -658,7 +2033,16 @@ func (s *PodService) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error
 
 // Lists all Pods.
 func (s *PodService) ListPods(p *ListPodsParams) (*ListPodsResponse, error) {
-	resp, err := s.cs.newRequest("listPods", p.toURLValues())
+	return s.ListPodsWithContext(context.Background(), p)
+}
+
+// ListPodsWithContext is the same as ListPods, but uses ctx to cancel the request and any async job polling
+func (s *PodService) ListPodsWithContext(ctx context.Context, p *ListPodsParams) (*ListPodsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listPods", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -671,6 +2055,92 @@ func (s *PodService) ListPods(p *ListPodsParams) (*ListPodsResponse, error) {
 	return &r, nil
 }
 
+// ListPodsPager iterates over all pages of a ListPods call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListPodsPager struct {
+	pager
+
+	s *PodService
+	p *ListPodsParams
+	r *ListPodsResponse
+}
+
+// NewListPodsPager returns a pager for all pages of a ListPods call with the given params
+func (s *PodService) NewListPodsPager(p *ListPodsParams) *ListPodsPager {
+	return &ListPodsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListPodsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListPodsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListPodsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListPodsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Pods), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListPodsPager) Page() *ListPodsResponse {
+	return pg.r
+}
+
+// ListPodsAll fetches all pages of a ListPods call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *PodService) ListPodsAll(p *ListPodsParams) (*ListPodsResponse, error) {
+	return s.ListPodsAllWithContext(context.Background(), p)
+}
+
+// ListPodsAllWithContext is the same as ListPodsAll, but uses ctx to cancel the requests
+func (s *PodService) ListPodsAllWithContext(ctx context.Context, p *ListPodsParams) (*ListPodsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Pod)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListPodsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListPodsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Pods
+		mu.Unlock()
+
+		return len(l.Pods), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListPodsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Pods = append(r.Pods, pages[page]...)
+	}
+	r.Count = len(r.Pods)
+
+	return r, nil
+}
+
 type ListPodsResponse struct {
 	Count int    `json:"count"`
 	Pods  []*Pod `json:"pod"`
@@ -712,7 +2182,8 @@ type ReleaseDedicatedPodParams struct {
 	p map[string]interface{}
 }
 
-func (p *ReleaseDedicatedPodParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ReleaseDedicatedPodParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -723,6 +2194,48 @@ func (p *ReleaseDedicatedPodParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ReleaseDedicatedPodParams) Validate() error {
+	v := newParamValidator("releaseDedicatedPod", p.p)
+	v.required("podid")
+	v.ids("podid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ReleaseDedicatedPodParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ReleaseDedicatedPodParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "podid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for releaseDedicatedPod", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of releaseDedicatedPod: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ReleaseDedicatedPodParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -730,6 +2243,15 @@ func (p *ReleaseDedicatedPodParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *ReleaseDedicatedPodParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ReleaseDedicatedPodParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ReleaseDedicatedPodParams instance,
 // as then you are sure you have configured all required params
 func (s *PodService) NewReleaseDedicatedPodParams(podid string) *ReleaseDedicatedPodParams {
@@ -741,7 +2263,16 @@ func (s *PodService) NewReleaseDedicatedPodParams(podid string) *ReleaseDedicate
 
 // Release the dedication for the pod
 func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedPod", p.toURLValues())
+	return s.ReleaseDedicatedPodWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedPodWithContext is the same as ReleaseDedicatedPod, but uses ctx to cancel the request and any async job polling
+func (s *PodService) ReleaseDedicatedPodWithContext(ctx context.Context, p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedPod", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -753,7 +2284,7 @@ func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*Release
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -769,6 +2300,31 @@ func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*Release
 	return &r, nil
 }
 
+// ReleaseDedicatedPodAsync starts the async job for ReleaseDedicatedPod and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ReleaseDedicatedPodResponse using Job.Result
+func (s *PodService) ReleaseDedicatedPodAsync(p *ReleaseDedicatedPodParams) (*Job, error) {
+	return s.ReleaseDedicatedPodAsyncWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedPodAsyncWithContext is the same as ReleaseDedicatedPodAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *PodService) ReleaseDedicatedPodAsyncWithContext(ctx context.Context, p *ReleaseDedicatedPodParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedPod", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleaseDedicatedPodResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type ReleaseDedicatedPodResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -776,11 +2332,153 @@ type ReleaseDedicatedPodResponse struct {
 	Success     bool   `json:"success"`
 }
 
+type ReleasePodIpAddressParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ReleasePodIpAddressParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		vv := strconv.FormatInt(v.(int64), 10)
+		u.Set("id", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ReleasePodIpAddressParams) Validate() error {
+	v := newParamValidator("releasePodIpAddress", p.p)
+	v.required("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ReleasePodIpAddressParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ReleasePodIpAddressParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for releasePodIpAddress", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of releasePodIpAddress: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ReleasePodIpAddressParams) SetId(v int64) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ReleasePodIpAddressParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ReleasePodIpAddressParams) GetId() (int64, bool) {
+	value, ok := p.p["id"].(int64)
+	return value, ok
+}
+
+// You should always use this function to get a new ReleasePodIpAddressParams instance,
+// as then you are sure you have configured all required params
+func (s *PodService) NewReleasePodIpAddressParams(id int64) *ReleasePodIpAddressParams {
+	p := &ReleasePodIpAddressParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Releases a Pod IP back to the Pod
+func (s *PodService) ReleasePodIpAddress(p *ReleasePodIpAddressParams) (*ReleasePodIpAddressResponse, error) {
+	return s.ReleasePodIpAddressWithContext(context.Background(), p)
+}
+
+// ReleasePodIpAddressWithContext is the same as ReleasePodIpAddress, but uses ctx to cancel the request and any async job polling
+func (s *PodService) ReleasePodIpAddressWithContext(ctx context.Context, p *ReleasePodIpAddressParams) (*ReleasePodIpAddressResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "releasePodIpAddress", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleasePodIpAddressResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ReleasePodIpAddressResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *ReleasePodIpAddressResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias ReleasePodIpAddressResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
 type UpdatePodParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdatePodParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdatePodParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -809,6 +2507,54 @@ func (p *UpdatePodParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdatePodParams) Validate() error {
+	v := newParamValidator("updatePod", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("allocationstate", 255)
+	v.maxLength("endip", 255)
+	v.maxLength("gateway", 255)
+	v.maxLength("name", 255)
+	v.maxLength("netmask", 255)
+	v.maxLength("startip", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdatePodParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdatePodParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "allocationstate", "endip", "gateway", "id", "name", "netmask", "startip":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updatePod", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updatePod: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdatePodParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -816,6 +2562,15 @@ func (p *UpdatePodParams) SetAllocationstate(v string) {
 	p.p["allocationstate"] = v
 }
 
+func (p *UpdatePodParams) ResetAllocationstate() {
+	delete(p.p, "allocationstate")
+}
+
+func (p *UpdatePodParams) GetAllocationstate() (string, bool) {
+	value, ok := p.p["allocationstate"].(string)
+	return value, ok
+}
+
 func (p *UpdatePodParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -823,6 +2578,15 @@ func (p *UpdatePodParams) SetEndip(v string) {
 	p.p["endip"] = v
 }
 
+func (p *UpdatePodParams) ResetEndip() {
+	delete(p.p, "endip")
+}
+
+func (p *UpdatePodParams) GetEndip() (string, bool) {
+	value, ok := p.p["endip"].(string)
+	return value, ok
+}
+
 func (p *UpdatePodParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -830,6 +2594,15 @@ func (p *UpdatePodParams) SetGateway(v string) {
 	p.p["gateway"] = v
 }
 
+func (p *UpdatePodParams) ResetGateway() {
+	delete(p.p, "gateway")
+}
+
+func (p *UpdatePodParams) GetGateway() (string, bool) {
+	value, ok := p.p["gateway"].(string)
+	return value, ok
+}
+
 func (p *UpdatePodParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -837,6 +2610,15 @@ func (p *UpdatePodParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdatePodParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdatePodParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdatePodParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -844,6 +2626,15 @@ func (p *UpdatePodParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdatePodParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdatePodParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdatePodParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -851,6 +2642,15 @@ func (p *UpdatePodParams) SetNetmask(v string) {
 	p.p["netmask"] = v
 }
 
+func (p *UpdatePodParams) ResetNetmask() {
+	delete(p.p, "netmask")
+}
+
+func (p *UpdatePodParams) GetNetmask() (string, bool) {
+	value, ok := p.p["netmask"].(string)
+	return value, ok
+}
+
 func (p *UpdatePodParams) SetStartip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -858,6 +2658,15 @@ func (p *UpdatePodParams) SetStartip(v string) {
 	p.p["startip"] = v
 }
 
+func (p *UpdatePodParams) ResetStartip() {
+	delete(p.p, "startip")
+}
+
+func (p *UpdatePodParams) GetStartip() (string, bool) {
+	value, ok := p.p["startip"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdatePodParams instance,
 // as then you are sure you have configured all required params
 func (s *PodService) NewUpdatePodParams(id string) *UpdatePodParams {
@@ -869,7 +2678,16 @@ func (s *PodService) NewUpdatePodParams(id string) *UpdatePodParams {
 
 // Updates a Pod.
 func (s *PodService) UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error) {
-	resp, err := s.cs.newRequest("updatePod", p.toURLValues())
+	return s.UpdatePodWithContext(context.Background(), p)
+}
+
+// UpdatePodWithContext is the same as UpdatePod, but uses ctx to cancel the request and any async job polling
+func (s *PodService) UpdatePodWithContext(ctx context.Context, p *UpdatePodParams) (*UpdatePodResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updatePod", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -20,18 +20,81 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// PoolServiceIface is the interface implemented by PoolService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type PoolServiceIface interface {
+	NewCreateStoragePoolParams(name string, url string, zoneid string) *CreateStoragePoolParams
+	CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error)
+	CreateStoragePoolWithContext(ctx context.Context, p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error)
+	NewDeleteStoragePoolParams(id string) *DeleteStoragePoolParams
+	DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error)
+	DeleteStoragePoolWithContext(ctx context.Context, p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error)
+	NewFindStoragePoolsForMigrationParams(id string) *FindStoragePoolsForMigrationParams
+	FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error)
+	FindStoragePoolsForMigrationWithContext(ctx context.Context, p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error)
+	NewListElastistorInterfaceParams() *ListElastistorInterfaceParams
+	ListElastistorInterface(p *ListElastistorInterfaceParams) (*ListElastistorInterfaceResponse, error)
+	ListElastistorInterfaceWithContext(ctx context.Context, p *ListElastistorInterfaceParams) (*ListElastistorInterfaceResponse, error)
+	NewListElastistorPoolParams() *ListElastistorPoolParams
+	ListElastistorPool(p *ListElastistorPoolParams) (*ListElastistorPoolResponse, error)
+	ListElastistorPoolWithContext(ctx context.Context, p *ListElastistorPoolParams) (*ListElastistorPoolResponse, error)
+	NewListElastistorVolumeParams(id string) *ListElastistorVolumeParams
+	GetElastistorVolumeByID(id string, opts ...OptionFunc) (*ElastistorVolume, int, error)
+	GetElastistorVolumeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ElastistorVolume, int, error)
+	ListElastistorVolume(p *ListElastistorVolumeParams) (*ListElastistorVolumeResponse, error)
+	ListElastistorVolumeWithContext(ctx context.Context, p *ListElastistorVolumeParams) (*ListElastistorVolumeResponse, error)
+	NewListStoragePoolsParams() *ListStoragePoolsParams
+	GetStoragePoolID(name string, opts ...OptionFunc) (string, int, error)
+	GetStoragePoolIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetStoragePoolByName(name string, opts ...OptionFunc) (*StoragePool, int, error)
+	GetStoragePoolByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*StoragePool, int, error)
+	GetStoragePoolByID(id string, opts ...OptionFunc) (*StoragePool, int, error)
+	GetStoragePoolByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StoragePool, int, error)
+	ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error)
+	ListStoragePoolsWithContext(ctx context.Context, p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error)
+	NewListStoragePoolsPager(p *ListStoragePoolsParams) *ListStoragePoolsPager
+	ListStoragePoolsAll(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error)
+	ListStoragePoolsAllWithContext(ctx context.Context, p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error)
+	NewListStoragePoolsMetricsParams() *ListStoragePoolsMetricsParams
+	GetStoragePoolsMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetStoragePoolsMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetStoragePoolsMetricByName(name string, opts ...OptionFunc) (*StoragePoolsMetric, int, error)
+	GetStoragePoolsMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*StoragePoolsMetric, int, error)
+	GetStoragePoolsMetricByID(id string, opts ...OptionFunc) (*StoragePoolsMetric, int, error)
+	GetStoragePoolsMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StoragePoolsMetric, int, error)
+	ListStoragePoolsMetrics(p *ListStoragePoolsMetricsParams) (*ListStoragePoolsMetricsResponse, error)
+	ListStoragePoolsMetricsWithContext(ctx context.Context, p *ListStoragePoolsMetricsParams) (*ListStoragePoolsMetricsResponse, error)
+	NewListStoragePoolsMetricsPager(p *ListStoragePoolsMetricsParams) *ListStoragePoolsMetricsPager
+	ListStoragePoolsMetricsAll(p *ListStoragePoolsMetricsParams) (*ListStoragePoolsMetricsResponse, error)
+	ListStoragePoolsMetricsAllWithContext(ctx context.Context, p *ListStoragePoolsMetricsParams) (*ListStoragePoolsMetricsResponse, error)
+	NewSyncStoragePoolParams(id string) *SyncStoragePoolParams
+	SyncStoragePool(p *SyncStoragePoolParams) (*SyncStoragePoolResponse, error)
+	SyncStoragePoolWithContext(ctx context.Context, p *SyncStoragePoolParams) (*SyncStoragePoolResponse, error)
+	SyncStoragePoolAsync(p *SyncStoragePoolParams) (*Job, error)
+	SyncStoragePoolAsyncWithContext(ctx context.Context, p *SyncStoragePoolParams) (*Job, error)
+	NewUpdateSiocInfoParams(iopsnotifythreshold int, limitiopspergb int, sharespergb int, storageid string, zoneid string) *UpdateSiocInfoParams
+	UpdateSiocInfo(p *UpdateSiocInfoParams) (*UpdateSiocInfoResponse, error)
+	UpdateSiocInfoWithContext(ctx context.Context, p *UpdateSiocInfoParams) (*UpdateSiocInfoResponse, error)
+	NewUpdateStoragePoolParams(id string) *UpdateStoragePoolParams
+	UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error)
+	UpdateStoragePoolWithContext(ctx context.Context, p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error)
+}
+
 type CreateStoragePoolParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateStoragePoolParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateStoragePoolParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -84,6 +147,66 @@ func (p *CreateStoragePoolParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateStoragePoolParams) Validate() error {
+	v := newParamValidator("createStoragePool", p.p)
+	v.required("name", "url", "zoneid")
+	v.ids("clusterid", "podid", "zoneid")
+	v.maxLength("hypervisor", 255)
+	v.maxLength("name", 255)
+	v.maxLength("provider", 255)
+	v.maxLength("scope", 255)
+	v.maxLength("tags", 255)
+	v.maxLength("url", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateStoragePoolParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateStoragePoolParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "capacitybytes", "capacityiops":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "clusterid", "hypervisor", "name", "podid", "provider", "scope", "tags", "url", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "managed":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createStoragePool", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createStoragePool: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateStoragePoolParams) SetCapacitybytes(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -91,6 +214,15 @@ func (p *CreateStoragePoolParams) SetCapacitybytes(v int64) {
 	p.p["capacitybytes"] = v
 }
 
+func (p *CreateStoragePoolParams) ResetCapacitybytes() {
+	delete(p.p, "capacitybytes")
+}
+
+func (p *CreateStoragePoolParams) GetCapacitybytes() (int64, bool) {
+	value, ok := p.p["capacitybytes"].(int64)
+	return value, ok
+}
+
 func (p *CreateStoragePoolParams) SetCapacityiops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -98,6 +230,15 @@ func (p *CreateStoragePoolParams) SetCapacityiops(v int64) {
 	p.p["capacityiops"] = v
 }
 
+func (p *CreateStoragePoolParams) ResetCapacityiops() {
+	delete(p.p, "capacityiops")
+}
+
+func (p *CreateStoragePoolParams) GetCapacityiops() (int64, bool) {
+	value, ok := p.p["capacityiops"].(int64)
+	return value, ok
+}
+
 func (p *CreateStoragePoolParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -105,6 +246,15 @@ func (p *CreateStoragePoolParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *CreateStoragePoolParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *CreateStoragePoolParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *CreateStoragePoolParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -112,6 +262,15 @@ func (p *CreateStoragePoolParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *CreateStoragePoolParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *CreateStoragePoolParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *CreateStoragePoolParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -119,6 +278,15 @@ func (p *CreateStoragePoolParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *CreateStoragePoolParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *CreateStoragePoolParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *CreateStoragePoolParams) SetManaged(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -126,6 +294,15 @@ func (p *CreateStoragePoolParams) SetManaged(v bool) {
 	p.p["managed"] = v
 }
 
+func (p *CreateStoragePoolParams) ResetManaged() {
+	delete(p.p, "managed")
+}
+
+func (p *CreateStoragePoolParams) GetManaged() (bool, bool) {
+	value, ok := p.p["managed"].(bool)
+	return value, ok
+}
+
 func (p *CreateStoragePoolParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -133,6 +310,15 @@ func (p *CreateStoragePoolParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateStoragePoolParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateStoragePoolParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateStoragePoolParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -140,6 +326,15 @@ func (p *CreateStoragePoolParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *CreateStoragePoolParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *CreateStoragePoolParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *CreateStoragePoolParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -147,6 +342,15 @@ func (p *CreateStoragePoolParams) SetProvider(v string) {
 	p.p["provider"] = v
 }
 
+func (p *CreateStoragePoolParams) ResetProvider() {
+	delete(p.p, "provider")
+}
+
+func (p *CreateStoragePoolParams) GetProvider() (string, bool) {
+	value, ok := p.p["provider"].(string)
+	return value, ok
+}
+
 func (p *CreateStoragePoolParams) SetScope(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -154,6 +358,15 @@ func (p *CreateStoragePoolParams) SetScope(v string) {
 	p.p["scope"] = v
 }
 
+func (p *CreateStoragePoolParams) ResetScope() {
+	delete(p.p, "scope")
+}
+
+func (p *CreateStoragePoolParams) GetScope() (string, bool) {
+	value, ok := p.p["scope"].(string)
+	return value, ok
+}
+
 func (p *CreateStoragePoolParams) SetTags(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -161,6 +374,15 @@ func (p *CreateStoragePoolParams) SetTags(v string) {
 	p.p["tags"] = v
 }
 
+func (p *CreateStoragePoolParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *CreateStoragePoolParams) GetTags() (string, bool) {
+	value, ok := p.p["tags"].(string)
+	return value, ok
+}
+
 func (p *CreateStoragePoolParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -168,6 +390,15 @@ func (p *CreateStoragePoolParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *CreateStoragePoolParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *CreateStoragePoolParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *CreateStoragePoolParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -175,6 +406,15 @@ func (p *CreateStoragePoolParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *CreateStoragePoolParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreateStoragePoolParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateStoragePoolParams instance,
 // as then you are sure you have configured all required params
 func (s *PoolService) NewCreateStoragePoolParams(name string, url string, zoneid string) *CreateStoragePoolParams {
@@ -188,7 +428,16 @@ func (s *PoolService) NewCreateStoragePoolParams(name string, url string, zoneid
 
 // Creates a storage pool.
 func (s *PoolService) CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("createStoragePool", p.toURLValues())
+	return s.CreateStoragePoolWithContext(context.Background(), p)
+}
+
+// CreateStoragePoolWithContext is the same as CreateStoragePool, but uses ctx to cancel the request and any async job polling
+func (s *PoolService) CreateStoragePoolWithContext(ctx context.Context, p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createStoragePool", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +484,8 @@ type DeleteStoragePoolParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteStoragePoolParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteStoragePoolParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -250,6 +500,52 @@ func (p *DeleteStoragePoolParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteStoragePoolParams) Validate() error {
+	v := newParamValidator("deleteStoragePool", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteStoragePoolParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteStoragePoolParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "forced":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteStoragePool", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteStoragePool: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteStoragePoolParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -257,6 +553,15 @@ func (p *DeleteStoragePoolParams) SetForced(v bool) {
 	p.p["forced"] = v
 }
 
+func (p *DeleteStoragePoolParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *DeleteStoragePoolParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
 func (p *DeleteStoragePoolParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -264,6 +569,15 @@ func (p *DeleteStoragePoolParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteStoragePoolParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteStoragePoolParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteStoragePoolParams instance,
 // as then you are sure you have configured all required params
 func (s *PoolService) NewDeleteStoragePoolParams(id string) *DeleteStoragePoolParams {
@@ -275,7 +589,16 @@ func (s *PoolService) NewDeleteStoragePoolParams(id string) *DeleteStoragePoolPa
 
 // Deletes a storage pool.
 func (s *PoolService) DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("deleteStoragePool", p.toURLValues())
+	return s.DeleteStoragePoolWithContext(context.Background(), p)
+}
+
+// DeleteStoragePoolWithContext is the same as DeleteStoragePool, but uses ctx to cancel the request and any async job polling
+func (s *PoolService) DeleteStoragePoolWithContext(ctx context.Context, p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteStoragePool", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -326,7 +649,8 @@ type FindStoragePoolsForMigrationParams struct {
 	p map[string]interface{}
 }
 
-func (p *FindStoragePoolsForMigrationParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *FindStoragePoolsForMigrationParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -348,6 +672,53 @@ func (p *FindStoragePoolsForMigrationParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *FindStoragePoolsForMigrationParams) Validate() error {
+	v := newParamValidator("findStoragePoolsForMigration", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *FindStoragePoolsForMigrationParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *FindStoragePoolsForMigrationParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for findStoragePoolsForMigration", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of findStoragePoolsForMigration: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *FindStoragePoolsForMigrationParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -355,6 +726,15 @@ func (p *FindStoragePoolsForMigrationParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *FindStoragePoolsForMigrationParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *FindStoragePoolsForMigrationParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *FindStoragePoolsForMigrationParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -362,6 +742,15 @@ func (p *FindStoragePoolsForMigrationParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *FindStoragePoolsForMigrationParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *FindStoragePoolsForMigrationParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *FindStoragePoolsForMigrationParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -369,6 +758,15 @@ func (p *FindStoragePoolsForMigrationParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *FindStoragePoolsForMigrationParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *FindStoragePoolsForMigrationParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *FindStoragePoolsForMigrationParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -376,6 +774,15 @@ func (p *FindStoragePoolsForMigrationParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *FindStoragePoolsForMigrationParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *FindStoragePoolsForMigrationParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new FindStoragePoolsForMigrationParams instance,
 // as then you are sure you have configured all required params
 func (s *PoolService) NewFindStoragePoolsForMigrationParams(id string) *FindStoragePoolsForMigrationParams {
@@ -387,7 +794,16 @@ func (s *PoolService) NewFindStoragePoolsForMigrationParams(id string) *FindStor
 
 // Lists storage pools available for migration of a volume.
 func (s *PoolService) FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error) {
-	resp, err := s.cs.newRequest("findStoragePoolsForMigration", p.toURLValues())
+	return s.FindStoragePoolsForMigrationWithContext(context.Background(), p)
+}
+
+// FindStoragePoolsForMigrationWithContext is the same as FindStoragePoolsForMigration, but uses ctx to cancel the request and any async job polling
+func (s *PoolService) FindStoragePoolsForMigrationWithContext(ctx context.Context, p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "findStoragePoolsForMigration", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -430,121 +846,663 @@ type FindStoragePoolsForMigrationResponse struct {
 	Zonename             string            `json:"zonename"`
 }
 
-type ListStoragePoolsParams struct {
+type ListElastistorInterfaceParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListStoragePoolsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListElastistorInterfaceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["path"]; found {
-		u.Set("path", v.(string))
-	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	if v, found := p.p["scope"]; found {
-		u.Set("scope", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v, found := p.p["controllerid"]; found {
+		u.Set("controllerid", v.(string))
 	}
 	return u
 }
 
-func (p *ListStoragePoolsParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListElastistorInterfaceParams) Validate() error {
+	v := newParamValidator("listElastistorInterface", p.p)
+	v.maxLength("controllerid", 255)
+	return v.err()
 }
 
-func (p *ListStoragePoolsParams) SetId(v string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListElastistorInterfaceParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["id"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *ListStoragePoolsParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListElastistorInterfaceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["ipaddress"] = v
-}
 
-func (p *ListStoragePoolsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "controllerid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listElastistorInterface", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listElastistorInterface: %w", k, err)
+		}
 	}
-	p.p["keyword"] = v
+	return nil
 }
 
-func (p *ListStoragePoolsParams) SetName(v string) {
+func (p *ListElastistorInterfaceParams) SetControllerid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["name"] = v
+	p.p["controllerid"] = v
 }
 
-func (p *ListStoragePoolsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListElastistorInterfaceParams) ResetControllerid() {
+	delete(p.p, "controllerid")
+}
+
+func (p *ListElastistorInterfaceParams) GetControllerid() (string, bool) {
+	value, ok := p.p["controllerid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListElastistorInterfaceParams instance,
+// as then you are sure you have configured all required params
+func (s *PoolService) NewListElastistorInterfaceParams() *ListElastistorInterfaceParams {
+	p := &ListElastistorInterfaceParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Lists the network Interfaces of elastistor
+func (s *PoolService) ListElastistorInterface(p *ListElastistorInterfaceParams) (*ListElastistorInterfaceResponse, error) {
+	return s.ListElastistorInterfaceWithContext(context.Background(), p)
+}
+
+// ListElastistorInterfaceWithContext is the same as ListElastistorInterface, but uses ctx to cancel the request and any async job polling
+func (s *PoolService) ListElastistorInterfaceWithContext(ctx context.Context, p *ListElastistorInterfaceParams) (*ListElastistorInterfaceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
-	p.p["page"] = v
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listElastistorInterface", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListElastistorInterfaceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
 }
 
-func (p *ListStoragePoolsParams) SetPagesize(v int) {
+type ListElastistorInterfaceResponse struct {
+	Count               int                    `json:"count"`
+	ElastistorInterface []*ElastistorInterface `json:"elastistorinterface"`
+}
+
+type ElastistorInterface struct {
+	Compression   string `json:"compression"`
+	Deduplication string `json:"deduplication"`
+	Graceallowed  string `json:"graceallowed"`
+	Id            string `json:"id"`
+	JobID         string `json:"jobid"`
+	Jobstatus     int    `json:"jobstatus"`
+	Name          string `json:"name"`
+	Sync          string `json:"sync"`
+}
+
+type ListElastistorPoolParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListElastistorPoolParams) ToURLValues() url.Values {
+	u := url.Values{}
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return u
 	}
-	p.p["pagesize"] = v
+	if v, found := p.p["id"]; found {
+		vv := strconv.FormatInt(v.(int64), 10)
+		u.Set("id", vv)
+	}
+	return u
 }
 
-func (p *ListStoragePoolsParams) SetPath(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListElastistorPoolParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListElastistorPoolParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["path"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *ListStoragePoolsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListElastistorPoolParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["podid"] = v
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listElastistorPool", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listElastistorPool: %w", k, err)
+		}
+	}
+	return nil
 }
 
-func (p *ListStoragePoolsParams) SetScope(v string) {
+func (p *ListElastistorPoolParams) SetId(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["scope"] = v
+	p.p["id"] = v
+}
+
+func (p *ListElastistorPoolParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListElastistorPoolParams) GetId() (int64, bool) {
+	value, ok := p.p["id"].(int64)
+	return value, ok
+}
+
+// You should always use this function to get a new ListElastistorPoolParams instance,
+// as then you are sure you have configured all required params
+func (s *PoolService) NewListElastistorPoolParams() *ListElastistorPoolParams {
+	p := &ListElastistorPoolParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Lists the pools of elastistor
+func (s *PoolService) ListElastistorPool(p *ListElastistorPoolParams) (*ListElastistorPoolResponse, error) {
+	return s.ListElastistorPoolWithContext(context.Background(), p)
+}
+
+// ListElastistorPoolWithContext is the same as ListElastistorPool, but uses ctx to cancel the request and any async job polling
+func (s *PoolService) ListElastistorPoolWithContext(ctx context.Context, p *ListElastistorPoolParams) (*ListElastistorPoolResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listElastistorPool", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListElastistorPoolResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListElastistorPoolResponse struct {
+	Count          int               `json:"count"`
+	ElastistorPool []*ElastistorPool `json:"elastistorpool"`
+}
+
+type ElastistorPool struct {
+	Controllerid string `json:"controllerid"`
+	Gateway      string `json:"gateway"`
+	Id           string `json:"id"`
+	JobID        string `json:"jobid"`
+	Jobstatus    int    `json:"jobstatus"`
+	Maxiops      int64  `json:"maxiops"`
+	Name         string `json:"name"`
+	Size         int64  `json:"size"`
+	State        string `json:"state"`
+}
+
+type ListElastistorVolumeParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListElastistorVolumeParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListElastistorVolumeParams) Validate() error {
+	v := newParamValidator("listElastistorVolume", p.p)
+	v.required("id")
+	v.maxLength("id", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListElastistorVolumeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListElastistorVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listElastistorVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listElastistorVolume: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListElastistorVolumeParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListElastistorVolumeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListElastistorVolumeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListElastistorVolumeParams instance,
+// as then you are sure you have configured all required params
+func (s *PoolService) NewListElastistorVolumeParams(id string) *ListElastistorVolumeParams {
+	p := &ListElastistorVolumeParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *PoolService) GetElastistorVolumeByID(id string, opts ...OptionFunc) (*ElastistorVolume, int, error) {
+	return s.GetElastistorVolumeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetElastistorVolumeByIDWithContext is the same as GetElastistorVolumeByID, but uses ctx to cancel the request
+func (s *PoolService) GetElastistorVolumeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ElastistorVolume, int, error) {
+	p := &ListElastistorVolumeParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListElastistorVolumeWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.ElastistorVolume[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for ElastistorVolume UUID: %s!", id)
+}
+
+// Lists the volumes of elastistor
+func (s *PoolService) ListElastistorVolume(p *ListElastistorVolumeParams) (*ListElastistorVolumeResponse, error) {
+	return s.ListElastistorVolumeWithContext(context.Background(), p)
+}
+
+// ListElastistorVolumeWithContext is the same as ListElastistorVolume, but uses ctx to cancel the request and any async job polling
+func (s *PoolService) ListElastistorVolumeWithContext(ctx context.Context, p *ListElastistorVolumeParams) (*ListElastistorVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listElastistorVolume", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListElastistorVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListElastistorVolumeResponse struct {
+	Count            int                 `json:"count"`
+	ElastistorVolume []*ElastistorVolume `json:"elastistorvolume"`
+}
+
+type ElastistorVolume struct {
+	Compression   string `json:"compression"`
+	Deduplication string `json:"deduplication"`
+	Graceallowed  string `json:"graceallowed"`
+	Id            string `json:"id"`
+	JobID         string `json:"jobid"`
+	Jobstatus     int    `json:"jobstatus"`
+	Name          string `json:"name"`
+	Sync          string `json:"sync"`
+}
+
+type ListStoragePoolsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListStoragePoolsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["clusterid"]; found {
+		u.Set("clusterid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["ipaddress"]; found {
+		u.Set("ipaddress", v.(string))
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["path"]; found {
+		u.Set("path", v.(string))
+	}
+	if v, found := p.p["podid"]; found {
+		u.Set("podid", v.(string))
+	}
+	if v, found := p.p["scope"]; found {
+		u.Set("scope", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListStoragePoolsParams) Validate() error {
+	v := newParamValidator("listStoragePools", p.p)
+	v.ids("clusterid", "id", "podid", "zoneid")
+	v.maxLength("ipaddress", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("path", 255)
+	v.maxLength("scope", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListStoragePoolsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListStoragePoolsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid", "id", "ipaddress", "keyword", "name", "path", "podid", "scope", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listStoragePools", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listStoragePools: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListStoragePoolsParams) SetClusterid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["clusterid"] = v
+}
+
+func (p *ListStoragePoolsParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ListStoragePoolsParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListStoragePoolsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListStoragePoolsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsParams) SetIpaddress(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ipaddress"] = v
+}
+
+func (p *ListStoragePoolsParams) ResetIpaddress() {
+	delete(p.p, "ipaddress")
+}
+
+func (p *ListStoragePoolsParams) GetIpaddress() (string, bool) {
+	value, ok := p.p["ipaddress"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListStoragePoolsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListStoragePoolsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ListStoragePoolsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListStoragePoolsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListStoragePoolsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListStoragePoolsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListStoragePoolsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListStoragePoolsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListStoragePoolsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListStoragePoolsParams) SetPath(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["path"] = v
+}
+
+func (p *ListStoragePoolsParams) ResetPath() {
+	delete(p.p, "path")
+}
+
+func (p *ListStoragePoolsParams) GetPath() (string, bool) {
+	value, ok := p.p["path"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsParams) SetPodid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["podid"] = v
+}
+
+func (p *ListStoragePoolsParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListStoragePoolsParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsParams) SetScope(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["scope"] = v
+}
+
+func (p *ListStoragePoolsParams) ResetScope() {
+	delete(p.p, "scope")
+}
+
+func (p *ListStoragePoolsParams) GetScope() (string, bool) {
+	value, ok := p.p["scope"].(string)
+	return value, ok
 }
 
 func (p *ListStoragePoolsParams) SetZoneid(v string) {
@@ -554,118 +1512,957 @@ func (p *ListStoragePoolsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
-// You should always use this function to get a new ListStoragePoolsParams instance,
+func (p *ListStoragePoolsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListStoragePoolsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListStoragePoolsParams instance,
+// as then you are sure you have configured all required params
+func (s *PoolService) NewListStoragePoolsParams() *ListStoragePoolsParams {
+	p := &ListStoragePoolsParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *PoolService) GetStoragePoolID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetStoragePoolIDWithContext(context.Background(), name, opts...)
+}
+
+// GetStoragePoolIDWithContext is the same as GetStoragePoolID, but uses ctx to cancel the request
+func (s *PoolService) GetStoragePoolIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListStoragePoolsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["name"] = name
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListStoragePoolsWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.StoragePools[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.StoragePools {
+			if v.Name == name {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *PoolService) GetStoragePoolByName(name string, opts ...OptionFunc) (*StoragePool, int, error) {
+	return s.GetStoragePoolByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetStoragePoolByNameWithContext is the same as GetStoragePoolByName, but uses ctx to cancel the requests
+func (s *PoolService) GetStoragePoolByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*StoragePool, int, error) {
+	id, count, err := s.GetStoragePoolIDWithContext(ctx, name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetStoragePoolByIDWithContext(ctx, id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *PoolService) GetStoragePoolByID(id string, opts ...OptionFunc) (*StoragePool, int, error) {
+	return s.GetStoragePoolByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetStoragePoolByIDWithContext is the same as GetStoragePoolByID, but uses ctx to cancel the request
+func (s *PoolService) GetStoragePoolByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StoragePool, int, error) {
+	p := &ListStoragePoolsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListStoragePoolsWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.StoragePools[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for StoragePool UUID: %s!", id)
+}
+
+// Lists storage pools.
+func (s *PoolService) ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
+	return s.ListStoragePoolsWithContext(context.Background(), p)
+}
+
+// ListStoragePoolsWithContext is the same as ListStoragePools, but uses ctx to cancel the request and any async job polling
+func (s *PoolService) ListStoragePoolsWithContext(ctx context.Context, p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listStoragePools", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListStoragePoolsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListStoragePoolsPager iterates over all pages of a ListStoragePools call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListStoragePoolsPager struct {
+	pager
+
+	s *PoolService
+	p *ListStoragePoolsParams
+	r *ListStoragePoolsResponse
+}
+
+// NewListStoragePoolsPager returns a pager for all pages of a ListStoragePools call with the given params
+func (s *PoolService) NewListStoragePoolsPager(p *ListStoragePoolsParams) *ListStoragePoolsPager {
+	return &ListStoragePoolsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListStoragePoolsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListStoragePoolsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListStoragePoolsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListStoragePoolsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.StoragePools), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListStoragePoolsPager) Page() *ListStoragePoolsResponse {
+	return pg.r
+}
+
+// ListStoragePoolsAll fetches all pages of a ListStoragePools call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *PoolService) ListStoragePoolsAll(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
+	return s.ListStoragePoolsAllWithContext(context.Background(), p)
+}
+
+// ListStoragePoolsAllWithContext is the same as ListStoragePoolsAll, but uses ctx to cancel the requests
+func (s *PoolService) ListStoragePoolsAllWithContext(ctx context.Context, p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*StoragePool)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListStoragePoolsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListStoragePoolsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.StoragePools
+		mu.Unlock()
+
+		return len(l.StoragePools), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListStoragePoolsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.StoragePools = append(r.StoragePools, pages[page]...)
+	}
+	r.Count = len(r.StoragePools)
+
+	return r, nil
+}
+
+type ListStoragePoolsResponse struct {
+	Count        int            `json:"count"`
+	StoragePools []*StoragePool `json:"storagepool"`
+}
+
+type StoragePool struct {
+	Allocatediops        int64             `json:"allocatediops"`
+	Capacityiops         int64             `json:"capacityiops"`
+	Clusterid            string            `json:"clusterid"`
+	Clustername          string            `json:"clustername"`
+	Created              string            `json:"created"`
+	Disksizeallocated    int64             `json:"disksizeallocated"`
+	Disksizetotal        int64             `json:"disksizetotal"`
+	Disksizeused         int64             `json:"disksizeused"`
+	Hypervisor           string            `json:"hypervisor"`
+	Id                   string            `json:"id"`
+	Ipaddress            string            `json:"ipaddress"`
+	JobID                string            `json:"jobid"`
+	Jobstatus            int               `json:"jobstatus"`
+	Name                 string            `json:"name"`
+	Overprovisionfactor  string            `json:"overprovisionfactor"`
+	Path                 string            `json:"path"`
+	Podid                string            `json:"podid"`
+	Podname              string            `json:"podname"`
+	Provider             string            `json:"provider"`
+	Scope                string            `json:"scope"`
+	State                string            `json:"state"`
+	Storagecapabilities  map[string]string `json:"storagecapabilities"`
+	Suitableformigration bool              `json:"suitableformigration"`
+	Tags                 string            `json:"tags"`
+	Type                 string            `json:"type"`
+	Zoneid               string            `json:"zoneid"`
+	Zonename             string            `json:"zonename"`
+}
+
+type ListStoragePoolsMetricsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListStoragePoolsMetricsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["clusterid"]; found {
+		u.Set("clusterid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["ipaddress"]; found {
+		u.Set("ipaddress", v.(string))
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["path"]; found {
+		u.Set("path", v.(string))
+	}
+	if v, found := p.p["podid"]; found {
+		u.Set("podid", v.(string))
+	}
+	if v, found := p.p["scope"]; found {
+		u.Set("scope", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListStoragePoolsMetricsParams) Validate() error {
+	v := newParamValidator("listStoragePoolsMetrics", p.p)
+	v.ids("clusterid", "id", "podid", "zoneid")
+	v.maxLength("ipaddress", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("path", 255)
+	v.maxLength("scope", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListStoragePoolsMetricsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListStoragePoolsMetricsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid", "id", "ipaddress", "keyword", "name", "path", "podid", "scope", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listStoragePoolsMetrics", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listStoragePoolsMetrics: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListStoragePoolsMetricsParams) SetClusterid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["clusterid"] = v
+}
+
+func (p *ListStoragePoolsMetricsParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ListStoragePoolsMetricsParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsMetricsParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListStoragePoolsMetricsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListStoragePoolsMetricsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsMetricsParams) SetIpaddress(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ipaddress"] = v
+}
+
+func (p *ListStoragePoolsMetricsParams) ResetIpaddress() {
+	delete(p.p, "ipaddress")
+}
+
+func (p *ListStoragePoolsMetricsParams) GetIpaddress() (string, bool) {
+	value, ok := p.p["ipaddress"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsMetricsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListStoragePoolsMetricsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListStoragePoolsMetricsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsMetricsParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ListStoragePoolsMetricsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListStoragePoolsMetricsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsMetricsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListStoragePoolsMetricsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListStoragePoolsMetricsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListStoragePoolsMetricsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListStoragePoolsMetricsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListStoragePoolsMetricsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListStoragePoolsMetricsParams) SetPath(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["path"] = v
+}
+
+func (p *ListStoragePoolsMetricsParams) ResetPath() {
+	delete(p.p, "path")
+}
+
+func (p *ListStoragePoolsMetricsParams) GetPath() (string, bool) {
+	value, ok := p.p["path"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsMetricsParams) SetPodid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["podid"] = v
+}
+
+func (p *ListStoragePoolsMetricsParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListStoragePoolsMetricsParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsMetricsParams) SetScope(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["scope"] = v
+}
+
+func (p *ListStoragePoolsMetricsParams) ResetScope() {
+	delete(p.p, "scope")
+}
+
+func (p *ListStoragePoolsMetricsParams) GetScope() (string, bool) {
+	value, ok := p.p["scope"].(string)
+	return value, ok
+}
+
+func (p *ListStoragePoolsMetricsParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ListStoragePoolsMetricsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListStoragePoolsMetricsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListStoragePoolsMetricsParams instance,
 // as then you are sure you have configured all required params
-func (s *PoolService) NewListStoragePoolsParams() *ListStoragePoolsParams {
-	p := &ListStoragePoolsParams{}
+func (s *PoolService) NewListStoragePoolsMetricsParams() *ListStoragePoolsMetricsParams {
+	p := &ListStoragePoolsMetricsParams{}
 	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *PoolService) GetStoragePoolID(name string, opts ...OptionFunc) (string, int, error) {
-	p := &ListStoragePoolsParams{}
-	p.p = make(map[string]interface{})
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *PoolService) GetStoragePoolsMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetStoragePoolsMetricIDWithContext(context.Background(), name, opts...)
+}
+
+// GetStoragePoolsMetricIDWithContext is the same as GetStoragePoolsMetricID, but uses ctx to cancel the request
+func (s *PoolService) GetStoragePoolsMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListStoragePoolsMetricsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["name"] = name
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListStoragePoolsMetricsWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.StoragePoolsMetrics[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.StoragePoolsMetrics {
+			if v.Name == name {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *PoolService) GetStoragePoolsMetricByName(name string, opts ...OptionFunc) (*StoragePoolsMetric, int, error) {
+	return s.GetStoragePoolsMetricByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetStoragePoolsMetricByNameWithContext is the same as GetStoragePoolsMetricByName, but uses ctx to cancel the requests
+func (s *PoolService) GetStoragePoolsMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*StoragePoolsMetric, int, error) {
+	id, count, err := s.GetStoragePoolsMetricIDWithContext(ctx, name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetStoragePoolsMetricByIDWithContext(ctx, id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *PoolService) GetStoragePoolsMetricByID(id string, opts ...OptionFunc) (*StoragePoolsMetric, int, error) {
+	return s.GetStoragePoolsMetricByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetStoragePoolsMetricByIDWithContext is the same as GetStoragePoolsMetricByID, but uses ctx to cancel the request
+func (s *PoolService) GetStoragePoolsMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StoragePoolsMetric, int, error) {
+	p := &ListStoragePoolsMetricsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListStoragePoolsMetricsWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.StoragePoolsMetrics[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for StoragePoolsMetric UUID: %s!", id)
+}
+
+// Lists storage pool metrics
+func (s *PoolService) ListStoragePoolsMetrics(p *ListStoragePoolsMetricsParams) (*ListStoragePoolsMetricsResponse, error) {
+	return s.ListStoragePoolsMetricsWithContext(context.Background(), p)
+}
+
+// ListStoragePoolsMetricsWithContext is the same as ListStoragePoolsMetrics, but uses ctx to cancel the request and any async job polling
+func (s *PoolService) ListStoragePoolsMetricsWithContext(ctx context.Context, p *ListStoragePoolsMetricsParams) (*ListStoragePoolsMetricsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listStoragePoolsMetrics", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListStoragePoolsMetricsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListStoragePoolsMetricsPager iterates over all pages of a ListStoragePoolsMetrics call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListStoragePoolsMetricsPager struct {
+	pager
+
+	s *PoolService
+	p *ListStoragePoolsMetricsParams
+	r *ListStoragePoolsMetricsResponse
+}
+
+// NewListStoragePoolsMetricsPager returns a pager for all pages of a ListStoragePoolsMetrics call with the given params
+func (s *PoolService) NewListStoragePoolsMetricsPager(p *ListStoragePoolsMetricsParams) *ListStoragePoolsMetricsPager {
+	return &ListStoragePoolsMetricsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListStoragePoolsMetricsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListStoragePoolsMetricsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListStoragePoolsMetricsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListStoragePoolsMetricsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.StoragePoolsMetrics), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListStoragePoolsMetricsPager) Page() *ListStoragePoolsMetricsResponse {
+	return pg.r
+}
+
+// ListStoragePoolsMetricsAll fetches all pages of a ListStoragePoolsMetrics call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *PoolService) ListStoragePoolsMetricsAll(p *ListStoragePoolsMetricsParams) (*ListStoragePoolsMetricsResponse, error) {
+	return s.ListStoragePoolsMetricsAllWithContext(context.Background(), p)
+}
+
+// ListStoragePoolsMetricsAllWithContext is the same as ListStoragePoolsMetricsAll, but uses ctx to cancel the requests
+func (s *PoolService) ListStoragePoolsMetricsAllWithContext(ctx context.Context, p *ListStoragePoolsMetricsParams) (*ListStoragePoolsMetricsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*StoragePoolsMetric)
 
-	p.p["name"] = name
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListStoragePoolsMetricsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
 
-	for _, fn := range append(s.cs.options, opts...) {
-		if err := fn(s.cs, p); err != nil {
-			return "", -1, err
+		l, err := s.ListStoragePoolsMetricsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
 		}
-	}
 
-	l, err := s.ListStoragePools(p)
+		mu.Lock()
+		pages[page] = l.StoragePoolsMetrics
+		mu.Unlock()
+
+		return len(l.StoragePoolsMetrics), l.Count, nil
+	})
 	if err != nil {
-		return "", -1, err
+		return nil, err
 	}
 
-	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	r := &ListStoragePoolsMetricsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.StoragePoolsMetrics = append(r.StoragePoolsMetrics, pages[page]...)
+	}
+	r.Count = len(r.StoragePoolsMetrics)
+
+	return r, nil
+}
+
+type ListStoragePoolsMetricsResponse struct {
+	Count               int                   `json:"count"`
+	StoragePoolsMetrics []*StoragePoolsMetric `json:"storagepool"`
+}
+
+type StoragePoolsMetric struct {
+	Allocatediops                    int64             `json:"allocatediops"`
+	Capacityiops                     int64             `json:"capacityiops"`
+	Clusterid                        string            `json:"clusterid"`
+	Clustername                      string            `json:"clustername"`
+	Created                          string            `json:"created"`
+	Disksizeallocated                int64             `json:"disksizeallocated"`
+	Disksizeallocatedgb              string            `json:"disksizeallocatedgb"`
+	Disksizetotal                    int64             `json:"disksizetotal"`
+	Disksizetotalgb                  string            `json:"disksizetotalgb"`
+	Disksizeunallocatedgb            string            `json:"disksizeunallocatedgb"`
+	Disksizeused                     int64             `json:"disksizeused"`
+	Disksizeusedgb                   string            `json:"disksizeusedgb"`
+	Hypervisor                       string            `json:"hypervisor"`
+	Id                               string            `json:"id"`
+	Ipaddress                        string            `json:"ipaddress"`
+	JobID                            string            `json:"jobid"`
+	Jobstatus                        int               `json:"jobstatus"`
+	Name                             string            `json:"name"`
+	Overprovisionfactor              string            `json:"overprovisionfactor"`
+	Path                             string            `json:"path"`
+	Podid                            string            `json:"podid"`
+	Podname                          string            `json:"podname"`
+	Provider                         string            `json:"provider"`
+	Scope                            string            `json:"scope"`
+	State                            string            `json:"state"`
+	Storageallocateddisablethreshold bool              `json:"storageallocateddisablethreshold"`
+	Storageallocatedthreshold        bool              `json:"storageallocatedthreshold"`
+	Storagecapabilities              map[string]string `json:"storagecapabilities"`
+	Storageusagedisablethreshold     bool              `json:"storageusagedisablethreshold"`
+	Storageusagethreshold            bool              `json:"storageusagethreshold"`
+	Suitableformigration             bool              `json:"suitableformigration"`
+	Tags                             string            `json:"tags"`
+	Type                             string            `json:"type"`
+	Zoneid                           string            `json:"zoneid"`
+	Zonename                         string            `json:"zonename"`
+}
+
+type SyncStoragePoolParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *SyncStoragePoolParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
 	}
+	return u
+}
 
-	if l.Count == 1 {
-		return l.StoragePools[0].Id, l.Count, nil
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *SyncStoragePoolParams) Validate() error {
+	v := newParamValidator("syncStoragePool", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *SyncStoragePoolParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *SyncStoragePoolParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.StoragePools {
-			if v.Name == name {
-				return v.Id, l.Count, nil
-			}
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for syncStoragePool", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of syncStoragePool: %w", k, err)
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *PoolService) GetStoragePoolByName(name string, opts ...OptionFunc) (*StoragePool, int, error) {
-	id, count, err := s.GetStoragePoolID(name, opts...)
-	if err != nil {
-		return nil, count, err
+func (p *SyncStoragePoolParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
+	p.p["id"] = v
+}
 
-	r, count, err := s.GetStoragePoolByID(id, opts...)
-	if err != nil {
-		return nil, count, err
-	}
-	return r, count, nil
+func (p *SyncStoragePoolParams) ResetId() {
+	delete(p.p, "id")
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *PoolService) GetStoragePoolByID(id string, opts ...OptionFunc) (*StoragePool, int, error) {
-	p := &ListStoragePoolsParams{}
-	p.p = make(map[string]interface{})
+func (p *SyncStoragePoolParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
 
+// You should always use this function to get a new SyncStoragePoolParams instance,
+// as then you are sure you have configured all required params
+func (s *PoolService) NewSyncStoragePoolParams(id string) *SyncStoragePoolParams {
+	p := &SyncStoragePoolParams{}
+	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	return p
+}
 
-	for _, fn := range append(s.cs.options, opts...) {
-		if err := fn(s.cs, p); err != nil {
-			return nil, -1, err
-		}
+// Sync storage pool with management server (currently supported for Datastore Cluster in VMware and syncs the datastores in it)
+func (s *PoolService) SyncStoragePool(p *SyncStoragePoolParams) (*SyncStoragePoolResponse, error) {
+	return s.SyncStoragePoolWithContext(context.Background(), p)
+}
+
+// SyncStoragePoolWithContext is the same as SyncStoragePool, but uses ctx to cancel the request and any async job polling
+func (s *PoolService) SyncStoragePoolWithContext(ctx context.Context, p *SyncStoragePoolParams) (*SyncStoragePoolResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
 
-	l, err := s.ListStoragePools(p)
+	resp, err := s.cs.newRequestWithContext(ctx, "syncStoragePool", p.ToURLValues())
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+		return nil, err
 	}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	var r SyncStoragePoolResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
 
-	if l.Count == 1 {
-		return l.StoragePools[0], l.Count, nil
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for StoragePool UUID: %s!", id)
+
+	return &r, nil
 }
 
-// Lists storage pools.
-func (s *PoolService) ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
-	resp, err := s.cs.newRequest("listStoragePools", p.toURLValues())
+// SyncStoragePoolAsync starts the async job for SyncStoragePool and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a SyncStoragePoolResponse using Job.Result
+func (s *PoolService) SyncStoragePoolAsync(p *SyncStoragePoolParams) (*Job, error) {
+	return s.SyncStoragePoolAsyncWithContext(context.Background(), p)
+}
+
+// SyncStoragePoolAsyncWithContext is the same as SyncStoragePoolAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *PoolService) SyncStoragePoolAsyncWithContext(ctx context.Context, p *SyncStoragePoolParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "syncStoragePool", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListStoragePoolsResponse
+	var r SyncStoragePoolResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
-	return &r, nil
-}
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
 
-type ListStoragePoolsResponse struct {
-	Count        int            `json:"count"`
-	StoragePools []*StoragePool `json:"storagepool"`
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
 }
 
-type StoragePool struct {
+type SyncStoragePoolResponse struct {
 	Allocatediops        int64             `json:"allocatediops"`
 	Capacityiops         int64             `json:"capacityiops"`
 	Clusterid            string            `json:"clusterid"`
@@ -695,11 +2492,212 @@ type StoragePool struct {
 	Zonename             string            `json:"zonename"`
 }
 
+type UpdateSiocInfoParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateSiocInfoParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["iopsnotifythreshold"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("iopsnotifythreshold", vv)
+	}
+	if v, found := p.p["limitiopspergb"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("limitiopspergb", vv)
+	}
+	if v, found := p.p["sharespergb"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("sharespergb", vv)
+	}
+	if v, found := p.p["storageid"]; found {
+		u.Set("storageid", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateSiocInfoParams) Validate() error {
+	v := newParamValidator("updateSiocInfo", p.p)
+	v.required("iopsnotifythreshold", "limitiopspergb", "sharespergb", "storageid", "zoneid")
+	v.ids("storageid", "zoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateSiocInfoParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateSiocInfoParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "iopsnotifythreshold", "limitiopspergb", "sharespergb":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "storageid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateSiocInfo", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateSiocInfo: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *UpdateSiocInfoParams) SetIopsnotifythreshold(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["iopsnotifythreshold"] = v
+}
+
+func (p *UpdateSiocInfoParams) ResetIopsnotifythreshold() {
+	delete(p.p, "iopsnotifythreshold")
+}
+
+func (p *UpdateSiocInfoParams) GetIopsnotifythreshold() (int, bool) {
+	value, ok := p.p["iopsnotifythreshold"].(int)
+	return value, ok
+}
+
+func (p *UpdateSiocInfoParams) SetLimitiopspergb(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["limitiopspergb"] = v
+}
+
+func (p *UpdateSiocInfoParams) ResetLimitiopspergb() {
+	delete(p.p, "limitiopspergb")
+}
+
+func (p *UpdateSiocInfoParams) GetLimitiopspergb() (int, bool) {
+	value, ok := p.p["limitiopspergb"].(int)
+	return value, ok
+}
+
+func (p *UpdateSiocInfoParams) SetSharespergb(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["sharespergb"] = v
+}
+
+func (p *UpdateSiocInfoParams) ResetSharespergb() {
+	delete(p.p, "sharespergb")
+}
+
+func (p *UpdateSiocInfoParams) GetSharespergb() (int, bool) {
+	value, ok := p.p["sharespergb"].(int)
+	return value, ok
+}
+
+func (p *UpdateSiocInfoParams) SetStorageid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["storageid"] = v
+}
+
+func (p *UpdateSiocInfoParams) ResetStorageid() {
+	delete(p.p, "storageid")
+}
+
+func (p *UpdateSiocInfoParams) GetStorageid() (string, bool) {
+	value, ok := p.p["storageid"].(string)
+	return value, ok
+}
+
+func (p *UpdateSiocInfoParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *UpdateSiocInfoParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *UpdateSiocInfoParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new UpdateSiocInfoParams instance,
+// as then you are sure you have configured all required params
+func (s *PoolService) NewUpdateSiocInfoParams(iopsnotifythreshold int, limitiopspergb int, sharespergb int, storageid string, zoneid string) *UpdateSiocInfoParams {
+	p := &UpdateSiocInfoParams{}
+	p.p = make(map[string]interface{})
+	p.p["iopsnotifythreshold"] = iopsnotifythreshold
+	p.p["limitiopspergb"] = limitiopspergb
+	p.p["sharespergb"] = sharespergb
+	p.p["storageid"] = storageid
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// Update SIOC info
+func (s *PoolService) UpdateSiocInfo(p *UpdateSiocInfoParams) (*UpdateSiocInfoResponse, error) {
+	return s.UpdateSiocInfoWithContext(context.Background(), p)
+}
+
+// UpdateSiocInfoWithContext is the same as UpdateSiocInfo, but uses ctx to cancel the request and any async job polling
+func (s *PoolService) UpdateSiocInfoWithContext(ctx context.Context, p *UpdateSiocInfoParams) (*UpdateSiocInfoResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateSiocInfo", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateSiocInfoResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type UpdateSiocInfoResponse struct {
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Msg       string `json:"msg"`
+}
+
 type UpdateStoragePoolParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateStoragePoolParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateStoragePoolParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -729,6 +2727,61 @@ func (p *UpdateStoragePoolParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateStoragePoolParams) Validate() error {
+	v := newParamValidator("updateStoragePool", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateStoragePoolParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateStoragePoolParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "capacitybytes", "capacityiops":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "enabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateStoragePool", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateStoragePool: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateStoragePoolParams) SetCapacitybytes(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -736,6 +2789,15 @@ func (p *UpdateStoragePoolParams) SetCapacitybytes(v int64) {
 	p.p["capacitybytes"] = v
 }
 
+func (p *UpdateStoragePoolParams) ResetCapacitybytes() {
+	delete(p.p, "capacitybytes")
+}
+
+func (p *UpdateStoragePoolParams) GetCapacitybytes() (int64, bool) {
+	value, ok := p.p["capacitybytes"].(int64)
+	return value, ok
+}
+
 func (p *UpdateStoragePoolParams) SetCapacityiops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -743,6 +2805,15 @@ func (p *UpdateStoragePoolParams) SetCapacityiops(v int64) {
 	p.p["capacityiops"] = v
 }
 
+func (p *UpdateStoragePoolParams) ResetCapacityiops() {
+	delete(p.p, "capacityiops")
+}
+
+func (p *UpdateStoragePoolParams) GetCapacityiops() (int64, bool) {
+	value, ok := p.p["capacityiops"].(int64)
+	return value, ok
+}
+
 func (p *UpdateStoragePoolParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -750,6 +2821,15 @@ func (p *UpdateStoragePoolParams) SetEnabled(v bool) {
 	p.p["enabled"] = v
 }
 
+func (p *UpdateStoragePoolParams) ResetEnabled() {
+	delete(p.p, "enabled")
+}
+
+func (p *UpdateStoragePoolParams) GetEnabled() (bool, bool) {
+	value, ok := p.p["enabled"].(bool)
+	return value, ok
+}
+
 func (p *UpdateStoragePoolParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -757,6 +2837,15 @@ func (p *UpdateStoragePoolParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateStoragePoolParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateStoragePoolParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateStoragePoolParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -764,6 +2853,15 @@ func (p *UpdateStoragePoolParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateStoragePoolParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateStoragePoolParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateStoragePoolParams) SetTags(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -771,6 +2869,15 @@ func (p *UpdateStoragePoolParams) SetTags(v []string) {
 	p.p["tags"] = v
 }
 
+func (p *UpdateStoragePoolParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *UpdateStoragePoolParams) GetTags() ([]string, bool) {
+	value, ok := p.p["tags"].([]string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateStoragePoolParams instance,
 // as then you are sure you have configured all required params
 func (s *PoolService) NewUpdateStoragePoolParams(id string) *UpdateStoragePoolParams {
@@ -782,7 +2889,16 @@ func (s *PoolService) NewUpdateStoragePoolParams(id string) *UpdateStoragePoolPa
 
 // Updates a storage pool.
 func (s *PoolService) UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("updateStoragePool", p.toURLValues())
+	return s.UpdateStoragePoolWithContext(context.Background(), p)
+}
+
+// UpdateStoragePoolWithContext is the same as UpdateStoragePool, but uses ctx to cancel the request and any async job polling
+func (s *PoolService) UpdateStoragePoolWithContext(ctx context.Context, p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateStoragePool", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
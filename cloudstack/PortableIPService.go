@@ -20,18 +20,43 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// PortableIPServiceIface is the interface implemented by PortableIPService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type PortableIPServiceIface interface {
+	NewCreatePortableIpRangeParams(endip string, gateway string, netmask string, regionid int, startip string) *CreatePortableIpRangeParams
+	CreatePortableIpRange(p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error)
+	CreatePortableIpRangeWithContext(ctx context.Context, p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error)
+	CreatePortableIpRangeAsync(p *CreatePortableIpRangeParams) (*Job, error)
+	CreatePortableIpRangeAsyncWithContext(ctx context.Context, p *CreatePortableIpRangeParams) (*Job, error)
+	NewDeletePortableIpRangeParams(id string) *DeletePortableIpRangeParams
+	DeletePortableIpRange(p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error)
+	DeletePortableIpRangeWithContext(ctx context.Context, p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error)
+	DeletePortableIpRangeAsync(p *DeletePortableIpRangeParams) (*Job, error)
+	DeletePortableIpRangeAsyncWithContext(ctx context.Context, p *DeletePortableIpRangeParams) (*Job, error)
+	NewListPortableIpRangesParams() *ListPortableIpRangesParams
+	GetPortableIpRangeByID(id string, opts ...OptionFunc) (*PortableIpRange, int, error)
+	GetPortableIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PortableIpRange, int, error)
+	ListPortableIpRanges(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error)
+	ListPortableIpRangesWithContext(ctx context.Context, p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error)
+	NewListPortableIpRangesPager(p *ListPortableIpRangesParams) *ListPortableIpRangesPager
+	ListPortableIpRangesAll(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error)
+	ListPortableIpRangesAllWithContext(ctx context.Context, p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error)
+}
+
 type CreatePortableIpRangeParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreatePortableIpRangeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreatePortableIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -58,6 +83,56 @@ func (p *CreatePortableIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreatePortableIpRangeParams) Validate() error {
+	v := newParamValidator("createPortableIpRange", p.p)
+	v.required("endip", "gateway", "netmask", "regionid", "startip")
+	v.maxLength("endip", 255)
+	v.maxLength("gateway", 255)
+	v.maxLength("netmask", 255)
+	v.maxLength("startip", 255)
+	v.maxLength("vlan", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreatePortableIpRangeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreatePortableIpRangeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "endip", "gateway", "netmask", "startip", "vlan":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "regionid":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createPortableIpRange", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createPortableIpRange: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreatePortableIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -65,6 +140,15 @@ func (p *CreatePortableIpRangeParams) SetEndip(v string) {
 	p.p["endip"] = v
 }
 
+func (p *CreatePortableIpRangeParams) ResetEndip() {
+	delete(p.p, "endip")
+}
+
+func (p *CreatePortableIpRangeParams) GetEndip() (string, bool) {
+	value, ok := p.p["endip"].(string)
+	return value, ok
+}
+
 func (p *CreatePortableIpRangeParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -72,6 +156,15 @@ func (p *CreatePortableIpRangeParams) SetGateway(v string) {
 	p.p["gateway"] = v
 }
 
+func (p *CreatePortableIpRangeParams) ResetGateway() {
+	delete(p.p, "gateway")
+}
+
+func (p *CreatePortableIpRangeParams) GetGateway() (string, bool) {
+	value, ok := p.p["gateway"].(string)
+	return value, ok
+}
+
 func (p *CreatePortableIpRangeParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +172,15 @@ func (p *CreatePortableIpRangeParams) SetNetmask(v string) {
 	p.p["netmask"] = v
 }
 
+func (p *CreatePortableIpRangeParams) ResetNetmask() {
+	delete(p.p, "netmask")
+}
+
+func (p *CreatePortableIpRangeParams) GetNetmask() (string, bool) {
+	value, ok := p.p["netmask"].(string)
+	return value, ok
+}
+
 func (p *CreatePortableIpRangeParams) SetRegionid(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -86,6 +188,15 @@ func (p *CreatePortableIpRangeParams) SetRegionid(v int) {
 	p.p["regionid"] = v
 }
 
+func (p *CreatePortableIpRangeParams) ResetRegionid() {
+	delete(p.p, "regionid")
+}
+
+func (p *CreatePortableIpRangeParams) GetRegionid() (int, bool) {
+	value, ok := p.p["regionid"].(int)
+	return value, ok
+}
+
 func (p *CreatePortableIpRangeParams) SetStartip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -93,6 +204,15 @@ func (p *CreatePortableIpRangeParams) SetStartip(v string) {
 	p.p["startip"] = v
 }
 
+func (p *CreatePortableIpRangeParams) ResetStartip() {
+	delete(p.p, "startip")
+}
+
+func (p *CreatePortableIpRangeParams) GetStartip() (string, bool) {
+	value, ok := p.p["startip"].(string)
+	return value, ok
+}
+
 func (p *CreatePortableIpRangeParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -100,6 +220,15 @@ func (p *CreatePortableIpRangeParams) SetVlan(v string) {
 	p.p["vlan"] = v
 }
 
+func (p *CreatePortableIpRangeParams) ResetVlan() {
+	delete(p.p, "vlan")
+}
+
+func (p *CreatePortableIpRangeParams) GetVlan() (string, bool) {
+	value, ok := p.p["vlan"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreatePortableIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *PortableIPService) NewCreatePortableIpRangeParams(endip string, gateway string, netmask string, regionid int, startip string) *CreatePortableIpRangeParams {
@@ -115,7 +244,16 @@ func (s *PortableIPService) NewCreatePortableIpRangeParams(endip string, gateway
 
 // adds a range of portable public IP's to a region
 func (s *PortableIPService) CreatePortableIpRange(p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("createPortableIpRange", p.toURLValues())
+	return s.CreatePortableIpRangeWithContext(context.Background(), p)
+}
+
+// CreatePortableIpRangeWithContext is the same as CreatePortableIpRange, but uses ctx to cancel the request and any async job polling
+func (s *PortableIPService) CreatePortableIpRangeWithContext(ctx context.Context, p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createPortableIpRange", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +265,7 @@ func (s *PortableIPService) CreatePortableIpRange(p *CreatePortableIpRangeParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -148,6 +286,40 @@ func (s *PortableIPService) CreatePortableIpRange(p *CreatePortableIpRangeParams
 	return &r, nil
 }
 
+// CreatePortableIpRangeAsync starts the async job for CreatePortableIpRange and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreatePortableIpRangeResponse using Job.Result
+func (s *PortableIPService) CreatePortableIpRangeAsync(p *CreatePortableIpRangeParams) (*Job, error) {
+	return s.CreatePortableIpRangeAsyncWithContext(context.Background(), p)
+}
+
+// CreatePortableIpRangeAsyncWithContext is the same as CreatePortableIpRangeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *PortableIPService) CreatePortableIpRangeAsyncWithContext(ctx context.Context, p *CreatePortableIpRangeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createPortableIpRange", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreatePortableIpRangeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreatePortableIpRangeResponse struct {
 	Endip             string                                           `json:"endip"`
 	Gateway           string                                           `json:"gateway"`
@@ -178,7 +350,8 @@ type DeletePortableIpRangeParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeletePortableIpRangeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeletePortableIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -189,6 +362,48 @@ func (p *DeletePortableIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeletePortableIpRangeParams) Validate() error {
+	v := newParamValidator("deletePortableIpRange", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeletePortableIpRangeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeletePortableIpRangeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deletePortableIpRange", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deletePortableIpRange: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeletePortableIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -196,6 +411,15 @@ func (p *DeletePortableIpRangeParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeletePortableIpRangeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeletePortableIpRangeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeletePortableIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *PortableIPService) NewDeletePortableIpRangeParams(id string) *DeletePortableIpRangeParams {
@@ -207,7 +431,16 @@ func (s *PortableIPService) NewDeletePortableIpRangeParams(id string) *DeletePor
 
 // deletes a range of portable public IP's associated with a region
 func (s *PortableIPService) DeletePortableIpRange(p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("deletePortableIpRange", p.toURLValues())
+	return s.DeletePortableIpRangeWithContext(context.Background(), p)
+}
+
+// DeletePortableIpRangeWithContext is the same as DeletePortableIpRange, but uses ctx to cancel the request and any async job polling
+func (s *PortableIPService) DeletePortableIpRangeWithContext(ctx context.Context, p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePortableIpRange", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -219,7 +452,7 @@ func (s *PortableIPService) DeletePortableIpRange(p *DeletePortableIpRangeParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -235,6 +468,31 @@ func (s *PortableIPService) DeletePortableIpRange(p *DeletePortableIpRangeParams
 	return &r, nil
 }
 
+// DeletePortableIpRangeAsync starts the async job for DeletePortableIpRange and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeletePortableIpRangeResponse using Job.Result
+func (s *PortableIPService) DeletePortableIpRangeAsync(p *DeletePortableIpRangeParams) (*Job, error) {
+	return s.DeletePortableIpRangeAsyncWithContext(context.Background(), p)
+}
+
+// DeletePortableIpRangeAsyncWithContext is the same as DeletePortableIpRangeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *PortableIPService) DeletePortableIpRangeAsyncWithContext(ctx context.Context, p *DeletePortableIpRangeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePortableIpRange", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeletePortableIpRangeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeletePortableIpRangeResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -246,7 +504,8 @@ type ListPortableIpRangesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListPortableIpRangesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListPortableIpRangesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -272,6 +531,52 @@ func (p *ListPortableIpRangesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListPortableIpRangesParams) Validate() error {
+	v := newParamValidator("listPortableIpRanges", p.p)
+	v.ids("id")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListPortableIpRangesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListPortableIpRangesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize", "regionid":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listPortableIpRanges", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listPortableIpRanges: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListPortableIpRangesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -279,6 +584,15 @@ func (p *ListPortableIpRangesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListPortableIpRangesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListPortableIpRangesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListPortableIpRangesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -286,6 +600,15 @@ func (p *ListPortableIpRangesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListPortableIpRangesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListPortableIpRangesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListPortableIpRangesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -293,6 +616,15 @@ func (p *ListPortableIpRangesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListPortableIpRangesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListPortableIpRangesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListPortableIpRangesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -300,6 +632,15 @@ func (p *ListPortableIpRangesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListPortableIpRangesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListPortableIpRangesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListPortableIpRangesParams) SetRegionid(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -307,6 +648,15 @@ func (p *ListPortableIpRangesParams) SetRegionid(v int) {
 	p.p["regionid"] = v
 }
 
+func (p *ListPortableIpRangesParams) ResetRegionid() {
+	delete(p.p, "regionid")
+}
+
+func (p *ListPortableIpRangesParams) GetRegionid() (int, bool) {
+	value, ok := p.p["regionid"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListPortableIpRangesParams instance,
 // as then you are sure you have configured all required params
 func (s *PortableIPService) NewListPortableIpRangesParams() *ListPortableIpRangesParams {
@@ -317,6 +667,11 @@ func (s *PortableIPService) NewListPortableIpRangesParams() *ListPortableIpRange
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PortableIPService) GetPortableIpRangeByID(id string, opts ...OptionFunc) (*PortableIpRange, int, error) {
+	return s.GetPortableIpRangeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetPortableIpRangeByIDWithContext is the same as GetPortableIpRangeByID, but uses ctx to cancel the request
+func (s *PortableIPService) GetPortableIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PortableIpRange, int, error) {
 	p := &ListPortableIpRangesParams{}
 	p.p = make(map[string]interface{})
 
@@ -328,18 +683,16 @@ func (s *PortableIPService) GetPortableIpRangeByID(id string, opts ...OptionFunc
 		}
 	}
 
-	l, err := s.ListPortableIpRanges(p)
+	l, err := s.ListPortableIpRangesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -350,7 +703,16 @@ func (s *PortableIPService) GetPortableIpRangeByID(id string, opts ...OptionFunc
 
 // list portable IP ranges
 func (s *PortableIPService) ListPortableIpRanges(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error) {
-	resp, err := s.cs.newRequest("listPortableIpRanges", p.toURLValues())
+	return s.ListPortableIpRangesWithContext(context.Background(), p)
+}
+
+// ListPortableIpRangesWithContext is the same as ListPortableIpRanges, but uses ctx to cancel the request and any async job polling
+func (s *PortableIPService) ListPortableIpRangesWithContext(ctx context.Context, p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listPortableIpRanges", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -363,6 +725,92 @@ func (s *PortableIPService) ListPortableIpRanges(p *ListPortableIpRangesParams)
 	return &r, nil
 }
 
+// ListPortableIpRangesPager iterates over all pages of a ListPortableIpRanges call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListPortableIpRangesPager struct {
+	pager
+
+	s *PortableIPService
+	p *ListPortableIpRangesParams
+	r *ListPortableIpRangesResponse
+}
+
+// NewListPortableIpRangesPager returns a pager for all pages of a ListPortableIpRanges call with the given params
+func (s *PortableIPService) NewListPortableIpRangesPager(p *ListPortableIpRangesParams) *ListPortableIpRangesPager {
+	return &ListPortableIpRangesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListPortableIpRangesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListPortableIpRangesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListPortableIpRangesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListPortableIpRangesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.PortableIpRanges), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListPortableIpRangesPager) Page() *ListPortableIpRangesResponse {
+	return pg.r
+}
+
+// ListPortableIpRangesAll fetches all pages of a ListPortableIpRanges call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *PortableIPService) ListPortableIpRangesAll(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error) {
+	return s.ListPortableIpRangesAllWithContext(context.Background(), p)
+}
+
+// ListPortableIpRangesAllWithContext is the same as ListPortableIpRangesAll, but uses ctx to cancel the requests
+func (s *PortableIPService) ListPortableIpRangesAllWithContext(ctx context.Context, p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*PortableIpRange)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListPortableIpRangesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListPortableIpRangesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.PortableIpRanges
+		mu.Unlock()
+
+		return len(l.PortableIpRanges), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListPortableIpRangesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.PortableIpRanges = append(r.PortableIpRanges, pages[page]...)
+	}
+	r.Count = len(r.PortableIpRanges)
+
+	return r, nil
+}
+
 type ListPortableIpRangesResponse struct {
 	Count            int                `json:"count"`
 	PortableIpRanges []*PortableIpRange `json:"portableiprange"`
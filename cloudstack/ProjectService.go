@@ -20,18 +20,117 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// ProjectServiceIface is the interface implemented by ProjectService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type ProjectServiceIface interface {
+	NewActivateProjectParams(id string) *ActivateProjectParams
+	ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error)
+	ActivateProjectWithContext(ctx context.Context, p *ActivateProjectParams) (*ActivateProjectResponse, error)
+	ActivateProjectAsync(p *ActivateProjectParams) (*Job, error)
+	ActivateProjectAsyncWithContext(ctx context.Context, p *ActivateProjectParams) (*Job, error)
+	NewAddUserToProjectParams(projectid string, username string) *AddUserToProjectParams
+	AddUserToProject(p *AddUserToProjectParams) (*AddUserToProjectResponse, error)
+	AddUserToProjectWithContext(ctx context.Context, p *AddUserToProjectParams) (*AddUserToProjectResponse, error)
+	AddUserToProjectAsync(p *AddUserToProjectParams) (*Job, error)
+	AddUserToProjectAsyncWithContext(ctx context.Context, p *AddUserToProjectParams) (*Job, error)
+	NewCreateProjectParams(displaytext string, name string) *CreateProjectParams
+	CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error)
+	CreateProjectWithContext(ctx context.Context, p *CreateProjectParams) (*CreateProjectResponse, error)
+	CreateProjectAsync(p *CreateProjectParams) (*Job, error)
+	CreateProjectAsyncWithContext(ctx context.Context, p *CreateProjectParams) (*Job, error)
+	NewCreateProjectRoleParams(name string, projectid string) *CreateProjectRoleParams
+	CreateProjectRole(p *CreateProjectRoleParams) (*CreateProjectRoleResponse, error)
+	CreateProjectRoleWithContext(ctx context.Context, p *CreateProjectRoleParams) (*CreateProjectRoleResponse, error)
+	NewCreateProjectRolePermissionParams(permission string, projectid string, projectroleid string, rule string) *CreateProjectRolePermissionParams
+	CreateProjectRolePermission(p *CreateProjectRolePermissionParams) (*CreateProjectRolePermissionResponse, error)
+	CreateProjectRolePermissionWithContext(ctx context.Context, p *CreateProjectRolePermissionParams) (*CreateProjectRolePermissionResponse, error)
+	NewDeleteProjectParams(id string) *DeleteProjectParams
+	DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error)
+	DeleteProjectWithContext(ctx context.Context, p *DeleteProjectParams) (*DeleteProjectResponse, error)
+	DeleteProjectAsync(p *DeleteProjectParams) (*Job, error)
+	DeleteProjectAsyncWithContext(ctx context.Context, p *DeleteProjectParams) (*Job, error)
+	NewDeleteProjectInvitationParams(id string) *DeleteProjectInvitationParams
+	DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error)
+	DeleteProjectInvitationWithContext(ctx context.Context, p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error)
+	DeleteProjectInvitationAsync(p *DeleteProjectInvitationParams) (*Job, error)
+	DeleteProjectInvitationAsyncWithContext(ctx context.Context, p *DeleteProjectInvitationParams) (*Job, error)
+	NewDeleteProjectRoleParams(id string, projectid string) *DeleteProjectRoleParams
+	DeleteProjectRole(p *DeleteProjectRoleParams) (*DeleteProjectRoleResponse, error)
+	DeleteProjectRoleWithContext(ctx context.Context, p *DeleteProjectRoleParams) (*DeleteProjectRoleResponse, error)
+	NewDeleteProjectRolePermissionParams(id string, projectid string) *DeleteProjectRolePermissionParams
+	DeleteProjectRolePermission(p *DeleteProjectRolePermissionParams) (*DeleteProjectRolePermissionResponse, error)
+	DeleteProjectRolePermissionWithContext(ctx context.Context, p *DeleteProjectRolePermissionParams) (*DeleteProjectRolePermissionResponse, error)
+	NewDeleteUserFromProjectParams(projectid string, userid string) *DeleteUserFromProjectParams
+	DeleteUserFromProject(p *DeleteUserFromProjectParams) (*DeleteUserFromProjectResponse, error)
+	DeleteUserFromProjectWithContext(ctx context.Context, p *DeleteUserFromProjectParams) (*DeleteUserFromProjectResponse, error)
+	DeleteUserFromProjectAsync(p *DeleteUserFromProjectParams) (*Job, error)
+	DeleteUserFromProjectAsyncWithContext(ctx context.Context, p *DeleteUserFromProjectParams) (*Job, error)
+	NewListProjectInvitationsParams() *ListProjectInvitationsParams
+	GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error)
+	GetProjectInvitationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ProjectInvitation, int, error)
+	ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error)
+	ListProjectInvitationsWithContext(ctx context.Context, p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error)
+	NewListProjectInvitationsPager(p *ListProjectInvitationsParams) *ListProjectInvitationsPager
+	ListProjectInvitationsAll(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error)
+	ListProjectInvitationsAllWithContext(ctx context.Context, p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error)
+	NewListProjectRolePermissionsParams(projectid string) *ListProjectRolePermissionsParams
+	ListProjectRolePermissions(p *ListProjectRolePermissionsParams) (*ListProjectRolePermissionsResponse, error)
+	ListProjectRolePermissionsWithContext(ctx context.Context, p *ListProjectRolePermissionsParams) (*ListProjectRolePermissionsResponse, error)
+	NewListProjectRolesParams(projectid string) *ListProjectRolesParams
+	GetProjectRoleID(name string, projectid string, opts ...OptionFunc) (string, int, error)
+	GetProjectRoleIDWithContext(ctx context.Context, name string, projectid string, opts ...OptionFunc) (string, int, error)
+	ListProjectRoles(p *ListProjectRolesParams) (*ListProjectRolesResponse, error)
+	ListProjectRolesWithContext(ctx context.Context, p *ListProjectRolesParams) (*ListProjectRolesResponse, error)
+	NewListProjectsParams() *ListProjectsParams
+	GetProjectID(name string, opts ...OptionFunc) (string, int, error)
+	GetProjectIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetProjectByName(name string, opts ...OptionFunc) (*Project, int, error)
+	GetProjectByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Project, int, error)
+	GetProjectByID(id string, opts ...OptionFunc) (*Project, int, error)
+	GetProjectByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Project, int, error)
+	ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error)
+	ListProjectsWithContext(ctx context.Context, p *ListProjectsParams) (*ListProjectsResponse, error)
+	NewListProjectsPager(p *ListProjectsParams) *ListProjectsPager
+	ListProjectsAll(p *ListProjectsParams) (*ListProjectsResponse, error)
+	ListProjectsAllWithContext(ctx context.Context, p *ListProjectsParams) (*ListProjectsResponse, error)
+	NewSuspendProjectParams(id string) *SuspendProjectParams
+	SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error)
+	SuspendProjectWithContext(ctx context.Context, p *SuspendProjectParams) (*SuspendProjectResponse, error)
+	SuspendProjectAsync(p *SuspendProjectParams) (*Job, error)
+	SuspendProjectAsyncWithContext(ctx context.Context, p *SuspendProjectParams) (*Job, error)
+	NewUpdateProjectParams(id string) *UpdateProjectParams
+	UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error)
+	UpdateProjectWithContext(ctx context.Context, p *UpdateProjectParams) (*UpdateProjectResponse, error)
+	UpdateProjectAsync(p *UpdateProjectParams) (*Job, error)
+	UpdateProjectAsyncWithContext(ctx context.Context, p *UpdateProjectParams) (*Job, error)
+	NewUpdateProjectInvitationParams(projectid string) *UpdateProjectInvitationParams
+	UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error)
+	UpdateProjectInvitationWithContext(ctx context.Context, p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error)
+	UpdateProjectInvitationAsync(p *UpdateProjectInvitationParams) (*Job, error)
+	UpdateProjectInvitationAsyncWithContext(ctx context.Context, p *UpdateProjectInvitationParams) (*Job, error)
+	NewUpdateProjectRoleParams(id string, projectid string) *UpdateProjectRoleParams
+	UpdateProjectRole(p *UpdateProjectRoleParams) (*UpdateProjectRoleResponse, error)
+	UpdateProjectRoleWithContext(ctx context.Context, p *UpdateProjectRoleParams) (*UpdateProjectRoleResponse, error)
+	NewUpdateProjectRolePermissionParams(projectid string, projectroleid string) *UpdateProjectRolePermissionParams
+	UpdateProjectRolePermission(p *UpdateProjectRolePermissionParams) (*UpdateProjectRolePermissionResponse, error)
+	UpdateProjectRolePermissionWithContext(ctx context.Context, p *UpdateProjectRolePermissionParams) (*UpdateProjectRolePermissionResponse, error)
+}
+
 type ActivateProjectParams struct {
 	p map[string]interface{}
 }
 
-func (p *ActivateProjectParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ActivateProjectParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -42,6 +141,48 @@ func (p *ActivateProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ActivateProjectParams) Validate() error {
+	v := newParamValidator("activateProject", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ActivateProjectParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ActivateProjectParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for activateProject", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of activateProject: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ActivateProjectParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -49,6 +190,15 @@ func (p *ActivateProjectParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ActivateProjectParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ActivateProjectParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ActivateProjectParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewActivateProjectParams(id string) *ActivateProjectParams {
@@ -60,7 +210,16 @@ func (s *ProjectService) NewActivateProjectParams(id string) *ActivateProjectPar
 
 // Activates a project
 func (s *ProjectService) ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error) {
-	resp, err := s.cs.newRequest("activateProject", p.toURLValues())
+	return s.ActivateProjectWithContext(context.Background(), p)
+}
+
+// ActivateProjectWithContext is the same as ActivateProject, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) ActivateProjectWithContext(ctx context.Context, p *ActivateProjectParams) (*ActivateProjectResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "activateProject", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +231,7 @@ func (s *ProjectService) ActivateProject(p *ActivateProjectParams) (*ActivatePro
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -93,6 +252,40 @@ func (s *ProjectService) ActivateProject(p *ActivateProjectParams) (*ActivatePro
 	return &r, nil
 }
 
+// ActivateProjectAsync starts the async job for ActivateProject and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ActivateProjectResponse using Job.Result
+func (s *ProjectService) ActivateProjectAsync(p *ActivateProjectParams) (*Job, error) {
+	return s.ActivateProjectAsyncWithContext(context.Background(), p)
+}
+
+// ActivateProjectAsyncWithContext is the same as ActivateProjectAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ProjectService) ActivateProjectAsyncWithContext(ctx context.Context, p *ActivateProjectParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "activateProject", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ActivateProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type ActivateProjectResponse struct {
 	Cpuavailable              string   `json:"cpuavailable"`
 	Cpulimit                  string   `json:"cpulimit"`
@@ -142,11 +335,246 @@ type ActivateProjectResponse struct {
 	Vpctotal                  int64    `json:"vpctotal"`
 }
 
+type AddUserToProjectParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddUserToProjectParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["email"]; found {
+		u.Set("email", v.(string))
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["projectroleid"]; found {
+		u.Set("projectroleid", v.(string))
+	}
+	if v, found := p.p["roletype"]; found {
+		u.Set("roletype", v.(string))
+	}
+	if v, found := p.p["username"]; found {
+		u.Set("username", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddUserToProjectParams) Validate() error {
+	v := newParamValidator("addUserToProject", p.p)
+	v.required("projectid", "username")
+	v.ids("projectid", "projectroleid")
+	v.maxLength("email", 255)
+	v.maxLength("roletype", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddUserToProjectParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddUserToProjectParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "email", "projectid", "projectroleid", "roletype", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addUserToProject", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addUserToProject: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *AddUserToProjectParams) SetEmail(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["email"] = v
+}
+
+func (p *AddUserToProjectParams) ResetEmail() {
+	delete(p.p, "email")
+}
+
+func (p *AddUserToProjectParams) GetEmail() (string, bool) {
+	value, ok := p.p["email"].(string)
+	return value, ok
+}
+
+func (p *AddUserToProjectParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *AddUserToProjectParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *AddUserToProjectParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *AddUserToProjectParams) SetProjectroleid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectroleid"] = v
+}
+
+func (p *AddUserToProjectParams) ResetProjectroleid() {
+	delete(p.p, "projectroleid")
+}
+
+func (p *AddUserToProjectParams) GetProjectroleid() (string, bool) {
+	value, ok := p.p["projectroleid"].(string)
+	return value, ok
+}
+
+func (p *AddUserToProjectParams) SetRoletype(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["roletype"] = v
+}
+
+func (p *AddUserToProjectParams) ResetRoletype() {
+	delete(p.p, "roletype")
+}
+
+func (p *AddUserToProjectParams) GetRoletype() (string, bool) {
+	value, ok := p.p["roletype"].(string)
+	return value, ok
+}
+
+func (p *AddUserToProjectParams) SetUsername(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["username"] = v
+}
+
+func (p *AddUserToProjectParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddUserToProjectParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AddUserToProjectParams instance,
+// as then you are sure you have configured all required params
+func (s *ProjectService) NewAddUserToProjectParams(projectid string, username string) *AddUserToProjectParams {
+	p := &AddUserToProjectParams{}
+	p.p = make(map[string]interface{})
+	p.p["projectid"] = projectid
+	p.p["username"] = username
+	return p
+}
+
+// Adds user to a project
+func (s *ProjectService) AddUserToProject(p *AddUserToProjectParams) (*AddUserToProjectResponse, error) {
+	return s.AddUserToProjectWithContext(context.Background(), p)
+}
+
+// AddUserToProjectWithContext is the same as AddUserToProject, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) AddUserToProjectWithContext(ctx context.Context, p *AddUserToProjectParams) (*AddUserToProjectResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addUserToProject", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddUserToProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// AddUserToProjectAsync starts the async job for AddUserToProject and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddUserToProjectResponse using Job.Result
+func (s *ProjectService) AddUserToProjectAsync(p *AddUserToProjectParams) (*Job, error) {
+	return s.AddUserToProjectAsyncWithContext(context.Background(), p)
+}
+
+// AddUserToProjectAsyncWithContext is the same as AddUserToProjectAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ProjectService) AddUserToProjectAsyncWithContext(ctx context.Context, p *AddUserToProjectParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addUserToProject", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddUserToProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type AddUserToProjectResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
 type CreateProjectParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateProjectParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateProjectParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -172,6 +600,51 @@ func (p *CreateProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateProjectParams) Validate() error {
+	v := newParamValidator("createProject", p.p)
+	v.required("displaytext", "name")
+	v.ids("accountid", "domainid", "userid")
+	v.maxLength("account", 255)
+	v.maxLength("displaytext", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateProjectParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateProjectParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "accountid", "displaytext", "domainid", "name", "userid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createProject", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createProject: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateProjectParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -179,6 +652,15 @@ func (p *CreateProjectParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateProjectParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateProjectParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateProjectParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -186,6 +668,15 @@ func (p *CreateProjectParams) SetAccountid(v string) {
 	p.p["accountid"] = v
 }
 
+func (p *CreateProjectParams) ResetAccountid() {
+	delete(p.p, "accountid")
+}
+
+func (p *CreateProjectParams) GetAccountid() (string, bool) {
+	value, ok := p.p["accountid"].(string)
+	return value, ok
+}
+
 func (p *CreateProjectParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,6 +684,15 @@ func (p *CreateProjectParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *CreateProjectParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *CreateProjectParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *CreateProjectParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -200,6 +700,15 @@ func (p *CreateProjectParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateProjectParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateProjectParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateProjectParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -207,6 +716,15 @@ func (p *CreateProjectParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateProjectParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateProjectParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateProjectParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -214,6 +732,15 @@ func (p *CreateProjectParams) SetUserid(v string) {
 	p.p["userid"] = v
 }
 
+func (p *CreateProjectParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *CreateProjectParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateProjectParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewCreateProjectParams(displaytext string, name string) *CreateProjectParams {
@@ -226,7 +753,16 @@ func (s *ProjectService) NewCreateProjectParams(displaytext string, name string)
 
 // Creates a project
 func (s *ProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error) {
-	resp, err := s.cs.newRequest("createProject", p.toURLValues())
+	return s.CreateProjectWithContext(context.Background(), p)
+}
+
+// CreateProjectWithContext is the same as CreateProject, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) CreateProjectWithContext(ctx context.Context, p *CreateProjectParams) (*CreateProjectResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createProject", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -238,7 +774,7 @@ func (s *ProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -259,6 +795,40 @@ func (s *ProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectRe
 	return &r, nil
 }
 
+// CreateProjectAsync starts the async job for CreateProject and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateProjectResponse using Job.Result
+func (s *ProjectService) CreateProjectAsync(p *CreateProjectParams) (*Job, error) {
+	return s.CreateProjectAsyncWithContext(context.Background(), p)
+}
+
+// CreateProjectAsyncWithContext is the same as CreateProjectAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ProjectService) CreateProjectAsyncWithContext(ctx context.Context, p *CreateProjectParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createProject", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateProjectResponse struct {
 	Cpuavailable              string   `json:"cpuavailable"`
 	Cpulimit                  string   `json:"cpulimit"`
@@ -308,328 +878,345 @@ type CreateProjectResponse struct {
 	Vpctotal                  int64    `json:"vpctotal"`
 }
 
-type DeleteProjectParams struct {
+type CreateProjectRoleParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteProjectParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateProjectRoleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v, found := p.p["description"]; found {
+		u.Set("description", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
 	}
 	return u
 }
 
-func (p *DeleteProjectParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-}
-
-// You should always use this function to get a new DeleteProjectParams instance,
-// as then you are sure you have configured all required params
-func (s *ProjectService) NewDeleteProjectParams(id string) *DeleteProjectParams {
-	p := &DeleteProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateProjectRoleParams) Validate() error {
+	v := newParamValidator("createProjectRole", p.p)
+	v.required("name", "projectid")
+	v.ids("projectid")
+	v.maxLength("description", 255)
+	v.maxLength("name", 255)
+	return v.err()
 }
 
-// Deletes a project
-func (s *ProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error) {
-	resp, err := s.cs.newRequest("deleteProject", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r DeleteProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateProjectRoleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
 	}
+	return json.Marshal(p.p)
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateProjectRoleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "name", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createProjectRole", k)
 		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createProjectRole: %w", k, err)
 		}
 	}
+	return nil
+}
 
-	return &r, nil
+func (p *CreateProjectRoleParams) SetDescription(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["description"] = v
 }
 
-type DeleteProjectResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+func (p *CreateProjectRoleParams) ResetDescription() {
+	delete(p.p, "description")
 }
 
-type DeleteProjectInvitationParams struct {
-	p map[string]interface{}
+func (p *CreateProjectRoleParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
 }
 
-func (p *DeleteProjectInvitationParams) toURLValues() url.Values {
-	u := url.Values{}
+func (p *CreateProjectRoleParams) SetName(v string) {
 	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+		p.p = make(map[string]interface{})
 	}
-	return u
+	p.p["name"] = v
 }
 
-func (p *DeleteProjectInvitationParams) SetId(v string) {
+func (p *CreateProjectRoleParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateProjectRoleParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *CreateProjectRoleParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["projectid"] = v
 }
 
-// You should always use this function to get a new DeleteProjectInvitationParams instance,
+func (p *CreateProjectRoleParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *CreateProjectRoleParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateProjectRoleParams instance,
 // as then you are sure you have configured all required params
-func (s *ProjectService) NewDeleteProjectInvitationParams(id string) *DeleteProjectInvitationParams {
-	p := &DeleteProjectInvitationParams{}
+func (s *ProjectService) NewCreateProjectRoleParams(name string, projectid string) *CreateProjectRoleParams {
+	p := &CreateProjectRoleParams{}
 	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.p["name"] = name
+	p.p["projectid"] = projectid
 	return p
 }
 
-// Deletes project invitation
-func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
-	resp, err := s.cs.newRequest("deleteProjectInvitation", p.toURLValues())
-	if err != nil {
+// Creates a Project role
+func (s *ProjectService) CreateProjectRole(p *CreateProjectRoleParams) (*CreateProjectRoleResponse, error) {
+	return s.CreateProjectRoleWithContext(context.Background(), p)
+}
+
+// CreateProjectRoleWithContext is the same as CreateProjectRole, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) CreateProjectRoleWithContext(ctx context.Context, p *CreateProjectRoleParams) (*CreateProjectRoleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
 		return nil, err
 	}
 
-	var r DeleteProjectInvitationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequestWithContext(ctx, "createProjectRole", p.ToURLValues())
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	var r CreateProjectRoleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
 
 	return &r, nil
 }
 
-type DeleteProjectInvitationResponse struct {
-	Displaytext string `json:"displaytext"`
+type CreateProjectRoleResponse struct {
+	Description string `json:"description"`
+	Id          string `json:"id"`
 	JobID       string `json:"jobid"`
 	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Name        string `json:"name"`
+	Projectid   string `json:"projectid"`
 }
 
-type ListProjectInvitationsParams struct {
+type CreateProjectRolePermissionParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListProjectInvitationsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateProjectRolePermissionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["activeonly"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("activeonly", vv)
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if v, found := p.p["description"]; found {
+		u.Set("description", v.(string))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v, found := p.p["permission"]; found {
+		u.Set("permission", v.(string))
 	}
 	if v, found := p.p["projectid"]; found {
 		u.Set("projectid", v.(string))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if v, found := p.p["projectroleid"]; found {
+		u.Set("projectroleid", v.(string))
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if v, found := p.p["rule"]; found {
+		u.Set("rule", v.(string))
 	}
 	return u
 }
 
-func (p *ListProjectInvitationsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateProjectRolePermissionParams) Validate() error {
+	v := newParamValidator("createProjectRolePermission", p.p)
+	v.required("permission", "projectid", "projectroleid", "rule")
+	v.ids("projectid", "projectroleid")
+	v.maxLength("description", 255)
+	v.maxLength("permission", 255)
+	v.maxLength("rule", 255)
+	return v.err()
 }
 
-func (p *ListProjectInvitationsParams) SetActiveonly(v bool) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateProjectRolePermissionParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["activeonly"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *ListProjectInvitationsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateProjectRolePermissionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "permission", "projectid", "projectroleid", "rule":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createProjectRolePermission", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createProjectRolePermission: %w", k, err)
+		}
 	}
-	p.p["domainid"] = v
+	return nil
 }
 
-func (p *ListProjectInvitationsParams) SetId(v string) {
+func (p *CreateProjectRolePermissionParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["description"] = v
 }
 
-func (p *ListProjectInvitationsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+func (p *CreateProjectRolePermissionParams) ResetDescription() {
+	delete(p.p, "description")
 }
 
-func (p *ListProjectInvitationsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+func (p *CreateProjectRolePermissionParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
 }
 
-func (p *ListProjectInvitationsParams) SetListall(v bool) {
+func (p *CreateProjectRolePermissionParams) SetPermission(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["listall"] = v
+	p.p["permission"] = v
 }
 
-func (p *ListProjectInvitationsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+func (p *CreateProjectRolePermissionParams) ResetPermission() {
+	delete(p.p, "permission")
 }
 
-func (p *ListProjectInvitationsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+func (p *CreateProjectRolePermissionParams) GetPermission() (string, bool) {
+	value, ok := p.p["permission"].(string)
+	return value, ok
 }
 
-func (p *ListProjectInvitationsParams) SetProjectid(v string) {
+func (p *CreateProjectRolePermissionParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["projectid"] = v
 }
 
-func (p *ListProjectInvitationsParams) SetState(v string) {
+func (p *CreateProjectRolePermissionParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *CreateProjectRolePermissionParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *CreateProjectRolePermissionParams) SetProjectroleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["state"] = v
+	p.p["projectroleid"] = v
 }
 
-func (p *ListProjectInvitationsParams) SetUserid(v string) {
+func (p *CreateProjectRolePermissionParams) ResetProjectroleid() {
+	delete(p.p, "projectroleid")
+}
+
+func (p *CreateProjectRolePermissionParams) GetProjectroleid() (string, bool) {
+	value, ok := p.p["projectroleid"].(string)
+	return value, ok
+}
+
+func (p *CreateProjectRolePermissionParams) SetRule(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["userid"] = v
+	p.p["rule"] = v
 }
 
-// You should always use this function to get a new ListProjectInvitationsParams instance,
+func (p *CreateProjectRolePermissionParams) ResetRule() {
+	delete(p.p, "rule")
+}
+
+func (p *CreateProjectRolePermissionParams) GetRule() (string, bool) {
+	value, ok := p.p["rule"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateProjectRolePermissionParams instance,
 // as then you are sure you have configured all required params
-func (s *ProjectService) NewListProjectInvitationsParams() *ListProjectInvitationsParams {
-	p := &ListProjectInvitationsParams{}
+func (s *ProjectService) NewCreateProjectRolePermissionParams(permission string, projectid string, projectroleid string, rule string) *CreateProjectRolePermissionParams {
+	p := &CreateProjectRolePermissionParams{}
 	p.p = make(map[string]interface{})
+	p.p["permission"] = permission
+	p.p["projectid"] = projectid
+	p.p["projectroleid"] = projectroleid
+	p.p["rule"] = rule
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ProjectService) GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error) {
-	p := &ListProjectInvitationsParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
-
-	for _, fn := range append(s.cs.options, opts...) {
-		if err := fn(s.cs, p); err != nil {
-			return nil, -1, err
-		}
-	}
-
-	l, err := s.ListProjectInvitations(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
-	}
-
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+// Adds API permissions to a project role
+func (s *ProjectService) CreateProjectRolePermission(p *CreateProjectRolePermissionParams) (*CreateProjectRolePermissionResponse, error) {
+	return s.CreateProjectRolePermissionWithContext(context.Background(), p)
+}
 
-	if l.Count == 1 {
-		return l.ProjectInvitations[0], l.Count, nil
+// CreateProjectRolePermissionWithContext is the same as CreateProjectRolePermission, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) CreateProjectRolePermissionWithContext(ctx context.Context, p *CreateProjectRolePermissionParams) (*CreateProjectRolePermissionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for ProjectInvitation UUID: %s!", id)
-}
 
-// Lists project invitations and provides detailed information for listed invitations
-func (s *ProjectService) ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
-	resp, err := s.cs.newRequest("listProjectInvitations", p.toURLValues())
+	resp, err := s.cs.newRequestWithContext(ctx, "createProjectRolePermission", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListProjectInvitationsResponse
+	var r CreateProjectRolePermissionResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
@@ -637,298 +1224,2754 @@ func (s *ProjectService) ListProjectInvitations(p *ListProjectInvitationsParams)
 	return &r, nil
 }
 
-type ListProjectInvitationsResponse struct {
-	Count              int                  `json:"count"`
-	ProjectInvitations []*ProjectInvitation `json:"projectinvitation"`
-}
-
-type ProjectInvitation struct {
-	Account   string `json:"account"`
-	Domain    string `json:"domain"`
-	Domainid  string `json:"domainid"`
-	Email     string `json:"email"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Project   string `json:"project"`
-	Projectid string `json:"projectid"`
-	State     string `json:"state"`
-	Userid    string `json:"userid"`
+type CreateProjectRolePermissionResponse struct {
+	Description     string `json:"description"`
+	Id              string `json:"id"`
+	JobID           string `json:"jobid"`
+	Jobstatus       int    `json:"jobstatus"`
+	Permission      string `json:"permission"`
+	Projectid       string `json:"projectid"`
+	Projectroleid   string `json:"projectroleid"`
+	Projectrolename string `json:"projectrolename"`
+	Rule            string `json:"rule"`
 }
 
-type ListProjectsParams struct {
+type DeleteProjectParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListProjectsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteProjectParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["details"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("details", vv)
-	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteProjectParams) Validate() error {
+	v := newParamValidator("deleteProject", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteProjectParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteProjectParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteProject", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteProject: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteProjectParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteProjectParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteProjectParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteProjectParams instance,
+// as then you are sure you have configured all required params
+func (s *ProjectService) NewDeleteProjectParams(id string) *DeleteProjectParams {
+	p := &DeleteProjectParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes a project
+func (s *ProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error) {
+	return s.DeleteProjectWithContext(context.Background(), p)
+}
+
+// DeleteProjectWithContext is the same as DeleteProject, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) DeleteProjectWithContext(ctx context.Context, p *DeleteProjectParams) (*DeleteProjectResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteProject", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteProjectAsync starts the async job for DeleteProject and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteProjectResponse using Job.Result
+func (s *ProjectService) DeleteProjectAsync(p *DeleteProjectParams) (*Job, error) {
+	return s.DeleteProjectAsyncWithContext(context.Background(), p)
+}
+
+// DeleteProjectAsyncWithContext is the same as DeleteProjectAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ProjectService) DeleteProjectAsyncWithContext(ctx context.Context, p *DeleteProjectParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteProject", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteProjectResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteProjectInvitationParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteProjectInvitationParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteProjectInvitationParams) Validate() error {
+	v := newParamValidator("deleteProjectInvitation", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteProjectInvitationParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteProjectInvitationParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteProjectInvitation", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteProjectInvitation: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteProjectInvitationParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteProjectInvitationParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteProjectInvitationParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteProjectInvitationParams instance,
+// as then you are sure you have configured all required params
+func (s *ProjectService) NewDeleteProjectInvitationParams(id string) *DeleteProjectInvitationParams {
+	p := &DeleteProjectInvitationParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Deletes project invitation
+func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
+	return s.DeleteProjectInvitationWithContext(context.Background(), p)
+}
+
+// DeleteProjectInvitationWithContext is the same as DeleteProjectInvitation, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) DeleteProjectInvitationWithContext(ctx context.Context, p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteProjectInvitation", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteProjectInvitationResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteProjectInvitationAsync starts the async job for DeleteProjectInvitation and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteProjectInvitationResponse using Job.Result
+func (s *ProjectService) DeleteProjectInvitationAsync(p *DeleteProjectInvitationParams) (*Job, error) {
+	return s.DeleteProjectInvitationAsyncWithContext(context.Background(), p)
+}
+
+// DeleteProjectInvitationAsyncWithContext is the same as DeleteProjectInvitationAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ProjectService) DeleteProjectInvitationAsyncWithContext(ctx context.Context, p *DeleteProjectInvitationParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteProjectInvitation", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteProjectInvitationResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteProjectInvitationResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type DeleteProjectRoleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteProjectRoleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteProjectRoleParams) Validate() error {
+	v := newParamValidator("deleteProjectRole", p.p)
+	v.required("id", "projectid")
+	v.ids("id", "projectid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteProjectRoleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteProjectRoleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteProjectRole", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteProjectRole: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteProjectRoleParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteProjectRoleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteProjectRoleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *DeleteProjectRoleParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *DeleteProjectRoleParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *DeleteProjectRoleParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteProjectRoleParams instance,
+// as then you are sure you have configured all required params
+func (s *ProjectService) NewDeleteProjectRoleParams(id string, projectid string) *DeleteProjectRoleParams {
+	p := &DeleteProjectRoleParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	p.p["projectid"] = projectid
+	return p
+}
+
+// Delete Project roles in CloudStack
+func (s *ProjectService) DeleteProjectRole(p *DeleteProjectRoleParams) (*DeleteProjectRoleResponse, error) {
+	return s.DeleteProjectRoleWithContext(context.Background(), p)
+}
+
+// DeleteProjectRoleWithContext is the same as DeleteProjectRole, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) DeleteProjectRoleWithContext(ctx context.Context, p *DeleteProjectRoleParams) (*DeleteProjectRoleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteProjectRole", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteProjectRoleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteProjectRoleResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteProjectRoleResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteProjectRoleResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type DeleteProjectRolePermissionParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteProjectRolePermissionParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteProjectRolePermissionParams) Validate() error {
+	v := newParamValidator("deleteProjectRolePermission", p.p)
+	v.required("id", "projectid")
+	v.ids("id", "projectid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteProjectRolePermissionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteProjectRolePermissionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteProjectRolePermission", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteProjectRolePermission: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteProjectRolePermissionParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteProjectRolePermissionParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteProjectRolePermissionParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *DeleteProjectRolePermissionParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *DeleteProjectRolePermissionParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *DeleteProjectRolePermissionParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteProjectRolePermissionParams instance,
+// as then you are sure you have configured all required params
+func (s *ProjectService) NewDeleteProjectRolePermissionParams(id string, projectid string) *DeleteProjectRolePermissionParams {
+	p := &DeleteProjectRolePermissionParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	p.p["projectid"] = projectid
+	return p
+}
+
+// Deletes a project role permission in the project
+func (s *ProjectService) DeleteProjectRolePermission(p *DeleteProjectRolePermissionParams) (*DeleteProjectRolePermissionResponse, error) {
+	return s.DeleteProjectRolePermissionWithContext(context.Background(), p)
+}
+
+// DeleteProjectRolePermissionWithContext is the same as DeleteProjectRolePermission, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) DeleteProjectRolePermissionWithContext(ctx context.Context, p *DeleteProjectRolePermissionParams) (*DeleteProjectRolePermissionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteProjectRolePermission", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteProjectRolePermissionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type DeleteProjectRolePermissionResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *DeleteProjectRolePermissionResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias DeleteProjectRolePermissionResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type DeleteUserFromProjectParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteUserFromProjectParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["userid"]; found {
+		u.Set("userid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteUserFromProjectParams) Validate() error {
+	v := newParamValidator("deleteUserFromProject", p.p)
+	v.required("projectid", "userid")
+	v.ids("projectid", "userid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteUserFromProjectParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteUserFromProjectParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "projectid", "userid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteUserFromProject", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteUserFromProject: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteUserFromProjectParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *DeleteUserFromProjectParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *DeleteUserFromProjectParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *DeleteUserFromProjectParams) SetUserid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["userid"] = v
+}
+
+func (p *DeleteUserFromProjectParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *DeleteUserFromProjectParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DeleteUserFromProjectParams instance,
+// as then you are sure you have configured all required params
+func (s *ProjectService) NewDeleteUserFromProjectParams(projectid string, userid string) *DeleteUserFromProjectParams {
+	p := &DeleteUserFromProjectParams{}
+	p.p = make(map[string]interface{})
+	p.p["projectid"] = projectid
+	p.p["userid"] = userid
+	return p
+}
+
+// Deletes user from the project
+func (s *ProjectService) DeleteUserFromProject(p *DeleteUserFromProjectParams) (*DeleteUserFromProjectResponse, error) {
+	return s.DeleteUserFromProjectWithContext(context.Background(), p)
+}
+
+// DeleteUserFromProjectWithContext is the same as DeleteUserFromProject, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) DeleteUserFromProjectWithContext(ctx context.Context, p *DeleteUserFromProjectParams) (*DeleteUserFromProjectResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteUserFromProject", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteUserFromProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// DeleteUserFromProjectAsync starts the async job for DeleteUserFromProject and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteUserFromProjectResponse using Job.Result
+func (s *ProjectService) DeleteUserFromProjectAsync(p *DeleteUserFromProjectParams) (*Job, error) {
+	return s.DeleteUserFromProjectAsyncWithContext(context.Background(), p)
+}
+
+// DeleteUserFromProjectAsyncWithContext is the same as DeleteUserFromProjectAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ProjectService) DeleteUserFromProjectAsyncWithContext(ctx context.Context, p *DeleteUserFromProjectParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteUserFromProject", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteUserFromProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type DeleteUserFromProjectResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type ListProjectInvitationsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListProjectInvitationsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["activeonly"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("activeonly", vv)
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["isrecursive"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isrecursive", vv)
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["listall"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("listall", vv)
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["state"]; found {
+		u.Set("state", v.(string))
+	}
+	if v, found := p.p["userid"]; found {
+		u.Set("userid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListProjectInvitationsParams) Validate() error {
+	v := newParamValidator("listProjectInvitations", p.p)
+	v.ids("domainid", "id", "projectid", "userid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListProjectInvitationsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListProjectInvitationsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "projectid", "state", "userid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "activeonly", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listProjectInvitations", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listProjectInvitations: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListProjectInvitationsParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *ListProjectInvitationsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListProjectInvitationsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *ListProjectInvitationsParams) SetActiveonly(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["activeonly"] = v
+}
+
+func (p *ListProjectInvitationsParams) ResetActiveonly() {
+	delete(p.p, "activeonly")
+}
+
+func (p *ListProjectInvitationsParams) GetActiveonly() (bool, bool) {
+	value, ok := p.p["activeonly"].(bool)
+	return value, ok
+}
+
+func (p *ListProjectInvitationsParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *ListProjectInvitationsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListProjectInvitationsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *ListProjectInvitationsParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListProjectInvitationsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListProjectInvitationsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListProjectInvitationsParams) SetIsrecursive(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isrecursive"] = v
+}
+
+func (p *ListProjectInvitationsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListProjectInvitationsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
+func (p *ListProjectInvitationsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListProjectInvitationsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListProjectInvitationsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListProjectInvitationsParams) SetListall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["listall"] = v
+}
+
+func (p *ListProjectInvitationsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListProjectInvitationsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
+func (p *ListProjectInvitationsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListProjectInvitationsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListProjectInvitationsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListProjectInvitationsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListProjectInvitationsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListProjectInvitationsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListProjectInvitationsParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *ListProjectInvitationsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListProjectInvitationsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *ListProjectInvitationsParams) SetState(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["state"] = v
+}
+
+func (p *ListProjectInvitationsParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListProjectInvitationsParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
+func (p *ListProjectInvitationsParams) SetUserid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["userid"] = v
+}
+
+func (p *ListProjectInvitationsParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *ListProjectInvitationsParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListProjectInvitationsParams instance,
+// as then you are sure you have configured all required params
+func (s *ProjectService) NewListProjectInvitationsParams() *ListProjectInvitationsParams {
+	p := &ListProjectInvitationsParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ProjectService) GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error) {
+	return s.GetProjectInvitationByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetProjectInvitationByIDWithContext is the same as GetProjectInvitationByID, but uses ctx to cancel the request
+func (s *ProjectService) GetProjectInvitationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ProjectInvitation, int, error) {
+	p := &ListProjectInvitationsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListProjectInvitationsWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.ProjectInvitations[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for ProjectInvitation UUID: %s!", id)
+}
+
+// Lists project invitations and provides detailed information for listed invitations
+func (s *ProjectService) ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
+	return s.ListProjectInvitationsWithContext(context.Background(), p)
+}
+
+// ListProjectInvitationsWithContext is the same as ListProjectInvitations, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) ListProjectInvitationsWithContext(ctx context.Context, p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listProjectInvitations", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListProjectInvitationsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListProjectInvitationsPager iterates over all pages of a ListProjectInvitations call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListProjectInvitationsPager struct {
+	pager
+
+	s *ProjectService
+	p *ListProjectInvitationsParams
+	r *ListProjectInvitationsResponse
+}
+
+// NewListProjectInvitationsPager returns a pager for all pages of a ListProjectInvitations call with the given params
+func (s *ProjectService) NewListProjectInvitationsPager(p *ListProjectInvitationsParams) *ListProjectInvitationsPager {
+	return &ListProjectInvitationsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListProjectInvitationsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListProjectInvitationsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListProjectInvitationsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListProjectInvitationsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.ProjectInvitations), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListProjectInvitationsPager) Page() *ListProjectInvitationsResponse {
+	return pg.r
+}
+
+// ListProjectInvitationsAll fetches all pages of a ListProjectInvitations call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ProjectService) ListProjectInvitationsAll(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
+	return s.ListProjectInvitationsAllWithContext(context.Background(), p)
+}
+
+// ListProjectInvitationsAllWithContext is the same as ListProjectInvitationsAll, but uses ctx to cancel the requests
+func (s *ProjectService) ListProjectInvitationsAllWithContext(ctx context.Context, p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*ProjectInvitation)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListProjectInvitationsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListProjectInvitationsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.ProjectInvitations
+		mu.Unlock()
+
+		return len(l.ProjectInvitations), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListProjectInvitationsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.ProjectInvitations = append(r.ProjectInvitations, pages[page]...)
+	}
+	r.Count = len(r.ProjectInvitations)
+
+	return r, nil
+}
+
+type ListProjectInvitationsResponse struct {
+	Count              int                  `json:"count"`
+	ProjectInvitations []*ProjectInvitation `json:"projectinvitation"`
+}
+
+type ProjectInvitation struct {
+	Account   string `json:"account"`
+	Domain    string `json:"domain"`
+	Domainid  string `json:"domainid"`
+	Email     string `json:"email"`
+	Id        string `json:"id"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Project   string `json:"project"`
+	Projectid string `json:"projectid"`
+	State     string `json:"state"`
+	Userid    string `json:"userid"`
+}
+
+type ListProjectRolePermissionsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListProjectRolePermissionsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["projectroleid"]; found {
+		u.Set("projectroleid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListProjectRolePermissionsParams) Validate() error {
+	v := newParamValidator("listProjectRolePermissions", p.p)
+	v.required("projectid")
+	v.ids("projectid", "projectroleid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListProjectRolePermissionsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListProjectRolePermissionsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "projectid", "projectroleid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listProjectRolePermissions", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listProjectRolePermissions: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListProjectRolePermissionsParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *ListProjectRolePermissionsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListProjectRolePermissionsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *ListProjectRolePermissionsParams) SetProjectroleid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectroleid"] = v
+}
+
+func (p *ListProjectRolePermissionsParams) ResetProjectroleid() {
+	delete(p.p, "projectroleid")
+}
+
+func (p *ListProjectRolePermissionsParams) GetProjectroleid() (string, bool) {
+	value, ok := p.p["projectroleid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListProjectRolePermissionsParams instance,
+// as then you are sure you have configured all required params
+func (s *ProjectService) NewListProjectRolePermissionsParams(projectid string) *ListProjectRolePermissionsParams {
+	p := &ListProjectRolePermissionsParams{}
+	p.p = make(map[string]interface{})
+	p.p["projectid"] = projectid
+	return p
+}
+
+// Lists a project's project role permissions
+func (s *ProjectService) ListProjectRolePermissions(p *ListProjectRolePermissionsParams) (*ListProjectRolePermissionsResponse, error) {
+	return s.ListProjectRolePermissionsWithContext(context.Background(), p)
+}
+
+// ListProjectRolePermissionsWithContext is the same as ListProjectRolePermissions, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) ListProjectRolePermissionsWithContext(ctx context.Context, p *ListProjectRolePermissionsParams) (*ListProjectRolePermissionsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listProjectRolePermissions", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListProjectRolePermissionsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListProjectRolePermissionsResponse struct {
+	Count                  int                      `json:"count"`
+	ProjectRolePermissions []*ProjectRolePermission `json:"projectrolepermission"`
+}
+
+type ProjectRolePermission struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *ProjectRolePermission) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias ProjectRolePermission
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type ListProjectRolesParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListProjectRolesParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["projectroleid"]; found {
+		u.Set("projectroleid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListProjectRolesParams) Validate() error {
+	v := newParamValidator("listProjectRoles", p.p)
+	v.required("projectid")
+	v.ids("projectid", "projectroleid")
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListProjectRolesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListProjectRolesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "name", "projectid", "projectroleid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listProjectRoles", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listProjectRoles: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListProjectRolesParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ListProjectRolesParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListProjectRolesParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ListProjectRolesParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *ListProjectRolesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListProjectRolesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *ListProjectRolesParams) SetProjectroleid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectroleid"] = v
+}
+
+func (p *ListProjectRolesParams) ResetProjectroleid() {
+	delete(p.p, "projectroleid")
+}
+
+func (p *ListProjectRolesParams) GetProjectroleid() (string, bool) {
+	value, ok := p.p["projectroleid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListProjectRolesParams instance,
+// as then you are sure you have configured all required params
+func (s *ProjectService) NewListProjectRolesParams(projectid string) *ListProjectRolesParams {
+	p := &ListProjectRolesParams{}
+	p.p = make(map[string]interface{})
+	p.p["projectid"] = projectid
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ProjectService) GetProjectRoleID(name string, projectid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetProjectRoleIDWithContext(context.Background(), name, projectid, opts...)
+}
+
+// GetProjectRoleIDWithContext is the same as GetProjectRoleID, but uses ctx to cancel the request
+func (s *ProjectService) GetProjectRoleIDWithContext(ctx context.Context, name string, projectid string, opts ...OptionFunc) (string, int, error) {
+	p := &ListProjectRolesParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["name"] = name
+	p.p["projectid"] = projectid
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListProjectRolesWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.ProjectRoles[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.ProjectRoles {
+			if v.Name == name {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// Lists Project roles in CloudStack
+func (s *ProjectService) ListProjectRoles(p *ListProjectRolesParams) (*ListProjectRolesResponse, error) {
+	return s.ListProjectRolesWithContext(context.Background(), p)
+}
+
+// ListProjectRolesWithContext is the same as ListProjectRoles, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) ListProjectRolesWithContext(ctx context.Context, p *ListProjectRolesParams) (*ListProjectRolesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listProjectRoles", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListProjectRolesResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListProjectRolesResponse struct {
+	Count        int            `json:"count"`
+	ProjectRoles []*ProjectRole `json:"projectrole"`
+}
+
+type ProjectRole struct {
+	Description string `json:"description"`
+	Id          string `json:"id"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Name        string `json:"name"`
+	Projectid   string `json:"projectid"`
+}
+
+type ListProjectsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListProjectsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["details"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("details", vv)
+	}
+	if v, found := p.p["displaytext"]; found {
+		u.Set("displaytext", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["isrecursive"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isrecursive", vv)
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["listall"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("listall", vv)
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["state"]; found {
+		u.Set("state", v.(string))
+	}
+	if v, found := p.p["tags"]; found {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
+		}
+	}
+	if v, found := p.p["username"]; found {
+		u.Set("username", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListProjectsParams) Validate() error {
+	v := newParamValidator("listProjects", p.p)
+	v.ids("domainid", "id")
+	v.maxLength("account", 255)
+	v.maxLength("displaytext", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListProjectsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListProjectsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "displaytext", "domainid", "id", "keyword", "name", "state", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listProjects", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listProjects: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListProjectsParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *ListProjectsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListProjectsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *ListProjectsParams) SetDetails(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["details"] = v
+}
+
+func (p *ListProjectsParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *ListProjectsParams) GetDetails() ([]string, bool) {
+	value, ok := p.p["details"].([]string)
+	return value, ok
+}
+
+func (p *ListProjectsParams) SetDisplaytext(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["displaytext"] = v
+}
+
+func (p *ListProjectsParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *ListProjectsParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
+func (p *ListProjectsParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *ListProjectsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListProjectsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *ListProjectsParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListProjectsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListProjectsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListProjectsParams) SetIsrecursive(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isrecursive"] = v
+}
+
+func (p *ListProjectsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListProjectsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
+func (p *ListProjectsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListProjectsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListProjectsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListProjectsParams) SetListall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["listall"] = v
+}
+
+func (p *ListProjectsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListProjectsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
+func (p *ListProjectsParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ListProjectsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListProjectsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ListProjectsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	p.p["page"] = v
+}
+
+func (p *ListProjectsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListProjectsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListProjectsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	p.p["pagesize"] = v
+}
+
+func (p *ListProjectsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListProjectsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListProjectsParams) SetState(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	p.p["state"] = v
+}
+
+func (p *ListProjectsParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListProjectsParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
+func (p *ListProjectsParams) SetTags(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	p.p["tags"] = v
+}
+
+func (p *ListProjectsParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListProjectsParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
+func (p *ListProjectsParams) SetUsername(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	p.p["username"] = v
+}
+
+func (p *ListProjectsParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *ListProjectsParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListProjectsParams instance,
+// as then you are sure you have configured all required params
+func (s *ProjectService) NewListProjectsParams() *ListProjectsParams {
+	p := &ListProjectsParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ProjectService) GetProjectID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetProjectIDWithContext(context.Background(), name, opts...)
+}
+
+// GetProjectIDWithContext is the same as GetProjectID, but uses ctx to cancel the request
+func (s *ProjectService) GetProjectIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListProjectsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["name"] = name
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+
+	l, err := s.ListProjectsWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.Projects[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.Projects {
+			if v.Name == name {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ProjectService) GetProjectByName(name string, opts ...OptionFunc) (*Project, int, error) {
+	return s.GetProjectByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetProjectByNameWithContext is the same as GetProjectByName, but uses ctx to cancel the requests
+func (s *ProjectService) GetProjectByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Project, int, error) {
+	id, count, err := s.GetProjectIDWithContext(ctx, name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetProjectByIDWithContext(ctx, id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ProjectService) GetProjectByID(id string, opts ...OptionFunc) (*Project, int, error) {
+	return s.GetProjectByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetProjectByIDWithContext is the same as GetProjectByID, but uses ctx to cancel the request
+func (s *ProjectService) GetProjectByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Project, int, error) {
+	p := &ListProjectsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListProjectsWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.Projects[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for Project UUID: %s!", id)
+}
+
+// Lists projects and provides detailed information for listed projects
+func (s *ProjectService) ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error) {
+	return s.ListProjectsWithContext(context.Background(), p)
+}
+
+// ListProjectsWithContext is the same as ListProjects, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) ListProjectsWithContext(ctx context.Context, p *ListProjectsParams) (*ListProjectsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listProjects", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListProjectsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListProjectsPager iterates over all pages of a ListProjects call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListProjectsPager struct {
+	pager
+
+	s *ProjectService
+	p *ListProjectsParams
+	r *ListProjectsResponse
+}
+
+// NewListProjectsPager returns a pager for all pages of a ListProjects call with the given params
+func (s *ProjectService) NewListProjectsPager(p *ListProjectsParams) *ListProjectsPager {
+	return &ListProjectsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListProjectsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListProjectsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListProjectsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListProjectsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Projects), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListProjectsPager) Page() *ListProjectsResponse {
+	return pg.r
+}
+
+// ListProjectsAll fetches all pages of a ListProjects call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ProjectService) ListProjectsAll(p *ListProjectsParams) (*ListProjectsResponse, error) {
+	return s.ListProjectsAllWithContext(context.Background(), p)
+}
+
+// ListProjectsAllWithContext is the same as ListProjectsAll, but uses ctx to cancel the requests
+func (s *ProjectService) ListProjectsAllWithContext(ctx context.Context, p *ListProjectsParams) (*ListProjectsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Project)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListProjectsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListProjectsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
 		}
+
+		mu.Lock()
+		pages[page] = l.Projects
+		mu.Unlock()
+
+		return len(l.Projects), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListProjectsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Projects = append(r.Projects, pages[page]...)
+	}
+	r.Count = len(r.Projects)
+
+	return r, nil
+}
+
+type ListProjectsResponse struct {
+	Count    int        `json:"count"`
+	Projects []*Project `json:"project"`
+}
+
+type Project struct {
+	Cpuavailable              string   `json:"cpuavailable"`
+	Cpulimit                  string   `json:"cpulimit"`
+	Cputotal                  int64    `json:"cputotal"`
+	Displaytext               string   `json:"displaytext"`
+	Domain                    string   `json:"domain"`
+	Domainid                  string   `json:"domainid"`
+	Id                        string   `json:"id"`
+	Ipavailable               string   `json:"ipavailable"`
+	Iplimit                   string   `json:"iplimit"`
+	Iptotal                   int64    `json:"iptotal"`
+	JobID                     string   `json:"jobid"`
+	Jobstatus                 int      `json:"jobstatus"`
+	Memoryavailable           string   `json:"memoryavailable"`
+	Memorylimit               string   `json:"memorylimit"`
+	Memorytotal               int64    `json:"memorytotal"`
+	Name                      string   `json:"name"`
+	Networkavailable          string   `json:"networkavailable"`
+	Networklimit              string   `json:"networklimit"`
+	Networktotal              int64    `json:"networktotal"`
+	Owner                     []string `json:"owner"`
+	Primarystorageavailable   string   `json:"primarystorageavailable"`
+	Primarystoragelimit       string   `json:"primarystoragelimit"`
+	Primarystoragetotal       int64    `json:"primarystoragetotal"`
+	Projectaccountname        string   `json:"projectaccountname"`
+	Secondarystorageavailable string   `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string   `json:"secondarystoragelimit"`
+	Secondarystoragetotal     float64  `json:"secondarystoragetotal"`
+	Snapshotavailable         string   `json:"snapshotavailable"`
+	Snapshotlimit             string   `json:"snapshotlimit"`
+	Snapshottotal             int64    `json:"snapshottotal"`
+	State                     string   `json:"state"`
+	Tags                      []Tags   `json:"tags"`
+	Templateavailable         string   `json:"templateavailable"`
+	Templatelimit             string   `json:"templatelimit"`
+	Templatetotal             int64    `json:"templatetotal"`
+	Vmavailable               string   `json:"vmavailable"`
+	Vmlimit                   string   `json:"vmlimit"`
+	Vmrunning                 int      `json:"vmrunning"`
+	Vmstopped                 int      `json:"vmstopped"`
+	Vmtotal                   int64    `json:"vmtotal"`
+	Volumeavailable           string   `json:"volumeavailable"`
+	Volumelimit               string   `json:"volumelimit"`
+	Volumetotal               int64    `json:"volumetotal"`
+	Vpcavailable              string   `json:"vpcavailable"`
+	Vpclimit                  string   `json:"vpclimit"`
+	Vpctotal                  int64    `json:"vpctotal"`
+}
+
+type SuspendProjectParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *SuspendProjectParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
 	}
 	return u
 }
 
-func (p *ListProjectsParams) SetAccount(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *SuspendProjectParams) Validate() error {
+	v := newParamValidator("suspendProject", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *SuspendProjectParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["account"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *ListProjectsParams) SetDetails(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *SuspendProjectParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for suspendProject", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of suspendProject: %w", k, err)
+		}
 	}
-	p.p["details"] = v
+	return nil
 }
 
-func (p *ListProjectsParams) SetDisplaytext(v string) {
+func (p *SuspendProjectParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["displaytext"] = v
+	p.p["id"] = v
 }
 
-func (p *ListProjectsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *SuspendProjectParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *SuspendProjectParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new SuspendProjectParams instance,
+// as then you are sure you have configured all required params
+func (s *ProjectService) NewSuspendProjectParams(id string) *SuspendProjectParams {
+	p := &SuspendProjectParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Suspends a project
+func (s *ProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error) {
+	return s.SuspendProjectWithContext(context.Background(), p)
+}
+
+// SuspendProjectWithContext is the same as SuspendProject, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) SuspendProjectWithContext(ctx context.Context, p *SuspendProjectParams) (*SuspendProjectResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
-	p.p["domainid"] = v
+
+	resp, err := s.cs.newRequestWithContext(ctx, "suspendProject", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r SuspendProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
 }
 
-func (p *ListProjectsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// SuspendProjectAsync starts the async job for SuspendProject and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a SuspendProjectResponse using Job.Result
+func (s *ProjectService) SuspendProjectAsync(p *SuspendProjectParams) (*Job, error) {
+	return s.SuspendProjectAsyncWithContext(context.Background(), p)
+}
+
+// SuspendProjectAsyncWithContext is the same as SuspendProjectAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ProjectService) SuspendProjectAsyncWithContext(ctx context.Context, p *SuspendProjectParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
+
+	resp, err := s.cs.newRequestWithContext(ctx, "suspendProject", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r SuspendProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
 }
 
-func (p *ListProjectsParams) SetIsrecursive(v bool) {
+type SuspendProjectResponse struct {
+	Cpuavailable              string   `json:"cpuavailable"`
+	Cpulimit                  string   `json:"cpulimit"`
+	Cputotal                  int64    `json:"cputotal"`
+	Displaytext               string   `json:"displaytext"`
+	Domain                    string   `json:"domain"`
+	Domainid                  string   `json:"domainid"`
+	Id                        string   `json:"id"`
+	Ipavailable               string   `json:"ipavailable"`
+	Iplimit                   string   `json:"iplimit"`
+	Iptotal                   int64    `json:"iptotal"`
+	JobID                     string   `json:"jobid"`
+	Jobstatus                 int      `json:"jobstatus"`
+	Memoryavailable           string   `json:"memoryavailable"`
+	Memorylimit               string   `json:"memorylimit"`
+	Memorytotal               int64    `json:"memorytotal"`
+	Name                      string   `json:"name"`
+	Networkavailable          string   `json:"networkavailable"`
+	Networklimit              string   `json:"networklimit"`
+	Networktotal              int64    `json:"networktotal"`
+	Owner                     []string `json:"owner"`
+	Primarystorageavailable   string   `json:"primarystorageavailable"`
+	Primarystoragelimit       string   `json:"primarystoragelimit"`
+	Primarystoragetotal       int64    `json:"primarystoragetotal"`
+	Projectaccountname        string   `json:"projectaccountname"`
+	Secondarystorageavailable string   `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string   `json:"secondarystoragelimit"`
+	Secondarystoragetotal     float64  `json:"secondarystoragetotal"`
+	Snapshotavailable         string   `json:"snapshotavailable"`
+	Snapshotlimit             string   `json:"snapshotlimit"`
+	Snapshottotal             int64    `json:"snapshottotal"`
+	State                     string   `json:"state"`
+	Tags                      []Tags   `json:"tags"`
+	Templateavailable         string   `json:"templateavailable"`
+	Templatelimit             string   `json:"templatelimit"`
+	Templatetotal             int64    `json:"templatetotal"`
+	Vmavailable               string   `json:"vmavailable"`
+	Vmlimit                   string   `json:"vmlimit"`
+	Vmrunning                 int      `json:"vmrunning"`
+	Vmstopped                 int      `json:"vmstopped"`
+	Vmtotal                   int64    `json:"vmtotal"`
+	Volumeavailable           string   `json:"volumeavailable"`
+	Volumelimit               string   `json:"volumelimit"`
+	Volumetotal               int64    `json:"volumetotal"`
+	Vpcavailable              string   `json:"vpcavailable"`
+	Vpclimit                  string   `json:"vpclimit"`
+	Vpctotal                  int64    `json:"vpctotal"`
+}
+
+type UpdateProjectParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateProjectParams) ToURLValues() url.Values {
+	u := url.Values{}
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return u
 	}
-	p.p["isrecursive"] = v
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["displaytext"]; found {
+		u.Set("displaytext", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["roletype"]; found {
+		u.Set("roletype", v.(string))
+	}
+	if v, found := p.p["swapowner"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("swapowner", vv)
+	}
+	if v, found := p.p["userid"]; found {
+		u.Set("userid", v.(string))
+	}
+	return u
 }
 
-func (p *ListProjectsParams) SetKeyword(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateProjectParams) Validate() error {
+	v := newParamValidator("updateProject", p.p)
+	v.required("id")
+	v.ids("id", "userid")
+	v.maxLength("account", 255)
+	v.maxLength("displaytext", 255)
+	v.maxLength("roletype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateProjectParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["keyword"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *ListProjectsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateProjectParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "displaytext", "id", "roletype", "userid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "swapowner":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateProject", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateProject: %w", k, err)
+		}
 	}
-	p.p["listall"] = v
+	return nil
 }
 
-func (p *ListProjectsParams) SetName(v string) {
+func (p *UpdateProjectParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["name"] = v
+	p.p["account"] = v
 }
 
-func (p *ListProjectsParams) SetPage(v int) {
+func (p *UpdateProjectParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *UpdateProjectParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *UpdateProjectParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["page"] = v
+	p.p["displaytext"] = v
 }
 
-func (p *ListProjectsParams) SetPagesize(v int) {
+func (p *UpdateProjectParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *UpdateProjectParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
+func (p *UpdateProjectParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["pagesize"] = v
+	p.p["id"] = v
 }
 
-func (p *ListProjectsParams) SetState(v string) {
+func (p *UpdateProjectParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateProjectParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *UpdateProjectParams) SetRoletype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["state"] = v
+	p.p["roletype"] = v
 }
 
-func (p *ListProjectsParams) SetTags(v map[string]string) {
+func (p *UpdateProjectParams) ResetRoletype() {
+	delete(p.p, "roletype")
+}
+
+func (p *UpdateProjectParams) GetRoletype() (string, bool) {
+	value, ok := p.p["roletype"].(string)
+	return value, ok
+}
+
+func (p *UpdateProjectParams) SetSwapowner(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["tags"] = v
+	p.p["swapowner"] = v
 }
 
-func (p *ListProjectsParams) SetUsername(v string) {
+func (p *UpdateProjectParams) ResetSwapowner() {
+	delete(p.p, "swapowner")
+}
+
+func (p *UpdateProjectParams) GetSwapowner() (bool, bool) {
+	value, ok := p.p["swapowner"].(bool)
+	return value, ok
+}
+
+func (p *UpdateProjectParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["username"] = v
+	p.p["userid"] = v
 }
 
-// You should always use this function to get a new ListProjectsParams instance,
-// as then you are sure you have configured all required params
-func (s *ProjectService) NewListProjectsParams() *ListProjectsParams {
-	p := &ListProjectsParams{}
+func (p *UpdateProjectParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *UpdateProjectParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new UpdateProjectParams instance,
+// as then you are sure you have configured all required params
+func (s *ProjectService) NewUpdateProjectParams(id string) *UpdateProjectParams {
+	p := &UpdateProjectParams{}
 	p.p = make(map[string]interface{})
+	p.p["id"] = id
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ProjectService) GetProjectID(name string, opts ...OptionFunc) (string, int, error) {
-	p := &ListProjectsParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["name"] = name
+// Updates a project
+func (s *ProjectService) UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error) {
+	return s.UpdateProjectWithContext(context.Background(), p)
+}
 
-	for _, fn := range append(s.cs.options, opts...) {
-		if err := fn(s.cs, p); err != nil {
-			return "", -1, err
-		}
+// UpdateProjectWithContext is the same as UpdateProject, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) UpdateProjectWithContext(ctx context.Context, p *UpdateProjectParams) (*UpdateProjectResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
 
-	l, err := s.ListProjects(p)
+	resp, err := s.cs.newRequestWithContext(ctx, "updateProject", p.ToURLValues())
 	if err != nil {
-		return "", -1, err
-	}
-
-	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return nil, err
 	}
 
-	if l.Count == 1 {
-		return l.Projects[0].Id, l.Count, nil
+	var r UpdateProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.Projects {
-			if v.Name == name {
-				return v.Id, l.Count, nil
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
 			}
+			return nil, err
 		}
-	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ProjectService) GetProjectByName(name string, opts ...OptionFunc) (*Project, int, error) {
-	id, count, err := s.GetProjectID(name, opts...)
-	if err != nil {
-		return nil, count, err
-	}
 
-	r, count, err := s.GetProjectByID(id, opts...)
-	if err != nil {
-		return nil, count, err
-	}
-	return r, count, nil
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ProjectService) GetProjectByID(id string, opts ...OptionFunc) (*Project, int, error) {
-	p := &ListProjectsParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
-
-	for _, fn := range append(s.cs.options, opts...) {
-		if err := fn(s.cs, p); err != nil {
-			return nil, -1, err
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	l, err := s.ListProjects(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
 		}
-		return nil, -1, err
 	}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+	return &r, nil
+}
 
-	if l.Count == 1 {
-		return l.Projects[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for Project UUID: %s!", id)
+// UpdateProjectAsync starts the async job for UpdateProject and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateProjectResponse using Job.Result
+func (s *ProjectService) UpdateProjectAsync(p *UpdateProjectParams) (*Job, error) {
+	return s.UpdateProjectAsyncWithContext(context.Background(), p)
 }
 
-// Lists projects and provides detailed information for listed projects
-func (s *ProjectService) ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error) {
-	resp, err := s.cs.newRequest("listProjects", p.toURLValues())
+// UpdateProjectAsyncWithContext is the same as UpdateProjectAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ProjectService) UpdateProjectAsyncWithContext(ctx context.Context, p *UpdateProjectParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateProject", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListProjectsResponse
+	var r UpdateProjectResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
-	return &r, nil
-}
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
 
-type ListProjectsResponse struct {
-	Count    int        `json:"count"`
-	Projects []*Project `json:"project"`
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
 }
 
-type Project struct {
+type UpdateProjectResponse struct {
 	Cpuavailable              string   `json:"cpuavailable"`
 	Cpulimit                  string   `json:"cpulimit"`
 	Cputotal                  int64    `json:"cputotal"`
@@ -977,52 +4020,196 @@ type Project struct {
 	Vpctotal                  int64    `json:"vpctotal"`
 }
 
-type SuspendProjectParams struct {
+type UpdateProjectInvitationParams struct {
 	p map[string]interface{}
 }
 
-func (p *SuspendProjectParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateProjectInvitationParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v, found := p.p["accept"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("accept", vv)
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["token"]; found {
+		u.Set("token", v.(string))
+	}
+	if v, found := p.p["userid"]; found {
+		u.Set("userid", v.(string))
 	}
 	return u
 }
 
-func (p *SuspendProjectParams) SetId(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateProjectInvitationParams) Validate() error {
+	v := newParamValidator("updateProjectInvitation", p.p)
+	v.required("projectid")
+	v.ids("projectid", "userid")
+	v.maxLength("account", 255)
+	v.maxLength("token", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateProjectInvitationParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateProjectInvitationParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "accept":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "account", "projectid", "token", "userid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateProjectInvitation", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateProjectInvitation: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *UpdateProjectInvitationParams) SetAccept(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["accept"] = v
 }
 
-// You should always use this function to get a new SuspendProjectParams instance,
+func (p *UpdateProjectInvitationParams) ResetAccept() {
+	delete(p.p, "accept")
+}
+
+func (p *UpdateProjectInvitationParams) GetAccept() (bool, bool) {
+	value, ok := p.p["accept"].(bool)
+	return value, ok
+}
+
+func (p *UpdateProjectInvitationParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *UpdateProjectInvitationParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *UpdateProjectInvitationParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *UpdateProjectInvitationParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *UpdateProjectInvitationParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *UpdateProjectInvitationParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *UpdateProjectInvitationParams) SetToken(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["token"] = v
+}
+
+func (p *UpdateProjectInvitationParams) ResetToken() {
+	delete(p.p, "token")
+}
+
+func (p *UpdateProjectInvitationParams) GetToken() (string, bool) {
+	value, ok := p.p["token"].(string)
+	return value, ok
+}
+
+func (p *UpdateProjectInvitationParams) SetUserid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["userid"] = v
+}
+
+func (p *UpdateProjectInvitationParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *UpdateProjectInvitationParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new UpdateProjectInvitationParams instance,
 // as then you are sure you have configured all required params
-func (s *ProjectService) NewSuspendProjectParams(id string) *SuspendProjectParams {
-	p := &SuspendProjectParams{}
+func (s *ProjectService) NewUpdateProjectInvitationParams(projectid string) *UpdateProjectInvitationParams {
+	p := &UpdateProjectInvitationParams{}
 	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.p["projectid"] = projectid
 	return p
 }
 
-// Suspends a project
-func (s *ProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error) {
-	resp, err := s.cs.newRequest("suspendProject", p.toURLValues())
+// Accepts or declines project invitation
+func (s *ProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
+	return s.UpdateProjectInvitationWithContext(context.Background(), p)
+}
+
+// UpdateProjectInvitationWithContext is the same as UpdateProjectInvitation, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) UpdateProjectInvitationWithContext(ctx context.Context, p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateProjectInvitation", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r SuspendProjectResponse
+	var r UpdateProjectInvitationResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1030,339 +4217,442 @@ func (s *ProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjec
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
 		if err := json.Unmarshal(b, &r); err != nil {
 			return nil, err
 		}
 	}
 
-	return &r, nil
-}
-
-type SuspendProjectResponse struct {
-	Cpuavailable              string   `json:"cpuavailable"`
-	Cpulimit                  string   `json:"cpulimit"`
-	Cputotal                  int64    `json:"cputotal"`
-	Displaytext               string   `json:"displaytext"`
-	Domain                    string   `json:"domain"`
-	Domainid                  string   `json:"domainid"`
-	Id                        string   `json:"id"`
-	Ipavailable               string   `json:"ipavailable"`
-	Iplimit                   string   `json:"iplimit"`
-	Iptotal                   int64    `json:"iptotal"`
-	JobID                     string   `json:"jobid"`
-	Jobstatus                 int      `json:"jobstatus"`
-	Memoryavailable           string   `json:"memoryavailable"`
-	Memorylimit               string   `json:"memorylimit"`
-	Memorytotal               int64    `json:"memorytotal"`
-	Name                      string   `json:"name"`
-	Networkavailable          string   `json:"networkavailable"`
-	Networklimit              string   `json:"networklimit"`
-	Networktotal              int64    `json:"networktotal"`
-	Owner                     []string `json:"owner"`
-	Primarystorageavailable   string   `json:"primarystorageavailable"`
-	Primarystoragelimit       string   `json:"primarystoragelimit"`
-	Primarystoragetotal       int64    `json:"primarystoragetotal"`
-	Projectaccountname        string   `json:"projectaccountname"`
-	Secondarystorageavailable string   `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string   `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64  `json:"secondarystoragetotal"`
-	Snapshotavailable         string   `json:"snapshotavailable"`
-	Snapshotlimit             string   `json:"snapshotlimit"`
-	Snapshottotal             int64    `json:"snapshottotal"`
-	State                     string   `json:"state"`
-	Tags                      []Tags   `json:"tags"`
-	Templateavailable         string   `json:"templateavailable"`
-	Templatelimit             string   `json:"templatelimit"`
-	Templatetotal             int64    `json:"templatetotal"`
-	Vmavailable               string   `json:"vmavailable"`
-	Vmlimit                   string   `json:"vmlimit"`
-	Vmrunning                 int      `json:"vmrunning"`
-	Vmstopped                 int      `json:"vmstopped"`
-	Vmtotal                   int64    `json:"vmtotal"`
-	Volumeavailable           string   `json:"volumeavailable"`
-	Volumelimit               string   `json:"volumelimit"`
-	Volumetotal               int64    `json:"volumetotal"`
-	Vpcavailable              string   `json:"vpcavailable"`
-	Vpclimit                  string   `json:"vpclimit"`
-	Vpctotal                  int64    `json:"vpctotal"`
+	return &r, nil
 }
 
-type UpdateProjectParams struct {
+// UpdateProjectInvitationAsync starts the async job for UpdateProjectInvitation and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateProjectInvitationResponse using Job.Result
+func (s *ProjectService) UpdateProjectInvitationAsync(p *UpdateProjectInvitationParams) (*Job, error) {
+	return s.UpdateProjectInvitationAsyncWithContext(context.Background(), p)
+}
+
+// UpdateProjectInvitationAsyncWithContext is the same as UpdateProjectInvitationAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ProjectService) UpdateProjectInvitationAsyncWithContext(ctx context.Context, p *UpdateProjectInvitationParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateProjectInvitation", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateProjectInvitationResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
+type UpdateProjectInvitationResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+type UpdateProjectRoleParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateProjectParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateProjectRoleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if v, found := p.p["description"]; found {
+		u.Set("description", v.(string))
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
 	}
-	if v, found := p.p["roletype"]; found {
-		u.Set("roletype", v.(string))
-	}
-	if v, found := p.p["swapowner"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("swapowner", vv)
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
 	}
 	return u
 }
 
-func (p *UpdateProjectParams) SetAccount(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateProjectRoleParams) Validate() error {
+	v := newParamValidator("updateProjectRole", p.p)
+	v.required("id", "projectid")
+	v.ids("id", "projectid")
+	v.maxLength("description", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateProjectRoleParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["account"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *UpdateProjectParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateProjectRoleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "id", "name", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateProjectRole", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateProjectRole: %w", k, err)
+		}
 	}
-	p.p["displaytext"] = v
+	return nil
 }
 
-func (p *UpdateProjectParams) SetId(v string) {
+func (p *UpdateProjectRoleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["description"] = v
 }
 
-func (p *UpdateProjectParams) SetRoletype(v string) {
+func (p *UpdateProjectRoleParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *UpdateProjectRoleParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
+func (p *UpdateProjectRoleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["roletype"] = v
+	p.p["id"] = v
 }
 
-func (p *UpdateProjectParams) SetSwapowner(v bool) {
+func (p *UpdateProjectRoleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateProjectRoleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *UpdateProjectRoleParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["swapowner"] = v
+	p.p["name"] = v
 }
 
-func (p *UpdateProjectParams) SetUserid(v string) {
+func (p *UpdateProjectRoleParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateProjectRoleParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *UpdateProjectRoleParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["userid"] = v
+	p.p["projectid"] = v
 }
 
-// You should always use this function to get a new UpdateProjectParams instance,
+func (p *UpdateProjectRoleParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *UpdateProjectRoleParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new UpdateProjectRoleParams instance,
 // as then you are sure you have configured all required params
-func (s *ProjectService) NewUpdateProjectParams(id string) *UpdateProjectParams {
-	p := &UpdateProjectParams{}
+func (s *ProjectService) NewUpdateProjectRoleParams(id string, projectid string) *UpdateProjectRoleParams {
+	p := &UpdateProjectRoleParams{}
 	p.p = make(map[string]interface{})
 	p.p["id"] = id
+	p.p["projectid"] = projectid
 	return p
 }
 
-// Updates a project
-func (s *ProjectService) UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error) {
-	resp, err := s.cs.newRequest("updateProject", p.toURLValues())
-	if err != nil {
+// Creates a Project role
+func (s *ProjectService) UpdateProjectRole(p *UpdateProjectRoleParams) (*UpdateProjectRoleResponse, error) {
+	return s.UpdateProjectRoleWithContext(context.Background(), p)
+}
+
+// UpdateProjectRoleWithContext is the same as UpdateProjectRole, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) UpdateProjectRoleWithContext(ctx context.Context, p *UpdateProjectRoleParams) (*UpdateProjectRoleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
 		return nil, err
 	}
 
-	var r UpdateProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateProjectRole", p.ToURLValues())
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	var r UpdateProjectRoleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
 
 	return &r, nil
 }
 
-type UpdateProjectResponse struct {
-	Cpuavailable              string   `json:"cpuavailable"`
-	Cpulimit                  string   `json:"cpulimit"`
-	Cputotal                  int64    `json:"cputotal"`
-	Displaytext               string   `json:"displaytext"`
-	Domain                    string   `json:"domain"`
-	Domainid                  string   `json:"domainid"`
-	Id                        string   `json:"id"`
-	Ipavailable               string   `json:"ipavailable"`
-	Iplimit                   string   `json:"iplimit"`
-	Iptotal                   int64    `json:"iptotal"`
-	JobID                     string   `json:"jobid"`
-	Jobstatus                 int      `json:"jobstatus"`
-	Memoryavailable           string   `json:"memoryavailable"`
-	Memorylimit               string   `json:"memorylimit"`
-	Memorytotal               int64    `json:"memorytotal"`
-	Name                      string   `json:"name"`
-	Networkavailable          string   `json:"networkavailable"`
-	Networklimit              string   `json:"networklimit"`
-	Networktotal              int64    `json:"networktotal"`
-	Owner                     []string `json:"owner"`
-	Primarystorageavailable   string   `json:"primarystorageavailable"`
-	Primarystoragelimit       string   `json:"primarystoragelimit"`
-	Primarystoragetotal       int64    `json:"primarystoragetotal"`
-	Projectaccountname        string   `json:"projectaccountname"`
-	Secondarystorageavailable string   `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string   `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64  `json:"secondarystoragetotal"`
-	Snapshotavailable         string   `json:"snapshotavailable"`
-	Snapshotlimit             string   `json:"snapshotlimit"`
-	Snapshottotal             int64    `json:"snapshottotal"`
-	State                     string   `json:"state"`
-	Tags                      []Tags   `json:"tags"`
-	Templateavailable         string   `json:"templateavailable"`
-	Templatelimit             string   `json:"templatelimit"`
-	Templatetotal             int64    `json:"templatetotal"`
-	Vmavailable               string   `json:"vmavailable"`
-	Vmlimit                   string   `json:"vmlimit"`
-	Vmrunning                 int      `json:"vmrunning"`
-	Vmstopped                 int      `json:"vmstopped"`
-	Vmtotal                   int64    `json:"vmtotal"`
-	Volumeavailable           string   `json:"volumeavailable"`
-	Volumelimit               string   `json:"volumelimit"`
-	Volumetotal               int64    `json:"volumetotal"`
-	Vpcavailable              string   `json:"vpcavailable"`
-	Vpclimit                  string   `json:"vpclimit"`
-	Vpctotal                  int64    `json:"vpctotal"`
+type UpdateProjectRoleResponse struct {
+	Description string `json:"description"`
+	Id          string `json:"id"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Name        string `json:"name"`
+	Projectid   string `json:"projectid"`
 }
 
-type UpdateProjectInvitationParams struct {
+type UpdateProjectRolePermissionParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateProjectInvitationParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateProjectRolePermissionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["accept"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("accept", vv)
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v, found := p.p["permission"]; found {
+		u.Set("permission", v.(string))
 	}
 	if v, found := p.p["projectid"]; found {
 		u.Set("projectid", v.(string))
 	}
-	if v, found := p.p["token"]; found {
-		u.Set("token", v.(string))
+	if v, found := p.p["projectroleid"]; found {
+		u.Set("projectroleid", v.(string))
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if v, found := p.p["projectrolepermissionid"]; found {
+		u.Set("projectrolepermissionid", v.(string))
+	}
+	if v, found := p.p["ruleorder"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("ruleorder", vv)
 	}
 	return u
 }
 
-func (p *UpdateProjectInvitationParams) SetAccept(v bool) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateProjectRolePermissionParams) Validate() error {
+	v := newParamValidator("updateProjectRolePermission", p.p)
+	v.required("projectid", "projectroleid")
+	v.ids("projectid", "projectroleid", "projectrolepermissionid")
+	v.maxLength("permission", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateProjectRolePermissionParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["accept"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *UpdateProjectInvitationParams) SetAccount(v string) {
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateProjectRolePermissionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "permission", "projectid", "projectroleid", "projectrolepermissionid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ruleorder":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateProjectRolePermission", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateProjectRolePermission: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *UpdateProjectRolePermissionParams) SetPermission(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["account"] = v
+	p.p["permission"] = v
 }
 
-func (p *UpdateProjectInvitationParams) SetProjectid(v string) {
+func (p *UpdateProjectRolePermissionParams) ResetPermission() {
+	delete(p.p, "permission")
+}
+
+func (p *UpdateProjectRolePermissionParams) GetPermission() (string, bool) {
+	value, ok := p.p["permission"].(string)
+	return value, ok
+}
+
+func (p *UpdateProjectRolePermissionParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["projectid"] = v
 }
 
-func (p *UpdateProjectInvitationParams) SetToken(v string) {
+func (p *UpdateProjectRolePermissionParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *UpdateProjectRolePermissionParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *UpdateProjectRolePermissionParams) SetProjectroleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["token"] = v
+	p.p["projectroleid"] = v
 }
 
-func (p *UpdateProjectInvitationParams) SetUserid(v string) {
+func (p *UpdateProjectRolePermissionParams) ResetProjectroleid() {
+	delete(p.p, "projectroleid")
+}
+
+func (p *UpdateProjectRolePermissionParams) GetProjectroleid() (string, bool) {
+	value, ok := p.p["projectroleid"].(string)
+	return value, ok
+}
+
+func (p *UpdateProjectRolePermissionParams) SetProjectrolepermissionid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["userid"] = v
+	p.p["projectrolepermissionid"] = v
 }
 
-// You should always use this function to get a new UpdateProjectInvitationParams instance,
+func (p *UpdateProjectRolePermissionParams) ResetProjectrolepermissionid() {
+	delete(p.p, "projectrolepermissionid")
+}
+
+func (p *UpdateProjectRolePermissionParams) GetProjectrolepermissionid() (string, bool) {
+	value, ok := p.p["projectrolepermissionid"].(string)
+	return value, ok
+}
+
+func (p *UpdateProjectRolePermissionParams) SetRuleorder(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ruleorder"] = v
+}
+
+func (p *UpdateProjectRolePermissionParams) ResetRuleorder() {
+	delete(p.p, "ruleorder")
+}
+
+func (p *UpdateProjectRolePermissionParams) GetRuleorder() ([]string, bool) {
+	value, ok := p.p["ruleorder"].([]string)
+	return value, ok
+}
+
+// You should always use this function to get a new UpdateProjectRolePermissionParams instance,
 // as then you are sure you have configured all required params
-func (s *ProjectService) NewUpdateProjectInvitationParams(projectid string) *UpdateProjectInvitationParams {
-	p := &UpdateProjectInvitationParams{}
+func (s *ProjectService) NewUpdateProjectRolePermissionParams(projectid string, projectroleid string) *UpdateProjectRolePermissionParams {
+	p := &UpdateProjectRolePermissionParams{}
 	p.p = make(map[string]interface{})
 	p.p["projectid"] = projectid
+	p.p["projectroleid"] = projectroleid
 	return p
 }
 
-// Accepts or declines project invitation
-func (s *ProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
-	resp, err := s.cs.newRequest("updateProjectInvitation", p.toURLValues())
-	if err != nil {
+// Updates a project role permission and/or order
+func (s *ProjectService) UpdateProjectRolePermission(p *UpdateProjectRolePermissionParams) (*UpdateProjectRolePermissionResponse, error) {
+	return s.UpdateProjectRolePermissionWithContext(context.Background(), p)
+}
+
+// UpdateProjectRolePermissionWithContext is the same as UpdateProjectRolePermission, but uses ctx to cancel the request and any async job polling
+func (s *ProjectService) UpdateProjectRolePermissionWithContext(ctx context.Context, p *UpdateProjectRolePermissionParams) (*UpdateProjectRolePermissionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
 		return nil, err
 	}
 
-	var r UpdateProjectInvitationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequestWithContext(ctx, "updateProjectRolePermission", p.ToURLValues())
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	var r UpdateProjectRolePermissionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
 
 	return &r, nil
 }
 
-type UpdateProjectInvitationResponse struct {
+type UpdateProjectRolePermissionResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
 	Jobstatus   int    `json:"jobstatus"`
 	Success     bool   `json:"success"`
 }
+
+func (r *UpdateProjectRolePermissionResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias UpdateProjectRolePermissionResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
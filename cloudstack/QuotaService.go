@@ -20,15 +20,26 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 )
 
+// QuotaServiceIface is the interface implemented by QuotaService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type QuotaServiceIface interface {
+	NewQuotaIsEnabledParams() *QuotaIsEnabledParams
+	QuotaIsEnabled(p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error)
+	QuotaIsEnabledWithContext(ctx context.Context, p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error)
+}
+
 type QuotaIsEnabledParams struct {
 	p map[string]interface{}
 }
 
-func (p *QuotaIsEnabledParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *QuotaIsEnabledParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -36,6 +47,34 @@ func (p *QuotaIsEnabledParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *QuotaIsEnabledParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *QuotaIsEnabledParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *QuotaIsEnabledParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k := range m {
+		return fmt.Errorf("Unknown param %s for quotaIsEnabled", k)
+	}
+	return nil
+}
+
 // You should always use this function to get a new QuotaIsEnabledParams instance,
 // as then you are sure you have configured all required params
 func (s *QuotaService) NewQuotaIsEnabledParams() *QuotaIsEnabledParams {
@@ -46,7 +85,16 @@ func (s *QuotaService) NewQuotaIsEnabledParams() *QuotaIsEnabledParams {
 
 // Return true if the plugin is enabled
 func (s *QuotaService) QuotaIsEnabled(p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error) {
-	resp, err := s.cs.newRequest("quotaIsEnabled", p.toURLValues())
+	return s.QuotaIsEnabledWithContext(context.Background(), p)
+}
+
+// QuotaIsEnabledWithContext is the same as QuotaIsEnabled, but uses ctx to cancel the request and any async job polling
+func (s *QuotaService) QuotaIsEnabledWithContext(ctx context.Context, p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "quotaIsEnabled", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
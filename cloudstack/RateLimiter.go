@@ -0,0 +1,184 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultRateLimitInterval is the default interval of a RateLimiter, which
+// matches the default value of the CloudStack api.throttling.interval setting
+const DefaultRateLimitInterval = time.Second
+
+// RateLimiter is a token bucket rate limiter that allows a number of requests per interval, which
+// mirrors the API throttling of CloudStack (the api.throttling.max and api.throttling.interval
+// settings). When CloudStack still throttles a request (HTTP 429) no more requests are sent until
+// the next interval. A RateLimiter is safe for concurrent use, and can be shared by multiple
+// clients using the same account, as the quota is applied per account.
+type RateLimiter struct {
+	mu       sync.Mutex
+	rate     float64       // The number of tokens added per second
+	burst    float64       // The max number of tokens
+	interval time.Duration // The interval in which the quota is reset
+	tokens   float64       // The available tokens, which is negative when there are pending reservations
+	last     time.Time     // The last time tokens were added, which is in the future while requests are blocked
+}
+
+// NewRateLimiter returns a limiter that allows limit requests per interval. If interval is
+// zero, DefaultRateLimitInterval is used.
+func NewRateLimiter(limit int, interval time.Duration) *RateLimiter {
+	l := &RateLimiter{last: time.Now()}
+	l.SetLimit(limit, interval)
+	l.tokens = l.burst
+	return l
+}
+
+// WithRateLimiter limits the rate of the requests sent by the client, including the requests
+// used to poll async jobs and to login a session client
+func WithRateLimiter(l *RateLimiter) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.limiter = l
+	}
+}
+
+// SetLimit changes the number of requests that are allowed per interval. If interval is zero,
+// DefaultRateLimitInterval is used.
+func (l *RateLimiter) SetLimit(limit int, interval time.Duration) {
+	if limit < 1 {
+		limit = 1
+	}
+	if interval <= 0 {
+		interval = DefaultRateLimitInterval
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+	l.interval = interval
+	l.burst = float64(limit)
+	l.rate = float64(limit) / interval.Seconds()
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// Seed sets the limit and the remaining quota of the current interval using the getApiLimit
+// API, which returns the quota of the account of the client. This only works if API throttling
+// is enabled in CloudStack. The interval of the limiter is kept as is, as the API doesn't return
+// the configured interval.
+func (l *RateLimiter) Seed(ctx context.Context, cs *CloudStackClient) error {
+	r, err := cs.Limit.GetApiLimitWithContext(ctx, cs.Limit.NewGetApiLimitParams())
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	interval := l.interval
+	l.mu.Unlock()
+
+	l.SetLimit(r.ApiAllowed, interval)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if remaining := float64(r.ApiAllowed - r.ApiIssued); remaining < l.tokens {
+		l.tokens = remaining
+	}
+	if l.tokens < 1 {
+		// The quota is used up, so wait until it is reset
+		l.block(now.Add(time.Duration(r.ExpireAfter) * time.Millisecond))
+	}
+
+	return nil
+}
+
+// Wait blocks until a request is allowed, or until ctx is done in which case the context error
+// is returned. Requests are allowed in the order in which Wait is called.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.refill(now)
+	l.tokens--
+
+	// Reserve a token, and wait until the reserved token is available
+	var d time.Duration
+	if l.last.After(now) {
+		d = l.last.Sub(now)
+	}
+	if l.tokens < 0 {
+		d += time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if d <= 0 {
+		return nil
+	}
+
+	if err := sleepWithContext(ctx, d); err != nil {
+		// Return the reserved token, as it isn't used
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// Stops sending requests until the next interval, after CloudStack throttled a request
+func (l *RateLimiter) throttle() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+	l.block(now.Add(l.interval))
+}
+
+// Blocks all requests until t, when the quota is reset and the bucket is full again
+// except for the pending reservations; must be called with the lock held
+func (l *RateLimiter) block(t time.Time) {
+	if !t.After(l.last) {
+		return
+	}
+
+	if l.tokens > 0 {
+		l.tokens = 0
+	}
+	l.tokens += l.burst
+	l.last = t
+}
+
+// Adds the tokens for the time passed since the last refill; must be called with the lock held
+func (l *RateLimiter) refill(now time.Time) {
+	if !now.After(l.last) {
+		return
+	}
+
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+}
@@ -20,16 +20,40 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// RegionServiceIface is the interface implemented by RegionService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type RegionServiceIface interface {
+	NewAddRegionParams(endpoint string, id int, name string) *AddRegionParams
+	AddRegion(p *AddRegionParams) (*AddRegionResponse, error)
+	AddRegionWithContext(ctx context.Context, p *AddRegionParams) (*AddRegionResponse, error)
+	NewListRegionsParams() *ListRegionsParams
+	ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error)
+	ListRegionsWithContext(ctx context.Context, p *ListRegionsParams) (*ListRegionsResponse, error)
+	NewListRegionsPager(p *ListRegionsParams) *ListRegionsPager
+	ListRegionsAll(p *ListRegionsParams) (*ListRegionsResponse, error)
+	ListRegionsAllWithContext(ctx context.Context, p *ListRegionsParams) (*ListRegionsResponse, error)
+	NewRemoveRegionParams(id int) *RemoveRegionParams
+	RemoveRegion(p *RemoveRegionParams) (*RemoveRegionResponse, error)
+	RemoveRegionWithContext(ctx context.Context, p *RemoveRegionParams) (*RemoveRegionResponse, error)
+	NewUpdateRegionParams(id int) *UpdateRegionParams
+	UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error)
+	UpdateRegionWithContext(ctx context.Context, p *UpdateRegionParams) (*UpdateRegionResponse, error)
+}
+
 type AddRegionParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddRegionParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddRegionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -47,6 +71,53 @@ func (p *AddRegionParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddRegionParams) Validate() error {
+	v := newParamValidator("addRegion", p.p)
+	v.required("endpoint", "id", "name")
+	v.maxLength("endpoint", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddRegionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddRegionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "endpoint", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addRegion", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addRegion: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddRegionParams) SetEndpoint(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -54,6 +125,15 @@ func (p *AddRegionParams) SetEndpoint(v string) {
 	p.p["endpoint"] = v
 }
 
+func (p *AddRegionParams) ResetEndpoint() {
+	delete(p.p, "endpoint")
+}
+
+func (p *AddRegionParams) GetEndpoint() (string, bool) {
+	value, ok := p.p["endpoint"].(string)
+	return value, ok
+}
+
 func (p *AddRegionParams) SetId(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -61,6 +141,15 @@ func (p *AddRegionParams) SetId(v int) {
 	p.p["id"] = v
 }
 
+func (p *AddRegionParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *AddRegionParams) GetId() (int, bool) {
+	value, ok := p.p["id"].(int)
+	return value, ok
+}
+
 func (p *AddRegionParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -68,6 +157,15 @@ func (p *AddRegionParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *AddRegionParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *AddRegionParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddRegionParams instance,
 // as then you are sure you have configured all required params
 func (s *RegionService) NewAddRegionParams(endpoint string, id int, name string) *AddRegionParams {
@@ -81,7 +179,16 @@ func (s *RegionService) NewAddRegionParams(endpoint string, id int, name string)
 
 // Adds a Region
 func (s *RegionService) AddRegion(p *AddRegionParams) (*AddRegionResponse, error) {
-	resp, err := s.cs.newRequest("addRegion", p.toURLValues())
+	return s.AddRegionWithContext(context.Background(), p)
+}
+
+// AddRegionWithContext is the same as AddRegion, but uses ctx to cancel the request and any async job polling
+func (s *RegionService) AddRegionWithContext(ctx context.Context, p *AddRegionParams) (*AddRegionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addRegion", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +215,8 @@ type ListRegionsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListRegionsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListRegionsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -134,6 +242,52 @@ func (p *ListRegionsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListRegionsParams) Validate() error {
+	v := newParamValidator("listRegions", p.p)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListRegionsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListRegionsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "keyword", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listRegions", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listRegions: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListRegionsParams) SetId(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -141,6 +295,15 @@ func (p *ListRegionsParams) SetId(v int) {
 	p.p["id"] = v
 }
 
+func (p *ListRegionsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListRegionsParams) GetId() (int, bool) {
+	value, ok := p.p["id"].(int)
+	return value, ok
+}
+
 func (p *ListRegionsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -148,6 +311,15 @@ func (p *ListRegionsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListRegionsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListRegionsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListRegionsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -155,6 +327,15 @@ func (p *ListRegionsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListRegionsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListRegionsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListRegionsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -162,6 +343,15 @@ func (p *ListRegionsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListRegionsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListRegionsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListRegionsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -169,6 +359,15 @@ func (p *ListRegionsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListRegionsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListRegionsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListRegionsParams instance,
 // as then you are sure you have configured all required params
 func (s *RegionService) NewListRegionsParams() *ListRegionsParams {
@@ -179,7 +378,16 @@ func (s *RegionService) NewListRegionsParams() *ListRegionsParams {
 
 // Lists Regions
 func (s *RegionService) ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error) {
-	resp, err := s.cs.newRequest("listRegions", p.toURLValues())
+	return s.ListRegionsWithContext(context.Background(), p)
+}
+
+// ListRegionsWithContext is the same as ListRegions, but uses ctx to cancel the request and any async job polling
+func (s *RegionService) ListRegionsWithContext(ctx context.Context, p *ListRegionsParams) (*ListRegionsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listRegions", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -192,6 +400,92 @@ func (s *RegionService) ListRegions(p *ListRegionsParams) (*ListRegionsResponse,
 	return &r, nil
 }
 
+// ListRegionsPager iterates over all pages of a ListRegions call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListRegionsPager struct {
+	pager
+
+	s *RegionService
+	p *ListRegionsParams
+	r *ListRegionsResponse
+}
+
+// NewListRegionsPager returns a pager for all pages of a ListRegions call with the given params
+func (s *RegionService) NewListRegionsPager(p *ListRegionsParams) *ListRegionsPager {
+	return &ListRegionsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListRegionsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListRegionsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListRegionsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListRegionsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Regions), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListRegionsPager) Page() *ListRegionsResponse {
+	return pg.r
+}
+
+// ListRegionsAll fetches all pages of a ListRegions call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *RegionService) ListRegionsAll(p *ListRegionsParams) (*ListRegionsResponse, error) {
+	return s.ListRegionsAllWithContext(context.Background(), p)
+}
+
+// ListRegionsAllWithContext is the same as ListRegionsAll, but uses ctx to cancel the requests
+func (s *RegionService) ListRegionsAllWithContext(ctx context.Context, p *ListRegionsParams) (*ListRegionsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Region)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListRegionsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListRegionsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Regions
+		mu.Unlock()
+
+		return len(l.Regions), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListRegionsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Regions = append(r.Regions, pages[page]...)
+	}
+	r.Count = len(r.Regions)
+
+	return r, nil
+}
+
 type ListRegionsResponse struct {
 	Count   int       `json:"count"`
 	Regions []*Region `json:"region"`
@@ -211,7 +505,8 @@ type RemoveRegionParams struct {
 	p map[string]interface{}
 }
 
-func (p *RemoveRegionParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RemoveRegionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -223,6 +518,47 @@ func (p *RemoveRegionParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RemoveRegionParams) Validate() error {
+	v := newParamValidator("removeRegion", p.p)
+	v.required("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RemoveRegionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RemoveRegionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for removeRegion", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of removeRegion: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveRegionParams) SetId(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -230,6 +566,15 @@ func (p *RemoveRegionParams) SetId(v int) {
 	p.p["id"] = v
 }
 
+func (p *RemoveRegionParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RemoveRegionParams) GetId() (int, bool) {
+	value, ok := p.p["id"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new RemoveRegionParams instance,
 // as then you are sure you have configured all required params
 func (s *RegionService) NewRemoveRegionParams(id int) *RemoveRegionParams {
@@ -241,7 +586,16 @@ func (s *RegionService) NewRemoveRegionParams(id int) *RemoveRegionParams {
 
 // Removes specified region
 func (s *RegionService) RemoveRegion(p *RemoveRegionParams) (*RemoveRegionResponse, error) {
-	resp, err := s.cs.newRequest("removeRegion", p.toURLValues())
+	return s.RemoveRegionWithContext(context.Background(), p)
+}
+
+// RemoveRegionWithContext is the same as RemoveRegion, but uses ctx to cancel the request and any async job polling
+func (s *RegionService) RemoveRegionWithContext(ctx context.Context, p *RemoveRegionParams) (*RemoveRegionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeRegion", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -292,7 +646,8 @@ type UpdateRegionParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateRegionParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateRegionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -310,6 +665,53 @@ func (p *UpdateRegionParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateRegionParams) Validate() error {
+	v := newParamValidator("updateRegion", p.p)
+	v.required("id")
+	v.maxLength("endpoint", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateRegionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateRegionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "endpoint", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateRegion", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateRegion: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateRegionParams) SetEndpoint(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -317,6 +719,15 @@ func (p *UpdateRegionParams) SetEndpoint(v string) {
 	p.p["endpoint"] = v
 }
 
+func (p *UpdateRegionParams) ResetEndpoint() {
+	delete(p.p, "endpoint")
+}
+
+func (p *UpdateRegionParams) GetEndpoint() (string, bool) {
+	value, ok := p.p["endpoint"].(string)
+	return value, ok
+}
+
 func (p *UpdateRegionParams) SetId(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -324,6 +735,15 @@ func (p *UpdateRegionParams) SetId(v int) {
 	p.p["id"] = v
 }
 
+func (p *UpdateRegionParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateRegionParams) GetId() (int, bool) {
+	value, ok := p.p["id"].(int)
+	return value, ok
+}
+
 func (p *UpdateRegionParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -331,6 +751,15 @@ func (p *UpdateRegionParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateRegionParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateRegionParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateRegionParams instance,
 // as then you are sure you have configured all required params
 func (s *RegionService) NewUpdateRegionParams(id int) *UpdateRegionParams {
@@ -342,7 +771,16 @@ func (s *RegionService) NewUpdateRegionParams(id int) *UpdateRegionParams {
 
 // Updates a region
 func (s *RegionService) UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error) {
-	resp, err := s.cs.newRequest("updateRegion", p.toURLValues())
+	return s.UpdateRegionWithContext(context.Background(), p)
+}
+
+// UpdateRegionWithContext is the same as UpdateRegion, but uses ctx to cancel the request and any async job polling
+func (s *RegionService) UpdateRegionWithContext(ctx context.Context, p *UpdateRegionParams) (*UpdateRegionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateRegion", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
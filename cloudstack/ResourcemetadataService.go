@@ -20,17 +20,47 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// ResourcemetadataServiceIface is the interface implemented by ResourcemetadataService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type ResourcemetadataServiceIface interface {
+	NewAddResourceDetailParams(details map[string]string, resourceid string, resourcetype string) *AddResourceDetailParams
+	AddResourceDetail(p *AddResourceDetailParams) (*AddResourceDetailResponse, error)
+	AddResourceDetailWithContext(ctx context.Context, p *AddResourceDetailParams) (*AddResourceDetailResponse, error)
+	AddResourceDetailAsync(p *AddResourceDetailParams) (*Job, error)
+	AddResourceDetailAsyncWithContext(ctx context.Context, p *AddResourceDetailParams) (*Job, error)
+	NewGetVolumeSnapshotDetailsParams(snapshotid string) *GetVolumeSnapshotDetailsParams
+	GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error)
+	GetVolumeSnapshotDetailsWithContext(ctx context.Context, p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error)
+	NewListDetailOptionsParams(resourcetype string) *ListDetailOptionsParams
+	ListDetailOptions(p *ListDetailOptionsParams) (*ListDetailOptionsResponse, error)
+	ListDetailOptionsWithContext(ctx context.Context, p *ListDetailOptionsParams) (*ListDetailOptionsResponse, error)
+	NewListResourceDetailsParams(resourcetype string) *ListResourceDetailsParams
+	ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error)
+	ListResourceDetailsWithContext(ctx context.Context, p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error)
+	NewListResourceDetailsPager(p *ListResourceDetailsParams) *ListResourceDetailsPager
+	ListResourceDetailsAll(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error)
+	ListResourceDetailsAllWithContext(ctx context.Context, p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error)
+	NewRemoveResourceDetailParams(resourceid string, resourcetype string) *RemoveResourceDetailParams
+	RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error)
+	RemoveResourceDetailWithContext(ctx context.Context, p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error)
+	RemoveResourceDetailAsync(p *RemoveResourceDetailParams) (*Job, error)
+	RemoveResourceDetailAsyncWithContext(ctx context.Context, p *RemoveResourceDetailParams) (*Job, error)
+}
+
 type AddResourceDetailParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddResourceDetailParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddResourceDetailParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -55,6 +85,57 @@ func (p *AddResourceDetailParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddResourceDetailParams) Validate() error {
+	v := newParamValidator("addResourceDetail", p.p)
+	v.required("details", "resourceid", "resourcetype")
+	v.maxLength("resourceid", 255)
+	v.maxLength("resourcetype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddResourceDetailParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddResourceDetailParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "resourceid", "resourcetype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addResourceDetail", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addResourceDetail: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddResourceDetailParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -62,6 +143,15 @@ func (p *AddResourceDetailParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *AddResourceDetailParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *AddResourceDetailParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *AddResourceDetailParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -69,6 +159,15 @@ func (p *AddResourceDetailParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *AddResourceDetailParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *AddResourceDetailParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *AddResourceDetailParams) SetResourceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -76,6 +175,15 @@ func (p *AddResourceDetailParams) SetResourceid(v string) {
 	p.p["resourceid"] = v
 }
 
+func (p *AddResourceDetailParams) ResetResourceid() {
+	delete(p.p, "resourceid")
+}
+
+func (p *AddResourceDetailParams) GetResourceid() (string, bool) {
+	value, ok := p.p["resourceid"].(string)
+	return value, ok
+}
+
 func (p *AddResourceDetailParams) SetResourcetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -83,6 +191,15 @@ func (p *AddResourceDetailParams) SetResourcetype(v string) {
 	p.p["resourcetype"] = v
 }
 
+func (p *AddResourceDetailParams) ResetResourcetype() {
+	delete(p.p, "resourcetype")
+}
+
+func (p *AddResourceDetailParams) GetResourcetype() (string, bool) {
+	value, ok := p.p["resourcetype"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddResourceDetailParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcemetadataService) NewAddResourceDetailParams(details map[string]string, resourceid string, resourcetype string) *AddResourceDetailParams {
@@ -96,7 +213,16 @@ func (s *ResourcemetadataService) NewAddResourceDetailParams(details map[string]
 
 // Adds detail for the Resource.
 func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams) (*AddResourceDetailResponse, error) {
-	resp, err := s.cs.newRequest("addResourceDetail", p.toURLValues())
+	return s.AddResourceDetailWithContext(context.Background(), p)
+}
+
+// AddResourceDetailWithContext is the same as AddResourceDetail, but uses ctx to cancel the request and any async job polling
+func (s *ResourcemetadataService) AddResourceDetailWithContext(ctx context.Context, p *AddResourceDetailParams) (*AddResourceDetailResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addResourceDetail", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +234,7 @@ func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -124,6 +250,31 @@ func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams)
 	return &r, nil
 }
 
+// AddResourceDetailAsync starts the async job for AddResourceDetail and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddResourceDetailResponse using Job.Result
+func (s *ResourcemetadataService) AddResourceDetailAsync(p *AddResourceDetailParams) (*Job, error) {
+	return s.AddResourceDetailAsyncWithContext(context.Background(), p)
+}
+
+// AddResourceDetailAsyncWithContext is the same as AddResourceDetailAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ResourcemetadataService) AddResourceDetailAsyncWithContext(ctx context.Context, p *AddResourceDetailParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addResourceDetail", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddResourceDetailResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type AddResourceDetailResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -135,7 +286,8 @@ type GetVolumeSnapshotDetailsParams struct {
 	p map[string]interface{}
 }
 
-func (p *GetVolumeSnapshotDetailsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetVolumeSnapshotDetailsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -146,6 +298,48 @@ func (p *GetVolumeSnapshotDetailsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetVolumeSnapshotDetailsParams) Validate() error {
+	v := newParamValidator("getVolumeSnapshotDetails", p.p)
+	v.required("snapshotid")
+	v.maxLength("snapshotid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetVolumeSnapshotDetailsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetVolumeSnapshotDetailsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "snapshotid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getVolumeSnapshotDetails", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getVolumeSnapshotDetails: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *GetVolumeSnapshotDetailsParams) SetSnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -153,6 +347,15 @@ func (p *GetVolumeSnapshotDetailsParams) SetSnapshotid(v string) {
 	p.p["snapshotid"] = v
 }
 
+func (p *GetVolumeSnapshotDetailsParams) ResetSnapshotid() {
+	delete(p.p, "snapshotid")
+}
+
+func (p *GetVolumeSnapshotDetailsParams) GetSnapshotid() (string, bool) {
+	value, ok := p.p["snapshotid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new GetVolumeSnapshotDetailsParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcemetadataService) NewGetVolumeSnapshotDetailsParams(snapshotid string) *GetVolumeSnapshotDetailsParams {
@@ -164,7 +367,16 @@ func (s *ResourcemetadataService) NewGetVolumeSnapshotDetailsParams(snapshotid s
 
 // Get Volume Snapshot Details
 func (s *ResourcemetadataService) GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error) {
-	resp, err := s.cs.newRequest("getVolumeSnapshotDetails", p.toURLValues())
+	return s.GetVolumeSnapshotDetailsWithContext(context.Background(), p)
+}
+
+// GetVolumeSnapshotDetailsWithContext is the same as GetVolumeSnapshotDetails, but uses ctx to cancel the request and any async job polling
+func (s *ResourcemetadataService) GetVolumeSnapshotDetailsWithContext(ctx context.Context, p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getVolumeSnapshotDetails", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -183,11 +395,150 @@ type GetVolumeSnapshotDetailsResponse struct {
 	VolumeiScsiName string `json:"volumeiScsiName"`
 }
 
+type ListDetailOptionsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListDetailOptionsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["resourceid"]; found {
+		u.Set("resourceid", v.(string))
+	}
+	if v, found := p.p["resourcetype"]; found {
+		u.Set("resourcetype", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListDetailOptionsParams) Validate() error {
+	v := newParamValidator("listDetailOptions", p.p)
+	v.required("resourcetype")
+	v.maxLength("resourceid", 255)
+	v.maxLength("resourcetype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListDetailOptionsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListDetailOptionsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "resourceid", "resourcetype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listDetailOptions", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listDetailOptions: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListDetailOptionsParams) SetResourceid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["resourceid"] = v
+}
+
+func (p *ListDetailOptionsParams) ResetResourceid() {
+	delete(p.p, "resourceid")
+}
+
+func (p *ListDetailOptionsParams) GetResourceid() (string, bool) {
+	value, ok := p.p["resourceid"].(string)
+	return value, ok
+}
+
+func (p *ListDetailOptionsParams) SetResourcetype(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["resourcetype"] = v
+}
+
+func (p *ListDetailOptionsParams) ResetResourcetype() {
+	delete(p.p, "resourcetype")
+}
+
+func (p *ListDetailOptionsParams) GetResourcetype() (string, bool) {
+	value, ok := p.p["resourcetype"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListDetailOptionsParams instance,
+// as then you are sure you have configured all required params
+func (s *ResourcemetadataService) NewListDetailOptionsParams(resourcetype string) *ListDetailOptionsParams {
+	p := &ListDetailOptionsParams{}
+	p.p = make(map[string]interface{})
+	p.p["resourcetype"] = resourcetype
+	return p
+}
+
+// Lists all possible details and their options for a resource type such as a VM or a template
+func (s *ResourcemetadataService) ListDetailOptions(p *ListDetailOptionsParams) (*ListDetailOptionsResponse, error) {
+	return s.ListDetailOptionsWithContext(context.Background(), p)
+}
+
+// ListDetailOptionsWithContext is the same as ListDetailOptions, but uses ctx to cancel the request and any async job polling
+func (s *ResourcemetadataService) ListDetailOptionsWithContext(ctx context.Context, p *ListDetailOptionsParams) (*ListDetailOptionsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listDetailOptions", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListDetailOptionsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListDetailOptionsResponse struct {
+	Count         int             `json:"count"`
+	DetailOptions []*DetailOption `json:"detailoption"`
+}
+
+type DetailOption struct {
+	Details   map[string]string `json:"details"`
+	JobID     string            `json:"jobid"`
+	Jobstatus int               `json:"jobstatus"`
+}
+
 type ListResourceDetailsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListResourceDetailsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListResourceDetailsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -239,6 +590,62 @@ func (p *ListResourceDetailsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListResourceDetailsParams) Validate() error {
+	v := newParamValidator("listResourceDetails", p.p)
+	v.required("resourcetype")
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("key", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("resourceid", 255)
+	v.maxLength("resourcetype", 255)
+	v.maxLength("value", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListResourceDetailsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListResourceDetailsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "key", "keyword", "projectid", "resourceid", "resourcetype", "value":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listResourceDetails", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listResourceDetails: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListResourceDetailsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -246,6 +653,15 @@ func (p *ListResourceDetailsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListResourceDetailsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListResourceDetailsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListResourceDetailsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -253,6 +669,15 @@ func (p *ListResourceDetailsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListResourceDetailsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListResourceDetailsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListResourceDetailsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -260,6 +685,15 @@ func (p *ListResourceDetailsParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListResourceDetailsParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListResourceDetailsParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListResourceDetailsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -267,6 +701,15 @@ func (p *ListResourceDetailsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListResourceDetailsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListResourceDetailsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListResourceDetailsParams) SetKey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -274,6 +717,15 @@ func (p *ListResourceDetailsParams) SetKey(v string) {
 	p.p["key"] = v
 }
 
+func (p *ListResourceDetailsParams) ResetKey() {
+	delete(p.p, "key")
+}
+
+func (p *ListResourceDetailsParams) GetKey() (string, bool) {
+	value, ok := p.p["key"].(string)
+	return value, ok
+}
+
 func (p *ListResourceDetailsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -281,6 +733,15 @@ func (p *ListResourceDetailsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListResourceDetailsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListResourceDetailsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListResourceDetailsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -288,6 +749,15 @@ func (p *ListResourceDetailsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListResourceDetailsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListResourceDetailsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListResourceDetailsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -295,6 +765,15 @@ func (p *ListResourceDetailsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListResourceDetailsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListResourceDetailsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListResourceDetailsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -302,6 +781,15 @@ func (p *ListResourceDetailsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListResourceDetailsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListResourceDetailsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListResourceDetailsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -309,6 +797,15 @@ func (p *ListResourceDetailsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListResourceDetailsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListResourceDetailsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListResourceDetailsParams) SetResourceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -316,6 +813,15 @@ func (p *ListResourceDetailsParams) SetResourceid(v string) {
 	p.p["resourceid"] = v
 }
 
+func (p *ListResourceDetailsParams) ResetResourceid() {
+	delete(p.p, "resourceid")
+}
+
+func (p *ListResourceDetailsParams) GetResourceid() (string, bool) {
+	value, ok := p.p["resourceid"].(string)
+	return value, ok
+}
+
 func (p *ListResourceDetailsParams) SetResourcetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -323,6 +829,15 @@ func (p *ListResourceDetailsParams) SetResourcetype(v string) {
 	p.p["resourcetype"] = v
 }
 
+func (p *ListResourceDetailsParams) ResetResourcetype() {
+	delete(p.p, "resourcetype")
+}
+
+func (p *ListResourceDetailsParams) GetResourcetype() (string, bool) {
+	value, ok := p.p["resourcetype"].(string)
+	return value, ok
+}
+
 func (p *ListResourceDetailsParams) SetValue(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -330,6 +845,15 @@ func (p *ListResourceDetailsParams) SetValue(v string) {
 	p.p["value"] = v
 }
 
+func (p *ListResourceDetailsParams) ResetValue() {
+	delete(p.p, "value")
+}
+
+func (p *ListResourceDetailsParams) GetValue() (string, bool) {
+	value, ok := p.p["value"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListResourceDetailsParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcemetadataService) NewListResourceDetailsParams(resourcetype string) *ListResourceDetailsParams {
@@ -341,7 +865,16 @@ func (s *ResourcemetadataService) NewListResourceDetailsParams(resourcetype stri
 
 // List resource detail(s)
 func (s *ResourcemetadataService) ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
-	resp, err := s.cs.newRequest("listResourceDetails", p.toURLValues())
+	return s.ListResourceDetailsWithContext(context.Background(), p)
+}
+
+// ListResourceDetailsWithContext is the same as ListResourceDetails, but uses ctx to cancel the request and any async job polling
+func (s *ResourcemetadataService) ListResourceDetailsWithContext(ctx context.Context, p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listResourceDetails", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -354,6 +887,92 @@ func (s *ResourcemetadataService) ListResourceDetails(p *ListResourceDetailsPara
 	return &r, nil
 }
 
+// ListResourceDetailsPager iterates over all pages of a ListResourceDetails call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListResourceDetailsPager struct {
+	pager
+
+	s *ResourcemetadataService
+	p *ListResourceDetailsParams
+	r *ListResourceDetailsResponse
+}
+
+// NewListResourceDetailsPager returns a pager for all pages of a ListResourceDetails call with the given params
+func (s *ResourcemetadataService) NewListResourceDetailsPager(p *ListResourceDetailsParams) *ListResourceDetailsPager {
+	return &ListResourceDetailsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListResourceDetailsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListResourceDetailsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListResourceDetailsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListResourceDetailsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.ResourceDetails), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListResourceDetailsPager) Page() *ListResourceDetailsResponse {
+	return pg.r
+}
+
+// ListResourceDetailsAll fetches all pages of a ListResourceDetails call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ResourcemetadataService) ListResourceDetailsAll(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
+	return s.ListResourceDetailsAllWithContext(context.Background(), p)
+}
+
+// ListResourceDetailsAllWithContext is the same as ListResourceDetailsAll, but uses ctx to cancel the requests
+func (s *ResourcemetadataService) ListResourceDetailsAllWithContext(ctx context.Context, p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*ResourceDetail)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListResourceDetailsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListResourceDetailsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.ResourceDetails
+		mu.Unlock()
+
+		return len(l.ResourceDetails), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListResourceDetailsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.ResourceDetails = append(r.ResourceDetails, pages[page]...)
+	}
+	r.Count = len(r.ResourceDetails)
+
+	return r, nil
+}
+
 type ListResourceDetailsResponse struct {
 	Count           int               `json:"count"`
 	ResourceDetails []*ResourceDetail `json:"resourcedetail"`
@@ -378,7 +997,8 @@ type RemoveResourceDetailParams struct {
 	p map[string]interface{}
 }
 
-func (p *RemoveResourceDetailParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RemoveResourceDetailParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -395,6 +1015,50 @@ func (p *RemoveResourceDetailParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RemoveResourceDetailParams) Validate() error {
+	v := newParamValidator("removeResourceDetail", p.p)
+	v.required("resourceid", "resourcetype")
+	v.maxLength("key", 255)
+	v.maxLength("resourceid", 255)
+	v.maxLength("resourcetype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RemoveResourceDetailParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RemoveResourceDetailParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "key", "resourceid", "resourcetype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for removeResourceDetail", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of removeResourceDetail: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveResourceDetailParams) SetKey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -402,6 +1066,15 @@ func (p *RemoveResourceDetailParams) SetKey(v string) {
 	p.p["key"] = v
 }
 
+func (p *RemoveResourceDetailParams) ResetKey() {
+	delete(p.p, "key")
+}
+
+func (p *RemoveResourceDetailParams) GetKey() (string, bool) {
+	value, ok := p.p["key"].(string)
+	return value, ok
+}
+
 func (p *RemoveResourceDetailParams) SetResourceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -409,6 +1082,15 @@ func (p *RemoveResourceDetailParams) SetResourceid(v string) {
 	p.p["resourceid"] = v
 }
 
+func (p *RemoveResourceDetailParams) ResetResourceid() {
+	delete(p.p, "resourceid")
+}
+
+func (p *RemoveResourceDetailParams) GetResourceid() (string, bool) {
+	value, ok := p.p["resourceid"].(string)
+	return value, ok
+}
+
 func (p *RemoveResourceDetailParams) SetResourcetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -416,6 +1098,15 @@ func (p *RemoveResourceDetailParams) SetResourcetype(v string) {
 	p.p["resourcetype"] = v
 }
 
+func (p *RemoveResourceDetailParams) ResetResourcetype() {
+	delete(p.p, "resourcetype")
+}
+
+func (p *RemoveResourceDetailParams) GetResourcetype() (string, bool) {
+	value, ok := p.p["resourcetype"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RemoveResourceDetailParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcemetadataService) NewRemoveResourceDetailParams(resourceid string, resourcetype string) *RemoveResourceDetailParams {
@@ -428,7 +1119,16 @@ func (s *ResourcemetadataService) NewRemoveResourceDetailParams(resourceid strin
 
 // Removes detail for the Resource.
 func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error) {
-	resp, err := s.cs.newRequest("removeResourceDetail", p.toURLValues())
+	return s.RemoveResourceDetailWithContext(context.Background(), p)
+}
+
+// RemoveResourceDetailWithContext is the same as RemoveResourceDetail, but uses ctx to cancel the request and any async job polling
+func (s *ResourcemetadataService) RemoveResourceDetailWithContext(ctx context.Context, p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeResourceDetail", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -440,7 +1140,7 @@ func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailPa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -456,6 +1156,31 @@ func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailPa
 	return &r, nil
 }
 
+// RemoveResourceDetailAsync starts the async job for RemoveResourceDetail and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RemoveResourceDetailResponse using Job.Result
+func (s *ResourcemetadataService) RemoveResourceDetailAsync(p *RemoveResourceDetailParams) (*Job, error) {
+	return s.RemoveResourceDetailAsyncWithContext(context.Background(), p)
+}
+
+// RemoveResourceDetailAsyncWithContext is the same as RemoveResourceDetailAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ResourcemetadataService) RemoveResourceDetailAsyncWithContext(ctx context.Context, p *RemoveResourceDetailParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeResourceDetail", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveResourceDetailResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type RemoveResourceDetailResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -20,18 +20,50 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// ResourcetagsServiceIface is the interface implemented by ResourcetagsService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type ResourcetagsServiceIface interface {
+	NewCreateTagsParams(resourceids []string, resourcetype string, tags map[string]string) *CreateTagsParams
+	CreateTags(p *CreateTagsParams) (*CreateTagsResponse, error)
+	CreateTagsWithContext(ctx context.Context, p *CreateTagsParams) (*CreateTagsResponse, error)
+	CreateTagsAsync(p *CreateTagsParams) (*Job, error)
+	CreateTagsAsyncWithContext(ctx context.Context, p *CreateTagsParams) (*Job, error)
+	NewDeleteTagsParams(resourceids []string, resourcetype string) *DeleteTagsParams
+	DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error)
+	DeleteTagsWithContext(ctx context.Context, p *DeleteTagsParams) (*DeleteTagsResponse, error)
+	DeleteTagsAsync(p *DeleteTagsParams) (*Job, error)
+	DeleteTagsAsyncWithContext(ctx context.Context, p *DeleteTagsParams) (*Job, error)
+	NewListStorageTagsParams() *ListStorageTagsParams
+	GetStorageTagID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetStorageTagIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
+	ListStorageTags(p *ListStorageTagsParams) (*ListStorageTagsResponse, error)
+	ListStorageTagsWithContext(ctx context.Context, p *ListStorageTagsParams) (*ListStorageTagsResponse, error)
+	NewListStorageTagsPager(p *ListStorageTagsParams) *ListStorageTagsPager
+	ListStorageTagsAll(p *ListStorageTagsParams) (*ListStorageTagsResponse, error)
+	ListStorageTagsAllWithContext(ctx context.Context, p *ListStorageTagsParams) (*ListStorageTagsResponse, error)
+	NewListTagsParams() *ListTagsParams
+	ListTags(p *ListTagsParams) (*ListTagsResponse, error)
+	ListTagsWithContext(ctx context.Context, p *ListTagsParams) (*ListTagsResponse, error)
+	NewListTagsPager(p *ListTagsParams) *ListTagsPager
+	ListTagsAll(p *ListTagsParams) (*ListTagsResponse, error)
+	ListTagsAllWithContext(ctx context.Context, p *ListTagsParams) (*ListTagsResponse, error)
+}
+
 type CreateTagsParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateTagsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateTagsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -56,6 +88,57 @@ func (p *CreateTagsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateTagsParams) Validate() error {
+	v := newParamValidator("createTags", p.p)
+	v.required("resourceids", "resourcetype", "tags")
+	v.maxLength("customer", 255)
+	v.maxLength("resourcetype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateTagsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateTagsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customer", "resourcetype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "resourceids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createTags", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createTags: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateTagsParams) SetCustomer(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -63,6 +146,15 @@ func (p *CreateTagsParams) SetCustomer(v string) {
 	p.p["customer"] = v
 }
 
+func (p *CreateTagsParams) ResetCustomer() {
+	delete(p.p, "customer")
+}
+
+func (p *CreateTagsParams) GetCustomer() (string, bool) {
+	value, ok := p.p["customer"].(string)
+	return value, ok
+}
+
 func (p *CreateTagsParams) SetResourceids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -70,6 +162,15 @@ func (p *CreateTagsParams) SetResourceids(v []string) {
 	p.p["resourceids"] = v
 }
 
+func (p *CreateTagsParams) ResetResourceids() {
+	delete(p.p, "resourceids")
+}
+
+func (p *CreateTagsParams) GetResourceids() ([]string, bool) {
+	value, ok := p.p["resourceids"].([]string)
+	return value, ok
+}
+
 func (p *CreateTagsParams) SetResourcetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -77,6 +178,15 @@ func (p *CreateTagsParams) SetResourcetype(v string) {
 	p.p["resourcetype"] = v
 }
 
+func (p *CreateTagsParams) ResetResourcetype() {
+	delete(p.p, "resourcetype")
+}
+
+func (p *CreateTagsParams) GetResourcetype() (string, bool) {
+	value, ok := p.p["resourcetype"].(string)
+	return value, ok
+}
+
 func (p *CreateTagsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -84,6 +194,15 @@ func (p *CreateTagsParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *CreateTagsParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *CreateTagsParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateTagsParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcetagsService) NewCreateTagsParams(resourceids []string, resourcetype string, tags map[string]string) *CreateTagsParams {
@@ -97,7 +216,16 @@ func (s *ResourcetagsService) NewCreateTagsParams(resourceids []string, resource
 
 // Creates resource tag(s)
 func (s *ResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsResponse, error) {
-	resp, err := s.cs.newRequest("createTags", p.toURLValues())
+	return s.CreateTagsWithContext(context.Background(), p)
+}
+
+// CreateTagsWithContext is the same as CreateTags, but uses ctx to cancel the request and any async job polling
+func (s *ResourcetagsService) CreateTagsWithContext(ctx context.Context, p *CreateTagsParams) (*CreateTagsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createTags", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +237,7 @@ func (s *ResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -125,6 +253,31 @@ func (s *ResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsRespon
 	return &r, nil
 }
 
+// CreateTagsAsync starts the async job for CreateTags and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateTagsResponse using Job.Result
+func (s *ResourcetagsService) CreateTagsAsync(p *CreateTagsParams) (*Job, error) {
+	return s.CreateTagsAsyncWithContext(context.Background(), p)
+}
+
+// CreateTagsAsyncWithContext is the same as CreateTagsAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ResourcetagsService) CreateTagsAsyncWithContext(ctx context.Context, p *CreateTagsParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createTags", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateTagsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type CreateTagsResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -136,7 +289,8 @@ type DeleteTagsParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteTagsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteTagsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -158,6 +312,56 @@ func (p *DeleteTagsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteTagsParams) Validate() error {
+	v := newParamValidator("deleteTags", p.p)
+	v.required("resourceids", "resourcetype")
+	v.maxLength("resourcetype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteTagsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteTagsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "resourceids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "resourcetype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteTags", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteTags: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteTagsParams) SetResourceids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -165,6 +369,15 @@ func (p *DeleteTagsParams) SetResourceids(v []string) {
 	p.p["resourceids"] = v
 }
 
+func (p *DeleteTagsParams) ResetResourceids() {
+	delete(p.p, "resourceids")
+}
+
+func (p *DeleteTagsParams) GetResourceids() ([]string, bool) {
+	value, ok := p.p["resourceids"].([]string)
+	return value, ok
+}
+
 func (p *DeleteTagsParams) SetResourcetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -172,6 +385,15 @@ func (p *DeleteTagsParams) SetResourcetype(v string) {
 	p.p["resourcetype"] = v
 }
 
+func (p *DeleteTagsParams) ResetResourcetype() {
+	delete(p.p, "resourcetype")
+}
+
+func (p *DeleteTagsParams) GetResourcetype() (string, bool) {
+	value, ok := p.p["resourcetype"].(string)
+	return value, ok
+}
+
 func (p *DeleteTagsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -179,6 +401,15 @@ func (p *DeleteTagsParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *DeleteTagsParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *DeleteTagsParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteTagsParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcetagsService) NewDeleteTagsParams(resourceids []string, resourcetype string) *DeleteTagsParams {
@@ -191,7 +422,16 @@ func (s *ResourcetagsService) NewDeleteTagsParams(resourceids []string, resource
 
 // Deleting resource tag(s)
 func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error) {
-	resp, err := s.cs.newRequest("deleteTags", p.toURLValues())
+	return s.DeleteTagsWithContext(context.Background(), p)
+}
+
+// DeleteTagsWithContext is the same as DeleteTags, but uses ctx to cancel the request and any async job polling
+func (s *ResourcetagsService) DeleteTagsWithContext(ctx context.Context, p *DeleteTagsParams) (*DeleteTagsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteTags", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -203,7 +443,7 @@ func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -219,6 +459,31 @@ func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsRespon
 	return &r, nil
 }
 
+// DeleteTagsAsync starts the async job for DeleteTags and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteTagsResponse using Job.Result
+func (s *ResourcetagsService) DeleteTagsAsync(p *DeleteTagsParams) (*Job, error) {
+	return s.DeleteTagsAsyncWithContext(context.Background(), p)
+}
+
+// DeleteTagsAsyncWithContext is the same as DeleteTagsAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ResourcetagsService) DeleteTagsAsyncWithContext(ctx context.Context, p *DeleteTagsParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteTags", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteTagsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteTagsResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -230,7 +495,8 @@ type ListStorageTagsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListStorageTagsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListStorageTagsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -249,6 +515,51 @@ func (p *ListStorageTagsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListStorageTagsParams) Validate() error {
+	v := newParamValidator("listStorageTags", p.p)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListStorageTagsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListStorageTagsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listStorageTags", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listStorageTags: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListStorageTagsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -256,6 +567,15 @@ func (p *ListStorageTagsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListStorageTagsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListStorageTagsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListStorageTagsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -263,6 +583,15 @@ func (p *ListStorageTagsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListStorageTagsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListStorageTagsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListStorageTagsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -270,6 +599,15 @@ func (p *ListStorageTagsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListStorageTagsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListStorageTagsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListStorageTagsParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcetagsService) NewListStorageTagsParams() *ListStorageTagsParams {
@@ -280,6 +618,11 @@ func (s *ResourcetagsService) NewListStorageTagsParams() *ListStorageTagsParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetStorageTagIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetStorageTagIDWithContext is the same as GetStorageTagID, but uses ctx to cancel the request
+func (s *ResourcetagsService) GetStorageTagIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListStorageTagsParams{}
 	p.p = make(map[string]interface{})
 
@@ -291,7 +634,7 @@ func (s *ResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc
 		}
 	}
 
-	l, err := s.ListStorageTags(p)
+	l, err := s.ListStorageTagsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -316,7 +659,16 @@ func (s *ResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc
 
 // Lists storage tags
 func (s *ResourcetagsService) ListStorageTags(p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
-	resp, err := s.cs.newRequest("listStorageTags", p.toURLValues())
+	return s.ListStorageTagsWithContext(context.Background(), p)
+}
+
+// ListStorageTagsWithContext is the same as ListStorageTags, but uses ctx to cancel the request and any async job polling
+func (s *ResourcetagsService) ListStorageTagsWithContext(ctx context.Context, p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listStorageTags", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -329,6 +681,92 @@ func (s *ResourcetagsService) ListStorageTags(p *ListStorageTagsParams) (*ListSt
 	return &r, nil
 }
 
+// ListStorageTagsPager iterates over all pages of a ListStorageTags call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListStorageTagsPager struct {
+	pager
+
+	s *ResourcetagsService
+	p *ListStorageTagsParams
+	r *ListStorageTagsResponse
+}
+
+// NewListStorageTagsPager returns a pager for all pages of a ListStorageTags call with the given params
+func (s *ResourcetagsService) NewListStorageTagsPager(p *ListStorageTagsParams) *ListStorageTagsPager {
+	return &ListStorageTagsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListStorageTagsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListStorageTagsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListStorageTagsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListStorageTagsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.StorageTags), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListStorageTagsPager) Page() *ListStorageTagsResponse {
+	return pg.r
+}
+
+// ListStorageTagsAll fetches all pages of a ListStorageTags call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ResourcetagsService) ListStorageTagsAll(p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
+	return s.ListStorageTagsAllWithContext(context.Background(), p)
+}
+
+// ListStorageTagsAllWithContext is the same as ListStorageTagsAll, but uses ctx to cancel the requests
+func (s *ResourcetagsService) ListStorageTagsAllWithContext(ctx context.Context, p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*StorageTag)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListStorageTagsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListStorageTagsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.StorageTags
+		mu.Unlock()
+
+		return len(l.StorageTags), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListStorageTagsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.StorageTags = append(r.StorageTags, pages[page]...)
+	}
+	r.Count = len(r.StorageTags)
+
+	return r, nil
+}
+
 type ListStorageTagsResponse struct {
 	Count       int           `json:"count"`
 	StorageTags []*StorageTag `json:"storagetag"`
@@ -346,7 +784,8 @@ type ListTagsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListTagsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListTagsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -397,6 +836,62 @@ func (p *ListTagsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListTagsParams) Validate() error {
+	v := newParamValidator("listTags", p.p)
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("customer", 255)
+	v.maxLength("key", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("resourceid", 255)
+	v.maxLength("resourcetype", 255)
+	v.maxLength("value", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListTagsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListTagsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "customer", "domainid", "key", "keyword", "projectid", "resourceid", "resourcetype", "value":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listTags", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listTags: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListTagsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -404,6 +899,15 @@ func (p *ListTagsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListTagsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListTagsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListTagsParams) SetCustomer(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -411,6 +915,15 @@ func (p *ListTagsParams) SetCustomer(v string) {
 	p.p["customer"] = v
 }
 
+func (p *ListTagsParams) ResetCustomer() {
+	delete(p.p, "customer")
+}
+
+func (p *ListTagsParams) GetCustomer() (string, bool) {
+	value, ok := p.p["customer"].(string)
+	return value, ok
+}
+
 func (p *ListTagsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -418,6 +931,15 @@ func (p *ListTagsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListTagsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListTagsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListTagsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -425,6 +947,15 @@ func (p *ListTagsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListTagsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListTagsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListTagsParams) SetKey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -432,6 +963,15 @@ func (p *ListTagsParams) SetKey(v string) {
 	p.p["key"] = v
 }
 
+func (p *ListTagsParams) ResetKey() {
+	delete(p.p, "key")
+}
+
+func (p *ListTagsParams) GetKey() (string, bool) {
+	value, ok := p.p["key"].(string)
+	return value, ok
+}
+
 func (p *ListTagsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -439,6 +979,15 @@ func (p *ListTagsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListTagsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListTagsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListTagsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -446,6 +995,15 @@ func (p *ListTagsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListTagsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListTagsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListTagsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -453,6 +1011,15 @@ func (p *ListTagsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListTagsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListTagsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListTagsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -460,6 +1027,15 @@ func (p *ListTagsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListTagsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListTagsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListTagsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -467,6 +1043,15 @@ func (p *ListTagsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListTagsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListTagsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListTagsParams) SetResourceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -474,6 +1059,15 @@ func (p *ListTagsParams) SetResourceid(v string) {
 	p.p["resourceid"] = v
 }
 
+func (p *ListTagsParams) ResetResourceid() {
+	delete(p.p, "resourceid")
+}
+
+func (p *ListTagsParams) GetResourceid() (string, bool) {
+	value, ok := p.p["resourceid"].(string)
+	return value, ok
+}
+
 func (p *ListTagsParams) SetResourcetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -481,6 +1075,15 @@ func (p *ListTagsParams) SetResourcetype(v string) {
 	p.p["resourcetype"] = v
 }
 
+func (p *ListTagsParams) ResetResourcetype() {
+	delete(p.p, "resourcetype")
+}
+
+func (p *ListTagsParams) GetResourcetype() (string, bool) {
+	value, ok := p.p["resourcetype"].(string)
+	return value, ok
+}
+
 func (p *ListTagsParams) SetValue(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -488,6 +1091,15 @@ func (p *ListTagsParams) SetValue(v string) {
 	p.p["value"] = v
 }
 
+func (p *ListTagsParams) ResetValue() {
+	delete(p.p, "value")
+}
+
+func (p *ListTagsParams) GetValue() (string, bool) {
+	value, ok := p.p["value"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListTagsParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcetagsService) NewListTagsParams() *ListTagsParams {
@@ -498,7 +1110,16 @@ func (s *ResourcetagsService) NewListTagsParams() *ListTagsParams {
 
 // List resource tag(s)
 func (s *ResourcetagsService) ListTags(p *ListTagsParams) (*ListTagsResponse, error) {
-	resp, err := s.cs.newRequest("listTags", p.toURLValues())
+	return s.ListTagsWithContext(context.Background(), p)
+}
+
+// ListTagsWithContext is the same as ListTags, but uses ctx to cancel the request and any async job polling
+func (s *ResourcetagsService) ListTagsWithContext(ctx context.Context, p *ListTagsParams) (*ListTagsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listTags", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -511,6 +1132,92 @@ func (s *ResourcetagsService) ListTags(p *ListTagsParams) (*ListTagsResponse, er
 	return &r, nil
 }
 
+// ListTagsPager iterates over all pages of a ListTags call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListTagsPager struct {
+	pager
+
+	s *ResourcetagsService
+	p *ListTagsParams
+	r *ListTagsResponse
+}
+
+// NewListTagsPager returns a pager for all pages of a ListTags call with the given params
+func (s *ResourcetagsService) NewListTagsPager(p *ListTagsParams) *ListTagsPager {
+	return &ListTagsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListTagsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListTagsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListTagsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListTagsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Tags), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListTagsPager) Page() *ListTagsResponse {
+	return pg.r
+}
+
+// ListTagsAll fetches all pages of a ListTags call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ResourcetagsService) ListTagsAll(p *ListTagsParams) (*ListTagsResponse, error) {
+	return s.ListTagsAllWithContext(context.Background(), p)
+}
+
+// ListTagsAllWithContext is the same as ListTagsAll, but uses ctx to cancel the requests
+func (s *ResourcetagsService) ListTagsAllWithContext(ctx context.Context, p *ListTagsParams) (*ListTagsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Tag)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListTagsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListTagsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Tags
+		mu.Unlock()
+
+		return len(l.Tags), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListTagsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Tags = append(r.Tags, pages[page]...)
+	}
+	r.Count = len(r.Tags)
+
+	return r, nil
+}
+
 type ListTagsResponse struct {
 	Count int    `json:"count"`
 	Tags  []*Tag `json:"tag"`
@@ -0,0 +1,156 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy defines if and how failed requests are retried. Only safe commands (the ones starting
+// with `list`, `get` or `query`, except for the `getUploadParamsFor...` commands that register
+// an upload) are retried by default, mutating commands are only retried when
+// they are explicitly added to RetryCommands or when RetryAll is set. Any zero value field will
+// use its default value, so to disable retries completely set MaxAttempts to 1.
+type RetryPolicy struct {
+	MaxAttempts    int                              // Max number of attempts including the first one; defaults to 3
+	InitialBackoff time.Duration                    // Backoff before the first retry, doubled for every next retry; defaults to 500ms
+	MaxBackoff     time.Duration                    // Max backoff between two attempts; defaults to 10 seconds
+	Retryable      func(api string, err error) bool // Decides if an error can be retried; defaults to IsRetryableError
+	RetryCommands  []string                         // Mutating commands that may also be retried
+	RetryAll       bool                             // If `true` all commands may be retried
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.retry = policy
+	}
+}
+
+// IsRetryableError returns true if err is most likely a transient failure, which means a connection
+// error, a 5xx response from CloudStack (or a proxy in front of it), a CloudStack internal error
+// (530), an API throttling error (429) or a concurrent operation error.
+func IsRetryableError(api string, err error) bool {
+	var cse *CSError
+	if errors.As(err, &cse) {
+		switch {
+		case cse.StatusCode == 429 || cse.ErrorCode == 429:
+			return true
+		case cse.StatusCode >= 500 && cse.StatusCode < 600:
+			return true
+		case cse.ErrorCode == 530:
+			return true
+		}
+		return IsConcurrentOperation(err)
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	var ne net.Error
+	return errors.As(err, &ne) && ne.Timeout()
+}
+
+func (rp *RetryPolicy) maxAttempts() int {
+	if rp.MaxAttempts <= 0 {
+		return 3
+	}
+	return rp.MaxAttempts
+}
+
+// Returns true if the failed attempt to execute api should be retried
+func (rp *RetryPolicy) shouldRetry(api string, attempt int, err error) bool {
+	if attempt >= rp.maxAttempts() || !rp.canRetry(api) {
+		return false
+	}
+
+	if rp.Retryable != nil {
+		return rp.Retryable(api, err)
+	}
+	return IsRetryableError(api, err)
+}
+
+// Returns true if api is allowed to be retried
+func (rp *RetryPolicy) canRetry(api string) bool {
+	if rp.RetryAll || isSafeCommand(api) {
+		return true
+	}
+
+	for _, c := range rp.RetryCommands {
+		if strings.EqualFold(c, api) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the time to wait after the given attempt failed, using an
+// exponential backoff with jitter
+func (rp *RetryPolicy) backoff(attempt int) time.Duration {
+	d := rp.InitialBackoff
+	if d <= 0 {
+		d = 500 * time.Millisecond
+	}
+
+	max := rp.MaxBackoff
+	if max <= 0 {
+		max = 10 * time.Second
+	}
+
+	for i := 1; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+
+	// Wait at least half of the backoff and a random part of the other half
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// The commands starting with one of the safe prefixes that do change something, as
+// they create an upload entry in CloudStack on every call
+var unsafeCommands = map[string]bool{
+	"getUploadParamsForIso":      true,
+	"getUploadParamsForTemplate": true,
+	"getUploadParamsForVolume":   true,
+}
+
+// Returns true if api doesn't change anything, so it's safe to retry
+func isSafeCommand(api string) bool {
+	if unsafeCommands[api] {
+		return false
+	}
+
+	for _, prefix := range []string{"list", "get", "query"} {
+		if strings.HasPrefix(api, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// A net.Error that reports a timeout
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "throttled", err: &CSError{StatusCode: 429, ErrorCode: 429}, want: true},
+		{name: "throttled error code", err: &CSError{StatusCode: 200, ErrorCode: 429}, want: true},
+		{name: "bad gateway", err: &CSError{StatusCode: 502}, want: true},
+		{name: "service unavailable", err: &CSError{StatusCode: 503}, want: true},
+		{name: "internal error", err: &CSError{StatusCode: 530, ErrorCode: 530}, want: true},
+		{name: "internal error code", err: &CSError{StatusCode: 200, ErrorCode: 530}, want: true},
+		{
+			name: "concurrent operation",
+			err:  &CSError{StatusCode: 431, ErrorCode: 431, ErrorText: "Unable to stop the VM due to a concurrent operation"},
+			want: true,
+		},
+		{name: "wrapped internal error", err: fmt.Errorf("Error listing zones: %w", &CSError{ErrorCode: 530}), want: true},
+		{name: "EOF", err: io.EOF, want: true},
+		{name: "unexpected EOF", err: &url.Error{Op: "Get", URL: "http://localhost", Err: io.ErrUnexpectedEOF}, want: true},
+		{
+			name: "connection reset",
+			err:  &url.Error{Op: "Get", URL: "http://localhost", Err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}},
+			want: true,
+		},
+		{name: "connection refused", err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, want: true},
+		{name: "timeout", err: &url.Error{Op: "Get", URL: "http://localhost", Err: timeoutError{}}, want: true},
+		{name: "invalid parameter", err: &CSError{StatusCode: 431, ErrorCode: 431, ErrorText: "Unable to execute API command"}, want: false},
+		{name: "unauthorized", err: &CSError{StatusCode: 401, ErrorCode: 401}, want: false},
+		{name: "other error", err: errors.New("failed"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryableError("listZones", tt.err); got != tt.want {
+				t.Errorf("Expected %v for %v, got %v", tt.want, tt.err, got)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  RetryPolicy
+		attempt int
+		want    time.Duration // The backoff without jitter
+	}{
+		{name: "default first retry", attempt: 1, want: 500 * time.Millisecond},
+		{name: "default second retry", attempt: 2, want: time.Second},
+		{name: "default max backoff", attempt: 10, want: 10 * time.Second},
+		{name: "custom initial backoff", policy: RetryPolicy{InitialBackoff: time.Second}, attempt: 3, want: 4 * time.Second},
+		{name: "custom max backoff", policy: RetryPolicy{MaxBackoff: 3 * time.Second}, attempt: 4, want: 3 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The jitter is random, so check the bounds a number of times
+			for i := 0; i < 100; i++ {
+				d := tt.policy.backoff(tt.attempt)
+				if d < tt.want/2 || d > tt.want {
+					t.Fatalf("Expected a backoff between %v and %v, got %v", tt.want/2, tt.want, d)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	retryable := &CSError{StatusCode: 503}
+
+	tests := []struct {
+		name    string
+		policy  RetryPolicy
+		api     string
+		attempt int
+		want    bool
+	}{
+		{name: "safe command", api: "listZones", attempt: 1, want: true},
+		{name: "max attempts reached", api: "listZones", attempt: 3, want: false},
+		{name: "retries disabled", policy: RetryPolicy{MaxAttempts: 1}, api: "listZones", attempt: 1, want: false},
+		{name: "mutating command", api: "deployVirtualMachine", attempt: 1, want: false},
+		{name: "upload command", api: "getUploadParamsForVolume", attempt: 1, want: false},
+		{name: "retry command", policy: RetryPolicy{RetryCommands: []string{"DeployVirtualMachine"}}, api: "deployVirtualMachine", attempt: 1, want: true},
+		{name: "retry all", policy: RetryPolicy{RetryAll: true}, api: "deployVirtualMachine", attempt: 1, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.shouldRetry(tt.api, tt.attempt, retryable); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
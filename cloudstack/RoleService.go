@@ -20,18 +20,62 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// RoleServiceIface is the interface implemented by RoleService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type RoleServiceIface interface {
+	NewCreateRoleParams(name string) *CreateRoleParams
+	CreateRole(p *CreateRoleParams) (*CreateRoleResponse, error)
+	CreateRoleWithContext(ctx context.Context, p *CreateRoleParams) (*CreateRoleResponse, error)
+	NewCreateRolePermissionParams(permission string, roleid string, rule string) *CreateRolePermissionParams
+	CreateRolePermission(p *CreateRolePermissionParams) (*CreateRolePermissionResponse, error)
+	CreateRolePermissionWithContext(ctx context.Context, p *CreateRolePermissionParams) (*CreateRolePermissionResponse, error)
+	NewDeleteRoleParams(id string) *DeleteRoleParams
+	DeleteRole(p *DeleteRoleParams) (*DeleteRoleResponse, error)
+	DeleteRoleWithContext(ctx context.Context, p *DeleteRoleParams) (*DeleteRoleResponse, error)
+	NewDeleteRolePermissionParams(id string) *DeleteRolePermissionParams
+	DeleteRolePermission(p *DeleteRolePermissionParams) (*DeleteRolePermissionResponse, error)
+	DeleteRolePermissionWithContext(ctx context.Context, p *DeleteRolePermissionParams) (*DeleteRolePermissionResponse, error)
+	NewImportRoleParams(name string, rules []ImportRoleRule) *ImportRoleParams
+	ImportRole(p *ImportRoleParams) (*ImportRoleResponse, error)
+	ImportRoleWithContext(ctx context.Context, p *ImportRoleParams) (*ImportRoleResponse, error)
+	NewListRolePermissionsParams() *ListRolePermissionsParams
+	ListRolePermissions(p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error)
+	ListRolePermissionsWithContext(ctx context.Context, p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error)
+	NewListRolesParams() *ListRolesParams
+	GetRoleID(name string, opts ...OptionFunc) (string, int, error)
+	GetRoleIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetRoleByName(name string, opts ...OptionFunc) (*Role, int, error)
+	GetRoleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Role, int, error)
+	GetRoleByID(id string, opts ...OptionFunc) (*Role, int, error)
+	GetRoleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Role, int, error)
+	ListRoles(p *ListRolesParams) (*ListRolesResponse, error)
+	ListRolesWithContext(ctx context.Context, p *ListRolesParams) (*ListRolesResponse, error)
+	NewListRolesPager(p *ListRolesParams) *ListRolesPager
+	ListRolesAll(p *ListRolesParams) (*ListRolesResponse, error)
+	ListRolesAllWithContext(ctx context.Context, p *ListRolesParams) (*ListRolesResponse, error)
+	NewUpdateRoleParams(id string) *UpdateRoleParams
+	UpdateRole(p *UpdateRoleParams) (*UpdateRoleResponse, error)
+	UpdateRoleWithContext(ctx context.Context, p *UpdateRoleParams) (*UpdateRoleResponse, error)
+	NewUpdateRolePermissionParams(roleid string) *UpdateRolePermissionParams
+	UpdateRolePermission(p *UpdateRolePermissionParams) (*UpdateRolePermissionResponse, error)
+	UpdateRolePermissionWithContext(ctx context.Context, p *UpdateRolePermissionParams) (*UpdateRolePermissionResponse, error)
+}
+
 type CreateRoleParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateRoleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateRoleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -51,6 +95,52 @@ func (p *CreateRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateRoleParams) Validate() error {
+	v := newParamValidator("createRole", p.p)
+	v.required("name")
+	v.ids("roleid")
+	v.maxLength("description", 255)
+	v.maxLength("name", 255)
+	v.maxLength("type", 255)
+	v.oneOf("type", "Admin", "ResourceAdmin", "DomainAdmin", "User")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateRoleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateRoleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "name", "roleid", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createRole", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createRole: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateRoleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -58,6 +148,15 @@ func (p *CreateRoleParams) SetDescription(v string) {
 	p.p["description"] = v
 }
 
+func (p *CreateRoleParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *CreateRoleParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
 func (p *CreateRoleParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -65,6 +164,15 @@ func (p *CreateRoleParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateRoleParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateRoleParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateRoleParams) SetRoleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -72,6 +180,15 @@ func (p *CreateRoleParams) SetRoleid(v string) {
 	p.p["roleid"] = v
 }
 
+func (p *CreateRoleParams) ResetRoleid() {
+	delete(p.p, "roleid")
+}
+
+func (p *CreateRoleParams) GetRoleid() (string, bool) {
+	value, ok := p.p["roleid"].(string)
+	return value, ok
+}
+
 func (p *CreateRoleParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +196,15 @@ func (p *CreateRoleParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *CreateRoleParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *CreateRoleParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateRoleParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewCreateRoleParams(name string) *CreateRoleParams {
@@ -90,7 +216,16 @@ func (s *RoleService) NewCreateRoleParams(name string) *CreateRoleParams {
 
 // Creates a role
 func (s *RoleService) CreateRole(p *CreateRoleParams) (*CreateRoleResponse, error) {
-	resp, err := s.cs.newRequest("createRole", p.toURLValues())
+	return s.CreateRoleWithContext(context.Background(), p)
+}
+
+// CreateRoleWithContext is the same as CreateRole, but uses ctx to cancel the request and any async job polling
+func (s *RoleService) CreateRoleWithContext(ctx context.Context, p *CreateRoleParams) (*CreateRoleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createRole", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +252,8 @@ type CreateRolePermissionParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateRolePermissionParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateRolePermissionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -137,6 +273,51 @@ func (p *CreateRolePermissionParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateRolePermissionParams) Validate() error {
+	v := newParamValidator("createRolePermission", p.p)
+	v.required("permission", "roleid", "rule")
+	v.ids("roleid")
+	v.maxLength("description", 255)
+	v.maxLength("permission", 255)
+	v.maxLength("rule", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateRolePermissionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateRolePermissionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "permission", "roleid", "rule":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createRolePermission", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createRolePermission: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateRolePermissionParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -144,6 +325,15 @@ func (p *CreateRolePermissionParams) SetDescription(v string) {
 	p.p["description"] = v
 }
 
+func (p *CreateRolePermissionParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *CreateRolePermissionParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
 func (p *CreateRolePermissionParams) SetPermission(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -151,6 +341,15 @@ func (p *CreateRolePermissionParams) SetPermission(v string) {
 	p.p["permission"] = v
 }
 
+func (p *CreateRolePermissionParams) ResetPermission() {
+	delete(p.p, "permission")
+}
+
+func (p *CreateRolePermissionParams) GetPermission() (string, bool) {
+	value, ok := p.p["permission"].(string)
+	return value, ok
+}
+
 func (p *CreateRolePermissionParams) SetRoleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -158,6 +357,15 @@ func (p *CreateRolePermissionParams) SetRoleid(v string) {
 	p.p["roleid"] = v
 }
 
+func (p *CreateRolePermissionParams) ResetRoleid() {
+	delete(p.p, "roleid")
+}
+
+func (p *CreateRolePermissionParams) GetRoleid() (string, bool) {
+	value, ok := p.p["roleid"].(string)
+	return value, ok
+}
+
 func (p *CreateRolePermissionParams) SetRule(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -165,6 +373,15 @@ func (p *CreateRolePermissionParams) SetRule(v string) {
 	p.p["rule"] = v
 }
 
+func (p *CreateRolePermissionParams) ResetRule() {
+	delete(p.p, "rule")
+}
+
+func (p *CreateRolePermissionParams) GetRule() (string, bool) {
+	value, ok := p.p["rule"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateRolePermissionParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewCreateRolePermissionParams(permission string, roleid string, rule string) *CreateRolePermissionParams {
@@ -178,7 +395,16 @@ func (s *RoleService) NewCreateRolePermissionParams(permission string, roleid st
 
 // Adds an API permission to a role
 func (s *RoleService) CreateRolePermission(p *CreateRolePermissionParams) (*CreateRolePermissionResponse, error) {
-	resp, err := s.cs.newRequest("createRolePermission", p.toURLValues())
+	return s.CreateRolePermissionWithContext(context.Background(), p)
+}
+
+// CreateRolePermissionWithContext is the same as CreateRolePermission, but uses ctx to cancel the request and any async job polling
+func (s *RoleService) CreateRolePermissionWithContext(ctx context.Context, p *CreateRolePermissionParams) (*CreateRolePermissionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createRolePermission", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -206,7 +432,8 @@ type DeleteRoleParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteRoleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteRoleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -217,6 +444,48 @@ func (p *DeleteRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteRoleParams) Validate() error {
+	v := newParamValidator("deleteRole", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteRoleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteRoleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteRole", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteRole: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteRoleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -224,6 +493,15 @@ func (p *DeleteRoleParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteRoleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteRoleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteRoleParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewDeleteRoleParams(id string) *DeleteRoleParams {
@@ -235,7 +513,16 @@ func (s *RoleService) NewDeleteRoleParams(id string) *DeleteRoleParams {
 
 // Deletes a role
 func (s *RoleService) DeleteRole(p *DeleteRoleParams) (*DeleteRoleResponse, error) {
-	resp, err := s.cs.newRequest("deleteRole", p.toURLValues())
+	return s.DeleteRoleWithContext(context.Background(), p)
+}
+
+// DeleteRoleWithContext is the same as DeleteRole, but uses ctx to cancel the request and any async job polling
+func (s *RoleService) DeleteRoleWithContext(ctx context.Context, p *DeleteRoleParams) (*DeleteRoleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteRole", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -286,7 +573,8 @@ type DeleteRolePermissionParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteRolePermissionParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteRolePermissionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -297,6 +585,48 @@ func (p *DeleteRolePermissionParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteRolePermissionParams) Validate() error {
+	v := newParamValidator("deleteRolePermission", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteRolePermissionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteRolePermissionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteRolePermission", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteRolePermission: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteRolePermissionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -304,6 +634,15 @@ func (p *DeleteRolePermissionParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteRolePermissionParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteRolePermissionParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteRolePermissionParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewDeleteRolePermissionParams(id string) *DeleteRolePermissionParams {
@@ -315,7 +654,16 @@ func (s *RoleService) NewDeleteRolePermissionParams(id string) *DeleteRolePermis
 
 // Deletes a role permission
 func (s *RoleService) DeleteRolePermission(p *DeleteRolePermissionParams) (*DeleteRolePermissionResponse, error) {
-	resp, err := s.cs.newRequest("deleteRolePermission", p.toURLValues())
+	return s.DeleteRolePermissionWithContext(context.Background(), p)
+}
+
+// DeleteRolePermissionWithContext is the same as DeleteRolePermission, but uses ctx to cancel the request and any async job polling
+func (s *RoleService) DeleteRolePermissionWithContext(ctx context.Context, p *DeleteRolePermissionParams) (*DeleteRolePermissionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteRolePermission", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -362,11 +710,228 @@ func (r *DeleteRolePermissionResponse) UnmarshalJSON(b []byte) error {
 	return json.Unmarshal(b, (*alias)(r))
 }
 
+type ImportRoleParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ImportRoleParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["description"]; found {
+		u.Set("description", v.(string))
+	}
+	if v, found := p.p["forced"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("forced", vv)
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["rules"]; found {
+		for i, item := range v.([]ImportRoleRule) {
+			if item.Rule != "" {
+				u.Set(fmt.Sprintf("rules[%d].rule", i), item.Rule)
+			}
+			if item.Permission != "" {
+				u.Set(fmt.Sprintf("rules[%d].permission", i), item.Permission)
+			}
+			if item.Description != "" {
+				u.Set(fmt.Sprintf("rules[%d].description", i), item.Description)
+			}
+		}
+	}
+	if v, found := p.p["type"]; found {
+		u.Set("type", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ImportRoleParams) Validate() error {
+	v := newParamValidator("importRole", p.p)
+	v.required("name", "rules")
+	v.maxLength("description", 255)
+	v.maxLength("name", 255)
+	v.maxLength("type", 255)
+	v.oneOf("type", "Admin", "ResourceAdmin", "DomainAdmin", "User")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ImportRoleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ImportRoleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "name", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "forced":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "rules":
+			var v []ImportRoleRule
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for importRole", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of importRole: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ImportRoleParams) SetDescription(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["description"] = v
+}
+
+func (p *ImportRoleParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *ImportRoleParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
+func (p *ImportRoleParams) SetForced(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["forced"] = v
+}
+
+func (p *ImportRoleParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *ImportRoleParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
+func (p *ImportRoleParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ImportRoleParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ImportRoleParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ImportRoleParams) SetRules(v []ImportRoleRule) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["rules"] = v
+}
+
+func (p *ImportRoleParams) ResetRules() {
+	delete(p.p, "rules")
+}
+
+func (p *ImportRoleParams) GetRules() ([]ImportRoleRule, bool) {
+	value, ok := p.p["rules"].([]ImportRoleRule)
+	return value, ok
+}
+
+func (p *ImportRoleParams) SetType(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["type"] = v
+}
+
+func (p *ImportRoleParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ImportRoleParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ImportRoleParams instance,
+// as then you are sure you have configured all required params
+func (s *RoleService) NewImportRoleParams(name string, rules []ImportRoleRule) *ImportRoleParams {
+	p := &ImportRoleParams{}
+	p.p = make(map[string]interface{})
+	p.p["name"] = name
+	p.p["rules"] = rules
+	return p
+}
+
+// Imports a role based on provided map of rule permissions
+func (s *RoleService) ImportRole(p *ImportRoleParams) (*ImportRoleResponse, error) {
+	return s.ImportRoleWithContext(context.Background(), p)
+}
+
+// ImportRoleWithContext is the same as ImportRole, but uses ctx to cancel the request and any async job polling
+func (s *RoleService) ImportRoleWithContext(ctx context.Context, p *ImportRoleParams) (*ImportRoleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "importRole", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ImportRoleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ImportRoleResponse struct {
+	Description string `json:"description"`
+	Id          string `json:"id"`
+	Isdefault   bool   `json:"isdefault"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+}
+
 type ListRolePermissionsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListRolePermissionsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListRolePermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -377,6 +942,47 @@ func (p *ListRolePermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListRolePermissionsParams) Validate() error {
+	v := newParamValidator("listRolePermissions", p.p)
+	v.ids("roleid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListRolePermissionsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListRolePermissionsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "roleid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listRolePermissions", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listRolePermissions: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListRolePermissionsParams) SetRoleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -384,6 +990,15 @@ func (p *ListRolePermissionsParams) SetRoleid(v string) {
 	p.p["roleid"] = v
 }
 
+func (p *ListRolePermissionsParams) ResetRoleid() {
+	delete(p.p, "roleid")
+}
+
+func (p *ListRolePermissionsParams) GetRoleid() (string, bool) {
+	value, ok := p.p["roleid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListRolePermissionsParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewListRolePermissionsParams() *ListRolePermissionsParams {
@@ -394,7 +1009,16 @@ func (s *RoleService) NewListRolePermissionsParams() *ListRolePermissionsParams
 
 // Lists role permissions
 func (s *RoleService) ListRolePermissions(p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listRolePermissions", p.toURLValues())
+	return s.ListRolePermissionsWithContext(context.Background(), p)
+}
+
+// ListRolePermissionsWithContext is the same as ListRolePermissions, but uses ctx to cancel the request and any async job polling
+func (s *RoleService) ListRolePermissionsWithContext(ctx context.Context, p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listRolePermissions", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -427,7 +1051,8 @@ type ListRolesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListRolesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListRolesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -455,6 +1080,55 @@ func (p *ListRolesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListRolesParams) Validate() error {
+	v := newParamValidator("listRoles", p.p)
+	v.ids("id")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("type", 255)
+	v.oneOf("type", "Admin", "ResourceAdmin", "DomainAdmin", "User")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListRolesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListRolesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword", "name", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listRoles", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listRoles: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListRolesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -462,6 +1136,15 @@ func (p *ListRolesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListRolesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListRolesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListRolesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -469,6 +1152,15 @@ func (p *ListRolesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListRolesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListRolesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListRolesParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -476,6 +1168,15 @@ func (p *ListRolesParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListRolesParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListRolesParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListRolesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -483,6 +1184,15 @@ func (p *ListRolesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListRolesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListRolesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListRolesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -490,6 +1200,15 @@ func (p *ListRolesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListRolesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListRolesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListRolesParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -497,6 +1216,15 @@ func (p *ListRolesParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *ListRolesParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ListRolesParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListRolesParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewListRolesParams() *ListRolesParams {
@@ -507,6 +1235,11 @@ func (s *RoleService) NewListRolesParams() *ListRolesParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RoleService) GetRoleID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetRoleIDWithContext(context.Background(), name, opts...)
+}
+
+// GetRoleIDWithContext is the same as GetRoleID, but uses ctx to cancel the request
+func (s *RoleService) GetRoleIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListRolesParams{}
 	p.p = make(map[string]interface{})
 
@@ -518,7 +1251,7 @@ func (s *RoleService) GetRoleID(name string, opts ...OptionFunc) (string, int, e
 		}
 	}
 
-	l, err := s.ListRoles(p)
+	l, err := s.ListRolesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -543,12 +1276,17 @@ func (s *RoleService) GetRoleID(name string, opts ...OptionFunc) (string, int, e
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RoleService) GetRoleByName(name string, opts ...OptionFunc) (*Role, int, error) {
-	id, count, err := s.GetRoleID(name, opts...)
+	return s.GetRoleByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetRoleByNameWithContext is the same as GetRoleByName, but uses ctx to cancel the requests
+func (s *RoleService) GetRoleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Role, int, error) {
+	id, count, err := s.GetRoleIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetRoleByID(id, opts...)
+	r, count, err := s.GetRoleByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -557,6 +1295,11 @@ func (s *RoleService) GetRoleByName(name string, opts ...OptionFunc) (*Role, int
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RoleService) GetRoleByID(id string, opts ...OptionFunc) (*Role, int, error) {
+	return s.GetRoleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetRoleByIDWithContext is the same as GetRoleByID, but uses ctx to cancel the request
+func (s *RoleService) GetRoleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Role, int, error) {
 	p := &ListRolesParams{}
 	p.p = make(map[string]interface{})
 
@@ -568,18 +1311,16 @@ func (s *RoleService) GetRoleByID(id string, opts ...OptionFunc) (*Role, int, er
 		}
 	}
 
-	l, err := s.ListRoles(p)
+	l, err := s.ListRolesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -590,7 +1331,16 @@ func (s *RoleService) GetRoleByID(id string, opts ...OptionFunc) (*Role, int, er
 
 // Lists dynamic roles in CloudStack
 func (s *RoleService) ListRoles(p *ListRolesParams) (*ListRolesResponse, error) {
-	resp, err := s.cs.newRequest("listRoles", p.toURLValues())
+	return s.ListRolesWithContext(context.Background(), p)
+}
+
+// ListRolesWithContext is the same as ListRoles, but uses ctx to cancel the request and any async job polling
+func (s *RoleService) ListRolesWithContext(ctx context.Context, p *ListRolesParams) (*ListRolesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listRoles", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -603,6 +1353,92 @@ func (s *RoleService) ListRoles(p *ListRolesParams) (*ListRolesResponse, error)
 	return &r, nil
 }
 
+// ListRolesPager iterates over all pages of a ListRoles call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListRolesPager struct {
+	pager
+
+	s *RoleService
+	p *ListRolesParams
+	r *ListRolesResponse
+}
+
+// NewListRolesPager returns a pager for all pages of a ListRoles call with the given params
+func (s *RoleService) NewListRolesPager(p *ListRolesParams) *ListRolesPager {
+	return &ListRolesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListRolesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListRolesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListRolesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListRolesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Roles), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListRolesPager) Page() *ListRolesResponse {
+	return pg.r
+}
+
+// ListRolesAll fetches all pages of a ListRoles call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *RoleService) ListRolesAll(p *ListRolesParams) (*ListRolesResponse, error) {
+	return s.ListRolesAllWithContext(context.Background(), p)
+}
+
+// ListRolesAllWithContext is the same as ListRolesAll, but uses ctx to cancel the requests
+func (s *RoleService) ListRolesAllWithContext(ctx context.Context, p *ListRolesParams) (*ListRolesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Role)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListRolesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListRolesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Roles
+		mu.Unlock()
+
+		return len(l.Roles), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListRolesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Roles = append(r.Roles, pages[page]...)
+	}
+	r.Count = len(r.Roles)
+
+	return r, nil
+}
+
 type ListRolesResponse struct {
 	Count int     `json:"count"`
 	Roles []*Role `json:"role"`
@@ -622,7 +1458,8 @@ type UpdateRoleParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateRoleParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateRoleParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -630,9 +1467,6 @@ func (p *UpdateRoleParams) toURLValues() url.Values {
 	if v, found := p.p["description"]; found {
 		u.Set("description", v.(string))
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
 	}
@@ -645,6 +1479,52 @@ func (p *UpdateRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateRoleParams) Validate() error {
+	v := newParamValidator("updateRole", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("description", 255)
+	v.maxLength("name", 255)
+	v.maxLength("type", 255)
+	v.oneOf("type", "Admin", "ResourceAdmin", "DomainAdmin", "User")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateRoleParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateRoleParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "id", "name", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateRole", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateRole: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateRoleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -652,6 +1532,15 @@ func (p *UpdateRoleParams) SetDescription(v string) {
 	p.p["description"] = v
 }
 
+func (p *UpdateRoleParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *UpdateRoleParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
 func (p *UpdateRoleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -659,6 +1548,15 @@ func (p *UpdateRoleParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateRoleParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateRoleParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateRoleParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -666,6 +1564,15 @@ func (p *UpdateRoleParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateRoleParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateRoleParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateRoleParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -673,6 +1580,15 @@ func (p *UpdateRoleParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *UpdateRoleParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *UpdateRoleParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateRoleParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewUpdateRoleParams(id string) *UpdateRoleParams {
@@ -684,7 +1600,16 @@ func (s *RoleService) NewUpdateRoleParams(id string) *UpdateRoleParams {
 
 // Updates a role
 func (s *RoleService) UpdateRole(p *UpdateRoleParams) (*UpdateRoleResponse, error) {
-	resp, err := s.cs.newRequest("updateRole", p.toURLValues())
+	return s.UpdateRoleWithContext(context.Background(), p)
+}
+
+// UpdateRoleWithContext is the same as UpdateRole, but uses ctx to cancel the request and any async job polling
+func (s *RoleService) UpdateRoleWithContext(ctx context.Context, p *UpdateRoleParams) (*UpdateRoleResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateRole", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -711,7 +1636,8 @@ type UpdateRolePermissionParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateRolePermissionParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateRolePermissionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -732,6 +1658,53 @@ func (p *UpdateRolePermissionParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateRolePermissionParams) Validate() error {
+	v := newParamValidator("updateRolePermission", p.p)
+	v.required("roleid")
+	v.ids("roleid", "ruleid")
+	v.maxLength("permission", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateRolePermissionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateRolePermissionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "permission", "roleid", "ruleid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ruleorder":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateRolePermission", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateRolePermission: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateRolePermissionParams) SetPermission(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -739,6 +1712,15 @@ func (p *UpdateRolePermissionParams) SetPermission(v string) {
 	p.p["permission"] = v
 }
 
+func (p *UpdateRolePermissionParams) ResetPermission() {
+	delete(p.p, "permission")
+}
+
+func (p *UpdateRolePermissionParams) GetPermission() (string, bool) {
+	value, ok := p.p["permission"].(string)
+	return value, ok
+}
+
 func (p *UpdateRolePermissionParams) SetRoleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -746,6 +1728,15 @@ func (p *UpdateRolePermissionParams) SetRoleid(v string) {
 	p.p["roleid"] = v
 }
 
+func (p *UpdateRolePermissionParams) ResetRoleid() {
+	delete(p.p, "roleid")
+}
+
+func (p *UpdateRolePermissionParams) GetRoleid() (string, bool) {
+	value, ok := p.p["roleid"].(string)
+	return value, ok
+}
+
 func (p *UpdateRolePermissionParams) SetRuleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -753,6 +1744,15 @@ func (p *UpdateRolePermissionParams) SetRuleid(v string) {
 	p.p["ruleid"] = v
 }
 
+func (p *UpdateRolePermissionParams) ResetRuleid() {
+	delete(p.p, "ruleid")
+}
+
+func (p *UpdateRolePermissionParams) GetRuleid() (string, bool) {
+	value, ok := p.p["ruleid"].(string)
+	return value, ok
+}
+
 func (p *UpdateRolePermissionParams) SetRuleorder(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -760,6 +1760,15 @@ func (p *UpdateRolePermissionParams) SetRuleorder(v []string) {
 	p.p["ruleorder"] = v
 }
 
+func (p *UpdateRolePermissionParams) ResetRuleorder() {
+	delete(p.p, "ruleorder")
+}
+
+func (p *UpdateRolePermissionParams) GetRuleorder() ([]string, bool) {
+	value, ok := p.p["ruleorder"].([]string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateRolePermissionParams instance,
 // as then you are sure you have configured all required params
 func (s *RoleService) NewUpdateRolePermissionParams(roleid string) *UpdateRolePermissionParams {
@@ -771,7 +1780,16 @@ func (s *RoleService) NewUpdateRolePermissionParams(roleid string) *UpdateRolePe
 
 // Updates a role permission order
 func (s *RoleService) UpdateRolePermission(p *UpdateRolePermissionParams) (*UpdateRolePermissionResponse, error) {
-	resp, err := s.cs.newRequest("updateRolePermission", p.toURLValues())
+	return s.UpdateRolePermissionWithContext(context.Background(), p)
+}
+
+// UpdateRolePermissionWithContext is the same as UpdateRolePermission, but uses ctx to cancel the request and any async job polling
+func (s *RoleService) UpdateRolePermissionWithContext(ctx context.Context, p *UpdateRolePermissionParams) (*UpdateRolePermissionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateRolePermission", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -20,18 +20,81 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// RouterServiceIface is the interface implemented by RouterService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type RouterServiceIface interface {
+	NewChangeServiceForRouterParams(id string, serviceofferingid string) *ChangeServiceForRouterParams
+	ChangeServiceForRouter(p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error)
+	ChangeServiceForRouterWithContext(ctx context.Context, p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error)
+	NewConfigureVirtualRouterElementParams(enabled bool, id string) *ConfigureVirtualRouterElementParams
+	ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error)
+	ConfigureVirtualRouterElementWithContext(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error)
+	ConfigureVirtualRouterElementAsync(p *ConfigureVirtualRouterElementParams) (*Job, error)
+	ConfigureVirtualRouterElementAsyncWithContext(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*Job, error)
+	NewCreateVirtualRouterElementParams(nspid string) *CreateVirtualRouterElementParams
+	CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error)
+	CreateVirtualRouterElementWithContext(ctx context.Context, p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error)
+	CreateVirtualRouterElementAsync(p *CreateVirtualRouterElementParams) (*Job, error)
+	CreateVirtualRouterElementAsyncWithContext(ctx context.Context, p *CreateVirtualRouterElementParams) (*Job, error)
+	NewDestroyRouterParams(id string) *DestroyRouterParams
+	DestroyRouter(p *DestroyRouterParams) (*DestroyRouterResponse, error)
+	DestroyRouterWithContext(ctx context.Context, p *DestroyRouterParams) (*DestroyRouterResponse, error)
+	DestroyRouterAsync(p *DestroyRouterParams) (*Job, error)
+	DestroyRouterAsyncWithContext(ctx context.Context, p *DestroyRouterParams) (*Job, error)
+	NewGetRouterHealthCheckResultsParams(routerid string) *GetRouterHealthCheckResultsParams
+	GetRouterHealthCheckResults(p *GetRouterHealthCheckResultsParams) (*GetRouterHealthCheckResultsResponse, error)
+	GetRouterHealthCheckResultsWithContext(ctx context.Context, p *GetRouterHealthCheckResultsParams) (*GetRouterHealthCheckResultsResponse, error)
+	NewListRoutersParams() *ListRoutersParams
+	GetRouterID(name string, opts ...OptionFunc) (string, int, error)
+	GetRouterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetRouterByName(name string, opts ...OptionFunc) (*Router, int, error)
+	GetRouterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Router, int, error)
+	GetRouterByID(id string, opts ...OptionFunc) (*Router, int, error)
+	GetRouterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Router, int, error)
+	ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error)
+	ListRoutersWithContext(ctx context.Context, p *ListRoutersParams) (*ListRoutersResponse, error)
+	NewListRoutersPager(p *ListRoutersParams) *ListRoutersPager
+	ListRoutersAll(p *ListRoutersParams) (*ListRoutersResponse, error)
+	ListRoutersAllWithContext(ctx context.Context, p *ListRoutersParams) (*ListRoutersResponse, error)
+	NewListVirtualRouterElementsParams() *ListVirtualRouterElementsParams
+	GetVirtualRouterElementByID(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error)
+	GetVirtualRouterElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualRouterElement, int, error)
+	ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error)
+	ListVirtualRouterElementsWithContext(ctx context.Context, p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error)
+	NewListVirtualRouterElementsPager(p *ListVirtualRouterElementsParams) *ListVirtualRouterElementsPager
+	ListVirtualRouterElementsAll(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error)
+	ListVirtualRouterElementsAllWithContext(ctx context.Context, p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error)
+	NewRebootRouterParams(id string) *RebootRouterParams
+	RebootRouter(p *RebootRouterParams) (*RebootRouterResponse, error)
+	RebootRouterWithContext(ctx context.Context, p *RebootRouterParams) (*RebootRouterResponse, error)
+	RebootRouterAsync(p *RebootRouterParams) (*Job, error)
+	RebootRouterAsyncWithContext(ctx context.Context, p *RebootRouterParams) (*Job, error)
+	NewStartRouterParams(id string) *StartRouterParams
+	StartRouter(p *StartRouterParams) (*StartRouterResponse, error)
+	StartRouterWithContext(ctx context.Context, p *StartRouterParams) (*StartRouterResponse, error)
+	StartRouterAsync(p *StartRouterParams) (*Job, error)
+	StartRouterAsyncWithContext(ctx context.Context, p *StartRouterParams) (*Job, error)
+	NewStopRouterParams(id string) *StopRouterParams
+	StopRouter(p *StopRouterParams) (*StopRouterResponse, error)
+	StopRouterWithContext(ctx context.Context, p *StopRouterParams) (*StopRouterResponse, error)
+	StopRouterAsync(p *StopRouterParams) (*Job, error)
+	StopRouterAsyncWithContext(ctx context.Context, p *StopRouterParams) (*Job, error)
+}
+
 type ChangeServiceForRouterParams struct {
 	p map[string]interface{}
 }
 
-func (p *ChangeServiceForRouterParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ChangeServiceForRouterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -45,6 +108,48 @@ func (p *ChangeServiceForRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ChangeServiceForRouterParams) Validate() error {
+	v := newParamValidator("changeServiceForRouter", p.p)
+	v.required("id", "serviceofferingid")
+	v.ids("id", "serviceofferingid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ChangeServiceForRouterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ChangeServiceForRouterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "serviceofferingid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for changeServiceForRouter", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of changeServiceForRouter: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ChangeServiceForRouterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -52,6 +157,15 @@ func (p *ChangeServiceForRouterParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ChangeServiceForRouterParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ChangeServiceForRouterParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ChangeServiceForRouterParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -59,6 +173,15 @@ func (p *ChangeServiceForRouterParams) SetServiceofferingid(v string) {
 	p.p["serviceofferingid"] = v
 }
 
+func (p *ChangeServiceForRouterParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *ChangeServiceForRouterParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ChangeServiceForRouterParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewChangeServiceForRouterParams(id string, serviceofferingid string) *ChangeServiceForRouterParams {
@@ -71,7 +194,16 @@ func (s *RouterService) NewChangeServiceForRouterParams(id string, serviceofferi
 
 // Upgrades domain router to a new service offering
 func (s *RouterService) ChangeServiceForRouter(p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForRouter", p.toURLValues())
+	return s.ChangeServiceForRouterWithContext(context.Background(), p)
+}
+
+// ChangeServiceForRouterWithContext is the same as ChangeServiceForRouter, but uses ctx to cancel the request and any async job polling
+func (s *RouterService) ChangeServiceForRouterWithContext(ctx context.Context, p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "changeServiceForRouter", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -151,7 +283,8 @@ type ConfigureVirtualRouterElementParams struct {
 	p map[string]interface{}
 }
 
-func (p *ConfigureVirtualRouterElementParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ConfigureVirtualRouterElementParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -166,6 +299,52 @@ func (p *ConfigureVirtualRouterElementParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ConfigureVirtualRouterElementParams) Validate() error {
+	v := newParamValidator("configureVirtualRouterElement", p.p)
+	v.required("enabled", "id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ConfigureVirtualRouterElementParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ConfigureVirtualRouterElementParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "enabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for configureVirtualRouterElement", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of configureVirtualRouterElement: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ConfigureVirtualRouterElementParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -173,6 +352,15 @@ func (p *ConfigureVirtualRouterElementParams) SetEnabled(v bool) {
 	p.p["enabled"] = v
 }
 
+func (p *ConfigureVirtualRouterElementParams) ResetEnabled() {
+	delete(p.p, "enabled")
+}
+
+func (p *ConfigureVirtualRouterElementParams) GetEnabled() (bool, bool) {
+	value, ok := p.p["enabled"].(bool)
+	return value, ok
+}
+
 func (p *ConfigureVirtualRouterElementParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -180,6 +368,15 @@ func (p *ConfigureVirtualRouterElementParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ConfigureVirtualRouterElementParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ConfigureVirtualRouterElementParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ConfigureVirtualRouterElementParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewConfigureVirtualRouterElementParams(enabled bool, id string) *ConfigureVirtualRouterElementParams {
@@ -192,7 +389,16 @@ func (s *RouterService) NewConfigureVirtualRouterElementParams(enabled bool, id
 
 // Configures a virtual router element.
 func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error) {
-	resp, err := s.cs.newRequest("configureVirtualRouterElement", p.toURLValues())
+	return s.ConfigureVirtualRouterElementWithContext(context.Background(), p)
+}
+
+// ConfigureVirtualRouterElementWithContext is the same as ConfigureVirtualRouterElement, but uses ctx to cancel the request and any async job polling
+func (s *RouterService) ConfigureVirtualRouterElementWithContext(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureVirtualRouterElement", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +410,7 @@ func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterE
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -225,6 +431,40 @@ func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterE
 	return &r, nil
 }
 
+// ConfigureVirtualRouterElementAsync starts the async job for ConfigureVirtualRouterElement and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a VirtualRouterElementResponse using Job.Result
+func (s *RouterService) ConfigureVirtualRouterElementAsync(p *ConfigureVirtualRouterElementParams) (*Job, error) {
+	return s.ConfigureVirtualRouterElementAsyncWithContext(context.Background(), p)
+}
+
+// ConfigureVirtualRouterElementAsyncWithContext is the same as ConfigureVirtualRouterElementAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *RouterService) ConfigureVirtualRouterElementAsyncWithContext(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureVirtualRouterElement", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r VirtualRouterElementResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type VirtualRouterElementResponse struct {
 	Account   string `json:"account"`
 	Domain    string `json:"domain"`
@@ -242,7 +482,8 @@ type CreateVirtualRouterElementParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateVirtualRouterElementParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateVirtualRouterElementParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -256,6 +497,48 @@ func (p *CreateVirtualRouterElementParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateVirtualRouterElementParams) Validate() error {
+	v := newParamValidator("createVirtualRouterElement", p.p)
+	v.required("nspid")
+	v.ids("nspid", "providertype")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateVirtualRouterElementParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateVirtualRouterElementParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "nspid", "providertype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createVirtualRouterElement", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createVirtualRouterElement: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVirtualRouterElementParams) SetNspid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -263,6 +546,15 @@ func (p *CreateVirtualRouterElementParams) SetNspid(v string) {
 	p.p["nspid"] = v
 }
 
+func (p *CreateVirtualRouterElementParams) ResetNspid() {
+	delete(p.p, "nspid")
+}
+
+func (p *CreateVirtualRouterElementParams) GetNspid() (string, bool) {
+	value, ok := p.p["nspid"].(string)
+	return value, ok
+}
+
 func (p *CreateVirtualRouterElementParams) SetProvidertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -270,6 +562,15 @@ func (p *CreateVirtualRouterElementParams) SetProvidertype(v string) {
 	p.p["providertype"] = v
 }
 
+func (p *CreateVirtualRouterElementParams) ResetProvidertype() {
+	delete(p.p, "providertype")
+}
+
+func (p *CreateVirtualRouterElementParams) GetProvidertype() (string, bool) {
+	value, ok := p.p["providertype"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateVirtualRouterElementParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewCreateVirtualRouterElementParams(nspid string) *CreateVirtualRouterElementParams {
@@ -281,7 +582,16 @@ func (s *RouterService) NewCreateVirtualRouterElementParams(nspid string) *Creat
 
 // Create a virtual router element.
 func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error) {
-	resp, err := s.cs.newRequest("createVirtualRouterElement", p.toURLValues())
+	return s.CreateVirtualRouterElementWithContext(context.Background(), p)
+}
+
+// CreateVirtualRouterElementWithContext is the same as CreateVirtualRouterElement, but uses ctx to cancel the request and any async job polling
+func (s *RouterService) CreateVirtualRouterElementWithContext(ctx context.Context, p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVirtualRouterElement", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -293,7 +603,7 @@ func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElement
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -314,6 +624,40 @@ func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElement
 	return &r, nil
 }
 
+// CreateVirtualRouterElementAsync starts the async job for CreateVirtualRouterElement and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateVirtualRouterElementResponse using Job.Result
+func (s *RouterService) CreateVirtualRouterElementAsync(p *CreateVirtualRouterElementParams) (*Job, error) {
+	return s.CreateVirtualRouterElementAsyncWithContext(context.Background(), p)
+}
+
+// CreateVirtualRouterElementAsyncWithContext is the same as CreateVirtualRouterElementAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *RouterService) CreateVirtualRouterElementAsyncWithContext(ctx context.Context, p *CreateVirtualRouterElementParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVirtualRouterElement", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVirtualRouterElementResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateVirtualRouterElementResponse struct {
 	Account   string `json:"account"`
 	Domain    string `json:"domain"`
@@ -331,7 +675,8 @@ type DestroyRouterParams struct {
 	p map[string]interface{}
 }
 
-func (p *DestroyRouterParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DestroyRouterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -342,6 +687,48 @@ func (p *DestroyRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DestroyRouterParams) Validate() error {
+	v := newParamValidator("destroyRouter", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DestroyRouterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DestroyRouterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for destroyRouter", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of destroyRouter: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DestroyRouterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -349,6 +736,15 @@ func (p *DestroyRouterParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DestroyRouterParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DestroyRouterParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DestroyRouterParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewDestroyRouterParams(id string) *DestroyRouterParams {
@@ -360,7 +756,16 @@ func (s *RouterService) NewDestroyRouterParams(id string) *DestroyRouterParams {
 
 // Destroys a router.
 func (s *RouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterResponse, error) {
-	resp, err := s.cs.newRequest("destroyRouter", p.toURLValues())
+	return s.DestroyRouterWithContext(context.Background(), p)
+}
+
+// DestroyRouterWithContext is the same as DestroyRouter, but uses ctx to cancel the request and any async job polling
+func (s *RouterService) DestroyRouterWithContext(ctx context.Context, p *DestroyRouterParams) (*DestroyRouterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "destroyRouter", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -372,7 +777,7 @@ func (s *RouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterRes
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -393,6 +798,40 @@ func (s *RouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterRes
 	return &r, nil
 }
 
+// DestroyRouterAsync starts the async job for DestroyRouter and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DestroyRouterResponse using Job.Result
+func (s *RouterService) DestroyRouterAsync(p *DestroyRouterParams) (*Job, error) {
+	return s.DestroyRouterAsyncWithContext(context.Background(), p)
+}
+
+// DestroyRouterAsyncWithContext is the same as DestroyRouterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *RouterService) DestroyRouterAsyncWithContext(ctx context.Context, p *DestroyRouterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "destroyRouter", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DestroyRouterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type DestroyRouterResponse struct {
 	Account             string                                    `json:"account"`
 	Created             string                                    `json:"created"`
@@ -456,11 +895,150 @@ type DestroyRouterResponseHealthcheckresults struct {
 	Success     bool   `json:"success"`
 }
 
+type GetRouterHealthCheckResultsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetRouterHealthCheckResultsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["performfreshchecks"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("performfreshchecks", vv)
+	}
+	if v, found := p.p["routerid"]; found {
+		u.Set("routerid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetRouterHealthCheckResultsParams) Validate() error {
+	v := newParamValidator("getRouterHealthCheckResults", p.p)
+	v.required("routerid")
+	v.ids("routerid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetRouterHealthCheckResultsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetRouterHealthCheckResultsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "performfreshchecks":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "routerid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getRouterHealthCheckResults", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getRouterHealthCheckResults: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *GetRouterHealthCheckResultsParams) SetPerformfreshchecks(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["performfreshchecks"] = v
+}
+
+func (p *GetRouterHealthCheckResultsParams) ResetPerformfreshchecks() {
+	delete(p.p, "performfreshchecks")
+}
+
+func (p *GetRouterHealthCheckResultsParams) GetPerformfreshchecks() (bool, bool) {
+	value, ok := p.p["performfreshchecks"].(bool)
+	return value, ok
+}
+
+func (p *GetRouterHealthCheckResultsParams) SetRouterid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["routerid"] = v
+}
+
+func (p *GetRouterHealthCheckResultsParams) ResetRouterid() {
+	delete(p.p, "routerid")
+}
+
+func (p *GetRouterHealthCheckResultsParams) GetRouterid() (string, bool) {
+	value, ok := p.p["routerid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new GetRouterHealthCheckResultsParams instance,
+// as then you are sure you have configured all required params
+func (s *RouterService) NewGetRouterHealthCheckResultsParams(routerid string) *GetRouterHealthCheckResultsParams {
+	p := &GetRouterHealthCheckResultsParams{}
+	p.p = make(map[string]interface{})
+	p.p["routerid"] = routerid
+	return p
+}
+
+// Starts a router.
+func (s *RouterService) GetRouterHealthCheckResults(p *GetRouterHealthCheckResultsParams) (*GetRouterHealthCheckResultsResponse, error) {
+	return s.GetRouterHealthCheckResultsWithContext(context.Background(), p)
+}
+
+// GetRouterHealthCheckResultsWithContext is the same as GetRouterHealthCheckResults, but uses ctx to cancel the request and any async job polling
+func (s *RouterService) GetRouterHealthCheckResultsWithContext(ctx context.Context, p *GetRouterHealthCheckResultsParams) (*GetRouterHealthCheckResultsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getRouterHealthCheckResults", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r GetRouterHealthCheckResultsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type GetRouterHealthCheckResultsResponse struct {
+	Healthchecks []string `json:"healthchecks"`
+	JobID        string   `json:"jobid"`
+	Jobstatus    int      `json:"jobstatus"`
+	Routerid     string   `json:"routerid"`
+}
+
 type ListRoutersParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListRoutersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListRoutersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -534,6 +1112,60 @@ func (p *ListRoutersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListRoutersParams) Validate() error {
+	v := newParamValidator("listRouters", p.p)
+	v.ids("clusterid", "domainid", "hostid", "id", "networkid", "podid", "projectid", "vpcid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	v.maxLength("version", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListRoutersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListRoutersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "clusterid", "domainid", "hostid", "id", "keyword", "name", "networkid", "podid", "projectid", "state", "version", "vpcid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fetchhealthcheckresults", "forvpc", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listRouters", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listRouters: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListRoutersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -541,6 +1173,15 @@ func (p *ListRoutersParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListRoutersParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListRoutersParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -548,6 +1189,15 @@ func (p *ListRoutersParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *ListRoutersParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ListRoutersParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -555,6 +1205,15 @@ func (p *ListRoutersParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListRoutersParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListRoutersParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetFetchhealthcheckresults(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -562,6 +1221,15 @@ func (p *ListRoutersParams) SetFetchhealthcheckresults(v bool) {
 	p.p["fetchhealthcheckresults"] = v
 }
 
+func (p *ListRoutersParams) ResetFetchhealthcheckresults() {
+	delete(p.p, "fetchhealthcheckresults")
+}
+
+func (p *ListRoutersParams) GetFetchhealthcheckresults() (bool, bool) {
+	value, ok := p.p["fetchhealthcheckresults"].(bool)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetForvpc(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -569,6 +1237,15 @@ func (p *ListRoutersParams) SetForvpc(v bool) {
 	p.p["forvpc"] = v
 }
 
+func (p *ListRoutersParams) ResetForvpc() {
+	delete(p.p, "forvpc")
+}
+
+func (p *ListRoutersParams) GetForvpc() (bool, bool) {
+	value, ok := p.p["forvpc"].(bool)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -576,6 +1253,15 @@ func (p *ListRoutersParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *ListRoutersParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ListRoutersParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -583,6 +1269,15 @@ func (p *ListRoutersParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListRoutersParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListRoutersParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -590,6 +1285,15 @@ func (p *ListRoutersParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListRoutersParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListRoutersParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -597,6 +1301,15 @@ func (p *ListRoutersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListRoutersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListRoutersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -604,6 +1317,15 @@ func (p *ListRoutersParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListRoutersParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListRoutersParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -611,6 +1333,15 @@ func (p *ListRoutersParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListRoutersParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListRoutersParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -618,6 +1349,15 @@ func (p *ListRoutersParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *ListRoutersParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListRoutersParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -625,6 +1365,15 @@ func (p *ListRoutersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListRoutersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListRoutersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -632,6 +1381,15 @@ func (p *ListRoutersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListRoutersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListRoutersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -639,6 +1397,15 @@ func (p *ListRoutersParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *ListRoutersParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListRoutersParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -646,6 +1413,15 @@ func (p *ListRoutersParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListRoutersParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListRoutersParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -653,6 +1429,15 @@ func (p *ListRoutersParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListRoutersParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListRoutersParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetVersion(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -660,6 +1445,15 @@ func (p *ListRoutersParams) SetVersion(v string) {
 	p.p["version"] = v
 }
 
+func (p *ListRoutersParams) ResetVersion() {
+	delete(p.p, "version")
+}
+
+func (p *ListRoutersParams) GetVersion() (string, bool) {
+	value, ok := p.p["version"].(string)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -667,6 +1461,15 @@ func (p *ListRoutersParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *ListRoutersParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *ListRoutersParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 func (p *ListRoutersParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -674,6 +1477,15 @@ func (p *ListRoutersParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListRoutersParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListRoutersParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListRoutersParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewListRoutersParams() *ListRoutersParams {
@@ -684,6 +1496,11 @@ func (s *RouterService) NewListRoutersParams() *ListRoutersParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RouterService) GetRouterID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetRouterIDWithContext(context.Background(), name, opts...)
+}
+
+// GetRouterIDWithContext is the same as GetRouterID, but uses ctx to cancel the request
+func (s *RouterService) GetRouterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListRoutersParams{}
 	p.p = make(map[string]interface{})
 
@@ -695,7 +1512,7 @@ func (s *RouterService) GetRouterID(name string, opts ...OptionFunc) (string, in
 		}
 	}
 
-	l, err := s.ListRouters(p)
+	l, err := s.ListRoutersWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -720,12 +1537,17 @@ func (s *RouterService) GetRouterID(name string, opts ...OptionFunc) (string, in
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RouterService) GetRouterByName(name string, opts ...OptionFunc) (*Router, int, error) {
-	id, count, err := s.GetRouterID(name, opts...)
+	return s.GetRouterByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetRouterByNameWithContext is the same as GetRouterByName, but uses ctx to cancel the requests
+func (s *RouterService) GetRouterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Router, int, error) {
+	id, count, err := s.GetRouterIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetRouterByID(id, opts...)
+	r, count, err := s.GetRouterByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -734,6 +1556,11 @@ func (s *RouterService) GetRouterByName(name string, opts ...OptionFunc) (*Route
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RouterService) GetRouterByID(id string, opts ...OptionFunc) (*Router, int, error) {
+	return s.GetRouterByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetRouterByIDWithContext is the same as GetRouterByID, but uses ctx to cancel the request
+func (s *RouterService) GetRouterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Router, int, error) {
 	p := &ListRoutersParams{}
 	p.p = make(map[string]interface{})
 
@@ -745,18 +1572,16 @@ func (s *RouterService) GetRouterByID(id string, opts ...OptionFunc) (*Router, i
 		}
 	}
 
-	l, err := s.ListRouters(p)
+	l, err := s.ListRoutersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -767,7 +1592,16 @@ func (s *RouterService) GetRouterByID(id string, opts ...OptionFunc) (*Router, i
 
 // List routers.
 func (s *RouterService) ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error) {
-	resp, err := s.cs.newRequest("listRouters", p.toURLValues())
+	return s.ListRoutersWithContext(context.Background(), p)
+}
+
+// ListRoutersWithContext is the same as ListRouters, but uses ctx to cancel the request and any async job polling
+func (s *RouterService) ListRoutersWithContext(ctx context.Context, p *ListRoutersParams) (*ListRoutersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listRouters", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -780,6 +1614,92 @@ func (s *RouterService) ListRouters(p *ListRoutersParams) (*ListRoutersResponse,
 	return &r, nil
 }
 
+// ListRoutersPager iterates over all pages of a ListRouters call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListRoutersPager struct {
+	pager
+
+	s *RouterService
+	p *ListRoutersParams
+	r *ListRoutersResponse
+}
+
+// NewListRoutersPager returns a pager for all pages of a ListRouters call with the given params
+func (s *RouterService) NewListRoutersPager(p *ListRoutersParams) *ListRoutersPager {
+	return &ListRoutersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListRoutersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListRoutersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListRoutersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListRoutersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Routers), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListRoutersPager) Page() *ListRoutersResponse {
+	return pg.r
+}
+
+// ListRoutersAll fetches all pages of a ListRouters call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *RouterService) ListRoutersAll(p *ListRoutersParams) (*ListRoutersResponse, error) {
+	return s.ListRoutersAllWithContext(context.Background(), p)
+}
+
+// ListRoutersAllWithContext is the same as ListRoutersAll, but uses ctx to cancel the requests
+func (s *RouterService) ListRoutersAllWithContext(ctx context.Context, p *ListRoutersParams) (*ListRoutersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Router)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListRoutersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListRoutersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Routers
+		mu.Unlock()
+
+		return len(l.Routers), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListRoutersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Routers = append(r.Routers, pages[page]...)
+	}
+	r.Count = len(r.Routers)
+
+	return r, nil
+}
+
 type ListRoutersResponse struct {
 	Count   int       `json:"count"`
 	Routers []*Router `json:"router"`
@@ -852,7 +1772,8 @@ type ListVirtualRouterElementsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListVirtualRouterElementsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVirtualRouterElementsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -881,6 +1802,56 @@ func (p *ListVirtualRouterElementsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVirtualRouterElementsParams) Validate() error {
+	v := newParamValidator("listVirtualRouterElements", p.p)
+	v.ids("id", "nspid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVirtualRouterElementsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVirtualRouterElementsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "enabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "keyword", "nspid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVirtualRouterElements", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVirtualRouterElements: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListVirtualRouterElementsParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -888,6 +1859,15 @@ func (p *ListVirtualRouterElementsParams) SetEnabled(v bool) {
 	p.p["enabled"] = v
 }
 
+func (p *ListVirtualRouterElementsParams) ResetEnabled() {
+	delete(p.p, "enabled")
+}
+
+func (p *ListVirtualRouterElementsParams) GetEnabled() (bool, bool) {
+	value, ok := p.p["enabled"].(bool)
+	return value, ok
+}
+
 func (p *ListVirtualRouterElementsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -895,6 +1875,15 @@ func (p *ListVirtualRouterElementsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListVirtualRouterElementsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListVirtualRouterElementsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualRouterElementsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -902,6 +1891,15 @@ func (p *ListVirtualRouterElementsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListVirtualRouterElementsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVirtualRouterElementsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualRouterElementsParams) SetNspid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -909,6 +1907,15 @@ func (p *ListVirtualRouterElementsParams) SetNspid(v string) {
 	p.p["nspid"] = v
 }
 
+func (p *ListVirtualRouterElementsParams) ResetNspid() {
+	delete(p.p, "nspid")
+}
+
+func (p *ListVirtualRouterElementsParams) GetNspid() (string, bool) {
+	value, ok := p.p["nspid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualRouterElementsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -916,6 +1923,15 @@ func (p *ListVirtualRouterElementsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListVirtualRouterElementsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVirtualRouterElementsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListVirtualRouterElementsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -923,6 +1939,15 @@ func (p *ListVirtualRouterElementsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListVirtualRouterElementsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVirtualRouterElementsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListVirtualRouterElementsParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewListVirtualRouterElementsParams() *ListVirtualRouterElementsParams {
@@ -933,6 +1958,11 @@ func (s *RouterService) NewListVirtualRouterElementsParams() *ListVirtualRouterE
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RouterService) GetVirtualRouterElementByID(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error) {
+	return s.GetVirtualRouterElementByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVirtualRouterElementByIDWithContext is the same as GetVirtualRouterElementByID, but uses ctx to cancel the request
+func (s *RouterService) GetVirtualRouterElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualRouterElement, int, error) {
 	p := &ListVirtualRouterElementsParams{}
 	p.p = make(map[string]interface{})
 
@@ -944,18 +1974,16 @@ func (s *RouterService) GetVirtualRouterElementByID(id string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListVirtualRouterElements(p)
+	l, err := s.ListVirtualRouterElementsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -966,7 +1994,16 @@ func (s *RouterService) GetVirtualRouterElementByID(id string, opts ...OptionFun
 
 // Lists all available virtual router elements.
 func (s *RouterService) ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualRouterElements", p.toURLValues())
+	return s.ListVirtualRouterElementsWithContext(context.Background(), p)
+}
+
+// ListVirtualRouterElementsWithContext is the same as ListVirtualRouterElements, but uses ctx to cancel the request and any async job polling
+func (s *RouterService) ListVirtualRouterElementsWithContext(ctx context.Context, p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVirtualRouterElements", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -979,6 +2016,92 @@ func (s *RouterService) ListVirtualRouterElements(p *ListVirtualRouterElementsPa
 	return &r, nil
 }
 
+// ListVirtualRouterElementsPager iterates over all pages of a ListVirtualRouterElements call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVirtualRouterElementsPager struct {
+	pager
+
+	s *RouterService
+	p *ListVirtualRouterElementsParams
+	r *ListVirtualRouterElementsResponse
+}
+
+// NewListVirtualRouterElementsPager returns a pager for all pages of a ListVirtualRouterElements call with the given params
+func (s *RouterService) NewListVirtualRouterElementsPager(p *ListVirtualRouterElementsParams) *ListVirtualRouterElementsPager {
+	return &ListVirtualRouterElementsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVirtualRouterElementsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVirtualRouterElementsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVirtualRouterElementsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVirtualRouterElementsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.VirtualRouterElements), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVirtualRouterElementsPager) Page() *ListVirtualRouterElementsResponse {
+	return pg.r
+}
+
+// ListVirtualRouterElementsAll fetches all pages of a ListVirtualRouterElements call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *RouterService) ListVirtualRouterElementsAll(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
+	return s.ListVirtualRouterElementsAllWithContext(context.Background(), p)
+}
+
+// ListVirtualRouterElementsAllWithContext is the same as ListVirtualRouterElementsAll, but uses ctx to cancel the requests
+func (s *RouterService) ListVirtualRouterElementsAllWithContext(ctx context.Context, p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*VirtualRouterElement)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVirtualRouterElementsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVirtualRouterElementsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.VirtualRouterElements
+		mu.Unlock()
+
+		return len(l.VirtualRouterElements), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVirtualRouterElementsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.VirtualRouterElements = append(r.VirtualRouterElements, pages[page]...)
+	}
+	r.Count = len(r.VirtualRouterElements)
+
+	return r, nil
+}
+
 type ListVirtualRouterElementsResponse struct {
 	Count                 int                     `json:"count"`
 	VirtualRouterElements []*VirtualRouterElement `json:"virtualrouterelement"`
@@ -1001,7 +2124,8 @@ type RebootRouterParams struct {
 	p map[string]interface{}
 }
 
-func (p *RebootRouterParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RebootRouterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1012,6 +2136,48 @@ func (p *RebootRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RebootRouterParams) Validate() error {
+	v := newParamValidator("rebootRouter", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RebootRouterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RebootRouterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for rebootRouter", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of rebootRouter: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RebootRouterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1019,6 +2185,15 @@ func (p *RebootRouterParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RebootRouterParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RebootRouterParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RebootRouterParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewRebootRouterParams(id string) *RebootRouterParams {
@@ -1030,7 +2205,16 @@ func (s *RouterService) NewRebootRouterParams(id string) *RebootRouterParams {
 
 // Starts a router.
 func (s *RouterService) RebootRouter(p *RebootRouterParams) (*RebootRouterResponse, error) {
-	resp, err := s.cs.newRequest("rebootRouter", p.toURLValues())
+	return s.RebootRouterWithContext(context.Background(), p)
+}
+
+// RebootRouterWithContext is the same as RebootRouter, but uses ctx to cancel the request and any async job polling
+func (s *RouterService) RebootRouterWithContext(ctx context.Context, p *RebootRouterParams) (*RebootRouterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "rebootRouter", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1042,7 +2226,7 @@ func (s *RouterService) RebootRouter(p *RebootRouterParams) (*RebootRouterRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1063,6 +2247,40 @@ func (s *RouterService) RebootRouter(p *RebootRouterParams) (*RebootRouterRespon
 	return &r, nil
 }
 
+// RebootRouterAsync starts the async job for RebootRouter and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RebootRouterResponse using Job.Result
+func (s *RouterService) RebootRouterAsync(p *RebootRouterParams) (*Job, error) {
+	return s.RebootRouterAsyncWithContext(context.Background(), p)
+}
+
+// RebootRouterAsyncWithContext is the same as RebootRouterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *RouterService) RebootRouterAsyncWithContext(ctx context.Context, p *RebootRouterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "rebootRouter", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RebootRouterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type RebootRouterResponse struct {
 	Account             string                                   `json:"account"`
 	Created             string                                   `json:"created"`
@@ -1130,7 +2348,8 @@ type StartRouterParams struct {
 	p map[string]interface{}
 }
 
-func (p *StartRouterParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *StartRouterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1141,6 +2360,48 @@ func (p *StartRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *StartRouterParams) Validate() error {
+	v := newParamValidator("startRouter", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *StartRouterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *StartRouterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for startRouter", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of startRouter: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *StartRouterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1148,6 +2409,15 @@ func (p *StartRouterParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *StartRouterParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *StartRouterParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new StartRouterParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewStartRouterParams(id string) *StartRouterParams {
@@ -1159,7 +2429,16 @@ func (s *RouterService) NewStartRouterParams(id string) *StartRouterParams {
 
 // Starts a router.
 func (s *RouterService) StartRouter(p *StartRouterParams) (*StartRouterResponse, error) {
-	resp, err := s.cs.newRequest("startRouter", p.toURLValues())
+	return s.StartRouterWithContext(context.Background(), p)
+}
+
+// StartRouterWithContext is the same as StartRouter, but uses ctx to cancel the request and any async job polling
+func (s *RouterService) StartRouterWithContext(ctx context.Context, p *StartRouterParams) (*StartRouterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "startRouter", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1171,7 +2450,7 @@ func (s *RouterService) StartRouter(p *StartRouterParams) (*StartRouterResponse,
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1192,6 +2471,40 @@ func (s *RouterService) StartRouter(p *StartRouterParams) (*StartRouterResponse,
 	return &r, nil
 }
 
+// StartRouterAsync starts the async job for StartRouter and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a StartRouterResponse using Job.Result
+func (s *RouterService) StartRouterAsync(p *StartRouterParams) (*Job, error) {
+	return s.StartRouterAsyncWithContext(context.Background(), p)
+}
+
+// StartRouterAsyncWithContext is the same as StartRouterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *RouterService) StartRouterAsyncWithContext(ctx context.Context, p *StartRouterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "startRouter", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StartRouterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type StartRouterResponse struct {
 	Account             string                                  `json:"account"`
 	Created             string                                  `json:"created"`
@@ -1259,7 +2572,8 @@ type StopRouterParams struct {
 	p map[string]interface{}
 }
 
-func (p *StopRouterParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *StopRouterParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1274,6 +2588,52 @@ func (p *StopRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *StopRouterParams) Validate() error {
+	v := newParamValidator("stopRouter", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *StopRouterParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *StopRouterParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "forced":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for stopRouter", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of stopRouter: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *StopRouterParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1281,6 +2641,15 @@ func (p *StopRouterParams) SetForced(v bool) {
 	p.p["forced"] = v
 }
 
+func (p *StopRouterParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *StopRouterParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
 func (p *StopRouterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1288,6 +2657,15 @@ func (p *StopRouterParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *StopRouterParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *StopRouterParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new StopRouterParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewStopRouterParams(id string) *StopRouterParams {
@@ -1299,7 +2677,16 @@ func (s *RouterService) NewStopRouterParams(id string) *StopRouterParams {
 
 // Stops a router.
 func (s *RouterService) StopRouter(p *StopRouterParams) (*StopRouterResponse, error) {
-	resp, err := s.cs.newRequest("stopRouter", p.toURLValues())
+	return s.StopRouterWithContext(context.Background(), p)
+}
+
+// StopRouterWithContext is the same as StopRouter, but uses ctx to cancel the request and any async job polling
+func (s *RouterService) StopRouterWithContext(ctx context.Context, p *StopRouterParams) (*StopRouterResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "stopRouter", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1311,7 +2698,7 @@ func (s *RouterService) StopRouter(p *StopRouterParams) (*StopRouterResponse, er
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1332,6 +2719,40 @@ func (s *RouterService) StopRouter(p *StopRouterParams) (*StopRouterResponse, er
 	return &r, nil
 }
 
+// StopRouterAsync starts the async job for StopRouter and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a StopRouterResponse using Job.Result
+func (s *RouterService) StopRouterAsync(p *StopRouterParams) (*Job, error) {
+	return s.StopRouterAsyncWithContext(context.Background(), p)
+}
+
+// StopRouterAsyncWithContext is the same as StopRouterAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *RouterService) StopRouterAsyncWithContext(ctx context.Context, p *StopRouterParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "stopRouter", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StopRouterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type StopRouterResponse struct {
 	Account             string                                 `json:"account"`
 	Created             string                                 `json:"created"`
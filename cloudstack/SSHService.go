@@ -20,16 +20,45 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// SSHServiceIface is the interface implemented by SSHService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type SSHServiceIface interface {
+	NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairParams
+	CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error)
+	CreateSSHKeyPairWithContext(ctx context.Context, p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error)
+	NewDeleteSSHKeyPairParams(name string) *DeleteSSHKeyPairParams
+	DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error)
+	DeleteSSHKeyPairWithContext(ctx context.Context, p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error)
+	NewListSSHKeyPairsParams() *ListSSHKeyPairsParams
+	ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error)
+	ListSSHKeyPairsWithContext(ctx context.Context, p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error)
+	NewListSSHKeyPairsPager(p *ListSSHKeyPairsParams) *ListSSHKeyPairsPager
+	ListSSHKeyPairsAll(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error)
+	ListSSHKeyPairsAllWithContext(ctx context.Context, p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error)
+	NewRegisterSSHKeyPairParams(name string, publickey string) *RegisterSSHKeyPairParams
+	RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error)
+	RegisterSSHKeyPairWithContext(ctx context.Context, p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error)
+	NewResetSSHKeyForVirtualMachineParams(id string, keypair string) *ResetSSHKeyForVirtualMachineParams
+	ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error)
+	ResetSSHKeyForVirtualMachineWithContext(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error)
+	ResetSSHKeyForVirtualMachineAsync(p *ResetSSHKeyForVirtualMachineParams) (*Job, error)
+	ResetSSHKeyForVirtualMachineAsyncWithContext(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*Job, error)
+}
+
 type CreateSSHKeyPairParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateSSHKeyPairParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateSSHKeyPairParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -49,6 +78,50 @@ func (p *CreateSSHKeyPairParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateSSHKeyPairParams) Validate() error {
+	v := newParamValidator("createSSHKeyPair", p.p)
+	v.required("name")
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateSSHKeyPairParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateSSHKeyPairParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "name", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createSSHKeyPair", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createSSHKeyPair: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateSSHKeyPairParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -56,6 +129,15 @@ func (p *CreateSSHKeyPairParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateSSHKeyPairParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateSSHKeyPairParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateSSHKeyPairParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -63,6 +145,15 @@ func (p *CreateSSHKeyPairParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateSSHKeyPairParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateSSHKeyPairParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateSSHKeyPairParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -70,6 +161,15 @@ func (p *CreateSSHKeyPairParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateSSHKeyPairParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateSSHKeyPairParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateSSHKeyPairParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -77,6 +177,15 @@ func (p *CreateSSHKeyPairParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *CreateSSHKeyPairParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *CreateSSHKeyPairParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateSSHKeyPairParams instance,
 // as then you are sure you have configured all required params
 func (s *SSHService) NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairParams {
@@ -88,7 +197,16 @@ func (s *SSHService) NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairPar
 
 // Create a new keypair and returns the private key
 func (s *SSHService) CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("createSSHKeyPair", p.toURLValues())
+	return s.CreateSSHKeyPairWithContext(context.Background(), p)
+}
+
+// CreateSSHKeyPairWithContext is the same as CreateSSHKeyPair, but uses ctx to cancel the request and any async job polling
+func (s *SSHService) CreateSSHKeyPairWithContext(ctx context.Context, p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createSSHKeyPair", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +238,8 @@ type DeleteSSHKeyPairParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteSSHKeyPairParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteSSHKeyPairParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -140,6 +259,50 @@ func (p *DeleteSSHKeyPairParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteSSHKeyPairParams) Validate() error {
+	v := newParamValidator("deleteSSHKeyPair", p.p)
+	v.required("name")
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteSSHKeyPairParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteSSHKeyPairParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "name", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteSSHKeyPair", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteSSHKeyPair: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteSSHKeyPairParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -147,6 +310,15 @@ func (p *DeleteSSHKeyPairParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *DeleteSSHKeyPairParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *DeleteSSHKeyPairParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *DeleteSSHKeyPairParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -154,6 +326,15 @@ func (p *DeleteSSHKeyPairParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *DeleteSSHKeyPairParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *DeleteSSHKeyPairParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *DeleteSSHKeyPairParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -161,6 +342,15 @@ func (p *DeleteSSHKeyPairParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *DeleteSSHKeyPairParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *DeleteSSHKeyPairParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *DeleteSSHKeyPairParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -168,6 +358,15 @@ func (p *DeleteSSHKeyPairParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *DeleteSSHKeyPairParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *DeleteSSHKeyPairParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteSSHKeyPairParams instance,
 // as then you are sure you have configured all required params
 func (s *SSHService) NewDeleteSSHKeyPairParams(name string) *DeleteSSHKeyPairParams {
@@ -179,7 +378,16 @@ func (s *SSHService) NewDeleteSSHKeyPairParams(name string) *DeleteSSHKeyPairPar
 
 // Deletes a keypair by name
 func (s *SSHService) DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("deleteSSHKeyPair", p.toURLValues())
+	return s.DeleteSSHKeyPairWithContext(context.Background(), p)
+}
+
+// DeleteSSHKeyPairWithContext is the same as DeleteSSHKeyPair, but uses ctx to cancel the request and any async job polling
+func (s *SSHService) DeleteSSHKeyPairWithContext(ctx context.Context, p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSSHKeyPair", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -230,7 +438,8 @@ type ListSSHKeyPairsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListSSHKeyPairsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListSSHKeyPairsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -272,6 +481,59 @@ func (p *ListSSHKeyPairsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListSSHKeyPairsParams) Validate() error {
+	v := newParamValidator("listSSHKeyPairs", p.p)
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("fingerprint", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListSSHKeyPairsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListSSHKeyPairsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "fingerprint", "keyword", "name", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listSSHKeyPairs", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listSSHKeyPairs: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListSSHKeyPairsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -279,6 +541,15 @@ func (p *ListSSHKeyPairsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListSSHKeyPairsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListSSHKeyPairsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListSSHKeyPairsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -286,6 +557,15 @@ func (p *ListSSHKeyPairsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListSSHKeyPairsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListSSHKeyPairsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListSSHKeyPairsParams) SetFingerprint(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -293,6 +573,15 @@ func (p *ListSSHKeyPairsParams) SetFingerprint(v string) {
 	p.p["fingerprint"] = v
 }
 
+func (p *ListSSHKeyPairsParams) ResetFingerprint() {
+	delete(p.p, "fingerprint")
+}
+
+func (p *ListSSHKeyPairsParams) GetFingerprint() (string, bool) {
+	value, ok := p.p["fingerprint"].(string)
+	return value, ok
+}
+
 func (p *ListSSHKeyPairsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -300,6 +589,15 @@ func (p *ListSSHKeyPairsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListSSHKeyPairsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListSSHKeyPairsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListSSHKeyPairsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -307,6 +605,15 @@ func (p *ListSSHKeyPairsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListSSHKeyPairsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListSSHKeyPairsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListSSHKeyPairsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -314,6 +621,15 @@ func (p *ListSSHKeyPairsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListSSHKeyPairsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListSSHKeyPairsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListSSHKeyPairsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -321,6 +637,15 @@ func (p *ListSSHKeyPairsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListSSHKeyPairsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListSSHKeyPairsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListSSHKeyPairsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -328,6 +653,15 @@ func (p *ListSSHKeyPairsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListSSHKeyPairsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListSSHKeyPairsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListSSHKeyPairsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -335,6 +669,15 @@ func (p *ListSSHKeyPairsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListSSHKeyPairsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListSSHKeyPairsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListSSHKeyPairsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -342,6 +685,15 @@ func (p *ListSSHKeyPairsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListSSHKeyPairsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListSSHKeyPairsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListSSHKeyPairsParams instance,
 // as then you are sure you have configured all required params
 func (s *SSHService) NewListSSHKeyPairsParams() *ListSSHKeyPairsParams {
@@ -352,7 +704,16 @@ func (s *SSHService) NewListSSHKeyPairsParams() *ListSSHKeyPairsParams {
 
 // List registered keypairs
 func (s *SSHService) ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
-	resp, err := s.cs.newRequest("listSSHKeyPairs", p.toURLValues())
+	return s.ListSSHKeyPairsWithContext(context.Background(), p)
+}
+
+// ListSSHKeyPairsWithContext is the same as ListSSHKeyPairs, but uses ctx to cancel the request and any async job polling
+func (s *SSHService) ListSSHKeyPairsWithContext(ctx context.Context, p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listSSHKeyPairs", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -365,6 +726,92 @@ func (s *SSHService) ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairs
 	return &r, nil
 }
 
+// ListSSHKeyPairsPager iterates over all pages of a ListSSHKeyPairs call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListSSHKeyPairsPager struct {
+	pager
+
+	s *SSHService
+	p *ListSSHKeyPairsParams
+	r *ListSSHKeyPairsResponse
+}
+
+// NewListSSHKeyPairsPager returns a pager for all pages of a ListSSHKeyPairs call with the given params
+func (s *SSHService) NewListSSHKeyPairsPager(p *ListSSHKeyPairsParams) *ListSSHKeyPairsPager {
+	return &ListSSHKeyPairsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListSSHKeyPairsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListSSHKeyPairsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListSSHKeyPairsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListSSHKeyPairsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.SSHKeyPairs), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListSSHKeyPairsPager) Page() *ListSSHKeyPairsResponse {
+	return pg.r
+}
+
+// ListSSHKeyPairsAll fetches all pages of a ListSSHKeyPairs call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *SSHService) ListSSHKeyPairsAll(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
+	return s.ListSSHKeyPairsAllWithContext(context.Background(), p)
+}
+
+// ListSSHKeyPairsAllWithContext is the same as ListSSHKeyPairsAll, but uses ctx to cancel the requests
+func (s *SSHService) ListSSHKeyPairsAllWithContext(ctx context.Context, p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*SSHKeyPair)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListSSHKeyPairsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListSSHKeyPairsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.SSHKeyPairs
+		mu.Unlock()
+
+		return len(l.SSHKeyPairs), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListSSHKeyPairsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.SSHKeyPairs = append(r.SSHKeyPairs, pages[page]...)
+	}
+	r.Count = len(r.SSHKeyPairs)
+
+	return r, nil
+}
+
 type ListSSHKeyPairsResponse struct {
 	Count       int           `json:"count"`
 	SSHKeyPairs []*SSHKeyPair `json:"sshkeypair"`
@@ -384,7 +831,8 @@ type RegisterSSHKeyPairParams struct {
 	p map[string]interface{}
 }
 
-func (p *RegisterSSHKeyPairParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RegisterSSHKeyPairParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -407,6 +855,51 @@ func (p *RegisterSSHKeyPairParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RegisterSSHKeyPairParams) Validate() error {
+	v := newParamValidator("registerSSHKeyPair", p.p)
+	v.required("name", "publickey")
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("name", 255)
+	v.maxLength("publickey", 5120)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RegisterSSHKeyPairParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RegisterSSHKeyPairParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "name", "projectid", "publickey":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for registerSSHKeyPair", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of registerSSHKeyPair: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RegisterSSHKeyPairParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -414,6 +907,15 @@ func (p *RegisterSSHKeyPairParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *RegisterSSHKeyPairParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *RegisterSSHKeyPairParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *RegisterSSHKeyPairParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -421,6 +923,15 @@ func (p *RegisterSSHKeyPairParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *RegisterSSHKeyPairParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *RegisterSSHKeyPairParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *RegisterSSHKeyPairParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -428,6 +939,15 @@ func (p *RegisterSSHKeyPairParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *RegisterSSHKeyPairParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *RegisterSSHKeyPairParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *RegisterSSHKeyPairParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -435,6 +955,15 @@ func (p *RegisterSSHKeyPairParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *RegisterSSHKeyPairParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *RegisterSSHKeyPairParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *RegisterSSHKeyPairParams) SetPublickey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -442,6 +971,15 @@ func (p *RegisterSSHKeyPairParams) SetPublickey(v string) {
 	p.p["publickey"] = v
 }
 
+func (p *RegisterSSHKeyPairParams) ResetPublickey() {
+	delete(p.p, "publickey")
+}
+
+func (p *RegisterSSHKeyPairParams) GetPublickey() (string, bool) {
+	value, ok := p.p["publickey"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RegisterSSHKeyPairParams instance,
 // as then you are sure you have configured all required params
 func (s *SSHService) NewRegisterSSHKeyPairParams(name string, publickey string) *RegisterSSHKeyPairParams {
@@ -454,7 +992,16 @@ func (s *SSHService) NewRegisterSSHKeyPairParams(name string, publickey string)
 
 // Register a public key in a keypair under a certain name
 func (s *SSHService) RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("registerSSHKeyPair", p.toURLValues())
+	return s.RegisterSSHKeyPairWithContext(context.Background(), p)
+}
+
+// RegisterSSHKeyPairWithContext is the same as RegisterSSHKeyPair, but uses ctx to cancel the request and any async job polling
+func (s *SSHService) RegisterSSHKeyPairWithContext(ctx context.Context, p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "registerSSHKeyPair", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -485,7 +1032,8 @@ type ResetSSHKeyForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *ResetSSHKeyForVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ResetSSHKeyForVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -508,6 +1056,50 @@ func (p *ResetSSHKeyForVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ResetSSHKeyForVirtualMachineParams) Validate() error {
+	v := newParamValidator("resetSSHKeyForVirtualMachine", p.p)
+	v.required("id", "keypair")
+	v.ids("domainid", "id", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("keypair", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ResetSSHKeyForVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ResetSSHKeyForVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keypair", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for resetSSHKeyForVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of resetSSHKeyForVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ResetSSHKeyForVirtualMachineParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -515,6 +1107,15 @@ func (p *ResetSSHKeyForVirtualMachineParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ResetSSHKeyForVirtualMachineParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ResetSSHKeyForVirtualMachineParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -522,6 +1123,15 @@ func (p *ResetSSHKeyForVirtualMachineParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ResetSSHKeyForVirtualMachineParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ResetSSHKeyForVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -529,6 +1139,15 @@ func (p *ResetSSHKeyForVirtualMachineParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ResetSSHKeyForVirtualMachineParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ResetSSHKeyForVirtualMachineParams) SetKeypair(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -536,6 +1155,15 @@ func (p *ResetSSHKeyForVirtualMachineParams) SetKeypair(v string) {
 	p.p["keypair"] = v
 }
 
+func (p *ResetSSHKeyForVirtualMachineParams) ResetKeypair() {
+	delete(p.p, "keypair")
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) GetKeypair() (string, bool) {
+	value, ok := p.p["keypair"].(string)
+	return value, ok
+}
+
 func (p *ResetSSHKeyForVirtualMachineParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -543,6 +1171,15 @@ func (p *ResetSSHKeyForVirtualMachineParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ResetSSHKeyForVirtualMachineParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ResetSSHKeyForVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *SSHService) NewResetSSHKeyForVirtualMachineParams(id string, keypair string) *ResetSSHKeyForVirtualMachineParams {
@@ -555,7 +1192,16 @@ func (s *SSHService) NewResetSSHKeyForVirtualMachineParams(id string, keypair st
 
 // Resets the SSH Key for virtual machine. The virtual machine must be in a "Stopped" state. [async]
 func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("resetSSHKeyForVirtualMachine", p.toURLValues())
+	return s.ResetSSHKeyForVirtualMachineWithContext(context.Background(), p)
+}
+
+// ResetSSHKeyForVirtualMachineWithContext is the same as ResetSSHKeyForVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *SSHService) ResetSSHKeyForVirtualMachineWithContext(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "resetSSHKeyForVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -567,7 +1213,7 @@ func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachin
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -588,6 +1234,40 @@ func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachin
 	return &r, nil
 }
 
+// ResetSSHKeyForVirtualMachineAsync starts the async job for ResetSSHKeyForVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ResetSSHKeyForVirtualMachineResponse using Job.Result
+func (s *SSHService) ResetSSHKeyForVirtualMachineAsync(p *ResetSSHKeyForVirtualMachineParams) (*Job, error) {
+	return s.ResetSSHKeyForVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// ResetSSHKeyForVirtualMachineAsyncWithContext is the same as ResetSSHKeyForVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SSHService) ResetSSHKeyForVirtualMachineAsyncWithContext(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "resetSSHKeyForVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ResetSSHKeyForVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type ResetSSHKeyForVirtualMachineResponse struct {
 	Account               string                                              `json:"account"`
 	Affinitygroup         []ResetSSHKeyForVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -20,13 +20,61 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// SecurityGroupServiceIface is the interface implemented by SecurityGroupService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type SecurityGroupServiceIface interface {
+	NewAuthorizeSecurityGroupEgressParams() *AuthorizeSecurityGroupEgressParams
+	AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error)
+	AuthorizeSecurityGroupEgressWithContext(ctx context.Context, p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error)
+	AuthorizeSecurityGroupEgressAsync(p *AuthorizeSecurityGroupEgressParams) (*Job, error)
+	AuthorizeSecurityGroupEgressAsyncWithContext(ctx context.Context, p *AuthorizeSecurityGroupEgressParams) (*Job, error)
+	NewAuthorizeSecurityGroupIngressParams() *AuthorizeSecurityGroupIngressParams
+	AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error)
+	AuthorizeSecurityGroupIngressWithContext(ctx context.Context, p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error)
+	AuthorizeSecurityGroupIngressAsync(p *AuthorizeSecurityGroupIngressParams) (*Job, error)
+	AuthorizeSecurityGroupIngressAsyncWithContext(ctx context.Context, p *AuthorizeSecurityGroupIngressParams) (*Job, error)
+	NewCreateSecurityGroupParams(name string) *CreateSecurityGroupParams
+	CreateSecurityGroup(p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error)
+	CreateSecurityGroupWithContext(ctx context.Context, p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error)
+	NewDeleteSecurityGroupParams() *DeleteSecurityGroupParams
+	DeleteSecurityGroup(p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error)
+	DeleteSecurityGroupWithContext(ctx context.Context, p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error)
+	NewListSecurityGroupsParams() *ListSecurityGroupsParams
+	GetSecurityGroupID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetSecurityGroupIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
+	GetSecurityGroupByName(name string, opts ...OptionFunc) (*SecurityGroup, int, error)
+	GetSecurityGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SecurityGroup, int, error)
+	GetSecurityGroupByID(id string, opts ...OptionFunc) (*SecurityGroup, int, error)
+	GetSecurityGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SecurityGroup, int, error)
+	ListSecurityGroups(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error)
+	ListSecurityGroupsWithContext(ctx context.Context, p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error)
+	NewListSecurityGroupsPager(p *ListSecurityGroupsParams) *ListSecurityGroupsPager
+	ListSecurityGroupsAll(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error)
+	ListSecurityGroupsAllWithContext(ctx context.Context, p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error)
+	NewRevokeSecurityGroupEgressParams(id string) *RevokeSecurityGroupEgressParams
+	RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error)
+	RevokeSecurityGroupEgressWithContext(ctx context.Context, p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error)
+	RevokeSecurityGroupEgressAsync(p *RevokeSecurityGroupEgressParams) (*Job, error)
+	RevokeSecurityGroupEgressAsyncWithContext(ctx context.Context, p *RevokeSecurityGroupEgressParams) (*Job, error)
+	NewRevokeSecurityGroupIngressParams(id string) *RevokeSecurityGroupIngressParams
+	RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error)
+	RevokeSecurityGroupIngressWithContext(ctx context.Context, p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error)
+	RevokeSecurityGroupIngressAsync(p *RevokeSecurityGroupIngressParams) (*Job, error)
+	RevokeSecurityGroupIngressAsyncWithContext(ctx context.Context, p *RevokeSecurityGroupIngressParams) (*Job, error)
+	NewUpdateSecurityGroupParams(id string) *UpdateSecurityGroupParams
+	UpdateSecurityGroup(p *UpdateSecurityGroupParams) (*UpdateSecurityGroupResponse, error)
+	UpdateSecurityGroupWithContext(ctx context.Context, p *UpdateSecurityGroupParams) (*UpdateSecurityGroupResponse, error)
+}
+
 // Helper function for maintaining backwards compatibility
 func convertAuthorizeSecurityGroupIngressResponse(b []byte) ([]byte, error) {
 	var raw struct {
@@ -63,7 +111,8 @@ type AuthorizeSecurityGroupEgressParams struct {
 	p map[string]interface{}
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AuthorizeSecurityGroupEgressParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -107,15 +156,74 @@ func (p *AuthorizeSecurityGroupEgressParams) toURLValues() url.Values {
 		u.Set("startport", vv)
 	}
 	if v, found := p.p["usersecuritygrouplist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].account", i), k)
-			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].group", i), m[k])
+		for i, item := range v.([]UserSecurityGroup) {
+			if item.Account != "" {
+				u.Set(fmt.Sprintf("usersecuritygrouplist[%d].account", i), item.Account)
+			}
+			if item.Group != "" {
+				u.Set(fmt.Sprintf("usersecuritygrouplist[%d].group", i), item.Group)
+			}
 		}
 	}
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AuthorizeSecurityGroupEgressParams) Validate() error {
+	v := newParamValidator("authorizeSecurityGroupEgress", p.p)
+	v.ids("domainid", "projectid", "securitygroupid")
+	v.maxLength("account", 255)
+	v.maxLength("protocol", 255)
+	v.maxLength("securitygroupname", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AuthorizeSecurityGroupEgressParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AuthorizeSecurityGroupEgressParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "projectid", "protocol", "securitygroupid", "securitygroupname":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "cidrlist":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "endport", "icmpcode", "icmptype", "startport":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "usersecuritygrouplist":
+			var v []UserSecurityGroup
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for authorizeSecurityGroupEgress", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of authorizeSecurityGroupEgress: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -123,6 +231,15 @@ func (p *AuthorizeSecurityGroupEgressParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *AuthorizeSecurityGroupEgressParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -130,6 +247,15 @@ func (p *AuthorizeSecurityGroupEgressParams) SetCidrlist(v []string) {
 	p.p["cidrlist"] = v
 }
 
+func (p *AuthorizeSecurityGroupEgressParams) ResetCidrlist() {
+	delete(p.p, "cidrlist")
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) GetCidrlist() ([]string, bool) {
+	value, ok := p.p["cidrlist"].([]string)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -137,6 +263,15 @@ func (p *AuthorizeSecurityGroupEgressParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *AuthorizeSecurityGroupEgressParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetEndport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -144,6 +279,15 @@ func (p *AuthorizeSecurityGroupEgressParams) SetEndport(v int) {
 	p.p["endport"] = v
 }
 
+func (p *AuthorizeSecurityGroupEgressParams) ResetEndport() {
+	delete(p.p, "endport")
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) GetEndport() (int, bool) {
+	value, ok := p.p["endport"].(int)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetIcmpcode(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -151,6 +295,15 @@ func (p *AuthorizeSecurityGroupEgressParams) SetIcmpcode(v int) {
 	p.p["icmpcode"] = v
 }
 
+func (p *AuthorizeSecurityGroupEgressParams) ResetIcmpcode() {
+	delete(p.p, "icmpcode")
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) GetIcmpcode() (int, bool) {
+	value, ok := p.p["icmpcode"].(int)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetIcmptype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -158,6 +311,15 @@ func (p *AuthorizeSecurityGroupEgressParams) SetIcmptype(v int) {
 	p.p["icmptype"] = v
 }
 
+func (p *AuthorizeSecurityGroupEgressParams) ResetIcmptype() {
+	delete(p.p, "icmptype")
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) GetIcmptype() (int, bool) {
+	value, ok := p.p["icmptype"].(int)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -165,6 +327,15 @@ func (p *AuthorizeSecurityGroupEgressParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *AuthorizeSecurityGroupEgressParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetProtocol(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -172,6 +343,15 @@ func (p *AuthorizeSecurityGroupEgressParams) SetProtocol(v string) {
 	p.p["protocol"] = v
 }
 
+func (p *AuthorizeSecurityGroupEgressParams) ResetProtocol() {
+	delete(p.p, "protocol")
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) GetProtocol() (string, bool) {
+	value, ok := p.p["protocol"].(string)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -179,6 +359,15 @@ func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupid(v string) {
 	p.p["securitygroupid"] = v
 }
 
+func (p *AuthorizeSecurityGroupEgressParams) ResetSecuritygroupid() {
+	delete(p.p, "securitygroupid")
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) GetSecuritygroupid() (string, bool) {
+	value, ok := p.p["securitygroupid"].(string)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -186,6 +375,15 @@ func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupname(v string) {
 	p.p["securitygroupname"] = v
 }
 
+func (p *AuthorizeSecurityGroupEgressParams) ResetSecuritygroupname() {
+	delete(p.p, "securitygroupname")
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) GetSecuritygroupname() (string, bool) {
+	value, ok := p.p["securitygroupname"].(string)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetStartport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,13 +391,31 @@ func (p *AuthorizeSecurityGroupEgressParams) SetStartport(v int) {
 	p.p["startport"] = v
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) SetUsersecuritygrouplist(v map[string]string) {
+func (p *AuthorizeSecurityGroupEgressParams) ResetStartport() {
+	delete(p.p, "startport")
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) GetStartport() (int, bool) {
+	value, ok := p.p["startport"].(int)
+	return value, ok
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) SetUsersecuritygrouplist(v []UserSecurityGroup) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["usersecuritygrouplist"] = v
 }
 
+func (p *AuthorizeSecurityGroupEgressParams) ResetUsersecuritygrouplist() {
+	delete(p.p, "usersecuritygrouplist")
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) GetUsersecuritygrouplist() ([]UserSecurityGroup, bool) {
+	value, ok := p.p["usersecuritygrouplist"].([]UserSecurityGroup)
+	return value, ok
+}
+
 // You should always use this function to get a new AuthorizeSecurityGroupEgressParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewAuthorizeSecurityGroupEgressParams() *AuthorizeSecurityGroupEgressParams {
@@ -210,7 +426,16 @@ func (s *SecurityGroupService) NewAuthorizeSecurityGroupEgressParams() *Authoriz
 
 // Authorizes a particular egress rule for this security group
 func (s *SecurityGroupService) AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error) {
-	resp, err := s.cs.newRequest("authorizeSecurityGroupEgress", p.toURLValues())
+	return s.AuthorizeSecurityGroupEgressWithContext(context.Background(), p)
+}
+
+// AuthorizeSecurityGroupEgressWithContext is the same as AuthorizeSecurityGroupEgress, but uses ctx to cancel the request and any async job polling
+func (s *SecurityGroupService) AuthorizeSecurityGroupEgressWithContext(ctx context.Context, p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "authorizeSecurityGroupEgress", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -222,7 +447,7 @@ func (s *SecurityGroupService) AuthorizeSecurityGroupEgress(p *AuthorizeSecurity
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -248,6 +473,45 @@ func (s *SecurityGroupService) AuthorizeSecurityGroupEgress(p *AuthorizeSecurity
 	return &r, nil
 }
 
+// AuthorizeSecurityGroupEgressAsync starts the async job for AuthorizeSecurityGroupEgress and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AuthorizeSecurityGroupEgressResponse using Job.Result
+func (s *SecurityGroupService) AuthorizeSecurityGroupEgressAsync(p *AuthorizeSecurityGroupEgressParams) (*Job, error) {
+	return s.AuthorizeSecurityGroupEgressAsyncWithContext(context.Background(), p)
+}
+
+// AuthorizeSecurityGroupEgressAsyncWithContext is the same as AuthorizeSecurityGroupEgressAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SecurityGroupService) AuthorizeSecurityGroupEgressAsyncWithContext(ctx context.Context, p *AuthorizeSecurityGroupEgressParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "authorizeSecurityGroupEgress", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AuthorizeSecurityGroupEgressResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertAuthorizeSecurityGroupEgressResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AuthorizeSecurityGroupEgressResponse struct {
 	Account           string `json:"account"`
 	Cidr              string `json:"cidr"`
@@ -267,7 +531,8 @@ type AuthorizeSecurityGroupIngressParams struct {
 	p map[string]interface{}
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AuthorizeSecurityGroupIngressParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -311,15 +576,74 @@ func (p *AuthorizeSecurityGroupIngressParams) toURLValues() url.Values {
 		u.Set("startport", vv)
 	}
 	if v, found := p.p["usersecuritygrouplist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].account", i), k)
-			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].group", i), m[k])
+		for i, item := range v.([]UserSecurityGroup) {
+			if item.Account != "" {
+				u.Set(fmt.Sprintf("usersecuritygrouplist[%d].account", i), item.Account)
+			}
+			if item.Group != "" {
+				u.Set(fmt.Sprintf("usersecuritygrouplist[%d].group", i), item.Group)
+			}
 		}
 	}
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AuthorizeSecurityGroupIngressParams) Validate() error {
+	v := newParamValidator("authorizeSecurityGroupIngress", p.p)
+	v.ids("domainid", "projectid", "securitygroupid")
+	v.maxLength("account", 255)
+	v.maxLength("protocol", 255)
+	v.maxLength("securitygroupname", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AuthorizeSecurityGroupIngressParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AuthorizeSecurityGroupIngressParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "projectid", "protocol", "securitygroupid", "securitygroupname":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "cidrlist":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "endport", "icmpcode", "icmptype", "startport":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "usersecuritygrouplist":
+			var v []UserSecurityGroup
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for authorizeSecurityGroupIngress", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of authorizeSecurityGroupIngress: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -327,6 +651,15 @@ func (p *AuthorizeSecurityGroupIngressParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *AuthorizeSecurityGroupIngressParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -334,6 +667,15 @@ func (p *AuthorizeSecurityGroupIngressParams) SetCidrlist(v []string) {
 	p.p["cidrlist"] = v
 }
 
+func (p *AuthorizeSecurityGroupIngressParams) ResetCidrlist() {
+	delete(p.p, "cidrlist")
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) GetCidrlist() ([]string, bool) {
+	value, ok := p.p["cidrlist"].([]string)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -341,6 +683,15 @@ func (p *AuthorizeSecurityGroupIngressParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *AuthorizeSecurityGroupIngressParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetEndport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -348,6 +699,15 @@ func (p *AuthorizeSecurityGroupIngressParams) SetEndport(v int) {
 	p.p["endport"] = v
 }
 
+func (p *AuthorizeSecurityGroupIngressParams) ResetEndport() {
+	delete(p.p, "endport")
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) GetEndport() (int, bool) {
+	value, ok := p.p["endport"].(int)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetIcmpcode(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -355,6 +715,15 @@ func (p *AuthorizeSecurityGroupIngressParams) SetIcmpcode(v int) {
 	p.p["icmpcode"] = v
 }
 
+func (p *AuthorizeSecurityGroupIngressParams) ResetIcmpcode() {
+	delete(p.p, "icmpcode")
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) GetIcmpcode() (int, bool) {
+	value, ok := p.p["icmpcode"].(int)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetIcmptype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -362,6 +731,15 @@ func (p *AuthorizeSecurityGroupIngressParams) SetIcmptype(v int) {
 	p.p["icmptype"] = v
 }
 
+func (p *AuthorizeSecurityGroupIngressParams) ResetIcmptype() {
+	delete(p.p, "icmptype")
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) GetIcmptype() (int, bool) {
+	value, ok := p.p["icmptype"].(int)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -369,6 +747,15 @@ func (p *AuthorizeSecurityGroupIngressParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *AuthorizeSecurityGroupIngressParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetProtocol(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -376,6 +763,15 @@ func (p *AuthorizeSecurityGroupIngressParams) SetProtocol(v string) {
 	p.p["protocol"] = v
 }
 
+func (p *AuthorizeSecurityGroupIngressParams) ResetProtocol() {
+	delete(p.p, "protocol")
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) GetProtocol() (string, bool) {
+	value, ok := p.p["protocol"].(string)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -383,6 +779,15 @@ func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupid(v string) {
 	p.p["securitygroupid"] = v
 }
 
+func (p *AuthorizeSecurityGroupIngressParams) ResetSecuritygroupid() {
+	delete(p.p, "securitygroupid")
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) GetSecuritygroupid() (string, bool) {
+	value, ok := p.p["securitygroupid"].(string)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -390,6 +795,15 @@ func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupname(v string) {
 	p.p["securitygroupname"] = v
 }
 
+func (p *AuthorizeSecurityGroupIngressParams) ResetSecuritygroupname() {
+	delete(p.p, "securitygroupname")
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) GetSecuritygroupname() (string, bool) {
+	value, ok := p.p["securitygroupname"].(string)
+	return value, ok
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetStartport(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -397,13 +811,31 @@ func (p *AuthorizeSecurityGroupIngressParams) SetStartport(v int) {
 	p.p["startport"] = v
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetUsersecuritygrouplist(v map[string]string) {
+func (p *AuthorizeSecurityGroupIngressParams) ResetStartport() {
+	delete(p.p, "startport")
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) GetStartport() (int, bool) {
+	value, ok := p.p["startport"].(int)
+	return value, ok
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetUsersecuritygrouplist(v []UserSecurityGroup) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["usersecuritygrouplist"] = v
 }
 
+func (p *AuthorizeSecurityGroupIngressParams) ResetUsersecuritygrouplist() {
+	delete(p.p, "usersecuritygrouplist")
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) GetUsersecuritygrouplist() ([]UserSecurityGroup, bool) {
+	value, ok := p.p["usersecuritygrouplist"].([]UserSecurityGroup)
+	return value, ok
+}
+
 // You should always use this function to get a new AuthorizeSecurityGroupIngressParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewAuthorizeSecurityGroupIngressParams() *AuthorizeSecurityGroupIngressParams {
@@ -414,7 +846,16 @@ func (s *SecurityGroupService) NewAuthorizeSecurityGroupIngressParams() *Authori
 
 // Authorizes a particular ingress rule for this security group
 func (s *SecurityGroupService) AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error) {
-	resp, err := s.cs.newRequest("authorizeSecurityGroupIngress", p.toURLValues())
+	return s.AuthorizeSecurityGroupIngressWithContext(context.Background(), p)
+}
+
+// AuthorizeSecurityGroupIngressWithContext is the same as AuthorizeSecurityGroupIngress, but uses ctx to cancel the request and any async job polling
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngressWithContext(ctx context.Context, p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "authorizeSecurityGroupIngress", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -426,7 +867,7 @@ func (s *SecurityGroupService) AuthorizeSecurityGroupIngress(p *AuthorizeSecurit
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -452,6 +893,45 @@ func (s *SecurityGroupService) AuthorizeSecurityGroupIngress(p *AuthorizeSecurit
 	return &r, nil
 }
 
+// AuthorizeSecurityGroupIngressAsync starts the async job for AuthorizeSecurityGroupIngress and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AuthorizeSecurityGroupIngressResponse using Job.Result
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngressAsync(p *AuthorizeSecurityGroupIngressParams) (*Job, error) {
+	return s.AuthorizeSecurityGroupIngressAsyncWithContext(context.Background(), p)
+}
+
+// AuthorizeSecurityGroupIngressAsyncWithContext is the same as AuthorizeSecurityGroupIngressAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngressAsyncWithContext(ctx context.Context, p *AuthorizeSecurityGroupIngressParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "authorizeSecurityGroupIngress", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AuthorizeSecurityGroupIngressResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err = convertAuthorizeSecurityGroupIngressResponse(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AuthorizeSecurityGroupIngressResponse struct {
 	Account           string `json:"account"`
 	Cidr              string `json:"cidr"`
@@ -471,7 +951,8 @@ type CreateSecurityGroupParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateSecurityGroupParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateSecurityGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -494,6 +975,51 @@ func (p *CreateSecurityGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateSecurityGroupParams) Validate() error {
+	v := newParamValidator("createSecurityGroup", p.p)
+	v.required("name")
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("description", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateSecurityGroupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateSecurityGroupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "description", "domainid", "name", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createSecurityGroup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createSecurityGroup: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateSecurityGroupParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -501,6 +1027,15 @@ func (p *CreateSecurityGroupParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateSecurityGroupParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateSecurityGroupParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateSecurityGroupParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -508,6 +1043,15 @@ func (p *CreateSecurityGroupParams) SetDescription(v string) {
 	p.p["description"] = v
 }
 
+func (p *CreateSecurityGroupParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *CreateSecurityGroupParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
 func (p *CreateSecurityGroupParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -515,6 +1059,15 @@ func (p *CreateSecurityGroupParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateSecurityGroupParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateSecurityGroupParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateSecurityGroupParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -522,6 +1075,15 @@ func (p *CreateSecurityGroupParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateSecurityGroupParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateSecurityGroupParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateSecurityGroupParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -529,6 +1091,15 @@ func (p *CreateSecurityGroupParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *CreateSecurityGroupParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *CreateSecurityGroupParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateSecurityGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewCreateSecurityGroupParams(name string) *CreateSecurityGroupParams {
@@ -540,7 +1111,16 @@ func (s *SecurityGroupService) NewCreateSecurityGroupParams(name string) *Create
 
 // Creates a security group
 func (s *SecurityGroupService) CreateSecurityGroup(p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error) {
-	resp, err := s.cs.newRequest("createSecurityGroup", p.toURLValues())
+	return s.CreateSecurityGroupWithContext(context.Background(), p)
+}
+
+// CreateSecurityGroupWithContext is the same as CreateSecurityGroup, but uses ctx to cancel the request and any async job polling
+func (s *SecurityGroupService) CreateSecurityGroupWithContext(ctx context.Context, p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createSecurityGroup", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -592,7 +1172,8 @@ type DeleteSecurityGroupParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteSecurityGroupParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteSecurityGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -615,26 +1196,96 @@ func (p *DeleteSecurityGroupParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *DeleteSecurityGroupParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteSecurityGroupParams) Validate() error {
+	v := newParamValidator("deleteSecurityGroup", p.p)
+	v.ids("domainid", "id", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("name", 255)
+	return v.err()
 }
 
-func (p *DeleteSecurityGroupParams) SetDomainid(v string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteSecurityGroupParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["domainid"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *DeleteSecurityGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteSecurityGroupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["id"] = v
-}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "name", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteSecurityGroup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteSecurityGroup: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DeleteSecurityGroupParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *DeleteSecurityGroupParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *DeleteSecurityGroupParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *DeleteSecurityGroupParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *DeleteSecurityGroupParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *DeleteSecurityGroupParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *DeleteSecurityGroupParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *DeleteSecurityGroupParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteSecurityGroupParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
 
 func (p *DeleteSecurityGroupParams) SetName(v string) {
 	if p.p == nil {
@@ -643,6 +1294,15 @@ func (p *DeleteSecurityGroupParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *DeleteSecurityGroupParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *DeleteSecurityGroupParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *DeleteSecurityGroupParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -650,6 +1310,15 @@ func (p *DeleteSecurityGroupParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *DeleteSecurityGroupParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *DeleteSecurityGroupParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteSecurityGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewDeleteSecurityGroupParams() *DeleteSecurityGroupParams {
@@ -660,7 +1329,16 @@ func (s *SecurityGroupService) NewDeleteSecurityGroupParams() *DeleteSecurityGro
 
 // Deletes security group
 func (s *SecurityGroupService) DeleteSecurityGroup(p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteSecurityGroup", p.toURLValues())
+	return s.DeleteSecurityGroupWithContext(context.Background(), p)
+}
+
+// DeleteSecurityGroupWithContext is the same as DeleteSecurityGroup, but uses ctx to cancel the request and any async job polling
+func (s *SecurityGroupService) DeleteSecurityGroupWithContext(ctx context.Context, p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSecurityGroup", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -711,7 +1389,8 @@ type ListSecurityGroupsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListSecurityGroupsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListSecurityGroupsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -763,6 +1442,62 @@ func (p *ListSecurityGroupsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListSecurityGroupsParams) Validate() error {
+	v := newParamValidator("listSecurityGroups", p.p)
+	v.ids("domainid", "id", "projectid", "virtualmachineid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("securitygroupname", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListSecurityGroupsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListSecurityGroupsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "projectid", "securitygroupname", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listSecurityGroups", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listSecurityGroups: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListSecurityGroupsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -770,6 +1505,15 @@ func (p *ListSecurityGroupsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListSecurityGroupsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListSecurityGroupsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListSecurityGroupsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -777,6 +1521,15 @@ func (p *ListSecurityGroupsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListSecurityGroupsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListSecurityGroupsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListSecurityGroupsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -784,6 +1537,15 @@ func (p *ListSecurityGroupsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListSecurityGroupsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListSecurityGroupsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListSecurityGroupsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -791,6 +1553,15 @@ func (p *ListSecurityGroupsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListSecurityGroupsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListSecurityGroupsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListSecurityGroupsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -798,6 +1569,15 @@ func (p *ListSecurityGroupsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListSecurityGroupsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListSecurityGroupsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListSecurityGroupsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -805,6 +1585,15 @@ func (p *ListSecurityGroupsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListSecurityGroupsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListSecurityGroupsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListSecurityGroupsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -812,6 +1601,15 @@ func (p *ListSecurityGroupsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListSecurityGroupsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListSecurityGroupsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListSecurityGroupsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -819,6 +1617,15 @@ func (p *ListSecurityGroupsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListSecurityGroupsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListSecurityGroupsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListSecurityGroupsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -826,6 +1633,15 @@ func (p *ListSecurityGroupsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListSecurityGroupsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListSecurityGroupsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListSecurityGroupsParams) SetSecuritygroupname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -833,6 +1649,15 @@ func (p *ListSecurityGroupsParams) SetSecuritygroupname(v string) {
 	p.p["securitygroupname"] = v
 }
 
+func (p *ListSecurityGroupsParams) ResetSecuritygroupname() {
+	delete(p.p, "securitygroupname")
+}
+
+func (p *ListSecurityGroupsParams) GetSecuritygroupname() (string, bool) {
+	value, ok := p.p["securitygroupname"].(string)
+	return value, ok
+}
+
 func (p *ListSecurityGroupsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -840,6 +1665,15 @@ func (p *ListSecurityGroupsParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListSecurityGroupsParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListSecurityGroupsParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 func (p *ListSecurityGroupsParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -847,6 +1681,15 @@ func (p *ListSecurityGroupsParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *ListSecurityGroupsParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *ListSecurityGroupsParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListSecurityGroupsParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewListSecurityGroupsParams() *ListSecurityGroupsParams {
@@ -857,6 +1700,11 @@ func (s *SecurityGroupService) NewListSecurityGroupsParams() *ListSecurityGroups
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SecurityGroupService) GetSecurityGroupID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSecurityGroupIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetSecurityGroupIDWithContext is the same as GetSecurityGroupID, but uses ctx to cancel the request
+func (s *SecurityGroupService) GetSecurityGroupIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSecurityGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -868,7 +1716,7 @@ func (s *SecurityGroupService) GetSecurityGroupID(keyword string, opts ...Option
 		}
 	}
 
-	l, err := s.ListSecurityGroups(p)
+	l, err := s.ListSecurityGroupsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -893,12 +1741,17 @@ func (s *SecurityGroupService) GetSecurityGroupID(keyword string, opts ...Option
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SecurityGroupService) GetSecurityGroupByName(name string, opts ...OptionFunc) (*SecurityGroup, int, error) {
-	id, count, err := s.GetSecurityGroupID(name, opts...)
+	return s.GetSecurityGroupByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetSecurityGroupByNameWithContext is the same as GetSecurityGroupByName, but uses ctx to cancel the requests
+func (s *SecurityGroupService) GetSecurityGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SecurityGroup, int, error) {
+	id, count, err := s.GetSecurityGroupIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetSecurityGroupByID(id, opts...)
+	r, count, err := s.GetSecurityGroupByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -907,6 +1760,11 @@ func (s *SecurityGroupService) GetSecurityGroupByName(name string, opts ...Optio
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SecurityGroupService) GetSecurityGroupByID(id string, opts ...OptionFunc) (*SecurityGroup, int, error) {
+	return s.GetSecurityGroupByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetSecurityGroupByIDWithContext is the same as GetSecurityGroupByID, but uses ctx to cancel the request
+func (s *SecurityGroupService) GetSecurityGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SecurityGroup, int, error) {
 	p := &ListSecurityGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -918,18 +1776,16 @@ func (s *SecurityGroupService) GetSecurityGroupByID(id string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListSecurityGroups(p)
+	l, err := s.ListSecurityGroupsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -940,7 +1796,16 @@ func (s *SecurityGroupService) GetSecurityGroupByID(id string, opts ...OptionFun
 
 // Lists security groups
 func (s *SecurityGroupService) ListSecurityGroups(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listSecurityGroups", p.toURLValues())
+	return s.ListSecurityGroupsWithContext(context.Background(), p)
+}
+
+// ListSecurityGroupsWithContext is the same as ListSecurityGroups, but uses ctx to cancel the request and any async job polling
+func (s *SecurityGroupService) ListSecurityGroupsWithContext(ctx context.Context, p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listSecurityGroups", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -953,6 +1818,92 @@ func (s *SecurityGroupService) ListSecurityGroups(p *ListSecurityGroupsParams) (
 	return &r, nil
 }
 
+// ListSecurityGroupsPager iterates over all pages of a ListSecurityGroups call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListSecurityGroupsPager struct {
+	pager
+
+	s *SecurityGroupService
+	p *ListSecurityGroupsParams
+	r *ListSecurityGroupsResponse
+}
+
+// NewListSecurityGroupsPager returns a pager for all pages of a ListSecurityGroups call with the given params
+func (s *SecurityGroupService) NewListSecurityGroupsPager(p *ListSecurityGroupsParams) *ListSecurityGroupsPager {
+	return &ListSecurityGroupsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListSecurityGroupsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListSecurityGroupsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListSecurityGroupsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListSecurityGroupsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.SecurityGroups), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListSecurityGroupsPager) Page() *ListSecurityGroupsResponse {
+	return pg.r
+}
+
+// ListSecurityGroupsAll fetches all pages of a ListSecurityGroups call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *SecurityGroupService) ListSecurityGroupsAll(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
+	return s.ListSecurityGroupsAllWithContext(context.Background(), p)
+}
+
+// ListSecurityGroupsAllWithContext is the same as ListSecurityGroupsAll, but uses ctx to cancel the requests
+func (s *SecurityGroupService) ListSecurityGroupsAllWithContext(ctx context.Context, p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*SecurityGroup)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListSecurityGroupsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListSecurityGroupsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.SecurityGroups
+		mu.Unlock()
+
+		return len(l.SecurityGroups), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListSecurityGroupsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.SecurityGroups = append(r.SecurityGroups, pages[page]...)
+	}
+	r.Count = len(r.SecurityGroups)
+
+	return r, nil
+}
+
 type ListSecurityGroupsResponse struct {
 	Count          int              `json:"count"`
 	SecurityGroups []*SecurityGroup `json:"securitygroup"`
@@ -993,7 +1944,8 @@ type RevokeSecurityGroupEgressParams struct {
 	p map[string]interface{}
 }
 
-func (p *RevokeSecurityGroupEgressParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RevokeSecurityGroupEgressParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1004,6 +1956,48 @@ func (p *RevokeSecurityGroupEgressParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RevokeSecurityGroupEgressParams) Validate() error {
+	v := newParamValidator("revokeSecurityGroupEgress", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RevokeSecurityGroupEgressParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RevokeSecurityGroupEgressParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for revokeSecurityGroupEgress", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of revokeSecurityGroupEgress: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RevokeSecurityGroupEgressParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1011,6 +2005,15 @@ func (p *RevokeSecurityGroupEgressParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RevokeSecurityGroupEgressParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RevokeSecurityGroupEgressParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RevokeSecurityGroupEgressParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewRevokeSecurityGroupEgressParams(id string) *RevokeSecurityGroupEgressParams {
@@ -1022,7 +2025,16 @@ func (s *SecurityGroupService) NewRevokeSecurityGroupEgressParams(id string) *Re
 
 // Deletes a particular egress rule from this security group
 func (s *SecurityGroupService) RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error) {
-	resp, err := s.cs.newRequest("revokeSecurityGroupEgress", p.toURLValues())
+	return s.RevokeSecurityGroupEgressWithContext(context.Background(), p)
+}
+
+// RevokeSecurityGroupEgressWithContext is the same as RevokeSecurityGroupEgress, but uses ctx to cancel the request and any async job polling
+func (s *SecurityGroupService) RevokeSecurityGroupEgressWithContext(ctx context.Context, p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "revokeSecurityGroupEgress", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1034,7 +2046,7 @@ func (s *SecurityGroupService) RevokeSecurityGroupEgress(p *RevokeSecurityGroupE
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1050,6 +2062,31 @@ func (s *SecurityGroupService) RevokeSecurityGroupEgress(p *RevokeSecurityGroupE
 	return &r, nil
 }
 
+// RevokeSecurityGroupEgressAsync starts the async job for RevokeSecurityGroupEgress and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RevokeSecurityGroupEgressResponse using Job.Result
+func (s *SecurityGroupService) RevokeSecurityGroupEgressAsync(p *RevokeSecurityGroupEgressParams) (*Job, error) {
+	return s.RevokeSecurityGroupEgressAsyncWithContext(context.Background(), p)
+}
+
+// RevokeSecurityGroupEgressAsyncWithContext is the same as RevokeSecurityGroupEgressAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SecurityGroupService) RevokeSecurityGroupEgressAsyncWithContext(ctx context.Context, p *RevokeSecurityGroupEgressParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "revokeSecurityGroupEgress", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RevokeSecurityGroupEgressResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type RevokeSecurityGroupEgressResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -1061,7 +2098,8 @@ type RevokeSecurityGroupIngressParams struct {
 	p map[string]interface{}
 }
 
-func (p *RevokeSecurityGroupIngressParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RevokeSecurityGroupIngressParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1072,6 +2110,48 @@ func (p *RevokeSecurityGroupIngressParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RevokeSecurityGroupIngressParams) Validate() error {
+	v := newParamValidator("revokeSecurityGroupIngress", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RevokeSecurityGroupIngressParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RevokeSecurityGroupIngressParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for revokeSecurityGroupIngress", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of revokeSecurityGroupIngress: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RevokeSecurityGroupIngressParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1079,6 +2159,15 @@ func (p *RevokeSecurityGroupIngressParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RevokeSecurityGroupIngressParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RevokeSecurityGroupIngressParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RevokeSecurityGroupIngressParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewRevokeSecurityGroupIngressParams(id string) *RevokeSecurityGroupIngressParams {
@@ -1090,7 +2179,16 @@ func (s *SecurityGroupService) NewRevokeSecurityGroupIngressParams(id string) *R
 
 // Deletes a particular ingress rule from this security group
 func (s *SecurityGroupService) RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error) {
-	resp, err := s.cs.newRequest("revokeSecurityGroupIngress", p.toURLValues())
+	return s.RevokeSecurityGroupIngressWithContext(context.Background(), p)
+}
+
+// RevokeSecurityGroupIngressWithContext is the same as RevokeSecurityGroupIngress, but uses ctx to cancel the request and any async job polling
+func (s *SecurityGroupService) RevokeSecurityGroupIngressWithContext(ctx context.Context, p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "revokeSecurityGroupIngress", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1102,7 +2200,7 @@ func (s *SecurityGroupService) RevokeSecurityGroupIngress(p *RevokeSecurityGroup
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1118,9 +2216,212 @@ func (s *SecurityGroupService) RevokeSecurityGroupIngress(p *RevokeSecurityGroup
 	return &r, nil
 }
 
+// RevokeSecurityGroupIngressAsync starts the async job for RevokeSecurityGroupIngress and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RevokeSecurityGroupIngressResponse using Job.Result
+func (s *SecurityGroupService) RevokeSecurityGroupIngressAsync(p *RevokeSecurityGroupIngressParams) (*Job, error) {
+	return s.RevokeSecurityGroupIngressAsyncWithContext(context.Background(), p)
+}
+
+// RevokeSecurityGroupIngressAsyncWithContext is the same as RevokeSecurityGroupIngressAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SecurityGroupService) RevokeSecurityGroupIngressAsyncWithContext(ctx context.Context, p *RevokeSecurityGroupIngressParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "revokeSecurityGroupIngress", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RevokeSecurityGroupIngressResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type RevokeSecurityGroupIngressResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
 	Jobstatus   int    `json:"jobstatus"`
 	Success     bool   `json:"success"`
 }
+
+type UpdateSecurityGroupParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateSecurityGroupParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["customid"]; found {
+		u.Set("customid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateSecurityGroupParams) Validate() error {
+	v := newParamValidator("updateSecurityGroup", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("customid", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateSecurityGroupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateSecurityGroupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "id", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateSecurityGroup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateSecurityGroup: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *UpdateSecurityGroupParams) SetCustomid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["customid"] = v
+}
+
+func (p *UpdateSecurityGroupParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateSecurityGroupParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
+func (p *UpdateSecurityGroupParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *UpdateSecurityGroupParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateSecurityGroupParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *UpdateSecurityGroupParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *UpdateSecurityGroupParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateSecurityGroupParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new UpdateSecurityGroupParams instance,
+// as then you are sure you have configured all required params
+func (s *SecurityGroupService) NewUpdateSecurityGroupParams(id string) *UpdateSecurityGroupParams {
+	p := &UpdateSecurityGroupParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Updates a security group
+func (s *SecurityGroupService) UpdateSecurityGroup(p *UpdateSecurityGroupParams) (*UpdateSecurityGroupResponse, error) {
+	return s.UpdateSecurityGroupWithContext(context.Background(), p)
+}
+
+// UpdateSecurityGroupWithContext is the same as UpdateSecurityGroup, but uses ctx to cancel the request and any async job polling
+func (s *SecurityGroupService) UpdateSecurityGroupWithContext(ctx context.Context, p *UpdateSecurityGroupParams) (*UpdateSecurityGroupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateSecurityGroup", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateSecurityGroupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type UpdateSecurityGroupResponse struct {
+	Account             string                            `json:"account"`
+	Description         string                            `json:"description"`
+	Domain              string                            `json:"domain"`
+	Domainid            string                            `json:"domainid"`
+	Egressrule          []UpdateSecurityGroupResponseRule `json:"egressrule"`
+	Id                  string                            `json:"id"`
+	Ingressrule         []UpdateSecurityGroupResponseRule `json:"ingressrule"`
+	JobID               string                            `json:"jobid"`
+	Jobstatus           int                               `json:"jobstatus"`
+	Name                string                            `json:"name"`
+	Project             string                            `json:"project"`
+	Projectid           string                            `json:"projectid"`
+	Tags                []Tags                            `json:"tags"`
+	Virtualmachinecount int                               `json:"virtualmachinecount"`
+	Virtualmachineids   []interface{}                     `json:"virtualmachineids"`
+}
+
+type UpdateSecurityGroupResponseRule struct {
+	Account           string `json:"account"`
+	Cidr              string `json:"cidr"`
+	Endport           int    `json:"endport"`
+	Icmpcode          int    `json:"icmpcode"`
+	Icmptype          int    `json:"icmptype"`
+	Protocol          string `json:"protocol"`
+	Ruleid            string `json:"ruleid"`
+	Securitygroupname string `json:"securitygroupname"`
+	Startport         int    `json:"startport"`
+	Tags              []Tags `json:"tags"`
+}
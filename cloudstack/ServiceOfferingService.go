@@ -20,18 +20,47 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// ServiceOfferingServiceIface is the interface implemented by ServiceOfferingService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type ServiceOfferingServiceIface interface {
+	NewCreateServiceOfferingParams(displaytext string, name string) *CreateServiceOfferingParams
+	CreateServiceOffering(p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error)
+	CreateServiceOfferingWithContext(ctx context.Context, p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error)
+	NewDeleteServiceOfferingParams(id string) *DeleteServiceOfferingParams
+	DeleteServiceOffering(p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error)
+	DeleteServiceOfferingWithContext(ctx context.Context, p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error)
+	NewListServiceOfferingsParams() *ListServiceOfferingsParams
+	GetServiceOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetServiceOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetServiceOfferingByName(name string, opts ...OptionFunc) (*ServiceOffering, int, error)
+	GetServiceOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ServiceOffering, int, error)
+	GetServiceOfferingByID(id string, opts ...OptionFunc) (*ServiceOffering, int, error)
+	GetServiceOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ServiceOffering, int, error)
+	ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error)
+	ListServiceOfferingsWithContext(ctx context.Context, p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error)
+	NewListServiceOfferingsPager(p *ListServiceOfferingsParams) *ListServiceOfferingsPager
+	ListServiceOfferingsAll(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error)
+	ListServiceOfferingsAllWithContext(ctx context.Context, p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error)
+	NewUpdateServiceOfferingParams(id string) *UpdateServiceOfferingParams
+	UpdateServiceOffering(p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error)
+	UpdateServiceOfferingWithContext(ctx context.Context, p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error)
+}
+
 type CreateServiceOfferingParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateServiceOfferingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateServiceOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -204,6 +233,79 @@ func (p *CreateServiceOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateServiceOfferingParams) Validate() error {
+	v := newParamValidator("createServiceOffering", p.p)
+	v.required("displaytext", "name")
+	v.ids("storagepolicy")
+	v.maxLength("cachemode", 255)
+	v.maxLength("deploymentplanner", 255)
+	v.maxLength("displaytext", 255)
+	v.maxLength("hosttags", 255)
+	v.maxLength("name", 255)
+	v.maxLength("provisioningtype", 255)
+	v.oneOf("provisioningtype", "thin", "sparse", "fat")
+	v.maxLength("storagetype", 255)
+	v.oneOf("storagetype", "local", "shared")
+	v.maxLength("systemvmtype", 255)
+	v.maxLength("tags", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateServiceOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateServiceOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "bytesreadrate", "bytesreadratemax", "bytesreadratemaxlength", "byteswriterate", "byteswriteratemax", "byteswriteratemaxlength", "iopsreadrate", "iopsreadratemax", "iopsreadratemaxlength", "iopswriterate", "iopswriteratemax", "iopswriteratemaxlength", "maxiops", "miniops", "rootdisksize":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "cachemode", "deploymentplanner", "displaytext", "hosttags", "name", "provisioningtype", "storagepolicy", "storagetype", "systemvmtype", "tags":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "cpunumber", "cpuspeed", "hypervisorsnapshotreserve", "maxcpunumber", "maxmemory", "memory", "mincpunumber", "minmemory", "networkrate":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "customized", "customizediops", "issystem", "isvolatile", "limitcpuuse", "offerha":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "domainid", "zoneid":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "serviceofferingdetails":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createServiceOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createServiceOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateServiceOfferingParams) SetBytesreadrate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -211,6 +313,15 @@ func (p *CreateServiceOfferingParams) SetBytesreadrate(v int64) {
 	p.p["bytesreadrate"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetBytesreadrate() {
+	delete(p.p, "bytesreadrate")
+}
+
+func (p *CreateServiceOfferingParams) GetBytesreadrate() (int64, bool) {
+	value, ok := p.p["bytesreadrate"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetBytesreadratemax(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -218,6 +329,15 @@ func (p *CreateServiceOfferingParams) SetBytesreadratemax(v int64) {
 	p.p["bytesreadratemax"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetBytesreadratemax() {
+	delete(p.p, "bytesreadratemax")
+}
+
+func (p *CreateServiceOfferingParams) GetBytesreadratemax() (int64, bool) {
+	value, ok := p.p["bytesreadratemax"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetBytesreadratemaxlength(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -225,6 +345,15 @@ func (p *CreateServiceOfferingParams) SetBytesreadratemaxlength(v int64) {
 	p.p["bytesreadratemaxlength"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetBytesreadratemaxlength() {
+	delete(p.p, "bytesreadratemaxlength")
+}
+
+func (p *CreateServiceOfferingParams) GetBytesreadratemaxlength() (int64, bool) {
+	value, ok := p.p["bytesreadratemaxlength"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetByteswriterate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -232,6 +361,15 @@ func (p *CreateServiceOfferingParams) SetByteswriterate(v int64) {
 	p.p["byteswriterate"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetByteswriterate() {
+	delete(p.p, "byteswriterate")
+}
+
+func (p *CreateServiceOfferingParams) GetByteswriterate() (int64, bool) {
+	value, ok := p.p["byteswriterate"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetByteswriteratemax(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -239,6 +377,15 @@ func (p *CreateServiceOfferingParams) SetByteswriteratemax(v int64) {
 	p.p["byteswriteratemax"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetByteswriteratemax() {
+	delete(p.p, "byteswriteratemax")
+}
+
+func (p *CreateServiceOfferingParams) GetByteswriteratemax() (int64, bool) {
+	value, ok := p.p["byteswriteratemax"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetByteswriteratemaxlength(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -246,6 +393,15 @@ func (p *CreateServiceOfferingParams) SetByteswriteratemaxlength(v int64) {
 	p.p["byteswriteratemaxlength"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetByteswriteratemaxlength() {
+	delete(p.p, "byteswriteratemaxlength")
+}
+
+func (p *CreateServiceOfferingParams) GetByteswriteratemaxlength() (int64, bool) {
+	value, ok := p.p["byteswriteratemaxlength"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetCachemode(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -253,6 +409,15 @@ func (p *CreateServiceOfferingParams) SetCachemode(v string) {
 	p.p["cachemode"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetCachemode() {
+	delete(p.p, "cachemode")
+}
+
+func (p *CreateServiceOfferingParams) GetCachemode() (string, bool) {
+	value, ok := p.p["cachemode"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetCpunumber(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -260,6 +425,15 @@ func (p *CreateServiceOfferingParams) SetCpunumber(v int) {
 	p.p["cpunumber"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetCpunumber() {
+	delete(p.p, "cpunumber")
+}
+
+func (p *CreateServiceOfferingParams) GetCpunumber() (int, bool) {
+	value, ok := p.p["cpunumber"].(int)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetCpuspeed(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -267,6 +441,15 @@ func (p *CreateServiceOfferingParams) SetCpuspeed(v int) {
 	p.p["cpuspeed"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetCpuspeed() {
+	delete(p.p, "cpuspeed")
+}
+
+func (p *CreateServiceOfferingParams) GetCpuspeed() (int, bool) {
+	value, ok := p.p["cpuspeed"].(int)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetCustomized(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -274,6 +457,15 @@ func (p *CreateServiceOfferingParams) SetCustomized(v bool) {
 	p.p["customized"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetCustomized() {
+	delete(p.p, "customized")
+}
+
+func (p *CreateServiceOfferingParams) GetCustomized() (bool, bool) {
+	value, ok := p.p["customized"].(bool)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetCustomizediops(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -281,6 +473,15 @@ func (p *CreateServiceOfferingParams) SetCustomizediops(v bool) {
 	p.p["customizediops"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetCustomizediops() {
+	delete(p.p, "customizediops")
+}
+
+func (p *CreateServiceOfferingParams) GetCustomizediops() (bool, bool) {
+	value, ok := p.p["customizediops"].(bool)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetDeploymentplanner(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -288,6 +489,15 @@ func (p *CreateServiceOfferingParams) SetDeploymentplanner(v string) {
 	p.p["deploymentplanner"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetDeploymentplanner() {
+	delete(p.p, "deploymentplanner")
+}
+
+func (p *CreateServiceOfferingParams) GetDeploymentplanner() (string, bool) {
+	value, ok := p.p["deploymentplanner"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -295,6 +505,15 @@ func (p *CreateServiceOfferingParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *CreateServiceOfferingParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetDomainid(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -302,6 +521,15 @@ func (p *CreateServiceOfferingParams) SetDomainid(v []string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateServiceOfferingParams) GetDomainid() ([]string, bool) {
+	value, ok := p.p["domainid"].([]string)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetHosttags(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -309,6 +537,15 @@ func (p *CreateServiceOfferingParams) SetHosttags(v string) {
 	p.p["hosttags"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetHosttags() {
+	delete(p.p, "hosttags")
+}
+
+func (p *CreateServiceOfferingParams) GetHosttags() (string, bool) {
+	value, ok := p.p["hosttags"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetHypervisorsnapshotreserve(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -316,6 +553,15 @@ func (p *CreateServiceOfferingParams) SetHypervisorsnapshotreserve(v int) {
 	p.p["hypervisorsnapshotreserve"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetHypervisorsnapshotreserve() {
+	delete(p.p, "hypervisorsnapshotreserve")
+}
+
+func (p *CreateServiceOfferingParams) GetHypervisorsnapshotreserve() (int, bool) {
+	value, ok := p.p["hypervisorsnapshotreserve"].(int)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetIopsreadrate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -323,6 +569,15 @@ func (p *CreateServiceOfferingParams) SetIopsreadrate(v int64) {
 	p.p["iopsreadrate"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetIopsreadrate() {
+	delete(p.p, "iopsreadrate")
+}
+
+func (p *CreateServiceOfferingParams) GetIopsreadrate() (int64, bool) {
+	value, ok := p.p["iopsreadrate"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetIopsreadratemax(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -330,6 +585,15 @@ func (p *CreateServiceOfferingParams) SetIopsreadratemax(v int64) {
 	p.p["iopsreadratemax"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetIopsreadratemax() {
+	delete(p.p, "iopsreadratemax")
+}
+
+func (p *CreateServiceOfferingParams) GetIopsreadratemax() (int64, bool) {
+	value, ok := p.p["iopsreadratemax"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetIopsreadratemaxlength(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -337,6 +601,15 @@ func (p *CreateServiceOfferingParams) SetIopsreadratemaxlength(v int64) {
 	p.p["iopsreadratemaxlength"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetIopsreadratemaxlength() {
+	delete(p.p, "iopsreadratemaxlength")
+}
+
+func (p *CreateServiceOfferingParams) GetIopsreadratemaxlength() (int64, bool) {
+	value, ok := p.p["iopsreadratemaxlength"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetIopswriterate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -344,6 +617,15 @@ func (p *CreateServiceOfferingParams) SetIopswriterate(v int64) {
 	p.p["iopswriterate"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetIopswriterate() {
+	delete(p.p, "iopswriterate")
+}
+
+func (p *CreateServiceOfferingParams) GetIopswriterate() (int64, bool) {
+	value, ok := p.p["iopswriterate"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetIopswriteratemax(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -351,6 +633,15 @@ func (p *CreateServiceOfferingParams) SetIopswriteratemax(v int64) {
 	p.p["iopswriteratemax"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetIopswriteratemax() {
+	delete(p.p, "iopswriteratemax")
+}
+
+func (p *CreateServiceOfferingParams) GetIopswriteratemax() (int64, bool) {
+	value, ok := p.p["iopswriteratemax"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetIopswriteratemaxlength(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -358,6 +649,15 @@ func (p *CreateServiceOfferingParams) SetIopswriteratemaxlength(v int64) {
 	p.p["iopswriteratemaxlength"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetIopswriteratemaxlength() {
+	delete(p.p, "iopswriteratemaxlength")
+}
+
+func (p *CreateServiceOfferingParams) GetIopswriteratemaxlength() (int64, bool) {
+	value, ok := p.p["iopswriteratemaxlength"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetIssystem(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -365,6 +665,15 @@ func (p *CreateServiceOfferingParams) SetIssystem(v bool) {
 	p.p["issystem"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetIssystem() {
+	delete(p.p, "issystem")
+}
+
+func (p *CreateServiceOfferingParams) GetIssystem() (bool, bool) {
+	value, ok := p.p["issystem"].(bool)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetIsvolatile(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -372,6 +681,15 @@ func (p *CreateServiceOfferingParams) SetIsvolatile(v bool) {
 	p.p["isvolatile"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetIsvolatile() {
+	delete(p.p, "isvolatile")
+}
+
+func (p *CreateServiceOfferingParams) GetIsvolatile() (bool, bool) {
+	value, ok := p.p["isvolatile"].(bool)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetLimitcpuuse(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -379,6 +697,15 @@ func (p *CreateServiceOfferingParams) SetLimitcpuuse(v bool) {
 	p.p["limitcpuuse"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetLimitcpuuse() {
+	delete(p.p, "limitcpuuse")
+}
+
+func (p *CreateServiceOfferingParams) GetLimitcpuuse() (bool, bool) {
+	value, ok := p.p["limitcpuuse"].(bool)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetMaxcpunumber(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -386,6 +713,15 @@ func (p *CreateServiceOfferingParams) SetMaxcpunumber(v int) {
 	p.p["maxcpunumber"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetMaxcpunumber() {
+	delete(p.p, "maxcpunumber")
+}
+
+func (p *CreateServiceOfferingParams) GetMaxcpunumber() (int, bool) {
+	value, ok := p.p["maxcpunumber"].(int)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetMaxiops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -393,6 +729,15 @@ func (p *CreateServiceOfferingParams) SetMaxiops(v int64) {
 	p.p["maxiops"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetMaxiops() {
+	delete(p.p, "maxiops")
+}
+
+func (p *CreateServiceOfferingParams) GetMaxiops() (int64, bool) {
+	value, ok := p.p["maxiops"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetMaxmemory(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -400,6 +745,15 @@ func (p *CreateServiceOfferingParams) SetMaxmemory(v int) {
 	p.p["maxmemory"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetMaxmemory() {
+	delete(p.p, "maxmemory")
+}
+
+func (p *CreateServiceOfferingParams) GetMaxmemory() (int, bool) {
+	value, ok := p.p["maxmemory"].(int)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetMemory(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -407,6 +761,15 @@ func (p *CreateServiceOfferingParams) SetMemory(v int) {
 	p.p["memory"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetMemory() {
+	delete(p.p, "memory")
+}
+
+func (p *CreateServiceOfferingParams) GetMemory() (int, bool) {
+	value, ok := p.p["memory"].(int)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetMincpunumber(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -414,6 +777,15 @@ func (p *CreateServiceOfferingParams) SetMincpunumber(v int) {
 	p.p["mincpunumber"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetMincpunumber() {
+	delete(p.p, "mincpunumber")
+}
+
+func (p *CreateServiceOfferingParams) GetMincpunumber() (int, bool) {
+	value, ok := p.p["mincpunumber"].(int)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetMiniops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -421,6 +793,15 @@ func (p *CreateServiceOfferingParams) SetMiniops(v int64) {
 	p.p["miniops"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetMiniops() {
+	delete(p.p, "miniops")
+}
+
+func (p *CreateServiceOfferingParams) GetMiniops() (int64, bool) {
+	value, ok := p.p["miniops"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetMinmemory(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -428,6 +809,15 @@ func (p *CreateServiceOfferingParams) SetMinmemory(v int) {
 	p.p["minmemory"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetMinmemory() {
+	delete(p.p, "minmemory")
+}
+
+func (p *CreateServiceOfferingParams) GetMinmemory() (int, bool) {
+	value, ok := p.p["minmemory"].(int)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -435,6 +825,15 @@ func (p *CreateServiceOfferingParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateServiceOfferingParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetNetworkrate(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -442,6 +841,15 @@ func (p *CreateServiceOfferingParams) SetNetworkrate(v int) {
 	p.p["networkrate"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetNetworkrate() {
+	delete(p.p, "networkrate")
+}
+
+func (p *CreateServiceOfferingParams) GetNetworkrate() (int, bool) {
+	value, ok := p.p["networkrate"].(int)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetOfferha(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -449,6 +857,15 @@ func (p *CreateServiceOfferingParams) SetOfferha(v bool) {
 	p.p["offerha"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetOfferha() {
+	delete(p.p, "offerha")
+}
+
+func (p *CreateServiceOfferingParams) GetOfferha() (bool, bool) {
+	value, ok := p.p["offerha"].(bool)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetProvisioningtype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -456,6 +873,15 @@ func (p *CreateServiceOfferingParams) SetProvisioningtype(v string) {
 	p.p["provisioningtype"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetProvisioningtype() {
+	delete(p.p, "provisioningtype")
+}
+
+func (p *CreateServiceOfferingParams) GetProvisioningtype() (string, bool) {
+	value, ok := p.p["provisioningtype"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetRootdisksize(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -463,6 +889,15 @@ func (p *CreateServiceOfferingParams) SetRootdisksize(v int64) {
 	p.p["rootdisksize"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetRootdisksize() {
+	delete(p.p, "rootdisksize")
+}
+
+func (p *CreateServiceOfferingParams) GetRootdisksize() (int64, bool) {
+	value, ok := p.p["rootdisksize"].(int64)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetServiceofferingdetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -470,6 +905,15 @@ func (p *CreateServiceOfferingParams) SetServiceofferingdetails(v map[string]str
 	p.p["serviceofferingdetails"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetServiceofferingdetails() {
+	delete(p.p, "serviceofferingdetails")
+}
+
+func (p *CreateServiceOfferingParams) GetServiceofferingdetails() (map[string]string, bool) {
+	value, ok := p.p["serviceofferingdetails"].(map[string]string)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetStoragepolicy(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -477,6 +921,15 @@ func (p *CreateServiceOfferingParams) SetStoragepolicy(v string) {
 	p.p["storagepolicy"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetStoragepolicy() {
+	delete(p.p, "storagepolicy")
+}
+
+func (p *CreateServiceOfferingParams) GetStoragepolicy() (string, bool) {
+	value, ok := p.p["storagepolicy"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetStoragetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -484,6 +937,15 @@ func (p *CreateServiceOfferingParams) SetStoragetype(v string) {
 	p.p["storagetype"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetStoragetype() {
+	delete(p.p, "storagetype")
+}
+
+func (p *CreateServiceOfferingParams) GetStoragetype() (string, bool) {
+	value, ok := p.p["storagetype"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetSystemvmtype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -491,6 +953,15 @@ func (p *CreateServiceOfferingParams) SetSystemvmtype(v string) {
 	p.p["systemvmtype"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetSystemvmtype() {
+	delete(p.p, "systemvmtype")
+}
+
+func (p *CreateServiceOfferingParams) GetSystemvmtype() (string, bool) {
+	value, ok := p.p["systemvmtype"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetTags(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -498,6 +969,15 @@ func (p *CreateServiceOfferingParams) SetTags(v string) {
 	p.p["tags"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *CreateServiceOfferingParams) GetTags() (string, bool) {
+	value, ok := p.p["tags"].(string)
+	return value, ok
+}
+
 func (p *CreateServiceOfferingParams) SetZoneid(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -505,6 +985,15 @@ func (p *CreateServiceOfferingParams) SetZoneid(v []string) {
 	p.p["zoneid"] = v
 }
 
+func (p *CreateServiceOfferingParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreateServiceOfferingParams) GetZoneid() ([]string, bool) {
+	value, ok := p.p["zoneid"].([]string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateServiceOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *ServiceOfferingService) NewCreateServiceOfferingParams(displaytext string, name string) *CreateServiceOfferingParams {
@@ -517,7 +1006,16 @@ func (s *ServiceOfferingService) NewCreateServiceOfferingParams(displaytext stri
 
 // Creates a service offering.
 func (s *ServiceOfferingService) CreateServiceOffering(p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createServiceOffering", p.toURLValues())
+	return s.CreateServiceOfferingWithContext(context.Background(), p)
+}
+
+// CreateServiceOfferingWithContext is the same as CreateServiceOffering, but uses ctx to cancel the request and any async job polling
+func (s *ServiceOfferingService) CreateServiceOfferingWithContext(ctx context.Context, p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createServiceOffering", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -587,7 +1085,8 @@ type DeleteServiceOfferingParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteServiceOfferingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteServiceOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -598,6 +1097,48 @@ func (p *DeleteServiceOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteServiceOfferingParams) Validate() error {
+	v := newParamValidator("deleteServiceOffering", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteServiceOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteServiceOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteServiceOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteServiceOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteServiceOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -605,6 +1146,15 @@ func (p *DeleteServiceOfferingParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteServiceOfferingParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteServiceOfferingParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteServiceOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *ServiceOfferingService) NewDeleteServiceOfferingParams(id string) *DeleteServiceOfferingParams {
@@ -616,7 +1166,16 @@ func (s *ServiceOfferingService) NewDeleteServiceOfferingParams(id string) *Dele
 
 // Deletes a service offering.
 func (s *ServiceOfferingService) DeleteServiceOffering(p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteServiceOffering", p.toURLValues())
+	return s.DeleteServiceOfferingWithContext(context.Background(), p)
+}
+
+// DeleteServiceOfferingWithContext is the same as DeleteServiceOffering, but uses ctx to cancel the request and any async job polling
+func (s *ServiceOfferingService) DeleteServiceOfferingWithContext(ctx context.Context, p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteServiceOffering", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -667,7 +1226,8 @@ type ListServiceOfferingsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListServiceOfferingsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListServiceOfferingsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -728,6 +1288,58 @@ func (p *ListServiceOfferingsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListServiceOfferingsParams) Validate() error {
+	v := newParamValidator("listServiceOfferings", p.p)
+	v.ids("domainid", "id", "virtualmachineid", "zoneid")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("systemvmtype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListServiceOfferingsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListServiceOfferingsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "cpunumber", "cpuspeed", "memory", "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "domainid", "id", "keyword", "name", "systemvmtype", "virtualmachineid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "issystem", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listServiceOfferings", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listServiceOfferings: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListServiceOfferingsParams) SetCpunumber(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -735,6 +1347,15 @@ func (p *ListServiceOfferingsParams) SetCpunumber(v int) {
 	p.p["cpunumber"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetCpunumber() {
+	delete(p.p, "cpunumber")
+}
+
+func (p *ListServiceOfferingsParams) GetCpunumber() (int, bool) {
+	value, ok := p.p["cpunumber"].(int)
+	return value, ok
+}
+
 func (p *ListServiceOfferingsParams) SetCpuspeed(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -742,6 +1363,15 @@ func (p *ListServiceOfferingsParams) SetCpuspeed(v int) {
 	p.p["cpuspeed"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetCpuspeed() {
+	delete(p.p, "cpuspeed")
+}
+
+func (p *ListServiceOfferingsParams) GetCpuspeed() (int, bool) {
+	value, ok := p.p["cpuspeed"].(int)
+	return value, ok
+}
+
 func (p *ListServiceOfferingsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -749,6 +1379,15 @@ func (p *ListServiceOfferingsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListServiceOfferingsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListServiceOfferingsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -756,6 +1395,15 @@ func (p *ListServiceOfferingsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListServiceOfferingsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListServiceOfferingsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -763,6 +1411,15 @@ func (p *ListServiceOfferingsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListServiceOfferingsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListServiceOfferingsParams) SetIssystem(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -770,6 +1427,15 @@ func (p *ListServiceOfferingsParams) SetIssystem(v bool) {
 	p.p["issystem"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetIssystem() {
+	delete(p.p, "issystem")
+}
+
+func (p *ListServiceOfferingsParams) GetIssystem() (bool, bool) {
+	value, ok := p.p["issystem"].(bool)
+	return value, ok
+}
+
 func (p *ListServiceOfferingsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -777,6 +1443,15 @@ func (p *ListServiceOfferingsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListServiceOfferingsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListServiceOfferingsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -784,6 +1459,15 @@ func (p *ListServiceOfferingsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListServiceOfferingsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListServiceOfferingsParams) SetMemory(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -791,6 +1475,15 @@ func (p *ListServiceOfferingsParams) SetMemory(v int) {
 	p.p["memory"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetMemory() {
+	delete(p.p, "memory")
+}
+
+func (p *ListServiceOfferingsParams) GetMemory() (int, bool) {
+	value, ok := p.p["memory"].(int)
+	return value, ok
+}
+
 func (p *ListServiceOfferingsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -798,6 +1491,15 @@ func (p *ListServiceOfferingsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListServiceOfferingsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListServiceOfferingsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -805,6 +1507,15 @@ func (p *ListServiceOfferingsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListServiceOfferingsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListServiceOfferingsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -812,6 +1523,15 @@ func (p *ListServiceOfferingsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListServiceOfferingsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListServiceOfferingsParams) SetSystemvmtype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -819,6 +1539,15 @@ func (p *ListServiceOfferingsParams) SetSystemvmtype(v string) {
 	p.p["systemvmtype"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetSystemvmtype() {
+	delete(p.p, "systemvmtype")
+}
+
+func (p *ListServiceOfferingsParams) GetSystemvmtype() (string, bool) {
+	value, ok := p.p["systemvmtype"].(string)
+	return value, ok
+}
+
 func (p *ListServiceOfferingsParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -826,6 +1555,15 @@ func (p *ListServiceOfferingsParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *ListServiceOfferingsParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 func (p *ListServiceOfferingsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -833,6 +1571,15 @@ func (p *ListServiceOfferingsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListServiceOfferingsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListServiceOfferingsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListServiceOfferingsParams instance,
 // as then you are sure you have configured all required params
 func (s *ServiceOfferingService) NewListServiceOfferingsParams() *ListServiceOfferingsParams {
@@ -843,6 +1590,11 @@ func (s *ServiceOfferingService) NewListServiceOfferingsParams() *ListServiceOff
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ServiceOfferingService) GetServiceOfferingID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetServiceOfferingIDWithContext(context.Background(), name, opts...)
+}
+
+// GetServiceOfferingIDWithContext is the same as GetServiceOfferingID, but uses ctx to cancel the request
+func (s *ServiceOfferingService) GetServiceOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListServiceOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -854,7 +1606,7 @@ func (s *ServiceOfferingService) GetServiceOfferingID(name string, opts ...Optio
 		}
 	}
 
-	l, err := s.ListServiceOfferings(p)
+	l, err := s.ListServiceOfferingsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -879,12 +1631,17 @@ func (s *ServiceOfferingService) GetServiceOfferingID(name string, opts ...Optio
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ServiceOfferingService) GetServiceOfferingByName(name string, opts ...OptionFunc) (*ServiceOffering, int, error) {
-	id, count, err := s.GetServiceOfferingID(name, opts...)
+	return s.GetServiceOfferingByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetServiceOfferingByNameWithContext is the same as GetServiceOfferingByName, but uses ctx to cancel the requests
+func (s *ServiceOfferingService) GetServiceOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ServiceOffering, int, error) {
+	id, count, err := s.GetServiceOfferingIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetServiceOfferingByID(id, opts...)
+	r, count, err := s.GetServiceOfferingByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -893,6 +1650,11 @@ func (s *ServiceOfferingService) GetServiceOfferingByName(name string, opts ...O
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ServiceOfferingService) GetServiceOfferingByID(id string, opts ...OptionFunc) (*ServiceOffering, int, error) {
+	return s.GetServiceOfferingByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetServiceOfferingByIDWithContext is the same as GetServiceOfferingByID, but uses ctx to cancel the request
+func (s *ServiceOfferingService) GetServiceOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ServiceOffering, int, error) {
 	p := &ListServiceOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -904,18 +1666,16 @@ func (s *ServiceOfferingService) GetServiceOfferingByID(id string, opts ...Optio
 		}
 	}
 
-	l, err := s.ListServiceOfferings(p)
+	l, err := s.ListServiceOfferingsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -926,7 +1686,16 @@ func (s *ServiceOfferingService) GetServiceOfferingByID(id string, opts ...Optio
 
 // Lists all available service offerings.
 func (s *ServiceOfferingService) ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listServiceOfferings", p.toURLValues())
+	return s.ListServiceOfferingsWithContext(context.Background(), p)
+}
+
+// ListServiceOfferingsWithContext is the same as ListServiceOfferings, but uses ctx to cancel the request and any async job polling
+func (s *ServiceOfferingService) ListServiceOfferingsWithContext(ctx context.Context, p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listServiceOfferings", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -939,6 +1708,92 @@ func (s *ServiceOfferingService) ListServiceOfferings(p *ListServiceOfferingsPar
 	return &r, nil
 }
 
+// ListServiceOfferingsPager iterates over all pages of a ListServiceOfferings call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListServiceOfferingsPager struct {
+	pager
+
+	s *ServiceOfferingService
+	p *ListServiceOfferingsParams
+	r *ListServiceOfferingsResponse
+}
+
+// NewListServiceOfferingsPager returns a pager for all pages of a ListServiceOfferings call with the given params
+func (s *ServiceOfferingService) NewListServiceOfferingsPager(p *ListServiceOfferingsParams) *ListServiceOfferingsPager {
+	return &ListServiceOfferingsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListServiceOfferingsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListServiceOfferingsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListServiceOfferingsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListServiceOfferingsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.ServiceOfferings), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListServiceOfferingsPager) Page() *ListServiceOfferingsResponse {
+	return pg.r
+}
+
+// ListServiceOfferingsAll fetches all pages of a ListServiceOfferings call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ServiceOfferingService) ListServiceOfferingsAll(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
+	return s.ListServiceOfferingsAllWithContext(context.Background(), p)
+}
+
+// ListServiceOfferingsAllWithContext is the same as ListServiceOfferingsAll, but uses ctx to cancel the requests
+func (s *ServiceOfferingService) ListServiceOfferingsAllWithContext(ctx context.Context, p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*ServiceOffering)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListServiceOfferingsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListServiceOfferingsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.ServiceOfferings
+		mu.Unlock()
+
+		return len(l.ServiceOfferings), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListServiceOfferingsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.ServiceOfferings = append(r.ServiceOfferings, pages[page]...)
+	}
+	r.Count = len(r.ServiceOfferings)
+
+	return r, nil
+}
+
 type ListServiceOfferingsResponse struct {
 	Count            int                `json:"count"`
 	ServiceOfferings []*ServiceOffering `json:"serviceoffering"`
@@ -997,7 +1852,8 @@ type UpdateServiceOfferingParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateServiceOfferingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateServiceOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1024,6 +1880,56 @@ func (p *UpdateServiceOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateServiceOfferingParams) Validate() error {
+	v := newParamValidator("updateServiceOffering", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("displaytext", 255)
+	v.maxLength("domainid", 255)
+	v.maxLength("name", 255)
+	v.maxLength("zoneid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateServiceOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateServiceOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "displaytext", "domainid", "id", "name", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "sortkey":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateServiceOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateServiceOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateServiceOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1031,6 +1937,15 @@ func (p *UpdateServiceOfferingParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *UpdateServiceOfferingParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *UpdateServiceOfferingParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *UpdateServiceOfferingParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1038,6 +1953,15 @@ func (p *UpdateServiceOfferingParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *UpdateServiceOfferingParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *UpdateServiceOfferingParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *UpdateServiceOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1045,6 +1969,15 @@ func (p *UpdateServiceOfferingParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateServiceOfferingParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateServiceOfferingParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateServiceOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1052,6 +1985,15 @@ func (p *UpdateServiceOfferingParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateServiceOfferingParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateServiceOfferingParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateServiceOfferingParams) SetSortkey(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1059,6 +2001,15 @@ func (p *UpdateServiceOfferingParams) SetSortkey(v int) {
 	p.p["sortkey"] = v
 }
 
+func (p *UpdateServiceOfferingParams) ResetSortkey() {
+	delete(p.p, "sortkey")
+}
+
+func (p *UpdateServiceOfferingParams) GetSortkey() (int, bool) {
+	value, ok := p.p["sortkey"].(int)
+	return value, ok
+}
+
 func (p *UpdateServiceOfferingParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1066,6 +2017,15 @@ func (p *UpdateServiceOfferingParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *UpdateServiceOfferingParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *UpdateServiceOfferingParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateServiceOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *ServiceOfferingService) NewUpdateServiceOfferingParams(id string) *UpdateServiceOfferingParams {
@@ -1077,7 +2037,16 @@ func (s *ServiceOfferingService) NewUpdateServiceOfferingParams(id string) *Upda
 
 // Updates a service offering.
 func (s *ServiceOfferingService) UpdateServiceOffering(p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateServiceOffering", p.toURLValues())
+	return s.UpdateServiceOfferingWithContext(context.Background(), p)
+}
+
+// UpdateServiceOfferingWithContext is the same as UpdateServiceOffering, but uses ctx to cancel the request and any async job polling
+func (s *ServiceOfferingService) UpdateServiceOfferingWithContext(ctx context.Context, p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateServiceOffering", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,194 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// SessionClosedErr is returned when a session client is used after it is closed
+var SessionClosedErr = errors.New("Session is closed")
+
+// session contains the credentials and the state of a logged in user
+type session struct {
+	username string
+	password string
+	domain   string
+
+	mu      sync.Mutex
+	key     string        // The session key returned by login
+	timeout time.Duration // The session timeout returned by login
+	expires time.Time     // The time the session times out if it is not used
+	closed  bool          // True after the session is logged out
+}
+
+// NewSessionClient returns a client that logs in with a username and password, instead of using an API
+// key and secret. The optional domain is the path of the domain of the user (e.g. "/" or "/customers").
+// Requests are authenticated using the session key and the JSESSIONID cookie returned by login, and when
+// the session times out the client logs in again. Call Close to logout when the client is no longer used.
+func NewSessionClient(apiurl string, username string, password string, domain string, verifyssl bool, options ...ClientOption) (*CloudStackClient, error) {
+	cs := newClient(apiurl, "", "", false, verifyssl, options...)
+	cs.session = &session{
+		username: username,
+		password: password,
+		domain:   domain,
+	}
+
+	if _, err := cs.session.sessionKey(context.Background(), cs); err != nil {
+		return nil, err
+	}
+
+	return cs, nil
+}
+
+// Close logs out the session of a client created with NewSessionClient, after which the client
+// can no longer be used. For any other client Close does nothing.
+func (cs *CloudStackClient) Close() error {
+	return cs.CloseWithContext(context.Background())
+}
+
+// CloseWithContext is the same as Close, but uses ctx to cancel the logout request
+func (cs *CloudStackClient) CloseWithContext(ctx context.Context) error {
+	if cs.session == nil {
+		return nil
+	}
+	return cs.session.logout(ctx, cs)
+}
+
+// Executes a single attempt of a request, using the session key to authenticate the request
+func (s *session) doRequest(ctx context.Context, cs *CloudStackClient, api string, params url.Values) (json.RawMessage, error) {
+	if api == "login" {
+		return cs.sendRequest(ctx, api, encodeValues(params))
+	}
+
+	key, err := s.sessionKey(ctx, cs)
+	if err != nil {
+		return nil, err
+	}
+
+	params.Set("sessionkey", key)
+	b, err := cs.sendRequest(ctx, api, encodeValues(params))
+
+	// The session can still time out earlier than expected, for example when
+	// the management server is restarted, so login again and try once more
+	if isSessionExpired(err) && api != "logout" {
+		s.invalidate(key)
+
+		if key, err = s.sessionKey(ctx, cs); err != nil {
+			return nil, err
+		}
+
+		params.Set("sessionkey", key)
+		b, err = cs.sendRequest(ctx, api, encodeValues(params))
+	}
+
+	if err == nil {
+		s.touch()
+	}
+
+	return b, err
+}
+
+// Returns the current session key, and logs in first if there is no session yet or if it timed out
+func (s *session) sessionKey(ctx context.Context, cs *CloudStackClient) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return "", SessionClosedErr
+	}
+
+	if s.key != "" && time.Now().Before(s.expires) {
+		return s.key, nil
+	}
+
+	p := cs.Authentication.NewLoginParams(s.password, s.username)
+	if s.domain != "" {
+		p.SetDomain(s.domain)
+	}
+
+	r, err := cs.Authentication.LoginWithContext(ctx, p)
+	if err != nil {
+		return "", err
+	}
+
+	s.key = r.Sessionkey
+	s.timeout = time.Duration(r.Timeout) * time.Second
+	s.expires = time.Now().Add(s.timeout * 9 / 10)
+
+	return s.key, nil
+}
+
+// Extends the session after it is used, as the session only times out when it is not used
+func (s *session) touch() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.expires = time.Now().Add(s.timeout * 9 / 10)
+}
+
+// Removes the session key if it is still the current key, so the next request logs in again
+func (s *session) invalidate(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.key == key {
+		s.key = ""
+	}
+}
+
+// Logs out the current session, if any. The session is closed first, so no other request can log
+// in again, and a session that already timed out is not logged out at all.
+func (s *session) logout(ctx context.Context, cs *CloudStackClient) error {
+	s.mu.Lock()
+	key := s.key
+	loggedIn := key != "" && !s.closed && time.Now().Before(s.expires)
+	s.key = ""
+	s.closed = true
+	s.mu.Unlock()
+
+	if !loggedIn {
+		return nil
+	}
+
+	params := url.Values{}
+	params.Set("command", "logout")
+	params.Set("response", "json")
+	params.Set("sessionkey", key)
+
+	_, err := cs.sendRequest(ctx, "logout", encodeValues(params))
+	if isSessionExpired(err) {
+		// The session already timed out, which is just as good
+		err = nil
+	}
+
+	return err
+}
+
+// Returns true if CloudStack no longer accepts the session key
+func isSessionExpired(err error) bool {
+	var cse *CSError
+	return errors.As(err, &cse) && (cse.StatusCode == 401 || cse.ErrorCode == 401)
+}
@@ -0,0 +1,83 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSessionClose(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout int // The session timeout returned by login
+		want    []string
+	}{
+		{name: "active session", timeout: 1800, want: []string{"login key1", "logout key1"}},
+		{name: "expired session", timeout: 0, want: []string{"login key1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var requests []string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				r.ParseForm()
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				switch command := r.Form.Get("command"); command {
+				case "login":
+					requests = append(requests, "login key1")
+					fmt.Fprintf(w, `{"loginresponse":{"sessionkey":"key1","timeout":%d}}`, tt.timeout)
+				default:
+					requests = append(requests, command+" "+r.Form.Get("sessionkey"))
+					fmt.Fprint(w, `{"logoutresponse":{"description":"success"}}`)
+				}
+			}))
+			defer srv.Close()
+
+			cs, err := NewSessionClient(srv.URL, "admin", "password", "", false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if err := cs.Close(); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if _, err := cs.session.sessionKey(context.Background(), cs); err != SessionClosedErr {
+				t.Errorf("Expected %v after closing, got %v", SessionClosedErr, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if !reflect.DeepEqual(requests, tt.want) {
+				t.Errorf("Expected the requests %q, got %q", tt.want, requests)
+			}
+		})
+	}
+}
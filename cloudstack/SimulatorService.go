@@ -0,0 +1,1068 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// SimulatorServiceIface is the interface implemented by SimulatorService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type SimulatorServiceIface interface {
+	NewCleanupSimulatorMockParams() *CleanupSimulatorMockParams
+	CleanupSimulatorMock(p *CleanupSimulatorMockParams) (*CleanupSimulatorMockResponse, error)
+	CleanupSimulatorMockWithContext(ctx context.Context, p *CleanupSimulatorMockParams) (*CleanupSimulatorMockResponse, error)
+	NewConfigureSimulatorParams(name string, value string) *ConfigureSimulatorParams
+	ConfigureSimulator(p *ConfigureSimulatorParams) (*SimulatorResponse, error)
+	ConfigureSimulatorWithContext(ctx context.Context, p *ConfigureSimulatorParams) (*SimulatorResponse, error)
+	NewConfigureSimulatorHAProviderStateParams(activity bool, fence bool, health bool, hostid string, recover bool) *ConfigureSimulatorHAProviderStateParams
+	ConfigureSimulatorHAProviderState(p *ConfigureSimulatorHAProviderStateParams) (*SimulatorHAProviderStateResponse, error)
+	ConfigureSimulatorHAProviderStateWithContext(ctx context.Context, p *ConfigureSimulatorHAProviderStateParams) (*SimulatorHAProviderStateResponse, error)
+	NewListSimulatorHAStateTransitionsParams(hostid string) *ListSimulatorHAStateTransitionsParams
+	ListSimulatorHAStateTransitions(p *ListSimulatorHAStateTransitionsParams) (*ListSimulatorHAStateTransitionsResponse, error)
+	ListSimulatorHAStateTransitionsWithContext(ctx context.Context, p *ListSimulatorHAStateTransitionsParams) (*ListSimulatorHAStateTransitionsResponse, error)
+	NewListSimulatorHAStateTransitionsPager(p *ListSimulatorHAStateTransitionsParams) *ListSimulatorHAStateTransitionsPager
+	ListSimulatorHAStateTransitionsAll(p *ListSimulatorHAStateTransitionsParams) (*ListSimulatorHAStateTransitionsResponse, error)
+	ListSimulatorHAStateTransitionsAllWithContext(ctx context.Context, p *ListSimulatorHAStateTransitionsParams) (*ListSimulatorHAStateTransitionsResponse, error)
+	NewQuerySimulatorMockParams() *QuerySimulatorMockParams
+	QuerySimulatorMock(p *QuerySimulatorMockParams) (*QuerySimulatorMockResponse, error)
+	QuerySimulatorMockWithContext(ctx context.Context, p *QuerySimulatorMockParams) (*QuerySimulatorMockResponse, error)
+}
+
+type CleanupSimulatorMockParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CleanupSimulatorMockParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		vv := strconv.FormatInt(v.(int64), 10)
+		u.Set("id", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CleanupSimulatorMockParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CleanupSimulatorMockParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CleanupSimulatorMockParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for cleanupSimulatorMock", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of cleanupSimulatorMock: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *CleanupSimulatorMockParams) SetId(v int64) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *CleanupSimulatorMockParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *CleanupSimulatorMockParams) GetId() (int64, bool) {
+	value, ok := p.p["id"].(int64)
+	return value, ok
+}
+
+// You should always use this function to get a new CleanupSimulatorMockParams instance,
+// as then you are sure you have configured all required params
+func (s *SimulatorService) NewCleanupSimulatorMockParams() *CleanupSimulatorMockParams {
+	p := &CleanupSimulatorMockParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// cleanup simulator mock
+func (s *SimulatorService) CleanupSimulatorMock(p *CleanupSimulatorMockParams) (*CleanupSimulatorMockResponse, error) {
+	return s.CleanupSimulatorMockWithContext(context.Background(), p)
+}
+
+// CleanupSimulatorMockWithContext is the same as CleanupSimulatorMock, but uses ctx to cancel the request and any async job polling
+func (s *SimulatorService) CleanupSimulatorMockWithContext(ctx context.Context, p *CleanupSimulatorMockParams) (*CleanupSimulatorMockResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "cleanupSimulatorMock", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CleanupSimulatorMockResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type CleanupSimulatorMockResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *CleanupSimulatorMockResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias CleanupSimulatorMockResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type ConfigureSimulatorParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ConfigureSimulatorParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["clusterid"]; found {
+		u.Set("clusterid", v.(string))
+	}
+	if v, found := p.p["count"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("count", vv)
+	}
+	if v, found := p.p["hostid"]; found {
+		u.Set("hostid", v.(string))
+	}
+	if v, found := p.p["jsonresponse"]; found {
+		u.Set("jsonresponse", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["podid"]; found {
+		u.Set("podid", v.(string))
+	}
+	if v, found := p.p["value"]; found {
+		u.Set("value", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ConfigureSimulatorParams) Validate() error {
+	v := newParamValidator("configureSimulator", p.p)
+	v.required("name", "value")
+	v.ids("clusterid", "hostid", "podid", "zoneid")
+	v.maxLength("jsonresponse", 4096)
+	v.maxLength("name", 255)
+	v.maxLength("value", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ConfigureSimulatorParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ConfigureSimulatorParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid", "hostid", "jsonresponse", "name", "podid", "value", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "count":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for configureSimulator", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of configureSimulator: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ConfigureSimulatorParams) SetClusterid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["clusterid"] = v
+}
+
+func (p *ConfigureSimulatorParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ConfigureSimulatorParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
+func (p *ConfigureSimulatorParams) SetCount(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["count"] = v
+}
+
+func (p *ConfigureSimulatorParams) ResetCount() {
+	delete(p.p, "count")
+}
+
+func (p *ConfigureSimulatorParams) GetCount() (int, bool) {
+	value, ok := p.p["count"].(int)
+	return value, ok
+}
+
+func (p *ConfigureSimulatorParams) SetHostid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostid"] = v
+}
+
+func (p *ConfigureSimulatorParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ConfigureSimulatorParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
+func (p *ConfigureSimulatorParams) SetJsonresponse(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["jsonresponse"] = v
+}
+
+func (p *ConfigureSimulatorParams) ResetJsonresponse() {
+	delete(p.p, "jsonresponse")
+}
+
+func (p *ConfigureSimulatorParams) GetJsonresponse() (string, bool) {
+	value, ok := p.p["jsonresponse"].(string)
+	return value, ok
+}
+
+func (p *ConfigureSimulatorParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ConfigureSimulatorParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ConfigureSimulatorParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ConfigureSimulatorParams) SetPodid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["podid"] = v
+}
+
+func (p *ConfigureSimulatorParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ConfigureSimulatorParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
+func (p *ConfigureSimulatorParams) SetValue(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["value"] = v
+}
+
+func (p *ConfigureSimulatorParams) ResetValue() {
+	delete(p.p, "value")
+}
+
+func (p *ConfigureSimulatorParams) GetValue() (string, bool) {
+	value, ok := p.p["value"].(string)
+	return value, ok
+}
+
+func (p *ConfigureSimulatorParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ConfigureSimulatorParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ConfigureSimulatorParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ConfigureSimulatorParams instance,
+// as then you are sure you have configured all required params
+func (s *SimulatorService) NewConfigureSimulatorParams(name string, value string) *ConfigureSimulatorParams {
+	p := &ConfigureSimulatorParams{}
+	p.p = make(map[string]interface{})
+	p.p["name"] = name
+	p.p["value"] = value
+	return p
+}
+
+// configure simulator
+func (s *SimulatorService) ConfigureSimulator(p *ConfigureSimulatorParams) (*SimulatorResponse, error) {
+	return s.ConfigureSimulatorWithContext(context.Background(), p)
+}
+
+// ConfigureSimulatorWithContext is the same as ConfigureSimulator, but uses ctx to cancel the request and any async job polling
+func (s *SimulatorService) ConfigureSimulatorWithContext(ctx context.Context, p *ConfigureSimulatorParams) (*SimulatorResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureSimulator", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r SimulatorResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type SimulatorResponse struct {
+	Clusterid int64  `json:"clusterid"`
+	Count     int    `json:"count"`
+	Hostid    int64  `json:"hostid"`
+	Id        int64  `json:"id"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Name      string `json:"name"`
+	Podid     int64  `json:"podid"`
+	Zoneid    int64  `json:"zoneid"`
+}
+
+type ConfigureSimulatorHAProviderStateParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ConfigureSimulatorHAProviderStateParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["activity"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("activity", vv)
+	}
+	if v, found := p.p["fence"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("fence", vv)
+	}
+	if v, found := p.p["health"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("health", vv)
+	}
+	if v, found := p.p["hostid"]; found {
+		u.Set("hostid", v.(string))
+	}
+	if v, found := p.p["recover"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("recover", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ConfigureSimulatorHAProviderStateParams) Validate() error {
+	v := newParamValidator("configureSimulatorHAProviderState", p.p)
+	v.required("activity", "fence", "health", "hostid", "recover")
+	v.ids("hostid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ConfigureSimulatorHAProviderStateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ConfigureSimulatorHAProviderStateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "activity", "fence", "health", "recover":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "hostid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for configureSimulatorHAProviderState", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of configureSimulatorHAProviderState: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) SetActivity(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["activity"] = v
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) ResetActivity() {
+	delete(p.p, "activity")
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) GetActivity() (bool, bool) {
+	value, ok := p.p["activity"].(bool)
+	return value, ok
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) SetFence(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["fence"] = v
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) ResetFence() {
+	delete(p.p, "fence")
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) GetFence() (bool, bool) {
+	value, ok := p.p["fence"].(bool)
+	return value, ok
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) SetHealth(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["health"] = v
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) ResetHealth() {
+	delete(p.p, "health")
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) GetHealth() (bool, bool) {
+	value, ok := p.p["health"].(bool)
+	return value, ok
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) SetHostid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostid"] = v
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) SetRecover(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["recover"] = v
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) ResetRecover() {
+	delete(p.p, "recover")
+}
+
+func (p *ConfigureSimulatorHAProviderStateParams) GetRecover() (bool, bool) {
+	value, ok := p.p["recover"].(bool)
+	return value, ok
+}
+
+// You should always use this function to get a new ConfigureSimulatorHAProviderStateParams instance,
+// as then you are sure you have configured all required params
+func (s *SimulatorService) NewConfigureSimulatorHAProviderStateParams(activity bool, fence bool, health bool, hostid string, recover bool) *ConfigureSimulatorHAProviderStateParams {
+	p := &ConfigureSimulatorHAProviderStateParams{}
+	p.p = make(map[string]interface{})
+	p.p["activity"] = activity
+	p.p["fence"] = fence
+	p.p["health"] = health
+	p.p["hostid"] = hostid
+	p.p["recover"] = recover
+	return p
+}
+
+// configures simulator HA provider state for a host for probing and testing
+func (s *SimulatorService) ConfigureSimulatorHAProviderState(p *ConfigureSimulatorHAProviderStateParams) (*SimulatorHAProviderStateResponse, error) {
+	return s.ConfigureSimulatorHAProviderStateWithContext(context.Background(), p)
+}
+
+// ConfigureSimulatorHAProviderStateWithContext is the same as ConfigureSimulatorHAProviderState, but uses ctx to cancel the request and any async job polling
+func (s *SimulatorService) ConfigureSimulatorHAProviderStateWithContext(ctx context.Context, p *ConfigureSimulatorHAProviderStateParams) (*SimulatorHAProviderStateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "configureSimulatorHAProviderState", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r SimulatorHAProviderStateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type SimulatorHAProviderStateResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *SimulatorHAProviderStateResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias SimulatorHAProviderStateResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type ListSimulatorHAStateTransitionsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListSimulatorHAStateTransitionsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["hostid"]; found {
+		u.Set("hostid", v.(string))
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListSimulatorHAStateTransitionsParams) Validate() error {
+	v := newParamValidator("listSimulatorHAStateTransitions", p.p)
+	v.required("hostid")
+	v.ids("hostid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListSimulatorHAStateTransitionsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListSimulatorHAStateTransitionsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid", "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listSimulatorHAStateTransitions", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listSimulatorHAStateTransitions: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListSimulatorHAStateTransitionsParams) SetHostid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostid"] = v
+}
+
+func (p *ListSimulatorHAStateTransitionsParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ListSimulatorHAStateTransitionsParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
+func (p *ListSimulatorHAStateTransitionsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListSimulatorHAStateTransitionsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListSimulatorHAStateTransitionsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListSimulatorHAStateTransitionsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListSimulatorHAStateTransitionsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListSimulatorHAStateTransitionsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListSimulatorHAStateTransitionsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListSimulatorHAStateTransitionsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListSimulatorHAStateTransitionsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+// You should always use this function to get a new ListSimulatorHAStateTransitionsParams instance,
+// as then you are sure you have configured all required params
+func (s *SimulatorService) NewListSimulatorHAStateTransitionsParams(hostid string) *ListSimulatorHAStateTransitionsParams {
+	p := &ListSimulatorHAStateTransitionsParams{}
+	p.p = make(map[string]interface{})
+	p.p["hostid"] = hostid
+	return p
+}
+
+// list recent simulator HA state transitions for a host for probing and testing
+func (s *SimulatorService) ListSimulatorHAStateTransitions(p *ListSimulatorHAStateTransitionsParams) (*ListSimulatorHAStateTransitionsResponse, error) {
+	return s.ListSimulatorHAStateTransitionsWithContext(context.Background(), p)
+}
+
+// ListSimulatorHAStateTransitionsWithContext is the same as ListSimulatorHAStateTransitions, but uses ctx to cancel the request and any async job polling
+func (s *SimulatorService) ListSimulatorHAStateTransitionsWithContext(ctx context.Context, p *ListSimulatorHAStateTransitionsParams) (*ListSimulatorHAStateTransitionsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listSimulatorHAStateTransitions", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListSimulatorHAStateTransitionsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListSimulatorHAStateTransitionsPager iterates over all pages of a ListSimulatorHAStateTransitions call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListSimulatorHAStateTransitionsPager struct {
+	pager
+
+	s *SimulatorService
+	p *ListSimulatorHAStateTransitionsParams
+	r *ListSimulatorHAStateTransitionsResponse
+}
+
+// NewListSimulatorHAStateTransitionsPager returns a pager for all pages of a ListSimulatorHAStateTransitions call with the given params
+func (s *SimulatorService) NewListSimulatorHAStateTransitionsPager(p *ListSimulatorHAStateTransitionsParams) *ListSimulatorHAStateTransitionsPager {
+	return &ListSimulatorHAStateTransitionsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListSimulatorHAStateTransitionsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListSimulatorHAStateTransitionsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListSimulatorHAStateTransitionsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListSimulatorHAStateTransitionsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.SimulatorHAStateTransitions), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListSimulatorHAStateTransitionsPager) Page() *ListSimulatorHAStateTransitionsResponse {
+	return pg.r
+}
+
+// ListSimulatorHAStateTransitionsAll fetches all pages of a ListSimulatorHAStateTransitions call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *SimulatorService) ListSimulatorHAStateTransitionsAll(p *ListSimulatorHAStateTransitionsParams) (*ListSimulatorHAStateTransitionsResponse, error) {
+	return s.ListSimulatorHAStateTransitionsAllWithContext(context.Background(), p)
+}
+
+// ListSimulatorHAStateTransitionsAllWithContext is the same as ListSimulatorHAStateTransitionsAll, but uses ctx to cancel the requests
+func (s *SimulatorService) ListSimulatorHAStateTransitionsAllWithContext(ctx context.Context, p *ListSimulatorHAStateTransitionsParams) (*ListSimulatorHAStateTransitionsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*SimulatorHAStateTransition)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListSimulatorHAStateTransitionsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListSimulatorHAStateTransitionsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.SimulatorHAStateTransitions
+		mu.Unlock()
+
+		return len(l.SimulatorHAStateTransitions), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListSimulatorHAStateTransitionsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.SimulatorHAStateTransitions = append(r.SimulatorHAStateTransitions, pages[page]...)
+	}
+	r.Count = len(r.SimulatorHAStateTransitions)
+
+	return r, nil
+}
+
+type ListSimulatorHAStateTransitionsResponse struct {
+	Count                       int                           `json:"count"`
+	SimulatorHAStateTransitions []*SimulatorHAStateTransition `json:"simulatorhastatetransition"`
+}
+
+type SimulatorHAStateTransition struct {
+	Activitycounter int64  `json:"activitycounter"`
+	Event           string `json:"event"`
+	Hastate         string `json:"hastate"`
+	JobID           string `json:"jobid"`
+	Jobstatus       int    `json:"jobstatus"`
+	Prevhastate     string `json:"prevhastate"`
+	Recoverycounter int64  `json:"recoverycounter"`
+}
+
+type QuerySimulatorMockParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *QuerySimulatorMockParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		vv := strconv.FormatInt(v.(int64), 10)
+		u.Set("id", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *QuerySimulatorMockParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *QuerySimulatorMockParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *QuerySimulatorMockParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for querySimulatorMock", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of querySimulatorMock: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *QuerySimulatorMockParams) SetId(v int64) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *QuerySimulatorMockParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *QuerySimulatorMockParams) GetId() (int64, bool) {
+	value, ok := p.p["id"].(int64)
+	return value, ok
+}
+
+// You should always use this function to get a new QuerySimulatorMockParams instance,
+// as then you are sure you have configured all required params
+func (s *SimulatorService) NewQuerySimulatorMockParams() *QuerySimulatorMockParams {
+	p := &QuerySimulatorMockParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// query simulator mock
+func (s *SimulatorService) QuerySimulatorMock(p *QuerySimulatorMockParams) (*QuerySimulatorMockResponse, error) {
+	return s.QuerySimulatorMockWithContext(context.Background(), p)
+}
+
+// QuerySimulatorMockWithContext is the same as QuerySimulatorMock, but uses ctx to cancel the request and any async job polling
+func (s *SimulatorService) QuerySimulatorMockWithContext(ctx context.Context, p *QuerySimulatorMockParams) (*QuerySimulatorMockResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "querySimulatorMock", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r QuerySimulatorMockResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type QuerySimulatorMockResponse struct {
+	Clusterid int64  `json:"clusterid"`
+	Count     int    `json:"count"`
+	Hostid    int64  `json:"hostid"`
+	Id        int64  `json:"id"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Name      string `json:"name"`
+	Podid     int64  `json:"podid"`
+	Zoneid    int64  `json:"zoneid"`
+}
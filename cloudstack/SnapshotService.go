@@ -20,18 +20,320 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// SnapshotServiceIface is the interface implemented by SnapshotService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type SnapshotServiceIface interface {
+	NewArchiveSnapshotParams(id string) *ArchiveSnapshotParams
+	ArchiveSnapshot(p *ArchiveSnapshotParams) (*ArchiveSnapshotResponse, error)
+	ArchiveSnapshotWithContext(ctx context.Context, p *ArchiveSnapshotParams) (*ArchiveSnapshotResponse, error)
+	ArchiveSnapshotAsync(p *ArchiveSnapshotParams) (*Job, error)
+	ArchiveSnapshotAsyncWithContext(ctx context.Context, p *ArchiveSnapshotParams) (*Job, error)
+	NewCreateSnapshotParams(volumeid string) *CreateSnapshotParams
+	CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error)
+	CreateSnapshotWithContext(ctx context.Context, p *CreateSnapshotParams) (*CreateSnapshotResponse, error)
+	CreateSnapshotAsync(p *CreateSnapshotParams) (*Job, error)
+	CreateSnapshotAsyncWithContext(ctx context.Context, p *CreateSnapshotParams) (*Job, error)
+	NewCreateSnapshotFromVMSnapshotParams(vmsnapshotid string, volumeid string) *CreateSnapshotFromVMSnapshotParams
+	CreateSnapshotFromVMSnapshot(p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error)
+	CreateSnapshotFromVMSnapshotWithContext(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error)
+	CreateSnapshotFromVMSnapshotAsync(p *CreateSnapshotFromVMSnapshotParams) (*Job, error)
+	CreateSnapshotFromVMSnapshotAsyncWithContext(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*Job, error)
+	NewCreateSnapshotPolicyParams(intervaltype string, maxsnaps int, schedule string, timezone string, volumeid string) *CreateSnapshotPolicyParams
+	CreateSnapshotPolicy(p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error)
+	CreateSnapshotPolicyWithContext(ctx context.Context, p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error)
+	NewCreateVMSnapshotParams(virtualmachineid string) *CreateVMSnapshotParams
+	CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error)
+	CreateVMSnapshotWithContext(ctx context.Context, p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error)
+	CreateVMSnapshotAsync(p *CreateVMSnapshotParams) (*Job, error)
+	CreateVMSnapshotAsyncWithContext(ctx context.Context, p *CreateVMSnapshotParams) (*Job, error)
+	NewDeleteSnapshotParams(id string) *DeleteSnapshotParams
+	DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error)
+	DeleteSnapshotWithContext(ctx context.Context, p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error)
+	DeleteSnapshotAsync(p *DeleteSnapshotParams) (*Job, error)
+	DeleteSnapshotAsyncWithContext(ctx context.Context, p *DeleteSnapshotParams) (*Job, error)
+	NewDeleteSnapshotPoliciesParams() *DeleteSnapshotPoliciesParams
+	DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error)
+	DeleteSnapshotPoliciesWithContext(ctx context.Context, p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error)
+	NewDeleteVMSnapshotParams(vmsnapshotid string) *DeleteVMSnapshotParams
+	DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error)
+	DeleteVMSnapshotWithContext(ctx context.Context, p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error)
+	DeleteVMSnapshotAsync(p *DeleteVMSnapshotParams) (*Job, error)
+	DeleteVMSnapshotAsyncWithContext(ctx context.Context, p *DeleteVMSnapshotParams) (*Job, error)
+	NewListSnapshotPoliciesParams() *ListSnapshotPoliciesParams
+	GetSnapshotPolicyByID(id string, opts ...OptionFunc) (*SnapshotPolicy, int, error)
+	GetSnapshotPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SnapshotPolicy, int, error)
+	ListSnapshotPolicies(p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error)
+	ListSnapshotPoliciesWithContext(ctx context.Context, p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error)
+	NewListSnapshotPoliciesPager(p *ListSnapshotPoliciesParams) *ListSnapshotPoliciesPager
+	ListSnapshotPoliciesAll(p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error)
+	ListSnapshotPoliciesAllWithContext(ctx context.Context, p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error)
+	NewListSnapshotsParams() *ListSnapshotsParams
+	GetSnapshotID(name string, opts ...OptionFunc) (string, int, error)
+	GetSnapshotIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetSnapshotByName(name string, opts ...OptionFunc) (*Snapshot, int, error)
+	GetSnapshotByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Snapshot, int, error)
+	GetSnapshotByID(id string, opts ...OptionFunc) (*Snapshot, int, error)
+	GetSnapshotByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Snapshot, int, error)
+	ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error)
+	ListSnapshotsWithContext(ctx context.Context, p *ListSnapshotsParams) (*ListSnapshotsResponse, error)
+	NewListSnapshotsPager(p *ListSnapshotsParams) *ListSnapshotsPager
+	ListSnapshotsAll(p *ListSnapshotsParams) (*ListSnapshotsResponse, error)
+	ListSnapshotsAllWithContext(ctx context.Context, p *ListSnapshotsParams) (*ListSnapshotsResponse, error)
+	NewListVMSnapshotParams() *ListVMSnapshotParams
+	GetVMSnapshotID(name string, opts ...OptionFunc) (string, int, error)
+	GetVMSnapshotIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error)
+	ListVMSnapshotWithContext(ctx context.Context, p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error)
+	NewListVMSnapshotPager(p *ListVMSnapshotParams) *ListVMSnapshotPager
+	ListVMSnapshotAll(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error)
+	ListVMSnapshotAllWithContext(ctx context.Context, p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error)
+	NewRevertSnapshotParams(id string) *RevertSnapshotParams
+	RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error)
+	RevertSnapshotWithContext(ctx context.Context, p *RevertSnapshotParams) (*RevertSnapshotResponse, error)
+	RevertSnapshotAsync(p *RevertSnapshotParams) (*Job, error)
+	RevertSnapshotAsyncWithContext(ctx context.Context, p *RevertSnapshotParams) (*Job, error)
+	NewRevertToVMSnapshotParams(vmsnapshotid string) *RevertToVMSnapshotParams
+	RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error)
+	RevertToVMSnapshotWithContext(ctx context.Context, p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error)
+	RevertToVMSnapshotAsync(p *RevertToVMSnapshotParams) (*Job, error)
+	RevertToVMSnapshotAsyncWithContext(ctx context.Context, p *RevertToVMSnapshotParams) (*Job, error)
+	NewUpdateSnapshotPolicyParams() *UpdateSnapshotPolicyParams
+	UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error)
+	UpdateSnapshotPolicyWithContext(ctx context.Context, p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error)
+	UpdateSnapshotPolicyAsync(p *UpdateSnapshotPolicyParams) (*Job, error)
+	UpdateSnapshotPolicyAsyncWithContext(ctx context.Context, p *UpdateSnapshotPolicyParams) (*Job, error)
+}
+
+type ArchiveSnapshotParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ArchiveSnapshotParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ArchiveSnapshotParams) Validate() error {
+	v := newParamValidator("archiveSnapshot", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ArchiveSnapshotParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ArchiveSnapshotParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for archiveSnapshot", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of archiveSnapshot: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ArchiveSnapshotParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ArchiveSnapshotParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ArchiveSnapshotParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ArchiveSnapshotParams instance,
+// as then you are sure you have configured all required params
+func (s *SnapshotService) NewArchiveSnapshotParams(id string) *ArchiveSnapshotParams {
+	p := &ArchiveSnapshotParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Archives (moves) a snapshot on primary storage to secondary storage
+func (s *SnapshotService) ArchiveSnapshot(p *ArchiveSnapshotParams) (*ArchiveSnapshotResponse, error) {
+	return s.ArchiveSnapshotWithContext(context.Background(), p)
+}
+
+// ArchiveSnapshotWithContext is the same as ArchiveSnapshot, but uses ctx to cancel the request and any async job polling
+func (s *SnapshotService) ArchiveSnapshotWithContext(ctx context.Context, p *ArchiveSnapshotParams) (*ArchiveSnapshotResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "archiveSnapshot", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ArchiveSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// ArchiveSnapshotAsync starts the async job for ArchiveSnapshot and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ArchiveSnapshotResponse using Job.Result
+func (s *SnapshotService) ArchiveSnapshotAsync(p *ArchiveSnapshotParams) (*Job, error) {
+	return s.ArchiveSnapshotAsyncWithContext(context.Background(), p)
+}
+
+// ArchiveSnapshotAsyncWithContext is the same as ArchiveSnapshotAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SnapshotService) ArchiveSnapshotAsyncWithContext(ctx context.Context, p *ArchiveSnapshotParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "archiveSnapshot", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ArchiveSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type ArchiveSnapshotResponse struct {
+	Account       string `json:"account"`
+	Created       string `json:"created"`
+	Domain        string `json:"domain"`
+	Domainid      string `json:"domainid"`
+	Id            string `json:"id"`
+	Intervaltype  string `json:"intervaltype"`
+	JobID         string `json:"jobid"`
+	Jobstatus     int    `json:"jobstatus"`
+	Locationtype  string `json:"locationtype"`
+	Name          string `json:"name"`
+	Osdisplayname string `json:"osdisplayname"`
+	Ostypeid      string `json:"ostypeid"`
+	Physicalsize  int64  `json:"physicalsize"`
+	Project       string `json:"project"`
+	Projectid     string `json:"projectid"`
+	Revertable    bool   `json:"revertable"`
+	Snapshottype  string `json:"snapshottype"`
+	State         string `json:"state"`
+	Tags          []Tags `json:"tags"`
+	Virtualsize   int64  `json:"virtualsize"`
+	Volumeid      string `json:"volumeid"`
+	Volumename    string `json:"volumename"`
+	Volumetype    string `json:"volumetype"`
+	Zoneid        string `json:"zoneid"`
+}
+
+func (r *ArchiveSnapshotResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias ArchiveSnapshotResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
 type CreateSnapshotParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateSnapshotParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateSnapshotParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -72,6 +374,59 @@ func (p *CreateSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateSnapshotParams) Validate() error {
+	v := newParamValidator("createSnapshot", p.p)
+	v.required("volumeid")
+	v.ids("domainid", "policyid", "volumeid")
+	v.maxLength("account", 255)
+	v.maxLength("locationtype", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateSnapshotParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateSnapshotParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "locationtype", "name", "policyid", "volumeid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "asyncbackup", "quiescevm":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createSnapshot", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createSnapshot: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateSnapshotParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +434,15 @@ func (p *CreateSnapshotParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateSnapshotParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateSnapshotParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateSnapshotParams) SetAsyncbackup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -86,6 +450,15 @@ func (p *CreateSnapshotParams) SetAsyncbackup(v bool) {
 	p.p["asyncbackup"] = v
 }
 
+func (p *CreateSnapshotParams) ResetAsyncbackup() {
+	delete(p.p, "asyncbackup")
+}
+
+func (p *CreateSnapshotParams) GetAsyncbackup() (bool, bool) {
+	value, ok := p.p["asyncbackup"].(bool)
+	return value, ok
+}
+
 func (p *CreateSnapshotParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -93,72 +466,399 @@ func (p *CreateSnapshotParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
-func (p *CreateSnapshotParams) SetLocationtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateSnapshotParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateSnapshotParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *CreateSnapshotParams) SetLocationtype(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["locationtype"] = v
+}
+
+func (p *CreateSnapshotParams) ResetLocationtype() {
+	delete(p.p, "locationtype")
+}
+
+func (p *CreateSnapshotParams) GetLocationtype() (string, bool) {
+	value, ok := p.p["locationtype"].(string)
+	return value, ok
+}
+
+func (p *CreateSnapshotParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *CreateSnapshotParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateSnapshotParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *CreateSnapshotParams) SetPolicyid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["policyid"] = v
+}
+
+func (p *CreateSnapshotParams) ResetPolicyid() {
+	delete(p.p, "policyid")
+}
+
+func (p *CreateSnapshotParams) GetPolicyid() (string, bool) {
+	value, ok := p.p["policyid"].(string)
+	return value, ok
+}
+
+func (p *CreateSnapshotParams) SetQuiescevm(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["quiescevm"] = v
+}
+
+func (p *CreateSnapshotParams) ResetQuiescevm() {
+	delete(p.p, "quiescevm")
+}
+
+func (p *CreateSnapshotParams) GetQuiescevm() (bool, bool) {
+	value, ok := p.p["quiescevm"].(bool)
+	return value, ok
+}
+
+func (p *CreateSnapshotParams) SetTags(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["tags"] = v
+}
+
+func (p *CreateSnapshotParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *CreateSnapshotParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
+func (p *CreateSnapshotParams) SetVolumeid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["volumeid"] = v
+}
+
+func (p *CreateSnapshotParams) ResetVolumeid() {
+	delete(p.p, "volumeid")
+}
+
+func (p *CreateSnapshotParams) GetVolumeid() (string, bool) {
+	value, ok := p.p["volumeid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateSnapshotParams instance,
+// as then you are sure you have configured all required params
+func (s *SnapshotService) NewCreateSnapshotParams(volumeid string) *CreateSnapshotParams {
+	p := &CreateSnapshotParams{}
+	p.p = make(map[string]interface{})
+	p.p["volumeid"] = volumeid
+	return p
+}
+
+// Creates an instant snapshot of a volume.
+func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
+	return s.CreateSnapshotWithContext(context.Background(), p)
+}
+
+// CreateSnapshotWithContext is the same as CreateSnapshot, but uses ctx to cancel the request and any async job polling
+func (s *SnapshotService) CreateSnapshotWithContext(ctx context.Context, p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createSnapshot", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// CreateSnapshotAsync starts the async job for CreateSnapshot and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateSnapshotResponse using Job.Result
+func (s *SnapshotService) CreateSnapshotAsync(p *CreateSnapshotParams) (*Job, error) {
+	return s.CreateSnapshotAsyncWithContext(context.Background(), p)
+}
+
+// CreateSnapshotAsyncWithContext is the same as CreateSnapshotAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SnapshotService) CreateSnapshotAsyncWithContext(ctx context.Context, p *CreateSnapshotParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createSnapshot", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type CreateSnapshotResponse struct {
+	Account       string `json:"account"`
+	Created       string `json:"created"`
+	Domain        string `json:"domain"`
+	Domainid      string `json:"domainid"`
+	Id            string `json:"id"`
+	Intervaltype  string `json:"intervaltype"`
+	JobID         string `json:"jobid"`
+	Jobstatus     int    `json:"jobstatus"`
+	Locationtype  string `json:"locationtype"`
+	Name          string `json:"name"`
+	Osdisplayname string `json:"osdisplayname"`
+	Ostypeid      string `json:"ostypeid"`
+	Physicalsize  int64  `json:"physicalsize"`
+	Project       string `json:"project"`
+	Projectid     string `json:"projectid"`
+	Revertable    bool   `json:"revertable"`
+	Snapshottype  string `json:"snapshottype"`
+	State         string `json:"state"`
+	Tags          []Tags `json:"tags"`
+	Virtualsize   int64  `json:"virtualsize"`
+	Volumeid      string `json:"volumeid"`
+	Volumename    string `json:"volumename"`
+	Volumetype    string `json:"volumetype"`
+	Zoneid        string `json:"zoneid"`
+}
+
+func (r *CreateSnapshotResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias CreateSnapshotResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type CreateSnapshotFromVMSnapshotParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateSnapshotFromVMSnapshotParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["vmsnapshotid"]; found {
+		u.Set("vmsnapshotid", v.(string))
+	}
+	if v, found := p.p["volumeid"]; found {
+		u.Set("volumeid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateSnapshotFromVMSnapshotParams) Validate() error {
+	v := newParamValidator("createSnapshotFromVMSnapshot", p.p)
+	v.required("vmsnapshotid", "volumeid")
+	v.ids("vmsnapshotid", "volumeid")
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateSnapshotFromVMSnapshotParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateSnapshotFromVMSnapshotParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "name", "vmsnapshotid", "volumeid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createSnapshotFromVMSnapshot", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createSnapshotFromVMSnapshot: %w", k, err)
+		}
 	}
-	p.p["locationtype"] = v
+	return nil
 }
 
-func (p *CreateSnapshotParams) SetName(v string) {
+func (p *CreateSnapshotFromVMSnapshotParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["name"] = v
 }
 
-func (p *CreateSnapshotParams) SetPolicyid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["policyid"] = v
+func (p *CreateSnapshotFromVMSnapshotParams) ResetName() {
+	delete(p.p, "name")
 }
 
-func (p *CreateSnapshotParams) SetQuiescevm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["quiescevm"] = v
+func (p *CreateSnapshotFromVMSnapshotParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
 }
 
-func (p *CreateSnapshotParams) SetTags(v map[string]string) {
+func (p *CreateSnapshotFromVMSnapshotParams) SetVmsnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["tags"] = v
+	p.p["vmsnapshotid"] = v
 }
 
-func (p *CreateSnapshotParams) SetVolumeid(v string) {
+func (p *CreateSnapshotFromVMSnapshotParams) ResetVmsnapshotid() {
+	delete(p.p, "vmsnapshotid")
+}
+
+func (p *CreateSnapshotFromVMSnapshotParams) GetVmsnapshotid() (string, bool) {
+	value, ok := p.p["vmsnapshotid"].(string)
+	return value, ok
+}
+
+func (p *CreateSnapshotFromVMSnapshotParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["volumeid"] = v
 }
 
-// You should always use this function to get a new CreateSnapshotParams instance,
+func (p *CreateSnapshotFromVMSnapshotParams) ResetVolumeid() {
+	delete(p.p, "volumeid")
+}
+
+func (p *CreateSnapshotFromVMSnapshotParams) GetVolumeid() (string, bool) {
+	value, ok := p.p["volumeid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateSnapshotFromVMSnapshotParams instance,
 // as then you are sure you have configured all required params
-func (s *SnapshotService) NewCreateSnapshotParams(volumeid string) *CreateSnapshotParams {
-	p := &CreateSnapshotParams{}
+func (s *SnapshotService) NewCreateSnapshotFromVMSnapshotParams(vmsnapshotid string, volumeid string) *CreateSnapshotFromVMSnapshotParams {
+	p := &CreateSnapshotFromVMSnapshotParams{}
 	p.p = make(map[string]interface{})
+	p.p["vmsnapshotid"] = vmsnapshotid
 	p.p["volumeid"] = volumeid
 	return p
 }
 
-// Creates an instant snapshot of a volume.
-func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("createSnapshot", p.toURLValues())
+// Creates an instant snapshot of a volume from existing vm snapshot.
+func (s *SnapshotService) CreateSnapshotFromVMSnapshot(p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error) {
+	return s.CreateSnapshotFromVMSnapshotWithContext(context.Background(), p)
+}
+
+// CreateSnapshotFromVMSnapshotWithContext is the same as CreateSnapshotFromVMSnapshot, but uses ctx to cancel the request and any async job polling
+func (s *SnapshotService) CreateSnapshotFromVMSnapshotWithContext(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createSnapshotFromVMSnapshot", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateSnapshotResponse
+	var r CreateSnapshotFromVMSnapshotResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -179,7 +879,41 @@ func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapsh
 	return &r, nil
 }
 
-type CreateSnapshotResponse struct {
+// CreateSnapshotFromVMSnapshotAsync starts the async job for CreateSnapshotFromVMSnapshot and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateSnapshotFromVMSnapshotResponse using Job.Result
+func (s *SnapshotService) CreateSnapshotFromVMSnapshotAsync(p *CreateSnapshotFromVMSnapshotParams) (*Job, error) {
+	return s.CreateSnapshotFromVMSnapshotAsyncWithContext(context.Background(), p)
+}
+
+// CreateSnapshotFromVMSnapshotAsyncWithContext is the same as CreateSnapshotFromVMSnapshotAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SnapshotService) CreateSnapshotFromVMSnapshotAsyncWithContext(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createSnapshotFromVMSnapshot", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateSnapshotFromVMSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type CreateSnapshotFromVMSnapshotResponse struct {
 	Account       string `json:"account"`
 	Created       string `json:"created"`
 	Domain        string `json:"domain"`
@@ -206,7 +940,7 @@ type CreateSnapshotResponse struct {
 	Zoneid        string `json:"zoneid"`
 }
 
-func (r *CreateSnapshotResponse) UnmarshalJSON(b []byte) error {
+func (r *CreateSnapshotFromVMSnapshotResponse) UnmarshalJSON(b []byte) error {
 	var m map[string]interface{}
 	err := json.Unmarshal(b, &m)
 	if err != nil {
@@ -229,7 +963,7 @@ func (r *CreateSnapshotResponse) UnmarshalJSON(b []byte) error {
 		}
 	}
 
-	type alias CreateSnapshotResponse
+	type alias CreateSnapshotFromVMSnapshotResponse
 	return json.Unmarshal(b, (*alias)(r))
 }
 
@@ -237,7 +971,8 @@ type CreateSnapshotPolicyParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateSnapshotPolicyParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateSnapshotPolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -272,6 +1007,64 @@ func (p *CreateSnapshotPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateSnapshotPolicyParams) Validate() error {
+	v := newParamValidator("createSnapshotPolicy", p.p)
+	v.required("intervaltype", "maxsnaps", "schedule", "timezone", "volumeid")
+	v.ids("volumeid")
+	v.maxLength("intervaltype", 255)
+	v.oneOf("intervaltype", "HOURLY", "DAILY", "WEEKLY", "MONTHLY")
+	v.maxLength("schedule", 255)
+	v.maxLength("timezone", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateSnapshotPolicyParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateSnapshotPolicyParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "intervaltype", "schedule", "timezone", "volumeid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "maxsnaps":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createSnapshotPolicy", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createSnapshotPolicy: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateSnapshotPolicyParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -279,6 +1072,15 @@ func (p *CreateSnapshotPolicyParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *CreateSnapshotPolicyParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateSnapshotPolicyParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *CreateSnapshotPolicyParams) SetIntervaltype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -286,6 +1088,15 @@ func (p *CreateSnapshotPolicyParams) SetIntervaltype(v string) {
 	p.p["intervaltype"] = v
 }
 
+func (p *CreateSnapshotPolicyParams) ResetIntervaltype() {
+	delete(p.p, "intervaltype")
+}
+
+func (p *CreateSnapshotPolicyParams) GetIntervaltype() (string, bool) {
+	value, ok := p.p["intervaltype"].(string)
+	return value, ok
+}
+
 func (p *CreateSnapshotPolicyParams) SetMaxsnaps(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -293,6 +1104,15 @@ func (p *CreateSnapshotPolicyParams) SetMaxsnaps(v int) {
 	p.p["maxsnaps"] = v
 }
 
+func (p *CreateSnapshotPolicyParams) ResetMaxsnaps() {
+	delete(p.p, "maxsnaps")
+}
+
+func (p *CreateSnapshotPolicyParams) GetMaxsnaps() (int, bool) {
+	value, ok := p.p["maxsnaps"].(int)
+	return value, ok
+}
+
 func (p *CreateSnapshotPolicyParams) SetSchedule(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -300,6 +1120,15 @@ func (p *CreateSnapshotPolicyParams) SetSchedule(v string) {
 	p.p["schedule"] = v
 }
 
+func (p *CreateSnapshotPolicyParams) ResetSchedule() {
+	delete(p.p, "schedule")
+}
+
+func (p *CreateSnapshotPolicyParams) GetSchedule() (string, bool) {
+	value, ok := p.p["schedule"].(string)
+	return value, ok
+}
+
 func (p *CreateSnapshotPolicyParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -307,6 +1136,15 @@ func (p *CreateSnapshotPolicyParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *CreateSnapshotPolicyParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *CreateSnapshotPolicyParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 func (p *CreateSnapshotPolicyParams) SetTimezone(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -314,6 +1152,15 @@ func (p *CreateSnapshotPolicyParams) SetTimezone(v string) {
 	p.p["timezone"] = v
 }
 
+func (p *CreateSnapshotPolicyParams) ResetTimezone() {
+	delete(p.p, "timezone")
+}
+
+func (p *CreateSnapshotPolicyParams) GetTimezone() (string, bool) {
+	value, ok := p.p["timezone"].(string)
+	return value, ok
+}
+
 func (p *CreateSnapshotPolicyParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -321,6 +1168,15 @@ func (p *CreateSnapshotPolicyParams) SetVolumeid(v string) {
 	p.p["volumeid"] = v
 }
 
+func (p *CreateSnapshotPolicyParams) ResetVolumeid() {
+	delete(p.p, "volumeid")
+}
+
+func (p *CreateSnapshotPolicyParams) GetVolumeid() (string, bool) {
+	value, ok := p.p["volumeid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateSnapshotPolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewCreateSnapshotPolicyParams(intervaltype string, maxsnaps int, schedule string, timezone string, volumeid string) *CreateSnapshotPolicyParams {
@@ -336,7 +1192,16 @@ func (s *SnapshotService) NewCreateSnapshotPolicyParams(intervaltype string, max
 
 // Creates a snapshot policy for the account.
 func (s *SnapshotService) CreateSnapshotPolicy(p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error) {
-	resp, err := s.cs.newRequest("createSnapshotPolicy", p.toURLValues())
+	return s.CreateSnapshotPolicyWithContext(context.Background(), p)
+}
+
+// CreateSnapshotPolicyWithContext is the same as CreateSnapshotPolicy, but uses ctx to cancel the request and any async job polling
+func (s *SnapshotService) CreateSnapshotPolicyWithContext(ctx context.Context, p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createSnapshotPolicy", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -366,7 +1231,8 @@ type CreateVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateVMSnapshotParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateVMSnapshotParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -391,6 +1257,54 @@ func (p *CreateVMSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateVMSnapshotParams) Validate() error {
+	v := newParamValidator("createVMSnapshot", p.p)
+	v.required("virtualmachineid")
+	v.ids("virtualmachineid")
+	v.maxLength("description", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateVMSnapshotParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateVMSnapshotParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "description", "name", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "quiescevm", "snapshotmemory":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createVMSnapshot", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createVMSnapshot: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVMSnapshotParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -398,6 +1312,15 @@ func (p *CreateVMSnapshotParams) SetDescription(v string) {
 	p.p["description"] = v
 }
 
+func (p *CreateVMSnapshotParams) ResetDescription() {
+	delete(p.p, "description")
+}
+
+func (p *CreateVMSnapshotParams) GetDescription() (string, bool) {
+	value, ok := p.p["description"].(string)
+	return value, ok
+}
+
 func (p *CreateVMSnapshotParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -405,6 +1328,15 @@ func (p *CreateVMSnapshotParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateVMSnapshotParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateVMSnapshotParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateVMSnapshotParams) SetQuiescevm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -412,6 +1344,15 @@ func (p *CreateVMSnapshotParams) SetQuiescevm(v bool) {
 	p.p["quiescevm"] = v
 }
 
+func (p *CreateVMSnapshotParams) ResetQuiescevm() {
+	delete(p.p, "quiescevm")
+}
+
+func (p *CreateVMSnapshotParams) GetQuiescevm() (bool, bool) {
+	value, ok := p.p["quiescevm"].(bool)
+	return value, ok
+}
+
 func (p *CreateVMSnapshotParams) SetSnapshotmemory(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -419,6 +1360,15 @@ func (p *CreateVMSnapshotParams) SetSnapshotmemory(v bool) {
 	p.p["snapshotmemory"] = v
 }
 
+func (p *CreateVMSnapshotParams) ResetSnapshotmemory() {
+	delete(p.p, "snapshotmemory")
+}
+
+func (p *CreateVMSnapshotParams) GetSnapshotmemory() (bool, bool) {
+	value, ok := p.p["snapshotmemory"].(bool)
+	return value, ok
+}
+
 func (p *CreateVMSnapshotParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -426,6 +1376,15 @@ func (p *CreateVMSnapshotParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *CreateVMSnapshotParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *CreateVMSnapshotParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateVMSnapshotParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewCreateVMSnapshotParams(virtualmachineid string) *CreateVMSnapshotParams {
@@ -437,7 +1396,16 @@ func (s *SnapshotService) NewCreateVMSnapshotParams(virtualmachineid string) *Cr
 
 // Creates snapshot for a vm.
 func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("createVMSnapshot", p.toURLValues())
+	return s.CreateVMSnapshotWithContext(context.Background(), p)
+}
+
+// CreateVMSnapshotWithContext is the same as CreateVMSnapshot, but uses ctx to cancel the request and any async job polling
+func (s *SnapshotService) CreateVMSnapshotWithContext(ctx context.Context, p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVMSnapshot", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -449,7 +1417,7 @@ func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVM
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -470,6 +1438,40 @@ func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVM
 	return &r, nil
 }
 
+// CreateVMSnapshotAsync starts the async job for CreateVMSnapshot and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateVMSnapshotResponse using Job.Result
+func (s *SnapshotService) CreateVMSnapshotAsync(p *CreateVMSnapshotParams) (*Job, error) {
+	return s.CreateVMSnapshotAsyncWithContext(context.Background(), p)
+}
+
+// CreateVMSnapshotAsyncWithContext is the same as CreateVMSnapshotAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SnapshotService) CreateVMSnapshotAsyncWithContext(ctx context.Context, p *CreateVMSnapshotParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVMSnapshot", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVMSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateVMSnapshotResponse struct {
 	Account            string `json:"account"`
 	Created            string `json:"created"`
@@ -500,7 +1502,8 @@ type DeleteSnapshotParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteSnapshotParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteSnapshotParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -511,6 +1514,48 @@ func (p *DeleteSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteSnapshotParams) Validate() error {
+	v := newParamValidator("deleteSnapshot", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteSnapshotParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteSnapshotParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteSnapshot", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteSnapshot: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteSnapshotParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -518,6 +1563,15 @@ func (p *DeleteSnapshotParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteSnapshotParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteSnapshotParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteSnapshotParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewDeleteSnapshotParams(id string) *DeleteSnapshotParams {
@@ -529,7 +1583,16 @@ func (s *SnapshotService) NewDeleteSnapshotParams(id string) *DeleteSnapshotPara
 
 // Deletes a snapshot of a disk volume.
 func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("deleteSnapshot", p.toURLValues())
+	return s.DeleteSnapshotWithContext(context.Background(), p)
+}
+
+// DeleteSnapshotWithContext is the same as DeleteSnapshot, but uses ctx to cancel the request and any async job polling
+func (s *SnapshotService) DeleteSnapshotWithContext(ctx context.Context, p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSnapshot", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -541,7 +1604,7 @@ func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapsh
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -557,6 +1620,31 @@ func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapsh
 	return &r, nil
 }
 
+// DeleteSnapshotAsync starts the async job for DeleteSnapshot and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteSnapshotResponse using Job.Result
+func (s *SnapshotService) DeleteSnapshotAsync(p *DeleteSnapshotParams) (*Job, error) {
+	return s.DeleteSnapshotAsyncWithContext(context.Background(), p)
+}
+
+// DeleteSnapshotAsyncWithContext is the same as DeleteSnapshotAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SnapshotService) DeleteSnapshotAsyncWithContext(ctx context.Context, p *DeleteSnapshotParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSnapshot", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteSnapshotResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -568,7 +1656,8 @@ type DeleteSnapshotPoliciesParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteSnapshotPoliciesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteSnapshotPoliciesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -583,6 +1672,51 @@ func (p *DeleteSnapshotPoliciesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteSnapshotPoliciesParams) Validate() error {
+	v := newParamValidator("deleteSnapshotPolicies", p.p)
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteSnapshotPoliciesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteSnapshotPoliciesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteSnapshotPolicies", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteSnapshotPolicies: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteSnapshotPoliciesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -590,6 +1724,15 @@ func (p *DeleteSnapshotPoliciesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteSnapshotPoliciesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteSnapshotPoliciesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *DeleteSnapshotPoliciesParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -597,6 +1740,15 @@ func (p *DeleteSnapshotPoliciesParams) SetIds(v []string) {
 	p.p["ids"] = v
 }
 
+func (p *DeleteSnapshotPoliciesParams) ResetIds() {
+	delete(p.p, "ids")
+}
+
+func (p *DeleteSnapshotPoliciesParams) GetIds() ([]string, bool) {
+	value, ok := p.p["ids"].([]string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteSnapshotPoliciesParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewDeleteSnapshotPoliciesParams() *DeleteSnapshotPoliciesParams {
@@ -607,7 +1759,16 @@ func (s *SnapshotService) NewDeleteSnapshotPoliciesParams() *DeleteSnapshotPolic
 
 // Deletes snapshot policies for the account.
 func (s *SnapshotService) DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("deleteSnapshotPolicies", p.toURLValues())
+	return s.DeleteSnapshotPoliciesWithContext(context.Background(), p)
+}
+
+// DeleteSnapshotPoliciesWithContext is the same as DeleteSnapshotPolicies, but uses ctx to cancel the request and any async job polling
+func (s *SnapshotService) DeleteSnapshotPoliciesWithContext(ctx context.Context, p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteSnapshotPolicies", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -658,15 +1819,58 @@ type DeleteVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteVMSnapshotParams) toURLValues() url.Values {
-	u := url.Values{}
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteVMSnapshotParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["vmsnapshotid"]; found {
+		u.Set("vmsnapshotid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteVMSnapshotParams) Validate() error {
+	v := newParamValidator("deleteVMSnapshot", p.p)
+	v.required("vmsnapshotid")
+	v.ids("vmsnapshotid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteVMSnapshotParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		return u
+		return []byte("{}"), nil
 	}
-	if v, found := p.p["vmsnapshotid"]; found {
-		u.Set("vmsnapshotid", v.(string))
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteVMSnapshotParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	return u
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "vmsnapshotid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteVMSnapshot", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteVMSnapshot: %w", k, err)
+		}
+	}
+	return nil
 }
 
 func (p *DeleteVMSnapshotParams) SetVmsnapshotid(v string) {
@@ -676,6 +1880,15 @@ func (p *DeleteVMSnapshotParams) SetVmsnapshotid(v string) {
 	p.p["vmsnapshotid"] = v
 }
 
+func (p *DeleteVMSnapshotParams) ResetVmsnapshotid() {
+	delete(p.p, "vmsnapshotid")
+}
+
+func (p *DeleteVMSnapshotParams) GetVmsnapshotid() (string, bool) {
+	value, ok := p.p["vmsnapshotid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteVMSnapshotParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewDeleteVMSnapshotParams(vmsnapshotid string) *DeleteVMSnapshotParams {
@@ -687,7 +1900,16 @@ func (s *SnapshotService) NewDeleteVMSnapshotParams(vmsnapshotid string) *Delete
 
 // Deletes a vmsnapshot.
 func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("deleteVMSnapshot", p.toURLValues())
+	return s.DeleteVMSnapshotWithContext(context.Background(), p)
+}
+
+// DeleteVMSnapshotWithContext is the same as DeleteVMSnapshot, but uses ctx to cancel the request and any async job polling
+func (s *SnapshotService) DeleteVMSnapshotWithContext(ctx context.Context, p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVMSnapshot", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -699,7 +1921,7 @@ func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVM
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -715,6 +1937,31 @@ func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVM
 	return &r, nil
 }
 
+// DeleteVMSnapshotAsync starts the async job for DeleteVMSnapshot and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteVMSnapshotResponse using Job.Result
+func (s *SnapshotService) DeleteVMSnapshotAsync(p *DeleteVMSnapshotParams) (*Job, error) {
+	return s.DeleteVMSnapshotAsyncWithContext(context.Background(), p)
+}
+
+// DeleteVMSnapshotAsyncWithContext is the same as DeleteVMSnapshotAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SnapshotService) DeleteVMSnapshotAsyncWithContext(ctx context.Context, p *DeleteVMSnapshotParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVMSnapshot", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVMSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteVMSnapshotResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -726,7 +1973,8 @@ type ListSnapshotPoliciesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListSnapshotPoliciesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListSnapshotPoliciesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -755,6 +2003,56 @@ func (p *ListSnapshotPoliciesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListSnapshotPoliciesParams) Validate() error {
+	v := newParamValidator("listSnapshotPolicies", p.p)
+	v.ids("id", "volumeid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListSnapshotPoliciesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListSnapshotPoliciesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "keyword", "volumeid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listSnapshotPolicies", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listSnapshotPolicies: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListSnapshotPoliciesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -762,6 +2060,15 @@ func (p *ListSnapshotPoliciesParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListSnapshotPoliciesParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListSnapshotPoliciesParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListSnapshotPoliciesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -769,6 +2076,15 @@ func (p *ListSnapshotPoliciesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListSnapshotPoliciesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListSnapshotPoliciesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListSnapshotPoliciesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -776,6 +2092,15 @@ func (p *ListSnapshotPoliciesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListSnapshotPoliciesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListSnapshotPoliciesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListSnapshotPoliciesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -783,6 +2108,15 @@ func (p *ListSnapshotPoliciesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListSnapshotPoliciesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListSnapshotPoliciesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListSnapshotPoliciesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -790,6 +2124,15 @@ func (p *ListSnapshotPoliciesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListSnapshotPoliciesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListSnapshotPoliciesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListSnapshotPoliciesParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -797,6 +2140,15 @@ func (p *ListSnapshotPoliciesParams) SetVolumeid(v string) {
 	p.p["volumeid"] = v
 }
 
+func (p *ListSnapshotPoliciesParams) ResetVolumeid() {
+	delete(p.p, "volumeid")
+}
+
+func (p *ListSnapshotPoliciesParams) GetVolumeid() (string, bool) {
+	value, ok := p.p["volumeid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListSnapshotPoliciesParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewListSnapshotPoliciesParams() *ListSnapshotPoliciesParams {
@@ -807,6 +2159,11 @@ func (s *SnapshotService) NewListSnapshotPoliciesParams() *ListSnapshotPoliciesP
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetSnapshotPolicyByID(id string, opts ...OptionFunc) (*SnapshotPolicy, int, error) {
+	return s.GetSnapshotPolicyByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetSnapshotPolicyByIDWithContext is the same as GetSnapshotPolicyByID, but uses ctx to cancel the request
+func (s *SnapshotService) GetSnapshotPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SnapshotPolicy, int, error) {
 	p := &ListSnapshotPoliciesParams{}
 	p.p = make(map[string]interface{})
 
@@ -818,18 +2175,16 @@ func (s *SnapshotService) GetSnapshotPolicyByID(id string, opts ...OptionFunc) (
 		}
 	}
 
-	l, err := s.ListSnapshotPolicies(p)
+	l, err := s.ListSnapshotPoliciesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -840,7 +2195,16 @@ func (s *SnapshotService) GetSnapshotPolicyByID(id string, opts ...OptionFunc) (
 
 // Lists snapshot policies.
 func (s *SnapshotService) ListSnapshotPolicies(p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listSnapshotPolicies", p.toURLValues())
+	return s.ListSnapshotPoliciesWithContext(context.Background(), p)
+}
+
+// ListSnapshotPoliciesWithContext is the same as ListSnapshotPolicies, but uses ctx to cancel the request and any async job polling
+func (s *SnapshotService) ListSnapshotPoliciesWithContext(ctx context.Context, p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listSnapshotPolicies", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -853,6 +2217,92 @@ func (s *SnapshotService) ListSnapshotPolicies(p *ListSnapshotPoliciesParams) (*
 	return &r, nil
 }
 
+// ListSnapshotPoliciesPager iterates over all pages of a ListSnapshotPolicies call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListSnapshotPoliciesPager struct {
+	pager
+
+	s *SnapshotService
+	p *ListSnapshotPoliciesParams
+	r *ListSnapshotPoliciesResponse
+}
+
+// NewListSnapshotPoliciesPager returns a pager for all pages of a ListSnapshotPolicies call with the given params
+func (s *SnapshotService) NewListSnapshotPoliciesPager(p *ListSnapshotPoliciesParams) *ListSnapshotPoliciesPager {
+	return &ListSnapshotPoliciesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListSnapshotPoliciesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListSnapshotPoliciesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListSnapshotPoliciesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListSnapshotPoliciesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.SnapshotPolicies), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListSnapshotPoliciesPager) Page() *ListSnapshotPoliciesResponse {
+	return pg.r
+}
+
+// ListSnapshotPoliciesAll fetches all pages of a ListSnapshotPolicies call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *SnapshotService) ListSnapshotPoliciesAll(p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error) {
+	return s.ListSnapshotPoliciesAllWithContext(context.Background(), p)
+}
+
+// ListSnapshotPoliciesAllWithContext is the same as ListSnapshotPoliciesAll, but uses ctx to cancel the requests
+func (s *SnapshotService) ListSnapshotPoliciesAllWithContext(ctx context.Context, p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*SnapshotPolicy)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListSnapshotPoliciesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListSnapshotPoliciesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.SnapshotPolicies
+		mu.Unlock()
+
+		return len(l.SnapshotPolicies), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListSnapshotPoliciesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.SnapshotPolicies = append(r.SnapshotPolicies, pages[page]...)
+	}
+	r.Count = len(r.SnapshotPolicies)
+
+	return r, nil
+}
+
 type ListSnapshotPoliciesResponse struct {
 	Count            int               `json:"count"`
 	SnapshotPolicies []*SnapshotPolicy `json:"snapshotpolicy"`
@@ -875,7 +2325,8 @@ type ListSnapshotsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListSnapshotsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListSnapshotsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -940,6 +2391,69 @@ func (p *ListSnapshotsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListSnapshotsParams) Validate() error {
+	v := newParamValidator("listSnapshots", p.p)
+	v.ids("domainid", "id", "projectid", "volumeid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("intervaltype", 255)
+	v.oneOf("intervaltype", "HOURLY", "DAILY", "WEEKLY", "MONTHLY")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("snapshottype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListSnapshotsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListSnapshotsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "intervaltype", "keyword", "name", "projectid", "snapshottype", "volumeid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listSnapshots", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listSnapshots: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListSnapshotsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -947,6 +2461,15 @@ func (p *ListSnapshotsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListSnapshotsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListSnapshotsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -954,6 +2477,15 @@ func (p *ListSnapshotsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListSnapshotsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListSnapshotsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -961,6 +2493,15 @@ func (p *ListSnapshotsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListSnapshotsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListSnapshotsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -968,6 +2509,15 @@ func (p *ListSnapshotsParams) SetIds(v []string) {
 	p.p["ids"] = v
 }
 
+func (p *ListSnapshotsParams) ResetIds() {
+	delete(p.p, "ids")
+}
+
+func (p *ListSnapshotsParams) GetIds() ([]string, bool) {
+	value, ok := p.p["ids"].([]string)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetIntervaltype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -975,6 +2525,15 @@ func (p *ListSnapshotsParams) SetIntervaltype(v string) {
 	p.p["intervaltype"] = v
 }
 
+func (p *ListSnapshotsParams) ResetIntervaltype() {
+	delete(p.p, "intervaltype")
+}
+
+func (p *ListSnapshotsParams) GetIntervaltype() (string, bool) {
+	value, ok := p.p["intervaltype"].(string)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -982,6 +2541,15 @@ func (p *ListSnapshotsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListSnapshotsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListSnapshotsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -989,6 +2557,15 @@ func (p *ListSnapshotsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListSnapshotsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListSnapshotsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -996,6 +2573,15 @@ func (p *ListSnapshotsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListSnapshotsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListSnapshotsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1003,6 +2589,15 @@ func (p *ListSnapshotsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListSnapshotsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListSnapshotsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1010,6 +2605,15 @@ func (p *ListSnapshotsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListSnapshotsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListSnapshotsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1017,6 +2621,15 @@ func (p *ListSnapshotsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListSnapshotsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListSnapshotsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1024,6 +2637,15 @@ func (p *ListSnapshotsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListSnapshotsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListSnapshotsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetSnapshottype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1031,6 +2653,15 @@ func (p *ListSnapshotsParams) SetSnapshottype(v string) {
 	p.p["snapshottype"] = v
 }
 
+func (p *ListSnapshotsParams) ResetSnapshottype() {
+	delete(p.p, "snapshottype")
+}
+
+func (p *ListSnapshotsParams) GetSnapshottype() (string, bool) {
+	value, ok := p.p["snapshottype"].(string)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1038,6 +2669,15 @@ func (p *ListSnapshotsParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListSnapshotsParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListSnapshotsParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1045,6 +2685,15 @@ func (p *ListSnapshotsParams) SetVolumeid(v string) {
 	p.p["volumeid"] = v
 }
 
+func (p *ListSnapshotsParams) ResetVolumeid() {
+	delete(p.p, "volumeid")
+}
+
+func (p *ListSnapshotsParams) GetVolumeid() (string, bool) {
+	value, ok := p.p["volumeid"].(string)
+	return value, ok
+}
+
 func (p *ListSnapshotsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1052,6 +2701,15 @@ func (p *ListSnapshotsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListSnapshotsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListSnapshotsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListSnapshotsParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewListSnapshotsParams() *ListSnapshotsParams {
@@ -1062,6 +2720,11 @@ func (s *SnapshotService) NewListSnapshotsParams() *ListSnapshotsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSnapshotIDWithContext(context.Background(), name, opts...)
+}
+
+// GetSnapshotIDWithContext is the same as GetSnapshotID, but uses ctx to cancel the request
+func (s *SnapshotService) GetSnapshotIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSnapshotsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1073,7 +2736,7 @@ func (s *SnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string
 		}
 	}
 
-	l, err := s.ListSnapshots(p)
+	l, err := s.ListSnapshotsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1098,12 +2761,17 @@ func (s *SnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetSnapshotByName(name string, opts ...OptionFunc) (*Snapshot, int, error) {
-	id, count, err := s.GetSnapshotID(name, opts...)
+	return s.GetSnapshotByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetSnapshotByNameWithContext is the same as GetSnapshotByName, but uses ctx to cancel the requests
+func (s *SnapshotService) GetSnapshotByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Snapshot, int, error) {
+	id, count, err := s.GetSnapshotIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetSnapshotByID(id, opts...)
+	r, count, err := s.GetSnapshotByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1112,6 +2780,11 @@ func (s *SnapshotService) GetSnapshotByName(name string, opts ...OptionFunc) (*S
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetSnapshotByID(id string, opts ...OptionFunc) (*Snapshot, int, error) {
+	return s.GetSnapshotByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetSnapshotByIDWithContext is the same as GetSnapshotByID, but uses ctx to cancel the request
+func (s *SnapshotService) GetSnapshotByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Snapshot, int, error) {
 	p := &ListSnapshotsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1123,18 +2796,16 @@ func (s *SnapshotService) GetSnapshotByID(id string, opts ...OptionFunc) (*Snaps
 		}
 	}
 
-	l, err := s.ListSnapshots(p)
+	l, err := s.ListSnapshotsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1145,7 +2816,16 @@ func (s *SnapshotService) GetSnapshotByID(id string, opts ...OptionFunc) (*Snaps
 
 // Lists all available snapshots for the account.
 func (s *SnapshotService) ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
-	resp, err := s.cs.newRequest("listSnapshots", p.toURLValues())
+	return s.ListSnapshotsWithContext(context.Background(), p)
+}
+
+// ListSnapshotsWithContext is the same as ListSnapshots, but uses ctx to cancel the request and any async job polling
+func (s *SnapshotService) ListSnapshotsWithContext(ctx context.Context, p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listSnapshots", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1158,6 +2838,92 @@ func (s *SnapshotService) ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsR
 	return &r, nil
 }
 
+// ListSnapshotsPager iterates over all pages of a ListSnapshots call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListSnapshotsPager struct {
+	pager
+
+	s *SnapshotService
+	p *ListSnapshotsParams
+	r *ListSnapshotsResponse
+}
+
+// NewListSnapshotsPager returns a pager for all pages of a ListSnapshots call with the given params
+func (s *SnapshotService) NewListSnapshotsPager(p *ListSnapshotsParams) *ListSnapshotsPager {
+	return &ListSnapshotsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListSnapshotsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListSnapshotsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListSnapshotsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListSnapshotsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Snapshots), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListSnapshotsPager) Page() *ListSnapshotsResponse {
+	return pg.r
+}
+
+// ListSnapshotsAll fetches all pages of a ListSnapshots call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *SnapshotService) ListSnapshotsAll(p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
+	return s.ListSnapshotsAllWithContext(context.Background(), p)
+}
+
+// ListSnapshotsAllWithContext is the same as ListSnapshotsAll, but uses ctx to cancel the requests
+func (s *SnapshotService) ListSnapshotsAllWithContext(ctx context.Context, p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Snapshot)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListSnapshotsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListSnapshotsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Snapshots
+		mu.Unlock()
+
+		return len(l.Snapshots), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListSnapshotsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Snapshots = append(r.Snapshots, pages[page]...)
+	}
+	r.Count = len(r.Snapshots)
+
+	return r, nil
+}
+
 type ListSnapshotsResponse struct {
 	Count     int         `json:"count"`
 	Snapshots []*Snapshot `json:"snapshot"`
@@ -1221,7 +2987,8 @@ type ListVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListVMSnapshotParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVMSnapshotParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1270,14 +3037,75 @@ func (p *ListVMSnapshotParams) toURLValues() url.Values {
 	if v, found := p.p["virtualmachineid"]; found {
 		u.Set("virtualmachineid", v.(string))
 	}
-	if v, found := p.p["vmsnapshotid"]; found {
-		u.Set("vmsnapshotid", v.(string))
+	if v, found := p.p["vmsnapshotid"]; found {
+		u.Set("vmsnapshotid", v.(string))
+	}
+	if v, found := p.p["vmsnapshotids"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("vmsnapshotids", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVMSnapshotParams) Validate() error {
+	v := newParamValidator("listVMSnapshot", p.p)
+	v.ids("domainid", "projectid", "virtualmachineid", "vmsnapshotid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVMSnapshotParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVMSnapshotParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	if v, found := p.p["vmsnapshotids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("vmsnapshotids", vv)
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "keyword", "name", "projectid", "state", "virtualmachineid", "vmsnapshotid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "vmsnapshotids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVMSnapshot", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVMSnapshot: %w", k, err)
+		}
 	}
-	return u
+	return nil
 }
 
 func (p *ListVMSnapshotParams) SetAccount(v string) {
@@ -1287,6 +3115,15 @@ func (p *ListVMSnapshotParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListVMSnapshotParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListVMSnapshotParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListVMSnapshotParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1294,6 +3131,15 @@ func (p *ListVMSnapshotParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListVMSnapshotParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListVMSnapshotParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListVMSnapshotParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1301,6 +3147,15 @@ func (p *ListVMSnapshotParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListVMSnapshotParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListVMSnapshotParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListVMSnapshotParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1308,6 +3163,15 @@ func (p *ListVMSnapshotParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListVMSnapshotParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVMSnapshotParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListVMSnapshotParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1315,6 +3179,15 @@ func (p *ListVMSnapshotParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListVMSnapshotParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListVMSnapshotParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListVMSnapshotParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1322,6 +3195,15 @@ func (p *ListVMSnapshotParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListVMSnapshotParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListVMSnapshotParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListVMSnapshotParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1329,6 +3211,15 @@ func (p *ListVMSnapshotParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListVMSnapshotParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVMSnapshotParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListVMSnapshotParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1336,6 +3227,15 @@ func (p *ListVMSnapshotParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListVMSnapshotParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVMSnapshotParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListVMSnapshotParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1343,6 +3243,15 @@ func (p *ListVMSnapshotParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListVMSnapshotParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListVMSnapshotParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListVMSnapshotParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1350,6 +3259,15 @@ func (p *ListVMSnapshotParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListVMSnapshotParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListVMSnapshotParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListVMSnapshotParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1357,6 +3275,15 @@ func (p *ListVMSnapshotParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListVMSnapshotParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListVMSnapshotParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 func (p *ListVMSnapshotParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1364,6 +3291,15 @@ func (p *ListVMSnapshotParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *ListVMSnapshotParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *ListVMSnapshotParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 func (p *ListVMSnapshotParams) SetVmsnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1371,6 +3307,15 @@ func (p *ListVMSnapshotParams) SetVmsnapshotid(v string) {
 	p.p["vmsnapshotid"] = v
 }
 
+func (p *ListVMSnapshotParams) ResetVmsnapshotid() {
+	delete(p.p, "vmsnapshotid")
+}
+
+func (p *ListVMSnapshotParams) GetVmsnapshotid() (string, bool) {
+	value, ok := p.p["vmsnapshotid"].(string)
+	return value, ok
+}
+
 func (p *ListVMSnapshotParams) SetVmsnapshotids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1378,6 +3323,15 @@ func (p *ListVMSnapshotParams) SetVmsnapshotids(v []string) {
 	p.p["vmsnapshotids"] = v
 }
 
+func (p *ListVMSnapshotParams) ResetVmsnapshotids() {
+	delete(p.p, "vmsnapshotids")
+}
+
+func (p *ListVMSnapshotParams) GetVmsnapshotids() ([]string, bool) {
+	value, ok := p.p["vmsnapshotids"].([]string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListVMSnapshotParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewListVMSnapshotParams() *ListVMSnapshotParams {
@@ -1388,6 +3342,11 @@ func (s *SnapshotService) NewListVMSnapshotParams() *ListVMSnapshotParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVMSnapshotIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVMSnapshotIDWithContext is the same as GetVMSnapshotID, but uses ctx to cancel the request
+func (s *SnapshotService) GetVMSnapshotIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVMSnapshotParams{}
 	p.p = make(map[string]interface{})
 
@@ -1399,7 +3358,7 @@ func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (stri
 		}
 	}
 
-	l, err := s.ListVMSnapshot(p)
+	l, err := s.ListVMSnapshotWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1424,7 +3383,16 @@ func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (stri
 
 // List virtual machine snapshot by conditions
 func (s *SnapshotService) ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("listVMSnapshot", p.toURLValues())
+	return s.ListVMSnapshotWithContext(context.Background(), p)
+}
+
+// ListVMSnapshotWithContext is the same as ListVMSnapshot, but uses ctx to cancel the request and any async job polling
+func (s *SnapshotService) ListVMSnapshotWithContext(ctx context.Context, p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVMSnapshot", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1437,6 +3405,92 @@ func (s *SnapshotService) ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapsh
 	return &r, nil
 }
 
+// ListVMSnapshotPager iterates over all pages of a ListVMSnapshot call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVMSnapshotPager struct {
+	pager
+
+	s *SnapshotService
+	p *ListVMSnapshotParams
+	r *ListVMSnapshotResponse
+}
+
+// NewListVMSnapshotPager returns a pager for all pages of a ListVMSnapshot call with the given params
+func (s *SnapshotService) NewListVMSnapshotPager(p *ListVMSnapshotParams) *ListVMSnapshotPager {
+	return &ListVMSnapshotPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVMSnapshotPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVMSnapshotPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVMSnapshotParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVMSnapshotWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.VMSnapshot), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVMSnapshotPager) Page() *ListVMSnapshotResponse {
+	return pg.r
+}
+
+// ListVMSnapshotAll fetches all pages of a ListVMSnapshot call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *SnapshotService) ListVMSnapshotAll(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
+	return s.ListVMSnapshotAllWithContext(context.Background(), p)
+}
+
+// ListVMSnapshotAllWithContext is the same as ListVMSnapshotAll, but uses ctx to cancel the requests
+func (s *SnapshotService) ListVMSnapshotAllWithContext(ctx context.Context, p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*VMSnapshot)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVMSnapshotParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVMSnapshotWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.VMSnapshot
+		mu.Unlock()
+
+		return len(l.VMSnapshot), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVMSnapshotResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.VMSnapshot = append(r.VMSnapshot, pages[page]...)
+	}
+	r.Count = len(r.VMSnapshot)
+
+	return r, nil
+}
+
 type ListVMSnapshotResponse struct {
 	Count      int           `json:"count"`
 	VMSnapshot []*VMSnapshot `json:"vmsnapshot"`
@@ -1472,7 +3526,8 @@ type RevertSnapshotParams struct {
 	p map[string]interface{}
 }
 
-func (p *RevertSnapshotParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RevertSnapshotParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1483,6 +3538,48 @@ func (p *RevertSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RevertSnapshotParams) Validate() error {
+	v := newParamValidator("revertSnapshot", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RevertSnapshotParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RevertSnapshotParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for revertSnapshot", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of revertSnapshot: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RevertSnapshotParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1490,6 +3587,15 @@ func (p *RevertSnapshotParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RevertSnapshotParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RevertSnapshotParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RevertSnapshotParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewRevertSnapshotParams(id string) *RevertSnapshotParams {
@@ -1501,7 +3607,16 @@ func (s *SnapshotService) NewRevertSnapshotParams(id string) *RevertSnapshotPara
 
 // This is supposed to revert a volume snapshot. This command is only supported with KVM so far
 func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("revertSnapshot", p.toURLValues())
+	return s.RevertSnapshotWithContext(context.Background(), p)
+}
+
+// RevertSnapshotWithContext is the same as RevertSnapshot, but uses ctx to cancel the request and any async job polling
+func (s *SnapshotService) RevertSnapshotWithContext(ctx context.Context, p *RevertSnapshotParams) (*RevertSnapshotResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "revertSnapshot", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1513,7 +3628,7 @@ func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapsh
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1534,6 +3649,40 @@ func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapsh
 	return &r, nil
 }
 
+// RevertSnapshotAsync starts the async job for RevertSnapshot and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RevertSnapshotResponse using Job.Result
+func (s *SnapshotService) RevertSnapshotAsync(p *RevertSnapshotParams) (*Job, error) {
+	return s.RevertSnapshotAsyncWithContext(context.Background(), p)
+}
+
+// RevertSnapshotAsyncWithContext is the same as RevertSnapshotAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SnapshotService) RevertSnapshotAsyncWithContext(ctx context.Context, p *RevertSnapshotParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "revertSnapshot", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RevertSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type RevertSnapshotResponse struct {
 	Account       string `json:"account"`
 	Created       string `json:"created"`
@@ -1592,7 +3741,8 @@ type RevertToVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
-func (p *RevertToVMSnapshotParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RevertToVMSnapshotParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1603,6 +3753,48 @@ func (p *RevertToVMSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RevertToVMSnapshotParams) Validate() error {
+	v := newParamValidator("revertToVMSnapshot", p.p)
+	v.required("vmsnapshotid")
+	v.ids("vmsnapshotid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RevertToVMSnapshotParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RevertToVMSnapshotParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "vmsnapshotid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for revertToVMSnapshot", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of revertToVMSnapshot: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RevertToVMSnapshotParams) SetVmsnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1610,6 +3802,15 @@ func (p *RevertToVMSnapshotParams) SetVmsnapshotid(v string) {
 	p.p["vmsnapshotid"] = v
 }
 
+func (p *RevertToVMSnapshotParams) ResetVmsnapshotid() {
+	delete(p.p, "vmsnapshotid")
+}
+
+func (p *RevertToVMSnapshotParams) GetVmsnapshotid() (string, bool) {
+	value, ok := p.p["vmsnapshotid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RevertToVMSnapshotParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewRevertToVMSnapshotParams(vmsnapshotid string) *RevertToVMSnapshotParams {
@@ -1621,7 +3822,16 @@ func (s *SnapshotService) NewRevertToVMSnapshotParams(vmsnapshotid string) *Reve
 
 // Revert VM from a vmsnapshot.
 func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("revertToVMSnapshot", p.toURLValues())
+	return s.RevertToVMSnapshotWithContext(context.Background(), p)
+}
+
+// RevertToVMSnapshotWithContext is the same as RevertToVMSnapshot, but uses ctx to cancel the request and any async job polling
+func (s *SnapshotService) RevertToVMSnapshotWithContext(ctx context.Context, p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "revertToVMSnapshot", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1633,7 +3843,7 @@ func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*Reve
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1654,6 +3864,40 @@ func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*Reve
 	return &r, nil
 }
 
+// RevertToVMSnapshotAsync starts the async job for RevertToVMSnapshot and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RevertToVMSnapshotResponse using Job.Result
+func (s *SnapshotService) RevertToVMSnapshotAsync(p *RevertToVMSnapshotParams) (*Job, error) {
+	return s.RevertToVMSnapshotAsyncWithContext(context.Background(), p)
+}
+
+// RevertToVMSnapshotAsyncWithContext is the same as RevertToVMSnapshotAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SnapshotService) RevertToVMSnapshotAsyncWithContext(ctx context.Context, p *RevertToVMSnapshotParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "revertToVMSnapshot", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RevertToVMSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type RevertToVMSnapshotResponse struct {
 	Account               string                                    `json:"account"`
 	Affinitygroup         []RevertToVMSnapshotResponseAffinitygroup `json:"affinitygroup"`
@@ -1801,7 +4045,8 @@ type UpdateSnapshotPolicyParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateSnapshotPolicyParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateSnapshotPolicyParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1819,6 +4064,52 @@ func (p *UpdateSnapshotPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateSnapshotPolicyParams) Validate() error {
+	v := newParamValidator("updateSnapshotPolicy", p.p)
+	v.ids("id")
+	v.maxLength("customid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateSnapshotPolicyParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateSnapshotPolicyParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateSnapshotPolicy", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateSnapshotPolicy: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateSnapshotPolicyParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1826,6 +4117,15 @@ func (p *UpdateSnapshotPolicyParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateSnapshotPolicyParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateSnapshotPolicyParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateSnapshotPolicyParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1833,6 +4133,15 @@ func (p *UpdateSnapshotPolicyParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateSnapshotPolicyParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateSnapshotPolicyParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateSnapshotPolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1840,6 +4149,15 @@ func (p *UpdateSnapshotPolicyParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateSnapshotPolicyParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateSnapshotPolicyParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateSnapshotPolicyParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewUpdateSnapshotPolicyParams() *UpdateSnapshotPolicyParams {
@@ -1850,7 +4168,16 @@ func (s *SnapshotService) NewUpdateSnapshotPolicyParams() *UpdateSnapshotPolicyP
 
 // Updates the snapshot policy.
 func (s *SnapshotService) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateSnapshotPolicy", p.toURLValues())
+	return s.UpdateSnapshotPolicyWithContext(context.Background(), p)
+}
+
+// UpdateSnapshotPolicyWithContext is the same as UpdateSnapshotPolicy, but uses ctx to cancel the request and any async job polling
+func (s *SnapshotService) UpdateSnapshotPolicyWithContext(ctx context.Context, p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateSnapshotPolicy", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1862,7 +4189,7 @@ func (s *SnapshotService) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1883,6 +4210,40 @@ func (s *SnapshotService) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*
 	return &r, nil
 }
 
+// UpdateSnapshotPolicyAsync starts the async job for UpdateSnapshotPolicy and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateSnapshotPolicyResponse using Job.Result
+func (s *SnapshotService) UpdateSnapshotPolicyAsync(p *UpdateSnapshotPolicyParams) (*Job, error) {
+	return s.UpdateSnapshotPolicyAsyncWithContext(context.Background(), p)
+}
+
+// UpdateSnapshotPolicyAsyncWithContext is the same as UpdateSnapshotPolicyAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SnapshotService) UpdateSnapshotPolicyAsyncWithContext(ctx context.Context, p *UpdateSnapshotPolicyParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateSnapshotPolicy", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateSnapshotPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateSnapshotPolicyResponse struct {
 	Fordisplay   bool   `json:"fordisplay"`
 	Id           string `json:"id"`
@@ -20,16 +20,41 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// StoragePoolServiceIface is the interface implemented by StoragePoolService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type StoragePoolServiceIface interface {
+	NewCancelStorageMaintenanceParams(id string) *CancelStorageMaintenanceParams
+	CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error)
+	CancelStorageMaintenanceWithContext(ctx context.Context, p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error)
+	CancelStorageMaintenanceAsync(p *CancelStorageMaintenanceParams) (*Job, error)
+	CancelStorageMaintenanceAsyncWithContext(ctx context.Context, p *CancelStorageMaintenanceParams) (*Job, error)
+	NewEnableStorageMaintenanceParams(id string) *EnableStorageMaintenanceParams
+	EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error)
+	EnableStorageMaintenanceWithContext(ctx context.Context, p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error)
+	EnableStorageMaintenanceAsync(p *EnableStorageMaintenanceParams) (*Job, error)
+	EnableStorageMaintenanceAsyncWithContext(ctx context.Context, p *EnableStorageMaintenanceParams) (*Job, error)
+	NewListStorageProvidersParams(storagePoolType string) *ListStorageProvidersParams
+	ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error)
+	ListStorageProvidersWithContext(ctx context.Context, p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error)
+	NewListStorageProvidersPager(p *ListStorageProvidersParams) *ListStorageProvidersPager
+	ListStorageProvidersAll(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error)
+	ListStorageProvidersAllWithContext(ctx context.Context, p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error)
+}
+
 type CancelStorageMaintenanceParams struct {
 	p map[string]interface{}
 }
 
-func (p *CancelStorageMaintenanceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CancelStorageMaintenanceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -40,6 +65,48 @@ func (p *CancelStorageMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CancelStorageMaintenanceParams) Validate() error {
+	v := newParamValidator("cancelStorageMaintenance", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CancelStorageMaintenanceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CancelStorageMaintenanceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for cancelStorageMaintenance", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of cancelStorageMaintenance: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CancelStorageMaintenanceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -47,6 +114,15 @@ func (p *CancelStorageMaintenanceParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *CancelStorageMaintenanceParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *CancelStorageMaintenanceParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CancelStorageMaintenanceParams instance,
 // as then you are sure you have configured all required params
 func (s *StoragePoolService) NewCancelStorageMaintenanceParams(id string) *CancelStorageMaintenanceParams {
@@ -58,7 +134,16 @@ func (s *StoragePoolService) NewCancelStorageMaintenanceParams(id string) *Cance
 
 // Cancels maintenance for primary storage
 func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("cancelStorageMaintenance", p.toURLValues())
+	return s.CancelStorageMaintenanceWithContext(context.Background(), p)
+}
+
+// CancelStorageMaintenanceWithContext is the same as CancelStorageMaintenance, but uses ctx to cancel the request and any async job polling
+func (s *StoragePoolService) CancelStorageMaintenanceWithContext(ctx context.Context, p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "cancelStorageMaintenance", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +155,7 @@ func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -91,6 +176,40 @@ func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanc
 	return &r, nil
 }
 
+// CancelStorageMaintenanceAsync starts the async job for CancelStorageMaintenance and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CancelStorageMaintenanceResponse using Job.Result
+func (s *StoragePoolService) CancelStorageMaintenanceAsync(p *CancelStorageMaintenanceParams) (*Job, error) {
+	return s.CancelStorageMaintenanceAsyncWithContext(context.Background(), p)
+}
+
+// CancelStorageMaintenanceAsyncWithContext is the same as CancelStorageMaintenanceAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *StoragePoolService) CancelStorageMaintenanceAsyncWithContext(ctx context.Context, p *CancelStorageMaintenanceParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "cancelStorageMaintenance", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CancelStorageMaintenanceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CancelStorageMaintenanceResponse struct {
 	Allocatediops        int64             `json:"allocatediops"`
 	Capacityiops         int64             `json:"capacityiops"`
@@ -125,7 +244,8 @@ type EnableStorageMaintenanceParams struct {
 	p map[string]interface{}
 }
 
-func (p *EnableStorageMaintenanceParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *EnableStorageMaintenanceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -136,6 +256,48 @@ func (p *EnableStorageMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *EnableStorageMaintenanceParams) Validate() error {
+	v := newParamValidator("enableStorageMaintenance", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *EnableStorageMaintenanceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *EnableStorageMaintenanceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for enableStorageMaintenance", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of enableStorageMaintenance: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *EnableStorageMaintenanceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -143,6 +305,15 @@ func (p *EnableStorageMaintenanceParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *EnableStorageMaintenanceParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *EnableStorageMaintenanceParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new EnableStorageMaintenanceParams instance,
 // as then you are sure you have configured all required params
 func (s *StoragePoolService) NewEnableStorageMaintenanceParams(id string) *EnableStorageMaintenanceParams {
@@ -154,7 +325,16 @@ func (s *StoragePoolService) NewEnableStorageMaintenanceParams(id string) *Enabl
 
 // Puts storage pool into maintenance state
 func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("enableStorageMaintenance", p.toURLValues())
+	return s.EnableStorageMaintenanceWithContext(context.Background(), p)
+}
+
+// EnableStorageMaintenanceWithContext is the same as EnableStorageMaintenance, but uses ctx to cancel the request and any async job polling
+func (s *StoragePoolService) EnableStorageMaintenanceWithContext(ctx context.Context, p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableStorageMaintenance", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +346,7 @@ func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -187,6 +367,40 @@ func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanc
 	return &r, nil
 }
 
+// EnableStorageMaintenanceAsync starts the async job for EnableStorageMaintenance and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a EnableStorageMaintenanceResponse using Job.Result
+func (s *StoragePoolService) EnableStorageMaintenanceAsync(p *EnableStorageMaintenanceParams) (*Job, error) {
+	return s.EnableStorageMaintenanceAsyncWithContext(context.Background(), p)
+}
+
+// EnableStorageMaintenanceAsyncWithContext is the same as EnableStorageMaintenanceAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *StoragePoolService) EnableStorageMaintenanceAsyncWithContext(ctx context.Context, p *EnableStorageMaintenanceParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableStorageMaintenance", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableStorageMaintenanceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type EnableStorageMaintenanceResponse struct {
 	Allocatediops        int64             `json:"allocatediops"`
 	Capacityiops         int64             `json:"capacityiops"`
@@ -221,7 +435,8 @@ type ListStorageProvidersParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListStorageProvidersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListStorageProvidersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -243,6 +458,53 @@ func (p *ListStorageProvidersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListStorageProvidersParams) Validate() error {
+	v := newParamValidator("listStorageProviders", p.p)
+	v.required("type")
+	v.maxLength("keyword", 255)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListStorageProvidersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListStorageProvidersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "type":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listStorageProviders", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listStorageProviders: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListStorageProvidersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -250,6 +512,15 @@ func (p *ListStorageProvidersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListStorageProvidersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListStorageProvidersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListStorageProvidersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -257,6 +528,15 @@ func (p *ListStorageProvidersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListStorageProvidersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListStorageProvidersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListStorageProvidersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -264,6 +544,15 @@ func (p *ListStorageProvidersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListStorageProvidersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListStorageProvidersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListStorageProvidersParams) SetType(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -271,6 +560,15 @@ func (p *ListStorageProvidersParams) SetType(v string) {
 	p.p["type"] = v
 }
 
+func (p *ListStorageProvidersParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ListStorageProvidersParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListStorageProvidersParams instance,
 // as then you are sure you have configured all required params
 func (s *StoragePoolService) NewListStorageProvidersParams(storagePoolType string) *ListStorageProvidersParams {
@@ -282,7 +580,16 @@ func (s *StoragePoolService) NewListStorageProvidersParams(storagePoolType strin
 
 // Lists storage providers.
 func (s *StoragePoolService) ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listStorageProviders", p.toURLValues())
+	return s.ListStorageProvidersWithContext(context.Background(), p)
+}
+
+// ListStorageProvidersWithContext is the same as ListStorageProviders, but uses ctx to cancel the request and any async job polling
+func (s *StoragePoolService) ListStorageProvidersWithContext(ctx context.Context, p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listStorageProviders", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -295,6 +602,92 @@ func (s *StoragePoolService) ListStorageProviders(p *ListStorageProvidersParams)
 	return &r, nil
 }
 
+// ListStorageProvidersPager iterates over all pages of a ListStorageProviders call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListStorageProvidersPager struct {
+	pager
+
+	s *StoragePoolService
+	p *ListStorageProvidersParams
+	r *ListStorageProvidersResponse
+}
+
+// NewListStorageProvidersPager returns a pager for all pages of a ListStorageProviders call with the given params
+func (s *StoragePoolService) NewListStorageProvidersPager(p *ListStorageProvidersParams) *ListStorageProvidersPager {
+	return &ListStorageProvidersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListStorageProvidersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListStorageProvidersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListStorageProvidersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListStorageProvidersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.StorageProviders), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListStorageProvidersPager) Page() *ListStorageProvidersResponse {
+	return pg.r
+}
+
+// ListStorageProvidersAll fetches all pages of a ListStorageProviders call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *StoragePoolService) ListStorageProvidersAll(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
+	return s.ListStorageProvidersAllWithContext(context.Background(), p)
+}
+
+// ListStorageProvidersAllWithContext is the same as ListStorageProvidersAll, but uses ctx to cancel the requests
+func (s *StoragePoolService) ListStorageProvidersAllWithContext(ctx context.Context, p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*StorageProvider)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListStorageProvidersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListStorageProvidersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.StorageProviders
+		mu.Unlock()
+
+		return len(l.StorageProviders), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListStorageProvidersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.StorageProviders = append(r.StorageProviders, pages[page]...)
+	}
+	r.Count = len(r.StorageProviders)
+
+	return r, nil
+}
+
 type ListStorageProvidersResponse struct {
 	Count            int                `json:"count"`
 	StorageProviders []*StorageProvider `json:"storageprovider"`
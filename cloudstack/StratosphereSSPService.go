@@ -20,16 +20,30 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 )
 
+// StratosphereSSPServiceIface is the interface implemented by StratosphereSSPService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type StratosphereSSPServiceIface interface {
+	NewAddStratosphereSspParams(name string, url string, zoneid string) *AddStratosphereSspParams
+	AddStratosphereSsp(p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error)
+	AddStratosphereSspWithContext(ctx context.Context, p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error)
+	NewDeleteStratosphereSspParams(hostid string) *DeleteStratosphereSspParams
+	DeleteStratosphereSsp(p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error)
+	DeleteStratosphereSspWithContext(ctx context.Context, p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error)
+}
+
 type AddStratosphereSspParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddStratosphereSspParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddStratosphereSspParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -55,6 +69,53 @@ func (p *AddStratosphereSspParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddStratosphereSspParams) Validate() error {
+	v := newParamValidator("addStratosphereSsp", p.p)
+	v.required("name", "url", "zoneid")
+	v.ids("zoneid")
+	v.maxLength("name", 255)
+	v.maxLength("password", 255)
+	v.maxLength("tenantuuid", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddStratosphereSspParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddStratosphereSspParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "name", "password", "tenantuuid", "url", "username", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addStratosphereSsp", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addStratosphereSsp: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddStratosphereSspParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -62,6 +123,15 @@ func (p *AddStratosphereSspParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *AddStratosphereSspParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *AddStratosphereSspParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *AddStratosphereSspParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -69,6 +139,15 @@ func (p *AddStratosphereSspParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *AddStratosphereSspParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddStratosphereSspParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *AddStratosphereSspParams) SetTenantuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -76,6 +155,15 @@ func (p *AddStratosphereSspParams) SetTenantuuid(v string) {
 	p.p["tenantuuid"] = v
 }
 
+func (p *AddStratosphereSspParams) ResetTenantuuid() {
+	delete(p.p, "tenantuuid")
+}
+
+func (p *AddStratosphereSspParams) GetTenantuuid() (string, bool) {
+	value, ok := p.p["tenantuuid"].(string)
+	return value, ok
+}
+
 func (p *AddStratosphereSspParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -83,6 +171,15 @@ func (p *AddStratosphereSspParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *AddStratosphereSspParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddStratosphereSspParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *AddStratosphereSspParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -90,6 +187,15 @@ func (p *AddStratosphereSspParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddStratosphereSspParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddStratosphereSspParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 func (p *AddStratosphereSspParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -97,6 +203,15 @@ func (p *AddStratosphereSspParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *AddStratosphereSspParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *AddStratosphereSspParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddStratosphereSspParams instance,
 // as then you are sure you have configured all required params
 func (s *StratosphereSSPService) NewAddStratosphereSspParams(name string, url string, zoneid string) *AddStratosphereSspParams {
@@ -110,7 +225,16 @@ func (s *StratosphereSSPService) NewAddStratosphereSspParams(name string, url st
 
 // Adds stratosphere ssp server
 func (s *StratosphereSSPService) AddStratosphereSsp(p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error) {
-	resp, err := s.cs.newRequest("addStratosphereSsp", p.toURLValues())
+	return s.AddStratosphereSspWithContext(context.Background(), p)
+}
+
+// AddStratosphereSspWithContext is the same as AddStratosphereSsp, but uses ctx to cancel the request and any async job polling
+func (s *StratosphereSSPService) AddStratosphereSspWithContext(ctx context.Context, p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addStratosphereSsp", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +260,8 @@ type DeleteStratosphereSspParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteStratosphereSspParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteStratosphereSspParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -147,6 +272,48 @@ func (p *DeleteStratosphereSspParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteStratosphereSspParams) Validate() error {
+	v := newParamValidator("deleteStratosphereSsp", p.p)
+	v.required("hostid")
+	v.ids("hostid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteStratosphereSspParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteStratosphereSspParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteStratosphereSsp", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteStratosphereSsp: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteStratosphereSspParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -154,6 +321,15 @@ func (p *DeleteStratosphereSspParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *DeleteStratosphereSspParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *DeleteStratosphereSspParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteStratosphereSspParams instance,
 // as then you are sure you have configured all required params
 func (s *StratosphereSSPService) NewDeleteStratosphereSspParams(hostid string) *DeleteStratosphereSspParams {
@@ -165,7 +341,16 @@ func (s *StratosphereSSPService) NewDeleteStratosphereSspParams(hostid string) *
 
 // Removes stratosphere ssp server
 func (s *StratosphereSSPService) DeleteStratosphereSsp(p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error) {
-	resp, err := s.cs.newRequest("deleteStratosphereSsp", p.toURLValues())
+	return s.DeleteStratosphereSspWithContext(context.Background(), p)
+}
+
+// DeleteStratosphereSspWithContext is the same as DeleteStratosphereSsp, but uses ctx to cancel the request and any async job polling
+func (s *StratosphereSSPService) DeleteStratosphereSspWithContext(ctx context.Context, p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteStratosphereSsp", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
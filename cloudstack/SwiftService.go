@@ -20,17 +20,36 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// SwiftServiceIface is the interface implemented by SwiftService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type SwiftServiceIface interface {
+	NewAddSwiftParams(url string) *AddSwiftParams
+	AddSwift(p *AddSwiftParams) (*AddSwiftResponse, error)
+	AddSwiftWithContext(ctx context.Context, p *AddSwiftParams) (*AddSwiftResponse, error)
+	NewListSwiftsParams() *ListSwiftsParams
+	GetSwiftID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetSwiftIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
+	ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, error)
+	ListSwiftsWithContext(ctx context.Context, p *ListSwiftsParams) (*ListSwiftsResponse, error)
+	NewListSwiftsPager(p *ListSwiftsParams) *ListSwiftsPager
+	ListSwiftsAll(p *ListSwiftsParams) (*ListSwiftsResponse, error)
+	ListSwiftsAllWithContext(ctx context.Context, p *ListSwiftsParams) (*ListSwiftsResponse, error)
+}
+
 type AddSwiftParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddSwiftParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddSwiftParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -50,6 +69,51 @@ func (p *AddSwiftParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddSwiftParams) Validate() error {
+	v := newParamValidator("addSwift", p.p)
+	v.required("url")
+	v.maxLength("account", 255)
+	v.maxLength("key", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddSwiftParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddSwiftParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "key", "url", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addSwift", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addSwift: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddSwiftParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -57,6 +121,15 @@ func (p *AddSwiftParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *AddSwiftParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *AddSwiftParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *AddSwiftParams) SetKey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -64,6 +137,15 @@ func (p *AddSwiftParams) SetKey(v string) {
 	p.p["key"] = v
 }
 
+func (p *AddSwiftParams) ResetKey() {
+	delete(p.p, "key")
+}
+
+func (p *AddSwiftParams) GetKey() (string, bool) {
+	value, ok := p.p["key"].(string)
+	return value, ok
+}
+
 func (p *AddSwiftParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +153,15 @@ func (p *AddSwiftParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *AddSwiftParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddSwiftParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *AddSwiftParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -78,6 +169,15 @@ func (p *AddSwiftParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddSwiftParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddSwiftParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddSwiftParams instance,
 // as then you are sure you have configured all required params
 func (s *SwiftService) NewAddSwiftParams(url string) *AddSwiftParams {
@@ -89,7 +189,16 @@ func (s *SwiftService) NewAddSwiftParams(url string) *AddSwiftParams {
 
 // Adds Swift.
 func (s *SwiftService) AddSwift(p *AddSwiftParams) (*AddSwiftResponse, error) {
-	resp, err := s.cs.newRequest("addSwift", p.toURLValues())
+	return s.AddSwiftWithContext(context.Background(), p)
+}
+
+// AddSwiftWithContext is the same as AddSwift, but uses ctx to cancel the request and any async job polling
+func (s *SwiftService) AddSwiftWithContext(ctx context.Context, p *AddSwiftParams) (*AddSwiftResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addSwift", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +231,8 @@ type ListSwiftsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListSwiftsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListSwiftsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -145,6 +255,55 @@ func (p *ListSwiftsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListSwiftsParams) Validate() error {
+	v := newParamValidator("listSwifts", p.p)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListSwiftsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListSwiftsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "keyword":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listSwifts", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listSwifts: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListSwiftsParams) SetId(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -152,6 +311,15 @@ func (p *ListSwiftsParams) SetId(v int64) {
 	p.p["id"] = v
 }
 
+func (p *ListSwiftsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListSwiftsParams) GetId() (int64, bool) {
+	value, ok := p.p["id"].(int64)
+	return value, ok
+}
+
 func (p *ListSwiftsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -159,6 +327,15 @@ func (p *ListSwiftsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListSwiftsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListSwiftsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListSwiftsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -166,6 +343,15 @@ func (p *ListSwiftsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListSwiftsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListSwiftsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListSwiftsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -173,6 +359,15 @@ func (p *ListSwiftsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListSwiftsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListSwiftsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new ListSwiftsParams instance,
 // as then you are sure you have configured all required params
 func (s *SwiftService) NewListSwiftsParams() *ListSwiftsParams {
@@ -183,6 +378,11 @@ func (s *SwiftService) NewListSwiftsParams() *ListSwiftsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SwiftService) GetSwiftID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSwiftIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetSwiftIDWithContext is the same as GetSwiftID, but uses ctx to cancel the request
+func (s *SwiftService) GetSwiftIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSwiftsParams{}
 	p.p = make(map[string]interface{})
 
@@ -194,7 +394,7 @@ func (s *SwiftService) GetSwiftID(keyword string, opts ...OptionFunc) (string, i
 		}
 	}
 
-	l, err := s.ListSwifts(p)
+	l, err := s.ListSwiftsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -219,7 +419,16 @@ func (s *SwiftService) GetSwiftID(keyword string, opts ...OptionFunc) (string, i
 
 // List Swift.
 func (s *SwiftService) ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, error) {
-	resp, err := s.cs.newRequest("listSwifts", p.toURLValues())
+	return s.ListSwiftsWithContext(context.Background(), p)
+}
+
+// ListSwiftsWithContext is the same as ListSwifts, but uses ctx to cancel the request and any async job polling
+func (s *SwiftService) ListSwiftsWithContext(ctx context.Context, p *ListSwiftsParams) (*ListSwiftsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listSwifts", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -232,6 +441,92 @@ func (s *SwiftService) ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, err
 	return &r, nil
 }
 
+// ListSwiftsPager iterates over all pages of a ListSwifts call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListSwiftsPager struct {
+	pager
+
+	s *SwiftService
+	p *ListSwiftsParams
+	r *ListSwiftsResponse
+}
+
+// NewListSwiftsPager returns a pager for all pages of a ListSwifts call with the given params
+func (s *SwiftService) NewListSwiftsPager(p *ListSwiftsParams) *ListSwiftsPager {
+	return &ListSwiftsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListSwiftsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListSwiftsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListSwiftsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListSwiftsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Swifts), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListSwiftsPager) Page() *ListSwiftsResponse {
+	return pg.r
+}
+
+// ListSwiftsAll fetches all pages of a ListSwifts call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *SwiftService) ListSwiftsAll(p *ListSwiftsParams) (*ListSwiftsResponse, error) {
+	return s.ListSwiftsAllWithContext(context.Background(), p)
+}
+
+// ListSwiftsAllWithContext is the same as ListSwiftsAll, but uses ctx to cancel the requests
+func (s *SwiftService) ListSwiftsAllWithContext(ctx context.Context, p *ListSwiftsParams) (*ListSwiftsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Swift)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListSwiftsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListSwiftsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Swifts
+		mu.Unlock()
+
+		return len(l.Swifts), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListSwiftsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Swifts = append(r.Swifts, pages[page]...)
+	}
+	r.Count = len(r.Swifts)
+
+	return r, nil
+}
+
 type ListSwiftsResponse struct {
 	Count  int      `json:"count"`
 	Swifts []*Swift `json:"swift"`
@@ -20,16 +20,31 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// SystemCapacityServiceIface is the interface implemented by SystemCapacityService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type SystemCapacityServiceIface interface {
+	NewListCapacityParams() *ListCapacityParams
+	ListCapacity(p *ListCapacityParams) (*ListCapacityResponse, error)
+	ListCapacityWithContext(ctx context.Context, p *ListCapacityParams) (*ListCapacityResponse, error)
+	NewListCapacityPager(p *ListCapacityParams) *ListCapacityPager
+	ListCapacityAll(p *ListCapacityParams) (*ListCapacityResponse, error)
+	ListCapacityAllWithContext(ctx context.Context, p *ListCapacityParams) (*ListCapacityResponse, error)
+}
+
 type ListCapacityParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListCapacityParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListCapacityParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -68,6 +83,57 @@ func (p *ListCapacityParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListCapacityParams) Validate() error {
+	v := newParamValidator("listCapacity", p.p)
+	v.ids("clusterid", "podid", "zoneid")
+	v.maxLength("keyword", 255)
+	v.maxLength("sortby", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListCapacityParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListCapacityParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid", "keyword", "podid", "sortby", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fetchlatest":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize", "type":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listCapacity", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listCapacity: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListCapacityParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -75,6 +141,15 @@ func (p *ListCapacityParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *ListCapacityParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ListCapacityParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *ListCapacityParams) SetFetchlatest(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -82,6 +157,15 @@ func (p *ListCapacityParams) SetFetchlatest(v bool) {
 	p.p["fetchlatest"] = v
 }
 
+func (p *ListCapacityParams) ResetFetchlatest() {
+	delete(p.p, "fetchlatest")
+}
+
+func (p *ListCapacityParams) GetFetchlatest() (bool, bool) {
+	value, ok := p.p["fetchlatest"].(bool)
+	return value, ok
+}
+
 func (p *ListCapacityParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -89,6 +173,15 @@ func (p *ListCapacityParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListCapacityParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListCapacityParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListCapacityParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -96,6 +189,15 @@ func (p *ListCapacityParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListCapacityParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListCapacityParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListCapacityParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -103,6 +205,15 @@ func (p *ListCapacityParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListCapacityParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListCapacityParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListCapacityParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -110,6 +221,15 @@ func (p *ListCapacityParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *ListCapacityParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListCapacityParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *ListCapacityParams) SetSortby(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -117,6 +237,15 @@ func (p *ListCapacityParams) SetSortby(v string) {
 	p.p["sortby"] = v
 }
 
+func (p *ListCapacityParams) ResetSortby() {
+	delete(p.p, "sortby")
+}
+
+func (p *ListCapacityParams) GetSortby() (string, bool) {
+	value, ok := p.p["sortby"].(string)
+	return value, ok
+}
+
 func (p *ListCapacityParams) SetType(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -124,6 +253,15 @@ func (p *ListCapacityParams) SetType(v int) {
 	p.p["type"] = v
 }
 
+func (p *ListCapacityParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ListCapacityParams) GetType() (int, bool) {
+	value, ok := p.p["type"].(int)
+	return value, ok
+}
+
 func (p *ListCapacityParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -131,6 +269,15 @@ func (p *ListCapacityParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListCapacityParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListCapacityParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListCapacityParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemCapacityService) NewListCapacityParams() *ListCapacityParams {
@@ -141,7 +288,16 @@ func (s *SystemCapacityService) NewListCapacityParams() *ListCapacityParams {
 
 // Lists all the system wide capacities.
 func (s *SystemCapacityService) ListCapacity(p *ListCapacityParams) (*ListCapacityResponse, error) {
-	resp, err := s.cs.newRequest("listCapacity", p.toURLValues())
+	return s.ListCapacityWithContext(context.Background(), p)
+}
+
+// ListCapacityWithContext is the same as ListCapacity, but uses ctx to cancel the request and any async job polling
+func (s *SystemCapacityService) ListCapacityWithContext(ctx context.Context, p *ListCapacityParams) (*ListCapacityResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listCapacity", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +310,92 @@ func (s *SystemCapacityService) ListCapacity(p *ListCapacityParams) (*ListCapaci
 	return &r, nil
 }
 
+// ListCapacityPager iterates over all pages of a ListCapacity call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListCapacityPager struct {
+	pager
+
+	s *SystemCapacityService
+	p *ListCapacityParams
+	r *ListCapacityResponse
+}
+
+// NewListCapacityPager returns a pager for all pages of a ListCapacity call with the given params
+func (s *SystemCapacityService) NewListCapacityPager(p *ListCapacityParams) *ListCapacityPager {
+	return &ListCapacityPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListCapacityPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListCapacityPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListCapacityParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListCapacityWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Capacity), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListCapacityPager) Page() *ListCapacityResponse {
+	return pg.r
+}
+
+// ListCapacityAll fetches all pages of a ListCapacity call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *SystemCapacityService) ListCapacityAll(p *ListCapacityParams) (*ListCapacityResponse, error) {
+	return s.ListCapacityAllWithContext(context.Background(), p)
+}
+
+// ListCapacityAllWithContext is the same as ListCapacityAll, but uses ctx to cancel the requests
+func (s *SystemCapacityService) ListCapacityAllWithContext(ctx context.Context, p *ListCapacityParams) (*ListCapacityResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Capacity)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListCapacityParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListCapacityWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Capacity
+		mu.Unlock()
+
+		return len(l.Capacity), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListCapacityResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Capacity = append(r.Capacity, pages[page]...)
+	}
+	r.Count = len(r.Capacity)
+
+	return r, nil
+}
+
 type ListCapacityResponse struct {
 	Count    int         `json:"count"`
 	Capacity []*Capacity `json:"capacity"`
@@ -20,18 +20,70 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// SystemVMServiceIface is the interface implemented by SystemVMService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type SystemVMServiceIface interface {
+	NewChangeServiceForSystemVmParams(id string, serviceofferingid string) *ChangeServiceForSystemVmParams
+	ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error)
+	ChangeServiceForSystemVmWithContext(ctx context.Context, p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error)
+	NewDestroySystemVmParams(id string) *DestroySystemVmParams
+	DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error)
+	DestroySystemVmWithContext(ctx context.Context, p *DestroySystemVmParams) (*DestroySystemVmResponse, error)
+	DestroySystemVmAsync(p *DestroySystemVmParams) (*Job, error)
+	DestroySystemVmAsyncWithContext(ctx context.Context, p *DestroySystemVmParams) (*Job, error)
+	NewListSystemVmsParams() *ListSystemVmsParams
+	GetSystemVmID(name string, opts ...OptionFunc) (string, int, error)
+	GetSystemVmIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetSystemVmByName(name string, opts ...OptionFunc) (*SystemVm, int, error)
+	GetSystemVmByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SystemVm, int, error)
+	GetSystemVmByID(id string, opts ...OptionFunc) (*SystemVm, int, error)
+	GetSystemVmByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SystemVm, int, error)
+	ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error)
+	ListSystemVmsWithContext(ctx context.Context, p *ListSystemVmsParams) (*ListSystemVmsResponse, error)
+	NewListSystemVmsPager(p *ListSystemVmsParams) *ListSystemVmsPager
+	ListSystemVmsAll(p *ListSystemVmsParams) (*ListSystemVmsResponse, error)
+	ListSystemVmsAllWithContext(ctx context.Context, p *ListSystemVmsParams) (*ListSystemVmsResponse, error)
+	NewMigrateSystemVmParams(hostid string, virtualmachineid string) *MigrateSystemVmParams
+	MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error)
+	MigrateSystemVmWithContext(ctx context.Context, p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error)
+	MigrateSystemVmAsync(p *MigrateSystemVmParams) (*Job, error)
+	MigrateSystemVmAsyncWithContext(ctx context.Context, p *MigrateSystemVmParams) (*Job, error)
+	NewRebootSystemVmParams(id string) *RebootSystemVmParams
+	RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error)
+	RebootSystemVmWithContext(ctx context.Context, p *RebootSystemVmParams) (*RebootSystemVmResponse, error)
+	RebootSystemVmAsync(p *RebootSystemVmParams) (*Job, error)
+	RebootSystemVmAsyncWithContext(ctx context.Context, p *RebootSystemVmParams) (*Job, error)
+	NewScaleSystemVmParams(id string, serviceofferingid string) *ScaleSystemVmParams
+	ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error)
+	ScaleSystemVmWithContext(ctx context.Context, p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error)
+	ScaleSystemVmAsync(p *ScaleSystemVmParams) (*Job, error)
+	ScaleSystemVmAsyncWithContext(ctx context.Context, p *ScaleSystemVmParams) (*Job, error)
+	NewStartSystemVmParams(id string) *StartSystemVmParams
+	StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error)
+	StartSystemVmWithContext(ctx context.Context, p *StartSystemVmParams) (*StartSystemVmResponse, error)
+	StartSystemVmAsync(p *StartSystemVmParams) (*Job, error)
+	StartSystemVmAsyncWithContext(ctx context.Context, p *StartSystemVmParams) (*Job, error)
+	NewStopSystemVmParams(id string) *StopSystemVmParams
+	StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error)
+	StopSystemVmWithContext(ctx context.Context, p *StopSystemVmParams) (*StopSystemVmResponse, error)
+	StopSystemVmAsync(p *StopSystemVmParams) (*Job, error)
+	StopSystemVmAsyncWithContext(ctx context.Context, p *StopSystemVmParams) (*Job, error)
+}
+
 type ChangeServiceForSystemVmParams struct {
 	p map[string]interface{}
 }
 
-func (p *ChangeServiceForSystemVmParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ChangeServiceForSystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -51,6 +103,52 @@ func (p *ChangeServiceForSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ChangeServiceForSystemVmParams) Validate() error {
+	v := newParamValidator("changeServiceForSystemVm", p.p)
+	v.required("id", "serviceofferingid")
+	v.ids("id", "serviceofferingid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ChangeServiceForSystemVmParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ChangeServiceForSystemVmParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "serviceofferingid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for changeServiceForSystemVm", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of changeServiceForSystemVm: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ChangeServiceForSystemVmParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -58,6 +156,15 @@ func (p *ChangeServiceForSystemVmParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *ChangeServiceForSystemVmParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *ChangeServiceForSystemVmParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *ChangeServiceForSystemVmParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -65,6 +172,15 @@ func (p *ChangeServiceForSystemVmParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ChangeServiceForSystemVmParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ChangeServiceForSystemVmParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ChangeServiceForSystemVmParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -72,6 +188,15 @@ func (p *ChangeServiceForSystemVmParams) SetServiceofferingid(v string) {
 	p.p["serviceofferingid"] = v
 }
 
+func (p *ChangeServiceForSystemVmParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *ChangeServiceForSystemVmParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ChangeServiceForSystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewChangeServiceForSystemVmParams(id string, serviceofferingid string) *ChangeServiceForSystemVmParams {
@@ -84,7 +209,16 @@ func (s *SystemVMService) NewChangeServiceForSystemVmParams(id string, serviceof
 
 // Changes the service offering for a system vm (console proxy or secondary storage). The system vm must be in a "Stopped" state for this command to take effect.
 func (s *SystemVMService) ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForSystemVm", p.toURLValues())
+	return s.ChangeServiceForSystemVmWithContext(context.Background(), p)
+}
+
+// ChangeServiceForSystemVmWithContext is the same as ChangeServiceForSystemVm, but uses ctx to cancel the request and any async job polling
+func (s *SystemVMService) ChangeServiceForSystemVmWithContext(ctx context.Context, p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "changeServiceForSystemVm", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +273,8 @@ type DestroySystemVmParams struct {
 	p map[string]interface{}
 }
 
-func (p *DestroySystemVmParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DestroySystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -150,6 +285,48 @@ func (p *DestroySystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DestroySystemVmParams) Validate() error {
+	v := newParamValidator("destroySystemVm", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DestroySystemVmParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DestroySystemVmParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for destroySystemVm", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of destroySystemVm: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DestroySystemVmParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -157,6 +334,15 @@ func (p *DestroySystemVmParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DestroySystemVmParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DestroySystemVmParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DestroySystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewDestroySystemVmParams(id string) *DestroySystemVmParams {
@@ -168,7 +354,16 @@ func (s *SystemVMService) NewDestroySystemVmParams(id string) *DestroySystemVmPa
 
 // Destroyes a system virtual machine.
 func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error) {
-	resp, err := s.cs.newRequest("destroySystemVm", p.toURLValues())
+	return s.DestroySystemVmWithContext(context.Background(), p)
+}
+
+// DestroySystemVmWithContext is the same as DestroySystemVm, but uses ctx to cancel the request and any async job polling
+func (s *SystemVMService) DestroySystemVmWithContext(ctx context.Context, p *DestroySystemVmParams) (*DestroySystemVmResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "destroySystemVm", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +375,7 @@ func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams) (*DestroySys
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -201,6 +396,40 @@ func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams) (*DestroySys
 	return &r, nil
 }
 
+// DestroySystemVmAsync starts the async job for DestroySystemVm and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DestroySystemVmResponse using Job.Result
+func (s *SystemVMService) DestroySystemVmAsync(p *DestroySystemVmParams) (*Job, error) {
+	return s.DestroySystemVmAsyncWithContext(context.Background(), p)
+}
+
+// DestroySystemVmAsyncWithContext is the same as DestroySystemVmAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SystemVMService) DestroySystemVmAsyncWithContext(ctx context.Context, p *DestroySystemVmParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "destroySystemVm", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DestroySystemVmResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type DestroySystemVmResponse struct {
 	Activeviewersessions int      `json:"activeviewersessions"`
 	Agentstate           string   `json:"agentstate"`
@@ -243,7 +472,8 @@ type ListSystemVmsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListSystemVmsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListSystemVmsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -286,6 +516,55 @@ func (p *ListSystemVmsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListSystemVmsParams) Validate() error {
+	v := newParamValidator("listSystemVms", p.p)
+	v.ids("hostid", "id", "podid", "storageid", "zoneid")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	v.maxLength("systemvmtype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListSystemVmsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListSystemVmsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid", "id", "keyword", "name", "podid", "state", "storageid", "systemvmtype", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listSystemVms", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listSystemVms: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListSystemVmsParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -293,6 +572,15 @@ func (p *ListSystemVmsParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *ListSystemVmsParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ListSystemVmsParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 func (p *ListSystemVmsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -300,6 +588,15 @@ func (p *ListSystemVmsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListSystemVmsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListSystemVmsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListSystemVmsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -307,6 +604,15 @@ func (p *ListSystemVmsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListSystemVmsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListSystemVmsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListSystemVmsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -314,6 +620,15 @@ func (p *ListSystemVmsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListSystemVmsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListSystemVmsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListSystemVmsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -321,6 +636,15 @@ func (p *ListSystemVmsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListSystemVmsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListSystemVmsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListSystemVmsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -328,6 +652,15 @@ func (p *ListSystemVmsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListSystemVmsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListSystemVmsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListSystemVmsParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -335,6 +668,15 @@ func (p *ListSystemVmsParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *ListSystemVmsParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListSystemVmsParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *ListSystemVmsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -342,6 +684,15 @@ func (p *ListSystemVmsParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListSystemVmsParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListSystemVmsParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListSystemVmsParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -349,6 +700,15 @@ func (p *ListSystemVmsParams) SetStorageid(v string) {
 	p.p["storageid"] = v
 }
 
+func (p *ListSystemVmsParams) ResetStorageid() {
+	delete(p.p, "storageid")
+}
+
+func (p *ListSystemVmsParams) GetStorageid() (string, bool) {
+	value, ok := p.p["storageid"].(string)
+	return value, ok
+}
+
 func (p *ListSystemVmsParams) SetSystemvmtype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -356,6 +716,15 @@ func (p *ListSystemVmsParams) SetSystemvmtype(v string) {
 	p.p["systemvmtype"] = v
 }
 
+func (p *ListSystemVmsParams) ResetSystemvmtype() {
+	delete(p.p, "systemvmtype")
+}
+
+func (p *ListSystemVmsParams) GetSystemvmtype() (string, bool) {
+	value, ok := p.p["systemvmtype"].(string)
+	return value, ok
+}
+
 func (p *ListSystemVmsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -363,6 +732,15 @@ func (p *ListSystemVmsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListSystemVmsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListSystemVmsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListSystemVmsParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewListSystemVmsParams() *ListSystemVmsParams {
@@ -373,6 +751,11 @@ func (s *SystemVMService) NewListSystemVmsParams() *ListSystemVmsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSystemVmIDWithContext(context.Background(), name, opts...)
+}
+
+// GetSystemVmIDWithContext is the same as GetSystemVmID, but uses ctx to cancel the request
+func (s *SystemVMService) GetSystemVmIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSystemVmsParams{}
 	p.p = make(map[string]interface{})
 
@@ -384,7 +767,7 @@ func (s *SystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string
 		}
 	}
 
-	l, err := s.ListSystemVms(p)
+	l, err := s.ListSystemVmsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -409,12 +792,17 @@ func (s *SystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SystemVMService) GetSystemVmByName(name string, opts ...OptionFunc) (*SystemVm, int, error) {
-	id, count, err := s.GetSystemVmID(name, opts...)
+	return s.GetSystemVmByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetSystemVmByNameWithContext is the same as GetSystemVmByName, but uses ctx to cancel the requests
+func (s *SystemVMService) GetSystemVmByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SystemVm, int, error) {
+	id, count, err := s.GetSystemVmIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetSystemVmByID(id, opts...)
+	r, count, err := s.GetSystemVmByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -423,6 +811,11 @@ func (s *SystemVMService) GetSystemVmByName(name string, opts ...OptionFunc) (*S
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SystemVMService) GetSystemVmByID(id string, opts ...OptionFunc) (*SystemVm, int, error) {
+	return s.GetSystemVmByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetSystemVmByIDWithContext is the same as GetSystemVmByID, but uses ctx to cancel the request
+func (s *SystemVMService) GetSystemVmByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SystemVm, int, error) {
 	p := &ListSystemVmsParams{}
 	p.p = make(map[string]interface{})
 
@@ -434,18 +827,16 @@ func (s *SystemVMService) GetSystemVmByID(id string, opts ...OptionFunc) (*Syste
 		}
 	}
 
-	l, err := s.ListSystemVms(p)
+	l, err := s.ListSystemVmsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -456,7 +847,16 @@ func (s *SystemVMService) GetSystemVmByID(id string, opts ...OptionFunc) (*Syste
 
 // List system virtual machines.
 func (s *SystemVMService) ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
-	resp, err := s.cs.newRequest("listSystemVms", p.toURLValues())
+	return s.ListSystemVmsWithContext(context.Background(), p)
+}
+
+// ListSystemVmsWithContext is the same as ListSystemVms, but uses ctx to cancel the request and any async job polling
+func (s *SystemVMService) ListSystemVmsWithContext(ctx context.Context, p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listSystemVms", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -469,6 +869,92 @@ func (s *SystemVMService) ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsR
 	return &r, nil
 }
 
+// ListSystemVmsPager iterates over all pages of a ListSystemVms call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListSystemVmsPager struct {
+	pager
+
+	s *SystemVMService
+	p *ListSystemVmsParams
+	r *ListSystemVmsResponse
+}
+
+// NewListSystemVmsPager returns a pager for all pages of a ListSystemVms call with the given params
+func (s *SystemVMService) NewListSystemVmsPager(p *ListSystemVmsParams) *ListSystemVmsPager {
+	return &ListSystemVmsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListSystemVmsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListSystemVmsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListSystemVmsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListSystemVmsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.SystemVms), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListSystemVmsPager) Page() *ListSystemVmsResponse {
+	return pg.r
+}
+
+// ListSystemVmsAll fetches all pages of a ListSystemVms call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *SystemVMService) ListSystemVmsAll(p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
+	return s.ListSystemVmsAllWithContext(context.Background(), p)
+}
+
+// ListSystemVmsAllWithContext is the same as ListSystemVmsAll, but uses ctx to cancel the requests
+func (s *SystemVMService) ListSystemVmsAllWithContext(ctx context.Context, p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*SystemVm)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListSystemVmsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListSystemVmsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.SystemVms
+		mu.Unlock()
+
+		return len(l.SystemVms), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListSystemVmsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.SystemVms = append(r.SystemVms, pages[page]...)
+	}
+	r.Count = len(r.SystemVms)
+
+	return r, nil
+}
+
 type ListSystemVmsResponse struct {
 	Count     int         `json:"count"`
 	SystemVms []*SystemVm `json:"systemvm"`
@@ -516,7 +1002,8 @@ type MigrateSystemVmParams struct {
 	p map[string]interface{}
 }
 
-func (p *MigrateSystemVmParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *MigrateSystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -530,6 +1017,48 @@ func (p *MigrateSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *MigrateSystemVmParams) Validate() error {
+	v := newParamValidator("migrateSystemVm", p.p)
+	v.required("hostid", "virtualmachineid")
+	v.ids("hostid", "virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *MigrateSystemVmParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *MigrateSystemVmParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for migrateSystemVm", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of migrateSystemVm: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *MigrateSystemVmParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -537,6 +1066,15 @@ func (p *MigrateSystemVmParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *MigrateSystemVmParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *MigrateSystemVmParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 func (p *MigrateSystemVmParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -544,6 +1082,15 @@ func (p *MigrateSystemVmParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *MigrateSystemVmParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *MigrateSystemVmParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new MigrateSystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewMigrateSystemVmParams(hostid string, virtualmachineid string) *MigrateSystemVmParams {
@@ -556,7 +1103,16 @@ func (s *SystemVMService) NewMigrateSystemVmParams(hostid string, virtualmachine
 
 // Attempts Migration of a system virtual machine to the host specified.
 func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("migrateSystemVm", p.toURLValues())
+	return s.MigrateSystemVmWithContext(context.Background(), p)
+}
+
+// MigrateSystemVmWithContext is the same as MigrateSystemVm, but uses ctx to cancel the request and any async job polling
+func (s *SystemVMService) MigrateSystemVmWithContext(ctx context.Context, p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateSystemVm", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -568,7 +1124,7 @@ func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSys
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -589,6 +1145,40 @@ func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSys
 	return &r, nil
 }
 
+// MigrateSystemVmAsync starts the async job for MigrateSystemVm and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a MigrateSystemVmResponse using Job.Result
+func (s *SystemVMService) MigrateSystemVmAsync(p *MigrateSystemVmParams) (*Job, error) {
+	return s.MigrateSystemVmAsyncWithContext(context.Background(), p)
+}
+
+// MigrateSystemVmAsyncWithContext is the same as MigrateSystemVmAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SystemVMService) MigrateSystemVmAsyncWithContext(ctx context.Context, p *MigrateSystemVmParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateSystemVm", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateSystemVmResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type MigrateSystemVmResponse struct {
 	Activeviewersessions int      `json:"activeviewersessions"`
 	Agentstate           string   `json:"agentstate"`
@@ -631,7 +1221,8 @@ type RebootSystemVmParams struct {
 	p map[string]interface{}
 }
 
-func (p *RebootSystemVmParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RebootSystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -642,6 +1233,48 @@ func (p *RebootSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RebootSystemVmParams) Validate() error {
+	v := newParamValidator("rebootSystemVm", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RebootSystemVmParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RebootSystemVmParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for rebootSystemVm", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of rebootSystemVm: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RebootSystemVmParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -649,6 +1282,15 @@ func (p *RebootSystemVmParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RebootSystemVmParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RebootSystemVmParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RebootSystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewRebootSystemVmParams(id string) *RebootSystemVmParams {
@@ -660,7 +1302,16 @@ func (s *SystemVMService) NewRebootSystemVmParams(id string) *RebootSystemVmPara
 
 // Reboots a system VM.
 func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("rebootSystemVm", p.toURLValues())
+	return s.RebootSystemVmWithContext(context.Background(), p)
+}
+
+// RebootSystemVmWithContext is the same as RebootSystemVm, but uses ctx to cancel the request and any async job polling
+func (s *SystemVMService) RebootSystemVmWithContext(ctx context.Context, p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "rebootSystemVm", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -672,7 +1323,7 @@ func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystem
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -693,6 +1344,40 @@ func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystem
 	return &r, nil
 }
 
+// RebootSystemVmAsync starts the async job for RebootSystemVm and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RebootSystemVmResponse using Job.Result
+func (s *SystemVMService) RebootSystemVmAsync(p *RebootSystemVmParams) (*Job, error) {
+	return s.RebootSystemVmAsyncWithContext(context.Background(), p)
+}
+
+// RebootSystemVmAsyncWithContext is the same as RebootSystemVmAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SystemVMService) RebootSystemVmAsyncWithContext(ctx context.Context, p *RebootSystemVmParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "rebootSystemVm", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RebootSystemVmResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type RebootSystemVmResponse struct {
 	Activeviewersessions int      `json:"activeviewersessions"`
 	Agentstate           string   `json:"agentstate"`
@@ -735,7 +1420,8 @@ type ScaleSystemVmParams struct {
 	p map[string]interface{}
 }
 
-func (p *ScaleSystemVmParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ScaleSystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -755,6 +1441,52 @@ func (p *ScaleSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ScaleSystemVmParams) Validate() error {
+	v := newParamValidator("scaleSystemVm", p.p)
+	v.required("id", "serviceofferingid")
+	v.ids("id", "serviceofferingid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ScaleSystemVmParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ScaleSystemVmParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "serviceofferingid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for scaleSystemVm", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of scaleSystemVm: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ScaleSystemVmParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -762,6 +1494,15 @@ func (p *ScaleSystemVmParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *ScaleSystemVmParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *ScaleSystemVmParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *ScaleSystemVmParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -769,6 +1510,15 @@ func (p *ScaleSystemVmParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ScaleSystemVmParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ScaleSystemVmParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ScaleSystemVmParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -776,6 +1526,15 @@ func (p *ScaleSystemVmParams) SetServiceofferingid(v string) {
 	p.p["serviceofferingid"] = v
 }
 
+func (p *ScaleSystemVmParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *ScaleSystemVmParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ScaleSystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewScaleSystemVmParams(id string, serviceofferingid string) *ScaleSystemVmParams {
@@ -788,7 +1547,16 @@ func (s *SystemVMService) NewScaleSystemVmParams(id string, serviceofferingid st
 
 // Scale the service offering for a system vm (console proxy or secondary storage). The system vm must be in a "Stopped" state for this command to take effect.
 func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("scaleSystemVm", p.toURLValues())
+	return s.ScaleSystemVmWithContext(context.Background(), p)
+}
+
+// ScaleSystemVmWithContext is the same as ScaleSystemVm, but uses ctx to cancel the request and any async job polling
+func (s *SystemVMService) ScaleSystemVmWithContext(ctx context.Context, p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "scaleSystemVm", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -800,7 +1568,7 @@ func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmR
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -821,6 +1589,40 @@ func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmR
 	return &r, nil
 }
 
+// ScaleSystemVmAsync starts the async job for ScaleSystemVm and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ScaleSystemVmResponse using Job.Result
+func (s *SystemVMService) ScaleSystemVmAsync(p *ScaleSystemVmParams) (*Job, error) {
+	return s.ScaleSystemVmAsyncWithContext(context.Background(), p)
+}
+
+// ScaleSystemVmAsyncWithContext is the same as ScaleSystemVmAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SystemVMService) ScaleSystemVmAsyncWithContext(ctx context.Context, p *ScaleSystemVmParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "scaleSystemVm", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ScaleSystemVmResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type ScaleSystemVmResponse struct {
 	Activeviewersessions int      `json:"activeviewersessions"`
 	Agentstate           string   `json:"agentstate"`
@@ -863,7 +1665,8 @@ type StartSystemVmParams struct {
 	p map[string]interface{}
 }
 
-func (p *StartSystemVmParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *StartSystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -874,6 +1677,48 @@ func (p *StartSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *StartSystemVmParams) Validate() error {
+	v := newParamValidator("startSystemVm", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *StartSystemVmParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *StartSystemVmParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for startSystemVm", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of startSystemVm: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *StartSystemVmParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -881,6 +1726,15 @@ func (p *StartSystemVmParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *StartSystemVmParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *StartSystemVmParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new StartSystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewStartSystemVmParams(id string) *StartSystemVmParams {
@@ -892,7 +1746,16 @@ func (s *SystemVMService) NewStartSystemVmParams(id string) *StartSystemVmParams
 
 // Starts a system virtual machine.
 func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("startSystemVm", p.toURLValues())
+	return s.StartSystemVmWithContext(context.Background(), p)
+}
+
+// StartSystemVmWithContext is the same as StartSystemVm, but uses ctx to cancel the request and any async job polling
+func (s *SystemVMService) StartSystemVmWithContext(ctx context.Context, p *StartSystemVmParams) (*StartSystemVmResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "startSystemVm", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -904,7 +1767,7 @@ func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmR
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -925,6 +1788,40 @@ func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmR
 	return &r, nil
 }
 
+// StartSystemVmAsync starts the async job for StartSystemVm and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a StartSystemVmResponse using Job.Result
+func (s *SystemVMService) StartSystemVmAsync(p *StartSystemVmParams) (*Job, error) {
+	return s.StartSystemVmAsyncWithContext(context.Background(), p)
+}
+
+// StartSystemVmAsyncWithContext is the same as StartSystemVmAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SystemVMService) StartSystemVmAsyncWithContext(ctx context.Context, p *StartSystemVmParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "startSystemVm", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StartSystemVmResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type StartSystemVmResponse struct {
 	Activeviewersessions int      `json:"activeviewersessions"`
 	Agentstate           string   `json:"agentstate"`
@@ -967,7 +1864,8 @@ type StopSystemVmParams struct {
 	p map[string]interface{}
 }
 
-func (p *StopSystemVmParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *StopSystemVmParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -982,6 +1880,52 @@ func (p *StopSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *StopSystemVmParams) Validate() error {
+	v := newParamValidator("stopSystemVm", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *StopSystemVmParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *StopSystemVmParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "forced":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for stopSystemVm", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of stopSystemVm: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *StopSystemVmParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -989,6 +1933,15 @@ func (p *StopSystemVmParams) SetForced(v bool) {
 	p.p["forced"] = v
 }
 
+func (p *StopSystemVmParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *StopSystemVmParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
 func (p *StopSystemVmParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -996,6 +1949,15 @@ func (p *StopSystemVmParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *StopSystemVmParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *StopSystemVmParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new StopSystemVmParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewStopSystemVmParams(id string) *StopSystemVmParams {
@@ -1007,7 +1969,16 @@ func (s *SystemVMService) NewStopSystemVmParams(id string) *StopSystemVmParams {
 
 // Stops a system VM.
 func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("stopSystemVm", p.toURLValues())
+	return s.StopSystemVmWithContext(context.Background(), p)
+}
+
+// StopSystemVmWithContext is the same as StopSystemVm, but uses ctx to cancel the request and any async job polling
+func (s *SystemVMService) StopSystemVmWithContext(ctx context.Context, p *StopSystemVmParams) (*StopSystemVmResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "stopSystemVm", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1019,7 +1990,7 @@ func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResp
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1040,6 +2011,40 @@ func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResp
 	return &r, nil
 }
 
+// StopSystemVmAsync starts the async job for StopSystemVm and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a StopSystemVmResponse using Job.Result
+func (s *SystemVMService) StopSystemVmAsync(p *StopSystemVmParams) (*Job, error) {
+	return s.StopSystemVmAsyncWithContext(context.Background(), p)
+}
+
+// StopSystemVmAsyncWithContext is the same as StopSystemVmAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *SystemVMService) StopSystemVmAsyncWithContext(ctx context.Context, p *StopSystemVmParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "stopSystemVm", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StopSystemVmResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type StopSystemVmResponse struct {
 	Activeviewersessions int      `json:"activeviewersessions"`
 	Agentstate           string   `json:"agentstate"`
@@ -20,18 +20,81 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// TemplateServiceIface is the interface implemented by TemplateService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type TemplateServiceIface interface {
+	NewCopyTemplateParams(id string) *CopyTemplateParams
+	CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error)
+	CopyTemplateWithContext(ctx context.Context, p *CopyTemplateParams) (*CopyTemplateResponse, error)
+	CopyTemplateAsync(p *CopyTemplateParams) (*Job, error)
+	CopyTemplateAsyncWithContext(ctx context.Context, p *CopyTemplateParams) (*Job, error)
+	NewCreateTemplateParams(displaytext string, name string, ostypeid string) *CreateTemplateParams
+	CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error)
+	CreateTemplateWithContext(ctx context.Context, p *CreateTemplateParams) (*CreateTemplateResponse, error)
+	CreateTemplateAsync(p *CreateTemplateParams) (*Job, error)
+	CreateTemplateAsyncWithContext(ctx context.Context, p *CreateTemplateParams) (*Job, error)
+	NewDeleteTemplateParams(id string) *DeleteTemplateParams
+	DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error)
+	DeleteTemplateWithContext(ctx context.Context, p *DeleteTemplateParams) (*DeleteTemplateResponse, error)
+	DeleteTemplateAsync(p *DeleteTemplateParams) (*Job, error)
+	DeleteTemplateAsyncWithContext(ctx context.Context, p *DeleteTemplateParams) (*Job, error)
+	NewExtractTemplateParams(id string, mode string) *ExtractTemplateParams
+	ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error)
+	ExtractTemplateWithContext(ctx context.Context, p *ExtractTemplateParams) (*ExtractTemplateResponse, error)
+	ExtractTemplateAsync(p *ExtractTemplateParams) (*Job, error)
+	ExtractTemplateAsyncWithContext(ctx context.Context, p *ExtractTemplateParams) (*Job, error)
+	NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor string, name string, zoneid string) *GetUploadParamsForTemplateParams
+	GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error)
+	GetUploadParamsForTemplateWithContext(ctx context.Context, p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error)
+	NewListTemplatePermissionsParams(id string) *ListTemplatePermissionsParams
+	GetTemplatePermissionByID(id string, opts ...OptionFunc) (*TemplatePermission, int, error)
+	GetTemplatePermissionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*TemplatePermission, int, error)
+	ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error)
+	ListTemplatePermissionsWithContext(ctx context.Context, p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error)
+	NewListTemplatesParams(templatefilter string) *ListTemplatesParams
+	GetTemplateID(name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetTemplateIDWithContext(ctx context.Context, name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetTemplateByName(name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error)
+	GetTemplateByNameWithContext(ctx context.Context, name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error)
+	GetTemplateByID(id string, templatefilter string, opts ...OptionFunc) (*Template, int, error)
+	GetTemplateByIDWithContext(ctx context.Context, id string, templatefilter string, opts ...OptionFunc) (*Template, int, error)
+	ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error)
+	ListTemplatesWithContext(ctx context.Context, p *ListTemplatesParams) (*ListTemplatesResponse, error)
+	NewListTemplatesPager(p *ListTemplatesParams) *ListTemplatesPager
+	ListTemplatesAll(p *ListTemplatesParams) (*ListTemplatesResponse, error)
+	ListTemplatesAllWithContext(ctx context.Context, p *ListTemplatesParams) (*ListTemplatesResponse, error)
+	NewPrepareTemplateParams(templateid string, zoneid string) *PrepareTemplateParams
+	PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error)
+	PrepareTemplateWithContext(ctx context.Context, p *PrepareTemplateParams) (*PrepareTemplateResponse, error)
+	NewRegisterTemplateParams(displaytext string, format string, hypervisor string, name string, url string) *RegisterTemplateParams
+	RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error)
+	RegisterTemplateWithContext(ctx context.Context, p *RegisterTemplateParams) (*RegisterTemplateResponse, error)
+	NewUpdateTemplateParams(id string) *UpdateTemplateParams
+	UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error)
+	UpdateTemplateWithContext(ctx context.Context, p *UpdateTemplateParams) (*UpdateTemplateResponse, error)
+	NewUpdateTemplatePermissionsParams(id string) *UpdateTemplatePermissionsParams
+	UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error)
+	UpdateTemplatePermissionsWithContext(ctx context.Context, p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error)
+	NewUpgradeRouterTemplateParams() *UpgradeRouterTemplateParams
+	UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error)
+	UpgradeRouterTemplateWithContext(ctx context.Context, p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error)
+}
+
 type CopyTemplateParams struct {
 	p map[string]interface{}
 }
 
-func (p *CopyTemplateParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CopyTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -52,6 +115,52 @@ func (p *CopyTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CopyTemplateParams) Validate() error {
+	v := newParamValidator("copyTemplate", p.p)
+	v.required("id")
+	v.ids("destzoneid", "id", "sourcezoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CopyTemplateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CopyTemplateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "destzoneid", "id", "sourcezoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "destzoneids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for copyTemplate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of copyTemplate: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CopyTemplateParams) SetDestzoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -59,6 +168,15 @@ func (p *CopyTemplateParams) SetDestzoneid(v string) {
 	p.p["destzoneid"] = v
 }
 
+func (p *CopyTemplateParams) ResetDestzoneid() {
+	delete(p.p, "destzoneid")
+}
+
+func (p *CopyTemplateParams) GetDestzoneid() (string, bool) {
+	value, ok := p.p["destzoneid"].(string)
+	return value, ok
+}
+
 func (p *CopyTemplateParams) SetDestzoneids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -66,6 +184,15 @@ func (p *CopyTemplateParams) SetDestzoneids(v []string) {
 	p.p["destzoneids"] = v
 }
 
+func (p *CopyTemplateParams) ResetDestzoneids() {
+	delete(p.p, "destzoneids")
+}
+
+func (p *CopyTemplateParams) GetDestzoneids() ([]string, bool) {
+	value, ok := p.p["destzoneids"].([]string)
+	return value, ok
+}
+
 func (p *CopyTemplateParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -73,6 +200,15 @@ func (p *CopyTemplateParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *CopyTemplateParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *CopyTemplateParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *CopyTemplateParams) SetSourcezoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -80,6 +216,15 @@ func (p *CopyTemplateParams) SetSourcezoneid(v string) {
 	p.p["sourcezoneid"] = v
 }
 
+func (p *CopyTemplateParams) ResetSourcezoneid() {
+	delete(p.p, "sourcezoneid")
+}
+
+func (p *CopyTemplateParams) GetSourcezoneid() (string, bool) {
+	value, ok := p.p["sourcezoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CopyTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewCopyTemplateParams(id string) *CopyTemplateParams {
@@ -91,7 +236,16 @@ func (s *TemplateService) NewCopyTemplateParams(id string) *CopyTemplateParams {
 
 // Copies a template from one zone to another.
 func (s *TemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error) {
-	resp, err := s.cs.newRequest("copyTemplate", p.toURLValues())
+	return s.CopyTemplateWithContext(context.Background(), p)
+}
+
+// CopyTemplateWithContext is the same as CopyTemplate, but uses ctx to cancel the request and any async job polling
+func (s *TemplateService) CopyTemplateWithContext(ctx context.Context, p *CopyTemplateParams) (*CopyTemplateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "copyTemplate", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +257,7 @@ func (s *TemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResp
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -124,6 +278,40 @@ func (s *TemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResp
 	return &r, nil
 }
 
+// CopyTemplateAsync starts the async job for CopyTemplate and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CopyTemplateResponse using Job.Result
+func (s *TemplateService) CopyTemplateAsync(p *CopyTemplateParams) (*Job, error) {
+	return s.CopyTemplateAsyncWithContext(context.Background(), p)
+}
+
+// CopyTemplateAsyncWithContext is the same as CopyTemplateAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *TemplateService) CopyTemplateAsyncWithContext(ctx context.Context, p *CopyTemplateParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "copyTemplate", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CopyTemplateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CopyTemplateResponse struct {
 	Account               string            `json:"account"`
 	Accountid             string            `json:"accountid"`
@@ -206,7 +394,8 @@ type CreateTemplateParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateTemplateParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -275,6 +464,64 @@ func (p *CreateTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateTemplateParams) Validate() error {
+	v := newParamValidator("createTemplate", p.p)
+	v.required("displaytext", "name", "ostypeid")
+	v.ids("ostypeid", "projectid", "snapshotid", "virtualmachineid", "volumeid")
+	v.maxLength("displaytext", 4096)
+	v.maxLength("name", 255)
+	v.maxLength("templatetag", 255)
+	v.maxLength("url", 2048)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateTemplateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateTemplateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "bits":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "displaytext", "name", "ostypeid", "projectid", "snapshotid", "templatetag", "url", "virtualmachineid", "volumeid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isdynamicallyscalable", "isfeatured", "ispublic", "passwordenabled", "requireshvm", "sshkeyenabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createTemplate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createTemplate: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateTemplateParams) SetBits(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -282,6 +529,15 @@ func (p *CreateTemplateParams) SetBits(v int) {
 	p.p["bits"] = v
 }
 
+func (p *CreateTemplateParams) ResetBits() {
+	delete(p.p, "bits")
+}
+
+func (p *CreateTemplateParams) GetBits() (int, bool) {
+	value, ok := p.p["bits"].(int)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -289,6 +545,15 @@ func (p *CreateTemplateParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *CreateTemplateParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *CreateTemplateParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -296,6 +561,15 @@ func (p *CreateTemplateParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *CreateTemplateParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *CreateTemplateParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetIsdynamicallyscalable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -303,6 +577,15 @@ func (p *CreateTemplateParams) SetIsdynamicallyscalable(v bool) {
 	p.p["isdynamicallyscalable"] = v
 }
 
+func (p *CreateTemplateParams) ResetIsdynamicallyscalable() {
+	delete(p.p, "isdynamicallyscalable")
+}
+
+func (p *CreateTemplateParams) GetIsdynamicallyscalable() (bool, bool) {
+	value, ok := p.p["isdynamicallyscalable"].(bool)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetIsfeatured(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -310,6 +593,15 @@ func (p *CreateTemplateParams) SetIsfeatured(v bool) {
 	p.p["isfeatured"] = v
 }
 
+func (p *CreateTemplateParams) ResetIsfeatured() {
+	delete(p.p, "isfeatured")
+}
+
+func (p *CreateTemplateParams) GetIsfeatured() (bool, bool) {
+	value, ok := p.p["isfeatured"].(bool)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -317,6 +609,15 @@ func (p *CreateTemplateParams) SetIspublic(v bool) {
 	p.p["ispublic"] = v
 }
 
+func (p *CreateTemplateParams) ResetIspublic() {
+	delete(p.p, "ispublic")
+}
+
+func (p *CreateTemplateParams) GetIspublic() (bool, bool) {
+	value, ok := p.p["ispublic"].(bool)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -324,6 +625,15 @@ func (p *CreateTemplateParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateTemplateParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateTemplateParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -331,6 +641,15 @@ func (p *CreateTemplateParams) SetOstypeid(v string) {
 	p.p["ostypeid"] = v
 }
 
+func (p *CreateTemplateParams) ResetOstypeid() {
+	delete(p.p, "ostypeid")
+}
+
+func (p *CreateTemplateParams) GetOstypeid() (string, bool) {
+	value, ok := p.p["ostypeid"].(string)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetPasswordenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -338,6 +657,15 @@ func (p *CreateTemplateParams) SetPasswordenabled(v bool) {
 	p.p["passwordenabled"] = v
 }
 
+func (p *CreateTemplateParams) ResetPasswordenabled() {
+	delete(p.p, "passwordenabled")
+}
+
+func (p *CreateTemplateParams) GetPasswordenabled() (bool, bool) {
+	value, ok := p.p["passwordenabled"].(bool)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -345,6 +673,15 @@ func (p *CreateTemplateParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *CreateTemplateParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *CreateTemplateParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetRequireshvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -352,6 +689,15 @@ func (p *CreateTemplateParams) SetRequireshvm(v bool) {
 	p.p["requireshvm"] = v
 }
 
+func (p *CreateTemplateParams) ResetRequireshvm() {
+	delete(p.p, "requireshvm")
+}
+
+func (p *CreateTemplateParams) GetRequireshvm() (bool, bool) {
+	value, ok := p.p["requireshvm"].(bool)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetSnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -359,6 +705,15 @@ func (p *CreateTemplateParams) SetSnapshotid(v string) {
 	p.p["snapshotid"] = v
 }
 
+func (p *CreateTemplateParams) ResetSnapshotid() {
+	delete(p.p, "snapshotid")
+}
+
+func (p *CreateTemplateParams) GetSnapshotid() (string, bool) {
+	value, ok := p.p["snapshotid"].(string)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetSshkeyenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -366,6 +721,15 @@ func (p *CreateTemplateParams) SetSshkeyenabled(v bool) {
 	p.p["sshkeyenabled"] = v
 }
 
+func (p *CreateTemplateParams) ResetSshkeyenabled() {
+	delete(p.p, "sshkeyenabled")
+}
+
+func (p *CreateTemplateParams) GetSshkeyenabled() (bool, bool) {
+	value, ok := p.p["sshkeyenabled"].(bool)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetTemplatetag(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -373,6 +737,15 @@ func (p *CreateTemplateParams) SetTemplatetag(v string) {
 	p.p["templatetag"] = v
 }
 
+func (p *CreateTemplateParams) ResetTemplatetag() {
+	delete(p.p, "templatetag")
+}
+
+func (p *CreateTemplateParams) GetTemplatetag() (string, bool) {
+	value, ok := p.p["templatetag"].(string)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -380,6 +753,15 @@ func (p *CreateTemplateParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *CreateTemplateParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *CreateTemplateParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -387,6 +769,15 @@ func (p *CreateTemplateParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *CreateTemplateParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *CreateTemplateParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 func (p *CreateTemplateParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -394,6 +785,15 @@ func (p *CreateTemplateParams) SetVolumeid(v string) {
 	p.p["volumeid"] = v
 }
 
+func (p *CreateTemplateParams) ResetVolumeid() {
+	delete(p.p, "volumeid")
+}
+
+func (p *CreateTemplateParams) GetVolumeid() (string, bool) {
+	value, ok := p.p["volumeid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewCreateTemplateParams(displaytext string, name string, ostypeid string) *CreateTemplateParams {
@@ -407,7 +807,16 @@ func (s *TemplateService) NewCreateTemplateParams(displaytext string, name strin
 
 // Creates a template of a virtual machine. The virtual machine must be in a STOPPED state. A template created from this command is automatically designated as a private template visible to the account that created it.
 func (s *TemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error) {
-	resp, err := s.cs.newRequest("createTemplate", p.toURLValues())
+	return s.CreateTemplateWithContext(context.Background(), p)
+}
+
+// CreateTemplateWithContext is the same as CreateTemplate, but uses ctx to cancel the request and any async job polling
+func (s *TemplateService) CreateTemplateWithContext(ctx context.Context, p *CreateTemplateParams) (*CreateTemplateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createTemplate", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -419,7 +828,7 @@ func (s *TemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTempla
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -440,6 +849,40 @@ func (s *TemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTempla
 	return &r, nil
 }
 
+// CreateTemplateAsync starts the async job for CreateTemplate and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateTemplateResponse using Job.Result
+func (s *TemplateService) CreateTemplateAsync(p *CreateTemplateParams) (*Job, error) {
+	return s.CreateTemplateAsyncWithContext(context.Background(), p)
+}
+
+// CreateTemplateAsyncWithContext is the same as CreateTemplateAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *TemplateService) CreateTemplateAsyncWithContext(ctx context.Context, p *CreateTemplateParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createTemplate", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateTemplateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateTemplateResponse struct {
 	Account               string            `json:"account"`
 	Accountid             string            `json:"accountid"`
@@ -522,7 +965,8 @@ type DeleteTemplateParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteTemplateParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -540,6 +984,52 @@ func (p *DeleteTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteTemplateParams) Validate() error {
+	v := newParamValidator("deleteTemplate", p.p)
+	v.required("id")
+	v.ids("id", "zoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteTemplateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteTemplateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "forced":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteTemplate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteTemplate: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteTemplateParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -547,6 +1037,15 @@ func (p *DeleteTemplateParams) SetForced(v bool) {
 	p.p["forced"] = v
 }
 
+func (p *DeleteTemplateParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *DeleteTemplateParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
 func (p *DeleteTemplateParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -554,6 +1053,15 @@ func (p *DeleteTemplateParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteTemplateParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteTemplateParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *DeleteTemplateParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -561,6 +1069,15 @@ func (p *DeleteTemplateParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *DeleteTemplateParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *DeleteTemplateParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewDeleteTemplateParams(id string) *DeleteTemplateParams {
@@ -572,7 +1089,16 @@ func (s *TemplateService) NewDeleteTemplateParams(id string) *DeleteTemplatePara
 
 // Deletes a template from the system. All virtual machines using the deleted template will not be affected.
 func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
-	resp, err := s.cs.newRequest("deleteTemplate", p.toURLValues())
+	return s.DeleteTemplateWithContext(context.Background(), p)
+}
+
+// DeleteTemplateWithContext is the same as DeleteTemplate, but uses ctx to cancel the request and any async job polling
+func (s *TemplateService) DeleteTemplateWithContext(ctx context.Context, p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteTemplate", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -584,7 +1110,7 @@ func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTempla
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -600,6 +1126,31 @@ func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTempla
 	return &r, nil
 }
 
+// DeleteTemplateAsync starts the async job for DeleteTemplate and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteTemplateResponse using Job.Result
+func (s *TemplateService) DeleteTemplateAsync(p *DeleteTemplateParams) (*Job, error) {
+	return s.DeleteTemplateAsyncWithContext(context.Background(), p)
+}
+
+// DeleteTemplateAsyncWithContext is the same as DeleteTemplateAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *TemplateService) DeleteTemplateAsyncWithContext(ctx context.Context, p *DeleteTemplateParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteTemplate", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteTemplateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteTemplateResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -611,7 +1162,8 @@ type ExtractTemplateParams struct {
 	p map[string]interface{}
 }
 
-func (p *ExtractTemplateParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ExtractTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -631,6 +1183,50 @@ func (p *ExtractTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ExtractTemplateParams) Validate() error {
+	v := newParamValidator("extractTemplate", p.p)
+	v.required("id", "mode")
+	v.ids("id", "zoneid")
+	v.maxLength("mode", 255)
+	v.maxLength("url", 2048)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ExtractTemplateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ExtractTemplateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "mode", "url", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for extractTemplate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of extractTemplate: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ExtractTemplateParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -638,6 +1234,15 @@ func (p *ExtractTemplateParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ExtractTemplateParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ExtractTemplateParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ExtractTemplateParams) SetMode(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -645,6 +1250,15 @@ func (p *ExtractTemplateParams) SetMode(v string) {
 	p.p["mode"] = v
 }
 
+func (p *ExtractTemplateParams) ResetMode() {
+	delete(p.p, "mode")
+}
+
+func (p *ExtractTemplateParams) GetMode() (string, bool) {
+	value, ok := p.p["mode"].(string)
+	return value, ok
+}
+
 func (p *ExtractTemplateParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -652,6 +1266,15 @@ func (p *ExtractTemplateParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *ExtractTemplateParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *ExtractTemplateParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *ExtractTemplateParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -659,6 +1282,15 @@ func (p *ExtractTemplateParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ExtractTemplateParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ExtractTemplateParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ExtractTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewExtractTemplateParams(id string, mode string) *ExtractTemplateParams {
@@ -671,7 +1303,16 @@ func (s *TemplateService) NewExtractTemplateParams(id string, mode string) *Extr
 
 // Extracts a template
 func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
-	resp, err := s.cs.newRequest("extractTemplate", p.toURLValues())
+	return s.ExtractTemplateWithContext(context.Background(), p)
+}
+
+// ExtractTemplateWithContext is the same as ExtractTemplate, but uses ctx to cancel the request and any async job polling
+func (s *TemplateService) ExtractTemplateWithContext(ctx context.Context, p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "extractTemplate", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -683,7 +1324,7 @@ func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTem
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -704,6 +1345,40 @@ func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTem
 	return &r, nil
 }
 
+// ExtractTemplateAsync starts the async job for ExtractTemplate and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ExtractTemplateResponse using Job.Result
+func (s *TemplateService) ExtractTemplateAsync(p *ExtractTemplateParams) (*Job, error) {
+	return s.ExtractTemplateAsyncWithContext(context.Background(), p)
+}
+
+// ExtractTemplateAsyncWithContext is the same as ExtractTemplateAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *TemplateService) ExtractTemplateAsyncWithContext(ctx context.Context, p *ExtractTemplateParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "extractTemplate", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ExtractTemplateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type ExtractTemplateResponse struct {
 	Accountid        string `json:"accountid"`
 	Created          string `json:"created"`
@@ -727,7 +1402,8 @@ type GetUploadParamsForTemplateParams struct {
 	p map[string]interface{}
 }
 
-func (p *GetUploadParamsForTemplateParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetUploadParamsForTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -814,6 +1490,67 @@ func (p *GetUploadParamsForTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetUploadParamsForTemplateParams) Validate() error {
+	v := newParamValidator("getUploadParamsForTemplate", p.p)
+	v.required("displaytext", "format", "hypervisor", "name", "zoneid")
+	v.ids("domainid", "ostypeid", "projectid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("checksum", 255)
+	v.maxLength("displaytext", 4096)
+	v.maxLength("format", 255)
+	v.maxLength("hypervisor", 255)
+	v.maxLength("name", 255)
+	v.maxLength("templatetag", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetUploadParamsForTemplateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetUploadParamsForTemplateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "checksum", "displaytext", "domainid", "format", "hypervisor", "name", "ostypeid", "projectid", "templatetag", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "bits":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "deployasis", "isdynamicallyscalable", "isextractable", "isfeatured", "ispublic", "isrouting", "passwordenabled", "requireshvm", "sshkeyenabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getUploadParamsForTemplate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getUploadParamsForTemplate: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *GetUploadParamsForTemplateParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -821,6 +1558,15 @@ func (p *GetUploadParamsForTemplateParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetBits(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -828,6 +1574,15 @@ func (p *GetUploadParamsForTemplateParams) SetBits(v int) {
 	p.p["bits"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetBits() {
+	delete(p.p, "bits")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetBits() (int, bool) {
+	value, ok := p.p["bits"].(int)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetChecksum(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -835,6 +1590,15 @@ func (p *GetUploadParamsForTemplateParams) SetChecksum(v string) {
 	p.p["checksum"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetChecksum() {
+	delete(p.p, "checksum")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetChecksum() (string, bool) {
+	value, ok := p.p["checksum"].(string)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetDeployasis(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -842,6 +1606,15 @@ func (p *GetUploadParamsForTemplateParams) SetDeployasis(v bool) {
 	p.p["deployasis"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetDeployasis() {
+	delete(p.p, "deployasis")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetDeployasis() (bool, bool) {
+	value, ok := p.p["deployasis"].(bool)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -849,6 +1622,15 @@ func (p *GetUploadParamsForTemplateParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -856,6 +1638,15 @@ func (p *GetUploadParamsForTemplateParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -863,6 +1654,15 @@ func (p *GetUploadParamsForTemplateParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetFormat(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -870,6 +1670,15 @@ func (p *GetUploadParamsForTemplateParams) SetFormat(v string) {
 	p.p["format"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetFormat() {
+	delete(p.p, "format")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetFormat() (string, bool) {
+	value, ok := p.p["format"].(string)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -877,6 +1686,15 @@ func (p *GetUploadParamsForTemplateParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetIsdynamicallyscalable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -884,6 +1702,15 @@ func (p *GetUploadParamsForTemplateParams) SetIsdynamicallyscalable(v bool) {
 	p.p["isdynamicallyscalable"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetIsdynamicallyscalable() {
+	delete(p.p, "isdynamicallyscalable")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetIsdynamicallyscalable() (bool, bool) {
+	value, ok := p.p["isdynamicallyscalable"].(bool)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetIsextractable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -891,6 +1718,15 @@ func (p *GetUploadParamsForTemplateParams) SetIsextractable(v bool) {
 	p.p["isextractable"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetIsextractable() {
+	delete(p.p, "isextractable")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetIsextractable() (bool, bool) {
+	value, ok := p.p["isextractable"].(bool)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetIsfeatured(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -898,6 +1734,15 @@ func (p *GetUploadParamsForTemplateParams) SetIsfeatured(v bool) {
 	p.p["isfeatured"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetIsfeatured() {
+	delete(p.p, "isfeatured")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetIsfeatured() (bool, bool) {
+	value, ok := p.p["isfeatured"].(bool)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -905,6 +1750,15 @@ func (p *GetUploadParamsForTemplateParams) SetIspublic(v bool) {
 	p.p["ispublic"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetIspublic() {
+	delete(p.p, "ispublic")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetIspublic() (bool, bool) {
+	value, ok := p.p["ispublic"].(bool)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetIsrouting(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -912,6 +1766,15 @@ func (p *GetUploadParamsForTemplateParams) SetIsrouting(v bool) {
 	p.p["isrouting"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetIsrouting() {
+	delete(p.p, "isrouting")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetIsrouting() (bool, bool) {
+	value, ok := p.p["isrouting"].(bool)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -919,6 +1782,15 @@ func (p *GetUploadParamsForTemplateParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -926,6 +1798,15 @@ func (p *GetUploadParamsForTemplateParams) SetOstypeid(v string) {
 	p.p["ostypeid"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetOstypeid() {
+	delete(p.p, "ostypeid")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetOstypeid() (string, bool) {
+	value, ok := p.p["ostypeid"].(string)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetPasswordenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -933,6 +1814,15 @@ func (p *GetUploadParamsForTemplateParams) SetPasswordenabled(v bool) {
 	p.p["passwordenabled"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetPasswordenabled() {
+	delete(p.p, "passwordenabled")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetPasswordenabled() (bool, bool) {
+	value, ok := p.p["passwordenabled"].(bool)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -940,6 +1830,15 @@ func (p *GetUploadParamsForTemplateParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetRequireshvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -947,6 +1846,15 @@ func (p *GetUploadParamsForTemplateParams) SetRequireshvm(v bool) {
 	p.p["requireshvm"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetRequireshvm() {
+	delete(p.p, "requireshvm")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetRequireshvm() (bool, bool) {
+	value, ok := p.p["requireshvm"].(bool)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetSshkeyenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -954,6 +1862,15 @@ func (p *GetUploadParamsForTemplateParams) SetSshkeyenabled(v bool) {
 	p.p["sshkeyenabled"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetSshkeyenabled() {
+	delete(p.p, "sshkeyenabled")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetSshkeyenabled() (bool, bool) {
+	value, ok := p.p["sshkeyenabled"].(bool)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetTemplatetag(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -961,6 +1878,15 @@ func (p *GetUploadParamsForTemplateParams) SetTemplatetag(v string) {
 	p.p["templatetag"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetTemplatetag() {
+	delete(p.p, "templatetag")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetTemplatetag() (string, bool) {
+	value, ok := p.p["templatetag"].(string)
+	return value, ok
+}
+
 func (p *GetUploadParamsForTemplateParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -968,6 +1894,15 @@ func (p *GetUploadParamsForTemplateParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *GetUploadParamsForTemplateParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *GetUploadParamsForTemplateParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new GetUploadParamsForTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor string, name string, zoneid string) *GetUploadParamsForTemplateParams {
@@ -983,7 +1918,16 @@ func (s *TemplateService) NewGetUploadParamsForTemplateParams(displaytext string
 
 // upload an existing template into the CloudStack cloud.
 func (s *TemplateService) GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error) {
-	resp, err := s.cs.newRequest("getUploadParamsForTemplate", p.toURLValues())
+	return s.GetUploadParamsForTemplateWithContext(context.Background(), p)
+}
+
+// GetUploadParamsForTemplateWithContext is the same as GetUploadParamsForTemplate, but uses ctx to cancel the request and any async job polling
+func (s *TemplateService) GetUploadParamsForTemplateWithContext(ctx context.Context, p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getUploadParamsForTemplate", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1010,7 +1954,8 @@ type ListTemplatePermissionsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListTemplatePermissionsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListTemplatePermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1021,6 +1966,48 @@ func (p *ListTemplatePermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListTemplatePermissionsParams) Validate() error {
+	v := newParamValidator("listTemplatePermissions", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListTemplatePermissionsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListTemplatePermissionsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listTemplatePermissions", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listTemplatePermissions: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListTemplatePermissionsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1028,6 +2015,15 @@ func (p *ListTemplatePermissionsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListTemplatePermissionsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListTemplatePermissionsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListTemplatePermissionsParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewListTemplatePermissionsParams(id string) *ListTemplatePermissionsParams {
@@ -1039,6 +2035,11 @@ func (s *TemplateService) NewListTemplatePermissionsParams(id string) *ListTempl
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *TemplateService) GetTemplatePermissionByID(id string, opts ...OptionFunc) (*TemplatePermission, int, error) {
+	return s.GetTemplatePermissionByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetTemplatePermissionByIDWithContext is the same as GetTemplatePermissionByID, but uses ctx to cancel the request
+func (s *TemplateService) GetTemplatePermissionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*TemplatePermission, int, error) {
 	p := &ListTemplatePermissionsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1050,18 +2051,16 @@ func (s *TemplateService) GetTemplatePermissionByID(id string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListTemplatePermissions(p)
+	l, err := s.ListTemplatePermissionsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1072,7 +2071,16 @@ func (s *TemplateService) GetTemplatePermissionByID(id string, opts ...OptionFun
 
 // List template visibility and all accounts that have permissions to view this template.
 func (s *TemplateService) ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listTemplatePermissions", p.toURLValues())
+	return s.ListTemplatePermissionsWithContext(context.Background(), p)
+}
+
+// ListTemplatePermissionsWithContext is the same as ListTemplatePermissions, but uses ctx to cancel the request and any async job polling
+func (s *TemplateService) ListTemplatePermissionsWithContext(ctx context.Context, p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listTemplatePermissions", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1104,7 +2112,8 @@ type ListTemplatesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListTemplatesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListTemplatesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1181,6 +2190,70 @@ func (p *ListTemplatesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListTemplatesParams) Validate() error {
+	v := newParamValidator("listTemplates", p.p)
+	v.required("templatefilter")
+	v.ids("domainid", "id", "parenttemplateid", "projectid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("hypervisor", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("templatefilter", 255)
+	v.oneOf("templatefilter", "featured", "self", "selfexecutable", "sharedexecutable", "executable", "community", "all")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListTemplatesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListTemplatesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "hypervisor", "id", "keyword", "name", "parenttemplateid", "projectid", "templatefilter", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details", "ids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall", "showremoved", "showunique":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listTemplates", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listTemplates: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListTemplatesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1188,6 +2261,15 @@ func (p *ListTemplatesParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListTemplatesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListTemplatesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetDetails(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1195,6 +2277,15 @@ func (p *ListTemplatesParams) SetDetails(v []string) {
 	p.p["details"] = v
 }
 
+func (p *ListTemplatesParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *ListTemplatesParams) GetDetails() ([]string, bool) {
+	value, ok := p.p["details"].([]string)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1202,6 +2293,15 @@ func (p *ListTemplatesParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListTemplatesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListTemplatesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1209,6 +2309,15 @@ func (p *ListTemplatesParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *ListTemplatesParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *ListTemplatesParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1216,6 +2325,15 @@ func (p *ListTemplatesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListTemplatesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListTemplatesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1223,6 +2341,15 @@ func (p *ListTemplatesParams) SetIds(v []string) {
 	p.p["ids"] = v
 }
 
+func (p *ListTemplatesParams) ResetIds() {
+	delete(p.p, "ids")
+}
+
+func (p *ListTemplatesParams) GetIds() ([]string, bool) {
+	value, ok := p.p["ids"].([]string)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1230,6 +2357,15 @@ func (p *ListTemplatesParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListTemplatesParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListTemplatesParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1237,6 +2373,15 @@ func (p *ListTemplatesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListTemplatesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListTemplatesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1244,6 +2389,15 @@ func (p *ListTemplatesParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListTemplatesParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListTemplatesParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1251,6 +2405,15 @@ func (p *ListTemplatesParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListTemplatesParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListTemplatesParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1258,6 +2421,15 @@ func (p *ListTemplatesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListTemplatesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListTemplatesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1265,6 +2437,15 @@ func (p *ListTemplatesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListTemplatesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListTemplatesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetParenttemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1272,6 +2453,15 @@ func (p *ListTemplatesParams) SetParenttemplateid(v string) {
 	p.p["parenttemplateid"] = v
 }
 
+func (p *ListTemplatesParams) ResetParenttemplateid() {
+	delete(p.p, "parenttemplateid")
+}
+
+func (p *ListTemplatesParams) GetParenttemplateid() (string, bool) {
+	value, ok := p.p["parenttemplateid"].(string)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1279,6 +2469,15 @@ func (p *ListTemplatesParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListTemplatesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListTemplatesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetShowremoved(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1286,6 +2485,15 @@ func (p *ListTemplatesParams) SetShowremoved(v bool) {
 	p.p["showremoved"] = v
 }
 
+func (p *ListTemplatesParams) ResetShowremoved() {
+	delete(p.p, "showremoved")
+}
+
+func (p *ListTemplatesParams) GetShowremoved() (bool, bool) {
+	value, ok := p.p["showremoved"].(bool)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetShowunique(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1293,6 +2501,15 @@ func (p *ListTemplatesParams) SetShowunique(v bool) {
 	p.p["showunique"] = v
 }
 
+func (p *ListTemplatesParams) ResetShowunique() {
+	delete(p.p, "showunique")
+}
+
+func (p *ListTemplatesParams) GetShowunique() (bool, bool) {
+	value, ok := p.p["showunique"].(bool)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1300,6 +2517,15 @@ func (p *ListTemplatesParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListTemplatesParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListTemplatesParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetTemplatefilter(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1307,6 +2533,15 @@ func (p *ListTemplatesParams) SetTemplatefilter(v string) {
 	p.p["templatefilter"] = v
 }
 
+func (p *ListTemplatesParams) ResetTemplatefilter() {
+	delete(p.p, "templatefilter")
+}
+
+func (p *ListTemplatesParams) GetTemplatefilter() (string, bool) {
+	value, ok := p.p["templatefilter"].(string)
+	return value, ok
+}
+
 func (p *ListTemplatesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1314,6 +2549,15 @@ func (p *ListTemplatesParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListTemplatesParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListTemplatesParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListTemplatesParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewListTemplatesParams(templatefilter string) *ListTemplatesParams {
@@ -1325,6 +2569,11 @@ func (s *TemplateService) NewListTemplatesParams(templatefilter string) *ListTem
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *TemplateService) GetTemplateID(name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetTemplateIDWithContext(context.Background(), name, templatefilter, zoneid, opts...)
+}
+
+// GetTemplateIDWithContext is the same as GetTemplateID, but uses ctx to cancel the request
+func (s *TemplateService) GetTemplateIDWithContext(ctx context.Context, name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListTemplatesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1338,7 +2587,7 @@ func (s *TemplateService) GetTemplateID(name string, templatefilter string, zone
 		}
 	}
 
-	l, err := s.ListTemplates(p)
+	l, err := s.ListTemplatesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1363,12 +2612,17 @@ func (s *TemplateService) GetTemplateID(name string, templatefilter string, zone
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *TemplateService) GetTemplateByName(name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error) {
-	id, count, err := s.GetTemplateID(name, templatefilter, zoneid, opts...)
+	return s.GetTemplateByNameWithContext(context.Background(), name, templatefilter, zoneid, opts...)
+}
+
+// GetTemplateByNameWithContext is the same as GetTemplateByName, but uses ctx to cancel the requests
+func (s *TemplateService) GetTemplateByNameWithContext(ctx context.Context, name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error) {
+	id, count, err := s.GetTemplateIDWithContext(ctx, name, templatefilter, zoneid, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetTemplateByID(id, templatefilter, opts...)
+	r, count, err := s.GetTemplateByIDWithContext(ctx, id, templatefilter, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1377,6 +2631,11 @@ func (s *TemplateService) GetTemplateByName(name string, templatefilter string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *TemplateService) GetTemplateByID(id string, templatefilter string, opts ...OptionFunc) (*Template, int, error) {
+	return s.GetTemplateByIDWithContext(context.Background(), id, templatefilter, opts...)
+}
+
+// GetTemplateByIDWithContext is the same as GetTemplateByID, but uses ctx to cancel the request
+func (s *TemplateService) GetTemplateByIDWithContext(ctx context.Context, id string, templatefilter string, opts ...OptionFunc) (*Template, int, error) {
 	p := &ListTemplatesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1389,18 +2648,16 @@ func (s *TemplateService) GetTemplateByID(id string, templatefilter string, opts
 		}
 	}
 
-	l, err := s.ListTemplates(p)
+	l, err := s.ListTemplatesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1411,7 +2668,16 @@ func (s *TemplateService) GetTemplateByID(id string, templatefilter string, opts
 
 // List all public, private, and privileged templates.
 func (s *TemplateService) ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error) {
-	resp, err := s.cs.newRequest("listTemplates", p.toURLValues())
+	return s.ListTemplatesWithContext(context.Background(), p)
+}
+
+// ListTemplatesWithContext is the same as ListTemplates, but uses ctx to cancel the request and any async job polling
+func (s *TemplateService) ListTemplatesWithContext(ctx context.Context, p *ListTemplatesParams) (*ListTemplatesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listTemplates", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1424,6 +2690,92 @@ func (s *TemplateService) ListTemplates(p *ListTemplatesParams) (*ListTemplatesR
 	return &r, nil
 }
 
+// ListTemplatesPager iterates over all pages of a ListTemplates call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListTemplatesPager struct {
+	pager
+
+	s *TemplateService
+	p *ListTemplatesParams
+	r *ListTemplatesResponse
+}
+
+// NewListTemplatesPager returns a pager for all pages of a ListTemplates call with the given params
+func (s *TemplateService) NewListTemplatesPager(p *ListTemplatesParams) *ListTemplatesPager {
+	return &ListTemplatesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListTemplatesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListTemplatesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListTemplatesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListTemplatesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Templates), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListTemplatesPager) Page() *ListTemplatesResponse {
+	return pg.r
+}
+
+// ListTemplatesAll fetches all pages of a ListTemplates call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *TemplateService) ListTemplatesAll(p *ListTemplatesParams) (*ListTemplatesResponse, error) {
+	return s.ListTemplatesAllWithContext(context.Background(), p)
+}
+
+// ListTemplatesAllWithContext is the same as ListTemplatesAll, but uses ctx to cancel the requests
+func (s *TemplateService) ListTemplatesAllWithContext(ctx context.Context, p *ListTemplatesParams) (*ListTemplatesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Template)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListTemplatesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListTemplatesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Templates
+		mu.Unlock()
+
+		return len(l.Templates), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListTemplatesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Templates = append(r.Templates, pages[page]...)
+	}
+	r.Count = len(r.Templates)
+
+	return r, nil
+}
+
 type ListTemplatesResponse struct {
 	Count     int         `json:"count"`
 	Templates []*Template `json:"template"`
@@ -1511,7 +2863,8 @@ type PrepareTemplateParams struct {
 	p map[string]interface{}
 }
 
-func (p *PrepareTemplateParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *PrepareTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1528,6 +2881,48 @@ func (p *PrepareTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *PrepareTemplateParams) Validate() error {
+	v := newParamValidator("prepareTemplate", p.p)
+	v.required("templateid", "zoneid")
+	v.ids("storageid", "templateid", "zoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *PrepareTemplateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *PrepareTemplateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "storageid", "templateid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for prepareTemplate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of prepareTemplate: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *PrepareTemplateParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1535,6 +2930,15 @@ func (p *PrepareTemplateParams) SetStorageid(v string) {
 	p.p["storageid"] = v
 }
 
+func (p *PrepareTemplateParams) ResetStorageid() {
+	delete(p.p, "storageid")
+}
+
+func (p *PrepareTemplateParams) GetStorageid() (string, bool) {
+	value, ok := p.p["storageid"].(string)
+	return value, ok
+}
+
 func (p *PrepareTemplateParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1542,6 +2946,15 @@ func (p *PrepareTemplateParams) SetTemplateid(v string) {
 	p.p["templateid"] = v
 }
 
+func (p *PrepareTemplateParams) ResetTemplateid() {
+	delete(p.p, "templateid")
+}
+
+func (p *PrepareTemplateParams) GetTemplateid() (string, bool) {
+	value, ok := p.p["templateid"].(string)
+	return value, ok
+}
+
 func (p *PrepareTemplateParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1549,6 +2962,15 @@ func (p *PrepareTemplateParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *PrepareTemplateParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *PrepareTemplateParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new PrepareTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewPrepareTemplateParams(templateid string, zoneid string) *PrepareTemplateParams {
@@ -1561,7 +2983,16 @@ func (s *TemplateService) NewPrepareTemplateParams(templateid string, zoneid str
 
 // load template into primary storage
 func (s *TemplateService) PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error) {
-	resp, err := s.cs.newRequest("prepareTemplate", p.toURLValues())
+	return s.PrepareTemplateWithContext(context.Background(), p)
+}
+
+// PrepareTemplateWithContext is the same as PrepareTemplate, but uses ctx to cancel the request and any async job polling
+func (s *TemplateService) PrepareTemplateWithContext(ctx context.Context, p *PrepareTemplateParams) (*PrepareTemplateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "prepareTemplate", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1656,7 +3087,8 @@ type RegisterTemplateParams struct {
 	p map[string]interface{}
 }
 
-func (p *RegisterTemplateParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RegisterTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1754,6 +3186,72 @@ func (p *RegisterTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RegisterTemplateParams) Validate() error {
+	v := newParamValidator("registerTemplate", p.p)
+	v.required("displaytext", "format", "hypervisor", "name", "url")
+	v.ids("domainid", "ostypeid", "projectid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("checksum", 255)
+	v.maxLength("displaytext", 4096)
+	v.maxLength("format", 255)
+	v.maxLength("hypervisor", 255)
+	v.maxLength("name", 255)
+	v.maxLength("templatetag", 255)
+	v.maxLength("url", 2048)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RegisterTemplateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RegisterTemplateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "checksum", "displaytext", "domainid", "format", "hypervisor", "name", "ostypeid", "projectid", "templatetag", "url", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "bits":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "deployasis", "directdownload", "isdynamicallyscalable", "isextractable", "isfeatured", "ispublic", "isrouting", "passwordenabled", "requireshvm", "sshkeyenabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "zoneids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for registerTemplate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of registerTemplate: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RegisterTemplateParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1761,6 +3259,15 @@ func (p *RegisterTemplateParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *RegisterTemplateParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *RegisterTemplateParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetBits(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1768,6 +3275,15 @@ func (p *RegisterTemplateParams) SetBits(v int) {
 	p.p["bits"] = v
 }
 
+func (p *RegisterTemplateParams) ResetBits() {
+	delete(p.p, "bits")
+}
+
+func (p *RegisterTemplateParams) GetBits() (int, bool) {
+	value, ok := p.p["bits"].(int)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetChecksum(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1775,6 +3291,15 @@ func (p *RegisterTemplateParams) SetChecksum(v string) {
 	p.p["checksum"] = v
 }
 
+func (p *RegisterTemplateParams) ResetChecksum() {
+	delete(p.p, "checksum")
+}
+
+func (p *RegisterTemplateParams) GetChecksum() (string, bool) {
+	value, ok := p.p["checksum"].(string)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetDeployasis(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1782,6 +3307,15 @@ func (p *RegisterTemplateParams) SetDeployasis(v bool) {
 	p.p["deployasis"] = v
 }
 
+func (p *RegisterTemplateParams) ResetDeployasis() {
+	delete(p.p, "deployasis")
+}
+
+func (p *RegisterTemplateParams) GetDeployasis() (bool, bool) {
+	value, ok := p.p["deployasis"].(bool)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1789,6 +3323,15 @@ func (p *RegisterTemplateParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *RegisterTemplateParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *RegisterTemplateParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetDirectdownload(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1796,6 +3339,15 @@ func (p *RegisterTemplateParams) SetDirectdownload(v bool) {
 	p.p["directdownload"] = v
 }
 
+func (p *RegisterTemplateParams) ResetDirectdownload() {
+	delete(p.p, "directdownload")
+}
+
+func (p *RegisterTemplateParams) GetDirectdownload() (bool, bool) {
+	value, ok := p.p["directdownload"].(bool)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1803,6 +3355,15 @@ func (p *RegisterTemplateParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *RegisterTemplateParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *RegisterTemplateParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1810,6 +3371,15 @@ func (p *RegisterTemplateParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *RegisterTemplateParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *RegisterTemplateParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetFormat(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1817,6 +3387,15 @@ func (p *RegisterTemplateParams) SetFormat(v string) {
 	p.p["format"] = v
 }
 
+func (p *RegisterTemplateParams) ResetFormat() {
+	delete(p.p, "format")
+}
+
+func (p *RegisterTemplateParams) GetFormat() (string, bool) {
+	value, ok := p.p["format"].(string)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1824,6 +3403,15 @@ func (p *RegisterTemplateParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *RegisterTemplateParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *RegisterTemplateParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetIsdynamicallyscalable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1831,6 +3419,15 @@ func (p *RegisterTemplateParams) SetIsdynamicallyscalable(v bool) {
 	p.p["isdynamicallyscalable"] = v
 }
 
+func (p *RegisterTemplateParams) ResetIsdynamicallyscalable() {
+	delete(p.p, "isdynamicallyscalable")
+}
+
+func (p *RegisterTemplateParams) GetIsdynamicallyscalable() (bool, bool) {
+	value, ok := p.p["isdynamicallyscalable"].(bool)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetIsextractable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1838,6 +3435,15 @@ func (p *RegisterTemplateParams) SetIsextractable(v bool) {
 	p.p["isextractable"] = v
 }
 
+func (p *RegisterTemplateParams) ResetIsextractable() {
+	delete(p.p, "isextractable")
+}
+
+func (p *RegisterTemplateParams) GetIsextractable() (bool, bool) {
+	value, ok := p.p["isextractable"].(bool)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetIsfeatured(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1845,6 +3451,15 @@ func (p *RegisterTemplateParams) SetIsfeatured(v bool) {
 	p.p["isfeatured"] = v
 }
 
+func (p *RegisterTemplateParams) ResetIsfeatured() {
+	delete(p.p, "isfeatured")
+}
+
+func (p *RegisterTemplateParams) GetIsfeatured() (bool, bool) {
+	value, ok := p.p["isfeatured"].(bool)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1852,6 +3467,15 @@ func (p *RegisterTemplateParams) SetIspublic(v bool) {
 	p.p["ispublic"] = v
 }
 
+func (p *RegisterTemplateParams) ResetIspublic() {
+	delete(p.p, "ispublic")
+}
+
+func (p *RegisterTemplateParams) GetIspublic() (bool, bool) {
+	value, ok := p.p["ispublic"].(bool)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetIsrouting(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1859,6 +3483,15 @@ func (p *RegisterTemplateParams) SetIsrouting(v bool) {
 	p.p["isrouting"] = v
 }
 
+func (p *RegisterTemplateParams) ResetIsrouting() {
+	delete(p.p, "isrouting")
+}
+
+func (p *RegisterTemplateParams) GetIsrouting() (bool, bool) {
+	value, ok := p.p["isrouting"].(bool)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1866,6 +3499,15 @@ func (p *RegisterTemplateParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *RegisterTemplateParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *RegisterTemplateParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1873,6 +3515,15 @@ func (p *RegisterTemplateParams) SetOstypeid(v string) {
 	p.p["ostypeid"] = v
 }
 
+func (p *RegisterTemplateParams) ResetOstypeid() {
+	delete(p.p, "ostypeid")
+}
+
+func (p *RegisterTemplateParams) GetOstypeid() (string, bool) {
+	value, ok := p.p["ostypeid"].(string)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetPasswordenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1880,6 +3531,15 @@ func (p *RegisterTemplateParams) SetPasswordenabled(v bool) {
 	p.p["passwordenabled"] = v
 }
 
+func (p *RegisterTemplateParams) ResetPasswordenabled() {
+	delete(p.p, "passwordenabled")
+}
+
+func (p *RegisterTemplateParams) GetPasswordenabled() (bool, bool) {
+	value, ok := p.p["passwordenabled"].(bool)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1887,6 +3547,15 @@ func (p *RegisterTemplateParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *RegisterTemplateParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *RegisterTemplateParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetRequireshvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1894,6 +3563,15 @@ func (p *RegisterTemplateParams) SetRequireshvm(v bool) {
 	p.p["requireshvm"] = v
 }
 
+func (p *RegisterTemplateParams) ResetRequireshvm() {
+	delete(p.p, "requireshvm")
+}
+
+func (p *RegisterTemplateParams) GetRequireshvm() (bool, bool) {
+	value, ok := p.p["requireshvm"].(bool)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetSshkeyenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1901,6 +3579,15 @@ func (p *RegisterTemplateParams) SetSshkeyenabled(v bool) {
 	p.p["sshkeyenabled"] = v
 }
 
+func (p *RegisterTemplateParams) ResetSshkeyenabled() {
+	delete(p.p, "sshkeyenabled")
+}
+
+func (p *RegisterTemplateParams) GetSshkeyenabled() (bool, bool) {
+	value, ok := p.p["sshkeyenabled"].(bool)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetTemplatetag(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1908,6 +3595,15 @@ func (p *RegisterTemplateParams) SetTemplatetag(v string) {
 	p.p["templatetag"] = v
 }
 
+func (p *RegisterTemplateParams) ResetTemplatetag() {
+	delete(p.p, "templatetag")
+}
+
+func (p *RegisterTemplateParams) GetTemplatetag() (string, bool) {
+	value, ok := p.p["templatetag"].(string)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1915,6 +3611,15 @@ func (p *RegisterTemplateParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *RegisterTemplateParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *RegisterTemplateParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1922,6 +3627,15 @@ func (p *RegisterTemplateParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *RegisterTemplateParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *RegisterTemplateParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 func (p *RegisterTemplateParams) SetZoneids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1929,6 +3643,15 @@ func (p *RegisterTemplateParams) SetZoneids(v []string) {
 	p.p["zoneids"] = v
 }
 
+func (p *RegisterTemplateParams) ResetZoneids() {
+	delete(p.p, "zoneids")
+}
+
+func (p *RegisterTemplateParams) GetZoneids() ([]string, bool) {
+	value, ok := p.p["zoneids"].([]string)
+	return value, ok
+}
+
 // You should always use this function to get a new RegisterTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewRegisterTemplateParams(displaytext string, format string, hypervisor string, name string, url string) *RegisterTemplateParams {
@@ -1944,7 +3667,16 @@ func (s *TemplateService) NewRegisterTemplateParams(displaytext string, format s
 
 // Registers an existing template into the CloudStack cloud.
 func (s *TemplateService) RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
-	resp, err := s.cs.newRequest("registerTemplate", p.toURLValues())
+	return s.RegisterTemplateWithContext(context.Background(), p)
+}
+
+// RegisterTemplateWithContext is the same as RegisterTemplate, but uses ctx to cancel the request and any async job polling
+func (s *TemplateService) RegisterTemplateWithContext(ctx context.Context, p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "registerTemplate", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2044,7 +3776,8 @@ type UpdateTemplateParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateTemplateParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2108,6 +3841,64 @@ func (p *UpdateTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateTemplateParams) Validate() error {
+	v := newParamValidator("updateTemplate", p.p)
+	v.required("id")
+	v.ids("id", "ostypeid")
+	v.maxLength("displaytext", 4096)
+	v.maxLength("format", 255)
+	v.maxLength("name", 255)
+	v.maxLength("templatetype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateTemplateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateTemplateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "bootable", "cleanupdetails", "isdynamicallyscalable", "isrouting", "passwordenabled", "requireshvm", "sshkeyenabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "displaytext", "format", "id", "name", "ostypeid", "templatetype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "sortkey":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateTemplate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateTemplate: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateTemplateParams) SetBootable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2115,6 +3906,15 @@ func (p *UpdateTemplateParams) SetBootable(v bool) {
 	p.p["bootable"] = v
 }
 
+func (p *UpdateTemplateParams) ResetBootable() {
+	delete(p.p, "bootable")
+}
+
+func (p *UpdateTemplateParams) GetBootable() (bool, bool) {
+	value, ok := p.p["bootable"].(bool)
+	return value, ok
+}
+
 func (p *UpdateTemplateParams) SetCleanupdetails(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2122,6 +3922,15 @@ func (p *UpdateTemplateParams) SetCleanupdetails(v bool) {
 	p.p["cleanupdetails"] = v
 }
 
+func (p *UpdateTemplateParams) ResetCleanupdetails() {
+	delete(p.p, "cleanupdetails")
+}
+
+func (p *UpdateTemplateParams) GetCleanupdetails() (bool, bool) {
+	value, ok := p.p["cleanupdetails"].(bool)
+	return value, ok
+}
+
 func (p *UpdateTemplateParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2129,6 +3938,15 @@ func (p *UpdateTemplateParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *UpdateTemplateParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *UpdateTemplateParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *UpdateTemplateParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2136,6 +3954,15 @@ func (p *UpdateTemplateParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *UpdateTemplateParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *UpdateTemplateParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *UpdateTemplateParams) SetFormat(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2143,6 +3970,15 @@ func (p *UpdateTemplateParams) SetFormat(v string) {
 	p.p["format"] = v
 }
 
+func (p *UpdateTemplateParams) ResetFormat() {
+	delete(p.p, "format")
+}
+
+func (p *UpdateTemplateParams) GetFormat() (string, bool) {
+	value, ok := p.p["format"].(string)
+	return value, ok
+}
+
 func (p *UpdateTemplateParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2150,6 +3986,15 @@ func (p *UpdateTemplateParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateTemplateParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateTemplateParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateTemplateParams) SetIsdynamicallyscalable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2157,6 +4002,15 @@ func (p *UpdateTemplateParams) SetIsdynamicallyscalable(v bool) {
 	p.p["isdynamicallyscalable"] = v
 }
 
+func (p *UpdateTemplateParams) ResetIsdynamicallyscalable() {
+	delete(p.p, "isdynamicallyscalable")
+}
+
+func (p *UpdateTemplateParams) GetIsdynamicallyscalable() (bool, bool) {
+	value, ok := p.p["isdynamicallyscalable"].(bool)
+	return value, ok
+}
+
 func (p *UpdateTemplateParams) SetIsrouting(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2164,6 +4018,15 @@ func (p *UpdateTemplateParams) SetIsrouting(v bool) {
 	p.p["isrouting"] = v
 }
 
+func (p *UpdateTemplateParams) ResetIsrouting() {
+	delete(p.p, "isrouting")
+}
+
+func (p *UpdateTemplateParams) GetIsrouting() (bool, bool) {
+	value, ok := p.p["isrouting"].(bool)
+	return value, ok
+}
+
 func (p *UpdateTemplateParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2171,6 +4034,15 @@ func (p *UpdateTemplateParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateTemplateParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateTemplateParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateTemplateParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2178,6 +4050,15 @@ func (p *UpdateTemplateParams) SetOstypeid(v string) {
 	p.p["ostypeid"] = v
 }
 
+func (p *UpdateTemplateParams) ResetOstypeid() {
+	delete(p.p, "ostypeid")
+}
+
+func (p *UpdateTemplateParams) GetOstypeid() (string, bool) {
+	value, ok := p.p["ostypeid"].(string)
+	return value, ok
+}
+
 func (p *UpdateTemplateParams) SetPasswordenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2185,6 +4066,15 @@ func (p *UpdateTemplateParams) SetPasswordenabled(v bool) {
 	p.p["passwordenabled"] = v
 }
 
+func (p *UpdateTemplateParams) ResetPasswordenabled() {
+	delete(p.p, "passwordenabled")
+}
+
+func (p *UpdateTemplateParams) GetPasswordenabled() (bool, bool) {
+	value, ok := p.p["passwordenabled"].(bool)
+	return value, ok
+}
+
 func (p *UpdateTemplateParams) SetRequireshvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2192,6 +4082,15 @@ func (p *UpdateTemplateParams) SetRequireshvm(v bool) {
 	p.p["requireshvm"] = v
 }
 
+func (p *UpdateTemplateParams) ResetRequireshvm() {
+	delete(p.p, "requireshvm")
+}
+
+func (p *UpdateTemplateParams) GetRequireshvm() (bool, bool) {
+	value, ok := p.p["requireshvm"].(bool)
+	return value, ok
+}
+
 func (p *UpdateTemplateParams) SetSortkey(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2199,6 +4098,15 @@ func (p *UpdateTemplateParams) SetSortkey(v int) {
 	p.p["sortkey"] = v
 }
 
+func (p *UpdateTemplateParams) ResetSortkey() {
+	delete(p.p, "sortkey")
+}
+
+func (p *UpdateTemplateParams) GetSortkey() (int, bool) {
+	value, ok := p.p["sortkey"].(int)
+	return value, ok
+}
+
 func (p *UpdateTemplateParams) SetSshkeyenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2206,6 +4114,15 @@ func (p *UpdateTemplateParams) SetSshkeyenabled(v bool) {
 	p.p["sshkeyenabled"] = v
 }
 
+func (p *UpdateTemplateParams) ResetSshkeyenabled() {
+	delete(p.p, "sshkeyenabled")
+}
+
+func (p *UpdateTemplateParams) GetSshkeyenabled() (bool, bool) {
+	value, ok := p.p["sshkeyenabled"].(bool)
+	return value, ok
+}
+
 func (p *UpdateTemplateParams) SetTemplatetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2213,6 +4130,15 @@ func (p *UpdateTemplateParams) SetTemplatetype(v string) {
 	p.p["templatetype"] = v
 }
 
+func (p *UpdateTemplateParams) ResetTemplatetype() {
+	delete(p.p, "templatetype")
+}
+
+func (p *UpdateTemplateParams) GetTemplatetype() (string, bool) {
+	value, ok := p.p["templatetype"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewUpdateTemplateParams(id string) *UpdateTemplateParams {
@@ -2224,7 +4150,16 @@ func (s *TemplateService) NewUpdateTemplateParams(id string) *UpdateTemplatePara
 
 // Updates attributes of a template.
 func (s *TemplateService) UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
-	resp, err := s.cs.newRequest("updateTemplate", p.toURLValues())
+	return s.UpdateTemplateWithContext(context.Background(), p)
+}
+
+// UpdateTemplateWithContext is the same as UpdateTemplate, but uses ctx to cancel the request and any async job polling
+func (s *TemplateService) UpdateTemplateWithContext(ctx context.Context, p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateTemplate", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2319,7 +4254,8 @@ type UpdateTemplatePermissionsParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateTemplatePermissionsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateTemplatePermissionsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2353,6 +4289,57 @@ func (p *UpdateTemplatePermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateTemplatePermissionsParams) Validate() error {
+	v := newParamValidator("updateTemplatePermissions", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("op", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateTemplatePermissionsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateTemplatePermissionsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "accounts", "projectids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "op":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isextractable", "isfeatured", "ispublic":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateTemplatePermissions", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateTemplatePermissions: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateTemplatePermissionsParams) SetAccounts(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2360,6 +4347,15 @@ func (p *UpdateTemplatePermissionsParams) SetAccounts(v []string) {
 	p.p["accounts"] = v
 }
 
+func (p *UpdateTemplatePermissionsParams) ResetAccounts() {
+	delete(p.p, "accounts")
+}
+
+func (p *UpdateTemplatePermissionsParams) GetAccounts() ([]string, bool) {
+	value, ok := p.p["accounts"].([]string)
+	return value, ok
+}
+
 func (p *UpdateTemplatePermissionsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2367,6 +4363,15 @@ func (p *UpdateTemplatePermissionsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateTemplatePermissionsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateTemplatePermissionsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateTemplatePermissionsParams) SetIsextractable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2374,6 +4379,15 @@ func (p *UpdateTemplatePermissionsParams) SetIsextractable(v bool) {
 	p.p["isextractable"] = v
 }
 
+func (p *UpdateTemplatePermissionsParams) ResetIsextractable() {
+	delete(p.p, "isextractable")
+}
+
+func (p *UpdateTemplatePermissionsParams) GetIsextractable() (bool, bool) {
+	value, ok := p.p["isextractable"].(bool)
+	return value, ok
+}
+
 func (p *UpdateTemplatePermissionsParams) SetIsfeatured(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2381,6 +4395,15 @@ func (p *UpdateTemplatePermissionsParams) SetIsfeatured(v bool) {
 	p.p["isfeatured"] = v
 }
 
+func (p *UpdateTemplatePermissionsParams) ResetIsfeatured() {
+	delete(p.p, "isfeatured")
+}
+
+func (p *UpdateTemplatePermissionsParams) GetIsfeatured() (bool, bool) {
+	value, ok := p.p["isfeatured"].(bool)
+	return value, ok
+}
+
 func (p *UpdateTemplatePermissionsParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2388,6 +4411,15 @@ func (p *UpdateTemplatePermissionsParams) SetIspublic(v bool) {
 	p.p["ispublic"] = v
 }
 
+func (p *UpdateTemplatePermissionsParams) ResetIspublic() {
+	delete(p.p, "ispublic")
+}
+
+func (p *UpdateTemplatePermissionsParams) GetIspublic() (bool, bool) {
+	value, ok := p.p["ispublic"].(bool)
+	return value, ok
+}
+
 func (p *UpdateTemplatePermissionsParams) SetOp(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2395,6 +4427,15 @@ func (p *UpdateTemplatePermissionsParams) SetOp(v string) {
 	p.p["op"] = v
 }
 
+func (p *UpdateTemplatePermissionsParams) ResetOp() {
+	delete(p.p, "op")
+}
+
+func (p *UpdateTemplatePermissionsParams) GetOp() (string, bool) {
+	value, ok := p.p["op"].(string)
+	return value, ok
+}
+
 func (p *UpdateTemplatePermissionsParams) SetProjectids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2402,6 +4443,15 @@ func (p *UpdateTemplatePermissionsParams) SetProjectids(v []string) {
 	p.p["projectids"] = v
 }
 
+func (p *UpdateTemplatePermissionsParams) ResetProjectids() {
+	delete(p.p, "projectids")
+}
+
+func (p *UpdateTemplatePermissionsParams) GetProjectids() ([]string, bool) {
+	value, ok := p.p["projectids"].([]string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateTemplatePermissionsParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewUpdateTemplatePermissionsParams(id string) *UpdateTemplatePermissionsParams {
@@ -2413,7 +4463,16 @@ func (s *TemplateService) NewUpdateTemplatePermissionsParams(id string) *UpdateT
 
 // Updates a template visibility permissions. A public template is visible to all accounts within the same domain. A private template is visible only to the owner of the template. A priviledged template is a private template with account permissions added. Only accounts specified under the template permissions are visible to them.
 func (s *TemplateService) UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("updateTemplatePermissions", p.toURLValues())
+	return s.UpdateTemplatePermissionsWithContext(context.Background(), p)
+}
+
+// UpdateTemplatePermissionsWithContext is the same as UpdateTemplatePermissions, but uses ctx to cancel the request and any async job polling
+func (s *TemplateService) UpdateTemplatePermissionsWithContext(ctx context.Context, p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateTemplatePermissions", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2464,7 +4523,8 @@ type UpgradeRouterTemplateParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpgradeRouterTemplateParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpgradeRouterTemplateParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2490,6 +4550,48 @@ func (p *UpgradeRouterTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpgradeRouterTemplateParams) Validate() error {
+	v := newParamValidator("upgradeRouterTemplate", p.p)
+	v.ids("clusterid", "domainid", "id", "podid", "zoneid")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpgradeRouterTemplateParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpgradeRouterTemplateParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "clusterid", "domainid", "id", "podid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for upgradeRouterTemplate", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of upgradeRouterTemplate: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpgradeRouterTemplateParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2497,6 +4599,15 @@ func (p *UpgradeRouterTemplateParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *UpgradeRouterTemplateParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *UpgradeRouterTemplateParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *UpgradeRouterTemplateParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2504,6 +4615,15 @@ func (p *UpgradeRouterTemplateParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *UpgradeRouterTemplateParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *UpgradeRouterTemplateParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *UpgradeRouterTemplateParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2511,6 +4631,15 @@ func (p *UpgradeRouterTemplateParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *UpgradeRouterTemplateParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *UpgradeRouterTemplateParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *UpgradeRouterTemplateParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2518,6 +4647,15 @@ func (p *UpgradeRouterTemplateParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpgradeRouterTemplateParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpgradeRouterTemplateParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpgradeRouterTemplateParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2525,6 +4663,15 @@ func (p *UpgradeRouterTemplateParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *UpgradeRouterTemplateParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *UpgradeRouterTemplateParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *UpgradeRouterTemplateParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2532,6 +4679,15 @@ func (p *UpgradeRouterTemplateParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *UpgradeRouterTemplateParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *UpgradeRouterTemplateParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpgradeRouterTemplateParams instance,
 // as then you are sure you have configured all required params
 func (s *TemplateService) NewUpgradeRouterTemplateParams() *UpgradeRouterTemplateParams {
@@ -2542,7 +4698,16 @@ func (s *TemplateService) NewUpgradeRouterTemplateParams() *UpgradeRouterTemplat
 
 // Upgrades router to use newer template
 func (s *TemplateService) UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
-	resp, err := s.cs.newRequest("upgradeRouterTemplate", p.toURLValues())
+	return s.UpgradeRouterTemplateWithContext(context.Background(), p)
+}
+
+// UpgradeRouterTemplateWithContext is the same as UpgradeRouterTemplate, but uses ctx to cancel the request and any async job polling
+func (s *TemplateService) UpgradeRouterTemplateWithContext(ctx context.Context, p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "upgradeRouterTemplate", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
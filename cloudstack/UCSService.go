@@ -20,18 +20,60 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// UCSServiceIface is the interface implemented by UCSService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type UCSServiceIface interface {
+	NewAddUcsManagerParams(password string, url string, username string, zoneid string) *AddUcsManagerParams
+	AddUcsManager(p *AddUcsManagerParams) (*AddUcsManagerResponse, error)
+	AddUcsManagerWithContext(ctx context.Context, p *AddUcsManagerParams) (*AddUcsManagerResponse, error)
+	NewAssociateUcsProfileToBladeParams(bladeid string, profiledn string, ucsmanagerid string) *AssociateUcsProfileToBladeParams
+	AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error)
+	AssociateUcsProfileToBladeWithContext(ctx context.Context, p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error)
+	AssociateUcsProfileToBladeAsync(p *AssociateUcsProfileToBladeParams) (*Job, error)
+	AssociateUcsProfileToBladeAsyncWithContext(ctx context.Context, p *AssociateUcsProfileToBladeParams) (*Job, error)
+	NewDeleteUcsManagerParams(ucsmanagerid string) *DeleteUcsManagerParams
+	DeleteUcsManager(p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error)
+	DeleteUcsManagerWithContext(ctx context.Context, p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error)
+	NewListUcsBladesParams(ucsmanagerid string) *ListUcsBladesParams
+	ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error)
+	ListUcsBladesWithContext(ctx context.Context, p *ListUcsBladesParams) (*ListUcsBladesResponse, error)
+	NewListUcsBladesPager(p *ListUcsBladesParams) *ListUcsBladesPager
+	ListUcsBladesAll(p *ListUcsBladesParams) (*ListUcsBladesResponse, error)
+	ListUcsBladesAllWithContext(ctx context.Context, p *ListUcsBladesParams) (*ListUcsBladesResponse, error)
+	NewListUcsManagersParams() *ListUcsManagersParams
+	GetUcsManagerID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetUcsManagerIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
+	GetUcsManagerByName(name string, opts ...OptionFunc) (*UcsManager, int, error)
+	GetUcsManagerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*UcsManager, int, error)
+	GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManager, int, error)
+	GetUcsManagerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*UcsManager, int, error)
+	ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagersResponse, error)
+	ListUcsManagersWithContext(ctx context.Context, p *ListUcsManagersParams) (*ListUcsManagersResponse, error)
+	NewListUcsManagersPager(p *ListUcsManagersParams) *ListUcsManagersPager
+	ListUcsManagersAll(p *ListUcsManagersParams) (*ListUcsManagersResponse, error)
+	ListUcsManagersAllWithContext(ctx context.Context, p *ListUcsManagersParams) (*ListUcsManagersResponse, error)
+	NewListUcsProfilesParams(ucsmanagerid string) *ListUcsProfilesParams
+	ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error)
+	ListUcsProfilesWithContext(ctx context.Context, p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error)
+	NewListUcsProfilesPager(p *ListUcsProfilesParams) *ListUcsProfilesPager
+	ListUcsProfilesAll(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error)
+	ListUcsProfilesAllWithContext(ctx context.Context, p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error)
+}
+
 type AddUcsManagerParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddUcsManagerParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddUcsManagerParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -54,6 +96,52 @@ func (p *AddUcsManagerParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddUcsManagerParams) Validate() error {
+	v := newParamValidator("addUcsManager", p.p)
+	v.required("password", "url", "username", "zoneid")
+	v.ids("zoneid")
+	v.maxLength("name", 255)
+	v.maxLength("password", 255)
+	v.maxLength("url", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddUcsManagerParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddUcsManagerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "name", "password", "url", "username", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addUcsManager", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addUcsManager: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddUcsManagerParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -61,6 +149,15 @@ func (p *AddUcsManagerParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *AddUcsManagerParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *AddUcsManagerParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *AddUcsManagerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -68,6 +165,15 @@ func (p *AddUcsManagerParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *AddUcsManagerParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddUcsManagerParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *AddUcsManagerParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -75,6 +181,15 @@ func (p *AddUcsManagerParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *AddUcsManagerParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddUcsManagerParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *AddUcsManagerParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -82,6 +197,15 @@ func (p *AddUcsManagerParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddUcsManagerParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddUcsManagerParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 func (p *AddUcsManagerParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -89,6 +213,15 @@ func (p *AddUcsManagerParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *AddUcsManagerParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *AddUcsManagerParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddUcsManagerParams instance,
 // as then you are sure you have configured all required params
 func (s *UCSService) NewAddUcsManagerParams(password string, url string, username string, zoneid string) *AddUcsManagerParams {
@@ -103,7 +236,16 @@ func (s *UCSService) NewAddUcsManagerParams(password string, url string, usernam
 
 // Adds a Ucs manager
 func (s *UCSService) AddUcsManager(p *AddUcsManagerParams) (*AddUcsManagerResponse, error) {
-	resp, err := s.cs.newRequest("addUcsManager", p.toURLValues())
+	return s.AddUcsManagerWithContext(context.Background(), p)
+}
+
+// AddUcsManagerWithContext is the same as AddUcsManager, but uses ctx to cancel the request and any async job polling
+func (s *UCSService) AddUcsManagerWithContext(ctx context.Context, p *AddUcsManagerParams) (*AddUcsManagerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addUcsManager", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +271,8 @@ type AssociateUcsProfileToBladeParams struct {
 	p map[string]interface{}
 }
 
-func (p *AssociateUcsProfileToBladeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AssociateUcsProfileToBladeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -146,6 +289,49 @@ func (p *AssociateUcsProfileToBladeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AssociateUcsProfileToBladeParams) Validate() error {
+	v := newParamValidator("associateUcsProfileToBlade", p.p)
+	v.required("bladeid", "profiledn", "ucsmanagerid")
+	v.ids("bladeid", "ucsmanagerid")
+	v.maxLength("profiledn", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AssociateUcsProfileToBladeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AssociateUcsProfileToBladeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "bladeid", "profiledn", "ucsmanagerid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for associateUcsProfileToBlade", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of associateUcsProfileToBlade: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AssociateUcsProfileToBladeParams) SetBladeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -153,6 +339,15 @@ func (p *AssociateUcsProfileToBladeParams) SetBladeid(v string) {
 	p.p["bladeid"] = v
 }
 
+func (p *AssociateUcsProfileToBladeParams) ResetBladeid() {
+	delete(p.p, "bladeid")
+}
+
+func (p *AssociateUcsProfileToBladeParams) GetBladeid() (string, bool) {
+	value, ok := p.p["bladeid"].(string)
+	return value, ok
+}
+
 func (p *AssociateUcsProfileToBladeParams) SetProfiledn(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -160,6 +355,15 @@ func (p *AssociateUcsProfileToBladeParams) SetProfiledn(v string) {
 	p.p["profiledn"] = v
 }
 
+func (p *AssociateUcsProfileToBladeParams) ResetProfiledn() {
+	delete(p.p, "profiledn")
+}
+
+func (p *AssociateUcsProfileToBladeParams) GetProfiledn() (string, bool) {
+	value, ok := p.p["profiledn"].(string)
+	return value, ok
+}
+
 func (p *AssociateUcsProfileToBladeParams) SetUcsmanagerid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -167,6 +371,15 @@ func (p *AssociateUcsProfileToBladeParams) SetUcsmanagerid(v string) {
 	p.p["ucsmanagerid"] = v
 }
 
+func (p *AssociateUcsProfileToBladeParams) ResetUcsmanagerid() {
+	delete(p.p, "ucsmanagerid")
+}
+
+func (p *AssociateUcsProfileToBladeParams) GetUcsmanagerid() (string, bool) {
+	value, ok := p.p["ucsmanagerid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AssociateUcsProfileToBladeParams instance,
 // as then you are sure you have configured all required params
 func (s *UCSService) NewAssociateUcsProfileToBladeParams(bladeid string, profiledn string, ucsmanagerid string) *AssociateUcsProfileToBladeParams {
@@ -180,7 +393,16 @@ func (s *UCSService) NewAssociateUcsProfileToBladeParams(bladeid string, profile
 
 // associate a profile to a blade
 func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error) {
-	resp, err := s.cs.newRequest("associateUcsProfileToBlade", p.toURLValues())
+	return s.AssociateUcsProfileToBladeWithContext(context.Background(), p)
+}
+
+// AssociateUcsProfileToBladeWithContext is the same as AssociateUcsProfileToBlade, but uses ctx to cancel the request and any async job polling
+func (s *UCSService) AssociateUcsProfileToBladeWithContext(ctx context.Context, p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "associateUcsProfileToBlade", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +414,7 @@ func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladePar
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -213,6 +435,40 @@ func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladePar
 	return &r, nil
 }
 
+// AssociateUcsProfileToBladeAsync starts the async job for AssociateUcsProfileToBlade and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AssociateUcsProfileToBladeResponse using Job.Result
+func (s *UCSService) AssociateUcsProfileToBladeAsync(p *AssociateUcsProfileToBladeParams) (*Job, error) {
+	return s.AssociateUcsProfileToBladeAsyncWithContext(context.Background(), p)
+}
+
+// AssociateUcsProfileToBladeAsyncWithContext is the same as AssociateUcsProfileToBladeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *UCSService) AssociateUcsProfileToBladeAsyncWithContext(ctx context.Context, p *AssociateUcsProfileToBladeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "associateUcsProfileToBlade", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AssociateUcsProfileToBladeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AssociateUcsProfileToBladeResponse struct {
 	Bladedn      string `json:"bladedn"`
 	Hostid       string `json:"hostid"`
@@ -227,7 +483,8 @@ type DeleteUcsManagerParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteUcsManagerParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteUcsManagerParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -238,6 +495,48 @@ func (p *DeleteUcsManagerParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteUcsManagerParams) Validate() error {
+	v := newParamValidator("deleteUcsManager", p.p)
+	v.required("ucsmanagerid")
+	v.ids("ucsmanagerid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteUcsManagerParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteUcsManagerParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "ucsmanagerid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteUcsManager", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteUcsManager: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteUcsManagerParams) SetUcsmanagerid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -245,6 +544,15 @@ func (p *DeleteUcsManagerParams) SetUcsmanagerid(v string) {
 	p.p["ucsmanagerid"] = v
 }
 
+func (p *DeleteUcsManagerParams) ResetUcsmanagerid() {
+	delete(p.p, "ucsmanagerid")
+}
+
+func (p *DeleteUcsManagerParams) GetUcsmanagerid() (string, bool) {
+	value, ok := p.p["ucsmanagerid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteUcsManagerParams instance,
 // as then you are sure you have configured all required params
 func (s *UCSService) NewDeleteUcsManagerParams(ucsmanagerid string) *DeleteUcsManagerParams {
@@ -256,7 +564,16 @@ func (s *UCSService) NewDeleteUcsManagerParams(ucsmanagerid string) *DeleteUcsMa
 
 // Delete a Ucs manager
 func (s *UCSService) DeleteUcsManager(p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error) {
-	resp, err := s.cs.newRequest("deleteUcsManager", p.toURLValues())
+	return s.DeleteUcsManagerWithContext(context.Background(), p)
+}
+
+// DeleteUcsManagerWithContext is the same as DeleteUcsManager, but uses ctx to cancel the request and any async job polling
+func (s *UCSService) DeleteUcsManagerWithContext(ctx context.Context, p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteUcsManager", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -307,7 +624,8 @@ type ListUcsBladesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListUcsBladesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListUcsBladesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -329,6 +647,53 @@ func (p *ListUcsBladesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListUcsBladesParams) Validate() error {
+	v := newParamValidator("listUcsBlades", p.p)
+	v.required("ucsmanagerid")
+	v.ids("ucsmanagerid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListUcsBladesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListUcsBladesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "ucsmanagerid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listUcsBlades", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listUcsBlades: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListUcsBladesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -336,6 +701,15 @@ func (p *ListUcsBladesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListUcsBladesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListUcsBladesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListUcsBladesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -343,6 +717,15 @@ func (p *ListUcsBladesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListUcsBladesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListUcsBladesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListUcsBladesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -350,6 +733,15 @@ func (p *ListUcsBladesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListUcsBladesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListUcsBladesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListUcsBladesParams) SetUcsmanagerid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -357,6 +749,15 @@ func (p *ListUcsBladesParams) SetUcsmanagerid(v string) {
 	p.p["ucsmanagerid"] = v
 }
 
+func (p *ListUcsBladesParams) ResetUcsmanagerid() {
+	delete(p.p, "ucsmanagerid")
+}
+
+func (p *ListUcsBladesParams) GetUcsmanagerid() (string, bool) {
+	value, ok := p.p["ucsmanagerid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListUcsBladesParams instance,
 // as then you are sure you have configured all required params
 func (s *UCSService) NewListUcsBladesParams(ucsmanagerid string) *ListUcsBladesParams {
@@ -368,7 +769,16 @@ func (s *UCSService) NewListUcsBladesParams(ucsmanagerid string) *ListUcsBladesP
 
 // List ucs blades
 func (s *UCSService) ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
-	resp, err := s.cs.newRequest("listUcsBlades", p.toURLValues())
+	return s.ListUcsBladesWithContext(context.Background(), p)
+}
+
+// ListUcsBladesWithContext is the same as ListUcsBlades, but uses ctx to cancel the request and any async job polling
+func (s *UCSService) ListUcsBladesWithContext(ctx context.Context, p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listUcsBlades", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -381,6 +791,92 @@ func (s *UCSService) ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesRespon
 	return &r, nil
 }
 
+// ListUcsBladesPager iterates over all pages of a ListUcsBlades call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListUcsBladesPager struct {
+	pager
+
+	s *UCSService
+	p *ListUcsBladesParams
+	r *ListUcsBladesResponse
+}
+
+// NewListUcsBladesPager returns a pager for all pages of a ListUcsBlades call with the given params
+func (s *UCSService) NewListUcsBladesPager(p *ListUcsBladesParams) *ListUcsBladesPager {
+	return &ListUcsBladesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListUcsBladesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListUcsBladesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListUcsBladesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListUcsBladesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.UcsBlades), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListUcsBladesPager) Page() *ListUcsBladesResponse {
+	return pg.r
+}
+
+// ListUcsBladesAll fetches all pages of a ListUcsBlades call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *UCSService) ListUcsBladesAll(p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
+	return s.ListUcsBladesAllWithContext(context.Background(), p)
+}
+
+// ListUcsBladesAllWithContext is the same as ListUcsBladesAll, but uses ctx to cancel the requests
+func (s *UCSService) ListUcsBladesAllWithContext(ctx context.Context, p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*UcsBlade)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListUcsBladesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListUcsBladesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.UcsBlades
+		mu.Unlock()
+
+		return len(l.UcsBlades), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListUcsBladesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.UcsBlades = append(r.UcsBlades, pages[page]...)
+	}
+	r.Count = len(r.UcsBlades)
+
+	return r, nil
+}
+
 type ListUcsBladesResponse struct {
 	Count     int         `json:"count"`
 	UcsBlades []*UcsBlade `json:"ucsblade"`
@@ -400,7 +896,8 @@ type ListUcsManagersParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListUcsManagersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListUcsManagersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -425,6 +922,52 @@ func (p *ListUcsManagersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListUcsManagersParams) Validate() error {
+	v := newParamValidator("listUcsManagers", p.p)
+	v.ids("id", "zoneid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListUcsManagersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListUcsManagersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "keyword", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listUcsManagers", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listUcsManagers: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListUcsManagersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -432,6 +975,15 @@ func (p *ListUcsManagersParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListUcsManagersParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListUcsManagersParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListUcsManagersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -439,6 +991,15 @@ func (p *ListUcsManagersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListUcsManagersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListUcsManagersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListUcsManagersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -446,6 +1007,15 @@ func (p *ListUcsManagersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListUcsManagersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListUcsManagersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListUcsManagersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -453,6 +1023,15 @@ func (p *ListUcsManagersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListUcsManagersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListUcsManagersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListUcsManagersParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -460,6 +1039,15 @@ func (p *ListUcsManagersParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListUcsManagersParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListUcsManagersParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListUcsManagersParams instance,
 // as then you are sure you have configured all required params
 func (s *UCSService) NewListUcsManagersParams() *ListUcsManagersParams {
@@ -470,6 +1058,11 @@ func (s *UCSService) NewListUcsManagersParams() *ListUcsManagersParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UCSService) GetUcsManagerID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetUcsManagerIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetUcsManagerIDWithContext is the same as GetUcsManagerID, but uses ctx to cancel the request
+func (s *UCSService) GetUcsManagerIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListUcsManagersParams{}
 	p.p = make(map[string]interface{})
 
@@ -481,7 +1074,7 @@ func (s *UCSService) GetUcsManagerID(keyword string, opts ...OptionFunc) (string
 		}
 	}
 
-	l, err := s.ListUcsManagers(p)
+	l, err := s.ListUcsManagersWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -506,12 +1099,17 @@ func (s *UCSService) GetUcsManagerID(keyword string, opts ...OptionFunc) (string
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UCSService) GetUcsManagerByName(name string, opts ...OptionFunc) (*UcsManager, int, error) {
-	id, count, err := s.GetUcsManagerID(name, opts...)
+	return s.GetUcsManagerByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetUcsManagerByNameWithContext is the same as GetUcsManagerByName, but uses ctx to cancel the requests
+func (s *UCSService) GetUcsManagerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*UcsManager, int, error) {
+	id, count, err := s.GetUcsManagerIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetUcsManagerByID(id, opts...)
+	r, count, err := s.GetUcsManagerByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -520,6 +1118,11 @@ func (s *UCSService) GetUcsManagerByName(name string, opts ...OptionFunc) (*UcsM
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UCSService) GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManager, int, error) {
+	return s.GetUcsManagerByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetUcsManagerByIDWithContext is the same as GetUcsManagerByID, but uses ctx to cancel the request
+func (s *UCSService) GetUcsManagerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*UcsManager, int, error) {
 	p := &ListUcsManagersParams{}
 	p.p = make(map[string]interface{})
 
@@ -531,18 +1134,16 @@ func (s *UCSService) GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManag
 		}
 	}
 
-	l, err := s.ListUcsManagers(p)
+	l, err := s.ListUcsManagersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -553,7 +1154,16 @@ func (s *UCSService) GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManag
 
 // List ucs manager
 func (s *UCSService) ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagersResponse, error) {
-	resp, err := s.cs.newRequest("listUcsManagers", p.toURLValues())
+	return s.ListUcsManagersWithContext(context.Background(), p)
+}
+
+// ListUcsManagersWithContext is the same as ListUcsManagers, but uses ctx to cancel the request and any async job polling
+func (s *UCSService) ListUcsManagersWithContext(ctx context.Context, p *ListUcsManagersParams) (*ListUcsManagersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listUcsManagers", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -566,6 +1176,92 @@ func (s *UCSService) ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagers
 	return &r, nil
 }
 
+// ListUcsManagersPager iterates over all pages of a ListUcsManagers call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListUcsManagersPager struct {
+	pager
+
+	s *UCSService
+	p *ListUcsManagersParams
+	r *ListUcsManagersResponse
+}
+
+// NewListUcsManagersPager returns a pager for all pages of a ListUcsManagers call with the given params
+func (s *UCSService) NewListUcsManagersPager(p *ListUcsManagersParams) *ListUcsManagersPager {
+	return &ListUcsManagersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListUcsManagersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListUcsManagersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListUcsManagersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListUcsManagersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.UcsManagers), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListUcsManagersPager) Page() *ListUcsManagersResponse {
+	return pg.r
+}
+
+// ListUcsManagersAll fetches all pages of a ListUcsManagers call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *UCSService) ListUcsManagersAll(p *ListUcsManagersParams) (*ListUcsManagersResponse, error) {
+	return s.ListUcsManagersAllWithContext(context.Background(), p)
+}
+
+// ListUcsManagersAllWithContext is the same as ListUcsManagersAll, but uses ctx to cancel the requests
+func (s *UCSService) ListUcsManagersAllWithContext(ctx context.Context, p *ListUcsManagersParams) (*ListUcsManagersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*UcsManager)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListUcsManagersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListUcsManagersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.UcsManagers
+		mu.Unlock()
+
+		return len(l.UcsManagers), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListUcsManagersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.UcsManagers = append(r.UcsManagers, pages[page]...)
+	}
+	r.Count = len(r.UcsManagers)
+
+	return r, nil
+}
+
 type ListUcsManagersResponse struct {
 	Count       int           `json:"count"`
 	UcsManagers []*UcsManager `json:"ucsmanager"`
@@ -584,7 +1280,8 @@ type ListUcsProfilesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListUcsProfilesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListUcsProfilesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -606,6 +1303,53 @@ func (p *ListUcsProfilesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListUcsProfilesParams) Validate() error {
+	v := newParamValidator("listUcsProfiles", p.p)
+	v.required("ucsmanagerid")
+	v.ids("ucsmanagerid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListUcsProfilesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListUcsProfilesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "ucsmanagerid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listUcsProfiles", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listUcsProfiles: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListUcsProfilesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -613,6 +1357,15 @@ func (p *ListUcsProfilesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListUcsProfilesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListUcsProfilesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListUcsProfilesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -620,6 +1373,15 @@ func (p *ListUcsProfilesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListUcsProfilesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListUcsProfilesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListUcsProfilesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -627,6 +1389,15 @@ func (p *ListUcsProfilesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListUcsProfilesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListUcsProfilesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListUcsProfilesParams) SetUcsmanagerid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -634,6 +1405,15 @@ func (p *ListUcsProfilesParams) SetUcsmanagerid(v string) {
 	p.p["ucsmanagerid"] = v
 }
 
+func (p *ListUcsProfilesParams) ResetUcsmanagerid() {
+	delete(p.p, "ucsmanagerid")
+}
+
+func (p *ListUcsProfilesParams) GetUcsmanagerid() (string, bool) {
+	value, ok := p.p["ucsmanagerid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListUcsProfilesParams instance,
 // as then you are sure you have configured all required params
 func (s *UCSService) NewListUcsProfilesParams(ucsmanagerid string) *ListUcsProfilesParams {
@@ -645,7 +1425,16 @@ func (s *UCSService) NewListUcsProfilesParams(ucsmanagerid string) *ListUcsProfi
 
 // List profile in ucs manager
 func (s *UCSService) ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error) {
-	resp, err := s.cs.newRequest("listUcsProfiles", p.toURLValues())
+	return s.ListUcsProfilesWithContext(context.Background(), p)
+}
+
+// ListUcsProfilesWithContext is the same as ListUcsProfiles, but uses ctx to cancel the request and any async job polling
+func (s *UCSService) ListUcsProfilesWithContext(ctx context.Context, p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listUcsProfiles", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -658,6 +1447,92 @@ func (s *UCSService) ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfiles
 	return &r, nil
 }
 
+// ListUcsProfilesPager iterates over all pages of a ListUcsProfiles call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListUcsProfilesPager struct {
+	pager
+
+	s *UCSService
+	p *ListUcsProfilesParams
+	r *ListUcsProfilesResponse
+}
+
+// NewListUcsProfilesPager returns a pager for all pages of a ListUcsProfiles call with the given params
+func (s *UCSService) NewListUcsProfilesPager(p *ListUcsProfilesParams) *ListUcsProfilesPager {
+	return &ListUcsProfilesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListUcsProfilesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListUcsProfilesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListUcsProfilesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListUcsProfilesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.UcsProfiles), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListUcsProfilesPager) Page() *ListUcsProfilesResponse {
+	return pg.r
+}
+
+// ListUcsProfilesAll fetches all pages of a ListUcsProfiles call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *UCSService) ListUcsProfilesAll(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error) {
+	return s.ListUcsProfilesAllWithContext(context.Background(), p)
+}
+
+// ListUcsProfilesAllWithContext is the same as ListUcsProfilesAll, but uses ctx to cancel the requests
+func (s *UCSService) ListUcsProfilesAllWithContext(ctx context.Context, p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*UcsProfile)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListUcsProfilesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListUcsProfilesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.UcsProfiles
+		mu.Unlock()
+
+		return len(l.UcsProfiles), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListUcsProfilesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.UcsProfiles = append(r.UcsProfiles, pages[page]...)
+	}
+	r.Count = len(r.UcsProfiles)
+
+	return r, nil
+}
+
 type ListUcsProfilesResponse struct {
 	Count       int           `json:"count"`
 	UcsProfiles []*UcsProfile `json:"ucsprofile"`
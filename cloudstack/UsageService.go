@@ -20,17 +20,81 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
+// UsageServiceIface is the interface implemented by UsageService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type UsageServiceIface interface {
+	NewAddTrafficMonitorParams(url string, zoneid string) *AddTrafficMonitorParams
+	AddTrafficMonitor(p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error)
+	AddTrafficMonitorWithContext(ctx context.Context, p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error)
+	NewAddTrafficTypeParams(physicalnetworkid string, traffictype string) *AddTrafficTypeParams
+	AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error)
+	AddTrafficTypeWithContext(ctx context.Context, p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error)
+	AddTrafficTypeAsync(p *AddTrafficTypeParams) (*Job, error)
+	AddTrafficTypeAsyncWithContext(ctx context.Context, p *AddTrafficTypeParams) (*Job, error)
+	NewDeleteTrafficMonitorParams(id string) *DeleteTrafficMonitorParams
+	DeleteTrafficMonitor(p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error)
+	DeleteTrafficMonitorWithContext(ctx context.Context, p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error)
+	NewDeleteTrafficTypeParams(id string) *DeleteTrafficTypeParams
+	DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error)
+	DeleteTrafficTypeWithContext(ctx context.Context, p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error)
+	DeleteTrafficTypeAsync(p *DeleteTrafficTypeParams) (*Job, error)
+	DeleteTrafficTypeAsyncWithContext(ctx context.Context, p *DeleteTrafficTypeParams) (*Job, error)
+	NewGenerateUsageRecordsParams(enddate string, startdate string) *GenerateUsageRecordsParams
+	GenerateUsageRecords(p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error)
+	GenerateUsageRecordsWithContext(ctx context.Context, p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error)
+	NewListTrafficMonitorsParams(zoneid string) *ListTrafficMonitorsParams
+	ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error)
+	ListTrafficMonitorsWithContext(ctx context.Context, p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error)
+	NewListTrafficMonitorsPager(p *ListTrafficMonitorsParams) *ListTrafficMonitorsPager
+	ListTrafficMonitorsAll(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error)
+	ListTrafficMonitorsAllWithContext(ctx context.Context, p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error)
+	NewListTrafficTypeImplementorsParams() *ListTrafficTypeImplementorsParams
+	ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error)
+	ListTrafficTypeImplementorsWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error)
+	NewListTrafficTypeImplementorsPager(p *ListTrafficTypeImplementorsParams) *ListTrafficTypeImplementorsPager
+	ListTrafficTypeImplementorsAll(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error)
+	ListTrafficTypeImplementorsAllWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error)
+	NewListTrafficTypesParams(physicalnetworkid string) *ListTrafficTypesParams
+	GetTrafficTypeID(keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error)
+	GetTrafficTypeIDWithContext(ctx context.Context, keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error)
+	ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error)
+	ListTrafficTypesWithContext(ctx context.Context, p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error)
+	NewListTrafficTypesPager(p *ListTrafficTypesParams) *ListTrafficTypesPager
+	ListTrafficTypesAll(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error)
+	ListTrafficTypesAllWithContext(ctx context.Context, p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error)
+	NewListUsageRecordsParams(enddate string, startdate string) *ListUsageRecordsParams
+	ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error)
+	ListUsageRecordsWithContext(ctx context.Context, p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error)
+	NewListUsageRecordsPager(p *ListUsageRecordsParams) *ListUsageRecordsPager
+	ListUsageRecordsAll(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error)
+	ListUsageRecordsAllWithContext(ctx context.Context, p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error)
+	NewListUsageTypesParams() *ListUsageTypesParams
+	ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesResponse, error)
+	ListUsageTypesWithContext(ctx context.Context, p *ListUsageTypesParams) (*ListUsageTypesResponse, error)
+	NewRemoveRawUsageRecordsParams(interval int) *RemoveRawUsageRecordsParams
+	RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams) (*RemoveRawUsageRecordsResponse, error)
+	RemoveRawUsageRecordsWithContext(ctx context.Context, p *RemoveRawUsageRecordsParams) (*RemoveRawUsageRecordsResponse, error)
+	NewUpdateTrafficTypeParams(id string) *UpdateTrafficTypeParams
+	UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error)
+	UpdateTrafficTypeWithContext(ctx context.Context, p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error)
+	UpdateTrafficTypeAsync(p *UpdateTrafficTypeParams) (*Job, error)
+	UpdateTrafficTypeAsyncWithContext(ctx context.Context, p *UpdateTrafficTypeParams) (*Job, error)
+}
+
 type AddTrafficMonitorParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddTrafficMonitorParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddTrafficMonitorParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -50,6 +114,51 @@ func (p *AddTrafficMonitorParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddTrafficMonitorParams) Validate() error {
+	v := newParamValidator("addTrafficMonitor", p.p)
+	v.required("url", "zoneid")
+	v.ids("zoneid")
+	v.maxLength("excludezones", 255)
+	v.maxLength("includezones", 255)
+	v.maxLength("url", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddTrafficMonitorParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddTrafficMonitorParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "excludezones", "includezones", "url", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addTrafficMonitor", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addTrafficMonitor: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddTrafficMonitorParams) SetExcludezones(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -57,6 +166,15 @@ func (p *AddTrafficMonitorParams) SetExcludezones(v string) {
 	p.p["excludezones"] = v
 }
 
+func (p *AddTrafficMonitorParams) ResetExcludezones() {
+	delete(p.p, "excludezones")
+}
+
+func (p *AddTrafficMonitorParams) GetExcludezones() (string, bool) {
+	value, ok := p.p["excludezones"].(string)
+	return value, ok
+}
+
 func (p *AddTrafficMonitorParams) SetIncludezones(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -64,6 +182,15 @@ func (p *AddTrafficMonitorParams) SetIncludezones(v string) {
 	p.p["includezones"] = v
 }
 
+func (p *AddTrafficMonitorParams) ResetIncludezones() {
+	delete(p.p, "includezones")
+}
+
+func (p *AddTrafficMonitorParams) GetIncludezones() (string, bool) {
+	value, ok := p.p["includezones"].(string)
+	return value, ok
+}
+
 func (p *AddTrafficMonitorParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -71,6 +198,15 @@ func (p *AddTrafficMonitorParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *AddTrafficMonitorParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *AddTrafficMonitorParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *AddTrafficMonitorParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -78,6 +214,15 @@ func (p *AddTrafficMonitorParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *AddTrafficMonitorParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *AddTrafficMonitorParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddTrafficMonitorParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewAddTrafficMonitorParams(url string, zoneid string) *AddTrafficMonitorParams {
@@ -90,7 +235,16 @@ func (s *UsageService) NewAddTrafficMonitorParams(url string, zoneid string) *Ad
 
 // Adds Traffic Monitor Host for Direct Network Usage
 func (s *UsageService) AddTrafficMonitor(p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error) {
-	resp, err := s.cs.newRequest("addTrafficMonitor", p.toURLValues())
+	return s.AddTrafficMonitorWithContext(context.Background(), p)
+}
+
+// AddTrafficMonitorWithContext is the same as AddTrafficMonitor, but uses ctx to cancel the request and any async job polling
+func (s *UsageService) AddTrafficMonitorWithContext(ctx context.Context, p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addTrafficMonitor", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +271,8 @@ type AddTrafficTypeParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddTrafficTypeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddTrafficTypeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -152,6 +307,56 @@ func (p *AddTrafficTypeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddTrafficTypeParams) Validate() error {
+	v := newParamValidator("addTrafficType", p.p)
+	v.required("physicalnetworkid", "traffictype")
+	v.ids("physicalnetworkid")
+	v.maxLength("hypervnetworklabel", 255)
+	v.maxLength("isolationmethod", 255)
+	v.maxLength("kvmnetworklabel", 255)
+	v.maxLength("ovm3networklabel", 255)
+	v.maxLength("traffictype", 255)
+	v.maxLength("vlan", 255)
+	v.maxLength("vmwarenetworklabel", 255)
+	v.maxLength("xennetworklabel", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddTrafficTypeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddTrafficTypeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hypervnetworklabel", "isolationmethod", "kvmnetworklabel", "ovm3networklabel", "physicalnetworkid", "traffictype", "vlan", "vmwarenetworklabel", "xennetworklabel":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addTrafficType", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addTrafficType: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddTrafficTypeParams) SetHypervnetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -159,6 +364,15 @@ func (p *AddTrafficTypeParams) SetHypervnetworklabel(v string) {
 	p.p["hypervnetworklabel"] = v
 }
 
+func (p *AddTrafficTypeParams) ResetHypervnetworklabel() {
+	delete(p.p, "hypervnetworklabel")
+}
+
+func (p *AddTrafficTypeParams) GetHypervnetworklabel() (string, bool) {
+	value, ok := p.p["hypervnetworklabel"].(string)
+	return value, ok
+}
+
 func (p *AddTrafficTypeParams) SetIsolationmethod(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -166,6 +380,15 @@ func (p *AddTrafficTypeParams) SetIsolationmethod(v string) {
 	p.p["isolationmethod"] = v
 }
 
+func (p *AddTrafficTypeParams) ResetIsolationmethod() {
+	delete(p.p, "isolationmethod")
+}
+
+func (p *AddTrafficTypeParams) GetIsolationmethod() (string, bool) {
+	value, ok := p.p["isolationmethod"].(string)
+	return value, ok
+}
+
 func (p *AddTrafficTypeParams) SetKvmnetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -173,6 +396,15 @@ func (p *AddTrafficTypeParams) SetKvmnetworklabel(v string) {
 	p.p["kvmnetworklabel"] = v
 }
 
+func (p *AddTrafficTypeParams) ResetKvmnetworklabel() {
+	delete(p.p, "kvmnetworklabel")
+}
+
+func (p *AddTrafficTypeParams) GetKvmnetworklabel() (string, bool) {
+	value, ok := p.p["kvmnetworklabel"].(string)
+	return value, ok
+}
+
 func (p *AddTrafficTypeParams) SetOvm3networklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -180,6 +412,15 @@ func (p *AddTrafficTypeParams) SetOvm3networklabel(v string) {
 	p.p["ovm3networklabel"] = v
 }
 
+func (p *AddTrafficTypeParams) ResetOvm3networklabel() {
+	delete(p.p, "ovm3networklabel")
+}
+
+func (p *AddTrafficTypeParams) GetOvm3networklabel() (string, bool) {
+	value, ok := p.p["ovm3networklabel"].(string)
+	return value, ok
+}
+
 func (p *AddTrafficTypeParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -187,6 +428,15 @@ func (p *AddTrafficTypeParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *AddTrafficTypeParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *AddTrafficTypeParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *AddTrafficTypeParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -194,6 +444,15 @@ func (p *AddTrafficTypeParams) SetTraffictype(v string) {
 	p.p["traffictype"] = v
 }
 
+func (p *AddTrafficTypeParams) ResetTraffictype() {
+	delete(p.p, "traffictype")
+}
+
+func (p *AddTrafficTypeParams) GetTraffictype() (string, bool) {
+	value, ok := p.p["traffictype"].(string)
+	return value, ok
+}
+
 func (p *AddTrafficTypeParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -201,6 +460,15 @@ func (p *AddTrafficTypeParams) SetVlan(v string) {
 	p.p["vlan"] = v
 }
 
+func (p *AddTrafficTypeParams) ResetVlan() {
+	delete(p.p, "vlan")
+}
+
+func (p *AddTrafficTypeParams) GetVlan() (string, bool) {
+	value, ok := p.p["vlan"].(string)
+	return value, ok
+}
+
 func (p *AddTrafficTypeParams) SetVmwarenetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -208,6 +476,15 @@ func (p *AddTrafficTypeParams) SetVmwarenetworklabel(v string) {
 	p.p["vmwarenetworklabel"] = v
 }
 
+func (p *AddTrafficTypeParams) ResetVmwarenetworklabel() {
+	delete(p.p, "vmwarenetworklabel")
+}
+
+func (p *AddTrafficTypeParams) GetVmwarenetworklabel() (string, bool) {
+	value, ok := p.p["vmwarenetworklabel"].(string)
+	return value, ok
+}
+
 func (p *AddTrafficTypeParams) SetXennetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -215,6 +492,15 @@ func (p *AddTrafficTypeParams) SetXennetworklabel(v string) {
 	p.p["xennetworklabel"] = v
 }
 
+func (p *AddTrafficTypeParams) ResetXennetworklabel() {
+	delete(p.p, "xennetworklabel")
+}
+
+func (p *AddTrafficTypeParams) GetXennetworklabel() (string, bool) {
+	value, ok := p.p["xennetworklabel"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddTrafficTypeParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewAddTrafficTypeParams(physicalnetworkid string, traffictype string) *AddTrafficTypeParams {
@@ -227,7 +513,16 @@ func (s *UsageService) NewAddTrafficTypeParams(physicalnetworkid string, traffic
 
 // Adds traffic type to a physical network
 func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error) {
-	resp, err := s.cs.newRequest("addTrafficType", p.toURLValues())
+	return s.AddTrafficTypeWithContext(context.Background(), p)
+}
+
+// AddTrafficTypeWithContext is the same as AddTrafficType, but uses ctx to cancel the request and any async job polling
+func (s *UsageService) AddTrafficTypeWithContext(ctx context.Context, p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addTrafficType", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -239,7 +534,7 @@ func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeR
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -260,6 +555,40 @@ func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeR
 	return &r, nil
 }
 
+// AddTrafficTypeAsync starts the async job for AddTrafficType and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddTrafficTypeResponse using Job.Result
+func (s *UsageService) AddTrafficTypeAsync(p *AddTrafficTypeParams) (*Job, error) {
+	return s.AddTrafficTypeAsyncWithContext(context.Background(), p)
+}
+
+// AddTrafficTypeAsyncWithContext is the same as AddTrafficTypeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *UsageService) AddTrafficTypeAsyncWithContext(ctx context.Context, p *AddTrafficTypeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addTrafficType", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddTrafficTypeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AddTrafficTypeResponse struct {
 	Hypervnetworklabel string `json:"hypervnetworklabel"`
 	Id                 string `json:"id"`
@@ -277,7 +606,8 @@ type DeleteTrafficMonitorParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteTrafficMonitorParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteTrafficMonitorParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -288,6 +618,48 @@ func (p *DeleteTrafficMonitorParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteTrafficMonitorParams) Validate() error {
+	v := newParamValidator("deleteTrafficMonitor", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteTrafficMonitorParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteTrafficMonitorParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteTrafficMonitor", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteTrafficMonitor: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteTrafficMonitorParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -295,6 +667,15 @@ func (p *DeleteTrafficMonitorParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteTrafficMonitorParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteTrafficMonitorParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteTrafficMonitorParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewDeleteTrafficMonitorParams(id string) *DeleteTrafficMonitorParams {
@@ -306,7 +687,16 @@ func (s *UsageService) NewDeleteTrafficMonitorParams(id string) *DeleteTrafficMo
 
 // Deletes an traffic monitor host.
 func (s *UsageService) DeleteTrafficMonitor(p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error) {
-	resp, err := s.cs.newRequest("deleteTrafficMonitor", p.toURLValues())
+	return s.DeleteTrafficMonitorWithContext(context.Background(), p)
+}
+
+// DeleteTrafficMonitorWithContext is the same as DeleteTrafficMonitor, but uses ctx to cancel the request and any async job polling
+func (s *UsageService) DeleteTrafficMonitorWithContext(ctx context.Context, p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteTrafficMonitor", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -357,7 +747,8 @@ type DeleteTrafficTypeParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteTrafficTypeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteTrafficTypeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -368,6 +759,48 @@ func (p *DeleteTrafficTypeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteTrafficTypeParams) Validate() error {
+	v := newParamValidator("deleteTrafficType", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteTrafficTypeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteTrafficTypeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteTrafficType", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteTrafficType: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteTrafficTypeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -375,6 +808,15 @@ func (p *DeleteTrafficTypeParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteTrafficTypeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteTrafficTypeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteTrafficTypeParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewDeleteTrafficTypeParams(id string) *DeleteTrafficTypeParams {
@@ -386,7 +828,16 @@ func (s *UsageService) NewDeleteTrafficTypeParams(id string) *DeleteTrafficTypeP
 
 // Deletes traffic type of a physical network
 func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error) {
-	resp, err := s.cs.newRequest("deleteTrafficType", p.toURLValues())
+	return s.DeleteTrafficTypeWithContext(context.Background(), p)
+}
+
+// DeleteTrafficTypeWithContext is the same as DeleteTrafficType, but uses ctx to cancel the request and any async job polling
+func (s *UsageService) DeleteTrafficTypeWithContext(ctx context.Context, p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteTrafficType", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -398,7 +849,7 @@ func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTra
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -414,6 +865,31 @@ func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTra
 	return &r, nil
 }
 
+// DeleteTrafficTypeAsync starts the async job for DeleteTrafficType and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteTrafficTypeResponse using Job.Result
+func (s *UsageService) DeleteTrafficTypeAsync(p *DeleteTrafficTypeParams) (*Job, error) {
+	return s.DeleteTrafficTypeAsyncWithContext(context.Background(), p)
+}
+
+// DeleteTrafficTypeAsyncWithContext is the same as DeleteTrafficTypeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *UsageService) DeleteTrafficTypeAsyncWithContext(ctx context.Context, p *DeleteTrafficTypeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteTrafficType", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteTrafficTypeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteTrafficTypeResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -425,7 +901,8 @@ type GenerateUsageRecordsParams struct {
 	p map[string]interface{}
 }
 
-func (p *GenerateUsageRecordsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GenerateUsageRecordsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -442,6 +919,48 @@ func (p *GenerateUsageRecordsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GenerateUsageRecordsParams) Validate() error {
+	v := newParamValidator("generateUsageRecords", p.p)
+	v.required("enddate", "startdate")
+	v.ids("domainid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GenerateUsageRecordsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GenerateUsageRecordsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "domainid", "enddate", "startdate":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for generateUsageRecords", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of generateUsageRecords: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *GenerateUsageRecordsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -449,6 +968,15 @@ func (p *GenerateUsageRecordsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *GenerateUsageRecordsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *GenerateUsageRecordsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *GenerateUsageRecordsParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -456,6 +984,15 @@ func (p *GenerateUsageRecordsParams) SetEnddate(v string) {
 	p.p["enddate"] = v
 }
 
+func (p *GenerateUsageRecordsParams) ResetEnddate() {
+	delete(p.p, "enddate")
+}
+
+func (p *GenerateUsageRecordsParams) GetEnddate() (string, bool) {
+	value, ok := p.p["enddate"].(string)
+	return value, ok
+}
+
 func (p *GenerateUsageRecordsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -463,6 +1000,15 @@ func (p *GenerateUsageRecordsParams) SetStartdate(v string) {
 	p.p["startdate"] = v
 }
 
+func (p *GenerateUsageRecordsParams) ResetStartdate() {
+	delete(p.p, "startdate")
+}
+
+func (p *GenerateUsageRecordsParams) GetStartdate() (string, bool) {
+	value, ok := p.p["startdate"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new GenerateUsageRecordsParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewGenerateUsageRecordsParams(enddate string, startdate string) *GenerateUsageRecordsParams {
@@ -475,7 +1021,16 @@ func (s *UsageService) NewGenerateUsageRecordsParams(enddate string, startdate s
 
 // Generates usage records. This will generate records only if there any records to be generated, i.e if the scheduled usage job was not run or failed
 func (s *UsageService) GenerateUsageRecords(p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error) {
-	resp, err := s.cs.newRequest("generateUsageRecords", p.toURLValues())
+	return s.GenerateUsageRecordsWithContext(context.Background(), p)
+}
+
+// GenerateUsageRecordsWithContext is the same as GenerateUsageRecords, but uses ctx to cancel the request and any async job polling
+func (s *UsageService) GenerateUsageRecordsWithContext(ctx context.Context, p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "generateUsageRecords", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -526,7 +1081,8 @@ type ListTrafficMonitorsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListTrafficMonitorsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListTrafficMonitorsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -548,6 +1104,53 @@ func (p *ListTrafficMonitorsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListTrafficMonitorsParams) Validate() error {
+	v := newParamValidator("listTrafficMonitors", p.p)
+	v.required("zoneid")
+	v.ids("zoneid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListTrafficMonitorsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListTrafficMonitorsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listTrafficMonitors", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listTrafficMonitors: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListTrafficMonitorsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -555,6 +1158,15 @@ func (p *ListTrafficMonitorsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListTrafficMonitorsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListTrafficMonitorsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListTrafficMonitorsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -562,6 +1174,15 @@ func (p *ListTrafficMonitorsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListTrafficMonitorsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListTrafficMonitorsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListTrafficMonitorsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -569,6 +1190,15 @@ func (p *ListTrafficMonitorsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListTrafficMonitorsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListTrafficMonitorsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListTrafficMonitorsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -576,6 +1206,15 @@ func (p *ListTrafficMonitorsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListTrafficMonitorsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListTrafficMonitorsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListTrafficMonitorsParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewListTrafficMonitorsParams(zoneid string) *ListTrafficMonitorsParams {
@@ -587,7 +1226,16 @@ func (s *UsageService) NewListTrafficMonitorsParams(zoneid string) *ListTrafficM
 
 // List traffic monitor Hosts.
 func (s *UsageService) ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficMonitors", p.toURLValues())
+	return s.ListTrafficMonitorsWithContext(context.Background(), p)
+}
+
+// ListTrafficMonitorsWithContext is the same as ListTrafficMonitors, but uses ctx to cancel the request and any async job polling
+func (s *UsageService) ListTrafficMonitorsWithContext(ctx context.Context, p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listTrafficMonitors", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -600,17 +1248,103 @@ func (s *UsageService) ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListT
 	return &r, nil
 }
 
-type ListTrafficMonitorsResponse struct {
-	Count           int               `json:"count"`
-	TrafficMonitors []*TrafficMonitor `json:"trafficmonitor"`
+// ListTrafficMonitorsPager iterates over all pages of a ListTrafficMonitors call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListTrafficMonitorsPager struct {
+	pager
+
+	s *UsageService
+	p *ListTrafficMonitorsParams
+	r *ListTrafficMonitorsResponse
 }
 
-type TrafficMonitor struct {
-	Id         string `json:"id"`
-	Ipaddress  string `json:"ipaddress"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Numretries string `json:"numretries"`
+// NewListTrafficMonitorsPager returns a pager for all pages of a ListTrafficMonitors call with the given params
+func (s *UsageService) NewListTrafficMonitorsPager(p *ListTrafficMonitorsParams) *ListTrafficMonitorsPager {
+	return &ListTrafficMonitorsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListTrafficMonitorsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListTrafficMonitorsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListTrafficMonitorsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListTrafficMonitorsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.TrafficMonitors), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListTrafficMonitorsPager) Page() *ListTrafficMonitorsResponse {
+	return pg.r
+}
+
+// ListTrafficMonitorsAll fetches all pages of a ListTrafficMonitors call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *UsageService) ListTrafficMonitorsAll(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error) {
+	return s.ListTrafficMonitorsAllWithContext(context.Background(), p)
+}
+
+// ListTrafficMonitorsAllWithContext is the same as ListTrafficMonitorsAll, but uses ctx to cancel the requests
+func (s *UsageService) ListTrafficMonitorsAllWithContext(ctx context.Context, p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*TrafficMonitor)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListTrafficMonitorsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListTrafficMonitorsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.TrafficMonitors
+		mu.Unlock()
+
+		return len(l.TrafficMonitors), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListTrafficMonitorsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.TrafficMonitors = append(r.TrafficMonitors, pages[page]...)
+	}
+	r.Count = len(r.TrafficMonitors)
+
+	return r, nil
+}
+
+type ListTrafficMonitorsResponse struct {
+	Count           int               `json:"count"`
+	TrafficMonitors []*TrafficMonitor `json:"trafficmonitor"`
+}
+
+type TrafficMonitor struct {
+	Id         string `json:"id"`
+	Ipaddress  string `json:"ipaddress"`
+	JobID      string `json:"jobid"`
+	Jobstatus  int    `json:"jobstatus"`
+	Numretries string `json:"numretries"`
 	Timeout    string `json:"timeout"`
 	Zoneid     string `json:"zoneid"`
 }
@@ -619,7 +1353,8 @@ type ListTrafficTypeImplementorsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListTrafficTypeImplementorsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListTrafficTypeImplementorsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -641,6 +1376,52 @@ func (p *ListTrafficTypeImplementorsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListTrafficTypeImplementorsParams) Validate() error {
+	v := newParamValidator("listTrafficTypeImplementors", p.p)
+	v.maxLength("keyword", 255)
+	v.maxLength("traffictype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListTrafficTypeImplementorsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListTrafficTypeImplementorsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "traffictype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listTrafficTypeImplementors", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listTrafficTypeImplementors: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListTrafficTypeImplementorsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -648,6 +1429,15 @@ func (p *ListTrafficTypeImplementorsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListTrafficTypeImplementorsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListTrafficTypeImplementorsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListTrafficTypeImplementorsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -655,6 +1445,15 @@ func (p *ListTrafficTypeImplementorsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListTrafficTypeImplementorsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListTrafficTypeImplementorsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListTrafficTypeImplementorsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -662,6 +1461,15 @@ func (p *ListTrafficTypeImplementorsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListTrafficTypeImplementorsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListTrafficTypeImplementorsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListTrafficTypeImplementorsParams) SetTraffictype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -669,6 +1477,15 @@ func (p *ListTrafficTypeImplementorsParams) SetTraffictype(v string) {
 	p.p["traffictype"] = v
 }
 
+func (p *ListTrafficTypeImplementorsParams) ResetTraffictype() {
+	delete(p.p, "traffictype")
+}
+
+func (p *ListTrafficTypeImplementorsParams) GetTraffictype() (string, bool) {
+	value, ok := p.p["traffictype"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListTrafficTypeImplementorsParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewListTrafficTypeImplementorsParams() *ListTrafficTypeImplementorsParams {
@@ -679,7 +1496,16 @@ func (s *UsageService) NewListTrafficTypeImplementorsParams() *ListTrafficTypeIm
 
 // Lists implementors of implementor of a network traffic type or implementors of all network traffic types
 func (s *UsageService) ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficTypeImplementors", p.toURLValues())
+	return s.ListTrafficTypeImplementorsWithContext(context.Background(), p)
+}
+
+// ListTrafficTypeImplementorsWithContext is the same as ListTrafficTypeImplementors, but uses ctx to cancel the request and any async job polling
+func (s *UsageService) ListTrafficTypeImplementorsWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listTrafficTypeImplementors", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -692,6 +1518,92 @@ func (s *UsageService) ListTrafficTypeImplementors(p *ListTrafficTypeImplementor
 	return &r, nil
 }
 
+// ListTrafficTypeImplementorsPager iterates over all pages of a ListTrafficTypeImplementors call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListTrafficTypeImplementorsPager struct {
+	pager
+
+	s *UsageService
+	p *ListTrafficTypeImplementorsParams
+	r *ListTrafficTypeImplementorsResponse
+}
+
+// NewListTrafficTypeImplementorsPager returns a pager for all pages of a ListTrafficTypeImplementors call with the given params
+func (s *UsageService) NewListTrafficTypeImplementorsPager(p *ListTrafficTypeImplementorsParams) *ListTrafficTypeImplementorsPager {
+	return &ListTrafficTypeImplementorsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListTrafficTypeImplementorsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListTrafficTypeImplementorsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListTrafficTypeImplementorsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListTrafficTypeImplementorsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.TrafficTypeImplementors), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListTrafficTypeImplementorsPager) Page() *ListTrafficTypeImplementorsResponse {
+	return pg.r
+}
+
+// ListTrafficTypeImplementorsAll fetches all pages of a ListTrafficTypeImplementors call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *UsageService) ListTrafficTypeImplementorsAll(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error) {
+	return s.ListTrafficTypeImplementorsAllWithContext(context.Background(), p)
+}
+
+// ListTrafficTypeImplementorsAllWithContext is the same as ListTrafficTypeImplementorsAll, but uses ctx to cancel the requests
+func (s *UsageService) ListTrafficTypeImplementorsAllWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*TrafficTypeImplementor)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListTrafficTypeImplementorsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListTrafficTypeImplementorsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.TrafficTypeImplementors
+		mu.Unlock()
+
+		return len(l.TrafficTypeImplementors), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListTrafficTypeImplementorsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.TrafficTypeImplementors = append(r.TrafficTypeImplementors, pages[page]...)
+	}
+	r.Count = len(r.TrafficTypeImplementors)
+
+	return r, nil
+}
+
 type ListTrafficTypeImplementorsResponse struct {
 	Count                   int                       `json:"count"`
 	TrafficTypeImplementors []*TrafficTypeImplementor `json:"traffictypeimplementor"`
@@ -708,7 +1620,8 @@ type ListTrafficTypesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListTrafficTypesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListTrafficTypesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -730,6 +1643,53 @@ func (p *ListTrafficTypesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListTrafficTypesParams) Validate() error {
+	v := newParamValidator("listTrafficTypes", p.p)
+	v.required("physicalnetworkid")
+	v.ids("physicalnetworkid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListTrafficTypesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListTrafficTypesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "physicalnetworkid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listTrafficTypes", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listTrafficTypes: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListTrafficTypesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -737,6 +1697,15 @@ func (p *ListTrafficTypesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListTrafficTypesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListTrafficTypesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListTrafficTypesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -744,6 +1713,15 @@ func (p *ListTrafficTypesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListTrafficTypesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListTrafficTypesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListTrafficTypesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -751,6 +1729,15 @@ func (p *ListTrafficTypesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListTrafficTypesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListTrafficTypesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListTrafficTypesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -758,6 +1745,15 @@ func (p *ListTrafficTypesParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *ListTrafficTypesParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListTrafficTypesParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListTrafficTypesParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewListTrafficTypesParams(physicalnetworkid string) *ListTrafficTypesParams {
@@ -769,6 +1765,11 @@ func (s *UsageService) NewListTrafficTypesParams(physicalnetworkid string) *List
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetTrafficTypeIDWithContext(context.Background(), keyword, physicalnetworkid, opts...)
+}
+
+// GetTrafficTypeIDWithContext is the same as GetTrafficTypeID, but uses ctx to cancel the request
+func (s *UsageService) GetTrafficTypeIDWithContext(ctx context.Context, keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListTrafficTypesParams{}
 	p.p = make(map[string]interface{})
 
@@ -781,7 +1782,7 @@ func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string
 		}
 	}
 
-	l, err := s.ListTrafficTypes(p)
+	l, err := s.ListTrafficTypesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -806,7 +1807,16 @@ func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string
 
 // Lists traffic types of a given physical network.
 func (s *UsageService) ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficTypes", p.toURLValues())
+	return s.ListTrafficTypesWithContext(context.Background(), p)
+}
+
+// ListTrafficTypesWithContext is the same as ListTrafficTypes, but uses ctx to cancel the request and any async job polling
+func (s *UsageService) ListTrafficTypesWithContext(ctx context.Context, p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listTrafficTypes", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -819,6 +1829,92 @@ func (s *UsageService) ListTrafficTypes(p *ListTrafficTypesParams) (*ListTraffic
 	return &r, nil
 }
 
+// ListTrafficTypesPager iterates over all pages of a ListTrafficTypes call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListTrafficTypesPager struct {
+	pager
+
+	s *UsageService
+	p *ListTrafficTypesParams
+	r *ListTrafficTypesResponse
+}
+
+// NewListTrafficTypesPager returns a pager for all pages of a ListTrafficTypes call with the given params
+func (s *UsageService) NewListTrafficTypesPager(p *ListTrafficTypesParams) *ListTrafficTypesPager {
+	return &ListTrafficTypesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListTrafficTypesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListTrafficTypesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListTrafficTypesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListTrafficTypesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.TrafficTypes), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListTrafficTypesPager) Page() *ListTrafficTypesResponse {
+	return pg.r
+}
+
+// ListTrafficTypesAll fetches all pages of a ListTrafficTypes call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *UsageService) ListTrafficTypesAll(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
+	return s.ListTrafficTypesAllWithContext(context.Background(), p)
+}
+
+// ListTrafficTypesAllWithContext is the same as ListTrafficTypesAll, but uses ctx to cancel the requests
+func (s *UsageService) ListTrafficTypesAllWithContext(ctx context.Context, p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*TrafficType)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListTrafficTypesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListTrafficTypesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.TrafficTypes
+		mu.Unlock()
+
+		return len(l.TrafficTypes), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListTrafficTypesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.TrafficTypes = append(r.TrafficTypes, pages[page]...)
+	}
+	r.Count = len(r.TrafficTypes)
+
+	return r, nil
+}
+
 type ListTrafficTypesResponse struct {
 	Count        int            `json:"count"`
 	TrafficTypes []*TrafficType `json:"traffictype"`
@@ -840,7 +1936,8 @@ type ListUsageRecordsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListUsageRecordsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListUsageRecordsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -896,6 +1993,63 @@ func (p *ListUsageRecordsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListUsageRecordsParams) Validate() error {
+	v := newParamValidator("listUsageRecords", p.p)
+	v.required("enddate", "startdate")
+	v.ids("accountid", "domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("usageid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListUsageRecordsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListUsageRecordsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "accountid", "domainid", "enddate", "keyword", "projectid", "startdate", "usageid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "includetags", "isrecursive", "oldformat":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "type":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listUsageRecords", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listUsageRecords: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListUsageRecordsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -903,6 +2057,15 @@ func (p *ListUsageRecordsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListUsageRecordsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListUsageRecordsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListUsageRecordsParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -910,6 +2073,15 @@ func (p *ListUsageRecordsParams) SetAccountid(v string) {
 	p.p["accountid"] = v
 }
 
+func (p *ListUsageRecordsParams) ResetAccountid() {
+	delete(p.p, "accountid")
+}
+
+func (p *ListUsageRecordsParams) GetAccountid() (string, bool) {
+	value, ok := p.p["accountid"].(string)
+	return value, ok
+}
+
 func (p *ListUsageRecordsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -917,6 +2089,15 @@ func (p *ListUsageRecordsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListUsageRecordsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListUsageRecordsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListUsageRecordsParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -924,6 +2105,15 @@ func (p *ListUsageRecordsParams) SetEnddate(v string) {
 	p.p["enddate"] = v
 }
 
+func (p *ListUsageRecordsParams) ResetEnddate() {
+	delete(p.p, "enddate")
+}
+
+func (p *ListUsageRecordsParams) GetEnddate() (string, bool) {
+	value, ok := p.p["enddate"].(string)
+	return value, ok
+}
+
 func (p *ListUsageRecordsParams) SetIncludetags(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -931,6 +2121,15 @@ func (p *ListUsageRecordsParams) SetIncludetags(v bool) {
 	p.p["includetags"] = v
 }
 
+func (p *ListUsageRecordsParams) ResetIncludetags() {
+	delete(p.p, "includetags")
+}
+
+func (p *ListUsageRecordsParams) GetIncludetags() (bool, bool) {
+	value, ok := p.p["includetags"].(bool)
+	return value, ok
+}
+
 func (p *ListUsageRecordsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -938,6 +2137,15 @@ func (p *ListUsageRecordsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListUsageRecordsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListUsageRecordsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListUsageRecordsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -945,6 +2153,15 @@ func (p *ListUsageRecordsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListUsageRecordsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListUsageRecordsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListUsageRecordsParams) SetOldformat(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -952,6 +2169,15 @@ func (p *ListUsageRecordsParams) SetOldformat(v bool) {
 	p.p["oldformat"] = v
 }
 
+func (p *ListUsageRecordsParams) ResetOldformat() {
+	delete(p.p, "oldformat")
+}
+
+func (p *ListUsageRecordsParams) GetOldformat() (bool, bool) {
+	value, ok := p.p["oldformat"].(bool)
+	return value, ok
+}
+
 func (p *ListUsageRecordsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -959,6 +2185,15 @@ func (p *ListUsageRecordsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListUsageRecordsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListUsageRecordsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListUsageRecordsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -966,6 +2201,15 @@ func (p *ListUsageRecordsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListUsageRecordsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListUsageRecordsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListUsageRecordsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -973,6 +2217,15 @@ func (p *ListUsageRecordsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListUsageRecordsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListUsageRecordsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListUsageRecordsParams) SetStartdate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -980,6 +2233,15 @@ func (p *ListUsageRecordsParams) SetStartdate(v string) {
 	p.p["startdate"] = v
 }
 
+func (p *ListUsageRecordsParams) ResetStartdate() {
+	delete(p.p, "startdate")
+}
+
+func (p *ListUsageRecordsParams) GetStartdate() (string, bool) {
+	value, ok := p.p["startdate"].(string)
+	return value, ok
+}
+
 func (p *ListUsageRecordsParams) SetType(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -987,6 +2249,15 @@ func (p *ListUsageRecordsParams) SetType(v int64) {
 	p.p["type"] = v
 }
 
+func (p *ListUsageRecordsParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ListUsageRecordsParams) GetType() (int64, bool) {
+	value, ok := p.p["type"].(int64)
+	return value, ok
+}
+
 func (p *ListUsageRecordsParams) SetUsageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -994,6 +2265,15 @@ func (p *ListUsageRecordsParams) SetUsageid(v string) {
 	p.p["usageid"] = v
 }
 
+func (p *ListUsageRecordsParams) ResetUsageid() {
+	delete(p.p, "usageid")
+}
+
+func (p *ListUsageRecordsParams) GetUsageid() (string, bool) {
+	value, ok := p.p["usageid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListUsageRecordsParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewListUsageRecordsParams(enddate string, startdate string) *ListUsageRecordsParams {
@@ -1006,7 +2286,16 @@ func (s *UsageService) NewListUsageRecordsParams(enddate string, startdate strin
 
 // Lists usage records for accounts
 func (s *UsageService) ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error) {
-	resp, err := s.cs.newRequest("listUsageRecords", p.toURLValues())
+	return s.ListUsageRecordsWithContext(context.Background(), p)
+}
+
+// ListUsageRecordsWithContext is the same as ListUsageRecords, but uses ctx to cancel the request and any async job polling
+func (s *UsageService) ListUsageRecordsWithContext(ctx context.Context, p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listUsageRecords", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1019,6 +2308,92 @@ func (s *UsageService) ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRe
 	return &r, nil
 }
 
+// ListUsageRecordsPager iterates over all pages of a ListUsageRecords call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListUsageRecordsPager struct {
+	pager
+
+	s *UsageService
+	p *ListUsageRecordsParams
+	r *ListUsageRecordsResponse
+}
+
+// NewListUsageRecordsPager returns a pager for all pages of a ListUsageRecords call with the given params
+func (s *UsageService) NewListUsageRecordsPager(p *ListUsageRecordsParams) *ListUsageRecordsPager {
+	return &ListUsageRecordsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListUsageRecordsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListUsageRecordsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListUsageRecordsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListUsageRecordsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.UsageRecords), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListUsageRecordsPager) Page() *ListUsageRecordsResponse {
+	return pg.r
+}
+
+// ListUsageRecordsAll fetches all pages of a ListUsageRecords call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *UsageService) ListUsageRecordsAll(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error) {
+	return s.ListUsageRecordsAllWithContext(context.Background(), p)
+}
+
+// ListUsageRecordsAllWithContext is the same as ListUsageRecordsAll, but uses ctx to cancel the requests
+func (s *UsageService) ListUsageRecordsAllWithContext(ctx context.Context, p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*UsageRecord)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListUsageRecordsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListUsageRecordsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.UsageRecords
+		mu.Unlock()
+
+		return len(l.UsageRecords), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListUsageRecordsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.UsageRecords = append(r.UsageRecords, pages[page]...)
+	}
+	r.Count = len(r.UsageRecords)
+
+	return r, nil
+}
+
 type ListUsageRecordsResponse struct {
 	Count        int            `json:"count"`
 	UsageRecords []*UsageRecord `json:"usagerecord"`
@@ -1094,7 +2469,8 @@ type ListUsageTypesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListUsageTypesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListUsageTypesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1102,6 +2478,34 @@ func (p *ListUsageTypesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListUsageTypesParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListUsageTypesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListUsageTypesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k := range m {
+		return fmt.Errorf("Unknown param %s for listUsageTypes", k)
+	}
+	return nil
+}
+
 // You should always use this function to get a new ListUsageTypesParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewListUsageTypesParams() *ListUsageTypesParams {
@@ -1112,7 +2516,16 @@ func (s *UsageService) NewListUsageTypesParams() *ListUsageTypesParams {
 
 // List Usage Types
 func (s *UsageService) ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesResponse, error) {
-	resp, err := s.cs.newRequest("listUsageTypes", p.toURLValues())
+	return s.ListUsageTypesWithContext(context.Background(), p)
+}
+
+// ListUsageTypesWithContext is the same as ListUsageTypes, but uses ctx to cancel the request and any async job polling
+func (s *UsageService) ListUsageTypesWithContext(ctx context.Context, p *ListUsageTypesParams) (*ListUsageTypesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listUsageTypes", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1141,7 +2554,8 @@ type RemoveRawUsageRecordsParams struct {
 	p map[string]interface{}
 }
 
-func (p *RemoveRawUsageRecordsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RemoveRawUsageRecordsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1153,6 +2567,47 @@ func (p *RemoveRawUsageRecordsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RemoveRawUsageRecordsParams) Validate() error {
+	v := newParamValidator("removeRawUsageRecords", p.p)
+	v.required("interval")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RemoveRawUsageRecordsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RemoveRawUsageRecordsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "interval":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for removeRawUsageRecords", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of removeRawUsageRecords: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveRawUsageRecordsParams) SetInterval(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1160,6 +2615,15 @@ func (p *RemoveRawUsageRecordsParams) SetInterval(v int) {
 	p.p["interval"] = v
 }
 
+func (p *RemoveRawUsageRecordsParams) ResetInterval() {
+	delete(p.p, "interval")
+}
+
+func (p *RemoveRawUsageRecordsParams) GetInterval() (int, bool) {
+	value, ok := p.p["interval"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new RemoveRawUsageRecordsParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewRemoveRawUsageRecordsParams(interval int) *RemoveRawUsageRecordsParams {
@@ -1171,7 +2635,16 @@ func (s *UsageService) NewRemoveRawUsageRecordsParams(interval int) *RemoveRawUs
 
 // Safely removes raw records from cloud_usage table
 func (s *UsageService) RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams) (*RemoveRawUsageRecordsResponse, error) {
-	resp, err := s.cs.newRequest("removeRawUsageRecords", p.toURLValues())
+	return s.RemoveRawUsageRecordsWithContext(context.Background(), p)
+}
+
+// RemoveRawUsageRecordsWithContext is the same as RemoveRawUsageRecords, but uses ctx to cancel the request and any async job polling
+func (s *UsageService) RemoveRawUsageRecordsWithContext(ctx context.Context, p *RemoveRawUsageRecordsParams) (*RemoveRawUsageRecordsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeRawUsageRecords", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1222,7 +2695,8 @@ type UpdateTrafficTypeParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateTrafficTypeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateTrafficTypeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1248,6 +2722,53 @@ func (p *UpdateTrafficTypeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateTrafficTypeParams) Validate() error {
+	v := newParamValidator("updateTrafficType", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("hypervnetworklabel", 255)
+	v.maxLength("kvmnetworklabel", 255)
+	v.maxLength("ovm3networklabel", 255)
+	v.maxLength("vmwarenetworklabel", 255)
+	v.maxLength("xennetworklabel", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateTrafficTypeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateTrafficTypeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hypervnetworklabel", "id", "kvmnetworklabel", "ovm3networklabel", "vmwarenetworklabel", "xennetworklabel":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateTrafficType", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateTrafficType: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateTrafficTypeParams) SetHypervnetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1255,6 +2776,15 @@ func (p *UpdateTrafficTypeParams) SetHypervnetworklabel(v string) {
 	p.p["hypervnetworklabel"] = v
 }
 
+func (p *UpdateTrafficTypeParams) ResetHypervnetworklabel() {
+	delete(p.p, "hypervnetworklabel")
+}
+
+func (p *UpdateTrafficTypeParams) GetHypervnetworklabel() (string, bool) {
+	value, ok := p.p["hypervnetworklabel"].(string)
+	return value, ok
+}
+
 func (p *UpdateTrafficTypeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1262,6 +2792,15 @@ func (p *UpdateTrafficTypeParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateTrafficTypeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateTrafficTypeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateTrafficTypeParams) SetKvmnetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1269,6 +2808,15 @@ func (p *UpdateTrafficTypeParams) SetKvmnetworklabel(v string) {
 	p.p["kvmnetworklabel"] = v
 }
 
+func (p *UpdateTrafficTypeParams) ResetKvmnetworklabel() {
+	delete(p.p, "kvmnetworklabel")
+}
+
+func (p *UpdateTrafficTypeParams) GetKvmnetworklabel() (string, bool) {
+	value, ok := p.p["kvmnetworklabel"].(string)
+	return value, ok
+}
+
 func (p *UpdateTrafficTypeParams) SetOvm3networklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1276,6 +2824,15 @@ func (p *UpdateTrafficTypeParams) SetOvm3networklabel(v string) {
 	p.p["ovm3networklabel"] = v
 }
 
+func (p *UpdateTrafficTypeParams) ResetOvm3networklabel() {
+	delete(p.p, "ovm3networklabel")
+}
+
+func (p *UpdateTrafficTypeParams) GetOvm3networklabel() (string, bool) {
+	value, ok := p.p["ovm3networklabel"].(string)
+	return value, ok
+}
+
 func (p *UpdateTrafficTypeParams) SetVmwarenetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1283,6 +2840,15 @@ func (p *UpdateTrafficTypeParams) SetVmwarenetworklabel(v string) {
 	p.p["vmwarenetworklabel"] = v
 }
 
+func (p *UpdateTrafficTypeParams) ResetVmwarenetworklabel() {
+	delete(p.p, "vmwarenetworklabel")
+}
+
+func (p *UpdateTrafficTypeParams) GetVmwarenetworklabel() (string, bool) {
+	value, ok := p.p["vmwarenetworklabel"].(string)
+	return value, ok
+}
+
 func (p *UpdateTrafficTypeParams) SetXennetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1290,6 +2856,15 @@ func (p *UpdateTrafficTypeParams) SetXennetworklabel(v string) {
 	p.p["xennetworklabel"] = v
 }
 
+func (p *UpdateTrafficTypeParams) ResetXennetworklabel() {
+	delete(p.p, "xennetworklabel")
+}
+
+func (p *UpdateTrafficTypeParams) GetXennetworklabel() (string, bool) {
+	value, ok := p.p["xennetworklabel"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateTrafficTypeParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewUpdateTrafficTypeParams(id string) *UpdateTrafficTypeParams {
@@ -1301,7 +2876,16 @@ func (s *UsageService) NewUpdateTrafficTypeParams(id string) *UpdateTrafficTypeP
 
 // Updates traffic type of a physical network
 func (s *UsageService) UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error) {
-	resp, err := s.cs.newRequest("updateTrafficType", p.toURLValues())
+	return s.UpdateTrafficTypeWithContext(context.Background(), p)
+}
+
+// UpdateTrafficTypeWithContext is the same as UpdateTrafficType, but uses ctx to cancel the request and any async job polling
+func (s *UsageService) UpdateTrafficTypeWithContext(ctx context.Context, p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateTrafficType", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1313,7 +2897,7 @@ func (s *UsageService) UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTra
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1334,6 +2918,40 @@ func (s *UsageService) UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTra
 	return &r, nil
 }
 
+// UpdateTrafficTypeAsync starts the async job for UpdateTrafficType and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateTrafficTypeResponse using Job.Result
+func (s *UsageService) UpdateTrafficTypeAsync(p *UpdateTrafficTypeParams) (*Job, error) {
+	return s.UpdateTrafficTypeAsyncWithContext(context.Background(), p)
+}
+
+// UpdateTrafficTypeAsyncWithContext is the same as UpdateTrafficTypeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *UsageService) UpdateTrafficTypeAsyncWithContext(ctx context.Context, p *UpdateTrafficTypeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateTrafficType", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateTrafficTypeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateTrafficTypeResponse struct {
 	Hypervnetworklabel string `json:"hypervnetworklabel"`
 	Id                 string `json:"id"`
@@ -20,18 +20,68 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// UserServiceIface is the interface implemented by UserService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type UserServiceIface interface {
+	NewCreateUserParams(account string, email string, firstname string, lastname string, password string, username string) *CreateUserParams
+	CreateUser(p *CreateUserParams) (*CreateUserResponse, error)
+	CreateUserWithContext(ctx context.Context, p *CreateUserParams) (*CreateUserResponse, error)
+	NewDeleteUserParams(id string) *DeleteUserParams
+	DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, error)
+	DeleteUserWithContext(ctx context.Context, p *DeleteUserParams) (*DeleteUserResponse, error)
+	NewDisableUserParams(id string) *DisableUserParams
+	DisableUser(p *DisableUserParams) (*DisableUserResponse, error)
+	DisableUserWithContext(ctx context.Context, p *DisableUserParams) (*DisableUserResponse, error)
+	DisableUserAsync(p *DisableUserParams) (*Job, error)
+	DisableUserAsyncWithContext(ctx context.Context, p *DisableUserParams) (*Job, error)
+	NewEnableUserParams(id string) *EnableUserParams
+	EnableUser(p *EnableUserParams) (*EnableUserResponse, error)
+	EnableUserWithContext(ctx context.Context, p *EnableUserParams) (*EnableUserResponse, error)
+	NewGetUserParams(userapikey string) *GetUserParams
+	GetUser(p *GetUserParams) (*GetUserResponse, error)
+	GetUserWithContext(ctx context.Context, p *GetUserParams) (*GetUserResponse, error)
+	NewGetUserKeysParams(id string) *GetUserKeysParams
+	GetUserKeys(p *GetUserKeysParams) (*GetUserKeysResponse, error)
+	GetUserKeysWithContext(ctx context.Context, p *GetUserKeysParams) (*GetUserKeysResponse, error)
+	NewGetVirtualMachineUserDataParams(virtualmachineid string) *GetVirtualMachineUserDataParams
+	GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error)
+	GetVirtualMachineUserDataWithContext(ctx context.Context, p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error)
+	NewListUsersParams() *ListUsersParams
+	GetUserByID(id string, opts ...OptionFunc) (*User, int, error)
+	GetUserByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*User, int, error)
+	ListUsers(p *ListUsersParams) (*ListUsersResponse, error)
+	ListUsersWithContext(ctx context.Context, p *ListUsersParams) (*ListUsersResponse, error)
+	NewListUsersPager(p *ListUsersParams) *ListUsersPager
+	ListUsersAll(p *ListUsersParams) (*ListUsersResponse, error)
+	ListUsersAllWithContext(ctx context.Context, p *ListUsersParams) (*ListUsersResponse, error)
+	NewLockUserParams(id string) *LockUserParams
+	LockUser(p *LockUserParams) (*LockUserResponse, error)
+	LockUserWithContext(ctx context.Context, p *LockUserParams) (*LockUserResponse, error)
+	NewMoveUserParams(id string) *MoveUserParams
+	MoveUser(p *MoveUserParams) (*MoveUserResponse, error)
+	MoveUserWithContext(ctx context.Context, p *MoveUserParams) (*MoveUserResponse, error)
+	NewRegisterUserKeysParams(id string) *RegisterUserKeysParams
+	RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error)
+	RegisterUserKeysWithContext(ctx context.Context, p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error)
+	NewUpdateUserParams(id string) *UpdateUserParams
+	UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, error)
+	UpdateUserWithContext(ctx context.Context, p *UpdateUserParams) (*UpdateUserResponse, error)
+}
+
 type CreateUserParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateUserParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateUserParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -66,6 +116,56 @@ func (p *CreateUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateUserParams) Validate() error {
+	v := newParamValidator("createUser", p.p)
+	v.required("account", "email", "firstname", "lastname", "password", "username")
+	v.ids("domainid")
+	v.maxLength("account", 255)
+	v.maxLength("email", 255)
+	v.maxLength("firstname", 255)
+	v.maxLength("lastname", 255)
+	v.maxLength("password", 255)
+	v.maxLength("timezone", 255)
+	v.maxLength("userid", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateUserParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateUserParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "email", "firstname", "lastname", "password", "timezone", "userid", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createUser", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createUser: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateUserParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -73,6 +173,15 @@ func (p *CreateUserParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateUserParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateUserParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateUserParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -80,6 +189,15 @@ func (p *CreateUserParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateUserParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateUserParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateUserParams) SetEmail(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -87,6 +205,15 @@ func (p *CreateUserParams) SetEmail(v string) {
 	p.p["email"] = v
 }
 
+func (p *CreateUserParams) ResetEmail() {
+	delete(p.p, "email")
+}
+
+func (p *CreateUserParams) GetEmail() (string, bool) {
+	value, ok := p.p["email"].(string)
+	return value, ok
+}
+
 func (p *CreateUserParams) SetFirstname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -94,6 +221,15 @@ func (p *CreateUserParams) SetFirstname(v string) {
 	p.p["firstname"] = v
 }
 
+func (p *CreateUserParams) ResetFirstname() {
+	delete(p.p, "firstname")
+}
+
+func (p *CreateUserParams) GetFirstname() (string, bool) {
+	value, ok := p.p["firstname"].(string)
+	return value, ok
+}
+
 func (p *CreateUserParams) SetLastname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -101,6 +237,15 @@ func (p *CreateUserParams) SetLastname(v string) {
 	p.p["lastname"] = v
 }
 
+func (p *CreateUserParams) ResetLastname() {
+	delete(p.p, "lastname")
+}
+
+func (p *CreateUserParams) GetLastname() (string, bool) {
+	value, ok := p.p["lastname"].(string)
+	return value, ok
+}
+
 func (p *CreateUserParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -108,6 +253,15 @@ func (p *CreateUserParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *CreateUserParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *CreateUserParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *CreateUserParams) SetTimezone(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -115,6 +269,15 @@ func (p *CreateUserParams) SetTimezone(v string) {
 	p.p["timezone"] = v
 }
 
+func (p *CreateUserParams) ResetTimezone() {
+	delete(p.p, "timezone")
+}
+
+func (p *CreateUserParams) GetTimezone() (string, bool) {
+	value, ok := p.p["timezone"].(string)
+	return value, ok
+}
+
 func (p *CreateUserParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -122,6 +285,15 @@ func (p *CreateUserParams) SetUserid(v string) {
 	p.p["userid"] = v
 }
 
+func (p *CreateUserParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *CreateUserParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
 func (p *CreateUserParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -129,6 +301,15 @@ func (p *CreateUserParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *CreateUserParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *CreateUserParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateUserParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewCreateUserParams(account string, email string, firstname string, lastname string, password string, username string) *CreateUserParams {
@@ -145,7 +326,16 @@ func (s *UserService) NewCreateUserParams(account string, email string, firstnam
 
 // Creates a user for an account that already exists
 func (s *UserService) CreateUser(p *CreateUserParams) (*CreateUserResponse, error) {
-	resp, err := s.cs.newRequest("createUser", p.toURLValues())
+	return s.CreateUserWithContext(context.Background(), p)
+}
+
+// CreateUserWithContext is the same as CreateUser, but uses ctx to cancel the request and any async job polling
+func (s *UserService) CreateUserWithContext(ctx context.Context, p *CreateUserParams) (*CreateUserResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createUser", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +382,8 @@ type DeleteUserParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteUserParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteUserParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -203,6 +394,48 @@ func (p *DeleteUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteUserParams) Validate() error {
+	v := newParamValidator("deleteUser", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteUserParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteUserParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteUser", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteUser: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteUserParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -210,6 +443,15 @@ func (p *DeleteUserParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteUserParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteUserParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteUserParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewDeleteUserParams(id string) *DeleteUserParams {
@@ -221,7 +463,16 @@ func (s *UserService) NewDeleteUserParams(id string) *DeleteUserParams {
 
 // Deletes a user for an account
 func (s *UserService) DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, error) {
-	resp, err := s.cs.newRequest("deleteUser", p.toURLValues())
+	return s.DeleteUserWithContext(context.Background(), p)
+}
+
+// DeleteUserWithContext is the same as DeleteUser, but uses ctx to cancel the request and any async job polling
+func (s *UserService) DeleteUserWithContext(ctx context.Context, p *DeleteUserParams) (*DeleteUserResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteUser", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -272,7 +523,8 @@ type DisableUserParams struct {
 	p map[string]interface{}
 }
 
-func (p *DisableUserParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DisableUserParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -283,6 +535,48 @@ func (p *DisableUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DisableUserParams) Validate() error {
+	v := newParamValidator("disableUser", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DisableUserParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DisableUserParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for disableUser", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of disableUser: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DisableUserParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -290,6 +584,15 @@ func (p *DisableUserParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DisableUserParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DisableUserParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DisableUserParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewDisableUserParams(id string) *DisableUserParams {
@@ -301,7 +604,16 @@ func (s *UserService) NewDisableUserParams(id string) *DisableUserParams {
 
 // Disables a user account
 func (s *UserService) DisableUser(p *DisableUserParams) (*DisableUserResponse, error) {
-	resp, err := s.cs.newRequest("disableUser", p.toURLValues())
+	return s.DisableUserWithContext(context.Background(), p)
+}
+
+// DisableUserWithContext is the same as DisableUser, but uses ctx to cancel the request and any async job polling
+func (s *UserService) DisableUserWithContext(ctx context.Context, p *DisableUserParams) (*DisableUserResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableUser", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -313,7 +625,7 @@ func (s *UserService) DisableUser(p *DisableUserParams) (*DisableUserResponse, e
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -334,6 +646,40 @@ func (s *UserService) DisableUser(p *DisableUserParams) (*DisableUserResponse, e
 	return &r, nil
 }
 
+// DisableUserAsync starts the async job for DisableUser and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DisableUserResponse using Job.Result
+func (s *UserService) DisableUserAsync(p *DisableUserParams) (*Job, error) {
+	return s.DisableUserAsyncWithContext(context.Background(), p)
+}
+
+// DisableUserAsyncWithContext is the same as DisableUserAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *UserService) DisableUserAsyncWithContext(ctx context.Context, p *DisableUserParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableUser", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableUserResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type DisableUserResponse struct {
 	Account             string `json:"account"`
 	Accountid           string `json:"accountid"`
@@ -364,7 +710,8 @@ type EnableUserParams struct {
 	p map[string]interface{}
 }
 
-func (p *EnableUserParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *EnableUserParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -375,6 +722,48 @@ func (p *EnableUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *EnableUserParams) Validate() error {
+	v := newParamValidator("enableUser", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *EnableUserParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *EnableUserParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for enableUser", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of enableUser: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *EnableUserParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -382,6 +771,15 @@ func (p *EnableUserParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *EnableUserParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *EnableUserParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new EnableUserParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewEnableUserParams(id string) *EnableUserParams {
@@ -393,7 +791,16 @@ func (s *UserService) NewEnableUserParams(id string) *EnableUserParams {
 
 // Enables a user account
 func (s *UserService) EnableUser(p *EnableUserParams) (*EnableUserResponse, error) {
-	resp, err := s.cs.newRequest("enableUser", p.toURLValues())
+	return s.EnableUserWithContext(context.Background(), p)
+}
+
+// EnableUserWithContext is the same as EnableUser, but uses ctx to cancel the request and any async job polling
+func (s *UserService) EnableUserWithContext(ctx context.Context, p *EnableUserParams) (*EnableUserResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableUser", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -436,7 +843,8 @@ type GetUserParams struct {
 	p map[string]interface{}
 }
 
-func (p *GetUserParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetUserParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -447,6 +855,48 @@ func (p *GetUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetUserParams) Validate() error {
+	v := newParamValidator("getUser", p.p)
+	v.required("userapikey")
+	v.maxLength("userapikey", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetUserParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetUserParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "userapikey":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getUser", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getUser: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *GetUserParams) SetUserapikey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -454,6 +904,15 @@ func (p *GetUserParams) SetUserapikey(v string) {
 	p.p["userapikey"] = v
 }
 
+func (p *GetUserParams) ResetUserapikey() {
+	delete(p.p, "userapikey")
+}
+
+func (p *GetUserParams) GetUserapikey() (string, bool) {
+	value, ok := p.p["userapikey"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new GetUserParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewGetUserParams(userapikey string) *GetUserParams {
@@ -465,7 +924,16 @@ func (s *UserService) NewGetUserParams(userapikey string) *GetUserParams {
 
 // Find user account by API key
 func (s *UserService) GetUser(p *GetUserParams) (*GetUserResponse, error) {
-	resp, err := s.cs.newRequest("getUser", p.toURLValues())
+	return s.GetUserWithContext(context.Background(), p)
+}
+
+// GetUserWithContext is the same as GetUser, but uses ctx to cancel the request and any async job polling
+func (s *UserService) GetUserWithContext(ctx context.Context, p *GetUserParams) (*GetUserResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getUser", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -504,49 +972,106 @@ type GetUserResponse struct {
 	Usersource          string `json:"usersource"`
 }
 
-type GetVirtualMachineUserDataParams struct {
+type GetUserKeysParams struct {
 	p map[string]interface{}
 }
 
-func (p *GetVirtualMachineUserDataParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetUserKeysParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
 	}
 	return u
 }
 
-func (p *GetVirtualMachineUserDataParams) SetVirtualmachineid(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetUserKeysParams) Validate() error {
+	v := newParamValidator("getUserKeys", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetUserKeysParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetUserKeysParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getUserKeys", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getUserKeys: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *GetUserKeysParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["virtualmachineid"] = v
+	p.p["id"] = v
 }
 
-// You should always use this function to get a new GetVirtualMachineUserDataParams instance,
+func (p *GetUserKeysParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *GetUserKeysParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new GetUserKeysParams instance,
 // as then you are sure you have configured all required params
-func (s *UserService) NewGetVirtualMachineUserDataParams(virtualmachineid string) *GetVirtualMachineUserDataParams {
-	p := &GetVirtualMachineUserDataParams{}
+func (s *UserService) NewGetUserKeysParams(id string) *GetUserKeysParams {
+	p := &GetUserKeysParams{}
 	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+	p.p["id"] = id
 	return p
 }
 
-// Returns user data associated with the VM
-func (s *UserService) GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error) {
-	resp, err := s.cs.newRequest("getVirtualMachineUserData", p.toURLValues())
-	if err != nil {
+// This command allows the user to query the seceret and API keys for the account
+func (s *UserService) GetUserKeys(p *GetUserKeysParams) (*GetUserKeysResponse, error) {
+	return s.GetUserKeysWithContext(context.Background(), p)
+}
+
+// GetUserKeysWithContext is the same as GetUserKeys, but uses ctx to cancel the request and any async job polling
+func (s *UserService) GetUserKeysWithContext(ctx context.Context, p *GetUserKeysParams) (*GetUserKeysResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
 		return nil, err
 	}
 
-	if resp, err = getRawValue(resp); err != nil {
+	resp, err := s.cs.newRequestWithContext(ctx, "getUserKeys", p.ToURLValues())
+	if err != nil {
 		return nil, err
 	}
 
-	var r GetVirtualMachineUserDataResponse
+	var r GetUserKeysResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
@@ -554,41 +1079,160 @@ func (s *UserService) GetVirtualMachineUserData(p *GetVirtualMachineUserDataPara
 	return &r, nil
 }
 
-type GetVirtualMachineUserDataResponse struct {
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Userdata         string `json:"userdata"`
-	Virtualmachineid string `json:"virtualmachineid"`
+type GetUserKeysResponse struct {
+	Apikey    string `json:"apikey"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Secretkey string `json:"secretkey"`
 }
 
-type ListUsersParams struct {
+type GetVirtualMachineUserDataParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListUsersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetVirtualMachineUserDataParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["accounttype"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("accounttype", vv)
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetVirtualMachineUserDataParams) Validate() error {
+	v := newParamValidator("getVirtualMachineUserData", p.p)
+	v.required("virtualmachineid")
+	v.ids("virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetVirtualMachineUserDataParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetVirtualMachineUserDataParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getVirtualMachineUserData", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getVirtualMachineUserData: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *GetVirtualMachineUserDataParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+}
+
+func (p *GetVirtualMachineUserDataParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *GetVirtualMachineUserDataParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new GetVirtualMachineUserDataParams instance,
+// as then you are sure you have configured all required params
+func (s *UserService) NewGetVirtualMachineUserDataParams(virtualmachineid string) *GetVirtualMachineUserDataParams {
+	p := &GetVirtualMachineUserDataParams{}
+	p.p = make(map[string]interface{})
+	p.p["virtualmachineid"] = virtualmachineid
+	return p
+}
+
+// Returns user data associated with the VM
+func (s *UserService) GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error) {
+	return s.GetVirtualMachineUserDataWithContext(context.Background(), p)
+}
+
+// GetVirtualMachineUserDataWithContext is the same as GetVirtualMachineUserData, but uses ctx to cancel the request and any async job polling
+func (s *UserService) GetVirtualMachineUserDataWithContext(ctx context.Context, p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getVirtualMachineUserData", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
+	var r GetVirtualMachineUserDataResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type GetVirtualMachineUserDataResponse struct {
+	JobID            string `json:"jobid"`
+	Jobstatus        int    `json:"jobstatus"`
+	Userdata         string `json:"userdata"`
+	Virtualmachineid string `json:"virtualmachineid"`
+}
+
+type ListUsersParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListUsersParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["accounttype"]; found {
+		vv := strconv.FormatInt(v.(int64), 10)
+		u.Set("accounttype", vv)
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["isrecursive"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isrecursive", vv)
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
 	}
 	if v, found := p.p["listall"]; found {
 		vv := strconv.FormatBool(v.(bool))
@@ -611,6 +1255,63 @@ func (p *ListUsersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListUsersParams) Validate() error {
+	v := newParamValidator("listUsers", p.p)
+	v.ids("domainid", "id")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("state", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListUsersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListUsersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "state", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "accounttype":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listUsers", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listUsers: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListUsersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -618,6 +1319,15 @@ func (p *ListUsersParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListUsersParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListUsersParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListUsersParams) SetAccounttype(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -625,6 +1335,15 @@ func (p *ListUsersParams) SetAccounttype(v int64) {
 	p.p["accounttype"] = v
 }
 
+func (p *ListUsersParams) ResetAccounttype() {
+	delete(p.p, "accounttype")
+}
+
+func (p *ListUsersParams) GetAccounttype() (int64, bool) {
+	value, ok := p.p["accounttype"].(int64)
+	return value, ok
+}
+
 func (p *ListUsersParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -632,6 +1351,15 @@ func (p *ListUsersParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListUsersParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListUsersParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListUsersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -639,6 +1367,15 @@ func (p *ListUsersParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListUsersParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListUsersParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListUsersParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -646,6 +1383,15 @@ func (p *ListUsersParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListUsersParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListUsersParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListUsersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -653,6 +1399,15 @@ func (p *ListUsersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListUsersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListUsersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListUsersParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -660,6 +1415,15 @@ func (p *ListUsersParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListUsersParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListUsersParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListUsersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -667,6 +1431,15 @@ func (p *ListUsersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListUsersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListUsersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListUsersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -674,6 +1447,15 @@ func (p *ListUsersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListUsersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListUsersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListUsersParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -681,6 +1463,15 @@ func (p *ListUsersParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListUsersParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListUsersParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListUsersParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -688,6 +1479,15 @@ func (p *ListUsersParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *ListUsersParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *ListUsersParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListUsersParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewListUsersParams() *ListUsersParams {
@@ -698,6 +1498,11 @@ func (s *UserService) NewListUsersParams() *ListUsersParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UserService) GetUserByID(id string, opts ...OptionFunc) (*User, int, error) {
+	return s.GetUserByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetUserByIDWithContext is the same as GetUserByID, but uses ctx to cancel the request
+func (s *UserService) GetUserByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*User, int, error) {
 	p := &ListUsersParams{}
 	p.p = make(map[string]interface{})
 
@@ -709,18 +1514,16 @@ func (s *UserService) GetUserByID(id string, opts ...OptionFunc) (*User, int, er
 		}
 	}
 
-	l, err := s.ListUsers(p)
+	l, err := s.ListUsersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -731,7 +1534,16 @@ func (s *UserService) GetUserByID(id string, opts ...OptionFunc) (*User, int, er
 
 // Lists user accounts
 func (s *UserService) ListUsers(p *ListUsersParams) (*ListUsersResponse, error) {
-	resp, err := s.cs.newRequest("listUsers", p.toURLValues())
+	return s.ListUsersWithContext(context.Background(), p)
+}
+
+// ListUsersWithContext is the same as ListUsers, but uses ctx to cancel the request and any async job polling
+func (s *UserService) ListUsersWithContext(ctx context.Context, p *ListUsersParams) (*ListUsersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listUsers", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -744,6 +1556,92 @@ func (s *UserService) ListUsers(p *ListUsersParams) (*ListUsersResponse, error)
 	return &r, nil
 }
 
+// ListUsersPager iterates over all pages of a ListUsers call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListUsersPager struct {
+	pager
+
+	s *UserService
+	p *ListUsersParams
+	r *ListUsersResponse
+}
+
+// NewListUsersPager returns a pager for all pages of a ListUsers call with the given params
+func (s *UserService) NewListUsersPager(p *ListUsersParams) *ListUsersPager {
+	return &ListUsersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListUsersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListUsersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListUsersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListUsersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Users), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListUsersPager) Page() *ListUsersResponse {
+	return pg.r
+}
+
+// ListUsersAll fetches all pages of a ListUsers call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *UserService) ListUsersAll(p *ListUsersParams) (*ListUsersResponse, error) {
+	return s.ListUsersAllWithContext(context.Background(), p)
+}
+
+// ListUsersAllWithContext is the same as ListUsersAll, but uses ctx to cancel the requests
+func (s *UserService) ListUsersAllWithContext(ctx context.Context, p *ListUsersParams) (*ListUsersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*User)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListUsersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListUsersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Users
+		mu.Unlock()
+
+		return len(l.Users), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListUsersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Users = append(r.Users, pages[page]...)
+	}
+	r.Count = len(r.Users)
+
+	return r, nil
+}
+
 type ListUsersResponse struct {
 	Count int     `json:"count"`
 	Users []*User `json:"user"`
@@ -779,7 +1677,8 @@ type LockUserParams struct {
 	p map[string]interface{}
 }
 
-func (p *LockUserParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *LockUserParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -790,6 +1689,48 @@ func (p *LockUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *LockUserParams) Validate() error {
+	v := newParamValidator("lockUser", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *LockUserParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *LockUserParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for lockUser", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of lockUser: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *LockUserParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -797,6 +1738,15 @@ func (p *LockUserParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *LockUserParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *LockUserParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new LockUserParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewLockUserParams(id string) *LockUserParams {
@@ -808,7 +1758,16 @@ func (s *UserService) NewLockUserParams(id string) *LockUserParams {
 
 // Locks a user account
 func (s *UserService) LockUser(p *LockUserParams) (*LockUserResponse, error) {
-	resp, err := s.cs.newRequest("lockUser", p.toURLValues())
+	return s.LockUserWithContext(context.Background(), p)
+}
+
+// LockUserWithContext is the same as LockUser, but uses ctx to cancel the request and any async job polling
+func (s *UserService) LockUserWithContext(ctx context.Context, p *LockUserParams) (*LockUserResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "lockUser", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -847,11 +1806,192 @@ type LockUserResponse struct {
 	Usersource          string `json:"usersource"`
 }
 
+type MoveUserParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *MoveUserParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["accountid"]; found {
+		u.Set("accountid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *MoveUserParams) Validate() error {
+	v := newParamValidator("moveUser", p.p)
+	v.required("id")
+	v.ids("accountid", "id")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *MoveUserParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *MoveUserParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "accountid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for moveUser", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of moveUser: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *MoveUserParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *MoveUserParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *MoveUserParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *MoveUserParams) SetAccountid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["accountid"] = v
+}
+
+func (p *MoveUserParams) ResetAccountid() {
+	delete(p.p, "accountid")
+}
+
+func (p *MoveUserParams) GetAccountid() (string, bool) {
+	value, ok := p.p["accountid"].(string)
+	return value, ok
+}
+
+func (p *MoveUserParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *MoveUserParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *MoveUserParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new MoveUserParams instance,
+// as then you are sure you have configured all required params
+func (s *UserService) NewMoveUserParams(id string) *MoveUserParams {
+	p := &MoveUserParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Moves a user to another account
+func (s *UserService) MoveUser(p *MoveUserParams) (*MoveUserResponse, error) {
+	return s.MoveUserWithContext(context.Background(), p)
+}
+
+// MoveUserWithContext is the same as MoveUser, but uses ctx to cancel the request and any async job polling
+func (s *UserService) MoveUserWithContext(ctx context.Context, p *MoveUserParams) (*MoveUserResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "moveUser", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MoveUserResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type MoveUserResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *MoveUserResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias MoveUserResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
 type RegisterUserKeysParams struct {
 	p map[string]interface{}
 }
 
-func (p *RegisterUserKeysParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RegisterUserKeysParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -862,6 +2002,48 @@ func (p *RegisterUserKeysParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RegisterUserKeysParams) Validate() error {
+	v := newParamValidator("registerUserKeys", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RegisterUserKeysParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RegisterUserKeysParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for registerUserKeys", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of registerUserKeys: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RegisterUserKeysParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -869,6 +2051,15 @@ func (p *RegisterUserKeysParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RegisterUserKeysParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RegisterUserKeysParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RegisterUserKeysParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewRegisterUserKeysParams(id string) *RegisterUserKeysParams {
@@ -880,7 +2071,16 @@ func (s *UserService) NewRegisterUserKeysParams(id string) *RegisterUserKeysPara
 
 // This command allows a user to register for the developer API, returning a secret key and an API key. This request is made through the integration API port, so it is a privileged command and must be made on behalf of a user. It is up to the implementer just how the username and password are entered, and then how that translates to an integration API request. Both secret key and API key should be returned to the user
 func (s *UserService) RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error) {
-	resp, err := s.cs.newRequest("registerUserKeys", p.toURLValues())
+	return s.RegisterUserKeysWithContext(context.Background(), p)
+}
+
+// RegisterUserKeysWithContext is the same as RegisterUserKeys, but uses ctx to cancel the request and any async job polling
+func (s *UserService) RegisterUserKeysWithContext(ctx context.Context, p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "registerUserKeys", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -908,7 +2108,8 @@ type UpdateUserParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateUserParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateUserParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -946,6 +2147,57 @@ func (p *UpdateUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateUserParams) Validate() error {
+	v := newParamValidator("updateUser", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("currentpassword", 255)
+	v.maxLength("email", 255)
+	v.maxLength("firstname", 255)
+	v.maxLength("lastname", 255)
+	v.maxLength("password", 255)
+	v.maxLength("timezone", 255)
+	v.maxLength("userapikey", 255)
+	v.maxLength("username", 255)
+	v.maxLength("usersecretkey", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateUserParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateUserParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "currentpassword", "email", "firstname", "id", "lastname", "password", "timezone", "userapikey", "username", "usersecretkey":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateUser", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateUser: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateUserParams) SetCurrentpassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -953,6 +2205,15 @@ func (p *UpdateUserParams) SetCurrentpassword(v string) {
 	p.p["currentpassword"] = v
 }
 
+func (p *UpdateUserParams) ResetCurrentpassword() {
+	delete(p.p, "currentpassword")
+}
+
+func (p *UpdateUserParams) GetCurrentpassword() (string, bool) {
+	value, ok := p.p["currentpassword"].(string)
+	return value, ok
+}
+
 func (p *UpdateUserParams) SetEmail(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -960,6 +2221,15 @@ func (p *UpdateUserParams) SetEmail(v string) {
 	p.p["email"] = v
 }
 
+func (p *UpdateUserParams) ResetEmail() {
+	delete(p.p, "email")
+}
+
+func (p *UpdateUserParams) GetEmail() (string, bool) {
+	value, ok := p.p["email"].(string)
+	return value, ok
+}
+
 func (p *UpdateUserParams) SetFirstname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -967,6 +2237,15 @@ func (p *UpdateUserParams) SetFirstname(v string) {
 	p.p["firstname"] = v
 }
 
+func (p *UpdateUserParams) ResetFirstname() {
+	delete(p.p, "firstname")
+}
+
+func (p *UpdateUserParams) GetFirstname() (string, bool) {
+	value, ok := p.p["firstname"].(string)
+	return value, ok
+}
+
 func (p *UpdateUserParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -974,6 +2253,15 @@ func (p *UpdateUserParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateUserParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateUserParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateUserParams) SetLastname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -981,6 +2269,15 @@ func (p *UpdateUserParams) SetLastname(v string) {
 	p.p["lastname"] = v
 }
 
+func (p *UpdateUserParams) ResetLastname() {
+	delete(p.p, "lastname")
+}
+
+func (p *UpdateUserParams) GetLastname() (string, bool) {
+	value, ok := p.p["lastname"].(string)
+	return value, ok
+}
+
 func (p *UpdateUserParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -988,6 +2285,15 @@ func (p *UpdateUserParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *UpdateUserParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *UpdateUserParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *UpdateUserParams) SetTimezone(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -995,6 +2301,15 @@ func (p *UpdateUserParams) SetTimezone(v string) {
 	p.p["timezone"] = v
 }
 
+func (p *UpdateUserParams) ResetTimezone() {
+	delete(p.p, "timezone")
+}
+
+func (p *UpdateUserParams) GetTimezone() (string, bool) {
+	value, ok := p.p["timezone"].(string)
+	return value, ok
+}
+
 func (p *UpdateUserParams) SetUserapikey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1002,6 +2317,15 @@ func (p *UpdateUserParams) SetUserapikey(v string) {
 	p.p["userapikey"] = v
 }
 
+func (p *UpdateUserParams) ResetUserapikey() {
+	delete(p.p, "userapikey")
+}
+
+func (p *UpdateUserParams) GetUserapikey() (string, bool) {
+	value, ok := p.p["userapikey"].(string)
+	return value, ok
+}
+
 func (p *UpdateUserParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1009,6 +2333,15 @@ func (p *UpdateUserParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *UpdateUserParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *UpdateUserParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 func (p *UpdateUserParams) SetUsersecretkey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1016,6 +2349,15 @@ func (p *UpdateUserParams) SetUsersecretkey(v string) {
 	p.p["usersecretkey"] = v
 }
 
+func (p *UpdateUserParams) ResetUsersecretkey() {
+	delete(p.p, "usersecretkey")
+}
+
+func (p *UpdateUserParams) GetUsersecretkey() (string, bool) {
+	value, ok := p.p["usersecretkey"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateUserParams instance,
 // as then you are sure you have configured all required params
 func (s *UserService) NewUpdateUserParams(id string) *UpdateUserParams {
@@ -1027,7 +2369,16 @@ func (s *UserService) NewUpdateUserParams(id string) *UpdateUserParams {
 
 // Updates a user account
 func (s *UserService) UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, error) {
-	resp, err := s.cs.newRequest("updateUser", p.toURLValues())
+	return s.UpdateUserWithContext(context.Background(), p)
+}
+
+// UpdateUserWithContext is the same as UpdateUser, but uses ctx to cancel the request and any async job polling
+func (s *UserService) UpdateUserWithContext(ctx context.Context, p *UpdateUserParams) (*UpdateUserResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateUser", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
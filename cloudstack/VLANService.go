@@ -20,18 +20,55 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// VLANServiceIface is the interface implemented by VLANService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type VLANServiceIface interface {
+	NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams
+	CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error)
+	CreateVlanIpRangeWithContext(ctx context.Context, p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error)
+	NewDedicateGuestVlanRangeParams(physicalnetworkid string, vlanrange string) *DedicateGuestVlanRangeParams
+	DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error)
+	DedicateGuestVlanRangeWithContext(ctx context.Context, p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error)
+	NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangeParams
+	DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error)
+	DeleteVlanIpRangeWithContext(ctx context.Context, p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error)
+	NewListDedicatedGuestVlanRangesParams() *ListDedicatedGuestVlanRangesParams
+	GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error)
+	GetDedicatedGuestVlanRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error)
+	ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error)
+	ListDedicatedGuestVlanRangesWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error)
+	NewListDedicatedGuestVlanRangesPager(p *ListDedicatedGuestVlanRangesParams) *ListDedicatedGuestVlanRangesPager
+	ListDedicatedGuestVlanRangesAll(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error)
+	ListDedicatedGuestVlanRangesAllWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error)
+	NewListVlanIpRangesParams() *ListVlanIpRangesParams
+	GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIpRange, int, error)
+	GetVlanIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VlanIpRange, int, error)
+	ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error)
+	ListVlanIpRangesWithContext(ctx context.Context, p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error)
+	NewListVlanIpRangesPager(p *ListVlanIpRangesParams) *ListVlanIpRangesPager
+	ListVlanIpRangesAll(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error)
+	ListVlanIpRangesAllWithContext(ctx context.Context, p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error)
+	NewReleaseDedicatedGuestVlanRangeParams(id string) *ReleaseDedicatedGuestVlanRangeParams
+	ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error)
+	ReleaseDedicatedGuestVlanRangeWithContext(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error)
+	ReleaseDedicatedGuestVlanRangeAsync(p *ReleaseDedicatedGuestVlanRangeParams) (*Job, error)
+	ReleaseDedicatedGuestVlanRangeAsyncWithContext(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*Job, error)
+}
+
 type CreateVlanIpRangeParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateVlanIpRangeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateVlanIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -95,6 +132,61 @@ func (p *CreateVlanIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateVlanIpRangeParams) Validate() error {
+	v := newParamValidator("createVlanIpRange", p.p)
+	v.ids("domainid", "networkid", "physicalnetworkid", "podid", "projectid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("endip", 255)
+	v.maxLength("endipv6", 255)
+	v.maxLength("gateway", 255)
+	v.maxLength("ip6cidr", 255)
+	v.maxLength("ip6gateway", 255)
+	v.maxLength("netmask", 255)
+	v.maxLength("startip", 255)
+	v.maxLength("startipv6", 255)
+	v.maxLength("vlan", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateVlanIpRangeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateVlanIpRangeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "endip", "endipv6", "gateway", "ip6cidr", "ip6gateway", "netmask", "networkid", "physicalnetworkid", "podid", "projectid", "startip", "startipv6", "vlan", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "forsystemvms", "forvirtualnetwork":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createVlanIpRange", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createVlanIpRange: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVlanIpRangeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -102,6 +194,15 @@ func (p *CreateVlanIpRangeParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateVlanIpRangeParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -109,6 +210,15 @@ func (p *CreateVlanIpRangeParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateVlanIpRangeParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -116,6 +226,15 @@ func (p *CreateVlanIpRangeParams) SetEndip(v string) {
 	p.p["endip"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetEndip() {
+	delete(p.p, "endip")
+}
+
+func (p *CreateVlanIpRangeParams) GetEndip() (string, bool) {
+	value, ok := p.p["endip"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetEndipv6(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -123,6 +242,15 @@ func (p *CreateVlanIpRangeParams) SetEndipv6(v string) {
 	p.p["endipv6"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetEndipv6() {
+	delete(p.p, "endipv6")
+}
+
+func (p *CreateVlanIpRangeParams) GetEndipv6() (string, bool) {
+	value, ok := p.p["endipv6"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetForsystemvms(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -130,6 +258,15 @@ func (p *CreateVlanIpRangeParams) SetForsystemvms(v bool) {
 	p.p["forsystemvms"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetForsystemvms() {
+	delete(p.p, "forsystemvms")
+}
+
+func (p *CreateVlanIpRangeParams) GetForsystemvms() (bool, bool) {
+	value, ok := p.p["forsystemvms"].(bool)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetForvirtualnetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -137,6 +274,15 @@ func (p *CreateVlanIpRangeParams) SetForvirtualnetwork(v bool) {
 	p.p["forvirtualnetwork"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetForvirtualnetwork() {
+	delete(p.p, "forvirtualnetwork")
+}
+
+func (p *CreateVlanIpRangeParams) GetForvirtualnetwork() (bool, bool) {
+	value, ok := p.p["forvirtualnetwork"].(bool)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -144,6 +290,15 @@ func (p *CreateVlanIpRangeParams) SetGateway(v string) {
 	p.p["gateway"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetGateway() {
+	delete(p.p, "gateway")
+}
+
+func (p *CreateVlanIpRangeParams) GetGateway() (string, bool) {
+	value, ok := p.p["gateway"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetIp6cidr(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -151,6 +306,15 @@ func (p *CreateVlanIpRangeParams) SetIp6cidr(v string) {
 	p.p["ip6cidr"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetIp6cidr() {
+	delete(p.p, "ip6cidr")
+}
+
+func (p *CreateVlanIpRangeParams) GetIp6cidr() (string, bool) {
+	value, ok := p.p["ip6cidr"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetIp6gateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -158,6 +322,15 @@ func (p *CreateVlanIpRangeParams) SetIp6gateway(v string) {
 	p.p["ip6gateway"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetIp6gateway() {
+	delete(p.p, "ip6gateway")
+}
+
+func (p *CreateVlanIpRangeParams) GetIp6gateway() (string, bool) {
+	value, ok := p.p["ip6gateway"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -165,6 +338,15 @@ func (p *CreateVlanIpRangeParams) SetNetmask(v string) {
 	p.p["netmask"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetNetmask() {
+	delete(p.p, "netmask")
+}
+
+func (p *CreateVlanIpRangeParams) GetNetmask() (string, bool) {
+	value, ok := p.p["netmask"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -172,6 +354,15 @@ func (p *CreateVlanIpRangeParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *CreateVlanIpRangeParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -179,6 +370,15 @@ func (p *CreateVlanIpRangeParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *CreateVlanIpRangeParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -186,6 +386,15 @@ func (p *CreateVlanIpRangeParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *CreateVlanIpRangeParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,6 +402,15 @@ func (p *CreateVlanIpRangeParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *CreateVlanIpRangeParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetStartip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -200,6 +418,15 @@ func (p *CreateVlanIpRangeParams) SetStartip(v string) {
 	p.p["startip"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetStartip() {
+	delete(p.p, "startip")
+}
+
+func (p *CreateVlanIpRangeParams) GetStartip() (string, bool) {
+	value, ok := p.p["startip"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetStartipv6(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -207,6 +434,15 @@ func (p *CreateVlanIpRangeParams) SetStartipv6(v string) {
 	p.p["startipv6"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetStartipv6() {
+	delete(p.p, "startipv6")
+}
+
+func (p *CreateVlanIpRangeParams) GetStartipv6() (string, bool) {
+	value, ok := p.p["startipv6"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -214,6 +450,15 @@ func (p *CreateVlanIpRangeParams) SetVlan(v string) {
 	p.p["vlan"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetVlan() {
+	delete(p.p, "vlan")
+}
+
+func (p *CreateVlanIpRangeParams) GetVlan() (string, bool) {
+	value, ok := p.p["vlan"].(string)
+	return value, ok
+}
+
 func (p *CreateVlanIpRangeParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -221,6 +466,15 @@ func (p *CreateVlanIpRangeParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *CreateVlanIpRangeParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreateVlanIpRangeParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateVlanIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *VLANService) NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams {
@@ -231,7 +485,16 @@ func (s *VLANService) NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams {
 
 // Creates a VLAN IP range.
 func (s *VLANService) CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("createVlanIpRange", p.toURLValues())
+	return s.CreateVlanIpRangeWithContext(context.Background(), p)
+}
+
+// CreateVlanIpRangeWithContext is the same as CreateVlanIpRange, but uses ctx to cancel the request and any async job polling
+func (s *VLANService) CreateVlanIpRangeWithContext(ctx context.Context, p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVlanIpRange", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -276,7 +539,8 @@ type DedicateGuestVlanRangeParams struct {
 	p map[string]interface{}
 }
 
-func (p *DedicateGuestVlanRangeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DedicateGuestVlanRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -299,6 +563,50 @@ func (p *DedicateGuestVlanRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DedicateGuestVlanRangeParams) Validate() error {
+	v := newParamValidator("dedicateGuestVlanRange", p.p)
+	v.required("physicalnetworkid", "vlanrange")
+	v.ids("domainid", "physicalnetworkid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("vlanrange", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DedicateGuestVlanRangeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DedicateGuestVlanRangeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "physicalnetworkid", "projectid", "vlanrange":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for dedicateGuestVlanRange", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of dedicateGuestVlanRange: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DedicateGuestVlanRangeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -306,6 +614,15 @@ func (p *DedicateGuestVlanRangeParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *DedicateGuestVlanRangeParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *DedicateGuestVlanRangeParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *DedicateGuestVlanRangeParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -313,6 +630,15 @@ func (p *DedicateGuestVlanRangeParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *DedicateGuestVlanRangeParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *DedicateGuestVlanRangeParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *DedicateGuestVlanRangeParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -320,6 +646,15 @@ func (p *DedicateGuestVlanRangeParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *DedicateGuestVlanRangeParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *DedicateGuestVlanRangeParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *DedicateGuestVlanRangeParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -327,6 +662,15 @@ func (p *DedicateGuestVlanRangeParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *DedicateGuestVlanRangeParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *DedicateGuestVlanRangeParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *DedicateGuestVlanRangeParams) SetVlanrange(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -334,6 +678,15 @@ func (p *DedicateGuestVlanRangeParams) SetVlanrange(v string) {
 	p.p["vlanrange"] = v
 }
 
+func (p *DedicateGuestVlanRangeParams) ResetVlanrange() {
+	delete(p.p, "vlanrange")
+}
+
+func (p *DedicateGuestVlanRangeParams) GetVlanrange() (string, bool) {
+	value, ok := p.p["vlanrange"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DedicateGuestVlanRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *VLANService) NewDedicateGuestVlanRangeParams(physicalnetworkid string, vlanrange string) *DedicateGuestVlanRangeParams {
@@ -346,7 +699,16 @@ func (s *VLANService) NewDedicateGuestVlanRangeParams(physicalnetworkid string,
 
 // Dedicates a guest vlan range to an account
 func (s *VLANService) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
-	resp, err := s.cs.newRequest("dedicateGuestVlanRange", p.toURLValues())
+	return s.DedicateGuestVlanRangeWithContext(context.Background(), p)
+}
+
+// DedicateGuestVlanRangeWithContext is the same as DedicateGuestVlanRange, but uses ctx to cancel the request and any async job polling
+func (s *VLANService) DedicateGuestVlanRangeWithContext(ctx context.Context, p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicateGuestVlanRange", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -377,7 +739,8 @@ type DeleteVlanIpRangeParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteVlanIpRangeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteVlanIpRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -388,6 +751,48 @@ func (p *DeleteVlanIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteVlanIpRangeParams) Validate() error {
+	v := newParamValidator("deleteVlanIpRange", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteVlanIpRangeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteVlanIpRangeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteVlanIpRange", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteVlanIpRange: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVlanIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -395,6 +800,15 @@ func (p *DeleteVlanIpRangeParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteVlanIpRangeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteVlanIpRangeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteVlanIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *VLANService) NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangeParams {
@@ -406,7 +820,16 @@ func (s *VLANService) NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangePa
 
 // Creates a VLAN IP range.
 func (s *VLANService) DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("deleteVlanIpRange", p.toURLValues())
+	return s.DeleteVlanIpRangeWithContext(context.Background(), p)
+}
+
+// DeleteVlanIpRangeWithContext is the same as DeleteVlanIpRange, but uses ctx to cancel the request and any async job polling
+func (s *VLANService) DeleteVlanIpRangeWithContext(ctx context.Context, p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVlanIpRange", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -457,7 +880,8 @@ type ListDedicatedGuestVlanRangesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListDedicatedGuestVlanRangesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListDedicatedGuestVlanRangesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -497,6 +921,54 @@ func (p *ListDedicatedGuestVlanRangesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListDedicatedGuestVlanRangesParams) Validate() error {
+	v := newParamValidator("listDedicatedGuestVlanRanges", p.p)
+	v.ids("domainid", "id", "physicalnetworkid", "projectid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("guestvlanrange", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListDedicatedGuestVlanRangesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListDedicatedGuestVlanRangesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "guestvlanrange", "id", "keyword", "physicalnetworkid", "projectid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listDedicatedGuestVlanRanges", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listDedicatedGuestVlanRanges: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -504,6 +976,15 @@ func (p *ListDedicatedGuestVlanRangesParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListDedicatedGuestVlanRangesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -511,6 +992,15 @@ func (p *ListDedicatedGuestVlanRangesParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListDedicatedGuestVlanRangesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetGuestvlanrange(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -518,6 +1008,15 @@ func (p *ListDedicatedGuestVlanRangesParams) SetGuestvlanrange(v string) {
 	p.p["guestvlanrange"] = v
 }
 
+func (p *ListDedicatedGuestVlanRangesParams) ResetGuestvlanrange() {
+	delete(p.p, "guestvlanrange")
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetGuestvlanrange() (string, bool) {
+	value, ok := p.p["guestvlanrange"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -525,6 +1024,15 @@ func (p *ListDedicatedGuestVlanRangesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListDedicatedGuestVlanRangesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -532,6 +1040,15 @@ func (p *ListDedicatedGuestVlanRangesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListDedicatedGuestVlanRangesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -539,6 +1056,15 @@ func (p *ListDedicatedGuestVlanRangesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListDedicatedGuestVlanRangesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -546,6 +1072,15 @@ func (p *ListDedicatedGuestVlanRangesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListDedicatedGuestVlanRangesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -553,6 +1088,15 @@ func (p *ListDedicatedGuestVlanRangesParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *ListDedicatedGuestVlanRangesParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -560,6 +1104,15 @@ func (p *ListDedicatedGuestVlanRangesParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListDedicatedGuestVlanRangesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -567,6 +1120,15 @@ func (p *ListDedicatedGuestVlanRangesParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListDedicatedGuestVlanRangesParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListDedicatedGuestVlanRangesParams instance,
 // as then you are sure you have configured all required params
 func (s *VLANService) NewListDedicatedGuestVlanRangesParams() *ListDedicatedGuestVlanRangesParams {
@@ -577,6 +1139,11 @@ func (s *VLANService) NewListDedicatedGuestVlanRangesParams() *ListDedicatedGues
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error) {
+	return s.GetDedicatedGuestVlanRangeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetDedicatedGuestVlanRangeByIDWithContext is the same as GetDedicatedGuestVlanRangeByID, but uses ctx to cancel the request
+func (s *VLANService) GetDedicatedGuestVlanRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error) {
 	p := &ListDedicatedGuestVlanRangesParams{}
 	p.p = make(map[string]interface{})
 
@@ -588,18 +1155,16 @@ func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFu
 		}
 	}
 
-	l, err := s.ListDedicatedGuestVlanRanges(p)
+	l, err := s.ListDedicatedGuestVlanRangesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -610,7 +1175,16 @@ func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFu
 
 // Lists dedicated guest vlan ranges
 func (s *VLANService) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedGuestVlanRanges", p.toURLValues())
+	return s.ListDedicatedGuestVlanRangesWithContext(context.Background(), p)
+}
+
+// ListDedicatedGuestVlanRangesWithContext is the same as ListDedicatedGuestVlanRanges, but uses ctx to cancel the request and any async job polling
+func (s *VLANService) ListDedicatedGuestVlanRangesWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listDedicatedGuestVlanRanges", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -623,6 +1197,92 @@ func (s *VLANService) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRang
 	return &r, nil
 }
 
+// ListDedicatedGuestVlanRangesPager iterates over all pages of a ListDedicatedGuestVlanRanges call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListDedicatedGuestVlanRangesPager struct {
+	pager
+
+	s *VLANService
+	p *ListDedicatedGuestVlanRangesParams
+	r *ListDedicatedGuestVlanRangesResponse
+}
+
+// NewListDedicatedGuestVlanRangesPager returns a pager for all pages of a ListDedicatedGuestVlanRanges call with the given params
+func (s *VLANService) NewListDedicatedGuestVlanRangesPager(p *ListDedicatedGuestVlanRangesParams) *ListDedicatedGuestVlanRangesPager {
+	return &ListDedicatedGuestVlanRangesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListDedicatedGuestVlanRangesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListDedicatedGuestVlanRangesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListDedicatedGuestVlanRangesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListDedicatedGuestVlanRangesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.DedicatedGuestVlanRanges), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListDedicatedGuestVlanRangesPager) Page() *ListDedicatedGuestVlanRangesResponse {
+	return pg.r
+}
+
+// ListDedicatedGuestVlanRangesAll fetches all pages of a ListDedicatedGuestVlanRanges call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VLANService) ListDedicatedGuestVlanRangesAll(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
+	return s.ListDedicatedGuestVlanRangesAllWithContext(context.Background(), p)
+}
+
+// ListDedicatedGuestVlanRangesAllWithContext is the same as ListDedicatedGuestVlanRangesAll, but uses ctx to cancel the requests
+func (s *VLANService) ListDedicatedGuestVlanRangesAllWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*DedicatedGuestVlanRange)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListDedicatedGuestVlanRangesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListDedicatedGuestVlanRangesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.DedicatedGuestVlanRanges
+		mu.Unlock()
+
+		return len(l.DedicatedGuestVlanRanges), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListDedicatedGuestVlanRangesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.DedicatedGuestVlanRanges = append(r.DedicatedGuestVlanRanges, pages[page]...)
+	}
+	r.Count = len(r.DedicatedGuestVlanRanges)
+
+	return r, nil
+}
+
 type ListDedicatedGuestVlanRangesResponse struct {
 	Count                    int                        `json:"count"`
 	DedicatedGuestVlanRanges []*DedicatedGuestVlanRange `json:"dedicatedguestvlanrange"`
@@ -646,7 +1306,8 @@ type ListVlanIpRangesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListVlanIpRangesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVlanIpRangesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -696,6 +1357,58 @@ func (p *ListVlanIpRangesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVlanIpRangesParams) Validate() error {
+	v := newParamValidator("listVlanIpRanges", p.p)
+	v.ids("domainid", "id", "networkid", "physicalnetworkid", "podid", "projectid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("vlan", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVlanIpRangesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVlanIpRangesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "networkid", "physicalnetworkid", "podid", "projectid", "vlan", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "forvirtualnetwork":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVlanIpRanges", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVlanIpRanges: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListVlanIpRangesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -703,6 +1416,15 @@ func (p *ListVlanIpRangesParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListVlanIpRangesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListVlanIpRangesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListVlanIpRangesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -710,6 +1432,15 @@ func (p *ListVlanIpRangesParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListVlanIpRangesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListVlanIpRangesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListVlanIpRangesParams) SetForvirtualnetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -717,6 +1448,15 @@ func (p *ListVlanIpRangesParams) SetForvirtualnetwork(v bool) {
 	p.p["forvirtualnetwork"] = v
 }
 
+func (p *ListVlanIpRangesParams) ResetForvirtualnetwork() {
+	delete(p.p, "forvirtualnetwork")
+}
+
+func (p *ListVlanIpRangesParams) GetForvirtualnetwork() (bool, bool) {
+	value, ok := p.p["forvirtualnetwork"].(bool)
+	return value, ok
+}
+
 func (p *ListVlanIpRangesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -724,6 +1464,15 @@ func (p *ListVlanIpRangesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListVlanIpRangesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListVlanIpRangesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListVlanIpRangesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -731,6 +1480,15 @@ func (p *ListVlanIpRangesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListVlanIpRangesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVlanIpRangesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListVlanIpRangesParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -738,6 +1496,15 @@ func (p *ListVlanIpRangesParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *ListVlanIpRangesParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListVlanIpRangesParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *ListVlanIpRangesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -745,6 +1512,15 @@ func (p *ListVlanIpRangesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListVlanIpRangesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVlanIpRangesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListVlanIpRangesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -752,6 +1528,15 @@ func (p *ListVlanIpRangesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListVlanIpRangesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVlanIpRangesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListVlanIpRangesParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -759,6 +1544,15 @@ func (p *ListVlanIpRangesParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *ListVlanIpRangesParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *ListVlanIpRangesParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *ListVlanIpRangesParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -766,6 +1560,15 @@ func (p *ListVlanIpRangesParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *ListVlanIpRangesParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListVlanIpRangesParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *ListVlanIpRangesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -773,6 +1576,15 @@ func (p *ListVlanIpRangesParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListVlanIpRangesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListVlanIpRangesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListVlanIpRangesParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -780,6 +1592,15 @@ func (p *ListVlanIpRangesParams) SetVlan(v string) {
 	p.p["vlan"] = v
 }
 
+func (p *ListVlanIpRangesParams) ResetVlan() {
+	delete(p.p, "vlan")
+}
+
+func (p *ListVlanIpRangesParams) GetVlan() (string, bool) {
+	value, ok := p.p["vlan"].(string)
+	return value, ok
+}
+
 func (p *ListVlanIpRangesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -787,6 +1608,15 @@ func (p *ListVlanIpRangesParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListVlanIpRangesParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListVlanIpRangesParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListVlanIpRangesParams instance,
 // as then you are sure you have configured all required params
 func (s *VLANService) NewListVlanIpRangesParams() *ListVlanIpRangesParams {
@@ -797,6 +1627,11 @@ func (s *VLANService) NewListVlanIpRangesParams() *ListVlanIpRangesParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIpRange, int, error) {
+	return s.GetVlanIpRangeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVlanIpRangeByIDWithContext is the same as GetVlanIpRangeByID, but uses ctx to cancel the request
+func (s *VLANService) GetVlanIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VlanIpRange, int, error) {
 	p := &ListVlanIpRangesParams{}
 	p.p = make(map[string]interface{})
 
@@ -808,18 +1643,16 @@ func (s *VLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIp
 		}
 	}
 
-	l, err := s.ListVlanIpRanges(p)
+	l, err := s.ListVlanIpRangesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -830,7 +1663,16 @@ func (s *VLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIp
 
 // Lists all VLAN IP ranges.
 func (s *VLANService) ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
-	resp, err := s.cs.newRequest("listVlanIpRanges", p.toURLValues())
+	return s.ListVlanIpRangesWithContext(context.Background(), p)
+}
+
+// ListVlanIpRangesWithContext is the same as ListVlanIpRanges, but uses ctx to cancel the request and any async job polling
+func (s *VLANService) ListVlanIpRangesWithContext(ctx context.Context, p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVlanIpRanges", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -843,6 +1685,92 @@ func (s *VLANService) ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRa
 	return &r, nil
 }
 
+// ListVlanIpRangesPager iterates over all pages of a ListVlanIpRanges call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVlanIpRangesPager struct {
+	pager
+
+	s *VLANService
+	p *ListVlanIpRangesParams
+	r *ListVlanIpRangesResponse
+}
+
+// NewListVlanIpRangesPager returns a pager for all pages of a ListVlanIpRanges call with the given params
+func (s *VLANService) NewListVlanIpRangesPager(p *ListVlanIpRangesParams) *ListVlanIpRangesPager {
+	return &ListVlanIpRangesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVlanIpRangesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVlanIpRangesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVlanIpRangesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVlanIpRangesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.VlanIpRanges), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVlanIpRangesPager) Page() *ListVlanIpRangesResponse {
+	return pg.r
+}
+
+// ListVlanIpRangesAll fetches all pages of a ListVlanIpRanges call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VLANService) ListVlanIpRangesAll(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
+	return s.ListVlanIpRangesAllWithContext(context.Background(), p)
+}
+
+// ListVlanIpRangesAllWithContext is the same as ListVlanIpRangesAll, but uses ctx to cancel the requests
+func (s *VLANService) ListVlanIpRangesAllWithContext(ctx context.Context, p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*VlanIpRange)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVlanIpRangesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVlanIpRangesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.VlanIpRanges
+		mu.Unlock()
+
+		return len(l.VlanIpRanges), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVlanIpRangesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.VlanIpRanges = append(r.VlanIpRanges, pages[page]...)
+	}
+	r.Count = len(r.VlanIpRanges)
+
+	return r, nil
+}
+
 type ListVlanIpRangesResponse struct {
 	Count        int            `json:"count"`
 	VlanIpRanges []*VlanIpRange `json:"vlaniprange"`
@@ -880,7 +1808,8 @@ type ReleaseDedicatedGuestVlanRangeParams struct {
 	p map[string]interface{}
 }
 
-func (p *ReleaseDedicatedGuestVlanRangeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ReleaseDedicatedGuestVlanRangeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -891,6 +1820,48 @@ func (p *ReleaseDedicatedGuestVlanRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ReleaseDedicatedGuestVlanRangeParams) Validate() error {
+	v := newParamValidator("releaseDedicatedGuestVlanRange", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ReleaseDedicatedGuestVlanRangeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ReleaseDedicatedGuestVlanRangeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for releaseDedicatedGuestVlanRange", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of releaseDedicatedGuestVlanRange: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ReleaseDedicatedGuestVlanRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -898,6 +1869,15 @@ func (p *ReleaseDedicatedGuestVlanRangeParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ReleaseDedicatedGuestVlanRangeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ReleaseDedicatedGuestVlanRangeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ReleaseDedicatedGuestVlanRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *VLANService) NewReleaseDedicatedGuestVlanRangeParams(id string) *ReleaseDedicatedGuestVlanRangeParams {
@@ -909,7 +1889,16 @@ func (s *VLANService) NewReleaseDedicatedGuestVlanRangeParams(id string) *Releas
 
 // Releases a dedicated guest vlan range to the system
 func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedGuestVlanRange", p.toURLValues())
+	return s.ReleaseDedicatedGuestVlanRangeWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedGuestVlanRangeWithContext is the same as ReleaseDedicatedGuestVlanRange, but uses ctx to cancel the request and any async job polling
+func (s *VLANService) ReleaseDedicatedGuestVlanRangeWithContext(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedGuestVlanRange", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -921,7 +1910,7 @@ func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVla
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -937,6 +1926,31 @@ func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVla
 	return &r, nil
 }
 
+// ReleaseDedicatedGuestVlanRangeAsync starts the async job for ReleaseDedicatedGuestVlanRange and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ReleaseDedicatedGuestVlanRangeResponse using Job.Result
+func (s *VLANService) ReleaseDedicatedGuestVlanRangeAsync(p *ReleaseDedicatedGuestVlanRangeParams) (*Job, error) {
+	return s.ReleaseDedicatedGuestVlanRangeAsyncWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedGuestVlanRangeAsyncWithContext is the same as ReleaseDedicatedGuestVlanRangeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VLANService) ReleaseDedicatedGuestVlanRangeAsyncWithContext(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedGuestVlanRange", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleaseDedicatedGuestVlanRangeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type ReleaseDedicatedGuestVlanRangeResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -20,18 +20,46 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// VMGroupServiceIface is the interface implemented by VMGroupService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type VMGroupServiceIface interface {
+	NewCreateInstanceGroupParams(name string) *CreateInstanceGroupParams
+	CreateInstanceGroup(p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error)
+	CreateInstanceGroupWithContext(ctx context.Context, p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error)
+	NewDeleteInstanceGroupParams(id string) *DeleteInstanceGroupParams
+	DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error)
+	DeleteInstanceGroupWithContext(ctx context.Context, p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error)
+	NewListInstanceGroupsParams() *ListInstanceGroupsParams
+	GetInstanceGroupID(name string, opts ...OptionFunc) (string, int, error)
+	GetInstanceGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetInstanceGroupByName(name string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	GetInstanceGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	GetInstanceGroupByID(id string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	GetInstanceGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	ListInstanceGroups(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error)
+	ListInstanceGroupsWithContext(ctx context.Context, p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error)
+	NewListInstanceGroupsPager(p *ListInstanceGroupsParams) *ListInstanceGroupsPager
+	ListInstanceGroupsAll(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error)
+	ListInstanceGroupsAllWithContext(ctx context.Context, p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error)
+	NewUpdateInstanceGroupParams(id string) *UpdateInstanceGroupParams
+	UpdateInstanceGroup(p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error)
+	UpdateInstanceGroupWithContext(ctx context.Context, p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error)
+}
+
 type CreateInstanceGroupParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateInstanceGroupParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateInstanceGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -51,6 +79,50 @@ func (p *CreateInstanceGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateInstanceGroupParams) Validate() error {
+	v := newParamValidator("createInstanceGroup", p.p)
+	v.required("name")
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateInstanceGroupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateInstanceGroupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "name", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createInstanceGroup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createInstanceGroup: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateInstanceGroupParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -58,6 +130,15 @@ func (p *CreateInstanceGroupParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateInstanceGroupParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateInstanceGroupParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateInstanceGroupParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -65,6 +146,15 @@ func (p *CreateInstanceGroupParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateInstanceGroupParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateInstanceGroupParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateInstanceGroupParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -72,6 +162,15 @@ func (p *CreateInstanceGroupParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateInstanceGroupParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateInstanceGroupParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateInstanceGroupParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +178,15 @@ func (p *CreateInstanceGroupParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *CreateInstanceGroupParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *CreateInstanceGroupParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateInstanceGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *VMGroupService) NewCreateInstanceGroupParams(name string) *CreateInstanceGroupParams {
@@ -90,7 +198,16 @@ func (s *VMGroupService) NewCreateInstanceGroupParams(name string) *CreateInstan
 
 // Creates a vm group
 func (s *VMGroupService) CreateInstanceGroup(p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error) {
-	resp, err := s.cs.newRequest("createInstanceGroup", p.toURLValues())
+	return s.CreateInstanceGroupWithContext(context.Background(), p)
+}
+
+// CreateInstanceGroupWithContext is the same as CreateInstanceGroup, but uses ctx to cancel the request and any async job polling
+func (s *VMGroupService) CreateInstanceGroupWithContext(ctx context.Context, p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createInstanceGroup", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +237,8 @@ type DeleteInstanceGroupParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteInstanceGroupParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteInstanceGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -131,6 +249,48 @@ func (p *DeleteInstanceGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteInstanceGroupParams) Validate() error {
+	v := newParamValidator("deleteInstanceGroup", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteInstanceGroupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteInstanceGroupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteInstanceGroup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteInstanceGroup: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteInstanceGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -138,6 +298,15 @@ func (p *DeleteInstanceGroupParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteInstanceGroupParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteInstanceGroupParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteInstanceGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *VMGroupService) NewDeleteInstanceGroupParams(id string) *DeleteInstanceGroupParams {
@@ -149,7 +318,16 @@ func (s *VMGroupService) NewDeleteInstanceGroupParams(id string) *DeleteInstance
 
 // Deletes a vm group
 func (s *VMGroupService) DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteInstanceGroup", p.toURLValues())
+	return s.DeleteInstanceGroupWithContext(context.Background(), p)
+}
+
+// DeleteInstanceGroupWithContext is the same as DeleteInstanceGroup, but uses ctx to cancel the request and any async job polling
+func (s *VMGroupService) DeleteInstanceGroupWithContext(ctx context.Context, p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteInstanceGroup", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -200,7 +378,8 @@ type ListInstanceGroupsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListInstanceGroupsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListInstanceGroupsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -242,6 +421,58 @@ func (p *ListInstanceGroupsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListInstanceGroupsParams) Validate() error {
+	v := newParamValidator("listInstanceGroups", p.p)
+	v.ids("domainid", "id", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListInstanceGroupsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListInstanceGroupsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "name", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listInstanceGroups", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listInstanceGroups: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListInstanceGroupsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -249,6 +480,15 @@ func (p *ListInstanceGroupsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListInstanceGroupsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListInstanceGroupsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListInstanceGroupsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -256,6 +496,15 @@ func (p *ListInstanceGroupsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListInstanceGroupsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListInstanceGroupsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListInstanceGroupsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -263,6 +512,15 @@ func (p *ListInstanceGroupsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListInstanceGroupsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListInstanceGroupsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListInstanceGroupsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -270,6 +528,15 @@ func (p *ListInstanceGroupsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListInstanceGroupsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListInstanceGroupsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListInstanceGroupsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -277,6 +544,15 @@ func (p *ListInstanceGroupsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListInstanceGroupsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListInstanceGroupsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListInstanceGroupsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -284,6 +560,15 @@ func (p *ListInstanceGroupsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListInstanceGroupsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListInstanceGroupsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListInstanceGroupsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -291,6 +576,15 @@ func (p *ListInstanceGroupsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListInstanceGroupsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListInstanceGroupsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListInstanceGroupsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -298,6 +592,15 @@ func (p *ListInstanceGroupsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListInstanceGroupsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListInstanceGroupsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListInstanceGroupsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -305,6 +608,15 @@ func (p *ListInstanceGroupsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListInstanceGroupsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListInstanceGroupsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListInstanceGroupsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -312,6 +624,15 @@ func (p *ListInstanceGroupsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListInstanceGroupsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListInstanceGroupsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListInstanceGroupsParams instance,
 // as then you are sure you have configured all required params
 func (s *VMGroupService) NewListInstanceGroupsParams() *ListInstanceGroupsParams {
@@ -322,6 +643,11 @@ func (s *VMGroupService) NewListInstanceGroupsParams() *ListInstanceGroupsParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VMGroupService) GetInstanceGroupID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetInstanceGroupIDWithContext(context.Background(), name, opts...)
+}
+
+// GetInstanceGroupIDWithContext is the same as GetInstanceGroupID, but uses ctx to cancel the request
+func (s *VMGroupService) GetInstanceGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListInstanceGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -333,7 +659,7 @@ func (s *VMGroupService) GetInstanceGroupID(name string, opts ...OptionFunc) (st
 		}
 	}
 
-	l, err := s.ListInstanceGroups(p)
+	l, err := s.ListInstanceGroupsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -358,12 +684,17 @@ func (s *VMGroupService) GetInstanceGroupID(name string, opts ...OptionFunc) (st
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VMGroupService) GetInstanceGroupByName(name string, opts ...OptionFunc) (*InstanceGroup, int, error) {
-	id, count, err := s.GetInstanceGroupID(name, opts...)
+	return s.GetInstanceGroupByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetInstanceGroupByNameWithContext is the same as GetInstanceGroupByName, but uses ctx to cancel the requests
+func (s *VMGroupService) GetInstanceGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*InstanceGroup, int, error) {
+	id, count, err := s.GetInstanceGroupIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetInstanceGroupByID(id, opts...)
+	r, count, err := s.GetInstanceGroupByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -372,6 +703,11 @@ func (s *VMGroupService) GetInstanceGroupByName(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VMGroupService) GetInstanceGroupByID(id string, opts ...OptionFunc) (*InstanceGroup, int, error) {
+	return s.GetInstanceGroupByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetInstanceGroupByIDWithContext is the same as GetInstanceGroupByID, but uses ctx to cancel the request
+func (s *VMGroupService) GetInstanceGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InstanceGroup, int, error) {
 	p := &ListInstanceGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -383,18 +719,16 @@ func (s *VMGroupService) GetInstanceGroupByID(id string, opts ...OptionFunc) (*I
 		}
 	}
 
-	l, err := s.ListInstanceGroups(p)
+	l, err := s.ListInstanceGroupsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -405,7 +739,16 @@ func (s *VMGroupService) GetInstanceGroupByID(id string, opts ...OptionFunc) (*I
 
 // Lists vm groups
 func (s *VMGroupService) ListInstanceGroups(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listInstanceGroups", p.toURLValues())
+	return s.ListInstanceGroupsWithContext(context.Background(), p)
+}
+
+// ListInstanceGroupsWithContext is the same as ListInstanceGroups, but uses ctx to cancel the request and any async job polling
+func (s *VMGroupService) ListInstanceGroupsWithContext(ctx context.Context, p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listInstanceGroups", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -418,6 +761,92 @@ func (s *VMGroupService) ListInstanceGroups(p *ListInstanceGroupsParams) (*ListI
 	return &r, nil
 }
 
+// ListInstanceGroupsPager iterates over all pages of a ListInstanceGroups call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListInstanceGroupsPager struct {
+	pager
+
+	s *VMGroupService
+	p *ListInstanceGroupsParams
+	r *ListInstanceGroupsResponse
+}
+
+// NewListInstanceGroupsPager returns a pager for all pages of a ListInstanceGroups call with the given params
+func (s *VMGroupService) NewListInstanceGroupsPager(p *ListInstanceGroupsParams) *ListInstanceGroupsPager {
+	return &ListInstanceGroupsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListInstanceGroupsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListInstanceGroupsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListInstanceGroupsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListInstanceGroupsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.InstanceGroups), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListInstanceGroupsPager) Page() *ListInstanceGroupsResponse {
+	return pg.r
+}
+
+// ListInstanceGroupsAll fetches all pages of a ListInstanceGroups call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VMGroupService) ListInstanceGroupsAll(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
+	return s.ListInstanceGroupsAllWithContext(context.Background(), p)
+}
+
+// ListInstanceGroupsAllWithContext is the same as ListInstanceGroupsAll, but uses ctx to cancel the requests
+func (s *VMGroupService) ListInstanceGroupsAllWithContext(ctx context.Context, p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*InstanceGroup)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListInstanceGroupsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListInstanceGroupsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.InstanceGroups
+		mu.Unlock()
+
+		return len(l.InstanceGroups), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListInstanceGroupsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.InstanceGroups = append(r.InstanceGroups, pages[page]...)
+	}
+	r.Count = len(r.InstanceGroups)
+
+	return r, nil
+}
+
 type ListInstanceGroupsResponse struct {
 	Count          int              `json:"count"`
 	InstanceGroups []*InstanceGroup `json:"instancegroup"`
@@ -440,7 +869,8 @@ type UpdateInstanceGroupParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateInstanceGroupParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateInstanceGroupParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -454,6 +884,49 @@ func (p *UpdateInstanceGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateInstanceGroupParams) Validate() error {
+	v := newParamValidator("updateInstanceGroup", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateInstanceGroupParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateInstanceGroupParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateInstanceGroup", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateInstanceGroup: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateInstanceGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -461,6 +934,15 @@ func (p *UpdateInstanceGroupParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateInstanceGroupParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateInstanceGroupParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateInstanceGroupParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -468,6 +950,15 @@ func (p *UpdateInstanceGroupParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateInstanceGroupParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateInstanceGroupParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateInstanceGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *VMGroupService) NewUpdateInstanceGroupParams(id string) *UpdateInstanceGroupParams {
@@ -479,7 +970,16 @@ func (s *VMGroupService) NewUpdateInstanceGroupParams(id string) *UpdateInstance
 
 // Updates a vm group
 func (s *VMGroupService) UpdateInstanceGroup(p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error) {
-	resp, err := s.cs.newRequest("updateInstanceGroup", p.toURLValues())
+	return s.UpdateInstanceGroupWithContext(context.Background(), p)
+}
+
+// UpdateInstanceGroupWithContext is the same as UpdateInstanceGroup, but uses ctx to cancel the request and any async job polling
+func (s *VMGroupService) UpdateInstanceGroupWithContext(ctx context.Context, p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateInstanceGroup", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,1522 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// VMWareServiceIface is the interface implemented by VMWareService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type VMWareServiceIface interface {
+	NewAddVmwareDcParams(name string, vcenter string, zoneid string) *AddVmwareDcParams
+	AddVmwareDc(p *AddVmwareDcParams) (*AddVmwareDcResponse, error)
+	AddVmwareDcWithContext(ctx context.Context, p *AddVmwareDcParams) (*AddVmwareDcResponse, error)
+	NewImportVsphereStoragePoliciesParams() *ImportVsphereStoragePoliciesParams
+	ImportVsphereStoragePolicies(p *ImportVsphereStoragePoliciesParams) (*ImportVsphereStoragePoliciesResponse, error)
+	ImportVsphereStoragePoliciesWithContext(ctx context.Context, p *ImportVsphereStoragePoliciesParams) (*ImportVsphereStoragePoliciesResponse, error)
+	NewListVmwareDcsParams(zoneid string) *ListVmwareDcsParams
+	GetVmwareDcID(keyword string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetVmwareDcIDWithContext(ctx context.Context, keyword string, zoneid string, opts ...OptionFunc) (string, int, error)
+	ListVmwareDcs(p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error)
+	ListVmwareDcsWithContext(ctx context.Context, p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error)
+	NewListVmwareDcsPager(p *ListVmwareDcsParams) *ListVmwareDcsPager
+	ListVmwareDcsAll(p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error)
+	ListVmwareDcsAllWithContext(ctx context.Context, p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error)
+	NewListVsphereStoragePoliciesParams() *ListVsphereStoragePoliciesParams
+	ListVsphereStoragePolicies(p *ListVsphereStoragePoliciesParams) (*ListVsphereStoragePoliciesResponse, error)
+	ListVsphereStoragePoliciesWithContext(ctx context.Context, p *ListVsphereStoragePoliciesParams) (*ListVsphereStoragePoliciesResponse, error)
+	NewListVsphereStoragePolicyCompatiblePoolsParams() *ListVsphereStoragePolicyCompatiblePoolsParams
+	GetVsphereStoragePolicyCompatiblePoolID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetVsphereStoragePolicyCompatiblePoolIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
+	ListVsphereStoragePolicyCompatiblePools(p *ListVsphereStoragePolicyCompatiblePoolsParams) (*ListVsphereStoragePolicyCompatiblePoolsResponse, error)
+	ListVsphereStoragePolicyCompatiblePoolsWithContext(ctx context.Context, p *ListVsphereStoragePolicyCompatiblePoolsParams) (*ListVsphereStoragePolicyCompatiblePoolsResponse, error)
+	NewListVsphereStoragePolicyCompatiblePoolsPager(p *ListVsphereStoragePolicyCompatiblePoolsParams) *ListVsphereStoragePolicyCompatiblePoolsPager
+	ListVsphereStoragePolicyCompatiblePoolsAll(p *ListVsphereStoragePolicyCompatiblePoolsParams) (*ListVsphereStoragePolicyCompatiblePoolsResponse, error)
+	ListVsphereStoragePolicyCompatiblePoolsAllWithContext(ctx context.Context, p *ListVsphereStoragePolicyCompatiblePoolsParams) (*ListVsphereStoragePolicyCompatiblePoolsResponse, error)
+	NewRemoveVmwareDcParams(zoneid string) *RemoveVmwareDcParams
+	RemoveVmwareDc(p *RemoveVmwareDcParams) (*RemoveVmwareDcResponse, error)
+	RemoveVmwareDcWithContext(ctx context.Context, p *RemoveVmwareDcParams) (*RemoveVmwareDcResponse, error)
+	NewUpdateVmwareDcParams(zoneid string) *UpdateVmwareDcParams
+	UpdateVmwareDc(p *UpdateVmwareDcParams) (*UpdateVmwareDcResponse, error)
+	UpdateVmwareDcWithContext(ctx context.Context, p *UpdateVmwareDcParams) (*UpdateVmwareDcResponse, error)
+}
+
+type AddVmwareDcParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddVmwareDcParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["password"]; found {
+		u.Set("password", v.(string))
+	}
+	if v, found := p.p["username"]; found {
+		u.Set("username", v.(string))
+	}
+	if v, found := p.p["vcenter"]; found {
+		u.Set("vcenter", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddVmwareDcParams) Validate() error {
+	v := newParamValidator("addVmwareDc", p.p)
+	v.required("name", "vcenter", "zoneid")
+	v.ids("zoneid")
+	v.maxLength("name", 255)
+	v.maxLength("password", 255)
+	v.maxLength("username", 255)
+	v.maxLength("vcenter", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddVmwareDcParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddVmwareDcParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "name", "password", "username", "vcenter", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addVmwareDc", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addVmwareDc: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *AddVmwareDcParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *AddVmwareDcParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *AddVmwareDcParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *AddVmwareDcParams) SetPassword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["password"] = v
+}
+
+func (p *AddVmwareDcParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddVmwareDcParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
+func (p *AddVmwareDcParams) SetUsername(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["username"] = v
+}
+
+func (p *AddVmwareDcParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddVmwareDcParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
+func (p *AddVmwareDcParams) SetVcenter(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["vcenter"] = v
+}
+
+func (p *AddVmwareDcParams) ResetVcenter() {
+	delete(p.p, "vcenter")
+}
+
+func (p *AddVmwareDcParams) GetVcenter() (string, bool) {
+	value, ok := p.p["vcenter"].(string)
+	return value, ok
+}
+
+func (p *AddVmwareDcParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *AddVmwareDcParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *AddVmwareDcParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new AddVmwareDcParams instance,
+// as then you are sure you have configured all required params
+func (s *VMWareService) NewAddVmwareDcParams(name string, vcenter string, zoneid string) *AddVmwareDcParams {
+	p := &AddVmwareDcParams{}
+	p.p = make(map[string]interface{})
+	p.p["name"] = name
+	p.p["vcenter"] = vcenter
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// Adds a VMware datacenter to specified zone
+func (s *VMWareService) AddVmwareDc(p *AddVmwareDcParams) (*AddVmwareDcResponse, error) {
+	return s.AddVmwareDcWithContext(context.Background(), p)
+}
+
+// AddVmwareDcWithContext is the same as AddVmwareDc, but uses ctx to cancel the request and any async job polling
+func (s *VMWareService) AddVmwareDcWithContext(ctx context.Context, p *AddVmwareDcParams) (*AddVmwareDcResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addVmwareDc", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddVmwareDcResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type AddVmwareDcResponse struct {
+	Id        string `json:"id"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Name      string `json:"name"`
+	Vcenter   string `json:"vcenter"`
+	Zoneid    int64  `json:"zoneid"`
+}
+
+type ImportVsphereStoragePoliciesParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ImportVsphereStoragePoliciesParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ImportVsphereStoragePoliciesParams) Validate() error {
+	v := newParamValidator("importVsphereStoragePolicies", p.p)
+	v.ids("zoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ImportVsphereStoragePoliciesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ImportVsphereStoragePoliciesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for importVsphereStoragePolicies", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of importVsphereStoragePolicies: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ImportVsphereStoragePoliciesParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ImportVsphereStoragePoliciesParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ImportVsphereStoragePoliciesParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ImportVsphereStoragePoliciesParams instance,
+// as then you are sure you have configured all required params
+func (s *VMWareService) NewImportVsphereStoragePoliciesParams() *ImportVsphereStoragePoliciesParams {
+	p := &ImportVsphereStoragePoliciesParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// Import vSphere storage policies
+func (s *VMWareService) ImportVsphereStoragePolicies(p *ImportVsphereStoragePoliciesParams) (*ImportVsphereStoragePoliciesResponse, error) {
+	return s.ImportVsphereStoragePoliciesWithContext(context.Background(), p)
+}
+
+// ImportVsphereStoragePoliciesWithContext is the same as ImportVsphereStoragePolicies, but uses ctx to cancel the request and any async job polling
+func (s *VMWareService) ImportVsphereStoragePoliciesWithContext(ctx context.Context, p *ImportVsphereStoragePoliciesParams) (*ImportVsphereStoragePoliciesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "importVsphereStoragePolicies", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ImportVsphereStoragePoliciesResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ImportVsphereStoragePoliciesResponse struct {
+	Description string `json:"description"`
+	Id          string `json:"id"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Name        string `json:"name"`
+	Policyid    string `json:"policyid"`
+	Zoneid      string `json:"zoneid"`
+}
+
+type ListVmwareDcsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVmwareDcsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVmwareDcsParams) Validate() error {
+	v := newParamValidator("listVmwareDcs", p.p)
+	v.required("zoneid")
+	v.ids("zoneid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVmwareDcsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVmwareDcsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVmwareDcs", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVmwareDcs: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListVmwareDcsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListVmwareDcsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVmwareDcsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListVmwareDcsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListVmwareDcsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVmwareDcsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListVmwareDcsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListVmwareDcsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVmwareDcsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListVmwareDcsParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ListVmwareDcsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListVmwareDcsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListVmwareDcsParams instance,
+// as then you are sure you have configured all required params
+func (s *VMWareService) NewListVmwareDcsParams(zoneid string) *ListVmwareDcsParams {
+	p := &ListVmwareDcsParams{}
+	p.p = make(map[string]interface{})
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VMWareService) GetVmwareDcID(keyword string, zoneid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVmwareDcIDWithContext(context.Background(), keyword, zoneid, opts...)
+}
+
+// GetVmwareDcIDWithContext is the same as GetVmwareDcID, but uses ctx to cancel the request
+func (s *VMWareService) GetVmwareDcIDWithContext(ctx context.Context, keyword string, zoneid string, opts ...OptionFunc) (string, int, error) {
+	p := &ListVmwareDcsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["keyword"] = keyword
+	p.p["zoneid"] = zoneid
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListVmwareDcsWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.VmwareDcs[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.VmwareDcs {
+			if v.Name == keyword {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// Retrieves VMware DC(s) associated with a zone.
+func (s *VMWareService) ListVmwareDcs(p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error) {
+	return s.ListVmwareDcsWithContext(context.Background(), p)
+}
+
+// ListVmwareDcsWithContext is the same as ListVmwareDcs, but uses ctx to cancel the request and any async job polling
+func (s *VMWareService) ListVmwareDcsWithContext(ctx context.Context, p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVmwareDcs", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListVmwareDcsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListVmwareDcsPager iterates over all pages of a ListVmwareDcs call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVmwareDcsPager struct {
+	pager
+
+	s *VMWareService
+	p *ListVmwareDcsParams
+	r *ListVmwareDcsResponse
+}
+
+// NewListVmwareDcsPager returns a pager for all pages of a ListVmwareDcs call with the given params
+func (s *VMWareService) NewListVmwareDcsPager(p *ListVmwareDcsParams) *ListVmwareDcsPager {
+	return &ListVmwareDcsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVmwareDcsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVmwareDcsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVmwareDcsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVmwareDcsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.VmwareDcs), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVmwareDcsPager) Page() *ListVmwareDcsResponse {
+	return pg.r
+}
+
+// ListVmwareDcsAll fetches all pages of a ListVmwareDcs call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VMWareService) ListVmwareDcsAll(p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error) {
+	return s.ListVmwareDcsAllWithContext(context.Background(), p)
+}
+
+// ListVmwareDcsAllWithContext is the same as ListVmwareDcsAll, but uses ctx to cancel the requests
+func (s *VMWareService) ListVmwareDcsAllWithContext(ctx context.Context, p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*VmwareDc)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVmwareDcsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVmwareDcsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.VmwareDcs
+		mu.Unlock()
+
+		return len(l.VmwareDcs), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVmwareDcsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.VmwareDcs = append(r.VmwareDcs, pages[page]...)
+	}
+	r.Count = len(r.VmwareDcs)
+
+	return r, nil
+}
+
+type ListVmwareDcsResponse struct {
+	Count     int         `json:"count"`
+	VmwareDcs []*VmwareDc `json:"vmwaredc"`
+}
+
+type VmwareDc struct {
+	Id        string `json:"id"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Name      string `json:"name"`
+	Vcenter   string `json:"vcenter"`
+	Zoneid    int64  `json:"zoneid"`
+}
+
+type ListVsphereStoragePoliciesParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVsphereStoragePoliciesParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVsphereStoragePoliciesParams) Validate() error {
+	v := newParamValidator("listVsphereStoragePolicies", p.p)
+	v.ids("zoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVsphereStoragePoliciesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVsphereStoragePoliciesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVsphereStoragePolicies", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVsphereStoragePolicies: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListVsphereStoragePoliciesParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ListVsphereStoragePoliciesParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListVsphereStoragePoliciesParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListVsphereStoragePoliciesParams instance,
+// as then you are sure you have configured all required params
+func (s *VMWareService) NewListVsphereStoragePoliciesParams() *ListVsphereStoragePoliciesParams {
+	p := &ListVsphereStoragePoliciesParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// List vSphere storage policies
+func (s *VMWareService) ListVsphereStoragePolicies(p *ListVsphereStoragePoliciesParams) (*ListVsphereStoragePoliciesResponse, error) {
+	return s.ListVsphereStoragePoliciesWithContext(context.Background(), p)
+}
+
+// ListVsphereStoragePoliciesWithContext is the same as ListVsphereStoragePolicies, but uses ctx to cancel the request and any async job polling
+func (s *VMWareService) ListVsphereStoragePoliciesWithContext(ctx context.Context, p *ListVsphereStoragePoliciesParams) (*ListVsphereStoragePoliciesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVsphereStoragePolicies", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListVsphereStoragePoliciesResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type ListVsphereStoragePoliciesResponse struct {
+	Count                  int                     `json:"count"`
+	VsphereStoragePolicies []*VsphereStoragePolicy `json:"vspherestoragepolicy"`
+}
+
+type VsphereStoragePolicy struct {
+	Description string `json:"description"`
+	Id          string `json:"id"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Name        string `json:"name"`
+	Policyid    string `json:"policyid"`
+	Zoneid      string `json:"zoneid"`
+}
+
+type ListVsphereStoragePolicyCompatiblePoolsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["policyid"]; found {
+		u.Set("policyid", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) Validate() error {
+	v := newParamValidator("listVsphereStoragePolicyCompatiblePools", p.p)
+	v.ids("policyid", "zoneid")
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "keyword", "policyid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVsphereStoragePolicyCompatiblePools", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVsphereStoragePolicyCompatiblePools: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) SetPolicyid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["policyid"] = v
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) ResetPolicyid() {
+	delete(p.p, "policyid")
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) GetPolicyid() (string, bool) {
+	value, ok := p.p["policyid"].(string)
+	return value, ok
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListVsphereStoragePolicyCompatiblePoolsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListVsphereStoragePolicyCompatiblePoolsParams instance,
+// as then you are sure you have configured all required params
+func (s *VMWareService) NewListVsphereStoragePolicyCompatiblePoolsParams() *ListVsphereStoragePolicyCompatiblePoolsParams {
+	p := &ListVsphereStoragePolicyCompatiblePoolsParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VMWareService) GetVsphereStoragePolicyCompatiblePoolID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVsphereStoragePolicyCompatiblePoolIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetVsphereStoragePolicyCompatiblePoolIDWithContext is the same as GetVsphereStoragePolicyCompatiblePoolID, but uses ctx to cancel the request
+func (s *VMWareService) GetVsphereStoragePolicyCompatiblePoolIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	p := &ListVsphereStoragePolicyCompatiblePoolsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["keyword"] = keyword
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListVsphereStoragePolicyCompatiblePoolsWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.VsphereStoragePolicyCompatiblePools[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.VsphereStoragePolicyCompatiblePools {
+			if v.Name == keyword {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// List storage pools compatible with a vSphere storage policy
+func (s *VMWareService) ListVsphereStoragePolicyCompatiblePools(p *ListVsphereStoragePolicyCompatiblePoolsParams) (*ListVsphereStoragePolicyCompatiblePoolsResponse, error) {
+	return s.ListVsphereStoragePolicyCompatiblePoolsWithContext(context.Background(), p)
+}
+
+// ListVsphereStoragePolicyCompatiblePoolsWithContext is the same as ListVsphereStoragePolicyCompatiblePools, but uses ctx to cancel the request and any async job polling
+func (s *VMWareService) ListVsphereStoragePolicyCompatiblePoolsWithContext(ctx context.Context, p *ListVsphereStoragePolicyCompatiblePoolsParams) (*ListVsphereStoragePolicyCompatiblePoolsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVsphereStoragePolicyCompatiblePools", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListVsphereStoragePolicyCompatiblePoolsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListVsphereStoragePolicyCompatiblePoolsPager iterates over all pages of a ListVsphereStoragePolicyCompatiblePools call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVsphereStoragePolicyCompatiblePoolsPager struct {
+	pager
+
+	s *VMWareService
+	p *ListVsphereStoragePolicyCompatiblePoolsParams
+	r *ListVsphereStoragePolicyCompatiblePoolsResponse
+}
+
+// NewListVsphereStoragePolicyCompatiblePoolsPager returns a pager for all pages of a ListVsphereStoragePolicyCompatiblePools call with the given params
+func (s *VMWareService) NewListVsphereStoragePolicyCompatiblePoolsPager(p *ListVsphereStoragePolicyCompatiblePoolsParams) *ListVsphereStoragePolicyCompatiblePoolsPager {
+	return &ListVsphereStoragePolicyCompatiblePoolsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVsphereStoragePolicyCompatiblePoolsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVsphereStoragePolicyCompatiblePoolsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVsphereStoragePolicyCompatiblePoolsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVsphereStoragePolicyCompatiblePoolsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.VsphereStoragePolicyCompatiblePools), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVsphereStoragePolicyCompatiblePoolsPager) Page() *ListVsphereStoragePolicyCompatiblePoolsResponse {
+	return pg.r
+}
+
+// ListVsphereStoragePolicyCompatiblePoolsAll fetches all pages of a ListVsphereStoragePolicyCompatiblePools call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VMWareService) ListVsphereStoragePolicyCompatiblePoolsAll(p *ListVsphereStoragePolicyCompatiblePoolsParams) (*ListVsphereStoragePolicyCompatiblePoolsResponse, error) {
+	return s.ListVsphereStoragePolicyCompatiblePoolsAllWithContext(context.Background(), p)
+}
+
+// ListVsphereStoragePolicyCompatiblePoolsAllWithContext is the same as ListVsphereStoragePolicyCompatiblePoolsAll, but uses ctx to cancel the requests
+func (s *VMWareService) ListVsphereStoragePolicyCompatiblePoolsAllWithContext(ctx context.Context, p *ListVsphereStoragePolicyCompatiblePoolsParams) (*ListVsphereStoragePolicyCompatiblePoolsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*VsphereStoragePolicyCompatiblePool)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVsphereStoragePolicyCompatiblePoolsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVsphereStoragePolicyCompatiblePoolsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.VsphereStoragePolicyCompatiblePools
+		mu.Unlock()
+
+		return len(l.VsphereStoragePolicyCompatiblePools), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVsphereStoragePolicyCompatiblePoolsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.VsphereStoragePolicyCompatiblePools = append(r.VsphereStoragePolicyCompatiblePools, pages[page]...)
+	}
+	r.Count = len(r.VsphereStoragePolicyCompatiblePools)
+
+	return r, nil
+}
+
+type ListVsphereStoragePolicyCompatiblePoolsResponse struct {
+	Count                               int                                   `json:"count"`
+	VsphereStoragePolicyCompatiblePools []*VsphereStoragePolicyCompatiblePool `json:"vspherestoragepolicycompatiblepool"`
+}
+
+type VsphereStoragePolicyCompatiblePool struct {
+	Allocatediops        int64             `json:"allocatediops"`
+	Capacityiops         int64             `json:"capacityiops"`
+	Clusterid            string            `json:"clusterid"`
+	Clustername          string            `json:"clustername"`
+	Created              string            `json:"created"`
+	Disksizeallocated    int64             `json:"disksizeallocated"`
+	Disksizetotal        int64             `json:"disksizetotal"`
+	Disksizeused         int64             `json:"disksizeused"`
+	Hypervisor           string            `json:"hypervisor"`
+	Id                   string            `json:"id"`
+	Ipaddress            string            `json:"ipaddress"`
+	JobID                string            `json:"jobid"`
+	Jobstatus            int               `json:"jobstatus"`
+	Name                 string            `json:"name"`
+	Overprovisionfactor  string            `json:"overprovisionfactor"`
+	Path                 string            `json:"path"`
+	Podid                string            `json:"podid"`
+	Podname              string            `json:"podname"`
+	Provider             string            `json:"provider"`
+	Scope                string            `json:"scope"`
+	State                string            `json:"state"`
+	Storagecapabilities  map[string]string `json:"storagecapabilities"`
+	Suitableformigration bool              `json:"suitableformigration"`
+	Tags                 string            `json:"tags"`
+	Type                 string            `json:"type"`
+	Zoneid               string            `json:"zoneid"`
+	Zonename             string            `json:"zonename"`
+}
+
+type RemoveVmwareDcParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RemoveVmwareDcParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RemoveVmwareDcParams) Validate() error {
+	v := newParamValidator("removeVmwareDc", p.p)
+	v.required("zoneid")
+	v.ids("zoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RemoveVmwareDcParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RemoveVmwareDcParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for removeVmwareDc", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of removeVmwareDc: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *RemoveVmwareDcParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *RemoveVmwareDcParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *RemoveVmwareDcParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new RemoveVmwareDcParams instance,
+// as then you are sure you have configured all required params
+func (s *VMWareService) NewRemoveVmwareDcParams(zoneid string) *RemoveVmwareDcParams {
+	p := &RemoveVmwareDcParams{}
+	p.p = make(map[string]interface{})
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// Remove a VMware datacenter from a zone.
+func (s *VMWareService) RemoveVmwareDc(p *RemoveVmwareDcParams) (*RemoveVmwareDcResponse, error) {
+	return s.RemoveVmwareDcWithContext(context.Background(), p)
+}
+
+// RemoveVmwareDcWithContext is the same as RemoveVmwareDc, but uses ctx to cancel the request and any async job polling
+func (s *VMWareService) RemoveVmwareDcWithContext(ctx context.Context, p *RemoveVmwareDcParams) (*RemoveVmwareDcResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeVmwareDc", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveVmwareDcResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type RemoveVmwareDcResponse struct {
+	Displaytext string `json:"displaytext"`
+	JobID       string `json:"jobid"`
+	Jobstatus   int    `json:"jobstatus"`
+	Success     bool   `json:"success"`
+}
+
+func (r *RemoveVmwareDcResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias RemoveVmwareDcResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type UpdateVmwareDcParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateVmwareDcParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["isrecursive"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isrecursive", vv)
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["password"]; found {
+		u.Set("password", v.(string))
+	}
+	if v, found := p.p["username"]; found {
+		u.Set("username", v.(string))
+	}
+	if v, found := p.p["vcenter"]; found {
+		u.Set("vcenter", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateVmwareDcParams) Validate() error {
+	v := newParamValidator("updateVmwareDc", p.p)
+	v.required("zoneid")
+	v.ids("zoneid")
+	v.maxLength("name", 255)
+	v.maxLength("password", 255)
+	v.maxLength("username", 255)
+	v.maxLength("vcenter", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateVmwareDcParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateVmwareDcParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "isrecursive":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "name", "password", "username", "vcenter", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateVmwareDc", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateVmwareDc: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *UpdateVmwareDcParams) SetIsrecursive(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isrecursive"] = v
+}
+
+func (p *UpdateVmwareDcParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *UpdateVmwareDcParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
+func (p *UpdateVmwareDcParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *UpdateVmwareDcParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateVmwareDcParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *UpdateVmwareDcParams) SetPassword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["password"] = v
+}
+
+func (p *UpdateVmwareDcParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *UpdateVmwareDcParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
+func (p *UpdateVmwareDcParams) SetUsername(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["username"] = v
+}
+
+func (p *UpdateVmwareDcParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *UpdateVmwareDcParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
+func (p *UpdateVmwareDcParams) SetVcenter(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["vcenter"] = v
+}
+
+func (p *UpdateVmwareDcParams) ResetVcenter() {
+	delete(p.p, "vcenter")
+}
+
+func (p *UpdateVmwareDcParams) GetVcenter() (string, bool) {
+	value, ok := p.p["vcenter"].(string)
+	return value, ok
+}
+
+func (p *UpdateVmwareDcParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *UpdateVmwareDcParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *UpdateVmwareDcParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new UpdateVmwareDcParams instance,
+// as then you are sure you have configured all required params
+func (s *VMWareService) NewUpdateVmwareDcParams(zoneid string) *UpdateVmwareDcParams {
+	p := &UpdateVmwareDcParams{}
+	p.p = make(map[string]interface{})
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// Updates a VMware datacenter details for a zone
+func (s *VMWareService) UpdateVmwareDc(p *UpdateVmwareDcParams) (*UpdateVmwareDcResponse, error) {
+	return s.UpdateVmwareDcWithContext(context.Background(), p)
+}
+
+// UpdateVmwareDcWithContext is the same as UpdateVmwareDc, but uses ctx to cancel the request and any async job polling
+func (s *VMWareService) UpdateVmwareDcWithContext(ctx context.Context, p *UpdateVmwareDcParams) (*UpdateVmwareDcResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVmwareDc", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVmwareDcResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type UpdateVmwareDcResponse struct {
+	Id        string `json:"id"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Name      string `json:"name"`
+	Vcenter   string `json:"vcenter"`
+	Zoneid    int64  `json:"zoneid"`
+}
@@ -20,18 +20,126 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// VPCServiceIface is the interface implemented by VPCService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type VPCServiceIface interface {
+	NewCreatePrivateGatewayParams(gateway string, ipaddress string, netmask string, vlan string, vpcid string) *CreatePrivateGatewayParams
+	CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error)
+	CreatePrivateGatewayWithContext(ctx context.Context, p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error)
+	CreatePrivateGatewayAsync(p *CreatePrivateGatewayParams) (*Job, error)
+	CreatePrivateGatewayAsyncWithContext(ctx context.Context, p *CreatePrivateGatewayParams) (*Job, error)
+	NewCreateStaticRouteParams(cidr string, gatewayid string) *CreateStaticRouteParams
+	CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error)
+	CreateStaticRouteWithContext(ctx context.Context, p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error)
+	CreateStaticRouteAsync(p *CreateStaticRouteParams) (*Job, error)
+	CreateStaticRouteAsyncWithContext(ctx context.Context, p *CreateStaticRouteParams) (*Job, error)
+	NewCreateVPCParams(cidr string, displaytext string, name string, vpcofferingid string, zoneid string) *CreateVPCParams
+	CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error)
+	CreateVPCWithContext(ctx context.Context, p *CreateVPCParams) (*CreateVPCResponse, error)
+	CreateVPCAsync(p *CreateVPCParams) (*Job, error)
+	CreateVPCAsyncWithContext(ctx context.Context, p *CreateVPCParams) (*Job, error)
+	NewCreateVPCOfferingParams(displaytext string, name string, supportedservices []string) *CreateVPCOfferingParams
+	CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error)
+	CreateVPCOfferingWithContext(ctx context.Context, p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error)
+	CreateVPCOfferingAsync(p *CreateVPCOfferingParams) (*Job, error)
+	CreateVPCOfferingAsyncWithContext(ctx context.Context, p *CreateVPCOfferingParams) (*Job, error)
+	NewDeletePrivateGatewayParams(id string) *DeletePrivateGatewayParams
+	DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error)
+	DeletePrivateGatewayWithContext(ctx context.Context, p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error)
+	DeletePrivateGatewayAsync(p *DeletePrivateGatewayParams) (*Job, error)
+	DeletePrivateGatewayAsyncWithContext(ctx context.Context, p *DeletePrivateGatewayParams) (*Job, error)
+	NewDeleteStaticRouteParams(id string) *DeleteStaticRouteParams
+	DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error)
+	DeleteStaticRouteWithContext(ctx context.Context, p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error)
+	DeleteStaticRouteAsync(p *DeleteStaticRouteParams) (*Job, error)
+	DeleteStaticRouteAsyncWithContext(ctx context.Context, p *DeleteStaticRouteParams) (*Job, error)
+	NewDeleteVPCParams(id string) *DeleteVPCParams
+	DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error)
+	DeleteVPCWithContext(ctx context.Context, p *DeleteVPCParams) (*DeleteVPCResponse, error)
+	DeleteVPCAsync(p *DeleteVPCParams) (*Job, error)
+	DeleteVPCAsyncWithContext(ctx context.Context, p *DeleteVPCParams) (*Job, error)
+	NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingParams
+	DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error)
+	DeleteVPCOfferingWithContext(ctx context.Context, p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error)
+	DeleteVPCOfferingAsync(p *DeleteVPCOfferingParams) (*Job, error)
+	DeleteVPCOfferingAsyncWithContext(ctx context.Context, p *DeleteVPCOfferingParams) (*Job, error)
+	NewListPrivateGatewaysParams() *ListPrivateGatewaysParams
+	GetPrivateGatewayByID(id string, opts ...OptionFunc) (*PrivateGateway, int, error)
+	GetPrivateGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PrivateGateway, int, error)
+	ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error)
+	ListPrivateGatewaysWithContext(ctx context.Context, p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error)
+	NewListPrivateGatewaysPager(p *ListPrivateGatewaysParams) *ListPrivateGatewaysPager
+	ListPrivateGatewaysAll(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error)
+	ListPrivateGatewaysAllWithContext(ctx context.Context, p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error)
+	NewListStaticRoutesParams() *ListStaticRoutesParams
+	GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticRoute, int, error)
+	GetStaticRouteByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StaticRoute, int, error)
+	ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error)
+	ListStaticRoutesWithContext(ctx context.Context, p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error)
+	NewListStaticRoutesPager(p *ListStaticRoutesParams) *ListStaticRoutesPager
+	ListStaticRoutesAll(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error)
+	ListStaticRoutesAllWithContext(ctx context.Context, p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error)
+	NewListVPCOfferingsParams() *ListVPCOfferingsParams
+	GetVPCOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetVPCOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetVPCOfferingByName(name string, opts ...OptionFunc) (*VPCOffering, int, error)
+	GetVPCOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VPCOffering, int, error)
+	GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffering, int, error)
+	GetVPCOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VPCOffering, int, error)
+	ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error)
+	ListVPCOfferingsWithContext(ctx context.Context, p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error)
+	NewListVPCOfferingsPager(p *ListVPCOfferingsParams) *ListVPCOfferingsPager
+	ListVPCOfferingsAll(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error)
+	ListVPCOfferingsAllWithContext(ctx context.Context, p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error)
+	NewListVPCsParams() *ListVPCsParams
+	GetVPCID(name string, opts ...OptionFunc) (string, int, error)
+	GetVPCIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetVPCByName(name string, opts ...OptionFunc) (*VPC, int, error)
+	GetVPCByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VPC, int, error)
+	GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error)
+	GetVPCByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VPC, int, error)
+	ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error)
+	ListVPCsWithContext(ctx context.Context, p *ListVPCsParams) (*ListVPCsResponse, error)
+	NewListVPCsPager(p *ListVPCsParams) *ListVPCsPager
+	ListVPCsAll(p *ListVPCsParams) (*ListVPCsResponse, error)
+	ListVPCsAllWithContext(ctx context.Context, p *ListVPCsParams) (*ListVPCsResponse, error)
+	NewMigrateVPCParams(vpcid string, vpcofferingid string) *MigrateVPCParams
+	MigrateVPC(p *MigrateVPCParams) (*MigrateVPCResponse, error)
+	MigrateVPCWithContext(ctx context.Context, p *MigrateVPCParams) (*MigrateVPCResponse, error)
+	MigrateVPCAsync(p *MigrateVPCParams) (*Job, error)
+	MigrateVPCAsyncWithContext(ctx context.Context, p *MigrateVPCParams) (*Job, error)
+	NewRestartVPCParams(id string) *RestartVPCParams
+	RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error)
+	RestartVPCWithContext(ctx context.Context, p *RestartVPCParams) (*RestartVPCResponse, error)
+	RestartVPCAsync(p *RestartVPCParams) (*Job, error)
+	RestartVPCAsyncWithContext(ctx context.Context, p *RestartVPCParams) (*Job, error)
+	NewUpdateVPCParams(id string) *UpdateVPCParams
+	UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error)
+	UpdateVPCWithContext(ctx context.Context, p *UpdateVPCParams) (*UpdateVPCResponse, error)
+	UpdateVPCAsync(p *UpdateVPCParams) (*Job, error)
+	UpdateVPCAsyncWithContext(ctx context.Context, p *UpdateVPCParams) (*Job, error)
+	NewUpdateVPCOfferingParams(id string) *UpdateVPCOfferingParams
+	UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error)
+	UpdateVPCOfferingWithContext(ctx context.Context, p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error)
+	UpdateVPCOfferingAsync(p *UpdateVPCOfferingParams) (*Job, error)
+	UpdateVPCOfferingAsyncWithContext(ctx context.Context, p *UpdateVPCOfferingParams) (*Job, error)
+}
+
 type CreatePrivateGatewayParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreatePrivateGatewayParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreatePrivateGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -71,6 +179,56 @@ func (p *CreatePrivateGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreatePrivateGatewayParams) Validate() error {
+	v := newParamValidator("createPrivateGateway", p.p)
+	v.required("gateway", "ipaddress", "netmask", "vlan", "vpcid")
+	v.ids("aclid", "networkofferingid", "physicalnetworkid", "vpcid")
+	v.maxLength("gateway", 255)
+	v.maxLength("ipaddress", 255)
+	v.maxLength("netmask", 255)
+	v.maxLength("vlan", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreatePrivateGatewayParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreatePrivateGatewayParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "aclid", "gateway", "ipaddress", "netmask", "networkofferingid", "physicalnetworkid", "vlan", "vpcid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "bypassvlanoverlapcheck", "sourcenatsupported":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createPrivateGateway", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createPrivateGateway: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreatePrivateGatewayParams) SetAclid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -78,6 +236,15 @@ func (p *CreatePrivateGatewayParams) SetAclid(v string) {
 	p.p["aclid"] = v
 }
 
+func (p *CreatePrivateGatewayParams) ResetAclid() {
+	delete(p.p, "aclid")
+}
+
+func (p *CreatePrivateGatewayParams) GetAclid() (string, bool) {
+	value, ok := p.p["aclid"].(string)
+	return value, ok
+}
+
 func (p *CreatePrivateGatewayParams) SetBypassvlanoverlapcheck(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -85,6 +252,15 @@ func (p *CreatePrivateGatewayParams) SetBypassvlanoverlapcheck(v bool) {
 	p.p["bypassvlanoverlapcheck"] = v
 }
 
+func (p *CreatePrivateGatewayParams) ResetBypassvlanoverlapcheck() {
+	delete(p.p, "bypassvlanoverlapcheck")
+}
+
+func (p *CreatePrivateGatewayParams) GetBypassvlanoverlapcheck() (bool, bool) {
+	value, ok := p.p["bypassvlanoverlapcheck"].(bool)
+	return value, ok
+}
+
 func (p *CreatePrivateGatewayParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -92,6 +268,15 @@ func (p *CreatePrivateGatewayParams) SetGateway(v string) {
 	p.p["gateway"] = v
 }
 
+func (p *CreatePrivateGatewayParams) ResetGateway() {
+	delete(p.p, "gateway")
+}
+
+func (p *CreatePrivateGatewayParams) GetGateway() (string, bool) {
+	value, ok := p.p["gateway"].(string)
+	return value, ok
+}
+
 func (p *CreatePrivateGatewayParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -99,6 +284,15 @@ func (p *CreatePrivateGatewayParams) SetIpaddress(v string) {
 	p.p["ipaddress"] = v
 }
 
+func (p *CreatePrivateGatewayParams) ResetIpaddress() {
+	delete(p.p, "ipaddress")
+}
+
+func (p *CreatePrivateGatewayParams) GetIpaddress() (string, bool) {
+	value, ok := p.p["ipaddress"].(string)
+	return value, ok
+}
+
 func (p *CreatePrivateGatewayParams) SetNetmask(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -106,6 +300,15 @@ func (p *CreatePrivateGatewayParams) SetNetmask(v string) {
 	p.p["netmask"] = v
 }
 
+func (p *CreatePrivateGatewayParams) ResetNetmask() {
+	delete(p.p, "netmask")
+}
+
+func (p *CreatePrivateGatewayParams) GetNetmask() (string, bool) {
+	value, ok := p.p["netmask"].(string)
+	return value, ok
+}
+
 func (p *CreatePrivateGatewayParams) SetNetworkofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -113,6 +316,15 @@ func (p *CreatePrivateGatewayParams) SetNetworkofferingid(v string) {
 	p.p["networkofferingid"] = v
 }
 
+func (p *CreatePrivateGatewayParams) ResetNetworkofferingid() {
+	delete(p.p, "networkofferingid")
+}
+
+func (p *CreatePrivateGatewayParams) GetNetworkofferingid() (string, bool) {
+	value, ok := p.p["networkofferingid"].(string)
+	return value, ok
+}
+
 func (p *CreatePrivateGatewayParams) SetPhysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -120,6 +332,15 @@ func (p *CreatePrivateGatewayParams) SetPhysicalnetworkid(v string) {
 	p.p["physicalnetworkid"] = v
 }
 
+func (p *CreatePrivateGatewayParams) ResetPhysicalnetworkid() {
+	delete(p.p, "physicalnetworkid")
+}
+
+func (p *CreatePrivateGatewayParams) GetPhysicalnetworkid() (string, bool) {
+	value, ok := p.p["physicalnetworkid"].(string)
+	return value, ok
+}
+
 func (p *CreatePrivateGatewayParams) SetSourcenatsupported(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -127,6 +348,15 @@ func (p *CreatePrivateGatewayParams) SetSourcenatsupported(v bool) {
 	p.p["sourcenatsupported"] = v
 }
 
+func (p *CreatePrivateGatewayParams) ResetSourcenatsupported() {
+	delete(p.p, "sourcenatsupported")
+}
+
+func (p *CreatePrivateGatewayParams) GetSourcenatsupported() (bool, bool) {
+	value, ok := p.p["sourcenatsupported"].(bool)
+	return value, ok
+}
+
 func (p *CreatePrivateGatewayParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -134,6 +364,15 @@ func (p *CreatePrivateGatewayParams) SetVlan(v string) {
 	p.p["vlan"] = v
 }
 
+func (p *CreatePrivateGatewayParams) ResetVlan() {
+	delete(p.p, "vlan")
+}
+
+func (p *CreatePrivateGatewayParams) GetVlan() (string, bool) {
+	value, ok := p.p["vlan"].(string)
+	return value, ok
+}
+
 func (p *CreatePrivateGatewayParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -141,6 +380,15 @@ func (p *CreatePrivateGatewayParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *CreatePrivateGatewayParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *CreatePrivateGatewayParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreatePrivateGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewCreatePrivateGatewayParams(gateway string, ipaddress string, netmask string, vlan string, vpcid string) *CreatePrivateGatewayParams {
@@ -156,7 +404,16 @@ func (s *VPCService) NewCreatePrivateGatewayParams(gateway string, ipaddress str
 
 // Creates a private gateway
 func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createPrivateGateway", p.toURLValues())
+	return s.CreatePrivateGatewayWithContext(context.Background(), p)
+}
+
+// CreatePrivateGatewayWithContext is the same as CreatePrivateGateway, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) CreatePrivateGatewayWithContext(ctx context.Context, p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createPrivateGateway", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -168,7 +425,7 @@ func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*Creat
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -189,6 +446,40 @@ func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*Creat
 	return &r, nil
 }
 
+// CreatePrivateGatewayAsync starts the async job for CreatePrivateGateway and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreatePrivateGatewayResponse using Job.Result
+func (s *VPCService) CreatePrivateGatewayAsync(p *CreatePrivateGatewayParams) (*Job, error) {
+	return s.CreatePrivateGatewayAsyncWithContext(context.Background(), p)
+}
+
+// CreatePrivateGatewayAsyncWithContext is the same as CreatePrivateGatewayAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPCService) CreatePrivateGatewayAsyncWithContext(ctx context.Context, p *CreatePrivateGatewayParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createPrivateGateway", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreatePrivateGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreatePrivateGatewayResponse struct {
 	Account            string `json:"account"`
 	Aclid              string `json:"aclid"`
@@ -217,7 +508,8 @@ type CreateStaticRouteParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateStaticRouteParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateStaticRouteParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -231,6 +523,49 @@ func (p *CreateStaticRouteParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateStaticRouteParams) Validate() error {
+	v := newParamValidator("createStaticRoute", p.p)
+	v.required("cidr", "gatewayid")
+	v.ids("gatewayid")
+	v.maxLength("cidr", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateStaticRouteParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateStaticRouteParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "cidr", "gatewayid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createStaticRoute", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createStaticRoute: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateStaticRouteParams) SetCidr(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -238,6 +573,15 @@ func (p *CreateStaticRouteParams) SetCidr(v string) {
 	p.p["cidr"] = v
 }
 
+func (p *CreateStaticRouteParams) ResetCidr() {
+	delete(p.p, "cidr")
+}
+
+func (p *CreateStaticRouteParams) GetCidr() (string, bool) {
+	value, ok := p.p["cidr"].(string)
+	return value, ok
+}
+
 func (p *CreateStaticRouteParams) SetGatewayid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -245,6 +589,15 @@ func (p *CreateStaticRouteParams) SetGatewayid(v string) {
 	p.p["gatewayid"] = v
 }
 
+func (p *CreateStaticRouteParams) ResetGatewayid() {
+	delete(p.p, "gatewayid")
+}
+
+func (p *CreateStaticRouteParams) GetGatewayid() (string, bool) {
+	value, ok := p.p["gatewayid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateStaticRouteParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewCreateStaticRouteParams(cidr string, gatewayid string) *CreateStaticRouteParams {
@@ -257,7 +610,16 @@ func (s *VPCService) NewCreateStaticRouteParams(cidr string, gatewayid string) *
 
 // Creates a static route
 func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
-	resp, err := s.cs.newRequest("createStaticRoute", p.toURLValues())
+	return s.CreateStaticRouteWithContext(context.Background(), p)
+}
+
+// CreateStaticRouteWithContext is the same as CreateStaticRoute, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) CreateStaticRouteWithContext(ctx context.Context, p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createStaticRoute", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -269,7 +631,7 @@ func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStati
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -290,6 +652,40 @@ func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStati
 	return &r, nil
 }
 
+// CreateStaticRouteAsync starts the async job for CreateStaticRoute and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateStaticRouteResponse using Job.Result
+func (s *VPCService) CreateStaticRouteAsync(p *CreateStaticRouteParams) (*Job, error) {
+	return s.CreateStaticRouteAsyncWithContext(context.Background(), p)
+}
+
+// CreateStaticRouteAsyncWithContext is the same as CreateStaticRouteAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPCService) CreateStaticRouteAsyncWithContext(ctx context.Context, p *CreateStaticRouteParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createStaticRoute", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateStaticRouteResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateStaticRouteResponse struct {
 	Account   string `json:"account"`
 	Cidr      string `json:"cidr"`
@@ -310,7 +706,8 @@ type CreateVPCParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateVPCParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateVPCParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -353,6 +750,57 @@ func (p *CreateVPCParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateVPCParams) Validate() error {
+	v := newParamValidator("createVPC", p.p)
+	v.required("cidr", "displaytext", "name", "vpcofferingid", "zoneid")
+	v.ids("domainid", "projectid", "vpcofferingid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("cidr", 255)
+	v.maxLength("displaytext", 255)
+	v.maxLength("name", 255)
+	v.maxLength("networkdomain", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateVPCParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateVPCParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "cidr", "displaytext", "domainid", "name", "networkdomain", "projectid", "vpcofferingid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "start":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createVPC", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createVPC: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVPCParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -360,6 +808,15 @@ func (p *CreateVPCParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateVPCParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateVPCParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateVPCParams) SetCidr(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -367,6 +824,15 @@ func (p *CreateVPCParams) SetCidr(v string) {
 	p.p["cidr"] = v
 }
 
+func (p *CreateVPCParams) ResetCidr() {
+	delete(p.p, "cidr")
+}
+
+func (p *CreateVPCParams) GetCidr() (string, bool) {
+	value, ok := p.p["cidr"].(string)
+	return value, ok
+}
+
 func (p *CreateVPCParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -374,6 +840,15 @@ func (p *CreateVPCParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *CreateVPCParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *CreateVPCParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *CreateVPCParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -381,6 +856,15 @@ func (p *CreateVPCParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateVPCParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateVPCParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateVPCParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -388,6 +872,15 @@ func (p *CreateVPCParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *CreateVPCParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateVPCParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *CreateVPCParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -395,6 +888,15 @@ func (p *CreateVPCParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateVPCParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateVPCParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateVPCParams) SetNetworkdomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -402,6 +904,15 @@ func (p *CreateVPCParams) SetNetworkdomain(v string) {
 	p.p["networkdomain"] = v
 }
 
+func (p *CreateVPCParams) ResetNetworkdomain() {
+	delete(p.p, "networkdomain")
+}
+
+func (p *CreateVPCParams) GetNetworkdomain() (string, bool) {
+	value, ok := p.p["networkdomain"].(string)
+	return value, ok
+}
+
 func (p *CreateVPCParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -409,6 +920,15 @@ func (p *CreateVPCParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *CreateVPCParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *CreateVPCParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *CreateVPCParams) SetStart(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -416,6 +936,15 @@ func (p *CreateVPCParams) SetStart(v bool) {
 	p.p["start"] = v
 }
 
+func (p *CreateVPCParams) ResetStart() {
+	delete(p.p, "start")
+}
+
+func (p *CreateVPCParams) GetStart() (bool, bool) {
+	value, ok := p.p["start"].(bool)
+	return value, ok
+}
+
 func (p *CreateVPCParams) SetVpcofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -423,6 +952,15 @@ func (p *CreateVPCParams) SetVpcofferingid(v string) {
 	p.p["vpcofferingid"] = v
 }
 
+func (p *CreateVPCParams) ResetVpcofferingid() {
+	delete(p.p, "vpcofferingid")
+}
+
+func (p *CreateVPCParams) GetVpcofferingid() (string, bool) {
+	value, ok := p.p["vpcofferingid"].(string)
+	return value, ok
+}
+
 func (p *CreateVPCParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -430,6 +968,15 @@ func (p *CreateVPCParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *CreateVPCParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreateVPCParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateVPCParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewCreateVPCParams(cidr string, displaytext string, name string, vpcofferingid string, zoneid string) *CreateVPCParams {
@@ -445,7 +992,16 @@ func (s *VPCService) NewCreateVPCParams(cidr string, displaytext string, name st
 
 // Creates a VPC
 func (s *VPCService) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
-	resp, err := s.cs.newRequest("createVPC", p.toURLValues())
+	return s.CreateVPCWithContext(context.Background(), p)
+}
+
+// CreateVPCWithContext is the same as CreateVPC, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) CreateVPCWithContext(ctx context.Context, p *CreateVPCParams) (*CreateVPCResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVPC", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -457,7 +1013,7 @@ func (s *VPCService) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -478,6 +1034,40 @@ func (s *VPCService) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
 	return &r, nil
 }
 
+// CreateVPCAsync starts the async job for CreateVPC and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateVPCResponse using Job.Result
+func (s *VPCService) CreateVPCAsync(p *CreateVPCParams) (*Job, error) {
+	return s.CreateVPCAsyncWithContext(context.Background(), p)
+}
+
+// CreateVPCAsyncWithContext is the same as CreateVPCAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPCService) CreateVPCAsyncWithContext(ctx context.Context, p *CreateVPCParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVPC", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVPCResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateVPCResponse struct {
 	Account              string                     `json:"account"`
 	Cidr                 string                     `json:"cidr"`
@@ -611,7 +1201,8 @@ type CreateVPCOfferingParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateVPCOfferingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateVPCOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -627,20 +1218,29 @@ func (p *CreateVPCOfferingParams) toURLValues() url.Values {
 		u.Set("name", v.(string))
 	}
 	if v, found := p.p["servicecapabilitylist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("servicecapabilitylist[%d].key", i), k)
-			u.Set(fmt.Sprintf("servicecapabilitylist[%d].value", i), m[k])
+		for i, item := range v.([]ServiceCapability) {
+			if item.Service != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].service", i), item.Service)
+			}
+			if item.CapabilityType != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].capabilitytype", i), item.CapabilityType)
+			}
+			if item.CapabilityValue != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].capabilityvalue", i), item.CapabilityValue)
+			}
 		}
 	}
 	if v, found := p.p["serviceofferingid"]; found {
 		u.Set("serviceofferingid", v.(string))
 	}
 	if v, found := p.p["serviceproviderlist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("serviceproviderlist[%d].service", i), k)
-			u.Set(fmt.Sprintf("serviceproviderlist[%d].provider", i), m[k])
+		for i, item := range v.([]ServiceProvider) {
+			if item.Service != "" {
+				u.Set(fmt.Sprintf("serviceproviderlist[%d].service", i), item.Service)
+			}
+			if item.Provider != "" {
+				u.Set(fmt.Sprintf("serviceproviderlist[%d].provider", i), item.Provider)
+			}
 		}
 	}
 	if v, found := p.p["supportedservices"]; found {
@@ -654,6 +1254,62 @@ func (p *CreateVPCOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateVPCOfferingParams) Validate() error {
+	v := newParamValidator("createVPCOffering", p.p)
+	v.required("displaytext", "name", "supportedservices")
+	v.ids("serviceofferingid")
+	v.maxLength("displaytext", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateVPCOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateVPCOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "displaytext", "name", "serviceofferingid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "domainid", "supportedservices", "zoneid":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "servicecapabilitylist":
+			var v []ServiceCapability
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "serviceproviderlist":
+			var v []ServiceProvider
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createVPCOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createVPCOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVPCOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -661,6 +1317,15 @@ func (p *CreateVPCOfferingParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *CreateVPCOfferingParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *CreateVPCOfferingParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *CreateVPCOfferingParams) SetDomainid(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -668,6 +1333,15 @@ func (p *CreateVPCOfferingParams) SetDomainid(v []string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateVPCOfferingParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateVPCOfferingParams) GetDomainid() ([]string, bool) {
+	value, ok := p.p["domainid"].([]string)
+	return value, ok
+}
+
 func (p *CreateVPCOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -675,13 +1349,31 @@ func (p *CreateVPCOfferingParams) SetName(v string) {
 	p.p["name"] = v
 }
 
-func (p *CreateVPCOfferingParams) SetServicecapabilitylist(v map[string]string) {
+func (p *CreateVPCOfferingParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateVPCOfferingParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *CreateVPCOfferingParams) SetServicecapabilitylist(v []ServiceCapability) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["servicecapabilitylist"] = v
 }
 
+func (p *CreateVPCOfferingParams) ResetServicecapabilitylist() {
+	delete(p.p, "servicecapabilitylist")
+}
+
+func (p *CreateVPCOfferingParams) GetServicecapabilitylist() ([]ServiceCapability, bool) {
+	value, ok := p.p["servicecapabilitylist"].([]ServiceCapability)
+	return value, ok
+}
+
 func (p *CreateVPCOfferingParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -689,13 +1381,31 @@ func (p *CreateVPCOfferingParams) SetServiceofferingid(v string) {
 	p.p["serviceofferingid"] = v
 }
 
-func (p *CreateVPCOfferingParams) SetServiceproviderlist(v map[string]string) {
+func (p *CreateVPCOfferingParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *CreateVPCOfferingParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
+func (p *CreateVPCOfferingParams) SetServiceproviderlist(v []ServiceProvider) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["serviceproviderlist"] = v
 }
 
+func (p *CreateVPCOfferingParams) ResetServiceproviderlist() {
+	delete(p.p, "serviceproviderlist")
+}
+
+func (p *CreateVPCOfferingParams) GetServiceproviderlist() ([]ServiceProvider, bool) {
+	value, ok := p.p["serviceproviderlist"].([]ServiceProvider)
+	return value, ok
+}
+
 func (p *CreateVPCOfferingParams) SetSupportedservices(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -703,6 +1413,15 @@ func (p *CreateVPCOfferingParams) SetSupportedservices(v []string) {
 	p.p["supportedservices"] = v
 }
 
+func (p *CreateVPCOfferingParams) ResetSupportedservices() {
+	delete(p.p, "supportedservices")
+}
+
+func (p *CreateVPCOfferingParams) GetSupportedservices() ([]string, bool) {
+	value, ok := p.p["supportedservices"].([]string)
+	return value, ok
+}
+
 func (p *CreateVPCOfferingParams) SetZoneid(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -710,9 +1429,18 @@ func (p *CreateVPCOfferingParams) SetZoneid(v []string) {
 	p.p["zoneid"] = v
 }
 
-// You should always use this function to get a new CreateVPCOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewCreateVPCOfferingParams(displaytext string, name string, supportedservices []string) *CreateVPCOfferingParams {
+func (p *CreateVPCOfferingParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreateVPCOfferingParams) GetZoneid() ([]string, bool) {
+	value, ok := p.p["zoneid"].([]string)
+	return value, ok
+}
+
+// You should always use this function to get a new CreateVPCOfferingParams instance,
+// as then you are sure you have configured all required params
+func (s *VPCService) NewCreateVPCOfferingParams(displaytext string, name string, supportedservices []string) *CreateVPCOfferingParams {
 	p := &CreateVPCOfferingParams{}
 	p.p = make(map[string]interface{})
 	p.p["displaytext"] = displaytext
@@ -723,7 +1451,16 @@ func (s *VPCService) NewCreateVPCOfferingParams(displaytext string, name string,
 
 // Creates VPC offering
 func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createVPCOffering", p.toURLValues())
+	return s.CreateVPCOfferingWithContext(context.Background(), p)
+}
+
+// CreateVPCOfferingWithContext is the same as CreateVPCOffering, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) CreateVPCOfferingWithContext(ctx context.Context, p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVPCOffering", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -735,7 +1472,7 @@ func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOf
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -756,6 +1493,40 @@ func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOf
 	return &r, nil
 }
 
+// CreateVPCOfferingAsync starts the async job for CreateVPCOffering and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateVPCOfferingResponse using Job.Result
+func (s *VPCService) CreateVPCOfferingAsync(p *CreateVPCOfferingParams) (*Job, error) {
+	return s.CreateVPCOfferingAsyncWithContext(context.Background(), p)
+}
+
+// CreateVPCOfferingAsyncWithContext is the same as CreateVPCOfferingAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPCService) CreateVPCOfferingAsyncWithContext(ctx context.Context, p *CreateVPCOfferingParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVPCOffering", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVPCOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateVPCOfferingResponse struct {
 	Created                string                             `json:"created"`
 	Displaytext            string                             `json:"displaytext"`
@@ -800,7 +1571,8 @@ type DeletePrivateGatewayParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeletePrivateGatewayParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeletePrivateGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -811,6 +1583,48 @@ func (p *DeletePrivateGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeletePrivateGatewayParams) Validate() error {
+	v := newParamValidator("deletePrivateGateway", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeletePrivateGatewayParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeletePrivateGatewayParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deletePrivateGateway", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deletePrivateGateway: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeletePrivateGatewayParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -818,6 +1632,15 @@ func (p *DeletePrivateGatewayParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeletePrivateGatewayParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeletePrivateGatewayParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeletePrivateGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewDeletePrivateGatewayParams(id string) *DeletePrivateGatewayParams {
@@ -829,7 +1652,16 @@ func (s *VPCService) NewDeletePrivateGatewayParams(id string) *DeletePrivateGate
 
 // Deletes a Private gateway
 func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deletePrivateGateway", p.toURLValues())
+	return s.DeletePrivateGatewayWithContext(context.Background(), p)
+}
+
+// DeletePrivateGatewayWithContext is the same as DeletePrivateGateway, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) DeletePrivateGatewayWithContext(ctx context.Context, p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePrivateGateway", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -841,7 +1673,7 @@ func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*Delet
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -857,6 +1689,31 @@ func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*Delet
 	return &r, nil
 }
 
+// DeletePrivateGatewayAsync starts the async job for DeletePrivateGateway and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeletePrivateGatewayResponse using Job.Result
+func (s *VPCService) DeletePrivateGatewayAsync(p *DeletePrivateGatewayParams) (*Job, error) {
+	return s.DeletePrivateGatewayAsyncWithContext(context.Background(), p)
+}
+
+// DeletePrivateGatewayAsyncWithContext is the same as DeletePrivateGatewayAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPCService) DeletePrivateGatewayAsyncWithContext(ctx context.Context, p *DeletePrivateGatewayParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deletePrivateGateway", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeletePrivateGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeletePrivateGatewayResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -868,7 +1725,8 @@ type DeleteStaticRouteParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteStaticRouteParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteStaticRouteParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -879,6 +1737,48 @@ func (p *DeleteStaticRouteParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteStaticRouteParams) Validate() error {
+	v := newParamValidator("deleteStaticRoute", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteStaticRouteParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteStaticRouteParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteStaticRoute", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteStaticRoute: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteStaticRouteParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -886,6 +1786,15 @@ func (p *DeleteStaticRouteParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteStaticRouteParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteStaticRouteParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteStaticRouteParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewDeleteStaticRouteParams(id string) *DeleteStaticRouteParams {
@@ -897,7 +1806,16 @@ func (s *VPCService) NewDeleteStaticRouteParams(id string) *DeleteStaticRoutePar
 
 // Deletes a static route
 func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
-	resp, err := s.cs.newRequest("deleteStaticRoute", p.toURLValues())
+	return s.DeleteStaticRouteWithContext(context.Background(), p)
+}
+
+// DeleteStaticRouteWithContext is the same as DeleteStaticRoute, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) DeleteStaticRouteWithContext(ctx context.Context, p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteStaticRoute", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -909,7 +1827,7 @@ func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStati
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -925,6 +1843,31 @@ func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStati
 	return &r, nil
 }
 
+// DeleteStaticRouteAsync starts the async job for DeleteStaticRoute and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteStaticRouteResponse using Job.Result
+func (s *VPCService) DeleteStaticRouteAsync(p *DeleteStaticRouteParams) (*Job, error) {
+	return s.DeleteStaticRouteAsyncWithContext(context.Background(), p)
+}
+
+// DeleteStaticRouteAsyncWithContext is the same as DeleteStaticRouteAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPCService) DeleteStaticRouteAsyncWithContext(ctx context.Context, p *DeleteStaticRouteParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteStaticRoute", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteStaticRouteResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteStaticRouteResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -936,7 +1879,8 @@ type DeleteVPCParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteVPCParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteVPCParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -947,6 +1891,48 @@ func (p *DeleteVPCParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteVPCParams) Validate() error {
+	v := newParamValidator("deleteVPC", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteVPCParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteVPCParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteVPC", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteVPC: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVPCParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -954,6 +1940,15 @@ func (p *DeleteVPCParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteVPCParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteVPCParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteVPCParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewDeleteVPCParams(id string) *DeleteVPCParams {
@@ -965,7 +1960,16 @@ func (s *VPCService) NewDeleteVPCParams(id string) *DeleteVPCParams {
 
 // Deletes a VPC
 func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
-	resp, err := s.cs.newRequest("deleteVPC", p.toURLValues())
+	return s.DeleteVPCWithContext(context.Background(), p)
+}
+
+// DeleteVPCWithContext is the same as DeleteVPC, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) DeleteVPCWithContext(ctx context.Context, p *DeleteVPCParams) (*DeleteVPCResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVPC", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -977,7 +1981,7 @@ func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -993,6 +1997,31 @@ func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
 	return &r, nil
 }
 
+// DeleteVPCAsync starts the async job for DeleteVPC and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteVPCResponse using Job.Result
+func (s *VPCService) DeleteVPCAsync(p *DeleteVPCParams) (*Job, error) {
+	return s.DeleteVPCAsyncWithContext(context.Background(), p)
+}
+
+// DeleteVPCAsyncWithContext is the same as DeleteVPCAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPCService) DeleteVPCAsyncWithContext(ctx context.Context, p *DeleteVPCParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVPC", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVPCResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteVPCResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -1004,7 +2033,8 @@ type DeleteVPCOfferingParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteVPCOfferingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteVPCOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1015,6 +2045,48 @@ func (p *DeleteVPCOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteVPCOfferingParams) Validate() error {
+	v := newParamValidator("deleteVPCOffering", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteVPCOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteVPCOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteVPCOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteVPCOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVPCOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1022,6 +2094,15 @@ func (p *DeleteVPCOfferingParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteVPCOfferingParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteVPCOfferingParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteVPCOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingParams {
@@ -1033,7 +2114,16 @@ func (s *VPCService) NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingPar
 
 // Deletes VPC offering
 func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteVPCOffering", p.toURLValues())
+	return s.DeleteVPCOfferingWithContext(context.Background(), p)
+}
+
+// DeleteVPCOfferingWithContext is the same as DeleteVPCOffering, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) DeleteVPCOfferingWithContext(ctx context.Context, p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVPCOffering", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1045,7 +2135,7 @@ func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOf
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1061,6 +2151,31 @@ func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOf
 	return &r, nil
 }
 
+// DeleteVPCOfferingAsync starts the async job for DeleteVPCOffering and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteVPCOfferingResponse using Job.Result
+func (s *VPCService) DeleteVPCOfferingAsync(p *DeleteVPCOfferingParams) (*Job, error) {
+	return s.DeleteVPCOfferingAsyncWithContext(context.Background(), p)
+}
+
+// DeleteVPCOfferingAsyncWithContext is the same as DeleteVPCOfferingAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPCService) DeleteVPCOfferingAsyncWithContext(ctx context.Context, p *DeleteVPCOfferingParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVPCOffering", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVPCOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteVPCOfferingResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -1072,7 +2187,8 @@ type ListPrivateGatewaysParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListPrivateGatewaysParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListPrivateGatewaysParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1123,6 +2239,60 @@ func (p *ListPrivateGatewaysParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListPrivateGatewaysParams) Validate() error {
+	v := newParamValidator("listPrivateGateways", p.p)
+	v.ids("domainid", "id", "projectid", "vpcid")
+	v.maxLength("account", 255)
+	v.maxLength("ipaddress", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("state", 255)
+	v.maxLength("vlan", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListPrivateGatewaysParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListPrivateGatewaysParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "ipaddress", "keyword", "projectid", "state", "vlan", "vpcid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listPrivateGateways", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listPrivateGateways: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListPrivateGatewaysParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1130,6 +2300,15 @@ func (p *ListPrivateGatewaysParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListPrivateGatewaysParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListPrivateGatewaysParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListPrivateGatewaysParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1137,6 +2316,15 @@ func (p *ListPrivateGatewaysParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListPrivateGatewaysParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListPrivateGatewaysParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListPrivateGatewaysParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1144,6 +2332,15 @@ func (p *ListPrivateGatewaysParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListPrivateGatewaysParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListPrivateGatewaysParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListPrivateGatewaysParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1151,6 +2348,15 @@ func (p *ListPrivateGatewaysParams) SetIpaddress(v string) {
 	p.p["ipaddress"] = v
 }
 
+func (p *ListPrivateGatewaysParams) ResetIpaddress() {
+	delete(p.p, "ipaddress")
+}
+
+func (p *ListPrivateGatewaysParams) GetIpaddress() (string, bool) {
+	value, ok := p.p["ipaddress"].(string)
+	return value, ok
+}
+
 func (p *ListPrivateGatewaysParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1158,6 +2364,15 @@ func (p *ListPrivateGatewaysParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListPrivateGatewaysParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListPrivateGatewaysParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListPrivateGatewaysParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1165,6 +2380,15 @@ func (p *ListPrivateGatewaysParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListPrivateGatewaysParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListPrivateGatewaysParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListPrivateGatewaysParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1172,6 +2396,15 @@ func (p *ListPrivateGatewaysParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListPrivateGatewaysParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListPrivateGatewaysParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListPrivateGatewaysParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1179,6 +2412,15 @@ func (p *ListPrivateGatewaysParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListPrivateGatewaysParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListPrivateGatewaysParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListPrivateGatewaysParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1186,6 +2428,15 @@ func (p *ListPrivateGatewaysParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListPrivateGatewaysParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListPrivateGatewaysParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListPrivateGatewaysParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1193,6 +2444,15 @@ func (p *ListPrivateGatewaysParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListPrivateGatewaysParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListPrivateGatewaysParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListPrivateGatewaysParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1200,6 +2460,15 @@ func (p *ListPrivateGatewaysParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListPrivateGatewaysParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListPrivateGatewaysParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListPrivateGatewaysParams) SetVlan(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1207,6 +2476,15 @@ func (p *ListPrivateGatewaysParams) SetVlan(v string) {
 	p.p["vlan"] = v
 }
 
+func (p *ListPrivateGatewaysParams) ResetVlan() {
+	delete(p.p, "vlan")
+}
+
+func (p *ListPrivateGatewaysParams) GetVlan() (string, bool) {
+	value, ok := p.p["vlan"].(string)
+	return value, ok
+}
+
 func (p *ListPrivateGatewaysParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1214,6 +2492,15 @@ func (p *ListPrivateGatewaysParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *ListPrivateGatewaysParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *ListPrivateGatewaysParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListPrivateGatewaysParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewListPrivateGatewaysParams() *ListPrivateGatewaysParams {
@@ -1224,6 +2511,11 @@ func (s *VPCService) NewListPrivateGatewaysParams() *ListPrivateGatewaysParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*PrivateGateway, int, error) {
+	return s.GetPrivateGatewayByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetPrivateGatewayByIDWithContext is the same as GetPrivateGatewayByID, but uses ctx to cancel the request
+func (s *VPCService) GetPrivateGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PrivateGateway, int, error) {
 	p := &ListPrivateGatewaysParams{}
 	p.p = make(map[string]interface{})
 
@@ -1235,18 +2527,16 @@ func (s *VPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*Priv
 		}
 	}
 
-	l, err := s.ListPrivateGateways(p)
+	l, err := s.ListPrivateGatewaysWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1257,8 +2547,17 @@ func (s *VPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*Priv
 
 // List private gateways
 func (s *VPCService) ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listPrivateGateways", p.toURLValues())
-	if err != nil {
+	return s.ListPrivateGatewaysWithContext(context.Background(), p)
+}
+
+// ListPrivateGatewaysWithContext is the same as ListPrivateGateways, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) ListPrivateGatewaysWithContext(ctx context.Context, p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listPrivateGateways", p.ToURLValues())
+	if err != nil {
 		return nil, err
 	}
 
@@ -1270,6 +2569,92 @@ func (s *VPCService) ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPri
 	return &r, nil
 }
 
+// ListPrivateGatewaysPager iterates over all pages of a ListPrivateGateways call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListPrivateGatewaysPager struct {
+	pager
+
+	s *VPCService
+	p *ListPrivateGatewaysParams
+	r *ListPrivateGatewaysResponse
+}
+
+// NewListPrivateGatewaysPager returns a pager for all pages of a ListPrivateGateways call with the given params
+func (s *VPCService) NewListPrivateGatewaysPager(p *ListPrivateGatewaysParams) *ListPrivateGatewaysPager {
+	return &ListPrivateGatewaysPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListPrivateGatewaysPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListPrivateGatewaysPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListPrivateGatewaysParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListPrivateGatewaysWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.PrivateGateways), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListPrivateGatewaysPager) Page() *ListPrivateGatewaysResponse {
+	return pg.r
+}
+
+// ListPrivateGatewaysAll fetches all pages of a ListPrivateGateways call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VPCService) ListPrivateGatewaysAll(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
+	return s.ListPrivateGatewaysAllWithContext(context.Background(), p)
+}
+
+// ListPrivateGatewaysAllWithContext is the same as ListPrivateGatewaysAll, but uses ctx to cancel the requests
+func (s *VPCService) ListPrivateGatewaysAllWithContext(ctx context.Context, p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*PrivateGateway)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListPrivateGatewaysParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListPrivateGatewaysWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.PrivateGateways
+		mu.Unlock()
+
+		return len(l.PrivateGateways), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListPrivateGatewaysResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.PrivateGateways = append(r.PrivateGateways, pages[page]...)
+	}
+	r.Count = len(r.PrivateGateways)
+
+	return r, nil
+}
+
 type ListPrivateGatewaysResponse struct {
 	Count           int               `json:"count"`
 	PrivateGateways []*PrivateGateway `json:"privategateway"`
@@ -1303,7 +2688,8 @@ type ListStaticRoutesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListStaticRoutesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListStaticRoutesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1358,6 +2744,62 @@ func (p *ListStaticRoutesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListStaticRoutesParams) Validate() error {
+	v := newParamValidator("listStaticRoutes", p.p)
+	v.ids("domainid", "gatewayid", "id", "projectid", "vpcid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListStaticRoutesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListStaticRoutesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "gatewayid", "id", "keyword", "projectid", "state", "vpcid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listStaticRoutes", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listStaticRoutes: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListStaticRoutesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1365,6 +2807,15 @@ func (p *ListStaticRoutesParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListStaticRoutesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListStaticRoutesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListStaticRoutesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1372,6 +2823,15 @@ func (p *ListStaticRoutesParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListStaticRoutesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListStaticRoutesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListStaticRoutesParams) SetGatewayid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1379,6 +2839,15 @@ func (p *ListStaticRoutesParams) SetGatewayid(v string) {
 	p.p["gatewayid"] = v
 }
 
+func (p *ListStaticRoutesParams) ResetGatewayid() {
+	delete(p.p, "gatewayid")
+}
+
+func (p *ListStaticRoutesParams) GetGatewayid() (string, bool) {
+	value, ok := p.p["gatewayid"].(string)
+	return value, ok
+}
+
 func (p *ListStaticRoutesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1386,6 +2855,15 @@ func (p *ListStaticRoutesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListStaticRoutesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListStaticRoutesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListStaticRoutesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1393,6 +2871,15 @@ func (p *ListStaticRoutesParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListStaticRoutesParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListStaticRoutesParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListStaticRoutesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1400,6 +2887,15 @@ func (p *ListStaticRoutesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListStaticRoutesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListStaticRoutesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListStaticRoutesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1407,6 +2903,15 @@ func (p *ListStaticRoutesParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListStaticRoutesParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListStaticRoutesParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListStaticRoutesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1414,6 +2919,15 @@ func (p *ListStaticRoutesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListStaticRoutesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListStaticRoutesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListStaticRoutesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1421,6 +2935,15 @@ func (p *ListStaticRoutesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListStaticRoutesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListStaticRoutesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListStaticRoutesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1428,6 +2951,15 @@ func (p *ListStaticRoutesParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListStaticRoutesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListStaticRoutesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListStaticRoutesParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1435,6 +2967,15 @@ func (p *ListStaticRoutesParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListStaticRoutesParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListStaticRoutesParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListStaticRoutesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1442,6 +2983,15 @@ func (p *ListStaticRoutesParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListStaticRoutesParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListStaticRoutesParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 func (p *ListStaticRoutesParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1449,6 +2999,15 @@ func (p *ListStaticRoutesParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *ListStaticRoutesParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *ListStaticRoutesParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListStaticRoutesParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewListStaticRoutesParams() *ListStaticRoutesParams {
@@ -1459,6 +3018,11 @@ func (s *VPCService) NewListStaticRoutesParams() *ListStaticRoutesParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticRoute, int, error) {
+	return s.GetStaticRouteByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetStaticRouteByIDWithContext is the same as GetStaticRouteByID, but uses ctx to cancel the request
+func (s *VPCService) GetStaticRouteByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StaticRoute, int, error) {
 	p := &ListStaticRoutesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1470,18 +3034,16 @@ func (s *VPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticR
 		}
 	}
 
-	l, err := s.ListStaticRoutes(p)
+	l, err := s.ListStaticRoutesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1492,7 +3054,16 @@ func (s *VPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticR
 
 // Lists all static routes
 func (s *VPCService) ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
-	resp, err := s.cs.newRequest("listStaticRoutes", p.toURLValues())
+	return s.ListStaticRoutesWithContext(context.Background(), p)
+}
+
+// ListStaticRoutesWithContext is the same as ListStaticRoutes, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) ListStaticRoutesWithContext(ctx context.Context, p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listStaticRoutes", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1505,6 +3076,92 @@ func (s *VPCService) ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRou
 	return &r, nil
 }
 
+// ListStaticRoutesPager iterates over all pages of a ListStaticRoutes call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListStaticRoutesPager struct {
+	pager
+
+	s *VPCService
+	p *ListStaticRoutesParams
+	r *ListStaticRoutesResponse
+}
+
+// NewListStaticRoutesPager returns a pager for all pages of a ListStaticRoutes call with the given params
+func (s *VPCService) NewListStaticRoutesPager(p *ListStaticRoutesParams) *ListStaticRoutesPager {
+	return &ListStaticRoutesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListStaticRoutesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListStaticRoutesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListStaticRoutesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListStaticRoutesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.StaticRoutes), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListStaticRoutesPager) Page() *ListStaticRoutesResponse {
+	return pg.r
+}
+
+// ListStaticRoutesAll fetches all pages of a ListStaticRoutes call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VPCService) ListStaticRoutesAll(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
+	return s.ListStaticRoutesAllWithContext(context.Background(), p)
+}
+
+// ListStaticRoutesAllWithContext is the same as ListStaticRoutesAll, but uses ctx to cancel the requests
+func (s *VPCService) ListStaticRoutesAllWithContext(ctx context.Context, p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*StaticRoute)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListStaticRoutesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListStaticRoutesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.StaticRoutes
+		mu.Unlock()
+
+		return len(l.StaticRoutes), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListStaticRoutesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.StaticRoutes = append(r.StaticRoutes, pages[page]...)
+	}
+	r.Count = len(r.StaticRoutes)
+
+	return r, nil
+}
+
 type ListStaticRoutesResponse struct {
 	Count        int            `json:"count"`
 	StaticRoutes []*StaticRoute `json:"staticroute"`
@@ -1530,7 +3187,8 @@ type ListVPCOfferingsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListVPCOfferingsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVPCOfferingsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1572,6 +3230,63 @@ func (p *ListVPCOfferingsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVPCOfferingsParams) Validate() error {
+	v := newParamValidator("listVPCOfferings", p.p)
+	v.ids("id", "zoneid")
+	v.maxLength("displaytext", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVPCOfferingsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVPCOfferingsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "displaytext", "id", "keyword", "name", "state", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isdefault":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "supportedservices":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVPCOfferings", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVPCOfferings: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListVPCOfferingsParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1579,6 +3294,15 @@ func (p *ListVPCOfferingsParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *ListVPCOfferingsParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *ListVPCOfferingsParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *ListVPCOfferingsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1586,6 +3310,15 @@ func (p *ListVPCOfferingsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListVPCOfferingsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListVPCOfferingsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListVPCOfferingsParams) SetIsdefault(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1593,6 +3326,15 @@ func (p *ListVPCOfferingsParams) SetIsdefault(v bool) {
 	p.p["isdefault"] = v
 }
 
+func (p *ListVPCOfferingsParams) ResetIsdefault() {
+	delete(p.p, "isdefault")
+}
+
+func (p *ListVPCOfferingsParams) GetIsdefault() (bool, bool) {
+	value, ok := p.p["isdefault"].(bool)
+	return value, ok
+}
+
 func (p *ListVPCOfferingsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1600,6 +3342,15 @@ func (p *ListVPCOfferingsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListVPCOfferingsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVPCOfferingsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListVPCOfferingsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1607,6 +3358,15 @@ func (p *ListVPCOfferingsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListVPCOfferingsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListVPCOfferingsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListVPCOfferingsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1614,6 +3374,15 @@ func (p *ListVPCOfferingsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListVPCOfferingsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVPCOfferingsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListVPCOfferingsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1621,6 +3390,15 @@ func (p *ListVPCOfferingsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListVPCOfferingsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVPCOfferingsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListVPCOfferingsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1628,6 +3406,15 @@ func (p *ListVPCOfferingsParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListVPCOfferingsParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListVPCOfferingsParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListVPCOfferingsParams) SetSupportedservices(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1635,6 +3422,15 @@ func (p *ListVPCOfferingsParams) SetSupportedservices(v []string) {
 	p.p["supportedservices"] = v
 }
 
+func (p *ListVPCOfferingsParams) ResetSupportedservices() {
+	delete(p.p, "supportedservices")
+}
+
+func (p *ListVPCOfferingsParams) GetSupportedservices() ([]string, bool) {
+	value, ok := p.p["supportedservices"].([]string)
+	return value, ok
+}
+
 func (p *ListVPCOfferingsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1642,6 +3438,15 @@ func (p *ListVPCOfferingsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListVPCOfferingsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListVPCOfferingsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListVPCOfferingsParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewListVPCOfferingsParams() *ListVPCOfferingsParams {
@@ -1652,6 +3457,11 @@ func (s *VPCService) NewListVPCOfferingsParams() *ListVPCOfferingsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVPCOfferingIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVPCOfferingIDWithContext is the same as GetVPCOfferingID, but uses ctx to cancel the request
+func (s *VPCService) GetVPCOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVPCOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1663,7 +3473,7 @@ func (s *VPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListVPCOfferings(p)
+	l, err := s.ListVPCOfferingsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1688,12 +3498,17 @@ func (s *VPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCOfferingByName(name string, opts ...OptionFunc) (*VPCOffering, int, error) {
-	id, count, err := s.GetVPCOfferingID(name, opts...)
+	return s.GetVPCOfferingByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVPCOfferingByNameWithContext is the same as GetVPCOfferingByName, but uses ctx to cancel the requests
+func (s *VPCService) GetVPCOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VPCOffering, int, error) {
+	id, count, err := s.GetVPCOfferingIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVPCOfferingByID(id, opts...)
+	r, count, err := s.GetVPCOfferingByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1702,6 +3517,11 @@ func (s *VPCService) GetVPCOfferingByName(name string, opts ...OptionFunc) (*VPC
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffering, int, error) {
+	return s.GetVPCOfferingByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVPCOfferingByIDWithContext is the same as GetVPCOfferingByID, but uses ctx to cancel the request
+func (s *VPCService) GetVPCOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VPCOffering, int, error) {
 	p := &ListVPCOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1713,18 +3533,16 @@ func (s *VPCService) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffe
 		}
 	}
 
-	l, err := s.ListVPCOfferings(p)
+	l, err := s.ListVPCOfferingsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1735,7 +3553,16 @@ func (s *VPCService) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffe
 
 // Lists VPC offerings
 func (s *VPCService) ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listVPCOfferings", p.toURLValues())
+	return s.ListVPCOfferingsWithContext(context.Background(), p)
+}
+
+// ListVPCOfferingsWithContext is the same as ListVPCOfferings, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) ListVPCOfferingsWithContext(ctx context.Context, p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVPCOfferings", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1748,6 +3575,92 @@ func (s *VPCService) ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferi
 	return &r, nil
 }
 
+// ListVPCOfferingsPager iterates over all pages of a ListVPCOfferings call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVPCOfferingsPager struct {
+	pager
+
+	s *VPCService
+	p *ListVPCOfferingsParams
+	r *ListVPCOfferingsResponse
+}
+
+// NewListVPCOfferingsPager returns a pager for all pages of a ListVPCOfferings call with the given params
+func (s *VPCService) NewListVPCOfferingsPager(p *ListVPCOfferingsParams) *ListVPCOfferingsPager {
+	return &ListVPCOfferingsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVPCOfferingsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVPCOfferingsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVPCOfferingsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVPCOfferingsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.VPCOfferings), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVPCOfferingsPager) Page() *ListVPCOfferingsResponse {
+	return pg.r
+}
+
+// ListVPCOfferingsAll fetches all pages of a ListVPCOfferings call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VPCService) ListVPCOfferingsAll(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
+	return s.ListVPCOfferingsAllWithContext(context.Background(), p)
+}
+
+// ListVPCOfferingsAllWithContext is the same as ListVPCOfferingsAll, but uses ctx to cancel the requests
+func (s *VPCService) ListVPCOfferingsAllWithContext(ctx context.Context, p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*VPCOffering)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVPCOfferingsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVPCOfferingsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.VPCOfferings
+		mu.Unlock()
+
+		return len(l.VPCOfferings), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVPCOfferingsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.VPCOfferings = append(r.VPCOfferings, pages[page]...)
+	}
+	r.Count = len(r.VPCOfferings)
+
+	return r, nil
+}
+
 type ListVPCOfferingsResponse struct {
 	Count        int            `json:"count"`
 	VPCOfferings []*VPCOffering `json:"vpcoffering"`
@@ -1797,7 +3710,8 @@ type ListVPCsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListVPCsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVPCsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1873,6 +3787,69 @@ func (p *ListVPCsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVPCsParams) Validate() error {
+	v := newParamValidator("listVPCs", p.p)
+	v.ids("domainid", "id", "projectid", "vpcofferingid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("cidr", 255)
+	v.maxLength("displaytext", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVPCsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVPCsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "cidr", "displaytext", "domainid", "id", "keyword", "name", "projectid", "state", "vpcofferingid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isrecursive", "listall", "restartrequired":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "supportedservices":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVPCs", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVPCs: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListVPCsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1880,6 +3857,15 @@ func (p *ListVPCsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListVPCsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListVPCsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetCidr(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1887,6 +3873,15 @@ func (p *ListVPCsParams) SetCidr(v string) {
 	p.p["cidr"] = v
 }
 
+func (p *ListVPCsParams) ResetCidr() {
+	delete(p.p, "cidr")
+}
+
+func (p *ListVPCsParams) GetCidr() (string, bool) {
+	value, ok := p.p["cidr"].(string)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1894,6 +3889,15 @@ func (p *ListVPCsParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *ListVPCsParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *ListVPCsParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1901,13 +3905,31 @@ func (p *ListVPCsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
-func (p *ListVPCsParams) SetFordisplay(v bool) {
+func (p *ListVPCsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListVPCsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *ListVPCsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["fordisplay"] = v
 }
 
+func (p *ListVPCsParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListVPCsParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1915,6 +3937,15 @@ func (p *ListVPCsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListVPCsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListVPCsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1922,6 +3953,15 @@ func (p *ListVPCsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListVPCsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListVPCsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1929,6 +3969,15 @@ func (p *ListVPCsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListVPCsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVPCsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1936,6 +3985,15 @@ func (p *ListVPCsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListVPCsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListVPCsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1943,6 +4001,15 @@ func (p *ListVPCsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListVPCsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListVPCsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1950,6 +4017,15 @@ func (p *ListVPCsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListVPCsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVPCsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1957,6 +4033,15 @@ func (p *ListVPCsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListVPCsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVPCsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1964,6 +4049,15 @@ func (p *ListVPCsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListVPCsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListVPCsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetRestartrequired(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1971,6 +4065,15 @@ func (p *ListVPCsParams) SetRestartrequired(v bool) {
 	p.p["restartrequired"] = v
 }
 
+func (p *ListVPCsParams) ResetRestartrequired() {
+	delete(p.p, "restartrequired")
+}
+
+func (p *ListVPCsParams) GetRestartrequired() (bool, bool) {
+	value, ok := p.p["restartrequired"].(bool)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1978,6 +4081,15 @@ func (p *ListVPCsParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListVPCsParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListVPCsParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetSupportedservices(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1985,6 +4097,15 @@ func (p *ListVPCsParams) SetSupportedservices(v []string) {
 	p.p["supportedservices"] = v
 }
 
+func (p *ListVPCsParams) ResetSupportedservices() {
+	delete(p.p, "supportedservices")
+}
+
+func (p *ListVPCsParams) GetSupportedservices() ([]string, bool) {
+	value, ok := p.p["supportedservices"].([]string)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1992,6 +4113,15 @@ func (p *ListVPCsParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListVPCsParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListVPCsParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetVpcofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1999,6 +4129,15 @@ func (p *ListVPCsParams) SetVpcofferingid(v string) {
 	p.p["vpcofferingid"] = v
 }
 
+func (p *ListVPCsParams) ResetVpcofferingid() {
+	delete(p.p, "vpcofferingid")
+}
+
+func (p *ListVPCsParams) GetVpcofferingid() (string, bool) {
+	value, ok := p.p["vpcofferingid"].(string)
+	return value, ok
+}
+
 func (p *ListVPCsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2006,6 +4145,15 @@ func (p *ListVPCsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListVPCsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListVPCsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListVPCsParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewListVPCsParams() *ListVPCsParams {
@@ -2016,6 +4164,11 @@ func (s *VPCService) NewListVPCsParams() *ListVPCsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVPCIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVPCIDWithContext is the same as GetVPCID, but uses ctx to cancel the request
+func (s *VPCService) GetVPCIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVPCsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2027,7 +4180,7 @@ func (s *VPCService) GetVPCID(name string, opts ...OptionFunc) (string, int, err
 		}
 	}
 
-	l, err := s.ListVPCs(p)
+	l, err := s.ListVPCsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2052,12 +4205,17 @@ func (s *VPCService) GetVPCID(name string, opts ...OptionFunc) (string, int, err
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCByName(name string, opts ...OptionFunc) (*VPC, int, error) {
-	id, count, err := s.GetVPCID(name, opts...)
+	return s.GetVPCByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVPCByNameWithContext is the same as GetVPCByName, but uses ctx to cancel the requests
+func (s *VPCService) GetVPCByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VPC, int, error) {
+	id, count, err := s.GetVPCIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVPCByID(id, opts...)
+	r, count, err := s.GetVPCByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2066,6 +4224,11 @@ func (s *VPCService) GetVPCByName(name string, opts ...OptionFunc) (*VPC, int, e
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error) {
+	return s.GetVPCByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVPCByIDWithContext is the same as GetVPCByID, but uses ctx to cancel the request
+func (s *VPCService) GetVPCByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VPC, int, error) {
 	p := &ListVPCsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2077,18 +4240,16 @@ func (s *VPCService) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error
 		}
 	}
 
-	l, err := s.ListVPCs(p)
+	l, err := s.ListVPCsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -2099,7 +4260,16 @@ func (s *VPCService) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error
 
 // Lists VPCs
 func (s *VPCService) ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error) {
-	resp, err := s.cs.newRequest("listVPCs", p.toURLValues())
+	return s.ListVPCsWithContext(context.Background(), p)
+}
+
+// ListVPCsWithContext is the same as ListVPCs, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) ListVPCsWithContext(ctx context.Context, p *ListVPCsParams) (*ListVPCsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVPCs", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2112,6 +4282,92 @@ func (s *VPCService) ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error) {
 	return &r, nil
 }
 
+// ListVPCsPager iterates over all pages of a ListVPCs call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVPCsPager struct {
+	pager
+
+	s *VPCService
+	p *ListVPCsParams
+	r *ListVPCsResponse
+}
+
+// NewListVPCsPager returns a pager for all pages of a ListVPCs call with the given params
+func (s *VPCService) NewListVPCsPager(p *ListVPCsParams) *ListVPCsPager {
+	return &ListVPCsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVPCsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVPCsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVPCsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVPCsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.VPCs), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVPCsPager) Page() *ListVPCsResponse {
+	return pg.r
+}
+
+// ListVPCsAll fetches all pages of a ListVPCs call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VPCService) ListVPCsAll(p *ListVPCsParams) (*ListVPCsResponse, error) {
+	return s.ListVPCsAllWithContext(context.Background(), p)
+}
+
+// ListVPCsAllWithContext is the same as ListVPCsAll, but uses ctx to cancel the requests
+func (s *VPCService) ListVPCsAllWithContext(ctx context.Context, p *ListVPCsParams) (*ListVPCsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*VPC)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVPCsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVPCsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.VPCs
+		mu.Unlock()
+
+		return len(l.VPCs), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVPCsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.VPCs = append(r.VPCs, pages[page]...)
+	}
+	r.Count = len(r.VPCs)
+
+	return r, nil
+}
+
 type ListVPCsResponse struct {
 	Count int    `json:"count"`
 	VPCs  []*VPC `json:"vpc"`
@@ -2224,13 +4480,299 @@ type VPCNetwork struct {
 	Zonesnetworkspans           []interface{}       `json:"zonesnetworkspans"`
 }
 
-type VPCNetworkService struct {
-	Capability []VPCNetworkServiceCapability `json:"capability"`
-	Name       string                        `json:"name"`
-	Provider   []VPCNetworkServiceProvider   `json:"provider"`
+type VPCNetworkService struct {
+	Capability []VPCNetworkServiceCapability `json:"capability"`
+	Name       string                        `json:"name"`
+	Provider   []VPCNetworkServiceProvider   `json:"provider"`
+}
+
+type VPCNetworkServiceProvider struct {
+	Canenableindividualservice   bool     `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
+	Id                           string   `json:"id"`
+	Name                         string   `json:"name"`
+	Physicalnetworkid            string   `json:"physicalnetworkid"`
+	Servicelist                  []string `json:"servicelist"`
+	State                        string   `json:"state"`
+}
+
+type VPCNetworkServiceCapability struct {
+	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
+	Name                       string `json:"name"`
+	Value                      string `json:"value"`
+}
+
+type MigrateVPCParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *MigrateVPCParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["resume"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("resume", vv)
+	}
+	if v, found := p.p["tiernetworkofferings"]; found {
+		for i, item := range v.([]TierNetworkOffering) {
+			if item.NetworkID != "" {
+				u.Set(fmt.Sprintf("tiernetworkofferings[%d].networkid", i), item.NetworkID)
+			}
+			if item.NetworkOfferingID != "" {
+				u.Set(fmt.Sprintf("tiernetworkofferings[%d].networkofferingid", i), item.NetworkOfferingID)
+			}
+		}
+	}
+	if v, found := p.p["vpcid"]; found {
+		u.Set("vpcid", v.(string))
+	}
+	if v, found := p.p["vpcofferingid"]; found {
+		u.Set("vpcofferingid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *MigrateVPCParams) Validate() error {
+	v := newParamValidator("migrateVPC", p.p)
+	v.required("vpcid", "vpcofferingid")
+	v.ids("vpcid", "vpcofferingid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *MigrateVPCParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *MigrateVPCParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "resume":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tiernetworkofferings":
+			var v []TierNetworkOffering
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "vpcid", "vpcofferingid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for migrateVPC", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of migrateVPC: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *MigrateVPCParams) SetResume(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["resume"] = v
+}
+
+func (p *MigrateVPCParams) ResetResume() {
+	delete(p.p, "resume")
+}
+
+func (p *MigrateVPCParams) GetResume() (bool, bool) {
+	value, ok := p.p["resume"].(bool)
+	return value, ok
+}
+
+func (p *MigrateVPCParams) SetTiernetworkofferings(v []TierNetworkOffering) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["tiernetworkofferings"] = v
+}
+
+func (p *MigrateVPCParams) ResetTiernetworkofferings() {
+	delete(p.p, "tiernetworkofferings")
+}
+
+func (p *MigrateVPCParams) GetTiernetworkofferings() ([]TierNetworkOffering, bool) {
+	value, ok := p.p["tiernetworkofferings"].([]TierNetworkOffering)
+	return value, ok
+}
+
+func (p *MigrateVPCParams) SetVpcid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["vpcid"] = v
+}
+
+func (p *MigrateVPCParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *MigrateVPCParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
+func (p *MigrateVPCParams) SetVpcofferingid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["vpcofferingid"] = v
+}
+
+func (p *MigrateVPCParams) ResetVpcofferingid() {
+	delete(p.p, "vpcofferingid")
+}
+
+func (p *MigrateVPCParams) GetVpcofferingid() (string, bool) {
+	value, ok := p.p["vpcofferingid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new MigrateVPCParams instance,
+// as then you are sure you have configured all required params
+func (s *VPCService) NewMigrateVPCParams(vpcid string, vpcofferingid string) *MigrateVPCParams {
+	p := &MigrateVPCParams{}
+	p.p = make(map[string]interface{})
+	p.p["vpcid"] = vpcid
+	p.p["vpcofferingid"] = vpcofferingid
+	return p
+}
+
+// moves a vpc to another physical network
+func (s *VPCService) MigrateVPC(p *MigrateVPCParams) (*MigrateVPCResponse, error) {
+	return s.MigrateVPCWithContext(context.Background(), p)
+}
+
+// MigrateVPCWithContext is the same as MigrateVPC, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) MigrateVPCWithContext(ctx context.Context, p *MigrateVPCParams) (*MigrateVPCResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateVPC", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateVPCResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// MigrateVPCAsync starts the async job for MigrateVPC and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a MigrateVPCResponse using Job.Result
+func (s *VPCService) MigrateVPCAsync(p *MigrateVPCParams) (*Job, error) {
+	return s.MigrateVPCAsyncWithContext(context.Background(), p)
+}
+
+// MigrateVPCAsyncWithContext is the same as MigrateVPCAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPCService) MigrateVPCAsyncWithContext(ctx context.Context, p *MigrateVPCParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateVPC", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateVPCResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type MigrateVPCResponse struct {
+	Account              string                      `json:"account"`
+	Cidr                 string                      `json:"cidr"`
+	Created              string                      `json:"created"`
+	Displaytext          string                      `json:"displaytext"`
+	Distributedvpcrouter bool                        `json:"distributedvpcrouter"`
+	Domain               string                      `json:"domain"`
+	Domainid             string                      `json:"domainid"`
+	Fordisplay           bool                        `json:"fordisplay"`
+	Id                   string                      `json:"id"`
+	JobID                string                      `json:"jobid"`
+	Jobstatus            int                         `json:"jobstatus"`
+	Name                 string                      `json:"name"`
+	Network              []MigrateVPCResponseNetwork `json:"network"`
+	Networkdomain        string                      `json:"networkdomain"`
+	Project              string                      `json:"project"`
+	Projectid            string                      `json:"projectid"`
+	Redundantvpcrouter   bool                        `json:"redundantvpcrouter"`
+	Regionlevelvpc       bool                        `json:"regionlevelvpc"`
+	Restartrequired      bool                        `json:"restartrequired"`
+	Service              []MigrateVPCResponseService `json:"service"`
+	State                string                      `json:"state"`
+	Tags                 []Tags                      `json:"tags"`
+	Vpcofferingid        string                      `json:"vpcofferingid"`
+	Vpcofferingname      string                      `json:"vpcofferingname"`
+	Zoneid               string                      `json:"zoneid"`
+	Zonename             string                      `json:"zonename"`
+}
+
+type MigrateVPCResponseService struct {
+	Capability []MigrateVPCResponseServiceCapability `json:"capability"`
+	Name       string                                `json:"name"`
+	Provider   []MigrateVPCResponseServiceProvider   `json:"provider"`
 }
 
-type VPCNetworkServiceProvider struct {
+type MigrateVPCResponseServiceProvider struct {
 	Canenableindividualservice   bool     `json:"canenableindividualservice"`
 	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
 	Id                           string   `json:"id"`
@@ -2240,7 +4782,85 @@ type VPCNetworkServiceProvider struct {
 	State                        string   `json:"state"`
 }
 
-type VPCNetworkServiceCapability struct {
+type MigrateVPCResponseServiceCapability struct {
+	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
+	Name                       string `json:"name"`
+	Value                      string `json:"value"`
+}
+
+type MigrateVPCResponseNetwork struct {
+	Account                     string                             `json:"account"`
+	Aclid                       string                             `json:"aclid"`
+	Aclname                     string                             `json:"aclname"`
+	Acltype                     string                             `json:"acltype"`
+	Broadcastdomaintype         string                             `json:"broadcastdomaintype"`
+	Broadcasturi                string                             `json:"broadcasturi"`
+	Canusefordeploy             bool                               `json:"canusefordeploy"`
+	Cidr                        string                             `json:"cidr"`
+	Details                     map[string]string                  `json:"details"`
+	Displaynetwork              bool                               `json:"displaynetwork"`
+	Displaytext                 string                             `json:"displaytext"`
+	Dns1                        string                             `json:"dns1"`
+	Dns2                        string                             `json:"dns2"`
+	Domain                      string                             `json:"domain"`
+	Domainid                    string                             `json:"domainid"`
+	Externalid                  string                             `json:"externalid"`
+	Gateway                     string                             `json:"gateway"`
+	Id                          string                             `json:"id"`
+	Ip6cidr                     string                             `json:"ip6cidr"`
+	Ip6gateway                  string                             `json:"ip6gateway"`
+	Isdefault                   bool                               `json:"isdefault"`
+	Ispersistent                bool                               `json:"ispersistent"`
+	Issystem                    bool                               `json:"issystem"`
+	Name                        string                             `json:"name"`
+	Netmask                     string                             `json:"netmask"`
+	Networkcidr                 string                             `json:"networkcidr"`
+	Networkdomain               string                             `json:"networkdomain"`
+	Networkofferingavailability string                             `json:"networkofferingavailability"`
+	Networkofferingconservemode bool                               `json:"networkofferingconservemode"`
+	Networkofferingdisplaytext  string                             `json:"networkofferingdisplaytext"`
+	Networkofferingid           string                             `json:"networkofferingid"`
+	Networkofferingname         string                             `json:"networkofferingname"`
+	Physicalnetworkid           string                             `json:"physicalnetworkid"`
+	Project                     string                             `json:"project"`
+	Projectid                   string                             `json:"projectid"`
+	Redundantrouter             bool                               `json:"redundantrouter"`
+	Related                     string                             `json:"related"`
+	Reservediprange             string                             `json:"reservediprange"`
+	Restartrequired             bool                               `json:"restartrequired"`
+	Service                     []MigrateVPCResponseNetworkService `json:"service"`
+	Specifyipranges             bool                               `json:"specifyipranges"`
+	State                       string                             `json:"state"`
+	Strechedl2subnet            bool                               `json:"strechedl2subnet"`
+	Subdomainaccess             bool                               `json:"subdomainaccess"`
+	Tags                        []Tags                             `json:"tags"`
+	Traffictype                 string                             `json:"traffictype"`
+	Type                        string                             `json:"type"`
+	Vlan                        string                             `json:"vlan"`
+	Vpcid                       string                             `json:"vpcid"`
+	Vpcname                     string                             `json:"vpcname"`
+	Zoneid                      string                             `json:"zoneid"`
+	Zonename                    string                             `json:"zonename"`
+	Zonesnetworkspans           []interface{}                      `json:"zonesnetworkspans"`
+}
+
+type MigrateVPCResponseNetworkService struct {
+	Capability []MigrateVPCResponseNetworkServiceCapability `json:"capability"`
+	Name       string                                       `json:"name"`
+	Provider   []MigrateVPCResponseNetworkServiceProvider   `json:"provider"`
+}
+
+type MigrateVPCResponseNetworkServiceProvider struct {
+	Canenableindividualservice   bool     `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
+	Id                           string   `json:"id"`
+	Name                         string   `json:"name"`
+	Physicalnetworkid            string   `json:"physicalnetworkid"`
+	Servicelist                  []string `json:"servicelist"`
+	State                        string   `json:"state"`
+}
+
+type MigrateVPCResponseNetworkServiceCapability struct {
 	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
 	Name                       string `json:"name"`
 	Value                      string `json:"value"`
@@ -2250,7 +4870,8 @@ type RestartVPCParams struct {
 	p map[string]interface{}
 }
 
-func (p *RestartVPCParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RestartVPCParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2269,6 +4890,52 @@ func (p *RestartVPCParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RestartVPCParams) Validate() error {
+	v := newParamValidator("restartVPC", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RestartVPCParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RestartVPCParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "cleanup", "makeredundant":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for restartVPC", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of restartVPC: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RestartVPCParams) SetCleanup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2276,6 +4943,15 @@ func (p *RestartVPCParams) SetCleanup(v bool) {
 	p.p["cleanup"] = v
 }
 
+func (p *RestartVPCParams) ResetCleanup() {
+	delete(p.p, "cleanup")
+}
+
+func (p *RestartVPCParams) GetCleanup() (bool, bool) {
+	value, ok := p.p["cleanup"].(bool)
+	return value, ok
+}
+
 func (p *RestartVPCParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2283,6 +4959,15 @@ func (p *RestartVPCParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RestartVPCParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RestartVPCParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *RestartVPCParams) SetMakeredundant(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2290,6 +4975,15 @@ func (p *RestartVPCParams) SetMakeredundant(v bool) {
 	p.p["makeredundant"] = v
 }
 
+func (p *RestartVPCParams) ResetMakeredundant() {
+	delete(p.p, "makeredundant")
+}
+
+func (p *RestartVPCParams) GetMakeredundant() (bool, bool) {
+	value, ok := p.p["makeredundant"].(bool)
+	return value, ok
+}
+
 // You should always use this function to get a new RestartVPCParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewRestartVPCParams(id string) *RestartVPCParams {
@@ -2301,7 +4995,16 @@ func (s *VPCService) NewRestartVPCParams(id string) *RestartVPCParams {
 
 // Restarts a VPC
 func (s *VPCService) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error) {
-	resp, err := s.cs.newRequest("restartVPC", p.toURLValues())
+	return s.RestartVPCWithContext(context.Background(), p)
+}
+
+// RestartVPCWithContext is the same as RestartVPC, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) RestartVPCWithContext(ctx context.Context, p *RestartVPCParams) (*RestartVPCResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "restartVPC", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2313,7 +5016,7 @@ func (s *VPCService) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2334,6 +5037,40 @@ func (s *VPCService) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error
 	return &r, nil
 }
 
+// RestartVPCAsync starts the async job for RestartVPC and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RestartVPCResponse using Job.Result
+func (s *VPCService) RestartVPCAsync(p *RestartVPCParams) (*Job, error) {
+	return s.RestartVPCAsyncWithContext(context.Background(), p)
+}
+
+// RestartVPCAsyncWithContext is the same as RestartVPCAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPCService) RestartVPCAsyncWithContext(ctx context.Context, p *RestartVPCParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "restartVPC", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RestartVPCResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type RestartVPCResponse struct {
 	Account              string                      `json:"account"`
 	Cidr                 string                      `json:"cidr"`
@@ -2467,7 +5204,8 @@ type UpdateVPCParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateVPCParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateVPCParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2491,6 +5229,55 @@ func (p *UpdateVPCParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateVPCParams) Validate() error {
+	v := newParamValidator("updateVPC", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("customid", 255)
+	v.maxLength("displaytext", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateVPCParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateVPCParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "displaytext", "id", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateVPC", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateVPC: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVPCParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2498,6 +5285,15 @@ func (p *UpdateVPCParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateVPCParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateVPCParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateVPCParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2505,6 +5301,15 @@ func (p *UpdateVPCParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *UpdateVPCParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *UpdateVPCParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *UpdateVPCParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2512,6 +5317,15 @@ func (p *UpdateVPCParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateVPCParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateVPCParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateVPCParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2519,6 +5333,15 @@ func (p *UpdateVPCParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateVPCParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateVPCParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateVPCParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2526,6 +5349,15 @@ func (p *UpdateVPCParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateVPCParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateVPCParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateVPCParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewUpdateVPCParams(id string) *UpdateVPCParams {
@@ -2537,7 +5369,16 @@ func (s *VPCService) NewUpdateVPCParams(id string) *UpdateVPCParams {
 
 // Updates a VPC
 func (s *VPCService) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
-	resp, err := s.cs.newRequest("updateVPC", p.toURLValues())
+	return s.UpdateVPCWithContext(context.Background(), p)
+}
+
+// UpdateVPCWithContext is the same as UpdateVPC, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) UpdateVPCWithContext(ctx context.Context, p *UpdateVPCParams) (*UpdateVPCResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVPC", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2549,7 +5390,7 @@ func (s *VPCService) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2570,6 +5411,40 @@ func (s *VPCService) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
 	return &r, nil
 }
 
+// UpdateVPCAsync starts the async job for UpdateVPC and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateVPCResponse using Job.Result
+func (s *VPCService) UpdateVPCAsync(p *UpdateVPCParams) (*Job, error) {
+	return s.UpdateVPCAsyncWithContext(context.Background(), p)
+}
+
+// UpdateVPCAsyncWithContext is the same as UpdateVPCAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPCService) UpdateVPCAsyncWithContext(ctx context.Context, p *UpdateVPCParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVPC", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVPCResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateVPCResponse struct {
 	Account              string                     `json:"account"`
 	Cidr                 string                     `json:"cidr"`
@@ -2703,7 +5578,8 @@ type UpdateVPCOfferingParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateVPCOfferingParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateVPCOfferingParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2733,6 +5609,57 @@ func (p *UpdateVPCOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateVPCOfferingParams) Validate() error {
+	v := newParamValidator("updateVPCOffering", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("displaytext", 255)
+	v.maxLength("domainid", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	v.maxLength("zoneid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateVPCOfferingParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateVPCOfferingParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "displaytext", "domainid", "id", "name", "state", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "sortkey":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateVPCOffering", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateVPCOffering: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVPCOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2740,6 +5667,15 @@ func (p *UpdateVPCOfferingParams) SetDisplaytext(v string) {
 	p.p["displaytext"] = v
 }
 
+func (p *UpdateVPCOfferingParams) ResetDisplaytext() {
+	delete(p.p, "displaytext")
+}
+
+func (p *UpdateVPCOfferingParams) GetDisplaytext() (string, bool) {
+	value, ok := p.p["displaytext"].(string)
+	return value, ok
+}
+
 func (p *UpdateVPCOfferingParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2747,6 +5683,15 @@ func (p *UpdateVPCOfferingParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *UpdateVPCOfferingParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *UpdateVPCOfferingParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *UpdateVPCOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2754,6 +5699,15 @@ func (p *UpdateVPCOfferingParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateVPCOfferingParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateVPCOfferingParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateVPCOfferingParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2761,6 +5715,15 @@ func (p *UpdateVPCOfferingParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateVPCOfferingParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateVPCOfferingParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateVPCOfferingParams) SetSortkey(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2768,6 +5731,15 @@ func (p *UpdateVPCOfferingParams) SetSortkey(v int) {
 	p.p["sortkey"] = v
 }
 
+func (p *UpdateVPCOfferingParams) ResetSortkey() {
+	delete(p.p, "sortkey")
+}
+
+func (p *UpdateVPCOfferingParams) GetSortkey() (int, bool) {
+	value, ok := p.p["sortkey"].(int)
+	return value, ok
+}
+
 func (p *UpdateVPCOfferingParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2775,6 +5747,15 @@ func (p *UpdateVPCOfferingParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *UpdateVPCOfferingParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *UpdateVPCOfferingParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *UpdateVPCOfferingParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2782,6 +5763,15 @@ func (p *UpdateVPCOfferingParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *UpdateVPCOfferingParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *UpdateVPCOfferingParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateVPCOfferingParams instance,
 // as then you are sure you have configured all required params
 func (s *VPCService) NewUpdateVPCOfferingParams(id string) *UpdateVPCOfferingParams {
@@ -2793,7 +5783,16 @@ func (s *VPCService) NewUpdateVPCOfferingParams(id string) *UpdateVPCOfferingPar
 
 // Updates VPC offering
 func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateVPCOffering", p.toURLValues())
+	return s.UpdateVPCOfferingWithContext(context.Background(), p)
+}
+
+// UpdateVPCOfferingWithContext is the same as UpdateVPCOffering, but uses ctx to cancel the request and any async job polling
+func (s *VPCService) UpdateVPCOfferingWithContext(ctx context.Context, p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVPCOffering", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2805,7 +5804,7 @@ func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOf
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2826,6 +5825,40 @@ func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOf
 	return &r, nil
 }
 
+// UpdateVPCOfferingAsync starts the async job for UpdateVPCOffering and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateVPCOfferingResponse using Job.Result
+func (s *VPCService) UpdateVPCOfferingAsync(p *UpdateVPCOfferingParams) (*Job, error) {
+	return s.UpdateVPCOfferingAsyncWithContext(context.Background(), p)
+}
+
+// UpdateVPCOfferingAsyncWithContext is the same as UpdateVPCOfferingAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPCService) UpdateVPCOfferingAsyncWithContext(ctx context.Context, p *UpdateVPCOfferingParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVPCOffering", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVPCOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateVPCOfferingResponse struct {
 	Created                string                             `json:"created"`
 	Displaytext            string                             `json:"displaytext"`
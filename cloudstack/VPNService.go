@@ -20,18 +20,144 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 )
 
+// VPNServiceIface is the interface implemented by VPNService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type VPNServiceIface interface {
+	NewAddVpnUserParams(password string, username string) *AddVpnUserParams
+	AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error)
+	AddVpnUserWithContext(ctx context.Context, p *AddVpnUserParams) (*AddVpnUserResponse, error)
+	AddVpnUserAsync(p *AddVpnUserParams) (*Job, error)
+	AddVpnUserAsyncWithContext(ctx context.Context, p *AddVpnUserParams) (*Job, error)
+	NewCreateRemoteAccessVpnParams(publicipid string) *CreateRemoteAccessVpnParams
+	CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error)
+	CreateRemoteAccessVpnWithContext(ctx context.Context, p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error)
+	CreateRemoteAccessVpnAsync(p *CreateRemoteAccessVpnParams) (*Job, error)
+	CreateRemoteAccessVpnAsyncWithContext(ctx context.Context, p *CreateRemoteAccessVpnParams) (*Job, error)
+	NewCreateVpnConnectionParams(s2scustomergatewayid string, s2svpngatewayid string) *CreateVpnConnectionParams
+	CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error)
+	CreateVpnConnectionWithContext(ctx context.Context, p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error)
+	CreateVpnConnectionAsync(p *CreateVpnConnectionParams) (*Job, error)
+	CreateVpnConnectionAsyncWithContext(ctx context.Context, p *CreateVpnConnectionParams) (*Job, error)
+	NewCreateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string) *CreateVpnCustomerGatewayParams
+	CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error)
+	CreateVpnCustomerGatewayWithContext(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error)
+	CreateVpnCustomerGatewayAsync(p *CreateVpnCustomerGatewayParams) (*Job, error)
+	CreateVpnCustomerGatewayAsyncWithContext(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*Job, error)
+	NewCreateVpnGatewayParams(vpcid string) *CreateVpnGatewayParams
+	CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error)
+	CreateVpnGatewayWithContext(ctx context.Context, p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error)
+	CreateVpnGatewayAsync(p *CreateVpnGatewayParams) (*Job, error)
+	CreateVpnGatewayAsyncWithContext(ctx context.Context, p *CreateVpnGatewayParams) (*Job, error)
+	NewDeleteRemoteAccessVpnParams(publicipid string) *DeleteRemoteAccessVpnParams
+	DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error)
+	DeleteRemoteAccessVpnWithContext(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error)
+	DeleteRemoteAccessVpnAsync(p *DeleteRemoteAccessVpnParams) (*Job, error)
+	DeleteRemoteAccessVpnAsyncWithContext(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*Job, error)
+	NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectionParams
+	DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error)
+	DeleteVpnConnectionWithContext(ctx context.Context, p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error)
+	DeleteVpnConnectionAsync(p *DeleteVpnConnectionParams) (*Job, error)
+	DeleteVpnConnectionAsyncWithContext(ctx context.Context, p *DeleteVpnConnectionParams) (*Job, error)
+	NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCustomerGatewayParams
+	DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error)
+	DeleteVpnCustomerGatewayWithContext(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error)
+	DeleteVpnCustomerGatewayAsync(p *DeleteVpnCustomerGatewayParams) (*Job, error)
+	DeleteVpnCustomerGatewayAsyncWithContext(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*Job, error)
+	NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParams
+	DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error)
+	DeleteVpnGatewayWithContext(ctx context.Context, p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error)
+	DeleteVpnGatewayAsync(p *DeleteVpnGatewayParams) (*Job, error)
+	DeleteVpnGatewayAsyncWithContext(ctx context.Context, p *DeleteVpnGatewayParams) (*Job, error)
+	NewListRemoteAccessVpnsParams() *ListRemoteAccessVpnsParams
+	GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error)
+	GetRemoteAccessVpnByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error)
+	ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error)
+	ListRemoteAccessVpnsWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error)
+	NewListRemoteAccessVpnsPager(p *ListRemoteAccessVpnsParams) *ListRemoteAccessVpnsPager
+	ListRemoteAccessVpnsAll(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error)
+	ListRemoteAccessVpnsAllWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error)
+	NewListVpnConnectionsParams() *ListVpnConnectionsParams
+	GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnConnection, int, error)
+	GetVpnConnectionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnConnection, int, error)
+	ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error)
+	ListVpnConnectionsWithContext(ctx context.Context, p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error)
+	NewListVpnConnectionsPager(p *ListVpnConnectionsParams) *ListVpnConnectionsPager
+	ListVpnConnectionsAll(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error)
+	ListVpnConnectionsAllWithContext(ctx context.Context, p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error)
+	NewListVpnCustomerGatewaysParams() *ListVpnCustomerGatewaysParams
+	GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetVpnCustomerGatewayIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
+	GetVpnCustomerGatewayByName(name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	GetVpnCustomerGatewayByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	GetVpnCustomerGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error)
+	ListVpnCustomerGatewaysWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error)
+	NewListVpnCustomerGatewaysPager(p *ListVpnCustomerGatewaysParams) *ListVpnCustomerGatewaysPager
+	ListVpnCustomerGatewaysAll(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error)
+	ListVpnCustomerGatewaysAllWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error)
+	NewListVpnGatewaysParams() *ListVpnGatewaysParams
+	GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGateway, int, error)
+	GetVpnGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnGateway, int, error)
+	ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error)
+	ListVpnGatewaysWithContext(ctx context.Context, p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error)
+	NewListVpnGatewaysPager(p *ListVpnGatewaysParams) *ListVpnGatewaysPager
+	ListVpnGatewaysAll(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error)
+	ListVpnGatewaysAllWithContext(ctx context.Context, p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error)
+	NewListVpnUsersParams() *ListVpnUsersParams
+	GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error)
+	GetVpnUserByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnUser, int, error)
+	ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error)
+	ListVpnUsersWithContext(ctx context.Context, p *ListVpnUsersParams) (*ListVpnUsersResponse, error)
+	NewListVpnUsersPager(p *ListVpnUsersParams) *ListVpnUsersPager
+	ListVpnUsersAll(p *ListVpnUsersParams) (*ListVpnUsersResponse, error)
+	ListVpnUsersAllWithContext(ctx context.Context, p *ListVpnUsersParams) (*ListVpnUsersResponse, error)
+	NewRemoveVpnUserParams(username string) *RemoveVpnUserParams
+	RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error)
+	RemoveVpnUserWithContext(ctx context.Context, p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error)
+	RemoveVpnUserAsync(p *RemoveVpnUserParams) (*Job, error)
+	RemoveVpnUserAsyncWithContext(ctx context.Context, p *RemoveVpnUserParams) (*Job, error)
+	NewResetVpnConnectionParams(id string) *ResetVpnConnectionParams
+	ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error)
+	ResetVpnConnectionWithContext(ctx context.Context, p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error)
+	ResetVpnConnectionAsync(p *ResetVpnConnectionParams) (*Job, error)
+	ResetVpnConnectionAsyncWithContext(ctx context.Context, p *ResetVpnConnectionParams) (*Job, error)
+	NewUpdateRemoteAccessVpnParams(id string) *UpdateRemoteAccessVpnParams
+	UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error)
+	UpdateRemoteAccessVpnWithContext(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error)
+	UpdateRemoteAccessVpnAsync(p *UpdateRemoteAccessVpnParams) (*Job, error)
+	UpdateRemoteAccessVpnAsyncWithContext(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*Job, error)
+	NewUpdateVpnConnectionParams(id string) *UpdateVpnConnectionParams
+	UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error)
+	UpdateVpnConnectionWithContext(ctx context.Context, p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error)
+	UpdateVpnConnectionAsync(p *UpdateVpnConnectionParams) (*Job, error)
+	UpdateVpnConnectionAsyncWithContext(ctx context.Context, p *UpdateVpnConnectionParams) (*Job, error)
+	NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string) *UpdateVpnCustomerGatewayParams
+	UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error)
+	UpdateVpnCustomerGatewayWithContext(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error)
+	UpdateVpnCustomerGatewayAsync(p *UpdateVpnCustomerGatewayParams) (*Job, error)
+	UpdateVpnCustomerGatewayAsyncWithContext(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*Job, error)
+	NewUpdateVpnGatewayParams(id string) *UpdateVpnGatewayParams
+	UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error)
+	UpdateVpnGatewayWithContext(ctx context.Context, p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error)
+	UpdateVpnGatewayAsync(p *UpdateVpnGatewayParams) (*Job, error)
+	UpdateVpnGatewayAsyncWithContext(ctx context.Context, p *UpdateVpnGatewayParams) (*Job, error)
+}
+
 type AddVpnUserParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddVpnUserParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddVpnUserParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -54,6 +180,51 @@ func (p *AddVpnUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddVpnUserParams) Validate() error {
+	v := newParamValidator("addVpnUser", p.p)
+	v.required("password", "username")
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("password", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddVpnUserParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddVpnUserParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "password", "projectid", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addVpnUser", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addVpnUser: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddVpnUserParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -61,6 +232,15 @@ func (p *AddVpnUserParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *AddVpnUserParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *AddVpnUserParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *AddVpnUserParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -68,6 +248,15 @@ func (p *AddVpnUserParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *AddVpnUserParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *AddVpnUserParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *AddVpnUserParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -75,6 +264,15 @@ func (p *AddVpnUserParams) SetPassword(v string) {
 	p.p["password"] = v
 }
 
+func (p *AddVpnUserParams) ResetPassword() {
+	delete(p.p, "password")
+}
+
+func (p *AddVpnUserParams) GetPassword() (string, bool) {
+	value, ok := p.p["password"].(string)
+	return value, ok
+}
+
 func (p *AddVpnUserParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -82,6 +280,15 @@ func (p *AddVpnUserParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *AddVpnUserParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *AddVpnUserParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *AddVpnUserParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -89,6 +296,15 @@ func (p *AddVpnUserParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *AddVpnUserParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *AddVpnUserParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddVpnUserParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewAddVpnUserParams(password string, username string) *AddVpnUserParams {
@@ -101,7 +317,16 @@ func (s *VPNService) NewAddVpnUserParams(password string, username string) *AddV
 
 // Adds vpn users
 func (s *VPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error) {
-	resp, err := s.cs.newRequest("addVpnUser", p.toURLValues())
+	return s.AddVpnUserWithContext(context.Background(), p)
+}
+
+// AddVpnUserWithContext is the same as AddVpnUser, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) AddVpnUserWithContext(ctx context.Context, p *AddVpnUserParams) (*AddVpnUserResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addVpnUser", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -113,7 +338,7 @@ func (s *VPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -134,6 +359,40 @@ func (s *VPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error
 	return &r, nil
 }
 
+// AddVpnUserAsync starts the async job for AddVpnUser and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddVpnUserResponse using Job.Result
+func (s *VPNService) AddVpnUserAsync(p *AddVpnUserParams) (*Job, error) {
+	return s.AddVpnUserAsyncWithContext(context.Background(), p)
+}
+
+// AddVpnUserAsyncWithContext is the same as AddVpnUserAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) AddVpnUserAsyncWithContext(ctx context.Context, p *AddVpnUserParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addVpnUser", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddVpnUserResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AddVpnUserResponse struct {
 	Account   string `json:"account"`
 	Domain    string `json:"domain"`
@@ -151,7 +410,8 @@ type CreateRemoteAccessVpnParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateRemoteAccessVpnParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateRemoteAccessVpnParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -179,6 +439,54 @@ func (p *CreateRemoteAccessVpnParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateRemoteAccessVpnParams) Validate() error {
+	v := newParamValidator("createRemoteAccessVpn", p.p)
+	v.required("publicipid")
+	v.ids("domainid", "publicipid")
+	v.maxLength("account", 255)
+	v.maxLength("iprange", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateRemoteAccessVpnParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateRemoteAccessVpnParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "iprange", "publicipid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "openfirewall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createRemoteAccessVpn", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createRemoteAccessVpn: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateRemoteAccessVpnParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -186,6 +494,15 @@ func (p *CreateRemoteAccessVpnParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateRemoteAccessVpnParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateRemoteAccessVpnParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateRemoteAccessVpnParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,6 +510,15 @@ func (p *CreateRemoteAccessVpnParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateRemoteAccessVpnParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateRemoteAccessVpnParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateRemoteAccessVpnParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -200,6 +526,15 @@ func (p *CreateRemoteAccessVpnParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *CreateRemoteAccessVpnParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateRemoteAccessVpnParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *CreateRemoteAccessVpnParams) SetIprange(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -207,6 +542,15 @@ func (p *CreateRemoteAccessVpnParams) SetIprange(v string) {
 	p.p["iprange"] = v
 }
 
+func (p *CreateRemoteAccessVpnParams) ResetIprange() {
+	delete(p.p, "iprange")
+}
+
+func (p *CreateRemoteAccessVpnParams) GetIprange() (string, bool) {
+	value, ok := p.p["iprange"].(string)
+	return value, ok
+}
+
 func (p *CreateRemoteAccessVpnParams) SetOpenfirewall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -214,6 +558,15 @@ func (p *CreateRemoteAccessVpnParams) SetOpenfirewall(v bool) {
 	p.p["openfirewall"] = v
 }
 
+func (p *CreateRemoteAccessVpnParams) ResetOpenfirewall() {
+	delete(p.p, "openfirewall")
+}
+
+func (p *CreateRemoteAccessVpnParams) GetOpenfirewall() (bool, bool) {
+	value, ok := p.p["openfirewall"].(bool)
+	return value, ok
+}
+
 func (p *CreateRemoteAccessVpnParams) SetPublicipid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -221,6 +574,15 @@ func (p *CreateRemoteAccessVpnParams) SetPublicipid(v string) {
 	p.p["publicipid"] = v
 }
 
+func (p *CreateRemoteAccessVpnParams) ResetPublicipid() {
+	delete(p.p, "publicipid")
+}
+
+func (p *CreateRemoteAccessVpnParams) GetPublicipid() (string, bool) {
+	value, ok := p.p["publicipid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateRemoteAccessVpnParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewCreateRemoteAccessVpnParams(publicipid string) *CreateRemoteAccessVpnParams {
@@ -232,7 +594,16 @@ func (s *VPNService) NewCreateRemoteAccessVpnParams(publicipid string) *CreateRe
 
 // Creates a l2tp/ipsec remote access vpn
 func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("createRemoteAccessVpn", p.toURLValues())
+	return s.CreateRemoteAccessVpnWithContext(context.Background(), p)
+}
+
+// CreateRemoteAccessVpnWithContext is the same as CreateRemoteAccessVpn, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) CreateRemoteAccessVpnWithContext(ctx context.Context, p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createRemoteAccessVpn", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -244,7 +615,7 @@ func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*Cre
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -265,6 +636,40 @@ func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*Cre
 	return &r, nil
 }
 
+// CreateRemoteAccessVpnAsync starts the async job for CreateRemoteAccessVpn and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateRemoteAccessVpnResponse using Job.Result
+func (s *VPNService) CreateRemoteAccessVpnAsync(p *CreateRemoteAccessVpnParams) (*Job, error) {
+	return s.CreateRemoteAccessVpnAsyncWithContext(context.Background(), p)
+}
+
+// CreateRemoteAccessVpnAsyncWithContext is the same as CreateRemoteAccessVpnAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) CreateRemoteAccessVpnAsyncWithContext(ctx context.Context, p *CreateRemoteAccessVpnParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createRemoteAccessVpn", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateRemoteAccessVpnResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateRemoteAccessVpnResponse struct {
 	Account      string `json:"account"`
 	Domain       string `json:"domain"`
@@ -286,7 +691,8 @@ type CreateVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateVpnConnectionParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateVpnConnectionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -308,6 +714,52 @@ func (p *CreateVpnConnectionParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateVpnConnectionParams) Validate() error {
+	v := newParamValidator("createVpnConnection", p.p)
+	v.required("s2scustomergatewayid", "s2svpngatewayid")
+	v.ids("s2scustomergatewayid", "s2svpngatewayid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateVpnConnectionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateVpnConnectionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "fordisplay", "passive":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "s2scustomergatewayid", "s2svpngatewayid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createVpnConnection", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createVpnConnection: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVpnConnectionParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -315,6 +767,15 @@ func (p *CreateVpnConnectionParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *CreateVpnConnectionParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateVpnConnectionParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *CreateVpnConnectionParams) SetPassive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -322,6 +783,15 @@ func (p *CreateVpnConnectionParams) SetPassive(v bool) {
 	p.p["passive"] = v
 }
 
+func (p *CreateVpnConnectionParams) ResetPassive() {
+	delete(p.p, "passive")
+}
+
+func (p *CreateVpnConnectionParams) GetPassive() (bool, bool) {
+	value, ok := p.p["passive"].(bool)
+	return value, ok
+}
+
 func (p *CreateVpnConnectionParams) SetS2scustomergatewayid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -329,6 +799,15 @@ func (p *CreateVpnConnectionParams) SetS2scustomergatewayid(v string) {
 	p.p["s2scustomergatewayid"] = v
 }
 
+func (p *CreateVpnConnectionParams) ResetS2scustomergatewayid() {
+	delete(p.p, "s2scustomergatewayid")
+}
+
+func (p *CreateVpnConnectionParams) GetS2scustomergatewayid() (string, bool) {
+	value, ok := p.p["s2scustomergatewayid"].(string)
+	return value, ok
+}
+
 func (p *CreateVpnConnectionParams) SetS2svpngatewayid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -336,6 +815,15 @@ func (p *CreateVpnConnectionParams) SetS2svpngatewayid(v string) {
 	p.p["s2svpngatewayid"] = v
 }
 
+func (p *CreateVpnConnectionParams) ResetS2svpngatewayid() {
+	delete(p.p, "s2svpngatewayid")
+}
+
+func (p *CreateVpnConnectionParams) GetS2svpngatewayid() (string, bool) {
+	value, ok := p.p["s2svpngatewayid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateVpnConnectionParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewCreateVpnConnectionParams(s2scustomergatewayid string, s2svpngatewayid string) *CreateVpnConnectionParams {
@@ -348,7 +836,16 @@ func (s *VPNService) NewCreateVpnConnectionParams(s2scustomergatewayid string, s
 
 // Create site to site vpn connection
 func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("createVpnConnection", p.toURLValues())
+	return s.CreateVpnConnectionWithContext(context.Background(), p)
+}
+
+// CreateVpnConnectionWithContext is the same as CreateVpnConnection, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) CreateVpnConnectionWithContext(ctx context.Context, p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVpnConnection", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -360,7 +857,7 @@ func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateV
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -381,6 +878,40 @@ func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateV
 	return &r, nil
 }
 
+// CreateVpnConnectionAsync starts the async job for CreateVpnConnection and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateVpnConnectionResponse using Job.Result
+func (s *VPNService) CreateVpnConnectionAsync(p *CreateVpnConnectionParams) (*Job, error) {
+	return s.CreateVpnConnectionAsyncWithContext(context.Background(), p)
+}
+
+// CreateVpnConnectionAsyncWithContext is the same as CreateVpnConnectionAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) CreateVpnConnectionAsyncWithContext(ctx context.Context, p *CreateVpnConnectionParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVpnConnection", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVpnConnectionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateVpnConnectionResponse struct {
 	Account              string `json:"account"`
 	Cidrlist             string `json:"cidrlist"`
@@ -415,7 +946,8 @@ type CreateVpnCustomerGatewayParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateVpnCustomerGatewayParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateVpnCustomerGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -473,6 +1005,65 @@ func (p *CreateVpnCustomerGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateVpnCustomerGatewayParams) Validate() error {
+	v := newParamValidator("createVpnCustomerGateway", p.p)
+	v.required("cidrlist", "esppolicy", "gateway", "ikepolicy", "ipsecpsk")
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("cidrlist", 255)
+	v.maxLength("esppolicy", 255)
+	v.maxLength("gateway", 255)
+	v.maxLength("ikepolicy", 255)
+	v.maxLength("ikeversion", 255)
+	v.oneOf("ikeversion", "ike", "ikev1", "ikev2")
+	v.maxLength("ipsecpsk", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateVpnCustomerGatewayParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateVpnCustomerGatewayParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "cidrlist", "domainid", "esppolicy", "gateway", "ikepolicy", "ikeversion", "ipsecpsk", "name", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "dpd", "forceencap", "splitconnections":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "esplifetime", "ikelifetime":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createVpnCustomerGateway", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createVpnCustomerGateway: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -480,6 +1071,15 @@ func (p *CreateVpnCustomerGatewayParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetCidrlist(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -487,6 +1087,15 @@ func (p *CreateVpnCustomerGatewayParams) SetCidrlist(v string) {
 	p.p["cidrlist"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetCidrlist() {
+	delete(p.p, "cidrlist")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetCidrlist() (string, bool) {
+	value, ok := p.p["cidrlist"].(string)
+	return value, ok
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -494,6 +1103,15 @@ func (p *CreateVpnCustomerGatewayParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetDpd(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -501,6 +1119,15 @@ func (p *CreateVpnCustomerGatewayParams) SetDpd(v bool) {
 	p.p["dpd"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetDpd() {
+	delete(p.p, "dpd")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetDpd() (bool, bool) {
+	value, ok := p.p["dpd"].(bool)
+	return value, ok
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetEsplifetime(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -508,6 +1135,15 @@ func (p *CreateVpnCustomerGatewayParams) SetEsplifetime(v int64) {
 	p.p["esplifetime"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetEsplifetime() {
+	delete(p.p, "esplifetime")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetEsplifetime() (int64, bool) {
+	value, ok := p.p["esplifetime"].(int64)
+	return value, ok
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetEsppolicy(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -515,6 +1151,15 @@ func (p *CreateVpnCustomerGatewayParams) SetEsppolicy(v string) {
 	p.p["esppolicy"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetEsppolicy() {
+	delete(p.p, "esppolicy")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetEsppolicy() (string, bool) {
+	value, ok := p.p["esppolicy"].(string)
+	return value, ok
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetForceencap(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -522,6 +1167,15 @@ func (p *CreateVpnCustomerGatewayParams) SetForceencap(v bool) {
 	p.p["forceencap"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetForceencap() {
+	delete(p.p, "forceencap")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetForceencap() (bool, bool) {
+	value, ok := p.p["forceencap"].(bool)
+	return value, ok
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -529,6 +1183,15 @@ func (p *CreateVpnCustomerGatewayParams) SetGateway(v string) {
 	p.p["gateway"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetGateway() {
+	delete(p.p, "gateway")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetGateway() (string, bool) {
+	value, ok := p.p["gateway"].(string)
+	return value, ok
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetIkelifetime(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -536,6 +1199,15 @@ func (p *CreateVpnCustomerGatewayParams) SetIkelifetime(v int64) {
 	p.p["ikelifetime"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetIkelifetime() {
+	delete(p.p, "ikelifetime")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetIkelifetime() (int64, bool) {
+	value, ok := p.p["ikelifetime"].(int64)
+	return value, ok
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetIkepolicy(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -543,6 +1215,15 @@ func (p *CreateVpnCustomerGatewayParams) SetIkepolicy(v string) {
 	p.p["ikepolicy"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetIkepolicy() {
+	delete(p.p, "ikepolicy")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetIkepolicy() (string, bool) {
+	value, ok := p.p["ikepolicy"].(string)
+	return value, ok
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetIkeversion(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -550,6 +1231,15 @@ func (p *CreateVpnCustomerGatewayParams) SetIkeversion(v string) {
 	p.p["ikeversion"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetIkeversion() {
+	delete(p.p, "ikeversion")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetIkeversion() (string, bool) {
+	value, ok := p.p["ikeversion"].(string)
+	return value, ok
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetIpsecpsk(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -557,6 +1247,15 @@ func (p *CreateVpnCustomerGatewayParams) SetIpsecpsk(v string) {
 	p.p["ipsecpsk"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetIpsecpsk() {
+	delete(p.p, "ipsecpsk")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetIpsecpsk() (string, bool) {
+	value, ok := p.p["ipsecpsk"].(string)
+	return value, ok
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -564,6 +1263,15 @@ func (p *CreateVpnCustomerGatewayParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -571,6 +1279,15 @@ func (p *CreateVpnCustomerGatewayParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetSplitconnections(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -578,6 +1295,15 @@ func (p *CreateVpnCustomerGatewayParams) SetSplitconnections(v bool) {
 	p.p["splitconnections"] = v
 }
 
+func (p *CreateVpnCustomerGatewayParams) ResetSplitconnections() {
+	delete(p.p, "splitconnections")
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetSplitconnections() (bool, bool) {
+	value, ok := p.p["splitconnections"].(bool)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateVpnCustomerGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewCreateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string) *CreateVpnCustomerGatewayParams {
@@ -593,7 +1319,16 @@ func (s *VPNService) NewCreateVpnCustomerGatewayParams(cidrlist string, esppolic
 
 // Creates site to site vpn customer gateway
 func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createVpnCustomerGateway", p.toURLValues())
+	return s.CreateVpnCustomerGatewayWithContext(context.Background(), p)
+}
+
+// CreateVpnCustomerGatewayWithContext is the same as CreateVpnCustomerGateway, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) CreateVpnCustomerGatewayWithContext(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVpnCustomerGateway", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -605,7 +1340,7 @@ func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -626,6 +1361,40 @@ func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams)
 	return &r, nil
 }
 
+// CreateVpnCustomerGatewayAsync starts the async job for CreateVpnCustomerGateway and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateVpnCustomerGatewayResponse using Job.Result
+func (s *VPNService) CreateVpnCustomerGatewayAsync(p *CreateVpnCustomerGatewayParams) (*Job, error) {
+	return s.CreateVpnCustomerGatewayAsyncWithContext(context.Background(), p)
+}
+
+// CreateVpnCustomerGatewayAsyncWithContext is the same as CreateVpnCustomerGatewayAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) CreateVpnCustomerGatewayAsyncWithContext(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVpnCustomerGateway", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVpnCustomerGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateVpnCustomerGatewayResponse struct {
 	Account          string `json:"account"`
 	Cidrlist         string `json:"cidrlist"`
@@ -655,7 +1424,8 @@ type CreateVpnGatewayParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateVpnGatewayParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateVpnGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -670,6 +1440,52 @@ func (p *CreateVpnGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateVpnGatewayParams) Validate() error {
+	v := newParamValidator("createVpnGateway", p.p)
+	v.required("vpcid")
+	v.ids("vpcid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateVpnGatewayParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateVpnGatewayParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "vpcid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createVpnGateway", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createVpnGateway: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVpnGatewayParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -677,6 +1493,15 @@ func (p *CreateVpnGatewayParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *CreateVpnGatewayParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *CreateVpnGatewayParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *CreateVpnGatewayParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -684,6 +1509,15 @@ func (p *CreateVpnGatewayParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *CreateVpnGatewayParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *CreateVpnGatewayParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateVpnGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewCreateVpnGatewayParams(vpcid string) *CreateVpnGatewayParams {
@@ -695,7 +1529,16 @@ func (s *VPNService) NewCreateVpnGatewayParams(vpcid string) *CreateVpnGatewayPa
 
 // Creates site to site vpn local gateway
 func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createVpnGateway", p.toURLValues())
+	return s.CreateVpnGatewayWithContext(context.Background(), p)
+}
+
+// CreateVpnGatewayWithContext is the same as CreateVpnGateway, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) CreateVpnGatewayWithContext(ctx context.Context, p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVpnGateway", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -707,7 +1550,7 @@ func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGate
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -728,6 +1571,40 @@ func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGate
 	return &r, nil
 }
 
+// CreateVpnGatewayAsync starts the async job for CreateVpnGateway and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateVpnGatewayResponse using Job.Result
+func (s *VPNService) CreateVpnGatewayAsync(p *CreateVpnGatewayParams) (*Job, error) {
+	return s.CreateVpnGatewayAsyncWithContext(context.Background(), p)
+}
+
+// CreateVpnGatewayAsyncWithContext is the same as CreateVpnGatewayAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) CreateVpnGatewayAsyncWithContext(ctx context.Context, p *CreateVpnGatewayParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVpnGateway", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVpnGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateVpnGatewayResponse struct {
 	Account    string `json:"account"`
 	Domain     string `json:"domain"`
@@ -748,7 +1625,8 @@ type DeleteRemoteAccessVpnParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteRemoteAccessVpnParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteRemoteAccessVpnParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -759,6 +1637,48 @@ func (p *DeleteRemoteAccessVpnParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteRemoteAccessVpnParams) Validate() error {
+	v := newParamValidator("deleteRemoteAccessVpn", p.p)
+	v.required("publicipid")
+	v.ids("publicipid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteRemoteAccessVpnParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteRemoteAccessVpnParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "publicipid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteRemoteAccessVpn", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteRemoteAccessVpn: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteRemoteAccessVpnParams) SetPublicipid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -766,6 +1686,15 @@ func (p *DeleteRemoteAccessVpnParams) SetPublicipid(v string) {
 	p.p["publicipid"] = v
 }
 
+func (p *DeleteRemoteAccessVpnParams) ResetPublicipid() {
+	delete(p.p, "publicipid")
+}
+
+func (p *DeleteRemoteAccessVpnParams) GetPublicipid() (string, bool) {
+	value, ok := p.p["publicipid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteRemoteAccessVpnParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewDeleteRemoteAccessVpnParams(publicipid string) *DeleteRemoteAccessVpnParams {
@@ -777,7 +1706,16 @@ func (s *VPNService) NewDeleteRemoteAccessVpnParams(publicipid string) *DeleteRe
 
 // Destroys a l2tp/ipsec remote access vpn
 func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("deleteRemoteAccessVpn", p.toURLValues())
+	return s.DeleteRemoteAccessVpnWithContext(context.Background(), p)
+}
+
+// DeleteRemoteAccessVpnWithContext is the same as DeleteRemoteAccessVpn, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) DeleteRemoteAccessVpnWithContext(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteRemoteAccessVpn", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -789,7 +1727,7 @@ func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*Del
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -805,6 +1743,31 @@ func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*Del
 	return &r, nil
 }
 
+// DeleteRemoteAccessVpnAsync starts the async job for DeleteRemoteAccessVpn and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteRemoteAccessVpnResponse using Job.Result
+func (s *VPNService) DeleteRemoteAccessVpnAsync(p *DeleteRemoteAccessVpnParams) (*Job, error) {
+	return s.DeleteRemoteAccessVpnAsyncWithContext(context.Background(), p)
+}
+
+// DeleteRemoteAccessVpnAsyncWithContext is the same as DeleteRemoteAccessVpnAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) DeleteRemoteAccessVpnAsyncWithContext(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteRemoteAccessVpn", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteRemoteAccessVpnResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteRemoteAccessVpnResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -816,7 +1779,8 @@ type DeleteVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteVpnConnectionParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteVpnConnectionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -827,6 +1791,48 @@ func (p *DeleteVpnConnectionParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteVpnConnectionParams) Validate() error {
+	v := newParamValidator("deleteVpnConnection", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteVpnConnectionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteVpnConnectionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteVpnConnection", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteVpnConnection: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVpnConnectionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -834,6 +1840,15 @@ func (p *DeleteVpnConnectionParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteVpnConnectionParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteVpnConnectionParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteVpnConnectionParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectionParams {
@@ -845,7 +1860,16 @@ func (s *VPNService) NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectio
 
 // Delete site to site vpn connection
 func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnConnection", p.toURLValues())
+	return s.DeleteVpnConnectionWithContext(context.Background(), p)
+}
+
+// DeleteVpnConnectionWithContext is the same as DeleteVpnConnection, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) DeleteVpnConnectionWithContext(ctx context.Context, p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVpnConnection", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -857,7 +1881,7 @@ func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteV
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -873,6 +1897,31 @@ func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteV
 	return &r, nil
 }
 
+// DeleteVpnConnectionAsync starts the async job for DeleteVpnConnection and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteVpnConnectionResponse using Job.Result
+func (s *VPNService) DeleteVpnConnectionAsync(p *DeleteVpnConnectionParams) (*Job, error) {
+	return s.DeleteVpnConnectionAsyncWithContext(context.Background(), p)
+}
+
+// DeleteVpnConnectionAsyncWithContext is the same as DeleteVpnConnectionAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) DeleteVpnConnectionAsyncWithContext(ctx context.Context, p *DeleteVpnConnectionParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVpnConnection", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVpnConnectionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteVpnConnectionResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -884,7 +1933,8 @@ type DeleteVpnCustomerGatewayParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteVpnCustomerGatewayParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteVpnCustomerGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -895,6 +1945,48 @@ func (p *DeleteVpnCustomerGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteVpnCustomerGatewayParams) Validate() error {
+	v := newParamValidator("deleteVpnCustomerGateway", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteVpnCustomerGatewayParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteVpnCustomerGatewayParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteVpnCustomerGateway", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteVpnCustomerGateway: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVpnCustomerGatewayParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -902,6 +1994,15 @@ func (p *DeleteVpnCustomerGatewayParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteVpnCustomerGatewayParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteVpnCustomerGatewayParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteVpnCustomerGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCustomerGatewayParams {
@@ -913,7 +2014,16 @@ func (s *VPNService) NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCust
 
 // Delete site to site vpn customer gateway
 func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnCustomerGateway", p.toURLValues())
+	return s.DeleteVpnCustomerGatewayWithContext(context.Background(), p)
+}
+
+// DeleteVpnCustomerGatewayWithContext is the same as DeleteVpnCustomerGateway, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) DeleteVpnCustomerGatewayWithContext(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVpnCustomerGateway", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -925,7 +2035,7 @@ func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -941,6 +2051,31 @@ func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams)
 	return &r, nil
 }
 
+// DeleteVpnCustomerGatewayAsync starts the async job for DeleteVpnCustomerGateway and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteVpnCustomerGatewayResponse using Job.Result
+func (s *VPNService) DeleteVpnCustomerGatewayAsync(p *DeleteVpnCustomerGatewayParams) (*Job, error) {
+	return s.DeleteVpnCustomerGatewayAsyncWithContext(context.Background(), p)
+}
+
+// DeleteVpnCustomerGatewayAsyncWithContext is the same as DeleteVpnCustomerGatewayAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) DeleteVpnCustomerGatewayAsyncWithContext(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVpnCustomerGateway", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVpnCustomerGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteVpnCustomerGatewayResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -952,7 +2087,8 @@ type DeleteVpnGatewayParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteVpnGatewayParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteVpnGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -963,6 +2099,48 @@ func (p *DeleteVpnGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteVpnGatewayParams) Validate() error {
+	v := newParamValidator("deleteVpnGateway", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteVpnGatewayParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteVpnGatewayParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteVpnGateway", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteVpnGateway: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVpnGatewayParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -970,6 +2148,15 @@ func (p *DeleteVpnGatewayParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteVpnGatewayParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteVpnGatewayParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteVpnGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParams {
@@ -981,7 +2168,16 @@ func (s *VPNService) NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParam
 
 // Delete site to site vpn gateway
 func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnGateway", p.toURLValues())
+	return s.DeleteVpnGatewayWithContext(context.Background(), p)
+}
+
+// DeleteVpnGatewayWithContext is the same as DeleteVpnGateway, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) DeleteVpnGatewayWithContext(ctx context.Context, p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVpnGateway", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -993,7 +2189,7 @@ func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGate
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1009,6 +2205,31 @@ func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGate
 	return &r, nil
 }
 
+// DeleteVpnGatewayAsync starts the async job for DeleteVpnGateway and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeleteVpnGatewayResponse using Job.Result
+func (s *VPNService) DeleteVpnGatewayAsync(p *DeleteVpnGatewayParams) (*Job, error) {
+	return s.DeleteVpnGatewayAsyncWithContext(context.Background(), p)
+}
+
+// DeleteVpnGatewayAsyncWithContext is the same as DeleteVpnGatewayAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) DeleteVpnGatewayAsyncWithContext(ctx context.Context, p *DeleteVpnGatewayParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVpnGateway", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVpnGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type DeleteVpnGatewayResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -1020,7 +2241,8 @@ type ListRemoteAccessVpnsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListRemoteAccessVpnsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListRemoteAccessVpnsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1069,6 +2291,57 @@ func (p *ListRemoteAccessVpnsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListRemoteAccessVpnsParams) Validate() error {
+	v := newParamValidator("listRemoteAccessVpns", p.p)
+	v.ids("domainid", "id", "networkid", "projectid", "publicipid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListRemoteAccessVpnsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListRemoteAccessVpnsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "networkid", "projectid", "publicipid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listRemoteAccessVpns", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listRemoteAccessVpns: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListRemoteAccessVpnsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1076,6 +2349,15 @@ func (p *ListRemoteAccessVpnsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListRemoteAccessVpnsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListRemoteAccessVpnsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListRemoteAccessVpnsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1083,6 +2365,15 @@ func (p *ListRemoteAccessVpnsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListRemoteAccessVpnsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListRemoteAccessVpnsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListRemoteAccessVpnsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1090,6 +2381,15 @@ func (p *ListRemoteAccessVpnsParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListRemoteAccessVpnsParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListRemoteAccessVpnsParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListRemoteAccessVpnsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1097,6 +2397,15 @@ func (p *ListRemoteAccessVpnsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListRemoteAccessVpnsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListRemoteAccessVpnsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListRemoteAccessVpnsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1104,6 +2413,15 @@ func (p *ListRemoteAccessVpnsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListRemoteAccessVpnsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListRemoteAccessVpnsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListRemoteAccessVpnsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1111,13 +2429,31 @@ func (p *ListRemoteAccessVpnsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
-func (p *ListRemoteAccessVpnsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRemoteAccessVpnsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListRemoteAccessVpnsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListRemoteAccessVpnsParams) SetListall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
 	p.p["listall"] = v
 }
 
+func (p *ListRemoteAccessVpnsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListRemoteAccessVpnsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListRemoteAccessVpnsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1125,6 +2461,15 @@ func (p *ListRemoteAccessVpnsParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *ListRemoteAccessVpnsParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListRemoteAccessVpnsParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *ListRemoteAccessVpnsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1132,6 +2477,15 @@ func (p *ListRemoteAccessVpnsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListRemoteAccessVpnsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListRemoteAccessVpnsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListRemoteAccessVpnsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1139,6 +2493,15 @@ func (p *ListRemoteAccessVpnsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListRemoteAccessVpnsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListRemoteAccessVpnsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListRemoteAccessVpnsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1146,6 +2509,15 @@ func (p *ListRemoteAccessVpnsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListRemoteAccessVpnsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListRemoteAccessVpnsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListRemoteAccessVpnsParams) SetPublicipid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1153,6 +2525,15 @@ func (p *ListRemoteAccessVpnsParams) SetPublicipid(v string) {
 	p.p["publicipid"] = v
 }
 
+func (p *ListRemoteAccessVpnsParams) ResetPublicipid() {
+	delete(p.p, "publicipid")
+}
+
+func (p *ListRemoteAccessVpnsParams) GetPublicipid() (string, bool) {
+	value, ok := p.p["publicipid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListRemoteAccessVpnsParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewListRemoteAccessVpnsParams() *ListRemoteAccessVpnsParams {
@@ -1163,6 +2544,11 @@ func (s *VPNService) NewListRemoteAccessVpnsParams() *ListRemoteAccessVpnsParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error) {
+	return s.GetRemoteAccessVpnByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetRemoteAccessVpnByIDWithContext is the same as GetRemoteAccessVpnByID, but uses ctx to cancel the request
+func (s *VPNService) GetRemoteAccessVpnByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error) {
 	p := &ListRemoteAccessVpnsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1174,18 +2560,16 @@ func (s *VPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*Rem
 		}
 	}
 
-	l, err := s.ListRemoteAccessVpns(p)
+	l, err := s.ListRemoteAccessVpnsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1196,7 +2580,16 @@ func (s *VPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*Rem
 
 // Lists remote access vpns
 func (s *VPNService) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
-	resp, err := s.cs.newRequest("listRemoteAccessVpns", p.toURLValues())
+	return s.ListRemoteAccessVpnsWithContext(context.Background(), p)
+}
+
+// ListRemoteAccessVpnsWithContext is the same as ListRemoteAccessVpns, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) ListRemoteAccessVpnsWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listRemoteAccessVpns", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1209,6 +2602,92 @@ func (s *VPNService) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListR
 	return &r, nil
 }
 
+// ListRemoteAccessVpnsPager iterates over all pages of a ListRemoteAccessVpns call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListRemoteAccessVpnsPager struct {
+	pager
+
+	s *VPNService
+	p *ListRemoteAccessVpnsParams
+	r *ListRemoteAccessVpnsResponse
+}
+
+// NewListRemoteAccessVpnsPager returns a pager for all pages of a ListRemoteAccessVpns call with the given params
+func (s *VPNService) NewListRemoteAccessVpnsPager(p *ListRemoteAccessVpnsParams) *ListRemoteAccessVpnsPager {
+	return &ListRemoteAccessVpnsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListRemoteAccessVpnsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListRemoteAccessVpnsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListRemoteAccessVpnsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListRemoteAccessVpnsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.RemoteAccessVpns), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListRemoteAccessVpnsPager) Page() *ListRemoteAccessVpnsResponse {
+	return pg.r
+}
+
+// ListRemoteAccessVpnsAll fetches all pages of a ListRemoteAccessVpns call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VPNService) ListRemoteAccessVpnsAll(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
+	return s.ListRemoteAccessVpnsAllWithContext(context.Background(), p)
+}
+
+// ListRemoteAccessVpnsAllWithContext is the same as ListRemoteAccessVpnsAll, but uses ctx to cancel the requests
+func (s *VPNService) ListRemoteAccessVpnsAllWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*RemoteAccessVpn)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListRemoteAccessVpnsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListRemoteAccessVpnsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.RemoteAccessVpns
+		mu.Unlock()
+
+		return len(l.RemoteAccessVpns), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListRemoteAccessVpnsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.RemoteAccessVpns = append(r.RemoteAccessVpns, pages[page]...)
+	}
+	r.Count = len(r.RemoteAccessVpns)
+
+	return r, nil
+}
+
 type ListRemoteAccessVpnsResponse struct {
 	Count            int                `json:"count"`
 	RemoteAccessVpns []*RemoteAccessVpn `json:"remoteaccessvpn"`
@@ -1235,7 +2714,8 @@ type ListVpnConnectionsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListVpnConnectionsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVpnConnectionsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1281,6 +2761,57 @@ func (p *ListVpnConnectionsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVpnConnectionsParams) Validate() error {
+	v := newParamValidator("listVpnConnections", p.p)
+	v.ids("domainid", "id", "projectid", "vpcid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVpnConnectionsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVpnConnectionsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "projectid", "vpcid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVpnConnections", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVpnConnections: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListVpnConnectionsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1288,6 +2819,15 @@ func (p *ListVpnConnectionsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListVpnConnectionsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListVpnConnectionsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListVpnConnectionsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1295,6 +2835,15 @@ func (p *ListVpnConnectionsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListVpnConnectionsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListVpnConnectionsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListVpnConnectionsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1302,6 +2851,15 @@ func (p *ListVpnConnectionsParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListVpnConnectionsParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListVpnConnectionsParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListVpnConnectionsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1309,6 +2867,15 @@ func (p *ListVpnConnectionsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListVpnConnectionsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListVpnConnectionsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListVpnConnectionsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1316,6 +2883,15 @@ func (p *ListVpnConnectionsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListVpnConnectionsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListVpnConnectionsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListVpnConnectionsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1323,6 +2899,15 @@ func (p *ListVpnConnectionsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListVpnConnectionsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVpnConnectionsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListVpnConnectionsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1330,6 +2915,15 @@ func (p *ListVpnConnectionsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListVpnConnectionsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListVpnConnectionsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListVpnConnectionsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1337,6 +2931,15 @@ func (p *ListVpnConnectionsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListVpnConnectionsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVpnConnectionsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListVpnConnectionsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1344,6 +2947,15 @@ func (p *ListVpnConnectionsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListVpnConnectionsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVpnConnectionsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListVpnConnectionsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1351,6 +2963,15 @@ func (p *ListVpnConnectionsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListVpnConnectionsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListVpnConnectionsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListVpnConnectionsParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1358,6 +2979,15 @@ func (p *ListVpnConnectionsParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *ListVpnConnectionsParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *ListVpnConnectionsParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListVpnConnectionsParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewListVpnConnectionsParams() *ListVpnConnectionsParams {
@@ -1368,6 +2998,11 @@ func (s *VPNService) NewListVpnConnectionsParams() *ListVpnConnectionsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnConnection, int, error) {
+	return s.GetVpnConnectionByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVpnConnectionByIDWithContext is the same as GetVpnConnectionByID, but uses ctx to cancel the request
+func (s *VPNService) GetVpnConnectionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnConnection, int, error) {
 	p := &ListVpnConnectionsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1379,18 +3014,16 @@ func (s *VPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnCo
 		}
 	}
 
-	l, err := s.ListVpnConnections(p)
+	l, err := s.ListVpnConnectionsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1401,7 +3034,16 @@ func (s *VPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnCo
 
 // Lists site to site vpn connection gateways
 func (s *VPNService) ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
-	resp, err := s.cs.newRequest("listVpnConnections", p.toURLValues())
+	return s.ListVpnConnectionsWithContext(context.Background(), p)
+}
+
+// ListVpnConnectionsWithContext is the same as ListVpnConnections, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) ListVpnConnectionsWithContext(ctx context.Context, p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVpnConnections", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1414,6 +3056,92 @@ func (s *VPNService) ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnCo
 	return &r, nil
 }
 
+// ListVpnConnectionsPager iterates over all pages of a ListVpnConnections call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVpnConnectionsPager struct {
+	pager
+
+	s *VPNService
+	p *ListVpnConnectionsParams
+	r *ListVpnConnectionsResponse
+}
+
+// NewListVpnConnectionsPager returns a pager for all pages of a ListVpnConnections call with the given params
+func (s *VPNService) NewListVpnConnectionsPager(p *ListVpnConnectionsParams) *ListVpnConnectionsPager {
+	return &ListVpnConnectionsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVpnConnectionsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVpnConnectionsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVpnConnectionsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVpnConnectionsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.VpnConnections), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVpnConnectionsPager) Page() *ListVpnConnectionsResponse {
+	return pg.r
+}
+
+// ListVpnConnectionsAll fetches all pages of a ListVpnConnections call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VPNService) ListVpnConnectionsAll(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
+	return s.ListVpnConnectionsAllWithContext(context.Background(), p)
+}
+
+// ListVpnConnectionsAllWithContext is the same as ListVpnConnectionsAll, but uses ctx to cancel the requests
+func (s *VPNService) ListVpnConnectionsAllWithContext(ctx context.Context, p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*VpnConnection)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVpnConnectionsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVpnConnectionsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.VpnConnections
+		mu.Unlock()
+
+		return len(l.VpnConnections), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVpnConnectionsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.VpnConnections = append(r.VpnConnections, pages[page]...)
+	}
+	r.Count = len(r.VpnConnections)
+
+	return r, nil
+}
+
 type ListVpnConnectionsResponse struct {
 	Count          int              `json:"count"`
 	VpnConnections []*VpnConnection `json:"vpnconnection"`
@@ -1453,7 +3181,8 @@ type ListVpnCustomerGatewaysParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListVpnCustomerGatewaysParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVpnCustomerGatewaysParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1492,6 +3221,57 @@ func (p *ListVpnCustomerGatewaysParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVpnCustomerGatewaysParams) Validate() error {
+	v := newParamValidator("listVpnCustomerGateways", p.p)
+	v.ids("domainid", "id", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVpnCustomerGatewaysParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVpnCustomerGatewaysParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "projectid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVpnCustomerGateways", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVpnCustomerGateways: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1499,6 +3279,15 @@ func (p *ListVpnCustomerGatewaysParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListVpnCustomerGatewaysParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1506,6 +3295,15 @@ func (p *ListVpnCustomerGatewaysParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListVpnCustomerGatewaysParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1513,6 +3311,15 @@ func (p *ListVpnCustomerGatewaysParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListVpnCustomerGatewaysParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1520,6 +3327,15 @@ func (p *ListVpnCustomerGatewaysParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListVpnCustomerGatewaysParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1527,6 +3343,15 @@ func (p *ListVpnCustomerGatewaysParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListVpnCustomerGatewaysParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1534,6 +3359,15 @@ func (p *ListVpnCustomerGatewaysParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListVpnCustomerGatewaysParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1541,6 +3375,15 @@ func (p *ListVpnCustomerGatewaysParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListVpnCustomerGatewaysParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1548,6 +3391,15 @@ func (p *ListVpnCustomerGatewaysParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListVpnCustomerGatewaysParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1555,6 +3407,15 @@ func (p *ListVpnCustomerGatewaysParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListVpnCustomerGatewaysParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListVpnCustomerGatewaysParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewListVpnCustomerGatewaysParams() *ListVpnCustomerGatewaysParams {
@@ -1565,6 +3426,11 @@ func (s *VPNService) NewListVpnCustomerGatewaysParams() *ListVpnCustomerGateways
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVpnCustomerGatewayIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetVpnCustomerGatewayIDWithContext is the same as GetVpnCustomerGatewayID, but uses ctx to cancel the request
+func (s *VPNService) GetVpnCustomerGatewayIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVpnCustomerGatewaysParams{}
 	p.p = make(map[string]interface{})
 
@@ -1576,7 +3442,7 @@ func (s *VPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListVpnCustomerGateways(p)
+	l, err := s.ListVpnCustomerGatewaysWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1601,12 +3467,17 @@ func (s *VPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnCustomerGatewayByName(name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
-	id, count, err := s.GetVpnCustomerGatewayID(name, opts...)
+	return s.GetVpnCustomerGatewayByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVpnCustomerGatewayByNameWithContext is the same as GetVpnCustomerGatewayByName, but uses ctx to cancel the requests
+func (s *VPNService) GetVpnCustomerGatewayByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
+	id, count, err := s.GetVpnCustomerGatewayIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVpnCustomerGatewayByID(id, opts...)
+	r, count, err := s.GetVpnCustomerGatewayByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1615,6 +3486,11 @@ func (s *VPNService) GetVpnCustomerGatewayByName(name string, opts ...OptionFunc
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
+	return s.GetVpnCustomerGatewayByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVpnCustomerGatewayByIDWithContext is the same as GetVpnCustomerGatewayByID, but uses ctx to cancel the request
+func (s *VPNService) GetVpnCustomerGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
 	p := &ListVpnCustomerGatewaysParams{}
 	p.p = make(map[string]interface{})
 
@@ -1626,18 +3502,16 @@ func (s *VPNService) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*
 		}
 	}
 
-	l, err := s.ListVpnCustomerGateways(p)
+	l, err := s.ListVpnCustomerGatewaysWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1648,7 +3522,16 @@ func (s *VPNService) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*
 
 // Lists site to site vpn customer gateways
 func (s *VPNService) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listVpnCustomerGateways", p.toURLValues())
+	return s.ListVpnCustomerGatewaysWithContext(context.Background(), p)
+}
+
+// ListVpnCustomerGatewaysWithContext is the same as ListVpnCustomerGateways, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) ListVpnCustomerGatewaysWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVpnCustomerGateways", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1661,6 +3544,92 @@ func (s *VPNService) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (
 	return &r, nil
 }
 
+// ListVpnCustomerGatewaysPager iterates over all pages of a ListVpnCustomerGateways call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVpnCustomerGatewaysPager struct {
+	pager
+
+	s *VPNService
+	p *ListVpnCustomerGatewaysParams
+	r *ListVpnCustomerGatewaysResponse
+}
+
+// NewListVpnCustomerGatewaysPager returns a pager for all pages of a ListVpnCustomerGateways call with the given params
+func (s *VPNService) NewListVpnCustomerGatewaysPager(p *ListVpnCustomerGatewaysParams) *ListVpnCustomerGatewaysPager {
+	return &ListVpnCustomerGatewaysPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVpnCustomerGatewaysPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVpnCustomerGatewaysPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVpnCustomerGatewaysParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVpnCustomerGatewaysWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.VpnCustomerGateways), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVpnCustomerGatewaysPager) Page() *ListVpnCustomerGatewaysResponse {
+	return pg.r
+}
+
+// ListVpnCustomerGatewaysAll fetches all pages of a ListVpnCustomerGateways call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VPNService) ListVpnCustomerGatewaysAll(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
+	return s.ListVpnCustomerGatewaysAllWithContext(context.Background(), p)
+}
+
+// ListVpnCustomerGatewaysAllWithContext is the same as ListVpnCustomerGatewaysAll, but uses ctx to cancel the requests
+func (s *VPNService) ListVpnCustomerGatewaysAllWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*VpnCustomerGateway)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVpnCustomerGatewaysParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVpnCustomerGatewaysWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.VpnCustomerGateways
+		mu.Unlock()
+
+		return len(l.VpnCustomerGateways), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVpnCustomerGatewaysResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.VpnCustomerGateways = append(r.VpnCustomerGateways, pages[page]...)
+	}
+	r.Count = len(r.VpnCustomerGateways)
+
+	return r, nil
+}
+
 type ListVpnCustomerGatewaysResponse struct {
 	Count               int                   `json:"count"`
 	VpnCustomerGateways []*VpnCustomerGateway `json:"vpncustomergateway"`
@@ -1695,7 +3664,8 @@ type ListVpnGatewaysParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListVpnGatewaysParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVpnGatewaysParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1741,6 +3711,57 @@ func (p *ListVpnGatewaysParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVpnGatewaysParams) Validate() error {
+	v := newParamValidator("listVpnGateways", p.p)
+	v.ids("domainid", "id", "projectid", "vpcid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVpnGatewaysParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVpnGatewaysParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "projectid", "vpcid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVpnGateways", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVpnGateways: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListVpnGatewaysParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1748,6 +3769,15 @@ func (p *ListVpnGatewaysParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListVpnGatewaysParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListVpnGatewaysParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListVpnGatewaysParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1755,6 +3785,15 @@ func (p *ListVpnGatewaysParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListVpnGatewaysParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListVpnGatewaysParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListVpnGatewaysParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1762,6 +3801,15 @@ func (p *ListVpnGatewaysParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *ListVpnGatewaysParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *ListVpnGatewaysParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *ListVpnGatewaysParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1769,6 +3817,15 @@ func (p *ListVpnGatewaysParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListVpnGatewaysParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListVpnGatewaysParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListVpnGatewaysParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1776,6 +3833,15 @@ func (p *ListVpnGatewaysParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListVpnGatewaysParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListVpnGatewaysParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListVpnGatewaysParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1783,6 +3849,15 @@ func (p *ListVpnGatewaysParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListVpnGatewaysParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVpnGatewaysParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListVpnGatewaysParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1790,6 +3865,15 @@ func (p *ListVpnGatewaysParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListVpnGatewaysParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListVpnGatewaysParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListVpnGatewaysParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1797,6 +3881,15 @@ func (p *ListVpnGatewaysParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListVpnGatewaysParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVpnGatewaysParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListVpnGatewaysParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1804,6 +3897,15 @@ func (p *ListVpnGatewaysParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListVpnGatewaysParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVpnGatewaysParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListVpnGatewaysParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1811,6 +3913,15 @@ func (p *ListVpnGatewaysParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListVpnGatewaysParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListVpnGatewaysParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListVpnGatewaysParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1818,6 +3929,15 @@ func (p *ListVpnGatewaysParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *ListVpnGatewaysParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *ListVpnGatewaysParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListVpnGatewaysParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewListVpnGatewaysParams() *ListVpnGatewaysParams {
@@ -1828,6 +3948,11 @@ func (s *VPNService) NewListVpnGatewaysParams() *ListVpnGatewaysParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGateway, int, error) {
+	return s.GetVpnGatewayByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVpnGatewayByIDWithContext is the same as GetVpnGatewayByID, but uses ctx to cancel the request
+func (s *VPNService) GetVpnGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnGateway, int, error) {
 	p := &ListVpnGatewaysParams{}
 	p.p = make(map[string]interface{})
 
@@ -1839,18 +3964,16 @@ func (s *VPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGatew
 		}
 	}
 
-	l, err := s.ListVpnGateways(p)
+	l, err := s.ListVpnGatewaysWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -1861,7 +3984,16 @@ func (s *VPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGatew
 
 // Lists site 2 site vpn gateways
 func (s *VPNService) ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listVpnGateways", p.toURLValues())
+	return s.ListVpnGatewaysWithContext(context.Background(), p)
+}
+
+// ListVpnGatewaysWithContext is the same as ListVpnGateways, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) ListVpnGatewaysWithContext(ctx context.Context, p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVpnGateways", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1874,6 +4006,92 @@ func (s *VPNService) ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGateways
 	return &r, nil
 }
 
+// ListVpnGatewaysPager iterates over all pages of a ListVpnGateways call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVpnGatewaysPager struct {
+	pager
+
+	s *VPNService
+	p *ListVpnGatewaysParams
+	r *ListVpnGatewaysResponse
+}
+
+// NewListVpnGatewaysPager returns a pager for all pages of a ListVpnGateways call with the given params
+func (s *VPNService) NewListVpnGatewaysPager(p *ListVpnGatewaysParams) *ListVpnGatewaysPager {
+	return &ListVpnGatewaysPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVpnGatewaysPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVpnGatewaysPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVpnGatewaysParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVpnGatewaysWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.VpnGateways), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVpnGatewaysPager) Page() *ListVpnGatewaysResponse {
+	return pg.r
+}
+
+// ListVpnGatewaysAll fetches all pages of a ListVpnGateways call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VPNService) ListVpnGatewaysAll(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
+	return s.ListVpnGatewaysAllWithContext(context.Background(), p)
+}
+
+// ListVpnGatewaysAllWithContext is the same as ListVpnGatewaysAll, but uses ctx to cancel the requests
+func (s *VPNService) ListVpnGatewaysAllWithContext(ctx context.Context, p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*VpnGateway)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVpnGatewaysParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVpnGatewaysWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.VpnGateways
+		mu.Unlock()
+
+		return len(l.VpnGateways), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVpnGatewaysResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.VpnGateways = append(r.VpnGateways, pages[page]...)
+	}
+	r.Count = len(r.VpnGateways)
+
+	return r, nil
+}
+
 type ListVpnGatewaysResponse struct {
 	Count       int           `json:"count"`
 	VpnGateways []*VpnGateway `json:"vpngateway"`
@@ -1899,7 +4117,8 @@ type ListVpnUsersParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListVpnUsersParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVpnUsersParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1941,6 +4160,58 @@ func (p *ListVpnUsersParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVpnUsersParams) Validate() error {
+	v := newParamValidator("listVpnUsers", p.p)
+	v.ids("domainid", "id", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVpnUsersParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVpnUsersParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id", "keyword", "projectid", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVpnUsers", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVpnUsers: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListVpnUsersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1948,6 +4219,15 @@ func (p *ListVpnUsersParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListVpnUsersParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListVpnUsersParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListVpnUsersParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1955,6 +4235,15 @@ func (p *ListVpnUsersParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListVpnUsersParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListVpnUsersParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListVpnUsersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1962,6 +4251,15 @@ func (p *ListVpnUsersParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListVpnUsersParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListVpnUsersParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListVpnUsersParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1969,6 +4267,15 @@ func (p *ListVpnUsersParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListVpnUsersParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListVpnUsersParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListVpnUsersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1976,6 +4283,15 @@ func (p *ListVpnUsersParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListVpnUsersParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVpnUsersParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListVpnUsersParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1983,6 +4299,15 @@ func (p *ListVpnUsersParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListVpnUsersParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListVpnUsersParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListVpnUsersParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1990,6 +4315,15 @@ func (p *ListVpnUsersParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListVpnUsersParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVpnUsersParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListVpnUsersParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1997,6 +4331,15 @@ func (p *ListVpnUsersParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListVpnUsersParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVpnUsersParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListVpnUsersParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2004,6 +4347,15 @@ func (p *ListVpnUsersParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListVpnUsersParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListVpnUsersParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListVpnUsersParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2011,6 +4363,15 @@ func (p *ListVpnUsersParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *ListVpnUsersParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *ListVpnUsersParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListVpnUsersParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewListVpnUsersParams() *ListVpnUsersParams {
@@ -2021,6 +4382,11 @@ func (s *VPNService) NewListVpnUsersParams() *ListVpnUsersParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error) {
+	return s.GetVpnUserByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVpnUserByIDWithContext is the same as GetVpnUserByID, but uses ctx to cancel the request
+func (s *VPNService) GetVpnUserByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnUser, int, error) {
 	p := &ListVpnUsersParams{}
 	p.p = make(map[string]interface{})
 
@@ -2032,18 +4398,16 @@ func (s *VPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, in
 		}
 	}
 
-	l, err := s.ListVpnUsers(p)
+	l, err := s.ListVpnUsersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -2054,7 +4418,16 @@ func (s *VPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, in
 
 // Lists vpn users
 func (s *VPNService) ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
-	resp, err := s.cs.newRequest("listVpnUsers", p.toURLValues())
+	return s.ListVpnUsersWithContext(context.Background(), p)
+}
+
+// ListVpnUsersWithContext is the same as ListVpnUsers, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) ListVpnUsersWithContext(ctx context.Context, p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVpnUsers", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2067,6 +4440,92 @@ func (s *VPNService) ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse,
 	return &r, nil
 }
 
+// ListVpnUsersPager iterates over all pages of a ListVpnUsers call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVpnUsersPager struct {
+	pager
+
+	s *VPNService
+	p *ListVpnUsersParams
+	r *ListVpnUsersResponse
+}
+
+// NewListVpnUsersPager returns a pager for all pages of a ListVpnUsers call with the given params
+func (s *VPNService) NewListVpnUsersPager(p *ListVpnUsersParams) *ListVpnUsersPager {
+	return &ListVpnUsersPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVpnUsersPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVpnUsersPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVpnUsersParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVpnUsersWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.VpnUsers), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVpnUsersPager) Page() *ListVpnUsersResponse {
+	return pg.r
+}
+
+// ListVpnUsersAll fetches all pages of a ListVpnUsers call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VPNService) ListVpnUsersAll(p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
+	return s.ListVpnUsersAllWithContext(context.Background(), p)
+}
+
+// ListVpnUsersAllWithContext is the same as ListVpnUsersAll, but uses ctx to cancel the requests
+func (s *VPNService) ListVpnUsersAllWithContext(ctx context.Context, p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*VpnUser)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVpnUsersParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVpnUsersWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.VpnUsers
+		mu.Unlock()
+
+		return len(l.VpnUsers), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVpnUsersResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.VpnUsers = append(r.VpnUsers, pages[page]...)
+	}
+	r.Count = len(r.VpnUsers)
+
+	return r, nil
+}
+
 type ListVpnUsersResponse struct {
 	Count    int        `json:"count"`
 	VpnUsers []*VpnUser `json:"vpnuser"`
@@ -2089,7 +4548,8 @@ type RemoveVpnUserParams struct {
 	p map[string]interface{}
 }
 
-func (p *RemoveVpnUserParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RemoveVpnUserParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2109,6 +4569,50 @@ func (p *RemoveVpnUserParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RemoveVpnUserParams) Validate() error {
+	v := newParamValidator("removeVpnUser", p.p)
+	v.required("username")
+	v.ids("domainid", "projectid")
+	v.maxLength("account", 255)
+	v.maxLength("username", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RemoveVpnUserParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RemoveVpnUserParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "projectid", "username":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for removeVpnUser", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of removeVpnUser: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveVpnUserParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2116,6 +4620,15 @@ func (p *RemoveVpnUserParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *RemoveVpnUserParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *RemoveVpnUserParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *RemoveVpnUserParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2123,6 +4636,15 @@ func (p *RemoveVpnUserParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *RemoveVpnUserParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *RemoveVpnUserParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *RemoveVpnUserParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2130,6 +4652,15 @@ func (p *RemoveVpnUserParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *RemoveVpnUserParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *RemoveVpnUserParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *RemoveVpnUserParams) SetUsername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2137,6 +4668,15 @@ func (p *RemoveVpnUserParams) SetUsername(v string) {
 	p.p["username"] = v
 }
 
+func (p *RemoveVpnUserParams) ResetUsername() {
+	delete(p.p, "username")
+}
+
+func (p *RemoveVpnUserParams) GetUsername() (string, bool) {
+	value, ok := p.p["username"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RemoveVpnUserParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewRemoveVpnUserParams(username string) *RemoveVpnUserParams {
@@ -2148,7 +4688,16 @@ func (s *VPNService) NewRemoveVpnUserParams(username string) *RemoveVpnUserParam
 
 // Removes vpn user
 func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error) {
-	resp, err := s.cs.newRequest("removeVpnUser", p.toURLValues())
+	return s.RemoveVpnUserWithContext(context.Background(), p)
+}
+
+// RemoveVpnUserWithContext is the same as RemoveVpnUser, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) RemoveVpnUserWithContext(ctx context.Context, p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeVpnUser", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2160,7 +4709,7 @@ func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2176,6 +4725,31 @@ func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserRespon
 	return &r, nil
 }
 
+// RemoveVpnUserAsync starts the async job for RemoveVpnUser and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RemoveVpnUserResponse using Job.Result
+func (s *VPNService) RemoveVpnUserAsync(p *RemoveVpnUserParams) (*Job, error) {
+	return s.RemoveVpnUserAsyncWithContext(context.Background(), p)
+}
+
+// RemoveVpnUserAsyncWithContext is the same as RemoveVpnUserAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) RemoveVpnUserAsyncWithContext(ctx context.Context, p *RemoveVpnUserParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeVpnUser", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveVpnUserResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type RemoveVpnUserResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -2187,7 +4761,8 @@ type ResetVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
-func (p *ResetVpnConnectionParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ResetVpnConnectionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2204,6 +4779,49 @@ func (p *ResetVpnConnectionParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ResetVpnConnectionParams) Validate() error {
+	v := newParamValidator("resetVpnConnection", p.p)
+	v.required("id")
+	v.ids("domainid", "id")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ResetVpnConnectionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ResetVpnConnectionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for resetVpnConnection", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of resetVpnConnection: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ResetVpnConnectionParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2211,6 +4829,15 @@ func (p *ResetVpnConnectionParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ResetVpnConnectionParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ResetVpnConnectionParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ResetVpnConnectionParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2218,6 +4845,15 @@ func (p *ResetVpnConnectionParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ResetVpnConnectionParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ResetVpnConnectionParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ResetVpnConnectionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2225,6 +4861,15 @@ func (p *ResetVpnConnectionParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ResetVpnConnectionParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ResetVpnConnectionParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ResetVpnConnectionParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewResetVpnConnectionParams(id string) *ResetVpnConnectionParams {
@@ -2236,7 +4881,16 @@ func (s *VPNService) NewResetVpnConnectionParams(id string) *ResetVpnConnectionP
 
 // Reset site to site vpn connection
 func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("resetVpnConnection", p.toURLValues())
+	return s.ResetVpnConnectionWithContext(context.Background(), p)
+}
+
+// ResetVpnConnectionWithContext is the same as ResetVpnConnection, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) ResetVpnConnectionWithContext(ctx context.Context, p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "resetVpnConnection", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2248,7 +4902,7 @@ func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnC
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2264,9 +4918,43 @@ func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnC
 		if err := json.Unmarshal(b, &r); err != nil {
 			return nil, err
 		}
-	}
+	}
+
+	return &r, nil
+}
+
+// ResetVpnConnectionAsync starts the async job for ResetVpnConnection and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ResetVpnConnectionResponse using Job.Result
+func (s *VPNService) ResetVpnConnectionAsync(p *ResetVpnConnectionParams) (*Job, error) {
+	return s.ResetVpnConnectionAsyncWithContext(context.Background(), p)
+}
+
+// ResetVpnConnectionAsyncWithContext is the same as ResetVpnConnectionAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) ResetVpnConnectionAsyncWithContext(ctx context.Context, p *ResetVpnConnectionParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "resetVpnConnection", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ResetVpnConnectionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
 
-	return &r, nil
+		return b, nil
+	}), nil
 }
 
 type ResetVpnConnectionResponse struct {
@@ -2303,7 +4991,8 @@ type UpdateRemoteAccessVpnParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateRemoteAccessVpnParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateRemoteAccessVpnParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2321,6 +5010,53 @@ func (p *UpdateRemoteAccessVpnParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateRemoteAccessVpnParams) Validate() error {
+	v := newParamValidator("updateRemoteAccessVpn", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("customid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateRemoteAccessVpnParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateRemoteAccessVpnParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateRemoteAccessVpn", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateRemoteAccessVpn: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateRemoteAccessVpnParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2328,6 +5064,15 @@ func (p *UpdateRemoteAccessVpnParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateRemoteAccessVpnParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateRemoteAccessVpnParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateRemoteAccessVpnParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2335,6 +5080,15 @@ func (p *UpdateRemoteAccessVpnParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateRemoteAccessVpnParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateRemoteAccessVpnParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateRemoteAccessVpnParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2342,6 +5096,15 @@ func (p *UpdateRemoteAccessVpnParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateRemoteAccessVpnParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateRemoteAccessVpnParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateRemoteAccessVpnParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewUpdateRemoteAccessVpnParams(id string) *UpdateRemoteAccessVpnParams {
@@ -2353,7 +5116,16 @@ func (s *VPNService) NewUpdateRemoteAccessVpnParams(id string) *UpdateRemoteAcce
 
 // Updates remote access vpn
 func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("updateRemoteAccessVpn", p.toURLValues())
+	return s.UpdateRemoteAccessVpnWithContext(context.Background(), p)
+}
+
+// UpdateRemoteAccessVpnWithContext is the same as UpdateRemoteAccessVpn, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) UpdateRemoteAccessVpnWithContext(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateRemoteAccessVpn", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2365,7 +5137,7 @@ func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*Upd
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2386,6 +5158,40 @@ func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*Upd
 	return &r, nil
 }
 
+// UpdateRemoteAccessVpnAsync starts the async job for UpdateRemoteAccessVpn and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateRemoteAccessVpnResponse using Job.Result
+func (s *VPNService) UpdateRemoteAccessVpnAsync(p *UpdateRemoteAccessVpnParams) (*Job, error) {
+	return s.UpdateRemoteAccessVpnAsyncWithContext(context.Background(), p)
+}
+
+// UpdateRemoteAccessVpnAsyncWithContext is the same as UpdateRemoteAccessVpnAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) UpdateRemoteAccessVpnAsyncWithContext(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateRemoteAccessVpn", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateRemoteAccessVpnResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateRemoteAccessVpnResponse struct {
 	Account      string `json:"account"`
 	Domain       string `json:"domain"`
@@ -2407,7 +5213,8 @@ type UpdateVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateVpnConnectionParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateVpnConnectionParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2425,6 +5232,53 @@ func (p *UpdateVpnConnectionParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateVpnConnectionParams) Validate() error {
+	v := newParamValidator("updateVpnConnection", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("customid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateVpnConnectionParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateVpnConnectionParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateVpnConnection", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateVpnConnection: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVpnConnectionParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2432,6 +5286,15 @@ func (p *UpdateVpnConnectionParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateVpnConnectionParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateVpnConnectionParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateVpnConnectionParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2439,6 +5302,15 @@ func (p *UpdateVpnConnectionParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateVpnConnectionParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateVpnConnectionParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateVpnConnectionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2446,6 +5318,15 @@ func (p *UpdateVpnConnectionParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateVpnConnectionParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateVpnConnectionParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateVpnConnectionParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewUpdateVpnConnectionParams(id string) *UpdateVpnConnectionParams {
@@ -2457,7 +5338,16 @@ func (s *VPNService) NewUpdateVpnConnectionParams(id string) *UpdateVpnConnectio
 
 // Updates site to site vpn connection
 func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnConnection", p.toURLValues())
+	return s.UpdateVpnConnectionWithContext(context.Background(), p)
+}
+
+// UpdateVpnConnectionWithContext is the same as UpdateVpnConnection, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) UpdateVpnConnectionWithContext(ctx context.Context, p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVpnConnection", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2469,7 +5359,7 @@ func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateV
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2490,6 +5380,40 @@ func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateV
 	return &r, nil
 }
 
+// UpdateVpnConnectionAsync starts the async job for UpdateVpnConnection and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateVpnConnectionResponse using Job.Result
+func (s *VPNService) UpdateVpnConnectionAsync(p *UpdateVpnConnectionParams) (*Job, error) {
+	return s.UpdateVpnConnectionAsyncWithContext(context.Background(), p)
+}
+
+// UpdateVpnConnectionAsyncWithContext is the same as UpdateVpnConnectionAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) UpdateVpnConnectionAsyncWithContext(ctx context.Context, p *UpdateVpnConnectionParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVpnConnection", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVpnConnectionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateVpnConnectionResponse struct {
 	Account              string `json:"account"`
 	Cidrlist             string `json:"cidrlist"`
@@ -2524,7 +5448,8 @@ type UpdateVpnCustomerGatewayParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateVpnCustomerGatewayParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateVpnCustomerGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2582,6 +5507,65 @@ func (p *UpdateVpnCustomerGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateVpnCustomerGatewayParams) Validate() error {
+	v := newParamValidator("updateVpnCustomerGateway", p.p)
+	v.required("cidrlist", "esppolicy", "gateway", "id", "ikepolicy", "ipsecpsk")
+	v.ids("domainid", "id")
+	v.maxLength("account", 255)
+	v.maxLength("cidrlist", 255)
+	v.maxLength("esppolicy", 255)
+	v.maxLength("gateway", 255)
+	v.maxLength("ikepolicy", 255)
+	v.maxLength("ikeversion", 255)
+	v.oneOf("ikeversion", "ike", "ikev1", "ikev2")
+	v.maxLength("ipsecpsk", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateVpnCustomerGatewayParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateVpnCustomerGatewayParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "cidrlist", "domainid", "esppolicy", "gateway", "id", "ikepolicy", "ikeversion", "ipsecpsk", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "dpd", "forceencap", "splitconnections":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "esplifetime", "ikelifetime":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateVpnCustomerGateway", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateVpnCustomerGateway: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2589,6 +5573,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetCidrlist(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2596,6 +5589,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetCidrlist(v string) {
 	p.p["cidrlist"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetCidrlist() {
+	delete(p.p, "cidrlist")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetCidrlist() (string, bool) {
+	value, ok := p.p["cidrlist"].(string)
+	return value, ok
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2603,6 +5605,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetDpd(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2610,6 +5621,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetDpd(v bool) {
 	p.p["dpd"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetDpd() {
+	delete(p.p, "dpd")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetDpd() (bool, bool) {
+	value, ok := p.p["dpd"].(bool)
+	return value, ok
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetEsplifetime(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2617,6 +5637,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetEsplifetime(v int64) {
 	p.p["esplifetime"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetEsplifetime() {
+	delete(p.p, "esplifetime")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetEsplifetime() (int64, bool) {
+	value, ok := p.p["esplifetime"].(int64)
+	return value, ok
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetEsppolicy(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2624,6 +5653,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetEsppolicy(v string) {
 	p.p["esppolicy"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetEsppolicy() {
+	delete(p.p, "esppolicy")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetEsppolicy() (string, bool) {
+	value, ok := p.p["esppolicy"].(string)
+	return value, ok
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetForceencap(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2631,6 +5669,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetForceencap(v bool) {
 	p.p["forceencap"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetForceencap() {
+	delete(p.p, "forceencap")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetForceencap() (bool, bool) {
+	value, ok := p.p["forceencap"].(bool)
+	return value, ok
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetGateway(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2638,6 +5685,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetGateway(v string) {
 	p.p["gateway"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetGateway() {
+	delete(p.p, "gateway")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetGateway() (string, bool) {
+	value, ok := p.p["gateway"].(string)
+	return value, ok
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2645,6 +5701,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetIkelifetime(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2652,6 +5717,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetIkelifetime(v int64) {
 	p.p["ikelifetime"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetIkelifetime() {
+	delete(p.p, "ikelifetime")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetIkelifetime() (int64, bool) {
+	value, ok := p.p["ikelifetime"].(int64)
+	return value, ok
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetIkepolicy(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2659,6 +5733,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetIkepolicy(v string) {
 	p.p["ikepolicy"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetIkepolicy() {
+	delete(p.p, "ikepolicy")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetIkepolicy() (string, bool) {
+	value, ok := p.p["ikepolicy"].(string)
+	return value, ok
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetIkeversion(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2666,6 +5749,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetIkeversion(v string) {
 	p.p["ikeversion"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetIkeversion() {
+	delete(p.p, "ikeversion")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetIkeversion() (string, bool) {
+	value, ok := p.p["ikeversion"].(string)
+	return value, ok
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetIpsecpsk(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2673,6 +5765,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetIpsecpsk(v string) {
 	p.p["ipsecpsk"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetIpsecpsk() {
+	delete(p.p, "ipsecpsk")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetIpsecpsk() (string, bool) {
+	value, ok := p.p["ipsecpsk"].(string)
+	return value, ok
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2680,6 +5781,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetSplitconnections(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2687,6 +5797,15 @@ func (p *UpdateVpnCustomerGatewayParams) SetSplitconnections(v bool) {
 	p.p["splitconnections"] = v
 }
 
+func (p *UpdateVpnCustomerGatewayParams) ResetSplitconnections() {
+	delete(p.p, "splitconnections")
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetSplitconnections() (bool, bool) {
+	value, ok := p.p["splitconnections"].(bool)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateVpnCustomerGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string) *UpdateVpnCustomerGatewayParams {
@@ -2703,7 +5822,16 @@ func (s *VPNService) NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolic
 
 // Update site to site vpn customer gateway
 func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnCustomerGateway", p.toURLValues())
+	return s.UpdateVpnCustomerGatewayWithContext(context.Background(), p)
+}
+
+// UpdateVpnCustomerGatewayWithContext is the same as UpdateVpnCustomerGateway, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) UpdateVpnCustomerGatewayWithContext(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVpnCustomerGateway", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2715,7 +5843,7 @@ func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2736,6 +5864,40 @@ func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams)
 	return &r, nil
 }
 
+// UpdateVpnCustomerGatewayAsync starts the async job for UpdateVpnCustomerGateway and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateVpnCustomerGatewayResponse using Job.Result
+func (s *VPNService) UpdateVpnCustomerGatewayAsync(p *UpdateVpnCustomerGatewayParams) (*Job, error) {
+	return s.UpdateVpnCustomerGatewayAsyncWithContext(context.Background(), p)
+}
+
+// UpdateVpnCustomerGatewayAsyncWithContext is the same as UpdateVpnCustomerGatewayAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) UpdateVpnCustomerGatewayAsyncWithContext(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVpnCustomerGateway", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVpnCustomerGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateVpnCustomerGatewayResponse struct {
 	Account          string `json:"account"`
 	Cidrlist         string `json:"cidrlist"`
@@ -2765,7 +5927,8 @@ type UpdateVpnGatewayParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateVpnGatewayParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateVpnGatewayParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2783,6 +5946,53 @@ func (p *UpdateVpnGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateVpnGatewayParams) Validate() error {
+	v := newParamValidator("updateVpnGateway", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("customid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateVpnGatewayParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateVpnGatewayParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "customid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "fordisplay":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateVpnGateway", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateVpnGateway: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVpnGatewayParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2790,6 +6000,15 @@ func (p *UpdateVpnGatewayParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateVpnGatewayParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateVpnGatewayParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateVpnGatewayParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2797,6 +6016,15 @@ func (p *UpdateVpnGatewayParams) SetFordisplay(v bool) {
 	p.p["fordisplay"] = v
 }
 
+func (p *UpdateVpnGatewayParams) ResetFordisplay() {
+	delete(p.p, "fordisplay")
+}
+
+func (p *UpdateVpnGatewayParams) GetFordisplay() (bool, bool) {
+	value, ok := p.p["fordisplay"].(bool)
+	return value, ok
+}
+
 func (p *UpdateVpnGatewayParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2804,6 +6032,15 @@ func (p *UpdateVpnGatewayParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateVpnGatewayParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateVpnGatewayParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateVpnGatewayParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewUpdateVpnGatewayParams(id string) *UpdateVpnGatewayParams {
@@ -2815,7 +6052,16 @@ func (s *VPNService) NewUpdateVpnGatewayParams(id string) *UpdateVpnGatewayParam
 
 // Updates site to site vpn local gateway
 func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnGateway", p.toURLValues())
+	return s.UpdateVpnGatewayWithContext(context.Background(), p)
+}
+
+// UpdateVpnGatewayWithContext is the same as UpdateVpnGateway, but uses ctx to cancel the request and any async job polling
+func (s *VPNService) UpdateVpnGatewayWithContext(ctx context.Context, p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVpnGateway", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2827,7 +6073,7 @@ func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGate
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2848,6 +6094,40 @@ func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGate
 	return &r, nil
 }
 
+// UpdateVpnGatewayAsync starts the async job for UpdateVpnGateway and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateVpnGatewayResponse using Job.Result
+func (s *VPNService) UpdateVpnGatewayAsync(p *UpdateVpnGatewayParams) (*Job, error) {
+	return s.UpdateVpnGatewayAsyncWithContext(context.Background(), p)
+}
+
+// UpdateVpnGatewayAsyncWithContext is the same as UpdateVpnGatewayAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VPNService) UpdateVpnGatewayAsyncWithContext(ctx context.Context, p *UpdateVpnGatewayParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVpnGateway", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVpnGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateVpnGatewayResponse struct {
 	Account    string `json:"account"`
 	Domain     string `json:"domain"`
@@ -0,0 +1,186 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// Validator is implemented by all generated params types. Validate checks the params against
+// the API spec, and returns a ValidationErrors containing every invalid param.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError is returned when the params of a command are invalid
+type ValidationError struct {
+	Command string // The API command
+	Param   string // The name of the invalid param
+	Reason  string // The reason why the param is invalid
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("Invalid params for %s: %s %s", e.Command, e.Param, e.Reason)
+}
+
+// ValidationErrors is returned by Validate when one or more params of a command are invalid.
+// It contains an error for every failed check, in the order in which the params are checked.
+// Use errors.As with a *ValidationError to get the first error.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	if len(e) == 0 {
+		return "Invalid params"
+	}
+
+	reasons := make([]string, len(e))
+	for i, err := range e {
+		reasons[i] = err.Param + " " + err.Reason
+	}
+	return fmt.Sprintf("Invalid params for %s: %s", e[0].Command, strings.Join(reasons, "; "))
+}
+
+// As makes errors.As find the first *ValidationError
+func (e ValidationErrors) As(target interface{}) bool {
+	if t, ok := target.(**ValidationError); ok && len(e) > 0 {
+		*t = e[0]
+		return true
+	}
+	return false
+}
+
+// WithValidation validates the params of every API command before the request is sent, so
+// invalid params are reported locally instead of by CloudStack. The params are checked for
+// missing required params, empty or invalid IDs, too long strings and unknown enum values.
+func WithValidation() ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.validate = true
+	}
+}
+
+// Validates p if the client is created using WithValidation
+func (cs *CloudStackClient) validateParams(p Validator) error {
+	if !cs.validate {
+		return nil
+	}
+	return p.Validate()
+}
+
+// paramValidator is used by the generated Validate methods. It collects all errors found, so
+// the checks can be chained without checking the error after every check.
+type paramValidator struct {
+	command string
+	params  map[string]interface{}
+	errs    ValidationErrors
+}
+
+func newParamValidator(command string, params map[string]interface{}) *paramValidator {
+	return &paramValidator{command: command, params: params}
+}
+
+// Adds an error for the param, unless an earlier check of the param already failed
+func (v *paramValidator) fail(param string, format string, args ...interface{}) {
+	for _, err := range v.errs {
+		if err.Param == param {
+			return
+		}
+	}
+	v.errs = append(v.errs, &ValidationError{Command: v.command, Param: param, Reason: fmt.Sprintf(format, args...)})
+}
+
+// Returns the collected errors, or nil if all checks passed
+func (v *paramValidator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// Checks that the params are set and not empty
+func (v *paramValidator) required(params ...string) {
+	for _, param := range params {
+		value, found := v.params[param]
+		if !found {
+			v.fail(param, "is required")
+			continue
+		}
+
+		rv := reflect.ValueOf(value)
+		switch rv.Kind() {
+		case reflect.String, reflect.Slice, reflect.Map:
+			if rv.Len() == 0 {
+				v.fail(param, "is required and must not be empty")
+			}
+		}
+	}
+}
+
+// Checks that the params are valid IDs (a UUID, or the numeric internal ID that is also
+// accepted by CloudStack), if they are set
+func (v *paramValidator) ids(params ...string) {
+	for _, param := range params {
+		value, ok := v.params[param].(string)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case value == "":
+			v.fail(param, "must not be empty")
+		case !IsID(value) && !isInternalID(value):
+			v.fail(param, "is not a valid ID: %q", value)
+		}
+	}
+}
+
+// Checks that the param is at most length characters long, if it is set
+func (v *paramValidator) maxLength(param string, length int) {
+	if value, ok := v.params[param].(string); ok && utf8.RuneCountInString(value) > length {
+		v.fail(param, "is longer than %d characters", length)
+	}
+}
+
+// Checks that the param is one of the values (case-insensitive), if it is set
+func (v *paramValidator) oneOf(param string, values ...string) {
+	value, ok := v.params[param].(string)
+	if !ok {
+		return
+	}
+
+	for _, allowed := range values {
+		if strings.EqualFold(value, allowed) {
+			return
+		}
+	}
+	v.fail(param, "must be one of %s, got %q", strings.Join(values, ", "), value)
+}
+
+// Returns true if id is a numeric internal ID
+func isInternalID(id string) bool {
+	for _, c := range id {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return id != ""
+}
@@ -0,0 +1,87 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	const uuid = "2b7a1f1c-5f0a-4c0e-9a64-3a8e4f0c1d2e"
+
+	tests := []struct {
+		name      string
+		params    func(p *DeployVirtualMachineParams)
+		wantErr   string
+		wantParam string // The param of the first error
+	}{
+		{
+			name:   "valid",
+			params: func(p *DeployVirtualMachineParams) {},
+		},
+		{
+			name:   "numeric internal IDs",
+			params: func(p *DeployVirtualMachineParams) { p.SetZoneid("1"); p.SetHostid("42") },
+		},
+		{
+			name:      "invalid ID",
+			params:    func(p *DeployVirtualMachineParams) { p.SetZoneid("zone1") },
+			wantErr:   `Invalid params for deployVirtualMachine: zoneid is not a valid ID: "zone1"`,
+			wantParam: "zoneid",
+		},
+		{
+			name: "all failures",
+			params: func(p *DeployVirtualMachineParams) {
+				p.SetTemplateid("")
+				p.SetHostid("-2")
+				p.SetKeyboard("azerty")
+			},
+			wantErr: `Invalid params for deployVirtualMachine: templateid is required and must not be empty; ` +
+				`hostid is not a valid ID: "-2"; keyboard must be one of de, de-ch, es, fi, fr, fr-be, fr-ch, is, it, jp, nl-be, no, pt, uk, us, got "azerty"`,
+			wantParam: "templateid",
+		},
+	}
+
+	cs := NewAsyncClient("http://localhost/client/api", "key", "secret", false)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := cs.VirtualMachine.NewDeployVirtualMachineParams(uuid, uuid, uuid)
+			tt.params(p)
+
+			err := p.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("Expected error %q, got %v", tt.wantErr, err)
+			}
+
+			var verr *ValidationError
+			if !errors.As(err, &verr) || verr.Param != tt.wantParam {
+				t.Errorf("Expected errors.As to find the first *ValidationError, got %+v", verr)
+			}
+		})
+	}
+}
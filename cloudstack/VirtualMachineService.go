@@ -20,18 +20,156 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// VirtualMachineServiceIface is the interface implemented by VirtualMachineService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type VirtualMachineServiceIface interface {
+	NewAddNicToVirtualMachineParams(networkid string, virtualmachineid string) *AddNicToVirtualMachineParams
+	AddNicToVirtualMachine(p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error)
+	AddNicToVirtualMachineWithContext(ctx context.Context, p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error)
+	AddNicToVirtualMachineAsync(p *AddNicToVirtualMachineParams) (*Job, error)
+	AddNicToVirtualMachineAsyncWithContext(ctx context.Context, p *AddNicToVirtualMachineParams) (*Job, error)
+	NewAssignVirtualMachineParams(virtualmachineid string) *AssignVirtualMachineParams
+	AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error)
+	AssignVirtualMachineWithContext(ctx context.Context, p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error)
+	NewChangeServiceForVirtualMachineParams(id string, serviceofferingid string) *ChangeServiceForVirtualMachineParams
+	ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error)
+	ChangeServiceForVirtualMachineWithContext(ctx context.Context, p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error)
+	NewCleanVMReservationsParams() *CleanVMReservationsParams
+	CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error)
+	CleanVMReservationsWithContext(ctx context.Context, p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error)
+	CleanVMReservationsAsync(p *CleanVMReservationsParams) (*Job, error)
+	CleanVMReservationsAsyncWithContext(ctx context.Context, p *CleanVMReservationsParams) (*Job, error)
+	NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string) *DeployVirtualMachineParams
+	DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error)
+	DeployVirtualMachineWithContext(ctx context.Context, p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error)
+	DeployVirtualMachineAsync(p *DeployVirtualMachineParams) (*Job, error)
+	DeployVirtualMachineAsyncWithContext(ctx context.Context, p *DeployVirtualMachineParams) (*Job, error)
+	NewDestroyVirtualMachineParams(id string) *DestroyVirtualMachineParams
+	DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error)
+	DestroyVirtualMachineWithContext(ctx context.Context, p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error)
+	DestroyVirtualMachineAsync(p *DestroyVirtualMachineParams) (*Job, error)
+	DestroyVirtualMachineAsyncWithContext(ctx context.Context, p *DestroyVirtualMachineParams) (*Job, error)
+	NewExpungeVirtualMachineParams(id string) *ExpungeVirtualMachineParams
+	ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error)
+	ExpungeVirtualMachineWithContext(ctx context.Context, p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error)
+	ExpungeVirtualMachineAsync(p *ExpungeVirtualMachineParams) (*Job, error)
+	ExpungeVirtualMachineAsyncWithContext(ctx context.Context, p *ExpungeVirtualMachineParams) (*Job, error)
+	NewGetVMPasswordParams(id string) *GetVMPasswordParams
+	GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error)
+	GetVMPasswordWithContext(ctx context.Context, p *GetVMPasswordParams) (*GetVMPasswordResponse, error)
+	NewImportUnmanagedInstanceParams(clusterid string, name string, serviceofferingid string) *ImportUnmanagedInstanceParams
+	ImportUnmanagedInstance(p *ImportUnmanagedInstanceParams) (*ImportUnmanagedInstanceResponse, error)
+	ImportUnmanagedInstanceWithContext(ctx context.Context, p *ImportUnmanagedInstanceParams) (*ImportUnmanagedInstanceResponse, error)
+	ImportUnmanagedInstanceAsync(p *ImportUnmanagedInstanceParams) (*Job, error)
+	ImportUnmanagedInstanceAsyncWithContext(ctx context.Context, p *ImportUnmanagedInstanceParams) (*Job, error)
+	NewListUnmanagedInstancesParams(clusterid string) *ListUnmanagedInstancesParams
+	ListUnmanagedInstances(p *ListUnmanagedInstancesParams) (*ListUnmanagedInstancesResponse, error)
+	ListUnmanagedInstancesWithContext(ctx context.Context, p *ListUnmanagedInstancesParams) (*ListUnmanagedInstancesResponse, error)
+	NewListUnmanagedInstancesPager(p *ListUnmanagedInstancesParams) *ListUnmanagedInstancesPager
+	ListUnmanagedInstancesAll(p *ListUnmanagedInstancesParams) (*ListUnmanagedInstancesResponse, error)
+	ListUnmanagedInstancesAllWithContext(ctx context.Context, p *ListUnmanagedInstancesParams) (*ListUnmanagedInstancesResponse, error)
+	NewListVirtualMachinesParams() *ListVirtualMachinesParams
+	GetVirtualMachineID(name string, opts ...OptionFunc) (string, int, error)
+	GetVirtualMachineIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetVirtualMachineByName(name string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	GetVirtualMachineByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	GetVirtualMachineByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	GetVirtualMachineByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error)
+	ListVirtualMachinesWithContext(ctx context.Context, p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error)
+	NewListVirtualMachinesPager(p *ListVirtualMachinesParams) *ListVirtualMachinesPager
+	ListVirtualMachinesAll(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error)
+	ListVirtualMachinesAllWithContext(ctx context.Context, p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error)
+	NewListVirtualMachinesMetricsParams() *ListVirtualMachinesMetricsParams
+	GetVirtualMachinesMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetVirtualMachinesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetVirtualMachinesMetricByName(name string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error)
+	GetVirtualMachinesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error)
+	GetVirtualMachinesMetricByID(id string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error)
+	GetVirtualMachinesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error)
+	ListVirtualMachinesMetrics(p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error)
+	ListVirtualMachinesMetricsWithContext(ctx context.Context, p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error)
+	NewListVirtualMachinesMetricsPager(p *ListVirtualMachinesMetricsParams) *ListVirtualMachinesMetricsPager
+	ListVirtualMachinesMetricsAll(p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error)
+	ListVirtualMachinesMetricsAllWithContext(ctx context.Context, p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error)
+	NewMigrateVirtualMachineParams(virtualmachineid string) *MigrateVirtualMachineParams
+	MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error)
+	MigrateVirtualMachineWithContext(ctx context.Context, p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error)
+	MigrateVirtualMachineAsync(p *MigrateVirtualMachineParams) (*Job, error)
+	MigrateVirtualMachineAsyncWithContext(ctx context.Context, p *MigrateVirtualMachineParams) (*Job, error)
+	NewMigrateVirtualMachineWithVolumeParams(hostid string, virtualmachineid string) *MigrateVirtualMachineWithVolumeParams
+	MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error)
+	MigrateVirtualMachineWithVolumeWithContext(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error)
+	MigrateVirtualMachineWithVolumeAsync(p *MigrateVirtualMachineWithVolumeParams) (*Job, error)
+	MigrateVirtualMachineWithVolumeAsyncWithContext(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*Job, error)
+	NewRebootVirtualMachineParams(id string) *RebootVirtualMachineParams
+	RebootVirtualMachine(p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error)
+	RebootVirtualMachineWithContext(ctx context.Context, p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error)
+	RebootVirtualMachineAsync(p *RebootVirtualMachineParams) (*Job, error)
+	RebootVirtualMachineAsyncWithContext(ctx context.Context, p *RebootVirtualMachineParams) (*Job, error)
+	NewRecoverVirtualMachineParams(id string) *RecoverVirtualMachineParams
+	RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error)
+	RecoverVirtualMachineWithContext(ctx context.Context, p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error)
+	NewRemoveNicFromVirtualMachineParams(nicid string, virtualmachineid string) *RemoveNicFromVirtualMachineParams
+	RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error)
+	RemoveNicFromVirtualMachineWithContext(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error)
+	RemoveNicFromVirtualMachineAsync(p *RemoveNicFromVirtualMachineParams) (*Job, error)
+	RemoveNicFromVirtualMachineAsyncWithContext(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*Job, error)
+	NewResetPasswordForVirtualMachineParams(id string) *ResetPasswordForVirtualMachineParams
+	ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error)
+	ResetPasswordForVirtualMachineWithContext(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error)
+	ResetPasswordForVirtualMachineAsync(p *ResetPasswordForVirtualMachineParams) (*Job, error)
+	ResetPasswordForVirtualMachineAsyncWithContext(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*Job, error)
+	NewRestoreVirtualMachineParams(virtualmachineid string) *RestoreVirtualMachineParams
+	RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error)
+	RestoreVirtualMachineWithContext(ctx context.Context, p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error)
+	RestoreVirtualMachineAsync(p *RestoreVirtualMachineParams) (*Job, error)
+	RestoreVirtualMachineAsyncWithContext(ctx context.Context, p *RestoreVirtualMachineParams) (*Job, error)
+	NewScaleVirtualMachineParams(id string, serviceofferingid string) *ScaleVirtualMachineParams
+	ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error)
+	ScaleVirtualMachineWithContext(ctx context.Context, p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error)
+	ScaleVirtualMachineAsync(p *ScaleVirtualMachineParams) (*Job, error)
+	ScaleVirtualMachineAsyncWithContext(ctx context.Context, p *ScaleVirtualMachineParams) (*Job, error)
+	NewStartVirtualMachineParams(id string) *StartVirtualMachineParams
+	StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error)
+	StartVirtualMachineWithContext(ctx context.Context, p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error)
+	StartVirtualMachineAsync(p *StartVirtualMachineParams) (*Job, error)
+	StartVirtualMachineAsyncWithContext(ctx context.Context, p *StartVirtualMachineParams) (*Job, error)
+	NewStopVirtualMachineParams(id string) *StopVirtualMachineParams
+	StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error)
+	StopVirtualMachineWithContext(ctx context.Context, p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error)
+	StopVirtualMachineAsync(p *StopVirtualMachineParams) (*Job, error)
+	StopVirtualMachineAsyncWithContext(ctx context.Context, p *StopVirtualMachineParams) (*Job, error)
+	NewUnmanageVirtualMachineParams(id string) *UnmanageVirtualMachineParams
+	UnmanageVirtualMachine(p *UnmanageVirtualMachineParams) (*UnmanageVirtualMachineResponse, error)
+	UnmanageVirtualMachineWithContext(ctx context.Context, p *UnmanageVirtualMachineParams) (*UnmanageVirtualMachineResponse, error)
+	UnmanageVirtualMachineAsync(p *UnmanageVirtualMachineParams) (*Job, error)
+	UnmanageVirtualMachineAsyncWithContext(ctx context.Context, p *UnmanageVirtualMachineParams) (*Job, error)
+	NewUpdateDefaultNicForVirtualMachineParams(nicid string, virtualmachineid string) *UpdateDefaultNicForVirtualMachineParams
+	UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error)
+	UpdateDefaultNicForVirtualMachineWithContext(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error)
+	UpdateDefaultNicForVirtualMachineAsync(p *UpdateDefaultNicForVirtualMachineParams) (*Job, error)
+	UpdateDefaultNicForVirtualMachineAsyncWithContext(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*Job, error)
+	NewUpdateVirtualMachineParams(id string) *UpdateVirtualMachineParams
+	UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error)
+	UpdateVirtualMachineWithContext(ctx context.Context, p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error)
+}
+
 type AddNicToVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *AddNicToVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AddNicToVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -58,6 +196,54 @@ func (p *AddNicToVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AddNicToVirtualMachineParams) Validate() error {
+	v := newParamValidator("addNicToVirtualMachine", p.p)
+	v.required("networkid", "virtualmachineid")
+	v.ids("networkid", "virtualmachineid")
+	v.maxLength("ipaddress", 255)
+	v.maxLength("macaddress", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AddNicToVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AddNicToVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "dhcpoptions":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ipaddress", "macaddress", "networkid", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for addNicToVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of addNicToVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AddNicToVirtualMachineParams) SetDhcpoptions(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -65,6 +251,15 @@ func (p *AddNicToVirtualMachineParams) SetDhcpoptions(v map[string]string) {
 	p.p["dhcpoptions"] = v
 }
 
+func (p *AddNicToVirtualMachineParams) ResetDhcpoptions() {
+	delete(p.p, "dhcpoptions")
+}
+
+func (p *AddNicToVirtualMachineParams) GetDhcpoptions() (map[string]string, bool) {
+	value, ok := p.p["dhcpoptions"].(map[string]string)
+	return value, ok
+}
+
 func (p *AddNicToVirtualMachineParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -72,6 +267,15 @@ func (p *AddNicToVirtualMachineParams) SetIpaddress(v string) {
 	p.p["ipaddress"] = v
 }
 
+func (p *AddNicToVirtualMachineParams) ResetIpaddress() {
+	delete(p.p, "ipaddress")
+}
+
+func (p *AddNicToVirtualMachineParams) GetIpaddress() (string, bool) {
+	value, ok := p.p["ipaddress"].(string)
+	return value, ok
+}
+
 func (p *AddNicToVirtualMachineParams) SetMacaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -79,6 +283,15 @@ func (p *AddNicToVirtualMachineParams) SetMacaddress(v string) {
 	p.p["macaddress"] = v
 }
 
+func (p *AddNicToVirtualMachineParams) ResetMacaddress() {
+	delete(p.p, "macaddress")
+}
+
+func (p *AddNicToVirtualMachineParams) GetMacaddress() (string, bool) {
+	value, ok := p.p["macaddress"].(string)
+	return value, ok
+}
+
 func (p *AddNicToVirtualMachineParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -86,6 +299,15 @@ func (p *AddNicToVirtualMachineParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *AddNicToVirtualMachineParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *AddNicToVirtualMachineParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *AddNicToVirtualMachineParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -93,6 +315,15 @@ func (p *AddNicToVirtualMachineParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *AddNicToVirtualMachineParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *AddNicToVirtualMachineParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AddNicToVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewAddNicToVirtualMachineParams(networkid string, virtualmachineid string) *AddNicToVirtualMachineParams {
@@ -105,7 +336,16 @@ func (s *VirtualMachineService) NewAddNicToVirtualMachineParams(networkid string
 
 // Adds VM to specified network by creating a NIC
 func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("addNicToVirtualMachine", p.toURLValues())
+	return s.AddNicToVirtualMachineWithContext(context.Background(), p)
+}
+
+// AddNicToVirtualMachineWithContext is the same as AddNicToVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) AddNicToVirtualMachineWithContext(ctx context.Context, p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addNicToVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +357,7 @@ func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachine
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -138,6 +378,40 @@ func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachine
 	return &r, nil
 }
 
+// AddNicToVirtualMachineAsync starts the async job for AddNicToVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AddNicToVirtualMachineResponse using Job.Result
+func (s *VirtualMachineService) AddNicToVirtualMachineAsync(p *AddNicToVirtualMachineParams) (*Job, error) {
+	return s.AddNicToVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// AddNicToVirtualMachineAsyncWithContext is the same as AddNicToVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) AddNicToVirtualMachineAsyncWithContext(ctx context.Context, p *AddNicToVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "addNicToVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddNicToVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AddNicToVirtualMachineResponse struct {
 	Account               string                                        `json:"account"`
 	Affinitygroup         []AddNicToVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -285,7 +559,8 @@ type AssignVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *AssignVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AssignVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -313,6 +588,53 @@ func (p *AssignVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AssignVirtualMachineParams) Validate() error {
+	v := newParamValidator("assignVirtualMachine", p.p)
+	v.required("virtualmachineid")
+	v.ids("domainid", "projectid", "virtualmachineid")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AssignVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AssignVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "projectid", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "networkids", "securitygroupids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for assignVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of assignVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AssignVirtualMachineParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -320,6 +642,15 @@ func (p *AssignVirtualMachineParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *AssignVirtualMachineParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *AssignVirtualMachineParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *AssignVirtualMachineParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -327,6 +658,15 @@ func (p *AssignVirtualMachineParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *AssignVirtualMachineParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *AssignVirtualMachineParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *AssignVirtualMachineParams) SetNetworkids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -334,6 +674,15 @@ func (p *AssignVirtualMachineParams) SetNetworkids(v []string) {
 	p.p["networkids"] = v
 }
 
+func (p *AssignVirtualMachineParams) ResetNetworkids() {
+	delete(p.p, "networkids")
+}
+
+func (p *AssignVirtualMachineParams) GetNetworkids() ([]string, bool) {
+	value, ok := p.p["networkids"].([]string)
+	return value, ok
+}
+
 func (p *AssignVirtualMachineParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -341,6 +690,15 @@ func (p *AssignVirtualMachineParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *AssignVirtualMachineParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *AssignVirtualMachineParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *AssignVirtualMachineParams) SetSecuritygroupids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -348,6 +706,15 @@ func (p *AssignVirtualMachineParams) SetSecuritygroupids(v []string) {
 	p.p["securitygroupids"] = v
 }
 
+func (p *AssignVirtualMachineParams) ResetSecuritygroupids() {
+	delete(p.p, "securitygroupids")
+}
+
+func (p *AssignVirtualMachineParams) GetSecuritygroupids() ([]string, bool) {
+	value, ok := p.p["securitygroupids"].([]string)
+	return value, ok
+}
+
 func (p *AssignVirtualMachineParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -355,6 +722,15 @@ func (p *AssignVirtualMachineParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *AssignVirtualMachineParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *AssignVirtualMachineParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AssignVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewAssignVirtualMachineParams(virtualmachineid string) *AssignVirtualMachineParams {
@@ -366,7 +742,16 @@ func (s *VirtualMachineService) NewAssignVirtualMachineParams(virtualmachineid s
 
 // Change ownership of a VM from one account to another. This API is available for Basic zones with security groups and Advanced zones with guest networks. A root administrator can reassign a VM from any account to any other account in any domain. A domain administrator can reassign a VM to any account in the same domain.
 func (s *VirtualMachineService) AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("assignVirtualMachine", p.toURLValues())
+	return s.AssignVirtualMachineWithContext(context.Background(), p)
+}
+
+// AssignVirtualMachineWithContext is the same as AssignVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) AssignVirtualMachineWithContext(ctx context.Context, p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "assignVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -526,7 +911,8 @@ type ChangeServiceForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *ChangeServiceForVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ChangeServiceForVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -546,6 +932,52 @@ func (p *ChangeServiceForVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ChangeServiceForVirtualMachineParams) Validate() error {
+	v := newParamValidator("changeServiceForVirtualMachine", p.p)
+	v.required("id", "serviceofferingid")
+	v.ids("id", "serviceofferingid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ChangeServiceForVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ChangeServiceForVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "serviceofferingid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for changeServiceForVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of changeServiceForVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ChangeServiceForVirtualMachineParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -553,6 +985,15 @@ func (p *ChangeServiceForVirtualMachineParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *ChangeServiceForVirtualMachineParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *ChangeServiceForVirtualMachineParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *ChangeServiceForVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -560,6 +1001,15 @@ func (p *ChangeServiceForVirtualMachineParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ChangeServiceForVirtualMachineParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ChangeServiceForVirtualMachineParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ChangeServiceForVirtualMachineParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -567,6 +1017,15 @@ func (p *ChangeServiceForVirtualMachineParams) SetServiceofferingid(v string) {
 	p.p["serviceofferingid"] = v
 }
 
+func (p *ChangeServiceForVirtualMachineParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *ChangeServiceForVirtualMachineParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ChangeServiceForVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewChangeServiceForVirtualMachineParams(id string, serviceofferingid string) *ChangeServiceForVirtualMachineParams {
@@ -579,7 +1038,16 @@ func (s *VirtualMachineService) NewChangeServiceForVirtualMachineParams(id strin
 
 // Changes the service offering for a virtual machine. The virtual machine must be in a "Stopped" state for this command to take effect.
 func (s *VirtualMachineService) ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForVirtualMachine", p.toURLValues())
+	return s.ChangeServiceForVirtualMachineWithContext(context.Background(), p)
+}
+
+// ChangeServiceForVirtualMachineWithContext is the same as ChangeServiceForVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) ChangeServiceForVirtualMachineWithContext(ctx context.Context, p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "changeServiceForVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -739,7 +1207,8 @@ type CleanVMReservationsParams struct {
 	p map[string]interface{}
 }
 
-func (p *CleanVMReservationsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CleanVMReservationsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -747,6 +1216,34 @@ func (p *CleanVMReservationsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CleanVMReservationsParams) Validate() error {
+	return nil
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CleanVMReservationsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CleanVMReservationsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k := range m {
+		return fmt.Errorf("Unknown param %s for cleanVMReservations", k)
+	}
+	return nil
+}
+
 // You should always use this function to get a new CleanVMReservationsParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewCleanVMReservationsParams() *CleanVMReservationsParams {
@@ -757,7 +1254,16 @@ func (s *VirtualMachineService) NewCleanVMReservationsParams() *CleanVMReservati
 
 // Cleanups VM reservations in the database.
 func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
-	resp, err := s.cs.newRequest("cleanVMReservations", p.toURLValues())
+	return s.CleanVMReservationsWithContext(context.Background(), p)
+}
+
+// CleanVMReservationsWithContext is the same as CleanVMReservations, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) CleanVMReservationsWithContext(ctx context.Context, p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "cleanVMReservations", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -769,7 +1275,7 @@ func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -785,6 +1291,31 @@ func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams
 	return &r, nil
 }
 
+// CleanVMReservationsAsync starts the async job for CleanVMReservations and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CleanVMReservationsResponse using Job.Result
+func (s *VirtualMachineService) CleanVMReservationsAsync(p *CleanVMReservationsParams) (*Job, error) {
+	return s.CleanVMReservationsAsyncWithContext(context.Background(), p)
+}
+
+// CleanVMReservationsAsyncWithContext is the same as CleanVMReservationsAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) CleanVMReservationsAsyncWithContext(ctx context.Context, p *CleanVMReservationsParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "cleanVMReservations", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CleanVMReservationsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type CleanVMReservationsResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -796,7 +1327,8 @@ type DeployVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeployVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeployVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -833,10 +1365,13 @@ func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 		u.Set("customid", v.(string))
 	}
 	if v, found := p.p["datadiskofferinglist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("datadiskofferinglist[%d].key", i), k)
-			u.Set(fmt.Sprintf("datadiskofferinglist[%d].value", i), m[k])
+		for i, item := range v.([]DataDiskTemplateToOffering) {
+			if item.DataDiskTemplateID != "" {
+				u.Set(fmt.Sprintf("datadiskofferinglist[%d].datadisktemplateid", i), item.DataDiskTemplateID)
+			}
+			if item.DiskOfferingID != "" {
+				u.Set(fmt.Sprintf("datadiskofferinglist[%d].diskofferingid", i), item.DiskOfferingID)
+			}
 		}
 	}
 	if v, found := p.p["deploymentplanner"]; found {
@@ -887,10 +1422,19 @@ func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 		u.Set("ipaddress", v.(string))
 	}
 	if v, found := p.p["iptonetworklist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("iptonetworklist[%d].key", i), k)
-			u.Set(fmt.Sprintf("iptonetworklist[%d].value", i), m[k])
+		for i, item := range v.([]IPToNetwork) {
+			if item.NetworkID != "" {
+				u.Set(fmt.Sprintf("iptonetworklist[%d].networkid", i), item.NetworkID)
+			}
+			if item.IP != "" {
+				u.Set(fmt.Sprintf("iptonetworklist[%d].ip", i), item.IP)
+			}
+			if item.IPv6 != "" {
+				u.Set(fmt.Sprintf("iptonetworklist[%d].ipv6", i), item.IPv6)
+			}
+			if item.MAC != "" {
+				u.Set(fmt.Sprintf("iptonetworklist[%d].mac", i), item.MAC)
+			}
 		}
 	}
 	if v, found := p.p["keyboard"]; found {
@@ -910,10 +1454,13 @@ func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 		u.Set("networkids", vv)
 	}
 	if v, found := p.p["nicnetworklist"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("nicnetworklist[%d].key", i), k)
-			u.Set(fmt.Sprintf("nicnetworklist[%d].value", i), m[k])
+		for i, item := range v.([]NICNetwork) {
+			if item.NIC != "" {
+				u.Set(fmt.Sprintf("nicnetworklist[%d].nic", i), item.NIC)
+			}
+			if item.Network != "" {
+				u.Set(fmt.Sprintf("nicnetworklist[%d].network", i), item.Network)
+			}
 		}
 	}
 	if v, found := p.p["podid"]; found {
@@ -964,6 +1511,93 @@ func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeployVirtualMachineParams) Validate() error {
+	v := newParamValidator("deployVirtualMachine", p.p)
+	v.required("serviceofferingid", "templateid", "zoneid")
+	v.ids("clusterid", "diskofferingid", "domainid", "hostid", "podid", "projectid", "serviceofferingid", "templateid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("bootmode", 255)
+	v.maxLength("boottype", 255)
+	v.maxLength("customid", 255)
+	v.maxLength("deploymentplanner", 255)
+	v.maxLength("displayname", 255)
+	v.maxLength("extraconfig", 5120)
+	v.maxLength("group", 255)
+	v.maxLength("hypervisor", 255)
+	v.maxLength("ip6address", 255)
+	v.maxLength("ipaddress", 255)
+	v.maxLength("keyboard", 255)
+	v.oneOf("keyboard", "de", "de-ch", "es", "fi", "fr", "fr-be", "fr-ch", "is", "it", "jp", "nl-be", "no", "pt", "uk", "us")
+	v.maxLength("keypair", 255)
+	v.maxLength("macaddress", 255)
+	v.maxLength("name", 255)
+	v.maxLength("userdata", 32768)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeployVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeployVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "bootmode", "boottype", "clusterid", "customid", "deploymentplanner", "diskofferingid", "displayname", "domainid", "extraconfig", "group", "hostid", "hypervisor", "ip6address", "ipaddress", "keyboard", "keypair", "macaddress", "name", "podid", "projectid", "serviceofferingid", "templateid", "userdata", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "affinitygroupids", "affinitygroupnames", "networkids", "securitygroupids", "securitygroupnames":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "bootintosetup", "copyimagetags", "displayvm", "startvm":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "datadiskofferinglist":
+			var v []DataDiskTemplateToOffering
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details", "dhcpoptionsnetworklist", "properties":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "iptonetworklist":
+			var v []IPToNetwork
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "nicnetworklist":
+			var v []NICNetwork
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "rootdisksize", "size":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deployVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deployVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeployVirtualMachineParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -971,6 +1605,15 @@ func (p *DeployVirtualMachineParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *DeployVirtualMachineParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetAffinitygroupids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -978,6 +1621,15 @@ func (p *DeployVirtualMachineParams) SetAffinitygroupids(v []string) {
 	p.p["affinitygroupids"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetAffinitygroupids() {
+	delete(p.p, "affinitygroupids")
+}
+
+func (p *DeployVirtualMachineParams) GetAffinitygroupids() ([]string, bool) {
+	value, ok := p.p["affinitygroupids"].([]string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetAffinitygroupnames(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -985,6 +1637,15 @@ func (p *DeployVirtualMachineParams) SetAffinitygroupnames(v []string) {
 	p.p["affinitygroupnames"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetAffinitygroupnames() {
+	delete(p.p, "affinitygroupnames")
+}
+
+func (p *DeployVirtualMachineParams) GetAffinitygroupnames() ([]string, bool) {
+	value, ok := p.p["affinitygroupnames"].([]string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetBootintosetup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -992,6 +1653,15 @@ func (p *DeployVirtualMachineParams) SetBootintosetup(v bool) {
 	p.p["bootintosetup"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetBootintosetup() {
+	delete(p.p, "bootintosetup")
+}
+
+func (p *DeployVirtualMachineParams) GetBootintosetup() (bool, bool) {
+	value, ok := p.p["bootintosetup"].(bool)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetBootmode(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -999,6 +1669,15 @@ func (p *DeployVirtualMachineParams) SetBootmode(v string) {
 	p.p["bootmode"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetBootmode() {
+	delete(p.p, "bootmode")
+}
+
+func (p *DeployVirtualMachineParams) GetBootmode() (string, bool) {
+	value, ok := p.p["bootmode"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetBoottype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1006,6 +1685,15 @@ func (p *DeployVirtualMachineParams) SetBoottype(v string) {
 	p.p["boottype"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetBoottype() {
+	delete(p.p, "boottype")
+}
+
+func (p *DeployVirtualMachineParams) GetBoottype() (string, bool) {
+	value, ok := p.p["boottype"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1013,6 +1701,15 @@ func (p *DeployVirtualMachineParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *DeployVirtualMachineParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetCopyimagetags(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1020,6 +1717,15 @@ func (p *DeployVirtualMachineParams) SetCopyimagetags(v bool) {
 	p.p["copyimagetags"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetCopyimagetags() {
+	delete(p.p, "copyimagetags")
+}
+
+func (p *DeployVirtualMachineParams) GetCopyimagetags() (bool, bool) {
+	value, ok := p.p["copyimagetags"].(bool)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1027,13 +1733,31 @@ func (p *DeployVirtualMachineParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
-func (p *DeployVirtualMachineParams) SetDatadiskofferinglist(v map[string]string) {
+func (p *DeployVirtualMachineParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *DeployVirtualMachineParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
+func (p *DeployVirtualMachineParams) SetDatadiskofferinglist(v []DataDiskTemplateToOffering) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["datadiskofferinglist"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetDatadiskofferinglist() {
+	delete(p.p, "datadiskofferinglist")
+}
+
+func (p *DeployVirtualMachineParams) GetDatadiskofferinglist() ([]DataDiskTemplateToOffering, bool) {
+	value, ok := p.p["datadiskofferinglist"].([]DataDiskTemplateToOffering)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetDeploymentplanner(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1041,6 +1765,15 @@ func (p *DeployVirtualMachineParams) SetDeploymentplanner(v string) {
 	p.p["deploymentplanner"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetDeploymentplanner() {
+	delete(p.p, "deploymentplanner")
+}
+
+func (p *DeployVirtualMachineParams) GetDeploymentplanner() (string, bool) {
+	value, ok := p.p["deploymentplanner"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1048,6 +1781,15 @@ func (p *DeployVirtualMachineParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *DeployVirtualMachineParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetDhcpoptionsnetworklist(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1055,6 +1797,15 @@ func (p *DeployVirtualMachineParams) SetDhcpoptionsnetworklist(v map[string]stri
 	p.p["dhcpoptionsnetworklist"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetDhcpoptionsnetworklist() {
+	delete(p.p, "dhcpoptionsnetworklist")
+}
+
+func (p *DeployVirtualMachineParams) GetDhcpoptionsnetworklist() (map[string]string, bool) {
+	value, ok := p.p["dhcpoptionsnetworklist"].(map[string]string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetDiskofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1062,6 +1813,15 @@ func (p *DeployVirtualMachineParams) SetDiskofferingid(v string) {
 	p.p["diskofferingid"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetDiskofferingid() {
+	delete(p.p, "diskofferingid")
+}
+
+func (p *DeployVirtualMachineParams) GetDiskofferingid() (string, bool) {
+	value, ok := p.p["diskofferingid"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetDisplayname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1069,6 +1829,15 @@ func (p *DeployVirtualMachineParams) SetDisplayname(v string) {
 	p.p["displayname"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetDisplayname() {
+	delete(p.p, "displayname")
+}
+
+func (p *DeployVirtualMachineParams) GetDisplayname() (string, bool) {
+	value, ok := p.p["displayname"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetDisplayvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1076,6 +1845,15 @@ func (p *DeployVirtualMachineParams) SetDisplayvm(v bool) {
 	p.p["displayvm"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetDisplayvm() {
+	delete(p.p, "displayvm")
+}
+
+func (p *DeployVirtualMachineParams) GetDisplayvm() (bool, bool) {
+	value, ok := p.p["displayvm"].(bool)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1083,6 +1861,15 @@ func (p *DeployVirtualMachineParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *DeployVirtualMachineParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetExtraconfig(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1090,6 +1877,15 @@ func (p *DeployVirtualMachineParams) SetExtraconfig(v string) {
 	p.p["extraconfig"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetExtraconfig() {
+	delete(p.p, "extraconfig")
+}
+
+func (p *DeployVirtualMachineParams) GetExtraconfig() (string, bool) {
+	value, ok := p.p["extraconfig"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetGroup(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1097,6 +1893,15 @@ func (p *DeployVirtualMachineParams) SetGroup(v string) {
 	p.p["group"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetGroup() {
+	delete(p.p, "group")
+}
+
+func (p *DeployVirtualMachineParams) GetGroup() (string, bool) {
+	value, ok := p.p["group"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1104,6 +1909,15 @@ func (p *DeployVirtualMachineParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *DeployVirtualMachineParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1111,6 +1925,15 @@ func (p *DeployVirtualMachineParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *DeployVirtualMachineParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetIp6address(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1118,6 +1941,15 @@ func (p *DeployVirtualMachineParams) SetIp6address(v string) {
 	p.p["ip6address"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetIp6address() {
+	delete(p.p, "ip6address")
+}
+
+func (p *DeployVirtualMachineParams) GetIp6address() (string, bool) {
+	value, ok := p.p["ip6address"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1125,13 +1957,31 @@ func (p *DeployVirtualMachineParams) SetIpaddress(v string) {
 	p.p["ipaddress"] = v
 }
 
-func (p *DeployVirtualMachineParams) SetIptonetworklist(v map[string]string) {
+func (p *DeployVirtualMachineParams) ResetIpaddress() {
+	delete(p.p, "ipaddress")
+}
+
+func (p *DeployVirtualMachineParams) GetIpaddress() (string, bool) {
+	value, ok := p.p["ipaddress"].(string)
+	return value, ok
+}
+
+func (p *DeployVirtualMachineParams) SetIptonetworklist(v []IPToNetwork) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["iptonetworklist"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetIptonetworklist() {
+	delete(p.p, "iptonetworklist")
+}
+
+func (p *DeployVirtualMachineParams) GetIptonetworklist() ([]IPToNetwork, bool) {
+	value, ok := p.p["iptonetworklist"].([]IPToNetwork)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetKeyboard(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1139,6 +1989,15 @@ func (p *DeployVirtualMachineParams) SetKeyboard(v string) {
 	p.p["keyboard"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetKeyboard() {
+	delete(p.p, "keyboard")
+}
+
+func (p *DeployVirtualMachineParams) GetKeyboard() (string, bool) {
+	value, ok := p.p["keyboard"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetKeypair(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1146,6 +2005,15 @@ func (p *DeployVirtualMachineParams) SetKeypair(v string) {
 	p.p["keypair"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetKeypair() {
+	delete(p.p, "keypair")
+}
+
+func (p *DeployVirtualMachineParams) GetKeypair() (string, bool) {
+	value, ok := p.p["keypair"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetMacaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1153,6 +2021,15 @@ func (p *DeployVirtualMachineParams) SetMacaddress(v string) {
 	p.p["macaddress"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetMacaddress() {
+	delete(p.p, "macaddress")
+}
+
+func (p *DeployVirtualMachineParams) GetMacaddress() (string, bool) {
+	value, ok := p.p["macaddress"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1160,6 +2037,15 @@ func (p *DeployVirtualMachineParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *DeployVirtualMachineParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetNetworkids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1167,13 +2053,31 @@ func (p *DeployVirtualMachineParams) SetNetworkids(v []string) {
 	p.p["networkids"] = v
 }
 
-func (p *DeployVirtualMachineParams) SetNicnetworklist(v map[string]string) {
+func (p *DeployVirtualMachineParams) ResetNetworkids() {
+	delete(p.p, "networkids")
+}
+
+func (p *DeployVirtualMachineParams) GetNetworkids() ([]string, bool) {
+	value, ok := p.p["networkids"].([]string)
+	return value, ok
+}
+
+func (p *DeployVirtualMachineParams) SetNicnetworklist(v []NICNetwork) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["nicnetworklist"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetNicnetworklist() {
+	delete(p.p, "nicnetworklist")
+}
+
+func (p *DeployVirtualMachineParams) GetNicnetworklist() ([]NICNetwork, bool) {
+	value, ok := p.p["nicnetworklist"].([]NICNetwork)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1181,6 +2085,15 @@ func (p *DeployVirtualMachineParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *DeployVirtualMachineParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1188,6 +2101,15 @@ func (p *DeployVirtualMachineParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *DeployVirtualMachineParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetProperties(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1195,6 +2117,15 @@ func (p *DeployVirtualMachineParams) SetProperties(v map[string]string) {
 	p.p["properties"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetProperties() {
+	delete(p.p, "properties")
+}
+
+func (p *DeployVirtualMachineParams) GetProperties() (map[string]string, bool) {
+	value, ok := p.p["properties"].(map[string]string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetRootdisksize(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1202,6 +2133,15 @@ func (p *DeployVirtualMachineParams) SetRootdisksize(v int64) {
 	p.p["rootdisksize"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetRootdisksize() {
+	delete(p.p, "rootdisksize")
+}
+
+func (p *DeployVirtualMachineParams) GetRootdisksize() (int64, bool) {
+	value, ok := p.p["rootdisksize"].(int64)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetSecuritygroupids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1209,6 +2149,15 @@ func (p *DeployVirtualMachineParams) SetSecuritygroupids(v []string) {
 	p.p["securitygroupids"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetSecuritygroupids() {
+	delete(p.p, "securitygroupids")
+}
+
+func (p *DeployVirtualMachineParams) GetSecuritygroupids() ([]string, bool) {
+	value, ok := p.p["securitygroupids"].([]string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetSecuritygroupnames(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1216,6 +2165,15 @@ func (p *DeployVirtualMachineParams) SetSecuritygroupnames(v []string) {
 	p.p["securitygroupnames"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetSecuritygroupnames() {
+	delete(p.p, "securitygroupnames")
+}
+
+func (p *DeployVirtualMachineParams) GetSecuritygroupnames() ([]string, bool) {
+	value, ok := p.p["securitygroupnames"].([]string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1223,6 +2181,15 @@ func (p *DeployVirtualMachineParams) SetServiceofferingid(v string) {
 	p.p["serviceofferingid"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *DeployVirtualMachineParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetSize(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1230,6 +2197,15 @@ func (p *DeployVirtualMachineParams) SetSize(v int64) {
 	p.p["size"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetSize() {
+	delete(p.p, "size")
+}
+
+func (p *DeployVirtualMachineParams) GetSize() (int64, bool) {
+	value, ok := p.p["size"].(int64)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetStartvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1237,6 +2213,15 @@ func (p *DeployVirtualMachineParams) SetStartvm(v bool) {
 	p.p["startvm"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetStartvm() {
+	delete(p.p, "startvm")
+}
+
+func (p *DeployVirtualMachineParams) GetStartvm() (bool, bool) {
+	value, ok := p.p["startvm"].(bool)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1244,6 +2229,15 @@ func (p *DeployVirtualMachineParams) SetTemplateid(v string) {
 	p.p["templateid"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetTemplateid() {
+	delete(p.p, "templateid")
+}
+
+func (p *DeployVirtualMachineParams) GetTemplateid() (string, bool) {
+	value, ok := p.p["templateid"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetUserdata(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1251,6 +2245,15 @@ func (p *DeployVirtualMachineParams) SetUserdata(v string) {
 	p.p["userdata"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetUserdata() {
+	delete(p.p, "userdata")
+}
+
+func (p *DeployVirtualMachineParams) GetUserdata() (string, bool) {
+	value, ok := p.p["userdata"].(string)
+	return value, ok
+}
+
 func (p *DeployVirtualMachineParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1258,6 +2261,15 @@ func (p *DeployVirtualMachineParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *DeployVirtualMachineParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *DeployVirtualMachineParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeployVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string) *DeployVirtualMachineParams {
@@ -1271,7 +2283,16 @@ func (s *VirtualMachineService) NewDeployVirtualMachineParams(serviceofferingid
 
 // Creates and automatically starts a virtual machine based on a service offering, disk offering, and template.
 func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("deployVirtualMachine", p.toURLValues())
+	return s.DeployVirtualMachineWithContext(context.Background(), p)
+}
+
+// DeployVirtualMachineWithContext is the same as DeployVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) DeployVirtualMachineWithContext(ctx context.Context, p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deployVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1283,7 +2304,7 @@ func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachinePara
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1304,6 +2325,40 @@ func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachinePara
 	return &r, nil
 }
 
+// DeployVirtualMachineAsync starts the async job for DeployVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DeployVirtualMachineResponse using Job.Result
+func (s *VirtualMachineService) DeployVirtualMachineAsync(p *DeployVirtualMachineParams) (*Job, error) {
+	return s.DeployVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// DeployVirtualMachineAsyncWithContext is the same as DeployVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) DeployVirtualMachineAsyncWithContext(ctx context.Context, p *DeployVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deployVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeployVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type DeployVirtualMachineResponse struct {
 	Account               string                                      `json:"account"`
 	Affinitygroup         []DeployVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -1451,7 +2506,8 @@ type DestroyVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *DestroyVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DestroyVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1470,6 +2526,56 @@ func (p *DestroyVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DestroyVirtualMachineParams) Validate() error {
+	v := newParamValidator("destroyVirtualMachine", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DestroyVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DestroyVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "expunge":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "volumeids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for destroyVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of destroyVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DestroyVirtualMachineParams) SetExpunge(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1477,6 +2583,15 @@ func (p *DestroyVirtualMachineParams) SetExpunge(v bool) {
 	p.p["expunge"] = v
 }
 
+func (p *DestroyVirtualMachineParams) ResetExpunge() {
+	delete(p.p, "expunge")
+}
+
+func (p *DestroyVirtualMachineParams) GetExpunge() (bool, bool) {
+	value, ok := p.p["expunge"].(bool)
+	return value, ok
+}
+
 func (p *DestroyVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1484,6 +2599,15 @@ func (p *DestroyVirtualMachineParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DestroyVirtualMachineParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DestroyVirtualMachineParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *DestroyVirtualMachineParams) SetVolumeids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1491,6 +2615,15 @@ func (p *DestroyVirtualMachineParams) SetVolumeids(v []string) {
 	p.p["volumeids"] = v
 }
 
+func (p *DestroyVirtualMachineParams) ResetVolumeids() {
+	delete(p.p, "volumeids")
+}
+
+func (p *DestroyVirtualMachineParams) GetVolumeids() ([]string, bool) {
+	value, ok := p.p["volumeids"].([]string)
+	return value, ok
+}
+
 // You should always use this function to get a new DestroyVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewDestroyVirtualMachineParams(id string) *DestroyVirtualMachineParams {
@@ -1502,7 +2635,16 @@ func (s *VirtualMachineService) NewDestroyVirtualMachineParams(id string) *Destr
 
 // Destroys a virtual machine. Once destroyed, only the administrator can recover it.
 func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("destroyVirtualMachine", p.toURLValues())
+	return s.DestroyVirtualMachineWithContext(context.Background(), p)
+}
+
+// DestroyVirtualMachineWithContext is the same as DestroyVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) DestroyVirtualMachineWithContext(ctx context.Context, p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "destroyVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1514,7 +2656,7 @@ func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachinePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1535,6 +2677,40 @@ func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachinePa
 	return &r, nil
 }
 
+// DestroyVirtualMachineAsync starts the async job for DestroyVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DestroyVirtualMachineResponse using Job.Result
+func (s *VirtualMachineService) DestroyVirtualMachineAsync(p *DestroyVirtualMachineParams) (*Job, error) {
+	return s.DestroyVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// DestroyVirtualMachineAsyncWithContext is the same as DestroyVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) DestroyVirtualMachineAsyncWithContext(ctx context.Context, p *DestroyVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "destroyVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DestroyVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type DestroyVirtualMachineResponse struct {
 	Account               string                                       `json:"account"`
 	Affinitygroup         []DestroyVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -1682,7 +2858,8 @@ type ExpungeVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *ExpungeVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ExpungeVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1693,6 +2870,48 @@ func (p *ExpungeVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ExpungeVirtualMachineParams) Validate() error {
+	v := newParamValidator("expungeVirtualMachine", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ExpungeVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ExpungeVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for expungeVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of expungeVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ExpungeVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1700,6 +2919,15 @@ func (p *ExpungeVirtualMachineParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ExpungeVirtualMachineParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ExpungeVirtualMachineParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ExpungeVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewExpungeVirtualMachineParams(id string) *ExpungeVirtualMachineParams {
@@ -1711,7 +2939,16 @@ func (s *VirtualMachineService) NewExpungeVirtualMachineParams(id string) *Expun
 
 // Expunge a virtual machine. Once expunged, it cannot be recoverd.
 func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("expungeVirtualMachine", p.toURLValues())
+	return s.ExpungeVirtualMachineWithContext(context.Background(), p)
+}
+
+// ExpungeVirtualMachineWithContext is the same as ExpungeVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) ExpungeVirtualMachineWithContext(ctx context.Context, p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "expungeVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1723,7 +2960,7 @@ func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachinePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1739,6 +2976,31 @@ func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachinePa
 	return &r, nil
 }
 
+// ExpungeVirtualMachineAsync starts the async job for ExpungeVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ExpungeVirtualMachineResponse using Job.Result
+func (s *VirtualMachineService) ExpungeVirtualMachineAsync(p *ExpungeVirtualMachineParams) (*Job, error) {
+	return s.ExpungeVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// ExpungeVirtualMachineAsyncWithContext is the same as ExpungeVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) ExpungeVirtualMachineAsyncWithContext(ctx context.Context, p *ExpungeVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "expungeVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ExpungeVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type ExpungeVirtualMachineResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -1750,7 +3012,8 @@ type GetVMPasswordParams struct {
 	p map[string]interface{}
 }
 
-func (p *GetVMPasswordParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetVMPasswordParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1761,6 +3024,48 @@ func (p *GetVMPasswordParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetVMPasswordParams) Validate() error {
+	v := newParamValidator("getVMPassword", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetVMPasswordParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetVMPasswordParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getVMPassword", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getVMPassword: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *GetVMPasswordParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1768,6 +3073,15 @@ func (p *GetVMPasswordParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *GetVMPasswordParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *GetVMPasswordParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new GetVMPasswordParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewGetVMPasswordParams(id string) *GetVMPasswordParams {
@@ -1779,7 +3093,16 @@ func (s *VirtualMachineService) NewGetVMPasswordParams(id string) *GetVMPassword
 
 // Returns an encrypted password for the VM
 func (s *VirtualMachineService) GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
-	resp, err := s.cs.newRequest("getVMPassword", p.toURLValues())
+	return s.GetVMPasswordWithContext(context.Background(), p)
+}
+
+// GetVMPasswordWithContext is the same as GetVMPassword, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) GetVMPasswordWithContext(ctx context.Context, p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getVMPassword", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1798,11 +3121,12 @@ type GetVMPasswordResponse struct {
 	Jobstatus         int    `json:"jobstatus"`
 }
 
-type ListVirtualMachinesParams struct {
+type ImportUnmanagedInstanceParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListVirtualMachinesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ImportUnmanagedInstanceParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1810,8 +3134,943 @@ func (p *ListVirtualMachinesParams) toURLValues() url.Values {
 	if v, found := p.p["account"]; found {
 		u.Set("account", v.(string))
 	}
-	if v, found := p.p["affinitygroupid"]; found {
-		u.Set("affinitygroupid", v.(string))
+	if v, found := p.p["clusterid"]; found {
+		u.Set("clusterid", v.(string))
+	}
+	if v, found := p.p["datadiskofferinglist"]; found {
+		for i, item := range v.([]DiskToDiskOffering) {
+			if item.Disk != "" {
+				u.Set(fmt.Sprintf("datadiskofferinglist[%d].disk", i), item.Disk)
+			}
+			if item.DiskOffering != "" {
+				u.Set(fmt.Sprintf("datadiskofferinglist[%d].diskOffering", i), item.DiskOffering)
+			}
+		}
+	}
+	if v, found := p.p["details"]; found {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("details[%d].%s", i, k), m[k])
+		}
+	}
+	if v, found := p.p["displayname"]; found {
+		u.Set("displayname", v.(string))
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["forced"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("forced", vv)
+	}
+	if v, found := p.p["hostname"]; found {
+		u.Set("hostname", v.(string))
+	}
+	if v, found := p.p["migrateallowed"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("migrateallowed", vv)
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["nicipaddresslist"]; found {
+		for i, item := range v.([]NICIPAddress) {
+			if item.NIC != "" {
+				u.Set(fmt.Sprintf("nicipaddresslist[%d].nic", i), item.NIC)
+			}
+			if item.IP4Address != "" {
+				u.Set(fmt.Sprintf("nicipaddresslist[%d].ip4Address", i), item.IP4Address)
+			}
+		}
+	}
+	if v, found := p.p["nicnetworklist"]; found {
+		for i, item := range v.([]NICNetwork) {
+			if item.NIC != "" {
+				u.Set(fmt.Sprintf("nicnetworklist[%d].nic", i), item.NIC)
+			}
+			if item.Network != "" {
+				u.Set(fmt.Sprintf("nicnetworklist[%d].network", i), item.Network)
+			}
+		}
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["serviceofferingid"]; found {
+		u.Set("serviceofferingid", v.(string))
+	}
+	if v, found := p.p["templateid"]; found {
+		u.Set("templateid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ImportUnmanagedInstanceParams) Validate() error {
+	v := newParamValidator("importUnmanagedInstance", p.p)
+	v.required("clusterid", "name", "serviceofferingid")
+	v.ids("clusterid", "domainid", "projectid", "serviceofferingid", "templateid")
+	v.maxLength("account", 255)
+	v.maxLength("displayname", 255)
+	v.maxLength("hostname", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ImportUnmanagedInstanceParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ImportUnmanagedInstanceParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "clusterid", "displayname", "domainid", "hostname", "name", "projectid", "serviceofferingid", "templateid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "datadiskofferinglist":
+			var v []DiskToDiskOffering
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "forced", "migrateallowed":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "nicipaddresslist":
+			var v []NICIPAddress
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "nicnetworklist":
+			var v []NICNetwork
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for importUnmanagedInstance", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of importUnmanagedInstance: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ImportUnmanagedInstanceParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *ImportUnmanagedInstanceParams) SetClusterid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["clusterid"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
+func (p *ImportUnmanagedInstanceParams) SetDatadiskofferinglist(v []DiskToDiskOffering) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["datadiskofferinglist"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetDatadiskofferinglist() {
+	delete(p.p, "datadiskofferinglist")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetDatadiskofferinglist() ([]DiskToDiskOffering, bool) {
+	value, ok := p.p["datadiskofferinglist"].([]DiskToDiskOffering)
+	return value, ok
+}
+
+func (p *ImportUnmanagedInstanceParams) SetDetails(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["details"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
+func (p *ImportUnmanagedInstanceParams) SetDisplayname(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["displayname"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetDisplayname() {
+	delete(p.p, "displayname")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetDisplayname() (string, bool) {
+	value, ok := p.p["displayname"].(string)
+	return value, ok
+}
+
+func (p *ImportUnmanagedInstanceParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *ImportUnmanagedInstanceParams) SetForced(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["forced"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
+func (p *ImportUnmanagedInstanceParams) SetHostname(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostname"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetHostname() {
+	delete(p.p, "hostname")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetHostname() (string, bool) {
+	value, ok := p.p["hostname"].(string)
+	return value, ok
+}
+
+func (p *ImportUnmanagedInstanceParams) SetMigrateallowed(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["migrateallowed"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetMigrateallowed() {
+	delete(p.p, "migrateallowed")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetMigrateallowed() (bool, bool) {
+	value, ok := p.p["migrateallowed"].(bool)
+	return value, ok
+}
+
+func (p *ImportUnmanagedInstanceParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ImportUnmanagedInstanceParams) SetNicipaddresslist(v []NICIPAddress) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["nicipaddresslist"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetNicipaddresslist() {
+	delete(p.p, "nicipaddresslist")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetNicipaddresslist() ([]NICIPAddress, bool) {
+	value, ok := p.p["nicipaddresslist"].([]NICIPAddress)
+	return value, ok
+}
+
+func (p *ImportUnmanagedInstanceParams) SetNicnetworklist(v []NICNetwork) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["nicnetworklist"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetNicnetworklist() {
+	delete(p.p, "nicnetworklist")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetNicnetworklist() ([]NICNetwork, bool) {
+	value, ok := p.p["nicnetworklist"].([]NICNetwork)
+	return value, ok
+}
+
+func (p *ImportUnmanagedInstanceParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *ImportUnmanagedInstanceParams) SetServiceofferingid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["serviceofferingid"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
+func (p *ImportUnmanagedInstanceParams) SetTemplateid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["templateid"] = v
+}
+
+func (p *ImportUnmanagedInstanceParams) ResetTemplateid() {
+	delete(p.p, "templateid")
+}
+
+func (p *ImportUnmanagedInstanceParams) GetTemplateid() (string, bool) {
+	value, ok := p.p["templateid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ImportUnmanagedInstanceParams instance,
+// as then you are sure you have configured all required params
+func (s *VirtualMachineService) NewImportUnmanagedInstanceParams(clusterid string, name string, serviceofferingid string) *ImportUnmanagedInstanceParams {
+	p := &ImportUnmanagedInstanceParams{}
+	p.p = make(map[string]interface{})
+	p.p["clusterid"] = clusterid
+	p.p["name"] = name
+	p.p["serviceofferingid"] = serviceofferingid
+	return p
+}
+
+// Import unmanaged virtual machine from a given cluster.
+func (s *VirtualMachineService) ImportUnmanagedInstance(p *ImportUnmanagedInstanceParams) (*ImportUnmanagedInstanceResponse, error) {
+	return s.ImportUnmanagedInstanceWithContext(context.Background(), p)
+}
+
+// ImportUnmanagedInstanceWithContext is the same as ImportUnmanagedInstance, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) ImportUnmanagedInstanceWithContext(ctx context.Context, p *ImportUnmanagedInstanceParams) (*ImportUnmanagedInstanceResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "importUnmanagedInstance", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ImportUnmanagedInstanceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// ImportUnmanagedInstanceAsync starts the async job for ImportUnmanagedInstance and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ImportUnmanagedInstanceResponse using Job.Result
+func (s *VirtualMachineService) ImportUnmanagedInstanceAsync(p *ImportUnmanagedInstanceParams) (*Job, error) {
+	return s.ImportUnmanagedInstanceAsyncWithContext(context.Background(), p)
+}
+
+// ImportUnmanagedInstanceAsyncWithContext is the same as ImportUnmanagedInstanceAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) ImportUnmanagedInstanceAsyncWithContext(ctx context.Context, p *ImportUnmanagedInstanceParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "importUnmanagedInstance", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ImportUnmanagedInstanceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type ImportUnmanagedInstanceResponse struct {
+	Account               string                                         `json:"account"`
+	Affinitygroup         []ImportUnmanagedInstanceResponseAffinitygroup `json:"affinitygroup"`
+	Backupofferingid      string                                         `json:"backupofferingid"`
+	Backupofferingname    string                                         `json:"backupofferingname"`
+	Bootmode              string                                         `json:"bootmode"`
+	Boottype              string                                         `json:"boottype"`
+	Cpunumber             int                                            `json:"cpunumber"`
+	Cpuspeed              int                                            `json:"cpuspeed"`
+	Cpuused               string                                         `json:"cpuused"`
+	Created               string                                         `json:"created"`
+	Details               map[string]string                              `json:"details"`
+	Diskioread            int64                                          `json:"diskioread"`
+	Diskiowrite           int64                                          `json:"diskiowrite"`
+	Diskkbsread           int64                                          `json:"diskkbsread"`
+	Diskkbswrite          int64                                          `json:"diskkbswrite"`
+	Diskofferingid        string                                         `json:"diskofferingid"`
+	Diskofferingname      string                                         `json:"diskofferingname"`
+	Displayname           string                                         `json:"displayname"`
+	Displayvm             bool                                           `json:"displayvm"`
+	Domain                string                                         `json:"domain"`
+	Domainid              string                                         `json:"domainid"`
+	Forvirtualnetwork     bool                                           `json:"forvirtualnetwork"`
+	Group                 string                                         `json:"group"`
+	Groupid               string                                         `json:"groupid"`
+	Guestosid             string                                         `json:"guestosid"`
+	Haenable              bool                                           `json:"haenable"`
+	Hostid                string                                         `json:"hostid"`
+	Hostname              string                                         `json:"hostname"`
+	Hypervisor            string                                         `json:"hypervisor"`
+	Id                    string                                         `json:"id"`
+	Instancename          string                                         `json:"instancename"`
+	Isdynamicallyscalable bool                                           `json:"isdynamicallyscalable"`
+	Isodisplaytext        string                                         `json:"isodisplaytext"`
+	Isoid                 string                                         `json:"isoid"`
+	Isoname               string                                         `json:"isoname"`
+	JobID                 string                                         `json:"jobid"`
+	Jobstatus             int                                            `json:"jobstatus"`
+	Keypair               string                                         `json:"keypair"`
+	Memory                int                                            `json:"memory"`
+	Memoryintfreekbs      int64                                          `json:"memoryintfreekbs"`
+	Memorykbs             int64                                          `json:"memorykbs"`
+	Memorytargetkbs       int64                                          `json:"memorytargetkbs"`
+	Name                  string                                         `json:"name"`
+	Networkkbsread        int64                                          `json:"networkkbsread"`
+	Networkkbswrite       int64                                          `json:"networkkbswrite"`
+	Nic                   []Nic                                          `json:"nic"`
+	Osdisplayname         string                                         `json:"osdisplayname"`
+	Ostypeid              string                                         `json:"ostypeid"`
+	Password              string                                         `json:"password"`
+	Passwordenabled       bool                                           `json:"passwordenabled"`
+	Project               string                                         `json:"project"`
+	Projectid             string                                         `json:"projectid"`
+	Publicip              string                                         `json:"publicip"`
+	Publicipid            string                                         `json:"publicipid"`
+	Readonlyuidetails     string                                         `json:"readonlyuidetails"`
+	Rootdeviceid          int64                                          `json:"rootdeviceid"`
+	Rootdevicetype        string                                         `json:"rootdevicetype"`
+	Securitygroup         []ImportUnmanagedInstanceResponseSecuritygroup `json:"securitygroup"`
+	Serviceofferingid     string                                         `json:"serviceofferingid"`
+	Serviceofferingname   string                                         `json:"serviceofferingname"`
+	Servicestate          string                                         `json:"servicestate"`
+	State                 string                                         `json:"state"`
+	Tags                  []Tags                                         `json:"tags"`
+	Templatedisplaytext   string                                         `json:"templatedisplaytext"`
+	Templateid            string                                         `json:"templateid"`
+	Templatename          string                                         `json:"templatename"`
+	Userid                string                                         `json:"userid"`
+	Username              string                                         `json:"username"`
+	Vgpu                  string                                         `json:"vgpu"`
+	Zoneid                string                                         `json:"zoneid"`
+	Zonename              string                                         `json:"zonename"`
+}
+
+type ImportUnmanagedInstanceResponseSecuritygroup struct {
+	Account             string                                             `json:"account"`
+	Description         string                                             `json:"description"`
+	Domain              string                                             `json:"domain"`
+	Domainid            string                                             `json:"domainid"`
+	Egressrule          []ImportUnmanagedInstanceResponseSecuritygroupRule `json:"egressrule"`
+	Id                  string                                             `json:"id"`
+	Ingressrule         []ImportUnmanagedInstanceResponseSecuritygroupRule `json:"ingressrule"`
+	Name                string                                             `json:"name"`
+	Project             string                                             `json:"project"`
+	Projectid           string                                             `json:"projectid"`
+	Tags                []Tags                                             `json:"tags"`
+	Virtualmachinecount int                                                `json:"virtualmachinecount"`
+	Virtualmachineids   []interface{}                                      `json:"virtualmachineids"`
+}
+
+type ImportUnmanagedInstanceResponseSecuritygroupRule struct {
+	Account           string `json:"account"`
+	Cidr              string `json:"cidr"`
+	Endport           int    `json:"endport"`
+	Icmpcode          int    `json:"icmpcode"`
+	Icmptype          int    `json:"icmptype"`
+	Protocol          string `json:"protocol"`
+	Ruleid            string `json:"ruleid"`
+	Securitygroupname string `json:"securitygroupname"`
+	Startport         int    `json:"startport"`
+	Tags              []Tags `json:"tags"`
+}
+
+type ImportUnmanagedInstanceResponseAffinitygroup struct {
+	Account           string   `json:"account"`
+	Description       string   `json:"description"`
+	Domain            string   `json:"domain"`
+	Domainid          string   `json:"domainid"`
+	Id                string   `json:"id"`
+	Name              string   `json:"name"`
+	Project           string   `json:"project"`
+	Projectid         string   `json:"projectid"`
+	Type              string   `json:"type"`
+	VirtualmachineIds []string `json:"virtualmachineIds"`
+}
+
+func (r *ImportUnmanagedInstanceResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias ImportUnmanagedInstanceResponse
+	return json.Unmarshal(b, (*alias)(r))
+}
+
+type ListUnmanagedInstancesParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListUnmanagedInstancesParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["clusterid"]; found {
+		u.Set("clusterid", v.(string))
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListUnmanagedInstancesParams) Validate() error {
+	v := newParamValidator("listUnmanagedInstances", p.p)
+	v.required("clusterid")
+	v.ids("clusterid")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListUnmanagedInstancesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListUnmanagedInstancesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid", "keyword", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listUnmanagedInstances", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listUnmanagedInstances: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListUnmanagedInstancesParams) SetClusterid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["clusterid"] = v
+}
+
+func (p *ListUnmanagedInstancesParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ListUnmanagedInstancesParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
+func (p *ListUnmanagedInstancesParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListUnmanagedInstancesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListUnmanagedInstancesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListUnmanagedInstancesParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ListUnmanagedInstancesParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListUnmanagedInstancesParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ListUnmanagedInstancesParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListUnmanagedInstancesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListUnmanagedInstancesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListUnmanagedInstancesParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListUnmanagedInstancesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListUnmanagedInstancesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+// You should always use this function to get a new ListUnmanagedInstancesParams instance,
+// as then you are sure you have configured all required params
+func (s *VirtualMachineService) NewListUnmanagedInstancesParams(clusterid string) *ListUnmanagedInstancesParams {
+	p := &ListUnmanagedInstancesParams{}
+	p.p = make(map[string]interface{})
+	p.p["clusterid"] = clusterid
+	return p
+}
+
+// Lists unmanaged virtual machines for a given cluster.
+func (s *VirtualMachineService) ListUnmanagedInstances(p *ListUnmanagedInstancesParams) (*ListUnmanagedInstancesResponse, error) {
+	return s.ListUnmanagedInstancesWithContext(context.Background(), p)
+}
+
+// ListUnmanagedInstancesWithContext is the same as ListUnmanagedInstances, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) ListUnmanagedInstancesWithContext(ctx context.Context, p *ListUnmanagedInstancesParams) (*ListUnmanagedInstancesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listUnmanagedInstances", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListUnmanagedInstancesResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListUnmanagedInstancesPager iterates over all pages of a ListUnmanagedInstances call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListUnmanagedInstancesPager struct {
+	pager
+
+	s *VirtualMachineService
+	p *ListUnmanagedInstancesParams
+	r *ListUnmanagedInstancesResponse
+}
+
+// NewListUnmanagedInstancesPager returns a pager for all pages of a ListUnmanagedInstances call with the given params
+func (s *VirtualMachineService) NewListUnmanagedInstancesPager(p *ListUnmanagedInstancesParams) *ListUnmanagedInstancesPager {
+	return &ListUnmanagedInstancesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListUnmanagedInstancesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListUnmanagedInstancesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListUnmanagedInstancesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListUnmanagedInstancesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.UnmanagedInstances), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListUnmanagedInstancesPager) Page() *ListUnmanagedInstancesResponse {
+	return pg.r
+}
+
+// ListUnmanagedInstancesAll fetches all pages of a ListUnmanagedInstances call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VirtualMachineService) ListUnmanagedInstancesAll(p *ListUnmanagedInstancesParams) (*ListUnmanagedInstancesResponse, error) {
+	return s.ListUnmanagedInstancesAllWithContext(context.Background(), p)
+}
+
+// ListUnmanagedInstancesAllWithContext is the same as ListUnmanagedInstancesAll, but uses ctx to cancel the requests
+func (s *VirtualMachineService) ListUnmanagedInstancesAllWithContext(ctx context.Context, p *ListUnmanagedInstancesParams) (*ListUnmanagedInstancesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*UnmanagedInstance)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListUnmanagedInstancesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListUnmanagedInstancesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.UnmanagedInstances
+		mu.Unlock()
+
+		return len(l.UnmanagedInstances), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListUnmanagedInstancesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.UnmanagedInstances = append(r.UnmanagedInstances, pages[page]...)
+	}
+	r.Count = len(r.UnmanagedInstances)
+
+	return r, nil
+}
+
+type ListUnmanagedInstancesResponse struct {
+	Count              int                  `json:"count"`
+	UnmanagedInstances []*UnmanagedInstance `json:"unmanagedinstance"`
+}
+
+type UnmanagedInstance struct {
+	Clusterid        string                  `json:"clusterid"`
+	Cpucorepersocket int                     `json:"cpucorepersocket"`
+	Cpunumber        int                     `json:"cpunumber"`
+	Cpuspeed         int                     `json:"cpuspeed"`
+	Disk             []UnmanagedInstanceDisk `json:"disk"`
+	Hostid           string                  `json:"hostid"`
+	JobID            string                  `json:"jobid"`
+	Jobstatus        int                     `json:"jobstatus"`
+	Memory           int                     `json:"memory"`
+	Name             string                  `json:"name"`
+	Nic              []Nic                   `json:"nic"`
+	Osdisplayname    string                  `json:"osdisplayname"`
+	Osid             string                  `json:"osid"`
+	Powerstate       string                  `json:"powerstate"`
+}
+
+type UnmanagedInstanceDisk struct {
+	Capacity       int64  `json:"capacity"`
+	Controller     string `json:"controller"`
+	Controllerunit int    `json:"controllerunit"`
+	Datastorehost  string `json:"datastorehost"`
+	Datastorename  string `json:"datastorename"`
+	Datastorepath  string `json:"datastorepath"`
+	Datastoretype  string `json:"datastoretype"`
+	Id             string `json:"id"`
+	Imagepath      string `json:"imagepath"`
+	Label          string `json:"label"`
+	Position       int    `json:"position"`
+}
+
+type ListVirtualMachinesParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVirtualMachinesParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["affinitygroupid"]; found {
+		u.Set("affinitygroupid", v.(string))
 	}
 	if v, found := p.p["details"]; found {
 		vv := strings.Join(v.([]string), ",")
@@ -1838,9 +4097,6 @@ func (p *ListVirtualMachinesParams) toURLValues() url.Values {
 	if v, found := p.p["hostid"]; found {
 		u.Set("hostid", v.(string))
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
-	}
 	if v, found := p.p["hypervisor"]; found {
 		u.Set("hypervisor", v.(string))
 	}
@@ -1885,9 +4141,6 @@ func (p *ListVirtualMachinesParams) toURLValues() url.Values {
 	if v, found := p.p["podid"]; found {
 		u.Set("podid", v.(string))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
 	if v, found := p.p["projectid"]; found {
 		u.Set("projectid", v.(string))
 	}
@@ -1903,9 +4156,6 @@ func (p *ListVirtualMachinesParams) toURLValues() url.Values {
 	if v, found := p.p["storageid"]; found {
 		u.Set("storageid", v.(string))
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
-	}
 	if v, found := p.p["tags"]; found {
 		m := v.(map[string]string)
 		for i, k := range getSortedKeysFromMap(m) {
@@ -1928,6 +4178,69 @@ func (p *ListVirtualMachinesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVirtualMachinesParams) Validate() error {
+	v := newParamValidator("listVirtualMachines", p.p)
+	v.ids("affinitygroupid", "domainid", "groupid", "hostid", "id", "isoid", "networkid", "podid", "projectid", "securitygroupid", "serviceofferingid", "storageid", "templateid", "userid", "vpcid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("hypervisor", 255)
+	v.maxLength("keypair", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVirtualMachinesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVirtualMachinesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "affinitygroupid", "domainid", "groupid", "hostid", "hypervisor", "id", "isoid", "keypair", "keyword", "name", "networkid", "podid", "projectid", "securitygroupid", "serviceofferingid", "state", "storageid", "templateid", "userid", "vpcid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details", "ids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "displayvm", "forvirtualnetwork", "haenable", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVirtualMachines", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVirtualMachines: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListVirtualMachinesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1935,6 +4248,15 @@ func (p *ListVirtualMachinesParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListVirtualMachinesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetAffinitygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1942,6 +4264,15 @@ func (p *ListVirtualMachinesParams) SetAffinitygroupid(v string) {
 	p.p["affinitygroupid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetAffinitygroupid() {
+	delete(p.p, "affinitygroupid")
+}
+
+func (p *ListVirtualMachinesParams) GetAffinitygroupid() (string, bool) {
+	value, ok := p.p["affinitygroupid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetDetails(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1949,6 +4280,15 @@ func (p *ListVirtualMachinesParams) SetDetails(v []string) {
 	p.p["details"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *ListVirtualMachinesParams) GetDetails() ([]string, bool) {
+	value, ok := p.p["details"].([]string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetDisplayvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1956,6 +4296,15 @@ func (p *ListVirtualMachinesParams) SetDisplayvm(v bool) {
 	p.p["displayvm"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetDisplayvm() {
+	delete(p.p, "displayvm")
+}
+
+func (p *ListVirtualMachinesParams) GetDisplayvm() (bool, bool) {
+	value, ok := p.p["displayvm"].(bool)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1963,6 +4312,15 @@ func (p *ListVirtualMachinesParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListVirtualMachinesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetForvirtualnetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1970,6 +4328,15 @@ func (p *ListVirtualMachinesParams) SetForvirtualnetwork(v bool) {
 	p.p["forvirtualnetwork"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetForvirtualnetwork() {
+	delete(p.p, "forvirtualnetwork")
+}
+
+func (p *ListVirtualMachinesParams) GetForvirtualnetwork() (bool, bool) {
+	value, ok := p.p["forvirtualnetwork"].(bool)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetGroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1977,6 +4344,15 @@ func (p *ListVirtualMachinesParams) SetGroupid(v string) {
 	p.p["groupid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetGroupid() {
+	delete(p.p, "groupid")
+}
+
+func (p *ListVirtualMachinesParams) GetGroupid() (string, bool) {
+	value, ok := p.p["groupid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetHaenable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1984,6 +4360,15 @@ func (p *ListVirtualMachinesParams) SetHaenable(v bool) {
 	p.p["haenable"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetHaenable() {
+	delete(p.p, "haenable")
+}
+
+func (p *ListVirtualMachinesParams) GetHaenable() (bool, bool) {
+	value, ok := p.p["haenable"].(bool)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1991,6 +4376,15 @@ func (p *ListVirtualMachinesParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ListVirtualMachinesParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1998,6 +4392,15 @@ func (p *ListVirtualMachinesParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *ListVirtualMachinesParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2005,6 +4408,15 @@ func (p *ListVirtualMachinesParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListVirtualMachinesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2012,6 +4424,15 @@ func (p *ListVirtualMachinesParams) SetIds(v []string) {
 	p.p["ids"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetIds() {
+	delete(p.p, "ids")
+}
+
+func (p *ListVirtualMachinesParams) GetIds() ([]string, bool) {
+	value, ok := p.p["ids"].([]string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetIsoid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2019,6 +4440,15 @@ func (p *ListVirtualMachinesParams) SetIsoid(v string) {
 	p.p["isoid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetIsoid() {
+	delete(p.p, "isoid")
+}
+
+func (p *ListVirtualMachinesParams) GetIsoid() (string, bool) {
+	value, ok := p.p["isoid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2026,6 +4456,15 @@ func (p *ListVirtualMachinesParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListVirtualMachinesParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetKeypair(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2033,6 +4472,15 @@ func (p *ListVirtualMachinesParams) SetKeypair(v string) {
 	p.p["keypair"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetKeypair() {
+	delete(p.p, "keypair")
+}
+
+func (p *ListVirtualMachinesParams) GetKeypair() (string, bool) {
+	value, ok := p.p["keypair"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2040,6 +4488,15 @@ func (p *ListVirtualMachinesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVirtualMachinesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2047,6 +4504,15 @@ func (p *ListVirtualMachinesParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListVirtualMachinesParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2054,6 +4520,15 @@ func (p *ListVirtualMachinesParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListVirtualMachinesParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2061,6 +4536,15 @@ func (p *ListVirtualMachinesParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListVirtualMachinesParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2068,6 +4552,15 @@ func (p *ListVirtualMachinesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVirtualMachinesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2075,6 +4568,15 @@ func (p *ListVirtualMachinesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVirtualMachinesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2082,6 +4584,15 @@ func (p *ListVirtualMachinesParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListVirtualMachinesParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2089,6 +4600,15 @@ func (p *ListVirtualMachinesParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListVirtualMachinesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetSecuritygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2096,6 +4616,15 @@ func (p *ListVirtualMachinesParams) SetSecuritygroupid(v string) {
 	p.p["securitygroupid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetSecuritygroupid() {
+	delete(p.p, "securitygroupid")
+}
+
+func (p *ListVirtualMachinesParams) GetSecuritygroupid() (string, bool) {
+	value, ok := p.p["securitygroupid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2103,6 +4632,15 @@ func (p *ListVirtualMachinesParams) SetServiceofferingid(v string) {
 	p.p["serviceofferingid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *ListVirtualMachinesParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2110,6 +4648,15 @@ func (p *ListVirtualMachinesParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListVirtualMachinesParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2117,6 +4664,15 @@ func (p *ListVirtualMachinesParams) SetStorageid(v string) {
 	p.p["storageid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetStorageid() {
+	delete(p.p, "storageid")
+}
+
+func (p *ListVirtualMachinesParams) GetStorageid() (string, bool) {
+	value, ok := p.p["storageid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2124,6 +4680,15 @@ func (p *ListVirtualMachinesParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListVirtualMachinesParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2131,6 +4696,15 @@ func (p *ListVirtualMachinesParams) SetTemplateid(v string) {
 	p.p["templateid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetTemplateid() {
+	delete(p.p, "templateid")
+}
+
+func (p *ListVirtualMachinesParams) GetTemplateid() (string, bool) {
+	value, ok := p.p["templateid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2138,6 +4712,15 @@ func (p *ListVirtualMachinesParams) SetUserid(v string) {
 	p.p["userid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *ListVirtualMachinesParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2145,6 +4728,15 @@ func (p *ListVirtualMachinesParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *ListVirtualMachinesParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2152,6 +4744,15 @@ func (p *ListVirtualMachinesParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListVirtualMachinesParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListVirtualMachinesParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListVirtualMachinesParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewListVirtualMachinesParams() *ListVirtualMachinesParams {
@@ -2162,6 +4763,11 @@ func (s *VirtualMachineService) NewListVirtualMachinesParams() *ListVirtualMachi
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVirtualMachineIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVirtualMachineIDWithContext is the same as GetVirtualMachineID, but uses ctx to cancel the request
+func (s *VirtualMachineService) GetVirtualMachineIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVirtualMachinesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2173,7 +4779,7 @@ func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionF
 		}
 	}
 
-	l, err := s.ListVirtualMachines(p)
+	l, err := s.ListVirtualMachinesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2198,12 +4804,17 @@ func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionF
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachineByName(name string, opts ...OptionFunc) (*VirtualMachine, int, error) {
-	id, count, err := s.GetVirtualMachineID(name, opts...)
+	return s.GetVirtualMachineByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVirtualMachineByNameWithContext is the same as GetVirtualMachineByName, but uses ctx to cancel the requests
+func (s *VirtualMachineService) GetVirtualMachineByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	id, count, err := s.GetVirtualMachineIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVirtualMachineByID(id, opts...)
+	r, count, err := s.GetVirtualMachineByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2212,6 +4823,11 @@ func (s *VirtualMachineService) GetVirtualMachineByName(name string, opts ...Opt
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	return s.GetVirtualMachineByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVirtualMachineByIDWithContext is the same as GetVirtualMachineByID, but uses ctx to cancel the request
+func (s *VirtualMachineService) GetVirtualMachineByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
 	p := &ListVirtualMachinesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2223,18 +4839,16 @@ func (s *VirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionF
 		}
 	}
 
-	l, err := s.ListVirtualMachines(p)
+	l, err := s.ListVirtualMachinesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -2245,7 +4859,16 @@ func (s *VirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionF
 
 // List the virtual machines owned by the account.
 func (s *VirtualMachineService) ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualMachines", p.toURLValues())
+	return s.ListVirtualMachinesWithContext(context.Background(), p)
+}
+
+// ListVirtualMachinesWithContext is the same as ListVirtualMachines, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) ListVirtualMachinesWithContext(ctx context.Context, p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVirtualMachines", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2258,6 +4881,92 @@ func (s *VirtualMachineService) ListVirtualMachines(p *ListVirtualMachinesParams
 	return &r, nil
 }
 
+// ListVirtualMachinesPager iterates over all pages of a ListVirtualMachines call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVirtualMachinesPager struct {
+	pager
+
+	s *VirtualMachineService
+	p *ListVirtualMachinesParams
+	r *ListVirtualMachinesResponse
+}
+
+// NewListVirtualMachinesPager returns a pager for all pages of a ListVirtualMachines call with the given params
+func (s *VirtualMachineService) NewListVirtualMachinesPager(p *ListVirtualMachinesParams) *ListVirtualMachinesPager {
+	return &ListVirtualMachinesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVirtualMachinesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVirtualMachinesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVirtualMachinesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVirtualMachinesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.VirtualMachines), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVirtualMachinesPager) Page() *ListVirtualMachinesResponse {
+	return pg.r
+}
+
+// ListVirtualMachinesAll fetches all pages of a ListVirtualMachines call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VirtualMachineService) ListVirtualMachinesAll(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
+	return s.ListVirtualMachinesAllWithContext(context.Background(), p)
+}
+
+// ListVirtualMachinesAllWithContext is the same as ListVirtualMachinesAll, but uses ctx to cancel the requests
+func (s *VirtualMachineService) ListVirtualMachinesAllWithContext(ctx context.Context, p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*VirtualMachine)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVirtualMachinesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVirtualMachinesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.VirtualMachines
+		mu.Unlock()
+
+		return len(l.VirtualMachines), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVirtualMachinesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.VirtualMachines = append(r.VirtualMachines, pages[page]...)
+	}
+	r.Count = len(r.VirtualMachines)
+
+	return r, nil
+}
+
 type ListVirtualMachinesResponse struct {
 	Count           int               `json:"count"`
 	VirtualMachines []*VirtualMachine `json:"virtualmachine"`
@@ -2410,7 +5119,8 @@ type ListVirtualMachinesMetricsParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListVirtualMachinesMetricsParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVirtualMachinesMetricsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2527,6 +5237,69 @@ func (p *ListVirtualMachinesMetricsParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVirtualMachinesMetricsParams) Validate() error {
+	v := newParamValidator("listVirtualMachinesMetrics", p.p)
+	v.ids("affinitygroupid", "domainid", "groupid", "hostid", "id", "isoid", "networkid", "podid", "projectid", "securitygroupid", "serviceofferingid", "storageid", "templateid", "userid", "vpcid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("hypervisor", 255)
+	v.maxLength("keypair", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVirtualMachinesMetricsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVirtualMachinesMetricsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "affinitygroupid", "domainid", "groupid", "hostid", "hypervisor", "id", "isoid", "keypair", "keyword", "name", "networkid", "podid", "projectid", "securitygroupid", "serviceofferingid", "state", "storageid", "templateid", "userid", "vpcid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details", "ids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "displayvm", "forvirtualnetwork", "haenable", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVirtualMachinesMetrics", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVirtualMachinesMetrics: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2534,6 +5307,15 @@ func (p *ListVirtualMachinesMetricsParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetAffinitygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2541,6 +5323,15 @@ func (p *ListVirtualMachinesMetricsParams) SetAffinitygroupid(v string) {
 	p.p["affinitygroupid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetAffinitygroupid() {
+	delete(p.p, "affinitygroupid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetAffinitygroupid() (string, bool) {
+	value, ok := p.p["affinitygroupid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetDetails(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2548,6 +5339,15 @@ func (p *ListVirtualMachinesMetricsParams) SetDetails(v []string) {
 	p.p["details"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetDetails() ([]string, bool) {
+	value, ok := p.p["details"].([]string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetDisplayvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2555,6 +5355,15 @@ func (p *ListVirtualMachinesMetricsParams) SetDisplayvm(v bool) {
 	p.p["displayvm"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetDisplayvm() {
+	delete(p.p, "displayvm")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetDisplayvm() (bool, bool) {
+	value, ok := p.p["displayvm"].(bool)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2562,6 +5371,15 @@ func (p *ListVirtualMachinesMetricsParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetForvirtualnetwork(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2569,6 +5387,15 @@ func (p *ListVirtualMachinesMetricsParams) SetForvirtualnetwork(v bool) {
 	p.p["forvirtualnetwork"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetForvirtualnetwork() {
+	delete(p.p, "forvirtualnetwork")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetForvirtualnetwork() (bool, bool) {
+	value, ok := p.p["forvirtualnetwork"].(bool)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetGroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2576,6 +5403,15 @@ func (p *ListVirtualMachinesMetricsParams) SetGroupid(v string) {
 	p.p["groupid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetGroupid() {
+	delete(p.p, "groupid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetGroupid() (string, bool) {
+	value, ok := p.p["groupid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetHaenable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2583,6 +5419,15 @@ func (p *ListVirtualMachinesMetricsParams) SetHaenable(v bool) {
 	p.p["haenable"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetHaenable() {
+	delete(p.p, "haenable")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetHaenable() (bool, bool) {
+	value, ok := p.p["haenable"].(bool)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2590,6 +5435,15 @@ func (p *ListVirtualMachinesMetricsParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetHypervisor(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2597,6 +5451,15 @@ func (p *ListVirtualMachinesMetricsParams) SetHypervisor(v string) {
 	p.p["hypervisor"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetHypervisor() {
+	delete(p.p, "hypervisor")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetHypervisor() (string, bool) {
+	value, ok := p.p["hypervisor"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2604,6 +5467,15 @@ func (p *ListVirtualMachinesMetricsParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2611,6 +5483,15 @@ func (p *ListVirtualMachinesMetricsParams) SetIds(v []string) {
 	p.p["ids"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetIds() {
+	delete(p.p, "ids")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetIds() ([]string, bool) {
+	value, ok := p.p["ids"].([]string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetIsoid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2618,6 +5499,15 @@ func (p *ListVirtualMachinesMetricsParams) SetIsoid(v string) {
 	p.p["isoid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetIsoid() {
+	delete(p.p, "isoid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetIsoid() (string, bool) {
+	value, ok := p.p["isoid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2625,6 +5515,15 @@ func (p *ListVirtualMachinesMetricsParams) SetIsrecursive(v bool) {
 	p.p["isrecursive"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetKeypair(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2632,6 +5531,15 @@ func (p *ListVirtualMachinesMetricsParams) SetKeypair(v string) {
 	p.p["keypair"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetKeypair() {
+	delete(p.p, "keypair")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetKeypair() (string, bool) {
+	value, ok := p.p["keypair"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2639,6 +5547,15 @@ func (p *ListVirtualMachinesMetricsParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetListall(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2646,6 +5563,15 @@ func (p *ListVirtualMachinesMetricsParams) SetListall(v bool) {
 	p.p["listall"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2653,6 +5579,15 @@ func (p *ListVirtualMachinesMetricsParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2660,6 +5595,15 @@ func (p *ListVirtualMachinesMetricsParams) SetNetworkid(v string) {
 	p.p["networkid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetNetworkid() {
+	delete(p.p, "networkid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetNetworkid() (string, bool) {
+	value, ok := p.p["networkid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2667,6 +5611,15 @@ func (p *ListVirtualMachinesMetricsParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2674,6 +5627,15 @@ func (p *ListVirtualMachinesMetricsParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2681,6 +5643,15 @@ func (p *ListVirtualMachinesMetricsParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2688,6 +5659,15 @@ func (p *ListVirtualMachinesMetricsParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetSecuritygroupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2695,6 +5675,15 @@ func (p *ListVirtualMachinesMetricsParams) SetSecuritygroupid(v string) {
 	p.p["securitygroupid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetSecuritygroupid() {
+	delete(p.p, "securitygroupid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetSecuritygroupid() (string, bool) {
+	value, ok := p.p["securitygroupid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2702,6 +5691,15 @@ func (p *ListVirtualMachinesMetricsParams) SetServiceofferingid(v string) {
 	p.p["serviceofferingid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2709,6 +5707,15 @@ func (p *ListVirtualMachinesMetricsParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2716,6 +5723,15 @@ func (p *ListVirtualMachinesMetricsParams) SetStorageid(v string) {
 	p.p["storageid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetStorageid() {
+	delete(p.p, "storageid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetStorageid() (string, bool) {
+	value, ok := p.p["storageid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2723,6 +5739,15 @@ func (p *ListVirtualMachinesMetricsParams) SetTags(v map[string]string) {
 	p.p["tags"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2730,6 +5755,15 @@ func (p *ListVirtualMachinesMetricsParams) SetTemplateid(v string) {
 	p.p["templateid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetTemplateid() {
+	delete(p.p, "templateid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetTemplateid() (string, bool) {
+	value, ok := p.p["templateid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2737,6 +5771,15 @@ func (p *ListVirtualMachinesMetricsParams) SetUserid(v string) {
 	p.p["userid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetUserid() {
+	delete(p.p, "userid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetUserid() (string, bool) {
+	value, ok := p.p["userid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetVpcid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2744,6 +5787,15 @@ func (p *ListVirtualMachinesMetricsParams) SetVpcid(v string) {
 	p.p["vpcid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetVpcid() {
+	delete(p.p, "vpcid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetVpcid() (string, bool) {
+	value, ok := p.p["vpcid"].(string)
+	return value, ok
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2751,6 +5803,15 @@ func (p *ListVirtualMachinesMetricsParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListVirtualMachinesMetricsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListVirtualMachinesMetricsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListVirtualMachinesMetricsParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewListVirtualMachinesMetricsParams() *ListVirtualMachinesMetricsParams {
@@ -2761,6 +5822,11 @@ func (s *VirtualMachineService) NewListVirtualMachinesMetricsParams() *ListVirtu
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachinesMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVirtualMachinesMetricIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVirtualMachinesMetricIDWithContext is the same as GetVirtualMachinesMetricID, but uses ctx to cancel the request
+func (s *VirtualMachineService) GetVirtualMachinesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVirtualMachinesMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2772,7 +5838,7 @@ func (s *VirtualMachineService) GetVirtualMachinesMetricID(name string, opts ...
 		}
 	}
 
-	l, err := s.ListVirtualMachinesMetrics(p)
+	l, err := s.ListVirtualMachinesMetricsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2797,12 +5863,17 @@ func (s *VirtualMachineService) GetVirtualMachinesMetricID(name string, opts ...
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachinesMetricByName(name string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error) {
-	id, count, err := s.GetVirtualMachinesMetricID(name, opts...)
+	return s.GetVirtualMachinesMetricByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVirtualMachinesMetricByNameWithContext is the same as GetVirtualMachinesMetricByName, but uses ctx to cancel the requests
+func (s *VirtualMachineService) GetVirtualMachinesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error) {
+	id, count, err := s.GetVirtualMachinesMetricIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVirtualMachinesMetricByID(id, opts...)
+	r, count, err := s.GetVirtualMachinesMetricByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2811,6 +5882,11 @@ func (s *VirtualMachineService) GetVirtualMachinesMetricByName(name string, opts
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachinesMetricByID(id string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error) {
+	return s.GetVirtualMachinesMetricByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVirtualMachinesMetricByIDWithContext is the same as GetVirtualMachinesMetricByID, but uses ctx to cancel the request
+func (s *VirtualMachineService) GetVirtualMachinesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error) {
 	p := &ListVirtualMachinesMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2822,18 +5898,16 @@ func (s *VirtualMachineService) GetVirtualMachinesMetricByID(id string, opts ...
 		}
 	}
 
-	l, err := s.ListVirtualMachinesMetrics(p)
+	l, err := s.ListVirtualMachinesMetricsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
@@ -2844,7 +5918,16 @@ func (s *VirtualMachineService) GetVirtualMachinesMetricByID(id string, opts ...
 
 // Lists VM metrics
 func (s *VirtualMachineService) ListVirtualMachinesMetrics(p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualMachinesMetrics", p.toURLValues())
+	return s.ListVirtualMachinesMetricsWithContext(context.Background(), p)
+}
+
+// ListVirtualMachinesMetricsWithContext is the same as ListVirtualMachinesMetrics, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) ListVirtualMachinesMetricsWithContext(ctx context.Context, p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVirtualMachinesMetrics", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2857,6 +5940,92 @@ func (s *VirtualMachineService) ListVirtualMachinesMetrics(p *ListVirtualMachine
 	return &r, nil
 }
 
+// ListVirtualMachinesMetricsPager iterates over all pages of a ListVirtualMachinesMetrics call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVirtualMachinesMetricsPager struct {
+	pager
+
+	s *VirtualMachineService
+	p *ListVirtualMachinesMetricsParams
+	r *ListVirtualMachinesMetricsResponse
+}
+
+// NewListVirtualMachinesMetricsPager returns a pager for all pages of a ListVirtualMachinesMetrics call with the given params
+func (s *VirtualMachineService) NewListVirtualMachinesMetricsPager(p *ListVirtualMachinesMetricsParams) *ListVirtualMachinesMetricsPager {
+	return &ListVirtualMachinesMetricsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVirtualMachinesMetricsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVirtualMachinesMetricsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVirtualMachinesMetricsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVirtualMachinesMetricsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.VirtualMachinesMetrics), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVirtualMachinesMetricsPager) Page() *ListVirtualMachinesMetricsResponse {
+	return pg.r
+}
+
+// ListVirtualMachinesMetricsAll fetches all pages of a ListVirtualMachinesMetrics call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VirtualMachineService) ListVirtualMachinesMetricsAll(p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error) {
+	return s.ListVirtualMachinesMetricsAllWithContext(context.Background(), p)
+}
+
+// ListVirtualMachinesMetricsAllWithContext is the same as ListVirtualMachinesMetricsAll, but uses ctx to cancel the requests
+func (s *VirtualMachineService) ListVirtualMachinesMetricsAllWithContext(ctx context.Context, p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*VirtualMachinesMetric)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVirtualMachinesMetricsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVirtualMachinesMetricsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.VirtualMachinesMetrics
+		mu.Unlock()
+
+		return len(l.VirtualMachinesMetrics), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVirtualMachinesMetricsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.VirtualMachinesMetrics = append(r.VirtualMachinesMetrics, pages[page]...)
+	}
+	r.Count = len(r.VirtualMachinesMetrics)
+
+	return r, nil
+}
+
 type ListVirtualMachinesMetricsResponse struct {
 	Count                  int                      `json:"count"`
 	VirtualMachinesMetrics []*VirtualMachinesMetric `json:"virtualmachine"`
@@ -3017,7 +6186,8 @@ type MigrateVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *MigrateVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *MigrateVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3028,10 +6198,52 @@ func (p *MigrateVirtualMachineParams) toURLValues() url.Values {
 	if v, found := p.p["storageid"]; found {
 		u.Set("storageid", v.(string))
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *MigrateVirtualMachineParams) Validate() error {
+	v := newParamValidator("migrateVirtualMachine", p.p)
+	v.required("virtualmachineid")
+	v.ids("hostid", "storageid", "virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *MigrateVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *MigrateVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	return u
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid", "storageid", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for migrateVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of migrateVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
 }
 
 func (p *MigrateVirtualMachineParams) SetHostid(v string) {
@@ -3041,6 +6253,15 @@ func (p *MigrateVirtualMachineParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *MigrateVirtualMachineParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *MigrateVirtualMachineParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 func (p *MigrateVirtualMachineParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3048,6 +6269,15 @@ func (p *MigrateVirtualMachineParams) SetStorageid(v string) {
 	p.p["storageid"] = v
 }
 
+func (p *MigrateVirtualMachineParams) ResetStorageid() {
+	delete(p.p, "storageid")
+}
+
+func (p *MigrateVirtualMachineParams) GetStorageid() (string, bool) {
+	value, ok := p.p["storageid"].(string)
+	return value, ok
+}
+
 func (p *MigrateVirtualMachineParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3055,6 +6285,15 @@ func (p *MigrateVirtualMachineParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *MigrateVirtualMachineParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *MigrateVirtualMachineParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new MigrateVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewMigrateVirtualMachineParams(virtualmachineid string) *MigrateVirtualMachineParams {
@@ -3066,7 +6305,16 @@ func (s *VirtualMachineService) NewMigrateVirtualMachineParams(virtualmachineid
 
 // Attempts Migration of a VM to a different host or Root volume of the vm to a different storage pool
 func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("migrateVirtualMachine", p.toURLValues())
+	return s.MigrateVirtualMachineWithContext(context.Background(), p)
+}
+
+// MigrateVirtualMachineWithContext is the same as MigrateVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) MigrateVirtualMachineWithContext(ctx context.Context, p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3078,7 +6326,7 @@ func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachinePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3099,6 +6347,40 @@ func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachinePa
 	return &r, nil
 }
 
+// MigrateVirtualMachineAsync starts the async job for MigrateVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a MigrateVirtualMachineResponse using Job.Result
+func (s *VirtualMachineService) MigrateVirtualMachineAsync(p *MigrateVirtualMachineParams) (*Job, error) {
+	return s.MigrateVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// MigrateVirtualMachineAsyncWithContext is the same as MigrateVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) MigrateVirtualMachineAsyncWithContext(ctx context.Context, p *MigrateVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type MigrateVirtualMachineResponse struct {
 	Account               string                                       `json:"account"`
 	Affinitygroup         []MigrateVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -3246,7 +6528,8 @@ type MigrateVirtualMachineWithVolumeParams struct {
 	p map[string]interface{}
 }
 
-func (p *MigrateVirtualMachineWithVolumeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *MigrateVirtualMachineWithVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3255,10 +6538,13 @@ func (p *MigrateVirtualMachineWithVolumeParams) toURLValues() url.Values {
 		u.Set("hostid", v.(string))
 	}
 	if v, found := p.p["migrateto"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("migrateto[%d].key", i), k)
-			u.Set(fmt.Sprintf("migrateto[%d].value", i), m[k])
+		for i, item := range v.([]VolumeToPool) {
+			if item.Volume != "" {
+				u.Set(fmt.Sprintf("migrateto[%d].volume", i), item.Volume)
+			}
+			if item.Pool != "" {
+				u.Set(fmt.Sprintf("migrateto[%d].pool", i), item.Pool)
+			}
 		}
 	}
 	if v, found := p.p["virtualmachineid"]; found {
@@ -3267,6 +6553,52 @@ func (p *MigrateVirtualMachineWithVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *MigrateVirtualMachineWithVolumeParams) Validate() error {
+	v := newParamValidator("migrateVirtualMachineWithVolume", p.p)
+	v.required("hostid", "virtualmachineid")
+	v.ids("hostid", "virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *MigrateVirtualMachineWithVolumeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *MigrateVirtualMachineWithVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "hostid", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "migrateto":
+			var v []VolumeToPool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for migrateVirtualMachineWithVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of migrateVirtualMachineWithVolume: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *MigrateVirtualMachineWithVolumeParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3274,13 +6606,31 @@ func (p *MigrateVirtualMachineWithVolumeParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
-func (p *MigrateVirtualMachineWithVolumeParams) SetMigrateto(v map[string]string) {
+func (p *MigrateVirtualMachineWithVolumeParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *MigrateVirtualMachineWithVolumeParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
+func (p *MigrateVirtualMachineWithVolumeParams) SetMigrateto(v []VolumeToPool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["migrateto"] = v
 }
 
+func (p *MigrateVirtualMachineWithVolumeParams) ResetMigrateto() {
+	delete(p.p, "migrateto")
+}
+
+func (p *MigrateVirtualMachineWithVolumeParams) GetMigrateto() ([]VolumeToPool, bool) {
+	value, ok := p.p["migrateto"].([]VolumeToPool)
+	return value, ok
+}
+
 func (p *MigrateVirtualMachineWithVolumeParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3288,6 +6638,15 @@ func (p *MigrateVirtualMachineWithVolumeParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *MigrateVirtualMachineWithVolumeParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *MigrateVirtualMachineWithVolumeParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new MigrateVirtualMachineWithVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewMigrateVirtualMachineWithVolumeParams(hostid string, virtualmachineid string) *MigrateVirtualMachineWithVolumeParams {
@@ -3300,7 +6659,16 @@ func (s *VirtualMachineService) NewMigrateVirtualMachineWithVolumeParams(hostid
 
 // Attempts Migration of a VM with its volumes to a different host
 func (s *VirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error) {
-	resp, err := s.cs.newRequest("migrateVirtualMachineWithVolume", p.toURLValues())
+	return s.MigrateVirtualMachineWithVolumeWithContext(context.Background(), p)
+}
+
+// MigrateVirtualMachineWithVolumeWithContext is the same as MigrateVirtualMachineWithVolume, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) MigrateVirtualMachineWithVolumeWithContext(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateVirtualMachineWithVolume", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3312,7 +6680,7 @@ func (s *VirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtua
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3333,6 +6701,40 @@ func (s *VirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtua
 	return &r, nil
 }
 
+// MigrateVirtualMachineWithVolumeAsync starts the async job for MigrateVirtualMachineWithVolume and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a MigrateVirtualMachineWithVolumeResponse using Job.Result
+func (s *VirtualMachineService) MigrateVirtualMachineWithVolumeAsync(p *MigrateVirtualMachineWithVolumeParams) (*Job, error) {
+	return s.MigrateVirtualMachineWithVolumeAsyncWithContext(context.Background(), p)
+}
+
+// MigrateVirtualMachineWithVolumeAsyncWithContext is the same as MigrateVirtualMachineWithVolumeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) MigrateVirtualMachineWithVolumeAsyncWithContext(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateVirtualMachineWithVolume", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateVirtualMachineWithVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type MigrateVirtualMachineWithVolumeResponse struct {
 	Account               string                                                 `json:"account"`
 	Affinitygroup         []MigrateVirtualMachineWithVolumeResponseAffinitygroup `json:"affinitygroup"`
@@ -3480,7 +6882,8 @@ type RebootVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *RebootVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RebootVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3495,6 +6898,52 @@ func (p *RebootVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RebootVirtualMachineParams) Validate() error {
+	v := newParamValidator("rebootVirtualMachine", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RebootVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RebootVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "bootintosetup":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for rebootVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of rebootVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RebootVirtualMachineParams) SetBootintosetup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3502,6 +6951,15 @@ func (p *RebootVirtualMachineParams) SetBootintosetup(v bool) {
 	p.p["bootintosetup"] = v
 }
 
+func (p *RebootVirtualMachineParams) ResetBootintosetup() {
+	delete(p.p, "bootintosetup")
+}
+
+func (p *RebootVirtualMachineParams) GetBootintosetup() (bool, bool) {
+	value, ok := p.p["bootintosetup"].(bool)
+	return value, ok
+}
+
 func (p *RebootVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3509,6 +6967,15 @@ func (p *RebootVirtualMachineParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RebootVirtualMachineParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RebootVirtualMachineParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RebootVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewRebootVirtualMachineParams(id string) *RebootVirtualMachineParams {
@@ -3520,7 +6987,16 @@ func (s *VirtualMachineService) NewRebootVirtualMachineParams(id string) *Reboot
 
 // Reboots a virtual machine.
 func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("rebootVirtualMachine", p.toURLValues())
+	return s.RebootVirtualMachineWithContext(context.Background(), p)
+}
+
+// RebootVirtualMachineWithContext is the same as RebootVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) RebootVirtualMachineWithContext(ctx context.Context, p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "rebootVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3532,7 +7008,7 @@ func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachinePara
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3553,6 +7029,40 @@ func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachinePara
 	return &r, nil
 }
 
+// RebootVirtualMachineAsync starts the async job for RebootVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RebootVirtualMachineResponse using Job.Result
+func (s *VirtualMachineService) RebootVirtualMachineAsync(p *RebootVirtualMachineParams) (*Job, error) {
+	return s.RebootVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// RebootVirtualMachineAsyncWithContext is the same as RebootVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) RebootVirtualMachineAsyncWithContext(ctx context.Context, p *RebootVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "rebootVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RebootVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type RebootVirtualMachineResponse struct {
 	Account               string                                      `json:"account"`
 	Affinitygroup         []RebootVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -3700,7 +7210,8 @@ type RecoverVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *RecoverVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RecoverVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3711,6 +7222,48 @@ func (p *RecoverVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RecoverVirtualMachineParams) Validate() error {
+	v := newParamValidator("recoverVirtualMachine", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RecoverVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RecoverVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for recoverVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of recoverVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RecoverVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3718,6 +7271,15 @@ func (p *RecoverVirtualMachineParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *RecoverVirtualMachineParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RecoverVirtualMachineParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RecoverVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewRecoverVirtualMachineParams(id string) *RecoverVirtualMachineParams {
@@ -3729,7 +7291,16 @@ func (s *VirtualMachineService) NewRecoverVirtualMachineParams(id string) *Recov
 
 // Recovers a virtual machine.
 func (s *VirtualMachineService) RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("recoverVirtualMachine", p.toURLValues())
+	return s.RecoverVirtualMachineWithContext(context.Background(), p)
+}
+
+// RecoverVirtualMachineWithContext is the same as RecoverVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) RecoverVirtualMachineWithContext(ctx context.Context, p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "recoverVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3889,7 +7460,8 @@ type RemoveNicFromVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *RemoveNicFromVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RemoveNicFromVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -3903,6 +7475,48 @@ func (p *RemoveNicFromVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RemoveNicFromVirtualMachineParams) Validate() error {
+	v := newParamValidator("removeNicFromVirtualMachine", p.p)
+	v.required("nicid", "virtualmachineid")
+	v.ids("nicid", "virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RemoveNicFromVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RemoveNicFromVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "nicid", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for removeNicFromVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of removeNicFromVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RemoveNicFromVirtualMachineParams) SetNicid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3910,6 +7524,15 @@ func (p *RemoveNicFromVirtualMachineParams) SetNicid(v string) {
 	p.p["nicid"] = v
 }
 
+func (p *RemoveNicFromVirtualMachineParams) ResetNicid() {
+	delete(p.p, "nicid")
+}
+
+func (p *RemoveNicFromVirtualMachineParams) GetNicid() (string, bool) {
+	value, ok := p.p["nicid"].(string)
+	return value, ok
+}
+
 func (p *RemoveNicFromVirtualMachineParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3917,6 +7540,15 @@ func (p *RemoveNicFromVirtualMachineParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *RemoveNicFromVirtualMachineParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *RemoveNicFromVirtualMachineParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RemoveNicFromVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewRemoveNicFromVirtualMachineParams(nicid string, virtualmachineid string) *RemoveNicFromVirtualMachineParams {
@@ -3929,7 +7561,16 @@ func (s *VirtualMachineService) NewRemoveNicFromVirtualMachineParams(nicid strin
 
 // Removes VM from specified network by deleting a NIC
 func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("removeNicFromVirtualMachine", p.toURLValues())
+	return s.RemoveNicFromVirtualMachineWithContext(context.Background(), p)
+}
+
+// RemoveNicFromVirtualMachineWithContext is the same as RemoveNicFromVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) RemoveNicFromVirtualMachineWithContext(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeNicFromVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3941,7 +7582,7 @@ func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirt
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -3962,6 +7603,40 @@ func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirt
 	return &r, nil
 }
 
+// RemoveNicFromVirtualMachineAsync starts the async job for RemoveNicFromVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RemoveNicFromVirtualMachineResponse using Job.Result
+func (s *VirtualMachineService) RemoveNicFromVirtualMachineAsync(p *RemoveNicFromVirtualMachineParams) (*Job, error) {
+	return s.RemoveNicFromVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// RemoveNicFromVirtualMachineAsyncWithContext is the same as RemoveNicFromVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) RemoveNicFromVirtualMachineAsyncWithContext(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "removeNicFromVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveNicFromVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type RemoveNicFromVirtualMachineResponse struct {
 	Account               string                                             `json:"account"`
 	Affinitygroup         []RemoveNicFromVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -4109,7 +7784,8 @@ type ResetPasswordForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *ResetPasswordForVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ResetPasswordForVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -4120,6 +7796,48 @@ func (p *ResetPasswordForVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ResetPasswordForVirtualMachineParams) Validate() error {
+	v := newParamValidator("resetPasswordForVirtualMachine", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ResetPasswordForVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ResetPasswordForVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for resetPasswordForVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of resetPasswordForVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ResetPasswordForVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4127,6 +7845,15 @@ func (p *ResetPasswordForVirtualMachineParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ResetPasswordForVirtualMachineParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ResetPasswordForVirtualMachineParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ResetPasswordForVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewResetPasswordForVirtualMachineParams(id string) *ResetPasswordForVirtualMachineParams {
@@ -4136,9 +7863,18 @@ func (s *VirtualMachineService) NewResetPasswordForVirtualMachineParams(id strin
 	return p
 }
 
-// Resets the password for virtual machine. The virtual machine must be in a "Stopped" state and the template must already support this feature for this command to take effect. [async]
-func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("resetPasswordForVirtualMachine", p.toURLValues())
+// Resets the password for virtual machine. The virtual machine must be in a "Stopped" state and the template must already support this feature for this command to take effect. [async]
+func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
+	return s.ResetPasswordForVirtualMachineWithContext(context.Background(), p)
+}
+
+// ResetPasswordForVirtualMachineWithContext is the same as ResetPasswordForVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) ResetPasswordForVirtualMachineWithContext(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "resetPasswordForVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4150,7 +7886,7 @@ func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordF
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4171,6 +7907,40 @@ func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordF
 	return &r, nil
 }
 
+// ResetPasswordForVirtualMachineAsync starts the async job for ResetPasswordForVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ResetPasswordForVirtualMachineResponse using Job.Result
+func (s *VirtualMachineService) ResetPasswordForVirtualMachineAsync(p *ResetPasswordForVirtualMachineParams) (*Job, error) {
+	return s.ResetPasswordForVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// ResetPasswordForVirtualMachineAsyncWithContext is the same as ResetPasswordForVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) ResetPasswordForVirtualMachineAsyncWithContext(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "resetPasswordForVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ResetPasswordForVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type ResetPasswordForVirtualMachineResponse struct {
 	Account               string                                                `json:"account"`
 	Affinitygroup         []ResetPasswordForVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -4318,7 +8088,8 @@ type RestoreVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *RestoreVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RestoreVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -4332,6 +8103,48 @@ func (p *RestoreVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RestoreVirtualMachineParams) Validate() error {
+	v := newParamValidator("restoreVirtualMachine", p.p)
+	v.required("virtualmachineid")
+	v.ids("templateid", "virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RestoreVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RestoreVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "templateid", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for restoreVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of restoreVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *RestoreVirtualMachineParams) SetTemplateid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4339,6 +8152,15 @@ func (p *RestoreVirtualMachineParams) SetTemplateid(v string) {
 	p.p["templateid"] = v
 }
 
+func (p *RestoreVirtualMachineParams) ResetTemplateid() {
+	delete(p.p, "templateid")
+}
+
+func (p *RestoreVirtualMachineParams) GetTemplateid() (string, bool) {
+	value, ok := p.p["templateid"].(string)
+	return value, ok
+}
+
 func (p *RestoreVirtualMachineParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4346,6 +8168,15 @@ func (p *RestoreVirtualMachineParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *RestoreVirtualMachineParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *RestoreVirtualMachineParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new RestoreVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewRestoreVirtualMachineParams(virtualmachineid string) *RestoreVirtualMachineParams {
@@ -4357,7 +8188,16 @@ func (s *VirtualMachineService) NewRestoreVirtualMachineParams(virtualmachineid
 
 // Restore a VM to original template/ISO or new template/ISO
 func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("restoreVirtualMachine", p.toURLValues())
+	return s.RestoreVirtualMachineWithContext(context.Background(), p)
+}
+
+// RestoreVirtualMachineWithContext is the same as RestoreVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) RestoreVirtualMachineWithContext(ctx context.Context, p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "restoreVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4369,7 +8209,7 @@ func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachinePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4390,6 +8230,40 @@ func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachinePa
 	return &r, nil
 }
 
+// RestoreVirtualMachineAsync starts the async job for RestoreVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a RestoreVirtualMachineResponse using Job.Result
+func (s *VirtualMachineService) RestoreVirtualMachineAsync(p *RestoreVirtualMachineParams) (*Job, error) {
+	return s.RestoreVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// RestoreVirtualMachineAsyncWithContext is the same as RestoreVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) RestoreVirtualMachineAsyncWithContext(ctx context.Context, p *RestoreVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "restoreVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RestoreVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type RestoreVirtualMachineResponse struct {
 	Account               string                                       `json:"account"`
 	Affinitygroup         []RestoreVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -4537,7 +8411,8 @@ type ScaleVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *ScaleVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ScaleVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -4557,6 +8432,52 @@ func (p *ScaleVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ScaleVirtualMachineParams) Validate() error {
+	v := newParamValidator("scaleVirtualMachine", p.p)
+	v.required("id", "serviceofferingid")
+	v.ids("id", "serviceofferingid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ScaleVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ScaleVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "serviceofferingid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for scaleVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of scaleVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ScaleVirtualMachineParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4564,6 +8485,15 @@ func (p *ScaleVirtualMachineParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *ScaleVirtualMachineParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *ScaleVirtualMachineParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *ScaleVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4571,6 +8501,15 @@ func (p *ScaleVirtualMachineParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ScaleVirtualMachineParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ScaleVirtualMachineParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ScaleVirtualMachineParams) SetServiceofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4578,6 +8517,15 @@ func (p *ScaleVirtualMachineParams) SetServiceofferingid(v string) {
 	p.p["serviceofferingid"] = v
 }
 
+func (p *ScaleVirtualMachineParams) ResetServiceofferingid() {
+	delete(p.p, "serviceofferingid")
+}
+
+func (p *ScaleVirtualMachineParams) GetServiceofferingid() (string, bool) {
+	value, ok := p.p["serviceofferingid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ScaleVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewScaleVirtualMachineParams(id string, serviceofferingid string) *ScaleVirtualMachineParams {
@@ -4590,7 +8538,16 @@ func (s *VirtualMachineService) NewScaleVirtualMachineParams(id string, serviceo
 
 // Scales the virtual machine to a new service offering.
 func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("scaleVirtualMachine", p.toURLValues())
+	return s.ScaleVirtualMachineWithContext(context.Background(), p)
+}
+
+// ScaleVirtualMachineWithContext is the same as ScaleVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) ScaleVirtualMachineWithContext(ctx context.Context, p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "scaleVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4602,7 +8559,7 @@ func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4618,6 +8575,31 @@ func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams
 	return &r, nil
 }
 
+// ScaleVirtualMachineAsync starts the async job for ScaleVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ScaleVirtualMachineResponse using Job.Result
+func (s *VirtualMachineService) ScaleVirtualMachineAsync(p *ScaleVirtualMachineParams) (*Job, error) {
+	return s.ScaleVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// ScaleVirtualMachineAsyncWithContext is the same as ScaleVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) ScaleVirtualMachineAsyncWithContext(ctx context.Context, p *ScaleVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "scaleVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ScaleVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type ScaleVirtualMachineResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -4629,7 +8611,8 @@ type StartVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *StartVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *StartVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -4656,6 +8639,53 @@ func (p *StartVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *StartVirtualMachineParams) Validate() error {
+	v := newParamValidator("startVirtualMachine", p.p)
+	v.required("id")
+	v.ids("clusterid", "hostid", "id", "podid")
+	v.maxLength("deploymentplanner", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *StartVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *StartVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "bootintosetup":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "clusterid", "deploymentplanner", "hostid", "id", "podid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for startVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of startVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *StartVirtualMachineParams) SetBootintosetup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4663,6 +8693,15 @@ func (p *StartVirtualMachineParams) SetBootintosetup(v bool) {
 	p.p["bootintosetup"] = v
 }
 
+func (p *StartVirtualMachineParams) ResetBootintosetup() {
+	delete(p.p, "bootintosetup")
+}
+
+func (p *StartVirtualMachineParams) GetBootintosetup() (bool, bool) {
+	value, ok := p.p["bootintosetup"].(bool)
+	return value, ok
+}
+
 func (p *StartVirtualMachineParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4670,6 +8709,15 @@ func (p *StartVirtualMachineParams) SetClusterid(v string) {
 	p.p["clusterid"] = v
 }
 
+func (p *StartVirtualMachineParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *StartVirtualMachineParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
 func (p *StartVirtualMachineParams) SetDeploymentplanner(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4677,6 +8725,15 @@ func (p *StartVirtualMachineParams) SetDeploymentplanner(v string) {
 	p.p["deploymentplanner"] = v
 }
 
+func (p *StartVirtualMachineParams) ResetDeploymentplanner() {
+	delete(p.p, "deploymentplanner")
+}
+
+func (p *StartVirtualMachineParams) GetDeploymentplanner() (string, bool) {
+	value, ok := p.p["deploymentplanner"].(string)
+	return value, ok
+}
+
 func (p *StartVirtualMachineParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4684,6 +8741,15 @@ func (p *StartVirtualMachineParams) SetHostid(v string) {
 	p.p["hostid"] = v
 }
 
+func (p *StartVirtualMachineParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *StartVirtualMachineParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
 func (p *StartVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4691,6 +8757,15 @@ func (p *StartVirtualMachineParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *StartVirtualMachineParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *StartVirtualMachineParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *StartVirtualMachineParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4698,6 +8773,15 @@ func (p *StartVirtualMachineParams) SetPodid(v string) {
 	p.p["podid"] = v
 }
 
+func (p *StartVirtualMachineParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *StartVirtualMachineParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new StartVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewStartVirtualMachineParams(id string) *StartVirtualMachineParams {
@@ -4709,7 +8793,16 @@ func (s *VirtualMachineService) NewStartVirtualMachineParams(id string) *StartVi
 
 // Starts a virtual machine.
 func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("startVirtualMachine", p.toURLValues())
+	return s.StartVirtualMachineWithContext(context.Background(), p)
+}
+
+// StartVirtualMachineWithContext is the same as StartVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) StartVirtualMachineWithContext(ctx context.Context, p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "startVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4721,7 +8814,7 @@ func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -4742,6 +8835,40 @@ func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams
 	return &r, nil
 }
 
+// StartVirtualMachineAsync starts the async job for StartVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a StartVirtualMachineResponse using Job.Result
+func (s *VirtualMachineService) StartVirtualMachineAsync(p *StartVirtualMachineParams) (*Job, error) {
+	return s.StartVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// StartVirtualMachineAsyncWithContext is the same as StartVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) StartVirtualMachineAsyncWithContext(ctx context.Context, p *StartVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "startVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StartVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type StartVirtualMachineResponse struct {
 	Account               string                                     `json:"account"`
 	Affinitygroup         []StartVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -4889,7 +9016,8 @@ type StopVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *StopVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *StopVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -4904,6 +9032,52 @@ func (p *StopVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *StopVirtualMachineParams) Validate() error {
+	v := newParamValidator("stopVirtualMachine", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *StopVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *StopVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "forced":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for stopVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of stopVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *StopVirtualMachineParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4911,6 +9085,15 @@ func (p *StopVirtualMachineParams) SetForced(v bool) {
 	p.p["forced"] = v
 }
 
+func (p *StopVirtualMachineParams) ResetForced() {
+	delete(p.p, "forced")
+}
+
+func (p *StopVirtualMachineParams) GetForced() (bool, bool) {
+	value, ok := p.p["forced"].(bool)
+	return value, ok
+}
+
 func (p *StopVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4918,6 +9101,15 @@ func (p *StopVirtualMachineParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *StopVirtualMachineParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *StopVirtualMachineParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new StopVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewStopVirtualMachineParams(id string) *StopVirtualMachineParams {
@@ -4927,9 +9119,63 @@ func (s *VirtualMachineService) NewStopVirtualMachineParams(id string) *StopVirt
 	return p
 }
 
-// Stops a virtual machine.
-func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("stopVirtualMachine", p.toURLValues())
+// Stops a virtual machine.
+func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
+	return s.StopVirtualMachineWithContext(context.Background(), p)
+}
+
+// StopVirtualMachineWithContext is the same as StopVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) StopVirtualMachineWithContext(ctx context.Context, p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "stopVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StopVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// StopVirtualMachineAsync starts the async job for StopVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a StopVirtualMachineResponse using Job.Result
+func (s *VirtualMachineService) StopVirtualMachineAsync(p *StopVirtualMachineParams) (*Job, error) {
+	return s.StopVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// StopVirtualMachineAsyncWithContext is the same as StopVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) StopVirtualMachineAsyncWithContext(ctx context.Context, p *StopVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "stopVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4939,27 +9185,16 @@ func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams)
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
 
 		b, err = getRawValue(b)
 		if err != nil {
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-
-	return &r, nil
+		return b, nil
+	}), nil
 }
 
 type StopVirtualMachineResponse struct {
@@ -5105,11 +9340,180 @@ func (r *StopVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	return json.Unmarshal(b, (*alias)(r))
 }
 
+type UnmanageVirtualMachineParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UnmanageVirtualMachineParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UnmanageVirtualMachineParams) Validate() error {
+	v := newParamValidator("unmanageVirtualMachine", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UnmanageVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UnmanageVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for unmanageVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of unmanageVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *UnmanageVirtualMachineParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *UnmanageVirtualMachineParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UnmanageVirtualMachineParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new UnmanageVirtualMachineParams instance,
+// as then you are sure you have configured all required params
+func (s *VirtualMachineService) NewUnmanageVirtualMachineParams(id string) *UnmanageVirtualMachineParams {
+	p := &UnmanageVirtualMachineParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	return p
+}
+
+// Unmanage a guest virtual machine.
+func (s *VirtualMachineService) UnmanageVirtualMachine(p *UnmanageVirtualMachineParams) (*UnmanageVirtualMachineResponse, error) {
+	return s.UnmanageVirtualMachineWithContext(context.Background(), p)
+}
+
+// UnmanageVirtualMachineWithContext is the same as UnmanageVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) UnmanageVirtualMachineWithContext(ctx context.Context, p *UnmanageVirtualMachineParams) (*UnmanageVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "unmanageVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UnmanageVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
+	}
+
+	return &r, nil
+}
+
+// UnmanageVirtualMachineAsync starts the async job for UnmanageVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UnmanageVirtualMachineResponse using Job.Result
+func (s *VirtualMachineService) UnmanageVirtualMachineAsync(p *UnmanageVirtualMachineParams) (*Job, error) {
+	return s.UnmanageVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// UnmanageVirtualMachineAsyncWithContext is the same as UnmanageVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) UnmanageVirtualMachineAsyncWithContext(ctx context.Context, p *UnmanageVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "unmanageVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UnmanageVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type UnmanageVirtualMachineResponse struct {
+	Details   string `json:"details"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Success   bool   `json:"success"`
+}
+
 type UpdateDefaultNicForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateDefaultNicForVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateDefaultNicForVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -5123,6 +9527,48 @@ func (p *UpdateDefaultNicForVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateDefaultNicForVirtualMachineParams) Validate() error {
+	v := newParamValidator("updateDefaultNicForVirtualMachine", p.p)
+	v.required("nicid", "virtualmachineid")
+	v.ids("nicid", "virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateDefaultNicForVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateDefaultNicForVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "nicid", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateDefaultNicForVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateDefaultNicForVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateDefaultNicForVirtualMachineParams) SetNicid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5130,6 +9576,15 @@ func (p *UpdateDefaultNicForVirtualMachineParams) SetNicid(v string) {
 	p.p["nicid"] = v
 }
 
+func (p *UpdateDefaultNicForVirtualMachineParams) ResetNicid() {
+	delete(p.p, "nicid")
+}
+
+func (p *UpdateDefaultNicForVirtualMachineParams) GetNicid() (string, bool) {
+	value, ok := p.p["nicid"].(string)
+	return value, ok
+}
+
 func (p *UpdateDefaultNicForVirtualMachineParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5137,6 +9592,15 @@ func (p *UpdateDefaultNicForVirtualMachineParams) SetVirtualmachineid(v string)
 	p.p["virtualmachineid"] = v
 }
 
+func (p *UpdateDefaultNicForVirtualMachineParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *UpdateDefaultNicForVirtualMachineParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateDefaultNicForVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewUpdateDefaultNicForVirtualMachineParams(nicid string, virtualmachineid string) *UpdateDefaultNicForVirtualMachineParams {
@@ -5149,7 +9613,16 @@ func (s *VirtualMachineService) NewUpdateDefaultNicForVirtualMachineParams(nicid
 
 // Changes the default NIC on a VM
 func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("updateDefaultNicForVirtualMachine", p.toURLValues())
+	return s.UpdateDefaultNicForVirtualMachineWithContext(context.Background(), p)
+}
+
+// UpdateDefaultNicForVirtualMachineWithContext is the same as UpdateDefaultNicForVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachineWithContext(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateDefaultNicForVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -5161,7 +9634,7 @@ func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefau
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -5182,6 +9655,40 @@ func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefau
 	return &r, nil
 }
 
+// UpdateDefaultNicForVirtualMachineAsync starts the async job for UpdateDefaultNicForVirtualMachine and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateDefaultNicForVirtualMachineResponse using Job.Result
+func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachineAsync(p *UpdateDefaultNicForVirtualMachineParams) (*Job, error) {
+	return s.UpdateDefaultNicForVirtualMachineAsyncWithContext(context.Background(), p)
+}
+
+// UpdateDefaultNicForVirtualMachineAsyncWithContext is the same as UpdateDefaultNicForVirtualMachineAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachineAsyncWithContext(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateDefaultNicForVirtualMachine", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateDefaultNicForVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateDefaultNicForVirtualMachineResponse struct {
 	Account               string                                                   `json:"account"`
 	Affinitygroup         []UpdateDefaultNicForVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -5329,7 +9836,8 @@ type UpdateVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateVirtualMachineParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateVirtualMachineParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -5401,6 +9909,67 @@ func (p *UpdateVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateVirtualMachineParams) Validate() error {
+	v := newParamValidator("updateVirtualMachine", p.p)
+	v.required("id")
+	v.ids("id", "ostypeid")
+	v.maxLength("customid", 255)
+	v.maxLength("displayname", 255)
+	v.maxLength("extraconfig", 5120)
+	v.maxLength("group", 255)
+	v.maxLength("instancename", 255)
+	v.maxLength("name", 255)
+	v.maxLength("userdata", 32768)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "cleanupdetails", "displayvm", "haenable", "isdynamicallyscalable":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "customid", "displayname", "extraconfig", "group", "id", "instancename", "name", "ostypeid", "userdata":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details", "dhcpoptionsnetworklist":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "securitygroupids", "securitygroupnames":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateVirtualMachine", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateVirtualMachine: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVirtualMachineParams) SetCleanupdetails(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5408,6 +9977,15 @@ func (p *UpdateVirtualMachineParams) SetCleanupdetails(v bool) {
 	p.p["cleanupdetails"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetCleanupdetails() {
+	delete(p.p, "cleanupdetails")
+}
+
+func (p *UpdateVirtualMachineParams) GetCleanupdetails() (bool, bool) {
+	value, ok := p.p["cleanupdetails"].(bool)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5415,6 +9993,15 @@ func (p *UpdateVirtualMachineParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateVirtualMachineParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5422,6 +10009,15 @@ func (p *UpdateVirtualMachineParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *UpdateVirtualMachineParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetDhcpoptionsnetworklist(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5429,6 +10025,15 @@ func (p *UpdateVirtualMachineParams) SetDhcpoptionsnetworklist(v map[string]stri
 	p.p["dhcpoptionsnetworklist"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetDhcpoptionsnetworklist() {
+	delete(p.p, "dhcpoptionsnetworklist")
+}
+
+func (p *UpdateVirtualMachineParams) GetDhcpoptionsnetworklist() (map[string]string, bool) {
+	value, ok := p.p["dhcpoptionsnetworklist"].(map[string]string)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetDisplayname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5436,6 +10041,15 @@ func (p *UpdateVirtualMachineParams) SetDisplayname(v string) {
 	p.p["displayname"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetDisplayname() {
+	delete(p.p, "displayname")
+}
+
+func (p *UpdateVirtualMachineParams) GetDisplayname() (string, bool) {
+	value, ok := p.p["displayname"].(string)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetDisplayvm(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5443,6 +10057,15 @@ func (p *UpdateVirtualMachineParams) SetDisplayvm(v bool) {
 	p.p["displayvm"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetDisplayvm() {
+	delete(p.p, "displayvm")
+}
+
+func (p *UpdateVirtualMachineParams) GetDisplayvm() (bool, bool) {
+	value, ok := p.p["displayvm"].(bool)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetExtraconfig(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5450,6 +10073,15 @@ func (p *UpdateVirtualMachineParams) SetExtraconfig(v string) {
 	p.p["extraconfig"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetExtraconfig() {
+	delete(p.p, "extraconfig")
+}
+
+func (p *UpdateVirtualMachineParams) GetExtraconfig() (string, bool) {
+	value, ok := p.p["extraconfig"].(string)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetGroup(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5457,6 +10089,15 @@ func (p *UpdateVirtualMachineParams) SetGroup(v string) {
 	p.p["group"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetGroup() {
+	delete(p.p, "group")
+}
+
+func (p *UpdateVirtualMachineParams) GetGroup() (string, bool) {
+	value, ok := p.p["group"].(string)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetHaenable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5464,6 +10105,15 @@ func (p *UpdateVirtualMachineParams) SetHaenable(v bool) {
 	p.p["haenable"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetHaenable() {
+	delete(p.p, "haenable")
+}
+
+func (p *UpdateVirtualMachineParams) GetHaenable() (bool, bool) {
+	value, ok := p.p["haenable"].(bool)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5471,6 +10121,15 @@ func (p *UpdateVirtualMachineParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateVirtualMachineParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetInstancename(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5478,6 +10137,15 @@ func (p *UpdateVirtualMachineParams) SetInstancename(v string) {
 	p.p["instancename"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetInstancename() {
+	delete(p.p, "instancename")
+}
+
+func (p *UpdateVirtualMachineParams) GetInstancename() (string, bool) {
+	value, ok := p.p["instancename"].(string)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetIsdynamicallyscalable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5485,6 +10153,15 @@ func (p *UpdateVirtualMachineParams) SetIsdynamicallyscalable(v bool) {
 	p.p["isdynamicallyscalable"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetIsdynamicallyscalable() {
+	delete(p.p, "isdynamicallyscalable")
+}
+
+func (p *UpdateVirtualMachineParams) GetIsdynamicallyscalable() (bool, bool) {
+	value, ok := p.p["isdynamicallyscalable"].(bool)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5492,6 +10169,15 @@ func (p *UpdateVirtualMachineParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateVirtualMachineParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetOstypeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5499,6 +10185,15 @@ func (p *UpdateVirtualMachineParams) SetOstypeid(v string) {
 	p.p["ostypeid"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetOstypeid() {
+	delete(p.p, "ostypeid")
+}
+
+func (p *UpdateVirtualMachineParams) GetOstypeid() (string, bool) {
+	value, ok := p.p["ostypeid"].(string)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetSecuritygroupids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5506,6 +10201,15 @@ func (p *UpdateVirtualMachineParams) SetSecuritygroupids(v []string) {
 	p.p["securitygroupids"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetSecuritygroupids() {
+	delete(p.p, "securitygroupids")
+}
+
+func (p *UpdateVirtualMachineParams) GetSecuritygroupids() ([]string, bool) {
+	value, ok := p.p["securitygroupids"].([]string)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetSecuritygroupnames(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5513,6 +10217,15 @@ func (p *UpdateVirtualMachineParams) SetSecuritygroupnames(v []string) {
 	p.p["securitygroupnames"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetSecuritygroupnames() {
+	delete(p.p, "securitygroupnames")
+}
+
+func (p *UpdateVirtualMachineParams) GetSecuritygroupnames() ([]string, bool) {
+	value, ok := p.p["securitygroupnames"].([]string)
+	return value, ok
+}
+
 func (p *UpdateVirtualMachineParams) SetUserdata(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5520,6 +10233,15 @@ func (p *UpdateVirtualMachineParams) SetUserdata(v string) {
 	p.p["userdata"] = v
 }
 
+func (p *UpdateVirtualMachineParams) ResetUserdata() {
+	delete(p.p, "userdata")
+}
+
+func (p *UpdateVirtualMachineParams) GetUserdata() (string, bool) {
+	value, ok := p.p["userdata"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateVirtualMachineParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewUpdateVirtualMachineParams(id string) *UpdateVirtualMachineParams {
@@ -5531,7 +10253,16 @@ func (s *VirtualMachineService) NewUpdateVirtualMachineParams(id string) *Update
 
 // Updates properties of a virtual machine. The VM has to be stopped and restarted for the new properties to take effect. UpdateVirtualMachine does not first check whether the VM is stopped. Therefore, stop the VM manually before issuing this call.
 func (s *VirtualMachineService) UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("updateVirtualMachine", p.toURLValues())
+	return s.UpdateVirtualMachineWithContext(context.Background(), p)
+}
+
+// UpdateVirtualMachineWithContext is the same as UpdateVirtualMachine, but uses ctx to cancel the request and any async job polling
+func (s *VirtualMachineService) UpdateVirtualMachineWithContext(ctx context.Context, p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVirtualMachine", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
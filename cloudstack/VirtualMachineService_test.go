@@ -0,0 +1,83 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDeployVirtualMachineParamsJSON(t *testing.T) {
+	cs := NewAsyncClient("http://localhost/client/api", "key", "secret", false)
+
+	p := cs.VirtualMachine.NewDeployVirtualMachineParams("offering", "template", "zone")
+	p.SetName("web1")
+	p.SetStartvm(false)
+	p.SetRootdisksize(20)
+	p.SetNetworkids([]string{"net1", "net2"})
+	p.SetDetails(map[string]string{"cpuNumber": "2"})
+	p.SetIptonetworklist([]IPToNetwork{{NetworkID: "net1", IP: "10.0.0.10"}})
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var decoded DeployVirtualMachineParams
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(decoded.p, p.p) {
+		t.Errorf("Expected the decoded params %#v, got %#v", p.p, decoded.p)
+	}
+	if got, want := decoded.ToURLValues(), p.ToURLValues(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected the decoded params to encode to %v, got %v", want, got)
+	}
+}
+
+func TestDeployVirtualMachineParamsUnmarshalJSONErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		wantErr string
+	}{
+		{
+			name:    "unknown param",
+			json:    `{"zoneid":"zone","unknown":1}`,
+			wantErr: "Unknown param unknown for deployVirtualMachine",
+		},
+		{
+			name:    "invalid type",
+			json:    `{"rootdisksize":"20"}`,
+			wantErr: "Invalid value for param rootdisksize of deployVirtualMachine: json: cannot unmarshal string into Go value of type int64",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p DeployVirtualMachineParams
+			if err := json.Unmarshal([]byte(tt.json), &p); err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Expected error %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
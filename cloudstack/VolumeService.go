@@ -20,18 +20,116 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// VolumeServiceIface is the interface implemented by VolumeService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type VolumeServiceIface interface {
+	NewAttachVolumeParams(id string, virtualmachineid string) *AttachVolumeParams
+	AttachVolume(p *AttachVolumeParams) (*AttachVolumeResponse, error)
+	AttachVolumeWithContext(ctx context.Context, p *AttachVolumeParams) (*AttachVolumeResponse, error)
+	AttachVolumeAsync(p *AttachVolumeParams) (*Job, error)
+	AttachVolumeAsyncWithContext(ctx context.Context, p *AttachVolumeParams) (*Job, error)
+	NewCreateVolumeParams() *CreateVolumeParams
+	CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error)
+	CreateVolumeWithContext(ctx context.Context, p *CreateVolumeParams) (*CreateVolumeResponse, error)
+	CreateVolumeAsync(p *CreateVolumeParams) (*Job, error)
+	CreateVolumeAsyncWithContext(ctx context.Context, p *CreateVolumeParams) (*Job, error)
+	NewDeleteVolumeParams(id string) *DeleteVolumeParams
+	DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error)
+	DeleteVolumeWithContext(ctx context.Context, p *DeleteVolumeParams) (*DeleteVolumeResponse, error)
+	NewDestroyVolumeParams(id string) *DestroyVolumeParams
+	DestroyVolume(p *DestroyVolumeParams) (*DestroyVolumeResponse, error)
+	DestroyVolumeWithContext(ctx context.Context, p *DestroyVolumeParams) (*DestroyVolumeResponse, error)
+	DestroyVolumeAsync(p *DestroyVolumeParams) (*Job, error)
+	DestroyVolumeAsyncWithContext(ctx context.Context, p *DestroyVolumeParams) (*Job, error)
+	NewDetachVolumeParams() *DetachVolumeParams
+	DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error)
+	DetachVolumeWithContext(ctx context.Context, p *DetachVolumeParams) (*DetachVolumeResponse, error)
+	DetachVolumeAsync(p *DetachVolumeParams) (*Job, error)
+	DetachVolumeAsyncWithContext(ctx context.Context, p *DetachVolumeParams) (*Job, error)
+	NewExtractVolumeParams(id string, mode string, zoneid string) *ExtractVolumeParams
+	ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error)
+	ExtractVolumeWithContext(ctx context.Context, p *ExtractVolumeParams) (*ExtractVolumeResponse, error)
+	ExtractVolumeAsync(p *ExtractVolumeParams) (*Job, error)
+	ExtractVolumeAsyncWithContext(ctx context.Context, p *ExtractVolumeParams) (*Job, error)
+	NewGetPathForVolumeParams(volumeid string) *GetPathForVolumeParams
+	GetPathForVolume(p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error)
+	GetPathForVolumeWithContext(ctx context.Context, p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error)
+	NewGetSolidFireVolumeAccessGroupIdsParams(clusterid string, storageid string) *GetSolidFireVolumeAccessGroupIdsParams
+	GetSolidFireVolumeAccessGroupIds(p *GetSolidFireVolumeAccessGroupIdsParams) (*GetSolidFireVolumeAccessGroupIdsResponse, error)
+	GetSolidFireVolumeAccessGroupIdsWithContext(ctx context.Context, p *GetSolidFireVolumeAccessGroupIdsParams) (*GetSolidFireVolumeAccessGroupIdsResponse, error)
+	NewGetSolidFireVolumeSizeParams(volumeid string) *GetSolidFireVolumeSizeParams
+	GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error)
+	GetSolidFireVolumeSizeWithContext(ctx context.Context, p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error)
+	NewGetUploadParamsForVolumeParams(format string, name string, zoneid string) *GetUploadParamsForVolumeParams
+	GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error)
+	GetUploadParamsForVolumeWithContext(ctx context.Context, p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error)
+	NewGetVolumeiScsiNameParams(volumeid string) *GetVolumeiScsiNameParams
+	GetVolumeiScsiName(p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error)
+	GetVolumeiScsiNameWithContext(ctx context.Context, p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error)
+	NewListVolumesParams() *ListVolumesParams
+	GetVolumeID(name string, opts ...OptionFunc) (string, int, error)
+	GetVolumeIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetVolumeByName(name string, opts ...OptionFunc) (*Volume, int, error)
+	GetVolumeByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Volume, int, error)
+	GetVolumeByID(id string, opts ...OptionFunc) (*Volume, int, error)
+	GetVolumeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Volume, int, error)
+	ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error)
+	ListVolumesWithContext(ctx context.Context, p *ListVolumesParams) (*ListVolumesResponse, error)
+	NewListVolumesPager(p *ListVolumesParams) *ListVolumesPager
+	ListVolumesAll(p *ListVolumesParams) (*ListVolumesResponse, error)
+	ListVolumesAllWithContext(ctx context.Context, p *ListVolumesParams) (*ListVolumesResponse, error)
+	NewListVolumesMetricsParams() *ListVolumesMetricsParams
+	GetVolumesMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetVolumesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetVolumesMetricByName(name string, opts ...OptionFunc) (*VolumesMetric, int, error)
+	GetVolumesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VolumesMetric, int, error)
+	GetVolumesMetricByID(id string, opts ...OptionFunc) (*VolumesMetric, int, error)
+	GetVolumesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VolumesMetric, int, error)
+	ListVolumesMetrics(p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error)
+	ListVolumesMetricsWithContext(ctx context.Context, p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error)
+	NewListVolumesMetricsPager(p *ListVolumesMetricsParams) *ListVolumesMetricsPager
+	ListVolumesMetricsAll(p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error)
+	ListVolumesMetricsAllWithContext(ctx context.Context, p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error)
+	NewMigrateVolumeParams(storageid string, volumeid string) *MigrateVolumeParams
+	MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error)
+	MigrateVolumeWithContext(ctx context.Context, p *MigrateVolumeParams) (*MigrateVolumeResponse, error)
+	MigrateVolumeAsync(p *MigrateVolumeParams) (*Job, error)
+	MigrateVolumeAsyncWithContext(ctx context.Context, p *MigrateVolumeParams) (*Job, error)
+	NewRecoverVolumeParams(id string) *RecoverVolumeParams
+	RecoverVolume(p *RecoverVolumeParams) (*RecoverVolumeResponse, error)
+	RecoverVolumeWithContext(ctx context.Context, p *RecoverVolumeParams) (*RecoverVolumeResponse, error)
+	NewResizeVolumeParams(id string) *ResizeVolumeParams
+	ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error)
+	ResizeVolumeWithContext(ctx context.Context, p *ResizeVolumeParams) (*ResizeVolumeResponse, error)
+	ResizeVolumeAsync(p *ResizeVolumeParams) (*Job, error)
+	ResizeVolumeAsyncWithContext(ctx context.Context, p *ResizeVolumeParams) (*Job, error)
+	NewUpdateVolumeParams() *UpdateVolumeParams
+	UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error)
+	UpdateVolumeWithContext(ctx context.Context, p *UpdateVolumeParams) (*UpdateVolumeResponse, error)
+	UpdateVolumeAsync(p *UpdateVolumeParams) (*Job, error)
+	UpdateVolumeAsyncWithContext(ctx context.Context, p *UpdateVolumeParams) (*Job, error)
+	NewUploadVolumeParams(format string, name string, url string, zoneid string) *UploadVolumeParams
+	UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error)
+	UploadVolumeWithContext(ctx context.Context, p *UploadVolumeParams) (*UploadVolumeResponse, error)
+	UploadVolumeAsync(p *UploadVolumeParams) (*Job, error)
+	UploadVolumeAsyncWithContext(ctx context.Context, p *UploadVolumeParams) (*Job, error)
+}
+
 type AttachVolumeParams struct {
 	p map[string]interface{}
 }
 
-func (p *AttachVolumeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *AttachVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -49,6 +147,52 @@ func (p *AttachVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *AttachVolumeParams) Validate() error {
+	v := newParamValidator("attachVolume", p.p)
+	v.required("id", "virtualmachineid")
+	v.ids("id", "virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *AttachVolumeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *AttachVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "deviceid":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for attachVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of attachVolume: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *AttachVolumeParams) SetDeviceid(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -56,6 +200,15 @@ func (p *AttachVolumeParams) SetDeviceid(v int64) {
 	p.p["deviceid"] = v
 }
 
+func (p *AttachVolumeParams) ResetDeviceid() {
+	delete(p.p, "deviceid")
+}
+
+func (p *AttachVolumeParams) GetDeviceid() (int64, bool) {
+	value, ok := p.p["deviceid"].(int64)
+	return value, ok
+}
+
 func (p *AttachVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -63,6 +216,15 @@ func (p *AttachVolumeParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *AttachVolumeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *AttachVolumeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *AttachVolumeParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -70,6 +232,15 @@ func (p *AttachVolumeParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *AttachVolumeParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *AttachVolumeParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new AttachVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewAttachVolumeParams(id string, virtualmachineid string) *AttachVolumeParams {
@@ -82,7 +253,16 @@ func (s *VolumeService) NewAttachVolumeParams(id string, virtualmachineid string
 
 // Attaches a disk volume to a virtual machine.
 func (s *VolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeResponse, error) {
-	resp, err := s.cs.newRequest("attachVolume", p.toURLValues())
+	return s.AttachVolumeWithContext(context.Background(), p)
+}
+
+// AttachVolumeWithContext is the same as AttachVolume, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) AttachVolumeWithContext(ctx context.Context, p *AttachVolumeParams) (*AttachVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "attachVolume", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +274,7 @@ func (s *VolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -115,6 +295,40 @@ func (s *VolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeRespon
 	return &r, nil
 }
 
+// AttachVolumeAsync starts the async job for AttachVolume and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a AttachVolumeResponse using Job.Result
+func (s *VolumeService) AttachVolumeAsync(p *AttachVolumeParams) (*Job, error) {
+	return s.AttachVolumeAsyncWithContext(context.Background(), p)
+}
+
+// AttachVolumeAsyncWithContext is the same as AttachVolumeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VolumeService) AttachVolumeAsyncWithContext(ctx context.Context, p *AttachVolumeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "attachVolume", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AttachVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type AttachVolumeResponse struct {
 	Account                    string `json:"account"`
 	Attached                   string `json:"attached"`
@@ -186,7 +400,8 @@ type CreateVolumeParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateVolumeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -237,6 +452,58 @@ func (p *CreateVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateVolumeParams) Validate() error {
+	v := newParamValidator("createVolume", p.p)
+	v.ids("diskofferingid", "domainid", "projectid", "snapshotid", "virtualmachineid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("customid", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateVolumeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "customid", "diskofferingid", "domainid", "name", "projectid", "snapshotid", "virtualmachineid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "displayvolume":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "maxiops", "miniops", "size":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createVolume: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateVolumeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -244,6 +511,15 @@ func (p *CreateVolumeParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *CreateVolumeParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *CreateVolumeParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *CreateVolumeParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -251,6 +527,15 @@ func (p *CreateVolumeParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *CreateVolumeParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *CreateVolumeParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *CreateVolumeParams) SetDiskofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -258,6 +543,15 @@ func (p *CreateVolumeParams) SetDiskofferingid(v string) {
 	p.p["diskofferingid"] = v
 }
 
+func (p *CreateVolumeParams) ResetDiskofferingid() {
+	delete(p.p, "diskofferingid")
+}
+
+func (p *CreateVolumeParams) GetDiskofferingid() (string, bool) {
+	value, ok := p.p["diskofferingid"].(string)
+	return value, ok
+}
+
 func (p *CreateVolumeParams) SetDisplayvolume(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -265,6 +559,15 @@ func (p *CreateVolumeParams) SetDisplayvolume(v bool) {
 	p.p["displayvolume"] = v
 }
 
+func (p *CreateVolumeParams) ResetDisplayvolume() {
+	delete(p.p, "displayvolume")
+}
+
+func (p *CreateVolumeParams) GetDisplayvolume() (bool, bool) {
+	value, ok := p.p["displayvolume"].(bool)
+	return value, ok
+}
+
 func (p *CreateVolumeParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -272,6 +575,15 @@ func (p *CreateVolumeParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateVolumeParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateVolumeParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateVolumeParams) SetMaxiops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -279,6 +591,15 @@ func (p *CreateVolumeParams) SetMaxiops(v int64) {
 	p.p["maxiops"] = v
 }
 
+func (p *CreateVolumeParams) ResetMaxiops() {
+	delete(p.p, "maxiops")
+}
+
+func (p *CreateVolumeParams) GetMaxiops() (int64, bool) {
+	value, ok := p.p["maxiops"].(int64)
+	return value, ok
+}
+
 func (p *CreateVolumeParams) SetMiniops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -286,6 +607,15 @@ func (p *CreateVolumeParams) SetMiniops(v int64) {
 	p.p["miniops"] = v
 }
 
+func (p *CreateVolumeParams) ResetMiniops() {
+	delete(p.p, "miniops")
+}
+
+func (p *CreateVolumeParams) GetMiniops() (int64, bool) {
+	value, ok := p.p["miniops"].(int64)
+	return value, ok
+}
+
 func (p *CreateVolumeParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -293,6 +623,15 @@ func (p *CreateVolumeParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateVolumeParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateVolumeParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateVolumeParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -300,6 +639,15 @@ func (p *CreateVolumeParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *CreateVolumeParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *CreateVolumeParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *CreateVolumeParams) SetSize(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -307,6 +655,15 @@ func (p *CreateVolumeParams) SetSize(v int64) {
 	p.p["size"] = v
 }
 
+func (p *CreateVolumeParams) ResetSize() {
+	delete(p.p, "size")
+}
+
+func (p *CreateVolumeParams) GetSize() (int64, bool) {
+	value, ok := p.p["size"].(int64)
+	return value, ok
+}
+
 func (p *CreateVolumeParams) SetSnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -314,6 +671,15 @@ func (p *CreateVolumeParams) SetSnapshotid(v string) {
 	p.p["snapshotid"] = v
 }
 
+func (p *CreateVolumeParams) ResetSnapshotid() {
+	delete(p.p, "snapshotid")
+}
+
+func (p *CreateVolumeParams) GetSnapshotid() (string, bool) {
+	value, ok := p.p["snapshotid"].(string)
+	return value, ok
+}
+
 func (p *CreateVolumeParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -321,6 +687,15 @@ func (p *CreateVolumeParams) SetVirtualmachineid(v string) {
 	p.p["virtualmachineid"] = v
 }
 
+func (p *CreateVolumeParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *CreateVolumeParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
 func (p *CreateVolumeParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -328,6 +703,15 @@ func (p *CreateVolumeParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *CreateVolumeParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *CreateVolumeParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewCreateVolumeParams() *CreateVolumeParams {
@@ -338,7 +722,16 @@ func (s *VolumeService) NewCreateVolumeParams() *CreateVolumeParams {
 
 // Creates a disk volume from a disk offering. This disk volume must still be attached to a virtual machine to make use of it.
 func (s *VolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("createVolume", p.toURLValues())
+	return s.CreateVolumeWithContext(context.Background(), p)
+}
+
+// CreateVolumeWithContext is the same as CreateVolume, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) CreateVolumeWithContext(ctx context.Context, p *CreateVolumeParams) (*CreateVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVolume", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -350,7 +743,7 @@ func (s *VolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -371,6 +764,40 @@ func (s *VolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeRespon
 	return &r, nil
 }
 
+// CreateVolumeAsync starts the async job for CreateVolume and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a CreateVolumeResponse using Job.Result
+func (s *VolumeService) CreateVolumeAsync(p *CreateVolumeParams) (*Job, error) {
+	return s.CreateVolumeAsyncWithContext(context.Background(), p)
+}
+
+// CreateVolumeAsyncWithContext is the same as CreateVolumeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VolumeService) CreateVolumeAsyncWithContext(ctx context.Context, p *CreateVolumeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createVolume", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type CreateVolumeResponse struct {
 	Account                    string `json:"account"`
 	Attached                   string `json:"attached"`
@@ -442,7 +869,8 @@ type DeleteVolumeParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteVolumeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -453,6 +881,48 @@ func (p *DeleteVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteVolumeParams) Validate() error {
+	v := newParamValidator("deleteVolume", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteVolumeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteVolume: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -460,6 +930,15 @@ func (p *DeleteVolumeParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteVolumeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteVolumeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewDeleteVolumeParams(id string) *DeleteVolumeParams {
@@ -471,7 +950,16 @@ func (s *VolumeService) NewDeleteVolumeParams(id string) *DeleteVolumeParams {
 
 // Deletes a detached disk volume.
 func (s *VolumeService) DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
-	resp, err := s.cs.newRequest("deleteVolume", p.toURLValues())
+	return s.DeleteVolumeWithContext(context.Background(), p)
+}
+
+// DeleteVolumeWithContext is the same as DeleteVolume, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) DeleteVolumeWithContext(ctx context.Context, p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteVolume", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -518,72 +1006,137 @@ func (r *DeleteVolumeResponse) UnmarshalJSON(b []byte) error {
 	return json.Unmarshal(b, (*alias)(r))
 }
 
-type DetachVolumeParams struct {
+type DestroyVolumeParams struct {
 	p map[string]interface{}
 }
 
-func (p *DetachVolumeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DestroyVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["deviceid"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("deviceid", vv)
+	if v, found := p.p["expunge"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("expunge", vv)
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
 	return u
 }
 
-func (p *DetachVolumeParams) SetDeviceid(v int64) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DestroyVolumeParams) Validate() error {
+	v := newParamValidator("destroyVolume", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DestroyVolumeParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["deviceid"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *DetachVolumeParams) SetId(v string) {
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DestroyVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "expunge":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for destroyVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of destroyVolume: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DestroyVolumeParams) SetExpunge(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["id"] = v
+	p.p["expunge"] = v
 }
 
-func (p *DetachVolumeParams) SetVirtualmachineid(v string) {
+func (p *DestroyVolumeParams) ResetExpunge() {
+	delete(p.p, "expunge")
+}
+
+func (p *DestroyVolumeParams) GetExpunge() (bool, bool) {
+	value, ok := p.p["expunge"].(bool)
+	return value, ok
+}
+
+func (p *DestroyVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["virtualmachineid"] = v
+	p.p["id"] = v
 }
 
-// You should always use this function to get a new DetachVolumeParams instance,
+func (p *DestroyVolumeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DestroyVolumeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DestroyVolumeParams instance,
 // as then you are sure you have configured all required params
-func (s *VolumeService) NewDetachVolumeParams() *DetachVolumeParams {
-	p := &DetachVolumeParams{}
+func (s *VolumeService) NewDestroyVolumeParams(id string) *DestroyVolumeParams {
+	p := &DestroyVolumeParams{}
 	p.p = make(map[string]interface{})
+	p.p["id"] = id
 	return p
 }
 
-// Detaches a disk volume from a virtual machine.
-func (s *VolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error) {
-	resp, err := s.cs.newRequest("detachVolume", p.toURLValues())
+// Destroys a Volume.
+func (s *VolumeService) DestroyVolume(p *DestroyVolumeParams) (*DestroyVolumeResponse, error) {
+	return s.DestroyVolumeWithContext(context.Background(), p)
+}
+
+// DestroyVolumeWithContext is the same as DestroyVolume, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) DestroyVolumeWithContext(ctx context.Context, p *DestroyVolumeParams) (*DestroyVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "destroyVolume", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DetachVolumeResponse
+	var r DestroyVolumeResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -604,7 +1157,41 @@ func (s *VolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeRespon
 	return &r, nil
 }
 
-type DetachVolumeResponse struct {
+// DestroyVolumeAsync starts the async job for DestroyVolume and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DestroyVolumeResponse using Job.Result
+func (s *VolumeService) DestroyVolumeAsync(p *DestroyVolumeParams) (*Job, error) {
+	return s.DestroyVolumeAsyncWithContext(context.Background(), p)
+}
+
+// DestroyVolumeAsyncWithContext is the same as DestroyVolumeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VolumeService) DestroyVolumeAsyncWithContext(ctx context.Context, p *DestroyVolumeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "destroyVolume", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DestroyVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
+type DestroyVolumeResponse struct {
 	Account                    string `json:"account"`
 	Attached                   string `json:"attached"`
 	Chaininfo                  string `json:"chaininfo"`
@@ -671,84 +1258,154 @@ type DetachVolumeResponse struct {
 	Zonename                   string `json:"zonename"`
 }
 
-type ExtractVolumeParams struct {
+type DetachVolumeParams struct {
 	p map[string]interface{}
 }
 
-func (p *ExtractVolumeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DetachVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
+	if v, found := p.p["deviceid"]; found {
+		vv := strconv.FormatInt(v.(int64), 10)
+		u.Set("deviceid", vv)
+	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
 	}
-	if v, found := p.p["mode"]; found {
-		u.Set("mode", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
 	}
 	return u
 }
 
-func (p *ExtractVolumeParams) SetId(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DetachVolumeParams) Validate() error {
+	v := newParamValidator("detachVolume", p.p)
+	v.ids("id", "virtualmachineid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DetachVolumeParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["id"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *ExtractVolumeParams) SetMode(v string) {
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DetachVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "deviceid":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "id", "virtualmachineid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for detachVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of detachVolume: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *DetachVolumeParams) SetDeviceid(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["mode"] = v
+	p.p["deviceid"] = v
 }
 
-func (p *ExtractVolumeParams) SetUrl(v string) {
+func (p *DetachVolumeParams) ResetDeviceid() {
+	delete(p.p, "deviceid")
+}
+
+func (p *DetachVolumeParams) GetDeviceid() (int64, bool) {
+	value, ok := p.p["deviceid"].(int64)
+	return value, ok
+}
+
+func (p *DetachVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["url"] = v
+	p.p["id"] = v
 }
 
-func (p *ExtractVolumeParams) SetZoneid(v string) {
+func (p *DetachVolumeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DetachVolumeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *DetachVolumeParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["zoneid"] = v
+	p.p["virtualmachineid"] = v
 }
 
-// You should always use this function to get a new ExtractVolumeParams instance,
+func (p *DetachVolumeParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *DetachVolumeParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new DetachVolumeParams instance,
 // as then you are sure you have configured all required params
-func (s *VolumeService) NewExtractVolumeParams(id string, mode string, zoneid string) *ExtractVolumeParams {
-	p := &ExtractVolumeParams{}
+func (s *VolumeService) NewDetachVolumeParams() *DetachVolumeParams {
+	p := &DetachVolumeParams{}
 	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["mode"] = mode
-	p.p["zoneid"] = zoneid
 	return p
 }
 
-// Extracts volume
-func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
-	resp, err := s.cs.newRequest("extractVolume", p.toURLValues())
+// Detaches a disk volume from a virtual machine.
+func (s *VolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error) {
+	return s.DetachVolumeWithContext(context.Background(), p)
+}
+
+// DetachVolumeWithContext is the same as DetachVolume, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) DetachVolumeWithContext(ctx context.Context, p *DetachVolumeParams) (*DetachVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "detachVolume", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ExtractVolumeResponse
+	var r DetachVolumeResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -769,250 +1426,448 @@ func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeRes
 	return &r, nil
 }
 
-type ExtractVolumeResponse struct {
-	Accountid        string `json:"accountid"`
-	Created          string `json:"created"`
-	ExtractId        string `json:"extractId"`
-	ExtractMode      string `json:"extractMode"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Name             string `json:"name"`
-	Resultstring     string `json:"resultstring"`
-	State            string `json:"state"`
-	Status           string `json:"status"`
-	Storagetype      string `json:"storagetype"`
-	Uploadpercentage int    `json:"uploadpercentage"`
-	Url              string `json:"url"`
-	Zoneid           string `json:"zoneid"`
-	Zonename         string `json:"zonename"`
-}
-
-type GetPathForVolumeParams struct {
-	p map[string]interface{}
-}
-
-func (p *GetPathForVolumeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
-	}
-	return u
+// DetachVolumeAsync starts the async job for DetachVolume and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DetachVolumeResponse using Job.Result
+func (s *VolumeService) DetachVolumeAsync(p *DetachVolumeParams) (*Job, error) {
+	return s.DetachVolumeAsyncWithContext(context.Background(), p)
 }
 
-func (p *GetPathForVolumeParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// DetachVolumeAsyncWithContext is the same as DetachVolumeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VolumeService) DetachVolumeAsyncWithContext(ctx context.Context, p *DetachVolumeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
-	p.p["volumeid"] = v
-}
-
-// You should always use this function to get a new GetPathForVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewGetPathForVolumeParams(volumeid string) *GetPathForVolumeParams {
-	p := &GetPathForVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["volumeid"] = volumeid
-	return p
-}
 
-// Get the path associated with the provided volume UUID
-func (s *VolumeService) GetPathForVolume(p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error) {
-	resp, err := s.cs.newRequest("getPathForVolume", p.toURLValues())
+	resp, err := s.cs.newRequestWithContext(ctx, "detachVolume", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r GetPathForVolumeResponse
+	var r DetachVolumeResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
-	return &r, nil
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
 }
 
-type GetPathForVolumeResponse struct {
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Path      string `json:"path"`
+type DetachVolumeResponse struct {
+	Account                    string `json:"account"`
+	Attached                   string `json:"attached"`
+	Chaininfo                  string `json:"chaininfo"`
+	Clusterid                  string `json:"clusterid"`
+	Clustername                string `json:"clustername"`
+	Created                    string `json:"created"`
+	Destroyed                  bool   `json:"destroyed"`
+	Deviceid                   int64  `json:"deviceid"`
+	DiskBytesReadRate          int64  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         int64  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           int64  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          int64  `json:"diskIopsWriteRate"`
+	Diskioread                 int64  `json:"diskioread"`
+	Diskiowrite                int64  `json:"diskiowrite"`
+	Diskkbsread                int64  `json:"diskkbsread"`
+	Diskkbswrite               int64  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string `json:"diskofferingdisplaytext"`
+	Diskofferingid             string `json:"diskofferingid"`
+	Diskofferingname           string `json:"diskofferingname"`
+	Displayvolume              bool   `json:"displayvolume"`
+	Domain                     string `json:"domain"`
+	Domainid                   string `json:"domainid"`
+	Hypervisor                 string `json:"hypervisor"`
+	Id                         string `json:"id"`
+	Isextractable              bool   `json:"isextractable"`
+	Isodisplaytext             string `json:"isodisplaytext"`
+	Isoid                      string `json:"isoid"`
+	Isoname                    string `json:"isoname"`
+	JobID                      string `json:"jobid"`
+	Jobstatus                  int    `json:"jobstatus"`
+	Maxiops                    int64  `json:"maxiops"`
+	Miniops                    int64  `json:"miniops"`
+	Name                       string `json:"name"`
+	Path                       string `json:"path"`
+	Physicalsize               int64  `json:"physicalsize"`
+	Podid                      string `json:"podid"`
+	Podname                    string `json:"podname"`
+	Project                    string `json:"project"`
+	Projectid                  string `json:"projectid"`
+	Provisioningtype           string `json:"provisioningtype"`
+	Quiescevm                  bool   `json:"quiescevm"`
+	Serviceofferingdisplaytext string `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string `json:"serviceofferingid"`
+	Serviceofferingname        string `json:"serviceofferingname"`
+	Size                       int64  `json:"size"`
+	Snapshotid                 string `json:"snapshotid"`
+	State                      string `json:"state"`
+	Status                     string `json:"status"`
+	Storage                    string `json:"storage"`
+	Storageid                  string `json:"storageid"`
+	Storagetype                string `json:"storagetype"`
+	Tags                       []Tags `json:"tags"`
+	Templatedisplaytext        string `json:"templatedisplaytext"`
+	Templateid                 string `json:"templateid"`
+	Templatename               string `json:"templatename"`
+	Type                       string `json:"type"`
+	Utilization                string `json:"utilization"`
+	Virtualmachineid           string `json:"virtualmachineid"`
+	Virtualsize                int64  `json:"virtualsize"`
+	Vmdisplayname              string `json:"vmdisplayname"`
+	Vmname                     string `json:"vmname"`
+	Vmstate                    string `json:"vmstate"`
+	Zoneid                     string `json:"zoneid"`
+	Zonename                   string `json:"zonename"`
 }
 
-type GetSolidFireVolumeSizeParams struct {
+type ExtractVolumeParams struct {
 	p map[string]interface{}
 }
 
-func (p *GetSolidFireVolumeSizeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ExtractVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["mode"]; found {
+		u.Set("mode", v.(string))
+	}
+	if v, found := p.p["url"]; found {
+		u.Set("url", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
 	}
 	return u
 }
 
-func (p *GetSolidFireVolumeSizeParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["volumeid"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ExtractVolumeParams) Validate() error {
+	v := newParamValidator("extractVolume", p.p)
+	v.required("id", "mode", "zoneid")
+	v.ids("id", "zoneid")
+	v.maxLength("mode", 255)
+	v.maxLength("url", 2048)
+	return v.err()
 }
 
-// You should always use this function to get a new GetSolidFireVolumeSizeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewGetSolidFireVolumeSizeParams(volumeid string) *GetSolidFireVolumeSizeParams {
-	p := &GetSolidFireVolumeSizeParams{}
-	p.p = make(map[string]interface{})
-	p.p["volumeid"] = volumeid
-	return p
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ExtractVolumeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
 }
 
-// Get the SF volume size including Hypervisor Snapshot Reserve
-func (s *VolumeService) GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error) {
-	resp, err := s.cs.newRequest("getSolidFireVolumeSize", p.toURLValues())
-	if err != nil {
-		return nil, err
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ExtractVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
 
-	var r GetSolidFireVolumeSizeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id", "mode", "url", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for extractVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of extractVolume: %w", k, err)
+		}
 	}
+	return nil
+}
 
-	return &r, nil
+func (p *ExtractVolumeParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
 }
 
-type GetSolidFireVolumeSizeResponse struct {
-	JobID               string `json:"jobid"`
-	Jobstatus           int    `json:"jobstatus"`
-	SolidFireVolumeSize int64  `json:"solidFireVolumeSize"`
+func (p *ExtractVolumeParams) ResetId() {
+	delete(p.p, "id")
 }
 
-type GetUploadParamsForVolumeParams struct {
-	p map[string]interface{}
+func (p *ExtractVolumeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
 }
 
-func (p *GetUploadParamsForVolumeParams) toURLValues() url.Values {
-	u := url.Values{}
+func (p *ExtractVolumeParams) SetMode(v string) {
 	if p.p == nil {
-		return u
+		p.p = make(map[string]interface{})
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	p.p["mode"] = v
+}
+
+func (p *ExtractVolumeParams) ResetMode() {
+	delete(p.p, "mode")
+}
+
+func (p *ExtractVolumeParams) GetMode() (string, bool) {
+	value, ok := p.p["mode"].(string)
+	return value, ok
+}
+
+func (p *ExtractVolumeParams) SetUrl(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
-	if v, found := p.p["checksum"]; found {
-		u.Set("checksum", v.(string))
+	p.p["url"] = v
+}
+
+func (p *ExtractVolumeParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *ExtractVolumeParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
+func (p *ExtractVolumeParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
-	if v, found := p.p["diskofferingid"]; found {
-		u.Set("diskofferingid", v.(string))
+	p.p["zoneid"] = v
+}
+
+func (p *ExtractVolumeParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ExtractVolumeParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ExtractVolumeParams instance,
+// as then you are sure you have configured all required params
+func (s *VolumeService) NewExtractVolumeParams(id string, mode string, zoneid string) *ExtractVolumeParams {
+	p := &ExtractVolumeParams{}
+	p.p = make(map[string]interface{})
+	p.p["id"] = id
+	p.p["mode"] = mode
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// Extracts volume
+func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
+	return s.ExtractVolumeWithContext(context.Background(), p)
+}
+
+// ExtractVolumeWithContext is the same as ExtractVolume, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) ExtractVolumeWithContext(ctx context.Context, p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+
+	resp, err := s.cs.newRequestWithContext(ctx, "extractVolume", p.ToURLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["format"]; found {
-		u.Set("format", v.(string))
+
+	var r ExtractVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["imagestoreuuid"]; found {
-		u.Set("imagestoreuuid", v.(string))
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
+			}
+			return nil, err
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+
+	return &r, nil
+}
+
+// ExtractVolumeAsync starts the async job for ExtractVolume and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ExtractVolumeResponse using Job.Result
+func (s *VolumeService) ExtractVolumeAsync(p *ExtractVolumeParams) (*Job, error) {
+	return s.ExtractVolumeAsyncWithContext(context.Background(), p)
+}
+
+// ExtractVolumeAsyncWithContext is the same as ExtractVolumeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VolumeService) ExtractVolumeAsyncWithContext(ctx context.Context, p *ExtractVolumeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+
+	resp, err := s.cs.newRequestWithContext(ctx, "extractVolume", p.ToURLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+
+	var r ExtractVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
-	return u
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
 }
 
-func (p *GetUploadParamsForVolumeParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+type ExtractVolumeResponse struct {
+	Accountid        string `json:"accountid"`
+	Created          string `json:"created"`
+	ExtractId        string `json:"extractId"`
+	ExtractMode      string `json:"extractMode"`
+	Id               string `json:"id"`
+	JobID            string `json:"jobid"`
+	Jobstatus        int    `json:"jobstatus"`
+	Name             string `json:"name"`
+	Resultstring     string `json:"resultstring"`
+	State            string `json:"state"`
+	Status           string `json:"status"`
+	Storagetype      string `json:"storagetype"`
+	Uploadpercentage int    `json:"uploadpercentage"`
+	Url              string `json:"url"`
+	Zoneid           string `json:"zoneid"`
+	Zonename         string `json:"zonename"`
 }
 
-func (p *GetUploadParamsForVolumeParams) SetChecksum(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["checksum"] = v
+type GetPathForVolumeParams struct {
+	p map[string]interface{}
 }
 
-func (p *GetUploadParamsForVolumeParams) SetDiskofferingid(v string) {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetPathForVolumeParams) ToURLValues() url.Values {
+	u := url.Values{}
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return u
 	}
-	p.p["diskofferingid"] = v
+	if v, found := p.p["volumeid"]; found {
+		u.Set("volumeid", v.(string))
+	}
+	return u
 }
 
-func (p *GetUploadParamsForVolumeParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetPathForVolumeParams) Validate() error {
+	v := newParamValidator("getPathForVolume", p.p)
+	v.required("volumeid")
+	v.maxLength("volumeid", 255)
+	return v.err()
 }
 
-func (p *GetUploadParamsForVolumeParams) SetFormat(v string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetPathForVolumeParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["format"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *GetUploadParamsForVolumeParams) SetImagestoreuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetPathForVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["imagestoreuuid"] = v
-}
 
-func (p *GetUploadParamsForVolumeParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "volumeid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getPathForVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getPathForVolume: %w", k, err)
+		}
 	}
-	p.p["name"] = v
+	return nil
 }
 
-func (p *GetUploadParamsForVolumeParams) SetProjectid(v string) {
+func (p *GetPathForVolumeParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["projectid"] = v
+	p.p["volumeid"] = v
 }
 
-func (p *GetUploadParamsForVolumeParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+func (p *GetPathForVolumeParams) ResetVolumeid() {
+	delete(p.p, "volumeid")
 }
 
-// You should always use this function to get a new GetUploadParamsForVolumeParams instance,
+func (p *GetPathForVolumeParams) GetVolumeid() (string, bool) {
+	value, ok := p.p["volumeid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new GetPathForVolumeParams instance,
 // as then you are sure you have configured all required params
-func (s *VolumeService) NewGetUploadParamsForVolumeParams(format string, name string, zoneid string) *GetUploadParamsForVolumeParams {
-	p := &GetUploadParamsForVolumeParams{}
+func (s *VolumeService) NewGetPathForVolumeParams(volumeid string) *GetPathForVolumeParams {
+	p := &GetPathForVolumeParams{}
 	p.p = make(map[string]interface{})
-	p.p["format"] = format
-	p.p["name"] = name
-	p.p["zoneid"] = zoneid
+	p.p["volumeid"] = volumeid
 	return p
 }
 
-// Upload a data disk to the cloudstack cloud.
-func (s *VolumeService) GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error) {
-	resp, err := s.cs.newRequest("getUploadParamsForVolume", p.toURLValues())
+// Get the path associated with the provided volume UUID
+func (s *VolumeService) GetPathForVolume(p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error) {
+	return s.GetPathForVolumeWithContext(context.Background(), p)
+}
+
+// GetPathForVolumeWithContext is the same as GetPathForVolume, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) GetPathForVolumeWithContext(ctx context.Context, p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getPathForVolume", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r GetUploadParamsForVolumeResponse
+	var r GetPathForVolumeResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
@@ -1020,55 +1875,133 @@ func (s *VolumeService) GetUploadParamsForVolume(p *GetUploadParamsForVolumePara
 	return &r, nil
 }
 
-type GetUploadParamsForVolumeResponse struct {
-	Expires   string `json:"expires"`
-	Id        string `json:"id"`
+type GetPathForVolumeResponse struct {
 	JobID     string `json:"jobid"`
 	Jobstatus int    `json:"jobstatus"`
-	Metadata  string `json:"metadata"`
-	PostURL   string `json:"postURL"`
-	Signature string `json:"signature"`
+	Path      string `json:"path"`
 }
 
-type GetVolumeiScsiNameParams struct {
+type GetSolidFireVolumeAccessGroupIdsParams struct {
 	p map[string]interface{}
 }
 
-func (p *GetVolumeiScsiNameParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetSolidFireVolumeAccessGroupIdsParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if v, found := p.p["clusterid"]; found {
+		u.Set("clusterid", v.(string))
+	}
+	if v, found := p.p["storageid"]; found {
+		u.Set("storageid", v.(string))
 	}
 	return u
 }
 
-func (p *GetVolumeiScsiNameParams) SetVolumeid(v string) {
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetSolidFireVolumeAccessGroupIdsParams) Validate() error {
+	v := newParamValidator("getSolidFireVolumeAccessGroupIds", p.p)
+	v.required("clusterid", "storageid")
+	v.maxLength("clusterid", 255)
+	v.maxLength("storageid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetSolidFireVolumeAccessGroupIdsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetSolidFireVolumeAccessGroupIdsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "clusterid", "storageid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getSolidFireVolumeAccessGroupIds", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getSolidFireVolumeAccessGroupIds: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *GetSolidFireVolumeAccessGroupIdsParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["volumeid"] = v
+	p.p["clusterid"] = v
 }
 
-// You should always use this function to get a new GetVolumeiScsiNameParams instance,
+func (p *GetSolidFireVolumeAccessGroupIdsParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *GetSolidFireVolumeAccessGroupIdsParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
+func (p *GetSolidFireVolumeAccessGroupIdsParams) SetStorageid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["storageid"] = v
+}
+
+func (p *GetSolidFireVolumeAccessGroupIdsParams) ResetStorageid() {
+	delete(p.p, "storageid")
+}
+
+func (p *GetSolidFireVolumeAccessGroupIdsParams) GetStorageid() (string, bool) {
+	value, ok := p.p["storageid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new GetSolidFireVolumeAccessGroupIdsParams instance,
 // as then you are sure you have configured all required params
-func (s *VolumeService) NewGetVolumeiScsiNameParams(volumeid string) *GetVolumeiScsiNameParams {
-	p := &GetVolumeiScsiNameParams{}
+func (s *VolumeService) NewGetSolidFireVolumeAccessGroupIdsParams(clusterid string, storageid string) *GetSolidFireVolumeAccessGroupIdsParams {
+	p := &GetSolidFireVolumeAccessGroupIdsParams{}
 	p.p = make(map[string]interface{})
-	p.p["volumeid"] = volumeid
+	p.p["clusterid"] = clusterid
+	p.p["storageid"] = storageid
 	return p
 }
 
-// Get Volume's iSCSI Name
-func (s *VolumeService) GetVolumeiScsiName(p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error) {
-	resp, err := s.cs.newRequest("getVolumeiScsiName", p.toURLValues())
+// Get the SF Volume Access Group IDs
+func (s *VolumeService) GetSolidFireVolumeAccessGroupIds(p *GetSolidFireVolumeAccessGroupIdsParams) (*GetSolidFireVolumeAccessGroupIdsResponse, error) {
+	return s.GetSolidFireVolumeAccessGroupIdsWithContext(context.Background(), p)
+}
+
+// GetSolidFireVolumeAccessGroupIdsWithContext is the same as GetSolidFireVolumeAccessGroupIds, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) GetSolidFireVolumeAccessGroupIdsWithContext(ctx context.Context, p *GetSolidFireVolumeAccessGroupIdsParams) (*GetSolidFireVolumeAccessGroupIdsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getSolidFireVolumeAccessGroupIds", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r GetVolumeiScsiNameResponse
+	var r GetSolidFireVolumeAccessGroupIdsResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
@@ -1076,366 +2009,2322 @@ func (s *VolumeService) GetVolumeiScsiName(p *GetVolumeiScsiNameParams) (*GetVol
 	return &r, nil
 }
 
-type GetVolumeiScsiNameResponse struct {
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	VolumeiScsiName string `json:"volumeiScsiName"`
+type GetSolidFireVolumeAccessGroupIdsResponse struct {
+	JobID                         string `json:"jobid"`
+	Jobstatus                     int    `json:"jobstatus"`
+	SolidFireVolumeAccessGroupIds string `json:"solidFireVolumeAccessGroupIds"`
 }
 
-type ListVolumesParams struct {
+type GetSolidFireVolumeSizeParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListVolumesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetSolidFireVolumeSizeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v, found := p.p["volumeid"]; found {
+		u.Set("volumeid", v.(string))
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetSolidFireVolumeSizeParams) Validate() error {
+	v := newParamValidator("getSolidFireVolumeSize", p.p)
+	v.required("volumeid")
+	v.maxLength("volumeid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetSolidFireVolumeSizeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
 	}
-	if v, found := p.p["diskofferingid"]; found {
-		u.Set("diskofferingid", v.(string))
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetSolidFireVolumeSizeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	if v, found := p.p["displayvolume"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayvolume", vv)
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "volumeid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getSolidFireVolumeSize", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getSolidFireVolumeSize: %w", k, err)
+		}
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	return nil
+}
+
+func (p *GetSolidFireVolumeSizeParams) SetVolumeid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	p.p["volumeid"] = v
+}
+
+func (p *GetSolidFireVolumeSizeParams) ResetVolumeid() {
+	delete(p.p, "volumeid")
+}
+
+func (p *GetSolidFireVolumeSizeParams) GetVolumeid() (string, bool) {
+	value, ok := p.p["volumeid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new GetSolidFireVolumeSizeParams instance,
+// as then you are sure you have configured all required params
+func (s *VolumeService) NewGetSolidFireVolumeSizeParams(volumeid string) *GetSolidFireVolumeSizeParams {
+	p := &GetSolidFireVolumeSizeParams{}
+	p.p = make(map[string]interface{})
+	p.p["volumeid"] = volumeid
+	return p
+}
+
+// Get the SF volume size including Hypervisor Snapshot Reserve
+func (s *VolumeService) GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error) {
+	return s.GetSolidFireVolumeSizeWithContext(context.Background(), p)
+}
+
+// GetSolidFireVolumeSizeWithContext is the same as GetSolidFireVolumeSize, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) GetSolidFireVolumeSizeWithContext(ctx context.Context, p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getSolidFireVolumeSize", p.ToURLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ",")
-		u.Set("ids", vv)
+
+	var r GetSolidFireVolumeSizeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+
+	return &r, nil
+}
+
+type GetSolidFireVolumeSizeResponse struct {
+	JobID               string `json:"jobid"`
+	Jobstatus           int    `json:"jobstatus"`
+	SolidFireVolumeSize int64  `json:"solidFireVolumeSize"`
+}
+
+type GetUploadParamsForVolumeParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetUploadParamsForVolumeParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if v, found := p.p["checksum"]; found {
+		u.Set("checksum", v.(string))
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v, found := p.p["diskofferingid"]; found {
+		u.Set("diskofferingid", v.(string))
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v, found := p.p["format"]; found {
+		u.Set("format", v.(string))
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if v, found := p.p["imagestoreuuid"]; found {
+		u.Set("imagestoreuuid", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
 	}
 	if v, found := p.p["projectid"]; found {
 		u.Set("projectid", v.(string))
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetUploadParamsForVolumeParams) Validate() error {
+	v := newParamValidator("getUploadParamsForVolume", p.p)
+	v.required("format", "name", "zoneid")
+	v.ids("diskofferingid", "domainid", "projectid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("checksum", 255)
+	v.maxLength("format", 255)
+	v.maxLength("imagestoreuuid", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetUploadParamsForVolumeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
 	}
-	if v, found := p.p["tags"]; found {
-		m := v.(map[string]string)
-		for i, k := range getSortedKeysFromMap(m) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetUploadParamsForVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "checksum", "diskofferingid", "domainid", "format", "imagestoreuuid", "name", "projectid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getUploadParamsForVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getUploadParamsForVolume: %w", k, err)
 		}
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	return nil
+}
+
+func (p *GetUploadParamsForVolumeParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	p.p["account"] = v
+}
+
+func (p *GetUploadParamsForVolumeParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *GetUploadParamsForVolumeParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForVolumeParams) SetChecksum(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	p.p["checksum"] = v
+}
+
+func (p *GetUploadParamsForVolumeParams) ResetChecksum() {
+	delete(p.p, "checksum")
+}
+
+func (p *GetUploadParamsForVolumeParams) GetChecksum() (string, bool) {
+	value, ok := p.p["checksum"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForVolumeParams) SetDiskofferingid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
 	}
-	return u
+	p.p["diskofferingid"] = v
 }
 
-func (p *ListVolumesParams) SetAccount(v string) {
+func (p *GetUploadParamsForVolumeParams) ResetDiskofferingid() {
+	delete(p.p, "diskofferingid")
+}
+
+func (p *GetUploadParamsForVolumeParams) GetDiskofferingid() (string, bool) {
+	value, ok := p.p["diskofferingid"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForVolumeParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["account"] = v
+	p.p["domainid"] = v
 }
 
-func (p *ListVolumesParams) SetClusterid(v string) {
+func (p *GetUploadParamsForVolumeParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *GetUploadParamsForVolumeParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForVolumeParams) SetFormat(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["clusterid"] = v
+	p.p["format"] = v
+}
+
+func (p *GetUploadParamsForVolumeParams) ResetFormat() {
+	delete(p.p, "format")
+}
+
+func (p *GetUploadParamsForVolumeParams) GetFormat() (string, bool) {
+	value, ok := p.p["format"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForVolumeParams) SetImagestoreuuid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["imagestoreuuid"] = v
+}
+
+func (p *GetUploadParamsForVolumeParams) ResetImagestoreuuid() {
+	delete(p.p, "imagestoreuuid")
+}
+
+func (p *GetUploadParamsForVolumeParams) GetImagestoreuuid() (string, bool) {
+	value, ok := p.p["imagestoreuuid"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForVolumeParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *GetUploadParamsForVolumeParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *GetUploadParamsForVolumeParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForVolumeParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *GetUploadParamsForVolumeParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *GetUploadParamsForVolumeParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *GetUploadParamsForVolumeParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *GetUploadParamsForVolumeParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *GetUploadParamsForVolumeParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new GetUploadParamsForVolumeParams instance,
+// as then you are sure you have configured all required params
+func (s *VolumeService) NewGetUploadParamsForVolumeParams(format string, name string, zoneid string) *GetUploadParamsForVolumeParams {
+	p := &GetUploadParamsForVolumeParams{}
+	p.p = make(map[string]interface{})
+	p.p["format"] = format
+	p.p["name"] = name
+	p.p["zoneid"] = zoneid
+	return p
+}
+
+// Upload a data disk to the cloudstack cloud.
+func (s *VolumeService) GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error) {
+	return s.GetUploadParamsForVolumeWithContext(context.Background(), p)
+}
+
+// GetUploadParamsForVolumeWithContext is the same as GetUploadParamsForVolume, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) GetUploadParamsForVolumeWithContext(ctx context.Context, p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getUploadParamsForVolume", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r GetUploadParamsForVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type GetUploadParamsForVolumeResponse struct {
+	Expires   string `json:"expires"`
+	Id        string `json:"id"`
+	JobID     string `json:"jobid"`
+	Jobstatus int    `json:"jobstatus"`
+	Metadata  string `json:"metadata"`
+	PostURL   string `json:"postURL"`
+	Signature string `json:"signature"`
+}
+
+type GetVolumeiScsiNameParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *GetVolumeiScsiNameParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["volumeid"]; found {
+		u.Set("volumeid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *GetVolumeiScsiNameParams) Validate() error {
+	v := newParamValidator("getVolumeiScsiName", p.p)
+	v.required("volumeid")
+	v.maxLength("volumeid", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *GetVolumeiScsiNameParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *GetVolumeiScsiNameParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "volumeid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for getVolumeiScsiName", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of getVolumeiScsiName: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *GetVolumeiScsiNameParams) SetVolumeid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["volumeid"] = v
+}
+
+func (p *GetVolumeiScsiNameParams) ResetVolumeid() {
+	delete(p.p, "volumeid")
+}
+
+func (p *GetVolumeiScsiNameParams) GetVolumeid() (string, bool) {
+	value, ok := p.p["volumeid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new GetVolumeiScsiNameParams instance,
+// as then you are sure you have configured all required params
+func (s *VolumeService) NewGetVolumeiScsiNameParams(volumeid string) *GetVolumeiScsiNameParams {
+	p := &GetVolumeiScsiNameParams{}
+	p.p = make(map[string]interface{})
+	p.p["volumeid"] = volumeid
+	return p
+}
+
+// Get Volume's iSCSI Name
+func (s *VolumeService) GetVolumeiScsiName(p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error) {
+	return s.GetVolumeiScsiNameWithContext(context.Background(), p)
+}
+
+// GetVolumeiScsiNameWithContext is the same as GetVolumeiScsiName, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) GetVolumeiScsiNameWithContext(ctx context.Context, p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "getVolumeiScsiName", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r GetVolumeiScsiNameResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+type GetVolumeiScsiNameResponse struct {
+	JobID           string `json:"jobid"`
+	Jobstatus       int    `json:"jobstatus"`
+	VolumeiScsiName string `json:"volumeiScsiName"`
+}
+
+type ListVolumesParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVolumesParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["clusterid"]; found {
+		u.Set("clusterid", v.(string))
+	}
+	if v, found := p.p["diskofferingid"]; found {
+		u.Set("diskofferingid", v.(string))
+	}
+	if v, found := p.p["displayvolume"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("displayvolume", vv)
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["hostid"]; found {
+		u.Set("hostid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["ids"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("ids", vv)
+	}
+	if v, found := p.p["isrecursive"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isrecursive", vv)
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["listall"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("listall", vv)
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["podid"]; found {
+		u.Set("podid", v.(string))
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["state"]; found {
+		u.Set("state", v.(string))
+	}
+	if v, found := p.p["storageid"]; found {
+		u.Set("storageid", v.(string))
+	}
+	if v, found := p.p["tags"]; found {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
+		}
+	}
+	if v, found := p.p["type"]; found {
+		u.Set("type", v.(string))
+	}
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVolumesParams) Validate() error {
+	v := newParamValidator("listVolumes", p.p)
+	v.ids("clusterid", "diskofferingid", "domainid", "hostid", "id", "podid", "projectid", "virtualmachineid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	v.maxLength("storageid", 255)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVolumesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVolumesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "clusterid", "diskofferingid", "domainid", "hostid", "id", "keyword", "name", "podid", "projectid", "state", "storageid", "type", "virtualmachineid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "displayvolume", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVolumes", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVolumes: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListVolumesParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *ListVolumesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListVolumesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetClusterid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["clusterid"] = v
+}
+
+func (p *ListVolumesParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ListVolumesParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetDiskofferingid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["diskofferingid"] = v
+}
+
+func (p *ListVolumesParams) ResetDiskofferingid() {
+	delete(p.p, "diskofferingid")
+}
+
+func (p *ListVolumesParams) GetDiskofferingid() (string, bool) {
+	value, ok := p.p["diskofferingid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetDisplayvolume(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["displayvolume"] = v
+}
+
+func (p *ListVolumesParams) ResetDisplayvolume() {
+	delete(p.p, "displayvolume")
+}
+
+func (p *ListVolumesParams) GetDisplayvolume() (bool, bool) {
+	value, ok := p.p["displayvolume"].(bool)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *ListVolumesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListVolumesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetHostid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostid"] = v
+}
+
+func (p *ListVolumesParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ListVolumesParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListVolumesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListVolumesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetIds(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ids"] = v
+}
+
+func (p *ListVolumesParams) ResetIds() {
+	delete(p.p, "ids")
+}
+
+func (p *ListVolumesParams) GetIds() ([]string, bool) {
+	value, ok := p.p["ids"].([]string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetIsrecursive(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isrecursive"] = v
+}
+
+func (p *ListVolumesParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListVolumesParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListVolumesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVolumesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetListall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["listall"] = v
+}
+
+func (p *ListVolumesParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListVolumesParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ListVolumesParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListVolumesParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListVolumesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVolumesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListVolumesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVolumesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetPodid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["podid"] = v
+}
+
+func (p *ListVolumesParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListVolumesParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *ListVolumesParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListVolumesParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetState(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["state"] = v
+}
+
+func (p *ListVolumesParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListVolumesParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetStorageid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["storageid"] = v
+}
+
+func (p *ListVolumesParams) ResetStorageid() {
+	delete(p.p, "storageid")
+}
+
+func (p *ListVolumesParams) GetStorageid() (string, bool) {
+	value, ok := p.p["storageid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetTags(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["tags"] = v
+}
+
+func (p *ListVolumesParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListVolumesParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetType(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["type"] = v
+}
+
+func (p *ListVolumesParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ListVolumesParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+}
+
+func (p *ListVolumesParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *ListVolumesParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ListVolumesParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListVolumesParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListVolumesParams instance,
+// as then you are sure you have configured all required params
+func (s *VolumeService) NewListVolumesParams() *ListVolumesParams {
+	p := &ListVolumesParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VolumeService) GetVolumeID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVolumeIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVolumeIDWithContext is the same as GetVolumeID, but uses ctx to cancel the request
+func (s *VolumeService) GetVolumeIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListVolumesParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["name"] = name
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListVolumesWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.Volumes[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.Volumes {
+			if v.Name == name {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VolumeService) GetVolumeByName(name string, opts ...OptionFunc) (*Volume, int, error) {
+	return s.GetVolumeByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVolumeByNameWithContext is the same as GetVolumeByName, but uses ctx to cancel the requests
+func (s *VolumeService) GetVolumeByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Volume, int, error) {
+	id, count, err := s.GetVolumeIDWithContext(ctx, name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetVolumeByIDWithContext(ctx, id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VolumeService) GetVolumeByID(id string, opts ...OptionFunc) (*Volume, int, error) {
+	return s.GetVolumeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVolumeByIDWithContext is the same as GetVolumeByID, but uses ctx to cancel the request
+func (s *VolumeService) GetVolumeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Volume, int, error) {
+	p := &ListVolumesParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListVolumesWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.Volumes[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for Volume UUID: %s!", id)
+}
+
+// Lists all volumes.
+func (s *VolumeService) ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error) {
+	return s.ListVolumesWithContext(context.Background(), p)
+}
+
+// ListVolumesWithContext is the same as ListVolumes, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) ListVolumesWithContext(ctx context.Context, p *ListVolumesParams) (*ListVolumesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVolumes", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListVolumesResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListVolumesPager iterates over all pages of a ListVolumes call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVolumesPager struct {
+	pager
+
+	s *VolumeService
+	p *ListVolumesParams
+	r *ListVolumesResponse
+}
+
+// NewListVolumesPager returns a pager for all pages of a ListVolumes call with the given params
+func (s *VolumeService) NewListVolumesPager(p *ListVolumesParams) *ListVolumesPager {
+	return &ListVolumesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVolumesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVolumesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVolumesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVolumesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Volumes), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVolumesPager) Page() *ListVolumesResponse {
+	return pg.r
+}
+
+// ListVolumesAll fetches all pages of a ListVolumes call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VolumeService) ListVolumesAll(p *ListVolumesParams) (*ListVolumesResponse, error) {
+	return s.ListVolumesAllWithContext(context.Background(), p)
+}
+
+// ListVolumesAllWithContext is the same as ListVolumesAll, but uses ctx to cancel the requests
+func (s *VolumeService) ListVolumesAllWithContext(ctx context.Context, p *ListVolumesParams) (*ListVolumesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Volume)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVolumesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVolumesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Volumes
+		mu.Unlock()
+
+		return len(l.Volumes), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVolumesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Volumes = append(r.Volumes, pages[page]...)
+	}
+	r.Count = len(r.Volumes)
+
+	return r, nil
+}
+
+type ListVolumesResponse struct {
+	Count   int       `json:"count"`
+	Volumes []*Volume `json:"volume"`
+}
+
+type Volume struct {
+	Account                    string `json:"account"`
+	Attached                   string `json:"attached"`
+	Chaininfo                  string `json:"chaininfo"`
+	Clusterid                  string `json:"clusterid"`
+	Clustername                string `json:"clustername"`
+	Created                    string `json:"created"`
+	Destroyed                  bool   `json:"destroyed"`
+	Deviceid                   int64  `json:"deviceid"`
+	DiskBytesReadRate          int64  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         int64  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           int64  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          int64  `json:"diskIopsWriteRate"`
+	Diskioread                 int64  `json:"diskioread"`
+	Diskiowrite                int64  `json:"diskiowrite"`
+	Diskkbsread                int64  `json:"diskkbsread"`
+	Diskkbswrite               int64  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string `json:"diskofferingdisplaytext"`
+	Diskofferingid             string `json:"diskofferingid"`
+	Diskofferingname           string `json:"diskofferingname"`
+	Displayvolume              bool   `json:"displayvolume"`
+	Domain                     string `json:"domain"`
+	Domainid                   string `json:"domainid"`
+	Hypervisor                 string `json:"hypervisor"`
+	Id                         string `json:"id"`
+	Isextractable              bool   `json:"isextractable"`
+	Isodisplaytext             string `json:"isodisplaytext"`
+	Isoid                      string `json:"isoid"`
+	Isoname                    string `json:"isoname"`
+	JobID                      string `json:"jobid"`
+	Jobstatus                  int    `json:"jobstatus"`
+	Maxiops                    int64  `json:"maxiops"`
+	Miniops                    int64  `json:"miniops"`
+	Name                       string `json:"name"`
+	Path                       string `json:"path"`
+	Physicalsize               int64  `json:"physicalsize"`
+	Podid                      string `json:"podid"`
+	Podname                    string `json:"podname"`
+	Project                    string `json:"project"`
+	Projectid                  string `json:"projectid"`
+	Provisioningtype           string `json:"provisioningtype"`
+	Quiescevm                  bool   `json:"quiescevm"`
+	Serviceofferingdisplaytext string `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string `json:"serviceofferingid"`
+	Serviceofferingname        string `json:"serviceofferingname"`
+	Size                       int64  `json:"size"`
+	Snapshotid                 string `json:"snapshotid"`
+	State                      string `json:"state"`
+	Status                     string `json:"status"`
+	Storage                    string `json:"storage"`
+	Storageid                  string `json:"storageid"`
+	Storagetype                string `json:"storagetype"`
+	Tags                       []Tags `json:"tags"`
+	Templatedisplaytext        string `json:"templatedisplaytext"`
+	Templateid                 string `json:"templateid"`
+	Templatename               string `json:"templatename"`
+	Type                       string `json:"type"`
+	Utilization                string `json:"utilization"`
+	Virtualmachineid           string `json:"virtualmachineid"`
+	Virtualsize                int64  `json:"virtualsize"`
+	Vmdisplayname              string `json:"vmdisplayname"`
+	Vmname                     string `json:"vmname"`
+	Vmstate                    string `json:"vmstate"`
+	Zoneid                     string `json:"zoneid"`
+	Zonename                   string `json:"zonename"`
+}
+
+type ListVolumesMetricsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListVolumesMetricsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["account"]; found {
+		u.Set("account", v.(string))
+	}
+	if v, found := p.p["clusterid"]; found {
+		u.Set("clusterid", v.(string))
+	}
+	if v, found := p.p["diskofferingid"]; found {
+		u.Set("diskofferingid", v.(string))
+	}
+	if v, found := p.p["displayvolume"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("displayvolume", vv)
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["hostid"]; found {
+		u.Set("hostid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["ids"]; found {
+		vv := strings.Join(v.([]string), ",")
+		u.Set("ids", vv)
+	}
+	if v, found := p.p["isrecursive"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("isrecursive", vv)
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["listall"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("listall", vv)
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["podid"]; found {
+		u.Set("podid", v.(string))
+	}
+	if v, found := p.p["projectid"]; found {
+		u.Set("projectid", v.(string))
+	}
+	if v, found := p.p["state"]; found {
+		u.Set("state", v.(string))
+	}
+	if v, found := p.p["storageid"]; found {
+		u.Set("storageid", v.(string))
+	}
+	if v, found := p.p["tags"]; found {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
+		}
+	}
+	if v, found := p.p["type"]; found {
+		u.Set("type", v.(string))
+	}
+	if v, found := p.p["virtualmachineid"]; found {
+		u.Set("virtualmachineid", v.(string))
+	}
+	if v, found := p.p["zoneid"]; found {
+		u.Set("zoneid", v.(string))
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListVolumesMetricsParams) Validate() error {
+	v := newParamValidator("listVolumesMetrics", p.p)
+	v.ids("clusterid", "diskofferingid", "domainid", "hostid", "id", "podid", "projectid", "virtualmachineid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("state", 255)
+	v.maxLength("storageid", 255)
+	v.maxLength("type", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListVolumesMetricsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListVolumesMetricsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "clusterid", "diskofferingid", "domainid", "hostid", "id", "keyword", "name", "podid", "projectid", "state", "storageid", "type", "virtualmachineid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "displayvolume", "isrecursive", "listall":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ids":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listVolumesMetrics", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listVolumesMetrics: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListVolumesMetricsParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListVolumesMetricsParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetClusterid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["clusterid"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetClusterid() {
+	delete(p.p, "clusterid")
+}
+
+func (p *ListVolumesMetricsParams) GetClusterid() (string, bool) {
+	value, ok := p.p["clusterid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetDiskofferingid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["diskofferingid"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetDiskofferingid() {
+	delete(p.p, "diskofferingid")
+}
+
+func (p *ListVolumesMetricsParams) GetDiskofferingid() (string, bool) {
+	value, ok := p.p["diskofferingid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetDisplayvolume(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["displayvolume"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetDisplayvolume() {
+	delete(p.p, "displayvolume")
+}
+
+func (p *ListVolumesMetricsParams) GetDisplayvolume() (bool, bool) {
+	value, ok := p.p["displayvolume"].(bool)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListVolumesMetricsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetHostid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostid"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetHostid() {
+	delete(p.p, "hostid")
+}
+
+func (p *ListVolumesMetricsParams) GetHostid() (string, bool) {
+	value, ok := p.p["hostid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListVolumesMetricsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetIds(v []string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["ids"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetIds() {
+	delete(p.p, "ids")
+}
+
+func (p *ListVolumesMetricsParams) GetIds() ([]string, bool) {
+	value, ok := p.p["ids"].([]string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetIsrecursive(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["isrecursive"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetIsrecursive() {
+	delete(p.p, "isrecursive")
+}
+
+func (p *ListVolumesMetricsParams) GetIsrecursive() (bool, bool) {
+	value, ok := p.p["isrecursive"].(bool)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListVolumesMetricsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetListall(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["listall"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetListall() {
+	delete(p.p, "listall")
+}
+
+func (p *ListVolumesMetricsParams) GetListall() (bool, bool) {
+	value, ok := p.p["listall"].(bool)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListVolumesMetricsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListVolumesMetricsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListVolumesMetricsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetPodid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["podid"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetPodid() {
+	delete(p.p, "podid")
+}
+
+func (p *ListVolumesMetricsParams) GetPodid() (string, bool) {
+	value, ok := p.p["podid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetProjectid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["projectid"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *ListVolumesMetricsParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetState(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["state"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *ListVolumesMetricsParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetStorageid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["storageid"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetStorageid() {
+	delete(p.p, "storageid")
+}
+
+func (p *ListVolumesMetricsParams) GetStorageid() (string, bool) {
+	value, ok := p.p["storageid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetTags(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["tags"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListVolumesMetricsParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetType(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["type"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetType() {
+	delete(p.p, "type")
+}
+
+func (p *ListVolumesMetricsParams) GetType() (string, bool) {
+	value, ok := p.p["type"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetVirtualmachineid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["virtualmachineid"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetVirtualmachineid() {
+	delete(p.p, "virtualmachineid")
+}
+
+func (p *ListVolumesMetricsParams) GetVirtualmachineid() (string, bool) {
+	value, ok := p.p["virtualmachineid"].(string)
+	return value, ok
+}
+
+func (p *ListVolumesMetricsParams) SetZoneid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["zoneid"] = v
+}
+
+func (p *ListVolumesMetricsParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListVolumesMetricsParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListVolumesMetricsParams instance,
+// as then you are sure you have configured all required params
+func (s *VolumeService) NewListVolumesMetricsParams() *ListVolumesMetricsParams {
+	p := &ListVolumesMetricsParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VolumeService) GetVolumesMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVolumesMetricIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVolumesMetricIDWithContext is the same as GetVolumesMetricID, but uses ctx to cancel the request
+func (s *VolumeService) GetVolumesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListVolumesMetricsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["name"] = name
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListVolumesMetricsWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.VolumesMetrics[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.VolumesMetrics {
+			if v.Name == name {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VolumeService) GetVolumesMetricByName(name string, opts ...OptionFunc) (*VolumesMetric, int, error) {
+	return s.GetVolumesMetricByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVolumesMetricByNameWithContext is the same as GetVolumesMetricByName, but uses ctx to cancel the requests
+func (s *VolumeService) GetVolumesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VolumesMetric, int, error) {
+	id, count, err := s.GetVolumesMetricIDWithContext(ctx, name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetVolumesMetricByIDWithContext(ctx, id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VolumeService) GetVolumesMetricByID(id string, opts ...OptionFunc) (*VolumesMetric, int, error) {
+	return s.GetVolumesMetricByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVolumesMetricByIDWithContext is the same as GetVolumesMetricByID, but uses ctx to cancel the request
+func (s *VolumeService) GetVolumesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VolumesMetric, int, error) {
+	p := &ListVolumesMetricsParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListVolumesMetricsWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.VolumesMetrics[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for VolumesMetric UUID: %s!", id)
+}
+
+// Lists volume metrics
+func (s *VolumeService) ListVolumesMetrics(p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error) {
+	return s.ListVolumesMetricsWithContext(context.Background(), p)
+}
+
+// ListVolumesMetricsWithContext is the same as ListVolumesMetrics, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) ListVolumesMetricsWithContext(ctx context.Context, p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listVolumesMetrics", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListVolumesMetricsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListVolumesMetricsPager iterates over all pages of a ListVolumesMetrics call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListVolumesMetricsPager struct {
+	pager
+
+	s *VolumeService
+	p *ListVolumesMetricsParams
+	r *ListVolumesMetricsResponse
+}
+
+// NewListVolumesMetricsPager returns a pager for all pages of a ListVolumesMetrics call with the given params
+func (s *VolumeService) NewListVolumesMetricsPager(p *ListVolumesMetricsParams) *ListVolumesMetricsPager {
+	return &ListVolumesMetricsPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListVolumesMetricsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListVolumesMetricsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListVolumesMetricsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListVolumesMetricsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.VolumesMetrics), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListVolumesMetricsPager) Page() *ListVolumesMetricsResponse {
+	return pg.r
+}
+
+// ListVolumesMetricsAll fetches all pages of a ListVolumesMetrics call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *VolumeService) ListVolumesMetricsAll(p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error) {
+	return s.ListVolumesMetricsAllWithContext(context.Background(), p)
+}
+
+// ListVolumesMetricsAllWithContext is the same as ListVolumesMetricsAll, but uses ctx to cancel the requests
+func (s *VolumeService) ListVolumesMetricsAllWithContext(ctx context.Context, p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*VolumesMetric)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListVolumesMetricsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListVolumesMetricsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.VolumesMetrics
+		mu.Unlock()
+
+		return len(l.VolumesMetrics), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListVolumesMetricsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.VolumesMetrics = append(r.VolumesMetrics, pages[page]...)
+	}
+	r.Count = len(r.VolumesMetrics)
+
+	return r, nil
+}
+
+type ListVolumesMetricsResponse struct {
+	Count          int              `json:"count"`
+	VolumesMetrics []*VolumesMetric `json:"volume"`
+}
+
+type VolumesMetric struct {
+	Account                    string `json:"account"`
+	Attached                   string `json:"attached"`
+	Chaininfo                  string `json:"chaininfo"`
+	Clusterid                  string `json:"clusterid"`
+	Clustername                string `json:"clustername"`
+	Created                    string `json:"created"`
+	Destroyed                  bool   `json:"destroyed"`
+	Deviceid                   int64  `json:"deviceid"`
+	DiskBytesReadRate          int64  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         int64  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           int64  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          int64  `json:"diskIopsWriteRate"`
+	Diskiopstotal              int64  `json:"diskiopstotal"`
+	Diskioread                 int64  `json:"diskioread"`
+	Diskiowrite                int64  `json:"diskiowrite"`
+	Diskkbsread                int64  `json:"diskkbsread"`
+	Diskkbswrite               int64  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string `json:"diskofferingdisplaytext"`
+	Diskofferingid             string `json:"diskofferingid"`
+	Diskofferingname           string `json:"diskofferingname"`
+	Displayvolume              bool   `json:"displayvolume"`
+	Domain                     string `json:"domain"`
+	Domainid                   string `json:"domainid"`
+	Hypervisor                 string `json:"hypervisor"`
+	Id                         string `json:"id"`
+	Isextractable              bool   `json:"isextractable"`
+	Isodisplaytext             string `json:"isodisplaytext"`
+	Isoid                      string `json:"isoid"`
+	Isoname                    string `json:"isoname"`
+	JobID                      string `json:"jobid"`
+	Jobstatus                  int    `json:"jobstatus"`
+	Maxiops                    int64  `json:"maxiops"`
+	Miniops                    int64  `json:"miniops"`
+	Name                       string `json:"name"`
+	Path                       string `json:"path"`
+	Physicalsize               int64  `json:"physicalsize"`
+	Podid                      string `json:"podid"`
+	Podname                    string `json:"podname"`
+	Project                    string `json:"project"`
+	Projectid                  string `json:"projectid"`
+	Provisioningtype           string `json:"provisioningtype"`
+	Quiescevm                  bool   `json:"quiescevm"`
+	Serviceofferingdisplaytext string `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string `json:"serviceofferingid"`
+	Serviceofferingname        string `json:"serviceofferingname"`
+	Size                       int64  `json:"size"`
+	Sizegb                     string `json:"sizegb"`
+	Snapshotid                 string `json:"snapshotid"`
+	State                      string `json:"state"`
+	Status                     string `json:"status"`
+	Storage                    string `json:"storage"`
+	Storageid                  string `json:"storageid"`
+	Storagetype                string `json:"storagetype"`
+	Tags                       []Tags `json:"tags"`
+	Templatedisplaytext        string `json:"templatedisplaytext"`
+	Templateid                 string `json:"templateid"`
+	Templatename               string `json:"templatename"`
+	Type                       string `json:"type"`
+	Utilization                string `json:"utilization"`
+	Virtualmachineid           string `json:"virtualmachineid"`
+	Virtualsize                int64  `json:"virtualsize"`
+	Vmdisplayname              string `json:"vmdisplayname"`
+	Vmname                     string `json:"vmname"`
+	Vmstate                    string `json:"vmstate"`
+	Zoneid                     string `json:"zoneid"`
+	Zonename                   string `json:"zonename"`
 }
 
-func (p *ListVolumesParams) SetDiskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["diskofferingid"] = v
+type MigrateVolumeParams struct {
+	p map[string]interface{}
 }
 
-func (p *ListVolumesParams) SetDisplayvolume(v bool) {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *MigrateVolumeParams) ToURLValues() url.Values {
+	u := url.Values{}
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return u
 	}
-	p.p["displayvolume"] = v
-}
-
-func (p *ListVolumesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if v, found := p.p["livemigrate"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("livemigrate", vv)
 	}
-	p.p["domainid"] = v
-}
-
-func (p *ListVolumesParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if v, found := p.p["newdiskofferingid"]; found {
+		u.Set("newdiskofferingid", v.(string))
 	}
-	p.p["hostid"] = v
-}
-
-func (p *ListVolumesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if v, found := p.p["storageid"]; found {
+		u.Set("storageid", v.(string))
 	}
-	p.p["id"] = v
+	if v, found := p.p["volumeid"]; found {
+		u.Set("volumeid", v.(string))
+	}
+	return u
 }
 
-func (p *ListVolumesParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *MigrateVolumeParams) Validate() error {
+	v := newParamValidator("migrateVolume", p.p)
+	v.required("storageid", "volumeid")
+	v.ids("storageid", "volumeid")
+	v.maxLength("newdiskofferingid", 255)
+	return v.err()
 }
 
-func (p *ListVolumesParams) SetIsrecursive(v bool) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *MigrateVolumeParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["isrecursive"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *ListVolumesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *MigrateVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["keyword"] = v
-}
 
-func (p *ListVolumesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "livemigrate":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "newdiskofferingid", "storageid", "volumeid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for migrateVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of migrateVolume: %w", k, err)
+		}
 	}
-	p.p["listall"] = v
+	return nil
 }
 
-func (p *ListVolumesParams) SetName(v string) {
+func (p *MigrateVolumeParams) SetLivemigrate(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["name"] = v
+	p.p["livemigrate"] = v
 }
 
-func (p *ListVolumesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+func (p *MigrateVolumeParams) ResetLivemigrate() {
+	delete(p.p, "livemigrate")
 }
 
-func (p *ListVolumesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+func (p *MigrateVolumeParams) GetLivemigrate() (bool, bool) {
+	value, ok := p.p["livemigrate"].(bool)
+	return value, ok
 }
 
-func (p *ListVolumesParams) SetPodid(v string) {
+func (p *MigrateVolumeParams) SetNewdiskofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["podid"] = v
+	p.p["newdiskofferingid"] = v
 }
 
-func (p *ListVolumesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+func (p *MigrateVolumeParams) ResetNewdiskofferingid() {
+	delete(p.p, "newdiskofferingid")
 }
 
-func (p *ListVolumesParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+func (p *MigrateVolumeParams) GetNewdiskofferingid() (string, bool) {
+	value, ok := p.p["newdiskofferingid"].(string)
+	return value, ok
 }
 
-func (p *ListVolumesParams) SetStorageid(v string) {
+func (p *MigrateVolumeParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["storageid"] = v
 }
 
-func (p *ListVolumesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+func (p *MigrateVolumeParams) ResetStorageid() {
+	delete(p.p, "storageid")
 }
 
-func (p *ListVolumesParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["type"] = v
+func (p *MigrateVolumeParams) GetStorageid() (string, bool) {
+	value, ok := p.p["storageid"].(string)
+	return value, ok
 }
 
-func (p *ListVolumesParams) SetVirtualmachineid(v string) {
+func (p *MigrateVolumeParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["virtualmachineid"] = v
+	p.p["volumeid"] = v
 }
 
-func (p *ListVolumesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+func (p *MigrateVolumeParams) ResetVolumeid() {
+	delete(p.p, "volumeid")
 }
 
-// You should always use this function to get a new ListVolumesParams instance,
+func (p *MigrateVolumeParams) GetVolumeid() (string, bool) {
+	value, ok := p.p["volumeid"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new MigrateVolumeParams instance,
 // as then you are sure you have configured all required params
-func (s *VolumeService) NewListVolumesParams() *ListVolumesParams {
-	p := &ListVolumesParams{}
+func (s *VolumeService) NewMigrateVolumeParams(storageid string, volumeid string) *MigrateVolumeParams {
+	p := &MigrateVolumeParams{}
 	p.p = make(map[string]interface{})
+	p.p["storageid"] = storageid
+	p.p["volumeid"] = volumeid
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VolumeService) GetVolumeID(name string, opts ...OptionFunc) (string, int, error) {
-	p := &ListVolumesParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["name"] = name
+// Migrate volume
+func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
+	return s.MigrateVolumeWithContext(context.Background(), p)
+}
 
-	for _, fn := range append(s.cs.options, opts...) {
-		if err := fn(s.cs, p); err != nil {
-			return "", -1, err
-		}
+// MigrateVolumeWithContext is the same as MigrateVolume, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) MigrateVolumeWithContext(ctx context.Context, p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
 	}
 
-	l, err := s.ListVolumes(p)
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateVolume", p.ToURLValues())
 	if err != nil {
-		return "", -1, err
-	}
-
-	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return nil, err
 	}
 
-	if l.Count == 1 {
-		return l.Volumes[0].Id, l.Count, nil
+	var r MigrateVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.Volumes {
-			if v.Name == name {
-				return v.Id, l.Count, nil
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
+		if err != nil {
+			if err == AsyncTimeoutErr {
+				return &r, err
 			}
+			return nil, err
 		}
-	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VolumeService) GetVolumeByName(name string, opts ...OptionFunc) (*Volume, int, error) {
-	id, count, err := s.GetVolumeID(name, opts...)
-	if err != nil {
-		return nil, count, err
-	}
-
-	r, count, err := s.GetVolumeByID(id, opts...)
-	if err != nil {
-		return nil, count, err
-	}
-	return r, count, nil
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VolumeService) GetVolumeByID(id string, opts ...OptionFunc) (*Volume, int, error) {
-	p := &ListVolumesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
-
-	for _, fn := range append(s.cs.options, opts...) {
-		if err := fn(s.cs, p); err != nil {
-			return nil, -1, err
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	l, err := s.ListVolumes(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, err
 		}
-		return nil, -1, err
 	}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+	return &r, nil
+}
 
-	if l.Count == 1 {
-		return l.Volumes[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for Volume UUID: %s!", id)
+// MigrateVolumeAsync starts the async job for MigrateVolume and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a MigrateVolumeResponse using Job.Result
+func (s *VolumeService) MigrateVolumeAsync(p *MigrateVolumeParams) (*Job, error) {
+	return s.MigrateVolumeAsyncWithContext(context.Background(), p)
 }
 
-// Lists all volumes.
-func (s *VolumeService) ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error) {
-	resp, err := s.cs.newRequest("listVolumes", p.toURLValues())
+// MigrateVolumeAsyncWithContext is the same as MigrateVolumeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VolumeService) MigrateVolumeAsyncWithContext(ctx context.Context, p *MigrateVolumeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "migrateVolume", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListVolumesResponse
+	var r MigrateVolumeResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
 
-	return &r, nil
-}
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
 
-type ListVolumesResponse struct {
-	Count   int       `json:"count"`
-	Volumes []*Volume `json:"volume"`
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
 }
 
-type Volume struct {
+type MigrateVolumeResponse struct {
 	Account                    string `json:"account"`
 	Attached                   string `json:"attached"`
 	Chaininfo                  string `json:"chaininfo"`
@@ -1502,105 +4391,114 @@ type Volume struct {
 	Zonename                   string `json:"zonename"`
 }
 
-type MigrateVolumeParams struct {
+type RecoverVolumeParams struct {
 	p map[string]interface{}
 }
 
-func (p *MigrateVolumeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *RecoverVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
 	}
-	if v, found := p.p["livemigrate"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("livemigrate", vv)
-	}
-	if v, found := p.p["newdiskofferingid"]; found {
-		u.Set("newdiskofferingid", v.(string))
-	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
-	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
 	}
 	return u
 }
 
-func (p *MigrateVolumeParams) SetLivemigrate(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["livemigrate"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *RecoverVolumeParams) Validate() error {
+	v := newParamValidator("recoverVolume", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
 }
 
-func (p *MigrateVolumeParams) SetNewdiskofferingid(v string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *RecoverVolumeParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["newdiskofferingid"] = v
+	return json.Marshal(p.p)
 }
 
-func (p *MigrateVolumeParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *RecoverVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	p.p["storageid"] = v
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for recoverVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of recoverVolume: %w", k, err)
+		}
+	}
+	return nil
 }
 
-func (p *MigrateVolumeParams) SetVolumeid(v string) {
+func (p *RecoverVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	p.p["volumeid"] = v
+	p.p["id"] = v
 }
 
-// You should always use this function to get a new MigrateVolumeParams instance,
+func (p *RecoverVolumeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *RecoverVolumeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+// You should always use this function to get a new RecoverVolumeParams instance,
 // as then you are sure you have configured all required params
-func (s *VolumeService) NewMigrateVolumeParams(storageid string, volumeid string) *MigrateVolumeParams {
-	p := &MigrateVolumeParams{}
+func (s *VolumeService) NewRecoverVolumeParams(id string) *RecoverVolumeParams {
+	p := &RecoverVolumeParams{}
 	p.p = make(map[string]interface{})
-	p.p["storageid"] = storageid
-	p.p["volumeid"] = volumeid
+	p.p["id"] = id
 	return p
 }
 
-// Migrate volume
-func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("migrateVolume", p.toURLValues())
-	if err != nil {
+// Recovers a Destroy volume.
+func (s *VolumeService) RecoverVolume(p *RecoverVolumeParams) (*RecoverVolumeResponse, error) {
+	return s.RecoverVolumeWithContext(context.Background(), p)
+}
+
+// RecoverVolumeWithContext is the same as RecoverVolume, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) RecoverVolumeWithContext(ctx context.Context, p *RecoverVolumeParams) (*RecoverVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
 		return nil, err
 	}
 
-	var r MigrateVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequestWithContext(ctx, "recoverVolume", p.ToURLValues())
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			if err == AsyncTimeoutErr {
-				return &r, err
-			}
-			return nil, err
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	var r RecoverVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
 	}
 
 	return &r, nil
 }
 
-type MigrateVolumeResponse struct {
+type RecoverVolumeResponse struct {
 	Account                    string `json:"account"`
 	Attached                   string `json:"attached"`
 	Chaininfo                  string `json:"chaininfo"`
@@ -1671,7 +4569,8 @@ type ResizeVolumeParams struct {
 	p map[string]interface{}
 }
 
-func (p *ResizeVolumeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ResizeVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1701,6 +4600,56 @@ func (p *ResizeVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ResizeVolumeParams) Validate() error {
+	v := newParamValidator("resizeVolume", p.p)
+	v.required("id")
+	v.ids("diskofferingid", "id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ResizeVolumeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ResizeVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "diskofferingid", "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "maxiops", "miniops", "size":
+			var v int64
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "shrinkok":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for resizeVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of resizeVolume: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ResizeVolumeParams) SetDiskofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1708,6 +4657,15 @@ func (p *ResizeVolumeParams) SetDiskofferingid(v string) {
 	p.p["diskofferingid"] = v
 }
 
+func (p *ResizeVolumeParams) ResetDiskofferingid() {
+	delete(p.p, "diskofferingid")
+}
+
+func (p *ResizeVolumeParams) GetDiskofferingid() (string, bool) {
+	value, ok := p.p["diskofferingid"].(string)
+	return value, ok
+}
+
 func (p *ResizeVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1715,6 +4673,15 @@ func (p *ResizeVolumeParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *ResizeVolumeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ResizeVolumeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *ResizeVolumeParams) SetMaxiops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1722,6 +4689,15 @@ func (p *ResizeVolumeParams) SetMaxiops(v int64) {
 	p.p["maxiops"] = v
 }
 
+func (p *ResizeVolumeParams) ResetMaxiops() {
+	delete(p.p, "maxiops")
+}
+
+func (p *ResizeVolumeParams) GetMaxiops() (int64, bool) {
+	value, ok := p.p["maxiops"].(int64)
+	return value, ok
+}
+
 func (p *ResizeVolumeParams) SetMiniops(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1729,6 +4705,15 @@ func (p *ResizeVolumeParams) SetMiniops(v int64) {
 	p.p["miniops"] = v
 }
 
+func (p *ResizeVolumeParams) ResetMiniops() {
+	delete(p.p, "miniops")
+}
+
+func (p *ResizeVolumeParams) GetMiniops() (int64, bool) {
+	value, ok := p.p["miniops"].(int64)
+	return value, ok
+}
+
 func (p *ResizeVolumeParams) SetShrinkok(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1736,6 +4721,15 @@ func (p *ResizeVolumeParams) SetShrinkok(v bool) {
 	p.p["shrinkok"] = v
 }
 
+func (p *ResizeVolumeParams) ResetShrinkok() {
+	delete(p.p, "shrinkok")
+}
+
+func (p *ResizeVolumeParams) GetShrinkok() (bool, bool) {
+	value, ok := p.p["shrinkok"].(bool)
+	return value, ok
+}
+
 func (p *ResizeVolumeParams) SetSize(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1743,6 +4737,15 @@ func (p *ResizeVolumeParams) SetSize(v int64) {
 	p.p["size"] = v
 }
 
+func (p *ResizeVolumeParams) ResetSize() {
+	delete(p.p, "size")
+}
+
+func (p *ResizeVolumeParams) GetSize() (int64, bool) {
+	value, ok := p.p["size"].(int64)
+	return value, ok
+}
+
 // You should always use this function to get a new ResizeVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewResizeVolumeParams(id string) *ResizeVolumeParams {
@@ -1754,7 +4757,16 @@ func (s *VolumeService) NewResizeVolumeParams(id string) *ResizeVolumeParams {
 
 // Resizes a volume
 func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
-	resp, err := s.cs.newRequest("resizeVolume", p.toURLValues())
+	return s.ResizeVolumeWithContext(context.Background(), p)
+}
+
+// ResizeVolumeWithContext is the same as ResizeVolume, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) ResizeVolumeWithContext(ctx context.Context, p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "resizeVolume", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1766,7 +4778,7 @@ func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1787,6 +4799,40 @@ func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeRespon
 	return &r, nil
 }
 
+// ResizeVolumeAsync starts the async job for ResizeVolume and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ResizeVolumeResponse using Job.Result
+func (s *VolumeService) ResizeVolumeAsync(p *ResizeVolumeParams) (*Job, error) {
+	return s.ResizeVolumeAsyncWithContext(context.Background(), p)
+}
+
+// ResizeVolumeAsyncWithContext is the same as ResizeVolumeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VolumeService) ResizeVolumeAsyncWithContext(ctx context.Context, p *ResizeVolumeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "resizeVolume", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ResizeVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type ResizeVolumeResponse struct {
 	Account                    string `json:"account"`
 	Attached                   string `json:"attached"`
@@ -1858,7 +4904,8 @@ type UpdateVolumeParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateVolumeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1888,6 +4935,55 @@ func (p *UpdateVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateVolumeParams) Validate() error {
+	v := newParamValidator("updateVolume", p.p)
+	v.ids("id", "storageid")
+	v.maxLength("chaininfo", 255)
+	v.maxLength("customid", 255)
+	v.maxLength("path", 255)
+	v.maxLength("state", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateVolumeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "chaininfo", "customid", "id", "path", "state", "storageid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "displayvolume":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateVolume: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateVolumeParams) SetChaininfo(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1895,6 +4991,15 @@ func (p *UpdateVolumeParams) SetChaininfo(v string) {
 	p.p["chaininfo"] = v
 }
 
+func (p *UpdateVolumeParams) ResetChaininfo() {
+	delete(p.p, "chaininfo")
+}
+
+func (p *UpdateVolumeParams) GetChaininfo() (string, bool) {
+	value, ok := p.p["chaininfo"].(string)
+	return value, ok
+}
+
 func (p *UpdateVolumeParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1902,6 +5007,15 @@ func (p *UpdateVolumeParams) SetCustomid(v string) {
 	p.p["customid"] = v
 }
 
+func (p *UpdateVolumeParams) ResetCustomid() {
+	delete(p.p, "customid")
+}
+
+func (p *UpdateVolumeParams) GetCustomid() (string, bool) {
+	value, ok := p.p["customid"].(string)
+	return value, ok
+}
+
 func (p *UpdateVolumeParams) SetDisplayvolume(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1909,6 +5023,15 @@ func (p *UpdateVolumeParams) SetDisplayvolume(v bool) {
 	p.p["displayvolume"] = v
 }
 
+func (p *UpdateVolumeParams) ResetDisplayvolume() {
+	delete(p.p, "displayvolume")
+}
+
+func (p *UpdateVolumeParams) GetDisplayvolume() (bool, bool) {
+	value, ok := p.p["displayvolume"].(bool)
+	return value, ok
+}
+
 func (p *UpdateVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1916,6 +5039,15 @@ func (p *UpdateVolumeParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateVolumeParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateVolumeParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateVolumeParams) SetPath(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1923,6 +5055,15 @@ func (p *UpdateVolumeParams) SetPath(v string) {
 	p.p["path"] = v
 }
 
+func (p *UpdateVolumeParams) ResetPath() {
+	delete(p.p, "path")
+}
+
+func (p *UpdateVolumeParams) GetPath() (string, bool) {
+	value, ok := p.p["path"].(string)
+	return value, ok
+}
+
 func (p *UpdateVolumeParams) SetState(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1930,6 +5071,15 @@ func (p *UpdateVolumeParams) SetState(v string) {
 	p.p["state"] = v
 }
 
+func (p *UpdateVolumeParams) ResetState() {
+	delete(p.p, "state")
+}
+
+func (p *UpdateVolumeParams) GetState() (string, bool) {
+	value, ok := p.p["state"].(string)
+	return value, ok
+}
+
 func (p *UpdateVolumeParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1937,6 +5087,15 @@ func (p *UpdateVolumeParams) SetStorageid(v string) {
 	p.p["storageid"] = v
 }
 
+func (p *UpdateVolumeParams) ResetStorageid() {
+	delete(p.p, "storageid")
+}
+
+func (p *UpdateVolumeParams) GetStorageid() (string, bool) {
+	value, ok := p.p["storageid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewUpdateVolumeParams() *UpdateVolumeParams {
@@ -1947,7 +5106,16 @@ func (s *VolumeService) NewUpdateVolumeParams() *UpdateVolumeParams {
 
 // Updates the volume.
 func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("updateVolume", p.toURLValues())
+	return s.UpdateVolumeWithContext(context.Background(), p)
+}
+
+// UpdateVolumeWithContext is the same as UpdateVolume, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) UpdateVolumeWithContext(ctx context.Context, p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVolume", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1959,7 +5127,7 @@ func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1980,6 +5148,40 @@ func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeRespon
 	return &r, nil
 }
 
+// UpdateVolumeAsync starts the async job for UpdateVolume and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UpdateVolumeResponse using Job.Result
+func (s *VolumeService) UpdateVolumeAsync(p *UpdateVolumeParams) (*Job, error) {
+	return s.UpdateVolumeAsyncWithContext(context.Background(), p)
+}
+
+// UpdateVolumeAsyncWithContext is the same as UpdateVolumeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VolumeService) UpdateVolumeAsyncWithContext(ctx context.Context, p *UpdateVolumeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateVolume", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UpdateVolumeResponse struct {
 	Account                    string `json:"account"`
 	Attached                   string `json:"attached"`
@@ -2051,7 +5253,8 @@ type UploadVolumeParams struct {
 	p map[string]interface{}
 }
 
-func (p *UploadVolumeParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UploadVolumeParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -2089,6 +5292,54 @@ func (p *UploadVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UploadVolumeParams) Validate() error {
+	v := newParamValidator("uploadVolume", p.p)
+	v.required("format", "name", "url", "zoneid")
+	v.ids("diskofferingid", "domainid", "projectid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("checksum", 255)
+	v.maxLength("format", 255)
+	v.maxLength("imagestoreuuid", 255)
+	v.maxLength("name", 255)
+	v.maxLength("url", 2048)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UploadVolumeParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UploadVolumeParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "checksum", "diskofferingid", "domainid", "format", "imagestoreuuid", "name", "projectid", "url", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for uploadVolume", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of uploadVolume: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UploadVolumeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2096,6 +5347,15 @@ func (p *UploadVolumeParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *UploadVolumeParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *UploadVolumeParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *UploadVolumeParams) SetChecksum(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2103,6 +5363,15 @@ func (p *UploadVolumeParams) SetChecksum(v string) {
 	p.p["checksum"] = v
 }
 
+func (p *UploadVolumeParams) ResetChecksum() {
+	delete(p.p, "checksum")
+}
+
+func (p *UploadVolumeParams) GetChecksum() (string, bool) {
+	value, ok := p.p["checksum"].(string)
+	return value, ok
+}
+
 func (p *UploadVolumeParams) SetDiskofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2110,6 +5379,15 @@ func (p *UploadVolumeParams) SetDiskofferingid(v string) {
 	p.p["diskofferingid"] = v
 }
 
+func (p *UploadVolumeParams) ResetDiskofferingid() {
+	delete(p.p, "diskofferingid")
+}
+
+func (p *UploadVolumeParams) GetDiskofferingid() (string, bool) {
+	value, ok := p.p["diskofferingid"].(string)
+	return value, ok
+}
+
 func (p *UploadVolumeParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2117,6 +5395,15 @@ func (p *UploadVolumeParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *UploadVolumeParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *UploadVolumeParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *UploadVolumeParams) SetFormat(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2124,6 +5411,15 @@ func (p *UploadVolumeParams) SetFormat(v string) {
 	p.p["format"] = v
 }
 
+func (p *UploadVolumeParams) ResetFormat() {
+	delete(p.p, "format")
+}
+
+func (p *UploadVolumeParams) GetFormat() (string, bool) {
+	value, ok := p.p["format"].(string)
+	return value, ok
+}
+
 func (p *UploadVolumeParams) SetImagestoreuuid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2131,6 +5427,15 @@ func (p *UploadVolumeParams) SetImagestoreuuid(v string) {
 	p.p["imagestoreuuid"] = v
 }
 
+func (p *UploadVolumeParams) ResetImagestoreuuid() {
+	delete(p.p, "imagestoreuuid")
+}
+
+func (p *UploadVolumeParams) GetImagestoreuuid() (string, bool) {
+	value, ok := p.p["imagestoreuuid"].(string)
+	return value, ok
+}
+
 func (p *UploadVolumeParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2138,6 +5443,15 @@ func (p *UploadVolumeParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UploadVolumeParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UploadVolumeParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UploadVolumeParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2145,6 +5459,15 @@ func (p *UploadVolumeParams) SetProjectid(v string) {
 	p.p["projectid"] = v
 }
 
+func (p *UploadVolumeParams) ResetProjectid() {
+	delete(p.p, "projectid")
+}
+
+func (p *UploadVolumeParams) GetProjectid() (string, bool) {
+	value, ok := p.p["projectid"].(string)
+	return value, ok
+}
+
 func (p *UploadVolumeParams) SetUrl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2152,6 +5475,15 @@ func (p *UploadVolumeParams) SetUrl(v string) {
 	p.p["url"] = v
 }
 
+func (p *UploadVolumeParams) ResetUrl() {
+	delete(p.p, "url")
+}
+
+func (p *UploadVolumeParams) GetUrl() (string, bool) {
+	value, ok := p.p["url"].(string)
+	return value, ok
+}
+
 func (p *UploadVolumeParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2159,6 +5491,15 @@ func (p *UploadVolumeParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *UploadVolumeParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *UploadVolumeParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new UploadVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewUploadVolumeParams(format string, name string, url string, zoneid string) *UploadVolumeParams {
@@ -2173,7 +5514,16 @@ func (s *VolumeService) NewUploadVolumeParams(format string, name string, url st
 
 // Uploads a data disk.
 func (s *VolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error) {
-	resp, err := s.cs.newRequest("uploadVolume", p.toURLValues())
+	return s.UploadVolumeWithContext(context.Background(), p)
+}
+
+// UploadVolumeWithContext is the same as UploadVolume, but uses ctx to cancel the request and any async job polling
+func (s *VolumeService) UploadVolumeWithContext(ctx context.Context, p *UploadVolumeParams) (*UploadVolumeResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "uploadVolume", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2185,7 +5535,7 @@ func (s *VolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -2206,6 +5556,40 @@ func (s *VolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeRespon
 	return &r, nil
 }
 
+// UploadVolumeAsync starts the async job for UploadVolume and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a UploadVolumeResponse using Job.Result
+func (s *VolumeService) UploadVolumeAsync(p *UploadVolumeParams) (*Job, error) {
+	return s.UploadVolumeAsyncWithContext(context.Background(), p)
+}
+
+// UploadVolumeAsyncWithContext is the same as UploadVolumeAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *VolumeService) UploadVolumeAsyncWithContext(ctx context.Context, p *UploadVolumeParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "uploadVolume", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UploadVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type UploadVolumeResponse struct {
 	Account                    string `json:"account"`
 	Attached                   string `json:"attached"`
@@ -20,18 +20,85 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// ZoneServiceIface is the interface implemented by ZoneService, which makes it
+// possible to replace the service with a mock, see the mocks package
+type ZoneServiceIface interface {
+	NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string) *CreateZoneParams
+	CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error)
+	CreateZoneWithContext(ctx context.Context, p *CreateZoneParams) (*CreateZoneResponse, error)
+	NewDedicateZoneParams(domainid string, zoneid string) *DedicateZoneParams
+	DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error)
+	DedicateZoneWithContext(ctx context.Context, p *DedicateZoneParams) (*DedicateZoneResponse, error)
+	DedicateZoneAsync(p *DedicateZoneParams) (*Job, error)
+	DedicateZoneAsyncWithContext(ctx context.Context, p *DedicateZoneParams) (*Job, error)
+	NewDeleteZoneParams(id string) *DeleteZoneParams
+	DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error)
+	DeleteZoneWithContext(ctx context.Context, p *DeleteZoneParams) (*DeleteZoneResponse, error)
+	NewDisableOutOfBandManagementForZoneParams(zoneid string) *DisableOutOfBandManagementForZoneParams
+	DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error)
+	DisableOutOfBandManagementForZoneWithContext(ctx context.Context, p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error)
+	DisableOutOfBandManagementForZoneAsync(p *DisableOutOfBandManagementForZoneParams) (*Job, error)
+	DisableOutOfBandManagementForZoneAsyncWithContext(ctx context.Context, p *DisableOutOfBandManagementForZoneParams) (*Job, error)
+	NewEnableOutOfBandManagementForZoneParams(zoneid string) *EnableOutOfBandManagementForZoneParams
+	EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error)
+	EnableOutOfBandManagementForZoneWithContext(ctx context.Context, p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error)
+	EnableOutOfBandManagementForZoneAsync(p *EnableOutOfBandManagementForZoneParams) (*Job, error)
+	EnableOutOfBandManagementForZoneAsyncWithContext(ctx context.Context, p *EnableOutOfBandManagementForZoneParams) (*Job, error)
+	NewListDedicatedZonesParams() *ListDedicatedZonesParams
+	ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error)
+	ListDedicatedZonesWithContext(ctx context.Context, p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error)
+	NewListDedicatedZonesPager(p *ListDedicatedZonesParams) *ListDedicatedZonesPager
+	ListDedicatedZonesAll(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error)
+	ListDedicatedZonesAllWithContext(ctx context.Context, p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error)
+	NewListZonesParams() *ListZonesParams
+	GetZoneID(name string, opts ...OptionFunc) (string, int, error)
+	GetZoneIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error)
+	GetZoneByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Zone, int, error)
+	GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error)
+	GetZoneByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Zone, int, error)
+	ListZones(p *ListZonesParams) (*ListZonesResponse, error)
+	ListZonesWithContext(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error)
+	NewListZonesPager(p *ListZonesParams) *ListZonesPager
+	ListZonesAll(p *ListZonesParams) (*ListZonesResponse, error)
+	ListZonesAllWithContext(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error)
+	NewListZonesMetricsParams() *ListZonesMetricsParams
+	GetZonesMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetZonesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
+	GetZonesMetricByName(name string, opts ...OptionFunc) (*ZonesMetric, int, error)
+	GetZonesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ZonesMetric, int, error)
+	GetZonesMetricByID(id string, opts ...OptionFunc) (*ZonesMetric, int, error)
+	GetZonesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ZonesMetric, int, error)
+	ListZonesMetrics(p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error)
+	ListZonesMetricsWithContext(ctx context.Context, p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error)
+	NewListZonesMetricsPager(p *ListZonesMetricsParams) *ListZonesMetricsPager
+	ListZonesMetricsAll(p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error)
+	ListZonesMetricsAllWithContext(ctx context.Context, p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error)
+	NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedicatedZoneParams
+	ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error)
+	ReleaseDedicatedZoneWithContext(ctx context.Context, p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error)
+	ReleaseDedicatedZoneAsync(p *ReleaseDedicatedZoneParams) (*Job, error)
+	ReleaseDedicatedZoneAsyncWithContext(ctx context.Context, p *ReleaseDedicatedZoneParams) (*Job, error)
+	NewUpdateZoneParams(id string) *UpdateZoneParams
+	UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error)
+	UpdateZoneWithContext(ctx context.Context, p *UpdateZoneParams) (*UpdateZoneResponse, error)
+}
+
 type CreateZoneParams struct {
 	p map[string]interface{}
 }
 
-func (p *CreateZoneParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *CreateZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -83,6 +150,63 @@ func (p *CreateZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *CreateZoneParams) Validate() error {
+	v := newParamValidator("createZone", p.p)
+	v.required("dns1", "internaldns1", "name", "networktype")
+	v.ids("domainid")
+	v.maxLength("allocationstate", 255)
+	v.maxLength("dns1", 255)
+	v.maxLength("dns2", 255)
+	v.maxLength("domain", 255)
+	v.maxLength("guestcidraddress", 255)
+	v.maxLength("internaldns1", 255)
+	v.maxLength("internaldns2", 255)
+	v.maxLength("ip6dns1", 255)
+	v.maxLength("ip6dns2", 255)
+	v.maxLength("name", 255)
+	v.maxLength("networktype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *CreateZoneParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *CreateZoneParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "allocationstate", "dns1", "dns2", "domain", "domainid", "guestcidraddress", "internaldns1", "internaldns2", "ip6dns1", "ip6dns2", "name", "networktype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "localstorageenabled", "securitygroupenabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for createZone", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of createZone: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *CreateZoneParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -90,6 +214,15 @@ func (p *CreateZoneParams) SetAllocationstate(v string) {
 	p.p["allocationstate"] = v
 }
 
+func (p *CreateZoneParams) ResetAllocationstate() {
+	delete(p.p, "allocationstate")
+}
+
+func (p *CreateZoneParams) GetAllocationstate() (string, bool) {
+	value, ok := p.p["allocationstate"].(string)
+	return value, ok
+}
+
 func (p *CreateZoneParams) SetDns1(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -97,6 +230,15 @@ func (p *CreateZoneParams) SetDns1(v string) {
 	p.p["dns1"] = v
 }
 
+func (p *CreateZoneParams) ResetDns1() {
+	delete(p.p, "dns1")
+}
+
+func (p *CreateZoneParams) GetDns1() (string, bool) {
+	value, ok := p.p["dns1"].(string)
+	return value, ok
+}
+
 func (p *CreateZoneParams) SetDns2(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -104,6 +246,15 @@ func (p *CreateZoneParams) SetDns2(v string) {
 	p.p["dns2"] = v
 }
 
+func (p *CreateZoneParams) ResetDns2() {
+	delete(p.p, "dns2")
+}
+
+func (p *CreateZoneParams) GetDns2() (string, bool) {
+	value, ok := p.p["dns2"].(string)
+	return value, ok
+}
+
 func (p *CreateZoneParams) SetDomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -111,6 +262,15 @@ func (p *CreateZoneParams) SetDomain(v string) {
 	p.p["domain"] = v
 }
 
+func (p *CreateZoneParams) ResetDomain() {
+	delete(p.p, "domain")
+}
+
+func (p *CreateZoneParams) GetDomain() (string, bool) {
+	value, ok := p.p["domain"].(string)
+	return value, ok
+}
+
 func (p *CreateZoneParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -118,6 +278,15 @@ func (p *CreateZoneParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *CreateZoneParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *CreateZoneParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *CreateZoneParams) SetGuestcidraddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -125,6 +294,15 @@ func (p *CreateZoneParams) SetGuestcidraddress(v string) {
 	p.p["guestcidraddress"] = v
 }
 
+func (p *CreateZoneParams) ResetGuestcidraddress() {
+	delete(p.p, "guestcidraddress")
+}
+
+func (p *CreateZoneParams) GetGuestcidraddress() (string, bool) {
+	value, ok := p.p["guestcidraddress"].(string)
+	return value, ok
+}
+
 func (p *CreateZoneParams) SetInternaldns1(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -132,6 +310,15 @@ func (p *CreateZoneParams) SetInternaldns1(v string) {
 	p.p["internaldns1"] = v
 }
 
+func (p *CreateZoneParams) ResetInternaldns1() {
+	delete(p.p, "internaldns1")
+}
+
+func (p *CreateZoneParams) GetInternaldns1() (string, bool) {
+	value, ok := p.p["internaldns1"].(string)
+	return value, ok
+}
+
 func (p *CreateZoneParams) SetInternaldns2(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -139,6 +326,15 @@ func (p *CreateZoneParams) SetInternaldns2(v string) {
 	p.p["internaldns2"] = v
 }
 
+func (p *CreateZoneParams) ResetInternaldns2() {
+	delete(p.p, "internaldns2")
+}
+
+func (p *CreateZoneParams) GetInternaldns2() (string, bool) {
+	value, ok := p.p["internaldns2"].(string)
+	return value, ok
+}
+
 func (p *CreateZoneParams) SetIp6dns1(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -146,6 +342,15 @@ func (p *CreateZoneParams) SetIp6dns1(v string) {
 	p.p["ip6dns1"] = v
 }
 
+func (p *CreateZoneParams) ResetIp6dns1() {
+	delete(p.p, "ip6dns1")
+}
+
+func (p *CreateZoneParams) GetIp6dns1() (string, bool) {
+	value, ok := p.p["ip6dns1"].(string)
+	return value, ok
+}
+
 func (p *CreateZoneParams) SetIp6dns2(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -153,6 +358,15 @@ func (p *CreateZoneParams) SetIp6dns2(v string) {
 	p.p["ip6dns2"] = v
 }
 
+func (p *CreateZoneParams) ResetIp6dns2() {
+	delete(p.p, "ip6dns2")
+}
+
+func (p *CreateZoneParams) GetIp6dns2() (string, bool) {
+	value, ok := p.p["ip6dns2"].(string)
+	return value, ok
+}
+
 func (p *CreateZoneParams) SetLocalstorageenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -160,6 +374,15 @@ func (p *CreateZoneParams) SetLocalstorageenabled(v bool) {
 	p.p["localstorageenabled"] = v
 }
 
+func (p *CreateZoneParams) ResetLocalstorageenabled() {
+	delete(p.p, "localstorageenabled")
+}
+
+func (p *CreateZoneParams) GetLocalstorageenabled() (bool, bool) {
+	value, ok := p.p["localstorageenabled"].(bool)
+	return value, ok
+}
+
 func (p *CreateZoneParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -167,6 +390,15 @@ func (p *CreateZoneParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *CreateZoneParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *CreateZoneParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *CreateZoneParams) SetNetworktype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -174,6 +406,15 @@ func (p *CreateZoneParams) SetNetworktype(v string) {
 	p.p["networktype"] = v
 }
 
+func (p *CreateZoneParams) ResetNetworktype() {
+	delete(p.p, "networktype")
+}
+
+func (p *CreateZoneParams) GetNetworktype() (string, bool) {
+	value, ok := p.p["networktype"].(string)
+	return value, ok
+}
+
 func (p *CreateZoneParams) SetSecuritygroupenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -181,6 +422,15 @@ func (p *CreateZoneParams) SetSecuritygroupenabled(v bool) {
 	p.p["securitygroupenabled"] = v
 }
 
+func (p *CreateZoneParams) ResetSecuritygroupenabled() {
+	delete(p.p, "securitygroupenabled")
+}
+
+func (p *CreateZoneParams) GetSecuritygroupenabled() (bool, bool) {
+	value, ok := p.p["securitygroupenabled"].(bool)
+	return value, ok
+}
+
 // You should always use this function to get a new CreateZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string) *CreateZoneParams {
@@ -195,7 +445,16 @@ func (s *ZoneService) NewCreateZoneParams(dns1 string, internaldns1 string, name
 
 // Creates a Zone.
 func (s *ZoneService) CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error) {
-	resp, err := s.cs.newRequest("createZone", p.toURLValues())
+	return s.CreateZoneWithContext(context.Background(), p)
+}
+
+// CreateZoneWithContext is the same as CreateZone, but uses ctx to cancel the request and any async job polling
+func (s *ZoneService) CreateZoneWithContext(ctx context.Context, p *CreateZoneParams) (*CreateZoneResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "createZone", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -255,7 +514,8 @@ type DedicateZoneParams struct {
 	p map[string]interface{}
 }
 
-func (p *DedicateZoneParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DedicateZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -272,6 +532,49 @@ func (p *DedicateZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DedicateZoneParams) Validate() error {
+	v := newParamValidator("dedicateZone", p.p)
+	v.required("domainid", "zoneid")
+	v.ids("domainid", "zoneid")
+	v.maxLength("account", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DedicateZoneParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DedicateZoneParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "domainid", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for dedicateZone", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of dedicateZone: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DedicateZoneParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -279,6 +582,15 @@ func (p *DedicateZoneParams) SetAccount(v string) {
 	p.p["account"] = v
 }
 
+func (p *DedicateZoneParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *DedicateZoneParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
 func (p *DedicateZoneParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -286,6 +598,15 @@ func (p *DedicateZoneParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *DedicateZoneParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *DedicateZoneParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *DedicateZoneParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -293,6 +614,15 @@ func (p *DedicateZoneParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *DedicateZoneParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *DedicateZoneParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DedicateZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewDedicateZoneParams(domainid string, zoneid string) *DedicateZoneParams {
@@ -305,7 +635,16 @@ func (s *ZoneService) NewDedicateZoneParams(domainid string, zoneid string) *Ded
 
 // Dedicates a zones.
 func (s *ZoneService) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error) {
-	resp, err := s.cs.newRequest("dedicateZone", p.toURLValues())
+	return s.DedicateZoneWithContext(context.Background(), p)
+}
+
+// DedicateZoneWithContext is the same as DedicateZone, but uses ctx to cancel the request and any async job polling
+func (s *ZoneService) DedicateZoneWithContext(ctx context.Context, p *DedicateZoneParams) (*DedicateZoneResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicateZone", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -317,7 +656,7 @@ func (s *ZoneService) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -338,6 +677,40 @@ func (s *ZoneService) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse
 	return &r, nil
 }
 
+// DedicateZoneAsync starts the async job for DedicateZone and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DedicateZoneResponse using Job.Result
+func (s *ZoneService) DedicateZoneAsync(p *DedicateZoneParams) (*Job, error) {
+	return s.DedicateZoneAsyncWithContext(context.Background(), p)
+}
+
+// DedicateZoneAsyncWithContext is the same as DedicateZoneAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ZoneService) DedicateZoneAsyncWithContext(ctx context.Context, p *DedicateZoneParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "dedicateZone", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DedicateZoneResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type DedicateZoneResponse struct {
 	Accountid       string `json:"accountid"`
 	Affinitygroupid string `json:"affinitygroupid"`
@@ -353,7 +726,8 @@ type DeleteZoneParams struct {
 	p map[string]interface{}
 }
 
-func (p *DeleteZoneParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DeleteZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -364,6 +738,48 @@ func (p *DeleteZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DeleteZoneParams) Validate() error {
+	v := newParamValidator("deleteZone", p.p)
+	v.required("id")
+	v.ids("id")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DeleteZoneParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DeleteZoneParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "id":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for deleteZone", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of deleteZone: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DeleteZoneParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -371,6 +787,15 @@ func (p *DeleteZoneParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *DeleteZoneParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *DeleteZoneParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DeleteZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewDeleteZoneParams(id string) *DeleteZoneParams {
@@ -382,7 +807,16 @@ func (s *ZoneService) NewDeleteZoneParams(id string) *DeleteZoneParams {
 
 // Deletes a Zone.
 func (s *ZoneService) DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error) {
-	resp, err := s.cs.newRequest("deleteZone", p.toURLValues())
+	return s.DeleteZoneWithContext(context.Background(), p)
+}
+
+// DeleteZoneWithContext is the same as DeleteZone, but uses ctx to cancel the request and any async job polling
+func (s *ZoneService) DeleteZoneWithContext(ctx context.Context, p *DeleteZoneParams) (*DeleteZoneResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "deleteZone", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -433,7 +867,8 @@ type DisableOutOfBandManagementForZoneParams struct {
 	p map[string]interface{}
 }
 
-func (p *DisableOutOfBandManagementForZoneParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *DisableOutOfBandManagementForZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -444,6 +879,48 @@ func (p *DisableOutOfBandManagementForZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *DisableOutOfBandManagementForZoneParams) Validate() error {
+	v := newParamValidator("disableOutOfBandManagementForZone", p.p)
+	v.required("zoneid")
+	v.ids("zoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *DisableOutOfBandManagementForZoneParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *DisableOutOfBandManagementForZoneParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for disableOutOfBandManagementForZone", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of disableOutOfBandManagementForZone: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *DisableOutOfBandManagementForZoneParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -451,6 +928,15 @@ func (p *DisableOutOfBandManagementForZoneParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *DisableOutOfBandManagementForZoneParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *DisableOutOfBandManagementForZoneParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new DisableOutOfBandManagementForZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewDisableOutOfBandManagementForZoneParams(zoneid string) *DisableOutOfBandManagementForZoneParams {
@@ -462,7 +948,16 @@ func (s *ZoneService) NewDisableOutOfBandManagementForZoneParams(zoneid string)
 
 // Disables out-of-band management for a zone
 func (s *ZoneService) DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error) {
-	resp, err := s.cs.newRequest("disableOutOfBandManagementForZone", p.toURLValues())
+	return s.DisableOutOfBandManagementForZoneWithContext(context.Background(), p)
+}
+
+// DisableOutOfBandManagementForZoneWithContext is the same as DisableOutOfBandManagementForZone, but uses ctx to cancel the request and any async job polling
+func (s *ZoneService) DisableOutOfBandManagementForZoneWithContext(ctx context.Context, p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableOutOfBandManagementForZone", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -474,7 +969,7 @@ func (s *ZoneService) DisableOutOfBandManagementForZone(p *DisableOutOfBandManag
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -495,6 +990,40 @@ func (s *ZoneService) DisableOutOfBandManagementForZone(p *DisableOutOfBandManag
 	return &r, nil
 }
 
+// DisableOutOfBandManagementForZoneAsync starts the async job for DisableOutOfBandManagementForZone and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a DisableOutOfBandManagementForZoneResponse using Job.Result
+func (s *ZoneService) DisableOutOfBandManagementForZoneAsync(p *DisableOutOfBandManagementForZoneParams) (*Job, error) {
+	return s.DisableOutOfBandManagementForZoneAsyncWithContext(context.Background(), p)
+}
+
+// DisableOutOfBandManagementForZoneAsyncWithContext is the same as DisableOutOfBandManagementForZoneAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ZoneService) DisableOutOfBandManagementForZoneAsyncWithContext(ctx context.Context, p *DisableOutOfBandManagementForZoneParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "disableOutOfBandManagementForZone", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableOutOfBandManagementForZoneResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type DisableOutOfBandManagementForZoneResponse struct {
 	Action      string `json:"action"`
 	Address     string `json:"address"`
@@ -515,7 +1044,8 @@ type EnableOutOfBandManagementForZoneParams struct {
 	p map[string]interface{}
 }
 
-func (p *EnableOutOfBandManagementForZoneParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *EnableOutOfBandManagementForZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -526,6 +1056,48 @@ func (p *EnableOutOfBandManagementForZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *EnableOutOfBandManagementForZoneParams) Validate() error {
+	v := newParamValidator("enableOutOfBandManagementForZone", p.p)
+	v.required("zoneid")
+	v.ids("zoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *EnableOutOfBandManagementForZoneParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *EnableOutOfBandManagementForZoneParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for enableOutOfBandManagementForZone", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of enableOutOfBandManagementForZone: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *EnableOutOfBandManagementForZoneParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -533,6 +1105,15 @@ func (p *EnableOutOfBandManagementForZoneParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *EnableOutOfBandManagementForZoneParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *EnableOutOfBandManagementForZoneParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new EnableOutOfBandManagementForZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewEnableOutOfBandManagementForZoneParams(zoneid string) *EnableOutOfBandManagementForZoneParams {
@@ -544,7 +1125,16 @@ func (s *ZoneService) NewEnableOutOfBandManagementForZoneParams(zoneid string) *
 
 // Enables out-of-band management for a zone
 func (s *ZoneService) EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error) {
-	resp, err := s.cs.newRequest("enableOutOfBandManagementForZone", p.toURLValues())
+	return s.EnableOutOfBandManagementForZoneWithContext(context.Background(), p)
+}
+
+// EnableOutOfBandManagementForZoneWithContext is the same as EnableOutOfBandManagementForZone, but uses ctx to cancel the request and any async job polling
+func (s *ZoneService) EnableOutOfBandManagementForZoneWithContext(ctx context.Context, p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableOutOfBandManagementForZone", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -556,7 +1146,7 @@ func (s *ZoneService) EnableOutOfBandManagementForZone(p *EnableOutOfBandManagem
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -577,6 +1167,40 @@ func (s *ZoneService) EnableOutOfBandManagementForZone(p *EnableOutOfBandManagem
 	return &r, nil
 }
 
+// EnableOutOfBandManagementForZoneAsync starts the async job for EnableOutOfBandManagementForZone and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a EnableOutOfBandManagementForZoneResponse using Job.Result
+func (s *ZoneService) EnableOutOfBandManagementForZoneAsync(p *EnableOutOfBandManagementForZoneParams) (*Job, error) {
+	return s.EnableOutOfBandManagementForZoneAsyncWithContext(context.Background(), p)
+}
+
+// EnableOutOfBandManagementForZoneAsyncWithContext is the same as EnableOutOfBandManagementForZoneAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ZoneService) EnableOutOfBandManagementForZoneAsyncWithContext(ctx context.Context, p *EnableOutOfBandManagementForZoneParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "enableOutOfBandManagementForZone", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableOutOfBandManagementForZoneResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {
+		var err error
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}), nil
+}
+
 type EnableOutOfBandManagementForZoneResponse struct {
 	Action      string `json:"action"`
 	Address     string `json:"address"`
@@ -597,7 +1221,8 @@ type ListDedicatedZonesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListDedicatedZonesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListDedicatedZonesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -628,18 +1253,83 @@ func (p *ListDedicatedZonesParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *ListDedicatedZonesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListDedicatedZonesParams) Validate() error {
+	v := newParamValidator("listDedicatedZones", p.p)
+	v.ids("affinitygroupid", "domainid", "zoneid")
+	v.maxLength("account", 255)
+	v.maxLength("keyword", 255)
+	return v.err()
 }
 
-func (p *ListDedicatedZonesParams) SetAffinitygroupid(v string) {
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListDedicatedZonesParams) MarshalJSON() ([]byte, error) {
 	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return []byte("{}"), nil
 	}
-	p.p["affinitygroupid"] = v
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListDedicatedZonesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "account", "affinitygroupid", "domainid", "keyword", "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listDedicatedZones", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listDedicatedZones: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListDedicatedZonesParams) SetAccount(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["account"] = v
+}
+
+func (p *ListDedicatedZonesParams) ResetAccount() {
+	delete(p.p, "account")
+}
+
+func (p *ListDedicatedZonesParams) GetAccount() (string, bool) {
+	value, ok := p.p["account"].(string)
+	return value, ok
+}
+
+func (p *ListDedicatedZonesParams) SetAffinitygroupid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["affinitygroupid"] = v
+}
+
+func (p *ListDedicatedZonesParams) ResetAffinitygroupid() {
+	delete(p.p, "affinitygroupid")
+}
+
+func (p *ListDedicatedZonesParams) GetAffinitygroupid() (string, bool) {
+	value, ok := p.p["affinitygroupid"].(string)
+	return value, ok
 }
 
 func (p *ListDedicatedZonesParams) SetDomainid(v string) {
@@ -649,6 +1339,15 @@ func (p *ListDedicatedZonesParams) SetDomainid(v string) {
 	p.p["domainid"] = v
 }
 
+func (p *ListDedicatedZonesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListDedicatedZonesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedZonesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -656,6 +1355,15 @@ func (p *ListDedicatedZonesParams) SetKeyword(v string) {
 	p.p["keyword"] = v
 }
 
+func (p *ListDedicatedZonesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListDedicatedZonesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
 func (p *ListDedicatedZonesParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -663,6 +1371,15 @@ func (p *ListDedicatedZonesParams) SetPage(v int) {
 	p.p["page"] = v
 }
 
+func (p *ListDedicatedZonesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListDedicatedZonesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
 func (p *ListDedicatedZonesParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -670,6 +1387,15 @@ func (p *ListDedicatedZonesParams) SetPagesize(v int) {
 	p.p["pagesize"] = v
 }
 
+func (p *ListDedicatedZonesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListDedicatedZonesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
 func (p *ListDedicatedZonesParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -677,6 +1403,15 @@ func (p *ListDedicatedZonesParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ListDedicatedZonesParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ListDedicatedZonesParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ListDedicatedZonesParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewListDedicatedZonesParams() *ListDedicatedZonesParams {
@@ -687,7 +1422,16 @@ func (s *ZoneService) NewListDedicatedZonesParams() *ListDedicatedZonesParams {
 
 // List dedicated zones.
 func (s *ZoneService) ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedZones", p.toURLValues())
+	return s.ListDedicatedZonesWithContext(context.Background(), p)
+}
+
+// ListDedicatedZonesWithContext is the same as ListDedicatedZones, but uses ctx to cancel the request and any async job polling
+func (s *ZoneService) ListDedicatedZonesWithContext(ctx context.Context, p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listDedicatedZones", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -700,6 +1444,92 @@ func (s *ZoneService) ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedi
 	return &r, nil
 }
 
+// ListDedicatedZonesPager iterates over all pages of a ListDedicatedZones call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListDedicatedZonesPager struct {
+	pager
+
+	s *ZoneService
+	p *ListDedicatedZonesParams
+	r *ListDedicatedZonesResponse
+}
+
+// NewListDedicatedZonesPager returns a pager for all pages of a ListDedicatedZones call with the given params
+func (s *ZoneService) NewListDedicatedZonesPager(p *ListDedicatedZonesParams) *ListDedicatedZonesPager {
+	return &ListDedicatedZonesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListDedicatedZonesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListDedicatedZonesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListDedicatedZonesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListDedicatedZonesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.DedicatedZones), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListDedicatedZonesPager) Page() *ListDedicatedZonesResponse {
+	return pg.r
+}
+
+// ListDedicatedZonesAll fetches all pages of a ListDedicatedZones call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ZoneService) ListDedicatedZonesAll(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
+	return s.ListDedicatedZonesAllWithContext(context.Background(), p)
+}
+
+// ListDedicatedZonesAllWithContext is the same as ListDedicatedZonesAll, but uses ctx to cancel the requests
+func (s *ZoneService) ListDedicatedZonesAllWithContext(ctx context.Context, p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*DedicatedZone)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListDedicatedZonesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListDedicatedZonesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.DedicatedZones
+		mu.Unlock()
+
+		return len(l.DedicatedZones), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListDedicatedZonesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.DedicatedZones = append(r.DedicatedZones, pages[page]...)
+	}
+	r.Count = len(r.DedicatedZones)
+
+	return r, nil
+}
+
 type ListDedicatedZonesResponse struct {
 	Count          int              `json:"count"`
 	DedicatedZones []*DedicatedZone `json:"dedicatedzone"`
@@ -720,7 +1550,8 @@ type ListZonesParams struct {
 	p map[string]interface{}
 }
 
-func (p *ListZonesParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListZonesParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -766,6 +1597,62 @@ func (p *ListZonesParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListZonesParams) Validate() error {
+	v := newParamValidator("listZones", p.p)
+	v.ids("domainid", "id")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("networktype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListZonesParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListZonesParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "available", "showcapacities":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "domainid", "id", "keyword", "name", "networktype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listZones", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listZones: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ListZonesParams) SetAvailable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -773,80 +1660,704 @@ func (p *ListZonesParams) SetAvailable(v bool) {
 	p.p["available"] = v
 }
 
-func (p *ListZonesParams) SetDomainid(v string) {
+func (p *ListZonesParams) ResetAvailable() {
+	delete(p.p, "available")
+}
+
+func (p *ListZonesParams) GetAvailable() (bool, bool) {
+	value, ok := p.p["available"].(bool)
+	return value, ok
+}
+
+func (p *ListZonesParams) SetDomainid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["domainid"] = v
+}
+
+func (p *ListZonesParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListZonesParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *ListZonesParams) SetId(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["id"] = v
+}
+
+func (p *ListZonesParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListZonesParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListZonesParams) SetKeyword(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["keyword"] = v
+}
+
+func (p *ListZonesParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListZonesParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListZonesParams) SetName(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["name"] = v
+}
+
+func (p *ListZonesParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListZonesParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ListZonesParams) SetNetworktype(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["networktype"] = v
+}
+
+func (p *ListZonesParams) ResetNetworktype() {
+	delete(p.p, "networktype")
+}
+
+func (p *ListZonesParams) GetNetworktype() (string, bool) {
+	value, ok := p.p["networktype"].(string)
+	return value, ok
+}
+
+func (p *ListZonesParams) SetPage(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["page"] = v
+}
+
+func (p *ListZonesParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListZonesParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListZonesParams) SetPagesize(v int) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["pagesize"] = v
+}
+
+func (p *ListZonesParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListZonesParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListZonesParams) SetShowcapacities(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["showcapacities"] = v
+}
+
+func (p *ListZonesParams) ResetShowcapacities() {
+	delete(p.p, "showcapacities")
+}
+
+func (p *ListZonesParams) GetShowcapacities() (bool, bool) {
+	value, ok := p.p["showcapacities"].(bool)
+	return value, ok
+}
+
+func (p *ListZonesParams) SetTags(v map[string]string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["tags"] = v
+}
+
+func (p *ListZonesParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListZonesParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListZonesParams instance,
+// as then you are sure you have configured all required params
+func (s *ZoneService) NewListZonesParams() *ListZonesParams {
+	p := &ListZonesParams{}
+	p.p = make(map[string]interface{})
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetZoneIDWithContext(context.Background(), name, opts...)
+}
+
+// GetZoneIDWithContext is the same as GetZoneID, but uses ctx to cancel the request
+func (s *ZoneService) GetZoneIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListZonesParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["name"] = name
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return "", -1, err
+		}
+	}
+
+	l, err := s.ListZonesWithContext(ctx, p)
+	if err != nil {
+		return "", -1, err
+	}
+
+	if l.Count == 0 {
+		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.Zones[0].Id, l.Count, nil
+	}
+
+	if l.Count > 1 {
+		for _, v := range l.Zones {
+			if v.Name == name {
+				return v.Id, l.Count, nil
+			}
+		}
+	}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ZoneService) GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error) {
+	return s.GetZoneByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetZoneByNameWithContext is the same as GetZoneByName, but uses ctx to cancel the requests
+func (s *ZoneService) GetZoneByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Zone, int, error) {
+	id, count, err := s.GetZoneIDWithContext(ctx, name, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+
+	r, count, err := s.GetZoneByIDWithContext(ctx, id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error) {
+	return s.GetZoneByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetZoneByIDWithContext is the same as GetZoneByID, but uses ctx to cancel the request
+func (s *ZoneService) GetZoneByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Zone, int, error) {
+	p := &ListZonesParams{}
+	p.p = make(map[string]interface{})
+
+	p.p["id"] = id
+
+	for _, fn := range append(s.cs.options, opts...) {
+		if err := fn(s.cs, p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListZonesWithContext(ctx, p)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
+	}
+
+	if l.Count == 1 {
+		return l.Zones[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for Zone UUID: %s!", id)
+}
+
+// Lists zones
+func (s *ZoneService) ListZones(p *ListZonesParams) (*ListZonesResponse, error) {
+	return s.ListZonesWithContext(context.Background(), p)
+}
+
+// ListZonesWithContext is the same as ListZones, but uses ctx to cancel the request and any async job polling
+func (s *ZoneService) ListZonesWithContext(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listZones", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListZonesResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListZonesPager iterates over all pages of a ListZones call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListZonesPager struct {
+	pager
+
+	s *ZoneService
+	p *ListZonesParams
+	r *ListZonesResponse
+}
+
+// NewListZonesPager returns a pager for all pages of a ListZones call with the given params
+func (s *ZoneService) NewListZonesPager(p *ListZonesParams) *ListZonesPager {
+	return &ListZonesPager{pager: newPager(p.p), s: s, p: p}
+}
+
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListZonesPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListZonesPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListZonesParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListZonesWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.Zones), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListZonesPager) Page() *ListZonesResponse {
+	return pg.r
+}
+
+// ListZonesAll fetches all pages of a ListZones call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ZoneService) ListZonesAll(p *ListZonesParams) (*ListZonesResponse, error) {
+	return s.ListZonesAllWithContext(context.Background(), p)
+}
+
+// ListZonesAllWithContext is the same as ListZonesAll, but uses ctx to cancel the requests
+func (s *ZoneService) ListZonesAllWithContext(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*Zone)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListZonesParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListZonesWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.Zones
+		mu.Unlock()
+
+		return len(l.Zones), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListZonesResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.Zones = append(r.Zones, pages[page]...)
+	}
+	r.Count = len(r.Zones)
+
+	return r, nil
+}
+
+type ListZonesResponse struct {
+	Count int     `json:"count"`
+	Zones []*Zone `json:"zone"`
+}
+
+type Zone struct {
+	Allocationstate       string            `json:"allocationstate"`
+	Capacity              []ZoneCapacity    `json:"capacity"`
+	Description           string            `json:"description"`
+	Dhcpprovider          string            `json:"dhcpprovider"`
+	Displaytext           string            `json:"displaytext"`
+	Dns1                  string            `json:"dns1"`
+	Dns2                  string            `json:"dns2"`
+	Domain                string            `json:"domain"`
+	Domainid              string            `json:"domainid"`
+	Domainname            string            `json:"domainname"`
+	Guestcidraddress      string            `json:"guestcidraddress"`
+	Id                    string            `json:"id"`
+	Internaldns1          string            `json:"internaldns1"`
+	Internaldns2          string            `json:"internaldns2"`
+	Ip6dns1               string            `json:"ip6dns1"`
+	Ip6dns2               string            `json:"ip6dns2"`
+	JobID                 string            `json:"jobid"`
+	Jobstatus             int               `json:"jobstatus"`
+	Localstorageenabled   bool              `json:"localstorageenabled"`
+	Name                  string            `json:"name"`
+	Networktype           string            `json:"networktype"`
+	Resourcedetails       map[string]string `json:"resourcedetails"`
+	Securitygroupsenabled bool              `json:"securitygroupsenabled"`
+	Tags                  []Tags            `json:"tags"`
+	Zonetoken             string            `json:"zonetoken"`
+}
+
+type ZoneCapacity struct {
+	Capacityallocated int64  `json:"capacityallocated"`
+	Capacitytotal     int64  `json:"capacitytotal"`
+	Capacityused      int64  `json:"capacityused"`
+	Clusterid         string `json:"clusterid"`
+	Clustername       string `json:"clustername"`
+	Name              string `json:"name"`
+	Percentused       string `json:"percentused"`
+	Podid             string `json:"podid"`
+	Podname           string `json:"podname"`
+	Type              int    `json:"type"`
+	Zoneid            string `json:"zoneid"`
+	Zonename          string `json:"zonename"`
+}
+
+type ListZonesMetricsParams struct {
+	p map[string]interface{}
+}
+
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ListZonesMetricsParams) ToURLValues() url.Values {
+	u := url.Values{}
+	if p.p == nil {
+		return u
+	}
+	if v, found := p.p["available"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("available", vv)
+	}
+	if v, found := p.p["domainid"]; found {
+		u.Set("domainid", v.(string))
+	}
+	if v, found := p.p["id"]; found {
+		u.Set("id", v.(string))
+	}
+	if v, found := p.p["keyword"]; found {
+		u.Set("keyword", v.(string))
+	}
+	if v, found := p.p["name"]; found {
+		u.Set("name", v.(string))
+	}
+	if v, found := p.p["networktype"]; found {
+		u.Set("networktype", v.(string))
+	}
+	if v, found := p.p["page"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("page", vv)
+	}
+	if v, found := p.p["pagesize"]; found {
+		vv := strconv.Itoa(v.(int))
+		u.Set("pagesize", vv)
+	}
+	if v, found := p.p["showcapacities"]; found {
+		vv := strconv.FormatBool(v.(bool))
+		u.Set("showcapacities", vv)
+	}
+	if v, found := p.p["tags"]; found {
+		m := v.(map[string]string)
+		for i, k := range getSortedKeysFromMap(m) {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), m[k])
+		}
+	}
+	return u
+}
+
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ListZonesMetricsParams) Validate() error {
+	v := newParamValidator("listZonesMetrics", p.p)
+	v.ids("domainid", "id")
+	v.maxLength("keyword", 255)
+	v.maxLength("name", 255)
+	v.maxLength("networktype", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ListZonesMetricsParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ListZonesMetricsParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "available", "showcapacities":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "domainid", "id", "keyword", "name", "networktype":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "page", "pagesize":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "tags":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for listZonesMetrics", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of listZonesMetrics: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (p *ListZonesMetricsParams) SetAvailable(v bool) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["available"] = v
+}
+
+func (p *ListZonesMetricsParams) ResetAvailable() {
+	delete(p.p, "available")
+}
+
+func (p *ListZonesMetricsParams) GetAvailable() (bool, bool) {
+	value, ok := p.p["available"].(bool)
+	return value, ok
+}
+
+func (p *ListZonesMetricsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["domainid"] = v
 }
 
-func (p *ListZonesParams) SetId(v string) {
+func (p *ListZonesMetricsParams) ResetDomainid() {
+	delete(p.p, "domainid")
+}
+
+func (p *ListZonesMetricsParams) GetDomainid() (string, bool) {
+	value, ok := p.p["domainid"].(string)
+	return value, ok
+}
+
+func (p *ListZonesMetricsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["id"] = v
 }
 
-func (p *ListZonesParams) SetKeyword(v string) {
+func (p *ListZonesMetricsParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *ListZonesMetricsParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
+func (p *ListZonesMetricsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["keyword"] = v
 }
 
-func (p *ListZonesParams) SetName(v string) {
+func (p *ListZonesMetricsParams) ResetKeyword() {
+	delete(p.p, "keyword")
+}
+
+func (p *ListZonesMetricsParams) GetKeyword() (string, bool) {
+	value, ok := p.p["keyword"].(string)
+	return value, ok
+}
+
+func (p *ListZonesMetricsParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["name"] = v
 }
 
-func (p *ListZonesParams) SetNetworktype(v string) {
+func (p *ListZonesMetricsParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *ListZonesMetricsParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
+func (p *ListZonesMetricsParams) SetNetworktype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["networktype"] = v
 }
 
-func (p *ListZonesParams) SetPage(v int) {
+func (p *ListZonesMetricsParams) ResetNetworktype() {
+	delete(p.p, "networktype")
+}
+
+func (p *ListZonesMetricsParams) GetNetworktype() (string, bool) {
+	value, ok := p.p["networktype"].(string)
+	return value, ok
+}
+
+func (p *ListZonesMetricsParams) SetPage(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["page"] = v
 }
 
-func (p *ListZonesParams) SetPagesize(v int) {
+func (p *ListZonesMetricsParams) ResetPage() {
+	delete(p.p, "page")
+}
+
+func (p *ListZonesMetricsParams) GetPage() (int, bool) {
+	value, ok := p.p["page"].(int)
+	return value, ok
+}
+
+func (p *ListZonesMetricsParams) SetPagesize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["pagesize"] = v
 }
 
-func (p *ListZonesParams) SetShowcapacities(v bool) {
+func (p *ListZonesMetricsParams) ResetPagesize() {
+	delete(p.p, "pagesize")
+}
+
+func (p *ListZonesMetricsParams) GetPagesize() (int, bool) {
+	value, ok := p.p["pagesize"].(int)
+	return value, ok
+}
+
+func (p *ListZonesMetricsParams) SetShowcapacities(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["showcapacities"] = v
 }
 
-func (p *ListZonesParams) SetTags(v map[string]string) {
+func (p *ListZonesMetricsParams) ResetShowcapacities() {
+	delete(p.p, "showcapacities")
+}
+
+func (p *ListZonesMetricsParams) GetShowcapacities() (bool, bool) {
+	value, ok := p.p["showcapacities"].(bool)
+	return value, ok
+}
+
+func (p *ListZonesMetricsParams) SetTags(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["tags"] = v
 }
 
-// You should always use this function to get a new ListZonesParams instance,
+func (p *ListZonesMetricsParams) ResetTags() {
+	delete(p.p, "tags")
+}
+
+func (p *ListZonesMetricsParams) GetTags() (map[string]string, bool) {
+	value, ok := p.p["tags"].(map[string]string)
+	return value, ok
+}
+
+// You should always use this function to get a new ListZonesMetricsParams instance,
 // as then you are sure you have configured all required params
-func (s *ZoneService) NewListZonesParams() *ListZonesParams {
-	p := &ListZonesParams{}
+func (s *ZoneService) NewListZonesMetricsParams() *ListZonesMetricsParams {
+	p := &ListZonesMetricsParams{}
 	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, error) {
-	p := &ListZonesParams{}
+func (s *ZoneService) GetZonesMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetZonesMetricIDWithContext(context.Background(), name, opts...)
+}
+
+// GetZonesMetricIDWithContext is the same as GetZonesMetricID, but uses ctx to cancel the request
+func (s *ZoneService) GetZonesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	p := &ListZonesMetricsParams{}
 	p.p = make(map[string]interface{})
 
 	p.p["name"] = name
@@ -857,7 +2368,7 @@ func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, e
 		}
 	}
 
-	l, err := s.ListZones(p)
+	l, err := s.ListZonesMetricsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -867,11 +2378,11 @@ func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, e
 	}
 
 	if l.Count == 1 {
-		return l.Zones[0].Id, l.Count, nil
+		return l.ZonesMetrics[0].Id, l.Count, nil
 	}
 
 	if l.Count > 1 {
-		for _, v := range l.Zones {
+		for _, v := range l.ZonesMetrics {
 			if v.Name == name {
 				return v.Id, l.Count, nil
 			}
@@ -881,13 +2392,18 @@ func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, e
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ZoneService) GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error) {
-	id, count, err := s.GetZoneID(name, opts...)
+func (s *ZoneService) GetZonesMetricByName(name string, opts ...OptionFunc) (*ZonesMetric, int, error) {
+	return s.GetZonesMetricByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetZonesMetricByNameWithContext is the same as GetZonesMetricByName, but uses ctx to cancel the requests
+func (s *ZoneService) GetZonesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ZonesMetric, int, error) {
+	id, count, err := s.GetZonesMetricIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetZoneByID(id, opts...)
+	r, count, err := s.GetZonesMetricByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -895,8 +2411,13 @@ func (s *ZoneService) GetZoneByName(name string, opts ...OptionFunc) (*Zone, int
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error) {
-	p := &ListZonesParams{}
+func (s *ZoneService) GetZonesMetricByID(id string, opts ...OptionFunc) (*ZonesMetric, int, error) {
+	return s.GetZonesMetricByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetZonesMetricByIDWithContext is the same as GetZonesMetricByID, but uses ctx to cancel the request
+func (s *ZoneService) GetZonesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ZonesMetric, int, error) {
+	p := &ListZonesMetricsParams{}
 	p.p = make(map[string]interface{})
 
 	p.p["id"] = id
@@ -907,34 +2428,41 @@ func (s *ZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, er
 		}
 	}
 
-	l, err := s.ListZones(p)
+	l, err := s.ListZonesMetricsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if IsNotFound(err) {
+			return nil, 0, fmt.Errorf("No match found for %s: %w", id, err)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, fmt.Errorf("No match found for %s: %w", id, ErrNotFound)
 	}
 
 	if l.Count == 1 {
-		return l.Zones[0], l.Count, nil
+		return l.ZonesMetrics[0], l.Count, nil
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for Zone UUID: %s!", id)
+	return nil, l.Count, fmt.Errorf("There is more then one result for ZonesMetric UUID: %s!", id)
 }
 
-// Lists zones
-func (s *ZoneService) ListZones(p *ListZonesParams) (*ListZonesResponse, error) {
-	resp, err := s.cs.newRequest("listZones", p.toURLValues())
+// Lists zone metrics
+func (s *ZoneService) ListZonesMetrics(p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error) {
+	return s.ListZonesMetricsWithContext(context.Background(), p)
+}
+
+// ListZonesMetricsWithContext is the same as ListZonesMetrics, but uses ctx to cancel the request and any async job polling
+func (s *ZoneService) ListZonesMetricsWithContext(ctx context.Context, p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "listZonesMetrics", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListZonesResponse
+	var r ListZonesMetricsResponse
 	if err := json.Unmarshal(resp, &r); err != nil {
 		return nil, err
 	}
@@ -942,40 +2470,144 @@ func (s *ZoneService) ListZones(p *ListZonesParams) (*ListZonesResponse, error)
 	return &r, nil
 }
 
-type ListZonesResponse struct {
-	Count int     `json:"count"`
-	Zones []*Zone `json:"zone"`
+// ListZonesMetricsPager iterates over all pages of a ListZonesMetrics call. Use Next to fetch the next page until it
+// returns false, and check Err afterwards to see if all pages were fetched successfully.
+type ListZonesMetricsPager struct {
+	pager
+
+	s *ZoneService
+	p *ListZonesMetricsParams
+	r *ListZonesMetricsResponse
 }
 
-type Zone struct {
-	Allocationstate       string            `json:"allocationstate"`
-	Capacity              []ZoneCapacity    `json:"capacity"`
-	Description           string            `json:"description"`
-	Dhcpprovider          string            `json:"dhcpprovider"`
-	Displaytext           string            `json:"displaytext"`
-	Dns1                  string            `json:"dns1"`
-	Dns2                  string            `json:"dns2"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Domainname            string            `json:"domainname"`
-	Guestcidraddress      string            `json:"guestcidraddress"`
-	Id                    string            `json:"id"`
-	Internaldns1          string            `json:"internaldns1"`
-	Internaldns2          string            `json:"internaldns2"`
-	Ip6dns1               string            `json:"ip6dns1"`
-	Ip6dns2               string            `json:"ip6dns2"`
-	JobID                 string            `json:"jobid"`
-	Jobstatus             int               `json:"jobstatus"`
-	Localstorageenabled   bool              `json:"localstorageenabled"`
-	Name                  string            `json:"name"`
-	Networktype           string            `json:"networktype"`
-	Resourcedetails       map[string]string `json:"resourcedetails"`
-	Securitygroupsenabled bool              `json:"securitygroupsenabled"`
-	Tags                  []Tags            `json:"tags"`
-	Zonetoken             string            `json:"zonetoken"`
+// NewListZonesMetricsPager returns a pager for all pages of a ListZonesMetrics call with the given params
+func (s *ZoneService) NewListZonesMetricsPager(p *ListZonesMetricsParams) *ListZonesMetricsPager {
+	return &ListZonesMetricsPager{pager: newPager(p.p), s: s, p: p}
 }
 
-type ZoneCapacity struct {
+// Next fetches the next page and returns true if the page contains any items
+func (pg *ListZonesMetricsPager) Next() bool {
+	return pg.NextWithContext(context.Background())
+}
+
+// NextWithContext is the same as Next, but uses ctx to cancel the request
+func (pg *ListZonesMetricsPager) NextWithContext(ctx context.Context) bool {
+	if !pg.next() {
+		return false
+	}
+
+	p := &ListZonesMetricsParams{p: copyParams(pg.p.p)}
+	p.SetPage(pg.page)
+	p.SetPagesize(pg.pagesize)
+
+	r, err := pg.s.ListZonesMetricsWithContext(ctx, p)
+	if err != nil {
+		return pg.update(0, err)
+	}
+	pg.r = r
+
+	return pg.update(len(r.ZonesMetrics), nil)
+}
+
+// Page returns the page fetched by the last call to Next
+func (pg *ListZonesMetricsPager) Page() *ListZonesMetricsResponse {
+	return pg.r
+}
+
+// ListZonesMetricsAll fetches all pages of a ListZonesMetrics call and returns all items in a single response.
+// Use the WithListConcurrency option to fetch multiple pages concurrently.
+func (s *ZoneService) ListZonesMetricsAll(p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error) {
+	return s.ListZonesMetricsAllWithContext(context.Background(), p)
+}
+
+// ListZonesMetricsAllWithContext is the same as ListZonesMetricsAll, but uses ctx to cancel the requests
+func (s *ZoneService) ListZonesMetricsAllWithContext(ctx context.Context, p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error) {
+	pagesize := pageSize(p.p)
+
+	var mu sync.Mutex
+	pages := make(map[int][]*ZonesMetric)
+
+	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {
+		pp := &ListZonesMetricsParams{p: copyParams(p.p)}
+		pp.SetPage(page)
+		pp.SetPagesize(pagesize)
+
+		l, err := s.ListZonesMetricsWithContext(ctx, pp)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		mu.Lock()
+		pages[page] = l.ZonesMetrics
+		mu.Unlock()
+
+		return len(l.ZonesMetrics), l.Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ListZonesMetricsResponse{}
+	for page := 1; page <= len(pages); page++ {
+		r.ZonesMetrics = append(r.ZonesMetrics, pages[page]...)
+	}
+	r.Count = len(r.ZonesMetrics)
+
+	return r, nil
+}
+
+type ListZonesMetricsResponse struct {
+	Count        int            `json:"count"`
+	ZonesMetrics []*ZonesMetric `json:"zone"`
+}
+
+type ZonesMetric struct {
+	Allocationstate                 string                `json:"allocationstate"`
+	Capacity                        []ZonesMetricCapacity `json:"capacity"`
+	Clusters                        string                `json:"clusters"`
+	Cpuallocated                    string                `json:"cpuallocated"`
+	Cpuallocateddisablethreshold    bool                  `json:"cpuallocateddisablethreshold"`
+	Cpuallocatedthreshold           bool                  `json:"cpuallocatedthreshold"`
+	Cpudisablethreshold             bool                  `json:"cpudisablethreshold"`
+	Cpumaxdeviation                 string                `json:"cpumaxdeviation"`
+	Cputhreshold                    bool                  `json:"cputhreshold"`
+	Cputotal                        string                `json:"cputotal"`
+	Cpuused                         string                `json:"cpuused"`
+	Description                     string                `json:"description"`
+	Dhcpprovider                    string                `json:"dhcpprovider"`
+	Displaytext                     string                `json:"displaytext"`
+	Dns1                            string                `json:"dns1"`
+	Dns2                            string                `json:"dns2"`
+	Domain                          string                `json:"domain"`
+	Domainid                        string                `json:"domainid"`
+	Domainname                      string                `json:"domainname"`
+	Guestcidraddress                string                `json:"guestcidraddress"`
+	Id                              string                `json:"id"`
+	Internaldns1                    string                `json:"internaldns1"`
+	Internaldns2                    string                `json:"internaldns2"`
+	Ip6dns1                         string                `json:"ip6dns1"`
+	Ip6dns2                         string                `json:"ip6dns2"`
+	JobID                           string                `json:"jobid"`
+	Jobstatus                       int                   `json:"jobstatus"`
+	Localstorageenabled             bool                  `json:"localstorageenabled"`
+	Memoryallocated                 string                `json:"memoryallocated"`
+	Memoryallocateddisablethreshold bool                  `json:"memoryallocateddisablethreshold"`
+	Memoryallocatedthreshold        bool                  `json:"memoryallocatedthreshold"`
+	Memorydisablethreshold          bool                  `json:"memorydisablethreshold"`
+	Memorymaxdeviation              string                `json:"memorymaxdeviation"`
+	Memorythreshold                 bool                  `json:"memorythreshold"`
+	Memorytotal                     string                `json:"memorytotal"`
+	Memoryused                      string                `json:"memoryused"`
+	Name                            string                `json:"name"`
+	Networktype                     string                `json:"networktype"`
+	Resourcedetails                 map[string]string     `json:"resourcedetails"`
+	Securitygroupsenabled           bool                  `json:"securitygroupsenabled"`
+	State                           string                `json:"state"`
+	Tags                            []Tags                `json:"tags"`
+	Zonetoken                       string                `json:"zonetoken"`
+}
+
+type ZonesMetricCapacity struct {
 	Capacityallocated int64  `json:"capacityallocated"`
 	Capacitytotal     int64  `json:"capacitytotal"`
 	Capacityused      int64  `json:"capacityused"`
@@ -994,7 +2626,8 @@ type ReleaseDedicatedZoneParams struct {
 	p map[string]interface{}
 }
 
-func (p *ReleaseDedicatedZoneParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *ReleaseDedicatedZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1005,6 +2638,48 @@ func (p *ReleaseDedicatedZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *ReleaseDedicatedZoneParams) Validate() error {
+	v := newParamValidator("releaseDedicatedZone", p.p)
+	v.required("zoneid")
+	v.ids("zoneid")
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *ReleaseDedicatedZoneParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *ReleaseDedicatedZoneParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "zoneid":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for releaseDedicatedZone", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of releaseDedicatedZone: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *ReleaseDedicatedZoneParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1012,6 +2687,15 @@ func (p *ReleaseDedicatedZoneParams) SetZoneid(v string) {
 	p.p["zoneid"] = v
 }
 
+func (p *ReleaseDedicatedZoneParams) ResetZoneid() {
+	delete(p.p, "zoneid")
+}
+
+func (p *ReleaseDedicatedZoneParams) GetZoneid() (string, bool) {
+	value, ok := p.p["zoneid"].(string)
+	return value, ok
+}
+
 // You should always use this function to get a new ReleaseDedicatedZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedicatedZoneParams {
@@ -1023,7 +2707,16 @@ func (s *ZoneService) NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedic
 
 // Release dedication of zone
 func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedZone", p.toURLValues())
+	return s.ReleaseDedicatedZoneWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedZoneWithContext is the same as ReleaseDedicatedZone, but uses ctx to cancel the request and any async job polling
+func (s *ZoneService) ReleaseDedicatedZoneWithContext(ctx context.Context, p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedZone", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1035,7 +2728,7 @@ func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*Rele
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)
 		if err != nil {
 			if err == AsyncTimeoutErr {
 				return &r, err
@@ -1051,6 +2744,31 @@ func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*Rele
 	return &r, nil
 }
 
+// ReleaseDedicatedZoneAsync starts the async job for ReleaseDedicatedZone and returns a handle to the job, without waiting for it to finish.
+// When the job is finished, the result can be decoded into a ReleaseDedicatedZoneResponse using Job.Result
+func (s *ZoneService) ReleaseDedicatedZoneAsync(p *ReleaseDedicatedZoneParams) (*Job, error) {
+	return s.ReleaseDedicatedZoneAsyncWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedZoneAsyncWithContext is the same as ReleaseDedicatedZoneAsync, but uses ctx to cancel the request and to bound the polling of the job
+func (s *ZoneService) ReleaseDedicatedZoneAsyncWithContext(ctx context.Context, p *ReleaseDedicatedZoneParams) (*Job, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "releaseDedicatedZone", p.ToURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleaseDedicatedZoneResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+
+	return newJob(ctx, s.cs, r.JobID, nil), nil
+}
+
 type ReleaseDedicatedZoneResponse struct {
 	Displaytext string `json:"displaytext"`
 	JobID       string `json:"jobid"`
@@ -1062,7 +2780,8 @@ type UpdateZoneParams struct {
 	p map[string]interface{}
 }
 
-func (p *UpdateZoneParams) toURLValues() url.Values {
+// ToURLValues returns the params encoded as they are sent to CloudStack
+func (p *UpdateZoneParams) ToURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
 		return u
@@ -1129,6 +2848,75 @@ func (p *UpdateZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// Validate returns an error if a required param is missing or empty, or if a param has an invalid value
+func (p *UpdateZoneParams) Validate() error {
+	v := newParamValidator("updateZone", p.p)
+	v.required("id")
+	v.ids("id")
+	v.maxLength("allocationstate", 255)
+	v.maxLength("dhcpprovider", 255)
+	v.maxLength("dns1", 255)
+	v.maxLength("dns2", 255)
+	v.maxLength("domain", 255)
+	v.maxLength("guestcidraddress", 255)
+	v.maxLength("internaldns1", 255)
+	v.maxLength("internaldns2", 255)
+	v.maxLength("ip6dns1", 255)
+	v.maxLength("ip6dns2", 255)
+	v.maxLength("name", 255)
+	return v.err()
+}
+
+// MarshalJSON returns the params that are set as a JSON object
+func (p *UpdateZoneParams) MarshalJSON() ([]byte, error) {
+	if p.p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p.p)
+}
+
+// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every
+// param into the same type as used by its setter
+func (p *UpdateZoneParams) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	p.p = make(map[string]interface{}, len(m))
+	for k, raw := range m {
+		var err error
+		switch k {
+		case "allocationstate", "dhcpprovider", "dns1", "dns2", "domain", "guestcidraddress", "id", "internaldns1", "internaldns2", "ip6dns1", "ip6dns2", "name":
+			var v string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "details":
+			var v map[string]string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "dnssearchorder":
+			var v []string
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "ispublic", "localstorageenabled":
+			var v bool
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		case "sortkey":
+			var v int
+			err = json.Unmarshal(raw, &v)
+			p.p[k] = v
+		default:
+			return fmt.Errorf("Unknown param %s for updateZone", k)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value for param %s of updateZone: %w", k, err)
+		}
+	}
+	return nil
+}
+
 func (p *UpdateZoneParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1136,6 +2924,15 @@ func (p *UpdateZoneParams) SetAllocationstate(v string) {
 	p.p["allocationstate"] = v
 }
 
+func (p *UpdateZoneParams) ResetAllocationstate() {
+	delete(p.p, "allocationstate")
+}
+
+func (p *UpdateZoneParams) GetAllocationstate() (string, bool) {
+	value, ok := p.p["allocationstate"].(string)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1143,6 +2940,15 @@ func (p *UpdateZoneParams) SetDetails(v map[string]string) {
 	p.p["details"] = v
 }
 
+func (p *UpdateZoneParams) ResetDetails() {
+	delete(p.p, "details")
+}
+
+func (p *UpdateZoneParams) GetDetails() (map[string]string, bool) {
+	value, ok := p.p["details"].(map[string]string)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetDhcpprovider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1150,6 +2956,15 @@ func (p *UpdateZoneParams) SetDhcpprovider(v string) {
 	p.p["dhcpprovider"] = v
 }
 
+func (p *UpdateZoneParams) ResetDhcpprovider() {
+	delete(p.p, "dhcpprovider")
+}
+
+func (p *UpdateZoneParams) GetDhcpprovider() (string, bool) {
+	value, ok := p.p["dhcpprovider"].(string)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetDns1(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1157,6 +2972,15 @@ func (p *UpdateZoneParams) SetDns1(v string) {
 	p.p["dns1"] = v
 }
 
+func (p *UpdateZoneParams) ResetDns1() {
+	delete(p.p, "dns1")
+}
+
+func (p *UpdateZoneParams) GetDns1() (string, bool) {
+	value, ok := p.p["dns1"].(string)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetDns2(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1164,6 +2988,15 @@ func (p *UpdateZoneParams) SetDns2(v string) {
 	p.p["dns2"] = v
 }
 
+func (p *UpdateZoneParams) ResetDns2() {
+	delete(p.p, "dns2")
+}
+
+func (p *UpdateZoneParams) GetDns2() (string, bool) {
+	value, ok := p.p["dns2"].(string)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetDnssearchorder(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1171,6 +3004,15 @@ func (p *UpdateZoneParams) SetDnssearchorder(v []string) {
 	p.p["dnssearchorder"] = v
 }
 
+func (p *UpdateZoneParams) ResetDnssearchorder() {
+	delete(p.p, "dnssearchorder")
+}
+
+func (p *UpdateZoneParams) GetDnssearchorder() ([]string, bool) {
+	value, ok := p.p["dnssearchorder"].([]string)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetDomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1178,6 +3020,15 @@ func (p *UpdateZoneParams) SetDomain(v string) {
 	p.p["domain"] = v
 }
 
+func (p *UpdateZoneParams) ResetDomain() {
+	delete(p.p, "domain")
+}
+
+func (p *UpdateZoneParams) GetDomain() (string, bool) {
+	value, ok := p.p["domain"].(string)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetGuestcidraddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1185,6 +3036,15 @@ func (p *UpdateZoneParams) SetGuestcidraddress(v string) {
 	p.p["guestcidraddress"] = v
 }
 
+func (p *UpdateZoneParams) ResetGuestcidraddress() {
+	delete(p.p, "guestcidraddress")
+}
+
+func (p *UpdateZoneParams) GetGuestcidraddress() (string, bool) {
+	value, ok := p.p["guestcidraddress"].(string)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1192,6 +3052,15 @@ func (p *UpdateZoneParams) SetId(v string) {
 	p.p["id"] = v
 }
 
+func (p *UpdateZoneParams) ResetId() {
+	delete(p.p, "id")
+}
+
+func (p *UpdateZoneParams) GetId() (string, bool) {
+	value, ok := p.p["id"].(string)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetInternaldns1(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1199,6 +3068,15 @@ func (p *UpdateZoneParams) SetInternaldns1(v string) {
 	p.p["internaldns1"] = v
 }
 
+func (p *UpdateZoneParams) ResetInternaldns1() {
+	delete(p.p, "internaldns1")
+}
+
+func (p *UpdateZoneParams) GetInternaldns1() (string, bool) {
+	value, ok := p.p["internaldns1"].(string)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetInternaldns2(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1206,6 +3084,15 @@ func (p *UpdateZoneParams) SetInternaldns2(v string) {
 	p.p["internaldns2"] = v
 }
 
+func (p *UpdateZoneParams) ResetInternaldns2() {
+	delete(p.p, "internaldns2")
+}
+
+func (p *UpdateZoneParams) GetInternaldns2() (string, bool) {
+	value, ok := p.p["internaldns2"].(string)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetIp6dns1(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1213,6 +3100,15 @@ func (p *UpdateZoneParams) SetIp6dns1(v string) {
 	p.p["ip6dns1"] = v
 }
 
+func (p *UpdateZoneParams) ResetIp6dns1() {
+	delete(p.p, "ip6dns1")
+}
+
+func (p *UpdateZoneParams) GetIp6dns1() (string, bool) {
+	value, ok := p.p["ip6dns1"].(string)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetIp6dns2(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1220,6 +3116,15 @@ func (p *UpdateZoneParams) SetIp6dns2(v string) {
 	p.p["ip6dns2"] = v
 }
 
+func (p *UpdateZoneParams) ResetIp6dns2() {
+	delete(p.p, "ip6dns2")
+}
+
+func (p *UpdateZoneParams) GetIp6dns2() (string, bool) {
+	value, ok := p.p["ip6dns2"].(string)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetIspublic(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1227,6 +3132,15 @@ func (p *UpdateZoneParams) SetIspublic(v bool) {
 	p.p["ispublic"] = v
 }
 
+func (p *UpdateZoneParams) ResetIspublic() {
+	delete(p.p, "ispublic")
+}
+
+func (p *UpdateZoneParams) GetIspublic() (bool, bool) {
+	value, ok := p.p["ispublic"].(bool)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetLocalstorageenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1234,6 +3148,15 @@ func (p *UpdateZoneParams) SetLocalstorageenabled(v bool) {
 	p.p["localstorageenabled"] = v
 }
 
+func (p *UpdateZoneParams) ResetLocalstorageenabled() {
+	delete(p.p, "localstorageenabled")
+}
+
+func (p *UpdateZoneParams) GetLocalstorageenabled() (bool, bool) {
+	value, ok := p.p["localstorageenabled"].(bool)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1241,6 +3164,15 @@ func (p *UpdateZoneParams) SetName(v string) {
 	p.p["name"] = v
 }
 
+func (p *UpdateZoneParams) ResetName() {
+	delete(p.p, "name")
+}
+
+func (p *UpdateZoneParams) GetName() (string, bool) {
+	value, ok := p.p["name"].(string)
+	return value, ok
+}
+
 func (p *UpdateZoneParams) SetSortkey(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1248,6 +3180,15 @@ func (p *UpdateZoneParams) SetSortkey(v int) {
 	p.p["sortkey"] = v
 }
 
+func (p *UpdateZoneParams) ResetSortkey() {
+	delete(p.p, "sortkey")
+}
+
+func (p *UpdateZoneParams) GetSortkey() (int, bool) {
+	value, ok := p.p["sortkey"].(int)
+	return value, ok
+}
+
 // You should always use this function to get a new UpdateZoneParams instance,
 // as then you are sure you have configured all required params
 func (s *ZoneService) NewUpdateZoneParams(id string) *UpdateZoneParams {
@@ -1259,7 +3200,16 @@ func (s *ZoneService) NewUpdateZoneParams(id string) *UpdateZoneParams {
 
 // Updates a Zone.
 func (s *ZoneService) UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error) {
-	resp, err := s.cs.newRequest("updateZone", p.toURLValues())
+	return s.UpdateZoneWithContext(context.Background(), p)
+}
+
+// UpdateZoneWithContext is the same as UpdateZone, but uses ctx to cancel the request and any async job polling
+func (s *ZoneService) UpdateZoneWithContext(ctx context.Context, p *UpdateZoneParams) (*UpdateZoneResponse, error) {
+	if err := s.cs.validateParams(p); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequestWithContext(ctx, "updateZone", p.ToURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,65 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+	"github.com/apache/cloudstack-go/v2/cloudstack/cloudstacktest"
+)
+
+func TestGetZoneByIDNotFound(t *testing.T) {
+	const id = "2b7a1f1c-5f0a-4c0e-9a64-3a8e4f0c1d2e"
+
+	tests := []struct {
+		name string
+		fail string // The error text of an injected failure, if any
+	}{
+		{name: "unknown ID"},
+		{name: "entity removed", fail: "Unable to find zone with id " + id},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := cloudstacktest.NewServer()
+			defer s.Close()
+			s.AddZone("zone1")
+			if tt.fail != "" {
+				s.FailNext("listZones", 431, tt.fail)
+			}
+
+			cs := s.NewClient()
+			z, count, err := cs.Zone.GetZoneByID(id)
+			if z != nil || count != 0 {
+				t.Errorf("Expected no zone and a count of 0, got %+v and %d", z, count)
+			}
+			if !cloudstack.IsNotFound(err) {
+				t.Fatalf("Expected IsNotFound to hold, got %v", err)
+			}
+
+			var cse *cloudstack.CSError
+			if !errors.As(err, &cse) || cse.ErrorCode != 431 {
+				t.Errorf("Expected the *CSError of CloudStack to be wrapped, got %v", err)
+			}
+		})
+	}
+}
@@ -21,7 +21,9 @@ package cloudstack
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha1"
 	"crypto/tls"
 	"encoding/base64"
@@ -55,95 +57,170 @@ type ClientOption func(*CloudStackClient)
 // OptionFunc can be passed to the courtesy helper functions to set additional parameters
 type OptionFunc func(*CloudStackClient, interface{}) error
 
+// CSError is returned when the CloudStack API responds with an error. Use errors.As to get
+// hold of it, or one of the IsNotFound, IsPermissionDenied or IsConcurrentOperation helpers
+// to check for some common failures.
 type CSError struct {
-	ErrorCode   int    `json:"errorcode"`
-	CSErrorCode int    `json:"cserrorcode"`
-	ErrorText   string `json:"errortext"`
+	StatusCode  int           `json:"-"` // The HTTP status code of the response
+	Command     string        `json:"-"` // The API command that failed
+	ErrorCode   int           `json:"errorcode"`
+	CSErrorCode int           `json:"cserrorcode"`
+	ErrorText   string        `json:"errortext"`
+	UUIDList    []CSErrorUUID `json:"uuidList"` // The UUIDs of the entities CloudStack related to the error
+	RequestID   string        `json:"-"`        // The ID of the request, sent using the X-Request-Id header
+}
+
+// CSErrorUUID is a single entry of the UUID list that can be part of a CloudStack error
+type CSErrorUUID struct {
+	UUID        string `json:"uuid"`
+	Description string `json:"description"`
+}
+
+func (e *CSError) Error() string {
+	return fmt.Sprintf("CloudStack API error %d (CSExceptionErrorCode: %d): %s", e.ErrorCode, e.CSErrorCode, e.ErrorText)
+}
+
+// Is makes it possible to compare a CSError with one of the sentinel errors using errors.Is
+func (e *CSError) Is(target error) bool {
+	text := strings.ToLower(e.ErrorText)
+
+	switch target {
+	case ErrNotFound:
+		return e.ErrorCode == 431 && (strings.Contains(text, "does not exist") ||
+			strings.Contains(text, "unable to find") || strings.Contains(text, "not found"))
+	case ErrPermissionDenied:
+		return e.ErrorCode == 401 || e.ErrorCode == 531 || strings.Contains(text, "does not have permission") ||
+			strings.Contains(text, "permission denied")
+	case ErrConcurrentOperation:
+		return strings.Contains(text, "concurrent operation")
+	}
+	return false
 }
 
-func (e *CSError) Error() error {
-	return fmt.Errorf("CloudStack API error %d (CSExceptionErrorCode: %d): %s", e.ErrorCode, e.CSErrorCode, e.ErrorText)
+var (
+	// ErrNotFound matches errors caused by referencing an entity that does not exist
+	ErrNotFound = errors.New("entity does not exist")
+
+	// ErrPermissionDenied matches errors caused by missing credentials or permissions
+	ErrPermissionDenied = errors.New("permission denied")
+
+	// ErrConcurrentOperation matches errors caused by another operation running on the same entity
+	ErrConcurrentOperation = errors.New("concurrent operation")
+)
+
+// IsNotFound returns true if err reports that the requested entity does not exist
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsPermissionDenied returns true if err reports missing credentials or permissions
+func IsPermissionDenied(err error) bool {
+	return errors.Is(err, ErrPermissionDenied)
+}
+
+// IsConcurrentOperation returns true if err reports that another operation is running on the same entity
+func IsConcurrentOperation(err error) bool {
+	return errors.Is(err, ErrConcurrentOperation)
 }
 
 type CloudStackClient struct {
 	HTTPGETOnly bool // If `true` only use HTTP GET calls
 
-	client  *http.Client // The http client for communicating
-	baseURL string       // The base URL of the API
-	apiKey  string       // Api key
-	secret  string       // Secret key
-	async   bool         // Wait for async calls to finish
-	options []OptionFunc // A list of option functions to apply to all API calls
-	timeout int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds
-
-	APIDiscovery        *APIDiscoveryService
-	Account             *AccountService
-	Address             *AddressService
-	AffinityGroup       *AffinityGroupService
-	Alert               *AlertService
-	Asyncjob            *AsyncjobService
-	Authentication      *AuthenticationService
-	AutoScale           *AutoScaleService
-	Baremetal           *BaremetalService
-	BigSwitchBCF        *BigSwitchBCFService
-	BrocadeVCS          *BrocadeVCSService
-	Certificate         *CertificateService
-	CloudIdentifier     *CloudIdentifierService
-	Cluster             *ClusterService
-	Configuration       *ConfigurationService
-	Custom              *CustomService
-	DiskOffering        *DiskOfferingService
-	Domain              *DomainService
-	Event               *EventService
-	Firewall            *FirewallService
-	GuestOS             *GuestOSService
-	Host                *HostService
-	Hypervisor          *HypervisorService
-	ISO                 *ISOService
-	ImageStore          *ImageStoreService
-	InternalLB          *InternalLBService
-	LDAP                *LDAPService
-	Limit               *LimitService
-	LoadBalancer        *LoadBalancerService
-	NAT                 *NATService
-	NetworkACL          *NetworkACLService
-	NetworkDevice       *NetworkDeviceService
-	NetworkOffering     *NetworkOfferingService
-	Network             *NetworkService
-	Nic                 *NicService
-	NiciraNVP           *NiciraNVPService
-	OutofbandManagement *OutofbandManagementService
-	OvsElement          *OvsElementService
-	Pod                 *PodService
-	Pool                *PoolService
-	PortableIP          *PortableIPService
-	Project             *ProjectService
-	Quota               *QuotaService
-	Region              *RegionService
-	Resourcemetadata    *ResourcemetadataService
-	Resourcetags        *ResourcetagsService
-	Role                *RoleService
-	Router              *RouterService
-	SSH                 *SSHService
-	SecurityGroup       *SecurityGroupService
-	ServiceOffering     *ServiceOfferingService
-	Snapshot            *SnapshotService
-	StoragePool         *StoragePoolService
-	StratosphereSSP     *StratosphereSSPService
-	Swift               *SwiftService
-	SystemCapacity      *SystemCapacityService
-	SystemVM            *SystemVMService
-	Template            *TemplateService
-	UCS                 *UCSService
-	Usage               *UsageService
-	User                *UserService
-	VLAN                *VLANService
-	VMGroup             *VMGroupService
-	VPC                 *VPCService
-	VPN                 *VPNService
-	VirtualMachine      *VirtualMachineService
-	Volume              *VolumeService
-	Zone                *ZoneService
+	client         *http.Client      // The http client for communicating
+	baseURL        string            // The base URL of the API
+	apiKey         string            // Api key
+	secret         string            // Secret key
+	async          bool              // Wait for async calls to finish
+	options        []OptionFunc      // A list of option functions to apply to all API calls
+	timeout        int64             // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds
+	watcher        *JobWatcher       // If set, async jobs are polled in batches by the watcher
+	retry          RetryPolicy       // The policy used to retry failed requests
+	concurrency    int               // Max number of pages fetched concurrently by the List...All helpers
+	session        *session          // If set, requests are authenticated using a session key
+	expiry         time.Duration     // If set, requests use signature version 3 and expire after this duration
+	middleware     []Middleware      // The middleware wrapping every request, see WithMiddleware
+	traces         []*ClientTrace    // The traces notified about every request and async job
+	limiter        *RateLimiter      // The optional rate limiter, see WithRateLimiter
+	methods        map[string]string // The HTTP method used per (lowercased) command, see WithHTTPMethod
+	maxQueryLength int               // Requests with a longer query use POST, see WithMaxGETQueryLength
+	validate       bool              // If set, the params of every request are validated, see WithValidation
+
+	APIDiscovery        APIDiscoveryServiceIface
+	Account             AccountServiceIface
+	Address             AddressServiceIface
+	AffinityGroup       AffinityGroupServiceIface
+	Alert               AlertServiceIface
+	Annotation          AnnotationServiceIface
+	Asyncjob            AsyncjobServiceIface
+	Authentication      AuthenticationServiceIface
+	AutoScale           AutoScaleServiceIface
+	Backup              BackupServiceIface
+	Baremetal           BaremetalServiceIface
+	BigSwitchBCF        BigSwitchBCFServiceIface
+	BrocadeVCS          BrocadeVCSServiceIface
+	Certificate         CertificateServiceIface
+	CloudIdentifier     CloudIdentifierServiceIface
+	Cluster             ClusterServiceIface
+	Configuration       ConfigurationServiceIface
+	Custom              CustomServiceIface
+	Diagnostics         DiagnosticsServiceIface
+	DiskOffering        DiskOfferingServiceIface
+	Domain              DomainServiceIface
+	Event               EventServiceIface
+	Firewall            FirewallServiceIface
+	GuestOS             GuestOSServiceIface
+	Host                HostServiceIface
+	Hypervisor          HypervisorServiceIface
+	ISO                 ISOServiceIface
+	ImageStore          ImageStoreServiceIface
+	InternalLB          InternalLBServiceIface
+	Kubernetes          KubernetesServiceIface
+	LDAP                LDAPServiceIface
+	Limit               LimitServiceIface
+	LoadBalancer        LoadBalancerServiceIface
+	Management          ManagementServiceIface
+	NAT                 NATServiceIface
+	Netscaler           NetscalerServiceIface
+	NetworkACL          NetworkACLServiceIface
+	NetworkDevice       NetworkDeviceServiceIface
+	NetworkOffering     NetworkOfferingServiceIface
+	Network             NetworkServiceIface
+	Nic                 NicServiceIface
+	NiciraNVP           NiciraNVPServiceIface
+	OutofbandManagement OutofbandManagementServiceIface
+	OvsElement          OvsElementServiceIface
+	Pod                 PodServiceIface
+	Pool                PoolServiceIface
+	PortableIP          PortableIPServiceIface
+	Project             ProjectServiceIface
+	Quota               QuotaServiceIface
+	Region              RegionServiceIface
+	Resourcemetadata    ResourcemetadataServiceIface
+	Resourcetags        ResourcetagsServiceIface
+	Role                RoleServiceIface
+	Router              RouterServiceIface
+	SSH                 SSHServiceIface
+	SecurityGroup       SecurityGroupServiceIface
+	ServiceOffering     ServiceOfferingServiceIface
+	Simulator           SimulatorServiceIface
+	Snapshot            SnapshotServiceIface
+	StoragePool         StoragePoolServiceIface
+	StratosphereSSP     StratosphereSSPServiceIface
+	Swift               SwiftServiceIface
+	SystemCapacity      SystemCapacityServiceIface
+	SystemVM            SystemVMServiceIface
+	Template            TemplateServiceIface
+	UCS                 UCSServiceIface
+	Usage               UsageServiceIface
+	User                UserServiceIface
+	VLAN                VLANServiceIface
+	VMGroup             VMGroupServiceIface
+	VMWare              VMWareServiceIface
+	VPC                 VPCServiceIface
+	VPN                 VPNServiceIface
+	VirtualMachine      VirtualMachineServiceIface
+	Volume              VolumeServiceIface
+	Zone                ZoneServiceIface
 }
 
 // Creates a new client for communicating with CloudStack
@@ -167,12 +244,13 @@ func newClient(apiurl string, apikey string, secret string, async bool, verifyss
 			},
 			Timeout: time.Duration(60 * time.Second),
 		},
-		baseURL: apiurl,
-		apiKey:  apikey,
-		secret:  secret,
-		async:   async,
-		options: []OptionFunc{},
-		timeout: 300,
+		baseURL:        apiurl,
+		apiKey:         apikey,
+		secret:         secret,
+		async:          async,
+		options:        []OptionFunc{},
+		timeout:        300,
+		maxQueryLength: DefaultMaxGETQueryLength,
 	}
 
 	for _, fn := range options {
@@ -184,9 +262,11 @@ func newClient(apiurl string, apikey string, secret string, async bool, verifyss
 	cs.Address = NewAddressService(cs)
 	cs.AffinityGroup = NewAffinityGroupService(cs)
 	cs.Alert = NewAlertService(cs)
+	cs.Annotation = NewAnnotationService(cs)
 	cs.Asyncjob = NewAsyncjobService(cs)
 	cs.Authentication = NewAuthenticationService(cs)
 	cs.AutoScale = NewAutoScaleService(cs)
+	cs.Backup = NewBackupService(cs)
 	cs.Baremetal = NewBaremetalService(cs)
 	cs.BigSwitchBCF = NewBigSwitchBCFService(cs)
 	cs.BrocadeVCS = NewBrocadeVCSService(cs)
@@ -195,6 +275,7 @@ func newClient(apiurl string, apikey string, secret string, async bool, verifyss
 	cs.Cluster = NewClusterService(cs)
 	cs.Configuration = NewConfigurationService(cs)
 	cs.Custom = NewCustomService(cs)
+	cs.Diagnostics = NewDiagnosticsService(cs)
 	cs.DiskOffering = NewDiskOfferingService(cs)
 	cs.Domain = NewDomainService(cs)
 	cs.Event = NewEventService(cs)
@@ -205,10 +286,13 @@ func newClient(apiurl string, apikey string, secret string, async bool, verifyss
 	cs.ISO = NewISOService(cs)
 	cs.ImageStore = NewImageStoreService(cs)
 	cs.InternalLB = NewInternalLBService(cs)
+	cs.Kubernetes = NewKubernetesService(cs)
 	cs.LDAP = NewLDAPService(cs)
 	cs.Limit = NewLimitService(cs)
 	cs.LoadBalancer = NewLoadBalancerService(cs)
+	cs.Management = NewManagementService(cs)
 	cs.NAT = NewNATService(cs)
+	cs.Netscaler = NewNetscalerService(cs)
 	cs.NetworkACL = NewNetworkACLService(cs)
 	cs.NetworkDevice = NewNetworkDeviceService(cs)
 	cs.NetworkOffering = NewNetworkOfferingService(cs)
@@ -230,6 +314,7 @@ func newClient(apiurl string, apikey string, secret string, async bool, verifyss
 	cs.SSH = NewSSHService(cs)
 	cs.SecurityGroup = NewSecurityGroupService(cs)
 	cs.ServiceOffering = NewServiceOfferingService(cs)
+	cs.Simulator = NewSimulatorService(cs)
 	cs.Snapshot = NewSnapshotService(cs)
 	cs.StoragePool = NewStoragePoolService(cs)
 	cs.StratosphereSSP = NewStratosphereSSPService(cs)
@@ -242,6 +327,7 @@ func newClient(apiurl string, apikey string, secret string, async bool, verifyss
 	cs.User = NewUserService(cs)
 	cs.VLAN = NewVLANService(cs)
 	cs.VMGroup = NewVMGroupService(cs)
+	cs.VMWare = NewVMWareService(cs)
 	cs.VPC = NewVPCService(cs)
 	cs.VPN = NewVPNService(cs)
 	cs.VirtualMachine = NewVirtualMachineService(cs)
@@ -286,17 +372,40 @@ func (cs *CloudStackClient) DefaultOptions(options ...OptionFunc) {
 var AsyncTimeoutErr = errors.New("Timeout while waiting for async job to finish")
 
 // A helper function that you can use to get the result of a running async job. If the job is not finished within the configured
-// timeout, the async job returns a AsyncTimeoutErr.
+// timeout, the async job returns a AsyncTimeoutErr. If the job failed, an *AsyncJobError is returned.
 func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (json.RawMessage, error) {
+	return cs.GetAsyncJobResultWithContext(context.Background(), jobid, timeout)
+}
+
+// GetAsyncJobResultWithContext is the same as GetAsyncJobResult, but it stops polling and returns the context
+// error as soon as ctx is cancelled or its deadline expires.
+func (cs *CloudStackClient) GetAsyncJobResultWithContext(ctx context.Context, jobid string, timeout int64) (json.RawMessage, error) {
+	ctx = cs.traceJobWaitStart(ctx, jobid)
+	start := time.Now()
+
+	b, err := cs.getAsyncJobResult(ctx, jobid, timeout)
+	cs.traceJobWaitDone(ctx, &JobWaitInfo{JobID: jobid, Duration: time.Since(start), Err: err})
+
+	return b, err
+}
+
+func (cs *CloudStackClient) getAsyncJobResult(ctx context.Context, jobid string, timeout int64) (json.RawMessage, error) {
+	if cs.watcher != nil {
+		return cs.watcher.Wait(ctx, jobid, timeout)
+	}
+
 	var timer time.Duration
 	currentTime := time.Now().Unix()
 
 	for {
 		p := cs.Asyncjob.NewQueryAsyncJobResultParams(jobid)
-		r, err := cs.Asyncjob.QueryAsyncJobResult(p)
+		pctx := cs.traceJobPollStart(ctx, jobid)
+		r, err := cs.Asyncjob.QueryAsyncJobResultWithContext(pctx, p)
 		if err != nil {
+			cs.traceJobPollDone(pctx, &JobPollInfo{JobID: jobid, Err: err})
 			return nil, err
 		}
+		cs.traceJobPollDone(pctx, &JobPollInfo{JobID: jobid, Status: r.Jobstatus})
 
 		// Status 1 means the job is finished successfully
 		if r.Jobstatus == 1 {
@@ -305,11 +414,7 @@ func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (json
 
 		// When the status is 2, the job has failed
 		if r.Jobstatus == 2 {
-			if r.Jobresulttype == "text" {
-				return nil, fmt.Errorf(string(r.Jobresult))
-			} else {
-				return nil, fmt.Errorf("Undefined error: %s", string(r.Jobresult))
-			}
+			return nil, newAsyncJobError(r)
 		}
 
 		if time.Now().Unix()-currentTime > timeout {
@@ -322,18 +427,155 @@ func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (json
 			timer++
 		}
 
-		time.Sleep(timer * time.Second)
+		if err := sleepWithContext(ctx, timer*time.Second); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// AsyncJobError is returned when an async job finished, but failed to complete successfully
+type AsyncJobError struct {
+	JobID           string          // The ID of the failed job
+	Cmd             string          // The command executed by the job
+	Jobresultcode   int             // The result code of the job
+	Jobinstancetype string          // The type of the instance the job was working on
+	Jobinstanceid   string          // The ID of the instance the job was working on
+	ErrorCode       int             // The error code parsed from the job result
+	CSErrorCode     int             // The CS exception error code parsed from the job result
+	ErrorText       string          // The error text parsed from the job result
+	Jobresult       json.RawMessage // The unparsed job result
+}
+
+func newAsyncJobError(r *QueryAsyncJobResultResponse) *AsyncJobError {
+	e := &AsyncJobError{
+		JobID:           r.JobID,
+		Cmd:             r.Cmd,
+		Jobresultcode:   r.Jobresultcode,
+		Jobinstancetype: r.Jobinstancetype,
+		Jobinstanceid:   r.Jobinstanceid,
+		Jobresult:       r.Jobresult,
+	}
+
+	var result struct {
+		ErrorCode   int    `json:"errorcode"`
+		CSErrorCode int    `json:"cserrorcode"`
+		ErrorText   string `json:"errortext"`
+	}
+	var text string
+
+	switch {
+	case json.Unmarshal(r.Jobresult, &result) == nil:
+		e.ErrorCode = result.ErrorCode
+		e.CSErrorCode = result.CSErrorCode
+		e.ErrorText = result.ErrorText
+	case json.Unmarshal(r.Jobresult, &text) == nil:
+		e.ErrorText = text
+	default:
+		e.ErrorText = string(r.Jobresult)
+	}
+
+	return e
+}
+
+func (e *AsyncJobError) Error() string {
+	if e.ErrorCode == 0 {
+		return fmt.Sprintf("Async job %s (%s) failed: %s", e.JobID, e.Cmd, e.ErrorText)
+	}
+	return fmt.Sprintf("Async job %s (%s) failed with error %d (CSExceptionErrorCode: %d): %s",
+		e.JobID, e.Cmd, e.ErrorCode, e.CSErrorCode, e.ErrorText)
+}
+
+// Is makes it possible to compare an AsyncJobError with one of the sentinel errors using errors.Is
+func (e *AsyncJobError) Is(target error) bool {
+	return (&CSError{ErrorCode: e.ErrorCode, CSErrorCode: e.CSErrorCode, ErrorText: e.ErrorText}).Is(target)
+}
+
+// sleepWithContext pauses the current goroutine for at least the duration d, or until
+// ctx is done in which case the context error is returned.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
 	}
 }
 
 // Execute the request against a CS API. Will return the raw JSON data returned by the API and nil if
-// no error occured. If the API returns an error the result will be nil and the HTTP error code and CS
-// error details. If a processing (code) error occurs the result will be nil and the generated error
+// no error occured. If the API returns an error the result will be nil and a *CSError containing the HTTP
+// status and CS error details. If a processing (code) error occurs the result will be nil and the generated error
 func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawMessage, error) {
-	params.Set("apiKey", cs.apiKey)
+	return cs.newRequestWithContext(context.Background(), api, params)
+}
+
+// Same as newRequest, but the HTTP request is bound to ctx so it can be cancelled
+func (cs *CloudStackClient) newRequestWithContext(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {
+	// The first middleware is the outermost one, so it is the first to see the request
+	next := RequestFunc(cs.retryRequest)
+	for i := len(cs.middleware) - 1; i >= 0; i-- {
+		next = cs.middleware[i](next)
+	}
+	return next(ctx, api, params)
+}
+
+// Executes the request, and retries failed attempts according to the retry policy
+func (cs *CloudStackClient) retryRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {
+	ctx = cs.traceRequestStart(ctx, api, params)
+	ctx, state := withRequestState(ctx)
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		b, err := cs.doRequest(ctx, api, params)
+		if err == nil || ctx.Err() != nil || !cs.retry.shouldRetry(api, attempt, err) {
+			cs.traceRequestDone(ctx, newRequestInfo(cs, api, params, state, attempt, start, b, err))
+			return b, err
+		}
+		cs.traceRequestRetry(ctx, newRequestInfo(cs, api, params, state, attempt, start, b, err))
+
+		if err := sleepWithContext(ctx, cs.retry.backoff(attempt)); err != nil {
+			cs.traceRequestDone(ctx, newRequestInfo(cs, api, params, state, attempt, start, nil, err))
+			return nil, err
+		}
+	}
+}
+
+// Executes a single attempt of a request, see newRequest
+func (cs *CloudStackClient) doRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {
+	// Add the transport and auth fields to a copy, so the caller, the middleware and the trace hooks
+	// never see any credentials, and every attempt starts from the original params
+	params = cloneValues(params)
 	params.Set("command", api)
 	params.Set("response", "json")
 
+	// A session client authenticates using a session key instead of a signature
+	if cs.session != nil {
+		return cs.session.doRequest(ctx, cs, api, params)
+	}
+
+	params.Set("apiKey", cs.apiKey)
+
+	// Use signature version 3, so the signed request expires
+	if cs.expiry > 0 {
+		setExpires(params, cs.expiry)
+	}
+
+	return cs.sendRequest(ctx, api, cs.signQuery(params))
+}
+
+// Returns a copy of params, so fields can be added to the copy without changing params
+func cloneValues(params url.Values) url.Values {
+	c := make(url.Values, len(params))
+	for k, v := range params {
+		c[k] = append([]string(nil), v...)
+	}
+	return c
+}
+
+// Returns the encoded query string of params, including the signature
+func (cs *CloudStackClient) signQuery(params url.Values) string {
 	// Generate signature for API call
 	// * Serialize parameters, URL encoding only values and sort them by key, done by encodeValues
 	// * Convert the entire argument string to lowercase
@@ -347,48 +589,158 @@ func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawM
 	mac.Write([]byte(s3))
 	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
 
-	var err error
-	var resp *http.Response
-	if !cs.HTTPGETOnly && (api == "deployVirtualMachine" || api == "login" || api == "updateVirtualMachine") {
-		// The deployVirtualMachine API should be called using a POST call
-		// so we don't have to worry about the userdata size
+	// Add the signature to the query, without adding it to the params so the
+	// params can be signed again when the request is retried
+	return s + "&signature=" + url.QueryEscape(signature)
+}
+
+// Returns the HTTP method used to execute the api with the encoded query
+func (cs *CloudStackClient) httpMethod(api string, query string) string {
+	switch {
+	case cs.HTTPGETOnly:
+		return http.MethodGet
+	case secretCommands[api]:
+		// Secrets are always sent using POST, so they don't end up in any access logs
+		return http.MethodPost
+	}
 
-		// Add the unescaped signature to the POST params
-		params.Set("signature", signature)
+	if m, ok := cs.methods[strings.ToLower(api)]; ok {
+		return m
+	}
 
+	// Switch to POST when the URL would get too long for the server
+	if cs.maxQueryLength > 0 && len(query) > cs.maxQueryLength {
+		return http.MethodPost
+	}
+	return http.MethodGet
+}
+
+// The commands with params carrying secrets (like passwords, private keys or user data),
+// which are always sent using POST
+var secretCommands = map[string]bool{
+	"addBaremetalDhcp":                  true,
+	"addBaremetalHost":                  true,
+	"addBaremetalPxeKickStartServer":    true,
+	"addBaremetalPxePingServer":         true,
+	"addBigSwitchBcfDevice":             true,
+	"addBrocadeVcsDevice":               true,
+	"addCiscoVnmcResource":              true,
+	"addCluster":                        true,
+	"addExternalFirewall":               true,
+	"addExternalLoadBalancer":           true,
+	"addF5LoadBalancer":                 true,
+	"addGloboDnsHost":                   true,
+	"addHost":                           true,
+	"addImageStoreS3":                   true,
+	"addNetscalerLoadBalancer":          true,
+	"addNiciraNvpDevice":                true,
+	"addOpenDaylightController":         true,
+	"addPaloAltoFirewall":               true,
+	"addSrxFirewall":                    true,
+	"addStratosphereSsp":                true,
+	"addUcsManager":                     true,
+	"addVmwareDc":                       true,
+	"addVpnUser":                        true,
+	"changeOutOfBandManagementPassword": true,
+	"configureOutOfBandManagement":      true,
+	"createAccount":                     true,
+	"createKubernetesCluster":           true,
+	"createUser":                        true,
+	"createVpnCustomerGateway":          true,
+	"deployVirtualMachine":              true,
+	"login":                             true,
+	"registerNetscalerControlCenter":    true,
+	"updateHostPassword":                true,
+	"updateProjectInvitation":           true,
+	"updateUser":                        true,
+	"updateVirtualMachine":              true,
+	"updateVmwareDc":                    true,
+	"updateVpnCustomerGateway":          true,
+	"uploadCustomCertificate":           true,
+	"uploadSslCert":                     true,
+}
+
+// Sends the encoded query to CloudStack and returns the raw JSON data returned by the API
+func (cs *CloudStackClient) sendRequest(ctx context.Context, api string, query string) (json.RawMessage, error) {
+	method := cs.httpMethod(api, query)
+	recordRequestMethod(ctx, method)
+
+	var req *http.Request
+	var err error
+	if method == http.MethodPost {
 		// Make a POST call
-		resp, err = cs.client.PostForm(cs.baseURL, params)
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, cs.baseURL, strings.NewReader(query))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	} else {
 		// Create the final URL before we issue the request
-		url := cs.baseURL + "?" + s + "&signature=" + url.QueryEscape(signature)
+		url := cs.baseURL + "?" + query
 
 		// Make a GET call
-		resp, err = cs.client.Get(url)
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if err != nil {
-		return nil, err
+
+	// Identify the request, so it can be correlated with the logs of proxies or load balancers
+	requestID := newRequestID()
+	req.Header.Set("X-Request-Id", requestID)
+
+	// Wait until the rate limiter allows another request
+	if cs.limiter != nil {
+		if err := cs.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
 	}
-	defer resp.Body.Close()
 
-	b, err := ioutil.ReadAll(resp.Body)
+	resp, err := cs.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	// Need to get the raw value to make the result play nice
-	b, err = getRawValue(b)
+	b, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
 
 	if resp.StatusCode != 200 {
-		var e CSError
-		if err := json.Unmarshal(b, &e); err != nil {
-			return nil, err
+		e := &CSError{StatusCode: resp.StatusCode, Command: api, RequestID: requestID}
+
+		// Prefer the request ID of a proxy or load balancer that replaced ours
+		if id := resp.Header.Get("X-Request-Id"); id != "" {
+			e.RequestID = id
+		}
+
+		// Not every failing response is a CloudStack error (e.g. when a proxy is
+		// in between), so fall back to using the body as the error text
+		if raw, err := getRawValue(b); err != nil || json.Unmarshal(raw, e) != nil {
+			e.ErrorText = strings.TrimSpace(string(b))
 		}
-		return nil, e.Error()
+
+		// Stop sending requests until the API throttling quota is reset
+		if cs.limiter != nil && (e.StatusCode == 429 || e.ErrorCode == 429) {
+			cs.limiter.throttle()
+		}
+		return nil, e
 	}
-	return b, nil
+
+	// Need to get the raw value to make the result play nice
+	return getRawValue(b)
+}
+
+// Returns a random (version 4) UUID that identifies a request
+func newRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return ""
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
 }
 
 // Custom version of net/url Encode that only URL escapes values
@@ -563,6 +915,100 @@ func WithZone(zone string) OptionFunc {
 	}
 }
 
+// CounterParam is a name/value pair used to configure the counters of an autoscale VM profile
+type CounterParam struct {
+	Name  string
+	Value string
+}
+
+// DataDiskTemplateToOffering maps a datadisk template to the disk offering used to create the data disk
+type DataDiskTemplateToOffering struct {
+	DataDiskTemplateID string
+	DiskOfferingID     string
+}
+
+// DiskToDiskOffering maps a disk of an unmanaged instance to the disk offering used for the imported volume
+type DiskToDiskOffering struct {
+	Disk         string
+	DiskOffering string
+}
+
+// GSLBLBRuleWeight sets the weight (between 1-100) of a load balancer rule in a GSLB rule
+type GSLBLBRuleWeight struct {
+	LoadBalancerID string
+	Weight         int
+}
+
+// IPToNetwork requests a specific IP address, IPv6 address and/or MAC address for the NIC in a network
+type IPToNetwork struct {
+	NetworkID string
+	IP        string
+	IPv6      string
+	MAC       string
+}
+
+// ImportRoleRule is a rule of a role that is imported, where the rule and the permission are required
+type ImportRoleRule struct {
+	Rule        string
+	Permission  string
+	Description string
+}
+
+// NICIPAddress maps a NIC of an unmanaged instance to the IPv4 address it should get
+type NICIPAddress struct {
+	NIC        string
+	IP4Address string
+}
+
+// NICNetwork maps a NIC of a VM to the network it should be connected to
+type NICNetwork struct {
+	NIC     string
+	Network string
+}
+
+// ServiceCapability sets a capability of a service that is offered by a network or VPC offering
+type ServiceCapability struct {
+	Service         string
+	CapabilityType  string
+	CapabilityValue string
+}
+
+// ServiceProvider maps a service that is offered by a network or VPC offering to the provider of the service
+type ServiceProvider struct {
+	Service  string
+	Provider string
+}
+
+// StickinessPolicyParam is a name/value pair used to configure a load balancer stickiness policy
+type StickinessPolicyParam struct {
+	Name  string
+	Value string
+}
+
+// TierNetworkOffering maps a network tier of a VPC to the network offering it should use
+type TierNetworkOffering struct {
+	NetworkID         string
+	NetworkOfferingID string
+}
+
+// UserSecurityGroup is a security group of an account that is allowed access by a security group rule
+type UserSecurityGroup struct {
+	Account string
+	Group   string
+}
+
+// VMIDIP maps a VM to one of its IP addresses, used when assigning a specific VM IP to a load balancer rule
+type VMIDIP struct {
+	VMID string
+	VMIP string
+}
+
+// VolumeToPool maps a volume to the storage pool it should be migrated to
+type VolumeToPool struct {
+	Volume string
+	Pool   string
+}
+
 type APIDiscoveryService struct {
 	cs *CloudStackClient
 }
@@ -603,6 +1049,14 @@ func NewAlertService(cs *CloudStackClient) *AlertService {
 	return &AlertService{cs: cs}
 }
 
+type AnnotationService struct {
+	cs *CloudStackClient
+}
+
+func NewAnnotationService(cs *CloudStackClient) *AnnotationService {
+	return &AnnotationService{cs: cs}
+}
+
 type AsyncjobService struct {
 	cs *CloudStackClient
 }
@@ -627,6 +1081,14 @@ func NewAutoScaleService(cs *CloudStackClient) *AutoScaleService {
 	return &AutoScaleService{cs: cs}
 }
 
+type BackupService struct {
+	cs *CloudStackClient
+}
+
+func NewBackupService(cs *CloudStackClient) *BackupService {
+	return &BackupService{cs: cs}
+}
+
 type BaremetalService struct {
 	cs *CloudStackClient
 }
@@ -691,6 +1153,14 @@ func NewCustomService(cs *CloudStackClient) *CustomService {
 	return &CustomService{cs: cs}
 }
 
+type DiagnosticsService struct {
+	cs *CloudStackClient
+}
+
+func NewDiagnosticsService(cs *CloudStackClient) *DiagnosticsService {
+	return &DiagnosticsService{cs: cs}
+}
+
 type DiskOfferingService struct {
 	cs *CloudStackClient
 }
@@ -771,6 +1241,14 @@ func NewInternalLBService(cs *CloudStackClient) *InternalLBService {
 	return &InternalLBService{cs: cs}
 }
 
+type KubernetesService struct {
+	cs *CloudStackClient
+}
+
+func NewKubernetesService(cs *CloudStackClient) *KubernetesService {
+	return &KubernetesService{cs: cs}
+}
+
 type LDAPService struct {
 	cs *CloudStackClient
 }
@@ -795,6 +1273,14 @@ func NewLoadBalancerService(cs *CloudStackClient) *LoadBalancerService {
 	return &LoadBalancerService{cs: cs}
 }
 
+type ManagementService struct {
+	cs *CloudStackClient
+}
+
+func NewManagementService(cs *CloudStackClient) *ManagementService {
+	return &ManagementService{cs: cs}
+}
+
 type NATService struct {
 	cs *CloudStackClient
 }
@@ -803,6 +1289,14 @@ func NewNATService(cs *CloudStackClient) *NATService {
 	return &NATService{cs: cs}
 }
 
+type NetscalerService struct {
+	cs *CloudStackClient
+}
+
+func NewNetscalerService(cs *CloudStackClient) *NetscalerService {
+	return &NetscalerService{cs: cs}
+}
+
 type NetworkACLService struct {
 	cs *CloudStackClient
 }
@@ -971,6 +1465,14 @@ func NewServiceOfferingService(cs *CloudStackClient) *ServiceOfferingService {
 	return &ServiceOfferingService{cs: cs}
 }
 
+type SimulatorService struct {
+	cs *CloudStackClient
+}
+
+func NewSimulatorService(cs *CloudStackClient) *SimulatorService {
+	return &SimulatorService{cs: cs}
+}
+
 type SnapshotService struct {
 	cs *CloudStackClient
 }
@@ -1067,6 +1569,14 @@ func NewVMGroupService(cs *CloudStackClient) *VMGroupService {
 	return &VMGroupService{cs: cs}
 }
 
+type VMWareService struct {
+	cs *CloudStackClient
+}
+
+func NewVMWareService(cs *CloudStackClient) *VMWareService {
+	return &VMWareService{cs: cs}
+}
+
 type VPCService struct {
 	cs *CloudStackClient
 }
@@ -0,0 +1,440 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstacktest
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// ErrUnmatchedRequest is wrapped by the error returned by a Replayer for a request
+// without a recorded response
+var ErrUnmatchedRequest = errors.New("No recorded response")
+
+// The params that are removed before a request is recorded or matched, as they
+// differ between runs or contain credentials
+var strippedParams = map[string]bool{
+	"apikey":           true,
+	"expires":          true,
+	"sessionkey":       true,
+	"signature":        true,
+	"signatureversion": true,
+}
+
+// RecorderOption can be passed to NewRecorder and NewReplayer to set custom options
+type RecorderOption func(stripped map[string]bool)
+
+// WithStrippedParams adds params that are removed before a request is recorded or
+// matched, next to the credentials, signature and expiry timestamp. Use the same
+// params for the Recorder and the Replayer of the same golden files.
+func WithStrippedParams(names ...string) RecorderOption {
+	return func(stripped map[string]bool) {
+		for _, name := range names {
+			stripped[strings.ToLower(name)] = true
+		}
+	}
+}
+
+// Returns the stripped params, including the ones added by the options
+func newStrippedParams(opts []RecorderOption) map[string]bool {
+	stripped := make(map[string]bool, len(strippedParams))
+	for k := range strippedParams {
+		stripped[k] = true
+	}
+	for _, fn := range opts {
+		fn(stripped)
+	}
+	return stripped
+}
+
+// recording is the content of a golden file, containing all responses recorded
+// for a command with the same normalized params
+type recording struct {
+	Command      string         `json:"command"`
+	Params       url.Values     `json:"params"`
+	Interactions []*interaction `json:"interactions"`
+}
+
+// interaction is a single recorded response. The body is stored as JSON if it is
+// valid JSON, and as text otherwise.
+type interaction struct {
+	Method      string          `json:"method"`
+	StatusCode  int             `json:"statuscode"`
+	ContentType string          `json:"contenttype,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"`
+	Text        string          `json:"text,omitempty"`
+}
+
+// Recorder is an http.RoundTripper that records all requests and responses in golden
+// files, which can be replayed by a Replayer. It can be used with a client by passing
+// it to cloudstack.WithHTTPClient:
+//
+//	rec := cloudstacktest.NewRecorder("testdata", nil)
+//	cs := cloudstack.NewClient(apiurl, apikey, secret, true, cloudstack.WithHTTPClient(&http.Client{Transport: rec}))
+//
+// Every golden file is named after the command and a hash of the normalized params of
+// the request, and contains the responses of all requests with the same key in the
+// order they were received. Before a request is recorded, its API key, signature,
+// session key and expiry timestamp are removed, and the values of params and response
+// fields ending with one of cloudstack.DefaultRedactedFields (or secretkey) are
+// redacted. A golden file is overwritten the first time its key is recorded by a Recorder.
+//
+// Params that differ between runs, like the startdate and enddate of listUsageRecords
+// and listEvents when they are derived from the current time, can be removed as well
+// using WithStrippedParams("startdate", "enddate"), so the recordings still match.
+type Recorder struct {
+	dir       string
+	transport http.RoundTripper
+	stripped  map[string]bool
+
+	mu         sync.Mutex
+	recordings map[string]*recording
+}
+
+// NewRecorder returns a recorder that sends requests using transport, and stores the
+// golden files in dir. If transport is nil, http.DefaultTransport is used.
+func NewRecorder(dir string, transport http.RoundTripper, opts ...RecorderOption) *Recorder {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &Recorder{
+		dir:        dir,
+		transport:  transport,
+		stripped:   newStrippedParams(opts),
+		recordings: make(map[string]*recording),
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := req.Clone(req.Context())
+	if body != nil {
+		out.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := r.transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+	command, params := normalize(req, body, r.stripped)
+	i := &interaction{
+		Method:      req.Method,
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+	}
+	if json.Valid(b) {
+		i.Body = redactBody(b)
+	} else {
+		i.Text = string(b)
+	}
+
+	if err := r.record(command, params, i); err != nil {
+		return nil, fmt.Errorf("Unable to record the response of %s: %w", command, err)
+	}
+
+	return resp, nil
+}
+
+// Adds the interaction to the recording of the request, and writes the golden file
+func (r *Recorder) record(command string, params url.Values, i *interaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file := goldenFile(r.dir, command, params)
+	rec, ok := r.recordings[file]
+	if !ok {
+		rec = &recording{Command: command, Params: params}
+		r.recordings[file] = rec
+	}
+	rec.Interactions = append(rec.Interactions, i)
+
+	b, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, append(b, '\n'), 0644)
+}
+
+// Replayer is an http.RoundTripper that replays the responses recorded by a Recorder,
+// without sending any requests. It can be used with a client by passing it to
+// cloudstack.WithHTTPClient:
+//
+//	cs := cloudstack.NewClient(apiurl, apikey, secret, true, cloudstack.WithHTTPClient(&http.Client{Transport: cloudstacktest.NewReplayer("testdata")}))
+//
+// A request is matched with the golden file of its command and normalized params, so
+// the URL, credentials and signature version used to replay don't need to match the
+// ones used to record. The recorded responses of a golden file are replayed in order,
+// after which the last response is repeated (for example when polling an async job
+// more often than when it was recorded). A request without a golden file fails with
+// an error wrapping ErrUnmatchedRequest.
+type Replayer struct {
+	dir      string
+	stripped map[string]bool
+
+	mu         sync.Mutex
+	recordings map[string]*recording
+	replayed   map[string]int
+}
+
+// NewReplayer returns a replayer that replays the golden files stored in dir. Pass the
+// same options as used to record the golden files.
+func NewReplayer(dir string, opts ...RecorderOption) *Replayer {
+	return &Replayer{
+		dir:        dir,
+		stripped:   newStrippedParams(opts),
+		recordings: make(map[string]*recording),
+		replayed:   make(map[string]int),
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	command, params := normalize(req, body, r.stripped)
+
+	i, err := r.next(command, params)
+	if err != nil {
+		return nil, err
+	}
+
+	b := []byte(i.Body)
+	if i.Body == nil {
+		b = []byte(i.Text)
+	}
+
+	header := make(http.Header)
+	if i.ContentType != "" {
+		header.Set("Content-Type", i.ContentType)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.StatusCode, http.StatusText(i.StatusCode)),
+		StatusCode:    i.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(b)),
+		ContentLength: int64(len(b)),
+		Request:       req,
+	}, nil
+}
+
+// Returns the next recorded response of the request
+func (r *Replayer) next(command string, params url.Values) (*interaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file := goldenFile(r.dir, command, params)
+	rec, ok := r.recordings[file]
+	if !ok {
+		b, err := ioutil.ReadFile(file)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w for %s with params %q (expected golden file %s)",
+				ErrUnmatchedRequest, command, params.Encode(), file)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		rec = &recording{}
+		if err := json.Unmarshal(b, rec); err != nil {
+			return nil, fmt.Errorf("Unable to read golden file %s: %w", file, err)
+		}
+		if len(rec.Interactions) == 0 {
+			return nil, fmt.Errorf("%w for %s in golden file %s", ErrUnmatchedRequest, command, file)
+		}
+		r.recordings[file] = rec
+	}
+
+	n := r.replayed[file]
+	r.replayed[file]++
+
+	if n >= len(rec.Interactions) {
+		n = len(rec.Interactions) - 1
+	}
+	return rec.Interactions[n], nil
+}
+
+// Reads and closes the body of the request, if any
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+
+	return ioutil.ReadAll(req.Body)
+}
+
+// Returns the command and the normalized params of the request. The params are taken
+// from both the URL and the body, the stripped params are removed and the values of
+// redacted params (including the values of map param items with a redacted key) are
+// replaced by cloudstack.RedactedValue. Param names are lowercased,
+// as CloudStack handles them case-insensitive.
+func normalize(req *http.Request, body []byte, stripped map[string]bool) (string, url.Values) {
+	query := req.URL.RawQuery
+	if len(body) > 0 {
+		if query != "" {
+			query += "&"
+		}
+		query += string(body)
+	}
+	values, _ := url.ParseQuery(query)
+
+	command := ""
+	params := make(url.Values)
+	for k, v := range values {
+		redacted := isRedacted(k) || isRedactedItem(k, values)
+
+		k = strings.ToLower(k)
+		switch {
+		case k == "command":
+			command = v[0]
+		case stripped[k]:
+		case redacted:
+			params[k] = []string{cloudstack.RedactedValue}
+		default:
+			params[k] = append(params[k], v...)
+		}
+	}
+
+	return command, params
+}
+
+// Returns the path of the golden file of a request, which is named after the command
+// and a hash of the normalized params
+func goldenFile(dir string, command string, params url.Values) string {
+	sum := sha1.Sum([]byte(params.Encode()))
+	return filepath.Join(dir, fmt.Sprintf("%s-%s.json", command, hex.EncodeToString(sum[:6])))
+}
+
+// The suffixes of the param and response field names that are redacted, which are the
+// default redacted fields of the logger and the secret key of an account
+var redactedFields = append([]string{"secretkey"}, cloudstack.DefaultRedactedFields...)
+
+// Returns true if the param or response field must be redacted. Unlike the logger, which
+// redacts every field containing one of the redacted fields, a field is only redacted if its
+// name ends with one of them, so fields like userdataid and userdataname are recorded as is.
+func isRedacted(name string) bool {
+	name = strings.ToLower(name)
+	for _, f := range redactedFields {
+		if strings.HasSuffix(name, strings.ToLower(f)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the param is the value of an item of a map param, like details[0].value,
+// of which the key (details[0].key or details[0].name) is redacted
+func isRedactedItem(param string, values url.Values) bool {
+	if !strings.HasSuffix(strings.ToLower(param), ".value") {
+		return false
+	}
+
+	prefix := param[:len(param)-len(".value")]
+	for _, sibling := range []string{prefix + ".key", prefix + ".name"} {
+		if v := values.Get(sibling); v != "" && isRedacted(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the JSON body with the values of all redacted fields replaced. The body is
+// only re-encoded when something is redacted, so the recorded body stays as close to
+// the original as possible.
+func redactBody(b []byte) json.RawMessage {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil || !redactValue(v) {
+		return b
+	}
+
+	redacted, err := json.Marshal(v)
+	if err != nil {
+		return b
+	}
+	return redacted
+}
+
+// Redacts the string fields of v in place, and returns true if any field was redacted
+func redactValue(v interface{}) bool {
+	redacted := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		// A key/value object, like the items of a details list, is redacted based on its key
+		name, _ := v["key"].(string)
+		if name == "" {
+			name, _ = v["name"].(string)
+		}
+		for k, vv := range v {
+			// Only strings are redacted, so the body can still be decoded
+			if _, ok := vv.(string); ok && (isRedacted(k) || (k == "value" && name != "" && isRedacted(name))) {
+				v[k] = cloudstack.RedactedValue
+				redacted = true
+			} else if redactValue(vv) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, vv := range v {
+			if redactValue(vv) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,223 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstacktest
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+func TestIsRedacted(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "password", want: true},
+		{name: "newPassword", want: true},
+		{name: "details[0].password", want: true},
+		{name: "apikey", want: true},
+		{name: "secretkey", want: true},
+		{name: "privatekey", want: true},
+		{name: "ipsecpsk", want: true},
+		{name: "userdata", want: true},
+		{name: "userdataid", want: false},
+		{name: "userdataname", want: false},
+		{name: "userdatapolicy", want: false},
+		{name: "passwordenabled", want: false},
+		{name: "name", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := isRedacted(tt.name); got != tt.want {
+			t.Errorf("isRedacted(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		query       string
+		body        string
+		opts        []RecorderOption
+		wantCommand string
+		wantParams  url.Values
+	}{
+		{
+			name:        "GET",
+			method:      http.MethodGet,
+			query:       "command=listZones&response=json&apiKey=key&signature=sig&Name=zone1",
+			wantCommand: "listZones",
+			wantParams:  url.Values{"response": {"json"}, "name": {"zone1"}},
+		},
+		{
+			name:        "POST",
+			method:      http.MethodPost,
+			body:        "command=deployVirtualMachine&zoneid=1&userdata=c2VjcmV0&userdataid=2&sessionkey=key",
+			wantCommand: "deployVirtualMachine",
+			wantParams:  url.Values{"zoneid": {"1"}, "userdata": {cloudstack.RedactedValue}, "userdataid": {"2"}},
+		},
+		{
+			name:        "query and body",
+			method:      http.MethodPost,
+			query:       "command=login&response=json",
+			body:        "username=admin&password=secret",
+			wantCommand: "login",
+			wantParams:  url.Values{"response": {"json"}, "username": {"admin"}, "password": {cloudstack.RedactedValue}},
+		},
+		{
+			name:        "version 3 signature",
+			method:      http.MethodGet,
+			query:       "command=listZones&signatureVersion=3&expires=2024-01-01T00%3A00%3A00%2B0000",
+			wantCommand: "listZones",
+			wantParams:  url.Values{},
+		},
+		{
+			name:        "details",
+			method:      http.MethodGet,
+			query:       "command=updateVirtualMachine&details%5B0%5D.key=vm.password&details%5B0%5D.value=secret&details%5B1%5D.key=cpu&details%5B1%5D.value=2",
+			wantCommand: "updateVirtualMachine",
+			wantParams: url.Values{
+				"details[0].key":   {"vm.password"},
+				"details[0].value": {cloudstack.RedactedValue},
+				"details[1].key":   {"cpu"},
+				"details[1].value": {"2"},
+			},
+		},
+		{
+			name:        "stripped params",
+			method:      http.MethodGet,
+			query:       "command=listEvents&startDate=2024-01-01&enddate=2024-01-02&type=VM.CREATE&apiKey=key",
+			opts:        []RecorderOption{WithStrippedParams("StartDate", "endDate")},
+			wantCommand: "listEvents",
+			wantParams:  url.Values{"type": {"VM.CREATE"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, "http://localhost/client/api?"+tt.query, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			command, params := normalize(req, []byte(tt.body), newStrippedParams(tt.opts))
+			if command != tt.wantCommand {
+				t.Errorf("Expected command %s, got %s", tt.wantCommand, command)
+			}
+			if !reflect.DeepEqual(params, tt.wantParams) {
+				t.Errorf("Expected params %v, got %v", tt.wantParams, params)
+			}
+		})
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "nothing redacted",
+			body: `{"zone": {"name": "zone1",  "id": 1}}`,
+			want: `{"zone": {"name": "zone1",  "id": 1}}`,
+		},
+		{
+			name: "nested fields",
+			body: `{"user":[{"username":"admin","apikey":"key","secretkey":"secret"}]}`,
+			want: `{"user":[{"apikey":"REDACTED","secretkey":"REDACTED","username":"admin"}]}`,
+		},
+		{
+			name: "only strings are redacted",
+			body: `{"vm":{"password":"secret","passwordenabled":true,"userdataid":"1","userdataname":"init"}}`,
+			want: `{"vm":{"password":"REDACTED","passwordenabled":true,"userdataid":"1","userdataname":"init"}}`,
+		},
+		{
+			name: "key/value items",
+			body: `{"details":[{"key":"vm.password","value":"secret"},{"name":"cpu","value":"2"}]}`,
+			want: `{"details":[{"key":"vm.password","value":"REDACTED"},{"name":"cpu","value":"2"}]}`,
+		},
+		{
+			name: "numbers are kept",
+			body: `{"count":12345678901234567890,"token":"abc"}`,
+			want: `{"count":12345678901234567890,"token":"REDACTED"}`,
+		},
+		{
+			name: "invalid JSON",
+			body: `{"password":`,
+			want: `{"password":`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(redactBody([]byte(tt.body))); got != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cloudstacktest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := NewServer()
+	z := s.AddZone("zone1")
+
+	rec := NewRecorder(dir, nil)
+	cs := s.NewClient(cloudstack.WithHTTPClient(&http.Client{Transport: rec}))
+	if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	s.Close()
+
+	// Replay using different credentials, as they are not part of the recording
+	replayer := NewReplayer(dir)
+	cs = cloudstack.NewAsyncClient("http://localhost/client/api", "other", "other", false,
+		cloudstack.WithHTTPClient(&http.Client{Transport: replayer}))
+
+	l, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if l.Count != 1 || l.Zones[0].Id != z.Id {
+		t.Errorf("Expected the recorded zone %s, got %+v", z.Id, l.Zones)
+	}
+
+	p := cs.Zone.NewListZonesParams()
+	p.SetName("zone2")
+	if _, err := cs.Zone.ListZones(p); !errors.Is(err, ErrUnmatchedRequest) {
+		t.Errorf("Expected an ErrUnmatchedRequest for a request that is not recorded, got %v", err)
+	}
+}
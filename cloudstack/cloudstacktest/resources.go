@@ -0,0 +1,853 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstacktest
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// The account and domain that own all resources
+const (
+	account  = "admin"
+	domain   = "ROOT"
+	domainID = "f7b7a3d2-5b5d-4a3e-9f61-3c1b8e0d2a10"
+)
+
+const gigabyte = 1024 * 1024 * 1024
+
+// AddZone adds an advanced zone and returns a copy of it
+func (s *Server) AddZone(name string) *cloudstack.Zone {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	z := &cloudstack.Zone{
+		Allocationstate: "Enabled",
+		Id:              newID(),
+		Name:            name,
+		Networktype:     "Advanced",
+	}
+	s.zones = append(s.zones, z)
+
+	c := *z
+	return &c
+}
+
+// AddServiceOffering adds a service offering with the given number of CPUs and memory in MB,
+// and returns a copy of it
+func (s *Server) AddServiceOffering(name string, cpunumber int, memory int) *cloudstack.ServiceOffering {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := &cloudstack.ServiceOffering{
+		Cpunumber:   cpunumber,
+		Cpuspeed:    1000,
+		Created:     now(),
+		Displaytext: name,
+		Id:          newID(),
+		Memory:      memory,
+		Name:        name,
+		Storagetype: "shared",
+	}
+	s.serviceOfferings = append(s.serviceOfferings, o)
+
+	c := *o
+	return &c
+}
+
+// AddDiskOffering adds a disk offering with the given disk size in GB, and returns a copy of it.
+// An offering with a disk size of 0 is a custom offering, which requires the size to be set when
+// creating a volume.
+func (s *Server) AddDiskOffering(name string, disksize int64) *cloudstack.DiskOffering {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := &cloudstack.DiskOffering{
+		Created:         now(),
+		Disksize:        disksize,
+		Displayoffering: true,
+		Displaytext:     name,
+		Id:              newID(),
+		Iscustomized:    disksize == 0,
+		Name:            name,
+		Storagetype:     "shared",
+	}
+	s.diskOfferings = append(s.diskOfferings, o)
+
+	c := *o
+	return &c
+}
+
+// AddNetworkOffering adds an enabled network offering for isolated guest networks, and returns
+// a copy of it
+func (s *Server) AddNetworkOffering(name string) *cloudstack.NetworkOffering {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := &cloudstack.NetworkOffering{
+		Availability: "Optional",
+		Created:      now(),
+		Displaytext:  name,
+		Guestiptype:  "Isolated",
+		Id:           newID(),
+		Name:         name,
+		State:        "Enabled",
+		Traffictype:  "Guest",
+	}
+	s.networkOfferings = append(s.networkOfferings, o)
+
+	c := *o
+	return &c
+}
+
+// AddTemplate adds a ready to use template in the zone, and returns a copy of it
+func (s *Server) AddTemplate(name string, zoneid string) *cloudstack.Template {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := &cloudstack.Template{
+		Account:      account,
+		Bootable:     true,
+		Created:      now(),
+		Displaytext:  name,
+		Domain:       domain,
+		Domainid:     domainID,
+		Format:       "QCOW2",
+		Hypervisor:   "KVM",
+		Id:           newID(),
+		Isfeatured:   true,
+		Ispublic:     true,
+		Isready:      true,
+		Name:         name,
+		Ostypename:   "Other Linux (64-bit)",
+		Size:         10 * gigabyte,
+		Status:       "Download Complete",
+		Templatetype: "USER",
+		Zoneid:       zoneid,
+	}
+	if z := s.findZone(zoneid); z != nil {
+		t.Zonename = z.Name
+	}
+	s.templates = append(s.templates, t)
+
+	c := *t
+	return &c
+}
+
+func (s *Server) findZone(id string) *cloudstack.Zone {
+	for _, z := range s.zones {
+		if z.Id == id {
+			return z
+		}
+	}
+	return nil
+}
+
+func (s *Server) zone(params url.Values) (*cloudstack.Zone, *apiError) {
+	if z := s.findZone(params.Get("zoneid")); z != nil {
+		return z, nil
+	}
+	return nil, errorf(431, "Unable to find zone with id %s", params.Get("zoneid"))
+}
+
+func (s *Server) serviceOffering(id string) (*cloudstack.ServiceOffering, *apiError) {
+	for _, o := range s.serviceOfferings {
+		if o.Id == id {
+			return o, nil
+		}
+	}
+	return nil, errorf(431, "Unable to find service offering with id %s", id)
+}
+
+func (s *Server) diskOffering(id string) (*cloudstack.DiskOffering, *apiError) {
+	for _, o := range s.diskOfferings {
+		if o.Id == id {
+			return o, nil
+		}
+	}
+	return nil, errorf(431, "Unable to find disk offering with id %s", id)
+}
+
+func (s *Server) networkOffering(id string) (*cloudstack.NetworkOffering, *apiError) {
+	for _, o := range s.networkOfferings {
+		if o.Id == id {
+			return o, nil
+		}
+	}
+	return nil, errorf(431, "Unable to find network offering with id %s", id)
+}
+
+func (s *Server) template(id string) (*cloudstack.Template, *apiError) {
+	for _, t := range s.templates {
+		if t.Id == id {
+			return t, nil
+		}
+	}
+	return nil, errorf(431, "Unable to find template with id %s", id)
+}
+
+func (s *Server) vm(id string) (*cloudstack.VirtualMachine, *apiError) {
+	for _, vm := range s.vms {
+		if vm.Id == id {
+			return vm, nil
+		}
+	}
+	return nil, errorf(431, "Unable to find virtual machine with id %s", id)
+}
+
+func (s *Server) volume(id string) (*cloudstack.Volume, *apiError) {
+	for _, v := range s.volumes {
+		if v.Id == id {
+			return v, nil
+		}
+	}
+	return nil, errorf(431, "Unable to find volume with id %s", id)
+}
+
+func (s *Server) network(id string) (*cloudstack.Network, *apiError) {
+	for _, n := range s.networks {
+		if n.Id == id {
+			return n, nil
+		}
+	}
+	return nil, errorf(431, "Unable to find network with id %s", id)
+}
+
+func (s *Server) ip(id string) (*cloudstack.PublicIpAddress, *apiError) {
+	for _, ip := range s.ips {
+		if ip.Id == id {
+			return ip, nil
+		}
+	}
+	return nil, errorf(431, "Unable to find ip address with id %s", id)
+}
+
+// Returns the next value of the counter
+func (s *Server) next() int {
+	s.counter++
+	return s.counter
+}
+
+func (s *Server) listZones(params url.Values) (interface{}, *apiError) {
+	return listResponse("zone", s.zones, params)
+}
+
+func (s *Server) listServiceOfferings(params url.Values) (interface{}, *apiError) {
+	return listResponse("serviceoffering", s.serviceOfferings, params)
+}
+
+func (s *Server) listDiskOfferings(params url.Values) (interface{}, *apiError) {
+	return listResponse("diskoffering", s.diskOfferings, params)
+}
+
+func (s *Server) listNetworkOfferings(params url.Values) (interface{}, *apiError) {
+	return listResponse("networkoffering", s.networkOfferings, params)
+}
+
+func (s *Server) listTemplates(params url.Values) (interface{}, *apiError) {
+	if err := required(params, "templatefilter"); err != nil {
+		return nil, err
+	}
+	return listResponse("template", s.templates, params)
+}
+
+func (s *Server) listVirtualMachines(params url.Values) (interface{}, *apiError) {
+	vms := s.vms
+
+	// The network of a virtual machine is part of its NICs
+	if networkid := params.Get("networkid"); networkid != "" {
+		vms = nil
+		for _, vm := range s.vms {
+			for _, nic := range vm.Nic {
+				if nic.Networkid == networkid {
+					vms = append(vms, vm)
+					break
+				}
+			}
+		}
+	}
+
+	return listResponse("virtualmachine", vms, params)
+}
+
+func (s *Server) deployVirtualMachine(params url.Values) (interface{}, *apiError) {
+	if err := required(params, "serviceofferingid", "templateid", "zoneid"); err != nil {
+		return nil, err
+	}
+
+	z, err := s.zone(params)
+	if err != nil {
+		return nil, err
+	}
+	so, err := s.serviceOffering(params.Get("serviceofferingid"))
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.template(params.Get("templateid"))
+	if err != nil {
+		return nil, err
+	}
+
+	var networks []*cloudstack.Network
+	if ids := params.Get("networkids"); ids != "" {
+		for _, id := range strings.Split(ids, ",") {
+			n, err := s.network(id)
+			if err != nil {
+				return nil, err
+			}
+			networks = append(networks, n)
+		}
+	}
+
+	var do *cloudstack.DiskOffering
+	if id := params.Get("diskofferingid"); id != "" {
+		if do, err = s.diskOffering(id); err != nil {
+			return nil, err
+		}
+	}
+
+	n := s.next()
+	vm := &cloudstack.VirtualMachine{
+		Account:             account,
+		Cpunumber:           so.Cpunumber,
+		Cpuspeed:            so.Cpuspeed,
+		Created:             now(),
+		Displayvm:           true,
+		Domain:              domain,
+		Domainid:            domainID,
+		Group:               params.Get("group"),
+		Hypervisor:          t.Hypervisor,
+		Id:                  newID(),
+		Instancename:        fmt.Sprintf("i-2-%d-VM", n),
+		Keypair:             params.Get("keypair"),
+		Memory:              so.Memory,
+		Name:                params.Get("name"),
+		Passwordenabled:     t.Passwordenabled,
+		Serviceofferingid:   so.Id,
+		Serviceofferingname: so.Name,
+		State:               "Starting",
+		Templatedisplaytext: t.Displaytext,
+		Templateid:          t.Id,
+		Templatename:        t.Name,
+		Zoneid:              z.Id,
+		Zonename:            z.Name,
+	}
+	if vm.Name == "" {
+		vm.Name = vm.Id
+	}
+	vm.Displayname = params.Get("displayname")
+	if vm.Displayname == "" {
+		vm.Displayname = vm.Name
+	}
+
+	for i, network := range networks {
+		vm.Nic = append(vm.Nic, s.newNic(vm, network, i == 0))
+	}
+	s.vms = append(s.vms, vm)
+
+	// Create the root disk and the optional data disk
+	size := t.Size
+	if v, _ := strconv.ParseInt(params.Get("rootdisksize"), 10, 64); v > 0 {
+		size = v * gigabyte
+	}
+	root := s.newVolume("ROOT-"+strconv.Itoa(n), "ROOT", size, z)
+	root.Templateid = t.Id
+	root.Templatename = t.Name
+	attach(root, vm, 0)
+
+	if do != nil {
+		size := do.Disksize
+		if v, _ := strconv.ParseInt(params.Get("size"), 10, 64); v > 0 {
+			size = v
+		}
+		data := s.newVolume("DATA-"+strconv.Itoa(n), "DATADISK", size*gigabyte, z)
+		data.Diskofferingid = do.Id
+		data.Diskofferingname = do.Name
+		attach(data, vm, 1)
+	}
+
+	state := "Running"
+	if params.Get("startvm") == "false" {
+		state = "Stopped"
+	}
+
+	return s.startJob(params, "VirtualMachine", vm.Id, func() (interface{}, *apiError) {
+		vm.State = state
+		for _, nic := range vm.Nic {
+			if network, err := s.network(nic.Networkid); err == nil {
+				network.State = "Implemented"
+			}
+		}
+		return map[string]interface{}{"virtualmachine": vm}, nil
+	}, func() {
+		vm.State = "Error"
+	}), nil
+}
+
+// Returns a new NIC of the virtual machine in the network
+func (s *Server) newNic(vm *cloudstack.VirtualMachine, network *cloudstack.Network, isdefault bool) cloudstack.Nic {
+	n := s.next()
+	gateway := strings.TrimSuffix(network.Gateway, ".1")
+
+	return cloudstack.Nic{
+		Deviceid:         strconv.Itoa(len(vm.Nic)),
+		Gateway:          network.Gateway,
+		Id:               newID(),
+		Ipaddress:        fmt.Sprintf("%s.%d", gateway, 2+n%250),
+		Isdefault:        isdefault,
+		Macaddress:       fmt.Sprintf("02:00:%02x:%02x:%02x:%02x", n>>24&0xff, n>>16&0xff, n>>8&0xff, n&0xff),
+		Netmask:          network.Netmask,
+		Networkid:        network.Id,
+		Networkname:      network.Name,
+		Traffictype:      "Guest",
+		Type:             network.Type,
+		Virtualmachineid: vm.Id,
+	}
+}
+
+func (s *Server) changeVMState(params url.Values, from string, transition string, to string) (interface{}, *apiError) {
+	if err := required(params, "id"); err != nil {
+		return nil, err
+	}
+
+	vm, err := s.vm(params.Get("id"))
+	if err != nil {
+		return nil, err
+	}
+	if vm.State != from {
+		return nil, errorf(431, "Virtual machine %s is in state %s, expected state %s", vm.Id, vm.State, from)
+	}
+
+	vm.State = transition
+	return s.startJob(params, "VirtualMachine", vm.Id, func() (interface{}, *apiError) {
+		vm.State = to
+		return map[string]interface{}{"virtualmachine": vm}, nil
+	}, func() {
+		vm.State = from
+	}), nil
+}
+
+func (s *Server) startVirtualMachine(params url.Values) (interface{}, *apiError) {
+	return s.changeVMState(params, "Stopped", "Starting", "Running")
+}
+
+func (s *Server) stopVirtualMachine(params url.Values) (interface{}, *apiError) {
+	return s.changeVMState(params, "Running", "Stopping", "Stopped")
+}
+
+func (s *Server) rebootVirtualMachine(params url.Values) (interface{}, *apiError) {
+	return s.changeVMState(params, "Running", "Running", "Running")
+}
+
+func (s *Server) destroyVirtualMachine(params url.Values) (interface{}, *apiError) {
+	if err := required(params, "id"); err != nil {
+		return nil, err
+	}
+
+	vm, err := s.vm(params.Get("id"))
+	if err != nil {
+		return nil, err
+	}
+	if vm.State == "Destroyed" || vm.State == "Expunging" {
+		return nil, errorf(431, "Virtual machine %s is already destroyed", vm.Id)
+	}
+
+	from := vm.State
+	expunge := params.Get("expunge") == "true"
+
+	vm.State = "Stopping"
+	return s.startJob(params, "VirtualMachine", vm.Id, func() (interface{}, *apiError) {
+		vm.State = "Destroyed"
+
+		// The root disk is removed together with the virtual machine, and data disks are detached
+		var volumes []*cloudstack.Volume
+		for _, v := range s.volumes {
+			switch {
+			case v.Virtualmachineid != vm.Id:
+				volumes = append(volumes, v)
+			case v.Type == "ROOT" && !expunge:
+				v.State = "Destroy"
+				volumes = append(volumes, v)
+			case v.Type != "ROOT":
+				detach(v)
+				volumes = append(volumes, v)
+			}
+		}
+		s.volumes = volumes
+
+		if expunge {
+			vm.State = "Expunging"
+			s.removeVM(vm)
+		}
+
+		return map[string]interface{}{"virtualmachine": vm}, nil
+	}, func() {
+		vm.State = from
+	}), nil
+}
+
+func (s *Server) removeVM(vm *cloudstack.VirtualMachine) {
+	for i, v := range s.vms {
+		if v == vm {
+			s.vms = append(s.vms[:i:i], s.vms[i+1:]...)
+			return
+		}
+	}
+}
+
+func (s *Server) listVolumes(params url.Values) (interface{}, *apiError) {
+	return listResponse("volume", s.volumes, params)
+}
+
+// Returns a new detached volume in the zone
+func (s *Server) newVolume(name string, typ string, size int64, z *cloudstack.Zone) *cloudstack.Volume {
+	v := &cloudstack.Volume{
+		Account:          account,
+		Created:          now(),
+		Displayvolume:    true,
+		Domain:           domain,
+		Domainid:         domainID,
+		Id:               newID(),
+		Name:             name,
+		Provisioningtype: "thin",
+		Size:             size,
+		State:            "Allocated",
+		Storagetype:      "shared",
+		Type:             typ,
+		Zoneid:           z.Id,
+		Zonename:         z.Name,
+	}
+	s.volumes = append(s.volumes, v)
+	return v
+}
+
+func attach(v *cloudstack.Volume, vm *cloudstack.VirtualMachine, deviceid int64) {
+	v.Attached = now()
+	v.Deviceid = deviceid
+	v.State = "Ready"
+	v.Virtualmachineid = vm.Id
+	v.Vmdisplayname = vm.Displayname
+	v.Vmname = vm.Name
+	v.Vmstate = vm.State
+}
+
+func detach(v *cloudstack.Volume) {
+	v.Attached = ""
+	v.Deviceid = 0
+	v.Virtualmachineid = ""
+	v.Vmdisplayname = ""
+	v.Vmname = ""
+	v.Vmstate = ""
+}
+
+// Returns the first free device ID of the virtual machine
+func (s *Server) nextDeviceID(vm *cloudstack.VirtualMachine) int64 {
+	var id int64
+	for _, v := range s.volumes {
+		if v.Virtualmachineid == vm.Id && v.Deviceid >= id {
+			id = v.Deviceid + 1
+		}
+	}
+	return id
+}
+
+func (s *Server) createVolume(params url.Values) (interface{}, *apiError) {
+	if err := required(params, "zoneid", "diskofferingid"); err != nil {
+		return nil, err
+	}
+
+	z, err := s.zone(params)
+	if err != nil {
+		return nil, err
+	}
+	do, err := s.diskOffering(params.Get("diskofferingid"))
+	if err != nil {
+		return nil, err
+	}
+
+	size := do.Disksize
+	if do.Iscustomized {
+		if size, _ = strconv.ParseInt(params.Get("size"), 10, 64); size <= 0 {
+			return nil, errorf(431, "This disk offering requires a custom size specified")
+		}
+	}
+
+	var vm *cloudstack.VirtualMachine
+	if id := params.Get("virtualmachineid"); id != "" {
+		if vm, err = s.vm(id); err != nil {
+			return nil, err
+		}
+	}
+
+	name := params.Get("name")
+	if name == "" {
+		name = "DATA-" + strconv.Itoa(s.next())
+	}
+
+	v := s.newVolume(name, "DATADISK", size*gigabyte, z)
+	v.Diskofferingid = do.Id
+	v.Diskofferingname = do.Name
+	v.State = "Allocating"
+
+	return s.startJob(params, "Volume", v.Id, func() (interface{}, *apiError) {
+		v.State = "Allocated"
+		if vm != nil {
+			attach(v, vm, s.nextDeviceID(vm))
+		}
+		return map[string]interface{}{"volume": v}, nil
+	}, func() {
+		v.State = "Error"
+	}), nil
+}
+
+func (s *Server) attachVolume(params url.Values) (interface{}, *apiError) {
+	if err := required(params, "id", "virtualmachineid"); err != nil {
+		return nil, err
+	}
+
+	v, err := s.volume(params.Get("id"))
+	if err != nil {
+		return nil, err
+	}
+	vm, err := s.vm(params.Get("virtualmachineid"))
+	if err != nil {
+		return nil, err
+	}
+	if v.Virtualmachineid != "" {
+		return nil, errorf(431, "Volume %s is already attached to virtual machine %s", v.Id, v.Virtualmachineid)
+	}
+
+	return s.startJob(params, "Volume", v.Id, func() (interface{}, *apiError) {
+		attach(v, vm, s.nextDeviceID(vm))
+		return map[string]interface{}{"volume": v}, nil
+	}, nil), nil
+}
+
+func (s *Server) detachVolume(params url.Values) (interface{}, *apiError) {
+	if err := required(params, "id"); err != nil {
+		return nil, err
+	}
+
+	v, err := s.volume(params.Get("id"))
+	if err != nil {
+		return nil, err
+	}
+	if v.Virtualmachineid == "" {
+		return nil, errorf(431, "Volume %s is not attached to a virtual machine", v.Id)
+	}
+	if v.Type == "ROOT" {
+		return nil, errorf(431, "The root volume of a virtual machine cannot be detached")
+	}
+
+	return s.startJob(params, "Volume", v.Id, func() (interface{}, *apiError) {
+		detach(v)
+		return map[string]interface{}{"volume": v}, nil
+	}, nil), nil
+}
+
+func (s *Server) deleteVolume(params url.Values) (interface{}, *apiError) {
+	if err := required(params, "id"); err != nil {
+		return nil, err
+	}
+
+	v, err := s.volume(params.Get("id"))
+	if err != nil {
+		return nil, err
+	}
+	if v.Virtualmachineid != "" {
+		return nil, errorf(431, "Please specify a volume that is not attached to any VM.")
+	}
+
+	for i, vv := range s.volumes {
+		if vv == v {
+			s.volumes = append(s.volumes[:i:i], s.volumes[i+1:]...)
+			break
+		}
+	}
+
+	return map[string]interface{}{"success": true}, nil
+}
+
+func (s *Server) listNetworks(params url.Values) (interface{}, *apiError) {
+	return listResponse("network", s.networks, params)
+}
+
+func (s *Server) createNetwork(params url.Values) (interface{}, *apiError) {
+	if err := required(params, "name", "networkofferingid", "zoneid"); err != nil {
+		return nil, err
+	}
+
+	z, err := s.zone(params)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.networkOffering(params.Get("networkofferingid"))
+	if err != nil {
+		return nil, err
+	}
+
+	n := s.next()
+	network := &cloudstack.Network{
+		Account:                    account,
+		Acltype:                    "Account",
+		Broadcastdomaintype:        "Vlan",
+		Canusefordeploy:            true,
+		Displaynetwork:             true,
+		Displaytext:                params.Get("displaytext"),
+		Domain:                     domain,
+		Domainid:                   domainID,
+		Gateway:                    params.Get("gateway"),
+		Id:                         newID(),
+		Name:                       params.Get("name"),
+		Netmask:                    params.Get("netmask"),
+		Networkofferingdisplaytext: o.Displaytext,
+		Networkofferingid:          o.Id,
+		Networkofferingname:        o.Name,
+		State:                      "Allocated",
+		Traffictype:                "Guest",
+		Type:                       o.Guestiptype,
+		Zoneid:                     z.Id,
+		Zonename:                   z.Name,
+	}
+	if network.Displaytext == "" {
+		network.Displaytext = network.Name
+	}
+	if network.Gateway == "" {
+		network.Gateway = fmt.Sprintf("10.%d.%d.1", 1+n/250%250, n%250)
+		network.Netmask = "255.255.255.0"
+	}
+	if network.Netmask == "" {
+		network.Netmask = "255.255.255.0"
+	}
+	network.Cidr = strings.TrimSuffix(network.Gateway, ".1") + ".0/24"
+	s.networks = append(s.networks, network)
+
+	return map[string]interface{}{"network": network}, nil
+}
+
+func (s *Server) deleteNetwork(params url.Values) (interface{}, *apiError) {
+	if err := required(params, "id"); err != nil {
+		return nil, err
+	}
+
+	network, err := s.network(params.Get("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vm := range s.vms {
+		for _, nic := range vm.Nic {
+			if nic.Networkid == network.Id {
+				return nil, errorf(431, "Can't delete the network, not all user vms are expunged")
+			}
+		}
+	}
+
+	return s.startJob(params, "Network", network.Id, func() (interface{}, *apiError) {
+		for i, n := range s.networks {
+			if n == network {
+				s.networks = append(s.networks[:i:i], s.networks[i+1:]...)
+				break
+			}
+		}
+		return map[string]interface{}{"success": true}, nil
+	}, nil), nil
+}
+
+func (s *Server) listPublicIpAddresses(params url.Values) (interface{}, *apiError) {
+	return listResponse("publicipaddress", s.ips, params)
+}
+
+func (s *Server) associateIpAddress(params url.Values) (interface{}, *apiError) {
+	var network *cloudstack.Network
+	var err *apiError
+	if id := params.Get("networkid"); id != "" {
+		if network, err = s.network(id); err != nil {
+			return nil, err
+		}
+		params.Set("zoneid", network.Zoneid)
+	}
+
+	if err := required(params, "zoneid"); err != nil {
+		return nil, err
+	}
+	z, err := s.zone(params)
+	if err != nil {
+		return nil, err
+	}
+
+	n := s.next()
+	ip := &cloudstack.PublicIpAddress{
+		Account:           account,
+		Allocated:         now(),
+		Domain:            domain,
+		Domainid:          domainID,
+		Forvirtualnetwork: true,
+		Id:                newID(),
+		Ipaddress:         fmt.Sprintf("100.64.%d.%d", n/250%250, 1+n%250),
+		State:             "Allocating",
+		Zoneid:            z.Id,
+		Zonename:          z.Name,
+	}
+	if network != nil {
+		ip.Associatednetworkid = network.Id
+		ip.Associatednetworkname = network.Name
+	}
+	s.ips = append(s.ips, ip)
+
+	return s.startJob(params, "IpAddress", ip.Id, func() (interface{}, *apiError) {
+		ip.State = "Allocated"
+		return map[string]interface{}{"ipaddress": ip}, nil
+	}, func() {
+		s.removeIP(ip)
+	}), nil
+}
+
+func (s *Server) disassociateIpAddress(params url.Values) (interface{}, *apiError) {
+	if err := required(params, "id"); err != nil {
+		return nil, err
+	}
+
+	ip, err := s.ip(params.Get("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	ip.State = "Releasing"
+	return s.startJob(params, "IpAddress", ip.Id, func() (interface{}, *apiError) {
+		s.removeIP(ip)
+		return map[string]interface{}{"success": true}, nil
+	}, func() {
+		ip.State = "Allocated"
+	}), nil
+}
+
+func (s *Server) removeIP(ip *cloudstack.PublicIpAddress) {
+	for i, v := range s.ips {
+		if v == ip {
+			s.ips = append(s.ips[:i:i], s.ips[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,657 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package cloudstacktest provides a fake CloudStack management server, which makes it possible to
+// test code using a CloudStackClient without a real management server.
+//
+// The server speaks the CloudStack JSON API, verifies the signature of every request and keeps an
+// in-memory state of zones, offerings, templates, virtual machines, volumes, networks and public IP
+// addresses. Async commands start an async job, which finishes after a configurable delay and can
+// be polled using queryAsyncJobResult or listAsyncJobs. Failures can be injected for both requests
+// and async jobs.
+//
+//	srv := cloudstacktest.NewServer()
+//	defer srv.Close()
+//
+//	zone := srv.AddZone("zone1")
+//	offering := srv.AddServiceOffering("small", 1, 1024)
+//	template := srv.AddTemplate("ubuntu", zone.Id)
+//
+//	cs := srv.NewClient()
+//	p := cs.VirtualMachine.NewDeployVirtualMachineParams(offering.Id, template.Id, zone.Id)
+//	vm, err := cs.VirtualMachine.DeployVirtualMachine(p)
+//
+// The package also contains a Recorder and a Replayer, which record the requests and responses of
+// a real management server in golden files and replay them without any network access.
+package cloudstacktest
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// The default credentials accepted by the server
+const (
+	DefaultAPIKey    = "cloudstacktest-api-key"
+	DefaultSecretKey = "cloudstacktest-secret-key"
+	DefaultUsername  = "admin"
+	DefaultPassword  = "password"
+)
+
+// The layout of the timestamps used by CloudStack
+const timeLayout = "2006-01-02T15:04:05-0700"
+
+// Option can be passed to NewServer to set custom options
+type Option func(*Server)
+
+// WithCredentials sets the API key and secret key the server accepts
+func WithCredentials(apiKey string, secretKey string) Option {
+	return func(s *Server) {
+		s.APIKey = apiKey
+		s.SecretKey = secretKey
+	}
+}
+
+// WithUser sets the username and password accepted by the login command
+func WithUser(username string, password string) Option {
+	return func(s *Server) {
+		s.Username = username
+		s.Password = password
+	}
+}
+
+// WithJobDelay sets the time it takes for an async job to finish; defaults to 0, in which
+// case a job finishes before the next request is handled
+func WithJobDelay(d time.Duration) Option {
+	return func(s *Server) {
+		s.jobDelay = d
+	}
+}
+
+// Server is a fake CloudStack management server. It is safe for concurrent use.
+type Server struct {
+	URL       string // The URL of the API, to be used as the API URL of a client
+	APIKey    string // The API key accepted by the server
+	SecretKey string // The secret key used to verify the signatures
+	Username  string // The username accepted by the login command
+	Password  string // The password accepted by the login command
+
+	srv *httptest.Server
+
+	mu          sync.Mutex
+	jobDelay    time.Duration
+	failures    map[string][]*apiError // The injected request failures per command
+	jobFailures map[string][]*apiError // The injected async job failures per command
+	sessions    map[string]bool
+	jobs        []*job
+	counter     int // Used to generate unique names, IP addresses and MAC addresses
+
+	zones            []*cloudstack.Zone
+	serviceOfferings []*cloudstack.ServiceOffering
+	diskOfferings    []*cloudstack.DiskOffering
+	networkOfferings []*cloudstack.NetworkOffering
+	templates        []*cloudstack.Template
+	vms              []*cloudstack.VirtualMachine
+	volumes          []*cloudstack.Volume
+	networks         []*cloudstack.Network
+	ips              []*cloudstack.PublicIpAddress
+}
+
+// NewServer starts and returns a new server without any resources. The server should be
+// closed when it is no longer used.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		APIKey:      DefaultAPIKey,
+		SecretKey:   DefaultSecretKey,
+		Username:    DefaultUsername,
+		Password:    DefaultPassword,
+		failures:    make(map[string][]*apiError),
+		jobFailures: make(map[string][]*apiError),
+		sessions:    make(map[string]bool),
+	}
+
+	for _, fn := range opts {
+		fn(s)
+	}
+
+	s.srv = httptest.NewServer(s)
+	s.URL = s.srv.URL + "/client/api"
+
+	return s
+}
+
+// Close shuts down the server
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// NewClient returns an async client using the credentials of the server. The options are
+// passed to cloudstack.NewAsyncClient.
+func (s *Server) NewClient(options ...cloudstack.ClientOption) *cloudstack.CloudStackClient {
+	return cloudstack.NewAsyncClient(s.URL, s.APIKey, s.SecretKey, false, options...)
+}
+
+// SetJobDelay changes the time it takes for new async jobs to finish
+func (s *Server) SetJobDelay(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobDelay = d
+}
+
+// FailNext makes the next request executing command fail with the given error code, which is
+// also used as the HTTP status code (e.g. 431 for an invalid parameter or 429 for throttling).
+// Calling FailNext multiple times fails multiple consecutive requests.
+func (s *Server) FailNext(command string, errorCode int, errorText string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	command = strings.ToLower(command)
+	s.failures[command] = append(s.failures[command], &apiError{code: errorCode, text: errorText})
+}
+
+// FailNextJob makes the next async job started by command fail with the given error code. The
+// request starting the job succeeds, but the job finishes with a failed status.
+func (s *Server) FailNextJob(command string, errorCode int, errorText string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	command = strings.ToLower(command)
+	s.jobFailures[command] = append(s.jobFailures[command], &apiError{code: errorCode, text: errorText})
+}
+
+// apiError is an error returned by the API
+type apiError struct {
+	code int
+	text string
+}
+
+func errorf(code int, format string, args ...interface{}) *apiError {
+	return &apiError{code: code, text: fmt.Sprintf(format, args...)}
+}
+
+// Returns the CloudStack exception code that matches the error code
+func (e *apiError) csErrorCode() int {
+	switch e.code {
+	case 431:
+		return 4350 // InvalidParameterValueException
+	case 530:
+		return 4250 // CloudRuntimeException
+	case 531:
+		return 4365 // PermissionDeniedException
+	default:
+		return 9999 // ServerApiException
+	}
+}
+
+func (e *apiError) response() map[string]interface{} {
+	return map[string]interface{}{
+		"uuidList":    []string{},
+		"errorcode":   e.code,
+		"cserrorcode": e.csErrorCode(),
+		"errortext":   e.text,
+	}
+}
+
+// The handlers of the supported commands
+type handlerFunc func(s *Server, params url.Values) (interface{}, *apiError)
+
+var handlers = map[string]handlerFunc{
+	"login":               (*Server).login,
+	"logout":              (*Server).logout,
+	"queryAsyncJobResult": (*Server).queryAsyncJobResult,
+	"listAsyncJobs":       (*Server).listAsyncJobs,
+
+	"listZones":             (*Server).listZones,
+	"listServiceOfferings":  (*Server).listServiceOfferings,
+	"listDiskOfferings":     (*Server).listDiskOfferings,
+	"listNetworkOfferings":  (*Server).listNetworkOfferings,
+	"listTemplates":         (*Server).listTemplates,
+	"listVirtualMachines":   (*Server).listVirtualMachines,
+	"deployVirtualMachine":  (*Server).deployVirtualMachine,
+	"startVirtualMachine":   (*Server).startVirtualMachine,
+	"stopVirtualMachine":    (*Server).stopVirtualMachine,
+	"rebootVirtualMachine":  (*Server).rebootVirtualMachine,
+	"destroyVirtualMachine": (*Server).destroyVirtualMachine,
+	"listVolumes":           (*Server).listVolumes,
+	"createVolume":          (*Server).createVolume,
+	"attachVolume":          (*Server).attachVolume,
+	"detachVolume":          (*Server).detachVolume,
+	"deleteVolume":          (*Server).deleteVolume,
+	"listNetworks":          (*Server).listNetworks,
+	"createNetwork":         (*Server).createNetwork,
+	"deleteNetwork":         (*Server).deleteNetwork,
+	"listPublicIpAddresses": (*Server).listPublicIpAddresses,
+	"associateIpAddress":    (*Server).associateIpAddress,
+	"disassociateIpAddress": (*Server).disassociateIpAddress,
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeResponse(w, "error", http.StatusBadRequest, errorf(http.StatusBadRequest, "%v", err).response())
+		return
+	}
+
+	params := r.Form
+	command := params.Get("command")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Finish all async jobs that are done by now
+	s.runJobs(time.Now())
+
+	if err := s.authenticate(command, params); err != nil {
+		writeResponse(w, command, err.code, err.response())
+		return
+	}
+
+	if err := s.nextFailure(s.failures, command); err != nil {
+		writeResponse(w, command, err.code, err.response())
+		return
+	}
+
+	h, ok := handlers[command]
+	if !ok {
+		err := errorf(432, "The given command does not exist or it is not available for user")
+		writeResponse(w, command, err.code, err.response())
+		return
+	}
+
+	v, err := h(s, params)
+	if err != nil {
+		writeResponse(w, command, err.code, err.response())
+		return
+	}
+	writeResponse(w, command, http.StatusOK, v)
+}
+
+func writeResponse(w http.ResponseWriter, command string, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		strings.ToLower(command) + "response": v,
+	})
+}
+
+// Verifies the signature or the session key of a request
+func (s *Server) authenticate(command string, params url.Values) *apiError {
+	unauthorized := errorf(401, "unable to verify user credentials and/or request signature")
+
+	// The credentials of the login command are verified by the command itself
+	if command == "login" {
+		return nil
+	}
+
+	if key := params.Get("sessionkey"); key != "" {
+		if !s.sessions[key] {
+			return unauthorized
+		}
+		return nil
+	}
+
+	if params.Get("apiKey") != s.APIKey {
+		return unauthorized
+	}
+
+	signature := params.Get("signature")
+	if !hmac.Equal([]byte(signature), []byte(sign(s.SecretKey, params))) {
+		return unauthorized
+	}
+
+	if params.Get("signatureversion") == "3" {
+		expires, err := time.Parse(timeLayout, params.Get("expires"))
+		if err != nil || time.Now().After(expires) {
+			return unauthorized
+		}
+	}
+
+	return nil
+}
+
+// Returns the signature of the params, which is calculated the same way as CloudStack does
+func sign(secret string, params url.Values) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		if k != "signature" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		for _, v := range params[k] {
+			parts = append(parts, k+"="+url.QueryEscape(v))
+		}
+	}
+
+	query := strings.Replace(strings.ToLower(strings.Join(parts, "&")), "+", "%20", -1)
+
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write([]byte(query))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Returns the next injected failure for the command, if any
+func (s *Server) nextFailure(failures map[string][]*apiError, command string) *apiError {
+	command = strings.ToLower(command)
+
+	errs := failures[command]
+	if len(errs) == 0 {
+		return nil
+	}
+
+	failures[command] = errs[1:]
+	return errs[0]
+}
+
+func (s *Server) login(params url.Values) (interface{}, *apiError) {
+	if s.Username == "" || params.Get("username") != s.Username || params.Get("password") != s.Password {
+		return nil, errorf(401, "Failed to authenticate user %s; please provide valid credentials", params.Get("username"))
+	}
+
+	key := newID()
+	s.sessions[key] = true
+
+	return &cloudstack.LoginResponse{
+		Account:    s.Username,
+		Domainid:   newID(),
+		Sessionkey: key,
+		Timeout:    1800,
+		Type:       "1",
+		Userid:     newID(),
+		Username:   s.Username,
+	}, nil
+}
+
+func (s *Server) logout(params url.Values) (interface{}, *apiError) {
+	delete(s.sessions, params.Get("sessionkey"))
+	return map[string]interface{}{"description": "success"}, nil
+}
+
+// job is an async job, which finishes once its ready time passed
+type job struct {
+	id           string
+	cmd          string
+	instanceType string
+	instanceID   string
+	created      time.Time
+	ready        time.Time
+	failure      *apiError                       // The injected failure, if any
+	run          func() (interface{}, *apiError) // Finishes the job and returns the job result
+	rollback     func()                          // Called instead of run if the job fails, may be nil
+
+	status int
+	code   int
+	result interface{}
+}
+
+// Starts an async job executing the command, and returns the response with the ID of the job
+func (s *Server) startJob(params url.Values, instanceType string, instanceID string, run func() (interface{}, *apiError), rollback func()) interface{} {
+	command := params.Get("command")
+	now := time.Now()
+
+	j := &job{
+		id:           newID(),
+		cmd:          command,
+		instanceType: instanceType,
+		instanceID:   instanceID,
+		created:      now,
+		ready:        now.Add(s.jobDelay),
+		failure:      s.nextFailure(s.jobFailures, command),
+		run:          run,
+		rollback:     rollback,
+	}
+	s.jobs = append(s.jobs, j)
+
+	return map[string]interface{}{
+		"id":    instanceID,
+		"jobid": j.id,
+	}
+}
+
+// Finishes all pending jobs that are ready, in the order in which they were started
+func (s *Server) runJobs(now time.Time) {
+	for _, j := range s.jobs {
+		if j.status != 0 || now.Before(j.ready) {
+			continue
+		}
+
+		err := j.failure
+		if err == nil {
+			j.result, err = j.run()
+		} else if j.rollback != nil {
+			j.rollback()
+		}
+
+		if err != nil {
+			j.status, j.code, j.result = 2, 530, err.response()
+			continue
+		}
+		j.status = 1
+	}
+}
+
+func (s *Server) queryAsyncJobResult(params url.Values) (interface{}, *apiError) {
+	if err := required(params, "jobid"); err != nil {
+		return nil, err
+	}
+
+	for _, j := range s.jobs {
+		if j.id == params.Get("jobid") {
+			return j.response(), nil
+		}
+	}
+
+	return nil, errorf(431, "Unable to find async job with id %s", params.Get("jobid"))
+}
+
+func (s *Server) listAsyncJobs(params url.Values) (interface{}, *apiError) {
+	var since time.Time
+	if v := params.Get("startdate"); v != "" {
+		t, err := time.Parse(timeLayout, v)
+		if err != nil {
+			return nil, errorf(431, "Unable to parse date %s for startdate", v)
+		}
+		since = t
+	}
+
+	// The timestamps only have a precision of seconds
+	jobs := []map[string]interface{}{}
+	for _, j := range s.jobs {
+		if !j.created.Truncate(time.Second).Before(since) {
+			jobs = append(jobs, j.response())
+		}
+	}
+
+	return listResponse("asyncjobs", jobs, params)
+}
+
+// Returns the job as it is returned by queryAsyncJobResult and listAsyncJobs
+func (j *job) response() map[string]interface{} {
+	r := map[string]interface{}{
+		"jobid":           j.id,
+		"cmd":             "org.apache.cloudstack.api.command." + j.cmd,
+		"created":         j.created.Format(timeLayout),
+		"jobinstancetype": j.instanceType,
+		"jobinstanceid":   j.instanceID,
+		"jobprocstatus":   0,
+		"jobresultcode":   j.code,
+		"jobstatus":       j.status,
+	}
+	if j.status != 0 {
+		r["jobresulttype"] = "object"
+		r["jobresult"] = j.result
+		r["completed"] = j.ready.Format(timeLayout)
+	}
+	return r
+}
+
+// Returns the items matching the params as a list response, using key as the name of the items.
+// Every param with the same name as a field of the items is used as a filter.
+func listResponse(key string, items interface{}, params url.Values) (interface{}, *apiError) {
+	b, err := json.Marshal(items)
+	if err != nil {
+		return nil, errorf(530, "%v", err)
+	}
+
+	var all []map[string]interface{}
+	if err := json.Unmarshal(b, &all); err != nil {
+		return nil, errorf(530, "%v", err)
+	}
+
+	var matched []map[string]interface{}
+	var found bool
+	for _, item := range all {
+		if item["id"] == params.Get("id") {
+			found = true
+		}
+		if matches(item, params) {
+			matched = append(matched, item)
+		}
+	}
+
+	// CloudStack fails to translate an unknown ID, instead of returning an empty list
+	if id := params.Get("id"); id != "" && !found {
+		return nil, errorf(431, "Unable to execute API command %s due to invalid value. Invalid parameter id value=%s "+
+			"due to incorrect long value format, or entity does not exist or due to incorrect parameter annotation "+
+			"for the field in api cmd class.", strings.ToLower(params.Get("command")), id)
+	}
+
+	// CloudStack returns an empty object if nothing matched
+	if len(matched) == 0 {
+		return map[string]interface{}{}, nil
+	}
+
+	r := map[string]interface{}{"count": len(matched)}
+
+	if pagesize, _ := strconv.Atoi(params.Get("pagesize")); pagesize > 0 {
+		page, _ := strconv.Atoi(params.Get("page"))
+		if page < 1 {
+			page = 1
+		}
+
+		start := (page - 1) * pagesize
+		if start > len(matched) {
+			start = len(matched)
+		}
+		end := start + pagesize
+		if end > len(matched) {
+			end = len(matched)
+		}
+		matched = matched[start:end]
+	}
+
+	r[key] = matched
+	return r, nil
+}
+
+// The params that are never used to filter the items of a list
+var nonFilterParams = map[string]bool{
+	"apikey":           true,
+	"command":          true,
+	"expires":          true,
+	"isrecursive":      true,
+	"keyword":          true,
+	"listall":          true,
+	"page":             true,
+	"pagesize":         true,
+	"response":         true,
+	"sessionkey":       true,
+	"signature":        true,
+	"signatureversion": true,
+	"templatefilter":   true,
+}
+
+// Returns true if the item matches the filters in the params
+func matches(item map[string]interface{}, params url.Values) bool {
+	if keyword := strings.ToLower(params.Get("keyword")); keyword != "" {
+		name, _ := item["name"].(string)
+		if !strings.Contains(strings.ToLower(name), keyword) {
+			return false
+		}
+	}
+
+	for k := range params {
+		k = strings.ToLower(k)
+		if nonFilterParams[k] {
+			continue
+		}
+
+		v, ok := item[k]
+		if !ok {
+			continue
+		}
+
+		switch v.(type) {
+		case string, bool, float64:
+			if !strings.EqualFold(fmt.Sprint(v), params.Get(k)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Returns an error if any of the params is missing
+func required(params url.Values, names ...string) *apiError {
+	for _, n := range names {
+		if params.Get(n) == "" {
+			return errorf(431, "Unable to execute API command %s due to missing parameter %s",
+				strings.ToLower(params.Get("command")), n)
+		}
+	}
+	return nil
+}
+
+// Returns a new random UUID
+func newID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// Returns the current time formatted like CloudStack does
+func now() string {
+	return time.Now().Format(timeLayout)
+}
@@ -0,0 +1,363 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstacktest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// Sends a listZones request signed using the given secret, after applying the changes to the
+// params, and returns the HTTP status code of the response
+func sendSigned(t *testing.T, s *Server, secret string, change func(url.Values)) int {
+	params := url.Values{}
+	params.Set("command", "listZones")
+	params.Set("response", "json")
+	params.Set("apiKey", s.APIKey)
+
+	change(params)
+	if params.Get("signature") == "" {
+		params.Set("signature", sign(secret, params))
+	}
+
+	resp, err := http.Get(s.URL + "?" + params.Encode())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	return resp.StatusCode
+}
+
+func TestAuthenticate(t *testing.T) {
+	expires := func(d time.Duration) func(url.Values) {
+		return func(p url.Values) {
+			p.Set("signatureversion", "3")
+			p.Set("expires", time.Now().Add(d).Format(timeLayout))
+		}
+	}
+
+	tests := []struct {
+		name   string
+		secret string
+		change func(url.Values)
+		want   int
+	}{
+		{name: "valid signature", change: func(url.Values) {}, want: http.StatusOK},
+		{name: "wrong secret key", secret: "wrong", change: func(url.Values) {}, want: http.StatusUnauthorized},
+		{name: "unknown API key", change: func(p url.Values) { p.Set("apiKey", "unknown") }, want: http.StatusUnauthorized},
+		{name: "tampered signature", change: func(p url.Values) { p.Set("signature", "dGFtcGVyZWQ=") }, want: http.StatusUnauthorized},
+		{name: "valid version 3 signature", change: expires(time.Minute), want: http.StatusOK},
+		{name: "expired version 3 signature", change: expires(-time.Minute), want: http.StatusUnauthorized},
+		{
+			name: "version 3 signature without expires",
+			change: func(p url.Values) {
+				p.Set("signatureversion", "3")
+			},
+			want: http.StatusUnauthorized,
+		},
+		{
+			name:   "unknown session key",
+			change: func(p url.Values) { p.Del("apiKey"); p.Set("sessionkey", "unknown") },
+			want:   http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServer()
+			defer s.Close()
+
+			secret := s.SecretKey
+			if tt.secret != "" {
+				secret = tt.secret
+			}
+
+			if got := sendSigned(t, s, secret, tt.change); got != tt.want {
+				t.Errorf("Expected status %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClientAuthentication(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  func(s *Server) *cloudstack.CloudStackClient
+		wantErr bool
+	}{
+		{
+			name:   "API key",
+			client: func(s *Server) *cloudstack.CloudStackClient { return s.NewClient() },
+		},
+		{
+			name: "signature version 3",
+			client: func(s *Server) *cloudstack.CloudStackClient {
+				return s.NewClient(cloudstack.WithSignatureExpiry(time.Minute))
+			},
+		},
+		{
+			name: "wrong secret key",
+			client: func(s *Server) *cloudstack.CloudStackClient {
+				return cloudstack.NewAsyncClient(s.URL, s.APIKey, "wrong", false)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServer()
+			defer s.Close()
+			s.AddZone("zone1")
+
+			cs := tt.client(s)
+			l, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+			if tt.wantErr {
+				var cse *cloudstack.CSError
+				if !errors.As(err, &cse) || cse.StatusCode != http.StatusUnauthorized {
+					t.Fatalf("Expected an unauthorized error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if l.Count != 1 {
+				t.Errorf("Expected 1 zone, got %d", l.Count)
+			}
+		})
+	}
+}
+
+func TestSession(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{name: "valid credentials", password: DefaultPassword},
+		{name: "wrong password", password: "wrong", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServer()
+			defer s.Close()
+			s.AddZone("zone1")
+
+			cs, err := cloudstack.NewSessionClient(s.URL, DefaultUsername, tt.password, "", false)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected the login to fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if n := len(s.sessions); n != 1 {
+				t.Fatalf("Expected 1 session, got %d", n)
+			}
+
+			if err := cs.Close(); err != nil {
+				t.Fatalf("Unexpected error when logging out: %v", err)
+			}
+			if n := len(s.sessions); n != 0 {
+				t.Errorf("Expected the session to be removed after logging out, got %d sessions", n)
+			}
+
+			if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); !errors.Is(err, cloudstack.SessionClosedErr) {
+				t.Errorf("Expected a SessionClosedErr after logging out, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAsyncJobs(t *testing.T) {
+	tests := []struct {
+		name      string
+		delay     time.Duration
+		failJob   bool
+		wantErr   error
+		wantState string
+	}{
+		{name: "finished", wantState: "Running"},
+		{name: "failed", failJob: true, wantErr: &cloudstack.AsyncJobError{}, wantState: "Error"},
+		{name: "delayed", delay: time.Hour, wantErr: context.DeadlineExceeded, wantState: "Starting"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServer()
+			defer s.Close()
+			z := s.AddZone("zone1")
+			so := s.AddServiceOffering("small", 1, 512)
+			tmpl := s.AddTemplate("template", z.Id)
+
+			s.SetJobDelay(tt.delay)
+			if tt.failJob {
+				s.FailNextJob("deployVirtualMachine", 530, "Insufficient capacity")
+			}
+
+			cs := s.NewClient()
+			p := cs.VirtualMachine.NewDeployVirtualMachineParams(so.Id, tmpl.Id, z.Id)
+			job, err := cs.VirtualMachine.DeployVirtualMachineAsync(p)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			err = job.Wait(ctx)
+
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				var r cloudstack.DeployVirtualMachineResponse
+				if err := job.Result(&r); err != nil {
+					t.Fatalf("Unable to decode the job result: %v", err)
+				}
+				if r.State != tt.wantState {
+					t.Errorf("Expected state %s in the job result, got %s", tt.wantState, r.State)
+				}
+			case *cloudstack.AsyncJobError:
+				if !errors.As(err, &want) {
+					t.Fatalf("Expected an AsyncJobError, got %v", err)
+				}
+				if want.ErrorCode != 530 || want.ErrorText != "Insufficient capacity" {
+					t.Errorf("Expected the injected failure, got %d: %s", want.ErrorCode, want.ErrorText)
+				}
+			default:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+				}
+			}
+
+			l, err := cs.VirtualMachine.ListVirtualMachines(cs.VirtualMachine.NewListVirtualMachinesParams())
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if l.Count != 1 || l.VirtualMachines[0].State != tt.wantState {
+				t.Errorf("Expected 1 VM in state %s, got %+v", tt.wantState, l.VirtualMachines)
+			}
+		})
+	}
+}
+
+func TestDeployAndListVirtualMachines(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	z1 := s.AddZone("zone1")
+	z2 := s.AddZone("zone2")
+	so := s.AddServiceOffering("small", 2, 2048)
+	t1 := s.AddTemplate("template", z1.Id)
+	t2 := s.AddTemplate("template", z2.Id)
+
+	cs := s.NewClient()
+
+	deploy := []struct {
+		name     string
+		zone     *cloudstack.Zone
+		template *cloudstack.Template
+		startvm  bool
+	}{
+		{name: "web1", zone: z1, template: t1, startvm: true},
+		{name: "web2", zone: z1, template: t1, startvm: false},
+		{name: "db1", zone: z2, template: t2, startvm: true},
+	}
+
+	for _, d := range deploy {
+		p := cs.VirtualMachine.NewDeployVirtualMachineParams(so.Id, d.template.Id, d.zone.Id)
+		p.SetName(d.name)
+		p.SetStartvm(d.startvm)
+
+		vm, err := cs.VirtualMachine.DeployVirtualMachine(p)
+		if err != nil {
+			t.Fatalf("Unable to deploy %s: %v", d.name, err)
+		}
+		if vm.Name != d.name || vm.Cpunumber != 2 || vm.Memory != 2048 {
+			t.Errorf("Unexpected VM %s: %+v", d.name, vm)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter func(p *cloudstack.ListVirtualMachinesParams)
+		want   []string
+	}{
+		{name: "all", filter: func(p *cloudstack.ListVirtualMachinesParams) {}, want: []string{"web1", "web2", "db1"}},
+		{name: "by zone", filter: func(p *cloudstack.ListVirtualMachinesParams) { p.SetZoneid(z1.Id) }, want: []string{"web1", "web2"}},
+		{name: "by name", filter: func(p *cloudstack.ListVirtualMachinesParams) { p.SetName("db1") }, want: []string{"db1"}},
+		{name: "by state", filter: func(p *cloudstack.ListVirtualMachinesParams) { p.SetState("Stopped") }, want: []string{"web2"}},
+		{name: "no match", filter: func(p *cloudstack.ListVirtualMachinesParams) { p.SetName("unknown") }, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := cs.VirtualMachine.NewListVirtualMachinesParams()
+			tt.filter(p)
+
+			l, err := cs.VirtualMachine.ListVirtualMachines(p)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var names []string
+			for _, vm := range l.VirtualMachines {
+				names = append(names, vm.Name)
+			}
+			if l.Count != len(tt.want) || len(names) != len(tt.want) {
+				t.Fatalf("Expected %v, got %v (count %d)", tt.want, names, l.Count)
+			}
+			for i := range names {
+				if names[i] != tt.want[i] {
+					t.Errorf("Expected %v, got %v", tt.want, names)
+					break
+				}
+			}
+		})
+	}
+
+	id, _, err := cs.VirtualMachine.GetVirtualMachineID("web1")
+	if err != nil {
+		t.Fatalf("Unable to get the ID of web1: %v", err)
+	}
+
+	vm, err := cs.VirtualMachine.StopVirtualMachine(cs.VirtualMachine.NewStopVirtualMachineParams(id))
+	if err != nil {
+		t.Fatalf("Unable to stop web1: %v", err)
+	}
+	if vm.State != "Stopped" {
+		t.Errorf("Expected web1 to be stopped, got %s", vm.State)
+	}
+}
@@ -0,0 +1,77 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockAPIDiscoveryService is a mock of cloudstack.APIDiscoveryServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockAPIDiscoveryService struct {
+	Recorder
+
+	NewListApisParamsFunc   func() *cloudstack.ListApisParams
+	ListApisFunc            func(p *cloudstack.ListApisParams) (*cloudstack.ListApisResponse, error)
+	ListApisWithContextFunc func(ctx context.Context, p *cloudstack.ListApisParams) (*cloudstack.ListApisResponse, error)
+}
+
+var _ cloudstack.APIDiscoveryServiceIface = (*MockAPIDiscoveryService)(nil)
+
+// NewListApisParams records the call and calls NewListApisParamsFunc
+func (m *MockAPIDiscoveryService) NewListApisParams() (r0 *cloudstack.ListApisParams) {
+	m.record("NewListApisParams")
+	if m.NewListApisParamsFunc != nil {
+		return m.NewListApisParamsFunc()
+	}
+	return cloudstack.NewAPIDiscoveryService(nil).NewListApisParams()
+}
+
+// ListApis records the call and calls ListApisFunc, or ListApisWithContextFunc if only that is set
+func (m *MockAPIDiscoveryService) ListApis(p *cloudstack.ListApisParams) (r0 *cloudstack.ListApisResponse, r1 error) {
+	m.record("ListApis", p)
+	if m.ListApisFunc != nil {
+		return m.ListApisFunc(p)
+	}
+	if m.ListApisWithContextFunc != nil {
+		return m.ListApisWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("APIDiscoveryService.ListApis")
+	return
+}
+
+// ListApisWithContext records the call and calls ListApisWithContextFunc, or ListApisFunc if only that is set
+func (m *MockAPIDiscoveryService) ListApisWithContext(ctx context.Context, p *cloudstack.ListApisParams) (r0 *cloudstack.ListApisResponse, r1 error) {
+	m.record("ListApisWithContext", ctx, p)
+	if m.ListApisWithContextFunc != nil {
+		return m.ListApisWithContextFunc(ctx, p)
+	}
+	if m.ListApisFunc != nil {
+		return m.ListApisFunc(p)
+	}
+	r1 = notMocked("APIDiscoveryService.ListApisWithContext")
+	return
+}
@@ -0,0 +1,823 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockAccountService is a mock of cloudstack.AccountServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockAccountService struct {
+	Recorder
+
+	NewAddAccountToProjectParamsFunc              func(projectid string) *cloudstack.AddAccountToProjectParams
+	AddAccountToProjectFunc                       func(p *cloudstack.AddAccountToProjectParams) (*cloudstack.AddAccountToProjectResponse, error)
+	AddAccountToProjectWithContextFunc            func(ctx context.Context, p *cloudstack.AddAccountToProjectParams) (*cloudstack.AddAccountToProjectResponse, error)
+	AddAccountToProjectAsyncFunc                  func(p *cloudstack.AddAccountToProjectParams) (*cloudstack.Job, error)
+	AddAccountToProjectAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.AddAccountToProjectParams) (*cloudstack.Job, error)
+	NewCreateAccountParamsFunc                    func(email string, firstname string, lastname string, password string, username string) *cloudstack.CreateAccountParams
+	CreateAccountFunc                             func(p *cloudstack.CreateAccountParams) (*cloudstack.CreateAccountResponse, error)
+	CreateAccountWithContextFunc                  func(ctx context.Context, p *cloudstack.CreateAccountParams) (*cloudstack.CreateAccountResponse, error)
+	NewDeleteAccountParamsFunc                    func(id string) *cloudstack.DeleteAccountParams
+	DeleteAccountFunc                             func(p *cloudstack.DeleteAccountParams) (*cloudstack.DeleteAccountResponse, error)
+	DeleteAccountWithContextFunc                  func(ctx context.Context, p *cloudstack.DeleteAccountParams) (*cloudstack.DeleteAccountResponse, error)
+	DeleteAccountAsyncFunc                        func(p *cloudstack.DeleteAccountParams) (*cloudstack.Job, error)
+	DeleteAccountAsyncWithContextFunc             func(ctx context.Context, p *cloudstack.DeleteAccountParams) (*cloudstack.Job, error)
+	NewDeleteAccountFromProjectParamsFunc         func(account string, projectid string) *cloudstack.DeleteAccountFromProjectParams
+	DeleteAccountFromProjectFunc                  func(p *cloudstack.DeleteAccountFromProjectParams) (*cloudstack.DeleteAccountFromProjectResponse, error)
+	DeleteAccountFromProjectWithContextFunc       func(ctx context.Context, p *cloudstack.DeleteAccountFromProjectParams) (*cloudstack.DeleteAccountFromProjectResponse, error)
+	DeleteAccountFromProjectAsyncFunc             func(p *cloudstack.DeleteAccountFromProjectParams) (*cloudstack.Job, error)
+	DeleteAccountFromProjectAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.DeleteAccountFromProjectParams) (*cloudstack.Job, error)
+	NewDisableAccountParamsFunc                   func(lock bool) *cloudstack.DisableAccountParams
+	DisableAccountFunc                            func(p *cloudstack.DisableAccountParams) (*cloudstack.DisableAccountResponse, error)
+	DisableAccountWithContextFunc                 func(ctx context.Context, p *cloudstack.DisableAccountParams) (*cloudstack.DisableAccountResponse, error)
+	DisableAccountAsyncFunc                       func(p *cloudstack.DisableAccountParams) (*cloudstack.Job, error)
+	DisableAccountAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.DisableAccountParams) (*cloudstack.Job, error)
+	NewEnableAccountParamsFunc                    func() *cloudstack.EnableAccountParams
+	EnableAccountFunc                             func(p *cloudstack.EnableAccountParams) (*cloudstack.EnableAccountResponse, error)
+	EnableAccountWithContextFunc                  func(ctx context.Context, p *cloudstack.EnableAccountParams) (*cloudstack.EnableAccountResponse, error)
+	NewGetSolidFireAccountIdParamsFunc            func(accountid string, storageid string) *cloudstack.GetSolidFireAccountIdParams
+	GetSolidFireAccountIdFunc                     func(p *cloudstack.GetSolidFireAccountIdParams) (*cloudstack.GetSolidFireAccountIdResponse, error)
+	GetSolidFireAccountIdWithContextFunc          func(ctx context.Context, p *cloudstack.GetSolidFireAccountIdParams) (*cloudstack.GetSolidFireAccountIdResponse, error)
+	NewListAccountsParamsFunc                     func() *cloudstack.ListAccountsParams
+	GetAccountIDFunc                              func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetAccountIDWithContextFunc                   func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetAccountByNameFunc                          func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Account, int, error)
+	GetAccountByNameWithContextFunc               func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.Account, int, error)
+	GetAccountByIDFunc                            func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Account, int, error)
+	GetAccountByIDWithContextFunc                 func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Account, int, error)
+	ListAccountsFunc                              func(p *cloudstack.ListAccountsParams) (*cloudstack.ListAccountsResponse, error)
+	ListAccountsWithContextFunc                   func(ctx context.Context, p *cloudstack.ListAccountsParams) (*cloudstack.ListAccountsResponse, error)
+	NewListAccountsPagerFunc                      func(p *cloudstack.ListAccountsParams) *cloudstack.ListAccountsPager
+	ListAccountsAllFunc                           func(p *cloudstack.ListAccountsParams) (*cloudstack.ListAccountsResponse, error)
+	ListAccountsAllWithContextFunc                func(ctx context.Context, p *cloudstack.ListAccountsParams) (*cloudstack.ListAccountsResponse, error)
+	NewListProjectAccountsParamsFunc              func(projectid string) *cloudstack.ListProjectAccountsParams
+	GetProjectAccountIDFunc                       func(keyword string, projectid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetProjectAccountIDWithContextFunc            func(ctx context.Context, keyword string, projectid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListProjectAccountsFunc                       func(p *cloudstack.ListProjectAccountsParams) (*cloudstack.ListProjectAccountsResponse, error)
+	ListProjectAccountsWithContextFunc            func(ctx context.Context, p *cloudstack.ListProjectAccountsParams) (*cloudstack.ListProjectAccountsResponse, error)
+	NewListProjectAccountsPagerFunc               func(p *cloudstack.ListProjectAccountsParams) *cloudstack.ListProjectAccountsPager
+	ListProjectAccountsAllFunc                    func(p *cloudstack.ListProjectAccountsParams) (*cloudstack.ListProjectAccountsResponse, error)
+	ListProjectAccountsAllWithContextFunc         func(ctx context.Context, p *cloudstack.ListProjectAccountsParams) (*cloudstack.ListProjectAccountsResponse, error)
+	NewLockAccountParamsFunc                      func(account string, domainid string) *cloudstack.LockAccountParams
+	LockAccountFunc                               func(p *cloudstack.LockAccountParams) (*cloudstack.LockAccountResponse, error)
+	LockAccountWithContextFunc                    func(ctx context.Context, p *cloudstack.LockAccountParams) (*cloudstack.LockAccountResponse, error)
+	NewMarkDefaultZoneForAccountParamsFunc        func(account string, domainid string, zoneid string) *cloudstack.MarkDefaultZoneForAccountParams
+	MarkDefaultZoneForAccountFunc                 func(p *cloudstack.MarkDefaultZoneForAccountParams) (*cloudstack.MarkDefaultZoneForAccountResponse, error)
+	MarkDefaultZoneForAccountWithContextFunc      func(ctx context.Context, p *cloudstack.MarkDefaultZoneForAccountParams) (*cloudstack.MarkDefaultZoneForAccountResponse, error)
+	MarkDefaultZoneForAccountAsyncFunc            func(p *cloudstack.MarkDefaultZoneForAccountParams) (*cloudstack.Job, error)
+	MarkDefaultZoneForAccountAsyncWithContextFunc func(ctx context.Context, p *cloudstack.MarkDefaultZoneForAccountParams) (*cloudstack.Job, error)
+	NewUpdateAccountParamsFunc                    func() *cloudstack.UpdateAccountParams
+	UpdateAccountFunc                             func(p *cloudstack.UpdateAccountParams) (*cloudstack.UpdateAccountResponse, error)
+	UpdateAccountWithContextFunc                  func(ctx context.Context, p *cloudstack.UpdateAccountParams) (*cloudstack.UpdateAccountResponse, error)
+}
+
+var _ cloudstack.AccountServiceIface = (*MockAccountService)(nil)
+
+// NewAddAccountToProjectParams records the call and calls NewAddAccountToProjectParamsFunc
+func (m *MockAccountService) NewAddAccountToProjectParams(projectid string) (r0 *cloudstack.AddAccountToProjectParams) {
+	m.record("NewAddAccountToProjectParams", projectid)
+	if m.NewAddAccountToProjectParamsFunc != nil {
+		return m.NewAddAccountToProjectParamsFunc(projectid)
+	}
+	return cloudstack.NewAccountService(nil).NewAddAccountToProjectParams(projectid)
+}
+
+// AddAccountToProject records the call and calls AddAccountToProjectFunc, or AddAccountToProjectWithContextFunc if only that is set
+func (m *MockAccountService) AddAccountToProject(p *cloudstack.AddAccountToProjectParams) (r0 *cloudstack.AddAccountToProjectResponse, r1 error) {
+	m.record("AddAccountToProject", p)
+	if m.AddAccountToProjectFunc != nil {
+		return m.AddAccountToProjectFunc(p)
+	}
+	if m.AddAccountToProjectWithContextFunc != nil {
+		return m.AddAccountToProjectWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.AddAccountToProject")
+	return
+}
+
+// AddAccountToProjectWithContext records the call and calls AddAccountToProjectWithContextFunc, or AddAccountToProjectFunc if only that is set
+func (m *MockAccountService) AddAccountToProjectWithContext(ctx context.Context, p *cloudstack.AddAccountToProjectParams) (r0 *cloudstack.AddAccountToProjectResponse, r1 error) {
+	m.record("AddAccountToProjectWithContext", ctx, p)
+	if m.AddAccountToProjectWithContextFunc != nil {
+		return m.AddAccountToProjectWithContextFunc(ctx, p)
+	}
+	if m.AddAccountToProjectFunc != nil {
+		return m.AddAccountToProjectFunc(p)
+	}
+	r1 = notMocked("AccountService.AddAccountToProjectWithContext")
+	return
+}
+
+// AddAccountToProjectAsync records the call and calls AddAccountToProjectAsyncFunc, or AddAccountToProjectAsyncWithContextFunc if only that is set
+func (m *MockAccountService) AddAccountToProjectAsync(p *cloudstack.AddAccountToProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddAccountToProjectAsync", p)
+	if m.AddAccountToProjectAsyncFunc != nil {
+		return m.AddAccountToProjectAsyncFunc(p)
+	}
+	if m.AddAccountToProjectAsyncWithContextFunc != nil {
+		return m.AddAccountToProjectAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.AddAccountToProjectAsync")
+	return
+}
+
+// AddAccountToProjectAsyncWithContext records the call and calls AddAccountToProjectAsyncWithContextFunc, or AddAccountToProjectAsyncFunc if only that is set
+func (m *MockAccountService) AddAccountToProjectAsyncWithContext(ctx context.Context, p *cloudstack.AddAccountToProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddAccountToProjectAsyncWithContext", ctx, p)
+	if m.AddAccountToProjectAsyncWithContextFunc != nil {
+		return m.AddAccountToProjectAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddAccountToProjectAsyncFunc != nil {
+		return m.AddAccountToProjectAsyncFunc(p)
+	}
+	r1 = notMocked("AccountService.AddAccountToProjectAsyncWithContext")
+	return
+}
+
+// NewCreateAccountParams records the call and calls NewCreateAccountParamsFunc
+func (m *MockAccountService) NewCreateAccountParams(email string, firstname string, lastname string, password string, username string) (r0 *cloudstack.CreateAccountParams) {
+	m.record("NewCreateAccountParams", email, firstname, lastname, password, username)
+	if m.NewCreateAccountParamsFunc != nil {
+		return m.NewCreateAccountParamsFunc(email, firstname, lastname, password, username)
+	}
+	return cloudstack.NewAccountService(nil).NewCreateAccountParams(email, firstname, lastname, password, username)
+}
+
+// CreateAccount records the call and calls CreateAccountFunc, or CreateAccountWithContextFunc if only that is set
+func (m *MockAccountService) CreateAccount(p *cloudstack.CreateAccountParams) (r0 *cloudstack.CreateAccountResponse, r1 error) {
+	m.record("CreateAccount", p)
+	if m.CreateAccountFunc != nil {
+		return m.CreateAccountFunc(p)
+	}
+	if m.CreateAccountWithContextFunc != nil {
+		return m.CreateAccountWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.CreateAccount")
+	return
+}
+
+// CreateAccountWithContext records the call and calls CreateAccountWithContextFunc, or CreateAccountFunc if only that is set
+func (m *MockAccountService) CreateAccountWithContext(ctx context.Context, p *cloudstack.CreateAccountParams) (r0 *cloudstack.CreateAccountResponse, r1 error) {
+	m.record("CreateAccountWithContext", ctx, p)
+	if m.CreateAccountWithContextFunc != nil {
+		return m.CreateAccountWithContextFunc(ctx, p)
+	}
+	if m.CreateAccountFunc != nil {
+		return m.CreateAccountFunc(p)
+	}
+	r1 = notMocked("AccountService.CreateAccountWithContext")
+	return
+}
+
+// NewDeleteAccountParams records the call and calls NewDeleteAccountParamsFunc
+func (m *MockAccountService) NewDeleteAccountParams(id string) (r0 *cloudstack.DeleteAccountParams) {
+	m.record("NewDeleteAccountParams", id)
+	if m.NewDeleteAccountParamsFunc != nil {
+		return m.NewDeleteAccountParamsFunc(id)
+	}
+	return cloudstack.NewAccountService(nil).NewDeleteAccountParams(id)
+}
+
+// DeleteAccount records the call and calls DeleteAccountFunc, or DeleteAccountWithContextFunc if only that is set
+func (m *MockAccountService) DeleteAccount(p *cloudstack.DeleteAccountParams) (r0 *cloudstack.DeleteAccountResponse, r1 error) {
+	m.record("DeleteAccount", p)
+	if m.DeleteAccountFunc != nil {
+		return m.DeleteAccountFunc(p)
+	}
+	if m.DeleteAccountWithContextFunc != nil {
+		return m.DeleteAccountWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.DeleteAccount")
+	return
+}
+
+// DeleteAccountWithContext records the call and calls DeleteAccountWithContextFunc, or DeleteAccountFunc if only that is set
+func (m *MockAccountService) DeleteAccountWithContext(ctx context.Context, p *cloudstack.DeleteAccountParams) (r0 *cloudstack.DeleteAccountResponse, r1 error) {
+	m.record("DeleteAccountWithContext", ctx, p)
+	if m.DeleteAccountWithContextFunc != nil {
+		return m.DeleteAccountWithContextFunc(ctx, p)
+	}
+	if m.DeleteAccountFunc != nil {
+		return m.DeleteAccountFunc(p)
+	}
+	r1 = notMocked("AccountService.DeleteAccountWithContext")
+	return
+}
+
+// DeleteAccountAsync records the call and calls DeleteAccountAsyncFunc, or DeleteAccountAsyncWithContextFunc if only that is set
+func (m *MockAccountService) DeleteAccountAsync(p *cloudstack.DeleteAccountParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteAccountAsync", p)
+	if m.DeleteAccountAsyncFunc != nil {
+		return m.DeleteAccountAsyncFunc(p)
+	}
+	if m.DeleteAccountAsyncWithContextFunc != nil {
+		return m.DeleteAccountAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.DeleteAccountAsync")
+	return
+}
+
+// DeleteAccountAsyncWithContext records the call and calls DeleteAccountAsyncWithContextFunc, or DeleteAccountAsyncFunc if only that is set
+func (m *MockAccountService) DeleteAccountAsyncWithContext(ctx context.Context, p *cloudstack.DeleteAccountParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteAccountAsyncWithContext", ctx, p)
+	if m.DeleteAccountAsyncWithContextFunc != nil {
+		return m.DeleteAccountAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteAccountAsyncFunc != nil {
+		return m.DeleteAccountAsyncFunc(p)
+	}
+	r1 = notMocked("AccountService.DeleteAccountAsyncWithContext")
+	return
+}
+
+// NewDeleteAccountFromProjectParams records the call and calls NewDeleteAccountFromProjectParamsFunc
+func (m *MockAccountService) NewDeleteAccountFromProjectParams(account string, projectid string) (r0 *cloudstack.DeleteAccountFromProjectParams) {
+	m.record("NewDeleteAccountFromProjectParams", account, projectid)
+	if m.NewDeleteAccountFromProjectParamsFunc != nil {
+		return m.NewDeleteAccountFromProjectParamsFunc(account, projectid)
+	}
+	return cloudstack.NewAccountService(nil).NewDeleteAccountFromProjectParams(account, projectid)
+}
+
+// DeleteAccountFromProject records the call and calls DeleteAccountFromProjectFunc, or DeleteAccountFromProjectWithContextFunc if only that is set
+func (m *MockAccountService) DeleteAccountFromProject(p *cloudstack.DeleteAccountFromProjectParams) (r0 *cloudstack.DeleteAccountFromProjectResponse, r1 error) {
+	m.record("DeleteAccountFromProject", p)
+	if m.DeleteAccountFromProjectFunc != nil {
+		return m.DeleteAccountFromProjectFunc(p)
+	}
+	if m.DeleteAccountFromProjectWithContextFunc != nil {
+		return m.DeleteAccountFromProjectWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.DeleteAccountFromProject")
+	return
+}
+
+// DeleteAccountFromProjectWithContext records the call and calls DeleteAccountFromProjectWithContextFunc, or DeleteAccountFromProjectFunc if only that is set
+func (m *MockAccountService) DeleteAccountFromProjectWithContext(ctx context.Context, p *cloudstack.DeleteAccountFromProjectParams) (r0 *cloudstack.DeleteAccountFromProjectResponse, r1 error) {
+	m.record("DeleteAccountFromProjectWithContext", ctx, p)
+	if m.DeleteAccountFromProjectWithContextFunc != nil {
+		return m.DeleteAccountFromProjectWithContextFunc(ctx, p)
+	}
+	if m.DeleteAccountFromProjectFunc != nil {
+		return m.DeleteAccountFromProjectFunc(p)
+	}
+	r1 = notMocked("AccountService.DeleteAccountFromProjectWithContext")
+	return
+}
+
+// DeleteAccountFromProjectAsync records the call and calls DeleteAccountFromProjectAsyncFunc, or DeleteAccountFromProjectAsyncWithContextFunc if only that is set
+func (m *MockAccountService) DeleteAccountFromProjectAsync(p *cloudstack.DeleteAccountFromProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteAccountFromProjectAsync", p)
+	if m.DeleteAccountFromProjectAsyncFunc != nil {
+		return m.DeleteAccountFromProjectAsyncFunc(p)
+	}
+	if m.DeleteAccountFromProjectAsyncWithContextFunc != nil {
+		return m.DeleteAccountFromProjectAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.DeleteAccountFromProjectAsync")
+	return
+}
+
+// DeleteAccountFromProjectAsyncWithContext records the call and calls DeleteAccountFromProjectAsyncWithContextFunc, or DeleteAccountFromProjectAsyncFunc if only that is set
+func (m *MockAccountService) DeleteAccountFromProjectAsyncWithContext(ctx context.Context, p *cloudstack.DeleteAccountFromProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteAccountFromProjectAsyncWithContext", ctx, p)
+	if m.DeleteAccountFromProjectAsyncWithContextFunc != nil {
+		return m.DeleteAccountFromProjectAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteAccountFromProjectAsyncFunc != nil {
+		return m.DeleteAccountFromProjectAsyncFunc(p)
+	}
+	r1 = notMocked("AccountService.DeleteAccountFromProjectAsyncWithContext")
+	return
+}
+
+// NewDisableAccountParams records the call and calls NewDisableAccountParamsFunc
+func (m *MockAccountService) NewDisableAccountParams(lock bool) (r0 *cloudstack.DisableAccountParams) {
+	m.record("NewDisableAccountParams", lock)
+	if m.NewDisableAccountParamsFunc != nil {
+		return m.NewDisableAccountParamsFunc(lock)
+	}
+	return cloudstack.NewAccountService(nil).NewDisableAccountParams(lock)
+}
+
+// DisableAccount records the call and calls DisableAccountFunc, or DisableAccountWithContextFunc if only that is set
+func (m *MockAccountService) DisableAccount(p *cloudstack.DisableAccountParams) (r0 *cloudstack.DisableAccountResponse, r1 error) {
+	m.record("DisableAccount", p)
+	if m.DisableAccountFunc != nil {
+		return m.DisableAccountFunc(p)
+	}
+	if m.DisableAccountWithContextFunc != nil {
+		return m.DisableAccountWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.DisableAccount")
+	return
+}
+
+// DisableAccountWithContext records the call and calls DisableAccountWithContextFunc, or DisableAccountFunc if only that is set
+func (m *MockAccountService) DisableAccountWithContext(ctx context.Context, p *cloudstack.DisableAccountParams) (r0 *cloudstack.DisableAccountResponse, r1 error) {
+	m.record("DisableAccountWithContext", ctx, p)
+	if m.DisableAccountWithContextFunc != nil {
+		return m.DisableAccountWithContextFunc(ctx, p)
+	}
+	if m.DisableAccountFunc != nil {
+		return m.DisableAccountFunc(p)
+	}
+	r1 = notMocked("AccountService.DisableAccountWithContext")
+	return
+}
+
+// DisableAccountAsync records the call and calls DisableAccountAsyncFunc, or DisableAccountAsyncWithContextFunc if only that is set
+func (m *MockAccountService) DisableAccountAsync(p *cloudstack.DisableAccountParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableAccountAsync", p)
+	if m.DisableAccountAsyncFunc != nil {
+		return m.DisableAccountAsyncFunc(p)
+	}
+	if m.DisableAccountAsyncWithContextFunc != nil {
+		return m.DisableAccountAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.DisableAccountAsync")
+	return
+}
+
+// DisableAccountAsyncWithContext records the call and calls DisableAccountAsyncWithContextFunc, or DisableAccountAsyncFunc if only that is set
+func (m *MockAccountService) DisableAccountAsyncWithContext(ctx context.Context, p *cloudstack.DisableAccountParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableAccountAsyncWithContext", ctx, p)
+	if m.DisableAccountAsyncWithContextFunc != nil {
+		return m.DisableAccountAsyncWithContextFunc(ctx, p)
+	}
+	if m.DisableAccountAsyncFunc != nil {
+		return m.DisableAccountAsyncFunc(p)
+	}
+	r1 = notMocked("AccountService.DisableAccountAsyncWithContext")
+	return
+}
+
+// NewEnableAccountParams records the call and calls NewEnableAccountParamsFunc
+func (m *MockAccountService) NewEnableAccountParams() (r0 *cloudstack.EnableAccountParams) {
+	m.record("NewEnableAccountParams")
+	if m.NewEnableAccountParamsFunc != nil {
+		return m.NewEnableAccountParamsFunc()
+	}
+	return cloudstack.NewAccountService(nil).NewEnableAccountParams()
+}
+
+// EnableAccount records the call and calls EnableAccountFunc, or EnableAccountWithContextFunc if only that is set
+func (m *MockAccountService) EnableAccount(p *cloudstack.EnableAccountParams) (r0 *cloudstack.EnableAccountResponse, r1 error) {
+	m.record("EnableAccount", p)
+	if m.EnableAccountFunc != nil {
+		return m.EnableAccountFunc(p)
+	}
+	if m.EnableAccountWithContextFunc != nil {
+		return m.EnableAccountWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.EnableAccount")
+	return
+}
+
+// EnableAccountWithContext records the call and calls EnableAccountWithContextFunc, or EnableAccountFunc if only that is set
+func (m *MockAccountService) EnableAccountWithContext(ctx context.Context, p *cloudstack.EnableAccountParams) (r0 *cloudstack.EnableAccountResponse, r1 error) {
+	m.record("EnableAccountWithContext", ctx, p)
+	if m.EnableAccountWithContextFunc != nil {
+		return m.EnableAccountWithContextFunc(ctx, p)
+	}
+	if m.EnableAccountFunc != nil {
+		return m.EnableAccountFunc(p)
+	}
+	r1 = notMocked("AccountService.EnableAccountWithContext")
+	return
+}
+
+// NewGetSolidFireAccountIdParams records the call and calls NewGetSolidFireAccountIdParamsFunc
+func (m *MockAccountService) NewGetSolidFireAccountIdParams(accountid string, storageid string) (r0 *cloudstack.GetSolidFireAccountIdParams) {
+	m.record("NewGetSolidFireAccountIdParams", accountid, storageid)
+	if m.NewGetSolidFireAccountIdParamsFunc != nil {
+		return m.NewGetSolidFireAccountIdParamsFunc(accountid, storageid)
+	}
+	return cloudstack.NewAccountService(nil).NewGetSolidFireAccountIdParams(accountid, storageid)
+}
+
+// GetSolidFireAccountId records the call and calls GetSolidFireAccountIdFunc, or GetSolidFireAccountIdWithContextFunc if only that is set
+func (m *MockAccountService) GetSolidFireAccountId(p *cloudstack.GetSolidFireAccountIdParams) (r0 *cloudstack.GetSolidFireAccountIdResponse, r1 error) {
+	m.record("GetSolidFireAccountId", p)
+	if m.GetSolidFireAccountIdFunc != nil {
+		return m.GetSolidFireAccountIdFunc(p)
+	}
+	if m.GetSolidFireAccountIdWithContextFunc != nil {
+		return m.GetSolidFireAccountIdWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.GetSolidFireAccountId")
+	return
+}
+
+// GetSolidFireAccountIdWithContext records the call and calls GetSolidFireAccountIdWithContextFunc, or GetSolidFireAccountIdFunc if only that is set
+func (m *MockAccountService) GetSolidFireAccountIdWithContext(ctx context.Context, p *cloudstack.GetSolidFireAccountIdParams) (r0 *cloudstack.GetSolidFireAccountIdResponse, r1 error) {
+	m.record("GetSolidFireAccountIdWithContext", ctx, p)
+	if m.GetSolidFireAccountIdWithContextFunc != nil {
+		return m.GetSolidFireAccountIdWithContextFunc(ctx, p)
+	}
+	if m.GetSolidFireAccountIdFunc != nil {
+		return m.GetSolidFireAccountIdFunc(p)
+	}
+	r1 = notMocked("AccountService.GetSolidFireAccountIdWithContext")
+	return
+}
+
+// NewListAccountsParams records the call and calls NewListAccountsParamsFunc
+func (m *MockAccountService) NewListAccountsParams() (r0 *cloudstack.ListAccountsParams) {
+	m.record("NewListAccountsParams")
+	if m.NewListAccountsParamsFunc != nil {
+		return m.NewListAccountsParamsFunc()
+	}
+	return cloudstack.NewAccountService(nil).NewListAccountsParams()
+}
+
+// GetAccountID records the call and calls GetAccountIDFunc, or GetAccountIDWithContextFunc if only that is set
+func (m *MockAccountService) GetAccountID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetAccountID", name, opts)
+	if m.GetAccountIDFunc != nil {
+		return m.GetAccountIDFunc(name, opts...)
+	}
+	if m.GetAccountIDWithContextFunc != nil {
+		return m.GetAccountIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("AccountService.GetAccountID")
+	return
+}
+
+// GetAccountIDWithContext records the call and calls GetAccountIDWithContextFunc, or GetAccountIDFunc if only that is set
+func (m *MockAccountService) GetAccountIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetAccountIDWithContext", ctx, name, opts)
+	if m.GetAccountIDWithContextFunc != nil {
+		return m.GetAccountIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetAccountIDFunc != nil {
+		return m.GetAccountIDFunc(name, opts...)
+	}
+	r2 = notMocked("AccountService.GetAccountIDWithContext")
+	return
+}
+
+// GetAccountByName records the call and calls GetAccountByNameFunc, or GetAccountByNameWithContextFunc if only that is set
+func (m *MockAccountService) GetAccountByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Account, r1 int, r2 error) {
+	m.record("GetAccountByName", name, opts)
+	if m.GetAccountByNameFunc != nil {
+		return m.GetAccountByNameFunc(name, opts...)
+	}
+	if m.GetAccountByNameWithContextFunc != nil {
+		return m.GetAccountByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("AccountService.GetAccountByName")
+	return
+}
+
+// GetAccountByNameWithContext records the call and calls GetAccountByNameWithContextFunc, or GetAccountByNameFunc if only that is set
+func (m *MockAccountService) GetAccountByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Account, r1 int, r2 error) {
+	m.record("GetAccountByNameWithContext", ctx, name, opts)
+	if m.GetAccountByNameWithContextFunc != nil {
+		return m.GetAccountByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetAccountByNameFunc != nil {
+		return m.GetAccountByNameFunc(name, opts...)
+	}
+	r2 = notMocked("AccountService.GetAccountByNameWithContext")
+	return
+}
+
+// GetAccountByID records the call and calls GetAccountByIDFunc, or GetAccountByIDWithContextFunc if only that is set
+func (m *MockAccountService) GetAccountByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Account, r1 int, r2 error) {
+	m.record("GetAccountByID", id, opts)
+	if m.GetAccountByIDFunc != nil {
+		return m.GetAccountByIDFunc(id, opts...)
+	}
+	if m.GetAccountByIDWithContextFunc != nil {
+		return m.GetAccountByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("AccountService.GetAccountByID")
+	return
+}
+
+// GetAccountByIDWithContext records the call and calls GetAccountByIDWithContextFunc, or GetAccountByIDFunc if only that is set
+func (m *MockAccountService) GetAccountByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Account, r1 int, r2 error) {
+	m.record("GetAccountByIDWithContext", ctx, id, opts)
+	if m.GetAccountByIDWithContextFunc != nil {
+		return m.GetAccountByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetAccountByIDFunc != nil {
+		return m.GetAccountByIDFunc(id, opts...)
+	}
+	r2 = notMocked("AccountService.GetAccountByIDWithContext")
+	return
+}
+
+// ListAccounts records the call and calls ListAccountsFunc, or ListAccountsWithContextFunc if only that is set
+func (m *MockAccountService) ListAccounts(p *cloudstack.ListAccountsParams) (r0 *cloudstack.ListAccountsResponse, r1 error) {
+	m.record("ListAccounts", p)
+	if m.ListAccountsFunc != nil {
+		return m.ListAccountsFunc(p)
+	}
+	if m.ListAccountsWithContextFunc != nil {
+		return m.ListAccountsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.ListAccounts")
+	return
+}
+
+// ListAccountsWithContext records the call and calls ListAccountsWithContextFunc, or ListAccountsFunc if only that is set
+func (m *MockAccountService) ListAccountsWithContext(ctx context.Context, p *cloudstack.ListAccountsParams) (r0 *cloudstack.ListAccountsResponse, r1 error) {
+	m.record("ListAccountsWithContext", ctx, p)
+	if m.ListAccountsWithContextFunc != nil {
+		return m.ListAccountsWithContextFunc(ctx, p)
+	}
+	if m.ListAccountsFunc != nil {
+		return m.ListAccountsFunc(p)
+	}
+	r1 = notMocked("AccountService.ListAccountsWithContext")
+	return
+}
+
+// NewListAccountsPager records the call and calls NewListAccountsPagerFunc
+func (m *MockAccountService) NewListAccountsPager(p *cloudstack.ListAccountsParams) (r0 *cloudstack.ListAccountsPager) {
+	m.record("NewListAccountsPager", p)
+	if m.NewListAccountsPagerFunc != nil {
+		return m.NewListAccountsPagerFunc(p)
+	}
+	return
+}
+
+// ListAccountsAll records the call and calls ListAccountsAllFunc, or ListAccountsAllWithContextFunc if only that is set
+func (m *MockAccountService) ListAccountsAll(p *cloudstack.ListAccountsParams) (r0 *cloudstack.ListAccountsResponse, r1 error) {
+	m.record("ListAccountsAll", p)
+	if m.ListAccountsAllFunc != nil {
+		return m.ListAccountsAllFunc(p)
+	}
+	if m.ListAccountsAllWithContextFunc != nil {
+		return m.ListAccountsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.ListAccountsAll")
+	return
+}
+
+// ListAccountsAllWithContext records the call and calls ListAccountsAllWithContextFunc, or ListAccountsAllFunc if only that is set
+func (m *MockAccountService) ListAccountsAllWithContext(ctx context.Context, p *cloudstack.ListAccountsParams) (r0 *cloudstack.ListAccountsResponse, r1 error) {
+	m.record("ListAccountsAllWithContext", ctx, p)
+	if m.ListAccountsAllWithContextFunc != nil {
+		return m.ListAccountsAllWithContextFunc(ctx, p)
+	}
+	if m.ListAccountsAllFunc != nil {
+		return m.ListAccountsAllFunc(p)
+	}
+	r1 = notMocked("AccountService.ListAccountsAllWithContext")
+	return
+}
+
+// NewListProjectAccountsParams records the call and calls NewListProjectAccountsParamsFunc
+func (m *MockAccountService) NewListProjectAccountsParams(projectid string) (r0 *cloudstack.ListProjectAccountsParams) {
+	m.record("NewListProjectAccountsParams", projectid)
+	if m.NewListProjectAccountsParamsFunc != nil {
+		return m.NewListProjectAccountsParamsFunc(projectid)
+	}
+	return cloudstack.NewAccountService(nil).NewListProjectAccountsParams(projectid)
+}
+
+// GetProjectAccountID records the call and calls GetProjectAccountIDFunc, or GetProjectAccountIDWithContextFunc if only that is set
+func (m *MockAccountService) GetProjectAccountID(keyword string, projectid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetProjectAccountID", keyword, projectid, opts)
+	if m.GetProjectAccountIDFunc != nil {
+		return m.GetProjectAccountIDFunc(keyword, projectid, opts...)
+	}
+	if m.GetProjectAccountIDWithContextFunc != nil {
+		return m.GetProjectAccountIDWithContextFunc(context.Background(), keyword, projectid, opts...)
+	}
+	r2 = notMocked("AccountService.GetProjectAccountID")
+	return
+}
+
+// GetProjectAccountIDWithContext records the call and calls GetProjectAccountIDWithContextFunc, or GetProjectAccountIDFunc if only that is set
+func (m *MockAccountService) GetProjectAccountIDWithContext(ctx context.Context, keyword string, projectid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetProjectAccountIDWithContext", ctx, keyword, projectid, opts)
+	if m.GetProjectAccountIDWithContextFunc != nil {
+		return m.GetProjectAccountIDWithContextFunc(ctx, keyword, projectid, opts...)
+	}
+	if m.GetProjectAccountIDFunc != nil {
+		return m.GetProjectAccountIDFunc(keyword, projectid, opts...)
+	}
+	r2 = notMocked("AccountService.GetProjectAccountIDWithContext")
+	return
+}
+
+// ListProjectAccounts records the call and calls ListProjectAccountsFunc, or ListProjectAccountsWithContextFunc if only that is set
+func (m *MockAccountService) ListProjectAccounts(p *cloudstack.ListProjectAccountsParams) (r0 *cloudstack.ListProjectAccountsResponse, r1 error) {
+	m.record("ListProjectAccounts", p)
+	if m.ListProjectAccountsFunc != nil {
+		return m.ListProjectAccountsFunc(p)
+	}
+	if m.ListProjectAccountsWithContextFunc != nil {
+		return m.ListProjectAccountsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.ListProjectAccounts")
+	return
+}
+
+// ListProjectAccountsWithContext records the call and calls ListProjectAccountsWithContextFunc, or ListProjectAccountsFunc if only that is set
+func (m *MockAccountService) ListProjectAccountsWithContext(ctx context.Context, p *cloudstack.ListProjectAccountsParams) (r0 *cloudstack.ListProjectAccountsResponse, r1 error) {
+	m.record("ListProjectAccountsWithContext", ctx, p)
+	if m.ListProjectAccountsWithContextFunc != nil {
+		return m.ListProjectAccountsWithContextFunc(ctx, p)
+	}
+	if m.ListProjectAccountsFunc != nil {
+		return m.ListProjectAccountsFunc(p)
+	}
+	r1 = notMocked("AccountService.ListProjectAccountsWithContext")
+	return
+}
+
+// NewListProjectAccountsPager records the call and calls NewListProjectAccountsPagerFunc
+func (m *MockAccountService) NewListProjectAccountsPager(p *cloudstack.ListProjectAccountsParams) (r0 *cloudstack.ListProjectAccountsPager) {
+	m.record("NewListProjectAccountsPager", p)
+	if m.NewListProjectAccountsPagerFunc != nil {
+		return m.NewListProjectAccountsPagerFunc(p)
+	}
+	return
+}
+
+// ListProjectAccountsAll records the call and calls ListProjectAccountsAllFunc, or ListProjectAccountsAllWithContextFunc if only that is set
+func (m *MockAccountService) ListProjectAccountsAll(p *cloudstack.ListProjectAccountsParams) (r0 *cloudstack.ListProjectAccountsResponse, r1 error) {
+	m.record("ListProjectAccountsAll", p)
+	if m.ListProjectAccountsAllFunc != nil {
+		return m.ListProjectAccountsAllFunc(p)
+	}
+	if m.ListProjectAccountsAllWithContextFunc != nil {
+		return m.ListProjectAccountsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.ListProjectAccountsAll")
+	return
+}
+
+// ListProjectAccountsAllWithContext records the call and calls ListProjectAccountsAllWithContextFunc, or ListProjectAccountsAllFunc if only that is set
+func (m *MockAccountService) ListProjectAccountsAllWithContext(ctx context.Context, p *cloudstack.ListProjectAccountsParams) (r0 *cloudstack.ListProjectAccountsResponse, r1 error) {
+	m.record("ListProjectAccountsAllWithContext", ctx, p)
+	if m.ListProjectAccountsAllWithContextFunc != nil {
+		return m.ListProjectAccountsAllWithContextFunc(ctx, p)
+	}
+	if m.ListProjectAccountsAllFunc != nil {
+		return m.ListProjectAccountsAllFunc(p)
+	}
+	r1 = notMocked("AccountService.ListProjectAccountsAllWithContext")
+	return
+}
+
+// NewLockAccountParams records the call and calls NewLockAccountParamsFunc
+func (m *MockAccountService) NewLockAccountParams(account string, domainid string) (r0 *cloudstack.LockAccountParams) {
+	m.record("NewLockAccountParams", account, domainid)
+	if m.NewLockAccountParamsFunc != nil {
+		return m.NewLockAccountParamsFunc(account, domainid)
+	}
+	return cloudstack.NewAccountService(nil).NewLockAccountParams(account, domainid)
+}
+
+// LockAccount records the call and calls LockAccountFunc, or LockAccountWithContextFunc if only that is set
+func (m *MockAccountService) LockAccount(p *cloudstack.LockAccountParams) (r0 *cloudstack.LockAccountResponse, r1 error) {
+	m.record("LockAccount", p)
+	if m.LockAccountFunc != nil {
+		return m.LockAccountFunc(p)
+	}
+	if m.LockAccountWithContextFunc != nil {
+		return m.LockAccountWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.LockAccount")
+	return
+}
+
+// LockAccountWithContext records the call and calls LockAccountWithContextFunc, or LockAccountFunc if only that is set
+func (m *MockAccountService) LockAccountWithContext(ctx context.Context, p *cloudstack.LockAccountParams) (r0 *cloudstack.LockAccountResponse, r1 error) {
+	m.record("LockAccountWithContext", ctx, p)
+	if m.LockAccountWithContextFunc != nil {
+		return m.LockAccountWithContextFunc(ctx, p)
+	}
+	if m.LockAccountFunc != nil {
+		return m.LockAccountFunc(p)
+	}
+	r1 = notMocked("AccountService.LockAccountWithContext")
+	return
+}
+
+// NewMarkDefaultZoneForAccountParams records the call and calls NewMarkDefaultZoneForAccountParamsFunc
+func (m *MockAccountService) NewMarkDefaultZoneForAccountParams(account string, domainid string, zoneid string) (r0 *cloudstack.MarkDefaultZoneForAccountParams) {
+	m.record("NewMarkDefaultZoneForAccountParams", account, domainid, zoneid)
+	if m.NewMarkDefaultZoneForAccountParamsFunc != nil {
+		return m.NewMarkDefaultZoneForAccountParamsFunc(account, domainid, zoneid)
+	}
+	return cloudstack.NewAccountService(nil).NewMarkDefaultZoneForAccountParams(account, domainid, zoneid)
+}
+
+// MarkDefaultZoneForAccount records the call and calls MarkDefaultZoneForAccountFunc, or MarkDefaultZoneForAccountWithContextFunc if only that is set
+func (m *MockAccountService) MarkDefaultZoneForAccount(p *cloudstack.MarkDefaultZoneForAccountParams) (r0 *cloudstack.MarkDefaultZoneForAccountResponse, r1 error) {
+	m.record("MarkDefaultZoneForAccount", p)
+	if m.MarkDefaultZoneForAccountFunc != nil {
+		return m.MarkDefaultZoneForAccountFunc(p)
+	}
+	if m.MarkDefaultZoneForAccountWithContextFunc != nil {
+		return m.MarkDefaultZoneForAccountWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.MarkDefaultZoneForAccount")
+	return
+}
+
+// MarkDefaultZoneForAccountWithContext records the call and calls MarkDefaultZoneForAccountWithContextFunc, or MarkDefaultZoneForAccountFunc if only that is set
+func (m *MockAccountService) MarkDefaultZoneForAccountWithContext(ctx context.Context, p *cloudstack.MarkDefaultZoneForAccountParams) (r0 *cloudstack.MarkDefaultZoneForAccountResponse, r1 error) {
+	m.record("MarkDefaultZoneForAccountWithContext", ctx, p)
+	if m.MarkDefaultZoneForAccountWithContextFunc != nil {
+		return m.MarkDefaultZoneForAccountWithContextFunc(ctx, p)
+	}
+	if m.MarkDefaultZoneForAccountFunc != nil {
+		return m.MarkDefaultZoneForAccountFunc(p)
+	}
+	r1 = notMocked("AccountService.MarkDefaultZoneForAccountWithContext")
+	return
+}
+
+// MarkDefaultZoneForAccountAsync records the call and calls MarkDefaultZoneForAccountAsyncFunc, or MarkDefaultZoneForAccountAsyncWithContextFunc if only that is set
+func (m *MockAccountService) MarkDefaultZoneForAccountAsync(p *cloudstack.MarkDefaultZoneForAccountParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MarkDefaultZoneForAccountAsync", p)
+	if m.MarkDefaultZoneForAccountAsyncFunc != nil {
+		return m.MarkDefaultZoneForAccountAsyncFunc(p)
+	}
+	if m.MarkDefaultZoneForAccountAsyncWithContextFunc != nil {
+		return m.MarkDefaultZoneForAccountAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.MarkDefaultZoneForAccountAsync")
+	return
+}
+
+// MarkDefaultZoneForAccountAsyncWithContext records the call and calls MarkDefaultZoneForAccountAsyncWithContextFunc, or MarkDefaultZoneForAccountAsyncFunc if only that is set
+func (m *MockAccountService) MarkDefaultZoneForAccountAsyncWithContext(ctx context.Context, p *cloudstack.MarkDefaultZoneForAccountParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MarkDefaultZoneForAccountAsyncWithContext", ctx, p)
+	if m.MarkDefaultZoneForAccountAsyncWithContextFunc != nil {
+		return m.MarkDefaultZoneForAccountAsyncWithContextFunc(ctx, p)
+	}
+	if m.MarkDefaultZoneForAccountAsyncFunc != nil {
+		return m.MarkDefaultZoneForAccountAsyncFunc(p)
+	}
+	r1 = notMocked("AccountService.MarkDefaultZoneForAccountAsyncWithContext")
+	return
+}
+
+// NewUpdateAccountParams records the call and calls NewUpdateAccountParamsFunc
+func (m *MockAccountService) NewUpdateAccountParams() (r0 *cloudstack.UpdateAccountParams) {
+	m.record("NewUpdateAccountParams")
+	if m.NewUpdateAccountParamsFunc != nil {
+		return m.NewUpdateAccountParamsFunc()
+	}
+	return cloudstack.NewAccountService(nil).NewUpdateAccountParams()
+}
+
+// UpdateAccount records the call and calls UpdateAccountFunc, or UpdateAccountWithContextFunc if only that is set
+func (m *MockAccountService) UpdateAccount(p *cloudstack.UpdateAccountParams) (r0 *cloudstack.UpdateAccountResponse, r1 error) {
+	m.record("UpdateAccount", p)
+	if m.UpdateAccountFunc != nil {
+		return m.UpdateAccountFunc(p)
+	}
+	if m.UpdateAccountWithContextFunc != nil {
+		return m.UpdateAccountWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AccountService.UpdateAccount")
+	return
+}
+
+// UpdateAccountWithContext records the call and calls UpdateAccountWithContextFunc, or UpdateAccountFunc if only that is set
+func (m *MockAccountService) UpdateAccountWithContext(ctx context.Context, p *cloudstack.UpdateAccountParams) (r0 *cloudstack.UpdateAccountResponse, r1 error) {
+	m.record("UpdateAccountWithContext", ctx, p)
+	if m.UpdateAccountWithContextFunc != nil {
+		return m.UpdateAccountWithContextFunc(ctx, p)
+	}
+	if m.UpdateAccountFunc != nil {
+		return m.UpdateAccountFunc(p)
+	}
+	r1 = notMocked("AccountService.UpdateAccountWithContext")
+	return
+}
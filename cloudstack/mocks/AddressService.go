@@ -0,0 +1,341 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockAddressService is a mock of cloudstack.AddressServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockAddressService struct {
+	Recorder
+
+	NewAssociateIpAddressParamsFunc           func() *cloudstack.AssociateIpAddressParams
+	AssociateIpAddressFunc                    func(p *cloudstack.AssociateIpAddressParams) (*cloudstack.AssociateIpAddressResponse, error)
+	AssociateIpAddressWithContextFunc         func(ctx context.Context, p *cloudstack.AssociateIpAddressParams) (*cloudstack.AssociateIpAddressResponse, error)
+	AssociateIpAddressAsyncFunc               func(p *cloudstack.AssociateIpAddressParams) (*cloudstack.Job, error)
+	AssociateIpAddressAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.AssociateIpAddressParams) (*cloudstack.Job, error)
+	NewDisassociateIpAddressParamsFunc        func(id string) *cloudstack.DisassociateIpAddressParams
+	DisassociateIpAddressFunc                 func(p *cloudstack.DisassociateIpAddressParams) (*cloudstack.DisassociateIpAddressResponse, error)
+	DisassociateIpAddressWithContextFunc      func(ctx context.Context, p *cloudstack.DisassociateIpAddressParams) (*cloudstack.DisassociateIpAddressResponse, error)
+	DisassociateIpAddressAsyncFunc            func(p *cloudstack.DisassociateIpAddressParams) (*cloudstack.Job, error)
+	DisassociateIpAddressAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DisassociateIpAddressParams) (*cloudstack.Job, error)
+	NewListPublicIpAddressesParamsFunc        func() *cloudstack.ListPublicIpAddressesParams
+	GetPublicIpAddressByIDFunc                func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.PublicIpAddress, int, error)
+	GetPublicIpAddressByIDWithContextFunc     func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.PublicIpAddress, int, error)
+	ListPublicIpAddressesFunc                 func(p *cloudstack.ListPublicIpAddressesParams) (*cloudstack.ListPublicIpAddressesResponse, error)
+	ListPublicIpAddressesWithContextFunc      func(ctx context.Context, p *cloudstack.ListPublicIpAddressesParams) (*cloudstack.ListPublicIpAddressesResponse, error)
+	NewListPublicIpAddressesPagerFunc         func(p *cloudstack.ListPublicIpAddressesParams) *cloudstack.ListPublicIpAddressesPager
+	ListPublicIpAddressesAllFunc              func(p *cloudstack.ListPublicIpAddressesParams) (*cloudstack.ListPublicIpAddressesResponse, error)
+	ListPublicIpAddressesAllWithContextFunc   func(ctx context.Context, p *cloudstack.ListPublicIpAddressesParams) (*cloudstack.ListPublicIpAddressesResponse, error)
+	NewUpdateIpAddressParamsFunc              func(id string) *cloudstack.UpdateIpAddressParams
+	UpdateIpAddressFunc                       func(p *cloudstack.UpdateIpAddressParams) (*cloudstack.UpdateIpAddressResponse, error)
+	UpdateIpAddressWithContextFunc            func(ctx context.Context, p *cloudstack.UpdateIpAddressParams) (*cloudstack.UpdateIpAddressResponse, error)
+	UpdateIpAddressAsyncFunc                  func(p *cloudstack.UpdateIpAddressParams) (*cloudstack.Job, error)
+	UpdateIpAddressAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.UpdateIpAddressParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.AddressServiceIface = (*MockAddressService)(nil)
+
+// NewAssociateIpAddressParams records the call and calls NewAssociateIpAddressParamsFunc
+func (m *MockAddressService) NewAssociateIpAddressParams() (r0 *cloudstack.AssociateIpAddressParams) {
+	m.record("NewAssociateIpAddressParams")
+	if m.NewAssociateIpAddressParamsFunc != nil {
+		return m.NewAssociateIpAddressParamsFunc()
+	}
+	return cloudstack.NewAddressService(nil).NewAssociateIpAddressParams()
+}
+
+// AssociateIpAddress records the call and calls AssociateIpAddressFunc, or AssociateIpAddressWithContextFunc if only that is set
+func (m *MockAddressService) AssociateIpAddress(p *cloudstack.AssociateIpAddressParams) (r0 *cloudstack.AssociateIpAddressResponse, r1 error) {
+	m.record("AssociateIpAddress", p)
+	if m.AssociateIpAddressFunc != nil {
+		return m.AssociateIpAddressFunc(p)
+	}
+	if m.AssociateIpAddressWithContextFunc != nil {
+		return m.AssociateIpAddressWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AddressService.AssociateIpAddress")
+	return
+}
+
+// AssociateIpAddressWithContext records the call and calls AssociateIpAddressWithContextFunc, or AssociateIpAddressFunc if only that is set
+func (m *MockAddressService) AssociateIpAddressWithContext(ctx context.Context, p *cloudstack.AssociateIpAddressParams) (r0 *cloudstack.AssociateIpAddressResponse, r1 error) {
+	m.record("AssociateIpAddressWithContext", ctx, p)
+	if m.AssociateIpAddressWithContextFunc != nil {
+		return m.AssociateIpAddressWithContextFunc(ctx, p)
+	}
+	if m.AssociateIpAddressFunc != nil {
+		return m.AssociateIpAddressFunc(p)
+	}
+	r1 = notMocked("AddressService.AssociateIpAddressWithContext")
+	return
+}
+
+// AssociateIpAddressAsync records the call and calls AssociateIpAddressAsyncFunc, or AssociateIpAddressAsyncWithContextFunc if only that is set
+func (m *MockAddressService) AssociateIpAddressAsync(p *cloudstack.AssociateIpAddressParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AssociateIpAddressAsync", p)
+	if m.AssociateIpAddressAsyncFunc != nil {
+		return m.AssociateIpAddressAsyncFunc(p)
+	}
+	if m.AssociateIpAddressAsyncWithContextFunc != nil {
+		return m.AssociateIpAddressAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AddressService.AssociateIpAddressAsync")
+	return
+}
+
+// AssociateIpAddressAsyncWithContext records the call and calls AssociateIpAddressAsyncWithContextFunc, or AssociateIpAddressAsyncFunc if only that is set
+func (m *MockAddressService) AssociateIpAddressAsyncWithContext(ctx context.Context, p *cloudstack.AssociateIpAddressParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AssociateIpAddressAsyncWithContext", ctx, p)
+	if m.AssociateIpAddressAsyncWithContextFunc != nil {
+		return m.AssociateIpAddressAsyncWithContextFunc(ctx, p)
+	}
+	if m.AssociateIpAddressAsyncFunc != nil {
+		return m.AssociateIpAddressAsyncFunc(p)
+	}
+	r1 = notMocked("AddressService.AssociateIpAddressAsyncWithContext")
+	return
+}
+
+// NewDisassociateIpAddressParams records the call and calls NewDisassociateIpAddressParamsFunc
+func (m *MockAddressService) NewDisassociateIpAddressParams(id string) (r0 *cloudstack.DisassociateIpAddressParams) {
+	m.record("NewDisassociateIpAddressParams", id)
+	if m.NewDisassociateIpAddressParamsFunc != nil {
+		return m.NewDisassociateIpAddressParamsFunc(id)
+	}
+	return cloudstack.NewAddressService(nil).NewDisassociateIpAddressParams(id)
+}
+
+// DisassociateIpAddress records the call and calls DisassociateIpAddressFunc, or DisassociateIpAddressWithContextFunc if only that is set
+func (m *MockAddressService) DisassociateIpAddress(p *cloudstack.DisassociateIpAddressParams) (r0 *cloudstack.DisassociateIpAddressResponse, r1 error) {
+	m.record("DisassociateIpAddress", p)
+	if m.DisassociateIpAddressFunc != nil {
+		return m.DisassociateIpAddressFunc(p)
+	}
+	if m.DisassociateIpAddressWithContextFunc != nil {
+		return m.DisassociateIpAddressWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AddressService.DisassociateIpAddress")
+	return
+}
+
+// DisassociateIpAddressWithContext records the call and calls DisassociateIpAddressWithContextFunc, or DisassociateIpAddressFunc if only that is set
+func (m *MockAddressService) DisassociateIpAddressWithContext(ctx context.Context, p *cloudstack.DisassociateIpAddressParams) (r0 *cloudstack.DisassociateIpAddressResponse, r1 error) {
+	m.record("DisassociateIpAddressWithContext", ctx, p)
+	if m.DisassociateIpAddressWithContextFunc != nil {
+		return m.DisassociateIpAddressWithContextFunc(ctx, p)
+	}
+	if m.DisassociateIpAddressFunc != nil {
+		return m.DisassociateIpAddressFunc(p)
+	}
+	r1 = notMocked("AddressService.DisassociateIpAddressWithContext")
+	return
+}
+
+// DisassociateIpAddressAsync records the call and calls DisassociateIpAddressAsyncFunc, or DisassociateIpAddressAsyncWithContextFunc if only that is set
+func (m *MockAddressService) DisassociateIpAddressAsync(p *cloudstack.DisassociateIpAddressParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisassociateIpAddressAsync", p)
+	if m.DisassociateIpAddressAsyncFunc != nil {
+		return m.DisassociateIpAddressAsyncFunc(p)
+	}
+	if m.DisassociateIpAddressAsyncWithContextFunc != nil {
+		return m.DisassociateIpAddressAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AddressService.DisassociateIpAddressAsync")
+	return
+}
+
+// DisassociateIpAddressAsyncWithContext records the call and calls DisassociateIpAddressAsyncWithContextFunc, or DisassociateIpAddressAsyncFunc if only that is set
+func (m *MockAddressService) DisassociateIpAddressAsyncWithContext(ctx context.Context, p *cloudstack.DisassociateIpAddressParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisassociateIpAddressAsyncWithContext", ctx, p)
+	if m.DisassociateIpAddressAsyncWithContextFunc != nil {
+		return m.DisassociateIpAddressAsyncWithContextFunc(ctx, p)
+	}
+	if m.DisassociateIpAddressAsyncFunc != nil {
+		return m.DisassociateIpAddressAsyncFunc(p)
+	}
+	r1 = notMocked("AddressService.DisassociateIpAddressAsyncWithContext")
+	return
+}
+
+// NewListPublicIpAddressesParams records the call and calls NewListPublicIpAddressesParamsFunc
+func (m *MockAddressService) NewListPublicIpAddressesParams() (r0 *cloudstack.ListPublicIpAddressesParams) {
+	m.record("NewListPublicIpAddressesParams")
+	if m.NewListPublicIpAddressesParamsFunc != nil {
+		return m.NewListPublicIpAddressesParamsFunc()
+	}
+	return cloudstack.NewAddressService(nil).NewListPublicIpAddressesParams()
+}
+
+// GetPublicIpAddressByID records the call and calls GetPublicIpAddressByIDFunc, or GetPublicIpAddressByIDWithContextFunc if only that is set
+func (m *MockAddressService) GetPublicIpAddressByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.PublicIpAddress, r1 int, r2 error) {
+	m.record("GetPublicIpAddressByID", id, opts)
+	if m.GetPublicIpAddressByIDFunc != nil {
+		return m.GetPublicIpAddressByIDFunc(id, opts...)
+	}
+	if m.GetPublicIpAddressByIDWithContextFunc != nil {
+		return m.GetPublicIpAddressByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("AddressService.GetPublicIpAddressByID")
+	return
+}
+
+// GetPublicIpAddressByIDWithContext records the call and calls GetPublicIpAddressByIDWithContextFunc, or GetPublicIpAddressByIDFunc if only that is set
+func (m *MockAddressService) GetPublicIpAddressByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.PublicIpAddress, r1 int, r2 error) {
+	m.record("GetPublicIpAddressByIDWithContext", ctx, id, opts)
+	if m.GetPublicIpAddressByIDWithContextFunc != nil {
+		return m.GetPublicIpAddressByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetPublicIpAddressByIDFunc != nil {
+		return m.GetPublicIpAddressByIDFunc(id, opts...)
+	}
+	r2 = notMocked("AddressService.GetPublicIpAddressByIDWithContext")
+	return
+}
+
+// ListPublicIpAddresses records the call and calls ListPublicIpAddressesFunc, or ListPublicIpAddressesWithContextFunc if only that is set
+func (m *MockAddressService) ListPublicIpAddresses(p *cloudstack.ListPublicIpAddressesParams) (r0 *cloudstack.ListPublicIpAddressesResponse, r1 error) {
+	m.record("ListPublicIpAddresses", p)
+	if m.ListPublicIpAddressesFunc != nil {
+		return m.ListPublicIpAddressesFunc(p)
+	}
+	if m.ListPublicIpAddressesWithContextFunc != nil {
+		return m.ListPublicIpAddressesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AddressService.ListPublicIpAddresses")
+	return
+}
+
+// ListPublicIpAddressesWithContext records the call and calls ListPublicIpAddressesWithContextFunc, or ListPublicIpAddressesFunc if only that is set
+func (m *MockAddressService) ListPublicIpAddressesWithContext(ctx context.Context, p *cloudstack.ListPublicIpAddressesParams) (r0 *cloudstack.ListPublicIpAddressesResponse, r1 error) {
+	m.record("ListPublicIpAddressesWithContext", ctx, p)
+	if m.ListPublicIpAddressesWithContextFunc != nil {
+		return m.ListPublicIpAddressesWithContextFunc(ctx, p)
+	}
+	if m.ListPublicIpAddressesFunc != nil {
+		return m.ListPublicIpAddressesFunc(p)
+	}
+	r1 = notMocked("AddressService.ListPublicIpAddressesWithContext")
+	return
+}
+
+// NewListPublicIpAddressesPager records the call and calls NewListPublicIpAddressesPagerFunc
+func (m *MockAddressService) NewListPublicIpAddressesPager(p *cloudstack.ListPublicIpAddressesParams) (r0 *cloudstack.ListPublicIpAddressesPager) {
+	m.record("NewListPublicIpAddressesPager", p)
+	if m.NewListPublicIpAddressesPagerFunc != nil {
+		return m.NewListPublicIpAddressesPagerFunc(p)
+	}
+	return
+}
+
+// ListPublicIpAddressesAll records the call and calls ListPublicIpAddressesAllFunc, or ListPublicIpAddressesAllWithContextFunc if only that is set
+func (m *MockAddressService) ListPublicIpAddressesAll(p *cloudstack.ListPublicIpAddressesParams) (r0 *cloudstack.ListPublicIpAddressesResponse, r1 error) {
+	m.record("ListPublicIpAddressesAll", p)
+	if m.ListPublicIpAddressesAllFunc != nil {
+		return m.ListPublicIpAddressesAllFunc(p)
+	}
+	if m.ListPublicIpAddressesAllWithContextFunc != nil {
+		return m.ListPublicIpAddressesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AddressService.ListPublicIpAddressesAll")
+	return
+}
+
+// ListPublicIpAddressesAllWithContext records the call and calls ListPublicIpAddressesAllWithContextFunc, or ListPublicIpAddressesAllFunc if only that is set
+func (m *MockAddressService) ListPublicIpAddressesAllWithContext(ctx context.Context, p *cloudstack.ListPublicIpAddressesParams) (r0 *cloudstack.ListPublicIpAddressesResponse, r1 error) {
+	m.record("ListPublicIpAddressesAllWithContext", ctx, p)
+	if m.ListPublicIpAddressesAllWithContextFunc != nil {
+		return m.ListPublicIpAddressesAllWithContextFunc(ctx, p)
+	}
+	if m.ListPublicIpAddressesAllFunc != nil {
+		return m.ListPublicIpAddressesAllFunc(p)
+	}
+	r1 = notMocked("AddressService.ListPublicIpAddressesAllWithContext")
+	return
+}
+
+// NewUpdateIpAddressParams records the call and calls NewUpdateIpAddressParamsFunc
+func (m *MockAddressService) NewUpdateIpAddressParams(id string) (r0 *cloudstack.UpdateIpAddressParams) {
+	m.record("NewUpdateIpAddressParams", id)
+	if m.NewUpdateIpAddressParamsFunc != nil {
+		return m.NewUpdateIpAddressParamsFunc(id)
+	}
+	return cloudstack.NewAddressService(nil).NewUpdateIpAddressParams(id)
+}
+
+// UpdateIpAddress records the call and calls UpdateIpAddressFunc, or UpdateIpAddressWithContextFunc if only that is set
+func (m *MockAddressService) UpdateIpAddress(p *cloudstack.UpdateIpAddressParams) (r0 *cloudstack.UpdateIpAddressResponse, r1 error) {
+	m.record("UpdateIpAddress", p)
+	if m.UpdateIpAddressFunc != nil {
+		return m.UpdateIpAddressFunc(p)
+	}
+	if m.UpdateIpAddressWithContextFunc != nil {
+		return m.UpdateIpAddressWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AddressService.UpdateIpAddress")
+	return
+}
+
+// UpdateIpAddressWithContext records the call and calls UpdateIpAddressWithContextFunc, or UpdateIpAddressFunc if only that is set
+func (m *MockAddressService) UpdateIpAddressWithContext(ctx context.Context, p *cloudstack.UpdateIpAddressParams) (r0 *cloudstack.UpdateIpAddressResponse, r1 error) {
+	m.record("UpdateIpAddressWithContext", ctx, p)
+	if m.UpdateIpAddressWithContextFunc != nil {
+		return m.UpdateIpAddressWithContextFunc(ctx, p)
+	}
+	if m.UpdateIpAddressFunc != nil {
+		return m.UpdateIpAddressFunc(p)
+	}
+	r1 = notMocked("AddressService.UpdateIpAddressWithContext")
+	return
+}
+
+// UpdateIpAddressAsync records the call and calls UpdateIpAddressAsyncFunc, or UpdateIpAddressAsyncWithContextFunc if only that is set
+func (m *MockAddressService) UpdateIpAddressAsync(p *cloudstack.UpdateIpAddressParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateIpAddressAsync", p)
+	if m.UpdateIpAddressAsyncFunc != nil {
+		return m.UpdateIpAddressAsyncFunc(p)
+	}
+	if m.UpdateIpAddressAsyncWithContextFunc != nil {
+		return m.UpdateIpAddressAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AddressService.UpdateIpAddressAsync")
+	return
+}
+
+// UpdateIpAddressAsyncWithContext records the call and calls UpdateIpAddressAsyncWithContextFunc, or UpdateIpAddressAsyncFunc if only that is set
+func (m *MockAddressService) UpdateIpAddressAsyncWithContext(ctx context.Context, p *cloudstack.UpdateIpAddressParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateIpAddressAsyncWithContext", ctx, p)
+	if m.UpdateIpAddressAsyncWithContextFunc != nil {
+		return m.UpdateIpAddressAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateIpAddressAsyncFunc != nil {
+		return m.UpdateIpAddressAsyncFunc(p)
+	}
+	r1 = notMocked("AddressService.UpdateIpAddressAsyncWithContext")
+	return
+}
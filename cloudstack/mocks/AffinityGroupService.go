@@ -0,0 +1,473 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockAffinityGroupService is a mock of cloudstack.AffinityGroupServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockAffinityGroupService struct {
+	Recorder
+
+	NewCreateAffinityGroupParamsFunc          func(name string, affinityGroupType string) *cloudstack.CreateAffinityGroupParams
+	CreateAffinityGroupFunc                   func(p *cloudstack.CreateAffinityGroupParams) (*cloudstack.CreateAffinityGroupResponse, error)
+	CreateAffinityGroupWithContextFunc        func(ctx context.Context, p *cloudstack.CreateAffinityGroupParams) (*cloudstack.CreateAffinityGroupResponse, error)
+	CreateAffinityGroupAsyncFunc              func(p *cloudstack.CreateAffinityGroupParams) (*cloudstack.Job, error)
+	CreateAffinityGroupAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.CreateAffinityGroupParams) (*cloudstack.Job, error)
+	NewDeleteAffinityGroupParamsFunc          func() *cloudstack.DeleteAffinityGroupParams
+	DeleteAffinityGroupFunc                   func(p *cloudstack.DeleteAffinityGroupParams) (*cloudstack.DeleteAffinityGroupResponse, error)
+	DeleteAffinityGroupWithContextFunc        func(ctx context.Context, p *cloudstack.DeleteAffinityGroupParams) (*cloudstack.DeleteAffinityGroupResponse, error)
+	DeleteAffinityGroupAsyncFunc              func(p *cloudstack.DeleteAffinityGroupParams) (*cloudstack.Job, error)
+	DeleteAffinityGroupAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.DeleteAffinityGroupParams) (*cloudstack.Job, error)
+	NewListAffinityGroupTypesParamsFunc       func() *cloudstack.ListAffinityGroupTypesParams
+	ListAffinityGroupTypesFunc                func(p *cloudstack.ListAffinityGroupTypesParams) (*cloudstack.ListAffinityGroupTypesResponse, error)
+	ListAffinityGroupTypesWithContextFunc     func(ctx context.Context, p *cloudstack.ListAffinityGroupTypesParams) (*cloudstack.ListAffinityGroupTypesResponse, error)
+	NewListAffinityGroupTypesPagerFunc        func(p *cloudstack.ListAffinityGroupTypesParams) *cloudstack.ListAffinityGroupTypesPager
+	ListAffinityGroupTypesAllFunc             func(p *cloudstack.ListAffinityGroupTypesParams) (*cloudstack.ListAffinityGroupTypesResponse, error)
+	ListAffinityGroupTypesAllWithContextFunc  func(ctx context.Context, p *cloudstack.ListAffinityGroupTypesParams) (*cloudstack.ListAffinityGroupTypesResponse, error)
+	NewListAffinityGroupsParamsFunc           func() *cloudstack.ListAffinityGroupsParams
+	GetAffinityGroupIDFunc                    func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetAffinityGroupIDWithContextFunc         func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetAffinityGroupByNameFunc                func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.AffinityGroup, int, error)
+	GetAffinityGroupByNameWithContextFunc     func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.AffinityGroup, int, error)
+	GetAffinityGroupByIDFunc                  func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.AffinityGroup, int, error)
+	GetAffinityGroupByIDWithContextFunc       func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.AffinityGroup, int, error)
+	ListAffinityGroupsFunc                    func(p *cloudstack.ListAffinityGroupsParams) (*cloudstack.ListAffinityGroupsResponse, error)
+	ListAffinityGroupsWithContextFunc         func(ctx context.Context, p *cloudstack.ListAffinityGroupsParams) (*cloudstack.ListAffinityGroupsResponse, error)
+	NewListAffinityGroupsPagerFunc            func(p *cloudstack.ListAffinityGroupsParams) *cloudstack.ListAffinityGroupsPager
+	ListAffinityGroupsAllFunc                 func(p *cloudstack.ListAffinityGroupsParams) (*cloudstack.ListAffinityGroupsResponse, error)
+	ListAffinityGroupsAllWithContextFunc      func(ctx context.Context, p *cloudstack.ListAffinityGroupsParams) (*cloudstack.ListAffinityGroupsResponse, error)
+	NewUpdateVMAffinityGroupParamsFunc        func(id string) *cloudstack.UpdateVMAffinityGroupParams
+	UpdateVMAffinityGroupFunc                 func(p *cloudstack.UpdateVMAffinityGroupParams) (*cloudstack.UpdateVMAffinityGroupResponse, error)
+	UpdateVMAffinityGroupWithContextFunc      func(ctx context.Context, p *cloudstack.UpdateVMAffinityGroupParams) (*cloudstack.UpdateVMAffinityGroupResponse, error)
+	UpdateVMAffinityGroupAsyncFunc            func(p *cloudstack.UpdateVMAffinityGroupParams) (*cloudstack.Job, error)
+	UpdateVMAffinityGroupAsyncWithContextFunc func(ctx context.Context, p *cloudstack.UpdateVMAffinityGroupParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.AffinityGroupServiceIface = (*MockAffinityGroupService)(nil)
+
+// NewCreateAffinityGroupParams records the call and calls NewCreateAffinityGroupParamsFunc
+func (m *MockAffinityGroupService) NewCreateAffinityGroupParams(name string, affinityGroupType string) (r0 *cloudstack.CreateAffinityGroupParams) {
+	m.record("NewCreateAffinityGroupParams", name, affinityGroupType)
+	if m.NewCreateAffinityGroupParamsFunc != nil {
+		return m.NewCreateAffinityGroupParamsFunc(name, affinityGroupType)
+	}
+	return cloudstack.NewAffinityGroupService(nil).NewCreateAffinityGroupParams(name, affinityGroupType)
+}
+
+// CreateAffinityGroup records the call and calls CreateAffinityGroupFunc, or CreateAffinityGroupWithContextFunc if only that is set
+func (m *MockAffinityGroupService) CreateAffinityGroup(p *cloudstack.CreateAffinityGroupParams) (r0 *cloudstack.CreateAffinityGroupResponse, r1 error) {
+	m.record("CreateAffinityGroup", p)
+	if m.CreateAffinityGroupFunc != nil {
+		return m.CreateAffinityGroupFunc(p)
+	}
+	if m.CreateAffinityGroupWithContextFunc != nil {
+		return m.CreateAffinityGroupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AffinityGroupService.CreateAffinityGroup")
+	return
+}
+
+// CreateAffinityGroupWithContext records the call and calls CreateAffinityGroupWithContextFunc, or CreateAffinityGroupFunc if only that is set
+func (m *MockAffinityGroupService) CreateAffinityGroupWithContext(ctx context.Context, p *cloudstack.CreateAffinityGroupParams) (r0 *cloudstack.CreateAffinityGroupResponse, r1 error) {
+	m.record("CreateAffinityGroupWithContext", ctx, p)
+	if m.CreateAffinityGroupWithContextFunc != nil {
+		return m.CreateAffinityGroupWithContextFunc(ctx, p)
+	}
+	if m.CreateAffinityGroupFunc != nil {
+		return m.CreateAffinityGroupFunc(p)
+	}
+	r1 = notMocked("AffinityGroupService.CreateAffinityGroupWithContext")
+	return
+}
+
+// CreateAffinityGroupAsync records the call and calls CreateAffinityGroupAsyncFunc, or CreateAffinityGroupAsyncWithContextFunc if only that is set
+func (m *MockAffinityGroupService) CreateAffinityGroupAsync(p *cloudstack.CreateAffinityGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateAffinityGroupAsync", p)
+	if m.CreateAffinityGroupAsyncFunc != nil {
+		return m.CreateAffinityGroupAsyncFunc(p)
+	}
+	if m.CreateAffinityGroupAsyncWithContextFunc != nil {
+		return m.CreateAffinityGroupAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AffinityGroupService.CreateAffinityGroupAsync")
+	return
+}
+
+// CreateAffinityGroupAsyncWithContext records the call and calls CreateAffinityGroupAsyncWithContextFunc, or CreateAffinityGroupAsyncFunc if only that is set
+func (m *MockAffinityGroupService) CreateAffinityGroupAsyncWithContext(ctx context.Context, p *cloudstack.CreateAffinityGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateAffinityGroupAsyncWithContext", ctx, p)
+	if m.CreateAffinityGroupAsyncWithContextFunc != nil {
+		return m.CreateAffinityGroupAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateAffinityGroupAsyncFunc != nil {
+		return m.CreateAffinityGroupAsyncFunc(p)
+	}
+	r1 = notMocked("AffinityGroupService.CreateAffinityGroupAsyncWithContext")
+	return
+}
+
+// NewDeleteAffinityGroupParams records the call and calls NewDeleteAffinityGroupParamsFunc
+func (m *MockAffinityGroupService) NewDeleteAffinityGroupParams() (r0 *cloudstack.DeleteAffinityGroupParams) {
+	m.record("NewDeleteAffinityGroupParams")
+	if m.NewDeleteAffinityGroupParamsFunc != nil {
+		return m.NewDeleteAffinityGroupParamsFunc()
+	}
+	return cloudstack.NewAffinityGroupService(nil).NewDeleteAffinityGroupParams()
+}
+
+// DeleteAffinityGroup records the call and calls DeleteAffinityGroupFunc, or DeleteAffinityGroupWithContextFunc if only that is set
+func (m *MockAffinityGroupService) DeleteAffinityGroup(p *cloudstack.DeleteAffinityGroupParams) (r0 *cloudstack.DeleteAffinityGroupResponse, r1 error) {
+	m.record("DeleteAffinityGroup", p)
+	if m.DeleteAffinityGroupFunc != nil {
+		return m.DeleteAffinityGroupFunc(p)
+	}
+	if m.DeleteAffinityGroupWithContextFunc != nil {
+		return m.DeleteAffinityGroupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AffinityGroupService.DeleteAffinityGroup")
+	return
+}
+
+// DeleteAffinityGroupWithContext records the call and calls DeleteAffinityGroupWithContextFunc, or DeleteAffinityGroupFunc if only that is set
+func (m *MockAffinityGroupService) DeleteAffinityGroupWithContext(ctx context.Context, p *cloudstack.DeleteAffinityGroupParams) (r0 *cloudstack.DeleteAffinityGroupResponse, r1 error) {
+	m.record("DeleteAffinityGroupWithContext", ctx, p)
+	if m.DeleteAffinityGroupWithContextFunc != nil {
+		return m.DeleteAffinityGroupWithContextFunc(ctx, p)
+	}
+	if m.DeleteAffinityGroupFunc != nil {
+		return m.DeleteAffinityGroupFunc(p)
+	}
+	r1 = notMocked("AffinityGroupService.DeleteAffinityGroupWithContext")
+	return
+}
+
+// DeleteAffinityGroupAsync records the call and calls DeleteAffinityGroupAsyncFunc, or DeleteAffinityGroupAsyncWithContextFunc if only that is set
+func (m *MockAffinityGroupService) DeleteAffinityGroupAsync(p *cloudstack.DeleteAffinityGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteAffinityGroupAsync", p)
+	if m.DeleteAffinityGroupAsyncFunc != nil {
+		return m.DeleteAffinityGroupAsyncFunc(p)
+	}
+	if m.DeleteAffinityGroupAsyncWithContextFunc != nil {
+		return m.DeleteAffinityGroupAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AffinityGroupService.DeleteAffinityGroupAsync")
+	return
+}
+
+// DeleteAffinityGroupAsyncWithContext records the call and calls DeleteAffinityGroupAsyncWithContextFunc, or DeleteAffinityGroupAsyncFunc if only that is set
+func (m *MockAffinityGroupService) DeleteAffinityGroupAsyncWithContext(ctx context.Context, p *cloudstack.DeleteAffinityGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteAffinityGroupAsyncWithContext", ctx, p)
+	if m.DeleteAffinityGroupAsyncWithContextFunc != nil {
+		return m.DeleteAffinityGroupAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteAffinityGroupAsyncFunc != nil {
+		return m.DeleteAffinityGroupAsyncFunc(p)
+	}
+	r1 = notMocked("AffinityGroupService.DeleteAffinityGroupAsyncWithContext")
+	return
+}
+
+// NewListAffinityGroupTypesParams records the call and calls NewListAffinityGroupTypesParamsFunc
+func (m *MockAffinityGroupService) NewListAffinityGroupTypesParams() (r0 *cloudstack.ListAffinityGroupTypesParams) {
+	m.record("NewListAffinityGroupTypesParams")
+	if m.NewListAffinityGroupTypesParamsFunc != nil {
+		return m.NewListAffinityGroupTypesParamsFunc()
+	}
+	return cloudstack.NewAffinityGroupService(nil).NewListAffinityGroupTypesParams()
+}
+
+// ListAffinityGroupTypes records the call and calls ListAffinityGroupTypesFunc, or ListAffinityGroupTypesWithContextFunc if only that is set
+func (m *MockAffinityGroupService) ListAffinityGroupTypes(p *cloudstack.ListAffinityGroupTypesParams) (r0 *cloudstack.ListAffinityGroupTypesResponse, r1 error) {
+	m.record("ListAffinityGroupTypes", p)
+	if m.ListAffinityGroupTypesFunc != nil {
+		return m.ListAffinityGroupTypesFunc(p)
+	}
+	if m.ListAffinityGroupTypesWithContextFunc != nil {
+		return m.ListAffinityGroupTypesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AffinityGroupService.ListAffinityGroupTypes")
+	return
+}
+
+// ListAffinityGroupTypesWithContext records the call and calls ListAffinityGroupTypesWithContextFunc, or ListAffinityGroupTypesFunc if only that is set
+func (m *MockAffinityGroupService) ListAffinityGroupTypesWithContext(ctx context.Context, p *cloudstack.ListAffinityGroupTypesParams) (r0 *cloudstack.ListAffinityGroupTypesResponse, r1 error) {
+	m.record("ListAffinityGroupTypesWithContext", ctx, p)
+	if m.ListAffinityGroupTypesWithContextFunc != nil {
+		return m.ListAffinityGroupTypesWithContextFunc(ctx, p)
+	}
+	if m.ListAffinityGroupTypesFunc != nil {
+		return m.ListAffinityGroupTypesFunc(p)
+	}
+	r1 = notMocked("AffinityGroupService.ListAffinityGroupTypesWithContext")
+	return
+}
+
+// NewListAffinityGroupTypesPager records the call and calls NewListAffinityGroupTypesPagerFunc
+func (m *MockAffinityGroupService) NewListAffinityGroupTypesPager(p *cloudstack.ListAffinityGroupTypesParams) (r0 *cloudstack.ListAffinityGroupTypesPager) {
+	m.record("NewListAffinityGroupTypesPager", p)
+	if m.NewListAffinityGroupTypesPagerFunc != nil {
+		return m.NewListAffinityGroupTypesPagerFunc(p)
+	}
+	return
+}
+
+// ListAffinityGroupTypesAll records the call and calls ListAffinityGroupTypesAllFunc, or ListAffinityGroupTypesAllWithContextFunc if only that is set
+func (m *MockAffinityGroupService) ListAffinityGroupTypesAll(p *cloudstack.ListAffinityGroupTypesParams) (r0 *cloudstack.ListAffinityGroupTypesResponse, r1 error) {
+	m.record("ListAffinityGroupTypesAll", p)
+	if m.ListAffinityGroupTypesAllFunc != nil {
+		return m.ListAffinityGroupTypesAllFunc(p)
+	}
+	if m.ListAffinityGroupTypesAllWithContextFunc != nil {
+		return m.ListAffinityGroupTypesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AffinityGroupService.ListAffinityGroupTypesAll")
+	return
+}
+
+// ListAffinityGroupTypesAllWithContext records the call and calls ListAffinityGroupTypesAllWithContextFunc, or ListAffinityGroupTypesAllFunc if only that is set
+func (m *MockAffinityGroupService) ListAffinityGroupTypesAllWithContext(ctx context.Context, p *cloudstack.ListAffinityGroupTypesParams) (r0 *cloudstack.ListAffinityGroupTypesResponse, r1 error) {
+	m.record("ListAffinityGroupTypesAllWithContext", ctx, p)
+	if m.ListAffinityGroupTypesAllWithContextFunc != nil {
+		return m.ListAffinityGroupTypesAllWithContextFunc(ctx, p)
+	}
+	if m.ListAffinityGroupTypesAllFunc != nil {
+		return m.ListAffinityGroupTypesAllFunc(p)
+	}
+	r1 = notMocked("AffinityGroupService.ListAffinityGroupTypesAllWithContext")
+	return
+}
+
+// NewListAffinityGroupsParams records the call and calls NewListAffinityGroupsParamsFunc
+func (m *MockAffinityGroupService) NewListAffinityGroupsParams() (r0 *cloudstack.ListAffinityGroupsParams) {
+	m.record("NewListAffinityGroupsParams")
+	if m.NewListAffinityGroupsParamsFunc != nil {
+		return m.NewListAffinityGroupsParamsFunc()
+	}
+	return cloudstack.NewAffinityGroupService(nil).NewListAffinityGroupsParams()
+}
+
+// GetAffinityGroupID records the call and calls GetAffinityGroupIDFunc, or GetAffinityGroupIDWithContextFunc if only that is set
+func (m *MockAffinityGroupService) GetAffinityGroupID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetAffinityGroupID", name, opts)
+	if m.GetAffinityGroupIDFunc != nil {
+		return m.GetAffinityGroupIDFunc(name, opts...)
+	}
+	if m.GetAffinityGroupIDWithContextFunc != nil {
+		return m.GetAffinityGroupIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("AffinityGroupService.GetAffinityGroupID")
+	return
+}
+
+// GetAffinityGroupIDWithContext records the call and calls GetAffinityGroupIDWithContextFunc, or GetAffinityGroupIDFunc if only that is set
+func (m *MockAffinityGroupService) GetAffinityGroupIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetAffinityGroupIDWithContext", ctx, name, opts)
+	if m.GetAffinityGroupIDWithContextFunc != nil {
+		return m.GetAffinityGroupIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetAffinityGroupIDFunc != nil {
+		return m.GetAffinityGroupIDFunc(name, opts...)
+	}
+	r2 = notMocked("AffinityGroupService.GetAffinityGroupIDWithContext")
+	return
+}
+
+// GetAffinityGroupByName records the call and calls GetAffinityGroupByNameFunc, or GetAffinityGroupByNameWithContextFunc if only that is set
+func (m *MockAffinityGroupService) GetAffinityGroupByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.AffinityGroup, r1 int, r2 error) {
+	m.record("GetAffinityGroupByName", name, opts)
+	if m.GetAffinityGroupByNameFunc != nil {
+		return m.GetAffinityGroupByNameFunc(name, opts...)
+	}
+	if m.GetAffinityGroupByNameWithContextFunc != nil {
+		return m.GetAffinityGroupByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("AffinityGroupService.GetAffinityGroupByName")
+	return
+}
+
+// GetAffinityGroupByNameWithContext records the call and calls GetAffinityGroupByNameWithContextFunc, or GetAffinityGroupByNameFunc if only that is set
+func (m *MockAffinityGroupService) GetAffinityGroupByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.AffinityGroup, r1 int, r2 error) {
+	m.record("GetAffinityGroupByNameWithContext", ctx, name, opts)
+	if m.GetAffinityGroupByNameWithContextFunc != nil {
+		return m.GetAffinityGroupByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetAffinityGroupByNameFunc != nil {
+		return m.GetAffinityGroupByNameFunc(name, opts...)
+	}
+	r2 = notMocked("AffinityGroupService.GetAffinityGroupByNameWithContext")
+	return
+}
+
+// GetAffinityGroupByID records the call and calls GetAffinityGroupByIDFunc, or GetAffinityGroupByIDWithContextFunc if only that is set
+func (m *MockAffinityGroupService) GetAffinityGroupByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.AffinityGroup, r1 int, r2 error) {
+	m.record("GetAffinityGroupByID", id, opts)
+	if m.GetAffinityGroupByIDFunc != nil {
+		return m.GetAffinityGroupByIDFunc(id, opts...)
+	}
+	if m.GetAffinityGroupByIDWithContextFunc != nil {
+		return m.GetAffinityGroupByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("AffinityGroupService.GetAffinityGroupByID")
+	return
+}
+
+// GetAffinityGroupByIDWithContext records the call and calls GetAffinityGroupByIDWithContextFunc, or GetAffinityGroupByIDFunc if only that is set
+func (m *MockAffinityGroupService) GetAffinityGroupByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.AffinityGroup, r1 int, r2 error) {
+	m.record("GetAffinityGroupByIDWithContext", ctx, id, opts)
+	if m.GetAffinityGroupByIDWithContextFunc != nil {
+		return m.GetAffinityGroupByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetAffinityGroupByIDFunc != nil {
+		return m.GetAffinityGroupByIDFunc(id, opts...)
+	}
+	r2 = notMocked("AffinityGroupService.GetAffinityGroupByIDWithContext")
+	return
+}
+
+// ListAffinityGroups records the call and calls ListAffinityGroupsFunc, or ListAffinityGroupsWithContextFunc if only that is set
+func (m *MockAffinityGroupService) ListAffinityGroups(p *cloudstack.ListAffinityGroupsParams) (r0 *cloudstack.ListAffinityGroupsResponse, r1 error) {
+	m.record("ListAffinityGroups", p)
+	if m.ListAffinityGroupsFunc != nil {
+		return m.ListAffinityGroupsFunc(p)
+	}
+	if m.ListAffinityGroupsWithContextFunc != nil {
+		return m.ListAffinityGroupsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AffinityGroupService.ListAffinityGroups")
+	return
+}
+
+// ListAffinityGroupsWithContext records the call and calls ListAffinityGroupsWithContextFunc, or ListAffinityGroupsFunc if only that is set
+func (m *MockAffinityGroupService) ListAffinityGroupsWithContext(ctx context.Context, p *cloudstack.ListAffinityGroupsParams) (r0 *cloudstack.ListAffinityGroupsResponse, r1 error) {
+	m.record("ListAffinityGroupsWithContext", ctx, p)
+	if m.ListAffinityGroupsWithContextFunc != nil {
+		return m.ListAffinityGroupsWithContextFunc(ctx, p)
+	}
+	if m.ListAffinityGroupsFunc != nil {
+		return m.ListAffinityGroupsFunc(p)
+	}
+	r1 = notMocked("AffinityGroupService.ListAffinityGroupsWithContext")
+	return
+}
+
+// NewListAffinityGroupsPager records the call and calls NewListAffinityGroupsPagerFunc
+func (m *MockAffinityGroupService) NewListAffinityGroupsPager(p *cloudstack.ListAffinityGroupsParams) (r0 *cloudstack.ListAffinityGroupsPager) {
+	m.record("NewListAffinityGroupsPager", p)
+	if m.NewListAffinityGroupsPagerFunc != nil {
+		return m.NewListAffinityGroupsPagerFunc(p)
+	}
+	return
+}
+
+// ListAffinityGroupsAll records the call and calls ListAffinityGroupsAllFunc, or ListAffinityGroupsAllWithContextFunc if only that is set
+func (m *MockAffinityGroupService) ListAffinityGroupsAll(p *cloudstack.ListAffinityGroupsParams) (r0 *cloudstack.ListAffinityGroupsResponse, r1 error) {
+	m.record("ListAffinityGroupsAll", p)
+	if m.ListAffinityGroupsAllFunc != nil {
+		return m.ListAffinityGroupsAllFunc(p)
+	}
+	if m.ListAffinityGroupsAllWithContextFunc != nil {
+		return m.ListAffinityGroupsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AffinityGroupService.ListAffinityGroupsAll")
+	return
+}
+
+// ListAffinityGroupsAllWithContext records the call and calls ListAffinityGroupsAllWithContextFunc, or ListAffinityGroupsAllFunc if only that is set
+func (m *MockAffinityGroupService) ListAffinityGroupsAllWithContext(ctx context.Context, p *cloudstack.ListAffinityGroupsParams) (r0 *cloudstack.ListAffinityGroupsResponse, r1 error) {
+	m.record("ListAffinityGroupsAllWithContext", ctx, p)
+	if m.ListAffinityGroupsAllWithContextFunc != nil {
+		return m.ListAffinityGroupsAllWithContextFunc(ctx, p)
+	}
+	if m.ListAffinityGroupsAllFunc != nil {
+		return m.ListAffinityGroupsAllFunc(p)
+	}
+	r1 = notMocked("AffinityGroupService.ListAffinityGroupsAllWithContext")
+	return
+}
+
+// NewUpdateVMAffinityGroupParams records the call and calls NewUpdateVMAffinityGroupParamsFunc
+func (m *MockAffinityGroupService) NewUpdateVMAffinityGroupParams(id string) (r0 *cloudstack.UpdateVMAffinityGroupParams) {
+	m.record("NewUpdateVMAffinityGroupParams", id)
+	if m.NewUpdateVMAffinityGroupParamsFunc != nil {
+		return m.NewUpdateVMAffinityGroupParamsFunc(id)
+	}
+	return cloudstack.NewAffinityGroupService(nil).NewUpdateVMAffinityGroupParams(id)
+}
+
+// UpdateVMAffinityGroup records the call and calls UpdateVMAffinityGroupFunc, or UpdateVMAffinityGroupWithContextFunc if only that is set
+func (m *MockAffinityGroupService) UpdateVMAffinityGroup(p *cloudstack.UpdateVMAffinityGroupParams) (r0 *cloudstack.UpdateVMAffinityGroupResponse, r1 error) {
+	m.record("UpdateVMAffinityGroup", p)
+	if m.UpdateVMAffinityGroupFunc != nil {
+		return m.UpdateVMAffinityGroupFunc(p)
+	}
+	if m.UpdateVMAffinityGroupWithContextFunc != nil {
+		return m.UpdateVMAffinityGroupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AffinityGroupService.UpdateVMAffinityGroup")
+	return
+}
+
+// UpdateVMAffinityGroupWithContext records the call and calls UpdateVMAffinityGroupWithContextFunc, or UpdateVMAffinityGroupFunc if only that is set
+func (m *MockAffinityGroupService) UpdateVMAffinityGroupWithContext(ctx context.Context, p *cloudstack.UpdateVMAffinityGroupParams) (r0 *cloudstack.UpdateVMAffinityGroupResponse, r1 error) {
+	m.record("UpdateVMAffinityGroupWithContext", ctx, p)
+	if m.UpdateVMAffinityGroupWithContextFunc != nil {
+		return m.UpdateVMAffinityGroupWithContextFunc(ctx, p)
+	}
+	if m.UpdateVMAffinityGroupFunc != nil {
+		return m.UpdateVMAffinityGroupFunc(p)
+	}
+	r1 = notMocked("AffinityGroupService.UpdateVMAffinityGroupWithContext")
+	return
+}
+
+// UpdateVMAffinityGroupAsync records the call and calls UpdateVMAffinityGroupAsyncFunc, or UpdateVMAffinityGroupAsyncWithContextFunc if only that is set
+func (m *MockAffinityGroupService) UpdateVMAffinityGroupAsync(p *cloudstack.UpdateVMAffinityGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVMAffinityGroupAsync", p)
+	if m.UpdateVMAffinityGroupAsyncFunc != nil {
+		return m.UpdateVMAffinityGroupAsyncFunc(p)
+	}
+	if m.UpdateVMAffinityGroupAsyncWithContextFunc != nil {
+		return m.UpdateVMAffinityGroupAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AffinityGroupService.UpdateVMAffinityGroupAsync")
+	return
+}
+
+// UpdateVMAffinityGroupAsyncWithContext records the call and calls UpdateVMAffinityGroupAsyncWithContextFunc, or UpdateVMAffinityGroupAsyncFunc if only that is set
+func (m *MockAffinityGroupService) UpdateVMAffinityGroupAsyncWithContext(ctx context.Context, p *cloudstack.UpdateVMAffinityGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVMAffinityGroupAsyncWithContext", ctx, p)
+	if m.UpdateVMAffinityGroupAsyncWithContextFunc != nil {
+		return m.UpdateVMAffinityGroupAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateVMAffinityGroupAsyncFunc != nil {
+		return m.UpdateVMAffinityGroupAsyncFunc(p)
+	}
+	r1 = notMocked("AffinityGroupService.UpdateVMAffinityGroupAsyncWithContext")
+	return
+}
@@ -0,0 +1,341 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockAlertService is a mock of cloudstack.AlertServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockAlertService struct {
+	Recorder
+
+	NewArchiveAlertsParamsFunc        func() *cloudstack.ArchiveAlertsParams
+	ArchiveAlertsFunc                 func(p *cloudstack.ArchiveAlertsParams) (*cloudstack.ArchiveAlertsResponse, error)
+	ArchiveAlertsWithContextFunc      func(ctx context.Context, p *cloudstack.ArchiveAlertsParams) (*cloudstack.ArchiveAlertsResponse, error)
+	NewDeleteAlertsParamsFunc         func() *cloudstack.DeleteAlertsParams
+	DeleteAlertsFunc                  func(p *cloudstack.DeleteAlertsParams) (*cloudstack.DeleteAlertsResponse, error)
+	DeleteAlertsWithContextFunc       func(ctx context.Context, p *cloudstack.DeleteAlertsParams) (*cloudstack.DeleteAlertsResponse, error)
+	NewGenerateAlertParamsFunc        func(description string, name string, alertType int) *cloudstack.GenerateAlertParams
+	GenerateAlertFunc                 func(p *cloudstack.GenerateAlertParams) (*cloudstack.GenerateAlertResponse, error)
+	GenerateAlertWithContextFunc      func(ctx context.Context, p *cloudstack.GenerateAlertParams) (*cloudstack.GenerateAlertResponse, error)
+	GenerateAlertAsyncFunc            func(p *cloudstack.GenerateAlertParams) (*cloudstack.Job, error)
+	GenerateAlertAsyncWithContextFunc func(ctx context.Context, p *cloudstack.GenerateAlertParams) (*cloudstack.Job, error)
+	NewListAlertsParamsFunc           func() *cloudstack.ListAlertsParams
+	GetAlertIDFunc                    func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetAlertIDWithContextFunc         func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetAlertByNameFunc                func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Alert, int, error)
+	GetAlertByNameWithContextFunc     func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.Alert, int, error)
+	GetAlertByIDFunc                  func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Alert, int, error)
+	GetAlertByIDWithContextFunc       func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Alert, int, error)
+	ListAlertsFunc                    func(p *cloudstack.ListAlertsParams) (*cloudstack.ListAlertsResponse, error)
+	ListAlertsWithContextFunc         func(ctx context.Context, p *cloudstack.ListAlertsParams) (*cloudstack.ListAlertsResponse, error)
+	NewListAlertsPagerFunc            func(p *cloudstack.ListAlertsParams) *cloudstack.ListAlertsPager
+	ListAlertsAllFunc                 func(p *cloudstack.ListAlertsParams) (*cloudstack.ListAlertsResponse, error)
+	ListAlertsAllWithContextFunc      func(ctx context.Context, p *cloudstack.ListAlertsParams) (*cloudstack.ListAlertsResponse, error)
+}
+
+var _ cloudstack.AlertServiceIface = (*MockAlertService)(nil)
+
+// NewArchiveAlertsParams records the call and calls NewArchiveAlertsParamsFunc
+func (m *MockAlertService) NewArchiveAlertsParams() (r0 *cloudstack.ArchiveAlertsParams) {
+	m.record("NewArchiveAlertsParams")
+	if m.NewArchiveAlertsParamsFunc != nil {
+		return m.NewArchiveAlertsParamsFunc()
+	}
+	return cloudstack.NewAlertService(nil).NewArchiveAlertsParams()
+}
+
+// ArchiveAlerts records the call and calls ArchiveAlertsFunc, or ArchiveAlertsWithContextFunc if only that is set
+func (m *MockAlertService) ArchiveAlerts(p *cloudstack.ArchiveAlertsParams) (r0 *cloudstack.ArchiveAlertsResponse, r1 error) {
+	m.record("ArchiveAlerts", p)
+	if m.ArchiveAlertsFunc != nil {
+		return m.ArchiveAlertsFunc(p)
+	}
+	if m.ArchiveAlertsWithContextFunc != nil {
+		return m.ArchiveAlertsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AlertService.ArchiveAlerts")
+	return
+}
+
+// ArchiveAlertsWithContext records the call and calls ArchiveAlertsWithContextFunc, or ArchiveAlertsFunc if only that is set
+func (m *MockAlertService) ArchiveAlertsWithContext(ctx context.Context, p *cloudstack.ArchiveAlertsParams) (r0 *cloudstack.ArchiveAlertsResponse, r1 error) {
+	m.record("ArchiveAlertsWithContext", ctx, p)
+	if m.ArchiveAlertsWithContextFunc != nil {
+		return m.ArchiveAlertsWithContextFunc(ctx, p)
+	}
+	if m.ArchiveAlertsFunc != nil {
+		return m.ArchiveAlertsFunc(p)
+	}
+	r1 = notMocked("AlertService.ArchiveAlertsWithContext")
+	return
+}
+
+// NewDeleteAlertsParams records the call and calls NewDeleteAlertsParamsFunc
+func (m *MockAlertService) NewDeleteAlertsParams() (r0 *cloudstack.DeleteAlertsParams) {
+	m.record("NewDeleteAlertsParams")
+	if m.NewDeleteAlertsParamsFunc != nil {
+		return m.NewDeleteAlertsParamsFunc()
+	}
+	return cloudstack.NewAlertService(nil).NewDeleteAlertsParams()
+}
+
+// DeleteAlerts records the call and calls DeleteAlertsFunc, or DeleteAlertsWithContextFunc if only that is set
+func (m *MockAlertService) DeleteAlerts(p *cloudstack.DeleteAlertsParams) (r0 *cloudstack.DeleteAlertsResponse, r1 error) {
+	m.record("DeleteAlerts", p)
+	if m.DeleteAlertsFunc != nil {
+		return m.DeleteAlertsFunc(p)
+	}
+	if m.DeleteAlertsWithContextFunc != nil {
+		return m.DeleteAlertsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AlertService.DeleteAlerts")
+	return
+}
+
+// DeleteAlertsWithContext records the call and calls DeleteAlertsWithContextFunc, or DeleteAlertsFunc if only that is set
+func (m *MockAlertService) DeleteAlertsWithContext(ctx context.Context, p *cloudstack.DeleteAlertsParams) (r0 *cloudstack.DeleteAlertsResponse, r1 error) {
+	m.record("DeleteAlertsWithContext", ctx, p)
+	if m.DeleteAlertsWithContextFunc != nil {
+		return m.DeleteAlertsWithContextFunc(ctx, p)
+	}
+	if m.DeleteAlertsFunc != nil {
+		return m.DeleteAlertsFunc(p)
+	}
+	r1 = notMocked("AlertService.DeleteAlertsWithContext")
+	return
+}
+
+// NewGenerateAlertParams records the call and calls NewGenerateAlertParamsFunc
+func (m *MockAlertService) NewGenerateAlertParams(description string, name string, alertType int) (r0 *cloudstack.GenerateAlertParams) {
+	m.record("NewGenerateAlertParams", description, name, alertType)
+	if m.NewGenerateAlertParamsFunc != nil {
+		return m.NewGenerateAlertParamsFunc(description, name, alertType)
+	}
+	return cloudstack.NewAlertService(nil).NewGenerateAlertParams(description, name, alertType)
+}
+
+// GenerateAlert records the call and calls GenerateAlertFunc, or GenerateAlertWithContextFunc if only that is set
+func (m *MockAlertService) GenerateAlert(p *cloudstack.GenerateAlertParams) (r0 *cloudstack.GenerateAlertResponse, r1 error) {
+	m.record("GenerateAlert", p)
+	if m.GenerateAlertFunc != nil {
+		return m.GenerateAlertFunc(p)
+	}
+	if m.GenerateAlertWithContextFunc != nil {
+		return m.GenerateAlertWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AlertService.GenerateAlert")
+	return
+}
+
+// GenerateAlertWithContext records the call and calls GenerateAlertWithContextFunc, or GenerateAlertFunc if only that is set
+func (m *MockAlertService) GenerateAlertWithContext(ctx context.Context, p *cloudstack.GenerateAlertParams) (r0 *cloudstack.GenerateAlertResponse, r1 error) {
+	m.record("GenerateAlertWithContext", ctx, p)
+	if m.GenerateAlertWithContextFunc != nil {
+		return m.GenerateAlertWithContextFunc(ctx, p)
+	}
+	if m.GenerateAlertFunc != nil {
+		return m.GenerateAlertFunc(p)
+	}
+	r1 = notMocked("AlertService.GenerateAlertWithContext")
+	return
+}
+
+// GenerateAlertAsync records the call and calls GenerateAlertAsyncFunc, or GenerateAlertAsyncWithContextFunc if only that is set
+func (m *MockAlertService) GenerateAlertAsync(p *cloudstack.GenerateAlertParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("GenerateAlertAsync", p)
+	if m.GenerateAlertAsyncFunc != nil {
+		return m.GenerateAlertAsyncFunc(p)
+	}
+	if m.GenerateAlertAsyncWithContextFunc != nil {
+		return m.GenerateAlertAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AlertService.GenerateAlertAsync")
+	return
+}
+
+// GenerateAlertAsyncWithContext records the call and calls GenerateAlertAsyncWithContextFunc, or GenerateAlertAsyncFunc if only that is set
+func (m *MockAlertService) GenerateAlertAsyncWithContext(ctx context.Context, p *cloudstack.GenerateAlertParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("GenerateAlertAsyncWithContext", ctx, p)
+	if m.GenerateAlertAsyncWithContextFunc != nil {
+		return m.GenerateAlertAsyncWithContextFunc(ctx, p)
+	}
+	if m.GenerateAlertAsyncFunc != nil {
+		return m.GenerateAlertAsyncFunc(p)
+	}
+	r1 = notMocked("AlertService.GenerateAlertAsyncWithContext")
+	return
+}
+
+// NewListAlertsParams records the call and calls NewListAlertsParamsFunc
+func (m *MockAlertService) NewListAlertsParams() (r0 *cloudstack.ListAlertsParams) {
+	m.record("NewListAlertsParams")
+	if m.NewListAlertsParamsFunc != nil {
+		return m.NewListAlertsParamsFunc()
+	}
+	return cloudstack.NewAlertService(nil).NewListAlertsParams()
+}
+
+// GetAlertID records the call and calls GetAlertIDFunc, or GetAlertIDWithContextFunc if only that is set
+func (m *MockAlertService) GetAlertID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetAlertID", name, opts)
+	if m.GetAlertIDFunc != nil {
+		return m.GetAlertIDFunc(name, opts...)
+	}
+	if m.GetAlertIDWithContextFunc != nil {
+		return m.GetAlertIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("AlertService.GetAlertID")
+	return
+}
+
+// GetAlertIDWithContext records the call and calls GetAlertIDWithContextFunc, or GetAlertIDFunc if only that is set
+func (m *MockAlertService) GetAlertIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetAlertIDWithContext", ctx, name, opts)
+	if m.GetAlertIDWithContextFunc != nil {
+		return m.GetAlertIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetAlertIDFunc != nil {
+		return m.GetAlertIDFunc(name, opts...)
+	}
+	r2 = notMocked("AlertService.GetAlertIDWithContext")
+	return
+}
+
+// GetAlertByName records the call and calls GetAlertByNameFunc, or GetAlertByNameWithContextFunc if only that is set
+func (m *MockAlertService) GetAlertByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Alert, r1 int, r2 error) {
+	m.record("GetAlertByName", name, opts)
+	if m.GetAlertByNameFunc != nil {
+		return m.GetAlertByNameFunc(name, opts...)
+	}
+	if m.GetAlertByNameWithContextFunc != nil {
+		return m.GetAlertByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("AlertService.GetAlertByName")
+	return
+}
+
+// GetAlertByNameWithContext records the call and calls GetAlertByNameWithContextFunc, or GetAlertByNameFunc if only that is set
+func (m *MockAlertService) GetAlertByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Alert, r1 int, r2 error) {
+	m.record("GetAlertByNameWithContext", ctx, name, opts)
+	if m.GetAlertByNameWithContextFunc != nil {
+		return m.GetAlertByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetAlertByNameFunc != nil {
+		return m.GetAlertByNameFunc(name, opts...)
+	}
+	r2 = notMocked("AlertService.GetAlertByNameWithContext")
+	return
+}
+
+// GetAlertByID records the call and calls GetAlertByIDFunc, or GetAlertByIDWithContextFunc if only that is set
+func (m *MockAlertService) GetAlertByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Alert, r1 int, r2 error) {
+	m.record("GetAlertByID", id, opts)
+	if m.GetAlertByIDFunc != nil {
+		return m.GetAlertByIDFunc(id, opts...)
+	}
+	if m.GetAlertByIDWithContextFunc != nil {
+		return m.GetAlertByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("AlertService.GetAlertByID")
+	return
+}
+
+// GetAlertByIDWithContext records the call and calls GetAlertByIDWithContextFunc, or GetAlertByIDFunc if only that is set
+func (m *MockAlertService) GetAlertByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Alert, r1 int, r2 error) {
+	m.record("GetAlertByIDWithContext", ctx, id, opts)
+	if m.GetAlertByIDWithContextFunc != nil {
+		return m.GetAlertByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetAlertByIDFunc != nil {
+		return m.GetAlertByIDFunc(id, opts...)
+	}
+	r2 = notMocked("AlertService.GetAlertByIDWithContext")
+	return
+}
+
+// ListAlerts records the call and calls ListAlertsFunc, or ListAlertsWithContextFunc if only that is set
+func (m *MockAlertService) ListAlerts(p *cloudstack.ListAlertsParams) (r0 *cloudstack.ListAlertsResponse, r1 error) {
+	m.record("ListAlerts", p)
+	if m.ListAlertsFunc != nil {
+		return m.ListAlertsFunc(p)
+	}
+	if m.ListAlertsWithContextFunc != nil {
+		return m.ListAlertsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AlertService.ListAlerts")
+	return
+}
+
+// ListAlertsWithContext records the call and calls ListAlertsWithContextFunc, or ListAlertsFunc if only that is set
+func (m *MockAlertService) ListAlertsWithContext(ctx context.Context, p *cloudstack.ListAlertsParams) (r0 *cloudstack.ListAlertsResponse, r1 error) {
+	m.record("ListAlertsWithContext", ctx, p)
+	if m.ListAlertsWithContextFunc != nil {
+		return m.ListAlertsWithContextFunc(ctx, p)
+	}
+	if m.ListAlertsFunc != nil {
+		return m.ListAlertsFunc(p)
+	}
+	r1 = notMocked("AlertService.ListAlertsWithContext")
+	return
+}
+
+// NewListAlertsPager records the call and calls NewListAlertsPagerFunc
+func (m *MockAlertService) NewListAlertsPager(p *cloudstack.ListAlertsParams) (r0 *cloudstack.ListAlertsPager) {
+	m.record("NewListAlertsPager", p)
+	if m.NewListAlertsPagerFunc != nil {
+		return m.NewListAlertsPagerFunc(p)
+	}
+	return
+}
+
+// ListAlertsAll records the call and calls ListAlertsAllFunc, or ListAlertsAllWithContextFunc if only that is set
+func (m *MockAlertService) ListAlertsAll(p *cloudstack.ListAlertsParams) (r0 *cloudstack.ListAlertsResponse, r1 error) {
+	m.record("ListAlertsAll", p)
+	if m.ListAlertsAllFunc != nil {
+		return m.ListAlertsAllFunc(p)
+	}
+	if m.ListAlertsAllWithContextFunc != nil {
+		return m.ListAlertsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AlertService.ListAlertsAll")
+	return
+}
+
+// ListAlertsAllWithContext records the call and calls ListAlertsAllWithContextFunc, or ListAlertsAllFunc if only that is set
+func (m *MockAlertService) ListAlertsAllWithContext(ctx context.Context, p *cloudstack.ListAlertsParams) (r0 *cloudstack.ListAlertsResponse, r1 error) {
+	m.record("ListAlertsAllWithContext", ctx, p)
+	if m.ListAlertsAllWithContextFunc != nil {
+		return m.ListAlertsAllWithContextFunc(ctx, p)
+	}
+	if m.ListAlertsAllFunc != nil {
+		return m.ListAlertsAllFunc(p)
+	}
+	r1 = notMocked("AlertService.ListAlertsAllWithContext")
+	return
+}
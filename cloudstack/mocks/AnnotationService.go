@@ -0,0 +1,219 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockAnnotationService is a mock of cloudstack.AnnotationServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockAnnotationService struct {
+	Recorder
+
+	NewAddAnnotationParamsFunc        func() *cloudstack.AddAnnotationParams
+	AddAnnotationFunc                 func(p *cloudstack.AddAnnotationParams) (*cloudstack.AddAnnotationResponse, error)
+	AddAnnotationWithContextFunc      func(ctx context.Context, p *cloudstack.AddAnnotationParams) (*cloudstack.AddAnnotationResponse, error)
+	NewListAnnotationsParamsFunc      func() *cloudstack.ListAnnotationsParams
+	GetAnnotationByIDFunc             func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Annotation, int, error)
+	GetAnnotationByIDWithContextFunc  func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Annotation, int, error)
+	ListAnnotationsFunc               func(p *cloudstack.ListAnnotationsParams) (*cloudstack.ListAnnotationsResponse, error)
+	ListAnnotationsWithContextFunc    func(ctx context.Context, p *cloudstack.ListAnnotationsParams) (*cloudstack.ListAnnotationsResponse, error)
+	NewListAnnotationsPagerFunc       func(p *cloudstack.ListAnnotationsParams) *cloudstack.ListAnnotationsPager
+	ListAnnotationsAllFunc            func(p *cloudstack.ListAnnotationsParams) (*cloudstack.ListAnnotationsResponse, error)
+	ListAnnotationsAllWithContextFunc func(ctx context.Context, p *cloudstack.ListAnnotationsParams) (*cloudstack.ListAnnotationsResponse, error)
+	NewRemoveAnnotationParamsFunc     func(id string) *cloudstack.RemoveAnnotationParams
+	RemoveAnnotationFunc              func(p *cloudstack.RemoveAnnotationParams) (*cloudstack.RemoveAnnotationResponse, error)
+	RemoveAnnotationWithContextFunc   func(ctx context.Context, p *cloudstack.RemoveAnnotationParams) (*cloudstack.RemoveAnnotationResponse, error)
+}
+
+var _ cloudstack.AnnotationServiceIface = (*MockAnnotationService)(nil)
+
+// NewAddAnnotationParams records the call and calls NewAddAnnotationParamsFunc
+func (m *MockAnnotationService) NewAddAnnotationParams() (r0 *cloudstack.AddAnnotationParams) {
+	m.record("NewAddAnnotationParams")
+	if m.NewAddAnnotationParamsFunc != nil {
+		return m.NewAddAnnotationParamsFunc()
+	}
+	return cloudstack.NewAnnotationService(nil).NewAddAnnotationParams()
+}
+
+// AddAnnotation records the call and calls AddAnnotationFunc, or AddAnnotationWithContextFunc if only that is set
+func (m *MockAnnotationService) AddAnnotation(p *cloudstack.AddAnnotationParams) (r0 *cloudstack.AddAnnotationResponse, r1 error) {
+	m.record("AddAnnotation", p)
+	if m.AddAnnotationFunc != nil {
+		return m.AddAnnotationFunc(p)
+	}
+	if m.AddAnnotationWithContextFunc != nil {
+		return m.AddAnnotationWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AnnotationService.AddAnnotation")
+	return
+}
+
+// AddAnnotationWithContext records the call and calls AddAnnotationWithContextFunc, or AddAnnotationFunc if only that is set
+func (m *MockAnnotationService) AddAnnotationWithContext(ctx context.Context, p *cloudstack.AddAnnotationParams) (r0 *cloudstack.AddAnnotationResponse, r1 error) {
+	m.record("AddAnnotationWithContext", ctx, p)
+	if m.AddAnnotationWithContextFunc != nil {
+		return m.AddAnnotationWithContextFunc(ctx, p)
+	}
+	if m.AddAnnotationFunc != nil {
+		return m.AddAnnotationFunc(p)
+	}
+	r1 = notMocked("AnnotationService.AddAnnotationWithContext")
+	return
+}
+
+// NewListAnnotationsParams records the call and calls NewListAnnotationsParamsFunc
+func (m *MockAnnotationService) NewListAnnotationsParams() (r0 *cloudstack.ListAnnotationsParams) {
+	m.record("NewListAnnotationsParams")
+	if m.NewListAnnotationsParamsFunc != nil {
+		return m.NewListAnnotationsParamsFunc()
+	}
+	return cloudstack.NewAnnotationService(nil).NewListAnnotationsParams()
+}
+
+// GetAnnotationByID records the call and calls GetAnnotationByIDFunc, or GetAnnotationByIDWithContextFunc if only that is set
+func (m *MockAnnotationService) GetAnnotationByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Annotation, r1 int, r2 error) {
+	m.record("GetAnnotationByID", id, opts)
+	if m.GetAnnotationByIDFunc != nil {
+		return m.GetAnnotationByIDFunc(id, opts...)
+	}
+	if m.GetAnnotationByIDWithContextFunc != nil {
+		return m.GetAnnotationByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("AnnotationService.GetAnnotationByID")
+	return
+}
+
+// GetAnnotationByIDWithContext records the call and calls GetAnnotationByIDWithContextFunc, or GetAnnotationByIDFunc if only that is set
+func (m *MockAnnotationService) GetAnnotationByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Annotation, r1 int, r2 error) {
+	m.record("GetAnnotationByIDWithContext", ctx, id, opts)
+	if m.GetAnnotationByIDWithContextFunc != nil {
+		return m.GetAnnotationByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetAnnotationByIDFunc != nil {
+		return m.GetAnnotationByIDFunc(id, opts...)
+	}
+	r2 = notMocked("AnnotationService.GetAnnotationByIDWithContext")
+	return
+}
+
+// ListAnnotations records the call and calls ListAnnotationsFunc, or ListAnnotationsWithContextFunc if only that is set
+func (m *MockAnnotationService) ListAnnotations(p *cloudstack.ListAnnotationsParams) (r0 *cloudstack.ListAnnotationsResponse, r1 error) {
+	m.record("ListAnnotations", p)
+	if m.ListAnnotationsFunc != nil {
+		return m.ListAnnotationsFunc(p)
+	}
+	if m.ListAnnotationsWithContextFunc != nil {
+		return m.ListAnnotationsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AnnotationService.ListAnnotations")
+	return
+}
+
+// ListAnnotationsWithContext records the call and calls ListAnnotationsWithContextFunc, or ListAnnotationsFunc if only that is set
+func (m *MockAnnotationService) ListAnnotationsWithContext(ctx context.Context, p *cloudstack.ListAnnotationsParams) (r0 *cloudstack.ListAnnotationsResponse, r1 error) {
+	m.record("ListAnnotationsWithContext", ctx, p)
+	if m.ListAnnotationsWithContextFunc != nil {
+		return m.ListAnnotationsWithContextFunc(ctx, p)
+	}
+	if m.ListAnnotationsFunc != nil {
+		return m.ListAnnotationsFunc(p)
+	}
+	r1 = notMocked("AnnotationService.ListAnnotationsWithContext")
+	return
+}
+
+// NewListAnnotationsPager records the call and calls NewListAnnotationsPagerFunc
+func (m *MockAnnotationService) NewListAnnotationsPager(p *cloudstack.ListAnnotationsParams) (r0 *cloudstack.ListAnnotationsPager) {
+	m.record("NewListAnnotationsPager", p)
+	if m.NewListAnnotationsPagerFunc != nil {
+		return m.NewListAnnotationsPagerFunc(p)
+	}
+	return
+}
+
+// ListAnnotationsAll records the call and calls ListAnnotationsAllFunc, or ListAnnotationsAllWithContextFunc if only that is set
+func (m *MockAnnotationService) ListAnnotationsAll(p *cloudstack.ListAnnotationsParams) (r0 *cloudstack.ListAnnotationsResponse, r1 error) {
+	m.record("ListAnnotationsAll", p)
+	if m.ListAnnotationsAllFunc != nil {
+		return m.ListAnnotationsAllFunc(p)
+	}
+	if m.ListAnnotationsAllWithContextFunc != nil {
+		return m.ListAnnotationsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AnnotationService.ListAnnotationsAll")
+	return
+}
+
+// ListAnnotationsAllWithContext records the call and calls ListAnnotationsAllWithContextFunc, or ListAnnotationsAllFunc if only that is set
+func (m *MockAnnotationService) ListAnnotationsAllWithContext(ctx context.Context, p *cloudstack.ListAnnotationsParams) (r0 *cloudstack.ListAnnotationsResponse, r1 error) {
+	m.record("ListAnnotationsAllWithContext", ctx, p)
+	if m.ListAnnotationsAllWithContextFunc != nil {
+		return m.ListAnnotationsAllWithContextFunc(ctx, p)
+	}
+	if m.ListAnnotationsAllFunc != nil {
+		return m.ListAnnotationsAllFunc(p)
+	}
+	r1 = notMocked("AnnotationService.ListAnnotationsAllWithContext")
+	return
+}
+
+// NewRemoveAnnotationParams records the call and calls NewRemoveAnnotationParamsFunc
+func (m *MockAnnotationService) NewRemoveAnnotationParams(id string) (r0 *cloudstack.RemoveAnnotationParams) {
+	m.record("NewRemoveAnnotationParams", id)
+	if m.NewRemoveAnnotationParamsFunc != nil {
+		return m.NewRemoveAnnotationParamsFunc(id)
+	}
+	return cloudstack.NewAnnotationService(nil).NewRemoveAnnotationParams(id)
+}
+
+// RemoveAnnotation records the call and calls RemoveAnnotationFunc, or RemoveAnnotationWithContextFunc if only that is set
+func (m *MockAnnotationService) RemoveAnnotation(p *cloudstack.RemoveAnnotationParams) (r0 *cloudstack.RemoveAnnotationResponse, r1 error) {
+	m.record("RemoveAnnotation", p)
+	if m.RemoveAnnotationFunc != nil {
+		return m.RemoveAnnotationFunc(p)
+	}
+	if m.RemoveAnnotationWithContextFunc != nil {
+		return m.RemoveAnnotationWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AnnotationService.RemoveAnnotation")
+	return
+}
+
+// RemoveAnnotationWithContext records the call and calls RemoveAnnotationWithContextFunc, or RemoveAnnotationFunc if only that is set
+func (m *MockAnnotationService) RemoveAnnotationWithContext(ctx context.Context, p *cloudstack.RemoveAnnotationParams) (r0 *cloudstack.RemoveAnnotationResponse, r1 error) {
+	m.record("RemoveAnnotationWithContext", ctx, p)
+	if m.RemoveAnnotationWithContextFunc != nil {
+		return m.RemoveAnnotationWithContextFunc(ctx, p)
+	}
+	if m.RemoveAnnotationFunc != nil {
+		return m.RemoveAnnotationFunc(p)
+	}
+	r1 = notMocked("AnnotationService.RemoveAnnotationWithContext")
+	return
+}
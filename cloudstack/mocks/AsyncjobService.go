@@ -0,0 +1,153 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockAsyncjobService is a mock of cloudstack.AsyncjobServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockAsyncjobService struct {
+	Recorder
+
+	NewListAsyncJobsParamsFunc         func() *cloudstack.ListAsyncJobsParams
+	ListAsyncJobsFunc                  func(p *cloudstack.ListAsyncJobsParams) (*cloudstack.ListAsyncJobsResponse, error)
+	ListAsyncJobsWithContextFunc       func(ctx context.Context, p *cloudstack.ListAsyncJobsParams) (*cloudstack.ListAsyncJobsResponse, error)
+	NewListAsyncJobsPagerFunc          func(p *cloudstack.ListAsyncJobsParams) *cloudstack.ListAsyncJobsPager
+	ListAsyncJobsAllFunc               func(p *cloudstack.ListAsyncJobsParams) (*cloudstack.ListAsyncJobsResponse, error)
+	ListAsyncJobsAllWithContextFunc    func(ctx context.Context, p *cloudstack.ListAsyncJobsParams) (*cloudstack.ListAsyncJobsResponse, error)
+	NewQueryAsyncJobResultParamsFunc   func(jobid string) *cloudstack.QueryAsyncJobResultParams
+	QueryAsyncJobResultFunc            func(p *cloudstack.QueryAsyncJobResultParams) (*cloudstack.QueryAsyncJobResultResponse, error)
+	QueryAsyncJobResultWithContextFunc func(ctx context.Context, p *cloudstack.QueryAsyncJobResultParams) (*cloudstack.QueryAsyncJobResultResponse, error)
+}
+
+var _ cloudstack.AsyncjobServiceIface = (*MockAsyncjobService)(nil)
+
+// NewListAsyncJobsParams records the call and calls NewListAsyncJobsParamsFunc
+func (m *MockAsyncjobService) NewListAsyncJobsParams() (r0 *cloudstack.ListAsyncJobsParams) {
+	m.record("NewListAsyncJobsParams")
+	if m.NewListAsyncJobsParamsFunc != nil {
+		return m.NewListAsyncJobsParamsFunc()
+	}
+	return cloudstack.NewAsyncjobService(nil).NewListAsyncJobsParams()
+}
+
+// ListAsyncJobs records the call and calls ListAsyncJobsFunc, or ListAsyncJobsWithContextFunc if only that is set
+func (m *MockAsyncjobService) ListAsyncJobs(p *cloudstack.ListAsyncJobsParams) (r0 *cloudstack.ListAsyncJobsResponse, r1 error) {
+	m.record("ListAsyncJobs", p)
+	if m.ListAsyncJobsFunc != nil {
+		return m.ListAsyncJobsFunc(p)
+	}
+	if m.ListAsyncJobsWithContextFunc != nil {
+		return m.ListAsyncJobsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AsyncjobService.ListAsyncJobs")
+	return
+}
+
+// ListAsyncJobsWithContext records the call and calls ListAsyncJobsWithContextFunc, or ListAsyncJobsFunc if only that is set
+func (m *MockAsyncjobService) ListAsyncJobsWithContext(ctx context.Context, p *cloudstack.ListAsyncJobsParams) (r0 *cloudstack.ListAsyncJobsResponse, r1 error) {
+	m.record("ListAsyncJobsWithContext", ctx, p)
+	if m.ListAsyncJobsWithContextFunc != nil {
+		return m.ListAsyncJobsWithContextFunc(ctx, p)
+	}
+	if m.ListAsyncJobsFunc != nil {
+		return m.ListAsyncJobsFunc(p)
+	}
+	r1 = notMocked("AsyncjobService.ListAsyncJobsWithContext")
+	return
+}
+
+// NewListAsyncJobsPager records the call and calls NewListAsyncJobsPagerFunc
+func (m *MockAsyncjobService) NewListAsyncJobsPager(p *cloudstack.ListAsyncJobsParams) (r0 *cloudstack.ListAsyncJobsPager) {
+	m.record("NewListAsyncJobsPager", p)
+	if m.NewListAsyncJobsPagerFunc != nil {
+		return m.NewListAsyncJobsPagerFunc(p)
+	}
+	return
+}
+
+// ListAsyncJobsAll records the call and calls ListAsyncJobsAllFunc, or ListAsyncJobsAllWithContextFunc if only that is set
+func (m *MockAsyncjobService) ListAsyncJobsAll(p *cloudstack.ListAsyncJobsParams) (r0 *cloudstack.ListAsyncJobsResponse, r1 error) {
+	m.record("ListAsyncJobsAll", p)
+	if m.ListAsyncJobsAllFunc != nil {
+		return m.ListAsyncJobsAllFunc(p)
+	}
+	if m.ListAsyncJobsAllWithContextFunc != nil {
+		return m.ListAsyncJobsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AsyncjobService.ListAsyncJobsAll")
+	return
+}
+
+// ListAsyncJobsAllWithContext records the call and calls ListAsyncJobsAllWithContextFunc, or ListAsyncJobsAllFunc if only that is set
+func (m *MockAsyncjobService) ListAsyncJobsAllWithContext(ctx context.Context, p *cloudstack.ListAsyncJobsParams) (r0 *cloudstack.ListAsyncJobsResponse, r1 error) {
+	m.record("ListAsyncJobsAllWithContext", ctx, p)
+	if m.ListAsyncJobsAllWithContextFunc != nil {
+		return m.ListAsyncJobsAllWithContextFunc(ctx, p)
+	}
+	if m.ListAsyncJobsAllFunc != nil {
+		return m.ListAsyncJobsAllFunc(p)
+	}
+	r1 = notMocked("AsyncjobService.ListAsyncJobsAllWithContext")
+	return
+}
+
+// NewQueryAsyncJobResultParams records the call and calls NewQueryAsyncJobResultParamsFunc
+func (m *MockAsyncjobService) NewQueryAsyncJobResultParams(jobid string) (r0 *cloudstack.QueryAsyncJobResultParams) {
+	m.record("NewQueryAsyncJobResultParams", jobid)
+	if m.NewQueryAsyncJobResultParamsFunc != nil {
+		return m.NewQueryAsyncJobResultParamsFunc(jobid)
+	}
+	return cloudstack.NewAsyncjobService(nil).NewQueryAsyncJobResultParams(jobid)
+}
+
+// QueryAsyncJobResult records the call and calls QueryAsyncJobResultFunc, or QueryAsyncJobResultWithContextFunc if only that is set
+func (m *MockAsyncjobService) QueryAsyncJobResult(p *cloudstack.QueryAsyncJobResultParams) (r0 *cloudstack.QueryAsyncJobResultResponse, r1 error) {
+	m.record("QueryAsyncJobResult", p)
+	if m.QueryAsyncJobResultFunc != nil {
+		return m.QueryAsyncJobResultFunc(p)
+	}
+	if m.QueryAsyncJobResultWithContextFunc != nil {
+		return m.QueryAsyncJobResultWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AsyncjobService.QueryAsyncJobResult")
+	return
+}
+
+// QueryAsyncJobResultWithContext records the call and calls QueryAsyncJobResultWithContextFunc, or QueryAsyncJobResultFunc if only that is set
+func (m *MockAsyncjobService) QueryAsyncJobResultWithContext(ctx context.Context, p *cloudstack.QueryAsyncJobResultParams) (r0 *cloudstack.QueryAsyncJobResultResponse, r1 error) {
+	m.record("QueryAsyncJobResultWithContext", ctx, p)
+	if m.QueryAsyncJobResultWithContextFunc != nil {
+		return m.QueryAsyncJobResultWithContextFunc(ctx, p)
+	}
+	if m.QueryAsyncJobResultFunc != nil {
+		return m.QueryAsyncJobResultFunc(p)
+	}
+	r1 = notMocked("AsyncjobService.QueryAsyncJobResultWithContext")
+	return
+}
@@ -0,0 +1,419 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockAuthenticationService is a mock of cloudstack.AuthenticationServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockAuthenticationService struct {
+	Recorder
+
+	NewAuthorizeSamlSsoParamsFunc           func(enable bool, userid string) *cloudstack.AuthorizeSamlSsoParams
+	AuthorizeSamlSsoFunc                    func(p *cloudstack.AuthorizeSamlSsoParams) (*cloudstack.AuthorizeSamlSsoResponse, error)
+	AuthorizeSamlSsoWithContextFunc         func(ctx context.Context, p *cloudstack.AuthorizeSamlSsoParams) (*cloudstack.AuthorizeSamlSsoResponse, error)
+	NewGetSPMetadataParamsFunc              func() *cloudstack.GetSPMetadataParams
+	GetSPMetadataFunc                       func(p *cloudstack.GetSPMetadataParams) (*cloudstack.GetSPMetadataResponse, error)
+	GetSPMetadataWithContextFunc            func(ctx context.Context, p *cloudstack.GetSPMetadataParams) (*cloudstack.GetSPMetadataResponse, error)
+	NewListAndSwitchSamlAccountParamsFunc   func() *cloudstack.ListAndSwitchSamlAccountParams
+	ListAndSwitchSamlAccountFunc            func(p *cloudstack.ListAndSwitchSamlAccountParams) (*cloudstack.ListAndSwitchSamlAccountResponse, error)
+	ListAndSwitchSamlAccountWithContextFunc func(ctx context.Context, p *cloudstack.ListAndSwitchSamlAccountParams) (*cloudstack.ListAndSwitchSamlAccountResponse, error)
+	NewListIdpsParamsFunc                   func() *cloudstack.ListIdpsParams
+	ListIdpsFunc                            func(p *cloudstack.ListIdpsParams) (*cloudstack.ListIdpsResponse, error)
+	ListIdpsWithContextFunc                 func(ctx context.Context, p *cloudstack.ListIdpsParams) (*cloudstack.ListIdpsResponse, error)
+	NewListSamlAuthorizationParamsFunc      func() *cloudstack.ListSamlAuthorizationParams
+	ListSamlAuthorizationFunc               func(p *cloudstack.ListSamlAuthorizationParams) (*cloudstack.ListSamlAuthorizationResponse, error)
+	ListSamlAuthorizationWithContextFunc    func(ctx context.Context, p *cloudstack.ListSamlAuthorizationParams) (*cloudstack.ListSamlAuthorizationResponse, error)
+	NewListSamlAuthorizationPagerFunc       func(p *cloudstack.ListSamlAuthorizationParams) *cloudstack.ListSamlAuthorizationPager
+	ListSamlAuthorizationAllFunc            func(p *cloudstack.ListSamlAuthorizationParams) (*cloudstack.ListSamlAuthorizationResponse, error)
+	ListSamlAuthorizationAllWithContextFunc func(ctx context.Context, p *cloudstack.ListSamlAuthorizationParams) (*cloudstack.ListSamlAuthorizationResponse, error)
+	NewLoginParamsFunc                      func(password string, username string) *cloudstack.LoginParams
+	LoginFunc                               func(p *cloudstack.LoginParams) (*cloudstack.LoginResponse, error)
+	LoginWithContextFunc                    func(ctx context.Context, p *cloudstack.LoginParams) (*cloudstack.LoginResponse, error)
+	NewLogoutParamsFunc                     func() *cloudstack.LogoutParams
+	LogoutFunc                              func(p *cloudstack.LogoutParams) (*cloudstack.LogoutResponse, error)
+	LogoutWithContextFunc                   func(ctx context.Context, p *cloudstack.LogoutParams) (*cloudstack.LogoutResponse, error)
+	NewSamlSloParamsFunc                    func() *cloudstack.SamlSloParams
+	SamlSloFunc                             func(p *cloudstack.SamlSloParams) (*cloudstack.SamlSloResponse, error)
+	SamlSloWithContextFunc                  func(ctx context.Context, p *cloudstack.SamlSloParams) (*cloudstack.SamlSloResponse, error)
+	NewSamlSsoParamsFunc                    func(idpid string) *cloudstack.SamlSsoParams
+	SamlSsoFunc                             func(p *cloudstack.SamlSsoParams) (*cloudstack.SamlSsoResponse, error)
+	SamlSsoWithContextFunc                  func(ctx context.Context, p *cloudstack.SamlSsoParams) (*cloudstack.SamlSsoResponse, error)
+}
+
+var _ cloudstack.AuthenticationServiceIface = (*MockAuthenticationService)(nil)
+
+// NewAuthorizeSamlSsoParams records the call and calls NewAuthorizeSamlSsoParamsFunc
+func (m *MockAuthenticationService) NewAuthorizeSamlSsoParams(enable bool, userid string) (r0 *cloudstack.AuthorizeSamlSsoParams) {
+	m.record("NewAuthorizeSamlSsoParams", enable, userid)
+	if m.NewAuthorizeSamlSsoParamsFunc != nil {
+		return m.NewAuthorizeSamlSsoParamsFunc(enable, userid)
+	}
+	return cloudstack.NewAuthenticationService(nil).NewAuthorizeSamlSsoParams(enable, userid)
+}
+
+// AuthorizeSamlSso records the call and calls AuthorizeSamlSsoFunc, or AuthorizeSamlSsoWithContextFunc if only that is set
+func (m *MockAuthenticationService) AuthorizeSamlSso(p *cloudstack.AuthorizeSamlSsoParams) (r0 *cloudstack.AuthorizeSamlSsoResponse, r1 error) {
+	m.record("AuthorizeSamlSso", p)
+	if m.AuthorizeSamlSsoFunc != nil {
+		return m.AuthorizeSamlSsoFunc(p)
+	}
+	if m.AuthorizeSamlSsoWithContextFunc != nil {
+		return m.AuthorizeSamlSsoWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AuthenticationService.AuthorizeSamlSso")
+	return
+}
+
+// AuthorizeSamlSsoWithContext records the call and calls AuthorizeSamlSsoWithContextFunc, or AuthorizeSamlSsoFunc if only that is set
+func (m *MockAuthenticationService) AuthorizeSamlSsoWithContext(ctx context.Context, p *cloudstack.AuthorizeSamlSsoParams) (r0 *cloudstack.AuthorizeSamlSsoResponse, r1 error) {
+	m.record("AuthorizeSamlSsoWithContext", ctx, p)
+	if m.AuthorizeSamlSsoWithContextFunc != nil {
+		return m.AuthorizeSamlSsoWithContextFunc(ctx, p)
+	}
+	if m.AuthorizeSamlSsoFunc != nil {
+		return m.AuthorizeSamlSsoFunc(p)
+	}
+	r1 = notMocked("AuthenticationService.AuthorizeSamlSsoWithContext")
+	return
+}
+
+// NewGetSPMetadataParams records the call and calls NewGetSPMetadataParamsFunc
+func (m *MockAuthenticationService) NewGetSPMetadataParams() (r0 *cloudstack.GetSPMetadataParams) {
+	m.record("NewGetSPMetadataParams")
+	if m.NewGetSPMetadataParamsFunc != nil {
+		return m.NewGetSPMetadataParamsFunc()
+	}
+	return cloudstack.NewAuthenticationService(nil).NewGetSPMetadataParams()
+}
+
+// GetSPMetadata records the call and calls GetSPMetadataFunc, or GetSPMetadataWithContextFunc if only that is set
+func (m *MockAuthenticationService) GetSPMetadata(p *cloudstack.GetSPMetadataParams) (r0 *cloudstack.GetSPMetadataResponse, r1 error) {
+	m.record("GetSPMetadata", p)
+	if m.GetSPMetadataFunc != nil {
+		return m.GetSPMetadataFunc(p)
+	}
+	if m.GetSPMetadataWithContextFunc != nil {
+		return m.GetSPMetadataWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AuthenticationService.GetSPMetadata")
+	return
+}
+
+// GetSPMetadataWithContext records the call and calls GetSPMetadataWithContextFunc, or GetSPMetadataFunc if only that is set
+func (m *MockAuthenticationService) GetSPMetadataWithContext(ctx context.Context, p *cloudstack.GetSPMetadataParams) (r0 *cloudstack.GetSPMetadataResponse, r1 error) {
+	m.record("GetSPMetadataWithContext", ctx, p)
+	if m.GetSPMetadataWithContextFunc != nil {
+		return m.GetSPMetadataWithContextFunc(ctx, p)
+	}
+	if m.GetSPMetadataFunc != nil {
+		return m.GetSPMetadataFunc(p)
+	}
+	r1 = notMocked("AuthenticationService.GetSPMetadataWithContext")
+	return
+}
+
+// NewListAndSwitchSamlAccountParams records the call and calls NewListAndSwitchSamlAccountParamsFunc
+func (m *MockAuthenticationService) NewListAndSwitchSamlAccountParams() (r0 *cloudstack.ListAndSwitchSamlAccountParams) {
+	m.record("NewListAndSwitchSamlAccountParams")
+	if m.NewListAndSwitchSamlAccountParamsFunc != nil {
+		return m.NewListAndSwitchSamlAccountParamsFunc()
+	}
+	return cloudstack.NewAuthenticationService(nil).NewListAndSwitchSamlAccountParams()
+}
+
+// ListAndSwitchSamlAccount records the call and calls ListAndSwitchSamlAccountFunc, or ListAndSwitchSamlAccountWithContextFunc if only that is set
+func (m *MockAuthenticationService) ListAndSwitchSamlAccount(p *cloudstack.ListAndSwitchSamlAccountParams) (r0 *cloudstack.ListAndSwitchSamlAccountResponse, r1 error) {
+	m.record("ListAndSwitchSamlAccount", p)
+	if m.ListAndSwitchSamlAccountFunc != nil {
+		return m.ListAndSwitchSamlAccountFunc(p)
+	}
+	if m.ListAndSwitchSamlAccountWithContextFunc != nil {
+		return m.ListAndSwitchSamlAccountWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AuthenticationService.ListAndSwitchSamlAccount")
+	return
+}
+
+// ListAndSwitchSamlAccountWithContext records the call and calls ListAndSwitchSamlAccountWithContextFunc, or ListAndSwitchSamlAccountFunc if only that is set
+func (m *MockAuthenticationService) ListAndSwitchSamlAccountWithContext(ctx context.Context, p *cloudstack.ListAndSwitchSamlAccountParams) (r0 *cloudstack.ListAndSwitchSamlAccountResponse, r1 error) {
+	m.record("ListAndSwitchSamlAccountWithContext", ctx, p)
+	if m.ListAndSwitchSamlAccountWithContextFunc != nil {
+		return m.ListAndSwitchSamlAccountWithContextFunc(ctx, p)
+	}
+	if m.ListAndSwitchSamlAccountFunc != nil {
+		return m.ListAndSwitchSamlAccountFunc(p)
+	}
+	r1 = notMocked("AuthenticationService.ListAndSwitchSamlAccountWithContext")
+	return
+}
+
+// NewListIdpsParams records the call and calls NewListIdpsParamsFunc
+func (m *MockAuthenticationService) NewListIdpsParams() (r0 *cloudstack.ListIdpsParams) {
+	m.record("NewListIdpsParams")
+	if m.NewListIdpsParamsFunc != nil {
+		return m.NewListIdpsParamsFunc()
+	}
+	return cloudstack.NewAuthenticationService(nil).NewListIdpsParams()
+}
+
+// ListIdps records the call and calls ListIdpsFunc, or ListIdpsWithContextFunc if only that is set
+func (m *MockAuthenticationService) ListIdps(p *cloudstack.ListIdpsParams) (r0 *cloudstack.ListIdpsResponse, r1 error) {
+	m.record("ListIdps", p)
+	if m.ListIdpsFunc != nil {
+		return m.ListIdpsFunc(p)
+	}
+	if m.ListIdpsWithContextFunc != nil {
+		return m.ListIdpsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AuthenticationService.ListIdps")
+	return
+}
+
+// ListIdpsWithContext records the call and calls ListIdpsWithContextFunc, or ListIdpsFunc if only that is set
+func (m *MockAuthenticationService) ListIdpsWithContext(ctx context.Context, p *cloudstack.ListIdpsParams) (r0 *cloudstack.ListIdpsResponse, r1 error) {
+	m.record("ListIdpsWithContext", ctx, p)
+	if m.ListIdpsWithContextFunc != nil {
+		return m.ListIdpsWithContextFunc(ctx, p)
+	}
+	if m.ListIdpsFunc != nil {
+		return m.ListIdpsFunc(p)
+	}
+	r1 = notMocked("AuthenticationService.ListIdpsWithContext")
+	return
+}
+
+// NewListSamlAuthorizationParams records the call and calls NewListSamlAuthorizationParamsFunc
+func (m *MockAuthenticationService) NewListSamlAuthorizationParams() (r0 *cloudstack.ListSamlAuthorizationParams) {
+	m.record("NewListSamlAuthorizationParams")
+	if m.NewListSamlAuthorizationParamsFunc != nil {
+		return m.NewListSamlAuthorizationParamsFunc()
+	}
+	return cloudstack.NewAuthenticationService(nil).NewListSamlAuthorizationParams()
+}
+
+// ListSamlAuthorization records the call and calls ListSamlAuthorizationFunc, or ListSamlAuthorizationWithContextFunc if only that is set
+func (m *MockAuthenticationService) ListSamlAuthorization(p *cloudstack.ListSamlAuthorizationParams) (r0 *cloudstack.ListSamlAuthorizationResponse, r1 error) {
+	m.record("ListSamlAuthorization", p)
+	if m.ListSamlAuthorizationFunc != nil {
+		return m.ListSamlAuthorizationFunc(p)
+	}
+	if m.ListSamlAuthorizationWithContextFunc != nil {
+		return m.ListSamlAuthorizationWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AuthenticationService.ListSamlAuthorization")
+	return
+}
+
+// ListSamlAuthorizationWithContext records the call and calls ListSamlAuthorizationWithContextFunc, or ListSamlAuthorizationFunc if only that is set
+func (m *MockAuthenticationService) ListSamlAuthorizationWithContext(ctx context.Context, p *cloudstack.ListSamlAuthorizationParams) (r0 *cloudstack.ListSamlAuthorizationResponse, r1 error) {
+	m.record("ListSamlAuthorizationWithContext", ctx, p)
+	if m.ListSamlAuthorizationWithContextFunc != nil {
+		return m.ListSamlAuthorizationWithContextFunc(ctx, p)
+	}
+	if m.ListSamlAuthorizationFunc != nil {
+		return m.ListSamlAuthorizationFunc(p)
+	}
+	r1 = notMocked("AuthenticationService.ListSamlAuthorizationWithContext")
+	return
+}
+
+// NewListSamlAuthorizationPager records the call and calls NewListSamlAuthorizationPagerFunc
+func (m *MockAuthenticationService) NewListSamlAuthorizationPager(p *cloudstack.ListSamlAuthorizationParams) (r0 *cloudstack.ListSamlAuthorizationPager) {
+	m.record("NewListSamlAuthorizationPager", p)
+	if m.NewListSamlAuthorizationPagerFunc != nil {
+		return m.NewListSamlAuthorizationPagerFunc(p)
+	}
+	return
+}
+
+// ListSamlAuthorizationAll records the call and calls ListSamlAuthorizationAllFunc, or ListSamlAuthorizationAllWithContextFunc if only that is set
+func (m *MockAuthenticationService) ListSamlAuthorizationAll(p *cloudstack.ListSamlAuthorizationParams) (r0 *cloudstack.ListSamlAuthorizationResponse, r1 error) {
+	m.record("ListSamlAuthorizationAll", p)
+	if m.ListSamlAuthorizationAllFunc != nil {
+		return m.ListSamlAuthorizationAllFunc(p)
+	}
+	if m.ListSamlAuthorizationAllWithContextFunc != nil {
+		return m.ListSamlAuthorizationAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AuthenticationService.ListSamlAuthorizationAll")
+	return
+}
+
+// ListSamlAuthorizationAllWithContext records the call and calls ListSamlAuthorizationAllWithContextFunc, or ListSamlAuthorizationAllFunc if only that is set
+func (m *MockAuthenticationService) ListSamlAuthorizationAllWithContext(ctx context.Context, p *cloudstack.ListSamlAuthorizationParams) (r0 *cloudstack.ListSamlAuthorizationResponse, r1 error) {
+	m.record("ListSamlAuthorizationAllWithContext", ctx, p)
+	if m.ListSamlAuthorizationAllWithContextFunc != nil {
+		return m.ListSamlAuthorizationAllWithContextFunc(ctx, p)
+	}
+	if m.ListSamlAuthorizationAllFunc != nil {
+		return m.ListSamlAuthorizationAllFunc(p)
+	}
+	r1 = notMocked("AuthenticationService.ListSamlAuthorizationAllWithContext")
+	return
+}
+
+// NewLoginParams records the call and calls NewLoginParamsFunc
+func (m *MockAuthenticationService) NewLoginParams(password string, username string) (r0 *cloudstack.LoginParams) {
+	m.record("NewLoginParams", password, username)
+	if m.NewLoginParamsFunc != nil {
+		return m.NewLoginParamsFunc(password, username)
+	}
+	return cloudstack.NewAuthenticationService(nil).NewLoginParams(password, username)
+}
+
+// Login records the call and calls LoginFunc, or LoginWithContextFunc if only that is set
+func (m *MockAuthenticationService) Login(p *cloudstack.LoginParams) (r0 *cloudstack.LoginResponse, r1 error) {
+	m.record("Login", p)
+	if m.LoginFunc != nil {
+		return m.LoginFunc(p)
+	}
+	if m.LoginWithContextFunc != nil {
+		return m.LoginWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AuthenticationService.Login")
+	return
+}
+
+// LoginWithContext records the call and calls LoginWithContextFunc, or LoginFunc if only that is set
+func (m *MockAuthenticationService) LoginWithContext(ctx context.Context, p *cloudstack.LoginParams) (r0 *cloudstack.LoginResponse, r1 error) {
+	m.record("LoginWithContext", ctx, p)
+	if m.LoginWithContextFunc != nil {
+		return m.LoginWithContextFunc(ctx, p)
+	}
+	if m.LoginFunc != nil {
+		return m.LoginFunc(p)
+	}
+	r1 = notMocked("AuthenticationService.LoginWithContext")
+	return
+}
+
+// NewLogoutParams records the call and calls NewLogoutParamsFunc
+func (m *MockAuthenticationService) NewLogoutParams() (r0 *cloudstack.LogoutParams) {
+	m.record("NewLogoutParams")
+	if m.NewLogoutParamsFunc != nil {
+		return m.NewLogoutParamsFunc()
+	}
+	return cloudstack.NewAuthenticationService(nil).NewLogoutParams()
+}
+
+// Logout records the call and calls LogoutFunc, or LogoutWithContextFunc if only that is set
+func (m *MockAuthenticationService) Logout(p *cloudstack.LogoutParams) (r0 *cloudstack.LogoutResponse, r1 error) {
+	m.record("Logout", p)
+	if m.LogoutFunc != nil {
+		return m.LogoutFunc(p)
+	}
+	if m.LogoutWithContextFunc != nil {
+		return m.LogoutWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AuthenticationService.Logout")
+	return
+}
+
+// LogoutWithContext records the call and calls LogoutWithContextFunc, or LogoutFunc if only that is set
+func (m *MockAuthenticationService) LogoutWithContext(ctx context.Context, p *cloudstack.LogoutParams) (r0 *cloudstack.LogoutResponse, r1 error) {
+	m.record("LogoutWithContext", ctx, p)
+	if m.LogoutWithContextFunc != nil {
+		return m.LogoutWithContextFunc(ctx, p)
+	}
+	if m.LogoutFunc != nil {
+		return m.LogoutFunc(p)
+	}
+	r1 = notMocked("AuthenticationService.LogoutWithContext")
+	return
+}
+
+// NewSamlSloParams records the call and calls NewSamlSloParamsFunc
+func (m *MockAuthenticationService) NewSamlSloParams() (r0 *cloudstack.SamlSloParams) {
+	m.record("NewSamlSloParams")
+	if m.NewSamlSloParamsFunc != nil {
+		return m.NewSamlSloParamsFunc()
+	}
+	return cloudstack.NewAuthenticationService(nil).NewSamlSloParams()
+}
+
+// SamlSlo records the call and calls SamlSloFunc, or SamlSloWithContextFunc if only that is set
+func (m *MockAuthenticationService) SamlSlo(p *cloudstack.SamlSloParams) (r0 *cloudstack.SamlSloResponse, r1 error) {
+	m.record("SamlSlo", p)
+	if m.SamlSloFunc != nil {
+		return m.SamlSloFunc(p)
+	}
+	if m.SamlSloWithContextFunc != nil {
+		return m.SamlSloWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AuthenticationService.SamlSlo")
+	return
+}
+
+// SamlSloWithContext records the call and calls SamlSloWithContextFunc, or SamlSloFunc if only that is set
+func (m *MockAuthenticationService) SamlSloWithContext(ctx context.Context, p *cloudstack.SamlSloParams) (r0 *cloudstack.SamlSloResponse, r1 error) {
+	m.record("SamlSloWithContext", ctx, p)
+	if m.SamlSloWithContextFunc != nil {
+		return m.SamlSloWithContextFunc(ctx, p)
+	}
+	if m.SamlSloFunc != nil {
+		return m.SamlSloFunc(p)
+	}
+	r1 = notMocked("AuthenticationService.SamlSloWithContext")
+	return
+}
+
+// NewSamlSsoParams records the call and calls NewSamlSsoParamsFunc
+func (m *MockAuthenticationService) NewSamlSsoParams(idpid string) (r0 *cloudstack.SamlSsoParams) {
+	m.record("NewSamlSsoParams", idpid)
+	if m.NewSamlSsoParamsFunc != nil {
+		return m.NewSamlSsoParamsFunc(idpid)
+	}
+	return cloudstack.NewAuthenticationService(nil).NewSamlSsoParams(idpid)
+}
+
+// SamlSso records the call and calls SamlSsoFunc, or SamlSsoWithContextFunc if only that is set
+func (m *MockAuthenticationService) SamlSso(p *cloudstack.SamlSsoParams) (r0 *cloudstack.SamlSsoResponse, r1 error) {
+	m.record("SamlSso", p)
+	if m.SamlSsoFunc != nil {
+		return m.SamlSsoFunc(p)
+	}
+	if m.SamlSsoWithContextFunc != nil {
+		return m.SamlSsoWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AuthenticationService.SamlSso")
+	return
+}
+
+// SamlSsoWithContext records the call and calls SamlSsoWithContextFunc, or SamlSsoFunc if only that is set
+func (m *MockAuthenticationService) SamlSsoWithContext(ctx context.Context, p *cloudstack.SamlSsoParams) (r0 *cloudstack.SamlSsoResponse, r1 error) {
+	m.record("SamlSsoWithContext", ctx, p)
+	if m.SamlSsoWithContextFunc != nil {
+		return m.SamlSsoWithContextFunc(ctx, p)
+	}
+	if m.SamlSsoFunc != nil {
+		return m.SamlSsoFunc(p)
+	}
+	r1 = notMocked("AuthenticationService.SamlSsoWithContext")
+	return
+}
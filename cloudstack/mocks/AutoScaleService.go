@@ -0,0 +1,1605 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockAutoScaleService is a mock of cloudstack.AutoScaleServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockAutoScaleService struct {
+	Recorder
+
+	NewCreateAutoScalePolicyParamsFunc           func(action string, conditionids []string, duration int) *cloudstack.CreateAutoScalePolicyParams
+	CreateAutoScalePolicyFunc                    func(p *cloudstack.CreateAutoScalePolicyParams) (*cloudstack.CreateAutoScalePolicyResponse, error)
+	CreateAutoScalePolicyWithContextFunc         func(ctx context.Context, p *cloudstack.CreateAutoScalePolicyParams) (*cloudstack.CreateAutoScalePolicyResponse, error)
+	CreateAutoScalePolicyAsyncFunc               func(p *cloudstack.CreateAutoScalePolicyParams) (*cloudstack.Job, error)
+	CreateAutoScalePolicyAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.CreateAutoScalePolicyParams) (*cloudstack.Job, error)
+	NewCreateAutoScaleVmGroupParamsFunc          func(lbruleid string, maxmembers int, minmembers int, scaledownpolicyids []string, scaleuppolicyids []string, vmprofileid string) *cloudstack.CreateAutoScaleVmGroupParams
+	CreateAutoScaleVmGroupFunc                   func(p *cloudstack.CreateAutoScaleVmGroupParams) (*cloudstack.CreateAutoScaleVmGroupResponse, error)
+	CreateAutoScaleVmGroupWithContextFunc        func(ctx context.Context, p *cloudstack.CreateAutoScaleVmGroupParams) (*cloudstack.CreateAutoScaleVmGroupResponse, error)
+	CreateAutoScaleVmGroupAsyncFunc              func(p *cloudstack.CreateAutoScaleVmGroupParams) (*cloudstack.Job, error)
+	CreateAutoScaleVmGroupAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.CreateAutoScaleVmGroupParams) (*cloudstack.Job, error)
+	NewCreateAutoScaleVmProfileParamsFunc        func(serviceofferingid string, templateid string, zoneid string) *cloudstack.CreateAutoScaleVmProfileParams
+	CreateAutoScaleVmProfileFunc                 func(p *cloudstack.CreateAutoScaleVmProfileParams) (*cloudstack.CreateAutoScaleVmProfileResponse, error)
+	CreateAutoScaleVmProfileWithContextFunc      func(ctx context.Context, p *cloudstack.CreateAutoScaleVmProfileParams) (*cloudstack.CreateAutoScaleVmProfileResponse, error)
+	CreateAutoScaleVmProfileAsyncFunc            func(p *cloudstack.CreateAutoScaleVmProfileParams) (*cloudstack.Job, error)
+	CreateAutoScaleVmProfileAsyncWithContextFunc func(ctx context.Context, p *cloudstack.CreateAutoScaleVmProfileParams) (*cloudstack.Job, error)
+	NewCreateConditionParamsFunc                 func(counterid string, relationaloperator string, threshold int64) *cloudstack.CreateConditionParams
+	CreateConditionFunc                          func(p *cloudstack.CreateConditionParams) (*cloudstack.CreateConditionResponse, error)
+	CreateConditionWithContextFunc               func(ctx context.Context, p *cloudstack.CreateConditionParams) (*cloudstack.CreateConditionResponse, error)
+	CreateConditionAsyncFunc                     func(p *cloudstack.CreateConditionParams) (*cloudstack.Job, error)
+	CreateConditionAsyncWithContextFunc          func(ctx context.Context, p *cloudstack.CreateConditionParams) (*cloudstack.Job, error)
+	NewCreateCounterParamsFunc                   func(name string, source string, value string) *cloudstack.CreateCounterParams
+	CreateCounterFunc                            func(p *cloudstack.CreateCounterParams) (*cloudstack.CreateCounterResponse, error)
+	CreateCounterWithContextFunc                 func(ctx context.Context, p *cloudstack.CreateCounterParams) (*cloudstack.CreateCounterResponse, error)
+	CreateCounterAsyncFunc                       func(p *cloudstack.CreateCounterParams) (*cloudstack.Job, error)
+	CreateCounterAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.CreateCounterParams) (*cloudstack.Job, error)
+	NewDeleteAutoScalePolicyParamsFunc           func(id string) *cloudstack.DeleteAutoScalePolicyParams
+	DeleteAutoScalePolicyFunc                    func(p *cloudstack.DeleteAutoScalePolicyParams) (*cloudstack.DeleteAutoScalePolicyResponse, error)
+	DeleteAutoScalePolicyWithContextFunc         func(ctx context.Context, p *cloudstack.DeleteAutoScalePolicyParams) (*cloudstack.DeleteAutoScalePolicyResponse, error)
+	DeleteAutoScalePolicyAsyncFunc               func(p *cloudstack.DeleteAutoScalePolicyParams) (*cloudstack.Job, error)
+	DeleteAutoScalePolicyAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.DeleteAutoScalePolicyParams) (*cloudstack.Job, error)
+	NewDeleteAutoScaleVmGroupParamsFunc          func(id string) *cloudstack.DeleteAutoScaleVmGroupParams
+	DeleteAutoScaleVmGroupFunc                   func(p *cloudstack.DeleteAutoScaleVmGroupParams) (*cloudstack.DeleteAutoScaleVmGroupResponse, error)
+	DeleteAutoScaleVmGroupWithContextFunc        func(ctx context.Context, p *cloudstack.DeleteAutoScaleVmGroupParams) (*cloudstack.DeleteAutoScaleVmGroupResponse, error)
+	DeleteAutoScaleVmGroupAsyncFunc              func(p *cloudstack.DeleteAutoScaleVmGroupParams) (*cloudstack.Job, error)
+	DeleteAutoScaleVmGroupAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.DeleteAutoScaleVmGroupParams) (*cloudstack.Job, error)
+	NewDeleteAutoScaleVmProfileParamsFunc        func(id string) *cloudstack.DeleteAutoScaleVmProfileParams
+	DeleteAutoScaleVmProfileFunc                 func(p *cloudstack.DeleteAutoScaleVmProfileParams) (*cloudstack.DeleteAutoScaleVmProfileResponse, error)
+	DeleteAutoScaleVmProfileWithContextFunc      func(ctx context.Context, p *cloudstack.DeleteAutoScaleVmProfileParams) (*cloudstack.DeleteAutoScaleVmProfileResponse, error)
+	DeleteAutoScaleVmProfileAsyncFunc            func(p *cloudstack.DeleteAutoScaleVmProfileParams) (*cloudstack.Job, error)
+	DeleteAutoScaleVmProfileAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DeleteAutoScaleVmProfileParams) (*cloudstack.Job, error)
+	NewDeleteConditionParamsFunc                 func(id string) *cloudstack.DeleteConditionParams
+	DeleteConditionFunc                          func(p *cloudstack.DeleteConditionParams) (*cloudstack.DeleteConditionResponse, error)
+	DeleteConditionWithContextFunc               func(ctx context.Context, p *cloudstack.DeleteConditionParams) (*cloudstack.DeleteConditionResponse, error)
+	DeleteConditionAsyncFunc                     func(p *cloudstack.DeleteConditionParams) (*cloudstack.Job, error)
+	DeleteConditionAsyncWithContextFunc          func(ctx context.Context, p *cloudstack.DeleteConditionParams) (*cloudstack.Job, error)
+	NewDeleteCounterParamsFunc                   func(id string) *cloudstack.DeleteCounterParams
+	DeleteCounterFunc                            func(p *cloudstack.DeleteCounterParams) (*cloudstack.DeleteCounterResponse, error)
+	DeleteCounterWithContextFunc                 func(ctx context.Context, p *cloudstack.DeleteCounterParams) (*cloudstack.DeleteCounterResponse, error)
+	DeleteCounterAsyncFunc                       func(p *cloudstack.DeleteCounterParams) (*cloudstack.Job, error)
+	DeleteCounterAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.DeleteCounterParams) (*cloudstack.Job, error)
+	NewDisableAutoScaleVmGroupParamsFunc         func(id string) *cloudstack.DisableAutoScaleVmGroupParams
+	DisableAutoScaleVmGroupFunc                  func(p *cloudstack.DisableAutoScaleVmGroupParams) (*cloudstack.DisableAutoScaleVmGroupResponse, error)
+	DisableAutoScaleVmGroupWithContextFunc       func(ctx context.Context, p *cloudstack.DisableAutoScaleVmGroupParams) (*cloudstack.DisableAutoScaleVmGroupResponse, error)
+	DisableAutoScaleVmGroupAsyncFunc             func(p *cloudstack.DisableAutoScaleVmGroupParams) (*cloudstack.Job, error)
+	DisableAutoScaleVmGroupAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.DisableAutoScaleVmGroupParams) (*cloudstack.Job, error)
+	NewEnableAutoScaleVmGroupParamsFunc          func(id string) *cloudstack.EnableAutoScaleVmGroupParams
+	EnableAutoScaleVmGroupFunc                   func(p *cloudstack.EnableAutoScaleVmGroupParams) (*cloudstack.EnableAutoScaleVmGroupResponse, error)
+	EnableAutoScaleVmGroupWithContextFunc        func(ctx context.Context, p *cloudstack.EnableAutoScaleVmGroupParams) (*cloudstack.EnableAutoScaleVmGroupResponse, error)
+	EnableAutoScaleVmGroupAsyncFunc              func(p *cloudstack.EnableAutoScaleVmGroupParams) (*cloudstack.Job, error)
+	EnableAutoScaleVmGroupAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.EnableAutoScaleVmGroupParams) (*cloudstack.Job, error)
+	NewListAutoScalePoliciesParamsFunc           func() *cloudstack.ListAutoScalePoliciesParams
+	GetAutoScalePolicyByIDFunc                   func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.AutoScalePolicy, int, error)
+	GetAutoScalePolicyByIDWithContextFunc        func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.AutoScalePolicy, int, error)
+	ListAutoScalePoliciesFunc                    func(p *cloudstack.ListAutoScalePoliciesParams) (*cloudstack.ListAutoScalePoliciesResponse, error)
+	ListAutoScalePoliciesWithContextFunc         func(ctx context.Context, p *cloudstack.ListAutoScalePoliciesParams) (*cloudstack.ListAutoScalePoliciesResponse, error)
+	NewListAutoScalePoliciesPagerFunc            func(p *cloudstack.ListAutoScalePoliciesParams) *cloudstack.ListAutoScalePoliciesPager
+	ListAutoScalePoliciesAllFunc                 func(p *cloudstack.ListAutoScalePoliciesParams) (*cloudstack.ListAutoScalePoliciesResponse, error)
+	ListAutoScalePoliciesAllWithContextFunc      func(ctx context.Context, p *cloudstack.ListAutoScalePoliciesParams) (*cloudstack.ListAutoScalePoliciesResponse, error)
+	NewListAutoScaleVmGroupsParamsFunc           func() *cloudstack.ListAutoScaleVmGroupsParams
+	GetAutoScaleVmGroupByIDFunc                  func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.AutoScaleVmGroup, int, error)
+	GetAutoScaleVmGroupByIDWithContextFunc       func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.AutoScaleVmGroup, int, error)
+	ListAutoScaleVmGroupsFunc                    func(p *cloudstack.ListAutoScaleVmGroupsParams) (*cloudstack.ListAutoScaleVmGroupsResponse, error)
+	ListAutoScaleVmGroupsWithContextFunc         func(ctx context.Context, p *cloudstack.ListAutoScaleVmGroupsParams) (*cloudstack.ListAutoScaleVmGroupsResponse, error)
+	NewListAutoScaleVmGroupsPagerFunc            func(p *cloudstack.ListAutoScaleVmGroupsParams) *cloudstack.ListAutoScaleVmGroupsPager
+	ListAutoScaleVmGroupsAllFunc                 func(p *cloudstack.ListAutoScaleVmGroupsParams) (*cloudstack.ListAutoScaleVmGroupsResponse, error)
+	ListAutoScaleVmGroupsAllWithContextFunc      func(ctx context.Context, p *cloudstack.ListAutoScaleVmGroupsParams) (*cloudstack.ListAutoScaleVmGroupsResponse, error)
+	NewListAutoScaleVmProfilesParamsFunc         func() *cloudstack.ListAutoScaleVmProfilesParams
+	GetAutoScaleVmProfileByIDFunc                func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.AutoScaleVmProfile, int, error)
+	GetAutoScaleVmProfileByIDWithContextFunc     func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.AutoScaleVmProfile, int, error)
+	ListAutoScaleVmProfilesFunc                  func(p *cloudstack.ListAutoScaleVmProfilesParams) (*cloudstack.ListAutoScaleVmProfilesResponse, error)
+	ListAutoScaleVmProfilesWithContextFunc       func(ctx context.Context, p *cloudstack.ListAutoScaleVmProfilesParams) (*cloudstack.ListAutoScaleVmProfilesResponse, error)
+	NewListAutoScaleVmProfilesPagerFunc          func(p *cloudstack.ListAutoScaleVmProfilesParams) *cloudstack.ListAutoScaleVmProfilesPager
+	ListAutoScaleVmProfilesAllFunc               func(p *cloudstack.ListAutoScaleVmProfilesParams) (*cloudstack.ListAutoScaleVmProfilesResponse, error)
+	ListAutoScaleVmProfilesAllWithContextFunc    func(ctx context.Context, p *cloudstack.ListAutoScaleVmProfilesParams) (*cloudstack.ListAutoScaleVmProfilesResponse, error)
+	NewListConditionsParamsFunc                  func() *cloudstack.ListConditionsParams
+	GetConditionByIDFunc                         func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Condition, int, error)
+	GetConditionByIDWithContextFunc              func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Condition, int, error)
+	ListConditionsFunc                           func(p *cloudstack.ListConditionsParams) (*cloudstack.ListConditionsResponse, error)
+	ListConditionsWithContextFunc                func(ctx context.Context, p *cloudstack.ListConditionsParams) (*cloudstack.ListConditionsResponse, error)
+	NewListConditionsPagerFunc                   func(p *cloudstack.ListConditionsParams) *cloudstack.ListConditionsPager
+	ListConditionsAllFunc                        func(p *cloudstack.ListConditionsParams) (*cloudstack.ListConditionsResponse, error)
+	ListConditionsAllWithContextFunc             func(ctx context.Context, p *cloudstack.ListConditionsParams) (*cloudstack.ListConditionsResponse, error)
+	NewListCountersParamsFunc                    func() *cloudstack.ListCountersParams
+	GetCounterIDFunc                             func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetCounterIDWithContextFunc                  func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetCounterByNameFunc                         func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Counter, int, error)
+	GetCounterByNameWithContextFunc              func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.Counter, int, error)
+	GetCounterByIDFunc                           func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Counter, int, error)
+	GetCounterByIDWithContextFunc                func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Counter, int, error)
+	ListCountersFunc                             func(p *cloudstack.ListCountersParams) (*cloudstack.ListCountersResponse, error)
+	ListCountersWithContextFunc                  func(ctx context.Context, p *cloudstack.ListCountersParams) (*cloudstack.ListCountersResponse, error)
+	NewListCountersPagerFunc                     func(p *cloudstack.ListCountersParams) *cloudstack.ListCountersPager
+	ListCountersAllFunc                          func(p *cloudstack.ListCountersParams) (*cloudstack.ListCountersResponse, error)
+	ListCountersAllWithContextFunc               func(ctx context.Context, p *cloudstack.ListCountersParams) (*cloudstack.ListCountersResponse, error)
+	NewUpdateAutoScalePolicyParamsFunc           func(id string) *cloudstack.UpdateAutoScalePolicyParams
+	UpdateAutoScalePolicyFunc                    func(p *cloudstack.UpdateAutoScalePolicyParams) (*cloudstack.UpdateAutoScalePolicyResponse, error)
+	UpdateAutoScalePolicyWithContextFunc         func(ctx context.Context, p *cloudstack.UpdateAutoScalePolicyParams) (*cloudstack.UpdateAutoScalePolicyResponse, error)
+	UpdateAutoScalePolicyAsyncFunc               func(p *cloudstack.UpdateAutoScalePolicyParams) (*cloudstack.Job, error)
+	UpdateAutoScalePolicyAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.UpdateAutoScalePolicyParams) (*cloudstack.Job, error)
+	NewUpdateAutoScaleVmGroupParamsFunc          func(id string) *cloudstack.UpdateAutoScaleVmGroupParams
+	UpdateAutoScaleVmGroupFunc                   func(p *cloudstack.UpdateAutoScaleVmGroupParams) (*cloudstack.UpdateAutoScaleVmGroupResponse, error)
+	UpdateAutoScaleVmGroupWithContextFunc        func(ctx context.Context, p *cloudstack.UpdateAutoScaleVmGroupParams) (*cloudstack.UpdateAutoScaleVmGroupResponse, error)
+	UpdateAutoScaleVmGroupAsyncFunc              func(p *cloudstack.UpdateAutoScaleVmGroupParams) (*cloudstack.Job, error)
+	UpdateAutoScaleVmGroupAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.UpdateAutoScaleVmGroupParams) (*cloudstack.Job, error)
+	NewUpdateAutoScaleVmProfileParamsFunc        func(id string) *cloudstack.UpdateAutoScaleVmProfileParams
+	UpdateAutoScaleVmProfileFunc                 func(p *cloudstack.UpdateAutoScaleVmProfileParams) (*cloudstack.UpdateAutoScaleVmProfileResponse, error)
+	UpdateAutoScaleVmProfileWithContextFunc      func(ctx context.Context, p *cloudstack.UpdateAutoScaleVmProfileParams) (*cloudstack.UpdateAutoScaleVmProfileResponse, error)
+	UpdateAutoScaleVmProfileAsyncFunc            func(p *cloudstack.UpdateAutoScaleVmProfileParams) (*cloudstack.Job, error)
+	UpdateAutoScaleVmProfileAsyncWithContextFunc func(ctx context.Context, p *cloudstack.UpdateAutoScaleVmProfileParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.AutoScaleServiceIface = (*MockAutoScaleService)(nil)
+
+// NewCreateAutoScalePolicyParams records the call and calls NewCreateAutoScalePolicyParamsFunc
+func (m *MockAutoScaleService) NewCreateAutoScalePolicyParams(action string, conditionids []string, duration int) (r0 *cloudstack.CreateAutoScalePolicyParams) {
+	m.record("NewCreateAutoScalePolicyParams", action, conditionids, duration)
+	if m.NewCreateAutoScalePolicyParamsFunc != nil {
+		return m.NewCreateAutoScalePolicyParamsFunc(action, conditionids, duration)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewCreateAutoScalePolicyParams(action, conditionids, duration)
+}
+
+// CreateAutoScalePolicy records the call and calls CreateAutoScalePolicyFunc, or CreateAutoScalePolicyWithContextFunc if only that is set
+func (m *MockAutoScaleService) CreateAutoScalePolicy(p *cloudstack.CreateAutoScalePolicyParams) (r0 *cloudstack.CreateAutoScalePolicyResponse, r1 error) {
+	m.record("CreateAutoScalePolicy", p)
+	if m.CreateAutoScalePolicyFunc != nil {
+		return m.CreateAutoScalePolicyFunc(p)
+	}
+	if m.CreateAutoScalePolicyWithContextFunc != nil {
+		return m.CreateAutoScalePolicyWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.CreateAutoScalePolicy")
+	return
+}
+
+// CreateAutoScalePolicyWithContext records the call and calls CreateAutoScalePolicyWithContextFunc, or CreateAutoScalePolicyFunc if only that is set
+func (m *MockAutoScaleService) CreateAutoScalePolicyWithContext(ctx context.Context, p *cloudstack.CreateAutoScalePolicyParams) (r0 *cloudstack.CreateAutoScalePolicyResponse, r1 error) {
+	m.record("CreateAutoScalePolicyWithContext", ctx, p)
+	if m.CreateAutoScalePolicyWithContextFunc != nil {
+		return m.CreateAutoScalePolicyWithContextFunc(ctx, p)
+	}
+	if m.CreateAutoScalePolicyFunc != nil {
+		return m.CreateAutoScalePolicyFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.CreateAutoScalePolicyWithContext")
+	return
+}
+
+// CreateAutoScalePolicyAsync records the call and calls CreateAutoScalePolicyAsyncFunc, or CreateAutoScalePolicyAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) CreateAutoScalePolicyAsync(p *cloudstack.CreateAutoScalePolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateAutoScalePolicyAsync", p)
+	if m.CreateAutoScalePolicyAsyncFunc != nil {
+		return m.CreateAutoScalePolicyAsyncFunc(p)
+	}
+	if m.CreateAutoScalePolicyAsyncWithContextFunc != nil {
+		return m.CreateAutoScalePolicyAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.CreateAutoScalePolicyAsync")
+	return
+}
+
+// CreateAutoScalePolicyAsyncWithContext records the call and calls CreateAutoScalePolicyAsyncWithContextFunc, or CreateAutoScalePolicyAsyncFunc if only that is set
+func (m *MockAutoScaleService) CreateAutoScalePolicyAsyncWithContext(ctx context.Context, p *cloudstack.CreateAutoScalePolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateAutoScalePolicyAsyncWithContext", ctx, p)
+	if m.CreateAutoScalePolicyAsyncWithContextFunc != nil {
+		return m.CreateAutoScalePolicyAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateAutoScalePolicyAsyncFunc != nil {
+		return m.CreateAutoScalePolicyAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.CreateAutoScalePolicyAsyncWithContext")
+	return
+}
+
+// NewCreateAutoScaleVmGroupParams records the call and calls NewCreateAutoScaleVmGroupParamsFunc
+func (m *MockAutoScaleService) NewCreateAutoScaleVmGroupParams(lbruleid string, maxmembers int, minmembers int, scaledownpolicyids []string, scaleuppolicyids []string, vmprofileid string) (r0 *cloudstack.CreateAutoScaleVmGroupParams) {
+	m.record("NewCreateAutoScaleVmGroupParams", lbruleid, maxmembers, minmembers, scaledownpolicyids, scaleuppolicyids, vmprofileid)
+	if m.NewCreateAutoScaleVmGroupParamsFunc != nil {
+		return m.NewCreateAutoScaleVmGroupParamsFunc(lbruleid, maxmembers, minmembers, scaledownpolicyids, scaleuppolicyids, vmprofileid)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewCreateAutoScaleVmGroupParams(lbruleid, maxmembers, minmembers, scaledownpolicyids, scaleuppolicyids, vmprofileid)
+}
+
+// CreateAutoScaleVmGroup records the call and calls CreateAutoScaleVmGroupFunc, or CreateAutoScaleVmGroupWithContextFunc if only that is set
+func (m *MockAutoScaleService) CreateAutoScaleVmGroup(p *cloudstack.CreateAutoScaleVmGroupParams) (r0 *cloudstack.CreateAutoScaleVmGroupResponse, r1 error) {
+	m.record("CreateAutoScaleVmGroup", p)
+	if m.CreateAutoScaleVmGroupFunc != nil {
+		return m.CreateAutoScaleVmGroupFunc(p)
+	}
+	if m.CreateAutoScaleVmGroupWithContextFunc != nil {
+		return m.CreateAutoScaleVmGroupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.CreateAutoScaleVmGroup")
+	return
+}
+
+// CreateAutoScaleVmGroupWithContext records the call and calls CreateAutoScaleVmGroupWithContextFunc, or CreateAutoScaleVmGroupFunc if only that is set
+func (m *MockAutoScaleService) CreateAutoScaleVmGroupWithContext(ctx context.Context, p *cloudstack.CreateAutoScaleVmGroupParams) (r0 *cloudstack.CreateAutoScaleVmGroupResponse, r1 error) {
+	m.record("CreateAutoScaleVmGroupWithContext", ctx, p)
+	if m.CreateAutoScaleVmGroupWithContextFunc != nil {
+		return m.CreateAutoScaleVmGroupWithContextFunc(ctx, p)
+	}
+	if m.CreateAutoScaleVmGroupFunc != nil {
+		return m.CreateAutoScaleVmGroupFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.CreateAutoScaleVmGroupWithContext")
+	return
+}
+
+// CreateAutoScaleVmGroupAsync records the call and calls CreateAutoScaleVmGroupAsyncFunc, or CreateAutoScaleVmGroupAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) CreateAutoScaleVmGroupAsync(p *cloudstack.CreateAutoScaleVmGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateAutoScaleVmGroupAsync", p)
+	if m.CreateAutoScaleVmGroupAsyncFunc != nil {
+		return m.CreateAutoScaleVmGroupAsyncFunc(p)
+	}
+	if m.CreateAutoScaleVmGroupAsyncWithContextFunc != nil {
+		return m.CreateAutoScaleVmGroupAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.CreateAutoScaleVmGroupAsync")
+	return
+}
+
+// CreateAutoScaleVmGroupAsyncWithContext records the call and calls CreateAutoScaleVmGroupAsyncWithContextFunc, or CreateAutoScaleVmGroupAsyncFunc if only that is set
+func (m *MockAutoScaleService) CreateAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *cloudstack.CreateAutoScaleVmGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateAutoScaleVmGroupAsyncWithContext", ctx, p)
+	if m.CreateAutoScaleVmGroupAsyncWithContextFunc != nil {
+		return m.CreateAutoScaleVmGroupAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateAutoScaleVmGroupAsyncFunc != nil {
+		return m.CreateAutoScaleVmGroupAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.CreateAutoScaleVmGroupAsyncWithContext")
+	return
+}
+
+// NewCreateAutoScaleVmProfileParams records the call and calls NewCreateAutoScaleVmProfileParamsFunc
+func (m *MockAutoScaleService) NewCreateAutoScaleVmProfileParams(serviceofferingid string, templateid string, zoneid string) (r0 *cloudstack.CreateAutoScaleVmProfileParams) {
+	m.record("NewCreateAutoScaleVmProfileParams", serviceofferingid, templateid, zoneid)
+	if m.NewCreateAutoScaleVmProfileParamsFunc != nil {
+		return m.NewCreateAutoScaleVmProfileParamsFunc(serviceofferingid, templateid, zoneid)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewCreateAutoScaleVmProfileParams(serviceofferingid, templateid, zoneid)
+}
+
+// CreateAutoScaleVmProfile records the call and calls CreateAutoScaleVmProfileFunc, or CreateAutoScaleVmProfileWithContextFunc if only that is set
+func (m *MockAutoScaleService) CreateAutoScaleVmProfile(p *cloudstack.CreateAutoScaleVmProfileParams) (r0 *cloudstack.CreateAutoScaleVmProfileResponse, r1 error) {
+	m.record("CreateAutoScaleVmProfile", p)
+	if m.CreateAutoScaleVmProfileFunc != nil {
+		return m.CreateAutoScaleVmProfileFunc(p)
+	}
+	if m.CreateAutoScaleVmProfileWithContextFunc != nil {
+		return m.CreateAutoScaleVmProfileWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.CreateAutoScaleVmProfile")
+	return
+}
+
+// CreateAutoScaleVmProfileWithContext records the call and calls CreateAutoScaleVmProfileWithContextFunc, or CreateAutoScaleVmProfileFunc if only that is set
+func (m *MockAutoScaleService) CreateAutoScaleVmProfileWithContext(ctx context.Context, p *cloudstack.CreateAutoScaleVmProfileParams) (r0 *cloudstack.CreateAutoScaleVmProfileResponse, r1 error) {
+	m.record("CreateAutoScaleVmProfileWithContext", ctx, p)
+	if m.CreateAutoScaleVmProfileWithContextFunc != nil {
+		return m.CreateAutoScaleVmProfileWithContextFunc(ctx, p)
+	}
+	if m.CreateAutoScaleVmProfileFunc != nil {
+		return m.CreateAutoScaleVmProfileFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.CreateAutoScaleVmProfileWithContext")
+	return
+}
+
+// CreateAutoScaleVmProfileAsync records the call and calls CreateAutoScaleVmProfileAsyncFunc, or CreateAutoScaleVmProfileAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) CreateAutoScaleVmProfileAsync(p *cloudstack.CreateAutoScaleVmProfileParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateAutoScaleVmProfileAsync", p)
+	if m.CreateAutoScaleVmProfileAsyncFunc != nil {
+		return m.CreateAutoScaleVmProfileAsyncFunc(p)
+	}
+	if m.CreateAutoScaleVmProfileAsyncWithContextFunc != nil {
+		return m.CreateAutoScaleVmProfileAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.CreateAutoScaleVmProfileAsync")
+	return
+}
+
+// CreateAutoScaleVmProfileAsyncWithContext records the call and calls CreateAutoScaleVmProfileAsyncWithContextFunc, or CreateAutoScaleVmProfileAsyncFunc if only that is set
+func (m *MockAutoScaleService) CreateAutoScaleVmProfileAsyncWithContext(ctx context.Context, p *cloudstack.CreateAutoScaleVmProfileParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateAutoScaleVmProfileAsyncWithContext", ctx, p)
+	if m.CreateAutoScaleVmProfileAsyncWithContextFunc != nil {
+		return m.CreateAutoScaleVmProfileAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateAutoScaleVmProfileAsyncFunc != nil {
+		return m.CreateAutoScaleVmProfileAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.CreateAutoScaleVmProfileAsyncWithContext")
+	return
+}
+
+// NewCreateConditionParams records the call and calls NewCreateConditionParamsFunc
+func (m *MockAutoScaleService) NewCreateConditionParams(counterid string, relationaloperator string, threshold int64) (r0 *cloudstack.CreateConditionParams) {
+	m.record("NewCreateConditionParams", counterid, relationaloperator, threshold)
+	if m.NewCreateConditionParamsFunc != nil {
+		return m.NewCreateConditionParamsFunc(counterid, relationaloperator, threshold)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewCreateConditionParams(counterid, relationaloperator, threshold)
+}
+
+// CreateCondition records the call and calls CreateConditionFunc, or CreateConditionWithContextFunc if only that is set
+func (m *MockAutoScaleService) CreateCondition(p *cloudstack.CreateConditionParams) (r0 *cloudstack.CreateConditionResponse, r1 error) {
+	m.record("CreateCondition", p)
+	if m.CreateConditionFunc != nil {
+		return m.CreateConditionFunc(p)
+	}
+	if m.CreateConditionWithContextFunc != nil {
+		return m.CreateConditionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.CreateCondition")
+	return
+}
+
+// CreateConditionWithContext records the call and calls CreateConditionWithContextFunc, or CreateConditionFunc if only that is set
+func (m *MockAutoScaleService) CreateConditionWithContext(ctx context.Context, p *cloudstack.CreateConditionParams) (r0 *cloudstack.CreateConditionResponse, r1 error) {
+	m.record("CreateConditionWithContext", ctx, p)
+	if m.CreateConditionWithContextFunc != nil {
+		return m.CreateConditionWithContextFunc(ctx, p)
+	}
+	if m.CreateConditionFunc != nil {
+		return m.CreateConditionFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.CreateConditionWithContext")
+	return
+}
+
+// CreateConditionAsync records the call and calls CreateConditionAsyncFunc, or CreateConditionAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) CreateConditionAsync(p *cloudstack.CreateConditionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateConditionAsync", p)
+	if m.CreateConditionAsyncFunc != nil {
+		return m.CreateConditionAsyncFunc(p)
+	}
+	if m.CreateConditionAsyncWithContextFunc != nil {
+		return m.CreateConditionAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.CreateConditionAsync")
+	return
+}
+
+// CreateConditionAsyncWithContext records the call and calls CreateConditionAsyncWithContextFunc, or CreateConditionAsyncFunc if only that is set
+func (m *MockAutoScaleService) CreateConditionAsyncWithContext(ctx context.Context, p *cloudstack.CreateConditionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateConditionAsyncWithContext", ctx, p)
+	if m.CreateConditionAsyncWithContextFunc != nil {
+		return m.CreateConditionAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateConditionAsyncFunc != nil {
+		return m.CreateConditionAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.CreateConditionAsyncWithContext")
+	return
+}
+
+// NewCreateCounterParams records the call and calls NewCreateCounterParamsFunc
+func (m *MockAutoScaleService) NewCreateCounterParams(name string, source string, value string) (r0 *cloudstack.CreateCounterParams) {
+	m.record("NewCreateCounterParams", name, source, value)
+	if m.NewCreateCounterParamsFunc != nil {
+		return m.NewCreateCounterParamsFunc(name, source, value)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewCreateCounterParams(name, source, value)
+}
+
+// CreateCounter records the call and calls CreateCounterFunc, or CreateCounterWithContextFunc if only that is set
+func (m *MockAutoScaleService) CreateCounter(p *cloudstack.CreateCounterParams) (r0 *cloudstack.CreateCounterResponse, r1 error) {
+	m.record("CreateCounter", p)
+	if m.CreateCounterFunc != nil {
+		return m.CreateCounterFunc(p)
+	}
+	if m.CreateCounterWithContextFunc != nil {
+		return m.CreateCounterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.CreateCounter")
+	return
+}
+
+// CreateCounterWithContext records the call and calls CreateCounterWithContextFunc, or CreateCounterFunc if only that is set
+func (m *MockAutoScaleService) CreateCounterWithContext(ctx context.Context, p *cloudstack.CreateCounterParams) (r0 *cloudstack.CreateCounterResponse, r1 error) {
+	m.record("CreateCounterWithContext", ctx, p)
+	if m.CreateCounterWithContextFunc != nil {
+		return m.CreateCounterWithContextFunc(ctx, p)
+	}
+	if m.CreateCounterFunc != nil {
+		return m.CreateCounterFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.CreateCounterWithContext")
+	return
+}
+
+// CreateCounterAsync records the call and calls CreateCounterAsyncFunc, or CreateCounterAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) CreateCounterAsync(p *cloudstack.CreateCounterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateCounterAsync", p)
+	if m.CreateCounterAsyncFunc != nil {
+		return m.CreateCounterAsyncFunc(p)
+	}
+	if m.CreateCounterAsyncWithContextFunc != nil {
+		return m.CreateCounterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.CreateCounterAsync")
+	return
+}
+
+// CreateCounterAsyncWithContext records the call and calls CreateCounterAsyncWithContextFunc, or CreateCounterAsyncFunc if only that is set
+func (m *MockAutoScaleService) CreateCounterAsyncWithContext(ctx context.Context, p *cloudstack.CreateCounterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateCounterAsyncWithContext", ctx, p)
+	if m.CreateCounterAsyncWithContextFunc != nil {
+		return m.CreateCounterAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateCounterAsyncFunc != nil {
+		return m.CreateCounterAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.CreateCounterAsyncWithContext")
+	return
+}
+
+// NewDeleteAutoScalePolicyParams records the call and calls NewDeleteAutoScalePolicyParamsFunc
+func (m *MockAutoScaleService) NewDeleteAutoScalePolicyParams(id string) (r0 *cloudstack.DeleteAutoScalePolicyParams) {
+	m.record("NewDeleteAutoScalePolicyParams", id)
+	if m.NewDeleteAutoScalePolicyParamsFunc != nil {
+		return m.NewDeleteAutoScalePolicyParamsFunc(id)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewDeleteAutoScalePolicyParams(id)
+}
+
+// DeleteAutoScalePolicy records the call and calls DeleteAutoScalePolicyFunc, or DeleteAutoScalePolicyWithContextFunc if only that is set
+func (m *MockAutoScaleService) DeleteAutoScalePolicy(p *cloudstack.DeleteAutoScalePolicyParams) (r0 *cloudstack.DeleteAutoScalePolicyResponse, r1 error) {
+	m.record("DeleteAutoScalePolicy", p)
+	if m.DeleteAutoScalePolicyFunc != nil {
+		return m.DeleteAutoScalePolicyFunc(p)
+	}
+	if m.DeleteAutoScalePolicyWithContextFunc != nil {
+		return m.DeleteAutoScalePolicyWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteAutoScalePolicy")
+	return
+}
+
+// DeleteAutoScalePolicyWithContext records the call and calls DeleteAutoScalePolicyWithContextFunc, or DeleteAutoScalePolicyFunc if only that is set
+func (m *MockAutoScaleService) DeleteAutoScalePolicyWithContext(ctx context.Context, p *cloudstack.DeleteAutoScalePolicyParams) (r0 *cloudstack.DeleteAutoScalePolicyResponse, r1 error) {
+	m.record("DeleteAutoScalePolicyWithContext", ctx, p)
+	if m.DeleteAutoScalePolicyWithContextFunc != nil {
+		return m.DeleteAutoScalePolicyWithContextFunc(ctx, p)
+	}
+	if m.DeleteAutoScalePolicyFunc != nil {
+		return m.DeleteAutoScalePolicyFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteAutoScalePolicyWithContext")
+	return
+}
+
+// DeleteAutoScalePolicyAsync records the call and calls DeleteAutoScalePolicyAsyncFunc, or DeleteAutoScalePolicyAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) DeleteAutoScalePolicyAsync(p *cloudstack.DeleteAutoScalePolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteAutoScalePolicyAsync", p)
+	if m.DeleteAutoScalePolicyAsyncFunc != nil {
+		return m.DeleteAutoScalePolicyAsyncFunc(p)
+	}
+	if m.DeleteAutoScalePolicyAsyncWithContextFunc != nil {
+		return m.DeleteAutoScalePolicyAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteAutoScalePolicyAsync")
+	return
+}
+
+// DeleteAutoScalePolicyAsyncWithContext records the call and calls DeleteAutoScalePolicyAsyncWithContextFunc, or DeleteAutoScalePolicyAsyncFunc if only that is set
+func (m *MockAutoScaleService) DeleteAutoScalePolicyAsyncWithContext(ctx context.Context, p *cloudstack.DeleteAutoScalePolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteAutoScalePolicyAsyncWithContext", ctx, p)
+	if m.DeleteAutoScalePolicyAsyncWithContextFunc != nil {
+		return m.DeleteAutoScalePolicyAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteAutoScalePolicyAsyncFunc != nil {
+		return m.DeleteAutoScalePolicyAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteAutoScalePolicyAsyncWithContext")
+	return
+}
+
+// NewDeleteAutoScaleVmGroupParams records the call and calls NewDeleteAutoScaleVmGroupParamsFunc
+func (m *MockAutoScaleService) NewDeleteAutoScaleVmGroupParams(id string) (r0 *cloudstack.DeleteAutoScaleVmGroupParams) {
+	m.record("NewDeleteAutoScaleVmGroupParams", id)
+	if m.NewDeleteAutoScaleVmGroupParamsFunc != nil {
+		return m.NewDeleteAutoScaleVmGroupParamsFunc(id)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewDeleteAutoScaleVmGroupParams(id)
+}
+
+// DeleteAutoScaleVmGroup records the call and calls DeleteAutoScaleVmGroupFunc, or DeleteAutoScaleVmGroupWithContextFunc if only that is set
+func (m *MockAutoScaleService) DeleteAutoScaleVmGroup(p *cloudstack.DeleteAutoScaleVmGroupParams) (r0 *cloudstack.DeleteAutoScaleVmGroupResponse, r1 error) {
+	m.record("DeleteAutoScaleVmGroup", p)
+	if m.DeleteAutoScaleVmGroupFunc != nil {
+		return m.DeleteAutoScaleVmGroupFunc(p)
+	}
+	if m.DeleteAutoScaleVmGroupWithContextFunc != nil {
+		return m.DeleteAutoScaleVmGroupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteAutoScaleVmGroup")
+	return
+}
+
+// DeleteAutoScaleVmGroupWithContext records the call and calls DeleteAutoScaleVmGroupWithContextFunc, or DeleteAutoScaleVmGroupFunc if only that is set
+func (m *MockAutoScaleService) DeleteAutoScaleVmGroupWithContext(ctx context.Context, p *cloudstack.DeleteAutoScaleVmGroupParams) (r0 *cloudstack.DeleteAutoScaleVmGroupResponse, r1 error) {
+	m.record("DeleteAutoScaleVmGroupWithContext", ctx, p)
+	if m.DeleteAutoScaleVmGroupWithContextFunc != nil {
+		return m.DeleteAutoScaleVmGroupWithContextFunc(ctx, p)
+	}
+	if m.DeleteAutoScaleVmGroupFunc != nil {
+		return m.DeleteAutoScaleVmGroupFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteAutoScaleVmGroupWithContext")
+	return
+}
+
+// DeleteAutoScaleVmGroupAsync records the call and calls DeleteAutoScaleVmGroupAsyncFunc, or DeleteAutoScaleVmGroupAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) DeleteAutoScaleVmGroupAsync(p *cloudstack.DeleteAutoScaleVmGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteAutoScaleVmGroupAsync", p)
+	if m.DeleteAutoScaleVmGroupAsyncFunc != nil {
+		return m.DeleteAutoScaleVmGroupAsyncFunc(p)
+	}
+	if m.DeleteAutoScaleVmGroupAsyncWithContextFunc != nil {
+		return m.DeleteAutoScaleVmGroupAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteAutoScaleVmGroupAsync")
+	return
+}
+
+// DeleteAutoScaleVmGroupAsyncWithContext records the call and calls DeleteAutoScaleVmGroupAsyncWithContextFunc, or DeleteAutoScaleVmGroupAsyncFunc if only that is set
+func (m *MockAutoScaleService) DeleteAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *cloudstack.DeleteAutoScaleVmGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteAutoScaleVmGroupAsyncWithContext", ctx, p)
+	if m.DeleteAutoScaleVmGroupAsyncWithContextFunc != nil {
+		return m.DeleteAutoScaleVmGroupAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteAutoScaleVmGroupAsyncFunc != nil {
+		return m.DeleteAutoScaleVmGroupAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteAutoScaleVmGroupAsyncWithContext")
+	return
+}
+
+// NewDeleteAutoScaleVmProfileParams records the call and calls NewDeleteAutoScaleVmProfileParamsFunc
+func (m *MockAutoScaleService) NewDeleteAutoScaleVmProfileParams(id string) (r0 *cloudstack.DeleteAutoScaleVmProfileParams) {
+	m.record("NewDeleteAutoScaleVmProfileParams", id)
+	if m.NewDeleteAutoScaleVmProfileParamsFunc != nil {
+		return m.NewDeleteAutoScaleVmProfileParamsFunc(id)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewDeleteAutoScaleVmProfileParams(id)
+}
+
+// DeleteAutoScaleVmProfile records the call and calls DeleteAutoScaleVmProfileFunc, or DeleteAutoScaleVmProfileWithContextFunc if only that is set
+func (m *MockAutoScaleService) DeleteAutoScaleVmProfile(p *cloudstack.DeleteAutoScaleVmProfileParams) (r0 *cloudstack.DeleteAutoScaleVmProfileResponse, r1 error) {
+	m.record("DeleteAutoScaleVmProfile", p)
+	if m.DeleteAutoScaleVmProfileFunc != nil {
+		return m.DeleteAutoScaleVmProfileFunc(p)
+	}
+	if m.DeleteAutoScaleVmProfileWithContextFunc != nil {
+		return m.DeleteAutoScaleVmProfileWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteAutoScaleVmProfile")
+	return
+}
+
+// DeleteAutoScaleVmProfileWithContext records the call and calls DeleteAutoScaleVmProfileWithContextFunc, or DeleteAutoScaleVmProfileFunc if only that is set
+func (m *MockAutoScaleService) DeleteAutoScaleVmProfileWithContext(ctx context.Context, p *cloudstack.DeleteAutoScaleVmProfileParams) (r0 *cloudstack.DeleteAutoScaleVmProfileResponse, r1 error) {
+	m.record("DeleteAutoScaleVmProfileWithContext", ctx, p)
+	if m.DeleteAutoScaleVmProfileWithContextFunc != nil {
+		return m.DeleteAutoScaleVmProfileWithContextFunc(ctx, p)
+	}
+	if m.DeleteAutoScaleVmProfileFunc != nil {
+		return m.DeleteAutoScaleVmProfileFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteAutoScaleVmProfileWithContext")
+	return
+}
+
+// DeleteAutoScaleVmProfileAsync records the call and calls DeleteAutoScaleVmProfileAsyncFunc, or DeleteAutoScaleVmProfileAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) DeleteAutoScaleVmProfileAsync(p *cloudstack.DeleteAutoScaleVmProfileParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteAutoScaleVmProfileAsync", p)
+	if m.DeleteAutoScaleVmProfileAsyncFunc != nil {
+		return m.DeleteAutoScaleVmProfileAsyncFunc(p)
+	}
+	if m.DeleteAutoScaleVmProfileAsyncWithContextFunc != nil {
+		return m.DeleteAutoScaleVmProfileAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteAutoScaleVmProfileAsync")
+	return
+}
+
+// DeleteAutoScaleVmProfileAsyncWithContext records the call and calls DeleteAutoScaleVmProfileAsyncWithContextFunc, or DeleteAutoScaleVmProfileAsyncFunc if only that is set
+func (m *MockAutoScaleService) DeleteAutoScaleVmProfileAsyncWithContext(ctx context.Context, p *cloudstack.DeleteAutoScaleVmProfileParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteAutoScaleVmProfileAsyncWithContext", ctx, p)
+	if m.DeleteAutoScaleVmProfileAsyncWithContextFunc != nil {
+		return m.DeleteAutoScaleVmProfileAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteAutoScaleVmProfileAsyncFunc != nil {
+		return m.DeleteAutoScaleVmProfileAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteAutoScaleVmProfileAsyncWithContext")
+	return
+}
+
+// NewDeleteConditionParams records the call and calls NewDeleteConditionParamsFunc
+func (m *MockAutoScaleService) NewDeleteConditionParams(id string) (r0 *cloudstack.DeleteConditionParams) {
+	m.record("NewDeleteConditionParams", id)
+	if m.NewDeleteConditionParamsFunc != nil {
+		return m.NewDeleteConditionParamsFunc(id)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewDeleteConditionParams(id)
+}
+
+// DeleteCondition records the call and calls DeleteConditionFunc, or DeleteConditionWithContextFunc if only that is set
+func (m *MockAutoScaleService) DeleteCondition(p *cloudstack.DeleteConditionParams) (r0 *cloudstack.DeleteConditionResponse, r1 error) {
+	m.record("DeleteCondition", p)
+	if m.DeleteConditionFunc != nil {
+		return m.DeleteConditionFunc(p)
+	}
+	if m.DeleteConditionWithContextFunc != nil {
+		return m.DeleteConditionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteCondition")
+	return
+}
+
+// DeleteConditionWithContext records the call and calls DeleteConditionWithContextFunc, or DeleteConditionFunc if only that is set
+func (m *MockAutoScaleService) DeleteConditionWithContext(ctx context.Context, p *cloudstack.DeleteConditionParams) (r0 *cloudstack.DeleteConditionResponse, r1 error) {
+	m.record("DeleteConditionWithContext", ctx, p)
+	if m.DeleteConditionWithContextFunc != nil {
+		return m.DeleteConditionWithContextFunc(ctx, p)
+	}
+	if m.DeleteConditionFunc != nil {
+		return m.DeleteConditionFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteConditionWithContext")
+	return
+}
+
+// DeleteConditionAsync records the call and calls DeleteConditionAsyncFunc, or DeleteConditionAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) DeleteConditionAsync(p *cloudstack.DeleteConditionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteConditionAsync", p)
+	if m.DeleteConditionAsyncFunc != nil {
+		return m.DeleteConditionAsyncFunc(p)
+	}
+	if m.DeleteConditionAsyncWithContextFunc != nil {
+		return m.DeleteConditionAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteConditionAsync")
+	return
+}
+
+// DeleteConditionAsyncWithContext records the call and calls DeleteConditionAsyncWithContextFunc, or DeleteConditionAsyncFunc if only that is set
+func (m *MockAutoScaleService) DeleteConditionAsyncWithContext(ctx context.Context, p *cloudstack.DeleteConditionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteConditionAsyncWithContext", ctx, p)
+	if m.DeleteConditionAsyncWithContextFunc != nil {
+		return m.DeleteConditionAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteConditionAsyncFunc != nil {
+		return m.DeleteConditionAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteConditionAsyncWithContext")
+	return
+}
+
+// NewDeleteCounterParams records the call and calls NewDeleteCounterParamsFunc
+func (m *MockAutoScaleService) NewDeleteCounterParams(id string) (r0 *cloudstack.DeleteCounterParams) {
+	m.record("NewDeleteCounterParams", id)
+	if m.NewDeleteCounterParamsFunc != nil {
+		return m.NewDeleteCounterParamsFunc(id)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewDeleteCounterParams(id)
+}
+
+// DeleteCounter records the call and calls DeleteCounterFunc, or DeleteCounterWithContextFunc if only that is set
+func (m *MockAutoScaleService) DeleteCounter(p *cloudstack.DeleteCounterParams) (r0 *cloudstack.DeleteCounterResponse, r1 error) {
+	m.record("DeleteCounter", p)
+	if m.DeleteCounterFunc != nil {
+		return m.DeleteCounterFunc(p)
+	}
+	if m.DeleteCounterWithContextFunc != nil {
+		return m.DeleteCounterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteCounter")
+	return
+}
+
+// DeleteCounterWithContext records the call and calls DeleteCounterWithContextFunc, or DeleteCounterFunc if only that is set
+func (m *MockAutoScaleService) DeleteCounterWithContext(ctx context.Context, p *cloudstack.DeleteCounterParams) (r0 *cloudstack.DeleteCounterResponse, r1 error) {
+	m.record("DeleteCounterWithContext", ctx, p)
+	if m.DeleteCounterWithContextFunc != nil {
+		return m.DeleteCounterWithContextFunc(ctx, p)
+	}
+	if m.DeleteCounterFunc != nil {
+		return m.DeleteCounterFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteCounterWithContext")
+	return
+}
+
+// DeleteCounterAsync records the call and calls DeleteCounterAsyncFunc, or DeleteCounterAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) DeleteCounterAsync(p *cloudstack.DeleteCounterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteCounterAsync", p)
+	if m.DeleteCounterAsyncFunc != nil {
+		return m.DeleteCounterAsyncFunc(p)
+	}
+	if m.DeleteCounterAsyncWithContextFunc != nil {
+		return m.DeleteCounterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteCounterAsync")
+	return
+}
+
+// DeleteCounterAsyncWithContext records the call and calls DeleteCounterAsyncWithContextFunc, or DeleteCounterAsyncFunc if only that is set
+func (m *MockAutoScaleService) DeleteCounterAsyncWithContext(ctx context.Context, p *cloudstack.DeleteCounterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteCounterAsyncWithContext", ctx, p)
+	if m.DeleteCounterAsyncWithContextFunc != nil {
+		return m.DeleteCounterAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteCounterAsyncFunc != nil {
+		return m.DeleteCounterAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.DeleteCounterAsyncWithContext")
+	return
+}
+
+// NewDisableAutoScaleVmGroupParams records the call and calls NewDisableAutoScaleVmGroupParamsFunc
+func (m *MockAutoScaleService) NewDisableAutoScaleVmGroupParams(id string) (r0 *cloudstack.DisableAutoScaleVmGroupParams) {
+	m.record("NewDisableAutoScaleVmGroupParams", id)
+	if m.NewDisableAutoScaleVmGroupParamsFunc != nil {
+		return m.NewDisableAutoScaleVmGroupParamsFunc(id)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewDisableAutoScaleVmGroupParams(id)
+}
+
+// DisableAutoScaleVmGroup records the call and calls DisableAutoScaleVmGroupFunc, or DisableAutoScaleVmGroupWithContextFunc if only that is set
+func (m *MockAutoScaleService) DisableAutoScaleVmGroup(p *cloudstack.DisableAutoScaleVmGroupParams) (r0 *cloudstack.DisableAutoScaleVmGroupResponse, r1 error) {
+	m.record("DisableAutoScaleVmGroup", p)
+	if m.DisableAutoScaleVmGroupFunc != nil {
+		return m.DisableAutoScaleVmGroupFunc(p)
+	}
+	if m.DisableAutoScaleVmGroupWithContextFunc != nil {
+		return m.DisableAutoScaleVmGroupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.DisableAutoScaleVmGroup")
+	return
+}
+
+// DisableAutoScaleVmGroupWithContext records the call and calls DisableAutoScaleVmGroupWithContextFunc, or DisableAutoScaleVmGroupFunc if only that is set
+func (m *MockAutoScaleService) DisableAutoScaleVmGroupWithContext(ctx context.Context, p *cloudstack.DisableAutoScaleVmGroupParams) (r0 *cloudstack.DisableAutoScaleVmGroupResponse, r1 error) {
+	m.record("DisableAutoScaleVmGroupWithContext", ctx, p)
+	if m.DisableAutoScaleVmGroupWithContextFunc != nil {
+		return m.DisableAutoScaleVmGroupWithContextFunc(ctx, p)
+	}
+	if m.DisableAutoScaleVmGroupFunc != nil {
+		return m.DisableAutoScaleVmGroupFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.DisableAutoScaleVmGroupWithContext")
+	return
+}
+
+// DisableAutoScaleVmGroupAsync records the call and calls DisableAutoScaleVmGroupAsyncFunc, or DisableAutoScaleVmGroupAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) DisableAutoScaleVmGroupAsync(p *cloudstack.DisableAutoScaleVmGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableAutoScaleVmGroupAsync", p)
+	if m.DisableAutoScaleVmGroupAsyncFunc != nil {
+		return m.DisableAutoScaleVmGroupAsyncFunc(p)
+	}
+	if m.DisableAutoScaleVmGroupAsyncWithContextFunc != nil {
+		return m.DisableAutoScaleVmGroupAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.DisableAutoScaleVmGroupAsync")
+	return
+}
+
+// DisableAutoScaleVmGroupAsyncWithContext records the call and calls DisableAutoScaleVmGroupAsyncWithContextFunc, or DisableAutoScaleVmGroupAsyncFunc if only that is set
+func (m *MockAutoScaleService) DisableAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *cloudstack.DisableAutoScaleVmGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableAutoScaleVmGroupAsyncWithContext", ctx, p)
+	if m.DisableAutoScaleVmGroupAsyncWithContextFunc != nil {
+		return m.DisableAutoScaleVmGroupAsyncWithContextFunc(ctx, p)
+	}
+	if m.DisableAutoScaleVmGroupAsyncFunc != nil {
+		return m.DisableAutoScaleVmGroupAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.DisableAutoScaleVmGroupAsyncWithContext")
+	return
+}
+
+// NewEnableAutoScaleVmGroupParams records the call and calls NewEnableAutoScaleVmGroupParamsFunc
+func (m *MockAutoScaleService) NewEnableAutoScaleVmGroupParams(id string) (r0 *cloudstack.EnableAutoScaleVmGroupParams) {
+	m.record("NewEnableAutoScaleVmGroupParams", id)
+	if m.NewEnableAutoScaleVmGroupParamsFunc != nil {
+		return m.NewEnableAutoScaleVmGroupParamsFunc(id)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewEnableAutoScaleVmGroupParams(id)
+}
+
+// EnableAutoScaleVmGroup records the call and calls EnableAutoScaleVmGroupFunc, or EnableAutoScaleVmGroupWithContextFunc if only that is set
+func (m *MockAutoScaleService) EnableAutoScaleVmGroup(p *cloudstack.EnableAutoScaleVmGroupParams) (r0 *cloudstack.EnableAutoScaleVmGroupResponse, r1 error) {
+	m.record("EnableAutoScaleVmGroup", p)
+	if m.EnableAutoScaleVmGroupFunc != nil {
+		return m.EnableAutoScaleVmGroupFunc(p)
+	}
+	if m.EnableAutoScaleVmGroupWithContextFunc != nil {
+		return m.EnableAutoScaleVmGroupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.EnableAutoScaleVmGroup")
+	return
+}
+
+// EnableAutoScaleVmGroupWithContext records the call and calls EnableAutoScaleVmGroupWithContextFunc, or EnableAutoScaleVmGroupFunc if only that is set
+func (m *MockAutoScaleService) EnableAutoScaleVmGroupWithContext(ctx context.Context, p *cloudstack.EnableAutoScaleVmGroupParams) (r0 *cloudstack.EnableAutoScaleVmGroupResponse, r1 error) {
+	m.record("EnableAutoScaleVmGroupWithContext", ctx, p)
+	if m.EnableAutoScaleVmGroupWithContextFunc != nil {
+		return m.EnableAutoScaleVmGroupWithContextFunc(ctx, p)
+	}
+	if m.EnableAutoScaleVmGroupFunc != nil {
+		return m.EnableAutoScaleVmGroupFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.EnableAutoScaleVmGroupWithContext")
+	return
+}
+
+// EnableAutoScaleVmGroupAsync records the call and calls EnableAutoScaleVmGroupAsyncFunc, or EnableAutoScaleVmGroupAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) EnableAutoScaleVmGroupAsync(p *cloudstack.EnableAutoScaleVmGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableAutoScaleVmGroupAsync", p)
+	if m.EnableAutoScaleVmGroupAsyncFunc != nil {
+		return m.EnableAutoScaleVmGroupAsyncFunc(p)
+	}
+	if m.EnableAutoScaleVmGroupAsyncWithContextFunc != nil {
+		return m.EnableAutoScaleVmGroupAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.EnableAutoScaleVmGroupAsync")
+	return
+}
+
+// EnableAutoScaleVmGroupAsyncWithContext records the call and calls EnableAutoScaleVmGroupAsyncWithContextFunc, or EnableAutoScaleVmGroupAsyncFunc if only that is set
+func (m *MockAutoScaleService) EnableAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *cloudstack.EnableAutoScaleVmGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableAutoScaleVmGroupAsyncWithContext", ctx, p)
+	if m.EnableAutoScaleVmGroupAsyncWithContextFunc != nil {
+		return m.EnableAutoScaleVmGroupAsyncWithContextFunc(ctx, p)
+	}
+	if m.EnableAutoScaleVmGroupAsyncFunc != nil {
+		return m.EnableAutoScaleVmGroupAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.EnableAutoScaleVmGroupAsyncWithContext")
+	return
+}
+
+// NewListAutoScalePoliciesParams records the call and calls NewListAutoScalePoliciesParamsFunc
+func (m *MockAutoScaleService) NewListAutoScalePoliciesParams() (r0 *cloudstack.ListAutoScalePoliciesParams) {
+	m.record("NewListAutoScalePoliciesParams")
+	if m.NewListAutoScalePoliciesParamsFunc != nil {
+		return m.NewListAutoScalePoliciesParamsFunc()
+	}
+	return cloudstack.NewAutoScaleService(nil).NewListAutoScalePoliciesParams()
+}
+
+// GetAutoScalePolicyByID records the call and calls GetAutoScalePolicyByIDFunc, or GetAutoScalePolicyByIDWithContextFunc if only that is set
+func (m *MockAutoScaleService) GetAutoScalePolicyByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.AutoScalePolicy, r1 int, r2 error) {
+	m.record("GetAutoScalePolicyByID", id, opts)
+	if m.GetAutoScalePolicyByIDFunc != nil {
+		return m.GetAutoScalePolicyByIDFunc(id, opts...)
+	}
+	if m.GetAutoScalePolicyByIDWithContextFunc != nil {
+		return m.GetAutoScalePolicyByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("AutoScaleService.GetAutoScalePolicyByID")
+	return
+}
+
+// GetAutoScalePolicyByIDWithContext records the call and calls GetAutoScalePolicyByIDWithContextFunc, or GetAutoScalePolicyByIDFunc if only that is set
+func (m *MockAutoScaleService) GetAutoScalePolicyByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.AutoScalePolicy, r1 int, r2 error) {
+	m.record("GetAutoScalePolicyByIDWithContext", ctx, id, opts)
+	if m.GetAutoScalePolicyByIDWithContextFunc != nil {
+		return m.GetAutoScalePolicyByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetAutoScalePolicyByIDFunc != nil {
+		return m.GetAutoScalePolicyByIDFunc(id, opts...)
+	}
+	r2 = notMocked("AutoScaleService.GetAutoScalePolicyByIDWithContext")
+	return
+}
+
+// ListAutoScalePolicies records the call and calls ListAutoScalePoliciesFunc, or ListAutoScalePoliciesWithContextFunc if only that is set
+func (m *MockAutoScaleService) ListAutoScalePolicies(p *cloudstack.ListAutoScalePoliciesParams) (r0 *cloudstack.ListAutoScalePoliciesResponse, r1 error) {
+	m.record("ListAutoScalePolicies", p)
+	if m.ListAutoScalePoliciesFunc != nil {
+		return m.ListAutoScalePoliciesFunc(p)
+	}
+	if m.ListAutoScalePoliciesWithContextFunc != nil {
+		return m.ListAutoScalePoliciesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.ListAutoScalePolicies")
+	return
+}
+
+// ListAutoScalePoliciesWithContext records the call and calls ListAutoScalePoliciesWithContextFunc, or ListAutoScalePoliciesFunc if only that is set
+func (m *MockAutoScaleService) ListAutoScalePoliciesWithContext(ctx context.Context, p *cloudstack.ListAutoScalePoliciesParams) (r0 *cloudstack.ListAutoScalePoliciesResponse, r1 error) {
+	m.record("ListAutoScalePoliciesWithContext", ctx, p)
+	if m.ListAutoScalePoliciesWithContextFunc != nil {
+		return m.ListAutoScalePoliciesWithContextFunc(ctx, p)
+	}
+	if m.ListAutoScalePoliciesFunc != nil {
+		return m.ListAutoScalePoliciesFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.ListAutoScalePoliciesWithContext")
+	return
+}
+
+// NewListAutoScalePoliciesPager records the call and calls NewListAutoScalePoliciesPagerFunc
+func (m *MockAutoScaleService) NewListAutoScalePoliciesPager(p *cloudstack.ListAutoScalePoliciesParams) (r0 *cloudstack.ListAutoScalePoliciesPager) {
+	m.record("NewListAutoScalePoliciesPager", p)
+	if m.NewListAutoScalePoliciesPagerFunc != nil {
+		return m.NewListAutoScalePoliciesPagerFunc(p)
+	}
+	return
+}
+
+// ListAutoScalePoliciesAll records the call and calls ListAutoScalePoliciesAllFunc, or ListAutoScalePoliciesAllWithContextFunc if only that is set
+func (m *MockAutoScaleService) ListAutoScalePoliciesAll(p *cloudstack.ListAutoScalePoliciesParams) (r0 *cloudstack.ListAutoScalePoliciesResponse, r1 error) {
+	m.record("ListAutoScalePoliciesAll", p)
+	if m.ListAutoScalePoliciesAllFunc != nil {
+		return m.ListAutoScalePoliciesAllFunc(p)
+	}
+	if m.ListAutoScalePoliciesAllWithContextFunc != nil {
+		return m.ListAutoScalePoliciesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.ListAutoScalePoliciesAll")
+	return
+}
+
+// ListAutoScalePoliciesAllWithContext records the call and calls ListAutoScalePoliciesAllWithContextFunc, or ListAutoScalePoliciesAllFunc if only that is set
+func (m *MockAutoScaleService) ListAutoScalePoliciesAllWithContext(ctx context.Context, p *cloudstack.ListAutoScalePoliciesParams) (r0 *cloudstack.ListAutoScalePoliciesResponse, r1 error) {
+	m.record("ListAutoScalePoliciesAllWithContext", ctx, p)
+	if m.ListAutoScalePoliciesAllWithContextFunc != nil {
+		return m.ListAutoScalePoliciesAllWithContextFunc(ctx, p)
+	}
+	if m.ListAutoScalePoliciesAllFunc != nil {
+		return m.ListAutoScalePoliciesAllFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.ListAutoScalePoliciesAllWithContext")
+	return
+}
+
+// NewListAutoScaleVmGroupsParams records the call and calls NewListAutoScaleVmGroupsParamsFunc
+func (m *MockAutoScaleService) NewListAutoScaleVmGroupsParams() (r0 *cloudstack.ListAutoScaleVmGroupsParams) {
+	m.record("NewListAutoScaleVmGroupsParams")
+	if m.NewListAutoScaleVmGroupsParamsFunc != nil {
+		return m.NewListAutoScaleVmGroupsParamsFunc()
+	}
+	return cloudstack.NewAutoScaleService(nil).NewListAutoScaleVmGroupsParams()
+}
+
+// GetAutoScaleVmGroupByID records the call and calls GetAutoScaleVmGroupByIDFunc, or GetAutoScaleVmGroupByIDWithContextFunc if only that is set
+func (m *MockAutoScaleService) GetAutoScaleVmGroupByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.AutoScaleVmGroup, r1 int, r2 error) {
+	m.record("GetAutoScaleVmGroupByID", id, opts)
+	if m.GetAutoScaleVmGroupByIDFunc != nil {
+		return m.GetAutoScaleVmGroupByIDFunc(id, opts...)
+	}
+	if m.GetAutoScaleVmGroupByIDWithContextFunc != nil {
+		return m.GetAutoScaleVmGroupByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("AutoScaleService.GetAutoScaleVmGroupByID")
+	return
+}
+
+// GetAutoScaleVmGroupByIDWithContext records the call and calls GetAutoScaleVmGroupByIDWithContextFunc, or GetAutoScaleVmGroupByIDFunc if only that is set
+func (m *MockAutoScaleService) GetAutoScaleVmGroupByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.AutoScaleVmGroup, r1 int, r2 error) {
+	m.record("GetAutoScaleVmGroupByIDWithContext", ctx, id, opts)
+	if m.GetAutoScaleVmGroupByIDWithContextFunc != nil {
+		return m.GetAutoScaleVmGroupByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetAutoScaleVmGroupByIDFunc != nil {
+		return m.GetAutoScaleVmGroupByIDFunc(id, opts...)
+	}
+	r2 = notMocked("AutoScaleService.GetAutoScaleVmGroupByIDWithContext")
+	return
+}
+
+// ListAutoScaleVmGroups records the call and calls ListAutoScaleVmGroupsFunc, or ListAutoScaleVmGroupsWithContextFunc if only that is set
+func (m *MockAutoScaleService) ListAutoScaleVmGroups(p *cloudstack.ListAutoScaleVmGroupsParams) (r0 *cloudstack.ListAutoScaleVmGroupsResponse, r1 error) {
+	m.record("ListAutoScaleVmGroups", p)
+	if m.ListAutoScaleVmGroupsFunc != nil {
+		return m.ListAutoScaleVmGroupsFunc(p)
+	}
+	if m.ListAutoScaleVmGroupsWithContextFunc != nil {
+		return m.ListAutoScaleVmGroupsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.ListAutoScaleVmGroups")
+	return
+}
+
+// ListAutoScaleVmGroupsWithContext records the call and calls ListAutoScaleVmGroupsWithContextFunc, or ListAutoScaleVmGroupsFunc if only that is set
+func (m *MockAutoScaleService) ListAutoScaleVmGroupsWithContext(ctx context.Context, p *cloudstack.ListAutoScaleVmGroupsParams) (r0 *cloudstack.ListAutoScaleVmGroupsResponse, r1 error) {
+	m.record("ListAutoScaleVmGroupsWithContext", ctx, p)
+	if m.ListAutoScaleVmGroupsWithContextFunc != nil {
+		return m.ListAutoScaleVmGroupsWithContextFunc(ctx, p)
+	}
+	if m.ListAutoScaleVmGroupsFunc != nil {
+		return m.ListAutoScaleVmGroupsFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.ListAutoScaleVmGroupsWithContext")
+	return
+}
+
+// NewListAutoScaleVmGroupsPager records the call and calls NewListAutoScaleVmGroupsPagerFunc
+func (m *MockAutoScaleService) NewListAutoScaleVmGroupsPager(p *cloudstack.ListAutoScaleVmGroupsParams) (r0 *cloudstack.ListAutoScaleVmGroupsPager) {
+	m.record("NewListAutoScaleVmGroupsPager", p)
+	if m.NewListAutoScaleVmGroupsPagerFunc != nil {
+		return m.NewListAutoScaleVmGroupsPagerFunc(p)
+	}
+	return
+}
+
+// ListAutoScaleVmGroupsAll records the call and calls ListAutoScaleVmGroupsAllFunc, or ListAutoScaleVmGroupsAllWithContextFunc if only that is set
+func (m *MockAutoScaleService) ListAutoScaleVmGroupsAll(p *cloudstack.ListAutoScaleVmGroupsParams) (r0 *cloudstack.ListAutoScaleVmGroupsResponse, r1 error) {
+	m.record("ListAutoScaleVmGroupsAll", p)
+	if m.ListAutoScaleVmGroupsAllFunc != nil {
+		return m.ListAutoScaleVmGroupsAllFunc(p)
+	}
+	if m.ListAutoScaleVmGroupsAllWithContextFunc != nil {
+		return m.ListAutoScaleVmGroupsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.ListAutoScaleVmGroupsAll")
+	return
+}
+
+// ListAutoScaleVmGroupsAllWithContext records the call and calls ListAutoScaleVmGroupsAllWithContextFunc, or ListAutoScaleVmGroupsAllFunc if only that is set
+func (m *MockAutoScaleService) ListAutoScaleVmGroupsAllWithContext(ctx context.Context, p *cloudstack.ListAutoScaleVmGroupsParams) (r0 *cloudstack.ListAutoScaleVmGroupsResponse, r1 error) {
+	m.record("ListAutoScaleVmGroupsAllWithContext", ctx, p)
+	if m.ListAutoScaleVmGroupsAllWithContextFunc != nil {
+		return m.ListAutoScaleVmGroupsAllWithContextFunc(ctx, p)
+	}
+	if m.ListAutoScaleVmGroupsAllFunc != nil {
+		return m.ListAutoScaleVmGroupsAllFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.ListAutoScaleVmGroupsAllWithContext")
+	return
+}
+
+// NewListAutoScaleVmProfilesParams records the call and calls NewListAutoScaleVmProfilesParamsFunc
+func (m *MockAutoScaleService) NewListAutoScaleVmProfilesParams() (r0 *cloudstack.ListAutoScaleVmProfilesParams) {
+	m.record("NewListAutoScaleVmProfilesParams")
+	if m.NewListAutoScaleVmProfilesParamsFunc != nil {
+		return m.NewListAutoScaleVmProfilesParamsFunc()
+	}
+	return cloudstack.NewAutoScaleService(nil).NewListAutoScaleVmProfilesParams()
+}
+
+// GetAutoScaleVmProfileByID records the call and calls GetAutoScaleVmProfileByIDFunc, or GetAutoScaleVmProfileByIDWithContextFunc if only that is set
+func (m *MockAutoScaleService) GetAutoScaleVmProfileByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.AutoScaleVmProfile, r1 int, r2 error) {
+	m.record("GetAutoScaleVmProfileByID", id, opts)
+	if m.GetAutoScaleVmProfileByIDFunc != nil {
+		return m.GetAutoScaleVmProfileByIDFunc(id, opts...)
+	}
+	if m.GetAutoScaleVmProfileByIDWithContextFunc != nil {
+		return m.GetAutoScaleVmProfileByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("AutoScaleService.GetAutoScaleVmProfileByID")
+	return
+}
+
+// GetAutoScaleVmProfileByIDWithContext records the call and calls GetAutoScaleVmProfileByIDWithContextFunc, or GetAutoScaleVmProfileByIDFunc if only that is set
+func (m *MockAutoScaleService) GetAutoScaleVmProfileByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.AutoScaleVmProfile, r1 int, r2 error) {
+	m.record("GetAutoScaleVmProfileByIDWithContext", ctx, id, opts)
+	if m.GetAutoScaleVmProfileByIDWithContextFunc != nil {
+		return m.GetAutoScaleVmProfileByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetAutoScaleVmProfileByIDFunc != nil {
+		return m.GetAutoScaleVmProfileByIDFunc(id, opts...)
+	}
+	r2 = notMocked("AutoScaleService.GetAutoScaleVmProfileByIDWithContext")
+	return
+}
+
+// ListAutoScaleVmProfiles records the call and calls ListAutoScaleVmProfilesFunc, or ListAutoScaleVmProfilesWithContextFunc if only that is set
+func (m *MockAutoScaleService) ListAutoScaleVmProfiles(p *cloudstack.ListAutoScaleVmProfilesParams) (r0 *cloudstack.ListAutoScaleVmProfilesResponse, r1 error) {
+	m.record("ListAutoScaleVmProfiles", p)
+	if m.ListAutoScaleVmProfilesFunc != nil {
+		return m.ListAutoScaleVmProfilesFunc(p)
+	}
+	if m.ListAutoScaleVmProfilesWithContextFunc != nil {
+		return m.ListAutoScaleVmProfilesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.ListAutoScaleVmProfiles")
+	return
+}
+
+// ListAutoScaleVmProfilesWithContext records the call and calls ListAutoScaleVmProfilesWithContextFunc, or ListAutoScaleVmProfilesFunc if only that is set
+func (m *MockAutoScaleService) ListAutoScaleVmProfilesWithContext(ctx context.Context, p *cloudstack.ListAutoScaleVmProfilesParams) (r0 *cloudstack.ListAutoScaleVmProfilesResponse, r1 error) {
+	m.record("ListAutoScaleVmProfilesWithContext", ctx, p)
+	if m.ListAutoScaleVmProfilesWithContextFunc != nil {
+		return m.ListAutoScaleVmProfilesWithContextFunc(ctx, p)
+	}
+	if m.ListAutoScaleVmProfilesFunc != nil {
+		return m.ListAutoScaleVmProfilesFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.ListAutoScaleVmProfilesWithContext")
+	return
+}
+
+// NewListAutoScaleVmProfilesPager records the call and calls NewListAutoScaleVmProfilesPagerFunc
+func (m *MockAutoScaleService) NewListAutoScaleVmProfilesPager(p *cloudstack.ListAutoScaleVmProfilesParams) (r0 *cloudstack.ListAutoScaleVmProfilesPager) {
+	m.record("NewListAutoScaleVmProfilesPager", p)
+	if m.NewListAutoScaleVmProfilesPagerFunc != nil {
+		return m.NewListAutoScaleVmProfilesPagerFunc(p)
+	}
+	return
+}
+
+// ListAutoScaleVmProfilesAll records the call and calls ListAutoScaleVmProfilesAllFunc, or ListAutoScaleVmProfilesAllWithContextFunc if only that is set
+func (m *MockAutoScaleService) ListAutoScaleVmProfilesAll(p *cloudstack.ListAutoScaleVmProfilesParams) (r0 *cloudstack.ListAutoScaleVmProfilesResponse, r1 error) {
+	m.record("ListAutoScaleVmProfilesAll", p)
+	if m.ListAutoScaleVmProfilesAllFunc != nil {
+		return m.ListAutoScaleVmProfilesAllFunc(p)
+	}
+	if m.ListAutoScaleVmProfilesAllWithContextFunc != nil {
+		return m.ListAutoScaleVmProfilesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.ListAutoScaleVmProfilesAll")
+	return
+}
+
+// ListAutoScaleVmProfilesAllWithContext records the call and calls ListAutoScaleVmProfilesAllWithContextFunc, or ListAutoScaleVmProfilesAllFunc if only that is set
+func (m *MockAutoScaleService) ListAutoScaleVmProfilesAllWithContext(ctx context.Context, p *cloudstack.ListAutoScaleVmProfilesParams) (r0 *cloudstack.ListAutoScaleVmProfilesResponse, r1 error) {
+	m.record("ListAutoScaleVmProfilesAllWithContext", ctx, p)
+	if m.ListAutoScaleVmProfilesAllWithContextFunc != nil {
+		return m.ListAutoScaleVmProfilesAllWithContextFunc(ctx, p)
+	}
+	if m.ListAutoScaleVmProfilesAllFunc != nil {
+		return m.ListAutoScaleVmProfilesAllFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.ListAutoScaleVmProfilesAllWithContext")
+	return
+}
+
+// NewListConditionsParams records the call and calls NewListConditionsParamsFunc
+func (m *MockAutoScaleService) NewListConditionsParams() (r0 *cloudstack.ListConditionsParams) {
+	m.record("NewListConditionsParams")
+	if m.NewListConditionsParamsFunc != nil {
+		return m.NewListConditionsParamsFunc()
+	}
+	return cloudstack.NewAutoScaleService(nil).NewListConditionsParams()
+}
+
+// GetConditionByID records the call and calls GetConditionByIDFunc, or GetConditionByIDWithContextFunc if only that is set
+func (m *MockAutoScaleService) GetConditionByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Condition, r1 int, r2 error) {
+	m.record("GetConditionByID", id, opts)
+	if m.GetConditionByIDFunc != nil {
+		return m.GetConditionByIDFunc(id, opts...)
+	}
+	if m.GetConditionByIDWithContextFunc != nil {
+		return m.GetConditionByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("AutoScaleService.GetConditionByID")
+	return
+}
+
+// GetConditionByIDWithContext records the call and calls GetConditionByIDWithContextFunc, or GetConditionByIDFunc if only that is set
+func (m *MockAutoScaleService) GetConditionByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Condition, r1 int, r2 error) {
+	m.record("GetConditionByIDWithContext", ctx, id, opts)
+	if m.GetConditionByIDWithContextFunc != nil {
+		return m.GetConditionByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetConditionByIDFunc != nil {
+		return m.GetConditionByIDFunc(id, opts...)
+	}
+	r2 = notMocked("AutoScaleService.GetConditionByIDWithContext")
+	return
+}
+
+// ListConditions records the call and calls ListConditionsFunc, or ListConditionsWithContextFunc if only that is set
+func (m *MockAutoScaleService) ListConditions(p *cloudstack.ListConditionsParams) (r0 *cloudstack.ListConditionsResponse, r1 error) {
+	m.record("ListConditions", p)
+	if m.ListConditionsFunc != nil {
+		return m.ListConditionsFunc(p)
+	}
+	if m.ListConditionsWithContextFunc != nil {
+		return m.ListConditionsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.ListConditions")
+	return
+}
+
+// ListConditionsWithContext records the call and calls ListConditionsWithContextFunc, or ListConditionsFunc if only that is set
+func (m *MockAutoScaleService) ListConditionsWithContext(ctx context.Context, p *cloudstack.ListConditionsParams) (r0 *cloudstack.ListConditionsResponse, r1 error) {
+	m.record("ListConditionsWithContext", ctx, p)
+	if m.ListConditionsWithContextFunc != nil {
+		return m.ListConditionsWithContextFunc(ctx, p)
+	}
+	if m.ListConditionsFunc != nil {
+		return m.ListConditionsFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.ListConditionsWithContext")
+	return
+}
+
+// NewListConditionsPager records the call and calls NewListConditionsPagerFunc
+func (m *MockAutoScaleService) NewListConditionsPager(p *cloudstack.ListConditionsParams) (r0 *cloudstack.ListConditionsPager) {
+	m.record("NewListConditionsPager", p)
+	if m.NewListConditionsPagerFunc != nil {
+		return m.NewListConditionsPagerFunc(p)
+	}
+	return
+}
+
+// ListConditionsAll records the call and calls ListConditionsAllFunc, or ListConditionsAllWithContextFunc if only that is set
+func (m *MockAutoScaleService) ListConditionsAll(p *cloudstack.ListConditionsParams) (r0 *cloudstack.ListConditionsResponse, r1 error) {
+	m.record("ListConditionsAll", p)
+	if m.ListConditionsAllFunc != nil {
+		return m.ListConditionsAllFunc(p)
+	}
+	if m.ListConditionsAllWithContextFunc != nil {
+		return m.ListConditionsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.ListConditionsAll")
+	return
+}
+
+// ListConditionsAllWithContext records the call and calls ListConditionsAllWithContextFunc, or ListConditionsAllFunc if only that is set
+func (m *MockAutoScaleService) ListConditionsAllWithContext(ctx context.Context, p *cloudstack.ListConditionsParams) (r0 *cloudstack.ListConditionsResponse, r1 error) {
+	m.record("ListConditionsAllWithContext", ctx, p)
+	if m.ListConditionsAllWithContextFunc != nil {
+		return m.ListConditionsAllWithContextFunc(ctx, p)
+	}
+	if m.ListConditionsAllFunc != nil {
+		return m.ListConditionsAllFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.ListConditionsAllWithContext")
+	return
+}
+
+// NewListCountersParams records the call and calls NewListCountersParamsFunc
+func (m *MockAutoScaleService) NewListCountersParams() (r0 *cloudstack.ListCountersParams) {
+	m.record("NewListCountersParams")
+	if m.NewListCountersParamsFunc != nil {
+		return m.NewListCountersParamsFunc()
+	}
+	return cloudstack.NewAutoScaleService(nil).NewListCountersParams()
+}
+
+// GetCounterID records the call and calls GetCounterIDFunc, or GetCounterIDWithContextFunc if only that is set
+func (m *MockAutoScaleService) GetCounterID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetCounterID", name, opts)
+	if m.GetCounterIDFunc != nil {
+		return m.GetCounterIDFunc(name, opts...)
+	}
+	if m.GetCounterIDWithContextFunc != nil {
+		return m.GetCounterIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("AutoScaleService.GetCounterID")
+	return
+}
+
+// GetCounterIDWithContext records the call and calls GetCounterIDWithContextFunc, or GetCounterIDFunc if only that is set
+func (m *MockAutoScaleService) GetCounterIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetCounterIDWithContext", ctx, name, opts)
+	if m.GetCounterIDWithContextFunc != nil {
+		return m.GetCounterIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetCounterIDFunc != nil {
+		return m.GetCounterIDFunc(name, opts...)
+	}
+	r2 = notMocked("AutoScaleService.GetCounterIDWithContext")
+	return
+}
+
+// GetCounterByName records the call and calls GetCounterByNameFunc, or GetCounterByNameWithContextFunc if only that is set
+func (m *MockAutoScaleService) GetCounterByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Counter, r1 int, r2 error) {
+	m.record("GetCounterByName", name, opts)
+	if m.GetCounterByNameFunc != nil {
+		return m.GetCounterByNameFunc(name, opts...)
+	}
+	if m.GetCounterByNameWithContextFunc != nil {
+		return m.GetCounterByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("AutoScaleService.GetCounterByName")
+	return
+}
+
+// GetCounterByNameWithContext records the call and calls GetCounterByNameWithContextFunc, or GetCounterByNameFunc if only that is set
+func (m *MockAutoScaleService) GetCounterByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Counter, r1 int, r2 error) {
+	m.record("GetCounterByNameWithContext", ctx, name, opts)
+	if m.GetCounterByNameWithContextFunc != nil {
+		return m.GetCounterByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetCounterByNameFunc != nil {
+		return m.GetCounterByNameFunc(name, opts...)
+	}
+	r2 = notMocked("AutoScaleService.GetCounterByNameWithContext")
+	return
+}
+
+// GetCounterByID records the call and calls GetCounterByIDFunc, or GetCounterByIDWithContextFunc if only that is set
+func (m *MockAutoScaleService) GetCounterByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Counter, r1 int, r2 error) {
+	m.record("GetCounterByID", id, opts)
+	if m.GetCounterByIDFunc != nil {
+		return m.GetCounterByIDFunc(id, opts...)
+	}
+	if m.GetCounterByIDWithContextFunc != nil {
+		return m.GetCounterByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("AutoScaleService.GetCounterByID")
+	return
+}
+
+// GetCounterByIDWithContext records the call and calls GetCounterByIDWithContextFunc, or GetCounterByIDFunc if only that is set
+func (m *MockAutoScaleService) GetCounterByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Counter, r1 int, r2 error) {
+	m.record("GetCounterByIDWithContext", ctx, id, opts)
+	if m.GetCounterByIDWithContextFunc != nil {
+		return m.GetCounterByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetCounterByIDFunc != nil {
+		return m.GetCounterByIDFunc(id, opts...)
+	}
+	r2 = notMocked("AutoScaleService.GetCounterByIDWithContext")
+	return
+}
+
+// ListCounters records the call and calls ListCountersFunc, or ListCountersWithContextFunc if only that is set
+func (m *MockAutoScaleService) ListCounters(p *cloudstack.ListCountersParams) (r0 *cloudstack.ListCountersResponse, r1 error) {
+	m.record("ListCounters", p)
+	if m.ListCountersFunc != nil {
+		return m.ListCountersFunc(p)
+	}
+	if m.ListCountersWithContextFunc != nil {
+		return m.ListCountersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.ListCounters")
+	return
+}
+
+// ListCountersWithContext records the call and calls ListCountersWithContextFunc, or ListCountersFunc if only that is set
+func (m *MockAutoScaleService) ListCountersWithContext(ctx context.Context, p *cloudstack.ListCountersParams) (r0 *cloudstack.ListCountersResponse, r1 error) {
+	m.record("ListCountersWithContext", ctx, p)
+	if m.ListCountersWithContextFunc != nil {
+		return m.ListCountersWithContextFunc(ctx, p)
+	}
+	if m.ListCountersFunc != nil {
+		return m.ListCountersFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.ListCountersWithContext")
+	return
+}
+
+// NewListCountersPager records the call and calls NewListCountersPagerFunc
+func (m *MockAutoScaleService) NewListCountersPager(p *cloudstack.ListCountersParams) (r0 *cloudstack.ListCountersPager) {
+	m.record("NewListCountersPager", p)
+	if m.NewListCountersPagerFunc != nil {
+		return m.NewListCountersPagerFunc(p)
+	}
+	return
+}
+
+// ListCountersAll records the call and calls ListCountersAllFunc, or ListCountersAllWithContextFunc if only that is set
+func (m *MockAutoScaleService) ListCountersAll(p *cloudstack.ListCountersParams) (r0 *cloudstack.ListCountersResponse, r1 error) {
+	m.record("ListCountersAll", p)
+	if m.ListCountersAllFunc != nil {
+		return m.ListCountersAllFunc(p)
+	}
+	if m.ListCountersAllWithContextFunc != nil {
+		return m.ListCountersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.ListCountersAll")
+	return
+}
+
+// ListCountersAllWithContext records the call and calls ListCountersAllWithContextFunc, or ListCountersAllFunc if only that is set
+func (m *MockAutoScaleService) ListCountersAllWithContext(ctx context.Context, p *cloudstack.ListCountersParams) (r0 *cloudstack.ListCountersResponse, r1 error) {
+	m.record("ListCountersAllWithContext", ctx, p)
+	if m.ListCountersAllWithContextFunc != nil {
+		return m.ListCountersAllWithContextFunc(ctx, p)
+	}
+	if m.ListCountersAllFunc != nil {
+		return m.ListCountersAllFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.ListCountersAllWithContext")
+	return
+}
+
+// NewUpdateAutoScalePolicyParams records the call and calls NewUpdateAutoScalePolicyParamsFunc
+func (m *MockAutoScaleService) NewUpdateAutoScalePolicyParams(id string) (r0 *cloudstack.UpdateAutoScalePolicyParams) {
+	m.record("NewUpdateAutoScalePolicyParams", id)
+	if m.NewUpdateAutoScalePolicyParamsFunc != nil {
+		return m.NewUpdateAutoScalePolicyParamsFunc(id)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewUpdateAutoScalePolicyParams(id)
+}
+
+// UpdateAutoScalePolicy records the call and calls UpdateAutoScalePolicyFunc, or UpdateAutoScalePolicyWithContextFunc if only that is set
+func (m *MockAutoScaleService) UpdateAutoScalePolicy(p *cloudstack.UpdateAutoScalePolicyParams) (r0 *cloudstack.UpdateAutoScalePolicyResponse, r1 error) {
+	m.record("UpdateAutoScalePolicy", p)
+	if m.UpdateAutoScalePolicyFunc != nil {
+		return m.UpdateAutoScalePolicyFunc(p)
+	}
+	if m.UpdateAutoScalePolicyWithContextFunc != nil {
+		return m.UpdateAutoScalePolicyWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.UpdateAutoScalePolicy")
+	return
+}
+
+// UpdateAutoScalePolicyWithContext records the call and calls UpdateAutoScalePolicyWithContextFunc, or UpdateAutoScalePolicyFunc if only that is set
+func (m *MockAutoScaleService) UpdateAutoScalePolicyWithContext(ctx context.Context, p *cloudstack.UpdateAutoScalePolicyParams) (r0 *cloudstack.UpdateAutoScalePolicyResponse, r1 error) {
+	m.record("UpdateAutoScalePolicyWithContext", ctx, p)
+	if m.UpdateAutoScalePolicyWithContextFunc != nil {
+		return m.UpdateAutoScalePolicyWithContextFunc(ctx, p)
+	}
+	if m.UpdateAutoScalePolicyFunc != nil {
+		return m.UpdateAutoScalePolicyFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.UpdateAutoScalePolicyWithContext")
+	return
+}
+
+// UpdateAutoScalePolicyAsync records the call and calls UpdateAutoScalePolicyAsyncFunc, or UpdateAutoScalePolicyAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) UpdateAutoScalePolicyAsync(p *cloudstack.UpdateAutoScalePolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateAutoScalePolicyAsync", p)
+	if m.UpdateAutoScalePolicyAsyncFunc != nil {
+		return m.UpdateAutoScalePolicyAsyncFunc(p)
+	}
+	if m.UpdateAutoScalePolicyAsyncWithContextFunc != nil {
+		return m.UpdateAutoScalePolicyAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.UpdateAutoScalePolicyAsync")
+	return
+}
+
+// UpdateAutoScalePolicyAsyncWithContext records the call and calls UpdateAutoScalePolicyAsyncWithContextFunc, or UpdateAutoScalePolicyAsyncFunc if only that is set
+func (m *MockAutoScaleService) UpdateAutoScalePolicyAsyncWithContext(ctx context.Context, p *cloudstack.UpdateAutoScalePolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateAutoScalePolicyAsyncWithContext", ctx, p)
+	if m.UpdateAutoScalePolicyAsyncWithContextFunc != nil {
+		return m.UpdateAutoScalePolicyAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateAutoScalePolicyAsyncFunc != nil {
+		return m.UpdateAutoScalePolicyAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.UpdateAutoScalePolicyAsyncWithContext")
+	return
+}
+
+// NewUpdateAutoScaleVmGroupParams records the call and calls NewUpdateAutoScaleVmGroupParamsFunc
+func (m *MockAutoScaleService) NewUpdateAutoScaleVmGroupParams(id string) (r0 *cloudstack.UpdateAutoScaleVmGroupParams) {
+	m.record("NewUpdateAutoScaleVmGroupParams", id)
+	if m.NewUpdateAutoScaleVmGroupParamsFunc != nil {
+		return m.NewUpdateAutoScaleVmGroupParamsFunc(id)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewUpdateAutoScaleVmGroupParams(id)
+}
+
+// UpdateAutoScaleVmGroup records the call and calls UpdateAutoScaleVmGroupFunc, or UpdateAutoScaleVmGroupWithContextFunc if only that is set
+func (m *MockAutoScaleService) UpdateAutoScaleVmGroup(p *cloudstack.UpdateAutoScaleVmGroupParams) (r0 *cloudstack.UpdateAutoScaleVmGroupResponse, r1 error) {
+	m.record("UpdateAutoScaleVmGroup", p)
+	if m.UpdateAutoScaleVmGroupFunc != nil {
+		return m.UpdateAutoScaleVmGroupFunc(p)
+	}
+	if m.UpdateAutoScaleVmGroupWithContextFunc != nil {
+		return m.UpdateAutoScaleVmGroupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.UpdateAutoScaleVmGroup")
+	return
+}
+
+// UpdateAutoScaleVmGroupWithContext records the call and calls UpdateAutoScaleVmGroupWithContextFunc, or UpdateAutoScaleVmGroupFunc if only that is set
+func (m *MockAutoScaleService) UpdateAutoScaleVmGroupWithContext(ctx context.Context, p *cloudstack.UpdateAutoScaleVmGroupParams) (r0 *cloudstack.UpdateAutoScaleVmGroupResponse, r1 error) {
+	m.record("UpdateAutoScaleVmGroupWithContext", ctx, p)
+	if m.UpdateAutoScaleVmGroupWithContextFunc != nil {
+		return m.UpdateAutoScaleVmGroupWithContextFunc(ctx, p)
+	}
+	if m.UpdateAutoScaleVmGroupFunc != nil {
+		return m.UpdateAutoScaleVmGroupFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.UpdateAutoScaleVmGroupWithContext")
+	return
+}
+
+// UpdateAutoScaleVmGroupAsync records the call and calls UpdateAutoScaleVmGroupAsyncFunc, or UpdateAutoScaleVmGroupAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) UpdateAutoScaleVmGroupAsync(p *cloudstack.UpdateAutoScaleVmGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateAutoScaleVmGroupAsync", p)
+	if m.UpdateAutoScaleVmGroupAsyncFunc != nil {
+		return m.UpdateAutoScaleVmGroupAsyncFunc(p)
+	}
+	if m.UpdateAutoScaleVmGroupAsyncWithContextFunc != nil {
+		return m.UpdateAutoScaleVmGroupAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.UpdateAutoScaleVmGroupAsync")
+	return
+}
+
+// UpdateAutoScaleVmGroupAsyncWithContext records the call and calls UpdateAutoScaleVmGroupAsyncWithContextFunc, or UpdateAutoScaleVmGroupAsyncFunc if only that is set
+func (m *MockAutoScaleService) UpdateAutoScaleVmGroupAsyncWithContext(ctx context.Context, p *cloudstack.UpdateAutoScaleVmGroupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateAutoScaleVmGroupAsyncWithContext", ctx, p)
+	if m.UpdateAutoScaleVmGroupAsyncWithContextFunc != nil {
+		return m.UpdateAutoScaleVmGroupAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateAutoScaleVmGroupAsyncFunc != nil {
+		return m.UpdateAutoScaleVmGroupAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.UpdateAutoScaleVmGroupAsyncWithContext")
+	return
+}
+
+// NewUpdateAutoScaleVmProfileParams records the call and calls NewUpdateAutoScaleVmProfileParamsFunc
+func (m *MockAutoScaleService) NewUpdateAutoScaleVmProfileParams(id string) (r0 *cloudstack.UpdateAutoScaleVmProfileParams) {
+	m.record("NewUpdateAutoScaleVmProfileParams", id)
+	if m.NewUpdateAutoScaleVmProfileParamsFunc != nil {
+		return m.NewUpdateAutoScaleVmProfileParamsFunc(id)
+	}
+	return cloudstack.NewAutoScaleService(nil).NewUpdateAutoScaleVmProfileParams(id)
+}
+
+// UpdateAutoScaleVmProfile records the call and calls UpdateAutoScaleVmProfileFunc, or UpdateAutoScaleVmProfileWithContextFunc if only that is set
+func (m *MockAutoScaleService) UpdateAutoScaleVmProfile(p *cloudstack.UpdateAutoScaleVmProfileParams) (r0 *cloudstack.UpdateAutoScaleVmProfileResponse, r1 error) {
+	m.record("UpdateAutoScaleVmProfile", p)
+	if m.UpdateAutoScaleVmProfileFunc != nil {
+		return m.UpdateAutoScaleVmProfileFunc(p)
+	}
+	if m.UpdateAutoScaleVmProfileWithContextFunc != nil {
+		return m.UpdateAutoScaleVmProfileWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.UpdateAutoScaleVmProfile")
+	return
+}
+
+// UpdateAutoScaleVmProfileWithContext records the call and calls UpdateAutoScaleVmProfileWithContextFunc, or UpdateAutoScaleVmProfileFunc if only that is set
+func (m *MockAutoScaleService) UpdateAutoScaleVmProfileWithContext(ctx context.Context, p *cloudstack.UpdateAutoScaleVmProfileParams) (r0 *cloudstack.UpdateAutoScaleVmProfileResponse, r1 error) {
+	m.record("UpdateAutoScaleVmProfileWithContext", ctx, p)
+	if m.UpdateAutoScaleVmProfileWithContextFunc != nil {
+		return m.UpdateAutoScaleVmProfileWithContextFunc(ctx, p)
+	}
+	if m.UpdateAutoScaleVmProfileFunc != nil {
+		return m.UpdateAutoScaleVmProfileFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.UpdateAutoScaleVmProfileWithContext")
+	return
+}
+
+// UpdateAutoScaleVmProfileAsync records the call and calls UpdateAutoScaleVmProfileAsyncFunc, or UpdateAutoScaleVmProfileAsyncWithContextFunc if only that is set
+func (m *MockAutoScaleService) UpdateAutoScaleVmProfileAsync(p *cloudstack.UpdateAutoScaleVmProfileParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateAutoScaleVmProfileAsync", p)
+	if m.UpdateAutoScaleVmProfileAsyncFunc != nil {
+		return m.UpdateAutoScaleVmProfileAsyncFunc(p)
+	}
+	if m.UpdateAutoScaleVmProfileAsyncWithContextFunc != nil {
+		return m.UpdateAutoScaleVmProfileAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("AutoScaleService.UpdateAutoScaleVmProfileAsync")
+	return
+}
+
+// UpdateAutoScaleVmProfileAsyncWithContext records the call and calls UpdateAutoScaleVmProfileAsyncWithContextFunc, or UpdateAutoScaleVmProfileAsyncFunc if only that is set
+func (m *MockAutoScaleService) UpdateAutoScaleVmProfileAsyncWithContext(ctx context.Context, p *cloudstack.UpdateAutoScaleVmProfileParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateAutoScaleVmProfileAsyncWithContext", ctx, p)
+	if m.UpdateAutoScaleVmProfileAsyncWithContextFunc != nil {
+		return m.UpdateAutoScaleVmProfileAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateAutoScaleVmProfileAsyncFunc != nil {
+		return m.UpdateAutoScaleVmProfileAsyncFunc(p)
+	}
+	r1 = notMocked("AutoScaleService.UpdateAutoScaleVmProfileAsyncWithContext")
+	return
+}
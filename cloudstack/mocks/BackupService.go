@@ -0,0 +1,1097 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockBackupService is a mock of cloudstack.BackupServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockBackupService struct {
+	Recorder
+
+	NewAssignVirtualMachineToBackupOfferingParamsFunc          func(backupofferingid string, virtualmachineid string) *cloudstack.AssignVirtualMachineToBackupOfferingParams
+	AssignVirtualMachineToBackupOfferingFunc                   func(p *cloudstack.AssignVirtualMachineToBackupOfferingParams) (*cloudstack.AssignVirtualMachineToBackupOfferingResponse, error)
+	AssignVirtualMachineToBackupOfferingWithContextFunc        func(ctx context.Context, p *cloudstack.AssignVirtualMachineToBackupOfferingParams) (*cloudstack.AssignVirtualMachineToBackupOfferingResponse, error)
+	AssignVirtualMachineToBackupOfferingAsyncFunc              func(p *cloudstack.AssignVirtualMachineToBackupOfferingParams) (*cloudstack.Job, error)
+	AssignVirtualMachineToBackupOfferingAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.AssignVirtualMachineToBackupOfferingParams) (*cloudstack.Job, error)
+	NewCreateBackupParamsFunc                                  func(virtualmachineid string) *cloudstack.CreateBackupParams
+	CreateBackupFunc                                           func(p *cloudstack.CreateBackupParams) (*cloudstack.CreateBackupResponse, error)
+	CreateBackupWithContextFunc                                func(ctx context.Context, p *cloudstack.CreateBackupParams) (*cloudstack.CreateBackupResponse, error)
+	CreateBackupAsyncFunc                                      func(p *cloudstack.CreateBackupParams) (*cloudstack.Job, error)
+	CreateBackupAsyncWithContextFunc                           func(ctx context.Context, p *cloudstack.CreateBackupParams) (*cloudstack.Job, error)
+	NewCreateBackupScheduleParamsFunc                          func(intervaltype string, schedule string, timezone string, virtualmachineid string) *cloudstack.CreateBackupScheduleParams
+	CreateBackupScheduleFunc                                   func(p *cloudstack.CreateBackupScheduleParams) (*cloudstack.CreateBackupScheduleResponse, error)
+	CreateBackupScheduleWithContextFunc                        func(ctx context.Context, p *cloudstack.CreateBackupScheduleParams) (*cloudstack.CreateBackupScheduleResponse, error)
+	NewDeleteBackupParamsFunc                                  func(id string) *cloudstack.DeleteBackupParams
+	DeleteBackupFunc                                           func(p *cloudstack.DeleteBackupParams) (*cloudstack.DeleteBackupResponse, error)
+	DeleteBackupWithContextFunc                                func(ctx context.Context, p *cloudstack.DeleteBackupParams) (*cloudstack.DeleteBackupResponse, error)
+	DeleteBackupAsyncFunc                                      func(p *cloudstack.DeleteBackupParams) (*cloudstack.Job, error)
+	DeleteBackupAsyncWithContextFunc                           func(ctx context.Context, p *cloudstack.DeleteBackupParams) (*cloudstack.Job, error)
+	NewDeleteBackupOfferingParamsFunc                          func(id string) *cloudstack.DeleteBackupOfferingParams
+	DeleteBackupOfferingFunc                                   func(p *cloudstack.DeleteBackupOfferingParams) (*cloudstack.DeleteBackupOfferingResponse, error)
+	DeleteBackupOfferingWithContextFunc                        func(ctx context.Context, p *cloudstack.DeleteBackupOfferingParams) (*cloudstack.DeleteBackupOfferingResponse, error)
+	NewDeleteBackupScheduleParamsFunc                          func(virtualmachineid string) *cloudstack.DeleteBackupScheduleParams
+	DeleteBackupScheduleFunc                                   func(p *cloudstack.DeleteBackupScheduleParams) (*cloudstack.DeleteBackupScheduleResponse, error)
+	DeleteBackupScheduleWithContextFunc                        func(ctx context.Context, p *cloudstack.DeleteBackupScheduleParams) (*cloudstack.DeleteBackupScheduleResponse, error)
+	NewImportBackupOfferingParamsFunc                          func(allowuserdrivenbackups bool, description string, externalid string, name string, zoneid string) *cloudstack.ImportBackupOfferingParams
+	ImportBackupOfferingFunc                                   func(p *cloudstack.ImportBackupOfferingParams) (*cloudstack.ImportBackupOfferingResponse, error)
+	ImportBackupOfferingWithContextFunc                        func(ctx context.Context, p *cloudstack.ImportBackupOfferingParams) (*cloudstack.ImportBackupOfferingResponse, error)
+	ImportBackupOfferingAsyncFunc                              func(p *cloudstack.ImportBackupOfferingParams) (*cloudstack.Job, error)
+	ImportBackupOfferingAsyncWithContextFunc                   func(ctx context.Context, p *cloudstack.ImportBackupOfferingParams) (*cloudstack.Job, error)
+	NewListBackupOfferingsParamsFunc                           func() *cloudstack.ListBackupOfferingsParams
+	GetBackupOfferingIDFunc                                    func(keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetBackupOfferingIDWithContextFunc                         func(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetBackupOfferingByNameFunc                                func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.BackupOffering, int, error)
+	GetBackupOfferingByNameWithContextFunc                     func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.BackupOffering, int, error)
+	GetBackupOfferingByIDFunc                                  func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.BackupOffering, int, error)
+	GetBackupOfferingByIDWithContextFunc                       func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.BackupOffering, int, error)
+	ListBackupOfferingsFunc                                    func(p *cloudstack.ListBackupOfferingsParams) (*cloudstack.ListBackupOfferingsResponse, error)
+	ListBackupOfferingsWithContextFunc                         func(ctx context.Context, p *cloudstack.ListBackupOfferingsParams) (*cloudstack.ListBackupOfferingsResponse, error)
+	NewListBackupOfferingsPagerFunc                            func(p *cloudstack.ListBackupOfferingsParams) *cloudstack.ListBackupOfferingsPager
+	ListBackupOfferingsAllFunc                                 func(p *cloudstack.ListBackupOfferingsParams) (*cloudstack.ListBackupOfferingsResponse, error)
+	ListBackupOfferingsAllWithContextFunc                      func(ctx context.Context, p *cloudstack.ListBackupOfferingsParams) (*cloudstack.ListBackupOfferingsResponse, error)
+	NewListBackupProviderOfferingsParamsFunc                   func(zoneid string) *cloudstack.ListBackupProviderOfferingsParams
+	GetBackupProviderOfferingIDFunc                            func(keyword string, zoneid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetBackupProviderOfferingIDWithContextFunc                 func(ctx context.Context, keyword string, zoneid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListBackupProviderOfferingsFunc                            func(p *cloudstack.ListBackupProviderOfferingsParams) (*cloudstack.ListBackupProviderOfferingsResponse, error)
+	ListBackupProviderOfferingsWithContextFunc                 func(ctx context.Context, p *cloudstack.ListBackupProviderOfferingsParams) (*cloudstack.ListBackupProviderOfferingsResponse, error)
+	NewListBackupProviderOfferingsPagerFunc                    func(p *cloudstack.ListBackupProviderOfferingsParams) *cloudstack.ListBackupProviderOfferingsPager
+	ListBackupProviderOfferingsAllFunc                         func(p *cloudstack.ListBackupProviderOfferingsParams) (*cloudstack.ListBackupProviderOfferingsResponse, error)
+	ListBackupProviderOfferingsAllWithContextFunc              func(ctx context.Context, p *cloudstack.ListBackupProviderOfferingsParams) (*cloudstack.ListBackupProviderOfferingsResponse, error)
+	NewListBackupProvidersParamsFunc                           func() *cloudstack.ListBackupProvidersParams
+	ListBackupProvidersFunc                                    func(p *cloudstack.ListBackupProvidersParams) (*cloudstack.ListBackupProvidersResponse, error)
+	ListBackupProvidersWithContextFunc                         func(ctx context.Context, p *cloudstack.ListBackupProvidersParams) (*cloudstack.ListBackupProvidersResponse, error)
+	NewListBackupScheduleParamsFunc                            func(virtualmachineid string) *cloudstack.ListBackupScheduleParams
+	ListBackupScheduleFunc                                     func(p *cloudstack.ListBackupScheduleParams) (*cloudstack.ListBackupScheduleResponse, error)
+	ListBackupScheduleWithContextFunc                          func(ctx context.Context, p *cloudstack.ListBackupScheduleParams) (*cloudstack.ListBackupScheduleResponse, error)
+	NewListBackupsParamsFunc                                   func() *cloudstack.ListBackupsParams
+	GetBackupByIDFunc                                          func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Backup, int, error)
+	GetBackupByIDWithContextFunc                               func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Backup, int, error)
+	ListBackupsFunc                                            func(p *cloudstack.ListBackupsParams) (*cloudstack.ListBackupsResponse, error)
+	ListBackupsWithContextFunc                                 func(ctx context.Context, p *cloudstack.ListBackupsParams) (*cloudstack.ListBackupsResponse, error)
+	NewListBackupsPagerFunc                                    func(p *cloudstack.ListBackupsParams) *cloudstack.ListBackupsPager
+	ListBackupsAllFunc                                         func(p *cloudstack.ListBackupsParams) (*cloudstack.ListBackupsResponse, error)
+	ListBackupsAllWithContextFunc                              func(ctx context.Context, p *cloudstack.ListBackupsParams) (*cloudstack.ListBackupsResponse, error)
+	NewRemoveVirtualMachineFromBackupOfferingParamsFunc        func(virtualmachineid string) *cloudstack.RemoveVirtualMachineFromBackupOfferingParams
+	RemoveVirtualMachineFromBackupOfferingFunc                 func(p *cloudstack.RemoveVirtualMachineFromBackupOfferingParams) (*cloudstack.RemoveVirtualMachineFromBackupOfferingResponse, error)
+	RemoveVirtualMachineFromBackupOfferingWithContextFunc      func(ctx context.Context, p *cloudstack.RemoveVirtualMachineFromBackupOfferingParams) (*cloudstack.RemoveVirtualMachineFromBackupOfferingResponse, error)
+	RemoveVirtualMachineFromBackupOfferingAsyncFunc            func(p *cloudstack.RemoveVirtualMachineFromBackupOfferingParams) (*cloudstack.Job, error)
+	RemoveVirtualMachineFromBackupOfferingAsyncWithContextFunc func(ctx context.Context, p *cloudstack.RemoveVirtualMachineFromBackupOfferingParams) (*cloudstack.Job, error)
+	NewRestoreBackupParamsFunc                                 func(id string) *cloudstack.RestoreBackupParams
+	RestoreBackupFunc                                          func(p *cloudstack.RestoreBackupParams) (*cloudstack.RestoreBackupResponse, error)
+	RestoreBackupWithContextFunc                               func(ctx context.Context, p *cloudstack.RestoreBackupParams) (*cloudstack.RestoreBackupResponse, error)
+	RestoreBackupAsyncFunc                                     func(p *cloudstack.RestoreBackupParams) (*cloudstack.Job, error)
+	RestoreBackupAsyncWithContextFunc                          func(ctx context.Context, p *cloudstack.RestoreBackupParams) (*cloudstack.Job, error)
+	NewRestoreVolumeFromBackupAndAttachToVMParamsFunc          func(backupid string, virtualmachineid string, volumeid string) *cloudstack.RestoreVolumeFromBackupAndAttachToVMParams
+	RestoreVolumeFromBackupAndAttachToVMFunc                   func(p *cloudstack.RestoreVolumeFromBackupAndAttachToVMParams) (*cloudstack.RestoreVolumeFromBackupAndAttachToVMResponse, error)
+	RestoreVolumeFromBackupAndAttachToVMWithContextFunc        func(ctx context.Context, p *cloudstack.RestoreVolumeFromBackupAndAttachToVMParams) (*cloudstack.RestoreVolumeFromBackupAndAttachToVMResponse, error)
+	RestoreVolumeFromBackupAndAttachToVMAsyncFunc              func(p *cloudstack.RestoreVolumeFromBackupAndAttachToVMParams) (*cloudstack.Job, error)
+	RestoreVolumeFromBackupAndAttachToVMAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.RestoreVolumeFromBackupAndAttachToVMParams) (*cloudstack.Job, error)
+	NewUpdateBackupScheduleParamsFunc                          func(intervaltype string, schedule string, timezone string, virtualmachineid string) *cloudstack.UpdateBackupScheduleParams
+	UpdateBackupScheduleFunc                                   func(p *cloudstack.UpdateBackupScheduleParams) (*cloudstack.UpdateBackupScheduleResponse, error)
+	UpdateBackupScheduleWithContextFunc                        func(ctx context.Context, p *cloudstack.UpdateBackupScheduleParams) (*cloudstack.UpdateBackupScheduleResponse, error)
+}
+
+var _ cloudstack.BackupServiceIface = (*MockBackupService)(nil)
+
+// NewAssignVirtualMachineToBackupOfferingParams records the call and calls NewAssignVirtualMachineToBackupOfferingParamsFunc
+func (m *MockBackupService) NewAssignVirtualMachineToBackupOfferingParams(backupofferingid string, virtualmachineid string) (r0 *cloudstack.AssignVirtualMachineToBackupOfferingParams) {
+	m.record("NewAssignVirtualMachineToBackupOfferingParams", backupofferingid, virtualmachineid)
+	if m.NewAssignVirtualMachineToBackupOfferingParamsFunc != nil {
+		return m.NewAssignVirtualMachineToBackupOfferingParamsFunc(backupofferingid, virtualmachineid)
+	}
+	return cloudstack.NewBackupService(nil).NewAssignVirtualMachineToBackupOfferingParams(backupofferingid, virtualmachineid)
+}
+
+// AssignVirtualMachineToBackupOffering records the call and calls AssignVirtualMachineToBackupOfferingFunc, or AssignVirtualMachineToBackupOfferingWithContextFunc if only that is set
+func (m *MockBackupService) AssignVirtualMachineToBackupOffering(p *cloudstack.AssignVirtualMachineToBackupOfferingParams) (r0 *cloudstack.AssignVirtualMachineToBackupOfferingResponse, r1 error) {
+	m.record("AssignVirtualMachineToBackupOffering", p)
+	if m.AssignVirtualMachineToBackupOfferingFunc != nil {
+		return m.AssignVirtualMachineToBackupOfferingFunc(p)
+	}
+	if m.AssignVirtualMachineToBackupOfferingWithContextFunc != nil {
+		return m.AssignVirtualMachineToBackupOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.AssignVirtualMachineToBackupOffering")
+	return
+}
+
+// AssignVirtualMachineToBackupOfferingWithContext records the call and calls AssignVirtualMachineToBackupOfferingWithContextFunc, or AssignVirtualMachineToBackupOfferingFunc if only that is set
+func (m *MockBackupService) AssignVirtualMachineToBackupOfferingWithContext(ctx context.Context, p *cloudstack.AssignVirtualMachineToBackupOfferingParams) (r0 *cloudstack.AssignVirtualMachineToBackupOfferingResponse, r1 error) {
+	m.record("AssignVirtualMachineToBackupOfferingWithContext", ctx, p)
+	if m.AssignVirtualMachineToBackupOfferingWithContextFunc != nil {
+		return m.AssignVirtualMachineToBackupOfferingWithContextFunc(ctx, p)
+	}
+	if m.AssignVirtualMachineToBackupOfferingFunc != nil {
+		return m.AssignVirtualMachineToBackupOfferingFunc(p)
+	}
+	r1 = notMocked("BackupService.AssignVirtualMachineToBackupOfferingWithContext")
+	return
+}
+
+// AssignVirtualMachineToBackupOfferingAsync records the call and calls AssignVirtualMachineToBackupOfferingAsyncFunc, or AssignVirtualMachineToBackupOfferingAsyncWithContextFunc if only that is set
+func (m *MockBackupService) AssignVirtualMachineToBackupOfferingAsync(p *cloudstack.AssignVirtualMachineToBackupOfferingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AssignVirtualMachineToBackupOfferingAsync", p)
+	if m.AssignVirtualMachineToBackupOfferingAsyncFunc != nil {
+		return m.AssignVirtualMachineToBackupOfferingAsyncFunc(p)
+	}
+	if m.AssignVirtualMachineToBackupOfferingAsyncWithContextFunc != nil {
+		return m.AssignVirtualMachineToBackupOfferingAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.AssignVirtualMachineToBackupOfferingAsync")
+	return
+}
+
+// AssignVirtualMachineToBackupOfferingAsyncWithContext records the call and calls AssignVirtualMachineToBackupOfferingAsyncWithContextFunc, or AssignVirtualMachineToBackupOfferingAsyncFunc if only that is set
+func (m *MockBackupService) AssignVirtualMachineToBackupOfferingAsyncWithContext(ctx context.Context, p *cloudstack.AssignVirtualMachineToBackupOfferingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AssignVirtualMachineToBackupOfferingAsyncWithContext", ctx, p)
+	if m.AssignVirtualMachineToBackupOfferingAsyncWithContextFunc != nil {
+		return m.AssignVirtualMachineToBackupOfferingAsyncWithContextFunc(ctx, p)
+	}
+	if m.AssignVirtualMachineToBackupOfferingAsyncFunc != nil {
+		return m.AssignVirtualMachineToBackupOfferingAsyncFunc(p)
+	}
+	r1 = notMocked("BackupService.AssignVirtualMachineToBackupOfferingAsyncWithContext")
+	return
+}
+
+// NewCreateBackupParams records the call and calls NewCreateBackupParamsFunc
+func (m *MockBackupService) NewCreateBackupParams(virtualmachineid string) (r0 *cloudstack.CreateBackupParams) {
+	m.record("NewCreateBackupParams", virtualmachineid)
+	if m.NewCreateBackupParamsFunc != nil {
+		return m.NewCreateBackupParamsFunc(virtualmachineid)
+	}
+	return cloudstack.NewBackupService(nil).NewCreateBackupParams(virtualmachineid)
+}
+
+// CreateBackup records the call and calls CreateBackupFunc, or CreateBackupWithContextFunc if only that is set
+func (m *MockBackupService) CreateBackup(p *cloudstack.CreateBackupParams) (r0 *cloudstack.CreateBackupResponse, r1 error) {
+	m.record("CreateBackup", p)
+	if m.CreateBackupFunc != nil {
+		return m.CreateBackupFunc(p)
+	}
+	if m.CreateBackupWithContextFunc != nil {
+		return m.CreateBackupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.CreateBackup")
+	return
+}
+
+// CreateBackupWithContext records the call and calls CreateBackupWithContextFunc, or CreateBackupFunc if only that is set
+func (m *MockBackupService) CreateBackupWithContext(ctx context.Context, p *cloudstack.CreateBackupParams) (r0 *cloudstack.CreateBackupResponse, r1 error) {
+	m.record("CreateBackupWithContext", ctx, p)
+	if m.CreateBackupWithContextFunc != nil {
+		return m.CreateBackupWithContextFunc(ctx, p)
+	}
+	if m.CreateBackupFunc != nil {
+		return m.CreateBackupFunc(p)
+	}
+	r1 = notMocked("BackupService.CreateBackupWithContext")
+	return
+}
+
+// CreateBackupAsync records the call and calls CreateBackupAsyncFunc, or CreateBackupAsyncWithContextFunc if only that is set
+func (m *MockBackupService) CreateBackupAsync(p *cloudstack.CreateBackupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateBackupAsync", p)
+	if m.CreateBackupAsyncFunc != nil {
+		return m.CreateBackupAsyncFunc(p)
+	}
+	if m.CreateBackupAsyncWithContextFunc != nil {
+		return m.CreateBackupAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.CreateBackupAsync")
+	return
+}
+
+// CreateBackupAsyncWithContext records the call and calls CreateBackupAsyncWithContextFunc, or CreateBackupAsyncFunc if only that is set
+func (m *MockBackupService) CreateBackupAsyncWithContext(ctx context.Context, p *cloudstack.CreateBackupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateBackupAsyncWithContext", ctx, p)
+	if m.CreateBackupAsyncWithContextFunc != nil {
+		return m.CreateBackupAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateBackupAsyncFunc != nil {
+		return m.CreateBackupAsyncFunc(p)
+	}
+	r1 = notMocked("BackupService.CreateBackupAsyncWithContext")
+	return
+}
+
+// NewCreateBackupScheduleParams records the call and calls NewCreateBackupScheduleParamsFunc
+func (m *MockBackupService) NewCreateBackupScheduleParams(intervaltype string, schedule string, timezone string, virtualmachineid string) (r0 *cloudstack.CreateBackupScheduleParams) {
+	m.record("NewCreateBackupScheduleParams", intervaltype, schedule, timezone, virtualmachineid)
+	if m.NewCreateBackupScheduleParamsFunc != nil {
+		return m.NewCreateBackupScheduleParamsFunc(intervaltype, schedule, timezone, virtualmachineid)
+	}
+	return cloudstack.NewBackupService(nil).NewCreateBackupScheduleParams(intervaltype, schedule, timezone, virtualmachineid)
+}
+
+// CreateBackupSchedule records the call and calls CreateBackupScheduleFunc, or CreateBackupScheduleWithContextFunc if only that is set
+func (m *MockBackupService) CreateBackupSchedule(p *cloudstack.CreateBackupScheduleParams) (r0 *cloudstack.CreateBackupScheduleResponse, r1 error) {
+	m.record("CreateBackupSchedule", p)
+	if m.CreateBackupScheduleFunc != nil {
+		return m.CreateBackupScheduleFunc(p)
+	}
+	if m.CreateBackupScheduleWithContextFunc != nil {
+		return m.CreateBackupScheduleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.CreateBackupSchedule")
+	return
+}
+
+// CreateBackupScheduleWithContext records the call and calls CreateBackupScheduleWithContextFunc, or CreateBackupScheduleFunc if only that is set
+func (m *MockBackupService) CreateBackupScheduleWithContext(ctx context.Context, p *cloudstack.CreateBackupScheduleParams) (r0 *cloudstack.CreateBackupScheduleResponse, r1 error) {
+	m.record("CreateBackupScheduleWithContext", ctx, p)
+	if m.CreateBackupScheduleWithContextFunc != nil {
+		return m.CreateBackupScheduleWithContextFunc(ctx, p)
+	}
+	if m.CreateBackupScheduleFunc != nil {
+		return m.CreateBackupScheduleFunc(p)
+	}
+	r1 = notMocked("BackupService.CreateBackupScheduleWithContext")
+	return
+}
+
+// NewDeleteBackupParams records the call and calls NewDeleteBackupParamsFunc
+func (m *MockBackupService) NewDeleteBackupParams(id string) (r0 *cloudstack.DeleteBackupParams) {
+	m.record("NewDeleteBackupParams", id)
+	if m.NewDeleteBackupParamsFunc != nil {
+		return m.NewDeleteBackupParamsFunc(id)
+	}
+	return cloudstack.NewBackupService(nil).NewDeleteBackupParams(id)
+}
+
+// DeleteBackup records the call and calls DeleteBackupFunc, or DeleteBackupWithContextFunc if only that is set
+func (m *MockBackupService) DeleteBackup(p *cloudstack.DeleteBackupParams) (r0 *cloudstack.DeleteBackupResponse, r1 error) {
+	m.record("DeleteBackup", p)
+	if m.DeleteBackupFunc != nil {
+		return m.DeleteBackupFunc(p)
+	}
+	if m.DeleteBackupWithContextFunc != nil {
+		return m.DeleteBackupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.DeleteBackup")
+	return
+}
+
+// DeleteBackupWithContext records the call and calls DeleteBackupWithContextFunc, or DeleteBackupFunc if only that is set
+func (m *MockBackupService) DeleteBackupWithContext(ctx context.Context, p *cloudstack.DeleteBackupParams) (r0 *cloudstack.DeleteBackupResponse, r1 error) {
+	m.record("DeleteBackupWithContext", ctx, p)
+	if m.DeleteBackupWithContextFunc != nil {
+		return m.DeleteBackupWithContextFunc(ctx, p)
+	}
+	if m.DeleteBackupFunc != nil {
+		return m.DeleteBackupFunc(p)
+	}
+	r1 = notMocked("BackupService.DeleteBackupWithContext")
+	return
+}
+
+// DeleteBackupAsync records the call and calls DeleteBackupAsyncFunc, or DeleteBackupAsyncWithContextFunc if only that is set
+func (m *MockBackupService) DeleteBackupAsync(p *cloudstack.DeleteBackupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteBackupAsync", p)
+	if m.DeleteBackupAsyncFunc != nil {
+		return m.DeleteBackupAsyncFunc(p)
+	}
+	if m.DeleteBackupAsyncWithContextFunc != nil {
+		return m.DeleteBackupAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.DeleteBackupAsync")
+	return
+}
+
+// DeleteBackupAsyncWithContext records the call and calls DeleteBackupAsyncWithContextFunc, or DeleteBackupAsyncFunc if only that is set
+func (m *MockBackupService) DeleteBackupAsyncWithContext(ctx context.Context, p *cloudstack.DeleteBackupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteBackupAsyncWithContext", ctx, p)
+	if m.DeleteBackupAsyncWithContextFunc != nil {
+		return m.DeleteBackupAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteBackupAsyncFunc != nil {
+		return m.DeleteBackupAsyncFunc(p)
+	}
+	r1 = notMocked("BackupService.DeleteBackupAsyncWithContext")
+	return
+}
+
+// NewDeleteBackupOfferingParams records the call and calls NewDeleteBackupOfferingParamsFunc
+func (m *MockBackupService) NewDeleteBackupOfferingParams(id string) (r0 *cloudstack.DeleteBackupOfferingParams) {
+	m.record("NewDeleteBackupOfferingParams", id)
+	if m.NewDeleteBackupOfferingParamsFunc != nil {
+		return m.NewDeleteBackupOfferingParamsFunc(id)
+	}
+	return cloudstack.NewBackupService(nil).NewDeleteBackupOfferingParams(id)
+}
+
+// DeleteBackupOffering records the call and calls DeleteBackupOfferingFunc, or DeleteBackupOfferingWithContextFunc if only that is set
+func (m *MockBackupService) DeleteBackupOffering(p *cloudstack.DeleteBackupOfferingParams) (r0 *cloudstack.DeleteBackupOfferingResponse, r1 error) {
+	m.record("DeleteBackupOffering", p)
+	if m.DeleteBackupOfferingFunc != nil {
+		return m.DeleteBackupOfferingFunc(p)
+	}
+	if m.DeleteBackupOfferingWithContextFunc != nil {
+		return m.DeleteBackupOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.DeleteBackupOffering")
+	return
+}
+
+// DeleteBackupOfferingWithContext records the call and calls DeleteBackupOfferingWithContextFunc, or DeleteBackupOfferingFunc if only that is set
+func (m *MockBackupService) DeleteBackupOfferingWithContext(ctx context.Context, p *cloudstack.DeleteBackupOfferingParams) (r0 *cloudstack.DeleteBackupOfferingResponse, r1 error) {
+	m.record("DeleteBackupOfferingWithContext", ctx, p)
+	if m.DeleteBackupOfferingWithContextFunc != nil {
+		return m.DeleteBackupOfferingWithContextFunc(ctx, p)
+	}
+	if m.DeleteBackupOfferingFunc != nil {
+		return m.DeleteBackupOfferingFunc(p)
+	}
+	r1 = notMocked("BackupService.DeleteBackupOfferingWithContext")
+	return
+}
+
+// NewDeleteBackupScheduleParams records the call and calls NewDeleteBackupScheduleParamsFunc
+func (m *MockBackupService) NewDeleteBackupScheduleParams(virtualmachineid string) (r0 *cloudstack.DeleteBackupScheduleParams) {
+	m.record("NewDeleteBackupScheduleParams", virtualmachineid)
+	if m.NewDeleteBackupScheduleParamsFunc != nil {
+		return m.NewDeleteBackupScheduleParamsFunc(virtualmachineid)
+	}
+	return cloudstack.NewBackupService(nil).NewDeleteBackupScheduleParams(virtualmachineid)
+}
+
+// DeleteBackupSchedule records the call and calls DeleteBackupScheduleFunc, or DeleteBackupScheduleWithContextFunc if only that is set
+func (m *MockBackupService) DeleteBackupSchedule(p *cloudstack.DeleteBackupScheduleParams) (r0 *cloudstack.DeleteBackupScheduleResponse, r1 error) {
+	m.record("DeleteBackupSchedule", p)
+	if m.DeleteBackupScheduleFunc != nil {
+		return m.DeleteBackupScheduleFunc(p)
+	}
+	if m.DeleteBackupScheduleWithContextFunc != nil {
+		return m.DeleteBackupScheduleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.DeleteBackupSchedule")
+	return
+}
+
+// DeleteBackupScheduleWithContext records the call and calls DeleteBackupScheduleWithContextFunc, or DeleteBackupScheduleFunc if only that is set
+func (m *MockBackupService) DeleteBackupScheduleWithContext(ctx context.Context, p *cloudstack.DeleteBackupScheduleParams) (r0 *cloudstack.DeleteBackupScheduleResponse, r1 error) {
+	m.record("DeleteBackupScheduleWithContext", ctx, p)
+	if m.DeleteBackupScheduleWithContextFunc != nil {
+		return m.DeleteBackupScheduleWithContextFunc(ctx, p)
+	}
+	if m.DeleteBackupScheduleFunc != nil {
+		return m.DeleteBackupScheduleFunc(p)
+	}
+	r1 = notMocked("BackupService.DeleteBackupScheduleWithContext")
+	return
+}
+
+// NewImportBackupOfferingParams records the call and calls NewImportBackupOfferingParamsFunc
+func (m *MockBackupService) NewImportBackupOfferingParams(allowuserdrivenbackups bool, description string, externalid string, name string, zoneid string) (r0 *cloudstack.ImportBackupOfferingParams) {
+	m.record("NewImportBackupOfferingParams", allowuserdrivenbackups, description, externalid, name, zoneid)
+	if m.NewImportBackupOfferingParamsFunc != nil {
+		return m.NewImportBackupOfferingParamsFunc(allowuserdrivenbackups, description, externalid, name, zoneid)
+	}
+	return cloudstack.NewBackupService(nil).NewImportBackupOfferingParams(allowuserdrivenbackups, description, externalid, name, zoneid)
+}
+
+// ImportBackupOffering records the call and calls ImportBackupOfferingFunc, or ImportBackupOfferingWithContextFunc if only that is set
+func (m *MockBackupService) ImportBackupOffering(p *cloudstack.ImportBackupOfferingParams) (r0 *cloudstack.ImportBackupOfferingResponse, r1 error) {
+	m.record("ImportBackupOffering", p)
+	if m.ImportBackupOfferingFunc != nil {
+		return m.ImportBackupOfferingFunc(p)
+	}
+	if m.ImportBackupOfferingWithContextFunc != nil {
+		return m.ImportBackupOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.ImportBackupOffering")
+	return
+}
+
+// ImportBackupOfferingWithContext records the call and calls ImportBackupOfferingWithContextFunc, or ImportBackupOfferingFunc if only that is set
+func (m *MockBackupService) ImportBackupOfferingWithContext(ctx context.Context, p *cloudstack.ImportBackupOfferingParams) (r0 *cloudstack.ImportBackupOfferingResponse, r1 error) {
+	m.record("ImportBackupOfferingWithContext", ctx, p)
+	if m.ImportBackupOfferingWithContextFunc != nil {
+		return m.ImportBackupOfferingWithContextFunc(ctx, p)
+	}
+	if m.ImportBackupOfferingFunc != nil {
+		return m.ImportBackupOfferingFunc(p)
+	}
+	r1 = notMocked("BackupService.ImportBackupOfferingWithContext")
+	return
+}
+
+// ImportBackupOfferingAsync records the call and calls ImportBackupOfferingAsyncFunc, or ImportBackupOfferingAsyncWithContextFunc if only that is set
+func (m *MockBackupService) ImportBackupOfferingAsync(p *cloudstack.ImportBackupOfferingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ImportBackupOfferingAsync", p)
+	if m.ImportBackupOfferingAsyncFunc != nil {
+		return m.ImportBackupOfferingAsyncFunc(p)
+	}
+	if m.ImportBackupOfferingAsyncWithContextFunc != nil {
+		return m.ImportBackupOfferingAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.ImportBackupOfferingAsync")
+	return
+}
+
+// ImportBackupOfferingAsyncWithContext records the call and calls ImportBackupOfferingAsyncWithContextFunc, or ImportBackupOfferingAsyncFunc if only that is set
+func (m *MockBackupService) ImportBackupOfferingAsyncWithContext(ctx context.Context, p *cloudstack.ImportBackupOfferingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ImportBackupOfferingAsyncWithContext", ctx, p)
+	if m.ImportBackupOfferingAsyncWithContextFunc != nil {
+		return m.ImportBackupOfferingAsyncWithContextFunc(ctx, p)
+	}
+	if m.ImportBackupOfferingAsyncFunc != nil {
+		return m.ImportBackupOfferingAsyncFunc(p)
+	}
+	r1 = notMocked("BackupService.ImportBackupOfferingAsyncWithContext")
+	return
+}
+
+// NewListBackupOfferingsParams records the call and calls NewListBackupOfferingsParamsFunc
+func (m *MockBackupService) NewListBackupOfferingsParams() (r0 *cloudstack.ListBackupOfferingsParams) {
+	m.record("NewListBackupOfferingsParams")
+	if m.NewListBackupOfferingsParamsFunc != nil {
+		return m.NewListBackupOfferingsParamsFunc()
+	}
+	return cloudstack.NewBackupService(nil).NewListBackupOfferingsParams()
+}
+
+// GetBackupOfferingID records the call and calls GetBackupOfferingIDFunc, or GetBackupOfferingIDWithContextFunc if only that is set
+func (m *MockBackupService) GetBackupOfferingID(keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetBackupOfferingID", keyword, opts)
+	if m.GetBackupOfferingIDFunc != nil {
+		return m.GetBackupOfferingIDFunc(keyword, opts...)
+	}
+	if m.GetBackupOfferingIDWithContextFunc != nil {
+		return m.GetBackupOfferingIDWithContextFunc(context.Background(), keyword, opts...)
+	}
+	r2 = notMocked("BackupService.GetBackupOfferingID")
+	return
+}
+
+// GetBackupOfferingIDWithContext records the call and calls GetBackupOfferingIDWithContextFunc, or GetBackupOfferingIDFunc if only that is set
+func (m *MockBackupService) GetBackupOfferingIDWithContext(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetBackupOfferingIDWithContext", ctx, keyword, opts)
+	if m.GetBackupOfferingIDWithContextFunc != nil {
+		return m.GetBackupOfferingIDWithContextFunc(ctx, keyword, opts...)
+	}
+	if m.GetBackupOfferingIDFunc != nil {
+		return m.GetBackupOfferingIDFunc(keyword, opts...)
+	}
+	r2 = notMocked("BackupService.GetBackupOfferingIDWithContext")
+	return
+}
+
+// GetBackupOfferingByName records the call and calls GetBackupOfferingByNameFunc, or GetBackupOfferingByNameWithContextFunc if only that is set
+func (m *MockBackupService) GetBackupOfferingByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.BackupOffering, r1 int, r2 error) {
+	m.record("GetBackupOfferingByName", name, opts)
+	if m.GetBackupOfferingByNameFunc != nil {
+		return m.GetBackupOfferingByNameFunc(name, opts...)
+	}
+	if m.GetBackupOfferingByNameWithContextFunc != nil {
+		return m.GetBackupOfferingByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("BackupService.GetBackupOfferingByName")
+	return
+}
+
+// GetBackupOfferingByNameWithContext records the call and calls GetBackupOfferingByNameWithContextFunc, or GetBackupOfferingByNameFunc if only that is set
+func (m *MockBackupService) GetBackupOfferingByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.BackupOffering, r1 int, r2 error) {
+	m.record("GetBackupOfferingByNameWithContext", ctx, name, opts)
+	if m.GetBackupOfferingByNameWithContextFunc != nil {
+		return m.GetBackupOfferingByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetBackupOfferingByNameFunc != nil {
+		return m.GetBackupOfferingByNameFunc(name, opts...)
+	}
+	r2 = notMocked("BackupService.GetBackupOfferingByNameWithContext")
+	return
+}
+
+// GetBackupOfferingByID records the call and calls GetBackupOfferingByIDFunc, or GetBackupOfferingByIDWithContextFunc if only that is set
+func (m *MockBackupService) GetBackupOfferingByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.BackupOffering, r1 int, r2 error) {
+	m.record("GetBackupOfferingByID", id, opts)
+	if m.GetBackupOfferingByIDFunc != nil {
+		return m.GetBackupOfferingByIDFunc(id, opts...)
+	}
+	if m.GetBackupOfferingByIDWithContextFunc != nil {
+		return m.GetBackupOfferingByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("BackupService.GetBackupOfferingByID")
+	return
+}
+
+// GetBackupOfferingByIDWithContext records the call and calls GetBackupOfferingByIDWithContextFunc, or GetBackupOfferingByIDFunc if only that is set
+func (m *MockBackupService) GetBackupOfferingByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.BackupOffering, r1 int, r2 error) {
+	m.record("GetBackupOfferingByIDWithContext", ctx, id, opts)
+	if m.GetBackupOfferingByIDWithContextFunc != nil {
+		return m.GetBackupOfferingByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetBackupOfferingByIDFunc != nil {
+		return m.GetBackupOfferingByIDFunc(id, opts...)
+	}
+	r2 = notMocked("BackupService.GetBackupOfferingByIDWithContext")
+	return
+}
+
+// ListBackupOfferings records the call and calls ListBackupOfferingsFunc, or ListBackupOfferingsWithContextFunc if only that is set
+func (m *MockBackupService) ListBackupOfferings(p *cloudstack.ListBackupOfferingsParams) (r0 *cloudstack.ListBackupOfferingsResponse, r1 error) {
+	m.record("ListBackupOfferings", p)
+	if m.ListBackupOfferingsFunc != nil {
+		return m.ListBackupOfferingsFunc(p)
+	}
+	if m.ListBackupOfferingsWithContextFunc != nil {
+		return m.ListBackupOfferingsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.ListBackupOfferings")
+	return
+}
+
+// ListBackupOfferingsWithContext records the call and calls ListBackupOfferingsWithContextFunc, or ListBackupOfferingsFunc if only that is set
+func (m *MockBackupService) ListBackupOfferingsWithContext(ctx context.Context, p *cloudstack.ListBackupOfferingsParams) (r0 *cloudstack.ListBackupOfferingsResponse, r1 error) {
+	m.record("ListBackupOfferingsWithContext", ctx, p)
+	if m.ListBackupOfferingsWithContextFunc != nil {
+		return m.ListBackupOfferingsWithContextFunc(ctx, p)
+	}
+	if m.ListBackupOfferingsFunc != nil {
+		return m.ListBackupOfferingsFunc(p)
+	}
+	r1 = notMocked("BackupService.ListBackupOfferingsWithContext")
+	return
+}
+
+// NewListBackupOfferingsPager records the call and calls NewListBackupOfferingsPagerFunc
+func (m *MockBackupService) NewListBackupOfferingsPager(p *cloudstack.ListBackupOfferingsParams) (r0 *cloudstack.ListBackupOfferingsPager) {
+	m.record("NewListBackupOfferingsPager", p)
+	if m.NewListBackupOfferingsPagerFunc != nil {
+		return m.NewListBackupOfferingsPagerFunc(p)
+	}
+	return
+}
+
+// ListBackupOfferingsAll records the call and calls ListBackupOfferingsAllFunc, or ListBackupOfferingsAllWithContextFunc if only that is set
+func (m *MockBackupService) ListBackupOfferingsAll(p *cloudstack.ListBackupOfferingsParams) (r0 *cloudstack.ListBackupOfferingsResponse, r1 error) {
+	m.record("ListBackupOfferingsAll", p)
+	if m.ListBackupOfferingsAllFunc != nil {
+		return m.ListBackupOfferingsAllFunc(p)
+	}
+	if m.ListBackupOfferingsAllWithContextFunc != nil {
+		return m.ListBackupOfferingsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.ListBackupOfferingsAll")
+	return
+}
+
+// ListBackupOfferingsAllWithContext records the call and calls ListBackupOfferingsAllWithContextFunc, or ListBackupOfferingsAllFunc if only that is set
+func (m *MockBackupService) ListBackupOfferingsAllWithContext(ctx context.Context, p *cloudstack.ListBackupOfferingsParams) (r0 *cloudstack.ListBackupOfferingsResponse, r1 error) {
+	m.record("ListBackupOfferingsAllWithContext", ctx, p)
+	if m.ListBackupOfferingsAllWithContextFunc != nil {
+		return m.ListBackupOfferingsAllWithContextFunc(ctx, p)
+	}
+	if m.ListBackupOfferingsAllFunc != nil {
+		return m.ListBackupOfferingsAllFunc(p)
+	}
+	r1 = notMocked("BackupService.ListBackupOfferingsAllWithContext")
+	return
+}
+
+// NewListBackupProviderOfferingsParams records the call and calls NewListBackupProviderOfferingsParamsFunc
+func (m *MockBackupService) NewListBackupProviderOfferingsParams(zoneid string) (r0 *cloudstack.ListBackupProviderOfferingsParams) {
+	m.record("NewListBackupProviderOfferingsParams", zoneid)
+	if m.NewListBackupProviderOfferingsParamsFunc != nil {
+		return m.NewListBackupProviderOfferingsParamsFunc(zoneid)
+	}
+	return cloudstack.NewBackupService(nil).NewListBackupProviderOfferingsParams(zoneid)
+}
+
+// GetBackupProviderOfferingID records the call and calls GetBackupProviderOfferingIDFunc, or GetBackupProviderOfferingIDWithContextFunc if only that is set
+func (m *MockBackupService) GetBackupProviderOfferingID(keyword string, zoneid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetBackupProviderOfferingID", keyword, zoneid, opts)
+	if m.GetBackupProviderOfferingIDFunc != nil {
+		return m.GetBackupProviderOfferingIDFunc(keyword, zoneid, opts...)
+	}
+	if m.GetBackupProviderOfferingIDWithContextFunc != nil {
+		return m.GetBackupProviderOfferingIDWithContextFunc(context.Background(), keyword, zoneid, opts...)
+	}
+	r2 = notMocked("BackupService.GetBackupProviderOfferingID")
+	return
+}
+
+// GetBackupProviderOfferingIDWithContext records the call and calls GetBackupProviderOfferingIDWithContextFunc, or GetBackupProviderOfferingIDFunc if only that is set
+func (m *MockBackupService) GetBackupProviderOfferingIDWithContext(ctx context.Context, keyword string, zoneid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetBackupProviderOfferingIDWithContext", ctx, keyword, zoneid, opts)
+	if m.GetBackupProviderOfferingIDWithContextFunc != nil {
+		return m.GetBackupProviderOfferingIDWithContextFunc(ctx, keyword, zoneid, opts...)
+	}
+	if m.GetBackupProviderOfferingIDFunc != nil {
+		return m.GetBackupProviderOfferingIDFunc(keyword, zoneid, opts...)
+	}
+	r2 = notMocked("BackupService.GetBackupProviderOfferingIDWithContext")
+	return
+}
+
+// ListBackupProviderOfferings records the call and calls ListBackupProviderOfferingsFunc, or ListBackupProviderOfferingsWithContextFunc if only that is set
+func (m *MockBackupService) ListBackupProviderOfferings(p *cloudstack.ListBackupProviderOfferingsParams) (r0 *cloudstack.ListBackupProviderOfferingsResponse, r1 error) {
+	m.record("ListBackupProviderOfferings", p)
+	if m.ListBackupProviderOfferingsFunc != nil {
+		return m.ListBackupProviderOfferingsFunc(p)
+	}
+	if m.ListBackupProviderOfferingsWithContextFunc != nil {
+		return m.ListBackupProviderOfferingsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.ListBackupProviderOfferings")
+	return
+}
+
+// ListBackupProviderOfferingsWithContext records the call and calls ListBackupProviderOfferingsWithContextFunc, or ListBackupProviderOfferingsFunc if only that is set
+func (m *MockBackupService) ListBackupProviderOfferingsWithContext(ctx context.Context, p *cloudstack.ListBackupProviderOfferingsParams) (r0 *cloudstack.ListBackupProviderOfferingsResponse, r1 error) {
+	m.record("ListBackupProviderOfferingsWithContext", ctx, p)
+	if m.ListBackupProviderOfferingsWithContextFunc != nil {
+		return m.ListBackupProviderOfferingsWithContextFunc(ctx, p)
+	}
+	if m.ListBackupProviderOfferingsFunc != nil {
+		return m.ListBackupProviderOfferingsFunc(p)
+	}
+	r1 = notMocked("BackupService.ListBackupProviderOfferingsWithContext")
+	return
+}
+
+// NewListBackupProviderOfferingsPager records the call and calls NewListBackupProviderOfferingsPagerFunc
+func (m *MockBackupService) NewListBackupProviderOfferingsPager(p *cloudstack.ListBackupProviderOfferingsParams) (r0 *cloudstack.ListBackupProviderOfferingsPager) {
+	m.record("NewListBackupProviderOfferingsPager", p)
+	if m.NewListBackupProviderOfferingsPagerFunc != nil {
+		return m.NewListBackupProviderOfferingsPagerFunc(p)
+	}
+	return
+}
+
+// ListBackupProviderOfferingsAll records the call and calls ListBackupProviderOfferingsAllFunc, or ListBackupProviderOfferingsAllWithContextFunc if only that is set
+func (m *MockBackupService) ListBackupProviderOfferingsAll(p *cloudstack.ListBackupProviderOfferingsParams) (r0 *cloudstack.ListBackupProviderOfferingsResponse, r1 error) {
+	m.record("ListBackupProviderOfferingsAll", p)
+	if m.ListBackupProviderOfferingsAllFunc != nil {
+		return m.ListBackupProviderOfferingsAllFunc(p)
+	}
+	if m.ListBackupProviderOfferingsAllWithContextFunc != nil {
+		return m.ListBackupProviderOfferingsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.ListBackupProviderOfferingsAll")
+	return
+}
+
+// ListBackupProviderOfferingsAllWithContext records the call and calls ListBackupProviderOfferingsAllWithContextFunc, or ListBackupProviderOfferingsAllFunc if only that is set
+func (m *MockBackupService) ListBackupProviderOfferingsAllWithContext(ctx context.Context, p *cloudstack.ListBackupProviderOfferingsParams) (r0 *cloudstack.ListBackupProviderOfferingsResponse, r1 error) {
+	m.record("ListBackupProviderOfferingsAllWithContext", ctx, p)
+	if m.ListBackupProviderOfferingsAllWithContextFunc != nil {
+		return m.ListBackupProviderOfferingsAllWithContextFunc(ctx, p)
+	}
+	if m.ListBackupProviderOfferingsAllFunc != nil {
+		return m.ListBackupProviderOfferingsAllFunc(p)
+	}
+	r1 = notMocked("BackupService.ListBackupProviderOfferingsAllWithContext")
+	return
+}
+
+// NewListBackupProvidersParams records the call and calls NewListBackupProvidersParamsFunc
+func (m *MockBackupService) NewListBackupProvidersParams() (r0 *cloudstack.ListBackupProvidersParams) {
+	m.record("NewListBackupProvidersParams")
+	if m.NewListBackupProvidersParamsFunc != nil {
+		return m.NewListBackupProvidersParamsFunc()
+	}
+	return cloudstack.NewBackupService(nil).NewListBackupProvidersParams()
+}
+
+// ListBackupProviders records the call and calls ListBackupProvidersFunc, or ListBackupProvidersWithContextFunc if only that is set
+func (m *MockBackupService) ListBackupProviders(p *cloudstack.ListBackupProvidersParams) (r0 *cloudstack.ListBackupProvidersResponse, r1 error) {
+	m.record("ListBackupProviders", p)
+	if m.ListBackupProvidersFunc != nil {
+		return m.ListBackupProvidersFunc(p)
+	}
+	if m.ListBackupProvidersWithContextFunc != nil {
+		return m.ListBackupProvidersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.ListBackupProviders")
+	return
+}
+
+// ListBackupProvidersWithContext records the call and calls ListBackupProvidersWithContextFunc, or ListBackupProvidersFunc if only that is set
+func (m *MockBackupService) ListBackupProvidersWithContext(ctx context.Context, p *cloudstack.ListBackupProvidersParams) (r0 *cloudstack.ListBackupProvidersResponse, r1 error) {
+	m.record("ListBackupProvidersWithContext", ctx, p)
+	if m.ListBackupProvidersWithContextFunc != nil {
+		return m.ListBackupProvidersWithContextFunc(ctx, p)
+	}
+	if m.ListBackupProvidersFunc != nil {
+		return m.ListBackupProvidersFunc(p)
+	}
+	r1 = notMocked("BackupService.ListBackupProvidersWithContext")
+	return
+}
+
+// NewListBackupScheduleParams records the call and calls NewListBackupScheduleParamsFunc
+func (m *MockBackupService) NewListBackupScheduleParams(virtualmachineid string) (r0 *cloudstack.ListBackupScheduleParams) {
+	m.record("NewListBackupScheduleParams", virtualmachineid)
+	if m.NewListBackupScheduleParamsFunc != nil {
+		return m.NewListBackupScheduleParamsFunc(virtualmachineid)
+	}
+	return cloudstack.NewBackupService(nil).NewListBackupScheduleParams(virtualmachineid)
+}
+
+// ListBackupSchedule records the call and calls ListBackupScheduleFunc, or ListBackupScheduleWithContextFunc if only that is set
+func (m *MockBackupService) ListBackupSchedule(p *cloudstack.ListBackupScheduleParams) (r0 *cloudstack.ListBackupScheduleResponse, r1 error) {
+	m.record("ListBackupSchedule", p)
+	if m.ListBackupScheduleFunc != nil {
+		return m.ListBackupScheduleFunc(p)
+	}
+	if m.ListBackupScheduleWithContextFunc != nil {
+		return m.ListBackupScheduleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.ListBackupSchedule")
+	return
+}
+
+// ListBackupScheduleWithContext records the call and calls ListBackupScheduleWithContextFunc, or ListBackupScheduleFunc if only that is set
+func (m *MockBackupService) ListBackupScheduleWithContext(ctx context.Context, p *cloudstack.ListBackupScheduleParams) (r0 *cloudstack.ListBackupScheduleResponse, r1 error) {
+	m.record("ListBackupScheduleWithContext", ctx, p)
+	if m.ListBackupScheduleWithContextFunc != nil {
+		return m.ListBackupScheduleWithContextFunc(ctx, p)
+	}
+	if m.ListBackupScheduleFunc != nil {
+		return m.ListBackupScheduleFunc(p)
+	}
+	r1 = notMocked("BackupService.ListBackupScheduleWithContext")
+	return
+}
+
+// NewListBackupsParams records the call and calls NewListBackupsParamsFunc
+func (m *MockBackupService) NewListBackupsParams() (r0 *cloudstack.ListBackupsParams) {
+	m.record("NewListBackupsParams")
+	if m.NewListBackupsParamsFunc != nil {
+		return m.NewListBackupsParamsFunc()
+	}
+	return cloudstack.NewBackupService(nil).NewListBackupsParams()
+}
+
+// GetBackupByID records the call and calls GetBackupByIDFunc, or GetBackupByIDWithContextFunc if only that is set
+func (m *MockBackupService) GetBackupByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Backup, r1 int, r2 error) {
+	m.record("GetBackupByID", id, opts)
+	if m.GetBackupByIDFunc != nil {
+		return m.GetBackupByIDFunc(id, opts...)
+	}
+	if m.GetBackupByIDWithContextFunc != nil {
+		return m.GetBackupByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("BackupService.GetBackupByID")
+	return
+}
+
+// GetBackupByIDWithContext records the call and calls GetBackupByIDWithContextFunc, or GetBackupByIDFunc if only that is set
+func (m *MockBackupService) GetBackupByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Backup, r1 int, r2 error) {
+	m.record("GetBackupByIDWithContext", ctx, id, opts)
+	if m.GetBackupByIDWithContextFunc != nil {
+		return m.GetBackupByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetBackupByIDFunc != nil {
+		return m.GetBackupByIDFunc(id, opts...)
+	}
+	r2 = notMocked("BackupService.GetBackupByIDWithContext")
+	return
+}
+
+// ListBackups records the call and calls ListBackupsFunc, or ListBackupsWithContextFunc if only that is set
+func (m *MockBackupService) ListBackups(p *cloudstack.ListBackupsParams) (r0 *cloudstack.ListBackupsResponse, r1 error) {
+	m.record("ListBackups", p)
+	if m.ListBackupsFunc != nil {
+		return m.ListBackupsFunc(p)
+	}
+	if m.ListBackupsWithContextFunc != nil {
+		return m.ListBackupsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.ListBackups")
+	return
+}
+
+// ListBackupsWithContext records the call and calls ListBackupsWithContextFunc, or ListBackupsFunc if only that is set
+func (m *MockBackupService) ListBackupsWithContext(ctx context.Context, p *cloudstack.ListBackupsParams) (r0 *cloudstack.ListBackupsResponse, r1 error) {
+	m.record("ListBackupsWithContext", ctx, p)
+	if m.ListBackupsWithContextFunc != nil {
+		return m.ListBackupsWithContextFunc(ctx, p)
+	}
+	if m.ListBackupsFunc != nil {
+		return m.ListBackupsFunc(p)
+	}
+	r1 = notMocked("BackupService.ListBackupsWithContext")
+	return
+}
+
+// NewListBackupsPager records the call and calls NewListBackupsPagerFunc
+func (m *MockBackupService) NewListBackupsPager(p *cloudstack.ListBackupsParams) (r0 *cloudstack.ListBackupsPager) {
+	m.record("NewListBackupsPager", p)
+	if m.NewListBackupsPagerFunc != nil {
+		return m.NewListBackupsPagerFunc(p)
+	}
+	return
+}
+
+// ListBackupsAll records the call and calls ListBackupsAllFunc, or ListBackupsAllWithContextFunc if only that is set
+func (m *MockBackupService) ListBackupsAll(p *cloudstack.ListBackupsParams) (r0 *cloudstack.ListBackupsResponse, r1 error) {
+	m.record("ListBackupsAll", p)
+	if m.ListBackupsAllFunc != nil {
+		return m.ListBackupsAllFunc(p)
+	}
+	if m.ListBackupsAllWithContextFunc != nil {
+		return m.ListBackupsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.ListBackupsAll")
+	return
+}
+
+// ListBackupsAllWithContext records the call and calls ListBackupsAllWithContextFunc, or ListBackupsAllFunc if only that is set
+func (m *MockBackupService) ListBackupsAllWithContext(ctx context.Context, p *cloudstack.ListBackupsParams) (r0 *cloudstack.ListBackupsResponse, r1 error) {
+	m.record("ListBackupsAllWithContext", ctx, p)
+	if m.ListBackupsAllWithContextFunc != nil {
+		return m.ListBackupsAllWithContextFunc(ctx, p)
+	}
+	if m.ListBackupsAllFunc != nil {
+		return m.ListBackupsAllFunc(p)
+	}
+	r1 = notMocked("BackupService.ListBackupsAllWithContext")
+	return
+}
+
+// NewRemoveVirtualMachineFromBackupOfferingParams records the call and calls NewRemoveVirtualMachineFromBackupOfferingParamsFunc
+func (m *MockBackupService) NewRemoveVirtualMachineFromBackupOfferingParams(virtualmachineid string) (r0 *cloudstack.RemoveVirtualMachineFromBackupOfferingParams) {
+	m.record("NewRemoveVirtualMachineFromBackupOfferingParams", virtualmachineid)
+	if m.NewRemoveVirtualMachineFromBackupOfferingParamsFunc != nil {
+		return m.NewRemoveVirtualMachineFromBackupOfferingParamsFunc(virtualmachineid)
+	}
+	return cloudstack.NewBackupService(nil).NewRemoveVirtualMachineFromBackupOfferingParams(virtualmachineid)
+}
+
+// RemoveVirtualMachineFromBackupOffering records the call and calls RemoveVirtualMachineFromBackupOfferingFunc, or RemoveVirtualMachineFromBackupOfferingWithContextFunc if only that is set
+func (m *MockBackupService) RemoveVirtualMachineFromBackupOffering(p *cloudstack.RemoveVirtualMachineFromBackupOfferingParams) (r0 *cloudstack.RemoveVirtualMachineFromBackupOfferingResponse, r1 error) {
+	m.record("RemoveVirtualMachineFromBackupOffering", p)
+	if m.RemoveVirtualMachineFromBackupOfferingFunc != nil {
+		return m.RemoveVirtualMachineFromBackupOfferingFunc(p)
+	}
+	if m.RemoveVirtualMachineFromBackupOfferingWithContextFunc != nil {
+		return m.RemoveVirtualMachineFromBackupOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.RemoveVirtualMachineFromBackupOffering")
+	return
+}
+
+// RemoveVirtualMachineFromBackupOfferingWithContext records the call and calls RemoveVirtualMachineFromBackupOfferingWithContextFunc, or RemoveVirtualMachineFromBackupOfferingFunc if only that is set
+func (m *MockBackupService) RemoveVirtualMachineFromBackupOfferingWithContext(ctx context.Context, p *cloudstack.RemoveVirtualMachineFromBackupOfferingParams) (r0 *cloudstack.RemoveVirtualMachineFromBackupOfferingResponse, r1 error) {
+	m.record("RemoveVirtualMachineFromBackupOfferingWithContext", ctx, p)
+	if m.RemoveVirtualMachineFromBackupOfferingWithContextFunc != nil {
+		return m.RemoveVirtualMachineFromBackupOfferingWithContextFunc(ctx, p)
+	}
+	if m.RemoveVirtualMachineFromBackupOfferingFunc != nil {
+		return m.RemoveVirtualMachineFromBackupOfferingFunc(p)
+	}
+	r1 = notMocked("BackupService.RemoveVirtualMachineFromBackupOfferingWithContext")
+	return
+}
+
+// RemoveVirtualMachineFromBackupOfferingAsync records the call and calls RemoveVirtualMachineFromBackupOfferingAsyncFunc, or RemoveVirtualMachineFromBackupOfferingAsyncWithContextFunc if only that is set
+func (m *MockBackupService) RemoveVirtualMachineFromBackupOfferingAsync(p *cloudstack.RemoveVirtualMachineFromBackupOfferingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveVirtualMachineFromBackupOfferingAsync", p)
+	if m.RemoveVirtualMachineFromBackupOfferingAsyncFunc != nil {
+		return m.RemoveVirtualMachineFromBackupOfferingAsyncFunc(p)
+	}
+	if m.RemoveVirtualMachineFromBackupOfferingAsyncWithContextFunc != nil {
+		return m.RemoveVirtualMachineFromBackupOfferingAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.RemoveVirtualMachineFromBackupOfferingAsync")
+	return
+}
+
+// RemoveVirtualMachineFromBackupOfferingAsyncWithContext records the call and calls RemoveVirtualMachineFromBackupOfferingAsyncWithContextFunc, or RemoveVirtualMachineFromBackupOfferingAsyncFunc if only that is set
+func (m *MockBackupService) RemoveVirtualMachineFromBackupOfferingAsyncWithContext(ctx context.Context, p *cloudstack.RemoveVirtualMachineFromBackupOfferingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveVirtualMachineFromBackupOfferingAsyncWithContext", ctx, p)
+	if m.RemoveVirtualMachineFromBackupOfferingAsyncWithContextFunc != nil {
+		return m.RemoveVirtualMachineFromBackupOfferingAsyncWithContextFunc(ctx, p)
+	}
+	if m.RemoveVirtualMachineFromBackupOfferingAsyncFunc != nil {
+		return m.RemoveVirtualMachineFromBackupOfferingAsyncFunc(p)
+	}
+	r1 = notMocked("BackupService.RemoveVirtualMachineFromBackupOfferingAsyncWithContext")
+	return
+}
+
+// NewRestoreBackupParams records the call and calls NewRestoreBackupParamsFunc
+func (m *MockBackupService) NewRestoreBackupParams(id string) (r0 *cloudstack.RestoreBackupParams) {
+	m.record("NewRestoreBackupParams", id)
+	if m.NewRestoreBackupParamsFunc != nil {
+		return m.NewRestoreBackupParamsFunc(id)
+	}
+	return cloudstack.NewBackupService(nil).NewRestoreBackupParams(id)
+}
+
+// RestoreBackup records the call and calls RestoreBackupFunc, or RestoreBackupWithContextFunc if only that is set
+func (m *MockBackupService) RestoreBackup(p *cloudstack.RestoreBackupParams) (r0 *cloudstack.RestoreBackupResponse, r1 error) {
+	m.record("RestoreBackup", p)
+	if m.RestoreBackupFunc != nil {
+		return m.RestoreBackupFunc(p)
+	}
+	if m.RestoreBackupWithContextFunc != nil {
+		return m.RestoreBackupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.RestoreBackup")
+	return
+}
+
+// RestoreBackupWithContext records the call and calls RestoreBackupWithContextFunc, or RestoreBackupFunc if only that is set
+func (m *MockBackupService) RestoreBackupWithContext(ctx context.Context, p *cloudstack.RestoreBackupParams) (r0 *cloudstack.RestoreBackupResponse, r1 error) {
+	m.record("RestoreBackupWithContext", ctx, p)
+	if m.RestoreBackupWithContextFunc != nil {
+		return m.RestoreBackupWithContextFunc(ctx, p)
+	}
+	if m.RestoreBackupFunc != nil {
+		return m.RestoreBackupFunc(p)
+	}
+	r1 = notMocked("BackupService.RestoreBackupWithContext")
+	return
+}
+
+// RestoreBackupAsync records the call and calls RestoreBackupAsyncFunc, or RestoreBackupAsyncWithContextFunc if only that is set
+func (m *MockBackupService) RestoreBackupAsync(p *cloudstack.RestoreBackupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RestoreBackupAsync", p)
+	if m.RestoreBackupAsyncFunc != nil {
+		return m.RestoreBackupAsyncFunc(p)
+	}
+	if m.RestoreBackupAsyncWithContextFunc != nil {
+		return m.RestoreBackupAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.RestoreBackupAsync")
+	return
+}
+
+// RestoreBackupAsyncWithContext records the call and calls RestoreBackupAsyncWithContextFunc, or RestoreBackupAsyncFunc if only that is set
+func (m *MockBackupService) RestoreBackupAsyncWithContext(ctx context.Context, p *cloudstack.RestoreBackupParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RestoreBackupAsyncWithContext", ctx, p)
+	if m.RestoreBackupAsyncWithContextFunc != nil {
+		return m.RestoreBackupAsyncWithContextFunc(ctx, p)
+	}
+	if m.RestoreBackupAsyncFunc != nil {
+		return m.RestoreBackupAsyncFunc(p)
+	}
+	r1 = notMocked("BackupService.RestoreBackupAsyncWithContext")
+	return
+}
+
+// NewRestoreVolumeFromBackupAndAttachToVMParams records the call and calls NewRestoreVolumeFromBackupAndAttachToVMParamsFunc
+func (m *MockBackupService) NewRestoreVolumeFromBackupAndAttachToVMParams(backupid string, virtualmachineid string, volumeid string) (r0 *cloudstack.RestoreVolumeFromBackupAndAttachToVMParams) {
+	m.record("NewRestoreVolumeFromBackupAndAttachToVMParams", backupid, virtualmachineid, volumeid)
+	if m.NewRestoreVolumeFromBackupAndAttachToVMParamsFunc != nil {
+		return m.NewRestoreVolumeFromBackupAndAttachToVMParamsFunc(backupid, virtualmachineid, volumeid)
+	}
+	return cloudstack.NewBackupService(nil).NewRestoreVolumeFromBackupAndAttachToVMParams(backupid, virtualmachineid, volumeid)
+}
+
+// RestoreVolumeFromBackupAndAttachToVM records the call and calls RestoreVolumeFromBackupAndAttachToVMFunc, or RestoreVolumeFromBackupAndAttachToVMWithContextFunc if only that is set
+func (m *MockBackupService) RestoreVolumeFromBackupAndAttachToVM(p *cloudstack.RestoreVolumeFromBackupAndAttachToVMParams) (r0 *cloudstack.RestoreVolumeFromBackupAndAttachToVMResponse, r1 error) {
+	m.record("RestoreVolumeFromBackupAndAttachToVM", p)
+	if m.RestoreVolumeFromBackupAndAttachToVMFunc != nil {
+		return m.RestoreVolumeFromBackupAndAttachToVMFunc(p)
+	}
+	if m.RestoreVolumeFromBackupAndAttachToVMWithContextFunc != nil {
+		return m.RestoreVolumeFromBackupAndAttachToVMWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.RestoreVolumeFromBackupAndAttachToVM")
+	return
+}
+
+// RestoreVolumeFromBackupAndAttachToVMWithContext records the call and calls RestoreVolumeFromBackupAndAttachToVMWithContextFunc, or RestoreVolumeFromBackupAndAttachToVMFunc if only that is set
+func (m *MockBackupService) RestoreVolumeFromBackupAndAttachToVMWithContext(ctx context.Context, p *cloudstack.RestoreVolumeFromBackupAndAttachToVMParams) (r0 *cloudstack.RestoreVolumeFromBackupAndAttachToVMResponse, r1 error) {
+	m.record("RestoreVolumeFromBackupAndAttachToVMWithContext", ctx, p)
+	if m.RestoreVolumeFromBackupAndAttachToVMWithContextFunc != nil {
+		return m.RestoreVolumeFromBackupAndAttachToVMWithContextFunc(ctx, p)
+	}
+	if m.RestoreVolumeFromBackupAndAttachToVMFunc != nil {
+		return m.RestoreVolumeFromBackupAndAttachToVMFunc(p)
+	}
+	r1 = notMocked("BackupService.RestoreVolumeFromBackupAndAttachToVMWithContext")
+	return
+}
+
+// RestoreVolumeFromBackupAndAttachToVMAsync records the call and calls RestoreVolumeFromBackupAndAttachToVMAsyncFunc, or RestoreVolumeFromBackupAndAttachToVMAsyncWithContextFunc if only that is set
+func (m *MockBackupService) RestoreVolumeFromBackupAndAttachToVMAsync(p *cloudstack.RestoreVolumeFromBackupAndAttachToVMParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RestoreVolumeFromBackupAndAttachToVMAsync", p)
+	if m.RestoreVolumeFromBackupAndAttachToVMAsyncFunc != nil {
+		return m.RestoreVolumeFromBackupAndAttachToVMAsyncFunc(p)
+	}
+	if m.RestoreVolumeFromBackupAndAttachToVMAsyncWithContextFunc != nil {
+		return m.RestoreVolumeFromBackupAndAttachToVMAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.RestoreVolumeFromBackupAndAttachToVMAsync")
+	return
+}
+
+// RestoreVolumeFromBackupAndAttachToVMAsyncWithContext records the call and calls RestoreVolumeFromBackupAndAttachToVMAsyncWithContextFunc, or RestoreVolumeFromBackupAndAttachToVMAsyncFunc if only that is set
+func (m *MockBackupService) RestoreVolumeFromBackupAndAttachToVMAsyncWithContext(ctx context.Context, p *cloudstack.RestoreVolumeFromBackupAndAttachToVMParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RestoreVolumeFromBackupAndAttachToVMAsyncWithContext", ctx, p)
+	if m.RestoreVolumeFromBackupAndAttachToVMAsyncWithContextFunc != nil {
+		return m.RestoreVolumeFromBackupAndAttachToVMAsyncWithContextFunc(ctx, p)
+	}
+	if m.RestoreVolumeFromBackupAndAttachToVMAsyncFunc != nil {
+		return m.RestoreVolumeFromBackupAndAttachToVMAsyncFunc(p)
+	}
+	r1 = notMocked("BackupService.RestoreVolumeFromBackupAndAttachToVMAsyncWithContext")
+	return
+}
+
+// NewUpdateBackupScheduleParams records the call and calls NewUpdateBackupScheduleParamsFunc
+func (m *MockBackupService) NewUpdateBackupScheduleParams(intervaltype string, schedule string, timezone string, virtualmachineid string) (r0 *cloudstack.UpdateBackupScheduleParams) {
+	m.record("NewUpdateBackupScheduleParams", intervaltype, schedule, timezone, virtualmachineid)
+	if m.NewUpdateBackupScheduleParamsFunc != nil {
+		return m.NewUpdateBackupScheduleParamsFunc(intervaltype, schedule, timezone, virtualmachineid)
+	}
+	return cloudstack.NewBackupService(nil).NewUpdateBackupScheduleParams(intervaltype, schedule, timezone, virtualmachineid)
+}
+
+// UpdateBackupSchedule records the call and calls UpdateBackupScheduleFunc, or UpdateBackupScheduleWithContextFunc if only that is set
+func (m *MockBackupService) UpdateBackupSchedule(p *cloudstack.UpdateBackupScheduleParams) (r0 *cloudstack.UpdateBackupScheduleResponse, r1 error) {
+	m.record("UpdateBackupSchedule", p)
+	if m.UpdateBackupScheduleFunc != nil {
+		return m.UpdateBackupScheduleFunc(p)
+	}
+	if m.UpdateBackupScheduleWithContextFunc != nil {
+		return m.UpdateBackupScheduleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BackupService.UpdateBackupSchedule")
+	return
+}
+
+// UpdateBackupScheduleWithContext records the call and calls UpdateBackupScheduleWithContextFunc, or UpdateBackupScheduleFunc if only that is set
+func (m *MockBackupService) UpdateBackupScheduleWithContext(ctx context.Context, p *cloudstack.UpdateBackupScheduleParams) (r0 *cloudstack.UpdateBackupScheduleResponse, r1 error) {
+	m.record("UpdateBackupScheduleWithContext", ctx, p)
+	if m.UpdateBackupScheduleWithContextFunc != nil {
+		return m.UpdateBackupScheduleWithContextFunc(ctx, p)
+	}
+	if m.UpdateBackupScheduleFunc != nil {
+		return m.UpdateBackupScheduleFunc(p)
+	}
+	r1 = notMocked("BackupService.UpdateBackupScheduleWithContext")
+	return
+}
@@ -0,0 +1,663 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockBaremetalService is a mock of cloudstack.BaremetalServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockBaremetalService struct {
+	Recorder
+
+	NewAddBaremetalDhcpParamsFunc                      func(dhcpservertype string, password string, physicalnetworkid string, url string, username string) *cloudstack.AddBaremetalDhcpParams
+	AddBaremetalDhcpFunc                               func(p *cloudstack.AddBaremetalDhcpParams) (*cloudstack.AddBaremetalDhcpResponse, error)
+	AddBaremetalDhcpWithContextFunc                    func(ctx context.Context, p *cloudstack.AddBaremetalDhcpParams) (*cloudstack.AddBaremetalDhcpResponse, error)
+	AddBaremetalDhcpAsyncFunc                          func(p *cloudstack.AddBaremetalDhcpParams) (*cloudstack.Job, error)
+	AddBaremetalDhcpAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.AddBaremetalDhcpParams) (*cloudstack.Job, error)
+	NewAddBaremetalPxeKickStartServerParamsFunc        func(password string, physicalnetworkid string, pxeservertype string, tftpdir string, url string, username string) *cloudstack.AddBaremetalPxeKickStartServerParams
+	AddBaremetalPxeKickStartServerFunc                 func(p *cloudstack.AddBaremetalPxeKickStartServerParams) (*cloudstack.AddBaremetalPxeKickStartServerResponse, error)
+	AddBaremetalPxeKickStartServerWithContextFunc      func(ctx context.Context, p *cloudstack.AddBaremetalPxeKickStartServerParams) (*cloudstack.AddBaremetalPxeKickStartServerResponse, error)
+	AddBaremetalPxeKickStartServerAsyncFunc            func(p *cloudstack.AddBaremetalPxeKickStartServerParams) (*cloudstack.Job, error)
+	AddBaremetalPxeKickStartServerAsyncWithContextFunc func(ctx context.Context, p *cloudstack.AddBaremetalPxeKickStartServerParams) (*cloudstack.Job, error)
+	NewAddBaremetalPxePingServerParamsFunc             func(password string, physicalnetworkid string, pingdir string, pingstorageserverip string, pxeservertype string, tftpdir string, url string, username string) *cloudstack.AddBaremetalPxePingServerParams
+	AddBaremetalPxePingServerFunc                      func(p *cloudstack.AddBaremetalPxePingServerParams) (*cloudstack.AddBaremetalPxePingServerResponse, error)
+	AddBaremetalPxePingServerWithContextFunc           func(ctx context.Context, p *cloudstack.AddBaremetalPxePingServerParams) (*cloudstack.AddBaremetalPxePingServerResponse, error)
+	AddBaremetalPxePingServerAsyncFunc                 func(p *cloudstack.AddBaremetalPxePingServerParams) (*cloudstack.Job, error)
+	AddBaremetalPxePingServerAsyncWithContextFunc      func(ctx context.Context, p *cloudstack.AddBaremetalPxePingServerParams) (*cloudstack.Job, error)
+	NewAddBaremetalRctParamsFunc                       func(baremetalrcturl string) *cloudstack.AddBaremetalRctParams
+	AddBaremetalRctFunc                                func(p *cloudstack.AddBaremetalRctParams) (*cloudstack.AddBaremetalRctResponse, error)
+	AddBaremetalRctWithContextFunc                     func(ctx context.Context, p *cloudstack.AddBaremetalRctParams) (*cloudstack.AddBaremetalRctResponse, error)
+	AddBaremetalRctAsyncFunc                           func(p *cloudstack.AddBaremetalRctParams) (*cloudstack.Job, error)
+	AddBaremetalRctAsyncWithContextFunc                func(ctx context.Context, p *cloudstack.AddBaremetalRctParams) (*cloudstack.Job, error)
+	NewDeleteBaremetalRctParamsFunc                    func(id string) *cloudstack.DeleteBaremetalRctParams
+	DeleteBaremetalRctFunc                             func(p *cloudstack.DeleteBaremetalRctParams) (*cloudstack.DeleteBaremetalRctResponse, error)
+	DeleteBaremetalRctWithContextFunc                  func(ctx context.Context, p *cloudstack.DeleteBaremetalRctParams) (*cloudstack.DeleteBaremetalRctResponse, error)
+	DeleteBaremetalRctAsyncFunc                        func(p *cloudstack.DeleteBaremetalRctParams) (*cloudstack.Job, error)
+	DeleteBaremetalRctAsyncWithContextFunc             func(ctx context.Context, p *cloudstack.DeleteBaremetalRctParams) (*cloudstack.Job, error)
+	NewListBaremetalDhcpParamsFunc                     func(physicalnetworkid string) *cloudstack.ListBaremetalDhcpParams
+	ListBaremetalDhcpFunc                              func(p *cloudstack.ListBaremetalDhcpParams) (*cloudstack.ListBaremetalDhcpResponse, error)
+	ListBaremetalDhcpWithContextFunc                   func(ctx context.Context, p *cloudstack.ListBaremetalDhcpParams) (*cloudstack.ListBaremetalDhcpResponse, error)
+	NewListBaremetalDhcpPagerFunc                      func(p *cloudstack.ListBaremetalDhcpParams) *cloudstack.ListBaremetalDhcpPager
+	ListBaremetalDhcpAllFunc                           func(p *cloudstack.ListBaremetalDhcpParams) (*cloudstack.ListBaremetalDhcpResponse, error)
+	ListBaremetalDhcpAllWithContextFunc                func(ctx context.Context, p *cloudstack.ListBaremetalDhcpParams) (*cloudstack.ListBaremetalDhcpResponse, error)
+	NewListBaremetalPxeServersParamsFunc               func(physicalnetworkid string) *cloudstack.ListBaremetalPxeServersParams
+	ListBaremetalPxeServersFunc                        func(p *cloudstack.ListBaremetalPxeServersParams) (*cloudstack.ListBaremetalPxeServersResponse, error)
+	ListBaremetalPxeServersWithContextFunc             func(ctx context.Context, p *cloudstack.ListBaremetalPxeServersParams) (*cloudstack.ListBaremetalPxeServersResponse, error)
+	NewListBaremetalPxeServersPagerFunc                func(p *cloudstack.ListBaremetalPxeServersParams) *cloudstack.ListBaremetalPxeServersPager
+	ListBaremetalPxeServersAllFunc                     func(p *cloudstack.ListBaremetalPxeServersParams) (*cloudstack.ListBaremetalPxeServersResponse, error)
+	ListBaremetalPxeServersAllWithContextFunc          func(ctx context.Context, p *cloudstack.ListBaremetalPxeServersParams) (*cloudstack.ListBaremetalPxeServersResponse, error)
+	NewListBaremetalRctParamsFunc                      func() *cloudstack.ListBaremetalRctParams
+	ListBaremetalRctFunc                               func(p *cloudstack.ListBaremetalRctParams) (*cloudstack.ListBaremetalRctResponse, error)
+	ListBaremetalRctWithContextFunc                    func(ctx context.Context, p *cloudstack.ListBaremetalRctParams) (*cloudstack.ListBaremetalRctResponse, error)
+	NewListBaremetalRctPagerFunc                       func(p *cloudstack.ListBaremetalRctParams) *cloudstack.ListBaremetalRctPager
+	ListBaremetalRctAllFunc                            func(p *cloudstack.ListBaremetalRctParams) (*cloudstack.ListBaremetalRctResponse, error)
+	ListBaremetalRctAllWithContextFunc                 func(ctx context.Context, p *cloudstack.ListBaremetalRctParams) (*cloudstack.ListBaremetalRctResponse, error)
+	NewNotifyBaremetalProvisionDoneParamsFunc          func(mac string) *cloudstack.NotifyBaremetalProvisionDoneParams
+	NotifyBaremetalProvisionDoneFunc                   func(p *cloudstack.NotifyBaremetalProvisionDoneParams) (*cloudstack.NotifyBaremetalProvisionDoneResponse, error)
+	NotifyBaremetalProvisionDoneWithContextFunc        func(ctx context.Context, p *cloudstack.NotifyBaremetalProvisionDoneParams) (*cloudstack.NotifyBaremetalProvisionDoneResponse, error)
+	NotifyBaremetalProvisionDoneAsyncFunc              func(p *cloudstack.NotifyBaremetalProvisionDoneParams) (*cloudstack.Job, error)
+	NotifyBaremetalProvisionDoneAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.NotifyBaremetalProvisionDoneParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.BaremetalServiceIface = (*MockBaremetalService)(nil)
+
+// NewAddBaremetalDhcpParams records the call and calls NewAddBaremetalDhcpParamsFunc
+func (m *MockBaremetalService) NewAddBaremetalDhcpParams(dhcpservertype string, password string, physicalnetworkid string, url string, username string) (r0 *cloudstack.AddBaremetalDhcpParams) {
+	m.record("NewAddBaremetalDhcpParams", dhcpservertype, password, physicalnetworkid, url, username)
+	if m.NewAddBaremetalDhcpParamsFunc != nil {
+		return m.NewAddBaremetalDhcpParamsFunc(dhcpservertype, password, physicalnetworkid, url, username)
+	}
+	return cloudstack.NewBaremetalService(nil).NewAddBaremetalDhcpParams(dhcpservertype, password, physicalnetworkid, url, username)
+}
+
+// AddBaremetalDhcp records the call and calls AddBaremetalDhcpFunc, or AddBaremetalDhcpWithContextFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalDhcp(p *cloudstack.AddBaremetalDhcpParams) (r0 *cloudstack.AddBaremetalDhcpResponse, r1 error) {
+	m.record("AddBaremetalDhcp", p)
+	if m.AddBaremetalDhcpFunc != nil {
+		return m.AddBaremetalDhcpFunc(p)
+	}
+	if m.AddBaremetalDhcpWithContextFunc != nil {
+		return m.AddBaremetalDhcpWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalDhcp")
+	return
+}
+
+// AddBaremetalDhcpWithContext records the call and calls AddBaremetalDhcpWithContextFunc, or AddBaremetalDhcpFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalDhcpWithContext(ctx context.Context, p *cloudstack.AddBaremetalDhcpParams) (r0 *cloudstack.AddBaremetalDhcpResponse, r1 error) {
+	m.record("AddBaremetalDhcpWithContext", ctx, p)
+	if m.AddBaremetalDhcpWithContextFunc != nil {
+		return m.AddBaremetalDhcpWithContextFunc(ctx, p)
+	}
+	if m.AddBaremetalDhcpFunc != nil {
+		return m.AddBaremetalDhcpFunc(p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalDhcpWithContext")
+	return
+}
+
+// AddBaremetalDhcpAsync records the call and calls AddBaremetalDhcpAsyncFunc, or AddBaremetalDhcpAsyncWithContextFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalDhcpAsync(p *cloudstack.AddBaremetalDhcpParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddBaremetalDhcpAsync", p)
+	if m.AddBaremetalDhcpAsyncFunc != nil {
+		return m.AddBaremetalDhcpAsyncFunc(p)
+	}
+	if m.AddBaremetalDhcpAsyncWithContextFunc != nil {
+		return m.AddBaremetalDhcpAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalDhcpAsync")
+	return
+}
+
+// AddBaremetalDhcpAsyncWithContext records the call and calls AddBaremetalDhcpAsyncWithContextFunc, or AddBaremetalDhcpAsyncFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalDhcpAsyncWithContext(ctx context.Context, p *cloudstack.AddBaremetalDhcpParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddBaremetalDhcpAsyncWithContext", ctx, p)
+	if m.AddBaremetalDhcpAsyncWithContextFunc != nil {
+		return m.AddBaremetalDhcpAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddBaremetalDhcpAsyncFunc != nil {
+		return m.AddBaremetalDhcpAsyncFunc(p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalDhcpAsyncWithContext")
+	return
+}
+
+// NewAddBaremetalPxeKickStartServerParams records the call and calls NewAddBaremetalPxeKickStartServerParamsFunc
+func (m *MockBaremetalService) NewAddBaremetalPxeKickStartServerParams(password string, physicalnetworkid string, pxeservertype string, tftpdir string, url string, username string) (r0 *cloudstack.AddBaremetalPxeKickStartServerParams) {
+	m.record("NewAddBaremetalPxeKickStartServerParams", password, physicalnetworkid, pxeservertype, tftpdir, url, username)
+	if m.NewAddBaremetalPxeKickStartServerParamsFunc != nil {
+		return m.NewAddBaremetalPxeKickStartServerParamsFunc(password, physicalnetworkid, pxeservertype, tftpdir, url, username)
+	}
+	return cloudstack.NewBaremetalService(nil).NewAddBaremetalPxeKickStartServerParams(password, physicalnetworkid, pxeservertype, tftpdir, url, username)
+}
+
+// AddBaremetalPxeKickStartServer records the call and calls AddBaremetalPxeKickStartServerFunc, or AddBaremetalPxeKickStartServerWithContextFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalPxeKickStartServer(p *cloudstack.AddBaremetalPxeKickStartServerParams) (r0 *cloudstack.AddBaremetalPxeKickStartServerResponse, r1 error) {
+	m.record("AddBaremetalPxeKickStartServer", p)
+	if m.AddBaremetalPxeKickStartServerFunc != nil {
+		return m.AddBaremetalPxeKickStartServerFunc(p)
+	}
+	if m.AddBaremetalPxeKickStartServerWithContextFunc != nil {
+		return m.AddBaremetalPxeKickStartServerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalPxeKickStartServer")
+	return
+}
+
+// AddBaremetalPxeKickStartServerWithContext records the call and calls AddBaremetalPxeKickStartServerWithContextFunc, or AddBaremetalPxeKickStartServerFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalPxeKickStartServerWithContext(ctx context.Context, p *cloudstack.AddBaremetalPxeKickStartServerParams) (r0 *cloudstack.AddBaremetalPxeKickStartServerResponse, r1 error) {
+	m.record("AddBaremetalPxeKickStartServerWithContext", ctx, p)
+	if m.AddBaremetalPxeKickStartServerWithContextFunc != nil {
+		return m.AddBaremetalPxeKickStartServerWithContextFunc(ctx, p)
+	}
+	if m.AddBaremetalPxeKickStartServerFunc != nil {
+		return m.AddBaremetalPxeKickStartServerFunc(p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalPxeKickStartServerWithContext")
+	return
+}
+
+// AddBaremetalPxeKickStartServerAsync records the call and calls AddBaremetalPxeKickStartServerAsyncFunc, or AddBaremetalPxeKickStartServerAsyncWithContextFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalPxeKickStartServerAsync(p *cloudstack.AddBaremetalPxeKickStartServerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddBaremetalPxeKickStartServerAsync", p)
+	if m.AddBaremetalPxeKickStartServerAsyncFunc != nil {
+		return m.AddBaremetalPxeKickStartServerAsyncFunc(p)
+	}
+	if m.AddBaremetalPxeKickStartServerAsyncWithContextFunc != nil {
+		return m.AddBaremetalPxeKickStartServerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalPxeKickStartServerAsync")
+	return
+}
+
+// AddBaremetalPxeKickStartServerAsyncWithContext records the call and calls AddBaremetalPxeKickStartServerAsyncWithContextFunc, or AddBaremetalPxeKickStartServerAsyncFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalPxeKickStartServerAsyncWithContext(ctx context.Context, p *cloudstack.AddBaremetalPxeKickStartServerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddBaremetalPxeKickStartServerAsyncWithContext", ctx, p)
+	if m.AddBaremetalPxeKickStartServerAsyncWithContextFunc != nil {
+		return m.AddBaremetalPxeKickStartServerAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddBaremetalPxeKickStartServerAsyncFunc != nil {
+		return m.AddBaremetalPxeKickStartServerAsyncFunc(p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalPxeKickStartServerAsyncWithContext")
+	return
+}
+
+// NewAddBaremetalPxePingServerParams records the call and calls NewAddBaremetalPxePingServerParamsFunc
+func (m *MockBaremetalService) NewAddBaremetalPxePingServerParams(password string, physicalnetworkid string, pingdir string, pingstorageserverip string, pxeservertype string, tftpdir string, url string, username string) (r0 *cloudstack.AddBaremetalPxePingServerParams) {
+	m.record("NewAddBaremetalPxePingServerParams", password, physicalnetworkid, pingdir, pingstorageserverip, pxeservertype, tftpdir, url, username)
+	if m.NewAddBaremetalPxePingServerParamsFunc != nil {
+		return m.NewAddBaremetalPxePingServerParamsFunc(password, physicalnetworkid, pingdir, pingstorageserverip, pxeservertype, tftpdir, url, username)
+	}
+	return cloudstack.NewBaremetalService(nil).NewAddBaremetalPxePingServerParams(password, physicalnetworkid, pingdir, pingstorageserverip, pxeservertype, tftpdir, url, username)
+}
+
+// AddBaremetalPxePingServer records the call and calls AddBaremetalPxePingServerFunc, or AddBaremetalPxePingServerWithContextFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalPxePingServer(p *cloudstack.AddBaremetalPxePingServerParams) (r0 *cloudstack.AddBaremetalPxePingServerResponse, r1 error) {
+	m.record("AddBaremetalPxePingServer", p)
+	if m.AddBaremetalPxePingServerFunc != nil {
+		return m.AddBaremetalPxePingServerFunc(p)
+	}
+	if m.AddBaremetalPxePingServerWithContextFunc != nil {
+		return m.AddBaremetalPxePingServerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalPxePingServer")
+	return
+}
+
+// AddBaremetalPxePingServerWithContext records the call and calls AddBaremetalPxePingServerWithContextFunc, or AddBaremetalPxePingServerFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalPxePingServerWithContext(ctx context.Context, p *cloudstack.AddBaremetalPxePingServerParams) (r0 *cloudstack.AddBaremetalPxePingServerResponse, r1 error) {
+	m.record("AddBaremetalPxePingServerWithContext", ctx, p)
+	if m.AddBaremetalPxePingServerWithContextFunc != nil {
+		return m.AddBaremetalPxePingServerWithContextFunc(ctx, p)
+	}
+	if m.AddBaremetalPxePingServerFunc != nil {
+		return m.AddBaremetalPxePingServerFunc(p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalPxePingServerWithContext")
+	return
+}
+
+// AddBaremetalPxePingServerAsync records the call and calls AddBaremetalPxePingServerAsyncFunc, or AddBaremetalPxePingServerAsyncWithContextFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalPxePingServerAsync(p *cloudstack.AddBaremetalPxePingServerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddBaremetalPxePingServerAsync", p)
+	if m.AddBaremetalPxePingServerAsyncFunc != nil {
+		return m.AddBaremetalPxePingServerAsyncFunc(p)
+	}
+	if m.AddBaremetalPxePingServerAsyncWithContextFunc != nil {
+		return m.AddBaremetalPxePingServerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalPxePingServerAsync")
+	return
+}
+
+// AddBaremetalPxePingServerAsyncWithContext records the call and calls AddBaremetalPxePingServerAsyncWithContextFunc, or AddBaremetalPxePingServerAsyncFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalPxePingServerAsyncWithContext(ctx context.Context, p *cloudstack.AddBaremetalPxePingServerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddBaremetalPxePingServerAsyncWithContext", ctx, p)
+	if m.AddBaremetalPxePingServerAsyncWithContextFunc != nil {
+		return m.AddBaremetalPxePingServerAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddBaremetalPxePingServerAsyncFunc != nil {
+		return m.AddBaremetalPxePingServerAsyncFunc(p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalPxePingServerAsyncWithContext")
+	return
+}
+
+// NewAddBaremetalRctParams records the call and calls NewAddBaremetalRctParamsFunc
+func (m *MockBaremetalService) NewAddBaremetalRctParams(baremetalrcturl string) (r0 *cloudstack.AddBaremetalRctParams) {
+	m.record("NewAddBaremetalRctParams", baremetalrcturl)
+	if m.NewAddBaremetalRctParamsFunc != nil {
+		return m.NewAddBaremetalRctParamsFunc(baremetalrcturl)
+	}
+	return cloudstack.NewBaremetalService(nil).NewAddBaremetalRctParams(baremetalrcturl)
+}
+
+// AddBaremetalRct records the call and calls AddBaremetalRctFunc, or AddBaremetalRctWithContextFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalRct(p *cloudstack.AddBaremetalRctParams) (r0 *cloudstack.AddBaremetalRctResponse, r1 error) {
+	m.record("AddBaremetalRct", p)
+	if m.AddBaremetalRctFunc != nil {
+		return m.AddBaremetalRctFunc(p)
+	}
+	if m.AddBaremetalRctWithContextFunc != nil {
+		return m.AddBaremetalRctWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalRct")
+	return
+}
+
+// AddBaremetalRctWithContext records the call and calls AddBaremetalRctWithContextFunc, or AddBaremetalRctFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalRctWithContext(ctx context.Context, p *cloudstack.AddBaremetalRctParams) (r0 *cloudstack.AddBaremetalRctResponse, r1 error) {
+	m.record("AddBaremetalRctWithContext", ctx, p)
+	if m.AddBaremetalRctWithContextFunc != nil {
+		return m.AddBaremetalRctWithContextFunc(ctx, p)
+	}
+	if m.AddBaremetalRctFunc != nil {
+		return m.AddBaremetalRctFunc(p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalRctWithContext")
+	return
+}
+
+// AddBaremetalRctAsync records the call and calls AddBaremetalRctAsyncFunc, or AddBaremetalRctAsyncWithContextFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalRctAsync(p *cloudstack.AddBaremetalRctParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddBaremetalRctAsync", p)
+	if m.AddBaremetalRctAsyncFunc != nil {
+		return m.AddBaremetalRctAsyncFunc(p)
+	}
+	if m.AddBaremetalRctAsyncWithContextFunc != nil {
+		return m.AddBaremetalRctAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalRctAsync")
+	return
+}
+
+// AddBaremetalRctAsyncWithContext records the call and calls AddBaremetalRctAsyncWithContextFunc, or AddBaremetalRctAsyncFunc if only that is set
+func (m *MockBaremetalService) AddBaremetalRctAsyncWithContext(ctx context.Context, p *cloudstack.AddBaremetalRctParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddBaremetalRctAsyncWithContext", ctx, p)
+	if m.AddBaremetalRctAsyncWithContextFunc != nil {
+		return m.AddBaremetalRctAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddBaremetalRctAsyncFunc != nil {
+		return m.AddBaremetalRctAsyncFunc(p)
+	}
+	r1 = notMocked("BaremetalService.AddBaremetalRctAsyncWithContext")
+	return
+}
+
+// NewDeleteBaremetalRctParams records the call and calls NewDeleteBaremetalRctParamsFunc
+func (m *MockBaremetalService) NewDeleteBaremetalRctParams(id string) (r0 *cloudstack.DeleteBaremetalRctParams) {
+	m.record("NewDeleteBaremetalRctParams", id)
+	if m.NewDeleteBaremetalRctParamsFunc != nil {
+		return m.NewDeleteBaremetalRctParamsFunc(id)
+	}
+	return cloudstack.NewBaremetalService(nil).NewDeleteBaremetalRctParams(id)
+}
+
+// DeleteBaremetalRct records the call and calls DeleteBaremetalRctFunc, or DeleteBaremetalRctWithContextFunc if only that is set
+func (m *MockBaremetalService) DeleteBaremetalRct(p *cloudstack.DeleteBaremetalRctParams) (r0 *cloudstack.DeleteBaremetalRctResponse, r1 error) {
+	m.record("DeleteBaremetalRct", p)
+	if m.DeleteBaremetalRctFunc != nil {
+		return m.DeleteBaremetalRctFunc(p)
+	}
+	if m.DeleteBaremetalRctWithContextFunc != nil {
+		return m.DeleteBaremetalRctWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.DeleteBaremetalRct")
+	return
+}
+
+// DeleteBaremetalRctWithContext records the call and calls DeleteBaremetalRctWithContextFunc, or DeleteBaremetalRctFunc if only that is set
+func (m *MockBaremetalService) DeleteBaremetalRctWithContext(ctx context.Context, p *cloudstack.DeleteBaremetalRctParams) (r0 *cloudstack.DeleteBaremetalRctResponse, r1 error) {
+	m.record("DeleteBaremetalRctWithContext", ctx, p)
+	if m.DeleteBaremetalRctWithContextFunc != nil {
+		return m.DeleteBaremetalRctWithContextFunc(ctx, p)
+	}
+	if m.DeleteBaremetalRctFunc != nil {
+		return m.DeleteBaremetalRctFunc(p)
+	}
+	r1 = notMocked("BaremetalService.DeleteBaremetalRctWithContext")
+	return
+}
+
+// DeleteBaremetalRctAsync records the call and calls DeleteBaremetalRctAsyncFunc, or DeleteBaremetalRctAsyncWithContextFunc if only that is set
+func (m *MockBaremetalService) DeleteBaremetalRctAsync(p *cloudstack.DeleteBaremetalRctParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteBaremetalRctAsync", p)
+	if m.DeleteBaremetalRctAsyncFunc != nil {
+		return m.DeleteBaremetalRctAsyncFunc(p)
+	}
+	if m.DeleteBaremetalRctAsyncWithContextFunc != nil {
+		return m.DeleteBaremetalRctAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.DeleteBaremetalRctAsync")
+	return
+}
+
+// DeleteBaremetalRctAsyncWithContext records the call and calls DeleteBaremetalRctAsyncWithContextFunc, or DeleteBaremetalRctAsyncFunc if only that is set
+func (m *MockBaremetalService) DeleteBaremetalRctAsyncWithContext(ctx context.Context, p *cloudstack.DeleteBaremetalRctParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteBaremetalRctAsyncWithContext", ctx, p)
+	if m.DeleteBaremetalRctAsyncWithContextFunc != nil {
+		return m.DeleteBaremetalRctAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteBaremetalRctAsyncFunc != nil {
+		return m.DeleteBaremetalRctAsyncFunc(p)
+	}
+	r1 = notMocked("BaremetalService.DeleteBaremetalRctAsyncWithContext")
+	return
+}
+
+// NewListBaremetalDhcpParams records the call and calls NewListBaremetalDhcpParamsFunc
+func (m *MockBaremetalService) NewListBaremetalDhcpParams(physicalnetworkid string) (r0 *cloudstack.ListBaremetalDhcpParams) {
+	m.record("NewListBaremetalDhcpParams", physicalnetworkid)
+	if m.NewListBaremetalDhcpParamsFunc != nil {
+		return m.NewListBaremetalDhcpParamsFunc(physicalnetworkid)
+	}
+	return cloudstack.NewBaremetalService(nil).NewListBaremetalDhcpParams(physicalnetworkid)
+}
+
+// ListBaremetalDhcp records the call and calls ListBaremetalDhcpFunc, or ListBaremetalDhcpWithContextFunc if only that is set
+func (m *MockBaremetalService) ListBaremetalDhcp(p *cloudstack.ListBaremetalDhcpParams) (r0 *cloudstack.ListBaremetalDhcpResponse, r1 error) {
+	m.record("ListBaremetalDhcp", p)
+	if m.ListBaremetalDhcpFunc != nil {
+		return m.ListBaremetalDhcpFunc(p)
+	}
+	if m.ListBaremetalDhcpWithContextFunc != nil {
+		return m.ListBaremetalDhcpWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.ListBaremetalDhcp")
+	return
+}
+
+// ListBaremetalDhcpWithContext records the call and calls ListBaremetalDhcpWithContextFunc, or ListBaremetalDhcpFunc if only that is set
+func (m *MockBaremetalService) ListBaremetalDhcpWithContext(ctx context.Context, p *cloudstack.ListBaremetalDhcpParams) (r0 *cloudstack.ListBaremetalDhcpResponse, r1 error) {
+	m.record("ListBaremetalDhcpWithContext", ctx, p)
+	if m.ListBaremetalDhcpWithContextFunc != nil {
+		return m.ListBaremetalDhcpWithContextFunc(ctx, p)
+	}
+	if m.ListBaremetalDhcpFunc != nil {
+		return m.ListBaremetalDhcpFunc(p)
+	}
+	r1 = notMocked("BaremetalService.ListBaremetalDhcpWithContext")
+	return
+}
+
+// NewListBaremetalDhcpPager records the call and calls NewListBaremetalDhcpPagerFunc
+func (m *MockBaremetalService) NewListBaremetalDhcpPager(p *cloudstack.ListBaremetalDhcpParams) (r0 *cloudstack.ListBaremetalDhcpPager) {
+	m.record("NewListBaremetalDhcpPager", p)
+	if m.NewListBaremetalDhcpPagerFunc != nil {
+		return m.NewListBaremetalDhcpPagerFunc(p)
+	}
+	return
+}
+
+// ListBaremetalDhcpAll records the call and calls ListBaremetalDhcpAllFunc, or ListBaremetalDhcpAllWithContextFunc if only that is set
+func (m *MockBaremetalService) ListBaremetalDhcpAll(p *cloudstack.ListBaremetalDhcpParams) (r0 *cloudstack.ListBaremetalDhcpResponse, r1 error) {
+	m.record("ListBaremetalDhcpAll", p)
+	if m.ListBaremetalDhcpAllFunc != nil {
+		return m.ListBaremetalDhcpAllFunc(p)
+	}
+	if m.ListBaremetalDhcpAllWithContextFunc != nil {
+		return m.ListBaremetalDhcpAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.ListBaremetalDhcpAll")
+	return
+}
+
+// ListBaremetalDhcpAllWithContext records the call and calls ListBaremetalDhcpAllWithContextFunc, or ListBaremetalDhcpAllFunc if only that is set
+func (m *MockBaremetalService) ListBaremetalDhcpAllWithContext(ctx context.Context, p *cloudstack.ListBaremetalDhcpParams) (r0 *cloudstack.ListBaremetalDhcpResponse, r1 error) {
+	m.record("ListBaremetalDhcpAllWithContext", ctx, p)
+	if m.ListBaremetalDhcpAllWithContextFunc != nil {
+		return m.ListBaremetalDhcpAllWithContextFunc(ctx, p)
+	}
+	if m.ListBaremetalDhcpAllFunc != nil {
+		return m.ListBaremetalDhcpAllFunc(p)
+	}
+	r1 = notMocked("BaremetalService.ListBaremetalDhcpAllWithContext")
+	return
+}
+
+// NewListBaremetalPxeServersParams records the call and calls NewListBaremetalPxeServersParamsFunc
+func (m *MockBaremetalService) NewListBaremetalPxeServersParams(physicalnetworkid string) (r0 *cloudstack.ListBaremetalPxeServersParams) {
+	m.record("NewListBaremetalPxeServersParams", physicalnetworkid)
+	if m.NewListBaremetalPxeServersParamsFunc != nil {
+		return m.NewListBaremetalPxeServersParamsFunc(physicalnetworkid)
+	}
+	return cloudstack.NewBaremetalService(nil).NewListBaremetalPxeServersParams(physicalnetworkid)
+}
+
+// ListBaremetalPxeServers records the call and calls ListBaremetalPxeServersFunc, or ListBaremetalPxeServersWithContextFunc if only that is set
+func (m *MockBaremetalService) ListBaremetalPxeServers(p *cloudstack.ListBaremetalPxeServersParams) (r0 *cloudstack.ListBaremetalPxeServersResponse, r1 error) {
+	m.record("ListBaremetalPxeServers", p)
+	if m.ListBaremetalPxeServersFunc != nil {
+		return m.ListBaremetalPxeServersFunc(p)
+	}
+	if m.ListBaremetalPxeServersWithContextFunc != nil {
+		return m.ListBaremetalPxeServersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.ListBaremetalPxeServers")
+	return
+}
+
+// ListBaremetalPxeServersWithContext records the call and calls ListBaremetalPxeServersWithContextFunc, or ListBaremetalPxeServersFunc if only that is set
+func (m *MockBaremetalService) ListBaremetalPxeServersWithContext(ctx context.Context, p *cloudstack.ListBaremetalPxeServersParams) (r0 *cloudstack.ListBaremetalPxeServersResponse, r1 error) {
+	m.record("ListBaremetalPxeServersWithContext", ctx, p)
+	if m.ListBaremetalPxeServersWithContextFunc != nil {
+		return m.ListBaremetalPxeServersWithContextFunc(ctx, p)
+	}
+	if m.ListBaremetalPxeServersFunc != nil {
+		return m.ListBaremetalPxeServersFunc(p)
+	}
+	r1 = notMocked("BaremetalService.ListBaremetalPxeServersWithContext")
+	return
+}
+
+// NewListBaremetalPxeServersPager records the call and calls NewListBaremetalPxeServersPagerFunc
+func (m *MockBaremetalService) NewListBaremetalPxeServersPager(p *cloudstack.ListBaremetalPxeServersParams) (r0 *cloudstack.ListBaremetalPxeServersPager) {
+	m.record("NewListBaremetalPxeServersPager", p)
+	if m.NewListBaremetalPxeServersPagerFunc != nil {
+		return m.NewListBaremetalPxeServersPagerFunc(p)
+	}
+	return
+}
+
+// ListBaremetalPxeServersAll records the call and calls ListBaremetalPxeServersAllFunc, or ListBaremetalPxeServersAllWithContextFunc if only that is set
+func (m *MockBaremetalService) ListBaremetalPxeServersAll(p *cloudstack.ListBaremetalPxeServersParams) (r0 *cloudstack.ListBaremetalPxeServersResponse, r1 error) {
+	m.record("ListBaremetalPxeServersAll", p)
+	if m.ListBaremetalPxeServersAllFunc != nil {
+		return m.ListBaremetalPxeServersAllFunc(p)
+	}
+	if m.ListBaremetalPxeServersAllWithContextFunc != nil {
+		return m.ListBaremetalPxeServersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.ListBaremetalPxeServersAll")
+	return
+}
+
+// ListBaremetalPxeServersAllWithContext records the call and calls ListBaremetalPxeServersAllWithContextFunc, or ListBaremetalPxeServersAllFunc if only that is set
+func (m *MockBaremetalService) ListBaremetalPxeServersAllWithContext(ctx context.Context, p *cloudstack.ListBaremetalPxeServersParams) (r0 *cloudstack.ListBaremetalPxeServersResponse, r1 error) {
+	m.record("ListBaremetalPxeServersAllWithContext", ctx, p)
+	if m.ListBaremetalPxeServersAllWithContextFunc != nil {
+		return m.ListBaremetalPxeServersAllWithContextFunc(ctx, p)
+	}
+	if m.ListBaremetalPxeServersAllFunc != nil {
+		return m.ListBaremetalPxeServersAllFunc(p)
+	}
+	r1 = notMocked("BaremetalService.ListBaremetalPxeServersAllWithContext")
+	return
+}
+
+// NewListBaremetalRctParams records the call and calls NewListBaremetalRctParamsFunc
+func (m *MockBaremetalService) NewListBaremetalRctParams() (r0 *cloudstack.ListBaremetalRctParams) {
+	m.record("NewListBaremetalRctParams")
+	if m.NewListBaremetalRctParamsFunc != nil {
+		return m.NewListBaremetalRctParamsFunc()
+	}
+	return cloudstack.NewBaremetalService(nil).NewListBaremetalRctParams()
+}
+
+// ListBaremetalRct records the call and calls ListBaremetalRctFunc, or ListBaremetalRctWithContextFunc if only that is set
+func (m *MockBaremetalService) ListBaremetalRct(p *cloudstack.ListBaremetalRctParams) (r0 *cloudstack.ListBaremetalRctResponse, r1 error) {
+	m.record("ListBaremetalRct", p)
+	if m.ListBaremetalRctFunc != nil {
+		return m.ListBaremetalRctFunc(p)
+	}
+	if m.ListBaremetalRctWithContextFunc != nil {
+		return m.ListBaremetalRctWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.ListBaremetalRct")
+	return
+}
+
+// ListBaremetalRctWithContext records the call and calls ListBaremetalRctWithContextFunc, or ListBaremetalRctFunc if only that is set
+func (m *MockBaremetalService) ListBaremetalRctWithContext(ctx context.Context, p *cloudstack.ListBaremetalRctParams) (r0 *cloudstack.ListBaremetalRctResponse, r1 error) {
+	m.record("ListBaremetalRctWithContext", ctx, p)
+	if m.ListBaremetalRctWithContextFunc != nil {
+		return m.ListBaremetalRctWithContextFunc(ctx, p)
+	}
+	if m.ListBaremetalRctFunc != nil {
+		return m.ListBaremetalRctFunc(p)
+	}
+	r1 = notMocked("BaremetalService.ListBaremetalRctWithContext")
+	return
+}
+
+// NewListBaremetalRctPager records the call and calls NewListBaremetalRctPagerFunc
+func (m *MockBaremetalService) NewListBaremetalRctPager(p *cloudstack.ListBaremetalRctParams) (r0 *cloudstack.ListBaremetalRctPager) {
+	m.record("NewListBaremetalRctPager", p)
+	if m.NewListBaremetalRctPagerFunc != nil {
+		return m.NewListBaremetalRctPagerFunc(p)
+	}
+	return
+}
+
+// ListBaremetalRctAll records the call and calls ListBaremetalRctAllFunc, or ListBaremetalRctAllWithContextFunc if only that is set
+func (m *MockBaremetalService) ListBaremetalRctAll(p *cloudstack.ListBaremetalRctParams) (r0 *cloudstack.ListBaremetalRctResponse, r1 error) {
+	m.record("ListBaremetalRctAll", p)
+	if m.ListBaremetalRctAllFunc != nil {
+		return m.ListBaremetalRctAllFunc(p)
+	}
+	if m.ListBaremetalRctAllWithContextFunc != nil {
+		return m.ListBaremetalRctAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.ListBaremetalRctAll")
+	return
+}
+
+// ListBaremetalRctAllWithContext records the call and calls ListBaremetalRctAllWithContextFunc, or ListBaremetalRctAllFunc if only that is set
+func (m *MockBaremetalService) ListBaremetalRctAllWithContext(ctx context.Context, p *cloudstack.ListBaremetalRctParams) (r0 *cloudstack.ListBaremetalRctResponse, r1 error) {
+	m.record("ListBaremetalRctAllWithContext", ctx, p)
+	if m.ListBaremetalRctAllWithContextFunc != nil {
+		return m.ListBaremetalRctAllWithContextFunc(ctx, p)
+	}
+	if m.ListBaremetalRctAllFunc != nil {
+		return m.ListBaremetalRctAllFunc(p)
+	}
+	r1 = notMocked("BaremetalService.ListBaremetalRctAllWithContext")
+	return
+}
+
+// NewNotifyBaremetalProvisionDoneParams records the call and calls NewNotifyBaremetalProvisionDoneParamsFunc
+func (m *MockBaremetalService) NewNotifyBaremetalProvisionDoneParams(mac string) (r0 *cloudstack.NotifyBaremetalProvisionDoneParams) {
+	m.record("NewNotifyBaremetalProvisionDoneParams", mac)
+	if m.NewNotifyBaremetalProvisionDoneParamsFunc != nil {
+		return m.NewNotifyBaremetalProvisionDoneParamsFunc(mac)
+	}
+	return cloudstack.NewBaremetalService(nil).NewNotifyBaremetalProvisionDoneParams(mac)
+}
+
+// NotifyBaremetalProvisionDone records the call and calls NotifyBaremetalProvisionDoneFunc, or NotifyBaremetalProvisionDoneWithContextFunc if only that is set
+func (m *MockBaremetalService) NotifyBaremetalProvisionDone(p *cloudstack.NotifyBaremetalProvisionDoneParams) (r0 *cloudstack.NotifyBaremetalProvisionDoneResponse, r1 error) {
+	m.record("NotifyBaremetalProvisionDone", p)
+	if m.NotifyBaremetalProvisionDoneFunc != nil {
+		return m.NotifyBaremetalProvisionDoneFunc(p)
+	}
+	if m.NotifyBaremetalProvisionDoneWithContextFunc != nil {
+		return m.NotifyBaremetalProvisionDoneWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.NotifyBaremetalProvisionDone")
+	return
+}
+
+// NotifyBaremetalProvisionDoneWithContext records the call and calls NotifyBaremetalProvisionDoneWithContextFunc, or NotifyBaremetalProvisionDoneFunc if only that is set
+func (m *MockBaremetalService) NotifyBaremetalProvisionDoneWithContext(ctx context.Context, p *cloudstack.NotifyBaremetalProvisionDoneParams) (r0 *cloudstack.NotifyBaremetalProvisionDoneResponse, r1 error) {
+	m.record("NotifyBaremetalProvisionDoneWithContext", ctx, p)
+	if m.NotifyBaremetalProvisionDoneWithContextFunc != nil {
+		return m.NotifyBaremetalProvisionDoneWithContextFunc(ctx, p)
+	}
+	if m.NotifyBaremetalProvisionDoneFunc != nil {
+		return m.NotifyBaremetalProvisionDoneFunc(p)
+	}
+	r1 = notMocked("BaremetalService.NotifyBaremetalProvisionDoneWithContext")
+	return
+}
+
+// NotifyBaremetalProvisionDoneAsync records the call and calls NotifyBaremetalProvisionDoneAsyncFunc, or NotifyBaremetalProvisionDoneAsyncWithContextFunc if only that is set
+func (m *MockBaremetalService) NotifyBaremetalProvisionDoneAsync(p *cloudstack.NotifyBaremetalProvisionDoneParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("NotifyBaremetalProvisionDoneAsync", p)
+	if m.NotifyBaremetalProvisionDoneAsyncFunc != nil {
+		return m.NotifyBaremetalProvisionDoneAsyncFunc(p)
+	}
+	if m.NotifyBaremetalProvisionDoneAsyncWithContextFunc != nil {
+		return m.NotifyBaremetalProvisionDoneAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BaremetalService.NotifyBaremetalProvisionDoneAsync")
+	return
+}
+
+// NotifyBaremetalProvisionDoneAsyncWithContext records the call and calls NotifyBaremetalProvisionDoneAsyncWithContextFunc, or NotifyBaremetalProvisionDoneAsyncFunc if only that is set
+func (m *MockBaremetalService) NotifyBaremetalProvisionDoneAsyncWithContext(ctx context.Context, p *cloudstack.NotifyBaremetalProvisionDoneParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("NotifyBaremetalProvisionDoneAsyncWithContext", ctx, p)
+	if m.NotifyBaremetalProvisionDoneAsyncWithContextFunc != nil {
+		return m.NotifyBaremetalProvisionDoneAsyncWithContextFunc(ctx, p)
+	}
+	if m.NotifyBaremetalProvisionDoneAsyncFunc != nil {
+		return m.NotifyBaremetalProvisionDoneAsyncFunc(p)
+	}
+	r1 = notMocked("BaremetalService.NotifyBaremetalProvisionDoneAsyncWithContext")
+	return
+}
@@ -0,0 +1,247 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockBigSwitchBCFService is a mock of cloudstack.BigSwitchBCFServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockBigSwitchBCFService struct {
+	Recorder
+
+	NewAddBigSwitchBcfDeviceParamsFunc           func(hostname string, nat bool, password string, physicalnetworkid string, username string) *cloudstack.AddBigSwitchBcfDeviceParams
+	AddBigSwitchBcfDeviceFunc                    func(p *cloudstack.AddBigSwitchBcfDeviceParams) (*cloudstack.AddBigSwitchBcfDeviceResponse, error)
+	AddBigSwitchBcfDeviceWithContextFunc         func(ctx context.Context, p *cloudstack.AddBigSwitchBcfDeviceParams) (*cloudstack.AddBigSwitchBcfDeviceResponse, error)
+	AddBigSwitchBcfDeviceAsyncFunc               func(p *cloudstack.AddBigSwitchBcfDeviceParams) (*cloudstack.Job, error)
+	AddBigSwitchBcfDeviceAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.AddBigSwitchBcfDeviceParams) (*cloudstack.Job, error)
+	NewDeleteBigSwitchBcfDeviceParamsFunc        func(bcfdeviceid string) *cloudstack.DeleteBigSwitchBcfDeviceParams
+	DeleteBigSwitchBcfDeviceFunc                 func(p *cloudstack.DeleteBigSwitchBcfDeviceParams) (*cloudstack.DeleteBigSwitchBcfDeviceResponse, error)
+	DeleteBigSwitchBcfDeviceWithContextFunc      func(ctx context.Context, p *cloudstack.DeleteBigSwitchBcfDeviceParams) (*cloudstack.DeleteBigSwitchBcfDeviceResponse, error)
+	DeleteBigSwitchBcfDeviceAsyncFunc            func(p *cloudstack.DeleteBigSwitchBcfDeviceParams) (*cloudstack.Job, error)
+	DeleteBigSwitchBcfDeviceAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DeleteBigSwitchBcfDeviceParams) (*cloudstack.Job, error)
+	NewListBigSwitchBcfDevicesParamsFunc         func() *cloudstack.ListBigSwitchBcfDevicesParams
+	ListBigSwitchBcfDevicesFunc                  func(p *cloudstack.ListBigSwitchBcfDevicesParams) (*cloudstack.ListBigSwitchBcfDevicesResponse, error)
+	ListBigSwitchBcfDevicesWithContextFunc       func(ctx context.Context, p *cloudstack.ListBigSwitchBcfDevicesParams) (*cloudstack.ListBigSwitchBcfDevicesResponse, error)
+	NewListBigSwitchBcfDevicesPagerFunc          func(p *cloudstack.ListBigSwitchBcfDevicesParams) *cloudstack.ListBigSwitchBcfDevicesPager
+	ListBigSwitchBcfDevicesAllFunc               func(p *cloudstack.ListBigSwitchBcfDevicesParams) (*cloudstack.ListBigSwitchBcfDevicesResponse, error)
+	ListBigSwitchBcfDevicesAllWithContextFunc    func(ctx context.Context, p *cloudstack.ListBigSwitchBcfDevicesParams) (*cloudstack.ListBigSwitchBcfDevicesResponse, error)
+}
+
+var _ cloudstack.BigSwitchBCFServiceIface = (*MockBigSwitchBCFService)(nil)
+
+// NewAddBigSwitchBcfDeviceParams records the call and calls NewAddBigSwitchBcfDeviceParamsFunc
+func (m *MockBigSwitchBCFService) NewAddBigSwitchBcfDeviceParams(hostname string, nat bool, password string, physicalnetworkid string, username string) (r0 *cloudstack.AddBigSwitchBcfDeviceParams) {
+	m.record("NewAddBigSwitchBcfDeviceParams", hostname, nat, password, physicalnetworkid, username)
+	if m.NewAddBigSwitchBcfDeviceParamsFunc != nil {
+		return m.NewAddBigSwitchBcfDeviceParamsFunc(hostname, nat, password, physicalnetworkid, username)
+	}
+	return cloudstack.NewBigSwitchBCFService(nil).NewAddBigSwitchBcfDeviceParams(hostname, nat, password, physicalnetworkid, username)
+}
+
+// AddBigSwitchBcfDevice records the call and calls AddBigSwitchBcfDeviceFunc, or AddBigSwitchBcfDeviceWithContextFunc if only that is set
+func (m *MockBigSwitchBCFService) AddBigSwitchBcfDevice(p *cloudstack.AddBigSwitchBcfDeviceParams) (r0 *cloudstack.AddBigSwitchBcfDeviceResponse, r1 error) {
+	m.record("AddBigSwitchBcfDevice", p)
+	if m.AddBigSwitchBcfDeviceFunc != nil {
+		return m.AddBigSwitchBcfDeviceFunc(p)
+	}
+	if m.AddBigSwitchBcfDeviceWithContextFunc != nil {
+		return m.AddBigSwitchBcfDeviceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BigSwitchBCFService.AddBigSwitchBcfDevice")
+	return
+}
+
+// AddBigSwitchBcfDeviceWithContext records the call and calls AddBigSwitchBcfDeviceWithContextFunc, or AddBigSwitchBcfDeviceFunc if only that is set
+func (m *MockBigSwitchBCFService) AddBigSwitchBcfDeviceWithContext(ctx context.Context, p *cloudstack.AddBigSwitchBcfDeviceParams) (r0 *cloudstack.AddBigSwitchBcfDeviceResponse, r1 error) {
+	m.record("AddBigSwitchBcfDeviceWithContext", ctx, p)
+	if m.AddBigSwitchBcfDeviceWithContextFunc != nil {
+		return m.AddBigSwitchBcfDeviceWithContextFunc(ctx, p)
+	}
+	if m.AddBigSwitchBcfDeviceFunc != nil {
+		return m.AddBigSwitchBcfDeviceFunc(p)
+	}
+	r1 = notMocked("BigSwitchBCFService.AddBigSwitchBcfDeviceWithContext")
+	return
+}
+
+// AddBigSwitchBcfDeviceAsync records the call and calls AddBigSwitchBcfDeviceAsyncFunc, or AddBigSwitchBcfDeviceAsyncWithContextFunc if only that is set
+func (m *MockBigSwitchBCFService) AddBigSwitchBcfDeviceAsync(p *cloudstack.AddBigSwitchBcfDeviceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddBigSwitchBcfDeviceAsync", p)
+	if m.AddBigSwitchBcfDeviceAsyncFunc != nil {
+		return m.AddBigSwitchBcfDeviceAsyncFunc(p)
+	}
+	if m.AddBigSwitchBcfDeviceAsyncWithContextFunc != nil {
+		return m.AddBigSwitchBcfDeviceAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BigSwitchBCFService.AddBigSwitchBcfDeviceAsync")
+	return
+}
+
+// AddBigSwitchBcfDeviceAsyncWithContext records the call and calls AddBigSwitchBcfDeviceAsyncWithContextFunc, or AddBigSwitchBcfDeviceAsyncFunc if only that is set
+func (m *MockBigSwitchBCFService) AddBigSwitchBcfDeviceAsyncWithContext(ctx context.Context, p *cloudstack.AddBigSwitchBcfDeviceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddBigSwitchBcfDeviceAsyncWithContext", ctx, p)
+	if m.AddBigSwitchBcfDeviceAsyncWithContextFunc != nil {
+		return m.AddBigSwitchBcfDeviceAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddBigSwitchBcfDeviceAsyncFunc != nil {
+		return m.AddBigSwitchBcfDeviceAsyncFunc(p)
+	}
+	r1 = notMocked("BigSwitchBCFService.AddBigSwitchBcfDeviceAsyncWithContext")
+	return
+}
+
+// NewDeleteBigSwitchBcfDeviceParams records the call and calls NewDeleteBigSwitchBcfDeviceParamsFunc
+func (m *MockBigSwitchBCFService) NewDeleteBigSwitchBcfDeviceParams(bcfdeviceid string) (r0 *cloudstack.DeleteBigSwitchBcfDeviceParams) {
+	m.record("NewDeleteBigSwitchBcfDeviceParams", bcfdeviceid)
+	if m.NewDeleteBigSwitchBcfDeviceParamsFunc != nil {
+		return m.NewDeleteBigSwitchBcfDeviceParamsFunc(bcfdeviceid)
+	}
+	return cloudstack.NewBigSwitchBCFService(nil).NewDeleteBigSwitchBcfDeviceParams(bcfdeviceid)
+}
+
+// DeleteBigSwitchBcfDevice records the call and calls DeleteBigSwitchBcfDeviceFunc, or DeleteBigSwitchBcfDeviceWithContextFunc if only that is set
+func (m *MockBigSwitchBCFService) DeleteBigSwitchBcfDevice(p *cloudstack.DeleteBigSwitchBcfDeviceParams) (r0 *cloudstack.DeleteBigSwitchBcfDeviceResponse, r1 error) {
+	m.record("DeleteBigSwitchBcfDevice", p)
+	if m.DeleteBigSwitchBcfDeviceFunc != nil {
+		return m.DeleteBigSwitchBcfDeviceFunc(p)
+	}
+	if m.DeleteBigSwitchBcfDeviceWithContextFunc != nil {
+		return m.DeleteBigSwitchBcfDeviceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BigSwitchBCFService.DeleteBigSwitchBcfDevice")
+	return
+}
+
+// DeleteBigSwitchBcfDeviceWithContext records the call and calls DeleteBigSwitchBcfDeviceWithContextFunc, or DeleteBigSwitchBcfDeviceFunc if only that is set
+func (m *MockBigSwitchBCFService) DeleteBigSwitchBcfDeviceWithContext(ctx context.Context, p *cloudstack.DeleteBigSwitchBcfDeviceParams) (r0 *cloudstack.DeleteBigSwitchBcfDeviceResponse, r1 error) {
+	m.record("DeleteBigSwitchBcfDeviceWithContext", ctx, p)
+	if m.DeleteBigSwitchBcfDeviceWithContextFunc != nil {
+		return m.DeleteBigSwitchBcfDeviceWithContextFunc(ctx, p)
+	}
+	if m.DeleteBigSwitchBcfDeviceFunc != nil {
+		return m.DeleteBigSwitchBcfDeviceFunc(p)
+	}
+	r1 = notMocked("BigSwitchBCFService.DeleteBigSwitchBcfDeviceWithContext")
+	return
+}
+
+// DeleteBigSwitchBcfDeviceAsync records the call and calls DeleteBigSwitchBcfDeviceAsyncFunc, or DeleteBigSwitchBcfDeviceAsyncWithContextFunc if only that is set
+func (m *MockBigSwitchBCFService) DeleteBigSwitchBcfDeviceAsync(p *cloudstack.DeleteBigSwitchBcfDeviceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteBigSwitchBcfDeviceAsync", p)
+	if m.DeleteBigSwitchBcfDeviceAsyncFunc != nil {
+		return m.DeleteBigSwitchBcfDeviceAsyncFunc(p)
+	}
+	if m.DeleteBigSwitchBcfDeviceAsyncWithContextFunc != nil {
+		return m.DeleteBigSwitchBcfDeviceAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BigSwitchBCFService.DeleteBigSwitchBcfDeviceAsync")
+	return
+}
+
+// DeleteBigSwitchBcfDeviceAsyncWithContext records the call and calls DeleteBigSwitchBcfDeviceAsyncWithContextFunc, or DeleteBigSwitchBcfDeviceAsyncFunc if only that is set
+func (m *MockBigSwitchBCFService) DeleteBigSwitchBcfDeviceAsyncWithContext(ctx context.Context, p *cloudstack.DeleteBigSwitchBcfDeviceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteBigSwitchBcfDeviceAsyncWithContext", ctx, p)
+	if m.DeleteBigSwitchBcfDeviceAsyncWithContextFunc != nil {
+		return m.DeleteBigSwitchBcfDeviceAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteBigSwitchBcfDeviceAsyncFunc != nil {
+		return m.DeleteBigSwitchBcfDeviceAsyncFunc(p)
+	}
+	r1 = notMocked("BigSwitchBCFService.DeleteBigSwitchBcfDeviceAsyncWithContext")
+	return
+}
+
+// NewListBigSwitchBcfDevicesParams records the call and calls NewListBigSwitchBcfDevicesParamsFunc
+func (m *MockBigSwitchBCFService) NewListBigSwitchBcfDevicesParams() (r0 *cloudstack.ListBigSwitchBcfDevicesParams) {
+	m.record("NewListBigSwitchBcfDevicesParams")
+	if m.NewListBigSwitchBcfDevicesParamsFunc != nil {
+		return m.NewListBigSwitchBcfDevicesParamsFunc()
+	}
+	return cloudstack.NewBigSwitchBCFService(nil).NewListBigSwitchBcfDevicesParams()
+}
+
+// ListBigSwitchBcfDevices records the call and calls ListBigSwitchBcfDevicesFunc, or ListBigSwitchBcfDevicesWithContextFunc if only that is set
+func (m *MockBigSwitchBCFService) ListBigSwitchBcfDevices(p *cloudstack.ListBigSwitchBcfDevicesParams) (r0 *cloudstack.ListBigSwitchBcfDevicesResponse, r1 error) {
+	m.record("ListBigSwitchBcfDevices", p)
+	if m.ListBigSwitchBcfDevicesFunc != nil {
+		return m.ListBigSwitchBcfDevicesFunc(p)
+	}
+	if m.ListBigSwitchBcfDevicesWithContextFunc != nil {
+		return m.ListBigSwitchBcfDevicesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BigSwitchBCFService.ListBigSwitchBcfDevices")
+	return
+}
+
+// ListBigSwitchBcfDevicesWithContext records the call and calls ListBigSwitchBcfDevicesWithContextFunc, or ListBigSwitchBcfDevicesFunc if only that is set
+func (m *MockBigSwitchBCFService) ListBigSwitchBcfDevicesWithContext(ctx context.Context, p *cloudstack.ListBigSwitchBcfDevicesParams) (r0 *cloudstack.ListBigSwitchBcfDevicesResponse, r1 error) {
+	m.record("ListBigSwitchBcfDevicesWithContext", ctx, p)
+	if m.ListBigSwitchBcfDevicesWithContextFunc != nil {
+		return m.ListBigSwitchBcfDevicesWithContextFunc(ctx, p)
+	}
+	if m.ListBigSwitchBcfDevicesFunc != nil {
+		return m.ListBigSwitchBcfDevicesFunc(p)
+	}
+	r1 = notMocked("BigSwitchBCFService.ListBigSwitchBcfDevicesWithContext")
+	return
+}
+
+// NewListBigSwitchBcfDevicesPager records the call and calls NewListBigSwitchBcfDevicesPagerFunc
+func (m *MockBigSwitchBCFService) NewListBigSwitchBcfDevicesPager(p *cloudstack.ListBigSwitchBcfDevicesParams) (r0 *cloudstack.ListBigSwitchBcfDevicesPager) {
+	m.record("NewListBigSwitchBcfDevicesPager", p)
+	if m.NewListBigSwitchBcfDevicesPagerFunc != nil {
+		return m.NewListBigSwitchBcfDevicesPagerFunc(p)
+	}
+	return
+}
+
+// ListBigSwitchBcfDevicesAll records the call and calls ListBigSwitchBcfDevicesAllFunc, or ListBigSwitchBcfDevicesAllWithContextFunc if only that is set
+func (m *MockBigSwitchBCFService) ListBigSwitchBcfDevicesAll(p *cloudstack.ListBigSwitchBcfDevicesParams) (r0 *cloudstack.ListBigSwitchBcfDevicesResponse, r1 error) {
+	m.record("ListBigSwitchBcfDevicesAll", p)
+	if m.ListBigSwitchBcfDevicesAllFunc != nil {
+		return m.ListBigSwitchBcfDevicesAllFunc(p)
+	}
+	if m.ListBigSwitchBcfDevicesAllWithContextFunc != nil {
+		return m.ListBigSwitchBcfDevicesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BigSwitchBCFService.ListBigSwitchBcfDevicesAll")
+	return
+}
+
+// ListBigSwitchBcfDevicesAllWithContext records the call and calls ListBigSwitchBcfDevicesAllWithContextFunc, or ListBigSwitchBcfDevicesAllFunc if only that is set
+func (m *MockBigSwitchBCFService) ListBigSwitchBcfDevicesAllWithContext(ctx context.Context, p *cloudstack.ListBigSwitchBcfDevicesParams) (r0 *cloudstack.ListBigSwitchBcfDevicesResponse, r1 error) {
+	m.record("ListBigSwitchBcfDevicesAllWithContext", ctx, p)
+	if m.ListBigSwitchBcfDevicesAllWithContextFunc != nil {
+		return m.ListBigSwitchBcfDevicesAllWithContextFunc(ctx, p)
+	}
+	if m.ListBigSwitchBcfDevicesAllFunc != nil {
+		return m.ListBigSwitchBcfDevicesAllFunc(p)
+	}
+	r1 = notMocked("BigSwitchBCFService.ListBigSwitchBcfDevicesAllWithContext")
+	return
+}
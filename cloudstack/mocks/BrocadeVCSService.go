@@ -0,0 +1,351 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockBrocadeVCSService is a mock of cloudstack.BrocadeVCSServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockBrocadeVCSService struct {
+	Recorder
+
+	NewAddBrocadeVcsDeviceParamsFunc               func(hostname string, password string, physicalnetworkid string, username string) *cloudstack.AddBrocadeVcsDeviceParams
+	AddBrocadeVcsDeviceFunc                        func(p *cloudstack.AddBrocadeVcsDeviceParams) (*cloudstack.AddBrocadeVcsDeviceResponse, error)
+	AddBrocadeVcsDeviceWithContextFunc             func(ctx context.Context, p *cloudstack.AddBrocadeVcsDeviceParams) (*cloudstack.AddBrocadeVcsDeviceResponse, error)
+	AddBrocadeVcsDeviceAsyncFunc                   func(p *cloudstack.AddBrocadeVcsDeviceParams) (*cloudstack.Job, error)
+	AddBrocadeVcsDeviceAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.AddBrocadeVcsDeviceParams) (*cloudstack.Job, error)
+	NewDeleteBrocadeVcsDeviceParamsFunc            func(vcsdeviceid string) *cloudstack.DeleteBrocadeVcsDeviceParams
+	DeleteBrocadeVcsDeviceFunc                     func(p *cloudstack.DeleteBrocadeVcsDeviceParams) (*cloudstack.DeleteBrocadeVcsDeviceResponse, error)
+	DeleteBrocadeVcsDeviceWithContextFunc          func(ctx context.Context, p *cloudstack.DeleteBrocadeVcsDeviceParams) (*cloudstack.DeleteBrocadeVcsDeviceResponse, error)
+	DeleteBrocadeVcsDeviceAsyncFunc                func(p *cloudstack.DeleteBrocadeVcsDeviceParams) (*cloudstack.Job, error)
+	DeleteBrocadeVcsDeviceAsyncWithContextFunc     func(ctx context.Context, p *cloudstack.DeleteBrocadeVcsDeviceParams) (*cloudstack.Job, error)
+	NewListBrocadeVcsDeviceNetworksParamsFunc      func(vcsdeviceid string) *cloudstack.ListBrocadeVcsDeviceNetworksParams
+	GetBrocadeVcsDeviceNetworkIDFunc               func(keyword string, vcsdeviceid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetBrocadeVcsDeviceNetworkIDWithContextFunc    func(ctx context.Context, keyword string, vcsdeviceid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListBrocadeVcsDeviceNetworksFunc               func(p *cloudstack.ListBrocadeVcsDeviceNetworksParams) (*cloudstack.ListBrocadeVcsDeviceNetworksResponse, error)
+	ListBrocadeVcsDeviceNetworksWithContextFunc    func(ctx context.Context, p *cloudstack.ListBrocadeVcsDeviceNetworksParams) (*cloudstack.ListBrocadeVcsDeviceNetworksResponse, error)
+	NewListBrocadeVcsDeviceNetworksPagerFunc       func(p *cloudstack.ListBrocadeVcsDeviceNetworksParams) *cloudstack.ListBrocadeVcsDeviceNetworksPager
+	ListBrocadeVcsDeviceNetworksAllFunc            func(p *cloudstack.ListBrocadeVcsDeviceNetworksParams) (*cloudstack.ListBrocadeVcsDeviceNetworksResponse, error)
+	ListBrocadeVcsDeviceNetworksAllWithContextFunc func(ctx context.Context, p *cloudstack.ListBrocadeVcsDeviceNetworksParams) (*cloudstack.ListBrocadeVcsDeviceNetworksResponse, error)
+	NewListBrocadeVcsDevicesParamsFunc             func() *cloudstack.ListBrocadeVcsDevicesParams
+	ListBrocadeVcsDevicesFunc                      func(p *cloudstack.ListBrocadeVcsDevicesParams) (*cloudstack.ListBrocadeVcsDevicesResponse, error)
+	ListBrocadeVcsDevicesWithContextFunc           func(ctx context.Context, p *cloudstack.ListBrocadeVcsDevicesParams) (*cloudstack.ListBrocadeVcsDevicesResponse, error)
+	NewListBrocadeVcsDevicesPagerFunc              func(p *cloudstack.ListBrocadeVcsDevicesParams) *cloudstack.ListBrocadeVcsDevicesPager
+	ListBrocadeVcsDevicesAllFunc                   func(p *cloudstack.ListBrocadeVcsDevicesParams) (*cloudstack.ListBrocadeVcsDevicesResponse, error)
+	ListBrocadeVcsDevicesAllWithContextFunc        func(ctx context.Context, p *cloudstack.ListBrocadeVcsDevicesParams) (*cloudstack.ListBrocadeVcsDevicesResponse, error)
+}
+
+var _ cloudstack.BrocadeVCSServiceIface = (*MockBrocadeVCSService)(nil)
+
+// NewAddBrocadeVcsDeviceParams records the call and calls NewAddBrocadeVcsDeviceParamsFunc
+func (m *MockBrocadeVCSService) NewAddBrocadeVcsDeviceParams(hostname string, password string, physicalnetworkid string, username string) (r0 *cloudstack.AddBrocadeVcsDeviceParams) {
+	m.record("NewAddBrocadeVcsDeviceParams", hostname, password, physicalnetworkid, username)
+	if m.NewAddBrocadeVcsDeviceParamsFunc != nil {
+		return m.NewAddBrocadeVcsDeviceParamsFunc(hostname, password, physicalnetworkid, username)
+	}
+	return cloudstack.NewBrocadeVCSService(nil).NewAddBrocadeVcsDeviceParams(hostname, password, physicalnetworkid, username)
+}
+
+// AddBrocadeVcsDevice records the call and calls AddBrocadeVcsDeviceFunc, or AddBrocadeVcsDeviceWithContextFunc if only that is set
+func (m *MockBrocadeVCSService) AddBrocadeVcsDevice(p *cloudstack.AddBrocadeVcsDeviceParams) (r0 *cloudstack.AddBrocadeVcsDeviceResponse, r1 error) {
+	m.record("AddBrocadeVcsDevice", p)
+	if m.AddBrocadeVcsDeviceFunc != nil {
+		return m.AddBrocadeVcsDeviceFunc(p)
+	}
+	if m.AddBrocadeVcsDeviceWithContextFunc != nil {
+		return m.AddBrocadeVcsDeviceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BrocadeVCSService.AddBrocadeVcsDevice")
+	return
+}
+
+// AddBrocadeVcsDeviceWithContext records the call and calls AddBrocadeVcsDeviceWithContextFunc, or AddBrocadeVcsDeviceFunc if only that is set
+func (m *MockBrocadeVCSService) AddBrocadeVcsDeviceWithContext(ctx context.Context, p *cloudstack.AddBrocadeVcsDeviceParams) (r0 *cloudstack.AddBrocadeVcsDeviceResponse, r1 error) {
+	m.record("AddBrocadeVcsDeviceWithContext", ctx, p)
+	if m.AddBrocadeVcsDeviceWithContextFunc != nil {
+		return m.AddBrocadeVcsDeviceWithContextFunc(ctx, p)
+	}
+	if m.AddBrocadeVcsDeviceFunc != nil {
+		return m.AddBrocadeVcsDeviceFunc(p)
+	}
+	r1 = notMocked("BrocadeVCSService.AddBrocadeVcsDeviceWithContext")
+	return
+}
+
+// AddBrocadeVcsDeviceAsync records the call and calls AddBrocadeVcsDeviceAsyncFunc, or AddBrocadeVcsDeviceAsyncWithContextFunc if only that is set
+func (m *MockBrocadeVCSService) AddBrocadeVcsDeviceAsync(p *cloudstack.AddBrocadeVcsDeviceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddBrocadeVcsDeviceAsync", p)
+	if m.AddBrocadeVcsDeviceAsyncFunc != nil {
+		return m.AddBrocadeVcsDeviceAsyncFunc(p)
+	}
+	if m.AddBrocadeVcsDeviceAsyncWithContextFunc != nil {
+		return m.AddBrocadeVcsDeviceAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BrocadeVCSService.AddBrocadeVcsDeviceAsync")
+	return
+}
+
+// AddBrocadeVcsDeviceAsyncWithContext records the call and calls AddBrocadeVcsDeviceAsyncWithContextFunc, or AddBrocadeVcsDeviceAsyncFunc if only that is set
+func (m *MockBrocadeVCSService) AddBrocadeVcsDeviceAsyncWithContext(ctx context.Context, p *cloudstack.AddBrocadeVcsDeviceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddBrocadeVcsDeviceAsyncWithContext", ctx, p)
+	if m.AddBrocadeVcsDeviceAsyncWithContextFunc != nil {
+		return m.AddBrocadeVcsDeviceAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddBrocadeVcsDeviceAsyncFunc != nil {
+		return m.AddBrocadeVcsDeviceAsyncFunc(p)
+	}
+	r1 = notMocked("BrocadeVCSService.AddBrocadeVcsDeviceAsyncWithContext")
+	return
+}
+
+// NewDeleteBrocadeVcsDeviceParams records the call and calls NewDeleteBrocadeVcsDeviceParamsFunc
+func (m *MockBrocadeVCSService) NewDeleteBrocadeVcsDeviceParams(vcsdeviceid string) (r0 *cloudstack.DeleteBrocadeVcsDeviceParams) {
+	m.record("NewDeleteBrocadeVcsDeviceParams", vcsdeviceid)
+	if m.NewDeleteBrocadeVcsDeviceParamsFunc != nil {
+		return m.NewDeleteBrocadeVcsDeviceParamsFunc(vcsdeviceid)
+	}
+	return cloudstack.NewBrocadeVCSService(nil).NewDeleteBrocadeVcsDeviceParams(vcsdeviceid)
+}
+
+// DeleteBrocadeVcsDevice records the call and calls DeleteBrocadeVcsDeviceFunc, or DeleteBrocadeVcsDeviceWithContextFunc if only that is set
+func (m *MockBrocadeVCSService) DeleteBrocadeVcsDevice(p *cloudstack.DeleteBrocadeVcsDeviceParams) (r0 *cloudstack.DeleteBrocadeVcsDeviceResponse, r1 error) {
+	m.record("DeleteBrocadeVcsDevice", p)
+	if m.DeleteBrocadeVcsDeviceFunc != nil {
+		return m.DeleteBrocadeVcsDeviceFunc(p)
+	}
+	if m.DeleteBrocadeVcsDeviceWithContextFunc != nil {
+		return m.DeleteBrocadeVcsDeviceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BrocadeVCSService.DeleteBrocadeVcsDevice")
+	return
+}
+
+// DeleteBrocadeVcsDeviceWithContext records the call and calls DeleteBrocadeVcsDeviceWithContextFunc, or DeleteBrocadeVcsDeviceFunc if only that is set
+func (m *MockBrocadeVCSService) DeleteBrocadeVcsDeviceWithContext(ctx context.Context, p *cloudstack.DeleteBrocadeVcsDeviceParams) (r0 *cloudstack.DeleteBrocadeVcsDeviceResponse, r1 error) {
+	m.record("DeleteBrocadeVcsDeviceWithContext", ctx, p)
+	if m.DeleteBrocadeVcsDeviceWithContextFunc != nil {
+		return m.DeleteBrocadeVcsDeviceWithContextFunc(ctx, p)
+	}
+	if m.DeleteBrocadeVcsDeviceFunc != nil {
+		return m.DeleteBrocadeVcsDeviceFunc(p)
+	}
+	r1 = notMocked("BrocadeVCSService.DeleteBrocadeVcsDeviceWithContext")
+	return
+}
+
+// DeleteBrocadeVcsDeviceAsync records the call and calls DeleteBrocadeVcsDeviceAsyncFunc, or DeleteBrocadeVcsDeviceAsyncWithContextFunc if only that is set
+func (m *MockBrocadeVCSService) DeleteBrocadeVcsDeviceAsync(p *cloudstack.DeleteBrocadeVcsDeviceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteBrocadeVcsDeviceAsync", p)
+	if m.DeleteBrocadeVcsDeviceAsyncFunc != nil {
+		return m.DeleteBrocadeVcsDeviceAsyncFunc(p)
+	}
+	if m.DeleteBrocadeVcsDeviceAsyncWithContextFunc != nil {
+		return m.DeleteBrocadeVcsDeviceAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BrocadeVCSService.DeleteBrocadeVcsDeviceAsync")
+	return
+}
+
+// DeleteBrocadeVcsDeviceAsyncWithContext records the call and calls DeleteBrocadeVcsDeviceAsyncWithContextFunc, or DeleteBrocadeVcsDeviceAsyncFunc if only that is set
+func (m *MockBrocadeVCSService) DeleteBrocadeVcsDeviceAsyncWithContext(ctx context.Context, p *cloudstack.DeleteBrocadeVcsDeviceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteBrocadeVcsDeviceAsyncWithContext", ctx, p)
+	if m.DeleteBrocadeVcsDeviceAsyncWithContextFunc != nil {
+		return m.DeleteBrocadeVcsDeviceAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteBrocadeVcsDeviceAsyncFunc != nil {
+		return m.DeleteBrocadeVcsDeviceAsyncFunc(p)
+	}
+	r1 = notMocked("BrocadeVCSService.DeleteBrocadeVcsDeviceAsyncWithContext")
+	return
+}
+
+// NewListBrocadeVcsDeviceNetworksParams records the call and calls NewListBrocadeVcsDeviceNetworksParamsFunc
+func (m *MockBrocadeVCSService) NewListBrocadeVcsDeviceNetworksParams(vcsdeviceid string) (r0 *cloudstack.ListBrocadeVcsDeviceNetworksParams) {
+	m.record("NewListBrocadeVcsDeviceNetworksParams", vcsdeviceid)
+	if m.NewListBrocadeVcsDeviceNetworksParamsFunc != nil {
+		return m.NewListBrocadeVcsDeviceNetworksParamsFunc(vcsdeviceid)
+	}
+	return cloudstack.NewBrocadeVCSService(nil).NewListBrocadeVcsDeviceNetworksParams(vcsdeviceid)
+}
+
+// GetBrocadeVcsDeviceNetworkID records the call and calls GetBrocadeVcsDeviceNetworkIDFunc, or GetBrocadeVcsDeviceNetworkIDWithContextFunc if only that is set
+func (m *MockBrocadeVCSService) GetBrocadeVcsDeviceNetworkID(keyword string, vcsdeviceid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetBrocadeVcsDeviceNetworkID", keyword, vcsdeviceid, opts)
+	if m.GetBrocadeVcsDeviceNetworkIDFunc != nil {
+		return m.GetBrocadeVcsDeviceNetworkIDFunc(keyword, vcsdeviceid, opts...)
+	}
+	if m.GetBrocadeVcsDeviceNetworkIDWithContextFunc != nil {
+		return m.GetBrocadeVcsDeviceNetworkIDWithContextFunc(context.Background(), keyword, vcsdeviceid, opts...)
+	}
+	r2 = notMocked("BrocadeVCSService.GetBrocadeVcsDeviceNetworkID")
+	return
+}
+
+// GetBrocadeVcsDeviceNetworkIDWithContext records the call and calls GetBrocadeVcsDeviceNetworkIDWithContextFunc, or GetBrocadeVcsDeviceNetworkIDFunc if only that is set
+func (m *MockBrocadeVCSService) GetBrocadeVcsDeviceNetworkIDWithContext(ctx context.Context, keyword string, vcsdeviceid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetBrocadeVcsDeviceNetworkIDWithContext", ctx, keyword, vcsdeviceid, opts)
+	if m.GetBrocadeVcsDeviceNetworkIDWithContextFunc != nil {
+		return m.GetBrocadeVcsDeviceNetworkIDWithContextFunc(ctx, keyword, vcsdeviceid, opts...)
+	}
+	if m.GetBrocadeVcsDeviceNetworkIDFunc != nil {
+		return m.GetBrocadeVcsDeviceNetworkIDFunc(keyword, vcsdeviceid, opts...)
+	}
+	r2 = notMocked("BrocadeVCSService.GetBrocadeVcsDeviceNetworkIDWithContext")
+	return
+}
+
+// ListBrocadeVcsDeviceNetworks records the call and calls ListBrocadeVcsDeviceNetworksFunc, or ListBrocadeVcsDeviceNetworksWithContextFunc if only that is set
+func (m *MockBrocadeVCSService) ListBrocadeVcsDeviceNetworks(p *cloudstack.ListBrocadeVcsDeviceNetworksParams) (r0 *cloudstack.ListBrocadeVcsDeviceNetworksResponse, r1 error) {
+	m.record("ListBrocadeVcsDeviceNetworks", p)
+	if m.ListBrocadeVcsDeviceNetworksFunc != nil {
+		return m.ListBrocadeVcsDeviceNetworksFunc(p)
+	}
+	if m.ListBrocadeVcsDeviceNetworksWithContextFunc != nil {
+		return m.ListBrocadeVcsDeviceNetworksWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BrocadeVCSService.ListBrocadeVcsDeviceNetworks")
+	return
+}
+
+// ListBrocadeVcsDeviceNetworksWithContext records the call and calls ListBrocadeVcsDeviceNetworksWithContextFunc, or ListBrocadeVcsDeviceNetworksFunc if only that is set
+func (m *MockBrocadeVCSService) ListBrocadeVcsDeviceNetworksWithContext(ctx context.Context, p *cloudstack.ListBrocadeVcsDeviceNetworksParams) (r0 *cloudstack.ListBrocadeVcsDeviceNetworksResponse, r1 error) {
+	m.record("ListBrocadeVcsDeviceNetworksWithContext", ctx, p)
+	if m.ListBrocadeVcsDeviceNetworksWithContextFunc != nil {
+		return m.ListBrocadeVcsDeviceNetworksWithContextFunc(ctx, p)
+	}
+	if m.ListBrocadeVcsDeviceNetworksFunc != nil {
+		return m.ListBrocadeVcsDeviceNetworksFunc(p)
+	}
+	r1 = notMocked("BrocadeVCSService.ListBrocadeVcsDeviceNetworksWithContext")
+	return
+}
+
+// NewListBrocadeVcsDeviceNetworksPager records the call and calls NewListBrocadeVcsDeviceNetworksPagerFunc
+func (m *MockBrocadeVCSService) NewListBrocadeVcsDeviceNetworksPager(p *cloudstack.ListBrocadeVcsDeviceNetworksParams) (r0 *cloudstack.ListBrocadeVcsDeviceNetworksPager) {
+	m.record("NewListBrocadeVcsDeviceNetworksPager", p)
+	if m.NewListBrocadeVcsDeviceNetworksPagerFunc != nil {
+		return m.NewListBrocadeVcsDeviceNetworksPagerFunc(p)
+	}
+	return
+}
+
+// ListBrocadeVcsDeviceNetworksAll records the call and calls ListBrocadeVcsDeviceNetworksAllFunc, or ListBrocadeVcsDeviceNetworksAllWithContextFunc if only that is set
+func (m *MockBrocadeVCSService) ListBrocadeVcsDeviceNetworksAll(p *cloudstack.ListBrocadeVcsDeviceNetworksParams) (r0 *cloudstack.ListBrocadeVcsDeviceNetworksResponse, r1 error) {
+	m.record("ListBrocadeVcsDeviceNetworksAll", p)
+	if m.ListBrocadeVcsDeviceNetworksAllFunc != nil {
+		return m.ListBrocadeVcsDeviceNetworksAllFunc(p)
+	}
+	if m.ListBrocadeVcsDeviceNetworksAllWithContextFunc != nil {
+		return m.ListBrocadeVcsDeviceNetworksAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BrocadeVCSService.ListBrocadeVcsDeviceNetworksAll")
+	return
+}
+
+// ListBrocadeVcsDeviceNetworksAllWithContext records the call and calls ListBrocadeVcsDeviceNetworksAllWithContextFunc, or ListBrocadeVcsDeviceNetworksAllFunc if only that is set
+func (m *MockBrocadeVCSService) ListBrocadeVcsDeviceNetworksAllWithContext(ctx context.Context, p *cloudstack.ListBrocadeVcsDeviceNetworksParams) (r0 *cloudstack.ListBrocadeVcsDeviceNetworksResponse, r1 error) {
+	m.record("ListBrocadeVcsDeviceNetworksAllWithContext", ctx, p)
+	if m.ListBrocadeVcsDeviceNetworksAllWithContextFunc != nil {
+		return m.ListBrocadeVcsDeviceNetworksAllWithContextFunc(ctx, p)
+	}
+	if m.ListBrocadeVcsDeviceNetworksAllFunc != nil {
+		return m.ListBrocadeVcsDeviceNetworksAllFunc(p)
+	}
+	r1 = notMocked("BrocadeVCSService.ListBrocadeVcsDeviceNetworksAllWithContext")
+	return
+}
+
+// NewListBrocadeVcsDevicesParams records the call and calls NewListBrocadeVcsDevicesParamsFunc
+func (m *MockBrocadeVCSService) NewListBrocadeVcsDevicesParams() (r0 *cloudstack.ListBrocadeVcsDevicesParams) {
+	m.record("NewListBrocadeVcsDevicesParams")
+	if m.NewListBrocadeVcsDevicesParamsFunc != nil {
+		return m.NewListBrocadeVcsDevicesParamsFunc()
+	}
+	return cloudstack.NewBrocadeVCSService(nil).NewListBrocadeVcsDevicesParams()
+}
+
+// ListBrocadeVcsDevices records the call and calls ListBrocadeVcsDevicesFunc, or ListBrocadeVcsDevicesWithContextFunc if only that is set
+func (m *MockBrocadeVCSService) ListBrocadeVcsDevices(p *cloudstack.ListBrocadeVcsDevicesParams) (r0 *cloudstack.ListBrocadeVcsDevicesResponse, r1 error) {
+	m.record("ListBrocadeVcsDevices", p)
+	if m.ListBrocadeVcsDevicesFunc != nil {
+		return m.ListBrocadeVcsDevicesFunc(p)
+	}
+	if m.ListBrocadeVcsDevicesWithContextFunc != nil {
+		return m.ListBrocadeVcsDevicesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BrocadeVCSService.ListBrocadeVcsDevices")
+	return
+}
+
+// ListBrocadeVcsDevicesWithContext records the call and calls ListBrocadeVcsDevicesWithContextFunc, or ListBrocadeVcsDevicesFunc if only that is set
+func (m *MockBrocadeVCSService) ListBrocadeVcsDevicesWithContext(ctx context.Context, p *cloudstack.ListBrocadeVcsDevicesParams) (r0 *cloudstack.ListBrocadeVcsDevicesResponse, r1 error) {
+	m.record("ListBrocadeVcsDevicesWithContext", ctx, p)
+	if m.ListBrocadeVcsDevicesWithContextFunc != nil {
+		return m.ListBrocadeVcsDevicesWithContextFunc(ctx, p)
+	}
+	if m.ListBrocadeVcsDevicesFunc != nil {
+		return m.ListBrocadeVcsDevicesFunc(p)
+	}
+	r1 = notMocked("BrocadeVCSService.ListBrocadeVcsDevicesWithContext")
+	return
+}
+
+// NewListBrocadeVcsDevicesPager records the call and calls NewListBrocadeVcsDevicesPagerFunc
+func (m *MockBrocadeVCSService) NewListBrocadeVcsDevicesPager(p *cloudstack.ListBrocadeVcsDevicesParams) (r0 *cloudstack.ListBrocadeVcsDevicesPager) {
+	m.record("NewListBrocadeVcsDevicesPager", p)
+	if m.NewListBrocadeVcsDevicesPagerFunc != nil {
+		return m.NewListBrocadeVcsDevicesPagerFunc(p)
+	}
+	return
+}
+
+// ListBrocadeVcsDevicesAll records the call and calls ListBrocadeVcsDevicesAllFunc, or ListBrocadeVcsDevicesAllWithContextFunc if only that is set
+func (m *MockBrocadeVCSService) ListBrocadeVcsDevicesAll(p *cloudstack.ListBrocadeVcsDevicesParams) (r0 *cloudstack.ListBrocadeVcsDevicesResponse, r1 error) {
+	m.record("ListBrocadeVcsDevicesAll", p)
+	if m.ListBrocadeVcsDevicesAllFunc != nil {
+		return m.ListBrocadeVcsDevicesAllFunc(p)
+	}
+	if m.ListBrocadeVcsDevicesAllWithContextFunc != nil {
+		return m.ListBrocadeVcsDevicesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("BrocadeVCSService.ListBrocadeVcsDevicesAll")
+	return
+}
+
+// ListBrocadeVcsDevicesAllWithContext records the call and calls ListBrocadeVcsDevicesAllWithContextFunc, or ListBrocadeVcsDevicesAllFunc if only that is set
+func (m *MockBrocadeVCSService) ListBrocadeVcsDevicesAllWithContext(ctx context.Context, p *cloudstack.ListBrocadeVcsDevicesParams) (r0 *cloudstack.ListBrocadeVcsDevicesResponse, r1 error) {
+	m.record("ListBrocadeVcsDevicesAllWithContext", ctx, p)
+	if m.ListBrocadeVcsDevicesAllWithContextFunc != nil {
+		return m.ListBrocadeVcsDevicesAllWithContextFunc(ctx, p)
+	}
+	if m.ListBrocadeVcsDevicesAllFunc != nil {
+		return m.ListBrocadeVcsDevicesAllFunc(p)
+	}
+	r1 = notMocked("BrocadeVCSService.ListBrocadeVcsDevicesAllWithContext")
+	return
+}
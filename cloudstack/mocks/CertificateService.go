@@ -0,0 +1,455 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockCertificateService is a mock of cloudstack.CertificateServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockCertificateService struct {
+	Recorder
+
+	NewIssueCertificateParamsFunc                          func() *cloudstack.IssueCertificateParams
+	IssueCertificateFunc                                   func(p *cloudstack.IssueCertificateParams) (*cloudstack.IssueCertificateResponse, error)
+	IssueCertificateWithContextFunc                        func(ctx context.Context, p *cloudstack.IssueCertificateParams) (*cloudstack.IssueCertificateResponse, error)
+	IssueCertificateAsyncFunc                              func(p *cloudstack.IssueCertificateParams) (*cloudstack.Job, error)
+	IssueCertificateAsyncWithContextFunc                   func(ctx context.Context, p *cloudstack.IssueCertificateParams) (*cloudstack.Job, error)
+	NewListCAProvidersParamsFunc                           func() *cloudstack.ListCAProvidersParams
+	ListCAProvidersFunc                                    func(p *cloudstack.ListCAProvidersParams) (*cloudstack.ListCAProvidersResponse, error)
+	ListCAProvidersWithContextFunc                         func(ctx context.Context, p *cloudstack.ListCAProvidersParams) (*cloudstack.ListCAProvidersResponse, error)
+	NewListCaCertificateParamsFunc                         func() *cloudstack.ListCaCertificateParams
+	ListCaCertificateFunc                                  func(p *cloudstack.ListCaCertificateParams) (*cloudstack.ListCaCertificateResponse, error)
+	ListCaCertificateWithContextFunc                       func(ctx context.Context, p *cloudstack.ListCaCertificateParams) (*cloudstack.ListCaCertificateResponse, error)
+	NewProvisionCertificateParamsFunc                      func(hostid string) *cloudstack.ProvisionCertificateParams
+	ProvisionCertificateFunc                               func(p *cloudstack.ProvisionCertificateParams) (*cloudstack.ProvisionCertificateResponse, error)
+	ProvisionCertificateWithContextFunc                    func(ctx context.Context, p *cloudstack.ProvisionCertificateParams) (*cloudstack.ProvisionCertificateResponse, error)
+	ProvisionCertificateAsyncFunc                          func(p *cloudstack.ProvisionCertificateParams) (*cloudstack.Job, error)
+	ProvisionCertificateAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.ProvisionCertificateParams) (*cloudstack.Job, error)
+	NewRevokeCertificateParamsFunc                         func(serial string) *cloudstack.RevokeCertificateParams
+	RevokeCertificateFunc                                  func(p *cloudstack.RevokeCertificateParams) (*cloudstack.RevokeCertificateResponse, error)
+	RevokeCertificateWithContextFunc                       func(ctx context.Context, p *cloudstack.RevokeCertificateParams) (*cloudstack.RevokeCertificateResponse, error)
+	RevokeCertificateAsyncFunc                             func(p *cloudstack.RevokeCertificateParams) (*cloudstack.Job, error)
+	RevokeCertificateAsyncWithContextFunc                  func(ctx context.Context, p *cloudstack.RevokeCertificateParams) (*cloudstack.Job, error)
+	NewRevokeTemplateDirectDownloadCertificateParamsFunc   func(hypervisor string, name string, zoneid string) *cloudstack.RevokeTemplateDirectDownloadCertificateParams
+	RevokeTemplateDirectDownloadCertificateFunc            func(p *cloudstack.RevokeTemplateDirectDownloadCertificateParams) (*cloudstack.RevokeTemplateDirectDownloadCertificateResponse, error)
+	RevokeTemplateDirectDownloadCertificateWithContextFunc func(ctx context.Context, p *cloudstack.RevokeTemplateDirectDownloadCertificateParams) (*cloudstack.RevokeTemplateDirectDownloadCertificateResponse, error)
+	NewUploadCustomCertificateParamsFunc                   func(certificate string, domainsuffix string) *cloudstack.UploadCustomCertificateParams
+	UploadCustomCertificateFunc                            func(p *cloudstack.UploadCustomCertificateParams) (*cloudstack.UploadCustomCertificateResponse, error)
+	UploadCustomCertificateWithContextFunc                 func(ctx context.Context, p *cloudstack.UploadCustomCertificateParams) (*cloudstack.UploadCustomCertificateResponse, error)
+	UploadCustomCertificateAsyncFunc                       func(p *cloudstack.UploadCustomCertificateParams) (*cloudstack.Job, error)
+	UploadCustomCertificateAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.UploadCustomCertificateParams) (*cloudstack.Job, error)
+	NewUploadTemplateDirectDownloadCertificateParamsFunc   func(certificate string, hypervisor string, name string, zoneid string) *cloudstack.UploadTemplateDirectDownloadCertificateParams
+	UploadTemplateDirectDownloadCertificateFunc            func(p *cloudstack.UploadTemplateDirectDownloadCertificateParams) (*cloudstack.UploadTemplateDirectDownloadCertificateResponse, error)
+	UploadTemplateDirectDownloadCertificateWithContextFunc func(ctx context.Context, p *cloudstack.UploadTemplateDirectDownloadCertificateParams) (*cloudstack.UploadTemplateDirectDownloadCertificateResponse, error)
+}
+
+var _ cloudstack.CertificateServiceIface = (*MockCertificateService)(nil)
+
+// NewIssueCertificateParams records the call and calls NewIssueCertificateParamsFunc
+func (m *MockCertificateService) NewIssueCertificateParams() (r0 *cloudstack.IssueCertificateParams) {
+	m.record("NewIssueCertificateParams")
+	if m.NewIssueCertificateParamsFunc != nil {
+		return m.NewIssueCertificateParamsFunc()
+	}
+	return cloudstack.NewCertificateService(nil).NewIssueCertificateParams()
+}
+
+// IssueCertificate records the call and calls IssueCertificateFunc, or IssueCertificateWithContextFunc if only that is set
+func (m *MockCertificateService) IssueCertificate(p *cloudstack.IssueCertificateParams) (r0 *cloudstack.IssueCertificateResponse, r1 error) {
+	m.record("IssueCertificate", p)
+	if m.IssueCertificateFunc != nil {
+		return m.IssueCertificateFunc(p)
+	}
+	if m.IssueCertificateWithContextFunc != nil {
+		return m.IssueCertificateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("CertificateService.IssueCertificate")
+	return
+}
+
+// IssueCertificateWithContext records the call and calls IssueCertificateWithContextFunc, or IssueCertificateFunc if only that is set
+func (m *MockCertificateService) IssueCertificateWithContext(ctx context.Context, p *cloudstack.IssueCertificateParams) (r0 *cloudstack.IssueCertificateResponse, r1 error) {
+	m.record("IssueCertificateWithContext", ctx, p)
+	if m.IssueCertificateWithContextFunc != nil {
+		return m.IssueCertificateWithContextFunc(ctx, p)
+	}
+	if m.IssueCertificateFunc != nil {
+		return m.IssueCertificateFunc(p)
+	}
+	r1 = notMocked("CertificateService.IssueCertificateWithContext")
+	return
+}
+
+// IssueCertificateAsync records the call and calls IssueCertificateAsyncFunc, or IssueCertificateAsyncWithContextFunc if only that is set
+func (m *MockCertificateService) IssueCertificateAsync(p *cloudstack.IssueCertificateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("IssueCertificateAsync", p)
+	if m.IssueCertificateAsyncFunc != nil {
+		return m.IssueCertificateAsyncFunc(p)
+	}
+	if m.IssueCertificateAsyncWithContextFunc != nil {
+		return m.IssueCertificateAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("CertificateService.IssueCertificateAsync")
+	return
+}
+
+// IssueCertificateAsyncWithContext records the call and calls IssueCertificateAsyncWithContextFunc, or IssueCertificateAsyncFunc if only that is set
+func (m *MockCertificateService) IssueCertificateAsyncWithContext(ctx context.Context, p *cloudstack.IssueCertificateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("IssueCertificateAsyncWithContext", ctx, p)
+	if m.IssueCertificateAsyncWithContextFunc != nil {
+		return m.IssueCertificateAsyncWithContextFunc(ctx, p)
+	}
+	if m.IssueCertificateAsyncFunc != nil {
+		return m.IssueCertificateAsyncFunc(p)
+	}
+	r1 = notMocked("CertificateService.IssueCertificateAsyncWithContext")
+	return
+}
+
+// NewListCAProvidersParams records the call and calls NewListCAProvidersParamsFunc
+func (m *MockCertificateService) NewListCAProvidersParams() (r0 *cloudstack.ListCAProvidersParams) {
+	m.record("NewListCAProvidersParams")
+	if m.NewListCAProvidersParamsFunc != nil {
+		return m.NewListCAProvidersParamsFunc()
+	}
+	return cloudstack.NewCertificateService(nil).NewListCAProvidersParams()
+}
+
+// ListCAProviders records the call and calls ListCAProvidersFunc, or ListCAProvidersWithContextFunc if only that is set
+func (m *MockCertificateService) ListCAProviders(p *cloudstack.ListCAProvidersParams) (r0 *cloudstack.ListCAProvidersResponse, r1 error) {
+	m.record("ListCAProviders", p)
+	if m.ListCAProvidersFunc != nil {
+		return m.ListCAProvidersFunc(p)
+	}
+	if m.ListCAProvidersWithContextFunc != nil {
+		return m.ListCAProvidersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("CertificateService.ListCAProviders")
+	return
+}
+
+// ListCAProvidersWithContext records the call and calls ListCAProvidersWithContextFunc, or ListCAProvidersFunc if only that is set
+func (m *MockCertificateService) ListCAProvidersWithContext(ctx context.Context, p *cloudstack.ListCAProvidersParams) (r0 *cloudstack.ListCAProvidersResponse, r1 error) {
+	m.record("ListCAProvidersWithContext", ctx, p)
+	if m.ListCAProvidersWithContextFunc != nil {
+		return m.ListCAProvidersWithContextFunc(ctx, p)
+	}
+	if m.ListCAProvidersFunc != nil {
+		return m.ListCAProvidersFunc(p)
+	}
+	r1 = notMocked("CertificateService.ListCAProvidersWithContext")
+	return
+}
+
+// NewListCaCertificateParams records the call and calls NewListCaCertificateParamsFunc
+func (m *MockCertificateService) NewListCaCertificateParams() (r0 *cloudstack.ListCaCertificateParams) {
+	m.record("NewListCaCertificateParams")
+	if m.NewListCaCertificateParamsFunc != nil {
+		return m.NewListCaCertificateParamsFunc()
+	}
+	return cloudstack.NewCertificateService(nil).NewListCaCertificateParams()
+}
+
+// ListCaCertificate records the call and calls ListCaCertificateFunc, or ListCaCertificateWithContextFunc if only that is set
+func (m *MockCertificateService) ListCaCertificate(p *cloudstack.ListCaCertificateParams) (r0 *cloudstack.ListCaCertificateResponse, r1 error) {
+	m.record("ListCaCertificate", p)
+	if m.ListCaCertificateFunc != nil {
+		return m.ListCaCertificateFunc(p)
+	}
+	if m.ListCaCertificateWithContextFunc != nil {
+		return m.ListCaCertificateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("CertificateService.ListCaCertificate")
+	return
+}
+
+// ListCaCertificateWithContext records the call and calls ListCaCertificateWithContextFunc, or ListCaCertificateFunc if only that is set
+func (m *MockCertificateService) ListCaCertificateWithContext(ctx context.Context, p *cloudstack.ListCaCertificateParams) (r0 *cloudstack.ListCaCertificateResponse, r1 error) {
+	m.record("ListCaCertificateWithContext", ctx, p)
+	if m.ListCaCertificateWithContextFunc != nil {
+		return m.ListCaCertificateWithContextFunc(ctx, p)
+	}
+	if m.ListCaCertificateFunc != nil {
+		return m.ListCaCertificateFunc(p)
+	}
+	r1 = notMocked("CertificateService.ListCaCertificateWithContext")
+	return
+}
+
+// NewProvisionCertificateParams records the call and calls NewProvisionCertificateParamsFunc
+func (m *MockCertificateService) NewProvisionCertificateParams(hostid string) (r0 *cloudstack.ProvisionCertificateParams) {
+	m.record("NewProvisionCertificateParams", hostid)
+	if m.NewProvisionCertificateParamsFunc != nil {
+		return m.NewProvisionCertificateParamsFunc(hostid)
+	}
+	return cloudstack.NewCertificateService(nil).NewProvisionCertificateParams(hostid)
+}
+
+// ProvisionCertificate records the call and calls ProvisionCertificateFunc, or ProvisionCertificateWithContextFunc if only that is set
+func (m *MockCertificateService) ProvisionCertificate(p *cloudstack.ProvisionCertificateParams) (r0 *cloudstack.ProvisionCertificateResponse, r1 error) {
+	m.record("ProvisionCertificate", p)
+	if m.ProvisionCertificateFunc != nil {
+		return m.ProvisionCertificateFunc(p)
+	}
+	if m.ProvisionCertificateWithContextFunc != nil {
+		return m.ProvisionCertificateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("CertificateService.ProvisionCertificate")
+	return
+}
+
+// ProvisionCertificateWithContext records the call and calls ProvisionCertificateWithContextFunc, or ProvisionCertificateFunc if only that is set
+func (m *MockCertificateService) ProvisionCertificateWithContext(ctx context.Context, p *cloudstack.ProvisionCertificateParams) (r0 *cloudstack.ProvisionCertificateResponse, r1 error) {
+	m.record("ProvisionCertificateWithContext", ctx, p)
+	if m.ProvisionCertificateWithContextFunc != nil {
+		return m.ProvisionCertificateWithContextFunc(ctx, p)
+	}
+	if m.ProvisionCertificateFunc != nil {
+		return m.ProvisionCertificateFunc(p)
+	}
+	r1 = notMocked("CertificateService.ProvisionCertificateWithContext")
+	return
+}
+
+// ProvisionCertificateAsync records the call and calls ProvisionCertificateAsyncFunc, or ProvisionCertificateAsyncWithContextFunc if only that is set
+func (m *MockCertificateService) ProvisionCertificateAsync(p *cloudstack.ProvisionCertificateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ProvisionCertificateAsync", p)
+	if m.ProvisionCertificateAsyncFunc != nil {
+		return m.ProvisionCertificateAsyncFunc(p)
+	}
+	if m.ProvisionCertificateAsyncWithContextFunc != nil {
+		return m.ProvisionCertificateAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("CertificateService.ProvisionCertificateAsync")
+	return
+}
+
+// ProvisionCertificateAsyncWithContext records the call and calls ProvisionCertificateAsyncWithContextFunc, or ProvisionCertificateAsyncFunc if only that is set
+func (m *MockCertificateService) ProvisionCertificateAsyncWithContext(ctx context.Context, p *cloudstack.ProvisionCertificateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ProvisionCertificateAsyncWithContext", ctx, p)
+	if m.ProvisionCertificateAsyncWithContextFunc != nil {
+		return m.ProvisionCertificateAsyncWithContextFunc(ctx, p)
+	}
+	if m.ProvisionCertificateAsyncFunc != nil {
+		return m.ProvisionCertificateAsyncFunc(p)
+	}
+	r1 = notMocked("CertificateService.ProvisionCertificateAsyncWithContext")
+	return
+}
+
+// NewRevokeCertificateParams records the call and calls NewRevokeCertificateParamsFunc
+func (m *MockCertificateService) NewRevokeCertificateParams(serial string) (r0 *cloudstack.RevokeCertificateParams) {
+	m.record("NewRevokeCertificateParams", serial)
+	if m.NewRevokeCertificateParamsFunc != nil {
+		return m.NewRevokeCertificateParamsFunc(serial)
+	}
+	return cloudstack.NewCertificateService(nil).NewRevokeCertificateParams(serial)
+}
+
+// RevokeCertificate records the call and calls RevokeCertificateFunc, or RevokeCertificateWithContextFunc if only that is set
+func (m *MockCertificateService) RevokeCertificate(p *cloudstack.RevokeCertificateParams) (r0 *cloudstack.RevokeCertificateResponse, r1 error) {
+	m.record("RevokeCertificate", p)
+	if m.RevokeCertificateFunc != nil {
+		return m.RevokeCertificateFunc(p)
+	}
+	if m.RevokeCertificateWithContextFunc != nil {
+		return m.RevokeCertificateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("CertificateService.RevokeCertificate")
+	return
+}
+
+// RevokeCertificateWithContext records the call and calls RevokeCertificateWithContextFunc, or RevokeCertificateFunc if only that is set
+func (m *MockCertificateService) RevokeCertificateWithContext(ctx context.Context, p *cloudstack.RevokeCertificateParams) (r0 *cloudstack.RevokeCertificateResponse, r1 error) {
+	m.record("RevokeCertificateWithContext", ctx, p)
+	if m.RevokeCertificateWithContextFunc != nil {
+		return m.RevokeCertificateWithContextFunc(ctx, p)
+	}
+	if m.RevokeCertificateFunc != nil {
+		return m.RevokeCertificateFunc(p)
+	}
+	r1 = notMocked("CertificateService.RevokeCertificateWithContext")
+	return
+}
+
+// RevokeCertificateAsync records the call and calls RevokeCertificateAsyncFunc, or RevokeCertificateAsyncWithContextFunc if only that is set
+func (m *MockCertificateService) RevokeCertificateAsync(p *cloudstack.RevokeCertificateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RevokeCertificateAsync", p)
+	if m.RevokeCertificateAsyncFunc != nil {
+		return m.RevokeCertificateAsyncFunc(p)
+	}
+	if m.RevokeCertificateAsyncWithContextFunc != nil {
+		return m.RevokeCertificateAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("CertificateService.RevokeCertificateAsync")
+	return
+}
+
+// RevokeCertificateAsyncWithContext records the call and calls RevokeCertificateAsyncWithContextFunc, or RevokeCertificateAsyncFunc if only that is set
+func (m *MockCertificateService) RevokeCertificateAsyncWithContext(ctx context.Context, p *cloudstack.RevokeCertificateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RevokeCertificateAsyncWithContext", ctx, p)
+	if m.RevokeCertificateAsyncWithContextFunc != nil {
+		return m.RevokeCertificateAsyncWithContextFunc(ctx, p)
+	}
+	if m.RevokeCertificateAsyncFunc != nil {
+		return m.RevokeCertificateAsyncFunc(p)
+	}
+	r1 = notMocked("CertificateService.RevokeCertificateAsyncWithContext")
+	return
+}
+
+// NewRevokeTemplateDirectDownloadCertificateParams records the call and calls NewRevokeTemplateDirectDownloadCertificateParamsFunc
+func (m *MockCertificateService) NewRevokeTemplateDirectDownloadCertificateParams(hypervisor string, name string, zoneid string) (r0 *cloudstack.RevokeTemplateDirectDownloadCertificateParams) {
+	m.record("NewRevokeTemplateDirectDownloadCertificateParams", hypervisor, name, zoneid)
+	if m.NewRevokeTemplateDirectDownloadCertificateParamsFunc != nil {
+		return m.NewRevokeTemplateDirectDownloadCertificateParamsFunc(hypervisor, name, zoneid)
+	}
+	return cloudstack.NewCertificateService(nil).NewRevokeTemplateDirectDownloadCertificateParams(hypervisor, name, zoneid)
+}
+
+// RevokeTemplateDirectDownloadCertificate records the call and calls RevokeTemplateDirectDownloadCertificateFunc, or RevokeTemplateDirectDownloadCertificateWithContextFunc if only that is set
+func (m *MockCertificateService) RevokeTemplateDirectDownloadCertificate(p *cloudstack.RevokeTemplateDirectDownloadCertificateParams) (r0 *cloudstack.RevokeTemplateDirectDownloadCertificateResponse, r1 error) {
+	m.record("RevokeTemplateDirectDownloadCertificate", p)
+	if m.RevokeTemplateDirectDownloadCertificateFunc != nil {
+		return m.RevokeTemplateDirectDownloadCertificateFunc(p)
+	}
+	if m.RevokeTemplateDirectDownloadCertificateWithContextFunc != nil {
+		return m.RevokeTemplateDirectDownloadCertificateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("CertificateService.RevokeTemplateDirectDownloadCertificate")
+	return
+}
+
+// RevokeTemplateDirectDownloadCertificateWithContext records the call and calls RevokeTemplateDirectDownloadCertificateWithContextFunc, or RevokeTemplateDirectDownloadCertificateFunc if only that is set
+func (m *MockCertificateService) RevokeTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *cloudstack.RevokeTemplateDirectDownloadCertificateParams) (r0 *cloudstack.RevokeTemplateDirectDownloadCertificateResponse, r1 error) {
+	m.record("RevokeTemplateDirectDownloadCertificateWithContext", ctx, p)
+	if m.RevokeTemplateDirectDownloadCertificateWithContextFunc != nil {
+		return m.RevokeTemplateDirectDownloadCertificateWithContextFunc(ctx, p)
+	}
+	if m.RevokeTemplateDirectDownloadCertificateFunc != nil {
+		return m.RevokeTemplateDirectDownloadCertificateFunc(p)
+	}
+	r1 = notMocked("CertificateService.RevokeTemplateDirectDownloadCertificateWithContext")
+	return
+}
+
+// NewUploadCustomCertificateParams records the call and calls NewUploadCustomCertificateParamsFunc
+func (m *MockCertificateService) NewUploadCustomCertificateParams(certificate string, domainsuffix string) (r0 *cloudstack.UploadCustomCertificateParams) {
+	m.record("NewUploadCustomCertificateParams", certificate, domainsuffix)
+	if m.NewUploadCustomCertificateParamsFunc != nil {
+		return m.NewUploadCustomCertificateParamsFunc(certificate, domainsuffix)
+	}
+	return cloudstack.NewCertificateService(nil).NewUploadCustomCertificateParams(certificate, domainsuffix)
+}
+
+// UploadCustomCertificate records the call and calls UploadCustomCertificateFunc, or UploadCustomCertificateWithContextFunc if only that is set
+func (m *MockCertificateService) UploadCustomCertificate(p *cloudstack.UploadCustomCertificateParams) (r0 *cloudstack.UploadCustomCertificateResponse, r1 error) {
+	m.record("UploadCustomCertificate", p)
+	if m.UploadCustomCertificateFunc != nil {
+		return m.UploadCustomCertificateFunc(p)
+	}
+	if m.UploadCustomCertificateWithContextFunc != nil {
+		return m.UploadCustomCertificateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("CertificateService.UploadCustomCertificate")
+	return
+}
+
+// UploadCustomCertificateWithContext records the call and calls UploadCustomCertificateWithContextFunc, or UploadCustomCertificateFunc if only that is set
+func (m *MockCertificateService) UploadCustomCertificateWithContext(ctx context.Context, p *cloudstack.UploadCustomCertificateParams) (r0 *cloudstack.UploadCustomCertificateResponse, r1 error) {
+	m.record("UploadCustomCertificateWithContext", ctx, p)
+	if m.UploadCustomCertificateWithContextFunc != nil {
+		return m.UploadCustomCertificateWithContextFunc(ctx, p)
+	}
+	if m.UploadCustomCertificateFunc != nil {
+		return m.UploadCustomCertificateFunc(p)
+	}
+	r1 = notMocked("CertificateService.UploadCustomCertificateWithContext")
+	return
+}
+
+// UploadCustomCertificateAsync records the call and calls UploadCustomCertificateAsyncFunc, or UploadCustomCertificateAsyncWithContextFunc if only that is set
+func (m *MockCertificateService) UploadCustomCertificateAsync(p *cloudstack.UploadCustomCertificateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UploadCustomCertificateAsync", p)
+	if m.UploadCustomCertificateAsyncFunc != nil {
+		return m.UploadCustomCertificateAsyncFunc(p)
+	}
+	if m.UploadCustomCertificateAsyncWithContextFunc != nil {
+		return m.UploadCustomCertificateAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("CertificateService.UploadCustomCertificateAsync")
+	return
+}
+
+// UploadCustomCertificateAsyncWithContext records the call and calls UploadCustomCertificateAsyncWithContextFunc, or UploadCustomCertificateAsyncFunc if only that is set
+func (m *MockCertificateService) UploadCustomCertificateAsyncWithContext(ctx context.Context, p *cloudstack.UploadCustomCertificateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UploadCustomCertificateAsyncWithContext", ctx, p)
+	if m.UploadCustomCertificateAsyncWithContextFunc != nil {
+		return m.UploadCustomCertificateAsyncWithContextFunc(ctx, p)
+	}
+	if m.UploadCustomCertificateAsyncFunc != nil {
+		return m.UploadCustomCertificateAsyncFunc(p)
+	}
+	r1 = notMocked("CertificateService.UploadCustomCertificateAsyncWithContext")
+	return
+}
+
+// NewUploadTemplateDirectDownloadCertificateParams records the call and calls NewUploadTemplateDirectDownloadCertificateParamsFunc
+func (m *MockCertificateService) NewUploadTemplateDirectDownloadCertificateParams(certificate string, hypervisor string, name string, zoneid string) (r0 *cloudstack.UploadTemplateDirectDownloadCertificateParams) {
+	m.record("NewUploadTemplateDirectDownloadCertificateParams", certificate, hypervisor, name, zoneid)
+	if m.NewUploadTemplateDirectDownloadCertificateParamsFunc != nil {
+		return m.NewUploadTemplateDirectDownloadCertificateParamsFunc(certificate, hypervisor, name, zoneid)
+	}
+	return cloudstack.NewCertificateService(nil).NewUploadTemplateDirectDownloadCertificateParams(certificate, hypervisor, name, zoneid)
+}
+
+// UploadTemplateDirectDownloadCertificate records the call and calls UploadTemplateDirectDownloadCertificateFunc, or UploadTemplateDirectDownloadCertificateWithContextFunc if only that is set
+func (m *MockCertificateService) UploadTemplateDirectDownloadCertificate(p *cloudstack.UploadTemplateDirectDownloadCertificateParams) (r0 *cloudstack.UploadTemplateDirectDownloadCertificateResponse, r1 error) {
+	m.record("UploadTemplateDirectDownloadCertificate", p)
+	if m.UploadTemplateDirectDownloadCertificateFunc != nil {
+		return m.UploadTemplateDirectDownloadCertificateFunc(p)
+	}
+	if m.UploadTemplateDirectDownloadCertificateWithContextFunc != nil {
+		return m.UploadTemplateDirectDownloadCertificateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("CertificateService.UploadTemplateDirectDownloadCertificate")
+	return
+}
+
+// UploadTemplateDirectDownloadCertificateWithContext records the call and calls UploadTemplateDirectDownloadCertificateWithContextFunc, or UploadTemplateDirectDownloadCertificateFunc if only that is set
+func (m *MockCertificateService) UploadTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *cloudstack.UploadTemplateDirectDownloadCertificateParams) (r0 *cloudstack.UploadTemplateDirectDownloadCertificateResponse, r1 error) {
+	m.record("UploadTemplateDirectDownloadCertificateWithContext", ctx, p)
+	if m.UploadTemplateDirectDownloadCertificateWithContextFunc != nil {
+		return m.UploadTemplateDirectDownloadCertificateWithContextFunc(ctx, p)
+	}
+	if m.UploadTemplateDirectDownloadCertificateFunc != nil {
+		return m.UploadTemplateDirectDownloadCertificateFunc(p)
+	}
+	r1 = notMocked("CertificateService.UploadTemplateDirectDownloadCertificateWithContext")
+	return
+}
@@ -0,0 +1,77 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockCloudIdentifierService is a mock of cloudstack.CloudIdentifierServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockCloudIdentifierService struct {
+	Recorder
+
+	NewGetCloudIdentifierParamsFunc   func(userid string) *cloudstack.GetCloudIdentifierParams
+	GetCloudIdentifierFunc            func(p *cloudstack.GetCloudIdentifierParams) (*cloudstack.GetCloudIdentifierResponse, error)
+	GetCloudIdentifierWithContextFunc func(ctx context.Context, p *cloudstack.GetCloudIdentifierParams) (*cloudstack.GetCloudIdentifierResponse, error)
+}
+
+var _ cloudstack.CloudIdentifierServiceIface = (*MockCloudIdentifierService)(nil)
+
+// NewGetCloudIdentifierParams records the call and calls NewGetCloudIdentifierParamsFunc
+func (m *MockCloudIdentifierService) NewGetCloudIdentifierParams(userid string) (r0 *cloudstack.GetCloudIdentifierParams) {
+	m.record("NewGetCloudIdentifierParams", userid)
+	if m.NewGetCloudIdentifierParamsFunc != nil {
+		return m.NewGetCloudIdentifierParamsFunc(userid)
+	}
+	return cloudstack.NewCloudIdentifierService(nil).NewGetCloudIdentifierParams(userid)
+}
+
+// GetCloudIdentifier records the call and calls GetCloudIdentifierFunc, or GetCloudIdentifierWithContextFunc if only that is set
+func (m *MockCloudIdentifierService) GetCloudIdentifier(p *cloudstack.GetCloudIdentifierParams) (r0 *cloudstack.GetCloudIdentifierResponse, r1 error) {
+	m.record("GetCloudIdentifier", p)
+	if m.GetCloudIdentifierFunc != nil {
+		return m.GetCloudIdentifierFunc(p)
+	}
+	if m.GetCloudIdentifierWithContextFunc != nil {
+		return m.GetCloudIdentifierWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("CloudIdentifierService.GetCloudIdentifier")
+	return
+}
+
+// GetCloudIdentifierWithContext records the call and calls GetCloudIdentifierWithContextFunc, or GetCloudIdentifierFunc if only that is set
+func (m *MockCloudIdentifierService) GetCloudIdentifierWithContext(ctx context.Context, p *cloudstack.GetCloudIdentifierParams) (r0 *cloudstack.GetCloudIdentifierResponse, r1 error) {
+	m.record("GetCloudIdentifierWithContext", ctx, p)
+	if m.GetCloudIdentifierWithContextFunc != nil {
+		return m.GetCloudIdentifierWithContextFunc(ctx, p)
+	}
+	if m.GetCloudIdentifierFunc != nil {
+		return m.GetCloudIdentifierFunc(p)
+	}
+	r1 = notMocked("CloudIdentifierService.GetCloudIdentifierWithContext")
+	return
+}
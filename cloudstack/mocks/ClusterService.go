@@ -0,0 +1,813 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockClusterService is a mock of cloudstack.ClusterServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockClusterService struct {
+	Recorder
+
+	NewAddClusterParamsFunc                                  func(clustername string, clustertype string, hypervisor string, podid string, zoneid string) *cloudstack.AddClusterParams
+	AddClusterFunc                                           func(p *cloudstack.AddClusterParams) (*cloudstack.AddClusterResponse, error)
+	AddClusterWithContextFunc                                func(ctx context.Context, p *cloudstack.AddClusterParams) (*cloudstack.AddClusterResponse, error)
+	NewDedicateClusterParamsFunc                             func(clusterid string, domainid string) *cloudstack.DedicateClusterParams
+	DedicateClusterFunc                                      func(p *cloudstack.DedicateClusterParams) (*cloudstack.DedicateClusterResponse, error)
+	DedicateClusterWithContextFunc                           func(ctx context.Context, p *cloudstack.DedicateClusterParams) (*cloudstack.DedicateClusterResponse, error)
+	DedicateClusterAsyncFunc                                 func(p *cloudstack.DedicateClusterParams) (*cloudstack.Job, error)
+	DedicateClusterAsyncWithContextFunc                      func(ctx context.Context, p *cloudstack.DedicateClusterParams) (*cloudstack.Job, error)
+	NewDeleteClusterParamsFunc                               func(id string) *cloudstack.DeleteClusterParams
+	DeleteClusterFunc                                        func(p *cloudstack.DeleteClusterParams) (*cloudstack.DeleteClusterResponse, error)
+	DeleteClusterWithContextFunc                             func(ctx context.Context, p *cloudstack.DeleteClusterParams) (*cloudstack.DeleteClusterResponse, error)
+	NewDisableOutOfBandManagementForClusterParamsFunc        func(clusterid string) *cloudstack.DisableOutOfBandManagementForClusterParams
+	DisableOutOfBandManagementForClusterFunc                 func(p *cloudstack.DisableOutOfBandManagementForClusterParams) (*cloudstack.DisableOutOfBandManagementForClusterResponse, error)
+	DisableOutOfBandManagementForClusterWithContextFunc      func(ctx context.Context, p *cloudstack.DisableOutOfBandManagementForClusterParams) (*cloudstack.DisableOutOfBandManagementForClusterResponse, error)
+	DisableOutOfBandManagementForClusterAsyncFunc            func(p *cloudstack.DisableOutOfBandManagementForClusterParams) (*cloudstack.Job, error)
+	DisableOutOfBandManagementForClusterAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DisableOutOfBandManagementForClusterParams) (*cloudstack.Job, error)
+	NewEnableOutOfBandManagementForClusterParamsFunc         func(clusterid string) *cloudstack.EnableOutOfBandManagementForClusterParams
+	EnableOutOfBandManagementForClusterFunc                  func(p *cloudstack.EnableOutOfBandManagementForClusterParams) (*cloudstack.EnableOutOfBandManagementForClusterResponse, error)
+	EnableOutOfBandManagementForClusterWithContextFunc       func(ctx context.Context, p *cloudstack.EnableOutOfBandManagementForClusterParams) (*cloudstack.EnableOutOfBandManagementForClusterResponse, error)
+	EnableOutOfBandManagementForClusterAsyncFunc             func(p *cloudstack.EnableOutOfBandManagementForClusterParams) (*cloudstack.Job, error)
+	EnableOutOfBandManagementForClusterAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.EnableOutOfBandManagementForClusterParams) (*cloudstack.Job, error)
+	NewListClustersParamsFunc                                func() *cloudstack.ListClustersParams
+	GetClusterIDFunc                                         func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetClusterIDWithContextFunc                              func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetClusterByNameFunc                                     func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Cluster, int, error)
+	GetClusterByNameWithContextFunc                          func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.Cluster, int, error)
+	GetClusterByIDFunc                                       func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Cluster, int, error)
+	GetClusterByIDWithContextFunc                            func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Cluster, int, error)
+	ListClustersFunc                                         func(p *cloudstack.ListClustersParams) (*cloudstack.ListClustersResponse, error)
+	ListClustersWithContextFunc                              func(ctx context.Context, p *cloudstack.ListClustersParams) (*cloudstack.ListClustersResponse, error)
+	NewListClustersPagerFunc                                 func(p *cloudstack.ListClustersParams) *cloudstack.ListClustersPager
+	ListClustersAllFunc                                      func(p *cloudstack.ListClustersParams) (*cloudstack.ListClustersResponse, error)
+	ListClustersAllWithContextFunc                           func(ctx context.Context, p *cloudstack.ListClustersParams) (*cloudstack.ListClustersResponse, error)
+	NewListClustersMetricsParamsFunc                         func() *cloudstack.ListClustersMetricsParams
+	GetClustersMetricIDFunc                                  func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetClustersMetricIDWithContextFunc                       func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetClustersMetricByNameFunc                              func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.ClustersMetric, int, error)
+	GetClustersMetricByNameWithContextFunc                   func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.ClustersMetric, int, error)
+	GetClustersMetricByIDFunc                                func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.ClustersMetric, int, error)
+	GetClustersMetricByIDWithContextFunc                     func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.ClustersMetric, int, error)
+	ListClustersMetricsFunc                                  func(p *cloudstack.ListClustersMetricsParams) (*cloudstack.ListClustersMetricsResponse, error)
+	ListClustersMetricsWithContextFunc                       func(ctx context.Context, p *cloudstack.ListClustersMetricsParams) (*cloudstack.ListClustersMetricsResponse, error)
+	NewListClustersMetricsPagerFunc                          func(p *cloudstack.ListClustersMetricsParams) *cloudstack.ListClustersMetricsPager
+	ListClustersMetricsAllFunc                               func(p *cloudstack.ListClustersMetricsParams) (*cloudstack.ListClustersMetricsResponse, error)
+	ListClustersMetricsAllWithContextFunc                    func(ctx context.Context, p *cloudstack.ListClustersMetricsParams) (*cloudstack.ListClustersMetricsResponse, error)
+	NewListDedicatedClustersParamsFunc                       func() *cloudstack.ListDedicatedClustersParams
+	ListDedicatedClustersFunc                                func(p *cloudstack.ListDedicatedClustersParams) (*cloudstack.ListDedicatedClustersResponse, error)
+	ListDedicatedClustersWithContextFunc                     func(ctx context.Context, p *cloudstack.ListDedicatedClustersParams) (*cloudstack.ListDedicatedClustersResponse, error)
+	NewListDedicatedClustersPagerFunc                        func(p *cloudstack.ListDedicatedClustersParams) *cloudstack.ListDedicatedClustersPager
+	ListDedicatedClustersAllFunc                             func(p *cloudstack.ListDedicatedClustersParams) (*cloudstack.ListDedicatedClustersResponse, error)
+	ListDedicatedClustersAllWithContextFunc                  func(ctx context.Context, p *cloudstack.ListDedicatedClustersParams) (*cloudstack.ListDedicatedClustersResponse, error)
+	NewReleaseDedicatedClusterParamsFunc                     func(clusterid string) *cloudstack.ReleaseDedicatedClusterParams
+	ReleaseDedicatedClusterFunc                              func(p *cloudstack.ReleaseDedicatedClusterParams) (*cloudstack.ReleaseDedicatedClusterResponse, error)
+	ReleaseDedicatedClusterWithContextFunc                   func(ctx context.Context, p *cloudstack.ReleaseDedicatedClusterParams) (*cloudstack.ReleaseDedicatedClusterResponse, error)
+	ReleaseDedicatedClusterAsyncFunc                         func(p *cloudstack.ReleaseDedicatedClusterParams) (*cloudstack.Job, error)
+	ReleaseDedicatedClusterAsyncWithContextFunc              func(ctx context.Context, p *cloudstack.ReleaseDedicatedClusterParams) (*cloudstack.Job, error)
+	NewUpdateClusterParamsFunc                               func(id string) *cloudstack.UpdateClusterParams
+	UpdateClusterFunc                                        func(p *cloudstack.UpdateClusterParams) (*cloudstack.UpdateClusterResponse, error)
+	UpdateClusterWithContextFunc                             func(ctx context.Context, p *cloudstack.UpdateClusterParams) (*cloudstack.UpdateClusterResponse, error)
+}
+
+var _ cloudstack.ClusterServiceIface = (*MockClusterService)(nil)
+
+// NewAddClusterParams records the call and calls NewAddClusterParamsFunc
+func (m *MockClusterService) NewAddClusterParams(clustername string, clustertype string, hypervisor string, podid string, zoneid string) (r0 *cloudstack.AddClusterParams) {
+	m.record("NewAddClusterParams", clustername, clustertype, hypervisor, podid, zoneid)
+	if m.NewAddClusterParamsFunc != nil {
+		return m.NewAddClusterParamsFunc(clustername, clustertype, hypervisor, podid, zoneid)
+	}
+	return cloudstack.NewClusterService(nil).NewAddClusterParams(clustername, clustertype, hypervisor, podid, zoneid)
+}
+
+// AddCluster records the call and calls AddClusterFunc, or AddClusterWithContextFunc if only that is set
+func (m *MockClusterService) AddCluster(p *cloudstack.AddClusterParams) (r0 *cloudstack.AddClusterResponse, r1 error) {
+	m.record("AddCluster", p)
+	if m.AddClusterFunc != nil {
+		return m.AddClusterFunc(p)
+	}
+	if m.AddClusterWithContextFunc != nil {
+		return m.AddClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.AddCluster")
+	return
+}
+
+// AddClusterWithContext records the call and calls AddClusterWithContextFunc, or AddClusterFunc if only that is set
+func (m *MockClusterService) AddClusterWithContext(ctx context.Context, p *cloudstack.AddClusterParams) (r0 *cloudstack.AddClusterResponse, r1 error) {
+	m.record("AddClusterWithContext", ctx, p)
+	if m.AddClusterWithContextFunc != nil {
+		return m.AddClusterWithContextFunc(ctx, p)
+	}
+	if m.AddClusterFunc != nil {
+		return m.AddClusterFunc(p)
+	}
+	r1 = notMocked("ClusterService.AddClusterWithContext")
+	return
+}
+
+// NewDedicateClusterParams records the call and calls NewDedicateClusterParamsFunc
+func (m *MockClusterService) NewDedicateClusterParams(clusterid string, domainid string) (r0 *cloudstack.DedicateClusterParams) {
+	m.record("NewDedicateClusterParams", clusterid, domainid)
+	if m.NewDedicateClusterParamsFunc != nil {
+		return m.NewDedicateClusterParamsFunc(clusterid, domainid)
+	}
+	return cloudstack.NewClusterService(nil).NewDedicateClusterParams(clusterid, domainid)
+}
+
+// DedicateCluster records the call and calls DedicateClusterFunc, or DedicateClusterWithContextFunc if only that is set
+func (m *MockClusterService) DedicateCluster(p *cloudstack.DedicateClusterParams) (r0 *cloudstack.DedicateClusterResponse, r1 error) {
+	m.record("DedicateCluster", p)
+	if m.DedicateClusterFunc != nil {
+		return m.DedicateClusterFunc(p)
+	}
+	if m.DedicateClusterWithContextFunc != nil {
+		return m.DedicateClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.DedicateCluster")
+	return
+}
+
+// DedicateClusterWithContext records the call and calls DedicateClusterWithContextFunc, or DedicateClusterFunc if only that is set
+func (m *MockClusterService) DedicateClusterWithContext(ctx context.Context, p *cloudstack.DedicateClusterParams) (r0 *cloudstack.DedicateClusterResponse, r1 error) {
+	m.record("DedicateClusterWithContext", ctx, p)
+	if m.DedicateClusterWithContextFunc != nil {
+		return m.DedicateClusterWithContextFunc(ctx, p)
+	}
+	if m.DedicateClusterFunc != nil {
+		return m.DedicateClusterFunc(p)
+	}
+	r1 = notMocked("ClusterService.DedicateClusterWithContext")
+	return
+}
+
+// DedicateClusterAsync records the call and calls DedicateClusterAsyncFunc, or DedicateClusterAsyncWithContextFunc if only that is set
+func (m *MockClusterService) DedicateClusterAsync(p *cloudstack.DedicateClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DedicateClusterAsync", p)
+	if m.DedicateClusterAsyncFunc != nil {
+		return m.DedicateClusterAsyncFunc(p)
+	}
+	if m.DedicateClusterAsyncWithContextFunc != nil {
+		return m.DedicateClusterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.DedicateClusterAsync")
+	return
+}
+
+// DedicateClusterAsyncWithContext records the call and calls DedicateClusterAsyncWithContextFunc, or DedicateClusterAsyncFunc if only that is set
+func (m *MockClusterService) DedicateClusterAsyncWithContext(ctx context.Context, p *cloudstack.DedicateClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DedicateClusterAsyncWithContext", ctx, p)
+	if m.DedicateClusterAsyncWithContextFunc != nil {
+		return m.DedicateClusterAsyncWithContextFunc(ctx, p)
+	}
+	if m.DedicateClusterAsyncFunc != nil {
+		return m.DedicateClusterAsyncFunc(p)
+	}
+	r1 = notMocked("ClusterService.DedicateClusterAsyncWithContext")
+	return
+}
+
+// NewDeleteClusterParams records the call and calls NewDeleteClusterParamsFunc
+func (m *MockClusterService) NewDeleteClusterParams(id string) (r0 *cloudstack.DeleteClusterParams) {
+	m.record("NewDeleteClusterParams", id)
+	if m.NewDeleteClusterParamsFunc != nil {
+		return m.NewDeleteClusterParamsFunc(id)
+	}
+	return cloudstack.NewClusterService(nil).NewDeleteClusterParams(id)
+}
+
+// DeleteCluster records the call and calls DeleteClusterFunc, or DeleteClusterWithContextFunc if only that is set
+func (m *MockClusterService) DeleteCluster(p *cloudstack.DeleteClusterParams) (r0 *cloudstack.DeleteClusterResponse, r1 error) {
+	m.record("DeleteCluster", p)
+	if m.DeleteClusterFunc != nil {
+		return m.DeleteClusterFunc(p)
+	}
+	if m.DeleteClusterWithContextFunc != nil {
+		return m.DeleteClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.DeleteCluster")
+	return
+}
+
+// DeleteClusterWithContext records the call and calls DeleteClusterWithContextFunc, or DeleteClusterFunc if only that is set
+func (m *MockClusterService) DeleteClusterWithContext(ctx context.Context, p *cloudstack.DeleteClusterParams) (r0 *cloudstack.DeleteClusterResponse, r1 error) {
+	m.record("DeleteClusterWithContext", ctx, p)
+	if m.DeleteClusterWithContextFunc != nil {
+		return m.DeleteClusterWithContextFunc(ctx, p)
+	}
+	if m.DeleteClusterFunc != nil {
+		return m.DeleteClusterFunc(p)
+	}
+	r1 = notMocked("ClusterService.DeleteClusterWithContext")
+	return
+}
+
+// NewDisableOutOfBandManagementForClusterParams records the call and calls NewDisableOutOfBandManagementForClusterParamsFunc
+func (m *MockClusterService) NewDisableOutOfBandManagementForClusterParams(clusterid string) (r0 *cloudstack.DisableOutOfBandManagementForClusterParams) {
+	m.record("NewDisableOutOfBandManagementForClusterParams", clusterid)
+	if m.NewDisableOutOfBandManagementForClusterParamsFunc != nil {
+		return m.NewDisableOutOfBandManagementForClusterParamsFunc(clusterid)
+	}
+	return cloudstack.NewClusterService(nil).NewDisableOutOfBandManagementForClusterParams(clusterid)
+}
+
+// DisableOutOfBandManagementForCluster records the call and calls DisableOutOfBandManagementForClusterFunc, or DisableOutOfBandManagementForClusterWithContextFunc if only that is set
+func (m *MockClusterService) DisableOutOfBandManagementForCluster(p *cloudstack.DisableOutOfBandManagementForClusterParams) (r0 *cloudstack.DisableOutOfBandManagementForClusterResponse, r1 error) {
+	m.record("DisableOutOfBandManagementForCluster", p)
+	if m.DisableOutOfBandManagementForClusterFunc != nil {
+		return m.DisableOutOfBandManagementForClusterFunc(p)
+	}
+	if m.DisableOutOfBandManagementForClusterWithContextFunc != nil {
+		return m.DisableOutOfBandManagementForClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.DisableOutOfBandManagementForCluster")
+	return
+}
+
+// DisableOutOfBandManagementForClusterWithContext records the call and calls DisableOutOfBandManagementForClusterWithContextFunc, or DisableOutOfBandManagementForClusterFunc if only that is set
+func (m *MockClusterService) DisableOutOfBandManagementForClusterWithContext(ctx context.Context, p *cloudstack.DisableOutOfBandManagementForClusterParams) (r0 *cloudstack.DisableOutOfBandManagementForClusterResponse, r1 error) {
+	m.record("DisableOutOfBandManagementForClusterWithContext", ctx, p)
+	if m.DisableOutOfBandManagementForClusterWithContextFunc != nil {
+		return m.DisableOutOfBandManagementForClusterWithContextFunc(ctx, p)
+	}
+	if m.DisableOutOfBandManagementForClusterFunc != nil {
+		return m.DisableOutOfBandManagementForClusterFunc(p)
+	}
+	r1 = notMocked("ClusterService.DisableOutOfBandManagementForClusterWithContext")
+	return
+}
+
+// DisableOutOfBandManagementForClusterAsync records the call and calls DisableOutOfBandManagementForClusterAsyncFunc, or DisableOutOfBandManagementForClusterAsyncWithContextFunc if only that is set
+func (m *MockClusterService) DisableOutOfBandManagementForClusterAsync(p *cloudstack.DisableOutOfBandManagementForClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableOutOfBandManagementForClusterAsync", p)
+	if m.DisableOutOfBandManagementForClusterAsyncFunc != nil {
+		return m.DisableOutOfBandManagementForClusterAsyncFunc(p)
+	}
+	if m.DisableOutOfBandManagementForClusterAsyncWithContextFunc != nil {
+		return m.DisableOutOfBandManagementForClusterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.DisableOutOfBandManagementForClusterAsync")
+	return
+}
+
+// DisableOutOfBandManagementForClusterAsyncWithContext records the call and calls DisableOutOfBandManagementForClusterAsyncWithContextFunc, or DisableOutOfBandManagementForClusterAsyncFunc if only that is set
+func (m *MockClusterService) DisableOutOfBandManagementForClusterAsyncWithContext(ctx context.Context, p *cloudstack.DisableOutOfBandManagementForClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableOutOfBandManagementForClusterAsyncWithContext", ctx, p)
+	if m.DisableOutOfBandManagementForClusterAsyncWithContextFunc != nil {
+		return m.DisableOutOfBandManagementForClusterAsyncWithContextFunc(ctx, p)
+	}
+	if m.DisableOutOfBandManagementForClusterAsyncFunc != nil {
+		return m.DisableOutOfBandManagementForClusterAsyncFunc(p)
+	}
+	r1 = notMocked("ClusterService.DisableOutOfBandManagementForClusterAsyncWithContext")
+	return
+}
+
+// NewEnableOutOfBandManagementForClusterParams records the call and calls NewEnableOutOfBandManagementForClusterParamsFunc
+func (m *MockClusterService) NewEnableOutOfBandManagementForClusterParams(clusterid string) (r0 *cloudstack.EnableOutOfBandManagementForClusterParams) {
+	m.record("NewEnableOutOfBandManagementForClusterParams", clusterid)
+	if m.NewEnableOutOfBandManagementForClusterParamsFunc != nil {
+		return m.NewEnableOutOfBandManagementForClusterParamsFunc(clusterid)
+	}
+	return cloudstack.NewClusterService(nil).NewEnableOutOfBandManagementForClusterParams(clusterid)
+}
+
+// EnableOutOfBandManagementForCluster records the call and calls EnableOutOfBandManagementForClusterFunc, or EnableOutOfBandManagementForClusterWithContextFunc if only that is set
+func (m *MockClusterService) EnableOutOfBandManagementForCluster(p *cloudstack.EnableOutOfBandManagementForClusterParams) (r0 *cloudstack.EnableOutOfBandManagementForClusterResponse, r1 error) {
+	m.record("EnableOutOfBandManagementForCluster", p)
+	if m.EnableOutOfBandManagementForClusterFunc != nil {
+		return m.EnableOutOfBandManagementForClusterFunc(p)
+	}
+	if m.EnableOutOfBandManagementForClusterWithContextFunc != nil {
+		return m.EnableOutOfBandManagementForClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.EnableOutOfBandManagementForCluster")
+	return
+}
+
+// EnableOutOfBandManagementForClusterWithContext records the call and calls EnableOutOfBandManagementForClusterWithContextFunc, or EnableOutOfBandManagementForClusterFunc if only that is set
+func (m *MockClusterService) EnableOutOfBandManagementForClusterWithContext(ctx context.Context, p *cloudstack.EnableOutOfBandManagementForClusterParams) (r0 *cloudstack.EnableOutOfBandManagementForClusterResponse, r1 error) {
+	m.record("EnableOutOfBandManagementForClusterWithContext", ctx, p)
+	if m.EnableOutOfBandManagementForClusterWithContextFunc != nil {
+		return m.EnableOutOfBandManagementForClusterWithContextFunc(ctx, p)
+	}
+	if m.EnableOutOfBandManagementForClusterFunc != nil {
+		return m.EnableOutOfBandManagementForClusterFunc(p)
+	}
+	r1 = notMocked("ClusterService.EnableOutOfBandManagementForClusterWithContext")
+	return
+}
+
+// EnableOutOfBandManagementForClusterAsync records the call and calls EnableOutOfBandManagementForClusterAsyncFunc, or EnableOutOfBandManagementForClusterAsyncWithContextFunc if only that is set
+func (m *MockClusterService) EnableOutOfBandManagementForClusterAsync(p *cloudstack.EnableOutOfBandManagementForClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableOutOfBandManagementForClusterAsync", p)
+	if m.EnableOutOfBandManagementForClusterAsyncFunc != nil {
+		return m.EnableOutOfBandManagementForClusterAsyncFunc(p)
+	}
+	if m.EnableOutOfBandManagementForClusterAsyncWithContextFunc != nil {
+		return m.EnableOutOfBandManagementForClusterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.EnableOutOfBandManagementForClusterAsync")
+	return
+}
+
+// EnableOutOfBandManagementForClusterAsyncWithContext records the call and calls EnableOutOfBandManagementForClusterAsyncWithContextFunc, or EnableOutOfBandManagementForClusterAsyncFunc if only that is set
+func (m *MockClusterService) EnableOutOfBandManagementForClusterAsyncWithContext(ctx context.Context, p *cloudstack.EnableOutOfBandManagementForClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableOutOfBandManagementForClusterAsyncWithContext", ctx, p)
+	if m.EnableOutOfBandManagementForClusterAsyncWithContextFunc != nil {
+		return m.EnableOutOfBandManagementForClusterAsyncWithContextFunc(ctx, p)
+	}
+	if m.EnableOutOfBandManagementForClusterAsyncFunc != nil {
+		return m.EnableOutOfBandManagementForClusterAsyncFunc(p)
+	}
+	r1 = notMocked("ClusterService.EnableOutOfBandManagementForClusterAsyncWithContext")
+	return
+}
+
+// NewListClustersParams records the call and calls NewListClustersParamsFunc
+func (m *MockClusterService) NewListClustersParams() (r0 *cloudstack.ListClustersParams) {
+	m.record("NewListClustersParams")
+	if m.NewListClustersParamsFunc != nil {
+		return m.NewListClustersParamsFunc()
+	}
+	return cloudstack.NewClusterService(nil).NewListClustersParams()
+}
+
+// GetClusterID records the call and calls GetClusterIDFunc, or GetClusterIDWithContextFunc if only that is set
+func (m *MockClusterService) GetClusterID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetClusterID", name, opts)
+	if m.GetClusterIDFunc != nil {
+		return m.GetClusterIDFunc(name, opts...)
+	}
+	if m.GetClusterIDWithContextFunc != nil {
+		return m.GetClusterIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ClusterService.GetClusterID")
+	return
+}
+
+// GetClusterIDWithContext records the call and calls GetClusterIDWithContextFunc, or GetClusterIDFunc if only that is set
+func (m *MockClusterService) GetClusterIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetClusterIDWithContext", ctx, name, opts)
+	if m.GetClusterIDWithContextFunc != nil {
+		return m.GetClusterIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetClusterIDFunc != nil {
+		return m.GetClusterIDFunc(name, opts...)
+	}
+	r2 = notMocked("ClusterService.GetClusterIDWithContext")
+	return
+}
+
+// GetClusterByName records the call and calls GetClusterByNameFunc, or GetClusterByNameWithContextFunc if only that is set
+func (m *MockClusterService) GetClusterByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Cluster, r1 int, r2 error) {
+	m.record("GetClusterByName", name, opts)
+	if m.GetClusterByNameFunc != nil {
+		return m.GetClusterByNameFunc(name, opts...)
+	}
+	if m.GetClusterByNameWithContextFunc != nil {
+		return m.GetClusterByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ClusterService.GetClusterByName")
+	return
+}
+
+// GetClusterByNameWithContext records the call and calls GetClusterByNameWithContextFunc, or GetClusterByNameFunc if only that is set
+func (m *MockClusterService) GetClusterByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Cluster, r1 int, r2 error) {
+	m.record("GetClusterByNameWithContext", ctx, name, opts)
+	if m.GetClusterByNameWithContextFunc != nil {
+		return m.GetClusterByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetClusterByNameFunc != nil {
+		return m.GetClusterByNameFunc(name, opts...)
+	}
+	r2 = notMocked("ClusterService.GetClusterByNameWithContext")
+	return
+}
+
+// GetClusterByID records the call and calls GetClusterByIDFunc, or GetClusterByIDWithContextFunc if only that is set
+func (m *MockClusterService) GetClusterByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Cluster, r1 int, r2 error) {
+	m.record("GetClusterByID", id, opts)
+	if m.GetClusterByIDFunc != nil {
+		return m.GetClusterByIDFunc(id, opts...)
+	}
+	if m.GetClusterByIDWithContextFunc != nil {
+		return m.GetClusterByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("ClusterService.GetClusterByID")
+	return
+}
+
+// GetClusterByIDWithContext records the call and calls GetClusterByIDWithContextFunc, or GetClusterByIDFunc if only that is set
+func (m *MockClusterService) GetClusterByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Cluster, r1 int, r2 error) {
+	m.record("GetClusterByIDWithContext", ctx, id, opts)
+	if m.GetClusterByIDWithContextFunc != nil {
+		return m.GetClusterByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetClusterByIDFunc != nil {
+		return m.GetClusterByIDFunc(id, opts...)
+	}
+	r2 = notMocked("ClusterService.GetClusterByIDWithContext")
+	return
+}
+
+// ListClusters records the call and calls ListClustersFunc, or ListClustersWithContextFunc if only that is set
+func (m *MockClusterService) ListClusters(p *cloudstack.ListClustersParams) (r0 *cloudstack.ListClustersResponse, r1 error) {
+	m.record("ListClusters", p)
+	if m.ListClustersFunc != nil {
+		return m.ListClustersFunc(p)
+	}
+	if m.ListClustersWithContextFunc != nil {
+		return m.ListClustersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.ListClusters")
+	return
+}
+
+// ListClustersWithContext records the call and calls ListClustersWithContextFunc, or ListClustersFunc if only that is set
+func (m *MockClusterService) ListClustersWithContext(ctx context.Context, p *cloudstack.ListClustersParams) (r0 *cloudstack.ListClustersResponse, r1 error) {
+	m.record("ListClustersWithContext", ctx, p)
+	if m.ListClustersWithContextFunc != nil {
+		return m.ListClustersWithContextFunc(ctx, p)
+	}
+	if m.ListClustersFunc != nil {
+		return m.ListClustersFunc(p)
+	}
+	r1 = notMocked("ClusterService.ListClustersWithContext")
+	return
+}
+
+// NewListClustersPager records the call and calls NewListClustersPagerFunc
+func (m *MockClusterService) NewListClustersPager(p *cloudstack.ListClustersParams) (r0 *cloudstack.ListClustersPager) {
+	m.record("NewListClustersPager", p)
+	if m.NewListClustersPagerFunc != nil {
+		return m.NewListClustersPagerFunc(p)
+	}
+	return
+}
+
+// ListClustersAll records the call and calls ListClustersAllFunc, or ListClustersAllWithContextFunc if only that is set
+func (m *MockClusterService) ListClustersAll(p *cloudstack.ListClustersParams) (r0 *cloudstack.ListClustersResponse, r1 error) {
+	m.record("ListClustersAll", p)
+	if m.ListClustersAllFunc != nil {
+		return m.ListClustersAllFunc(p)
+	}
+	if m.ListClustersAllWithContextFunc != nil {
+		return m.ListClustersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.ListClustersAll")
+	return
+}
+
+// ListClustersAllWithContext records the call and calls ListClustersAllWithContextFunc, or ListClustersAllFunc if only that is set
+func (m *MockClusterService) ListClustersAllWithContext(ctx context.Context, p *cloudstack.ListClustersParams) (r0 *cloudstack.ListClustersResponse, r1 error) {
+	m.record("ListClustersAllWithContext", ctx, p)
+	if m.ListClustersAllWithContextFunc != nil {
+		return m.ListClustersAllWithContextFunc(ctx, p)
+	}
+	if m.ListClustersAllFunc != nil {
+		return m.ListClustersAllFunc(p)
+	}
+	r1 = notMocked("ClusterService.ListClustersAllWithContext")
+	return
+}
+
+// NewListClustersMetricsParams records the call and calls NewListClustersMetricsParamsFunc
+func (m *MockClusterService) NewListClustersMetricsParams() (r0 *cloudstack.ListClustersMetricsParams) {
+	m.record("NewListClustersMetricsParams")
+	if m.NewListClustersMetricsParamsFunc != nil {
+		return m.NewListClustersMetricsParamsFunc()
+	}
+	return cloudstack.NewClusterService(nil).NewListClustersMetricsParams()
+}
+
+// GetClustersMetricID records the call and calls GetClustersMetricIDFunc, or GetClustersMetricIDWithContextFunc if only that is set
+func (m *MockClusterService) GetClustersMetricID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetClustersMetricID", name, opts)
+	if m.GetClustersMetricIDFunc != nil {
+		return m.GetClustersMetricIDFunc(name, opts...)
+	}
+	if m.GetClustersMetricIDWithContextFunc != nil {
+		return m.GetClustersMetricIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ClusterService.GetClustersMetricID")
+	return
+}
+
+// GetClustersMetricIDWithContext records the call and calls GetClustersMetricIDWithContextFunc, or GetClustersMetricIDFunc if only that is set
+func (m *MockClusterService) GetClustersMetricIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetClustersMetricIDWithContext", ctx, name, opts)
+	if m.GetClustersMetricIDWithContextFunc != nil {
+		return m.GetClustersMetricIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetClustersMetricIDFunc != nil {
+		return m.GetClustersMetricIDFunc(name, opts...)
+	}
+	r2 = notMocked("ClusterService.GetClustersMetricIDWithContext")
+	return
+}
+
+// GetClustersMetricByName records the call and calls GetClustersMetricByNameFunc, or GetClustersMetricByNameWithContextFunc if only that is set
+func (m *MockClusterService) GetClustersMetricByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ClustersMetric, r1 int, r2 error) {
+	m.record("GetClustersMetricByName", name, opts)
+	if m.GetClustersMetricByNameFunc != nil {
+		return m.GetClustersMetricByNameFunc(name, opts...)
+	}
+	if m.GetClustersMetricByNameWithContextFunc != nil {
+		return m.GetClustersMetricByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ClusterService.GetClustersMetricByName")
+	return
+}
+
+// GetClustersMetricByNameWithContext records the call and calls GetClustersMetricByNameWithContextFunc, or GetClustersMetricByNameFunc if only that is set
+func (m *MockClusterService) GetClustersMetricByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ClustersMetric, r1 int, r2 error) {
+	m.record("GetClustersMetricByNameWithContext", ctx, name, opts)
+	if m.GetClustersMetricByNameWithContextFunc != nil {
+		return m.GetClustersMetricByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetClustersMetricByNameFunc != nil {
+		return m.GetClustersMetricByNameFunc(name, opts...)
+	}
+	r2 = notMocked("ClusterService.GetClustersMetricByNameWithContext")
+	return
+}
+
+// GetClustersMetricByID records the call and calls GetClustersMetricByIDFunc, or GetClustersMetricByIDWithContextFunc if only that is set
+func (m *MockClusterService) GetClustersMetricByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ClustersMetric, r1 int, r2 error) {
+	m.record("GetClustersMetricByID", id, opts)
+	if m.GetClustersMetricByIDFunc != nil {
+		return m.GetClustersMetricByIDFunc(id, opts...)
+	}
+	if m.GetClustersMetricByIDWithContextFunc != nil {
+		return m.GetClustersMetricByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("ClusterService.GetClustersMetricByID")
+	return
+}
+
+// GetClustersMetricByIDWithContext records the call and calls GetClustersMetricByIDWithContextFunc, or GetClustersMetricByIDFunc if only that is set
+func (m *MockClusterService) GetClustersMetricByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ClustersMetric, r1 int, r2 error) {
+	m.record("GetClustersMetricByIDWithContext", ctx, id, opts)
+	if m.GetClustersMetricByIDWithContextFunc != nil {
+		return m.GetClustersMetricByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetClustersMetricByIDFunc != nil {
+		return m.GetClustersMetricByIDFunc(id, opts...)
+	}
+	r2 = notMocked("ClusterService.GetClustersMetricByIDWithContext")
+	return
+}
+
+// ListClustersMetrics records the call and calls ListClustersMetricsFunc, or ListClustersMetricsWithContextFunc if only that is set
+func (m *MockClusterService) ListClustersMetrics(p *cloudstack.ListClustersMetricsParams) (r0 *cloudstack.ListClustersMetricsResponse, r1 error) {
+	m.record("ListClustersMetrics", p)
+	if m.ListClustersMetricsFunc != nil {
+		return m.ListClustersMetricsFunc(p)
+	}
+	if m.ListClustersMetricsWithContextFunc != nil {
+		return m.ListClustersMetricsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.ListClustersMetrics")
+	return
+}
+
+// ListClustersMetricsWithContext records the call and calls ListClustersMetricsWithContextFunc, or ListClustersMetricsFunc if only that is set
+func (m *MockClusterService) ListClustersMetricsWithContext(ctx context.Context, p *cloudstack.ListClustersMetricsParams) (r0 *cloudstack.ListClustersMetricsResponse, r1 error) {
+	m.record("ListClustersMetricsWithContext", ctx, p)
+	if m.ListClustersMetricsWithContextFunc != nil {
+		return m.ListClustersMetricsWithContextFunc(ctx, p)
+	}
+	if m.ListClustersMetricsFunc != nil {
+		return m.ListClustersMetricsFunc(p)
+	}
+	r1 = notMocked("ClusterService.ListClustersMetricsWithContext")
+	return
+}
+
+// NewListClustersMetricsPager records the call and calls NewListClustersMetricsPagerFunc
+func (m *MockClusterService) NewListClustersMetricsPager(p *cloudstack.ListClustersMetricsParams) (r0 *cloudstack.ListClustersMetricsPager) {
+	m.record("NewListClustersMetricsPager", p)
+	if m.NewListClustersMetricsPagerFunc != nil {
+		return m.NewListClustersMetricsPagerFunc(p)
+	}
+	return
+}
+
+// ListClustersMetricsAll records the call and calls ListClustersMetricsAllFunc, or ListClustersMetricsAllWithContextFunc if only that is set
+func (m *MockClusterService) ListClustersMetricsAll(p *cloudstack.ListClustersMetricsParams) (r0 *cloudstack.ListClustersMetricsResponse, r1 error) {
+	m.record("ListClustersMetricsAll", p)
+	if m.ListClustersMetricsAllFunc != nil {
+		return m.ListClustersMetricsAllFunc(p)
+	}
+	if m.ListClustersMetricsAllWithContextFunc != nil {
+		return m.ListClustersMetricsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.ListClustersMetricsAll")
+	return
+}
+
+// ListClustersMetricsAllWithContext records the call and calls ListClustersMetricsAllWithContextFunc, or ListClustersMetricsAllFunc if only that is set
+func (m *MockClusterService) ListClustersMetricsAllWithContext(ctx context.Context, p *cloudstack.ListClustersMetricsParams) (r0 *cloudstack.ListClustersMetricsResponse, r1 error) {
+	m.record("ListClustersMetricsAllWithContext", ctx, p)
+	if m.ListClustersMetricsAllWithContextFunc != nil {
+		return m.ListClustersMetricsAllWithContextFunc(ctx, p)
+	}
+	if m.ListClustersMetricsAllFunc != nil {
+		return m.ListClustersMetricsAllFunc(p)
+	}
+	r1 = notMocked("ClusterService.ListClustersMetricsAllWithContext")
+	return
+}
+
+// NewListDedicatedClustersParams records the call and calls NewListDedicatedClustersParamsFunc
+func (m *MockClusterService) NewListDedicatedClustersParams() (r0 *cloudstack.ListDedicatedClustersParams) {
+	m.record("NewListDedicatedClustersParams")
+	if m.NewListDedicatedClustersParamsFunc != nil {
+		return m.NewListDedicatedClustersParamsFunc()
+	}
+	return cloudstack.NewClusterService(nil).NewListDedicatedClustersParams()
+}
+
+// ListDedicatedClusters records the call and calls ListDedicatedClustersFunc, or ListDedicatedClustersWithContextFunc if only that is set
+func (m *MockClusterService) ListDedicatedClusters(p *cloudstack.ListDedicatedClustersParams) (r0 *cloudstack.ListDedicatedClustersResponse, r1 error) {
+	m.record("ListDedicatedClusters", p)
+	if m.ListDedicatedClustersFunc != nil {
+		return m.ListDedicatedClustersFunc(p)
+	}
+	if m.ListDedicatedClustersWithContextFunc != nil {
+		return m.ListDedicatedClustersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.ListDedicatedClusters")
+	return
+}
+
+// ListDedicatedClustersWithContext records the call and calls ListDedicatedClustersWithContextFunc, or ListDedicatedClustersFunc if only that is set
+func (m *MockClusterService) ListDedicatedClustersWithContext(ctx context.Context, p *cloudstack.ListDedicatedClustersParams) (r0 *cloudstack.ListDedicatedClustersResponse, r1 error) {
+	m.record("ListDedicatedClustersWithContext", ctx, p)
+	if m.ListDedicatedClustersWithContextFunc != nil {
+		return m.ListDedicatedClustersWithContextFunc(ctx, p)
+	}
+	if m.ListDedicatedClustersFunc != nil {
+		return m.ListDedicatedClustersFunc(p)
+	}
+	r1 = notMocked("ClusterService.ListDedicatedClustersWithContext")
+	return
+}
+
+// NewListDedicatedClustersPager records the call and calls NewListDedicatedClustersPagerFunc
+func (m *MockClusterService) NewListDedicatedClustersPager(p *cloudstack.ListDedicatedClustersParams) (r0 *cloudstack.ListDedicatedClustersPager) {
+	m.record("NewListDedicatedClustersPager", p)
+	if m.NewListDedicatedClustersPagerFunc != nil {
+		return m.NewListDedicatedClustersPagerFunc(p)
+	}
+	return
+}
+
+// ListDedicatedClustersAll records the call and calls ListDedicatedClustersAllFunc, or ListDedicatedClustersAllWithContextFunc if only that is set
+func (m *MockClusterService) ListDedicatedClustersAll(p *cloudstack.ListDedicatedClustersParams) (r0 *cloudstack.ListDedicatedClustersResponse, r1 error) {
+	m.record("ListDedicatedClustersAll", p)
+	if m.ListDedicatedClustersAllFunc != nil {
+		return m.ListDedicatedClustersAllFunc(p)
+	}
+	if m.ListDedicatedClustersAllWithContextFunc != nil {
+		return m.ListDedicatedClustersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.ListDedicatedClustersAll")
+	return
+}
+
+// ListDedicatedClustersAllWithContext records the call and calls ListDedicatedClustersAllWithContextFunc, or ListDedicatedClustersAllFunc if only that is set
+func (m *MockClusterService) ListDedicatedClustersAllWithContext(ctx context.Context, p *cloudstack.ListDedicatedClustersParams) (r0 *cloudstack.ListDedicatedClustersResponse, r1 error) {
+	m.record("ListDedicatedClustersAllWithContext", ctx, p)
+	if m.ListDedicatedClustersAllWithContextFunc != nil {
+		return m.ListDedicatedClustersAllWithContextFunc(ctx, p)
+	}
+	if m.ListDedicatedClustersAllFunc != nil {
+		return m.ListDedicatedClustersAllFunc(p)
+	}
+	r1 = notMocked("ClusterService.ListDedicatedClustersAllWithContext")
+	return
+}
+
+// NewReleaseDedicatedClusterParams records the call and calls NewReleaseDedicatedClusterParamsFunc
+func (m *MockClusterService) NewReleaseDedicatedClusterParams(clusterid string) (r0 *cloudstack.ReleaseDedicatedClusterParams) {
+	m.record("NewReleaseDedicatedClusterParams", clusterid)
+	if m.NewReleaseDedicatedClusterParamsFunc != nil {
+		return m.NewReleaseDedicatedClusterParamsFunc(clusterid)
+	}
+	return cloudstack.NewClusterService(nil).NewReleaseDedicatedClusterParams(clusterid)
+}
+
+// ReleaseDedicatedCluster records the call and calls ReleaseDedicatedClusterFunc, or ReleaseDedicatedClusterWithContextFunc if only that is set
+func (m *MockClusterService) ReleaseDedicatedCluster(p *cloudstack.ReleaseDedicatedClusterParams) (r0 *cloudstack.ReleaseDedicatedClusterResponse, r1 error) {
+	m.record("ReleaseDedicatedCluster", p)
+	if m.ReleaseDedicatedClusterFunc != nil {
+		return m.ReleaseDedicatedClusterFunc(p)
+	}
+	if m.ReleaseDedicatedClusterWithContextFunc != nil {
+		return m.ReleaseDedicatedClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.ReleaseDedicatedCluster")
+	return
+}
+
+// ReleaseDedicatedClusterWithContext records the call and calls ReleaseDedicatedClusterWithContextFunc, or ReleaseDedicatedClusterFunc if only that is set
+func (m *MockClusterService) ReleaseDedicatedClusterWithContext(ctx context.Context, p *cloudstack.ReleaseDedicatedClusterParams) (r0 *cloudstack.ReleaseDedicatedClusterResponse, r1 error) {
+	m.record("ReleaseDedicatedClusterWithContext", ctx, p)
+	if m.ReleaseDedicatedClusterWithContextFunc != nil {
+		return m.ReleaseDedicatedClusterWithContextFunc(ctx, p)
+	}
+	if m.ReleaseDedicatedClusterFunc != nil {
+		return m.ReleaseDedicatedClusterFunc(p)
+	}
+	r1 = notMocked("ClusterService.ReleaseDedicatedClusterWithContext")
+	return
+}
+
+// ReleaseDedicatedClusterAsync records the call and calls ReleaseDedicatedClusterAsyncFunc, or ReleaseDedicatedClusterAsyncWithContextFunc if only that is set
+func (m *MockClusterService) ReleaseDedicatedClusterAsync(p *cloudstack.ReleaseDedicatedClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReleaseDedicatedClusterAsync", p)
+	if m.ReleaseDedicatedClusterAsyncFunc != nil {
+		return m.ReleaseDedicatedClusterAsyncFunc(p)
+	}
+	if m.ReleaseDedicatedClusterAsyncWithContextFunc != nil {
+		return m.ReleaseDedicatedClusterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.ReleaseDedicatedClusterAsync")
+	return
+}
+
+// ReleaseDedicatedClusterAsyncWithContext records the call and calls ReleaseDedicatedClusterAsyncWithContextFunc, or ReleaseDedicatedClusterAsyncFunc if only that is set
+func (m *MockClusterService) ReleaseDedicatedClusterAsyncWithContext(ctx context.Context, p *cloudstack.ReleaseDedicatedClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReleaseDedicatedClusterAsyncWithContext", ctx, p)
+	if m.ReleaseDedicatedClusterAsyncWithContextFunc != nil {
+		return m.ReleaseDedicatedClusterAsyncWithContextFunc(ctx, p)
+	}
+	if m.ReleaseDedicatedClusterAsyncFunc != nil {
+		return m.ReleaseDedicatedClusterAsyncFunc(p)
+	}
+	r1 = notMocked("ClusterService.ReleaseDedicatedClusterAsyncWithContext")
+	return
+}
+
+// NewUpdateClusterParams records the call and calls NewUpdateClusterParamsFunc
+func (m *MockClusterService) NewUpdateClusterParams(id string) (r0 *cloudstack.UpdateClusterParams) {
+	m.record("NewUpdateClusterParams", id)
+	if m.NewUpdateClusterParamsFunc != nil {
+		return m.NewUpdateClusterParamsFunc(id)
+	}
+	return cloudstack.NewClusterService(nil).NewUpdateClusterParams(id)
+}
+
+// UpdateCluster records the call and calls UpdateClusterFunc, or UpdateClusterWithContextFunc if only that is set
+func (m *MockClusterService) UpdateCluster(p *cloudstack.UpdateClusterParams) (r0 *cloudstack.UpdateClusterResponse, r1 error) {
+	m.record("UpdateCluster", p)
+	if m.UpdateClusterFunc != nil {
+		return m.UpdateClusterFunc(p)
+	}
+	if m.UpdateClusterWithContextFunc != nil {
+		return m.UpdateClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ClusterService.UpdateCluster")
+	return
+}
+
+// UpdateClusterWithContext records the call and calls UpdateClusterWithContextFunc, or UpdateClusterFunc if only that is set
+func (m *MockClusterService) UpdateClusterWithContext(ctx context.Context, p *cloudstack.UpdateClusterParams) (r0 *cloudstack.UpdateClusterResponse, r1 error) {
+	m.record("UpdateClusterWithContext", ctx, p)
+	if m.UpdateClusterWithContextFunc != nil {
+		return m.UpdateClusterWithContextFunc(ctx, p)
+	}
+	if m.UpdateClusterFunc != nil {
+		return m.UpdateClusterFunc(p)
+	}
+	r1 = notMocked("ClusterService.UpdateClusterWithContext")
+	return
+}
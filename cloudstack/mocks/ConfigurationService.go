@@ -0,0 +1,305 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockConfigurationService is a mock of cloudstack.ConfigurationServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockConfigurationService struct {
+	Recorder
+
+	NewCloudianIsEnabledParamsFunc           func() *cloudstack.CloudianIsEnabledParams
+	CloudianIsEnabledFunc                    func(p *cloudstack.CloudianIsEnabledParams) (*cloudstack.CloudianIsEnabledResponse, error)
+	CloudianIsEnabledWithContextFunc         func(ctx context.Context, p *cloudstack.CloudianIsEnabledParams) (*cloudstack.CloudianIsEnabledResponse, error)
+	NewListCapabilitiesParamsFunc            func() *cloudstack.ListCapabilitiesParams
+	ListCapabilitiesFunc                     func(p *cloudstack.ListCapabilitiesParams) (*cloudstack.ListCapabilitiesResponse, error)
+	ListCapabilitiesWithContextFunc          func(ctx context.Context, p *cloudstack.ListCapabilitiesParams) (*cloudstack.ListCapabilitiesResponse, error)
+	NewListConfigurationsParamsFunc          func() *cloudstack.ListConfigurationsParams
+	ListConfigurationsFunc                   func(p *cloudstack.ListConfigurationsParams) (*cloudstack.ListConfigurationsResponse, error)
+	ListConfigurationsWithContextFunc        func(ctx context.Context, p *cloudstack.ListConfigurationsParams) (*cloudstack.ListConfigurationsResponse, error)
+	NewListConfigurationsPagerFunc           func(p *cloudstack.ListConfigurationsParams) *cloudstack.ListConfigurationsPager
+	ListConfigurationsAllFunc                func(p *cloudstack.ListConfigurationsParams) (*cloudstack.ListConfigurationsResponse, error)
+	ListConfigurationsAllWithContextFunc     func(ctx context.Context, p *cloudstack.ListConfigurationsParams) (*cloudstack.ListConfigurationsResponse, error)
+	NewListDeploymentPlannersParamsFunc      func() *cloudstack.ListDeploymentPlannersParams
+	ListDeploymentPlannersFunc               func(p *cloudstack.ListDeploymentPlannersParams) (*cloudstack.ListDeploymentPlannersResponse, error)
+	ListDeploymentPlannersWithContextFunc    func(ctx context.Context, p *cloudstack.ListDeploymentPlannersParams) (*cloudstack.ListDeploymentPlannersResponse, error)
+	NewListDeploymentPlannersPagerFunc       func(p *cloudstack.ListDeploymentPlannersParams) *cloudstack.ListDeploymentPlannersPager
+	ListDeploymentPlannersAllFunc            func(p *cloudstack.ListDeploymentPlannersParams) (*cloudstack.ListDeploymentPlannersResponse, error)
+	ListDeploymentPlannersAllWithContextFunc func(ctx context.Context, p *cloudstack.ListDeploymentPlannersParams) (*cloudstack.ListDeploymentPlannersResponse, error)
+	NewUpdateConfigurationParamsFunc         func(name string) *cloudstack.UpdateConfigurationParams
+	UpdateConfigurationFunc                  func(p *cloudstack.UpdateConfigurationParams) (*cloudstack.UpdateConfigurationResponse, error)
+	UpdateConfigurationWithContextFunc       func(ctx context.Context, p *cloudstack.UpdateConfigurationParams) (*cloudstack.UpdateConfigurationResponse, error)
+}
+
+var _ cloudstack.ConfigurationServiceIface = (*MockConfigurationService)(nil)
+
+// NewCloudianIsEnabledParams records the call and calls NewCloudianIsEnabledParamsFunc
+func (m *MockConfigurationService) NewCloudianIsEnabledParams() (r0 *cloudstack.CloudianIsEnabledParams) {
+	m.record("NewCloudianIsEnabledParams")
+	if m.NewCloudianIsEnabledParamsFunc != nil {
+		return m.NewCloudianIsEnabledParamsFunc()
+	}
+	return cloudstack.NewConfigurationService(nil).NewCloudianIsEnabledParams()
+}
+
+// CloudianIsEnabled records the call and calls CloudianIsEnabledFunc, or CloudianIsEnabledWithContextFunc if only that is set
+func (m *MockConfigurationService) CloudianIsEnabled(p *cloudstack.CloudianIsEnabledParams) (r0 *cloudstack.CloudianIsEnabledResponse, r1 error) {
+	m.record("CloudianIsEnabled", p)
+	if m.CloudianIsEnabledFunc != nil {
+		return m.CloudianIsEnabledFunc(p)
+	}
+	if m.CloudianIsEnabledWithContextFunc != nil {
+		return m.CloudianIsEnabledWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ConfigurationService.CloudianIsEnabled")
+	return
+}
+
+// CloudianIsEnabledWithContext records the call and calls CloudianIsEnabledWithContextFunc, or CloudianIsEnabledFunc if only that is set
+func (m *MockConfigurationService) CloudianIsEnabledWithContext(ctx context.Context, p *cloudstack.CloudianIsEnabledParams) (r0 *cloudstack.CloudianIsEnabledResponse, r1 error) {
+	m.record("CloudianIsEnabledWithContext", ctx, p)
+	if m.CloudianIsEnabledWithContextFunc != nil {
+		return m.CloudianIsEnabledWithContextFunc(ctx, p)
+	}
+	if m.CloudianIsEnabledFunc != nil {
+		return m.CloudianIsEnabledFunc(p)
+	}
+	r1 = notMocked("ConfigurationService.CloudianIsEnabledWithContext")
+	return
+}
+
+// NewListCapabilitiesParams records the call and calls NewListCapabilitiesParamsFunc
+func (m *MockConfigurationService) NewListCapabilitiesParams() (r0 *cloudstack.ListCapabilitiesParams) {
+	m.record("NewListCapabilitiesParams")
+	if m.NewListCapabilitiesParamsFunc != nil {
+		return m.NewListCapabilitiesParamsFunc()
+	}
+	return cloudstack.NewConfigurationService(nil).NewListCapabilitiesParams()
+}
+
+// ListCapabilities records the call and calls ListCapabilitiesFunc, or ListCapabilitiesWithContextFunc if only that is set
+func (m *MockConfigurationService) ListCapabilities(p *cloudstack.ListCapabilitiesParams) (r0 *cloudstack.ListCapabilitiesResponse, r1 error) {
+	m.record("ListCapabilities", p)
+	if m.ListCapabilitiesFunc != nil {
+		return m.ListCapabilitiesFunc(p)
+	}
+	if m.ListCapabilitiesWithContextFunc != nil {
+		return m.ListCapabilitiesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ConfigurationService.ListCapabilities")
+	return
+}
+
+// ListCapabilitiesWithContext records the call and calls ListCapabilitiesWithContextFunc, or ListCapabilitiesFunc if only that is set
+func (m *MockConfigurationService) ListCapabilitiesWithContext(ctx context.Context, p *cloudstack.ListCapabilitiesParams) (r0 *cloudstack.ListCapabilitiesResponse, r1 error) {
+	m.record("ListCapabilitiesWithContext", ctx, p)
+	if m.ListCapabilitiesWithContextFunc != nil {
+		return m.ListCapabilitiesWithContextFunc(ctx, p)
+	}
+	if m.ListCapabilitiesFunc != nil {
+		return m.ListCapabilitiesFunc(p)
+	}
+	r1 = notMocked("ConfigurationService.ListCapabilitiesWithContext")
+	return
+}
+
+// NewListConfigurationsParams records the call and calls NewListConfigurationsParamsFunc
+func (m *MockConfigurationService) NewListConfigurationsParams() (r0 *cloudstack.ListConfigurationsParams) {
+	m.record("NewListConfigurationsParams")
+	if m.NewListConfigurationsParamsFunc != nil {
+		return m.NewListConfigurationsParamsFunc()
+	}
+	return cloudstack.NewConfigurationService(nil).NewListConfigurationsParams()
+}
+
+// ListConfigurations records the call and calls ListConfigurationsFunc, or ListConfigurationsWithContextFunc if only that is set
+func (m *MockConfigurationService) ListConfigurations(p *cloudstack.ListConfigurationsParams) (r0 *cloudstack.ListConfigurationsResponse, r1 error) {
+	m.record("ListConfigurations", p)
+	if m.ListConfigurationsFunc != nil {
+		return m.ListConfigurationsFunc(p)
+	}
+	if m.ListConfigurationsWithContextFunc != nil {
+		return m.ListConfigurationsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ConfigurationService.ListConfigurations")
+	return
+}
+
+// ListConfigurationsWithContext records the call and calls ListConfigurationsWithContextFunc, or ListConfigurationsFunc if only that is set
+func (m *MockConfigurationService) ListConfigurationsWithContext(ctx context.Context, p *cloudstack.ListConfigurationsParams) (r0 *cloudstack.ListConfigurationsResponse, r1 error) {
+	m.record("ListConfigurationsWithContext", ctx, p)
+	if m.ListConfigurationsWithContextFunc != nil {
+		return m.ListConfigurationsWithContextFunc(ctx, p)
+	}
+	if m.ListConfigurationsFunc != nil {
+		return m.ListConfigurationsFunc(p)
+	}
+	r1 = notMocked("ConfigurationService.ListConfigurationsWithContext")
+	return
+}
+
+// NewListConfigurationsPager records the call and calls NewListConfigurationsPagerFunc
+func (m *MockConfigurationService) NewListConfigurationsPager(p *cloudstack.ListConfigurationsParams) (r0 *cloudstack.ListConfigurationsPager) {
+	m.record("NewListConfigurationsPager", p)
+	if m.NewListConfigurationsPagerFunc != nil {
+		return m.NewListConfigurationsPagerFunc(p)
+	}
+	return
+}
+
+// ListConfigurationsAll records the call and calls ListConfigurationsAllFunc, or ListConfigurationsAllWithContextFunc if only that is set
+func (m *MockConfigurationService) ListConfigurationsAll(p *cloudstack.ListConfigurationsParams) (r0 *cloudstack.ListConfigurationsResponse, r1 error) {
+	m.record("ListConfigurationsAll", p)
+	if m.ListConfigurationsAllFunc != nil {
+		return m.ListConfigurationsAllFunc(p)
+	}
+	if m.ListConfigurationsAllWithContextFunc != nil {
+		return m.ListConfigurationsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ConfigurationService.ListConfigurationsAll")
+	return
+}
+
+// ListConfigurationsAllWithContext records the call and calls ListConfigurationsAllWithContextFunc, or ListConfigurationsAllFunc if only that is set
+func (m *MockConfigurationService) ListConfigurationsAllWithContext(ctx context.Context, p *cloudstack.ListConfigurationsParams) (r0 *cloudstack.ListConfigurationsResponse, r1 error) {
+	m.record("ListConfigurationsAllWithContext", ctx, p)
+	if m.ListConfigurationsAllWithContextFunc != nil {
+		return m.ListConfigurationsAllWithContextFunc(ctx, p)
+	}
+	if m.ListConfigurationsAllFunc != nil {
+		return m.ListConfigurationsAllFunc(p)
+	}
+	r1 = notMocked("ConfigurationService.ListConfigurationsAllWithContext")
+	return
+}
+
+// NewListDeploymentPlannersParams records the call and calls NewListDeploymentPlannersParamsFunc
+func (m *MockConfigurationService) NewListDeploymentPlannersParams() (r0 *cloudstack.ListDeploymentPlannersParams) {
+	m.record("NewListDeploymentPlannersParams")
+	if m.NewListDeploymentPlannersParamsFunc != nil {
+		return m.NewListDeploymentPlannersParamsFunc()
+	}
+	return cloudstack.NewConfigurationService(nil).NewListDeploymentPlannersParams()
+}
+
+// ListDeploymentPlanners records the call and calls ListDeploymentPlannersFunc, or ListDeploymentPlannersWithContextFunc if only that is set
+func (m *MockConfigurationService) ListDeploymentPlanners(p *cloudstack.ListDeploymentPlannersParams) (r0 *cloudstack.ListDeploymentPlannersResponse, r1 error) {
+	m.record("ListDeploymentPlanners", p)
+	if m.ListDeploymentPlannersFunc != nil {
+		return m.ListDeploymentPlannersFunc(p)
+	}
+	if m.ListDeploymentPlannersWithContextFunc != nil {
+		return m.ListDeploymentPlannersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ConfigurationService.ListDeploymentPlanners")
+	return
+}
+
+// ListDeploymentPlannersWithContext records the call and calls ListDeploymentPlannersWithContextFunc, or ListDeploymentPlannersFunc if only that is set
+func (m *MockConfigurationService) ListDeploymentPlannersWithContext(ctx context.Context, p *cloudstack.ListDeploymentPlannersParams) (r0 *cloudstack.ListDeploymentPlannersResponse, r1 error) {
+	m.record("ListDeploymentPlannersWithContext", ctx, p)
+	if m.ListDeploymentPlannersWithContextFunc != nil {
+		return m.ListDeploymentPlannersWithContextFunc(ctx, p)
+	}
+	if m.ListDeploymentPlannersFunc != nil {
+		return m.ListDeploymentPlannersFunc(p)
+	}
+	r1 = notMocked("ConfigurationService.ListDeploymentPlannersWithContext")
+	return
+}
+
+// NewListDeploymentPlannersPager records the call and calls NewListDeploymentPlannersPagerFunc
+func (m *MockConfigurationService) NewListDeploymentPlannersPager(p *cloudstack.ListDeploymentPlannersParams) (r0 *cloudstack.ListDeploymentPlannersPager) {
+	m.record("NewListDeploymentPlannersPager", p)
+	if m.NewListDeploymentPlannersPagerFunc != nil {
+		return m.NewListDeploymentPlannersPagerFunc(p)
+	}
+	return
+}
+
+// ListDeploymentPlannersAll records the call and calls ListDeploymentPlannersAllFunc, or ListDeploymentPlannersAllWithContextFunc if only that is set
+func (m *MockConfigurationService) ListDeploymentPlannersAll(p *cloudstack.ListDeploymentPlannersParams) (r0 *cloudstack.ListDeploymentPlannersResponse, r1 error) {
+	m.record("ListDeploymentPlannersAll", p)
+	if m.ListDeploymentPlannersAllFunc != nil {
+		return m.ListDeploymentPlannersAllFunc(p)
+	}
+	if m.ListDeploymentPlannersAllWithContextFunc != nil {
+		return m.ListDeploymentPlannersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ConfigurationService.ListDeploymentPlannersAll")
+	return
+}
+
+// ListDeploymentPlannersAllWithContext records the call and calls ListDeploymentPlannersAllWithContextFunc, or ListDeploymentPlannersAllFunc if only that is set
+func (m *MockConfigurationService) ListDeploymentPlannersAllWithContext(ctx context.Context, p *cloudstack.ListDeploymentPlannersParams) (r0 *cloudstack.ListDeploymentPlannersResponse, r1 error) {
+	m.record("ListDeploymentPlannersAllWithContext", ctx, p)
+	if m.ListDeploymentPlannersAllWithContextFunc != nil {
+		return m.ListDeploymentPlannersAllWithContextFunc(ctx, p)
+	}
+	if m.ListDeploymentPlannersAllFunc != nil {
+		return m.ListDeploymentPlannersAllFunc(p)
+	}
+	r1 = notMocked("ConfigurationService.ListDeploymentPlannersAllWithContext")
+	return
+}
+
+// NewUpdateConfigurationParams records the call and calls NewUpdateConfigurationParamsFunc
+func (m *MockConfigurationService) NewUpdateConfigurationParams(name string) (r0 *cloudstack.UpdateConfigurationParams) {
+	m.record("NewUpdateConfigurationParams", name)
+	if m.NewUpdateConfigurationParamsFunc != nil {
+		return m.NewUpdateConfigurationParamsFunc(name)
+	}
+	return cloudstack.NewConfigurationService(nil).NewUpdateConfigurationParams(name)
+}
+
+// UpdateConfiguration records the call and calls UpdateConfigurationFunc, or UpdateConfigurationWithContextFunc if only that is set
+func (m *MockConfigurationService) UpdateConfiguration(p *cloudstack.UpdateConfigurationParams) (r0 *cloudstack.UpdateConfigurationResponse, r1 error) {
+	m.record("UpdateConfiguration", p)
+	if m.UpdateConfigurationFunc != nil {
+		return m.UpdateConfigurationFunc(p)
+	}
+	if m.UpdateConfigurationWithContextFunc != nil {
+		return m.UpdateConfigurationWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ConfigurationService.UpdateConfiguration")
+	return
+}
+
+// UpdateConfigurationWithContext records the call and calls UpdateConfigurationWithContextFunc, or UpdateConfigurationFunc if only that is set
+func (m *MockConfigurationService) UpdateConfigurationWithContext(ctx context.Context, p *cloudstack.UpdateConfigurationParams) (r0 *cloudstack.UpdateConfigurationResponse, r1 error) {
+	m.record("UpdateConfigurationWithContext", ctx, p)
+	if m.UpdateConfigurationWithContextFunc != nil {
+		return m.UpdateConfigurationWithContextFunc(ctx, p)
+	}
+	if m.UpdateConfigurationFunc != nil {
+		return m.UpdateConfigurationFunc(p)
+	}
+	r1 = notMocked("ConfigurationService.UpdateConfigurationWithContext")
+	return
+}
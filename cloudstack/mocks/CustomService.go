@@ -0,0 +1,67 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockCustomService is a mock of cloudstack.CustomServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockCustomService struct {
+	Recorder
+
+	CustomRequestFunc            func(api string, p *cloudstack.CustomServiceParams, result interface{}) error
+	CustomRequestWithContextFunc func(ctx context.Context, api string, p *cloudstack.CustomServiceParams, result interface{}) error
+}
+
+var _ cloudstack.CustomServiceIface = (*MockCustomService)(nil)
+
+// CustomRequest records the call and calls CustomRequestFunc, or CustomRequestWithContextFunc if only that is set
+func (m *MockCustomService) CustomRequest(api string, p *cloudstack.CustomServiceParams, result interface{}) (r0 error) {
+	m.record("CustomRequest", api, p, result)
+	if m.CustomRequestFunc != nil {
+		return m.CustomRequestFunc(api, p, result)
+	}
+	if m.CustomRequestWithContextFunc != nil {
+		return m.CustomRequestWithContextFunc(context.Background(), api, p, result)
+	}
+	r0 = notMocked("CustomService.CustomRequest")
+	return
+}
+
+// CustomRequestWithContext records the call and calls CustomRequestWithContextFunc, or CustomRequestFunc if only that is set
+func (m *MockCustomService) CustomRequestWithContext(ctx context.Context, api string, p *cloudstack.CustomServiceParams, result interface{}) (r0 error) {
+	m.record("CustomRequestWithContext", ctx, api, p, result)
+	if m.CustomRequestWithContextFunc != nil {
+		return m.CustomRequestWithContextFunc(ctx, api, p, result)
+	}
+	if m.CustomRequestFunc != nil {
+		return m.CustomRequestFunc(api, p, result)
+	}
+	r0 = notMocked("CustomService.CustomRequestWithContext")
+	return
+}
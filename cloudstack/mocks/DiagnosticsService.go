@@ -0,0 +1,171 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockDiagnosticsService is a mock of cloudstack.DiagnosticsServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockDiagnosticsService struct {
+	Recorder
+
+	NewGetDiagnosticsDataParamsFunc        func(targetid string) *cloudstack.GetDiagnosticsDataParams
+	GetDiagnosticsDataFunc                 func(p *cloudstack.GetDiagnosticsDataParams) (*cloudstack.GetDiagnosticsDataResponse, error)
+	GetDiagnosticsDataWithContextFunc      func(ctx context.Context, p *cloudstack.GetDiagnosticsDataParams) (*cloudstack.GetDiagnosticsDataResponse, error)
+	GetDiagnosticsDataAsyncFunc            func(p *cloudstack.GetDiagnosticsDataParams) (*cloudstack.Job, error)
+	GetDiagnosticsDataAsyncWithContextFunc func(ctx context.Context, p *cloudstack.GetDiagnosticsDataParams) (*cloudstack.Job, error)
+	NewRunDiagnosticsParamsFunc            func(ipaddress string, targetid string, diagnosticsType string) *cloudstack.RunDiagnosticsParams
+	RunDiagnosticsFunc                     func(p *cloudstack.RunDiagnosticsParams) (*cloudstack.RunDiagnosticsResponse, error)
+	RunDiagnosticsWithContextFunc          func(ctx context.Context, p *cloudstack.RunDiagnosticsParams) (*cloudstack.RunDiagnosticsResponse, error)
+	RunDiagnosticsAsyncFunc                func(p *cloudstack.RunDiagnosticsParams) (*cloudstack.Job, error)
+	RunDiagnosticsAsyncWithContextFunc     func(ctx context.Context, p *cloudstack.RunDiagnosticsParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.DiagnosticsServiceIface = (*MockDiagnosticsService)(nil)
+
+// NewGetDiagnosticsDataParams records the call and calls NewGetDiagnosticsDataParamsFunc
+func (m *MockDiagnosticsService) NewGetDiagnosticsDataParams(targetid string) (r0 *cloudstack.GetDiagnosticsDataParams) {
+	m.record("NewGetDiagnosticsDataParams", targetid)
+	if m.NewGetDiagnosticsDataParamsFunc != nil {
+		return m.NewGetDiagnosticsDataParamsFunc(targetid)
+	}
+	return cloudstack.NewDiagnosticsService(nil).NewGetDiagnosticsDataParams(targetid)
+}
+
+// GetDiagnosticsData records the call and calls GetDiagnosticsDataFunc, or GetDiagnosticsDataWithContextFunc if only that is set
+func (m *MockDiagnosticsService) GetDiagnosticsData(p *cloudstack.GetDiagnosticsDataParams) (r0 *cloudstack.GetDiagnosticsDataResponse, r1 error) {
+	m.record("GetDiagnosticsData", p)
+	if m.GetDiagnosticsDataFunc != nil {
+		return m.GetDiagnosticsDataFunc(p)
+	}
+	if m.GetDiagnosticsDataWithContextFunc != nil {
+		return m.GetDiagnosticsDataWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DiagnosticsService.GetDiagnosticsData")
+	return
+}
+
+// GetDiagnosticsDataWithContext records the call and calls GetDiagnosticsDataWithContextFunc, or GetDiagnosticsDataFunc if only that is set
+func (m *MockDiagnosticsService) GetDiagnosticsDataWithContext(ctx context.Context, p *cloudstack.GetDiagnosticsDataParams) (r0 *cloudstack.GetDiagnosticsDataResponse, r1 error) {
+	m.record("GetDiagnosticsDataWithContext", ctx, p)
+	if m.GetDiagnosticsDataWithContextFunc != nil {
+		return m.GetDiagnosticsDataWithContextFunc(ctx, p)
+	}
+	if m.GetDiagnosticsDataFunc != nil {
+		return m.GetDiagnosticsDataFunc(p)
+	}
+	r1 = notMocked("DiagnosticsService.GetDiagnosticsDataWithContext")
+	return
+}
+
+// GetDiagnosticsDataAsync records the call and calls GetDiagnosticsDataAsyncFunc, or GetDiagnosticsDataAsyncWithContextFunc if only that is set
+func (m *MockDiagnosticsService) GetDiagnosticsDataAsync(p *cloudstack.GetDiagnosticsDataParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("GetDiagnosticsDataAsync", p)
+	if m.GetDiagnosticsDataAsyncFunc != nil {
+		return m.GetDiagnosticsDataAsyncFunc(p)
+	}
+	if m.GetDiagnosticsDataAsyncWithContextFunc != nil {
+		return m.GetDiagnosticsDataAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DiagnosticsService.GetDiagnosticsDataAsync")
+	return
+}
+
+// GetDiagnosticsDataAsyncWithContext records the call and calls GetDiagnosticsDataAsyncWithContextFunc, or GetDiagnosticsDataAsyncFunc if only that is set
+func (m *MockDiagnosticsService) GetDiagnosticsDataAsyncWithContext(ctx context.Context, p *cloudstack.GetDiagnosticsDataParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("GetDiagnosticsDataAsyncWithContext", ctx, p)
+	if m.GetDiagnosticsDataAsyncWithContextFunc != nil {
+		return m.GetDiagnosticsDataAsyncWithContextFunc(ctx, p)
+	}
+	if m.GetDiagnosticsDataAsyncFunc != nil {
+		return m.GetDiagnosticsDataAsyncFunc(p)
+	}
+	r1 = notMocked("DiagnosticsService.GetDiagnosticsDataAsyncWithContext")
+	return
+}
+
+// NewRunDiagnosticsParams records the call and calls NewRunDiagnosticsParamsFunc
+func (m *MockDiagnosticsService) NewRunDiagnosticsParams(ipaddress string, targetid string, diagnosticsType string) (r0 *cloudstack.RunDiagnosticsParams) {
+	m.record("NewRunDiagnosticsParams", ipaddress, targetid, diagnosticsType)
+	if m.NewRunDiagnosticsParamsFunc != nil {
+		return m.NewRunDiagnosticsParamsFunc(ipaddress, targetid, diagnosticsType)
+	}
+	return cloudstack.NewDiagnosticsService(nil).NewRunDiagnosticsParams(ipaddress, targetid, diagnosticsType)
+}
+
+// RunDiagnostics records the call and calls RunDiagnosticsFunc, or RunDiagnosticsWithContextFunc if only that is set
+func (m *MockDiagnosticsService) RunDiagnostics(p *cloudstack.RunDiagnosticsParams) (r0 *cloudstack.RunDiagnosticsResponse, r1 error) {
+	m.record("RunDiagnostics", p)
+	if m.RunDiagnosticsFunc != nil {
+		return m.RunDiagnosticsFunc(p)
+	}
+	if m.RunDiagnosticsWithContextFunc != nil {
+		return m.RunDiagnosticsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DiagnosticsService.RunDiagnostics")
+	return
+}
+
+// RunDiagnosticsWithContext records the call and calls RunDiagnosticsWithContextFunc, or RunDiagnosticsFunc if only that is set
+func (m *MockDiagnosticsService) RunDiagnosticsWithContext(ctx context.Context, p *cloudstack.RunDiagnosticsParams) (r0 *cloudstack.RunDiagnosticsResponse, r1 error) {
+	m.record("RunDiagnosticsWithContext", ctx, p)
+	if m.RunDiagnosticsWithContextFunc != nil {
+		return m.RunDiagnosticsWithContextFunc(ctx, p)
+	}
+	if m.RunDiagnosticsFunc != nil {
+		return m.RunDiagnosticsFunc(p)
+	}
+	r1 = notMocked("DiagnosticsService.RunDiagnosticsWithContext")
+	return
+}
+
+// RunDiagnosticsAsync records the call and calls RunDiagnosticsAsyncFunc, or RunDiagnosticsAsyncWithContextFunc if only that is set
+func (m *MockDiagnosticsService) RunDiagnosticsAsync(p *cloudstack.RunDiagnosticsParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RunDiagnosticsAsync", p)
+	if m.RunDiagnosticsAsyncFunc != nil {
+		return m.RunDiagnosticsAsyncFunc(p)
+	}
+	if m.RunDiagnosticsAsyncWithContextFunc != nil {
+		return m.RunDiagnosticsAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DiagnosticsService.RunDiagnosticsAsync")
+	return
+}
+
+// RunDiagnosticsAsyncWithContext records the call and calls RunDiagnosticsAsyncWithContextFunc, or RunDiagnosticsAsyncFunc if only that is set
+func (m *MockDiagnosticsService) RunDiagnosticsAsyncWithContext(ctx context.Context, p *cloudstack.RunDiagnosticsParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RunDiagnosticsAsyncWithContext", ctx, p)
+	if m.RunDiagnosticsAsyncWithContextFunc != nil {
+		return m.RunDiagnosticsAsyncWithContextFunc(ctx, p)
+	}
+	if m.RunDiagnosticsAsyncFunc != nil {
+		return m.RunDiagnosticsAsyncFunc(p)
+	}
+	r1 = notMocked("DiagnosticsService.RunDiagnosticsAsyncWithContext")
+	return
+}
@@ -0,0 +1,313 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockDiskOfferingService is a mock of cloudstack.DiskOfferingServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockDiskOfferingService struct {
+	Recorder
+
+	NewCreateDiskOfferingParamsFunc      func(displaytext string, name string) *cloudstack.CreateDiskOfferingParams
+	CreateDiskOfferingFunc               func(p *cloudstack.CreateDiskOfferingParams) (*cloudstack.CreateDiskOfferingResponse, error)
+	CreateDiskOfferingWithContextFunc    func(ctx context.Context, p *cloudstack.CreateDiskOfferingParams) (*cloudstack.CreateDiskOfferingResponse, error)
+	NewDeleteDiskOfferingParamsFunc      func(id string) *cloudstack.DeleteDiskOfferingParams
+	DeleteDiskOfferingFunc               func(p *cloudstack.DeleteDiskOfferingParams) (*cloudstack.DeleteDiskOfferingResponse, error)
+	DeleteDiskOfferingWithContextFunc    func(ctx context.Context, p *cloudstack.DeleteDiskOfferingParams) (*cloudstack.DeleteDiskOfferingResponse, error)
+	NewListDiskOfferingsParamsFunc       func() *cloudstack.ListDiskOfferingsParams
+	GetDiskOfferingIDFunc                func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetDiskOfferingIDWithContextFunc     func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetDiskOfferingByNameFunc            func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.DiskOffering, int, error)
+	GetDiskOfferingByNameWithContextFunc func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.DiskOffering, int, error)
+	GetDiskOfferingByIDFunc              func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.DiskOffering, int, error)
+	GetDiskOfferingByIDWithContextFunc   func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.DiskOffering, int, error)
+	ListDiskOfferingsFunc                func(p *cloudstack.ListDiskOfferingsParams) (*cloudstack.ListDiskOfferingsResponse, error)
+	ListDiskOfferingsWithContextFunc     func(ctx context.Context, p *cloudstack.ListDiskOfferingsParams) (*cloudstack.ListDiskOfferingsResponse, error)
+	NewListDiskOfferingsPagerFunc        func(p *cloudstack.ListDiskOfferingsParams) *cloudstack.ListDiskOfferingsPager
+	ListDiskOfferingsAllFunc             func(p *cloudstack.ListDiskOfferingsParams) (*cloudstack.ListDiskOfferingsResponse, error)
+	ListDiskOfferingsAllWithContextFunc  func(ctx context.Context, p *cloudstack.ListDiskOfferingsParams) (*cloudstack.ListDiskOfferingsResponse, error)
+	NewUpdateDiskOfferingParamsFunc      func(id string) *cloudstack.UpdateDiskOfferingParams
+	UpdateDiskOfferingFunc               func(p *cloudstack.UpdateDiskOfferingParams) (*cloudstack.UpdateDiskOfferingResponse, error)
+	UpdateDiskOfferingWithContextFunc    func(ctx context.Context, p *cloudstack.UpdateDiskOfferingParams) (*cloudstack.UpdateDiskOfferingResponse, error)
+}
+
+var _ cloudstack.DiskOfferingServiceIface = (*MockDiskOfferingService)(nil)
+
+// NewCreateDiskOfferingParams records the call and calls NewCreateDiskOfferingParamsFunc
+func (m *MockDiskOfferingService) NewCreateDiskOfferingParams(displaytext string, name string) (r0 *cloudstack.CreateDiskOfferingParams) {
+	m.record("NewCreateDiskOfferingParams", displaytext, name)
+	if m.NewCreateDiskOfferingParamsFunc != nil {
+		return m.NewCreateDiskOfferingParamsFunc(displaytext, name)
+	}
+	return cloudstack.NewDiskOfferingService(nil).NewCreateDiskOfferingParams(displaytext, name)
+}
+
+// CreateDiskOffering records the call and calls CreateDiskOfferingFunc, or CreateDiskOfferingWithContextFunc if only that is set
+func (m *MockDiskOfferingService) CreateDiskOffering(p *cloudstack.CreateDiskOfferingParams) (r0 *cloudstack.CreateDiskOfferingResponse, r1 error) {
+	m.record("CreateDiskOffering", p)
+	if m.CreateDiskOfferingFunc != nil {
+		return m.CreateDiskOfferingFunc(p)
+	}
+	if m.CreateDiskOfferingWithContextFunc != nil {
+		return m.CreateDiskOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DiskOfferingService.CreateDiskOffering")
+	return
+}
+
+// CreateDiskOfferingWithContext records the call and calls CreateDiskOfferingWithContextFunc, or CreateDiskOfferingFunc if only that is set
+func (m *MockDiskOfferingService) CreateDiskOfferingWithContext(ctx context.Context, p *cloudstack.CreateDiskOfferingParams) (r0 *cloudstack.CreateDiskOfferingResponse, r1 error) {
+	m.record("CreateDiskOfferingWithContext", ctx, p)
+	if m.CreateDiskOfferingWithContextFunc != nil {
+		return m.CreateDiskOfferingWithContextFunc(ctx, p)
+	}
+	if m.CreateDiskOfferingFunc != nil {
+		return m.CreateDiskOfferingFunc(p)
+	}
+	r1 = notMocked("DiskOfferingService.CreateDiskOfferingWithContext")
+	return
+}
+
+// NewDeleteDiskOfferingParams records the call and calls NewDeleteDiskOfferingParamsFunc
+func (m *MockDiskOfferingService) NewDeleteDiskOfferingParams(id string) (r0 *cloudstack.DeleteDiskOfferingParams) {
+	m.record("NewDeleteDiskOfferingParams", id)
+	if m.NewDeleteDiskOfferingParamsFunc != nil {
+		return m.NewDeleteDiskOfferingParamsFunc(id)
+	}
+	return cloudstack.NewDiskOfferingService(nil).NewDeleteDiskOfferingParams(id)
+}
+
+// DeleteDiskOffering records the call and calls DeleteDiskOfferingFunc, or DeleteDiskOfferingWithContextFunc if only that is set
+func (m *MockDiskOfferingService) DeleteDiskOffering(p *cloudstack.DeleteDiskOfferingParams) (r0 *cloudstack.DeleteDiskOfferingResponse, r1 error) {
+	m.record("DeleteDiskOffering", p)
+	if m.DeleteDiskOfferingFunc != nil {
+		return m.DeleteDiskOfferingFunc(p)
+	}
+	if m.DeleteDiskOfferingWithContextFunc != nil {
+		return m.DeleteDiskOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DiskOfferingService.DeleteDiskOffering")
+	return
+}
+
+// DeleteDiskOfferingWithContext records the call and calls DeleteDiskOfferingWithContextFunc, or DeleteDiskOfferingFunc if only that is set
+func (m *MockDiskOfferingService) DeleteDiskOfferingWithContext(ctx context.Context, p *cloudstack.DeleteDiskOfferingParams) (r0 *cloudstack.DeleteDiskOfferingResponse, r1 error) {
+	m.record("DeleteDiskOfferingWithContext", ctx, p)
+	if m.DeleteDiskOfferingWithContextFunc != nil {
+		return m.DeleteDiskOfferingWithContextFunc(ctx, p)
+	}
+	if m.DeleteDiskOfferingFunc != nil {
+		return m.DeleteDiskOfferingFunc(p)
+	}
+	r1 = notMocked("DiskOfferingService.DeleteDiskOfferingWithContext")
+	return
+}
+
+// NewListDiskOfferingsParams records the call and calls NewListDiskOfferingsParamsFunc
+func (m *MockDiskOfferingService) NewListDiskOfferingsParams() (r0 *cloudstack.ListDiskOfferingsParams) {
+	m.record("NewListDiskOfferingsParams")
+	if m.NewListDiskOfferingsParamsFunc != nil {
+		return m.NewListDiskOfferingsParamsFunc()
+	}
+	return cloudstack.NewDiskOfferingService(nil).NewListDiskOfferingsParams()
+}
+
+// GetDiskOfferingID records the call and calls GetDiskOfferingIDFunc, or GetDiskOfferingIDWithContextFunc if only that is set
+func (m *MockDiskOfferingService) GetDiskOfferingID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetDiskOfferingID", name, opts)
+	if m.GetDiskOfferingIDFunc != nil {
+		return m.GetDiskOfferingIDFunc(name, opts...)
+	}
+	if m.GetDiskOfferingIDWithContextFunc != nil {
+		return m.GetDiskOfferingIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("DiskOfferingService.GetDiskOfferingID")
+	return
+}
+
+// GetDiskOfferingIDWithContext records the call and calls GetDiskOfferingIDWithContextFunc, or GetDiskOfferingIDFunc if only that is set
+func (m *MockDiskOfferingService) GetDiskOfferingIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetDiskOfferingIDWithContext", ctx, name, opts)
+	if m.GetDiskOfferingIDWithContextFunc != nil {
+		return m.GetDiskOfferingIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetDiskOfferingIDFunc != nil {
+		return m.GetDiskOfferingIDFunc(name, opts...)
+	}
+	r2 = notMocked("DiskOfferingService.GetDiskOfferingIDWithContext")
+	return
+}
+
+// GetDiskOfferingByName records the call and calls GetDiskOfferingByNameFunc, or GetDiskOfferingByNameWithContextFunc if only that is set
+func (m *MockDiskOfferingService) GetDiskOfferingByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.DiskOffering, r1 int, r2 error) {
+	m.record("GetDiskOfferingByName", name, opts)
+	if m.GetDiskOfferingByNameFunc != nil {
+		return m.GetDiskOfferingByNameFunc(name, opts...)
+	}
+	if m.GetDiskOfferingByNameWithContextFunc != nil {
+		return m.GetDiskOfferingByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("DiskOfferingService.GetDiskOfferingByName")
+	return
+}
+
+// GetDiskOfferingByNameWithContext records the call and calls GetDiskOfferingByNameWithContextFunc, or GetDiskOfferingByNameFunc if only that is set
+func (m *MockDiskOfferingService) GetDiskOfferingByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.DiskOffering, r1 int, r2 error) {
+	m.record("GetDiskOfferingByNameWithContext", ctx, name, opts)
+	if m.GetDiskOfferingByNameWithContextFunc != nil {
+		return m.GetDiskOfferingByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetDiskOfferingByNameFunc != nil {
+		return m.GetDiskOfferingByNameFunc(name, opts...)
+	}
+	r2 = notMocked("DiskOfferingService.GetDiskOfferingByNameWithContext")
+	return
+}
+
+// GetDiskOfferingByID records the call and calls GetDiskOfferingByIDFunc, or GetDiskOfferingByIDWithContextFunc if only that is set
+func (m *MockDiskOfferingService) GetDiskOfferingByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.DiskOffering, r1 int, r2 error) {
+	m.record("GetDiskOfferingByID", id, opts)
+	if m.GetDiskOfferingByIDFunc != nil {
+		return m.GetDiskOfferingByIDFunc(id, opts...)
+	}
+	if m.GetDiskOfferingByIDWithContextFunc != nil {
+		return m.GetDiskOfferingByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("DiskOfferingService.GetDiskOfferingByID")
+	return
+}
+
+// GetDiskOfferingByIDWithContext records the call and calls GetDiskOfferingByIDWithContextFunc, or GetDiskOfferingByIDFunc if only that is set
+func (m *MockDiskOfferingService) GetDiskOfferingByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.DiskOffering, r1 int, r2 error) {
+	m.record("GetDiskOfferingByIDWithContext", ctx, id, opts)
+	if m.GetDiskOfferingByIDWithContextFunc != nil {
+		return m.GetDiskOfferingByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetDiskOfferingByIDFunc != nil {
+		return m.GetDiskOfferingByIDFunc(id, opts...)
+	}
+	r2 = notMocked("DiskOfferingService.GetDiskOfferingByIDWithContext")
+	return
+}
+
+// ListDiskOfferings records the call and calls ListDiskOfferingsFunc, or ListDiskOfferingsWithContextFunc if only that is set
+func (m *MockDiskOfferingService) ListDiskOfferings(p *cloudstack.ListDiskOfferingsParams) (r0 *cloudstack.ListDiskOfferingsResponse, r1 error) {
+	m.record("ListDiskOfferings", p)
+	if m.ListDiskOfferingsFunc != nil {
+		return m.ListDiskOfferingsFunc(p)
+	}
+	if m.ListDiskOfferingsWithContextFunc != nil {
+		return m.ListDiskOfferingsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DiskOfferingService.ListDiskOfferings")
+	return
+}
+
+// ListDiskOfferingsWithContext records the call and calls ListDiskOfferingsWithContextFunc, or ListDiskOfferingsFunc if only that is set
+func (m *MockDiskOfferingService) ListDiskOfferingsWithContext(ctx context.Context, p *cloudstack.ListDiskOfferingsParams) (r0 *cloudstack.ListDiskOfferingsResponse, r1 error) {
+	m.record("ListDiskOfferingsWithContext", ctx, p)
+	if m.ListDiskOfferingsWithContextFunc != nil {
+		return m.ListDiskOfferingsWithContextFunc(ctx, p)
+	}
+	if m.ListDiskOfferingsFunc != nil {
+		return m.ListDiskOfferingsFunc(p)
+	}
+	r1 = notMocked("DiskOfferingService.ListDiskOfferingsWithContext")
+	return
+}
+
+// NewListDiskOfferingsPager records the call and calls NewListDiskOfferingsPagerFunc
+func (m *MockDiskOfferingService) NewListDiskOfferingsPager(p *cloudstack.ListDiskOfferingsParams) (r0 *cloudstack.ListDiskOfferingsPager) {
+	m.record("NewListDiskOfferingsPager", p)
+	if m.NewListDiskOfferingsPagerFunc != nil {
+		return m.NewListDiskOfferingsPagerFunc(p)
+	}
+	return
+}
+
+// ListDiskOfferingsAll records the call and calls ListDiskOfferingsAllFunc, or ListDiskOfferingsAllWithContextFunc if only that is set
+func (m *MockDiskOfferingService) ListDiskOfferingsAll(p *cloudstack.ListDiskOfferingsParams) (r0 *cloudstack.ListDiskOfferingsResponse, r1 error) {
+	m.record("ListDiskOfferingsAll", p)
+	if m.ListDiskOfferingsAllFunc != nil {
+		return m.ListDiskOfferingsAllFunc(p)
+	}
+	if m.ListDiskOfferingsAllWithContextFunc != nil {
+		return m.ListDiskOfferingsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DiskOfferingService.ListDiskOfferingsAll")
+	return
+}
+
+// ListDiskOfferingsAllWithContext records the call and calls ListDiskOfferingsAllWithContextFunc, or ListDiskOfferingsAllFunc if only that is set
+func (m *MockDiskOfferingService) ListDiskOfferingsAllWithContext(ctx context.Context, p *cloudstack.ListDiskOfferingsParams) (r0 *cloudstack.ListDiskOfferingsResponse, r1 error) {
+	m.record("ListDiskOfferingsAllWithContext", ctx, p)
+	if m.ListDiskOfferingsAllWithContextFunc != nil {
+		return m.ListDiskOfferingsAllWithContextFunc(ctx, p)
+	}
+	if m.ListDiskOfferingsAllFunc != nil {
+		return m.ListDiskOfferingsAllFunc(p)
+	}
+	r1 = notMocked("DiskOfferingService.ListDiskOfferingsAllWithContext")
+	return
+}
+
+// NewUpdateDiskOfferingParams records the call and calls NewUpdateDiskOfferingParamsFunc
+func (m *MockDiskOfferingService) NewUpdateDiskOfferingParams(id string) (r0 *cloudstack.UpdateDiskOfferingParams) {
+	m.record("NewUpdateDiskOfferingParams", id)
+	if m.NewUpdateDiskOfferingParamsFunc != nil {
+		return m.NewUpdateDiskOfferingParamsFunc(id)
+	}
+	return cloudstack.NewDiskOfferingService(nil).NewUpdateDiskOfferingParams(id)
+}
+
+// UpdateDiskOffering records the call and calls UpdateDiskOfferingFunc, or UpdateDiskOfferingWithContextFunc if only that is set
+func (m *MockDiskOfferingService) UpdateDiskOffering(p *cloudstack.UpdateDiskOfferingParams) (r0 *cloudstack.UpdateDiskOfferingResponse, r1 error) {
+	m.record("UpdateDiskOffering", p)
+	if m.UpdateDiskOfferingFunc != nil {
+		return m.UpdateDiskOfferingFunc(p)
+	}
+	if m.UpdateDiskOfferingWithContextFunc != nil {
+		return m.UpdateDiskOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DiskOfferingService.UpdateDiskOffering")
+	return
+}
+
+// UpdateDiskOfferingWithContext records the call and calls UpdateDiskOfferingWithContextFunc, or UpdateDiskOfferingFunc if only that is set
+func (m *MockDiskOfferingService) UpdateDiskOfferingWithContext(ctx context.Context, p *cloudstack.UpdateDiskOfferingParams) (r0 *cloudstack.UpdateDiskOfferingResponse, r1 error) {
+	m.record("UpdateDiskOfferingWithContext", ctx, p)
+	if m.UpdateDiskOfferingWithContextFunc != nil {
+		return m.UpdateDiskOfferingWithContextFunc(ctx, p)
+	}
+	if m.UpdateDiskOfferingFunc != nil {
+		return m.UpdateDiskOfferingFunc(p)
+	}
+	r1 = notMocked("DiskOfferingService.UpdateDiskOfferingWithContext")
+	return
+}
@@ -0,0 +1,501 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockDomainService is a mock of cloudstack.DomainServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockDomainService struct {
+	Recorder
+
+	NewCreateDomainParamsFunc              func(name string) *cloudstack.CreateDomainParams
+	CreateDomainFunc                       func(p *cloudstack.CreateDomainParams) (*cloudstack.CreateDomainResponse, error)
+	CreateDomainWithContextFunc            func(ctx context.Context, p *cloudstack.CreateDomainParams) (*cloudstack.CreateDomainResponse, error)
+	NewDeleteDomainParamsFunc              func(id string) *cloudstack.DeleteDomainParams
+	DeleteDomainFunc                       func(p *cloudstack.DeleteDomainParams) (*cloudstack.DeleteDomainResponse, error)
+	DeleteDomainWithContextFunc            func(ctx context.Context, p *cloudstack.DeleteDomainParams) (*cloudstack.DeleteDomainResponse, error)
+	DeleteDomainAsyncFunc                  func(p *cloudstack.DeleteDomainParams) (*cloudstack.Job, error)
+	DeleteDomainAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.DeleteDomainParams) (*cloudstack.Job, error)
+	NewListDomainChildrenParamsFunc        func() *cloudstack.ListDomainChildrenParams
+	GetDomainChildrenIDFunc                func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetDomainChildrenIDWithContextFunc     func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetDomainChildrenByNameFunc            func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.DomainChildren, int, error)
+	GetDomainChildrenByNameWithContextFunc func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.DomainChildren, int, error)
+	GetDomainChildrenByIDFunc              func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.DomainChildren, int, error)
+	GetDomainChildrenByIDWithContextFunc   func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.DomainChildren, int, error)
+	ListDomainChildrenFunc                 func(p *cloudstack.ListDomainChildrenParams) (*cloudstack.ListDomainChildrenResponse, error)
+	ListDomainChildrenWithContextFunc      func(ctx context.Context, p *cloudstack.ListDomainChildrenParams) (*cloudstack.ListDomainChildrenResponse, error)
+	NewListDomainChildrenPagerFunc         func(p *cloudstack.ListDomainChildrenParams) *cloudstack.ListDomainChildrenPager
+	ListDomainChildrenAllFunc              func(p *cloudstack.ListDomainChildrenParams) (*cloudstack.ListDomainChildrenResponse, error)
+	ListDomainChildrenAllWithContextFunc   func(ctx context.Context, p *cloudstack.ListDomainChildrenParams) (*cloudstack.ListDomainChildrenResponse, error)
+	NewListDomainsParamsFunc               func() *cloudstack.ListDomainsParams
+	GetDomainIDFunc                        func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetDomainIDWithContextFunc             func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetDomainByNameFunc                    func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Domain, int, error)
+	GetDomainByNameWithContextFunc         func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.Domain, int, error)
+	GetDomainByIDFunc                      func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Domain, int, error)
+	GetDomainByIDWithContextFunc           func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Domain, int, error)
+	ListDomainsFunc                        func(p *cloudstack.ListDomainsParams) (*cloudstack.ListDomainsResponse, error)
+	ListDomainsWithContextFunc             func(ctx context.Context, p *cloudstack.ListDomainsParams) (*cloudstack.ListDomainsResponse, error)
+	NewListDomainsPagerFunc                func(p *cloudstack.ListDomainsParams) *cloudstack.ListDomainsPager
+	ListDomainsAllFunc                     func(p *cloudstack.ListDomainsParams) (*cloudstack.ListDomainsResponse, error)
+	ListDomainsAllWithContextFunc          func(ctx context.Context, p *cloudstack.ListDomainsParams) (*cloudstack.ListDomainsResponse, error)
+	NewUpdateDomainParamsFunc              func(id string) *cloudstack.UpdateDomainParams
+	UpdateDomainFunc                       func(p *cloudstack.UpdateDomainParams) (*cloudstack.UpdateDomainResponse, error)
+	UpdateDomainWithContextFunc            func(ctx context.Context, p *cloudstack.UpdateDomainParams) (*cloudstack.UpdateDomainResponse, error)
+}
+
+var _ cloudstack.DomainServiceIface = (*MockDomainService)(nil)
+
+// NewCreateDomainParams records the call and calls NewCreateDomainParamsFunc
+func (m *MockDomainService) NewCreateDomainParams(name string) (r0 *cloudstack.CreateDomainParams) {
+	m.record("NewCreateDomainParams", name)
+	if m.NewCreateDomainParamsFunc != nil {
+		return m.NewCreateDomainParamsFunc(name)
+	}
+	return cloudstack.NewDomainService(nil).NewCreateDomainParams(name)
+}
+
+// CreateDomain records the call and calls CreateDomainFunc, or CreateDomainWithContextFunc if only that is set
+func (m *MockDomainService) CreateDomain(p *cloudstack.CreateDomainParams) (r0 *cloudstack.CreateDomainResponse, r1 error) {
+	m.record("CreateDomain", p)
+	if m.CreateDomainFunc != nil {
+		return m.CreateDomainFunc(p)
+	}
+	if m.CreateDomainWithContextFunc != nil {
+		return m.CreateDomainWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DomainService.CreateDomain")
+	return
+}
+
+// CreateDomainWithContext records the call and calls CreateDomainWithContextFunc, or CreateDomainFunc if only that is set
+func (m *MockDomainService) CreateDomainWithContext(ctx context.Context, p *cloudstack.CreateDomainParams) (r0 *cloudstack.CreateDomainResponse, r1 error) {
+	m.record("CreateDomainWithContext", ctx, p)
+	if m.CreateDomainWithContextFunc != nil {
+		return m.CreateDomainWithContextFunc(ctx, p)
+	}
+	if m.CreateDomainFunc != nil {
+		return m.CreateDomainFunc(p)
+	}
+	r1 = notMocked("DomainService.CreateDomainWithContext")
+	return
+}
+
+// NewDeleteDomainParams records the call and calls NewDeleteDomainParamsFunc
+func (m *MockDomainService) NewDeleteDomainParams(id string) (r0 *cloudstack.DeleteDomainParams) {
+	m.record("NewDeleteDomainParams", id)
+	if m.NewDeleteDomainParamsFunc != nil {
+		return m.NewDeleteDomainParamsFunc(id)
+	}
+	return cloudstack.NewDomainService(nil).NewDeleteDomainParams(id)
+}
+
+// DeleteDomain records the call and calls DeleteDomainFunc, or DeleteDomainWithContextFunc if only that is set
+func (m *MockDomainService) DeleteDomain(p *cloudstack.DeleteDomainParams) (r0 *cloudstack.DeleteDomainResponse, r1 error) {
+	m.record("DeleteDomain", p)
+	if m.DeleteDomainFunc != nil {
+		return m.DeleteDomainFunc(p)
+	}
+	if m.DeleteDomainWithContextFunc != nil {
+		return m.DeleteDomainWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DomainService.DeleteDomain")
+	return
+}
+
+// DeleteDomainWithContext records the call and calls DeleteDomainWithContextFunc, or DeleteDomainFunc if only that is set
+func (m *MockDomainService) DeleteDomainWithContext(ctx context.Context, p *cloudstack.DeleteDomainParams) (r0 *cloudstack.DeleteDomainResponse, r1 error) {
+	m.record("DeleteDomainWithContext", ctx, p)
+	if m.DeleteDomainWithContextFunc != nil {
+		return m.DeleteDomainWithContextFunc(ctx, p)
+	}
+	if m.DeleteDomainFunc != nil {
+		return m.DeleteDomainFunc(p)
+	}
+	r1 = notMocked("DomainService.DeleteDomainWithContext")
+	return
+}
+
+// DeleteDomainAsync records the call and calls DeleteDomainAsyncFunc, or DeleteDomainAsyncWithContextFunc if only that is set
+func (m *MockDomainService) DeleteDomainAsync(p *cloudstack.DeleteDomainParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteDomainAsync", p)
+	if m.DeleteDomainAsyncFunc != nil {
+		return m.DeleteDomainAsyncFunc(p)
+	}
+	if m.DeleteDomainAsyncWithContextFunc != nil {
+		return m.DeleteDomainAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DomainService.DeleteDomainAsync")
+	return
+}
+
+// DeleteDomainAsyncWithContext records the call and calls DeleteDomainAsyncWithContextFunc, or DeleteDomainAsyncFunc if only that is set
+func (m *MockDomainService) DeleteDomainAsyncWithContext(ctx context.Context, p *cloudstack.DeleteDomainParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteDomainAsyncWithContext", ctx, p)
+	if m.DeleteDomainAsyncWithContextFunc != nil {
+		return m.DeleteDomainAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteDomainAsyncFunc != nil {
+		return m.DeleteDomainAsyncFunc(p)
+	}
+	r1 = notMocked("DomainService.DeleteDomainAsyncWithContext")
+	return
+}
+
+// NewListDomainChildrenParams records the call and calls NewListDomainChildrenParamsFunc
+func (m *MockDomainService) NewListDomainChildrenParams() (r0 *cloudstack.ListDomainChildrenParams) {
+	m.record("NewListDomainChildrenParams")
+	if m.NewListDomainChildrenParamsFunc != nil {
+		return m.NewListDomainChildrenParamsFunc()
+	}
+	return cloudstack.NewDomainService(nil).NewListDomainChildrenParams()
+}
+
+// GetDomainChildrenID records the call and calls GetDomainChildrenIDFunc, or GetDomainChildrenIDWithContextFunc if only that is set
+func (m *MockDomainService) GetDomainChildrenID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetDomainChildrenID", name, opts)
+	if m.GetDomainChildrenIDFunc != nil {
+		return m.GetDomainChildrenIDFunc(name, opts...)
+	}
+	if m.GetDomainChildrenIDWithContextFunc != nil {
+		return m.GetDomainChildrenIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("DomainService.GetDomainChildrenID")
+	return
+}
+
+// GetDomainChildrenIDWithContext records the call and calls GetDomainChildrenIDWithContextFunc, or GetDomainChildrenIDFunc if only that is set
+func (m *MockDomainService) GetDomainChildrenIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetDomainChildrenIDWithContext", ctx, name, opts)
+	if m.GetDomainChildrenIDWithContextFunc != nil {
+		return m.GetDomainChildrenIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetDomainChildrenIDFunc != nil {
+		return m.GetDomainChildrenIDFunc(name, opts...)
+	}
+	r2 = notMocked("DomainService.GetDomainChildrenIDWithContext")
+	return
+}
+
+// GetDomainChildrenByName records the call and calls GetDomainChildrenByNameFunc, or GetDomainChildrenByNameWithContextFunc if only that is set
+func (m *MockDomainService) GetDomainChildrenByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.DomainChildren, r1 int, r2 error) {
+	m.record("GetDomainChildrenByName", name, opts)
+	if m.GetDomainChildrenByNameFunc != nil {
+		return m.GetDomainChildrenByNameFunc(name, opts...)
+	}
+	if m.GetDomainChildrenByNameWithContextFunc != nil {
+		return m.GetDomainChildrenByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("DomainService.GetDomainChildrenByName")
+	return
+}
+
+// GetDomainChildrenByNameWithContext records the call and calls GetDomainChildrenByNameWithContextFunc, or GetDomainChildrenByNameFunc if only that is set
+func (m *MockDomainService) GetDomainChildrenByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.DomainChildren, r1 int, r2 error) {
+	m.record("GetDomainChildrenByNameWithContext", ctx, name, opts)
+	if m.GetDomainChildrenByNameWithContextFunc != nil {
+		return m.GetDomainChildrenByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetDomainChildrenByNameFunc != nil {
+		return m.GetDomainChildrenByNameFunc(name, opts...)
+	}
+	r2 = notMocked("DomainService.GetDomainChildrenByNameWithContext")
+	return
+}
+
+// GetDomainChildrenByID records the call and calls GetDomainChildrenByIDFunc, or GetDomainChildrenByIDWithContextFunc if only that is set
+func (m *MockDomainService) GetDomainChildrenByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.DomainChildren, r1 int, r2 error) {
+	m.record("GetDomainChildrenByID", id, opts)
+	if m.GetDomainChildrenByIDFunc != nil {
+		return m.GetDomainChildrenByIDFunc(id, opts...)
+	}
+	if m.GetDomainChildrenByIDWithContextFunc != nil {
+		return m.GetDomainChildrenByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("DomainService.GetDomainChildrenByID")
+	return
+}
+
+// GetDomainChildrenByIDWithContext records the call and calls GetDomainChildrenByIDWithContextFunc, or GetDomainChildrenByIDFunc if only that is set
+func (m *MockDomainService) GetDomainChildrenByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.DomainChildren, r1 int, r2 error) {
+	m.record("GetDomainChildrenByIDWithContext", ctx, id, opts)
+	if m.GetDomainChildrenByIDWithContextFunc != nil {
+		return m.GetDomainChildrenByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetDomainChildrenByIDFunc != nil {
+		return m.GetDomainChildrenByIDFunc(id, opts...)
+	}
+	r2 = notMocked("DomainService.GetDomainChildrenByIDWithContext")
+	return
+}
+
+// ListDomainChildren records the call and calls ListDomainChildrenFunc, or ListDomainChildrenWithContextFunc if only that is set
+func (m *MockDomainService) ListDomainChildren(p *cloudstack.ListDomainChildrenParams) (r0 *cloudstack.ListDomainChildrenResponse, r1 error) {
+	m.record("ListDomainChildren", p)
+	if m.ListDomainChildrenFunc != nil {
+		return m.ListDomainChildrenFunc(p)
+	}
+	if m.ListDomainChildrenWithContextFunc != nil {
+		return m.ListDomainChildrenWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DomainService.ListDomainChildren")
+	return
+}
+
+// ListDomainChildrenWithContext records the call and calls ListDomainChildrenWithContextFunc, or ListDomainChildrenFunc if only that is set
+func (m *MockDomainService) ListDomainChildrenWithContext(ctx context.Context, p *cloudstack.ListDomainChildrenParams) (r0 *cloudstack.ListDomainChildrenResponse, r1 error) {
+	m.record("ListDomainChildrenWithContext", ctx, p)
+	if m.ListDomainChildrenWithContextFunc != nil {
+		return m.ListDomainChildrenWithContextFunc(ctx, p)
+	}
+	if m.ListDomainChildrenFunc != nil {
+		return m.ListDomainChildrenFunc(p)
+	}
+	r1 = notMocked("DomainService.ListDomainChildrenWithContext")
+	return
+}
+
+// NewListDomainChildrenPager records the call and calls NewListDomainChildrenPagerFunc
+func (m *MockDomainService) NewListDomainChildrenPager(p *cloudstack.ListDomainChildrenParams) (r0 *cloudstack.ListDomainChildrenPager) {
+	m.record("NewListDomainChildrenPager", p)
+	if m.NewListDomainChildrenPagerFunc != nil {
+		return m.NewListDomainChildrenPagerFunc(p)
+	}
+	return
+}
+
+// ListDomainChildrenAll records the call and calls ListDomainChildrenAllFunc, or ListDomainChildrenAllWithContextFunc if only that is set
+func (m *MockDomainService) ListDomainChildrenAll(p *cloudstack.ListDomainChildrenParams) (r0 *cloudstack.ListDomainChildrenResponse, r1 error) {
+	m.record("ListDomainChildrenAll", p)
+	if m.ListDomainChildrenAllFunc != nil {
+		return m.ListDomainChildrenAllFunc(p)
+	}
+	if m.ListDomainChildrenAllWithContextFunc != nil {
+		return m.ListDomainChildrenAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DomainService.ListDomainChildrenAll")
+	return
+}
+
+// ListDomainChildrenAllWithContext records the call and calls ListDomainChildrenAllWithContextFunc, or ListDomainChildrenAllFunc if only that is set
+func (m *MockDomainService) ListDomainChildrenAllWithContext(ctx context.Context, p *cloudstack.ListDomainChildrenParams) (r0 *cloudstack.ListDomainChildrenResponse, r1 error) {
+	m.record("ListDomainChildrenAllWithContext", ctx, p)
+	if m.ListDomainChildrenAllWithContextFunc != nil {
+		return m.ListDomainChildrenAllWithContextFunc(ctx, p)
+	}
+	if m.ListDomainChildrenAllFunc != nil {
+		return m.ListDomainChildrenAllFunc(p)
+	}
+	r1 = notMocked("DomainService.ListDomainChildrenAllWithContext")
+	return
+}
+
+// NewListDomainsParams records the call and calls NewListDomainsParamsFunc
+func (m *MockDomainService) NewListDomainsParams() (r0 *cloudstack.ListDomainsParams) {
+	m.record("NewListDomainsParams")
+	if m.NewListDomainsParamsFunc != nil {
+		return m.NewListDomainsParamsFunc()
+	}
+	return cloudstack.NewDomainService(nil).NewListDomainsParams()
+}
+
+// GetDomainID records the call and calls GetDomainIDFunc, or GetDomainIDWithContextFunc if only that is set
+func (m *MockDomainService) GetDomainID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetDomainID", name, opts)
+	if m.GetDomainIDFunc != nil {
+		return m.GetDomainIDFunc(name, opts...)
+	}
+	if m.GetDomainIDWithContextFunc != nil {
+		return m.GetDomainIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("DomainService.GetDomainID")
+	return
+}
+
+// GetDomainIDWithContext records the call and calls GetDomainIDWithContextFunc, or GetDomainIDFunc if only that is set
+func (m *MockDomainService) GetDomainIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetDomainIDWithContext", ctx, name, opts)
+	if m.GetDomainIDWithContextFunc != nil {
+		return m.GetDomainIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetDomainIDFunc != nil {
+		return m.GetDomainIDFunc(name, opts...)
+	}
+	r2 = notMocked("DomainService.GetDomainIDWithContext")
+	return
+}
+
+// GetDomainByName records the call and calls GetDomainByNameFunc, or GetDomainByNameWithContextFunc if only that is set
+func (m *MockDomainService) GetDomainByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Domain, r1 int, r2 error) {
+	m.record("GetDomainByName", name, opts)
+	if m.GetDomainByNameFunc != nil {
+		return m.GetDomainByNameFunc(name, opts...)
+	}
+	if m.GetDomainByNameWithContextFunc != nil {
+		return m.GetDomainByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("DomainService.GetDomainByName")
+	return
+}
+
+// GetDomainByNameWithContext records the call and calls GetDomainByNameWithContextFunc, or GetDomainByNameFunc if only that is set
+func (m *MockDomainService) GetDomainByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Domain, r1 int, r2 error) {
+	m.record("GetDomainByNameWithContext", ctx, name, opts)
+	if m.GetDomainByNameWithContextFunc != nil {
+		return m.GetDomainByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetDomainByNameFunc != nil {
+		return m.GetDomainByNameFunc(name, opts...)
+	}
+	r2 = notMocked("DomainService.GetDomainByNameWithContext")
+	return
+}
+
+// GetDomainByID records the call and calls GetDomainByIDFunc, or GetDomainByIDWithContextFunc if only that is set
+func (m *MockDomainService) GetDomainByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Domain, r1 int, r2 error) {
+	m.record("GetDomainByID", id, opts)
+	if m.GetDomainByIDFunc != nil {
+		return m.GetDomainByIDFunc(id, opts...)
+	}
+	if m.GetDomainByIDWithContextFunc != nil {
+		return m.GetDomainByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("DomainService.GetDomainByID")
+	return
+}
+
+// GetDomainByIDWithContext records the call and calls GetDomainByIDWithContextFunc, or GetDomainByIDFunc if only that is set
+func (m *MockDomainService) GetDomainByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Domain, r1 int, r2 error) {
+	m.record("GetDomainByIDWithContext", ctx, id, opts)
+	if m.GetDomainByIDWithContextFunc != nil {
+		return m.GetDomainByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetDomainByIDFunc != nil {
+		return m.GetDomainByIDFunc(id, opts...)
+	}
+	r2 = notMocked("DomainService.GetDomainByIDWithContext")
+	return
+}
+
+// ListDomains records the call and calls ListDomainsFunc, or ListDomainsWithContextFunc if only that is set
+func (m *MockDomainService) ListDomains(p *cloudstack.ListDomainsParams) (r0 *cloudstack.ListDomainsResponse, r1 error) {
+	m.record("ListDomains", p)
+	if m.ListDomainsFunc != nil {
+		return m.ListDomainsFunc(p)
+	}
+	if m.ListDomainsWithContextFunc != nil {
+		return m.ListDomainsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DomainService.ListDomains")
+	return
+}
+
+// ListDomainsWithContext records the call and calls ListDomainsWithContextFunc, or ListDomainsFunc if only that is set
+func (m *MockDomainService) ListDomainsWithContext(ctx context.Context, p *cloudstack.ListDomainsParams) (r0 *cloudstack.ListDomainsResponse, r1 error) {
+	m.record("ListDomainsWithContext", ctx, p)
+	if m.ListDomainsWithContextFunc != nil {
+		return m.ListDomainsWithContextFunc(ctx, p)
+	}
+	if m.ListDomainsFunc != nil {
+		return m.ListDomainsFunc(p)
+	}
+	r1 = notMocked("DomainService.ListDomainsWithContext")
+	return
+}
+
+// NewListDomainsPager records the call and calls NewListDomainsPagerFunc
+func (m *MockDomainService) NewListDomainsPager(p *cloudstack.ListDomainsParams) (r0 *cloudstack.ListDomainsPager) {
+	m.record("NewListDomainsPager", p)
+	if m.NewListDomainsPagerFunc != nil {
+		return m.NewListDomainsPagerFunc(p)
+	}
+	return
+}
+
+// ListDomainsAll records the call and calls ListDomainsAllFunc, or ListDomainsAllWithContextFunc if only that is set
+func (m *MockDomainService) ListDomainsAll(p *cloudstack.ListDomainsParams) (r0 *cloudstack.ListDomainsResponse, r1 error) {
+	m.record("ListDomainsAll", p)
+	if m.ListDomainsAllFunc != nil {
+		return m.ListDomainsAllFunc(p)
+	}
+	if m.ListDomainsAllWithContextFunc != nil {
+		return m.ListDomainsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DomainService.ListDomainsAll")
+	return
+}
+
+// ListDomainsAllWithContext records the call and calls ListDomainsAllWithContextFunc, or ListDomainsAllFunc if only that is set
+func (m *MockDomainService) ListDomainsAllWithContext(ctx context.Context, p *cloudstack.ListDomainsParams) (r0 *cloudstack.ListDomainsResponse, r1 error) {
+	m.record("ListDomainsAllWithContext", ctx, p)
+	if m.ListDomainsAllWithContextFunc != nil {
+		return m.ListDomainsAllWithContextFunc(ctx, p)
+	}
+	if m.ListDomainsAllFunc != nil {
+		return m.ListDomainsAllFunc(p)
+	}
+	r1 = notMocked("DomainService.ListDomainsAllWithContext")
+	return
+}
+
+// NewUpdateDomainParams records the call and calls NewUpdateDomainParamsFunc
+func (m *MockDomainService) NewUpdateDomainParams(id string) (r0 *cloudstack.UpdateDomainParams) {
+	m.record("NewUpdateDomainParams", id)
+	if m.NewUpdateDomainParamsFunc != nil {
+		return m.NewUpdateDomainParamsFunc(id)
+	}
+	return cloudstack.NewDomainService(nil).NewUpdateDomainParams(id)
+}
+
+// UpdateDomain records the call and calls UpdateDomainFunc, or UpdateDomainWithContextFunc if only that is set
+func (m *MockDomainService) UpdateDomain(p *cloudstack.UpdateDomainParams) (r0 *cloudstack.UpdateDomainResponse, r1 error) {
+	m.record("UpdateDomain", p)
+	if m.UpdateDomainFunc != nil {
+		return m.UpdateDomainFunc(p)
+	}
+	if m.UpdateDomainWithContextFunc != nil {
+		return m.UpdateDomainWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("DomainService.UpdateDomain")
+	return
+}
+
+// UpdateDomainWithContext records the call and calls UpdateDomainWithContextFunc, or UpdateDomainFunc if only that is set
+func (m *MockDomainService) UpdateDomainWithContext(ctx context.Context, p *cloudstack.UpdateDomainParams) (r0 *cloudstack.UpdateDomainResponse, r1 error) {
+	m.record("UpdateDomainWithContext", ctx, p)
+	if m.UpdateDomainWithContextFunc != nil {
+		return m.UpdateDomainWithContextFunc(ctx, p)
+	}
+	if m.UpdateDomainFunc != nil {
+		return m.UpdateDomainFunc(p)
+	}
+	r1 = notMocked("DomainService.UpdateDomainWithContext")
+	return
+}
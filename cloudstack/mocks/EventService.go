@@ -0,0 +1,257 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockEventService is a mock of cloudstack.EventServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockEventService struct {
+	Recorder
+
+	NewArchiveEventsParamsFunc    func() *cloudstack.ArchiveEventsParams
+	ArchiveEventsFunc             func(p *cloudstack.ArchiveEventsParams) (*cloudstack.ArchiveEventsResponse, error)
+	ArchiveEventsWithContextFunc  func(ctx context.Context, p *cloudstack.ArchiveEventsParams) (*cloudstack.ArchiveEventsResponse, error)
+	NewDeleteEventsParamsFunc     func() *cloudstack.DeleteEventsParams
+	DeleteEventsFunc              func(p *cloudstack.DeleteEventsParams) (*cloudstack.DeleteEventsResponse, error)
+	DeleteEventsWithContextFunc   func(ctx context.Context, p *cloudstack.DeleteEventsParams) (*cloudstack.DeleteEventsResponse, error)
+	NewListEventTypesParamsFunc   func() *cloudstack.ListEventTypesParams
+	ListEventTypesFunc            func(p *cloudstack.ListEventTypesParams) (*cloudstack.ListEventTypesResponse, error)
+	ListEventTypesWithContextFunc func(ctx context.Context, p *cloudstack.ListEventTypesParams) (*cloudstack.ListEventTypesResponse, error)
+	NewListEventsParamsFunc       func() *cloudstack.ListEventsParams
+	GetEventByIDFunc              func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Event, int, error)
+	GetEventByIDWithContextFunc   func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Event, int, error)
+	ListEventsFunc                func(p *cloudstack.ListEventsParams) (*cloudstack.ListEventsResponse, error)
+	ListEventsWithContextFunc     func(ctx context.Context, p *cloudstack.ListEventsParams) (*cloudstack.ListEventsResponse, error)
+	NewListEventsPagerFunc        func(p *cloudstack.ListEventsParams) *cloudstack.ListEventsPager
+	ListEventsAllFunc             func(p *cloudstack.ListEventsParams) (*cloudstack.ListEventsResponse, error)
+	ListEventsAllWithContextFunc  func(ctx context.Context, p *cloudstack.ListEventsParams) (*cloudstack.ListEventsResponse, error)
+}
+
+var _ cloudstack.EventServiceIface = (*MockEventService)(nil)
+
+// NewArchiveEventsParams records the call and calls NewArchiveEventsParamsFunc
+func (m *MockEventService) NewArchiveEventsParams() (r0 *cloudstack.ArchiveEventsParams) {
+	m.record("NewArchiveEventsParams")
+	if m.NewArchiveEventsParamsFunc != nil {
+		return m.NewArchiveEventsParamsFunc()
+	}
+	return cloudstack.NewEventService(nil).NewArchiveEventsParams()
+}
+
+// ArchiveEvents records the call and calls ArchiveEventsFunc, or ArchiveEventsWithContextFunc if only that is set
+func (m *MockEventService) ArchiveEvents(p *cloudstack.ArchiveEventsParams) (r0 *cloudstack.ArchiveEventsResponse, r1 error) {
+	m.record("ArchiveEvents", p)
+	if m.ArchiveEventsFunc != nil {
+		return m.ArchiveEventsFunc(p)
+	}
+	if m.ArchiveEventsWithContextFunc != nil {
+		return m.ArchiveEventsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("EventService.ArchiveEvents")
+	return
+}
+
+// ArchiveEventsWithContext records the call and calls ArchiveEventsWithContextFunc, or ArchiveEventsFunc if only that is set
+func (m *MockEventService) ArchiveEventsWithContext(ctx context.Context, p *cloudstack.ArchiveEventsParams) (r0 *cloudstack.ArchiveEventsResponse, r1 error) {
+	m.record("ArchiveEventsWithContext", ctx, p)
+	if m.ArchiveEventsWithContextFunc != nil {
+		return m.ArchiveEventsWithContextFunc(ctx, p)
+	}
+	if m.ArchiveEventsFunc != nil {
+		return m.ArchiveEventsFunc(p)
+	}
+	r1 = notMocked("EventService.ArchiveEventsWithContext")
+	return
+}
+
+// NewDeleteEventsParams records the call and calls NewDeleteEventsParamsFunc
+func (m *MockEventService) NewDeleteEventsParams() (r0 *cloudstack.DeleteEventsParams) {
+	m.record("NewDeleteEventsParams")
+	if m.NewDeleteEventsParamsFunc != nil {
+		return m.NewDeleteEventsParamsFunc()
+	}
+	return cloudstack.NewEventService(nil).NewDeleteEventsParams()
+}
+
+// DeleteEvents records the call and calls DeleteEventsFunc, or DeleteEventsWithContextFunc if only that is set
+func (m *MockEventService) DeleteEvents(p *cloudstack.DeleteEventsParams) (r0 *cloudstack.DeleteEventsResponse, r1 error) {
+	m.record("DeleteEvents", p)
+	if m.DeleteEventsFunc != nil {
+		return m.DeleteEventsFunc(p)
+	}
+	if m.DeleteEventsWithContextFunc != nil {
+		return m.DeleteEventsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("EventService.DeleteEvents")
+	return
+}
+
+// DeleteEventsWithContext records the call and calls DeleteEventsWithContextFunc, or DeleteEventsFunc if only that is set
+func (m *MockEventService) DeleteEventsWithContext(ctx context.Context, p *cloudstack.DeleteEventsParams) (r0 *cloudstack.DeleteEventsResponse, r1 error) {
+	m.record("DeleteEventsWithContext", ctx, p)
+	if m.DeleteEventsWithContextFunc != nil {
+		return m.DeleteEventsWithContextFunc(ctx, p)
+	}
+	if m.DeleteEventsFunc != nil {
+		return m.DeleteEventsFunc(p)
+	}
+	r1 = notMocked("EventService.DeleteEventsWithContext")
+	return
+}
+
+// NewListEventTypesParams records the call and calls NewListEventTypesParamsFunc
+func (m *MockEventService) NewListEventTypesParams() (r0 *cloudstack.ListEventTypesParams) {
+	m.record("NewListEventTypesParams")
+	if m.NewListEventTypesParamsFunc != nil {
+		return m.NewListEventTypesParamsFunc()
+	}
+	return cloudstack.NewEventService(nil).NewListEventTypesParams()
+}
+
+// ListEventTypes records the call and calls ListEventTypesFunc, or ListEventTypesWithContextFunc if only that is set
+func (m *MockEventService) ListEventTypes(p *cloudstack.ListEventTypesParams) (r0 *cloudstack.ListEventTypesResponse, r1 error) {
+	m.record("ListEventTypes", p)
+	if m.ListEventTypesFunc != nil {
+		return m.ListEventTypesFunc(p)
+	}
+	if m.ListEventTypesWithContextFunc != nil {
+		return m.ListEventTypesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("EventService.ListEventTypes")
+	return
+}
+
+// ListEventTypesWithContext records the call and calls ListEventTypesWithContextFunc, or ListEventTypesFunc if only that is set
+func (m *MockEventService) ListEventTypesWithContext(ctx context.Context, p *cloudstack.ListEventTypesParams) (r0 *cloudstack.ListEventTypesResponse, r1 error) {
+	m.record("ListEventTypesWithContext", ctx, p)
+	if m.ListEventTypesWithContextFunc != nil {
+		return m.ListEventTypesWithContextFunc(ctx, p)
+	}
+	if m.ListEventTypesFunc != nil {
+		return m.ListEventTypesFunc(p)
+	}
+	r1 = notMocked("EventService.ListEventTypesWithContext")
+	return
+}
+
+// NewListEventsParams records the call and calls NewListEventsParamsFunc
+func (m *MockEventService) NewListEventsParams() (r0 *cloudstack.ListEventsParams) {
+	m.record("NewListEventsParams")
+	if m.NewListEventsParamsFunc != nil {
+		return m.NewListEventsParamsFunc()
+	}
+	return cloudstack.NewEventService(nil).NewListEventsParams()
+}
+
+// GetEventByID records the call and calls GetEventByIDFunc, or GetEventByIDWithContextFunc if only that is set
+func (m *MockEventService) GetEventByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Event, r1 int, r2 error) {
+	m.record("GetEventByID", id, opts)
+	if m.GetEventByIDFunc != nil {
+		return m.GetEventByIDFunc(id, opts...)
+	}
+	if m.GetEventByIDWithContextFunc != nil {
+		return m.GetEventByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("EventService.GetEventByID")
+	return
+}
+
+// GetEventByIDWithContext records the call and calls GetEventByIDWithContextFunc, or GetEventByIDFunc if only that is set
+func (m *MockEventService) GetEventByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Event, r1 int, r2 error) {
+	m.record("GetEventByIDWithContext", ctx, id, opts)
+	if m.GetEventByIDWithContextFunc != nil {
+		return m.GetEventByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetEventByIDFunc != nil {
+		return m.GetEventByIDFunc(id, opts...)
+	}
+	r2 = notMocked("EventService.GetEventByIDWithContext")
+	return
+}
+
+// ListEvents records the call and calls ListEventsFunc, or ListEventsWithContextFunc if only that is set
+func (m *MockEventService) ListEvents(p *cloudstack.ListEventsParams) (r0 *cloudstack.ListEventsResponse, r1 error) {
+	m.record("ListEvents", p)
+	if m.ListEventsFunc != nil {
+		return m.ListEventsFunc(p)
+	}
+	if m.ListEventsWithContextFunc != nil {
+		return m.ListEventsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("EventService.ListEvents")
+	return
+}
+
+// ListEventsWithContext records the call and calls ListEventsWithContextFunc, or ListEventsFunc if only that is set
+func (m *MockEventService) ListEventsWithContext(ctx context.Context, p *cloudstack.ListEventsParams) (r0 *cloudstack.ListEventsResponse, r1 error) {
+	m.record("ListEventsWithContext", ctx, p)
+	if m.ListEventsWithContextFunc != nil {
+		return m.ListEventsWithContextFunc(ctx, p)
+	}
+	if m.ListEventsFunc != nil {
+		return m.ListEventsFunc(p)
+	}
+	r1 = notMocked("EventService.ListEventsWithContext")
+	return
+}
+
+// NewListEventsPager records the call and calls NewListEventsPagerFunc
+func (m *MockEventService) NewListEventsPager(p *cloudstack.ListEventsParams) (r0 *cloudstack.ListEventsPager) {
+	m.record("NewListEventsPager", p)
+	if m.NewListEventsPagerFunc != nil {
+		return m.NewListEventsPagerFunc(p)
+	}
+	return
+}
+
+// ListEventsAll records the call and calls ListEventsAllFunc, or ListEventsAllWithContextFunc if only that is set
+func (m *MockEventService) ListEventsAll(p *cloudstack.ListEventsParams) (r0 *cloudstack.ListEventsResponse, r1 error) {
+	m.record("ListEventsAll", p)
+	if m.ListEventsAllFunc != nil {
+		return m.ListEventsAllFunc(p)
+	}
+	if m.ListEventsAllWithContextFunc != nil {
+		return m.ListEventsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("EventService.ListEventsAll")
+	return
+}
+
+// ListEventsAllWithContext records the call and calls ListEventsAllWithContextFunc, or ListEventsAllFunc if only that is set
+func (m *MockEventService) ListEventsAllWithContext(ctx context.Context, p *cloudstack.ListEventsParams) (r0 *cloudstack.ListEventsResponse, r1 error) {
+	m.record("ListEventsAllWithContext", ctx, p)
+	if m.ListEventsAllWithContextFunc != nil {
+		return m.ListEventsAllWithContextFunc(ctx, p)
+	}
+	if m.ListEventsAllFunc != nil {
+		return m.ListEventsAllFunc(p)
+	}
+	r1 = notMocked("EventService.ListEventsAllWithContext")
+	return
+}
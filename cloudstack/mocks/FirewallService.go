@@ -0,0 +1,2053 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockFirewallService is a mock of cloudstack.FirewallServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockFirewallService struct {
+	Recorder
+
+	NewAddCiscoAsa1000vResourceParamsFunc         func(clusterid string, hostname string, insideportprofile string, physicalnetworkid string) *cloudstack.AddCiscoAsa1000vResourceParams
+	AddCiscoAsa1000vResourceFunc                  func(p *cloudstack.AddCiscoAsa1000vResourceParams) (*cloudstack.AddCiscoAsa1000vResourceResponse, error)
+	AddCiscoAsa1000vResourceWithContextFunc       func(ctx context.Context, p *cloudstack.AddCiscoAsa1000vResourceParams) (*cloudstack.AddCiscoAsa1000vResourceResponse, error)
+	NewAddCiscoVnmcResourceParamsFunc             func(hostname string, password string, physicalnetworkid string, username string) *cloudstack.AddCiscoVnmcResourceParams
+	AddCiscoVnmcResourceFunc                      func(p *cloudstack.AddCiscoVnmcResourceParams) (*cloudstack.AddCiscoVnmcResourceResponse, error)
+	AddCiscoVnmcResourceWithContextFunc           func(ctx context.Context, p *cloudstack.AddCiscoVnmcResourceParams) (*cloudstack.AddCiscoVnmcResourceResponse, error)
+	NewAddExternalFirewallParamsFunc              func(password string, url string, username string, zoneid string) *cloudstack.AddExternalFirewallParams
+	AddExternalFirewallFunc                       func(p *cloudstack.AddExternalFirewallParams) (*cloudstack.AddExternalFirewallResponse, error)
+	AddExternalFirewallWithContextFunc            func(ctx context.Context, p *cloudstack.AddExternalFirewallParams) (*cloudstack.AddExternalFirewallResponse, error)
+	NewAddPaloAltoFirewallParamsFunc              func(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *cloudstack.AddPaloAltoFirewallParams
+	AddPaloAltoFirewallFunc                       func(p *cloudstack.AddPaloAltoFirewallParams) (*cloudstack.AddPaloAltoFirewallResponse, error)
+	AddPaloAltoFirewallWithContextFunc            func(ctx context.Context, p *cloudstack.AddPaloAltoFirewallParams) (*cloudstack.AddPaloAltoFirewallResponse, error)
+	AddPaloAltoFirewallAsyncFunc                  func(p *cloudstack.AddPaloAltoFirewallParams) (*cloudstack.Job, error)
+	AddPaloAltoFirewallAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.AddPaloAltoFirewallParams) (*cloudstack.Job, error)
+	NewAddSrxFirewallParamsFunc                   func(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *cloudstack.AddSrxFirewallParams
+	AddSrxFirewallFunc                            func(p *cloudstack.AddSrxFirewallParams) (*cloudstack.AddSrxFirewallResponse, error)
+	AddSrxFirewallWithContextFunc                 func(ctx context.Context, p *cloudstack.AddSrxFirewallParams) (*cloudstack.AddSrxFirewallResponse, error)
+	AddSrxFirewallAsyncFunc                       func(p *cloudstack.AddSrxFirewallParams) (*cloudstack.Job, error)
+	AddSrxFirewallAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.AddSrxFirewallParams) (*cloudstack.Job, error)
+	NewConfigurePaloAltoFirewallParamsFunc        func(fwdeviceid string) *cloudstack.ConfigurePaloAltoFirewallParams
+	ConfigurePaloAltoFirewallFunc                 func(p *cloudstack.ConfigurePaloAltoFirewallParams) (*cloudstack.PaloAltoFirewallResponse, error)
+	ConfigurePaloAltoFirewallWithContextFunc      func(ctx context.Context, p *cloudstack.ConfigurePaloAltoFirewallParams) (*cloudstack.PaloAltoFirewallResponse, error)
+	ConfigurePaloAltoFirewallAsyncFunc            func(p *cloudstack.ConfigurePaloAltoFirewallParams) (*cloudstack.Job, error)
+	ConfigurePaloAltoFirewallAsyncWithContextFunc func(ctx context.Context, p *cloudstack.ConfigurePaloAltoFirewallParams) (*cloudstack.Job, error)
+	NewConfigureSrxFirewallParamsFunc             func(fwdeviceid string) *cloudstack.ConfigureSrxFirewallParams
+	ConfigureSrxFirewallFunc                      func(p *cloudstack.ConfigureSrxFirewallParams) (*cloudstack.SrxFirewallResponse, error)
+	ConfigureSrxFirewallWithContextFunc           func(ctx context.Context, p *cloudstack.ConfigureSrxFirewallParams) (*cloudstack.SrxFirewallResponse, error)
+	ConfigureSrxFirewallAsyncFunc                 func(p *cloudstack.ConfigureSrxFirewallParams) (*cloudstack.Job, error)
+	ConfigureSrxFirewallAsyncWithContextFunc      func(ctx context.Context, p *cloudstack.ConfigureSrxFirewallParams) (*cloudstack.Job, error)
+	NewCreateEgressFirewallRuleParamsFunc         func(networkid string, protocol string) *cloudstack.CreateEgressFirewallRuleParams
+	CreateEgressFirewallRuleFunc                  func(p *cloudstack.CreateEgressFirewallRuleParams) (*cloudstack.CreateEgressFirewallRuleResponse, error)
+	CreateEgressFirewallRuleWithContextFunc       func(ctx context.Context, p *cloudstack.CreateEgressFirewallRuleParams) (*cloudstack.CreateEgressFirewallRuleResponse, error)
+	CreateEgressFirewallRuleAsyncFunc             func(p *cloudstack.CreateEgressFirewallRuleParams) (*cloudstack.Job, error)
+	CreateEgressFirewallRuleAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.CreateEgressFirewallRuleParams) (*cloudstack.Job, error)
+	NewCreateFirewallRuleParamsFunc               func(ipaddressid string, protocol string) *cloudstack.CreateFirewallRuleParams
+	CreateFirewallRuleFunc                        func(p *cloudstack.CreateFirewallRuleParams) (*cloudstack.CreateFirewallRuleResponse, error)
+	CreateFirewallRuleWithContextFunc             func(ctx context.Context, p *cloudstack.CreateFirewallRuleParams) (*cloudstack.CreateFirewallRuleResponse, error)
+	CreateFirewallRuleAsyncFunc                   func(p *cloudstack.CreateFirewallRuleParams) (*cloudstack.Job, error)
+	CreateFirewallRuleAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.CreateFirewallRuleParams) (*cloudstack.Job, error)
+	NewCreatePortForwardingRuleParamsFunc         func(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) *cloudstack.CreatePortForwardingRuleParams
+	CreatePortForwardingRuleFunc                  func(p *cloudstack.CreatePortForwardingRuleParams) (*cloudstack.CreatePortForwardingRuleResponse, error)
+	CreatePortForwardingRuleWithContextFunc       func(ctx context.Context, p *cloudstack.CreatePortForwardingRuleParams) (*cloudstack.CreatePortForwardingRuleResponse, error)
+	CreatePortForwardingRuleAsyncFunc             func(p *cloudstack.CreatePortForwardingRuleParams) (*cloudstack.Job, error)
+	CreatePortForwardingRuleAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.CreatePortForwardingRuleParams) (*cloudstack.Job, error)
+	NewDeleteCiscoAsa1000vResourceParamsFunc      func(resourceid string) *cloudstack.DeleteCiscoAsa1000vResourceParams
+	DeleteCiscoAsa1000vResourceFunc               func(p *cloudstack.DeleteCiscoAsa1000vResourceParams) (*cloudstack.DeleteCiscoAsa1000vResourceResponse, error)
+	DeleteCiscoAsa1000vResourceWithContextFunc    func(ctx context.Context, p *cloudstack.DeleteCiscoAsa1000vResourceParams) (*cloudstack.DeleteCiscoAsa1000vResourceResponse, error)
+	NewDeleteCiscoVnmcResourceParamsFunc          func(resourceid string) *cloudstack.DeleteCiscoVnmcResourceParams
+	DeleteCiscoVnmcResourceFunc                   func(p *cloudstack.DeleteCiscoVnmcResourceParams) (*cloudstack.DeleteCiscoVnmcResourceResponse, error)
+	DeleteCiscoVnmcResourceWithContextFunc        func(ctx context.Context, p *cloudstack.DeleteCiscoVnmcResourceParams) (*cloudstack.DeleteCiscoVnmcResourceResponse, error)
+	NewDeleteEgressFirewallRuleParamsFunc         func(id string) *cloudstack.DeleteEgressFirewallRuleParams
+	DeleteEgressFirewallRuleFunc                  func(p *cloudstack.DeleteEgressFirewallRuleParams) (*cloudstack.DeleteEgressFirewallRuleResponse, error)
+	DeleteEgressFirewallRuleWithContextFunc       func(ctx context.Context, p *cloudstack.DeleteEgressFirewallRuleParams) (*cloudstack.DeleteEgressFirewallRuleResponse, error)
+	DeleteEgressFirewallRuleAsyncFunc             func(p *cloudstack.DeleteEgressFirewallRuleParams) (*cloudstack.Job, error)
+	DeleteEgressFirewallRuleAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.DeleteEgressFirewallRuleParams) (*cloudstack.Job, error)
+	NewDeleteExternalFirewallParamsFunc           func(id string) *cloudstack.DeleteExternalFirewallParams
+	DeleteExternalFirewallFunc                    func(p *cloudstack.DeleteExternalFirewallParams) (*cloudstack.DeleteExternalFirewallResponse, error)
+	DeleteExternalFirewallWithContextFunc         func(ctx context.Context, p *cloudstack.DeleteExternalFirewallParams) (*cloudstack.DeleteExternalFirewallResponse, error)
+	NewDeleteFirewallRuleParamsFunc               func(id string) *cloudstack.DeleteFirewallRuleParams
+	DeleteFirewallRuleFunc                        func(p *cloudstack.DeleteFirewallRuleParams) (*cloudstack.DeleteFirewallRuleResponse, error)
+	DeleteFirewallRuleWithContextFunc             func(ctx context.Context, p *cloudstack.DeleteFirewallRuleParams) (*cloudstack.DeleteFirewallRuleResponse, error)
+	DeleteFirewallRuleAsyncFunc                   func(p *cloudstack.DeleteFirewallRuleParams) (*cloudstack.Job, error)
+	DeleteFirewallRuleAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.DeleteFirewallRuleParams) (*cloudstack.Job, error)
+	NewDeletePaloAltoFirewallParamsFunc           func(fwdeviceid string) *cloudstack.DeletePaloAltoFirewallParams
+	DeletePaloAltoFirewallFunc                    func(p *cloudstack.DeletePaloAltoFirewallParams) (*cloudstack.DeletePaloAltoFirewallResponse, error)
+	DeletePaloAltoFirewallWithContextFunc         func(ctx context.Context, p *cloudstack.DeletePaloAltoFirewallParams) (*cloudstack.DeletePaloAltoFirewallResponse, error)
+	DeletePaloAltoFirewallAsyncFunc               func(p *cloudstack.DeletePaloAltoFirewallParams) (*cloudstack.Job, error)
+	DeletePaloAltoFirewallAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.DeletePaloAltoFirewallParams) (*cloudstack.Job, error)
+	NewDeletePortForwardingRuleParamsFunc         func(id string) *cloudstack.DeletePortForwardingRuleParams
+	DeletePortForwardingRuleFunc                  func(p *cloudstack.DeletePortForwardingRuleParams) (*cloudstack.DeletePortForwardingRuleResponse, error)
+	DeletePortForwardingRuleWithContextFunc       func(ctx context.Context, p *cloudstack.DeletePortForwardingRuleParams) (*cloudstack.DeletePortForwardingRuleResponse, error)
+	DeletePortForwardingRuleAsyncFunc             func(p *cloudstack.DeletePortForwardingRuleParams) (*cloudstack.Job, error)
+	DeletePortForwardingRuleAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.DeletePortForwardingRuleParams) (*cloudstack.Job, error)
+	NewDeleteSrxFirewallParamsFunc                func(fwdeviceid string) *cloudstack.DeleteSrxFirewallParams
+	DeleteSrxFirewallFunc                         func(p *cloudstack.DeleteSrxFirewallParams) (*cloudstack.DeleteSrxFirewallResponse, error)
+	DeleteSrxFirewallWithContextFunc              func(ctx context.Context, p *cloudstack.DeleteSrxFirewallParams) (*cloudstack.DeleteSrxFirewallResponse, error)
+	DeleteSrxFirewallAsyncFunc                    func(p *cloudstack.DeleteSrxFirewallParams) (*cloudstack.Job, error)
+	DeleteSrxFirewallAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.DeleteSrxFirewallParams) (*cloudstack.Job, error)
+	NewListCiscoAsa1000vResourcesParamsFunc       func() *cloudstack.ListCiscoAsa1000vResourcesParams
+	ListCiscoAsa1000vResourcesFunc                func(p *cloudstack.ListCiscoAsa1000vResourcesParams) (*cloudstack.ListCiscoAsa1000vResourcesResponse, error)
+	ListCiscoAsa1000vResourcesWithContextFunc     func(ctx context.Context, p *cloudstack.ListCiscoAsa1000vResourcesParams) (*cloudstack.ListCiscoAsa1000vResourcesResponse, error)
+	NewListCiscoAsa1000vResourcesPagerFunc        func(p *cloudstack.ListCiscoAsa1000vResourcesParams) *cloudstack.ListCiscoAsa1000vResourcesPager
+	ListCiscoAsa1000vResourcesAllFunc             func(p *cloudstack.ListCiscoAsa1000vResourcesParams) (*cloudstack.ListCiscoAsa1000vResourcesResponse, error)
+	ListCiscoAsa1000vResourcesAllWithContextFunc  func(ctx context.Context, p *cloudstack.ListCiscoAsa1000vResourcesParams) (*cloudstack.ListCiscoAsa1000vResourcesResponse, error)
+	NewListCiscoVnmcResourcesParamsFunc           func() *cloudstack.ListCiscoVnmcResourcesParams
+	ListCiscoVnmcResourcesFunc                    func(p *cloudstack.ListCiscoVnmcResourcesParams) (*cloudstack.ListCiscoVnmcResourcesResponse, error)
+	ListCiscoVnmcResourcesWithContextFunc         func(ctx context.Context, p *cloudstack.ListCiscoVnmcResourcesParams) (*cloudstack.ListCiscoVnmcResourcesResponse, error)
+	NewListCiscoVnmcResourcesPagerFunc            func(p *cloudstack.ListCiscoVnmcResourcesParams) *cloudstack.ListCiscoVnmcResourcesPager
+	ListCiscoVnmcResourcesAllFunc                 func(p *cloudstack.ListCiscoVnmcResourcesParams) (*cloudstack.ListCiscoVnmcResourcesResponse, error)
+	ListCiscoVnmcResourcesAllWithContextFunc      func(ctx context.Context, p *cloudstack.ListCiscoVnmcResourcesParams) (*cloudstack.ListCiscoVnmcResourcesResponse, error)
+	NewListEgressFirewallRulesParamsFunc          func() *cloudstack.ListEgressFirewallRulesParams
+	GetEgressFirewallRuleByIDFunc                 func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.EgressFirewallRule, int, error)
+	GetEgressFirewallRuleByIDWithContextFunc      func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.EgressFirewallRule, int, error)
+	ListEgressFirewallRulesFunc                   func(p *cloudstack.ListEgressFirewallRulesParams) (*cloudstack.ListEgressFirewallRulesResponse, error)
+	ListEgressFirewallRulesWithContextFunc        func(ctx context.Context, p *cloudstack.ListEgressFirewallRulesParams) (*cloudstack.ListEgressFirewallRulesResponse, error)
+	NewListEgressFirewallRulesPagerFunc           func(p *cloudstack.ListEgressFirewallRulesParams) *cloudstack.ListEgressFirewallRulesPager
+	ListEgressFirewallRulesAllFunc                func(p *cloudstack.ListEgressFirewallRulesParams) (*cloudstack.ListEgressFirewallRulesResponse, error)
+	ListEgressFirewallRulesAllWithContextFunc     func(ctx context.Context, p *cloudstack.ListEgressFirewallRulesParams) (*cloudstack.ListEgressFirewallRulesResponse, error)
+	NewListExternalFirewallsParamsFunc            func(zoneid string) *cloudstack.ListExternalFirewallsParams
+	ListExternalFirewallsFunc                     func(p *cloudstack.ListExternalFirewallsParams) (*cloudstack.ListExternalFirewallsResponse, error)
+	ListExternalFirewallsWithContextFunc          func(ctx context.Context, p *cloudstack.ListExternalFirewallsParams) (*cloudstack.ListExternalFirewallsResponse, error)
+	NewListExternalFirewallsPagerFunc             func(p *cloudstack.ListExternalFirewallsParams) *cloudstack.ListExternalFirewallsPager
+	ListExternalFirewallsAllFunc                  func(p *cloudstack.ListExternalFirewallsParams) (*cloudstack.ListExternalFirewallsResponse, error)
+	ListExternalFirewallsAllWithContextFunc       func(ctx context.Context, p *cloudstack.ListExternalFirewallsParams) (*cloudstack.ListExternalFirewallsResponse, error)
+	NewListFirewallRulesParamsFunc                func() *cloudstack.ListFirewallRulesParams
+	GetFirewallRuleByIDFunc                       func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.FirewallRule, int, error)
+	GetFirewallRuleByIDWithContextFunc            func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.FirewallRule, int, error)
+	ListFirewallRulesFunc                         func(p *cloudstack.ListFirewallRulesParams) (*cloudstack.ListFirewallRulesResponse, error)
+	ListFirewallRulesWithContextFunc              func(ctx context.Context, p *cloudstack.ListFirewallRulesParams) (*cloudstack.ListFirewallRulesResponse, error)
+	NewListFirewallRulesPagerFunc                 func(p *cloudstack.ListFirewallRulesParams) *cloudstack.ListFirewallRulesPager
+	ListFirewallRulesAllFunc                      func(p *cloudstack.ListFirewallRulesParams) (*cloudstack.ListFirewallRulesResponse, error)
+	ListFirewallRulesAllWithContextFunc           func(ctx context.Context, p *cloudstack.ListFirewallRulesParams) (*cloudstack.ListFirewallRulesResponse, error)
+	NewListPaloAltoFirewallsParamsFunc            func() *cloudstack.ListPaloAltoFirewallsParams
+	ListPaloAltoFirewallsFunc                     func(p *cloudstack.ListPaloAltoFirewallsParams) (*cloudstack.ListPaloAltoFirewallsResponse, error)
+	ListPaloAltoFirewallsWithContextFunc          func(ctx context.Context, p *cloudstack.ListPaloAltoFirewallsParams) (*cloudstack.ListPaloAltoFirewallsResponse, error)
+	NewListPaloAltoFirewallsPagerFunc             func(p *cloudstack.ListPaloAltoFirewallsParams) *cloudstack.ListPaloAltoFirewallsPager
+	ListPaloAltoFirewallsAllFunc                  func(p *cloudstack.ListPaloAltoFirewallsParams) (*cloudstack.ListPaloAltoFirewallsResponse, error)
+	ListPaloAltoFirewallsAllWithContextFunc       func(ctx context.Context, p *cloudstack.ListPaloAltoFirewallsParams) (*cloudstack.ListPaloAltoFirewallsResponse, error)
+	NewListPortForwardingRulesParamsFunc          func() *cloudstack.ListPortForwardingRulesParams
+	GetPortForwardingRuleByIDFunc                 func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.PortForwardingRule, int, error)
+	GetPortForwardingRuleByIDWithContextFunc      func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.PortForwardingRule, int, error)
+	ListPortForwardingRulesFunc                   func(p *cloudstack.ListPortForwardingRulesParams) (*cloudstack.ListPortForwardingRulesResponse, error)
+	ListPortForwardingRulesWithContextFunc        func(ctx context.Context, p *cloudstack.ListPortForwardingRulesParams) (*cloudstack.ListPortForwardingRulesResponse, error)
+	NewListPortForwardingRulesPagerFunc           func(p *cloudstack.ListPortForwardingRulesParams) *cloudstack.ListPortForwardingRulesPager
+	ListPortForwardingRulesAllFunc                func(p *cloudstack.ListPortForwardingRulesParams) (*cloudstack.ListPortForwardingRulesResponse, error)
+	ListPortForwardingRulesAllWithContextFunc     func(ctx context.Context, p *cloudstack.ListPortForwardingRulesParams) (*cloudstack.ListPortForwardingRulesResponse, error)
+	NewListSrxFirewallNetworksParamsFunc          func(lbdeviceid string) *cloudstack.ListSrxFirewallNetworksParams
+	GetSrxFirewallNetworkIDFunc                   func(keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetSrxFirewallNetworkIDWithContextFunc        func(ctx context.Context, keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListSrxFirewallNetworksFunc                   func(p *cloudstack.ListSrxFirewallNetworksParams) (*cloudstack.ListSrxFirewallNetworksResponse, error)
+	ListSrxFirewallNetworksWithContextFunc        func(ctx context.Context, p *cloudstack.ListSrxFirewallNetworksParams) (*cloudstack.ListSrxFirewallNetworksResponse, error)
+	NewListSrxFirewallNetworksPagerFunc           func(p *cloudstack.ListSrxFirewallNetworksParams) *cloudstack.ListSrxFirewallNetworksPager
+	ListSrxFirewallNetworksAllFunc                func(p *cloudstack.ListSrxFirewallNetworksParams) (*cloudstack.ListSrxFirewallNetworksResponse, error)
+	ListSrxFirewallNetworksAllWithContextFunc     func(ctx context.Context, p *cloudstack.ListSrxFirewallNetworksParams) (*cloudstack.ListSrxFirewallNetworksResponse, error)
+	NewListSrxFirewallsParamsFunc                 func() *cloudstack.ListSrxFirewallsParams
+	ListSrxFirewallsFunc                          func(p *cloudstack.ListSrxFirewallsParams) (*cloudstack.ListSrxFirewallsResponse, error)
+	ListSrxFirewallsWithContextFunc               func(ctx context.Context, p *cloudstack.ListSrxFirewallsParams) (*cloudstack.ListSrxFirewallsResponse, error)
+	NewListSrxFirewallsPagerFunc                  func(p *cloudstack.ListSrxFirewallsParams) *cloudstack.ListSrxFirewallsPager
+	ListSrxFirewallsAllFunc                       func(p *cloudstack.ListSrxFirewallsParams) (*cloudstack.ListSrxFirewallsResponse, error)
+	ListSrxFirewallsAllWithContextFunc            func(ctx context.Context, p *cloudstack.ListSrxFirewallsParams) (*cloudstack.ListSrxFirewallsResponse, error)
+	NewUpdateEgressFirewallRuleParamsFunc         func(id string) *cloudstack.UpdateEgressFirewallRuleParams
+	UpdateEgressFirewallRuleFunc                  func(p *cloudstack.UpdateEgressFirewallRuleParams) (*cloudstack.UpdateEgressFirewallRuleResponse, error)
+	UpdateEgressFirewallRuleWithContextFunc       func(ctx context.Context, p *cloudstack.UpdateEgressFirewallRuleParams) (*cloudstack.UpdateEgressFirewallRuleResponse, error)
+	UpdateEgressFirewallRuleAsyncFunc             func(p *cloudstack.UpdateEgressFirewallRuleParams) (*cloudstack.Job, error)
+	UpdateEgressFirewallRuleAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.UpdateEgressFirewallRuleParams) (*cloudstack.Job, error)
+	NewUpdateFirewallRuleParamsFunc               func(id string) *cloudstack.UpdateFirewallRuleParams
+	UpdateFirewallRuleFunc                        func(p *cloudstack.UpdateFirewallRuleParams) (*cloudstack.UpdateFirewallRuleResponse, error)
+	UpdateFirewallRuleWithContextFunc             func(ctx context.Context, p *cloudstack.UpdateFirewallRuleParams) (*cloudstack.UpdateFirewallRuleResponse, error)
+	UpdateFirewallRuleAsyncFunc                   func(p *cloudstack.UpdateFirewallRuleParams) (*cloudstack.Job, error)
+	UpdateFirewallRuleAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.UpdateFirewallRuleParams) (*cloudstack.Job, error)
+	NewUpdatePortForwardingRuleParamsFunc         func(id string) *cloudstack.UpdatePortForwardingRuleParams
+	UpdatePortForwardingRuleFunc                  func(p *cloudstack.UpdatePortForwardingRuleParams) (*cloudstack.UpdatePortForwardingRuleResponse, error)
+	UpdatePortForwardingRuleWithContextFunc       func(ctx context.Context, p *cloudstack.UpdatePortForwardingRuleParams) (*cloudstack.UpdatePortForwardingRuleResponse, error)
+	UpdatePortForwardingRuleAsyncFunc             func(p *cloudstack.UpdatePortForwardingRuleParams) (*cloudstack.Job, error)
+	UpdatePortForwardingRuleAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.UpdatePortForwardingRuleParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.FirewallServiceIface = (*MockFirewallService)(nil)
+
+// NewAddCiscoAsa1000vResourceParams records the call and calls NewAddCiscoAsa1000vResourceParamsFunc
+func (m *MockFirewallService) NewAddCiscoAsa1000vResourceParams(clusterid string, hostname string, insideportprofile string, physicalnetworkid string) (r0 *cloudstack.AddCiscoAsa1000vResourceParams) {
+	m.record("NewAddCiscoAsa1000vResourceParams", clusterid, hostname, insideportprofile, physicalnetworkid)
+	if m.NewAddCiscoAsa1000vResourceParamsFunc != nil {
+		return m.NewAddCiscoAsa1000vResourceParamsFunc(clusterid, hostname, insideportprofile, physicalnetworkid)
+	}
+	return cloudstack.NewFirewallService(nil).NewAddCiscoAsa1000vResourceParams(clusterid, hostname, insideportprofile, physicalnetworkid)
+}
+
+// AddCiscoAsa1000vResource records the call and calls AddCiscoAsa1000vResourceFunc, or AddCiscoAsa1000vResourceWithContextFunc if only that is set
+func (m *MockFirewallService) AddCiscoAsa1000vResource(p *cloudstack.AddCiscoAsa1000vResourceParams) (r0 *cloudstack.AddCiscoAsa1000vResourceResponse, r1 error) {
+	m.record("AddCiscoAsa1000vResource", p)
+	if m.AddCiscoAsa1000vResourceFunc != nil {
+		return m.AddCiscoAsa1000vResourceFunc(p)
+	}
+	if m.AddCiscoAsa1000vResourceWithContextFunc != nil {
+		return m.AddCiscoAsa1000vResourceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.AddCiscoAsa1000vResource")
+	return
+}
+
+// AddCiscoAsa1000vResourceWithContext records the call and calls AddCiscoAsa1000vResourceWithContextFunc, or AddCiscoAsa1000vResourceFunc if only that is set
+func (m *MockFirewallService) AddCiscoAsa1000vResourceWithContext(ctx context.Context, p *cloudstack.AddCiscoAsa1000vResourceParams) (r0 *cloudstack.AddCiscoAsa1000vResourceResponse, r1 error) {
+	m.record("AddCiscoAsa1000vResourceWithContext", ctx, p)
+	if m.AddCiscoAsa1000vResourceWithContextFunc != nil {
+		return m.AddCiscoAsa1000vResourceWithContextFunc(ctx, p)
+	}
+	if m.AddCiscoAsa1000vResourceFunc != nil {
+		return m.AddCiscoAsa1000vResourceFunc(p)
+	}
+	r1 = notMocked("FirewallService.AddCiscoAsa1000vResourceWithContext")
+	return
+}
+
+// NewAddCiscoVnmcResourceParams records the call and calls NewAddCiscoVnmcResourceParamsFunc
+func (m *MockFirewallService) NewAddCiscoVnmcResourceParams(hostname string, password string, physicalnetworkid string, username string) (r0 *cloudstack.AddCiscoVnmcResourceParams) {
+	m.record("NewAddCiscoVnmcResourceParams", hostname, password, physicalnetworkid, username)
+	if m.NewAddCiscoVnmcResourceParamsFunc != nil {
+		return m.NewAddCiscoVnmcResourceParamsFunc(hostname, password, physicalnetworkid, username)
+	}
+	return cloudstack.NewFirewallService(nil).NewAddCiscoVnmcResourceParams(hostname, password, physicalnetworkid, username)
+}
+
+// AddCiscoVnmcResource records the call and calls AddCiscoVnmcResourceFunc, or AddCiscoVnmcResourceWithContextFunc if only that is set
+func (m *MockFirewallService) AddCiscoVnmcResource(p *cloudstack.AddCiscoVnmcResourceParams) (r0 *cloudstack.AddCiscoVnmcResourceResponse, r1 error) {
+	m.record("AddCiscoVnmcResource", p)
+	if m.AddCiscoVnmcResourceFunc != nil {
+		return m.AddCiscoVnmcResourceFunc(p)
+	}
+	if m.AddCiscoVnmcResourceWithContextFunc != nil {
+		return m.AddCiscoVnmcResourceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.AddCiscoVnmcResource")
+	return
+}
+
+// AddCiscoVnmcResourceWithContext records the call and calls AddCiscoVnmcResourceWithContextFunc, or AddCiscoVnmcResourceFunc if only that is set
+func (m *MockFirewallService) AddCiscoVnmcResourceWithContext(ctx context.Context, p *cloudstack.AddCiscoVnmcResourceParams) (r0 *cloudstack.AddCiscoVnmcResourceResponse, r1 error) {
+	m.record("AddCiscoVnmcResourceWithContext", ctx, p)
+	if m.AddCiscoVnmcResourceWithContextFunc != nil {
+		return m.AddCiscoVnmcResourceWithContextFunc(ctx, p)
+	}
+	if m.AddCiscoVnmcResourceFunc != nil {
+		return m.AddCiscoVnmcResourceFunc(p)
+	}
+	r1 = notMocked("FirewallService.AddCiscoVnmcResourceWithContext")
+	return
+}
+
+// NewAddExternalFirewallParams records the call and calls NewAddExternalFirewallParamsFunc
+func (m *MockFirewallService) NewAddExternalFirewallParams(password string, url string, username string, zoneid string) (r0 *cloudstack.AddExternalFirewallParams) {
+	m.record("NewAddExternalFirewallParams", password, url, username, zoneid)
+	if m.NewAddExternalFirewallParamsFunc != nil {
+		return m.NewAddExternalFirewallParamsFunc(password, url, username, zoneid)
+	}
+	return cloudstack.NewFirewallService(nil).NewAddExternalFirewallParams(password, url, username, zoneid)
+}
+
+// AddExternalFirewall records the call and calls AddExternalFirewallFunc, or AddExternalFirewallWithContextFunc if only that is set
+func (m *MockFirewallService) AddExternalFirewall(p *cloudstack.AddExternalFirewallParams) (r0 *cloudstack.AddExternalFirewallResponse, r1 error) {
+	m.record("AddExternalFirewall", p)
+	if m.AddExternalFirewallFunc != nil {
+		return m.AddExternalFirewallFunc(p)
+	}
+	if m.AddExternalFirewallWithContextFunc != nil {
+		return m.AddExternalFirewallWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.AddExternalFirewall")
+	return
+}
+
+// AddExternalFirewallWithContext records the call and calls AddExternalFirewallWithContextFunc, or AddExternalFirewallFunc if only that is set
+func (m *MockFirewallService) AddExternalFirewallWithContext(ctx context.Context, p *cloudstack.AddExternalFirewallParams) (r0 *cloudstack.AddExternalFirewallResponse, r1 error) {
+	m.record("AddExternalFirewallWithContext", ctx, p)
+	if m.AddExternalFirewallWithContextFunc != nil {
+		return m.AddExternalFirewallWithContextFunc(ctx, p)
+	}
+	if m.AddExternalFirewallFunc != nil {
+		return m.AddExternalFirewallFunc(p)
+	}
+	r1 = notMocked("FirewallService.AddExternalFirewallWithContext")
+	return
+}
+
+// NewAddPaloAltoFirewallParams records the call and calls NewAddPaloAltoFirewallParamsFunc
+func (m *MockFirewallService) NewAddPaloAltoFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) (r0 *cloudstack.AddPaloAltoFirewallParams) {
+	m.record("NewAddPaloAltoFirewallParams", networkdevicetype, password, physicalnetworkid, url, username)
+	if m.NewAddPaloAltoFirewallParamsFunc != nil {
+		return m.NewAddPaloAltoFirewallParamsFunc(networkdevicetype, password, physicalnetworkid, url, username)
+	}
+	return cloudstack.NewFirewallService(nil).NewAddPaloAltoFirewallParams(networkdevicetype, password, physicalnetworkid, url, username)
+}
+
+// AddPaloAltoFirewall records the call and calls AddPaloAltoFirewallFunc, or AddPaloAltoFirewallWithContextFunc if only that is set
+func (m *MockFirewallService) AddPaloAltoFirewall(p *cloudstack.AddPaloAltoFirewallParams) (r0 *cloudstack.AddPaloAltoFirewallResponse, r1 error) {
+	m.record("AddPaloAltoFirewall", p)
+	if m.AddPaloAltoFirewallFunc != nil {
+		return m.AddPaloAltoFirewallFunc(p)
+	}
+	if m.AddPaloAltoFirewallWithContextFunc != nil {
+		return m.AddPaloAltoFirewallWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.AddPaloAltoFirewall")
+	return
+}
+
+// AddPaloAltoFirewallWithContext records the call and calls AddPaloAltoFirewallWithContextFunc, or AddPaloAltoFirewallFunc if only that is set
+func (m *MockFirewallService) AddPaloAltoFirewallWithContext(ctx context.Context, p *cloudstack.AddPaloAltoFirewallParams) (r0 *cloudstack.AddPaloAltoFirewallResponse, r1 error) {
+	m.record("AddPaloAltoFirewallWithContext", ctx, p)
+	if m.AddPaloAltoFirewallWithContextFunc != nil {
+		return m.AddPaloAltoFirewallWithContextFunc(ctx, p)
+	}
+	if m.AddPaloAltoFirewallFunc != nil {
+		return m.AddPaloAltoFirewallFunc(p)
+	}
+	r1 = notMocked("FirewallService.AddPaloAltoFirewallWithContext")
+	return
+}
+
+// AddPaloAltoFirewallAsync records the call and calls AddPaloAltoFirewallAsyncFunc, or AddPaloAltoFirewallAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) AddPaloAltoFirewallAsync(p *cloudstack.AddPaloAltoFirewallParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddPaloAltoFirewallAsync", p)
+	if m.AddPaloAltoFirewallAsyncFunc != nil {
+		return m.AddPaloAltoFirewallAsyncFunc(p)
+	}
+	if m.AddPaloAltoFirewallAsyncWithContextFunc != nil {
+		return m.AddPaloAltoFirewallAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.AddPaloAltoFirewallAsync")
+	return
+}
+
+// AddPaloAltoFirewallAsyncWithContext records the call and calls AddPaloAltoFirewallAsyncWithContextFunc, or AddPaloAltoFirewallAsyncFunc if only that is set
+func (m *MockFirewallService) AddPaloAltoFirewallAsyncWithContext(ctx context.Context, p *cloudstack.AddPaloAltoFirewallParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddPaloAltoFirewallAsyncWithContext", ctx, p)
+	if m.AddPaloAltoFirewallAsyncWithContextFunc != nil {
+		return m.AddPaloAltoFirewallAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddPaloAltoFirewallAsyncFunc != nil {
+		return m.AddPaloAltoFirewallAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.AddPaloAltoFirewallAsyncWithContext")
+	return
+}
+
+// NewAddSrxFirewallParams records the call and calls NewAddSrxFirewallParamsFunc
+func (m *MockFirewallService) NewAddSrxFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) (r0 *cloudstack.AddSrxFirewallParams) {
+	m.record("NewAddSrxFirewallParams", networkdevicetype, password, physicalnetworkid, url, username)
+	if m.NewAddSrxFirewallParamsFunc != nil {
+		return m.NewAddSrxFirewallParamsFunc(networkdevicetype, password, physicalnetworkid, url, username)
+	}
+	return cloudstack.NewFirewallService(nil).NewAddSrxFirewallParams(networkdevicetype, password, physicalnetworkid, url, username)
+}
+
+// AddSrxFirewall records the call and calls AddSrxFirewallFunc, or AddSrxFirewallWithContextFunc if only that is set
+func (m *MockFirewallService) AddSrxFirewall(p *cloudstack.AddSrxFirewallParams) (r0 *cloudstack.AddSrxFirewallResponse, r1 error) {
+	m.record("AddSrxFirewall", p)
+	if m.AddSrxFirewallFunc != nil {
+		return m.AddSrxFirewallFunc(p)
+	}
+	if m.AddSrxFirewallWithContextFunc != nil {
+		return m.AddSrxFirewallWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.AddSrxFirewall")
+	return
+}
+
+// AddSrxFirewallWithContext records the call and calls AddSrxFirewallWithContextFunc, or AddSrxFirewallFunc if only that is set
+func (m *MockFirewallService) AddSrxFirewallWithContext(ctx context.Context, p *cloudstack.AddSrxFirewallParams) (r0 *cloudstack.AddSrxFirewallResponse, r1 error) {
+	m.record("AddSrxFirewallWithContext", ctx, p)
+	if m.AddSrxFirewallWithContextFunc != nil {
+		return m.AddSrxFirewallWithContextFunc(ctx, p)
+	}
+	if m.AddSrxFirewallFunc != nil {
+		return m.AddSrxFirewallFunc(p)
+	}
+	r1 = notMocked("FirewallService.AddSrxFirewallWithContext")
+	return
+}
+
+// AddSrxFirewallAsync records the call and calls AddSrxFirewallAsyncFunc, or AddSrxFirewallAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) AddSrxFirewallAsync(p *cloudstack.AddSrxFirewallParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddSrxFirewallAsync", p)
+	if m.AddSrxFirewallAsyncFunc != nil {
+		return m.AddSrxFirewallAsyncFunc(p)
+	}
+	if m.AddSrxFirewallAsyncWithContextFunc != nil {
+		return m.AddSrxFirewallAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.AddSrxFirewallAsync")
+	return
+}
+
+// AddSrxFirewallAsyncWithContext records the call and calls AddSrxFirewallAsyncWithContextFunc, or AddSrxFirewallAsyncFunc if only that is set
+func (m *MockFirewallService) AddSrxFirewallAsyncWithContext(ctx context.Context, p *cloudstack.AddSrxFirewallParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddSrxFirewallAsyncWithContext", ctx, p)
+	if m.AddSrxFirewallAsyncWithContextFunc != nil {
+		return m.AddSrxFirewallAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddSrxFirewallAsyncFunc != nil {
+		return m.AddSrxFirewallAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.AddSrxFirewallAsyncWithContext")
+	return
+}
+
+// NewConfigurePaloAltoFirewallParams records the call and calls NewConfigurePaloAltoFirewallParamsFunc
+func (m *MockFirewallService) NewConfigurePaloAltoFirewallParams(fwdeviceid string) (r0 *cloudstack.ConfigurePaloAltoFirewallParams) {
+	m.record("NewConfigurePaloAltoFirewallParams", fwdeviceid)
+	if m.NewConfigurePaloAltoFirewallParamsFunc != nil {
+		return m.NewConfigurePaloAltoFirewallParamsFunc(fwdeviceid)
+	}
+	return cloudstack.NewFirewallService(nil).NewConfigurePaloAltoFirewallParams(fwdeviceid)
+}
+
+// ConfigurePaloAltoFirewall records the call and calls ConfigurePaloAltoFirewallFunc, or ConfigurePaloAltoFirewallWithContextFunc if only that is set
+func (m *MockFirewallService) ConfigurePaloAltoFirewall(p *cloudstack.ConfigurePaloAltoFirewallParams) (r0 *cloudstack.PaloAltoFirewallResponse, r1 error) {
+	m.record("ConfigurePaloAltoFirewall", p)
+	if m.ConfigurePaloAltoFirewallFunc != nil {
+		return m.ConfigurePaloAltoFirewallFunc(p)
+	}
+	if m.ConfigurePaloAltoFirewallWithContextFunc != nil {
+		return m.ConfigurePaloAltoFirewallWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ConfigurePaloAltoFirewall")
+	return
+}
+
+// ConfigurePaloAltoFirewallWithContext records the call and calls ConfigurePaloAltoFirewallWithContextFunc, or ConfigurePaloAltoFirewallFunc if only that is set
+func (m *MockFirewallService) ConfigurePaloAltoFirewallWithContext(ctx context.Context, p *cloudstack.ConfigurePaloAltoFirewallParams) (r0 *cloudstack.PaloAltoFirewallResponse, r1 error) {
+	m.record("ConfigurePaloAltoFirewallWithContext", ctx, p)
+	if m.ConfigurePaloAltoFirewallWithContextFunc != nil {
+		return m.ConfigurePaloAltoFirewallWithContextFunc(ctx, p)
+	}
+	if m.ConfigurePaloAltoFirewallFunc != nil {
+		return m.ConfigurePaloAltoFirewallFunc(p)
+	}
+	r1 = notMocked("FirewallService.ConfigurePaloAltoFirewallWithContext")
+	return
+}
+
+// ConfigurePaloAltoFirewallAsync records the call and calls ConfigurePaloAltoFirewallAsyncFunc, or ConfigurePaloAltoFirewallAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) ConfigurePaloAltoFirewallAsync(p *cloudstack.ConfigurePaloAltoFirewallParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigurePaloAltoFirewallAsync", p)
+	if m.ConfigurePaloAltoFirewallAsyncFunc != nil {
+		return m.ConfigurePaloAltoFirewallAsyncFunc(p)
+	}
+	if m.ConfigurePaloAltoFirewallAsyncWithContextFunc != nil {
+		return m.ConfigurePaloAltoFirewallAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ConfigurePaloAltoFirewallAsync")
+	return
+}
+
+// ConfigurePaloAltoFirewallAsyncWithContext records the call and calls ConfigurePaloAltoFirewallAsyncWithContextFunc, or ConfigurePaloAltoFirewallAsyncFunc if only that is set
+func (m *MockFirewallService) ConfigurePaloAltoFirewallAsyncWithContext(ctx context.Context, p *cloudstack.ConfigurePaloAltoFirewallParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigurePaloAltoFirewallAsyncWithContext", ctx, p)
+	if m.ConfigurePaloAltoFirewallAsyncWithContextFunc != nil {
+		return m.ConfigurePaloAltoFirewallAsyncWithContextFunc(ctx, p)
+	}
+	if m.ConfigurePaloAltoFirewallAsyncFunc != nil {
+		return m.ConfigurePaloAltoFirewallAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.ConfigurePaloAltoFirewallAsyncWithContext")
+	return
+}
+
+// NewConfigureSrxFirewallParams records the call and calls NewConfigureSrxFirewallParamsFunc
+func (m *MockFirewallService) NewConfigureSrxFirewallParams(fwdeviceid string) (r0 *cloudstack.ConfigureSrxFirewallParams) {
+	m.record("NewConfigureSrxFirewallParams", fwdeviceid)
+	if m.NewConfigureSrxFirewallParamsFunc != nil {
+		return m.NewConfigureSrxFirewallParamsFunc(fwdeviceid)
+	}
+	return cloudstack.NewFirewallService(nil).NewConfigureSrxFirewallParams(fwdeviceid)
+}
+
+// ConfigureSrxFirewall records the call and calls ConfigureSrxFirewallFunc, or ConfigureSrxFirewallWithContextFunc if only that is set
+func (m *MockFirewallService) ConfigureSrxFirewall(p *cloudstack.ConfigureSrxFirewallParams) (r0 *cloudstack.SrxFirewallResponse, r1 error) {
+	m.record("ConfigureSrxFirewall", p)
+	if m.ConfigureSrxFirewallFunc != nil {
+		return m.ConfigureSrxFirewallFunc(p)
+	}
+	if m.ConfigureSrxFirewallWithContextFunc != nil {
+		return m.ConfigureSrxFirewallWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ConfigureSrxFirewall")
+	return
+}
+
+// ConfigureSrxFirewallWithContext records the call and calls ConfigureSrxFirewallWithContextFunc, or ConfigureSrxFirewallFunc if only that is set
+func (m *MockFirewallService) ConfigureSrxFirewallWithContext(ctx context.Context, p *cloudstack.ConfigureSrxFirewallParams) (r0 *cloudstack.SrxFirewallResponse, r1 error) {
+	m.record("ConfigureSrxFirewallWithContext", ctx, p)
+	if m.ConfigureSrxFirewallWithContextFunc != nil {
+		return m.ConfigureSrxFirewallWithContextFunc(ctx, p)
+	}
+	if m.ConfigureSrxFirewallFunc != nil {
+		return m.ConfigureSrxFirewallFunc(p)
+	}
+	r1 = notMocked("FirewallService.ConfigureSrxFirewallWithContext")
+	return
+}
+
+// ConfigureSrxFirewallAsync records the call and calls ConfigureSrxFirewallAsyncFunc, or ConfigureSrxFirewallAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) ConfigureSrxFirewallAsync(p *cloudstack.ConfigureSrxFirewallParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigureSrxFirewallAsync", p)
+	if m.ConfigureSrxFirewallAsyncFunc != nil {
+		return m.ConfigureSrxFirewallAsyncFunc(p)
+	}
+	if m.ConfigureSrxFirewallAsyncWithContextFunc != nil {
+		return m.ConfigureSrxFirewallAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ConfigureSrxFirewallAsync")
+	return
+}
+
+// ConfigureSrxFirewallAsyncWithContext records the call and calls ConfigureSrxFirewallAsyncWithContextFunc, or ConfigureSrxFirewallAsyncFunc if only that is set
+func (m *MockFirewallService) ConfigureSrxFirewallAsyncWithContext(ctx context.Context, p *cloudstack.ConfigureSrxFirewallParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigureSrxFirewallAsyncWithContext", ctx, p)
+	if m.ConfigureSrxFirewallAsyncWithContextFunc != nil {
+		return m.ConfigureSrxFirewallAsyncWithContextFunc(ctx, p)
+	}
+	if m.ConfigureSrxFirewallAsyncFunc != nil {
+		return m.ConfigureSrxFirewallAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.ConfigureSrxFirewallAsyncWithContext")
+	return
+}
+
+// NewCreateEgressFirewallRuleParams records the call and calls NewCreateEgressFirewallRuleParamsFunc
+func (m *MockFirewallService) NewCreateEgressFirewallRuleParams(networkid string, protocol string) (r0 *cloudstack.CreateEgressFirewallRuleParams) {
+	m.record("NewCreateEgressFirewallRuleParams", networkid, protocol)
+	if m.NewCreateEgressFirewallRuleParamsFunc != nil {
+		return m.NewCreateEgressFirewallRuleParamsFunc(networkid, protocol)
+	}
+	return cloudstack.NewFirewallService(nil).NewCreateEgressFirewallRuleParams(networkid, protocol)
+}
+
+// CreateEgressFirewallRule records the call and calls CreateEgressFirewallRuleFunc, or CreateEgressFirewallRuleWithContextFunc if only that is set
+func (m *MockFirewallService) CreateEgressFirewallRule(p *cloudstack.CreateEgressFirewallRuleParams) (r0 *cloudstack.CreateEgressFirewallRuleResponse, r1 error) {
+	m.record("CreateEgressFirewallRule", p)
+	if m.CreateEgressFirewallRuleFunc != nil {
+		return m.CreateEgressFirewallRuleFunc(p)
+	}
+	if m.CreateEgressFirewallRuleWithContextFunc != nil {
+		return m.CreateEgressFirewallRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.CreateEgressFirewallRule")
+	return
+}
+
+// CreateEgressFirewallRuleWithContext records the call and calls CreateEgressFirewallRuleWithContextFunc, or CreateEgressFirewallRuleFunc if only that is set
+func (m *MockFirewallService) CreateEgressFirewallRuleWithContext(ctx context.Context, p *cloudstack.CreateEgressFirewallRuleParams) (r0 *cloudstack.CreateEgressFirewallRuleResponse, r1 error) {
+	m.record("CreateEgressFirewallRuleWithContext", ctx, p)
+	if m.CreateEgressFirewallRuleWithContextFunc != nil {
+		return m.CreateEgressFirewallRuleWithContextFunc(ctx, p)
+	}
+	if m.CreateEgressFirewallRuleFunc != nil {
+		return m.CreateEgressFirewallRuleFunc(p)
+	}
+	r1 = notMocked("FirewallService.CreateEgressFirewallRuleWithContext")
+	return
+}
+
+// CreateEgressFirewallRuleAsync records the call and calls CreateEgressFirewallRuleAsyncFunc, or CreateEgressFirewallRuleAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) CreateEgressFirewallRuleAsync(p *cloudstack.CreateEgressFirewallRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateEgressFirewallRuleAsync", p)
+	if m.CreateEgressFirewallRuleAsyncFunc != nil {
+		return m.CreateEgressFirewallRuleAsyncFunc(p)
+	}
+	if m.CreateEgressFirewallRuleAsyncWithContextFunc != nil {
+		return m.CreateEgressFirewallRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.CreateEgressFirewallRuleAsync")
+	return
+}
+
+// CreateEgressFirewallRuleAsyncWithContext records the call and calls CreateEgressFirewallRuleAsyncWithContextFunc, or CreateEgressFirewallRuleAsyncFunc if only that is set
+func (m *MockFirewallService) CreateEgressFirewallRuleAsyncWithContext(ctx context.Context, p *cloudstack.CreateEgressFirewallRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateEgressFirewallRuleAsyncWithContext", ctx, p)
+	if m.CreateEgressFirewallRuleAsyncWithContextFunc != nil {
+		return m.CreateEgressFirewallRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateEgressFirewallRuleAsyncFunc != nil {
+		return m.CreateEgressFirewallRuleAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.CreateEgressFirewallRuleAsyncWithContext")
+	return
+}
+
+// NewCreateFirewallRuleParams records the call and calls NewCreateFirewallRuleParamsFunc
+func (m *MockFirewallService) NewCreateFirewallRuleParams(ipaddressid string, protocol string) (r0 *cloudstack.CreateFirewallRuleParams) {
+	m.record("NewCreateFirewallRuleParams", ipaddressid, protocol)
+	if m.NewCreateFirewallRuleParamsFunc != nil {
+		return m.NewCreateFirewallRuleParamsFunc(ipaddressid, protocol)
+	}
+	return cloudstack.NewFirewallService(nil).NewCreateFirewallRuleParams(ipaddressid, protocol)
+}
+
+// CreateFirewallRule records the call and calls CreateFirewallRuleFunc, or CreateFirewallRuleWithContextFunc if only that is set
+func (m *MockFirewallService) CreateFirewallRule(p *cloudstack.CreateFirewallRuleParams) (r0 *cloudstack.CreateFirewallRuleResponse, r1 error) {
+	m.record("CreateFirewallRule", p)
+	if m.CreateFirewallRuleFunc != nil {
+		return m.CreateFirewallRuleFunc(p)
+	}
+	if m.CreateFirewallRuleWithContextFunc != nil {
+		return m.CreateFirewallRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.CreateFirewallRule")
+	return
+}
+
+// CreateFirewallRuleWithContext records the call and calls CreateFirewallRuleWithContextFunc, or CreateFirewallRuleFunc if only that is set
+func (m *MockFirewallService) CreateFirewallRuleWithContext(ctx context.Context, p *cloudstack.CreateFirewallRuleParams) (r0 *cloudstack.CreateFirewallRuleResponse, r1 error) {
+	m.record("CreateFirewallRuleWithContext", ctx, p)
+	if m.CreateFirewallRuleWithContextFunc != nil {
+		return m.CreateFirewallRuleWithContextFunc(ctx, p)
+	}
+	if m.CreateFirewallRuleFunc != nil {
+		return m.CreateFirewallRuleFunc(p)
+	}
+	r1 = notMocked("FirewallService.CreateFirewallRuleWithContext")
+	return
+}
+
+// CreateFirewallRuleAsync records the call and calls CreateFirewallRuleAsyncFunc, or CreateFirewallRuleAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) CreateFirewallRuleAsync(p *cloudstack.CreateFirewallRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateFirewallRuleAsync", p)
+	if m.CreateFirewallRuleAsyncFunc != nil {
+		return m.CreateFirewallRuleAsyncFunc(p)
+	}
+	if m.CreateFirewallRuleAsyncWithContextFunc != nil {
+		return m.CreateFirewallRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.CreateFirewallRuleAsync")
+	return
+}
+
+// CreateFirewallRuleAsyncWithContext records the call and calls CreateFirewallRuleAsyncWithContextFunc, or CreateFirewallRuleAsyncFunc if only that is set
+func (m *MockFirewallService) CreateFirewallRuleAsyncWithContext(ctx context.Context, p *cloudstack.CreateFirewallRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateFirewallRuleAsyncWithContext", ctx, p)
+	if m.CreateFirewallRuleAsyncWithContextFunc != nil {
+		return m.CreateFirewallRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateFirewallRuleAsyncFunc != nil {
+		return m.CreateFirewallRuleAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.CreateFirewallRuleAsyncWithContext")
+	return
+}
+
+// NewCreatePortForwardingRuleParams records the call and calls NewCreatePortForwardingRuleParamsFunc
+func (m *MockFirewallService) NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) (r0 *cloudstack.CreatePortForwardingRuleParams) {
+	m.record("NewCreatePortForwardingRuleParams", ipaddressid, privateport, protocol, publicport, virtualmachineid)
+	if m.NewCreatePortForwardingRuleParamsFunc != nil {
+		return m.NewCreatePortForwardingRuleParamsFunc(ipaddressid, privateport, protocol, publicport, virtualmachineid)
+	}
+	return cloudstack.NewFirewallService(nil).NewCreatePortForwardingRuleParams(ipaddressid, privateport, protocol, publicport, virtualmachineid)
+}
+
+// CreatePortForwardingRule records the call and calls CreatePortForwardingRuleFunc, or CreatePortForwardingRuleWithContextFunc if only that is set
+func (m *MockFirewallService) CreatePortForwardingRule(p *cloudstack.CreatePortForwardingRuleParams) (r0 *cloudstack.CreatePortForwardingRuleResponse, r1 error) {
+	m.record("CreatePortForwardingRule", p)
+	if m.CreatePortForwardingRuleFunc != nil {
+		return m.CreatePortForwardingRuleFunc(p)
+	}
+	if m.CreatePortForwardingRuleWithContextFunc != nil {
+		return m.CreatePortForwardingRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.CreatePortForwardingRule")
+	return
+}
+
+// CreatePortForwardingRuleWithContext records the call and calls CreatePortForwardingRuleWithContextFunc, or CreatePortForwardingRuleFunc if only that is set
+func (m *MockFirewallService) CreatePortForwardingRuleWithContext(ctx context.Context, p *cloudstack.CreatePortForwardingRuleParams) (r0 *cloudstack.CreatePortForwardingRuleResponse, r1 error) {
+	m.record("CreatePortForwardingRuleWithContext", ctx, p)
+	if m.CreatePortForwardingRuleWithContextFunc != nil {
+		return m.CreatePortForwardingRuleWithContextFunc(ctx, p)
+	}
+	if m.CreatePortForwardingRuleFunc != nil {
+		return m.CreatePortForwardingRuleFunc(p)
+	}
+	r1 = notMocked("FirewallService.CreatePortForwardingRuleWithContext")
+	return
+}
+
+// CreatePortForwardingRuleAsync records the call and calls CreatePortForwardingRuleAsyncFunc, or CreatePortForwardingRuleAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) CreatePortForwardingRuleAsync(p *cloudstack.CreatePortForwardingRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreatePortForwardingRuleAsync", p)
+	if m.CreatePortForwardingRuleAsyncFunc != nil {
+		return m.CreatePortForwardingRuleAsyncFunc(p)
+	}
+	if m.CreatePortForwardingRuleAsyncWithContextFunc != nil {
+		return m.CreatePortForwardingRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.CreatePortForwardingRuleAsync")
+	return
+}
+
+// CreatePortForwardingRuleAsyncWithContext records the call and calls CreatePortForwardingRuleAsyncWithContextFunc, or CreatePortForwardingRuleAsyncFunc if only that is set
+func (m *MockFirewallService) CreatePortForwardingRuleAsyncWithContext(ctx context.Context, p *cloudstack.CreatePortForwardingRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreatePortForwardingRuleAsyncWithContext", ctx, p)
+	if m.CreatePortForwardingRuleAsyncWithContextFunc != nil {
+		return m.CreatePortForwardingRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreatePortForwardingRuleAsyncFunc != nil {
+		return m.CreatePortForwardingRuleAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.CreatePortForwardingRuleAsyncWithContext")
+	return
+}
+
+// NewDeleteCiscoAsa1000vResourceParams records the call and calls NewDeleteCiscoAsa1000vResourceParamsFunc
+func (m *MockFirewallService) NewDeleteCiscoAsa1000vResourceParams(resourceid string) (r0 *cloudstack.DeleteCiscoAsa1000vResourceParams) {
+	m.record("NewDeleteCiscoAsa1000vResourceParams", resourceid)
+	if m.NewDeleteCiscoAsa1000vResourceParamsFunc != nil {
+		return m.NewDeleteCiscoAsa1000vResourceParamsFunc(resourceid)
+	}
+	return cloudstack.NewFirewallService(nil).NewDeleteCiscoAsa1000vResourceParams(resourceid)
+}
+
+// DeleteCiscoAsa1000vResource records the call and calls DeleteCiscoAsa1000vResourceFunc, or DeleteCiscoAsa1000vResourceWithContextFunc if only that is set
+func (m *MockFirewallService) DeleteCiscoAsa1000vResource(p *cloudstack.DeleteCiscoAsa1000vResourceParams) (r0 *cloudstack.DeleteCiscoAsa1000vResourceResponse, r1 error) {
+	m.record("DeleteCiscoAsa1000vResource", p)
+	if m.DeleteCiscoAsa1000vResourceFunc != nil {
+		return m.DeleteCiscoAsa1000vResourceFunc(p)
+	}
+	if m.DeleteCiscoAsa1000vResourceWithContextFunc != nil {
+		return m.DeleteCiscoAsa1000vResourceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.DeleteCiscoAsa1000vResource")
+	return
+}
+
+// DeleteCiscoAsa1000vResourceWithContext records the call and calls DeleteCiscoAsa1000vResourceWithContextFunc, or DeleteCiscoAsa1000vResourceFunc if only that is set
+func (m *MockFirewallService) DeleteCiscoAsa1000vResourceWithContext(ctx context.Context, p *cloudstack.DeleteCiscoAsa1000vResourceParams) (r0 *cloudstack.DeleteCiscoAsa1000vResourceResponse, r1 error) {
+	m.record("DeleteCiscoAsa1000vResourceWithContext", ctx, p)
+	if m.DeleteCiscoAsa1000vResourceWithContextFunc != nil {
+		return m.DeleteCiscoAsa1000vResourceWithContextFunc(ctx, p)
+	}
+	if m.DeleteCiscoAsa1000vResourceFunc != nil {
+		return m.DeleteCiscoAsa1000vResourceFunc(p)
+	}
+	r1 = notMocked("FirewallService.DeleteCiscoAsa1000vResourceWithContext")
+	return
+}
+
+// NewDeleteCiscoVnmcResourceParams records the call and calls NewDeleteCiscoVnmcResourceParamsFunc
+func (m *MockFirewallService) NewDeleteCiscoVnmcResourceParams(resourceid string) (r0 *cloudstack.DeleteCiscoVnmcResourceParams) {
+	m.record("NewDeleteCiscoVnmcResourceParams", resourceid)
+	if m.NewDeleteCiscoVnmcResourceParamsFunc != nil {
+		return m.NewDeleteCiscoVnmcResourceParamsFunc(resourceid)
+	}
+	return cloudstack.NewFirewallService(nil).NewDeleteCiscoVnmcResourceParams(resourceid)
+}
+
+// DeleteCiscoVnmcResource records the call and calls DeleteCiscoVnmcResourceFunc, or DeleteCiscoVnmcResourceWithContextFunc if only that is set
+func (m *MockFirewallService) DeleteCiscoVnmcResource(p *cloudstack.DeleteCiscoVnmcResourceParams) (r0 *cloudstack.DeleteCiscoVnmcResourceResponse, r1 error) {
+	m.record("DeleteCiscoVnmcResource", p)
+	if m.DeleteCiscoVnmcResourceFunc != nil {
+		return m.DeleteCiscoVnmcResourceFunc(p)
+	}
+	if m.DeleteCiscoVnmcResourceWithContextFunc != nil {
+		return m.DeleteCiscoVnmcResourceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.DeleteCiscoVnmcResource")
+	return
+}
+
+// DeleteCiscoVnmcResourceWithContext records the call and calls DeleteCiscoVnmcResourceWithContextFunc, or DeleteCiscoVnmcResourceFunc if only that is set
+func (m *MockFirewallService) DeleteCiscoVnmcResourceWithContext(ctx context.Context, p *cloudstack.DeleteCiscoVnmcResourceParams) (r0 *cloudstack.DeleteCiscoVnmcResourceResponse, r1 error) {
+	m.record("DeleteCiscoVnmcResourceWithContext", ctx, p)
+	if m.DeleteCiscoVnmcResourceWithContextFunc != nil {
+		return m.DeleteCiscoVnmcResourceWithContextFunc(ctx, p)
+	}
+	if m.DeleteCiscoVnmcResourceFunc != nil {
+		return m.DeleteCiscoVnmcResourceFunc(p)
+	}
+	r1 = notMocked("FirewallService.DeleteCiscoVnmcResourceWithContext")
+	return
+}
+
+// NewDeleteEgressFirewallRuleParams records the call and calls NewDeleteEgressFirewallRuleParamsFunc
+func (m *MockFirewallService) NewDeleteEgressFirewallRuleParams(id string) (r0 *cloudstack.DeleteEgressFirewallRuleParams) {
+	m.record("NewDeleteEgressFirewallRuleParams", id)
+	if m.NewDeleteEgressFirewallRuleParamsFunc != nil {
+		return m.NewDeleteEgressFirewallRuleParamsFunc(id)
+	}
+	return cloudstack.NewFirewallService(nil).NewDeleteEgressFirewallRuleParams(id)
+}
+
+// DeleteEgressFirewallRule records the call and calls DeleteEgressFirewallRuleFunc, or DeleteEgressFirewallRuleWithContextFunc if only that is set
+func (m *MockFirewallService) DeleteEgressFirewallRule(p *cloudstack.DeleteEgressFirewallRuleParams) (r0 *cloudstack.DeleteEgressFirewallRuleResponse, r1 error) {
+	m.record("DeleteEgressFirewallRule", p)
+	if m.DeleteEgressFirewallRuleFunc != nil {
+		return m.DeleteEgressFirewallRuleFunc(p)
+	}
+	if m.DeleteEgressFirewallRuleWithContextFunc != nil {
+		return m.DeleteEgressFirewallRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.DeleteEgressFirewallRule")
+	return
+}
+
+// DeleteEgressFirewallRuleWithContext records the call and calls DeleteEgressFirewallRuleWithContextFunc, or DeleteEgressFirewallRuleFunc if only that is set
+func (m *MockFirewallService) DeleteEgressFirewallRuleWithContext(ctx context.Context, p *cloudstack.DeleteEgressFirewallRuleParams) (r0 *cloudstack.DeleteEgressFirewallRuleResponse, r1 error) {
+	m.record("DeleteEgressFirewallRuleWithContext", ctx, p)
+	if m.DeleteEgressFirewallRuleWithContextFunc != nil {
+		return m.DeleteEgressFirewallRuleWithContextFunc(ctx, p)
+	}
+	if m.DeleteEgressFirewallRuleFunc != nil {
+		return m.DeleteEgressFirewallRuleFunc(p)
+	}
+	r1 = notMocked("FirewallService.DeleteEgressFirewallRuleWithContext")
+	return
+}
+
+// DeleteEgressFirewallRuleAsync records the call and calls DeleteEgressFirewallRuleAsyncFunc, or DeleteEgressFirewallRuleAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) DeleteEgressFirewallRuleAsync(p *cloudstack.DeleteEgressFirewallRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteEgressFirewallRuleAsync", p)
+	if m.DeleteEgressFirewallRuleAsyncFunc != nil {
+		return m.DeleteEgressFirewallRuleAsyncFunc(p)
+	}
+	if m.DeleteEgressFirewallRuleAsyncWithContextFunc != nil {
+		return m.DeleteEgressFirewallRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.DeleteEgressFirewallRuleAsync")
+	return
+}
+
+// DeleteEgressFirewallRuleAsyncWithContext records the call and calls DeleteEgressFirewallRuleAsyncWithContextFunc, or DeleteEgressFirewallRuleAsyncFunc if only that is set
+func (m *MockFirewallService) DeleteEgressFirewallRuleAsyncWithContext(ctx context.Context, p *cloudstack.DeleteEgressFirewallRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteEgressFirewallRuleAsyncWithContext", ctx, p)
+	if m.DeleteEgressFirewallRuleAsyncWithContextFunc != nil {
+		return m.DeleteEgressFirewallRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteEgressFirewallRuleAsyncFunc != nil {
+		return m.DeleteEgressFirewallRuleAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.DeleteEgressFirewallRuleAsyncWithContext")
+	return
+}
+
+// NewDeleteExternalFirewallParams records the call and calls NewDeleteExternalFirewallParamsFunc
+func (m *MockFirewallService) NewDeleteExternalFirewallParams(id string) (r0 *cloudstack.DeleteExternalFirewallParams) {
+	m.record("NewDeleteExternalFirewallParams", id)
+	if m.NewDeleteExternalFirewallParamsFunc != nil {
+		return m.NewDeleteExternalFirewallParamsFunc(id)
+	}
+	return cloudstack.NewFirewallService(nil).NewDeleteExternalFirewallParams(id)
+}
+
+// DeleteExternalFirewall records the call and calls DeleteExternalFirewallFunc, or DeleteExternalFirewallWithContextFunc if only that is set
+func (m *MockFirewallService) DeleteExternalFirewall(p *cloudstack.DeleteExternalFirewallParams) (r0 *cloudstack.DeleteExternalFirewallResponse, r1 error) {
+	m.record("DeleteExternalFirewall", p)
+	if m.DeleteExternalFirewallFunc != nil {
+		return m.DeleteExternalFirewallFunc(p)
+	}
+	if m.DeleteExternalFirewallWithContextFunc != nil {
+		return m.DeleteExternalFirewallWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.DeleteExternalFirewall")
+	return
+}
+
+// DeleteExternalFirewallWithContext records the call and calls DeleteExternalFirewallWithContextFunc, or DeleteExternalFirewallFunc if only that is set
+func (m *MockFirewallService) DeleteExternalFirewallWithContext(ctx context.Context, p *cloudstack.DeleteExternalFirewallParams) (r0 *cloudstack.DeleteExternalFirewallResponse, r1 error) {
+	m.record("DeleteExternalFirewallWithContext", ctx, p)
+	if m.DeleteExternalFirewallWithContextFunc != nil {
+		return m.DeleteExternalFirewallWithContextFunc(ctx, p)
+	}
+	if m.DeleteExternalFirewallFunc != nil {
+		return m.DeleteExternalFirewallFunc(p)
+	}
+	r1 = notMocked("FirewallService.DeleteExternalFirewallWithContext")
+	return
+}
+
+// NewDeleteFirewallRuleParams records the call and calls NewDeleteFirewallRuleParamsFunc
+func (m *MockFirewallService) NewDeleteFirewallRuleParams(id string) (r0 *cloudstack.DeleteFirewallRuleParams) {
+	m.record("NewDeleteFirewallRuleParams", id)
+	if m.NewDeleteFirewallRuleParamsFunc != nil {
+		return m.NewDeleteFirewallRuleParamsFunc(id)
+	}
+	return cloudstack.NewFirewallService(nil).NewDeleteFirewallRuleParams(id)
+}
+
+// DeleteFirewallRule records the call and calls DeleteFirewallRuleFunc, or DeleteFirewallRuleWithContextFunc if only that is set
+func (m *MockFirewallService) DeleteFirewallRule(p *cloudstack.DeleteFirewallRuleParams) (r0 *cloudstack.DeleteFirewallRuleResponse, r1 error) {
+	m.record("DeleteFirewallRule", p)
+	if m.DeleteFirewallRuleFunc != nil {
+		return m.DeleteFirewallRuleFunc(p)
+	}
+	if m.DeleteFirewallRuleWithContextFunc != nil {
+		return m.DeleteFirewallRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.DeleteFirewallRule")
+	return
+}
+
+// DeleteFirewallRuleWithContext records the call and calls DeleteFirewallRuleWithContextFunc, or DeleteFirewallRuleFunc if only that is set
+func (m *MockFirewallService) DeleteFirewallRuleWithContext(ctx context.Context, p *cloudstack.DeleteFirewallRuleParams) (r0 *cloudstack.DeleteFirewallRuleResponse, r1 error) {
+	m.record("DeleteFirewallRuleWithContext", ctx, p)
+	if m.DeleteFirewallRuleWithContextFunc != nil {
+		return m.DeleteFirewallRuleWithContextFunc(ctx, p)
+	}
+	if m.DeleteFirewallRuleFunc != nil {
+		return m.DeleteFirewallRuleFunc(p)
+	}
+	r1 = notMocked("FirewallService.DeleteFirewallRuleWithContext")
+	return
+}
+
+// DeleteFirewallRuleAsync records the call and calls DeleteFirewallRuleAsyncFunc, or DeleteFirewallRuleAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) DeleteFirewallRuleAsync(p *cloudstack.DeleteFirewallRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteFirewallRuleAsync", p)
+	if m.DeleteFirewallRuleAsyncFunc != nil {
+		return m.DeleteFirewallRuleAsyncFunc(p)
+	}
+	if m.DeleteFirewallRuleAsyncWithContextFunc != nil {
+		return m.DeleteFirewallRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.DeleteFirewallRuleAsync")
+	return
+}
+
+// DeleteFirewallRuleAsyncWithContext records the call and calls DeleteFirewallRuleAsyncWithContextFunc, or DeleteFirewallRuleAsyncFunc if only that is set
+func (m *MockFirewallService) DeleteFirewallRuleAsyncWithContext(ctx context.Context, p *cloudstack.DeleteFirewallRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteFirewallRuleAsyncWithContext", ctx, p)
+	if m.DeleteFirewallRuleAsyncWithContextFunc != nil {
+		return m.DeleteFirewallRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteFirewallRuleAsyncFunc != nil {
+		return m.DeleteFirewallRuleAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.DeleteFirewallRuleAsyncWithContext")
+	return
+}
+
+// NewDeletePaloAltoFirewallParams records the call and calls NewDeletePaloAltoFirewallParamsFunc
+func (m *MockFirewallService) NewDeletePaloAltoFirewallParams(fwdeviceid string) (r0 *cloudstack.DeletePaloAltoFirewallParams) {
+	m.record("NewDeletePaloAltoFirewallParams", fwdeviceid)
+	if m.NewDeletePaloAltoFirewallParamsFunc != nil {
+		return m.NewDeletePaloAltoFirewallParamsFunc(fwdeviceid)
+	}
+	return cloudstack.NewFirewallService(nil).NewDeletePaloAltoFirewallParams(fwdeviceid)
+}
+
+// DeletePaloAltoFirewall records the call and calls DeletePaloAltoFirewallFunc, or DeletePaloAltoFirewallWithContextFunc if only that is set
+func (m *MockFirewallService) DeletePaloAltoFirewall(p *cloudstack.DeletePaloAltoFirewallParams) (r0 *cloudstack.DeletePaloAltoFirewallResponse, r1 error) {
+	m.record("DeletePaloAltoFirewall", p)
+	if m.DeletePaloAltoFirewallFunc != nil {
+		return m.DeletePaloAltoFirewallFunc(p)
+	}
+	if m.DeletePaloAltoFirewallWithContextFunc != nil {
+		return m.DeletePaloAltoFirewallWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.DeletePaloAltoFirewall")
+	return
+}
+
+// DeletePaloAltoFirewallWithContext records the call and calls DeletePaloAltoFirewallWithContextFunc, or DeletePaloAltoFirewallFunc if only that is set
+func (m *MockFirewallService) DeletePaloAltoFirewallWithContext(ctx context.Context, p *cloudstack.DeletePaloAltoFirewallParams) (r0 *cloudstack.DeletePaloAltoFirewallResponse, r1 error) {
+	m.record("DeletePaloAltoFirewallWithContext", ctx, p)
+	if m.DeletePaloAltoFirewallWithContextFunc != nil {
+		return m.DeletePaloAltoFirewallWithContextFunc(ctx, p)
+	}
+	if m.DeletePaloAltoFirewallFunc != nil {
+		return m.DeletePaloAltoFirewallFunc(p)
+	}
+	r1 = notMocked("FirewallService.DeletePaloAltoFirewallWithContext")
+	return
+}
+
+// DeletePaloAltoFirewallAsync records the call and calls DeletePaloAltoFirewallAsyncFunc, or DeletePaloAltoFirewallAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) DeletePaloAltoFirewallAsync(p *cloudstack.DeletePaloAltoFirewallParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeletePaloAltoFirewallAsync", p)
+	if m.DeletePaloAltoFirewallAsyncFunc != nil {
+		return m.DeletePaloAltoFirewallAsyncFunc(p)
+	}
+	if m.DeletePaloAltoFirewallAsyncWithContextFunc != nil {
+		return m.DeletePaloAltoFirewallAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.DeletePaloAltoFirewallAsync")
+	return
+}
+
+// DeletePaloAltoFirewallAsyncWithContext records the call and calls DeletePaloAltoFirewallAsyncWithContextFunc, or DeletePaloAltoFirewallAsyncFunc if only that is set
+func (m *MockFirewallService) DeletePaloAltoFirewallAsyncWithContext(ctx context.Context, p *cloudstack.DeletePaloAltoFirewallParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeletePaloAltoFirewallAsyncWithContext", ctx, p)
+	if m.DeletePaloAltoFirewallAsyncWithContextFunc != nil {
+		return m.DeletePaloAltoFirewallAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeletePaloAltoFirewallAsyncFunc != nil {
+		return m.DeletePaloAltoFirewallAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.DeletePaloAltoFirewallAsyncWithContext")
+	return
+}
+
+// NewDeletePortForwardingRuleParams records the call and calls NewDeletePortForwardingRuleParamsFunc
+func (m *MockFirewallService) NewDeletePortForwardingRuleParams(id string) (r0 *cloudstack.DeletePortForwardingRuleParams) {
+	m.record("NewDeletePortForwardingRuleParams", id)
+	if m.NewDeletePortForwardingRuleParamsFunc != nil {
+		return m.NewDeletePortForwardingRuleParamsFunc(id)
+	}
+	return cloudstack.NewFirewallService(nil).NewDeletePortForwardingRuleParams(id)
+}
+
+// DeletePortForwardingRule records the call and calls DeletePortForwardingRuleFunc, or DeletePortForwardingRuleWithContextFunc if only that is set
+func (m *MockFirewallService) DeletePortForwardingRule(p *cloudstack.DeletePortForwardingRuleParams) (r0 *cloudstack.DeletePortForwardingRuleResponse, r1 error) {
+	m.record("DeletePortForwardingRule", p)
+	if m.DeletePortForwardingRuleFunc != nil {
+		return m.DeletePortForwardingRuleFunc(p)
+	}
+	if m.DeletePortForwardingRuleWithContextFunc != nil {
+		return m.DeletePortForwardingRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.DeletePortForwardingRule")
+	return
+}
+
+// DeletePortForwardingRuleWithContext records the call and calls DeletePortForwardingRuleWithContextFunc, or DeletePortForwardingRuleFunc if only that is set
+func (m *MockFirewallService) DeletePortForwardingRuleWithContext(ctx context.Context, p *cloudstack.DeletePortForwardingRuleParams) (r0 *cloudstack.DeletePortForwardingRuleResponse, r1 error) {
+	m.record("DeletePortForwardingRuleWithContext", ctx, p)
+	if m.DeletePortForwardingRuleWithContextFunc != nil {
+		return m.DeletePortForwardingRuleWithContextFunc(ctx, p)
+	}
+	if m.DeletePortForwardingRuleFunc != nil {
+		return m.DeletePortForwardingRuleFunc(p)
+	}
+	r1 = notMocked("FirewallService.DeletePortForwardingRuleWithContext")
+	return
+}
+
+// DeletePortForwardingRuleAsync records the call and calls DeletePortForwardingRuleAsyncFunc, or DeletePortForwardingRuleAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) DeletePortForwardingRuleAsync(p *cloudstack.DeletePortForwardingRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeletePortForwardingRuleAsync", p)
+	if m.DeletePortForwardingRuleAsyncFunc != nil {
+		return m.DeletePortForwardingRuleAsyncFunc(p)
+	}
+	if m.DeletePortForwardingRuleAsyncWithContextFunc != nil {
+		return m.DeletePortForwardingRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.DeletePortForwardingRuleAsync")
+	return
+}
+
+// DeletePortForwardingRuleAsyncWithContext records the call and calls DeletePortForwardingRuleAsyncWithContextFunc, or DeletePortForwardingRuleAsyncFunc if only that is set
+func (m *MockFirewallService) DeletePortForwardingRuleAsyncWithContext(ctx context.Context, p *cloudstack.DeletePortForwardingRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeletePortForwardingRuleAsyncWithContext", ctx, p)
+	if m.DeletePortForwardingRuleAsyncWithContextFunc != nil {
+		return m.DeletePortForwardingRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeletePortForwardingRuleAsyncFunc != nil {
+		return m.DeletePortForwardingRuleAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.DeletePortForwardingRuleAsyncWithContext")
+	return
+}
+
+// NewDeleteSrxFirewallParams records the call and calls NewDeleteSrxFirewallParamsFunc
+func (m *MockFirewallService) NewDeleteSrxFirewallParams(fwdeviceid string) (r0 *cloudstack.DeleteSrxFirewallParams) {
+	m.record("NewDeleteSrxFirewallParams", fwdeviceid)
+	if m.NewDeleteSrxFirewallParamsFunc != nil {
+		return m.NewDeleteSrxFirewallParamsFunc(fwdeviceid)
+	}
+	return cloudstack.NewFirewallService(nil).NewDeleteSrxFirewallParams(fwdeviceid)
+}
+
+// DeleteSrxFirewall records the call and calls DeleteSrxFirewallFunc, or DeleteSrxFirewallWithContextFunc if only that is set
+func (m *MockFirewallService) DeleteSrxFirewall(p *cloudstack.DeleteSrxFirewallParams) (r0 *cloudstack.DeleteSrxFirewallResponse, r1 error) {
+	m.record("DeleteSrxFirewall", p)
+	if m.DeleteSrxFirewallFunc != nil {
+		return m.DeleteSrxFirewallFunc(p)
+	}
+	if m.DeleteSrxFirewallWithContextFunc != nil {
+		return m.DeleteSrxFirewallWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.DeleteSrxFirewall")
+	return
+}
+
+// DeleteSrxFirewallWithContext records the call and calls DeleteSrxFirewallWithContextFunc, or DeleteSrxFirewallFunc if only that is set
+func (m *MockFirewallService) DeleteSrxFirewallWithContext(ctx context.Context, p *cloudstack.DeleteSrxFirewallParams) (r0 *cloudstack.DeleteSrxFirewallResponse, r1 error) {
+	m.record("DeleteSrxFirewallWithContext", ctx, p)
+	if m.DeleteSrxFirewallWithContextFunc != nil {
+		return m.DeleteSrxFirewallWithContextFunc(ctx, p)
+	}
+	if m.DeleteSrxFirewallFunc != nil {
+		return m.DeleteSrxFirewallFunc(p)
+	}
+	r1 = notMocked("FirewallService.DeleteSrxFirewallWithContext")
+	return
+}
+
+// DeleteSrxFirewallAsync records the call and calls DeleteSrxFirewallAsyncFunc, or DeleteSrxFirewallAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) DeleteSrxFirewallAsync(p *cloudstack.DeleteSrxFirewallParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteSrxFirewallAsync", p)
+	if m.DeleteSrxFirewallAsyncFunc != nil {
+		return m.DeleteSrxFirewallAsyncFunc(p)
+	}
+	if m.DeleteSrxFirewallAsyncWithContextFunc != nil {
+		return m.DeleteSrxFirewallAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.DeleteSrxFirewallAsync")
+	return
+}
+
+// DeleteSrxFirewallAsyncWithContext records the call and calls DeleteSrxFirewallAsyncWithContextFunc, or DeleteSrxFirewallAsyncFunc if only that is set
+func (m *MockFirewallService) DeleteSrxFirewallAsyncWithContext(ctx context.Context, p *cloudstack.DeleteSrxFirewallParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteSrxFirewallAsyncWithContext", ctx, p)
+	if m.DeleteSrxFirewallAsyncWithContextFunc != nil {
+		return m.DeleteSrxFirewallAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteSrxFirewallAsyncFunc != nil {
+		return m.DeleteSrxFirewallAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.DeleteSrxFirewallAsyncWithContext")
+	return
+}
+
+// NewListCiscoAsa1000vResourcesParams records the call and calls NewListCiscoAsa1000vResourcesParamsFunc
+func (m *MockFirewallService) NewListCiscoAsa1000vResourcesParams() (r0 *cloudstack.ListCiscoAsa1000vResourcesParams) {
+	m.record("NewListCiscoAsa1000vResourcesParams")
+	if m.NewListCiscoAsa1000vResourcesParamsFunc != nil {
+		return m.NewListCiscoAsa1000vResourcesParamsFunc()
+	}
+	return cloudstack.NewFirewallService(nil).NewListCiscoAsa1000vResourcesParams()
+}
+
+// ListCiscoAsa1000vResources records the call and calls ListCiscoAsa1000vResourcesFunc, or ListCiscoAsa1000vResourcesWithContextFunc if only that is set
+func (m *MockFirewallService) ListCiscoAsa1000vResources(p *cloudstack.ListCiscoAsa1000vResourcesParams) (r0 *cloudstack.ListCiscoAsa1000vResourcesResponse, r1 error) {
+	m.record("ListCiscoAsa1000vResources", p)
+	if m.ListCiscoAsa1000vResourcesFunc != nil {
+		return m.ListCiscoAsa1000vResourcesFunc(p)
+	}
+	if m.ListCiscoAsa1000vResourcesWithContextFunc != nil {
+		return m.ListCiscoAsa1000vResourcesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListCiscoAsa1000vResources")
+	return
+}
+
+// ListCiscoAsa1000vResourcesWithContext records the call and calls ListCiscoAsa1000vResourcesWithContextFunc, or ListCiscoAsa1000vResourcesFunc if only that is set
+func (m *MockFirewallService) ListCiscoAsa1000vResourcesWithContext(ctx context.Context, p *cloudstack.ListCiscoAsa1000vResourcesParams) (r0 *cloudstack.ListCiscoAsa1000vResourcesResponse, r1 error) {
+	m.record("ListCiscoAsa1000vResourcesWithContext", ctx, p)
+	if m.ListCiscoAsa1000vResourcesWithContextFunc != nil {
+		return m.ListCiscoAsa1000vResourcesWithContextFunc(ctx, p)
+	}
+	if m.ListCiscoAsa1000vResourcesFunc != nil {
+		return m.ListCiscoAsa1000vResourcesFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListCiscoAsa1000vResourcesWithContext")
+	return
+}
+
+// NewListCiscoAsa1000vResourcesPager records the call and calls NewListCiscoAsa1000vResourcesPagerFunc
+func (m *MockFirewallService) NewListCiscoAsa1000vResourcesPager(p *cloudstack.ListCiscoAsa1000vResourcesParams) (r0 *cloudstack.ListCiscoAsa1000vResourcesPager) {
+	m.record("NewListCiscoAsa1000vResourcesPager", p)
+	if m.NewListCiscoAsa1000vResourcesPagerFunc != nil {
+		return m.NewListCiscoAsa1000vResourcesPagerFunc(p)
+	}
+	return
+}
+
+// ListCiscoAsa1000vResourcesAll records the call and calls ListCiscoAsa1000vResourcesAllFunc, or ListCiscoAsa1000vResourcesAllWithContextFunc if only that is set
+func (m *MockFirewallService) ListCiscoAsa1000vResourcesAll(p *cloudstack.ListCiscoAsa1000vResourcesParams) (r0 *cloudstack.ListCiscoAsa1000vResourcesResponse, r1 error) {
+	m.record("ListCiscoAsa1000vResourcesAll", p)
+	if m.ListCiscoAsa1000vResourcesAllFunc != nil {
+		return m.ListCiscoAsa1000vResourcesAllFunc(p)
+	}
+	if m.ListCiscoAsa1000vResourcesAllWithContextFunc != nil {
+		return m.ListCiscoAsa1000vResourcesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListCiscoAsa1000vResourcesAll")
+	return
+}
+
+// ListCiscoAsa1000vResourcesAllWithContext records the call and calls ListCiscoAsa1000vResourcesAllWithContextFunc, or ListCiscoAsa1000vResourcesAllFunc if only that is set
+func (m *MockFirewallService) ListCiscoAsa1000vResourcesAllWithContext(ctx context.Context, p *cloudstack.ListCiscoAsa1000vResourcesParams) (r0 *cloudstack.ListCiscoAsa1000vResourcesResponse, r1 error) {
+	m.record("ListCiscoAsa1000vResourcesAllWithContext", ctx, p)
+	if m.ListCiscoAsa1000vResourcesAllWithContextFunc != nil {
+		return m.ListCiscoAsa1000vResourcesAllWithContextFunc(ctx, p)
+	}
+	if m.ListCiscoAsa1000vResourcesAllFunc != nil {
+		return m.ListCiscoAsa1000vResourcesAllFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListCiscoAsa1000vResourcesAllWithContext")
+	return
+}
+
+// NewListCiscoVnmcResourcesParams records the call and calls NewListCiscoVnmcResourcesParamsFunc
+func (m *MockFirewallService) NewListCiscoVnmcResourcesParams() (r0 *cloudstack.ListCiscoVnmcResourcesParams) {
+	m.record("NewListCiscoVnmcResourcesParams")
+	if m.NewListCiscoVnmcResourcesParamsFunc != nil {
+		return m.NewListCiscoVnmcResourcesParamsFunc()
+	}
+	return cloudstack.NewFirewallService(nil).NewListCiscoVnmcResourcesParams()
+}
+
+// ListCiscoVnmcResources records the call and calls ListCiscoVnmcResourcesFunc, or ListCiscoVnmcResourcesWithContextFunc if only that is set
+func (m *MockFirewallService) ListCiscoVnmcResources(p *cloudstack.ListCiscoVnmcResourcesParams) (r0 *cloudstack.ListCiscoVnmcResourcesResponse, r1 error) {
+	m.record("ListCiscoVnmcResources", p)
+	if m.ListCiscoVnmcResourcesFunc != nil {
+		return m.ListCiscoVnmcResourcesFunc(p)
+	}
+	if m.ListCiscoVnmcResourcesWithContextFunc != nil {
+		return m.ListCiscoVnmcResourcesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListCiscoVnmcResources")
+	return
+}
+
+// ListCiscoVnmcResourcesWithContext records the call and calls ListCiscoVnmcResourcesWithContextFunc, or ListCiscoVnmcResourcesFunc if only that is set
+func (m *MockFirewallService) ListCiscoVnmcResourcesWithContext(ctx context.Context, p *cloudstack.ListCiscoVnmcResourcesParams) (r0 *cloudstack.ListCiscoVnmcResourcesResponse, r1 error) {
+	m.record("ListCiscoVnmcResourcesWithContext", ctx, p)
+	if m.ListCiscoVnmcResourcesWithContextFunc != nil {
+		return m.ListCiscoVnmcResourcesWithContextFunc(ctx, p)
+	}
+	if m.ListCiscoVnmcResourcesFunc != nil {
+		return m.ListCiscoVnmcResourcesFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListCiscoVnmcResourcesWithContext")
+	return
+}
+
+// NewListCiscoVnmcResourcesPager records the call and calls NewListCiscoVnmcResourcesPagerFunc
+func (m *MockFirewallService) NewListCiscoVnmcResourcesPager(p *cloudstack.ListCiscoVnmcResourcesParams) (r0 *cloudstack.ListCiscoVnmcResourcesPager) {
+	m.record("NewListCiscoVnmcResourcesPager", p)
+	if m.NewListCiscoVnmcResourcesPagerFunc != nil {
+		return m.NewListCiscoVnmcResourcesPagerFunc(p)
+	}
+	return
+}
+
+// ListCiscoVnmcResourcesAll records the call and calls ListCiscoVnmcResourcesAllFunc, or ListCiscoVnmcResourcesAllWithContextFunc if only that is set
+func (m *MockFirewallService) ListCiscoVnmcResourcesAll(p *cloudstack.ListCiscoVnmcResourcesParams) (r0 *cloudstack.ListCiscoVnmcResourcesResponse, r1 error) {
+	m.record("ListCiscoVnmcResourcesAll", p)
+	if m.ListCiscoVnmcResourcesAllFunc != nil {
+		return m.ListCiscoVnmcResourcesAllFunc(p)
+	}
+	if m.ListCiscoVnmcResourcesAllWithContextFunc != nil {
+		return m.ListCiscoVnmcResourcesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListCiscoVnmcResourcesAll")
+	return
+}
+
+// ListCiscoVnmcResourcesAllWithContext records the call and calls ListCiscoVnmcResourcesAllWithContextFunc, or ListCiscoVnmcResourcesAllFunc if only that is set
+func (m *MockFirewallService) ListCiscoVnmcResourcesAllWithContext(ctx context.Context, p *cloudstack.ListCiscoVnmcResourcesParams) (r0 *cloudstack.ListCiscoVnmcResourcesResponse, r1 error) {
+	m.record("ListCiscoVnmcResourcesAllWithContext", ctx, p)
+	if m.ListCiscoVnmcResourcesAllWithContextFunc != nil {
+		return m.ListCiscoVnmcResourcesAllWithContextFunc(ctx, p)
+	}
+	if m.ListCiscoVnmcResourcesAllFunc != nil {
+		return m.ListCiscoVnmcResourcesAllFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListCiscoVnmcResourcesAllWithContext")
+	return
+}
+
+// NewListEgressFirewallRulesParams records the call and calls NewListEgressFirewallRulesParamsFunc
+func (m *MockFirewallService) NewListEgressFirewallRulesParams() (r0 *cloudstack.ListEgressFirewallRulesParams) {
+	m.record("NewListEgressFirewallRulesParams")
+	if m.NewListEgressFirewallRulesParamsFunc != nil {
+		return m.NewListEgressFirewallRulesParamsFunc()
+	}
+	return cloudstack.NewFirewallService(nil).NewListEgressFirewallRulesParams()
+}
+
+// GetEgressFirewallRuleByID records the call and calls GetEgressFirewallRuleByIDFunc, or GetEgressFirewallRuleByIDWithContextFunc if only that is set
+func (m *MockFirewallService) GetEgressFirewallRuleByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.EgressFirewallRule, r1 int, r2 error) {
+	m.record("GetEgressFirewallRuleByID", id, opts)
+	if m.GetEgressFirewallRuleByIDFunc != nil {
+		return m.GetEgressFirewallRuleByIDFunc(id, opts...)
+	}
+	if m.GetEgressFirewallRuleByIDWithContextFunc != nil {
+		return m.GetEgressFirewallRuleByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("FirewallService.GetEgressFirewallRuleByID")
+	return
+}
+
+// GetEgressFirewallRuleByIDWithContext records the call and calls GetEgressFirewallRuleByIDWithContextFunc, or GetEgressFirewallRuleByIDFunc if only that is set
+func (m *MockFirewallService) GetEgressFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.EgressFirewallRule, r1 int, r2 error) {
+	m.record("GetEgressFirewallRuleByIDWithContext", ctx, id, opts)
+	if m.GetEgressFirewallRuleByIDWithContextFunc != nil {
+		return m.GetEgressFirewallRuleByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetEgressFirewallRuleByIDFunc != nil {
+		return m.GetEgressFirewallRuleByIDFunc(id, opts...)
+	}
+	r2 = notMocked("FirewallService.GetEgressFirewallRuleByIDWithContext")
+	return
+}
+
+// ListEgressFirewallRules records the call and calls ListEgressFirewallRulesFunc, or ListEgressFirewallRulesWithContextFunc if only that is set
+func (m *MockFirewallService) ListEgressFirewallRules(p *cloudstack.ListEgressFirewallRulesParams) (r0 *cloudstack.ListEgressFirewallRulesResponse, r1 error) {
+	m.record("ListEgressFirewallRules", p)
+	if m.ListEgressFirewallRulesFunc != nil {
+		return m.ListEgressFirewallRulesFunc(p)
+	}
+	if m.ListEgressFirewallRulesWithContextFunc != nil {
+		return m.ListEgressFirewallRulesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListEgressFirewallRules")
+	return
+}
+
+// ListEgressFirewallRulesWithContext records the call and calls ListEgressFirewallRulesWithContextFunc, or ListEgressFirewallRulesFunc if only that is set
+func (m *MockFirewallService) ListEgressFirewallRulesWithContext(ctx context.Context, p *cloudstack.ListEgressFirewallRulesParams) (r0 *cloudstack.ListEgressFirewallRulesResponse, r1 error) {
+	m.record("ListEgressFirewallRulesWithContext", ctx, p)
+	if m.ListEgressFirewallRulesWithContextFunc != nil {
+		return m.ListEgressFirewallRulesWithContextFunc(ctx, p)
+	}
+	if m.ListEgressFirewallRulesFunc != nil {
+		return m.ListEgressFirewallRulesFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListEgressFirewallRulesWithContext")
+	return
+}
+
+// NewListEgressFirewallRulesPager records the call and calls NewListEgressFirewallRulesPagerFunc
+func (m *MockFirewallService) NewListEgressFirewallRulesPager(p *cloudstack.ListEgressFirewallRulesParams) (r0 *cloudstack.ListEgressFirewallRulesPager) {
+	m.record("NewListEgressFirewallRulesPager", p)
+	if m.NewListEgressFirewallRulesPagerFunc != nil {
+		return m.NewListEgressFirewallRulesPagerFunc(p)
+	}
+	return
+}
+
+// ListEgressFirewallRulesAll records the call and calls ListEgressFirewallRulesAllFunc, or ListEgressFirewallRulesAllWithContextFunc if only that is set
+func (m *MockFirewallService) ListEgressFirewallRulesAll(p *cloudstack.ListEgressFirewallRulesParams) (r0 *cloudstack.ListEgressFirewallRulesResponse, r1 error) {
+	m.record("ListEgressFirewallRulesAll", p)
+	if m.ListEgressFirewallRulesAllFunc != nil {
+		return m.ListEgressFirewallRulesAllFunc(p)
+	}
+	if m.ListEgressFirewallRulesAllWithContextFunc != nil {
+		return m.ListEgressFirewallRulesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListEgressFirewallRulesAll")
+	return
+}
+
+// ListEgressFirewallRulesAllWithContext records the call and calls ListEgressFirewallRulesAllWithContextFunc, or ListEgressFirewallRulesAllFunc if only that is set
+func (m *MockFirewallService) ListEgressFirewallRulesAllWithContext(ctx context.Context, p *cloudstack.ListEgressFirewallRulesParams) (r0 *cloudstack.ListEgressFirewallRulesResponse, r1 error) {
+	m.record("ListEgressFirewallRulesAllWithContext", ctx, p)
+	if m.ListEgressFirewallRulesAllWithContextFunc != nil {
+		return m.ListEgressFirewallRulesAllWithContextFunc(ctx, p)
+	}
+	if m.ListEgressFirewallRulesAllFunc != nil {
+		return m.ListEgressFirewallRulesAllFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListEgressFirewallRulesAllWithContext")
+	return
+}
+
+// NewListExternalFirewallsParams records the call and calls NewListExternalFirewallsParamsFunc
+func (m *MockFirewallService) NewListExternalFirewallsParams(zoneid string) (r0 *cloudstack.ListExternalFirewallsParams) {
+	m.record("NewListExternalFirewallsParams", zoneid)
+	if m.NewListExternalFirewallsParamsFunc != nil {
+		return m.NewListExternalFirewallsParamsFunc(zoneid)
+	}
+	return cloudstack.NewFirewallService(nil).NewListExternalFirewallsParams(zoneid)
+}
+
+// ListExternalFirewalls records the call and calls ListExternalFirewallsFunc, or ListExternalFirewallsWithContextFunc if only that is set
+func (m *MockFirewallService) ListExternalFirewalls(p *cloudstack.ListExternalFirewallsParams) (r0 *cloudstack.ListExternalFirewallsResponse, r1 error) {
+	m.record("ListExternalFirewalls", p)
+	if m.ListExternalFirewallsFunc != nil {
+		return m.ListExternalFirewallsFunc(p)
+	}
+	if m.ListExternalFirewallsWithContextFunc != nil {
+		return m.ListExternalFirewallsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListExternalFirewalls")
+	return
+}
+
+// ListExternalFirewallsWithContext records the call and calls ListExternalFirewallsWithContextFunc, or ListExternalFirewallsFunc if only that is set
+func (m *MockFirewallService) ListExternalFirewallsWithContext(ctx context.Context, p *cloudstack.ListExternalFirewallsParams) (r0 *cloudstack.ListExternalFirewallsResponse, r1 error) {
+	m.record("ListExternalFirewallsWithContext", ctx, p)
+	if m.ListExternalFirewallsWithContextFunc != nil {
+		return m.ListExternalFirewallsWithContextFunc(ctx, p)
+	}
+	if m.ListExternalFirewallsFunc != nil {
+		return m.ListExternalFirewallsFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListExternalFirewallsWithContext")
+	return
+}
+
+// NewListExternalFirewallsPager records the call and calls NewListExternalFirewallsPagerFunc
+func (m *MockFirewallService) NewListExternalFirewallsPager(p *cloudstack.ListExternalFirewallsParams) (r0 *cloudstack.ListExternalFirewallsPager) {
+	m.record("NewListExternalFirewallsPager", p)
+	if m.NewListExternalFirewallsPagerFunc != nil {
+		return m.NewListExternalFirewallsPagerFunc(p)
+	}
+	return
+}
+
+// ListExternalFirewallsAll records the call and calls ListExternalFirewallsAllFunc, or ListExternalFirewallsAllWithContextFunc if only that is set
+func (m *MockFirewallService) ListExternalFirewallsAll(p *cloudstack.ListExternalFirewallsParams) (r0 *cloudstack.ListExternalFirewallsResponse, r1 error) {
+	m.record("ListExternalFirewallsAll", p)
+	if m.ListExternalFirewallsAllFunc != nil {
+		return m.ListExternalFirewallsAllFunc(p)
+	}
+	if m.ListExternalFirewallsAllWithContextFunc != nil {
+		return m.ListExternalFirewallsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListExternalFirewallsAll")
+	return
+}
+
+// ListExternalFirewallsAllWithContext records the call and calls ListExternalFirewallsAllWithContextFunc, or ListExternalFirewallsAllFunc if only that is set
+func (m *MockFirewallService) ListExternalFirewallsAllWithContext(ctx context.Context, p *cloudstack.ListExternalFirewallsParams) (r0 *cloudstack.ListExternalFirewallsResponse, r1 error) {
+	m.record("ListExternalFirewallsAllWithContext", ctx, p)
+	if m.ListExternalFirewallsAllWithContextFunc != nil {
+		return m.ListExternalFirewallsAllWithContextFunc(ctx, p)
+	}
+	if m.ListExternalFirewallsAllFunc != nil {
+		return m.ListExternalFirewallsAllFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListExternalFirewallsAllWithContext")
+	return
+}
+
+// NewListFirewallRulesParams records the call and calls NewListFirewallRulesParamsFunc
+func (m *MockFirewallService) NewListFirewallRulesParams() (r0 *cloudstack.ListFirewallRulesParams) {
+	m.record("NewListFirewallRulesParams")
+	if m.NewListFirewallRulesParamsFunc != nil {
+		return m.NewListFirewallRulesParamsFunc()
+	}
+	return cloudstack.NewFirewallService(nil).NewListFirewallRulesParams()
+}
+
+// GetFirewallRuleByID records the call and calls GetFirewallRuleByIDFunc, or GetFirewallRuleByIDWithContextFunc if only that is set
+func (m *MockFirewallService) GetFirewallRuleByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.FirewallRule, r1 int, r2 error) {
+	m.record("GetFirewallRuleByID", id, opts)
+	if m.GetFirewallRuleByIDFunc != nil {
+		return m.GetFirewallRuleByIDFunc(id, opts...)
+	}
+	if m.GetFirewallRuleByIDWithContextFunc != nil {
+		return m.GetFirewallRuleByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("FirewallService.GetFirewallRuleByID")
+	return
+}
+
+// GetFirewallRuleByIDWithContext records the call and calls GetFirewallRuleByIDWithContextFunc, or GetFirewallRuleByIDFunc if only that is set
+func (m *MockFirewallService) GetFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.FirewallRule, r1 int, r2 error) {
+	m.record("GetFirewallRuleByIDWithContext", ctx, id, opts)
+	if m.GetFirewallRuleByIDWithContextFunc != nil {
+		return m.GetFirewallRuleByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetFirewallRuleByIDFunc != nil {
+		return m.GetFirewallRuleByIDFunc(id, opts...)
+	}
+	r2 = notMocked("FirewallService.GetFirewallRuleByIDWithContext")
+	return
+}
+
+// ListFirewallRules records the call and calls ListFirewallRulesFunc, or ListFirewallRulesWithContextFunc if only that is set
+func (m *MockFirewallService) ListFirewallRules(p *cloudstack.ListFirewallRulesParams) (r0 *cloudstack.ListFirewallRulesResponse, r1 error) {
+	m.record("ListFirewallRules", p)
+	if m.ListFirewallRulesFunc != nil {
+		return m.ListFirewallRulesFunc(p)
+	}
+	if m.ListFirewallRulesWithContextFunc != nil {
+		return m.ListFirewallRulesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListFirewallRules")
+	return
+}
+
+// ListFirewallRulesWithContext records the call and calls ListFirewallRulesWithContextFunc, or ListFirewallRulesFunc if only that is set
+func (m *MockFirewallService) ListFirewallRulesWithContext(ctx context.Context, p *cloudstack.ListFirewallRulesParams) (r0 *cloudstack.ListFirewallRulesResponse, r1 error) {
+	m.record("ListFirewallRulesWithContext", ctx, p)
+	if m.ListFirewallRulesWithContextFunc != nil {
+		return m.ListFirewallRulesWithContextFunc(ctx, p)
+	}
+	if m.ListFirewallRulesFunc != nil {
+		return m.ListFirewallRulesFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListFirewallRulesWithContext")
+	return
+}
+
+// NewListFirewallRulesPager records the call and calls NewListFirewallRulesPagerFunc
+func (m *MockFirewallService) NewListFirewallRulesPager(p *cloudstack.ListFirewallRulesParams) (r0 *cloudstack.ListFirewallRulesPager) {
+	m.record("NewListFirewallRulesPager", p)
+	if m.NewListFirewallRulesPagerFunc != nil {
+		return m.NewListFirewallRulesPagerFunc(p)
+	}
+	return
+}
+
+// ListFirewallRulesAll records the call and calls ListFirewallRulesAllFunc, or ListFirewallRulesAllWithContextFunc if only that is set
+func (m *MockFirewallService) ListFirewallRulesAll(p *cloudstack.ListFirewallRulesParams) (r0 *cloudstack.ListFirewallRulesResponse, r1 error) {
+	m.record("ListFirewallRulesAll", p)
+	if m.ListFirewallRulesAllFunc != nil {
+		return m.ListFirewallRulesAllFunc(p)
+	}
+	if m.ListFirewallRulesAllWithContextFunc != nil {
+		return m.ListFirewallRulesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListFirewallRulesAll")
+	return
+}
+
+// ListFirewallRulesAllWithContext records the call and calls ListFirewallRulesAllWithContextFunc, or ListFirewallRulesAllFunc if only that is set
+func (m *MockFirewallService) ListFirewallRulesAllWithContext(ctx context.Context, p *cloudstack.ListFirewallRulesParams) (r0 *cloudstack.ListFirewallRulesResponse, r1 error) {
+	m.record("ListFirewallRulesAllWithContext", ctx, p)
+	if m.ListFirewallRulesAllWithContextFunc != nil {
+		return m.ListFirewallRulesAllWithContextFunc(ctx, p)
+	}
+	if m.ListFirewallRulesAllFunc != nil {
+		return m.ListFirewallRulesAllFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListFirewallRulesAllWithContext")
+	return
+}
+
+// NewListPaloAltoFirewallsParams records the call and calls NewListPaloAltoFirewallsParamsFunc
+func (m *MockFirewallService) NewListPaloAltoFirewallsParams() (r0 *cloudstack.ListPaloAltoFirewallsParams) {
+	m.record("NewListPaloAltoFirewallsParams")
+	if m.NewListPaloAltoFirewallsParamsFunc != nil {
+		return m.NewListPaloAltoFirewallsParamsFunc()
+	}
+	return cloudstack.NewFirewallService(nil).NewListPaloAltoFirewallsParams()
+}
+
+// ListPaloAltoFirewalls records the call and calls ListPaloAltoFirewallsFunc, or ListPaloAltoFirewallsWithContextFunc if only that is set
+func (m *MockFirewallService) ListPaloAltoFirewalls(p *cloudstack.ListPaloAltoFirewallsParams) (r0 *cloudstack.ListPaloAltoFirewallsResponse, r1 error) {
+	m.record("ListPaloAltoFirewalls", p)
+	if m.ListPaloAltoFirewallsFunc != nil {
+		return m.ListPaloAltoFirewallsFunc(p)
+	}
+	if m.ListPaloAltoFirewallsWithContextFunc != nil {
+		return m.ListPaloAltoFirewallsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListPaloAltoFirewalls")
+	return
+}
+
+// ListPaloAltoFirewallsWithContext records the call and calls ListPaloAltoFirewallsWithContextFunc, or ListPaloAltoFirewallsFunc if only that is set
+func (m *MockFirewallService) ListPaloAltoFirewallsWithContext(ctx context.Context, p *cloudstack.ListPaloAltoFirewallsParams) (r0 *cloudstack.ListPaloAltoFirewallsResponse, r1 error) {
+	m.record("ListPaloAltoFirewallsWithContext", ctx, p)
+	if m.ListPaloAltoFirewallsWithContextFunc != nil {
+		return m.ListPaloAltoFirewallsWithContextFunc(ctx, p)
+	}
+	if m.ListPaloAltoFirewallsFunc != nil {
+		return m.ListPaloAltoFirewallsFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListPaloAltoFirewallsWithContext")
+	return
+}
+
+// NewListPaloAltoFirewallsPager records the call and calls NewListPaloAltoFirewallsPagerFunc
+func (m *MockFirewallService) NewListPaloAltoFirewallsPager(p *cloudstack.ListPaloAltoFirewallsParams) (r0 *cloudstack.ListPaloAltoFirewallsPager) {
+	m.record("NewListPaloAltoFirewallsPager", p)
+	if m.NewListPaloAltoFirewallsPagerFunc != nil {
+		return m.NewListPaloAltoFirewallsPagerFunc(p)
+	}
+	return
+}
+
+// ListPaloAltoFirewallsAll records the call and calls ListPaloAltoFirewallsAllFunc, or ListPaloAltoFirewallsAllWithContextFunc if only that is set
+func (m *MockFirewallService) ListPaloAltoFirewallsAll(p *cloudstack.ListPaloAltoFirewallsParams) (r0 *cloudstack.ListPaloAltoFirewallsResponse, r1 error) {
+	m.record("ListPaloAltoFirewallsAll", p)
+	if m.ListPaloAltoFirewallsAllFunc != nil {
+		return m.ListPaloAltoFirewallsAllFunc(p)
+	}
+	if m.ListPaloAltoFirewallsAllWithContextFunc != nil {
+		return m.ListPaloAltoFirewallsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListPaloAltoFirewallsAll")
+	return
+}
+
+// ListPaloAltoFirewallsAllWithContext records the call and calls ListPaloAltoFirewallsAllWithContextFunc, or ListPaloAltoFirewallsAllFunc if only that is set
+func (m *MockFirewallService) ListPaloAltoFirewallsAllWithContext(ctx context.Context, p *cloudstack.ListPaloAltoFirewallsParams) (r0 *cloudstack.ListPaloAltoFirewallsResponse, r1 error) {
+	m.record("ListPaloAltoFirewallsAllWithContext", ctx, p)
+	if m.ListPaloAltoFirewallsAllWithContextFunc != nil {
+		return m.ListPaloAltoFirewallsAllWithContextFunc(ctx, p)
+	}
+	if m.ListPaloAltoFirewallsAllFunc != nil {
+		return m.ListPaloAltoFirewallsAllFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListPaloAltoFirewallsAllWithContext")
+	return
+}
+
+// NewListPortForwardingRulesParams records the call and calls NewListPortForwardingRulesParamsFunc
+func (m *MockFirewallService) NewListPortForwardingRulesParams() (r0 *cloudstack.ListPortForwardingRulesParams) {
+	m.record("NewListPortForwardingRulesParams")
+	if m.NewListPortForwardingRulesParamsFunc != nil {
+		return m.NewListPortForwardingRulesParamsFunc()
+	}
+	return cloudstack.NewFirewallService(nil).NewListPortForwardingRulesParams()
+}
+
+// GetPortForwardingRuleByID records the call and calls GetPortForwardingRuleByIDFunc, or GetPortForwardingRuleByIDWithContextFunc if only that is set
+func (m *MockFirewallService) GetPortForwardingRuleByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.PortForwardingRule, r1 int, r2 error) {
+	m.record("GetPortForwardingRuleByID", id, opts)
+	if m.GetPortForwardingRuleByIDFunc != nil {
+		return m.GetPortForwardingRuleByIDFunc(id, opts...)
+	}
+	if m.GetPortForwardingRuleByIDWithContextFunc != nil {
+		return m.GetPortForwardingRuleByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("FirewallService.GetPortForwardingRuleByID")
+	return
+}
+
+// GetPortForwardingRuleByIDWithContext records the call and calls GetPortForwardingRuleByIDWithContextFunc, or GetPortForwardingRuleByIDFunc if only that is set
+func (m *MockFirewallService) GetPortForwardingRuleByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.PortForwardingRule, r1 int, r2 error) {
+	m.record("GetPortForwardingRuleByIDWithContext", ctx, id, opts)
+	if m.GetPortForwardingRuleByIDWithContextFunc != nil {
+		return m.GetPortForwardingRuleByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetPortForwardingRuleByIDFunc != nil {
+		return m.GetPortForwardingRuleByIDFunc(id, opts...)
+	}
+	r2 = notMocked("FirewallService.GetPortForwardingRuleByIDWithContext")
+	return
+}
+
+// ListPortForwardingRules records the call and calls ListPortForwardingRulesFunc, or ListPortForwardingRulesWithContextFunc if only that is set
+func (m *MockFirewallService) ListPortForwardingRules(p *cloudstack.ListPortForwardingRulesParams) (r0 *cloudstack.ListPortForwardingRulesResponse, r1 error) {
+	m.record("ListPortForwardingRules", p)
+	if m.ListPortForwardingRulesFunc != nil {
+		return m.ListPortForwardingRulesFunc(p)
+	}
+	if m.ListPortForwardingRulesWithContextFunc != nil {
+		return m.ListPortForwardingRulesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListPortForwardingRules")
+	return
+}
+
+// ListPortForwardingRulesWithContext records the call and calls ListPortForwardingRulesWithContextFunc, or ListPortForwardingRulesFunc if only that is set
+func (m *MockFirewallService) ListPortForwardingRulesWithContext(ctx context.Context, p *cloudstack.ListPortForwardingRulesParams) (r0 *cloudstack.ListPortForwardingRulesResponse, r1 error) {
+	m.record("ListPortForwardingRulesWithContext", ctx, p)
+	if m.ListPortForwardingRulesWithContextFunc != nil {
+		return m.ListPortForwardingRulesWithContextFunc(ctx, p)
+	}
+	if m.ListPortForwardingRulesFunc != nil {
+		return m.ListPortForwardingRulesFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListPortForwardingRulesWithContext")
+	return
+}
+
+// NewListPortForwardingRulesPager records the call and calls NewListPortForwardingRulesPagerFunc
+func (m *MockFirewallService) NewListPortForwardingRulesPager(p *cloudstack.ListPortForwardingRulesParams) (r0 *cloudstack.ListPortForwardingRulesPager) {
+	m.record("NewListPortForwardingRulesPager", p)
+	if m.NewListPortForwardingRulesPagerFunc != nil {
+		return m.NewListPortForwardingRulesPagerFunc(p)
+	}
+	return
+}
+
+// ListPortForwardingRulesAll records the call and calls ListPortForwardingRulesAllFunc, or ListPortForwardingRulesAllWithContextFunc if only that is set
+func (m *MockFirewallService) ListPortForwardingRulesAll(p *cloudstack.ListPortForwardingRulesParams) (r0 *cloudstack.ListPortForwardingRulesResponse, r1 error) {
+	m.record("ListPortForwardingRulesAll", p)
+	if m.ListPortForwardingRulesAllFunc != nil {
+		return m.ListPortForwardingRulesAllFunc(p)
+	}
+	if m.ListPortForwardingRulesAllWithContextFunc != nil {
+		return m.ListPortForwardingRulesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListPortForwardingRulesAll")
+	return
+}
+
+// ListPortForwardingRulesAllWithContext records the call and calls ListPortForwardingRulesAllWithContextFunc, or ListPortForwardingRulesAllFunc if only that is set
+func (m *MockFirewallService) ListPortForwardingRulesAllWithContext(ctx context.Context, p *cloudstack.ListPortForwardingRulesParams) (r0 *cloudstack.ListPortForwardingRulesResponse, r1 error) {
+	m.record("ListPortForwardingRulesAllWithContext", ctx, p)
+	if m.ListPortForwardingRulesAllWithContextFunc != nil {
+		return m.ListPortForwardingRulesAllWithContextFunc(ctx, p)
+	}
+	if m.ListPortForwardingRulesAllFunc != nil {
+		return m.ListPortForwardingRulesAllFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListPortForwardingRulesAllWithContext")
+	return
+}
+
+// NewListSrxFirewallNetworksParams records the call and calls NewListSrxFirewallNetworksParamsFunc
+func (m *MockFirewallService) NewListSrxFirewallNetworksParams(lbdeviceid string) (r0 *cloudstack.ListSrxFirewallNetworksParams) {
+	m.record("NewListSrxFirewallNetworksParams", lbdeviceid)
+	if m.NewListSrxFirewallNetworksParamsFunc != nil {
+		return m.NewListSrxFirewallNetworksParamsFunc(lbdeviceid)
+	}
+	return cloudstack.NewFirewallService(nil).NewListSrxFirewallNetworksParams(lbdeviceid)
+}
+
+// GetSrxFirewallNetworkID records the call and calls GetSrxFirewallNetworkIDFunc, or GetSrxFirewallNetworkIDWithContextFunc if only that is set
+func (m *MockFirewallService) GetSrxFirewallNetworkID(keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetSrxFirewallNetworkID", keyword, lbdeviceid, opts)
+	if m.GetSrxFirewallNetworkIDFunc != nil {
+		return m.GetSrxFirewallNetworkIDFunc(keyword, lbdeviceid, opts...)
+	}
+	if m.GetSrxFirewallNetworkIDWithContextFunc != nil {
+		return m.GetSrxFirewallNetworkIDWithContextFunc(context.Background(), keyword, lbdeviceid, opts...)
+	}
+	r2 = notMocked("FirewallService.GetSrxFirewallNetworkID")
+	return
+}
+
+// GetSrxFirewallNetworkIDWithContext records the call and calls GetSrxFirewallNetworkIDWithContextFunc, or GetSrxFirewallNetworkIDFunc if only that is set
+func (m *MockFirewallService) GetSrxFirewallNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetSrxFirewallNetworkIDWithContext", ctx, keyword, lbdeviceid, opts)
+	if m.GetSrxFirewallNetworkIDWithContextFunc != nil {
+		return m.GetSrxFirewallNetworkIDWithContextFunc(ctx, keyword, lbdeviceid, opts...)
+	}
+	if m.GetSrxFirewallNetworkIDFunc != nil {
+		return m.GetSrxFirewallNetworkIDFunc(keyword, lbdeviceid, opts...)
+	}
+	r2 = notMocked("FirewallService.GetSrxFirewallNetworkIDWithContext")
+	return
+}
+
+// ListSrxFirewallNetworks records the call and calls ListSrxFirewallNetworksFunc, or ListSrxFirewallNetworksWithContextFunc if only that is set
+func (m *MockFirewallService) ListSrxFirewallNetworks(p *cloudstack.ListSrxFirewallNetworksParams) (r0 *cloudstack.ListSrxFirewallNetworksResponse, r1 error) {
+	m.record("ListSrxFirewallNetworks", p)
+	if m.ListSrxFirewallNetworksFunc != nil {
+		return m.ListSrxFirewallNetworksFunc(p)
+	}
+	if m.ListSrxFirewallNetworksWithContextFunc != nil {
+		return m.ListSrxFirewallNetworksWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListSrxFirewallNetworks")
+	return
+}
+
+// ListSrxFirewallNetworksWithContext records the call and calls ListSrxFirewallNetworksWithContextFunc, or ListSrxFirewallNetworksFunc if only that is set
+func (m *MockFirewallService) ListSrxFirewallNetworksWithContext(ctx context.Context, p *cloudstack.ListSrxFirewallNetworksParams) (r0 *cloudstack.ListSrxFirewallNetworksResponse, r1 error) {
+	m.record("ListSrxFirewallNetworksWithContext", ctx, p)
+	if m.ListSrxFirewallNetworksWithContextFunc != nil {
+		return m.ListSrxFirewallNetworksWithContextFunc(ctx, p)
+	}
+	if m.ListSrxFirewallNetworksFunc != nil {
+		return m.ListSrxFirewallNetworksFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListSrxFirewallNetworksWithContext")
+	return
+}
+
+// NewListSrxFirewallNetworksPager records the call and calls NewListSrxFirewallNetworksPagerFunc
+func (m *MockFirewallService) NewListSrxFirewallNetworksPager(p *cloudstack.ListSrxFirewallNetworksParams) (r0 *cloudstack.ListSrxFirewallNetworksPager) {
+	m.record("NewListSrxFirewallNetworksPager", p)
+	if m.NewListSrxFirewallNetworksPagerFunc != nil {
+		return m.NewListSrxFirewallNetworksPagerFunc(p)
+	}
+	return
+}
+
+// ListSrxFirewallNetworksAll records the call and calls ListSrxFirewallNetworksAllFunc, or ListSrxFirewallNetworksAllWithContextFunc if only that is set
+func (m *MockFirewallService) ListSrxFirewallNetworksAll(p *cloudstack.ListSrxFirewallNetworksParams) (r0 *cloudstack.ListSrxFirewallNetworksResponse, r1 error) {
+	m.record("ListSrxFirewallNetworksAll", p)
+	if m.ListSrxFirewallNetworksAllFunc != nil {
+		return m.ListSrxFirewallNetworksAllFunc(p)
+	}
+	if m.ListSrxFirewallNetworksAllWithContextFunc != nil {
+		return m.ListSrxFirewallNetworksAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListSrxFirewallNetworksAll")
+	return
+}
+
+// ListSrxFirewallNetworksAllWithContext records the call and calls ListSrxFirewallNetworksAllWithContextFunc, or ListSrxFirewallNetworksAllFunc if only that is set
+func (m *MockFirewallService) ListSrxFirewallNetworksAllWithContext(ctx context.Context, p *cloudstack.ListSrxFirewallNetworksParams) (r0 *cloudstack.ListSrxFirewallNetworksResponse, r1 error) {
+	m.record("ListSrxFirewallNetworksAllWithContext", ctx, p)
+	if m.ListSrxFirewallNetworksAllWithContextFunc != nil {
+		return m.ListSrxFirewallNetworksAllWithContextFunc(ctx, p)
+	}
+	if m.ListSrxFirewallNetworksAllFunc != nil {
+		return m.ListSrxFirewallNetworksAllFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListSrxFirewallNetworksAllWithContext")
+	return
+}
+
+// NewListSrxFirewallsParams records the call and calls NewListSrxFirewallsParamsFunc
+func (m *MockFirewallService) NewListSrxFirewallsParams() (r0 *cloudstack.ListSrxFirewallsParams) {
+	m.record("NewListSrxFirewallsParams")
+	if m.NewListSrxFirewallsParamsFunc != nil {
+		return m.NewListSrxFirewallsParamsFunc()
+	}
+	return cloudstack.NewFirewallService(nil).NewListSrxFirewallsParams()
+}
+
+// ListSrxFirewalls records the call and calls ListSrxFirewallsFunc, or ListSrxFirewallsWithContextFunc if only that is set
+func (m *MockFirewallService) ListSrxFirewalls(p *cloudstack.ListSrxFirewallsParams) (r0 *cloudstack.ListSrxFirewallsResponse, r1 error) {
+	m.record("ListSrxFirewalls", p)
+	if m.ListSrxFirewallsFunc != nil {
+		return m.ListSrxFirewallsFunc(p)
+	}
+	if m.ListSrxFirewallsWithContextFunc != nil {
+		return m.ListSrxFirewallsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListSrxFirewalls")
+	return
+}
+
+// ListSrxFirewallsWithContext records the call and calls ListSrxFirewallsWithContextFunc, or ListSrxFirewallsFunc if only that is set
+func (m *MockFirewallService) ListSrxFirewallsWithContext(ctx context.Context, p *cloudstack.ListSrxFirewallsParams) (r0 *cloudstack.ListSrxFirewallsResponse, r1 error) {
+	m.record("ListSrxFirewallsWithContext", ctx, p)
+	if m.ListSrxFirewallsWithContextFunc != nil {
+		return m.ListSrxFirewallsWithContextFunc(ctx, p)
+	}
+	if m.ListSrxFirewallsFunc != nil {
+		return m.ListSrxFirewallsFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListSrxFirewallsWithContext")
+	return
+}
+
+// NewListSrxFirewallsPager records the call and calls NewListSrxFirewallsPagerFunc
+func (m *MockFirewallService) NewListSrxFirewallsPager(p *cloudstack.ListSrxFirewallsParams) (r0 *cloudstack.ListSrxFirewallsPager) {
+	m.record("NewListSrxFirewallsPager", p)
+	if m.NewListSrxFirewallsPagerFunc != nil {
+		return m.NewListSrxFirewallsPagerFunc(p)
+	}
+	return
+}
+
+// ListSrxFirewallsAll records the call and calls ListSrxFirewallsAllFunc, or ListSrxFirewallsAllWithContextFunc if only that is set
+func (m *MockFirewallService) ListSrxFirewallsAll(p *cloudstack.ListSrxFirewallsParams) (r0 *cloudstack.ListSrxFirewallsResponse, r1 error) {
+	m.record("ListSrxFirewallsAll", p)
+	if m.ListSrxFirewallsAllFunc != nil {
+		return m.ListSrxFirewallsAllFunc(p)
+	}
+	if m.ListSrxFirewallsAllWithContextFunc != nil {
+		return m.ListSrxFirewallsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.ListSrxFirewallsAll")
+	return
+}
+
+// ListSrxFirewallsAllWithContext records the call and calls ListSrxFirewallsAllWithContextFunc, or ListSrxFirewallsAllFunc if only that is set
+func (m *MockFirewallService) ListSrxFirewallsAllWithContext(ctx context.Context, p *cloudstack.ListSrxFirewallsParams) (r0 *cloudstack.ListSrxFirewallsResponse, r1 error) {
+	m.record("ListSrxFirewallsAllWithContext", ctx, p)
+	if m.ListSrxFirewallsAllWithContextFunc != nil {
+		return m.ListSrxFirewallsAllWithContextFunc(ctx, p)
+	}
+	if m.ListSrxFirewallsAllFunc != nil {
+		return m.ListSrxFirewallsAllFunc(p)
+	}
+	r1 = notMocked("FirewallService.ListSrxFirewallsAllWithContext")
+	return
+}
+
+// NewUpdateEgressFirewallRuleParams records the call and calls NewUpdateEgressFirewallRuleParamsFunc
+func (m *MockFirewallService) NewUpdateEgressFirewallRuleParams(id string) (r0 *cloudstack.UpdateEgressFirewallRuleParams) {
+	m.record("NewUpdateEgressFirewallRuleParams", id)
+	if m.NewUpdateEgressFirewallRuleParamsFunc != nil {
+		return m.NewUpdateEgressFirewallRuleParamsFunc(id)
+	}
+	return cloudstack.NewFirewallService(nil).NewUpdateEgressFirewallRuleParams(id)
+}
+
+// UpdateEgressFirewallRule records the call and calls UpdateEgressFirewallRuleFunc, or UpdateEgressFirewallRuleWithContextFunc if only that is set
+func (m *MockFirewallService) UpdateEgressFirewallRule(p *cloudstack.UpdateEgressFirewallRuleParams) (r0 *cloudstack.UpdateEgressFirewallRuleResponse, r1 error) {
+	m.record("UpdateEgressFirewallRule", p)
+	if m.UpdateEgressFirewallRuleFunc != nil {
+		return m.UpdateEgressFirewallRuleFunc(p)
+	}
+	if m.UpdateEgressFirewallRuleWithContextFunc != nil {
+		return m.UpdateEgressFirewallRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.UpdateEgressFirewallRule")
+	return
+}
+
+// UpdateEgressFirewallRuleWithContext records the call and calls UpdateEgressFirewallRuleWithContextFunc, or UpdateEgressFirewallRuleFunc if only that is set
+func (m *MockFirewallService) UpdateEgressFirewallRuleWithContext(ctx context.Context, p *cloudstack.UpdateEgressFirewallRuleParams) (r0 *cloudstack.UpdateEgressFirewallRuleResponse, r1 error) {
+	m.record("UpdateEgressFirewallRuleWithContext", ctx, p)
+	if m.UpdateEgressFirewallRuleWithContextFunc != nil {
+		return m.UpdateEgressFirewallRuleWithContextFunc(ctx, p)
+	}
+	if m.UpdateEgressFirewallRuleFunc != nil {
+		return m.UpdateEgressFirewallRuleFunc(p)
+	}
+	r1 = notMocked("FirewallService.UpdateEgressFirewallRuleWithContext")
+	return
+}
+
+// UpdateEgressFirewallRuleAsync records the call and calls UpdateEgressFirewallRuleAsyncFunc, or UpdateEgressFirewallRuleAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) UpdateEgressFirewallRuleAsync(p *cloudstack.UpdateEgressFirewallRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateEgressFirewallRuleAsync", p)
+	if m.UpdateEgressFirewallRuleAsyncFunc != nil {
+		return m.UpdateEgressFirewallRuleAsyncFunc(p)
+	}
+	if m.UpdateEgressFirewallRuleAsyncWithContextFunc != nil {
+		return m.UpdateEgressFirewallRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.UpdateEgressFirewallRuleAsync")
+	return
+}
+
+// UpdateEgressFirewallRuleAsyncWithContext records the call and calls UpdateEgressFirewallRuleAsyncWithContextFunc, or UpdateEgressFirewallRuleAsyncFunc if only that is set
+func (m *MockFirewallService) UpdateEgressFirewallRuleAsyncWithContext(ctx context.Context, p *cloudstack.UpdateEgressFirewallRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateEgressFirewallRuleAsyncWithContext", ctx, p)
+	if m.UpdateEgressFirewallRuleAsyncWithContextFunc != nil {
+		return m.UpdateEgressFirewallRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateEgressFirewallRuleAsyncFunc != nil {
+		return m.UpdateEgressFirewallRuleAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.UpdateEgressFirewallRuleAsyncWithContext")
+	return
+}
+
+// NewUpdateFirewallRuleParams records the call and calls NewUpdateFirewallRuleParamsFunc
+func (m *MockFirewallService) NewUpdateFirewallRuleParams(id string) (r0 *cloudstack.UpdateFirewallRuleParams) {
+	m.record("NewUpdateFirewallRuleParams", id)
+	if m.NewUpdateFirewallRuleParamsFunc != nil {
+		return m.NewUpdateFirewallRuleParamsFunc(id)
+	}
+	return cloudstack.NewFirewallService(nil).NewUpdateFirewallRuleParams(id)
+}
+
+// UpdateFirewallRule records the call and calls UpdateFirewallRuleFunc, or UpdateFirewallRuleWithContextFunc if only that is set
+func (m *MockFirewallService) UpdateFirewallRule(p *cloudstack.UpdateFirewallRuleParams) (r0 *cloudstack.UpdateFirewallRuleResponse, r1 error) {
+	m.record("UpdateFirewallRule", p)
+	if m.UpdateFirewallRuleFunc != nil {
+		return m.UpdateFirewallRuleFunc(p)
+	}
+	if m.UpdateFirewallRuleWithContextFunc != nil {
+		return m.UpdateFirewallRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.UpdateFirewallRule")
+	return
+}
+
+// UpdateFirewallRuleWithContext records the call and calls UpdateFirewallRuleWithContextFunc, or UpdateFirewallRuleFunc if only that is set
+func (m *MockFirewallService) UpdateFirewallRuleWithContext(ctx context.Context, p *cloudstack.UpdateFirewallRuleParams) (r0 *cloudstack.UpdateFirewallRuleResponse, r1 error) {
+	m.record("UpdateFirewallRuleWithContext", ctx, p)
+	if m.UpdateFirewallRuleWithContextFunc != nil {
+		return m.UpdateFirewallRuleWithContextFunc(ctx, p)
+	}
+	if m.UpdateFirewallRuleFunc != nil {
+		return m.UpdateFirewallRuleFunc(p)
+	}
+	r1 = notMocked("FirewallService.UpdateFirewallRuleWithContext")
+	return
+}
+
+// UpdateFirewallRuleAsync records the call and calls UpdateFirewallRuleAsyncFunc, or UpdateFirewallRuleAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) UpdateFirewallRuleAsync(p *cloudstack.UpdateFirewallRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateFirewallRuleAsync", p)
+	if m.UpdateFirewallRuleAsyncFunc != nil {
+		return m.UpdateFirewallRuleAsyncFunc(p)
+	}
+	if m.UpdateFirewallRuleAsyncWithContextFunc != nil {
+		return m.UpdateFirewallRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.UpdateFirewallRuleAsync")
+	return
+}
+
+// UpdateFirewallRuleAsyncWithContext records the call and calls UpdateFirewallRuleAsyncWithContextFunc, or UpdateFirewallRuleAsyncFunc if only that is set
+func (m *MockFirewallService) UpdateFirewallRuleAsyncWithContext(ctx context.Context, p *cloudstack.UpdateFirewallRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateFirewallRuleAsyncWithContext", ctx, p)
+	if m.UpdateFirewallRuleAsyncWithContextFunc != nil {
+		return m.UpdateFirewallRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateFirewallRuleAsyncFunc != nil {
+		return m.UpdateFirewallRuleAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.UpdateFirewallRuleAsyncWithContext")
+	return
+}
+
+// NewUpdatePortForwardingRuleParams records the call and calls NewUpdatePortForwardingRuleParamsFunc
+func (m *MockFirewallService) NewUpdatePortForwardingRuleParams(id string) (r0 *cloudstack.UpdatePortForwardingRuleParams) {
+	m.record("NewUpdatePortForwardingRuleParams", id)
+	if m.NewUpdatePortForwardingRuleParamsFunc != nil {
+		return m.NewUpdatePortForwardingRuleParamsFunc(id)
+	}
+	return cloudstack.NewFirewallService(nil).NewUpdatePortForwardingRuleParams(id)
+}
+
+// UpdatePortForwardingRule records the call and calls UpdatePortForwardingRuleFunc, or UpdatePortForwardingRuleWithContextFunc if only that is set
+func (m *MockFirewallService) UpdatePortForwardingRule(p *cloudstack.UpdatePortForwardingRuleParams) (r0 *cloudstack.UpdatePortForwardingRuleResponse, r1 error) {
+	m.record("UpdatePortForwardingRule", p)
+	if m.UpdatePortForwardingRuleFunc != nil {
+		return m.UpdatePortForwardingRuleFunc(p)
+	}
+	if m.UpdatePortForwardingRuleWithContextFunc != nil {
+		return m.UpdatePortForwardingRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.UpdatePortForwardingRule")
+	return
+}
+
+// UpdatePortForwardingRuleWithContext records the call and calls UpdatePortForwardingRuleWithContextFunc, or UpdatePortForwardingRuleFunc if only that is set
+func (m *MockFirewallService) UpdatePortForwardingRuleWithContext(ctx context.Context, p *cloudstack.UpdatePortForwardingRuleParams) (r0 *cloudstack.UpdatePortForwardingRuleResponse, r1 error) {
+	m.record("UpdatePortForwardingRuleWithContext", ctx, p)
+	if m.UpdatePortForwardingRuleWithContextFunc != nil {
+		return m.UpdatePortForwardingRuleWithContextFunc(ctx, p)
+	}
+	if m.UpdatePortForwardingRuleFunc != nil {
+		return m.UpdatePortForwardingRuleFunc(p)
+	}
+	r1 = notMocked("FirewallService.UpdatePortForwardingRuleWithContext")
+	return
+}
+
+// UpdatePortForwardingRuleAsync records the call and calls UpdatePortForwardingRuleAsyncFunc, or UpdatePortForwardingRuleAsyncWithContextFunc if only that is set
+func (m *MockFirewallService) UpdatePortForwardingRuleAsync(p *cloudstack.UpdatePortForwardingRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdatePortForwardingRuleAsync", p)
+	if m.UpdatePortForwardingRuleAsyncFunc != nil {
+		return m.UpdatePortForwardingRuleAsyncFunc(p)
+	}
+	if m.UpdatePortForwardingRuleAsyncWithContextFunc != nil {
+		return m.UpdatePortForwardingRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("FirewallService.UpdatePortForwardingRuleAsync")
+	return
+}
+
+// UpdatePortForwardingRuleAsyncWithContext records the call and calls UpdatePortForwardingRuleAsyncWithContextFunc, or UpdatePortForwardingRuleAsyncFunc if only that is set
+func (m *MockFirewallService) UpdatePortForwardingRuleAsyncWithContext(ctx context.Context, p *cloudstack.UpdatePortForwardingRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdatePortForwardingRuleAsyncWithContext", ctx, p)
+	if m.UpdatePortForwardingRuleAsyncWithContextFunc != nil {
+		return m.UpdatePortForwardingRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdatePortForwardingRuleAsyncFunc != nil {
+		return m.UpdatePortForwardingRuleAsyncFunc(p)
+	}
+	r1 = notMocked("FirewallService.UpdatePortForwardingRuleAsyncWithContext")
+	return
+}
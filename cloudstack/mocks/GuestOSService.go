@@ -0,0 +1,803 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockGuestOSService is a mock of cloudstack.GuestOSServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockGuestOSService struct {
+	Recorder
+
+	NewAddGuestOsParamsFunc                  func(details map[string]string, oscategoryid string, osdisplayname string) *cloudstack.AddGuestOsParams
+	AddGuestOsFunc                           func(p *cloudstack.AddGuestOsParams) (*cloudstack.AddGuestOsResponse, error)
+	AddGuestOsWithContextFunc                func(ctx context.Context, p *cloudstack.AddGuestOsParams) (*cloudstack.AddGuestOsResponse, error)
+	AddGuestOsAsyncFunc                      func(p *cloudstack.AddGuestOsParams) (*cloudstack.Job, error)
+	AddGuestOsAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.AddGuestOsParams) (*cloudstack.Job, error)
+	NewAddGuestOsMappingParamsFunc           func(hypervisor string, hypervisorversion string, osnameforhypervisor string) *cloudstack.AddGuestOsMappingParams
+	AddGuestOsMappingFunc                    func(p *cloudstack.AddGuestOsMappingParams) (*cloudstack.AddGuestOsMappingResponse, error)
+	AddGuestOsMappingWithContextFunc         func(ctx context.Context, p *cloudstack.AddGuestOsMappingParams) (*cloudstack.AddGuestOsMappingResponse, error)
+	AddGuestOsMappingAsyncFunc               func(p *cloudstack.AddGuestOsMappingParams) (*cloudstack.Job, error)
+	AddGuestOsMappingAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.AddGuestOsMappingParams) (*cloudstack.Job, error)
+	NewListGuestOsMappingParamsFunc          func() *cloudstack.ListGuestOsMappingParams
+	GetGuestOsMappingByIDFunc                func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.GuestOsMapping, int, error)
+	GetGuestOsMappingByIDWithContextFunc     func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.GuestOsMapping, int, error)
+	ListGuestOsMappingFunc                   func(p *cloudstack.ListGuestOsMappingParams) (*cloudstack.ListGuestOsMappingResponse, error)
+	ListGuestOsMappingWithContextFunc        func(ctx context.Context, p *cloudstack.ListGuestOsMappingParams) (*cloudstack.ListGuestOsMappingResponse, error)
+	NewListGuestOsMappingPagerFunc           func(p *cloudstack.ListGuestOsMappingParams) *cloudstack.ListGuestOsMappingPager
+	ListGuestOsMappingAllFunc                func(p *cloudstack.ListGuestOsMappingParams) (*cloudstack.ListGuestOsMappingResponse, error)
+	ListGuestOsMappingAllWithContextFunc     func(ctx context.Context, p *cloudstack.ListGuestOsMappingParams) (*cloudstack.ListGuestOsMappingResponse, error)
+	NewListOsCategoriesParamsFunc            func() *cloudstack.ListOsCategoriesParams
+	GetOsCategoryIDFunc                      func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetOsCategoryIDWithContextFunc           func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetOsCategoryByNameFunc                  func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.OsCategory, int, error)
+	GetOsCategoryByNameWithContextFunc       func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.OsCategory, int, error)
+	GetOsCategoryByIDFunc                    func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.OsCategory, int, error)
+	GetOsCategoryByIDWithContextFunc         func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.OsCategory, int, error)
+	ListOsCategoriesFunc                     func(p *cloudstack.ListOsCategoriesParams) (*cloudstack.ListOsCategoriesResponse, error)
+	ListOsCategoriesWithContextFunc          func(ctx context.Context, p *cloudstack.ListOsCategoriesParams) (*cloudstack.ListOsCategoriesResponse, error)
+	NewListOsCategoriesPagerFunc             func(p *cloudstack.ListOsCategoriesParams) *cloudstack.ListOsCategoriesPager
+	ListOsCategoriesAllFunc                  func(p *cloudstack.ListOsCategoriesParams) (*cloudstack.ListOsCategoriesResponse, error)
+	ListOsCategoriesAllWithContextFunc       func(ctx context.Context, p *cloudstack.ListOsCategoriesParams) (*cloudstack.ListOsCategoriesResponse, error)
+	NewListOsTypesParamsFunc                 func() *cloudstack.ListOsTypesParams
+	GetOsTypeByIDFunc                        func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.OsType, int, error)
+	GetOsTypeByIDWithContextFunc             func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.OsType, int, error)
+	ListOsTypesFunc                          func(p *cloudstack.ListOsTypesParams) (*cloudstack.ListOsTypesResponse, error)
+	ListOsTypesWithContextFunc               func(ctx context.Context, p *cloudstack.ListOsTypesParams) (*cloudstack.ListOsTypesResponse, error)
+	NewListOsTypesPagerFunc                  func(p *cloudstack.ListOsTypesParams) *cloudstack.ListOsTypesPager
+	ListOsTypesAllFunc                       func(p *cloudstack.ListOsTypesParams) (*cloudstack.ListOsTypesResponse, error)
+	ListOsTypesAllWithContextFunc            func(ctx context.Context, p *cloudstack.ListOsTypesParams) (*cloudstack.ListOsTypesResponse, error)
+	NewRemoveGuestOsParamsFunc               func(id string) *cloudstack.RemoveGuestOsParams
+	RemoveGuestOsFunc                        func(p *cloudstack.RemoveGuestOsParams) (*cloudstack.RemoveGuestOsResponse, error)
+	RemoveGuestOsWithContextFunc             func(ctx context.Context, p *cloudstack.RemoveGuestOsParams) (*cloudstack.RemoveGuestOsResponse, error)
+	RemoveGuestOsAsyncFunc                   func(p *cloudstack.RemoveGuestOsParams) (*cloudstack.Job, error)
+	RemoveGuestOsAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.RemoveGuestOsParams) (*cloudstack.Job, error)
+	NewRemoveGuestOsMappingParamsFunc        func(id string) *cloudstack.RemoveGuestOsMappingParams
+	RemoveGuestOsMappingFunc                 func(p *cloudstack.RemoveGuestOsMappingParams) (*cloudstack.RemoveGuestOsMappingResponse, error)
+	RemoveGuestOsMappingWithContextFunc      func(ctx context.Context, p *cloudstack.RemoveGuestOsMappingParams) (*cloudstack.RemoveGuestOsMappingResponse, error)
+	RemoveGuestOsMappingAsyncFunc            func(p *cloudstack.RemoveGuestOsMappingParams) (*cloudstack.Job, error)
+	RemoveGuestOsMappingAsyncWithContextFunc func(ctx context.Context, p *cloudstack.RemoveGuestOsMappingParams) (*cloudstack.Job, error)
+	NewUpdateGuestOsParamsFunc               func(details map[string]string, id string, osdisplayname string) *cloudstack.UpdateGuestOsParams
+	UpdateGuestOsFunc                        func(p *cloudstack.UpdateGuestOsParams) (*cloudstack.UpdateGuestOsResponse, error)
+	UpdateGuestOsWithContextFunc             func(ctx context.Context, p *cloudstack.UpdateGuestOsParams) (*cloudstack.UpdateGuestOsResponse, error)
+	UpdateGuestOsAsyncFunc                   func(p *cloudstack.UpdateGuestOsParams) (*cloudstack.Job, error)
+	UpdateGuestOsAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.UpdateGuestOsParams) (*cloudstack.Job, error)
+	NewUpdateGuestOsMappingParamsFunc        func(id string, osnameforhypervisor string) *cloudstack.UpdateGuestOsMappingParams
+	UpdateGuestOsMappingFunc                 func(p *cloudstack.UpdateGuestOsMappingParams) (*cloudstack.UpdateGuestOsMappingResponse, error)
+	UpdateGuestOsMappingWithContextFunc      func(ctx context.Context, p *cloudstack.UpdateGuestOsMappingParams) (*cloudstack.UpdateGuestOsMappingResponse, error)
+	UpdateGuestOsMappingAsyncFunc            func(p *cloudstack.UpdateGuestOsMappingParams) (*cloudstack.Job, error)
+	UpdateGuestOsMappingAsyncWithContextFunc func(ctx context.Context, p *cloudstack.UpdateGuestOsMappingParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.GuestOSServiceIface = (*MockGuestOSService)(nil)
+
+// NewAddGuestOsParams records the call and calls NewAddGuestOsParamsFunc
+func (m *MockGuestOSService) NewAddGuestOsParams(details map[string]string, oscategoryid string, osdisplayname string) (r0 *cloudstack.AddGuestOsParams) {
+	m.record("NewAddGuestOsParams", details, oscategoryid, osdisplayname)
+	if m.NewAddGuestOsParamsFunc != nil {
+		return m.NewAddGuestOsParamsFunc(details, oscategoryid, osdisplayname)
+	}
+	return cloudstack.NewGuestOSService(nil).NewAddGuestOsParams(details, oscategoryid, osdisplayname)
+}
+
+// AddGuestOs records the call and calls AddGuestOsFunc, or AddGuestOsWithContextFunc if only that is set
+func (m *MockGuestOSService) AddGuestOs(p *cloudstack.AddGuestOsParams) (r0 *cloudstack.AddGuestOsResponse, r1 error) {
+	m.record("AddGuestOs", p)
+	if m.AddGuestOsFunc != nil {
+		return m.AddGuestOsFunc(p)
+	}
+	if m.AddGuestOsWithContextFunc != nil {
+		return m.AddGuestOsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.AddGuestOs")
+	return
+}
+
+// AddGuestOsWithContext records the call and calls AddGuestOsWithContextFunc, or AddGuestOsFunc if only that is set
+func (m *MockGuestOSService) AddGuestOsWithContext(ctx context.Context, p *cloudstack.AddGuestOsParams) (r0 *cloudstack.AddGuestOsResponse, r1 error) {
+	m.record("AddGuestOsWithContext", ctx, p)
+	if m.AddGuestOsWithContextFunc != nil {
+		return m.AddGuestOsWithContextFunc(ctx, p)
+	}
+	if m.AddGuestOsFunc != nil {
+		return m.AddGuestOsFunc(p)
+	}
+	r1 = notMocked("GuestOSService.AddGuestOsWithContext")
+	return
+}
+
+// AddGuestOsAsync records the call and calls AddGuestOsAsyncFunc, or AddGuestOsAsyncWithContextFunc if only that is set
+func (m *MockGuestOSService) AddGuestOsAsync(p *cloudstack.AddGuestOsParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddGuestOsAsync", p)
+	if m.AddGuestOsAsyncFunc != nil {
+		return m.AddGuestOsAsyncFunc(p)
+	}
+	if m.AddGuestOsAsyncWithContextFunc != nil {
+		return m.AddGuestOsAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.AddGuestOsAsync")
+	return
+}
+
+// AddGuestOsAsyncWithContext records the call and calls AddGuestOsAsyncWithContextFunc, or AddGuestOsAsyncFunc if only that is set
+func (m *MockGuestOSService) AddGuestOsAsyncWithContext(ctx context.Context, p *cloudstack.AddGuestOsParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddGuestOsAsyncWithContext", ctx, p)
+	if m.AddGuestOsAsyncWithContextFunc != nil {
+		return m.AddGuestOsAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddGuestOsAsyncFunc != nil {
+		return m.AddGuestOsAsyncFunc(p)
+	}
+	r1 = notMocked("GuestOSService.AddGuestOsAsyncWithContext")
+	return
+}
+
+// NewAddGuestOsMappingParams records the call and calls NewAddGuestOsMappingParamsFunc
+func (m *MockGuestOSService) NewAddGuestOsMappingParams(hypervisor string, hypervisorversion string, osnameforhypervisor string) (r0 *cloudstack.AddGuestOsMappingParams) {
+	m.record("NewAddGuestOsMappingParams", hypervisor, hypervisorversion, osnameforhypervisor)
+	if m.NewAddGuestOsMappingParamsFunc != nil {
+		return m.NewAddGuestOsMappingParamsFunc(hypervisor, hypervisorversion, osnameforhypervisor)
+	}
+	return cloudstack.NewGuestOSService(nil).NewAddGuestOsMappingParams(hypervisor, hypervisorversion, osnameforhypervisor)
+}
+
+// AddGuestOsMapping records the call and calls AddGuestOsMappingFunc, or AddGuestOsMappingWithContextFunc if only that is set
+func (m *MockGuestOSService) AddGuestOsMapping(p *cloudstack.AddGuestOsMappingParams) (r0 *cloudstack.AddGuestOsMappingResponse, r1 error) {
+	m.record("AddGuestOsMapping", p)
+	if m.AddGuestOsMappingFunc != nil {
+		return m.AddGuestOsMappingFunc(p)
+	}
+	if m.AddGuestOsMappingWithContextFunc != nil {
+		return m.AddGuestOsMappingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.AddGuestOsMapping")
+	return
+}
+
+// AddGuestOsMappingWithContext records the call and calls AddGuestOsMappingWithContextFunc, or AddGuestOsMappingFunc if only that is set
+func (m *MockGuestOSService) AddGuestOsMappingWithContext(ctx context.Context, p *cloudstack.AddGuestOsMappingParams) (r0 *cloudstack.AddGuestOsMappingResponse, r1 error) {
+	m.record("AddGuestOsMappingWithContext", ctx, p)
+	if m.AddGuestOsMappingWithContextFunc != nil {
+		return m.AddGuestOsMappingWithContextFunc(ctx, p)
+	}
+	if m.AddGuestOsMappingFunc != nil {
+		return m.AddGuestOsMappingFunc(p)
+	}
+	r1 = notMocked("GuestOSService.AddGuestOsMappingWithContext")
+	return
+}
+
+// AddGuestOsMappingAsync records the call and calls AddGuestOsMappingAsyncFunc, or AddGuestOsMappingAsyncWithContextFunc if only that is set
+func (m *MockGuestOSService) AddGuestOsMappingAsync(p *cloudstack.AddGuestOsMappingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddGuestOsMappingAsync", p)
+	if m.AddGuestOsMappingAsyncFunc != nil {
+		return m.AddGuestOsMappingAsyncFunc(p)
+	}
+	if m.AddGuestOsMappingAsyncWithContextFunc != nil {
+		return m.AddGuestOsMappingAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.AddGuestOsMappingAsync")
+	return
+}
+
+// AddGuestOsMappingAsyncWithContext records the call and calls AddGuestOsMappingAsyncWithContextFunc, or AddGuestOsMappingAsyncFunc if only that is set
+func (m *MockGuestOSService) AddGuestOsMappingAsyncWithContext(ctx context.Context, p *cloudstack.AddGuestOsMappingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddGuestOsMappingAsyncWithContext", ctx, p)
+	if m.AddGuestOsMappingAsyncWithContextFunc != nil {
+		return m.AddGuestOsMappingAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddGuestOsMappingAsyncFunc != nil {
+		return m.AddGuestOsMappingAsyncFunc(p)
+	}
+	r1 = notMocked("GuestOSService.AddGuestOsMappingAsyncWithContext")
+	return
+}
+
+// NewListGuestOsMappingParams records the call and calls NewListGuestOsMappingParamsFunc
+func (m *MockGuestOSService) NewListGuestOsMappingParams() (r0 *cloudstack.ListGuestOsMappingParams) {
+	m.record("NewListGuestOsMappingParams")
+	if m.NewListGuestOsMappingParamsFunc != nil {
+		return m.NewListGuestOsMappingParamsFunc()
+	}
+	return cloudstack.NewGuestOSService(nil).NewListGuestOsMappingParams()
+}
+
+// GetGuestOsMappingByID records the call and calls GetGuestOsMappingByIDFunc, or GetGuestOsMappingByIDWithContextFunc if only that is set
+func (m *MockGuestOSService) GetGuestOsMappingByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.GuestOsMapping, r1 int, r2 error) {
+	m.record("GetGuestOsMappingByID", id, opts)
+	if m.GetGuestOsMappingByIDFunc != nil {
+		return m.GetGuestOsMappingByIDFunc(id, opts...)
+	}
+	if m.GetGuestOsMappingByIDWithContextFunc != nil {
+		return m.GetGuestOsMappingByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("GuestOSService.GetGuestOsMappingByID")
+	return
+}
+
+// GetGuestOsMappingByIDWithContext records the call and calls GetGuestOsMappingByIDWithContextFunc, or GetGuestOsMappingByIDFunc if only that is set
+func (m *MockGuestOSService) GetGuestOsMappingByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.GuestOsMapping, r1 int, r2 error) {
+	m.record("GetGuestOsMappingByIDWithContext", ctx, id, opts)
+	if m.GetGuestOsMappingByIDWithContextFunc != nil {
+		return m.GetGuestOsMappingByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetGuestOsMappingByIDFunc != nil {
+		return m.GetGuestOsMappingByIDFunc(id, opts...)
+	}
+	r2 = notMocked("GuestOSService.GetGuestOsMappingByIDWithContext")
+	return
+}
+
+// ListGuestOsMapping records the call and calls ListGuestOsMappingFunc, or ListGuestOsMappingWithContextFunc if only that is set
+func (m *MockGuestOSService) ListGuestOsMapping(p *cloudstack.ListGuestOsMappingParams) (r0 *cloudstack.ListGuestOsMappingResponse, r1 error) {
+	m.record("ListGuestOsMapping", p)
+	if m.ListGuestOsMappingFunc != nil {
+		return m.ListGuestOsMappingFunc(p)
+	}
+	if m.ListGuestOsMappingWithContextFunc != nil {
+		return m.ListGuestOsMappingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.ListGuestOsMapping")
+	return
+}
+
+// ListGuestOsMappingWithContext records the call and calls ListGuestOsMappingWithContextFunc, or ListGuestOsMappingFunc if only that is set
+func (m *MockGuestOSService) ListGuestOsMappingWithContext(ctx context.Context, p *cloudstack.ListGuestOsMappingParams) (r0 *cloudstack.ListGuestOsMappingResponse, r1 error) {
+	m.record("ListGuestOsMappingWithContext", ctx, p)
+	if m.ListGuestOsMappingWithContextFunc != nil {
+		return m.ListGuestOsMappingWithContextFunc(ctx, p)
+	}
+	if m.ListGuestOsMappingFunc != nil {
+		return m.ListGuestOsMappingFunc(p)
+	}
+	r1 = notMocked("GuestOSService.ListGuestOsMappingWithContext")
+	return
+}
+
+// NewListGuestOsMappingPager records the call and calls NewListGuestOsMappingPagerFunc
+func (m *MockGuestOSService) NewListGuestOsMappingPager(p *cloudstack.ListGuestOsMappingParams) (r0 *cloudstack.ListGuestOsMappingPager) {
+	m.record("NewListGuestOsMappingPager", p)
+	if m.NewListGuestOsMappingPagerFunc != nil {
+		return m.NewListGuestOsMappingPagerFunc(p)
+	}
+	return
+}
+
+// ListGuestOsMappingAll records the call and calls ListGuestOsMappingAllFunc, or ListGuestOsMappingAllWithContextFunc if only that is set
+func (m *MockGuestOSService) ListGuestOsMappingAll(p *cloudstack.ListGuestOsMappingParams) (r0 *cloudstack.ListGuestOsMappingResponse, r1 error) {
+	m.record("ListGuestOsMappingAll", p)
+	if m.ListGuestOsMappingAllFunc != nil {
+		return m.ListGuestOsMappingAllFunc(p)
+	}
+	if m.ListGuestOsMappingAllWithContextFunc != nil {
+		return m.ListGuestOsMappingAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.ListGuestOsMappingAll")
+	return
+}
+
+// ListGuestOsMappingAllWithContext records the call and calls ListGuestOsMappingAllWithContextFunc, or ListGuestOsMappingAllFunc if only that is set
+func (m *MockGuestOSService) ListGuestOsMappingAllWithContext(ctx context.Context, p *cloudstack.ListGuestOsMappingParams) (r0 *cloudstack.ListGuestOsMappingResponse, r1 error) {
+	m.record("ListGuestOsMappingAllWithContext", ctx, p)
+	if m.ListGuestOsMappingAllWithContextFunc != nil {
+		return m.ListGuestOsMappingAllWithContextFunc(ctx, p)
+	}
+	if m.ListGuestOsMappingAllFunc != nil {
+		return m.ListGuestOsMappingAllFunc(p)
+	}
+	r1 = notMocked("GuestOSService.ListGuestOsMappingAllWithContext")
+	return
+}
+
+// NewListOsCategoriesParams records the call and calls NewListOsCategoriesParamsFunc
+func (m *MockGuestOSService) NewListOsCategoriesParams() (r0 *cloudstack.ListOsCategoriesParams) {
+	m.record("NewListOsCategoriesParams")
+	if m.NewListOsCategoriesParamsFunc != nil {
+		return m.NewListOsCategoriesParamsFunc()
+	}
+	return cloudstack.NewGuestOSService(nil).NewListOsCategoriesParams()
+}
+
+// GetOsCategoryID records the call and calls GetOsCategoryIDFunc, or GetOsCategoryIDWithContextFunc if only that is set
+func (m *MockGuestOSService) GetOsCategoryID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetOsCategoryID", name, opts)
+	if m.GetOsCategoryIDFunc != nil {
+		return m.GetOsCategoryIDFunc(name, opts...)
+	}
+	if m.GetOsCategoryIDWithContextFunc != nil {
+		return m.GetOsCategoryIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("GuestOSService.GetOsCategoryID")
+	return
+}
+
+// GetOsCategoryIDWithContext records the call and calls GetOsCategoryIDWithContextFunc, or GetOsCategoryIDFunc if only that is set
+func (m *MockGuestOSService) GetOsCategoryIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetOsCategoryIDWithContext", ctx, name, opts)
+	if m.GetOsCategoryIDWithContextFunc != nil {
+		return m.GetOsCategoryIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetOsCategoryIDFunc != nil {
+		return m.GetOsCategoryIDFunc(name, opts...)
+	}
+	r2 = notMocked("GuestOSService.GetOsCategoryIDWithContext")
+	return
+}
+
+// GetOsCategoryByName records the call and calls GetOsCategoryByNameFunc, or GetOsCategoryByNameWithContextFunc if only that is set
+func (m *MockGuestOSService) GetOsCategoryByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.OsCategory, r1 int, r2 error) {
+	m.record("GetOsCategoryByName", name, opts)
+	if m.GetOsCategoryByNameFunc != nil {
+		return m.GetOsCategoryByNameFunc(name, opts...)
+	}
+	if m.GetOsCategoryByNameWithContextFunc != nil {
+		return m.GetOsCategoryByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("GuestOSService.GetOsCategoryByName")
+	return
+}
+
+// GetOsCategoryByNameWithContext records the call and calls GetOsCategoryByNameWithContextFunc, or GetOsCategoryByNameFunc if only that is set
+func (m *MockGuestOSService) GetOsCategoryByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.OsCategory, r1 int, r2 error) {
+	m.record("GetOsCategoryByNameWithContext", ctx, name, opts)
+	if m.GetOsCategoryByNameWithContextFunc != nil {
+		return m.GetOsCategoryByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetOsCategoryByNameFunc != nil {
+		return m.GetOsCategoryByNameFunc(name, opts...)
+	}
+	r2 = notMocked("GuestOSService.GetOsCategoryByNameWithContext")
+	return
+}
+
+// GetOsCategoryByID records the call and calls GetOsCategoryByIDFunc, or GetOsCategoryByIDWithContextFunc if only that is set
+func (m *MockGuestOSService) GetOsCategoryByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.OsCategory, r1 int, r2 error) {
+	m.record("GetOsCategoryByID", id, opts)
+	if m.GetOsCategoryByIDFunc != nil {
+		return m.GetOsCategoryByIDFunc(id, opts...)
+	}
+	if m.GetOsCategoryByIDWithContextFunc != nil {
+		return m.GetOsCategoryByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("GuestOSService.GetOsCategoryByID")
+	return
+}
+
+// GetOsCategoryByIDWithContext records the call and calls GetOsCategoryByIDWithContextFunc, or GetOsCategoryByIDFunc if only that is set
+func (m *MockGuestOSService) GetOsCategoryByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.OsCategory, r1 int, r2 error) {
+	m.record("GetOsCategoryByIDWithContext", ctx, id, opts)
+	if m.GetOsCategoryByIDWithContextFunc != nil {
+		return m.GetOsCategoryByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetOsCategoryByIDFunc != nil {
+		return m.GetOsCategoryByIDFunc(id, opts...)
+	}
+	r2 = notMocked("GuestOSService.GetOsCategoryByIDWithContext")
+	return
+}
+
+// ListOsCategories records the call and calls ListOsCategoriesFunc, or ListOsCategoriesWithContextFunc if only that is set
+func (m *MockGuestOSService) ListOsCategories(p *cloudstack.ListOsCategoriesParams) (r0 *cloudstack.ListOsCategoriesResponse, r1 error) {
+	m.record("ListOsCategories", p)
+	if m.ListOsCategoriesFunc != nil {
+		return m.ListOsCategoriesFunc(p)
+	}
+	if m.ListOsCategoriesWithContextFunc != nil {
+		return m.ListOsCategoriesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.ListOsCategories")
+	return
+}
+
+// ListOsCategoriesWithContext records the call and calls ListOsCategoriesWithContextFunc, or ListOsCategoriesFunc if only that is set
+func (m *MockGuestOSService) ListOsCategoriesWithContext(ctx context.Context, p *cloudstack.ListOsCategoriesParams) (r0 *cloudstack.ListOsCategoriesResponse, r1 error) {
+	m.record("ListOsCategoriesWithContext", ctx, p)
+	if m.ListOsCategoriesWithContextFunc != nil {
+		return m.ListOsCategoriesWithContextFunc(ctx, p)
+	}
+	if m.ListOsCategoriesFunc != nil {
+		return m.ListOsCategoriesFunc(p)
+	}
+	r1 = notMocked("GuestOSService.ListOsCategoriesWithContext")
+	return
+}
+
+// NewListOsCategoriesPager records the call and calls NewListOsCategoriesPagerFunc
+func (m *MockGuestOSService) NewListOsCategoriesPager(p *cloudstack.ListOsCategoriesParams) (r0 *cloudstack.ListOsCategoriesPager) {
+	m.record("NewListOsCategoriesPager", p)
+	if m.NewListOsCategoriesPagerFunc != nil {
+		return m.NewListOsCategoriesPagerFunc(p)
+	}
+	return
+}
+
+// ListOsCategoriesAll records the call and calls ListOsCategoriesAllFunc, or ListOsCategoriesAllWithContextFunc if only that is set
+func (m *MockGuestOSService) ListOsCategoriesAll(p *cloudstack.ListOsCategoriesParams) (r0 *cloudstack.ListOsCategoriesResponse, r1 error) {
+	m.record("ListOsCategoriesAll", p)
+	if m.ListOsCategoriesAllFunc != nil {
+		return m.ListOsCategoriesAllFunc(p)
+	}
+	if m.ListOsCategoriesAllWithContextFunc != nil {
+		return m.ListOsCategoriesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.ListOsCategoriesAll")
+	return
+}
+
+// ListOsCategoriesAllWithContext records the call and calls ListOsCategoriesAllWithContextFunc, or ListOsCategoriesAllFunc if only that is set
+func (m *MockGuestOSService) ListOsCategoriesAllWithContext(ctx context.Context, p *cloudstack.ListOsCategoriesParams) (r0 *cloudstack.ListOsCategoriesResponse, r1 error) {
+	m.record("ListOsCategoriesAllWithContext", ctx, p)
+	if m.ListOsCategoriesAllWithContextFunc != nil {
+		return m.ListOsCategoriesAllWithContextFunc(ctx, p)
+	}
+	if m.ListOsCategoriesAllFunc != nil {
+		return m.ListOsCategoriesAllFunc(p)
+	}
+	r1 = notMocked("GuestOSService.ListOsCategoriesAllWithContext")
+	return
+}
+
+// NewListOsTypesParams records the call and calls NewListOsTypesParamsFunc
+func (m *MockGuestOSService) NewListOsTypesParams() (r0 *cloudstack.ListOsTypesParams) {
+	m.record("NewListOsTypesParams")
+	if m.NewListOsTypesParamsFunc != nil {
+		return m.NewListOsTypesParamsFunc()
+	}
+	return cloudstack.NewGuestOSService(nil).NewListOsTypesParams()
+}
+
+// GetOsTypeByID records the call and calls GetOsTypeByIDFunc, or GetOsTypeByIDWithContextFunc if only that is set
+func (m *MockGuestOSService) GetOsTypeByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.OsType, r1 int, r2 error) {
+	m.record("GetOsTypeByID", id, opts)
+	if m.GetOsTypeByIDFunc != nil {
+		return m.GetOsTypeByIDFunc(id, opts...)
+	}
+	if m.GetOsTypeByIDWithContextFunc != nil {
+		return m.GetOsTypeByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("GuestOSService.GetOsTypeByID")
+	return
+}
+
+// GetOsTypeByIDWithContext records the call and calls GetOsTypeByIDWithContextFunc, or GetOsTypeByIDFunc if only that is set
+func (m *MockGuestOSService) GetOsTypeByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.OsType, r1 int, r2 error) {
+	m.record("GetOsTypeByIDWithContext", ctx, id, opts)
+	if m.GetOsTypeByIDWithContextFunc != nil {
+		return m.GetOsTypeByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetOsTypeByIDFunc != nil {
+		return m.GetOsTypeByIDFunc(id, opts...)
+	}
+	r2 = notMocked("GuestOSService.GetOsTypeByIDWithContext")
+	return
+}
+
+// ListOsTypes records the call and calls ListOsTypesFunc, or ListOsTypesWithContextFunc if only that is set
+func (m *MockGuestOSService) ListOsTypes(p *cloudstack.ListOsTypesParams) (r0 *cloudstack.ListOsTypesResponse, r1 error) {
+	m.record("ListOsTypes", p)
+	if m.ListOsTypesFunc != nil {
+		return m.ListOsTypesFunc(p)
+	}
+	if m.ListOsTypesWithContextFunc != nil {
+		return m.ListOsTypesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.ListOsTypes")
+	return
+}
+
+// ListOsTypesWithContext records the call and calls ListOsTypesWithContextFunc, or ListOsTypesFunc if only that is set
+func (m *MockGuestOSService) ListOsTypesWithContext(ctx context.Context, p *cloudstack.ListOsTypesParams) (r0 *cloudstack.ListOsTypesResponse, r1 error) {
+	m.record("ListOsTypesWithContext", ctx, p)
+	if m.ListOsTypesWithContextFunc != nil {
+		return m.ListOsTypesWithContextFunc(ctx, p)
+	}
+	if m.ListOsTypesFunc != nil {
+		return m.ListOsTypesFunc(p)
+	}
+	r1 = notMocked("GuestOSService.ListOsTypesWithContext")
+	return
+}
+
+// NewListOsTypesPager records the call and calls NewListOsTypesPagerFunc
+func (m *MockGuestOSService) NewListOsTypesPager(p *cloudstack.ListOsTypesParams) (r0 *cloudstack.ListOsTypesPager) {
+	m.record("NewListOsTypesPager", p)
+	if m.NewListOsTypesPagerFunc != nil {
+		return m.NewListOsTypesPagerFunc(p)
+	}
+	return
+}
+
+// ListOsTypesAll records the call and calls ListOsTypesAllFunc, or ListOsTypesAllWithContextFunc if only that is set
+func (m *MockGuestOSService) ListOsTypesAll(p *cloudstack.ListOsTypesParams) (r0 *cloudstack.ListOsTypesResponse, r1 error) {
+	m.record("ListOsTypesAll", p)
+	if m.ListOsTypesAllFunc != nil {
+		return m.ListOsTypesAllFunc(p)
+	}
+	if m.ListOsTypesAllWithContextFunc != nil {
+		return m.ListOsTypesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.ListOsTypesAll")
+	return
+}
+
+// ListOsTypesAllWithContext records the call and calls ListOsTypesAllWithContextFunc, or ListOsTypesAllFunc if only that is set
+func (m *MockGuestOSService) ListOsTypesAllWithContext(ctx context.Context, p *cloudstack.ListOsTypesParams) (r0 *cloudstack.ListOsTypesResponse, r1 error) {
+	m.record("ListOsTypesAllWithContext", ctx, p)
+	if m.ListOsTypesAllWithContextFunc != nil {
+		return m.ListOsTypesAllWithContextFunc(ctx, p)
+	}
+	if m.ListOsTypesAllFunc != nil {
+		return m.ListOsTypesAllFunc(p)
+	}
+	r1 = notMocked("GuestOSService.ListOsTypesAllWithContext")
+	return
+}
+
+// NewRemoveGuestOsParams records the call and calls NewRemoveGuestOsParamsFunc
+func (m *MockGuestOSService) NewRemoveGuestOsParams(id string) (r0 *cloudstack.RemoveGuestOsParams) {
+	m.record("NewRemoveGuestOsParams", id)
+	if m.NewRemoveGuestOsParamsFunc != nil {
+		return m.NewRemoveGuestOsParamsFunc(id)
+	}
+	return cloudstack.NewGuestOSService(nil).NewRemoveGuestOsParams(id)
+}
+
+// RemoveGuestOs records the call and calls RemoveGuestOsFunc, or RemoveGuestOsWithContextFunc if only that is set
+func (m *MockGuestOSService) RemoveGuestOs(p *cloudstack.RemoveGuestOsParams) (r0 *cloudstack.RemoveGuestOsResponse, r1 error) {
+	m.record("RemoveGuestOs", p)
+	if m.RemoveGuestOsFunc != nil {
+		return m.RemoveGuestOsFunc(p)
+	}
+	if m.RemoveGuestOsWithContextFunc != nil {
+		return m.RemoveGuestOsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.RemoveGuestOs")
+	return
+}
+
+// RemoveGuestOsWithContext records the call and calls RemoveGuestOsWithContextFunc, or RemoveGuestOsFunc if only that is set
+func (m *MockGuestOSService) RemoveGuestOsWithContext(ctx context.Context, p *cloudstack.RemoveGuestOsParams) (r0 *cloudstack.RemoveGuestOsResponse, r1 error) {
+	m.record("RemoveGuestOsWithContext", ctx, p)
+	if m.RemoveGuestOsWithContextFunc != nil {
+		return m.RemoveGuestOsWithContextFunc(ctx, p)
+	}
+	if m.RemoveGuestOsFunc != nil {
+		return m.RemoveGuestOsFunc(p)
+	}
+	r1 = notMocked("GuestOSService.RemoveGuestOsWithContext")
+	return
+}
+
+// RemoveGuestOsAsync records the call and calls RemoveGuestOsAsyncFunc, or RemoveGuestOsAsyncWithContextFunc if only that is set
+func (m *MockGuestOSService) RemoveGuestOsAsync(p *cloudstack.RemoveGuestOsParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveGuestOsAsync", p)
+	if m.RemoveGuestOsAsyncFunc != nil {
+		return m.RemoveGuestOsAsyncFunc(p)
+	}
+	if m.RemoveGuestOsAsyncWithContextFunc != nil {
+		return m.RemoveGuestOsAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.RemoveGuestOsAsync")
+	return
+}
+
+// RemoveGuestOsAsyncWithContext records the call and calls RemoveGuestOsAsyncWithContextFunc, or RemoveGuestOsAsyncFunc if only that is set
+func (m *MockGuestOSService) RemoveGuestOsAsyncWithContext(ctx context.Context, p *cloudstack.RemoveGuestOsParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveGuestOsAsyncWithContext", ctx, p)
+	if m.RemoveGuestOsAsyncWithContextFunc != nil {
+		return m.RemoveGuestOsAsyncWithContextFunc(ctx, p)
+	}
+	if m.RemoveGuestOsAsyncFunc != nil {
+		return m.RemoveGuestOsAsyncFunc(p)
+	}
+	r1 = notMocked("GuestOSService.RemoveGuestOsAsyncWithContext")
+	return
+}
+
+// NewRemoveGuestOsMappingParams records the call and calls NewRemoveGuestOsMappingParamsFunc
+func (m *MockGuestOSService) NewRemoveGuestOsMappingParams(id string) (r0 *cloudstack.RemoveGuestOsMappingParams) {
+	m.record("NewRemoveGuestOsMappingParams", id)
+	if m.NewRemoveGuestOsMappingParamsFunc != nil {
+		return m.NewRemoveGuestOsMappingParamsFunc(id)
+	}
+	return cloudstack.NewGuestOSService(nil).NewRemoveGuestOsMappingParams(id)
+}
+
+// RemoveGuestOsMapping records the call and calls RemoveGuestOsMappingFunc, or RemoveGuestOsMappingWithContextFunc if only that is set
+func (m *MockGuestOSService) RemoveGuestOsMapping(p *cloudstack.RemoveGuestOsMappingParams) (r0 *cloudstack.RemoveGuestOsMappingResponse, r1 error) {
+	m.record("RemoveGuestOsMapping", p)
+	if m.RemoveGuestOsMappingFunc != nil {
+		return m.RemoveGuestOsMappingFunc(p)
+	}
+	if m.RemoveGuestOsMappingWithContextFunc != nil {
+		return m.RemoveGuestOsMappingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.RemoveGuestOsMapping")
+	return
+}
+
+// RemoveGuestOsMappingWithContext records the call and calls RemoveGuestOsMappingWithContextFunc, or RemoveGuestOsMappingFunc if only that is set
+func (m *MockGuestOSService) RemoveGuestOsMappingWithContext(ctx context.Context, p *cloudstack.RemoveGuestOsMappingParams) (r0 *cloudstack.RemoveGuestOsMappingResponse, r1 error) {
+	m.record("RemoveGuestOsMappingWithContext", ctx, p)
+	if m.RemoveGuestOsMappingWithContextFunc != nil {
+		return m.RemoveGuestOsMappingWithContextFunc(ctx, p)
+	}
+	if m.RemoveGuestOsMappingFunc != nil {
+		return m.RemoveGuestOsMappingFunc(p)
+	}
+	r1 = notMocked("GuestOSService.RemoveGuestOsMappingWithContext")
+	return
+}
+
+// RemoveGuestOsMappingAsync records the call and calls RemoveGuestOsMappingAsyncFunc, or RemoveGuestOsMappingAsyncWithContextFunc if only that is set
+func (m *MockGuestOSService) RemoveGuestOsMappingAsync(p *cloudstack.RemoveGuestOsMappingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveGuestOsMappingAsync", p)
+	if m.RemoveGuestOsMappingAsyncFunc != nil {
+		return m.RemoveGuestOsMappingAsyncFunc(p)
+	}
+	if m.RemoveGuestOsMappingAsyncWithContextFunc != nil {
+		return m.RemoveGuestOsMappingAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.RemoveGuestOsMappingAsync")
+	return
+}
+
+// RemoveGuestOsMappingAsyncWithContext records the call and calls RemoveGuestOsMappingAsyncWithContextFunc, or RemoveGuestOsMappingAsyncFunc if only that is set
+func (m *MockGuestOSService) RemoveGuestOsMappingAsyncWithContext(ctx context.Context, p *cloudstack.RemoveGuestOsMappingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveGuestOsMappingAsyncWithContext", ctx, p)
+	if m.RemoveGuestOsMappingAsyncWithContextFunc != nil {
+		return m.RemoveGuestOsMappingAsyncWithContextFunc(ctx, p)
+	}
+	if m.RemoveGuestOsMappingAsyncFunc != nil {
+		return m.RemoveGuestOsMappingAsyncFunc(p)
+	}
+	r1 = notMocked("GuestOSService.RemoveGuestOsMappingAsyncWithContext")
+	return
+}
+
+// NewUpdateGuestOsParams records the call and calls NewUpdateGuestOsParamsFunc
+func (m *MockGuestOSService) NewUpdateGuestOsParams(details map[string]string, id string, osdisplayname string) (r0 *cloudstack.UpdateGuestOsParams) {
+	m.record("NewUpdateGuestOsParams", details, id, osdisplayname)
+	if m.NewUpdateGuestOsParamsFunc != nil {
+		return m.NewUpdateGuestOsParamsFunc(details, id, osdisplayname)
+	}
+	return cloudstack.NewGuestOSService(nil).NewUpdateGuestOsParams(details, id, osdisplayname)
+}
+
+// UpdateGuestOs records the call and calls UpdateGuestOsFunc, or UpdateGuestOsWithContextFunc if only that is set
+func (m *MockGuestOSService) UpdateGuestOs(p *cloudstack.UpdateGuestOsParams) (r0 *cloudstack.UpdateGuestOsResponse, r1 error) {
+	m.record("UpdateGuestOs", p)
+	if m.UpdateGuestOsFunc != nil {
+		return m.UpdateGuestOsFunc(p)
+	}
+	if m.UpdateGuestOsWithContextFunc != nil {
+		return m.UpdateGuestOsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.UpdateGuestOs")
+	return
+}
+
+// UpdateGuestOsWithContext records the call and calls UpdateGuestOsWithContextFunc, or UpdateGuestOsFunc if only that is set
+func (m *MockGuestOSService) UpdateGuestOsWithContext(ctx context.Context, p *cloudstack.UpdateGuestOsParams) (r0 *cloudstack.UpdateGuestOsResponse, r1 error) {
+	m.record("UpdateGuestOsWithContext", ctx, p)
+	if m.UpdateGuestOsWithContextFunc != nil {
+		return m.UpdateGuestOsWithContextFunc(ctx, p)
+	}
+	if m.UpdateGuestOsFunc != nil {
+		return m.UpdateGuestOsFunc(p)
+	}
+	r1 = notMocked("GuestOSService.UpdateGuestOsWithContext")
+	return
+}
+
+// UpdateGuestOsAsync records the call and calls UpdateGuestOsAsyncFunc, or UpdateGuestOsAsyncWithContextFunc if only that is set
+func (m *MockGuestOSService) UpdateGuestOsAsync(p *cloudstack.UpdateGuestOsParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateGuestOsAsync", p)
+	if m.UpdateGuestOsAsyncFunc != nil {
+		return m.UpdateGuestOsAsyncFunc(p)
+	}
+	if m.UpdateGuestOsAsyncWithContextFunc != nil {
+		return m.UpdateGuestOsAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.UpdateGuestOsAsync")
+	return
+}
+
+// UpdateGuestOsAsyncWithContext records the call and calls UpdateGuestOsAsyncWithContextFunc, or UpdateGuestOsAsyncFunc if only that is set
+func (m *MockGuestOSService) UpdateGuestOsAsyncWithContext(ctx context.Context, p *cloudstack.UpdateGuestOsParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateGuestOsAsyncWithContext", ctx, p)
+	if m.UpdateGuestOsAsyncWithContextFunc != nil {
+		return m.UpdateGuestOsAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateGuestOsAsyncFunc != nil {
+		return m.UpdateGuestOsAsyncFunc(p)
+	}
+	r1 = notMocked("GuestOSService.UpdateGuestOsAsyncWithContext")
+	return
+}
+
+// NewUpdateGuestOsMappingParams records the call and calls NewUpdateGuestOsMappingParamsFunc
+func (m *MockGuestOSService) NewUpdateGuestOsMappingParams(id string, osnameforhypervisor string) (r0 *cloudstack.UpdateGuestOsMappingParams) {
+	m.record("NewUpdateGuestOsMappingParams", id, osnameforhypervisor)
+	if m.NewUpdateGuestOsMappingParamsFunc != nil {
+		return m.NewUpdateGuestOsMappingParamsFunc(id, osnameforhypervisor)
+	}
+	return cloudstack.NewGuestOSService(nil).NewUpdateGuestOsMappingParams(id, osnameforhypervisor)
+}
+
+// UpdateGuestOsMapping records the call and calls UpdateGuestOsMappingFunc, or UpdateGuestOsMappingWithContextFunc if only that is set
+func (m *MockGuestOSService) UpdateGuestOsMapping(p *cloudstack.UpdateGuestOsMappingParams) (r0 *cloudstack.UpdateGuestOsMappingResponse, r1 error) {
+	m.record("UpdateGuestOsMapping", p)
+	if m.UpdateGuestOsMappingFunc != nil {
+		return m.UpdateGuestOsMappingFunc(p)
+	}
+	if m.UpdateGuestOsMappingWithContextFunc != nil {
+		return m.UpdateGuestOsMappingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.UpdateGuestOsMapping")
+	return
+}
+
+// UpdateGuestOsMappingWithContext records the call and calls UpdateGuestOsMappingWithContextFunc, or UpdateGuestOsMappingFunc if only that is set
+func (m *MockGuestOSService) UpdateGuestOsMappingWithContext(ctx context.Context, p *cloudstack.UpdateGuestOsMappingParams) (r0 *cloudstack.UpdateGuestOsMappingResponse, r1 error) {
+	m.record("UpdateGuestOsMappingWithContext", ctx, p)
+	if m.UpdateGuestOsMappingWithContextFunc != nil {
+		return m.UpdateGuestOsMappingWithContextFunc(ctx, p)
+	}
+	if m.UpdateGuestOsMappingFunc != nil {
+		return m.UpdateGuestOsMappingFunc(p)
+	}
+	r1 = notMocked("GuestOSService.UpdateGuestOsMappingWithContext")
+	return
+}
+
+// UpdateGuestOsMappingAsync records the call and calls UpdateGuestOsMappingAsyncFunc, or UpdateGuestOsMappingAsyncWithContextFunc if only that is set
+func (m *MockGuestOSService) UpdateGuestOsMappingAsync(p *cloudstack.UpdateGuestOsMappingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateGuestOsMappingAsync", p)
+	if m.UpdateGuestOsMappingAsyncFunc != nil {
+		return m.UpdateGuestOsMappingAsyncFunc(p)
+	}
+	if m.UpdateGuestOsMappingAsyncWithContextFunc != nil {
+		return m.UpdateGuestOsMappingAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("GuestOSService.UpdateGuestOsMappingAsync")
+	return
+}
+
+// UpdateGuestOsMappingAsyncWithContext records the call and calls UpdateGuestOsMappingAsyncWithContextFunc, or UpdateGuestOsMappingAsyncFunc if only that is set
+func (m *MockGuestOSService) UpdateGuestOsMappingAsyncWithContext(ctx context.Context, p *cloudstack.UpdateGuestOsMappingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateGuestOsMappingAsyncWithContext", ctx, p)
+	if m.UpdateGuestOsMappingAsyncWithContextFunc != nil {
+		return m.UpdateGuestOsMappingAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateGuestOsMappingAsyncFunc != nil {
+		return m.UpdateGuestOsMappingAsyncFunc(p)
+	}
+	r1 = notMocked("GuestOSService.UpdateGuestOsMappingAsyncWithContext")
+	return
+}
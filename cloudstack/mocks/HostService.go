@@ -0,0 +1,2003 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockHostService is a mock of cloudstack.HostServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockHostService struct {
+	Recorder
+
+	NewAddBaremetalHostParamsFunc                         func(hypervisor string, password string, podid string, url string, username string, zoneid string) *cloudstack.AddBaremetalHostParams
+	AddBaremetalHostFunc                                  func(p *cloudstack.AddBaremetalHostParams) (*cloudstack.AddBaremetalHostResponse, error)
+	AddBaremetalHostWithContextFunc                       func(ctx context.Context, p *cloudstack.AddBaremetalHostParams) (*cloudstack.AddBaremetalHostResponse, error)
+	NewAddGloboDnsHostParamsFunc                          func(password string, physicalnetworkid string, url string, username string) *cloudstack.AddGloboDnsHostParams
+	AddGloboDnsHostFunc                                   func(p *cloudstack.AddGloboDnsHostParams) (*cloudstack.AddGloboDnsHostResponse, error)
+	AddGloboDnsHostWithContextFunc                        func(ctx context.Context, p *cloudstack.AddGloboDnsHostParams) (*cloudstack.AddGloboDnsHostResponse, error)
+	AddGloboDnsHostAsyncFunc                              func(p *cloudstack.AddGloboDnsHostParams) (*cloudstack.Job, error)
+	AddGloboDnsHostAsyncWithContextFunc                   func(ctx context.Context, p *cloudstack.AddGloboDnsHostParams) (*cloudstack.Job, error)
+	NewAddHostParamsFunc                                  func(hypervisor string, password string, podid string, url string, username string, zoneid string) *cloudstack.AddHostParams
+	AddHostFunc                                           func(p *cloudstack.AddHostParams) (*cloudstack.AddHostResponse, error)
+	AddHostWithContextFunc                                func(ctx context.Context, p *cloudstack.AddHostParams) (*cloudstack.AddHostResponse, error)
+	NewAddSecondaryStorageParamsFunc                      func(url string) *cloudstack.AddSecondaryStorageParams
+	AddSecondaryStorageFunc                               func(p *cloudstack.AddSecondaryStorageParams) (*cloudstack.AddSecondaryStorageResponse, error)
+	AddSecondaryStorageWithContextFunc                    func(ctx context.Context, p *cloudstack.AddSecondaryStorageParams) (*cloudstack.AddSecondaryStorageResponse, error)
+	NewCancelHostMaintenanceParamsFunc                    func(id string) *cloudstack.CancelHostMaintenanceParams
+	CancelHostMaintenanceFunc                             func(p *cloudstack.CancelHostMaintenanceParams) (*cloudstack.CancelHostMaintenanceResponse, error)
+	CancelHostMaintenanceWithContextFunc                  func(ctx context.Context, p *cloudstack.CancelHostMaintenanceParams) (*cloudstack.CancelHostMaintenanceResponse, error)
+	CancelHostMaintenanceAsyncFunc                        func(p *cloudstack.CancelHostMaintenanceParams) (*cloudstack.Job, error)
+	CancelHostMaintenanceAsyncWithContextFunc             func(ctx context.Context, p *cloudstack.CancelHostMaintenanceParams) (*cloudstack.Job, error)
+	NewConfigureHAForHostParamsFunc                       func(hostid string, provider string) *cloudstack.ConfigureHAForHostParams
+	ConfigureHAForHostFunc                                func(p *cloudstack.ConfigureHAForHostParams) (*cloudstack.HAForHostResponse, error)
+	ConfigureHAForHostWithContextFunc                     func(ctx context.Context, p *cloudstack.ConfigureHAForHostParams) (*cloudstack.HAForHostResponse, error)
+	ConfigureHAForHostAsyncFunc                           func(p *cloudstack.ConfigureHAForHostParams) (*cloudstack.Job, error)
+	ConfigureHAForHostAsyncWithContextFunc                func(ctx context.Context, p *cloudstack.ConfigureHAForHostParams) (*cloudstack.Job, error)
+	NewDedicateHostParamsFunc                             func(domainid string, hostid string) *cloudstack.DedicateHostParams
+	DedicateHostFunc                                      func(p *cloudstack.DedicateHostParams) (*cloudstack.DedicateHostResponse, error)
+	DedicateHostWithContextFunc                           func(ctx context.Context, p *cloudstack.DedicateHostParams) (*cloudstack.DedicateHostResponse, error)
+	DedicateHostAsyncFunc                                 func(p *cloudstack.DedicateHostParams) (*cloudstack.Job, error)
+	DedicateHostAsyncWithContextFunc                      func(ctx context.Context, p *cloudstack.DedicateHostParams) (*cloudstack.Job, error)
+	NewDeleteHostParamsFunc                               func(id string) *cloudstack.DeleteHostParams
+	DeleteHostFunc                                        func(p *cloudstack.DeleteHostParams) (*cloudstack.DeleteHostResponse, error)
+	DeleteHostWithContextFunc                             func(ctx context.Context, p *cloudstack.DeleteHostParams) (*cloudstack.DeleteHostResponse, error)
+	NewDisableHAForClusterParamsFunc                      func(clusterid string) *cloudstack.DisableHAForClusterParams
+	DisableHAForClusterFunc                               func(p *cloudstack.DisableHAForClusterParams) (*cloudstack.DisableHAForClusterResponse, error)
+	DisableHAForClusterWithContextFunc                    func(ctx context.Context, p *cloudstack.DisableHAForClusterParams) (*cloudstack.DisableHAForClusterResponse, error)
+	DisableHAForClusterAsyncFunc                          func(p *cloudstack.DisableHAForClusterParams) (*cloudstack.Job, error)
+	DisableHAForClusterAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.DisableHAForClusterParams) (*cloudstack.Job, error)
+	NewDisableHAForHostParamsFunc                         func(hostid string) *cloudstack.DisableHAForHostParams
+	DisableHAForHostFunc                                  func(p *cloudstack.DisableHAForHostParams) (*cloudstack.DisableHAForHostResponse, error)
+	DisableHAForHostWithContextFunc                       func(ctx context.Context, p *cloudstack.DisableHAForHostParams) (*cloudstack.DisableHAForHostResponse, error)
+	DisableHAForHostAsyncFunc                             func(p *cloudstack.DisableHAForHostParams) (*cloudstack.Job, error)
+	DisableHAForHostAsyncWithContextFunc                  func(ctx context.Context, p *cloudstack.DisableHAForHostParams) (*cloudstack.Job, error)
+	NewDisableHAForZoneParamsFunc                         func(zoneid string) *cloudstack.DisableHAForZoneParams
+	DisableHAForZoneFunc                                  func(p *cloudstack.DisableHAForZoneParams) (*cloudstack.DisableHAForZoneResponse, error)
+	DisableHAForZoneWithContextFunc                       func(ctx context.Context, p *cloudstack.DisableHAForZoneParams) (*cloudstack.DisableHAForZoneResponse, error)
+	DisableHAForZoneAsyncFunc                             func(p *cloudstack.DisableHAForZoneParams) (*cloudstack.Job, error)
+	DisableHAForZoneAsyncWithContextFunc                  func(ctx context.Context, p *cloudstack.DisableHAForZoneParams) (*cloudstack.Job, error)
+	NewDisableOutOfBandManagementForHostParamsFunc        func(hostid string) *cloudstack.DisableOutOfBandManagementForHostParams
+	DisableOutOfBandManagementForHostFunc                 func(p *cloudstack.DisableOutOfBandManagementForHostParams) (*cloudstack.DisableOutOfBandManagementForHostResponse, error)
+	DisableOutOfBandManagementForHostWithContextFunc      func(ctx context.Context, p *cloudstack.DisableOutOfBandManagementForHostParams) (*cloudstack.DisableOutOfBandManagementForHostResponse, error)
+	DisableOutOfBandManagementForHostAsyncFunc            func(p *cloudstack.DisableOutOfBandManagementForHostParams) (*cloudstack.Job, error)
+	DisableOutOfBandManagementForHostAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DisableOutOfBandManagementForHostParams) (*cloudstack.Job, error)
+	NewEnableHAForClusterParamsFunc                       func(clusterid string) *cloudstack.EnableHAForClusterParams
+	EnableHAForClusterFunc                                func(p *cloudstack.EnableHAForClusterParams) (*cloudstack.EnableHAForClusterResponse, error)
+	EnableHAForClusterWithContextFunc                     func(ctx context.Context, p *cloudstack.EnableHAForClusterParams) (*cloudstack.EnableHAForClusterResponse, error)
+	EnableHAForClusterAsyncFunc                           func(p *cloudstack.EnableHAForClusterParams) (*cloudstack.Job, error)
+	EnableHAForClusterAsyncWithContextFunc                func(ctx context.Context, p *cloudstack.EnableHAForClusterParams) (*cloudstack.Job, error)
+	NewEnableHAForHostParamsFunc                          func(hostid string) *cloudstack.EnableHAForHostParams
+	EnableHAForHostFunc                                   func(p *cloudstack.EnableHAForHostParams) (*cloudstack.EnableHAForHostResponse, error)
+	EnableHAForHostWithContextFunc                        func(ctx context.Context, p *cloudstack.EnableHAForHostParams) (*cloudstack.EnableHAForHostResponse, error)
+	EnableHAForHostAsyncFunc                              func(p *cloudstack.EnableHAForHostParams) (*cloudstack.Job, error)
+	EnableHAForHostAsyncWithContextFunc                   func(ctx context.Context, p *cloudstack.EnableHAForHostParams) (*cloudstack.Job, error)
+	NewEnableHAForZoneParamsFunc                          func(zoneid string) *cloudstack.EnableHAForZoneParams
+	EnableHAForZoneFunc                                   func(p *cloudstack.EnableHAForZoneParams) (*cloudstack.EnableHAForZoneResponse, error)
+	EnableHAForZoneWithContextFunc                        func(ctx context.Context, p *cloudstack.EnableHAForZoneParams) (*cloudstack.EnableHAForZoneResponse, error)
+	EnableHAForZoneAsyncFunc                              func(p *cloudstack.EnableHAForZoneParams) (*cloudstack.Job, error)
+	EnableHAForZoneAsyncWithContextFunc                   func(ctx context.Context, p *cloudstack.EnableHAForZoneParams) (*cloudstack.Job, error)
+	NewEnableOutOfBandManagementForHostParamsFunc         func(hostid string) *cloudstack.EnableOutOfBandManagementForHostParams
+	EnableOutOfBandManagementForHostFunc                  func(p *cloudstack.EnableOutOfBandManagementForHostParams) (*cloudstack.EnableOutOfBandManagementForHostResponse, error)
+	EnableOutOfBandManagementForHostWithContextFunc       func(ctx context.Context, p *cloudstack.EnableOutOfBandManagementForHostParams) (*cloudstack.EnableOutOfBandManagementForHostResponse, error)
+	EnableOutOfBandManagementForHostAsyncFunc             func(p *cloudstack.EnableOutOfBandManagementForHostParams) (*cloudstack.Job, error)
+	EnableOutOfBandManagementForHostAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.EnableOutOfBandManagementForHostParams) (*cloudstack.Job, error)
+	NewFindHostsForMigrationParamsFunc                    func(virtualmachineid string) *cloudstack.FindHostsForMigrationParams
+	FindHostsForMigrationFunc                             func(p *cloudstack.FindHostsForMigrationParams) (*cloudstack.FindHostsForMigrationResponse, error)
+	FindHostsForMigrationWithContextFunc                  func(ctx context.Context, p *cloudstack.FindHostsForMigrationParams) (*cloudstack.FindHostsForMigrationResponse, error)
+	NewListDedicatedHostsParamsFunc                       func() *cloudstack.ListDedicatedHostsParams
+	ListDedicatedHostsFunc                                func(p *cloudstack.ListDedicatedHostsParams) (*cloudstack.ListDedicatedHostsResponse, error)
+	ListDedicatedHostsWithContextFunc                     func(ctx context.Context, p *cloudstack.ListDedicatedHostsParams) (*cloudstack.ListDedicatedHostsResponse, error)
+	NewListDedicatedHostsPagerFunc                        func(p *cloudstack.ListDedicatedHostsParams) *cloudstack.ListDedicatedHostsPager
+	ListDedicatedHostsAllFunc                             func(p *cloudstack.ListDedicatedHostsParams) (*cloudstack.ListDedicatedHostsResponse, error)
+	ListDedicatedHostsAllWithContextFunc                  func(ctx context.Context, p *cloudstack.ListDedicatedHostsParams) (*cloudstack.ListDedicatedHostsResponse, error)
+	NewListHostHAProvidersParamsFunc                      func(hypervisor string) *cloudstack.ListHostHAProvidersParams
+	ListHostHAProvidersFunc                               func(p *cloudstack.ListHostHAProvidersParams) (*cloudstack.ListHostHAProvidersResponse, error)
+	ListHostHAProvidersWithContextFunc                    func(ctx context.Context, p *cloudstack.ListHostHAProvidersParams) (*cloudstack.ListHostHAProvidersResponse, error)
+	NewListHostHAResourcesParamsFunc                      func() *cloudstack.ListHostHAResourcesParams
+	ListHostHAResourcesFunc                               func(p *cloudstack.ListHostHAResourcesParams) (*cloudstack.ListHostHAResourcesResponse, error)
+	ListHostHAResourcesWithContextFunc                    func(ctx context.Context, p *cloudstack.ListHostHAResourcesParams) (*cloudstack.ListHostHAResourcesResponse, error)
+	NewListHostTagsParamsFunc                             func() *cloudstack.ListHostTagsParams
+	GetHostTagIDFunc                                      func(keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetHostTagIDWithContextFunc                           func(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListHostTagsFunc                                      func(p *cloudstack.ListHostTagsParams) (*cloudstack.ListHostTagsResponse, error)
+	ListHostTagsWithContextFunc                           func(ctx context.Context, p *cloudstack.ListHostTagsParams) (*cloudstack.ListHostTagsResponse, error)
+	NewListHostTagsPagerFunc                              func(p *cloudstack.ListHostTagsParams) *cloudstack.ListHostTagsPager
+	ListHostTagsAllFunc                                   func(p *cloudstack.ListHostTagsParams) (*cloudstack.ListHostTagsResponse, error)
+	ListHostTagsAllWithContextFunc                        func(ctx context.Context, p *cloudstack.ListHostTagsParams) (*cloudstack.ListHostTagsResponse, error)
+	NewListHostsParamsFunc                                func() *cloudstack.ListHostsParams
+	GetHostIDFunc                                         func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetHostIDWithContextFunc                              func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetHostByNameFunc                                     func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Host, int, error)
+	GetHostByNameWithContextFunc                          func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.Host, int, error)
+	GetHostByIDFunc                                       func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Host, int, error)
+	GetHostByIDWithContextFunc                            func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Host, int, error)
+	ListHostsFunc                                         func(p *cloudstack.ListHostsParams) (*cloudstack.ListHostsResponse, error)
+	ListHostsWithContextFunc                              func(ctx context.Context, p *cloudstack.ListHostsParams) (*cloudstack.ListHostsResponse, error)
+	NewListHostsPagerFunc                                 func(p *cloudstack.ListHostsParams) *cloudstack.ListHostsPager
+	ListHostsAllFunc                                      func(p *cloudstack.ListHostsParams) (*cloudstack.ListHostsResponse, error)
+	ListHostsAllWithContextFunc                           func(ctx context.Context, p *cloudstack.ListHostsParams) (*cloudstack.ListHostsResponse, error)
+	NewListHostsMetricsParamsFunc                         func() *cloudstack.ListHostsMetricsParams
+	GetHostsMetricIDFunc                                  func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetHostsMetricIDWithContextFunc                       func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetHostsMetricByNameFunc                              func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.HostsMetric, int, error)
+	GetHostsMetricByNameWithContextFunc                   func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.HostsMetric, int, error)
+	GetHostsMetricByIDFunc                                func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.HostsMetric, int, error)
+	GetHostsMetricByIDWithContextFunc                     func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.HostsMetric, int, error)
+	ListHostsMetricsFunc                                  func(p *cloudstack.ListHostsMetricsParams) (*cloudstack.ListHostsMetricsResponse, error)
+	ListHostsMetricsWithContextFunc                       func(ctx context.Context, p *cloudstack.ListHostsMetricsParams) (*cloudstack.ListHostsMetricsResponse, error)
+	NewListHostsMetricsPagerFunc                          func(p *cloudstack.ListHostsMetricsParams) *cloudstack.ListHostsMetricsPager
+	ListHostsMetricsAllFunc                               func(p *cloudstack.ListHostsMetricsParams) (*cloudstack.ListHostsMetricsResponse, error)
+	ListHostsMetricsAllWithContextFunc                    func(ctx context.Context, p *cloudstack.ListHostsMetricsParams) (*cloudstack.ListHostsMetricsResponse, error)
+	NewPrepareHostForMaintenanceParamsFunc                func(id string) *cloudstack.PrepareHostForMaintenanceParams
+	PrepareHostForMaintenanceFunc                         func(p *cloudstack.PrepareHostForMaintenanceParams) (*cloudstack.PrepareHostForMaintenanceResponse, error)
+	PrepareHostForMaintenanceWithContextFunc              func(ctx context.Context, p *cloudstack.PrepareHostForMaintenanceParams) (*cloudstack.PrepareHostForMaintenanceResponse, error)
+	PrepareHostForMaintenanceAsyncFunc                    func(p *cloudstack.PrepareHostForMaintenanceParams) (*cloudstack.Job, error)
+	PrepareHostForMaintenanceAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.PrepareHostForMaintenanceParams) (*cloudstack.Job, error)
+	NewReconnectHostParamsFunc                            func(id string) *cloudstack.ReconnectHostParams
+	ReconnectHostFunc                                     func(p *cloudstack.ReconnectHostParams) (*cloudstack.ReconnectHostResponse, error)
+	ReconnectHostWithContextFunc                          func(ctx context.Context, p *cloudstack.ReconnectHostParams) (*cloudstack.ReconnectHostResponse, error)
+	ReconnectHostAsyncFunc                                func(p *cloudstack.ReconnectHostParams) (*cloudstack.Job, error)
+	ReconnectHostAsyncWithContextFunc                     func(ctx context.Context, p *cloudstack.ReconnectHostParams) (*cloudstack.Job, error)
+	NewReleaseDedicatedHostParamsFunc                     func(hostid string) *cloudstack.ReleaseDedicatedHostParams
+	ReleaseDedicatedHostFunc                              func(p *cloudstack.ReleaseDedicatedHostParams) (*cloudstack.ReleaseDedicatedHostResponse, error)
+	ReleaseDedicatedHostWithContextFunc                   func(ctx context.Context, p *cloudstack.ReleaseDedicatedHostParams) (*cloudstack.ReleaseDedicatedHostResponse, error)
+	ReleaseDedicatedHostAsyncFunc                         func(p *cloudstack.ReleaseDedicatedHostParams) (*cloudstack.Job, error)
+	ReleaseDedicatedHostAsyncWithContextFunc              func(ctx context.Context, p *cloudstack.ReleaseDedicatedHostParams) (*cloudstack.Job, error)
+	NewReleaseHostReservationParamsFunc                   func(id string) *cloudstack.ReleaseHostReservationParams
+	ReleaseHostReservationFunc                            func(p *cloudstack.ReleaseHostReservationParams) (*cloudstack.ReleaseHostReservationResponse, error)
+	ReleaseHostReservationWithContextFunc                 func(ctx context.Context, p *cloudstack.ReleaseHostReservationParams) (*cloudstack.ReleaseHostReservationResponse, error)
+	ReleaseHostReservationAsyncFunc                       func(p *cloudstack.ReleaseHostReservationParams) (*cloudstack.Job, error)
+	ReleaseHostReservationAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.ReleaseHostReservationParams) (*cloudstack.Job, error)
+	NewStartRollingMaintenanceParamsFunc                  func() *cloudstack.StartRollingMaintenanceParams
+	StartRollingMaintenanceFunc                           func(p *cloudstack.StartRollingMaintenanceParams) (*cloudstack.StartRollingMaintenanceResponse, error)
+	StartRollingMaintenanceWithContextFunc                func(ctx context.Context, p *cloudstack.StartRollingMaintenanceParams) (*cloudstack.StartRollingMaintenanceResponse, error)
+	StartRollingMaintenanceAsyncFunc                      func(p *cloudstack.StartRollingMaintenanceParams) (*cloudstack.Job, error)
+	StartRollingMaintenanceAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.StartRollingMaintenanceParams) (*cloudstack.Job, error)
+	NewUpdateHostParamsFunc                               func(id string) *cloudstack.UpdateHostParams
+	UpdateHostFunc                                        func(p *cloudstack.UpdateHostParams) (*cloudstack.UpdateHostResponse, error)
+	UpdateHostWithContextFunc                             func(ctx context.Context, p *cloudstack.UpdateHostParams) (*cloudstack.UpdateHostResponse, error)
+	NewUpdateHostPasswordParamsFunc                       func(password string, username string) *cloudstack.UpdateHostPasswordParams
+	UpdateHostPasswordFunc                                func(p *cloudstack.UpdateHostPasswordParams) (*cloudstack.UpdateHostPasswordResponse, error)
+	UpdateHostPasswordWithContextFunc                     func(ctx context.Context, p *cloudstack.UpdateHostPasswordParams) (*cloudstack.UpdateHostPasswordResponse, error)
+}
+
+var _ cloudstack.HostServiceIface = (*MockHostService)(nil)
+
+// NewAddBaremetalHostParams records the call and calls NewAddBaremetalHostParamsFunc
+func (m *MockHostService) NewAddBaremetalHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string) (r0 *cloudstack.AddBaremetalHostParams) {
+	m.record("NewAddBaremetalHostParams", hypervisor, password, podid, url, username, zoneid)
+	if m.NewAddBaremetalHostParamsFunc != nil {
+		return m.NewAddBaremetalHostParamsFunc(hypervisor, password, podid, url, username, zoneid)
+	}
+	return cloudstack.NewHostService(nil).NewAddBaremetalHostParams(hypervisor, password, podid, url, username, zoneid)
+}
+
+// AddBaremetalHost records the call and calls AddBaremetalHostFunc, or AddBaremetalHostWithContextFunc if only that is set
+func (m *MockHostService) AddBaremetalHost(p *cloudstack.AddBaremetalHostParams) (r0 *cloudstack.AddBaremetalHostResponse, r1 error) {
+	m.record("AddBaremetalHost", p)
+	if m.AddBaremetalHostFunc != nil {
+		return m.AddBaremetalHostFunc(p)
+	}
+	if m.AddBaremetalHostWithContextFunc != nil {
+		return m.AddBaremetalHostWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.AddBaremetalHost")
+	return
+}
+
+// AddBaremetalHostWithContext records the call and calls AddBaremetalHostWithContextFunc, or AddBaremetalHostFunc if only that is set
+func (m *MockHostService) AddBaremetalHostWithContext(ctx context.Context, p *cloudstack.AddBaremetalHostParams) (r0 *cloudstack.AddBaremetalHostResponse, r1 error) {
+	m.record("AddBaremetalHostWithContext", ctx, p)
+	if m.AddBaremetalHostWithContextFunc != nil {
+		return m.AddBaremetalHostWithContextFunc(ctx, p)
+	}
+	if m.AddBaremetalHostFunc != nil {
+		return m.AddBaremetalHostFunc(p)
+	}
+	r1 = notMocked("HostService.AddBaremetalHostWithContext")
+	return
+}
+
+// NewAddGloboDnsHostParams records the call and calls NewAddGloboDnsHostParamsFunc
+func (m *MockHostService) NewAddGloboDnsHostParams(password string, physicalnetworkid string, url string, username string) (r0 *cloudstack.AddGloboDnsHostParams) {
+	m.record("NewAddGloboDnsHostParams", password, physicalnetworkid, url, username)
+	if m.NewAddGloboDnsHostParamsFunc != nil {
+		return m.NewAddGloboDnsHostParamsFunc(password, physicalnetworkid, url, username)
+	}
+	return cloudstack.NewHostService(nil).NewAddGloboDnsHostParams(password, physicalnetworkid, url, username)
+}
+
+// AddGloboDnsHost records the call and calls AddGloboDnsHostFunc, or AddGloboDnsHostWithContextFunc if only that is set
+func (m *MockHostService) AddGloboDnsHost(p *cloudstack.AddGloboDnsHostParams) (r0 *cloudstack.AddGloboDnsHostResponse, r1 error) {
+	m.record("AddGloboDnsHost", p)
+	if m.AddGloboDnsHostFunc != nil {
+		return m.AddGloboDnsHostFunc(p)
+	}
+	if m.AddGloboDnsHostWithContextFunc != nil {
+		return m.AddGloboDnsHostWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.AddGloboDnsHost")
+	return
+}
+
+// AddGloboDnsHostWithContext records the call and calls AddGloboDnsHostWithContextFunc, or AddGloboDnsHostFunc if only that is set
+func (m *MockHostService) AddGloboDnsHostWithContext(ctx context.Context, p *cloudstack.AddGloboDnsHostParams) (r0 *cloudstack.AddGloboDnsHostResponse, r1 error) {
+	m.record("AddGloboDnsHostWithContext", ctx, p)
+	if m.AddGloboDnsHostWithContextFunc != nil {
+		return m.AddGloboDnsHostWithContextFunc(ctx, p)
+	}
+	if m.AddGloboDnsHostFunc != nil {
+		return m.AddGloboDnsHostFunc(p)
+	}
+	r1 = notMocked("HostService.AddGloboDnsHostWithContext")
+	return
+}
+
+// AddGloboDnsHostAsync records the call and calls AddGloboDnsHostAsyncFunc, or AddGloboDnsHostAsyncWithContextFunc if only that is set
+func (m *MockHostService) AddGloboDnsHostAsync(p *cloudstack.AddGloboDnsHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddGloboDnsHostAsync", p)
+	if m.AddGloboDnsHostAsyncFunc != nil {
+		return m.AddGloboDnsHostAsyncFunc(p)
+	}
+	if m.AddGloboDnsHostAsyncWithContextFunc != nil {
+		return m.AddGloboDnsHostAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.AddGloboDnsHostAsync")
+	return
+}
+
+// AddGloboDnsHostAsyncWithContext records the call and calls AddGloboDnsHostAsyncWithContextFunc, or AddGloboDnsHostAsyncFunc if only that is set
+func (m *MockHostService) AddGloboDnsHostAsyncWithContext(ctx context.Context, p *cloudstack.AddGloboDnsHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddGloboDnsHostAsyncWithContext", ctx, p)
+	if m.AddGloboDnsHostAsyncWithContextFunc != nil {
+		return m.AddGloboDnsHostAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddGloboDnsHostAsyncFunc != nil {
+		return m.AddGloboDnsHostAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.AddGloboDnsHostAsyncWithContext")
+	return
+}
+
+// NewAddHostParams records the call and calls NewAddHostParamsFunc
+func (m *MockHostService) NewAddHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string) (r0 *cloudstack.AddHostParams) {
+	m.record("NewAddHostParams", hypervisor, password, podid, url, username, zoneid)
+	if m.NewAddHostParamsFunc != nil {
+		return m.NewAddHostParamsFunc(hypervisor, password, podid, url, username, zoneid)
+	}
+	return cloudstack.NewHostService(nil).NewAddHostParams(hypervisor, password, podid, url, username, zoneid)
+}
+
+// AddHost records the call and calls AddHostFunc, or AddHostWithContextFunc if only that is set
+func (m *MockHostService) AddHost(p *cloudstack.AddHostParams) (r0 *cloudstack.AddHostResponse, r1 error) {
+	m.record("AddHost", p)
+	if m.AddHostFunc != nil {
+		return m.AddHostFunc(p)
+	}
+	if m.AddHostWithContextFunc != nil {
+		return m.AddHostWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.AddHost")
+	return
+}
+
+// AddHostWithContext records the call and calls AddHostWithContextFunc, or AddHostFunc if only that is set
+func (m *MockHostService) AddHostWithContext(ctx context.Context, p *cloudstack.AddHostParams) (r0 *cloudstack.AddHostResponse, r1 error) {
+	m.record("AddHostWithContext", ctx, p)
+	if m.AddHostWithContextFunc != nil {
+		return m.AddHostWithContextFunc(ctx, p)
+	}
+	if m.AddHostFunc != nil {
+		return m.AddHostFunc(p)
+	}
+	r1 = notMocked("HostService.AddHostWithContext")
+	return
+}
+
+// NewAddSecondaryStorageParams records the call and calls NewAddSecondaryStorageParamsFunc
+func (m *MockHostService) NewAddSecondaryStorageParams(url string) (r0 *cloudstack.AddSecondaryStorageParams) {
+	m.record("NewAddSecondaryStorageParams", url)
+	if m.NewAddSecondaryStorageParamsFunc != nil {
+		return m.NewAddSecondaryStorageParamsFunc(url)
+	}
+	return cloudstack.NewHostService(nil).NewAddSecondaryStorageParams(url)
+}
+
+// AddSecondaryStorage records the call and calls AddSecondaryStorageFunc, or AddSecondaryStorageWithContextFunc if only that is set
+func (m *MockHostService) AddSecondaryStorage(p *cloudstack.AddSecondaryStorageParams) (r0 *cloudstack.AddSecondaryStorageResponse, r1 error) {
+	m.record("AddSecondaryStorage", p)
+	if m.AddSecondaryStorageFunc != nil {
+		return m.AddSecondaryStorageFunc(p)
+	}
+	if m.AddSecondaryStorageWithContextFunc != nil {
+		return m.AddSecondaryStorageWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.AddSecondaryStorage")
+	return
+}
+
+// AddSecondaryStorageWithContext records the call and calls AddSecondaryStorageWithContextFunc, or AddSecondaryStorageFunc if only that is set
+func (m *MockHostService) AddSecondaryStorageWithContext(ctx context.Context, p *cloudstack.AddSecondaryStorageParams) (r0 *cloudstack.AddSecondaryStorageResponse, r1 error) {
+	m.record("AddSecondaryStorageWithContext", ctx, p)
+	if m.AddSecondaryStorageWithContextFunc != nil {
+		return m.AddSecondaryStorageWithContextFunc(ctx, p)
+	}
+	if m.AddSecondaryStorageFunc != nil {
+		return m.AddSecondaryStorageFunc(p)
+	}
+	r1 = notMocked("HostService.AddSecondaryStorageWithContext")
+	return
+}
+
+// NewCancelHostMaintenanceParams records the call and calls NewCancelHostMaintenanceParamsFunc
+func (m *MockHostService) NewCancelHostMaintenanceParams(id string) (r0 *cloudstack.CancelHostMaintenanceParams) {
+	m.record("NewCancelHostMaintenanceParams", id)
+	if m.NewCancelHostMaintenanceParamsFunc != nil {
+		return m.NewCancelHostMaintenanceParamsFunc(id)
+	}
+	return cloudstack.NewHostService(nil).NewCancelHostMaintenanceParams(id)
+}
+
+// CancelHostMaintenance records the call and calls CancelHostMaintenanceFunc, or CancelHostMaintenanceWithContextFunc if only that is set
+func (m *MockHostService) CancelHostMaintenance(p *cloudstack.CancelHostMaintenanceParams) (r0 *cloudstack.CancelHostMaintenanceResponse, r1 error) {
+	m.record("CancelHostMaintenance", p)
+	if m.CancelHostMaintenanceFunc != nil {
+		return m.CancelHostMaintenanceFunc(p)
+	}
+	if m.CancelHostMaintenanceWithContextFunc != nil {
+		return m.CancelHostMaintenanceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.CancelHostMaintenance")
+	return
+}
+
+// CancelHostMaintenanceWithContext records the call and calls CancelHostMaintenanceWithContextFunc, or CancelHostMaintenanceFunc if only that is set
+func (m *MockHostService) CancelHostMaintenanceWithContext(ctx context.Context, p *cloudstack.CancelHostMaintenanceParams) (r0 *cloudstack.CancelHostMaintenanceResponse, r1 error) {
+	m.record("CancelHostMaintenanceWithContext", ctx, p)
+	if m.CancelHostMaintenanceWithContextFunc != nil {
+		return m.CancelHostMaintenanceWithContextFunc(ctx, p)
+	}
+	if m.CancelHostMaintenanceFunc != nil {
+		return m.CancelHostMaintenanceFunc(p)
+	}
+	r1 = notMocked("HostService.CancelHostMaintenanceWithContext")
+	return
+}
+
+// CancelHostMaintenanceAsync records the call and calls CancelHostMaintenanceAsyncFunc, or CancelHostMaintenanceAsyncWithContextFunc if only that is set
+func (m *MockHostService) CancelHostMaintenanceAsync(p *cloudstack.CancelHostMaintenanceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CancelHostMaintenanceAsync", p)
+	if m.CancelHostMaintenanceAsyncFunc != nil {
+		return m.CancelHostMaintenanceAsyncFunc(p)
+	}
+	if m.CancelHostMaintenanceAsyncWithContextFunc != nil {
+		return m.CancelHostMaintenanceAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.CancelHostMaintenanceAsync")
+	return
+}
+
+// CancelHostMaintenanceAsyncWithContext records the call and calls CancelHostMaintenanceAsyncWithContextFunc, or CancelHostMaintenanceAsyncFunc if only that is set
+func (m *MockHostService) CancelHostMaintenanceAsyncWithContext(ctx context.Context, p *cloudstack.CancelHostMaintenanceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CancelHostMaintenanceAsyncWithContext", ctx, p)
+	if m.CancelHostMaintenanceAsyncWithContextFunc != nil {
+		return m.CancelHostMaintenanceAsyncWithContextFunc(ctx, p)
+	}
+	if m.CancelHostMaintenanceAsyncFunc != nil {
+		return m.CancelHostMaintenanceAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.CancelHostMaintenanceAsyncWithContext")
+	return
+}
+
+// NewConfigureHAForHostParams records the call and calls NewConfigureHAForHostParamsFunc
+func (m *MockHostService) NewConfigureHAForHostParams(hostid string, provider string) (r0 *cloudstack.ConfigureHAForHostParams) {
+	m.record("NewConfigureHAForHostParams", hostid, provider)
+	if m.NewConfigureHAForHostParamsFunc != nil {
+		return m.NewConfigureHAForHostParamsFunc(hostid, provider)
+	}
+	return cloudstack.NewHostService(nil).NewConfigureHAForHostParams(hostid, provider)
+}
+
+// ConfigureHAForHost records the call and calls ConfigureHAForHostFunc, or ConfigureHAForHostWithContextFunc if only that is set
+func (m *MockHostService) ConfigureHAForHost(p *cloudstack.ConfigureHAForHostParams) (r0 *cloudstack.HAForHostResponse, r1 error) {
+	m.record("ConfigureHAForHost", p)
+	if m.ConfigureHAForHostFunc != nil {
+		return m.ConfigureHAForHostFunc(p)
+	}
+	if m.ConfigureHAForHostWithContextFunc != nil {
+		return m.ConfigureHAForHostWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ConfigureHAForHost")
+	return
+}
+
+// ConfigureHAForHostWithContext records the call and calls ConfigureHAForHostWithContextFunc, or ConfigureHAForHostFunc if only that is set
+func (m *MockHostService) ConfigureHAForHostWithContext(ctx context.Context, p *cloudstack.ConfigureHAForHostParams) (r0 *cloudstack.HAForHostResponse, r1 error) {
+	m.record("ConfigureHAForHostWithContext", ctx, p)
+	if m.ConfigureHAForHostWithContextFunc != nil {
+		return m.ConfigureHAForHostWithContextFunc(ctx, p)
+	}
+	if m.ConfigureHAForHostFunc != nil {
+		return m.ConfigureHAForHostFunc(p)
+	}
+	r1 = notMocked("HostService.ConfigureHAForHostWithContext")
+	return
+}
+
+// ConfigureHAForHostAsync records the call and calls ConfigureHAForHostAsyncFunc, or ConfigureHAForHostAsyncWithContextFunc if only that is set
+func (m *MockHostService) ConfigureHAForHostAsync(p *cloudstack.ConfigureHAForHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigureHAForHostAsync", p)
+	if m.ConfigureHAForHostAsyncFunc != nil {
+		return m.ConfigureHAForHostAsyncFunc(p)
+	}
+	if m.ConfigureHAForHostAsyncWithContextFunc != nil {
+		return m.ConfigureHAForHostAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ConfigureHAForHostAsync")
+	return
+}
+
+// ConfigureHAForHostAsyncWithContext records the call and calls ConfigureHAForHostAsyncWithContextFunc, or ConfigureHAForHostAsyncFunc if only that is set
+func (m *MockHostService) ConfigureHAForHostAsyncWithContext(ctx context.Context, p *cloudstack.ConfigureHAForHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigureHAForHostAsyncWithContext", ctx, p)
+	if m.ConfigureHAForHostAsyncWithContextFunc != nil {
+		return m.ConfigureHAForHostAsyncWithContextFunc(ctx, p)
+	}
+	if m.ConfigureHAForHostAsyncFunc != nil {
+		return m.ConfigureHAForHostAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.ConfigureHAForHostAsyncWithContext")
+	return
+}
+
+// NewDedicateHostParams records the call and calls NewDedicateHostParamsFunc
+func (m *MockHostService) NewDedicateHostParams(domainid string, hostid string) (r0 *cloudstack.DedicateHostParams) {
+	m.record("NewDedicateHostParams", domainid, hostid)
+	if m.NewDedicateHostParamsFunc != nil {
+		return m.NewDedicateHostParamsFunc(domainid, hostid)
+	}
+	return cloudstack.NewHostService(nil).NewDedicateHostParams(domainid, hostid)
+}
+
+// DedicateHost records the call and calls DedicateHostFunc, or DedicateHostWithContextFunc if only that is set
+func (m *MockHostService) DedicateHost(p *cloudstack.DedicateHostParams) (r0 *cloudstack.DedicateHostResponse, r1 error) {
+	m.record("DedicateHost", p)
+	if m.DedicateHostFunc != nil {
+		return m.DedicateHostFunc(p)
+	}
+	if m.DedicateHostWithContextFunc != nil {
+		return m.DedicateHostWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.DedicateHost")
+	return
+}
+
+// DedicateHostWithContext records the call and calls DedicateHostWithContextFunc, or DedicateHostFunc if only that is set
+func (m *MockHostService) DedicateHostWithContext(ctx context.Context, p *cloudstack.DedicateHostParams) (r0 *cloudstack.DedicateHostResponse, r1 error) {
+	m.record("DedicateHostWithContext", ctx, p)
+	if m.DedicateHostWithContextFunc != nil {
+		return m.DedicateHostWithContextFunc(ctx, p)
+	}
+	if m.DedicateHostFunc != nil {
+		return m.DedicateHostFunc(p)
+	}
+	r1 = notMocked("HostService.DedicateHostWithContext")
+	return
+}
+
+// DedicateHostAsync records the call and calls DedicateHostAsyncFunc, or DedicateHostAsyncWithContextFunc if only that is set
+func (m *MockHostService) DedicateHostAsync(p *cloudstack.DedicateHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DedicateHostAsync", p)
+	if m.DedicateHostAsyncFunc != nil {
+		return m.DedicateHostAsyncFunc(p)
+	}
+	if m.DedicateHostAsyncWithContextFunc != nil {
+		return m.DedicateHostAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.DedicateHostAsync")
+	return
+}
+
+// DedicateHostAsyncWithContext records the call and calls DedicateHostAsyncWithContextFunc, or DedicateHostAsyncFunc if only that is set
+func (m *MockHostService) DedicateHostAsyncWithContext(ctx context.Context, p *cloudstack.DedicateHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DedicateHostAsyncWithContext", ctx, p)
+	if m.DedicateHostAsyncWithContextFunc != nil {
+		return m.DedicateHostAsyncWithContextFunc(ctx, p)
+	}
+	if m.DedicateHostAsyncFunc != nil {
+		return m.DedicateHostAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.DedicateHostAsyncWithContext")
+	return
+}
+
+// NewDeleteHostParams records the call and calls NewDeleteHostParamsFunc
+func (m *MockHostService) NewDeleteHostParams(id string) (r0 *cloudstack.DeleteHostParams) {
+	m.record("NewDeleteHostParams", id)
+	if m.NewDeleteHostParamsFunc != nil {
+		return m.NewDeleteHostParamsFunc(id)
+	}
+	return cloudstack.NewHostService(nil).NewDeleteHostParams(id)
+}
+
+// DeleteHost records the call and calls DeleteHostFunc, or DeleteHostWithContextFunc if only that is set
+func (m *MockHostService) DeleteHost(p *cloudstack.DeleteHostParams) (r0 *cloudstack.DeleteHostResponse, r1 error) {
+	m.record("DeleteHost", p)
+	if m.DeleteHostFunc != nil {
+		return m.DeleteHostFunc(p)
+	}
+	if m.DeleteHostWithContextFunc != nil {
+		return m.DeleteHostWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.DeleteHost")
+	return
+}
+
+// DeleteHostWithContext records the call and calls DeleteHostWithContextFunc, or DeleteHostFunc if only that is set
+func (m *MockHostService) DeleteHostWithContext(ctx context.Context, p *cloudstack.DeleteHostParams) (r0 *cloudstack.DeleteHostResponse, r1 error) {
+	m.record("DeleteHostWithContext", ctx, p)
+	if m.DeleteHostWithContextFunc != nil {
+		return m.DeleteHostWithContextFunc(ctx, p)
+	}
+	if m.DeleteHostFunc != nil {
+		return m.DeleteHostFunc(p)
+	}
+	r1 = notMocked("HostService.DeleteHostWithContext")
+	return
+}
+
+// NewDisableHAForClusterParams records the call and calls NewDisableHAForClusterParamsFunc
+func (m *MockHostService) NewDisableHAForClusterParams(clusterid string) (r0 *cloudstack.DisableHAForClusterParams) {
+	m.record("NewDisableHAForClusterParams", clusterid)
+	if m.NewDisableHAForClusterParamsFunc != nil {
+		return m.NewDisableHAForClusterParamsFunc(clusterid)
+	}
+	return cloudstack.NewHostService(nil).NewDisableHAForClusterParams(clusterid)
+}
+
+// DisableHAForCluster records the call and calls DisableHAForClusterFunc, or DisableHAForClusterWithContextFunc if only that is set
+func (m *MockHostService) DisableHAForCluster(p *cloudstack.DisableHAForClusterParams) (r0 *cloudstack.DisableHAForClusterResponse, r1 error) {
+	m.record("DisableHAForCluster", p)
+	if m.DisableHAForClusterFunc != nil {
+		return m.DisableHAForClusterFunc(p)
+	}
+	if m.DisableHAForClusterWithContextFunc != nil {
+		return m.DisableHAForClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.DisableHAForCluster")
+	return
+}
+
+// DisableHAForClusterWithContext records the call and calls DisableHAForClusterWithContextFunc, or DisableHAForClusterFunc if only that is set
+func (m *MockHostService) DisableHAForClusterWithContext(ctx context.Context, p *cloudstack.DisableHAForClusterParams) (r0 *cloudstack.DisableHAForClusterResponse, r1 error) {
+	m.record("DisableHAForClusterWithContext", ctx, p)
+	if m.DisableHAForClusterWithContextFunc != nil {
+		return m.DisableHAForClusterWithContextFunc(ctx, p)
+	}
+	if m.DisableHAForClusterFunc != nil {
+		return m.DisableHAForClusterFunc(p)
+	}
+	r1 = notMocked("HostService.DisableHAForClusterWithContext")
+	return
+}
+
+// DisableHAForClusterAsync records the call and calls DisableHAForClusterAsyncFunc, or DisableHAForClusterAsyncWithContextFunc if only that is set
+func (m *MockHostService) DisableHAForClusterAsync(p *cloudstack.DisableHAForClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableHAForClusterAsync", p)
+	if m.DisableHAForClusterAsyncFunc != nil {
+		return m.DisableHAForClusterAsyncFunc(p)
+	}
+	if m.DisableHAForClusterAsyncWithContextFunc != nil {
+		return m.DisableHAForClusterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.DisableHAForClusterAsync")
+	return
+}
+
+// DisableHAForClusterAsyncWithContext records the call and calls DisableHAForClusterAsyncWithContextFunc, or DisableHAForClusterAsyncFunc if only that is set
+func (m *MockHostService) DisableHAForClusterAsyncWithContext(ctx context.Context, p *cloudstack.DisableHAForClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableHAForClusterAsyncWithContext", ctx, p)
+	if m.DisableHAForClusterAsyncWithContextFunc != nil {
+		return m.DisableHAForClusterAsyncWithContextFunc(ctx, p)
+	}
+	if m.DisableHAForClusterAsyncFunc != nil {
+		return m.DisableHAForClusterAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.DisableHAForClusterAsyncWithContext")
+	return
+}
+
+// NewDisableHAForHostParams records the call and calls NewDisableHAForHostParamsFunc
+func (m *MockHostService) NewDisableHAForHostParams(hostid string) (r0 *cloudstack.DisableHAForHostParams) {
+	m.record("NewDisableHAForHostParams", hostid)
+	if m.NewDisableHAForHostParamsFunc != nil {
+		return m.NewDisableHAForHostParamsFunc(hostid)
+	}
+	return cloudstack.NewHostService(nil).NewDisableHAForHostParams(hostid)
+}
+
+// DisableHAForHost records the call and calls DisableHAForHostFunc, or DisableHAForHostWithContextFunc if only that is set
+func (m *MockHostService) DisableHAForHost(p *cloudstack.DisableHAForHostParams) (r0 *cloudstack.DisableHAForHostResponse, r1 error) {
+	m.record("DisableHAForHost", p)
+	if m.DisableHAForHostFunc != nil {
+		return m.DisableHAForHostFunc(p)
+	}
+	if m.DisableHAForHostWithContextFunc != nil {
+		return m.DisableHAForHostWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.DisableHAForHost")
+	return
+}
+
+// DisableHAForHostWithContext records the call and calls DisableHAForHostWithContextFunc, or DisableHAForHostFunc if only that is set
+func (m *MockHostService) DisableHAForHostWithContext(ctx context.Context, p *cloudstack.DisableHAForHostParams) (r0 *cloudstack.DisableHAForHostResponse, r1 error) {
+	m.record("DisableHAForHostWithContext", ctx, p)
+	if m.DisableHAForHostWithContextFunc != nil {
+		return m.DisableHAForHostWithContextFunc(ctx, p)
+	}
+	if m.DisableHAForHostFunc != nil {
+		return m.DisableHAForHostFunc(p)
+	}
+	r1 = notMocked("HostService.DisableHAForHostWithContext")
+	return
+}
+
+// DisableHAForHostAsync records the call and calls DisableHAForHostAsyncFunc, or DisableHAForHostAsyncWithContextFunc if only that is set
+func (m *MockHostService) DisableHAForHostAsync(p *cloudstack.DisableHAForHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableHAForHostAsync", p)
+	if m.DisableHAForHostAsyncFunc != nil {
+		return m.DisableHAForHostAsyncFunc(p)
+	}
+	if m.DisableHAForHostAsyncWithContextFunc != nil {
+		return m.DisableHAForHostAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.DisableHAForHostAsync")
+	return
+}
+
+// DisableHAForHostAsyncWithContext records the call and calls DisableHAForHostAsyncWithContextFunc, or DisableHAForHostAsyncFunc if only that is set
+func (m *MockHostService) DisableHAForHostAsyncWithContext(ctx context.Context, p *cloudstack.DisableHAForHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableHAForHostAsyncWithContext", ctx, p)
+	if m.DisableHAForHostAsyncWithContextFunc != nil {
+		return m.DisableHAForHostAsyncWithContextFunc(ctx, p)
+	}
+	if m.DisableHAForHostAsyncFunc != nil {
+		return m.DisableHAForHostAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.DisableHAForHostAsyncWithContext")
+	return
+}
+
+// NewDisableHAForZoneParams records the call and calls NewDisableHAForZoneParamsFunc
+func (m *MockHostService) NewDisableHAForZoneParams(zoneid string) (r0 *cloudstack.DisableHAForZoneParams) {
+	m.record("NewDisableHAForZoneParams", zoneid)
+	if m.NewDisableHAForZoneParamsFunc != nil {
+		return m.NewDisableHAForZoneParamsFunc(zoneid)
+	}
+	return cloudstack.NewHostService(nil).NewDisableHAForZoneParams(zoneid)
+}
+
+// DisableHAForZone records the call and calls DisableHAForZoneFunc, or DisableHAForZoneWithContextFunc if only that is set
+func (m *MockHostService) DisableHAForZone(p *cloudstack.DisableHAForZoneParams) (r0 *cloudstack.DisableHAForZoneResponse, r1 error) {
+	m.record("DisableHAForZone", p)
+	if m.DisableHAForZoneFunc != nil {
+		return m.DisableHAForZoneFunc(p)
+	}
+	if m.DisableHAForZoneWithContextFunc != nil {
+		return m.DisableHAForZoneWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.DisableHAForZone")
+	return
+}
+
+// DisableHAForZoneWithContext records the call and calls DisableHAForZoneWithContextFunc, or DisableHAForZoneFunc if only that is set
+func (m *MockHostService) DisableHAForZoneWithContext(ctx context.Context, p *cloudstack.DisableHAForZoneParams) (r0 *cloudstack.DisableHAForZoneResponse, r1 error) {
+	m.record("DisableHAForZoneWithContext", ctx, p)
+	if m.DisableHAForZoneWithContextFunc != nil {
+		return m.DisableHAForZoneWithContextFunc(ctx, p)
+	}
+	if m.DisableHAForZoneFunc != nil {
+		return m.DisableHAForZoneFunc(p)
+	}
+	r1 = notMocked("HostService.DisableHAForZoneWithContext")
+	return
+}
+
+// DisableHAForZoneAsync records the call and calls DisableHAForZoneAsyncFunc, or DisableHAForZoneAsyncWithContextFunc if only that is set
+func (m *MockHostService) DisableHAForZoneAsync(p *cloudstack.DisableHAForZoneParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableHAForZoneAsync", p)
+	if m.DisableHAForZoneAsyncFunc != nil {
+		return m.DisableHAForZoneAsyncFunc(p)
+	}
+	if m.DisableHAForZoneAsyncWithContextFunc != nil {
+		return m.DisableHAForZoneAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.DisableHAForZoneAsync")
+	return
+}
+
+// DisableHAForZoneAsyncWithContext records the call and calls DisableHAForZoneAsyncWithContextFunc, or DisableHAForZoneAsyncFunc if only that is set
+func (m *MockHostService) DisableHAForZoneAsyncWithContext(ctx context.Context, p *cloudstack.DisableHAForZoneParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableHAForZoneAsyncWithContext", ctx, p)
+	if m.DisableHAForZoneAsyncWithContextFunc != nil {
+		return m.DisableHAForZoneAsyncWithContextFunc(ctx, p)
+	}
+	if m.DisableHAForZoneAsyncFunc != nil {
+		return m.DisableHAForZoneAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.DisableHAForZoneAsyncWithContext")
+	return
+}
+
+// NewDisableOutOfBandManagementForHostParams records the call and calls NewDisableOutOfBandManagementForHostParamsFunc
+func (m *MockHostService) NewDisableOutOfBandManagementForHostParams(hostid string) (r0 *cloudstack.DisableOutOfBandManagementForHostParams) {
+	m.record("NewDisableOutOfBandManagementForHostParams", hostid)
+	if m.NewDisableOutOfBandManagementForHostParamsFunc != nil {
+		return m.NewDisableOutOfBandManagementForHostParamsFunc(hostid)
+	}
+	return cloudstack.NewHostService(nil).NewDisableOutOfBandManagementForHostParams(hostid)
+}
+
+// DisableOutOfBandManagementForHost records the call and calls DisableOutOfBandManagementForHostFunc, or DisableOutOfBandManagementForHostWithContextFunc if only that is set
+func (m *MockHostService) DisableOutOfBandManagementForHost(p *cloudstack.DisableOutOfBandManagementForHostParams) (r0 *cloudstack.DisableOutOfBandManagementForHostResponse, r1 error) {
+	m.record("DisableOutOfBandManagementForHost", p)
+	if m.DisableOutOfBandManagementForHostFunc != nil {
+		return m.DisableOutOfBandManagementForHostFunc(p)
+	}
+	if m.DisableOutOfBandManagementForHostWithContextFunc != nil {
+		return m.DisableOutOfBandManagementForHostWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.DisableOutOfBandManagementForHost")
+	return
+}
+
+// DisableOutOfBandManagementForHostWithContext records the call and calls DisableOutOfBandManagementForHostWithContextFunc, or DisableOutOfBandManagementForHostFunc if only that is set
+func (m *MockHostService) DisableOutOfBandManagementForHostWithContext(ctx context.Context, p *cloudstack.DisableOutOfBandManagementForHostParams) (r0 *cloudstack.DisableOutOfBandManagementForHostResponse, r1 error) {
+	m.record("DisableOutOfBandManagementForHostWithContext", ctx, p)
+	if m.DisableOutOfBandManagementForHostWithContextFunc != nil {
+		return m.DisableOutOfBandManagementForHostWithContextFunc(ctx, p)
+	}
+	if m.DisableOutOfBandManagementForHostFunc != nil {
+		return m.DisableOutOfBandManagementForHostFunc(p)
+	}
+	r1 = notMocked("HostService.DisableOutOfBandManagementForHostWithContext")
+	return
+}
+
+// DisableOutOfBandManagementForHostAsync records the call and calls DisableOutOfBandManagementForHostAsyncFunc, or DisableOutOfBandManagementForHostAsyncWithContextFunc if only that is set
+func (m *MockHostService) DisableOutOfBandManagementForHostAsync(p *cloudstack.DisableOutOfBandManagementForHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableOutOfBandManagementForHostAsync", p)
+	if m.DisableOutOfBandManagementForHostAsyncFunc != nil {
+		return m.DisableOutOfBandManagementForHostAsyncFunc(p)
+	}
+	if m.DisableOutOfBandManagementForHostAsyncWithContextFunc != nil {
+		return m.DisableOutOfBandManagementForHostAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.DisableOutOfBandManagementForHostAsync")
+	return
+}
+
+// DisableOutOfBandManagementForHostAsyncWithContext records the call and calls DisableOutOfBandManagementForHostAsyncWithContextFunc, or DisableOutOfBandManagementForHostAsyncFunc if only that is set
+func (m *MockHostService) DisableOutOfBandManagementForHostAsyncWithContext(ctx context.Context, p *cloudstack.DisableOutOfBandManagementForHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableOutOfBandManagementForHostAsyncWithContext", ctx, p)
+	if m.DisableOutOfBandManagementForHostAsyncWithContextFunc != nil {
+		return m.DisableOutOfBandManagementForHostAsyncWithContextFunc(ctx, p)
+	}
+	if m.DisableOutOfBandManagementForHostAsyncFunc != nil {
+		return m.DisableOutOfBandManagementForHostAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.DisableOutOfBandManagementForHostAsyncWithContext")
+	return
+}
+
+// NewEnableHAForClusterParams records the call and calls NewEnableHAForClusterParamsFunc
+func (m *MockHostService) NewEnableHAForClusterParams(clusterid string) (r0 *cloudstack.EnableHAForClusterParams) {
+	m.record("NewEnableHAForClusterParams", clusterid)
+	if m.NewEnableHAForClusterParamsFunc != nil {
+		return m.NewEnableHAForClusterParamsFunc(clusterid)
+	}
+	return cloudstack.NewHostService(nil).NewEnableHAForClusterParams(clusterid)
+}
+
+// EnableHAForCluster records the call and calls EnableHAForClusterFunc, or EnableHAForClusterWithContextFunc if only that is set
+func (m *MockHostService) EnableHAForCluster(p *cloudstack.EnableHAForClusterParams) (r0 *cloudstack.EnableHAForClusterResponse, r1 error) {
+	m.record("EnableHAForCluster", p)
+	if m.EnableHAForClusterFunc != nil {
+		return m.EnableHAForClusterFunc(p)
+	}
+	if m.EnableHAForClusterWithContextFunc != nil {
+		return m.EnableHAForClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.EnableHAForCluster")
+	return
+}
+
+// EnableHAForClusterWithContext records the call and calls EnableHAForClusterWithContextFunc, or EnableHAForClusterFunc if only that is set
+func (m *MockHostService) EnableHAForClusterWithContext(ctx context.Context, p *cloudstack.EnableHAForClusterParams) (r0 *cloudstack.EnableHAForClusterResponse, r1 error) {
+	m.record("EnableHAForClusterWithContext", ctx, p)
+	if m.EnableHAForClusterWithContextFunc != nil {
+		return m.EnableHAForClusterWithContextFunc(ctx, p)
+	}
+	if m.EnableHAForClusterFunc != nil {
+		return m.EnableHAForClusterFunc(p)
+	}
+	r1 = notMocked("HostService.EnableHAForClusterWithContext")
+	return
+}
+
+// EnableHAForClusterAsync records the call and calls EnableHAForClusterAsyncFunc, or EnableHAForClusterAsyncWithContextFunc if only that is set
+func (m *MockHostService) EnableHAForClusterAsync(p *cloudstack.EnableHAForClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableHAForClusterAsync", p)
+	if m.EnableHAForClusterAsyncFunc != nil {
+		return m.EnableHAForClusterAsyncFunc(p)
+	}
+	if m.EnableHAForClusterAsyncWithContextFunc != nil {
+		return m.EnableHAForClusterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.EnableHAForClusterAsync")
+	return
+}
+
+// EnableHAForClusterAsyncWithContext records the call and calls EnableHAForClusterAsyncWithContextFunc, or EnableHAForClusterAsyncFunc if only that is set
+func (m *MockHostService) EnableHAForClusterAsyncWithContext(ctx context.Context, p *cloudstack.EnableHAForClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableHAForClusterAsyncWithContext", ctx, p)
+	if m.EnableHAForClusterAsyncWithContextFunc != nil {
+		return m.EnableHAForClusterAsyncWithContextFunc(ctx, p)
+	}
+	if m.EnableHAForClusterAsyncFunc != nil {
+		return m.EnableHAForClusterAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.EnableHAForClusterAsyncWithContext")
+	return
+}
+
+// NewEnableHAForHostParams records the call and calls NewEnableHAForHostParamsFunc
+func (m *MockHostService) NewEnableHAForHostParams(hostid string) (r0 *cloudstack.EnableHAForHostParams) {
+	m.record("NewEnableHAForHostParams", hostid)
+	if m.NewEnableHAForHostParamsFunc != nil {
+		return m.NewEnableHAForHostParamsFunc(hostid)
+	}
+	return cloudstack.NewHostService(nil).NewEnableHAForHostParams(hostid)
+}
+
+// EnableHAForHost records the call and calls EnableHAForHostFunc, or EnableHAForHostWithContextFunc if only that is set
+func (m *MockHostService) EnableHAForHost(p *cloudstack.EnableHAForHostParams) (r0 *cloudstack.EnableHAForHostResponse, r1 error) {
+	m.record("EnableHAForHost", p)
+	if m.EnableHAForHostFunc != nil {
+		return m.EnableHAForHostFunc(p)
+	}
+	if m.EnableHAForHostWithContextFunc != nil {
+		return m.EnableHAForHostWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.EnableHAForHost")
+	return
+}
+
+// EnableHAForHostWithContext records the call and calls EnableHAForHostWithContextFunc, or EnableHAForHostFunc if only that is set
+func (m *MockHostService) EnableHAForHostWithContext(ctx context.Context, p *cloudstack.EnableHAForHostParams) (r0 *cloudstack.EnableHAForHostResponse, r1 error) {
+	m.record("EnableHAForHostWithContext", ctx, p)
+	if m.EnableHAForHostWithContextFunc != nil {
+		return m.EnableHAForHostWithContextFunc(ctx, p)
+	}
+	if m.EnableHAForHostFunc != nil {
+		return m.EnableHAForHostFunc(p)
+	}
+	r1 = notMocked("HostService.EnableHAForHostWithContext")
+	return
+}
+
+// EnableHAForHostAsync records the call and calls EnableHAForHostAsyncFunc, or EnableHAForHostAsyncWithContextFunc if only that is set
+func (m *MockHostService) EnableHAForHostAsync(p *cloudstack.EnableHAForHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableHAForHostAsync", p)
+	if m.EnableHAForHostAsyncFunc != nil {
+		return m.EnableHAForHostAsyncFunc(p)
+	}
+	if m.EnableHAForHostAsyncWithContextFunc != nil {
+		return m.EnableHAForHostAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.EnableHAForHostAsync")
+	return
+}
+
+// EnableHAForHostAsyncWithContext records the call and calls EnableHAForHostAsyncWithContextFunc, or EnableHAForHostAsyncFunc if only that is set
+func (m *MockHostService) EnableHAForHostAsyncWithContext(ctx context.Context, p *cloudstack.EnableHAForHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableHAForHostAsyncWithContext", ctx, p)
+	if m.EnableHAForHostAsyncWithContextFunc != nil {
+		return m.EnableHAForHostAsyncWithContextFunc(ctx, p)
+	}
+	if m.EnableHAForHostAsyncFunc != nil {
+		return m.EnableHAForHostAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.EnableHAForHostAsyncWithContext")
+	return
+}
+
+// NewEnableHAForZoneParams records the call and calls NewEnableHAForZoneParamsFunc
+func (m *MockHostService) NewEnableHAForZoneParams(zoneid string) (r0 *cloudstack.EnableHAForZoneParams) {
+	m.record("NewEnableHAForZoneParams", zoneid)
+	if m.NewEnableHAForZoneParamsFunc != nil {
+		return m.NewEnableHAForZoneParamsFunc(zoneid)
+	}
+	return cloudstack.NewHostService(nil).NewEnableHAForZoneParams(zoneid)
+}
+
+// EnableHAForZone records the call and calls EnableHAForZoneFunc, or EnableHAForZoneWithContextFunc if only that is set
+func (m *MockHostService) EnableHAForZone(p *cloudstack.EnableHAForZoneParams) (r0 *cloudstack.EnableHAForZoneResponse, r1 error) {
+	m.record("EnableHAForZone", p)
+	if m.EnableHAForZoneFunc != nil {
+		return m.EnableHAForZoneFunc(p)
+	}
+	if m.EnableHAForZoneWithContextFunc != nil {
+		return m.EnableHAForZoneWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.EnableHAForZone")
+	return
+}
+
+// EnableHAForZoneWithContext records the call and calls EnableHAForZoneWithContextFunc, or EnableHAForZoneFunc if only that is set
+func (m *MockHostService) EnableHAForZoneWithContext(ctx context.Context, p *cloudstack.EnableHAForZoneParams) (r0 *cloudstack.EnableHAForZoneResponse, r1 error) {
+	m.record("EnableHAForZoneWithContext", ctx, p)
+	if m.EnableHAForZoneWithContextFunc != nil {
+		return m.EnableHAForZoneWithContextFunc(ctx, p)
+	}
+	if m.EnableHAForZoneFunc != nil {
+		return m.EnableHAForZoneFunc(p)
+	}
+	r1 = notMocked("HostService.EnableHAForZoneWithContext")
+	return
+}
+
+// EnableHAForZoneAsync records the call and calls EnableHAForZoneAsyncFunc, or EnableHAForZoneAsyncWithContextFunc if only that is set
+func (m *MockHostService) EnableHAForZoneAsync(p *cloudstack.EnableHAForZoneParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableHAForZoneAsync", p)
+	if m.EnableHAForZoneAsyncFunc != nil {
+		return m.EnableHAForZoneAsyncFunc(p)
+	}
+	if m.EnableHAForZoneAsyncWithContextFunc != nil {
+		return m.EnableHAForZoneAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.EnableHAForZoneAsync")
+	return
+}
+
+// EnableHAForZoneAsyncWithContext records the call and calls EnableHAForZoneAsyncWithContextFunc, or EnableHAForZoneAsyncFunc if only that is set
+func (m *MockHostService) EnableHAForZoneAsyncWithContext(ctx context.Context, p *cloudstack.EnableHAForZoneParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableHAForZoneAsyncWithContext", ctx, p)
+	if m.EnableHAForZoneAsyncWithContextFunc != nil {
+		return m.EnableHAForZoneAsyncWithContextFunc(ctx, p)
+	}
+	if m.EnableHAForZoneAsyncFunc != nil {
+		return m.EnableHAForZoneAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.EnableHAForZoneAsyncWithContext")
+	return
+}
+
+// NewEnableOutOfBandManagementForHostParams records the call and calls NewEnableOutOfBandManagementForHostParamsFunc
+func (m *MockHostService) NewEnableOutOfBandManagementForHostParams(hostid string) (r0 *cloudstack.EnableOutOfBandManagementForHostParams) {
+	m.record("NewEnableOutOfBandManagementForHostParams", hostid)
+	if m.NewEnableOutOfBandManagementForHostParamsFunc != nil {
+		return m.NewEnableOutOfBandManagementForHostParamsFunc(hostid)
+	}
+	return cloudstack.NewHostService(nil).NewEnableOutOfBandManagementForHostParams(hostid)
+}
+
+// EnableOutOfBandManagementForHost records the call and calls EnableOutOfBandManagementForHostFunc, or EnableOutOfBandManagementForHostWithContextFunc if only that is set
+func (m *MockHostService) EnableOutOfBandManagementForHost(p *cloudstack.EnableOutOfBandManagementForHostParams) (r0 *cloudstack.EnableOutOfBandManagementForHostResponse, r1 error) {
+	m.record("EnableOutOfBandManagementForHost", p)
+	if m.EnableOutOfBandManagementForHostFunc != nil {
+		return m.EnableOutOfBandManagementForHostFunc(p)
+	}
+	if m.EnableOutOfBandManagementForHostWithContextFunc != nil {
+		return m.EnableOutOfBandManagementForHostWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.EnableOutOfBandManagementForHost")
+	return
+}
+
+// EnableOutOfBandManagementForHostWithContext records the call and calls EnableOutOfBandManagementForHostWithContextFunc, or EnableOutOfBandManagementForHostFunc if only that is set
+func (m *MockHostService) EnableOutOfBandManagementForHostWithContext(ctx context.Context, p *cloudstack.EnableOutOfBandManagementForHostParams) (r0 *cloudstack.EnableOutOfBandManagementForHostResponse, r1 error) {
+	m.record("EnableOutOfBandManagementForHostWithContext", ctx, p)
+	if m.EnableOutOfBandManagementForHostWithContextFunc != nil {
+		return m.EnableOutOfBandManagementForHostWithContextFunc(ctx, p)
+	}
+	if m.EnableOutOfBandManagementForHostFunc != nil {
+		return m.EnableOutOfBandManagementForHostFunc(p)
+	}
+	r1 = notMocked("HostService.EnableOutOfBandManagementForHostWithContext")
+	return
+}
+
+// EnableOutOfBandManagementForHostAsync records the call and calls EnableOutOfBandManagementForHostAsyncFunc, or EnableOutOfBandManagementForHostAsyncWithContextFunc if only that is set
+func (m *MockHostService) EnableOutOfBandManagementForHostAsync(p *cloudstack.EnableOutOfBandManagementForHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableOutOfBandManagementForHostAsync", p)
+	if m.EnableOutOfBandManagementForHostAsyncFunc != nil {
+		return m.EnableOutOfBandManagementForHostAsyncFunc(p)
+	}
+	if m.EnableOutOfBandManagementForHostAsyncWithContextFunc != nil {
+		return m.EnableOutOfBandManagementForHostAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.EnableOutOfBandManagementForHostAsync")
+	return
+}
+
+// EnableOutOfBandManagementForHostAsyncWithContext records the call and calls EnableOutOfBandManagementForHostAsyncWithContextFunc, or EnableOutOfBandManagementForHostAsyncFunc if only that is set
+func (m *MockHostService) EnableOutOfBandManagementForHostAsyncWithContext(ctx context.Context, p *cloudstack.EnableOutOfBandManagementForHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableOutOfBandManagementForHostAsyncWithContext", ctx, p)
+	if m.EnableOutOfBandManagementForHostAsyncWithContextFunc != nil {
+		return m.EnableOutOfBandManagementForHostAsyncWithContextFunc(ctx, p)
+	}
+	if m.EnableOutOfBandManagementForHostAsyncFunc != nil {
+		return m.EnableOutOfBandManagementForHostAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.EnableOutOfBandManagementForHostAsyncWithContext")
+	return
+}
+
+// NewFindHostsForMigrationParams records the call and calls NewFindHostsForMigrationParamsFunc
+func (m *MockHostService) NewFindHostsForMigrationParams(virtualmachineid string) (r0 *cloudstack.FindHostsForMigrationParams) {
+	m.record("NewFindHostsForMigrationParams", virtualmachineid)
+	if m.NewFindHostsForMigrationParamsFunc != nil {
+		return m.NewFindHostsForMigrationParamsFunc(virtualmachineid)
+	}
+	return cloudstack.NewHostService(nil).NewFindHostsForMigrationParams(virtualmachineid)
+}
+
+// FindHostsForMigration records the call and calls FindHostsForMigrationFunc, or FindHostsForMigrationWithContextFunc if only that is set
+func (m *MockHostService) FindHostsForMigration(p *cloudstack.FindHostsForMigrationParams) (r0 *cloudstack.FindHostsForMigrationResponse, r1 error) {
+	m.record("FindHostsForMigration", p)
+	if m.FindHostsForMigrationFunc != nil {
+		return m.FindHostsForMigrationFunc(p)
+	}
+	if m.FindHostsForMigrationWithContextFunc != nil {
+		return m.FindHostsForMigrationWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.FindHostsForMigration")
+	return
+}
+
+// FindHostsForMigrationWithContext records the call and calls FindHostsForMigrationWithContextFunc, or FindHostsForMigrationFunc if only that is set
+func (m *MockHostService) FindHostsForMigrationWithContext(ctx context.Context, p *cloudstack.FindHostsForMigrationParams) (r0 *cloudstack.FindHostsForMigrationResponse, r1 error) {
+	m.record("FindHostsForMigrationWithContext", ctx, p)
+	if m.FindHostsForMigrationWithContextFunc != nil {
+		return m.FindHostsForMigrationWithContextFunc(ctx, p)
+	}
+	if m.FindHostsForMigrationFunc != nil {
+		return m.FindHostsForMigrationFunc(p)
+	}
+	r1 = notMocked("HostService.FindHostsForMigrationWithContext")
+	return
+}
+
+// NewListDedicatedHostsParams records the call and calls NewListDedicatedHostsParamsFunc
+func (m *MockHostService) NewListDedicatedHostsParams() (r0 *cloudstack.ListDedicatedHostsParams) {
+	m.record("NewListDedicatedHostsParams")
+	if m.NewListDedicatedHostsParamsFunc != nil {
+		return m.NewListDedicatedHostsParamsFunc()
+	}
+	return cloudstack.NewHostService(nil).NewListDedicatedHostsParams()
+}
+
+// ListDedicatedHosts records the call and calls ListDedicatedHostsFunc, or ListDedicatedHostsWithContextFunc if only that is set
+func (m *MockHostService) ListDedicatedHosts(p *cloudstack.ListDedicatedHostsParams) (r0 *cloudstack.ListDedicatedHostsResponse, r1 error) {
+	m.record("ListDedicatedHosts", p)
+	if m.ListDedicatedHostsFunc != nil {
+		return m.ListDedicatedHostsFunc(p)
+	}
+	if m.ListDedicatedHostsWithContextFunc != nil {
+		return m.ListDedicatedHostsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ListDedicatedHosts")
+	return
+}
+
+// ListDedicatedHostsWithContext records the call and calls ListDedicatedHostsWithContextFunc, or ListDedicatedHostsFunc if only that is set
+func (m *MockHostService) ListDedicatedHostsWithContext(ctx context.Context, p *cloudstack.ListDedicatedHostsParams) (r0 *cloudstack.ListDedicatedHostsResponse, r1 error) {
+	m.record("ListDedicatedHostsWithContext", ctx, p)
+	if m.ListDedicatedHostsWithContextFunc != nil {
+		return m.ListDedicatedHostsWithContextFunc(ctx, p)
+	}
+	if m.ListDedicatedHostsFunc != nil {
+		return m.ListDedicatedHostsFunc(p)
+	}
+	r1 = notMocked("HostService.ListDedicatedHostsWithContext")
+	return
+}
+
+// NewListDedicatedHostsPager records the call and calls NewListDedicatedHostsPagerFunc
+func (m *MockHostService) NewListDedicatedHostsPager(p *cloudstack.ListDedicatedHostsParams) (r0 *cloudstack.ListDedicatedHostsPager) {
+	m.record("NewListDedicatedHostsPager", p)
+	if m.NewListDedicatedHostsPagerFunc != nil {
+		return m.NewListDedicatedHostsPagerFunc(p)
+	}
+	return
+}
+
+// ListDedicatedHostsAll records the call and calls ListDedicatedHostsAllFunc, or ListDedicatedHostsAllWithContextFunc if only that is set
+func (m *MockHostService) ListDedicatedHostsAll(p *cloudstack.ListDedicatedHostsParams) (r0 *cloudstack.ListDedicatedHostsResponse, r1 error) {
+	m.record("ListDedicatedHostsAll", p)
+	if m.ListDedicatedHostsAllFunc != nil {
+		return m.ListDedicatedHostsAllFunc(p)
+	}
+	if m.ListDedicatedHostsAllWithContextFunc != nil {
+		return m.ListDedicatedHostsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ListDedicatedHostsAll")
+	return
+}
+
+// ListDedicatedHostsAllWithContext records the call and calls ListDedicatedHostsAllWithContextFunc, or ListDedicatedHostsAllFunc if only that is set
+func (m *MockHostService) ListDedicatedHostsAllWithContext(ctx context.Context, p *cloudstack.ListDedicatedHostsParams) (r0 *cloudstack.ListDedicatedHostsResponse, r1 error) {
+	m.record("ListDedicatedHostsAllWithContext", ctx, p)
+	if m.ListDedicatedHostsAllWithContextFunc != nil {
+		return m.ListDedicatedHostsAllWithContextFunc(ctx, p)
+	}
+	if m.ListDedicatedHostsAllFunc != nil {
+		return m.ListDedicatedHostsAllFunc(p)
+	}
+	r1 = notMocked("HostService.ListDedicatedHostsAllWithContext")
+	return
+}
+
+// NewListHostHAProvidersParams records the call and calls NewListHostHAProvidersParamsFunc
+func (m *MockHostService) NewListHostHAProvidersParams(hypervisor string) (r0 *cloudstack.ListHostHAProvidersParams) {
+	m.record("NewListHostHAProvidersParams", hypervisor)
+	if m.NewListHostHAProvidersParamsFunc != nil {
+		return m.NewListHostHAProvidersParamsFunc(hypervisor)
+	}
+	return cloudstack.NewHostService(nil).NewListHostHAProvidersParams(hypervisor)
+}
+
+// ListHostHAProviders records the call and calls ListHostHAProvidersFunc, or ListHostHAProvidersWithContextFunc if only that is set
+func (m *MockHostService) ListHostHAProviders(p *cloudstack.ListHostHAProvidersParams) (r0 *cloudstack.ListHostHAProvidersResponse, r1 error) {
+	m.record("ListHostHAProviders", p)
+	if m.ListHostHAProvidersFunc != nil {
+		return m.ListHostHAProvidersFunc(p)
+	}
+	if m.ListHostHAProvidersWithContextFunc != nil {
+		return m.ListHostHAProvidersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ListHostHAProviders")
+	return
+}
+
+// ListHostHAProvidersWithContext records the call and calls ListHostHAProvidersWithContextFunc, or ListHostHAProvidersFunc if only that is set
+func (m *MockHostService) ListHostHAProvidersWithContext(ctx context.Context, p *cloudstack.ListHostHAProvidersParams) (r0 *cloudstack.ListHostHAProvidersResponse, r1 error) {
+	m.record("ListHostHAProvidersWithContext", ctx, p)
+	if m.ListHostHAProvidersWithContextFunc != nil {
+		return m.ListHostHAProvidersWithContextFunc(ctx, p)
+	}
+	if m.ListHostHAProvidersFunc != nil {
+		return m.ListHostHAProvidersFunc(p)
+	}
+	r1 = notMocked("HostService.ListHostHAProvidersWithContext")
+	return
+}
+
+// NewListHostHAResourcesParams records the call and calls NewListHostHAResourcesParamsFunc
+func (m *MockHostService) NewListHostHAResourcesParams() (r0 *cloudstack.ListHostHAResourcesParams) {
+	m.record("NewListHostHAResourcesParams")
+	if m.NewListHostHAResourcesParamsFunc != nil {
+		return m.NewListHostHAResourcesParamsFunc()
+	}
+	return cloudstack.NewHostService(nil).NewListHostHAResourcesParams()
+}
+
+// ListHostHAResources records the call and calls ListHostHAResourcesFunc, or ListHostHAResourcesWithContextFunc if only that is set
+func (m *MockHostService) ListHostHAResources(p *cloudstack.ListHostHAResourcesParams) (r0 *cloudstack.ListHostHAResourcesResponse, r1 error) {
+	m.record("ListHostHAResources", p)
+	if m.ListHostHAResourcesFunc != nil {
+		return m.ListHostHAResourcesFunc(p)
+	}
+	if m.ListHostHAResourcesWithContextFunc != nil {
+		return m.ListHostHAResourcesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ListHostHAResources")
+	return
+}
+
+// ListHostHAResourcesWithContext records the call and calls ListHostHAResourcesWithContextFunc, or ListHostHAResourcesFunc if only that is set
+func (m *MockHostService) ListHostHAResourcesWithContext(ctx context.Context, p *cloudstack.ListHostHAResourcesParams) (r0 *cloudstack.ListHostHAResourcesResponse, r1 error) {
+	m.record("ListHostHAResourcesWithContext", ctx, p)
+	if m.ListHostHAResourcesWithContextFunc != nil {
+		return m.ListHostHAResourcesWithContextFunc(ctx, p)
+	}
+	if m.ListHostHAResourcesFunc != nil {
+		return m.ListHostHAResourcesFunc(p)
+	}
+	r1 = notMocked("HostService.ListHostHAResourcesWithContext")
+	return
+}
+
+// NewListHostTagsParams records the call and calls NewListHostTagsParamsFunc
+func (m *MockHostService) NewListHostTagsParams() (r0 *cloudstack.ListHostTagsParams) {
+	m.record("NewListHostTagsParams")
+	if m.NewListHostTagsParamsFunc != nil {
+		return m.NewListHostTagsParamsFunc()
+	}
+	return cloudstack.NewHostService(nil).NewListHostTagsParams()
+}
+
+// GetHostTagID records the call and calls GetHostTagIDFunc, or GetHostTagIDWithContextFunc if only that is set
+func (m *MockHostService) GetHostTagID(keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetHostTagID", keyword, opts)
+	if m.GetHostTagIDFunc != nil {
+		return m.GetHostTagIDFunc(keyword, opts...)
+	}
+	if m.GetHostTagIDWithContextFunc != nil {
+		return m.GetHostTagIDWithContextFunc(context.Background(), keyword, opts...)
+	}
+	r2 = notMocked("HostService.GetHostTagID")
+	return
+}
+
+// GetHostTagIDWithContext records the call and calls GetHostTagIDWithContextFunc, or GetHostTagIDFunc if only that is set
+func (m *MockHostService) GetHostTagIDWithContext(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetHostTagIDWithContext", ctx, keyword, opts)
+	if m.GetHostTagIDWithContextFunc != nil {
+		return m.GetHostTagIDWithContextFunc(ctx, keyword, opts...)
+	}
+	if m.GetHostTagIDFunc != nil {
+		return m.GetHostTagIDFunc(keyword, opts...)
+	}
+	r2 = notMocked("HostService.GetHostTagIDWithContext")
+	return
+}
+
+// ListHostTags records the call and calls ListHostTagsFunc, or ListHostTagsWithContextFunc if only that is set
+func (m *MockHostService) ListHostTags(p *cloudstack.ListHostTagsParams) (r0 *cloudstack.ListHostTagsResponse, r1 error) {
+	m.record("ListHostTags", p)
+	if m.ListHostTagsFunc != nil {
+		return m.ListHostTagsFunc(p)
+	}
+	if m.ListHostTagsWithContextFunc != nil {
+		return m.ListHostTagsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ListHostTags")
+	return
+}
+
+// ListHostTagsWithContext records the call and calls ListHostTagsWithContextFunc, or ListHostTagsFunc if only that is set
+func (m *MockHostService) ListHostTagsWithContext(ctx context.Context, p *cloudstack.ListHostTagsParams) (r0 *cloudstack.ListHostTagsResponse, r1 error) {
+	m.record("ListHostTagsWithContext", ctx, p)
+	if m.ListHostTagsWithContextFunc != nil {
+		return m.ListHostTagsWithContextFunc(ctx, p)
+	}
+	if m.ListHostTagsFunc != nil {
+		return m.ListHostTagsFunc(p)
+	}
+	r1 = notMocked("HostService.ListHostTagsWithContext")
+	return
+}
+
+// NewListHostTagsPager records the call and calls NewListHostTagsPagerFunc
+func (m *MockHostService) NewListHostTagsPager(p *cloudstack.ListHostTagsParams) (r0 *cloudstack.ListHostTagsPager) {
+	m.record("NewListHostTagsPager", p)
+	if m.NewListHostTagsPagerFunc != nil {
+		return m.NewListHostTagsPagerFunc(p)
+	}
+	return
+}
+
+// ListHostTagsAll records the call and calls ListHostTagsAllFunc, or ListHostTagsAllWithContextFunc if only that is set
+func (m *MockHostService) ListHostTagsAll(p *cloudstack.ListHostTagsParams) (r0 *cloudstack.ListHostTagsResponse, r1 error) {
+	m.record("ListHostTagsAll", p)
+	if m.ListHostTagsAllFunc != nil {
+		return m.ListHostTagsAllFunc(p)
+	}
+	if m.ListHostTagsAllWithContextFunc != nil {
+		return m.ListHostTagsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ListHostTagsAll")
+	return
+}
+
+// ListHostTagsAllWithContext records the call and calls ListHostTagsAllWithContextFunc, or ListHostTagsAllFunc if only that is set
+func (m *MockHostService) ListHostTagsAllWithContext(ctx context.Context, p *cloudstack.ListHostTagsParams) (r0 *cloudstack.ListHostTagsResponse, r1 error) {
+	m.record("ListHostTagsAllWithContext", ctx, p)
+	if m.ListHostTagsAllWithContextFunc != nil {
+		return m.ListHostTagsAllWithContextFunc(ctx, p)
+	}
+	if m.ListHostTagsAllFunc != nil {
+		return m.ListHostTagsAllFunc(p)
+	}
+	r1 = notMocked("HostService.ListHostTagsAllWithContext")
+	return
+}
+
+// NewListHostsParams records the call and calls NewListHostsParamsFunc
+func (m *MockHostService) NewListHostsParams() (r0 *cloudstack.ListHostsParams) {
+	m.record("NewListHostsParams")
+	if m.NewListHostsParamsFunc != nil {
+		return m.NewListHostsParamsFunc()
+	}
+	return cloudstack.NewHostService(nil).NewListHostsParams()
+}
+
+// GetHostID records the call and calls GetHostIDFunc, or GetHostIDWithContextFunc if only that is set
+func (m *MockHostService) GetHostID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetHostID", name, opts)
+	if m.GetHostIDFunc != nil {
+		return m.GetHostIDFunc(name, opts...)
+	}
+	if m.GetHostIDWithContextFunc != nil {
+		return m.GetHostIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("HostService.GetHostID")
+	return
+}
+
+// GetHostIDWithContext records the call and calls GetHostIDWithContextFunc, or GetHostIDFunc if only that is set
+func (m *MockHostService) GetHostIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetHostIDWithContext", ctx, name, opts)
+	if m.GetHostIDWithContextFunc != nil {
+		return m.GetHostIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetHostIDFunc != nil {
+		return m.GetHostIDFunc(name, opts...)
+	}
+	r2 = notMocked("HostService.GetHostIDWithContext")
+	return
+}
+
+// GetHostByName records the call and calls GetHostByNameFunc, or GetHostByNameWithContextFunc if only that is set
+func (m *MockHostService) GetHostByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Host, r1 int, r2 error) {
+	m.record("GetHostByName", name, opts)
+	if m.GetHostByNameFunc != nil {
+		return m.GetHostByNameFunc(name, opts...)
+	}
+	if m.GetHostByNameWithContextFunc != nil {
+		return m.GetHostByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("HostService.GetHostByName")
+	return
+}
+
+// GetHostByNameWithContext records the call and calls GetHostByNameWithContextFunc, or GetHostByNameFunc if only that is set
+func (m *MockHostService) GetHostByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Host, r1 int, r2 error) {
+	m.record("GetHostByNameWithContext", ctx, name, opts)
+	if m.GetHostByNameWithContextFunc != nil {
+		return m.GetHostByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetHostByNameFunc != nil {
+		return m.GetHostByNameFunc(name, opts...)
+	}
+	r2 = notMocked("HostService.GetHostByNameWithContext")
+	return
+}
+
+// GetHostByID records the call and calls GetHostByIDFunc, or GetHostByIDWithContextFunc if only that is set
+func (m *MockHostService) GetHostByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Host, r1 int, r2 error) {
+	m.record("GetHostByID", id, opts)
+	if m.GetHostByIDFunc != nil {
+		return m.GetHostByIDFunc(id, opts...)
+	}
+	if m.GetHostByIDWithContextFunc != nil {
+		return m.GetHostByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("HostService.GetHostByID")
+	return
+}
+
+// GetHostByIDWithContext records the call and calls GetHostByIDWithContextFunc, or GetHostByIDFunc if only that is set
+func (m *MockHostService) GetHostByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Host, r1 int, r2 error) {
+	m.record("GetHostByIDWithContext", ctx, id, opts)
+	if m.GetHostByIDWithContextFunc != nil {
+		return m.GetHostByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetHostByIDFunc != nil {
+		return m.GetHostByIDFunc(id, opts...)
+	}
+	r2 = notMocked("HostService.GetHostByIDWithContext")
+	return
+}
+
+// ListHosts records the call and calls ListHostsFunc, or ListHostsWithContextFunc if only that is set
+func (m *MockHostService) ListHosts(p *cloudstack.ListHostsParams) (r0 *cloudstack.ListHostsResponse, r1 error) {
+	m.record("ListHosts", p)
+	if m.ListHostsFunc != nil {
+		return m.ListHostsFunc(p)
+	}
+	if m.ListHostsWithContextFunc != nil {
+		return m.ListHostsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ListHosts")
+	return
+}
+
+// ListHostsWithContext records the call and calls ListHostsWithContextFunc, or ListHostsFunc if only that is set
+func (m *MockHostService) ListHostsWithContext(ctx context.Context, p *cloudstack.ListHostsParams) (r0 *cloudstack.ListHostsResponse, r1 error) {
+	m.record("ListHostsWithContext", ctx, p)
+	if m.ListHostsWithContextFunc != nil {
+		return m.ListHostsWithContextFunc(ctx, p)
+	}
+	if m.ListHostsFunc != nil {
+		return m.ListHostsFunc(p)
+	}
+	r1 = notMocked("HostService.ListHostsWithContext")
+	return
+}
+
+// NewListHostsPager records the call and calls NewListHostsPagerFunc
+func (m *MockHostService) NewListHostsPager(p *cloudstack.ListHostsParams) (r0 *cloudstack.ListHostsPager) {
+	m.record("NewListHostsPager", p)
+	if m.NewListHostsPagerFunc != nil {
+		return m.NewListHostsPagerFunc(p)
+	}
+	return
+}
+
+// ListHostsAll records the call and calls ListHostsAllFunc, or ListHostsAllWithContextFunc if only that is set
+func (m *MockHostService) ListHostsAll(p *cloudstack.ListHostsParams) (r0 *cloudstack.ListHostsResponse, r1 error) {
+	m.record("ListHostsAll", p)
+	if m.ListHostsAllFunc != nil {
+		return m.ListHostsAllFunc(p)
+	}
+	if m.ListHostsAllWithContextFunc != nil {
+		return m.ListHostsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ListHostsAll")
+	return
+}
+
+// ListHostsAllWithContext records the call and calls ListHostsAllWithContextFunc, or ListHostsAllFunc if only that is set
+func (m *MockHostService) ListHostsAllWithContext(ctx context.Context, p *cloudstack.ListHostsParams) (r0 *cloudstack.ListHostsResponse, r1 error) {
+	m.record("ListHostsAllWithContext", ctx, p)
+	if m.ListHostsAllWithContextFunc != nil {
+		return m.ListHostsAllWithContextFunc(ctx, p)
+	}
+	if m.ListHostsAllFunc != nil {
+		return m.ListHostsAllFunc(p)
+	}
+	r1 = notMocked("HostService.ListHostsAllWithContext")
+	return
+}
+
+// NewListHostsMetricsParams records the call and calls NewListHostsMetricsParamsFunc
+func (m *MockHostService) NewListHostsMetricsParams() (r0 *cloudstack.ListHostsMetricsParams) {
+	m.record("NewListHostsMetricsParams")
+	if m.NewListHostsMetricsParamsFunc != nil {
+		return m.NewListHostsMetricsParamsFunc()
+	}
+	return cloudstack.NewHostService(nil).NewListHostsMetricsParams()
+}
+
+// GetHostsMetricID records the call and calls GetHostsMetricIDFunc, or GetHostsMetricIDWithContextFunc if only that is set
+func (m *MockHostService) GetHostsMetricID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetHostsMetricID", name, opts)
+	if m.GetHostsMetricIDFunc != nil {
+		return m.GetHostsMetricIDFunc(name, opts...)
+	}
+	if m.GetHostsMetricIDWithContextFunc != nil {
+		return m.GetHostsMetricIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("HostService.GetHostsMetricID")
+	return
+}
+
+// GetHostsMetricIDWithContext records the call and calls GetHostsMetricIDWithContextFunc, or GetHostsMetricIDFunc if only that is set
+func (m *MockHostService) GetHostsMetricIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetHostsMetricIDWithContext", ctx, name, opts)
+	if m.GetHostsMetricIDWithContextFunc != nil {
+		return m.GetHostsMetricIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetHostsMetricIDFunc != nil {
+		return m.GetHostsMetricIDFunc(name, opts...)
+	}
+	r2 = notMocked("HostService.GetHostsMetricIDWithContext")
+	return
+}
+
+// GetHostsMetricByName records the call and calls GetHostsMetricByNameFunc, or GetHostsMetricByNameWithContextFunc if only that is set
+func (m *MockHostService) GetHostsMetricByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.HostsMetric, r1 int, r2 error) {
+	m.record("GetHostsMetricByName", name, opts)
+	if m.GetHostsMetricByNameFunc != nil {
+		return m.GetHostsMetricByNameFunc(name, opts...)
+	}
+	if m.GetHostsMetricByNameWithContextFunc != nil {
+		return m.GetHostsMetricByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("HostService.GetHostsMetricByName")
+	return
+}
+
+// GetHostsMetricByNameWithContext records the call and calls GetHostsMetricByNameWithContextFunc, or GetHostsMetricByNameFunc if only that is set
+func (m *MockHostService) GetHostsMetricByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.HostsMetric, r1 int, r2 error) {
+	m.record("GetHostsMetricByNameWithContext", ctx, name, opts)
+	if m.GetHostsMetricByNameWithContextFunc != nil {
+		return m.GetHostsMetricByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetHostsMetricByNameFunc != nil {
+		return m.GetHostsMetricByNameFunc(name, opts...)
+	}
+	r2 = notMocked("HostService.GetHostsMetricByNameWithContext")
+	return
+}
+
+// GetHostsMetricByID records the call and calls GetHostsMetricByIDFunc, or GetHostsMetricByIDWithContextFunc if only that is set
+func (m *MockHostService) GetHostsMetricByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.HostsMetric, r1 int, r2 error) {
+	m.record("GetHostsMetricByID", id, opts)
+	if m.GetHostsMetricByIDFunc != nil {
+		return m.GetHostsMetricByIDFunc(id, opts...)
+	}
+	if m.GetHostsMetricByIDWithContextFunc != nil {
+		return m.GetHostsMetricByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("HostService.GetHostsMetricByID")
+	return
+}
+
+// GetHostsMetricByIDWithContext records the call and calls GetHostsMetricByIDWithContextFunc, or GetHostsMetricByIDFunc if only that is set
+func (m *MockHostService) GetHostsMetricByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.HostsMetric, r1 int, r2 error) {
+	m.record("GetHostsMetricByIDWithContext", ctx, id, opts)
+	if m.GetHostsMetricByIDWithContextFunc != nil {
+		return m.GetHostsMetricByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetHostsMetricByIDFunc != nil {
+		return m.GetHostsMetricByIDFunc(id, opts...)
+	}
+	r2 = notMocked("HostService.GetHostsMetricByIDWithContext")
+	return
+}
+
+// ListHostsMetrics records the call and calls ListHostsMetricsFunc, or ListHostsMetricsWithContextFunc if only that is set
+func (m *MockHostService) ListHostsMetrics(p *cloudstack.ListHostsMetricsParams) (r0 *cloudstack.ListHostsMetricsResponse, r1 error) {
+	m.record("ListHostsMetrics", p)
+	if m.ListHostsMetricsFunc != nil {
+		return m.ListHostsMetricsFunc(p)
+	}
+	if m.ListHostsMetricsWithContextFunc != nil {
+		return m.ListHostsMetricsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ListHostsMetrics")
+	return
+}
+
+// ListHostsMetricsWithContext records the call and calls ListHostsMetricsWithContextFunc, or ListHostsMetricsFunc if only that is set
+func (m *MockHostService) ListHostsMetricsWithContext(ctx context.Context, p *cloudstack.ListHostsMetricsParams) (r0 *cloudstack.ListHostsMetricsResponse, r1 error) {
+	m.record("ListHostsMetricsWithContext", ctx, p)
+	if m.ListHostsMetricsWithContextFunc != nil {
+		return m.ListHostsMetricsWithContextFunc(ctx, p)
+	}
+	if m.ListHostsMetricsFunc != nil {
+		return m.ListHostsMetricsFunc(p)
+	}
+	r1 = notMocked("HostService.ListHostsMetricsWithContext")
+	return
+}
+
+// NewListHostsMetricsPager records the call and calls NewListHostsMetricsPagerFunc
+func (m *MockHostService) NewListHostsMetricsPager(p *cloudstack.ListHostsMetricsParams) (r0 *cloudstack.ListHostsMetricsPager) {
+	m.record("NewListHostsMetricsPager", p)
+	if m.NewListHostsMetricsPagerFunc != nil {
+		return m.NewListHostsMetricsPagerFunc(p)
+	}
+	return
+}
+
+// ListHostsMetricsAll records the call and calls ListHostsMetricsAllFunc, or ListHostsMetricsAllWithContextFunc if only that is set
+func (m *MockHostService) ListHostsMetricsAll(p *cloudstack.ListHostsMetricsParams) (r0 *cloudstack.ListHostsMetricsResponse, r1 error) {
+	m.record("ListHostsMetricsAll", p)
+	if m.ListHostsMetricsAllFunc != nil {
+		return m.ListHostsMetricsAllFunc(p)
+	}
+	if m.ListHostsMetricsAllWithContextFunc != nil {
+		return m.ListHostsMetricsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ListHostsMetricsAll")
+	return
+}
+
+// ListHostsMetricsAllWithContext records the call and calls ListHostsMetricsAllWithContextFunc, or ListHostsMetricsAllFunc if only that is set
+func (m *MockHostService) ListHostsMetricsAllWithContext(ctx context.Context, p *cloudstack.ListHostsMetricsParams) (r0 *cloudstack.ListHostsMetricsResponse, r1 error) {
+	m.record("ListHostsMetricsAllWithContext", ctx, p)
+	if m.ListHostsMetricsAllWithContextFunc != nil {
+		return m.ListHostsMetricsAllWithContextFunc(ctx, p)
+	}
+	if m.ListHostsMetricsAllFunc != nil {
+		return m.ListHostsMetricsAllFunc(p)
+	}
+	r1 = notMocked("HostService.ListHostsMetricsAllWithContext")
+	return
+}
+
+// NewPrepareHostForMaintenanceParams records the call and calls NewPrepareHostForMaintenanceParamsFunc
+func (m *MockHostService) NewPrepareHostForMaintenanceParams(id string) (r0 *cloudstack.PrepareHostForMaintenanceParams) {
+	m.record("NewPrepareHostForMaintenanceParams", id)
+	if m.NewPrepareHostForMaintenanceParamsFunc != nil {
+		return m.NewPrepareHostForMaintenanceParamsFunc(id)
+	}
+	return cloudstack.NewHostService(nil).NewPrepareHostForMaintenanceParams(id)
+}
+
+// PrepareHostForMaintenance records the call and calls PrepareHostForMaintenanceFunc, or PrepareHostForMaintenanceWithContextFunc if only that is set
+func (m *MockHostService) PrepareHostForMaintenance(p *cloudstack.PrepareHostForMaintenanceParams) (r0 *cloudstack.PrepareHostForMaintenanceResponse, r1 error) {
+	m.record("PrepareHostForMaintenance", p)
+	if m.PrepareHostForMaintenanceFunc != nil {
+		return m.PrepareHostForMaintenanceFunc(p)
+	}
+	if m.PrepareHostForMaintenanceWithContextFunc != nil {
+		return m.PrepareHostForMaintenanceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.PrepareHostForMaintenance")
+	return
+}
+
+// PrepareHostForMaintenanceWithContext records the call and calls PrepareHostForMaintenanceWithContextFunc, or PrepareHostForMaintenanceFunc if only that is set
+func (m *MockHostService) PrepareHostForMaintenanceWithContext(ctx context.Context, p *cloudstack.PrepareHostForMaintenanceParams) (r0 *cloudstack.PrepareHostForMaintenanceResponse, r1 error) {
+	m.record("PrepareHostForMaintenanceWithContext", ctx, p)
+	if m.PrepareHostForMaintenanceWithContextFunc != nil {
+		return m.PrepareHostForMaintenanceWithContextFunc(ctx, p)
+	}
+	if m.PrepareHostForMaintenanceFunc != nil {
+		return m.PrepareHostForMaintenanceFunc(p)
+	}
+	r1 = notMocked("HostService.PrepareHostForMaintenanceWithContext")
+	return
+}
+
+// PrepareHostForMaintenanceAsync records the call and calls PrepareHostForMaintenanceAsyncFunc, or PrepareHostForMaintenanceAsyncWithContextFunc if only that is set
+func (m *MockHostService) PrepareHostForMaintenanceAsync(p *cloudstack.PrepareHostForMaintenanceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("PrepareHostForMaintenanceAsync", p)
+	if m.PrepareHostForMaintenanceAsyncFunc != nil {
+		return m.PrepareHostForMaintenanceAsyncFunc(p)
+	}
+	if m.PrepareHostForMaintenanceAsyncWithContextFunc != nil {
+		return m.PrepareHostForMaintenanceAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.PrepareHostForMaintenanceAsync")
+	return
+}
+
+// PrepareHostForMaintenanceAsyncWithContext records the call and calls PrepareHostForMaintenanceAsyncWithContextFunc, or PrepareHostForMaintenanceAsyncFunc if only that is set
+func (m *MockHostService) PrepareHostForMaintenanceAsyncWithContext(ctx context.Context, p *cloudstack.PrepareHostForMaintenanceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("PrepareHostForMaintenanceAsyncWithContext", ctx, p)
+	if m.PrepareHostForMaintenanceAsyncWithContextFunc != nil {
+		return m.PrepareHostForMaintenanceAsyncWithContextFunc(ctx, p)
+	}
+	if m.PrepareHostForMaintenanceAsyncFunc != nil {
+		return m.PrepareHostForMaintenanceAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.PrepareHostForMaintenanceAsyncWithContext")
+	return
+}
+
+// NewReconnectHostParams records the call and calls NewReconnectHostParamsFunc
+func (m *MockHostService) NewReconnectHostParams(id string) (r0 *cloudstack.ReconnectHostParams) {
+	m.record("NewReconnectHostParams", id)
+	if m.NewReconnectHostParamsFunc != nil {
+		return m.NewReconnectHostParamsFunc(id)
+	}
+	return cloudstack.NewHostService(nil).NewReconnectHostParams(id)
+}
+
+// ReconnectHost records the call and calls ReconnectHostFunc, or ReconnectHostWithContextFunc if only that is set
+func (m *MockHostService) ReconnectHost(p *cloudstack.ReconnectHostParams) (r0 *cloudstack.ReconnectHostResponse, r1 error) {
+	m.record("ReconnectHost", p)
+	if m.ReconnectHostFunc != nil {
+		return m.ReconnectHostFunc(p)
+	}
+	if m.ReconnectHostWithContextFunc != nil {
+		return m.ReconnectHostWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ReconnectHost")
+	return
+}
+
+// ReconnectHostWithContext records the call and calls ReconnectHostWithContextFunc, or ReconnectHostFunc if only that is set
+func (m *MockHostService) ReconnectHostWithContext(ctx context.Context, p *cloudstack.ReconnectHostParams) (r0 *cloudstack.ReconnectHostResponse, r1 error) {
+	m.record("ReconnectHostWithContext", ctx, p)
+	if m.ReconnectHostWithContextFunc != nil {
+		return m.ReconnectHostWithContextFunc(ctx, p)
+	}
+	if m.ReconnectHostFunc != nil {
+		return m.ReconnectHostFunc(p)
+	}
+	r1 = notMocked("HostService.ReconnectHostWithContext")
+	return
+}
+
+// ReconnectHostAsync records the call and calls ReconnectHostAsyncFunc, or ReconnectHostAsyncWithContextFunc if only that is set
+func (m *MockHostService) ReconnectHostAsync(p *cloudstack.ReconnectHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReconnectHostAsync", p)
+	if m.ReconnectHostAsyncFunc != nil {
+		return m.ReconnectHostAsyncFunc(p)
+	}
+	if m.ReconnectHostAsyncWithContextFunc != nil {
+		return m.ReconnectHostAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ReconnectHostAsync")
+	return
+}
+
+// ReconnectHostAsyncWithContext records the call and calls ReconnectHostAsyncWithContextFunc, or ReconnectHostAsyncFunc if only that is set
+func (m *MockHostService) ReconnectHostAsyncWithContext(ctx context.Context, p *cloudstack.ReconnectHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReconnectHostAsyncWithContext", ctx, p)
+	if m.ReconnectHostAsyncWithContextFunc != nil {
+		return m.ReconnectHostAsyncWithContextFunc(ctx, p)
+	}
+	if m.ReconnectHostAsyncFunc != nil {
+		return m.ReconnectHostAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.ReconnectHostAsyncWithContext")
+	return
+}
+
+// NewReleaseDedicatedHostParams records the call and calls NewReleaseDedicatedHostParamsFunc
+func (m *MockHostService) NewReleaseDedicatedHostParams(hostid string) (r0 *cloudstack.ReleaseDedicatedHostParams) {
+	m.record("NewReleaseDedicatedHostParams", hostid)
+	if m.NewReleaseDedicatedHostParamsFunc != nil {
+		return m.NewReleaseDedicatedHostParamsFunc(hostid)
+	}
+	return cloudstack.NewHostService(nil).NewReleaseDedicatedHostParams(hostid)
+}
+
+// ReleaseDedicatedHost records the call and calls ReleaseDedicatedHostFunc, or ReleaseDedicatedHostWithContextFunc if only that is set
+func (m *MockHostService) ReleaseDedicatedHost(p *cloudstack.ReleaseDedicatedHostParams) (r0 *cloudstack.ReleaseDedicatedHostResponse, r1 error) {
+	m.record("ReleaseDedicatedHost", p)
+	if m.ReleaseDedicatedHostFunc != nil {
+		return m.ReleaseDedicatedHostFunc(p)
+	}
+	if m.ReleaseDedicatedHostWithContextFunc != nil {
+		return m.ReleaseDedicatedHostWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ReleaseDedicatedHost")
+	return
+}
+
+// ReleaseDedicatedHostWithContext records the call and calls ReleaseDedicatedHostWithContextFunc, or ReleaseDedicatedHostFunc if only that is set
+func (m *MockHostService) ReleaseDedicatedHostWithContext(ctx context.Context, p *cloudstack.ReleaseDedicatedHostParams) (r0 *cloudstack.ReleaseDedicatedHostResponse, r1 error) {
+	m.record("ReleaseDedicatedHostWithContext", ctx, p)
+	if m.ReleaseDedicatedHostWithContextFunc != nil {
+		return m.ReleaseDedicatedHostWithContextFunc(ctx, p)
+	}
+	if m.ReleaseDedicatedHostFunc != nil {
+		return m.ReleaseDedicatedHostFunc(p)
+	}
+	r1 = notMocked("HostService.ReleaseDedicatedHostWithContext")
+	return
+}
+
+// ReleaseDedicatedHostAsync records the call and calls ReleaseDedicatedHostAsyncFunc, or ReleaseDedicatedHostAsyncWithContextFunc if only that is set
+func (m *MockHostService) ReleaseDedicatedHostAsync(p *cloudstack.ReleaseDedicatedHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReleaseDedicatedHostAsync", p)
+	if m.ReleaseDedicatedHostAsyncFunc != nil {
+		return m.ReleaseDedicatedHostAsyncFunc(p)
+	}
+	if m.ReleaseDedicatedHostAsyncWithContextFunc != nil {
+		return m.ReleaseDedicatedHostAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ReleaseDedicatedHostAsync")
+	return
+}
+
+// ReleaseDedicatedHostAsyncWithContext records the call and calls ReleaseDedicatedHostAsyncWithContextFunc, or ReleaseDedicatedHostAsyncFunc if only that is set
+func (m *MockHostService) ReleaseDedicatedHostAsyncWithContext(ctx context.Context, p *cloudstack.ReleaseDedicatedHostParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReleaseDedicatedHostAsyncWithContext", ctx, p)
+	if m.ReleaseDedicatedHostAsyncWithContextFunc != nil {
+		return m.ReleaseDedicatedHostAsyncWithContextFunc(ctx, p)
+	}
+	if m.ReleaseDedicatedHostAsyncFunc != nil {
+		return m.ReleaseDedicatedHostAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.ReleaseDedicatedHostAsyncWithContext")
+	return
+}
+
+// NewReleaseHostReservationParams records the call and calls NewReleaseHostReservationParamsFunc
+func (m *MockHostService) NewReleaseHostReservationParams(id string) (r0 *cloudstack.ReleaseHostReservationParams) {
+	m.record("NewReleaseHostReservationParams", id)
+	if m.NewReleaseHostReservationParamsFunc != nil {
+		return m.NewReleaseHostReservationParamsFunc(id)
+	}
+	return cloudstack.NewHostService(nil).NewReleaseHostReservationParams(id)
+}
+
+// ReleaseHostReservation records the call and calls ReleaseHostReservationFunc, or ReleaseHostReservationWithContextFunc if only that is set
+func (m *MockHostService) ReleaseHostReservation(p *cloudstack.ReleaseHostReservationParams) (r0 *cloudstack.ReleaseHostReservationResponse, r1 error) {
+	m.record("ReleaseHostReservation", p)
+	if m.ReleaseHostReservationFunc != nil {
+		return m.ReleaseHostReservationFunc(p)
+	}
+	if m.ReleaseHostReservationWithContextFunc != nil {
+		return m.ReleaseHostReservationWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ReleaseHostReservation")
+	return
+}
+
+// ReleaseHostReservationWithContext records the call and calls ReleaseHostReservationWithContextFunc, or ReleaseHostReservationFunc if only that is set
+func (m *MockHostService) ReleaseHostReservationWithContext(ctx context.Context, p *cloudstack.ReleaseHostReservationParams) (r0 *cloudstack.ReleaseHostReservationResponse, r1 error) {
+	m.record("ReleaseHostReservationWithContext", ctx, p)
+	if m.ReleaseHostReservationWithContextFunc != nil {
+		return m.ReleaseHostReservationWithContextFunc(ctx, p)
+	}
+	if m.ReleaseHostReservationFunc != nil {
+		return m.ReleaseHostReservationFunc(p)
+	}
+	r1 = notMocked("HostService.ReleaseHostReservationWithContext")
+	return
+}
+
+// ReleaseHostReservationAsync records the call and calls ReleaseHostReservationAsyncFunc, or ReleaseHostReservationAsyncWithContextFunc if only that is set
+func (m *MockHostService) ReleaseHostReservationAsync(p *cloudstack.ReleaseHostReservationParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReleaseHostReservationAsync", p)
+	if m.ReleaseHostReservationAsyncFunc != nil {
+		return m.ReleaseHostReservationAsyncFunc(p)
+	}
+	if m.ReleaseHostReservationAsyncWithContextFunc != nil {
+		return m.ReleaseHostReservationAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.ReleaseHostReservationAsync")
+	return
+}
+
+// ReleaseHostReservationAsyncWithContext records the call and calls ReleaseHostReservationAsyncWithContextFunc, or ReleaseHostReservationAsyncFunc if only that is set
+func (m *MockHostService) ReleaseHostReservationAsyncWithContext(ctx context.Context, p *cloudstack.ReleaseHostReservationParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReleaseHostReservationAsyncWithContext", ctx, p)
+	if m.ReleaseHostReservationAsyncWithContextFunc != nil {
+		return m.ReleaseHostReservationAsyncWithContextFunc(ctx, p)
+	}
+	if m.ReleaseHostReservationAsyncFunc != nil {
+		return m.ReleaseHostReservationAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.ReleaseHostReservationAsyncWithContext")
+	return
+}
+
+// NewStartRollingMaintenanceParams records the call and calls NewStartRollingMaintenanceParamsFunc
+func (m *MockHostService) NewStartRollingMaintenanceParams() (r0 *cloudstack.StartRollingMaintenanceParams) {
+	m.record("NewStartRollingMaintenanceParams")
+	if m.NewStartRollingMaintenanceParamsFunc != nil {
+		return m.NewStartRollingMaintenanceParamsFunc()
+	}
+	return cloudstack.NewHostService(nil).NewStartRollingMaintenanceParams()
+}
+
+// StartRollingMaintenance records the call and calls StartRollingMaintenanceFunc, or StartRollingMaintenanceWithContextFunc if only that is set
+func (m *MockHostService) StartRollingMaintenance(p *cloudstack.StartRollingMaintenanceParams) (r0 *cloudstack.StartRollingMaintenanceResponse, r1 error) {
+	m.record("StartRollingMaintenance", p)
+	if m.StartRollingMaintenanceFunc != nil {
+		return m.StartRollingMaintenanceFunc(p)
+	}
+	if m.StartRollingMaintenanceWithContextFunc != nil {
+		return m.StartRollingMaintenanceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.StartRollingMaintenance")
+	return
+}
+
+// StartRollingMaintenanceWithContext records the call and calls StartRollingMaintenanceWithContextFunc, or StartRollingMaintenanceFunc if only that is set
+func (m *MockHostService) StartRollingMaintenanceWithContext(ctx context.Context, p *cloudstack.StartRollingMaintenanceParams) (r0 *cloudstack.StartRollingMaintenanceResponse, r1 error) {
+	m.record("StartRollingMaintenanceWithContext", ctx, p)
+	if m.StartRollingMaintenanceWithContextFunc != nil {
+		return m.StartRollingMaintenanceWithContextFunc(ctx, p)
+	}
+	if m.StartRollingMaintenanceFunc != nil {
+		return m.StartRollingMaintenanceFunc(p)
+	}
+	r1 = notMocked("HostService.StartRollingMaintenanceWithContext")
+	return
+}
+
+// StartRollingMaintenanceAsync records the call and calls StartRollingMaintenanceAsyncFunc, or StartRollingMaintenanceAsyncWithContextFunc if only that is set
+func (m *MockHostService) StartRollingMaintenanceAsync(p *cloudstack.StartRollingMaintenanceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StartRollingMaintenanceAsync", p)
+	if m.StartRollingMaintenanceAsyncFunc != nil {
+		return m.StartRollingMaintenanceAsyncFunc(p)
+	}
+	if m.StartRollingMaintenanceAsyncWithContextFunc != nil {
+		return m.StartRollingMaintenanceAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.StartRollingMaintenanceAsync")
+	return
+}
+
+// StartRollingMaintenanceAsyncWithContext records the call and calls StartRollingMaintenanceAsyncWithContextFunc, or StartRollingMaintenanceAsyncFunc if only that is set
+func (m *MockHostService) StartRollingMaintenanceAsyncWithContext(ctx context.Context, p *cloudstack.StartRollingMaintenanceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StartRollingMaintenanceAsyncWithContext", ctx, p)
+	if m.StartRollingMaintenanceAsyncWithContextFunc != nil {
+		return m.StartRollingMaintenanceAsyncWithContextFunc(ctx, p)
+	}
+	if m.StartRollingMaintenanceAsyncFunc != nil {
+		return m.StartRollingMaintenanceAsyncFunc(p)
+	}
+	r1 = notMocked("HostService.StartRollingMaintenanceAsyncWithContext")
+	return
+}
+
+// NewUpdateHostParams records the call and calls NewUpdateHostParamsFunc
+func (m *MockHostService) NewUpdateHostParams(id string) (r0 *cloudstack.UpdateHostParams) {
+	m.record("NewUpdateHostParams", id)
+	if m.NewUpdateHostParamsFunc != nil {
+		return m.NewUpdateHostParamsFunc(id)
+	}
+	return cloudstack.NewHostService(nil).NewUpdateHostParams(id)
+}
+
+// UpdateHost records the call and calls UpdateHostFunc, or UpdateHostWithContextFunc if only that is set
+func (m *MockHostService) UpdateHost(p *cloudstack.UpdateHostParams) (r0 *cloudstack.UpdateHostResponse, r1 error) {
+	m.record("UpdateHost", p)
+	if m.UpdateHostFunc != nil {
+		return m.UpdateHostFunc(p)
+	}
+	if m.UpdateHostWithContextFunc != nil {
+		return m.UpdateHostWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.UpdateHost")
+	return
+}
+
+// UpdateHostWithContext records the call and calls UpdateHostWithContextFunc, or UpdateHostFunc if only that is set
+func (m *MockHostService) UpdateHostWithContext(ctx context.Context, p *cloudstack.UpdateHostParams) (r0 *cloudstack.UpdateHostResponse, r1 error) {
+	m.record("UpdateHostWithContext", ctx, p)
+	if m.UpdateHostWithContextFunc != nil {
+		return m.UpdateHostWithContextFunc(ctx, p)
+	}
+	if m.UpdateHostFunc != nil {
+		return m.UpdateHostFunc(p)
+	}
+	r1 = notMocked("HostService.UpdateHostWithContext")
+	return
+}
+
+// NewUpdateHostPasswordParams records the call and calls NewUpdateHostPasswordParamsFunc
+func (m *MockHostService) NewUpdateHostPasswordParams(password string, username string) (r0 *cloudstack.UpdateHostPasswordParams) {
+	m.record("NewUpdateHostPasswordParams", password, username)
+	if m.NewUpdateHostPasswordParamsFunc != nil {
+		return m.NewUpdateHostPasswordParamsFunc(password, username)
+	}
+	return cloudstack.NewHostService(nil).NewUpdateHostPasswordParams(password, username)
+}
+
+// UpdateHostPassword records the call and calls UpdateHostPasswordFunc, or UpdateHostPasswordWithContextFunc if only that is set
+func (m *MockHostService) UpdateHostPassword(p *cloudstack.UpdateHostPasswordParams) (r0 *cloudstack.UpdateHostPasswordResponse, r1 error) {
+	m.record("UpdateHostPassword", p)
+	if m.UpdateHostPasswordFunc != nil {
+		return m.UpdateHostPasswordFunc(p)
+	}
+	if m.UpdateHostPasswordWithContextFunc != nil {
+		return m.UpdateHostPasswordWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HostService.UpdateHostPassword")
+	return
+}
+
+// UpdateHostPasswordWithContext records the call and calls UpdateHostPasswordWithContextFunc, or UpdateHostPasswordFunc if only that is set
+func (m *MockHostService) UpdateHostPasswordWithContext(ctx context.Context, p *cloudstack.UpdateHostPasswordParams) (r0 *cloudstack.UpdateHostPasswordResponse, r1 error) {
+	m.record("UpdateHostPasswordWithContext", ctx, p)
+	if m.UpdateHostPasswordWithContextFunc != nil {
+		return m.UpdateHostPasswordWithContextFunc(ctx, p)
+	}
+	if m.UpdateHostPasswordFunc != nil {
+		return m.UpdateHostPasswordFunc(p)
+	}
+	r1 = notMocked("HostService.UpdateHostPasswordWithContext")
+	return
+}
@@ -0,0 +1,219 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockHypervisorService is a mock of cloudstack.HypervisorServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockHypervisorService struct {
+	Recorder
+
+	NewListHypervisorCapabilitiesParamsFunc      func() *cloudstack.ListHypervisorCapabilitiesParams
+	GetHypervisorCapabilityByIDFunc              func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.HypervisorCapability, int, error)
+	GetHypervisorCapabilityByIDWithContextFunc   func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.HypervisorCapability, int, error)
+	ListHypervisorCapabilitiesFunc               func(p *cloudstack.ListHypervisorCapabilitiesParams) (*cloudstack.ListHypervisorCapabilitiesResponse, error)
+	ListHypervisorCapabilitiesWithContextFunc    func(ctx context.Context, p *cloudstack.ListHypervisorCapabilitiesParams) (*cloudstack.ListHypervisorCapabilitiesResponse, error)
+	NewListHypervisorCapabilitiesPagerFunc       func(p *cloudstack.ListHypervisorCapabilitiesParams) *cloudstack.ListHypervisorCapabilitiesPager
+	ListHypervisorCapabilitiesAllFunc            func(p *cloudstack.ListHypervisorCapabilitiesParams) (*cloudstack.ListHypervisorCapabilitiesResponse, error)
+	ListHypervisorCapabilitiesAllWithContextFunc func(ctx context.Context, p *cloudstack.ListHypervisorCapabilitiesParams) (*cloudstack.ListHypervisorCapabilitiesResponse, error)
+	NewListHypervisorsParamsFunc                 func() *cloudstack.ListHypervisorsParams
+	ListHypervisorsFunc                          func(p *cloudstack.ListHypervisorsParams) (*cloudstack.ListHypervisorsResponse, error)
+	ListHypervisorsWithContextFunc               func(ctx context.Context, p *cloudstack.ListHypervisorsParams) (*cloudstack.ListHypervisorsResponse, error)
+	NewUpdateHypervisorCapabilitiesParamsFunc    func() *cloudstack.UpdateHypervisorCapabilitiesParams
+	UpdateHypervisorCapabilitiesFunc             func(p *cloudstack.UpdateHypervisorCapabilitiesParams) (*cloudstack.UpdateHypervisorCapabilitiesResponse, error)
+	UpdateHypervisorCapabilitiesWithContextFunc  func(ctx context.Context, p *cloudstack.UpdateHypervisorCapabilitiesParams) (*cloudstack.UpdateHypervisorCapabilitiesResponse, error)
+}
+
+var _ cloudstack.HypervisorServiceIface = (*MockHypervisorService)(nil)
+
+// NewListHypervisorCapabilitiesParams records the call and calls NewListHypervisorCapabilitiesParamsFunc
+func (m *MockHypervisorService) NewListHypervisorCapabilitiesParams() (r0 *cloudstack.ListHypervisorCapabilitiesParams) {
+	m.record("NewListHypervisorCapabilitiesParams")
+	if m.NewListHypervisorCapabilitiesParamsFunc != nil {
+		return m.NewListHypervisorCapabilitiesParamsFunc()
+	}
+	return cloudstack.NewHypervisorService(nil).NewListHypervisorCapabilitiesParams()
+}
+
+// GetHypervisorCapabilityByID records the call and calls GetHypervisorCapabilityByIDFunc, or GetHypervisorCapabilityByIDWithContextFunc if only that is set
+func (m *MockHypervisorService) GetHypervisorCapabilityByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.HypervisorCapability, r1 int, r2 error) {
+	m.record("GetHypervisorCapabilityByID", id, opts)
+	if m.GetHypervisorCapabilityByIDFunc != nil {
+		return m.GetHypervisorCapabilityByIDFunc(id, opts...)
+	}
+	if m.GetHypervisorCapabilityByIDWithContextFunc != nil {
+		return m.GetHypervisorCapabilityByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("HypervisorService.GetHypervisorCapabilityByID")
+	return
+}
+
+// GetHypervisorCapabilityByIDWithContext records the call and calls GetHypervisorCapabilityByIDWithContextFunc, or GetHypervisorCapabilityByIDFunc if only that is set
+func (m *MockHypervisorService) GetHypervisorCapabilityByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.HypervisorCapability, r1 int, r2 error) {
+	m.record("GetHypervisorCapabilityByIDWithContext", ctx, id, opts)
+	if m.GetHypervisorCapabilityByIDWithContextFunc != nil {
+		return m.GetHypervisorCapabilityByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetHypervisorCapabilityByIDFunc != nil {
+		return m.GetHypervisorCapabilityByIDFunc(id, opts...)
+	}
+	r2 = notMocked("HypervisorService.GetHypervisorCapabilityByIDWithContext")
+	return
+}
+
+// ListHypervisorCapabilities records the call and calls ListHypervisorCapabilitiesFunc, or ListHypervisorCapabilitiesWithContextFunc if only that is set
+func (m *MockHypervisorService) ListHypervisorCapabilities(p *cloudstack.ListHypervisorCapabilitiesParams) (r0 *cloudstack.ListHypervisorCapabilitiesResponse, r1 error) {
+	m.record("ListHypervisorCapabilities", p)
+	if m.ListHypervisorCapabilitiesFunc != nil {
+		return m.ListHypervisorCapabilitiesFunc(p)
+	}
+	if m.ListHypervisorCapabilitiesWithContextFunc != nil {
+		return m.ListHypervisorCapabilitiesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HypervisorService.ListHypervisorCapabilities")
+	return
+}
+
+// ListHypervisorCapabilitiesWithContext records the call and calls ListHypervisorCapabilitiesWithContextFunc, or ListHypervisorCapabilitiesFunc if only that is set
+func (m *MockHypervisorService) ListHypervisorCapabilitiesWithContext(ctx context.Context, p *cloudstack.ListHypervisorCapabilitiesParams) (r0 *cloudstack.ListHypervisorCapabilitiesResponse, r1 error) {
+	m.record("ListHypervisorCapabilitiesWithContext", ctx, p)
+	if m.ListHypervisorCapabilitiesWithContextFunc != nil {
+		return m.ListHypervisorCapabilitiesWithContextFunc(ctx, p)
+	}
+	if m.ListHypervisorCapabilitiesFunc != nil {
+		return m.ListHypervisorCapabilitiesFunc(p)
+	}
+	r1 = notMocked("HypervisorService.ListHypervisorCapabilitiesWithContext")
+	return
+}
+
+// NewListHypervisorCapabilitiesPager records the call and calls NewListHypervisorCapabilitiesPagerFunc
+func (m *MockHypervisorService) NewListHypervisorCapabilitiesPager(p *cloudstack.ListHypervisorCapabilitiesParams) (r0 *cloudstack.ListHypervisorCapabilitiesPager) {
+	m.record("NewListHypervisorCapabilitiesPager", p)
+	if m.NewListHypervisorCapabilitiesPagerFunc != nil {
+		return m.NewListHypervisorCapabilitiesPagerFunc(p)
+	}
+	return
+}
+
+// ListHypervisorCapabilitiesAll records the call and calls ListHypervisorCapabilitiesAllFunc, or ListHypervisorCapabilitiesAllWithContextFunc if only that is set
+func (m *MockHypervisorService) ListHypervisorCapabilitiesAll(p *cloudstack.ListHypervisorCapabilitiesParams) (r0 *cloudstack.ListHypervisorCapabilitiesResponse, r1 error) {
+	m.record("ListHypervisorCapabilitiesAll", p)
+	if m.ListHypervisorCapabilitiesAllFunc != nil {
+		return m.ListHypervisorCapabilitiesAllFunc(p)
+	}
+	if m.ListHypervisorCapabilitiesAllWithContextFunc != nil {
+		return m.ListHypervisorCapabilitiesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HypervisorService.ListHypervisorCapabilitiesAll")
+	return
+}
+
+// ListHypervisorCapabilitiesAllWithContext records the call and calls ListHypervisorCapabilitiesAllWithContextFunc, or ListHypervisorCapabilitiesAllFunc if only that is set
+func (m *MockHypervisorService) ListHypervisorCapabilitiesAllWithContext(ctx context.Context, p *cloudstack.ListHypervisorCapabilitiesParams) (r0 *cloudstack.ListHypervisorCapabilitiesResponse, r1 error) {
+	m.record("ListHypervisorCapabilitiesAllWithContext", ctx, p)
+	if m.ListHypervisorCapabilitiesAllWithContextFunc != nil {
+		return m.ListHypervisorCapabilitiesAllWithContextFunc(ctx, p)
+	}
+	if m.ListHypervisorCapabilitiesAllFunc != nil {
+		return m.ListHypervisorCapabilitiesAllFunc(p)
+	}
+	r1 = notMocked("HypervisorService.ListHypervisorCapabilitiesAllWithContext")
+	return
+}
+
+// NewListHypervisorsParams records the call and calls NewListHypervisorsParamsFunc
+func (m *MockHypervisorService) NewListHypervisorsParams() (r0 *cloudstack.ListHypervisorsParams) {
+	m.record("NewListHypervisorsParams")
+	if m.NewListHypervisorsParamsFunc != nil {
+		return m.NewListHypervisorsParamsFunc()
+	}
+	return cloudstack.NewHypervisorService(nil).NewListHypervisorsParams()
+}
+
+// ListHypervisors records the call and calls ListHypervisorsFunc, or ListHypervisorsWithContextFunc if only that is set
+func (m *MockHypervisorService) ListHypervisors(p *cloudstack.ListHypervisorsParams) (r0 *cloudstack.ListHypervisorsResponse, r1 error) {
+	m.record("ListHypervisors", p)
+	if m.ListHypervisorsFunc != nil {
+		return m.ListHypervisorsFunc(p)
+	}
+	if m.ListHypervisorsWithContextFunc != nil {
+		return m.ListHypervisorsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HypervisorService.ListHypervisors")
+	return
+}
+
+// ListHypervisorsWithContext records the call and calls ListHypervisorsWithContextFunc, or ListHypervisorsFunc if only that is set
+func (m *MockHypervisorService) ListHypervisorsWithContext(ctx context.Context, p *cloudstack.ListHypervisorsParams) (r0 *cloudstack.ListHypervisorsResponse, r1 error) {
+	m.record("ListHypervisorsWithContext", ctx, p)
+	if m.ListHypervisorsWithContextFunc != nil {
+		return m.ListHypervisorsWithContextFunc(ctx, p)
+	}
+	if m.ListHypervisorsFunc != nil {
+		return m.ListHypervisorsFunc(p)
+	}
+	r1 = notMocked("HypervisorService.ListHypervisorsWithContext")
+	return
+}
+
+// NewUpdateHypervisorCapabilitiesParams records the call and calls NewUpdateHypervisorCapabilitiesParamsFunc
+func (m *MockHypervisorService) NewUpdateHypervisorCapabilitiesParams() (r0 *cloudstack.UpdateHypervisorCapabilitiesParams) {
+	m.record("NewUpdateHypervisorCapabilitiesParams")
+	if m.NewUpdateHypervisorCapabilitiesParamsFunc != nil {
+		return m.NewUpdateHypervisorCapabilitiesParamsFunc()
+	}
+	return cloudstack.NewHypervisorService(nil).NewUpdateHypervisorCapabilitiesParams()
+}
+
+// UpdateHypervisorCapabilities records the call and calls UpdateHypervisorCapabilitiesFunc, or UpdateHypervisorCapabilitiesWithContextFunc if only that is set
+func (m *MockHypervisorService) UpdateHypervisorCapabilities(p *cloudstack.UpdateHypervisorCapabilitiesParams) (r0 *cloudstack.UpdateHypervisorCapabilitiesResponse, r1 error) {
+	m.record("UpdateHypervisorCapabilities", p)
+	if m.UpdateHypervisorCapabilitiesFunc != nil {
+		return m.UpdateHypervisorCapabilitiesFunc(p)
+	}
+	if m.UpdateHypervisorCapabilitiesWithContextFunc != nil {
+		return m.UpdateHypervisorCapabilitiesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("HypervisorService.UpdateHypervisorCapabilities")
+	return
+}
+
+// UpdateHypervisorCapabilitiesWithContext records the call and calls UpdateHypervisorCapabilitiesWithContextFunc, or UpdateHypervisorCapabilitiesFunc if only that is set
+func (m *MockHypervisorService) UpdateHypervisorCapabilitiesWithContext(ctx context.Context, p *cloudstack.UpdateHypervisorCapabilitiesParams) (r0 *cloudstack.UpdateHypervisorCapabilitiesResponse, r1 error) {
+	m.record("UpdateHypervisorCapabilitiesWithContext", ctx, p)
+	if m.UpdateHypervisorCapabilitiesWithContextFunc != nil {
+		return m.UpdateHypervisorCapabilitiesWithContextFunc(ctx, p)
+	}
+	if m.UpdateHypervisorCapabilitiesFunc != nil {
+		return m.UpdateHypervisorCapabilitiesFunc(p)
+	}
+	r1 = notMocked("HypervisorService.UpdateHypervisorCapabilitiesWithContext")
+	return
+}
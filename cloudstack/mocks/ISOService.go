@@ -0,0 +1,747 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockISOService is a mock of cloudstack.ISOServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockISOService struct {
+	Recorder
+
+	NewAttachIsoParamsFunc               func(id string, virtualmachineid string) *cloudstack.AttachIsoParams
+	AttachIsoFunc                        func(p *cloudstack.AttachIsoParams) (*cloudstack.AttachIsoResponse, error)
+	AttachIsoWithContextFunc             func(ctx context.Context, p *cloudstack.AttachIsoParams) (*cloudstack.AttachIsoResponse, error)
+	AttachIsoAsyncFunc                   func(p *cloudstack.AttachIsoParams) (*cloudstack.Job, error)
+	AttachIsoAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.AttachIsoParams) (*cloudstack.Job, error)
+	NewCopyIsoParamsFunc                 func(id string) *cloudstack.CopyIsoParams
+	CopyIsoFunc                          func(p *cloudstack.CopyIsoParams) (*cloudstack.CopyIsoResponse, error)
+	CopyIsoWithContextFunc               func(ctx context.Context, p *cloudstack.CopyIsoParams) (*cloudstack.CopyIsoResponse, error)
+	CopyIsoAsyncFunc                     func(p *cloudstack.CopyIsoParams) (*cloudstack.Job, error)
+	CopyIsoAsyncWithContextFunc          func(ctx context.Context, p *cloudstack.CopyIsoParams) (*cloudstack.Job, error)
+	NewDeleteIsoParamsFunc               func(id string) *cloudstack.DeleteIsoParams
+	DeleteIsoFunc                        func(p *cloudstack.DeleteIsoParams) (*cloudstack.DeleteIsoResponse, error)
+	DeleteIsoWithContextFunc             func(ctx context.Context, p *cloudstack.DeleteIsoParams) (*cloudstack.DeleteIsoResponse, error)
+	DeleteIsoAsyncFunc                   func(p *cloudstack.DeleteIsoParams) (*cloudstack.Job, error)
+	DeleteIsoAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.DeleteIsoParams) (*cloudstack.Job, error)
+	NewDetachIsoParamsFunc               func(virtualmachineid string) *cloudstack.DetachIsoParams
+	DetachIsoFunc                        func(p *cloudstack.DetachIsoParams) (*cloudstack.DetachIsoResponse, error)
+	DetachIsoWithContextFunc             func(ctx context.Context, p *cloudstack.DetachIsoParams) (*cloudstack.DetachIsoResponse, error)
+	DetachIsoAsyncFunc                   func(p *cloudstack.DetachIsoParams) (*cloudstack.Job, error)
+	DetachIsoAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.DetachIsoParams) (*cloudstack.Job, error)
+	NewExtractIsoParamsFunc              func(id string, mode string) *cloudstack.ExtractIsoParams
+	ExtractIsoFunc                       func(p *cloudstack.ExtractIsoParams) (*cloudstack.ExtractIsoResponse, error)
+	ExtractIsoWithContextFunc            func(ctx context.Context, p *cloudstack.ExtractIsoParams) (*cloudstack.ExtractIsoResponse, error)
+	ExtractIsoAsyncFunc                  func(p *cloudstack.ExtractIsoParams) (*cloudstack.Job, error)
+	ExtractIsoAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.ExtractIsoParams) (*cloudstack.Job, error)
+	NewGetUploadParamsForIsoParamsFunc   func(displaytext string, format string, name string, zoneid string) *cloudstack.GetUploadParamsForIsoParams
+	GetUploadParamsForIsoFunc            func(p *cloudstack.GetUploadParamsForIsoParams) (*cloudstack.GetUploadParamsForIsoResponse, error)
+	GetUploadParamsForIsoWithContextFunc func(ctx context.Context, p *cloudstack.GetUploadParamsForIsoParams) (*cloudstack.GetUploadParamsForIsoResponse, error)
+	NewListIsoPermissionsParamsFunc      func(id string) *cloudstack.ListIsoPermissionsParams
+	GetIsoPermissionByIDFunc             func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.IsoPermission, int, error)
+	GetIsoPermissionByIDWithContextFunc  func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.IsoPermission, int, error)
+	ListIsoPermissionsFunc               func(p *cloudstack.ListIsoPermissionsParams) (*cloudstack.ListIsoPermissionsResponse, error)
+	ListIsoPermissionsWithContextFunc    func(ctx context.Context, p *cloudstack.ListIsoPermissionsParams) (*cloudstack.ListIsoPermissionsResponse, error)
+	NewListIsosParamsFunc                func() *cloudstack.ListIsosParams
+	GetIsoIDFunc                         func(name string, isofilter string, zoneid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetIsoIDWithContextFunc              func(ctx context.Context, name string, isofilter string, zoneid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetIsoByNameFunc                     func(name string, isofilter string, zoneid string, opts ...cloudstack.OptionFunc) (*cloudstack.Iso, int, error)
+	GetIsoByNameWithContextFunc          func(ctx context.Context, name string, isofilter string, zoneid string, opts ...cloudstack.OptionFunc) (*cloudstack.Iso, int, error)
+	GetIsoByIDFunc                       func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Iso, int, error)
+	GetIsoByIDWithContextFunc            func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Iso, int, error)
+	ListIsosFunc                         func(p *cloudstack.ListIsosParams) (*cloudstack.ListIsosResponse, error)
+	ListIsosWithContextFunc              func(ctx context.Context, p *cloudstack.ListIsosParams) (*cloudstack.ListIsosResponse, error)
+	NewListIsosPagerFunc                 func(p *cloudstack.ListIsosParams) *cloudstack.ListIsosPager
+	ListIsosAllFunc                      func(p *cloudstack.ListIsosParams) (*cloudstack.ListIsosResponse, error)
+	ListIsosAllWithContextFunc           func(ctx context.Context, p *cloudstack.ListIsosParams) (*cloudstack.ListIsosResponse, error)
+	NewRegisterIsoParamsFunc             func(displaytext string, name string, url string, zoneid string) *cloudstack.RegisterIsoParams
+	RegisterIsoFunc                      func(p *cloudstack.RegisterIsoParams) (*cloudstack.RegisterIsoResponse, error)
+	RegisterIsoWithContextFunc           func(ctx context.Context, p *cloudstack.RegisterIsoParams) (*cloudstack.RegisterIsoResponse, error)
+	NewUpdateIsoParamsFunc               func(id string) *cloudstack.UpdateIsoParams
+	UpdateIsoFunc                        func(p *cloudstack.UpdateIsoParams) (*cloudstack.UpdateIsoResponse, error)
+	UpdateIsoWithContextFunc             func(ctx context.Context, p *cloudstack.UpdateIsoParams) (*cloudstack.UpdateIsoResponse, error)
+	NewUpdateIsoPermissionsParamsFunc    func(id string) *cloudstack.UpdateIsoPermissionsParams
+	UpdateIsoPermissionsFunc             func(p *cloudstack.UpdateIsoPermissionsParams) (*cloudstack.UpdateIsoPermissionsResponse, error)
+	UpdateIsoPermissionsWithContextFunc  func(ctx context.Context, p *cloudstack.UpdateIsoPermissionsParams) (*cloudstack.UpdateIsoPermissionsResponse, error)
+}
+
+var _ cloudstack.ISOServiceIface = (*MockISOService)(nil)
+
+// NewAttachIsoParams records the call and calls NewAttachIsoParamsFunc
+func (m *MockISOService) NewAttachIsoParams(id string, virtualmachineid string) (r0 *cloudstack.AttachIsoParams) {
+	m.record("NewAttachIsoParams", id, virtualmachineid)
+	if m.NewAttachIsoParamsFunc != nil {
+		return m.NewAttachIsoParamsFunc(id, virtualmachineid)
+	}
+	return cloudstack.NewISOService(nil).NewAttachIsoParams(id, virtualmachineid)
+}
+
+// AttachIso records the call and calls AttachIsoFunc, or AttachIsoWithContextFunc if only that is set
+func (m *MockISOService) AttachIso(p *cloudstack.AttachIsoParams) (r0 *cloudstack.AttachIsoResponse, r1 error) {
+	m.record("AttachIso", p)
+	if m.AttachIsoFunc != nil {
+		return m.AttachIsoFunc(p)
+	}
+	if m.AttachIsoWithContextFunc != nil {
+		return m.AttachIsoWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.AttachIso")
+	return
+}
+
+// AttachIsoWithContext records the call and calls AttachIsoWithContextFunc, or AttachIsoFunc if only that is set
+func (m *MockISOService) AttachIsoWithContext(ctx context.Context, p *cloudstack.AttachIsoParams) (r0 *cloudstack.AttachIsoResponse, r1 error) {
+	m.record("AttachIsoWithContext", ctx, p)
+	if m.AttachIsoWithContextFunc != nil {
+		return m.AttachIsoWithContextFunc(ctx, p)
+	}
+	if m.AttachIsoFunc != nil {
+		return m.AttachIsoFunc(p)
+	}
+	r1 = notMocked("ISOService.AttachIsoWithContext")
+	return
+}
+
+// AttachIsoAsync records the call and calls AttachIsoAsyncFunc, or AttachIsoAsyncWithContextFunc if only that is set
+func (m *MockISOService) AttachIsoAsync(p *cloudstack.AttachIsoParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AttachIsoAsync", p)
+	if m.AttachIsoAsyncFunc != nil {
+		return m.AttachIsoAsyncFunc(p)
+	}
+	if m.AttachIsoAsyncWithContextFunc != nil {
+		return m.AttachIsoAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.AttachIsoAsync")
+	return
+}
+
+// AttachIsoAsyncWithContext records the call and calls AttachIsoAsyncWithContextFunc, or AttachIsoAsyncFunc if only that is set
+func (m *MockISOService) AttachIsoAsyncWithContext(ctx context.Context, p *cloudstack.AttachIsoParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AttachIsoAsyncWithContext", ctx, p)
+	if m.AttachIsoAsyncWithContextFunc != nil {
+		return m.AttachIsoAsyncWithContextFunc(ctx, p)
+	}
+	if m.AttachIsoAsyncFunc != nil {
+		return m.AttachIsoAsyncFunc(p)
+	}
+	r1 = notMocked("ISOService.AttachIsoAsyncWithContext")
+	return
+}
+
+// NewCopyIsoParams records the call and calls NewCopyIsoParamsFunc
+func (m *MockISOService) NewCopyIsoParams(id string) (r0 *cloudstack.CopyIsoParams) {
+	m.record("NewCopyIsoParams", id)
+	if m.NewCopyIsoParamsFunc != nil {
+		return m.NewCopyIsoParamsFunc(id)
+	}
+	return cloudstack.NewISOService(nil).NewCopyIsoParams(id)
+}
+
+// CopyIso records the call and calls CopyIsoFunc, or CopyIsoWithContextFunc if only that is set
+func (m *MockISOService) CopyIso(p *cloudstack.CopyIsoParams) (r0 *cloudstack.CopyIsoResponse, r1 error) {
+	m.record("CopyIso", p)
+	if m.CopyIsoFunc != nil {
+		return m.CopyIsoFunc(p)
+	}
+	if m.CopyIsoWithContextFunc != nil {
+		return m.CopyIsoWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.CopyIso")
+	return
+}
+
+// CopyIsoWithContext records the call and calls CopyIsoWithContextFunc, or CopyIsoFunc if only that is set
+func (m *MockISOService) CopyIsoWithContext(ctx context.Context, p *cloudstack.CopyIsoParams) (r0 *cloudstack.CopyIsoResponse, r1 error) {
+	m.record("CopyIsoWithContext", ctx, p)
+	if m.CopyIsoWithContextFunc != nil {
+		return m.CopyIsoWithContextFunc(ctx, p)
+	}
+	if m.CopyIsoFunc != nil {
+		return m.CopyIsoFunc(p)
+	}
+	r1 = notMocked("ISOService.CopyIsoWithContext")
+	return
+}
+
+// CopyIsoAsync records the call and calls CopyIsoAsyncFunc, or CopyIsoAsyncWithContextFunc if only that is set
+func (m *MockISOService) CopyIsoAsync(p *cloudstack.CopyIsoParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CopyIsoAsync", p)
+	if m.CopyIsoAsyncFunc != nil {
+		return m.CopyIsoAsyncFunc(p)
+	}
+	if m.CopyIsoAsyncWithContextFunc != nil {
+		return m.CopyIsoAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.CopyIsoAsync")
+	return
+}
+
+// CopyIsoAsyncWithContext records the call and calls CopyIsoAsyncWithContextFunc, or CopyIsoAsyncFunc if only that is set
+func (m *MockISOService) CopyIsoAsyncWithContext(ctx context.Context, p *cloudstack.CopyIsoParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CopyIsoAsyncWithContext", ctx, p)
+	if m.CopyIsoAsyncWithContextFunc != nil {
+		return m.CopyIsoAsyncWithContextFunc(ctx, p)
+	}
+	if m.CopyIsoAsyncFunc != nil {
+		return m.CopyIsoAsyncFunc(p)
+	}
+	r1 = notMocked("ISOService.CopyIsoAsyncWithContext")
+	return
+}
+
+// NewDeleteIsoParams records the call and calls NewDeleteIsoParamsFunc
+func (m *MockISOService) NewDeleteIsoParams(id string) (r0 *cloudstack.DeleteIsoParams) {
+	m.record("NewDeleteIsoParams", id)
+	if m.NewDeleteIsoParamsFunc != nil {
+		return m.NewDeleteIsoParamsFunc(id)
+	}
+	return cloudstack.NewISOService(nil).NewDeleteIsoParams(id)
+}
+
+// DeleteIso records the call and calls DeleteIsoFunc, or DeleteIsoWithContextFunc if only that is set
+func (m *MockISOService) DeleteIso(p *cloudstack.DeleteIsoParams) (r0 *cloudstack.DeleteIsoResponse, r1 error) {
+	m.record("DeleteIso", p)
+	if m.DeleteIsoFunc != nil {
+		return m.DeleteIsoFunc(p)
+	}
+	if m.DeleteIsoWithContextFunc != nil {
+		return m.DeleteIsoWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.DeleteIso")
+	return
+}
+
+// DeleteIsoWithContext records the call and calls DeleteIsoWithContextFunc, or DeleteIsoFunc if only that is set
+func (m *MockISOService) DeleteIsoWithContext(ctx context.Context, p *cloudstack.DeleteIsoParams) (r0 *cloudstack.DeleteIsoResponse, r1 error) {
+	m.record("DeleteIsoWithContext", ctx, p)
+	if m.DeleteIsoWithContextFunc != nil {
+		return m.DeleteIsoWithContextFunc(ctx, p)
+	}
+	if m.DeleteIsoFunc != nil {
+		return m.DeleteIsoFunc(p)
+	}
+	r1 = notMocked("ISOService.DeleteIsoWithContext")
+	return
+}
+
+// DeleteIsoAsync records the call and calls DeleteIsoAsyncFunc, or DeleteIsoAsyncWithContextFunc if only that is set
+func (m *MockISOService) DeleteIsoAsync(p *cloudstack.DeleteIsoParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteIsoAsync", p)
+	if m.DeleteIsoAsyncFunc != nil {
+		return m.DeleteIsoAsyncFunc(p)
+	}
+	if m.DeleteIsoAsyncWithContextFunc != nil {
+		return m.DeleteIsoAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.DeleteIsoAsync")
+	return
+}
+
+// DeleteIsoAsyncWithContext records the call and calls DeleteIsoAsyncWithContextFunc, or DeleteIsoAsyncFunc if only that is set
+func (m *MockISOService) DeleteIsoAsyncWithContext(ctx context.Context, p *cloudstack.DeleteIsoParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteIsoAsyncWithContext", ctx, p)
+	if m.DeleteIsoAsyncWithContextFunc != nil {
+		return m.DeleteIsoAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteIsoAsyncFunc != nil {
+		return m.DeleteIsoAsyncFunc(p)
+	}
+	r1 = notMocked("ISOService.DeleteIsoAsyncWithContext")
+	return
+}
+
+// NewDetachIsoParams records the call and calls NewDetachIsoParamsFunc
+func (m *MockISOService) NewDetachIsoParams(virtualmachineid string) (r0 *cloudstack.DetachIsoParams) {
+	m.record("NewDetachIsoParams", virtualmachineid)
+	if m.NewDetachIsoParamsFunc != nil {
+		return m.NewDetachIsoParamsFunc(virtualmachineid)
+	}
+	return cloudstack.NewISOService(nil).NewDetachIsoParams(virtualmachineid)
+}
+
+// DetachIso records the call and calls DetachIsoFunc, or DetachIsoWithContextFunc if only that is set
+func (m *MockISOService) DetachIso(p *cloudstack.DetachIsoParams) (r0 *cloudstack.DetachIsoResponse, r1 error) {
+	m.record("DetachIso", p)
+	if m.DetachIsoFunc != nil {
+		return m.DetachIsoFunc(p)
+	}
+	if m.DetachIsoWithContextFunc != nil {
+		return m.DetachIsoWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.DetachIso")
+	return
+}
+
+// DetachIsoWithContext records the call and calls DetachIsoWithContextFunc, or DetachIsoFunc if only that is set
+func (m *MockISOService) DetachIsoWithContext(ctx context.Context, p *cloudstack.DetachIsoParams) (r0 *cloudstack.DetachIsoResponse, r1 error) {
+	m.record("DetachIsoWithContext", ctx, p)
+	if m.DetachIsoWithContextFunc != nil {
+		return m.DetachIsoWithContextFunc(ctx, p)
+	}
+	if m.DetachIsoFunc != nil {
+		return m.DetachIsoFunc(p)
+	}
+	r1 = notMocked("ISOService.DetachIsoWithContext")
+	return
+}
+
+// DetachIsoAsync records the call and calls DetachIsoAsyncFunc, or DetachIsoAsyncWithContextFunc if only that is set
+func (m *MockISOService) DetachIsoAsync(p *cloudstack.DetachIsoParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DetachIsoAsync", p)
+	if m.DetachIsoAsyncFunc != nil {
+		return m.DetachIsoAsyncFunc(p)
+	}
+	if m.DetachIsoAsyncWithContextFunc != nil {
+		return m.DetachIsoAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.DetachIsoAsync")
+	return
+}
+
+// DetachIsoAsyncWithContext records the call and calls DetachIsoAsyncWithContextFunc, or DetachIsoAsyncFunc if only that is set
+func (m *MockISOService) DetachIsoAsyncWithContext(ctx context.Context, p *cloudstack.DetachIsoParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DetachIsoAsyncWithContext", ctx, p)
+	if m.DetachIsoAsyncWithContextFunc != nil {
+		return m.DetachIsoAsyncWithContextFunc(ctx, p)
+	}
+	if m.DetachIsoAsyncFunc != nil {
+		return m.DetachIsoAsyncFunc(p)
+	}
+	r1 = notMocked("ISOService.DetachIsoAsyncWithContext")
+	return
+}
+
+// NewExtractIsoParams records the call and calls NewExtractIsoParamsFunc
+func (m *MockISOService) NewExtractIsoParams(id string, mode string) (r0 *cloudstack.ExtractIsoParams) {
+	m.record("NewExtractIsoParams", id, mode)
+	if m.NewExtractIsoParamsFunc != nil {
+		return m.NewExtractIsoParamsFunc(id, mode)
+	}
+	return cloudstack.NewISOService(nil).NewExtractIsoParams(id, mode)
+}
+
+// ExtractIso records the call and calls ExtractIsoFunc, or ExtractIsoWithContextFunc if only that is set
+func (m *MockISOService) ExtractIso(p *cloudstack.ExtractIsoParams) (r0 *cloudstack.ExtractIsoResponse, r1 error) {
+	m.record("ExtractIso", p)
+	if m.ExtractIsoFunc != nil {
+		return m.ExtractIsoFunc(p)
+	}
+	if m.ExtractIsoWithContextFunc != nil {
+		return m.ExtractIsoWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.ExtractIso")
+	return
+}
+
+// ExtractIsoWithContext records the call and calls ExtractIsoWithContextFunc, or ExtractIsoFunc if only that is set
+func (m *MockISOService) ExtractIsoWithContext(ctx context.Context, p *cloudstack.ExtractIsoParams) (r0 *cloudstack.ExtractIsoResponse, r1 error) {
+	m.record("ExtractIsoWithContext", ctx, p)
+	if m.ExtractIsoWithContextFunc != nil {
+		return m.ExtractIsoWithContextFunc(ctx, p)
+	}
+	if m.ExtractIsoFunc != nil {
+		return m.ExtractIsoFunc(p)
+	}
+	r1 = notMocked("ISOService.ExtractIsoWithContext")
+	return
+}
+
+// ExtractIsoAsync records the call and calls ExtractIsoAsyncFunc, or ExtractIsoAsyncWithContextFunc if only that is set
+func (m *MockISOService) ExtractIsoAsync(p *cloudstack.ExtractIsoParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ExtractIsoAsync", p)
+	if m.ExtractIsoAsyncFunc != nil {
+		return m.ExtractIsoAsyncFunc(p)
+	}
+	if m.ExtractIsoAsyncWithContextFunc != nil {
+		return m.ExtractIsoAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.ExtractIsoAsync")
+	return
+}
+
+// ExtractIsoAsyncWithContext records the call and calls ExtractIsoAsyncWithContextFunc, or ExtractIsoAsyncFunc if only that is set
+func (m *MockISOService) ExtractIsoAsyncWithContext(ctx context.Context, p *cloudstack.ExtractIsoParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ExtractIsoAsyncWithContext", ctx, p)
+	if m.ExtractIsoAsyncWithContextFunc != nil {
+		return m.ExtractIsoAsyncWithContextFunc(ctx, p)
+	}
+	if m.ExtractIsoAsyncFunc != nil {
+		return m.ExtractIsoAsyncFunc(p)
+	}
+	r1 = notMocked("ISOService.ExtractIsoAsyncWithContext")
+	return
+}
+
+// NewGetUploadParamsForIsoParams records the call and calls NewGetUploadParamsForIsoParamsFunc
+func (m *MockISOService) NewGetUploadParamsForIsoParams(displaytext string, format string, name string, zoneid string) (r0 *cloudstack.GetUploadParamsForIsoParams) {
+	m.record("NewGetUploadParamsForIsoParams", displaytext, format, name, zoneid)
+	if m.NewGetUploadParamsForIsoParamsFunc != nil {
+		return m.NewGetUploadParamsForIsoParamsFunc(displaytext, format, name, zoneid)
+	}
+	return cloudstack.NewISOService(nil).NewGetUploadParamsForIsoParams(displaytext, format, name, zoneid)
+}
+
+// GetUploadParamsForIso records the call and calls GetUploadParamsForIsoFunc, or GetUploadParamsForIsoWithContextFunc if only that is set
+func (m *MockISOService) GetUploadParamsForIso(p *cloudstack.GetUploadParamsForIsoParams) (r0 *cloudstack.GetUploadParamsForIsoResponse, r1 error) {
+	m.record("GetUploadParamsForIso", p)
+	if m.GetUploadParamsForIsoFunc != nil {
+		return m.GetUploadParamsForIsoFunc(p)
+	}
+	if m.GetUploadParamsForIsoWithContextFunc != nil {
+		return m.GetUploadParamsForIsoWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.GetUploadParamsForIso")
+	return
+}
+
+// GetUploadParamsForIsoWithContext records the call and calls GetUploadParamsForIsoWithContextFunc, or GetUploadParamsForIsoFunc if only that is set
+func (m *MockISOService) GetUploadParamsForIsoWithContext(ctx context.Context, p *cloudstack.GetUploadParamsForIsoParams) (r0 *cloudstack.GetUploadParamsForIsoResponse, r1 error) {
+	m.record("GetUploadParamsForIsoWithContext", ctx, p)
+	if m.GetUploadParamsForIsoWithContextFunc != nil {
+		return m.GetUploadParamsForIsoWithContextFunc(ctx, p)
+	}
+	if m.GetUploadParamsForIsoFunc != nil {
+		return m.GetUploadParamsForIsoFunc(p)
+	}
+	r1 = notMocked("ISOService.GetUploadParamsForIsoWithContext")
+	return
+}
+
+// NewListIsoPermissionsParams records the call and calls NewListIsoPermissionsParamsFunc
+func (m *MockISOService) NewListIsoPermissionsParams(id string) (r0 *cloudstack.ListIsoPermissionsParams) {
+	m.record("NewListIsoPermissionsParams", id)
+	if m.NewListIsoPermissionsParamsFunc != nil {
+		return m.NewListIsoPermissionsParamsFunc(id)
+	}
+	return cloudstack.NewISOService(nil).NewListIsoPermissionsParams(id)
+}
+
+// GetIsoPermissionByID records the call and calls GetIsoPermissionByIDFunc, or GetIsoPermissionByIDWithContextFunc if only that is set
+func (m *MockISOService) GetIsoPermissionByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.IsoPermission, r1 int, r2 error) {
+	m.record("GetIsoPermissionByID", id, opts)
+	if m.GetIsoPermissionByIDFunc != nil {
+		return m.GetIsoPermissionByIDFunc(id, opts...)
+	}
+	if m.GetIsoPermissionByIDWithContextFunc != nil {
+		return m.GetIsoPermissionByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("ISOService.GetIsoPermissionByID")
+	return
+}
+
+// GetIsoPermissionByIDWithContext records the call and calls GetIsoPermissionByIDWithContextFunc, or GetIsoPermissionByIDFunc if only that is set
+func (m *MockISOService) GetIsoPermissionByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.IsoPermission, r1 int, r2 error) {
+	m.record("GetIsoPermissionByIDWithContext", ctx, id, opts)
+	if m.GetIsoPermissionByIDWithContextFunc != nil {
+		return m.GetIsoPermissionByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetIsoPermissionByIDFunc != nil {
+		return m.GetIsoPermissionByIDFunc(id, opts...)
+	}
+	r2 = notMocked("ISOService.GetIsoPermissionByIDWithContext")
+	return
+}
+
+// ListIsoPermissions records the call and calls ListIsoPermissionsFunc, or ListIsoPermissionsWithContextFunc if only that is set
+func (m *MockISOService) ListIsoPermissions(p *cloudstack.ListIsoPermissionsParams) (r0 *cloudstack.ListIsoPermissionsResponse, r1 error) {
+	m.record("ListIsoPermissions", p)
+	if m.ListIsoPermissionsFunc != nil {
+		return m.ListIsoPermissionsFunc(p)
+	}
+	if m.ListIsoPermissionsWithContextFunc != nil {
+		return m.ListIsoPermissionsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.ListIsoPermissions")
+	return
+}
+
+// ListIsoPermissionsWithContext records the call and calls ListIsoPermissionsWithContextFunc, or ListIsoPermissionsFunc if only that is set
+func (m *MockISOService) ListIsoPermissionsWithContext(ctx context.Context, p *cloudstack.ListIsoPermissionsParams) (r0 *cloudstack.ListIsoPermissionsResponse, r1 error) {
+	m.record("ListIsoPermissionsWithContext", ctx, p)
+	if m.ListIsoPermissionsWithContextFunc != nil {
+		return m.ListIsoPermissionsWithContextFunc(ctx, p)
+	}
+	if m.ListIsoPermissionsFunc != nil {
+		return m.ListIsoPermissionsFunc(p)
+	}
+	r1 = notMocked("ISOService.ListIsoPermissionsWithContext")
+	return
+}
+
+// NewListIsosParams records the call and calls NewListIsosParamsFunc
+func (m *MockISOService) NewListIsosParams() (r0 *cloudstack.ListIsosParams) {
+	m.record("NewListIsosParams")
+	if m.NewListIsosParamsFunc != nil {
+		return m.NewListIsosParamsFunc()
+	}
+	return cloudstack.NewISOService(nil).NewListIsosParams()
+}
+
+// GetIsoID records the call and calls GetIsoIDFunc, or GetIsoIDWithContextFunc if only that is set
+func (m *MockISOService) GetIsoID(name string, isofilter string, zoneid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetIsoID", name, isofilter, zoneid, opts)
+	if m.GetIsoIDFunc != nil {
+		return m.GetIsoIDFunc(name, isofilter, zoneid, opts...)
+	}
+	if m.GetIsoIDWithContextFunc != nil {
+		return m.GetIsoIDWithContextFunc(context.Background(), name, isofilter, zoneid, opts...)
+	}
+	r2 = notMocked("ISOService.GetIsoID")
+	return
+}
+
+// GetIsoIDWithContext records the call and calls GetIsoIDWithContextFunc, or GetIsoIDFunc if only that is set
+func (m *MockISOService) GetIsoIDWithContext(ctx context.Context, name string, isofilter string, zoneid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetIsoIDWithContext", ctx, name, isofilter, zoneid, opts)
+	if m.GetIsoIDWithContextFunc != nil {
+		return m.GetIsoIDWithContextFunc(ctx, name, isofilter, zoneid, opts...)
+	}
+	if m.GetIsoIDFunc != nil {
+		return m.GetIsoIDFunc(name, isofilter, zoneid, opts...)
+	}
+	r2 = notMocked("ISOService.GetIsoIDWithContext")
+	return
+}
+
+// GetIsoByName records the call and calls GetIsoByNameFunc, or GetIsoByNameWithContextFunc if only that is set
+func (m *MockISOService) GetIsoByName(name string, isofilter string, zoneid string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Iso, r1 int, r2 error) {
+	m.record("GetIsoByName", name, isofilter, zoneid, opts)
+	if m.GetIsoByNameFunc != nil {
+		return m.GetIsoByNameFunc(name, isofilter, zoneid, opts...)
+	}
+	if m.GetIsoByNameWithContextFunc != nil {
+		return m.GetIsoByNameWithContextFunc(context.Background(), name, isofilter, zoneid, opts...)
+	}
+	r2 = notMocked("ISOService.GetIsoByName")
+	return
+}
+
+// GetIsoByNameWithContext records the call and calls GetIsoByNameWithContextFunc, or GetIsoByNameFunc if only that is set
+func (m *MockISOService) GetIsoByNameWithContext(ctx context.Context, name string, isofilter string, zoneid string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Iso, r1 int, r2 error) {
+	m.record("GetIsoByNameWithContext", ctx, name, isofilter, zoneid, opts)
+	if m.GetIsoByNameWithContextFunc != nil {
+		return m.GetIsoByNameWithContextFunc(ctx, name, isofilter, zoneid, opts...)
+	}
+	if m.GetIsoByNameFunc != nil {
+		return m.GetIsoByNameFunc(name, isofilter, zoneid, opts...)
+	}
+	r2 = notMocked("ISOService.GetIsoByNameWithContext")
+	return
+}
+
+// GetIsoByID records the call and calls GetIsoByIDFunc, or GetIsoByIDWithContextFunc if only that is set
+func (m *MockISOService) GetIsoByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Iso, r1 int, r2 error) {
+	m.record("GetIsoByID", id, opts)
+	if m.GetIsoByIDFunc != nil {
+		return m.GetIsoByIDFunc(id, opts...)
+	}
+	if m.GetIsoByIDWithContextFunc != nil {
+		return m.GetIsoByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("ISOService.GetIsoByID")
+	return
+}
+
+// GetIsoByIDWithContext records the call and calls GetIsoByIDWithContextFunc, or GetIsoByIDFunc if only that is set
+func (m *MockISOService) GetIsoByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Iso, r1 int, r2 error) {
+	m.record("GetIsoByIDWithContext", ctx, id, opts)
+	if m.GetIsoByIDWithContextFunc != nil {
+		return m.GetIsoByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetIsoByIDFunc != nil {
+		return m.GetIsoByIDFunc(id, opts...)
+	}
+	r2 = notMocked("ISOService.GetIsoByIDWithContext")
+	return
+}
+
+// ListIsos records the call and calls ListIsosFunc, or ListIsosWithContextFunc if only that is set
+func (m *MockISOService) ListIsos(p *cloudstack.ListIsosParams) (r0 *cloudstack.ListIsosResponse, r1 error) {
+	m.record("ListIsos", p)
+	if m.ListIsosFunc != nil {
+		return m.ListIsosFunc(p)
+	}
+	if m.ListIsosWithContextFunc != nil {
+		return m.ListIsosWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.ListIsos")
+	return
+}
+
+// ListIsosWithContext records the call and calls ListIsosWithContextFunc, or ListIsosFunc if only that is set
+func (m *MockISOService) ListIsosWithContext(ctx context.Context, p *cloudstack.ListIsosParams) (r0 *cloudstack.ListIsosResponse, r1 error) {
+	m.record("ListIsosWithContext", ctx, p)
+	if m.ListIsosWithContextFunc != nil {
+		return m.ListIsosWithContextFunc(ctx, p)
+	}
+	if m.ListIsosFunc != nil {
+		return m.ListIsosFunc(p)
+	}
+	r1 = notMocked("ISOService.ListIsosWithContext")
+	return
+}
+
+// NewListIsosPager records the call and calls NewListIsosPagerFunc
+func (m *MockISOService) NewListIsosPager(p *cloudstack.ListIsosParams) (r0 *cloudstack.ListIsosPager) {
+	m.record("NewListIsosPager", p)
+	if m.NewListIsosPagerFunc != nil {
+		return m.NewListIsosPagerFunc(p)
+	}
+	return
+}
+
+// ListIsosAll records the call and calls ListIsosAllFunc, or ListIsosAllWithContextFunc if only that is set
+func (m *MockISOService) ListIsosAll(p *cloudstack.ListIsosParams) (r0 *cloudstack.ListIsosResponse, r1 error) {
+	m.record("ListIsosAll", p)
+	if m.ListIsosAllFunc != nil {
+		return m.ListIsosAllFunc(p)
+	}
+	if m.ListIsosAllWithContextFunc != nil {
+		return m.ListIsosAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.ListIsosAll")
+	return
+}
+
+// ListIsosAllWithContext records the call and calls ListIsosAllWithContextFunc, or ListIsosAllFunc if only that is set
+func (m *MockISOService) ListIsosAllWithContext(ctx context.Context, p *cloudstack.ListIsosParams) (r0 *cloudstack.ListIsosResponse, r1 error) {
+	m.record("ListIsosAllWithContext", ctx, p)
+	if m.ListIsosAllWithContextFunc != nil {
+		return m.ListIsosAllWithContextFunc(ctx, p)
+	}
+	if m.ListIsosAllFunc != nil {
+		return m.ListIsosAllFunc(p)
+	}
+	r1 = notMocked("ISOService.ListIsosAllWithContext")
+	return
+}
+
+// NewRegisterIsoParams records the call and calls NewRegisterIsoParamsFunc
+func (m *MockISOService) NewRegisterIsoParams(displaytext string, name string, url string, zoneid string) (r0 *cloudstack.RegisterIsoParams) {
+	m.record("NewRegisterIsoParams", displaytext, name, url, zoneid)
+	if m.NewRegisterIsoParamsFunc != nil {
+		return m.NewRegisterIsoParamsFunc(displaytext, name, url, zoneid)
+	}
+	return cloudstack.NewISOService(nil).NewRegisterIsoParams(displaytext, name, url, zoneid)
+}
+
+// RegisterIso records the call and calls RegisterIsoFunc, or RegisterIsoWithContextFunc if only that is set
+func (m *MockISOService) RegisterIso(p *cloudstack.RegisterIsoParams) (r0 *cloudstack.RegisterIsoResponse, r1 error) {
+	m.record("RegisterIso", p)
+	if m.RegisterIsoFunc != nil {
+		return m.RegisterIsoFunc(p)
+	}
+	if m.RegisterIsoWithContextFunc != nil {
+		return m.RegisterIsoWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.RegisterIso")
+	return
+}
+
+// RegisterIsoWithContext records the call and calls RegisterIsoWithContextFunc, or RegisterIsoFunc if only that is set
+func (m *MockISOService) RegisterIsoWithContext(ctx context.Context, p *cloudstack.RegisterIsoParams) (r0 *cloudstack.RegisterIsoResponse, r1 error) {
+	m.record("RegisterIsoWithContext", ctx, p)
+	if m.RegisterIsoWithContextFunc != nil {
+		return m.RegisterIsoWithContextFunc(ctx, p)
+	}
+	if m.RegisterIsoFunc != nil {
+		return m.RegisterIsoFunc(p)
+	}
+	r1 = notMocked("ISOService.RegisterIsoWithContext")
+	return
+}
+
+// NewUpdateIsoParams records the call and calls NewUpdateIsoParamsFunc
+func (m *MockISOService) NewUpdateIsoParams(id string) (r0 *cloudstack.UpdateIsoParams) {
+	m.record("NewUpdateIsoParams", id)
+	if m.NewUpdateIsoParamsFunc != nil {
+		return m.NewUpdateIsoParamsFunc(id)
+	}
+	return cloudstack.NewISOService(nil).NewUpdateIsoParams(id)
+}
+
+// UpdateIso records the call and calls UpdateIsoFunc, or UpdateIsoWithContextFunc if only that is set
+func (m *MockISOService) UpdateIso(p *cloudstack.UpdateIsoParams) (r0 *cloudstack.UpdateIsoResponse, r1 error) {
+	m.record("UpdateIso", p)
+	if m.UpdateIsoFunc != nil {
+		return m.UpdateIsoFunc(p)
+	}
+	if m.UpdateIsoWithContextFunc != nil {
+		return m.UpdateIsoWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.UpdateIso")
+	return
+}
+
+// UpdateIsoWithContext records the call and calls UpdateIsoWithContextFunc, or UpdateIsoFunc if only that is set
+func (m *MockISOService) UpdateIsoWithContext(ctx context.Context, p *cloudstack.UpdateIsoParams) (r0 *cloudstack.UpdateIsoResponse, r1 error) {
+	m.record("UpdateIsoWithContext", ctx, p)
+	if m.UpdateIsoWithContextFunc != nil {
+		return m.UpdateIsoWithContextFunc(ctx, p)
+	}
+	if m.UpdateIsoFunc != nil {
+		return m.UpdateIsoFunc(p)
+	}
+	r1 = notMocked("ISOService.UpdateIsoWithContext")
+	return
+}
+
+// NewUpdateIsoPermissionsParams records the call and calls NewUpdateIsoPermissionsParamsFunc
+func (m *MockISOService) NewUpdateIsoPermissionsParams(id string) (r0 *cloudstack.UpdateIsoPermissionsParams) {
+	m.record("NewUpdateIsoPermissionsParams", id)
+	if m.NewUpdateIsoPermissionsParamsFunc != nil {
+		return m.NewUpdateIsoPermissionsParamsFunc(id)
+	}
+	return cloudstack.NewISOService(nil).NewUpdateIsoPermissionsParams(id)
+}
+
+// UpdateIsoPermissions records the call and calls UpdateIsoPermissionsFunc, or UpdateIsoPermissionsWithContextFunc if only that is set
+func (m *MockISOService) UpdateIsoPermissions(p *cloudstack.UpdateIsoPermissionsParams) (r0 *cloudstack.UpdateIsoPermissionsResponse, r1 error) {
+	m.record("UpdateIsoPermissions", p)
+	if m.UpdateIsoPermissionsFunc != nil {
+		return m.UpdateIsoPermissionsFunc(p)
+	}
+	if m.UpdateIsoPermissionsWithContextFunc != nil {
+		return m.UpdateIsoPermissionsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ISOService.UpdateIsoPermissions")
+	return
+}
+
+// UpdateIsoPermissionsWithContext records the call and calls UpdateIsoPermissionsWithContextFunc, or UpdateIsoPermissionsFunc if only that is set
+func (m *MockISOService) UpdateIsoPermissionsWithContext(ctx context.Context, p *cloudstack.UpdateIsoPermissionsParams) (r0 *cloudstack.UpdateIsoPermissionsResponse, r1 error) {
+	m.record("UpdateIsoPermissionsWithContext", ctx, p)
+	if m.UpdateIsoPermissionsWithContextFunc != nil {
+		return m.UpdateIsoPermissionsWithContextFunc(ctx, p)
+	}
+	if m.UpdateIsoPermissionsFunc != nil {
+		return m.UpdateIsoPermissionsFunc(p)
+	}
+	r1 = notMocked("ISOService.UpdateIsoPermissionsWithContext")
+	return
+}
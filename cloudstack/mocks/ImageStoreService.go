@@ -0,0 +1,691 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockImageStoreService is a mock of cloudstack.ImageStoreServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockImageStoreService struct {
+	Recorder
+
+	NewAddImageStoreParamsFunc                      func(provider string) *cloudstack.AddImageStoreParams
+	AddImageStoreFunc                               func(p *cloudstack.AddImageStoreParams) (*cloudstack.AddImageStoreResponse, error)
+	AddImageStoreWithContextFunc                    func(ctx context.Context, p *cloudstack.AddImageStoreParams) (*cloudstack.AddImageStoreResponse, error)
+	NewAddImageStoreS3ParamsFunc                    func(accesskey string, bucket string, endpoint string, secretkey string) *cloudstack.AddImageStoreS3Params
+	AddImageStoreS3Func                             func(p *cloudstack.AddImageStoreS3Params) (*cloudstack.AddImageStoreS3Response, error)
+	AddImageStoreS3WithContextFunc                  func(ctx context.Context, p *cloudstack.AddImageStoreS3Params) (*cloudstack.AddImageStoreS3Response, error)
+	NewCreateSecondaryStagingStoreParamsFunc        func(url string) *cloudstack.CreateSecondaryStagingStoreParams
+	CreateSecondaryStagingStoreFunc                 func(p *cloudstack.CreateSecondaryStagingStoreParams) (*cloudstack.CreateSecondaryStagingStoreResponse, error)
+	CreateSecondaryStagingStoreWithContextFunc      func(ctx context.Context, p *cloudstack.CreateSecondaryStagingStoreParams) (*cloudstack.CreateSecondaryStagingStoreResponse, error)
+	NewDeleteImageStoreParamsFunc                   func(id string) *cloudstack.DeleteImageStoreParams
+	DeleteImageStoreFunc                            func(p *cloudstack.DeleteImageStoreParams) (*cloudstack.DeleteImageStoreResponse, error)
+	DeleteImageStoreWithContextFunc                 func(ctx context.Context, p *cloudstack.DeleteImageStoreParams) (*cloudstack.DeleteImageStoreResponse, error)
+	NewDeleteSecondaryStagingStoreParamsFunc        func(id string) *cloudstack.DeleteSecondaryStagingStoreParams
+	DeleteSecondaryStagingStoreFunc                 func(p *cloudstack.DeleteSecondaryStagingStoreParams) (*cloudstack.DeleteSecondaryStagingStoreResponse, error)
+	DeleteSecondaryStagingStoreWithContextFunc      func(ctx context.Context, p *cloudstack.DeleteSecondaryStagingStoreParams) (*cloudstack.DeleteSecondaryStagingStoreResponse, error)
+	NewListImageStoresParamsFunc                    func() *cloudstack.ListImageStoresParams
+	GetImageStoreIDFunc                             func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetImageStoreIDWithContextFunc                  func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetImageStoreByNameFunc                         func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.ImageStore, int, error)
+	GetImageStoreByNameWithContextFunc              func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.ImageStore, int, error)
+	GetImageStoreByIDFunc                           func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.ImageStore, int, error)
+	GetImageStoreByIDWithContextFunc                func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.ImageStore, int, error)
+	ListImageStoresFunc                             func(p *cloudstack.ListImageStoresParams) (*cloudstack.ListImageStoresResponse, error)
+	ListImageStoresWithContextFunc                  func(ctx context.Context, p *cloudstack.ListImageStoresParams) (*cloudstack.ListImageStoresResponse, error)
+	NewListImageStoresPagerFunc                     func(p *cloudstack.ListImageStoresParams) *cloudstack.ListImageStoresPager
+	ListImageStoresAllFunc                          func(p *cloudstack.ListImageStoresParams) (*cloudstack.ListImageStoresResponse, error)
+	ListImageStoresAllWithContextFunc               func(ctx context.Context, p *cloudstack.ListImageStoresParams) (*cloudstack.ListImageStoresResponse, error)
+	NewListSecondaryStagingStoresParamsFunc         func() *cloudstack.ListSecondaryStagingStoresParams
+	GetSecondaryStagingStoreIDFunc                  func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetSecondaryStagingStoreIDWithContextFunc       func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetSecondaryStagingStoreByNameFunc              func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.SecondaryStagingStore, int, error)
+	GetSecondaryStagingStoreByNameWithContextFunc   func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.SecondaryStagingStore, int, error)
+	GetSecondaryStagingStoreByIDFunc                func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.SecondaryStagingStore, int, error)
+	GetSecondaryStagingStoreByIDWithContextFunc     func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.SecondaryStagingStore, int, error)
+	ListSecondaryStagingStoresFunc                  func(p *cloudstack.ListSecondaryStagingStoresParams) (*cloudstack.ListSecondaryStagingStoresResponse, error)
+	ListSecondaryStagingStoresWithContextFunc       func(ctx context.Context, p *cloudstack.ListSecondaryStagingStoresParams) (*cloudstack.ListSecondaryStagingStoresResponse, error)
+	NewListSecondaryStagingStoresPagerFunc          func(p *cloudstack.ListSecondaryStagingStoresParams) *cloudstack.ListSecondaryStagingStoresPager
+	ListSecondaryStagingStoresAllFunc               func(p *cloudstack.ListSecondaryStagingStoresParams) (*cloudstack.ListSecondaryStagingStoresResponse, error)
+	ListSecondaryStagingStoresAllWithContextFunc    func(ctx context.Context, p *cloudstack.ListSecondaryStagingStoresParams) (*cloudstack.ListSecondaryStagingStoresResponse, error)
+	NewMigrateSecondaryStorageDataParamsFunc        func(destpools []string, srcpool string) *cloudstack.MigrateSecondaryStorageDataParams
+	MigrateSecondaryStorageDataFunc                 func(p *cloudstack.MigrateSecondaryStorageDataParams) (*cloudstack.MigrateSecondaryStorageDataResponse, error)
+	MigrateSecondaryStorageDataWithContextFunc      func(ctx context.Context, p *cloudstack.MigrateSecondaryStorageDataParams) (*cloudstack.MigrateSecondaryStorageDataResponse, error)
+	MigrateSecondaryStorageDataAsyncFunc            func(p *cloudstack.MigrateSecondaryStorageDataParams) (*cloudstack.Job, error)
+	MigrateSecondaryStorageDataAsyncWithContextFunc func(ctx context.Context, p *cloudstack.MigrateSecondaryStorageDataParams) (*cloudstack.Job, error)
+	NewUpdateCloudToUseObjectStoreParamsFunc        func(provider string) *cloudstack.UpdateCloudToUseObjectStoreParams
+	UpdateCloudToUseObjectStoreFunc                 func(p *cloudstack.UpdateCloudToUseObjectStoreParams) (*cloudstack.UpdateCloudToUseObjectStoreResponse, error)
+	UpdateCloudToUseObjectStoreWithContextFunc      func(ctx context.Context, p *cloudstack.UpdateCloudToUseObjectStoreParams) (*cloudstack.UpdateCloudToUseObjectStoreResponse, error)
+	NewUpdateImageStoreParamsFunc                   func(id string, readonly bool) *cloudstack.UpdateImageStoreParams
+	UpdateImageStoreFunc                            func(p *cloudstack.UpdateImageStoreParams) (*cloudstack.UpdateImageStoreResponse, error)
+	UpdateImageStoreWithContextFunc                 func(ctx context.Context, p *cloudstack.UpdateImageStoreParams) (*cloudstack.UpdateImageStoreResponse, error)
+}
+
+var _ cloudstack.ImageStoreServiceIface = (*MockImageStoreService)(nil)
+
+// NewAddImageStoreParams records the call and calls NewAddImageStoreParamsFunc
+func (m *MockImageStoreService) NewAddImageStoreParams(provider string) (r0 *cloudstack.AddImageStoreParams) {
+	m.record("NewAddImageStoreParams", provider)
+	if m.NewAddImageStoreParamsFunc != nil {
+		return m.NewAddImageStoreParamsFunc(provider)
+	}
+	return cloudstack.NewImageStoreService(nil).NewAddImageStoreParams(provider)
+}
+
+// AddImageStore records the call and calls AddImageStoreFunc, or AddImageStoreWithContextFunc if only that is set
+func (m *MockImageStoreService) AddImageStore(p *cloudstack.AddImageStoreParams) (r0 *cloudstack.AddImageStoreResponse, r1 error) {
+	m.record("AddImageStore", p)
+	if m.AddImageStoreFunc != nil {
+		return m.AddImageStoreFunc(p)
+	}
+	if m.AddImageStoreWithContextFunc != nil {
+		return m.AddImageStoreWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ImageStoreService.AddImageStore")
+	return
+}
+
+// AddImageStoreWithContext records the call and calls AddImageStoreWithContextFunc, or AddImageStoreFunc if only that is set
+func (m *MockImageStoreService) AddImageStoreWithContext(ctx context.Context, p *cloudstack.AddImageStoreParams) (r0 *cloudstack.AddImageStoreResponse, r1 error) {
+	m.record("AddImageStoreWithContext", ctx, p)
+	if m.AddImageStoreWithContextFunc != nil {
+		return m.AddImageStoreWithContextFunc(ctx, p)
+	}
+	if m.AddImageStoreFunc != nil {
+		return m.AddImageStoreFunc(p)
+	}
+	r1 = notMocked("ImageStoreService.AddImageStoreWithContext")
+	return
+}
+
+// NewAddImageStoreS3Params records the call and calls NewAddImageStoreS3ParamsFunc
+func (m *MockImageStoreService) NewAddImageStoreS3Params(accesskey string, bucket string, endpoint string, secretkey string) (r0 *cloudstack.AddImageStoreS3Params) {
+	m.record("NewAddImageStoreS3Params", accesskey, bucket, endpoint, secretkey)
+	if m.NewAddImageStoreS3ParamsFunc != nil {
+		return m.NewAddImageStoreS3ParamsFunc(accesskey, bucket, endpoint, secretkey)
+	}
+	return cloudstack.NewImageStoreService(nil).NewAddImageStoreS3Params(accesskey, bucket, endpoint, secretkey)
+}
+
+// AddImageStoreS3 records the call and calls AddImageStoreS3Func, or AddImageStoreS3WithContextFunc if only that is set
+func (m *MockImageStoreService) AddImageStoreS3(p *cloudstack.AddImageStoreS3Params) (r0 *cloudstack.AddImageStoreS3Response, r1 error) {
+	m.record("AddImageStoreS3", p)
+	if m.AddImageStoreS3Func != nil {
+		return m.AddImageStoreS3Func(p)
+	}
+	if m.AddImageStoreS3WithContextFunc != nil {
+		return m.AddImageStoreS3WithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ImageStoreService.AddImageStoreS3")
+	return
+}
+
+// AddImageStoreS3WithContext records the call and calls AddImageStoreS3WithContextFunc, or AddImageStoreS3Func if only that is set
+func (m *MockImageStoreService) AddImageStoreS3WithContext(ctx context.Context, p *cloudstack.AddImageStoreS3Params) (r0 *cloudstack.AddImageStoreS3Response, r1 error) {
+	m.record("AddImageStoreS3WithContext", ctx, p)
+	if m.AddImageStoreS3WithContextFunc != nil {
+		return m.AddImageStoreS3WithContextFunc(ctx, p)
+	}
+	if m.AddImageStoreS3Func != nil {
+		return m.AddImageStoreS3Func(p)
+	}
+	r1 = notMocked("ImageStoreService.AddImageStoreS3WithContext")
+	return
+}
+
+// NewCreateSecondaryStagingStoreParams records the call and calls NewCreateSecondaryStagingStoreParamsFunc
+func (m *MockImageStoreService) NewCreateSecondaryStagingStoreParams(url string) (r0 *cloudstack.CreateSecondaryStagingStoreParams) {
+	m.record("NewCreateSecondaryStagingStoreParams", url)
+	if m.NewCreateSecondaryStagingStoreParamsFunc != nil {
+		return m.NewCreateSecondaryStagingStoreParamsFunc(url)
+	}
+	return cloudstack.NewImageStoreService(nil).NewCreateSecondaryStagingStoreParams(url)
+}
+
+// CreateSecondaryStagingStore records the call and calls CreateSecondaryStagingStoreFunc, or CreateSecondaryStagingStoreWithContextFunc if only that is set
+func (m *MockImageStoreService) CreateSecondaryStagingStore(p *cloudstack.CreateSecondaryStagingStoreParams) (r0 *cloudstack.CreateSecondaryStagingStoreResponse, r1 error) {
+	m.record("CreateSecondaryStagingStore", p)
+	if m.CreateSecondaryStagingStoreFunc != nil {
+		return m.CreateSecondaryStagingStoreFunc(p)
+	}
+	if m.CreateSecondaryStagingStoreWithContextFunc != nil {
+		return m.CreateSecondaryStagingStoreWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ImageStoreService.CreateSecondaryStagingStore")
+	return
+}
+
+// CreateSecondaryStagingStoreWithContext records the call and calls CreateSecondaryStagingStoreWithContextFunc, or CreateSecondaryStagingStoreFunc if only that is set
+func (m *MockImageStoreService) CreateSecondaryStagingStoreWithContext(ctx context.Context, p *cloudstack.CreateSecondaryStagingStoreParams) (r0 *cloudstack.CreateSecondaryStagingStoreResponse, r1 error) {
+	m.record("CreateSecondaryStagingStoreWithContext", ctx, p)
+	if m.CreateSecondaryStagingStoreWithContextFunc != nil {
+		return m.CreateSecondaryStagingStoreWithContextFunc(ctx, p)
+	}
+	if m.CreateSecondaryStagingStoreFunc != nil {
+		return m.CreateSecondaryStagingStoreFunc(p)
+	}
+	r1 = notMocked("ImageStoreService.CreateSecondaryStagingStoreWithContext")
+	return
+}
+
+// NewDeleteImageStoreParams records the call and calls NewDeleteImageStoreParamsFunc
+func (m *MockImageStoreService) NewDeleteImageStoreParams(id string) (r0 *cloudstack.DeleteImageStoreParams) {
+	m.record("NewDeleteImageStoreParams", id)
+	if m.NewDeleteImageStoreParamsFunc != nil {
+		return m.NewDeleteImageStoreParamsFunc(id)
+	}
+	return cloudstack.NewImageStoreService(nil).NewDeleteImageStoreParams(id)
+}
+
+// DeleteImageStore records the call and calls DeleteImageStoreFunc, or DeleteImageStoreWithContextFunc if only that is set
+func (m *MockImageStoreService) DeleteImageStore(p *cloudstack.DeleteImageStoreParams) (r0 *cloudstack.DeleteImageStoreResponse, r1 error) {
+	m.record("DeleteImageStore", p)
+	if m.DeleteImageStoreFunc != nil {
+		return m.DeleteImageStoreFunc(p)
+	}
+	if m.DeleteImageStoreWithContextFunc != nil {
+		return m.DeleteImageStoreWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ImageStoreService.DeleteImageStore")
+	return
+}
+
+// DeleteImageStoreWithContext records the call and calls DeleteImageStoreWithContextFunc, or DeleteImageStoreFunc if only that is set
+func (m *MockImageStoreService) DeleteImageStoreWithContext(ctx context.Context, p *cloudstack.DeleteImageStoreParams) (r0 *cloudstack.DeleteImageStoreResponse, r1 error) {
+	m.record("DeleteImageStoreWithContext", ctx, p)
+	if m.DeleteImageStoreWithContextFunc != nil {
+		return m.DeleteImageStoreWithContextFunc(ctx, p)
+	}
+	if m.DeleteImageStoreFunc != nil {
+		return m.DeleteImageStoreFunc(p)
+	}
+	r1 = notMocked("ImageStoreService.DeleteImageStoreWithContext")
+	return
+}
+
+// NewDeleteSecondaryStagingStoreParams records the call and calls NewDeleteSecondaryStagingStoreParamsFunc
+func (m *MockImageStoreService) NewDeleteSecondaryStagingStoreParams(id string) (r0 *cloudstack.DeleteSecondaryStagingStoreParams) {
+	m.record("NewDeleteSecondaryStagingStoreParams", id)
+	if m.NewDeleteSecondaryStagingStoreParamsFunc != nil {
+		return m.NewDeleteSecondaryStagingStoreParamsFunc(id)
+	}
+	return cloudstack.NewImageStoreService(nil).NewDeleteSecondaryStagingStoreParams(id)
+}
+
+// DeleteSecondaryStagingStore records the call and calls DeleteSecondaryStagingStoreFunc, or DeleteSecondaryStagingStoreWithContextFunc if only that is set
+func (m *MockImageStoreService) DeleteSecondaryStagingStore(p *cloudstack.DeleteSecondaryStagingStoreParams) (r0 *cloudstack.DeleteSecondaryStagingStoreResponse, r1 error) {
+	m.record("DeleteSecondaryStagingStore", p)
+	if m.DeleteSecondaryStagingStoreFunc != nil {
+		return m.DeleteSecondaryStagingStoreFunc(p)
+	}
+	if m.DeleteSecondaryStagingStoreWithContextFunc != nil {
+		return m.DeleteSecondaryStagingStoreWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ImageStoreService.DeleteSecondaryStagingStore")
+	return
+}
+
+// DeleteSecondaryStagingStoreWithContext records the call and calls DeleteSecondaryStagingStoreWithContextFunc, or DeleteSecondaryStagingStoreFunc if only that is set
+func (m *MockImageStoreService) DeleteSecondaryStagingStoreWithContext(ctx context.Context, p *cloudstack.DeleteSecondaryStagingStoreParams) (r0 *cloudstack.DeleteSecondaryStagingStoreResponse, r1 error) {
+	m.record("DeleteSecondaryStagingStoreWithContext", ctx, p)
+	if m.DeleteSecondaryStagingStoreWithContextFunc != nil {
+		return m.DeleteSecondaryStagingStoreWithContextFunc(ctx, p)
+	}
+	if m.DeleteSecondaryStagingStoreFunc != nil {
+		return m.DeleteSecondaryStagingStoreFunc(p)
+	}
+	r1 = notMocked("ImageStoreService.DeleteSecondaryStagingStoreWithContext")
+	return
+}
+
+// NewListImageStoresParams records the call and calls NewListImageStoresParamsFunc
+func (m *MockImageStoreService) NewListImageStoresParams() (r0 *cloudstack.ListImageStoresParams) {
+	m.record("NewListImageStoresParams")
+	if m.NewListImageStoresParamsFunc != nil {
+		return m.NewListImageStoresParamsFunc()
+	}
+	return cloudstack.NewImageStoreService(nil).NewListImageStoresParams()
+}
+
+// GetImageStoreID records the call and calls GetImageStoreIDFunc, or GetImageStoreIDWithContextFunc if only that is set
+func (m *MockImageStoreService) GetImageStoreID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetImageStoreID", name, opts)
+	if m.GetImageStoreIDFunc != nil {
+		return m.GetImageStoreIDFunc(name, opts...)
+	}
+	if m.GetImageStoreIDWithContextFunc != nil {
+		return m.GetImageStoreIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ImageStoreService.GetImageStoreID")
+	return
+}
+
+// GetImageStoreIDWithContext records the call and calls GetImageStoreIDWithContextFunc, or GetImageStoreIDFunc if only that is set
+func (m *MockImageStoreService) GetImageStoreIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetImageStoreIDWithContext", ctx, name, opts)
+	if m.GetImageStoreIDWithContextFunc != nil {
+		return m.GetImageStoreIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetImageStoreIDFunc != nil {
+		return m.GetImageStoreIDFunc(name, opts...)
+	}
+	r2 = notMocked("ImageStoreService.GetImageStoreIDWithContext")
+	return
+}
+
+// GetImageStoreByName records the call and calls GetImageStoreByNameFunc, or GetImageStoreByNameWithContextFunc if only that is set
+func (m *MockImageStoreService) GetImageStoreByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ImageStore, r1 int, r2 error) {
+	m.record("GetImageStoreByName", name, opts)
+	if m.GetImageStoreByNameFunc != nil {
+		return m.GetImageStoreByNameFunc(name, opts...)
+	}
+	if m.GetImageStoreByNameWithContextFunc != nil {
+		return m.GetImageStoreByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ImageStoreService.GetImageStoreByName")
+	return
+}
+
+// GetImageStoreByNameWithContext records the call and calls GetImageStoreByNameWithContextFunc, or GetImageStoreByNameFunc if only that is set
+func (m *MockImageStoreService) GetImageStoreByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ImageStore, r1 int, r2 error) {
+	m.record("GetImageStoreByNameWithContext", ctx, name, opts)
+	if m.GetImageStoreByNameWithContextFunc != nil {
+		return m.GetImageStoreByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetImageStoreByNameFunc != nil {
+		return m.GetImageStoreByNameFunc(name, opts...)
+	}
+	r2 = notMocked("ImageStoreService.GetImageStoreByNameWithContext")
+	return
+}
+
+// GetImageStoreByID records the call and calls GetImageStoreByIDFunc, or GetImageStoreByIDWithContextFunc if only that is set
+func (m *MockImageStoreService) GetImageStoreByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ImageStore, r1 int, r2 error) {
+	m.record("GetImageStoreByID", id, opts)
+	if m.GetImageStoreByIDFunc != nil {
+		return m.GetImageStoreByIDFunc(id, opts...)
+	}
+	if m.GetImageStoreByIDWithContextFunc != nil {
+		return m.GetImageStoreByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("ImageStoreService.GetImageStoreByID")
+	return
+}
+
+// GetImageStoreByIDWithContext records the call and calls GetImageStoreByIDWithContextFunc, or GetImageStoreByIDFunc if only that is set
+func (m *MockImageStoreService) GetImageStoreByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ImageStore, r1 int, r2 error) {
+	m.record("GetImageStoreByIDWithContext", ctx, id, opts)
+	if m.GetImageStoreByIDWithContextFunc != nil {
+		return m.GetImageStoreByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetImageStoreByIDFunc != nil {
+		return m.GetImageStoreByIDFunc(id, opts...)
+	}
+	r2 = notMocked("ImageStoreService.GetImageStoreByIDWithContext")
+	return
+}
+
+// ListImageStores records the call and calls ListImageStoresFunc, or ListImageStoresWithContextFunc if only that is set
+func (m *MockImageStoreService) ListImageStores(p *cloudstack.ListImageStoresParams) (r0 *cloudstack.ListImageStoresResponse, r1 error) {
+	m.record("ListImageStores", p)
+	if m.ListImageStoresFunc != nil {
+		return m.ListImageStoresFunc(p)
+	}
+	if m.ListImageStoresWithContextFunc != nil {
+		return m.ListImageStoresWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ImageStoreService.ListImageStores")
+	return
+}
+
+// ListImageStoresWithContext records the call and calls ListImageStoresWithContextFunc, or ListImageStoresFunc if only that is set
+func (m *MockImageStoreService) ListImageStoresWithContext(ctx context.Context, p *cloudstack.ListImageStoresParams) (r0 *cloudstack.ListImageStoresResponse, r1 error) {
+	m.record("ListImageStoresWithContext", ctx, p)
+	if m.ListImageStoresWithContextFunc != nil {
+		return m.ListImageStoresWithContextFunc(ctx, p)
+	}
+	if m.ListImageStoresFunc != nil {
+		return m.ListImageStoresFunc(p)
+	}
+	r1 = notMocked("ImageStoreService.ListImageStoresWithContext")
+	return
+}
+
+// NewListImageStoresPager records the call and calls NewListImageStoresPagerFunc
+func (m *MockImageStoreService) NewListImageStoresPager(p *cloudstack.ListImageStoresParams) (r0 *cloudstack.ListImageStoresPager) {
+	m.record("NewListImageStoresPager", p)
+	if m.NewListImageStoresPagerFunc != nil {
+		return m.NewListImageStoresPagerFunc(p)
+	}
+	return
+}
+
+// ListImageStoresAll records the call and calls ListImageStoresAllFunc, or ListImageStoresAllWithContextFunc if only that is set
+func (m *MockImageStoreService) ListImageStoresAll(p *cloudstack.ListImageStoresParams) (r0 *cloudstack.ListImageStoresResponse, r1 error) {
+	m.record("ListImageStoresAll", p)
+	if m.ListImageStoresAllFunc != nil {
+		return m.ListImageStoresAllFunc(p)
+	}
+	if m.ListImageStoresAllWithContextFunc != nil {
+		return m.ListImageStoresAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ImageStoreService.ListImageStoresAll")
+	return
+}
+
+// ListImageStoresAllWithContext records the call and calls ListImageStoresAllWithContextFunc, or ListImageStoresAllFunc if only that is set
+func (m *MockImageStoreService) ListImageStoresAllWithContext(ctx context.Context, p *cloudstack.ListImageStoresParams) (r0 *cloudstack.ListImageStoresResponse, r1 error) {
+	m.record("ListImageStoresAllWithContext", ctx, p)
+	if m.ListImageStoresAllWithContextFunc != nil {
+		return m.ListImageStoresAllWithContextFunc(ctx, p)
+	}
+	if m.ListImageStoresAllFunc != nil {
+		return m.ListImageStoresAllFunc(p)
+	}
+	r1 = notMocked("ImageStoreService.ListImageStoresAllWithContext")
+	return
+}
+
+// NewListSecondaryStagingStoresParams records the call and calls NewListSecondaryStagingStoresParamsFunc
+func (m *MockImageStoreService) NewListSecondaryStagingStoresParams() (r0 *cloudstack.ListSecondaryStagingStoresParams) {
+	m.record("NewListSecondaryStagingStoresParams")
+	if m.NewListSecondaryStagingStoresParamsFunc != nil {
+		return m.NewListSecondaryStagingStoresParamsFunc()
+	}
+	return cloudstack.NewImageStoreService(nil).NewListSecondaryStagingStoresParams()
+}
+
+// GetSecondaryStagingStoreID records the call and calls GetSecondaryStagingStoreIDFunc, or GetSecondaryStagingStoreIDWithContextFunc if only that is set
+func (m *MockImageStoreService) GetSecondaryStagingStoreID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetSecondaryStagingStoreID", name, opts)
+	if m.GetSecondaryStagingStoreIDFunc != nil {
+		return m.GetSecondaryStagingStoreIDFunc(name, opts...)
+	}
+	if m.GetSecondaryStagingStoreIDWithContextFunc != nil {
+		return m.GetSecondaryStagingStoreIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ImageStoreService.GetSecondaryStagingStoreID")
+	return
+}
+
+// GetSecondaryStagingStoreIDWithContext records the call and calls GetSecondaryStagingStoreIDWithContextFunc, or GetSecondaryStagingStoreIDFunc if only that is set
+func (m *MockImageStoreService) GetSecondaryStagingStoreIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetSecondaryStagingStoreIDWithContext", ctx, name, opts)
+	if m.GetSecondaryStagingStoreIDWithContextFunc != nil {
+		return m.GetSecondaryStagingStoreIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetSecondaryStagingStoreIDFunc != nil {
+		return m.GetSecondaryStagingStoreIDFunc(name, opts...)
+	}
+	r2 = notMocked("ImageStoreService.GetSecondaryStagingStoreIDWithContext")
+	return
+}
+
+// GetSecondaryStagingStoreByName records the call and calls GetSecondaryStagingStoreByNameFunc, or GetSecondaryStagingStoreByNameWithContextFunc if only that is set
+func (m *MockImageStoreService) GetSecondaryStagingStoreByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.SecondaryStagingStore, r1 int, r2 error) {
+	m.record("GetSecondaryStagingStoreByName", name, opts)
+	if m.GetSecondaryStagingStoreByNameFunc != nil {
+		return m.GetSecondaryStagingStoreByNameFunc(name, opts...)
+	}
+	if m.GetSecondaryStagingStoreByNameWithContextFunc != nil {
+		return m.GetSecondaryStagingStoreByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ImageStoreService.GetSecondaryStagingStoreByName")
+	return
+}
+
+// GetSecondaryStagingStoreByNameWithContext records the call and calls GetSecondaryStagingStoreByNameWithContextFunc, or GetSecondaryStagingStoreByNameFunc if only that is set
+func (m *MockImageStoreService) GetSecondaryStagingStoreByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.SecondaryStagingStore, r1 int, r2 error) {
+	m.record("GetSecondaryStagingStoreByNameWithContext", ctx, name, opts)
+	if m.GetSecondaryStagingStoreByNameWithContextFunc != nil {
+		return m.GetSecondaryStagingStoreByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetSecondaryStagingStoreByNameFunc != nil {
+		return m.GetSecondaryStagingStoreByNameFunc(name, opts...)
+	}
+	r2 = notMocked("ImageStoreService.GetSecondaryStagingStoreByNameWithContext")
+	return
+}
+
+// GetSecondaryStagingStoreByID records the call and calls GetSecondaryStagingStoreByIDFunc, or GetSecondaryStagingStoreByIDWithContextFunc if only that is set
+func (m *MockImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.SecondaryStagingStore, r1 int, r2 error) {
+	m.record("GetSecondaryStagingStoreByID", id, opts)
+	if m.GetSecondaryStagingStoreByIDFunc != nil {
+		return m.GetSecondaryStagingStoreByIDFunc(id, opts...)
+	}
+	if m.GetSecondaryStagingStoreByIDWithContextFunc != nil {
+		return m.GetSecondaryStagingStoreByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("ImageStoreService.GetSecondaryStagingStoreByID")
+	return
+}
+
+// GetSecondaryStagingStoreByIDWithContext records the call and calls GetSecondaryStagingStoreByIDWithContextFunc, or GetSecondaryStagingStoreByIDFunc if only that is set
+func (m *MockImageStoreService) GetSecondaryStagingStoreByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.SecondaryStagingStore, r1 int, r2 error) {
+	m.record("GetSecondaryStagingStoreByIDWithContext", ctx, id, opts)
+	if m.GetSecondaryStagingStoreByIDWithContextFunc != nil {
+		return m.GetSecondaryStagingStoreByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetSecondaryStagingStoreByIDFunc != nil {
+		return m.GetSecondaryStagingStoreByIDFunc(id, opts...)
+	}
+	r2 = notMocked("ImageStoreService.GetSecondaryStagingStoreByIDWithContext")
+	return
+}
+
+// ListSecondaryStagingStores records the call and calls ListSecondaryStagingStoresFunc, or ListSecondaryStagingStoresWithContextFunc if only that is set
+func (m *MockImageStoreService) ListSecondaryStagingStores(p *cloudstack.ListSecondaryStagingStoresParams) (r0 *cloudstack.ListSecondaryStagingStoresResponse, r1 error) {
+	m.record("ListSecondaryStagingStores", p)
+	if m.ListSecondaryStagingStoresFunc != nil {
+		return m.ListSecondaryStagingStoresFunc(p)
+	}
+	if m.ListSecondaryStagingStoresWithContextFunc != nil {
+		return m.ListSecondaryStagingStoresWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ImageStoreService.ListSecondaryStagingStores")
+	return
+}
+
+// ListSecondaryStagingStoresWithContext records the call and calls ListSecondaryStagingStoresWithContextFunc, or ListSecondaryStagingStoresFunc if only that is set
+func (m *MockImageStoreService) ListSecondaryStagingStoresWithContext(ctx context.Context, p *cloudstack.ListSecondaryStagingStoresParams) (r0 *cloudstack.ListSecondaryStagingStoresResponse, r1 error) {
+	m.record("ListSecondaryStagingStoresWithContext", ctx, p)
+	if m.ListSecondaryStagingStoresWithContextFunc != nil {
+		return m.ListSecondaryStagingStoresWithContextFunc(ctx, p)
+	}
+	if m.ListSecondaryStagingStoresFunc != nil {
+		return m.ListSecondaryStagingStoresFunc(p)
+	}
+	r1 = notMocked("ImageStoreService.ListSecondaryStagingStoresWithContext")
+	return
+}
+
+// NewListSecondaryStagingStoresPager records the call and calls NewListSecondaryStagingStoresPagerFunc
+func (m *MockImageStoreService) NewListSecondaryStagingStoresPager(p *cloudstack.ListSecondaryStagingStoresParams) (r0 *cloudstack.ListSecondaryStagingStoresPager) {
+	m.record("NewListSecondaryStagingStoresPager", p)
+	if m.NewListSecondaryStagingStoresPagerFunc != nil {
+		return m.NewListSecondaryStagingStoresPagerFunc(p)
+	}
+	return
+}
+
+// ListSecondaryStagingStoresAll records the call and calls ListSecondaryStagingStoresAllFunc, or ListSecondaryStagingStoresAllWithContextFunc if only that is set
+func (m *MockImageStoreService) ListSecondaryStagingStoresAll(p *cloudstack.ListSecondaryStagingStoresParams) (r0 *cloudstack.ListSecondaryStagingStoresResponse, r1 error) {
+	m.record("ListSecondaryStagingStoresAll", p)
+	if m.ListSecondaryStagingStoresAllFunc != nil {
+		return m.ListSecondaryStagingStoresAllFunc(p)
+	}
+	if m.ListSecondaryStagingStoresAllWithContextFunc != nil {
+		return m.ListSecondaryStagingStoresAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ImageStoreService.ListSecondaryStagingStoresAll")
+	return
+}
+
+// ListSecondaryStagingStoresAllWithContext records the call and calls ListSecondaryStagingStoresAllWithContextFunc, or ListSecondaryStagingStoresAllFunc if only that is set
+func (m *MockImageStoreService) ListSecondaryStagingStoresAllWithContext(ctx context.Context, p *cloudstack.ListSecondaryStagingStoresParams) (r0 *cloudstack.ListSecondaryStagingStoresResponse, r1 error) {
+	m.record("ListSecondaryStagingStoresAllWithContext", ctx, p)
+	if m.ListSecondaryStagingStoresAllWithContextFunc != nil {
+		return m.ListSecondaryStagingStoresAllWithContextFunc(ctx, p)
+	}
+	if m.ListSecondaryStagingStoresAllFunc != nil {
+		return m.ListSecondaryStagingStoresAllFunc(p)
+	}
+	r1 = notMocked("ImageStoreService.ListSecondaryStagingStoresAllWithContext")
+	return
+}
+
+// NewMigrateSecondaryStorageDataParams records the call and calls NewMigrateSecondaryStorageDataParamsFunc
+func (m *MockImageStoreService) NewMigrateSecondaryStorageDataParams(destpools []string, srcpool string) (r0 *cloudstack.MigrateSecondaryStorageDataParams) {
+	m.record("NewMigrateSecondaryStorageDataParams", destpools, srcpool)
+	if m.NewMigrateSecondaryStorageDataParamsFunc != nil {
+		return m.NewMigrateSecondaryStorageDataParamsFunc(destpools, srcpool)
+	}
+	return cloudstack.NewImageStoreService(nil).NewMigrateSecondaryStorageDataParams(destpools, srcpool)
+}
+
+// MigrateSecondaryStorageData records the call and calls MigrateSecondaryStorageDataFunc, or MigrateSecondaryStorageDataWithContextFunc if only that is set
+func (m *MockImageStoreService) MigrateSecondaryStorageData(p *cloudstack.MigrateSecondaryStorageDataParams) (r0 *cloudstack.MigrateSecondaryStorageDataResponse, r1 error) {
+	m.record("MigrateSecondaryStorageData", p)
+	if m.MigrateSecondaryStorageDataFunc != nil {
+		return m.MigrateSecondaryStorageDataFunc(p)
+	}
+	if m.MigrateSecondaryStorageDataWithContextFunc != nil {
+		return m.MigrateSecondaryStorageDataWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ImageStoreService.MigrateSecondaryStorageData")
+	return
+}
+
+// MigrateSecondaryStorageDataWithContext records the call and calls MigrateSecondaryStorageDataWithContextFunc, or MigrateSecondaryStorageDataFunc if only that is set
+func (m *MockImageStoreService) MigrateSecondaryStorageDataWithContext(ctx context.Context, p *cloudstack.MigrateSecondaryStorageDataParams) (r0 *cloudstack.MigrateSecondaryStorageDataResponse, r1 error) {
+	m.record("MigrateSecondaryStorageDataWithContext", ctx, p)
+	if m.MigrateSecondaryStorageDataWithContextFunc != nil {
+		return m.MigrateSecondaryStorageDataWithContextFunc(ctx, p)
+	}
+	if m.MigrateSecondaryStorageDataFunc != nil {
+		return m.MigrateSecondaryStorageDataFunc(p)
+	}
+	r1 = notMocked("ImageStoreService.MigrateSecondaryStorageDataWithContext")
+	return
+}
+
+// MigrateSecondaryStorageDataAsync records the call and calls MigrateSecondaryStorageDataAsyncFunc, or MigrateSecondaryStorageDataAsyncWithContextFunc if only that is set
+func (m *MockImageStoreService) MigrateSecondaryStorageDataAsync(p *cloudstack.MigrateSecondaryStorageDataParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MigrateSecondaryStorageDataAsync", p)
+	if m.MigrateSecondaryStorageDataAsyncFunc != nil {
+		return m.MigrateSecondaryStorageDataAsyncFunc(p)
+	}
+	if m.MigrateSecondaryStorageDataAsyncWithContextFunc != nil {
+		return m.MigrateSecondaryStorageDataAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ImageStoreService.MigrateSecondaryStorageDataAsync")
+	return
+}
+
+// MigrateSecondaryStorageDataAsyncWithContext records the call and calls MigrateSecondaryStorageDataAsyncWithContextFunc, or MigrateSecondaryStorageDataAsyncFunc if only that is set
+func (m *MockImageStoreService) MigrateSecondaryStorageDataAsyncWithContext(ctx context.Context, p *cloudstack.MigrateSecondaryStorageDataParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MigrateSecondaryStorageDataAsyncWithContext", ctx, p)
+	if m.MigrateSecondaryStorageDataAsyncWithContextFunc != nil {
+		return m.MigrateSecondaryStorageDataAsyncWithContextFunc(ctx, p)
+	}
+	if m.MigrateSecondaryStorageDataAsyncFunc != nil {
+		return m.MigrateSecondaryStorageDataAsyncFunc(p)
+	}
+	r1 = notMocked("ImageStoreService.MigrateSecondaryStorageDataAsyncWithContext")
+	return
+}
+
+// NewUpdateCloudToUseObjectStoreParams records the call and calls NewUpdateCloudToUseObjectStoreParamsFunc
+func (m *MockImageStoreService) NewUpdateCloudToUseObjectStoreParams(provider string) (r0 *cloudstack.UpdateCloudToUseObjectStoreParams) {
+	m.record("NewUpdateCloudToUseObjectStoreParams", provider)
+	if m.NewUpdateCloudToUseObjectStoreParamsFunc != nil {
+		return m.NewUpdateCloudToUseObjectStoreParamsFunc(provider)
+	}
+	return cloudstack.NewImageStoreService(nil).NewUpdateCloudToUseObjectStoreParams(provider)
+}
+
+// UpdateCloudToUseObjectStore records the call and calls UpdateCloudToUseObjectStoreFunc, or UpdateCloudToUseObjectStoreWithContextFunc if only that is set
+func (m *MockImageStoreService) UpdateCloudToUseObjectStore(p *cloudstack.UpdateCloudToUseObjectStoreParams) (r0 *cloudstack.UpdateCloudToUseObjectStoreResponse, r1 error) {
+	m.record("UpdateCloudToUseObjectStore", p)
+	if m.UpdateCloudToUseObjectStoreFunc != nil {
+		return m.UpdateCloudToUseObjectStoreFunc(p)
+	}
+	if m.UpdateCloudToUseObjectStoreWithContextFunc != nil {
+		return m.UpdateCloudToUseObjectStoreWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ImageStoreService.UpdateCloudToUseObjectStore")
+	return
+}
+
+// UpdateCloudToUseObjectStoreWithContext records the call and calls UpdateCloudToUseObjectStoreWithContextFunc, or UpdateCloudToUseObjectStoreFunc if only that is set
+func (m *MockImageStoreService) UpdateCloudToUseObjectStoreWithContext(ctx context.Context, p *cloudstack.UpdateCloudToUseObjectStoreParams) (r0 *cloudstack.UpdateCloudToUseObjectStoreResponse, r1 error) {
+	m.record("UpdateCloudToUseObjectStoreWithContext", ctx, p)
+	if m.UpdateCloudToUseObjectStoreWithContextFunc != nil {
+		return m.UpdateCloudToUseObjectStoreWithContextFunc(ctx, p)
+	}
+	if m.UpdateCloudToUseObjectStoreFunc != nil {
+		return m.UpdateCloudToUseObjectStoreFunc(p)
+	}
+	r1 = notMocked("ImageStoreService.UpdateCloudToUseObjectStoreWithContext")
+	return
+}
+
+// NewUpdateImageStoreParams records the call and calls NewUpdateImageStoreParamsFunc
+func (m *MockImageStoreService) NewUpdateImageStoreParams(id string, readonly bool) (r0 *cloudstack.UpdateImageStoreParams) {
+	m.record("NewUpdateImageStoreParams", id, readonly)
+	if m.NewUpdateImageStoreParamsFunc != nil {
+		return m.NewUpdateImageStoreParamsFunc(id, readonly)
+	}
+	return cloudstack.NewImageStoreService(nil).NewUpdateImageStoreParams(id, readonly)
+}
+
+// UpdateImageStore records the call and calls UpdateImageStoreFunc, or UpdateImageStoreWithContextFunc if only that is set
+func (m *MockImageStoreService) UpdateImageStore(p *cloudstack.UpdateImageStoreParams) (r0 *cloudstack.UpdateImageStoreResponse, r1 error) {
+	m.record("UpdateImageStore", p)
+	if m.UpdateImageStoreFunc != nil {
+		return m.UpdateImageStoreFunc(p)
+	}
+	if m.UpdateImageStoreWithContextFunc != nil {
+		return m.UpdateImageStoreWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ImageStoreService.UpdateImageStore")
+	return
+}
+
+// UpdateImageStoreWithContext records the call and calls UpdateImageStoreWithContextFunc, or UpdateImageStoreFunc if only that is set
+func (m *MockImageStoreService) UpdateImageStoreWithContext(ctx context.Context, p *cloudstack.UpdateImageStoreParams) (r0 *cloudstack.UpdateImageStoreResponse, r1 error) {
+	m.record("UpdateImageStoreWithContext", ctx, p)
+	if m.UpdateImageStoreWithContextFunc != nil {
+		return m.UpdateImageStoreWithContextFunc(ctx, p)
+	}
+	if m.UpdateImageStoreFunc != nil {
+		return m.UpdateImageStoreFunc(p)
+	}
+	r1 = notMocked("ImageStoreService.UpdateImageStoreWithContext")
+	return
+}
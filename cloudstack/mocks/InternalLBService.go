@@ -0,0 +1,567 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockInternalLBService is a mock of cloudstack.InternalLBServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockInternalLBService struct {
+	Recorder
+
+	NewConfigureInternalLoadBalancerElementParamsFunc        func(enabled bool, id string) *cloudstack.ConfigureInternalLoadBalancerElementParams
+	ConfigureInternalLoadBalancerElementFunc                 func(p *cloudstack.ConfigureInternalLoadBalancerElementParams) (*cloudstack.InternalLoadBalancerElementResponse, error)
+	ConfigureInternalLoadBalancerElementWithContextFunc      func(ctx context.Context, p *cloudstack.ConfigureInternalLoadBalancerElementParams) (*cloudstack.InternalLoadBalancerElementResponse, error)
+	ConfigureInternalLoadBalancerElementAsyncFunc            func(p *cloudstack.ConfigureInternalLoadBalancerElementParams) (*cloudstack.Job, error)
+	ConfigureInternalLoadBalancerElementAsyncWithContextFunc func(ctx context.Context, p *cloudstack.ConfigureInternalLoadBalancerElementParams) (*cloudstack.Job, error)
+	NewCreateInternalLoadBalancerElementParamsFunc           func(nspid string) *cloudstack.CreateInternalLoadBalancerElementParams
+	CreateInternalLoadBalancerElementFunc                    func(p *cloudstack.CreateInternalLoadBalancerElementParams) (*cloudstack.CreateInternalLoadBalancerElementResponse, error)
+	CreateInternalLoadBalancerElementWithContextFunc         func(ctx context.Context, p *cloudstack.CreateInternalLoadBalancerElementParams) (*cloudstack.CreateInternalLoadBalancerElementResponse, error)
+	CreateInternalLoadBalancerElementAsyncFunc               func(p *cloudstack.CreateInternalLoadBalancerElementParams) (*cloudstack.Job, error)
+	CreateInternalLoadBalancerElementAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.CreateInternalLoadBalancerElementParams) (*cloudstack.Job, error)
+	NewListInternalLoadBalancerElementsParamsFunc            func() *cloudstack.ListInternalLoadBalancerElementsParams
+	GetInternalLoadBalancerElementByIDFunc                   func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.InternalLoadBalancerElement, int, error)
+	GetInternalLoadBalancerElementByIDWithContextFunc        func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.InternalLoadBalancerElement, int, error)
+	ListInternalLoadBalancerElementsFunc                     func(p *cloudstack.ListInternalLoadBalancerElementsParams) (*cloudstack.ListInternalLoadBalancerElementsResponse, error)
+	ListInternalLoadBalancerElementsWithContextFunc          func(ctx context.Context, p *cloudstack.ListInternalLoadBalancerElementsParams) (*cloudstack.ListInternalLoadBalancerElementsResponse, error)
+	NewListInternalLoadBalancerElementsPagerFunc             func(p *cloudstack.ListInternalLoadBalancerElementsParams) *cloudstack.ListInternalLoadBalancerElementsPager
+	ListInternalLoadBalancerElementsAllFunc                  func(p *cloudstack.ListInternalLoadBalancerElementsParams) (*cloudstack.ListInternalLoadBalancerElementsResponse, error)
+	ListInternalLoadBalancerElementsAllWithContextFunc       func(ctx context.Context, p *cloudstack.ListInternalLoadBalancerElementsParams) (*cloudstack.ListInternalLoadBalancerElementsResponse, error)
+	NewListInternalLoadBalancerVMsParamsFunc                 func() *cloudstack.ListInternalLoadBalancerVMsParams
+	GetInternalLoadBalancerVMIDFunc                          func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetInternalLoadBalancerVMIDWithContextFunc               func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetInternalLoadBalancerVMByNameFunc                      func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.InternalLoadBalancerVM, int, error)
+	GetInternalLoadBalancerVMByNameWithContextFunc           func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.InternalLoadBalancerVM, int, error)
+	GetInternalLoadBalancerVMByIDFunc                        func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.InternalLoadBalancerVM, int, error)
+	GetInternalLoadBalancerVMByIDWithContextFunc             func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.InternalLoadBalancerVM, int, error)
+	ListInternalLoadBalancerVMsFunc                          func(p *cloudstack.ListInternalLoadBalancerVMsParams) (*cloudstack.ListInternalLoadBalancerVMsResponse, error)
+	ListInternalLoadBalancerVMsWithContextFunc               func(ctx context.Context, p *cloudstack.ListInternalLoadBalancerVMsParams) (*cloudstack.ListInternalLoadBalancerVMsResponse, error)
+	NewListInternalLoadBalancerVMsPagerFunc                  func(p *cloudstack.ListInternalLoadBalancerVMsParams) *cloudstack.ListInternalLoadBalancerVMsPager
+	ListInternalLoadBalancerVMsAllFunc                       func(p *cloudstack.ListInternalLoadBalancerVMsParams) (*cloudstack.ListInternalLoadBalancerVMsResponse, error)
+	ListInternalLoadBalancerVMsAllWithContextFunc            func(ctx context.Context, p *cloudstack.ListInternalLoadBalancerVMsParams) (*cloudstack.ListInternalLoadBalancerVMsResponse, error)
+	NewStartInternalLoadBalancerVMParamsFunc                 func(id string) *cloudstack.StartInternalLoadBalancerVMParams
+	StartInternalLoadBalancerVMFunc                          func(p *cloudstack.StartInternalLoadBalancerVMParams) (*cloudstack.StartInternalLoadBalancerVMResponse, error)
+	StartInternalLoadBalancerVMWithContextFunc               func(ctx context.Context, p *cloudstack.StartInternalLoadBalancerVMParams) (*cloudstack.StartInternalLoadBalancerVMResponse, error)
+	StartInternalLoadBalancerVMAsyncFunc                     func(p *cloudstack.StartInternalLoadBalancerVMParams) (*cloudstack.Job, error)
+	StartInternalLoadBalancerVMAsyncWithContextFunc          func(ctx context.Context, p *cloudstack.StartInternalLoadBalancerVMParams) (*cloudstack.Job, error)
+	NewStopInternalLoadBalancerVMParamsFunc                  func(id string) *cloudstack.StopInternalLoadBalancerVMParams
+	StopInternalLoadBalancerVMFunc                           func(p *cloudstack.StopInternalLoadBalancerVMParams) (*cloudstack.StopInternalLoadBalancerVMResponse, error)
+	StopInternalLoadBalancerVMWithContextFunc                func(ctx context.Context, p *cloudstack.StopInternalLoadBalancerVMParams) (*cloudstack.StopInternalLoadBalancerVMResponse, error)
+	StopInternalLoadBalancerVMAsyncFunc                      func(p *cloudstack.StopInternalLoadBalancerVMParams) (*cloudstack.Job, error)
+	StopInternalLoadBalancerVMAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.StopInternalLoadBalancerVMParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.InternalLBServiceIface = (*MockInternalLBService)(nil)
+
+// NewConfigureInternalLoadBalancerElementParams records the call and calls NewConfigureInternalLoadBalancerElementParamsFunc
+func (m *MockInternalLBService) NewConfigureInternalLoadBalancerElementParams(enabled bool, id string) (r0 *cloudstack.ConfigureInternalLoadBalancerElementParams) {
+	m.record("NewConfigureInternalLoadBalancerElementParams", enabled, id)
+	if m.NewConfigureInternalLoadBalancerElementParamsFunc != nil {
+		return m.NewConfigureInternalLoadBalancerElementParamsFunc(enabled, id)
+	}
+	return cloudstack.NewInternalLBService(nil).NewConfigureInternalLoadBalancerElementParams(enabled, id)
+}
+
+// ConfigureInternalLoadBalancerElement records the call and calls ConfigureInternalLoadBalancerElementFunc, or ConfigureInternalLoadBalancerElementWithContextFunc if only that is set
+func (m *MockInternalLBService) ConfigureInternalLoadBalancerElement(p *cloudstack.ConfigureInternalLoadBalancerElementParams) (r0 *cloudstack.InternalLoadBalancerElementResponse, r1 error) {
+	m.record("ConfigureInternalLoadBalancerElement", p)
+	if m.ConfigureInternalLoadBalancerElementFunc != nil {
+		return m.ConfigureInternalLoadBalancerElementFunc(p)
+	}
+	if m.ConfigureInternalLoadBalancerElementWithContextFunc != nil {
+		return m.ConfigureInternalLoadBalancerElementWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("InternalLBService.ConfigureInternalLoadBalancerElement")
+	return
+}
+
+// ConfigureInternalLoadBalancerElementWithContext records the call and calls ConfigureInternalLoadBalancerElementWithContextFunc, or ConfigureInternalLoadBalancerElementFunc if only that is set
+func (m *MockInternalLBService) ConfigureInternalLoadBalancerElementWithContext(ctx context.Context, p *cloudstack.ConfigureInternalLoadBalancerElementParams) (r0 *cloudstack.InternalLoadBalancerElementResponse, r1 error) {
+	m.record("ConfigureInternalLoadBalancerElementWithContext", ctx, p)
+	if m.ConfigureInternalLoadBalancerElementWithContextFunc != nil {
+		return m.ConfigureInternalLoadBalancerElementWithContextFunc(ctx, p)
+	}
+	if m.ConfigureInternalLoadBalancerElementFunc != nil {
+		return m.ConfigureInternalLoadBalancerElementFunc(p)
+	}
+	r1 = notMocked("InternalLBService.ConfigureInternalLoadBalancerElementWithContext")
+	return
+}
+
+// ConfigureInternalLoadBalancerElementAsync records the call and calls ConfigureInternalLoadBalancerElementAsyncFunc, or ConfigureInternalLoadBalancerElementAsyncWithContextFunc if only that is set
+func (m *MockInternalLBService) ConfigureInternalLoadBalancerElementAsync(p *cloudstack.ConfigureInternalLoadBalancerElementParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigureInternalLoadBalancerElementAsync", p)
+	if m.ConfigureInternalLoadBalancerElementAsyncFunc != nil {
+		return m.ConfigureInternalLoadBalancerElementAsyncFunc(p)
+	}
+	if m.ConfigureInternalLoadBalancerElementAsyncWithContextFunc != nil {
+		return m.ConfigureInternalLoadBalancerElementAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("InternalLBService.ConfigureInternalLoadBalancerElementAsync")
+	return
+}
+
+// ConfigureInternalLoadBalancerElementAsyncWithContext records the call and calls ConfigureInternalLoadBalancerElementAsyncWithContextFunc, or ConfigureInternalLoadBalancerElementAsyncFunc if only that is set
+func (m *MockInternalLBService) ConfigureInternalLoadBalancerElementAsyncWithContext(ctx context.Context, p *cloudstack.ConfigureInternalLoadBalancerElementParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigureInternalLoadBalancerElementAsyncWithContext", ctx, p)
+	if m.ConfigureInternalLoadBalancerElementAsyncWithContextFunc != nil {
+		return m.ConfigureInternalLoadBalancerElementAsyncWithContextFunc(ctx, p)
+	}
+	if m.ConfigureInternalLoadBalancerElementAsyncFunc != nil {
+		return m.ConfigureInternalLoadBalancerElementAsyncFunc(p)
+	}
+	r1 = notMocked("InternalLBService.ConfigureInternalLoadBalancerElementAsyncWithContext")
+	return
+}
+
+// NewCreateInternalLoadBalancerElementParams records the call and calls NewCreateInternalLoadBalancerElementParamsFunc
+func (m *MockInternalLBService) NewCreateInternalLoadBalancerElementParams(nspid string) (r0 *cloudstack.CreateInternalLoadBalancerElementParams) {
+	m.record("NewCreateInternalLoadBalancerElementParams", nspid)
+	if m.NewCreateInternalLoadBalancerElementParamsFunc != nil {
+		return m.NewCreateInternalLoadBalancerElementParamsFunc(nspid)
+	}
+	return cloudstack.NewInternalLBService(nil).NewCreateInternalLoadBalancerElementParams(nspid)
+}
+
+// CreateInternalLoadBalancerElement records the call and calls CreateInternalLoadBalancerElementFunc, or CreateInternalLoadBalancerElementWithContextFunc if only that is set
+func (m *MockInternalLBService) CreateInternalLoadBalancerElement(p *cloudstack.CreateInternalLoadBalancerElementParams) (r0 *cloudstack.CreateInternalLoadBalancerElementResponse, r1 error) {
+	m.record("CreateInternalLoadBalancerElement", p)
+	if m.CreateInternalLoadBalancerElementFunc != nil {
+		return m.CreateInternalLoadBalancerElementFunc(p)
+	}
+	if m.CreateInternalLoadBalancerElementWithContextFunc != nil {
+		return m.CreateInternalLoadBalancerElementWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("InternalLBService.CreateInternalLoadBalancerElement")
+	return
+}
+
+// CreateInternalLoadBalancerElementWithContext records the call and calls CreateInternalLoadBalancerElementWithContextFunc, or CreateInternalLoadBalancerElementFunc if only that is set
+func (m *MockInternalLBService) CreateInternalLoadBalancerElementWithContext(ctx context.Context, p *cloudstack.CreateInternalLoadBalancerElementParams) (r0 *cloudstack.CreateInternalLoadBalancerElementResponse, r1 error) {
+	m.record("CreateInternalLoadBalancerElementWithContext", ctx, p)
+	if m.CreateInternalLoadBalancerElementWithContextFunc != nil {
+		return m.CreateInternalLoadBalancerElementWithContextFunc(ctx, p)
+	}
+	if m.CreateInternalLoadBalancerElementFunc != nil {
+		return m.CreateInternalLoadBalancerElementFunc(p)
+	}
+	r1 = notMocked("InternalLBService.CreateInternalLoadBalancerElementWithContext")
+	return
+}
+
+// CreateInternalLoadBalancerElementAsync records the call and calls CreateInternalLoadBalancerElementAsyncFunc, or CreateInternalLoadBalancerElementAsyncWithContextFunc if only that is set
+func (m *MockInternalLBService) CreateInternalLoadBalancerElementAsync(p *cloudstack.CreateInternalLoadBalancerElementParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateInternalLoadBalancerElementAsync", p)
+	if m.CreateInternalLoadBalancerElementAsyncFunc != nil {
+		return m.CreateInternalLoadBalancerElementAsyncFunc(p)
+	}
+	if m.CreateInternalLoadBalancerElementAsyncWithContextFunc != nil {
+		return m.CreateInternalLoadBalancerElementAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("InternalLBService.CreateInternalLoadBalancerElementAsync")
+	return
+}
+
+// CreateInternalLoadBalancerElementAsyncWithContext records the call and calls CreateInternalLoadBalancerElementAsyncWithContextFunc, or CreateInternalLoadBalancerElementAsyncFunc if only that is set
+func (m *MockInternalLBService) CreateInternalLoadBalancerElementAsyncWithContext(ctx context.Context, p *cloudstack.CreateInternalLoadBalancerElementParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateInternalLoadBalancerElementAsyncWithContext", ctx, p)
+	if m.CreateInternalLoadBalancerElementAsyncWithContextFunc != nil {
+		return m.CreateInternalLoadBalancerElementAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateInternalLoadBalancerElementAsyncFunc != nil {
+		return m.CreateInternalLoadBalancerElementAsyncFunc(p)
+	}
+	r1 = notMocked("InternalLBService.CreateInternalLoadBalancerElementAsyncWithContext")
+	return
+}
+
+// NewListInternalLoadBalancerElementsParams records the call and calls NewListInternalLoadBalancerElementsParamsFunc
+func (m *MockInternalLBService) NewListInternalLoadBalancerElementsParams() (r0 *cloudstack.ListInternalLoadBalancerElementsParams) {
+	m.record("NewListInternalLoadBalancerElementsParams")
+	if m.NewListInternalLoadBalancerElementsParamsFunc != nil {
+		return m.NewListInternalLoadBalancerElementsParamsFunc()
+	}
+	return cloudstack.NewInternalLBService(nil).NewListInternalLoadBalancerElementsParams()
+}
+
+// GetInternalLoadBalancerElementByID records the call and calls GetInternalLoadBalancerElementByIDFunc, or GetInternalLoadBalancerElementByIDWithContextFunc if only that is set
+func (m *MockInternalLBService) GetInternalLoadBalancerElementByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.InternalLoadBalancerElement, r1 int, r2 error) {
+	m.record("GetInternalLoadBalancerElementByID", id, opts)
+	if m.GetInternalLoadBalancerElementByIDFunc != nil {
+		return m.GetInternalLoadBalancerElementByIDFunc(id, opts...)
+	}
+	if m.GetInternalLoadBalancerElementByIDWithContextFunc != nil {
+		return m.GetInternalLoadBalancerElementByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("InternalLBService.GetInternalLoadBalancerElementByID")
+	return
+}
+
+// GetInternalLoadBalancerElementByIDWithContext records the call and calls GetInternalLoadBalancerElementByIDWithContextFunc, or GetInternalLoadBalancerElementByIDFunc if only that is set
+func (m *MockInternalLBService) GetInternalLoadBalancerElementByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.InternalLoadBalancerElement, r1 int, r2 error) {
+	m.record("GetInternalLoadBalancerElementByIDWithContext", ctx, id, opts)
+	if m.GetInternalLoadBalancerElementByIDWithContextFunc != nil {
+		return m.GetInternalLoadBalancerElementByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetInternalLoadBalancerElementByIDFunc != nil {
+		return m.GetInternalLoadBalancerElementByIDFunc(id, opts...)
+	}
+	r2 = notMocked("InternalLBService.GetInternalLoadBalancerElementByIDWithContext")
+	return
+}
+
+// ListInternalLoadBalancerElements records the call and calls ListInternalLoadBalancerElementsFunc, or ListInternalLoadBalancerElementsWithContextFunc if only that is set
+func (m *MockInternalLBService) ListInternalLoadBalancerElements(p *cloudstack.ListInternalLoadBalancerElementsParams) (r0 *cloudstack.ListInternalLoadBalancerElementsResponse, r1 error) {
+	m.record("ListInternalLoadBalancerElements", p)
+	if m.ListInternalLoadBalancerElementsFunc != nil {
+		return m.ListInternalLoadBalancerElementsFunc(p)
+	}
+	if m.ListInternalLoadBalancerElementsWithContextFunc != nil {
+		return m.ListInternalLoadBalancerElementsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("InternalLBService.ListInternalLoadBalancerElements")
+	return
+}
+
+// ListInternalLoadBalancerElementsWithContext records the call and calls ListInternalLoadBalancerElementsWithContextFunc, or ListInternalLoadBalancerElementsFunc if only that is set
+func (m *MockInternalLBService) ListInternalLoadBalancerElementsWithContext(ctx context.Context, p *cloudstack.ListInternalLoadBalancerElementsParams) (r0 *cloudstack.ListInternalLoadBalancerElementsResponse, r1 error) {
+	m.record("ListInternalLoadBalancerElementsWithContext", ctx, p)
+	if m.ListInternalLoadBalancerElementsWithContextFunc != nil {
+		return m.ListInternalLoadBalancerElementsWithContextFunc(ctx, p)
+	}
+	if m.ListInternalLoadBalancerElementsFunc != nil {
+		return m.ListInternalLoadBalancerElementsFunc(p)
+	}
+	r1 = notMocked("InternalLBService.ListInternalLoadBalancerElementsWithContext")
+	return
+}
+
+// NewListInternalLoadBalancerElementsPager records the call and calls NewListInternalLoadBalancerElementsPagerFunc
+func (m *MockInternalLBService) NewListInternalLoadBalancerElementsPager(p *cloudstack.ListInternalLoadBalancerElementsParams) (r0 *cloudstack.ListInternalLoadBalancerElementsPager) {
+	m.record("NewListInternalLoadBalancerElementsPager", p)
+	if m.NewListInternalLoadBalancerElementsPagerFunc != nil {
+		return m.NewListInternalLoadBalancerElementsPagerFunc(p)
+	}
+	return
+}
+
+// ListInternalLoadBalancerElementsAll records the call and calls ListInternalLoadBalancerElementsAllFunc, or ListInternalLoadBalancerElementsAllWithContextFunc if only that is set
+func (m *MockInternalLBService) ListInternalLoadBalancerElementsAll(p *cloudstack.ListInternalLoadBalancerElementsParams) (r0 *cloudstack.ListInternalLoadBalancerElementsResponse, r1 error) {
+	m.record("ListInternalLoadBalancerElementsAll", p)
+	if m.ListInternalLoadBalancerElementsAllFunc != nil {
+		return m.ListInternalLoadBalancerElementsAllFunc(p)
+	}
+	if m.ListInternalLoadBalancerElementsAllWithContextFunc != nil {
+		return m.ListInternalLoadBalancerElementsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("InternalLBService.ListInternalLoadBalancerElementsAll")
+	return
+}
+
+// ListInternalLoadBalancerElementsAllWithContext records the call and calls ListInternalLoadBalancerElementsAllWithContextFunc, or ListInternalLoadBalancerElementsAllFunc if only that is set
+func (m *MockInternalLBService) ListInternalLoadBalancerElementsAllWithContext(ctx context.Context, p *cloudstack.ListInternalLoadBalancerElementsParams) (r0 *cloudstack.ListInternalLoadBalancerElementsResponse, r1 error) {
+	m.record("ListInternalLoadBalancerElementsAllWithContext", ctx, p)
+	if m.ListInternalLoadBalancerElementsAllWithContextFunc != nil {
+		return m.ListInternalLoadBalancerElementsAllWithContextFunc(ctx, p)
+	}
+	if m.ListInternalLoadBalancerElementsAllFunc != nil {
+		return m.ListInternalLoadBalancerElementsAllFunc(p)
+	}
+	r1 = notMocked("InternalLBService.ListInternalLoadBalancerElementsAllWithContext")
+	return
+}
+
+// NewListInternalLoadBalancerVMsParams records the call and calls NewListInternalLoadBalancerVMsParamsFunc
+func (m *MockInternalLBService) NewListInternalLoadBalancerVMsParams() (r0 *cloudstack.ListInternalLoadBalancerVMsParams) {
+	m.record("NewListInternalLoadBalancerVMsParams")
+	if m.NewListInternalLoadBalancerVMsParamsFunc != nil {
+		return m.NewListInternalLoadBalancerVMsParamsFunc()
+	}
+	return cloudstack.NewInternalLBService(nil).NewListInternalLoadBalancerVMsParams()
+}
+
+// GetInternalLoadBalancerVMID records the call and calls GetInternalLoadBalancerVMIDFunc, or GetInternalLoadBalancerVMIDWithContextFunc if only that is set
+func (m *MockInternalLBService) GetInternalLoadBalancerVMID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetInternalLoadBalancerVMID", name, opts)
+	if m.GetInternalLoadBalancerVMIDFunc != nil {
+		return m.GetInternalLoadBalancerVMIDFunc(name, opts...)
+	}
+	if m.GetInternalLoadBalancerVMIDWithContextFunc != nil {
+		return m.GetInternalLoadBalancerVMIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("InternalLBService.GetInternalLoadBalancerVMID")
+	return
+}
+
+// GetInternalLoadBalancerVMIDWithContext records the call and calls GetInternalLoadBalancerVMIDWithContextFunc, or GetInternalLoadBalancerVMIDFunc if only that is set
+func (m *MockInternalLBService) GetInternalLoadBalancerVMIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetInternalLoadBalancerVMIDWithContext", ctx, name, opts)
+	if m.GetInternalLoadBalancerVMIDWithContextFunc != nil {
+		return m.GetInternalLoadBalancerVMIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetInternalLoadBalancerVMIDFunc != nil {
+		return m.GetInternalLoadBalancerVMIDFunc(name, opts...)
+	}
+	r2 = notMocked("InternalLBService.GetInternalLoadBalancerVMIDWithContext")
+	return
+}
+
+// GetInternalLoadBalancerVMByName records the call and calls GetInternalLoadBalancerVMByNameFunc, or GetInternalLoadBalancerVMByNameWithContextFunc if only that is set
+func (m *MockInternalLBService) GetInternalLoadBalancerVMByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.InternalLoadBalancerVM, r1 int, r2 error) {
+	m.record("GetInternalLoadBalancerVMByName", name, opts)
+	if m.GetInternalLoadBalancerVMByNameFunc != nil {
+		return m.GetInternalLoadBalancerVMByNameFunc(name, opts...)
+	}
+	if m.GetInternalLoadBalancerVMByNameWithContextFunc != nil {
+		return m.GetInternalLoadBalancerVMByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("InternalLBService.GetInternalLoadBalancerVMByName")
+	return
+}
+
+// GetInternalLoadBalancerVMByNameWithContext records the call and calls GetInternalLoadBalancerVMByNameWithContextFunc, or GetInternalLoadBalancerVMByNameFunc if only that is set
+func (m *MockInternalLBService) GetInternalLoadBalancerVMByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.InternalLoadBalancerVM, r1 int, r2 error) {
+	m.record("GetInternalLoadBalancerVMByNameWithContext", ctx, name, opts)
+	if m.GetInternalLoadBalancerVMByNameWithContextFunc != nil {
+		return m.GetInternalLoadBalancerVMByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetInternalLoadBalancerVMByNameFunc != nil {
+		return m.GetInternalLoadBalancerVMByNameFunc(name, opts...)
+	}
+	r2 = notMocked("InternalLBService.GetInternalLoadBalancerVMByNameWithContext")
+	return
+}
+
+// GetInternalLoadBalancerVMByID records the call and calls GetInternalLoadBalancerVMByIDFunc, or GetInternalLoadBalancerVMByIDWithContextFunc if only that is set
+func (m *MockInternalLBService) GetInternalLoadBalancerVMByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.InternalLoadBalancerVM, r1 int, r2 error) {
+	m.record("GetInternalLoadBalancerVMByID", id, opts)
+	if m.GetInternalLoadBalancerVMByIDFunc != nil {
+		return m.GetInternalLoadBalancerVMByIDFunc(id, opts...)
+	}
+	if m.GetInternalLoadBalancerVMByIDWithContextFunc != nil {
+		return m.GetInternalLoadBalancerVMByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("InternalLBService.GetInternalLoadBalancerVMByID")
+	return
+}
+
+// GetInternalLoadBalancerVMByIDWithContext records the call and calls GetInternalLoadBalancerVMByIDWithContextFunc, or GetInternalLoadBalancerVMByIDFunc if only that is set
+func (m *MockInternalLBService) GetInternalLoadBalancerVMByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.InternalLoadBalancerVM, r1 int, r2 error) {
+	m.record("GetInternalLoadBalancerVMByIDWithContext", ctx, id, opts)
+	if m.GetInternalLoadBalancerVMByIDWithContextFunc != nil {
+		return m.GetInternalLoadBalancerVMByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetInternalLoadBalancerVMByIDFunc != nil {
+		return m.GetInternalLoadBalancerVMByIDFunc(id, opts...)
+	}
+	r2 = notMocked("InternalLBService.GetInternalLoadBalancerVMByIDWithContext")
+	return
+}
+
+// ListInternalLoadBalancerVMs records the call and calls ListInternalLoadBalancerVMsFunc, or ListInternalLoadBalancerVMsWithContextFunc if only that is set
+func (m *MockInternalLBService) ListInternalLoadBalancerVMs(p *cloudstack.ListInternalLoadBalancerVMsParams) (r0 *cloudstack.ListInternalLoadBalancerVMsResponse, r1 error) {
+	m.record("ListInternalLoadBalancerVMs", p)
+	if m.ListInternalLoadBalancerVMsFunc != nil {
+		return m.ListInternalLoadBalancerVMsFunc(p)
+	}
+	if m.ListInternalLoadBalancerVMsWithContextFunc != nil {
+		return m.ListInternalLoadBalancerVMsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("InternalLBService.ListInternalLoadBalancerVMs")
+	return
+}
+
+// ListInternalLoadBalancerVMsWithContext records the call and calls ListInternalLoadBalancerVMsWithContextFunc, or ListInternalLoadBalancerVMsFunc if only that is set
+func (m *MockInternalLBService) ListInternalLoadBalancerVMsWithContext(ctx context.Context, p *cloudstack.ListInternalLoadBalancerVMsParams) (r0 *cloudstack.ListInternalLoadBalancerVMsResponse, r1 error) {
+	m.record("ListInternalLoadBalancerVMsWithContext", ctx, p)
+	if m.ListInternalLoadBalancerVMsWithContextFunc != nil {
+		return m.ListInternalLoadBalancerVMsWithContextFunc(ctx, p)
+	}
+	if m.ListInternalLoadBalancerVMsFunc != nil {
+		return m.ListInternalLoadBalancerVMsFunc(p)
+	}
+	r1 = notMocked("InternalLBService.ListInternalLoadBalancerVMsWithContext")
+	return
+}
+
+// NewListInternalLoadBalancerVMsPager records the call and calls NewListInternalLoadBalancerVMsPagerFunc
+func (m *MockInternalLBService) NewListInternalLoadBalancerVMsPager(p *cloudstack.ListInternalLoadBalancerVMsParams) (r0 *cloudstack.ListInternalLoadBalancerVMsPager) {
+	m.record("NewListInternalLoadBalancerVMsPager", p)
+	if m.NewListInternalLoadBalancerVMsPagerFunc != nil {
+		return m.NewListInternalLoadBalancerVMsPagerFunc(p)
+	}
+	return
+}
+
+// ListInternalLoadBalancerVMsAll records the call and calls ListInternalLoadBalancerVMsAllFunc, or ListInternalLoadBalancerVMsAllWithContextFunc if only that is set
+func (m *MockInternalLBService) ListInternalLoadBalancerVMsAll(p *cloudstack.ListInternalLoadBalancerVMsParams) (r0 *cloudstack.ListInternalLoadBalancerVMsResponse, r1 error) {
+	m.record("ListInternalLoadBalancerVMsAll", p)
+	if m.ListInternalLoadBalancerVMsAllFunc != nil {
+		return m.ListInternalLoadBalancerVMsAllFunc(p)
+	}
+	if m.ListInternalLoadBalancerVMsAllWithContextFunc != nil {
+		return m.ListInternalLoadBalancerVMsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("InternalLBService.ListInternalLoadBalancerVMsAll")
+	return
+}
+
+// ListInternalLoadBalancerVMsAllWithContext records the call and calls ListInternalLoadBalancerVMsAllWithContextFunc, or ListInternalLoadBalancerVMsAllFunc if only that is set
+func (m *MockInternalLBService) ListInternalLoadBalancerVMsAllWithContext(ctx context.Context, p *cloudstack.ListInternalLoadBalancerVMsParams) (r0 *cloudstack.ListInternalLoadBalancerVMsResponse, r1 error) {
+	m.record("ListInternalLoadBalancerVMsAllWithContext", ctx, p)
+	if m.ListInternalLoadBalancerVMsAllWithContextFunc != nil {
+		return m.ListInternalLoadBalancerVMsAllWithContextFunc(ctx, p)
+	}
+	if m.ListInternalLoadBalancerVMsAllFunc != nil {
+		return m.ListInternalLoadBalancerVMsAllFunc(p)
+	}
+	r1 = notMocked("InternalLBService.ListInternalLoadBalancerVMsAllWithContext")
+	return
+}
+
+// NewStartInternalLoadBalancerVMParams records the call and calls NewStartInternalLoadBalancerVMParamsFunc
+func (m *MockInternalLBService) NewStartInternalLoadBalancerVMParams(id string) (r0 *cloudstack.StartInternalLoadBalancerVMParams) {
+	m.record("NewStartInternalLoadBalancerVMParams", id)
+	if m.NewStartInternalLoadBalancerVMParamsFunc != nil {
+		return m.NewStartInternalLoadBalancerVMParamsFunc(id)
+	}
+	return cloudstack.NewInternalLBService(nil).NewStartInternalLoadBalancerVMParams(id)
+}
+
+// StartInternalLoadBalancerVM records the call and calls StartInternalLoadBalancerVMFunc, or StartInternalLoadBalancerVMWithContextFunc if only that is set
+func (m *MockInternalLBService) StartInternalLoadBalancerVM(p *cloudstack.StartInternalLoadBalancerVMParams) (r0 *cloudstack.StartInternalLoadBalancerVMResponse, r1 error) {
+	m.record("StartInternalLoadBalancerVM", p)
+	if m.StartInternalLoadBalancerVMFunc != nil {
+		return m.StartInternalLoadBalancerVMFunc(p)
+	}
+	if m.StartInternalLoadBalancerVMWithContextFunc != nil {
+		return m.StartInternalLoadBalancerVMWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("InternalLBService.StartInternalLoadBalancerVM")
+	return
+}
+
+// StartInternalLoadBalancerVMWithContext records the call and calls StartInternalLoadBalancerVMWithContextFunc, or StartInternalLoadBalancerVMFunc if only that is set
+func (m *MockInternalLBService) StartInternalLoadBalancerVMWithContext(ctx context.Context, p *cloudstack.StartInternalLoadBalancerVMParams) (r0 *cloudstack.StartInternalLoadBalancerVMResponse, r1 error) {
+	m.record("StartInternalLoadBalancerVMWithContext", ctx, p)
+	if m.StartInternalLoadBalancerVMWithContextFunc != nil {
+		return m.StartInternalLoadBalancerVMWithContextFunc(ctx, p)
+	}
+	if m.StartInternalLoadBalancerVMFunc != nil {
+		return m.StartInternalLoadBalancerVMFunc(p)
+	}
+	r1 = notMocked("InternalLBService.StartInternalLoadBalancerVMWithContext")
+	return
+}
+
+// StartInternalLoadBalancerVMAsync records the call and calls StartInternalLoadBalancerVMAsyncFunc, or StartInternalLoadBalancerVMAsyncWithContextFunc if only that is set
+func (m *MockInternalLBService) StartInternalLoadBalancerVMAsync(p *cloudstack.StartInternalLoadBalancerVMParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StartInternalLoadBalancerVMAsync", p)
+	if m.StartInternalLoadBalancerVMAsyncFunc != nil {
+		return m.StartInternalLoadBalancerVMAsyncFunc(p)
+	}
+	if m.StartInternalLoadBalancerVMAsyncWithContextFunc != nil {
+		return m.StartInternalLoadBalancerVMAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("InternalLBService.StartInternalLoadBalancerVMAsync")
+	return
+}
+
+// StartInternalLoadBalancerVMAsyncWithContext records the call and calls StartInternalLoadBalancerVMAsyncWithContextFunc, or StartInternalLoadBalancerVMAsyncFunc if only that is set
+func (m *MockInternalLBService) StartInternalLoadBalancerVMAsyncWithContext(ctx context.Context, p *cloudstack.StartInternalLoadBalancerVMParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StartInternalLoadBalancerVMAsyncWithContext", ctx, p)
+	if m.StartInternalLoadBalancerVMAsyncWithContextFunc != nil {
+		return m.StartInternalLoadBalancerVMAsyncWithContextFunc(ctx, p)
+	}
+	if m.StartInternalLoadBalancerVMAsyncFunc != nil {
+		return m.StartInternalLoadBalancerVMAsyncFunc(p)
+	}
+	r1 = notMocked("InternalLBService.StartInternalLoadBalancerVMAsyncWithContext")
+	return
+}
+
+// NewStopInternalLoadBalancerVMParams records the call and calls NewStopInternalLoadBalancerVMParamsFunc
+func (m *MockInternalLBService) NewStopInternalLoadBalancerVMParams(id string) (r0 *cloudstack.StopInternalLoadBalancerVMParams) {
+	m.record("NewStopInternalLoadBalancerVMParams", id)
+	if m.NewStopInternalLoadBalancerVMParamsFunc != nil {
+		return m.NewStopInternalLoadBalancerVMParamsFunc(id)
+	}
+	return cloudstack.NewInternalLBService(nil).NewStopInternalLoadBalancerVMParams(id)
+}
+
+// StopInternalLoadBalancerVM records the call and calls StopInternalLoadBalancerVMFunc, or StopInternalLoadBalancerVMWithContextFunc if only that is set
+func (m *MockInternalLBService) StopInternalLoadBalancerVM(p *cloudstack.StopInternalLoadBalancerVMParams) (r0 *cloudstack.StopInternalLoadBalancerVMResponse, r1 error) {
+	m.record("StopInternalLoadBalancerVM", p)
+	if m.StopInternalLoadBalancerVMFunc != nil {
+		return m.StopInternalLoadBalancerVMFunc(p)
+	}
+	if m.StopInternalLoadBalancerVMWithContextFunc != nil {
+		return m.StopInternalLoadBalancerVMWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("InternalLBService.StopInternalLoadBalancerVM")
+	return
+}
+
+// StopInternalLoadBalancerVMWithContext records the call and calls StopInternalLoadBalancerVMWithContextFunc, or StopInternalLoadBalancerVMFunc if only that is set
+func (m *MockInternalLBService) StopInternalLoadBalancerVMWithContext(ctx context.Context, p *cloudstack.StopInternalLoadBalancerVMParams) (r0 *cloudstack.StopInternalLoadBalancerVMResponse, r1 error) {
+	m.record("StopInternalLoadBalancerVMWithContext", ctx, p)
+	if m.StopInternalLoadBalancerVMWithContextFunc != nil {
+		return m.StopInternalLoadBalancerVMWithContextFunc(ctx, p)
+	}
+	if m.StopInternalLoadBalancerVMFunc != nil {
+		return m.StopInternalLoadBalancerVMFunc(p)
+	}
+	r1 = notMocked("InternalLBService.StopInternalLoadBalancerVMWithContext")
+	return
+}
+
+// StopInternalLoadBalancerVMAsync records the call and calls StopInternalLoadBalancerVMAsyncFunc, or StopInternalLoadBalancerVMAsyncWithContextFunc if only that is set
+func (m *MockInternalLBService) StopInternalLoadBalancerVMAsync(p *cloudstack.StopInternalLoadBalancerVMParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StopInternalLoadBalancerVMAsync", p)
+	if m.StopInternalLoadBalancerVMAsyncFunc != nil {
+		return m.StopInternalLoadBalancerVMAsyncFunc(p)
+	}
+	if m.StopInternalLoadBalancerVMAsyncWithContextFunc != nil {
+		return m.StopInternalLoadBalancerVMAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("InternalLBService.StopInternalLoadBalancerVMAsync")
+	return
+}
+
+// StopInternalLoadBalancerVMAsyncWithContext records the call and calls StopInternalLoadBalancerVMAsyncWithContextFunc, or StopInternalLoadBalancerVMAsyncFunc if only that is set
+func (m *MockInternalLBService) StopInternalLoadBalancerVMAsyncWithContext(ctx context.Context, p *cloudstack.StopInternalLoadBalancerVMParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StopInternalLoadBalancerVMAsyncWithContext", ctx, p)
+	if m.StopInternalLoadBalancerVMAsyncWithContextFunc != nil {
+		return m.StopInternalLoadBalancerVMAsyncWithContextFunc(ctx, p)
+	}
+	if m.StopInternalLoadBalancerVMAsyncFunc != nil {
+		return m.StopInternalLoadBalancerVMAsyncFunc(p)
+	}
+	r1 = notMocked("InternalLBService.StopInternalLoadBalancerVMAsyncWithContext")
+	return
+}
@@ -0,0 +1,963 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockKubernetesService is a mock of cloudstack.KubernetesServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockKubernetesService struct {
+	Recorder
+
+	GetKubernetesClusterKubeconfigFunc                   func(id string, opts ...cloudstack.OptionFunc) ([]byte, error)
+	GetKubernetesClusterKubeconfigWithContextFunc        func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) ([]byte, error)
+	NewAddKubernetesSupportedVersionParamsFunc           func(mincpunumber int, minmemory int, semanticversion string) *cloudstack.AddKubernetesSupportedVersionParams
+	AddKubernetesSupportedVersionFunc                    func(p *cloudstack.AddKubernetesSupportedVersionParams) (*cloudstack.AddKubernetesSupportedVersionResponse, error)
+	AddKubernetesSupportedVersionWithContextFunc         func(ctx context.Context, p *cloudstack.AddKubernetesSupportedVersionParams) (*cloudstack.AddKubernetesSupportedVersionResponse, error)
+	NewCreateKubernetesClusterParamsFunc                 func(description string, kubernetesversionid string, name string, serviceofferingid string, size int64, zoneid string) *cloudstack.CreateKubernetesClusterParams
+	CreateKubernetesClusterFunc                          func(p *cloudstack.CreateKubernetesClusterParams) (*cloudstack.CreateKubernetesClusterResponse, error)
+	CreateKubernetesClusterWithContextFunc               func(ctx context.Context, p *cloudstack.CreateKubernetesClusterParams) (*cloudstack.CreateKubernetesClusterResponse, error)
+	CreateKubernetesClusterAsyncFunc                     func(p *cloudstack.CreateKubernetesClusterParams) (*cloudstack.Job, error)
+	CreateKubernetesClusterAsyncWithContextFunc          func(ctx context.Context, p *cloudstack.CreateKubernetesClusterParams) (*cloudstack.Job, error)
+	NewDeleteKubernetesClusterParamsFunc                 func(id string) *cloudstack.DeleteKubernetesClusterParams
+	DeleteKubernetesClusterFunc                          func(p *cloudstack.DeleteKubernetesClusterParams) (*cloudstack.DeleteKubernetesClusterResponse, error)
+	DeleteKubernetesClusterWithContextFunc               func(ctx context.Context, p *cloudstack.DeleteKubernetesClusterParams) (*cloudstack.DeleteKubernetesClusterResponse, error)
+	DeleteKubernetesClusterAsyncFunc                     func(p *cloudstack.DeleteKubernetesClusterParams) (*cloudstack.Job, error)
+	DeleteKubernetesClusterAsyncWithContextFunc          func(ctx context.Context, p *cloudstack.DeleteKubernetesClusterParams) (*cloudstack.Job, error)
+	NewDeleteKubernetesSupportedVersionParamsFunc        func(id string) *cloudstack.DeleteKubernetesSupportedVersionParams
+	DeleteKubernetesSupportedVersionFunc                 func(p *cloudstack.DeleteKubernetesSupportedVersionParams) (*cloudstack.DeleteKubernetesSupportedVersionResponse, error)
+	DeleteKubernetesSupportedVersionWithContextFunc      func(ctx context.Context, p *cloudstack.DeleteKubernetesSupportedVersionParams) (*cloudstack.DeleteKubernetesSupportedVersionResponse, error)
+	DeleteKubernetesSupportedVersionAsyncFunc            func(p *cloudstack.DeleteKubernetesSupportedVersionParams) (*cloudstack.Job, error)
+	DeleteKubernetesSupportedVersionAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DeleteKubernetesSupportedVersionParams) (*cloudstack.Job, error)
+	NewGetKubernetesClusterConfigParamsFunc              func() *cloudstack.GetKubernetesClusterConfigParams
+	GetKubernetesClusterConfigFunc                       func(p *cloudstack.GetKubernetesClusterConfigParams) (*cloudstack.GetKubernetesClusterConfigResponse, error)
+	GetKubernetesClusterConfigWithContextFunc            func(ctx context.Context, p *cloudstack.GetKubernetesClusterConfigParams) (*cloudstack.GetKubernetesClusterConfigResponse, error)
+	NewListKubernetesClustersParamsFunc                  func() *cloudstack.ListKubernetesClustersParams
+	GetKubernetesClusterIDFunc                           func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetKubernetesClusterIDWithContextFunc                func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetKubernetesClusterByNameFunc                       func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.KubernetesCluster, int, error)
+	GetKubernetesClusterByNameWithContextFunc            func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.KubernetesCluster, int, error)
+	GetKubernetesClusterByIDFunc                         func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.KubernetesCluster, int, error)
+	GetKubernetesClusterByIDWithContextFunc              func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.KubernetesCluster, int, error)
+	ListKubernetesClustersFunc                           func(p *cloudstack.ListKubernetesClustersParams) (*cloudstack.ListKubernetesClustersResponse, error)
+	ListKubernetesClustersWithContextFunc                func(ctx context.Context, p *cloudstack.ListKubernetesClustersParams) (*cloudstack.ListKubernetesClustersResponse, error)
+	NewListKubernetesClustersPagerFunc                   func(p *cloudstack.ListKubernetesClustersParams) *cloudstack.ListKubernetesClustersPager
+	ListKubernetesClustersAllFunc                        func(p *cloudstack.ListKubernetesClustersParams) (*cloudstack.ListKubernetesClustersResponse, error)
+	ListKubernetesClustersAllWithContextFunc             func(ctx context.Context, p *cloudstack.ListKubernetesClustersParams) (*cloudstack.ListKubernetesClustersResponse, error)
+	NewListKubernetesSupportedVersionsParamsFunc         func() *cloudstack.ListKubernetesSupportedVersionsParams
+	GetKubernetesSupportedVersionIDFunc                  func(keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetKubernetesSupportedVersionIDWithContextFunc       func(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetKubernetesSupportedVersionByNameFunc              func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.KubernetesSupportedVersion, int, error)
+	GetKubernetesSupportedVersionByNameWithContextFunc   func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.KubernetesSupportedVersion, int, error)
+	GetKubernetesSupportedVersionByIDFunc                func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.KubernetesSupportedVersion, int, error)
+	GetKubernetesSupportedVersionByIDWithContextFunc     func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.KubernetesSupportedVersion, int, error)
+	ListKubernetesSupportedVersionsFunc                  func(p *cloudstack.ListKubernetesSupportedVersionsParams) (*cloudstack.ListKubernetesSupportedVersionsResponse, error)
+	ListKubernetesSupportedVersionsWithContextFunc       func(ctx context.Context, p *cloudstack.ListKubernetesSupportedVersionsParams) (*cloudstack.ListKubernetesSupportedVersionsResponse, error)
+	NewListKubernetesSupportedVersionsPagerFunc          func(p *cloudstack.ListKubernetesSupportedVersionsParams) *cloudstack.ListKubernetesSupportedVersionsPager
+	ListKubernetesSupportedVersionsAllFunc               func(p *cloudstack.ListKubernetesSupportedVersionsParams) (*cloudstack.ListKubernetesSupportedVersionsResponse, error)
+	ListKubernetesSupportedVersionsAllWithContextFunc    func(ctx context.Context, p *cloudstack.ListKubernetesSupportedVersionsParams) (*cloudstack.ListKubernetesSupportedVersionsResponse, error)
+	NewScaleKubernetesClusterParamsFunc                  func(id string) *cloudstack.ScaleKubernetesClusterParams
+	ScaleKubernetesClusterFunc                           func(p *cloudstack.ScaleKubernetesClusterParams) (*cloudstack.ScaleKubernetesClusterResponse, error)
+	ScaleKubernetesClusterWithContextFunc                func(ctx context.Context, p *cloudstack.ScaleKubernetesClusterParams) (*cloudstack.ScaleKubernetesClusterResponse, error)
+	ScaleKubernetesClusterAsyncFunc                      func(p *cloudstack.ScaleKubernetesClusterParams) (*cloudstack.Job, error)
+	ScaleKubernetesClusterAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.ScaleKubernetesClusterParams) (*cloudstack.Job, error)
+	NewStartKubernetesClusterParamsFunc                  func(id string) *cloudstack.StartKubernetesClusterParams
+	StartKubernetesClusterFunc                           func(p *cloudstack.StartKubernetesClusterParams) (*cloudstack.StartKubernetesClusterResponse, error)
+	StartKubernetesClusterWithContextFunc                func(ctx context.Context, p *cloudstack.StartKubernetesClusterParams) (*cloudstack.StartKubernetesClusterResponse, error)
+	StartKubernetesClusterAsyncFunc                      func(p *cloudstack.StartKubernetesClusterParams) (*cloudstack.Job, error)
+	StartKubernetesClusterAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.StartKubernetesClusterParams) (*cloudstack.Job, error)
+	NewStopKubernetesClusterParamsFunc                   func(id string) *cloudstack.StopKubernetesClusterParams
+	StopKubernetesClusterFunc                            func(p *cloudstack.StopKubernetesClusterParams) (*cloudstack.StopKubernetesClusterResponse, error)
+	StopKubernetesClusterWithContextFunc                 func(ctx context.Context, p *cloudstack.StopKubernetesClusterParams) (*cloudstack.StopKubernetesClusterResponse, error)
+	StopKubernetesClusterAsyncFunc                       func(p *cloudstack.StopKubernetesClusterParams) (*cloudstack.Job, error)
+	StopKubernetesClusterAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.StopKubernetesClusterParams) (*cloudstack.Job, error)
+	NewUpdateKubernetesSupportedVersionParamsFunc        func(id string, state string) *cloudstack.UpdateKubernetesSupportedVersionParams
+	UpdateKubernetesSupportedVersionFunc                 func(p *cloudstack.UpdateKubernetesSupportedVersionParams) (*cloudstack.UpdateKubernetesSupportedVersionResponse, error)
+	UpdateKubernetesSupportedVersionWithContextFunc      func(ctx context.Context, p *cloudstack.UpdateKubernetesSupportedVersionParams) (*cloudstack.UpdateKubernetesSupportedVersionResponse, error)
+	NewUpgradeKubernetesClusterParamsFunc                func(id string, kubernetesversionid string) *cloudstack.UpgradeKubernetesClusterParams
+	UpgradeKubernetesClusterFunc                         func(p *cloudstack.UpgradeKubernetesClusterParams) (*cloudstack.UpgradeKubernetesClusterResponse, error)
+	UpgradeKubernetesClusterWithContextFunc              func(ctx context.Context, p *cloudstack.UpgradeKubernetesClusterParams) (*cloudstack.UpgradeKubernetesClusterResponse, error)
+	UpgradeKubernetesClusterAsyncFunc                    func(p *cloudstack.UpgradeKubernetesClusterParams) (*cloudstack.Job, error)
+	UpgradeKubernetesClusterAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.UpgradeKubernetesClusterParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.KubernetesServiceIface = (*MockKubernetesService)(nil)
+
+// GetKubernetesClusterKubeconfig records the call and calls GetKubernetesClusterKubeconfigFunc, or GetKubernetesClusterKubeconfigWithContextFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesClusterKubeconfig(id string, opts ...cloudstack.OptionFunc) (r0 []byte, r1 error) {
+	m.record("GetKubernetesClusterKubeconfig", id, opts)
+	if m.GetKubernetesClusterKubeconfigFunc != nil {
+		return m.GetKubernetesClusterKubeconfigFunc(id, opts...)
+	}
+	if m.GetKubernetesClusterKubeconfigWithContextFunc != nil {
+		return m.GetKubernetesClusterKubeconfigWithContextFunc(context.Background(), id, opts...)
+	}
+	r1 = notMocked("KubernetesService.GetKubernetesClusterKubeconfig")
+	return
+}
+
+// GetKubernetesClusterKubeconfigWithContext records the call and calls GetKubernetesClusterKubeconfigWithContextFunc, or GetKubernetesClusterKubeconfigFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesClusterKubeconfigWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 []byte, r1 error) {
+	m.record("GetKubernetesClusterKubeconfigWithContext", ctx, id, opts)
+	if m.GetKubernetesClusterKubeconfigWithContextFunc != nil {
+		return m.GetKubernetesClusterKubeconfigWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetKubernetesClusterKubeconfigFunc != nil {
+		return m.GetKubernetesClusterKubeconfigFunc(id, opts...)
+	}
+	r1 = notMocked("KubernetesService.GetKubernetesClusterKubeconfigWithContext")
+	return
+}
+
+// NewAddKubernetesSupportedVersionParams records the call and calls NewAddKubernetesSupportedVersionParamsFunc
+func (m *MockKubernetesService) NewAddKubernetesSupportedVersionParams(mincpunumber int, minmemory int, semanticversion string) (r0 *cloudstack.AddKubernetesSupportedVersionParams) {
+	m.record("NewAddKubernetesSupportedVersionParams", mincpunumber, minmemory, semanticversion)
+	if m.NewAddKubernetesSupportedVersionParamsFunc != nil {
+		return m.NewAddKubernetesSupportedVersionParamsFunc(mincpunumber, minmemory, semanticversion)
+	}
+	return cloudstack.NewKubernetesService(nil).NewAddKubernetesSupportedVersionParams(mincpunumber, minmemory, semanticversion)
+}
+
+// AddKubernetesSupportedVersion records the call and calls AddKubernetesSupportedVersionFunc, or AddKubernetesSupportedVersionWithContextFunc if only that is set
+func (m *MockKubernetesService) AddKubernetesSupportedVersion(p *cloudstack.AddKubernetesSupportedVersionParams) (r0 *cloudstack.AddKubernetesSupportedVersionResponse, r1 error) {
+	m.record("AddKubernetesSupportedVersion", p)
+	if m.AddKubernetesSupportedVersionFunc != nil {
+		return m.AddKubernetesSupportedVersionFunc(p)
+	}
+	if m.AddKubernetesSupportedVersionWithContextFunc != nil {
+		return m.AddKubernetesSupportedVersionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.AddKubernetesSupportedVersion")
+	return
+}
+
+// AddKubernetesSupportedVersionWithContext records the call and calls AddKubernetesSupportedVersionWithContextFunc, or AddKubernetesSupportedVersionFunc if only that is set
+func (m *MockKubernetesService) AddKubernetesSupportedVersionWithContext(ctx context.Context, p *cloudstack.AddKubernetesSupportedVersionParams) (r0 *cloudstack.AddKubernetesSupportedVersionResponse, r1 error) {
+	m.record("AddKubernetesSupportedVersionWithContext", ctx, p)
+	if m.AddKubernetesSupportedVersionWithContextFunc != nil {
+		return m.AddKubernetesSupportedVersionWithContextFunc(ctx, p)
+	}
+	if m.AddKubernetesSupportedVersionFunc != nil {
+		return m.AddKubernetesSupportedVersionFunc(p)
+	}
+	r1 = notMocked("KubernetesService.AddKubernetesSupportedVersionWithContext")
+	return
+}
+
+// NewCreateKubernetesClusterParams records the call and calls NewCreateKubernetesClusterParamsFunc
+func (m *MockKubernetesService) NewCreateKubernetesClusterParams(description string, kubernetesversionid string, name string, serviceofferingid string, size int64, zoneid string) (r0 *cloudstack.CreateKubernetesClusterParams) {
+	m.record("NewCreateKubernetesClusterParams", description, kubernetesversionid, name, serviceofferingid, size, zoneid)
+	if m.NewCreateKubernetesClusterParamsFunc != nil {
+		return m.NewCreateKubernetesClusterParamsFunc(description, kubernetesversionid, name, serviceofferingid, size, zoneid)
+	}
+	return cloudstack.NewKubernetesService(nil).NewCreateKubernetesClusterParams(description, kubernetesversionid, name, serviceofferingid, size, zoneid)
+}
+
+// CreateKubernetesCluster records the call and calls CreateKubernetesClusterFunc, or CreateKubernetesClusterWithContextFunc if only that is set
+func (m *MockKubernetesService) CreateKubernetesCluster(p *cloudstack.CreateKubernetesClusterParams) (r0 *cloudstack.CreateKubernetesClusterResponse, r1 error) {
+	m.record("CreateKubernetesCluster", p)
+	if m.CreateKubernetesClusterFunc != nil {
+		return m.CreateKubernetesClusterFunc(p)
+	}
+	if m.CreateKubernetesClusterWithContextFunc != nil {
+		return m.CreateKubernetesClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.CreateKubernetesCluster")
+	return
+}
+
+// CreateKubernetesClusterWithContext records the call and calls CreateKubernetesClusterWithContextFunc, or CreateKubernetesClusterFunc if only that is set
+func (m *MockKubernetesService) CreateKubernetesClusterWithContext(ctx context.Context, p *cloudstack.CreateKubernetesClusterParams) (r0 *cloudstack.CreateKubernetesClusterResponse, r1 error) {
+	m.record("CreateKubernetesClusterWithContext", ctx, p)
+	if m.CreateKubernetesClusterWithContextFunc != nil {
+		return m.CreateKubernetesClusterWithContextFunc(ctx, p)
+	}
+	if m.CreateKubernetesClusterFunc != nil {
+		return m.CreateKubernetesClusterFunc(p)
+	}
+	r1 = notMocked("KubernetesService.CreateKubernetesClusterWithContext")
+	return
+}
+
+// CreateKubernetesClusterAsync records the call and calls CreateKubernetesClusterAsyncFunc, or CreateKubernetesClusterAsyncWithContextFunc if only that is set
+func (m *MockKubernetesService) CreateKubernetesClusterAsync(p *cloudstack.CreateKubernetesClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateKubernetesClusterAsync", p)
+	if m.CreateKubernetesClusterAsyncFunc != nil {
+		return m.CreateKubernetesClusterAsyncFunc(p)
+	}
+	if m.CreateKubernetesClusterAsyncWithContextFunc != nil {
+		return m.CreateKubernetesClusterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.CreateKubernetesClusterAsync")
+	return
+}
+
+// CreateKubernetesClusterAsyncWithContext records the call and calls CreateKubernetesClusterAsyncWithContextFunc, or CreateKubernetesClusterAsyncFunc if only that is set
+func (m *MockKubernetesService) CreateKubernetesClusterAsyncWithContext(ctx context.Context, p *cloudstack.CreateKubernetesClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateKubernetesClusterAsyncWithContext", ctx, p)
+	if m.CreateKubernetesClusterAsyncWithContextFunc != nil {
+		return m.CreateKubernetesClusterAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateKubernetesClusterAsyncFunc != nil {
+		return m.CreateKubernetesClusterAsyncFunc(p)
+	}
+	r1 = notMocked("KubernetesService.CreateKubernetesClusterAsyncWithContext")
+	return
+}
+
+// NewDeleteKubernetesClusterParams records the call and calls NewDeleteKubernetesClusterParamsFunc
+func (m *MockKubernetesService) NewDeleteKubernetesClusterParams(id string) (r0 *cloudstack.DeleteKubernetesClusterParams) {
+	m.record("NewDeleteKubernetesClusterParams", id)
+	if m.NewDeleteKubernetesClusterParamsFunc != nil {
+		return m.NewDeleteKubernetesClusterParamsFunc(id)
+	}
+	return cloudstack.NewKubernetesService(nil).NewDeleteKubernetesClusterParams(id)
+}
+
+// DeleteKubernetesCluster records the call and calls DeleteKubernetesClusterFunc, or DeleteKubernetesClusterWithContextFunc if only that is set
+func (m *MockKubernetesService) DeleteKubernetesCluster(p *cloudstack.DeleteKubernetesClusterParams) (r0 *cloudstack.DeleteKubernetesClusterResponse, r1 error) {
+	m.record("DeleteKubernetesCluster", p)
+	if m.DeleteKubernetesClusterFunc != nil {
+		return m.DeleteKubernetesClusterFunc(p)
+	}
+	if m.DeleteKubernetesClusterWithContextFunc != nil {
+		return m.DeleteKubernetesClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.DeleteKubernetesCluster")
+	return
+}
+
+// DeleteKubernetesClusterWithContext records the call and calls DeleteKubernetesClusterWithContextFunc, or DeleteKubernetesClusterFunc if only that is set
+func (m *MockKubernetesService) DeleteKubernetesClusterWithContext(ctx context.Context, p *cloudstack.DeleteKubernetesClusterParams) (r0 *cloudstack.DeleteKubernetesClusterResponse, r1 error) {
+	m.record("DeleteKubernetesClusterWithContext", ctx, p)
+	if m.DeleteKubernetesClusterWithContextFunc != nil {
+		return m.DeleteKubernetesClusterWithContextFunc(ctx, p)
+	}
+	if m.DeleteKubernetesClusterFunc != nil {
+		return m.DeleteKubernetesClusterFunc(p)
+	}
+	r1 = notMocked("KubernetesService.DeleteKubernetesClusterWithContext")
+	return
+}
+
+// DeleteKubernetesClusterAsync records the call and calls DeleteKubernetesClusterAsyncFunc, or DeleteKubernetesClusterAsyncWithContextFunc if only that is set
+func (m *MockKubernetesService) DeleteKubernetesClusterAsync(p *cloudstack.DeleteKubernetesClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteKubernetesClusterAsync", p)
+	if m.DeleteKubernetesClusterAsyncFunc != nil {
+		return m.DeleteKubernetesClusterAsyncFunc(p)
+	}
+	if m.DeleteKubernetesClusterAsyncWithContextFunc != nil {
+		return m.DeleteKubernetesClusterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.DeleteKubernetesClusterAsync")
+	return
+}
+
+// DeleteKubernetesClusterAsyncWithContext records the call and calls DeleteKubernetesClusterAsyncWithContextFunc, or DeleteKubernetesClusterAsyncFunc if only that is set
+func (m *MockKubernetesService) DeleteKubernetesClusterAsyncWithContext(ctx context.Context, p *cloudstack.DeleteKubernetesClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteKubernetesClusterAsyncWithContext", ctx, p)
+	if m.DeleteKubernetesClusterAsyncWithContextFunc != nil {
+		return m.DeleteKubernetesClusterAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteKubernetesClusterAsyncFunc != nil {
+		return m.DeleteKubernetesClusterAsyncFunc(p)
+	}
+	r1 = notMocked("KubernetesService.DeleteKubernetesClusterAsyncWithContext")
+	return
+}
+
+// NewDeleteKubernetesSupportedVersionParams records the call and calls NewDeleteKubernetesSupportedVersionParamsFunc
+func (m *MockKubernetesService) NewDeleteKubernetesSupportedVersionParams(id string) (r0 *cloudstack.DeleteKubernetesSupportedVersionParams) {
+	m.record("NewDeleteKubernetesSupportedVersionParams", id)
+	if m.NewDeleteKubernetesSupportedVersionParamsFunc != nil {
+		return m.NewDeleteKubernetesSupportedVersionParamsFunc(id)
+	}
+	return cloudstack.NewKubernetesService(nil).NewDeleteKubernetesSupportedVersionParams(id)
+}
+
+// DeleteKubernetesSupportedVersion records the call and calls DeleteKubernetesSupportedVersionFunc, or DeleteKubernetesSupportedVersionWithContextFunc if only that is set
+func (m *MockKubernetesService) DeleteKubernetesSupportedVersion(p *cloudstack.DeleteKubernetesSupportedVersionParams) (r0 *cloudstack.DeleteKubernetesSupportedVersionResponse, r1 error) {
+	m.record("DeleteKubernetesSupportedVersion", p)
+	if m.DeleteKubernetesSupportedVersionFunc != nil {
+		return m.DeleteKubernetesSupportedVersionFunc(p)
+	}
+	if m.DeleteKubernetesSupportedVersionWithContextFunc != nil {
+		return m.DeleteKubernetesSupportedVersionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.DeleteKubernetesSupportedVersion")
+	return
+}
+
+// DeleteKubernetesSupportedVersionWithContext records the call and calls DeleteKubernetesSupportedVersionWithContextFunc, or DeleteKubernetesSupportedVersionFunc if only that is set
+func (m *MockKubernetesService) DeleteKubernetesSupportedVersionWithContext(ctx context.Context, p *cloudstack.DeleteKubernetesSupportedVersionParams) (r0 *cloudstack.DeleteKubernetesSupportedVersionResponse, r1 error) {
+	m.record("DeleteKubernetesSupportedVersionWithContext", ctx, p)
+	if m.DeleteKubernetesSupportedVersionWithContextFunc != nil {
+		return m.DeleteKubernetesSupportedVersionWithContextFunc(ctx, p)
+	}
+	if m.DeleteKubernetesSupportedVersionFunc != nil {
+		return m.DeleteKubernetesSupportedVersionFunc(p)
+	}
+	r1 = notMocked("KubernetesService.DeleteKubernetesSupportedVersionWithContext")
+	return
+}
+
+// DeleteKubernetesSupportedVersionAsync records the call and calls DeleteKubernetesSupportedVersionAsyncFunc, or DeleteKubernetesSupportedVersionAsyncWithContextFunc if only that is set
+func (m *MockKubernetesService) DeleteKubernetesSupportedVersionAsync(p *cloudstack.DeleteKubernetesSupportedVersionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteKubernetesSupportedVersionAsync", p)
+	if m.DeleteKubernetesSupportedVersionAsyncFunc != nil {
+		return m.DeleteKubernetesSupportedVersionAsyncFunc(p)
+	}
+	if m.DeleteKubernetesSupportedVersionAsyncWithContextFunc != nil {
+		return m.DeleteKubernetesSupportedVersionAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.DeleteKubernetesSupportedVersionAsync")
+	return
+}
+
+// DeleteKubernetesSupportedVersionAsyncWithContext records the call and calls DeleteKubernetesSupportedVersionAsyncWithContextFunc, or DeleteKubernetesSupportedVersionAsyncFunc if only that is set
+func (m *MockKubernetesService) DeleteKubernetesSupportedVersionAsyncWithContext(ctx context.Context, p *cloudstack.DeleteKubernetesSupportedVersionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteKubernetesSupportedVersionAsyncWithContext", ctx, p)
+	if m.DeleteKubernetesSupportedVersionAsyncWithContextFunc != nil {
+		return m.DeleteKubernetesSupportedVersionAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteKubernetesSupportedVersionAsyncFunc != nil {
+		return m.DeleteKubernetesSupportedVersionAsyncFunc(p)
+	}
+	r1 = notMocked("KubernetesService.DeleteKubernetesSupportedVersionAsyncWithContext")
+	return
+}
+
+// NewGetKubernetesClusterConfigParams records the call and calls NewGetKubernetesClusterConfigParamsFunc
+func (m *MockKubernetesService) NewGetKubernetesClusterConfigParams() (r0 *cloudstack.GetKubernetesClusterConfigParams) {
+	m.record("NewGetKubernetesClusterConfigParams")
+	if m.NewGetKubernetesClusterConfigParamsFunc != nil {
+		return m.NewGetKubernetesClusterConfigParamsFunc()
+	}
+	return cloudstack.NewKubernetesService(nil).NewGetKubernetesClusterConfigParams()
+}
+
+// GetKubernetesClusterConfig records the call and calls GetKubernetesClusterConfigFunc, or GetKubernetesClusterConfigWithContextFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesClusterConfig(p *cloudstack.GetKubernetesClusterConfigParams) (r0 *cloudstack.GetKubernetesClusterConfigResponse, r1 error) {
+	m.record("GetKubernetesClusterConfig", p)
+	if m.GetKubernetesClusterConfigFunc != nil {
+		return m.GetKubernetesClusterConfigFunc(p)
+	}
+	if m.GetKubernetesClusterConfigWithContextFunc != nil {
+		return m.GetKubernetesClusterConfigWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.GetKubernetesClusterConfig")
+	return
+}
+
+// GetKubernetesClusterConfigWithContext records the call and calls GetKubernetesClusterConfigWithContextFunc, or GetKubernetesClusterConfigFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesClusterConfigWithContext(ctx context.Context, p *cloudstack.GetKubernetesClusterConfigParams) (r0 *cloudstack.GetKubernetesClusterConfigResponse, r1 error) {
+	m.record("GetKubernetesClusterConfigWithContext", ctx, p)
+	if m.GetKubernetesClusterConfigWithContextFunc != nil {
+		return m.GetKubernetesClusterConfigWithContextFunc(ctx, p)
+	}
+	if m.GetKubernetesClusterConfigFunc != nil {
+		return m.GetKubernetesClusterConfigFunc(p)
+	}
+	r1 = notMocked("KubernetesService.GetKubernetesClusterConfigWithContext")
+	return
+}
+
+// NewListKubernetesClustersParams records the call and calls NewListKubernetesClustersParamsFunc
+func (m *MockKubernetesService) NewListKubernetesClustersParams() (r0 *cloudstack.ListKubernetesClustersParams) {
+	m.record("NewListKubernetesClustersParams")
+	if m.NewListKubernetesClustersParamsFunc != nil {
+		return m.NewListKubernetesClustersParamsFunc()
+	}
+	return cloudstack.NewKubernetesService(nil).NewListKubernetesClustersParams()
+}
+
+// GetKubernetesClusterID records the call and calls GetKubernetesClusterIDFunc, or GetKubernetesClusterIDWithContextFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesClusterID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetKubernetesClusterID", name, opts)
+	if m.GetKubernetesClusterIDFunc != nil {
+		return m.GetKubernetesClusterIDFunc(name, opts...)
+	}
+	if m.GetKubernetesClusterIDWithContextFunc != nil {
+		return m.GetKubernetesClusterIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("KubernetesService.GetKubernetesClusterID")
+	return
+}
+
+// GetKubernetesClusterIDWithContext records the call and calls GetKubernetesClusterIDWithContextFunc, or GetKubernetesClusterIDFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesClusterIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetKubernetesClusterIDWithContext", ctx, name, opts)
+	if m.GetKubernetesClusterIDWithContextFunc != nil {
+		return m.GetKubernetesClusterIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetKubernetesClusterIDFunc != nil {
+		return m.GetKubernetesClusterIDFunc(name, opts...)
+	}
+	r2 = notMocked("KubernetesService.GetKubernetesClusterIDWithContext")
+	return
+}
+
+// GetKubernetesClusterByName records the call and calls GetKubernetesClusterByNameFunc, or GetKubernetesClusterByNameWithContextFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesClusterByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.KubernetesCluster, r1 int, r2 error) {
+	m.record("GetKubernetesClusterByName", name, opts)
+	if m.GetKubernetesClusterByNameFunc != nil {
+		return m.GetKubernetesClusterByNameFunc(name, opts...)
+	}
+	if m.GetKubernetesClusterByNameWithContextFunc != nil {
+		return m.GetKubernetesClusterByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("KubernetesService.GetKubernetesClusterByName")
+	return
+}
+
+// GetKubernetesClusterByNameWithContext records the call and calls GetKubernetesClusterByNameWithContextFunc, or GetKubernetesClusterByNameFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesClusterByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.KubernetesCluster, r1 int, r2 error) {
+	m.record("GetKubernetesClusterByNameWithContext", ctx, name, opts)
+	if m.GetKubernetesClusterByNameWithContextFunc != nil {
+		return m.GetKubernetesClusterByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetKubernetesClusterByNameFunc != nil {
+		return m.GetKubernetesClusterByNameFunc(name, opts...)
+	}
+	r2 = notMocked("KubernetesService.GetKubernetesClusterByNameWithContext")
+	return
+}
+
+// GetKubernetesClusterByID records the call and calls GetKubernetesClusterByIDFunc, or GetKubernetesClusterByIDWithContextFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesClusterByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.KubernetesCluster, r1 int, r2 error) {
+	m.record("GetKubernetesClusterByID", id, opts)
+	if m.GetKubernetesClusterByIDFunc != nil {
+		return m.GetKubernetesClusterByIDFunc(id, opts...)
+	}
+	if m.GetKubernetesClusterByIDWithContextFunc != nil {
+		return m.GetKubernetesClusterByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("KubernetesService.GetKubernetesClusterByID")
+	return
+}
+
+// GetKubernetesClusterByIDWithContext records the call and calls GetKubernetesClusterByIDWithContextFunc, or GetKubernetesClusterByIDFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesClusterByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.KubernetesCluster, r1 int, r2 error) {
+	m.record("GetKubernetesClusterByIDWithContext", ctx, id, opts)
+	if m.GetKubernetesClusterByIDWithContextFunc != nil {
+		return m.GetKubernetesClusterByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetKubernetesClusterByIDFunc != nil {
+		return m.GetKubernetesClusterByIDFunc(id, opts...)
+	}
+	r2 = notMocked("KubernetesService.GetKubernetesClusterByIDWithContext")
+	return
+}
+
+// ListKubernetesClusters records the call and calls ListKubernetesClustersFunc, or ListKubernetesClustersWithContextFunc if only that is set
+func (m *MockKubernetesService) ListKubernetesClusters(p *cloudstack.ListKubernetesClustersParams) (r0 *cloudstack.ListKubernetesClustersResponse, r1 error) {
+	m.record("ListKubernetesClusters", p)
+	if m.ListKubernetesClustersFunc != nil {
+		return m.ListKubernetesClustersFunc(p)
+	}
+	if m.ListKubernetesClustersWithContextFunc != nil {
+		return m.ListKubernetesClustersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.ListKubernetesClusters")
+	return
+}
+
+// ListKubernetesClustersWithContext records the call and calls ListKubernetesClustersWithContextFunc, or ListKubernetesClustersFunc if only that is set
+func (m *MockKubernetesService) ListKubernetesClustersWithContext(ctx context.Context, p *cloudstack.ListKubernetesClustersParams) (r0 *cloudstack.ListKubernetesClustersResponse, r1 error) {
+	m.record("ListKubernetesClustersWithContext", ctx, p)
+	if m.ListKubernetesClustersWithContextFunc != nil {
+		return m.ListKubernetesClustersWithContextFunc(ctx, p)
+	}
+	if m.ListKubernetesClustersFunc != nil {
+		return m.ListKubernetesClustersFunc(p)
+	}
+	r1 = notMocked("KubernetesService.ListKubernetesClustersWithContext")
+	return
+}
+
+// NewListKubernetesClustersPager records the call and calls NewListKubernetesClustersPagerFunc
+func (m *MockKubernetesService) NewListKubernetesClustersPager(p *cloudstack.ListKubernetesClustersParams) (r0 *cloudstack.ListKubernetesClustersPager) {
+	m.record("NewListKubernetesClustersPager", p)
+	if m.NewListKubernetesClustersPagerFunc != nil {
+		return m.NewListKubernetesClustersPagerFunc(p)
+	}
+	return
+}
+
+// ListKubernetesClustersAll records the call and calls ListKubernetesClustersAllFunc, or ListKubernetesClustersAllWithContextFunc if only that is set
+func (m *MockKubernetesService) ListKubernetesClustersAll(p *cloudstack.ListKubernetesClustersParams) (r0 *cloudstack.ListKubernetesClustersResponse, r1 error) {
+	m.record("ListKubernetesClustersAll", p)
+	if m.ListKubernetesClustersAllFunc != nil {
+		return m.ListKubernetesClustersAllFunc(p)
+	}
+	if m.ListKubernetesClustersAllWithContextFunc != nil {
+		return m.ListKubernetesClustersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.ListKubernetesClustersAll")
+	return
+}
+
+// ListKubernetesClustersAllWithContext records the call and calls ListKubernetesClustersAllWithContextFunc, or ListKubernetesClustersAllFunc if only that is set
+func (m *MockKubernetesService) ListKubernetesClustersAllWithContext(ctx context.Context, p *cloudstack.ListKubernetesClustersParams) (r0 *cloudstack.ListKubernetesClustersResponse, r1 error) {
+	m.record("ListKubernetesClustersAllWithContext", ctx, p)
+	if m.ListKubernetesClustersAllWithContextFunc != nil {
+		return m.ListKubernetesClustersAllWithContextFunc(ctx, p)
+	}
+	if m.ListKubernetesClustersAllFunc != nil {
+		return m.ListKubernetesClustersAllFunc(p)
+	}
+	r1 = notMocked("KubernetesService.ListKubernetesClustersAllWithContext")
+	return
+}
+
+// NewListKubernetesSupportedVersionsParams records the call and calls NewListKubernetesSupportedVersionsParamsFunc
+func (m *MockKubernetesService) NewListKubernetesSupportedVersionsParams() (r0 *cloudstack.ListKubernetesSupportedVersionsParams) {
+	m.record("NewListKubernetesSupportedVersionsParams")
+	if m.NewListKubernetesSupportedVersionsParamsFunc != nil {
+		return m.NewListKubernetesSupportedVersionsParamsFunc()
+	}
+	return cloudstack.NewKubernetesService(nil).NewListKubernetesSupportedVersionsParams()
+}
+
+// GetKubernetesSupportedVersionID records the call and calls GetKubernetesSupportedVersionIDFunc, or GetKubernetesSupportedVersionIDWithContextFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesSupportedVersionID(keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetKubernetesSupportedVersionID", keyword, opts)
+	if m.GetKubernetesSupportedVersionIDFunc != nil {
+		return m.GetKubernetesSupportedVersionIDFunc(keyword, opts...)
+	}
+	if m.GetKubernetesSupportedVersionIDWithContextFunc != nil {
+		return m.GetKubernetesSupportedVersionIDWithContextFunc(context.Background(), keyword, opts...)
+	}
+	r2 = notMocked("KubernetesService.GetKubernetesSupportedVersionID")
+	return
+}
+
+// GetKubernetesSupportedVersionIDWithContext records the call and calls GetKubernetesSupportedVersionIDWithContextFunc, or GetKubernetesSupportedVersionIDFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesSupportedVersionIDWithContext(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetKubernetesSupportedVersionIDWithContext", ctx, keyword, opts)
+	if m.GetKubernetesSupportedVersionIDWithContextFunc != nil {
+		return m.GetKubernetesSupportedVersionIDWithContextFunc(ctx, keyword, opts...)
+	}
+	if m.GetKubernetesSupportedVersionIDFunc != nil {
+		return m.GetKubernetesSupportedVersionIDFunc(keyword, opts...)
+	}
+	r2 = notMocked("KubernetesService.GetKubernetesSupportedVersionIDWithContext")
+	return
+}
+
+// GetKubernetesSupportedVersionByName records the call and calls GetKubernetesSupportedVersionByNameFunc, or GetKubernetesSupportedVersionByNameWithContextFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesSupportedVersionByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.KubernetesSupportedVersion, r1 int, r2 error) {
+	m.record("GetKubernetesSupportedVersionByName", name, opts)
+	if m.GetKubernetesSupportedVersionByNameFunc != nil {
+		return m.GetKubernetesSupportedVersionByNameFunc(name, opts...)
+	}
+	if m.GetKubernetesSupportedVersionByNameWithContextFunc != nil {
+		return m.GetKubernetesSupportedVersionByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("KubernetesService.GetKubernetesSupportedVersionByName")
+	return
+}
+
+// GetKubernetesSupportedVersionByNameWithContext records the call and calls GetKubernetesSupportedVersionByNameWithContextFunc, or GetKubernetesSupportedVersionByNameFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesSupportedVersionByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.KubernetesSupportedVersion, r1 int, r2 error) {
+	m.record("GetKubernetesSupportedVersionByNameWithContext", ctx, name, opts)
+	if m.GetKubernetesSupportedVersionByNameWithContextFunc != nil {
+		return m.GetKubernetesSupportedVersionByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetKubernetesSupportedVersionByNameFunc != nil {
+		return m.GetKubernetesSupportedVersionByNameFunc(name, opts...)
+	}
+	r2 = notMocked("KubernetesService.GetKubernetesSupportedVersionByNameWithContext")
+	return
+}
+
+// GetKubernetesSupportedVersionByID records the call and calls GetKubernetesSupportedVersionByIDFunc, or GetKubernetesSupportedVersionByIDWithContextFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesSupportedVersionByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.KubernetesSupportedVersion, r1 int, r2 error) {
+	m.record("GetKubernetesSupportedVersionByID", id, opts)
+	if m.GetKubernetesSupportedVersionByIDFunc != nil {
+		return m.GetKubernetesSupportedVersionByIDFunc(id, opts...)
+	}
+	if m.GetKubernetesSupportedVersionByIDWithContextFunc != nil {
+		return m.GetKubernetesSupportedVersionByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("KubernetesService.GetKubernetesSupportedVersionByID")
+	return
+}
+
+// GetKubernetesSupportedVersionByIDWithContext records the call and calls GetKubernetesSupportedVersionByIDWithContextFunc, or GetKubernetesSupportedVersionByIDFunc if only that is set
+func (m *MockKubernetesService) GetKubernetesSupportedVersionByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.KubernetesSupportedVersion, r1 int, r2 error) {
+	m.record("GetKubernetesSupportedVersionByIDWithContext", ctx, id, opts)
+	if m.GetKubernetesSupportedVersionByIDWithContextFunc != nil {
+		return m.GetKubernetesSupportedVersionByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetKubernetesSupportedVersionByIDFunc != nil {
+		return m.GetKubernetesSupportedVersionByIDFunc(id, opts...)
+	}
+	r2 = notMocked("KubernetesService.GetKubernetesSupportedVersionByIDWithContext")
+	return
+}
+
+// ListKubernetesSupportedVersions records the call and calls ListKubernetesSupportedVersionsFunc, or ListKubernetesSupportedVersionsWithContextFunc if only that is set
+func (m *MockKubernetesService) ListKubernetesSupportedVersions(p *cloudstack.ListKubernetesSupportedVersionsParams) (r0 *cloudstack.ListKubernetesSupportedVersionsResponse, r1 error) {
+	m.record("ListKubernetesSupportedVersions", p)
+	if m.ListKubernetesSupportedVersionsFunc != nil {
+		return m.ListKubernetesSupportedVersionsFunc(p)
+	}
+	if m.ListKubernetesSupportedVersionsWithContextFunc != nil {
+		return m.ListKubernetesSupportedVersionsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.ListKubernetesSupportedVersions")
+	return
+}
+
+// ListKubernetesSupportedVersionsWithContext records the call and calls ListKubernetesSupportedVersionsWithContextFunc, or ListKubernetesSupportedVersionsFunc if only that is set
+func (m *MockKubernetesService) ListKubernetesSupportedVersionsWithContext(ctx context.Context, p *cloudstack.ListKubernetesSupportedVersionsParams) (r0 *cloudstack.ListKubernetesSupportedVersionsResponse, r1 error) {
+	m.record("ListKubernetesSupportedVersionsWithContext", ctx, p)
+	if m.ListKubernetesSupportedVersionsWithContextFunc != nil {
+		return m.ListKubernetesSupportedVersionsWithContextFunc(ctx, p)
+	}
+	if m.ListKubernetesSupportedVersionsFunc != nil {
+		return m.ListKubernetesSupportedVersionsFunc(p)
+	}
+	r1 = notMocked("KubernetesService.ListKubernetesSupportedVersionsWithContext")
+	return
+}
+
+// NewListKubernetesSupportedVersionsPager records the call and calls NewListKubernetesSupportedVersionsPagerFunc
+func (m *MockKubernetesService) NewListKubernetesSupportedVersionsPager(p *cloudstack.ListKubernetesSupportedVersionsParams) (r0 *cloudstack.ListKubernetesSupportedVersionsPager) {
+	m.record("NewListKubernetesSupportedVersionsPager", p)
+	if m.NewListKubernetesSupportedVersionsPagerFunc != nil {
+		return m.NewListKubernetesSupportedVersionsPagerFunc(p)
+	}
+	return
+}
+
+// ListKubernetesSupportedVersionsAll records the call and calls ListKubernetesSupportedVersionsAllFunc, or ListKubernetesSupportedVersionsAllWithContextFunc if only that is set
+func (m *MockKubernetesService) ListKubernetesSupportedVersionsAll(p *cloudstack.ListKubernetesSupportedVersionsParams) (r0 *cloudstack.ListKubernetesSupportedVersionsResponse, r1 error) {
+	m.record("ListKubernetesSupportedVersionsAll", p)
+	if m.ListKubernetesSupportedVersionsAllFunc != nil {
+		return m.ListKubernetesSupportedVersionsAllFunc(p)
+	}
+	if m.ListKubernetesSupportedVersionsAllWithContextFunc != nil {
+		return m.ListKubernetesSupportedVersionsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.ListKubernetesSupportedVersionsAll")
+	return
+}
+
+// ListKubernetesSupportedVersionsAllWithContext records the call and calls ListKubernetesSupportedVersionsAllWithContextFunc, or ListKubernetesSupportedVersionsAllFunc if only that is set
+func (m *MockKubernetesService) ListKubernetesSupportedVersionsAllWithContext(ctx context.Context, p *cloudstack.ListKubernetesSupportedVersionsParams) (r0 *cloudstack.ListKubernetesSupportedVersionsResponse, r1 error) {
+	m.record("ListKubernetesSupportedVersionsAllWithContext", ctx, p)
+	if m.ListKubernetesSupportedVersionsAllWithContextFunc != nil {
+		return m.ListKubernetesSupportedVersionsAllWithContextFunc(ctx, p)
+	}
+	if m.ListKubernetesSupportedVersionsAllFunc != nil {
+		return m.ListKubernetesSupportedVersionsAllFunc(p)
+	}
+	r1 = notMocked("KubernetesService.ListKubernetesSupportedVersionsAllWithContext")
+	return
+}
+
+// NewScaleKubernetesClusterParams records the call and calls NewScaleKubernetesClusterParamsFunc
+func (m *MockKubernetesService) NewScaleKubernetesClusterParams(id string) (r0 *cloudstack.ScaleKubernetesClusterParams) {
+	m.record("NewScaleKubernetesClusterParams", id)
+	if m.NewScaleKubernetesClusterParamsFunc != nil {
+		return m.NewScaleKubernetesClusterParamsFunc(id)
+	}
+	return cloudstack.NewKubernetesService(nil).NewScaleKubernetesClusterParams(id)
+}
+
+// ScaleKubernetesCluster records the call and calls ScaleKubernetesClusterFunc, or ScaleKubernetesClusterWithContextFunc if only that is set
+func (m *MockKubernetesService) ScaleKubernetesCluster(p *cloudstack.ScaleKubernetesClusterParams) (r0 *cloudstack.ScaleKubernetesClusterResponse, r1 error) {
+	m.record("ScaleKubernetesCluster", p)
+	if m.ScaleKubernetesClusterFunc != nil {
+		return m.ScaleKubernetesClusterFunc(p)
+	}
+	if m.ScaleKubernetesClusterWithContextFunc != nil {
+		return m.ScaleKubernetesClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.ScaleKubernetesCluster")
+	return
+}
+
+// ScaleKubernetesClusterWithContext records the call and calls ScaleKubernetesClusterWithContextFunc, or ScaleKubernetesClusterFunc if only that is set
+func (m *MockKubernetesService) ScaleKubernetesClusterWithContext(ctx context.Context, p *cloudstack.ScaleKubernetesClusterParams) (r0 *cloudstack.ScaleKubernetesClusterResponse, r1 error) {
+	m.record("ScaleKubernetesClusterWithContext", ctx, p)
+	if m.ScaleKubernetesClusterWithContextFunc != nil {
+		return m.ScaleKubernetesClusterWithContextFunc(ctx, p)
+	}
+	if m.ScaleKubernetesClusterFunc != nil {
+		return m.ScaleKubernetesClusterFunc(p)
+	}
+	r1 = notMocked("KubernetesService.ScaleKubernetesClusterWithContext")
+	return
+}
+
+// ScaleKubernetesClusterAsync records the call and calls ScaleKubernetesClusterAsyncFunc, or ScaleKubernetesClusterAsyncWithContextFunc if only that is set
+func (m *MockKubernetesService) ScaleKubernetesClusterAsync(p *cloudstack.ScaleKubernetesClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ScaleKubernetesClusterAsync", p)
+	if m.ScaleKubernetesClusterAsyncFunc != nil {
+		return m.ScaleKubernetesClusterAsyncFunc(p)
+	}
+	if m.ScaleKubernetesClusterAsyncWithContextFunc != nil {
+		return m.ScaleKubernetesClusterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.ScaleKubernetesClusterAsync")
+	return
+}
+
+// ScaleKubernetesClusterAsyncWithContext records the call and calls ScaleKubernetesClusterAsyncWithContextFunc, or ScaleKubernetesClusterAsyncFunc if only that is set
+func (m *MockKubernetesService) ScaleKubernetesClusterAsyncWithContext(ctx context.Context, p *cloudstack.ScaleKubernetesClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ScaleKubernetesClusterAsyncWithContext", ctx, p)
+	if m.ScaleKubernetesClusterAsyncWithContextFunc != nil {
+		return m.ScaleKubernetesClusterAsyncWithContextFunc(ctx, p)
+	}
+	if m.ScaleKubernetesClusterAsyncFunc != nil {
+		return m.ScaleKubernetesClusterAsyncFunc(p)
+	}
+	r1 = notMocked("KubernetesService.ScaleKubernetesClusterAsyncWithContext")
+	return
+}
+
+// NewStartKubernetesClusterParams records the call and calls NewStartKubernetesClusterParamsFunc
+func (m *MockKubernetesService) NewStartKubernetesClusterParams(id string) (r0 *cloudstack.StartKubernetesClusterParams) {
+	m.record("NewStartKubernetesClusterParams", id)
+	if m.NewStartKubernetesClusterParamsFunc != nil {
+		return m.NewStartKubernetesClusterParamsFunc(id)
+	}
+	return cloudstack.NewKubernetesService(nil).NewStartKubernetesClusterParams(id)
+}
+
+// StartKubernetesCluster records the call and calls StartKubernetesClusterFunc, or StartKubernetesClusterWithContextFunc if only that is set
+func (m *MockKubernetesService) StartKubernetesCluster(p *cloudstack.StartKubernetesClusterParams) (r0 *cloudstack.StartKubernetesClusterResponse, r1 error) {
+	m.record("StartKubernetesCluster", p)
+	if m.StartKubernetesClusterFunc != nil {
+		return m.StartKubernetesClusterFunc(p)
+	}
+	if m.StartKubernetesClusterWithContextFunc != nil {
+		return m.StartKubernetesClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.StartKubernetesCluster")
+	return
+}
+
+// StartKubernetesClusterWithContext records the call and calls StartKubernetesClusterWithContextFunc, or StartKubernetesClusterFunc if only that is set
+func (m *MockKubernetesService) StartKubernetesClusterWithContext(ctx context.Context, p *cloudstack.StartKubernetesClusterParams) (r0 *cloudstack.StartKubernetesClusterResponse, r1 error) {
+	m.record("StartKubernetesClusterWithContext", ctx, p)
+	if m.StartKubernetesClusterWithContextFunc != nil {
+		return m.StartKubernetesClusterWithContextFunc(ctx, p)
+	}
+	if m.StartKubernetesClusterFunc != nil {
+		return m.StartKubernetesClusterFunc(p)
+	}
+	r1 = notMocked("KubernetesService.StartKubernetesClusterWithContext")
+	return
+}
+
+// StartKubernetesClusterAsync records the call and calls StartKubernetesClusterAsyncFunc, or StartKubernetesClusterAsyncWithContextFunc if only that is set
+func (m *MockKubernetesService) StartKubernetesClusterAsync(p *cloudstack.StartKubernetesClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StartKubernetesClusterAsync", p)
+	if m.StartKubernetesClusterAsyncFunc != nil {
+		return m.StartKubernetesClusterAsyncFunc(p)
+	}
+	if m.StartKubernetesClusterAsyncWithContextFunc != nil {
+		return m.StartKubernetesClusterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.StartKubernetesClusterAsync")
+	return
+}
+
+// StartKubernetesClusterAsyncWithContext records the call and calls StartKubernetesClusterAsyncWithContextFunc, or StartKubernetesClusterAsyncFunc if only that is set
+func (m *MockKubernetesService) StartKubernetesClusterAsyncWithContext(ctx context.Context, p *cloudstack.StartKubernetesClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StartKubernetesClusterAsyncWithContext", ctx, p)
+	if m.StartKubernetesClusterAsyncWithContextFunc != nil {
+		return m.StartKubernetesClusterAsyncWithContextFunc(ctx, p)
+	}
+	if m.StartKubernetesClusterAsyncFunc != nil {
+		return m.StartKubernetesClusterAsyncFunc(p)
+	}
+	r1 = notMocked("KubernetesService.StartKubernetesClusterAsyncWithContext")
+	return
+}
+
+// NewStopKubernetesClusterParams records the call and calls NewStopKubernetesClusterParamsFunc
+func (m *MockKubernetesService) NewStopKubernetesClusterParams(id string) (r0 *cloudstack.StopKubernetesClusterParams) {
+	m.record("NewStopKubernetesClusterParams", id)
+	if m.NewStopKubernetesClusterParamsFunc != nil {
+		return m.NewStopKubernetesClusterParamsFunc(id)
+	}
+	return cloudstack.NewKubernetesService(nil).NewStopKubernetesClusterParams(id)
+}
+
+// StopKubernetesCluster records the call and calls StopKubernetesClusterFunc, or StopKubernetesClusterWithContextFunc if only that is set
+func (m *MockKubernetesService) StopKubernetesCluster(p *cloudstack.StopKubernetesClusterParams) (r0 *cloudstack.StopKubernetesClusterResponse, r1 error) {
+	m.record("StopKubernetesCluster", p)
+	if m.StopKubernetesClusterFunc != nil {
+		return m.StopKubernetesClusterFunc(p)
+	}
+	if m.StopKubernetesClusterWithContextFunc != nil {
+		return m.StopKubernetesClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.StopKubernetesCluster")
+	return
+}
+
+// StopKubernetesClusterWithContext records the call and calls StopKubernetesClusterWithContextFunc, or StopKubernetesClusterFunc if only that is set
+func (m *MockKubernetesService) StopKubernetesClusterWithContext(ctx context.Context, p *cloudstack.StopKubernetesClusterParams) (r0 *cloudstack.StopKubernetesClusterResponse, r1 error) {
+	m.record("StopKubernetesClusterWithContext", ctx, p)
+	if m.StopKubernetesClusterWithContextFunc != nil {
+		return m.StopKubernetesClusterWithContextFunc(ctx, p)
+	}
+	if m.StopKubernetesClusterFunc != nil {
+		return m.StopKubernetesClusterFunc(p)
+	}
+	r1 = notMocked("KubernetesService.StopKubernetesClusterWithContext")
+	return
+}
+
+// StopKubernetesClusterAsync records the call and calls StopKubernetesClusterAsyncFunc, or StopKubernetesClusterAsyncWithContextFunc if only that is set
+func (m *MockKubernetesService) StopKubernetesClusterAsync(p *cloudstack.StopKubernetesClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StopKubernetesClusterAsync", p)
+	if m.StopKubernetesClusterAsyncFunc != nil {
+		return m.StopKubernetesClusterAsyncFunc(p)
+	}
+	if m.StopKubernetesClusterAsyncWithContextFunc != nil {
+		return m.StopKubernetesClusterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.StopKubernetesClusterAsync")
+	return
+}
+
+// StopKubernetesClusterAsyncWithContext records the call and calls StopKubernetesClusterAsyncWithContextFunc, or StopKubernetesClusterAsyncFunc if only that is set
+func (m *MockKubernetesService) StopKubernetesClusterAsyncWithContext(ctx context.Context, p *cloudstack.StopKubernetesClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StopKubernetesClusterAsyncWithContext", ctx, p)
+	if m.StopKubernetesClusterAsyncWithContextFunc != nil {
+		return m.StopKubernetesClusterAsyncWithContextFunc(ctx, p)
+	}
+	if m.StopKubernetesClusterAsyncFunc != nil {
+		return m.StopKubernetesClusterAsyncFunc(p)
+	}
+	r1 = notMocked("KubernetesService.StopKubernetesClusterAsyncWithContext")
+	return
+}
+
+// NewUpdateKubernetesSupportedVersionParams records the call and calls NewUpdateKubernetesSupportedVersionParamsFunc
+func (m *MockKubernetesService) NewUpdateKubernetesSupportedVersionParams(id string, state string) (r0 *cloudstack.UpdateKubernetesSupportedVersionParams) {
+	m.record("NewUpdateKubernetesSupportedVersionParams", id, state)
+	if m.NewUpdateKubernetesSupportedVersionParamsFunc != nil {
+		return m.NewUpdateKubernetesSupportedVersionParamsFunc(id, state)
+	}
+	return cloudstack.NewKubernetesService(nil).NewUpdateKubernetesSupportedVersionParams(id, state)
+}
+
+// UpdateKubernetesSupportedVersion records the call and calls UpdateKubernetesSupportedVersionFunc, or UpdateKubernetesSupportedVersionWithContextFunc if only that is set
+func (m *MockKubernetesService) UpdateKubernetesSupportedVersion(p *cloudstack.UpdateKubernetesSupportedVersionParams) (r0 *cloudstack.UpdateKubernetesSupportedVersionResponse, r1 error) {
+	m.record("UpdateKubernetesSupportedVersion", p)
+	if m.UpdateKubernetesSupportedVersionFunc != nil {
+		return m.UpdateKubernetesSupportedVersionFunc(p)
+	}
+	if m.UpdateKubernetesSupportedVersionWithContextFunc != nil {
+		return m.UpdateKubernetesSupportedVersionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.UpdateKubernetesSupportedVersion")
+	return
+}
+
+// UpdateKubernetesSupportedVersionWithContext records the call and calls UpdateKubernetesSupportedVersionWithContextFunc, or UpdateKubernetesSupportedVersionFunc if only that is set
+func (m *MockKubernetesService) UpdateKubernetesSupportedVersionWithContext(ctx context.Context, p *cloudstack.UpdateKubernetesSupportedVersionParams) (r0 *cloudstack.UpdateKubernetesSupportedVersionResponse, r1 error) {
+	m.record("UpdateKubernetesSupportedVersionWithContext", ctx, p)
+	if m.UpdateKubernetesSupportedVersionWithContextFunc != nil {
+		return m.UpdateKubernetesSupportedVersionWithContextFunc(ctx, p)
+	}
+	if m.UpdateKubernetesSupportedVersionFunc != nil {
+		return m.UpdateKubernetesSupportedVersionFunc(p)
+	}
+	r1 = notMocked("KubernetesService.UpdateKubernetesSupportedVersionWithContext")
+	return
+}
+
+// NewUpgradeKubernetesClusterParams records the call and calls NewUpgradeKubernetesClusterParamsFunc
+func (m *MockKubernetesService) NewUpgradeKubernetesClusterParams(id string, kubernetesversionid string) (r0 *cloudstack.UpgradeKubernetesClusterParams) {
+	m.record("NewUpgradeKubernetesClusterParams", id, kubernetesversionid)
+	if m.NewUpgradeKubernetesClusterParamsFunc != nil {
+		return m.NewUpgradeKubernetesClusterParamsFunc(id, kubernetesversionid)
+	}
+	return cloudstack.NewKubernetesService(nil).NewUpgradeKubernetesClusterParams(id, kubernetesversionid)
+}
+
+// UpgradeKubernetesCluster records the call and calls UpgradeKubernetesClusterFunc, or UpgradeKubernetesClusterWithContextFunc if only that is set
+func (m *MockKubernetesService) UpgradeKubernetesCluster(p *cloudstack.UpgradeKubernetesClusterParams) (r0 *cloudstack.UpgradeKubernetesClusterResponse, r1 error) {
+	m.record("UpgradeKubernetesCluster", p)
+	if m.UpgradeKubernetesClusterFunc != nil {
+		return m.UpgradeKubernetesClusterFunc(p)
+	}
+	if m.UpgradeKubernetesClusterWithContextFunc != nil {
+		return m.UpgradeKubernetesClusterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.UpgradeKubernetesCluster")
+	return
+}
+
+// UpgradeKubernetesClusterWithContext records the call and calls UpgradeKubernetesClusterWithContextFunc, or UpgradeKubernetesClusterFunc if only that is set
+func (m *MockKubernetesService) UpgradeKubernetesClusterWithContext(ctx context.Context, p *cloudstack.UpgradeKubernetesClusterParams) (r0 *cloudstack.UpgradeKubernetesClusterResponse, r1 error) {
+	m.record("UpgradeKubernetesClusterWithContext", ctx, p)
+	if m.UpgradeKubernetesClusterWithContextFunc != nil {
+		return m.UpgradeKubernetesClusterWithContextFunc(ctx, p)
+	}
+	if m.UpgradeKubernetesClusterFunc != nil {
+		return m.UpgradeKubernetesClusterFunc(p)
+	}
+	r1 = notMocked("KubernetesService.UpgradeKubernetesClusterWithContext")
+	return
+}
+
+// UpgradeKubernetesClusterAsync records the call and calls UpgradeKubernetesClusterAsyncFunc, or UpgradeKubernetesClusterAsyncWithContextFunc if only that is set
+func (m *MockKubernetesService) UpgradeKubernetesClusterAsync(p *cloudstack.UpgradeKubernetesClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpgradeKubernetesClusterAsync", p)
+	if m.UpgradeKubernetesClusterAsyncFunc != nil {
+		return m.UpgradeKubernetesClusterAsyncFunc(p)
+	}
+	if m.UpgradeKubernetesClusterAsyncWithContextFunc != nil {
+		return m.UpgradeKubernetesClusterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("KubernetesService.UpgradeKubernetesClusterAsync")
+	return
+}
+
+// UpgradeKubernetesClusterAsyncWithContext records the call and calls UpgradeKubernetesClusterAsyncWithContextFunc, or UpgradeKubernetesClusterAsyncFunc if only that is set
+func (m *MockKubernetesService) UpgradeKubernetesClusterAsyncWithContext(ctx context.Context, p *cloudstack.UpgradeKubernetesClusterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpgradeKubernetesClusterAsyncWithContext", ctx, p)
+	if m.UpgradeKubernetesClusterAsyncWithContextFunc != nil {
+		return m.UpgradeKubernetesClusterAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpgradeKubernetesClusterAsyncFunc != nil {
+		return m.UpgradeKubernetesClusterAsyncFunc(p)
+	}
+	r1 = notMocked("KubernetesService.UpgradeKubernetesClusterAsyncWithContext")
+	return
+}
@@ -0,0 +1,533 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockLDAPService is a mock of cloudstack.LDAPServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockLDAPService struct {
+	Recorder
+
+	NewAddLdapConfigurationParamsFunc        func(hostname string, port int) *cloudstack.AddLdapConfigurationParams
+	AddLdapConfigurationFunc                 func(p *cloudstack.AddLdapConfigurationParams) (*cloudstack.AddLdapConfigurationResponse, error)
+	AddLdapConfigurationWithContextFunc      func(ctx context.Context, p *cloudstack.AddLdapConfigurationParams) (*cloudstack.AddLdapConfigurationResponse, error)
+	NewDeleteLdapConfigurationParamsFunc     func(hostname string) *cloudstack.DeleteLdapConfigurationParams
+	DeleteLdapConfigurationFunc              func(p *cloudstack.DeleteLdapConfigurationParams) (*cloudstack.DeleteLdapConfigurationResponse, error)
+	DeleteLdapConfigurationWithContextFunc   func(ctx context.Context, p *cloudstack.DeleteLdapConfigurationParams) (*cloudstack.DeleteLdapConfigurationResponse, error)
+	NewImportLdapUsersParamsFunc             func() *cloudstack.ImportLdapUsersParams
+	ImportLdapUsersFunc                      func(p *cloudstack.ImportLdapUsersParams) (*cloudstack.ImportLdapUsersResponse, error)
+	ImportLdapUsersWithContextFunc           func(ctx context.Context, p *cloudstack.ImportLdapUsersParams) (*cloudstack.ImportLdapUsersResponse, error)
+	NewLdapConfigParamsFunc                  func() *cloudstack.LdapConfigParams
+	LdapConfigFunc                           func(p *cloudstack.LdapConfigParams) (*cloudstack.LdapConfigResponse, error)
+	LdapConfigWithContextFunc                func(ctx context.Context, p *cloudstack.LdapConfigParams) (*cloudstack.LdapConfigResponse, error)
+	NewLdapCreateAccountParamsFunc           func(username string) *cloudstack.LdapCreateAccountParams
+	LdapCreateAccountFunc                    func(p *cloudstack.LdapCreateAccountParams) (*cloudstack.LdapCreateAccountResponse, error)
+	LdapCreateAccountWithContextFunc         func(ctx context.Context, p *cloudstack.LdapCreateAccountParams) (*cloudstack.LdapCreateAccountResponse, error)
+	NewLdapRemoveParamsFunc                  func() *cloudstack.LdapRemoveParams
+	LdapRemoveFunc                           func(p *cloudstack.LdapRemoveParams) (*cloudstack.LdapRemoveResponse, error)
+	LdapRemoveWithContextFunc                func(ctx context.Context, p *cloudstack.LdapRemoveParams) (*cloudstack.LdapRemoveResponse, error)
+	NewLinkAccountToLdapParamsFunc           func(account string, accounttype int, domainid string, ldapdomain string) *cloudstack.LinkAccountToLdapParams
+	LinkAccountToLdapFunc                    func(p *cloudstack.LinkAccountToLdapParams) (*cloudstack.LinkAccountToLdapResponse, error)
+	LinkAccountToLdapWithContextFunc         func(ctx context.Context, p *cloudstack.LinkAccountToLdapParams) (*cloudstack.LinkAccountToLdapResponse, error)
+	NewLinkDomainToLdapParamsFunc            func(accounttype int, domainid string, lDAPType string) *cloudstack.LinkDomainToLdapParams
+	LinkDomainToLdapFunc                     func(p *cloudstack.LinkDomainToLdapParams) (*cloudstack.LinkDomainToLdapResponse, error)
+	LinkDomainToLdapWithContextFunc          func(ctx context.Context, p *cloudstack.LinkDomainToLdapParams) (*cloudstack.LinkDomainToLdapResponse, error)
+	NewListLdapConfigurationsParamsFunc      func() *cloudstack.ListLdapConfigurationsParams
+	ListLdapConfigurationsFunc               func(p *cloudstack.ListLdapConfigurationsParams) (*cloudstack.ListLdapConfigurationsResponse, error)
+	ListLdapConfigurationsWithContextFunc    func(ctx context.Context, p *cloudstack.ListLdapConfigurationsParams) (*cloudstack.ListLdapConfigurationsResponse, error)
+	NewListLdapConfigurationsPagerFunc       func(p *cloudstack.ListLdapConfigurationsParams) *cloudstack.ListLdapConfigurationsPager
+	ListLdapConfigurationsAllFunc            func(p *cloudstack.ListLdapConfigurationsParams) (*cloudstack.ListLdapConfigurationsResponse, error)
+	ListLdapConfigurationsAllWithContextFunc func(ctx context.Context, p *cloudstack.ListLdapConfigurationsParams) (*cloudstack.ListLdapConfigurationsResponse, error)
+	NewListLdapUsersParamsFunc               func() *cloudstack.ListLdapUsersParams
+	ListLdapUsersFunc                        func(p *cloudstack.ListLdapUsersParams) (*cloudstack.ListLdapUsersResponse, error)
+	ListLdapUsersWithContextFunc             func(ctx context.Context, p *cloudstack.ListLdapUsersParams) (*cloudstack.ListLdapUsersResponse, error)
+	NewListLdapUsersPagerFunc                func(p *cloudstack.ListLdapUsersParams) *cloudstack.ListLdapUsersPager
+	ListLdapUsersAllFunc                     func(p *cloudstack.ListLdapUsersParams) (*cloudstack.ListLdapUsersResponse, error)
+	ListLdapUsersAllWithContextFunc          func(ctx context.Context, p *cloudstack.ListLdapUsersParams) (*cloudstack.ListLdapUsersResponse, error)
+	NewSearchLdapParamsFunc                  func(query string) *cloudstack.SearchLdapParams
+	SearchLdapFunc                           func(p *cloudstack.SearchLdapParams) (*cloudstack.SearchLdapResponse, error)
+	SearchLdapWithContextFunc                func(ctx context.Context, p *cloudstack.SearchLdapParams) (*cloudstack.SearchLdapResponse, error)
+}
+
+var _ cloudstack.LDAPServiceIface = (*MockLDAPService)(nil)
+
+// NewAddLdapConfigurationParams records the call and calls NewAddLdapConfigurationParamsFunc
+func (m *MockLDAPService) NewAddLdapConfigurationParams(hostname string, port int) (r0 *cloudstack.AddLdapConfigurationParams) {
+	m.record("NewAddLdapConfigurationParams", hostname, port)
+	if m.NewAddLdapConfigurationParamsFunc != nil {
+		return m.NewAddLdapConfigurationParamsFunc(hostname, port)
+	}
+	return cloudstack.NewLDAPService(nil).NewAddLdapConfigurationParams(hostname, port)
+}
+
+// AddLdapConfiguration records the call and calls AddLdapConfigurationFunc, or AddLdapConfigurationWithContextFunc if only that is set
+func (m *MockLDAPService) AddLdapConfiguration(p *cloudstack.AddLdapConfigurationParams) (r0 *cloudstack.AddLdapConfigurationResponse, r1 error) {
+	m.record("AddLdapConfiguration", p)
+	if m.AddLdapConfigurationFunc != nil {
+		return m.AddLdapConfigurationFunc(p)
+	}
+	if m.AddLdapConfigurationWithContextFunc != nil {
+		return m.AddLdapConfigurationWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LDAPService.AddLdapConfiguration")
+	return
+}
+
+// AddLdapConfigurationWithContext records the call and calls AddLdapConfigurationWithContextFunc, or AddLdapConfigurationFunc if only that is set
+func (m *MockLDAPService) AddLdapConfigurationWithContext(ctx context.Context, p *cloudstack.AddLdapConfigurationParams) (r0 *cloudstack.AddLdapConfigurationResponse, r1 error) {
+	m.record("AddLdapConfigurationWithContext", ctx, p)
+	if m.AddLdapConfigurationWithContextFunc != nil {
+		return m.AddLdapConfigurationWithContextFunc(ctx, p)
+	}
+	if m.AddLdapConfigurationFunc != nil {
+		return m.AddLdapConfigurationFunc(p)
+	}
+	r1 = notMocked("LDAPService.AddLdapConfigurationWithContext")
+	return
+}
+
+// NewDeleteLdapConfigurationParams records the call and calls NewDeleteLdapConfigurationParamsFunc
+func (m *MockLDAPService) NewDeleteLdapConfigurationParams(hostname string) (r0 *cloudstack.DeleteLdapConfigurationParams) {
+	m.record("NewDeleteLdapConfigurationParams", hostname)
+	if m.NewDeleteLdapConfigurationParamsFunc != nil {
+		return m.NewDeleteLdapConfigurationParamsFunc(hostname)
+	}
+	return cloudstack.NewLDAPService(nil).NewDeleteLdapConfigurationParams(hostname)
+}
+
+// DeleteLdapConfiguration records the call and calls DeleteLdapConfigurationFunc, or DeleteLdapConfigurationWithContextFunc if only that is set
+func (m *MockLDAPService) DeleteLdapConfiguration(p *cloudstack.DeleteLdapConfigurationParams) (r0 *cloudstack.DeleteLdapConfigurationResponse, r1 error) {
+	m.record("DeleteLdapConfiguration", p)
+	if m.DeleteLdapConfigurationFunc != nil {
+		return m.DeleteLdapConfigurationFunc(p)
+	}
+	if m.DeleteLdapConfigurationWithContextFunc != nil {
+		return m.DeleteLdapConfigurationWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LDAPService.DeleteLdapConfiguration")
+	return
+}
+
+// DeleteLdapConfigurationWithContext records the call and calls DeleteLdapConfigurationWithContextFunc, or DeleteLdapConfigurationFunc if only that is set
+func (m *MockLDAPService) DeleteLdapConfigurationWithContext(ctx context.Context, p *cloudstack.DeleteLdapConfigurationParams) (r0 *cloudstack.DeleteLdapConfigurationResponse, r1 error) {
+	m.record("DeleteLdapConfigurationWithContext", ctx, p)
+	if m.DeleteLdapConfigurationWithContextFunc != nil {
+		return m.DeleteLdapConfigurationWithContextFunc(ctx, p)
+	}
+	if m.DeleteLdapConfigurationFunc != nil {
+		return m.DeleteLdapConfigurationFunc(p)
+	}
+	r1 = notMocked("LDAPService.DeleteLdapConfigurationWithContext")
+	return
+}
+
+// NewImportLdapUsersParams records the call and calls NewImportLdapUsersParamsFunc
+func (m *MockLDAPService) NewImportLdapUsersParams() (r0 *cloudstack.ImportLdapUsersParams) {
+	m.record("NewImportLdapUsersParams")
+	if m.NewImportLdapUsersParamsFunc != nil {
+		return m.NewImportLdapUsersParamsFunc()
+	}
+	return cloudstack.NewLDAPService(nil).NewImportLdapUsersParams()
+}
+
+// ImportLdapUsers records the call and calls ImportLdapUsersFunc, or ImportLdapUsersWithContextFunc if only that is set
+func (m *MockLDAPService) ImportLdapUsers(p *cloudstack.ImportLdapUsersParams) (r0 *cloudstack.ImportLdapUsersResponse, r1 error) {
+	m.record("ImportLdapUsers", p)
+	if m.ImportLdapUsersFunc != nil {
+		return m.ImportLdapUsersFunc(p)
+	}
+	if m.ImportLdapUsersWithContextFunc != nil {
+		return m.ImportLdapUsersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LDAPService.ImportLdapUsers")
+	return
+}
+
+// ImportLdapUsersWithContext records the call and calls ImportLdapUsersWithContextFunc, or ImportLdapUsersFunc if only that is set
+func (m *MockLDAPService) ImportLdapUsersWithContext(ctx context.Context, p *cloudstack.ImportLdapUsersParams) (r0 *cloudstack.ImportLdapUsersResponse, r1 error) {
+	m.record("ImportLdapUsersWithContext", ctx, p)
+	if m.ImportLdapUsersWithContextFunc != nil {
+		return m.ImportLdapUsersWithContextFunc(ctx, p)
+	}
+	if m.ImportLdapUsersFunc != nil {
+		return m.ImportLdapUsersFunc(p)
+	}
+	r1 = notMocked("LDAPService.ImportLdapUsersWithContext")
+	return
+}
+
+// NewLdapConfigParams records the call and calls NewLdapConfigParamsFunc
+func (m *MockLDAPService) NewLdapConfigParams() (r0 *cloudstack.LdapConfigParams) {
+	m.record("NewLdapConfigParams")
+	if m.NewLdapConfigParamsFunc != nil {
+		return m.NewLdapConfigParamsFunc()
+	}
+	return cloudstack.NewLDAPService(nil).NewLdapConfigParams()
+}
+
+// LdapConfig records the call and calls LdapConfigFunc, or LdapConfigWithContextFunc if only that is set
+func (m *MockLDAPService) LdapConfig(p *cloudstack.LdapConfigParams) (r0 *cloudstack.LdapConfigResponse, r1 error) {
+	m.record("LdapConfig", p)
+	if m.LdapConfigFunc != nil {
+		return m.LdapConfigFunc(p)
+	}
+	if m.LdapConfigWithContextFunc != nil {
+		return m.LdapConfigWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LDAPService.LdapConfig")
+	return
+}
+
+// LdapConfigWithContext records the call and calls LdapConfigWithContextFunc, or LdapConfigFunc if only that is set
+func (m *MockLDAPService) LdapConfigWithContext(ctx context.Context, p *cloudstack.LdapConfigParams) (r0 *cloudstack.LdapConfigResponse, r1 error) {
+	m.record("LdapConfigWithContext", ctx, p)
+	if m.LdapConfigWithContextFunc != nil {
+		return m.LdapConfigWithContextFunc(ctx, p)
+	}
+	if m.LdapConfigFunc != nil {
+		return m.LdapConfigFunc(p)
+	}
+	r1 = notMocked("LDAPService.LdapConfigWithContext")
+	return
+}
+
+// NewLdapCreateAccountParams records the call and calls NewLdapCreateAccountParamsFunc
+func (m *MockLDAPService) NewLdapCreateAccountParams(username string) (r0 *cloudstack.LdapCreateAccountParams) {
+	m.record("NewLdapCreateAccountParams", username)
+	if m.NewLdapCreateAccountParamsFunc != nil {
+		return m.NewLdapCreateAccountParamsFunc(username)
+	}
+	return cloudstack.NewLDAPService(nil).NewLdapCreateAccountParams(username)
+}
+
+// LdapCreateAccount records the call and calls LdapCreateAccountFunc, or LdapCreateAccountWithContextFunc if only that is set
+func (m *MockLDAPService) LdapCreateAccount(p *cloudstack.LdapCreateAccountParams) (r0 *cloudstack.LdapCreateAccountResponse, r1 error) {
+	m.record("LdapCreateAccount", p)
+	if m.LdapCreateAccountFunc != nil {
+		return m.LdapCreateAccountFunc(p)
+	}
+	if m.LdapCreateAccountWithContextFunc != nil {
+		return m.LdapCreateAccountWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LDAPService.LdapCreateAccount")
+	return
+}
+
+// LdapCreateAccountWithContext records the call and calls LdapCreateAccountWithContextFunc, or LdapCreateAccountFunc if only that is set
+func (m *MockLDAPService) LdapCreateAccountWithContext(ctx context.Context, p *cloudstack.LdapCreateAccountParams) (r0 *cloudstack.LdapCreateAccountResponse, r1 error) {
+	m.record("LdapCreateAccountWithContext", ctx, p)
+	if m.LdapCreateAccountWithContextFunc != nil {
+		return m.LdapCreateAccountWithContextFunc(ctx, p)
+	}
+	if m.LdapCreateAccountFunc != nil {
+		return m.LdapCreateAccountFunc(p)
+	}
+	r1 = notMocked("LDAPService.LdapCreateAccountWithContext")
+	return
+}
+
+// NewLdapRemoveParams records the call and calls NewLdapRemoveParamsFunc
+func (m *MockLDAPService) NewLdapRemoveParams() (r0 *cloudstack.LdapRemoveParams) {
+	m.record("NewLdapRemoveParams")
+	if m.NewLdapRemoveParamsFunc != nil {
+		return m.NewLdapRemoveParamsFunc()
+	}
+	return cloudstack.NewLDAPService(nil).NewLdapRemoveParams()
+}
+
+// LdapRemove records the call and calls LdapRemoveFunc, or LdapRemoveWithContextFunc if only that is set
+func (m *MockLDAPService) LdapRemove(p *cloudstack.LdapRemoveParams) (r0 *cloudstack.LdapRemoveResponse, r1 error) {
+	m.record("LdapRemove", p)
+	if m.LdapRemoveFunc != nil {
+		return m.LdapRemoveFunc(p)
+	}
+	if m.LdapRemoveWithContextFunc != nil {
+		return m.LdapRemoveWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LDAPService.LdapRemove")
+	return
+}
+
+// LdapRemoveWithContext records the call and calls LdapRemoveWithContextFunc, or LdapRemoveFunc if only that is set
+func (m *MockLDAPService) LdapRemoveWithContext(ctx context.Context, p *cloudstack.LdapRemoveParams) (r0 *cloudstack.LdapRemoveResponse, r1 error) {
+	m.record("LdapRemoveWithContext", ctx, p)
+	if m.LdapRemoveWithContextFunc != nil {
+		return m.LdapRemoveWithContextFunc(ctx, p)
+	}
+	if m.LdapRemoveFunc != nil {
+		return m.LdapRemoveFunc(p)
+	}
+	r1 = notMocked("LDAPService.LdapRemoveWithContext")
+	return
+}
+
+// NewLinkAccountToLdapParams records the call and calls NewLinkAccountToLdapParamsFunc
+func (m *MockLDAPService) NewLinkAccountToLdapParams(account string, accounttype int, domainid string, ldapdomain string) (r0 *cloudstack.LinkAccountToLdapParams) {
+	m.record("NewLinkAccountToLdapParams", account, accounttype, domainid, ldapdomain)
+	if m.NewLinkAccountToLdapParamsFunc != nil {
+		return m.NewLinkAccountToLdapParamsFunc(account, accounttype, domainid, ldapdomain)
+	}
+	return cloudstack.NewLDAPService(nil).NewLinkAccountToLdapParams(account, accounttype, domainid, ldapdomain)
+}
+
+// LinkAccountToLdap records the call and calls LinkAccountToLdapFunc, or LinkAccountToLdapWithContextFunc if only that is set
+func (m *MockLDAPService) LinkAccountToLdap(p *cloudstack.LinkAccountToLdapParams) (r0 *cloudstack.LinkAccountToLdapResponse, r1 error) {
+	m.record("LinkAccountToLdap", p)
+	if m.LinkAccountToLdapFunc != nil {
+		return m.LinkAccountToLdapFunc(p)
+	}
+	if m.LinkAccountToLdapWithContextFunc != nil {
+		return m.LinkAccountToLdapWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LDAPService.LinkAccountToLdap")
+	return
+}
+
+// LinkAccountToLdapWithContext records the call and calls LinkAccountToLdapWithContextFunc, or LinkAccountToLdapFunc if only that is set
+func (m *MockLDAPService) LinkAccountToLdapWithContext(ctx context.Context, p *cloudstack.LinkAccountToLdapParams) (r0 *cloudstack.LinkAccountToLdapResponse, r1 error) {
+	m.record("LinkAccountToLdapWithContext", ctx, p)
+	if m.LinkAccountToLdapWithContextFunc != nil {
+		return m.LinkAccountToLdapWithContextFunc(ctx, p)
+	}
+	if m.LinkAccountToLdapFunc != nil {
+		return m.LinkAccountToLdapFunc(p)
+	}
+	r1 = notMocked("LDAPService.LinkAccountToLdapWithContext")
+	return
+}
+
+// NewLinkDomainToLdapParams records the call and calls NewLinkDomainToLdapParamsFunc
+func (m *MockLDAPService) NewLinkDomainToLdapParams(accounttype int, domainid string, lDAPType string) (r0 *cloudstack.LinkDomainToLdapParams) {
+	m.record("NewLinkDomainToLdapParams", accounttype, domainid, lDAPType)
+	if m.NewLinkDomainToLdapParamsFunc != nil {
+		return m.NewLinkDomainToLdapParamsFunc(accounttype, domainid, lDAPType)
+	}
+	return cloudstack.NewLDAPService(nil).NewLinkDomainToLdapParams(accounttype, domainid, lDAPType)
+}
+
+// LinkDomainToLdap records the call and calls LinkDomainToLdapFunc, or LinkDomainToLdapWithContextFunc if only that is set
+func (m *MockLDAPService) LinkDomainToLdap(p *cloudstack.LinkDomainToLdapParams) (r0 *cloudstack.LinkDomainToLdapResponse, r1 error) {
+	m.record("LinkDomainToLdap", p)
+	if m.LinkDomainToLdapFunc != nil {
+		return m.LinkDomainToLdapFunc(p)
+	}
+	if m.LinkDomainToLdapWithContextFunc != nil {
+		return m.LinkDomainToLdapWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LDAPService.LinkDomainToLdap")
+	return
+}
+
+// LinkDomainToLdapWithContext records the call and calls LinkDomainToLdapWithContextFunc, or LinkDomainToLdapFunc if only that is set
+func (m *MockLDAPService) LinkDomainToLdapWithContext(ctx context.Context, p *cloudstack.LinkDomainToLdapParams) (r0 *cloudstack.LinkDomainToLdapResponse, r1 error) {
+	m.record("LinkDomainToLdapWithContext", ctx, p)
+	if m.LinkDomainToLdapWithContextFunc != nil {
+		return m.LinkDomainToLdapWithContextFunc(ctx, p)
+	}
+	if m.LinkDomainToLdapFunc != nil {
+		return m.LinkDomainToLdapFunc(p)
+	}
+	r1 = notMocked("LDAPService.LinkDomainToLdapWithContext")
+	return
+}
+
+// NewListLdapConfigurationsParams records the call and calls NewListLdapConfigurationsParamsFunc
+func (m *MockLDAPService) NewListLdapConfigurationsParams() (r0 *cloudstack.ListLdapConfigurationsParams) {
+	m.record("NewListLdapConfigurationsParams")
+	if m.NewListLdapConfigurationsParamsFunc != nil {
+		return m.NewListLdapConfigurationsParamsFunc()
+	}
+	return cloudstack.NewLDAPService(nil).NewListLdapConfigurationsParams()
+}
+
+// ListLdapConfigurations records the call and calls ListLdapConfigurationsFunc, or ListLdapConfigurationsWithContextFunc if only that is set
+func (m *MockLDAPService) ListLdapConfigurations(p *cloudstack.ListLdapConfigurationsParams) (r0 *cloudstack.ListLdapConfigurationsResponse, r1 error) {
+	m.record("ListLdapConfigurations", p)
+	if m.ListLdapConfigurationsFunc != nil {
+		return m.ListLdapConfigurationsFunc(p)
+	}
+	if m.ListLdapConfigurationsWithContextFunc != nil {
+		return m.ListLdapConfigurationsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LDAPService.ListLdapConfigurations")
+	return
+}
+
+// ListLdapConfigurationsWithContext records the call and calls ListLdapConfigurationsWithContextFunc, or ListLdapConfigurationsFunc if only that is set
+func (m *MockLDAPService) ListLdapConfigurationsWithContext(ctx context.Context, p *cloudstack.ListLdapConfigurationsParams) (r0 *cloudstack.ListLdapConfigurationsResponse, r1 error) {
+	m.record("ListLdapConfigurationsWithContext", ctx, p)
+	if m.ListLdapConfigurationsWithContextFunc != nil {
+		return m.ListLdapConfigurationsWithContextFunc(ctx, p)
+	}
+	if m.ListLdapConfigurationsFunc != nil {
+		return m.ListLdapConfigurationsFunc(p)
+	}
+	r1 = notMocked("LDAPService.ListLdapConfigurationsWithContext")
+	return
+}
+
+// NewListLdapConfigurationsPager records the call and calls NewListLdapConfigurationsPagerFunc
+func (m *MockLDAPService) NewListLdapConfigurationsPager(p *cloudstack.ListLdapConfigurationsParams) (r0 *cloudstack.ListLdapConfigurationsPager) {
+	m.record("NewListLdapConfigurationsPager", p)
+	if m.NewListLdapConfigurationsPagerFunc != nil {
+		return m.NewListLdapConfigurationsPagerFunc(p)
+	}
+	return
+}
+
+// ListLdapConfigurationsAll records the call and calls ListLdapConfigurationsAllFunc, or ListLdapConfigurationsAllWithContextFunc if only that is set
+func (m *MockLDAPService) ListLdapConfigurationsAll(p *cloudstack.ListLdapConfigurationsParams) (r0 *cloudstack.ListLdapConfigurationsResponse, r1 error) {
+	m.record("ListLdapConfigurationsAll", p)
+	if m.ListLdapConfigurationsAllFunc != nil {
+		return m.ListLdapConfigurationsAllFunc(p)
+	}
+	if m.ListLdapConfigurationsAllWithContextFunc != nil {
+		return m.ListLdapConfigurationsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LDAPService.ListLdapConfigurationsAll")
+	return
+}
+
+// ListLdapConfigurationsAllWithContext records the call and calls ListLdapConfigurationsAllWithContextFunc, or ListLdapConfigurationsAllFunc if only that is set
+func (m *MockLDAPService) ListLdapConfigurationsAllWithContext(ctx context.Context, p *cloudstack.ListLdapConfigurationsParams) (r0 *cloudstack.ListLdapConfigurationsResponse, r1 error) {
+	m.record("ListLdapConfigurationsAllWithContext", ctx, p)
+	if m.ListLdapConfigurationsAllWithContextFunc != nil {
+		return m.ListLdapConfigurationsAllWithContextFunc(ctx, p)
+	}
+	if m.ListLdapConfigurationsAllFunc != nil {
+		return m.ListLdapConfigurationsAllFunc(p)
+	}
+	r1 = notMocked("LDAPService.ListLdapConfigurationsAllWithContext")
+	return
+}
+
+// NewListLdapUsersParams records the call and calls NewListLdapUsersParamsFunc
+func (m *MockLDAPService) NewListLdapUsersParams() (r0 *cloudstack.ListLdapUsersParams) {
+	m.record("NewListLdapUsersParams")
+	if m.NewListLdapUsersParamsFunc != nil {
+		return m.NewListLdapUsersParamsFunc()
+	}
+	return cloudstack.NewLDAPService(nil).NewListLdapUsersParams()
+}
+
+// ListLdapUsers records the call and calls ListLdapUsersFunc, or ListLdapUsersWithContextFunc if only that is set
+func (m *MockLDAPService) ListLdapUsers(p *cloudstack.ListLdapUsersParams) (r0 *cloudstack.ListLdapUsersResponse, r1 error) {
+	m.record("ListLdapUsers", p)
+	if m.ListLdapUsersFunc != nil {
+		return m.ListLdapUsersFunc(p)
+	}
+	if m.ListLdapUsersWithContextFunc != nil {
+		return m.ListLdapUsersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LDAPService.ListLdapUsers")
+	return
+}
+
+// ListLdapUsersWithContext records the call and calls ListLdapUsersWithContextFunc, or ListLdapUsersFunc if only that is set
+func (m *MockLDAPService) ListLdapUsersWithContext(ctx context.Context, p *cloudstack.ListLdapUsersParams) (r0 *cloudstack.ListLdapUsersResponse, r1 error) {
+	m.record("ListLdapUsersWithContext", ctx, p)
+	if m.ListLdapUsersWithContextFunc != nil {
+		return m.ListLdapUsersWithContextFunc(ctx, p)
+	}
+	if m.ListLdapUsersFunc != nil {
+		return m.ListLdapUsersFunc(p)
+	}
+	r1 = notMocked("LDAPService.ListLdapUsersWithContext")
+	return
+}
+
+// NewListLdapUsersPager records the call and calls NewListLdapUsersPagerFunc
+func (m *MockLDAPService) NewListLdapUsersPager(p *cloudstack.ListLdapUsersParams) (r0 *cloudstack.ListLdapUsersPager) {
+	m.record("NewListLdapUsersPager", p)
+	if m.NewListLdapUsersPagerFunc != nil {
+		return m.NewListLdapUsersPagerFunc(p)
+	}
+	return
+}
+
+// ListLdapUsersAll records the call and calls ListLdapUsersAllFunc, or ListLdapUsersAllWithContextFunc if only that is set
+func (m *MockLDAPService) ListLdapUsersAll(p *cloudstack.ListLdapUsersParams) (r0 *cloudstack.ListLdapUsersResponse, r1 error) {
+	m.record("ListLdapUsersAll", p)
+	if m.ListLdapUsersAllFunc != nil {
+		return m.ListLdapUsersAllFunc(p)
+	}
+	if m.ListLdapUsersAllWithContextFunc != nil {
+		return m.ListLdapUsersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LDAPService.ListLdapUsersAll")
+	return
+}
+
+// ListLdapUsersAllWithContext records the call and calls ListLdapUsersAllWithContextFunc, or ListLdapUsersAllFunc if only that is set
+func (m *MockLDAPService) ListLdapUsersAllWithContext(ctx context.Context, p *cloudstack.ListLdapUsersParams) (r0 *cloudstack.ListLdapUsersResponse, r1 error) {
+	m.record("ListLdapUsersAllWithContext", ctx, p)
+	if m.ListLdapUsersAllWithContextFunc != nil {
+		return m.ListLdapUsersAllWithContextFunc(ctx, p)
+	}
+	if m.ListLdapUsersAllFunc != nil {
+		return m.ListLdapUsersAllFunc(p)
+	}
+	r1 = notMocked("LDAPService.ListLdapUsersAllWithContext")
+	return
+}
+
+// NewSearchLdapParams records the call and calls NewSearchLdapParamsFunc
+func (m *MockLDAPService) NewSearchLdapParams(query string) (r0 *cloudstack.SearchLdapParams) {
+	m.record("NewSearchLdapParams", query)
+	if m.NewSearchLdapParamsFunc != nil {
+		return m.NewSearchLdapParamsFunc(query)
+	}
+	return cloudstack.NewLDAPService(nil).NewSearchLdapParams(query)
+}
+
+// SearchLdap records the call and calls SearchLdapFunc, or SearchLdapWithContextFunc if only that is set
+func (m *MockLDAPService) SearchLdap(p *cloudstack.SearchLdapParams) (r0 *cloudstack.SearchLdapResponse, r1 error) {
+	m.record("SearchLdap", p)
+	if m.SearchLdapFunc != nil {
+		return m.SearchLdapFunc(p)
+	}
+	if m.SearchLdapWithContextFunc != nil {
+		return m.SearchLdapWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LDAPService.SearchLdap")
+	return
+}
+
+// SearchLdapWithContext records the call and calls SearchLdapWithContextFunc, or SearchLdapFunc if only that is set
+func (m *MockLDAPService) SearchLdapWithContext(ctx context.Context, p *cloudstack.SearchLdapParams) (r0 *cloudstack.SearchLdapResponse, r1 error) {
+	m.record("SearchLdapWithContext", ctx, p)
+	if m.SearchLdapWithContextFunc != nil {
+		return m.SearchLdapWithContextFunc(ctx, p)
+	}
+	if m.SearchLdapFunc != nil {
+		return m.SearchLdapFunc(p)
+	}
+	r1 = notMocked("LDAPService.SearchLdapWithContext")
+	return
+}
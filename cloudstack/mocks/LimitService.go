@@ -0,0 +1,267 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockLimitService is a mock of cloudstack.LimitServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockLimitService struct {
+	Recorder
+
+	NewGetApiLimitParamsFunc             func() *cloudstack.GetApiLimitParams
+	GetApiLimitFunc                      func(p *cloudstack.GetApiLimitParams) (*cloudstack.GetApiLimitResponse, error)
+	GetApiLimitWithContextFunc           func(ctx context.Context, p *cloudstack.GetApiLimitParams) (*cloudstack.GetApiLimitResponse, error)
+	NewListResourceLimitsParamsFunc      func() *cloudstack.ListResourceLimitsParams
+	ListResourceLimitsFunc               func(p *cloudstack.ListResourceLimitsParams) (*cloudstack.ListResourceLimitsResponse, error)
+	ListResourceLimitsWithContextFunc    func(ctx context.Context, p *cloudstack.ListResourceLimitsParams) (*cloudstack.ListResourceLimitsResponse, error)
+	NewListResourceLimitsPagerFunc       func(p *cloudstack.ListResourceLimitsParams) *cloudstack.ListResourceLimitsPager
+	ListResourceLimitsAllFunc            func(p *cloudstack.ListResourceLimitsParams) (*cloudstack.ListResourceLimitsResponse, error)
+	ListResourceLimitsAllWithContextFunc func(ctx context.Context, p *cloudstack.ListResourceLimitsParams) (*cloudstack.ListResourceLimitsResponse, error)
+	NewResetApiLimitParamsFunc           func() *cloudstack.ResetApiLimitParams
+	ResetApiLimitFunc                    func(p *cloudstack.ResetApiLimitParams) (*cloudstack.ResetApiLimitResponse, error)
+	ResetApiLimitWithContextFunc         func(ctx context.Context, p *cloudstack.ResetApiLimitParams) (*cloudstack.ResetApiLimitResponse, error)
+	NewUpdateResourceCountParamsFunc     func(domainid string) *cloudstack.UpdateResourceCountParams
+	UpdateResourceCountFunc              func(p *cloudstack.UpdateResourceCountParams) (*cloudstack.UpdateResourceCountResponse, error)
+	UpdateResourceCountWithContextFunc   func(ctx context.Context, p *cloudstack.UpdateResourceCountParams) (*cloudstack.UpdateResourceCountResponse, error)
+	NewUpdateResourceLimitParamsFunc     func(resourcetype int) *cloudstack.UpdateResourceLimitParams
+	UpdateResourceLimitFunc              func(p *cloudstack.UpdateResourceLimitParams) (*cloudstack.UpdateResourceLimitResponse, error)
+	UpdateResourceLimitWithContextFunc   func(ctx context.Context, p *cloudstack.UpdateResourceLimitParams) (*cloudstack.UpdateResourceLimitResponse, error)
+}
+
+var _ cloudstack.LimitServiceIface = (*MockLimitService)(nil)
+
+// NewGetApiLimitParams records the call and calls NewGetApiLimitParamsFunc
+func (m *MockLimitService) NewGetApiLimitParams() (r0 *cloudstack.GetApiLimitParams) {
+	m.record("NewGetApiLimitParams")
+	if m.NewGetApiLimitParamsFunc != nil {
+		return m.NewGetApiLimitParamsFunc()
+	}
+	return cloudstack.NewLimitService(nil).NewGetApiLimitParams()
+}
+
+// GetApiLimit records the call and calls GetApiLimitFunc, or GetApiLimitWithContextFunc if only that is set
+func (m *MockLimitService) GetApiLimit(p *cloudstack.GetApiLimitParams) (r0 *cloudstack.GetApiLimitResponse, r1 error) {
+	m.record("GetApiLimit", p)
+	if m.GetApiLimitFunc != nil {
+		return m.GetApiLimitFunc(p)
+	}
+	if m.GetApiLimitWithContextFunc != nil {
+		return m.GetApiLimitWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LimitService.GetApiLimit")
+	return
+}
+
+// GetApiLimitWithContext records the call and calls GetApiLimitWithContextFunc, or GetApiLimitFunc if only that is set
+func (m *MockLimitService) GetApiLimitWithContext(ctx context.Context, p *cloudstack.GetApiLimitParams) (r0 *cloudstack.GetApiLimitResponse, r1 error) {
+	m.record("GetApiLimitWithContext", ctx, p)
+	if m.GetApiLimitWithContextFunc != nil {
+		return m.GetApiLimitWithContextFunc(ctx, p)
+	}
+	if m.GetApiLimitFunc != nil {
+		return m.GetApiLimitFunc(p)
+	}
+	r1 = notMocked("LimitService.GetApiLimitWithContext")
+	return
+}
+
+// NewListResourceLimitsParams records the call and calls NewListResourceLimitsParamsFunc
+func (m *MockLimitService) NewListResourceLimitsParams() (r0 *cloudstack.ListResourceLimitsParams) {
+	m.record("NewListResourceLimitsParams")
+	if m.NewListResourceLimitsParamsFunc != nil {
+		return m.NewListResourceLimitsParamsFunc()
+	}
+	return cloudstack.NewLimitService(nil).NewListResourceLimitsParams()
+}
+
+// ListResourceLimits records the call and calls ListResourceLimitsFunc, or ListResourceLimitsWithContextFunc if only that is set
+func (m *MockLimitService) ListResourceLimits(p *cloudstack.ListResourceLimitsParams) (r0 *cloudstack.ListResourceLimitsResponse, r1 error) {
+	m.record("ListResourceLimits", p)
+	if m.ListResourceLimitsFunc != nil {
+		return m.ListResourceLimitsFunc(p)
+	}
+	if m.ListResourceLimitsWithContextFunc != nil {
+		return m.ListResourceLimitsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LimitService.ListResourceLimits")
+	return
+}
+
+// ListResourceLimitsWithContext records the call and calls ListResourceLimitsWithContextFunc, or ListResourceLimitsFunc if only that is set
+func (m *MockLimitService) ListResourceLimitsWithContext(ctx context.Context, p *cloudstack.ListResourceLimitsParams) (r0 *cloudstack.ListResourceLimitsResponse, r1 error) {
+	m.record("ListResourceLimitsWithContext", ctx, p)
+	if m.ListResourceLimitsWithContextFunc != nil {
+		return m.ListResourceLimitsWithContextFunc(ctx, p)
+	}
+	if m.ListResourceLimitsFunc != nil {
+		return m.ListResourceLimitsFunc(p)
+	}
+	r1 = notMocked("LimitService.ListResourceLimitsWithContext")
+	return
+}
+
+// NewListResourceLimitsPager records the call and calls NewListResourceLimitsPagerFunc
+func (m *MockLimitService) NewListResourceLimitsPager(p *cloudstack.ListResourceLimitsParams) (r0 *cloudstack.ListResourceLimitsPager) {
+	m.record("NewListResourceLimitsPager", p)
+	if m.NewListResourceLimitsPagerFunc != nil {
+		return m.NewListResourceLimitsPagerFunc(p)
+	}
+	return
+}
+
+// ListResourceLimitsAll records the call and calls ListResourceLimitsAllFunc, or ListResourceLimitsAllWithContextFunc if only that is set
+func (m *MockLimitService) ListResourceLimitsAll(p *cloudstack.ListResourceLimitsParams) (r0 *cloudstack.ListResourceLimitsResponse, r1 error) {
+	m.record("ListResourceLimitsAll", p)
+	if m.ListResourceLimitsAllFunc != nil {
+		return m.ListResourceLimitsAllFunc(p)
+	}
+	if m.ListResourceLimitsAllWithContextFunc != nil {
+		return m.ListResourceLimitsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LimitService.ListResourceLimitsAll")
+	return
+}
+
+// ListResourceLimitsAllWithContext records the call and calls ListResourceLimitsAllWithContextFunc, or ListResourceLimitsAllFunc if only that is set
+func (m *MockLimitService) ListResourceLimitsAllWithContext(ctx context.Context, p *cloudstack.ListResourceLimitsParams) (r0 *cloudstack.ListResourceLimitsResponse, r1 error) {
+	m.record("ListResourceLimitsAllWithContext", ctx, p)
+	if m.ListResourceLimitsAllWithContextFunc != nil {
+		return m.ListResourceLimitsAllWithContextFunc(ctx, p)
+	}
+	if m.ListResourceLimitsAllFunc != nil {
+		return m.ListResourceLimitsAllFunc(p)
+	}
+	r1 = notMocked("LimitService.ListResourceLimitsAllWithContext")
+	return
+}
+
+// NewResetApiLimitParams records the call and calls NewResetApiLimitParamsFunc
+func (m *MockLimitService) NewResetApiLimitParams() (r0 *cloudstack.ResetApiLimitParams) {
+	m.record("NewResetApiLimitParams")
+	if m.NewResetApiLimitParamsFunc != nil {
+		return m.NewResetApiLimitParamsFunc()
+	}
+	return cloudstack.NewLimitService(nil).NewResetApiLimitParams()
+}
+
+// ResetApiLimit records the call and calls ResetApiLimitFunc, or ResetApiLimitWithContextFunc if only that is set
+func (m *MockLimitService) ResetApiLimit(p *cloudstack.ResetApiLimitParams) (r0 *cloudstack.ResetApiLimitResponse, r1 error) {
+	m.record("ResetApiLimit", p)
+	if m.ResetApiLimitFunc != nil {
+		return m.ResetApiLimitFunc(p)
+	}
+	if m.ResetApiLimitWithContextFunc != nil {
+		return m.ResetApiLimitWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LimitService.ResetApiLimit")
+	return
+}
+
+// ResetApiLimitWithContext records the call and calls ResetApiLimitWithContextFunc, or ResetApiLimitFunc if only that is set
+func (m *MockLimitService) ResetApiLimitWithContext(ctx context.Context, p *cloudstack.ResetApiLimitParams) (r0 *cloudstack.ResetApiLimitResponse, r1 error) {
+	m.record("ResetApiLimitWithContext", ctx, p)
+	if m.ResetApiLimitWithContextFunc != nil {
+		return m.ResetApiLimitWithContextFunc(ctx, p)
+	}
+	if m.ResetApiLimitFunc != nil {
+		return m.ResetApiLimitFunc(p)
+	}
+	r1 = notMocked("LimitService.ResetApiLimitWithContext")
+	return
+}
+
+// NewUpdateResourceCountParams records the call and calls NewUpdateResourceCountParamsFunc
+func (m *MockLimitService) NewUpdateResourceCountParams(domainid string) (r0 *cloudstack.UpdateResourceCountParams) {
+	m.record("NewUpdateResourceCountParams", domainid)
+	if m.NewUpdateResourceCountParamsFunc != nil {
+		return m.NewUpdateResourceCountParamsFunc(domainid)
+	}
+	return cloudstack.NewLimitService(nil).NewUpdateResourceCountParams(domainid)
+}
+
+// UpdateResourceCount records the call and calls UpdateResourceCountFunc, or UpdateResourceCountWithContextFunc if only that is set
+func (m *MockLimitService) UpdateResourceCount(p *cloudstack.UpdateResourceCountParams) (r0 *cloudstack.UpdateResourceCountResponse, r1 error) {
+	m.record("UpdateResourceCount", p)
+	if m.UpdateResourceCountFunc != nil {
+		return m.UpdateResourceCountFunc(p)
+	}
+	if m.UpdateResourceCountWithContextFunc != nil {
+		return m.UpdateResourceCountWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LimitService.UpdateResourceCount")
+	return
+}
+
+// UpdateResourceCountWithContext records the call and calls UpdateResourceCountWithContextFunc, or UpdateResourceCountFunc if only that is set
+func (m *MockLimitService) UpdateResourceCountWithContext(ctx context.Context, p *cloudstack.UpdateResourceCountParams) (r0 *cloudstack.UpdateResourceCountResponse, r1 error) {
+	m.record("UpdateResourceCountWithContext", ctx, p)
+	if m.UpdateResourceCountWithContextFunc != nil {
+		return m.UpdateResourceCountWithContextFunc(ctx, p)
+	}
+	if m.UpdateResourceCountFunc != nil {
+		return m.UpdateResourceCountFunc(p)
+	}
+	r1 = notMocked("LimitService.UpdateResourceCountWithContext")
+	return
+}
+
+// NewUpdateResourceLimitParams records the call and calls NewUpdateResourceLimitParamsFunc
+func (m *MockLimitService) NewUpdateResourceLimitParams(resourcetype int) (r0 *cloudstack.UpdateResourceLimitParams) {
+	m.record("NewUpdateResourceLimitParams", resourcetype)
+	if m.NewUpdateResourceLimitParamsFunc != nil {
+		return m.NewUpdateResourceLimitParamsFunc(resourcetype)
+	}
+	return cloudstack.NewLimitService(nil).NewUpdateResourceLimitParams(resourcetype)
+}
+
+// UpdateResourceLimit records the call and calls UpdateResourceLimitFunc, or UpdateResourceLimitWithContextFunc if only that is set
+func (m *MockLimitService) UpdateResourceLimit(p *cloudstack.UpdateResourceLimitParams) (r0 *cloudstack.UpdateResourceLimitResponse, r1 error) {
+	m.record("UpdateResourceLimit", p)
+	if m.UpdateResourceLimitFunc != nil {
+		return m.UpdateResourceLimitFunc(p)
+	}
+	if m.UpdateResourceLimitWithContextFunc != nil {
+		return m.UpdateResourceLimitWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LimitService.UpdateResourceLimit")
+	return
+}
+
+// UpdateResourceLimitWithContext records the call and calls UpdateResourceLimitWithContextFunc, or UpdateResourceLimitFunc if only that is set
+func (m *MockLimitService) UpdateResourceLimitWithContext(ctx context.Context, p *cloudstack.UpdateResourceLimitParams) (r0 *cloudstack.UpdateResourceLimitResponse, r1 error) {
+	m.record("UpdateResourceLimitWithContext", ctx, p)
+	if m.UpdateResourceLimitWithContextFunc != nil {
+		return m.UpdateResourceLimitWithContextFunc(ctx, p)
+	}
+	if m.UpdateResourceLimitFunc != nil {
+		return m.UpdateResourceLimitFunc(p)
+	}
+	r1 = notMocked("LimitService.UpdateResourceLimitWithContext")
+	return
+}
@@ -0,0 +1,3125 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockLoadBalancerService is a mock of cloudstack.LoadBalancerServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockLoadBalancerService struct {
+	Recorder
+
+	NewAddExternalLoadBalancerParamsFunc                 func(password string, url string, username string, zoneid string) *cloudstack.AddExternalLoadBalancerParams
+	AddExternalLoadBalancerFunc                          func(p *cloudstack.AddExternalLoadBalancerParams) (*cloudstack.AddExternalLoadBalancerResponse, error)
+	AddExternalLoadBalancerWithContextFunc               func(ctx context.Context, p *cloudstack.AddExternalLoadBalancerParams) (*cloudstack.AddExternalLoadBalancerResponse, error)
+	NewAddF5LoadBalancerParamsFunc                       func(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *cloudstack.AddF5LoadBalancerParams
+	AddF5LoadBalancerFunc                                func(p *cloudstack.AddF5LoadBalancerParams) (*cloudstack.AddF5LoadBalancerResponse, error)
+	AddF5LoadBalancerWithContextFunc                     func(ctx context.Context, p *cloudstack.AddF5LoadBalancerParams) (*cloudstack.AddF5LoadBalancerResponse, error)
+	AddF5LoadBalancerAsyncFunc                           func(p *cloudstack.AddF5LoadBalancerParams) (*cloudstack.Job, error)
+	AddF5LoadBalancerAsyncWithContextFunc                func(ctx context.Context, p *cloudstack.AddF5LoadBalancerParams) (*cloudstack.Job, error)
+	NewAddNetscalerLoadBalancerParamsFunc                func(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *cloudstack.AddNetscalerLoadBalancerParams
+	AddNetscalerLoadBalancerFunc                         func(p *cloudstack.AddNetscalerLoadBalancerParams) (*cloudstack.AddNetscalerLoadBalancerResponse, error)
+	AddNetscalerLoadBalancerWithContextFunc              func(ctx context.Context, p *cloudstack.AddNetscalerLoadBalancerParams) (*cloudstack.AddNetscalerLoadBalancerResponse, error)
+	AddNetscalerLoadBalancerAsyncFunc                    func(p *cloudstack.AddNetscalerLoadBalancerParams) (*cloudstack.Job, error)
+	AddNetscalerLoadBalancerAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.AddNetscalerLoadBalancerParams) (*cloudstack.Job, error)
+	NewAssignCertToLoadBalancerParamsFunc                func(certid string, lbruleid string) *cloudstack.AssignCertToLoadBalancerParams
+	AssignCertToLoadBalancerFunc                         func(p *cloudstack.AssignCertToLoadBalancerParams) (*cloudstack.AssignCertToLoadBalancerResponse, error)
+	AssignCertToLoadBalancerWithContextFunc              func(ctx context.Context, p *cloudstack.AssignCertToLoadBalancerParams) (*cloudstack.AssignCertToLoadBalancerResponse, error)
+	AssignCertToLoadBalancerAsyncFunc                    func(p *cloudstack.AssignCertToLoadBalancerParams) (*cloudstack.Job, error)
+	AssignCertToLoadBalancerAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.AssignCertToLoadBalancerParams) (*cloudstack.Job, error)
+	NewAssignToGlobalLoadBalancerRuleParamsFunc          func(id string, loadbalancerrulelist []string) *cloudstack.AssignToGlobalLoadBalancerRuleParams
+	AssignToGlobalLoadBalancerRuleFunc                   func(p *cloudstack.AssignToGlobalLoadBalancerRuleParams) (*cloudstack.AssignToGlobalLoadBalancerRuleResponse, error)
+	AssignToGlobalLoadBalancerRuleWithContextFunc        func(ctx context.Context, p *cloudstack.AssignToGlobalLoadBalancerRuleParams) (*cloudstack.AssignToGlobalLoadBalancerRuleResponse, error)
+	AssignToGlobalLoadBalancerRuleAsyncFunc              func(p *cloudstack.AssignToGlobalLoadBalancerRuleParams) (*cloudstack.Job, error)
+	AssignToGlobalLoadBalancerRuleAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.AssignToGlobalLoadBalancerRuleParams) (*cloudstack.Job, error)
+	NewAssignToLoadBalancerRuleParamsFunc                func(id string) *cloudstack.AssignToLoadBalancerRuleParams
+	AssignToLoadBalancerRuleFunc                         func(p *cloudstack.AssignToLoadBalancerRuleParams) (*cloudstack.AssignToLoadBalancerRuleResponse, error)
+	AssignToLoadBalancerRuleWithContextFunc              func(ctx context.Context, p *cloudstack.AssignToLoadBalancerRuleParams) (*cloudstack.AssignToLoadBalancerRuleResponse, error)
+	AssignToLoadBalancerRuleAsyncFunc                    func(p *cloudstack.AssignToLoadBalancerRuleParams) (*cloudstack.Job, error)
+	AssignToLoadBalancerRuleAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.AssignToLoadBalancerRuleParams) (*cloudstack.Job, error)
+	NewConfigureF5LoadBalancerParamsFunc                 func(lbdeviceid string) *cloudstack.ConfigureF5LoadBalancerParams
+	ConfigureF5LoadBalancerFunc                          func(p *cloudstack.ConfigureF5LoadBalancerParams) (*cloudstack.F5LoadBalancerResponse, error)
+	ConfigureF5LoadBalancerWithContextFunc               func(ctx context.Context, p *cloudstack.ConfigureF5LoadBalancerParams) (*cloudstack.F5LoadBalancerResponse, error)
+	ConfigureF5LoadBalancerAsyncFunc                     func(p *cloudstack.ConfigureF5LoadBalancerParams) (*cloudstack.Job, error)
+	ConfigureF5LoadBalancerAsyncWithContextFunc          func(ctx context.Context, p *cloudstack.ConfigureF5LoadBalancerParams) (*cloudstack.Job, error)
+	NewConfigureNetscalerLoadBalancerParamsFunc          func(lbdeviceid string) *cloudstack.ConfigureNetscalerLoadBalancerParams
+	ConfigureNetscalerLoadBalancerFunc                   func(p *cloudstack.ConfigureNetscalerLoadBalancerParams) (*cloudstack.NetscalerLoadBalancerResponse, error)
+	ConfigureNetscalerLoadBalancerWithContextFunc        func(ctx context.Context, p *cloudstack.ConfigureNetscalerLoadBalancerParams) (*cloudstack.NetscalerLoadBalancerResponse, error)
+	ConfigureNetscalerLoadBalancerAsyncFunc              func(p *cloudstack.ConfigureNetscalerLoadBalancerParams) (*cloudstack.Job, error)
+	ConfigureNetscalerLoadBalancerAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.ConfigureNetscalerLoadBalancerParams) (*cloudstack.Job, error)
+	NewCreateGlobalLoadBalancerRuleParamsFunc            func(gslbdomainname string, gslbservicetype string, name string, regionid int) *cloudstack.CreateGlobalLoadBalancerRuleParams
+	CreateGlobalLoadBalancerRuleFunc                     func(p *cloudstack.CreateGlobalLoadBalancerRuleParams) (*cloudstack.CreateGlobalLoadBalancerRuleResponse, error)
+	CreateGlobalLoadBalancerRuleWithContextFunc          func(ctx context.Context, p *cloudstack.CreateGlobalLoadBalancerRuleParams) (*cloudstack.CreateGlobalLoadBalancerRuleResponse, error)
+	CreateGlobalLoadBalancerRuleAsyncFunc                func(p *cloudstack.CreateGlobalLoadBalancerRuleParams) (*cloudstack.Job, error)
+	CreateGlobalLoadBalancerRuleAsyncWithContextFunc     func(ctx context.Context, p *cloudstack.CreateGlobalLoadBalancerRuleParams) (*cloudstack.Job, error)
+	NewCreateLBHealthCheckPolicyParamsFunc               func(lbruleid string) *cloudstack.CreateLBHealthCheckPolicyParams
+	CreateLBHealthCheckPolicyFunc                        func(p *cloudstack.CreateLBHealthCheckPolicyParams) (*cloudstack.CreateLBHealthCheckPolicyResponse, error)
+	CreateLBHealthCheckPolicyWithContextFunc             func(ctx context.Context, p *cloudstack.CreateLBHealthCheckPolicyParams) (*cloudstack.CreateLBHealthCheckPolicyResponse, error)
+	CreateLBHealthCheckPolicyAsyncFunc                   func(p *cloudstack.CreateLBHealthCheckPolicyParams) (*cloudstack.Job, error)
+	CreateLBHealthCheckPolicyAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.CreateLBHealthCheckPolicyParams) (*cloudstack.Job, error)
+	NewCreateLBStickinessPolicyParamsFunc                func(lbruleid string, methodname string, name string) *cloudstack.CreateLBStickinessPolicyParams
+	CreateLBStickinessPolicyFunc                         func(p *cloudstack.CreateLBStickinessPolicyParams) (*cloudstack.CreateLBStickinessPolicyResponse, error)
+	CreateLBStickinessPolicyWithContextFunc              func(ctx context.Context, p *cloudstack.CreateLBStickinessPolicyParams) (*cloudstack.CreateLBStickinessPolicyResponse, error)
+	CreateLBStickinessPolicyAsyncFunc                    func(p *cloudstack.CreateLBStickinessPolicyParams) (*cloudstack.Job, error)
+	CreateLBStickinessPolicyAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.CreateLBStickinessPolicyParams) (*cloudstack.Job, error)
+	NewCreateLoadBalancerParamsFunc                      func(algorithm string, instanceport int, name string, networkid string, scheme string, sourceipaddressnetworkid string, sourceport int) *cloudstack.CreateLoadBalancerParams
+	CreateLoadBalancerFunc                               func(p *cloudstack.CreateLoadBalancerParams) (*cloudstack.CreateLoadBalancerResponse, error)
+	CreateLoadBalancerWithContextFunc                    func(ctx context.Context, p *cloudstack.CreateLoadBalancerParams) (*cloudstack.CreateLoadBalancerResponse, error)
+	CreateLoadBalancerAsyncFunc                          func(p *cloudstack.CreateLoadBalancerParams) (*cloudstack.Job, error)
+	CreateLoadBalancerAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.CreateLoadBalancerParams) (*cloudstack.Job, error)
+	NewCreateLoadBalancerRuleParamsFunc                  func(algorithm string, name string, privateport int, publicport int) *cloudstack.CreateLoadBalancerRuleParams
+	CreateLoadBalancerRuleFunc                           func(p *cloudstack.CreateLoadBalancerRuleParams) (*cloudstack.CreateLoadBalancerRuleResponse, error)
+	CreateLoadBalancerRuleWithContextFunc                func(ctx context.Context, p *cloudstack.CreateLoadBalancerRuleParams) (*cloudstack.CreateLoadBalancerRuleResponse, error)
+	CreateLoadBalancerRuleAsyncFunc                      func(p *cloudstack.CreateLoadBalancerRuleParams) (*cloudstack.Job, error)
+	CreateLoadBalancerRuleAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.CreateLoadBalancerRuleParams) (*cloudstack.Job, error)
+	NewDeleteExternalLoadBalancerParamsFunc              func(id string) *cloudstack.DeleteExternalLoadBalancerParams
+	DeleteExternalLoadBalancerFunc                       func(p *cloudstack.DeleteExternalLoadBalancerParams) (*cloudstack.DeleteExternalLoadBalancerResponse, error)
+	DeleteExternalLoadBalancerWithContextFunc            func(ctx context.Context, p *cloudstack.DeleteExternalLoadBalancerParams) (*cloudstack.DeleteExternalLoadBalancerResponse, error)
+	NewDeleteF5LoadBalancerParamsFunc                    func(lbdeviceid string) *cloudstack.DeleteF5LoadBalancerParams
+	DeleteF5LoadBalancerFunc                             func(p *cloudstack.DeleteF5LoadBalancerParams) (*cloudstack.DeleteF5LoadBalancerResponse, error)
+	DeleteF5LoadBalancerWithContextFunc                  func(ctx context.Context, p *cloudstack.DeleteF5LoadBalancerParams) (*cloudstack.DeleteF5LoadBalancerResponse, error)
+	DeleteF5LoadBalancerAsyncFunc                        func(p *cloudstack.DeleteF5LoadBalancerParams) (*cloudstack.Job, error)
+	DeleteF5LoadBalancerAsyncWithContextFunc             func(ctx context.Context, p *cloudstack.DeleteF5LoadBalancerParams) (*cloudstack.Job, error)
+	NewDeleteGlobalLoadBalancerRuleParamsFunc            func(id string) *cloudstack.DeleteGlobalLoadBalancerRuleParams
+	DeleteGlobalLoadBalancerRuleFunc                     func(p *cloudstack.DeleteGlobalLoadBalancerRuleParams) (*cloudstack.DeleteGlobalLoadBalancerRuleResponse, error)
+	DeleteGlobalLoadBalancerRuleWithContextFunc          func(ctx context.Context, p *cloudstack.DeleteGlobalLoadBalancerRuleParams) (*cloudstack.DeleteGlobalLoadBalancerRuleResponse, error)
+	DeleteGlobalLoadBalancerRuleAsyncFunc                func(p *cloudstack.DeleteGlobalLoadBalancerRuleParams) (*cloudstack.Job, error)
+	DeleteGlobalLoadBalancerRuleAsyncWithContextFunc     func(ctx context.Context, p *cloudstack.DeleteGlobalLoadBalancerRuleParams) (*cloudstack.Job, error)
+	NewDeleteLBHealthCheckPolicyParamsFunc               func(id string) *cloudstack.DeleteLBHealthCheckPolicyParams
+	DeleteLBHealthCheckPolicyFunc                        func(p *cloudstack.DeleteLBHealthCheckPolicyParams) (*cloudstack.DeleteLBHealthCheckPolicyResponse, error)
+	DeleteLBHealthCheckPolicyWithContextFunc             func(ctx context.Context, p *cloudstack.DeleteLBHealthCheckPolicyParams) (*cloudstack.DeleteLBHealthCheckPolicyResponse, error)
+	DeleteLBHealthCheckPolicyAsyncFunc                   func(p *cloudstack.DeleteLBHealthCheckPolicyParams) (*cloudstack.Job, error)
+	DeleteLBHealthCheckPolicyAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.DeleteLBHealthCheckPolicyParams) (*cloudstack.Job, error)
+	NewDeleteLBStickinessPolicyParamsFunc                func(id string) *cloudstack.DeleteLBStickinessPolicyParams
+	DeleteLBStickinessPolicyFunc                         func(p *cloudstack.DeleteLBStickinessPolicyParams) (*cloudstack.DeleteLBStickinessPolicyResponse, error)
+	DeleteLBStickinessPolicyWithContextFunc              func(ctx context.Context, p *cloudstack.DeleteLBStickinessPolicyParams) (*cloudstack.DeleteLBStickinessPolicyResponse, error)
+	DeleteLBStickinessPolicyAsyncFunc                    func(p *cloudstack.DeleteLBStickinessPolicyParams) (*cloudstack.Job, error)
+	DeleteLBStickinessPolicyAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.DeleteLBStickinessPolicyParams) (*cloudstack.Job, error)
+	NewDeleteLoadBalancerParamsFunc                      func(id string) *cloudstack.DeleteLoadBalancerParams
+	DeleteLoadBalancerFunc                               func(p *cloudstack.DeleteLoadBalancerParams) (*cloudstack.DeleteLoadBalancerResponse, error)
+	DeleteLoadBalancerWithContextFunc                    func(ctx context.Context, p *cloudstack.DeleteLoadBalancerParams) (*cloudstack.DeleteLoadBalancerResponse, error)
+	DeleteLoadBalancerAsyncFunc                          func(p *cloudstack.DeleteLoadBalancerParams) (*cloudstack.Job, error)
+	DeleteLoadBalancerAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.DeleteLoadBalancerParams) (*cloudstack.Job, error)
+	NewDeleteLoadBalancerRuleParamsFunc                  func(id string) *cloudstack.DeleteLoadBalancerRuleParams
+	DeleteLoadBalancerRuleFunc                           func(p *cloudstack.DeleteLoadBalancerRuleParams) (*cloudstack.DeleteLoadBalancerRuleResponse, error)
+	DeleteLoadBalancerRuleWithContextFunc                func(ctx context.Context, p *cloudstack.DeleteLoadBalancerRuleParams) (*cloudstack.DeleteLoadBalancerRuleResponse, error)
+	DeleteLoadBalancerRuleAsyncFunc                      func(p *cloudstack.DeleteLoadBalancerRuleParams) (*cloudstack.Job, error)
+	DeleteLoadBalancerRuleAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.DeleteLoadBalancerRuleParams) (*cloudstack.Job, error)
+	NewDeleteNetscalerLoadBalancerParamsFunc             func(lbdeviceid string) *cloudstack.DeleteNetscalerLoadBalancerParams
+	DeleteNetscalerLoadBalancerFunc                      func(p *cloudstack.DeleteNetscalerLoadBalancerParams) (*cloudstack.DeleteNetscalerLoadBalancerResponse, error)
+	DeleteNetscalerLoadBalancerWithContextFunc           func(ctx context.Context, p *cloudstack.DeleteNetscalerLoadBalancerParams) (*cloudstack.DeleteNetscalerLoadBalancerResponse, error)
+	DeleteNetscalerLoadBalancerAsyncFunc                 func(p *cloudstack.DeleteNetscalerLoadBalancerParams) (*cloudstack.Job, error)
+	DeleteNetscalerLoadBalancerAsyncWithContextFunc      func(ctx context.Context, p *cloudstack.DeleteNetscalerLoadBalancerParams) (*cloudstack.Job, error)
+	NewDeleteSslCertParamsFunc                           func(id string) *cloudstack.DeleteSslCertParams
+	DeleteSslCertFunc                                    func(p *cloudstack.DeleteSslCertParams) (*cloudstack.DeleteSslCertResponse, error)
+	DeleteSslCertWithContextFunc                         func(ctx context.Context, p *cloudstack.DeleteSslCertParams) (*cloudstack.DeleteSslCertResponse, error)
+	NewListExternalLoadBalancersParamsFunc               func() *cloudstack.ListExternalLoadBalancersParams
+	GetExternalLoadBalancerIDFunc                        func(keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetExternalLoadBalancerIDWithContextFunc             func(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListExternalLoadBalancersFunc                        func(p *cloudstack.ListExternalLoadBalancersParams) (*cloudstack.ListExternalLoadBalancersResponse, error)
+	ListExternalLoadBalancersWithContextFunc             func(ctx context.Context, p *cloudstack.ListExternalLoadBalancersParams) (*cloudstack.ListExternalLoadBalancersResponse, error)
+	NewListExternalLoadBalancersPagerFunc                func(p *cloudstack.ListExternalLoadBalancersParams) *cloudstack.ListExternalLoadBalancersPager
+	ListExternalLoadBalancersAllFunc                     func(p *cloudstack.ListExternalLoadBalancersParams) (*cloudstack.ListExternalLoadBalancersResponse, error)
+	ListExternalLoadBalancersAllWithContextFunc          func(ctx context.Context, p *cloudstack.ListExternalLoadBalancersParams) (*cloudstack.ListExternalLoadBalancersResponse, error)
+	NewListF5LoadBalancerNetworksParamsFunc              func(lbdeviceid string) *cloudstack.ListF5LoadBalancerNetworksParams
+	GetF5LoadBalancerNetworkIDFunc                       func(keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetF5LoadBalancerNetworkIDWithContextFunc            func(ctx context.Context, keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListF5LoadBalancerNetworksFunc                       func(p *cloudstack.ListF5LoadBalancerNetworksParams) (*cloudstack.ListF5LoadBalancerNetworksResponse, error)
+	ListF5LoadBalancerNetworksWithContextFunc            func(ctx context.Context, p *cloudstack.ListF5LoadBalancerNetworksParams) (*cloudstack.ListF5LoadBalancerNetworksResponse, error)
+	NewListF5LoadBalancerNetworksPagerFunc               func(p *cloudstack.ListF5LoadBalancerNetworksParams) *cloudstack.ListF5LoadBalancerNetworksPager
+	ListF5LoadBalancerNetworksAllFunc                    func(p *cloudstack.ListF5LoadBalancerNetworksParams) (*cloudstack.ListF5LoadBalancerNetworksResponse, error)
+	ListF5LoadBalancerNetworksAllWithContextFunc         func(ctx context.Context, p *cloudstack.ListF5LoadBalancerNetworksParams) (*cloudstack.ListF5LoadBalancerNetworksResponse, error)
+	NewListF5LoadBalancersParamsFunc                     func() *cloudstack.ListF5LoadBalancersParams
+	ListF5LoadBalancersFunc                              func(p *cloudstack.ListF5LoadBalancersParams) (*cloudstack.ListF5LoadBalancersResponse, error)
+	ListF5LoadBalancersWithContextFunc                   func(ctx context.Context, p *cloudstack.ListF5LoadBalancersParams) (*cloudstack.ListF5LoadBalancersResponse, error)
+	NewListF5LoadBalancersPagerFunc                      func(p *cloudstack.ListF5LoadBalancersParams) *cloudstack.ListF5LoadBalancersPager
+	ListF5LoadBalancersAllFunc                           func(p *cloudstack.ListF5LoadBalancersParams) (*cloudstack.ListF5LoadBalancersResponse, error)
+	ListF5LoadBalancersAllWithContextFunc                func(ctx context.Context, p *cloudstack.ListF5LoadBalancersParams) (*cloudstack.ListF5LoadBalancersResponse, error)
+	NewListGlobalLoadBalancerRulesParamsFunc             func() *cloudstack.ListGlobalLoadBalancerRulesParams
+	GetGlobalLoadBalancerRuleIDFunc                      func(keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetGlobalLoadBalancerRuleIDWithContextFunc           func(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetGlobalLoadBalancerRuleByNameFunc                  func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.GlobalLoadBalancerRule, int, error)
+	GetGlobalLoadBalancerRuleByNameWithContextFunc       func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.GlobalLoadBalancerRule, int, error)
+	GetGlobalLoadBalancerRuleByIDFunc                    func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.GlobalLoadBalancerRule, int, error)
+	GetGlobalLoadBalancerRuleByIDWithContextFunc         func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.GlobalLoadBalancerRule, int, error)
+	ListGlobalLoadBalancerRulesFunc                      func(p *cloudstack.ListGlobalLoadBalancerRulesParams) (*cloudstack.ListGlobalLoadBalancerRulesResponse, error)
+	ListGlobalLoadBalancerRulesWithContextFunc           func(ctx context.Context, p *cloudstack.ListGlobalLoadBalancerRulesParams) (*cloudstack.ListGlobalLoadBalancerRulesResponse, error)
+	NewListGlobalLoadBalancerRulesPagerFunc              func(p *cloudstack.ListGlobalLoadBalancerRulesParams) *cloudstack.ListGlobalLoadBalancerRulesPager
+	ListGlobalLoadBalancerRulesAllFunc                   func(p *cloudstack.ListGlobalLoadBalancerRulesParams) (*cloudstack.ListGlobalLoadBalancerRulesResponse, error)
+	ListGlobalLoadBalancerRulesAllWithContextFunc        func(ctx context.Context, p *cloudstack.ListGlobalLoadBalancerRulesParams) (*cloudstack.ListGlobalLoadBalancerRulesResponse, error)
+	NewListLBHealthCheckPoliciesParamsFunc               func() *cloudstack.ListLBHealthCheckPoliciesParams
+	GetLBHealthCheckPolicyByIDFunc                       func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.LBHealthCheckPolicy, int, error)
+	GetLBHealthCheckPolicyByIDWithContextFunc            func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.LBHealthCheckPolicy, int, error)
+	ListLBHealthCheckPoliciesFunc                        func(p *cloudstack.ListLBHealthCheckPoliciesParams) (*cloudstack.ListLBHealthCheckPoliciesResponse, error)
+	ListLBHealthCheckPoliciesWithContextFunc             func(ctx context.Context, p *cloudstack.ListLBHealthCheckPoliciesParams) (*cloudstack.ListLBHealthCheckPoliciesResponse, error)
+	NewListLBHealthCheckPoliciesPagerFunc                func(p *cloudstack.ListLBHealthCheckPoliciesParams) *cloudstack.ListLBHealthCheckPoliciesPager
+	ListLBHealthCheckPoliciesAllFunc                     func(p *cloudstack.ListLBHealthCheckPoliciesParams) (*cloudstack.ListLBHealthCheckPoliciesResponse, error)
+	ListLBHealthCheckPoliciesAllWithContextFunc          func(ctx context.Context, p *cloudstack.ListLBHealthCheckPoliciesParams) (*cloudstack.ListLBHealthCheckPoliciesResponse, error)
+	NewListLBStickinessPoliciesParamsFunc                func() *cloudstack.ListLBStickinessPoliciesParams
+	GetLBStickinessPolicyByIDFunc                        func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.LBStickinessPolicy, int, error)
+	GetLBStickinessPolicyByIDWithContextFunc             func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.LBStickinessPolicy, int, error)
+	ListLBStickinessPoliciesFunc                         func(p *cloudstack.ListLBStickinessPoliciesParams) (*cloudstack.ListLBStickinessPoliciesResponse, error)
+	ListLBStickinessPoliciesWithContextFunc              func(ctx context.Context, p *cloudstack.ListLBStickinessPoliciesParams) (*cloudstack.ListLBStickinessPoliciesResponse, error)
+	NewListLBStickinessPoliciesPagerFunc                 func(p *cloudstack.ListLBStickinessPoliciesParams) *cloudstack.ListLBStickinessPoliciesPager
+	ListLBStickinessPoliciesAllFunc                      func(p *cloudstack.ListLBStickinessPoliciesParams) (*cloudstack.ListLBStickinessPoliciesResponse, error)
+	ListLBStickinessPoliciesAllWithContextFunc           func(ctx context.Context, p *cloudstack.ListLBStickinessPoliciesParams) (*cloudstack.ListLBStickinessPoliciesResponse, error)
+	NewListLoadBalancerRuleInstancesParamsFunc           func(id string) *cloudstack.ListLoadBalancerRuleInstancesParams
+	GetLoadBalancerRuleInstanceByIDFunc                  func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualMachine, int, error)
+	GetLoadBalancerRuleInstanceByIDWithContextFunc       func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualMachine, int, error)
+	ListLoadBalancerRuleInstancesFunc                    func(p *cloudstack.ListLoadBalancerRuleInstancesParams) (*cloudstack.ListLoadBalancerRuleInstancesResponse, error)
+	ListLoadBalancerRuleInstancesWithContextFunc         func(ctx context.Context, p *cloudstack.ListLoadBalancerRuleInstancesParams) (*cloudstack.ListLoadBalancerRuleInstancesResponse, error)
+	NewListLoadBalancerRulesParamsFunc                   func() *cloudstack.ListLoadBalancerRulesParams
+	GetLoadBalancerRuleIDFunc                            func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetLoadBalancerRuleIDWithContextFunc                 func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetLoadBalancerRuleByNameFunc                        func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.LoadBalancerRule, int, error)
+	GetLoadBalancerRuleByNameWithContextFunc             func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.LoadBalancerRule, int, error)
+	GetLoadBalancerRuleByIDFunc                          func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.LoadBalancerRule, int, error)
+	GetLoadBalancerRuleByIDWithContextFunc               func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.LoadBalancerRule, int, error)
+	ListLoadBalancerRulesFunc                            func(p *cloudstack.ListLoadBalancerRulesParams) (*cloudstack.ListLoadBalancerRulesResponse, error)
+	ListLoadBalancerRulesWithContextFunc                 func(ctx context.Context, p *cloudstack.ListLoadBalancerRulesParams) (*cloudstack.ListLoadBalancerRulesResponse, error)
+	NewListLoadBalancerRulesPagerFunc                    func(p *cloudstack.ListLoadBalancerRulesParams) *cloudstack.ListLoadBalancerRulesPager
+	ListLoadBalancerRulesAllFunc                         func(p *cloudstack.ListLoadBalancerRulesParams) (*cloudstack.ListLoadBalancerRulesResponse, error)
+	ListLoadBalancerRulesAllWithContextFunc              func(ctx context.Context, p *cloudstack.ListLoadBalancerRulesParams) (*cloudstack.ListLoadBalancerRulesResponse, error)
+	NewListLoadBalancersParamsFunc                       func() *cloudstack.ListLoadBalancersParams
+	GetLoadBalancerIDFunc                                func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetLoadBalancerIDWithContextFunc                     func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetLoadBalancerByNameFunc                            func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.LoadBalancer, int, error)
+	GetLoadBalancerByNameWithContextFunc                 func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.LoadBalancer, int, error)
+	GetLoadBalancerByIDFunc                              func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.LoadBalancer, int, error)
+	GetLoadBalancerByIDWithContextFunc                   func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.LoadBalancer, int, error)
+	ListLoadBalancersFunc                                func(p *cloudstack.ListLoadBalancersParams) (*cloudstack.ListLoadBalancersResponse, error)
+	ListLoadBalancersWithContextFunc                     func(ctx context.Context, p *cloudstack.ListLoadBalancersParams) (*cloudstack.ListLoadBalancersResponse, error)
+	NewListLoadBalancersPagerFunc                        func(p *cloudstack.ListLoadBalancersParams) *cloudstack.ListLoadBalancersPager
+	ListLoadBalancersAllFunc                             func(p *cloudstack.ListLoadBalancersParams) (*cloudstack.ListLoadBalancersResponse, error)
+	ListLoadBalancersAllWithContextFunc                  func(ctx context.Context, p *cloudstack.ListLoadBalancersParams) (*cloudstack.ListLoadBalancersResponse, error)
+	NewListNetscalerLoadBalancersParamsFunc              func() *cloudstack.ListNetscalerLoadBalancersParams
+	ListNetscalerLoadBalancersFunc                       func(p *cloudstack.ListNetscalerLoadBalancersParams) (*cloudstack.ListNetscalerLoadBalancersResponse, error)
+	ListNetscalerLoadBalancersWithContextFunc            func(ctx context.Context, p *cloudstack.ListNetscalerLoadBalancersParams) (*cloudstack.ListNetscalerLoadBalancersResponse, error)
+	NewListNetscalerLoadBalancersPagerFunc               func(p *cloudstack.ListNetscalerLoadBalancersParams) *cloudstack.ListNetscalerLoadBalancersPager
+	ListNetscalerLoadBalancersAllFunc                    func(p *cloudstack.ListNetscalerLoadBalancersParams) (*cloudstack.ListNetscalerLoadBalancersResponse, error)
+	ListNetscalerLoadBalancersAllWithContextFunc         func(ctx context.Context, p *cloudstack.ListNetscalerLoadBalancersParams) (*cloudstack.ListNetscalerLoadBalancersResponse, error)
+	NewListSslCertsParamsFunc                            func() *cloudstack.ListSslCertsParams
+	ListSslCertsFunc                                     func(p *cloudstack.ListSslCertsParams) (*cloudstack.ListSslCertsResponse, error)
+	ListSslCertsWithContextFunc                          func(ctx context.Context, p *cloudstack.ListSslCertsParams) (*cloudstack.ListSslCertsResponse, error)
+	NewRemoveCertFromLoadBalancerParamsFunc              func(lbruleid string) *cloudstack.RemoveCertFromLoadBalancerParams
+	RemoveCertFromLoadBalancerFunc                       func(p *cloudstack.RemoveCertFromLoadBalancerParams) (*cloudstack.RemoveCertFromLoadBalancerResponse, error)
+	RemoveCertFromLoadBalancerWithContextFunc            func(ctx context.Context, p *cloudstack.RemoveCertFromLoadBalancerParams) (*cloudstack.RemoveCertFromLoadBalancerResponse, error)
+	RemoveCertFromLoadBalancerAsyncFunc                  func(p *cloudstack.RemoveCertFromLoadBalancerParams) (*cloudstack.Job, error)
+	RemoveCertFromLoadBalancerAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.RemoveCertFromLoadBalancerParams) (*cloudstack.Job, error)
+	NewRemoveFromGlobalLoadBalancerRuleParamsFunc        func(id string, loadbalancerrulelist []string) *cloudstack.RemoveFromGlobalLoadBalancerRuleParams
+	RemoveFromGlobalLoadBalancerRuleFunc                 func(p *cloudstack.RemoveFromGlobalLoadBalancerRuleParams) (*cloudstack.RemoveFromGlobalLoadBalancerRuleResponse, error)
+	RemoveFromGlobalLoadBalancerRuleWithContextFunc      func(ctx context.Context, p *cloudstack.RemoveFromGlobalLoadBalancerRuleParams) (*cloudstack.RemoveFromGlobalLoadBalancerRuleResponse, error)
+	RemoveFromGlobalLoadBalancerRuleAsyncFunc            func(p *cloudstack.RemoveFromGlobalLoadBalancerRuleParams) (*cloudstack.Job, error)
+	RemoveFromGlobalLoadBalancerRuleAsyncWithContextFunc func(ctx context.Context, p *cloudstack.RemoveFromGlobalLoadBalancerRuleParams) (*cloudstack.Job, error)
+	NewRemoveFromLoadBalancerRuleParamsFunc              func(id string) *cloudstack.RemoveFromLoadBalancerRuleParams
+	RemoveFromLoadBalancerRuleFunc                       func(p *cloudstack.RemoveFromLoadBalancerRuleParams) (*cloudstack.RemoveFromLoadBalancerRuleResponse, error)
+	RemoveFromLoadBalancerRuleWithContextFunc            func(ctx context.Context, p *cloudstack.RemoveFromLoadBalancerRuleParams) (*cloudstack.RemoveFromLoadBalancerRuleResponse, error)
+	RemoveFromLoadBalancerRuleAsyncFunc                  func(p *cloudstack.RemoveFromLoadBalancerRuleParams) (*cloudstack.Job, error)
+	RemoveFromLoadBalancerRuleAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.RemoveFromLoadBalancerRuleParams) (*cloudstack.Job, error)
+	NewUpdateGlobalLoadBalancerRuleParamsFunc            func(id string) *cloudstack.UpdateGlobalLoadBalancerRuleParams
+	UpdateGlobalLoadBalancerRuleFunc                     func(p *cloudstack.UpdateGlobalLoadBalancerRuleParams) (*cloudstack.UpdateGlobalLoadBalancerRuleResponse, error)
+	UpdateGlobalLoadBalancerRuleWithContextFunc          func(ctx context.Context, p *cloudstack.UpdateGlobalLoadBalancerRuleParams) (*cloudstack.UpdateGlobalLoadBalancerRuleResponse, error)
+	UpdateGlobalLoadBalancerRuleAsyncFunc                func(p *cloudstack.UpdateGlobalLoadBalancerRuleParams) (*cloudstack.Job, error)
+	UpdateGlobalLoadBalancerRuleAsyncWithContextFunc     func(ctx context.Context, p *cloudstack.UpdateGlobalLoadBalancerRuleParams) (*cloudstack.Job, error)
+	NewUpdateLBHealthCheckPolicyParamsFunc               func(id string) *cloudstack.UpdateLBHealthCheckPolicyParams
+	UpdateLBHealthCheckPolicyFunc                        func(p *cloudstack.UpdateLBHealthCheckPolicyParams) (*cloudstack.UpdateLBHealthCheckPolicyResponse, error)
+	UpdateLBHealthCheckPolicyWithContextFunc             func(ctx context.Context, p *cloudstack.UpdateLBHealthCheckPolicyParams) (*cloudstack.UpdateLBHealthCheckPolicyResponse, error)
+	UpdateLBHealthCheckPolicyAsyncFunc                   func(p *cloudstack.UpdateLBHealthCheckPolicyParams) (*cloudstack.Job, error)
+	UpdateLBHealthCheckPolicyAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.UpdateLBHealthCheckPolicyParams) (*cloudstack.Job, error)
+	NewUpdateLBStickinessPolicyParamsFunc                func(id string) *cloudstack.UpdateLBStickinessPolicyParams
+	UpdateLBStickinessPolicyFunc                         func(p *cloudstack.UpdateLBStickinessPolicyParams) (*cloudstack.UpdateLBStickinessPolicyResponse, error)
+	UpdateLBStickinessPolicyWithContextFunc              func(ctx context.Context, p *cloudstack.UpdateLBStickinessPolicyParams) (*cloudstack.UpdateLBStickinessPolicyResponse, error)
+	UpdateLBStickinessPolicyAsyncFunc                    func(p *cloudstack.UpdateLBStickinessPolicyParams) (*cloudstack.Job, error)
+	UpdateLBStickinessPolicyAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.UpdateLBStickinessPolicyParams) (*cloudstack.Job, error)
+	NewUpdateLoadBalancerParamsFunc                      func(id string) *cloudstack.UpdateLoadBalancerParams
+	UpdateLoadBalancerFunc                               func(p *cloudstack.UpdateLoadBalancerParams) (*cloudstack.UpdateLoadBalancerResponse, error)
+	UpdateLoadBalancerWithContextFunc                    func(ctx context.Context, p *cloudstack.UpdateLoadBalancerParams) (*cloudstack.UpdateLoadBalancerResponse, error)
+	UpdateLoadBalancerAsyncFunc                          func(p *cloudstack.UpdateLoadBalancerParams) (*cloudstack.Job, error)
+	UpdateLoadBalancerAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.UpdateLoadBalancerParams) (*cloudstack.Job, error)
+	NewUpdateLoadBalancerRuleParamsFunc                  func(id string) *cloudstack.UpdateLoadBalancerRuleParams
+	UpdateLoadBalancerRuleFunc                           func(p *cloudstack.UpdateLoadBalancerRuleParams) (*cloudstack.UpdateLoadBalancerRuleResponse, error)
+	UpdateLoadBalancerRuleWithContextFunc                func(ctx context.Context, p *cloudstack.UpdateLoadBalancerRuleParams) (*cloudstack.UpdateLoadBalancerRuleResponse, error)
+	UpdateLoadBalancerRuleAsyncFunc                      func(p *cloudstack.UpdateLoadBalancerRuleParams) (*cloudstack.Job, error)
+	UpdateLoadBalancerRuleAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.UpdateLoadBalancerRuleParams) (*cloudstack.Job, error)
+	NewUploadSslCertParamsFunc                           func(certificate string, name string, privatekey string) *cloudstack.UploadSslCertParams
+	UploadSslCertFunc                                    func(p *cloudstack.UploadSslCertParams) (*cloudstack.UploadSslCertResponse, error)
+	UploadSslCertWithContextFunc                         func(ctx context.Context, p *cloudstack.UploadSslCertParams) (*cloudstack.UploadSslCertResponse, error)
+}
+
+var _ cloudstack.LoadBalancerServiceIface = (*MockLoadBalancerService)(nil)
+
+// NewAddExternalLoadBalancerParams records the call and calls NewAddExternalLoadBalancerParamsFunc
+func (m *MockLoadBalancerService) NewAddExternalLoadBalancerParams(password string, url string, username string, zoneid string) (r0 *cloudstack.AddExternalLoadBalancerParams) {
+	m.record("NewAddExternalLoadBalancerParams", password, url, username, zoneid)
+	if m.NewAddExternalLoadBalancerParamsFunc != nil {
+		return m.NewAddExternalLoadBalancerParamsFunc(password, url, username, zoneid)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewAddExternalLoadBalancerParams(password, url, username, zoneid)
+}
+
+// AddExternalLoadBalancer records the call and calls AddExternalLoadBalancerFunc, or AddExternalLoadBalancerWithContextFunc if only that is set
+func (m *MockLoadBalancerService) AddExternalLoadBalancer(p *cloudstack.AddExternalLoadBalancerParams) (r0 *cloudstack.AddExternalLoadBalancerResponse, r1 error) {
+	m.record("AddExternalLoadBalancer", p)
+	if m.AddExternalLoadBalancerFunc != nil {
+		return m.AddExternalLoadBalancerFunc(p)
+	}
+	if m.AddExternalLoadBalancerWithContextFunc != nil {
+		return m.AddExternalLoadBalancerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.AddExternalLoadBalancer")
+	return
+}
+
+// AddExternalLoadBalancerWithContext records the call and calls AddExternalLoadBalancerWithContextFunc, or AddExternalLoadBalancerFunc if only that is set
+func (m *MockLoadBalancerService) AddExternalLoadBalancerWithContext(ctx context.Context, p *cloudstack.AddExternalLoadBalancerParams) (r0 *cloudstack.AddExternalLoadBalancerResponse, r1 error) {
+	m.record("AddExternalLoadBalancerWithContext", ctx, p)
+	if m.AddExternalLoadBalancerWithContextFunc != nil {
+		return m.AddExternalLoadBalancerWithContextFunc(ctx, p)
+	}
+	if m.AddExternalLoadBalancerFunc != nil {
+		return m.AddExternalLoadBalancerFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.AddExternalLoadBalancerWithContext")
+	return
+}
+
+// NewAddF5LoadBalancerParams records the call and calls NewAddF5LoadBalancerParamsFunc
+func (m *MockLoadBalancerService) NewAddF5LoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) (r0 *cloudstack.AddF5LoadBalancerParams) {
+	m.record("NewAddF5LoadBalancerParams", networkdevicetype, password, physicalnetworkid, url, username)
+	if m.NewAddF5LoadBalancerParamsFunc != nil {
+		return m.NewAddF5LoadBalancerParamsFunc(networkdevicetype, password, physicalnetworkid, url, username)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewAddF5LoadBalancerParams(networkdevicetype, password, physicalnetworkid, url, username)
+}
+
+// AddF5LoadBalancer records the call and calls AddF5LoadBalancerFunc, or AddF5LoadBalancerWithContextFunc if only that is set
+func (m *MockLoadBalancerService) AddF5LoadBalancer(p *cloudstack.AddF5LoadBalancerParams) (r0 *cloudstack.AddF5LoadBalancerResponse, r1 error) {
+	m.record("AddF5LoadBalancer", p)
+	if m.AddF5LoadBalancerFunc != nil {
+		return m.AddF5LoadBalancerFunc(p)
+	}
+	if m.AddF5LoadBalancerWithContextFunc != nil {
+		return m.AddF5LoadBalancerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.AddF5LoadBalancer")
+	return
+}
+
+// AddF5LoadBalancerWithContext records the call and calls AddF5LoadBalancerWithContextFunc, or AddF5LoadBalancerFunc if only that is set
+func (m *MockLoadBalancerService) AddF5LoadBalancerWithContext(ctx context.Context, p *cloudstack.AddF5LoadBalancerParams) (r0 *cloudstack.AddF5LoadBalancerResponse, r1 error) {
+	m.record("AddF5LoadBalancerWithContext", ctx, p)
+	if m.AddF5LoadBalancerWithContextFunc != nil {
+		return m.AddF5LoadBalancerWithContextFunc(ctx, p)
+	}
+	if m.AddF5LoadBalancerFunc != nil {
+		return m.AddF5LoadBalancerFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.AddF5LoadBalancerWithContext")
+	return
+}
+
+// AddF5LoadBalancerAsync records the call and calls AddF5LoadBalancerAsyncFunc, or AddF5LoadBalancerAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) AddF5LoadBalancerAsync(p *cloudstack.AddF5LoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddF5LoadBalancerAsync", p)
+	if m.AddF5LoadBalancerAsyncFunc != nil {
+		return m.AddF5LoadBalancerAsyncFunc(p)
+	}
+	if m.AddF5LoadBalancerAsyncWithContextFunc != nil {
+		return m.AddF5LoadBalancerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.AddF5LoadBalancerAsync")
+	return
+}
+
+// AddF5LoadBalancerAsyncWithContext records the call and calls AddF5LoadBalancerAsyncWithContextFunc, or AddF5LoadBalancerAsyncFunc if only that is set
+func (m *MockLoadBalancerService) AddF5LoadBalancerAsyncWithContext(ctx context.Context, p *cloudstack.AddF5LoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddF5LoadBalancerAsyncWithContext", ctx, p)
+	if m.AddF5LoadBalancerAsyncWithContextFunc != nil {
+		return m.AddF5LoadBalancerAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddF5LoadBalancerAsyncFunc != nil {
+		return m.AddF5LoadBalancerAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.AddF5LoadBalancerAsyncWithContext")
+	return
+}
+
+// NewAddNetscalerLoadBalancerParams records the call and calls NewAddNetscalerLoadBalancerParamsFunc
+func (m *MockLoadBalancerService) NewAddNetscalerLoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) (r0 *cloudstack.AddNetscalerLoadBalancerParams) {
+	m.record("NewAddNetscalerLoadBalancerParams", networkdevicetype, password, physicalnetworkid, url, username)
+	if m.NewAddNetscalerLoadBalancerParamsFunc != nil {
+		return m.NewAddNetscalerLoadBalancerParamsFunc(networkdevicetype, password, physicalnetworkid, url, username)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewAddNetscalerLoadBalancerParams(networkdevicetype, password, physicalnetworkid, url, username)
+}
+
+// AddNetscalerLoadBalancer records the call and calls AddNetscalerLoadBalancerFunc, or AddNetscalerLoadBalancerWithContextFunc if only that is set
+func (m *MockLoadBalancerService) AddNetscalerLoadBalancer(p *cloudstack.AddNetscalerLoadBalancerParams) (r0 *cloudstack.AddNetscalerLoadBalancerResponse, r1 error) {
+	m.record("AddNetscalerLoadBalancer", p)
+	if m.AddNetscalerLoadBalancerFunc != nil {
+		return m.AddNetscalerLoadBalancerFunc(p)
+	}
+	if m.AddNetscalerLoadBalancerWithContextFunc != nil {
+		return m.AddNetscalerLoadBalancerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.AddNetscalerLoadBalancer")
+	return
+}
+
+// AddNetscalerLoadBalancerWithContext records the call and calls AddNetscalerLoadBalancerWithContextFunc, or AddNetscalerLoadBalancerFunc if only that is set
+func (m *MockLoadBalancerService) AddNetscalerLoadBalancerWithContext(ctx context.Context, p *cloudstack.AddNetscalerLoadBalancerParams) (r0 *cloudstack.AddNetscalerLoadBalancerResponse, r1 error) {
+	m.record("AddNetscalerLoadBalancerWithContext", ctx, p)
+	if m.AddNetscalerLoadBalancerWithContextFunc != nil {
+		return m.AddNetscalerLoadBalancerWithContextFunc(ctx, p)
+	}
+	if m.AddNetscalerLoadBalancerFunc != nil {
+		return m.AddNetscalerLoadBalancerFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.AddNetscalerLoadBalancerWithContext")
+	return
+}
+
+// AddNetscalerLoadBalancerAsync records the call and calls AddNetscalerLoadBalancerAsyncFunc, or AddNetscalerLoadBalancerAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) AddNetscalerLoadBalancerAsync(p *cloudstack.AddNetscalerLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddNetscalerLoadBalancerAsync", p)
+	if m.AddNetscalerLoadBalancerAsyncFunc != nil {
+		return m.AddNetscalerLoadBalancerAsyncFunc(p)
+	}
+	if m.AddNetscalerLoadBalancerAsyncWithContextFunc != nil {
+		return m.AddNetscalerLoadBalancerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.AddNetscalerLoadBalancerAsync")
+	return
+}
+
+// AddNetscalerLoadBalancerAsyncWithContext records the call and calls AddNetscalerLoadBalancerAsyncWithContextFunc, or AddNetscalerLoadBalancerAsyncFunc if only that is set
+func (m *MockLoadBalancerService) AddNetscalerLoadBalancerAsyncWithContext(ctx context.Context, p *cloudstack.AddNetscalerLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddNetscalerLoadBalancerAsyncWithContext", ctx, p)
+	if m.AddNetscalerLoadBalancerAsyncWithContextFunc != nil {
+		return m.AddNetscalerLoadBalancerAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddNetscalerLoadBalancerAsyncFunc != nil {
+		return m.AddNetscalerLoadBalancerAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.AddNetscalerLoadBalancerAsyncWithContext")
+	return
+}
+
+// NewAssignCertToLoadBalancerParams records the call and calls NewAssignCertToLoadBalancerParamsFunc
+func (m *MockLoadBalancerService) NewAssignCertToLoadBalancerParams(certid string, lbruleid string) (r0 *cloudstack.AssignCertToLoadBalancerParams) {
+	m.record("NewAssignCertToLoadBalancerParams", certid, lbruleid)
+	if m.NewAssignCertToLoadBalancerParamsFunc != nil {
+		return m.NewAssignCertToLoadBalancerParamsFunc(certid, lbruleid)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewAssignCertToLoadBalancerParams(certid, lbruleid)
+}
+
+// AssignCertToLoadBalancer records the call and calls AssignCertToLoadBalancerFunc, or AssignCertToLoadBalancerWithContextFunc if only that is set
+func (m *MockLoadBalancerService) AssignCertToLoadBalancer(p *cloudstack.AssignCertToLoadBalancerParams) (r0 *cloudstack.AssignCertToLoadBalancerResponse, r1 error) {
+	m.record("AssignCertToLoadBalancer", p)
+	if m.AssignCertToLoadBalancerFunc != nil {
+		return m.AssignCertToLoadBalancerFunc(p)
+	}
+	if m.AssignCertToLoadBalancerWithContextFunc != nil {
+		return m.AssignCertToLoadBalancerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.AssignCertToLoadBalancer")
+	return
+}
+
+// AssignCertToLoadBalancerWithContext records the call and calls AssignCertToLoadBalancerWithContextFunc, or AssignCertToLoadBalancerFunc if only that is set
+func (m *MockLoadBalancerService) AssignCertToLoadBalancerWithContext(ctx context.Context, p *cloudstack.AssignCertToLoadBalancerParams) (r0 *cloudstack.AssignCertToLoadBalancerResponse, r1 error) {
+	m.record("AssignCertToLoadBalancerWithContext", ctx, p)
+	if m.AssignCertToLoadBalancerWithContextFunc != nil {
+		return m.AssignCertToLoadBalancerWithContextFunc(ctx, p)
+	}
+	if m.AssignCertToLoadBalancerFunc != nil {
+		return m.AssignCertToLoadBalancerFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.AssignCertToLoadBalancerWithContext")
+	return
+}
+
+// AssignCertToLoadBalancerAsync records the call and calls AssignCertToLoadBalancerAsyncFunc, or AssignCertToLoadBalancerAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) AssignCertToLoadBalancerAsync(p *cloudstack.AssignCertToLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AssignCertToLoadBalancerAsync", p)
+	if m.AssignCertToLoadBalancerAsyncFunc != nil {
+		return m.AssignCertToLoadBalancerAsyncFunc(p)
+	}
+	if m.AssignCertToLoadBalancerAsyncWithContextFunc != nil {
+		return m.AssignCertToLoadBalancerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.AssignCertToLoadBalancerAsync")
+	return
+}
+
+// AssignCertToLoadBalancerAsyncWithContext records the call and calls AssignCertToLoadBalancerAsyncWithContextFunc, or AssignCertToLoadBalancerAsyncFunc if only that is set
+func (m *MockLoadBalancerService) AssignCertToLoadBalancerAsyncWithContext(ctx context.Context, p *cloudstack.AssignCertToLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AssignCertToLoadBalancerAsyncWithContext", ctx, p)
+	if m.AssignCertToLoadBalancerAsyncWithContextFunc != nil {
+		return m.AssignCertToLoadBalancerAsyncWithContextFunc(ctx, p)
+	}
+	if m.AssignCertToLoadBalancerAsyncFunc != nil {
+		return m.AssignCertToLoadBalancerAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.AssignCertToLoadBalancerAsyncWithContext")
+	return
+}
+
+// NewAssignToGlobalLoadBalancerRuleParams records the call and calls NewAssignToGlobalLoadBalancerRuleParamsFunc
+func (m *MockLoadBalancerService) NewAssignToGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) (r0 *cloudstack.AssignToGlobalLoadBalancerRuleParams) {
+	m.record("NewAssignToGlobalLoadBalancerRuleParams", id, loadbalancerrulelist)
+	if m.NewAssignToGlobalLoadBalancerRuleParamsFunc != nil {
+		return m.NewAssignToGlobalLoadBalancerRuleParamsFunc(id, loadbalancerrulelist)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewAssignToGlobalLoadBalancerRuleParams(id, loadbalancerrulelist)
+}
+
+// AssignToGlobalLoadBalancerRule records the call and calls AssignToGlobalLoadBalancerRuleFunc, or AssignToGlobalLoadBalancerRuleWithContextFunc if only that is set
+func (m *MockLoadBalancerService) AssignToGlobalLoadBalancerRule(p *cloudstack.AssignToGlobalLoadBalancerRuleParams) (r0 *cloudstack.AssignToGlobalLoadBalancerRuleResponse, r1 error) {
+	m.record("AssignToGlobalLoadBalancerRule", p)
+	if m.AssignToGlobalLoadBalancerRuleFunc != nil {
+		return m.AssignToGlobalLoadBalancerRuleFunc(p)
+	}
+	if m.AssignToGlobalLoadBalancerRuleWithContextFunc != nil {
+		return m.AssignToGlobalLoadBalancerRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.AssignToGlobalLoadBalancerRule")
+	return
+}
+
+// AssignToGlobalLoadBalancerRuleWithContext records the call and calls AssignToGlobalLoadBalancerRuleWithContextFunc, or AssignToGlobalLoadBalancerRuleFunc if only that is set
+func (m *MockLoadBalancerService) AssignToGlobalLoadBalancerRuleWithContext(ctx context.Context, p *cloudstack.AssignToGlobalLoadBalancerRuleParams) (r0 *cloudstack.AssignToGlobalLoadBalancerRuleResponse, r1 error) {
+	m.record("AssignToGlobalLoadBalancerRuleWithContext", ctx, p)
+	if m.AssignToGlobalLoadBalancerRuleWithContextFunc != nil {
+		return m.AssignToGlobalLoadBalancerRuleWithContextFunc(ctx, p)
+	}
+	if m.AssignToGlobalLoadBalancerRuleFunc != nil {
+		return m.AssignToGlobalLoadBalancerRuleFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.AssignToGlobalLoadBalancerRuleWithContext")
+	return
+}
+
+// AssignToGlobalLoadBalancerRuleAsync records the call and calls AssignToGlobalLoadBalancerRuleAsyncFunc, or AssignToGlobalLoadBalancerRuleAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) AssignToGlobalLoadBalancerRuleAsync(p *cloudstack.AssignToGlobalLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AssignToGlobalLoadBalancerRuleAsync", p)
+	if m.AssignToGlobalLoadBalancerRuleAsyncFunc != nil {
+		return m.AssignToGlobalLoadBalancerRuleAsyncFunc(p)
+	}
+	if m.AssignToGlobalLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.AssignToGlobalLoadBalancerRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.AssignToGlobalLoadBalancerRuleAsync")
+	return
+}
+
+// AssignToGlobalLoadBalancerRuleAsyncWithContext records the call and calls AssignToGlobalLoadBalancerRuleAsyncWithContextFunc, or AssignToGlobalLoadBalancerRuleAsyncFunc if only that is set
+func (m *MockLoadBalancerService) AssignToGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *cloudstack.AssignToGlobalLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AssignToGlobalLoadBalancerRuleAsyncWithContext", ctx, p)
+	if m.AssignToGlobalLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.AssignToGlobalLoadBalancerRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.AssignToGlobalLoadBalancerRuleAsyncFunc != nil {
+		return m.AssignToGlobalLoadBalancerRuleAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.AssignToGlobalLoadBalancerRuleAsyncWithContext")
+	return
+}
+
+// NewAssignToLoadBalancerRuleParams records the call and calls NewAssignToLoadBalancerRuleParamsFunc
+func (m *MockLoadBalancerService) NewAssignToLoadBalancerRuleParams(id string) (r0 *cloudstack.AssignToLoadBalancerRuleParams) {
+	m.record("NewAssignToLoadBalancerRuleParams", id)
+	if m.NewAssignToLoadBalancerRuleParamsFunc != nil {
+		return m.NewAssignToLoadBalancerRuleParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewAssignToLoadBalancerRuleParams(id)
+}
+
+// AssignToLoadBalancerRule records the call and calls AssignToLoadBalancerRuleFunc, or AssignToLoadBalancerRuleWithContextFunc if only that is set
+func (m *MockLoadBalancerService) AssignToLoadBalancerRule(p *cloudstack.AssignToLoadBalancerRuleParams) (r0 *cloudstack.AssignToLoadBalancerRuleResponse, r1 error) {
+	m.record("AssignToLoadBalancerRule", p)
+	if m.AssignToLoadBalancerRuleFunc != nil {
+		return m.AssignToLoadBalancerRuleFunc(p)
+	}
+	if m.AssignToLoadBalancerRuleWithContextFunc != nil {
+		return m.AssignToLoadBalancerRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.AssignToLoadBalancerRule")
+	return
+}
+
+// AssignToLoadBalancerRuleWithContext records the call and calls AssignToLoadBalancerRuleWithContextFunc, or AssignToLoadBalancerRuleFunc if only that is set
+func (m *MockLoadBalancerService) AssignToLoadBalancerRuleWithContext(ctx context.Context, p *cloudstack.AssignToLoadBalancerRuleParams) (r0 *cloudstack.AssignToLoadBalancerRuleResponse, r1 error) {
+	m.record("AssignToLoadBalancerRuleWithContext", ctx, p)
+	if m.AssignToLoadBalancerRuleWithContextFunc != nil {
+		return m.AssignToLoadBalancerRuleWithContextFunc(ctx, p)
+	}
+	if m.AssignToLoadBalancerRuleFunc != nil {
+		return m.AssignToLoadBalancerRuleFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.AssignToLoadBalancerRuleWithContext")
+	return
+}
+
+// AssignToLoadBalancerRuleAsync records the call and calls AssignToLoadBalancerRuleAsyncFunc, or AssignToLoadBalancerRuleAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) AssignToLoadBalancerRuleAsync(p *cloudstack.AssignToLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AssignToLoadBalancerRuleAsync", p)
+	if m.AssignToLoadBalancerRuleAsyncFunc != nil {
+		return m.AssignToLoadBalancerRuleAsyncFunc(p)
+	}
+	if m.AssignToLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.AssignToLoadBalancerRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.AssignToLoadBalancerRuleAsync")
+	return
+}
+
+// AssignToLoadBalancerRuleAsyncWithContext records the call and calls AssignToLoadBalancerRuleAsyncWithContextFunc, or AssignToLoadBalancerRuleAsyncFunc if only that is set
+func (m *MockLoadBalancerService) AssignToLoadBalancerRuleAsyncWithContext(ctx context.Context, p *cloudstack.AssignToLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AssignToLoadBalancerRuleAsyncWithContext", ctx, p)
+	if m.AssignToLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.AssignToLoadBalancerRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.AssignToLoadBalancerRuleAsyncFunc != nil {
+		return m.AssignToLoadBalancerRuleAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.AssignToLoadBalancerRuleAsyncWithContext")
+	return
+}
+
+// NewConfigureF5LoadBalancerParams records the call and calls NewConfigureF5LoadBalancerParamsFunc
+func (m *MockLoadBalancerService) NewConfigureF5LoadBalancerParams(lbdeviceid string) (r0 *cloudstack.ConfigureF5LoadBalancerParams) {
+	m.record("NewConfigureF5LoadBalancerParams", lbdeviceid)
+	if m.NewConfigureF5LoadBalancerParamsFunc != nil {
+		return m.NewConfigureF5LoadBalancerParamsFunc(lbdeviceid)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewConfigureF5LoadBalancerParams(lbdeviceid)
+}
+
+// ConfigureF5LoadBalancer records the call and calls ConfigureF5LoadBalancerFunc, or ConfigureF5LoadBalancerWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ConfigureF5LoadBalancer(p *cloudstack.ConfigureF5LoadBalancerParams) (r0 *cloudstack.F5LoadBalancerResponse, r1 error) {
+	m.record("ConfigureF5LoadBalancer", p)
+	if m.ConfigureF5LoadBalancerFunc != nil {
+		return m.ConfigureF5LoadBalancerFunc(p)
+	}
+	if m.ConfigureF5LoadBalancerWithContextFunc != nil {
+		return m.ConfigureF5LoadBalancerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ConfigureF5LoadBalancer")
+	return
+}
+
+// ConfigureF5LoadBalancerWithContext records the call and calls ConfigureF5LoadBalancerWithContextFunc, or ConfigureF5LoadBalancerFunc if only that is set
+func (m *MockLoadBalancerService) ConfigureF5LoadBalancerWithContext(ctx context.Context, p *cloudstack.ConfigureF5LoadBalancerParams) (r0 *cloudstack.F5LoadBalancerResponse, r1 error) {
+	m.record("ConfigureF5LoadBalancerWithContext", ctx, p)
+	if m.ConfigureF5LoadBalancerWithContextFunc != nil {
+		return m.ConfigureF5LoadBalancerWithContextFunc(ctx, p)
+	}
+	if m.ConfigureF5LoadBalancerFunc != nil {
+		return m.ConfigureF5LoadBalancerFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ConfigureF5LoadBalancerWithContext")
+	return
+}
+
+// ConfigureF5LoadBalancerAsync records the call and calls ConfigureF5LoadBalancerAsyncFunc, or ConfigureF5LoadBalancerAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ConfigureF5LoadBalancerAsync(p *cloudstack.ConfigureF5LoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigureF5LoadBalancerAsync", p)
+	if m.ConfigureF5LoadBalancerAsyncFunc != nil {
+		return m.ConfigureF5LoadBalancerAsyncFunc(p)
+	}
+	if m.ConfigureF5LoadBalancerAsyncWithContextFunc != nil {
+		return m.ConfigureF5LoadBalancerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ConfigureF5LoadBalancerAsync")
+	return
+}
+
+// ConfigureF5LoadBalancerAsyncWithContext records the call and calls ConfigureF5LoadBalancerAsyncWithContextFunc, or ConfigureF5LoadBalancerAsyncFunc if only that is set
+func (m *MockLoadBalancerService) ConfigureF5LoadBalancerAsyncWithContext(ctx context.Context, p *cloudstack.ConfigureF5LoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigureF5LoadBalancerAsyncWithContext", ctx, p)
+	if m.ConfigureF5LoadBalancerAsyncWithContextFunc != nil {
+		return m.ConfigureF5LoadBalancerAsyncWithContextFunc(ctx, p)
+	}
+	if m.ConfigureF5LoadBalancerAsyncFunc != nil {
+		return m.ConfigureF5LoadBalancerAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ConfigureF5LoadBalancerAsyncWithContext")
+	return
+}
+
+// NewConfigureNetscalerLoadBalancerParams records the call and calls NewConfigureNetscalerLoadBalancerParamsFunc
+func (m *MockLoadBalancerService) NewConfigureNetscalerLoadBalancerParams(lbdeviceid string) (r0 *cloudstack.ConfigureNetscalerLoadBalancerParams) {
+	m.record("NewConfigureNetscalerLoadBalancerParams", lbdeviceid)
+	if m.NewConfigureNetscalerLoadBalancerParamsFunc != nil {
+		return m.NewConfigureNetscalerLoadBalancerParamsFunc(lbdeviceid)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewConfigureNetscalerLoadBalancerParams(lbdeviceid)
+}
+
+// ConfigureNetscalerLoadBalancer records the call and calls ConfigureNetscalerLoadBalancerFunc, or ConfigureNetscalerLoadBalancerWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ConfigureNetscalerLoadBalancer(p *cloudstack.ConfigureNetscalerLoadBalancerParams) (r0 *cloudstack.NetscalerLoadBalancerResponse, r1 error) {
+	m.record("ConfigureNetscalerLoadBalancer", p)
+	if m.ConfigureNetscalerLoadBalancerFunc != nil {
+		return m.ConfigureNetscalerLoadBalancerFunc(p)
+	}
+	if m.ConfigureNetscalerLoadBalancerWithContextFunc != nil {
+		return m.ConfigureNetscalerLoadBalancerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ConfigureNetscalerLoadBalancer")
+	return
+}
+
+// ConfigureNetscalerLoadBalancerWithContext records the call and calls ConfigureNetscalerLoadBalancerWithContextFunc, or ConfigureNetscalerLoadBalancerFunc if only that is set
+func (m *MockLoadBalancerService) ConfigureNetscalerLoadBalancerWithContext(ctx context.Context, p *cloudstack.ConfigureNetscalerLoadBalancerParams) (r0 *cloudstack.NetscalerLoadBalancerResponse, r1 error) {
+	m.record("ConfigureNetscalerLoadBalancerWithContext", ctx, p)
+	if m.ConfigureNetscalerLoadBalancerWithContextFunc != nil {
+		return m.ConfigureNetscalerLoadBalancerWithContextFunc(ctx, p)
+	}
+	if m.ConfigureNetscalerLoadBalancerFunc != nil {
+		return m.ConfigureNetscalerLoadBalancerFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ConfigureNetscalerLoadBalancerWithContext")
+	return
+}
+
+// ConfigureNetscalerLoadBalancerAsync records the call and calls ConfigureNetscalerLoadBalancerAsyncFunc, or ConfigureNetscalerLoadBalancerAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ConfigureNetscalerLoadBalancerAsync(p *cloudstack.ConfigureNetscalerLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigureNetscalerLoadBalancerAsync", p)
+	if m.ConfigureNetscalerLoadBalancerAsyncFunc != nil {
+		return m.ConfigureNetscalerLoadBalancerAsyncFunc(p)
+	}
+	if m.ConfigureNetscalerLoadBalancerAsyncWithContextFunc != nil {
+		return m.ConfigureNetscalerLoadBalancerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ConfigureNetscalerLoadBalancerAsync")
+	return
+}
+
+// ConfigureNetscalerLoadBalancerAsyncWithContext records the call and calls ConfigureNetscalerLoadBalancerAsyncWithContextFunc, or ConfigureNetscalerLoadBalancerAsyncFunc if only that is set
+func (m *MockLoadBalancerService) ConfigureNetscalerLoadBalancerAsyncWithContext(ctx context.Context, p *cloudstack.ConfigureNetscalerLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigureNetscalerLoadBalancerAsyncWithContext", ctx, p)
+	if m.ConfigureNetscalerLoadBalancerAsyncWithContextFunc != nil {
+		return m.ConfigureNetscalerLoadBalancerAsyncWithContextFunc(ctx, p)
+	}
+	if m.ConfigureNetscalerLoadBalancerAsyncFunc != nil {
+		return m.ConfigureNetscalerLoadBalancerAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ConfigureNetscalerLoadBalancerAsyncWithContext")
+	return
+}
+
+// NewCreateGlobalLoadBalancerRuleParams records the call and calls NewCreateGlobalLoadBalancerRuleParamsFunc
+func (m *MockLoadBalancerService) NewCreateGlobalLoadBalancerRuleParams(gslbdomainname string, gslbservicetype string, name string, regionid int) (r0 *cloudstack.CreateGlobalLoadBalancerRuleParams) {
+	m.record("NewCreateGlobalLoadBalancerRuleParams", gslbdomainname, gslbservicetype, name, regionid)
+	if m.NewCreateGlobalLoadBalancerRuleParamsFunc != nil {
+		return m.NewCreateGlobalLoadBalancerRuleParamsFunc(gslbdomainname, gslbservicetype, name, regionid)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewCreateGlobalLoadBalancerRuleParams(gslbdomainname, gslbservicetype, name, regionid)
+}
+
+// CreateGlobalLoadBalancerRule records the call and calls CreateGlobalLoadBalancerRuleFunc, or CreateGlobalLoadBalancerRuleWithContextFunc if only that is set
+func (m *MockLoadBalancerService) CreateGlobalLoadBalancerRule(p *cloudstack.CreateGlobalLoadBalancerRuleParams) (r0 *cloudstack.CreateGlobalLoadBalancerRuleResponse, r1 error) {
+	m.record("CreateGlobalLoadBalancerRule", p)
+	if m.CreateGlobalLoadBalancerRuleFunc != nil {
+		return m.CreateGlobalLoadBalancerRuleFunc(p)
+	}
+	if m.CreateGlobalLoadBalancerRuleWithContextFunc != nil {
+		return m.CreateGlobalLoadBalancerRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateGlobalLoadBalancerRule")
+	return
+}
+
+// CreateGlobalLoadBalancerRuleWithContext records the call and calls CreateGlobalLoadBalancerRuleWithContextFunc, or CreateGlobalLoadBalancerRuleFunc if only that is set
+func (m *MockLoadBalancerService) CreateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *cloudstack.CreateGlobalLoadBalancerRuleParams) (r0 *cloudstack.CreateGlobalLoadBalancerRuleResponse, r1 error) {
+	m.record("CreateGlobalLoadBalancerRuleWithContext", ctx, p)
+	if m.CreateGlobalLoadBalancerRuleWithContextFunc != nil {
+		return m.CreateGlobalLoadBalancerRuleWithContextFunc(ctx, p)
+	}
+	if m.CreateGlobalLoadBalancerRuleFunc != nil {
+		return m.CreateGlobalLoadBalancerRuleFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateGlobalLoadBalancerRuleWithContext")
+	return
+}
+
+// CreateGlobalLoadBalancerRuleAsync records the call and calls CreateGlobalLoadBalancerRuleAsyncFunc, or CreateGlobalLoadBalancerRuleAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) CreateGlobalLoadBalancerRuleAsync(p *cloudstack.CreateGlobalLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateGlobalLoadBalancerRuleAsync", p)
+	if m.CreateGlobalLoadBalancerRuleAsyncFunc != nil {
+		return m.CreateGlobalLoadBalancerRuleAsyncFunc(p)
+	}
+	if m.CreateGlobalLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.CreateGlobalLoadBalancerRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateGlobalLoadBalancerRuleAsync")
+	return
+}
+
+// CreateGlobalLoadBalancerRuleAsyncWithContext records the call and calls CreateGlobalLoadBalancerRuleAsyncWithContextFunc, or CreateGlobalLoadBalancerRuleAsyncFunc if only that is set
+func (m *MockLoadBalancerService) CreateGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *cloudstack.CreateGlobalLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateGlobalLoadBalancerRuleAsyncWithContext", ctx, p)
+	if m.CreateGlobalLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.CreateGlobalLoadBalancerRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateGlobalLoadBalancerRuleAsyncFunc != nil {
+		return m.CreateGlobalLoadBalancerRuleAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateGlobalLoadBalancerRuleAsyncWithContext")
+	return
+}
+
+// NewCreateLBHealthCheckPolicyParams records the call and calls NewCreateLBHealthCheckPolicyParamsFunc
+func (m *MockLoadBalancerService) NewCreateLBHealthCheckPolicyParams(lbruleid string) (r0 *cloudstack.CreateLBHealthCheckPolicyParams) {
+	m.record("NewCreateLBHealthCheckPolicyParams", lbruleid)
+	if m.NewCreateLBHealthCheckPolicyParamsFunc != nil {
+		return m.NewCreateLBHealthCheckPolicyParamsFunc(lbruleid)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewCreateLBHealthCheckPolicyParams(lbruleid)
+}
+
+// CreateLBHealthCheckPolicy records the call and calls CreateLBHealthCheckPolicyFunc, or CreateLBHealthCheckPolicyWithContextFunc if only that is set
+func (m *MockLoadBalancerService) CreateLBHealthCheckPolicy(p *cloudstack.CreateLBHealthCheckPolicyParams) (r0 *cloudstack.CreateLBHealthCheckPolicyResponse, r1 error) {
+	m.record("CreateLBHealthCheckPolicy", p)
+	if m.CreateLBHealthCheckPolicyFunc != nil {
+		return m.CreateLBHealthCheckPolicyFunc(p)
+	}
+	if m.CreateLBHealthCheckPolicyWithContextFunc != nil {
+		return m.CreateLBHealthCheckPolicyWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLBHealthCheckPolicy")
+	return
+}
+
+// CreateLBHealthCheckPolicyWithContext records the call and calls CreateLBHealthCheckPolicyWithContextFunc, or CreateLBHealthCheckPolicyFunc if only that is set
+func (m *MockLoadBalancerService) CreateLBHealthCheckPolicyWithContext(ctx context.Context, p *cloudstack.CreateLBHealthCheckPolicyParams) (r0 *cloudstack.CreateLBHealthCheckPolicyResponse, r1 error) {
+	m.record("CreateLBHealthCheckPolicyWithContext", ctx, p)
+	if m.CreateLBHealthCheckPolicyWithContextFunc != nil {
+		return m.CreateLBHealthCheckPolicyWithContextFunc(ctx, p)
+	}
+	if m.CreateLBHealthCheckPolicyFunc != nil {
+		return m.CreateLBHealthCheckPolicyFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLBHealthCheckPolicyWithContext")
+	return
+}
+
+// CreateLBHealthCheckPolicyAsync records the call and calls CreateLBHealthCheckPolicyAsyncFunc, or CreateLBHealthCheckPolicyAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) CreateLBHealthCheckPolicyAsync(p *cloudstack.CreateLBHealthCheckPolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateLBHealthCheckPolicyAsync", p)
+	if m.CreateLBHealthCheckPolicyAsyncFunc != nil {
+		return m.CreateLBHealthCheckPolicyAsyncFunc(p)
+	}
+	if m.CreateLBHealthCheckPolicyAsyncWithContextFunc != nil {
+		return m.CreateLBHealthCheckPolicyAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLBHealthCheckPolicyAsync")
+	return
+}
+
+// CreateLBHealthCheckPolicyAsyncWithContext records the call and calls CreateLBHealthCheckPolicyAsyncWithContextFunc, or CreateLBHealthCheckPolicyAsyncFunc if only that is set
+func (m *MockLoadBalancerService) CreateLBHealthCheckPolicyAsyncWithContext(ctx context.Context, p *cloudstack.CreateLBHealthCheckPolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateLBHealthCheckPolicyAsyncWithContext", ctx, p)
+	if m.CreateLBHealthCheckPolicyAsyncWithContextFunc != nil {
+		return m.CreateLBHealthCheckPolicyAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateLBHealthCheckPolicyAsyncFunc != nil {
+		return m.CreateLBHealthCheckPolicyAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLBHealthCheckPolicyAsyncWithContext")
+	return
+}
+
+// NewCreateLBStickinessPolicyParams records the call and calls NewCreateLBStickinessPolicyParamsFunc
+func (m *MockLoadBalancerService) NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string) (r0 *cloudstack.CreateLBStickinessPolicyParams) {
+	m.record("NewCreateLBStickinessPolicyParams", lbruleid, methodname, name)
+	if m.NewCreateLBStickinessPolicyParamsFunc != nil {
+		return m.NewCreateLBStickinessPolicyParamsFunc(lbruleid, methodname, name)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewCreateLBStickinessPolicyParams(lbruleid, methodname, name)
+}
+
+// CreateLBStickinessPolicy records the call and calls CreateLBStickinessPolicyFunc, or CreateLBStickinessPolicyWithContextFunc if only that is set
+func (m *MockLoadBalancerService) CreateLBStickinessPolicy(p *cloudstack.CreateLBStickinessPolicyParams) (r0 *cloudstack.CreateLBStickinessPolicyResponse, r1 error) {
+	m.record("CreateLBStickinessPolicy", p)
+	if m.CreateLBStickinessPolicyFunc != nil {
+		return m.CreateLBStickinessPolicyFunc(p)
+	}
+	if m.CreateLBStickinessPolicyWithContextFunc != nil {
+		return m.CreateLBStickinessPolicyWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLBStickinessPolicy")
+	return
+}
+
+// CreateLBStickinessPolicyWithContext records the call and calls CreateLBStickinessPolicyWithContextFunc, or CreateLBStickinessPolicyFunc if only that is set
+func (m *MockLoadBalancerService) CreateLBStickinessPolicyWithContext(ctx context.Context, p *cloudstack.CreateLBStickinessPolicyParams) (r0 *cloudstack.CreateLBStickinessPolicyResponse, r1 error) {
+	m.record("CreateLBStickinessPolicyWithContext", ctx, p)
+	if m.CreateLBStickinessPolicyWithContextFunc != nil {
+		return m.CreateLBStickinessPolicyWithContextFunc(ctx, p)
+	}
+	if m.CreateLBStickinessPolicyFunc != nil {
+		return m.CreateLBStickinessPolicyFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLBStickinessPolicyWithContext")
+	return
+}
+
+// CreateLBStickinessPolicyAsync records the call and calls CreateLBStickinessPolicyAsyncFunc, or CreateLBStickinessPolicyAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) CreateLBStickinessPolicyAsync(p *cloudstack.CreateLBStickinessPolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateLBStickinessPolicyAsync", p)
+	if m.CreateLBStickinessPolicyAsyncFunc != nil {
+		return m.CreateLBStickinessPolicyAsyncFunc(p)
+	}
+	if m.CreateLBStickinessPolicyAsyncWithContextFunc != nil {
+		return m.CreateLBStickinessPolicyAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLBStickinessPolicyAsync")
+	return
+}
+
+// CreateLBStickinessPolicyAsyncWithContext records the call and calls CreateLBStickinessPolicyAsyncWithContextFunc, or CreateLBStickinessPolicyAsyncFunc if only that is set
+func (m *MockLoadBalancerService) CreateLBStickinessPolicyAsyncWithContext(ctx context.Context, p *cloudstack.CreateLBStickinessPolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateLBStickinessPolicyAsyncWithContext", ctx, p)
+	if m.CreateLBStickinessPolicyAsyncWithContextFunc != nil {
+		return m.CreateLBStickinessPolicyAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateLBStickinessPolicyAsyncFunc != nil {
+		return m.CreateLBStickinessPolicyAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLBStickinessPolicyAsyncWithContext")
+	return
+}
+
+// NewCreateLoadBalancerParams records the call and calls NewCreateLoadBalancerParamsFunc
+func (m *MockLoadBalancerService) NewCreateLoadBalancerParams(algorithm string, instanceport int, name string, networkid string, scheme string, sourceipaddressnetworkid string, sourceport int) (r0 *cloudstack.CreateLoadBalancerParams) {
+	m.record("NewCreateLoadBalancerParams", algorithm, instanceport, name, networkid, scheme, sourceipaddressnetworkid, sourceport)
+	if m.NewCreateLoadBalancerParamsFunc != nil {
+		return m.NewCreateLoadBalancerParamsFunc(algorithm, instanceport, name, networkid, scheme, sourceipaddressnetworkid, sourceport)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewCreateLoadBalancerParams(algorithm, instanceport, name, networkid, scheme, sourceipaddressnetworkid, sourceport)
+}
+
+// CreateLoadBalancer records the call and calls CreateLoadBalancerFunc, or CreateLoadBalancerWithContextFunc if only that is set
+func (m *MockLoadBalancerService) CreateLoadBalancer(p *cloudstack.CreateLoadBalancerParams) (r0 *cloudstack.CreateLoadBalancerResponse, r1 error) {
+	m.record("CreateLoadBalancer", p)
+	if m.CreateLoadBalancerFunc != nil {
+		return m.CreateLoadBalancerFunc(p)
+	}
+	if m.CreateLoadBalancerWithContextFunc != nil {
+		return m.CreateLoadBalancerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLoadBalancer")
+	return
+}
+
+// CreateLoadBalancerWithContext records the call and calls CreateLoadBalancerWithContextFunc, or CreateLoadBalancerFunc if only that is set
+func (m *MockLoadBalancerService) CreateLoadBalancerWithContext(ctx context.Context, p *cloudstack.CreateLoadBalancerParams) (r0 *cloudstack.CreateLoadBalancerResponse, r1 error) {
+	m.record("CreateLoadBalancerWithContext", ctx, p)
+	if m.CreateLoadBalancerWithContextFunc != nil {
+		return m.CreateLoadBalancerWithContextFunc(ctx, p)
+	}
+	if m.CreateLoadBalancerFunc != nil {
+		return m.CreateLoadBalancerFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLoadBalancerWithContext")
+	return
+}
+
+// CreateLoadBalancerAsync records the call and calls CreateLoadBalancerAsyncFunc, or CreateLoadBalancerAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) CreateLoadBalancerAsync(p *cloudstack.CreateLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateLoadBalancerAsync", p)
+	if m.CreateLoadBalancerAsyncFunc != nil {
+		return m.CreateLoadBalancerAsyncFunc(p)
+	}
+	if m.CreateLoadBalancerAsyncWithContextFunc != nil {
+		return m.CreateLoadBalancerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLoadBalancerAsync")
+	return
+}
+
+// CreateLoadBalancerAsyncWithContext records the call and calls CreateLoadBalancerAsyncWithContextFunc, or CreateLoadBalancerAsyncFunc if only that is set
+func (m *MockLoadBalancerService) CreateLoadBalancerAsyncWithContext(ctx context.Context, p *cloudstack.CreateLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateLoadBalancerAsyncWithContext", ctx, p)
+	if m.CreateLoadBalancerAsyncWithContextFunc != nil {
+		return m.CreateLoadBalancerAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateLoadBalancerAsyncFunc != nil {
+		return m.CreateLoadBalancerAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLoadBalancerAsyncWithContext")
+	return
+}
+
+// NewCreateLoadBalancerRuleParams records the call and calls NewCreateLoadBalancerRuleParamsFunc
+func (m *MockLoadBalancerService) NewCreateLoadBalancerRuleParams(algorithm string, name string, privateport int, publicport int) (r0 *cloudstack.CreateLoadBalancerRuleParams) {
+	m.record("NewCreateLoadBalancerRuleParams", algorithm, name, privateport, publicport)
+	if m.NewCreateLoadBalancerRuleParamsFunc != nil {
+		return m.NewCreateLoadBalancerRuleParamsFunc(algorithm, name, privateport, publicport)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewCreateLoadBalancerRuleParams(algorithm, name, privateport, publicport)
+}
+
+// CreateLoadBalancerRule records the call and calls CreateLoadBalancerRuleFunc, or CreateLoadBalancerRuleWithContextFunc if only that is set
+func (m *MockLoadBalancerService) CreateLoadBalancerRule(p *cloudstack.CreateLoadBalancerRuleParams) (r0 *cloudstack.CreateLoadBalancerRuleResponse, r1 error) {
+	m.record("CreateLoadBalancerRule", p)
+	if m.CreateLoadBalancerRuleFunc != nil {
+		return m.CreateLoadBalancerRuleFunc(p)
+	}
+	if m.CreateLoadBalancerRuleWithContextFunc != nil {
+		return m.CreateLoadBalancerRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLoadBalancerRule")
+	return
+}
+
+// CreateLoadBalancerRuleWithContext records the call and calls CreateLoadBalancerRuleWithContextFunc, or CreateLoadBalancerRuleFunc if only that is set
+func (m *MockLoadBalancerService) CreateLoadBalancerRuleWithContext(ctx context.Context, p *cloudstack.CreateLoadBalancerRuleParams) (r0 *cloudstack.CreateLoadBalancerRuleResponse, r1 error) {
+	m.record("CreateLoadBalancerRuleWithContext", ctx, p)
+	if m.CreateLoadBalancerRuleWithContextFunc != nil {
+		return m.CreateLoadBalancerRuleWithContextFunc(ctx, p)
+	}
+	if m.CreateLoadBalancerRuleFunc != nil {
+		return m.CreateLoadBalancerRuleFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLoadBalancerRuleWithContext")
+	return
+}
+
+// CreateLoadBalancerRuleAsync records the call and calls CreateLoadBalancerRuleAsyncFunc, or CreateLoadBalancerRuleAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) CreateLoadBalancerRuleAsync(p *cloudstack.CreateLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateLoadBalancerRuleAsync", p)
+	if m.CreateLoadBalancerRuleAsyncFunc != nil {
+		return m.CreateLoadBalancerRuleAsyncFunc(p)
+	}
+	if m.CreateLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.CreateLoadBalancerRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLoadBalancerRuleAsync")
+	return
+}
+
+// CreateLoadBalancerRuleAsyncWithContext records the call and calls CreateLoadBalancerRuleAsyncWithContextFunc, or CreateLoadBalancerRuleAsyncFunc if only that is set
+func (m *MockLoadBalancerService) CreateLoadBalancerRuleAsyncWithContext(ctx context.Context, p *cloudstack.CreateLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateLoadBalancerRuleAsyncWithContext", ctx, p)
+	if m.CreateLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.CreateLoadBalancerRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateLoadBalancerRuleAsyncFunc != nil {
+		return m.CreateLoadBalancerRuleAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.CreateLoadBalancerRuleAsyncWithContext")
+	return
+}
+
+// NewDeleteExternalLoadBalancerParams records the call and calls NewDeleteExternalLoadBalancerParamsFunc
+func (m *MockLoadBalancerService) NewDeleteExternalLoadBalancerParams(id string) (r0 *cloudstack.DeleteExternalLoadBalancerParams) {
+	m.record("NewDeleteExternalLoadBalancerParams", id)
+	if m.NewDeleteExternalLoadBalancerParamsFunc != nil {
+		return m.NewDeleteExternalLoadBalancerParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewDeleteExternalLoadBalancerParams(id)
+}
+
+// DeleteExternalLoadBalancer records the call and calls DeleteExternalLoadBalancerFunc, or DeleteExternalLoadBalancerWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteExternalLoadBalancer(p *cloudstack.DeleteExternalLoadBalancerParams) (r0 *cloudstack.DeleteExternalLoadBalancerResponse, r1 error) {
+	m.record("DeleteExternalLoadBalancer", p)
+	if m.DeleteExternalLoadBalancerFunc != nil {
+		return m.DeleteExternalLoadBalancerFunc(p)
+	}
+	if m.DeleteExternalLoadBalancerWithContextFunc != nil {
+		return m.DeleteExternalLoadBalancerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteExternalLoadBalancer")
+	return
+}
+
+// DeleteExternalLoadBalancerWithContext records the call and calls DeleteExternalLoadBalancerWithContextFunc, or DeleteExternalLoadBalancerFunc if only that is set
+func (m *MockLoadBalancerService) DeleteExternalLoadBalancerWithContext(ctx context.Context, p *cloudstack.DeleteExternalLoadBalancerParams) (r0 *cloudstack.DeleteExternalLoadBalancerResponse, r1 error) {
+	m.record("DeleteExternalLoadBalancerWithContext", ctx, p)
+	if m.DeleteExternalLoadBalancerWithContextFunc != nil {
+		return m.DeleteExternalLoadBalancerWithContextFunc(ctx, p)
+	}
+	if m.DeleteExternalLoadBalancerFunc != nil {
+		return m.DeleteExternalLoadBalancerFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteExternalLoadBalancerWithContext")
+	return
+}
+
+// NewDeleteF5LoadBalancerParams records the call and calls NewDeleteF5LoadBalancerParamsFunc
+func (m *MockLoadBalancerService) NewDeleteF5LoadBalancerParams(lbdeviceid string) (r0 *cloudstack.DeleteF5LoadBalancerParams) {
+	m.record("NewDeleteF5LoadBalancerParams", lbdeviceid)
+	if m.NewDeleteF5LoadBalancerParamsFunc != nil {
+		return m.NewDeleteF5LoadBalancerParamsFunc(lbdeviceid)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewDeleteF5LoadBalancerParams(lbdeviceid)
+}
+
+// DeleteF5LoadBalancer records the call and calls DeleteF5LoadBalancerFunc, or DeleteF5LoadBalancerWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteF5LoadBalancer(p *cloudstack.DeleteF5LoadBalancerParams) (r0 *cloudstack.DeleteF5LoadBalancerResponse, r1 error) {
+	m.record("DeleteF5LoadBalancer", p)
+	if m.DeleteF5LoadBalancerFunc != nil {
+		return m.DeleteF5LoadBalancerFunc(p)
+	}
+	if m.DeleteF5LoadBalancerWithContextFunc != nil {
+		return m.DeleteF5LoadBalancerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteF5LoadBalancer")
+	return
+}
+
+// DeleteF5LoadBalancerWithContext records the call and calls DeleteF5LoadBalancerWithContextFunc, or DeleteF5LoadBalancerFunc if only that is set
+func (m *MockLoadBalancerService) DeleteF5LoadBalancerWithContext(ctx context.Context, p *cloudstack.DeleteF5LoadBalancerParams) (r0 *cloudstack.DeleteF5LoadBalancerResponse, r1 error) {
+	m.record("DeleteF5LoadBalancerWithContext", ctx, p)
+	if m.DeleteF5LoadBalancerWithContextFunc != nil {
+		return m.DeleteF5LoadBalancerWithContextFunc(ctx, p)
+	}
+	if m.DeleteF5LoadBalancerFunc != nil {
+		return m.DeleteF5LoadBalancerFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteF5LoadBalancerWithContext")
+	return
+}
+
+// DeleteF5LoadBalancerAsync records the call and calls DeleteF5LoadBalancerAsyncFunc, or DeleteF5LoadBalancerAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteF5LoadBalancerAsync(p *cloudstack.DeleteF5LoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteF5LoadBalancerAsync", p)
+	if m.DeleteF5LoadBalancerAsyncFunc != nil {
+		return m.DeleteF5LoadBalancerAsyncFunc(p)
+	}
+	if m.DeleteF5LoadBalancerAsyncWithContextFunc != nil {
+		return m.DeleteF5LoadBalancerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteF5LoadBalancerAsync")
+	return
+}
+
+// DeleteF5LoadBalancerAsyncWithContext records the call and calls DeleteF5LoadBalancerAsyncWithContextFunc, or DeleteF5LoadBalancerAsyncFunc if only that is set
+func (m *MockLoadBalancerService) DeleteF5LoadBalancerAsyncWithContext(ctx context.Context, p *cloudstack.DeleteF5LoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteF5LoadBalancerAsyncWithContext", ctx, p)
+	if m.DeleteF5LoadBalancerAsyncWithContextFunc != nil {
+		return m.DeleteF5LoadBalancerAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteF5LoadBalancerAsyncFunc != nil {
+		return m.DeleteF5LoadBalancerAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteF5LoadBalancerAsyncWithContext")
+	return
+}
+
+// NewDeleteGlobalLoadBalancerRuleParams records the call and calls NewDeleteGlobalLoadBalancerRuleParamsFunc
+func (m *MockLoadBalancerService) NewDeleteGlobalLoadBalancerRuleParams(id string) (r0 *cloudstack.DeleteGlobalLoadBalancerRuleParams) {
+	m.record("NewDeleteGlobalLoadBalancerRuleParams", id)
+	if m.NewDeleteGlobalLoadBalancerRuleParamsFunc != nil {
+		return m.NewDeleteGlobalLoadBalancerRuleParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewDeleteGlobalLoadBalancerRuleParams(id)
+}
+
+// DeleteGlobalLoadBalancerRule records the call and calls DeleteGlobalLoadBalancerRuleFunc, or DeleteGlobalLoadBalancerRuleWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteGlobalLoadBalancerRule(p *cloudstack.DeleteGlobalLoadBalancerRuleParams) (r0 *cloudstack.DeleteGlobalLoadBalancerRuleResponse, r1 error) {
+	m.record("DeleteGlobalLoadBalancerRule", p)
+	if m.DeleteGlobalLoadBalancerRuleFunc != nil {
+		return m.DeleteGlobalLoadBalancerRuleFunc(p)
+	}
+	if m.DeleteGlobalLoadBalancerRuleWithContextFunc != nil {
+		return m.DeleteGlobalLoadBalancerRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteGlobalLoadBalancerRule")
+	return
+}
+
+// DeleteGlobalLoadBalancerRuleWithContext records the call and calls DeleteGlobalLoadBalancerRuleWithContextFunc, or DeleteGlobalLoadBalancerRuleFunc if only that is set
+func (m *MockLoadBalancerService) DeleteGlobalLoadBalancerRuleWithContext(ctx context.Context, p *cloudstack.DeleteGlobalLoadBalancerRuleParams) (r0 *cloudstack.DeleteGlobalLoadBalancerRuleResponse, r1 error) {
+	m.record("DeleteGlobalLoadBalancerRuleWithContext", ctx, p)
+	if m.DeleteGlobalLoadBalancerRuleWithContextFunc != nil {
+		return m.DeleteGlobalLoadBalancerRuleWithContextFunc(ctx, p)
+	}
+	if m.DeleteGlobalLoadBalancerRuleFunc != nil {
+		return m.DeleteGlobalLoadBalancerRuleFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteGlobalLoadBalancerRuleWithContext")
+	return
+}
+
+// DeleteGlobalLoadBalancerRuleAsync records the call and calls DeleteGlobalLoadBalancerRuleAsyncFunc, or DeleteGlobalLoadBalancerRuleAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteGlobalLoadBalancerRuleAsync(p *cloudstack.DeleteGlobalLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteGlobalLoadBalancerRuleAsync", p)
+	if m.DeleteGlobalLoadBalancerRuleAsyncFunc != nil {
+		return m.DeleteGlobalLoadBalancerRuleAsyncFunc(p)
+	}
+	if m.DeleteGlobalLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.DeleteGlobalLoadBalancerRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteGlobalLoadBalancerRuleAsync")
+	return
+}
+
+// DeleteGlobalLoadBalancerRuleAsyncWithContext records the call and calls DeleteGlobalLoadBalancerRuleAsyncWithContextFunc, or DeleteGlobalLoadBalancerRuleAsyncFunc if only that is set
+func (m *MockLoadBalancerService) DeleteGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *cloudstack.DeleteGlobalLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteGlobalLoadBalancerRuleAsyncWithContext", ctx, p)
+	if m.DeleteGlobalLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.DeleteGlobalLoadBalancerRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteGlobalLoadBalancerRuleAsyncFunc != nil {
+		return m.DeleteGlobalLoadBalancerRuleAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteGlobalLoadBalancerRuleAsyncWithContext")
+	return
+}
+
+// NewDeleteLBHealthCheckPolicyParams records the call and calls NewDeleteLBHealthCheckPolicyParamsFunc
+func (m *MockLoadBalancerService) NewDeleteLBHealthCheckPolicyParams(id string) (r0 *cloudstack.DeleteLBHealthCheckPolicyParams) {
+	m.record("NewDeleteLBHealthCheckPolicyParams", id)
+	if m.NewDeleteLBHealthCheckPolicyParamsFunc != nil {
+		return m.NewDeleteLBHealthCheckPolicyParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewDeleteLBHealthCheckPolicyParams(id)
+}
+
+// DeleteLBHealthCheckPolicy records the call and calls DeleteLBHealthCheckPolicyFunc, or DeleteLBHealthCheckPolicyWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLBHealthCheckPolicy(p *cloudstack.DeleteLBHealthCheckPolicyParams) (r0 *cloudstack.DeleteLBHealthCheckPolicyResponse, r1 error) {
+	m.record("DeleteLBHealthCheckPolicy", p)
+	if m.DeleteLBHealthCheckPolicyFunc != nil {
+		return m.DeleteLBHealthCheckPolicyFunc(p)
+	}
+	if m.DeleteLBHealthCheckPolicyWithContextFunc != nil {
+		return m.DeleteLBHealthCheckPolicyWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLBHealthCheckPolicy")
+	return
+}
+
+// DeleteLBHealthCheckPolicyWithContext records the call and calls DeleteLBHealthCheckPolicyWithContextFunc, or DeleteLBHealthCheckPolicyFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLBHealthCheckPolicyWithContext(ctx context.Context, p *cloudstack.DeleteLBHealthCheckPolicyParams) (r0 *cloudstack.DeleteLBHealthCheckPolicyResponse, r1 error) {
+	m.record("DeleteLBHealthCheckPolicyWithContext", ctx, p)
+	if m.DeleteLBHealthCheckPolicyWithContextFunc != nil {
+		return m.DeleteLBHealthCheckPolicyWithContextFunc(ctx, p)
+	}
+	if m.DeleteLBHealthCheckPolicyFunc != nil {
+		return m.DeleteLBHealthCheckPolicyFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLBHealthCheckPolicyWithContext")
+	return
+}
+
+// DeleteLBHealthCheckPolicyAsync records the call and calls DeleteLBHealthCheckPolicyAsyncFunc, or DeleteLBHealthCheckPolicyAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLBHealthCheckPolicyAsync(p *cloudstack.DeleteLBHealthCheckPolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteLBHealthCheckPolicyAsync", p)
+	if m.DeleteLBHealthCheckPolicyAsyncFunc != nil {
+		return m.DeleteLBHealthCheckPolicyAsyncFunc(p)
+	}
+	if m.DeleteLBHealthCheckPolicyAsyncWithContextFunc != nil {
+		return m.DeleteLBHealthCheckPolicyAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLBHealthCheckPolicyAsync")
+	return
+}
+
+// DeleteLBHealthCheckPolicyAsyncWithContext records the call and calls DeleteLBHealthCheckPolicyAsyncWithContextFunc, or DeleteLBHealthCheckPolicyAsyncFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLBHealthCheckPolicyAsyncWithContext(ctx context.Context, p *cloudstack.DeleteLBHealthCheckPolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteLBHealthCheckPolicyAsyncWithContext", ctx, p)
+	if m.DeleteLBHealthCheckPolicyAsyncWithContextFunc != nil {
+		return m.DeleteLBHealthCheckPolicyAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteLBHealthCheckPolicyAsyncFunc != nil {
+		return m.DeleteLBHealthCheckPolicyAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLBHealthCheckPolicyAsyncWithContext")
+	return
+}
+
+// NewDeleteLBStickinessPolicyParams records the call and calls NewDeleteLBStickinessPolicyParamsFunc
+func (m *MockLoadBalancerService) NewDeleteLBStickinessPolicyParams(id string) (r0 *cloudstack.DeleteLBStickinessPolicyParams) {
+	m.record("NewDeleteLBStickinessPolicyParams", id)
+	if m.NewDeleteLBStickinessPolicyParamsFunc != nil {
+		return m.NewDeleteLBStickinessPolicyParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewDeleteLBStickinessPolicyParams(id)
+}
+
+// DeleteLBStickinessPolicy records the call and calls DeleteLBStickinessPolicyFunc, or DeleteLBStickinessPolicyWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLBStickinessPolicy(p *cloudstack.DeleteLBStickinessPolicyParams) (r0 *cloudstack.DeleteLBStickinessPolicyResponse, r1 error) {
+	m.record("DeleteLBStickinessPolicy", p)
+	if m.DeleteLBStickinessPolicyFunc != nil {
+		return m.DeleteLBStickinessPolicyFunc(p)
+	}
+	if m.DeleteLBStickinessPolicyWithContextFunc != nil {
+		return m.DeleteLBStickinessPolicyWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLBStickinessPolicy")
+	return
+}
+
+// DeleteLBStickinessPolicyWithContext records the call and calls DeleteLBStickinessPolicyWithContextFunc, or DeleteLBStickinessPolicyFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLBStickinessPolicyWithContext(ctx context.Context, p *cloudstack.DeleteLBStickinessPolicyParams) (r0 *cloudstack.DeleteLBStickinessPolicyResponse, r1 error) {
+	m.record("DeleteLBStickinessPolicyWithContext", ctx, p)
+	if m.DeleteLBStickinessPolicyWithContextFunc != nil {
+		return m.DeleteLBStickinessPolicyWithContextFunc(ctx, p)
+	}
+	if m.DeleteLBStickinessPolicyFunc != nil {
+		return m.DeleteLBStickinessPolicyFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLBStickinessPolicyWithContext")
+	return
+}
+
+// DeleteLBStickinessPolicyAsync records the call and calls DeleteLBStickinessPolicyAsyncFunc, or DeleteLBStickinessPolicyAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLBStickinessPolicyAsync(p *cloudstack.DeleteLBStickinessPolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteLBStickinessPolicyAsync", p)
+	if m.DeleteLBStickinessPolicyAsyncFunc != nil {
+		return m.DeleteLBStickinessPolicyAsyncFunc(p)
+	}
+	if m.DeleteLBStickinessPolicyAsyncWithContextFunc != nil {
+		return m.DeleteLBStickinessPolicyAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLBStickinessPolicyAsync")
+	return
+}
+
+// DeleteLBStickinessPolicyAsyncWithContext records the call and calls DeleteLBStickinessPolicyAsyncWithContextFunc, or DeleteLBStickinessPolicyAsyncFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLBStickinessPolicyAsyncWithContext(ctx context.Context, p *cloudstack.DeleteLBStickinessPolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteLBStickinessPolicyAsyncWithContext", ctx, p)
+	if m.DeleteLBStickinessPolicyAsyncWithContextFunc != nil {
+		return m.DeleteLBStickinessPolicyAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteLBStickinessPolicyAsyncFunc != nil {
+		return m.DeleteLBStickinessPolicyAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLBStickinessPolicyAsyncWithContext")
+	return
+}
+
+// NewDeleteLoadBalancerParams records the call and calls NewDeleteLoadBalancerParamsFunc
+func (m *MockLoadBalancerService) NewDeleteLoadBalancerParams(id string) (r0 *cloudstack.DeleteLoadBalancerParams) {
+	m.record("NewDeleteLoadBalancerParams", id)
+	if m.NewDeleteLoadBalancerParamsFunc != nil {
+		return m.NewDeleteLoadBalancerParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewDeleteLoadBalancerParams(id)
+}
+
+// DeleteLoadBalancer records the call and calls DeleteLoadBalancerFunc, or DeleteLoadBalancerWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLoadBalancer(p *cloudstack.DeleteLoadBalancerParams) (r0 *cloudstack.DeleteLoadBalancerResponse, r1 error) {
+	m.record("DeleteLoadBalancer", p)
+	if m.DeleteLoadBalancerFunc != nil {
+		return m.DeleteLoadBalancerFunc(p)
+	}
+	if m.DeleteLoadBalancerWithContextFunc != nil {
+		return m.DeleteLoadBalancerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLoadBalancer")
+	return
+}
+
+// DeleteLoadBalancerWithContext records the call and calls DeleteLoadBalancerWithContextFunc, or DeleteLoadBalancerFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLoadBalancerWithContext(ctx context.Context, p *cloudstack.DeleteLoadBalancerParams) (r0 *cloudstack.DeleteLoadBalancerResponse, r1 error) {
+	m.record("DeleteLoadBalancerWithContext", ctx, p)
+	if m.DeleteLoadBalancerWithContextFunc != nil {
+		return m.DeleteLoadBalancerWithContextFunc(ctx, p)
+	}
+	if m.DeleteLoadBalancerFunc != nil {
+		return m.DeleteLoadBalancerFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLoadBalancerWithContext")
+	return
+}
+
+// DeleteLoadBalancerAsync records the call and calls DeleteLoadBalancerAsyncFunc, or DeleteLoadBalancerAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLoadBalancerAsync(p *cloudstack.DeleteLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteLoadBalancerAsync", p)
+	if m.DeleteLoadBalancerAsyncFunc != nil {
+		return m.DeleteLoadBalancerAsyncFunc(p)
+	}
+	if m.DeleteLoadBalancerAsyncWithContextFunc != nil {
+		return m.DeleteLoadBalancerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLoadBalancerAsync")
+	return
+}
+
+// DeleteLoadBalancerAsyncWithContext records the call and calls DeleteLoadBalancerAsyncWithContextFunc, or DeleteLoadBalancerAsyncFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLoadBalancerAsyncWithContext(ctx context.Context, p *cloudstack.DeleteLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteLoadBalancerAsyncWithContext", ctx, p)
+	if m.DeleteLoadBalancerAsyncWithContextFunc != nil {
+		return m.DeleteLoadBalancerAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteLoadBalancerAsyncFunc != nil {
+		return m.DeleteLoadBalancerAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLoadBalancerAsyncWithContext")
+	return
+}
+
+// NewDeleteLoadBalancerRuleParams records the call and calls NewDeleteLoadBalancerRuleParamsFunc
+func (m *MockLoadBalancerService) NewDeleteLoadBalancerRuleParams(id string) (r0 *cloudstack.DeleteLoadBalancerRuleParams) {
+	m.record("NewDeleteLoadBalancerRuleParams", id)
+	if m.NewDeleteLoadBalancerRuleParamsFunc != nil {
+		return m.NewDeleteLoadBalancerRuleParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewDeleteLoadBalancerRuleParams(id)
+}
+
+// DeleteLoadBalancerRule records the call and calls DeleteLoadBalancerRuleFunc, or DeleteLoadBalancerRuleWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLoadBalancerRule(p *cloudstack.DeleteLoadBalancerRuleParams) (r0 *cloudstack.DeleteLoadBalancerRuleResponse, r1 error) {
+	m.record("DeleteLoadBalancerRule", p)
+	if m.DeleteLoadBalancerRuleFunc != nil {
+		return m.DeleteLoadBalancerRuleFunc(p)
+	}
+	if m.DeleteLoadBalancerRuleWithContextFunc != nil {
+		return m.DeleteLoadBalancerRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLoadBalancerRule")
+	return
+}
+
+// DeleteLoadBalancerRuleWithContext records the call and calls DeleteLoadBalancerRuleWithContextFunc, or DeleteLoadBalancerRuleFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLoadBalancerRuleWithContext(ctx context.Context, p *cloudstack.DeleteLoadBalancerRuleParams) (r0 *cloudstack.DeleteLoadBalancerRuleResponse, r1 error) {
+	m.record("DeleteLoadBalancerRuleWithContext", ctx, p)
+	if m.DeleteLoadBalancerRuleWithContextFunc != nil {
+		return m.DeleteLoadBalancerRuleWithContextFunc(ctx, p)
+	}
+	if m.DeleteLoadBalancerRuleFunc != nil {
+		return m.DeleteLoadBalancerRuleFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLoadBalancerRuleWithContext")
+	return
+}
+
+// DeleteLoadBalancerRuleAsync records the call and calls DeleteLoadBalancerRuleAsyncFunc, or DeleteLoadBalancerRuleAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLoadBalancerRuleAsync(p *cloudstack.DeleteLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteLoadBalancerRuleAsync", p)
+	if m.DeleteLoadBalancerRuleAsyncFunc != nil {
+		return m.DeleteLoadBalancerRuleAsyncFunc(p)
+	}
+	if m.DeleteLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.DeleteLoadBalancerRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLoadBalancerRuleAsync")
+	return
+}
+
+// DeleteLoadBalancerRuleAsyncWithContext records the call and calls DeleteLoadBalancerRuleAsyncWithContextFunc, or DeleteLoadBalancerRuleAsyncFunc if only that is set
+func (m *MockLoadBalancerService) DeleteLoadBalancerRuleAsyncWithContext(ctx context.Context, p *cloudstack.DeleteLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteLoadBalancerRuleAsyncWithContext", ctx, p)
+	if m.DeleteLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.DeleteLoadBalancerRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteLoadBalancerRuleAsyncFunc != nil {
+		return m.DeleteLoadBalancerRuleAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteLoadBalancerRuleAsyncWithContext")
+	return
+}
+
+// NewDeleteNetscalerLoadBalancerParams records the call and calls NewDeleteNetscalerLoadBalancerParamsFunc
+func (m *MockLoadBalancerService) NewDeleteNetscalerLoadBalancerParams(lbdeviceid string) (r0 *cloudstack.DeleteNetscalerLoadBalancerParams) {
+	m.record("NewDeleteNetscalerLoadBalancerParams", lbdeviceid)
+	if m.NewDeleteNetscalerLoadBalancerParamsFunc != nil {
+		return m.NewDeleteNetscalerLoadBalancerParamsFunc(lbdeviceid)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewDeleteNetscalerLoadBalancerParams(lbdeviceid)
+}
+
+// DeleteNetscalerLoadBalancer records the call and calls DeleteNetscalerLoadBalancerFunc, or DeleteNetscalerLoadBalancerWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteNetscalerLoadBalancer(p *cloudstack.DeleteNetscalerLoadBalancerParams) (r0 *cloudstack.DeleteNetscalerLoadBalancerResponse, r1 error) {
+	m.record("DeleteNetscalerLoadBalancer", p)
+	if m.DeleteNetscalerLoadBalancerFunc != nil {
+		return m.DeleteNetscalerLoadBalancerFunc(p)
+	}
+	if m.DeleteNetscalerLoadBalancerWithContextFunc != nil {
+		return m.DeleteNetscalerLoadBalancerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteNetscalerLoadBalancer")
+	return
+}
+
+// DeleteNetscalerLoadBalancerWithContext records the call and calls DeleteNetscalerLoadBalancerWithContextFunc, or DeleteNetscalerLoadBalancerFunc if only that is set
+func (m *MockLoadBalancerService) DeleteNetscalerLoadBalancerWithContext(ctx context.Context, p *cloudstack.DeleteNetscalerLoadBalancerParams) (r0 *cloudstack.DeleteNetscalerLoadBalancerResponse, r1 error) {
+	m.record("DeleteNetscalerLoadBalancerWithContext", ctx, p)
+	if m.DeleteNetscalerLoadBalancerWithContextFunc != nil {
+		return m.DeleteNetscalerLoadBalancerWithContextFunc(ctx, p)
+	}
+	if m.DeleteNetscalerLoadBalancerFunc != nil {
+		return m.DeleteNetscalerLoadBalancerFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteNetscalerLoadBalancerWithContext")
+	return
+}
+
+// DeleteNetscalerLoadBalancerAsync records the call and calls DeleteNetscalerLoadBalancerAsyncFunc, or DeleteNetscalerLoadBalancerAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteNetscalerLoadBalancerAsync(p *cloudstack.DeleteNetscalerLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteNetscalerLoadBalancerAsync", p)
+	if m.DeleteNetscalerLoadBalancerAsyncFunc != nil {
+		return m.DeleteNetscalerLoadBalancerAsyncFunc(p)
+	}
+	if m.DeleteNetscalerLoadBalancerAsyncWithContextFunc != nil {
+		return m.DeleteNetscalerLoadBalancerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteNetscalerLoadBalancerAsync")
+	return
+}
+
+// DeleteNetscalerLoadBalancerAsyncWithContext records the call and calls DeleteNetscalerLoadBalancerAsyncWithContextFunc, or DeleteNetscalerLoadBalancerAsyncFunc if only that is set
+func (m *MockLoadBalancerService) DeleteNetscalerLoadBalancerAsyncWithContext(ctx context.Context, p *cloudstack.DeleteNetscalerLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteNetscalerLoadBalancerAsyncWithContext", ctx, p)
+	if m.DeleteNetscalerLoadBalancerAsyncWithContextFunc != nil {
+		return m.DeleteNetscalerLoadBalancerAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteNetscalerLoadBalancerAsyncFunc != nil {
+		return m.DeleteNetscalerLoadBalancerAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteNetscalerLoadBalancerAsyncWithContext")
+	return
+}
+
+// NewDeleteSslCertParams records the call and calls NewDeleteSslCertParamsFunc
+func (m *MockLoadBalancerService) NewDeleteSslCertParams(id string) (r0 *cloudstack.DeleteSslCertParams) {
+	m.record("NewDeleteSslCertParams", id)
+	if m.NewDeleteSslCertParamsFunc != nil {
+		return m.NewDeleteSslCertParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewDeleteSslCertParams(id)
+}
+
+// DeleteSslCert records the call and calls DeleteSslCertFunc, or DeleteSslCertWithContextFunc if only that is set
+func (m *MockLoadBalancerService) DeleteSslCert(p *cloudstack.DeleteSslCertParams) (r0 *cloudstack.DeleteSslCertResponse, r1 error) {
+	m.record("DeleteSslCert", p)
+	if m.DeleteSslCertFunc != nil {
+		return m.DeleteSslCertFunc(p)
+	}
+	if m.DeleteSslCertWithContextFunc != nil {
+		return m.DeleteSslCertWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteSslCert")
+	return
+}
+
+// DeleteSslCertWithContext records the call and calls DeleteSslCertWithContextFunc, or DeleteSslCertFunc if only that is set
+func (m *MockLoadBalancerService) DeleteSslCertWithContext(ctx context.Context, p *cloudstack.DeleteSslCertParams) (r0 *cloudstack.DeleteSslCertResponse, r1 error) {
+	m.record("DeleteSslCertWithContext", ctx, p)
+	if m.DeleteSslCertWithContextFunc != nil {
+		return m.DeleteSslCertWithContextFunc(ctx, p)
+	}
+	if m.DeleteSslCertFunc != nil {
+		return m.DeleteSslCertFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.DeleteSslCertWithContext")
+	return
+}
+
+// NewListExternalLoadBalancersParams records the call and calls NewListExternalLoadBalancersParamsFunc
+func (m *MockLoadBalancerService) NewListExternalLoadBalancersParams() (r0 *cloudstack.ListExternalLoadBalancersParams) {
+	m.record("NewListExternalLoadBalancersParams")
+	if m.NewListExternalLoadBalancersParamsFunc != nil {
+		return m.NewListExternalLoadBalancersParamsFunc()
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewListExternalLoadBalancersParams()
+}
+
+// GetExternalLoadBalancerID records the call and calls GetExternalLoadBalancerIDFunc, or GetExternalLoadBalancerIDWithContextFunc if only that is set
+func (m *MockLoadBalancerService) GetExternalLoadBalancerID(keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetExternalLoadBalancerID", keyword, opts)
+	if m.GetExternalLoadBalancerIDFunc != nil {
+		return m.GetExternalLoadBalancerIDFunc(keyword, opts...)
+	}
+	if m.GetExternalLoadBalancerIDWithContextFunc != nil {
+		return m.GetExternalLoadBalancerIDWithContextFunc(context.Background(), keyword, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetExternalLoadBalancerID")
+	return
+}
+
+// GetExternalLoadBalancerIDWithContext records the call and calls GetExternalLoadBalancerIDWithContextFunc, or GetExternalLoadBalancerIDFunc if only that is set
+func (m *MockLoadBalancerService) GetExternalLoadBalancerIDWithContext(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetExternalLoadBalancerIDWithContext", ctx, keyword, opts)
+	if m.GetExternalLoadBalancerIDWithContextFunc != nil {
+		return m.GetExternalLoadBalancerIDWithContextFunc(ctx, keyword, opts...)
+	}
+	if m.GetExternalLoadBalancerIDFunc != nil {
+		return m.GetExternalLoadBalancerIDFunc(keyword, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetExternalLoadBalancerIDWithContext")
+	return
+}
+
+// ListExternalLoadBalancers records the call and calls ListExternalLoadBalancersFunc, or ListExternalLoadBalancersWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListExternalLoadBalancers(p *cloudstack.ListExternalLoadBalancersParams) (r0 *cloudstack.ListExternalLoadBalancersResponse, r1 error) {
+	m.record("ListExternalLoadBalancers", p)
+	if m.ListExternalLoadBalancersFunc != nil {
+		return m.ListExternalLoadBalancersFunc(p)
+	}
+	if m.ListExternalLoadBalancersWithContextFunc != nil {
+		return m.ListExternalLoadBalancersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListExternalLoadBalancers")
+	return
+}
+
+// ListExternalLoadBalancersWithContext records the call and calls ListExternalLoadBalancersWithContextFunc, or ListExternalLoadBalancersFunc if only that is set
+func (m *MockLoadBalancerService) ListExternalLoadBalancersWithContext(ctx context.Context, p *cloudstack.ListExternalLoadBalancersParams) (r0 *cloudstack.ListExternalLoadBalancersResponse, r1 error) {
+	m.record("ListExternalLoadBalancersWithContext", ctx, p)
+	if m.ListExternalLoadBalancersWithContextFunc != nil {
+		return m.ListExternalLoadBalancersWithContextFunc(ctx, p)
+	}
+	if m.ListExternalLoadBalancersFunc != nil {
+		return m.ListExternalLoadBalancersFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListExternalLoadBalancersWithContext")
+	return
+}
+
+// NewListExternalLoadBalancersPager records the call and calls NewListExternalLoadBalancersPagerFunc
+func (m *MockLoadBalancerService) NewListExternalLoadBalancersPager(p *cloudstack.ListExternalLoadBalancersParams) (r0 *cloudstack.ListExternalLoadBalancersPager) {
+	m.record("NewListExternalLoadBalancersPager", p)
+	if m.NewListExternalLoadBalancersPagerFunc != nil {
+		return m.NewListExternalLoadBalancersPagerFunc(p)
+	}
+	return
+}
+
+// ListExternalLoadBalancersAll records the call and calls ListExternalLoadBalancersAllFunc, or ListExternalLoadBalancersAllWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListExternalLoadBalancersAll(p *cloudstack.ListExternalLoadBalancersParams) (r0 *cloudstack.ListExternalLoadBalancersResponse, r1 error) {
+	m.record("ListExternalLoadBalancersAll", p)
+	if m.ListExternalLoadBalancersAllFunc != nil {
+		return m.ListExternalLoadBalancersAllFunc(p)
+	}
+	if m.ListExternalLoadBalancersAllWithContextFunc != nil {
+		return m.ListExternalLoadBalancersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListExternalLoadBalancersAll")
+	return
+}
+
+// ListExternalLoadBalancersAllWithContext records the call and calls ListExternalLoadBalancersAllWithContextFunc, or ListExternalLoadBalancersAllFunc if only that is set
+func (m *MockLoadBalancerService) ListExternalLoadBalancersAllWithContext(ctx context.Context, p *cloudstack.ListExternalLoadBalancersParams) (r0 *cloudstack.ListExternalLoadBalancersResponse, r1 error) {
+	m.record("ListExternalLoadBalancersAllWithContext", ctx, p)
+	if m.ListExternalLoadBalancersAllWithContextFunc != nil {
+		return m.ListExternalLoadBalancersAllWithContextFunc(ctx, p)
+	}
+	if m.ListExternalLoadBalancersAllFunc != nil {
+		return m.ListExternalLoadBalancersAllFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListExternalLoadBalancersAllWithContext")
+	return
+}
+
+// NewListF5LoadBalancerNetworksParams records the call and calls NewListF5LoadBalancerNetworksParamsFunc
+func (m *MockLoadBalancerService) NewListF5LoadBalancerNetworksParams(lbdeviceid string) (r0 *cloudstack.ListF5LoadBalancerNetworksParams) {
+	m.record("NewListF5LoadBalancerNetworksParams", lbdeviceid)
+	if m.NewListF5LoadBalancerNetworksParamsFunc != nil {
+		return m.NewListF5LoadBalancerNetworksParamsFunc(lbdeviceid)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewListF5LoadBalancerNetworksParams(lbdeviceid)
+}
+
+// GetF5LoadBalancerNetworkID records the call and calls GetF5LoadBalancerNetworkIDFunc, or GetF5LoadBalancerNetworkIDWithContextFunc if only that is set
+func (m *MockLoadBalancerService) GetF5LoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetF5LoadBalancerNetworkID", keyword, lbdeviceid, opts)
+	if m.GetF5LoadBalancerNetworkIDFunc != nil {
+		return m.GetF5LoadBalancerNetworkIDFunc(keyword, lbdeviceid, opts...)
+	}
+	if m.GetF5LoadBalancerNetworkIDWithContextFunc != nil {
+		return m.GetF5LoadBalancerNetworkIDWithContextFunc(context.Background(), keyword, lbdeviceid, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetF5LoadBalancerNetworkID")
+	return
+}
+
+// GetF5LoadBalancerNetworkIDWithContext records the call and calls GetF5LoadBalancerNetworkIDWithContextFunc, or GetF5LoadBalancerNetworkIDFunc if only that is set
+func (m *MockLoadBalancerService) GetF5LoadBalancerNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetF5LoadBalancerNetworkIDWithContext", ctx, keyword, lbdeviceid, opts)
+	if m.GetF5LoadBalancerNetworkIDWithContextFunc != nil {
+		return m.GetF5LoadBalancerNetworkIDWithContextFunc(ctx, keyword, lbdeviceid, opts...)
+	}
+	if m.GetF5LoadBalancerNetworkIDFunc != nil {
+		return m.GetF5LoadBalancerNetworkIDFunc(keyword, lbdeviceid, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetF5LoadBalancerNetworkIDWithContext")
+	return
+}
+
+// ListF5LoadBalancerNetworks records the call and calls ListF5LoadBalancerNetworksFunc, or ListF5LoadBalancerNetworksWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListF5LoadBalancerNetworks(p *cloudstack.ListF5LoadBalancerNetworksParams) (r0 *cloudstack.ListF5LoadBalancerNetworksResponse, r1 error) {
+	m.record("ListF5LoadBalancerNetworks", p)
+	if m.ListF5LoadBalancerNetworksFunc != nil {
+		return m.ListF5LoadBalancerNetworksFunc(p)
+	}
+	if m.ListF5LoadBalancerNetworksWithContextFunc != nil {
+		return m.ListF5LoadBalancerNetworksWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListF5LoadBalancerNetworks")
+	return
+}
+
+// ListF5LoadBalancerNetworksWithContext records the call and calls ListF5LoadBalancerNetworksWithContextFunc, or ListF5LoadBalancerNetworksFunc if only that is set
+func (m *MockLoadBalancerService) ListF5LoadBalancerNetworksWithContext(ctx context.Context, p *cloudstack.ListF5LoadBalancerNetworksParams) (r0 *cloudstack.ListF5LoadBalancerNetworksResponse, r1 error) {
+	m.record("ListF5LoadBalancerNetworksWithContext", ctx, p)
+	if m.ListF5LoadBalancerNetworksWithContextFunc != nil {
+		return m.ListF5LoadBalancerNetworksWithContextFunc(ctx, p)
+	}
+	if m.ListF5LoadBalancerNetworksFunc != nil {
+		return m.ListF5LoadBalancerNetworksFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListF5LoadBalancerNetworksWithContext")
+	return
+}
+
+// NewListF5LoadBalancerNetworksPager records the call and calls NewListF5LoadBalancerNetworksPagerFunc
+func (m *MockLoadBalancerService) NewListF5LoadBalancerNetworksPager(p *cloudstack.ListF5LoadBalancerNetworksParams) (r0 *cloudstack.ListF5LoadBalancerNetworksPager) {
+	m.record("NewListF5LoadBalancerNetworksPager", p)
+	if m.NewListF5LoadBalancerNetworksPagerFunc != nil {
+		return m.NewListF5LoadBalancerNetworksPagerFunc(p)
+	}
+	return
+}
+
+// ListF5LoadBalancerNetworksAll records the call and calls ListF5LoadBalancerNetworksAllFunc, or ListF5LoadBalancerNetworksAllWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListF5LoadBalancerNetworksAll(p *cloudstack.ListF5LoadBalancerNetworksParams) (r0 *cloudstack.ListF5LoadBalancerNetworksResponse, r1 error) {
+	m.record("ListF5LoadBalancerNetworksAll", p)
+	if m.ListF5LoadBalancerNetworksAllFunc != nil {
+		return m.ListF5LoadBalancerNetworksAllFunc(p)
+	}
+	if m.ListF5LoadBalancerNetworksAllWithContextFunc != nil {
+		return m.ListF5LoadBalancerNetworksAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListF5LoadBalancerNetworksAll")
+	return
+}
+
+// ListF5LoadBalancerNetworksAllWithContext records the call and calls ListF5LoadBalancerNetworksAllWithContextFunc, or ListF5LoadBalancerNetworksAllFunc if only that is set
+func (m *MockLoadBalancerService) ListF5LoadBalancerNetworksAllWithContext(ctx context.Context, p *cloudstack.ListF5LoadBalancerNetworksParams) (r0 *cloudstack.ListF5LoadBalancerNetworksResponse, r1 error) {
+	m.record("ListF5LoadBalancerNetworksAllWithContext", ctx, p)
+	if m.ListF5LoadBalancerNetworksAllWithContextFunc != nil {
+		return m.ListF5LoadBalancerNetworksAllWithContextFunc(ctx, p)
+	}
+	if m.ListF5LoadBalancerNetworksAllFunc != nil {
+		return m.ListF5LoadBalancerNetworksAllFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListF5LoadBalancerNetworksAllWithContext")
+	return
+}
+
+// NewListF5LoadBalancersParams records the call and calls NewListF5LoadBalancersParamsFunc
+func (m *MockLoadBalancerService) NewListF5LoadBalancersParams() (r0 *cloudstack.ListF5LoadBalancersParams) {
+	m.record("NewListF5LoadBalancersParams")
+	if m.NewListF5LoadBalancersParamsFunc != nil {
+		return m.NewListF5LoadBalancersParamsFunc()
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewListF5LoadBalancersParams()
+}
+
+// ListF5LoadBalancers records the call and calls ListF5LoadBalancersFunc, or ListF5LoadBalancersWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListF5LoadBalancers(p *cloudstack.ListF5LoadBalancersParams) (r0 *cloudstack.ListF5LoadBalancersResponse, r1 error) {
+	m.record("ListF5LoadBalancers", p)
+	if m.ListF5LoadBalancersFunc != nil {
+		return m.ListF5LoadBalancersFunc(p)
+	}
+	if m.ListF5LoadBalancersWithContextFunc != nil {
+		return m.ListF5LoadBalancersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListF5LoadBalancers")
+	return
+}
+
+// ListF5LoadBalancersWithContext records the call and calls ListF5LoadBalancersWithContextFunc, or ListF5LoadBalancersFunc if only that is set
+func (m *MockLoadBalancerService) ListF5LoadBalancersWithContext(ctx context.Context, p *cloudstack.ListF5LoadBalancersParams) (r0 *cloudstack.ListF5LoadBalancersResponse, r1 error) {
+	m.record("ListF5LoadBalancersWithContext", ctx, p)
+	if m.ListF5LoadBalancersWithContextFunc != nil {
+		return m.ListF5LoadBalancersWithContextFunc(ctx, p)
+	}
+	if m.ListF5LoadBalancersFunc != nil {
+		return m.ListF5LoadBalancersFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListF5LoadBalancersWithContext")
+	return
+}
+
+// NewListF5LoadBalancersPager records the call and calls NewListF5LoadBalancersPagerFunc
+func (m *MockLoadBalancerService) NewListF5LoadBalancersPager(p *cloudstack.ListF5LoadBalancersParams) (r0 *cloudstack.ListF5LoadBalancersPager) {
+	m.record("NewListF5LoadBalancersPager", p)
+	if m.NewListF5LoadBalancersPagerFunc != nil {
+		return m.NewListF5LoadBalancersPagerFunc(p)
+	}
+	return
+}
+
+// ListF5LoadBalancersAll records the call and calls ListF5LoadBalancersAllFunc, or ListF5LoadBalancersAllWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListF5LoadBalancersAll(p *cloudstack.ListF5LoadBalancersParams) (r0 *cloudstack.ListF5LoadBalancersResponse, r1 error) {
+	m.record("ListF5LoadBalancersAll", p)
+	if m.ListF5LoadBalancersAllFunc != nil {
+		return m.ListF5LoadBalancersAllFunc(p)
+	}
+	if m.ListF5LoadBalancersAllWithContextFunc != nil {
+		return m.ListF5LoadBalancersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListF5LoadBalancersAll")
+	return
+}
+
+// ListF5LoadBalancersAllWithContext records the call and calls ListF5LoadBalancersAllWithContextFunc, or ListF5LoadBalancersAllFunc if only that is set
+func (m *MockLoadBalancerService) ListF5LoadBalancersAllWithContext(ctx context.Context, p *cloudstack.ListF5LoadBalancersParams) (r0 *cloudstack.ListF5LoadBalancersResponse, r1 error) {
+	m.record("ListF5LoadBalancersAllWithContext", ctx, p)
+	if m.ListF5LoadBalancersAllWithContextFunc != nil {
+		return m.ListF5LoadBalancersAllWithContextFunc(ctx, p)
+	}
+	if m.ListF5LoadBalancersAllFunc != nil {
+		return m.ListF5LoadBalancersAllFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListF5LoadBalancersAllWithContext")
+	return
+}
+
+// NewListGlobalLoadBalancerRulesParams records the call and calls NewListGlobalLoadBalancerRulesParamsFunc
+func (m *MockLoadBalancerService) NewListGlobalLoadBalancerRulesParams() (r0 *cloudstack.ListGlobalLoadBalancerRulesParams) {
+	m.record("NewListGlobalLoadBalancerRulesParams")
+	if m.NewListGlobalLoadBalancerRulesParamsFunc != nil {
+		return m.NewListGlobalLoadBalancerRulesParamsFunc()
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewListGlobalLoadBalancerRulesParams()
+}
+
+// GetGlobalLoadBalancerRuleID records the call and calls GetGlobalLoadBalancerRuleIDFunc, or GetGlobalLoadBalancerRuleIDWithContextFunc if only that is set
+func (m *MockLoadBalancerService) GetGlobalLoadBalancerRuleID(keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetGlobalLoadBalancerRuleID", keyword, opts)
+	if m.GetGlobalLoadBalancerRuleIDFunc != nil {
+		return m.GetGlobalLoadBalancerRuleIDFunc(keyword, opts...)
+	}
+	if m.GetGlobalLoadBalancerRuleIDWithContextFunc != nil {
+		return m.GetGlobalLoadBalancerRuleIDWithContextFunc(context.Background(), keyword, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetGlobalLoadBalancerRuleID")
+	return
+}
+
+// GetGlobalLoadBalancerRuleIDWithContext records the call and calls GetGlobalLoadBalancerRuleIDWithContextFunc, or GetGlobalLoadBalancerRuleIDFunc if only that is set
+func (m *MockLoadBalancerService) GetGlobalLoadBalancerRuleIDWithContext(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetGlobalLoadBalancerRuleIDWithContext", ctx, keyword, opts)
+	if m.GetGlobalLoadBalancerRuleIDWithContextFunc != nil {
+		return m.GetGlobalLoadBalancerRuleIDWithContextFunc(ctx, keyword, opts...)
+	}
+	if m.GetGlobalLoadBalancerRuleIDFunc != nil {
+		return m.GetGlobalLoadBalancerRuleIDFunc(keyword, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetGlobalLoadBalancerRuleIDWithContext")
+	return
+}
+
+// GetGlobalLoadBalancerRuleByName records the call and calls GetGlobalLoadBalancerRuleByNameFunc, or GetGlobalLoadBalancerRuleByNameWithContextFunc if only that is set
+func (m *MockLoadBalancerService) GetGlobalLoadBalancerRuleByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.GlobalLoadBalancerRule, r1 int, r2 error) {
+	m.record("GetGlobalLoadBalancerRuleByName", name, opts)
+	if m.GetGlobalLoadBalancerRuleByNameFunc != nil {
+		return m.GetGlobalLoadBalancerRuleByNameFunc(name, opts...)
+	}
+	if m.GetGlobalLoadBalancerRuleByNameWithContextFunc != nil {
+		return m.GetGlobalLoadBalancerRuleByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetGlobalLoadBalancerRuleByName")
+	return
+}
+
+// GetGlobalLoadBalancerRuleByNameWithContext records the call and calls GetGlobalLoadBalancerRuleByNameWithContextFunc, or GetGlobalLoadBalancerRuleByNameFunc if only that is set
+func (m *MockLoadBalancerService) GetGlobalLoadBalancerRuleByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.GlobalLoadBalancerRule, r1 int, r2 error) {
+	m.record("GetGlobalLoadBalancerRuleByNameWithContext", ctx, name, opts)
+	if m.GetGlobalLoadBalancerRuleByNameWithContextFunc != nil {
+		return m.GetGlobalLoadBalancerRuleByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetGlobalLoadBalancerRuleByNameFunc != nil {
+		return m.GetGlobalLoadBalancerRuleByNameFunc(name, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetGlobalLoadBalancerRuleByNameWithContext")
+	return
+}
+
+// GetGlobalLoadBalancerRuleByID records the call and calls GetGlobalLoadBalancerRuleByIDFunc, or GetGlobalLoadBalancerRuleByIDWithContextFunc if only that is set
+func (m *MockLoadBalancerService) GetGlobalLoadBalancerRuleByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.GlobalLoadBalancerRule, r1 int, r2 error) {
+	m.record("GetGlobalLoadBalancerRuleByID", id, opts)
+	if m.GetGlobalLoadBalancerRuleByIDFunc != nil {
+		return m.GetGlobalLoadBalancerRuleByIDFunc(id, opts...)
+	}
+	if m.GetGlobalLoadBalancerRuleByIDWithContextFunc != nil {
+		return m.GetGlobalLoadBalancerRuleByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetGlobalLoadBalancerRuleByID")
+	return
+}
+
+// GetGlobalLoadBalancerRuleByIDWithContext records the call and calls GetGlobalLoadBalancerRuleByIDWithContextFunc, or GetGlobalLoadBalancerRuleByIDFunc if only that is set
+func (m *MockLoadBalancerService) GetGlobalLoadBalancerRuleByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.GlobalLoadBalancerRule, r1 int, r2 error) {
+	m.record("GetGlobalLoadBalancerRuleByIDWithContext", ctx, id, opts)
+	if m.GetGlobalLoadBalancerRuleByIDWithContextFunc != nil {
+		return m.GetGlobalLoadBalancerRuleByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetGlobalLoadBalancerRuleByIDFunc != nil {
+		return m.GetGlobalLoadBalancerRuleByIDFunc(id, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetGlobalLoadBalancerRuleByIDWithContext")
+	return
+}
+
+// ListGlobalLoadBalancerRules records the call and calls ListGlobalLoadBalancerRulesFunc, or ListGlobalLoadBalancerRulesWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListGlobalLoadBalancerRules(p *cloudstack.ListGlobalLoadBalancerRulesParams) (r0 *cloudstack.ListGlobalLoadBalancerRulesResponse, r1 error) {
+	m.record("ListGlobalLoadBalancerRules", p)
+	if m.ListGlobalLoadBalancerRulesFunc != nil {
+		return m.ListGlobalLoadBalancerRulesFunc(p)
+	}
+	if m.ListGlobalLoadBalancerRulesWithContextFunc != nil {
+		return m.ListGlobalLoadBalancerRulesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListGlobalLoadBalancerRules")
+	return
+}
+
+// ListGlobalLoadBalancerRulesWithContext records the call and calls ListGlobalLoadBalancerRulesWithContextFunc, or ListGlobalLoadBalancerRulesFunc if only that is set
+func (m *MockLoadBalancerService) ListGlobalLoadBalancerRulesWithContext(ctx context.Context, p *cloudstack.ListGlobalLoadBalancerRulesParams) (r0 *cloudstack.ListGlobalLoadBalancerRulesResponse, r1 error) {
+	m.record("ListGlobalLoadBalancerRulesWithContext", ctx, p)
+	if m.ListGlobalLoadBalancerRulesWithContextFunc != nil {
+		return m.ListGlobalLoadBalancerRulesWithContextFunc(ctx, p)
+	}
+	if m.ListGlobalLoadBalancerRulesFunc != nil {
+		return m.ListGlobalLoadBalancerRulesFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListGlobalLoadBalancerRulesWithContext")
+	return
+}
+
+// NewListGlobalLoadBalancerRulesPager records the call and calls NewListGlobalLoadBalancerRulesPagerFunc
+func (m *MockLoadBalancerService) NewListGlobalLoadBalancerRulesPager(p *cloudstack.ListGlobalLoadBalancerRulesParams) (r0 *cloudstack.ListGlobalLoadBalancerRulesPager) {
+	m.record("NewListGlobalLoadBalancerRulesPager", p)
+	if m.NewListGlobalLoadBalancerRulesPagerFunc != nil {
+		return m.NewListGlobalLoadBalancerRulesPagerFunc(p)
+	}
+	return
+}
+
+// ListGlobalLoadBalancerRulesAll records the call and calls ListGlobalLoadBalancerRulesAllFunc, or ListGlobalLoadBalancerRulesAllWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListGlobalLoadBalancerRulesAll(p *cloudstack.ListGlobalLoadBalancerRulesParams) (r0 *cloudstack.ListGlobalLoadBalancerRulesResponse, r1 error) {
+	m.record("ListGlobalLoadBalancerRulesAll", p)
+	if m.ListGlobalLoadBalancerRulesAllFunc != nil {
+		return m.ListGlobalLoadBalancerRulesAllFunc(p)
+	}
+	if m.ListGlobalLoadBalancerRulesAllWithContextFunc != nil {
+		return m.ListGlobalLoadBalancerRulesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListGlobalLoadBalancerRulesAll")
+	return
+}
+
+// ListGlobalLoadBalancerRulesAllWithContext records the call and calls ListGlobalLoadBalancerRulesAllWithContextFunc, or ListGlobalLoadBalancerRulesAllFunc if only that is set
+func (m *MockLoadBalancerService) ListGlobalLoadBalancerRulesAllWithContext(ctx context.Context, p *cloudstack.ListGlobalLoadBalancerRulesParams) (r0 *cloudstack.ListGlobalLoadBalancerRulesResponse, r1 error) {
+	m.record("ListGlobalLoadBalancerRulesAllWithContext", ctx, p)
+	if m.ListGlobalLoadBalancerRulesAllWithContextFunc != nil {
+		return m.ListGlobalLoadBalancerRulesAllWithContextFunc(ctx, p)
+	}
+	if m.ListGlobalLoadBalancerRulesAllFunc != nil {
+		return m.ListGlobalLoadBalancerRulesAllFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListGlobalLoadBalancerRulesAllWithContext")
+	return
+}
+
+// NewListLBHealthCheckPoliciesParams records the call and calls NewListLBHealthCheckPoliciesParamsFunc
+func (m *MockLoadBalancerService) NewListLBHealthCheckPoliciesParams() (r0 *cloudstack.ListLBHealthCheckPoliciesParams) {
+	m.record("NewListLBHealthCheckPoliciesParams")
+	if m.NewListLBHealthCheckPoliciesParamsFunc != nil {
+		return m.NewListLBHealthCheckPoliciesParamsFunc()
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewListLBHealthCheckPoliciesParams()
+}
+
+// GetLBHealthCheckPolicyByID records the call and calls GetLBHealthCheckPolicyByIDFunc, or GetLBHealthCheckPolicyByIDWithContextFunc if only that is set
+func (m *MockLoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.LBHealthCheckPolicy, r1 int, r2 error) {
+	m.record("GetLBHealthCheckPolicyByID", id, opts)
+	if m.GetLBHealthCheckPolicyByIDFunc != nil {
+		return m.GetLBHealthCheckPolicyByIDFunc(id, opts...)
+	}
+	if m.GetLBHealthCheckPolicyByIDWithContextFunc != nil {
+		return m.GetLBHealthCheckPolicyByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLBHealthCheckPolicyByID")
+	return
+}
+
+// GetLBHealthCheckPolicyByIDWithContext records the call and calls GetLBHealthCheckPolicyByIDWithContextFunc, or GetLBHealthCheckPolicyByIDFunc if only that is set
+func (m *MockLoadBalancerService) GetLBHealthCheckPolicyByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.LBHealthCheckPolicy, r1 int, r2 error) {
+	m.record("GetLBHealthCheckPolicyByIDWithContext", ctx, id, opts)
+	if m.GetLBHealthCheckPolicyByIDWithContextFunc != nil {
+		return m.GetLBHealthCheckPolicyByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetLBHealthCheckPolicyByIDFunc != nil {
+		return m.GetLBHealthCheckPolicyByIDFunc(id, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLBHealthCheckPolicyByIDWithContext")
+	return
+}
+
+// ListLBHealthCheckPolicies records the call and calls ListLBHealthCheckPoliciesFunc, or ListLBHealthCheckPoliciesWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListLBHealthCheckPolicies(p *cloudstack.ListLBHealthCheckPoliciesParams) (r0 *cloudstack.ListLBHealthCheckPoliciesResponse, r1 error) {
+	m.record("ListLBHealthCheckPolicies", p)
+	if m.ListLBHealthCheckPoliciesFunc != nil {
+		return m.ListLBHealthCheckPoliciesFunc(p)
+	}
+	if m.ListLBHealthCheckPoliciesWithContextFunc != nil {
+		return m.ListLBHealthCheckPoliciesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLBHealthCheckPolicies")
+	return
+}
+
+// ListLBHealthCheckPoliciesWithContext records the call and calls ListLBHealthCheckPoliciesWithContextFunc, or ListLBHealthCheckPoliciesFunc if only that is set
+func (m *MockLoadBalancerService) ListLBHealthCheckPoliciesWithContext(ctx context.Context, p *cloudstack.ListLBHealthCheckPoliciesParams) (r0 *cloudstack.ListLBHealthCheckPoliciesResponse, r1 error) {
+	m.record("ListLBHealthCheckPoliciesWithContext", ctx, p)
+	if m.ListLBHealthCheckPoliciesWithContextFunc != nil {
+		return m.ListLBHealthCheckPoliciesWithContextFunc(ctx, p)
+	}
+	if m.ListLBHealthCheckPoliciesFunc != nil {
+		return m.ListLBHealthCheckPoliciesFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLBHealthCheckPoliciesWithContext")
+	return
+}
+
+// NewListLBHealthCheckPoliciesPager records the call and calls NewListLBHealthCheckPoliciesPagerFunc
+func (m *MockLoadBalancerService) NewListLBHealthCheckPoliciesPager(p *cloudstack.ListLBHealthCheckPoliciesParams) (r0 *cloudstack.ListLBHealthCheckPoliciesPager) {
+	m.record("NewListLBHealthCheckPoliciesPager", p)
+	if m.NewListLBHealthCheckPoliciesPagerFunc != nil {
+		return m.NewListLBHealthCheckPoliciesPagerFunc(p)
+	}
+	return
+}
+
+// ListLBHealthCheckPoliciesAll records the call and calls ListLBHealthCheckPoliciesAllFunc, or ListLBHealthCheckPoliciesAllWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListLBHealthCheckPoliciesAll(p *cloudstack.ListLBHealthCheckPoliciesParams) (r0 *cloudstack.ListLBHealthCheckPoliciesResponse, r1 error) {
+	m.record("ListLBHealthCheckPoliciesAll", p)
+	if m.ListLBHealthCheckPoliciesAllFunc != nil {
+		return m.ListLBHealthCheckPoliciesAllFunc(p)
+	}
+	if m.ListLBHealthCheckPoliciesAllWithContextFunc != nil {
+		return m.ListLBHealthCheckPoliciesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLBHealthCheckPoliciesAll")
+	return
+}
+
+// ListLBHealthCheckPoliciesAllWithContext records the call and calls ListLBHealthCheckPoliciesAllWithContextFunc, or ListLBHealthCheckPoliciesAllFunc if only that is set
+func (m *MockLoadBalancerService) ListLBHealthCheckPoliciesAllWithContext(ctx context.Context, p *cloudstack.ListLBHealthCheckPoliciesParams) (r0 *cloudstack.ListLBHealthCheckPoliciesResponse, r1 error) {
+	m.record("ListLBHealthCheckPoliciesAllWithContext", ctx, p)
+	if m.ListLBHealthCheckPoliciesAllWithContextFunc != nil {
+		return m.ListLBHealthCheckPoliciesAllWithContextFunc(ctx, p)
+	}
+	if m.ListLBHealthCheckPoliciesAllFunc != nil {
+		return m.ListLBHealthCheckPoliciesAllFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLBHealthCheckPoliciesAllWithContext")
+	return
+}
+
+// NewListLBStickinessPoliciesParams records the call and calls NewListLBStickinessPoliciesParamsFunc
+func (m *MockLoadBalancerService) NewListLBStickinessPoliciesParams() (r0 *cloudstack.ListLBStickinessPoliciesParams) {
+	m.record("NewListLBStickinessPoliciesParams")
+	if m.NewListLBStickinessPoliciesParamsFunc != nil {
+		return m.NewListLBStickinessPoliciesParamsFunc()
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewListLBStickinessPoliciesParams()
+}
+
+// GetLBStickinessPolicyByID records the call and calls GetLBStickinessPolicyByIDFunc, or GetLBStickinessPolicyByIDWithContextFunc if only that is set
+func (m *MockLoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.LBStickinessPolicy, r1 int, r2 error) {
+	m.record("GetLBStickinessPolicyByID", id, opts)
+	if m.GetLBStickinessPolicyByIDFunc != nil {
+		return m.GetLBStickinessPolicyByIDFunc(id, opts...)
+	}
+	if m.GetLBStickinessPolicyByIDWithContextFunc != nil {
+		return m.GetLBStickinessPolicyByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLBStickinessPolicyByID")
+	return
+}
+
+// GetLBStickinessPolicyByIDWithContext records the call and calls GetLBStickinessPolicyByIDWithContextFunc, or GetLBStickinessPolicyByIDFunc if only that is set
+func (m *MockLoadBalancerService) GetLBStickinessPolicyByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.LBStickinessPolicy, r1 int, r2 error) {
+	m.record("GetLBStickinessPolicyByIDWithContext", ctx, id, opts)
+	if m.GetLBStickinessPolicyByIDWithContextFunc != nil {
+		return m.GetLBStickinessPolicyByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetLBStickinessPolicyByIDFunc != nil {
+		return m.GetLBStickinessPolicyByIDFunc(id, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLBStickinessPolicyByIDWithContext")
+	return
+}
+
+// ListLBStickinessPolicies records the call and calls ListLBStickinessPoliciesFunc, or ListLBStickinessPoliciesWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListLBStickinessPolicies(p *cloudstack.ListLBStickinessPoliciesParams) (r0 *cloudstack.ListLBStickinessPoliciesResponse, r1 error) {
+	m.record("ListLBStickinessPolicies", p)
+	if m.ListLBStickinessPoliciesFunc != nil {
+		return m.ListLBStickinessPoliciesFunc(p)
+	}
+	if m.ListLBStickinessPoliciesWithContextFunc != nil {
+		return m.ListLBStickinessPoliciesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLBStickinessPolicies")
+	return
+}
+
+// ListLBStickinessPoliciesWithContext records the call and calls ListLBStickinessPoliciesWithContextFunc, or ListLBStickinessPoliciesFunc if only that is set
+func (m *MockLoadBalancerService) ListLBStickinessPoliciesWithContext(ctx context.Context, p *cloudstack.ListLBStickinessPoliciesParams) (r0 *cloudstack.ListLBStickinessPoliciesResponse, r1 error) {
+	m.record("ListLBStickinessPoliciesWithContext", ctx, p)
+	if m.ListLBStickinessPoliciesWithContextFunc != nil {
+		return m.ListLBStickinessPoliciesWithContextFunc(ctx, p)
+	}
+	if m.ListLBStickinessPoliciesFunc != nil {
+		return m.ListLBStickinessPoliciesFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLBStickinessPoliciesWithContext")
+	return
+}
+
+// NewListLBStickinessPoliciesPager records the call and calls NewListLBStickinessPoliciesPagerFunc
+func (m *MockLoadBalancerService) NewListLBStickinessPoliciesPager(p *cloudstack.ListLBStickinessPoliciesParams) (r0 *cloudstack.ListLBStickinessPoliciesPager) {
+	m.record("NewListLBStickinessPoliciesPager", p)
+	if m.NewListLBStickinessPoliciesPagerFunc != nil {
+		return m.NewListLBStickinessPoliciesPagerFunc(p)
+	}
+	return
+}
+
+// ListLBStickinessPoliciesAll records the call and calls ListLBStickinessPoliciesAllFunc, or ListLBStickinessPoliciesAllWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListLBStickinessPoliciesAll(p *cloudstack.ListLBStickinessPoliciesParams) (r0 *cloudstack.ListLBStickinessPoliciesResponse, r1 error) {
+	m.record("ListLBStickinessPoliciesAll", p)
+	if m.ListLBStickinessPoliciesAllFunc != nil {
+		return m.ListLBStickinessPoliciesAllFunc(p)
+	}
+	if m.ListLBStickinessPoliciesAllWithContextFunc != nil {
+		return m.ListLBStickinessPoliciesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLBStickinessPoliciesAll")
+	return
+}
+
+// ListLBStickinessPoliciesAllWithContext records the call and calls ListLBStickinessPoliciesAllWithContextFunc, or ListLBStickinessPoliciesAllFunc if only that is set
+func (m *MockLoadBalancerService) ListLBStickinessPoliciesAllWithContext(ctx context.Context, p *cloudstack.ListLBStickinessPoliciesParams) (r0 *cloudstack.ListLBStickinessPoliciesResponse, r1 error) {
+	m.record("ListLBStickinessPoliciesAllWithContext", ctx, p)
+	if m.ListLBStickinessPoliciesAllWithContextFunc != nil {
+		return m.ListLBStickinessPoliciesAllWithContextFunc(ctx, p)
+	}
+	if m.ListLBStickinessPoliciesAllFunc != nil {
+		return m.ListLBStickinessPoliciesAllFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLBStickinessPoliciesAllWithContext")
+	return
+}
+
+// NewListLoadBalancerRuleInstancesParams records the call and calls NewListLoadBalancerRuleInstancesParamsFunc
+func (m *MockLoadBalancerService) NewListLoadBalancerRuleInstancesParams(id string) (r0 *cloudstack.ListLoadBalancerRuleInstancesParams) {
+	m.record("NewListLoadBalancerRuleInstancesParams", id)
+	if m.NewListLoadBalancerRuleInstancesParamsFunc != nil {
+		return m.NewListLoadBalancerRuleInstancesParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewListLoadBalancerRuleInstancesParams(id)
+}
+
+// GetLoadBalancerRuleInstanceByID records the call and calls GetLoadBalancerRuleInstanceByIDFunc, or GetLoadBalancerRuleInstanceByIDWithContextFunc if only that is set
+func (m *MockLoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VirtualMachine, r1 int, r2 error) {
+	m.record("GetLoadBalancerRuleInstanceByID", id, opts)
+	if m.GetLoadBalancerRuleInstanceByIDFunc != nil {
+		return m.GetLoadBalancerRuleInstanceByIDFunc(id, opts...)
+	}
+	if m.GetLoadBalancerRuleInstanceByIDWithContextFunc != nil {
+		return m.GetLoadBalancerRuleInstanceByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLoadBalancerRuleInstanceByID")
+	return
+}
+
+// GetLoadBalancerRuleInstanceByIDWithContext records the call and calls GetLoadBalancerRuleInstanceByIDWithContextFunc, or GetLoadBalancerRuleInstanceByIDFunc if only that is set
+func (m *MockLoadBalancerService) GetLoadBalancerRuleInstanceByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VirtualMachine, r1 int, r2 error) {
+	m.record("GetLoadBalancerRuleInstanceByIDWithContext", ctx, id, opts)
+	if m.GetLoadBalancerRuleInstanceByIDWithContextFunc != nil {
+		return m.GetLoadBalancerRuleInstanceByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetLoadBalancerRuleInstanceByIDFunc != nil {
+		return m.GetLoadBalancerRuleInstanceByIDFunc(id, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLoadBalancerRuleInstanceByIDWithContext")
+	return
+}
+
+// ListLoadBalancerRuleInstances records the call and calls ListLoadBalancerRuleInstancesFunc, or ListLoadBalancerRuleInstancesWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListLoadBalancerRuleInstances(p *cloudstack.ListLoadBalancerRuleInstancesParams) (r0 *cloudstack.ListLoadBalancerRuleInstancesResponse, r1 error) {
+	m.record("ListLoadBalancerRuleInstances", p)
+	if m.ListLoadBalancerRuleInstancesFunc != nil {
+		return m.ListLoadBalancerRuleInstancesFunc(p)
+	}
+	if m.ListLoadBalancerRuleInstancesWithContextFunc != nil {
+		return m.ListLoadBalancerRuleInstancesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLoadBalancerRuleInstances")
+	return
+}
+
+// ListLoadBalancerRuleInstancesWithContext records the call and calls ListLoadBalancerRuleInstancesWithContextFunc, or ListLoadBalancerRuleInstancesFunc if only that is set
+func (m *MockLoadBalancerService) ListLoadBalancerRuleInstancesWithContext(ctx context.Context, p *cloudstack.ListLoadBalancerRuleInstancesParams) (r0 *cloudstack.ListLoadBalancerRuleInstancesResponse, r1 error) {
+	m.record("ListLoadBalancerRuleInstancesWithContext", ctx, p)
+	if m.ListLoadBalancerRuleInstancesWithContextFunc != nil {
+		return m.ListLoadBalancerRuleInstancesWithContextFunc(ctx, p)
+	}
+	if m.ListLoadBalancerRuleInstancesFunc != nil {
+		return m.ListLoadBalancerRuleInstancesFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLoadBalancerRuleInstancesWithContext")
+	return
+}
+
+// NewListLoadBalancerRulesParams records the call and calls NewListLoadBalancerRulesParamsFunc
+func (m *MockLoadBalancerService) NewListLoadBalancerRulesParams() (r0 *cloudstack.ListLoadBalancerRulesParams) {
+	m.record("NewListLoadBalancerRulesParams")
+	if m.NewListLoadBalancerRulesParamsFunc != nil {
+		return m.NewListLoadBalancerRulesParamsFunc()
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewListLoadBalancerRulesParams()
+}
+
+// GetLoadBalancerRuleID records the call and calls GetLoadBalancerRuleIDFunc, or GetLoadBalancerRuleIDWithContextFunc if only that is set
+func (m *MockLoadBalancerService) GetLoadBalancerRuleID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetLoadBalancerRuleID", name, opts)
+	if m.GetLoadBalancerRuleIDFunc != nil {
+		return m.GetLoadBalancerRuleIDFunc(name, opts...)
+	}
+	if m.GetLoadBalancerRuleIDWithContextFunc != nil {
+		return m.GetLoadBalancerRuleIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLoadBalancerRuleID")
+	return
+}
+
+// GetLoadBalancerRuleIDWithContext records the call and calls GetLoadBalancerRuleIDWithContextFunc, or GetLoadBalancerRuleIDFunc if only that is set
+func (m *MockLoadBalancerService) GetLoadBalancerRuleIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetLoadBalancerRuleIDWithContext", ctx, name, opts)
+	if m.GetLoadBalancerRuleIDWithContextFunc != nil {
+		return m.GetLoadBalancerRuleIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetLoadBalancerRuleIDFunc != nil {
+		return m.GetLoadBalancerRuleIDFunc(name, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLoadBalancerRuleIDWithContext")
+	return
+}
+
+// GetLoadBalancerRuleByName records the call and calls GetLoadBalancerRuleByNameFunc, or GetLoadBalancerRuleByNameWithContextFunc if only that is set
+func (m *MockLoadBalancerService) GetLoadBalancerRuleByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.LoadBalancerRule, r1 int, r2 error) {
+	m.record("GetLoadBalancerRuleByName", name, opts)
+	if m.GetLoadBalancerRuleByNameFunc != nil {
+		return m.GetLoadBalancerRuleByNameFunc(name, opts...)
+	}
+	if m.GetLoadBalancerRuleByNameWithContextFunc != nil {
+		return m.GetLoadBalancerRuleByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLoadBalancerRuleByName")
+	return
+}
+
+// GetLoadBalancerRuleByNameWithContext records the call and calls GetLoadBalancerRuleByNameWithContextFunc, or GetLoadBalancerRuleByNameFunc if only that is set
+func (m *MockLoadBalancerService) GetLoadBalancerRuleByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.LoadBalancerRule, r1 int, r2 error) {
+	m.record("GetLoadBalancerRuleByNameWithContext", ctx, name, opts)
+	if m.GetLoadBalancerRuleByNameWithContextFunc != nil {
+		return m.GetLoadBalancerRuleByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetLoadBalancerRuleByNameFunc != nil {
+		return m.GetLoadBalancerRuleByNameFunc(name, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLoadBalancerRuleByNameWithContext")
+	return
+}
+
+// GetLoadBalancerRuleByID records the call and calls GetLoadBalancerRuleByIDFunc, or GetLoadBalancerRuleByIDWithContextFunc if only that is set
+func (m *MockLoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.LoadBalancerRule, r1 int, r2 error) {
+	m.record("GetLoadBalancerRuleByID", id, opts)
+	if m.GetLoadBalancerRuleByIDFunc != nil {
+		return m.GetLoadBalancerRuleByIDFunc(id, opts...)
+	}
+	if m.GetLoadBalancerRuleByIDWithContextFunc != nil {
+		return m.GetLoadBalancerRuleByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLoadBalancerRuleByID")
+	return
+}
+
+// GetLoadBalancerRuleByIDWithContext records the call and calls GetLoadBalancerRuleByIDWithContextFunc, or GetLoadBalancerRuleByIDFunc if only that is set
+func (m *MockLoadBalancerService) GetLoadBalancerRuleByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.LoadBalancerRule, r1 int, r2 error) {
+	m.record("GetLoadBalancerRuleByIDWithContext", ctx, id, opts)
+	if m.GetLoadBalancerRuleByIDWithContextFunc != nil {
+		return m.GetLoadBalancerRuleByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetLoadBalancerRuleByIDFunc != nil {
+		return m.GetLoadBalancerRuleByIDFunc(id, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLoadBalancerRuleByIDWithContext")
+	return
+}
+
+// ListLoadBalancerRules records the call and calls ListLoadBalancerRulesFunc, or ListLoadBalancerRulesWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListLoadBalancerRules(p *cloudstack.ListLoadBalancerRulesParams) (r0 *cloudstack.ListLoadBalancerRulesResponse, r1 error) {
+	m.record("ListLoadBalancerRules", p)
+	if m.ListLoadBalancerRulesFunc != nil {
+		return m.ListLoadBalancerRulesFunc(p)
+	}
+	if m.ListLoadBalancerRulesWithContextFunc != nil {
+		return m.ListLoadBalancerRulesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLoadBalancerRules")
+	return
+}
+
+// ListLoadBalancerRulesWithContext records the call and calls ListLoadBalancerRulesWithContextFunc, or ListLoadBalancerRulesFunc if only that is set
+func (m *MockLoadBalancerService) ListLoadBalancerRulesWithContext(ctx context.Context, p *cloudstack.ListLoadBalancerRulesParams) (r0 *cloudstack.ListLoadBalancerRulesResponse, r1 error) {
+	m.record("ListLoadBalancerRulesWithContext", ctx, p)
+	if m.ListLoadBalancerRulesWithContextFunc != nil {
+		return m.ListLoadBalancerRulesWithContextFunc(ctx, p)
+	}
+	if m.ListLoadBalancerRulesFunc != nil {
+		return m.ListLoadBalancerRulesFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLoadBalancerRulesWithContext")
+	return
+}
+
+// NewListLoadBalancerRulesPager records the call and calls NewListLoadBalancerRulesPagerFunc
+func (m *MockLoadBalancerService) NewListLoadBalancerRulesPager(p *cloudstack.ListLoadBalancerRulesParams) (r0 *cloudstack.ListLoadBalancerRulesPager) {
+	m.record("NewListLoadBalancerRulesPager", p)
+	if m.NewListLoadBalancerRulesPagerFunc != nil {
+		return m.NewListLoadBalancerRulesPagerFunc(p)
+	}
+	return
+}
+
+// ListLoadBalancerRulesAll records the call and calls ListLoadBalancerRulesAllFunc, or ListLoadBalancerRulesAllWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListLoadBalancerRulesAll(p *cloudstack.ListLoadBalancerRulesParams) (r0 *cloudstack.ListLoadBalancerRulesResponse, r1 error) {
+	m.record("ListLoadBalancerRulesAll", p)
+	if m.ListLoadBalancerRulesAllFunc != nil {
+		return m.ListLoadBalancerRulesAllFunc(p)
+	}
+	if m.ListLoadBalancerRulesAllWithContextFunc != nil {
+		return m.ListLoadBalancerRulesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLoadBalancerRulesAll")
+	return
+}
+
+// ListLoadBalancerRulesAllWithContext records the call and calls ListLoadBalancerRulesAllWithContextFunc, or ListLoadBalancerRulesAllFunc if only that is set
+func (m *MockLoadBalancerService) ListLoadBalancerRulesAllWithContext(ctx context.Context, p *cloudstack.ListLoadBalancerRulesParams) (r0 *cloudstack.ListLoadBalancerRulesResponse, r1 error) {
+	m.record("ListLoadBalancerRulesAllWithContext", ctx, p)
+	if m.ListLoadBalancerRulesAllWithContextFunc != nil {
+		return m.ListLoadBalancerRulesAllWithContextFunc(ctx, p)
+	}
+	if m.ListLoadBalancerRulesAllFunc != nil {
+		return m.ListLoadBalancerRulesAllFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLoadBalancerRulesAllWithContext")
+	return
+}
+
+// NewListLoadBalancersParams records the call and calls NewListLoadBalancersParamsFunc
+func (m *MockLoadBalancerService) NewListLoadBalancersParams() (r0 *cloudstack.ListLoadBalancersParams) {
+	m.record("NewListLoadBalancersParams")
+	if m.NewListLoadBalancersParamsFunc != nil {
+		return m.NewListLoadBalancersParamsFunc()
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewListLoadBalancersParams()
+}
+
+// GetLoadBalancerID records the call and calls GetLoadBalancerIDFunc, or GetLoadBalancerIDWithContextFunc if only that is set
+func (m *MockLoadBalancerService) GetLoadBalancerID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetLoadBalancerID", name, opts)
+	if m.GetLoadBalancerIDFunc != nil {
+		return m.GetLoadBalancerIDFunc(name, opts...)
+	}
+	if m.GetLoadBalancerIDWithContextFunc != nil {
+		return m.GetLoadBalancerIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLoadBalancerID")
+	return
+}
+
+// GetLoadBalancerIDWithContext records the call and calls GetLoadBalancerIDWithContextFunc, or GetLoadBalancerIDFunc if only that is set
+func (m *MockLoadBalancerService) GetLoadBalancerIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetLoadBalancerIDWithContext", ctx, name, opts)
+	if m.GetLoadBalancerIDWithContextFunc != nil {
+		return m.GetLoadBalancerIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetLoadBalancerIDFunc != nil {
+		return m.GetLoadBalancerIDFunc(name, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLoadBalancerIDWithContext")
+	return
+}
+
+// GetLoadBalancerByName records the call and calls GetLoadBalancerByNameFunc, or GetLoadBalancerByNameWithContextFunc if only that is set
+func (m *MockLoadBalancerService) GetLoadBalancerByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.LoadBalancer, r1 int, r2 error) {
+	m.record("GetLoadBalancerByName", name, opts)
+	if m.GetLoadBalancerByNameFunc != nil {
+		return m.GetLoadBalancerByNameFunc(name, opts...)
+	}
+	if m.GetLoadBalancerByNameWithContextFunc != nil {
+		return m.GetLoadBalancerByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLoadBalancerByName")
+	return
+}
+
+// GetLoadBalancerByNameWithContext records the call and calls GetLoadBalancerByNameWithContextFunc, or GetLoadBalancerByNameFunc if only that is set
+func (m *MockLoadBalancerService) GetLoadBalancerByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.LoadBalancer, r1 int, r2 error) {
+	m.record("GetLoadBalancerByNameWithContext", ctx, name, opts)
+	if m.GetLoadBalancerByNameWithContextFunc != nil {
+		return m.GetLoadBalancerByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetLoadBalancerByNameFunc != nil {
+		return m.GetLoadBalancerByNameFunc(name, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLoadBalancerByNameWithContext")
+	return
+}
+
+// GetLoadBalancerByID records the call and calls GetLoadBalancerByIDFunc, or GetLoadBalancerByIDWithContextFunc if only that is set
+func (m *MockLoadBalancerService) GetLoadBalancerByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.LoadBalancer, r1 int, r2 error) {
+	m.record("GetLoadBalancerByID", id, opts)
+	if m.GetLoadBalancerByIDFunc != nil {
+		return m.GetLoadBalancerByIDFunc(id, opts...)
+	}
+	if m.GetLoadBalancerByIDWithContextFunc != nil {
+		return m.GetLoadBalancerByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLoadBalancerByID")
+	return
+}
+
+// GetLoadBalancerByIDWithContext records the call and calls GetLoadBalancerByIDWithContextFunc, or GetLoadBalancerByIDFunc if only that is set
+func (m *MockLoadBalancerService) GetLoadBalancerByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.LoadBalancer, r1 int, r2 error) {
+	m.record("GetLoadBalancerByIDWithContext", ctx, id, opts)
+	if m.GetLoadBalancerByIDWithContextFunc != nil {
+		return m.GetLoadBalancerByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetLoadBalancerByIDFunc != nil {
+		return m.GetLoadBalancerByIDFunc(id, opts...)
+	}
+	r2 = notMocked("LoadBalancerService.GetLoadBalancerByIDWithContext")
+	return
+}
+
+// ListLoadBalancers records the call and calls ListLoadBalancersFunc, or ListLoadBalancersWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListLoadBalancers(p *cloudstack.ListLoadBalancersParams) (r0 *cloudstack.ListLoadBalancersResponse, r1 error) {
+	m.record("ListLoadBalancers", p)
+	if m.ListLoadBalancersFunc != nil {
+		return m.ListLoadBalancersFunc(p)
+	}
+	if m.ListLoadBalancersWithContextFunc != nil {
+		return m.ListLoadBalancersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLoadBalancers")
+	return
+}
+
+// ListLoadBalancersWithContext records the call and calls ListLoadBalancersWithContextFunc, or ListLoadBalancersFunc if only that is set
+func (m *MockLoadBalancerService) ListLoadBalancersWithContext(ctx context.Context, p *cloudstack.ListLoadBalancersParams) (r0 *cloudstack.ListLoadBalancersResponse, r1 error) {
+	m.record("ListLoadBalancersWithContext", ctx, p)
+	if m.ListLoadBalancersWithContextFunc != nil {
+		return m.ListLoadBalancersWithContextFunc(ctx, p)
+	}
+	if m.ListLoadBalancersFunc != nil {
+		return m.ListLoadBalancersFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLoadBalancersWithContext")
+	return
+}
+
+// NewListLoadBalancersPager records the call and calls NewListLoadBalancersPagerFunc
+func (m *MockLoadBalancerService) NewListLoadBalancersPager(p *cloudstack.ListLoadBalancersParams) (r0 *cloudstack.ListLoadBalancersPager) {
+	m.record("NewListLoadBalancersPager", p)
+	if m.NewListLoadBalancersPagerFunc != nil {
+		return m.NewListLoadBalancersPagerFunc(p)
+	}
+	return
+}
+
+// ListLoadBalancersAll records the call and calls ListLoadBalancersAllFunc, or ListLoadBalancersAllWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListLoadBalancersAll(p *cloudstack.ListLoadBalancersParams) (r0 *cloudstack.ListLoadBalancersResponse, r1 error) {
+	m.record("ListLoadBalancersAll", p)
+	if m.ListLoadBalancersAllFunc != nil {
+		return m.ListLoadBalancersAllFunc(p)
+	}
+	if m.ListLoadBalancersAllWithContextFunc != nil {
+		return m.ListLoadBalancersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLoadBalancersAll")
+	return
+}
+
+// ListLoadBalancersAllWithContext records the call and calls ListLoadBalancersAllWithContextFunc, or ListLoadBalancersAllFunc if only that is set
+func (m *MockLoadBalancerService) ListLoadBalancersAllWithContext(ctx context.Context, p *cloudstack.ListLoadBalancersParams) (r0 *cloudstack.ListLoadBalancersResponse, r1 error) {
+	m.record("ListLoadBalancersAllWithContext", ctx, p)
+	if m.ListLoadBalancersAllWithContextFunc != nil {
+		return m.ListLoadBalancersAllWithContextFunc(ctx, p)
+	}
+	if m.ListLoadBalancersAllFunc != nil {
+		return m.ListLoadBalancersAllFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListLoadBalancersAllWithContext")
+	return
+}
+
+// NewListNetscalerLoadBalancersParams records the call and calls NewListNetscalerLoadBalancersParamsFunc
+func (m *MockLoadBalancerService) NewListNetscalerLoadBalancersParams() (r0 *cloudstack.ListNetscalerLoadBalancersParams) {
+	m.record("NewListNetscalerLoadBalancersParams")
+	if m.NewListNetscalerLoadBalancersParamsFunc != nil {
+		return m.NewListNetscalerLoadBalancersParamsFunc()
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewListNetscalerLoadBalancersParams()
+}
+
+// ListNetscalerLoadBalancers records the call and calls ListNetscalerLoadBalancersFunc, or ListNetscalerLoadBalancersWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListNetscalerLoadBalancers(p *cloudstack.ListNetscalerLoadBalancersParams) (r0 *cloudstack.ListNetscalerLoadBalancersResponse, r1 error) {
+	m.record("ListNetscalerLoadBalancers", p)
+	if m.ListNetscalerLoadBalancersFunc != nil {
+		return m.ListNetscalerLoadBalancersFunc(p)
+	}
+	if m.ListNetscalerLoadBalancersWithContextFunc != nil {
+		return m.ListNetscalerLoadBalancersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListNetscalerLoadBalancers")
+	return
+}
+
+// ListNetscalerLoadBalancersWithContext records the call and calls ListNetscalerLoadBalancersWithContextFunc, or ListNetscalerLoadBalancersFunc if only that is set
+func (m *MockLoadBalancerService) ListNetscalerLoadBalancersWithContext(ctx context.Context, p *cloudstack.ListNetscalerLoadBalancersParams) (r0 *cloudstack.ListNetscalerLoadBalancersResponse, r1 error) {
+	m.record("ListNetscalerLoadBalancersWithContext", ctx, p)
+	if m.ListNetscalerLoadBalancersWithContextFunc != nil {
+		return m.ListNetscalerLoadBalancersWithContextFunc(ctx, p)
+	}
+	if m.ListNetscalerLoadBalancersFunc != nil {
+		return m.ListNetscalerLoadBalancersFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListNetscalerLoadBalancersWithContext")
+	return
+}
+
+// NewListNetscalerLoadBalancersPager records the call and calls NewListNetscalerLoadBalancersPagerFunc
+func (m *MockLoadBalancerService) NewListNetscalerLoadBalancersPager(p *cloudstack.ListNetscalerLoadBalancersParams) (r0 *cloudstack.ListNetscalerLoadBalancersPager) {
+	m.record("NewListNetscalerLoadBalancersPager", p)
+	if m.NewListNetscalerLoadBalancersPagerFunc != nil {
+		return m.NewListNetscalerLoadBalancersPagerFunc(p)
+	}
+	return
+}
+
+// ListNetscalerLoadBalancersAll records the call and calls ListNetscalerLoadBalancersAllFunc, or ListNetscalerLoadBalancersAllWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListNetscalerLoadBalancersAll(p *cloudstack.ListNetscalerLoadBalancersParams) (r0 *cloudstack.ListNetscalerLoadBalancersResponse, r1 error) {
+	m.record("ListNetscalerLoadBalancersAll", p)
+	if m.ListNetscalerLoadBalancersAllFunc != nil {
+		return m.ListNetscalerLoadBalancersAllFunc(p)
+	}
+	if m.ListNetscalerLoadBalancersAllWithContextFunc != nil {
+		return m.ListNetscalerLoadBalancersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListNetscalerLoadBalancersAll")
+	return
+}
+
+// ListNetscalerLoadBalancersAllWithContext records the call and calls ListNetscalerLoadBalancersAllWithContextFunc, or ListNetscalerLoadBalancersAllFunc if only that is set
+func (m *MockLoadBalancerService) ListNetscalerLoadBalancersAllWithContext(ctx context.Context, p *cloudstack.ListNetscalerLoadBalancersParams) (r0 *cloudstack.ListNetscalerLoadBalancersResponse, r1 error) {
+	m.record("ListNetscalerLoadBalancersAllWithContext", ctx, p)
+	if m.ListNetscalerLoadBalancersAllWithContextFunc != nil {
+		return m.ListNetscalerLoadBalancersAllWithContextFunc(ctx, p)
+	}
+	if m.ListNetscalerLoadBalancersAllFunc != nil {
+		return m.ListNetscalerLoadBalancersAllFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListNetscalerLoadBalancersAllWithContext")
+	return
+}
+
+// NewListSslCertsParams records the call and calls NewListSslCertsParamsFunc
+func (m *MockLoadBalancerService) NewListSslCertsParams() (r0 *cloudstack.ListSslCertsParams) {
+	m.record("NewListSslCertsParams")
+	if m.NewListSslCertsParamsFunc != nil {
+		return m.NewListSslCertsParamsFunc()
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewListSslCertsParams()
+}
+
+// ListSslCerts records the call and calls ListSslCertsFunc, or ListSslCertsWithContextFunc if only that is set
+func (m *MockLoadBalancerService) ListSslCerts(p *cloudstack.ListSslCertsParams) (r0 *cloudstack.ListSslCertsResponse, r1 error) {
+	m.record("ListSslCerts", p)
+	if m.ListSslCertsFunc != nil {
+		return m.ListSslCertsFunc(p)
+	}
+	if m.ListSslCertsWithContextFunc != nil {
+		return m.ListSslCertsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.ListSslCerts")
+	return
+}
+
+// ListSslCertsWithContext records the call and calls ListSslCertsWithContextFunc, or ListSslCertsFunc if only that is set
+func (m *MockLoadBalancerService) ListSslCertsWithContext(ctx context.Context, p *cloudstack.ListSslCertsParams) (r0 *cloudstack.ListSslCertsResponse, r1 error) {
+	m.record("ListSslCertsWithContext", ctx, p)
+	if m.ListSslCertsWithContextFunc != nil {
+		return m.ListSslCertsWithContextFunc(ctx, p)
+	}
+	if m.ListSslCertsFunc != nil {
+		return m.ListSslCertsFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.ListSslCertsWithContext")
+	return
+}
+
+// NewRemoveCertFromLoadBalancerParams records the call and calls NewRemoveCertFromLoadBalancerParamsFunc
+func (m *MockLoadBalancerService) NewRemoveCertFromLoadBalancerParams(lbruleid string) (r0 *cloudstack.RemoveCertFromLoadBalancerParams) {
+	m.record("NewRemoveCertFromLoadBalancerParams", lbruleid)
+	if m.NewRemoveCertFromLoadBalancerParamsFunc != nil {
+		return m.NewRemoveCertFromLoadBalancerParamsFunc(lbruleid)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewRemoveCertFromLoadBalancerParams(lbruleid)
+}
+
+// RemoveCertFromLoadBalancer records the call and calls RemoveCertFromLoadBalancerFunc, or RemoveCertFromLoadBalancerWithContextFunc if only that is set
+func (m *MockLoadBalancerService) RemoveCertFromLoadBalancer(p *cloudstack.RemoveCertFromLoadBalancerParams) (r0 *cloudstack.RemoveCertFromLoadBalancerResponse, r1 error) {
+	m.record("RemoveCertFromLoadBalancer", p)
+	if m.RemoveCertFromLoadBalancerFunc != nil {
+		return m.RemoveCertFromLoadBalancerFunc(p)
+	}
+	if m.RemoveCertFromLoadBalancerWithContextFunc != nil {
+		return m.RemoveCertFromLoadBalancerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.RemoveCertFromLoadBalancer")
+	return
+}
+
+// RemoveCertFromLoadBalancerWithContext records the call and calls RemoveCertFromLoadBalancerWithContextFunc, or RemoveCertFromLoadBalancerFunc if only that is set
+func (m *MockLoadBalancerService) RemoveCertFromLoadBalancerWithContext(ctx context.Context, p *cloudstack.RemoveCertFromLoadBalancerParams) (r0 *cloudstack.RemoveCertFromLoadBalancerResponse, r1 error) {
+	m.record("RemoveCertFromLoadBalancerWithContext", ctx, p)
+	if m.RemoveCertFromLoadBalancerWithContextFunc != nil {
+		return m.RemoveCertFromLoadBalancerWithContextFunc(ctx, p)
+	}
+	if m.RemoveCertFromLoadBalancerFunc != nil {
+		return m.RemoveCertFromLoadBalancerFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.RemoveCertFromLoadBalancerWithContext")
+	return
+}
+
+// RemoveCertFromLoadBalancerAsync records the call and calls RemoveCertFromLoadBalancerAsyncFunc, or RemoveCertFromLoadBalancerAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) RemoveCertFromLoadBalancerAsync(p *cloudstack.RemoveCertFromLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveCertFromLoadBalancerAsync", p)
+	if m.RemoveCertFromLoadBalancerAsyncFunc != nil {
+		return m.RemoveCertFromLoadBalancerAsyncFunc(p)
+	}
+	if m.RemoveCertFromLoadBalancerAsyncWithContextFunc != nil {
+		return m.RemoveCertFromLoadBalancerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.RemoveCertFromLoadBalancerAsync")
+	return
+}
+
+// RemoveCertFromLoadBalancerAsyncWithContext records the call and calls RemoveCertFromLoadBalancerAsyncWithContextFunc, or RemoveCertFromLoadBalancerAsyncFunc if only that is set
+func (m *MockLoadBalancerService) RemoveCertFromLoadBalancerAsyncWithContext(ctx context.Context, p *cloudstack.RemoveCertFromLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveCertFromLoadBalancerAsyncWithContext", ctx, p)
+	if m.RemoveCertFromLoadBalancerAsyncWithContextFunc != nil {
+		return m.RemoveCertFromLoadBalancerAsyncWithContextFunc(ctx, p)
+	}
+	if m.RemoveCertFromLoadBalancerAsyncFunc != nil {
+		return m.RemoveCertFromLoadBalancerAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.RemoveCertFromLoadBalancerAsyncWithContext")
+	return
+}
+
+// NewRemoveFromGlobalLoadBalancerRuleParams records the call and calls NewRemoveFromGlobalLoadBalancerRuleParamsFunc
+func (m *MockLoadBalancerService) NewRemoveFromGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) (r0 *cloudstack.RemoveFromGlobalLoadBalancerRuleParams) {
+	m.record("NewRemoveFromGlobalLoadBalancerRuleParams", id, loadbalancerrulelist)
+	if m.NewRemoveFromGlobalLoadBalancerRuleParamsFunc != nil {
+		return m.NewRemoveFromGlobalLoadBalancerRuleParamsFunc(id, loadbalancerrulelist)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewRemoveFromGlobalLoadBalancerRuleParams(id, loadbalancerrulelist)
+}
+
+// RemoveFromGlobalLoadBalancerRule records the call and calls RemoveFromGlobalLoadBalancerRuleFunc, or RemoveFromGlobalLoadBalancerRuleWithContextFunc if only that is set
+func (m *MockLoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *cloudstack.RemoveFromGlobalLoadBalancerRuleParams) (r0 *cloudstack.RemoveFromGlobalLoadBalancerRuleResponse, r1 error) {
+	m.record("RemoveFromGlobalLoadBalancerRule", p)
+	if m.RemoveFromGlobalLoadBalancerRuleFunc != nil {
+		return m.RemoveFromGlobalLoadBalancerRuleFunc(p)
+	}
+	if m.RemoveFromGlobalLoadBalancerRuleWithContextFunc != nil {
+		return m.RemoveFromGlobalLoadBalancerRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.RemoveFromGlobalLoadBalancerRule")
+	return
+}
+
+// RemoveFromGlobalLoadBalancerRuleWithContext records the call and calls RemoveFromGlobalLoadBalancerRuleWithContextFunc, or RemoveFromGlobalLoadBalancerRuleFunc if only that is set
+func (m *MockLoadBalancerService) RemoveFromGlobalLoadBalancerRuleWithContext(ctx context.Context, p *cloudstack.RemoveFromGlobalLoadBalancerRuleParams) (r0 *cloudstack.RemoveFromGlobalLoadBalancerRuleResponse, r1 error) {
+	m.record("RemoveFromGlobalLoadBalancerRuleWithContext", ctx, p)
+	if m.RemoveFromGlobalLoadBalancerRuleWithContextFunc != nil {
+		return m.RemoveFromGlobalLoadBalancerRuleWithContextFunc(ctx, p)
+	}
+	if m.RemoveFromGlobalLoadBalancerRuleFunc != nil {
+		return m.RemoveFromGlobalLoadBalancerRuleFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.RemoveFromGlobalLoadBalancerRuleWithContext")
+	return
+}
+
+// RemoveFromGlobalLoadBalancerRuleAsync records the call and calls RemoveFromGlobalLoadBalancerRuleAsyncFunc, or RemoveFromGlobalLoadBalancerRuleAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) RemoveFromGlobalLoadBalancerRuleAsync(p *cloudstack.RemoveFromGlobalLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveFromGlobalLoadBalancerRuleAsync", p)
+	if m.RemoveFromGlobalLoadBalancerRuleAsyncFunc != nil {
+		return m.RemoveFromGlobalLoadBalancerRuleAsyncFunc(p)
+	}
+	if m.RemoveFromGlobalLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.RemoveFromGlobalLoadBalancerRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.RemoveFromGlobalLoadBalancerRuleAsync")
+	return
+}
+
+// RemoveFromGlobalLoadBalancerRuleAsyncWithContext records the call and calls RemoveFromGlobalLoadBalancerRuleAsyncWithContextFunc, or RemoveFromGlobalLoadBalancerRuleAsyncFunc if only that is set
+func (m *MockLoadBalancerService) RemoveFromGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *cloudstack.RemoveFromGlobalLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveFromGlobalLoadBalancerRuleAsyncWithContext", ctx, p)
+	if m.RemoveFromGlobalLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.RemoveFromGlobalLoadBalancerRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.RemoveFromGlobalLoadBalancerRuleAsyncFunc != nil {
+		return m.RemoveFromGlobalLoadBalancerRuleAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.RemoveFromGlobalLoadBalancerRuleAsyncWithContext")
+	return
+}
+
+// NewRemoveFromLoadBalancerRuleParams records the call and calls NewRemoveFromLoadBalancerRuleParamsFunc
+func (m *MockLoadBalancerService) NewRemoveFromLoadBalancerRuleParams(id string) (r0 *cloudstack.RemoveFromLoadBalancerRuleParams) {
+	m.record("NewRemoveFromLoadBalancerRuleParams", id)
+	if m.NewRemoveFromLoadBalancerRuleParamsFunc != nil {
+		return m.NewRemoveFromLoadBalancerRuleParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewRemoveFromLoadBalancerRuleParams(id)
+}
+
+// RemoveFromLoadBalancerRule records the call and calls RemoveFromLoadBalancerRuleFunc, or RemoveFromLoadBalancerRuleWithContextFunc if only that is set
+func (m *MockLoadBalancerService) RemoveFromLoadBalancerRule(p *cloudstack.RemoveFromLoadBalancerRuleParams) (r0 *cloudstack.RemoveFromLoadBalancerRuleResponse, r1 error) {
+	m.record("RemoveFromLoadBalancerRule", p)
+	if m.RemoveFromLoadBalancerRuleFunc != nil {
+		return m.RemoveFromLoadBalancerRuleFunc(p)
+	}
+	if m.RemoveFromLoadBalancerRuleWithContextFunc != nil {
+		return m.RemoveFromLoadBalancerRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.RemoveFromLoadBalancerRule")
+	return
+}
+
+// RemoveFromLoadBalancerRuleWithContext records the call and calls RemoveFromLoadBalancerRuleWithContextFunc, or RemoveFromLoadBalancerRuleFunc if only that is set
+func (m *MockLoadBalancerService) RemoveFromLoadBalancerRuleWithContext(ctx context.Context, p *cloudstack.RemoveFromLoadBalancerRuleParams) (r0 *cloudstack.RemoveFromLoadBalancerRuleResponse, r1 error) {
+	m.record("RemoveFromLoadBalancerRuleWithContext", ctx, p)
+	if m.RemoveFromLoadBalancerRuleWithContextFunc != nil {
+		return m.RemoveFromLoadBalancerRuleWithContextFunc(ctx, p)
+	}
+	if m.RemoveFromLoadBalancerRuleFunc != nil {
+		return m.RemoveFromLoadBalancerRuleFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.RemoveFromLoadBalancerRuleWithContext")
+	return
+}
+
+// RemoveFromLoadBalancerRuleAsync records the call and calls RemoveFromLoadBalancerRuleAsyncFunc, or RemoveFromLoadBalancerRuleAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) RemoveFromLoadBalancerRuleAsync(p *cloudstack.RemoveFromLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveFromLoadBalancerRuleAsync", p)
+	if m.RemoveFromLoadBalancerRuleAsyncFunc != nil {
+		return m.RemoveFromLoadBalancerRuleAsyncFunc(p)
+	}
+	if m.RemoveFromLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.RemoveFromLoadBalancerRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.RemoveFromLoadBalancerRuleAsync")
+	return
+}
+
+// RemoveFromLoadBalancerRuleAsyncWithContext records the call and calls RemoveFromLoadBalancerRuleAsyncWithContextFunc, or RemoveFromLoadBalancerRuleAsyncFunc if only that is set
+func (m *MockLoadBalancerService) RemoveFromLoadBalancerRuleAsyncWithContext(ctx context.Context, p *cloudstack.RemoveFromLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveFromLoadBalancerRuleAsyncWithContext", ctx, p)
+	if m.RemoveFromLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.RemoveFromLoadBalancerRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.RemoveFromLoadBalancerRuleAsyncFunc != nil {
+		return m.RemoveFromLoadBalancerRuleAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.RemoveFromLoadBalancerRuleAsyncWithContext")
+	return
+}
+
+// NewUpdateGlobalLoadBalancerRuleParams records the call and calls NewUpdateGlobalLoadBalancerRuleParamsFunc
+func (m *MockLoadBalancerService) NewUpdateGlobalLoadBalancerRuleParams(id string) (r0 *cloudstack.UpdateGlobalLoadBalancerRuleParams) {
+	m.record("NewUpdateGlobalLoadBalancerRuleParams", id)
+	if m.NewUpdateGlobalLoadBalancerRuleParamsFunc != nil {
+		return m.NewUpdateGlobalLoadBalancerRuleParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewUpdateGlobalLoadBalancerRuleParams(id)
+}
+
+// UpdateGlobalLoadBalancerRule records the call and calls UpdateGlobalLoadBalancerRuleFunc, or UpdateGlobalLoadBalancerRuleWithContextFunc if only that is set
+func (m *MockLoadBalancerService) UpdateGlobalLoadBalancerRule(p *cloudstack.UpdateGlobalLoadBalancerRuleParams) (r0 *cloudstack.UpdateGlobalLoadBalancerRuleResponse, r1 error) {
+	m.record("UpdateGlobalLoadBalancerRule", p)
+	if m.UpdateGlobalLoadBalancerRuleFunc != nil {
+		return m.UpdateGlobalLoadBalancerRuleFunc(p)
+	}
+	if m.UpdateGlobalLoadBalancerRuleWithContextFunc != nil {
+		return m.UpdateGlobalLoadBalancerRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateGlobalLoadBalancerRule")
+	return
+}
+
+// UpdateGlobalLoadBalancerRuleWithContext records the call and calls UpdateGlobalLoadBalancerRuleWithContextFunc, or UpdateGlobalLoadBalancerRuleFunc if only that is set
+func (m *MockLoadBalancerService) UpdateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *cloudstack.UpdateGlobalLoadBalancerRuleParams) (r0 *cloudstack.UpdateGlobalLoadBalancerRuleResponse, r1 error) {
+	m.record("UpdateGlobalLoadBalancerRuleWithContext", ctx, p)
+	if m.UpdateGlobalLoadBalancerRuleWithContextFunc != nil {
+		return m.UpdateGlobalLoadBalancerRuleWithContextFunc(ctx, p)
+	}
+	if m.UpdateGlobalLoadBalancerRuleFunc != nil {
+		return m.UpdateGlobalLoadBalancerRuleFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateGlobalLoadBalancerRuleWithContext")
+	return
+}
+
+// UpdateGlobalLoadBalancerRuleAsync records the call and calls UpdateGlobalLoadBalancerRuleAsyncFunc, or UpdateGlobalLoadBalancerRuleAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) UpdateGlobalLoadBalancerRuleAsync(p *cloudstack.UpdateGlobalLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateGlobalLoadBalancerRuleAsync", p)
+	if m.UpdateGlobalLoadBalancerRuleAsyncFunc != nil {
+		return m.UpdateGlobalLoadBalancerRuleAsyncFunc(p)
+	}
+	if m.UpdateGlobalLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.UpdateGlobalLoadBalancerRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateGlobalLoadBalancerRuleAsync")
+	return
+}
+
+// UpdateGlobalLoadBalancerRuleAsyncWithContext records the call and calls UpdateGlobalLoadBalancerRuleAsyncWithContextFunc, or UpdateGlobalLoadBalancerRuleAsyncFunc if only that is set
+func (m *MockLoadBalancerService) UpdateGlobalLoadBalancerRuleAsyncWithContext(ctx context.Context, p *cloudstack.UpdateGlobalLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateGlobalLoadBalancerRuleAsyncWithContext", ctx, p)
+	if m.UpdateGlobalLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.UpdateGlobalLoadBalancerRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateGlobalLoadBalancerRuleAsyncFunc != nil {
+		return m.UpdateGlobalLoadBalancerRuleAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateGlobalLoadBalancerRuleAsyncWithContext")
+	return
+}
+
+// NewUpdateLBHealthCheckPolicyParams records the call and calls NewUpdateLBHealthCheckPolicyParamsFunc
+func (m *MockLoadBalancerService) NewUpdateLBHealthCheckPolicyParams(id string) (r0 *cloudstack.UpdateLBHealthCheckPolicyParams) {
+	m.record("NewUpdateLBHealthCheckPolicyParams", id)
+	if m.NewUpdateLBHealthCheckPolicyParamsFunc != nil {
+		return m.NewUpdateLBHealthCheckPolicyParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewUpdateLBHealthCheckPolicyParams(id)
+}
+
+// UpdateLBHealthCheckPolicy records the call and calls UpdateLBHealthCheckPolicyFunc, or UpdateLBHealthCheckPolicyWithContextFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLBHealthCheckPolicy(p *cloudstack.UpdateLBHealthCheckPolicyParams) (r0 *cloudstack.UpdateLBHealthCheckPolicyResponse, r1 error) {
+	m.record("UpdateLBHealthCheckPolicy", p)
+	if m.UpdateLBHealthCheckPolicyFunc != nil {
+		return m.UpdateLBHealthCheckPolicyFunc(p)
+	}
+	if m.UpdateLBHealthCheckPolicyWithContextFunc != nil {
+		return m.UpdateLBHealthCheckPolicyWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLBHealthCheckPolicy")
+	return
+}
+
+// UpdateLBHealthCheckPolicyWithContext records the call and calls UpdateLBHealthCheckPolicyWithContextFunc, or UpdateLBHealthCheckPolicyFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLBHealthCheckPolicyWithContext(ctx context.Context, p *cloudstack.UpdateLBHealthCheckPolicyParams) (r0 *cloudstack.UpdateLBHealthCheckPolicyResponse, r1 error) {
+	m.record("UpdateLBHealthCheckPolicyWithContext", ctx, p)
+	if m.UpdateLBHealthCheckPolicyWithContextFunc != nil {
+		return m.UpdateLBHealthCheckPolicyWithContextFunc(ctx, p)
+	}
+	if m.UpdateLBHealthCheckPolicyFunc != nil {
+		return m.UpdateLBHealthCheckPolicyFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLBHealthCheckPolicyWithContext")
+	return
+}
+
+// UpdateLBHealthCheckPolicyAsync records the call and calls UpdateLBHealthCheckPolicyAsyncFunc, or UpdateLBHealthCheckPolicyAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLBHealthCheckPolicyAsync(p *cloudstack.UpdateLBHealthCheckPolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateLBHealthCheckPolicyAsync", p)
+	if m.UpdateLBHealthCheckPolicyAsyncFunc != nil {
+		return m.UpdateLBHealthCheckPolicyAsyncFunc(p)
+	}
+	if m.UpdateLBHealthCheckPolicyAsyncWithContextFunc != nil {
+		return m.UpdateLBHealthCheckPolicyAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLBHealthCheckPolicyAsync")
+	return
+}
+
+// UpdateLBHealthCheckPolicyAsyncWithContext records the call and calls UpdateLBHealthCheckPolicyAsyncWithContextFunc, or UpdateLBHealthCheckPolicyAsyncFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLBHealthCheckPolicyAsyncWithContext(ctx context.Context, p *cloudstack.UpdateLBHealthCheckPolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateLBHealthCheckPolicyAsyncWithContext", ctx, p)
+	if m.UpdateLBHealthCheckPolicyAsyncWithContextFunc != nil {
+		return m.UpdateLBHealthCheckPolicyAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateLBHealthCheckPolicyAsyncFunc != nil {
+		return m.UpdateLBHealthCheckPolicyAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLBHealthCheckPolicyAsyncWithContext")
+	return
+}
+
+// NewUpdateLBStickinessPolicyParams records the call and calls NewUpdateLBStickinessPolicyParamsFunc
+func (m *MockLoadBalancerService) NewUpdateLBStickinessPolicyParams(id string) (r0 *cloudstack.UpdateLBStickinessPolicyParams) {
+	m.record("NewUpdateLBStickinessPolicyParams", id)
+	if m.NewUpdateLBStickinessPolicyParamsFunc != nil {
+		return m.NewUpdateLBStickinessPolicyParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewUpdateLBStickinessPolicyParams(id)
+}
+
+// UpdateLBStickinessPolicy records the call and calls UpdateLBStickinessPolicyFunc, or UpdateLBStickinessPolicyWithContextFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLBStickinessPolicy(p *cloudstack.UpdateLBStickinessPolicyParams) (r0 *cloudstack.UpdateLBStickinessPolicyResponse, r1 error) {
+	m.record("UpdateLBStickinessPolicy", p)
+	if m.UpdateLBStickinessPolicyFunc != nil {
+		return m.UpdateLBStickinessPolicyFunc(p)
+	}
+	if m.UpdateLBStickinessPolicyWithContextFunc != nil {
+		return m.UpdateLBStickinessPolicyWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLBStickinessPolicy")
+	return
+}
+
+// UpdateLBStickinessPolicyWithContext records the call and calls UpdateLBStickinessPolicyWithContextFunc, or UpdateLBStickinessPolicyFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLBStickinessPolicyWithContext(ctx context.Context, p *cloudstack.UpdateLBStickinessPolicyParams) (r0 *cloudstack.UpdateLBStickinessPolicyResponse, r1 error) {
+	m.record("UpdateLBStickinessPolicyWithContext", ctx, p)
+	if m.UpdateLBStickinessPolicyWithContextFunc != nil {
+		return m.UpdateLBStickinessPolicyWithContextFunc(ctx, p)
+	}
+	if m.UpdateLBStickinessPolicyFunc != nil {
+		return m.UpdateLBStickinessPolicyFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLBStickinessPolicyWithContext")
+	return
+}
+
+// UpdateLBStickinessPolicyAsync records the call and calls UpdateLBStickinessPolicyAsyncFunc, or UpdateLBStickinessPolicyAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLBStickinessPolicyAsync(p *cloudstack.UpdateLBStickinessPolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateLBStickinessPolicyAsync", p)
+	if m.UpdateLBStickinessPolicyAsyncFunc != nil {
+		return m.UpdateLBStickinessPolicyAsyncFunc(p)
+	}
+	if m.UpdateLBStickinessPolicyAsyncWithContextFunc != nil {
+		return m.UpdateLBStickinessPolicyAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLBStickinessPolicyAsync")
+	return
+}
+
+// UpdateLBStickinessPolicyAsyncWithContext records the call and calls UpdateLBStickinessPolicyAsyncWithContextFunc, or UpdateLBStickinessPolicyAsyncFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLBStickinessPolicyAsyncWithContext(ctx context.Context, p *cloudstack.UpdateLBStickinessPolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateLBStickinessPolicyAsyncWithContext", ctx, p)
+	if m.UpdateLBStickinessPolicyAsyncWithContextFunc != nil {
+		return m.UpdateLBStickinessPolicyAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateLBStickinessPolicyAsyncFunc != nil {
+		return m.UpdateLBStickinessPolicyAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLBStickinessPolicyAsyncWithContext")
+	return
+}
+
+// NewUpdateLoadBalancerParams records the call and calls NewUpdateLoadBalancerParamsFunc
+func (m *MockLoadBalancerService) NewUpdateLoadBalancerParams(id string) (r0 *cloudstack.UpdateLoadBalancerParams) {
+	m.record("NewUpdateLoadBalancerParams", id)
+	if m.NewUpdateLoadBalancerParamsFunc != nil {
+		return m.NewUpdateLoadBalancerParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewUpdateLoadBalancerParams(id)
+}
+
+// UpdateLoadBalancer records the call and calls UpdateLoadBalancerFunc, or UpdateLoadBalancerWithContextFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLoadBalancer(p *cloudstack.UpdateLoadBalancerParams) (r0 *cloudstack.UpdateLoadBalancerResponse, r1 error) {
+	m.record("UpdateLoadBalancer", p)
+	if m.UpdateLoadBalancerFunc != nil {
+		return m.UpdateLoadBalancerFunc(p)
+	}
+	if m.UpdateLoadBalancerWithContextFunc != nil {
+		return m.UpdateLoadBalancerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLoadBalancer")
+	return
+}
+
+// UpdateLoadBalancerWithContext records the call and calls UpdateLoadBalancerWithContextFunc, or UpdateLoadBalancerFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLoadBalancerWithContext(ctx context.Context, p *cloudstack.UpdateLoadBalancerParams) (r0 *cloudstack.UpdateLoadBalancerResponse, r1 error) {
+	m.record("UpdateLoadBalancerWithContext", ctx, p)
+	if m.UpdateLoadBalancerWithContextFunc != nil {
+		return m.UpdateLoadBalancerWithContextFunc(ctx, p)
+	}
+	if m.UpdateLoadBalancerFunc != nil {
+		return m.UpdateLoadBalancerFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLoadBalancerWithContext")
+	return
+}
+
+// UpdateLoadBalancerAsync records the call and calls UpdateLoadBalancerAsyncFunc, or UpdateLoadBalancerAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLoadBalancerAsync(p *cloudstack.UpdateLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateLoadBalancerAsync", p)
+	if m.UpdateLoadBalancerAsyncFunc != nil {
+		return m.UpdateLoadBalancerAsyncFunc(p)
+	}
+	if m.UpdateLoadBalancerAsyncWithContextFunc != nil {
+		return m.UpdateLoadBalancerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLoadBalancerAsync")
+	return
+}
+
+// UpdateLoadBalancerAsyncWithContext records the call and calls UpdateLoadBalancerAsyncWithContextFunc, or UpdateLoadBalancerAsyncFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLoadBalancerAsyncWithContext(ctx context.Context, p *cloudstack.UpdateLoadBalancerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateLoadBalancerAsyncWithContext", ctx, p)
+	if m.UpdateLoadBalancerAsyncWithContextFunc != nil {
+		return m.UpdateLoadBalancerAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateLoadBalancerAsyncFunc != nil {
+		return m.UpdateLoadBalancerAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLoadBalancerAsyncWithContext")
+	return
+}
+
+// NewUpdateLoadBalancerRuleParams records the call and calls NewUpdateLoadBalancerRuleParamsFunc
+func (m *MockLoadBalancerService) NewUpdateLoadBalancerRuleParams(id string) (r0 *cloudstack.UpdateLoadBalancerRuleParams) {
+	m.record("NewUpdateLoadBalancerRuleParams", id)
+	if m.NewUpdateLoadBalancerRuleParamsFunc != nil {
+		return m.NewUpdateLoadBalancerRuleParamsFunc(id)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewUpdateLoadBalancerRuleParams(id)
+}
+
+// UpdateLoadBalancerRule records the call and calls UpdateLoadBalancerRuleFunc, or UpdateLoadBalancerRuleWithContextFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLoadBalancerRule(p *cloudstack.UpdateLoadBalancerRuleParams) (r0 *cloudstack.UpdateLoadBalancerRuleResponse, r1 error) {
+	m.record("UpdateLoadBalancerRule", p)
+	if m.UpdateLoadBalancerRuleFunc != nil {
+		return m.UpdateLoadBalancerRuleFunc(p)
+	}
+	if m.UpdateLoadBalancerRuleWithContextFunc != nil {
+		return m.UpdateLoadBalancerRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLoadBalancerRule")
+	return
+}
+
+// UpdateLoadBalancerRuleWithContext records the call and calls UpdateLoadBalancerRuleWithContextFunc, or UpdateLoadBalancerRuleFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLoadBalancerRuleWithContext(ctx context.Context, p *cloudstack.UpdateLoadBalancerRuleParams) (r0 *cloudstack.UpdateLoadBalancerRuleResponse, r1 error) {
+	m.record("UpdateLoadBalancerRuleWithContext", ctx, p)
+	if m.UpdateLoadBalancerRuleWithContextFunc != nil {
+		return m.UpdateLoadBalancerRuleWithContextFunc(ctx, p)
+	}
+	if m.UpdateLoadBalancerRuleFunc != nil {
+		return m.UpdateLoadBalancerRuleFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLoadBalancerRuleWithContext")
+	return
+}
+
+// UpdateLoadBalancerRuleAsync records the call and calls UpdateLoadBalancerRuleAsyncFunc, or UpdateLoadBalancerRuleAsyncWithContextFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLoadBalancerRuleAsync(p *cloudstack.UpdateLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateLoadBalancerRuleAsync", p)
+	if m.UpdateLoadBalancerRuleAsyncFunc != nil {
+		return m.UpdateLoadBalancerRuleAsyncFunc(p)
+	}
+	if m.UpdateLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.UpdateLoadBalancerRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLoadBalancerRuleAsync")
+	return
+}
+
+// UpdateLoadBalancerRuleAsyncWithContext records the call and calls UpdateLoadBalancerRuleAsyncWithContextFunc, or UpdateLoadBalancerRuleAsyncFunc if only that is set
+func (m *MockLoadBalancerService) UpdateLoadBalancerRuleAsyncWithContext(ctx context.Context, p *cloudstack.UpdateLoadBalancerRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateLoadBalancerRuleAsyncWithContext", ctx, p)
+	if m.UpdateLoadBalancerRuleAsyncWithContextFunc != nil {
+		return m.UpdateLoadBalancerRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateLoadBalancerRuleAsyncFunc != nil {
+		return m.UpdateLoadBalancerRuleAsyncFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.UpdateLoadBalancerRuleAsyncWithContext")
+	return
+}
+
+// NewUploadSslCertParams records the call and calls NewUploadSslCertParamsFunc
+func (m *MockLoadBalancerService) NewUploadSslCertParams(certificate string, name string, privatekey string) (r0 *cloudstack.UploadSslCertParams) {
+	m.record("NewUploadSslCertParams", certificate, name, privatekey)
+	if m.NewUploadSslCertParamsFunc != nil {
+		return m.NewUploadSslCertParamsFunc(certificate, name, privatekey)
+	}
+	return cloudstack.NewLoadBalancerService(nil).NewUploadSslCertParams(certificate, name, privatekey)
+}
+
+// UploadSslCert records the call and calls UploadSslCertFunc, or UploadSslCertWithContextFunc if only that is set
+func (m *MockLoadBalancerService) UploadSslCert(p *cloudstack.UploadSslCertParams) (r0 *cloudstack.UploadSslCertResponse, r1 error) {
+	m.record("UploadSslCert", p)
+	if m.UploadSslCertFunc != nil {
+		return m.UploadSslCertFunc(p)
+	}
+	if m.UploadSslCertWithContextFunc != nil {
+		return m.UploadSslCertWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("LoadBalancerService.UploadSslCert")
+	return
+}
+
+// UploadSslCertWithContext records the call and calls UploadSslCertWithContextFunc, or UploadSslCertFunc if only that is set
+func (m *MockLoadBalancerService) UploadSslCertWithContext(ctx context.Context, p *cloudstack.UploadSslCertParams) (r0 *cloudstack.UploadSslCertResponse, r1 error) {
+	m.record("UploadSslCertWithContext", ctx, p)
+	if m.UploadSslCertWithContextFunc != nil {
+		return m.UploadSslCertWithContextFunc(ctx, p)
+	}
+	if m.UploadSslCertFunc != nil {
+		return m.UploadSslCertFunc(p)
+	}
+	r1 = notMocked("LoadBalancerService.UploadSslCertWithContext")
+	return
+}
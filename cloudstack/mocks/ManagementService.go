@@ -0,0 +1,237 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockManagementService is a mock of cloudstack.ManagementServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockManagementService struct {
+	Recorder
+
+	NewListInfrastructureParamsFunc          func() *cloudstack.ListInfrastructureParams
+	ListInfrastructureFunc                   func(p *cloudstack.ListInfrastructureParams) (*cloudstack.ListInfrastructureResponse, error)
+	ListInfrastructureWithContextFunc        func(ctx context.Context, p *cloudstack.ListInfrastructureParams) (*cloudstack.ListInfrastructureResponse, error)
+	NewListManagementServersParamsFunc       func() *cloudstack.ListManagementServersParams
+	GetManagementServerIDFunc                func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetManagementServerIDWithContextFunc     func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetManagementServerByNameFunc            func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.ManagementServer, int, error)
+	GetManagementServerByNameWithContextFunc func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.ManagementServer, int, error)
+	GetManagementServerByIDFunc              func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.ManagementServer, int, error)
+	GetManagementServerByIDWithContextFunc   func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.ManagementServer, int, error)
+	ListManagementServersFunc                func(p *cloudstack.ListManagementServersParams) (*cloudstack.ListManagementServersResponse, error)
+	ListManagementServersWithContextFunc     func(ctx context.Context, p *cloudstack.ListManagementServersParams) (*cloudstack.ListManagementServersResponse, error)
+	NewListManagementServersPagerFunc        func(p *cloudstack.ListManagementServersParams) *cloudstack.ListManagementServersPager
+	ListManagementServersAllFunc             func(p *cloudstack.ListManagementServersParams) (*cloudstack.ListManagementServersResponse, error)
+	ListManagementServersAllWithContextFunc  func(ctx context.Context, p *cloudstack.ListManagementServersParams) (*cloudstack.ListManagementServersResponse, error)
+}
+
+var _ cloudstack.ManagementServiceIface = (*MockManagementService)(nil)
+
+// NewListInfrastructureParams records the call and calls NewListInfrastructureParamsFunc
+func (m *MockManagementService) NewListInfrastructureParams() (r0 *cloudstack.ListInfrastructureParams) {
+	m.record("NewListInfrastructureParams")
+	if m.NewListInfrastructureParamsFunc != nil {
+		return m.NewListInfrastructureParamsFunc()
+	}
+	return cloudstack.NewManagementService(nil).NewListInfrastructureParams()
+}
+
+// ListInfrastructure records the call and calls ListInfrastructureFunc, or ListInfrastructureWithContextFunc if only that is set
+func (m *MockManagementService) ListInfrastructure(p *cloudstack.ListInfrastructureParams) (r0 *cloudstack.ListInfrastructureResponse, r1 error) {
+	m.record("ListInfrastructure", p)
+	if m.ListInfrastructureFunc != nil {
+		return m.ListInfrastructureFunc(p)
+	}
+	if m.ListInfrastructureWithContextFunc != nil {
+		return m.ListInfrastructureWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ManagementService.ListInfrastructure")
+	return
+}
+
+// ListInfrastructureWithContext records the call and calls ListInfrastructureWithContextFunc, or ListInfrastructureFunc if only that is set
+func (m *MockManagementService) ListInfrastructureWithContext(ctx context.Context, p *cloudstack.ListInfrastructureParams) (r0 *cloudstack.ListInfrastructureResponse, r1 error) {
+	m.record("ListInfrastructureWithContext", ctx, p)
+	if m.ListInfrastructureWithContextFunc != nil {
+		return m.ListInfrastructureWithContextFunc(ctx, p)
+	}
+	if m.ListInfrastructureFunc != nil {
+		return m.ListInfrastructureFunc(p)
+	}
+	r1 = notMocked("ManagementService.ListInfrastructureWithContext")
+	return
+}
+
+// NewListManagementServersParams records the call and calls NewListManagementServersParamsFunc
+func (m *MockManagementService) NewListManagementServersParams() (r0 *cloudstack.ListManagementServersParams) {
+	m.record("NewListManagementServersParams")
+	if m.NewListManagementServersParamsFunc != nil {
+		return m.NewListManagementServersParamsFunc()
+	}
+	return cloudstack.NewManagementService(nil).NewListManagementServersParams()
+}
+
+// GetManagementServerID records the call and calls GetManagementServerIDFunc, or GetManagementServerIDWithContextFunc if only that is set
+func (m *MockManagementService) GetManagementServerID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetManagementServerID", name, opts)
+	if m.GetManagementServerIDFunc != nil {
+		return m.GetManagementServerIDFunc(name, opts...)
+	}
+	if m.GetManagementServerIDWithContextFunc != nil {
+		return m.GetManagementServerIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ManagementService.GetManagementServerID")
+	return
+}
+
+// GetManagementServerIDWithContext records the call and calls GetManagementServerIDWithContextFunc, or GetManagementServerIDFunc if only that is set
+func (m *MockManagementService) GetManagementServerIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetManagementServerIDWithContext", ctx, name, opts)
+	if m.GetManagementServerIDWithContextFunc != nil {
+		return m.GetManagementServerIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetManagementServerIDFunc != nil {
+		return m.GetManagementServerIDFunc(name, opts...)
+	}
+	r2 = notMocked("ManagementService.GetManagementServerIDWithContext")
+	return
+}
+
+// GetManagementServerByName records the call and calls GetManagementServerByNameFunc, or GetManagementServerByNameWithContextFunc if only that is set
+func (m *MockManagementService) GetManagementServerByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ManagementServer, r1 int, r2 error) {
+	m.record("GetManagementServerByName", name, opts)
+	if m.GetManagementServerByNameFunc != nil {
+		return m.GetManagementServerByNameFunc(name, opts...)
+	}
+	if m.GetManagementServerByNameWithContextFunc != nil {
+		return m.GetManagementServerByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ManagementService.GetManagementServerByName")
+	return
+}
+
+// GetManagementServerByNameWithContext records the call and calls GetManagementServerByNameWithContextFunc, or GetManagementServerByNameFunc if only that is set
+func (m *MockManagementService) GetManagementServerByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ManagementServer, r1 int, r2 error) {
+	m.record("GetManagementServerByNameWithContext", ctx, name, opts)
+	if m.GetManagementServerByNameWithContextFunc != nil {
+		return m.GetManagementServerByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetManagementServerByNameFunc != nil {
+		return m.GetManagementServerByNameFunc(name, opts...)
+	}
+	r2 = notMocked("ManagementService.GetManagementServerByNameWithContext")
+	return
+}
+
+// GetManagementServerByID records the call and calls GetManagementServerByIDFunc, or GetManagementServerByIDWithContextFunc if only that is set
+func (m *MockManagementService) GetManagementServerByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ManagementServer, r1 int, r2 error) {
+	m.record("GetManagementServerByID", id, opts)
+	if m.GetManagementServerByIDFunc != nil {
+		return m.GetManagementServerByIDFunc(id, opts...)
+	}
+	if m.GetManagementServerByIDWithContextFunc != nil {
+		return m.GetManagementServerByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("ManagementService.GetManagementServerByID")
+	return
+}
+
+// GetManagementServerByIDWithContext records the call and calls GetManagementServerByIDWithContextFunc, or GetManagementServerByIDFunc if only that is set
+func (m *MockManagementService) GetManagementServerByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ManagementServer, r1 int, r2 error) {
+	m.record("GetManagementServerByIDWithContext", ctx, id, opts)
+	if m.GetManagementServerByIDWithContextFunc != nil {
+		return m.GetManagementServerByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetManagementServerByIDFunc != nil {
+		return m.GetManagementServerByIDFunc(id, opts...)
+	}
+	r2 = notMocked("ManagementService.GetManagementServerByIDWithContext")
+	return
+}
+
+// ListManagementServers records the call and calls ListManagementServersFunc, or ListManagementServersWithContextFunc if only that is set
+func (m *MockManagementService) ListManagementServers(p *cloudstack.ListManagementServersParams) (r0 *cloudstack.ListManagementServersResponse, r1 error) {
+	m.record("ListManagementServers", p)
+	if m.ListManagementServersFunc != nil {
+		return m.ListManagementServersFunc(p)
+	}
+	if m.ListManagementServersWithContextFunc != nil {
+		return m.ListManagementServersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ManagementService.ListManagementServers")
+	return
+}
+
+// ListManagementServersWithContext records the call and calls ListManagementServersWithContextFunc, or ListManagementServersFunc if only that is set
+func (m *MockManagementService) ListManagementServersWithContext(ctx context.Context, p *cloudstack.ListManagementServersParams) (r0 *cloudstack.ListManagementServersResponse, r1 error) {
+	m.record("ListManagementServersWithContext", ctx, p)
+	if m.ListManagementServersWithContextFunc != nil {
+		return m.ListManagementServersWithContextFunc(ctx, p)
+	}
+	if m.ListManagementServersFunc != nil {
+		return m.ListManagementServersFunc(p)
+	}
+	r1 = notMocked("ManagementService.ListManagementServersWithContext")
+	return
+}
+
+// NewListManagementServersPager records the call and calls NewListManagementServersPagerFunc
+func (m *MockManagementService) NewListManagementServersPager(p *cloudstack.ListManagementServersParams) (r0 *cloudstack.ListManagementServersPager) {
+	m.record("NewListManagementServersPager", p)
+	if m.NewListManagementServersPagerFunc != nil {
+		return m.NewListManagementServersPagerFunc(p)
+	}
+	return
+}
+
+// ListManagementServersAll records the call and calls ListManagementServersAllFunc, or ListManagementServersAllWithContextFunc if only that is set
+func (m *MockManagementService) ListManagementServersAll(p *cloudstack.ListManagementServersParams) (r0 *cloudstack.ListManagementServersResponse, r1 error) {
+	m.record("ListManagementServersAll", p)
+	if m.ListManagementServersAllFunc != nil {
+		return m.ListManagementServersAllFunc(p)
+	}
+	if m.ListManagementServersAllWithContextFunc != nil {
+		return m.ListManagementServersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ManagementService.ListManagementServersAll")
+	return
+}
+
+// ListManagementServersAllWithContext records the call and calls ListManagementServersAllWithContextFunc, or ListManagementServersAllFunc if only that is set
+func (m *MockManagementService) ListManagementServersAllWithContext(ctx context.Context, p *cloudstack.ListManagementServersParams) (r0 *cloudstack.ListManagementServersResponse, r1 error) {
+	m.record("ListManagementServersAllWithContext", ctx, p)
+	if m.ListManagementServersAllWithContextFunc != nil {
+		return m.ListManagementServersAllWithContextFunc(ctx, p)
+	}
+	if m.ListManagementServersAllFunc != nil {
+		return m.ListManagementServersAllFunc(p)
+	}
+	r1 = notMocked("ManagementService.ListManagementServersAllWithContext")
+	return
+}
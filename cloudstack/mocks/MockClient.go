@@ -0,0 +1,267 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockClient contains a mock of every service of a CloudStackClient
+type MockClient struct {
+	APIDiscovery        *MockAPIDiscoveryService
+	Account             *MockAccountService
+	Address             *MockAddressService
+	AffinityGroup       *MockAffinityGroupService
+	Alert               *MockAlertService
+	Annotation          *MockAnnotationService
+	Asyncjob            *MockAsyncjobService
+	Authentication      *MockAuthenticationService
+	AutoScale           *MockAutoScaleService
+	Backup              *MockBackupService
+	Baremetal           *MockBaremetalService
+	BigSwitchBCF        *MockBigSwitchBCFService
+	BrocadeVCS          *MockBrocadeVCSService
+	Certificate         *MockCertificateService
+	CloudIdentifier     *MockCloudIdentifierService
+	Cluster             *MockClusterService
+	Configuration       *MockConfigurationService
+	Custom              *MockCustomService
+	Diagnostics         *MockDiagnosticsService
+	DiskOffering        *MockDiskOfferingService
+	Domain              *MockDomainService
+	Event               *MockEventService
+	Firewall            *MockFirewallService
+	GuestOS             *MockGuestOSService
+	Host                *MockHostService
+	Hypervisor          *MockHypervisorService
+	ISO                 *MockISOService
+	ImageStore          *MockImageStoreService
+	InternalLB          *MockInternalLBService
+	Kubernetes          *MockKubernetesService
+	LDAP                *MockLDAPService
+	Limit               *MockLimitService
+	LoadBalancer        *MockLoadBalancerService
+	Management          *MockManagementService
+	NAT                 *MockNATService
+	Netscaler           *MockNetscalerService
+	NetworkACL          *MockNetworkACLService
+	NetworkDevice       *MockNetworkDeviceService
+	NetworkOffering     *MockNetworkOfferingService
+	Network             *MockNetworkService
+	Nic                 *MockNicService
+	NiciraNVP           *MockNiciraNVPService
+	OutofbandManagement *MockOutofbandManagementService
+	OvsElement          *MockOvsElementService
+	Pod                 *MockPodService
+	Pool                *MockPoolService
+	PortableIP          *MockPortableIPService
+	Project             *MockProjectService
+	Quota               *MockQuotaService
+	Region              *MockRegionService
+	Resourcemetadata    *MockResourcemetadataService
+	Resourcetags        *MockResourcetagsService
+	Role                *MockRoleService
+	Router              *MockRouterService
+	SSH                 *MockSSHService
+	SecurityGroup       *MockSecurityGroupService
+	ServiceOffering     *MockServiceOfferingService
+	Simulator           *MockSimulatorService
+	Snapshot            *MockSnapshotService
+	StoragePool         *MockStoragePoolService
+	StratosphereSSP     *MockStratosphereSSPService
+	Swift               *MockSwiftService
+	SystemCapacity      *MockSystemCapacityService
+	SystemVM            *MockSystemVMService
+	Template            *MockTemplateService
+	UCS                 *MockUCSService
+	Usage               *MockUsageService
+	User                *MockUserService
+	VLAN                *MockVLANService
+	VMGroup             *MockVMGroupService
+	VMWare              *MockVMWareService
+	VPC                 *MockVPCService
+	VPN                 *MockVPNService
+	VirtualMachine      *MockVirtualMachineService
+	Volume              *MockVolumeService
+	Zone                *MockZoneService
+}
+
+// NewMockClient returns a client of which all services are replaced by mocks, together
+// with the mocks so their behavior can be set and their calls can be inspected
+func NewMockClient() (*cloudstack.CloudStackClient, *MockClient) {
+	m := &MockClient{
+		APIDiscovery:        &MockAPIDiscoveryService{},
+		Account:             &MockAccountService{},
+		Address:             &MockAddressService{},
+		AffinityGroup:       &MockAffinityGroupService{},
+		Alert:               &MockAlertService{},
+		Annotation:          &MockAnnotationService{},
+		Asyncjob:            &MockAsyncjobService{},
+		Authentication:      &MockAuthenticationService{},
+		AutoScale:           &MockAutoScaleService{},
+		Backup:              &MockBackupService{},
+		Baremetal:           &MockBaremetalService{},
+		BigSwitchBCF:        &MockBigSwitchBCFService{},
+		BrocadeVCS:          &MockBrocadeVCSService{},
+		Certificate:         &MockCertificateService{},
+		CloudIdentifier:     &MockCloudIdentifierService{},
+		Cluster:             &MockClusterService{},
+		Configuration:       &MockConfigurationService{},
+		Custom:              &MockCustomService{},
+		Diagnostics:         &MockDiagnosticsService{},
+		DiskOffering:        &MockDiskOfferingService{},
+		Domain:              &MockDomainService{},
+		Event:               &MockEventService{},
+		Firewall:            &MockFirewallService{},
+		GuestOS:             &MockGuestOSService{},
+		Host:                &MockHostService{},
+		Hypervisor:          &MockHypervisorService{},
+		ISO:                 &MockISOService{},
+		ImageStore:          &MockImageStoreService{},
+		InternalLB:          &MockInternalLBService{},
+		Kubernetes:          &MockKubernetesService{},
+		LDAP:                &MockLDAPService{},
+		Limit:               &MockLimitService{},
+		LoadBalancer:        &MockLoadBalancerService{},
+		Management:          &MockManagementService{},
+		NAT:                 &MockNATService{},
+		Netscaler:           &MockNetscalerService{},
+		NetworkACL:          &MockNetworkACLService{},
+		NetworkDevice:       &MockNetworkDeviceService{},
+		NetworkOffering:     &MockNetworkOfferingService{},
+		Network:             &MockNetworkService{},
+		Nic:                 &MockNicService{},
+		NiciraNVP:           &MockNiciraNVPService{},
+		OutofbandManagement: &MockOutofbandManagementService{},
+		OvsElement:          &MockOvsElementService{},
+		Pod:                 &MockPodService{},
+		Pool:                &MockPoolService{},
+		PortableIP:          &MockPortableIPService{},
+		Project:             &MockProjectService{},
+		Quota:               &MockQuotaService{},
+		Region:              &MockRegionService{},
+		Resourcemetadata:    &MockResourcemetadataService{},
+		Resourcetags:        &MockResourcetagsService{},
+		Role:                &MockRoleService{},
+		Router:              &MockRouterService{},
+		SSH:                 &MockSSHService{},
+		SecurityGroup:       &MockSecurityGroupService{},
+		ServiceOffering:     &MockServiceOfferingService{},
+		Simulator:           &MockSimulatorService{},
+		Snapshot:            &MockSnapshotService{},
+		StoragePool:         &MockStoragePoolService{},
+		StratosphereSSP:     &MockStratosphereSSPService{},
+		Swift:               &MockSwiftService{},
+		SystemCapacity:      &MockSystemCapacityService{},
+		SystemVM:            &MockSystemVMService{},
+		Template:            &MockTemplateService{},
+		UCS:                 &MockUCSService{},
+		Usage:               &MockUsageService{},
+		User:                &MockUserService{},
+		VLAN:                &MockVLANService{},
+		VMGroup:             &MockVMGroupService{},
+		VMWare:              &MockVMWareService{},
+		VPC:                 &MockVPCService{},
+		VPN:                 &MockVPNService{},
+		VirtualMachine:      &MockVirtualMachineService{},
+		Volume:              &MockVolumeService{},
+		Zone:                &MockZoneService{},
+	}
+
+	cs := cloudstack.NewClient("", "", "", false)
+	cs.APIDiscovery = m.APIDiscovery
+	cs.Account = m.Account
+	cs.Address = m.Address
+	cs.AffinityGroup = m.AffinityGroup
+	cs.Alert = m.Alert
+	cs.Annotation = m.Annotation
+	cs.Asyncjob = m.Asyncjob
+	cs.Authentication = m.Authentication
+	cs.AutoScale = m.AutoScale
+	cs.Backup = m.Backup
+	cs.Baremetal = m.Baremetal
+	cs.BigSwitchBCF = m.BigSwitchBCF
+	cs.BrocadeVCS = m.BrocadeVCS
+	cs.Certificate = m.Certificate
+	cs.CloudIdentifier = m.CloudIdentifier
+	cs.Cluster = m.Cluster
+	cs.Configuration = m.Configuration
+	cs.Custom = m.Custom
+	cs.Diagnostics = m.Diagnostics
+	cs.DiskOffering = m.DiskOffering
+	cs.Domain = m.Domain
+	cs.Event = m.Event
+	cs.Firewall = m.Firewall
+	cs.GuestOS = m.GuestOS
+	cs.Host = m.Host
+	cs.Hypervisor = m.Hypervisor
+	cs.ISO = m.ISO
+	cs.ImageStore = m.ImageStore
+	cs.InternalLB = m.InternalLB
+	cs.Kubernetes = m.Kubernetes
+	cs.LDAP = m.LDAP
+	cs.Limit = m.Limit
+	cs.LoadBalancer = m.LoadBalancer
+	cs.Management = m.Management
+	cs.NAT = m.NAT
+	cs.Netscaler = m.Netscaler
+	cs.NetworkACL = m.NetworkACL
+	cs.NetworkDevice = m.NetworkDevice
+	cs.NetworkOffering = m.NetworkOffering
+	cs.Network = m.Network
+	cs.Nic = m.Nic
+	cs.NiciraNVP = m.NiciraNVP
+	cs.OutofbandManagement = m.OutofbandManagement
+	cs.OvsElement = m.OvsElement
+	cs.Pod = m.Pod
+	cs.Pool = m.Pool
+	cs.PortableIP = m.PortableIP
+	cs.Project = m.Project
+	cs.Quota = m.Quota
+	cs.Region = m.Region
+	cs.Resourcemetadata = m.Resourcemetadata
+	cs.Resourcetags = m.Resourcetags
+	cs.Role = m.Role
+	cs.Router = m.Router
+	cs.SSH = m.SSH
+	cs.SecurityGroup = m.SecurityGroup
+	cs.ServiceOffering = m.ServiceOffering
+	cs.Simulator = m.Simulator
+	cs.Snapshot = m.Snapshot
+	cs.StoragePool = m.StoragePool
+	cs.StratosphereSSP = m.StratosphereSSP
+	cs.Swift = m.Swift
+	cs.SystemCapacity = m.SystemCapacity
+	cs.SystemVM = m.SystemVM
+	cs.Template = m.Template
+	cs.UCS = m.UCS
+	cs.Usage = m.Usage
+	cs.User = m.User
+	cs.VLAN = m.VLAN
+	cs.VMGroup = m.VMGroup
+	cs.VMWare = m.VMWare
+	cs.VPC = m.VPC
+	cs.VPN = m.VPN
+	cs.VirtualMachine = m.VirtualMachine
+	cs.Volume = m.Volume
+	cs.Zone = m.Zone
+
+	return cs, m
+}
@@ -0,0 +1,379 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockNATService is a mock of cloudstack.NATServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockNATService struct {
+	Recorder
+
+	NewCreateIpForwardingRuleParamsFunc        func(ipaddressid string, protocol string, startport int) *cloudstack.CreateIpForwardingRuleParams
+	CreateIpForwardingRuleFunc                 func(p *cloudstack.CreateIpForwardingRuleParams) (*cloudstack.CreateIpForwardingRuleResponse, error)
+	CreateIpForwardingRuleWithContextFunc      func(ctx context.Context, p *cloudstack.CreateIpForwardingRuleParams) (*cloudstack.CreateIpForwardingRuleResponse, error)
+	CreateIpForwardingRuleAsyncFunc            func(p *cloudstack.CreateIpForwardingRuleParams) (*cloudstack.Job, error)
+	CreateIpForwardingRuleAsyncWithContextFunc func(ctx context.Context, p *cloudstack.CreateIpForwardingRuleParams) (*cloudstack.Job, error)
+	NewDeleteIpForwardingRuleParamsFunc        func(id string) *cloudstack.DeleteIpForwardingRuleParams
+	DeleteIpForwardingRuleFunc                 func(p *cloudstack.DeleteIpForwardingRuleParams) (*cloudstack.DeleteIpForwardingRuleResponse, error)
+	DeleteIpForwardingRuleWithContextFunc      func(ctx context.Context, p *cloudstack.DeleteIpForwardingRuleParams) (*cloudstack.DeleteIpForwardingRuleResponse, error)
+	DeleteIpForwardingRuleAsyncFunc            func(p *cloudstack.DeleteIpForwardingRuleParams) (*cloudstack.Job, error)
+	DeleteIpForwardingRuleAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DeleteIpForwardingRuleParams) (*cloudstack.Job, error)
+	NewDisableStaticNatParamsFunc              func(ipaddressid string) *cloudstack.DisableStaticNatParams
+	DisableStaticNatFunc                       func(p *cloudstack.DisableStaticNatParams) (*cloudstack.DisableStaticNatResponse, error)
+	DisableStaticNatWithContextFunc            func(ctx context.Context, p *cloudstack.DisableStaticNatParams) (*cloudstack.DisableStaticNatResponse, error)
+	DisableStaticNatAsyncFunc                  func(p *cloudstack.DisableStaticNatParams) (*cloudstack.Job, error)
+	DisableStaticNatAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.DisableStaticNatParams) (*cloudstack.Job, error)
+	NewEnableStaticNatParamsFunc               func(ipaddressid string, virtualmachineid string) *cloudstack.EnableStaticNatParams
+	EnableStaticNatFunc                        func(p *cloudstack.EnableStaticNatParams) (*cloudstack.EnableStaticNatResponse, error)
+	EnableStaticNatWithContextFunc             func(ctx context.Context, p *cloudstack.EnableStaticNatParams) (*cloudstack.EnableStaticNatResponse, error)
+	NewListIpForwardingRulesParamsFunc         func() *cloudstack.ListIpForwardingRulesParams
+	GetIpForwardingRuleByIDFunc                func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.IpForwardingRule, int, error)
+	GetIpForwardingRuleByIDWithContextFunc     func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.IpForwardingRule, int, error)
+	ListIpForwardingRulesFunc                  func(p *cloudstack.ListIpForwardingRulesParams) (*cloudstack.ListIpForwardingRulesResponse, error)
+	ListIpForwardingRulesWithContextFunc       func(ctx context.Context, p *cloudstack.ListIpForwardingRulesParams) (*cloudstack.ListIpForwardingRulesResponse, error)
+	NewListIpForwardingRulesPagerFunc          func(p *cloudstack.ListIpForwardingRulesParams) *cloudstack.ListIpForwardingRulesPager
+	ListIpForwardingRulesAllFunc               func(p *cloudstack.ListIpForwardingRulesParams) (*cloudstack.ListIpForwardingRulesResponse, error)
+	ListIpForwardingRulesAllWithContextFunc    func(ctx context.Context, p *cloudstack.ListIpForwardingRulesParams) (*cloudstack.ListIpForwardingRulesResponse, error)
+}
+
+var _ cloudstack.NATServiceIface = (*MockNATService)(nil)
+
+// NewCreateIpForwardingRuleParams records the call and calls NewCreateIpForwardingRuleParamsFunc
+func (m *MockNATService) NewCreateIpForwardingRuleParams(ipaddressid string, protocol string, startport int) (r0 *cloudstack.CreateIpForwardingRuleParams) {
+	m.record("NewCreateIpForwardingRuleParams", ipaddressid, protocol, startport)
+	if m.NewCreateIpForwardingRuleParamsFunc != nil {
+		return m.NewCreateIpForwardingRuleParamsFunc(ipaddressid, protocol, startport)
+	}
+	return cloudstack.NewNATService(nil).NewCreateIpForwardingRuleParams(ipaddressid, protocol, startport)
+}
+
+// CreateIpForwardingRule records the call and calls CreateIpForwardingRuleFunc, or CreateIpForwardingRuleWithContextFunc if only that is set
+func (m *MockNATService) CreateIpForwardingRule(p *cloudstack.CreateIpForwardingRuleParams) (r0 *cloudstack.CreateIpForwardingRuleResponse, r1 error) {
+	m.record("CreateIpForwardingRule", p)
+	if m.CreateIpForwardingRuleFunc != nil {
+		return m.CreateIpForwardingRuleFunc(p)
+	}
+	if m.CreateIpForwardingRuleWithContextFunc != nil {
+		return m.CreateIpForwardingRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NATService.CreateIpForwardingRule")
+	return
+}
+
+// CreateIpForwardingRuleWithContext records the call and calls CreateIpForwardingRuleWithContextFunc, or CreateIpForwardingRuleFunc if only that is set
+func (m *MockNATService) CreateIpForwardingRuleWithContext(ctx context.Context, p *cloudstack.CreateIpForwardingRuleParams) (r0 *cloudstack.CreateIpForwardingRuleResponse, r1 error) {
+	m.record("CreateIpForwardingRuleWithContext", ctx, p)
+	if m.CreateIpForwardingRuleWithContextFunc != nil {
+		return m.CreateIpForwardingRuleWithContextFunc(ctx, p)
+	}
+	if m.CreateIpForwardingRuleFunc != nil {
+		return m.CreateIpForwardingRuleFunc(p)
+	}
+	r1 = notMocked("NATService.CreateIpForwardingRuleWithContext")
+	return
+}
+
+// CreateIpForwardingRuleAsync records the call and calls CreateIpForwardingRuleAsyncFunc, or CreateIpForwardingRuleAsyncWithContextFunc if only that is set
+func (m *MockNATService) CreateIpForwardingRuleAsync(p *cloudstack.CreateIpForwardingRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateIpForwardingRuleAsync", p)
+	if m.CreateIpForwardingRuleAsyncFunc != nil {
+		return m.CreateIpForwardingRuleAsyncFunc(p)
+	}
+	if m.CreateIpForwardingRuleAsyncWithContextFunc != nil {
+		return m.CreateIpForwardingRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NATService.CreateIpForwardingRuleAsync")
+	return
+}
+
+// CreateIpForwardingRuleAsyncWithContext records the call and calls CreateIpForwardingRuleAsyncWithContextFunc, or CreateIpForwardingRuleAsyncFunc if only that is set
+func (m *MockNATService) CreateIpForwardingRuleAsyncWithContext(ctx context.Context, p *cloudstack.CreateIpForwardingRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateIpForwardingRuleAsyncWithContext", ctx, p)
+	if m.CreateIpForwardingRuleAsyncWithContextFunc != nil {
+		return m.CreateIpForwardingRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateIpForwardingRuleAsyncFunc != nil {
+		return m.CreateIpForwardingRuleAsyncFunc(p)
+	}
+	r1 = notMocked("NATService.CreateIpForwardingRuleAsyncWithContext")
+	return
+}
+
+// NewDeleteIpForwardingRuleParams records the call and calls NewDeleteIpForwardingRuleParamsFunc
+func (m *MockNATService) NewDeleteIpForwardingRuleParams(id string) (r0 *cloudstack.DeleteIpForwardingRuleParams) {
+	m.record("NewDeleteIpForwardingRuleParams", id)
+	if m.NewDeleteIpForwardingRuleParamsFunc != nil {
+		return m.NewDeleteIpForwardingRuleParamsFunc(id)
+	}
+	return cloudstack.NewNATService(nil).NewDeleteIpForwardingRuleParams(id)
+}
+
+// DeleteIpForwardingRule records the call and calls DeleteIpForwardingRuleFunc, or DeleteIpForwardingRuleWithContextFunc if only that is set
+func (m *MockNATService) DeleteIpForwardingRule(p *cloudstack.DeleteIpForwardingRuleParams) (r0 *cloudstack.DeleteIpForwardingRuleResponse, r1 error) {
+	m.record("DeleteIpForwardingRule", p)
+	if m.DeleteIpForwardingRuleFunc != nil {
+		return m.DeleteIpForwardingRuleFunc(p)
+	}
+	if m.DeleteIpForwardingRuleWithContextFunc != nil {
+		return m.DeleteIpForwardingRuleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NATService.DeleteIpForwardingRule")
+	return
+}
+
+// DeleteIpForwardingRuleWithContext records the call and calls DeleteIpForwardingRuleWithContextFunc, or DeleteIpForwardingRuleFunc if only that is set
+func (m *MockNATService) DeleteIpForwardingRuleWithContext(ctx context.Context, p *cloudstack.DeleteIpForwardingRuleParams) (r0 *cloudstack.DeleteIpForwardingRuleResponse, r1 error) {
+	m.record("DeleteIpForwardingRuleWithContext", ctx, p)
+	if m.DeleteIpForwardingRuleWithContextFunc != nil {
+		return m.DeleteIpForwardingRuleWithContextFunc(ctx, p)
+	}
+	if m.DeleteIpForwardingRuleFunc != nil {
+		return m.DeleteIpForwardingRuleFunc(p)
+	}
+	r1 = notMocked("NATService.DeleteIpForwardingRuleWithContext")
+	return
+}
+
+// DeleteIpForwardingRuleAsync records the call and calls DeleteIpForwardingRuleAsyncFunc, or DeleteIpForwardingRuleAsyncWithContextFunc if only that is set
+func (m *MockNATService) DeleteIpForwardingRuleAsync(p *cloudstack.DeleteIpForwardingRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteIpForwardingRuleAsync", p)
+	if m.DeleteIpForwardingRuleAsyncFunc != nil {
+		return m.DeleteIpForwardingRuleAsyncFunc(p)
+	}
+	if m.DeleteIpForwardingRuleAsyncWithContextFunc != nil {
+		return m.DeleteIpForwardingRuleAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NATService.DeleteIpForwardingRuleAsync")
+	return
+}
+
+// DeleteIpForwardingRuleAsyncWithContext records the call and calls DeleteIpForwardingRuleAsyncWithContextFunc, or DeleteIpForwardingRuleAsyncFunc if only that is set
+func (m *MockNATService) DeleteIpForwardingRuleAsyncWithContext(ctx context.Context, p *cloudstack.DeleteIpForwardingRuleParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteIpForwardingRuleAsyncWithContext", ctx, p)
+	if m.DeleteIpForwardingRuleAsyncWithContextFunc != nil {
+		return m.DeleteIpForwardingRuleAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteIpForwardingRuleAsyncFunc != nil {
+		return m.DeleteIpForwardingRuleAsyncFunc(p)
+	}
+	r1 = notMocked("NATService.DeleteIpForwardingRuleAsyncWithContext")
+	return
+}
+
+// NewDisableStaticNatParams records the call and calls NewDisableStaticNatParamsFunc
+func (m *MockNATService) NewDisableStaticNatParams(ipaddressid string) (r0 *cloudstack.DisableStaticNatParams) {
+	m.record("NewDisableStaticNatParams", ipaddressid)
+	if m.NewDisableStaticNatParamsFunc != nil {
+		return m.NewDisableStaticNatParamsFunc(ipaddressid)
+	}
+	return cloudstack.NewNATService(nil).NewDisableStaticNatParams(ipaddressid)
+}
+
+// DisableStaticNat records the call and calls DisableStaticNatFunc, or DisableStaticNatWithContextFunc if only that is set
+func (m *MockNATService) DisableStaticNat(p *cloudstack.DisableStaticNatParams) (r0 *cloudstack.DisableStaticNatResponse, r1 error) {
+	m.record("DisableStaticNat", p)
+	if m.DisableStaticNatFunc != nil {
+		return m.DisableStaticNatFunc(p)
+	}
+	if m.DisableStaticNatWithContextFunc != nil {
+		return m.DisableStaticNatWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NATService.DisableStaticNat")
+	return
+}
+
+// DisableStaticNatWithContext records the call and calls DisableStaticNatWithContextFunc, or DisableStaticNatFunc if only that is set
+func (m *MockNATService) DisableStaticNatWithContext(ctx context.Context, p *cloudstack.DisableStaticNatParams) (r0 *cloudstack.DisableStaticNatResponse, r1 error) {
+	m.record("DisableStaticNatWithContext", ctx, p)
+	if m.DisableStaticNatWithContextFunc != nil {
+		return m.DisableStaticNatWithContextFunc(ctx, p)
+	}
+	if m.DisableStaticNatFunc != nil {
+		return m.DisableStaticNatFunc(p)
+	}
+	r1 = notMocked("NATService.DisableStaticNatWithContext")
+	return
+}
+
+// DisableStaticNatAsync records the call and calls DisableStaticNatAsyncFunc, or DisableStaticNatAsyncWithContextFunc if only that is set
+func (m *MockNATService) DisableStaticNatAsync(p *cloudstack.DisableStaticNatParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableStaticNatAsync", p)
+	if m.DisableStaticNatAsyncFunc != nil {
+		return m.DisableStaticNatAsyncFunc(p)
+	}
+	if m.DisableStaticNatAsyncWithContextFunc != nil {
+		return m.DisableStaticNatAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NATService.DisableStaticNatAsync")
+	return
+}
+
+// DisableStaticNatAsyncWithContext records the call and calls DisableStaticNatAsyncWithContextFunc, or DisableStaticNatAsyncFunc if only that is set
+func (m *MockNATService) DisableStaticNatAsyncWithContext(ctx context.Context, p *cloudstack.DisableStaticNatParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableStaticNatAsyncWithContext", ctx, p)
+	if m.DisableStaticNatAsyncWithContextFunc != nil {
+		return m.DisableStaticNatAsyncWithContextFunc(ctx, p)
+	}
+	if m.DisableStaticNatAsyncFunc != nil {
+		return m.DisableStaticNatAsyncFunc(p)
+	}
+	r1 = notMocked("NATService.DisableStaticNatAsyncWithContext")
+	return
+}
+
+// NewEnableStaticNatParams records the call and calls NewEnableStaticNatParamsFunc
+func (m *MockNATService) NewEnableStaticNatParams(ipaddressid string, virtualmachineid string) (r0 *cloudstack.EnableStaticNatParams) {
+	m.record("NewEnableStaticNatParams", ipaddressid, virtualmachineid)
+	if m.NewEnableStaticNatParamsFunc != nil {
+		return m.NewEnableStaticNatParamsFunc(ipaddressid, virtualmachineid)
+	}
+	return cloudstack.NewNATService(nil).NewEnableStaticNatParams(ipaddressid, virtualmachineid)
+}
+
+// EnableStaticNat records the call and calls EnableStaticNatFunc, or EnableStaticNatWithContextFunc if only that is set
+func (m *MockNATService) EnableStaticNat(p *cloudstack.EnableStaticNatParams) (r0 *cloudstack.EnableStaticNatResponse, r1 error) {
+	m.record("EnableStaticNat", p)
+	if m.EnableStaticNatFunc != nil {
+		return m.EnableStaticNatFunc(p)
+	}
+	if m.EnableStaticNatWithContextFunc != nil {
+		return m.EnableStaticNatWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NATService.EnableStaticNat")
+	return
+}
+
+// EnableStaticNatWithContext records the call and calls EnableStaticNatWithContextFunc, or EnableStaticNatFunc if only that is set
+func (m *MockNATService) EnableStaticNatWithContext(ctx context.Context, p *cloudstack.EnableStaticNatParams) (r0 *cloudstack.EnableStaticNatResponse, r1 error) {
+	m.record("EnableStaticNatWithContext", ctx, p)
+	if m.EnableStaticNatWithContextFunc != nil {
+		return m.EnableStaticNatWithContextFunc(ctx, p)
+	}
+	if m.EnableStaticNatFunc != nil {
+		return m.EnableStaticNatFunc(p)
+	}
+	r1 = notMocked("NATService.EnableStaticNatWithContext")
+	return
+}
+
+// NewListIpForwardingRulesParams records the call and calls NewListIpForwardingRulesParamsFunc
+func (m *MockNATService) NewListIpForwardingRulesParams() (r0 *cloudstack.ListIpForwardingRulesParams) {
+	m.record("NewListIpForwardingRulesParams")
+	if m.NewListIpForwardingRulesParamsFunc != nil {
+		return m.NewListIpForwardingRulesParamsFunc()
+	}
+	return cloudstack.NewNATService(nil).NewListIpForwardingRulesParams()
+}
+
+// GetIpForwardingRuleByID records the call and calls GetIpForwardingRuleByIDFunc, or GetIpForwardingRuleByIDWithContextFunc if only that is set
+func (m *MockNATService) GetIpForwardingRuleByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.IpForwardingRule, r1 int, r2 error) {
+	m.record("GetIpForwardingRuleByID", id, opts)
+	if m.GetIpForwardingRuleByIDFunc != nil {
+		return m.GetIpForwardingRuleByIDFunc(id, opts...)
+	}
+	if m.GetIpForwardingRuleByIDWithContextFunc != nil {
+		return m.GetIpForwardingRuleByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("NATService.GetIpForwardingRuleByID")
+	return
+}
+
+// GetIpForwardingRuleByIDWithContext records the call and calls GetIpForwardingRuleByIDWithContextFunc, or GetIpForwardingRuleByIDFunc if only that is set
+func (m *MockNATService) GetIpForwardingRuleByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.IpForwardingRule, r1 int, r2 error) {
+	m.record("GetIpForwardingRuleByIDWithContext", ctx, id, opts)
+	if m.GetIpForwardingRuleByIDWithContextFunc != nil {
+		return m.GetIpForwardingRuleByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetIpForwardingRuleByIDFunc != nil {
+		return m.GetIpForwardingRuleByIDFunc(id, opts...)
+	}
+	r2 = notMocked("NATService.GetIpForwardingRuleByIDWithContext")
+	return
+}
+
+// ListIpForwardingRules records the call and calls ListIpForwardingRulesFunc, or ListIpForwardingRulesWithContextFunc if only that is set
+func (m *MockNATService) ListIpForwardingRules(p *cloudstack.ListIpForwardingRulesParams) (r0 *cloudstack.ListIpForwardingRulesResponse, r1 error) {
+	m.record("ListIpForwardingRules", p)
+	if m.ListIpForwardingRulesFunc != nil {
+		return m.ListIpForwardingRulesFunc(p)
+	}
+	if m.ListIpForwardingRulesWithContextFunc != nil {
+		return m.ListIpForwardingRulesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NATService.ListIpForwardingRules")
+	return
+}
+
+// ListIpForwardingRulesWithContext records the call and calls ListIpForwardingRulesWithContextFunc, or ListIpForwardingRulesFunc if only that is set
+func (m *MockNATService) ListIpForwardingRulesWithContext(ctx context.Context, p *cloudstack.ListIpForwardingRulesParams) (r0 *cloudstack.ListIpForwardingRulesResponse, r1 error) {
+	m.record("ListIpForwardingRulesWithContext", ctx, p)
+	if m.ListIpForwardingRulesWithContextFunc != nil {
+		return m.ListIpForwardingRulesWithContextFunc(ctx, p)
+	}
+	if m.ListIpForwardingRulesFunc != nil {
+		return m.ListIpForwardingRulesFunc(p)
+	}
+	r1 = notMocked("NATService.ListIpForwardingRulesWithContext")
+	return
+}
+
+// NewListIpForwardingRulesPager records the call and calls NewListIpForwardingRulesPagerFunc
+func (m *MockNATService) NewListIpForwardingRulesPager(p *cloudstack.ListIpForwardingRulesParams) (r0 *cloudstack.ListIpForwardingRulesPager) {
+	m.record("NewListIpForwardingRulesPager", p)
+	if m.NewListIpForwardingRulesPagerFunc != nil {
+		return m.NewListIpForwardingRulesPagerFunc(p)
+	}
+	return
+}
+
+// ListIpForwardingRulesAll records the call and calls ListIpForwardingRulesAllFunc, or ListIpForwardingRulesAllWithContextFunc if only that is set
+func (m *MockNATService) ListIpForwardingRulesAll(p *cloudstack.ListIpForwardingRulesParams) (r0 *cloudstack.ListIpForwardingRulesResponse, r1 error) {
+	m.record("ListIpForwardingRulesAll", p)
+	if m.ListIpForwardingRulesAllFunc != nil {
+		return m.ListIpForwardingRulesAllFunc(p)
+	}
+	if m.ListIpForwardingRulesAllWithContextFunc != nil {
+		return m.ListIpForwardingRulesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NATService.ListIpForwardingRulesAll")
+	return
+}
+
+// ListIpForwardingRulesAllWithContext records the call and calls ListIpForwardingRulesAllWithContextFunc, or ListIpForwardingRulesAllFunc if only that is set
+func (m *MockNATService) ListIpForwardingRulesAllWithContext(ctx context.Context, p *cloudstack.ListIpForwardingRulesParams) (r0 *cloudstack.ListIpForwardingRulesResponse, r1 error) {
+	m.record("ListIpForwardingRulesAllWithContext", ctx, p)
+	if m.ListIpForwardingRulesAllWithContextFunc != nil {
+		return m.ListIpForwardingRulesAllWithContextFunc(ctx, p)
+	}
+	if m.ListIpForwardingRulesAllFunc != nil {
+		return m.ListIpForwardingRulesAllFunc(p)
+	}
+	r1 = notMocked("NATService.ListIpForwardingRulesAllWithContext")
+	return
+}
@@ -0,0 +1,531 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockNetscalerService is a mock of cloudstack.NetscalerServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockNetscalerService struct {
+	Recorder
+
+	NewDeleteNetscalerControlCenterParamsFunc          func(id string) *cloudstack.DeleteNetscalerControlCenterParams
+	DeleteNetscalerControlCenterFunc                   func(p *cloudstack.DeleteNetscalerControlCenterParams) (*cloudstack.DeleteNetscalerControlCenterResponse, error)
+	DeleteNetscalerControlCenterWithContextFunc        func(ctx context.Context, p *cloudstack.DeleteNetscalerControlCenterParams) (*cloudstack.DeleteNetscalerControlCenterResponse, error)
+	NewDeleteServicePackageOfferingParamsFunc          func(id string) *cloudstack.DeleteServicePackageOfferingParams
+	DeleteServicePackageOfferingFunc                   func(p *cloudstack.DeleteServicePackageOfferingParams) (*cloudstack.DeleteServicePackageOfferingResponse, error)
+	DeleteServicePackageOfferingWithContextFunc        func(ctx context.Context, p *cloudstack.DeleteServicePackageOfferingParams) (*cloudstack.DeleteServicePackageOfferingResponse, error)
+	NewDeployNetscalerVpxParamsFunc                    func(serviceofferingid string, templateid string, zoneid string) *cloudstack.DeployNetscalerVpxParams
+	DeployNetscalerVpxFunc                             func(p *cloudstack.DeployNetscalerVpxParams) (*cloudstack.DeployNetscalerVpxResponse, error)
+	DeployNetscalerVpxWithContextFunc                  func(ctx context.Context, p *cloudstack.DeployNetscalerVpxParams) (*cloudstack.DeployNetscalerVpxResponse, error)
+	DeployNetscalerVpxAsyncFunc                        func(p *cloudstack.DeployNetscalerVpxParams) (*cloudstack.Job, error)
+	DeployNetscalerVpxAsyncWithContextFunc             func(ctx context.Context, p *cloudstack.DeployNetscalerVpxParams) (*cloudstack.Job, error)
+	NewListNetscalerControlCenterParamsFunc            func() *cloudstack.ListNetscalerControlCenterParams
+	ListNetscalerControlCenterFunc                     func(p *cloudstack.ListNetscalerControlCenterParams) (*cloudstack.ListNetscalerControlCenterResponse, error)
+	ListNetscalerControlCenterWithContextFunc          func(ctx context.Context, p *cloudstack.ListNetscalerControlCenterParams) (*cloudstack.ListNetscalerControlCenterResponse, error)
+	NewListNetscalerControlCenterPagerFunc             func(p *cloudstack.ListNetscalerControlCenterParams) *cloudstack.ListNetscalerControlCenterPager
+	ListNetscalerControlCenterAllFunc                  func(p *cloudstack.ListNetscalerControlCenterParams) (*cloudstack.ListNetscalerControlCenterResponse, error)
+	ListNetscalerControlCenterAllWithContextFunc       func(ctx context.Context, p *cloudstack.ListNetscalerControlCenterParams) (*cloudstack.ListNetscalerControlCenterResponse, error)
+	NewListRegisteredServicePackagesParamsFunc         func() *cloudstack.ListRegisteredServicePackagesParams
+	GetRegisteredServicePackageIDFunc                  func(keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetRegisteredServicePackageIDWithContextFunc       func(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListRegisteredServicePackagesFunc                  func(p *cloudstack.ListRegisteredServicePackagesParams) (*cloudstack.ListRegisteredServicePackagesResponse, error)
+	ListRegisteredServicePackagesWithContextFunc       func(ctx context.Context, p *cloudstack.ListRegisteredServicePackagesParams) (*cloudstack.ListRegisteredServicePackagesResponse, error)
+	NewListRegisteredServicePackagesPagerFunc          func(p *cloudstack.ListRegisteredServicePackagesParams) *cloudstack.ListRegisteredServicePackagesPager
+	ListRegisteredServicePackagesAllFunc               func(p *cloudstack.ListRegisteredServicePackagesParams) (*cloudstack.ListRegisteredServicePackagesResponse, error)
+	ListRegisteredServicePackagesAllWithContextFunc    func(ctx context.Context, p *cloudstack.ListRegisteredServicePackagesParams) (*cloudstack.ListRegisteredServicePackagesResponse, error)
+	NewRegisterNetscalerControlCenterParamsFunc        func(ipaddress string, numretries int, password string, username string) *cloudstack.RegisterNetscalerControlCenterParams
+	RegisterNetscalerControlCenterFunc                 func(p *cloudstack.RegisterNetscalerControlCenterParams) (*cloudstack.RegisterNetscalerControlCenterResponse, error)
+	RegisterNetscalerControlCenterWithContextFunc      func(ctx context.Context, p *cloudstack.RegisterNetscalerControlCenterParams) (*cloudstack.RegisterNetscalerControlCenterResponse, error)
+	RegisterNetscalerControlCenterAsyncFunc            func(p *cloudstack.RegisterNetscalerControlCenterParams) (*cloudstack.Job, error)
+	RegisterNetscalerControlCenterAsyncWithContextFunc func(ctx context.Context, p *cloudstack.RegisterNetscalerControlCenterParams) (*cloudstack.Job, error)
+	NewRegisterNetscalerServicePackageParamsFunc       func(description string, name string) *cloudstack.RegisterNetscalerServicePackageParams
+	RegisterNetscalerServicePackageFunc                func(p *cloudstack.RegisterNetscalerServicePackageParams) (*cloudstack.RegisterNetscalerServicePackageResponse, error)
+	RegisterNetscalerServicePackageWithContextFunc     func(ctx context.Context, p *cloudstack.RegisterNetscalerServicePackageParams) (*cloudstack.RegisterNetscalerServicePackageResponse, error)
+	NewStopNetScalerVpxParamsFunc                      func(id string) *cloudstack.StopNetScalerVpxParams
+	StopNetScalerVpxFunc                               func(p *cloudstack.StopNetScalerVpxParams) (*cloudstack.StopNetScalerVpxResponse, error)
+	StopNetScalerVpxWithContextFunc                    func(ctx context.Context, p *cloudstack.StopNetScalerVpxParams) (*cloudstack.StopNetScalerVpxResponse, error)
+	StopNetScalerVpxAsyncFunc                          func(p *cloudstack.StopNetScalerVpxParams) (*cloudstack.Job, error)
+	StopNetScalerVpxAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.StopNetScalerVpxParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.NetscalerServiceIface = (*MockNetscalerService)(nil)
+
+// NewDeleteNetscalerControlCenterParams records the call and calls NewDeleteNetscalerControlCenterParamsFunc
+func (m *MockNetscalerService) NewDeleteNetscalerControlCenterParams(id string) (r0 *cloudstack.DeleteNetscalerControlCenterParams) {
+	m.record("NewDeleteNetscalerControlCenterParams", id)
+	if m.NewDeleteNetscalerControlCenterParamsFunc != nil {
+		return m.NewDeleteNetscalerControlCenterParamsFunc(id)
+	}
+	return cloudstack.NewNetscalerService(nil).NewDeleteNetscalerControlCenterParams(id)
+}
+
+// DeleteNetscalerControlCenter records the call and calls DeleteNetscalerControlCenterFunc, or DeleteNetscalerControlCenterWithContextFunc if only that is set
+func (m *MockNetscalerService) DeleteNetscalerControlCenter(p *cloudstack.DeleteNetscalerControlCenterParams) (r0 *cloudstack.DeleteNetscalerControlCenterResponse, r1 error) {
+	m.record("DeleteNetscalerControlCenter", p)
+	if m.DeleteNetscalerControlCenterFunc != nil {
+		return m.DeleteNetscalerControlCenterFunc(p)
+	}
+	if m.DeleteNetscalerControlCenterWithContextFunc != nil {
+		return m.DeleteNetscalerControlCenterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetscalerService.DeleteNetscalerControlCenter")
+	return
+}
+
+// DeleteNetscalerControlCenterWithContext records the call and calls DeleteNetscalerControlCenterWithContextFunc, or DeleteNetscalerControlCenterFunc if only that is set
+func (m *MockNetscalerService) DeleteNetscalerControlCenterWithContext(ctx context.Context, p *cloudstack.DeleteNetscalerControlCenterParams) (r0 *cloudstack.DeleteNetscalerControlCenterResponse, r1 error) {
+	m.record("DeleteNetscalerControlCenterWithContext", ctx, p)
+	if m.DeleteNetscalerControlCenterWithContextFunc != nil {
+		return m.DeleteNetscalerControlCenterWithContextFunc(ctx, p)
+	}
+	if m.DeleteNetscalerControlCenterFunc != nil {
+		return m.DeleteNetscalerControlCenterFunc(p)
+	}
+	r1 = notMocked("NetscalerService.DeleteNetscalerControlCenterWithContext")
+	return
+}
+
+// NewDeleteServicePackageOfferingParams records the call and calls NewDeleteServicePackageOfferingParamsFunc
+func (m *MockNetscalerService) NewDeleteServicePackageOfferingParams(id string) (r0 *cloudstack.DeleteServicePackageOfferingParams) {
+	m.record("NewDeleteServicePackageOfferingParams", id)
+	if m.NewDeleteServicePackageOfferingParamsFunc != nil {
+		return m.NewDeleteServicePackageOfferingParamsFunc(id)
+	}
+	return cloudstack.NewNetscalerService(nil).NewDeleteServicePackageOfferingParams(id)
+}
+
+// DeleteServicePackageOffering records the call and calls DeleteServicePackageOfferingFunc, or DeleteServicePackageOfferingWithContextFunc if only that is set
+func (m *MockNetscalerService) DeleteServicePackageOffering(p *cloudstack.DeleteServicePackageOfferingParams) (r0 *cloudstack.DeleteServicePackageOfferingResponse, r1 error) {
+	m.record("DeleteServicePackageOffering", p)
+	if m.DeleteServicePackageOfferingFunc != nil {
+		return m.DeleteServicePackageOfferingFunc(p)
+	}
+	if m.DeleteServicePackageOfferingWithContextFunc != nil {
+		return m.DeleteServicePackageOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetscalerService.DeleteServicePackageOffering")
+	return
+}
+
+// DeleteServicePackageOfferingWithContext records the call and calls DeleteServicePackageOfferingWithContextFunc, or DeleteServicePackageOfferingFunc if only that is set
+func (m *MockNetscalerService) DeleteServicePackageOfferingWithContext(ctx context.Context, p *cloudstack.DeleteServicePackageOfferingParams) (r0 *cloudstack.DeleteServicePackageOfferingResponse, r1 error) {
+	m.record("DeleteServicePackageOfferingWithContext", ctx, p)
+	if m.DeleteServicePackageOfferingWithContextFunc != nil {
+		return m.DeleteServicePackageOfferingWithContextFunc(ctx, p)
+	}
+	if m.DeleteServicePackageOfferingFunc != nil {
+		return m.DeleteServicePackageOfferingFunc(p)
+	}
+	r1 = notMocked("NetscalerService.DeleteServicePackageOfferingWithContext")
+	return
+}
+
+// NewDeployNetscalerVpxParams records the call and calls NewDeployNetscalerVpxParamsFunc
+func (m *MockNetscalerService) NewDeployNetscalerVpxParams(serviceofferingid string, templateid string, zoneid string) (r0 *cloudstack.DeployNetscalerVpxParams) {
+	m.record("NewDeployNetscalerVpxParams", serviceofferingid, templateid, zoneid)
+	if m.NewDeployNetscalerVpxParamsFunc != nil {
+		return m.NewDeployNetscalerVpxParamsFunc(serviceofferingid, templateid, zoneid)
+	}
+	return cloudstack.NewNetscalerService(nil).NewDeployNetscalerVpxParams(serviceofferingid, templateid, zoneid)
+}
+
+// DeployNetscalerVpx records the call and calls DeployNetscalerVpxFunc, or DeployNetscalerVpxWithContextFunc if only that is set
+func (m *MockNetscalerService) DeployNetscalerVpx(p *cloudstack.DeployNetscalerVpxParams) (r0 *cloudstack.DeployNetscalerVpxResponse, r1 error) {
+	m.record("DeployNetscalerVpx", p)
+	if m.DeployNetscalerVpxFunc != nil {
+		return m.DeployNetscalerVpxFunc(p)
+	}
+	if m.DeployNetscalerVpxWithContextFunc != nil {
+		return m.DeployNetscalerVpxWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetscalerService.DeployNetscalerVpx")
+	return
+}
+
+// DeployNetscalerVpxWithContext records the call and calls DeployNetscalerVpxWithContextFunc, or DeployNetscalerVpxFunc if only that is set
+func (m *MockNetscalerService) DeployNetscalerVpxWithContext(ctx context.Context, p *cloudstack.DeployNetscalerVpxParams) (r0 *cloudstack.DeployNetscalerVpxResponse, r1 error) {
+	m.record("DeployNetscalerVpxWithContext", ctx, p)
+	if m.DeployNetscalerVpxWithContextFunc != nil {
+		return m.DeployNetscalerVpxWithContextFunc(ctx, p)
+	}
+	if m.DeployNetscalerVpxFunc != nil {
+		return m.DeployNetscalerVpxFunc(p)
+	}
+	r1 = notMocked("NetscalerService.DeployNetscalerVpxWithContext")
+	return
+}
+
+// DeployNetscalerVpxAsync records the call and calls DeployNetscalerVpxAsyncFunc, or DeployNetscalerVpxAsyncWithContextFunc if only that is set
+func (m *MockNetscalerService) DeployNetscalerVpxAsync(p *cloudstack.DeployNetscalerVpxParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeployNetscalerVpxAsync", p)
+	if m.DeployNetscalerVpxAsyncFunc != nil {
+		return m.DeployNetscalerVpxAsyncFunc(p)
+	}
+	if m.DeployNetscalerVpxAsyncWithContextFunc != nil {
+		return m.DeployNetscalerVpxAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetscalerService.DeployNetscalerVpxAsync")
+	return
+}
+
+// DeployNetscalerVpxAsyncWithContext records the call and calls DeployNetscalerVpxAsyncWithContextFunc, or DeployNetscalerVpxAsyncFunc if only that is set
+func (m *MockNetscalerService) DeployNetscalerVpxAsyncWithContext(ctx context.Context, p *cloudstack.DeployNetscalerVpxParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeployNetscalerVpxAsyncWithContext", ctx, p)
+	if m.DeployNetscalerVpxAsyncWithContextFunc != nil {
+		return m.DeployNetscalerVpxAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeployNetscalerVpxAsyncFunc != nil {
+		return m.DeployNetscalerVpxAsyncFunc(p)
+	}
+	r1 = notMocked("NetscalerService.DeployNetscalerVpxAsyncWithContext")
+	return
+}
+
+// NewListNetscalerControlCenterParams records the call and calls NewListNetscalerControlCenterParamsFunc
+func (m *MockNetscalerService) NewListNetscalerControlCenterParams() (r0 *cloudstack.ListNetscalerControlCenterParams) {
+	m.record("NewListNetscalerControlCenterParams")
+	if m.NewListNetscalerControlCenterParamsFunc != nil {
+		return m.NewListNetscalerControlCenterParamsFunc()
+	}
+	return cloudstack.NewNetscalerService(nil).NewListNetscalerControlCenterParams()
+}
+
+// ListNetscalerControlCenter records the call and calls ListNetscalerControlCenterFunc, or ListNetscalerControlCenterWithContextFunc if only that is set
+func (m *MockNetscalerService) ListNetscalerControlCenter(p *cloudstack.ListNetscalerControlCenterParams) (r0 *cloudstack.ListNetscalerControlCenterResponse, r1 error) {
+	m.record("ListNetscalerControlCenter", p)
+	if m.ListNetscalerControlCenterFunc != nil {
+		return m.ListNetscalerControlCenterFunc(p)
+	}
+	if m.ListNetscalerControlCenterWithContextFunc != nil {
+		return m.ListNetscalerControlCenterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetscalerService.ListNetscalerControlCenter")
+	return
+}
+
+// ListNetscalerControlCenterWithContext records the call and calls ListNetscalerControlCenterWithContextFunc, or ListNetscalerControlCenterFunc if only that is set
+func (m *MockNetscalerService) ListNetscalerControlCenterWithContext(ctx context.Context, p *cloudstack.ListNetscalerControlCenterParams) (r0 *cloudstack.ListNetscalerControlCenterResponse, r1 error) {
+	m.record("ListNetscalerControlCenterWithContext", ctx, p)
+	if m.ListNetscalerControlCenterWithContextFunc != nil {
+		return m.ListNetscalerControlCenterWithContextFunc(ctx, p)
+	}
+	if m.ListNetscalerControlCenterFunc != nil {
+		return m.ListNetscalerControlCenterFunc(p)
+	}
+	r1 = notMocked("NetscalerService.ListNetscalerControlCenterWithContext")
+	return
+}
+
+// NewListNetscalerControlCenterPager records the call and calls NewListNetscalerControlCenterPagerFunc
+func (m *MockNetscalerService) NewListNetscalerControlCenterPager(p *cloudstack.ListNetscalerControlCenterParams) (r0 *cloudstack.ListNetscalerControlCenterPager) {
+	m.record("NewListNetscalerControlCenterPager", p)
+	if m.NewListNetscalerControlCenterPagerFunc != nil {
+		return m.NewListNetscalerControlCenterPagerFunc(p)
+	}
+	return
+}
+
+// ListNetscalerControlCenterAll records the call and calls ListNetscalerControlCenterAllFunc, or ListNetscalerControlCenterAllWithContextFunc if only that is set
+func (m *MockNetscalerService) ListNetscalerControlCenterAll(p *cloudstack.ListNetscalerControlCenterParams) (r0 *cloudstack.ListNetscalerControlCenterResponse, r1 error) {
+	m.record("ListNetscalerControlCenterAll", p)
+	if m.ListNetscalerControlCenterAllFunc != nil {
+		return m.ListNetscalerControlCenterAllFunc(p)
+	}
+	if m.ListNetscalerControlCenterAllWithContextFunc != nil {
+		return m.ListNetscalerControlCenterAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetscalerService.ListNetscalerControlCenterAll")
+	return
+}
+
+// ListNetscalerControlCenterAllWithContext records the call and calls ListNetscalerControlCenterAllWithContextFunc, or ListNetscalerControlCenterAllFunc if only that is set
+func (m *MockNetscalerService) ListNetscalerControlCenterAllWithContext(ctx context.Context, p *cloudstack.ListNetscalerControlCenterParams) (r0 *cloudstack.ListNetscalerControlCenterResponse, r1 error) {
+	m.record("ListNetscalerControlCenterAllWithContext", ctx, p)
+	if m.ListNetscalerControlCenterAllWithContextFunc != nil {
+		return m.ListNetscalerControlCenterAllWithContextFunc(ctx, p)
+	}
+	if m.ListNetscalerControlCenterAllFunc != nil {
+		return m.ListNetscalerControlCenterAllFunc(p)
+	}
+	r1 = notMocked("NetscalerService.ListNetscalerControlCenterAllWithContext")
+	return
+}
+
+// NewListRegisteredServicePackagesParams records the call and calls NewListRegisteredServicePackagesParamsFunc
+func (m *MockNetscalerService) NewListRegisteredServicePackagesParams() (r0 *cloudstack.ListRegisteredServicePackagesParams) {
+	m.record("NewListRegisteredServicePackagesParams")
+	if m.NewListRegisteredServicePackagesParamsFunc != nil {
+		return m.NewListRegisteredServicePackagesParamsFunc()
+	}
+	return cloudstack.NewNetscalerService(nil).NewListRegisteredServicePackagesParams()
+}
+
+// GetRegisteredServicePackageID records the call and calls GetRegisteredServicePackageIDFunc, or GetRegisteredServicePackageIDWithContextFunc if only that is set
+func (m *MockNetscalerService) GetRegisteredServicePackageID(keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetRegisteredServicePackageID", keyword, opts)
+	if m.GetRegisteredServicePackageIDFunc != nil {
+		return m.GetRegisteredServicePackageIDFunc(keyword, opts...)
+	}
+	if m.GetRegisteredServicePackageIDWithContextFunc != nil {
+		return m.GetRegisteredServicePackageIDWithContextFunc(context.Background(), keyword, opts...)
+	}
+	r2 = notMocked("NetscalerService.GetRegisteredServicePackageID")
+	return
+}
+
+// GetRegisteredServicePackageIDWithContext records the call and calls GetRegisteredServicePackageIDWithContextFunc, or GetRegisteredServicePackageIDFunc if only that is set
+func (m *MockNetscalerService) GetRegisteredServicePackageIDWithContext(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetRegisteredServicePackageIDWithContext", ctx, keyword, opts)
+	if m.GetRegisteredServicePackageIDWithContextFunc != nil {
+		return m.GetRegisteredServicePackageIDWithContextFunc(ctx, keyword, opts...)
+	}
+	if m.GetRegisteredServicePackageIDFunc != nil {
+		return m.GetRegisteredServicePackageIDFunc(keyword, opts...)
+	}
+	r2 = notMocked("NetscalerService.GetRegisteredServicePackageIDWithContext")
+	return
+}
+
+// ListRegisteredServicePackages records the call and calls ListRegisteredServicePackagesFunc, or ListRegisteredServicePackagesWithContextFunc if only that is set
+func (m *MockNetscalerService) ListRegisteredServicePackages(p *cloudstack.ListRegisteredServicePackagesParams) (r0 *cloudstack.ListRegisteredServicePackagesResponse, r1 error) {
+	m.record("ListRegisteredServicePackages", p)
+	if m.ListRegisteredServicePackagesFunc != nil {
+		return m.ListRegisteredServicePackagesFunc(p)
+	}
+	if m.ListRegisteredServicePackagesWithContextFunc != nil {
+		return m.ListRegisteredServicePackagesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetscalerService.ListRegisteredServicePackages")
+	return
+}
+
+// ListRegisteredServicePackagesWithContext records the call and calls ListRegisteredServicePackagesWithContextFunc, or ListRegisteredServicePackagesFunc if only that is set
+func (m *MockNetscalerService) ListRegisteredServicePackagesWithContext(ctx context.Context, p *cloudstack.ListRegisteredServicePackagesParams) (r0 *cloudstack.ListRegisteredServicePackagesResponse, r1 error) {
+	m.record("ListRegisteredServicePackagesWithContext", ctx, p)
+	if m.ListRegisteredServicePackagesWithContextFunc != nil {
+		return m.ListRegisteredServicePackagesWithContextFunc(ctx, p)
+	}
+	if m.ListRegisteredServicePackagesFunc != nil {
+		return m.ListRegisteredServicePackagesFunc(p)
+	}
+	r1 = notMocked("NetscalerService.ListRegisteredServicePackagesWithContext")
+	return
+}
+
+// NewListRegisteredServicePackagesPager records the call and calls NewListRegisteredServicePackagesPagerFunc
+func (m *MockNetscalerService) NewListRegisteredServicePackagesPager(p *cloudstack.ListRegisteredServicePackagesParams) (r0 *cloudstack.ListRegisteredServicePackagesPager) {
+	m.record("NewListRegisteredServicePackagesPager", p)
+	if m.NewListRegisteredServicePackagesPagerFunc != nil {
+		return m.NewListRegisteredServicePackagesPagerFunc(p)
+	}
+	return
+}
+
+// ListRegisteredServicePackagesAll records the call and calls ListRegisteredServicePackagesAllFunc, or ListRegisteredServicePackagesAllWithContextFunc if only that is set
+func (m *MockNetscalerService) ListRegisteredServicePackagesAll(p *cloudstack.ListRegisteredServicePackagesParams) (r0 *cloudstack.ListRegisteredServicePackagesResponse, r1 error) {
+	m.record("ListRegisteredServicePackagesAll", p)
+	if m.ListRegisteredServicePackagesAllFunc != nil {
+		return m.ListRegisteredServicePackagesAllFunc(p)
+	}
+	if m.ListRegisteredServicePackagesAllWithContextFunc != nil {
+		return m.ListRegisteredServicePackagesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetscalerService.ListRegisteredServicePackagesAll")
+	return
+}
+
+// ListRegisteredServicePackagesAllWithContext records the call and calls ListRegisteredServicePackagesAllWithContextFunc, or ListRegisteredServicePackagesAllFunc if only that is set
+func (m *MockNetscalerService) ListRegisteredServicePackagesAllWithContext(ctx context.Context, p *cloudstack.ListRegisteredServicePackagesParams) (r0 *cloudstack.ListRegisteredServicePackagesResponse, r1 error) {
+	m.record("ListRegisteredServicePackagesAllWithContext", ctx, p)
+	if m.ListRegisteredServicePackagesAllWithContextFunc != nil {
+		return m.ListRegisteredServicePackagesAllWithContextFunc(ctx, p)
+	}
+	if m.ListRegisteredServicePackagesAllFunc != nil {
+		return m.ListRegisteredServicePackagesAllFunc(p)
+	}
+	r1 = notMocked("NetscalerService.ListRegisteredServicePackagesAllWithContext")
+	return
+}
+
+// NewRegisterNetscalerControlCenterParams records the call and calls NewRegisterNetscalerControlCenterParamsFunc
+func (m *MockNetscalerService) NewRegisterNetscalerControlCenterParams(ipaddress string, numretries int, password string, username string) (r0 *cloudstack.RegisterNetscalerControlCenterParams) {
+	m.record("NewRegisterNetscalerControlCenterParams", ipaddress, numretries, password, username)
+	if m.NewRegisterNetscalerControlCenterParamsFunc != nil {
+		return m.NewRegisterNetscalerControlCenterParamsFunc(ipaddress, numretries, password, username)
+	}
+	return cloudstack.NewNetscalerService(nil).NewRegisterNetscalerControlCenterParams(ipaddress, numretries, password, username)
+}
+
+// RegisterNetscalerControlCenter records the call and calls RegisterNetscalerControlCenterFunc, or RegisterNetscalerControlCenterWithContextFunc if only that is set
+func (m *MockNetscalerService) RegisterNetscalerControlCenter(p *cloudstack.RegisterNetscalerControlCenterParams) (r0 *cloudstack.RegisterNetscalerControlCenterResponse, r1 error) {
+	m.record("RegisterNetscalerControlCenter", p)
+	if m.RegisterNetscalerControlCenterFunc != nil {
+		return m.RegisterNetscalerControlCenterFunc(p)
+	}
+	if m.RegisterNetscalerControlCenterWithContextFunc != nil {
+		return m.RegisterNetscalerControlCenterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetscalerService.RegisterNetscalerControlCenter")
+	return
+}
+
+// RegisterNetscalerControlCenterWithContext records the call and calls RegisterNetscalerControlCenterWithContextFunc, or RegisterNetscalerControlCenterFunc if only that is set
+func (m *MockNetscalerService) RegisterNetscalerControlCenterWithContext(ctx context.Context, p *cloudstack.RegisterNetscalerControlCenterParams) (r0 *cloudstack.RegisterNetscalerControlCenterResponse, r1 error) {
+	m.record("RegisterNetscalerControlCenterWithContext", ctx, p)
+	if m.RegisterNetscalerControlCenterWithContextFunc != nil {
+		return m.RegisterNetscalerControlCenterWithContextFunc(ctx, p)
+	}
+	if m.RegisterNetscalerControlCenterFunc != nil {
+		return m.RegisterNetscalerControlCenterFunc(p)
+	}
+	r1 = notMocked("NetscalerService.RegisterNetscalerControlCenterWithContext")
+	return
+}
+
+// RegisterNetscalerControlCenterAsync records the call and calls RegisterNetscalerControlCenterAsyncFunc, or RegisterNetscalerControlCenterAsyncWithContextFunc if only that is set
+func (m *MockNetscalerService) RegisterNetscalerControlCenterAsync(p *cloudstack.RegisterNetscalerControlCenterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RegisterNetscalerControlCenterAsync", p)
+	if m.RegisterNetscalerControlCenterAsyncFunc != nil {
+		return m.RegisterNetscalerControlCenterAsyncFunc(p)
+	}
+	if m.RegisterNetscalerControlCenterAsyncWithContextFunc != nil {
+		return m.RegisterNetscalerControlCenterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetscalerService.RegisterNetscalerControlCenterAsync")
+	return
+}
+
+// RegisterNetscalerControlCenterAsyncWithContext records the call and calls RegisterNetscalerControlCenterAsyncWithContextFunc, or RegisterNetscalerControlCenterAsyncFunc if only that is set
+func (m *MockNetscalerService) RegisterNetscalerControlCenterAsyncWithContext(ctx context.Context, p *cloudstack.RegisterNetscalerControlCenterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RegisterNetscalerControlCenterAsyncWithContext", ctx, p)
+	if m.RegisterNetscalerControlCenterAsyncWithContextFunc != nil {
+		return m.RegisterNetscalerControlCenterAsyncWithContextFunc(ctx, p)
+	}
+	if m.RegisterNetscalerControlCenterAsyncFunc != nil {
+		return m.RegisterNetscalerControlCenterAsyncFunc(p)
+	}
+	r1 = notMocked("NetscalerService.RegisterNetscalerControlCenterAsyncWithContext")
+	return
+}
+
+// NewRegisterNetscalerServicePackageParams records the call and calls NewRegisterNetscalerServicePackageParamsFunc
+func (m *MockNetscalerService) NewRegisterNetscalerServicePackageParams(description string, name string) (r0 *cloudstack.RegisterNetscalerServicePackageParams) {
+	m.record("NewRegisterNetscalerServicePackageParams", description, name)
+	if m.NewRegisterNetscalerServicePackageParamsFunc != nil {
+		return m.NewRegisterNetscalerServicePackageParamsFunc(description, name)
+	}
+	return cloudstack.NewNetscalerService(nil).NewRegisterNetscalerServicePackageParams(description, name)
+}
+
+// RegisterNetscalerServicePackage records the call and calls RegisterNetscalerServicePackageFunc, or RegisterNetscalerServicePackageWithContextFunc if only that is set
+func (m *MockNetscalerService) RegisterNetscalerServicePackage(p *cloudstack.RegisterNetscalerServicePackageParams) (r0 *cloudstack.RegisterNetscalerServicePackageResponse, r1 error) {
+	m.record("RegisterNetscalerServicePackage", p)
+	if m.RegisterNetscalerServicePackageFunc != nil {
+		return m.RegisterNetscalerServicePackageFunc(p)
+	}
+	if m.RegisterNetscalerServicePackageWithContextFunc != nil {
+		return m.RegisterNetscalerServicePackageWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetscalerService.RegisterNetscalerServicePackage")
+	return
+}
+
+// RegisterNetscalerServicePackageWithContext records the call and calls RegisterNetscalerServicePackageWithContextFunc, or RegisterNetscalerServicePackageFunc if only that is set
+func (m *MockNetscalerService) RegisterNetscalerServicePackageWithContext(ctx context.Context, p *cloudstack.RegisterNetscalerServicePackageParams) (r0 *cloudstack.RegisterNetscalerServicePackageResponse, r1 error) {
+	m.record("RegisterNetscalerServicePackageWithContext", ctx, p)
+	if m.RegisterNetscalerServicePackageWithContextFunc != nil {
+		return m.RegisterNetscalerServicePackageWithContextFunc(ctx, p)
+	}
+	if m.RegisterNetscalerServicePackageFunc != nil {
+		return m.RegisterNetscalerServicePackageFunc(p)
+	}
+	r1 = notMocked("NetscalerService.RegisterNetscalerServicePackageWithContext")
+	return
+}
+
+// NewStopNetScalerVpxParams records the call and calls NewStopNetScalerVpxParamsFunc
+func (m *MockNetscalerService) NewStopNetScalerVpxParams(id string) (r0 *cloudstack.StopNetScalerVpxParams) {
+	m.record("NewStopNetScalerVpxParams", id)
+	if m.NewStopNetScalerVpxParamsFunc != nil {
+		return m.NewStopNetScalerVpxParamsFunc(id)
+	}
+	return cloudstack.NewNetscalerService(nil).NewStopNetScalerVpxParams(id)
+}
+
+// StopNetScalerVpx records the call and calls StopNetScalerVpxFunc, or StopNetScalerVpxWithContextFunc if only that is set
+func (m *MockNetscalerService) StopNetScalerVpx(p *cloudstack.StopNetScalerVpxParams) (r0 *cloudstack.StopNetScalerVpxResponse, r1 error) {
+	m.record("StopNetScalerVpx", p)
+	if m.StopNetScalerVpxFunc != nil {
+		return m.StopNetScalerVpxFunc(p)
+	}
+	if m.StopNetScalerVpxWithContextFunc != nil {
+		return m.StopNetScalerVpxWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetscalerService.StopNetScalerVpx")
+	return
+}
+
+// StopNetScalerVpxWithContext records the call and calls StopNetScalerVpxWithContextFunc, or StopNetScalerVpxFunc if only that is set
+func (m *MockNetscalerService) StopNetScalerVpxWithContext(ctx context.Context, p *cloudstack.StopNetScalerVpxParams) (r0 *cloudstack.StopNetScalerVpxResponse, r1 error) {
+	m.record("StopNetScalerVpxWithContext", ctx, p)
+	if m.StopNetScalerVpxWithContextFunc != nil {
+		return m.StopNetScalerVpxWithContextFunc(ctx, p)
+	}
+	if m.StopNetScalerVpxFunc != nil {
+		return m.StopNetScalerVpxFunc(p)
+	}
+	r1 = notMocked("NetscalerService.StopNetScalerVpxWithContext")
+	return
+}
+
+// StopNetScalerVpxAsync records the call and calls StopNetScalerVpxAsyncFunc, or StopNetScalerVpxAsyncWithContextFunc if only that is set
+func (m *MockNetscalerService) StopNetScalerVpxAsync(p *cloudstack.StopNetScalerVpxParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StopNetScalerVpxAsync", p)
+	if m.StopNetScalerVpxAsyncFunc != nil {
+		return m.StopNetScalerVpxAsyncFunc(p)
+	}
+	if m.StopNetScalerVpxAsyncWithContextFunc != nil {
+		return m.StopNetScalerVpxAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetscalerService.StopNetScalerVpxAsync")
+	return
+}
+
+// StopNetScalerVpxAsyncWithContext records the call and calls StopNetScalerVpxAsyncWithContextFunc, or StopNetScalerVpxAsyncFunc if only that is set
+func (m *MockNetscalerService) StopNetScalerVpxAsyncWithContext(ctx context.Context, p *cloudstack.StopNetScalerVpxParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StopNetScalerVpxAsyncWithContext", ctx, p)
+	if m.StopNetScalerVpxAsyncWithContextFunc != nil {
+		return m.StopNetScalerVpxAsyncWithContextFunc(ctx, p)
+	}
+	if m.StopNetScalerVpxAsyncFunc != nil {
+		return m.StopNetScalerVpxAsyncFunc(p)
+	}
+	r1 = notMocked("NetscalerService.StopNetScalerVpxAsyncWithContext")
+	return
+}
@@ -0,0 +1,831 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockNetworkACLService is a mock of cloudstack.NetworkACLServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockNetworkACLService struct {
+	Recorder
+
+	NewCreateNetworkACLParamsFunc             func(protocol string) *cloudstack.CreateNetworkACLParams
+	CreateNetworkACLFunc                      func(p *cloudstack.CreateNetworkACLParams) (*cloudstack.CreateNetworkACLResponse, error)
+	CreateNetworkACLWithContextFunc           func(ctx context.Context, p *cloudstack.CreateNetworkACLParams) (*cloudstack.CreateNetworkACLResponse, error)
+	CreateNetworkACLAsyncFunc                 func(p *cloudstack.CreateNetworkACLParams) (*cloudstack.Job, error)
+	CreateNetworkACLAsyncWithContextFunc      func(ctx context.Context, p *cloudstack.CreateNetworkACLParams) (*cloudstack.Job, error)
+	NewCreateNetworkACLListParamsFunc         func(name string, vpcid string) *cloudstack.CreateNetworkACLListParams
+	CreateNetworkACLListFunc                  func(p *cloudstack.CreateNetworkACLListParams) (*cloudstack.CreateNetworkACLListResponse, error)
+	CreateNetworkACLListWithContextFunc       func(ctx context.Context, p *cloudstack.CreateNetworkACLListParams) (*cloudstack.CreateNetworkACLListResponse, error)
+	CreateNetworkACLListAsyncFunc             func(p *cloudstack.CreateNetworkACLListParams) (*cloudstack.Job, error)
+	CreateNetworkACLListAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.CreateNetworkACLListParams) (*cloudstack.Job, error)
+	NewDeleteNetworkACLParamsFunc             func(id string) *cloudstack.DeleteNetworkACLParams
+	DeleteNetworkACLFunc                      func(p *cloudstack.DeleteNetworkACLParams) (*cloudstack.DeleteNetworkACLResponse, error)
+	DeleteNetworkACLWithContextFunc           func(ctx context.Context, p *cloudstack.DeleteNetworkACLParams) (*cloudstack.DeleteNetworkACLResponse, error)
+	DeleteNetworkACLAsyncFunc                 func(p *cloudstack.DeleteNetworkACLParams) (*cloudstack.Job, error)
+	DeleteNetworkACLAsyncWithContextFunc      func(ctx context.Context, p *cloudstack.DeleteNetworkACLParams) (*cloudstack.Job, error)
+	NewDeleteNetworkACLListParamsFunc         func(id string) *cloudstack.DeleteNetworkACLListParams
+	DeleteNetworkACLListFunc                  func(p *cloudstack.DeleteNetworkACLListParams) (*cloudstack.DeleteNetworkACLListResponse, error)
+	DeleteNetworkACLListWithContextFunc       func(ctx context.Context, p *cloudstack.DeleteNetworkACLListParams) (*cloudstack.DeleteNetworkACLListResponse, error)
+	DeleteNetworkACLListAsyncFunc             func(p *cloudstack.DeleteNetworkACLListParams) (*cloudstack.Job, error)
+	DeleteNetworkACLListAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.DeleteNetworkACLListParams) (*cloudstack.Job, error)
+	NewListNetworkACLListsParamsFunc          func() *cloudstack.ListNetworkACLListsParams
+	GetNetworkACLListIDFunc                   func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetNetworkACLListIDWithContextFunc        func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetNetworkACLListByNameFunc               func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.NetworkACLList, int, error)
+	GetNetworkACLListByNameWithContextFunc    func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.NetworkACLList, int, error)
+	GetNetworkACLListByIDFunc                 func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.NetworkACLList, int, error)
+	GetNetworkACLListByIDWithContextFunc      func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.NetworkACLList, int, error)
+	ListNetworkACLListsFunc                   func(p *cloudstack.ListNetworkACLListsParams) (*cloudstack.ListNetworkACLListsResponse, error)
+	ListNetworkACLListsWithContextFunc        func(ctx context.Context, p *cloudstack.ListNetworkACLListsParams) (*cloudstack.ListNetworkACLListsResponse, error)
+	NewListNetworkACLListsPagerFunc           func(p *cloudstack.ListNetworkACLListsParams) *cloudstack.ListNetworkACLListsPager
+	ListNetworkACLListsAllFunc                func(p *cloudstack.ListNetworkACLListsParams) (*cloudstack.ListNetworkACLListsResponse, error)
+	ListNetworkACLListsAllWithContextFunc     func(ctx context.Context, p *cloudstack.ListNetworkACLListsParams) (*cloudstack.ListNetworkACLListsResponse, error)
+	NewListNetworkACLsParamsFunc              func() *cloudstack.ListNetworkACLsParams
+	GetNetworkACLByIDFunc                     func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.NetworkACL, int, error)
+	GetNetworkACLByIDWithContextFunc          func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.NetworkACL, int, error)
+	ListNetworkACLsFunc                       func(p *cloudstack.ListNetworkACLsParams) (*cloudstack.ListNetworkACLsResponse, error)
+	ListNetworkACLsWithContextFunc            func(ctx context.Context, p *cloudstack.ListNetworkACLsParams) (*cloudstack.ListNetworkACLsResponse, error)
+	NewListNetworkACLsPagerFunc               func(p *cloudstack.ListNetworkACLsParams) *cloudstack.ListNetworkACLsPager
+	ListNetworkACLsAllFunc                    func(p *cloudstack.ListNetworkACLsParams) (*cloudstack.ListNetworkACLsResponse, error)
+	ListNetworkACLsAllWithContextFunc         func(ctx context.Context, p *cloudstack.ListNetworkACLsParams) (*cloudstack.ListNetworkACLsResponse, error)
+	NewMoveNetworkAclItemParamsFunc           func(id string) *cloudstack.MoveNetworkAclItemParams
+	MoveNetworkAclItemFunc                    func(p *cloudstack.MoveNetworkAclItemParams) (*cloudstack.MoveNetworkAclItemResponse, error)
+	MoveNetworkAclItemWithContextFunc         func(ctx context.Context, p *cloudstack.MoveNetworkAclItemParams) (*cloudstack.MoveNetworkAclItemResponse, error)
+	MoveNetworkAclItemAsyncFunc               func(p *cloudstack.MoveNetworkAclItemParams) (*cloudstack.Job, error)
+	MoveNetworkAclItemAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.MoveNetworkAclItemParams) (*cloudstack.Job, error)
+	NewReplaceNetworkACLListParamsFunc        func(aclid string) *cloudstack.ReplaceNetworkACLListParams
+	ReplaceNetworkACLListFunc                 func(p *cloudstack.ReplaceNetworkACLListParams) (*cloudstack.ReplaceNetworkACLListResponse, error)
+	ReplaceNetworkACLListWithContextFunc      func(ctx context.Context, p *cloudstack.ReplaceNetworkACLListParams) (*cloudstack.ReplaceNetworkACLListResponse, error)
+	ReplaceNetworkACLListAsyncFunc            func(p *cloudstack.ReplaceNetworkACLListParams) (*cloudstack.Job, error)
+	ReplaceNetworkACLListAsyncWithContextFunc func(ctx context.Context, p *cloudstack.ReplaceNetworkACLListParams) (*cloudstack.Job, error)
+	NewUpdateNetworkACLItemParamsFunc         func(id string) *cloudstack.UpdateNetworkACLItemParams
+	UpdateNetworkACLItemFunc                  func(p *cloudstack.UpdateNetworkACLItemParams) (*cloudstack.UpdateNetworkACLItemResponse, error)
+	UpdateNetworkACLItemWithContextFunc       func(ctx context.Context, p *cloudstack.UpdateNetworkACLItemParams) (*cloudstack.UpdateNetworkACLItemResponse, error)
+	UpdateNetworkACLItemAsyncFunc             func(p *cloudstack.UpdateNetworkACLItemParams) (*cloudstack.Job, error)
+	UpdateNetworkACLItemAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.UpdateNetworkACLItemParams) (*cloudstack.Job, error)
+	NewUpdateNetworkACLListParamsFunc         func(id string) *cloudstack.UpdateNetworkACLListParams
+	UpdateNetworkACLListFunc                  func(p *cloudstack.UpdateNetworkACLListParams) (*cloudstack.UpdateNetworkACLListResponse, error)
+	UpdateNetworkACLListWithContextFunc       func(ctx context.Context, p *cloudstack.UpdateNetworkACLListParams) (*cloudstack.UpdateNetworkACLListResponse, error)
+	UpdateNetworkACLListAsyncFunc             func(p *cloudstack.UpdateNetworkACLListParams) (*cloudstack.Job, error)
+	UpdateNetworkACLListAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.UpdateNetworkACLListParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.NetworkACLServiceIface = (*MockNetworkACLService)(nil)
+
+// NewCreateNetworkACLParams records the call and calls NewCreateNetworkACLParamsFunc
+func (m *MockNetworkACLService) NewCreateNetworkACLParams(protocol string) (r0 *cloudstack.CreateNetworkACLParams) {
+	m.record("NewCreateNetworkACLParams", protocol)
+	if m.NewCreateNetworkACLParamsFunc != nil {
+		return m.NewCreateNetworkACLParamsFunc(protocol)
+	}
+	return cloudstack.NewNetworkACLService(nil).NewCreateNetworkACLParams(protocol)
+}
+
+// CreateNetworkACL records the call and calls CreateNetworkACLFunc, or CreateNetworkACLWithContextFunc if only that is set
+func (m *MockNetworkACLService) CreateNetworkACL(p *cloudstack.CreateNetworkACLParams) (r0 *cloudstack.CreateNetworkACLResponse, r1 error) {
+	m.record("CreateNetworkACL", p)
+	if m.CreateNetworkACLFunc != nil {
+		return m.CreateNetworkACLFunc(p)
+	}
+	if m.CreateNetworkACLWithContextFunc != nil {
+		return m.CreateNetworkACLWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.CreateNetworkACL")
+	return
+}
+
+// CreateNetworkACLWithContext records the call and calls CreateNetworkACLWithContextFunc, or CreateNetworkACLFunc if only that is set
+func (m *MockNetworkACLService) CreateNetworkACLWithContext(ctx context.Context, p *cloudstack.CreateNetworkACLParams) (r0 *cloudstack.CreateNetworkACLResponse, r1 error) {
+	m.record("CreateNetworkACLWithContext", ctx, p)
+	if m.CreateNetworkACLWithContextFunc != nil {
+		return m.CreateNetworkACLWithContextFunc(ctx, p)
+	}
+	if m.CreateNetworkACLFunc != nil {
+		return m.CreateNetworkACLFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.CreateNetworkACLWithContext")
+	return
+}
+
+// CreateNetworkACLAsync records the call and calls CreateNetworkACLAsyncFunc, or CreateNetworkACLAsyncWithContextFunc if only that is set
+func (m *MockNetworkACLService) CreateNetworkACLAsync(p *cloudstack.CreateNetworkACLParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateNetworkACLAsync", p)
+	if m.CreateNetworkACLAsyncFunc != nil {
+		return m.CreateNetworkACLAsyncFunc(p)
+	}
+	if m.CreateNetworkACLAsyncWithContextFunc != nil {
+		return m.CreateNetworkACLAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.CreateNetworkACLAsync")
+	return
+}
+
+// CreateNetworkACLAsyncWithContext records the call and calls CreateNetworkACLAsyncWithContextFunc, or CreateNetworkACLAsyncFunc if only that is set
+func (m *MockNetworkACLService) CreateNetworkACLAsyncWithContext(ctx context.Context, p *cloudstack.CreateNetworkACLParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateNetworkACLAsyncWithContext", ctx, p)
+	if m.CreateNetworkACLAsyncWithContextFunc != nil {
+		return m.CreateNetworkACLAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateNetworkACLAsyncFunc != nil {
+		return m.CreateNetworkACLAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.CreateNetworkACLAsyncWithContext")
+	return
+}
+
+// NewCreateNetworkACLListParams records the call and calls NewCreateNetworkACLListParamsFunc
+func (m *MockNetworkACLService) NewCreateNetworkACLListParams(name string, vpcid string) (r0 *cloudstack.CreateNetworkACLListParams) {
+	m.record("NewCreateNetworkACLListParams", name, vpcid)
+	if m.NewCreateNetworkACLListParamsFunc != nil {
+		return m.NewCreateNetworkACLListParamsFunc(name, vpcid)
+	}
+	return cloudstack.NewNetworkACLService(nil).NewCreateNetworkACLListParams(name, vpcid)
+}
+
+// CreateNetworkACLList records the call and calls CreateNetworkACLListFunc, or CreateNetworkACLListWithContextFunc if only that is set
+func (m *MockNetworkACLService) CreateNetworkACLList(p *cloudstack.CreateNetworkACLListParams) (r0 *cloudstack.CreateNetworkACLListResponse, r1 error) {
+	m.record("CreateNetworkACLList", p)
+	if m.CreateNetworkACLListFunc != nil {
+		return m.CreateNetworkACLListFunc(p)
+	}
+	if m.CreateNetworkACLListWithContextFunc != nil {
+		return m.CreateNetworkACLListWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.CreateNetworkACLList")
+	return
+}
+
+// CreateNetworkACLListWithContext records the call and calls CreateNetworkACLListWithContextFunc, or CreateNetworkACLListFunc if only that is set
+func (m *MockNetworkACLService) CreateNetworkACLListWithContext(ctx context.Context, p *cloudstack.CreateNetworkACLListParams) (r0 *cloudstack.CreateNetworkACLListResponse, r1 error) {
+	m.record("CreateNetworkACLListWithContext", ctx, p)
+	if m.CreateNetworkACLListWithContextFunc != nil {
+		return m.CreateNetworkACLListWithContextFunc(ctx, p)
+	}
+	if m.CreateNetworkACLListFunc != nil {
+		return m.CreateNetworkACLListFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.CreateNetworkACLListWithContext")
+	return
+}
+
+// CreateNetworkACLListAsync records the call and calls CreateNetworkACLListAsyncFunc, or CreateNetworkACLListAsyncWithContextFunc if only that is set
+func (m *MockNetworkACLService) CreateNetworkACLListAsync(p *cloudstack.CreateNetworkACLListParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateNetworkACLListAsync", p)
+	if m.CreateNetworkACLListAsyncFunc != nil {
+		return m.CreateNetworkACLListAsyncFunc(p)
+	}
+	if m.CreateNetworkACLListAsyncWithContextFunc != nil {
+		return m.CreateNetworkACLListAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.CreateNetworkACLListAsync")
+	return
+}
+
+// CreateNetworkACLListAsyncWithContext records the call and calls CreateNetworkACLListAsyncWithContextFunc, or CreateNetworkACLListAsyncFunc if only that is set
+func (m *MockNetworkACLService) CreateNetworkACLListAsyncWithContext(ctx context.Context, p *cloudstack.CreateNetworkACLListParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateNetworkACLListAsyncWithContext", ctx, p)
+	if m.CreateNetworkACLListAsyncWithContextFunc != nil {
+		return m.CreateNetworkACLListAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateNetworkACLListAsyncFunc != nil {
+		return m.CreateNetworkACLListAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.CreateNetworkACLListAsyncWithContext")
+	return
+}
+
+// NewDeleteNetworkACLParams records the call and calls NewDeleteNetworkACLParamsFunc
+func (m *MockNetworkACLService) NewDeleteNetworkACLParams(id string) (r0 *cloudstack.DeleteNetworkACLParams) {
+	m.record("NewDeleteNetworkACLParams", id)
+	if m.NewDeleteNetworkACLParamsFunc != nil {
+		return m.NewDeleteNetworkACLParamsFunc(id)
+	}
+	return cloudstack.NewNetworkACLService(nil).NewDeleteNetworkACLParams(id)
+}
+
+// DeleteNetworkACL records the call and calls DeleteNetworkACLFunc, or DeleteNetworkACLWithContextFunc if only that is set
+func (m *MockNetworkACLService) DeleteNetworkACL(p *cloudstack.DeleteNetworkACLParams) (r0 *cloudstack.DeleteNetworkACLResponse, r1 error) {
+	m.record("DeleteNetworkACL", p)
+	if m.DeleteNetworkACLFunc != nil {
+		return m.DeleteNetworkACLFunc(p)
+	}
+	if m.DeleteNetworkACLWithContextFunc != nil {
+		return m.DeleteNetworkACLWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.DeleteNetworkACL")
+	return
+}
+
+// DeleteNetworkACLWithContext records the call and calls DeleteNetworkACLWithContextFunc, or DeleteNetworkACLFunc if only that is set
+func (m *MockNetworkACLService) DeleteNetworkACLWithContext(ctx context.Context, p *cloudstack.DeleteNetworkACLParams) (r0 *cloudstack.DeleteNetworkACLResponse, r1 error) {
+	m.record("DeleteNetworkACLWithContext", ctx, p)
+	if m.DeleteNetworkACLWithContextFunc != nil {
+		return m.DeleteNetworkACLWithContextFunc(ctx, p)
+	}
+	if m.DeleteNetworkACLFunc != nil {
+		return m.DeleteNetworkACLFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.DeleteNetworkACLWithContext")
+	return
+}
+
+// DeleteNetworkACLAsync records the call and calls DeleteNetworkACLAsyncFunc, or DeleteNetworkACLAsyncWithContextFunc if only that is set
+func (m *MockNetworkACLService) DeleteNetworkACLAsync(p *cloudstack.DeleteNetworkACLParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteNetworkACLAsync", p)
+	if m.DeleteNetworkACLAsyncFunc != nil {
+		return m.DeleteNetworkACLAsyncFunc(p)
+	}
+	if m.DeleteNetworkACLAsyncWithContextFunc != nil {
+		return m.DeleteNetworkACLAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.DeleteNetworkACLAsync")
+	return
+}
+
+// DeleteNetworkACLAsyncWithContext records the call and calls DeleteNetworkACLAsyncWithContextFunc, or DeleteNetworkACLAsyncFunc if only that is set
+func (m *MockNetworkACLService) DeleteNetworkACLAsyncWithContext(ctx context.Context, p *cloudstack.DeleteNetworkACLParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteNetworkACLAsyncWithContext", ctx, p)
+	if m.DeleteNetworkACLAsyncWithContextFunc != nil {
+		return m.DeleteNetworkACLAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteNetworkACLAsyncFunc != nil {
+		return m.DeleteNetworkACLAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.DeleteNetworkACLAsyncWithContext")
+	return
+}
+
+// NewDeleteNetworkACLListParams records the call and calls NewDeleteNetworkACLListParamsFunc
+func (m *MockNetworkACLService) NewDeleteNetworkACLListParams(id string) (r0 *cloudstack.DeleteNetworkACLListParams) {
+	m.record("NewDeleteNetworkACLListParams", id)
+	if m.NewDeleteNetworkACLListParamsFunc != nil {
+		return m.NewDeleteNetworkACLListParamsFunc(id)
+	}
+	return cloudstack.NewNetworkACLService(nil).NewDeleteNetworkACLListParams(id)
+}
+
+// DeleteNetworkACLList records the call and calls DeleteNetworkACLListFunc, or DeleteNetworkACLListWithContextFunc if only that is set
+func (m *MockNetworkACLService) DeleteNetworkACLList(p *cloudstack.DeleteNetworkACLListParams) (r0 *cloudstack.DeleteNetworkACLListResponse, r1 error) {
+	m.record("DeleteNetworkACLList", p)
+	if m.DeleteNetworkACLListFunc != nil {
+		return m.DeleteNetworkACLListFunc(p)
+	}
+	if m.DeleteNetworkACLListWithContextFunc != nil {
+		return m.DeleteNetworkACLListWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.DeleteNetworkACLList")
+	return
+}
+
+// DeleteNetworkACLListWithContext records the call and calls DeleteNetworkACLListWithContextFunc, or DeleteNetworkACLListFunc if only that is set
+func (m *MockNetworkACLService) DeleteNetworkACLListWithContext(ctx context.Context, p *cloudstack.DeleteNetworkACLListParams) (r0 *cloudstack.DeleteNetworkACLListResponse, r1 error) {
+	m.record("DeleteNetworkACLListWithContext", ctx, p)
+	if m.DeleteNetworkACLListWithContextFunc != nil {
+		return m.DeleteNetworkACLListWithContextFunc(ctx, p)
+	}
+	if m.DeleteNetworkACLListFunc != nil {
+		return m.DeleteNetworkACLListFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.DeleteNetworkACLListWithContext")
+	return
+}
+
+// DeleteNetworkACLListAsync records the call and calls DeleteNetworkACLListAsyncFunc, or DeleteNetworkACLListAsyncWithContextFunc if only that is set
+func (m *MockNetworkACLService) DeleteNetworkACLListAsync(p *cloudstack.DeleteNetworkACLListParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteNetworkACLListAsync", p)
+	if m.DeleteNetworkACLListAsyncFunc != nil {
+		return m.DeleteNetworkACLListAsyncFunc(p)
+	}
+	if m.DeleteNetworkACLListAsyncWithContextFunc != nil {
+		return m.DeleteNetworkACLListAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.DeleteNetworkACLListAsync")
+	return
+}
+
+// DeleteNetworkACLListAsyncWithContext records the call and calls DeleteNetworkACLListAsyncWithContextFunc, or DeleteNetworkACLListAsyncFunc if only that is set
+func (m *MockNetworkACLService) DeleteNetworkACLListAsyncWithContext(ctx context.Context, p *cloudstack.DeleteNetworkACLListParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteNetworkACLListAsyncWithContext", ctx, p)
+	if m.DeleteNetworkACLListAsyncWithContextFunc != nil {
+		return m.DeleteNetworkACLListAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteNetworkACLListAsyncFunc != nil {
+		return m.DeleteNetworkACLListAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.DeleteNetworkACLListAsyncWithContext")
+	return
+}
+
+// NewListNetworkACLListsParams records the call and calls NewListNetworkACLListsParamsFunc
+func (m *MockNetworkACLService) NewListNetworkACLListsParams() (r0 *cloudstack.ListNetworkACLListsParams) {
+	m.record("NewListNetworkACLListsParams")
+	if m.NewListNetworkACLListsParamsFunc != nil {
+		return m.NewListNetworkACLListsParamsFunc()
+	}
+	return cloudstack.NewNetworkACLService(nil).NewListNetworkACLListsParams()
+}
+
+// GetNetworkACLListID records the call and calls GetNetworkACLListIDFunc, or GetNetworkACLListIDWithContextFunc if only that is set
+func (m *MockNetworkACLService) GetNetworkACLListID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetNetworkACLListID", name, opts)
+	if m.GetNetworkACLListIDFunc != nil {
+		return m.GetNetworkACLListIDFunc(name, opts...)
+	}
+	if m.GetNetworkACLListIDWithContextFunc != nil {
+		return m.GetNetworkACLListIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("NetworkACLService.GetNetworkACLListID")
+	return
+}
+
+// GetNetworkACLListIDWithContext records the call and calls GetNetworkACLListIDWithContextFunc, or GetNetworkACLListIDFunc if only that is set
+func (m *MockNetworkACLService) GetNetworkACLListIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetNetworkACLListIDWithContext", ctx, name, opts)
+	if m.GetNetworkACLListIDWithContextFunc != nil {
+		return m.GetNetworkACLListIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetNetworkACLListIDFunc != nil {
+		return m.GetNetworkACLListIDFunc(name, opts...)
+	}
+	r2 = notMocked("NetworkACLService.GetNetworkACLListIDWithContext")
+	return
+}
+
+// GetNetworkACLListByName records the call and calls GetNetworkACLListByNameFunc, or GetNetworkACLListByNameWithContextFunc if only that is set
+func (m *MockNetworkACLService) GetNetworkACLListByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.NetworkACLList, r1 int, r2 error) {
+	m.record("GetNetworkACLListByName", name, opts)
+	if m.GetNetworkACLListByNameFunc != nil {
+		return m.GetNetworkACLListByNameFunc(name, opts...)
+	}
+	if m.GetNetworkACLListByNameWithContextFunc != nil {
+		return m.GetNetworkACLListByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("NetworkACLService.GetNetworkACLListByName")
+	return
+}
+
+// GetNetworkACLListByNameWithContext records the call and calls GetNetworkACLListByNameWithContextFunc, or GetNetworkACLListByNameFunc if only that is set
+func (m *MockNetworkACLService) GetNetworkACLListByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.NetworkACLList, r1 int, r2 error) {
+	m.record("GetNetworkACLListByNameWithContext", ctx, name, opts)
+	if m.GetNetworkACLListByNameWithContextFunc != nil {
+		return m.GetNetworkACLListByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetNetworkACLListByNameFunc != nil {
+		return m.GetNetworkACLListByNameFunc(name, opts...)
+	}
+	r2 = notMocked("NetworkACLService.GetNetworkACLListByNameWithContext")
+	return
+}
+
+// GetNetworkACLListByID records the call and calls GetNetworkACLListByIDFunc, or GetNetworkACLListByIDWithContextFunc if only that is set
+func (m *MockNetworkACLService) GetNetworkACLListByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.NetworkACLList, r1 int, r2 error) {
+	m.record("GetNetworkACLListByID", id, opts)
+	if m.GetNetworkACLListByIDFunc != nil {
+		return m.GetNetworkACLListByIDFunc(id, opts...)
+	}
+	if m.GetNetworkACLListByIDWithContextFunc != nil {
+		return m.GetNetworkACLListByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("NetworkACLService.GetNetworkACLListByID")
+	return
+}
+
+// GetNetworkACLListByIDWithContext records the call and calls GetNetworkACLListByIDWithContextFunc, or GetNetworkACLListByIDFunc if only that is set
+func (m *MockNetworkACLService) GetNetworkACLListByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.NetworkACLList, r1 int, r2 error) {
+	m.record("GetNetworkACLListByIDWithContext", ctx, id, opts)
+	if m.GetNetworkACLListByIDWithContextFunc != nil {
+		return m.GetNetworkACLListByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetNetworkACLListByIDFunc != nil {
+		return m.GetNetworkACLListByIDFunc(id, opts...)
+	}
+	r2 = notMocked("NetworkACLService.GetNetworkACLListByIDWithContext")
+	return
+}
+
+// ListNetworkACLLists records the call and calls ListNetworkACLListsFunc, or ListNetworkACLListsWithContextFunc if only that is set
+func (m *MockNetworkACLService) ListNetworkACLLists(p *cloudstack.ListNetworkACLListsParams) (r0 *cloudstack.ListNetworkACLListsResponse, r1 error) {
+	m.record("ListNetworkACLLists", p)
+	if m.ListNetworkACLListsFunc != nil {
+		return m.ListNetworkACLListsFunc(p)
+	}
+	if m.ListNetworkACLListsWithContextFunc != nil {
+		return m.ListNetworkACLListsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.ListNetworkACLLists")
+	return
+}
+
+// ListNetworkACLListsWithContext records the call and calls ListNetworkACLListsWithContextFunc, or ListNetworkACLListsFunc if only that is set
+func (m *MockNetworkACLService) ListNetworkACLListsWithContext(ctx context.Context, p *cloudstack.ListNetworkACLListsParams) (r0 *cloudstack.ListNetworkACLListsResponse, r1 error) {
+	m.record("ListNetworkACLListsWithContext", ctx, p)
+	if m.ListNetworkACLListsWithContextFunc != nil {
+		return m.ListNetworkACLListsWithContextFunc(ctx, p)
+	}
+	if m.ListNetworkACLListsFunc != nil {
+		return m.ListNetworkACLListsFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.ListNetworkACLListsWithContext")
+	return
+}
+
+// NewListNetworkACLListsPager records the call and calls NewListNetworkACLListsPagerFunc
+func (m *MockNetworkACLService) NewListNetworkACLListsPager(p *cloudstack.ListNetworkACLListsParams) (r0 *cloudstack.ListNetworkACLListsPager) {
+	m.record("NewListNetworkACLListsPager", p)
+	if m.NewListNetworkACLListsPagerFunc != nil {
+		return m.NewListNetworkACLListsPagerFunc(p)
+	}
+	return
+}
+
+// ListNetworkACLListsAll records the call and calls ListNetworkACLListsAllFunc, or ListNetworkACLListsAllWithContextFunc if only that is set
+func (m *MockNetworkACLService) ListNetworkACLListsAll(p *cloudstack.ListNetworkACLListsParams) (r0 *cloudstack.ListNetworkACLListsResponse, r1 error) {
+	m.record("ListNetworkACLListsAll", p)
+	if m.ListNetworkACLListsAllFunc != nil {
+		return m.ListNetworkACLListsAllFunc(p)
+	}
+	if m.ListNetworkACLListsAllWithContextFunc != nil {
+		return m.ListNetworkACLListsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.ListNetworkACLListsAll")
+	return
+}
+
+// ListNetworkACLListsAllWithContext records the call and calls ListNetworkACLListsAllWithContextFunc, or ListNetworkACLListsAllFunc if only that is set
+func (m *MockNetworkACLService) ListNetworkACLListsAllWithContext(ctx context.Context, p *cloudstack.ListNetworkACLListsParams) (r0 *cloudstack.ListNetworkACLListsResponse, r1 error) {
+	m.record("ListNetworkACLListsAllWithContext", ctx, p)
+	if m.ListNetworkACLListsAllWithContextFunc != nil {
+		return m.ListNetworkACLListsAllWithContextFunc(ctx, p)
+	}
+	if m.ListNetworkACLListsAllFunc != nil {
+		return m.ListNetworkACLListsAllFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.ListNetworkACLListsAllWithContext")
+	return
+}
+
+// NewListNetworkACLsParams records the call and calls NewListNetworkACLsParamsFunc
+func (m *MockNetworkACLService) NewListNetworkACLsParams() (r0 *cloudstack.ListNetworkACLsParams) {
+	m.record("NewListNetworkACLsParams")
+	if m.NewListNetworkACLsParamsFunc != nil {
+		return m.NewListNetworkACLsParamsFunc()
+	}
+	return cloudstack.NewNetworkACLService(nil).NewListNetworkACLsParams()
+}
+
+// GetNetworkACLByID records the call and calls GetNetworkACLByIDFunc, or GetNetworkACLByIDWithContextFunc if only that is set
+func (m *MockNetworkACLService) GetNetworkACLByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.NetworkACL, r1 int, r2 error) {
+	m.record("GetNetworkACLByID", id, opts)
+	if m.GetNetworkACLByIDFunc != nil {
+		return m.GetNetworkACLByIDFunc(id, opts...)
+	}
+	if m.GetNetworkACLByIDWithContextFunc != nil {
+		return m.GetNetworkACLByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("NetworkACLService.GetNetworkACLByID")
+	return
+}
+
+// GetNetworkACLByIDWithContext records the call and calls GetNetworkACLByIDWithContextFunc, or GetNetworkACLByIDFunc if only that is set
+func (m *MockNetworkACLService) GetNetworkACLByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.NetworkACL, r1 int, r2 error) {
+	m.record("GetNetworkACLByIDWithContext", ctx, id, opts)
+	if m.GetNetworkACLByIDWithContextFunc != nil {
+		return m.GetNetworkACLByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetNetworkACLByIDFunc != nil {
+		return m.GetNetworkACLByIDFunc(id, opts...)
+	}
+	r2 = notMocked("NetworkACLService.GetNetworkACLByIDWithContext")
+	return
+}
+
+// ListNetworkACLs records the call and calls ListNetworkACLsFunc, or ListNetworkACLsWithContextFunc if only that is set
+func (m *MockNetworkACLService) ListNetworkACLs(p *cloudstack.ListNetworkACLsParams) (r0 *cloudstack.ListNetworkACLsResponse, r1 error) {
+	m.record("ListNetworkACLs", p)
+	if m.ListNetworkACLsFunc != nil {
+		return m.ListNetworkACLsFunc(p)
+	}
+	if m.ListNetworkACLsWithContextFunc != nil {
+		return m.ListNetworkACLsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.ListNetworkACLs")
+	return
+}
+
+// ListNetworkACLsWithContext records the call and calls ListNetworkACLsWithContextFunc, or ListNetworkACLsFunc if only that is set
+func (m *MockNetworkACLService) ListNetworkACLsWithContext(ctx context.Context, p *cloudstack.ListNetworkACLsParams) (r0 *cloudstack.ListNetworkACLsResponse, r1 error) {
+	m.record("ListNetworkACLsWithContext", ctx, p)
+	if m.ListNetworkACLsWithContextFunc != nil {
+		return m.ListNetworkACLsWithContextFunc(ctx, p)
+	}
+	if m.ListNetworkACLsFunc != nil {
+		return m.ListNetworkACLsFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.ListNetworkACLsWithContext")
+	return
+}
+
+// NewListNetworkACLsPager records the call and calls NewListNetworkACLsPagerFunc
+func (m *MockNetworkACLService) NewListNetworkACLsPager(p *cloudstack.ListNetworkACLsParams) (r0 *cloudstack.ListNetworkACLsPager) {
+	m.record("NewListNetworkACLsPager", p)
+	if m.NewListNetworkACLsPagerFunc != nil {
+		return m.NewListNetworkACLsPagerFunc(p)
+	}
+	return
+}
+
+// ListNetworkACLsAll records the call and calls ListNetworkACLsAllFunc, or ListNetworkACLsAllWithContextFunc if only that is set
+func (m *MockNetworkACLService) ListNetworkACLsAll(p *cloudstack.ListNetworkACLsParams) (r0 *cloudstack.ListNetworkACLsResponse, r1 error) {
+	m.record("ListNetworkACLsAll", p)
+	if m.ListNetworkACLsAllFunc != nil {
+		return m.ListNetworkACLsAllFunc(p)
+	}
+	if m.ListNetworkACLsAllWithContextFunc != nil {
+		return m.ListNetworkACLsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.ListNetworkACLsAll")
+	return
+}
+
+// ListNetworkACLsAllWithContext records the call and calls ListNetworkACLsAllWithContextFunc, or ListNetworkACLsAllFunc if only that is set
+func (m *MockNetworkACLService) ListNetworkACLsAllWithContext(ctx context.Context, p *cloudstack.ListNetworkACLsParams) (r0 *cloudstack.ListNetworkACLsResponse, r1 error) {
+	m.record("ListNetworkACLsAllWithContext", ctx, p)
+	if m.ListNetworkACLsAllWithContextFunc != nil {
+		return m.ListNetworkACLsAllWithContextFunc(ctx, p)
+	}
+	if m.ListNetworkACLsAllFunc != nil {
+		return m.ListNetworkACLsAllFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.ListNetworkACLsAllWithContext")
+	return
+}
+
+// NewMoveNetworkAclItemParams records the call and calls NewMoveNetworkAclItemParamsFunc
+func (m *MockNetworkACLService) NewMoveNetworkAclItemParams(id string) (r0 *cloudstack.MoveNetworkAclItemParams) {
+	m.record("NewMoveNetworkAclItemParams", id)
+	if m.NewMoveNetworkAclItemParamsFunc != nil {
+		return m.NewMoveNetworkAclItemParamsFunc(id)
+	}
+	return cloudstack.NewNetworkACLService(nil).NewMoveNetworkAclItemParams(id)
+}
+
+// MoveNetworkAclItem records the call and calls MoveNetworkAclItemFunc, or MoveNetworkAclItemWithContextFunc if only that is set
+func (m *MockNetworkACLService) MoveNetworkAclItem(p *cloudstack.MoveNetworkAclItemParams) (r0 *cloudstack.MoveNetworkAclItemResponse, r1 error) {
+	m.record("MoveNetworkAclItem", p)
+	if m.MoveNetworkAclItemFunc != nil {
+		return m.MoveNetworkAclItemFunc(p)
+	}
+	if m.MoveNetworkAclItemWithContextFunc != nil {
+		return m.MoveNetworkAclItemWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.MoveNetworkAclItem")
+	return
+}
+
+// MoveNetworkAclItemWithContext records the call and calls MoveNetworkAclItemWithContextFunc, or MoveNetworkAclItemFunc if only that is set
+func (m *MockNetworkACLService) MoveNetworkAclItemWithContext(ctx context.Context, p *cloudstack.MoveNetworkAclItemParams) (r0 *cloudstack.MoveNetworkAclItemResponse, r1 error) {
+	m.record("MoveNetworkAclItemWithContext", ctx, p)
+	if m.MoveNetworkAclItemWithContextFunc != nil {
+		return m.MoveNetworkAclItemWithContextFunc(ctx, p)
+	}
+	if m.MoveNetworkAclItemFunc != nil {
+		return m.MoveNetworkAclItemFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.MoveNetworkAclItemWithContext")
+	return
+}
+
+// MoveNetworkAclItemAsync records the call and calls MoveNetworkAclItemAsyncFunc, or MoveNetworkAclItemAsyncWithContextFunc if only that is set
+func (m *MockNetworkACLService) MoveNetworkAclItemAsync(p *cloudstack.MoveNetworkAclItemParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MoveNetworkAclItemAsync", p)
+	if m.MoveNetworkAclItemAsyncFunc != nil {
+		return m.MoveNetworkAclItemAsyncFunc(p)
+	}
+	if m.MoveNetworkAclItemAsyncWithContextFunc != nil {
+		return m.MoveNetworkAclItemAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.MoveNetworkAclItemAsync")
+	return
+}
+
+// MoveNetworkAclItemAsyncWithContext records the call and calls MoveNetworkAclItemAsyncWithContextFunc, or MoveNetworkAclItemAsyncFunc if only that is set
+func (m *MockNetworkACLService) MoveNetworkAclItemAsyncWithContext(ctx context.Context, p *cloudstack.MoveNetworkAclItemParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MoveNetworkAclItemAsyncWithContext", ctx, p)
+	if m.MoveNetworkAclItemAsyncWithContextFunc != nil {
+		return m.MoveNetworkAclItemAsyncWithContextFunc(ctx, p)
+	}
+	if m.MoveNetworkAclItemAsyncFunc != nil {
+		return m.MoveNetworkAclItemAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.MoveNetworkAclItemAsyncWithContext")
+	return
+}
+
+// NewReplaceNetworkACLListParams records the call and calls NewReplaceNetworkACLListParamsFunc
+func (m *MockNetworkACLService) NewReplaceNetworkACLListParams(aclid string) (r0 *cloudstack.ReplaceNetworkACLListParams) {
+	m.record("NewReplaceNetworkACLListParams", aclid)
+	if m.NewReplaceNetworkACLListParamsFunc != nil {
+		return m.NewReplaceNetworkACLListParamsFunc(aclid)
+	}
+	return cloudstack.NewNetworkACLService(nil).NewReplaceNetworkACLListParams(aclid)
+}
+
+// ReplaceNetworkACLList records the call and calls ReplaceNetworkACLListFunc, or ReplaceNetworkACLListWithContextFunc if only that is set
+func (m *MockNetworkACLService) ReplaceNetworkACLList(p *cloudstack.ReplaceNetworkACLListParams) (r0 *cloudstack.ReplaceNetworkACLListResponse, r1 error) {
+	m.record("ReplaceNetworkACLList", p)
+	if m.ReplaceNetworkACLListFunc != nil {
+		return m.ReplaceNetworkACLListFunc(p)
+	}
+	if m.ReplaceNetworkACLListWithContextFunc != nil {
+		return m.ReplaceNetworkACLListWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.ReplaceNetworkACLList")
+	return
+}
+
+// ReplaceNetworkACLListWithContext records the call and calls ReplaceNetworkACLListWithContextFunc, or ReplaceNetworkACLListFunc if only that is set
+func (m *MockNetworkACLService) ReplaceNetworkACLListWithContext(ctx context.Context, p *cloudstack.ReplaceNetworkACLListParams) (r0 *cloudstack.ReplaceNetworkACLListResponse, r1 error) {
+	m.record("ReplaceNetworkACLListWithContext", ctx, p)
+	if m.ReplaceNetworkACLListWithContextFunc != nil {
+		return m.ReplaceNetworkACLListWithContextFunc(ctx, p)
+	}
+	if m.ReplaceNetworkACLListFunc != nil {
+		return m.ReplaceNetworkACLListFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.ReplaceNetworkACLListWithContext")
+	return
+}
+
+// ReplaceNetworkACLListAsync records the call and calls ReplaceNetworkACLListAsyncFunc, or ReplaceNetworkACLListAsyncWithContextFunc if only that is set
+func (m *MockNetworkACLService) ReplaceNetworkACLListAsync(p *cloudstack.ReplaceNetworkACLListParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReplaceNetworkACLListAsync", p)
+	if m.ReplaceNetworkACLListAsyncFunc != nil {
+		return m.ReplaceNetworkACLListAsyncFunc(p)
+	}
+	if m.ReplaceNetworkACLListAsyncWithContextFunc != nil {
+		return m.ReplaceNetworkACLListAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.ReplaceNetworkACLListAsync")
+	return
+}
+
+// ReplaceNetworkACLListAsyncWithContext records the call and calls ReplaceNetworkACLListAsyncWithContextFunc, or ReplaceNetworkACLListAsyncFunc if only that is set
+func (m *MockNetworkACLService) ReplaceNetworkACLListAsyncWithContext(ctx context.Context, p *cloudstack.ReplaceNetworkACLListParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReplaceNetworkACLListAsyncWithContext", ctx, p)
+	if m.ReplaceNetworkACLListAsyncWithContextFunc != nil {
+		return m.ReplaceNetworkACLListAsyncWithContextFunc(ctx, p)
+	}
+	if m.ReplaceNetworkACLListAsyncFunc != nil {
+		return m.ReplaceNetworkACLListAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.ReplaceNetworkACLListAsyncWithContext")
+	return
+}
+
+// NewUpdateNetworkACLItemParams records the call and calls NewUpdateNetworkACLItemParamsFunc
+func (m *MockNetworkACLService) NewUpdateNetworkACLItemParams(id string) (r0 *cloudstack.UpdateNetworkACLItemParams) {
+	m.record("NewUpdateNetworkACLItemParams", id)
+	if m.NewUpdateNetworkACLItemParamsFunc != nil {
+		return m.NewUpdateNetworkACLItemParamsFunc(id)
+	}
+	return cloudstack.NewNetworkACLService(nil).NewUpdateNetworkACLItemParams(id)
+}
+
+// UpdateNetworkACLItem records the call and calls UpdateNetworkACLItemFunc, or UpdateNetworkACLItemWithContextFunc if only that is set
+func (m *MockNetworkACLService) UpdateNetworkACLItem(p *cloudstack.UpdateNetworkACLItemParams) (r0 *cloudstack.UpdateNetworkACLItemResponse, r1 error) {
+	m.record("UpdateNetworkACLItem", p)
+	if m.UpdateNetworkACLItemFunc != nil {
+		return m.UpdateNetworkACLItemFunc(p)
+	}
+	if m.UpdateNetworkACLItemWithContextFunc != nil {
+		return m.UpdateNetworkACLItemWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.UpdateNetworkACLItem")
+	return
+}
+
+// UpdateNetworkACLItemWithContext records the call and calls UpdateNetworkACLItemWithContextFunc, or UpdateNetworkACLItemFunc if only that is set
+func (m *MockNetworkACLService) UpdateNetworkACLItemWithContext(ctx context.Context, p *cloudstack.UpdateNetworkACLItemParams) (r0 *cloudstack.UpdateNetworkACLItemResponse, r1 error) {
+	m.record("UpdateNetworkACLItemWithContext", ctx, p)
+	if m.UpdateNetworkACLItemWithContextFunc != nil {
+		return m.UpdateNetworkACLItemWithContextFunc(ctx, p)
+	}
+	if m.UpdateNetworkACLItemFunc != nil {
+		return m.UpdateNetworkACLItemFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.UpdateNetworkACLItemWithContext")
+	return
+}
+
+// UpdateNetworkACLItemAsync records the call and calls UpdateNetworkACLItemAsyncFunc, or UpdateNetworkACLItemAsyncWithContextFunc if only that is set
+func (m *MockNetworkACLService) UpdateNetworkACLItemAsync(p *cloudstack.UpdateNetworkACLItemParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateNetworkACLItemAsync", p)
+	if m.UpdateNetworkACLItemAsyncFunc != nil {
+		return m.UpdateNetworkACLItemAsyncFunc(p)
+	}
+	if m.UpdateNetworkACLItemAsyncWithContextFunc != nil {
+		return m.UpdateNetworkACLItemAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.UpdateNetworkACLItemAsync")
+	return
+}
+
+// UpdateNetworkACLItemAsyncWithContext records the call and calls UpdateNetworkACLItemAsyncWithContextFunc, or UpdateNetworkACLItemAsyncFunc if only that is set
+func (m *MockNetworkACLService) UpdateNetworkACLItemAsyncWithContext(ctx context.Context, p *cloudstack.UpdateNetworkACLItemParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateNetworkACLItemAsyncWithContext", ctx, p)
+	if m.UpdateNetworkACLItemAsyncWithContextFunc != nil {
+		return m.UpdateNetworkACLItemAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateNetworkACLItemAsyncFunc != nil {
+		return m.UpdateNetworkACLItemAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.UpdateNetworkACLItemAsyncWithContext")
+	return
+}
+
+// NewUpdateNetworkACLListParams records the call and calls NewUpdateNetworkACLListParamsFunc
+func (m *MockNetworkACLService) NewUpdateNetworkACLListParams(id string) (r0 *cloudstack.UpdateNetworkACLListParams) {
+	m.record("NewUpdateNetworkACLListParams", id)
+	if m.NewUpdateNetworkACLListParamsFunc != nil {
+		return m.NewUpdateNetworkACLListParamsFunc(id)
+	}
+	return cloudstack.NewNetworkACLService(nil).NewUpdateNetworkACLListParams(id)
+}
+
+// UpdateNetworkACLList records the call and calls UpdateNetworkACLListFunc, or UpdateNetworkACLListWithContextFunc if only that is set
+func (m *MockNetworkACLService) UpdateNetworkACLList(p *cloudstack.UpdateNetworkACLListParams) (r0 *cloudstack.UpdateNetworkACLListResponse, r1 error) {
+	m.record("UpdateNetworkACLList", p)
+	if m.UpdateNetworkACLListFunc != nil {
+		return m.UpdateNetworkACLListFunc(p)
+	}
+	if m.UpdateNetworkACLListWithContextFunc != nil {
+		return m.UpdateNetworkACLListWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.UpdateNetworkACLList")
+	return
+}
+
+// UpdateNetworkACLListWithContext records the call and calls UpdateNetworkACLListWithContextFunc, or UpdateNetworkACLListFunc if only that is set
+func (m *MockNetworkACLService) UpdateNetworkACLListWithContext(ctx context.Context, p *cloudstack.UpdateNetworkACLListParams) (r0 *cloudstack.UpdateNetworkACLListResponse, r1 error) {
+	m.record("UpdateNetworkACLListWithContext", ctx, p)
+	if m.UpdateNetworkACLListWithContextFunc != nil {
+		return m.UpdateNetworkACLListWithContextFunc(ctx, p)
+	}
+	if m.UpdateNetworkACLListFunc != nil {
+		return m.UpdateNetworkACLListFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.UpdateNetworkACLListWithContext")
+	return
+}
+
+// UpdateNetworkACLListAsync records the call and calls UpdateNetworkACLListAsyncFunc, or UpdateNetworkACLListAsyncWithContextFunc if only that is set
+func (m *MockNetworkACLService) UpdateNetworkACLListAsync(p *cloudstack.UpdateNetworkACLListParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateNetworkACLListAsync", p)
+	if m.UpdateNetworkACLListAsyncFunc != nil {
+		return m.UpdateNetworkACLListAsyncFunc(p)
+	}
+	if m.UpdateNetworkACLListAsyncWithContextFunc != nil {
+		return m.UpdateNetworkACLListAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkACLService.UpdateNetworkACLListAsync")
+	return
+}
+
+// UpdateNetworkACLListAsyncWithContext records the call and calls UpdateNetworkACLListAsyncWithContextFunc, or UpdateNetworkACLListAsyncFunc if only that is set
+func (m *MockNetworkACLService) UpdateNetworkACLListAsyncWithContext(ctx context.Context, p *cloudstack.UpdateNetworkACLListParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateNetworkACLListAsyncWithContext", ctx, p)
+	if m.UpdateNetworkACLListAsyncWithContextFunc != nil {
+		return m.UpdateNetworkACLListAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateNetworkACLListAsyncFunc != nil {
+		return m.UpdateNetworkACLListAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkACLService.UpdateNetworkACLListAsyncWithContext")
+	return
+}
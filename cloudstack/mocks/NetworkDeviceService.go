@@ -0,0 +1,465 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockNetworkDeviceService is a mock of cloudstack.NetworkDeviceServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockNetworkDeviceService struct {
+	Recorder
+
+	NewAddNetworkDeviceParamsFunc            func() *cloudstack.AddNetworkDeviceParams
+	AddNetworkDeviceFunc                     func(p *cloudstack.AddNetworkDeviceParams) (*cloudstack.AddNetworkDeviceResponse, error)
+	AddNetworkDeviceWithContextFunc          func(ctx context.Context, p *cloudstack.AddNetworkDeviceParams) (*cloudstack.AddNetworkDeviceResponse, error)
+	NewDeleteCiscoNexusVSMParamsFunc         func(id string) *cloudstack.DeleteCiscoNexusVSMParams
+	DeleteCiscoNexusVSMFunc                  func(p *cloudstack.DeleteCiscoNexusVSMParams) (*cloudstack.DeleteCiscoNexusVSMResponse, error)
+	DeleteCiscoNexusVSMWithContextFunc       func(ctx context.Context, p *cloudstack.DeleteCiscoNexusVSMParams) (*cloudstack.DeleteCiscoNexusVSMResponse, error)
+	DeleteCiscoNexusVSMAsyncFunc             func(p *cloudstack.DeleteCiscoNexusVSMParams) (*cloudstack.Job, error)
+	DeleteCiscoNexusVSMAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.DeleteCiscoNexusVSMParams) (*cloudstack.Job, error)
+	NewDeleteNetworkDeviceParamsFunc         func(id string) *cloudstack.DeleteNetworkDeviceParams
+	DeleteNetworkDeviceFunc                  func(p *cloudstack.DeleteNetworkDeviceParams) (*cloudstack.DeleteNetworkDeviceResponse, error)
+	DeleteNetworkDeviceWithContextFunc       func(ctx context.Context, p *cloudstack.DeleteNetworkDeviceParams) (*cloudstack.DeleteNetworkDeviceResponse, error)
+	NewDisableCiscoNexusVSMParamsFunc        func(id string) *cloudstack.DisableCiscoNexusVSMParams
+	DisableCiscoNexusVSMFunc                 func(p *cloudstack.DisableCiscoNexusVSMParams) (*cloudstack.DisableCiscoNexusVSMResponse, error)
+	DisableCiscoNexusVSMWithContextFunc      func(ctx context.Context, p *cloudstack.DisableCiscoNexusVSMParams) (*cloudstack.DisableCiscoNexusVSMResponse, error)
+	DisableCiscoNexusVSMAsyncFunc            func(p *cloudstack.DisableCiscoNexusVSMParams) (*cloudstack.Job, error)
+	DisableCiscoNexusVSMAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DisableCiscoNexusVSMParams) (*cloudstack.Job, error)
+	NewEnableCiscoNexusVSMParamsFunc         func(id string) *cloudstack.EnableCiscoNexusVSMParams
+	EnableCiscoNexusVSMFunc                  func(p *cloudstack.EnableCiscoNexusVSMParams) (*cloudstack.EnableCiscoNexusVSMResponse, error)
+	EnableCiscoNexusVSMWithContextFunc       func(ctx context.Context, p *cloudstack.EnableCiscoNexusVSMParams) (*cloudstack.EnableCiscoNexusVSMResponse, error)
+	EnableCiscoNexusVSMAsyncFunc             func(p *cloudstack.EnableCiscoNexusVSMParams) (*cloudstack.Job, error)
+	EnableCiscoNexusVSMAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.EnableCiscoNexusVSMParams) (*cloudstack.Job, error)
+	NewListCiscoNexusVSMsParamsFunc          func() *cloudstack.ListCiscoNexusVSMsParams
+	ListCiscoNexusVSMsFunc                   func(p *cloudstack.ListCiscoNexusVSMsParams) (*cloudstack.ListCiscoNexusVSMsResponse, error)
+	ListCiscoNexusVSMsWithContextFunc        func(ctx context.Context, p *cloudstack.ListCiscoNexusVSMsParams) (*cloudstack.ListCiscoNexusVSMsResponse, error)
+	NewListCiscoNexusVSMsPagerFunc           func(p *cloudstack.ListCiscoNexusVSMsParams) *cloudstack.ListCiscoNexusVSMsPager
+	ListCiscoNexusVSMsAllFunc                func(p *cloudstack.ListCiscoNexusVSMsParams) (*cloudstack.ListCiscoNexusVSMsResponse, error)
+	ListCiscoNexusVSMsAllWithContextFunc     func(ctx context.Context, p *cloudstack.ListCiscoNexusVSMsParams) (*cloudstack.ListCiscoNexusVSMsResponse, error)
+	NewListNetworkDeviceParamsFunc           func() *cloudstack.ListNetworkDeviceParams
+	ListNetworkDeviceFunc                    func(p *cloudstack.ListNetworkDeviceParams) (*cloudstack.ListNetworkDeviceResponse, error)
+	ListNetworkDeviceWithContextFunc         func(ctx context.Context, p *cloudstack.ListNetworkDeviceParams) (*cloudstack.ListNetworkDeviceResponse, error)
+	NewListNetworkDevicePagerFunc            func(p *cloudstack.ListNetworkDeviceParams) *cloudstack.ListNetworkDevicePager
+	ListNetworkDeviceAllFunc                 func(p *cloudstack.ListNetworkDeviceParams) (*cloudstack.ListNetworkDeviceResponse, error)
+	ListNetworkDeviceAllWithContextFunc      func(ctx context.Context, p *cloudstack.ListNetworkDeviceParams) (*cloudstack.ListNetworkDeviceResponse, error)
+}
+
+var _ cloudstack.NetworkDeviceServiceIface = (*MockNetworkDeviceService)(nil)
+
+// NewAddNetworkDeviceParams records the call and calls NewAddNetworkDeviceParamsFunc
+func (m *MockNetworkDeviceService) NewAddNetworkDeviceParams() (r0 *cloudstack.AddNetworkDeviceParams) {
+	m.record("NewAddNetworkDeviceParams")
+	if m.NewAddNetworkDeviceParamsFunc != nil {
+		return m.NewAddNetworkDeviceParamsFunc()
+	}
+	return cloudstack.NewNetworkDeviceService(nil).NewAddNetworkDeviceParams()
+}
+
+// AddNetworkDevice records the call and calls AddNetworkDeviceFunc, or AddNetworkDeviceWithContextFunc if only that is set
+func (m *MockNetworkDeviceService) AddNetworkDevice(p *cloudstack.AddNetworkDeviceParams) (r0 *cloudstack.AddNetworkDeviceResponse, r1 error) {
+	m.record("AddNetworkDevice", p)
+	if m.AddNetworkDeviceFunc != nil {
+		return m.AddNetworkDeviceFunc(p)
+	}
+	if m.AddNetworkDeviceWithContextFunc != nil {
+		return m.AddNetworkDeviceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkDeviceService.AddNetworkDevice")
+	return
+}
+
+// AddNetworkDeviceWithContext records the call and calls AddNetworkDeviceWithContextFunc, or AddNetworkDeviceFunc if only that is set
+func (m *MockNetworkDeviceService) AddNetworkDeviceWithContext(ctx context.Context, p *cloudstack.AddNetworkDeviceParams) (r0 *cloudstack.AddNetworkDeviceResponse, r1 error) {
+	m.record("AddNetworkDeviceWithContext", ctx, p)
+	if m.AddNetworkDeviceWithContextFunc != nil {
+		return m.AddNetworkDeviceWithContextFunc(ctx, p)
+	}
+	if m.AddNetworkDeviceFunc != nil {
+		return m.AddNetworkDeviceFunc(p)
+	}
+	r1 = notMocked("NetworkDeviceService.AddNetworkDeviceWithContext")
+	return
+}
+
+// NewDeleteCiscoNexusVSMParams records the call and calls NewDeleteCiscoNexusVSMParamsFunc
+func (m *MockNetworkDeviceService) NewDeleteCiscoNexusVSMParams(id string) (r0 *cloudstack.DeleteCiscoNexusVSMParams) {
+	m.record("NewDeleteCiscoNexusVSMParams", id)
+	if m.NewDeleteCiscoNexusVSMParamsFunc != nil {
+		return m.NewDeleteCiscoNexusVSMParamsFunc(id)
+	}
+	return cloudstack.NewNetworkDeviceService(nil).NewDeleteCiscoNexusVSMParams(id)
+}
+
+// DeleteCiscoNexusVSM records the call and calls DeleteCiscoNexusVSMFunc, or DeleteCiscoNexusVSMWithContextFunc if only that is set
+func (m *MockNetworkDeviceService) DeleteCiscoNexusVSM(p *cloudstack.DeleteCiscoNexusVSMParams) (r0 *cloudstack.DeleteCiscoNexusVSMResponse, r1 error) {
+	m.record("DeleteCiscoNexusVSM", p)
+	if m.DeleteCiscoNexusVSMFunc != nil {
+		return m.DeleteCiscoNexusVSMFunc(p)
+	}
+	if m.DeleteCiscoNexusVSMWithContextFunc != nil {
+		return m.DeleteCiscoNexusVSMWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkDeviceService.DeleteCiscoNexusVSM")
+	return
+}
+
+// DeleteCiscoNexusVSMWithContext records the call and calls DeleteCiscoNexusVSMWithContextFunc, or DeleteCiscoNexusVSMFunc if only that is set
+func (m *MockNetworkDeviceService) DeleteCiscoNexusVSMWithContext(ctx context.Context, p *cloudstack.DeleteCiscoNexusVSMParams) (r0 *cloudstack.DeleteCiscoNexusVSMResponse, r1 error) {
+	m.record("DeleteCiscoNexusVSMWithContext", ctx, p)
+	if m.DeleteCiscoNexusVSMWithContextFunc != nil {
+		return m.DeleteCiscoNexusVSMWithContextFunc(ctx, p)
+	}
+	if m.DeleteCiscoNexusVSMFunc != nil {
+		return m.DeleteCiscoNexusVSMFunc(p)
+	}
+	r1 = notMocked("NetworkDeviceService.DeleteCiscoNexusVSMWithContext")
+	return
+}
+
+// DeleteCiscoNexusVSMAsync records the call and calls DeleteCiscoNexusVSMAsyncFunc, or DeleteCiscoNexusVSMAsyncWithContextFunc if only that is set
+func (m *MockNetworkDeviceService) DeleteCiscoNexusVSMAsync(p *cloudstack.DeleteCiscoNexusVSMParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteCiscoNexusVSMAsync", p)
+	if m.DeleteCiscoNexusVSMAsyncFunc != nil {
+		return m.DeleteCiscoNexusVSMAsyncFunc(p)
+	}
+	if m.DeleteCiscoNexusVSMAsyncWithContextFunc != nil {
+		return m.DeleteCiscoNexusVSMAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkDeviceService.DeleteCiscoNexusVSMAsync")
+	return
+}
+
+// DeleteCiscoNexusVSMAsyncWithContext records the call and calls DeleteCiscoNexusVSMAsyncWithContextFunc, or DeleteCiscoNexusVSMAsyncFunc if only that is set
+func (m *MockNetworkDeviceService) DeleteCiscoNexusVSMAsyncWithContext(ctx context.Context, p *cloudstack.DeleteCiscoNexusVSMParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteCiscoNexusVSMAsyncWithContext", ctx, p)
+	if m.DeleteCiscoNexusVSMAsyncWithContextFunc != nil {
+		return m.DeleteCiscoNexusVSMAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteCiscoNexusVSMAsyncFunc != nil {
+		return m.DeleteCiscoNexusVSMAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkDeviceService.DeleteCiscoNexusVSMAsyncWithContext")
+	return
+}
+
+// NewDeleteNetworkDeviceParams records the call and calls NewDeleteNetworkDeviceParamsFunc
+func (m *MockNetworkDeviceService) NewDeleteNetworkDeviceParams(id string) (r0 *cloudstack.DeleteNetworkDeviceParams) {
+	m.record("NewDeleteNetworkDeviceParams", id)
+	if m.NewDeleteNetworkDeviceParamsFunc != nil {
+		return m.NewDeleteNetworkDeviceParamsFunc(id)
+	}
+	return cloudstack.NewNetworkDeviceService(nil).NewDeleteNetworkDeviceParams(id)
+}
+
+// DeleteNetworkDevice records the call and calls DeleteNetworkDeviceFunc, or DeleteNetworkDeviceWithContextFunc if only that is set
+func (m *MockNetworkDeviceService) DeleteNetworkDevice(p *cloudstack.DeleteNetworkDeviceParams) (r0 *cloudstack.DeleteNetworkDeviceResponse, r1 error) {
+	m.record("DeleteNetworkDevice", p)
+	if m.DeleteNetworkDeviceFunc != nil {
+		return m.DeleteNetworkDeviceFunc(p)
+	}
+	if m.DeleteNetworkDeviceWithContextFunc != nil {
+		return m.DeleteNetworkDeviceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkDeviceService.DeleteNetworkDevice")
+	return
+}
+
+// DeleteNetworkDeviceWithContext records the call and calls DeleteNetworkDeviceWithContextFunc, or DeleteNetworkDeviceFunc if only that is set
+func (m *MockNetworkDeviceService) DeleteNetworkDeviceWithContext(ctx context.Context, p *cloudstack.DeleteNetworkDeviceParams) (r0 *cloudstack.DeleteNetworkDeviceResponse, r1 error) {
+	m.record("DeleteNetworkDeviceWithContext", ctx, p)
+	if m.DeleteNetworkDeviceWithContextFunc != nil {
+		return m.DeleteNetworkDeviceWithContextFunc(ctx, p)
+	}
+	if m.DeleteNetworkDeviceFunc != nil {
+		return m.DeleteNetworkDeviceFunc(p)
+	}
+	r1 = notMocked("NetworkDeviceService.DeleteNetworkDeviceWithContext")
+	return
+}
+
+// NewDisableCiscoNexusVSMParams records the call and calls NewDisableCiscoNexusVSMParamsFunc
+func (m *MockNetworkDeviceService) NewDisableCiscoNexusVSMParams(id string) (r0 *cloudstack.DisableCiscoNexusVSMParams) {
+	m.record("NewDisableCiscoNexusVSMParams", id)
+	if m.NewDisableCiscoNexusVSMParamsFunc != nil {
+		return m.NewDisableCiscoNexusVSMParamsFunc(id)
+	}
+	return cloudstack.NewNetworkDeviceService(nil).NewDisableCiscoNexusVSMParams(id)
+}
+
+// DisableCiscoNexusVSM records the call and calls DisableCiscoNexusVSMFunc, or DisableCiscoNexusVSMWithContextFunc if only that is set
+func (m *MockNetworkDeviceService) DisableCiscoNexusVSM(p *cloudstack.DisableCiscoNexusVSMParams) (r0 *cloudstack.DisableCiscoNexusVSMResponse, r1 error) {
+	m.record("DisableCiscoNexusVSM", p)
+	if m.DisableCiscoNexusVSMFunc != nil {
+		return m.DisableCiscoNexusVSMFunc(p)
+	}
+	if m.DisableCiscoNexusVSMWithContextFunc != nil {
+		return m.DisableCiscoNexusVSMWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkDeviceService.DisableCiscoNexusVSM")
+	return
+}
+
+// DisableCiscoNexusVSMWithContext records the call and calls DisableCiscoNexusVSMWithContextFunc, or DisableCiscoNexusVSMFunc if only that is set
+func (m *MockNetworkDeviceService) DisableCiscoNexusVSMWithContext(ctx context.Context, p *cloudstack.DisableCiscoNexusVSMParams) (r0 *cloudstack.DisableCiscoNexusVSMResponse, r1 error) {
+	m.record("DisableCiscoNexusVSMWithContext", ctx, p)
+	if m.DisableCiscoNexusVSMWithContextFunc != nil {
+		return m.DisableCiscoNexusVSMWithContextFunc(ctx, p)
+	}
+	if m.DisableCiscoNexusVSMFunc != nil {
+		return m.DisableCiscoNexusVSMFunc(p)
+	}
+	r1 = notMocked("NetworkDeviceService.DisableCiscoNexusVSMWithContext")
+	return
+}
+
+// DisableCiscoNexusVSMAsync records the call and calls DisableCiscoNexusVSMAsyncFunc, or DisableCiscoNexusVSMAsyncWithContextFunc if only that is set
+func (m *MockNetworkDeviceService) DisableCiscoNexusVSMAsync(p *cloudstack.DisableCiscoNexusVSMParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableCiscoNexusVSMAsync", p)
+	if m.DisableCiscoNexusVSMAsyncFunc != nil {
+		return m.DisableCiscoNexusVSMAsyncFunc(p)
+	}
+	if m.DisableCiscoNexusVSMAsyncWithContextFunc != nil {
+		return m.DisableCiscoNexusVSMAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkDeviceService.DisableCiscoNexusVSMAsync")
+	return
+}
+
+// DisableCiscoNexusVSMAsyncWithContext records the call and calls DisableCiscoNexusVSMAsyncWithContextFunc, or DisableCiscoNexusVSMAsyncFunc if only that is set
+func (m *MockNetworkDeviceService) DisableCiscoNexusVSMAsyncWithContext(ctx context.Context, p *cloudstack.DisableCiscoNexusVSMParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableCiscoNexusVSMAsyncWithContext", ctx, p)
+	if m.DisableCiscoNexusVSMAsyncWithContextFunc != nil {
+		return m.DisableCiscoNexusVSMAsyncWithContextFunc(ctx, p)
+	}
+	if m.DisableCiscoNexusVSMAsyncFunc != nil {
+		return m.DisableCiscoNexusVSMAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkDeviceService.DisableCiscoNexusVSMAsyncWithContext")
+	return
+}
+
+// NewEnableCiscoNexusVSMParams records the call and calls NewEnableCiscoNexusVSMParamsFunc
+func (m *MockNetworkDeviceService) NewEnableCiscoNexusVSMParams(id string) (r0 *cloudstack.EnableCiscoNexusVSMParams) {
+	m.record("NewEnableCiscoNexusVSMParams", id)
+	if m.NewEnableCiscoNexusVSMParamsFunc != nil {
+		return m.NewEnableCiscoNexusVSMParamsFunc(id)
+	}
+	return cloudstack.NewNetworkDeviceService(nil).NewEnableCiscoNexusVSMParams(id)
+}
+
+// EnableCiscoNexusVSM records the call and calls EnableCiscoNexusVSMFunc, or EnableCiscoNexusVSMWithContextFunc if only that is set
+func (m *MockNetworkDeviceService) EnableCiscoNexusVSM(p *cloudstack.EnableCiscoNexusVSMParams) (r0 *cloudstack.EnableCiscoNexusVSMResponse, r1 error) {
+	m.record("EnableCiscoNexusVSM", p)
+	if m.EnableCiscoNexusVSMFunc != nil {
+		return m.EnableCiscoNexusVSMFunc(p)
+	}
+	if m.EnableCiscoNexusVSMWithContextFunc != nil {
+		return m.EnableCiscoNexusVSMWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkDeviceService.EnableCiscoNexusVSM")
+	return
+}
+
+// EnableCiscoNexusVSMWithContext records the call and calls EnableCiscoNexusVSMWithContextFunc, or EnableCiscoNexusVSMFunc if only that is set
+func (m *MockNetworkDeviceService) EnableCiscoNexusVSMWithContext(ctx context.Context, p *cloudstack.EnableCiscoNexusVSMParams) (r0 *cloudstack.EnableCiscoNexusVSMResponse, r1 error) {
+	m.record("EnableCiscoNexusVSMWithContext", ctx, p)
+	if m.EnableCiscoNexusVSMWithContextFunc != nil {
+		return m.EnableCiscoNexusVSMWithContextFunc(ctx, p)
+	}
+	if m.EnableCiscoNexusVSMFunc != nil {
+		return m.EnableCiscoNexusVSMFunc(p)
+	}
+	r1 = notMocked("NetworkDeviceService.EnableCiscoNexusVSMWithContext")
+	return
+}
+
+// EnableCiscoNexusVSMAsync records the call and calls EnableCiscoNexusVSMAsyncFunc, or EnableCiscoNexusVSMAsyncWithContextFunc if only that is set
+func (m *MockNetworkDeviceService) EnableCiscoNexusVSMAsync(p *cloudstack.EnableCiscoNexusVSMParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableCiscoNexusVSMAsync", p)
+	if m.EnableCiscoNexusVSMAsyncFunc != nil {
+		return m.EnableCiscoNexusVSMAsyncFunc(p)
+	}
+	if m.EnableCiscoNexusVSMAsyncWithContextFunc != nil {
+		return m.EnableCiscoNexusVSMAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkDeviceService.EnableCiscoNexusVSMAsync")
+	return
+}
+
+// EnableCiscoNexusVSMAsyncWithContext records the call and calls EnableCiscoNexusVSMAsyncWithContextFunc, or EnableCiscoNexusVSMAsyncFunc if only that is set
+func (m *MockNetworkDeviceService) EnableCiscoNexusVSMAsyncWithContext(ctx context.Context, p *cloudstack.EnableCiscoNexusVSMParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableCiscoNexusVSMAsyncWithContext", ctx, p)
+	if m.EnableCiscoNexusVSMAsyncWithContextFunc != nil {
+		return m.EnableCiscoNexusVSMAsyncWithContextFunc(ctx, p)
+	}
+	if m.EnableCiscoNexusVSMAsyncFunc != nil {
+		return m.EnableCiscoNexusVSMAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkDeviceService.EnableCiscoNexusVSMAsyncWithContext")
+	return
+}
+
+// NewListCiscoNexusVSMsParams records the call and calls NewListCiscoNexusVSMsParamsFunc
+func (m *MockNetworkDeviceService) NewListCiscoNexusVSMsParams() (r0 *cloudstack.ListCiscoNexusVSMsParams) {
+	m.record("NewListCiscoNexusVSMsParams")
+	if m.NewListCiscoNexusVSMsParamsFunc != nil {
+		return m.NewListCiscoNexusVSMsParamsFunc()
+	}
+	return cloudstack.NewNetworkDeviceService(nil).NewListCiscoNexusVSMsParams()
+}
+
+// ListCiscoNexusVSMs records the call and calls ListCiscoNexusVSMsFunc, or ListCiscoNexusVSMsWithContextFunc if only that is set
+func (m *MockNetworkDeviceService) ListCiscoNexusVSMs(p *cloudstack.ListCiscoNexusVSMsParams) (r0 *cloudstack.ListCiscoNexusVSMsResponse, r1 error) {
+	m.record("ListCiscoNexusVSMs", p)
+	if m.ListCiscoNexusVSMsFunc != nil {
+		return m.ListCiscoNexusVSMsFunc(p)
+	}
+	if m.ListCiscoNexusVSMsWithContextFunc != nil {
+		return m.ListCiscoNexusVSMsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkDeviceService.ListCiscoNexusVSMs")
+	return
+}
+
+// ListCiscoNexusVSMsWithContext records the call and calls ListCiscoNexusVSMsWithContextFunc, or ListCiscoNexusVSMsFunc if only that is set
+func (m *MockNetworkDeviceService) ListCiscoNexusVSMsWithContext(ctx context.Context, p *cloudstack.ListCiscoNexusVSMsParams) (r0 *cloudstack.ListCiscoNexusVSMsResponse, r1 error) {
+	m.record("ListCiscoNexusVSMsWithContext", ctx, p)
+	if m.ListCiscoNexusVSMsWithContextFunc != nil {
+		return m.ListCiscoNexusVSMsWithContextFunc(ctx, p)
+	}
+	if m.ListCiscoNexusVSMsFunc != nil {
+		return m.ListCiscoNexusVSMsFunc(p)
+	}
+	r1 = notMocked("NetworkDeviceService.ListCiscoNexusVSMsWithContext")
+	return
+}
+
+// NewListCiscoNexusVSMsPager records the call and calls NewListCiscoNexusVSMsPagerFunc
+func (m *MockNetworkDeviceService) NewListCiscoNexusVSMsPager(p *cloudstack.ListCiscoNexusVSMsParams) (r0 *cloudstack.ListCiscoNexusVSMsPager) {
+	m.record("NewListCiscoNexusVSMsPager", p)
+	if m.NewListCiscoNexusVSMsPagerFunc != nil {
+		return m.NewListCiscoNexusVSMsPagerFunc(p)
+	}
+	return
+}
+
+// ListCiscoNexusVSMsAll records the call and calls ListCiscoNexusVSMsAllFunc, or ListCiscoNexusVSMsAllWithContextFunc if only that is set
+func (m *MockNetworkDeviceService) ListCiscoNexusVSMsAll(p *cloudstack.ListCiscoNexusVSMsParams) (r0 *cloudstack.ListCiscoNexusVSMsResponse, r1 error) {
+	m.record("ListCiscoNexusVSMsAll", p)
+	if m.ListCiscoNexusVSMsAllFunc != nil {
+		return m.ListCiscoNexusVSMsAllFunc(p)
+	}
+	if m.ListCiscoNexusVSMsAllWithContextFunc != nil {
+		return m.ListCiscoNexusVSMsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkDeviceService.ListCiscoNexusVSMsAll")
+	return
+}
+
+// ListCiscoNexusVSMsAllWithContext records the call and calls ListCiscoNexusVSMsAllWithContextFunc, or ListCiscoNexusVSMsAllFunc if only that is set
+func (m *MockNetworkDeviceService) ListCiscoNexusVSMsAllWithContext(ctx context.Context, p *cloudstack.ListCiscoNexusVSMsParams) (r0 *cloudstack.ListCiscoNexusVSMsResponse, r1 error) {
+	m.record("ListCiscoNexusVSMsAllWithContext", ctx, p)
+	if m.ListCiscoNexusVSMsAllWithContextFunc != nil {
+		return m.ListCiscoNexusVSMsAllWithContextFunc(ctx, p)
+	}
+	if m.ListCiscoNexusVSMsAllFunc != nil {
+		return m.ListCiscoNexusVSMsAllFunc(p)
+	}
+	r1 = notMocked("NetworkDeviceService.ListCiscoNexusVSMsAllWithContext")
+	return
+}
+
+// NewListNetworkDeviceParams records the call and calls NewListNetworkDeviceParamsFunc
+func (m *MockNetworkDeviceService) NewListNetworkDeviceParams() (r0 *cloudstack.ListNetworkDeviceParams) {
+	m.record("NewListNetworkDeviceParams")
+	if m.NewListNetworkDeviceParamsFunc != nil {
+		return m.NewListNetworkDeviceParamsFunc()
+	}
+	return cloudstack.NewNetworkDeviceService(nil).NewListNetworkDeviceParams()
+}
+
+// ListNetworkDevice records the call and calls ListNetworkDeviceFunc, or ListNetworkDeviceWithContextFunc if only that is set
+func (m *MockNetworkDeviceService) ListNetworkDevice(p *cloudstack.ListNetworkDeviceParams) (r0 *cloudstack.ListNetworkDeviceResponse, r1 error) {
+	m.record("ListNetworkDevice", p)
+	if m.ListNetworkDeviceFunc != nil {
+		return m.ListNetworkDeviceFunc(p)
+	}
+	if m.ListNetworkDeviceWithContextFunc != nil {
+		return m.ListNetworkDeviceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkDeviceService.ListNetworkDevice")
+	return
+}
+
+// ListNetworkDeviceWithContext records the call and calls ListNetworkDeviceWithContextFunc, or ListNetworkDeviceFunc if only that is set
+func (m *MockNetworkDeviceService) ListNetworkDeviceWithContext(ctx context.Context, p *cloudstack.ListNetworkDeviceParams) (r0 *cloudstack.ListNetworkDeviceResponse, r1 error) {
+	m.record("ListNetworkDeviceWithContext", ctx, p)
+	if m.ListNetworkDeviceWithContextFunc != nil {
+		return m.ListNetworkDeviceWithContextFunc(ctx, p)
+	}
+	if m.ListNetworkDeviceFunc != nil {
+		return m.ListNetworkDeviceFunc(p)
+	}
+	r1 = notMocked("NetworkDeviceService.ListNetworkDeviceWithContext")
+	return
+}
+
+// NewListNetworkDevicePager records the call and calls NewListNetworkDevicePagerFunc
+func (m *MockNetworkDeviceService) NewListNetworkDevicePager(p *cloudstack.ListNetworkDeviceParams) (r0 *cloudstack.ListNetworkDevicePager) {
+	m.record("NewListNetworkDevicePager", p)
+	if m.NewListNetworkDevicePagerFunc != nil {
+		return m.NewListNetworkDevicePagerFunc(p)
+	}
+	return
+}
+
+// ListNetworkDeviceAll records the call and calls ListNetworkDeviceAllFunc, or ListNetworkDeviceAllWithContextFunc if only that is set
+func (m *MockNetworkDeviceService) ListNetworkDeviceAll(p *cloudstack.ListNetworkDeviceParams) (r0 *cloudstack.ListNetworkDeviceResponse, r1 error) {
+	m.record("ListNetworkDeviceAll", p)
+	if m.ListNetworkDeviceAllFunc != nil {
+		return m.ListNetworkDeviceAllFunc(p)
+	}
+	if m.ListNetworkDeviceAllWithContextFunc != nil {
+		return m.ListNetworkDeviceAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkDeviceService.ListNetworkDeviceAll")
+	return
+}
+
+// ListNetworkDeviceAllWithContext records the call and calls ListNetworkDeviceAllWithContextFunc, or ListNetworkDeviceAllFunc if only that is set
+func (m *MockNetworkDeviceService) ListNetworkDeviceAllWithContext(ctx context.Context, p *cloudstack.ListNetworkDeviceParams) (r0 *cloudstack.ListNetworkDeviceResponse, r1 error) {
+	m.record("ListNetworkDeviceAllWithContext", ctx, p)
+	if m.ListNetworkDeviceAllWithContextFunc != nil {
+		return m.ListNetworkDeviceAllWithContextFunc(ctx, p)
+	}
+	if m.ListNetworkDeviceAllFunc != nil {
+		return m.ListNetworkDeviceAllFunc(p)
+	}
+	r1 = notMocked("NetworkDeviceService.ListNetworkDeviceAllWithContext")
+	return
+}
@@ -0,0 +1,313 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockNetworkOfferingService is a mock of cloudstack.NetworkOfferingServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockNetworkOfferingService struct {
+	Recorder
+
+	NewCreateNetworkOfferingParamsFunc      func(displaytext string, guestiptype string, name string, supportedservices []string, traffictype string) *cloudstack.CreateNetworkOfferingParams
+	CreateNetworkOfferingFunc               func(p *cloudstack.CreateNetworkOfferingParams) (*cloudstack.CreateNetworkOfferingResponse, error)
+	CreateNetworkOfferingWithContextFunc    func(ctx context.Context, p *cloudstack.CreateNetworkOfferingParams) (*cloudstack.CreateNetworkOfferingResponse, error)
+	NewDeleteNetworkOfferingParamsFunc      func(id string) *cloudstack.DeleteNetworkOfferingParams
+	DeleteNetworkOfferingFunc               func(p *cloudstack.DeleteNetworkOfferingParams) (*cloudstack.DeleteNetworkOfferingResponse, error)
+	DeleteNetworkOfferingWithContextFunc    func(ctx context.Context, p *cloudstack.DeleteNetworkOfferingParams) (*cloudstack.DeleteNetworkOfferingResponse, error)
+	NewListNetworkOfferingsParamsFunc       func() *cloudstack.ListNetworkOfferingsParams
+	GetNetworkOfferingIDFunc                func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetNetworkOfferingIDWithContextFunc     func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetNetworkOfferingByNameFunc            func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.NetworkOffering, int, error)
+	GetNetworkOfferingByNameWithContextFunc func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.NetworkOffering, int, error)
+	GetNetworkOfferingByIDFunc              func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.NetworkOffering, int, error)
+	GetNetworkOfferingByIDWithContextFunc   func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.NetworkOffering, int, error)
+	ListNetworkOfferingsFunc                func(p *cloudstack.ListNetworkOfferingsParams) (*cloudstack.ListNetworkOfferingsResponse, error)
+	ListNetworkOfferingsWithContextFunc     func(ctx context.Context, p *cloudstack.ListNetworkOfferingsParams) (*cloudstack.ListNetworkOfferingsResponse, error)
+	NewListNetworkOfferingsPagerFunc        func(p *cloudstack.ListNetworkOfferingsParams) *cloudstack.ListNetworkOfferingsPager
+	ListNetworkOfferingsAllFunc             func(p *cloudstack.ListNetworkOfferingsParams) (*cloudstack.ListNetworkOfferingsResponse, error)
+	ListNetworkOfferingsAllWithContextFunc  func(ctx context.Context, p *cloudstack.ListNetworkOfferingsParams) (*cloudstack.ListNetworkOfferingsResponse, error)
+	NewUpdateNetworkOfferingParamsFunc      func() *cloudstack.UpdateNetworkOfferingParams
+	UpdateNetworkOfferingFunc               func(p *cloudstack.UpdateNetworkOfferingParams) (*cloudstack.UpdateNetworkOfferingResponse, error)
+	UpdateNetworkOfferingWithContextFunc    func(ctx context.Context, p *cloudstack.UpdateNetworkOfferingParams) (*cloudstack.UpdateNetworkOfferingResponse, error)
+}
+
+var _ cloudstack.NetworkOfferingServiceIface = (*MockNetworkOfferingService)(nil)
+
+// NewCreateNetworkOfferingParams records the call and calls NewCreateNetworkOfferingParamsFunc
+func (m *MockNetworkOfferingService) NewCreateNetworkOfferingParams(displaytext string, guestiptype string, name string, supportedservices []string, traffictype string) (r0 *cloudstack.CreateNetworkOfferingParams) {
+	m.record("NewCreateNetworkOfferingParams", displaytext, guestiptype, name, supportedservices, traffictype)
+	if m.NewCreateNetworkOfferingParamsFunc != nil {
+		return m.NewCreateNetworkOfferingParamsFunc(displaytext, guestiptype, name, supportedservices, traffictype)
+	}
+	return cloudstack.NewNetworkOfferingService(nil).NewCreateNetworkOfferingParams(displaytext, guestiptype, name, supportedservices, traffictype)
+}
+
+// CreateNetworkOffering records the call and calls CreateNetworkOfferingFunc, or CreateNetworkOfferingWithContextFunc if only that is set
+func (m *MockNetworkOfferingService) CreateNetworkOffering(p *cloudstack.CreateNetworkOfferingParams) (r0 *cloudstack.CreateNetworkOfferingResponse, r1 error) {
+	m.record("CreateNetworkOffering", p)
+	if m.CreateNetworkOfferingFunc != nil {
+		return m.CreateNetworkOfferingFunc(p)
+	}
+	if m.CreateNetworkOfferingWithContextFunc != nil {
+		return m.CreateNetworkOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkOfferingService.CreateNetworkOffering")
+	return
+}
+
+// CreateNetworkOfferingWithContext records the call and calls CreateNetworkOfferingWithContextFunc, or CreateNetworkOfferingFunc if only that is set
+func (m *MockNetworkOfferingService) CreateNetworkOfferingWithContext(ctx context.Context, p *cloudstack.CreateNetworkOfferingParams) (r0 *cloudstack.CreateNetworkOfferingResponse, r1 error) {
+	m.record("CreateNetworkOfferingWithContext", ctx, p)
+	if m.CreateNetworkOfferingWithContextFunc != nil {
+		return m.CreateNetworkOfferingWithContextFunc(ctx, p)
+	}
+	if m.CreateNetworkOfferingFunc != nil {
+		return m.CreateNetworkOfferingFunc(p)
+	}
+	r1 = notMocked("NetworkOfferingService.CreateNetworkOfferingWithContext")
+	return
+}
+
+// NewDeleteNetworkOfferingParams records the call and calls NewDeleteNetworkOfferingParamsFunc
+func (m *MockNetworkOfferingService) NewDeleteNetworkOfferingParams(id string) (r0 *cloudstack.DeleteNetworkOfferingParams) {
+	m.record("NewDeleteNetworkOfferingParams", id)
+	if m.NewDeleteNetworkOfferingParamsFunc != nil {
+		return m.NewDeleteNetworkOfferingParamsFunc(id)
+	}
+	return cloudstack.NewNetworkOfferingService(nil).NewDeleteNetworkOfferingParams(id)
+}
+
+// DeleteNetworkOffering records the call and calls DeleteNetworkOfferingFunc, or DeleteNetworkOfferingWithContextFunc if only that is set
+func (m *MockNetworkOfferingService) DeleteNetworkOffering(p *cloudstack.DeleteNetworkOfferingParams) (r0 *cloudstack.DeleteNetworkOfferingResponse, r1 error) {
+	m.record("DeleteNetworkOffering", p)
+	if m.DeleteNetworkOfferingFunc != nil {
+		return m.DeleteNetworkOfferingFunc(p)
+	}
+	if m.DeleteNetworkOfferingWithContextFunc != nil {
+		return m.DeleteNetworkOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkOfferingService.DeleteNetworkOffering")
+	return
+}
+
+// DeleteNetworkOfferingWithContext records the call and calls DeleteNetworkOfferingWithContextFunc, or DeleteNetworkOfferingFunc if only that is set
+func (m *MockNetworkOfferingService) DeleteNetworkOfferingWithContext(ctx context.Context, p *cloudstack.DeleteNetworkOfferingParams) (r0 *cloudstack.DeleteNetworkOfferingResponse, r1 error) {
+	m.record("DeleteNetworkOfferingWithContext", ctx, p)
+	if m.DeleteNetworkOfferingWithContextFunc != nil {
+		return m.DeleteNetworkOfferingWithContextFunc(ctx, p)
+	}
+	if m.DeleteNetworkOfferingFunc != nil {
+		return m.DeleteNetworkOfferingFunc(p)
+	}
+	r1 = notMocked("NetworkOfferingService.DeleteNetworkOfferingWithContext")
+	return
+}
+
+// NewListNetworkOfferingsParams records the call and calls NewListNetworkOfferingsParamsFunc
+func (m *MockNetworkOfferingService) NewListNetworkOfferingsParams() (r0 *cloudstack.ListNetworkOfferingsParams) {
+	m.record("NewListNetworkOfferingsParams")
+	if m.NewListNetworkOfferingsParamsFunc != nil {
+		return m.NewListNetworkOfferingsParamsFunc()
+	}
+	return cloudstack.NewNetworkOfferingService(nil).NewListNetworkOfferingsParams()
+}
+
+// GetNetworkOfferingID records the call and calls GetNetworkOfferingIDFunc, or GetNetworkOfferingIDWithContextFunc if only that is set
+func (m *MockNetworkOfferingService) GetNetworkOfferingID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetNetworkOfferingID", name, opts)
+	if m.GetNetworkOfferingIDFunc != nil {
+		return m.GetNetworkOfferingIDFunc(name, opts...)
+	}
+	if m.GetNetworkOfferingIDWithContextFunc != nil {
+		return m.GetNetworkOfferingIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("NetworkOfferingService.GetNetworkOfferingID")
+	return
+}
+
+// GetNetworkOfferingIDWithContext records the call and calls GetNetworkOfferingIDWithContextFunc, or GetNetworkOfferingIDFunc if only that is set
+func (m *MockNetworkOfferingService) GetNetworkOfferingIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetNetworkOfferingIDWithContext", ctx, name, opts)
+	if m.GetNetworkOfferingIDWithContextFunc != nil {
+		return m.GetNetworkOfferingIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetNetworkOfferingIDFunc != nil {
+		return m.GetNetworkOfferingIDFunc(name, opts...)
+	}
+	r2 = notMocked("NetworkOfferingService.GetNetworkOfferingIDWithContext")
+	return
+}
+
+// GetNetworkOfferingByName records the call and calls GetNetworkOfferingByNameFunc, or GetNetworkOfferingByNameWithContextFunc if only that is set
+func (m *MockNetworkOfferingService) GetNetworkOfferingByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.NetworkOffering, r1 int, r2 error) {
+	m.record("GetNetworkOfferingByName", name, opts)
+	if m.GetNetworkOfferingByNameFunc != nil {
+		return m.GetNetworkOfferingByNameFunc(name, opts...)
+	}
+	if m.GetNetworkOfferingByNameWithContextFunc != nil {
+		return m.GetNetworkOfferingByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("NetworkOfferingService.GetNetworkOfferingByName")
+	return
+}
+
+// GetNetworkOfferingByNameWithContext records the call and calls GetNetworkOfferingByNameWithContextFunc, or GetNetworkOfferingByNameFunc if only that is set
+func (m *MockNetworkOfferingService) GetNetworkOfferingByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.NetworkOffering, r1 int, r2 error) {
+	m.record("GetNetworkOfferingByNameWithContext", ctx, name, opts)
+	if m.GetNetworkOfferingByNameWithContextFunc != nil {
+		return m.GetNetworkOfferingByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetNetworkOfferingByNameFunc != nil {
+		return m.GetNetworkOfferingByNameFunc(name, opts...)
+	}
+	r2 = notMocked("NetworkOfferingService.GetNetworkOfferingByNameWithContext")
+	return
+}
+
+// GetNetworkOfferingByID records the call and calls GetNetworkOfferingByIDFunc, or GetNetworkOfferingByIDWithContextFunc if only that is set
+func (m *MockNetworkOfferingService) GetNetworkOfferingByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.NetworkOffering, r1 int, r2 error) {
+	m.record("GetNetworkOfferingByID", id, opts)
+	if m.GetNetworkOfferingByIDFunc != nil {
+		return m.GetNetworkOfferingByIDFunc(id, opts...)
+	}
+	if m.GetNetworkOfferingByIDWithContextFunc != nil {
+		return m.GetNetworkOfferingByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("NetworkOfferingService.GetNetworkOfferingByID")
+	return
+}
+
+// GetNetworkOfferingByIDWithContext records the call and calls GetNetworkOfferingByIDWithContextFunc, or GetNetworkOfferingByIDFunc if only that is set
+func (m *MockNetworkOfferingService) GetNetworkOfferingByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.NetworkOffering, r1 int, r2 error) {
+	m.record("GetNetworkOfferingByIDWithContext", ctx, id, opts)
+	if m.GetNetworkOfferingByIDWithContextFunc != nil {
+		return m.GetNetworkOfferingByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetNetworkOfferingByIDFunc != nil {
+		return m.GetNetworkOfferingByIDFunc(id, opts...)
+	}
+	r2 = notMocked("NetworkOfferingService.GetNetworkOfferingByIDWithContext")
+	return
+}
+
+// ListNetworkOfferings records the call and calls ListNetworkOfferingsFunc, or ListNetworkOfferingsWithContextFunc if only that is set
+func (m *MockNetworkOfferingService) ListNetworkOfferings(p *cloudstack.ListNetworkOfferingsParams) (r0 *cloudstack.ListNetworkOfferingsResponse, r1 error) {
+	m.record("ListNetworkOfferings", p)
+	if m.ListNetworkOfferingsFunc != nil {
+		return m.ListNetworkOfferingsFunc(p)
+	}
+	if m.ListNetworkOfferingsWithContextFunc != nil {
+		return m.ListNetworkOfferingsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkOfferingService.ListNetworkOfferings")
+	return
+}
+
+// ListNetworkOfferingsWithContext records the call and calls ListNetworkOfferingsWithContextFunc, or ListNetworkOfferingsFunc if only that is set
+func (m *MockNetworkOfferingService) ListNetworkOfferingsWithContext(ctx context.Context, p *cloudstack.ListNetworkOfferingsParams) (r0 *cloudstack.ListNetworkOfferingsResponse, r1 error) {
+	m.record("ListNetworkOfferingsWithContext", ctx, p)
+	if m.ListNetworkOfferingsWithContextFunc != nil {
+		return m.ListNetworkOfferingsWithContextFunc(ctx, p)
+	}
+	if m.ListNetworkOfferingsFunc != nil {
+		return m.ListNetworkOfferingsFunc(p)
+	}
+	r1 = notMocked("NetworkOfferingService.ListNetworkOfferingsWithContext")
+	return
+}
+
+// NewListNetworkOfferingsPager records the call and calls NewListNetworkOfferingsPagerFunc
+func (m *MockNetworkOfferingService) NewListNetworkOfferingsPager(p *cloudstack.ListNetworkOfferingsParams) (r0 *cloudstack.ListNetworkOfferingsPager) {
+	m.record("NewListNetworkOfferingsPager", p)
+	if m.NewListNetworkOfferingsPagerFunc != nil {
+		return m.NewListNetworkOfferingsPagerFunc(p)
+	}
+	return
+}
+
+// ListNetworkOfferingsAll records the call and calls ListNetworkOfferingsAllFunc, or ListNetworkOfferingsAllWithContextFunc if only that is set
+func (m *MockNetworkOfferingService) ListNetworkOfferingsAll(p *cloudstack.ListNetworkOfferingsParams) (r0 *cloudstack.ListNetworkOfferingsResponse, r1 error) {
+	m.record("ListNetworkOfferingsAll", p)
+	if m.ListNetworkOfferingsAllFunc != nil {
+		return m.ListNetworkOfferingsAllFunc(p)
+	}
+	if m.ListNetworkOfferingsAllWithContextFunc != nil {
+		return m.ListNetworkOfferingsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkOfferingService.ListNetworkOfferingsAll")
+	return
+}
+
+// ListNetworkOfferingsAllWithContext records the call and calls ListNetworkOfferingsAllWithContextFunc, or ListNetworkOfferingsAllFunc if only that is set
+func (m *MockNetworkOfferingService) ListNetworkOfferingsAllWithContext(ctx context.Context, p *cloudstack.ListNetworkOfferingsParams) (r0 *cloudstack.ListNetworkOfferingsResponse, r1 error) {
+	m.record("ListNetworkOfferingsAllWithContext", ctx, p)
+	if m.ListNetworkOfferingsAllWithContextFunc != nil {
+		return m.ListNetworkOfferingsAllWithContextFunc(ctx, p)
+	}
+	if m.ListNetworkOfferingsAllFunc != nil {
+		return m.ListNetworkOfferingsAllFunc(p)
+	}
+	r1 = notMocked("NetworkOfferingService.ListNetworkOfferingsAllWithContext")
+	return
+}
+
+// NewUpdateNetworkOfferingParams records the call and calls NewUpdateNetworkOfferingParamsFunc
+func (m *MockNetworkOfferingService) NewUpdateNetworkOfferingParams() (r0 *cloudstack.UpdateNetworkOfferingParams) {
+	m.record("NewUpdateNetworkOfferingParams")
+	if m.NewUpdateNetworkOfferingParamsFunc != nil {
+		return m.NewUpdateNetworkOfferingParamsFunc()
+	}
+	return cloudstack.NewNetworkOfferingService(nil).NewUpdateNetworkOfferingParams()
+}
+
+// UpdateNetworkOffering records the call and calls UpdateNetworkOfferingFunc, or UpdateNetworkOfferingWithContextFunc if only that is set
+func (m *MockNetworkOfferingService) UpdateNetworkOffering(p *cloudstack.UpdateNetworkOfferingParams) (r0 *cloudstack.UpdateNetworkOfferingResponse, r1 error) {
+	m.record("UpdateNetworkOffering", p)
+	if m.UpdateNetworkOfferingFunc != nil {
+		return m.UpdateNetworkOfferingFunc(p)
+	}
+	if m.UpdateNetworkOfferingWithContextFunc != nil {
+		return m.UpdateNetworkOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkOfferingService.UpdateNetworkOffering")
+	return
+}
+
+// UpdateNetworkOfferingWithContext records the call and calls UpdateNetworkOfferingWithContextFunc, or UpdateNetworkOfferingFunc if only that is set
+func (m *MockNetworkOfferingService) UpdateNetworkOfferingWithContext(ctx context.Context, p *cloudstack.UpdateNetworkOfferingParams) (r0 *cloudstack.UpdateNetworkOfferingResponse, r1 error) {
+	m.record("UpdateNetworkOfferingWithContext", ctx, p)
+	if m.UpdateNetworkOfferingWithContextFunc != nil {
+		return m.UpdateNetworkOfferingWithContextFunc(ctx, p)
+	}
+	if m.UpdateNetworkOfferingFunc != nil {
+		return m.UpdateNetworkOfferingFunc(p)
+	}
+	r1 = notMocked("NetworkOfferingService.UpdateNetworkOfferingWithContext")
+	return
+}
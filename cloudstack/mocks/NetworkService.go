@@ -0,0 +1,2267 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockNetworkService is a mock of cloudstack.NetworkServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockNetworkService struct {
+	Recorder
+
+	NewAddNetworkServiceProviderParamsFunc              func(name string, physicalnetworkid string) *cloudstack.AddNetworkServiceProviderParams
+	AddNetworkServiceProviderFunc                       func(p *cloudstack.AddNetworkServiceProviderParams) (*cloudstack.AddNetworkServiceProviderResponse, error)
+	AddNetworkServiceProviderWithContextFunc            func(ctx context.Context, p *cloudstack.AddNetworkServiceProviderParams) (*cloudstack.AddNetworkServiceProviderResponse, error)
+	AddNetworkServiceProviderAsyncFunc                  func(p *cloudstack.AddNetworkServiceProviderParams) (*cloudstack.Job, error)
+	AddNetworkServiceProviderAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.AddNetworkServiceProviderParams) (*cloudstack.Job, error)
+	NewAddOpenDaylightControllerParamsFunc              func(password string, physicalnetworkid string, url string, username string) *cloudstack.AddOpenDaylightControllerParams
+	AddOpenDaylightControllerFunc                       func(p *cloudstack.AddOpenDaylightControllerParams) (*cloudstack.AddOpenDaylightControllerResponse, error)
+	AddOpenDaylightControllerWithContextFunc            func(ctx context.Context, p *cloudstack.AddOpenDaylightControllerParams) (*cloudstack.AddOpenDaylightControllerResponse, error)
+	AddOpenDaylightControllerAsyncFunc                  func(p *cloudstack.AddOpenDaylightControllerParams) (*cloudstack.Job, error)
+	AddOpenDaylightControllerAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.AddOpenDaylightControllerParams) (*cloudstack.Job, error)
+	NewCreateNetworkParamsFunc                          func(displaytext string, name string, networkofferingid string, zoneid string) *cloudstack.CreateNetworkParams
+	CreateNetworkFunc                                   func(p *cloudstack.CreateNetworkParams) (*cloudstack.CreateNetworkResponse, error)
+	CreateNetworkWithContextFunc                        func(ctx context.Context, p *cloudstack.CreateNetworkParams) (*cloudstack.CreateNetworkResponse, error)
+	NewCreatePhysicalNetworkParamsFunc                  func(name string, zoneid string) *cloudstack.CreatePhysicalNetworkParams
+	CreatePhysicalNetworkFunc                           func(p *cloudstack.CreatePhysicalNetworkParams) (*cloudstack.CreatePhysicalNetworkResponse, error)
+	CreatePhysicalNetworkWithContextFunc                func(ctx context.Context, p *cloudstack.CreatePhysicalNetworkParams) (*cloudstack.CreatePhysicalNetworkResponse, error)
+	CreatePhysicalNetworkAsyncFunc                      func(p *cloudstack.CreatePhysicalNetworkParams) (*cloudstack.Job, error)
+	CreatePhysicalNetworkAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.CreatePhysicalNetworkParams) (*cloudstack.Job, error)
+	NewCreateServiceInstanceParamsFunc                  func(leftnetworkid string, name string, rightnetworkid string, serviceofferingid string, templateid string, zoneid string) *cloudstack.CreateServiceInstanceParams
+	CreateServiceInstanceFunc                           func(p *cloudstack.CreateServiceInstanceParams) (*cloudstack.CreateServiceInstanceResponse, error)
+	CreateServiceInstanceWithContextFunc                func(ctx context.Context, p *cloudstack.CreateServiceInstanceParams) (*cloudstack.CreateServiceInstanceResponse, error)
+	CreateServiceInstanceAsyncFunc                      func(p *cloudstack.CreateServiceInstanceParams) (*cloudstack.Job, error)
+	CreateServiceInstanceAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.CreateServiceInstanceParams) (*cloudstack.Job, error)
+	NewCreateStorageNetworkIpRangeParamsFunc            func(gateway string, netmask string, podid string, startip string) *cloudstack.CreateStorageNetworkIpRangeParams
+	CreateStorageNetworkIpRangeFunc                     func(p *cloudstack.CreateStorageNetworkIpRangeParams) (*cloudstack.CreateStorageNetworkIpRangeResponse, error)
+	CreateStorageNetworkIpRangeWithContextFunc          func(ctx context.Context, p *cloudstack.CreateStorageNetworkIpRangeParams) (*cloudstack.CreateStorageNetworkIpRangeResponse, error)
+	CreateStorageNetworkIpRangeAsyncFunc                func(p *cloudstack.CreateStorageNetworkIpRangeParams) (*cloudstack.Job, error)
+	CreateStorageNetworkIpRangeAsyncWithContextFunc     func(ctx context.Context, p *cloudstack.CreateStorageNetworkIpRangeParams) (*cloudstack.Job, error)
+	NewDedicatePublicIpRangeParamsFunc                  func(domainid string, id string) *cloudstack.DedicatePublicIpRangeParams
+	DedicatePublicIpRangeFunc                           func(p *cloudstack.DedicatePublicIpRangeParams) (*cloudstack.DedicatePublicIpRangeResponse, error)
+	DedicatePublicIpRangeWithContextFunc                func(ctx context.Context, p *cloudstack.DedicatePublicIpRangeParams) (*cloudstack.DedicatePublicIpRangeResponse, error)
+	NewDeleteNetworkParamsFunc                          func(id string) *cloudstack.DeleteNetworkParams
+	DeleteNetworkFunc                                   func(p *cloudstack.DeleteNetworkParams) (*cloudstack.DeleteNetworkResponse, error)
+	DeleteNetworkWithContextFunc                        func(ctx context.Context, p *cloudstack.DeleteNetworkParams) (*cloudstack.DeleteNetworkResponse, error)
+	DeleteNetworkAsyncFunc                              func(p *cloudstack.DeleteNetworkParams) (*cloudstack.Job, error)
+	DeleteNetworkAsyncWithContextFunc                   func(ctx context.Context, p *cloudstack.DeleteNetworkParams) (*cloudstack.Job, error)
+	NewDeleteNetworkServiceProviderParamsFunc           func(id string) *cloudstack.DeleteNetworkServiceProviderParams
+	DeleteNetworkServiceProviderFunc                    func(p *cloudstack.DeleteNetworkServiceProviderParams) (*cloudstack.DeleteNetworkServiceProviderResponse, error)
+	DeleteNetworkServiceProviderWithContextFunc         func(ctx context.Context, p *cloudstack.DeleteNetworkServiceProviderParams) (*cloudstack.DeleteNetworkServiceProviderResponse, error)
+	DeleteNetworkServiceProviderAsyncFunc               func(p *cloudstack.DeleteNetworkServiceProviderParams) (*cloudstack.Job, error)
+	DeleteNetworkServiceProviderAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.DeleteNetworkServiceProviderParams) (*cloudstack.Job, error)
+	NewDeleteOpenDaylightControllerParamsFunc           func(id string) *cloudstack.DeleteOpenDaylightControllerParams
+	DeleteOpenDaylightControllerFunc                    func(p *cloudstack.DeleteOpenDaylightControllerParams) (*cloudstack.DeleteOpenDaylightControllerResponse, error)
+	DeleteOpenDaylightControllerWithContextFunc         func(ctx context.Context, p *cloudstack.DeleteOpenDaylightControllerParams) (*cloudstack.DeleteOpenDaylightControllerResponse, error)
+	DeleteOpenDaylightControllerAsyncFunc               func(p *cloudstack.DeleteOpenDaylightControllerParams) (*cloudstack.Job, error)
+	DeleteOpenDaylightControllerAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.DeleteOpenDaylightControllerParams) (*cloudstack.Job, error)
+	NewDeletePhysicalNetworkParamsFunc                  func(id string) *cloudstack.DeletePhysicalNetworkParams
+	DeletePhysicalNetworkFunc                           func(p *cloudstack.DeletePhysicalNetworkParams) (*cloudstack.DeletePhysicalNetworkResponse, error)
+	DeletePhysicalNetworkWithContextFunc                func(ctx context.Context, p *cloudstack.DeletePhysicalNetworkParams) (*cloudstack.DeletePhysicalNetworkResponse, error)
+	DeletePhysicalNetworkAsyncFunc                      func(p *cloudstack.DeletePhysicalNetworkParams) (*cloudstack.Job, error)
+	DeletePhysicalNetworkAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.DeletePhysicalNetworkParams) (*cloudstack.Job, error)
+	NewDeleteStorageNetworkIpRangeParamsFunc            func(id string) *cloudstack.DeleteStorageNetworkIpRangeParams
+	DeleteStorageNetworkIpRangeFunc                     func(p *cloudstack.DeleteStorageNetworkIpRangeParams) (*cloudstack.DeleteStorageNetworkIpRangeResponse, error)
+	DeleteStorageNetworkIpRangeWithContextFunc          func(ctx context.Context, p *cloudstack.DeleteStorageNetworkIpRangeParams) (*cloudstack.DeleteStorageNetworkIpRangeResponse, error)
+	DeleteStorageNetworkIpRangeAsyncFunc                func(p *cloudstack.DeleteStorageNetworkIpRangeParams) (*cloudstack.Job, error)
+	DeleteStorageNetworkIpRangeAsyncWithContextFunc     func(ctx context.Context, p *cloudstack.DeleteStorageNetworkIpRangeParams) (*cloudstack.Job, error)
+	NewListNetscalerLoadBalancerNetworksParamsFunc      func(lbdeviceid string) *cloudstack.ListNetscalerLoadBalancerNetworksParams
+	GetNetscalerLoadBalancerNetworkIDFunc               func(keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetNetscalerLoadBalancerNetworkIDWithContextFunc    func(ctx context.Context, keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListNetscalerLoadBalancerNetworksFunc               func(p *cloudstack.ListNetscalerLoadBalancerNetworksParams) (*cloudstack.ListNetscalerLoadBalancerNetworksResponse, error)
+	ListNetscalerLoadBalancerNetworksWithContextFunc    func(ctx context.Context, p *cloudstack.ListNetscalerLoadBalancerNetworksParams) (*cloudstack.ListNetscalerLoadBalancerNetworksResponse, error)
+	NewListNetscalerLoadBalancerNetworksPagerFunc       func(p *cloudstack.ListNetscalerLoadBalancerNetworksParams) *cloudstack.ListNetscalerLoadBalancerNetworksPager
+	ListNetscalerLoadBalancerNetworksAllFunc            func(p *cloudstack.ListNetscalerLoadBalancerNetworksParams) (*cloudstack.ListNetscalerLoadBalancerNetworksResponse, error)
+	ListNetscalerLoadBalancerNetworksAllWithContextFunc func(ctx context.Context, p *cloudstack.ListNetscalerLoadBalancerNetworksParams) (*cloudstack.ListNetscalerLoadBalancerNetworksResponse, error)
+	NewListNetworkIsolationMethodsParamsFunc            func() *cloudstack.ListNetworkIsolationMethodsParams
+	ListNetworkIsolationMethodsFunc                     func(p *cloudstack.ListNetworkIsolationMethodsParams) (*cloudstack.ListNetworkIsolationMethodsResponse, error)
+	ListNetworkIsolationMethodsWithContextFunc          func(ctx context.Context, p *cloudstack.ListNetworkIsolationMethodsParams) (*cloudstack.ListNetworkIsolationMethodsResponse, error)
+	NewListNetworkIsolationMethodsPagerFunc             func(p *cloudstack.ListNetworkIsolationMethodsParams) *cloudstack.ListNetworkIsolationMethodsPager
+	ListNetworkIsolationMethodsAllFunc                  func(p *cloudstack.ListNetworkIsolationMethodsParams) (*cloudstack.ListNetworkIsolationMethodsResponse, error)
+	ListNetworkIsolationMethodsAllWithContextFunc       func(ctx context.Context, p *cloudstack.ListNetworkIsolationMethodsParams) (*cloudstack.ListNetworkIsolationMethodsResponse, error)
+	NewListNetworkServiceProvidersParamsFunc            func() *cloudstack.ListNetworkServiceProvidersParams
+	GetNetworkServiceProviderIDFunc                     func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetNetworkServiceProviderIDWithContextFunc          func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListNetworkServiceProvidersFunc                     func(p *cloudstack.ListNetworkServiceProvidersParams) (*cloudstack.ListNetworkServiceProvidersResponse, error)
+	ListNetworkServiceProvidersWithContextFunc          func(ctx context.Context, p *cloudstack.ListNetworkServiceProvidersParams) (*cloudstack.ListNetworkServiceProvidersResponse, error)
+	NewListNetworkServiceProvidersPagerFunc             func(p *cloudstack.ListNetworkServiceProvidersParams) *cloudstack.ListNetworkServiceProvidersPager
+	ListNetworkServiceProvidersAllFunc                  func(p *cloudstack.ListNetworkServiceProvidersParams) (*cloudstack.ListNetworkServiceProvidersResponse, error)
+	ListNetworkServiceProvidersAllWithContextFunc       func(ctx context.Context, p *cloudstack.ListNetworkServiceProvidersParams) (*cloudstack.ListNetworkServiceProvidersResponse, error)
+	NewListNetworksParamsFunc                           func() *cloudstack.ListNetworksParams
+	GetNetworkIDFunc                                    func(keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetNetworkIDWithContextFunc                         func(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetNetworkByNameFunc                                func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Network, int, error)
+	GetNetworkByNameWithContextFunc                     func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.Network, int, error)
+	GetNetworkByIDFunc                                  func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Network, int, error)
+	GetNetworkByIDWithContextFunc                       func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Network, int, error)
+	ListNetworksFunc                                    func(p *cloudstack.ListNetworksParams) (*cloudstack.ListNetworksResponse, error)
+	ListNetworksWithContextFunc                         func(ctx context.Context, p *cloudstack.ListNetworksParams) (*cloudstack.ListNetworksResponse, error)
+	NewListNetworksPagerFunc                            func(p *cloudstack.ListNetworksParams) *cloudstack.ListNetworksPager
+	ListNetworksAllFunc                                 func(p *cloudstack.ListNetworksParams) (*cloudstack.ListNetworksResponse, error)
+	ListNetworksAllWithContextFunc                      func(ctx context.Context, p *cloudstack.ListNetworksParams) (*cloudstack.ListNetworksResponse, error)
+	NewListNiciraNvpDeviceNetworksParamsFunc            func(nvpdeviceid string) *cloudstack.ListNiciraNvpDeviceNetworksParams
+	GetNiciraNvpDeviceNetworkIDFunc                     func(keyword string, nvpdeviceid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetNiciraNvpDeviceNetworkIDWithContextFunc          func(ctx context.Context, keyword string, nvpdeviceid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListNiciraNvpDeviceNetworksFunc                     func(p *cloudstack.ListNiciraNvpDeviceNetworksParams) (*cloudstack.ListNiciraNvpDeviceNetworksResponse, error)
+	ListNiciraNvpDeviceNetworksWithContextFunc          func(ctx context.Context, p *cloudstack.ListNiciraNvpDeviceNetworksParams) (*cloudstack.ListNiciraNvpDeviceNetworksResponse, error)
+	NewListNiciraNvpDeviceNetworksPagerFunc             func(p *cloudstack.ListNiciraNvpDeviceNetworksParams) *cloudstack.ListNiciraNvpDeviceNetworksPager
+	ListNiciraNvpDeviceNetworksAllFunc                  func(p *cloudstack.ListNiciraNvpDeviceNetworksParams) (*cloudstack.ListNiciraNvpDeviceNetworksResponse, error)
+	ListNiciraNvpDeviceNetworksAllWithContextFunc       func(ctx context.Context, p *cloudstack.ListNiciraNvpDeviceNetworksParams) (*cloudstack.ListNiciraNvpDeviceNetworksResponse, error)
+	NewListOpenDaylightControllersParamsFunc            func() *cloudstack.ListOpenDaylightControllersParams
+	GetOpenDaylightControllerByIDFunc                   func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.OpenDaylightController, int, error)
+	GetOpenDaylightControllerByIDWithContextFunc        func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.OpenDaylightController, int, error)
+	ListOpenDaylightControllersFunc                     func(p *cloudstack.ListOpenDaylightControllersParams) (*cloudstack.ListOpenDaylightControllersResponse, error)
+	ListOpenDaylightControllersWithContextFunc          func(ctx context.Context, p *cloudstack.ListOpenDaylightControllersParams) (*cloudstack.ListOpenDaylightControllersResponse, error)
+	NewListPaloAltoFirewallNetworksParamsFunc           func(lbdeviceid string) *cloudstack.ListPaloAltoFirewallNetworksParams
+	GetPaloAltoFirewallNetworkIDFunc                    func(keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetPaloAltoFirewallNetworkIDWithContextFunc         func(ctx context.Context, keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListPaloAltoFirewallNetworksFunc                    func(p *cloudstack.ListPaloAltoFirewallNetworksParams) (*cloudstack.ListPaloAltoFirewallNetworksResponse, error)
+	ListPaloAltoFirewallNetworksWithContextFunc         func(ctx context.Context, p *cloudstack.ListPaloAltoFirewallNetworksParams) (*cloudstack.ListPaloAltoFirewallNetworksResponse, error)
+	NewListPaloAltoFirewallNetworksPagerFunc            func(p *cloudstack.ListPaloAltoFirewallNetworksParams) *cloudstack.ListPaloAltoFirewallNetworksPager
+	ListPaloAltoFirewallNetworksAllFunc                 func(p *cloudstack.ListPaloAltoFirewallNetworksParams) (*cloudstack.ListPaloAltoFirewallNetworksResponse, error)
+	ListPaloAltoFirewallNetworksAllWithContextFunc      func(ctx context.Context, p *cloudstack.ListPaloAltoFirewallNetworksParams) (*cloudstack.ListPaloAltoFirewallNetworksResponse, error)
+	NewListPhysicalNetworksParamsFunc                   func() *cloudstack.ListPhysicalNetworksParams
+	GetPhysicalNetworkIDFunc                            func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetPhysicalNetworkIDWithContextFunc                 func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetPhysicalNetworkByNameFunc                        func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.PhysicalNetwork, int, error)
+	GetPhysicalNetworkByNameWithContextFunc             func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.PhysicalNetwork, int, error)
+	GetPhysicalNetworkByIDFunc                          func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.PhysicalNetwork, int, error)
+	GetPhysicalNetworkByIDWithContextFunc               func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.PhysicalNetwork, int, error)
+	ListPhysicalNetworksFunc                            func(p *cloudstack.ListPhysicalNetworksParams) (*cloudstack.ListPhysicalNetworksResponse, error)
+	ListPhysicalNetworksWithContextFunc                 func(ctx context.Context, p *cloudstack.ListPhysicalNetworksParams) (*cloudstack.ListPhysicalNetworksResponse, error)
+	NewListPhysicalNetworksPagerFunc                    func(p *cloudstack.ListPhysicalNetworksParams) *cloudstack.ListPhysicalNetworksPager
+	ListPhysicalNetworksAllFunc                         func(p *cloudstack.ListPhysicalNetworksParams) (*cloudstack.ListPhysicalNetworksResponse, error)
+	ListPhysicalNetworksAllWithContextFunc              func(ctx context.Context, p *cloudstack.ListPhysicalNetworksParams) (*cloudstack.ListPhysicalNetworksResponse, error)
+	NewListStorageNetworkIpRangeParamsFunc              func() *cloudstack.ListStorageNetworkIpRangeParams
+	GetStorageNetworkIpRangeByIDFunc                    func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.StorageNetworkIpRange, int, error)
+	GetStorageNetworkIpRangeByIDWithContextFunc         func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.StorageNetworkIpRange, int, error)
+	ListStorageNetworkIpRangeFunc                       func(p *cloudstack.ListStorageNetworkIpRangeParams) (*cloudstack.ListStorageNetworkIpRangeResponse, error)
+	ListStorageNetworkIpRangeWithContextFunc            func(ctx context.Context, p *cloudstack.ListStorageNetworkIpRangeParams) (*cloudstack.ListStorageNetworkIpRangeResponse, error)
+	NewListStorageNetworkIpRangePagerFunc               func(p *cloudstack.ListStorageNetworkIpRangeParams) *cloudstack.ListStorageNetworkIpRangePager
+	ListStorageNetworkIpRangeAllFunc                    func(p *cloudstack.ListStorageNetworkIpRangeParams) (*cloudstack.ListStorageNetworkIpRangeResponse, error)
+	ListStorageNetworkIpRangeAllWithContextFunc         func(ctx context.Context, p *cloudstack.ListStorageNetworkIpRangeParams) (*cloudstack.ListStorageNetworkIpRangeResponse, error)
+	NewListSupportedNetworkServicesParamsFunc           func() *cloudstack.ListSupportedNetworkServicesParams
+	ListSupportedNetworkServicesFunc                    func(p *cloudstack.ListSupportedNetworkServicesParams) (*cloudstack.ListSupportedNetworkServicesResponse, error)
+	ListSupportedNetworkServicesWithContextFunc         func(ctx context.Context, p *cloudstack.ListSupportedNetworkServicesParams) (*cloudstack.ListSupportedNetworkServicesResponse, error)
+	NewListSupportedNetworkServicesPagerFunc            func(p *cloudstack.ListSupportedNetworkServicesParams) *cloudstack.ListSupportedNetworkServicesPager
+	ListSupportedNetworkServicesAllFunc                 func(p *cloudstack.ListSupportedNetworkServicesParams) (*cloudstack.ListSupportedNetworkServicesResponse, error)
+	ListSupportedNetworkServicesAllWithContextFunc      func(ctx context.Context, p *cloudstack.ListSupportedNetworkServicesParams) (*cloudstack.ListSupportedNetworkServicesResponse, error)
+	NewMigrateNetworkParamsFunc                         func(networkid string, networkofferingid string) *cloudstack.MigrateNetworkParams
+	MigrateNetworkFunc                                  func(p *cloudstack.MigrateNetworkParams) (*cloudstack.MigrateNetworkResponse, error)
+	MigrateNetworkWithContextFunc                       func(ctx context.Context, p *cloudstack.MigrateNetworkParams) (*cloudstack.MigrateNetworkResponse, error)
+	MigrateNetworkAsyncFunc                             func(p *cloudstack.MigrateNetworkParams) (*cloudstack.Job, error)
+	MigrateNetworkAsyncWithContextFunc                  func(ctx context.Context, p *cloudstack.MigrateNetworkParams) (*cloudstack.Job, error)
+	NewReleasePublicIpRangeParamsFunc                   func(id string) *cloudstack.ReleasePublicIpRangeParams
+	ReleasePublicIpRangeFunc                            func(p *cloudstack.ReleasePublicIpRangeParams) (*cloudstack.ReleasePublicIpRangeResponse, error)
+	ReleasePublicIpRangeWithContextFunc                 func(ctx context.Context, p *cloudstack.ReleasePublicIpRangeParams) (*cloudstack.ReleasePublicIpRangeResponse, error)
+	NewRestartNetworkParamsFunc                         func(id string) *cloudstack.RestartNetworkParams
+	RestartNetworkFunc                                  func(p *cloudstack.RestartNetworkParams) (*cloudstack.RestartNetworkResponse, error)
+	RestartNetworkWithContextFunc                       func(ctx context.Context, p *cloudstack.RestartNetworkParams) (*cloudstack.RestartNetworkResponse, error)
+	RestartNetworkAsyncFunc                             func(p *cloudstack.RestartNetworkParams) (*cloudstack.Job, error)
+	RestartNetworkAsyncWithContextFunc                  func(ctx context.Context, p *cloudstack.RestartNetworkParams) (*cloudstack.Job, error)
+	NewUpdateNetworkParamsFunc                          func(id string) *cloudstack.UpdateNetworkParams
+	UpdateNetworkFunc                                   func(p *cloudstack.UpdateNetworkParams) (*cloudstack.UpdateNetworkResponse, error)
+	UpdateNetworkWithContextFunc                        func(ctx context.Context, p *cloudstack.UpdateNetworkParams) (*cloudstack.UpdateNetworkResponse, error)
+	UpdateNetworkAsyncFunc                              func(p *cloudstack.UpdateNetworkParams) (*cloudstack.Job, error)
+	UpdateNetworkAsyncWithContextFunc                   func(ctx context.Context, p *cloudstack.UpdateNetworkParams) (*cloudstack.Job, error)
+	NewUpdateNetworkServiceProviderParamsFunc           func(id string) *cloudstack.UpdateNetworkServiceProviderParams
+	UpdateNetworkServiceProviderFunc                    func(p *cloudstack.UpdateNetworkServiceProviderParams) (*cloudstack.UpdateNetworkServiceProviderResponse, error)
+	UpdateNetworkServiceProviderWithContextFunc         func(ctx context.Context, p *cloudstack.UpdateNetworkServiceProviderParams) (*cloudstack.UpdateNetworkServiceProviderResponse, error)
+	UpdateNetworkServiceProviderAsyncFunc               func(p *cloudstack.UpdateNetworkServiceProviderParams) (*cloudstack.Job, error)
+	UpdateNetworkServiceProviderAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.UpdateNetworkServiceProviderParams) (*cloudstack.Job, error)
+	NewUpdatePhysicalNetworkParamsFunc                  func(id string) *cloudstack.UpdatePhysicalNetworkParams
+	UpdatePhysicalNetworkFunc                           func(p *cloudstack.UpdatePhysicalNetworkParams) (*cloudstack.UpdatePhysicalNetworkResponse, error)
+	UpdatePhysicalNetworkWithContextFunc                func(ctx context.Context, p *cloudstack.UpdatePhysicalNetworkParams) (*cloudstack.UpdatePhysicalNetworkResponse, error)
+	UpdatePhysicalNetworkAsyncFunc                      func(p *cloudstack.UpdatePhysicalNetworkParams) (*cloudstack.Job, error)
+	UpdatePhysicalNetworkAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.UpdatePhysicalNetworkParams) (*cloudstack.Job, error)
+	NewUpdateStorageNetworkIpRangeParamsFunc            func(id string) *cloudstack.UpdateStorageNetworkIpRangeParams
+	UpdateStorageNetworkIpRangeFunc                     func(p *cloudstack.UpdateStorageNetworkIpRangeParams) (*cloudstack.UpdateStorageNetworkIpRangeResponse, error)
+	UpdateStorageNetworkIpRangeWithContextFunc          func(ctx context.Context, p *cloudstack.UpdateStorageNetworkIpRangeParams) (*cloudstack.UpdateStorageNetworkIpRangeResponse, error)
+	UpdateStorageNetworkIpRangeAsyncFunc                func(p *cloudstack.UpdateStorageNetworkIpRangeParams) (*cloudstack.Job, error)
+	UpdateStorageNetworkIpRangeAsyncWithContextFunc     func(ctx context.Context, p *cloudstack.UpdateStorageNetworkIpRangeParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.NetworkServiceIface = (*MockNetworkService)(nil)
+
+// NewAddNetworkServiceProviderParams records the call and calls NewAddNetworkServiceProviderParamsFunc
+func (m *MockNetworkService) NewAddNetworkServiceProviderParams(name string, physicalnetworkid string) (r0 *cloudstack.AddNetworkServiceProviderParams) {
+	m.record("NewAddNetworkServiceProviderParams", name, physicalnetworkid)
+	if m.NewAddNetworkServiceProviderParamsFunc != nil {
+		return m.NewAddNetworkServiceProviderParamsFunc(name, physicalnetworkid)
+	}
+	return cloudstack.NewNetworkService(nil).NewAddNetworkServiceProviderParams(name, physicalnetworkid)
+}
+
+// AddNetworkServiceProvider records the call and calls AddNetworkServiceProviderFunc, or AddNetworkServiceProviderWithContextFunc if only that is set
+func (m *MockNetworkService) AddNetworkServiceProvider(p *cloudstack.AddNetworkServiceProviderParams) (r0 *cloudstack.AddNetworkServiceProviderResponse, r1 error) {
+	m.record("AddNetworkServiceProvider", p)
+	if m.AddNetworkServiceProviderFunc != nil {
+		return m.AddNetworkServiceProviderFunc(p)
+	}
+	if m.AddNetworkServiceProviderWithContextFunc != nil {
+		return m.AddNetworkServiceProviderWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.AddNetworkServiceProvider")
+	return
+}
+
+// AddNetworkServiceProviderWithContext records the call and calls AddNetworkServiceProviderWithContextFunc, or AddNetworkServiceProviderFunc if only that is set
+func (m *MockNetworkService) AddNetworkServiceProviderWithContext(ctx context.Context, p *cloudstack.AddNetworkServiceProviderParams) (r0 *cloudstack.AddNetworkServiceProviderResponse, r1 error) {
+	m.record("AddNetworkServiceProviderWithContext", ctx, p)
+	if m.AddNetworkServiceProviderWithContextFunc != nil {
+		return m.AddNetworkServiceProviderWithContextFunc(ctx, p)
+	}
+	if m.AddNetworkServiceProviderFunc != nil {
+		return m.AddNetworkServiceProviderFunc(p)
+	}
+	r1 = notMocked("NetworkService.AddNetworkServiceProviderWithContext")
+	return
+}
+
+// AddNetworkServiceProviderAsync records the call and calls AddNetworkServiceProviderAsyncFunc, or AddNetworkServiceProviderAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) AddNetworkServiceProviderAsync(p *cloudstack.AddNetworkServiceProviderParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddNetworkServiceProviderAsync", p)
+	if m.AddNetworkServiceProviderAsyncFunc != nil {
+		return m.AddNetworkServiceProviderAsyncFunc(p)
+	}
+	if m.AddNetworkServiceProviderAsyncWithContextFunc != nil {
+		return m.AddNetworkServiceProviderAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.AddNetworkServiceProviderAsync")
+	return
+}
+
+// AddNetworkServiceProviderAsyncWithContext records the call and calls AddNetworkServiceProviderAsyncWithContextFunc, or AddNetworkServiceProviderAsyncFunc if only that is set
+func (m *MockNetworkService) AddNetworkServiceProviderAsyncWithContext(ctx context.Context, p *cloudstack.AddNetworkServiceProviderParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddNetworkServiceProviderAsyncWithContext", ctx, p)
+	if m.AddNetworkServiceProviderAsyncWithContextFunc != nil {
+		return m.AddNetworkServiceProviderAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddNetworkServiceProviderAsyncFunc != nil {
+		return m.AddNetworkServiceProviderAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.AddNetworkServiceProviderAsyncWithContext")
+	return
+}
+
+// NewAddOpenDaylightControllerParams records the call and calls NewAddOpenDaylightControllerParamsFunc
+func (m *MockNetworkService) NewAddOpenDaylightControllerParams(password string, physicalnetworkid string, url string, username string) (r0 *cloudstack.AddOpenDaylightControllerParams) {
+	m.record("NewAddOpenDaylightControllerParams", password, physicalnetworkid, url, username)
+	if m.NewAddOpenDaylightControllerParamsFunc != nil {
+		return m.NewAddOpenDaylightControllerParamsFunc(password, physicalnetworkid, url, username)
+	}
+	return cloudstack.NewNetworkService(nil).NewAddOpenDaylightControllerParams(password, physicalnetworkid, url, username)
+}
+
+// AddOpenDaylightController records the call and calls AddOpenDaylightControllerFunc, or AddOpenDaylightControllerWithContextFunc if only that is set
+func (m *MockNetworkService) AddOpenDaylightController(p *cloudstack.AddOpenDaylightControllerParams) (r0 *cloudstack.AddOpenDaylightControllerResponse, r1 error) {
+	m.record("AddOpenDaylightController", p)
+	if m.AddOpenDaylightControllerFunc != nil {
+		return m.AddOpenDaylightControllerFunc(p)
+	}
+	if m.AddOpenDaylightControllerWithContextFunc != nil {
+		return m.AddOpenDaylightControllerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.AddOpenDaylightController")
+	return
+}
+
+// AddOpenDaylightControllerWithContext records the call and calls AddOpenDaylightControllerWithContextFunc, or AddOpenDaylightControllerFunc if only that is set
+func (m *MockNetworkService) AddOpenDaylightControllerWithContext(ctx context.Context, p *cloudstack.AddOpenDaylightControllerParams) (r0 *cloudstack.AddOpenDaylightControllerResponse, r1 error) {
+	m.record("AddOpenDaylightControllerWithContext", ctx, p)
+	if m.AddOpenDaylightControllerWithContextFunc != nil {
+		return m.AddOpenDaylightControllerWithContextFunc(ctx, p)
+	}
+	if m.AddOpenDaylightControllerFunc != nil {
+		return m.AddOpenDaylightControllerFunc(p)
+	}
+	r1 = notMocked("NetworkService.AddOpenDaylightControllerWithContext")
+	return
+}
+
+// AddOpenDaylightControllerAsync records the call and calls AddOpenDaylightControllerAsyncFunc, or AddOpenDaylightControllerAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) AddOpenDaylightControllerAsync(p *cloudstack.AddOpenDaylightControllerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddOpenDaylightControllerAsync", p)
+	if m.AddOpenDaylightControllerAsyncFunc != nil {
+		return m.AddOpenDaylightControllerAsyncFunc(p)
+	}
+	if m.AddOpenDaylightControllerAsyncWithContextFunc != nil {
+		return m.AddOpenDaylightControllerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.AddOpenDaylightControllerAsync")
+	return
+}
+
+// AddOpenDaylightControllerAsyncWithContext records the call and calls AddOpenDaylightControllerAsyncWithContextFunc, or AddOpenDaylightControllerAsyncFunc if only that is set
+func (m *MockNetworkService) AddOpenDaylightControllerAsyncWithContext(ctx context.Context, p *cloudstack.AddOpenDaylightControllerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddOpenDaylightControllerAsyncWithContext", ctx, p)
+	if m.AddOpenDaylightControllerAsyncWithContextFunc != nil {
+		return m.AddOpenDaylightControllerAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddOpenDaylightControllerAsyncFunc != nil {
+		return m.AddOpenDaylightControllerAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.AddOpenDaylightControllerAsyncWithContext")
+	return
+}
+
+// NewCreateNetworkParams records the call and calls NewCreateNetworkParamsFunc
+func (m *MockNetworkService) NewCreateNetworkParams(displaytext string, name string, networkofferingid string, zoneid string) (r0 *cloudstack.CreateNetworkParams) {
+	m.record("NewCreateNetworkParams", displaytext, name, networkofferingid, zoneid)
+	if m.NewCreateNetworkParamsFunc != nil {
+		return m.NewCreateNetworkParamsFunc(displaytext, name, networkofferingid, zoneid)
+	}
+	return cloudstack.NewNetworkService(nil).NewCreateNetworkParams(displaytext, name, networkofferingid, zoneid)
+}
+
+// CreateNetwork records the call and calls CreateNetworkFunc, or CreateNetworkWithContextFunc if only that is set
+func (m *MockNetworkService) CreateNetwork(p *cloudstack.CreateNetworkParams) (r0 *cloudstack.CreateNetworkResponse, r1 error) {
+	m.record("CreateNetwork", p)
+	if m.CreateNetworkFunc != nil {
+		return m.CreateNetworkFunc(p)
+	}
+	if m.CreateNetworkWithContextFunc != nil {
+		return m.CreateNetworkWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.CreateNetwork")
+	return
+}
+
+// CreateNetworkWithContext records the call and calls CreateNetworkWithContextFunc, or CreateNetworkFunc if only that is set
+func (m *MockNetworkService) CreateNetworkWithContext(ctx context.Context, p *cloudstack.CreateNetworkParams) (r0 *cloudstack.CreateNetworkResponse, r1 error) {
+	m.record("CreateNetworkWithContext", ctx, p)
+	if m.CreateNetworkWithContextFunc != nil {
+		return m.CreateNetworkWithContextFunc(ctx, p)
+	}
+	if m.CreateNetworkFunc != nil {
+		return m.CreateNetworkFunc(p)
+	}
+	r1 = notMocked("NetworkService.CreateNetworkWithContext")
+	return
+}
+
+// NewCreatePhysicalNetworkParams records the call and calls NewCreatePhysicalNetworkParamsFunc
+func (m *MockNetworkService) NewCreatePhysicalNetworkParams(name string, zoneid string) (r0 *cloudstack.CreatePhysicalNetworkParams) {
+	m.record("NewCreatePhysicalNetworkParams", name, zoneid)
+	if m.NewCreatePhysicalNetworkParamsFunc != nil {
+		return m.NewCreatePhysicalNetworkParamsFunc(name, zoneid)
+	}
+	return cloudstack.NewNetworkService(nil).NewCreatePhysicalNetworkParams(name, zoneid)
+}
+
+// CreatePhysicalNetwork records the call and calls CreatePhysicalNetworkFunc, or CreatePhysicalNetworkWithContextFunc if only that is set
+func (m *MockNetworkService) CreatePhysicalNetwork(p *cloudstack.CreatePhysicalNetworkParams) (r0 *cloudstack.CreatePhysicalNetworkResponse, r1 error) {
+	m.record("CreatePhysicalNetwork", p)
+	if m.CreatePhysicalNetworkFunc != nil {
+		return m.CreatePhysicalNetworkFunc(p)
+	}
+	if m.CreatePhysicalNetworkWithContextFunc != nil {
+		return m.CreatePhysicalNetworkWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.CreatePhysicalNetwork")
+	return
+}
+
+// CreatePhysicalNetworkWithContext records the call and calls CreatePhysicalNetworkWithContextFunc, or CreatePhysicalNetworkFunc if only that is set
+func (m *MockNetworkService) CreatePhysicalNetworkWithContext(ctx context.Context, p *cloudstack.CreatePhysicalNetworkParams) (r0 *cloudstack.CreatePhysicalNetworkResponse, r1 error) {
+	m.record("CreatePhysicalNetworkWithContext", ctx, p)
+	if m.CreatePhysicalNetworkWithContextFunc != nil {
+		return m.CreatePhysicalNetworkWithContextFunc(ctx, p)
+	}
+	if m.CreatePhysicalNetworkFunc != nil {
+		return m.CreatePhysicalNetworkFunc(p)
+	}
+	r1 = notMocked("NetworkService.CreatePhysicalNetworkWithContext")
+	return
+}
+
+// CreatePhysicalNetworkAsync records the call and calls CreatePhysicalNetworkAsyncFunc, or CreatePhysicalNetworkAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) CreatePhysicalNetworkAsync(p *cloudstack.CreatePhysicalNetworkParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreatePhysicalNetworkAsync", p)
+	if m.CreatePhysicalNetworkAsyncFunc != nil {
+		return m.CreatePhysicalNetworkAsyncFunc(p)
+	}
+	if m.CreatePhysicalNetworkAsyncWithContextFunc != nil {
+		return m.CreatePhysicalNetworkAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.CreatePhysicalNetworkAsync")
+	return
+}
+
+// CreatePhysicalNetworkAsyncWithContext records the call and calls CreatePhysicalNetworkAsyncWithContextFunc, or CreatePhysicalNetworkAsyncFunc if only that is set
+func (m *MockNetworkService) CreatePhysicalNetworkAsyncWithContext(ctx context.Context, p *cloudstack.CreatePhysicalNetworkParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreatePhysicalNetworkAsyncWithContext", ctx, p)
+	if m.CreatePhysicalNetworkAsyncWithContextFunc != nil {
+		return m.CreatePhysicalNetworkAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreatePhysicalNetworkAsyncFunc != nil {
+		return m.CreatePhysicalNetworkAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.CreatePhysicalNetworkAsyncWithContext")
+	return
+}
+
+// NewCreateServiceInstanceParams records the call and calls NewCreateServiceInstanceParamsFunc
+func (m *MockNetworkService) NewCreateServiceInstanceParams(leftnetworkid string, name string, rightnetworkid string, serviceofferingid string, templateid string, zoneid string) (r0 *cloudstack.CreateServiceInstanceParams) {
+	m.record("NewCreateServiceInstanceParams", leftnetworkid, name, rightnetworkid, serviceofferingid, templateid, zoneid)
+	if m.NewCreateServiceInstanceParamsFunc != nil {
+		return m.NewCreateServiceInstanceParamsFunc(leftnetworkid, name, rightnetworkid, serviceofferingid, templateid, zoneid)
+	}
+	return cloudstack.NewNetworkService(nil).NewCreateServiceInstanceParams(leftnetworkid, name, rightnetworkid, serviceofferingid, templateid, zoneid)
+}
+
+// CreateServiceInstance records the call and calls CreateServiceInstanceFunc, or CreateServiceInstanceWithContextFunc if only that is set
+func (m *MockNetworkService) CreateServiceInstance(p *cloudstack.CreateServiceInstanceParams) (r0 *cloudstack.CreateServiceInstanceResponse, r1 error) {
+	m.record("CreateServiceInstance", p)
+	if m.CreateServiceInstanceFunc != nil {
+		return m.CreateServiceInstanceFunc(p)
+	}
+	if m.CreateServiceInstanceWithContextFunc != nil {
+		return m.CreateServiceInstanceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.CreateServiceInstance")
+	return
+}
+
+// CreateServiceInstanceWithContext records the call and calls CreateServiceInstanceWithContextFunc, or CreateServiceInstanceFunc if only that is set
+func (m *MockNetworkService) CreateServiceInstanceWithContext(ctx context.Context, p *cloudstack.CreateServiceInstanceParams) (r0 *cloudstack.CreateServiceInstanceResponse, r1 error) {
+	m.record("CreateServiceInstanceWithContext", ctx, p)
+	if m.CreateServiceInstanceWithContextFunc != nil {
+		return m.CreateServiceInstanceWithContextFunc(ctx, p)
+	}
+	if m.CreateServiceInstanceFunc != nil {
+		return m.CreateServiceInstanceFunc(p)
+	}
+	r1 = notMocked("NetworkService.CreateServiceInstanceWithContext")
+	return
+}
+
+// CreateServiceInstanceAsync records the call and calls CreateServiceInstanceAsyncFunc, or CreateServiceInstanceAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) CreateServiceInstanceAsync(p *cloudstack.CreateServiceInstanceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateServiceInstanceAsync", p)
+	if m.CreateServiceInstanceAsyncFunc != nil {
+		return m.CreateServiceInstanceAsyncFunc(p)
+	}
+	if m.CreateServiceInstanceAsyncWithContextFunc != nil {
+		return m.CreateServiceInstanceAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.CreateServiceInstanceAsync")
+	return
+}
+
+// CreateServiceInstanceAsyncWithContext records the call and calls CreateServiceInstanceAsyncWithContextFunc, or CreateServiceInstanceAsyncFunc if only that is set
+func (m *MockNetworkService) CreateServiceInstanceAsyncWithContext(ctx context.Context, p *cloudstack.CreateServiceInstanceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateServiceInstanceAsyncWithContext", ctx, p)
+	if m.CreateServiceInstanceAsyncWithContextFunc != nil {
+		return m.CreateServiceInstanceAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateServiceInstanceAsyncFunc != nil {
+		return m.CreateServiceInstanceAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.CreateServiceInstanceAsyncWithContext")
+	return
+}
+
+// NewCreateStorageNetworkIpRangeParams records the call and calls NewCreateStorageNetworkIpRangeParamsFunc
+func (m *MockNetworkService) NewCreateStorageNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) (r0 *cloudstack.CreateStorageNetworkIpRangeParams) {
+	m.record("NewCreateStorageNetworkIpRangeParams", gateway, netmask, podid, startip)
+	if m.NewCreateStorageNetworkIpRangeParamsFunc != nil {
+		return m.NewCreateStorageNetworkIpRangeParamsFunc(gateway, netmask, podid, startip)
+	}
+	return cloudstack.NewNetworkService(nil).NewCreateStorageNetworkIpRangeParams(gateway, netmask, podid, startip)
+}
+
+// CreateStorageNetworkIpRange records the call and calls CreateStorageNetworkIpRangeFunc, or CreateStorageNetworkIpRangeWithContextFunc if only that is set
+func (m *MockNetworkService) CreateStorageNetworkIpRange(p *cloudstack.CreateStorageNetworkIpRangeParams) (r0 *cloudstack.CreateStorageNetworkIpRangeResponse, r1 error) {
+	m.record("CreateStorageNetworkIpRange", p)
+	if m.CreateStorageNetworkIpRangeFunc != nil {
+		return m.CreateStorageNetworkIpRangeFunc(p)
+	}
+	if m.CreateStorageNetworkIpRangeWithContextFunc != nil {
+		return m.CreateStorageNetworkIpRangeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.CreateStorageNetworkIpRange")
+	return
+}
+
+// CreateStorageNetworkIpRangeWithContext records the call and calls CreateStorageNetworkIpRangeWithContextFunc, or CreateStorageNetworkIpRangeFunc if only that is set
+func (m *MockNetworkService) CreateStorageNetworkIpRangeWithContext(ctx context.Context, p *cloudstack.CreateStorageNetworkIpRangeParams) (r0 *cloudstack.CreateStorageNetworkIpRangeResponse, r1 error) {
+	m.record("CreateStorageNetworkIpRangeWithContext", ctx, p)
+	if m.CreateStorageNetworkIpRangeWithContextFunc != nil {
+		return m.CreateStorageNetworkIpRangeWithContextFunc(ctx, p)
+	}
+	if m.CreateStorageNetworkIpRangeFunc != nil {
+		return m.CreateStorageNetworkIpRangeFunc(p)
+	}
+	r1 = notMocked("NetworkService.CreateStorageNetworkIpRangeWithContext")
+	return
+}
+
+// CreateStorageNetworkIpRangeAsync records the call and calls CreateStorageNetworkIpRangeAsyncFunc, or CreateStorageNetworkIpRangeAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) CreateStorageNetworkIpRangeAsync(p *cloudstack.CreateStorageNetworkIpRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateStorageNetworkIpRangeAsync", p)
+	if m.CreateStorageNetworkIpRangeAsyncFunc != nil {
+		return m.CreateStorageNetworkIpRangeAsyncFunc(p)
+	}
+	if m.CreateStorageNetworkIpRangeAsyncWithContextFunc != nil {
+		return m.CreateStorageNetworkIpRangeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.CreateStorageNetworkIpRangeAsync")
+	return
+}
+
+// CreateStorageNetworkIpRangeAsyncWithContext records the call and calls CreateStorageNetworkIpRangeAsyncWithContextFunc, or CreateStorageNetworkIpRangeAsyncFunc if only that is set
+func (m *MockNetworkService) CreateStorageNetworkIpRangeAsyncWithContext(ctx context.Context, p *cloudstack.CreateStorageNetworkIpRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateStorageNetworkIpRangeAsyncWithContext", ctx, p)
+	if m.CreateStorageNetworkIpRangeAsyncWithContextFunc != nil {
+		return m.CreateStorageNetworkIpRangeAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateStorageNetworkIpRangeAsyncFunc != nil {
+		return m.CreateStorageNetworkIpRangeAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.CreateStorageNetworkIpRangeAsyncWithContext")
+	return
+}
+
+// NewDedicatePublicIpRangeParams records the call and calls NewDedicatePublicIpRangeParamsFunc
+func (m *MockNetworkService) NewDedicatePublicIpRangeParams(domainid string, id string) (r0 *cloudstack.DedicatePublicIpRangeParams) {
+	m.record("NewDedicatePublicIpRangeParams", domainid, id)
+	if m.NewDedicatePublicIpRangeParamsFunc != nil {
+		return m.NewDedicatePublicIpRangeParamsFunc(domainid, id)
+	}
+	return cloudstack.NewNetworkService(nil).NewDedicatePublicIpRangeParams(domainid, id)
+}
+
+// DedicatePublicIpRange records the call and calls DedicatePublicIpRangeFunc, or DedicatePublicIpRangeWithContextFunc if only that is set
+func (m *MockNetworkService) DedicatePublicIpRange(p *cloudstack.DedicatePublicIpRangeParams) (r0 *cloudstack.DedicatePublicIpRangeResponse, r1 error) {
+	m.record("DedicatePublicIpRange", p)
+	if m.DedicatePublicIpRangeFunc != nil {
+		return m.DedicatePublicIpRangeFunc(p)
+	}
+	if m.DedicatePublicIpRangeWithContextFunc != nil {
+		return m.DedicatePublicIpRangeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.DedicatePublicIpRange")
+	return
+}
+
+// DedicatePublicIpRangeWithContext records the call and calls DedicatePublicIpRangeWithContextFunc, or DedicatePublicIpRangeFunc if only that is set
+func (m *MockNetworkService) DedicatePublicIpRangeWithContext(ctx context.Context, p *cloudstack.DedicatePublicIpRangeParams) (r0 *cloudstack.DedicatePublicIpRangeResponse, r1 error) {
+	m.record("DedicatePublicIpRangeWithContext", ctx, p)
+	if m.DedicatePublicIpRangeWithContextFunc != nil {
+		return m.DedicatePublicIpRangeWithContextFunc(ctx, p)
+	}
+	if m.DedicatePublicIpRangeFunc != nil {
+		return m.DedicatePublicIpRangeFunc(p)
+	}
+	r1 = notMocked("NetworkService.DedicatePublicIpRangeWithContext")
+	return
+}
+
+// NewDeleteNetworkParams records the call and calls NewDeleteNetworkParamsFunc
+func (m *MockNetworkService) NewDeleteNetworkParams(id string) (r0 *cloudstack.DeleteNetworkParams) {
+	m.record("NewDeleteNetworkParams", id)
+	if m.NewDeleteNetworkParamsFunc != nil {
+		return m.NewDeleteNetworkParamsFunc(id)
+	}
+	return cloudstack.NewNetworkService(nil).NewDeleteNetworkParams(id)
+}
+
+// DeleteNetwork records the call and calls DeleteNetworkFunc, or DeleteNetworkWithContextFunc if only that is set
+func (m *MockNetworkService) DeleteNetwork(p *cloudstack.DeleteNetworkParams) (r0 *cloudstack.DeleteNetworkResponse, r1 error) {
+	m.record("DeleteNetwork", p)
+	if m.DeleteNetworkFunc != nil {
+		return m.DeleteNetworkFunc(p)
+	}
+	if m.DeleteNetworkWithContextFunc != nil {
+		return m.DeleteNetworkWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.DeleteNetwork")
+	return
+}
+
+// DeleteNetworkWithContext records the call and calls DeleteNetworkWithContextFunc, or DeleteNetworkFunc if only that is set
+func (m *MockNetworkService) DeleteNetworkWithContext(ctx context.Context, p *cloudstack.DeleteNetworkParams) (r0 *cloudstack.DeleteNetworkResponse, r1 error) {
+	m.record("DeleteNetworkWithContext", ctx, p)
+	if m.DeleteNetworkWithContextFunc != nil {
+		return m.DeleteNetworkWithContextFunc(ctx, p)
+	}
+	if m.DeleteNetworkFunc != nil {
+		return m.DeleteNetworkFunc(p)
+	}
+	r1 = notMocked("NetworkService.DeleteNetworkWithContext")
+	return
+}
+
+// DeleteNetworkAsync records the call and calls DeleteNetworkAsyncFunc, or DeleteNetworkAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) DeleteNetworkAsync(p *cloudstack.DeleteNetworkParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteNetworkAsync", p)
+	if m.DeleteNetworkAsyncFunc != nil {
+		return m.DeleteNetworkAsyncFunc(p)
+	}
+	if m.DeleteNetworkAsyncWithContextFunc != nil {
+		return m.DeleteNetworkAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.DeleteNetworkAsync")
+	return
+}
+
+// DeleteNetworkAsyncWithContext records the call and calls DeleteNetworkAsyncWithContextFunc, or DeleteNetworkAsyncFunc if only that is set
+func (m *MockNetworkService) DeleteNetworkAsyncWithContext(ctx context.Context, p *cloudstack.DeleteNetworkParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteNetworkAsyncWithContext", ctx, p)
+	if m.DeleteNetworkAsyncWithContextFunc != nil {
+		return m.DeleteNetworkAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteNetworkAsyncFunc != nil {
+		return m.DeleteNetworkAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.DeleteNetworkAsyncWithContext")
+	return
+}
+
+// NewDeleteNetworkServiceProviderParams records the call and calls NewDeleteNetworkServiceProviderParamsFunc
+func (m *MockNetworkService) NewDeleteNetworkServiceProviderParams(id string) (r0 *cloudstack.DeleteNetworkServiceProviderParams) {
+	m.record("NewDeleteNetworkServiceProviderParams", id)
+	if m.NewDeleteNetworkServiceProviderParamsFunc != nil {
+		return m.NewDeleteNetworkServiceProviderParamsFunc(id)
+	}
+	return cloudstack.NewNetworkService(nil).NewDeleteNetworkServiceProviderParams(id)
+}
+
+// DeleteNetworkServiceProvider records the call and calls DeleteNetworkServiceProviderFunc, or DeleteNetworkServiceProviderWithContextFunc if only that is set
+func (m *MockNetworkService) DeleteNetworkServiceProvider(p *cloudstack.DeleteNetworkServiceProviderParams) (r0 *cloudstack.DeleteNetworkServiceProviderResponse, r1 error) {
+	m.record("DeleteNetworkServiceProvider", p)
+	if m.DeleteNetworkServiceProviderFunc != nil {
+		return m.DeleteNetworkServiceProviderFunc(p)
+	}
+	if m.DeleteNetworkServiceProviderWithContextFunc != nil {
+		return m.DeleteNetworkServiceProviderWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.DeleteNetworkServiceProvider")
+	return
+}
+
+// DeleteNetworkServiceProviderWithContext records the call and calls DeleteNetworkServiceProviderWithContextFunc, or DeleteNetworkServiceProviderFunc if only that is set
+func (m *MockNetworkService) DeleteNetworkServiceProviderWithContext(ctx context.Context, p *cloudstack.DeleteNetworkServiceProviderParams) (r0 *cloudstack.DeleteNetworkServiceProviderResponse, r1 error) {
+	m.record("DeleteNetworkServiceProviderWithContext", ctx, p)
+	if m.DeleteNetworkServiceProviderWithContextFunc != nil {
+		return m.DeleteNetworkServiceProviderWithContextFunc(ctx, p)
+	}
+	if m.DeleteNetworkServiceProviderFunc != nil {
+		return m.DeleteNetworkServiceProviderFunc(p)
+	}
+	r1 = notMocked("NetworkService.DeleteNetworkServiceProviderWithContext")
+	return
+}
+
+// DeleteNetworkServiceProviderAsync records the call and calls DeleteNetworkServiceProviderAsyncFunc, or DeleteNetworkServiceProviderAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) DeleteNetworkServiceProviderAsync(p *cloudstack.DeleteNetworkServiceProviderParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteNetworkServiceProviderAsync", p)
+	if m.DeleteNetworkServiceProviderAsyncFunc != nil {
+		return m.DeleteNetworkServiceProviderAsyncFunc(p)
+	}
+	if m.DeleteNetworkServiceProviderAsyncWithContextFunc != nil {
+		return m.DeleteNetworkServiceProviderAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.DeleteNetworkServiceProviderAsync")
+	return
+}
+
+// DeleteNetworkServiceProviderAsyncWithContext records the call and calls DeleteNetworkServiceProviderAsyncWithContextFunc, or DeleteNetworkServiceProviderAsyncFunc if only that is set
+func (m *MockNetworkService) DeleteNetworkServiceProviderAsyncWithContext(ctx context.Context, p *cloudstack.DeleteNetworkServiceProviderParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteNetworkServiceProviderAsyncWithContext", ctx, p)
+	if m.DeleteNetworkServiceProviderAsyncWithContextFunc != nil {
+		return m.DeleteNetworkServiceProviderAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteNetworkServiceProviderAsyncFunc != nil {
+		return m.DeleteNetworkServiceProviderAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.DeleteNetworkServiceProviderAsyncWithContext")
+	return
+}
+
+// NewDeleteOpenDaylightControllerParams records the call and calls NewDeleteOpenDaylightControllerParamsFunc
+func (m *MockNetworkService) NewDeleteOpenDaylightControllerParams(id string) (r0 *cloudstack.DeleteOpenDaylightControllerParams) {
+	m.record("NewDeleteOpenDaylightControllerParams", id)
+	if m.NewDeleteOpenDaylightControllerParamsFunc != nil {
+		return m.NewDeleteOpenDaylightControllerParamsFunc(id)
+	}
+	return cloudstack.NewNetworkService(nil).NewDeleteOpenDaylightControllerParams(id)
+}
+
+// DeleteOpenDaylightController records the call and calls DeleteOpenDaylightControllerFunc, or DeleteOpenDaylightControllerWithContextFunc if only that is set
+func (m *MockNetworkService) DeleteOpenDaylightController(p *cloudstack.DeleteOpenDaylightControllerParams) (r0 *cloudstack.DeleteOpenDaylightControllerResponse, r1 error) {
+	m.record("DeleteOpenDaylightController", p)
+	if m.DeleteOpenDaylightControllerFunc != nil {
+		return m.DeleteOpenDaylightControllerFunc(p)
+	}
+	if m.DeleteOpenDaylightControllerWithContextFunc != nil {
+		return m.DeleteOpenDaylightControllerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.DeleteOpenDaylightController")
+	return
+}
+
+// DeleteOpenDaylightControllerWithContext records the call and calls DeleteOpenDaylightControllerWithContextFunc, or DeleteOpenDaylightControllerFunc if only that is set
+func (m *MockNetworkService) DeleteOpenDaylightControllerWithContext(ctx context.Context, p *cloudstack.DeleteOpenDaylightControllerParams) (r0 *cloudstack.DeleteOpenDaylightControllerResponse, r1 error) {
+	m.record("DeleteOpenDaylightControllerWithContext", ctx, p)
+	if m.DeleteOpenDaylightControllerWithContextFunc != nil {
+		return m.DeleteOpenDaylightControllerWithContextFunc(ctx, p)
+	}
+	if m.DeleteOpenDaylightControllerFunc != nil {
+		return m.DeleteOpenDaylightControllerFunc(p)
+	}
+	r1 = notMocked("NetworkService.DeleteOpenDaylightControllerWithContext")
+	return
+}
+
+// DeleteOpenDaylightControllerAsync records the call and calls DeleteOpenDaylightControllerAsyncFunc, or DeleteOpenDaylightControllerAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) DeleteOpenDaylightControllerAsync(p *cloudstack.DeleteOpenDaylightControllerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteOpenDaylightControllerAsync", p)
+	if m.DeleteOpenDaylightControllerAsyncFunc != nil {
+		return m.DeleteOpenDaylightControllerAsyncFunc(p)
+	}
+	if m.DeleteOpenDaylightControllerAsyncWithContextFunc != nil {
+		return m.DeleteOpenDaylightControllerAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.DeleteOpenDaylightControllerAsync")
+	return
+}
+
+// DeleteOpenDaylightControllerAsyncWithContext records the call and calls DeleteOpenDaylightControllerAsyncWithContextFunc, or DeleteOpenDaylightControllerAsyncFunc if only that is set
+func (m *MockNetworkService) DeleteOpenDaylightControllerAsyncWithContext(ctx context.Context, p *cloudstack.DeleteOpenDaylightControllerParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteOpenDaylightControllerAsyncWithContext", ctx, p)
+	if m.DeleteOpenDaylightControllerAsyncWithContextFunc != nil {
+		return m.DeleteOpenDaylightControllerAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteOpenDaylightControllerAsyncFunc != nil {
+		return m.DeleteOpenDaylightControllerAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.DeleteOpenDaylightControllerAsyncWithContext")
+	return
+}
+
+// NewDeletePhysicalNetworkParams records the call and calls NewDeletePhysicalNetworkParamsFunc
+func (m *MockNetworkService) NewDeletePhysicalNetworkParams(id string) (r0 *cloudstack.DeletePhysicalNetworkParams) {
+	m.record("NewDeletePhysicalNetworkParams", id)
+	if m.NewDeletePhysicalNetworkParamsFunc != nil {
+		return m.NewDeletePhysicalNetworkParamsFunc(id)
+	}
+	return cloudstack.NewNetworkService(nil).NewDeletePhysicalNetworkParams(id)
+}
+
+// DeletePhysicalNetwork records the call and calls DeletePhysicalNetworkFunc, or DeletePhysicalNetworkWithContextFunc if only that is set
+func (m *MockNetworkService) DeletePhysicalNetwork(p *cloudstack.DeletePhysicalNetworkParams) (r0 *cloudstack.DeletePhysicalNetworkResponse, r1 error) {
+	m.record("DeletePhysicalNetwork", p)
+	if m.DeletePhysicalNetworkFunc != nil {
+		return m.DeletePhysicalNetworkFunc(p)
+	}
+	if m.DeletePhysicalNetworkWithContextFunc != nil {
+		return m.DeletePhysicalNetworkWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.DeletePhysicalNetwork")
+	return
+}
+
+// DeletePhysicalNetworkWithContext records the call and calls DeletePhysicalNetworkWithContextFunc, or DeletePhysicalNetworkFunc if only that is set
+func (m *MockNetworkService) DeletePhysicalNetworkWithContext(ctx context.Context, p *cloudstack.DeletePhysicalNetworkParams) (r0 *cloudstack.DeletePhysicalNetworkResponse, r1 error) {
+	m.record("DeletePhysicalNetworkWithContext", ctx, p)
+	if m.DeletePhysicalNetworkWithContextFunc != nil {
+		return m.DeletePhysicalNetworkWithContextFunc(ctx, p)
+	}
+	if m.DeletePhysicalNetworkFunc != nil {
+		return m.DeletePhysicalNetworkFunc(p)
+	}
+	r1 = notMocked("NetworkService.DeletePhysicalNetworkWithContext")
+	return
+}
+
+// DeletePhysicalNetworkAsync records the call and calls DeletePhysicalNetworkAsyncFunc, or DeletePhysicalNetworkAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) DeletePhysicalNetworkAsync(p *cloudstack.DeletePhysicalNetworkParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeletePhysicalNetworkAsync", p)
+	if m.DeletePhysicalNetworkAsyncFunc != nil {
+		return m.DeletePhysicalNetworkAsyncFunc(p)
+	}
+	if m.DeletePhysicalNetworkAsyncWithContextFunc != nil {
+		return m.DeletePhysicalNetworkAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.DeletePhysicalNetworkAsync")
+	return
+}
+
+// DeletePhysicalNetworkAsyncWithContext records the call and calls DeletePhysicalNetworkAsyncWithContextFunc, or DeletePhysicalNetworkAsyncFunc if only that is set
+func (m *MockNetworkService) DeletePhysicalNetworkAsyncWithContext(ctx context.Context, p *cloudstack.DeletePhysicalNetworkParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeletePhysicalNetworkAsyncWithContext", ctx, p)
+	if m.DeletePhysicalNetworkAsyncWithContextFunc != nil {
+		return m.DeletePhysicalNetworkAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeletePhysicalNetworkAsyncFunc != nil {
+		return m.DeletePhysicalNetworkAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.DeletePhysicalNetworkAsyncWithContext")
+	return
+}
+
+// NewDeleteStorageNetworkIpRangeParams records the call and calls NewDeleteStorageNetworkIpRangeParamsFunc
+func (m *MockNetworkService) NewDeleteStorageNetworkIpRangeParams(id string) (r0 *cloudstack.DeleteStorageNetworkIpRangeParams) {
+	m.record("NewDeleteStorageNetworkIpRangeParams", id)
+	if m.NewDeleteStorageNetworkIpRangeParamsFunc != nil {
+		return m.NewDeleteStorageNetworkIpRangeParamsFunc(id)
+	}
+	return cloudstack.NewNetworkService(nil).NewDeleteStorageNetworkIpRangeParams(id)
+}
+
+// DeleteStorageNetworkIpRange records the call and calls DeleteStorageNetworkIpRangeFunc, or DeleteStorageNetworkIpRangeWithContextFunc if only that is set
+func (m *MockNetworkService) DeleteStorageNetworkIpRange(p *cloudstack.DeleteStorageNetworkIpRangeParams) (r0 *cloudstack.DeleteStorageNetworkIpRangeResponse, r1 error) {
+	m.record("DeleteStorageNetworkIpRange", p)
+	if m.DeleteStorageNetworkIpRangeFunc != nil {
+		return m.DeleteStorageNetworkIpRangeFunc(p)
+	}
+	if m.DeleteStorageNetworkIpRangeWithContextFunc != nil {
+		return m.DeleteStorageNetworkIpRangeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.DeleteStorageNetworkIpRange")
+	return
+}
+
+// DeleteStorageNetworkIpRangeWithContext records the call and calls DeleteStorageNetworkIpRangeWithContextFunc, or DeleteStorageNetworkIpRangeFunc if only that is set
+func (m *MockNetworkService) DeleteStorageNetworkIpRangeWithContext(ctx context.Context, p *cloudstack.DeleteStorageNetworkIpRangeParams) (r0 *cloudstack.DeleteStorageNetworkIpRangeResponse, r1 error) {
+	m.record("DeleteStorageNetworkIpRangeWithContext", ctx, p)
+	if m.DeleteStorageNetworkIpRangeWithContextFunc != nil {
+		return m.DeleteStorageNetworkIpRangeWithContextFunc(ctx, p)
+	}
+	if m.DeleteStorageNetworkIpRangeFunc != nil {
+		return m.DeleteStorageNetworkIpRangeFunc(p)
+	}
+	r1 = notMocked("NetworkService.DeleteStorageNetworkIpRangeWithContext")
+	return
+}
+
+// DeleteStorageNetworkIpRangeAsync records the call and calls DeleteStorageNetworkIpRangeAsyncFunc, or DeleteStorageNetworkIpRangeAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) DeleteStorageNetworkIpRangeAsync(p *cloudstack.DeleteStorageNetworkIpRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteStorageNetworkIpRangeAsync", p)
+	if m.DeleteStorageNetworkIpRangeAsyncFunc != nil {
+		return m.DeleteStorageNetworkIpRangeAsyncFunc(p)
+	}
+	if m.DeleteStorageNetworkIpRangeAsyncWithContextFunc != nil {
+		return m.DeleteStorageNetworkIpRangeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.DeleteStorageNetworkIpRangeAsync")
+	return
+}
+
+// DeleteStorageNetworkIpRangeAsyncWithContext records the call and calls DeleteStorageNetworkIpRangeAsyncWithContextFunc, or DeleteStorageNetworkIpRangeAsyncFunc if only that is set
+func (m *MockNetworkService) DeleteStorageNetworkIpRangeAsyncWithContext(ctx context.Context, p *cloudstack.DeleteStorageNetworkIpRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteStorageNetworkIpRangeAsyncWithContext", ctx, p)
+	if m.DeleteStorageNetworkIpRangeAsyncWithContextFunc != nil {
+		return m.DeleteStorageNetworkIpRangeAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteStorageNetworkIpRangeAsyncFunc != nil {
+		return m.DeleteStorageNetworkIpRangeAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.DeleteStorageNetworkIpRangeAsyncWithContext")
+	return
+}
+
+// NewListNetscalerLoadBalancerNetworksParams records the call and calls NewListNetscalerLoadBalancerNetworksParamsFunc
+func (m *MockNetworkService) NewListNetscalerLoadBalancerNetworksParams(lbdeviceid string) (r0 *cloudstack.ListNetscalerLoadBalancerNetworksParams) {
+	m.record("NewListNetscalerLoadBalancerNetworksParams", lbdeviceid)
+	if m.NewListNetscalerLoadBalancerNetworksParamsFunc != nil {
+		return m.NewListNetscalerLoadBalancerNetworksParamsFunc(lbdeviceid)
+	}
+	return cloudstack.NewNetworkService(nil).NewListNetscalerLoadBalancerNetworksParams(lbdeviceid)
+}
+
+// GetNetscalerLoadBalancerNetworkID records the call and calls GetNetscalerLoadBalancerNetworkIDFunc, or GetNetscalerLoadBalancerNetworkIDWithContextFunc if only that is set
+func (m *MockNetworkService) GetNetscalerLoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetNetscalerLoadBalancerNetworkID", keyword, lbdeviceid, opts)
+	if m.GetNetscalerLoadBalancerNetworkIDFunc != nil {
+		return m.GetNetscalerLoadBalancerNetworkIDFunc(keyword, lbdeviceid, opts...)
+	}
+	if m.GetNetscalerLoadBalancerNetworkIDWithContextFunc != nil {
+		return m.GetNetscalerLoadBalancerNetworkIDWithContextFunc(context.Background(), keyword, lbdeviceid, opts...)
+	}
+	r2 = notMocked("NetworkService.GetNetscalerLoadBalancerNetworkID")
+	return
+}
+
+// GetNetscalerLoadBalancerNetworkIDWithContext records the call and calls GetNetscalerLoadBalancerNetworkIDWithContextFunc, or GetNetscalerLoadBalancerNetworkIDFunc if only that is set
+func (m *MockNetworkService) GetNetscalerLoadBalancerNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetNetscalerLoadBalancerNetworkIDWithContext", ctx, keyword, lbdeviceid, opts)
+	if m.GetNetscalerLoadBalancerNetworkIDWithContextFunc != nil {
+		return m.GetNetscalerLoadBalancerNetworkIDWithContextFunc(ctx, keyword, lbdeviceid, opts...)
+	}
+	if m.GetNetscalerLoadBalancerNetworkIDFunc != nil {
+		return m.GetNetscalerLoadBalancerNetworkIDFunc(keyword, lbdeviceid, opts...)
+	}
+	r2 = notMocked("NetworkService.GetNetscalerLoadBalancerNetworkIDWithContext")
+	return
+}
+
+// ListNetscalerLoadBalancerNetworks records the call and calls ListNetscalerLoadBalancerNetworksFunc, or ListNetscalerLoadBalancerNetworksWithContextFunc if only that is set
+func (m *MockNetworkService) ListNetscalerLoadBalancerNetworks(p *cloudstack.ListNetscalerLoadBalancerNetworksParams) (r0 *cloudstack.ListNetscalerLoadBalancerNetworksResponse, r1 error) {
+	m.record("ListNetscalerLoadBalancerNetworks", p)
+	if m.ListNetscalerLoadBalancerNetworksFunc != nil {
+		return m.ListNetscalerLoadBalancerNetworksFunc(p)
+	}
+	if m.ListNetscalerLoadBalancerNetworksWithContextFunc != nil {
+		return m.ListNetscalerLoadBalancerNetworksWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListNetscalerLoadBalancerNetworks")
+	return
+}
+
+// ListNetscalerLoadBalancerNetworksWithContext records the call and calls ListNetscalerLoadBalancerNetworksWithContextFunc, or ListNetscalerLoadBalancerNetworksFunc if only that is set
+func (m *MockNetworkService) ListNetscalerLoadBalancerNetworksWithContext(ctx context.Context, p *cloudstack.ListNetscalerLoadBalancerNetworksParams) (r0 *cloudstack.ListNetscalerLoadBalancerNetworksResponse, r1 error) {
+	m.record("ListNetscalerLoadBalancerNetworksWithContext", ctx, p)
+	if m.ListNetscalerLoadBalancerNetworksWithContextFunc != nil {
+		return m.ListNetscalerLoadBalancerNetworksWithContextFunc(ctx, p)
+	}
+	if m.ListNetscalerLoadBalancerNetworksFunc != nil {
+		return m.ListNetscalerLoadBalancerNetworksFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListNetscalerLoadBalancerNetworksWithContext")
+	return
+}
+
+// NewListNetscalerLoadBalancerNetworksPager records the call and calls NewListNetscalerLoadBalancerNetworksPagerFunc
+func (m *MockNetworkService) NewListNetscalerLoadBalancerNetworksPager(p *cloudstack.ListNetscalerLoadBalancerNetworksParams) (r0 *cloudstack.ListNetscalerLoadBalancerNetworksPager) {
+	m.record("NewListNetscalerLoadBalancerNetworksPager", p)
+	if m.NewListNetscalerLoadBalancerNetworksPagerFunc != nil {
+		return m.NewListNetscalerLoadBalancerNetworksPagerFunc(p)
+	}
+	return
+}
+
+// ListNetscalerLoadBalancerNetworksAll records the call and calls ListNetscalerLoadBalancerNetworksAllFunc, or ListNetscalerLoadBalancerNetworksAllWithContextFunc if only that is set
+func (m *MockNetworkService) ListNetscalerLoadBalancerNetworksAll(p *cloudstack.ListNetscalerLoadBalancerNetworksParams) (r0 *cloudstack.ListNetscalerLoadBalancerNetworksResponse, r1 error) {
+	m.record("ListNetscalerLoadBalancerNetworksAll", p)
+	if m.ListNetscalerLoadBalancerNetworksAllFunc != nil {
+		return m.ListNetscalerLoadBalancerNetworksAllFunc(p)
+	}
+	if m.ListNetscalerLoadBalancerNetworksAllWithContextFunc != nil {
+		return m.ListNetscalerLoadBalancerNetworksAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListNetscalerLoadBalancerNetworksAll")
+	return
+}
+
+// ListNetscalerLoadBalancerNetworksAllWithContext records the call and calls ListNetscalerLoadBalancerNetworksAllWithContextFunc, or ListNetscalerLoadBalancerNetworksAllFunc if only that is set
+func (m *MockNetworkService) ListNetscalerLoadBalancerNetworksAllWithContext(ctx context.Context, p *cloudstack.ListNetscalerLoadBalancerNetworksParams) (r0 *cloudstack.ListNetscalerLoadBalancerNetworksResponse, r1 error) {
+	m.record("ListNetscalerLoadBalancerNetworksAllWithContext", ctx, p)
+	if m.ListNetscalerLoadBalancerNetworksAllWithContextFunc != nil {
+		return m.ListNetscalerLoadBalancerNetworksAllWithContextFunc(ctx, p)
+	}
+	if m.ListNetscalerLoadBalancerNetworksAllFunc != nil {
+		return m.ListNetscalerLoadBalancerNetworksAllFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListNetscalerLoadBalancerNetworksAllWithContext")
+	return
+}
+
+// NewListNetworkIsolationMethodsParams records the call and calls NewListNetworkIsolationMethodsParamsFunc
+func (m *MockNetworkService) NewListNetworkIsolationMethodsParams() (r0 *cloudstack.ListNetworkIsolationMethodsParams) {
+	m.record("NewListNetworkIsolationMethodsParams")
+	if m.NewListNetworkIsolationMethodsParamsFunc != nil {
+		return m.NewListNetworkIsolationMethodsParamsFunc()
+	}
+	return cloudstack.NewNetworkService(nil).NewListNetworkIsolationMethodsParams()
+}
+
+// ListNetworkIsolationMethods records the call and calls ListNetworkIsolationMethodsFunc, or ListNetworkIsolationMethodsWithContextFunc if only that is set
+func (m *MockNetworkService) ListNetworkIsolationMethods(p *cloudstack.ListNetworkIsolationMethodsParams) (r0 *cloudstack.ListNetworkIsolationMethodsResponse, r1 error) {
+	m.record("ListNetworkIsolationMethods", p)
+	if m.ListNetworkIsolationMethodsFunc != nil {
+		return m.ListNetworkIsolationMethodsFunc(p)
+	}
+	if m.ListNetworkIsolationMethodsWithContextFunc != nil {
+		return m.ListNetworkIsolationMethodsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListNetworkIsolationMethods")
+	return
+}
+
+// ListNetworkIsolationMethodsWithContext records the call and calls ListNetworkIsolationMethodsWithContextFunc, or ListNetworkIsolationMethodsFunc if only that is set
+func (m *MockNetworkService) ListNetworkIsolationMethodsWithContext(ctx context.Context, p *cloudstack.ListNetworkIsolationMethodsParams) (r0 *cloudstack.ListNetworkIsolationMethodsResponse, r1 error) {
+	m.record("ListNetworkIsolationMethodsWithContext", ctx, p)
+	if m.ListNetworkIsolationMethodsWithContextFunc != nil {
+		return m.ListNetworkIsolationMethodsWithContextFunc(ctx, p)
+	}
+	if m.ListNetworkIsolationMethodsFunc != nil {
+		return m.ListNetworkIsolationMethodsFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListNetworkIsolationMethodsWithContext")
+	return
+}
+
+// NewListNetworkIsolationMethodsPager records the call and calls NewListNetworkIsolationMethodsPagerFunc
+func (m *MockNetworkService) NewListNetworkIsolationMethodsPager(p *cloudstack.ListNetworkIsolationMethodsParams) (r0 *cloudstack.ListNetworkIsolationMethodsPager) {
+	m.record("NewListNetworkIsolationMethodsPager", p)
+	if m.NewListNetworkIsolationMethodsPagerFunc != nil {
+		return m.NewListNetworkIsolationMethodsPagerFunc(p)
+	}
+	return
+}
+
+// ListNetworkIsolationMethodsAll records the call and calls ListNetworkIsolationMethodsAllFunc, or ListNetworkIsolationMethodsAllWithContextFunc if only that is set
+func (m *MockNetworkService) ListNetworkIsolationMethodsAll(p *cloudstack.ListNetworkIsolationMethodsParams) (r0 *cloudstack.ListNetworkIsolationMethodsResponse, r1 error) {
+	m.record("ListNetworkIsolationMethodsAll", p)
+	if m.ListNetworkIsolationMethodsAllFunc != nil {
+		return m.ListNetworkIsolationMethodsAllFunc(p)
+	}
+	if m.ListNetworkIsolationMethodsAllWithContextFunc != nil {
+		return m.ListNetworkIsolationMethodsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListNetworkIsolationMethodsAll")
+	return
+}
+
+// ListNetworkIsolationMethodsAllWithContext records the call and calls ListNetworkIsolationMethodsAllWithContextFunc, or ListNetworkIsolationMethodsAllFunc if only that is set
+func (m *MockNetworkService) ListNetworkIsolationMethodsAllWithContext(ctx context.Context, p *cloudstack.ListNetworkIsolationMethodsParams) (r0 *cloudstack.ListNetworkIsolationMethodsResponse, r1 error) {
+	m.record("ListNetworkIsolationMethodsAllWithContext", ctx, p)
+	if m.ListNetworkIsolationMethodsAllWithContextFunc != nil {
+		return m.ListNetworkIsolationMethodsAllWithContextFunc(ctx, p)
+	}
+	if m.ListNetworkIsolationMethodsAllFunc != nil {
+		return m.ListNetworkIsolationMethodsAllFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListNetworkIsolationMethodsAllWithContext")
+	return
+}
+
+// NewListNetworkServiceProvidersParams records the call and calls NewListNetworkServiceProvidersParamsFunc
+func (m *MockNetworkService) NewListNetworkServiceProvidersParams() (r0 *cloudstack.ListNetworkServiceProvidersParams) {
+	m.record("NewListNetworkServiceProvidersParams")
+	if m.NewListNetworkServiceProvidersParamsFunc != nil {
+		return m.NewListNetworkServiceProvidersParamsFunc()
+	}
+	return cloudstack.NewNetworkService(nil).NewListNetworkServiceProvidersParams()
+}
+
+// GetNetworkServiceProviderID records the call and calls GetNetworkServiceProviderIDFunc, or GetNetworkServiceProviderIDWithContextFunc if only that is set
+func (m *MockNetworkService) GetNetworkServiceProviderID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetNetworkServiceProviderID", name, opts)
+	if m.GetNetworkServiceProviderIDFunc != nil {
+		return m.GetNetworkServiceProviderIDFunc(name, opts...)
+	}
+	if m.GetNetworkServiceProviderIDWithContextFunc != nil {
+		return m.GetNetworkServiceProviderIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("NetworkService.GetNetworkServiceProviderID")
+	return
+}
+
+// GetNetworkServiceProviderIDWithContext records the call and calls GetNetworkServiceProviderIDWithContextFunc, or GetNetworkServiceProviderIDFunc if only that is set
+func (m *MockNetworkService) GetNetworkServiceProviderIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetNetworkServiceProviderIDWithContext", ctx, name, opts)
+	if m.GetNetworkServiceProviderIDWithContextFunc != nil {
+		return m.GetNetworkServiceProviderIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetNetworkServiceProviderIDFunc != nil {
+		return m.GetNetworkServiceProviderIDFunc(name, opts...)
+	}
+	r2 = notMocked("NetworkService.GetNetworkServiceProviderIDWithContext")
+	return
+}
+
+// ListNetworkServiceProviders records the call and calls ListNetworkServiceProvidersFunc, or ListNetworkServiceProvidersWithContextFunc if only that is set
+func (m *MockNetworkService) ListNetworkServiceProviders(p *cloudstack.ListNetworkServiceProvidersParams) (r0 *cloudstack.ListNetworkServiceProvidersResponse, r1 error) {
+	m.record("ListNetworkServiceProviders", p)
+	if m.ListNetworkServiceProvidersFunc != nil {
+		return m.ListNetworkServiceProvidersFunc(p)
+	}
+	if m.ListNetworkServiceProvidersWithContextFunc != nil {
+		return m.ListNetworkServiceProvidersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListNetworkServiceProviders")
+	return
+}
+
+// ListNetworkServiceProvidersWithContext records the call and calls ListNetworkServiceProvidersWithContextFunc, or ListNetworkServiceProvidersFunc if only that is set
+func (m *MockNetworkService) ListNetworkServiceProvidersWithContext(ctx context.Context, p *cloudstack.ListNetworkServiceProvidersParams) (r0 *cloudstack.ListNetworkServiceProvidersResponse, r1 error) {
+	m.record("ListNetworkServiceProvidersWithContext", ctx, p)
+	if m.ListNetworkServiceProvidersWithContextFunc != nil {
+		return m.ListNetworkServiceProvidersWithContextFunc(ctx, p)
+	}
+	if m.ListNetworkServiceProvidersFunc != nil {
+		return m.ListNetworkServiceProvidersFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListNetworkServiceProvidersWithContext")
+	return
+}
+
+// NewListNetworkServiceProvidersPager records the call and calls NewListNetworkServiceProvidersPagerFunc
+func (m *MockNetworkService) NewListNetworkServiceProvidersPager(p *cloudstack.ListNetworkServiceProvidersParams) (r0 *cloudstack.ListNetworkServiceProvidersPager) {
+	m.record("NewListNetworkServiceProvidersPager", p)
+	if m.NewListNetworkServiceProvidersPagerFunc != nil {
+		return m.NewListNetworkServiceProvidersPagerFunc(p)
+	}
+	return
+}
+
+// ListNetworkServiceProvidersAll records the call and calls ListNetworkServiceProvidersAllFunc, or ListNetworkServiceProvidersAllWithContextFunc if only that is set
+func (m *MockNetworkService) ListNetworkServiceProvidersAll(p *cloudstack.ListNetworkServiceProvidersParams) (r0 *cloudstack.ListNetworkServiceProvidersResponse, r1 error) {
+	m.record("ListNetworkServiceProvidersAll", p)
+	if m.ListNetworkServiceProvidersAllFunc != nil {
+		return m.ListNetworkServiceProvidersAllFunc(p)
+	}
+	if m.ListNetworkServiceProvidersAllWithContextFunc != nil {
+		return m.ListNetworkServiceProvidersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListNetworkServiceProvidersAll")
+	return
+}
+
+// ListNetworkServiceProvidersAllWithContext records the call and calls ListNetworkServiceProvidersAllWithContextFunc, or ListNetworkServiceProvidersAllFunc if only that is set
+func (m *MockNetworkService) ListNetworkServiceProvidersAllWithContext(ctx context.Context, p *cloudstack.ListNetworkServiceProvidersParams) (r0 *cloudstack.ListNetworkServiceProvidersResponse, r1 error) {
+	m.record("ListNetworkServiceProvidersAllWithContext", ctx, p)
+	if m.ListNetworkServiceProvidersAllWithContextFunc != nil {
+		return m.ListNetworkServiceProvidersAllWithContextFunc(ctx, p)
+	}
+	if m.ListNetworkServiceProvidersAllFunc != nil {
+		return m.ListNetworkServiceProvidersAllFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListNetworkServiceProvidersAllWithContext")
+	return
+}
+
+// NewListNetworksParams records the call and calls NewListNetworksParamsFunc
+func (m *MockNetworkService) NewListNetworksParams() (r0 *cloudstack.ListNetworksParams) {
+	m.record("NewListNetworksParams")
+	if m.NewListNetworksParamsFunc != nil {
+		return m.NewListNetworksParamsFunc()
+	}
+	return cloudstack.NewNetworkService(nil).NewListNetworksParams()
+}
+
+// GetNetworkID records the call and calls GetNetworkIDFunc, or GetNetworkIDWithContextFunc if only that is set
+func (m *MockNetworkService) GetNetworkID(keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetNetworkID", keyword, opts)
+	if m.GetNetworkIDFunc != nil {
+		return m.GetNetworkIDFunc(keyword, opts...)
+	}
+	if m.GetNetworkIDWithContextFunc != nil {
+		return m.GetNetworkIDWithContextFunc(context.Background(), keyword, opts...)
+	}
+	r2 = notMocked("NetworkService.GetNetworkID")
+	return
+}
+
+// GetNetworkIDWithContext records the call and calls GetNetworkIDWithContextFunc, or GetNetworkIDFunc if only that is set
+func (m *MockNetworkService) GetNetworkIDWithContext(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetNetworkIDWithContext", ctx, keyword, opts)
+	if m.GetNetworkIDWithContextFunc != nil {
+		return m.GetNetworkIDWithContextFunc(ctx, keyword, opts...)
+	}
+	if m.GetNetworkIDFunc != nil {
+		return m.GetNetworkIDFunc(keyword, opts...)
+	}
+	r2 = notMocked("NetworkService.GetNetworkIDWithContext")
+	return
+}
+
+// GetNetworkByName records the call and calls GetNetworkByNameFunc, or GetNetworkByNameWithContextFunc if only that is set
+func (m *MockNetworkService) GetNetworkByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Network, r1 int, r2 error) {
+	m.record("GetNetworkByName", name, opts)
+	if m.GetNetworkByNameFunc != nil {
+		return m.GetNetworkByNameFunc(name, opts...)
+	}
+	if m.GetNetworkByNameWithContextFunc != nil {
+		return m.GetNetworkByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("NetworkService.GetNetworkByName")
+	return
+}
+
+// GetNetworkByNameWithContext records the call and calls GetNetworkByNameWithContextFunc, or GetNetworkByNameFunc if only that is set
+func (m *MockNetworkService) GetNetworkByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Network, r1 int, r2 error) {
+	m.record("GetNetworkByNameWithContext", ctx, name, opts)
+	if m.GetNetworkByNameWithContextFunc != nil {
+		return m.GetNetworkByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetNetworkByNameFunc != nil {
+		return m.GetNetworkByNameFunc(name, opts...)
+	}
+	r2 = notMocked("NetworkService.GetNetworkByNameWithContext")
+	return
+}
+
+// GetNetworkByID records the call and calls GetNetworkByIDFunc, or GetNetworkByIDWithContextFunc if only that is set
+func (m *MockNetworkService) GetNetworkByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Network, r1 int, r2 error) {
+	m.record("GetNetworkByID", id, opts)
+	if m.GetNetworkByIDFunc != nil {
+		return m.GetNetworkByIDFunc(id, opts...)
+	}
+	if m.GetNetworkByIDWithContextFunc != nil {
+		return m.GetNetworkByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("NetworkService.GetNetworkByID")
+	return
+}
+
+// GetNetworkByIDWithContext records the call and calls GetNetworkByIDWithContextFunc, or GetNetworkByIDFunc if only that is set
+func (m *MockNetworkService) GetNetworkByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Network, r1 int, r2 error) {
+	m.record("GetNetworkByIDWithContext", ctx, id, opts)
+	if m.GetNetworkByIDWithContextFunc != nil {
+		return m.GetNetworkByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetNetworkByIDFunc != nil {
+		return m.GetNetworkByIDFunc(id, opts...)
+	}
+	r2 = notMocked("NetworkService.GetNetworkByIDWithContext")
+	return
+}
+
+// ListNetworks records the call and calls ListNetworksFunc, or ListNetworksWithContextFunc if only that is set
+func (m *MockNetworkService) ListNetworks(p *cloudstack.ListNetworksParams) (r0 *cloudstack.ListNetworksResponse, r1 error) {
+	m.record("ListNetworks", p)
+	if m.ListNetworksFunc != nil {
+		return m.ListNetworksFunc(p)
+	}
+	if m.ListNetworksWithContextFunc != nil {
+		return m.ListNetworksWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListNetworks")
+	return
+}
+
+// ListNetworksWithContext records the call and calls ListNetworksWithContextFunc, or ListNetworksFunc if only that is set
+func (m *MockNetworkService) ListNetworksWithContext(ctx context.Context, p *cloudstack.ListNetworksParams) (r0 *cloudstack.ListNetworksResponse, r1 error) {
+	m.record("ListNetworksWithContext", ctx, p)
+	if m.ListNetworksWithContextFunc != nil {
+		return m.ListNetworksWithContextFunc(ctx, p)
+	}
+	if m.ListNetworksFunc != nil {
+		return m.ListNetworksFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListNetworksWithContext")
+	return
+}
+
+// NewListNetworksPager records the call and calls NewListNetworksPagerFunc
+func (m *MockNetworkService) NewListNetworksPager(p *cloudstack.ListNetworksParams) (r0 *cloudstack.ListNetworksPager) {
+	m.record("NewListNetworksPager", p)
+	if m.NewListNetworksPagerFunc != nil {
+		return m.NewListNetworksPagerFunc(p)
+	}
+	return
+}
+
+// ListNetworksAll records the call and calls ListNetworksAllFunc, or ListNetworksAllWithContextFunc if only that is set
+func (m *MockNetworkService) ListNetworksAll(p *cloudstack.ListNetworksParams) (r0 *cloudstack.ListNetworksResponse, r1 error) {
+	m.record("ListNetworksAll", p)
+	if m.ListNetworksAllFunc != nil {
+		return m.ListNetworksAllFunc(p)
+	}
+	if m.ListNetworksAllWithContextFunc != nil {
+		return m.ListNetworksAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListNetworksAll")
+	return
+}
+
+// ListNetworksAllWithContext records the call and calls ListNetworksAllWithContextFunc, or ListNetworksAllFunc if only that is set
+func (m *MockNetworkService) ListNetworksAllWithContext(ctx context.Context, p *cloudstack.ListNetworksParams) (r0 *cloudstack.ListNetworksResponse, r1 error) {
+	m.record("ListNetworksAllWithContext", ctx, p)
+	if m.ListNetworksAllWithContextFunc != nil {
+		return m.ListNetworksAllWithContextFunc(ctx, p)
+	}
+	if m.ListNetworksAllFunc != nil {
+		return m.ListNetworksAllFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListNetworksAllWithContext")
+	return
+}
+
+// NewListNiciraNvpDeviceNetworksParams records the call and calls NewListNiciraNvpDeviceNetworksParamsFunc
+func (m *MockNetworkService) NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string) (r0 *cloudstack.ListNiciraNvpDeviceNetworksParams) {
+	m.record("NewListNiciraNvpDeviceNetworksParams", nvpdeviceid)
+	if m.NewListNiciraNvpDeviceNetworksParamsFunc != nil {
+		return m.NewListNiciraNvpDeviceNetworksParamsFunc(nvpdeviceid)
+	}
+	return cloudstack.NewNetworkService(nil).NewListNiciraNvpDeviceNetworksParams(nvpdeviceid)
+}
+
+// GetNiciraNvpDeviceNetworkID records the call and calls GetNiciraNvpDeviceNetworkIDFunc, or GetNiciraNvpDeviceNetworkIDWithContextFunc if only that is set
+func (m *MockNetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetNiciraNvpDeviceNetworkID", keyword, nvpdeviceid, opts)
+	if m.GetNiciraNvpDeviceNetworkIDFunc != nil {
+		return m.GetNiciraNvpDeviceNetworkIDFunc(keyword, nvpdeviceid, opts...)
+	}
+	if m.GetNiciraNvpDeviceNetworkIDWithContextFunc != nil {
+		return m.GetNiciraNvpDeviceNetworkIDWithContextFunc(context.Background(), keyword, nvpdeviceid, opts...)
+	}
+	r2 = notMocked("NetworkService.GetNiciraNvpDeviceNetworkID")
+	return
+}
+
+// GetNiciraNvpDeviceNetworkIDWithContext records the call and calls GetNiciraNvpDeviceNetworkIDWithContextFunc, or GetNiciraNvpDeviceNetworkIDFunc if only that is set
+func (m *MockNetworkService) GetNiciraNvpDeviceNetworkIDWithContext(ctx context.Context, keyword string, nvpdeviceid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetNiciraNvpDeviceNetworkIDWithContext", ctx, keyword, nvpdeviceid, opts)
+	if m.GetNiciraNvpDeviceNetworkIDWithContextFunc != nil {
+		return m.GetNiciraNvpDeviceNetworkIDWithContextFunc(ctx, keyword, nvpdeviceid, opts...)
+	}
+	if m.GetNiciraNvpDeviceNetworkIDFunc != nil {
+		return m.GetNiciraNvpDeviceNetworkIDFunc(keyword, nvpdeviceid, opts...)
+	}
+	r2 = notMocked("NetworkService.GetNiciraNvpDeviceNetworkIDWithContext")
+	return
+}
+
+// ListNiciraNvpDeviceNetworks records the call and calls ListNiciraNvpDeviceNetworksFunc, or ListNiciraNvpDeviceNetworksWithContextFunc if only that is set
+func (m *MockNetworkService) ListNiciraNvpDeviceNetworks(p *cloudstack.ListNiciraNvpDeviceNetworksParams) (r0 *cloudstack.ListNiciraNvpDeviceNetworksResponse, r1 error) {
+	m.record("ListNiciraNvpDeviceNetworks", p)
+	if m.ListNiciraNvpDeviceNetworksFunc != nil {
+		return m.ListNiciraNvpDeviceNetworksFunc(p)
+	}
+	if m.ListNiciraNvpDeviceNetworksWithContextFunc != nil {
+		return m.ListNiciraNvpDeviceNetworksWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListNiciraNvpDeviceNetworks")
+	return
+}
+
+// ListNiciraNvpDeviceNetworksWithContext records the call and calls ListNiciraNvpDeviceNetworksWithContextFunc, or ListNiciraNvpDeviceNetworksFunc if only that is set
+func (m *MockNetworkService) ListNiciraNvpDeviceNetworksWithContext(ctx context.Context, p *cloudstack.ListNiciraNvpDeviceNetworksParams) (r0 *cloudstack.ListNiciraNvpDeviceNetworksResponse, r1 error) {
+	m.record("ListNiciraNvpDeviceNetworksWithContext", ctx, p)
+	if m.ListNiciraNvpDeviceNetworksWithContextFunc != nil {
+		return m.ListNiciraNvpDeviceNetworksWithContextFunc(ctx, p)
+	}
+	if m.ListNiciraNvpDeviceNetworksFunc != nil {
+		return m.ListNiciraNvpDeviceNetworksFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListNiciraNvpDeviceNetworksWithContext")
+	return
+}
+
+// NewListNiciraNvpDeviceNetworksPager records the call and calls NewListNiciraNvpDeviceNetworksPagerFunc
+func (m *MockNetworkService) NewListNiciraNvpDeviceNetworksPager(p *cloudstack.ListNiciraNvpDeviceNetworksParams) (r0 *cloudstack.ListNiciraNvpDeviceNetworksPager) {
+	m.record("NewListNiciraNvpDeviceNetworksPager", p)
+	if m.NewListNiciraNvpDeviceNetworksPagerFunc != nil {
+		return m.NewListNiciraNvpDeviceNetworksPagerFunc(p)
+	}
+	return
+}
+
+// ListNiciraNvpDeviceNetworksAll records the call and calls ListNiciraNvpDeviceNetworksAllFunc, or ListNiciraNvpDeviceNetworksAllWithContextFunc if only that is set
+func (m *MockNetworkService) ListNiciraNvpDeviceNetworksAll(p *cloudstack.ListNiciraNvpDeviceNetworksParams) (r0 *cloudstack.ListNiciraNvpDeviceNetworksResponse, r1 error) {
+	m.record("ListNiciraNvpDeviceNetworksAll", p)
+	if m.ListNiciraNvpDeviceNetworksAllFunc != nil {
+		return m.ListNiciraNvpDeviceNetworksAllFunc(p)
+	}
+	if m.ListNiciraNvpDeviceNetworksAllWithContextFunc != nil {
+		return m.ListNiciraNvpDeviceNetworksAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListNiciraNvpDeviceNetworksAll")
+	return
+}
+
+// ListNiciraNvpDeviceNetworksAllWithContext records the call and calls ListNiciraNvpDeviceNetworksAllWithContextFunc, or ListNiciraNvpDeviceNetworksAllFunc if only that is set
+func (m *MockNetworkService) ListNiciraNvpDeviceNetworksAllWithContext(ctx context.Context, p *cloudstack.ListNiciraNvpDeviceNetworksParams) (r0 *cloudstack.ListNiciraNvpDeviceNetworksResponse, r1 error) {
+	m.record("ListNiciraNvpDeviceNetworksAllWithContext", ctx, p)
+	if m.ListNiciraNvpDeviceNetworksAllWithContextFunc != nil {
+		return m.ListNiciraNvpDeviceNetworksAllWithContextFunc(ctx, p)
+	}
+	if m.ListNiciraNvpDeviceNetworksAllFunc != nil {
+		return m.ListNiciraNvpDeviceNetworksAllFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListNiciraNvpDeviceNetworksAllWithContext")
+	return
+}
+
+// NewListOpenDaylightControllersParams records the call and calls NewListOpenDaylightControllersParamsFunc
+func (m *MockNetworkService) NewListOpenDaylightControllersParams() (r0 *cloudstack.ListOpenDaylightControllersParams) {
+	m.record("NewListOpenDaylightControllersParams")
+	if m.NewListOpenDaylightControllersParamsFunc != nil {
+		return m.NewListOpenDaylightControllersParamsFunc()
+	}
+	return cloudstack.NewNetworkService(nil).NewListOpenDaylightControllersParams()
+}
+
+// GetOpenDaylightControllerByID records the call and calls GetOpenDaylightControllerByIDFunc, or GetOpenDaylightControllerByIDWithContextFunc if only that is set
+func (m *MockNetworkService) GetOpenDaylightControllerByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.OpenDaylightController, r1 int, r2 error) {
+	m.record("GetOpenDaylightControllerByID", id, opts)
+	if m.GetOpenDaylightControllerByIDFunc != nil {
+		return m.GetOpenDaylightControllerByIDFunc(id, opts...)
+	}
+	if m.GetOpenDaylightControllerByIDWithContextFunc != nil {
+		return m.GetOpenDaylightControllerByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("NetworkService.GetOpenDaylightControllerByID")
+	return
+}
+
+// GetOpenDaylightControllerByIDWithContext records the call and calls GetOpenDaylightControllerByIDWithContextFunc, or GetOpenDaylightControllerByIDFunc if only that is set
+func (m *MockNetworkService) GetOpenDaylightControllerByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.OpenDaylightController, r1 int, r2 error) {
+	m.record("GetOpenDaylightControllerByIDWithContext", ctx, id, opts)
+	if m.GetOpenDaylightControllerByIDWithContextFunc != nil {
+		return m.GetOpenDaylightControllerByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetOpenDaylightControllerByIDFunc != nil {
+		return m.GetOpenDaylightControllerByIDFunc(id, opts...)
+	}
+	r2 = notMocked("NetworkService.GetOpenDaylightControllerByIDWithContext")
+	return
+}
+
+// ListOpenDaylightControllers records the call and calls ListOpenDaylightControllersFunc, or ListOpenDaylightControllersWithContextFunc if only that is set
+func (m *MockNetworkService) ListOpenDaylightControllers(p *cloudstack.ListOpenDaylightControllersParams) (r0 *cloudstack.ListOpenDaylightControllersResponse, r1 error) {
+	m.record("ListOpenDaylightControllers", p)
+	if m.ListOpenDaylightControllersFunc != nil {
+		return m.ListOpenDaylightControllersFunc(p)
+	}
+	if m.ListOpenDaylightControllersWithContextFunc != nil {
+		return m.ListOpenDaylightControllersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListOpenDaylightControllers")
+	return
+}
+
+// ListOpenDaylightControllersWithContext records the call and calls ListOpenDaylightControllersWithContextFunc, or ListOpenDaylightControllersFunc if only that is set
+func (m *MockNetworkService) ListOpenDaylightControllersWithContext(ctx context.Context, p *cloudstack.ListOpenDaylightControllersParams) (r0 *cloudstack.ListOpenDaylightControllersResponse, r1 error) {
+	m.record("ListOpenDaylightControllersWithContext", ctx, p)
+	if m.ListOpenDaylightControllersWithContextFunc != nil {
+		return m.ListOpenDaylightControllersWithContextFunc(ctx, p)
+	}
+	if m.ListOpenDaylightControllersFunc != nil {
+		return m.ListOpenDaylightControllersFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListOpenDaylightControllersWithContext")
+	return
+}
+
+// NewListPaloAltoFirewallNetworksParams records the call and calls NewListPaloAltoFirewallNetworksParamsFunc
+func (m *MockNetworkService) NewListPaloAltoFirewallNetworksParams(lbdeviceid string) (r0 *cloudstack.ListPaloAltoFirewallNetworksParams) {
+	m.record("NewListPaloAltoFirewallNetworksParams", lbdeviceid)
+	if m.NewListPaloAltoFirewallNetworksParamsFunc != nil {
+		return m.NewListPaloAltoFirewallNetworksParamsFunc(lbdeviceid)
+	}
+	return cloudstack.NewNetworkService(nil).NewListPaloAltoFirewallNetworksParams(lbdeviceid)
+}
+
+// GetPaloAltoFirewallNetworkID records the call and calls GetPaloAltoFirewallNetworkIDFunc, or GetPaloAltoFirewallNetworkIDWithContextFunc if only that is set
+func (m *MockNetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetPaloAltoFirewallNetworkID", keyword, lbdeviceid, opts)
+	if m.GetPaloAltoFirewallNetworkIDFunc != nil {
+		return m.GetPaloAltoFirewallNetworkIDFunc(keyword, lbdeviceid, opts...)
+	}
+	if m.GetPaloAltoFirewallNetworkIDWithContextFunc != nil {
+		return m.GetPaloAltoFirewallNetworkIDWithContextFunc(context.Background(), keyword, lbdeviceid, opts...)
+	}
+	r2 = notMocked("NetworkService.GetPaloAltoFirewallNetworkID")
+	return
+}
+
+// GetPaloAltoFirewallNetworkIDWithContext records the call and calls GetPaloAltoFirewallNetworkIDWithContextFunc, or GetPaloAltoFirewallNetworkIDFunc if only that is set
+func (m *MockNetworkService) GetPaloAltoFirewallNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetPaloAltoFirewallNetworkIDWithContext", ctx, keyword, lbdeviceid, opts)
+	if m.GetPaloAltoFirewallNetworkIDWithContextFunc != nil {
+		return m.GetPaloAltoFirewallNetworkIDWithContextFunc(ctx, keyword, lbdeviceid, opts...)
+	}
+	if m.GetPaloAltoFirewallNetworkIDFunc != nil {
+		return m.GetPaloAltoFirewallNetworkIDFunc(keyword, lbdeviceid, opts...)
+	}
+	r2 = notMocked("NetworkService.GetPaloAltoFirewallNetworkIDWithContext")
+	return
+}
+
+// ListPaloAltoFirewallNetworks records the call and calls ListPaloAltoFirewallNetworksFunc, or ListPaloAltoFirewallNetworksWithContextFunc if only that is set
+func (m *MockNetworkService) ListPaloAltoFirewallNetworks(p *cloudstack.ListPaloAltoFirewallNetworksParams) (r0 *cloudstack.ListPaloAltoFirewallNetworksResponse, r1 error) {
+	m.record("ListPaloAltoFirewallNetworks", p)
+	if m.ListPaloAltoFirewallNetworksFunc != nil {
+		return m.ListPaloAltoFirewallNetworksFunc(p)
+	}
+	if m.ListPaloAltoFirewallNetworksWithContextFunc != nil {
+		return m.ListPaloAltoFirewallNetworksWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListPaloAltoFirewallNetworks")
+	return
+}
+
+// ListPaloAltoFirewallNetworksWithContext records the call and calls ListPaloAltoFirewallNetworksWithContextFunc, or ListPaloAltoFirewallNetworksFunc if only that is set
+func (m *MockNetworkService) ListPaloAltoFirewallNetworksWithContext(ctx context.Context, p *cloudstack.ListPaloAltoFirewallNetworksParams) (r0 *cloudstack.ListPaloAltoFirewallNetworksResponse, r1 error) {
+	m.record("ListPaloAltoFirewallNetworksWithContext", ctx, p)
+	if m.ListPaloAltoFirewallNetworksWithContextFunc != nil {
+		return m.ListPaloAltoFirewallNetworksWithContextFunc(ctx, p)
+	}
+	if m.ListPaloAltoFirewallNetworksFunc != nil {
+		return m.ListPaloAltoFirewallNetworksFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListPaloAltoFirewallNetworksWithContext")
+	return
+}
+
+// NewListPaloAltoFirewallNetworksPager records the call and calls NewListPaloAltoFirewallNetworksPagerFunc
+func (m *MockNetworkService) NewListPaloAltoFirewallNetworksPager(p *cloudstack.ListPaloAltoFirewallNetworksParams) (r0 *cloudstack.ListPaloAltoFirewallNetworksPager) {
+	m.record("NewListPaloAltoFirewallNetworksPager", p)
+	if m.NewListPaloAltoFirewallNetworksPagerFunc != nil {
+		return m.NewListPaloAltoFirewallNetworksPagerFunc(p)
+	}
+	return
+}
+
+// ListPaloAltoFirewallNetworksAll records the call and calls ListPaloAltoFirewallNetworksAllFunc, or ListPaloAltoFirewallNetworksAllWithContextFunc if only that is set
+func (m *MockNetworkService) ListPaloAltoFirewallNetworksAll(p *cloudstack.ListPaloAltoFirewallNetworksParams) (r0 *cloudstack.ListPaloAltoFirewallNetworksResponse, r1 error) {
+	m.record("ListPaloAltoFirewallNetworksAll", p)
+	if m.ListPaloAltoFirewallNetworksAllFunc != nil {
+		return m.ListPaloAltoFirewallNetworksAllFunc(p)
+	}
+	if m.ListPaloAltoFirewallNetworksAllWithContextFunc != nil {
+		return m.ListPaloAltoFirewallNetworksAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListPaloAltoFirewallNetworksAll")
+	return
+}
+
+// ListPaloAltoFirewallNetworksAllWithContext records the call and calls ListPaloAltoFirewallNetworksAllWithContextFunc, or ListPaloAltoFirewallNetworksAllFunc if only that is set
+func (m *MockNetworkService) ListPaloAltoFirewallNetworksAllWithContext(ctx context.Context, p *cloudstack.ListPaloAltoFirewallNetworksParams) (r0 *cloudstack.ListPaloAltoFirewallNetworksResponse, r1 error) {
+	m.record("ListPaloAltoFirewallNetworksAllWithContext", ctx, p)
+	if m.ListPaloAltoFirewallNetworksAllWithContextFunc != nil {
+		return m.ListPaloAltoFirewallNetworksAllWithContextFunc(ctx, p)
+	}
+	if m.ListPaloAltoFirewallNetworksAllFunc != nil {
+		return m.ListPaloAltoFirewallNetworksAllFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListPaloAltoFirewallNetworksAllWithContext")
+	return
+}
+
+// NewListPhysicalNetworksParams records the call and calls NewListPhysicalNetworksParamsFunc
+func (m *MockNetworkService) NewListPhysicalNetworksParams() (r0 *cloudstack.ListPhysicalNetworksParams) {
+	m.record("NewListPhysicalNetworksParams")
+	if m.NewListPhysicalNetworksParamsFunc != nil {
+		return m.NewListPhysicalNetworksParamsFunc()
+	}
+	return cloudstack.NewNetworkService(nil).NewListPhysicalNetworksParams()
+}
+
+// GetPhysicalNetworkID records the call and calls GetPhysicalNetworkIDFunc, or GetPhysicalNetworkIDWithContextFunc if only that is set
+func (m *MockNetworkService) GetPhysicalNetworkID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetPhysicalNetworkID", name, opts)
+	if m.GetPhysicalNetworkIDFunc != nil {
+		return m.GetPhysicalNetworkIDFunc(name, opts...)
+	}
+	if m.GetPhysicalNetworkIDWithContextFunc != nil {
+		return m.GetPhysicalNetworkIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("NetworkService.GetPhysicalNetworkID")
+	return
+}
+
+// GetPhysicalNetworkIDWithContext records the call and calls GetPhysicalNetworkIDWithContextFunc, or GetPhysicalNetworkIDFunc if only that is set
+func (m *MockNetworkService) GetPhysicalNetworkIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetPhysicalNetworkIDWithContext", ctx, name, opts)
+	if m.GetPhysicalNetworkIDWithContextFunc != nil {
+		return m.GetPhysicalNetworkIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetPhysicalNetworkIDFunc != nil {
+		return m.GetPhysicalNetworkIDFunc(name, opts...)
+	}
+	r2 = notMocked("NetworkService.GetPhysicalNetworkIDWithContext")
+	return
+}
+
+// GetPhysicalNetworkByName records the call and calls GetPhysicalNetworkByNameFunc, or GetPhysicalNetworkByNameWithContextFunc if only that is set
+func (m *MockNetworkService) GetPhysicalNetworkByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.PhysicalNetwork, r1 int, r2 error) {
+	m.record("GetPhysicalNetworkByName", name, opts)
+	if m.GetPhysicalNetworkByNameFunc != nil {
+		return m.GetPhysicalNetworkByNameFunc(name, opts...)
+	}
+	if m.GetPhysicalNetworkByNameWithContextFunc != nil {
+		return m.GetPhysicalNetworkByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("NetworkService.GetPhysicalNetworkByName")
+	return
+}
+
+// GetPhysicalNetworkByNameWithContext records the call and calls GetPhysicalNetworkByNameWithContextFunc, or GetPhysicalNetworkByNameFunc if only that is set
+func (m *MockNetworkService) GetPhysicalNetworkByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.PhysicalNetwork, r1 int, r2 error) {
+	m.record("GetPhysicalNetworkByNameWithContext", ctx, name, opts)
+	if m.GetPhysicalNetworkByNameWithContextFunc != nil {
+		return m.GetPhysicalNetworkByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetPhysicalNetworkByNameFunc != nil {
+		return m.GetPhysicalNetworkByNameFunc(name, opts...)
+	}
+	r2 = notMocked("NetworkService.GetPhysicalNetworkByNameWithContext")
+	return
+}
+
+// GetPhysicalNetworkByID records the call and calls GetPhysicalNetworkByIDFunc, or GetPhysicalNetworkByIDWithContextFunc if only that is set
+func (m *MockNetworkService) GetPhysicalNetworkByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.PhysicalNetwork, r1 int, r2 error) {
+	m.record("GetPhysicalNetworkByID", id, opts)
+	if m.GetPhysicalNetworkByIDFunc != nil {
+		return m.GetPhysicalNetworkByIDFunc(id, opts...)
+	}
+	if m.GetPhysicalNetworkByIDWithContextFunc != nil {
+		return m.GetPhysicalNetworkByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("NetworkService.GetPhysicalNetworkByID")
+	return
+}
+
+// GetPhysicalNetworkByIDWithContext records the call and calls GetPhysicalNetworkByIDWithContextFunc, or GetPhysicalNetworkByIDFunc if only that is set
+func (m *MockNetworkService) GetPhysicalNetworkByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.PhysicalNetwork, r1 int, r2 error) {
+	m.record("GetPhysicalNetworkByIDWithContext", ctx, id, opts)
+	if m.GetPhysicalNetworkByIDWithContextFunc != nil {
+		return m.GetPhysicalNetworkByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetPhysicalNetworkByIDFunc != nil {
+		return m.GetPhysicalNetworkByIDFunc(id, opts...)
+	}
+	r2 = notMocked("NetworkService.GetPhysicalNetworkByIDWithContext")
+	return
+}
+
+// ListPhysicalNetworks records the call and calls ListPhysicalNetworksFunc, or ListPhysicalNetworksWithContextFunc if only that is set
+func (m *MockNetworkService) ListPhysicalNetworks(p *cloudstack.ListPhysicalNetworksParams) (r0 *cloudstack.ListPhysicalNetworksResponse, r1 error) {
+	m.record("ListPhysicalNetworks", p)
+	if m.ListPhysicalNetworksFunc != nil {
+		return m.ListPhysicalNetworksFunc(p)
+	}
+	if m.ListPhysicalNetworksWithContextFunc != nil {
+		return m.ListPhysicalNetworksWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListPhysicalNetworks")
+	return
+}
+
+// ListPhysicalNetworksWithContext records the call and calls ListPhysicalNetworksWithContextFunc, or ListPhysicalNetworksFunc if only that is set
+func (m *MockNetworkService) ListPhysicalNetworksWithContext(ctx context.Context, p *cloudstack.ListPhysicalNetworksParams) (r0 *cloudstack.ListPhysicalNetworksResponse, r1 error) {
+	m.record("ListPhysicalNetworksWithContext", ctx, p)
+	if m.ListPhysicalNetworksWithContextFunc != nil {
+		return m.ListPhysicalNetworksWithContextFunc(ctx, p)
+	}
+	if m.ListPhysicalNetworksFunc != nil {
+		return m.ListPhysicalNetworksFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListPhysicalNetworksWithContext")
+	return
+}
+
+// NewListPhysicalNetworksPager records the call and calls NewListPhysicalNetworksPagerFunc
+func (m *MockNetworkService) NewListPhysicalNetworksPager(p *cloudstack.ListPhysicalNetworksParams) (r0 *cloudstack.ListPhysicalNetworksPager) {
+	m.record("NewListPhysicalNetworksPager", p)
+	if m.NewListPhysicalNetworksPagerFunc != nil {
+		return m.NewListPhysicalNetworksPagerFunc(p)
+	}
+	return
+}
+
+// ListPhysicalNetworksAll records the call and calls ListPhysicalNetworksAllFunc, or ListPhysicalNetworksAllWithContextFunc if only that is set
+func (m *MockNetworkService) ListPhysicalNetworksAll(p *cloudstack.ListPhysicalNetworksParams) (r0 *cloudstack.ListPhysicalNetworksResponse, r1 error) {
+	m.record("ListPhysicalNetworksAll", p)
+	if m.ListPhysicalNetworksAllFunc != nil {
+		return m.ListPhysicalNetworksAllFunc(p)
+	}
+	if m.ListPhysicalNetworksAllWithContextFunc != nil {
+		return m.ListPhysicalNetworksAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListPhysicalNetworksAll")
+	return
+}
+
+// ListPhysicalNetworksAllWithContext records the call and calls ListPhysicalNetworksAllWithContextFunc, or ListPhysicalNetworksAllFunc if only that is set
+func (m *MockNetworkService) ListPhysicalNetworksAllWithContext(ctx context.Context, p *cloudstack.ListPhysicalNetworksParams) (r0 *cloudstack.ListPhysicalNetworksResponse, r1 error) {
+	m.record("ListPhysicalNetworksAllWithContext", ctx, p)
+	if m.ListPhysicalNetworksAllWithContextFunc != nil {
+		return m.ListPhysicalNetworksAllWithContextFunc(ctx, p)
+	}
+	if m.ListPhysicalNetworksAllFunc != nil {
+		return m.ListPhysicalNetworksAllFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListPhysicalNetworksAllWithContext")
+	return
+}
+
+// NewListStorageNetworkIpRangeParams records the call and calls NewListStorageNetworkIpRangeParamsFunc
+func (m *MockNetworkService) NewListStorageNetworkIpRangeParams() (r0 *cloudstack.ListStorageNetworkIpRangeParams) {
+	m.record("NewListStorageNetworkIpRangeParams")
+	if m.NewListStorageNetworkIpRangeParamsFunc != nil {
+		return m.NewListStorageNetworkIpRangeParamsFunc()
+	}
+	return cloudstack.NewNetworkService(nil).NewListStorageNetworkIpRangeParams()
+}
+
+// GetStorageNetworkIpRangeByID records the call and calls GetStorageNetworkIpRangeByIDFunc, or GetStorageNetworkIpRangeByIDWithContextFunc if only that is set
+func (m *MockNetworkService) GetStorageNetworkIpRangeByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.StorageNetworkIpRange, r1 int, r2 error) {
+	m.record("GetStorageNetworkIpRangeByID", id, opts)
+	if m.GetStorageNetworkIpRangeByIDFunc != nil {
+		return m.GetStorageNetworkIpRangeByIDFunc(id, opts...)
+	}
+	if m.GetStorageNetworkIpRangeByIDWithContextFunc != nil {
+		return m.GetStorageNetworkIpRangeByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("NetworkService.GetStorageNetworkIpRangeByID")
+	return
+}
+
+// GetStorageNetworkIpRangeByIDWithContext records the call and calls GetStorageNetworkIpRangeByIDWithContextFunc, or GetStorageNetworkIpRangeByIDFunc if only that is set
+func (m *MockNetworkService) GetStorageNetworkIpRangeByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.StorageNetworkIpRange, r1 int, r2 error) {
+	m.record("GetStorageNetworkIpRangeByIDWithContext", ctx, id, opts)
+	if m.GetStorageNetworkIpRangeByIDWithContextFunc != nil {
+		return m.GetStorageNetworkIpRangeByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetStorageNetworkIpRangeByIDFunc != nil {
+		return m.GetStorageNetworkIpRangeByIDFunc(id, opts...)
+	}
+	r2 = notMocked("NetworkService.GetStorageNetworkIpRangeByIDWithContext")
+	return
+}
+
+// ListStorageNetworkIpRange records the call and calls ListStorageNetworkIpRangeFunc, or ListStorageNetworkIpRangeWithContextFunc if only that is set
+func (m *MockNetworkService) ListStorageNetworkIpRange(p *cloudstack.ListStorageNetworkIpRangeParams) (r0 *cloudstack.ListStorageNetworkIpRangeResponse, r1 error) {
+	m.record("ListStorageNetworkIpRange", p)
+	if m.ListStorageNetworkIpRangeFunc != nil {
+		return m.ListStorageNetworkIpRangeFunc(p)
+	}
+	if m.ListStorageNetworkIpRangeWithContextFunc != nil {
+		return m.ListStorageNetworkIpRangeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListStorageNetworkIpRange")
+	return
+}
+
+// ListStorageNetworkIpRangeWithContext records the call and calls ListStorageNetworkIpRangeWithContextFunc, or ListStorageNetworkIpRangeFunc if only that is set
+func (m *MockNetworkService) ListStorageNetworkIpRangeWithContext(ctx context.Context, p *cloudstack.ListStorageNetworkIpRangeParams) (r0 *cloudstack.ListStorageNetworkIpRangeResponse, r1 error) {
+	m.record("ListStorageNetworkIpRangeWithContext", ctx, p)
+	if m.ListStorageNetworkIpRangeWithContextFunc != nil {
+		return m.ListStorageNetworkIpRangeWithContextFunc(ctx, p)
+	}
+	if m.ListStorageNetworkIpRangeFunc != nil {
+		return m.ListStorageNetworkIpRangeFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListStorageNetworkIpRangeWithContext")
+	return
+}
+
+// NewListStorageNetworkIpRangePager records the call and calls NewListStorageNetworkIpRangePagerFunc
+func (m *MockNetworkService) NewListStorageNetworkIpRangePager(p *cloudstack.ListStorageNetworkIpRangeParams) (r0 *cloudstack.ListStorageNetworkIpRangePager) {
+	m.record("NewListStorageNetworkIpRangePager", p)
+	if m.NewListStorageNetworkIpRangePagerFunc != nil {
+		return m.NewListStorageNetworkIpRangePagerFunc(p)
+	}
+	return
+}
+
+// ListStorageNetworkIpRangeAll records the call and calls ListStorageNetworkIpRangeAllFunc, or ListStorageNetworkIpRangeAllWithContextFunc if only that is set
+func (m *MockNetworkService) ListStorageNetworkIpRangeAll(p *cloudstack.ListStorageNetworkIpRangeParams) (r0 *cloudstack.ListStorageNetworkIpRangeResponse, r1 error) {
+	m.record("ListStorageNetworkIpRangeAll", p)
+	if m.ListStorageNetworkIpRangeAllFunc != nil {
+		return m.ListStorageNetworkIpRangeAllFunc(p)
+	}
+	if m.ListStorageNetworkIpRangeAllWithContextFunc != nil {
+		return m.ListStorageNetworkIpRangeAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListStorageNetworkIpRangeAll")
+	return
+}
+
+// ListStorageNetworkIpRangeAllWithContext records the call and calls ListStorageNetworkIpRangeAllWithContextFunc, or ListStorageNetworkIpRangeAllFunc if only that is set
+func (m *MockNetworkService) ListStorageNetworkIpRangeAllWithContext(ctx context.Context, p *cloudstack.ListStorageNetworkIpRangeParams) (r0 *cloudstack.ListStorageNetworkIpRangeResponse, r1 error) {
+	m.record("ListStorageNetworkIpRangeAllWithContext", ctx, p)
+	if m.ListStorageNetworkIpRangeAllWithContextFunc != nil {
+		return m.ListStorageNetworkIpRangeAllWithContextFunc(ctx, p)
+	}
+	if m.ListStorageNetworkIpRangeAllFunc != nil {
+		return m.ListStorageNetworkIpRangeAllFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListStorageNetworkIpRangeAllWithContext")
+	return
+}
+
+// NewListSupportedNetworkServicesParams records the call and calls NewListSupportedNetworkServicesParamsFunc
+func (m *MockNetworkService) NewListSupportedNetworkServicesParams() (r0 *cloudstack.ListSupportedNetworkServicesParams) {
+	m.record("NewListSupportedNetworkServicesParams")
+	if m.NewListSupportedNetworkServicesParamsFunc != nil {
+		return m.NewListSupportedNetworkServicesParamsFunc()
+	}
+	return cloudstack.NewNetworkService(nil).NewListSupportedNetworkServicesParams()
+}
+
+// ListSupportedNetworkServices records the call and calls ListSupportedNetworkServicesFunc, or ListSupportedNetworkServicesWithContextFunc if only that is set
+func (m *MockNetworkService) ListSupportedNetworkServices(p *cloudstack.ListSupportedNetworkServicesParams) (r0 *cloudstack.ListSupportedNetworkServicesResponse, r1 error) {
+	m.record("ListSupportedNetworkServices", p)
+	if m.ListSupportedNetworkServicesFunc != nil {
+		return m.ListSupportedNetworkServicesFunc(p)
+	}
+	if m.ListSupportedNetworkServicesWithContextFunc != nil {
+		return m.ListSupportedNetworkServicesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListSupportedNetworkServices")
+	return
+}
+
+// ListSupportedNetworkServicesWithContext records the call and calls ListSupportedNetworkServicesWithContextFunc, or ListSupportedNetworkServicesFunc if only that is set
+func (m *MockNetworkService) ListSupportedNetworkServicesWithContext(ctx context.Context, p *cloudstack.ListSupportedNetworkServicesParams) (r0 *cloudstack.ListSupportedNetworkServicesResponse, r1 error) {
+	m.record("ListSupportedNetworkServicesWithContext", ctx, p)
+	if m.ListSupportedNetworkServicesWithContextFunc != nil {
+		return m.ListSupportedNetworkServicesWithContextFunc(ctx, p)
+	}
+	if m.ListSupportedNetworkServicesFunc != nil {
+		return m.ListSupportedNetworkServicesFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListSupportedNetworkServicesWithContext")
+	return
+}
+
+// NewListSupportedNetworkServicesPager records the call and calls NewListSupportedNetworkServicesPagerFunc
+func (m *MockNetworkService) NewListSupportedNetworkServicesPager(p *cloudstack.ListSupportedNetworkServicesParams) (r0 *cloudstack.ListSupportedNetworkServicesPager) {
+	m.record("NewListSupportedNetworkServicesPager", p)
+	if m.NewListSupportedNetworkServicesPagerFunc != nil {
+		return m.NewListSupportedNetworkServicesPagerFunc(p)
+	}
+	return
+}
+
+// ListSupportedNetworkServicesAll records the call and calls ListSupportedNetworkServicesAllFunc, or ListSupportedNetworkServicesAllWithContextFunc if only that is set
+func (m *MockNetworkService) ListSupportedNetworkServicesAll(p *cloudstack.ListSupportedNetworkServicesParams) (r0 *cloudstack.ListSupportedNetworkServicesResponse, r1 error) {
+	m.record("ListSupportedNetworkServicesAll", p)
+	if m.ListSupportedNetworkServicesAllFunc != nil {
+		return m.ListSupportedNetworkServicesAllFunc(p)
+	}
+	if m.ListSupportedNetworkServicesAllWithContextFunc != nil {
+		return m.ListSupportedNetworkServicesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ListSupportedNetworkServicesAll")
+	return
+}
+
+// ListSupportedNetworkServicesAllWithContext records the call and calls ListSupportedNetworkServicesAllWithContextFunc, or ListSupportedNetworkServicesAllFunc if only that is set
+func (m *MockNetworkService) ListSupportedNetworkServicesAllWithContext(ctx context.Context, p *cloudstack.ListSupportedNetworkServicesParams) (r0 *cloudstack.ListSupportedNetworkServicesResponse, r1 error) {
+	m.record("ListSupportedNetworkServicesAllWithContext", ctx, p)
+	if m.ListSupportedNetworkServicesAllWithContextFunc != nil {
+		return m.ListSupportedNetworkServicesAllWithContextFunc(ctx, p)
+	}
+	if m.ListSupportedNetworkServicesAllFunc != nil {
+		return m.ListSupportedNetworkServicesAllFunc(p)
+	}
+	r1 = notMocked("NetworkService.ListSupportedNetworkServicesAllWithContext")
+	return
+}
+
+// NewMigrateNetworkParams records the call and calls NewMigrateNetworkParamsFunc
+func (m *MockNetworkService) NewMigrateNetworkParams(networkid string, networkofferingid string) (r0 *cloudstack.MigrateNetworkParams) {
+	m.record("NewMigrateNetworkParams", networkid, networkofferingid)
+	if m.NewMigrateNetworkParamsFunc != nil {
+		return m.NewMigrateNetworkParamsFunc(networkid, networkofferingid)
+	}
+	return cloudstack.NewNetworkService(nil).NewMigrateNetworkParams(networkid, networkofferingid)
+}
+
+// MigrateNetwork records the call and calls MigrateNetworkFunc, or MigrateNetworkWithContextFunc if only that is set
+func (m *MockNetworkService) MigrateNetwork(p *cloudstack.MigrateNetworkParams) (r0 *cloudstack.MigrateNetworkResponse, r1 error) {
+	m.record("MigrateNetwork", p)
+	if m.MigrateNetworkFunc != nil {
+		return m.MigrateNetworkFunc(p)
+	}
+	if m.MigrateNetworkWithContextFunc != nil {
+		return m.MigrateNetworkWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.MigrateNetwork")
+	return
+}
+
+// MigrateNetworkWithContext records the call and calls MigrateNetworkWithContextFunc, or MigrateNetworkFunc if only that is set
+func (m *MockNetworkService) MigrateNetworkWithContext(ctx context.Context, p *cloudstack.MigrateNetworkParams) (r0 *cloudstack.MigrateNetworkResponse, r1 error) {
+	m.record("MigrateNetworkWithContext", ctx, p)
+	if m.MigrateNetworkWithContextFunc != nil {
+		return m.MigrateNetworkWithContextFunc(ctx, p)
+	}
+	if m.MigrateNetworkFunc != nil {
+		return m.MigrateNetworkFunc(p)
+	}
+	r1 = notMocked("NetworkService.MigrateNetworkWithContext")
+	return
+}
+
+// MigrateNetworkAsync records the call and calls MigrateNetworkAsyncFunc, or MigrateNetworkAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) MigrateNetworkAsync(p *cloudstack.MigrateNetworkParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MigrateNetworkAsync", p)
+	if m.MigrateNetworkAsyncFunc != nil {
+		return m.MigrateNetworkAsyncFunc(p)
+	}
+	if m.MigrateNetworkAsyncWithContextFunc != nil {
+		return m.MigrateNetworkAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.MigrateNetworkAsync")
+	return
+}
+
+// MigrateNetworkAsyncWithContext records the call and calls MigrateNetworkAsyncWithContextFunc, or MigrateNetworkAsyncFunc if only that is set
+func (m *MockNetworkService) MigrateNetworkAsyncWithContext(ctx context.Context, p *cloudstack.MigrateNetworkParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MigrateNetworkAsyncWithContext", ctx, p)
+	if m.MigrateNetworkAsyncWithContextFunc != nil {
+		return m.MigrateNetworkAsyncWithContextFunc(ctx, p)
+	}
+	if m.MigrateNetworkAsyncFunc != nil {
+		return m.MigrateNetworkAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.MigrateNetworkAsyncWithContext")
+	return
+}
+
+// NewReleasePublicIpRangeParams records the call and calls NewReleasePublicIpRangeParamsFunc
+func (m *MockNetworkService) NewReleasePublicIpRangeParams(id string) (r0 *cloudstack.ReleasePublicIpRangeParams) {
+	m.record("NewReleasePublicIpRangeParams", id)
+	if m.NewReleasePublicIpRangeParamsFunc != nil {
+		return m.NewReleasePublicIpRangeParamsFunc(id)
+	}
+	return cloudstack.NewNetworkService(nil).NewReleasePublicIpRangeParams(id)
+}
+
+// ReleasePublicIpRange records the call and calls ReleasePublicIpRangeFunc, or ReleasePublicIpRangeWithContextFunc if only that is set
+func (m *MockNetworkService) ReleasePublicIpRange(p *cloudstack.ReleasePublicIpRangeParams) (r0 *cloudstack.ReleasePublicIpRangeResponse, r1 error) {
+	m.record("ReleasePublicIpRange", p)
+	if m.ReleasePublicIpRangeFunc != nil {
+		return m.ReleasePublicIpRangeFunc(p)
+	}
+	if m.ReleasePublicIpRangeWithContextFunc != nil {
+		return m.ReleasePublicIpRangeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.ReleasePublicIpRange")
+	return
+}
+
+// ReleasePublicIpRangeWithContext records the call and calls ReleasePublicIpRangeWithContextFunc, or ReleasePublicIpRangeFunc if only that is set
+func (m *MockNetworkService) ReleasePublicIpRangeWithContext(ctx context.Context, p *cloudstack.ReleasePublicIpRangeParams) (r0 *cloudstack.ReleasePublicIpRangeResponse, r1 error) {
+	m.record("ReleasePublicIpRangeWithContext", ctx, p)
+	if m.ReleasePublicIpRangeWithContextFunc != nil {
+		return m.ReleasePublicIpRangeWithContextFunc(ctx, p)
+	}
+	if m.ReleasePublicIpRangeFunc != nil {
+		return m.ReleasePublicIpRangeFunc(p)
+	}
+	r1 = notMocked("NetworkService.ReleasePublicIpRangeWithContext")
+	return
+}
+
+// NewRestartNetworkParams records the call and calls NewRestartNetworkParamsFunc
+func (m *MockNetworkService) NewRestartNetworkParams(id string) (r0 *cloudstack.RestartNetworkParams) {
+	m.record("NewRestartNetworkParams", id)
+	if m.NewRestartNetworkParamsFunc != nil {
+		return m.NewRestartNetworkParamsFunc(id)
+	}
+	return cloudstack.NewNetworkService(nil).NewRestartNetworkParams(id)
+}
+
+// RestartNetwork records the call and calls RestartNetworkFunc, or RestartNetworkWithContextFunc if only that is set
+func (m *MockNetworkService) RestartNetwork(p *cloudstack.RestartNetworkParams) (r0 *cloudstack.RestartNetworkResponse, r1 error) {
+	m.record("RestartNetwork", p)
+	if m.RestartNetworkFunc != nil {
+		return m.RestartNetworkFunc(p)
+	}
+	if m.RestartNetworkWithContextFunc != nil {
+		return m.RestartNetworkWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.RestartNetwork")
+	return
+}
+
+// RestartNetworkWithContext records the call and calls RestartNetworkWithContextFunc, or RestartNetworkFunc if only that is set
+func (m *MockNetworkService) RestartNetworkWithContext(ctx context.Context, p *cloudstack.RestartNetworkParams) (r0 *cloudstack.RestartNetworkResponse, r1 error) {
+	m.record("RestartNetworkWithContext", ctx, p)
+	if m.RestartNetworkWithContextFunc != nil {
+		return m.RestartNetworkWithContextFunc(ctx, p)
+	}
+	if m.RestartNetworkFunc != nil {
+		return m.RestartNetworkFunc(p)
+	}
+	r1 = notMocked("NetworkService.RestartNetworkWithContext")
+	return
+}
+
+// RestartNetworkAsync records the call and calls RestartNetworkAsyncFunc, or RestartNetworkAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) RestartNetworkAsync(p *cloudstack.RestartNetworkParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RestartNetworkAsync", p)
+	if m.RestartNetworkAsyncFunc != nil {
+		return m.RestartNetworkAsyncFunc(p)
+	}
+	if m.RestartNetworkAsyncWithContextFunc != nil {
+		return m.RestartNetworkAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.RestartNetworkAsync")
+	return
+}
+
+// RestartNetworkAsyncWithContext records the call and calls RestartNetworkAsyncWithContextFunc, or RestartNetworkAsyncFunc if only that is set
+func (m *MockNetworkService) RestartNetworkAsyncWithContext(ctx context.Context, p *cloudstack.RestartNetworkParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RestartNetworkAsyncWithContext", ctx, p)
+	if m.RestartNetworkAsyncWithContextFunc != nil {
+		return m.RestartNetworkAsyncWithContextFunc(ctx, p)
+	}
+	if m.RestartNetworkAsyncFunc != nil {
+		return m.RestartNetworkAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.RestartNetworkAsyncWithContext")
+	return
+}
+
+// NewUpdateNetworkParams records the call and calls NewUpdateNetworkParamsFunc
+func (m *MockNetworkService) NewUpdateNetworkParams(id string) (r0 *cloudstack.UpdateNetworkParams) {
+	m.record("NewUpdateNetworkParams", id)
+	if m.NewUpdateNetworkParamsFunc != nil {
+		return m.NewUpdateNetworkParamsFunc(id)
+	}
+	return cloudstack.NewNetworkService(nil).NewUpdateNetworkParams(id)
+}
+
+// UpdateNetwork records the call and calls UpdateNetworkFunc, or UpdateNetworkWithContextFunc if only that is set
+func (m *MockNetworkService) UpdateNetwork(p *cloudstack.UpdateNetworkParams) (r0 *cloudstack.UpdateNetworkResponse, r1 error) {
+	m.record("UpdateNetwork", p)
+	if m.UpdateNetworkFunc != nil {
+		return m.UpdateNetworkFunc(p)
+	}
+	if m.UpdateNetworkWithContextFunc != nil {
+		return m.UpdateNetworkWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.UpdateNetwork")
+	return
+}
+
+// UpdateNetworkWithContext records the call and calls UpdateNetworkWithContextFunc, or UpdateNetworkFunc if only that is set
+func (m *MockNetworkService) UpdateNetworkWithContext(ctx context.Context, p *cloudstack.UpdateNetworkParams) (r0 *cloudstack.UpdateNetworkResponse, r1 error) {
+	m.record("UpdateNetworkWithContext", ctx, p)
+	if m.UpdateNetworkWithContextFunc != nil {
+		return m.UpdateNetworkWithContextFunc(ctx, p)
+	}
+	if m.UpdateNetworkFunc != nil {
+		return m.UpdateNetworkFunc(p)
+	}
+	r1 = notMocked("NetworkService.UpdateNetworkWithContext")
+	return
+}
+
+// UpdateNetworkAsync records the call and calls UpdateNetworkAsyncFunc, or UpdateNetworkAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) UpdateNetworkAsync(p *cloudstack.UpdateNetworkParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateNetworkAsync", p)
+	if m.UpdateNetworkAsyncFunc != nil {
+		return m.UpdateNetworkAsyncFunc(p)
+	}
+	if m.UpdateNetworkAsyncWithContextFunc != nil {
+		return m.UpdateNetworkAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.UpdateNetworkAsync")
+	return
+}
+
+// UpdateNetworkAsyncWithContext records the call and calls UpdateNetworkAsyncWithContextFunc, or UpdateNetworkAsyncFunc if only that is set
+func (m *MockNetworkService) UpdateNetworkAsyncWithContext(ctx context.Context, p *cloudstack.UpdateNetworkParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateNetworkAsyncWithContext", ctx, p)
+	if m.UpdateNetworkAsyncWithContextFunc != nil {
+		return m.UpdateNetworkAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateNetworkAsyncFunc != nil {
+		return m.UpdateNetworkAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.UpdateNetworkAsyncWithContext")
+	return
+}
+
+// NewUpdateNetworkServiceProviderParams records the call and calls NewUpdateNetworkServiceProviderParamsFunc
+func (m *MockNetworkService) NewUpdateNetworkServiceProviderParams(id string) (r0 *cloudstack.UpdateNetworkServiceProviderParams) {
+	m.record("NewUpdateNetworkServiceProviderParams", id)
+	if m.NewUpdateNetworkServiceProviderParamsFunc != nil {
+		return m.NewUpdateNetworkServiceProviderParamsFunc(id)
+	}
+	return cloudstack.NewNetworkService(nil).NewUpdateNetworkServiceProviderParams(id)
+}
+
+// UpdateNetworkServiceProvider records the call and calls UpdateNetworkServiceProviderFunc, or UpdateNetworkServiceProviderWithContextFunc if only that is set
+func (m *MockNetworkService) UpdateNetworkServiceProvider(p *cloudstack.UpdateNetworkServiceProviderParams) (r0 *cloudstack.UpdateNetworkServiceProviderResponse, r1 error) {
+	m.record("UpdateNetworkServiceProvider", p)
+	if m.UpdateNetworkServiceProviderFunc != nil {
+		return m.UpdateNetworkServiceProviderFunc(p)
+	}
+	if m.UpdateNetworkServiceProviderWithContextFunc != nil {
+		return m.UpdateNetworkServiceProviderWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.UpdateNetworkServiceProvider")
+	return
+}
+
+// UpdateNetworkServiceProviderWithContext records the call and calls UpdateNetworkServiceProviderWithContextFunc, or UpdateNetworkServiceProviderFunc if only that is set
+func (m *MockNetworkService) UpdateNetworkServiceProviderWithContext(ctx context.Context, p *cloudstack.UpdateNetworkServiceProviderParams) (r0 *cloudstack.UpdateNetworkServiceProviderResponse, r1 error) {
+	m.record("UpdateNetworkServiceProviderWithContext", ctx, p)
+	if m.UpdateNetworkServiceProviderWithContextFunc != nil {
+		return m.UpdateNetworkServiceProviderWithContextFunc(ctx, p)
+	}
+	if m.UpdateNetworkServiceProviderFunc != nil {
+		return m.UpdateNetworkServiceProviderFunc(p)
+	}
+	r1 = notMocked("NetworkService.UpdateNetworkServiceProviderWithContext")
+	return
+}
+
+// UpdateNetworkServiceProviderAsync records the call and calls UpdateNetworkServiceProviderAsyncFunc, or UpdateNetworkServiceProviderAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) UpdateNetworkServiceProviderAsync(p *cloudstack.UpdateNetworkServiceProviderParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateNetworkServiceProviderAsync", p)
+	if m.UpdateNetworkServiceProviderAsyncFunc != nil {
+		return m.UpdateNetworkServiceProviderAsyncFunc(p)
+	}
+	if m.UpdateNetworkServiceProviderAsyncWithContextFunc != nil {
+		return m.UpdateNetworkServiceProviderAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.UpdateNetworkServiceProviderAsync")
+	return
+}
+
+// UpdateNetworkServiceProviderAsyncWithContext records the call and calls UpdateNetworkServiceProviderAsyncWithContextFunc, or UpdateNetworkServiceProviderAsyncFunc if only that is set
+func (m *MockNetworkService) UpdateNetworkServiceProviderAsyncWithContext(ctx context.Context, p *cloudstack.UpdateNetworkServiceProviderParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateNetworkServiceProviderAsyncWithContext", ctx, p)
+	if m.UpdateNetworkServiceProviderAsyncWithContextFunc != nil {
+		return m.UpdateNetworkServiceProviderAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateNetworkServiceProviderAsyncFunc != nil {
+		return m.UpdateNetworkServiceProviderAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.UpdateNetworkServiceProviderAsyncWithContext")
+	return
+}
+
+// NewUpdatePhysicalNetworkParams records the call and calls NewUpdatePhysicalNetworkParamsFunc
+func (m *MockNetworkService) NewUpdatePhysicalNetworkParams(id string) (r0 *cloudstack.UpdatePhysicalNetworkParams) {
+	m.record("NewUpdatePhysicalNetworkParams", id)
+	if m.NewUpdatePhysicalNetworkParamsFunc != nil {
+		return m.NewUpdatePhysicalNetworkParamsFunc(id)
+	}
+	return cloudstack.NewNetworkService(nil).NewUpdatePhysicalNetworkParams(id)
+}
+
+// UpdatePhysicalNetwork records the call and calls UpdatePhysicalNetworkFunc, or UpdatePhysicalNetworkWithContextFunc if only that is set
+func (m *MockNetworkService) UpdatePhysicalNetwork(p *cloudstack.UpdatePhysicalNetworkParams) (r0 *cloudstack.UpdatePhysicalNetworkResponse, r1 error) {
+	m.record("UpdatePhysicalNetwork", p)
+	if m.UpdatePhysicalNetworkFunc != nil {
+		return m.UpdatePhysicalNetworkFunc(p)
+	}
+	if m.UpdatePhysicalNetworkWithContextFunc != nil {
+		return m.UpdatePhysicalNetworkWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.UpdatePhysicalNetwork")
+	return
+}
+
+// UpdatePhysicalNetworkWithContext records the call and calls UpdatePhysicalNetworkWithContextFunc, or UpdatePhysicalNetworkFunc if only that is set
+func (m *MockNetworkService) UpdatePhysicalNetworkWithContext(ctx context.Context, p *cloudstack.UpdatePhysicalNetworkParams) (r0 *cloudstack.UpdatePhysicalNetworkResponse, r1 error) {
+	m.record("UpdatePhysicalNetworkWithContext", ctx, p)
+	if m.UpdatePhysicalNetworkWithContextFunc != nil {
+		return m.UpdatePhysicalNetworkWithContextFunc(ctx, p)
+	}
+	if m.UpdatePhysicalNetworkFunc != nil {
+		return m.UpdatePhysicalNetworkFunc(p)
+	}
+	r1 = notMocked("NetworkService.UpdatePhysicalNetworkWithContext")
+	return
+}
+
+// UpdatePhysicalNetworkAsync records the call and calls UpdatePhysicalNetworkAsyncFunc, or UpdatePhysicalNetworkAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) UpdatePhysicalNetworkAsync(p *cloudstack.UpdatePhysicalNetworkParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdatePhysicalNetworkAsync", p)
+	if m.UpdatePhysicalNetworkAsyncFunc != nil {
+		return m.UpdatePhysicalNetworkAsyncFunc(p)
+	}
+	if m.UpdatePhysicalNetworkAsyncWithContextFunc != nil {
+		return m.UpdatePhysicalNetworkAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.UpdatePhysicalNetworkAsync")
+	return
+}
+
+// UpdatePhysicalNetworkAsyncWithContext records the call and calls UpdatePhysicalNetworkAsyncWithContextFunc, or UpdatePhysicalNetworkAsyncFunc if only that is set
+func (m *MockNetworkService) UpdatePhysicalNetworkAsyncWithContext(ctx context.Context, p *cloudstack.UpdatePhysicalNetworkParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdatePhysicalNetworkAsyncWithContext", ctx, p)
+	if m.UpdatePhysicalNetworkAsyncWithContextFunc != nil {
+		return m.UpdatePhysicalNetworkAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdatePhysicalNetworkAsyncFunc != nil {
+		return m.UpdatePhysicalNetworkAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.UpdatePhysicalNetworkAsyncWithContext")
+	return
+}
+
+// NewUpdateStorageNetworkIpRangeParams records the call and calls NewUpdateStorageNetworkIpRangeParamsFunc
+func (m *MockNetworkService) NewUpdateStorageNetworkIpRangeParams(id string) (r0 *cloudstack.UpdateStorageNetworkIpRangeParams) {
+	m.record("NewUpdateStorageNetworkIpRangeParams", id)
+	if m.NewUpdateStorageNetworkIpRangeParamsFunc != nil {
+		return m.NewUpdateStorageNetworkIpRangeParamsFunc(id)
+	}
+	return cloudstack.NewNetworkService(nil).NewUpdateStorageNetworkIpRangeParams(id)
+}
+
+// UpdateStorageNetworkIpRange records the call and calls UpdateStorageNetworkIpRangeFunc, or UpdateStorageNetworkIpRangeWithContextFunc if only that is set
+func (m *MockNetworkService) UpdateStorageNetworkIpRange(p *cloudstack.UpdateStorageNetworkIpRangeParams) (r0 *cloudstack.UpdateStorageNetworkIpRangeResponse, r1 error) {
+	m.record("UpdateStorageNetworkIpRange", p)
+	if m.UpdateStorageNetworkIpRangeFunc != nil {
+		return m.UpdateStorageNetworkIpRangeFunc(p)
+	}
+	if m.UpdateStorageNetworkIpRangeWithContextFunc != nil {
+		return m.UpdateStorageNetworkIpRangeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.UpdateStorageNetworkIpRange")
+	return
+}
+
+// UpdateStorageNetworkIpRangeWithContext records the call and calls UpdateStorageNetworkIpRangeWithContextFunc, or UpdateStorageNetworkIpRangeFunc if only that is set
+func (m *MockNetworkService) UpdateStorageNetworkIpRangeWithContext(ctx context.Context, p *cloudstack.UpdateStorageNetworkIpRangeParams) (r0 *cloudstack.UpdateStorageNetworkIpRangeResponse, r1 error) {
+	m.record("UpdateStorageNetworkIpRangeWithContext", ctx, p)
+	if m.UpdateStorageNetworkIpRangeWithContextFunc != nil {
+		return m.UpdateStorageNetworkIpRangeWithContextFunc(ctx, p)
+	}
+	if m.UpdateStorageNetworkIpRangeFunc != nil {
+		return m.UpdateStorageNetworkIpRangeFunc(p)
+	}
+	r1 = notMocked("NetworkService.UpdateStorageNetworkIpRangeWithContext")
+	return
+}
+
+// UpdateStorageNetworkIpRangeAsync records the call and calls UpdateStorageNetworkIpRangeAsyncFunc, or UpdateStorageNetworkIpRangeAsyncWithContextFunc if only that is set
+func (m *MockNetworkService) UpdateStorageNetworkIpRangeAsync(p *cloudstack.UpdateStorageNetworkIpRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateStorageNetworkIpRangeAsync", p)
+	if m.UpdateStorageNetworkIpRangeAsyncFunc != nil {
+		return m.UpdateStorageNetworkIpRangeAsyncFunc(p)
+	}
+	if m.UpdateStorageNetworkIpRangeAsyncWithContextFunc != nil {
+		return m.UpdateStorageNetworkIpRangeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NetworkService.UpdateStorageNetworkIpRangeAsync")
+	return
+}
+
+// UpdateStorageNetworkIpRangeAsyncWithContext records the call and calls UpdateStorageNetworkIpRangeAsyncWithContextFunc, or UpdateStorageNetworkIpRangeAsyncFunc if only that is set
+func (m *MockNetworkService) UpdateStorageNetworkIpRangeAsyncWithContext(ctx context.Context, p *cloudstack.UpdateStorageNetworkIpRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateStorageNetworkIpRangeAsyncWithContext", ctx, p)
+	if m.UpdateStorageNetworkIpRangeAsyncWithContextFunc != nil {
+		return m.UpdateStorageNetworkIpRangeAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateStorageNetworkIpRangeAsyncFunc != nil {
+		return m.UpdateStorageNetworkIpRangeAsyncFunc(p)
+	}
+	r1 = notMocked("NetworkService.UpdateStorageNetworkIpRangeAsyncWithContext")
+	return
+}
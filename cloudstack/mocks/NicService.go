@@ -0,0 +1,313 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockNicService is a mock of cloudstack.NicServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockNicService struct {
+	Recorder
+
+	NewAddIpToNicParamsFunc             func(nicid string) *cloudstack.AddIpToNicParams
+	AddIpToNicFunc                      func(p *cloudstack.AddIpToNicParams) (*cloudstack.AddIpToNicResponse, error)
+	AddIpToNicWithContextFunc           func(ctx context.Context, p *cloudstack.AddIpToNicParams) (*cloudstack.AddIpToNicResponse, error)
+	AddIpToNicAsyncFunc                 func(p *cloudstack.AddIpToNicParams) (*cloudstack.Job, error)
+	AddIpToNicAsyncWithContextFunc      func(ctx context.Context, p *cloudstack.AddIpToNicParams) (*cloudstack.Job, error)
+	NewListNicsParamsFunc               func(virtualmachineid string) *cloudstack.ListNicsParams
+	ListNicsFunc                        func(p *cloudstack.ListNicsParams) (*cloudstack.ListNicsResponse, error)
+	ListNicsWithContextFunc             func(ctx context.Context, p *cloudstack.ListNicsParams) (*cloudstack.ListNicsResponse, error)
+	NewListNicsPagerFunc                func(p *cloudstack.ListNicsParams) *cloudstack.ListNicsPager
+	ListNicsAllFunc                     func(p *cloudstack.ListNicsParams) (*cloudstack.ListNicsResponse, error)
+	ListNicsAllWithContextFunc          func(ctx context.Context, p *cloudstack.ListNicsParams) (*cloudstack.ListNicsResponse, error)
+	NewRemoveIpFromNicParamsFunc        func(id string) *cloudstack.RemoveIpFromNicParams
+	RemoveIpFromNicFunc                 func(p *cloudstack.RemoveIpFromNicParams) (*cloudstack.RemoveIpFromNicResponse, error)
+	RemoveIpFromNicWithContextFunc      func(ctx context.Context, p *cloudstack.RemoveIpFromNicParams) (*cloudstack.RemoveIpFromNicResponse, error)
+	RemoveIpFromNicAsyncFunc            func(p *cloudstack.RemoveIpFromNicParams) (*cloudstack.Job, error)
+	RemoveIpFromNicAsyncWithContextFunc func(ctx context.Context, p *cloudstack.RemoveIpFromNicParams) (*cloudstack.Job, error)
+	NewUpdateVmNicIpParamsFunc          func(nicid string) *cloudstack.UpdateVmNicIpParams
+	UpdateVmNicIpFunc                   func(p *cloudstack.UpdateVmNicIpParams) (*cloudstack.UpdateVmNicIpResponse, error)
+	UpdateVmNicIpWithContextFunc        func(ctx context.Context, p *cloudstack.UpdateVmNicIpParams) (*cloudstack.UpdateVmNicIpResponse, error)
+	UpdateVmNicIpAsyncFunc              func(p *cloudstack.UpdateVmNicIpParams) (*cloudstack.Job, error)
+	UpdateVmNicIpAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.UpdateVmNicIpParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.NicServiceIface = (*MockNicService)(nil)
+
+// NewAddIpToNicParams records the call and calls NewAddIpToNicParamsFunc
+func (m *MockNicService) NewAddIpToNicParams(nicid string) (r0 *cloudstack.AddIpToNicParams) {
+	m.record("NewAddIpToNicParams", nicid)
+	if m.NewAddIpToNicParamsFunc != nil {
+		return m.NewAddIpToNicParamsFunc(nicid)
+	}
+	return cloudstack.NewNicService(nil).NewAddIpToNicParams(nicid)
+}
+
+// AddIpToNic records the call and calls AddIpToNicFunc, or AddIpToNicWithContextFunc if only that is set
+func (m *MockNicService) AddIpToNic(p *cloudstack.AddIpToNicParams) (r0 *cloudstack.AddIpToNicResponse, r1 error) {
+	m.record("AddIpToNic", p)
+	if m.AddIpToNicFunc != nil {
+		return m.AddIpToNicFunc(p)
+	}
+	if m.AddIpToNicWithContextFunc != nil {
+		return m.AddIpToNicWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NicService.AddIpToNic")
+	return
+}
+
+// AddIpToNicWithContext records the call and calls AddIpToNicWithContextFunc, or AddIpToNicFunc if only that is set
+func (m *MockNicService) AddIpToNicWithContext(ctx context.Context, p *cloudstack.AddIpToNicParams) (r0 *cloudstack.AddIpToNicResponse, r1 error) {
+	m.record("AddIpToNicWithContext", ctx, p)
+	if m.AddIpToNicWithContextFunc != nil {
+		return m.AddIpToNicWithContextFunc(ctx, p)
+	}
+	if m.AddIpToNicFunc != nil {
+		return m.AddIpToNicFunc(p)
+	}
+	r1 = notMocked("NicService.AddIpToNicWithContext")
+	return
+}
+
+// AddIpToNicAsync records the call and calls AddIpToNicAsyncFunc, or AddIpToNicAsyncWithContextFunc if only that is set
+func (m *MockNicService) AddIpToNicAsync(p *cloudstack.AddIpToNicParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddIpToNicAsync", p)
+	if m.AddIpToNicAsyncFunc != nil {
+		return m.AddIpToNicAsyncFunc(p)
+	}
+	if m.AddIpToNicAsyncWithContextFunc != nil {
+		return m.AddIpToNicAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NicService.AddIpToNicAsync")
+	return
+}
+
+// AddIpToNicAsyncWithContext records the call and calls AddIpToNicAsyncWithContextFunc, or AddIpToNicAsyncFunc if only that is set
+func (m *MockNicService) AddIpToNicAsyncWithContext(ctx context.Context, p *cloudstack.AddIpToNicParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddIpToNicAsyncWithContext", ctx, p)
+	if m.AddIpToNicAsyncWithContextFunc != nil {
+		return m.AddIpToNicAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddIpToNicAsyncFunc != nil {
+		return m.AddIpToNicAsyncFunc(p)
+	}
+	r1 = notMocked("NicService.AddIpToNicAsyncWithContext")
+	return
+}
+
+// NewListNicsParams records the call and calls NewListNicsParamsFunc
+func (m *MockNicService) NewListNicsParams(virtualmachineid string) (r0 *cloudstack.ListNicsParams) {
+	m.record("NewListNicsParams", virtualmachineid)
+	if m.NewListNicsParamsFunc != nil {
+		return m.NewListNicsParamsFunc(virtualmachineid)
+	}
+	return cloudstack.NewNicService(nil).NewListNicsParams(virtualmachineid)
+}
+
+// ListNics records the call and calls ListNicsFunc, or ListNicsWithContextFunc if only that is set
+func (m *MockNicService) ListNics(p *cloudstack.ListNicsParams) (r0 *cloudstack.ListNicsResponse, r1 error) {
+	m.record("ListNics", p)
+	if m.ListNicsFunc != nil {
+		return m.ListNicsFunc(p)
+	}
+	if m.ListNicsWithContextFunc != nil {
+		return m.ListNicsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NicService.ListNics")
+	return
+}
+
+// ListNicsWithContext records the call and calls ListNicsWithContextFunc, or ListNicsFunc if only that is set
+func (m *MockNicService) ListNicsWithContext(ctx context.Context, p *cloudstack.ListNicsParams) (r0 *cloudstack.ListNicsResponse, r1 error) {
+	m.record("ListNicsWithContext", ctx, p)
+	if m.ListNicsWithContextFunc != nil {
+		return m.ListNicsWithContextFunc(ctx, p)
+	}
+	if m.ListNicsFunc != nil {
+		return m.ListNicsFunc(p)
+	}
+	r1 = notMocked("NicService.ListNicsWithContext")
+	return
+}
+
+// NewListNicsPager records the call and calls NewListNicsPagerFunc
+func (m *MockNicService) NewListNicsPager(p *cloudstack.ListNicsParams) (r0 *cloudstack.ListNicsPager) {
+	m.record("NewListNicsPager", p)
+	if m.NewListNicsPagerFunc != nil {
+		return m.NewListNicsPagerFunc(p)
+	}
+	return
+}
+
+// ListNicsAll records the call and calls ListNicsAllFunc, or ListNicsAllWithContextFunc if only that is set
+func (m *MockNicService) ListNicsAll(p *cloudstack.ListNicsParams) (r0 *cloudstack.ListNicsResponse, r1 error) {
+	m.record("ListNicsAll", p)
+	if m.ListNicsAllFunc != nil {
+		return m.ListNicsAllFunc(p)
+	}
+	if m.ListNicsAllWithContextFunc != nil {
+		return m.ListNicsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NicService.ListNicsAll")
+	return
+}
+
+// ListNicsAllWithContext records the call and calls ListNicsAllWithContextFunc, or ListNicsAllFunc if only that is set
+func (m *MockNicService) ListNicsAllWithContext(ctx context.Context, p *cloudstack.ListNicsParams) (r0 *cloudstack.ListNicsResponse, r1 error) {
+	m.record("ListNicsAllWithContext", ctx, p)
+	if m.ListNicsAllWithContextFunc != nil {
+		return m.ListNicsAllWithContextFunc(ctx, p)
+	}
+	if m.ListNicsAllFunc != nil {
+		return m.ListNicsAllFunc(p)
+	}
+	r1 = notMocked("NicService.ListNicsAllWithContext")
+	return
+}
+
+// NewRemoveIpFromNicParams records the call and calls NewRemoveIpFromNicParamsFunc
+func (m *MockNicService) NewRemoveIpFromNicParams(id string) (r0 *cloudstack.RemoveIpFromNicParams) {
+	m.record("NewRemoveIpFromNicParams", id)
+	if m.NewRemoveIpFromNicParamsFunc != nil {
+		return m.NewRemoveIpFromNicParamsFunc(id)
+	}
+	return cloudstack.NewNicService(nil).NewRemoveIpFromNicParams(id)
+}
+
+// RemoveIpFromNic records the call and calls RemoveIpFromNicFunc, or RemoveIpFromNicWithContextFunc if only that is set
+func (m *MockNicService) RemoveIpFromNic(p *cloudstack.RemoveIpFromNicParams) (r0 *cloudstack.RemoveIpFromNicResponse, r1 error) {
+	m.record("RemoveIpFromNic", p)
+	if m.RemoveIpFromNicFunc != nil {
+		return m.RemoveIpFromNicFunc(p)
+	}
+	if m.RemoveIpFromNicWithContextFunc != nil {
+		return m.RemoveIpFromNicWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NicService.RemoveIpFromNic")
+	return
+}
+
+// RemoveIpFromNicWithContext records the call and calls RemoveIpFromNicWithContextFunc, or RemoveIpFromNicFunc if only that is set
+func (m *MockNicService) RemoveIpFromNicWithContext(ctx context.Context, p *cloudstack.RemoveIpFromNicParams) (r0 *cloudstack.RemoveIpFromNicResponse, r1 error) {
+	m.record("RemoveIpFromNicWithContext", ctx, p)
+	if m.RemoveIpFromNicWithContextFunc != nil {
+		return m.RemoveIpFromNicWithContextFunc(ctx, p)
+	}
+	if m.RemoveIpFromNicFunc != nil {
+		return m.RemoveIpFromNicFunc(p)
+	}
+	r1 = notMocked("NicService.RemoveIpFromNicWithContext")
+	return
+}
+
+// RemoveIpFromNicAsync records the call and calls RemoveIpFromNicAsyncFunc, or RemoveIpFromNicAsyncWithContextFunc if only that is set
+func (m *MockNicService) RemoveIpFromNicAsync(p *cloudstack.RemoveIpFromNicParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveIpFromNicAsync", p)
+	if m.RemoveIpFromNicAsyncFunc != nil {
+		return m.RemoveIpFromNicAsyncFunc(p)
+	}
+	if m.RemoveIpFromNicAsyncWithContextFunc != nil {
+		return m.RemoveIpFromNicAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NicService.RemoveIpFromNicAsync")
+	return
+}
+
+// RemoveIpFromNicAsyncWithContext records the call and calls RemoveIpFromNicAsyncWithContextFunc, or RemoveIpFromNicAsyncFunc if only that is set
+func (m *MockNicService) RemoveIpFromNicAsyncWithContext(ctx context.Context, p *cloudstack.RemoveIpFromNicParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveIpFromNicAsyncWithContext", ctx, p)
+	if m.RemoveIpFromNicAsyncWithContextFunc != nil {
+		return m.RemoveIpFromNicAsyncWithContextFunc(ctx, p)
+	}
+	if m.RemoveIpFromNicAsyncFunc != nil {
+		return m.RemoveIpFromNicAsyncFunc(p)
+	}
+	r1 = notMocked("NicService.RemoveIpFromNicAsyncWithContext")
+	return
+}
+
+// NewUpdateVmNicIpParams records the call and calls NewUpdateVmNicIpParamsFunc
+func (m *MockNicService) NewUpdateVmNicIpParams(nicid string) (r0 *cloudstack.UpdateVmNicIpParams) {
+	m.record("NewUpdateVmNicIpParams", nicid)
+	if m.NewUpdateVmNicIpParamsFunc != nil {
+		return m.NewUpdateVmNicIpParamsFunc(nicid)
+	}
+	return cloudstack.NewNicService(nil).NewUpdateVmNicIpParams(nicid)
+}
+
+// UpdateVmNicIp records the call and calls UpdateVmNicIpFunc, or UpdateVmNicIpWithContextFunc if only that is set
+func (m *MockNicService) UpdateVmNicIp(p *cloudstack.UpdateVmNicIpParams) (r0 *cloudstack.UpdateVmNicIpResponse, r1 error) {
+	m.record("UpdateVmNicIp", p)
+	if m.UpdateVmNicIpFunc != nil {
+		return m.UpdateVmNicIpFunc(p)
+	}
+	if m.UpdateVmNicIpWithContextFunc != nil {
+		return m.UpdateVmNicIpWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NicService.UpdateVmNicIp")
+	return
+}
+
+// UpdateVmNicIpWithContext records the call and calls UpdateVmNicIpWithContextFunc, or UpdateVmNicIpFunc if only that is set
+func (m *MockNicService) UpdateVmNicIpWithContext(ctx context.Context, p *cloudstack.UpdateVmNicIpParams) (r0 *cloudstack.UpdateVmNicIpResponse, r1 error) {
+	m.record("UpdateVmNicIpWithContext", ctx, p)
+	if m.UpdateVmNicIpWithContextFunc != nil {
+		return m.UpdateVmNicIpWithContextFunc(ctx, p)
+	}
+	if m.UpdateVmNicIpFunc != nil {
+		return m.UpdateVmNicIpFunc(p)
+	}
+	r1 = notMocked("NicService.UpdateVmNicIpWithContext")
+	return
+}
+
+// UpdateVmNicIpAsync records the call and calls UpdateVmNicIpAsyncFunc, or UpdateVmNicIpAsyncWithContextFunc if only that is set
+func (m *MockNicService) UpdateVmNicIpAsync(p *cloudstack.UpdateVmNicIpParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVmNicIpAsync", p)
+	if m.UpdateVmNicIpAsyncFunc != nil {
+		return m.UpdateVmNicIpAsyncFunc(p)
+	}
+	if m.UpdateVmNicIpAsyncWithContextFunc != nil {
+		return m.UpdateVmNicIpAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NicService.UpdateVmNicIpAsync")
+	return
+}
+
+// UpdateVmNicIpAsyncWithContext records the call and calls UpdateVmNicIpAsyncWithContextFunc, or UpdateVmNicIpAsyncFunc if only that is set
+func (m *MockNicService) UpdateVmNicIpAsyncWithContext(ctx context.Context, p *cloudstack.UpdateVmNicIpParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVmNicIpAsyncWithContext", ctx, p)
+	if m.UpdateVmNicIpAsyncWithContextFunc != nil {
+		return m.UpdateVmNicIpAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateVmNicIpAsyncFunc != nil {
+		return m.UpdateVmNicIpAsyncFunc(p)
+	}
+	r1 = notMocked("NicService.UpdateVmNicIpAsyncWithContext")
+	return
+}
@@ -0,0 +1,247 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockNiciraNVPService is a mock of cloudstack.NiciraNVPServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockNiciraNVPService struct {
+	Recorder
+
+	NewAddNiciraNvpDeviceParamsFunc           func(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string) *cloudstack.AddNiciraNvpDeviceParams
+	AddNiciraNvpDeviceFunc                    func(p *cloudstack.AddNiciraNvpDeviceParams) (*cloudstack.AddNiciraNvpDeviceResponse, error)
+	AddNiciraNvpDeviceWithContextFunc         func(ctx context.Context, p *cloudstack.AddNiciraNvpDeviceParams) (*cloudstack.AddNiciraNvpDeviceResponse, error)
+	AddNiciraNvpDeviceAsyncFunc               func(p *cloudstack.AddNiciraNvpDeviceParams) (*cloudstack.Job, error)
+	AddNiciraNvpDeviceAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.AddNiciraNvpDeviceParams) (*cloudstack.Job, error)
+	NewDeleteNiciraNvpDeviceParamsFunc        func(nvpdeviceid string) *cloudstack.DeleteNiciraNvpDeviceParams
+	DeleteNiciraNvpDeviceFunc                 func(p *cloudstack.DeleteNiciraNvpDeviceParams) (*cloudstack.DeleteNiciraNvpDeviceResponse, error)
+	DeleteNiciraNvpDeviceWithContextFunc      func(ctx context.Context, p *cloudstack.DeleteNiciraNvpDeviceParams) (*cloudstack.DeleteNiciraNvpDeviceResponse, error)
+	DeleteNiciraNvpDeviceAsyncFunc            func(p *cloudstack.DeleteNiciraNvpDeviceParams) (*cloudstack.Job, error)
+	DeleteNiciraNvpDeviceAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DeleteNiciraNvpDeviceParams) (*cloudstack.Job, error)
+	NewListNiciraNvpDevicesParamsFunc         func() *cloudstack.ListNiciraNvpDevicesParams
+	ListNiciraNvpDevicesFunc                  func(p *cloudstack.ListNiciraNvpDevicesParams) (*cloudstack.ListNiciraNvpDevicesResponse, error)
+	ListNiciraNvpDevicesWithContextFunc       func(ctx context.Context, p *cloudstack.ListNiciraNvpDevicesParams) (*cloudstack.ListNiciraNvpDevicesResponse, error)
+	NewListNiciraNvpDevicesPagerFunc          func(p *cloudstack.ListNiciraNvpDevicesParams) *cloudstack.ListNiciraNvpDevicesPager
+	ListNiciraNvpDevicesAllFunc               func(p *cloudstack.ListNiciraNvpDevicesParams) (*cloudstack.ListNiciraNvpDevicesResponse, error)
+	ListNiciraNvpDevicesAllWithContextFunc    func(ctx context.Context, p *cloudstack.ListNiciraNvpDevicesParams) (*cloudstack.ListNiciraNvpDevicesResponse, error)
+}
+
+var _ cloudstack.NiciraNVPServiceIface = (*MockNiciraNVPService)(nil)
+
+// NewAddNiciraNvpDeviceParams records the call and calls NewAddNiciraNvpDeviceParamsFunc
+func (m *MockNiciraNVPService) NewAddNiciraNvpDeviceParams(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string) (r0 *cloudstack.AddNiciraNvpDeviceParams) {
+	m.record("NewAddNiciraNvpDeviceParams", hostname, password, physicalnetworkid, transportzoneuuid, username)
+	if m.NewAddNiciraNvpDeviceParamsFunc != nil {
+		return m.NewAddNiciraNvpDeviceParamsFunc(hostname, password, physicalnetworkid, transportzoneuuid, username)
+	}
+	return cloudstack.NewNiciraNVPService(nil).NewAddNiciraNvpDeviceParams(hostname, password, physicalnetworkid, transportzoneuuid, username)
+}
+
+// AddNiciraNvpDevice records the call and calls AddNiciraNvpDeviceFunc, or AddNiciraNvpDeviceWithContextFunc if only that is set
+func (m *MockNiciraNVPService) AddNiciraNvpDevice(p *cloudstack.AddNiciraNvpDeviceParams) (r0 *cloudstack.AddNiciraNvpDeviceResponse, r1 error) {
+	m.record("AddNiciraNvpDevice", p)
+	if m.AddNiciraNvpDeviceFunc != nil {
+		return m.AddNiciraNvpDeviceFunc(p)
+	}
+	if m.AddNiciraNvpDeviceWithContextFunc != nil {
+		return m.AddNiciraNvpDeviceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NiciraNVPService.AddNiciraNvpDevice")
+	return
+}
+
+// AddNiciraNvpDeviceWithContext records the call and calls AddNiciraNvpDeviceWithContextFunc, or AddNiciraNvpDeviceFunc if only that is set
+func (m *MockNiciraNVPService) AddNiciraNvpDeviceWithContext(ctx context.Context, p *cloudstack.AddNiciraNvpDeviceParams) (r0 *cloudstack.AddNiciraNvpDeviceResponse, r1 error) {
+	m.record("AddNiciraNvpDeviceWithContext", ctx, p)
+	if m.AddNiciraNvpDeviceWithContextFunc != nil {
+		return m.AddNiciraNvpDeviceWithContextFunc(ctx, p)
+	}
+	if m.AddNiciraNvpDeviceFunc != nil {
+		return m.AddNiciraNvpDeviceFunc(p)
+	}
+	r1 = notMocked("NiciraNVPService.AddNiciraNvpDeviceWithContext")
+	return
+}
+
+// AddNiciraNvpDeviceAsync records the call and calls AddNiciraNvpDeviceAsyncFunc, or AddNiciraNvpDeviceAsyncWithContextFunc if only that is set
+func (m *MockNiciraNVPService) AddNiciraNvpDeviceAsync(p *cloudstack.AddNiciraNvpDeviceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddNiciraNvpDeviceAsync", p)
+	if m.AddNiciraNvpDeviceAsyncFunc != nil {
+		return m.AddNiciraNvpDeviceAsyncFunc(p)
+	}
+	if m.AddNiciraNvpDeviceAsyncWithContextFunc != nil {
+		return m.AddNiciraNvpDeviceAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NiciraNVPService.AddNiciraNvpDeviceAsync")
+	return
+}
+
+// AddNiciraNvpDeviceAsyncWithContext records the call and calls AddNiciraNvpDeviceAsyncWithContextFunc, or AddNiciraNvpDeviceAsyncFunc if only that is set
+func (m *MockNiciraNVPService) AddNiciraNvpDeviceAsyncWithContext(ctx context.Context, p *cloudstack.AddNiciraNvpDeviceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddNiciraNvpDeviceAsyncWithContext", ctx, p)
+	if m.AddNiciraNvpDeviceAsyncWithContextFunc != nil {
+		return m.AddNiciraNvpDeviceAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddNiciraNvpDeviceAsyncFunc != nil {
+		return m.AddNiciraNvpDeviceAsyncFunc(p)
+	}
+	r1 = notMocked("NiciraNVPService.AddNiciraNvpDeviceAsyncWithContext")
+	return
+}
+
+// NewDeleteNiciraNvpDeviceParams records the call and calls NewDeleteNiciraNvpDeviceParamsFunc
+func (m *MockNiciraNVPService) NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) (r0 *cloudstack.DeleteNiciraNvpDeviceParams) {
+	m.record("NewDeleteNiciraNvpDeviceParams", nvpdeviceid)
+	if m.NewDeleteNiciraNvpDeviceParamsFunc != nil {
+		return m.NewDeleteNiciraNvpDeviceParamsFunc(nvpdeviceid)
+	}
+	return cloudstack.NewNiciraNVPService(nil).NewDeleteNiciraNvpDeviceParams(nvpdeviceid)
+}
+
+// DeleteNiciraNvpDevice records the call and calls DeleteNiciraNvpDeviceFunc, or DeleteNiciraNvpDeviceWithContextFunc if only that is set
+func (m *MockNiciraNVPService) DeleteNiciraNvpDevice(p *cloudstack.DeleteNiciraNvpDeviceParams) (r0 *cloudstack.DeleteNiciraNvpDeviceResponse, r1 error) {
+	m.record("DeleteNiciraNvpDevice", p)
+	if m.DeleteNiciraNvpDeviceFunc != nil {
+		return m.DeleteNiciraNvpDeviceFunc(p)
+	}
+	if m.DeleteNiciraNvpDeviceWithContextFunc != nil {
+		return m.DeleteNiciraNvpDeviceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NiciraNVPService.DeleteNiciraNvpDevice")
+	return
+}
+
+// DeleteNiciraNvpDeviceWithContext records the call and calls DeleteNiciraNvpDeviceWithContextFunc, or DeleteNiciraNvpDeviceFunc if only that is set
+func (m *MockNiciraNVPService) DeleteNiciraNvpDeviceWithContext(ctx context.Context, p *cloudstack.DeleteNiciraNvpDeviceParams) (r0 *cloudstack.DeleteNiciraNvpDeviceResponse, r1 error) {
+	m.record("DeleteNiciraNvpDeviceWithContext", ctx, p)
+	if m.DeleteNiciraNvpDeviceWithContextFunc != nil {
+		return m.DeleteNiciraNvpDeviceWithContextFunc(ctx, p)
+	}
+	if m.DeleteNiciraNvpDeviceFunc != nil {
+		return m.DeleteNiciraNvpDeviceFunc(p)
+	}
+	r1 = notMocked("NiciraNVPService.DeleteNiciraNvpDeviceWithContext")
+	return
+}
+
+// DeleteNiciraNvpDeviceAsync records the call and calls DeleteNiciraNvpDeviceAsyncFunc, or DeleteNiciraNvpDeviceAsyncWithContextFunc if only that is set
+func (m *MockNiciraNVPService) DeleteNiciraNvpDeviceAsync(p *cloudstack.DeleteNiciraNvpDeviceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteNiciraNvpDeviceAsync", p)
+	if m.DeleteNiciraNvpDeviceAsyncFunc != nil {
+		return m.DeleteNiciraNvpDeviceAsyncFunc(p)
+	}
+	if m.DeleteNiciraNvpDeviceAsyncWithContextFunc != nil {
+		return m.DeleteNiciraNvpDeviceAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NiciraNVPService.DeleteNiciraNvpDeviceAsync")
+	return
+}
+
+// DeleteNiciraNvpDeviceAsyncWithContext records the call and calls DeleteNiciraNvpDeviceAsyncWithContextFunc, or DeleteNiciraNvpDeviceAsyncFunc if only that is set
+func (m *MockNiciraNVPService) DeleteNiciraNvpDeviceAsyncWithContext(ctx context.Context, p *cloudstack.DeleteNiciraNvpDeviceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteNiciraNvpDeviceAsyncWithContext", ctx, p)
+	if m.DeleteNiciraNvpDeviceAsyncWithContextFunc != nil {
+		return m.DeleteNiciraNvpDeviceAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteNiciraNvpDeviceAsyncFunc != nil {
+		return m.DeleteNiciraNvpDeviceAsyncFunc(p)
+	}
+	r1 = notMocked("NiciraNVPService.DeleteNiciraNvpDeviceAsyncWithContext")
+	return
+}
+
+// NewListNiciraNvpDevicesParams records the call and calls NewListNiciraNvpDevicesParamsFunc
+func (m *MockNiciraNVPService) NewListNiciraNvpDevicesParams() (r0 *cloudstack.ListNiciraNvpDevicesParams) {
+	m.record("NewListNiciraNvpDevicesParams")
+	if m.NewListNiciraNvpDevicesParamsFunc != nil {
+		return m.NewListNiciraNvpDevicesParamsFunc()
+	}
+	return cloudstack.NewNiciraNVPService(nil).NewListNiciraNvpDevicesParams()
+}
+
+// ListNiciraNvpDevices records the call and calls ListNiciraNvpDevicesFunc, or ListNiciraNvpDevicesWithContextFunc if only that is set
+func (m *MockNiciraNVPService) ListNiciraNvpDevices(p *cloudstack.ListNiciraNvpDevicesParams) (r0 *cloudstack.ListNiciraNvpDevicesResponse, r1 error) {
+	m.record("ListNiciraNvpDevices", p)
+	if m.ListNiciraNvpDevicesFunc != nil {
+		return m.ListNiciraNvpDevicesFunc(p)
+	}
+	if m.ListNiciraNvpDevicesWithContextFunc != nil {
+		return m.ListNiciraNvpDevicesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NiciraNVPService.ListNiciraNvpDevices")
+	return
+}
+
+// ListNiciraNvpDevicesWithContext records the call and calls ListNiciraNvpDevicesWithContextFunc, or ListNiciraNvpDevicesFunc if only that is set
+func (m *MockNiciraNVPService) ListNiciraNvpDevicesWithContext(ctx context.Context, p *cloudstack.ListNiciraNvpDevicesParams) (r0 *cloudstack.ListNiciraNvpDevicesResponse, r1 error) {
+	m.record("ListNiciraNvpDevicesWithContext", ctx, p)
+	if m.ListNiciraNvpDevicesWithContextFunc != nil {
+		return m.ListNiciraNvpDevicesWithContextFunc(ctx, p)
+	}
+	if m.ListNiciraNvpDevicesFunc != nil {
+		return m.ListNiciraNvpDevicesFunc(p)
+	}
+	r1 = notMocked("NiciraNVPService.ListNiciraNvpDevicesWithContext")
+	return
+}
+
+// NewListNiciraNvpDevicesPager records the call and calls NewListNiciraNvpDevicesPagerFunc
+func (m *MockNiciraNVPService) NewListNiciraNvpDevicesPager(p *cloudstack.ListNiciraNvpDevicesParams) (r0 *cloudstack.ListNiciraNvpDevicesPager) {
+	m.record("NewListNiciraNvpDevicesPager", p)
+	if m.NewListNiciraNvpDevicesPagerFunc != nil {
+		return m.NewListNiciraNvpDevicesPagerFunc(p)
+	}
+	return
+}
+
+// ListNiciraNvpDevicesAll records the call and calls ListNiciraNvpDevicesAllFunc, or ListNiciraNvpDevicesAllWithContextFunc if only that is set
+func (m *MockNiciraNVPService) ListNiciraNvpDevicesAll(p *cloudstack.ListNiciraNvpDevicesParams) (r0 *cloudstack.ListNiciraNvpDevicesResponse, r1 error) {
+	m.record("ListNiciraNvpDevicesAll", p)
+	if m.ListNiciraNvpDevicesAllFunc != nil {
+		return m.ListNiciraNvpDevicesAllFunc(p)
+	}
+	if m.ListNiciraNvpDevicesAllWithContextFunc != nil {
+		return m.ListNiciraNvpDevicesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("NiciraNVPService.ListNiciraNvpDevicesAll")
+	return
+}
+
+// ListNiciraNvpDevicesAllWithContext records the call and calls ListNiciraNvpDevicesAllWithContextFunc, or ListNiciraNvpDevicesAllFunc if only that is set
+func (m *MockNiciraNVPService) ListNiciraNvpDevicesAllWithContext(ctx context.Context, p *cloudstack.ListNiciraNvpDevicesParams) (r0 *cloudstack.ListNiciraNvpDevicesResponse, r1 error) {
+	m.record("ListNiciraNvpDevicesAllWithContext", ctx, p)
+	if m.ListNiciraNvpDevicesAllWithContextFunc != nil {
+		return m.ListNiciraNvpDevicesAllWithContextFunc(ctx, p)
+	}
+	if m.ListNiciraNvpDevicesAllFunc != nil {
+		return m.ListNiciraNvpDevicesAllFunc(p)
+	}
+	r1 = notMocked("NiciraNVPService.ListNiciraNvpDevicesAllWithContext")
+	return
+}
@@ -0,0 +1,209 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockOutofbandManagementService is a mock of cloudstack.OutofbandManagementServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockOutofbandManagementService struct {
+	Recorder
+
+	NewChangeOutOfBandManagementPasswordParamsFunc          func(hostid string) *cloudstack.ChangeOutOfBandManagementPasswordParams
+	ChangeOutOfBandManagementPasswordFunc                   func(p *cloudstack.ChangeOutOfBandManagementPasswordParams) (*cloudstack.ChangeOutOfBandManagementPasswordResponse, error)
+	ChangeOutOfBandManagementPasswordWithContextFunc        func(ctx context.Context, p *cloudstack.ChangeOutOfBandManagementPasswordParams) (*cloudstack.ChangeOutOfBandManagementPasswordResponse, error)
+	ChangeOutOfBandManagementPasswordAsyncFunc              func(p *cloudstack.ChangeOutOfBandManagementPasswordParams) (*cloudstack.Job, error)
+	ChangeOutOfBandManagementPasswordAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.ChangeOutOfBandManagementPasswordParams) (*cloudstack.Job, error)
+	NewConfigureOutOfBandManagementParamsFunc               func(address string, driver string, hostid string, password string, port string, username string) *cloudstack.ConfigureOutOfBandManagementParams
+	ConfigureOutOfBandManagementFunc                        func(p *cloudstack.ConfigureOutOfBandManagementParams) (*cloudstack.OutOfBandManagementResponse, error)
+	ConfigureOutOfBandManagementWithContextFunc             func(ctx context.Context, p *cloudstack.ConfigureOutOfBandManagementParams) (*cloudstack.OutOfBandManagementResponse, error)
+	NewIssueOutOfBandManagementPowerActionParamsFunc        func(action string, hostid string) *cloudstack.IssueOutOfBandManagementPowerActionParams
+	IssueOutOfBandManagementPowerActionFunc                 func(p *cloudstack.IssueOutOfBandManagementPowerActionParams) (*cloudstack.IssueOutOfBandManagementPowerActionResponse, error)
+	IssueOutOfBandManagementPowerActionWithContextFunc      func(ctx context.Context, p *cloudstack.IssueOutOfBandManagementPowerActionParams) (*cloudstack.IssueOutOfBandManagementPowerActionResponse, error)
+	IssueOutOfBandManagementPowerActionAsyncFunc            func(p *cloudstack.IssueOutOfBandManagementPowerActionParams) (*cloudstack.Job, error)
+	IssueOutOfBandManagementPowerActionAsyncWithContextFunc func(ctx context.Context, p *cloudstack.IssueOutOfBandManagementPowerActionParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.OutofbandManagementServiceIface = (*MockOutofbandManagementService)(nil)
+
+// NewChangeOutOfBandManagementPasswordParams records the call and calls NewChangeOutOfBandManagementPasswordParamsFunc
+func (m *MockOutofbandManagementService) NewChangeOutOfBandManagementPasswordParams(hostid string) (r0 *cloudstack.ChangeOutOfBandManagementPasswordParams) {
+	m.record("NewChangeOutOfBandManagementPasswordParams", hostid)
+	if m.NewChangeOutOfBandManagementPasswordParamsFunc != nil {
+		return m.NewChangeOutOfBandManagementPasswordParamsFunc(hostid)
+	}
+	return cloudstack.NewOutofbandManagementService(nil).NewChangeOutOfBandManagementPasswordParams(hostid)
+}
+
+// ChangeOutOfBandManagementPassword records the call and calls ChangeOutOfBandManagementPasswordFunc, or ChangeOutOfBandManagementPasswordWithContextFunc if only that is set
+func (m *MockOutofbandManagementService) ChangeOutOfBandManagementPassword(p *cloudstack.ChangeOutOfBandManagementPasswordParams) (r0 *cloudstack.ChangeOutOfBandManagementPasswordResponse, r1 error) {
+	m.record("ChangeOutOfBandManagementPassword", p)
+	if m.ChangeOutOfBandManagementPasswordFunc != nil {
+		return m.ChangeOutOfBandManagementPasswordFunc(p)
+	}
+	if m.ChangeOutOfBandManagementPasswordWithContextFunc != nil {
+		return m.ChangeOutOfBandManagementPasswordWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("OutofbandManagementService.ChangeOutOfBandManagementPassword")
+	return
+}
+
+// ChangeOutOfBandManagementPasswordWithContext records the call and calls ChangeOutOfBandManagementPasswordWithContextFunc, or ChangeOutOfBandManagementPasswordFunc if only that is set
+func (m *MockOutofbandManagementService) ChangeOutOfBandManagementPasswordWithContext(ctx context.Context, p *cloudstack.ChangeOutOfBandManagementPasswordParams) (r0 *cloudstack.ChangeOutOfBandManagementPasswordResponse, r1 error) {
+	m.record("ChangeOutOfBandManagementPasswordWithContext", ctx, p)
+	if m.ChangeOutOfBandManagementPasswordWithContextFunc != nil {
+		return m.ChangeOutOfBandManagementPasswordWithContextFunc(ctx, p)
+	}
+	if m.ChangeOutOfBandManagementPasswordFunc != nil {
+		return m.ChangeOutOfBandManagementPasswordFunc(p)
+	}
+	r1 = notMocked("OutofbandManagementService.ChangeOutOfBandManagementPasswordWithContext")
+	return
+}
+
+// ChangeOutOfBandManagementPasswordAsync records the call and calls ChangeOutOfBandManagementPasswordAsyncFunc, or ChangeOutOfBandManagementPasswordAsyncWithContextFunc if only that is set
+func (m *MockOutofbandManagementService) ChangeOutOfBandManagementPasswordAsync(p *cloudstack.ChangeOutOfBandManagementPasswordParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ChangeOutOfBandManagementPasswordAsync", p)
+	if m.ChangeOutOfBandManagementPasswordAsyncFunc != nil {
+		return m.ChangeOutOfBandManagementPasswordAsyncFunc(p)
+	}
+	if m.ChangeOutOfBandManagementPasswordAsyncWithContextFunc != nil {
+		return m.ChangeOutOfBandManagementPasswordAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("OutofbandManagementService.ChangeOutOfBandManagementPasswordAsync")
+	return
+}
+
+// ChangeOutOfBandManagementPasswordAsyncWithContext records the call and calls ChangeOutOfBandManagementPasswordAsyncWithContextFunc, or ChangeOutOfBandManagementPasswordAsyncFunc if only that is set
+func (m *MockOutofbandManagementService) ChangeOutOfBandManagementPasswordAsyncWithContext(ctx context.Context, p *cloudstack.ChangeOutOfBandManagementPasswordParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ChangeOutOfBandManagementPasswordAsyncWithContext", ctx, p)
+	if m.ChangeOutOfBandManagementPasswordAsyncWithContextFunc != nil {
+		return m.ChangeOutOfBandManagementPasswordAsyncWithContextFunc(ctx, p)
+	}
+	if m.ChangeOutOfBandManagementPasswordAsyncFunc != nil {
+		return m.ChangeOutOfBandManagementPasswordAsyncFunc(p)
+	}
+	r1 = notMocked("OutofbandManagementService.ChangeOutOfBandManagementPasswordAsyncWithContext")
+	return
+}
+
+// NewConfigureOutOfBandManagementParams records the call and calls NewConfigureOutOfBandManagementParamsFunc
+func (m *MockOutofbandManagementService) NewConfigureOutOfBandManagementParams(address string, driver string, hostid string, password string, port string, username string) (r0 *cloudstack.ConfigureOutOfBandManagementParams) {
+	m.record("NewConfigureOutOfBandManagementParams", address, driver, hostid, password, port, username)
+	if m.NewConfigureOutOfBandManagementParamsFunc != nil {
+		return m.NewConfigureOutOfBandManagementParamsFunc(address, driver, hostid, password, port, username)
+	}
+	return cloudstack.NewOutofbandManagementService(nil).NewConfigureOutOfBandManagementParams(address, driver, hostid, password, port, username)
+}
+
+// ConfigureOutOfBandManagement records the call and calls ConfigureOutOfBandManagementFunc, or ConfigureOutOfBandManagementWithContextFunc if only that is set
+func (m *MockOutofbandManagementService) ConfigureOutOfBandManagement(p *cloudstack.ConfigureOutOfBandManagementParams) (r0 *cloudstack.OutOfBandManagementResponse, r1 error) {
+	m.record("ConfigureOutOfBandManagement", p)
+	if m.ConfigureOutOfBandManagementFunc != nil {
+		return m.ConfigureOutOfBandManagementFunc(p)
+	}
+	if m.ConfigureOutOfBandManagementWithContextFunc != nil {
+		return m.ConfigureOutOfBandManagementWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("OutofbandManagementService.ConfigureOutOfBandManagement")
+	return
+}
+
+// ConfigureOutOfBandManagementWithContext records the call and calls ConfigureOutOfBandManagementWithContextFunc, or ConfigureOutOfBandManagementFunc if only that is set
+func (m *MockOutofbandManagementService) ConfigureOutOfBandManagementWithContext(ctx context.Context, p *cloudstack.ConfigureOutOfBandManagementParams) (r0 *cloudstack.OutOfBandManagementResponse, r1 error) {
+	m.record("ConfigureOutOfBandManagementWithContext", ctx, p)
+	if m.ConfigureOutOfBandManagementWithContextFunc != nil {
+		return m.ConfigureOutOfBandManagementWithContextFunc(ctx, p)
+	}
+	if m.ConfigureOutOfBandManagementFunc != nil {
+		return m.ConfigureOutOfBandManagementFunc(p)
+	}
+	r1 = notMocked("OutofbandManagementService.ConfigureOutOfBandManagementWithContext")
+	return
+}
+
+// NewIssueOutOfBandManagementPowerActionParams records the call and calls NewIssueOutOfBandManagementPowerActionParamsFunc
+func (m *MockOutofbandManagementService) NewIssueOutOfBandManagementPowerActionParams(action string, hostid string) (r0 *cloudstack.IssueOutOfBandManagementPowerActionParams) {
+	m.record("NewIssueOutOfBandManagementPowerActionParams", action, hostid)
+	if m.NewIssueOutOfBandManagementPowerActionParamsFunc != nil {
+		return m.NewIssueOutOfBandManagementPowerActionParamsFunc(action, hostid)
+	}
+	return cloudstack.NewOutofbandManagementService(nil).NewIssueOutOfBandManagementPowerActionParams(action, hostid)
+}
+
+// IssueOutOfBandManagementPowerAction records the call and calls IssueOutOfBandManagementPowerActionFunc, or IssueOutOfBandManagementPowerActionWithContextFunc if only that is set
+func (m *MockOutofbandManagementService) IssueOutOfBandManagementPowerAction(p *cloudstack.IssueOutOfBandManagementPowerActionParams) (r0 *cloudstack.IssueOutOfBandManagementPowerActionResponse, r1 error) {
+	m.record("IssueOutOfBandManagementPowerAction", p)
+	if m.IssueOutOfBandManagementPowerActionFunc != nil {
+		return m.IssueOutOfBandManagementPowerActionFunc(p)
+	}
+	if m.IssueOutOfBandManagementPowerActionWithContextFunc != nil {
+		return m.IssueOutOfBandManagementPowerActionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("OutofbandManagementService.IssueOutOfBandManagementPowerAction")
+	return
+}
+
+// IssueOutOfBandManagementPowerActionWithContext records the call and calls IssueOutOfBandManagementPowerActionWithContextFunc, or IssueOutOfBandManagementPowerActionFunc if only that is set
+func (m *MockOutofbandManagementService) IssueOutOfBandManagementPowerActionWithContext(ctx context.Context, p *cloudstack.IssueOutOfBandManagementPowerActionParams) (r0 *cloudstack.IssueOutOfBandManagementPowerActionResponse, r1 error) {
+	m.record("IssueOutOfBandManagementPowerActionWithContext", ctx, p)
+	if m.IssueOutOfBandManagementPowerActionWithContextFunc != nil {
+		return m.IssueOutOfBandManagementPowerActionWithContextFunc(ctx, p)
+	}
+	if m.IssueOutOfBandManagementPowerActionFunc != nil {
+		return m.IssueOutOfBandManagementPowerActionFunc(p)
+	}
+	r1 = notMocked("OutofbandManagementService.IssueOutOfBandManagementPowerActionWithContext")
+	return
+}
+
+// IssueOutOfBandManagementPowerActionAsync records the call and calls IssueOutOfBandManagementPowerActionAsyncFunc, or IssueOutOfBandManagementPowerActionAsyncWithContextFunc if only that is set
+func (m *MockOutofbandManagementService) IssueOutOfBandManagementPowerActionAsync(p *cloudstack.IssueOutOfBandManagementPowerActionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("IssueOutOfBandManagementPowerActionAsync", p)
+	if m.IssueOutOfBandManagementPowerActionAsyncFunc != nil {
+		return m.IssueOutOfBandManagementPowerActionAsyncFunc(p)
+	}
+	if m.IssueOutOfBandManagementPowerActionAsyncWithContextFunc != nil {
+		return m.IssueOutOfBandManagementPowerActionAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("OutofbandManagementService.IssueOutOfBandManagementPowerActionAsync")
+	return
+}
+
+// IssueOutOfBandManagementPowerActionAsyncWithContext records the call and calls IssueOutOfBandManagementPowerActionAsyncWithContextFunc, or IssueOutOfBandManagementPowerActionAsyncFunc if only that is set
+func (m *MockOutofbandManagementService) IssueOutOfBandManagementPowerActionAsyncWithContext(ctx context.Context, p *cloudstack.IssueOutOfBandManagementPowerActionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("IssueOutOfBandManagementPowerActionAsyncWithContext", ctx, p)
+	if m.IssueOutOfBandManagementPowerActionAsyncWithContextFunc != nil {
+		return m.IssueOutOfBandManagementPowerActionAsyncWithContextFunc(ctx, p)
+	}
+	if m.IssueOutOfBandManagementPowerActionAsyncFunc != nil {
+		return m.IssueOutOfBandManagementPowerActionAsyncFunc(p)
+	}
+	r1 = notMocked("OutofbandManagementService.IssueOutOfBandManagementPowerActionAsyncWithContext")
+	return
+}
@@ -0,0 +1,209 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockOvsElementService is a mock of cloudstack.OvsElementServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockOvsElementService struct {
+	Recorder
+
+	NewConfigureOvsElementParamsFunc        func(enabled bool, id string) *cloudstack.ConfigureOvsElementParams
+	ConfigureOvsElementFunc                 func(p *cloudstack.ConfigureOvsElementParams) (*cloudstack.OvsElementResponse, error)
+	ConfigureOvsElementWithContextFunc      func(ctx context.Context, p *cloudstack.ConfigureOvsElementParams) (*cloudstack.OvsElementResponse, error)
+	ConfigureOvsElementAsyncFunc            func(p *cloudstack.ConfigureOvsElementParams) (*cloudstack.Job, error)
+	ConfigureOvsElementAsyncWithContextFunc func(ctx context.Context, p *cloudstack.ConfigureOvsElementParams) (*cloudstack.Job, error)
+	NewListOvsElementsParamsFunc            func() *cloudstack.ListOvsElementsParams
+	GetOvsElementByIDFunc                   func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.OvsElement, int, error)
+	GetOvsElementByIDWithContextFunc        func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.OvsElement, int, error)
+	ListOvsElementsFunc                     func(p *cloudstack.ListOvsElementsParams) (*cloudstack.ListOvsElementsResponse, error)
+	ListOvsElementsWithContextFunc          func(ctx context.Context, p *cloudstack.ListOvsElementsParams) (*cloudstack.ListOvsElementsResponse, error)
+	NewListOvsElementsPagerFunc             func(p *cloudstack.ListOvsElementsParams) *cloudstack.ListOvsElementsPager
+	ListOvsElementsAllFunc                  func(p *cloudstack.ListOvsElementsParams) (*cloudstack.ListOvsElementsResponse, error)
+	ListOvsElementsAllWithContextFunc       func(ctx context.Context, p *cloudstack.ListOvsElementsParams) (*cloudstack.ListOvsElementsResponse, error)
+}
+
+var _ cloudstack.OvsElementServiceIface = (*MockOvsElementService)(nil)
+
+// NewConfigureOvsElementParams records the call and calls NewConfigureOvsElementParamsFunc
+func (m *MockOvsElementService) NewConfigureOvsElementParams(enabled bool, id string) (r0 *cloudstack.ConfigureOvsElementParams) {
+	m.record("NewConfigureOvsElementParams", enabled, id)
+	if m.NewConfigureOvsElementParamsFunc != nil {
+		return m.NewConfigureOvsElementParamsFunc(enabled, id)
+	}
+	return cloudstack.NewOvsElementService(nil).NewConfigureOvsElementParams(enabled, id)
+}
+
+// ConfigureOvsElement records the call and calls ConfigureOvsElementFunc, or ConfigureOvsElementWithContextFunc if only that is set
+func (m *MockOvsElementService) ConfigureOvsElement(p *cloudstack.ConfigureOvsElementParams) (r0 *cloudstack.OvsElementResponse, r1 error) {
+	m.record("ConfigureOvsElement", p)
+	if m.ConfigureOvsElementFunc != nil {
+		return m.ConfigureOvsElementFunc(p)
+	}
+	if m.ConfigureOvsElementWithContextFunc != nil {
+		return m.ConfigureOvsElementWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("OvsElementService.ConfigureOvsElement")
+	return
+}
+
+// ConfigureOvsElementWithContext records the call and calls ConfigureOvsElementWithContextFunc, or ConfigureOvsElementFunc if only that is set
+func (m *MockOvsElementService) ConfigureOvsElementWithContext(ctx context.Context, p *cloudstack.ConfigureOvsElementParams) (r0 *cloudstack.OvsElementResponse, r1 error) {
+	m.record("ConfigureOvsElementWithContext", ctx, p)
+	if m.ConfigureOvsElementWithContextFunc != nil {
+		return m.ConfigureOvsElementWithContextFunc(ctx, p)
+	}
+	if m.ConfigureOvsElementFunc != nil {
+		return m.ConfigureOvsElementFunc(p)
+	}
+	r1 = notMocked("OvsElementService.ConfigureOvsElementWithContext")
+	return
+}
+
+// ConfigureOvsElementAsync records the call and calls ConfigureOvsElementAsyncFunc, or ConfigureOvsElementAsyncWithContextFunc if only that is set
+func (m *MockOvsElementService) ConfigureOvsElementAsync(p *cloudstack.ConfigureOvsElementParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigureOvsElementAsync", p)
+	if m.ConfigureOvsElementAsyncFunc != nil {
+		return m.ConfigureOvsElementAsyncFunc(p)
+	}
+	if m.ConfigureOvsElementAsyncWithContextFunc != nil {
+		return m.ConfigureOvsElementAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("OvsElementService.ConfigureOvsElementAsync")
+	return
+}
+
+// ConfigureOvsElementAsyncWithContext records the call and calls ConfigureOvsElementAsyncWithContextFunc, or ConfigureOvsElementAsyncFunc if only that is set
+func (m *MockOvsElementService) ConfigureOvsElementAsyncWithContext(ctx context.Context, p *cloudstack.ConfigureOvsElementParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigureOvsElementAsyncWithContext", ctx, p)
+	if m.ConfigureOvsElementAsyncWithContextFunc != nil {
+		return m.ConfigureOvsElementAsyncWithContextFunc(ctx, p)
+	}
+	if m.ConfigureOvsElementAsyncFunc != nil {
+		return m.ConfigureOvsElementAsyncFunc(p)
+	}
+	r1 = notMocked("OvsElementService.ConfigureOvsElementAsyncWithContext")
+	return
+}
+
+// NewListOvsElementsParams records the call and calls NewListOvsElementsParamsFunc
+func (m *MockOvsElementService) NewListOvsElementsParams() (r0 *cloudstack.ListOvsElementsParams) {
+	m.record("NewListOvsElementsParams")
+	if m.NewListOvsElementsParamsFunc != nil {
+		return m.NewListOvsElementsParamsFunc()
+	}
+	return cloudstack.NewOvsElementService(nil).NewListOvsElementsParams()
+}
+
+// GetOvsElementByID records the call and calls GetOvsElementByIDFunc, or GetOvsElementByIDWithContextFunc if only that is set
+func (m *MockOvsElementService) GetOvsElementByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.OvsElement, r1 int, r2 error) {
+	m.record("GetOvsElementByID", id, opts)
+	if m.GetOvsElementByIDFunc != nil {
+		return m.GetOvsElementByIDFunc(id, opts...)
+	}
+	if m.GetOvsElementByIDWithContextFunc != nil {
+		return m.GetOvsElementByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("OvsElementService.GetOvsElementByID")
+	return
+}
+
+// GetOvsElementByIDWithContext records the call and calls GetOvsElementByIDWithContextFunc, or GetOvsElementByIDFunc if only that is set
+func (m *MockOvsElementService) GetOvsElementByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.OvsElement, r1 int, r2 error) {
+	m.record("GetOvsElementByIDWithContext", ctx, id, opts)
+	if m.GetOvsElementByIDWithContextFunc != nil {
+		return m.GetOvsElementByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetOvsElementByIDFunc != nil {
+		return m.GetOvsElementByIDFunc(id, opts...)
+	}
+	r2 = notMocked("OvsElementService.GetOvsElementByIDWithContext")
+	return
+}
+
+// ListOvsElements records the call and calls ListOvsElementsFunc, or ListOvsElementsWithContextFunc if only that is set
+func (m *MockOvsElementService) ListOvsElements(p *cloudstack.ListOvsElementsParams) (r0 *cloudstack.ListOvsElementsResponse, r1 error) {
+	m.record("ListOvsElements", p)
+	if m.ListOvsElementsFunc != nil {
+		return m.ListOvsElementsFunc(p)
+	}
+	if m.ListOvsElementsWithContextFunc != nil {
+		return m.ListOvsElementsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("OvsElementService.ListOvsElements")
+	return
+}
+
+// ListOvsElementsWithContext records the call and calls ListOvsElementsWithContextFunc, or ListOvsElementsFunc if only that is set
+func (m *MockOvsElementService) ListOvsElementsWithContext(ctx context.Context, p *cloudstack.ListOvsElementsParams) (r0 *cloudstack.ListOvsElementsResponse, r1 error) {
+	m.record("ListOvsElementsWithContext", ctx, p)
+	if m.ListOvsElementsWithContextFunc != nil {
+		return m.ListOvsElementsWithContextFunc(ctx, p)
+	}
+	if m.ListOvsElementsFunc != nil {
+		return m.ListOvsElementsFunc(p)
+	}
+	r1 = notMocked("OvsElementService.ListOvsElementsWithContext")
+	return
+}
+
+// NewListOvsElementsPager records the call and calls NewListOvsElementsPagerFunc
+func (m *MockOvsElementService) NewListOvsElementsPager(p *cloudstack.ListOvsElementsParams) (r0 *cloudstack.ListOvsElementsPager) {
+	m.record("NewListOvsElementsPager", p)
+	if m.NewListOvsElementsPagerFunc != nil {
+		return m.NewListOvsElementsPagerFunc(p)
+	}
+	return
+}
+
+// ListOvsElementsAll records the call and calls ListOvsElementsAllFunc, or ListOvsElementsAllWithContextFunc if only that is set
+func (m *MockOvsElementService) ListOvsElementsAll(p *cloudstack.ListOvsElementsParams) (r0 *cloudstack.ListOvsElementsResponse, r1 error) {
+	m.record("ListOvsElementsAll", p)
+	if m.ListOvsElementsAllFunc != nil {
+		return m.ListOvsElementsAllFunc(p)
+	}
+	if m.ListOvsElementsAllWithContextFunc != nil {
+		return m.ListOvsElementsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("OvsElementService.ListOvsElementsAll")
+	return
+}
+
+// ListOvsElementsAllWithContext records the call and calls ListOvsElementsAllWithContextFunc, or ListOvsElementsAllFunc if only that is set
+func (m *MockOvsElementService) ListOvsElementsAllWithContext(ctx context.Context, p *cloudstack.ListOvsElementsParams) (r0 *cloudstack.ListOvsElementsResponse, r1 error) {
+	m.record("ListOvsElementsAllWithContext", ctx, p)
+	if m.ListOvsElementsAllWithContextFunc != nil {
+		return m.ListOvsElementsAllWithContextFunc(ctx, p)
+	}
+	if m.ListOvsElementsAllFunc != nil {
+		return m.ListOvsElementsAllFunc(p)
+	}
+	r1 = notMocked("OvsElementService.ListOvsElementsAllWithContext")
+	return
+}
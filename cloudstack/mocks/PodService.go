@@ -0,0 +1,729 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockPodService is a mock of cloudstack.PodServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockPodService struct {
+	Recorder
+
+	NewAcquirePodIpAddressParamsFunc                   func(zoneid string) *cloudstack.AcquirePodIpAddressParams
+	AcquirePodIpAddressFunc                            func(p *cloudstack.AcquirePodIpAddressParams) (*cloudstack.AcquirePodIpAddressResponse, error)
+	AcquirePodIpAddressWithContextFunc                 func(ctx context.Context, p *cloudstack.AcquirePodIpAddressParams) (*cloudstack.AcquirePodIpAddressResponse, error)
+	NewCreateManagementNetworkIpRangeParamsFunc        func(gateway string, netmask string, podid string, startip string) *cloudstack.CreateManagementNetworkIpRangeParams
+	CreateManagementNetworkIpRangeFunc                 func(p *cloudstack.CreateManagementNetworkIpRangeParams) (*cloudstack.CreateManagementNetworkIpRangeResponse, error)
+	CreateManagementNetworkIpRangeWithContextFunc      func(ctx context.Context, p *cloudstack.CreateManagementNetworkIpRangeParams) (*cloudstack.CreateManagementNetworkIpRangeResponse, error)
+	CreateManagementNetworkIpRangeAsyncFunc            func(p *cloudstack.CreateManagementNetworkIpRangeParams) (*cloudstack.Job, error)
+	CreateManagementNetworkIpRangeAsyncWithContextFunc func(ctx context.Context, p *cloudstack.CreateManagementNetworkIpRangeParams) (*cloudstack.Job, error)
+	NewCreatePodParamsFunc                             func(gateway string, name string, netmask string, startip string, zoneid string) *cloudstack.CreatePodParams
+	CreatePodFunc                                      func(p *cloudstack.CreatePodParams) (*cloudstack.CreatePodResponse, error)
+	CreatePodWithContextFunc                           func(ctx context.Context, p *cloudstack.CreatePodParams) (*cloudstack.CreatePodResponse, error)
+	NewDedicatePodParamsFunc                           func(domainid string, podid string) *cloudstack.DedicatePodParams
+	DedicatePodFunc                                    func(p *cloudstack.DedicatePodParams) (*cloudstack.DedicatePodResponse, error)
+	DedicatePodWithContextFunc                         func(ctx context.Context, p *cloudstack.DedicatePodParams) (*cloudstack.DedicatePodResponse, error)
+	DedicatePodAsyncFunc                               func(p *cloudstack.DedicatePodParams) (*cloudstack.Job, error)
+	DedicatePodAsyncWithContextFunc                    func(ctx context.Context, p *cloudstack.DedicatePodParams) (*cloudstack.Job, error)
+	NewDeleteManagementNetworkIpRangeParamsFunc        func(endip string, podid string, startip string, vlan string) *cloudstack.DeleteManagementNetworkIpRangeParams
+	DeleteManagementNetworkIpRangeFunc                 func(p *cloudstack.DeleteManagementNetworkIpRangeParams) (*cloudstack.DeleteManagementNetworkIpRangeResponse, error)
+	DeleteManagementNetworkIpRangeWithContextFunc      func(ctx context.Context, p *cloudstack.DeleteManagementNetworkIpRangeParams) (*cloudstack.DeleteManagementNetworkIpRangeResponse, error)
+	DeleteManagementNetworkIpRangeAsyncFunc            func(p *cloudstack.DeleteManagementNetworkIpRangeParams) (*cloudstack.Job, error)
+	DeleteManagementNetworkIpRangeAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DeleteManagementNetworkIpRangeParams) (*cloudstack.Job, error)
+	NewDeletePodParamsFunc                             func(id string) *cloudstack.DeletePodParams
+	DeletePodFunc                                      func(p *cloudstack.DeletePodParams) (*cloudstack.DeletePodResponse, error)
+	DeletePodWithContextFunc                           func(ctx context.Context, p *cloudstack.DeletePodParams) (*cloudstack.DeletePodResponse, error)
+	NewListDedicatedPodsParamsFunc                     func() *cloudstack.ListDedicatedPodsParams
+	ListDedicatedPodsFunc                              func(p *cloudstack.ListDedicatedPodsParams) (*cloudstack.ListDedicatedPodsResponse, error)
+	ListDedicatedPodsWithContextFunc                   func(ctx context.Context, p *cloudstack.ListDedicatedPodsParams) (*cloudstack.ListDedicatedPodsResponse, error)
+	NewListDedicatedPodsPagerFunc                      func(p *cloudstack.ListDedicatedPodsParams) *cloudstack.ListDedicatedPodsPager
+	ListDedicatedPodsAllFunc                           func(p *cloudstack.ListDedicatedPodsParams) (*cloudstack.ListDedicatedPodsResponse, error)
+	ListDedicatedPodsAllWithContextFunc                func(ctx context.Context, p *cloudstack.ListDedicatedPodsParams) (*cloudstack.ListDedicatedPodsResponse, error)
+	NewListPodsParamsFunc                              func() *cloudstack.ListPodsParams
+	GetPodIDFunc                                       func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetPodIDWithContextFunc                            func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetPodByNameFunc                                   func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Pod, int, error)
+	GetPodByNameWithContextFunc                        func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.Pod, int, error)
+	GetPodByIDFunc                                     func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Pod, int, error)
+	GetPodByIDWithContextFunc                          func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Pod, int, error)
+	ListPodsFunc                                       func(p *cloudstack.ListPodsParams) (*cloudstack.ListPodsResponse, error)
+	ListPodsWithContextFunc                            func(ctx context.Context, p *cloudstack.ListPodsParams) (*cloudstack.ListPodsResponse, error)
+	NewListPodsPagerFunc                               func(p *cloudstack.ListPodsParams) *cloudstack.ListPodsPager
+	ListPodsAllFunc                                    func(p *cloudstack.ListPodsParams) (*cloudstack.ListPodsResponse, error)
+	ListPodsAllWithContextFunc                         func(ctx context.Context, p *cloudstack.ListPodsParams) (*cloudstack.ListPodsResponse, error)
+	NewReleaseDedicatedPodParamsFunc                   func(podid string) *cloudstack.ReleaseDedicatedPodParams
+	ReleaseDedicatedPodFunc                            func(p *cloudstack.ReleaseDedicatedPodParams) (*cloudstack.ReleaseDedicatedPodResponse, error)
+	ReleaseDedicatedPodWithContextFunc                 func(ctx context.Context, p *cloudstack.ReleaseDedicatedPodParams) (*cloudstack.ReleaseDedicatedPodResponse, error)
+	ReleaseDedicatedPodAsyncFunc                       func(p *cloudstack.ReleaseDedicatedPodParams) (*cloudstack.Job, error)
+	ReleaseDedicatedPodAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.ReleaseDedicatedPodParams) (*cloudstack.Job, error)
+	NewReleasePodIpAddressParamsFunc                   func(id int64) *cloudstack.ReleasePodIpAddressParams
+	ReleasePodIpAddressFunc                            func(p *cloudstack.ReleasePodIpAddressParams) (*cloudstack.ReleasePodIpAddressResponse, error)
+	ReleasePodIpAddressWithContextFunc                 func(ctx context.Context, p *cloudstack.ReleasePodIpAddressParams) (*cloudstack.ReleasePodIpAddressResponse, error)
+	NewUpdatePodParamsFunc                             func(id string) *cloudstack.UpdatePodParams
+	UpdatePodFunc                                      func(p *cloudstack.UpdatePodParams) (*cloudstack.UpdatePodResponse, error)
+	UpdatePodWithContextFunc                           func(ctx context.Context, p *cloudstack.UpdatePodParams) (*cloudstack.UpdatePodResponse, error)
+}
+
+var _ cloudstack.PodServiceIface = (*MockPodService)(nil)
+
+// NewAcquirePodIpAddressParams records the call and calls NewAcquirePodIpAddressParamsFunc
+func (m *MockPodService) NewAcquirePodIpAddressParams(zoneid string) (r0 *cloudstack.AcquirePodIpAddressParams) {
+	m.record("NewAcquirePodIpAddressParams", zoneid)
+	if m.NewAcquirePodIpAddressParamsFunc != nil {
+		return m.NewAcquirePodIpAddressParamsFunc(zoneid)
+	}
+	return cloudstack.NewPodService(nil).NewAcquirePodIpAddressParams(zoneid)
+}
+
+// AcquirePodIpAddress records the call and calls AcquirePodIpAddressFunc, or AcquirePodIpAddressWithContextFunc if only that is set
+func (m *MockPodService) AcquirePodIpAddress(p *cloudstack.AcquirePodIpAddressParams) (r0 *cloudstack.AcquirePodIpAddressResponse, r1 error) {
+	m.record("AcquirePodIpAddress", p)
+	if m.AcquirePodIpAddressFunc != nil {
+		return m.AcquirePodIpAddressFunc(p)
+	}
+	if m.AcquirePodIpAddressWithContextFunc != nil {
+		return m.AcquirePodIpAddressWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.AcquirePodIpAddress")
+	return
+}
+
+// AcquirePodIpAddressWithContext records the call and calls AcquirePodIpAddressWithContextFunc, or AcquirePodIpAddressFunc if only that is set
+func (m *MockPodService) AcquirePodIpAddressWithContext(ctx context.Context, p *cloudstack.AcquirePodIpAddressParams) (r0 *cloudstack.AcquirePodIpAddressResponse, r1 error) {
+	m.record("AcquirePodIpAddressWithContext", ctx, p)
+	if m.AcquirePodIpAddressWithContextFunc != nil {
+		return m.AcquirePodIpAddressWithContextFunc(ctx, p)
+	}
+	if m.AcquirePodIpAddressFunc != nil {
+		return m.AcquirePodIpAddressFunc(p)
+	}
+	r1 = notMocked("PodService.AcquirePodIpAddressWithContext")
+	return
+}
+
+// NewCreateManagementNetworkIpRangeParams records the call and calls NewCreateManagementNetworkIpRangeParamsFunc
+func (m *MockPodService) NewCreateManagementNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) (r0 *cloudstack.CreateManagementNetworkIpRangeParams) {
+	m.record("NewCreateManagementNetworkIpRangeParams", gateway, netmask, podid, startip)
+	if m.NewCreateManagementNetworkIpRangeParamsFunc != nil {
+		return m.NewCreateManagementNetworkIpRangeParamsFunc(gateway, netmask, podid, startip)
+	}
+	return cloudstack.NewPodService(nil).NewCreateManagementNetworkIpRangeParams(gateway, netmask, podid, startip)
+}
+
+// CreateManagementNetworkIpRange records the call and calls CreateManagementNetworkIpRangeFunc, or CreateManagementNetworkIpRangeWithContextFunc if only that is set
+func (m *MockPodService) CreateManagementNetworkIpRange(p *cloudstack.CreateManagementNetworkIpRangeParams) (r0 *cloudstack.CreateManagementNetworkIpRangeResponse, r1 error) {
+	m.record("CreateManagementNetworkIpRange", p)
+	if m.CreateManagementNetworkIpRangeFunc != nil {
+		return m.CreateManagementNetworkIpRangeFunc(p)
+	}
+	if m.CreateManagementNetworkIpRangeWithContextFunc != nil {
+		return m.CreateManagementNetworkIpRangeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.CreateManagementNetworkIpRange")
+	return
+}
+
+// CreateManagementNetworkIpRangeWithContext records the call and calls CreateManagementNetworkIpRangeWithContextFunc, or CreateManagementNetworkIpRangeFunc if only that is set
+func (m *MockPodService) CreateManagementNetworkIpRangeWithContext(ctx context.Context, p *cloudstack.CreateManagementNetworkIpRangeParams) (r0 *cloudstack.CreateManagementNetworkIpRangeResponse, r1 error) {
+	m.record("CreateManagementNetworkIpRangeWithContext", ctx, p)
+	if m.CreateManagementNetworkIpRangeWithContextFunc != nil {
+		return m.CreateManagementNetworkIpRangeWithContextFunc(ctx, p)
+	}
+	if m.CreateManagementNetworkIpRangeFunc != nil {
+		return m.CreateManagementNetworkIpRangeFunc(p)
+	}
+	r1 = notMocked("PodService.CreateManagementNetworkIpRangeWithContext")
+	return
+}
+
+// CreateManagementNetworkIpRangeAsync records the call and calls CreateManagementNetworkIpRangeAsyncFunc, or CreateManagementNetworkIpRangeAsyncWithContextFunc if only that is set
+func (m *MockPodService) CreateManagementNetworkIpRangeAsync(p *cloudstack.CreateManagementNetworkIpRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateManagementNetworkIpRangeAsync", p)
+	if m.CreateManagementNetworkIpRangeAsyncFunc != nil {
+		return m.CreateManagementNetworkIpRangeAsyncFunc(p)
+	}
+	if m.CreateManagementNetworkIpRangeAsyncWithContextFunc != nil {
+		return m.CreateManagementNetworkIpRangeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.CreateManagementNetworkIpRangeAsync")
+	return
+}
+
+// CreateManagementNetworkIpRangeAsyncWithContext records the call and calls CreateManagementNetworkIpRangeAsyncWithContextFunc, or CreateManagementNetworkIpRangeAsyncFunc if only that is set
+func (m *MockPodService) CreateManagementNetworkIpRangeAsyncWithContext(ctx context.Context, p *cloudstack.CreateManagementNetworkIpRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateManagementNetworkIpRangeAsyncWithContext", ctx, p)
+	if m.CreateManagementNetworkIpRangeAsyncWithContextFunc != nil {
+		return m.CreateManagementNetworkIpRangeAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateManagementNetworkIpRangeAsyncFunc != nil {
+		return m.CreateManagementNetworkIpRangeAsyncFunc(p)
+	}
+	r1 = notMocked("PodService.CreateManagementNetworkIpRangeAsyncWithContext")
+	return
+}
+
+// NewCreatePodParams records the call and calls NewCreatePodParamsFunc
+func (m *MockPodService) NewCreatePodParams(gateway string, name string, netmask string, startip string, zoneid string) (r0 *cloudstack.CreatePodParams) {
+	m.record("NewCreatePodParams", gateway, name, netmask, startip, zoneid)
+	if m.NewCreatePodParamsFunc != nil {
+		return m.NewCreatePodParamsFunc(gateway, name, netmask, startip, zoneid)
+	}
+	return cloudstack.NewPodService(nil).NewCreatePodParams(gateway, name, netmask, startip, zoneid)
+}
+
+// CreatePod records the call and calls CreatePodFunc, or CreatePodWithContextFunc if only that is set
+func (m *MockPodService) CreatePod(p *cloudstack.CreatePodParams) (r0 *cloudstack.CreatePodResponse, r1 error) {
+	m.record("CreatePod", p)
+	if m.CreatePodFunc != nil {
+		return m.CreatePodFunc(p)
+	}
+	if m.CreatePodWithContextFunc != nil {
+		return m.CreatePodWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.CreatePod")
+	return
+}
+
+// CreatePodWithContext records the call and calls CreatePodWithContextFunc, or CreatePodFunc if only that is set
+func (m *MockPodService) CreatePodWithContext(ctx context.Context, p *cloudstack.CreatePodParams) (r0 *cloudstack.CreatePodResponse, r1 error) {
+	m.record("CreatePodWithContext", ctx, p)
+	if m.CreatePodWithContextFunc != nil {
+		return m.CreatePodWithContextFunc(ctx, p)
+	}
+	if m.CreatePodFunc != nil {
+		return m.CreatePodFunc(p)
+	}
+	r1 = notMocked("PodService.CreatePodWithContext")
+	return
+}
+
+// NewDedicatePodParams records the call and calls NewDedicatePodParamsFunc
+func (m *MockPodService) NewDedicatePodParams(domainid string, podid string) (r0 *cloudstack.DedicatePodParams) {
+	m.record("NewDedicatePodParams", domainid, podid)
+	if m.NewDedicatePodParamsFunc != nil {
+		return m.NewDedicatePodParamsFunc(domainid, podid)
+	}
+	return cloudstack.NewPodService(nil).NewDedicatePodParams(domainid, podid)
+}
+
+// DedicatePod records the call and calls DedicatePodFunc, or DedicatePodWithContextFunc if only that is set
+func (m *MockPodService) DedicatePod(p *cloudstack.DedicatePodParams) (r0 *cloudstack.DedicatePodResponse, r1 error) {
+	m.record("DedicatePod", p)
+	if m.DedicatePodFunc != nil {
+		return m.DedicatePodFunc(p)
+	}
+	if m.DedicatePodWithContextFunc != nil {
+		return m.DedicatePodWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.DedicatePod")
+	return
+}
+
+// DedicatePodWithContext records the call and calls DedicatePodWithContextFunc, or DedicatePodFunc if only that is set
+func (m *MockPodService) DedicatePodWithContext(ctx context.Context, p *cloudstack.DedicatePodParams) (r0 *cloudstack.DedicatePodResponse, r1 error) {
+	m.record("DedicatePodWithContext", ctx, p)
+	if m.DedicatePodWithContextFunc != nil {
+		return m.DedicatePodWithContextFunc(ctx, p)
+	}
+	if m.DedicatePodFunc != nil {
+		return m.DedicatePodFunc(p)
+	}
+	r1 = notMocked("PodService.DedicatePodWithContext")
+	return
+}
+
+// DedicatePodAsync records the call and calls DedicatePodAsyncFunc, or DedicatePodAsyncWithContextFunc if only that is set
+func (m *MockPodService) DedicatePodAsync(p *cloudstack.DedicatePodParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DedicatePodAsync", p)
+	if m.DedicatePodAsyncFunc != nil {
+		return m.DedicatePodAsyncFunc(p)
+	}
+	if m.DedicatePodAsyncWithContextFunc != nil {
+		return m.DedicatePodAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.DedicatePodAsync")
+	return
+}
+
+// DedicatePodAsyncWithContext records the call and calls DedicatePodAsyncWithContextFunc, or DedicatePodAsyncFunc if only that is set
+func (m *MockPodService) DedicatePodAsyncWithContext(ctx context.Context, p *cloudstack.DedicatePodParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DedicatePodAsyncWithContext", ctx, p)
+	if m.DedicatePodAsyncWithContextFunc != nil {
+		return m.DedicatePodAsyncWithContextFunc(ctx, p)
+	}
+	if m.DedicatePodAsyncFunc != nil {
+		return m.DedicatePodAsyncFunc(p)
+	}
+	r1 = notMocked("PodService.DedicatePodAsyncWithContext")
+	return
+}
+
+// NewDeleteManagementNetworkIpRangeParams records the call and calls NewDeleteManagementNetworkIpRangeParamsFunc
+func (m *MockPodService) NewDeleteManagementNetworkIpRangeParams(endip string, podid string, startip string, vlan string) (r0 *cloudstack.DeleteManagementNetworkIpRangeParams) {
+	m.record("NewDeleteManagementNetworkIpRangeParams", endip, podid, startip, vlan)
+	if m.NewDeleteManagementNetworkIpRangeParamsFunc != nil {
+		return m.NewDeleteManagementNetworkIpRangeParamsFunc(endip, podid, startip, vlan)
+	}
+	return cloudstack.NewPodService(nil).NewDeleteManagementNetworkIpRangeParams(endip, podid, startip, vlan)
+}
+
+// DeleteManagementNetworkIpRange records the call and calls DeleteManagementNetworkIpRangeFunc, or DeleteManagementNetworkIpRangeWithContextFunc if only that is set
+func (m *MockPodService) DeleteManagementNetworkIpRange(p *cloudstack.DeleteManagementNetworkIpRangeParams) (r0 *cloudstack.DeleteManagementNetworkIpRangeResponse, r1 error) {
+	m.record("DeleteManagementNetworkIpRange", p)
+	if m.DeleteManagementNetworkIpRangeFunc != nil {
+		return m.DeleteManagementNetworkIpRangeFunc(p)
+	}
+	if m.DeleteManagementNetworkIpRangeWithContextFunc != nil {
+		return m.DeleteManagementNetworkIpRangeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.DeleteManagementNetworkIpRange")
+	return
+}
+
+// DeleteManagementNetworkIpRangeWithContext records the call and calls DeleteManagementNetworkIpRangeWithContextFunc, or DeleteManagementNetworkIpRangeFunc if only that is set
+func (m *MockPodService) DeleteManagementNetworkIpRangeWithContext(ctx context.Context, p *cloudstack.DeleteManagementNetworkIpRangeParams) (r0 *cloudstack.DeleteManagementNetworkIpRangeResponse, r1 error) {
+	m.record("DeleteManagementNetworkIpRangeWithContext", ctx, p)
+	if m.DeleteManagementNetworkIpRangeWithContextFunc != nil {
+		return m.DeleteManagementNetworkIpRangeWithContextFunc(ctx, p)
+	}
+	if m.DeleteManagementNetworkIpRangeFunc != nil {
+		return m.DeleteManagementNetworkIpRangeFunc(p)
+	}
+	r1 = notMocked("PodService.DeleteManagementNetworkIpRangeWithContext")
+	return
+}
+
+// DeleteManagementNetworkIpRangeAsync records the call and calls DeleteManagementNetworkIpRangeAsyncFunc, or DeleteManagementNetworkIpRangeAsyncWithContextFunc if only that is set
+func (m *MockPodService) DeleteManagementNetworkIpRangeAsync(p *cloudstack.DeleteManagementNetworkIpRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteManagementNetworkIpRangeAsync", p)
+	if m.DeleteManagementNetworkIpRangeAsyncFunc != nil {
+		return m.DeleteManagementNetworkIpRangeAsyncFunc(p)
+	}
+	if m.DeleteManagementNetworkIpRangeAsyncWithContextFunc != nil {
+		return m.DeleteManagementNetworkIpRangeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.DeleteManagementNetworkIpRangeAsync")
+	return
+}
+
+// DeleteManagementNetworkIpRangeAsyncWithContext records the call and calls DeleteManagementNetworkIpRangeAsyncWithContextFunc, or DeleteManagementNetworkIpRangeAsyncFunc if only that is set
+func (m *MockPodService) DeleteManagementNetworkIpRangeAsyncWithContext(ctx context.Context, p *cloudstack.DeleteManagementNetworkIpRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteManagementNetworkIpRangeAsyncWithContext", ctx, p)
+	if m.DeleteManagementNetworkIpRangeAsyncWithContextFunc != nil {
+		return m.DeleteManagementNetworkIpRangeAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteManagementNetworkIpRangeAsyncFunc != nil {
+		return m.DeleteManagementNetworkIpRangeAsyncFunc(p)
+	}
+	r1 = notMocked("PodService.DeleteManagementNetworkIpRangeAsyncWithContext")
+	return
+}
+
+// NewDeletePodParams records the call and calls NewDeletePodParamsFunc
+func (m *MockPodService) NewDeletePodParams(id string) (r0 *cloudstack.DeletePodParams) {
+	m.record("NewDeletePodParams", id)
+	if m.NewDeletePodParamsFunc != nil {
+		return m.NewDeletePodParamsFunc(id)
+	}
+	return cloudstack.NewPodService(nil).NewDeletePodParams(id)
+}
+
+// DeletePod records the call and calls DeletePodFunc, or DeletePodWithContextFunc if only that is set
+func (m *MockPodService) DeletePod(p *cloudstack.DeletePodParams) (r0 *cloudstack.DeletePodResponse, r1 error) {
+	m.record("DeletePod", p)
+	if m.DeletePodFunc != nil {
+		return m.DeletePodFunc(p)
+	}
+	if m.DeletePodWithContextFunc != nil {
+		return m.DeletePodWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.DeletePod")
+	return
+}
+
+// DeletePodWithContext records the call and calls DeletePodWithContextFunc, or DeletePodFunc if only that is set
+func (m *MockPodService) DeletePodWithContext(ctx context.Context, p *cloudstack.DeletePodParams) (r0 *cloudstack.DeletePodResponse, r1 error) {
+	m.record("DeletePodWithContext", ctx, p)
+	if m.DeletePodWithContextFunc != nil {
+		return m.DeletePodWithContextFunc(ctx, p)
+	}
+	if m.DeletePodFunc != nil {
+		return m.DeletePodFunc(p)
+	}
+	r1 = notMocked("PodService.DeletePodWithContext")
+	return
+}
+
+// NewListDedicatedPodsParams records the call and calls NewListDedicatedPodsParamsFunc
+func (m *MockPodService) NewListDedicatedPodsParams() (r0 *cloudstack.ListDedicatedPodsParams) {
+	m.record("NewListDedicatedPodsParams")
+	if m.NewListDedicatedPodsParamsFunc != nil {
+		return m.NewListDedicatedPodsParamsFunc()
+	}
+	return cloudstack.NewPodService(nil).NewListDedicatedPodsParams()
+}
+
+// ListDedicatedPods records the call and calls ListDedicatedPodsFunc, or ListDedicatedPodsWithContextFunc if only that is set
+func (m *MockPodService) ListDedicatedPods(p *cloudstack.ListDedicatedPodsParams) (r0 *cloudstack.ListDedicatedPodsResponse, r1 error) {
+	m.record("ListDedicatedPods", p)
+	if m.ListDedicatedPodsFunc != nil {
+		return m.ListDedicatedPodsFunc(p)
+	}
+	if m.ListDedicatedPodsWithContextFunc != nil {
+		return m.ListDedicatedPodsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.ListDedicatedPods")
+	return
+}
+
+// ListDedicatedPodsWithContext records the call and calls ListDedicatedPodsWithContextFunc, or ListDedicatedPodsFunc if only that is set
+func (m *MockPodService) ListDedicatedPodsWithContext(ctx context.Context, p *cloudstack.ListDedicatedPodsParams) (r0 *cloudstack.ListDedicatedPodsResponse, r1 error) {
+	m.record("ListDedicatedPodsWithContext", ctx, p)
+	if m.ListDedicatedPodsWithContextFunc != nil {
+		return m.ListDedicatedPodsWithContextFunc(ctx, p)
+	}
+	if m.ListDedicatedPodsFunc != nil {
+		return m.ListDedicatedPodsFunc(p)
+	}
+	r1 = notMocked("PodService.ListDedicatedPodsWithContext")
+	return
+}
+
+// NewListDedicatedPodsPager records the call and calls NewListDedicatedPodsPagerFunc
+func (m *MockPodService) NewListDedicatedPodsPager(p *cloudstack.ListDedicatedPodsParams) (r0 *cloudstack.ListDedicatedPodsPager) {
+	m.record("NewListDedicatedPodsPager", p)
+	if m.NewListDedicatedPodsPagerFunc != nil {
+		return m.NewListDedicatedPodsPagerFunc(p)
+	}
+	return
+}
+
+// ListDedicatedPodsAll records the call and calls ListDedicatedPodsAllFunc, or ListDedicatedPodsAllWithContextFunc if only that is set
+func (m *MockPodService) ListDedicatedPodsAll(p *cloudstack.ListDedicatedPodsParams) (r0 *cloudstack.ListDedicatedPodsResponse, r1 error) {
+	m.record("ListDedicatedPodsAll", p)
+	if m.ListDedicatedPodsAllFunc != nil {
+		return m.ListDedicatedPodsAllFunc(p)
+	}
+	if m.ListDedicatedPodsAllWithContextFunc != nil {
+		return m.ListDedicatedPodsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.ListDedicatedPodsAll")
+	return
+}
+
+// ListDedicatedPodsAllWithContext records the call and calls ListDedicatedPodsAllWithContextFunc, or ListDedicatedPodsAllFunc if only that is set
+func (m *MockPodService) ListDedicatedPodsAllWithContext(ctx context.Context, p *cloudstack.ListDedicatedPodsParams) (r0 *cloudstack.ListDedicatedPodsResponse, r1 error) {
+	m.record("ListDedicatedPodsAllWithContext", ctx, p)
+	if m.ListDedicatedPodsAllWithContextFunc != nil {
+		return m.ListDedicatedPodsAllWithContextFunc(ctx, p)
+	}
+	if m.ListDedicatedPodsAllFunc != nil {
+		return m.ListDedicatedPodsAllFunc(p)
+	}
+	r1 = notMocked("PodService.ListDedicatedPodsAllWithContext")
+	return
+}
+
+// NewListPodsParams records the call and calls NewListPodsParamsFunc
+func (m *MockPodService) NewListPodsParams() (r0 *cloudstack.ListPodsParams) {
+	m.record("NewListPodsParams")
+	if m.NewListPodsParamsFunc != nil {
+		return m.NewListPodsParamsFunc()
+	}
+	return cloudstack.NewPodService(nil).NewListPodsParams()
+}
+
+// GetPodID records the call and calls GetPodIDFunc, or GetPodIDWithContextFunc if only that is set
+func (m *MockPodService) GetPodID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetPodID", name, opts)
+	if m.GetPodIDFunc != nil {
+		return m.GetPodIDFunc(name, opts...)
+	}
+	if m.GetPodIDWithContextFunc != nil {
+		return m.GetPodIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("PodService.GetPodID")
+	return
+}
+
+// GetPodIDWithContext records the call and calls GetPodIDWithContextFunc, or GetPodIDFunc if only that is set
+func (m *MockPodService) GetPodIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetPodIDWithContext", ctx, name, opts)
+	if m.GetPodIDWithContextFunc != nil {
+		return m.GetPodIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetPodIDFunc != nil {
+		return m.GetPodIDFunc(name, opts...)
+	}
+	r2 = notMocked("PodService.GetPodIDWithContext")
+	return
+}
+
+// GetPodByName records the call and calls GetPodByNameFunc, or GetPodByNameWithContextFunc if only that is set
+func (m *MockPodService) GetPodByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Pod, r1 int, r2 error) {
+	m.record("GetPodByName", name, opts)
+	if m.GetPodByNameFunc != nil {
+		return m.GetPodByNameFunc(name, opts...)
+	}
+	if m.GetPodByNameWithContextFunc != nil {
+		return m.GetPodByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("PodService.GetPodByName")
+	return
+}
+
+// GetPodByNameWithContext records the call and calls GetPodByNameWithContextFunc, or GetPodByNameFunc if only that is set
+func (m *MockPodService) GetPodByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Pod, r1 int, r2 error) {
+	m.record("GetPodByNameWithContext", ctx, name, opts)
+	if m.GetPodByNameWithContextFunc != nil {
+		return m.GetPodByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetPodByNameFunc != nil {
+		return m.GetPodByNameFunc(name, opts...)
+	}
+	r2 = notMocked("PodService.GetPodByNameWithContext")
+	return
+}
+
+// GetPodByID records the call and calls GetPodByIDFunc, or GetPodByIDWithContextFunc if only that is set
+func (m *MockPodService) GetPodByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Pod, r1 int, r2 error) {
+	m.record("GetPodByID", id, opts)
+	if m.GetPodByIDFunc != nil {
+		return m.GetPodByIDFunc(id, opts...)
+	}
+	if m.GetPodByIDWithContextFunc != nil {
+		return m.GetPodByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("PodService.GetPodByID")
+	return
+}
+
+// GetPodByIDWithContext records the call and calls GetPodByIDWithContextFunc, or GetPodByIDFunc if only that is set
+func (m *MockPodService) GetPodByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Pod, r1 int, r2 error) {
+	m.record("GetPodByIDWithContext", ctx, id, opts)
+	if m.GetPodByIDWithContextFunc != nil {
+		return m.GetPodByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetPodByIDFunc != nil {
+		return m.GetPodByIDFunc(id, opts...)
+	}
+	r2 = notMocked("PodService.GetPodByIDWithContext")
+	return
+}
+
+// ListPods records the call and calls ListPodsFunc, or ListPodsWithContextFunc if only that is set
+func (m *MockPodService) ListPods(p *cloudstack.ListPodsParams) (r0 *cloudstack.ListPodsResponse, r1 error) {
+	m.record("ListPods", p)
+	if m.ListPodsFunc != nil {
+		return m.ListPodsFunc(p)
+	}
+	if m.ListPodsWithContextFunc != nil {
+		return m.ListPodsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.ListPods")
+	return
+}
+
+// ListPodsWithContext records the call and calls ListPodsWithContextFunc, or ListPodsFunc if only that is set
+func (m *MockPodService) ListPodsWithContext(ctx context.Context, p *cloudstack.ListPodsParams) (r0 *cloudstack.ListPodsResponse, r1 error) {
+	m.record("ListPodsWithContext", ctx, p)
+	if m.ListPodsWithContextFunc != nil {
+		return m.ListPodsWithContextFunc(ctx, p)
+	}
+	if m.ListPodsFunc != nil {
+		return m.ListPodsFunc(p)
+	}
+	r1 = notMocked("PodService.ListPodsWithContext")
+	return
+}
+
+// NewListPodsPager records the call and calls NewListPodsPagerFunc
+func (m *MockPodService) NewListPodsPager(p *cloudstack.ListPodsParams) (r0 *cloudstack.ListPodsPager) {
+	m.record("NewListPodsPager", p)
+	if m.NewListPodsPagerFunc != nil {
+		return m.NewListPodsPagerFunc(p)
+	}
+	return
+}
+
+// ListPodsAll records the call and calls ListPodsAllFunc, or ListPodsAllWithContextFunc if only that is set
+func (m *MockPodService) ListPodsAll(p *cloudstack.ListPodsParams) (r0 *cloudstack.ListPodsResponse, r1 error) {
+	m.record("ListPodsAll", p)
+	if m.ListPodsAllFunc != nil {
+		return m.ListPodsAllFunc(p)
+	}
+	if m.ListPodsAllWithContextFunc != nil {
+		return m.ListPodsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.ListPodsAll")
+	return
+}
+
+// ListPodsAllWithContext records the call and calls ListPodsAllWithContextFunc, or ListPodsAllFunc if only that is set
+func (m *MockPodService) ListPodsAllWithContext(ctx context.Context, p *cloudstack.ListPodsParams) (r0 *cloudstack.ListPodsResponse, r1 error) {
+	m.record("ListPodsAllWithContext", ctx, p)
+	if m.ListPodsAllWithContextFunc != nil {
+		return m.ListPodsAllWithContextFunc(ctx, p)
+	}
+	if m.ListPodsAllFunc != nil {
+		return m.ListPodsAllFunc(p)
+	}
+	r1 = notMocked("PodService.ListPodsAllWithContext")
+	return
+}
+
+// NewReleaseDedicatedPodParams records the call and calls NewReleaseDedicatedPodParamsFunc
+func (m *MockPodService) NewReleaseDedicatedPodParams(podid string) (r0 *cloudstack.ReleaseDedicatedPodParams) {
+	m.record("NewReleaseDedicatedPodParams", podid)
+	if m.NewReleaseDedicatedPodParamsFunc != nil {
+		return m.NewReleaseDedicatedPodParamsFunc(podid)
+	}
+	return cloudstack.NewPodService(nil).NewReleaseDedicatedPodParams(podid)
+}
+
+// ReleaseDedicatedPod records the call and calls ReleaseDedicatedPodFunc, or ReleaseDedicatedPodWithContextFunc if only that is set
+func (m *MockPodService) ReleaseDedicatedPod(p *cloudstack.ReleaseDedicatedPodParams) (r0 *cloudstack.ReleaseDedicatedPodResponse, r1 error) {
+	m.record("ReleaseDedicatedPod", p)
+	if m.ReleaseDedicatedPodFunc != nil {
+		return m.ReleaseDedicatedPodFunc(p)
+	}
+	if m.ReleaseDedicatedPodWithContextFunc != nil {
+		return m.ReleaseDedicatedPodWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.ReleaseDedicatedPod")
+	return
+}
+
+// ReleaseDedicatedPodWithContext records the call and calls ReleaseDedicatedPodWithContextFunc, or ReleaseDedicatedPodFunc if only that is set
+func (m *MockPodService) ReleaseDedicatedPodWithContext(ctx context.Context, p *cloudstack.ReleaseDedicatedPodParams) (r0 *cloudstack.ReleaseDedicatedPodResponse, r1 error) {
+	m.record("ReleaseDedicatedPodWithContext", ctx, p)
+	if m.ReleaseDedicatedPodWithContextFunc != nil {
+		return m.ReleaseDedicatedPodWithContextFunc(ctx, p)
+	}
+	if m.ReleaseDedicatedPodFunc != nil {
+		return m.ReleaseDedicatedPodFunc(p)
+	}
+	r1 = notMocked("PodService.ReleaseDedicatedPodWithContext")
+	return
+}
+
+// ReleaseDedicatedPodAsync records the call and calls ReleaseDedicatedPodAsyncFunc, or ReleaseDedicatedPodAsyncWithContextFunc if only that is set
+func (m *MockPodService) ReleaseDedicatedPodAsync(p *cloudstack.ReleaseDedicatedPodParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReleaseDedicatedPodAsync", p)
+	if m.ReleaseDedicatedPodAsyncFunc != nil {
+		return m.ReleaseDedicatedPodAsyncFunc(p)
+	}
+	if m.ReleaseDedicatedPodAsyncWithContextFunc != nil {
+		return m.ReleaseDedicatedPodAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.ReleaseDedicatedPodAsync")
+	return
+}
+
+// ReleaseDedicatedPodAsyncWithContext records the call and calls ReleaseDedicatedPodAsyncWithContextFunc, or ReleaseDedicatedPodAsyncFunc if only that is set
+func (m *MockPodService) ReleaseDedicatedPodAsyncWithContext(ctx context.Context, p *cloudstack.ReleaseDedicatedPodParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReleaseDedicatedPodAsyncWithContext", ctx, p)
+	if m.ReleaseDedicatedPodAsyncWithContextFunc != nil {
+		return m.ReleaseDedicatedPodAsyncWithContextFunc(ctx, p)
+	}
+	if m.ReleaseDedicatedPodAsyncFunc != nil {
+		return m.ReleaseDedicatedPodAsyncFunc(p)
+	}
+	r1 = notMocked("PodService.ReleaseDedicatedPodAsyncWithContext")
+	return
+}
+
+// NewReleasePodIpAddressParams records the call and calls NewReleasePodIpAddressParamsFunc
+func (m *MockPodService) NewReleasePodIpAddressParams(id int64) (r0 *cloudstack.ReleasePodIpAddressParams) {
+	m.record("NewReleasePodIpAddressParams", id)
+	if m.NewReleasePodIpAddressParamsFunc != nil {
+		return m.NewReleasePodIpAddressParamsFunc(id)
+	}
+	return cloudstack.NewPodService(nil).NewReleasePodIpAddressParams(id)
+}
+
+// ReleasePodIpAddress records the call and calls ReleasePodIpAddressFunc, or ReleasePodIpAddressWithContextFunc if only that is set
+func (m *MockPodService) ReleasePodIpAddress(p *cloudstack.ReleasePodIpAddressParams) (r0 *cloudstack.ReleasePodIpAddressResponse, r1 error) {
+	m.record("ReleasePodIpAddress", p)
+	if m.ReleasePodIpAddressFunc != nil {
+		return m.ReleasePodIpAddressFunc(p)
+	}
+	if m.ReleasePodIpAddressWithContextFunc != nil {
+		return m.ReleasePodIpAddressWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.ReleasePodIpAddress")
+	return
+}
+
+// ReleasePodIpAddressWithContext records the call and calls ReleasePodIpAddressWithContextFunc, or ReleasePodIpAddressFunc if only that is set
+func (m *MockPodService) ReleasePodIpAddressWithContext(ctx context.Context, p *cloudstack.ReleasePodIpAddressParams) (r0 *cloudstack.ReleasePodIpAddressResponse, r1 error) {
+	m.record("ReleasePodIpAddressWithContext", ctx, p)
+	if m.ReleasePodIpAddressWithContextFunc != nil {
+		return m.ReleasePodIpAddressWithContextFunc(ctx, p)
+	}
+	if m.ReleasePodIpAddressFunc != nil {
+		return m.ReleasePodIpAddressFunc(p)
+	}
+	r1 = notMocked("PodService.ReleasePodIpAddressWithContext")
+	return
+}
+
+// NewUpdatePodParams records the call and calls NewUpdatePodParamsFunc
+func (m *MockPodService) NewUpdatePodParams(id string) (r0 *cloudstack.UpdatePodParams) {
+	m.record("NewUpdatePodParams", id)
+	if m.NewUpdatePodParamsFunc != nil {
+		return m.NewUpdatePodParamsFunc(id)
+	}
+	return cloudstack.NewPodService(nil).NewUpdatePodParams(id)
+}
+
+// UpdatePod records the call and calls UpdatePodFunc, or UpdatePodWithContextFunc if only that is set
+func (m *MockPodService) UpdatePod(p *cloudstack.UpdatePodParams) (r0 *cloudstack.UpdatePodResponse, r1 error) {
+	m.record("UpdatePod", p)
+	if m.UpdatePodFunc != nil {
+		return m.UpdatePodFunc(p)
+	}
+	if m.UpdatePodWithContextFunc != nil {
+		return m.UpdatePodWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PodService.UpdatePod")
+	return
+}
+
+// UpdatePodWithContext records the call and calls UpdatePodWithContextFunc, or UpdatePodFunc if only that is set
+func (m *MockPodService) UpdatePodWithContext(ctx context.Context, p *cloudstack.UpdatePodParams) (r0 *cloudstack.UpdatePodResponse, r1 error) {
+	m.record("UpdatePodWithContext", ctx, p)
+	if m.UpdatePodWithContextFunc != nil {
+		return m.UpdatePodWithContextFunc(ctx, p)
+	}
+	if m.UpdatePodFunc != nil {
+		return m.UpdatePodFunc(p)
+	}
+	r1 = notMocked("PodService.UpdatePodWithContext")
+	return
+}
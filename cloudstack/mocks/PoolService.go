@@ -0,0 +1,757 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockPoolService is a mock of cloudstack.PoolServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockPoolService struct {
+	Recorder
+
+	NewCreateStoragePoolParamsFunc              func(name string, url string, zoneid string) *cloudstack.CreateStoragePoolParams
+	CreateStoragePoolFunc                       func(p *cloudstack.CreateStoragePoolParams) (*cloudstack.CreateStoragePoolResponse, error)
+	CreateStoragePoolWithContextFunc            func(ctx context.Context, p *cloudstack.CreateStoragePoolParams) (*cloudstack.CreateStoragePoolResponse, error)
+	NewDeleteStoragePoolParamsFunc              func(id string) *cloudstack.DeleteStoragePoolParams
+	DeleteStoragePoolFunc                       func(p *cloudstack.DeleteStoragePoolParams) (*cloudstack.DeleteStoragePoolResponse, error)
+	DeleteStoragePoolWithContextFunc            func(ctx context.Context, p *cloudstack.DeleteStoragePoolParams) (*cloudstack.DeleteStoragePoolResponse, error)
+	NewFindStoragePoolsForMigrationParamsFunc   func(id string) *cloudstack.FindStoragePoolsForMigrationParams
+	FindStoragePoolsForMigrationFunc            func(p *cloudstack.FindStoragePoolsForMigrationParams) (*cloudstack.FindStoragePoolsForMigrationResponse, error)
+	FindStoragePoolsForMigrationWithContextFunc func(ctx context.Context, p *cloudstack.FindStoragePoolsForMigrationParams) (*cloudstack.FindStoragePoolsForMigrationResponse, error)
+	NewListElastistorInterfaceParamsFunc        func() *cloudstack.ListElastistorInterfaceParams
+	ListElastistorInterfaceFunc                 func(p *cloudstack.ListElastistorInterfaceParams) (*cloudstack.ListElastistorInterfaceResponse, error)
+	ListElastistorInterfaceWithContextFunc      func(ctx context.Context, p *cloudstack.ListElastistorInterfaceParams) (*cloudstack.ListElastistorInterfaceResponse, error)
+	NewListElastistorPoolParamsFunc             func() *cloudstack.ListElastistorPoolParams
+	ListElastistorPoolFunc                      func(p *cloudstack.ListElastistorPoolParams) (*cloudstack.ListElastistorPoolResponse, error)
+	ListElastistorPoolWithContextFunc           func(ctx context.Context, p *cloudstack.ListElastistorPoolParams) (*cloudstack.ListElastistorPoolResponse, error)
+	NewListElastistorVolumeParamsFunc           func(id string) *cloudstack.ListElastistorVolumeParams
+	GetElastistorVolumeByIDFunc                 func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.ElastistorVolume, int, error)
+	GetElastistorVolumeByIDWithContextFunc      func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.ElastistorVolume, int, error)
+	ListElastistorVolumeFunc                    func(p *cloudstack.ListElastistorVolumeParams) (*cloudstack.ListElastistorVolumeResponse, error)
+	ListElastistorVolumeWithContextFunc         func(ctx context.Context, p *cloudstack.ListElastistorVolumeParams) (*cloudstack.ListElastistorVolumeResponse, error)
+	NewListStoragePoolsParamsFunc               func() *cloudstack.ListStoragePoolsParams
+	GetStoragePoolIDFunc                        func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetStoragePoolIDWithContextFunc             func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetStoragePoolByNameFunc                    func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.StoragePool, int, error)
+	GetStoragePoolByNameWithContextFunc         func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.StoragePool, int, error)
+	GetStoragePoolByIDFunc                      func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.StoragePool, int, error)
+	GetStoragePoolByIDWithContextFunc           func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.StoragePool, int, error)
+	ListStoragePoolsFunc                        func(p *cloudstack.ListStoragePoolsParams) (*cloudstack.ListStoragePoolsResponse, error)
+	ListStoragePoolsWithContextFunc             func(ctx context.Context, p *cloudstack.ListStoragePoolsParams) (*cloudstack.ListStoragePoolsResponse, error)
+	NewListStoragePoolsPagerFunc                func(p *cloudstack.ListStoragePoolsParams) *cloudstack.ListStoragePoolsPager
+	ListStoragePoolsAllFunc                     func(p *cloudstack.ListStoragePoolsParams) (*cloudstack.ListStoragePoolsResponse, error)
+	ListStoragePoolsAllWithContextFunc          func(ctx context.Context, p *cloudstack.ListStoragePoolsParams) (*cloudstack.ListStoragePoolsResponse, error)
+	NewListStoragePoolsMetricsParamsFunc        func() *cloudstack.ListStoragePoolsMetricsParams
+	GetStoragePoolsMetricIDFunc                 func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetStoragePoolsMetricIDWithContextFunc      func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetStoragePoolsMetricByNameFunc             func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.StoragePoolsMetric, int, error)
+	GetStoragePoolsMetricByNameWithContextFunc  func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.StoragePoolsMetric, int, error)
+	GetStoragePoolsMetricByIDFunc               func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.StoragePoolsMetric, int, error)
+	GetStoragePoolsMetricByIDWithContextFunc    func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.StoragePoolsMetric, int, error)
+	ListStoragePoolsMetricsFunc                 func(p *cloudstack.ListStoragePoolsMetricsParams) (*cloudstack.ListStoragePoolsMetricsResponse, error)
+	ListStoragePoolsMetricsWithContextFunc      func(ctx context.Context, p *cloudstack.ListStoragePoolsMetricsParams) (*cloudstack.ListStoragePoolsMetricsResponse, error)
+	NewListStoragePoolsMetricsPagerFunc         func(p *cloudstack.ListStoragePoolsMetricsParams) *cloudstack.ListStoragePoolsMetricsPager
+	ListStoragePoolsMetricsAllFunc              func(p *cloudstack.ListStoragePoolsMetricsParams) (*cloudstack.ListStoragePoolsMetricsResponse, error)
+	ListStoragePoolsMetricsAllWithContextFunc   func(ctx context.Context, p *cloudstack.ListStoragePoolsMetricsParams) (*cloudstack.ListStoragePoolsMetricsResponse, error)
+	NewSyncStoragePoolParamsFunc                func(id string) *cloudstack.SyncStoragePoolParams
+	SyncStoragePoolFunc                         func(p *cloudstack.SyncStoragePoolParams) (*cloudstack.SyncStoragePoolResponse, error)
+	SyncStoragePoolWithContextFunc              func(ctx context.Context, p *cloudstack.SyncStoragePoolParams) (*cloudstack.SyncStoragePoolResponse, error)
+	SyncStoragePoolAsyncFunc                    func(p *cloudstack.SyncStoragePoolParams) (*cloudstack.Job, error)
+	SyncStoragePoolAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.SyncStoragePoolParams) (*cloudstack.Job, error)
+	NewUpdateSiocInfoParamsFunc                 func(iopsnotifythreshold int, limitiopspergb int, sharespergb int, storageid string, zoneid string) *cloudstack.UpdateSiocInfoParams
+	UpdateSiocInfoFunc                          func(p *cloudstack.UpdateSiocInfoParams) (*cloudstack.UpdateSiocInfoResponse, error)
+	UpdateSiocInfoWithContextFunc               func(ctx context.Context, p *cloudstack.UpdateSiocInfoParams) (*cloudstack.UpdateSiocInfoResponse, error)
+	NewUpdateStoragePoolParamsFunc              func(id string) *cloudstack.UpdateStoragePoolParams
+	UpdateStoragePoolFunc                       func(p *cloudstack.UpdateStoragePoolParams) (*cloudstack.UpdateStoragePoolResponse, error)
+	UpdateStoragePoolWithContextFunc            func(ctx context.Context, p *cloudstack.UpdateStoragePoolParams) (*cloudstack.UpdateStoragePoolResponse, error)
+}
+
+var _ cloudstack.PoolServiceIface = (*MockPoolService)(nil)
+
+// NewCreateStoragePoolParams records the call and calls NewCreateStoragePoolParamsFunc
+func (m *MockPoolService) NewCreateStoragePoolParams(name string, url string, zoneid string) (r0 *cloudstack.CreateStoragePoolParams) {
+	m.record("NewCreateStoragePoolParams", name, url, zoneid)
+	if m.NewCreateStoragePoolParamsFunc != nil {
+		return m.NewCreateStoragePoolParamsFunc(name, url, zoneid)
+	}
+	return cloudstack.NewPoolService(nil).NewCreateStoragePoolParams(name, url, zoneid)
+}
+
+// CreateStoragePool records the call and calls CreateStoragePoolFunc, or CreateStoragePoolWithContextFunc if only that is set
+func (m *MockPoolService) CreateStoragePool(p *cloudstack.CreateStoragePoolParams) (r0 *cloudstack.CreateStoragePoolResponse, r1 error) {
+	m.record("CreateStoragePool", p)
+	if m.CreateStoragePoolFunc != nil {
+		return m.CreateStoragePoolFunc(p)
+	}
+	if m.CreateStoragePoolWithContextFunc != nil {
+		return m.CreateStoragePoolWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PoolService.CreateStoragePool")
+	return
+}
+
+// CreateStoragePoolWithContext records the call and calls CreateStoragePoolWithContextFunc, or CreateStoragePoolFunc if only that is set
+func (m *MockPoolService) CreateStoragePoolWithContext(ctx context.Context, p *cloudstack.CreateStoragePoolParams) (r0 *cloudstack.CreateStoragePoolResponse, r1 error) {
+	m.record("CreateStoragePoolWithContext", ctx, p)
+	if m.CreateStoragePoolWithContextFunc != nil {
+		return m.CreateStoragePoolWithContextFunc(ctx, p)
+	}
+	if m.CreateStoragePoolFunc != nil {
+		return m.CreateStoragePoolFunc(p)
+	}
+	r1 = notMocked("PoolService.CreateStoragePoolWithContext")
+	return
+}
+
+// NewDeleteStoragePoolParams records the call and calls NewDeleteStoragePoolParamsFunc
+func (m *MockPoolService) NewDeleteStoragePoolParams(id string) (r0 *cloudstack.DeleteStoragePoolParams) {
+	m.record("NewDeleteStoragePoolParams", id)
+	if m.NewDeleteStoragePoolParamsFunc != nil {
+		return m.NewDeleteStoragePoolParamsFunc(id)
+	}
+	return cloudstack.NewPoolService(nil).NewDeleteStoragePoolParams(id)
+}
+
+// DeleteStoragePool records the call and calls DeleteStoragePoolFunc, or DeleteStoragePoolWithContextFunc if only that is set
+func (m *MockPoolService) DeleteStoragePool(p *cloudstack.DeleteStoragePoolParams) (r0 *cloudstack.DeleteStoragePoolResponse, r1 error) {
+	m.record("DeleteStoragePool", p)
+	if m.DeleteStoragePoolFunc != nil {
+		return m.DeleteStoragePoolFunc(p)
+	}
+	if m.DeleteStoragePoolWithContextFunc != nil {
+		return m.DeleteStoragePoolWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PoolService.DeleteStoragePool")
+	return
+}
+
+// DeleteStoragePoolWithContext records the call and calls DeleteStoragePoolWithContextFunc, or DeleteStoragePoolFunc if only that is set
+func (m *MockPoolService) DeleteStoragePoolWithContext(ctx context.Context, p *cloudstack.DeleteStoragePoolParams) (r0 *cloudstack.DeleteStoragePoolResponse, r1 error) {
+	m.record("DeleteStoragePoolWithContext", ctx, p)
+	if m.DeleteStoragePoolWithContextFunc != nil {
+		return m.DeleteStoragePoolWithContextFunc(ctx, p)
+	}
+	if m.DeleteStoragePoolFunc != nil {
+		return m.DeleteStoragePoolFunc(p)
+	}
+	r1 = notMocked("PoolService.DeleteStoragePoolWithContext")
+	return
+}
+
+// NewFindStoragePoolsForMigrationParams records the call and calls NewFindStoragePoolsForMigrationParamsFunc
+func (m *MockPoolService) NewFindStoragePoolsForMigrationParams(id string) (r0 *cloudstack.FindStoragePoolsForMigrationParams) {
+	m.record("NewFindStoragePoolsForMigrationParams", id)
+	if m.NewFindStoragePoolsForMigrationParamsFunc != nil {
+		return m.NewFindStoragePoolsForMigrationParamsFunc(id)
+	}
+	return cloudstack.NewPoolService(nil).NewFindStoragePoolsForMigrationParams(id)
+}
+
+// FindStoragePoolsForMigration records the call and calls FindStoragePoolsForMigrationFunc, or FindStoragePoolsForMigrationWithContextFunc if only that is set
+func (m *MockPoolService) FindStoragePoolsForMigration(p *cloudstack.FindStoragePoolsForMigrationParams) (r0 *cloudstack.FindStoragePoolsForMigrationResponse, r1 error) {
+	m.record("FindStoragePoolsForMigration", p)
+	if m.FindStoragePoolsForMigrationFunc != nil {
+		return m.FindStoragePoolsForMigrationFunc(p)
+	}
+	if m.FindStoragePoolsForMigrationWithContextFunc != nil {
+		return m.FindStoragePoolsForMigrationWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PoolService.FindStoragePoolsForMigration")
+	return
+}
+
+// FindStoragePoolsForMigrationWithContext records the call and calls FindStoragePoolsForMigrationWithContextFunc, or FindStoragePoolsForMigrationFunc if only that is set
+func (m *MockPoolService) FindStoragePoolsForMigrationWithContext(ctx context.Context, p *cloudstack.FindStoragePoolsForMigrationParams) (r0 *cloudstack.FindStoragePoolsForMigrationResponse, r1 error) {
+	m.record("FindStoragePoolsForMigrationWithContext", ctx, p)
+	if m.FindStoragePoolsForMigrationWithContextFunc != nil {
+		return m.FindStoragePoolsForMigrationWithContextFunc(ctx, p)
+	}
+	if m.FindStoragePoolsForMigrationFunc != nil {
+		return m.FindStoragePoolsForMigrationFunc(p)
+	}
+	r1 = notMocked("PoolService.FindStoragePoolsForMigrationWithContext")
+	return
+}
+
+// NewListElastistorInterfaceParams records the call and calls NewListElastistorInterfaceParamsFunc
+func (m *MockPoolService) NewListElastistorInterfaceParams() (r0 *cloudstack.ListElastistorInterfaceParams) {
+	m.record("NewListElastistorInterfaceParams")
+	if m.NewListElastistorInterfaceParamsFunc != nil {
+		return m.NewListElastistorInterfaceParamsFunc()
+	}
+	return cloudstack.NewPoolService(nil).NewListElastistorInterfaceParams()
+}
+
+// ListElastistorInterface records the call and calls ListElastistorInterfaceFunc, or ListElastistorInterfaceWithContextFunc if only that is set
+func (m *MockPoolService) ListElastistorInterface(p *cloudstack.ListElastistorInterfaceParams) (r0 *cloudstack.ListElastistorInterfaceResponse, r1 error) {
+	m.record("ListElastistorInterface", p)
+	if m.ListElastistorInterfaceFunc != nil {
+		return m.ListElastistorInterfaceFunc(p)
+	}
+	if m.ListElastistorInterfaceWithContextFunc != nil {
+		return m.ListElastistorInterfaceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PoolService.ListElastistorInterface")
+	return
+}
+
+// ListElastistorInterfaceWithContext records the call and calls ListElastistorInterfaceWithContextFunc, or ListElastistorInterfaceFunc if only that is set
+func (m *MockPoolService) ListElastistorInterfaceWithContext(ctx context.Context, p *cloudstack.ListElastistorInterfaceParams) (r0 *cloudstack.ListElastistorInterfaceResponse, r1 error) {
+	m.record("ListElastistorInterfaceWithContext", ctx, p)
+	if m.ListElastistorInterfaceWithContextFunc != nil {
+		return m.ListElastistorInterfaceWithContextFunc(ctx, p)
+	}
+	if m.ListElastistorInterfaceFunc != nil {
+		return m.ListElastistorInterfaceFunc(p)
+	}
+	r1 = notMocked("PoolService.ListElastistorInterfaceWithContext")
+	return
+}
+
+// NewListElastistorPoolParams records the call and calls NewListElastistorPoolParamsFunc
+func (m *MockPoolService) NewListElastistorPoolParams() (r0 *cloudstack.ListElastistorPoolParams) {
+	m.record("NewListElastistorPoolParams")
+	if m.NewListElastistorPoolParamsFunc != nil {
+		return m.NewListElastistorPoolParamsFunc()
+	}
+	return cloudstack.NewPoolService(nil).NewListElastistorPoolParams()
+}
+
+// ListElastistorPool records the call and calls ListElastistorPoolFunc, or ListElastistorPoolWithContextFunc if only that is set
+func (m *MockPoolService) ListElastistorPool(p *cloudstack.ListElastistorPoolParams) (r0 *cloudstack.ListElastistorPoolResponse, r1 error) {
+	m.record("ListElastistorPool", p)
+	if m.ListElastistorPoolFunc != nil {
+		return m.ListElastistorPoolFunc(p)
+	}
+	if m.ListElastistorPoolWithContextFunc != nil {
+		return m.ListElastistorPoolWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PoolService.ListElastistorPool")
+	return
+}
+
+// ListElastistorPoolWithContext records the call and calls ListElastistorPoolWithContextFunc, or ListElastistorPoolFunc if only that is set
+func (m *MockPoolService) ListElastistorPoolWithContext(ctx context.Context, p *cloudstack.ListElastistorPoolParams) (r0 *cloudstack.ListElastistorPoolResponse, r1 error) {
+	m.record("ListElastistorPoolWithContext", ctx, p)
+	if m.ListElastistorPoolWithContextFunc != nil {
+		return m.ListElastistorPoolWithContextFunc(ctx, p)
+	}
+	if m.ListElastistorPoolFunc != nil {
+		return m.ListElastistorPoolFunc(p)
+	}
+	r1 = notMocked("PoolService.ListElastistorPoolWithContext")
+	return
+}
+
+// NewListElastistorVolumeParams records the call and calls NewListElastistorVolumeParamsFunc
+func (m *MockPoolService) NewListElastistorVolumeParams(id string) (r0 *cloudstack.ListElastistorVolumeParams) {
+	m.record("NewListElastistorVolumeParams", id)
+	if m.NewListElastistorVolumeParamsFunc != nil {
+		return m.NewListElastistorVolumeParamsFunc(id)
+	}
+	return cloudstack.NewPoolService(nil).NewListElastistorVolumeParams(id)
+}
+
+// GetElastistorVolumeByID records the call and calls GetElastistorVolumeByIDFunc, or GetElastistorVolumeByIDWithContextFunc if only that is set
+func (m *MockPoolService) GetElastistorVolumeByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ElastistorVolume, r1 int, r2 error) {
+	m.record("GetElastistorVolumeByID", id, opts)
+	if m.GetElastistorVolumeByIDFunc != nil {
+		return m.GetElastistorVolumeByIDFunc(id, opts...)
+	}
+	if m.GetElastistorVolumeByIDWithContextFunc != nil {
+		return m.GetElastistorVolumeByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("PoolService.GetElastistorVolumeByID")
+	return
+}
+
+// GetElastistorVolumeByIDWithContext records the call and calls GetElastistorVolumeByIDWithContextFunc, or GetElastistorVolumeByIDFunc if only that is set
+func (m *MockPoolService) GetElastistorVolumeByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ElastistorVolume, r1 int, r2 error) {
+	m.record("GetElastistorVolumeByIDWithContext", ctx, id, opts)
+	if m.GetElastistorVolumeByIDWithContextFunc != nil {
+		return m.GetElastistorVolumeByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetElastistorVolumeByIDFunc != nil {
+		return m.GetElastistorVolumeByIDFunc(id, opts...)
+	}
+	r2 = notMocked("PoolService.GetElastistorVolumeByIDWithContext")
+	return
+}
+
+// ListElastistorVolume records the call and calls ListElastistorVolumeFunc, or ListElastistorVolumeWithContextFunc if only that is set
+func (m *MockPoolService) ListElastistorVolume(p *cloudstack.ListElastistorVolumeParams) (r0 *cloudstack.ListElastistorVolumeResponse, r1 error) {
+	m.record("ListElastistorVolume", p)
+	if m.ListElastistorVolumeFunc != nil {
+		return m.ListElastistorVolumeFunc(p)
+	}
+	if m.ListElastistorVolumeWithContextFunc != nil {
+		return m.ListElastistorVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PoolService.ListElastistorVolume")
+	return
+}
+
+// ListElastistorVolumeWithContext records the call and calls ListElastistorVolumeWithContextFunc, or ListElastistorVolumeFunc if only that is set
+func (m *MockPoolService) ListElastistorVolumeWithContext(ctx context.Context, p *cloudstack.ListElastistorVolumeParams) (r0 *cloudstack.ListElastistorVolumeResponse, r1 error) {
+	m.record("ListElastistorVolumeWithContext", ctx, p)
+	if m.ListElastistorVolumeWithContextFunc != nil {
+		return m.ListElastistorVolumeWithContextFunc(ctx, p)
+	}
+	if m.ListElastistorVolumeFunc != nil {
+		return m.ListElastistorVolumeFunc(p)
+	}
+	r1 = notMocked("PoolService.ListElastistorVolumeWithContext")
+	return
+}
+
+// NewListStoragePoolsParams records the call and calls NewListStoragePoolsParamsFunc
+func (m *MockPoolService) NewListStoragePoolsParams() (r0 *cloudstack.ListStoragePoolsParams) {
+	m.record("NewListStoragePoolsParams")
+	if m.NewListStoragePoolsParamsFunc != nil {
+		return m.NewListStoragePoolsParamsFunc()
+	}
+	return cloudstack.NewPoolService(nil).NewListStoragePoolsParams()
+}
+
+// GetStoragePoolID records the call and calls GetStoragePoolIDFunc, or GetStoragePoolIDWithContextFunc if only that is set
+func (m *MockPoolService) GetStoragePoolID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetStoragePoolID", name, opts)
+	if m.GetStoragePoolIDFunc != nil {
+		return m.GetStoragePoolIDFunc(name, opts...)
+	}
+	if m.GetStoragePoolIDWithContextFunc != nil {
+		return m.GetStoragePoolIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("PoolService.GetStoragePoolID")
+	return
+}
+
+// GetStoragePoolIDWithContext records the call and calls GetStoragePoolIDWithContextFunc, or GetStoragePoolIDFunc if only that is set
+func (m *MockPoolService) GetStoragePoolIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetStoragePoolIDWithContext", ctx, name, opts)
+	if m.GetStoragePoolIDWithContextFunc != nil {
+		return m.GetStoragePoolIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetStoragePoolIDFunc != nil {
+		return m.GetStoragePoolIDFunc(name, opts...)
+	}
+	r2 = notMocked("PoolService.GetStoragePoolIDWithContext")
+	return
+}
+
+// GetStoragePoolByName records the call and calls GetStoragePoolByNameFunc, or GetStoragePoolByNameWithContextFunc if only that is set
+func (m *MockPoolService) GetStoragePoolByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.StoragePool, r1 int, r2 error) {
+	m.record("GetStoragePoolByName", name, opts)
+	if m.GetStoragePoolByNameFunc != nil {
+		return m.GetStoragePoolByNameFunc(name, opts...)
+	}
+	if m.GetStoragePoolByNameWithContextFunc != nil {
+		return m.GetStoragePoolByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("PoolService.GetStoragePoolByName")
+	return
+}
+
+// GetStoragePoolByNameWithContext records the call and calls GetStoragePoolByNameWithContextFunc, or GetStoragePoolByNameFunc if only that is set
+func (m *MockPoolService) GetStoragePoolByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.StoragePool, r1 int, r2 error) {
+	m.record("GetStoragePoolByNameWithContext", ctx, name, opts)
+	if m.GetStoragePoolByNameWithContextFunc != nil {
+		return m.GetStoragePoolByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetStoragePoolByNameFunc != nil {
+		return m.GetStoragePoolByNameFunc(name, opts...)
+	}
+	r2 = notMocked("PoolService.GetStoragePoolByNameWithContext")
+	return
+}
+
+// GetStoragePoolByID records the call and calls GetStoragePoolByIDFunc, or GetStoragePoolByIDWithContextFunc if only that is set
+func (m *MockPoolService) GetStoragePoolByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.StoragePool, r1 int, r2 error) {
+	m.record("GetStoragePoolByID", id, opts)
+	if m.GetStoragePoolByIDFunc != nil {
+		return m.GetStoragePoolByIDFunc(id, opts...)
+	}
+	if m.GetStoragePoolByIDWithContextFunc != nil {
+		return m.GetStoragePoolByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("PoolService.GetStoragePoolByID")
+	return
+}
+
+// GetStoragePoolByIDWithContext records the call and calls GetStoragePoolByIDWithContextFunc, or GetStoragePoolByIDFunc if only that is set
+func (m *MockPoolService) GetStoragePoolByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.StoragePool, r1 int, r2 error) {
+	m.record("GetStoragePoolByIDWithContext", ctx, id, opts)
+	if m.GetStoragePoolByIDWithContextFunc != nil {
+		return m.GetStoragePoolByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetStoragePoolByIDFunc != nil {
+		return m.GetStoragePoolByIDFunc(id, opts...)
+	}
+	r2 = notMocked("PoolService.GetStoragePoolByIDWithContext")
+	return
+}
+
+// ListStoragePools records the call and calls ListStoragePoolsFunc, or ListStoragePoolsWithContextFunc if only that is set
+func (m *MockPoolService) ListStoragePools(p *cloudstack.ListStoragePoolsParams) (r0 *cloudstack.ListStoragePoolsResponse, r1 error) {
+	m.record("ListStoragePools", p)
+	if m.ListStoragePoolsFunc != nil {
+		return m.ListStoragePoolsFunc(p)
+	}
+	if m.ListStoragePoolsWithContextFunc != nil {
+		return m.ListStoragePoolsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PoolService.ListStoragePools")
+	return
+}
+
+// ListStoragePoolsWithContext records the call and calls ListStoragePoolsWithContextFunc, or ListStoragePoolsFunc if only that is set
+func (m *MockPoolService) ListStoragePoolsWithContext(ctx context.Context, p *cloudstack.ListStoragePoolsParams) (r0 *cloudstack.ListStoragePoolsResponse, r1 error) {
+	m.record("ListStoragePoolsWithContext", ctx, p)
+	if m.ListStoragePoolsWithContextFunc != nil {
+		return m.ListStoragePoolsWithContextFunc(ctx, p)
+	}
+	if m.ListStoragePoolsFunc != nil {
+		return m.ListStoragePoolsFunc(p)
+	}
+	r1 = notMocked("PoolService.ListStoragePoolsWithContext")
+	return
+}
+
+// NewListStoragePoolsPager records the call and calls NewListStoragePoolsPagerFunc
+func (m *MockPoolService) NewListStoragePoolsPager(p *cloudstack.ListStoragePoolsParams) (r0 *cloudstack.ListStoragePoolsPager) {
+	m.record("NewListStoragePoolsPager", p)
+	if m.NewListStoragePoolsPagerFunc != nil {
+		return m.NewListStoragePoolsPagerFunc(p)
+	}
+	return
+}
+
+// ListStoragePoolsAll records the call and calls ListStoragePoolsAllFunc, or ListStoragePoolsAllWithContextFunc if only that is set
+func (m *MockPoolService) ListStoragePoolsAll(p *cloudstack.ListStoragePoolsParams) (r0 *cloudstack.ListStoragePoolsResponse, r1 error) {
+	m.record("ListStoragePoolsAll", p)
+	if m.ListStoragePoolsAllFunc != nil {
+		return m.ListStoragePoolsAllFunc(p)
+	}
+	if m.ListStoragePoolsAllWithContextFunc != nil {
+		return m.ListStoragePoolsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PoolService.ListStoragePoolsAll")
+	return
+}
+
+// ListStoragePoolsAllWithContext records the call and calls ListStoragePoolsAllWithContextFunc, or ListStoragePoolsAllFunc if only that is set
+func (m *MockPoolService) ListStoragePoolsAllWithContext(ctx context.Context, p *cloudstack.ListStoragePoolsParams) (r0 *cloudstack.ListStoragePoolsResponse, r1 error) {
+	m.record("ListStoragePoolsAllWithContext", ctx, p)
+	if m.ListStoragePoolsAllWithContextFunc != nil {
+		return m.ListStoragePoolsAllWithContextFunc(ctx, p)
+	}
+	if m.ListStoragePoolsAllFunc != nil {
+		return m.ListStoragePoolsAllFunc(p)
+	}
+	r1 = notMocked("PoolService.ListStoragePoolsAllWithContext")
+	return
+}
+
+// NewListStoragePoolsMetricsParams records the call and calls NewListStoragePoolsMetricsParamsFunc
+func (m *MockPoolService) NewListStoragePoolsMetricsParams() (r0 *cloudstack.ListStoragePoolsMetricsParams) {
+	m.record("NewListStoragePoolsMetricsParams")
+	if m.NewListStoragePoolsMetricsParamsFunc != nil {
+		return m.NewListStoragePoolsMetricsParamsFunc()
+	}
+	return cloudstack.NewPoolService(nil).NewListStoragePoolsMetricsParams()
+}
+
+// GetStoragePoolsMetricID records the call and calls GetStoragePoolsMetricIDFunc, or GetStoragePoolsMetricIDWithContextFunc if only that is set
+func (m *MockPoolService) GetStoragePoolsMetricID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetStoragePoolsMetricID", name, opts)
+	if m.GetStoragePoolsMetricIDFunc != nil {
+		return m.GetStoragePoolsMetricIDFunc(name, opts...)
+	}
+	if m.GetStoragePoolsMetricIDWithContextFunc != nil {
+		return m.GetStoragePoolsMetricIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("PoolService.GetStoragePoolsMetricID")
+	return
+}
+
+// GetStoragePoolsMetricIDWithContext records the call and calls GetStoragePoolsMetricIDWithContextFunc, or GetStoragePoolsMetricIDFunc if only that is set
+func (m *MockPoolService) GetStoragePoolsMetricIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetStoragePoolsMetricIDWithContext", ctx, name, opts)
+	if m.GetStoragePoolsMetricIDWithContextFunc != nil {
+		return m.GetStoragePoolsMetricIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetStoragePoolsMetricIDFunc != nil {
+		return m.GetStoragePoolsMetricIDFunc(name, opts...)
+	}
+	r2 = notMocked("PoolService.GetStoragePoolsMetricIDWithContext")
+	return
+}
+
+// GetStoragePoolsMetricByName records the call and calls GetStoragePoolsMetricByNameFunc, or GetStoragePoolsMetricByNameWithContextFunc if only that is set
+func (m *MockPoolService) GetStoragePoolsMetricByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.StoragePoolsMetric, r1 int, r2 error) {
+	m.record("GetStoragePoolsMetricByName", name, opts)
+	if m.GetStoragePoolsMetricByNameFunc != nil {
+		return m.GetStoragePoolsMetricByNameFunc(name, opts...)
+	}
+	if m.GetStoragePoolsMetricByNameWithContextFunc != nil {
+		return m.GetStoragePoolsMetricByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("PoolService.GetStoragePoolsMetricByName")
+	return
+}
+
+// GetStoragePoolsMetricByNameWithContext records the call and calls GetStoragePoolsMetricByNameWithContextFunc, or GetStoragePoolsMetricByNameFunc if only that is set
+func (m *MockPoolService) GetStoragePoolsMetricByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.StoragePoolsMetric, r1 int, r2 error) {
+	m.record("GetStoragePoolsMetricByNameWithContext", ctx, name, opts)
+	if m.GetStoragePoolsMetricByNameWithContextFunc != nil {
+		return m.GetStoragePoolsMetricByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetStoragePoolsMetricByNameFunc != nil {
+		return m.GetStoragePoolsMetricByNameFunc(name, opts...)
+	}
+	r2 = notMocked("PoolService.GetStoragePoolsMetricByNameWithContext")
+	return
+}
+
+// GetStoragePoolsMetricByID records the call and calls GetStoragePoolsMetricByIDFunc, or GetStoragePoolsMetricByIDWithContextFunc if only that is set
+func (m *MockPoolService) GetStoragePoolsMetricByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.StoragePoolsMetric, r1 int, r2 error) {
+	m.record("GetStoragePoolsMetricByID", id, opts)
+	if m.GetStoragePoolsMetricByIDFunc != nil {
+		return m.GetStoragePoolsMetricByIDFunc(id, opts...)
+	}
+	if m.GetStoragePoolsMetricByIDWithContextFunc != nil {
+		return m.GetStoragePoolsMetricByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("PoolService.GetStoragePoolsMetricByID")
+	return
+}
+
+// GetStoragePoolsMetricByIDWithContext records the call and calls GetStoragePoolsMetricByIDWithContextFunc, or GetStoragePoolsMetricByIDFunc if only that is set
+func (m *MockPoolService) GetStoragePoolsMetricByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.StoragePoolsMetric, r1 int, r2 error) {
+	m.record("GetStoragePoolsMetricByIDWithContext", ctx, id, opts)
+	if m.GetStoragePoolsMetricByIDWithContextFunc != nil {
+		return m.GetStoragePoolsMetricByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetStoragePoolsMetricByIDFunc != nil {
+		return m.GetStoragePoolsMetricByIDFunc(id, opts...)
+	}
+	r2 = notMocked("PoolService.GetStoragePoolsMetricByIDWithContext")
+	return
+}
+
+// ListStoragePoolsMetrics records the call and calls ListStoragePoolsMetricsFunc, or ListStoragePoolsMetricsWithContextFunc if only that is set
+func (m *MockPoolService) ListStoragePoolsMetrics(p *cloudstack.ListStoragePoolsMetricsParams) (r0 *cloudstack.ListStoragePoolsMetricsResponse, r1 error) {
+	m.record("ListStoragePoolsMetrics", p)
+	if m.ListStoragePoolsMetricsFunc != nil {
+		return m.ListStoragePoolsMetricsFunc(p)
+	}
+	if m.ListStoragePoolsMetricsWithContextFunc != nil {
+		return m.ListStoragePoolsMetricsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PoolService.ListStoragePoolsMetrics")
+	return
+}
+
+// ListStoragePoolsMetricsWithContext records the call and calls ListStoragePoolsMetricsWithContextFunc, or ListStoragePoolsMetricsFunc if only that is set
+func (m *MockPoolService) ListStoragePoolsMetricsWithContext(ctx context.Context, p *cloudstack.ListStoragePoolsMetricsParams) (r0 *cloudstack.ListStoragePoolsMetricsResponse, r1 error) {
+	m.record("ListStoragePoolsMetricsWithContext", ctx, p)
+	if m.ListStoragePoolsMetricsWithContextFunc != nil {
+		return m.ListStoragePoolsMetricsWithContextFunc(ctx, p)
+	}
+	if m.ListStoragePoolsMetricsFunc != nil {
+		return m.ListStoragePoolsMetricsFunc(p)
+	}
+	r1 = notMocked("PoolService.ListStoragePoolsMetricsWithContext")
+	return
+}
+
+// NewListStoragePoolsMetricsPager records the call and calls NewListStoragePoolsMetricsPagerFunc
+func (m *MockPoolService) NewListStoragePoolsMetricsPager(p *cloudstack.ListStoragePoolsMetricsParams) (r0 *cloudstack.ListStoragePoolsMetricsPager) {
+	m.record("NewListStoragePoolsMetricsPager", p)
+	if m.NewListStoragePoolsMetricsPagerFunc != nil {
+		return m.NewListStoragePoolsMetricsPagerFunc(p)
+	}
+	return
+}
+
+// ListStoragePoolsMetricsAll records the call and calls ListStoragePoolsMetricsAllFunc, or ListStoragePoolsMetricsAllWithContextFunc if only that is set
+func (m *MockPoolService) ListStoragePoolsMetricsAll(p *cloudstack.ListStoragePoolsMetricsParams) (r0 *cloudstack.ListStoragePoolsMetricsResponse, r1 error) {
+	m.record("ListStoragePoolsMetricsAll", p)
+	if m.ListStoragePoolsMetricsAllFunc != nil {
+		return m.ListStoragePoolsMetricsAllFunc(p)
+	}
+	if m.ListStoragePoolsMetricsAllWithContextFunc != nil {
+		return m.ListStoragePoolsMetricsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PoolService.ListStoragePoolsMetricsAll")
+	return
+}
+
+// ListStoragePoolsMetricsAllWithContext records the call and calls ListStoragePoolsMetricsAllWithContextFunc, or ListStoragePoolsMetricsAllFunc if only that is set
+func (m *MockPoolService) ListStoragePoolsMetricsAllWithContext(ctx context.Context, p *cloudstack.ListStoragePoolsMetricsParams) (r0 *cloudstack.ListStoragePoolsMetricsResponse, r1 error) {
+	m.record("ListStoragePoolsMetricsAllWithContext", ctx, p)
+	if m.ListStoragePoolsMetricsAllWithContextFunc != nil {
+		return m.ListStoragePoolsMetricsAllWithContextFunc(ctx, p)
+	}
+	if m.ListStoragePoolsMetricsAllFunc != nil {
+		return m.ListStoragePoolsMetricsAllFunc(p)
+	}
+	r1 = notMocked("PoolService.ListStoragePoolsMetricsAllWithContext")
+	return
+}
+
+// NewSyncStoragePoolParams records the call and calls NewSyncStoragePoolParamsFunc
+func (m *MockPoolService) NewSyncStoragePoolParams(id string) (r0 *cloudstack.SyncStoragePoolParams) {
+	m.record("NewSyncStoragePoolParams", id)
+	if m.NewSyncStoragePoolParamsFunc != nil {
+		return m.NewSyncStoragePoolParamsFunc(id)
+	}
+	return cloudstack.NewPoolService(nil).NewSyncStoragePoolParams(id)
+}
+
+// SyncStoragePool records the call and calls SyncStoragePoolFunc, or SyncStoragePoolWithContextFunc if only that is set
+func (m *MockPoolService) SyncStoragePool(p *cloudstack.SyncStoragePoolParams) (r0 *cloudstack.SyncStoragePoolResponse, r1 error) {
+	m.record("SyncStoragePool", p)
+	if m.SyncStoragePoolFunc != nil {
+		return m.SyncStoragePoolFunc(p)
+	}
+	if m.SyncStoragePoolWithContextFunc != nil {
+		return m.SyncStoragePoolWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PoolService.SyncStoragePool")
+	return
+}
+
+// SyncStoragePoolWithContext records the call and calls SyncStoragePoolWithContextFunc, or SyncStoragePoolFunc if only that is set
+func (m *MockPoolService) SyncStoragePoolWithContext(ctx context.Context, p *cloudstack.SyncStoragePoolParams) (r0 *cloudstack.SyncStoragePoolResponse, r1 error) {
+	m.record("SyncStoragePoolWithContext", ctx, p)
+	if m.SyncStoragePoolWithContextFunc != nil {
+		return m.SyncStoragePoolWithContextFunc(ctx, p)
+	}
+	if m.SyncStoragePoolFunc != nil {
+		return m.SyncStoragePoolFunc(p)
+	}
+	r1 = notMocked("PoolService.SyncStoragePoolWithContext")
+	return
+}
+
+// SyncStoragePoolAsync records the call and calls SyncStoragePoolAsyncFunc, or SyncStoragePoolAsyncWithContextFunc if only that is set
+func (m *MockPoolService) SyncStoragePoolAsync(p *cloudstack.SyncStoragePoolParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("SyncStoragePoolAsync", p)
+	if m.SyncStoragePoolAsyncFunc != nil {
+		return m.SyncStoragePoolAsyncFunc(p)
+	}
+	if m.SyncStoragePoolAsyncWithContextFunc != nil {
+		return m.SyncStoragePoolAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PoolService.SyncStoragePoolAsync")
+	return
+}
+
+// SyncStoragePoolAsyncWithContext records the call and calls SyncStoragePoolAsyncWithContextFunc, or SyncStoragePoolAsyncFunc if only that is set
+func (m *MockPoolService) SyncStoragePoolAsyncWithContext(ctx context.Context, p *cloudstack.SyncStoragePoolParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("SyncStoragePoolAsyncWithContext", ctx, p)
+	if m.SyncStoragePoolAsyncWithContextFunc != nil {
+		return m.SyncStoragePoolAsyncWithContextFunc(ctx, p)
+	}
+	if m.SyncStoragePoolAsyncFunc != nil {
+		return m.SyncStoragePoolAsyncFunc(p)
+	}
+	r1 = notMocked("PoolService.SyncStoragePoolAsyncWithContext")
+	return
+}
+
+// NewUpdateSiocInfoParams records the call and calls NewUpdateSiocInfoParamsFunc
+func (m *MockPoolService) NewUpdateSiocInfoParams(iopsnotifythreshold int, limitiopspergb int, sharespergb int, storageid string, zoneid string) (r0 *cloudstack.UpdateSiocInfoParams) {
+	m.record("NewUpdateSiocInfoParams", iopsnotifythreshold, limitiopspergb, sharespergb, storageid, zoneid)
+	if m.NewUpdateSiocInfoParamsFunc != nil {
+		return m.NewUpdateSiocInfoParamsFunc(iopsnotifythreshold, limitiopspergb, sharespergb, storageid, zoneid)
+	}
+	return cloudstack.NewPoolService(nil).NewUpdateSiocInfoParams(iopsnotifythreshold, limitiopspergb, sharespergb, storageid, zoneid)
+}
+
+// UpdateSiocInfo records the call and calls UpdateSiocInfoFunc, or UpdateSiocInfoWithContextFunc if only that is set
+func (m *MockPoolService) UpdateSiocInfo(p *cloudstack.UpdateSiocInfoParams) (r0 *cloudstack.UpdateSiocInfoResponse, r1 error) {
+	m.record("UpdateSiocInfo", p)
+	if m.UpdateSiocInfoFunc != nil {
+		return m.UpdateSiocInfoFunc(p)
+	}
+	if m.UpdateSiocInfoWithContextFunc != nil {
+		return m.UpdateSiocInfoWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PoolService.UpdateSiocInfo")
+	return
+}
+
+// UpdateSiocInfoWithContext records the call and calls UpdateSiocInfoWithContextFunc, or UpdateSiocInfoFunc if only that is set
+func (m *MockPoolService) UpdateSiocInfoWithContext(ctx context.Context, p *cloudstack.UpdateSiocInfoParams) (r0 *cloudstack.UpdateSiocInfoResponse, r1 error) {
+	m.record("UpdateSiocInfoWithContext", ctx, p)
+	if m.UpdateSiocInfoWithContextFunc != nil {
+		return m.UpdateSiocInfoWithContextFunc(ctx, p)
+	}
+	if m.UpdateSiocInfoFunc != nil {
+		return m.UpdateSiocInfoFunc(p)
+	}
+	r1 = notMocked("PoolService.UpdateSiocInfoWithContext")
+	return
+}
+
+// NewUpdateStoragePoolParams records the call and calls NewUpdateStoragePoolParamsFunc
+func (m *MockPoolService) NewUpdateStoragePoolParams(id string) (r0 *cloudstack.UpdateStoragePoolParams) {
+	m.record("NewUpdateStoragePoolParams", id)
+	if m.NewUpdateStoragePoolParamsFunc != nil {
+		return m.NewUpdateStoragePoolParamsFunc(id)
+	}
+	return cloudstack.NewPoolService(nil).NewUpdateStoragePoolParams(id)
+}
+
+// UpdateStoragePool records the call and calls UpdateStoragePoolFunc, or UpdateStoragePoolWithContextFunc if only that is set
+func (m *MockPoolService) UpdateStoragePool(p *cloudstack.UpdateStoragePoolParams) (r0 *cloudstack.UpdateStoragePoolResponse, r1 error) {
+	m.record("UpdateStoragePool", p)
+	if m.UpdateStoragePoolFunc != nil {
+		return m.UpdateStoragePoolFunc(p)
+	}
+	if m.UpdateStoragePoolWithContextFunc != nil {
+		return m.UpdateStoragePoolWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PoolService.UpdateStoragePool")
+	return
+}
+
+// UpdateStoragePoolWithContext records the call and calls UpdateStoragePoolWithContextFunc, or UpdateStoragePoolFunc if only that is set
+func (m *MockPoolService) UpdateStoragePoolWithContext(ctx context.Context, p *cloudstack.UpdateStoragePoolParams) (r0 *cloudstack.UpdateStoragePoolResponse, r1 error) {
+	m.record("UpdateStoragePoolWithContext", ctx, p)
+	if m.UpdateStoragePoolWithContextFunc != nil {
+		return m.UpdateStoragePoolWithContextFunc(ctx, p)
+	}
+	if m.UpdateStoragePoolFunc != nil {
+		return m.UpdateStoragePoolFunc(p)
+	}
+	r1 = notMocked("PoolService.UpdateStoragePoolWithContext")
+	return
+}
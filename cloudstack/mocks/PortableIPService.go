@@ -0,0 +1,275 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockPortableIPService is a mock of cloudstack.PortableIPServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockPortableIPService struct {
+	Recorder
+
+	NewCreatePortableIpRangeParamsFunc        func(endip string, gateway string, netmask string, regionid int, startip string) *cloudstack.CreatePortableIpRangeParams
+	CreatePortableIpRangeFunc                 func(p *cloudstack.CreatePortableIpRangeParams) (*cloudstack.CreatePortableIpRangeResponse, error)
+	CreatePortableIpRangeWithContextFunc      func(ctx context.Context, p *cloudstack.CreatePortableIpRangeParams) (*cloudstack.CreatePortableIpRangeResponse, error)
+	CreatePortableIpRangeAsyncFunc            func(p *cloudstack.CreatePortableIpRangeParams) (*cloudstack.Job, error)
+	CreatePortableIpRangeAsyncWithContextFunc func(ctx context.Context, p *cloudstack.CreatePortableIpRangeParams) (*cloudstack.Job, error)
+	NewDeletePortableIpRangeParamsFunc        func(id string) *cloudstack.DeletePortableIpRangeParams
+	DeletePortableIpRangeFunc                 func(p *cloudstack.DeletePortableIpRangeParams) (*cloudstack.DeletePortableIpRangeResponse, error)
+	DeletePortableIpRangeWithContextFunc      func(ctx context.Context, p *cloudstack.DeletePortableIpRangeParams) (*cloudstack.DeletePortableIpRangeResponse, error)
+	DeletePortableIpRangeAsyncFunc            func(p *cloudstack.DeletePortableIpRangeParams) (*cloudstack.Job, error)
+	DeletePortableIpRangeAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DeletePortableIpRangeParams) (*cloudstack.Job, error)
+	NewListPortableIpRangesParamsFunc         func() *cloudstack.ListPortableIpRangesParams
+	GetPortableIpRangeByIDFunc                func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.PortableIpRange, int, error)
+	GetPortableIpRangeByIDWithContextFunc     func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.PortableIpRange, int, error)
+	ListPortableIpRangesFunc                  func(p *cloudstack.ListPortableIpRangesParams) (*cloudstack.ListPortableIpRangesResponse, error)
+	ListPortableIpRangesWithContextFunc       func(ctx context.Context, p *cloudstack.ListPortableIpRangesParams) (*cloudstack.ListPortableIpRangesResponse, error)
+	NewListPortableIpRangesPagerFunc          func(p *cloudstack.ListPortableIpRangesParams) *cloudstack.ListPortableIpRangesPager
+	ListPortableIpRangesAllFunc               func(p *cloudstack.ListPortableIpRangesParams) (*cloudstack.ListPortableIpRangesResponse, error)
+	ListPortableIpRangesAllWithContextFunc    func(ctx context.Context, p *cloudstack.ListPortableIpRangesParams) (*cloudstack.ListPortableIpRangesResponse, error)
+}
+
+var _ cloudstack.PortableIPServiceIface = (*MockPortableIPService)(nil)
+
+// NewCreatePortableIpRangeParams records the call and calls NewCreatePortableIpRangeParamsFunc
+func (m *MockPortableIPService) NewCreatePortableIpRangeParams(endip string, gateway string, netmask string, regionid int, startip string) (r0 *cloudstack.CreatePortableIpRangeParams) {
+	m.record("NewCreatePortableIpRangeParams", endip, gateway, netmask, regionid, startip)
+	if m.NewCreatePortableIpRangeParamsFunc != nil {
+		return m.NewCreatePortableIpRangeParamsFunc(endip, gateway, netmask, regionid, startip)
+	}
+	return cloudstack.NewPortableIPService(nil).NewCreatePortableIpRangeParams(endip, gateway, netmask, regionid, startip)
+}
+
+// CreatePortableIpRange records the call and calls CreatePortableIpRangeFunc, or CreatePortableIpRangeWithContextFunc if only that is set
+func (m *MockPortableIPService) CreatePortableIpRange(p *cloudstack.CreatePortableIpRangeParams) (r0 *cloudstack.CreatePortableIpRangeResponse, r1 error) {
+	m.record("CreatePortableIpRange", p)
+	if m.CreatePortableIpRangeFunc != nil {
+		return m.CreatePortableIpRangeFunc(p)
+	}
+	if m.CreatePortableIpRangeWithContextFunc != nil {
+		return m.CreatePortableIpRangeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PortableIPService.CreatePortableIpRange")
+	return
+}
+
+// CreatePortableIpRangeWithContext records the call and calls CreatePortableIpRangeWithContextFunc, or CreatePortableIpRangeFunc if only that is set
+func (m *MockPortableIPService) CreatePortableIpRangeWithContext(ctx context.Context, p *cloudstack.CreatePortableIpRangeParams) (r0 *cloudstack.CreatePortableIpRangeResponse, r1 error) {
+	m.record("CreatePortableIpRangeWithContext", ctx, p)
+	if m.CreatePortableIpRangeWithContextFunc != nil {
+		return m.CreatePortableIpRangeWithContextFunc(ctx, p)
+	}
+	if m.CreatePortableIpRangeFunc != nil {
+		return m.CreatePortableIpRangeFunc(p)
+	}
+	r1 = notMocked("PortableIPService.CreatePortableIpRangeWithContext")
+	return
+}
+
+// CreatePortableIpRangeAsync records the call and calls CreatePortableIpRangeAsyncFunc, or CreatePortableIpRangeAsyncWithContextFunc if only that is set
+func (m *MockPortableIPService) CreatePortableIpRangeAsync(p *cloudstack.CreatePortableIpRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreatePortableIpRangeAsync", p)
+	if m.CreatePortableIpRangeAsyncFunc != nil {
+		return m.CreatePortableIpRangeAsyncFunc(p)
+	}
+	if m.CreatePortableIpRangeAsyncWithContextFunc != nil {
+		return m.CreatePortableIpRangeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PortableIPService.CreatePortableIpRangeAsync")
+	return
+}
+
+// CreatePortableIpRangeAsyncWithContext records the call and calls CreatePortableIpRangeAsyncWithContextFunc, or CreatePortableIpRangeAsyncFunc if only that is set
+func (m *MockPortableIPService) CreatePortableIpRangeAsyncWithContext(ctx context.Context, p *cloudstack.CreatePortableIpRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreatePortableIpRangeAsyncWithContext", ctx, p)
+	if m.CreatePortableIpRangeAsyncWithContextFunc != nil {
+		return m.CreatePortableIpRangeAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreatePortableIpRangeAsyncFunc != nil {
+		return m.CreatePortableIpRangeAsyncFunc(p)
+	}
+	r1 = notMocked("PortableIPService.CreatePortableIpRangeAsyncWithContext")
+	return
+}
+
+// NewDeletePortableIpRangeParams records the call and calls NewDeletePortableIpRangeParamsFunc
+func (m *MockPortableIPService) NewDeletePortableIpRangeParams(id string) (r0 *cloudstack.DeletePortableIpRangeParams) {
+	m.record("NewDeletePortableIpRangeParams", id)
+	if m.NewDeletePortableIpRangeParamsFunc != nil {
+		return m.NewDeletePortableIpRangeParamsFunc(id)
+	}
+	return cloudstack.NewPortableIPService(nil).NewDeletePortableIpRangeParams(id)
+}
+
+// DeletePortableIpRange records the call and calls DeletePortableIpRangeFunc, or DeletePortableIpRangeWithContextFunc if only that is set
+func (m *MockPortableIPService) DeletePortableIpRange(p *cloudstack.DeletePortableIpRangeParams) (r0 *cloudstack.DeletePortableIpRangeResponse, r1 error) {
+	m.record("DeletePortableIpRange", p)
+	if m.DeletePortableIpRangeFunc != nil {
+		return m.DeletePortableIpRangeFunc(p)
+	}
+	if m.DeletePortableIpRangeWithContextFunc != nil {
+		return m.DeletePortableIpRangeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PortableIPService.DeletePortableIpRange")
+	return
+}
+
+// DeletePortableIpRangeWithContext records the call and calls DeletePortableIpRangeWithContextFunc, or DeletePortableIpRangeFunc if only that is set
+func (m *MockPortableIPService) DeletePortableIpRangeWithContext(ctx context.Context, p *cloudstack.DeletePortableIpRangeParams) (r0 *cloudstack.DeletePortableIpRangeResponse, r1 error) {
+	m.record("DeletePortableIpRangeWithContext", ctx, p)
+	if m.DeletePortableIpRangeWithContextFunc != nil {
+		return m.DeletePortableIpRangeWithContextFunc(ctx, p)
+	}
+	if m.DeletePortableIpRangeFunc != nil {
+		return m.DeletePortableIpRangeFunc(p)
+	}
+	r1 = notMocked("PortableIPService.DeletePortableIpRangeWithContext")
+	return
+}
+
+// DeletePortableIpRangeAsync records the call and calls DeletePortableIpRangeAsyncFunc, or DeletePortableIpRangeAsyncWithContextFunc if only that is set
+func (m *MockPortableIPService) DeletePortableIpRangeAsync(p *cloudstack.DeletePortableIpRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeletePortableIpRangeAsync", p)
+	if m.DeletePortableIpRangeAsyncFunc != nil {
+		return m.DeletePortableIpRangeAsyncFunc(p)
+	}
+	if m.DeletePortableIpRangeAsyncWithContextFunc != nil {
+		return m.DeletePortableIpRangeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PortableIPService.DeletePortableIpRangeAsync")
+	return
+}
+
+// DeletePortableIpRangeAsyncWithContext records the call and calls DeletePortableIpRangeAsyncWithContextFunc, or DeletePortableIpRangeAsyncFunc if only that is set
+func (m *MockPortableIPService) DeletePortableIpRangeAsyncWithContext(ctx context.Context, p *cloudstack.DeletePortableIpRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeletePortableIpRangeAsyncWithContext", ctx, p)
+	if m.DeletePortableIpRangeAsyncWithContextFunc != nil {
+		return m.DeletePortableIpRangeAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeletePortableIpRangeAsyncFunc != nil {
+		return m.DeletePortableIpRangeAsyncFunc(p)
+	}
+	r1 = notMocked("PortableIPService.DeletePortableIpRangeAsyncWithContext")
+	return
+}
+
+// NewListPortableIpRangesParams records the call and calls NewListPortableIpRangesParamsFunc
+func (m *MockPortableIPService) NewListPortableIpRangesParams() (r0 *cloudstack.ListPortableIpRangesParams) {
+	m.record("NewListPortableIpRangesParams")
+	if m.NewListPortableIpRangesParamsFunc != nil {
+		return m.NewListPortableIpRangesParamsFunc()
+	}
+	return cloudstack.NewPortableIPService(nil).NewListPortableIpRangesParams()
+}
+
+// GetPortableIpRangeByID records the call and calls GetPortableIpRangeByIDFunc, or GetPortableIpRangeByIDWithContextFunc if only that is set
+func (m *MockPortableIPService) GetPortableIpRangeByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.PortableIpRange, r1 int, r2 error) {
+	m.record("GetPortableIpRangeByID", id, opts)
+	if m.GetPortableIpRangeByIDFunc != nil {
+		return m.GetPortableIpRangeByIDFunc(id, opts...)
+	}
+	if m.GetPortableIpRangeByIDWithContextFunc != nil {
+		return m.GetPortableIpRangeByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("PortableIPService.GetPortableIpRangeByID")
+	return
+}
+
+// GetPortableIpRangeByIDWithContext records the call and calls GetPortableIpRangeByIDWithContextFunc, or GetPortableIpRangeByIDFunc if only that is set
+func (m *MockPortableIPService) GetPortableIpRangeByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.PortableIpRange, r1 int, r2 error) {
+	m.record("GetPortableIpRangeByIDWithContext", ctx, id, opts)
+	if m.GetPortableIpRangeByIDWithContextFunc != nil {
+		return m.GetPortableIpRangeByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetPortableIpRangeByIDFunc != nil {
+		return m.GetPortableIpRangeByIDFunc(id, opts...)
+	}
+	r2 = notMocked("PortableIPService.GetPortableIpRangeByIDWithContext")
+	return
+}
+
+// ListPortableIpRanges records the call and calls ListPortableIpRangesFunc, or ListPortableIpRangesWithContextFunc if only that is set
+func (m *MockPortableIPService) ListPortableIpRanges(p *cloudstack.ListPortableIpRangesParams) (r0 *cloudstack.ListPortableIpRangesResponse, r1 error) {
+	m.record("ListPortableIpRanges", p)
+	if m.ListPortableIpRangesFunc != nil {
+		return m.ListPortableIpRangesFunc(p)
+	}
+	if m.ListPortableIpRangesWithContextFunc != nil {
+		return m.ListPortableIpRangesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PortableIPService.ListPortableIpRanges")
+	return
+}
+
+// ListPortableIpRangesWithContext records the call and calls ListPortableIpRangesWithContextFunc, or ListPortableIpRangesFunc if only that is set
+func (m *MockPortableIPService) ListPortableIpRangesWithContext(ctx context.Context, p *cloudstack.ListPortableIpRangesParams) (r0 *cloudstack.ListPortableIpRangesResponse, r1 error) {
+	m.record("ListPortableIpRangesWithContext", ctx, p)
+	if m.ListPortableIpRangesWithContextFunc != nil {
+		return m.ListPortableIpRangesWithContextFunc(ctx, p)
+	}
+	if m.ListPortableIpRangesFunc != nil {
+		return m.ListPortableIpRangesFunc(p)
+	}
+	r1 = notMocked("PortableIPService.ListPortableIpRangesWithContext")
+	return
+}
+
+// NewListPortableIpRangesPager records the call and calls NewListPortableIpRangesPagerFunc
+func (m *MockPortableIPService) NewListPortableIpRangesPager(p *cloudstack.ListPortableIpRangesParams) (r0 *cloudstack.ListPortableIpRangesPager) {
+	m.record("NewListPortableIpRangesPager", p)
+	if m.NewListPortableIpRangesPagerFunc != nil {
+		return m.NewListPortableIpRangesPagerFunc(p)
+	}
+	return
+}
+
+// ListPortableIpRangesAll records the call and calls ListPortableIpRangesAllFunc, or ListPortableIpRangesAllWithContextFunc if only that is set
+func (m *MockPortableIPService) ListPortableIpRangesAll(p *cloudstack.ListPortableIpRangesParams) (r0 *cloudstack.ListPortableIpRangesResponse, r1 error) {
+	m.record("ListPortableIpRangesAll", p)
+	if m.ListPortableIpRangesAllFunc != nil {
+		return m.ListPortableIpRangesAllFunc(p)
+	}
+	if m.ListPortableIpRangesAllWithContextFunc != nil {
+		return m.ListPortableIpRangesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("PortableIPService.ListPortableIpRangesAll")
+	return
+}
+
+// ListPortableIpRangesAllWithContext records the call and calls ListPortableIpRangesAllWithContextFunc, or ListPortableIpRangesAllFunc if only that is set
+func (m *MockPortableIPService) ListPortableIpRangesAllWithContext(ctx context.Context, p *cloudstack.ListPortableIpRangesParams) (r0 *cloudstack.ListPortableIpRangesResponse, r1 error) {
+	m.record("ListPortableIpRangesAllWithContext", ctx, p)
+	if m.ListPortableIpRangesAllWithContextFunc != nil {
+		return m.ListPortableIpRangesAllWithContextFunc(ctx, p)
+	}
+	if m.ListPortableIpRangesAllFunc != nil {
+		return m.ListPortableIpRangesAllFunc(p)
+	}
+	r1 = notMocked("PortableIPService.ListPortableIpRangesAllWithContext")
+	return
+}
@@ -0,0 +1,1229 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockProjectService is a mock of cloudstack.ProjectServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockProjectService struct {
+	Recorder
+
+	NewActivateProjectParamsFunc                func(id string) *cloudstack.ActivateProjectParams
+	ActivateProjectFunc                         func(p *cloudstack.ActivateProjectParams) (*cloudstack.ActivateProjectResponse, error)
+	ActivateProjectWithContextFunc              func(ctx context.Context, p *cloudstack.ActivateProjectParams) (*cloudstack.ActivateProjectResponse, error)
+	ActivateProjectAsyncFunc                    func(p *cloudstack.ActivateProjectParams) (*cloudstack.Job, error)
+	ActivateProjectAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.ActivateProjectParams) (*cloudstack.Job, error)
+	NewAddUserToProjectParamsFunc               func(projectid string, username string) *cloudstack.AddUserToProjectParams
+	AddUserToProjectFunc                        func(p *cloudstack.AddUserToProjectParams) (*cloudstack.AddUserToProjectResponse, error)
+	AddUserToProjectWithContextFunc             func(ctx context.Context, p *cloudstack.AddUserToProjectParams) (*cloudstack.AddUserToProjectResponse, error)
+	AddUserToProjectAsyncFunc                   func(p *cloudstack.AddUserToProjectParams) (*cloudstack.Job, error)
+	AddUserToProjectAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.AddUserToProjectParams) (*cloudstack.Job, error)
+	NewCreateProjectParamsFunc                  func(displaytext string, name string) *cloudstack.CreateProjectParams
+	CreateProjectFunc                           func(p *cloudstack.CreateProjectParams) (*cloudstack.CreateProjectResponse, error)
+	CreateProjectWithContextFunc                func(ctx context.Context, p *cloudstack.CreateProjectParams) (*cloudstack.CreateProjectResponse, error)
+	CreateProjectAsyncFunc                      func(p *cloudstack.CreateProjectParams) (*cloudstack.Job, error)
+	CreateProjectAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.CreateProjectParams) (*cloudstack.Job, error)
+	NewCreateProjectRoleParamsFunc              func(name string, projectid string) *cloudstack.CreateProjectRoleParams
+	CreateProjectRoleFunc                       func(p *cloudstack.CreateProjectRoleParams) (*cloudstack.CreateProjectRoleResponse, error)
+	CreateProjectRoleWithContextFunc            func(ctx context.Context, p *cloudstack.CreateProjectRoleParams) (*cloudstack.CreateProjectRoleResponse, error)
+	NewCreateProjectRolePermissionParamsFunc    func(permission string, projectid string, projectroleid string, rule string) *cloudstack.CreateProjectRolePermissionParams
+	CreateProjectRolePermissionFunc             func(p *cloudstack.CreateProjectRolePermissionParams) (*cloudstack.CreateProjectRolePermissionResponse, error)
+	CreateProjectRolePermissionWithContextFunc  func(ctx context.Context, p *cloudstack.CreateProjectRolePermissionParams) (*cloudstack.CreateProjectRolePermissionResponse, error)
+	NewDeleteProjectParamsFunc                  func(id string) *cloudstack.DeleteProjectParams
+	DeleteProjectFunc                           func(p *cloudstack.DeleteProjectParams) (*cloudstack.DeleteProjectResponse, error)
+	DeleteProjectWithContextFunc                func(ctx context.Context, p *cloudstack.DeleteProjectParams) (*cloudstack.DeleteProjectResponse, error)
+	DeleteProjectAsyncFunc                      func(p *cloudstack.DeleteProjectParams) (*cloudstack.Job, error)
+	DeleteProjectAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.DeleteProjectParams) (*cloudstack.Job, error)
+	NewDeleteProjectInvitationParamsFunc        func(id string) *cloudstack.DeleteProjectInvitationParams
+	DeleteProjectInvitationFunc                 func(p *cloudstack.DeleteProjectInvitationParams) (*cloudstack.DeleteProjectInvitationResponse, error)
+	DeleteProjectInvitationWithContextFunc      func(ctx context.Context, p *cloudstack.DeleteProjectInvitationParams) (*cloudstack.DeleteProjectInvitationResponse, error)
+	DeleteProjectInvitationAsyncFunc            func(p *cloudstack.DeleteProjectInvitationParams) (*cloudstack.Job, error)
+	DeleteProjectInvitationAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DeleteProjectInvitationParams) (*cloudstack.Job, error)
+	NewDeleteProjectRoleParamsFunc              func(id string, projectid string) *cloudstack.DeleteProjectRoleParams
+	DeleteProjectRoleFunc                       func(p *cloudstack.DeleteProjectRoleParams) (*cloudstack.DeleteProjectRoleResponse, error)
+	DeleteProjectRoleWithContextFunc            func(ctx context.Context, p *cloudstack.DeleteProjectRoleParams) (*cloudstack.DeleteProjectRoleResponse, error)
+	NewDeleteProjectRolePermissionParamsFunc    func(id string, projectid string) *cloudstack.DeleteProjectRolePermissionParams
+	DeleteProjectRolePermissionFunc             func(p *cloudstack.DeleteProjectRolePermissionParams) (*cloudstack.DeleteProjectRolePermissionResponse, error)
+	DeleteProjectRolePermissionWithContextFunc  func(ctx context.Context, p *cloudstack.DeleteProjectRolePermissionParams) (*cloudstack.DeleteProjectRolePermissionResponse, error)
+	NewDeleteUserFromProjectParamsFunc          func(projectid string, userid string) *cloudstack.DeleteUserFromProjectParams
+	DeleteUserFromProjectFunc                   func(p *cloudstack.DeleteUserFromProjectParams) (*cloudstack.DeleteUserFromProjectResponse, error)
+	DeleteUserFromProjectWithContextFunc        func(ctx context.Context, p *cloudstack.DeleteUserFromProjectParams) (*cloudstack.DeleteUserFromProjectResponse, error)
+	DeleteUserFromProjectAsyncFunc              func(p *cloudstack.DeleteUserFromProjectParams) (*cloudstack.Job, error)
+	DeleteUserFromProjectAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.DeleteUserFromProjectParams) (*cloudstack.Job, error)
+	NewListProjectInvitationsParamsFunc         func() *cloudstack.ListProjectInvitationsParams
+	GetProjectInvitationByIDFunc                func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.ProjectInvitation, int, error)
+	GetProjectInvitationByIDWithContextFunc     func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.ProjectInvitation, int, error)
+	ListProjectInvitationsFunc                  func(p *cloudstack.ListProjectInvitationsParams) (*cloudstack.ListProjectInvitationsResponse, error)
+	ListProjectInvitationsWithContextFunc       func(ctx context.Context, p *cloudstack.ListProjectInvitationsParams) (*cloudstack.ListProjectInvitationsResponse, error)
+	NewListProjectInvitationsPagerFunc          func(p *cloudstack.ListProjectInvitationsParams) *cloudstack.ListProjectInvitationsPager
+	ListProjectInvitationsAllFunc               func(p *cloudstack.ListProjectInvitationsParams) (*cloudstack.ListProjectInvitationsResponse, error)
+	ListProjectInvitationsAllWithContextFunc    func(ctx context.Context, p *cloudstack.ListProjectInvitationsParams) (*cloudstack.ListProjectInvitationsResponse, error)
+	NewListProjectRolePermissionsParamsFunc     func(projectid string) *cloudstack.ListProjectRolePermissionsParams
+	ListProjectRolePermissionsFunc              func(p *cloudstack.ListProjectRolePermissionsParams) (*cloudstack.ListProjectRolePermissionsResponse, error)
+	ListProjectRolePermissionsWithContextFunc   func(ctx context.Context, p *cloudstack.ListProjectRolePermissionsParams) (*cloudstack.ListProjectRolePermissionsResponse, error)
+	NewListProjectRolesParamsFunc               func(projectid string) *cloudstack.ListProjectRolesParams
+	GetProjectRoleIDFunc                        func(name string, projectid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetProjectRoleIDWithContextFunc             func(ctx context.Context, name string, projectid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListProjectRolesFunc                        func(p *cloudstack.ListProjectRolesParams) (*cloudstack.ListProjectRolesResponse, error)
+	ListProjectRolesWithContextFunc             func(ctx context.Context, p *cloudstack.ListProjectRolesParams) (*cloudstack.ListProjectRolesResponse, error)
+	NewListProjectsParamsFunc                   func() *cloudstack.ListProjectsParams
+	GetProjectIDFunc                            func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetProjectIDWithContextFunc                 func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetProjectByNameFunc                        func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Project, int, error)
+	GetProjectByNameWithContextFunc             func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.Project, int, error)
+	GetProjectByIDFunc                          func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Project, int, error)
+	GetProjectByIDWithContextFunc               func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Project, int, error)
+	ListProjectsFunc                            func(p *cloudstack.ListProjectsParams) (*cloudstack.ListProjectsResponse, error)
+	ListProjectsWithContextFunc                 func(ctx context.Context, p *cloudstack.ListProjectsParams) (*cloudstack.ListProjectsResponse, error)
+	NewListProjectsPagerFunc                    func(p *cloudstack.ListProjectsParams) *cloudstack.ListProjectsPager
+	ListProjectsAllFunc                         func(p *cloudstack.ListProjectsParams) (*cloudstack.ListProjectsResponse, error)
+	ListProjectsAllWithContextFunc              func(ctx context.Context, p *cloudstack.ListProjectsParams) (*cloudstack.ListProjectsResponse, error)
+	NewSuspendProjectParamsFunc                 func(id string) *cloudstack.SuspendProjectParams
+	SuspendProjectFunc                          func(p *cloudstack.SuspendProjectParams) (*cloudstack.SuspendProjectResponse, error)
+	SuspendProjectWithContextFunc               func(ctx context.Context, p *cloudstack.SuspendProjectParams) (*cloudstack.SuspendProjectResponse, error)
+	SuspendProjectAsyncFunc                     func(p *cloudstack.SuspendProjectParams) (*cloudstack.Job, error)
+	SuspendProjectAsyncWithContextFunc          func(ctx context.Context, p *cloudstack.SuspendProjectParams) (*cloudstack.Job, error)
+	NewUpdateProjectParamsFunc                  func(id string) *cloudstack.UpdateProjectParams
+	UpdateProjectFunc                           func(p *cloudstack.UpdateProjectParams) (*cloudstack.UpdateProjectResponse, error)
+	UpdateProjectWithContextFunc                func(ctx context.Context, p *cloudstack.UpdateProjectParams) (*cloudstack.UpdateProjectResponse, error)
+	UpdateProjectAsyncFunc                      func(p *cloudstack.UpdateProjectParams) (*cloudstack.Job, error)
+	UpdateProjectAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.UpdateProjectParams) (*cloudstack.Job, error)
+	NewUpdateProjectInvitationParamsFunc        func(projectid string) *cloudstack.UpdateProjectInvitationParams
+	UpdateProjectInvitationFunc                 func(p *cloudstack.UpdateProjectInvitationParams) (*cloudstack.UpdateProjectInvitationResponse, error)
+	UpdateProjectInvitationWithContextFunc      func(ctx context.Context, p *cloudstack.UpdateProjectInvitationParams) (*cloudstack.UpdateProjectInvitationResponse, error)
+	UpdateProjectInvitationAsyncFunc            func(p *cloudstack.UpdateProjectInvitationParams) (*cloudstack.Job, error)
+	UpdateProjectInvitationAsyncWithContextFunc func(ctx context.Context, p *cloudstack.UpdateProjectInvitationParams) (*cloudstack.Job, error)
+	NewUpdateProjectRoleParamsFunc              func(id string, projectid string) *cloudstack.UpdateProjectRoleParams
+	UpdateProjectRoleFunc                       func(p *cloudstack.UpdateProjectRoleParams) (*cloudstack.UpdateProjectRoleResponse, error)
+	UpdateProjectRoleWithContextFunc            func(ctx context.Context, p *cloudstack.UpdateProjectRoleParams) (*cloudstack.UpdateProjectRoleResponse, error)
+	NewUpdateProjectRolePermissionParamsFunc    func(projectid string, projectroleid string) *cloudstack.UpdateProjectRolePermissionParams
+	UpdateProjectRolePermissionFunc             func(p *cloudstack.UpdateProjectRolePermissionParams) (*cloudstack.UpdateProjectRolePermissionResponse, error)
+	UpdateProjectRolePermissionWithContextFunc  func(ctx context.Context, p *cloudstack.UpdateProjectRolePermissionParams) (*cloudstack.UpdateProjectRolePermissionResponse, error)
+}
+
+var _ cloudstack.ProjectServiceIface = (*MockProjectService)(nil)
+
+// NewActivateProjectParams records the call and calls NewActivateProjectParamsFunc
+func (m *MockProjectService) NewActivateProjectParams(id string) (r0 *cloudstack.ActivateProjectParams) {
+	m.record("NewActivateProjectParams", id)
+	if m.NewActivateProjectParamsFunc != nil {
+		return m.NewActivateProjectParamsFunc(id)
+	}
+	return cloudstack.NewProjectService(nil).NewActivateProjectParams(id)
+}
+
+// ActivateProject records the call and calls ActivateProjectFunc, or ActivateProjectWithContextFunc if only that is set
+func (m *MockProjectService) ActivateProject(p *cloudstack.ActivateProjectParams) (r0 *cloudstack.ActivateProjectResponse, r1 error) {
+	m.record("ActivateProject", p)
+	if m.ActivateProjectFunc != nil {
+		return m.ActivateProjectFunc(p)
+	}
+	if m.ActivateProjectWithContextFunc != nil {
+		return m.ActivateProjectWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.ActivateProject")
+	return
+}
+
+// ActivateProjectWithContext records the call and calls ActivateProjectWithContextFunc, or ActivateProjectFunc if only that is set
+func (m *MockProjectService) ActivateProjectWithContext(ctx context.Context, p *cloudstack.ActivateProjectParams) (r0 *cloudstack.ActivateProjectResponse, r1 error) {
+	m.record("ActivateProjectWithContext", ctx, p)
+	if m.ActivateProjectWithContextFunc != nil {
+		return m.ActivateProjectWithContextFunc(ctx, p)
+	}
+	if m.ActivateProjectFunc != nil {
+		return m.ActivateProjectFunc(p)
+	}
+	r1 = notMocked("ProjectService.ActivateProjectWithContext")
+	return
+}
+
+// ActivateProjectAsync records the call and calls ActivateProjectAsyncFunc, or ActivateProjectAsyncWithContextFunc if only that is set
+func (m *MockProjectService) ActivateProjectAsync(p *cloudstack.ActivateProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ActivateProjectAsync", p)
+	if m.ActivateProjectAsyncFunc != nil {
+		return m.ActivateProjectAsyncFunc(p)
+	}
+	if m.ActivateProjectAsyncWithContextFunc != nil {
+		return m.ActivateProjectAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.ActivateProjectAsync")
+	return
+}
+
+// ActivateProjectAsyncWithContext records the call and calls ActivateProjectAsyncWithContextFunc, or ActivateProjectAsyncFunc if only that is set
+func (m *MockProjectService) ActivateProjectAsyncWithContext(ctx context.Context, p *cloudstack.ActivateProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ActivateProjectAsyncWithContext", ctx, p)
+	if m.ActivateProjectAsyncWithContextFunc != nil {
+		return m.ActivateProjectAsyncWithContextFunc(ctx, p)
+	}
+	if m.ActivateProjectAsyncFunc != nil {
+		return m.ActivateProjectAsyncFunc(p)
+	}
+	r1 = notMocked("ProjectService.ActivateProjectAsyncWithContext")
+	return
+}
+
+// NewAddUserToProjectParams records the call and calls NewAddUserToProjectParamsFunc
+func (m *MockProjectService) NewAddUserToProjectParams(projectid string, username string) (r0 *cloudstack.AddUserToProjectParams) {
+	m.record("NewAddUserToProjectParams", projectid, username)
+	if m.NewAddUserToProjectParamsFunc != nil {
+		return m.NewAddUserToProjectParamsFunc(projectid, username)
+	}
+	return cloudstack.NewProjectService(nil).NewAddUserToProjectParams(projectid, username)
+}
+
+// AddUserToProject records the call and calls AddUserToProjectFunc, or AddUserToProjectWithContextFunc if only that is set
+func (m *MockProjectService) AddUserToProject(p *cloudstack.AddUserToProjectParams) (r0 *cloudstack.AddUserToProjectResponse, r1 error) {
+	m.record("AddUserToProject", p)
+	if m.AddUserToProjectFunc != nil {
+		return m.AddUserToProjectFunc(p)
+	}
+	if m.AddUserToProjectWithContextFunc != nil {
+		return m.AddUserToProjectWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.AddUserToProject")
+	return
+}
+
+// AddUserToProjectWithContext records the call and calls AddUserToProjectWithContextFunc, or AddUserToProjectFunc if only that is set
+func (m *MockProjectService) AddUserToProjectWithContext(ctx context.Context, p *cloudstack.AddUserToProjectParams) (r0 *cloudstack.AddUserToProjectResponse, r1 error) {
+	m.record("AddUserToProjectWithContext", ctx, p)
+	if m.AddUserToProjectWithContextFunc != nil {
+		return m.AddUserToProjectWithContextFunc(ctx, p)
+	}
+	if m.AddUserToProjectFunc != nil {
+		return m.AddUserToProjectFunc(p)
+	}
+	r1 = notMocked("ProjectService.AddUserToProjectWithContext")
+	return
+}
+
+// AddUserToProjectAsync records the call and calls AddUserToProjectAsyncFunc, or AddUserToProjectAsyncWithContextFunc if only that is set
+func (m *MockProjectService) AddUserToProjectAsync(p *cloudstack.AddUserToProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddUserToProjectAsync", p)
+	if m.AddUserToProjectAsyncFunc != nil {
+		return m.AddUserToProjectAsyncFunc(p)
+	}
+	if m.AddUserToProjectAsyncWithContextFunc != nil {
+		return m.AddUserToProjectAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.AddUserToProjectAsync")
+	return
+}
+
+// AddUserToProjectAsyncWithContext records the call and calls AddUserToProjectAsyncWithContextFunc, or AddUserToProjectAsyncFunc if only that is set
+func (m *MockProjectService) AddUserToProjectAsyncWithContext(ctx context.Context, p *cloudstack.AddUserToProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddUserToProjectAsyncWithContext", ctx, p)
+	if m.AddUserToProjectAsyncWithContextFunc != nil {
+		return m.AddUserToProjectAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddUserToProjectAsyncFunc != nil {
+		return m.AddUserToProjectAsyncFunc(p)
+	}
+	r1 = notMocked("ProjectService.AddUserToProjectAsyncWithContext")
+	return
+}
+
+// NewCreateProjectParams records the call and calls NewCreateProjectParamsFunc
+func (m *MockProjectService) NewCreateProjectParams(displaytext string, name string) (r0 *cloudstack.CreateProjectParams) {
+	m.record("NewCreateProjectParams", displaytext, name)
+	if m.NewCreateProjectParamsFunc != nil {
+		return m.NewCreateProjectParamsFunc(displaytext, name)
+	}
+	return cloudstack.NewProjectService(nil).NewCreateProjectParams(displaytext, name)
+}
+
+// CreateProject records the call and calls CreateProjectFunc, or CreateProjectWithContextFunc if only that is set
+func (m *MockProjectService) CreateProject(p *cloudstack.CreateProjectParams) (r0 *cloudstack.CreateProjectResponse, r1 error) {
+	m.record("CreateProject", p)
+	if m.CreateProjectFunc != nil {
+		return m.CreateProjectFunc(p)
+	}
+	if m.CreateProjectWithContextFunc != nil {
+		return m.CreateProjectWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.CreateProject")
+	return
+}
+
+// CreateProjectWithContext records the call and calls CreateProjectWithContextFunc, or CreateProjectFunc if only that is set
+func (m *MockProjectService) CreateProjectWithContext(ctx context.Context, p *cloudstack.CreateProjectParams) (r0 *cloudstack.CreateProjectResponse, r1 error) {
+	m.record("CreateProjectWithContext", ctx, p)
+	if m.CreateProjectWithContextFunc != nil {
+		return m.CreateProjectWithContextFunc(ctx, p)
+	}
+	if m.CreateProjectFunc != nil {
+		return m.CreateProjectFunc(p)
+	}
+	r1 = notMocked("ProjectService.CreateProjectWithContext")
+	return
+}
+
+// CreateProjectAsync records the call and calls CreateProjectAsyncFunc, or CreateProjectAsyncWithContextFunc if only that is set
+func (m *MockProjectService) CreateProjectAsync(p *cloudstack.CreateProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateProjectAsync", p)
+	if m.CreateProjectAsyncFunc != nil {
+		return m.CreateProjectAsyncFunc(p)
+	}
+	if m.CreateProjectAsyncWithContextFunc != nil {
+		return m.CreateProjectAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.CreateProjectAsync")
+	return
+}
+
+// CreateProjectAsyncWithContext records the call and calls CreateProjectAsyncWithContextFunc, or CreateProjectAsyncFunc if only that is set
+func (m *MockProjectService) CreateProjectAsyncWithContext(ctx context.Context, p *cloudstack.CreateProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateProjectAsyncWithContext", ctx, p)
+	if m.CreateProjectAsyncWithContextFunc != nil {
+		return m.CreateProjectAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateProjectAsyncFunc != nil {
+		return m.CreateProjectAsyncFunc(p)
+	}
+	r1 = notMocked("ProjectService.CreateProjectAsyncWithContext")
+	return
+}
+
+// NewCreateProjectRoleParams records the call and calls NewCreateProjectRoleParamsFunc
+func (m *MockProjectService) NewCreateProjectRoleParams(name string, projectid string) (r0 *cloudstack.CreateProjectRoleParams) {
+	m.record("NewCreateProjectRoleParams", name, projectid)
+	if m.NewCreateProjectRoleParamsFunc != nil {
+		return m.NewCreateProjectRoleParamsFunc(name, projectid)
+	}
+	return cloudstack.NewProjectService(nil).NewCreateProjectRoleParams(name, projectid)
+}
+
+// CreateProjectRole records the call and calls CreateProjectRoleFunc, or CreateProjectRoleWithContextFunc if only that is set
+func (m *MockProjectService) CreateProjectRole(p *cloudstack.CreateProjectRoleParams) (r0 *cloudstack.CreateProjectRoleResponse, r1 error) {
+	m.record("CreateProjectRole", p)
+	if m.CreateProjectRoleFunc != nil {
+		return m.CreateProjectRoleFunc(p)
+	}
+	if m.CreateProjectRoleWithContextFunc != nil {
+		return m.CreateProjectRoleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.CreateProjectRole")
+	return
+}
+
+// CreateProjectRoleWithContext records the call and calls CreateProjectRoleWithContextFunc, or CreateProjectRoleFunc if only that is set
+func (m *MockProjectService) CreateProjectRoleWithContext(ctx context.Context, p *cloudstack.CreateProjectRoleParams) (r0 *cloudstack.CreateProjectRoleResponse, r1 error) {
+	m.record("CreateProjectRoleWithContext", ctx, p)
+	if m.CreateProjectRoleWithContextFunc != nil {
+		return m.CreateProjectRoleWithContextFunc(ctx, p)
+	}
+	if m.CreateProjectRoleFunc != nil {
+		return m.CreateProjectRoleFunc(p)
+	}
+	r1 = notMocked("ProjectService.CreateProjectRoleWithContext")
+	return
+}
+
+// NewCreateProjectRolePermissionParams records the call and calls NewCreateProjectRolePermissionParamsFunc
+func (m *MockProjectService) NewCreateProjectRolePermissionParams(permission string, projectid string, projectroleid string, rule string) (r0 *cloudstack.CreateProjectRolePermissionParams) {
+	m.record("NewCreateProjectRolePermissionParams", permission, projectid, projectroleid, rule)
+	if m.NewCreateProjectRolePermissionParamsFunc != nil {
+		return m.NewCreateProjectRolePermissionParamsFunc(permission, projectid, projectroleid, rule)
+	}
+	return cloudstack.NewProjectService(nil).NewCreateProjectRolePermissionParams(permission, projectid, projectroleid, rule)
+}
+
+// CreateProjectRolePermission records the call and calls CreateProjectRolePermissionFunc, or CreateProjectRolePermissionWithContextFunc if only that is set
+func (m *MockProjectService) CreateProjectRolePermission(p *cloudstack.CreateProjectRolePermissionParams) (r0 *cloudstack.CreateProjectRolePermissionResponse, r1 error) {
+	m.record("CreateProjectRolePermission", p)
+	if m.CreateProjectRolePermissionFunc != nil {
+		return m.CreateProjectRolePermissionFunc(p)
+	}
+	if m.CreateProjectRolePermissionWithContextFunc != nil {
+		return m.CreateProjectRolePermissionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.CreateProjectRolePermission")
+	return
+}
+
+// CreateProjectRolePermissionWithContext records the call and calls CreateProjectRolePermissionWithContextFunc, or CreateProjectRolePermissionFunc if only that is set
+func (m *MockProjectService) CreateProjectRolePermissionWithContext(ctx context.Context, p *cloudstack.CreateProjectRolePermissionParams) (r0 *cloudstack.CreateProjectRolePermissionResponse, r1 error) {
+	m.record("CreateProjectRolePermissionWithContext", ctx, p)
+	if m.CreateProjectRolePermissionWithContextFunc != nil {
+		return m.CreateProjectRolePermissionWithContextFunc(ctx, p)
+	}
+	if m.CreateProjectRolePermissionFunc != nil {
+		return m.CreateProjectRolePermissionFunc(p)
+	}
+	r1 = notMocked("ProjectService.CreateProjectRolePermissionWithContext")
+	return
+}
+
+// NewDeleteProjectParams records the call and calls NewDeleteProjectParamsFunc
+func (m *MockProjectService) NewDeleteProjectParams(id string) (r0 *cloudstack.DeleteProjectParams) {
+	m.record("NewDeleteProjectParams", id)
+	if m.NewDeleteProjectParamsFunc != nil {
+		return m.NewDeleteProjectParamsFunc(id)
+	}
+	return cloudstack.NewProjectService(nil).NewDeleteProjectParams(id)
+}
+
+// DeleteProject records the call and calls DeleteProjectFunc, or DeleteProjectWithContextFunc if only that is set
+func (m *MockProjectService) DeleteProject(p *cloudstack.DeleteProjectParams) (r0 *cloudstack.DeleteProjectResponse, r1 error) {
+	m.record("DeleteProject", p)
+	if m.DeleteProjectFunc != nil {
+		return m.DeleteProjectFunc(p)
+	}
+	if m.DeleteProjectWithContextFunc != nil {
+		return m.DeleteProjectWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.DeleteProject")
+	return
+}
+
+// DeleteProjectWithContext records the call and calls DeleteProjectWithContextFunc, or DeleteProjectFunc if only that is set
+func (m *MockProjectService) DeleteProjectWithContext(ctx context.Context, p *cloudstack.DeleteProjectParams) (r0 *cloudstack.DeleteProjectResponse, r1 error) {
+	m.record("DeleteProjectWithContext", ctx, p)
+	if m.DeleteProjectWithContextFunc != nil {
+		return m.DeleteProjectWithContextFunc(ctx, p)
+	}
+	if m.DeleteProjectFunc != nil {
+		return m.DeleteProjectFunc(p)
+	}
+	r1 = notMocked("ProjectService.DeleteProjectWithContext")
+	return
+}
+
+// DeleteProjectAsync records the call and calls DeleteProjectAsyncFunc, or DeleteProjectAsyncWithContextFunc if only that is set
+func (m *MockProjectService) DeleteProjectAsync(p *cloudstack.DeleteProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteProjectAsync", p)
+	if m.DeleteProjectAsyncFunc != nil {
+		return m.DeleteProjectAsyncFunc(p)
+	}
+	if m.DeleteProjectAsyncWithContextFunc != nil {
+		return m.DeleteProjectAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.DeleteProjectAsync")
+	return
+}
+
+// DeleteProjectAsyncWithContext records the call and calls DeleteProjectAsyncWithContextFunc, or DeleteProjectAsyncFunc if only that is set
+func (m *MockProjectService) DeleteProjectAsyncWithContext(ctx context.Context, p *cloudstack.DeleteProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteProjectAsyncWithContext", ctx, p)
+	if m.DeleteProjectAsyncWithContextFunc != nil {
+		return m.DeleteProjectAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteProjectAsyncFunc != nil {
+		return m.DeleteProjectAsyncFunc(p)
+	}
+	r1 = notMocked("ProjectService.DeleteProjectAsyncWithContext")
+	return
+}
+
+// NewDeleteProjectInvitationParams records the call and calls NewDeleteProjectInvitationParamsFunc
+func (m *MockProjectService) NewDeleteProjectInvitationParams(id string) (r0 *cloudstack.DeleteProjectInvitationParams) {
+	m.record("NewDeleteProjectInvitationParams", id)
+	if m.NewDeleteProjectInvitationParamsFunc != nil {
+		return m.NewDeleteProjectInvitationParamsFunc(id)
+	}
+	return cloudstack.NewProjectService(nil).NewDeleteProjectInvitationParams(id)
+}
+
+// DeleteProjectInvitation records the call and calls DeleteProjectInvitationFunc, or DeleteProjectInvitationWithContextFunc if only that is set
+func (m *MockProjectService) DeleteProjectInvitation(p *cloudstack.DeleteProjectInvitationParams) (r0 *cloudstack.DeleteProjectInvitationResponse, r1 error) {
+	m.record("DeleteProjectInvitation", p)
+	if m.DeleteProjectInvitationFunc != nil {
+		return m.DeleteProjectInvitationFunc(p)
+	}
+	if m.DeleteProjectInvitationWithContextFunc != nil {
+		return m.DeleteProjectInvitationWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.DeleteProjectInvitation")
+	return
+}
+
+// DeleteProjectInvitationWithContext records the call and calls DeleteProjectInvitationWithContextFunc, or DeleteProjectInvitationFunc if only that is set
+func (m *MockProjectService) DeleteProjectInvitationWithContext(ctx context.Context, p *cloudstack.DeleteProjectInvitationParams) (r0 *cloudstack.DeleteProjectInvitationResponse, r1 error) {
+	m.record("DeleteProjectInvitationWithContext", ctx, p)
+	if m.DeleteProjectInvitationWithContextFunc != nil {
+		return m.DeleteProjectInvitationWithContextFunc(ctx, p)
+	}
+	if m.DeleteProjectInvitationFunc != nil {
+		return m.DeleteProjectInvitationFunc(p)
+	}
+	r1 = notMocked("ProjectService.DeleteProjectInvitationWithContext")
+	return
+}
+
+// DeleteProjectInvitationAsync records the call and calls DeleteProjectInvitationAsyncFunc, or DeleteProjectInvitationAsyncWithContextFunc if only that is set
+func (m *MockProjectService) DeleteProjectInvitationAsync(p *cloudstack.DeleteProjectInvitationParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteProjectInvitationAsync", p)
+	if m.DeleteProjectInvitationAsyncFunc != nil {
+		return m.DeleteProjectInvitationAsyncFunc(p)
+	}
+	if m.DeleteProjectInvitationAsyncWithContextFunc != nil {
+		return m.DeleteProjectInvitationAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.DeleteProjectInvitationAsync")
+	return
+}
+
+// DeleteProjectInvitationAsyncWithContext records the call and calls DeleteProjectInvitationAsyncWithContextFunc, or DeleteProjectInvitationAsyncFunc if only that is set
+func (m *MockProjectService) DeleteProjectInvitationAsyncWithContext(ctx context.Context, p *cloudstack.DeleteProjectInvitationParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteProjectInvitationAsyncWithContext", ctx, p)
+	if m.DeleteProjectInvitationAsyncWithContextFunc != nil {
+		return m.DeleteProjectInvitationAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteProjectInvitationAsyncFunc != nil {
+		return m.DeleteProjectInvitationAsyncFunc(p)
+	}
+	r1 = notMocked("ProjectService.DeleteProjectInvitationAsyncWithContext")
+	return
+}
+
+// NewDeleteProjectRoleParams records the call and calls NewDeleteProjectRoleParamsFunc
+func (m *MockProjectService) NewDeleteProjectRoleParams(id string, projectid string) (r0 *cloudstack.DeleteProjectRoleParams) {
+	m.record("NewDeleteProjectRoleParams", id, projectid)
+	if m.NewDeleteProjectRoleParamsFunc != nil {
+		return m.NewDeleteProjectRoleParamsFunc(id, projectid)
+	}
+	return cloudstack.NewProjectService(nil).NewDeleteProjectRoleParams(id, projectid)
+}
+
+// DeleteProjectRole records the call and calls DeleteProjectRoleFunc, or DeleteProjectRoleWithContextFunc if only that is set
+func (m *MockProjectService) DeleteProjectRole(p *cloudstack.DeleteProjectRoleParams) (r0 *cloudstack.DeleteProjectRoleResponse, r1 error) {
+	m.record("DeleteProjectRole", p)
+	if m.DeleteProjectRoleFunc != nil {
+		return m.DeleteProjectRoleFunc(p)
+	}
+	if m.DeleteProjectRoleWithContextFunc != nil {
+		return m.DeleteProjectRoleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.DeleteProjectRole")
+	return
+}
+
+// DeleteProjectRoleWithContext records the call and calls DeleteProjectRoleWithContextFunc, or DeleteProjectRoleFunc if only that is set
+func (m *MockProjectService) DeleteProjectRoleWithContext(ctx context.Context, p *cloudstack.DeleteProjectRoleParams) (r0 *cloudstack.DeleteProjectRoleResponse, r1 error) {
+	m.record("DeleteProjectRoleWithContext", ctx, p)
+	if m.DeleteProjectRoleWithContextFunc != nil {
+		return m.DeleteProjectRoleWithContextFunc(ctx, p)
+	}
+	if m.DeleteProjectRoleFunc != nil {
+		return m.DeleteProjectRoleFunc(p)
+	}
+	r1 = notMocked("ProjectService.DeleteProjectRoleWithContext")
+	return
+}
+
+// NewDeleteProjectRolePermissionParams records the call and calls NewDeleteProjectRolePermissionParamsFunc
+func (m *MockProjectService) NewDeleteProjectRolePermissionParams(id string, projectid string) (r0 *cloudstack.DeleteProjectRolePermissionParams) {
+	m.record("NewDeleteProjectRolePermissionParams", id, projectid)
+	if m.NewDeleteProjectRolePermissionParamsFunc != nil {
+		return m.NewDeleteProjectRolePermissionParamsFunc(id, projectid)
+	}
+	return cloudstack.NewProjectService(nil).NewDeleteProjectRolePermissionParams(id, projectid)
+}
+
+// DeleteProjectRolePermission records the call and calls DeleteProjectRolePermissionFunc, or DeleteProjectRolePermissionWithContextFunc if only that is set
+func (m *MockProjectService) DeleteProjectRolePermission(p *cloudstack.DeleteProjectRolePermissionParams) (r0 *cloudstack.DeleteProjectRolePermissionResponse, r1 error) {
+	m.record("DeleteProjectRolePermission", p)
+	if m.DeleteProjectRolePermissionFunc != nil {
+		return m.DeleteProjectRolePermissionFunc(p)
+	}
+	if m.DeleteProjectRolePermissionWithContextFunc != nil {
+		return m.DeleteProjectRolePermissionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.DeleteProjectRolePermission")
+	return
+}
+
+// DeleteProjectRolePermissionWithContext records the call and calls DeleteProjectRolePermissionWithContextFunc, or DeleteProjectRolePermissionFunc if only that is set
+func (m *MockProjectService) DeleteProjectRolePermissionWithContext(ctx context.Context, p *cloudstack.DeleteProjectRolePermissionParams) (r0 *cloudstack.DeleteProjectRolePermissionResponse, r1 error) {
+	m.record("DeleteProjectRolePermissionWithContext", ctx, p)
+	if m.DeleteProjectRolePermissionWithContextFunc != nil {
+		return m.DeleteProjectRolePermissionWithContextFunc(ctx, p)
+	}
+	if m.DeleteProjectRolePermissionFunc != nil {
+		return m.DeleteProjectRolePermissionFunc(p)
+	}
+	r1 = notMocked("ProjectService.DeleteProjectRolePermissionWithContext")
+	return
+}
+
+// NewDeleteUserFromProjectParams records the call and calls NewDeleteUserFromProjectParamsFunc
+func (m *MockProjectService) NewDeleteUserFromProjectParams(projectid string, userid string) (r0 *cloudstack.DeleteUserFromProjectParams) {
+	m.record("NewDeleteUserFromProjectParams", projectid, userid)
+	if m.NewDeleteUserFromProjectParamsFunc != nil {
+		return m.NewDeleteUserFromProjectParamsFunc(projectid, userid)
+	}
+	return cloudstack.NewProjectService(nil).NewDeleteUserFromProjectParams(projectid, userid)
+}
+
+// DeleteUserFromProject records the call and calls DeleteUserFromProjectFunc, or DeleteUserFromProjectWithContextFunc if only that is set
+func (m *MockProjectService) DeleteUserFromProject(p *cloudstack.DeleteUserFromProjectParams) (r0 *cloudstack.DeleteUserFromProjectResponse, r1 error) {
+	m.record("DeleteUserFromProject", p)
+	if m.DeleteUserFromProjectFunc != nil {
+		return m.DeleteUserFromProjectFunc(p)
+	}
+	if m.DeleteUserFromProjectWithContextFunc != nil {
+		return m.DeleteUserFromProjectWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.DeleteUserFromProject")
+	return
+}
+
+// DeleteUserFromProjectWithContext records the call and calls DeleteUserFromProjectWithContextFunc, or DeleteUserFromProjectFunc if only that is set
+func (m *MockProjectService) DeleteUserFromProjectWithContext(ctx context.Context, p *cloudstack.DeleteUserFromProjectParams) (r0 *cloudstack.DeleteUserFromProjectResponse, r1 error) {
+	m.record("DeleteUserFromProjectWithContext", ctx, p)
+	if m.DeleteUserFromProjectWithContextFunc != nil {
+		return m.DeleteUserFromProjectWithContextFunc(ctx, p)
+	}
+	if m.DeleteUserFromProjectFunc != nil {
+		return m.DeleteUserFromProjectFunc(p)
+	}
+	r1 = notMocked("ProjectService.DeleteUserFromProjectWithContext")
+	return
+}
+
+// DeleteUserFromProjectAsync records the call and calls DeleteUserFromProjectAsyncFunc, or DeleteUserFromProjectAsyncWithContextFunc if only that is set
+func (m *MockProjectService) DeleteUserFromProjectAsync(p *cloudstack.DeleteUserFromProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteUserFromProjectAsync", p)
+	if m.DeleteUserFromProjectAsyncFunc != nil {
+		return m.DeleteUserFromProjectAsyncFunc(p)
+	}
+	if m.DeleteUserFromProjectAsyncWithContextFunc != nil {
+		return m.DeleteUserFromProjectAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.DeleteUserFromProjectAsync")
+	return
+}
+
+// DeleteUserFromProjectAsyncWithContext records the call and calls DeleteUserFromProjectAsyncWithContextFunc, or DeleteUserFromProjectAsyncFunc if only that is set
+func (m *MockProjectService) DeleteUserFromProjectAsyncWithContext(ctx context.Context, p *cloudstack.DeleteUserFromProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteUserFromProjectAsyncWithContext", ctx, p)
+	if m.DeleteUserFromProjectAsyncWithContextFunc != nil {
+		return m.DeleteUserFromProjectAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteUserFromProjectAsyncFunc != nil {
+		return m.DeleteUserFromProjectAsyncFunc(p)
+	}
+	r1 = notMocked("ProjectService.DeleteUserFromProjectAsyncWithContext")
+	return
+}
+
+// NewListProjectInvitationsParams records the call and calls NewListProjectInvitationsParamsFunc
+func (m *MockProjectService) NewListProjectInvitationsParams() (r0 *cloudstack.ListProjectInvitationsParams) {
+	m.record("NewListProjectInvitationsParams")
+	if m.NewListProjectInvitationsParamsFunc != nil {
+		return m.NewListProjectInvitationsParamsFunc()
+	}
+	return cloudstack.NewProjectService(nil).NewListProjectInvitationsParams()
+}
+
+// GetProjectInvitationByID records the call and calls GetProjectInvitationByIDFunc, or GetProjectInvitationByIDWithContextFunc if only that is set
+func (m *MockProjectService) GetProjectInvitationByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ProjectInvitation, r1 int, r2 error) {
+	m.record("GetProjectInvitationByID", id, opts)
+	if m.GetProjectInvitationByIDFunc != nil {
+		return m.GetProjectInvitationByIDFunc(id, opts...)
+	}
+	if m.GetProjectInvitationByIDWithContextFunc != nil {
+		return m.GetProjectInvitationByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("ProjectService.GetProjectInvitationByID")
+	return
+}
+
+// GetProjectInvitationByIDWithContext records the call and calls GetProjectInvitationByIDWithContextFunc, or GetProjectInvitationByIDFunc if only that is set
+func (m *MockProjectService) GetProjectInvitationByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ProjectInvitation, r1 int, r2 error) {
+	m.record("GetProjectInvitationByIDWithContext", ctx, id, opts)
+	if m.GetProjectInvitationByIDWithContextFunc != nil {
+		return m.GetProjectInvitationByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetProjectInvitationByIDFunc != nil {
+		return m.GetProjectInvitationByIDFunc(id, opts...)
+	}
+	r2 = notMocked("ProjectService.GetProjectInvitationByIDWithContext")
+	return
+}
+
+// ListProjectInvitations records the call and calls ListProjectInvitationsFunc, or ListProjectInvitationsWithContextFunc if only that is set
+func (m *MockProjectService) ListProjectInvitations(p *cloudstack.ListProjectInvitationsParams) (r0 *cloudstack.ListProjectInvitationsResponse, r1 error) {
+	m.record("ListProjectInvitations", p)
+	if m.ListProjectInvitationsFunc != nil {
+		return m.ListProjectInvitationsFunc(p)
+	}
+	if m.ListProjectInvitationsWithContextFunc != nil {
+		return m.ListProjectInvitationsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.ListProjectInvitations")
+	return
+}
+
+// ListProjectInvitationsWithContext records the call and calls ListProjectInvitationsWithContextFunc, or ListProjectInvitationsFunc if only that is set
+func (m *MockProjectService) ListProjectInvitationsWithContext(ctx context.Context, p *cloudstack.ListProjectInvitationsParams) (r0 *cloudstack.ListProjectInvitationsResponse, r1 error) {
+	m.record("ListProjectInvitationsWithContext", ctx, p)
+	if m.ListProjectInvitationsWithContextFunc != nil {
+		return m.ListProjectInvitationsWithContextFunc(ctx, p)
+	}
+	if m.ListProjectInvitationsFunc != nil {
+		return m.ListProjectInvitationsFunc(p)
+	}
+	r1 = notMocked("ProjectService.ListProjectInvitationsWithContext")
+	return
+}
+
+// NewListProjectInvitationsPager records the call and calls NewListProjectInvitationsPagerFunc
+func (m *MockProjectService) NewListProjectInvitationsPager(p *cloudstack.ListProjectInvitationsParams) (r0 *cloudstack.ListProjectInvitationsPager) {
+	m.record("NewListProjectInvitationsPager", p)
+	if m.NewListProjectInvitationsPagerFunc != nil {
+		return m.NewListProjectInvitationsPagerFunc(p)
+	}
+	return
+}
+
+// ListProjectInvitationsAll records the call and calls ListProjectInvitationsAllFunc, or ListProjectInvitationsAllWithContextFunc if only that is set
+func (m *MockProjectService) ListProjectInvitationsAll(p *cloudstack.ListProjectInvitationsParams) (r0 *cloudstack.ListProjectInvitationsResponse, r1 error) {
+	m.record("ListProjectInvitationsAll", p)
+	if m.ListProjectInvitationsAllFunc != nil {
+		return m.ListProjectInvitationsAllFunc(p)
+	}
+	if m.ListProjectInvitationsAllWithContextFunc != nil {
+		return m.ListProjectInvitationsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.ListProjectInvitationsAll")
+	return
+}
+
+// ListProjectInvitationsAllWithContext records the call and calls ListProjectInvitationsAllWithContextFunc, or ListProjectInvitationsAllFunc if only that is set
+func (m *MockProjectService) ListProjectInvitationsAllWithContext(ctx context.Context, p *cloudstack.ListProjectInvitationsParams) (r0 *cloudstack.ListProjectInvitationsResponse, r1 error) {
+	m.record("ListProjectInvitationsAllWithContext", ctx, p)
+	if m.ListProjectInvitationsAllWithContextFunc != nil {
+		return m.ListProjectInvitationsAllWithContextFunc(ctx, p)
+	}
+	if m.ListProjectInvitationsAllFunc != nil {
+		return m.ListProjectInvitationsAllFunc(p)
+	}
+	r1 = notMocked("ProjectService.ListProjectInvitationsAllWithContext")
+	return
+}
+
+// NewListProjectRolePermissionsParams records the call and calls NewListProjectRolePermissionsParamsFunc
+func (m *MockProjectService) NewListProjectRolePermissionsParams(projectid string) (r0 *cloudstack.ListProjectRolePermissionsParams) {
+	m.record("NewListProjectRolePermissionsParams", projectid)
+	if m.NewListProjectRolePermissionsParamsFunc != nil {
+		return m.NewListProjectRolePermissionsParamsFunc(projectid)
+	}
+	return cloudstack.NewProjectService(nil).NewListProjectRolePermissionsParams(projectid)
+}
+
+// ListProjectRolePermissions records the call and calls ListProjectRolePermissionsFunc, or ListProjectRolePermissionsWithContextFunc if only that is set
+func (m *MockProjectService) ListProjectRolePermissions(p *cloudstack.ListProjectRolePermissionsParams) (r0 *cloudstack.ListProjectRolePermissionsResponse, r1 error) {
+	m.record("ListProjectRolePermissions", p)
+	if m.ListProjectRolePermissionsFunc != nil {
+		return m.ListProjectRolePermissionsFunc(p)
+	}
+	if m.ListProjectRolePermissionsWithContextFunc != nil {
+		return m.ListProjectRolePermissionsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.ListProjectRolePermissions")
+	return
+}
+
+// ListProjectRolePermissionsWithContext records the call and calls ListProjectRolePermissionsWithContextFunc, or ListProjectRolePermissionsFunc if only that is set
+func (m *MockProjectService) ListProjectRolePermissionsWithContext(ctx context.Context, p *cloudstack.ListProjectRolePermissionsParams) (r0 *cloudstack.ListProjectRolePermissionsResponse, r1 error) {
+	m.record("ListProjectRolePermissionsWithContext", ctx, p)
+	if m.ListProjectRolePermissionsWithContextFunc != nil {
+		return m.ListProjectRolePermissionsWithContextFunc(ctx, p)
+	}
+	if m.ListProjectRolePermissionsFunc != nil {
+		return m.ListProjectRolePermissionsFunc(p)
+	}
+	r1 = notMocked("ProjectService.ListProjectRolePermissionsWithContext")
+	return
+}
+
+// NewListProjectRolesParams records the call and calls NewListProjectRolesParamsFunc
+func (m *MockProjectService) NewListProjectRolesParams(projectid string) (r0 *cloudstack.ListProjectRolesParams) {
+	m.record("NewListProjectRolesParams", projectid)
+	if m.NewListProjectRolesParamsFunc != nil {
+		return m.NewListProjectRolesParamsFunc(projectid)
+	}
+	return cloudstack.NewProjectService(nil).NewListProjectRolesParams(projectid)
+}
+
+// GetProjectRoleID records the call and calls GetProjectRoleIDFunc, or GetProjectRoleIDWithContextFunc if only that is set
+func (m *MockProjectService) GetProjectRoleID(name string, projectid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetProjectRoleID", name, projectid, opts)
+	if m.GetProjectRoleIDFunc != nil {
+		return m.GetProjectRoleIDFunc(name, projectid, opts...)
+	}
+	if m.GetProjectRoleIDWithContextFunc != nil {
+		return m.GetProjectRoleIDWithContextFunc(context.Background(), name, projectid, opts...)
+	}
+	r2 = notMocked("ProjectService.GetProjectRoleID")
+	return
+}
+
+// GetProjectRoleIDWithContext records the call and calls GetProjectRoleIDWithContextFunc, or GetProjectRoleIDFunc if only that is set
+func (m *MockProjectService) GetProjectRoleIDWithContext(ctx context.Context, name string, projectid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetProjectRoleIDWithContext", ctx, name, projectid, opts)
+	if m.GetProjectRoleIDWithContextFunc != nil {
+		return m.GetProjectRoleIDWithContextFunc(ctx, name, projectid, opts...)
+	}
+	if m.GetProjectRoleIDFunc != nil {
+		return m.GetProjectRoleIDFunc(name, projectid, opts...)
+	}
+	r2 = notMocked("ProjectService.GetProjectRoleIDWithContext")
+	return
+}
+
+// ListProjectRoles records the call and calls ListProjectRolesFunc, or ListProjectRolesWithContextFunc if only that is set
+func (m *MockProjectService) ListProjectRoles(p *cloudstack.ListProjectRolesParams) (r0 *cloudstack.ListProjectRolesResponse, r1 error) {
+	m.record("ListProjectRoles", p)
+	if m.ListProjectRolesFunc != nil {
+		return m.ListProjectRolesFunc(p)
+	}
+	if m.ListProjectRolesWithContextFunc != nil {
+		return m.ListProjectRolesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.ListProjectRoles")
+	return
+}
+
+// ListProjectRolesWithContext records the call and calls ListProjectRolesWithContextFunc, or ListProjectRolesFunc if only that is set
+func (m *MockProjectService) ListProjectRolesWithContext(ctx context.Context, p *cloudstack.ListProjectRolesParams) (r0 *cloudstack.ListProjectRolesResponse, r1 error) {
+	m.record("ListProjectRolesWithContext", ctx, p)
+	if m.ListProjectRolesWithContextFunc != nil {
+		return m.ListProjectRolesWithContextFunc(ctx, p)
+	}
+	if m.ListProjectRolesFunc != nil {
+		return m.ListProjectRolesFunc(p)
+	}
+	r1 = notMocked("ProjectService.ListProjectRolesWithContext")
+	return
+}
+
+// NewListProjectsParams records the call and calls NewListProjectsParamsFunc
+func (m *MockProjectService) NewListProjectsParams() (r0 *cloudstack.ListProjectsParams) {
+	m.record("NewListProjectsParams")
+	if m.NewListProjectsParamsFunc != nil {
+		return m.NewListProjectsParamsFunc()
+	}
+	return cloudstack.NewProjectService(nil).NewListProjectsParams()
+}
+
+// GetProjectID records the call and calls GetProjectIDFunc, or GetProjectIDWithContextFunc if only that is set
+func (m *MockProjectService) GetProjectID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetProjectID", name, opts)
+	if m.GetProjectIDFunc != nil {
+		return m.GetProjectIDFunc(name, opts...)
+	}
+	if m.GetProjectIDWithContextFunc != nil {
+		return m.GetProjectIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ProjectService.GetProjectID")
+	return
+}
+
+// GetProjectIDWithContext records the call and calls GetProjectIDWithContextFunc, or GetProjectIDFunc if only that is set
+func (m *MockProjectService) GetProjectIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetProjectIDWithContext", ctx, name, opts)
+	if m.GetProjectIDWithContextFunc != nil {
+		return m.GetProjectIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetProjectIDFunc != nil {
+		return m.GetProjectIDFunc(name, opts...)
+	}
+	r2 = notMocked("ProjectService.GetProjectIDWithContext")
+	return
+}
+
+// GetProjectByName records the call and calls GetProjectByNameFunc, or GetProjectByNameWithContextFunc if only that is set
+func (m *MockProjectService) GetProjectByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Project, r1 int, r2 error) {
+	m.record("GetProjectByName", name, opts)
+	if m.GetProjectByNameFunc != nil {
+		return m.GetProjectByNameFunc(name, opts...)
+	}
+	if m.GetProjectByNameWithContextFunc != nil {
+		return m.GetProjectByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ProjectService.GetProjectByName")
+	return
+}
+
+// GetProjectByNameWithContext records the call and calls GetProjectByNameWithContextFunc, or GetProjectByNameFunc if only that is set
+func (m *MockProjectService) GetProjectByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Project, r1 int, r2 error) {
+	m.record("GetProjectByNameWithContext", ctx, name, opts)
+	if m.GetProjectByNameWithContextFunc != nil {
+		return m.GetProjectByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetProjectByNameFunc != nil {
+		return m.GetProjectByNameFunc(name, opts...)
+	}
+	r2 = notMocked("ProjectService.GetProjectByNameWithContext")
+	return
+}
+
+// GetProjectByID records the call and calls GetProjectByIDFunc, or GetProjectByIDWithContextFunc if only that is set
+func (m *MockProjectService) GetProjectByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Project, r1 int, r2 error) {
+	m.record("GetProjectByID", id, opts)
+	if m.GetProjectByIDFunc != nil {
+		return m.GetProjectByIDFunc(id, opts...)
+	}
+	if m.GetProjectByIDWithContextFunc != nil {
+		return m.GetProjectByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("ProjectService.GetProjectByID")
+	return
+}
+
+// GetProjectByIDWithContext records the call and calls GetProjectByIDWithContextFunc, or GetProjectByIDFunc if only that is set
+func (m *MockProjectService) GetProjectByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Project, r1 int, r2 error) {
+	m.record("GetProjectByIDWithContext", ctx, id, opts)
+	if m.GetProjectByIDWithContextFunc != nil {
+		return m.GetProjectByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetProjectByIDFunc != nil {
+		return m.GetProjectByIDFunc(id, opts...)
+	}
+	r2 = notMocked("ProjectService.GetProjectByIDWithContext")
+	return
+}
+
+// ListProjects records the call and calls ListProjectsFunc, or ListProjectsWithContextFunc if only that is set
+func (m *MockProjectService) ListProjects(p *cloudstack.ListProjectsParams) (r0 *cloudstack.ListProjectsResponse, r1 error) {
+	m.record("ListProjects", p)
+	if m.ListProjectsFunc != nil {
+		return m.ListProjectsFunc(p)
+	}
+	if m.ListProjectsWithContextFunc != nil {
+		return m.ListProjectsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.ListProjects")
+	return
+}
+
+// ListProjectsWithContext records the call and calls ListProjectsWithContextFunc, or ListProjectsFunc if only that is set
+func (m *MockProjectService) ListProjectsWithContext(ctx context.Context, p *cloudstack.ListProjectsParams) (r0 *cloudstack.ListProjectsResponse, r1 error) {
+	m.record("ListProjectsWithContext", ctx, p)
+	if m.ListProjectsWithContextFunc != nil {
+		return m.ListProjectsWithContextFunc(ctx, p)
+	}
+	if m.ListProjectsFunc != nil {
+		return m.ListProjectsFunc(p)
+	}
+	r1 = notMocked("ProjectService.ListProjectsWithContext")
+	return
+}
+
+// NewListProjectsPager records the call and calls NewListProjectsPagerFunc
+func (m *MockProjectService) NewListProjectsPager(p *cloudstack.ListProjectsParams) (r0 *cloudstack.ListProjectsPager) {
+	m.record("NewListProjectsPager", p)
+	if m.NewListProjectsPagerFunc != nil {
+		return m.NewListProjectsPagerFunc(p)
+	}
+	return
+}
+
+// ListProjectsAll records the call and calls ListProjectsAllFunc, or ListProjectsAllWithContextFunc if only that is set
+func (m *MockProjectService) ListProjectsAll(p *cloudstack.ListProjectsParams) (r0 *cloudstack.ListProjectsResponse, r1 error) {
+	m.record("ListProjectsAll", p)
+	if m.ListProjectsAllFunc != nil {
+		return m.ListProjectsAllFunc(p)
+	}
+	if m.ListProjectsAllWithContextFunc != nil {
+		return m.ListProjectsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.ListProjectsAll")
+	return
+}
+
+// ListProjectsAllWithContext records the call and calls ListProjectsAllWithContextFunc, or ListProjectsAllFunc if only that is set
+func (m *MockProjectService) ListProjectsAllWithContext(ctx context.Context, p *cloudstack.ListProjectsParams) (r0 *cloudstack.ListProjectsResponse, r1 error) {
+	m.record("ListProjectsAllWithContext", ctx, p)
+	if m.ListProjectsAllWithContextFunc != nil {
+		return m.ListProjectsAllWithContextFunc(ctx, p)
+	}
+	if m.ListProjectsAllFunc != nil {
+		return m.ListProjectsAllFunc(p)
+	}
+	r1 = notMocked("ProjectService.ListProjectsAllWithContext")
+	return
+}
+
+// NewSuspendProjectParams records the call and calls NewSuspendProjectParamsFunc
+func (m *MockProjectService) NewSuspendProjectParams(id string) (r0 *cloudstack.SuspendProjectParams) {
+	m.record("NewSuspendProjectParams", id)
+	if m.NewSuspendProjectParamsFunc != nil {
+		return m.NewSuspendProjectParamsFunc(id)
+	}
+	return cloudstack.NewProjectService(nil).NewSuspendProjectParams(id)
+}
+
+// SuspendProject records the call and calls SuspendProjectFunc, or SuspendProjectWithContextFunc if only that is set
+func (m *MockProjectService) SuspendProject(p *cloudstack.SuspendProjectParams) (r0 *cloudstack.SuspendProjectResponse, r1 error) {
+	m.record("SuspendProject", p)
+	if m.SuspendProjectFunc != nil {
+		return m.SuspendProjectFunc(p)
+	}
+	if m.SuspendProjectWithContextFunc != nil {
+		return m.SuspendProjectWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.SuspendProject")
+	return
+}
+
+// SuspendProjectWithContext records the call and calls SuspendProjectWithContextFunc, or SuspendProjectFunc if only that is set
+func (m *MockProjectService) SuspendProjectWithContext(ctx context.Context, p *cloudstack.SuspendProjectParams) (r0 *cloudstack.SuspendProjectResponse, r1 error) {
+	m.record("SuspendProjectWithContext", ctx, p)
+	if m.SuspendProjectWithContextFunc != nil {
+		return m.SuspendProjectWithContextFunc(ctx, p)
+	}
+	if m.SuspendProjectFunc != nil {
+		return m.SuspendProjectFunc(p)
+	}
+	r1 = notMocked("ProjectService.SuspendProjectWithContext")
+	return
+}
+
+// SuspendProjectAsync records the call and calls SuspendProjectAsyncFunc, or SuspendProjectAsyncWithContextFunc if only that is set
+func (m *MockProjectService) SuspendProjectAsync(p *cloudstack.SuspendProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("SuspendProjectAsync", p)
+	if m.SuspendProjectAsyncFunc != nil {
+		return m.SuspendProjectAsyncFunc(p)
+	}
+	if m.SuspendProjectAsyncWithContextFunc != nil {
+		return m.SuspendProjectAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.SuspendProjectAsync")
+	return
+}
+
+// SuspendProjectAsyncWithContext records the call and calls SuspendProjectAsyncWithContextFunc, or SuspendProjectAsyncFunc if only that is set
+func (m *MockProjectService) SuspendProjectAsyncWithContext(ctx context.Context, p *cloudstack.SuspendProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("SuspendProjectAsyncWithContext", ctx, p)
+	if m.SuspendProjectAsyncWithContextFunc != nil {
+		return m.SuspendProjectAsyncWithContextFunc(ctx, p)
+	}
+	if m.SuspendProjectAsyncFunc != nil {
+		return m.SuspendProjectAsyncFunc(p)
+	}
+	r1 = notMocked("ProjectService.SuspendProjectAsyncWithContext")
+	return
+}
+
+// NewUpdateProjectParams records the call and calls NewUpdateProjectParamsFunc
+func (m *MockProjectService) NewUpdateProjectParams(id string) (r0 *cloudstack.UpdateProjectParams) {
+	m.record("NewUpdateProjectParams", id)
+	if m.NewUpdateProjectParamsFunc != nil {
+		return m.NewUpdateProjectParamsFunc(id)
+	}
+	return cloudstack.NewProjectService(nil).NewUpdateProjectParams(id)
+}
+
+// UpdateProject records the call and calls UpdateProjectFunc, or UpdateProjectWithContextFunc if only that is set
+func (m *MockProjectService) UpdateProject(p *cloudstack.UpdateProjectParams) (r0 *cloudstack.UpdateProjectResponse, r1 error) {
+	m.record("UpdateProject", p)
+	if m.UpdateProjectFunc != nil {
+		return m.UpdateProjectFunc(p)
+	}
+	if m.UpdateProjectWithContextFunc != nil {
+		return m.UpdateProjectWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.UpdateProject")
+	return
+}
+
+// UpdateProjectWithContext records the call and calls UpdateProjectWithContextFunc, or UpdateProjectFunc if only that is set
+func (m *MockProjectService) UpdateProjectWithContext(ctx context.Context, p *cloudstack.UpdateProjectParams) (r0 *cloudstack.UpdateProjectResponse, r1 error) {
+	m.record("UpdateProjectWithContext", ctx, p)
+	if m.UpdateProjectWithContextFunc != nil {
+		return m.UpdateProjectWithContextFunc(ctx, p)
+	}
+	if m.UpdateProjectFunc != nil {
+		return m.UpdateProjectFunc(p)
+	}
+	r1 = notMocked("ProjectService.UpdateProjectWithContext")
+	return
+}
+
+// UpdateProjectAsync records the call and calls UpdateProjectAsyncFunc, or UpdateProjectAsyncWithContextFunc if only that is set
+func (m *MockProjectService) UpdateProjectAsync(p *cloudstack.UpdateProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateProjectAsync", p)
+	if m.UpdateProjectAsyncFunc != nil {
+		return m.UpdateProjectAsyncFunc(p)
+	}
+	if m.UpdateProjectAsyncWithContextFunc != nil {
+		return m.UpdateProjectAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.UpdateProjectAsync")
+	return
+}
+
+// UpdateProjectAsyncWithContext records the call and calls UpdateProjectAsyncWithContextFunc, or UpdateProjectAsyncFunc if only that is set
+func (m *MockProjectService) UpdateProjectAsyncWithContext(ctx context.Context, p *cloudstack.UpdateProjectParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateProjectAsyncWithContext", ctx, p)
+	if m.UpdateProjectAsyncWithContextFunc != nil {
+		return m.UpdateProjectAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateProjectAsyncFunc != nil {
+		return m.UpdateProjectAsyncFunc(p)
+	}
+	r1 = notMocked("ProjectService.UpdateProjectAsyncWithContext")
+	return
+}
+
+// NewUpdateProjectInvitationParams records the call and calls NewUpdateProjectInvitationParamsFunc
+func (m *MockProjectService) NewUpdateProjectInvitationParams(projectid string) (r0 *cloudstack.UpdateProjectInvitationParams) {
+	m.record("NewUpdateProjectInvitationParams", projectid)
+	if m.NewUpdateProjectInvitationParamsFunc != nil {
+		return m.NewUpdateProjectInvitationParamsFunc(projectid)
+	}
+	return cloudstack.NewProjectService(nil).NewUpdateProjectInvitationParams(projectid)
+}
+
+// UpdateProjectInvitation records the call and calls UpdateProjectInvitationFunc, or UpdateProjectInvitationWithContextFunc if only that is set
+func (m *MockProjectService) UpdateProjectInvitation(p *cloudstack.UpdateProjectInvitationParams) (r0 *cloudstack.UpdateProjectInvitationResponse, r1 error) {
+	m.record("UpdateProjectInvitation", p)
+	if m.UpdateProjectInvitationFunc != nil {
+		return m.UpdateProjectInvitationFunc(p)
+	}
+	if m.UpdateProjectInvitationWithContextFunc != nil {
+		return m.UpdateProjectInvitationWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.UpdateProjectInvitation")
+	return
+}
+
+// UpdateProjectInvitationWithContext records the call and calls UpdateProjectInvitationWithContextFunc, or UpdateProjectInvitationFunc if only that is set
+func (m *MockProjectService) UpdateProjectInvitationWithContext(ctx context.Context, p *cloudstack.UpdateProjectInvitationParams) (r0 *cloudstack.UpdateProjectInvitationResponse, r1 error) {
+	m.record("UpdateProjectInvitationWithContext", ctx, p)
+	if m.UpdateProjectInvitationWithContextFunc != nil {
+		return m.UpdateProjectInvitationWithContextFunc(ctx, p)
+	}
+	if m.UpdateProjectInvitationFunc != nil {
+		return m.UpdateProjectInvitationFunc(p)
+	}
+	r1 = notMocked("ProjectService.UpdateProjectInvitationWithContext")
+	return
+}
+
+// UpdateProjectInvitationAsync records the call and calls UpdateProjectInvitationAsyncFunc, or UpdateProjectInvitationAsyncWithContextFunc if only that is set
+func (m *MockProjectService) UpdateProjectInvitationAsync(p *cloudstack.UpdateProjectInvitationParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateProjectInvitationAsync", p)
+	if m.UpdateProjectInvitationAsyncFunc != nil {
+		return m.UpdateProjectInvitationAsyncFunc(p)
+	}
+	if m.UpdateProjectInvitationAsyncWithContextFunc != nil {
+		return m.UpdateProjectInvitationAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.UpdateProjectInvitationAsync")
+	return
+}
+
+// UpdateProjectInvitationAsyncWithContext records the call and calls UpdateProjectInvitationAsyncWithContextFunc, or UpdateProjectInvitationAsyncFunc if only that is set
+func (m *MockProjectService) UpdateProjectInvitationAsyncWithContext(ctx context.Context, p *cloudstack.UpdateProjectInvitationParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateProjectInvitationAsyncWithContext", ctx, p)
+	if m.UpdateProjectInvitationAsyncWithContextFunc != nil {
+		return m.UpdateProjectInvitationAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateProjectInvitationAsyncFunc != nil {
+		return m.UpdateProjectInvitationAsyncFunc(p)
+	}
+	r1 = notMocked("ProjectService.UpdateProjectInvitationAsyncWithContext")
+	return
+}
+
+// NewUpdateProjectRoleParams records the call and calls NewUpdateProjectRoleParamsFunc
+func (m *MockProjectService) NewUpdateProjectRoleParams(id string, projectid string) (r0 *cloudstack.UpdateProjectRoleParams) {
+	m.record("NewUpdateProjectRoleParams", id, projectid)
+	if m.NewUpdateProjectRoleParamsFunc != nil {
+		return m.NewUpdateProjectRoleParamsFunc(id, projectid)
+	}
+	return cloudstack.NewProjectService(nil).NewUpdateProjectRoleParams(id, projectid)
+}
+
+// UpdateProjectRole records the call and calls UpdateProjectRoleFunc, or UpdateProjectRoleWithContextFunc if only that is set
+func (m *MockProjectService) UpdateProjectRole(p *cloudstack.UpdateProjectRoleParams) (r0 *cloudstack.UpdateProjectRoleResponse, r1 error) {
+	m.record("UpdateProjectRole", p)
+	if m.UpdateProjectRoleFunc != nil {
+		return m.UpdateProjectRoleFunc(p)
+	}
+	if m.UpdateProjectRoleWithContextFunc != nil {
+		return m.UpdateProjectRoleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.UpdateProjectRole")
+	return
+}
+
+// UpdateProjectRoleWithContext records the call and calls UpdateProjectRoleWithContextFunc, or UpdateProjectRoleFunc if only that is set
+func (m *MockProjectService) UpdateProjectRoleWithContext(ctx context.Context, p *cloudstack.UpdateProjectRoleParams) (r0 *cloudstack.UpdateProjectRoleResponse, r1 error) {
+	m.record("UpdateProjectRoleWithContext", ctx, p)
+	if m.UpdateProjectRoleWithContextFunc != nil {
+		return m.UpdateProjectRoleWithContextFunc(ctx, p)
+	}
+	if m.UpdateProjectRoleFunc != nil {
+		return m.UpdateProjectRoleFunc(p)
+	}
+	r1 = notMocked("ProjectService.UpdateProjectRoleWithContext")
+	return
+}
+
+// NewUpdateProjectRolePermissionParams records the call and calls NewUpdateProjectRolePermissionParamsFunc
+func (m *MockProjectService) NewUpdateProjectRolePermissionParams(projectid string, projectroleid string) (r0 *cloudstack.UpdateProjectRolePermissionParams) {
+	m.record("NewUpdateProjectRolePermissionParams", projectid, projectroleid)
+	if m.NewUpdateProjectRolePermissionParamsFunc != nil {
+		return m.NewUpdateProjectRolePermissionParamsFunc(projectid, projectroleid)
+	}
+	return cloudstack.NewProjectService(nil).NewUpdateProjectRolePermissionParams(projectid, projectroleid)
+}
+
+// UpdateProjectRolePermission records the call and calls UpdateProjectRolePermissionFunc, or UpdateProjectRolePermissionWithContextFunc if only that is set
+func (m *MockProjectService) UpdateProjectRolePermission(p *cloudstack.UpdateProjectRolePermissionParams) (r0 *cloudstack.UpdateProjectRolePermissionResponse, r1 error) {
+	m.record("UpdateProjectRolePermission", p)
+	if m.UpdateProjectRolePermissionFunc != nil {
+		return m.UpdateProjectRolePermissionFunc(p)
+	}
+	if m.UpdateProjectRolePermissionWithContextFunc != nil {
+		return m.UpdateProjectRolePermissionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ProjectService.UpdateProjectRolePermission")
+	return
+}
+
+// UpdateProjectRolePermissionWithContext records the call and calls UpdateProjectRolePermissionWithContextFunc, or UpdateProjectRolePermissionFunc if only that is set
+func (m *MockProjectService) UpdateProjectRolePermissionWithContext(ctx context.Context, p *cloudstack.UpdateProjectRolePermissionParams) (r0 *cloudstack.UpdateProjectRolePermissionResponse, r1 error) {
+	m.record("UpdateProjectRolePermissionWithContext", ctx, p)
+	if m.UpdateProjectRolePermissionWithContextFunc != nil {
+		return m.UpdateProjectRolePermissionWithContextFunc(ctx, p)
+	}
+	if m.UpdateProjectRolePermissionFunc != nil {
+		return m.UpdateProjectRolePermissionFunc(p)
+	}
+	r1 = notMocked("ProjectService.UpdateProjectRolePermissionWithContext")
+	return
+}
@@ -0,0 +1,77 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockQuotaService is a mock of cloudstack.QuotaServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockQuotaService struct {
+	Recorder
+
+	NewQuotaIsEnabledParamsFunc   func() *cloudstack.QuotaIsEnabledParams
+	QuotaIsEnabledFunc            func(p *cloudstack.QuotaIsEnabledParams) (*cloudstack.QuotaIsEnabledResponse, error)
+	QuotaIsEnabledWithContextFunc func(ctx context.Context, p *cloudstack.QuotaIsEnabledParams) (*cloudstack.QuotaIsEnabledResponse, error)
+}
+
+var _ cloudstack.QuotaServiceIface = (*MockQuotaService)(nil)
+
+// NewQuotaIsEnabledParams records the call and calls NewQuotaIsEnabledParamsFunc
+func (m *MockQuotaService) NewQuotaIsEnabledParams() (r0 *cloudstack.QuotaIsEnabledParams) {
+	m.record("NewQuotaIsEnabledParams")
+	if m.NewQuotaIsEnabledParamsFunc != nil {
+		return m.NewQuotaIsEnabledParamsFunc()
+	}
+	return cloudstack.NewQuotaService(nil).NewQuotaIsEnabledParams()
+}
+
+// QuotaIsEnabled records the call and calls QuotaIsEnabledFunc, or QuotaIsEnabledWithContextFunc if only that is set
+func (m *MockQuotaService) QuotaIsEnabled(p *cloudstack.QuotaIsEnabledParams) (r0 *cloudstack.QuotaIsEnabledResponse, r1 error) {
+	m.record("QuotaIsEnabled", p)
+	if m.QuotaIsEnabledFunc != nil {
+		return m.QuotaIsEnabledFunc(p)
+	}
+	if m.QuotaIsEnabledWithContextFunc != nil {
+		return m.QuotaIsEnabledWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("QuotaService.QuotaIsEnabled")
+	return
+}
+
+// QuotaIsEnabledWithContext records the call and calls QuotaIsEnabledWithContextFunc, or QuotaIsEnabledFunc if only that is set
+func (m *MockQuotaService) QuotaIsEnabledWithContext(ctx context.Context, p *cloudstack.QuotaIsEnabledParams) (r0 *cloudstack.QuotaIsEnabledResponse, r1 error) {
+	m.record("QuotaIsEnabledWithContext", ctx, p)
+	if m.QuotaIsEnabledWithContextFunc != nil {
+		return m.QuotaIsEnabledWithContextFunc(ctx, p)
+	}
+	if m.QuotaIsEnabledFunc != nil {
+		return m.QuotaIsEnabledFunc(p)
+	}
+	r1 = notMocked("QuotaService.QuotaIsEnabledWithContext")
+	return
+}
@@ -0,0 +1,100 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package mocks contains generated mocks of all services of a CloudStackClient,
+// which can be used to test code using a client without talking to CloudStack.
+//
+// Every mock records all calls, and handles a call using the matching ...Func
+// field if it is set:
+//
+//	cs, m := mocks.NewMockClient()
+//	m.VirtualMachine.StopVirtualMachineFunc = func(p *cloudstack.StopVirtualMachineParams) (*cloudstack.StopVirtualMachineResponse, error) {
+//		return &cloudstack.StopVirtualMachineResponse{State: "Stopped"}, nil
+//	}
+//
+//	// Run the code under test using cs
+//
+//	calls := m.VirtualMachine.CallsTo("StopVirtualMachine")
+//
+// A method and its ...WithContext variant fall back to each other's ...Func field,
+// so setting StopVirtualMachineFunc also handles the calls to
+// StopVirtualMachineWithContext, and the other way around.
+package mocks
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotMocked is wrapped by the error returned by a mocked method without a ...Func
+var ErrNotMocked = errors.New("method is not mocked")
+
+// Call is a recorded call of a mocked method
+type Call struct {
+	Method string        // The name of the called method
+	Args   []interface{} // The arguments of the call
+}
+
+// Recorder records the calls of a mock, and is safe for concurrent use
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns all recorded calls, in the order in which they were made
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls of the named method
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Reset removes all recorded calls
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+func notMocked(method string) error {
+	return fmt.Errorf("%s: %w", method, ErrNotMocked)
+}
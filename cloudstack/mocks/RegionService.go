@@ -0,0 +1,229 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockRegionService is a mock of cloudstack.RegionServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockRegionService struct {
+	Recorder
+
+	NewAddRegionParamsFunc        func(endpoint string, id int, name string) *cloudstack.AddRegionParams
+	AddRegionFunc                 func(p *cloudstack.AddRegionParams) (*cloudstack.AddRegionResponse, error)
+	AddRegionWithContextFunc      func(ctx context.Context, p *cloudstack.AddRegionParams) (*cloudstack.AddRegionResponse, error)
+	NewListRegionsParamsFunc      func() *cloudstack.ListRegionsParams
+	ListRegionsFunc               func(p *cloudstack.ListRegionsParams) (*cloudstack.ListRegionsResponse, error)
+	ListRegionsWithContextFunc    func(ctx context.Context, p *cloudstack.ListRegionsParams) (*cloudstack.ListRegionsResponse, error)
+	NewListRegionsPagerFunc       func(p *cloudstack.ListRegionsParams) *cloudstack.ListRegionsPager
+	ListRegionsAllFunc            func(p *cloudstack.ListRegionsParams) (*cloudstack.ListRegionsResponse, error)
+	ListRegionsAllWithContextFunc func(ctx context.Context, p *cloudstack.ListRegionsParams) (*cloudstack.ListRegionsResponse, error)
+	NewRemoveRegionParamsFunc     func(id int) *cloudstack.RemoveRegionParams
+	RemoveRegionFunc              func(p *cloudstack.RemoveRegionParams) (*cloudstack.RemoveRegionResponse, error)
+	RemoveRegionWithContextFunc   func(ctx context.Context, p *cloudstack.RemoveRegionParams) (*cloudstack.RemoveRegionResponse, error)
+	NewUpdateRegionParamsFunc     func(id int) *cloudstack.UpdateRegionParams
+	UpdateRegionFunc              func(p *cloudstack.UpdateRegionParams) (*cloudstack.UpdateRegionResponse, error)
+	UpdateRegionWithContextFunc   func(ctx context.Context, p *cloudstack.UpdateRegionParams) (*cloudstack.UpdateRegionResponse, error)
+}
+
+var _ cloudstack.RegionServiceIface = (*MockRegionService)(nil)
+
+// NewAddRegionParams records the call and calls NewAddRegionParamsFunc
+func (m *MockRegionService) NewAddRegionParams(endpoint string, id int, name string) (r0 *cloudstack.AddRegionParams) {
+	m.record("NewAddRegionParams", endpoint, id, name)
+	if m.NewAddRegionParamsFunc != nil {
+		return m.NewAddRegionParamsFunc(endpoint, id, name)
+	}
+	return cloudstack.NewRegionService(nil).NewAddRegionParams(endpoint, id, name)
+}
+
+// AddRegion records the call and calls AddRegionFunc, or AddRegionWithContextFunc if only that is set
+func (m *MockRegionService) AddRegion(p *cloudstack.AddRegionParams) (r0 *cloudstack.AddRegionResponse, r1 error) {
+	m.record("AddRegion", p)
+	if m.AddRegionFunc != nil {
+		return m.AddRegionFunc(p)
+	}
+	if m.AddRegionWithContextFunc != nil {
+		return m.AddRegionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RegionService.AddRegion")
+	return
+}
+
+// AddRegionWithContext records the call and calls AddRegionWithContextFunc, or AddRegionFunc if only that is set
+func (m *MockRegionService) AddRegionWithContext(ctx context.Context, p *cloudstack.AddRegionParams) (r0 *cloudstack.AddRegionResponse, r1 error) {
+	m.record("AddRegionWithContext", ctx, p)
+	if m.AddRegionWithContextFunc != nil {
+		return m.AddRegionWithContextFunc(ctx, p)
+	}
+	if m.AddRegionFunc != nil {
+		return m.AddRegionFunc(p)
+	}
+	r1 = notMocked("RegionService.AddRegionWithContext")
+	return
+}
+
+// NewListRegionsParams records the call and calls NewListRegionsParamsFunc
+func (m *MockRegionService) NewListRegionsParams() (r0 *cloudstack.ListRegionsParams) {
+	m.record("NewListRegionsParams")
+	if m.NewListRegionsParamsFunc != nil {
+		return m.NewListRegionsParamsFunc()
+	}
+	return cloudstack.NewRegionService(nil).NewListRegionsParams()
+}
+
+// ListRegions records the call and calls ListRegionsFunc, or ListRegionsWithContextFunc if only that is set
+func (m *MockRegionService) ListRegions(p *cloudstack.ListRegionsParams) (r0 *cloudstack.ListRegionsResponse, r1 error) {
+	m.record("ListRegions", p)
+	if m.ListRegionsFunc != nil {
+		return m.ListRegionsFunc(p)
+	}
+	if m.ListRegionsWithContextFunc != nil {
+		return m.ListRegionsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RegionService.ListRegions")
+	return
+}
+
+// ListRegionsWithContext records the call and calls ListRegionsWithContextFunc, or ListRegionsFunc if only that is set
+func (m *MockRegionService) ListRegionsWithContext(ctx context.Context, p *cloudstack.ListRegionsParams) (r0 *cloudstack.ListRegionsResponse, r1 error) {
+	m.record("ListRegionsWithContext", ctx, p)
+	if m.ListRegionsWithContextFunc != nil {
+		return m.ListRegionsWithContextFunc(ctx, p)
+	}
+	if m.ListRegionsFunc != nil {
+		return m.ListRegionsFunc(p)
+	}
+	r1 = notMocked("RegionService.ListRegionsWithContext")
+	return
+}
+
+// NewListRegionsPager records the call and calls NewListRegionsPagerFunc
+func (m *MockRegionService) NewListRegionsPager(p *cloudstack.ListRegionsParams) (r0 *cloudstack.ListRegionsPager) {
+	m.record("NewListRegionsPager", p)
+	if m.NewListRegionsPagerFunc != nil {
+		return m.NewListRegionsPagerFunc(p)
+	}
+	return
+}
+
+// ListRegionsAll records the call and calls ListRegionsAllFunc, or ListRegionsAllWithContextFunc if only that is set
+func (m *MockRegionService) ListRegionsAll(p *cloudstack.ListRegionsParams) (r0 *cloudstack.ListRegionsResponse, r1 error) {
+	m.record("ListRegionsAll", p)
+	if m.ListRegionsAllFunc != nil {
+		return m.ListRegionsAllFunc(p)
+	}
+	if m.ListRegionsAllWithContextFunc != nil {
+		return m.ListRegionsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RegionService.ListRegionsAll")
+	return
+}
+
+// ListRegionsAllWithContext records the call and calls ListRegionsAllWithContextFunc, or ListRegionsAllFunc if only that is set
+func (m *MockRegionService) ListRegionsAllWithContext(ctx context.Context, p *cloudstack.ListRegionsParams) (r0 *cloudstack.ListRegionsResponse, r1 error) {
+	m.record("ListRegionsAllWithContext", ctx, p)
+	if m.ListRegionsAllWithContextFunc != nil {
+		return m.ListRegionsAllWithContextFunc(ctx, p)
+	}
+	if m.ListRegionsAllFunc != nil {
+		return m.ListRegionsAllFunc(p)
+	}
+	r1 = notMocked("RegionService.ListRegionsAllWithContext")
+	return
+}
+
+// NewRemoveRegionParams records the call and calls NewRemoveRegionParamsFunc
+func (m *MockRegionService) NewRemoveRegionParams(id int) (r0 *cloudstack.RemoveRegionParams) {
+	m.record("NewRemoveRegionParams", id)
+	if m.NewRemoveRegionParamsFunc != nil {
+		return m.NewRemoveRegionParamsFunc(id)
+	}
+	return cloudstack.NewRegionService(nil).NewRemoveRegionParams(id)
+}
+
+// RemoveRegion records the call and calls RemoveRegionFunc, or RemoveRegionWithContextFunc if only that is set
+func (m *MockRegionService) RemoveRegion(p *cloudstack.RemoveRegionParams) (r0 *cloudstack.RemoveRegionResponse, r1 error) {
+	m.record("RemoveRegion", p)
+	if m.RemoveRegionFunc != nil {
+		return m.RemoveRegionFunc(p)
+	}
+	if m.RemoveRegionWithContextFunc != nil {
+		return m.RemoveRegionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RegionService.RemoveRegion")
+	return
+}
+
+// RemoveRegionWithContext records the call and calls RemoveRegionWithContextFunc, or RemoveRegionFunc if only that is set
+func (m *MockRegionService) RemoveRegionWithContext(ctx context.Context, p *cloudstack.RemoveRegionParams) (r0 *cloudstack.RemoveRegionResponse, r1 error) {
+	m.record("RemoveRegionWithContext", ctx, p)
+	if m.RemoveRegionWithContextFunc != nil {
+		return m.RemoveRegionWithContextFunc(ctx, p)
+	}
+	if m.RemoveRegionFunc != nil {
+		return m.RemoveRegionFunc(p)
+	}
+	r1 = notMocked("RegionService.RemoveRegionWithContext")
+	return
+}
+
+// NewUpdateRegionParams records the call and calls NewUpdateRegionParamsFunc
+func (m *MockRegionService) NewUpdateRegionParams(id int) (r0 *cloudstack.UpdateRegionParams) {
+	m.record("NewUpdateRegionParams", id)
+	if m.NewUpdateRegionParamsFunc != nil {
+		return m.NewUpdateRegionParamsFunc(id)
+	}
+	return cloudstack.NewRegionService(nil).NewUpdateRegionParams(id)
+}
+
+// UpdateRegion records the call and calls UpdateRegionFunc, or UpdateRegionWithContextFunc if only that is set
+func (m *MockRegionService) UpdateRegion(p *cloudstack.UpdateRegionParams) (r0 *cloudstack.UpdateRegionResponse, r1 error) {
+	m.record("UpdateRegion", p)
+	if m.UpdateRegionFunc != nil {
+		return m.UpdateRegionFunc(p)
+	}
+	if m.UpdateRegionWithContextFunc != nil {
+		return m.UpdateRegionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RegionService.UpdateRegion")
+	return
+}
+
+// UpdateRegionWithContext records the call and calls UpdateRegionWithContextFunc, or UpdateRegionFunc if only that is set
+func (m *MockRegionService) UpdateRegionWithContext(ctx context.Context, p *cloudstack.UpdateRegionParams) (r0 *cloudstack.UpdateRegionResponse, r1 error) {
+	m.record("UpdateRegionWithContext", ctx, p)
+	if m.UpdateRegionWithContextFunc != nil {
+		return m.UpdateRegionWithContextFunc(ctx, p)
+	}
+	if m.UpdateRegionFunc != nil {
+		return m.UpdateRegionFunc(p)
+	}
+	r1 = notMocked("RegionService.UpdateRegionWithContext")
+	return
+}
@@ -0,0 +1,323 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockResourcemetadataService is a mock of cloudstack.ResourcemetadataServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockResourcemetadataService struct {
+	Recorder
+
+	NewAddResourceDetailParamsFunc           func(details map[string]string, resourceid string, resourcetype string) *cloudstack.AddResourceDetailParams
+	AddResourceDetailFunc                    func(p *cloudstack.AddResourceDetailParams) (*cloudstack.AddResourceDetailResponse, error)
+	AddResourceDetailWithContextFunc         func(ctx context.Context, p *cloudstack.AddResourceDetailParams) (*cloudstack.AddResourceDetailResponse, error)
+	AddResourceDetailAsyncFunc               func(p *cloudstack.AddResourceDetailParams) (*cloudstack.Job, error)
+	AddResourceDetailAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.AddResourceDetailParams) (*cloudstack.Job, error)
+	NewGetVolumeSnapshotDetailsParamsFunc    func(snapshotid string) *cloudstack.GetVolumeSnapshotDetailsParams
+	GetVolumeSnapshotDetailsFunc             func(p *cloudstack.GetVolumeSnapshotDetailsParams) (*cloudstack.GetVolumeSnapshotDetailsResponse, error)
+	GetVolumeSnapshotDetailsWithContextFunc  func(ctx context.Context, p *cloudstack.GetVolumeSnapshotDetailsParams) (*cloudstack.GetVolumeSnapshotDetailsResponse, error)
+	NewListDetailOptionsParamsFunc           func(resourcetype string) *cloudstack.ListDetailOptionsParams
+	ListDetailOptionsFunc                    func(p *cloudstack.ListDetailOptionsParams) (*cloudstack.ListDetailOptionsResponse, error)
+	ListDetailOptionsWithContextFunc         func(ctx context.Context, p *cloudstack.ListDetailOptionsParams) (*cloudstack.ListDetailOptionsResponse, error)
+	NewListResourceDetailsParamsFunc         func(resourcetype string) *cloudstack.ListResourceDetailsParams
+	ListResourceDetailsFunc                  func(p *cloudstack.ListResourceDetailsParams) (*cloudstack.ListResourceDetailsResponse, error)
+	ListResourceDetailsWithContextFunc       func(ctx context.Context, p *cloudstack.ListResourceDetailsParams) (*cloudstack.ListResourceDetailsResponse, error)
+	NewListResourceDetailsPagerFunc          func(p *cloudstack.ListResourceDetailsParams) *cloudstack.ListResourceDetailsPager
+	ListResourceDetailsAllFunc               func(p *cloudstack.ListResourceDetailsParams) (*cloudstack.ListResourceDetailsResponse, error)
+	ListResourceDetailsAllWithContextFunc    func(ctx context.Context, p *cloudstack.ListResourceDetailsParams) (*cloudstack.ListResourceDetailsResponse, error)
+	NewRemoveResourceDetailParamsFunc        func(resourceid string, resourcetype string) *cloudstack.RemoveResourceDetailParams
+	RemoveResourceDetailFunc                 func(p *cloudstack.RemoveResourceDetailParams) (*cloudstack.RemoveResourceDetailResponse, error)
+	RemoveResourceDetailWithContextFunc      func(ctx context.Context, p *cloudstack.RemoveResourceDetailParams) (*cloudstack.RemoveResourceDetailResponse, error)
+	RemoveResourceDetailAsyncFunc            func(p *cloudstack.RemoveResourceDetailParams) (*cloudstack.Job, error)
+	RemoveResourceDetailAsyncWithContextFunc func(ctx context.Context, p *cloudstack.RemoveResourceDetailParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.ResourcemetadataServiceIface = (*MockResourcemetadataService)(nil)
+
+// NewAddResourceDetailParams records the call and calls NewAddResourceDetailParamsFunc
+func (m *MockResourcemetadataService) NewAddResourceDetailParams(details map[string]string, resourceid string, resourcetype string) (r0 *cloudstack.AddResourceDetailParams) {
+	m.record("NewAddResourceDetailParams", details, resourceid, resourcetype)
+	if m.NewAddResourceDetailParamsFunc != nil {
+		return m.NewAddResourceDetailParamsFunc(details, resourceid, resourcetype)
+	}
+	return cloudstack.NewResourcemetadataService(nil).NewAddResourceDetailParams(details, resourceid, resourcetype)
+}
+
+// AddResourceDetail records the call and calls AddResourceDetailFunc, or AddResourceDetailWithContextFunc if only that is set
+func (m *MockResourcemetadataService) AddResourceDetail(p *cloudstack.AddResourceDetailParams) (r0 *cloudstack.AddResourceDetailResponse, r1 error) {
+	m.record("AddResourceDetail", p)
+	if m.AddResourceDetailFunc != nil {
+		return m.AddResourceDetailFunc(p)
+	}
+	if m.AddResourceDetailWithContextFunc != nil {
+		return m.AddResourceDetailWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcemetadataService.AddResourceDetail")
+	return
+}
+
+// AddResourceDetailWithContext records the call and calls AddResourceDetailWithContextFunc, or AddResourceDetailFunc if only that is set
+func (m *MockResourcemetadataService) AddResourceDetailWithContext(ctx context.Context, p *cloudstack.AddResourceDetailParams) (r0 *cloudstack.AddResourceDetailResponse, r1 error) {
+	m.record("AddResourceDetailWithContext", ctx, p)
+	if m.AddResourceDetailWithContextFunc != nil {
+		return m.AddResourceDetailWithContextFunc(ctx, p)
+	}
+	if m.AddResourceDetailFunc != nil {
+		return m.AddResourceDetailFunc(p)
+	}
+	r1 = notMocked("ResourcemetadataService.AddResourceDetailWithContext")
+	return
+}
+
+// AddResourceDetailAsync records the call and calls AddResourceDetailAsyncFunc, or AddResourceDetailAsyncWithContextFunc if only that is set
+func (m *MockResourcemetadataService) AddResourceDetailAsync(p *cloudstack.AddResourceDetailParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddResourceDetailAsync", p)
+	if m.AddResourceDetailAsyncFunc != nil {
+		return m.AddResourceDetailAsyncFunc(p)
+	}
+	if m.AddResourceDetailAsyncWithContextFunc != nil {
+		return m.AddResourceDetailAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcemetadataService.AddResourceDetailAsync")
+	return
+}
+
+// AddResourceDetailAsyncWithContext records the call and calls AddResourceDetailAsyncWithContextFunc, or AddResourceDetailAsyncFunc if only that is set
+func (m *MockResourcemetadataService) AddResourceDetailAsyncWithContext(ctx context.Context, p *cloudstack.AddResourceDetailParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddResourceDetailAsyncWithContext", ctx, p)
+	if m.AddResourceDetailAsyncWithContextFunc != nil {
+		return m.AddResourceDetailAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddResourceDetailAsyncFunc != nil {
+		return m.AddResourceDetailAsyncFunc(p)
+	}
+	r1 = notMocked("ResourcemetadataService.AddResourceDetailAsyncWithContext")
+	return
+}
+
+// NewGetVolumeSnapshotDetailsParams records the call and calls NewGetVolumeSnapshotDetailsParamsFunc
+func (m *MockResourcemetadataService) NewGetVolumeSnapshotDetailsParams(snapshotid string) (r0 *cloudstack.GetVolumeSnapshotDetailsParams) {
+	m.record("NewGetVolumeSnapshotDetailsParams", snapshotid)
+	if m.NewGetVolumeSnapshotDetailsParamsFunc != nil {
+		return m.NewGetVolumeSnapshotDetailsParamsFunc(snapshotid)
+	}
+	return cloudstack.NewResourcemetadataService(nil).NewGetVolumeSnapshotDetailsParams(snapshotid)
+}
+
+// GetVolumeSnapshotDetails records the call and calls GetVolumeSnapshotDetailsFunc, or GetVolumeSnapshotDetailsWithContextFunc if only that is set
+func (m *MockResourcemetadataService) GetVolumeSnapshotDetails(p *cloudstack.GetVolumeSnapshotDetailsParams) (r0 *cloudstack.GetVolumeSnapshotDetailsResponse, r1 error) {
+	m.record("GetVolumeSnapshotDetails", p)
+	if m.GetVolumeSnapshotDetailsFunc != nil {
+		return m.GetVolumeSnapshotDetailsFunc(p)
+	}
+	if m.GetVolumeSnapshotDetailsWithContextFunc != nil {
+		return m.GetVolumeSnapshotDetailsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcemetadataService.GetVolumeSnapshotDetails")
+	return
+}
+
+// GetVolumeSnapshotDetailsWithContext records the call and calls GetVolumeSnapshotDetailsWithContextFunc, or GetVolumeSnapshotDetailsFunc if only that is set
+func (m *MockResourcemetadataService) GetVolumeSnapshotDetailsWithContext(ctx context.Context, p *cloudstack.GetVolumeSnapshotDetailsParams) (r0 *cloudstack.GetVolumeSnapshotDetailsResponse, r1 error) {
+	m.record("GetVolumeSnapshotDetailsWithContext", ctx, p)
+	if m.GetVolumeSnapshotDetailsWithContextFunc != nil {
+		return m.GetVolumeSnapshotDetailsWithContextFunc(ctx, p)
+	}
+	if m.GetVolumeSnapshotDetailsFunc != nil {
+		return m.GetVolumeSnapshotDetailsFunc(p)
+	}
+	r1 = notMocked("ResourcemetadataService.GetVolumeSnapshotDetailsWithContext")
+	return
+}
+
+// NewListDetailOptionsParams records the call and calls NewListDetailOptionsParamsFunc
+func (m *MockResourcemetadataService) NewListDetailOptionsParams(resourcetype string) (r0 *cloudstack.ListDetailOptionsParams) {
+	m.record("NewListDetailOptionsParams", resourcetype)
+	if m.NewListDetailOptionsParamsFunc != nil {
+		return m.NewListDetailOptionsParamsFunc(resourcetype)
+	}
+	return cloudstack.NewResourcemetadataService(nil).NewListDetailOptionsParams(resourcetype)
+}
+
+// ListDetailOptions records the call and calls ListDetailOptionsFunc, or ListDetailOptionsWithContextFunc if only that is set
+func (m *MockResourcemetadataService) ListDetailOptions(p *cloudstack.ListDetailOptionsParams) (r0 *cloudstack.ListDetailOptionsResponse, r1 error) {
+	m.record("ListDetailOptions", p)
+	if m.ListDetailOptionsFunc != nil {
+		return m.ListDetailOptionsFunc(p)
+	}
+	if m.ListDetailOptionsWithContextFunc != nil {
+		return m.ListDetailOptionsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcemetadataService.ListDetailOptions")
+	return
+}
+
+// ListDetailOptionsWithContext records the call and calls ListDetailOptionsWithContextFunc, or ListDetailOptionsFunc if only that is set
+func (m *MockResourcemetadataService) ListDetailOptionsWithContext(ctx context.Context, p *cloudstack.ListDetailOptionsParams) (r0 *cloudstack.ListDetailOptionsResponse, r1 error) {
+	m.record("ListDetailOptionsWithContext", ctx, p)
+	if m.ListDetailOptionsWithContextFunc != nil {
+		return m.ListDetailOptionsWithContextFunc(ctx, p)
+	}
+	if m.ListDetailOptionsFunc != nil {
+		return m.ListDetailOptionsFunc(p)
+	}
+	r1 = notMocked("ResourcemetadataService.ListDetailOptionsWithContext")
+	return
+}
+
+// NewListResourceDetailsParams records the call and calls NewListResourceDetailsParamsFunc
+func (m *MockResourcemetadataService) NewListResourceDetailsParams(resourcetype string) (r0 *cloudstack.ListResourceDetailsParams) {
+	m.record("NewListResourceDetailsParams", resourcetype)
+	if m.NewListResourceDetailsParamsFunc != nil {
+		return m.NewListResourceDetailsParamsFunc(resourcetype)
+	}
+	return cloudstack.NewResourcemetadataService(nil).NewListResourceDetailsParams(resourcetype)
+}
+
+// ListResourceDetails records the call and calls ListResourceDetailsFunc, or ListResourceDetailsWithContextFunc if only that is set
+func (m *MockResourcemetadataService) ListResourceDetails(p *cloudstack.ListResourceDetailsParams) (r0 *cloudstack.ListResourceDetailsResponse, r1 error) {
+	m.record("ListResourceDetails", p)
+	if m.ListResourceDetailsFunc != nil {
+		return m.ListResourceDetailsFunc(p)
+	}
+	if m.ListResourceDetailsWithContextFunc != nil {
+		return m.ListResourceDetailsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcemetadataService.ListResourceDetails")
+	return
+}
+
+// ListResourceDetailsWithContext records the call and calls ListResourceDetailsWithContextFunc, or ListResourceDetailsFunc if only that is set
+func (m *MockResourcemetadataService) ListResourceDetailsWithContext(ctx context.Context, p *cloudstack.ListResourceDetailsParams) (r0 *cloudstack.ListResourceDetailsResponse, r1 error) {
+	m.record("ListResourceDetailsWithContext", ctx, p)
+	if m.ListResourceDetailsWithContextFunc != nil {
+		return m.ListResourceDetailsWithContextFunc(ctx, p)
+	}
+	if m.ListResourceDetailsFunc != nil {
+		return m.ListResourceDetailsFunc(p)
+	}
+	r1 = notMocked("ResourcemetadataService.ListResourceDetailsWithContext")
+	return
+}
+
+// NewListResourceDetailsPager records the call and calls NewListResourceDetailsPagerFunc
+func (m *MockResourcemetadataService) NewListResourceDetailsPager(p *cloudstack.ListResourceDetailsParams) (r0 *cloudstack.ListResourceDetailsPager) {
+	m.record("NewListResourceDetailsPager", p)
+	if m.NewListResourceDetailsPagerFunc != nil {
+		return m.NewListResourceDetailsPagerFunc(p)
+	}
+	return
+}
+
+// ListResourceDetailsAll records the call and calls ListResourceDetailsAllFunc, or ListResourceDetailsAllWithContextFunc if only that is set
+func (m *MockResourcemetadataService) ListResourceDetailsAll(p *cloudstack.ListResourceDetailsParams) (r0 *cloudstack.ListResourceDetailsResponse, r1 error) {
+	m.record("ListResourceDetailsAll", p)
+	if m.ListResourceDetailsAllFunc != nil {
+		return m.ListResourceDetailsAllFunc(p)
+	}
+	if m.ListResourceDetailsAllWithContextFunc != nil {
+		return m.ListResourceDetailsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcemetadataService.ListResourceDetailsAll")
+	return
+}
+
+// ListResourceDetailsAllWithContext records the call and calls ListResourceDetailsAllWithContextFunc, or ListResourceDetailsAllFunc if only that is set
+func (m *MockResourcemetadataService) ListResourceDetailsAllWithContext(ctx context.Context, p *cloudstack.ListResourceDetailsParams) (r0 *cloudstack.ListResourceDetailsResponse, r1 error) {
+	m.record("ListResourceDetailsAllWithContext", ctx, p)
+	if m.ListResourceDetailsAllWithContextFunc != nil {
+		return m.ListResourceDetailsAllWithContextFunc(ctx, p)
+	}
+	if m.ListResourceDetailsAllFunc != nil {
+		return m.ListResourceDetailsAllFunc(p)
+	}
+	r1 = notMocked("ResourcemetadataService.ListResourceDetailsAllWithContext")
+	return
+}
+
+// NewRemoveResourceDetailParams records the call and calls NewRemoveResourceDetailParamsFunc
+func (m *MockResourcemetadataService) NewRemoveResourceDetailParams(resourceid string, resourcetype string) (r0 *cloudstack.RemoveResourceDetailParams) {
+	m.record("NewRemoveResourceDetailParams", resourceid, resourcetype)
+	if m.NewRemoveResourceDetailParamsFunc != nil {
+		return m.NewRemoveResourceDetailParamsFunc(resourceid, resourcetype)
+	}
+	return cloudstack.NewResourcemetadataService(nil).NewRemoveResourceDetailParams(resourceid, resourcetype)
+}
+
+// RemoveResourceDetail records the call and calls RemoveResourceDetailFunc, or RemoveResourceDetailWithContextFunc if only that is set
+func (m *MockResourcemetadataService) RemoveResourceDetail(p *cloudstack.RemoveResourceDetailParams) (r0 *cloudstack.RemoveResourceDetailResponse, r1 error) {
+	m.record("RemoveResourceDetail", p)
+	if m.RemoveResourceDetailFunc != nil {
+		return m.RemoveResourceDetailFunc(p)
+	}
+	if m.RemoveResourceDetailWithContextFunc != nil {
+		return m.RemoveResourceDetailWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcemetadataService.RemoveResourceDetail")
+	return
+}
+
+// RemoveResourceDetailWithContext records the call and calls RemoveResourceDetailWithContextFunc, or RemoveResourceDetailFunc if only that is set
+func (m *MockResourcemetadataService) RemoveResourceDetailWithContext(ctx context.Context, p *cloudstack.RemoveResourceDetailParams) (r0 *cloudstack.RemoveResourceDetailResponse, r1 error) {
+	m.record("RemoveResourceDetailWithContext", ctx, p)
+	if m.RemoveResourceDetailWithContextFunc != nil {
+		return m.RemoveResourceDetailWithContextFunc(ctx, p)
+	}
+	if m.RemoveResourceDetailFunc != nil {
+		return m.RemoveResourceDetailFunc(p)
+	}
+	r1 = notMocked("ResourcemetadataService.RemoveResourceDetailWithContext")
+	return
+}
+
+// RemoveResourceDetailAsync records the call and calls RemoveResourceDetailAsyncFunc, or RemoveResourceDetailAsyncWithContextFunc if only that is set
+func (m *MockResourcemetadataService) RemoveResourceDetailAsync(p *cloudstack.RemoveResourceDetailParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveResourceDetailAsync", p)
+	if m.RemoveResourceDetailAsyncFunc != nil {
+		return m.RemoveResourceDetailAsyncFunc(p)
+	}
+	if m.RemoveResourceDetailAsyncWithContextFunc != nil {
+		return m.RemoveResourceDetailAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcemetadataService.RemoveResourceDetailAsync")
+	return
+}
+
+// RemoveResourceDetailAsyncWithContext records the call and calls RemoveResourceDetailAsyncWithContextFunc, or RemoveResourceDetailAsyncFunc if only that is set
+func (m *MockResourcemetadataService) RemoveResourceDetailAsyncWithContext(ctx context.Context, p *cloudstack.RemoveResourceDetailParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveResourceDetailAsyncWithContext", ctx, p)
+	if m.RemoveResourceDetailAsyncWithContextFunc != nil {
+		return m.RemoveResourceDetailAsyncWithContextFunc(ctx, p)
+	}
+	if m.RemoveResourceDetailAsyncFunc != nil {
+		return m.RemoveResourceDetailAsyncFunc(p)
+	}
+	r1 = notMocked("ResourcemetadataService.RemoveResourceDetailAsyncWithContext")
+	return
+}
@@ -0,0 +1,351 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockResourcetagsService is a mock of cloudstack.ResourcetagsServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockResourcetagsService struct {
+	Recorder
+
+	NewCreateTagsParamsFunc           func(resourceids []string, resourcetype string, tags map[string]string) *cloudstack.CreateTagsParams
+	CreateTagsFunc                    func(p *cloudstack.CreateTagsParams) (*cloudstack.CreateTagsResponse, error)
+	CreateTagsWithContextFunc         func(ctx context.Context, p *cloudstack.CreateTagsParams) (*cloudstack.CreateTagsResponse, error)
+	CreateTagsAsyncFunc               func(p *cloudstack.CreateTagsParams) (*cloudstack.Job, error)
+	CreateTagsAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.CreateTagsParams) (*cloudstack.Job, error)
+	NewDeleteTagsParamsFunc           func(resourceids []string, resourcetype string) *cloudstack.DeleteTagsParams
+	DeleteTagsFunc                    func(p *cloudstack.DeleteTagsParams) (*cloudstack.DeleteTagsResponse, error)
+	DeleteTagsWithContextFunc         func(ctx context.Context, p *cloudstack.DeleteTagsParams) (*cloudstack.DeleteTagsResponse, error)
+	DeleteTagsAsyncFunc               func(p *cloudstack.DeleteTagsParams) (*cloudstack.Job, error)
+	DeleteTagsAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.DeleteTagsParams) (*cloudstack.Job, error)
+	NewListStorageTagsParamsFunc      func() *cloudstack.ListStorageTagsParams
+	GetStorageTagIDFunc               func(keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetStorageTagIDWithContextFunc    func(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListStorageTagsFunc               func(p *cloudstack.ListStorageTagsParams) (*cloudstack.ListStorageTagsResponse, error)
+	ListStorageTagsWithContextFunc    func(ctx context.Context, p *cloudstack.ListStorageTagsParams) (*cloudstack.ListStorageTagsResponse, error)
+	NewListStorageTagsPagerFunc       func(p *cloudstack.ListStorageTagsParams) *cloudstack.ListStorageTagsPager
+	ListStorageTagsAllFunc            func(p *cloudstack.ListStorageTagsParams) (*cloudstack.ListStorageTagsResponse, error)
+	ListStorageTagsAllWithContextFunc func(ctx context.Context, p *cloudstack.ListStorageTagsParams) (*cloudstack.ListStorageTagsResponse, error)
+	NewListTagsParamsFunc             func() *cloudstack.ListTagsParams
+	ListTagsFunc                      func(p *cloudstack.ListTagsParams) (*cloudstack.ListTagsResponse, error)
+	ListTagsWithContextFunc           func(ctx context.Context, p *cloudstack.ListTagsParams) (*cloudstack.ListTagsResponse, error)
+	NewListTagsPagerFunc              func(p *cloudstack.ListTagsParams) *cloudstack.ListTagsPager
+	ListTagsAllFunc                   func(p *cloudstack.ListTagsParams) (*cloudstack.ListTagsResponse, error)
+	ListTagsAllWithContextFunc        func(ctx context.Context, p *cloudstack.ListTagsParams) (*cloudstack.ListTagsResponse, error)
+}
+
+var _ cloudstack.ResourcetagsServiceIface = (*MockResourcetagsService)(nil)
+
+// NewCreateTagsParams records the call and calls NewCreateTagsParamsFunc
+func (m *MockResourcetagsService) NewCreateTagsParams(resourceids []string, resourcetype string, tags map[string]string) (r0 *cloudstack.CreateTagsParams) {
+	m.record("NewCreateTagsParams", resourceids, resourcetype, tags)
+	if m.NewCreateTagsParamsFunc != nil {
+		return m.NewCreateTagsParamsFunc(resourceids, resourcetype, tags)
+	}
+	return cloudstack.NewResourcetagsService(nil).NewCreateTagsParams(resourceids, resourcetype, tags)
+}
+
+// CreateTags records the call and calls CreateTagsFunc, or CreateTagsWithContextFunc if only that is set
+func (m *MockResourcetagsService) CreateTags(p *cloudstack.CreateTagsParams) (r0 *cloudstack.CreateTagsResponse, r1 error) {
+	m.record("CreateTags", p)
+	if m.CreateTagsFunc != nil {
+		return m.CreateTagsFunc(p)
+	}
+	if m.CreateTagsWithContextFunc != nil {
+		return m.CreateTagsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcetagsService.CreateTags")
+	return
+}
+
+// CreateTagsWithContext records the call and calls CreateTagsWithContextFunc, or CreateTagsFunc if only that is set
+func (m *MockResourcetagsService) CreateTagsWithContext(ctx context.Context, p *cloudstack.CreateTagsParams) (r0 *cloudstack.CreateTagsResponse, r1 error) {
+	m.record("CreateTagsWithContext", ctx, p)
+	if m.CreateTagsWithContextFunc != nil {
+		return m.CreateTagsWithContextFunc(ctx, p)
+	}
+	if m.CreateTagsFunc != nil {
+		return m.CreateTagsFunc(p)
+	}
+	r1 = notMocked("ResourcetagsService.CreateTagsWithContext")
+	return
+}
+
+// CreateTagsAsync records the call and calls CreateTagsAsyncFunc, or CreateTagsAsyncWithContextFunc if only that is set
+func (m *MockResourcetagsService) CreateTagsAsync(p *cloudstack.CreateTagsParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateTagsAsync", p)
+	if m.CreateTagsAsyncFunc != nil {
+		return m.CreateTagsAsyncFunc(p)
+	}
+	if m.CreateTagsAsyncWithContextFunc != nil {
+		return m.CreateTagsAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcetagsService.CreateTagsAsync")
+	return
+}
+
+// CreateTagsAsyncWithContext records the call and calls CreateTagsAsyncWithContextFunc, or CreateTagsAsyncFunc if only that is set
+func (m *MockResourcetagsService) CreateTagsAsyncWithContext(ctx context.Context, p *cloudstack.CreateTagsParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateTagsAsyncWithContext", ctx, p)
+	if m.CreateTagsAsyncWithContextFunc != nil {
+		return m.CreateTagsAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateTagsAsyncFunc != nil {
+		return m.CreateTagsAsyncFunc(p)
+	}
+	r1 = notMocked("ResourcetagsService.CreateTagsAsyncWithContext")
+	return
+}
+
+// NewDeleteTagsParams records the call and calls NewDeleteTagsParamsFunc
+func (m *MockResourcetagsService) NewDeleteTagsParams(resourceids []string, resourcetype string) (r0 *cloudstack.DeleteTagsParams) {
+	m.record("NewDeleteTagsParams", resourceids, resourcetype)
+	if m.NewDeleteTagsParamsFunc != nil {
+		return m.NewDeleteTagsParamsFunc(resourceids, resourcetype)
+	}
+	return cloudstack.NewResourcetagsService(nil).NewDeleteTagsParams(resourceids, resourcetype)
+}
+
+// DeleteTags records the call and calls DeleteTagsFunc, or DeleteTagsWithContextFunc if only that is set
+func (m *MockResourcetagsService) DeleteTags(p *cloudstack.DeleteTagsParams) (r0 *cloudstack.DeleteTagsResponse, r1 error) {
+	m.record("DeleteTags", p)
+	if m.DeleteTagsFunc != nil {
+		return m.DeleteTagsFunc(p)
+	}
+	if m.DeleteTagsWithContextFunc != nil {
+		return m.DeleteTagsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcetagsService.DeleteTags")
+	return
+}
+
+// DeleteTagsWithContext records the call and calls DeleteTagsWithContextFunc, or DeleteTagsFunc if only that is set
+func (m *MockResourcetagsService) DeleteTagsWithContext(ctx context.Context, p *cloudstack.DeleteTagsParams) (r0 *cloudstack.DeleteTagsResponse, r1 error) {
+	m.record("DeleteTagsWithContext", ctx, p)
+	if m.DeleteTagsWithContextFunc != nil {
+		return m.DeleteTagsWithContextFunc(ctx, p)
+	}
+	if m.DeleteTagsFunc != nil {
+		return m.DeleteTagsFunc(p)
+	}
+	r1 = notMocked("ResourcetagsService.DeleteTagsWithContext")
+	return
+}
+
+// DeleteTagsAsync records the call and calls DeleteTagsAsyncFunc, or DeleteTagsAsyncWithContextFunc if only that is set
+func (m *MockResourcetagsService) DeleteTagsAsync(p *cloudstack.DeleteTagsParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteTagsAsync", p)
+	if m.DeleteTagsAsyncFunc != nil {
+		return m.DeleteTagsAsyncFunc(p)
+	}
+	if m.DeleteTagsAsyncWithContextFunc != nil {
+		return m.DeleteTagsAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcetagsService.DeleteTagsAsync")
+	return
+}
+
+// DeleteTagsAsyncWithContext records the call and calls DeleteTagsAsyncWithContextFunc, or DeleteTagsAsyncFunc if only that is set
+func (m *MockResourcetagsService) DeleteTagsAsyncWithContext(ctx context.Context, p *cloudstack.DeleteTagsParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteTagsAsyncWithContext", ctx, p)
+	if m.DeleteTagsAsyncWithContextFunc != nil {
+		return m.DeleteTagsAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteTagsAsyncFunc != nil {
+		return m.DeleteTagsAsyncFunc(p)
+	}
+	r1 = notMocked("ResourcetagsService.DeleteTagsAsyncWithContext")
+	return
+}
+
+// NewListStorageTagsParams records the call and calls NewListStorageTagsParamsFunc
+func (m *MockResourcetagsService) NewListStorageTagsParams() (r0 *cloudstack.ListStorageTagsParams) {
+	m.record("NewListStorageTagsParams")
+	if m.NewListStorageTagsParamsFunc != nil {
+		return m.NewListStorageTagsParamsFunc()
+	}
+	return cloudstack.NewResourcetagsService(nil).NewListStorageTagsParams()
+}
+
+// GetStorageTagID records the call and calls GetStorageTagIDFunc, or GetStorageTagIDWithContextFunc if only that is set
+func (m *MockResourcetagsService) GetStorageTagID(keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetStorageTagID", keyword, opts)
+	if m.GetStorageTagIDFunc != nil {
+		return m.GetStorageTagIDFunc(keyword, opts...)
+	}
+	if m.GetStorageTagIDWithContextFunc != nil {
+		return m.GetStorageTagIDWithContextFunc(context.Background(), keyword, opts...)
+	}
+	r2 = notMocked("ResourcetagsService.GetStorageTagID")
+	return
+}
+
+// GetStorageTagIDWithContext records the call and calls GetStorageTagIDWithContextFunc, or GetStorageTagIDFunc if only that is set
+func (m *MockResourcetagsService) GetStorageTagIDWithContext(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetStorageTagIDWithContext", ctx, keyword, opts)
+	if m.GetStorageTagIDWithContextFunc != nil {
+		return m.GetStorageTagIDWithContextFunc(ctx, keyword, opts...)
+	}
+	if m.GetStorageTagIDFunc != nil {
+		return m.GetStorageTagIDFunc(keyword, opts...)
+	}
+	r2 = notMocked("ResourcetagsService.GetStorageTagIDWithContext")
+	return
+}
+
+// ListStorageTags records the call and calls ListStorageTagsFunc, or ListStorageTagsWithContextFunc if only that is set
+func (m *MockResourcetagsService) ListStorageTags(p *cloudstack.ListStorageTagsParams) (r0 *cloudstack.ListStorageTagsResponse, r1 error) {
+	m.record("ListStorageTags", p)
+	if m.ListStorageTagsFunc != nil {
+		return m.ListStorageTagsFunc(p)
+	}
+	if m.ListStorageTagsWithContextFunc != nil {
+		return m.ListStorageTagsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcetagsService.ListStorageTags")
+	return
+}
+
+// ListStorageTagsWithContext records the call and calls ListStorageTagsWithContextFunc, or ListStorageTagsFunc if only that is set
+func (m *MockResourcetagsService) ListStorageTagsWithContext(ctx context.Context, p *cloudstack.ListStorageTagsParams) (r0 *cloudstack.ListStorageTagsResponse, r1 error) {
+	m.record("ListStorageTagsWithContext", ctx, p)
+	if m.ListStorageTagsWithContextFunc != nil {
+		return m.ListStorageTagsWithContextFunc(ctx, p)
+	}
+	if m.ListStorageTagsFunc != nil {
+		return m.ListStorageTagsFunc(p)
+	}
+	r1 = notMocked("ResourcetagsService.ListStorageTagsWithContext")
+	return
+}
+
+// NewListStorageTagsPager records the call and calls NewListStorageTagsPagerFunc
+func (m *MockResourcetagsService) NewListStorageTagsPager(p *cloudstack.ListStorageTagsParams) (r0 *cloudstack.ListStorageTagsPager) {
+	m.record("NewListStorageTagsPager", p)
+	if m.NewListStorageTagsPagerFunc != nil {
+		return m.NewListStorageTagsPagerFunc(p)
+	}
+	return
+}
+
+// ListStorageTagsAll records the call and calls ListStorageTagsAllFunc, or ListStorageTagsAllWithContextFunc if only that is set
+func (m *MockResourcetagsService) ListStorageTagsAll(p *cloudstack.ListStorageTagsParams) (r0 *cloudstack.ListStorageTagsResponse, r1 error) {
+	m.record("ListStorageTagsAll", p)
+	if m.ListStorageTagsAllFunc != nil {
+		return m.ListStorageTagsAllFunc(p)
+	}
+	if m.ListStorageTagsAllWithContextFunc != nil {
+		return m.ListStorageTagsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcetagsService.ListStorageTagsAll")
+	return
+}
+
+// ListStorageTagsAllWithContext records the call and calls ListStorageTagsAllWithContextFunc, or ListStorageTagsAllFunc if only that is set
+func (m *MockResourcetagsService) ListStorageTagsAllWithContext(ctx context.Context, p *cloudstack.ListStorageTagsParams) (r0 *cloudstack.ListStorageTagsResponse, r1 error) {
+	m.record("ListStorageTagsAllWithContext", ctx, p)
+	if m.ListStorageTagsAllWithContextFunc != nil {
+		return m.ListStorageTagsAllWithContextFunc(ctx, p)
+	}
+	if m.ListStorageTagsAllFunc != nil {
+		return m.ListStorageTagsAllFunc(p)
+	}
+	r1 = notMocked("ResourcetagsService.ListStorageTagsAllWithContext")
+	return
+}
+
+// NewListTagsParams records the call and calls NewListTagsParamsFunc
+func (m *MockResourcetagsService) NewListTagsParams() (r0 *cloudstack.ListTagsParams) {
+	m.record("NewListTagsParams")
+	if m.NewListTagsParamsFunc != nil {
+		return m.NewListTagsParamsFunc()
+	}
+	return cloudstack.NewResourcetagsService(nil).NewListTagsParams()
+}
+
+// ListTags records the call and calls ListTagsFunc, or ListTagsWithContextFunc if only that is set
+func (m *MockResourcetagsService) ListTags(p *cloudstack.ListTagsParams) (r0 *cloudstack.ListTagsResponse, r1 error) {
+	m.record("ListTags", p)
+	if m.ListTagsFunc != nil {
+		return m.ListTagsFunc(p)
+	}
+	if m.ListTagsWithContextFunc != nil {
+		return m.ListTagsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcetagsService.ListTags")
+	return
+}
+
+// ListTagsWithContext records the call and calls ListTagsWithContextFunc, or ListTagsFunc if only that is set
+func (m *MockResourcetagsService) ListTagsWithContext(ctx context.Context, p *cloudstack.ListTagsParams) (r0 *cloudstack.ListTagsResponse, r1 error) {
+	m.record("ListTagsWithContext", ctx, p)
+	if m.ListTagsWithContextFunc != nil {
+		return m.ListTagsWithContextFunc(ctx, p)
+	}
+	if m.ListTagsFunc != nil {
+		return m.ListTagsFunc(p)
+	}
+	r1 = notMocked("ResourcetagsService.ListTagsWithContext")
+	return
+}
+
+// NewListTagsPager records the call and calls NewListTagsPagerFunc
+func (m *MockResourcetagsService) NewListTagsPager(p *cloudstack.ListTagsParams) (r0 *cloudstack.ListTagsPager) {
+	m.record("NewListTagsPager", p)
+	if m.NewListTagsPagerFunc != nil {
+		return m.NewListTagsPagerFunc(p)
+	}
+	return
+}
+
+// ListTagsAll records the call and calls ListTagsAllFunc, or ListTagsAllWithContextFunc if only that is set
+func (m *MockResourcetagsService) ListTagsAll(p *cloudstack.ListTagsParams) (r0 *cloudstack.ListTagsResponse, r1 error) {
+	m.record("ListTagsAll", p)
+	if m.ListTagsAllFunc != nil {
+		return m.ListTagsAllFunc(p)
+	}
+	if m.ListTagsAllWithContextFunc != nil {
+		return m.ListTagsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ResourcetagsService.ListTagsAll")
+	return
+}
+
+// ListTagsAllWithContext records the call and calls ListTagsAllWithContextFunc, or ListTagsAllFunc if only that is set
+func (m *MockResourcetagsService) ListTagsAllWithContext(ctx context.Context, p *cloudstack.ListTagsParams) (r0 *cloudstack.ListTagsResponse, r1 error) {
+	m.record("ListTagsAllWithContext", ctx, p)
+	if m.ListTagsAllWithContextFunc != nil {
+		return m.ListTagsAllWithContextFunc(ctx, p)
+	}
+	if m.ListTagsAllFunc != nil {
+		return m.ListTagsAllFunc(p)
+	}
+	r1 = notMocked("ResourcetagsService.ListTagsAllWithContext")
+	return
+}
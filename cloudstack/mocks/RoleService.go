@@ -0,0 +1,503 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockRoleService is a mock of cloudstack.RoleServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockRoleService struct {
+	Recorder
+
+	NewCreateRoleParamsFunc             func(name string) *cloudstack.CreateRoleParams
+	CreateRoleFunc                      func(p *cloudstack.CreateRoleParams) (*cloudstack.CreateRoleResponse, error)
+	CreateRoleWithContextFunc           func(ctx context.Context, p *cloudstack.CreateRoleParams) (*cloudstack.CreateRoleResponse, error)
+	NewCreateRolePermissionParamsFunc   func(permission string, roleid string, rule string) *cloudstack.CreateRolePermissionParams
+	CreateRolePermissionFunc            func(p *cloudstack.CreateRolePermissionParams) (*cloudstack.CreateRolePermissionResponse, error)
+	CreateRolePermissionWithContextFunc func(ctx context.Context, p *cloudstack.CreateRolePermissionParams) (*cloudstack.CreateRolePermissionResponse, error)
+	NewDeleteRoleParamsFunc             func(id string) *cloudstack.DeleteRoleParams
+	DeleteRoleFunc                      func(p *cloudstack.DeleteRoleParams) (*cloudstack.DeleteRoleResponse, error)
+	DeleteRoleWithContextFunc           func(ctx context.Context, p *cloudstack.DeleteRoleParams) (*cloudstack.DeleteRoleResponse, error)
+	NewDeleteRolePermissionParamsFunc   func(id string) *cloudstack.DeleteRolePermissionParams
+	DeleteRolePermissionFunc            func(p *cloudstack.DeleteRolePermissionParams) (*cloudstack.DeleteRolePermissionResponse, error)
+	DeleteRolePermissionWithContextFunc func(ctx context.Context, p *cloudstack.DeleteRolePermissionParams) (*cloudstack.DeleteRolePermissionResponse, error)
+	NewImportRoleParamsFunc             func(name string, rules []cloudstack.ImportRoleRule) *cloudstack.ImportRoleParams
+	ImportRoleFunc                      func(p *cloudstack.ImportRoleParams) (*cloudstack.ImportRoleResponse, error)
+	ImportRoleWithContextFunc           func(ctx context.Context, p *cloudstack.ImportRoleParams) (*cloudstack.ImportRoleResponse, error)
+	NewListRolePermissionsParamsFunc    func() *cloudstack.ListRolePermissionsParams
+	ListRolePermissionsFunc             func(p *cloudstack.ListRolePermissionsParams) (*cloudstack.ListRolePermissionsResponse, error)
+	ListRolePermissionsWithContextFunc  func(ctx context.Context, p *cloudstack.ListRolePermissionsParams) (*cloudstack.ListRolePermissionsResponse, error)
+	NewListRolesParamsFunc              func() *cloudstack.ListRolesParams
+	GetRoleIDFunc                       func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetRoleIDWithContextFunc            func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetRoleByNameFunc                   func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Role, int, error)
+	GetRoleByNameWithContextFunc        func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.Role, int, error)
+	GetRoleByIDFunc                     func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Role, int, error)
+	GetRoleByIDWithContextFunc          func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Role, int, error)
+	ListRolesFunc                       func(p *cloudstack.ListRolesParams) (*cloudstack.ListRolesResponse, error)
+	ListRolesWithContextFunc            func(ctx context.Context, p *cloudstack.ListRolesParams) (*cloudstack.ListRolesResponse, error)
+	NewListRolesPagerFunc               func(p *cloudstack.ListRolesParams) *cloudstack.ListRolesPager
+	ListRolesAllFunc                    func(p *cloudstack.ListRolesParams) (*cloudstack.ListRolesResponse, error)
+	ListRolesAllWithContextFunc         func(ctx context.Context, p *cloudstack.ListRolesParams) (*cloudstack.ListRolesResponse, error)
+	NewUpdateRoleParamsFunc             func(id string) *cloudstack.UpdateRoleParams
+	UpdateRoleFunc                      func(p *cloudstack.UpdateRoleParams) (*cloudstack.UpdateRoleResponse, error)
+	UpdateRoleWithContextFunc           func(ctx context.Context, p *cloudstack.UpdateRoleParams) (*cloudstack.UpdateRoleResponse, error)
+	NewUpdateRolePermissionParamsFunc   func(roleid string) *cloudstack.UpdateRolePermissionParams
+	UpdateRolePermissionFunc            func(p *cloudstack.UpdateRolePermissionParams) (*cloudstack.UpdateRolePermissionResponse, error)
+	UpdateRolePermissionWithContextFunc func(ctx context.Context, p *cloudstack.UpdateRolePermissionParams) (*cloudstack.UpdateRolePermissionResponse, error)
+}
+
+var _ cloudstack.RoleServiceIface = (*MockRoleService)(nil)
+
+// NewCreateRoleParams records the call and calls NewCreateRoleParamsFunc
+func (m *MockRoleService) NewCreateRoleParams(name string) (r0 *cloudstack.CreateRoleParams) {
+	m.record("NewCreateRoleParams", name)
+	if m.NewCreateRoleParamsFunc != nil {
+		return m.NewCreateRoleParamsFunc(name)
+	}
+	return cloudstack.NewRoleService(nil).NewCreateRoleParams(name)
+}
+
+// CreateRole records the call and calls CreateRoleFunc, or CreateRoleWithContextFunc if only that is set
+func (m *MockRoleService) CreateRole(p *cloudstack.CreateRoleParams) (r0 *cloudstack.CreateRoleResponse, r1 error) {
+	m.record("CreateRole", p)
+	if m.CreateRoleFunc != nil {
+		return m.CreateRoleFunc(p)
+	}
+	if m.CreateRoleWithContextFunc != nil {
+		return m.CreateRoleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RoleService.CreateRole")
+	return
+}
+
+// CreateRoleWithContext records the call and calls CreateRoleWithContextFunc, or CreateRoleFunc if only that is set
+func (m *MockRoleService) CreateRoleWithContext(ctx context.Context, p *cloudstack.CreateRoleParams) (r0 *cloudstack.CreateRoleResponse, r1 error) {
+	m.record("CreateRoleWithContext", ctx, p)
+	if m.CreateRoleWithContextFunc != nil {
+		return m.CreateRoleWithContextFunc(ctx, p)
+	}
+	if m.CreateRoleFunc != nil {
+		return m.CreateRoleFunc(p)
+	}
+	r1 = notMocked("RoleService.CreateRoleWithContext")
+	return
+}
+
+// NewCreateRolePermissionParams records the call and calls NewCreateRolePermissionParamsFunc
+func (m *MockRoleService) NewCreateRolePermissionParams(permission string, roleid string, rule string) (r0 *cloudstack.CreateRolePermissionParams) {
+	m.record("NewCreateRolePermissionParams", permission, roleid, rule)
+	if m.NewCreateRolePermissionParamsFunc != nil {
+		return m.NewCreateRolePermissionParamsFunc(permission, roleid, rule)
+	}
+	return cloudstack.NewRoleService(nil).NewCreateRolePermissionParams(permission, roleid, rule)
+}
+
+// CreateRolePermission records the call and calls CreateRolePermissionFunc, or CreateRolePermissionWithContextFunc if only that is set
+func (m *MockRoleService) CreateRolePermission(p *cloudstack.CreateRolePermissionParams) (r0 *cloudstack.CreateRolePermissionResponse, r1 error) {
+	m.record("CreateRolePermission", p)
+	if m.CreateRolePermissionFunc != nil {
+		return m.CreateRolePermissionFunc(p)
+	}
+	if m.CreateRolePermissionWithContextFunc != nil {
+		return m.CreateRolePermissionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RoleService.CreateRolePermission")
+	return
+}
+
+// CreateRolePermissionWithContext records the call and calls CreateRolePermissionWithContextFunc, or CreateRolePermissionFunc if only that is set
+func (m *MockRoleService) CreateRolePermissionWithContext(ctx context.Context, p *cloudstack.CreateRolePermissionParams) (r0 *cloudstack.CreateRolePermissionResponse, r1 error) {
+	m.record("CreateRolePermissionWithContext", ctx, p)
+	if m.CreateRolePermissionWithContextFunc != nil {
+		return m.CreateRolePermissionWithContextFunc(ctx, p)
+	}
+	if m.CreateRolePermissionFunc != nil {
+		return m.CreateRolePermissionFunc(p)
+	}
+	r1 = notMocked("RoleService.CreateRolePermissionWithContext")
+	return
+}
+
+// NewDeleteRoleParams records the call and calls NewDeleteRoleParamsFunc
+func (m *MockRoleService) NewDeleteRoleParams(id string) (r0 *cloudstack.DeleteRoleParams) {
+	m.record("NewDeleteRoleParams", id)
+	if m.NewDeleteRoleParamsFunc != nil {
+		return m.NewDeleteRoleParamsFunc(id)
+	}
+	return cloudstack.NewRoleService(nil).NewDeleteRoleParams(id)
+}
+
+// DeleteRole records the call and calls DeleteRoleFunc, or DeleteRoleWithContextFunc if only that is set
+func (m *MockRoleService) DeleteRole(p *cloudstack.DeleteRoleParams) (r0 *cloudstack.DeleteRoleResponse, r1 error) {
+	m.record("DeleteRole", p)
+	if m.DeleteRoleFunc != nil {
+		return m.DeleteRoleFunc(p)
+	}
+	if m.DeleteRoleWithContextFunc != nil {
+		return m.DeleteRoleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RoleService.DeleteRole")
+	return
+}
+
+// DeleteRoleWithContext records the call and calls DeleteRoleWithContextFunc, or DeleteRoleFunc if only that is set
+func (m *MockRoleService) DeleteRoleWithContext(ctx context.Context, p *cloudstack.DeleteRoleParams) (r0 *cloudstack.DeleteRoleResponse, r1 error) {
+	m.record("DeleteRoleWithContext", ctx, p)
+	if m.DeleteRoleWithContextFunc != nil {
+		return m.DeleteRoleWithContextFunc(ctx, p)
+	}
+	if m.DeleteRoleFunc != nil {
+		return m.DeleteRoleFunc(p)
+	}
+	r1 = notMocked("RoleService.DeleteRoleWithContext")
+	return
+}
+
+// NewDeleteRolePermissionParams records the call and calls NewDeleteRolePermissionParamsFunc
+func (m *MockRoleService) NewDeleteRolePermissionParams(id string) (r0 *cloudstack.DeleteRolePermissionParams) {
+	m.record("NewDeleteRolePermissionParams", id)
+	if m.NewDeleteRolePermissionParamsFunc != nil {
+		return m.NewDeleteRolePermissionParamsFunc(id)
+	}
+	return cloudstack.NewRoleService(nil).NewDeleteRolePermissionParams(id)
+}
+
+// DeleteRolePermission records the call and calls DeleteRolePermissionFunc, or DeleteRolePermissionWithContextFunc if only that is set
+func (m *MockRoleService) DeleteRolePermission(p *cloudstack.DeleteRolePermissionParams) (r0 *cloudstack.DeleteRolePermissionResponse, r1 error) {
+	m.record("DeleteRolePermission", p)
+	if m.DeleteRolePermissionFunc != nil {
+		return m.DeleteRolePermissionFunc(p)
+	}
+	if m.DeleteRolePermissionWithContextFunc != nil {
+		return m.DeleteRolePermissionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RoleService.DeleteRolePermission")
+	return
+}
+
+// DeleteRolePermissionWithContext records the call and calls DeleteRolePermissionWithContextFunc, or DeleteRolePermissionFunc if only that is set
+func (m *MockRoleService) DeleteRolePermissionWithContext(ctx context.Context, p *cloudstack.DeleteRolePermissionParams) (r0 *cloudstack.DeleteRolePermissionResponse, r1 error) {
+	m.record("DeleteRolePermissionWithContext", ctx, p)
+	if m.DeleteRolePermissionWithContextFunc != nil {
+		return m.DeleteRolePermissionWithContextFunc(ctx, p)
+	}
+	if m.DeleteRolePermissionFunc != nil {
+		return m.DeleteRolePermissionFunc(p)
+	}
+	r1 = notMocked("RoleService.DeleteRolePermissionWithContext")
+	return
+}
+
+// NewImportRoleParams records the call and calls NewImportRoleParamsFunc
+func (m *MockRoleService) NewImportRoleParams(name string, rules []cloudstack.ImportRoleRule) (r0 *cloudstack.ImportRoleParams) {
+	m.record("NewImportRoleParams", name, rules)
+	if m.NewImportRoleParamsFunc != nil {
+		return m.NewImportRoleParamsFunc(name, rules)
+	}
+	return cloudstack.NewRoleService(nil).NewImportRoleParams(name, rules)
+}
+
+// ImportRole records the call and calls ImportRoleFunc, or ImportRoleWithContextFunc if only that is set
+func (m *MockRoleService) ImportRole(p *cloudstack.ImportRoleParams) (r0 *cloudstack.ImportRoleResponse, r1 error) {
+	m.record("ImportRole", p)
+	if m.ImportRoleFunc != nil {
+		return m.ImportRoleFunc(p)
+	}
+	if m.ImportRoleWithContextFunc != nil {
+		return m.ImportRoleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RoleService.ImportRole")
+	return
+}
+
+// ImportRoleWithContext records the call and calls ImportRoleWithContextFunc, or ImportRoleFunc if only that is set
+func (m *MockRoleService) ImportRoleWithContext(ctx context.Context, p *cloudstack.ImportRoleParams) (r0 *cloudstack.ImportRoleResponse, r1 error) {
+	m.record("ImportRoleWithContext", ctx, p)
+	if m.ImportRoleWithContextFunc != nil {
+		return m.ImportRoleWithContextFunc(ctx, p)
+	}
+	if m.ImportRoleFunc != nil {
+		return m.ImportRoleFunc(p)
+	}
+	r1 = notMocked("RoleService.ImportRoleWithContext")
+	return
+}
+
+// NewListRolePermissionsParams records the call and calls NewListRolePermissionsParamsFunc
+func (m *MockRoleService) NewListRolePermissionsParams() (r0 *cloudstack.ListRolePermissionsParams) {
+	m.record("NewListRolePermissionsParams")
+	if m.NewListRolePermissionsParamsFunc != nil {
+		return m.NewListRolePermissionsParamsFunc()
+	}
+	return cloudstack.NewRoleService(nil).NewListRolePermissionsParams()
+}
+
+// ListRolePermissions records the call and calls ListRolePermissionsFunc, or ListRolePermissionsWithContextFunc if only that is set
+func (m *MockRoleService) ListRolePermissions(p *cloudstack.ListRolePermissionsParams) (r0 *cloudstack.ListRolePermissionsResponse, r1 error) {
+	m.record("ListRolePermissions", p)
+	if m.ListRolePermissionsFunc != nil {
+		return m.ListRolePermissionsFunc(p)
+	}
+	if m.ListRolePermissionsWithContextFunc != nil {
+		return m.ListRolePermissionsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RoleService.ListRolePermissions")
+	return
+}
+
+// ListRolePermissionsWithContext records the call and calls ListRolePermissionsWithContextFunc, or ListRolePermissionsFunc if only that is set
+func (m *MockRoleService) ListRolePermissionsWithContext(ctx context.Context, p *cloudstack.ListRolePermissionsParams) (r0 *cloudstack.ListRolePermissionsResponse, r1 error) {
+	m.record("ListRolePermissionsWithContext", ctx, p)
+	if m.ListRolePermissionsWithContextFunc != nil {
+		return m.ListRolePermissionsWithContextFunc(ctx, p)
+	}
+	if m.ListRolePermissionsFunc != nil {
+		return m.ListRolePermissionsFunc(p)
+	}
+	r1 = notMocked("RoleService.ListRolePermissionsWithContext")
+	return
+}
+
+// NewListRolesParams records the call and calls NewListRolesParamsFunc
+func (m *MockRoleService) NewListRolesParams() (r0 *cloudstack.ListRolesParams) {
+	m.record("NewListRolesParams")
+	if m.NewListRolesParamsFunc != nil {
+		return m.NewListRolesParamsFunc()
+	}
+	return cloudstack.NewRoleService(nil).NewListRolesParams()
+}
+
+// GetRoleID records the call and calls GetRoleIDFunc, or GetRoleIDWithContextFunc if only that is set
+func (m *MockRoleService) GetRoleID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetRoleID", name, opts)
+	if m.GetRoleIDFunc != nil {
+		return m.GetRoleIDFunc(name, opts...)
+	}
+	if m.GetRoleIDWithContextFunc != nil {
+		return m.GetRoleIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("RoleService.GetRoleID")
+	return
+}
+
+// GetRoleIDWithContext records the call and calls GetRoleIDWithContextFunc, or GetRoleIDFunc if only that is set
+func (m *MockRoleService) GetRoleIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetRoleIDWithContext", ctx, name, opts)
+	if m.GetRoleIDWithContextFunc != nil {
+		return m.GetRoleIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetRoleIDFunc != nil {
+		return m.GetRoleIDFunc(name, opts...)
+	}
+	r2 = notMocked("RoleService.GetRoleIDWithContext")
+	return
+}
+
+// GetRoleByName records the call and calls GetRoleByNameFunc, or GetRoleByNameWithContextFunc if only that is set
+func (m *MockRoleService) GetRoleByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Role, r1 int, r2 error) {
+	m.record("GetRoleByName", name, opts)
+	if m.GetRoleByNameFunc != nil {
+		return m.GetRoleByNameFunc(name, opts...)
+	}
+	if m.GetRoleByNameWithContextFunc != nil {
+		return m.GetRoleByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("RoleService.GetRoleByName")
+	return
+}
+
+// GetRoleByNameWithContext records the call and calls GetRoleByNameWithContextFunc, or GetRoleByNameFunc if only that is set
+func (m *MockRoleService) GetRoleByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Role, r1 int, r2 error) {
+	m.record("GetRoleByNameWithContext", ctx, name, opts)
+	if m.GetRoleByNameWithContextFunc != nil {
+		return m.GetRoleByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetRoleByNameFunc != nil {
+		return m.GetRoleByNameFunc(name, opts...)
+	}
+	r2 = notMocked("RoleService.GetRoleByNameWithContext")
+	return
+}
+
+// GetRoleByID records the call and calls GetRoleByIDFunc, or GetRoleByIDWithContextFunc if only that is set
+func (m *MockRoleService) GetRoleByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Role, r1 int, r2 error) {
+	m.record("GetRoleByID", id, opts)
+	if m.GetRoleByIDFunc != nil {
+		return m.GetRoleByIDFunc(id, opts...)
+	}
+	if m.GetRoleByIDWithContextFunc != nil {
+		return m.GetRoleByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("RoleService.GetRoleByID")
+	return
+}
+
+// GetRoleByIDWithContext records the call and calls GetRoleByIDWithContextFunc, or GetRoleByIDFunc if only that is set
+func (m *MockRoleService) GetRoleByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Role, r1 int, r2 error) {
+	m.record("GetRoleByIDWithContext", ctx, id, opts)
+	if m.GetRoleByIDWithContextFunc != nil {
+		return m.GetRoleByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetRoleByIDFunc != nil {
+		return m.GetRoleByIDFunc(id, opts...)
+	}
+	r2 = notMocked("RoleService.GetRoleByIDWithContext")
+	return
+}
+
+// ListRoles records the call and calls ListRolesFunc, or ListRolesWithContextFunc if only that is set
+func (m *MockRoleService) ListRoles(p *cloudstack.ListRolesParams) (r0 *cloudstack.ListRolesResponse, r1 error) {
+	m.record("ListRoles", p)
+	if m.ListRolesFunc != nil {
+		return m.ListRolesFunc(p)
+	}
+	if m.ListRolesWithContextFunc != nil {
+		return m.ListRolesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RoleService.ListRoles")
+	return
+}
+
+// ListRolesWithContext records the call and calls ListRolesWithContextFunc, or ListRolesFunc if only that is set
+func (m *MockRoleService) ListRolesWithContext(ctx context.Context, p *cloudstack.ListRolesParams) (r0 *cloudstack.ListRolesResponse, r1 error) {
+	m.record("ListRolesWithContext", ctx, p)
+	if m.ListRolesWithContextFunc != nil {
+		return m.ListRolesWithContextFunc(ctx, p)
+	}
+	if m.ListRolesFunc != nil {
+		return m.ListRolesFunc(p)
+	}
+	r1 = notMocked("RoleService.ListRolesWithContext")
+	return
+}
+
+// NewListRolesPager records the call and calls NewListRolesPagerFunc
+func (m *MockRoleService) NewListRolesPager(p *cloudstack.ListRolesParams) (r0 *cloudstack.ListRolesPager) {
+	m.record("NewListRolesPager", p)
+	if m.NewListRolesPagerFunc != nil {
+		return m.NewListRolesPagerFunc(p)
+	}
+	return
+}
+
+// ListRolesAll records the call and calls ListRolesAllFunc, or ListRolesAllWithContextFunc if only that is set
+func (m *MockRoleService) ListRolesAll(p *cloudstack.ListRolesParams) (r0 *cloudstack.ListRolesResponse, r1 error) {
+	m.record("ListRolesAll", p)
+	if m.ListRolesAllFunc != nil {
+		return m.ListRolesAllFunc(p)
+	}
+	if m.ListRolesAllWithContextFunc != nil {
+		return m.ListRolesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RoleService.ListRolesAll")
+	return
+}
+
+// ListRolesAllWithContext records the call and calls ListRolesAllWithContextFunc, or ListRolesAllFunc if only that is set
+func (m *MockRoleService) ListRolesAllWithContext(ctx context.Context, p *cloudstack.ListRolesParams) (r0 *cloudstack.ListRolesResponse, r1 error) {
+	m.record("ListRolesAllWithContext", ctx, p)
+	if m.ListRolesAllWithContextFunc != nil {
+		return m.ListRolesAllWithContextFunc(ctx, p)
+	}
+	if m.ListRolesAllFunc != nil {
+		return m.ListRolesAllFunc(p)
+	}
+	r1 = notMocked("RoleService.ListRolesAllWithContext")
+	return
+}
+
+// NewUpdateRoleParams records the call and calls NewUpdateRoleParamsFunc
+func (m *MockRoleService) NewUpdateRoleParams(id string) (r0 *cloudstack.UpdateRoleParams) {
+	m.record("NewUpdateRoleParams", id)
+	if m.NewUpdateRoleParamsFunc != nil {
+		return m.NewUpdateRoleParamsFunc(id)
+	}
+	return cloudstack.NewRoleService(nil).NewUpdateRoleParams(id)
+}
+
+// UpdateRole records the call and calls UpdateRoleFunc, or UpdateRoleWithContextFunc if only that is set
+func (m *MockRoleService) UpdateRole(p *cloudstack.UpdateRoleParams) (r0 *cloudstack.UpdateRoleResponse, r1 error) {
+	m.record("UpdateRole", p)
+	if m.UpdateRoleFunc != nil {
+		return m.UpdateRoleFunc(p)
+	}
+	if m.UpdateRoleWithContextFunc != nil {
+		return m.UpdateRoleWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RoleService.UpdateRole")
+	return
+}
+
+// UpdateRoleWithContext records the call and calls UpdateRoleWithContextFunc, or UpdateRoleFunc if only that is set
+func (m *MockRoleService) UpdateRoleWithContext(ctx context.Context, p *cloudstack.UpdateRoleParams) (r0 *cloudstack.UpdateRoleResponse, r1 error) {
+	m.record("UpdateRoleWithContext", ctx, p)
+	if m.UpdateRoleWithContextFunc != nil {
+		return m.UpdateRoleWithContextFunc(ctx, p)
+	}
+	if m.UpdateRoleFunc != nil {
+		return m.UpdateRoleFunc(p)
+	}
+	r1 = notMocked("RoleService.UpdateRoleWithContext")
+	return
+}
+
+// NewUpdateRolePermissionParams records the call and calls NewUpdateRolePermissionParamsFunc
+func (m *MockRoleService) NewUpdateRolePermissionParams(roleid string) (r0 *cloudstack.UpdateRolePermissionParams) {
+	m.record("NewUpdateRolePermissionParams", roleid)
+	if m.NewUpdateRolePermissionParamsFunc != nil {
+		return m.NewUpdateRolePermissionParamsFunc(roleid)
+	}
+	return cloudstack.NewRoleService(nil).NewUpdateRolePermissionParams(roleid)
+}
+
+// UpdateRolePermission records the call and calls UpdateRolePermissionFunc, or UpdateRolePermissionWithContextFunc if only that is set
+func (m *MockRoleService) UpdateRolePermission(p *cloudstack.UpdateRolePermissionParams) (r0 *cloudstack.UpdateRolePermissionResponse, r1 error) {
+	m.record("UpdateRolePermission", p)
+	if m.UpdateRolePermissionFunc != nil {
+		return m.UpdateRolePermissionFunc(p)
+	}
+	if m.UpdateRolePermissionWithContextFunc != nil {
+		return m.UpdateRolePermissionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RoleService.UpdateRolePermission")
+	return
+}
+
+// UpdateRolePermissionWithContext records the call and calls UpdateRolePermissionWithContextFunc, or UpdateRolePermissionFunc if only that is set
+func (m *MockRoleService) UpdateRolePermissionWithContext(ctx context.Context, p *cloudstack.UpdateRolePermissionParams) (r0 *cloudstack.UpdateRolePermissionResponse, r1 error) {
+	m.record("UpdateRolePermissionWithContext", ctx, p)
+	if m.UpdateRolePermissionWithContextFunc != nil {
+		return m.UpdateRolePermissionWithContextFunc(ctx, p)
+	}
+	if m.UpdateRolePermissionFunc != nil {
+		return m.UpdateRolePermissionFunc(p)
+	}
+	r1 = notMocked("RoleService.UpdateRolePermissionWithContext")
+	return
+}
@@ -0,0 +1,775 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockRouterService is a mock of cloudstack.RouterServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockRouterService struct {
+	Recorder
+
+	NewChangeServiceForRouterParamsFunc               func(id string, serviceofferingid string) *cloudstack.ChangeServiceForRouterParams
+	ChangeServiceForRouterFunc                        func(p *cloudstack.ChangeServiceForRouterParams) (*cloudstack.ChangeServiceForRouterResponse, error)
+	ChangeServiceForRouterWithContextFunc             func(ctx context.Context, p *cloudstack.ChangeServiceForRouterParams) (*cloudstack.ChangeServiceForRouterResponse, error)
+	NewConfigureVirtualRouterElementParamsFunc        func(enabled bool, id string) *cloudstack.ConfigureVirtualRouterElementParams
+	ConfigureVirtualRouterElementFunc                 func(p *cloudstack.ConfigureVirtualRouterElementParams) (*cloudstack.VirtualRouterElementResponse, error)
+	ConfigureVirtualRouterElementWithContextFunc      func(ctx context.Context, p *cloudstack.ConfigureVirtualRouterElementParams) (*cloudstack.VirtualRouterElementResponse, error)
+	ConfigureVirtualRouterElementAsyncFunc            func(p *cloudstack.ConfigureVirtualRouterElementParams) (*cloudstack.Job, error)
+	ConfigureVirtualRouterElementAsyncWithContextFunc func(ctx context.Context, p *cloudstack.ConfigureVirtualRouterElementParams) (*cloudstack.Job, error)
+	NewCreateVirtualRouterElementParamsFunc           func(nspid string) *cloudstack.CreateVirtualRouterElementParams
+	CreateVirtualRouterElementFunc                    func(p *cloudstack.CreateVirtualRouterElementParams) (*cloudstack.CreateVirtualRouterElementResponse, error)
+	CreateVirtualRouterElementWithContextFunc         func(ctx context.Context, p *cloudstack.CreateVirtualRouterElementParams) (*cloudstack.CreateVirtualRouterElementResponse, error)
+	CreateVirtualRouterElementAsyncFunc               func(p *cloudstack.CreateVirtualRouterElementParams) (*cloudstack.Job, error)
+	CreateVirtualRouterElementAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.CreateVirtualRouterElementParams) (*cloudstack.Job, error)
+	NewDestroyRouterParamsFunc                        func(id string) *cloudstack.DestroyRouterParams
+	DestroyRouterFunc                                 func(p *cloudstack.DestroyRouterParams) (*cloudstack.DestroyRouterResponse, error)
+	DestroyRouterWithContextFunc                      func(ctx context.Context, p *cloudstack.DestroyRouterParams) (*cloudstack.DestroyRouterResponse, error)
+	DestroyRouterAsyncFunc                            func(p *cloudstack.DestroyRouterParams) (*cloudstack.Job, error)
+	DestroyRouterAsyncWithContextFunc                 func(ctx context.Context, p *cloudstack.DestroyRouterParams) (*cloudstack.Job, error)
+	NewGetRouterHealthCheckResultsParamsFunc          func(routerid string) *cloudstack.GetRouterHealthCheckResultsParams
+	GetRouterHealthCheckResultsFunc                   func(p *cloudstack.GetRouterHealthCheckResultsParams) (*cloudstack.GetRouterHealthCheckResultsResponse, error)
+	GetRouterHealthCheckResultsWithContextFunc        func(ctx context.Context, p *cloudstack.GetRouterHealthCheckResultsParams) (*cloudstack.GetRouterHealthCheckResultsResponse, error)
+	NewListRoutersParamsFunc                          func() *cloudstack.ListRoutersParams
+	GetRouterIDFunc                                   func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetRouterIDWithContextFunc                        func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetRouterByNameFunc                               func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Router, int, error)
+	GetRouterByNameWithContextFunc                    func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.Router, int, error)
+	GetRouterByIDFunc                                 func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Router, int, error)
+	GetRouterByIDWithContextFunc                      func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Router, int, error)
+	ListRoutersFunc                                   func(p *cloudstack.ListRoutersParams) (*cloudstack.ListRoutersResponse, error)
+	ListRoutersWithContextFunc                        func(ctx context.Context, p *cloudstack.ListRoutersParams) (*cloudstack.ListRoutersResponse, error)
+	NewListRoutersPagerFunc                           func(p *cloudstack.ListRoutersParams) *cloudstack.ListRoutersPager
+	ListRoutersAllFunc                                func(p *cloudstack.ListRoutersParams) (*cloudstack.ListRoutersResponse, error)
+	ListRoutersAllWithContextFunc                     func(ctx context.Context, p *cloudstack.ListRoutersParams) (*cloudstack.ListRoutersResponse, error)
+	NewListVirtualRouterElementsParamsFunc            func() *cloudstack.ListVirtualRouterElementsParams
+	GetVirtualRouterElementByIDFunc                   func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualRouterElement, int, error)
+	GetVirtualRouterElementByIDWithContextFunc        func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualRouterElement, int, error)
+	ListVirtualRouterElementsFunc                     func(p *cloudstack.ListVirtualRouterElementsParams) (*cloudstack.ListVirtualRouterElementsResponse, error)
+	ListVirtualRouterElementsWithContextFunc          func(ctx context.Context, p *cloudstack.ListVirtualRouterElementsParams) (*cloudstack.ListVirtualRouterElementsResponse, error)
+	NewListVirtualRouterElementsPagerFunc             func(p *cloudstack.ListVirtualRouterElementsParams) *cloudstack.ListVirtualRouterElementsPager
+	ListVirtualRouterElementsAllFunc                  func(p *cloudstack.ListVirtualRouterElementsParams) (*cloudstack.ListVirtualRouterElementsResponse, error)
+	ListVirtualRouterElementsAllWithContextFunc       func(ctx context.Context, p *cloudstack.ListVirtualRouterElementsParams) (*cloudstack.ListVirtualRouterElementsResponse, error)
+	NewRebootRouterParamsFunc                         func(id string) *cloudstack.RebootRouterParams
+	RebootRouterFunc                                  func(p *cloudstack.RebootRouterParams) (*cloudstack.RebootRouterResponse, error)
+	RebootRouterWithContextFunc                       func(ctx context.Context, p *cloudstack.RebootRouterParams) (*cloudstack.RebootRouterResponse, error)
+	RebootRouterAsyncFunc                             func(p *cloudstack.RebootRouterParams) (*cloudstack.Job, error)
+	RebootRouterAsyncWithContextFunc                  func(ctx context.Context, p *cloudstack.RebootRouterParams) (*cloudstack.Job, error)
+	NewStartRouterParamsFunc                          func(id string) *cloudstack.StartRouterParams
+	StartRouterFunc                                   func(p *cloudstack.StartRouterParams) (*cloudstack.StartRouterResponse, error)
+	StartRouterWithContextFunc                        func(ctx context.Context, p *cloudstack.StartRouterParams) (*cloudstack.StartRouterResponse, error)
+	StartRouterAsyncFunc                              func(p *cloudstack.StartRouterParams) (*cloudstack.Job, error)
+	StartRouterAsyncWithContextFunc                   func(ctx context.Context, p *cloudstack.StartRouterParams) (*cloudstack.Job, error)
+	NewStopRouterParamsFunc                           func(id string) *cloudstack.StopRouterParams
+	StopRouterFunc                                    func(p *cloudstack.StopRouterParams) (*cloudstack.StopRouterResponse, error)
+	StopRouterWithContextFunc                         func(ctx context.Context, p *cloudstack.StopRouterParams) (*cloudstack.StopRouterResponse, error)
+	StopRouterAsyncFunc                               func(p *cloudstack.StopRouterParams) (*cloudstack.Job, error)
+	StopRouterAsyncWithContextFunc                    func(ctx context.Context, p *cloudstack.StopRouterParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.RouterServiceIface = (*MockRouterService)(nil)
+
+// NewChangeServiceForRouterParams records the call and calls NewChangeServiceForRouterParamsFunc
+func (m *MockRouterService) NewChangeServiceForRouterParams(id string, serviceofferingid string) (r0 *cloudstack.ChangeServiceForRouterParams) {
+	m.record("NewChangeServiceForRouterParams", id, serviceofferingid)
+	if m.NewChangeServiceForRouterParamsFunc != nil {
+		return m.NewChangeServiceForRouterParamsFunc(id, serviceofferingid)
+	}
+	return cloudstack.NewRouterService(nil).NewChangeServiceForRouterParams(id, serviceofferingid)
+}
+
+// ChangeServiceForRouter records the call and calls ChangeServiceForRouterFunc, or ChangeServiceForRouterWithContextFunc if only that is set
+func (m *MockRouterService) ChangeServiceForRouter(p *cloudstack.ChangeServiceForRouterParams) (r0 *cloudstack.ChangeServiceForRouterResponse, r1 error) {
+	m.record("ChangeServiceForRouter", p)
+	if m.ChangeServiceForRouterFunc != nil {
+		return m.ChangeServiceForRouterFunc(p)
+	}
+	if m.ChangeServiceForRouterWithContextFunc != nil {
+		return m.ChangeServiceForRouterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.ChangeServiceForRouter")
+	return
+}
+
+// ChangeServiceForRouterWithContext records the call and calls ChangeServiceForRouterWithContextFunc, or ChangeServiceForRouterFunc if only that is set
+func (m *MockRouterService) ChangeServiceForRouterWithContext(ctx context.Context, p *cloudstack.ChangeServiceForRouterParams) (r0 *cloudstack.ChangeServiceForRouterResponse, r1 error) {
+	m.record("ChangeServiceForRouterWithContext", ctx, p)
+	if m.ChangeServiceForRouterWithContextFunc != nil {
+		return m.ChangeServiceForRouterWithContextFunc(ctx, p)
+	}
+	if m.ChangeServiceForRouterFunc != nil {
+		return m.ChangeServiceForRouterFunc(p)
+	}
+	r1 = notMocked("RouterService.ChangeServiceForRouterWithContext")
+	return
+}
+
+// NewConfigureVirtualRouterElementParams records the call and calls NewConfigureVirtualRouterElementParamsFunc
+func (m *MockRouterService) NewConfigureVirtualRouterElementParams(enabled bool, id string) (r0 *cloudstack.ConfigureVirtualRouterElementParams) {
+	m.record("NewConfigureVirtualRouterElementParams", enabled, id)
+	if m.NewConfigureVirtualRouterElementParamsFunc != nil {
+		return m.NewConfigureVirtualRouterElementParamsFunc(enabled, id)
+	}
+	return cloudstack.NewRouterService(nil).NewConfigureVirtualRouterElementParams(enabled, id)
+}
+
+// ConfigureVirtualRouterElement records the call and calls ConfigureVirtualRouterElementFunc, or ConfigureVirtualRouterElementWithContextFunc if only that is set
+func (m *MockRouterService) ConfigureVirtualRouterElement(p *cloudstack.ConfigureVirtualRouterElementParams) (r0 *cloudstack.VirtualRouterElementResponse, r1 error) {
+	m.record("ConfigureVirtualRouterElement", p)
+	if m.ConfigureVirtualRouterElementFunc != nil {
+		return m.ConfigureVirtualRouterElementFunc(p)
+	}
+	if m.ConfigureVirtualRouterElementWithContextFunc != nil {
+		return m.ConfigureVirtualRouterElementWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.ConfigureVirtualRouterElement")
+	return
+}
+
+// ConfigureVirtualRouterElementWithContext records the call and calls ConfigureVirtualRouterElementWithContextFunc, or ConfigureVirtualRouterElementFunc if only that is set
+func (m *MockRouterService) ConfigureVirtualRouterElementWithContext(ctx context.Context, p *cloudstack.ConfigureVirtualRouterElementParams) (r0 *cloudstack.VirtualRouterElementResponse, r1 error) {
+	m.record("ConfigureVirtualRouterElementWithContext", ctx, p)
+	if m.ConfigureVirtualRouterElementWithContextFunc != nil {
+		return m.ConfigureVirtualRouterElementWithContextFunc(ctx, p)
+	}
+	if m.ConfigureVirtualRouterElementFunc != nil {
+		return m.ConfigureVirtualRouterElementFunc(p)
+	}
+	r1 = notMocked("RouterService.ConfigureVirtualRouterElementWithContext")
+	return
+}
+
+// ConfigureVirtualRouterElementAsync records the call and calls ConfigureVirtualRouterElementAsyncFunc, or ConfigureVirtualRouterElementAsyncWithContextFunc if only that is set
+func (m *MockRouterService) ConfigureVirtualRouterElementAsync(p *cloudstack.ConfigureVirtualRouterElementParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigureVirtualRouterElementAsync", p)
+	if m.ConfigureVirtualRouterElementAsyncFunc != nil {
+		return m.ConfigureVirtualRouterElementAsyncFunc(p)
+	}
+	if m.ConfigureVirtualRouterElementAsyncWithContextFunc != nil {
+		return m.ConfigureVirtualRouterElementAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.ConfigureVirtualRouterElementAsync")
+	return
+}
+
+// ConfigureVirtualRouterElementAsyncWithContext records the call and calls ConfigureVirtualRouterElementAsyncWithContextFunc, or ConfigureVirtualRouterElementAsyncFunc if only that is set
+func (m *MockRouterService) ConfigureVirtualRouterElementAsyncWithContext(ctx context.Context, p *cloudstack.ConfigureVirtualRouterElementParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ConfigureVirtualRouterElementAsyncWithContext", ctx, p)
+	if m.ConfigureVirtualRouterElementAsyncWithContextFunc != nil {
+		return m.ConfigureVirtualRouterElementAsyncWithContextFunc(ctx, p)
+	}
+	if m.ConfigureVirtualRouterElementAsyncFunc != nil {
+		return m.ConfigureVirtualRouterElementAsyncFunc(p)
+	}
+	r1 = notMocked("RouterService.ConfigureVirtualRouterElementAsyncWithContext")
+	return
+}
+
+// NewCreateVirtualRouterElementParams records the call and calls NewCreateVirtualRouterElementParamsFunc
+func (m *MockRouterService) NewCreateVirtualRouterElementParams(nspid string) (r0 *cloudstack.CreateVirtualRouterElementParams) {
+	m.record("NewCreateVirtualRouterElementParams", nspid)
+	if m.NewCreateVirtualRouterElementParamsFunc != nil {
+		return m.NewCreateVirtualRouterElementParamsFunc(nspid)
+	}
+	return cloudstack.NewRouterService(nil).NewCreateVirtualRouterElementParams(nspid)
+}
+
+// CreateVirtualRouterElement records the call and calls CreateVirtualRouterElementFunc, or CreateVirtualRouterElementWithContextFunc if only that is set
+func (m *MockRouterService) CreateVirtualRouterElement(p *cloudstack.CreateVirtualRouterElementParams) (r0 *cloudstack.CreateVirtualRouterElementResponse, r1 error) {
+	m.record("CreateVirtualRouterElement", p)
+	if m.CreateVirtualRouterElementFunc != nil {
+		return m.CreateVirtualRouterElementFunc(p)
+	}
+	if m.CreateVirtualRouterElementWithContextFunc != nil {
+		return m.CreateVirtualRouterElementWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.CreateVirtualRouterElement")
+	return
+}
+
+// CreateVirtualRouterElementWithContext records the call and calls CreateVirtualRouterElementWithContextFunc, or CreateVirtualRouterElementFunc if only that is set
+func (m *MockRouterService) CreateVirtualRouterElementWithContext(ctx context.Context, p *cloudstack.CreateVirtualRouterElementParams) (r0 *cloudstack.CreateVirtualRouterElementResponse, r1 error) {
+	m.record("CreateVirtualRouterElementWithContext", ctx, p)
+	if m.CreateVirtualRouterElementWithContextFunc != nil {
+		return m.CreateVirtualRouterElementWithContextFunc(ctx, p)
+	}
+	if m.CreateVirtualRouterElementFunc != nil {
+		return m.CreateVirtualRouterElementFunc(p)
+	}
+	r1 = notMocked("RouterService.CreateVirtualRouterElementWithContext")
+	return
+}
+
+// CreateVirtualRouterElementAsync records the call and calls CreateVirtualRouterElementAsyncFunc, or CreateVirtualRouterElementAsyncWithContextFunc if only that is set
+func (m *MockRouterService) CreateVirtualRouterElementAsync(p *cloudstack.CreateVirtualRouterElementParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVirtualRouterElementAsync", p)
+	if m.CreateVirtualRouterElementAsyncFunc != nil {
+		return m.CreateVirtualRouterElementAsyncFunc(p)
+	}
+	if m.CreateVirtualRouterElementAsyncWithContextFunc != nil {
+		return m.CreateVirtualRouterElementAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.CreateVirtualRouterElementAsync")
+	return
+}
+
+// CreateVirtualRouterElementAsyncWithContext records the call and calls CreateVirtualRouterElementAsyncWithContextFunc, or CreateVirtualRouterElementAsyncFunc if only that is set
+func (m *MockRouterService) CreateVirtualRouterElementAsyncWithContext(ctx context.Context, p *cloudstack.CreateVirtualRouterElementParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVirtualRouterElementAsyncWithContext", ctx, p)
+	if m.CreateVirtualRouterElementAsyncWithContextFunc != nil {
+		return m.CreateVirtualRouterElementAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateVirtualRouterElementAsyncFunc != nil {
+		return m.CreateVirtualRouterElementAsyncFunc(p)
+	}
+	r1 = notMocked("RouterService.CreateVirtualRouterElementAsyncWithContext")
+	return
+}
+
+// NewDestroyRouterParams records the call and calls NewDestroyRouterParamsFunc
+func (m *MockRouterService) NewDestroyRouterParams(id string) (r0 *cloudstack.DestroyRouterParams) {
+	m.record("NewDestroyRouterParams", id)
+	if m.NewDestroyRouterParamsFunc != nil {
+		return m.NewDestroyRouterParamsFunc(id)
+	}
+	return cloudstack.NewRouterService(nil).NewDestroyRouterParams(id)
+}
+
+// DestroyRouter records the call and calls DestroyRouterFunc, or DestroyRouterWithContextFunc if only that is set
+func (m *MockRouterService) DestroyRouter(p *cloudstack.DestroyRouterParams) (r0 *cloudstack.DestroyRouterResponse, r1 error) {
+	m.record("DestroyRouter", p)
+	if m.DestroyRouterFunc != nil {
+		return m.DestroyRouterFunc(p)
+	}
+	if m.DestroyRouterWithContextFunc != nil {
+		return m.DestroyRouterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.DestroyRouter")
+	return
+}
+
+// DestroyRouterWithContext records the call and calls DestroyRouterWithContextFunc, or DestroyRouterFunc if only that is set
+func (m *MockRouterService) DestroyRouterWithContext(ctx context.Context, p *cloudstack.DestroyRouterParams) (r0 *cloudstack.DestroyRouterResponse, r1 error) {
+	m.record("DestroyRouterWithContext", ctx, p)
+	if m.DestroyRouterWithContextFunc != nil {
+		return m.DestroyRouterWithContextFunc(ctx, p)
+	}
+	if m.DestroyRouterFunc != nil {
+		return m.DestroyRouterFunc(p)
+	}
+	r1 = notMocked("RouterService.DestroyRouterWithContext")
+	return
+}
+
+// DestroyRouterAsync records the call and calls DestroyRouterAsyncFunc, or DestroyRouterAsyncWithContextFunc if only that is set
+func (m *MockRouterService) DestroyRouterAsync(p *cloudstack.DestroyRouterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DestroyRouterAsync", p)
+	if m.DestroyRouterAsyncFunc != nil {
+		return m.DestroyRouterAsyncFunc(p)
+	}
+	if m.DestroyRouterAsyncWithContextFunc != nil {
+		return m.DestroyRouterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.DestroyRouterAsync")
+	return
+}
+
+// DestroyRouterAsyncWithContext records the call and calls DestroyRouterAsyncWithContextFunc, or DestroyRouterAsyncFunc if only that is set
+func (m *MockRouterService) DestroyRouterAsyncWithContext(ctx context.Context, p *cloudstack.DestroyRouterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DestroyRouterAsyncWithContext", ctx, p)
+	if m.DestroyRouterAsyncWithContextFunc != nil {
+		return m.DestroyRouterAsyncWithContextFunc(ctx, p)
+	}
+	if m.DestroyRouterAsyncFunc != nil {
+		return m.DestroyRouterAsyncFunc(p)
+	}
+	r1 = notMocked("RouterService.DestroyRouterAsyncWithContext")
+	return
+}
+
+// NewGetRouterHealthCheckResultsParams records the call and calls NewGetRouterHealthCheckResultsParamsFunc
+func (m *MockRouterService) NewGetRouterHealthCheckResultsParams(routerid string) (r0 *cloudstack.GetRouterHealthCheckResultsParams) {
+	m.record("NewGetRouterHealthCheckResultsParams", routerid)
+	if m.NewGetRouterHealthCheckResultsParamsFunc != nil {
+		return m.NewGetRouterHealthCheckResultsParamsFunc(routerid)
+	}
+	return cloudstack.NewRouterService(nil).NewGetRouterHealthCheckResultsParams(routerid)
+}
+
+// GetRouterHealthCheckResults records the call and calls GetRouterHealthCheckResultsFunc, or GetRouterHealthCheckResultsWithContextFunc if only that is set
+func (m *MockRouterService) GetRouterHealthCheckResults(p *cloudstack.GetRouterHealthCheckResultsParams) (r0 *cloudstack.GetRouterHealthCheckResultsResponse, r1 error) {
+	m.record("GetRouterHealthCheckResults", p)
+	if m.GetRouterHealthCheckResultsFunc != nil {
+		return m.GetRouterHealthCheckResultsFunc(p)
+	}
+	if m.GetRouterHealthCheckResultsWithContextFunc != nil {
+		return m.GetRouterHealthCheckResultsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.GetRouterHealthCheckResults")
+	return
+}
+
+// GetRouterHealthCheckResultsWithContext records the call and calls GetRouterHealthCheckResultsWithContextFunc, or GetRouterHealthCheckResultsFunc if only that is set
+func (m *MockRouterService) GetRouterHealthCheckResultsWithContext(ctx context.Context, p *cloudstack.GetRouterHealthCheckResultsParams) (r0 *cloudstack.GetRouterHealthCheckResultsResponse, r1 error) {
+	m.record("GetRouterHealthCheckResultsWithContext", ctx, p)
+	if m.GetRouterHealthCheckResultsWithContextFunc != nil {
+		return m.GetRouterHealthCheckResultsWithContextFunc(ctx, p)
+	}
+	if m.GetRouterHealthCheckResultsFunc != nil {
+		return m.GetRouterHealthCheckResultsFunc(p)
+	}
+	r1 = notMocked("RouterService.GetRouterHealthCheckResultsWithContext")
+	return
+}
+
+// NewListRoutersParams records the call and calls NewListRoutersParamsFunc
+func (m *MockRouterService) NewListRoutersParams() (r0 *cloudstack.ListRoutersParams) {
+	m.record("NewListRoutersParams")
+	if m.NewListRoutersParamsFunc != nil {
+		return m.NewListRoutersParamsFunc()
+	}
+	return cloudstack.NewRouterService(nil).NewListRoutersParams()
+}
+
+// GetRouterID records the call and calls GetRouterIDFunc, or GetRouterIDWithContextFunc if only that is set
+func (m *MockRouterService) GetRouterID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetRouterID", name, opts)
+	if m.GetRouterIDFunc != nil {
+		return m.GetRouterIDFunc(name, opts...)
+	}
+	if m.GetRouterIDWithContextFunc != nil {
+		return m.GetRouterIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("RouterService.GetRouterID")
+	return
+}
+
+// GetRouterIDWithContext records the call and calls GetRouterIDWithContextFunc, or GetRouterIDFunc if only that is set
+func (m *MockRouterService) GetRouterIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetRouterIDWithContext", ctx, name, opts)
+	if m.GetRouterIDWithContextFunc != nil {
+		return m.GetRouterIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetRouterIDFunc != nil {
+		return m.GetRouterIDFunc(name, opts...)
+	}
+	r2 = notMocked("RouterService.GetRouterIDWithContext")
+	return
+}
+
+// GetRouterByName records the call and calls GetRouterByNameFunc, or GetRouterByNameWithContextFunc if only that is set
+func (m *MockRouterService) GetRouterByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Router, r1 int, r2 error) {
+	m.record("GetRouterByName", name, opts)
+	if m.GetRouterByNameFunc != nil {
+		return m.GetRouterByNameFunc(name, opts...)
+	}
+	if m.GetRouterByNameWithContextFunc != nil {
+		return m.GetRouterByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("RouterService.GetRouterByName")
+	return
+}
+
+// GetRouterByNameWithContext records the call and calls GetRouterByNameWithContextFunc, or GetRouterByNameFunc if only that is set
+func (m *MockRouterService) GetRouterByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Router, r1 int, r2 error) {
+	m.record("GetRouterByNameWithContext", ctx, name, opts)
+	if m.GetRouterByNameWithContextFunc != nil {
+		return m.GetRouterByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetRouterByNameFunc != nil {
+		return m.GetRouterByNameFunc(name, opts...)
+	}
+	r2 = notMocked("RouterService.GetRouterByNameWithContext")
+	return
+}
+
+// GetRouterByID records the call and calls GetRouterByIDFunc, or GetRouterByIDWithContextFunc if only that is set
+func (m *MockRouterService) GetRouterByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Router, r1 int, r2 error) {
+	m.record("GetRouterByID", id, opts)
+	if m.GetRouterByIDFunc != nil {
+		return m.GetRouterByIDFunc(id, opts...)
+	}
+	if m.GetRouterByIDWithContextFunc != nil {
+		return m.GetRouterByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("RouterService.GetRouterByID")
+	return
+}
+
+// GetRouterByIDWithContext records the call and calls GetRouterByIDWithContextFunc, or GetRouterByIDFunc if only that is set
+func (m *MockRouterService) GetRouterByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Router, r1 int, r2 error) {
+	m.record("GetRouterByIDWithContext", ctx, id, opts)
+	if m.GetRouterByIDWithContextFunc != nil {
+		return m.GetRouterByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetRouterByIDFunc != nil {
+		return m.GetRouterByIDFunc(id, opts...)
+	}
+	r2 = notMocked("RouterService.GetRouterByIDWithContext")
+	return
+}
+
+// ListRouters records the call and calls ListRoutersFunc, or ListRoutersWithContextFunc if only that is set
+func (m *MockRouterService) ListRouters(p *cloudstack.ListRoutersParams) (r0 *cloudstack.ListRoutersResponse, r1 error) {
+	m.record("ListRouters", p)
+	if m.ListRoutersFunc != nil {
+		return m.ListRoutersFunc(p)
+	}
+	if m.ListRoutersWithContextFunc != nil {
+		return m.ListRoutersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.ListRouters")
+	return
+}
+
+// ListRoutersWithContext records the call and calls ListRoutersWithContextFunc, or ListRoutersFunc if only that is set
+func (m *MockRouterService) ListRoutersWithContext(ctx context.Context, p *cloudstack.ListRoutersParams) (r0 *cloudstack.ListRoutersResponse, r1 error) {
+	m.record("ListRoutersWithContext", ctx, p)
+	if m.ListRoutersWithContextFunc != nil {
+		return m.ListRoutersWithContextFunc(ctx, p)
+	}
+	if m.ListRoutersFunc != nil {
+		return m.ListRoutersFunc(p)
+	}
+	r1 = notMocked("RouterService.ListRoutersWithContext")
+	return
+}
+
+// NewListRoutersPager records the call and calls NewListRoutersPagerFunc
+func (m *MockRouterService) NewListRoutersPager(p *cloudstack.ListRoutersParams) (r0 *cloudstack.ListRoutersPager) {
+	m.record("NewListRoutersPager", p)
+	if m.NewListRoutersPagerFunc != nil {
+		return m.NewListRoutersPagerFunc(p)
+	}
+	return
+}
+
+// ListRoutersAll records the call and calls ListRoutersAllFunc, or ListRoutersAllWithContextFunc if only that is set
+func (m *MockRouterService) ListRoutersAll(p *cloudstack.ListRoutersParams) (r0 *cloudstack.ListRoutersResponse, r1 error) {
+	m.record("ListRoutersAll", p)
+	if m.ListRoutersAllFunc != nil {
+		return m.ListRoutersAllFunc(p)
+	}
+	if m.ListRoutersAllWithContextFunc != nil {
+		return m.ListRoutersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.ListRoutersAll")
+	return
+}
+
+// ListRoutersAllWithContext records the call and calls ListRoutersAllWithContextFunc, or ListRoutersAllFunc if only that is set
+func (m *MockRouterService) ListRoutersAllWithContext(ctx context.Context, p *cloudstack.ListRoutersParams) (r0 *cloudstack.ListRoutersResponse, r1 error) {
+	m.record("ListRoutersAllWithContext", ctx, p)
+	if m.ListRoutersAllWithContextFunc != nil {
+		return m.ListRoutersAllWithContextFunc(ctx, p)
+	}
+	if m.ListRoutersAllFunc != nil {
+		return m.ListRoutersAllFunc(p)
+	}
+	r1 = notMocked("RouterService.ListRoutersAllWithContext")
+	return
+}
+
+// NewListVirtualRouterElementsParams records the call and calls NewListVirtualRouterElementsParamsFunc
+func (m *MockRouterService) NewListVirtualRouterElementsParams() (r0 *cloudstack.ListVirtualRouterElementsParams) {
+	m.record("NewListVirtualRouterElementsParams")
+	if m.NewListVirtualRouterElementsParamsFunc != nil {
+		return m.NewListVirtualRouterElementsParamsFunc()
+	}
+	return cloudstack.NewRouterService(nil).NewListVirtualRouterElementsParams()
+}
+
+// GetVirtualRouterElementByID records the call and calls GetVirtualRouterElementByIDFunc, or GetVirtualRouterElementByIDWithContextFunc if only that is set
+func (m *MockRouterService) GetVirtualRouterElementByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VirtualRouterElement, r1 int, r2 error) {
+	m.record("GetVirtualRouterElementByID", id, opts)
+	if m.GetVirtualRouterElementByIDFunc != nil {
+		return m.GetVirtualRouterElementByIDFunc(id, opts...)
+	}
+	if m.GetVirtualRouterElementByIDWithContextFunc != nil {
+		return m.GetVirtualRouterElementByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("RouterService.GetVirtualRouterElementByID")
+	return
+}
+
+// GetVirtualRouterElementByIDWithContext records the call and calls GetVirtualRouterElementByIDWithContextFunc, or GetVirtualRouterElementByIDFunc if only that is set
+func (m *MockRouterService) GetVirtualRouterElementByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VirtualRouterElement, r1 int, r2 error) {
+	m.record("GetVirtualRouterElementByIDWithContext", ctx, id, opts)
+	if m.GetVirtualRouterElementByIDWithContextFunc != nil {
+		return m.GetVirtualRouterElementByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetVirtualRouterElementByIDFunc != nil {
+		return m.GetVirtualRouterElementByIDFunc(id, opts...)
+	}
+	r2 = notMocked("RouterService.GetVirtualRouterElementByIDWithContext")
+	return
+}
+
+// ListVirtualRouterElements records the call and calls ListVirtualRouterElementsFunc, or ListVirtualRouterElementsWithContextFunc if only that is set
+func (m *MockRouterService) ListVirtualRouterElements(p *cloudstack.ListVirtualRouterElementsParams) (r0 *cloudstack.ListVirtualRouterElementsResponse, r1 error) {
+	m.record("ListVirtualRouterElements", p)
+	if m.ListVirtualRouterElementsFunc != nil {
+		return m.ListVirtualRouterElementsFunc(p)
+	}
+	if m.ListVirtualRouterElementsWithContextFunc != nil {
+		return m.ListVirtualRouterElementsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.ListVirtualRouterElements")
+	return
+}
+
+// ListVirtualRouterElementsWithContext records the call and calls ListVirtualRouterElementsWithContextFunc, or ListVirtualRouterElementsFunc if only that is set
+func (m *MockRouterService) ListVirtualRouterElementsWithContext(ctx context.Context, p *cloudstack.ListVirtualRouterElementsParams) (r0 *cloudstack.ListVirtualRouterElementsResponse, r1 error) {
+	m.record("ListVirtualRouterElementsWithContext", ctx, p)
+	if m.ListVirtualRouterElementsWithContextFunc != nil {
+		return m.ListVirtualRouterElementsWithContextFunc(ctx, p)
+	}
+	if m.ListVirtualRouterElementsFunc != nil {
+		return m.ListVirtualRouterElementsFunc(p)
+	}
+	r1 = notMocked("RouterService.ListVirtualRouterElementsWithContext")
+	return
+}
+
+// NewListVirtualRouterElementsPager records the call and calls NewListVirtualRouterElementsPagerFunc
+func (m *MockRouterService) NewListVirtualRouterElementsPager(p *cloudstack.ListVirtualRouterElementsParams) (r0 *cloudstack.ListVirtualRouterElementsPager) {
+	m.record("NewListVirtualRouterElementsPager", p)
+	if m.NewListVirtualRouterElementsPagerFunc != nil {
+		return m.NewListVirtualRouterElementsPagerFunc(p)
+	}
+	return
+}
+
+// ListVirtualRouterElementsAll records the call and calls ListVirtualRouterElementsAllFunc, or ListVirtualRouterElementsAllWithContextFunc if only that is set
+func (m *MockRouterService) ListVirtualRouterElementsAll(p *cloudstack.ListVirtualRouterElementsParams) (r0 *cloudstack.ListVirtualRouterElementsResponse, r1 error) {
+	m.record("ListVirtualRouterElementsAll", p)
+	if m.ListVirtualRouterElementsAllFunc != nil {
+		return m.ListVirtualRouterElementsAllFunc(p)
+	}
+	if m.ListVirtualRouterElementsAllWithContextFunc != nil {
+		return m.ListVirtualRouterElementsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.ListVirtualRouterElementsAll")
+	return
+}
+
+// ListVirtualRouterElementsAllWithContext records the call and calls ListVirtualRouterElementsAllWithContextFunc, or ListVirtualRouterElementsAllFunc if only that is set
+func (m *MockRouterService) ListVirtualRouterElementsAllWithContext(ctx context.Context, p *cloudstack.ListVirtualRouterElementsParams) (r0 *cloudstack.ListVirtualRouterElementsResponse, r1 error) {
+	m.record("ListVirtualRouterElementsAllWithContext", ctx, p)
+	if m.ListVirtualRouterElementsAllWithContextFunc != nil {
+		return m.ListVirtualRouterElementsAllWithContextFunc(ctx, p)
+	}
+	if m.ListVirtualRouterElementsAllFunc != nil {
+		return m.ListVirtualRouterElementsAllFunc(p)
+	}
+	r1 = notMocked("RouterService.ListVirtualRouterElementsAllWithContext")
+	return
+}
+
+// NewRebootRouterParams records the call and calls NewRebootRouterParamsFunc
+func (m *MockRouterService) NewRebootRouterParams(id string) (r0 *cloudstack.RebootRouterParams) {
+	m.record("NewRebootRouterParams", id)
+	if m.NewRebootRouterParamsFunc != nil {
+		return m.NewRebootRouterParamsFunc(id)
+	}
+	return cloudstack.NewRouterService(nil).NewRebootRouterParams(id)
+}
+
+// RebootRouter records the call and calls RebootRouterFunc, or RebootRouterWithContextFunc if only that is set
+func (m *MockRouterService) RebootRouter(p *cloudstack.RebootRouterParams) (r0 *cloudstack.RebootRouterResponse, r1 error) {
+	m.record("RebootRouter", p)
+	if m.RebootRouterFunc != nil {
+		return m.RebootRouterFunc(p)
+	}
+	if m.RebootRouterWithContextFunc != nil {
+		return m.RebootRouterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.RebootRouter")
+	return
+}
+
+// RebootRouterWithContext records the call and calls RebootRouterWithContextFunc, or RebootRouterFunc if only that is set
+func (m *MockRouterService) RebootRouterWithContext(ctx context.Context, p *cloudstack.RebootRouterParams) (r0 *cloudstack.RebootRouterResponse, r1 error) {
+	m.record("RebootRouterWithContext", ctx, p)
+	if m.RebootRouterWithContextFunc != nil {
+		return m.RebootRouterWithContextFunc(ctx, p)
+	}
+	if m.RebootRouterFunc != nil {
+		return m.RebootRouterFunc(p)
+	}
+	r1 = notMocked("RouterService.RebootRouterWithContext")
+	return
+}
+
+// RebootRouterAsync records the call and calls RebootRouterAsyncFunc, or RebootRouterAsyncWithContextFunc if only that is set
+func (m *MockRouterService) RebootRouterAsync(p *cloudstack.RebootRouterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RebootRouterAsync", p)
+	if m.RebootRouterAsyncFunc != nil {
+		return m.RebootRouterAsyncFunc(p)
+	}
+	if m.RebootRouterAsyncWithContextFunc != nil {
+		return m.RebootRouterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.RebootRouterAsync")
+	return
+}
+
+// RebootRouterAsyncWithContext records the call and calls RebootRouterAsyncWithContextFunc, or RebootRouterAsyncFunc if only that is set
+func (m *MockRouterService) RebootRouterAsyncWithContext(ctx context.Context, p *cloudstack.RebootRouterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RebootRouterAsyncWithContext", ctx, p)
+	if m.RebootRouterAsyncWithContextFunc != nil {
+		return m.RebootRouterAsyncWithContextFunc(ctx, p)
+	}
+	if m.RebootRouterAsyncFunc != nil {
+		return m.RebootRouterAsyncFunc(p)
+	}
+	r1 = notMocked("RouterService.RebootRouterAsyncWithContext")
+	return
+}
+
+// NewStartRouterParams records the call and calls NewStartRouterParamsFunc
+func (m *MockRouterService) NewStartRouterParams(id string) (r0 *cloudstack.StartRouterParams) {
+	m.record("NewStartRouterParams", id)
+	if m.NewStartRouterParamsFunc != nil {
+		return m.NewStartRouterParamsFunc(id)
+	}
+	return cloudstack.NewRouterService(nil).NewStartRouterParams(id)
+}
+
+// StartRouter records the call and calls StartRouterFunc, or StartRouterWithContextFunc if only that is set
+func (m *MockRouterService) StartRouter(p *cloudstack.StartRouterParams) (r0 *cloudstack.StartRouterResponse, r1 error) {
+	m.record("StartRouter", p)
+	if m.StartRouterFunc != nil {
+		return m.StartRouterFunc(p)
+	}
+	if m.StartRouterWithContextFunc != nil {
+		return m.StartRouterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.StartRouter")
+	return
+}
+
+// StartRouterWithContext records the call and calls StartRouterWithContextFunc, or StartRouterFunc if only that is set
+func (m *MockRouterService) StartRouterWithContext(ctx context.Context, p *cloudstack.StartRouterParams) (r0 *cloudstack.StartRouterResponse, r1 error) {
+	m.record("StartRouterWithContext", ctx, p)
+	if m.StartRouterWithContextFunc != nil {
+		return m.StartRouterWithContextFunc(ctx, p)
+	}
+	if m.StartRouterFunc != nil {
+		return m.StartRouterFunc(p)
+	}
+	r1 = notMocked("RouterService.StartRouterWithContext")
+	return
+}
+
+// StartRouterAsync records the call and calls StartRouterAsyncFunc, or StartRouterAsyncWithContextFunc if only that is set
+func (m *MockRouterService) StartRouterAsync(p *cloudstack.StartRouterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StartRouterAsync", p)
+	if m.StartRouterAsyncFunc != nil {
+		return m.StartRouterAsyncFunc(p)
+	}
+	if m.StartRouterAsyncWithContextFunc != nil {
+		return m.StartRouterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.StartRouterAsync")
+	return
+}
+
+// StartRouterAsyncWithContext records the call and calls StartRouterAsyncWithContextFunc, or StartRouterAsyncFunc if only that is set
+func (m *MockRouterService) StartRouterAsyncWithContext(ctx context.Context, p *cloudstack.StartRouterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StartRouterAsyncWithContext", ctx, p)
+	if m.StartRouterAsyncWithContextFunc != nil {
+		return m.StartRouterAsyncWithContextFunc(ctx, p)
+	}
+	if m.StartRouterAsyncFunc != nil {
+		return m.StartRouterAsyncFunc(p)
+	}
+	r1 = notMocked("RouterService.StartRouterAsyncWithContext")
+	return
+}
+
+// NewStopRouterParams records the call and calls NewStopRouterParamsFunc
+func (m *MockRouterService) NewStopRouterParams(id string) (r0 *cloudstack.StopRouterParams) {
+	m.record("NewStopRouterParams", id)
+	if m.NewStopRouterParamsFunc != nil {
+		return m.NewStopRouterParamsFunc(id)
+	}
+	return cloudstack.NewRouterService(nil).NewStopRouterParams(id)
+}
+
+// StopRouter records the call and calls StopRouterFunc, or StopRouterWithContextFunc if only that is set
+func (m *MockRouterService) StopRouter(p *cloudstack.StopRouterParams) (r0 *cloudstack.StopRouterResponse, r1 error) {
+	m.record("StopRouter", p)
+	if m.StopRouterFunc != nil {
+		return m.StopRouterFunc(p)
+	}
+	if m.StopRouterWithContextFunc != nil {
+		return m.StopRouterWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.StopRouter")
+	return
+}
+
+// StopRouterWithContext records the call and calls StopRouterWithContextFunc, or StopRouterFunc if only that is set
+func (m *MockRouterService) StopRouterWithContext(ctx context.Context, p *cloudstack.StopRouterParams) (r0 *cloudstack.StopRouterResponse, r1 error) {
+	m.record("StopRouterWithContext", ctx, p)
+	if m.StopRouterWithContextFunc != nil {
+		return m.StopRouterWithContextFunc(ctx, p)
+	}
+	if m.StopRouterFunc != nil {
+		return m.StopRouterFunc(p)
+	}
+	r1 = notMocked("RouterService.StopRouterWithContext")
+	return
+}
+
+// StopRouterAsync records the call and calls StopRouterAsyncFunc, or StopRouterAsyncWithContextFunc if only that is set
+func (m *MockRouterService) StopRouterAsync(p *cloudstack.StopRouterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StopRouterAsync", p)
+	if m.StopRouterAsyncFunc != nil {
+		return m.StopRouterAsyncFunc(p)
+	}
+	if m.StopRouterAsyncWithContextFunc != nil {
+		return m.StopRouterAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("RouterService.StopRouterAsync")
+	return
+}
+
+// StopRouterAsyncWithContext records the call and calls StopRouterAsyncWithContextFunc, or StopRouterAsyncFunc if only that is set
+func (m *MockRouterService) StopRouterAsyncWithContext(ctx context.Context, p *cloudstack.StopRouterParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StopRouterAsyncWithContext", ctx, p)
+	if m.StopRouterAsyncWithContextFunc != nil {
+		return m.StopRouterAsyncWithContextFunc(ctx, p)
+	}
+	if m.StopRouterAsyncFunc != nil {
+		return m.StopRouterAsyncFunc(p)
+	}
+	r1 = notMocked("RouterService.StopRouterAsyncWithContext")
+	return
+}
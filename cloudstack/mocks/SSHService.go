@@ -0,0 +1,295 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockSSHService is a mock of cloudstack.SSHServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockSSHService struct {
+	Recorder
+
+	NewCreateSSHKeyPairParamsFunc                    func(name string) *cloudstack.CreateSSHKeyPairParams
+	CreateSSHKeyPairFunc                             func(p *cloudstack.CreateSSHKeyPairParams) (*cloudstack.CreateSSHKeyPairResponse, error)
+	CreateSSHKeyPairWithContextFunc                  func(ctx context.Context, p *cloudstack.CreateSSHKeyPairParams) (*cloudstack.CreateSSHKeyPairResponse, error)
+	NewDeleteSSHKeyPairParamsFunc                    func(name string) *cloudstack.DeleteSSHKeyPairParams
+	DeleteSSHKeyPairFunc                             func(p *cloudstack.DeleteSSHKeyPairParams) (*cloudstack.DeleteSSHKeyPairResponse, error)
+	DeleteSSHKeyPairWithContextFunc                  func(ctx context.Context, p *cloudstack.DeleteSSHKeyPairParams) (*cloudstack.DeleteSSHKeyPairResponse, error)
+	NewListSSHKeyPairsParamsFunc                     func() *cloudstack.ListSSHKeyPairsParams
+	ListSSHKeyPairsFunc                              func(p *cloudstack.ListSSHKeyPairsParams) (*cloudstack.ListSSHKeyPairsResponse, error)
+	ListSSHKeyPairsWithContextFunc                   func(ctx context.Context, p *cloudstack.ListSSHKeyPairsParams) (*cloudstack.ListSSHKeyPairsResponse, error)
+	NewListSSHKeyPairsPagerFunc                      func(p *cloudstack.ListSSHKeyPairsParams) *cloudstack.ListSSHKeyPairsPager
+	ListSSHKeyPairsAllFunc                           func(p *cloudstack.ListSSHKeyPairsParams) (*cloudstack.ListSSHKeyPairsResponse, error)
+	ListSSHKeyPairsAllWithContextFunc                func(ctx context.Context, p *cloudstack.ListSSHKeyPairsParams) (*cloudstack.ListSSHKeyPairsResponse, error)
+	NewRegisterSSHKeyPairParamsFunc                  func(name string, publickey string) *cloudstack.RegisterSSHKeyPairParams
+	RegisterSSHKeyPairFunc                           func(p *cloudstack.RegisterSSHKeyPairParams) (*cloudstack.RegisterSSHKeyPairResponse, error)
+	RegisterSSHKeyPairWithContextFunc                func(ctx context.Context, p *cloudstack.RegisterSSHKeyPairParams) (*cloudstack.RegisterSSHKeyPairResponse, error)
+	NewResetSSHKeyForVirtualMachineParamsFunc        func(id string, keypair string) *cloudstack.ResetSSHKeyForVirtualMachineParams
+	ResetSSHKeyForVirtualMachineFunc                 func(p *cloudstack.ResetSSHKeyForVirtualMachineParams) (*cloudstack.ResetSSHKeyForVirtualMachineResponse, error)
+	ResetSSHKeyForVirtualMachineWithContextFunc      func(ctx context.Context, p *cloudstack.ResetSSHKeyForVirtualMachineParams) (*cloudstack.ResetSSHKeyForVirtualMachineResponse, error)
+	ResetSSHKeyForVirtualMachineAsyncFunc            func(p *cloudstack.ResetSSHKeyForVirtualMachineParams) (*cloudstack.Job, error)
+	ResetSSHKeyForVirtualMachineAsyncWithContextFunc func(ctx context.Context, p *cloudstack.ResetSSHKeyForVirtualMachineParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.SSHServiceIface = (*MockSSHService)(nil)
+
+// NewCreateSSHKeyPairParams records the call and calls NewCreateSSHKeyPairParamsFunc
+func (m *MockSSHService) NewCreateSSHKeyPairParams(name string) (r0 *cloudstack.CreateSSHKeyPairParams) {
+	m.record("NewCreateSSHKeyPairParams", name)
+	if m.NewCreateSSHKeyPairParamsFunc != nil {
+		return m.NewCreateSSHKeyPairParamsFunc(name)
+	}
+	return cloudstack.NewSSHService(nil).NewCreateSSHKeyPairParams(name)
+}
+
+// CreateSSHKeyPair records the call and calls CreateSSHKeyPairFunc, or CreateSSHKeyPairWithContextFunc if only that is set
+func (m *MockSSHService) CreateSSHKeyPair(p *cloudstack.CreateSSHKeyPairParams) (r0 *cloudstack.CreateSSHKeyPairResponse, r1 error) {
+	m.record("CreateSSHKeyPair", p)
+	if m.CreateSSHKeyPairFunc != nil {
+		return m.CreateSSHKeyPairFunc(p)
+	}
+	if m.CreateSSHKeyPairWithContextFunc != nil {
+		return m.CreateSSHKeyPairWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SSHService.CreateSSHKeyPair")
+	return
+}
+
+// CreateSSHKeyPairWithContext records the call and calls CreateSSHKeyPairWithContextFunc, or CreateSSHKeyPairFunc if only that is set
+func (m *MockSSHService) CreateSSHKeyPairWithContext(ctx context.Context, p *cloudstack.CreateSSHKeyPairParams) (r0 *cloudstack.CreateSSHKeyPairResponse, r1 error) {
+	m.record("CreateSSHKeyPairWithContext", ctx, p)
+	if m.CreateSSHKeyPairWithContextFunc != nil {
+		return m.CreateSSHKeyPairWithContextFunc(ctx, p)
+	}
+	if m.CreateSSHKeyPairFunc != nil {
+		return m.CreateSSHKeyPairFunc(p)
+	}
+	r1 = notMocked("SSHService.CreateSSHKeyPairWithContext")
+	return
+}
+
+// NewDeleteSSHKeyPairParams records the call and calls NewDeleteSSHKeyPairParamsFunc
+func (m *MockSSHService) NewDeleteSSHKeyPairParams(name string) (r0 *cloudstack.DeleteSSHKeyPairParams) {
+	m.record("NewDeleteSSHKeyPairParams", name)
+	if m.NewDeleteSSHKeyPairParamsFunc != nil {
+		return m.NewDeleteSSHKeyPairParamsFunc(name)
+	}
+	return cloudstack.NewSSHService(nil).NewDeleteSSHKeyPairParams(name)
+}
+
+// DeleteSSHKeyPair records the call and calls DeleteSSHKeyPairFunc, or DeleteSSHKeyPairWithContextFunc if only that is set
+func (m *MockSSHService) DeleteSSHKeyPair(p *cloudstack.DeleteSSHKeyPairParams) (r0 *cloudstack.DeleteSSHKeyPairResponse, r1 error) {
+	m.record("DeleteSSHKeyPair", p)
+	if m.DeleteSSHKeyPairFunc != nil {
+		return m.DeleteSSHKeyPairFunc(p)
+	}
+	if m.DeleteSSHKeyPairWithContextFunc != nil {
+		return m.DeleteSSHKeyPairWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SSHService.DeleteSSHKeyPair")
+	return
+}
+
+// DeleteSSHKeyPairWithContext records the call and calls DeleteSSHKeyPairWithContextFunc, or DeleteSSHKeyPairFunc if only that is set
+func (m *MockSSHService) DeleteSSHKeyPairWithContext(ctx context.Context, p *cloudstack.DeleteSSHKeyPairParams) (r0 *cloudstack.DeleteSSHKeyPairResponse, r1 error) {
+	m.record("DeleteSSHKeyPairWithContext", ctx, p)
+	if m.DeleteSSHKeyPairWithContextFunc != nil {
+		return m.DeleteSSHKeyPairWithContextFunc(ctx, p)
+	}
+	if m.DeleteSSHKeyPairFunc != nil {
+		return m.DeleteSSHKeyPairFunc(p)
+	}
+	r1 = notMocked("SSHService.DeleteSSHKeyPairWithContext")
+	return
+}
+
+// NewListSSHKeyPairsParams records the call and calls NewListSSHKeyPairsParamsFunc
+func (m *MockSSHService) NewListSSHKeyPairsParams() (r0 *cloudstack.ListSSHKeyPairsParams) {
+	m.record("NewListSSHKeyPairsParams")
+	if m.NewListSSHKeyPairsParamsFunc != nil {
+		return m.NewListSSHKeyPairsParamsFunc()
+	}
+	return cloudstack.NewSSHService(nil).NewListSSHKeyPairsParams()
+}
+
+// ListSSHKeyPairs records the call and calls ListSSHKeyPairsFunc, or ListSSHKeyPairsWithContextFunc if only that is set
+func (m *MockSSHService) ListSSHKeyPairs(p *cloudstack.ListSSHKeyPairsParams) (r0 *cloudstack.ListSSHKeyPairsResponse, r1 error) {
+	m.record("ListSSHKeyPairs", p)
+	if m.ListSSHKeyPairsFunc != nil {
+		return m.ListSSHKeyPairsFunc(p)
+	}
+	if m.ListSSHKeyPairsWithContextFunc != nil {
+		return m.ListSSHKeyPairsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SSHService.ListSSHKeyPairs")
+	return
+}
+
+// ListSSHKeyPairsWithContext records the call and calls ListSSHKeyPairsWithContextFunc, or ListSSHKeyPairsFunc if only that is set
+func (m *MockSSHService) ListSSHKeyPairsWithContext(ctx context.Context, p *cloudstack.ListSSHKeyPairsParams) (r0 *cloudstack.ListSSHKeyPairsResponse, r1 error) {
+	m.record("ListSSHKeyPairsWithContext", ctx, p)
+	if m.ListSSHKeyPairsWithContextFunc != nil {
+		return m.ListSSHKeyPairsWithContextFunc(ctx, p)
+	}
+	if m.ListSSHKeyPairsFunc != nil {
+		return m.ListSSHKeyPairsFunc(p)
+	}
+	r1 = notMocked("SSHService.ListSSHKeyPairsWithContext")
+	return
+}
+
+// NewListSSHKeyPairsPager records the call and calls NewListSSHKeyPairsPagerFunc
+func (m *MockSSHService) NewListSSHKeyPairsPager(p *cloudstack.ListSSHKeyPairsParams) (r0 *cloudstack.ListSSHKeyPairsPager) {
+	m.record("NewListSSHKeyPairsPager", p)
+	if m.NewListSSHKeyPairsPagerFunc != nil {
+		return m.NewListSSHKeyPairsPagerFunc(p)
+	}
+	return
+}
+
+// ListSSHKeyPairsAll records the call and calls ListSSHKeyPairsAllFunc, or ListSSHKeyPairsAllWithContextFunc if only that is set
+func (m *MockSSHService) ListSSHKeyPairsAll(p *cloudstack.ListSSHKeyPairsParams) (r0 *cloudstack.ListSSHKeyPairsResponse, r1 error) {
+	m.record("ListSSHKeyPairsAll", p)
+	if m.ListSSHKeyPairsAllFunc != nil {
+		return m.ListSSHKeyPairsAllFunc(p)
+	}
+	if m.ListSSHKeyPairsAllWithContextFunc != nil {
+		return m.ListSSHKeyPairsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SSHService.ListSSHKeyPairsAll")
+	return
+}
+
+// ListSSHKeyPairsAllWithContext records the call and calls ListSSHKeyPairsAllWithContextFunc, or ListSSHKeyPairsAllFunc if only that is set
+func (m *MockSSHService) ListSSHKeyPairsAllWithContext(ctx context.Context, p *cloudstack.ListSSHKeyPairsParams) (r0 *cloudstack.ListSSHKeyPairsResponse, r1 error) {
+	m.record("ListSSHKeyPairsAllWithContext", ctx, p)
+	if m.ListSSHKeyPairsAllWithContextFunc != nil {
+		return m.ListSSHKeyPairsAllWithContextFunc(ctx, p)
+	}
+	if m.ListSSHKeyPairsAllFunc != nil {
+		return m.ListSSHKeyPairsAllFunc(p)
+	}
+	r1 = notMocked("SSHService.ListSSHKeyPairsAllWithContext")
+	return
+}
+
+// NewRegisterSSHKeyPairParams records the call and calls NewRegisterSSHKeyPairParamsFunc
+func (m *MockSSHService) NewRegisterSSHKeyPairParams(name string, publickey string) (r0 *cloudstack.RegisterSSHKeyPairParams) {
+	m.record("NewRegisterSSHKeyPairParams", name, publickey)
+	if m.NewRegisterSSHKeyPairParamsFunc != nil {
+		return m.NewRegisterSSHKeyPairParamsFunc(name, publickey)
+	}
+	return cloudstack.NewSSHService(nil).NewRegisterSSHKeyPairParams(name, publickey)
+}
+
+// RegisterSSHKeyPair records the call and calls RegisterSSHKeyPairFunc, or RegisterSSHKeyPairWithContextFunc if only that is set
+func (m *MockSSHService) RegisterSSHKeyPair(p *cloudstack.RegisterSSHKeyPairParams) (r0 *cloudstack.RegisterSSHKeyPairResponse, r1 error) {
+	m.record("RegisterSSHKeyPair", p)
+	if m.RegisterSSHKeyPairFunc != nil {
+		return m.RegisterSSHKeyPairFunc(p)
+	}
+	if m.RegisterSSHKeyPairWithContextFunc != nil {
+		return m.RegisterSSHKeyPairWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SSHService.RegisterSSHKeyPair")
+	return
+}
+
+// RegisterSSHKeyPairWithContext records the call and calls RegisterSSHKeyPairWithContextFunc, or RegisterSSHKeyPairFunc if only that is set
+func (m *MockSSHService) RegisterSSHKeyPairWithContext(ctx context.Context, p *cloudstack.RegisterSSHKeyPairParams) (r0 *cloudstack.RegisterSSHKeyPairResponse, r1 error) {
+	m.record("RegisterSSHKeyPairWithContext", ctx, p)
+	if m.RegisterSSHKeyPairWithContextFunc != nil {
+		return m.RegisterSSHKeyPairWithContextFunc(ctx, p)
+	}
+	if m.RegisterSSHKeyPairFunc != nil {
+		return m.RegisterSSHKeyPairFunc(p)
+	}
+	r1 = notMocked("SSHService.RegisterSSHKeyPairWithContext")
+	return
+}
+
+// NewResetSSHKeyForVirtualMachineParams records the call and calls NewResetSSHKeyForVirtualMachineParamsFunc
+func (m *MockSSHService) NewResetSSHKeyForVirtualMachineParams(id string, keypair string) (r0 *cloudstack.ResetSSHKeyForVirtualMachineParams) {
+	m.record("NewResetSSHKeyForVirtualMachineParams", id, keypair)
+	if m.NewResetSSHKeyForVirtualMachineParamsFunc != nil {
+		return m.NewResetSSHKeyForVirtualMachineParamsFunc(id, keypair)
+	}
+	return cloudstack.NewSSHService(nil).NewResetSSHKeyForVirtualMachineParams(id, keypair)
+}
+
+// ResetSSHKeyForVirtualMachine records the call and calls ResetSSHKeyForVirtualMachineFunc, or ResetSSHKeyForVirtualMachineWithContextFunc if only that is set
+func (m *MockSSHService) ResetSSHKeyForVirtualMachine(p *cloudstack.ResetSSHKeyForVirtualMachineParams) (r0 *cloudstack.ResetSSHKeyForVirtualMachineResponse, r1 error) {
+	m.record("ResetSSHKeyForVirtualMachine", p)
+	if m.ResetSSHKeyForVirtualMachineFunc != nil {
+		return m.ResetSSHKeyForVirtualMachineFunc(p)
+	}
+	if m.ResetSSHKeyForVirtualMachineWithContextFunc != nil {
+		return m.ResetSSHKeyForVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SSHService.ResetSSHKeyForVirtualMachine")
+	return
+}
+
+// ResetSSHKeyForVirtualMachineWithContext records the call and calls ResetSSHKeyForVirtualMachineWithContextFunc, or ResetSSHKeyForVirtualMachineFunc if only that is set
+func (m *MockSSHService) ResetSSHKeyForVirtualMachineWithContext(ctx context.Context, p *cloudstack.ResetSSHKeyForVirtualMachineParams) (r0 *cloudstack.ResetSSHKeyForVirtualMachineResponse, r1 error) {
+	m.record("ResetSSHKeyForVirtualMachineWithContext", ctx, p)
+	if m.ResetSSHKeyForVirtualMachineWithContextFunc != nil {
+		return m.ResetSSHKeyForVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.ResetSSHKeyForVirtualMachineFunc != nil {
+		return m.ResetSSHKeyForVirtualMachineFunc(p)
+	}
+	r1 = notMocked("SSHService.ResetSSHKeyForVirtualMachineWithContext")
+	return
+}
+
+// ResetSSHKeyForVirtualMachineAsync records the call and calls ResetSSHKeyForVirtualMachineAsyncFunc, or ResetSSHKeyForVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockSSHService) ResetSSHKeyForVirtualMachineAsync(p *cloudstack.ResetSSHKeyForVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ResetSSHKeyForVirtualMachineAsync", p)
+	if m.ResetSSHKeyForVirtualMachineAsyncFunc != nil {
+		return m.ResetSSHKeyForVirtualMachineAsyncFunc(p)
+	}
+	if m.ResetSSHKeyForVirtualMachineAsyncWithContextFunc != nil {
+		return m.ResetSSHKeyForVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SSHService.ResetSSHKeyForVirtualMachineAsync")
+	return
+}
+
+// ResetSSHKeyForVirtualMachineAsyncWithContext records the call and calls ResetSSHKeyForVirtualMachineAsyncWithContextFunc, or ResetSSHKeyForVirtualMachineAsyncFunc if only that is set
+func (m *MockSSHService) ResetSSHKeyForVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.ResetSSHKeyForVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ResetSSHKeyForVirtualMachineAsyncWithContext", ctx, p)
+	if m.ResetSSHKeyForVirtualMachineAsyncWithContextFunc != nil {
+		return m.ResetSSHKeyForVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.ResetSSHKeyForVirtualMachineAsyncFunc != nil {
+		return m.ResetSSHKeyForVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("SSHService.ResetSSHKeyForVirtualMachineAsyncWithContext")
+	return
+}
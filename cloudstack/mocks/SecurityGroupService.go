@@ -0,0 +1,577 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockSecurityGroupService is a mock of cloudstack.SecurityGroupServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockSecurityGroupService struct {
+	Recorder
+
+	NewAuthorizeSecurityGroupEgressParamsFunc         func() *cloudstack.AuthorizeSecurityGroupEgressParams
+	AuthorizeSecurityGroupEgressFunc                  func(p *cloudstack.AuthorizeSecurityGroupEgressParams) (*cloudstack.AuthorizeSecurityGroupEgressResponse, error)
+	AuthorizeSecurityGroupEgressWithContextFunc       func(ctx context.Context, p *cloudstack.AuthorizeSecurityGroupEgressParams) (*cloudstack.AuthorizeSecurityGroupEgressResponse, error)
+	AuthorizeSecurityGroupEgressAsyncFunc             func(p *cloudstack.AuthorizeSecurityGroupEgressParams) (*cloudstack.Job, error)
+	AuthorizeSecurityGroupEgressAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.AuthorizeSecurityGroupEgressParams) (*cloudstack.Job, error)
+	NewAuthorizeSecurityGroupIngressParamsFunc        func() *cloudstack.AuthorizeSecurityGroupIngressParams
+	AuthorizeSecurityGroupIngressFunc                 func(p *cloudstack.AuthorizeSecurityGroupIngressParams) (*cloudstack.AuthorizeSecurityGroupIngressResponse, error)
+	AuthorizeSecurityGroupIngressWithContextFunc      func(ctx context.Context, p *cloudstack.AuthorizeSecurityGroupIngressParams) (*cloudstack.AuthorizeSecurityGroupIngressResponse, error)
+	AuthorizeSecurityGroupIngressAsyncFunc            func(p *cloudstack.AuthorizeSecurityGroupIngressParams) (*cloudstack.Job, error)
+	AuthorizeSecurityGroupIngressAsyncWithContextFunc func(ctx context.Context, p *cloudstack.AuthorizeSecurityGroupIngressParams) (*cloudstack.Job, error)
+	NewCreateSecurityGroupParamsFunc                  func(name string) *cloudstack.CreateSecurityGroupParams
+	CreateSecurityGroupFunc                           func(p *cloudstack.CreateSecurityGroupParams) (*cloudstack.CreateSecurityGroupResponse, error)
+	CreateSecurityGroupWithContextFunc                func(ctx context.Context, p *cloudstack.CreateSecurityGroupParams) (*cloudstack.CreateSecurityGroupResponse, error)
+	NewDeleteSecurityGroupParamsFunc                  func() *cloudstack.DeleteSecurityGroupParams
+	DeleteSecurityGroupFunc                           func(p *cloudstack.DeleteSecurityGroupParams) (*cloudstack.DeleteSecurityGroupResponse, error)
+	DeleteSecurityGroupWithContextFunc                func(ctx context.Context, p *cloudstack.DeleteSecurityGroupParams) (*cloudstack.DeleteSecurityGroupResponse, error)
+	NewListSecurityGroupsParamsFunc                   func() *cloudstack.ListSecurityGroupsParams
+	GetSecurityGroupIDFunc                            func(keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetSecurityGroupIDWithContextFunc                 func(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetSecurityGroupByNameFunc                        func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.SecurityGroup, int, error)
+	GetSecurityGroupByNameWithContextFunc             func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.SecurityGroup, int, error)
+	GetSecurityGroupByIDFunc                          func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.SecurityGroup, int, error)
+	GetSecurityGroupByIDWithContextFunc               func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.SecurityGroup, int, error)
+	ListSecurityGroupsFunc                            func(p *cloudstack.ListSecurityGroupsParams) (*cloudstack.ListSecurityGroupsResponse, error)
+	ListSecurityGroupsWithContextFunc                 func(ctx context.Context, p *cloudstack.ListSecurityGroupsParams) (*cloudstack.ListSecurityGroupsResponse, error)
+	NewListSecurityGroupsPagerFunc                    func(p *cloudstack.ListSecurityGroupsParams) *cloudstack.ListSecurityGroupsPager
+	ListSecurityGroupsAllFunc                         func(p *cloudstack.ListSecurityGroupsParams) (*cloudstack.ListSecurityGroupsResponse, error)
+	ListSecurityGroupsAllWithContextFunc              func(ctx context.Context, p *cloudstack.ListSecurityGroupsParams) (*cloudstack.ListSecurityGroupsResponse, error)
+	NewRevokeSecurityGroupEgressParamsFunc            func(id string) *cloudstack.RevokeSecurityGroupEgressParams
+	RevokeSecurityGroupEgressFunc                     func(p *cloudstack.RevokeSecurityGroupEgressParams) (*cloudstack.RevokeSecurityGroupEgressResponse, error)
+	RevokeSecurityGroupEgressWithContextFunc          func(ctx context.Context, p *cloudstack.RevokeSecurityGroupEgressParams) (*cloudstack.RevokeSecurityGroupEgressResponse, error)
+	RevokeSecurityGroupEgressAsyncFunc                func(p *cloudstack.RevokeSecurityGroupEgressParams) (*cloudstack.Job, error)
+	RevokeSecurityGroupEgressAsyncWithContextFunc     func(ctx context.Context, p *cloudstack.RevokeSecurityGroupEgressParams) (*cloudstack.Job, error)
+	NewRevokeSecurityGroupIngressParamsFunc           func(id string) *cloudstack.RevokeSecurityGroupIngressParams
+	RevokeSecurityGroupIngressFunc                    func(p *cloudstack.RevokeSecurityGroupIngressParams) (*cloudstack.RevokeSecurityGroupIngressResponse, error)
+	RevokeSecurityGroupIngressWithContextFunc         func(ctx context.Context, p *cloudstack.RevokeSecurityGroupIngressParams) (*cloudstack.RevokeSecurityGroupIngressResponse, error)
+	RevokeSecurityGroupIngressAsyncFunc               func(p *cloudstack.RevokeSecurityGroupIngressParams) (*cloudstack.Job, error)
+	RevokeSecurityGroupIngressAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.RevokeSecurityGroupIngressParams) (*cloudstack.Job, error)
+	NewUpdateSecurityGroupParamsFunc                  func(id string) *cloudstack.UpdateSecurityGroupParams
+	UpdateSecurityGroupFunc                           func(p *cloudstack.UpdateSecurityGroupParams) (*cloudstack.UpdateSecurityGroupResponse, error)
+	UpdateSecurityGroupWithContextFunc                func(ctx context.Context, p *cloudstack.UpdateSecurityGroupParams) (*cloudstack.UpdateSecurityGroupResponse, error)
+}
+
+var _ cloudstack.SecurityGroupServiceIface = (*MockSecurityGroupService)(nil)
+
+// NewAuthorizeSecurityGroupEgressParams records the call and calls NewAuthorizeSecurityGroupEgressParamsFunc
+func (m *MockSecurityGroupService) NewAuthorizeSecurityGroupEgressParams() (r0 *cloudstack.AuthorizeSecurityGroupEgressParams) {
+	m.record("NewAuthorizeSecurityGroupEgressParams")
+	if m.NewAuthorizeSecurityGroupEgressParamsFunc != nil {
+		return m.NewAuthorizeSecurityGroupEgressParamsFunc()
+	}
+	return cloudstack.NewSecurityGroupService(nil).NewAuthorizeSecurityGroupEgressParams()
+}
+
+// AuthorizeSecurityGroupEgress records the call and calls AuthorizeSecurityGroupEgressFunc, or AuthorizeSecurityGroupEgressWithContextFunc if only that is set
+func (m *MockSecurityGroupService) AuthorizeSecurityGroupEgress(p *cloudstack.AuthorizeSecurityGroupEgressParams) (r0 *cloudstack.AuthorizeSecurityGroupEgressResponse, r1 error) {
+	m.record("AuthorizeSecurityGroupEgress", p)
+	if m.AuthorizeSecurityGroupEgressFunc != nil {
+		return m.AuthorizeSecurityGroupEgressFunc(p)
+	}
+	if m.AuthorizeSecurityGroupEgressWithContextFunc != nil {
+		return m.AuthorizeSecurityGroupEgressWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SecurityGroupService.AuthorizeSecurityGroupEgress")
+	return
+}
+
+// AuthorizeSecurityGroupEgressWithContext records the call and calls AuthorizeSecurityGroupEgressWithContextFunc, or AuthorizeSecurityGroupEgressFunc if only that is set
+func (m *MockSecurityGroupService) AuthorizeSecurityGroupEgressWithContext(ctx context.Context, p *cloudstack.AuthorizeSecurityGroupEgressParams) (r0 *cloudstack.AuthorizeSecurityGroupEgressResponse, r1 error) {
+	m.record("AuthorizeSecurityGroupEgressWithContext", ctx, p)
+	if m.AuthorizeSecurityGroupEgressWithContextFunc != nil {
+		return m.AuthorizeSecurityGroupEgressWithContextFunc(ctx, p)
+	}
+	if m.AuthorizeSecurityGroupEgressFunc != nil {
+		return m.AuthorizeSecurityGroupEgressFunc(p)
+	}
+	r1 = notMocked("SecurityGroupService.AuthorizeSecurityGroupEgressWithContext")
+	return
+}
+
+// AuthorizeSecurityGroupEgressAsync records the call and calls AuthorizeSecurityGroupEgressAsyncFunc, or AuthorizeSecurityGroupEgressAsyncWithContextFunc if only that is set
+func (m *MockSecurityGroupService) AuthorizeSecurityGroupEgressAsync(p *cloudstack.AuthorizeSecurityGroupEgressParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AuthorizeSecurityGroupEgressAsync", p)
+	if m.AuthorizeSecurityGroupEgressAsyncFunc != nil {
+		return m.AuthorizeSecurityGroupEgressAsyncFunc(p)
+	}
+	if m.AuthorizeSecurityGroupEgressAsyncWithContextFunc != nil {
+		return m.AuthorizeSecurityGroupEgressAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SecurityGroupService.AuthorizeSecurityGroupEgressAsync")
+	return
+}
+
+// AuthorizeSecurityGroupEgressAsyncWithContext records the call and calls AuthorizeSecurityGroupEgressAsyncWithContextFunc, or AuthorizeSecurityGroupEgressAsyncFunc if only that is set
+func (m *MockSecurityGroupService) AuthorizeSecurityGroupEgressAsyncWithContext(ctx context.Context, p *cloudstack.AuthorizeSecurityGroupEgressParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AuthorizeSecurityGroupEgressAsyncWithContext", ctx, p)
+	if m.AuthorizeSecurityGroupEgressAsyncWithContextFunc != nil {
+		return m.AuthorizeSecurityGroupEgressAsyncWithContextFunc(ctx, p)
+	}
+	if m.AuthorizeSecurityGroupEgressAsyncFunc != nil {
+		return m.AuthorizeSecurityGroupEgressAsyncFunc(p)
+	}
+	r1 = notMocked("SecurityGroupService.AuthorizeSecurityGroupEgressAsyncWithContext")
+	return
+}
+
+// NewAuthorizeSecurityGroupIngressParams records the call and calls NewAuthorizeSecurityGroupIngressParamsFunc
+func (m *MockSecurityGroupService) NewAuthorizeSecurityGroupIngressParams() (r0 *cloudstack.AuthorizeSecurityGroupIngressParams) {
+	m.record("NewAuthorizeSecurityGroupIngressParams")
+	if m.NewAuthorizeSecurityGroupIngressParamsFunc != nil {
+		return m.NewAuthorizeSecurityGroupIngressParamsFunc()
+	}
+	return cloudstack.NewSecurityGroupService(nil).NewAuthorizeSecurityGroupIngressParams()
+}
+
+// AuthorizeSecurityGroupIngress records the call and calls AuthorizeSecurityGroupIngressFunc, or AuthorizeSecurityGroupIngressWithContextFunc if only that is set
+func (m *MockSecurityGroupService) AuthorizeSecurityGroupIngress(p *cloudstack.AuthorizeSecurityGroupIngressParams) (r0 *cloudstack.AuthorizeSecurityGroupIngressResponse, r1 error) {
+	m.record("AuthorizeSecurityGroupIngress", p)
+	if m.AuthorizeSecurityGroupIngressFunc != nil {
+		return m.AuthorizeSecurityGroupIngressFunc(p)
+	}
+	if m.AuthorizeSecurityGroupIngressWithContextFunc != nil {
+		return m.AuthorizeSecurityGroupIngressWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SecurityGroupService.AuthorizeSecurityGroupIngress")
+	return
+}
+
+// AuthorizeSecurityGroupIngressWithContext records the call and calls AuthorizeSecurityGroupIngressWithContextFunc, or AuthorizeSecurityGroupIngressFunc if only that is set
+func (m *MockSecurityGroupService) AuthorizeSecurityGroupIngressWithContext(ctx context.Context, p *cloudstack.AuthorizeSecurityGroupIngressParams) (r0 *cloudstack.AuthorizeSecurityGroupIngressResponse, r1 error) {
+	m.record("AuthorizeSecurityGroupIngressWithContext", ctx, p)
+	if m.AuthorizeSecurityGroupIngressWithContextFunc != nil {
+		return m.AuthorizeSecurityGroupIngressWithContextFunc(ctx, p)
+	}
+	if m.AuthorizeSecurityGroupIngressFunc != nil {
+		return m.AuthorizeSecurityGroupIngressFunc(p)
+	}
+	r1 = notMocked("SecurityGroupService.AuthorizeSecurityGroupIngressWithContext")
+	return
+}
+
+// AuthorizeSecurityGroupIngressAsync records the call and calls AuthorizeSecurityGroupIngressAsyncFunc, or AuthorizeSecurityGroupIngressAsyncWithContextFunc if only that is set
+func (m *MockSecurityGroupService) AuthorizeSecurityGroupIngressAsync(p *cloudstack.AuthorizeSecurityGroupIngressParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AuthorizeSecurityGroupIngressAsync", p)
+	if m.AuthorizeSecurityGroupIngressAsyncFunc != nil {
+		return m.AuthorizeSecurityGroupIngressAsyncFunc(p)
+	}
+	if m.AuthorizeSecurityGroupIngressAsyncWithContextFunc != nil {
+		return m.AuthorizeSecurityGroupIngressAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SecurityGroupService.AuthorizeSecurityGroupIngressAsync")
+	return
+}
+
+// AuthorizeSecurityGroupIngressAsyncWithContext records the call and calls AuthorizeSecurityGroupIngressAsyncWithContextFunc, or AuthorizeSecurityGroupIngressAsyncFunc if only that is set
+func (m *MockSecurityGroupService) AuthorizeSecurityGroupIngressAsyncWithContext(ctx context.Context, p *cloudstack.AuthorizeSecurityGroupIngressParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AuthorizeSecurityGroupIngressAsyncWithContext", ctx, p)
+	if m.AuthorizeSecurityGroupIngressAsyncWithContextFunc != nil {
+		return m.AuthorizeSecurityGroupIngressAsyncWithContextFunc(ctx, p)
+	}
+	if m.AuthorizeSecurityGroupIngressAsyncFunc != nil {
+		return m.AuthorizeSecurityGroupIngressAsyncFunc(p)
+	}
+	r1 = notMocked("SecurityGroupService.AuthorizeSecurityGroupIngressAsyncWithContext")
+	return
+}
+
+// NewCreateSecurityGroupParams records the call and calls NewCreateSecurityGroupParamsFunc
+func (m *MockSecurityGroupService) NewCreateSecurityGroupParams(name string) (r0 *cloudstack.CreateSecurityGroupParams) {
+	m.record("NewCreateSecurityGroupParams", name)
+	if m.NewCreateSecurityGroupParamsFunc != nil {
+		return m.NewCreateSecurityGroupParamsFunc(name)
+	}
+	return cloudstack.NewSecurityGroupService(nil).NewCreateSecurityGroupParams(name)
+}
+
+// CreateSecurityGroup records the call and calls CreateSecurityGroupFunc, or CreateSecurityGroupWithContextFunc if only that is set
+func (m *MockSecurityGroupService) CreateSecurityGroup(p *cloudstack.CreateSecurityGroupParams) (r0 *cloudstack.CreateSecurityGroupResponse, r1 error) {
+	m.record("CreateSecurityGroup", p)
+	if m.CreateSecurityGroupFunc != nil {
+		return m.CreateSecurityGroupFunc(p)
+	}
+	if m.CreateSecurityGroupWithContextFunc != nil {
+		return m.CreateSecurityGroupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SecurityGroupService.CreateSecurityGroup")
+	return
+}
+
+// CreateSecurityGroupWithContext records the call and calls CreateSecurityGroupWithContextFunc, or CreateSecurityGroupFunc if only that is set
+func (m *MockSecurityGroupService) CreateSecurityGroupWithContext(ctx context.Context, p *cloudstack.CreateSecurityGroupParams) (r0 *cloudstack.CreateSecurityGroupResponse, r1 error) {
+	m.record("CreateSecurityGroupWithContext", ctx, p)
+	if m.CreateSecurityGroupWithContextFunc != nil {
+		return m.CreateSecurityGroupWithContextFunc(ctx, p)
+	}
+	if m.CreateSecurityGroupFunc != nil {
+		return m.CreateSecurityGroupFunc(p)
+	}
+	r1 = notMocked("SecurityGroupService.CreateSecurityGroupWithContext")
+	return
+}
+
+// NewDeleteSecurityGroupParams records the call and calls NewDeleteSecurityGroupParamsFunc
+func (m *MockSecurityGroupService) NewDeleteSecurityGroupParams() (r0 *cloudstack.DeleteSecurityGroupParams) {
+	m.record("NewDeleteSecurityGroupParams")
+	if m.NewDeleteSecurityGroupParamsFunc != nil {
+		return m.NewDeleteSecurityGroupParamsFunc()
+	}
+	return cloudstack.NewSecurityGroupService(nil).NewDeleteSecurityGroupParams()
+}
+
+// DeleteSecurityGroup records the call and calls DeleteSecurityGroupFunc, or DeleteSecurityGroupWithContextFunc if only that is set
+func (m *MockSecurityGroupService) DeleteSecurityGroup(p *cloudstack.DeleteSecurityGroupParams) (r0 *cloudstack.DeleteSecurityGroupResponse, r1 error) {
+	m.record("DeleteSecurityGroup", p)
+	if m.DeleteSecurityGroupFunc != nil {
+		return m.DeleteSecurityGroupFunc(p)
+	}
+	if m.DeleteSecurityGroupWithContextFunc != nil {
+		return m.DeleteSecurityGroupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SecurityGroupService.DeleteSecurityGroup")
+	return
+}
+
+// DeleteSecurityGroupWithContext records the call and calls DeleteSecurityGroupWithContextFunc, or DeleteSecurityGroupFunc if only that is set
+func (m *MockSecurityGroupService) DeleteSecurityGroupWithContext(ctx context.Context, p *cloudstack.DeleteSecurityGroupParams) (r0 *cloudstack.DeleteSecurityGroupResponse, r1 error) {
+	m.record("DeleteSecurityGroupWithContext", ctx, p)
+	if m.DeleteSecurityGroupWithContextFunc != nil {
+		return m.DeleteSecurityGroupWithContextFunc(ctx, p)
+	}
+	if m.DeleteSecurityGroupFunc != nil {
+		return m.DeleteSecurityGroupFunc(p)
+	}
+	r1 = notMocked("SecurityGroupService.DeleteSecurityGroupWithContext")
+	return
+}
+
+// NewListSecurityGroupsParams records the call and calls NewListSecurityGroupsParamsFunc
+func (m *MockSecurityGroupService) NewListSecurityGroupsParams() (r0 *cloudstack.ListSecurityGroupsParams) {
+	m.record("NewListSecurityGroupsParams")
+	if m.NewListSecurityGroupsParamsFunc != nil {
+		return m.NewListSecurityGroupsParamsFunc()
+	}
+	return cloudstack.NewSecurityGroupService(nil).NewListSecurityGroupsParams()
+}
+
+// GetSecurityGroupID records the call and calls GetSecurityGroupIDFunc, or GetSecurityGroupIDWithContextFunc if only that is set
+func (m *MockSecurityGroupService) GetSecurityGroupID(keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetSecurityGroupID", keyword, opts)
+	if m.GetSecurityGroupIDFunc != nil {
+		return m.GetSecurityGroupIDFunc(keyword, opts...)
+	}
+	if m.GetSecurityGroupIDWithContextFunc != nil {
+		return m.GetSecurityGroupIDWithContextFunc(context.Background(), keyword, opts...)
+	}
+	r2 = notMocked("SecurityGroupService.GetSecurityGroupID")
+	return
+}
+
+// GetSecurityGroupIDWithContext records the call and calls GetSecurityGroupIDWithContextFunc, or GetSecurityGroupIDFunc if only that is set
+func (m *MockSecurityGroupService) GetSecurityGroupIDWithContext(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetSecurityGroupIDWithContext", ctx, keyword, opts)
+	if m.GetSecurityGroupIDWithContextFunc != nil {
+		return m.GetSecurityGroupIDWithContextFunc(ctx, keyword, opts...)
+	}
+	if m.GetSecurityGroupIDFunc != nil {
+		return m.GetSecurityGroupIDFunc(keyword, opts...)
+	}
+	r2 = notMocked("SecurityGroupService.GetSecurityGroupIDWithContext")
+	return
+}
+
+// GetSecurityGroupByName records the call and calls GetSecurityGroupByNameFunc, or GetSecurityGroupByNameWithContextFunc if only that is set
+func (m *MockSecurityGroupService) GetSecurityGroupByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.SecurityGroup, r1 int, r2 error) {
+	m.record("GetSecurityGroupByName", name, opts)
+	if m.GetSecurityGroupByNameFunc != nil {
+		return m.GetSecurityGroupByNameFunc(name, opts...)
+	}
+	if m.GetSecurityGroupByNameWithContextFunc != nil {
+		return m.GetSecurityGroupByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("SecurityGroupService.GetSecurityGroupByName")
+	return
+}
+
+// GetSecurityGroupByNameWithContext records the call and calls GetSecurityGroupByNameWithContextFunc, or GetSecurityGroupByNameFunc if only that is set
+func (m *MockSecurityGroupService) GetSecurityGroupByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.SecurityGroup, r1 int, r2 error) {
+	m.record("GetSecurityGroupByNameWithContext", ctx, name, opts)
+	if m.GetSecurityGroupByNameWithContextFunc != nil {
+		return m.GetSecurityGroupByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetSecurityGroupByNameFunc != nil {
+		return m.GetSecurityGroupByNameFunc(name, opts...)
+	}
+	r2 = notMocked("SecurityGroupService.GetSecurityGroupByNameWithContext")
+	return
+}
+
+// GetSecurityGroupByID records the call and calls GetSecurityGroupByIDFunc, or GetSecurityGroupByIDWithContextFunc if only that is set
+func (m *MockSecurityGroupService) GetSecurityGroupByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.SecurityGroup, r1 int, r2 error) {
+	m.record("GetSecurityGroupByID", id, opts)
+	if m.GetSecurityGroupByIDFunc != nil {
+		return m.GetSecurityGroupByIDFunc(id, opts...)
+	}
+	if m.GetSecurityGroupByIDWithContextFunc != nil {
+		return m.GetSecurityGroupByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("SecurityGroupService.GetSecurityGroupByID")
+	return
+}
+
+// GetSecurityGroupByIDWithContext records the call and calls GetSecurityGroupByIDWithContextFunc, or GetSecurityGroupByIDFunc if only that is set
+func (m *MockSecurityGroupService) GetSecurityGroupByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.SecurityGroup, r1 int, r2 error) {
+	m.record("GetSecurityGroupByIDWithContext", ctx, id, opts)
+	if m.GetSecurityGroupByIDWithContextFunc != nil {
+		return m.GetSecurityGroupByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetSecurityGroupByIDFunc != nil {
+		return m.GetSecurityGroupByIDFunc(id, opts...)
+	}
+	r2 = notMocked("SecurityGroupService.GetSecurityGroupByIDWithContext")
+	return
+}
+
+// ListSecurityGroups records the call and calls ListSecurityGroupsFunc, or ListSecurityGroupsWithContextFunc if only that is set
+func (m *MockSecurityGroupService) ListSecurityGroups(p *cloudstack.ListSecurityGroupsParams) (r0 *cloudstack.ListSecurityGroupsResponse, r1 error) {
+	m.record("ListSecurityGroups", p)
+	if m.ListSecurityGroupsFunc != nil {
+		return m.ListSecurityGroupsFunc(p)
+	}
+	if m.ListSecurityGroupsWithContextFunc != nil {
+		return m.ListSecurityGroupsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SecurityGroupService.ListSecurityGroups")
+	return
+}
+
+// ListSecurityGroupsWithContext records the call and calls ListSecurityGroupsWithContextFunc, or ListSecurityGroupsFunc if only that is set
+func (m *MockSecurityGroupService) ListSecurityGroupsWithContext(ctx context.Context, p *cloudstack.ListSecurityGroupsParams) (r0 *cloudstack.ListSecurityGroupsResponse, r1 error) {
+	m.record("ListSecurityGroupsWithContext", ctx, p)
+	if m.ListSecurityGroupsWithContextFunc != nil {
+		return m.ListSecurityGroupsWithContextFunc(ctx, p)
+	}
+	if m.ListSecurityGroupsFunc != nil {
+		return m.ListSecurityGroupsFunc(p)
+	}
+	r1 = notMocked("SecurityGroupService.ListSecurityGroupsWithContext")
+	return
+}
+
+// NewListSecurityGroupsPager records the call and calls NewListSecurityGroupsPagerFunc
+func (m *MockSecurityGroupService) NewListSecurityGroupsPager(p *cloudstack.ListSecurityGroupsParams) (r0 *cloudstack.ListSecurityGroupsPager) {
+	m.record("NewListSecurityGroupsPager", p)
+	if m.NewListSecurityGroupsPagerFunc != nil {
+		return m.NewListSecurityGroupsPagerFunc(p)
+	}
+	return
+}
+
+// ListSecurityGroupsAll records the call and calls ListSecurityGroupsAllFunc, or ListSecurityGroupsAllWithContextFunc if only that is set
+func (m *MockSecurityGroupService) ListSecurityGroupsAll(p *cloudstack.ListSecurityGroupsParams) (r0 *cloudstack.ListSecurityGroupsResponse, r1 error) {
+	m.record("ListSecurityGroupsAll", p)
+	if m.ListSecurityGroupsAllFunc != nil {
+		return m.ListSecurityGroupsAllFunc(p)
+	}
+	if m.ListSecurityGroupsAllWithContextFunc != nil {
+		return m.ListSecurityGroupsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SecurityGroupService.ListSecurityGroupsAll")
+	return
+}
+
+// ListSecurityGroupsAllWithContext records the call and calls ListSecurityGroupsAllWithContextFunc, or ListSecurityGroupsAllFunc if only that is set
+func (m *MockSecurityGroupService) ListSecurityGroupsAllWithContext(ctx context.Context, p *cloudstack.ListSecurityGroupsParams) (r0 *cloudstack.ListSecurityGroupsResponse, r1 error) {
+	m.record("ListSecurityGroupsAllWithContext", ctx, p)
+	if m.ListSecurityGroupsAllWithContextFunc != nil {
+		return m.ListSecurityGroupsAllWithContextFunc(ctx, p)
+	}
+	if m.ListSecurityGroupsAllFunc != nil {
+		return m.ListSecurityGroupsAllFunc(p)
+	}
+	r1 = notMocked("SecurityGroupService.ListSecurityGroupsAllWithContext")
+	return
+}
+
+// NewRevokeSecurityGroupEgressParams records the call and calls NewRevokeSecurityGroupEgressParamsFunc
+func (m *MockSecurityGroupService) NewRevokeSecurityGroupEgressParams(id string) (r0 *cloudstack.RevokeSecurityGroupEgressParams) {
+	m.record("NewRevokeSecurityGroupEgressParams", id)
+	if m.NewRevokeSecurityGroupEgressParamsFunc != nil {
+		return m.NewRevokeSecurityGroupEgressParamsFunc(id)
+	}
+	return cloudstack.NewSecurityGroupService(nil).NewRevokeSecurityGroupEgressParams(id)
+}
+
+// RevokeSecurityGroupEgress records the call and calls RevokeSecurityGroupEgressFunc, or RevokeSecurityGroupEgressWithContextFunc if only that is set
+func (m *MockSecurityGroupService) RevokeSecurityGroupEgress(p *cloudstack.RevokeSecurityGroupEgressParams) (r0 *cloudstack.RevokeSecurityGroupEgressResponse, r1 error) {
+	m.record("RevokeSecurityGroupEgress", p)
+	if m.RevokeSecurityGroupEgressFunc != nil {
+		return m.RevokeSecurityGroupEgressFunc(p)
+	}
+	if m.RevokeSecurityGroupEgressWithContextFunc != nil {
+		return m.RevokeSecurityGroupEgressWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SecurityGroupService.RevokeSecurityGroupEgress")
+	return
+}
+
+// RevokeSecurityGroupEgressWithContext records the call and calls RevokeSecurityGroupEgressWithContextFunc, or RevokeSecurityGroupEgressFunc if only that is set
+func (m *MockSecurityGroupService) RevokeSecurityGroupEgressWithContext(ctx context.Context, p *cloudstack.RevokeSecurityGroupEgressParams) (r0 *cloudstack.RevokeSecurityGroupEgressResponse, r1 error) {
+	m.record("RevokeSecurityGroupEgressWithContext", ctx, p)
+	if m.RevokeSecurityGroupEgressWithContextFunc != nil {
+		return m.RevokeSecurityGroupEgressWithContextFunc(ctx, p)
+	}
+	if m.RevokeSecurityGroupEgressFunc != nil {
+		return m.RevokeSecurityGroupEgressFunc(p)
+	}
+	r1 = notMocked("SecurityGroupService.RevokeSecurityGroupEgressWithContext")
+	return
+}
+
+// RevokeSecurityGroupEgressAsync records the call and calls RevokeSecurityGroupEgressAsyncFunc, or RevokeSecurityGroupEgressAsyncWithContextFunc if only that is set
+func (m *MockSecurityGroupService) RevokeSecurityGroupEgressAsync(p *cloudstack.RevokeSecurityGroupEgressParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RevokeSecurityGroupEgressAsync", p)
+	if m.RevokeSecurityGroupEgressAsyncFunc != nil {
+		return m.RevokeSecurityGroupEgressAsyncFunc(p)
+	}
+	if m.RevokeSecurityGroupEgressAsyncWithContextFunc != nil {
+		return m.RevokeSecurityGroupEgressAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SecurityGroupService.RevokeSecurityGroupEgressAsync")
+	return
+}
+
+// RevokeSecurityGroupEgressAsyncWithContext records the call and calls RevokeSecurityGroupEgressAsyncWithContextFunc, or RevokeSecurityGroupEgressAsyncFunc if only that is set
+func (m *MockSecurityGroupService) RevokeSecurityGroupEgressAsyncWithContext(ctx context.Context, p *cloudstack.RevokeSecurityGroupEgressParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RevokeSecurityGroupEgressAsyncWithContext", ctx, p)
+	if m.RevokeSecurityGroupEgressAsyncWithContextFunc != nil {
+		return m.RevokeSecurityGroupEgressAsyncWithContextFunc(ctx, p)
+	}
+	if m.RevokeSecurityGroupEgressAsyncFunc != nil {
+		return m.RevokeSecurityGroupEgressAsyncFunc(p)
+	}
+	r1 = notMocked("SecurityGroupService.RevokeSecurityGroupEgressAsyncWithContext")
+	return
+}
+
+// NewRevokeSecurityGroupIngressParams records the call and calls NewRevokeSecurityGroupIngressParamsFunc
+func (m *MockSecurityGroupService) NewRevokeSecurityGroupIngressParams(id string) (r0 *cloudstack.RevokeSecurityGroupIngressParams) {
+	m.record("NewRevokeSecurityGroupIngressParams", id)
+	if m.NewRevokeSecurityGroupIngressParamsFunc != nil {
+		return m.NewRevokeSecurityGroupIngressParamsFunc(id)
+	}
+	return cloudstack.NewSecurityGroupService(nil).NewRevokeSecurityGroupIngressParams(id)
+}
+
+// RevokeSecurityGroupIngress records the call and calls RevokeSecurityGroupIngressFunc, or RevokeSecurityGroupIngressWithContextFunc if only that is set
+func (m *MockSecurityGroupService) RevokeSecurityGroupIngress(p *cloudstack.RevokeSecurityGroupIngressParams) (r0 *cloudstack.RevokeSecurityGroupIngressResponse, r1 error) {
+	m.record("RevokeSecurityGroupIngress", p)
+	if m.RevokeSecurityGroupIngressFunc != nil {
+		return m.RevokeSecurityGroupIngressFunc(p)
+	}
+	if m.RevokeSecurityGroupIngressWithContextFunc != nil {
+		return m.RevokeSecurityGroupIngressWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SecurityGroupService.RevokeSecurityGroupIngress")
+	return
+}
+
+// RevokeSecurityGroupIngressWithContext records the call and calls RevokeSecurityGroupIngressWithContextFunc, or RevokeSecurityGroupIngressFunc if only that is set
+func (m *MockSecurityGroupService) RevokeSecurityGroupIngressWithContext(ctx context.Context, p *cloudstack.RevokeSecurityGroupIngressParams) (r0 *cloudstack.RevokeSecurityGroupIngressResponse, r1 error) {
+	m.record("RevokeSecurityGroupIngressWithContext", ctx, p)
+	if m.RevokeSecurityGroupIngressWithContextFunc != nil {
+		return m.RevokeSecurityGroupIngressWithContextFunc(ctx, p)
+	}
+	if m.RevokeSecurityGroupIngressFunc != nil {
+		return m.RevokeSecurityGroupIngressFunc(p)
+	}
+	r1 = notMocked("SecurityGroupService.RevokeSecurityGroupIngressWithContext")
+	return
+}
+
+// RevokeSecurityGroupIngressAsync records the call and calls RevokeSecurityGroupIngressAsyncFunc, or RevokeSecurityGroupIngressAsyncWithContextFunc if only that is set
+func (m *MockSecurityGroupService) RevokeSecurityGroupIngressAsync(p *cloudstack.RevokeSecurityGroupIngressParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RevokeSecurityGroupIngressAsync", p)
+	if m.RevokeSecurityGroupIngressAsyncFunc != nil {
+		return m.RevokeSecurityGroupIngressAsyncFunc(p)
+	}
+	if m.RevokeSecurityGroupIngressAsyncWithContextFunc != nil {
+		return m.RevokeSecurityGroupIngressAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SecurityGroupService.RevokeSecurityGroupIngressAsync")
+	return
+}
+
+// RevokeSecurityGroupIngressAsyncWithContext records the call and calls RevokeSecurityGroupIngressAsyncWithContextFunc, or RevokeSecurityGroupIngressAsyncFunc if only that is set
+func (m *MockSecurityGroupService) RevokeSecurityGroupIngressAsyncWithContext(ctx context.Context, p *cloudstack.RevokeSecurityGroupIngressParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RevokeSecurityGroupIngressAsyncWithContext", ctx, p)
+	if m.RevokeSecurityGroupIngressAsyncWithContextFunc != nil {
+		return m.RevokeSecurityGroupIngressAsyncWithContextFunc(ctx, p)
+	}
+	if m.RevokeSecurityGroupIngressAsyncFunc != nil {
+		return m.RevokeSecurityGroupIngressAsyncFunc(p)
+	}
+	r1 = notMocked("SecurityGroupService.RevokeSecurityGroupIngressAsyncWithContext")
+	return
+}
+
+// NewUpdateSecurityGroupParams records the call and calls NewUpdateSecurityGroupParamsFunc
+func (m *MockSecurityGroupService) NewUpdateSecurityGroupParams(id string) (r0 *cloudstack.UpdateSecurityGroupParams) {
+	m.record("NewUpdateSecurityGroupParams", id)
+	if m.NewUpdateSecurityGroupParamsFunc != nil {
+		return m.NewUpdateSecurityGroupParamsFunc(id)
+	}
+	return cloudstack.NewSecurityGroupService(nil).NewUpdateSecurityGroupParams(id)
+}
+
+// UpdateSecurityGroup records the call and calls UpdateSecurityGroupFunc, or UpdateSecurityGroupWithContextFunc if only that is set
+func (m *MockSecurityGroupService) UpdateSecurityGroup(p *cloudstack.UpdateSecurityGroupParams) (r0 *cloudstack.UpdateSecurityGroupResponse, r1 error) {
+	m.record("UpdateSecurityGroup", p)
+	if m.UpdateSecurityGroupFunc != nil {
+		return m.UpdateSecurityGroupFunc(p)
+	}
+	if m.UpdateSecurityGroupWithContextFunc != nil {
+		return m.UpdateSecurityGroupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SecurityGroupService.UpdateSecurityGroup")
+	return
+}
+
+// UpdateSecurityGroupWithContext records the call and calls UpdateSecurityGroupWithContextFunc, or UpdateSecurityGroupFunc if only that is set
+func (m *MockSecurityGroupService) UpdateSecurityGroupWithContext(ctx context.Context, p *cloudstack.UpdateSecurityGroupParams) (r0 *cloudstack.UpdateSecurityGroupResponse, r1 error) {
+	m.record("UpdateSecurityGroupWithContext", ctx, p)
+	if m.UpdateSecurityGroupWithContextFunc != nil {
+		return m.UpdateSecurityGroupWithContextFunc(ctx, p)
+	}
+	if m.UpdateSecurityGroupFunc != nil {
+		return m.UpdateSecurityGroupFunc(p)
+	}
+	r1 = notMocked("SecurityGroupService.UpdateSecurityGroupWithContext")
+	return
+}
@@ -0,0 +1,313 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockServiceOfferingService is a mock of cloudstack.ServiceOfferingServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockServiceOfferingService struct {
+	Recorder
+
+	NewCreateServiceOfferingParamsFunc      func(displaytext string, name string) *cloudstack.CreateServiceOfferingParams
+	CreateServiceOfferingFunc               func(p *cloudstack.CreateServiceOfferingParams) (*cloudstack.CreateServiceOfferingResponse, error)
+	CreateServiceOfferingWithContextFunc    func(ctx context.Context, p *cloudstack.CreateServiceOfferingParams) (*cloudstack.CreateServiceOfferingResponse, error)
+	NewDeleteServiceOfferingParamsFunc      func(id string) *cloudstack.DeleteServiceOfferingParams
+	DeleteServiceOfferingFunc               func(p *cloudstack.DeleteServiceOfferingParams) (*cloudstack.DeleteServiceOfferingResponse, error)
+	DeleteServiceOfferingWithContextFunc    func(ctx context.Context, p *cloudstack.DeleteServiceOfferingParams) (*cloudstack.DeleteServiceOfferingResponse, error)
+	NewListServiceOfferingsParamsFunc       func() *cloudstack.ListServiceOfferingsParams
+	GetServiceOfferingIDFunc                func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetServiceOfferingIDWithContextFunc     func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetServiceOfferingByNameFunc            func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.ServiceOffering, int, error)
+	GetServiceOfferingByNameWithContextFunc func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.ServiceOffering, int, error)
+	GetServiceOfferingByIDFunc              func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.ServiceOffering, int, error)
+	GetServiceOfferingByIDWithContextFunc   func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.ServiceOffering, int, error)
+	ListServiceOfferingsFunc                func(p *cloudstack.ListServiceOfferingsParams) (*cloudstack.ListServiceOfferingsResponse, error)
+	ListServiceOfferingsWithContextFunc     func(ctx context.Context, p *cloudstack.ListServiceOfferingsParams) (*cloudstack.ListServiceOfferingsResponse, error)
+	NewListServiceOfferingsPagerFunc        func(p *cloudstack.ListServiceOfferingsParams) *cloudstack.ListServiceOfferingsPager
+	ListServiceOfferingsAllFunc             func(p *cloudstack.ListServiceOfferingsParams) (*cloudstack.ListServiceOfferingsResponse, error)
+	ListServiceOfferingsAllWithContextFunc  func(ctx context.Context, p *cloudstack.ListServiceOfferingsParams) (*cloudstack.ListServiceOfferingsResponse, error)
+	NewUpdateServiceOfferingParamsFunc      func(id string) *cloudstack.UpdateServiceOfferingParams
+	UpdateServiceOfferingFunc               func(p *cloudstack.UpdateServiceOfferingParams) (*cloudstack.UpdateServiceOfferingResponse, error)
+	UpdateServiceOfferingWithContextFunc    func(ctx context.Context, p *cloudstack.UpdateServiceOfferingParams) (*cloudstack.UpdateServiceOfferingResponse, error)
+}
+
+var _ cloudstack.ServiceOfferingServiceIface = (*MockServiceOfferingService)(nil)
+
+// NewCreateServiceOfferingParams records the call and calls NewCreateServiceOfferingParamsFunc
+func (m *MockServiceOfferingService) NewCreateServiceOfferingParams(displaytext string, name string) (r0 *cloudstack.CreateServiceOfferingParams) {
+	m.record("NewCreateServiceOfferingParams", displaytext, name)
+	if m.NewCreateServiceOfferingParamsFunc != nil {
+		return m.NewCreateServiceOfferingParamsFunc(displaytext, name)
+	}
+	return cloudstack.NewServiceOfferingService(nil).NewCreateServiceOfferingParams(displaytext, name)
+}
+
+// CreateServiceOffering records the call and calls CreateServiceOfferingFunc, or CreateServiceOfferingWithContextFunc if only that is set
+func (m *MockServiceOfferingService) CreateServiceOffering(p *cloudstack.CreateServiceOfferingParams) (r0 *cloudstack.CreateServiceOfferingResponse, r1 error) {
+	m.record("CreateServiceOffering", p)
+	if m.CreateServiceOfferingFunc != nil {
+		return m.CreateServiceOfferingFunc(p)
+	}
+	if m.CreateServiceOfferingWithContextFunc != nil {
+		return m.CreateServiceOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ServiceOfferingService.CreateServiceOffering")
+	return
+}
+
+// CreateServiceOfferingWithContext records the call and calls CreateServiceOfferingWithContextFunc, or CreateServiceOfferingFunc if only that is set
+func (m *MockServiceOfferingService) CreateServiceOfferingWithContext(ctx context.Context, p *cloudstack.CreateServiceOfferingParams) (r0 *cloudstack.CreateServiceOfferingResponse, r1 error) {
+	m.record("CreateServiceOfferingWithContext", ctx, p)
+	if m.CreateServiceOfferingWithContextFunc != nil {
+		return m.CreateServiceOfferingWithContextFunc(ctx, p)
+	}
+	if m.CreateServiceOfferingFunc != nil {
+		return m.CreateServiceOfferingFunc(p)
+	}
+	r1 = notMocked("ServiceOfferingService.CreateServiceOfferingWithContext")
+	return
+}
+
+// NewDeleteServiceOfferingParams records the call and calls NewDeleteServiceOfferingParamsFunc
+func (m *MockServiceOfferingService) NewDeleteServiceOfferingParams(id string) (r0 *cloudstack.DeleteServiceOfferingParams) {
+	m.record("NewDeleteServiceOfferingParams", id)
+	if m.NewDeleteServiceOfferingParamsFunc != nil {
+		return m.NewDeleteServiceOfferingParamsFunc(id)
+	}
+	return cloudstack.NewServiceOfferingService(nil).NewDeleteServiceOfferingParams(id)
+}
+
+// DeleteServiceOffering records the call and calls DeleteServiceOfferingFunc, or DeleteServiceOfferingWithContextFunc if only that is set
+func (m *MockServiceOfferingService) DeleteServiceOffering(p *cloudstack.DeleteServiceOfferingParams) (r0 *cloudstack.DeleteServiceOfferingResponse, r1 error) {
+	m.record("DeleteServiceOffering", p)
+	if m.DeleteServiceOfferingFunc != nil {
+		return m.DeleteServiceOfferingFunc(p)
+	}
+	if m.DeleteServiceOfferingWithContextFunc != nil {
+		return m.DeleteServiceOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ServiceOfferingService.DeleteServiceOffering")
+	return
+}
+
+// DeleteServiceOfferingWithContext records the call and calls DeleteServiceOfferingWithContextFunc, or DeleteServiceOfferingFunc if only that is set
+func (m *MockServiceOfferingService) DeleteServiceOfferingWithContext(ctx context.Context, p *cloudstack.DeleteServiceOfferingParams) (r0 *cloudstack.DeleteServiceOfferingResponse, r1 error) {
+	m.record("DeleteServiceOfferingWithContext", ctx, p)
+	if m.DeleteServiceOfferingWithContextFunc != nil {
+		return m.DeleteServiceOfferingWithContextFunc(ctx, p)
+	}
+	if m.DeleteServiceOfferingFunc != nil {
+		return m.DeleteServiceOfferingFunc(p)
+	}
+	r1 = notMocked("ServiceOfferingService.DeleteServiceOfferingWithContext")
+	return
+}
+
+// NewListServiceOfferingsParams records the call and calls NewListServiceOfferingsParamsFunc
+func (m *MockServiceOfferingService) NewListServiceOfferingsParams() (r0 *cloudstack.ListServiceOfferingsParams) {
+	m.record("NewListServiceOfferingsParams")
+	if m.NewListServiceOfferingsParamsFunc != nil {
+		return m.NewListServiceOfferingsParamsFunc()
+	}
+	return cloudstack.NewServiceOfferingService(nil).NewListServiceOfferingsParams()
+}
+
+// GetServiceOfferingID records the call and calls GetServiceOfferingIDFunc, or GetServiceOfferingIDWithContextFunc if only that is set
+func (m *MockServiceOfferingService) GetServiceOfferingID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetServiceOfferingID", name, opts)
+	if m.GetServiceOfferingIDFunc != nil {
+		return m.GetServiceOfferingIDFunc(name, opts...)
+	}
+	if m.GetServiceOfferingIDWithContextFunc != nil {
+		return m.GetServiceOfferingIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ServiceOfferingService.GetServiceOfferingID")
+	return
+}
+
+// GetServiceOfferingIDWithContext records the call and calls GetServiceOfferingIDWithContextFunc, or GetServiceOfferingIDFunc if only that is set
+func (m *MockServiceOfferingService) GetServiceOfferingIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetServiceOfferingIDWithContext", ctx, name, opts)
+	if m.GetServiceOfferingIDWithContextFunc != nil {
+		return m.GetServiceOfferingIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetServiceOfferingIDFunc != nil {
+		return m.GetServiceOfferingIDFunc(name, opts...)
+	}
+	r2 = notMocked("ServiceOfferingService.GetServiceOfferingIDWithContext")
+	return
+}
+
+// GetServiceOfferingByName records the call and calls GetServiceOfferingByNameFunc, or GetServiceOfferingByNameWithContextFunc if only that is set
+func (m *MockServiceOfferingService) GetServiceOfferingByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ServiceOffering, r1 int, r2 error) {
+	m.record("GetServiceOfferingByName", name, opts)
+	if m.GetServiceOfferingByNameFunc != nil {
+		return m.GetServiceOfferingByNameFunc(name, opts...)
+	}
+	if m.GetServiceOfferingByNameWithContextFunc != nil {
+		return m.GetServiceOfferingByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ServiceOfferingService.GetServiceOfferingByName")
+	return
+}
+
+// GetServiceOfferingByNameWithContext records the call and calls GetServiceOfferingByNameWithContextFunc, or GetServiceOfferingByNameFunc if only that is set
+func (m *MockServiceOfferingService) GetServiceOfferingByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ServiceOffering, r1 int, r2 error) {
+	m.record("GetServiceOfferingByNameWithContext", ctx, name, opts)
+	if m.GetServiceOfferingByNameWithContextFunc != nil {
+		return m.GetServiceOfferingByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetServiceOfferingByNameFunc != nil {
+		return m.GetServiceOfferingByNameFunc(name, opts...)
+	}
+	r2 = notMocked("ServiceOfferingService.GetServiceOfferingByNameWithContext")
+	return
+}
+
+// GetServiceOfferingByID records the call and calls GetServiceOfferingByIDFunc, or GetServiceOfferingByIDWithContextFunc if only that is set
+func (m *MockServiceOfferingService) GetServiceOfferingByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ServiceOffering, r1 int, r2 error) {
+	m.record("GetServiceOfferingByID", id, opts)
+	if m.GetServiceOfferingByIDFunc != nil {
+		return m.GetServiceOfferingByIDFunc(id, opts...)
+	}
+	if m.GetServiceOfferingByIDWithContextFunc != nil {
+		return m.GetServiceOfferingByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("ServiceOfferingService.GetServiceOfferingByID")
+	return
+}
+
+// GetServiceOfferingByIDWithContext records the call and calls GetServiceOfferingByIDWithContextFunc, or GetServiceOfferingByIDFunc if only that is set
+func (m *MockServiceOfferingService) GetServiceOfferingByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ServiceOffering, r1 int, r2 error) {
+	m.record("GetServiceOfferingByIDWithContext", ctx, id, opts)
+	if m.GetServiceOfferingByIDWithContextFunc != nil {
+		return m.GetServiceOfferingByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetServiceOfferingByIDFunc != nil {
+		return m.GetServiceOfferingByIDFunc(id, opts...)
+	}
+	r2 = notMocked("ServiceOfferingService.GetServiceOfferingByIDWithContext")
+	return
+}
+
+// ListServiceOfferings records the call and calls ListServiceOfferingsFunc, or ListServiceOfferingsWithContextFunc if only that is set
+func (m *MockServiceOfferingService) ListServiceOfferings(p *cloudstack.ListServiceOfferingsParams) (r0 *cloudstack.ListServiceOfferingsResponse, r1 error) {
+	m.record("ListServiceOfferings", p)
+	if m.ListServiceOfferingsFunc != nil {
+		return m.ListServiceOfferingsFunc(p)
+	}
+	if m.ListServiceOfferingsWithContextFunc != nil {
+		return m.ListServiceOfferingsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ServiceOfferingService.ListServiceOfferings")
+	return
+}
+
+// ListServiceOfferingsWithContext records the call and calls ListServiceOfferingsWithContextFunc, or ListServiceOfferingsFunc if only that is set
+func (m *MockServiceOfferingService) ListServiceOfferingsWithContext(ctx context.Context, p *cloudstack.ListServiceOfferingsParams) (r0 *cloudstack.ListServiceOfferingsResponse, r1 error) {
+	m.record("ListServiceOfferingsWithContext", ctx, p)
+	if m.ListServiceOfferingsWithContextFunc != nil {
+		return m.ListServiceOfferingsWithContextFunc(ctx, p)
+	}
+	if m.ListServiceOfferingsFunc != nil {
+		return m.ListServiceOfferingsFunc(p)
+	}
+	r1 = notMocked("ServiceOfferingService.ListServiceOfferingsWithContext")
+	return
+}
+
+// NewListServiceOfferingsPager records the call and calls NewListServiceOfferingsPagerFunc
+func (m *MockServiceOfferingService) NewListServiceOfferingsPager(p *cloudstack.ListServiceOfferingsParams) (r0 *cloudstack.ListServiceOfferingsPager) {
+	m.record("NewListServiceOfferingsPager", p)
+	if m.NewListServiceOfferingsPagerFunc != nil {
+		return m.NewListServiceOfferingsPagerFunc(p)
+	}
+	return
+}
+
+// ListServiceOfferingsAll records the call and calls ListServiceOfferingsAllFunc, or ListServiceOfferingsAllWithContextFunc if only that is set
+func (m *MockServiceOfferingService) ListServiceOfferingsAll(p *cloudstack.ListServiceOfferingsParams) (r0 *cloudstack.ListServiceOfferingsResponse, r1 error) {
+	m.record("ListServiceOfferingsAll", p)
+	if m.ListServiceOfferingsAllFunc != nil {
+		return m.ListServiceOfferingsAllFunc(p)
+	}
+	if m.ListServiceOfferingsAllWithContextFunc != nil {
+		return m.ListServiceOfferingsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ServiceOfferingService.ListServiceOfferingsAll")
+	return
+}
+
+// ListServiceOfferingsAllWithContext records the call and calls ListServiceOfferingsAllWithContextFunc, or ListServiceOfferingsAllFunc if only that is set
+func (m *MockServiceOfferingService) ListServiceOfferingsAllWithContext(ctx context.Context, p *cloudstack.ListServiceOfferingsParams) (r0 *cloudstack.ListServiceOfferingsResponse, r1 error) {
+	m.record("ListServiceOfferingsAllWithContext", ctx, p)
+	if m.ListServiceOfferingsAllWithContextFunc != nil {
+		return m.ListServiceOfferingsAllWithContextFunc(ctx, p)
+	}
+	if m.ListServiceOfferingsAllFunc != nil {
+		return m.ListServiceOfferingsAllFunc(p)
+	}
+	r1 = notMocked("ServiceOfferingService.ListServiceOfferingsAllWithContext")
+	return
+}
+
+// NewUpdateServiceOfferingParams records the call and calls NewUpdateServiceOfferingParamsFunc
+func (m *MockServiceOfferingService) NewUpdateServiceOfferingParams(id string) (r0 *cloudstack.UpdateServiceOfferingParams) {
+	m.record("NewUpdateServiceOfferingParams", id)
+	if m.NewUpdateServiceOfferingParamsFunc != nil {
+		return m.NewUpdateServiceOfferingParamsFunc(id)
+	}
+	return cloudstack.NewServiceOfferingService(nil).NewUpdateServiceOfferingParams(id)
+}
+
+// UpdateServiceOffering records the call and calls UpdateServiceOfferingFunc, or UpdateServiceOfferingWithContextFunc if only that is set
+func (m *MockServiceOfferingService) UpdateServiceOffering(p *cloudstack.UpdateServiceOfferingParams) (r0 *cloudstack.UpdateServiceOfferingResponse, r1 error) {
+	m.record("UpdateServiceOffering", p)
+	if m.UpdateServiceOfferingFunc != nil {
+		return m.UpdateServiceOfferingFunc(p)
+	}
+	if m.UpdateServiceOfferingWithContextFunc != nil {
+		return m.UpdateServiceOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ServiceOfferingService.UpdateServiceOffering")
+	return
+}
+
+// UpdateServiceOfferingWithContext records the call and calls UpdateServiceOfferingWithContextFunc, or UpdateServiceOfferingFunc if only that is set
+func (m *MockServiceOfferingService) UpdateServiceOfferingWithContext(ctx context.Context, p *cloudstack.UpdateServiceOfferingParams) (r0 *cloudstack.UpdateServiceOfferingResponse, r1 error) {
+	m.record("UpdateServiceOfferingWithContext", ctx, p)
+	if m.UpdateServiceOfferingWithContextFunc != nil {
+		return m.UpdateServiceOfferingWithContextFunc(ctx, p)
+	}
+	if m.UpdateServiceOfferingFunc != nil {
+		return m.UpdateServiceOfferingFunc(p)
+	}
+	r1 = notMocked("ServiceOfferingService.UpdateServiceOfferingWithContext")
+	return
+}
@@ -0,0 +1,267 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockSimulatorService is a mock of cloudstack.SimulatorServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockSimulatorService struct {
+	Recorder
+
+	NewCleanupSimulatorMockParamsFunc                 func() *cloudstack.CleanupSimulatorMockParams
+	CleanupSimulatorMockFunc                          func(p *cloudstack.CleanupSimulatorMockParams) (*cloudstack.CleanupSimulatorMockResponse, error)
+	CleanupSimulatorMockWithContextFunc               func(ctx context.Context, p *cloudstack.CleanupSimulatorMockParams) (*cloudstack.CleanupSimulatorMockResponse, error)
+	NewConfigureSimulatorParamsFunc                   func(name string, value string) *cloudstack.ConfigureSimulatorParams
+	ConfigureSimulatorFunc                            func(p *cloudstack.ConfigureSimulatorParams) (*cloudstack.SimulatorResponse, error)
+	ConfigureSimulatorWithContextFunc                 func(ctx context.Context, p *cloudstack.ConfigureSimulatorParams) (*cloudstack.SimulatorResponse, error)
+	NewConfigureSimulatorHAProviderStateParamsFunc    func(activity bool, fence bool, health bool, hostid string, recover bool) *cloudstack.ConfigureSimulatorHAProviderStateParams
+	ConfigureSimulatorHAProviderStateFunc             func(p *cloudstack.ConfigureSimulatorHAProviderStateParams) (*cloudstack.SimulatorHAProviderStateResponse, error)
+	ConfigureSimulatorHAProviderStateWithContextFunc  func(ctx context.Context, p *cloudstack.ConfigureSimulatorHAProviderStateParams) (*cloudstack.SimulatorHAProviderStateResponse, error)
+	NewListSimulatorHAStateTransitionsParamsFunc      func(hostid string) *cloudstack.ListSimulatorHAStateTransitionsParams
+	ListSimulatorHAStateTransitionsFunc               func(p *cloudstack.ListSimulatorHAStateTransitionsParams) (*cloudstack.ListSimulatorHAStateTransitionsResponse, error)
+	ListSimulatorHAStateTransitionsWithContextFunc    func(ctx context.Context, p *cloudstack.ListSimulatorHAStateTransitionsParams) (*cloudstack.ListSimulatorHAStateTransitionsResponse, error)
+	NewListSimulatorHAStateTransitionsPagerFunc       func(p *cloudstack.ListSimulatorHAStateTransitionsParams) *cloudstack.ListSimulatorHAStateTransitionsPager
+	ListSimulatorHAStateTransitionsAllFunc            func(p *cloudstack.ListSimulatorHAStateTransitionsParams) (*cloudstack.ListSimulatorHAStateTransitionsResponse, error)
+	ListSimulatorHAStateTransitionsAllWithContextFunc func(ctx context.Context, p *cloudstack.ListSimulatorHAStateTransitionsParams) (*cloudstack.ListSimulatorHAStateTransitionsResponse, error)
+	NewQuerySimulatorMockParamsFunc                   func() *cloudstack.QuerySimulatorMockParams
+	QuerySimulatorMockFunc                            func(p *cloudstack.QuerySimulatorMockParams) (*cloudstack.QuerySimulatorMockResponse, error)
+	QuerySimulatorMockWithContextFunc                 func(ctx context.Context, p *cloudstack.QuerySimulatorMockParams) (*cloudstack.QuerySimulatorMockResponse, error)
+}
+
+var _ cloudstack.SimulatorServiceIface = (*MockSimulatorService)(nil)
+
+// NewCleanupSimulatorMockParams records the call and calls NewCleanupSimulatorMockParamsFunc
+func (m *MockSimulatorService) NewCleanupSimulatorMockParams() (r0 *cloudstack.CleanupSimulatorMockParams) {
+	m.record("NewCleanupSimulatorMockParams")
+	if m.NewCleanupSimulatorMockParamsFunc != nil {
+		return m.NewCleanupSimulatorMockParamsFunc()
+	}
+	return cloudstack.NewSimulatorService(nil).NewCleanupSimulatorMockParams()
+}
+
+// CleanupSimulatorMock records the call and calls CleanupSimulatorMockFunc, or CleanupSimulatorMockWithContextFunc if only that is set
+func (m *MockSimulatorService) CleanupSimulatorMock(p *cloudstack.CleanupSimulatorMockParams) (r0 *cloudstack.CleanupSimulatorMockResponse, r1 error) {
+	m.record("CleanupSimulatorMock", p)
+	if m.CleanupSimulatorMockFunc != nil {
+		return m.CleanupSimulatorMockFunc(p)
+	}
+	if m.CleanupSimulatorMockWithContextFunc != nil {
+		return m.CleanupSimulatorMockWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SimulatorService.CleanupSimulatorMock")
+	return
+}
+
+// CleanupSimulatorMockWithContext records the call and calls CleanupSimulatorMockWithContextFunc, or CleanupSimulatorMockFunc if only that is set
+func (m *MockSimulatorService) CleanupSimulatorMockWithContext(ctx context.Context, p *cloudstack.CleanupSimulatorMockParams) (r0 *cloudstack.CleanupSimulatorMockResponse, r1 error) {
+	m.record("CleanupSimulatorMockWithContext", ctx, p)
+	if m.CleanupSimulatorMockWithContextFunc != nil {
+		return m.CleanupSimulatorMockWithContextFunc(ctx, p)
+	}
+	if m.CleanupSimulatorMockFunc != nil {
+		return m.CleanupSimulatorMockFunc(p)
+	}
+	r1 = notMocked("SimulatorService.CleanupSimulatorMockWithContext")
+	return
+}
+
+// NewConfigureSimulatorParams records the call and calls NewConfigureSimulatorParamsFunc
+func (m *MockSimulatorService) NewConfigureSimulatorParams(name string, value string) (r0 *cloudstack.ConfigureSimulatorParams) {
+	m.record("NewConfigureSimulatorParams", name, value)
+	if m.NewConfigureSimulatorParamsFunc != nil {
+		return m.NewConfigureSimulatorParamsFunc(name, value)
+	}
+	return cloudstack.NewSimulatorService(nil).NewConfigureSimulatorParams(name, value)
+}
+
+// ConfigureSimulator records the call and calls ConfigureSimulatorFunc, or ConfigureSimulatorWithContextFunc if only that is set
+func (m *MockSimulatorService) ConfigureSimulator(p *cloudstack.ConfigureSimulatorParams) (r0 *cloudstack.SimulatorResponse, r1 error) {
+	m.record("ConfigureSimulator", p)
+	if m.ConfigureSimulatorFunc != nil {
+		return m.ConfigureSimulatorFunc(p)
+	}
+	if m.ConfigureSimulatorWithContextFunc != nil {
+		return m.ConfigureSimulatorWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SimulatorService.ConfigureSimulator")
+	return
+}
+
+// ConfigureSimulatorWithContext records the call and calls ConfigureSimulatorWithContextFunc, or ConfigureSimulatorFunc if only that is set
+func (m *MockSimulatorService) ConfigureSimulatorWithContext(ctx context.Context, p *cloudstack.ConfigureSimulatorParams) (r0 *cloudstack.SimulatorResponse, r1 error) {
+	m.record("ConfigureSimulatorWithContext", ctx, p)
+	if m.ConfigureSimulatorWithContextFunc != nil {
+		return m.ConfigureSimulatorWithContextFunc(ctx, p)
+	}
+	if m.ConfigureSimulatorFunc != nil {
+		return m.ConfigureSimulatorFunc(p)
+	}
+	r1 = notMocked("SimulatorService.ConfigureSimulatorWithContext")
+	return
+}
+
+// NewConfigureSimulatorHAProviderStateParams records the call and calls NewConfigureSimulatorHAProviderStateParamsFunc
+func (m *MockSimulatorService) NewConfigureSimulatorHAProviderStateParams(activity bool, fence bool, health bool, hostid string, recover bool) (r0 *cloudstack.ConfigureSimulatorHAProviderStateParams) {
+	m.record("NewConfigureSimulatorHAProviderStateParams", activity, fence, health, hostid, recover)
+	if m.NewConfigureSimulatorHAProviderStateParamsFunc != nil {
+		return m.NewConfigureSimulatorHAProviderStateParamsFunc(activity, fence, health, hostid, recover)
+	}
+	return cloudstack.NewSimulatorService(nil).NewConfigureSimulatorHAProviderStateParams(activity, fence, health, hostid, recover)
+}
+
+// ConfigureSimulatorHAProviderState records the call and calls ConfigureSimulatorHAProviderStateFunc, or ConfigureSimulatorHAProviderStateWithContextFunc if only that is set
+func (m *MockSimulatorService) ConfigureSimulatorHAProviderState(p *cloudstack.ConfigureSimulatorHAProviderStateParams) (r0 *cloudstack.SimulatorHAProviderStateResponse, r1 error) {
+	m.record("ConfigureSimulatorHAProviderState", p)
+	if m.ConfigureSimulatorHAProviderStateFunc != nil {
+		return m.ConfigureSimulatorHAProviderStateFunc(p)
+	}
+	if m.ConfigureSimulatorHAProviderStateWithContextFunc != nil {
+		return m.ConfigureSimulatorHAProviderStateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SimulatorService.ConfigureSimulatorHAProviderState")
+	return
+}
+
+// ConfigureSimulatorHAProviderStateWithContext records the call and calls ConfigureSimulatorHAProviderStateWithContextFunc, or ConfigureSimulatorHAProviderStateFunc if only that is set
+func (m *MockSimulatorService) ConfigureSimulatorHAProviderStateWithContext(ctx context.Context, p *cloudstack.ConfigureSimulatorHAProviderStateParams) (r0 *cloudstack.SimulatorHAProviderStateResponse, r1 error) {
+	m.record("ConfigureSimulatorHAProviderStateWithContext", ctx, p)
+	if m.ConfigureSimulatorHAProviderStateWithContextFunc != nil {
+		return m.ConfigureSimulatorHAProviderStateWithContextFunc(ctx, p)
+	}
+	if m.ConfigureSimulatorHAProviderStateFunc != nil {
+		return m.ConfigureSimulatorHAProviderStateFunc(p)
+	}
+	r1 = notMocked("SimulatorService.ConfigureSimulatorHAProviderStateWithContext")
+	return
+}
+
+// NewListSimulatorHAStateTransitionsParams records the call and calls NewListSimulatorHAStateTransitionsParamsFunc
+func (m *MockSimulatorService) NewListSimulatorHAStateTransitionsParams(hostid string) (r0 *cloudstack.ListSimulatorHAStateTransitionsParams) {
+	m.record("NewListSimulatorHAStateTransitionsParams", hostid)
+	if m.NewListSimulatorHAStateTransitionsParamsFunc != nil {
+		return m.NewListSimulatorHAStateTransitionsParamsFunc(hostid)
+	}
+	return cloudstack.NewSimulatorService(nil).NewListSimulatorHAStateTransitionsParams(hostid)
+}
+
+// ListSimulatorHAStateTransitions records the call and calls ListSimulatorHAStateTransitionsFunc, or ListSimulatorHAStateTransitionsWithContextFunc if only that is set
+func (m *MockSimulatorService) ListSimulatorHAStateTransitions(p *cloudstack.ListSimulatorHAStateTransitionsParams) (r0 *cloudstack.ListSimulatorHAStateTransitionsResponse, r1 error) {
+	m.record("ListSimulatorHAStateTransitions", p)
+	if m.ListSimulatorHAStateTransitionsFunc != nil {
+		return m.ListSimulatorHAStateTransitionsFunc(p)
+	}
+	if m.ListSimulatorHAStateTransitionsWithContextFunc != nil {
+		return m.ListSimulatorHAStateTransitionsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SimulatorService.ListSimulatorHAStateTransitions")
+	return
+}
+
+// ListSimulatorHAStateTransitionsWithContext records the call and calls ListSimulatorHAStateTransitionsWithContextFunc, or ListSimulatorHAStateTransitionsFunc if only that is set
+func (m *MockSimulatorService) ListSimulatorHAStateTransitionsWithContext(ctx context.Context, p *cloudstack.ListSimulatorHAStateTransitionsParams) (r0 *cloudstack.ListSimulatorHAStateTransitionsResponse, r1 error) {
+	m.record("ListSimulatorHAStateTransitionsWithContext", ctx, p)
+	if m.ListSimulatorHAStateTransitionsWithContextFunc != nil {
+		return m.ListSimulatorHAStateTransitionsWithContextFunc(ctx, p)
+	}
+	if m.ListSimulatorHAStateTransitionsFunc != nil {
+		return m.ListSimulatorHAStateTransitionsFunc(p)
+	}
+	r1 = notMocked("SimulatorService.ListSimulatorHAStateTransitionsWithContext")
+	return
+}
+
+// NewListSimulatorHAStateTransitionsPager records the call and calls NewListSimulatorHAStateTransitionsPagerFunc
+func (m *MockSimulatorService) NewListSimulatorHAStateTransitionsPager(p *cloudstack.ListSimulatorHAStateTransitionsParams) (r0 *cloudstack.ListSimulatorHAStateTransitionsPager) {
+	m.record("NewListSimulatorHAStateTransitionsPager", p)
+	if m.NewListSimulatorHAStateTransitionsPagerFunc != nil {
+		return m.NewListSimulatorHAStateTransitionsPagerFunc(p)
+	}
+	return
+}
+
+// ListSimulatorHAStateTransitionsAll records the call and calls ListSimulatorHAStateTransitionsAllFunc, or ListSimulatorHAStateTransitionsAllWithContextFunc if only that is set
+func (m *MockSimulatorService) ListSimulatorHAStateTransitionsAll(p *cloudstack.ListSimulatorHAStateTransitionsParams) (r0 *cloudstack.ListSimulatorHAStateTransitionsResponse, r1 error) {
+	m.record("ListSimulatorHAStateTransitionsAll", p)
+	if m.ListSimulatorHAStateTransitionsAllFunc != nil {
+		return m.ListSimulatorHAStateTransitionsAllFunc(p)
+	}
+	if m.ListSimulatorHAStateTransitionsAllWithContextFunc != nil {
+		return m.ListSimulatorHAStateTransitionsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SimulatorService.ListSimulatorHAStateTransitionsAll")
+	return
+}
+
+// ListSimulatorHAStateTransitionsAllWithContext records the call and calls ListSimulatorHAStateTransitionsAllWithContextFunc, or ListSimulatorHAStateTransitionsAllFunc if only that is set
+func (m *MockSimulatorService) ListSimulatorHAStateTransitionsAllWithContext(ctx context.Context, p *cloudstack.ListSimulatorHAStateTransitionsParams) (r0 *cloudstack.ListSimulatorHAStateTransitionsResponse, r1 error) {
+	m.record("ListSimulatorHAStateTransitionsAllWithContext", ctx, p)
+	if m.ListSimulatorHAStateTransitionsAllWithContextFunc != nil {
+		return m.ListSimulatorHAStateTransitionsAllWithContextFunc(ctx, p)
+	}
+	if m.ListSimulatorHAStateTransitionsAllFunc != nil {
+		return m.ListSimulatorHAStateTransitionsAllFunc(p)
+	}
+	r1 = notMocked("SimulatorService.ListSimulatorHAStateTransitionsAllWithContext")
+	return
+}
+
+// NewQuerySimulatorMockParams records the call and calls NewQuerySimulatorMockParamsFunc
+func (m *MockSimulatorService) NewQuerySimulatorMockParams() (r0 *cloudstack.QuerySimulatorMockParams) {
+	m.record("NewQuerySimulatorMockParams")
+	if m.NewQuerySimulatorMockParamsFunc != nil {
+		return m.NewQuerySimulatorMockParamsFunc()
+	}
+	return cloudstack.NewSimulatorService(nil).NewQuerySimulatorMockParams()
+}
+
+// QuerySimulatorMock records the call and calls QuerySimulatorMockFunc, or QuerySimulatorMockWithContextFunc if only that is set
+func (m *MockSimulatorService) QuerySimulatorMock(p *cloudstack.QuerySimulatorMockParams) (r0 *cloudstack.QuerySimulatorMockResponse, r1 error) {
+	m.record("QuerySimulatorMock", p)
+	if m.QuerySimulatorMockFunc != nil {
+		return m.QuerySimulatorMockFunc(p)
+	}
+	if m.QuerySimulatorMockWithContextFunc != nil {
+		return m.QuerySimulatorMockWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SimulatorService.QuerySimulatorMock")
+	return
+}
+
+// QuerySimulatorMockWithContext records the call and calls QuerySimulatorMockWithContextFunc, or QuerySimulatorMockFunc if only that is set
+func (m *MockSimulatorService) QuerySimulatorMockWithContext(ctx context.Context, p *cloudstack.QuerySimulatorMockParams) (r0 *cloudstack.QuerySimulatorMockResponse, r1 error) {
+	m.record("QuerySimulatorMockWithContext", ctx, p)
+	if m.QuerySimulatorMockWithContextFunc != nil {
+		return m.QuerySimulatorMockWithContextFunc(ctx, p)
+	}
+	if m.QuerySimulatorMockFunc != nil {
+		return m.QuerySimulatorMockFunc(p)
+	}
+	r1 = notMocked("SimulatorService.QuerySimulatorMockWithContext")
+	return
+}
@@ -0,0 +1,1077 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockSnapshotService is a mock of cloudstack.SnapshotServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockSnapshotService struct {
+	Recorder
+
+	NewArchiveSnapshotParamsFunc                     func(id string) *cloudstack.ArchiveSnapshotParams
+	ArchiveSnapshotFunc                              func(p *cloudstack.ArchiveSnapshotParams) (*cloudstack.ArchiveSnapshotResponse, error)
+	ArchiveSnapshotWithContextFunc                   func(ctx context.Context, p *cloudstack.ArchiveSnapshotParams) (*cloudstack.ArchiveSnapshotResponse, error)
+	ArchiveSnapshotAsyncFunc                         func(p *cloudstack.ArchiveSnapshotParams) (*cloudstack.Job, error)
+	ArchiveSnapshotAsyncWithContextFunc              func(ctx context.Context, p *cloudstack.ArchiveSnapshotParams) (*cloudstack.Job, error)
+	NewCreateSnapshotParamsFunc                      func(volumeid string) *cloudstack.CreateSnapshotParams
+	CreateSnapshotFunc                               func(p *cloudstack.CreateSnapshotParams) (*cloudstack.CreateSnapshotResponse, error)
+	CreateSnapshotWithContextFunc                    func(ctx context.Context, p *cloudstack.CreateSnapshotParams) (*cloudstack.CreateSnapshotResponse, error)
+	CreateSnapshotAsyncFunc                          func(p *cloudstack.CreateSnapshotParams) (*cloudstack.Job, error)
+	CreateSnapshotAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.CreateSnapshotParams) (*cloudstack.Job, error)
+	NewCreateSnapshotFromVMSnapshotParamsFunc        func(vmsnapshotid string, volumeid string) *cloudstack.CreateSnapshotFromVMSnapshotParams
+	CreateSnapshotFromVMSnapshotFunc                 func(p *cloudstack.CreateSnapshotFromVMSnapshotParams) (*cloudstack.CreateSnapshotFromVMSnapshotResponse, error)
+	CreateSnapshotFromVMSnapshotWithContextFunc      func(ctx context.Context, p *cloudstack.CreateSnapshotFromVMSnapshotParams) (*cloudstack.CreateSnapshotFromVMSnapshotResponse, error)
+	CreateSnapshotFromVMSnapshotAsyncFunc            func(p *cloudstack.CreateSnapshotFromVMSnapshotParams) (*cloudstack.Job, error)
+	CreateSnapshotFromVMSnapshotAsyncWithContextFunc func(ctx context.Context, p *cloudstack.CreateSnapshotFromVMSnapshotParams) (*cloudstack.Job, error)
+	NewCreateSnapshotPolicyParamsFunc                func(intervaltype string, maxsnaps int, schedule string, timezone string, volumeid string) *cloudstack.CreateSnapshotPolicyParams
+	CreateSnapshotPolicyFunc                         func(p *cloudstack.CreateSnapshotPolicyParams) (*cloudstack.CreateSnapshotPolicyResponse, error)
+	CreateSnapshotPolicyWithContextFunc              func(ctx context.Context, p *cloudstack.CreateSnapshotPolicyParams) (*cloudstack.CreateSnapshotPolicyResponse, error)
+	NewCreateVMSnapshotParamsFunc                    func(virtualmachineid string) *cloudstack.CreateVMSnapshotParams
+	CreateVMSnapshotFunc                             func(p *cloudstack.CreateVMSnapshotParams) (*cloudstack.CreateVMSnapshotResponse, error)
+	CreateVMSnapshotWithContextFunc                  func(ctx context.Context, p *cloudstack.CreateVMSnapshotParams) (*cloudstack.CreateVMSnapshotResponse, error)
+	CreateVMSnapshotAsyncFunc                        func(p *cloudstack.CreateVMSnapshotParams) (*cloudstack.Job, error)
+	CreateVMSnapshotAsyncWithContextFunc             func(ctx context.Context, p *cloudstack.CreateVMSnapshotParams) (*cloudstack.Job, error)
+	NewDeleteSnapshotParamsFunc                      func(id string) *cloudstack.DeleteSnapshotParams
+	DeleteSnapshotFunc                               func(p *cloudstack.DeleteSnapshotParams) (*cloudstack.DeleteSnapshotResponse, error)
+	DeleteSnapshotWithContextFunc                    func(ctx context.Context, p *cloudstack.DeleteSnapshotParams) (*cloudstack.DeleteSnapshotResponse, error)
+	DeleteSnapshotAsyncFunc                          func(p *cloudstack.DeleteSnapshotParams) (*cloudstack.Job, error)
+	DeleteSnapshotAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.DeleteSnapshotParams) (*cloudstack.Job, error)
+	NewDeleteSnapshotPoliciesParamsFunc              func() *cloudstack.DeleteSnapshotPoliciesParams
+	DeleteSnapshotPoliciesFunc                       func(p *cloudstack.DeleteSnapshotPoliciesParams) (*cloudstack.DeleteSnapshotPoliciesResponse, error)
+	DeleteSnapshotPoliciesWithContextFunc            func(ctx context.Context, p *cloudstack.DeleteSnapshotPoliciesParams) (*cloudstack.DeleteSnapshotPoliciesResponse, error)
+	NewDeleteVMSnapshotParamsFunc                    func(vmsnapshotid string) *cloudstack.DeleteVMSnapshotParams
+	DeleteVMSnapshotFunc                             func(p *cloudstack.DeleteVMSnapshotParams) (*cloudstack.DeleteVMSnapshotResponse, error)
+	DeleteVMSnapshotWithContextFunc                  func(ctx context.Context, p *cloudstack.DeleteVMSnapshotParams) (*cloudstack.DeleteVMSnapshotResponse, error)
+	DeleteVMSnapshotAsyncFunc                        func(p *cloudstack.DeleteVMSnapshotParams) (*cloudstack.Job, error)
+	DeleteVMSnapshotAsyncWithContextFunc             func(ctx context.Context, p *cloudstack.DeleteVMSnapshotParams) (*cloudstack.Job, error)
+	NewListSnapshotPoliciesParamsFunc                func() *cloudstack.ListSnapshotPoliciesParams
+	GetSnapshotPolicyByIDFunc                        func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.SnapshotPolicy, int, error)
+	GetSnapshotPolicyByIDWithContextFunc             func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.SnapshotPolicy, int, error)
+	ListSnapshotPoliciesFunc                         func(p *cloudstack.ListSnapshotPoliciesParams) (*cloudstack.ListSnapshotPoliciesResponse, error)
+	ListSnapshotPoliciesWithContextFunc              func(ctx context.Context, p *cloudstack.ListSnapshotPoliciesParams) (*cloudstack.ListSnapshotPoliciesResponse, error)
+	NewListSnapshotPoliciesPagerFunc                 func(p *cloudstack.ListSnapshotPoliciesParams) *cloudstack.ListSnapshotPoliciesPager
+	ListSnapshotPoliciesAllFunc                      func(p *cloudstack.ListSnapshotPoliciesParams) (*cloudstack.ListSnapshotPoliciesResponse, error)
+	ListSnapshotPoliciesAllWithContextFunc           func(ctx context.Context, p *cloudstack.ListSnapshotPoliciesParams) (*cloudstack.ListSnapshotPoliciesResponse, error)
+	NewListSnapshotsParamsFunc                       func() *cloudstack.ListSnapshotsParams
+	GetSnapshotIDFunc                                func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetSnapshotIDWithContextFunc                     func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetSnapshotByNameFunc                            func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Snapshot, int, error)
+	GetSnapshotByNameWithContextFunc                 func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.Snapshot, int, error)
+	GetSnapshotByIDFunc                              func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Snapshot, int, error)
+	GetSnapshotByIDWithContextFunc                   func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Snapshot, int, error)
+	ListSnapshotsFunc                                func(p *cloudstack.ListSnapshotsParams) (*cloudstack.ListSnapshotsResponse, error)
+	ListSnapshotsWithContextFunc                     func(ctx context.Context, p *cloudstack.ListSnapshotsParams) (*cloudstack.ListSnapshotsResponse, error)
+	NewListSnapshotsPagerFunc                        func(p *cloudstack.ListSnapshotsParams) *cloudstack.ListSnapshotsPager
+	ListSnapshotsAllFunc                             func(p *cloudstack.ListSnapshotsParams) (*cloudstack.ListSnapshotsResponse, error)
+	ListSnapshotsAllWithContextFunc                  func(ctx context.Context, p *cloudstack.ListSnapshotsParams) (*cloudstack.ListSnapshotsResponse, error)
+	NewListVMSnapshotParamsFunc                      func() *cloudstack.ListVMSnapshotParams
+	GetVMSnapshotIDFunc                              func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVMSnapshotIDWithContextFunc                   func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListVMSnapshotFunc                               func(p *cloudstack.ListVMSnapshotParams) (*cloudstack.ListVMSnapshotResponse, error)
+	ListVMSnapshotWithContextFunc                    func(ctx context.Context, p *cloudstack.ListVMSnapshotParams) (*cloudstack.ListVMSnapshotResponse, error)
+	NewListVMSnapshotPagerFunc                       func(p *cloudstack.ListVMSnapshotParams) *cloudstack.ListVMSnapshotPager
+	ListVMSnapshotAllFunc                            func(p *cloudstack.ListVMSnapshotParams) (*cloudstack.ListVMSnapshotResponse, error)
+	ListVMSnapshotAllWithContextFunc                 func(ctx context.Context, p *cloudstack.ListVMSnapshotParams) (*cloudstack.ListVMSnapshotResponse, error)
+	NewRevertSnapshotParamsFunc                      func(id string) *cloudstack.RevertSnapshotParams
+	RevertSnapshotFunc                               func(p *cloudstack.RevertSnapshotParams) (*cloudstack.RevertSnapshotResponse, error)
+	RevertSnapshotWithContextFunc                    func(ctx context.Context, p *cloudstack.RevertSnapshotParams) (*cloudstack.RevertSnapshotResponse, error)
+	RevertSnapshotAsyncFunc                          func(p *cloudstack.RevertSnapshotParams) (*cloudstack.Job, error)
+	RevertSnapshotAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.RevertSnapshotParams) (*cloudstack.Job, error)
+	NewRevertToVMSnapshotParamsFunc                  func(vmsnapshotid string) *cloudstack.RevertToVMSnapshotParams
+	RevertToVMSnapshotFunc                           func(p *cloudstack.RevertToVMSnapshotParams) (*cloudstack.RevertToVMSnapshotResponse, error)
+	RevertToVMSnapshotWithContextFunc                func(ctx context.Context, p *cloudstack.RevertToVMSnapshotParams) (*cloudstack.RevertToVMSnapshotResponse, error)
+	RevertToVMSnapshotAsyncFunc                      func(p *cloudstack.RevertToVMSnapshotParams) (*cloudstack.Job, error)
+	RevertToVMSnapshotAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.RevertToVMSnapshotParams) (*cloudstack.Job, error)
+	NewUpdateSnapshotPolicyParamsFunc                func() *cloudstack.UpdateSnapshotPolicyParams
+	UpdateSnapshotPolicyFunc                         func(p *cloudstack.UpdateSnapshotPolicyParams) (*cloudstack.UpdateSnapshotPolicyResponse, error)
+	UpdateSnapshotPolicyWithContextFunc              func(ctx context.Context, p *cloudstack.UpdateSnapshotPolicyParams) (*cloudstack.UpdateSnapshotPolicyResponse, error)
+	UpdateSnapshotPolicyAsyncFunc                    func(p *cloudstack.UpdateSnapshotPolicyParams) (*cloudstack.Job, error)
+	UpdateSnapshotPolicyAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.UpdateSnapshotPolicyParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.SnapshotServiceIface = (*MockSnapshotService)(nil)
+
+// NewArchiveSnapshotParams records the call and calls NewArchiveSnapshotParamsFunc
+func (m *MockSnapshotService) NewArchiveSnapshotParams(id string) (r0 *cloudstack.ArchiveSnapshotParams) {
+	m.record("NewArchiveSnapshotParams", id)
+	if m.NewArchiveSnapshotParamsFunc != nil {
+		return m.NewArchiveSnapshotParamsFunc(id)
+	}
+	return cloudstack.NewSnapshotService(nil).NewArchiveSnapshotParams(id)
+}
+
+// ArchiveSnapshot records the call and calls ArchiveSnapshotFunc, or ArchiveSnapshotWithContextFunc if only that is set
+func (m *MockSnapshotService) ArchiveSnapshot(p *cloudstack.ArchiveSnapshotParams) (r0 *cloudstack.ArchiveSnapshotResponse, r1 error) {
+	m.record("ArchiveSnapshot", p)
+	if m.ArchiveSnapshotFunc != nil {
+		return m.ArchiveSnapshotFunc(p)
+	}
+	if m.ArchiveSnapshotWithContextFunc != nil {
+		return m.ArchiveSnapshotWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.ArchiveSnapshot")
+	return
+}
+
+// ArchiveSnapshotWithContext records the call and calls ArchiveSnapshotWithContextFunc, or ArchiveSnapshotFunc if only that is set
+func (m *MockSnapshotService) ArchiveSnapshotWithContext(ctx context.Context, p *cloudstack.ArchiveSnapshotParams) (r0 *cloudstack.ArchiveSnapshotResponse, r1 error) {
+	m.record("ArchiveSnapshotWithContext", ctx, p)
+	if m.ArchiveSnapshotWithContextFunc != nil {
+		return m.ArchiveSnapshotWithContextFunc(ctx, p)
+	}
+	if m.ArchiveSnapshotFunc != nil {
+		return m.ArchiveSnapshotFunc(p)
+	}
+	r1 = notMocked("SnapshotService.ArchiveSnapshotWithContext")
+	return
+}
+
+// ArchiveSnapshotAsync records the call and calls ArchiveSnapshotAsyncFunc, or ArchiveSnapshotAsyncWithContextFunc if only that is set
+func (m *MockSnapshotService) ArchiveSnapshotAsync(p *cloudstack.ArchiveSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ArchiveSnapshotAsync", p)
+	if m.ArchiveSnapshotAsyncFunc != nil {
+		return m.ArchiveSnapshotAsyncFunc(p)
+	}
+	if m.ArchiveSnapshotAsyncWithContextFunc != nil {
+		return m.ArchiveSnapshotAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.ArchiveSnapshotAsync")
+	return
+}
+
+// ArchiveSnapshotAsyncWithContext records the call and calls ArchiveSnapshotAsyncWithContextFunc, or ArchiveSnapshotAsyncFunc if only that is set
+func (m *MockSnapshotService) ArchiveSnapshotAsyncWithContext(ctx context.Context, p *cloudstack.ArchiveSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ArchiveSnapshotAsyncWithContext", ctx, p)
+	if m.ArchiveSnapshotAsyncWithContextFunc != nil {
+		return m.ArchiveSnapshotAsyncWithContextFunc(ctx, p)
+	}
+	if m.ArchiveSnapshotAsyncFunc != nil {
+		return m.ArchiveSnapshotAsyncFunc(p)
+	}
+	r1 = notMocked("SnapshotService.ArchiveSnapshotAsyncWithContext")
+	return
+}
+
+// NewCreateSnapshotParams records the call and calls NewCreateSnapshotParamsFunc
+func (m *MockSnapshotService) NewCreateSnapshotParams(volumeid string) (r0 *cloudstack.CreateSnapshotParams) {
+	m.record("NewCreateSnapshotParams", volumeid)
+	if m.NewCreateSnapshotParamsFunc != nil {
+		return m.NewCreateSnapshotParamsFunc(volumeid)
+	}
+	return cloudstack.NewSnapshotService(nil).NewCreateSnapshotParams(volumeid)
+}
+
+// CreateSnapshot records the call and calls CreateSnapshotFunc, or CreateSnapshotWithContextFunc if only that is set
+func (m *MockSnapshotService) CreateSnapshot(p *cloudstack.CreateSnapshotParams) (r0 *cloudstack.CreateSnapshotResponse, r1 error) {
+	m.record("CreateSnapshot", p)
+	if m.CreateSnapshotFunc != nil {
+		return m.CreateSnapshotFunc(p)
+	}
+	if m.CreateSnapshotWithContextFunc != nil {
+		return m.CreateSnapshotWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.CreateSnapshot")
+	return
+}
+
+// CreateSnapshotWithContext records the call and calls CreateSnapshotWithContextFunc, or CreateSnapshotFunc if only that is set
+func (m *MockSnapshotService) CreateSnapshotWithContext(ctx context.Context, p *cloudstack.CreateSnapshotParams) (r0 *cloudstack.CreateSnapshotResponse, r1 error) {
+	m.record("CreateSnapshotWithContext", ctx, p)
+	if m.CreateSnapshotWithContextFunc != nil {
+		return m.CreateSnapshotWithContextFunc(ctx, p)
+	}
+	if m.CreateSnapshotFunc != nil {
+		return m.CreateSnapshotFunc(p)
+	}
+	r1 = notMocked("SnapshotService.CreateSnapshotWithContext")
+	return
+}
+
+// CreateSnapshotAsync records the call and calls CreateSnapshotAsyncFunc, or CreateSnapshotAsyncWithContextFunc if only that is set
+func (m *MockSnapshotService) CreateSnapshotAsync(p *cloudstack.CreateSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateSnapshotAsync", p)
+	if m.CreateSnapshotAsyncFunc != nil {
+		return m.CreateSnapshotAsyncFunc(p)
+	}
+	if m.CreateSnapshotAsyncWithContextFunc != nil {
+		return m.CreateSnapshotAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.CreateSnapshotAsync")
+	return
+}
+
+// CreateSnapshotAsyncWithContext records the call and calls CreateSnapshotAsyncWithContextFunc, or CreateSnapshotAsyncFunc if only that is set
+func (m *MockSnapshotService) CreateSnapshotAsyncWithContext(ctx context.Context, p *cloudstack.CreateSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateSnapshotAsyncWithContext", ctx, p)
+	if m.CreateSnapshotAsyncWithContextFunc != nil {
+		return m.CreateSnapshotAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateSnapshotAsyncFunc != nil {
+		return m.CreateSnapshotAsyncFunc(p)
+	}
+	r1 = notMocked("SnapshotService.CreateSnapshotAsyncWithContext")
+	return
+}
+
+// NewCreateSnapshotFromVMSnapshotParams records the call and calls NewCreateSnapshotFromVMSnapshotParamsFunc
+func (m *MockSnapshotService) NewCreateSnapshotFromVMSnapshotParams(vmsnapshotid string, volumeid string) (r0 *cloudstack.CreateSnapshotFromVMSnapshotParams) {
+	m.record("NewCreateSnapshotFromVMSnapshotParams", vmsnapshotid, volumeid)
+	if m.NewCreateSnapshotFromVMSnapshotParamsFunc != nil {
+		return m.NewCreateSnapshotFromVMSnapshotParamsFunc(vmsnapshotid, volumeid)
+	}
+	return cloudstack.NewSnapshotService(nil).NewCreateSnapshotFromVMSnapshotParams(vmsnapshotid, volumeid)
+}
+
+// CreateSnapshotFromVMSnapshot records the call and calls CreateSnapshotFromVMSnapshotFunc, or CreateSnapshotFromVMSnapshotWithContextFunc if only that is set
+func (m *MockSnapshotService) CreateSnapshotFromVMSnapshot(p *cloudstack.CreateSnapshotFromVMSnapshotParams) (r0 *cloudstack.CreateSnapshotFromVMSnapshotResponse, r1 error) {
+	m.record("CreateSnapshotFromVMSnapshot", p)
+	if m.CreateSnapshotFromVMSnapshotFunc != nil {
+		return m.CreateSnapshotFromVMSnapshotFunc(p)
+	}
+	if m.CreateSnapshotFromVMSnapshotWithContextFunc != nil {
+		return m.CreateSnapshotFromVMSnapshotWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.CreateSnapshotFromVMSnapshot")
+	return
+}
+
+// CreateSnapshotFromVMSnapshotWithContext records the call and calls CreateSnapshotFromVMSnapshotWithContextFunc, or CreateSnapshotFromVMSnapshotFunc if only that is set
+func (m *MockSnapshotService) CreateSnapshotFromVMSnapshotWithContext(ctx context.Context, p *cloudstack.CreateSnapshotFromVMSnapshotParams) (r0 *cloudstack.CreateSnapshotFromVMSnapshotResponse, r1 error) {
+	m.record("CreateSnapshotFromVMSnapshotWithContext", ctx, p)
+	if m.CreateSnapshotFromVMSnapshotWithContextFunc != nil {
+		return m.CreateSnapshotFromVMSnapshotWithContextFunc(ctx, p)
+	}
+	if m.CreateSnapshotFromVMSnapshotFunc != nil {
+		return m.CreateSnapshotFromVMSnapshotFunc(p)
+	}
+	r1 = notMocked("SnapshotService.CreateSnapshotFromVMSnapshotWithContext")
+	return
+}
+
+// CreateSnapshotFromVMSnapshotAsync records the call and calls CreateSnapshotFromVMSnapshotAsyncFunc, or CreateSnapshotFromVMSnapshotAsyncWithContextFunc if only that is set
+func (m *MockSnapshotService) CreateSnapshotFromVMSnapshotAsync(p *cloudstack.CreateSnapshotFromVMSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateSnapshotFromVMSnapshotAsync", p)
+	if m.CreateSnapshotFromVMSnapshotAsyncFunc != nil {
+		return m.CreateSnapshotFromVMSnapshotAsyncFunc(p)
+	}
+	if m.CreateSnapshotFromVMSnapshotAsyncWithContextFunc != nil {
+		return m.CreateSnapshotFromVMSnapshotAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.CreateSnapshotFromVMSnapshotAsync")
+	return
+}
+
+// CreateSnapshotFromVMSnapshotAsyncWithContext records the call and calls CreateSnapshotFromVMSnapshotAsyncWithContextFunc, or CreateSnapshotFromVMSnapshotAsyncFunc if only that is set
+func (m *MockSnapshotService) CreateSnapshotFromVMSnapshotAsyncWithContext(ctx context.Context, p *cloudstack.CreateSnapshotFromVMSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateSnapshotFromVMSnapshotAsyncWithContext", ctx, p)
+	if m.CreateSnapshotFromVMSnapshotAsyncWithContextFunc != nil {
+		return m.CreateSnapshotFromVMSnapshotAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateSnapshotFromVMSnapshotAsyncFunc != nil {
+		return m.CreateSnapshotFromVMSnapshotAsyncFunc(p)
+	}
+	r1 = notMocked("SnapshotService.CreateSnapshotFromVMSnapshotAsyncWithContext")
+	return
+}
+
+// NewCreateSnapshotPolicyParams records the call and calls NewCreateSnapshotPolicyParamsFunc
+func (m *MockSnapshotService) NewCreateSnapshotPolicyParams(intervaltype string, maxsnaps int, schedule string, timezone string, volumeid string) (r0 *cloudstack.CreateSnapshotPolicyParams) {
+	m.record("NewCreateSnapshotPolicyParams", intervaltype, maxsnaps, schedule, timezone, volumeid)
+	if m.NewCreateSnapshotPolicyParamsFunc != nil {
+		return m.NewCreateSnapshotPolicyParamsFunc(intervaltype, maxsnaps, schedule, timezone, volumeid)
+	}
+	return cloudstack.NewSnapshotService(nil).NewCreateSnapshotPolicyParams(intervaltype, maxsnaps, schedule, timezone, volumeid)
+}
+
+// CreateSnapshotPolicy records the call and calls CreateSnapshotPolicyFunc, or CreateSnapshotPolicyWithContextFunc if only that is set
+func (m *MockSnapshotService) CreateSnapshotPolicy(p *cloudstack.CreateSnapshotPolicyParams) (r0 *cloudstack.CreateSnapshotPolicyResponse, r1 error) {
+	m.record("CreateSnapshotPolicy", p)
+	if m.CreateSnapshotPolicyFunc != nil {
+		return m.CreateSnapshotPolicyFunc(p)
+	}
+	if m.CreateSnapshotPolicyWithContextFunc != nil {
+		return m.CreateSnapshotPolicyWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.CreateSnapshotPolicy")
+	return
+}
+
+// CreateSnapshotPolicyWithContext records the call and calls CreateSnapshotPolicyWithContextFunc, or CreateSnapshotPolicyFunc if only that is set
+func (m *MockSnapshotService) CreateSnapshotPolicyWithContext(ctx context.Context, p *cloudstack.CreateSnapshotPolicyParams) (r0 *cloudstack.CreateSnapshotPolicyResponse, r1 error) {
+	m.record("CreateSnapshotPolicyWithContext", ctx, p)
+	if m.CreateSnapshotPolicyWithContextFunc != nil {
+		return m.CreateSnapshotPolicyWithContextFunc(ctx, p)
+	}
+	if m.CreateSnapshotPolicyFunc != nil {
+		return m.CreateSnapshotPolicyFunc(p)
+	}
+	r1 = notMocked("SnapshotService.CreateSnapshotPolicyWithContext")
+	return
+}
+
+// NewCreateVMSnapshotParams records the call and calls NewCreateVMSnapshotParamsFunc
+func (m *MockSnapshotService) NewCreateVMSnapshotParams(virtualmachineid string) (r0 *cloudstack.CreateVMSnapshotParams) {
+	m.record("NewCreateVMSnapshotParams", virtualmachineid)
+	if m.NewCreateVMSnapshotParamsFunc != nil {
+		return m.NewCreateVMSnapshotParamsFunc(virtualmachineid)
+	}
+	return cloudstack.NewSnapshotService(nil).NewCreateVMSnapshotParams(virtualmachineid)
+}
+
+// CreateVMSnapshot records the call and calls CreateVMSnapshotFunc, or CreateVMSnapshotWithContextFunc if only that is set
+func (m *MockSnapshotService) CreateVMSnapshot(p *cloudstack.CreateVMSnapshotParams) (r0 *cloudstack.CreateVMSnapshotResponse, r1 error) {
+	m.record("CreateVMSnapshot", p)
+	if m.CreateVMSnapshotFunc != nil {
+		return m.CreateVMSnapshotFunc(p)
+	}
+	if m.CreateVMSnapshotWithContextFunc != nil {
+		return m.CreateVMSnapshotWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.CreateVMSnapshot")
+	return
+}
+
+// CreateVMSnapshotWithContext records the call and calls CreateVMSnapshotWithContextFunc, or CreateVMSnapshotFunc if only that is set
+func (m *MockSnapshotService) CreateVMSnapshotWithContext(ctx context.Context, p *cloudstack.CreateVMSnapshotParams) (r0 *cloudstack.CreateVMSnapshotResponse, r1 error) {
+	m.record("CreateVMSnapshotWithContext", ctx, p)
+	if m.CreateVMSnapshotWithContextFunc != nil {
+		return m.CreateVMSnapshotWithContextFunc(ctx, p)
+	}
+	if m.CreateVMSnapshotFunc != nil {
+		return m.CreateVMSnapshotFunc(p)
+	}
+	r1 = notMocked("SnapshotService.CreateVMSnapshotWithContext")
+	return
+}
+
+// CreateVMSnapshotAsync records the call and calls CreateVMSnapshotAsyncFunc, or CreateVMSnapshotAsyncWithContextFunc if only that is set
+func (m *MockSnapshotService) CreateVMSnapshotAsync(p *cloudstack.CreateVMSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVMSnapshotAsync", p)
+	if m.CreateVMSnapshotAsyncFunc != nil {
+		return m.CreateVMSnapshotAsyncFunc(p)
+	}
+	if m.CreateVMSnapshotAsyncWithContextFunc != nil {
+		return m.CreateVMSnapshotAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.CreateVMSnapshotAsync")
+	return
+}
+
+// CreateVMSnapshotAsyncWithContext records the call and calls CreateVMSnapshotAsyncWithContextFunc, or CreateVMSnapshotAsyncFunc if only that is set
+func (m *MockSnapshotService) CreateVMSnapshotAsyncWithContext(ctx context.Context, p *cloudstack.CreateVMSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVMSnapshotAsyncWithContext", ctx, p)
+	if m.CreateVMSnapshotAsyncWithContextFunc != nil {
+		return m.CreateVMSnapshotAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateVMSnapshotAsyncFunc != nil {
+		return m.CreateVMSnapshotAsyncFunc(p)
+	}
+	r1 = notMocked("SnapshotService.CreateVMSnapshotAsyncWithContext")
+	return
+}
+
+// NewDeleteSnapshotParams records the call and calls NewDeleteSnapshotParamsFunc
+func (m *MockSnapshotService) NewDeleteSnapshotParams(id string) (r0 *cloudstack.DeleteSnapshotParams) {
+	m.record("NewDeleteSnapshotParams", id)
+	if m.NewDeleteSnapshotParamsFunc != nil {
+		return m.NewDeleteSnapshotParamsFunc(id)
+	}
+	return cloudstack.NewSnapshotService(nil).NewDeleteSnapshotParams(id)
+}
+
+// DeleteSnapshot records the call and calls DeleteSnapshotFunc, or DeleteSnapshotWithContextFunc if only that is set
+func (m *MockSnapshotService) DeleteSnapshot(p *cloudstack.DeleteSnapshotParams) (r0 *cloudstack.DeleteSnapshotResponse, r1 error) {
+	m.record("DeleteSnapshot", p)
+	if m.DeleteSnapshotFunc != nil {
+		return m.DeleteSnapshotFunc(p)
+	}
+	if m.DeleteSnapshotWithContextFunc != nil {
+		return m.DeleteSnapshotWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.DeleteSnapshot")
+	return
+}
+
+// DeleteSnapshotWithContext records the call and calls DeleteSnapshotWithContextFunc, or DeleteSnapshotFunc if only that is set
+func (m *MockSnapshotService) DeleteSnapshotWithContext(ctx context.Context, p *cloudstack.DeleteSnapshotParams) (r0 *cloudstack.DeleteSnapshotResponse, r1 error) {
+	m.record("DeleteSnapshotWithContext", ctx, p)
+	if m.DeleteSnapshotWithContextFunc != nil {
+		return m.DeleteSnapshotWithContextFunc(ctx, p)
+	}
+	if m.DeleteSnapshotFunc != nil {
+		return m.DeleteSnapshotFunc(p)
+	}
+	r1 = notMocked("SnapshotService.DeleteSnapshotWithContext")
+	return
+}
+
+// DeleteSnapshotAsync records the call and calls DeleteSnapshotAsyncFunc, or DeleteSnapshotAsyncWithContextFunc if only that is set
+func (m *MockSnapshotService) DeleteSnapshotAsync(p *cloudstack.DeleteSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteSnapshotAsync", p)
+	if m.DeleteSnapshotAsyncFunc != nil {
+		return m.DeleteSnapshotAsyncFunc(p)
+	}
+	if m.DeleteSnapshotAsyncWithContextFunc != nil {
+		return m.DeleteSnapshotAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.DeleteSnapshotAsync")
+	return
+}
+
+// DeleteSnapshotAsyncWithContext records the call and calls DeleteSnapshotAsyncWithContextFunc, or DeleteSnapshotAsyncFunc if only that is set
+func (m *MockSnapshotService) DeleteSnapshotAsyncWithContext(ctx context.Context, p *cloudstack.DeleteSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteSnapshotAsyncWithContext", ctx, p)
+	if m.DeleteSnapshotAsyncWithContextFunc != nil {
+		return m.DeleteSnapshotAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteSnapshotAsyncFunc != nil {
+		return m.DeleteSnapshotAsyncFunc(p)
+	}
+	r1 = notMocked("SnapshotService.DeleteSnapshotAsyncWithContext")
+	return
+}
+
+// NewDeleteSnapshotPoliciesParams records the call and calls NewDeleteSnapshotPoliciesParamsFunc
+func (m *MockSnapshotService) NewDeleteSnapshotPoliciesParams() (r0 *cloudstack.DeleteSnapshotPoliciesParams) {
+	m.record("NewDeleteSnapshotPoliciesParams")
+	if m.NewDeleteSnapshotPoliciesParamsFunc != nil {
+		return m.NewDeleteSnapshotPoliciesParamsFunc()
+	}
+	return cloudstack.NewSnapshotService(nil).NewDeleteSnapshotPoliciesParams()
+}
+
+// DeleteSnapshotPolicies records the call and calls DeleteSnapshotPoliciesFunc, or DeleteSnapshotPoliciesWithContextFunc if only that is set
+func (m *MockSnapshotService) DeleteSnapshotPolicies(p *cloudstack.DeleteSnapshotPoliciesParams) (r0 *cloudstack.DeleteSnapshotPoliciesResponse, r1 error) {
+	m.record("DeleteSnapshotPolicies", p)
+	if m.DeleteSnapshotPoliciesFunc != nil {
+		return m.DeleteSnapshotPoliciesFunc(p)
+	}
+	if m.DeleteSnapshotPoliciesWithContextFunc != nil {
+		return m.DeleteSnapshotPoliciesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.DeleteSnapshotPolicies")
+	return
+}
+
+// DeleteSnapshotPoliciesWithContext records the call and calls DeleteSnapshotPoliciesWithContextFunc, or DeleteSnapshotPoliciesFunc if only that is set
+func (m *MockSnapshotService) DeleteSnapshotPoliciesWithContext(ctx context.Context, p *cloudstack.DeleteSnapshotPoliciesParams) (r0 *cloudstack.DeleteSnapshotPoliciesResponse, r1 error) {
+	m.record("DeleteSnapshotPoliciesWithContext", ctx, p)
+	if m.DeleteSnapshotPoliciesWithContextFunc != nil {
+		return m.DeleteSnapshotPoliciesWithContextFunc(ctx, p)
+	}
+	if m.DeleteSnapshotPoliciesFunc != nil {
+		return m.DeleteSnapshotPoliciesFunc(p)
+	}
+	r1 = notMocked("SnapshotService.DeleteSnapshotPoliciesWithContext")
+	return
+}
+
+// NewDeleteVMSnapshotParams records the call and calls NewDeleteVMSnapshotParamsFunc
+func (m *MockSnapshotService) NewDeleteVMSnapshotParams(vmsnapshotid string) (r0 *cloudstack.DeleteVMSnapshotParams) {
+	m.record("NewDeleteVMSnapshotParams", vmsnapshotid)
+	if m.NewDeleteVMSnapshotParamsFunc != nil {
+		return m.NewDeleteVMSnapshotParamsFunc(vmsnapshotid)
+	}
+	return cloudstack.NewSnapshotService(nil).NewDeleteVMSnapshotParams(vmsnapshotid)
+}
+
+// DeleteVMSnapshot records the call and calls DeleteVMSnapshotFunc, or DeleteVMSnapshotWithContextFunc if only that is set
+func (m *MockSnapshotService) DeleteVMSnapshot(p *cloudstack.DeleteVMSnapshotParams) (r0 *cloudstack.DeleteVMSnapshotResponse, r1 error) {
+	m.record("DeleteVMSnapshot", p)
+	if m.DeleteVMSnapshotFunc != nil {
+		return m.DeleteVMSnapshotFunc(p)
+	}
+	if m.DeleteVMSnapshotWithContextFunc != nil {
+		return m.DeleteVMSnapshotWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.DeleteVMSnapshot")
+	return
+}
+
+// DeleteVMSnapshotWithContext records the call and calls DeleteVMSnapshotWithContextFunc, or DeleteVMSnapshotFunc if only that is set
+func (m *MockSnapshotService) DeleteVMSnapshotWithContext(ctx context.Context, p *cloudstack.DeleteVMSnapshotParams) (r0 *cloudstack.DeleteVMSnapshotResponse, r1 error) {
+	m.record("DeleteVMSnapshotWithContext", ctx, p)
+	if m.DeleteVMSnapshotWithContextFunc != nil {
+		return m.DeleteVMSnapshotWithContextFunc(ctx, p)
+	}
+	if m.DeleteVMSnapshotFunc != nil {
+		return m.DeleteVMSnapshotFunc(p)
+	}
+	r1 = notMocked("SnapshotService.DeleteVMSnapshotWithContext")
+	return
+}
+
+// DeleteVMSnapshotAsync records the call and calls DeleteVMSnapshotAsyncFunc, or DeleteVMSnapshotAsyncWithContextFunc if only that is set
+func (m *MockSnapshotService) DeleteVMSnapshotAsync(p *cloudstack.DeleteVMSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteVMSnapshotAsync", p)
+	if m.DeleteVMSnapshotAsyncFunc != nil {
+		return m.DeleteVMSnapshotAsyncFunc(p)
+	}
+	if m.DeleteVMSnapshotAsyncWithContextFunc != nil {
+		return m.DeleteVMSnapshotAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.DeleteVMSnapshotAsync")
+	return
+}
+
+// DeleteVMSnapshotAsyncWithContext records the call and calls DeleteVMSnapshotAsyncWithContextFunc, or DeleteVMSnapshotAsyncFunc if only that is set
+func (m *MockSnapshotService) DeleteVMSnapshotAsyncWithContext(ctx context.Context, p *cloudstack.DeleteVMSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteVMSnapshotAsyncWithContext", ctx, p)
+	if m.DeleteVMSnapshotAsyncWithContextFunc != nil {
+		return m.DeleteVMSnapshotAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteVMSnapshotAsyncFunc != nil {
+		return m.DeleteVMSnapshotAsyncFunc(p)
+	}
+	r1 = notMocked("SnapshotService.DeleteVMSnapshotAsyncWithContext")
+	return
+}
+
+// NewListSnapshotPoliciesParams records the call and calls NewListSnapshotPoliciesParamsFunc
+func (m *MockSnapshotService) NewListSnapshotPoliciesParams() (r0 *cloudstack.ListSnapshotPoliciesParams) {
+	m.record("NewListSnapshotPoliciesParams")
+	if m.NewListSnapshotPoliciesParamsFunc != nil {
+		return m.NewListSnapshotPoliciesParamsFunc()
+	}
+	return cloudstack.NewSnapshotService(nil).NewListSnapshotPoliciesParams()
+}
+
+// GetSnapshotPolicyByID records the call and calls GetSnapshotPolicyByIDFunc, or GetSnapshotPolicyByIDWithContextFunc if only that is set
+func (m *MockSnapshotService) GetSnapshotPolicyByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.SnapshotPolicy, r1 int, r2 error) {
+	m.record("GetSnapshotPolicyByID", id, opts)
+	if m.GetSnapshotPolicyByIDFunc != nil {
+		return m.GetSnapshotPolicyByIDFunc(id, opts...)
+	}
+	if m.GetSnapshotPolicyByIDWithContextFunc != nil {
+		return m.GetSnapshotPolicyByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("SnapshotService.GetSnapshotPolicyByID")
+	return
+}
+
+// GetSnapshotPolicyByIDWithContext records the call and calls GetSnapshotPolicyByIDWithContextFunc, or GetSnapshotPolicyByIDFunc if only that is set
+func (m *MockSnapshotService) GetSnapshotPolicyByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.SnapshotPolicy, r1 int, r2 error) {
+	m.record("GetSnapshotPolicyByIDWithContext", ctx, id, opts)
+	if m.GetSnapshotPolicyByIDWithContextFunc != nil {
+		return m.GetSnapshotPolicyByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetSnapshotPolicyByIDFunc != nil {
+		return m.GetSnapshotPolicyByIDFunc(id, opts...)
+	}
+	r2 = notMocked("SnapshotService.GetSnapshotPolicyByIDWithContext")
+	return
+}
+
+// ListSnapshotPolicies records the call and calls ListSnapshotPoliciesFunc, or ListSnapshotPoliciesWithContextFunc if only that is set
+func (m *MockSnapshotService) ListSnapshotPolicies(p *cloudstack.ListSnapshotPoliciesParams) (r0 *cloudstack.ListSnapshotPoliciesResponse, r1 error) {
+	m.record("ListSnapshotPolicies", p)
+	if m.ListSnapshotPoliciesFunc != nil {
+		return m.ListSnapshotPoliciesFunc(p)
+	}
+	if m.ListSnapshotPoliciesWithContextFunc != nil {
+		return m.ListSnapshotPoliciesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.ListSnapshotPolicies")
+	return
+}
+
+// ListSnapshotPoliciesWithContext records the call and calls ListSnapshotPoliciesWithContextFunc, or ListSnapshotPoliciesFunc if only that is set
+func (m *MockSnapshotService) ListSnapshotPoliciesWithContext(ctx context.Context, p *cloudstack.ListSnapshotPoliciesParams) (r0 *cloudstack.ListSnapshotPoliciesResponse, r1 error) {
+	m.record("ListSnapshotPoliciesWithContext", ctx, p)
+	if m.ListSnapshotPoliciesWithContextFunc != nil {
+		return m.ListSnapshotPoliciesWithContextFunc(ctx, p)
+	}
+	if m.ListSnapshotPoliciesFunc != nil {
+		return m.ListSnapshotPoliciesFunc(p)
+	}
+	r1 = notMocked("SnapshotService.ListSnapshotPoliciesWithContext")
+	return
+}
+
+// NewListSnapshotPoliciesPager records the call and calls NewListSnapshotPoliciesPagerFunc
+func (m *MockSnapshotService) NewListSnapshotPoliciesPager(p *cloudstack.ListSnapshotPoliciesParams) (r0 *cloudstack.ListSnapshotPoliciesPager) {
+	m.record("NewListSnapshotPoliciesPager", p)
+	if m.NewListSnapshotPoliciesPagerFunc != nil {
+		return m.NewListSnapshotPoliciesPagerFunc(p)
+	}
+	return
+}
+
+// ListSnapshotPoliciesAll records the call and calls ListSnapshotPoliciesAllFunc, or ListSnapshotPoliciesAllWithContextFunc if only that is set
+func (m *MockSnapshotService) ListSnapshotPoliciesAll(p *cloudstack.ListSnapshotPoliciesParams) (r0 *cloudstack.ListSnapshotPoliciesResponse, r1 error) {
+	m.record("ListSnapshotPoliciesAll", p)
+	if m.ListSnapshotPoliciesAllFunc != nil {
+		return m.ListSnapshotPoliciesAllFunc(p)
+	}
+	if m.ListSnapshotPoliciesAllWithContextFunc != nil {
+		return m.ListSnapshotPoliciesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.ListSnapshotPoliciesAll")
+	return
+}
+
+// ListSnapshotPoliciesAllWithContext records the call and calls ListSnapshotPoliciesAllWithContextFunc, or ListSnapshotPoliciesAllFunc if only that is set
+func (m *MockSnapshotService) ListSnapshotPoliciesAllWithContext(ctx context.Context, p *cloudstack.ListSnapshotPoliciesParams) (r0 *cloudstack.ListSnapshotPoliciesResponse, r1 error) {
+	m.record("ListSnapshotPoliciesAllWithContext", ctx, p)
+	if m.ListSnapshotPoliciesAllWithContextFunc != nil {
+		return m.ListSnapshotPoliciesAllWithContextFunc(ctx, p)
+	}
+	if m.ListSnapshotPoliciesAllFunc != nil {
+		return m.ListSnapshotPoliciesAllFunc(p)
+	}
+	r1 = notMocked("SnapshotService.ListSnapshotPoliciesAllWithContext")
+	return
+}
+
+// NewListSnapshotsParams records the call and calls NewListSnapshotsParamsFunc
+func (m *MockSnapshotService) NewListSnapshotsParams() (r0 *cloudstack.ListSnapshotsParams) {
+	m.record("NewListSnapshotsParams")
+	if m.NewListSnapshotsParamsFunc != nil {
+		return m.NewListSnapshotsParamsFunc()
+	}
+	return cloudstack.NewSnapshotService(nil).NewListSnapshotsParams()
+}
+
+// GetSnapshotID records the call and calls GetSnapshotIDFunc, or GetSnapshotIDWithContextFunc if only that is set
+func (m *MockSnapshotService) GetSnapshotID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetSnapshotID", name, opts)
+	if m.GetSnapshotIDFunc != nil {
+		return m.GetSnapshotIDFunc(name, opts...)
+	}
+	if m.GetSnapshotIDWithContextFunc != nil {
+		return m.GetSnapshotIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("SnapshotService.GetSnapshotID")
+	return
+}
+
+// GetSnapshotIDWithContext records the call and calls GetSnapshotIDWithContextFunc, or GetSnapshotIDFunc if only that is set
+func (m *MockSnapshotService) GetSnapshotIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetSnapshotIDWithContext", ctx, name, opts)
+	if m.GetSnapshotIDWithContextFunc != nil {
+		return m.GetSnapshotIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetSnapshotIDFunc != nil {
+		return m.GetSnapshotIDFunc(name, opts...)
+	}
+	r2 = notMocked("SnapshotService.GetSnapshotIDWithContext")
+	return
+}
+
+// GetSnapshotByName records the call and calls GetSnapshotByNameFunc, or GetSnapshotByNameWithContextFunc if only that is set
+func (m *MockSnapshotService) GetSnapshotByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Snapshot, r1 int, r2 error) {
+	m.record("GetSnapshotByName", name, opts)
+	if m.GetSnapshotByNameFunc != nil {
+		return m.GetSnapshotByNameFunc(name, opts...)
+	}
+	if m.GetSnapshotByNameWithContextFunc != nil {
+		return m.GetSnapshotByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("SnapshotService.GetSnapshotByName")
+	return
+}
+
+// GetSnapshotByNameWithContext records the call and calls GetSnapshotByNameWithContextFunc, or GetSnapshotByNameFunc if only that is set
+func (m *MockSnapshotService) GetSnapshotByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Snapshot, r1 int, r2 error) {
+	m.record("GetSnapshotByNameWithContext", ctx, name, opts)
+	if m.GetSnapshotByNameWithContextFunc != nil {
+		return m.GetSnapshotByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetSnapshotByNameFunc != nil {
+		return m.GetSnapshotByNameFunc(name, opts...)
+	}
+	r2 = notMocked("SnapshotService.GetSnapshotByNameWithContext")
+	return
+}
+
+// GetSnapshotByID records the call and calls GetSnapshotByIDFunc, or GetSnapshotByIDWithContextFunc if only that is set
+func (m *MockSnapshotService) GetSnapshotByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Snapshot, r1 int, r2 error) {
+	m.record("GetSnapshotByID", id, opts)
+	if m.GetSnapshotByIDFunc != nil {
+		return m.GetSnapshotByIDFunc(id, opts...)
+	}
+	if m.GetSnapshotByIDWithContextFunc != nil {
+		return m.GetSnapshotByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("SnapshotService.GetSnapshotByID")
+	return
+}
+
+// GetSnapshotByIDWithContext records the call and calls GetSnapshotByIDWithContextFunc, or GetSnapshotByIDFunc if only that is set
+func (m *MockSnapshotService) GetSnapshotByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Snapshot, r1 int, r2 error) {
+	m.record("GetSnapshotByIDWithContext", ctx, id, opts)
+	if m.GetSnapshotByIDWithContextFunc != nil {
+		return m.GetSnapshotByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetSnapshotByIDFunc != nil {
+		return m.GetSnapshotByIDFunc(id, opts...)
+	}
+	r2 = notMocked("SnapshotService.GetSnapshotByIDWithContext")
+	return
+}
+
+// ListSnapshots records the call and calls ListSnapshotsFunc, or ListSnapshotsWithContextFunc if only that is set
+func (m *MockSnapshotService) ListSnapshots(p *cloudstack.ListSnapshotsParams) (r0 *cloudstack.ListSnapshotsResponse, r1 error) {
+	m.record("ListSnapshots", p)
+	if m.ListSnapshotsFunc != nil {
+		return m.ListSnapshotsFunc(p)
+	}
+	if m.ListSnapshotsWithContextFunc != nil {
+		return m.ListSnapshotsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.ListSnapshots")
+	return
+}
+
+// ListSnapshotsWithContext records the call and calls ListSnapshotsWithContextFunc, or ListSnapshotsFunc if only that is set
+func (m *MockSnapshotService) ListSnapshotsWithContext(ctx context.Context, p *cloudstack.ListSnapshotsParams) (r0 *cloudstack.ListSnapshotsResponse, r1 error) {
+	m.record("ListSnapshotsWithContext", ctx, p)
+	if m.ListSnapshotsWithContextFunc != nil {
+		return m.ListSnapshotsWithContextFunc(ctx, p)
+	}
+	if m.ListSnapshotsFunc != nil {
+		return m.ListSnapshotsFunc(p)
+	}
+	r1 = notMocked("SnapshotService.ListSnapshotsWithContext")
+	return
+}
+
+// NewListSnapshotsPager records the call and calls NewListSnapshotsPagerFunc
+func (m *MockSnapshotService) NewListSnapshotsPager(p *cloudstack.ListSnapshotsParams) (r0 *cloudstack.ListSnapshotsPager) {
+	m.record("NewListSnapshotsPager", p)
+	if m.NewListSnapshotsPagerFunc != nil {
+		return m.NewListSnapshotsPagerFunc(p)
+	}
+	return
+}
+
+// ListSnapshotsAll records the call and calls ListSnapshotsAllFunc, or ListSnapshotsAllWithContextFunc if only that is set
+func (m *MockSnapshotService) ListSnapshotsAll(p *cloudstack.ListSnapshotsParams) (r0 *cloudstack.ListSnapshotsResponse, r1 error) {
+	m.record("ListSnapshotsAll", p)
+	if m.ListSnapshotsAllFunc != nil {
+		return m.ListSnapshotsAllFunc(p)
+	}
+	if m.ListSnapshotsAllWithContextFunc != nil {
+		return m.ListSnapshotsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.ListSnapshotsAll")
+	return
+}
+
+// ListSnapshotsAllWithContext records the call and calls ListSnapshotsAllWithContextFunc, or ListSnapshotsAllFunc if only that is set
+func (m *MockSnapshotService) ListSnapshotsAllWithContext(ctx context.Context, p *cloudstack.ListSnapshotsParams) (r0 *cloudstack.ListSnapshotsResponse, r1 error) {
+	m.record("ListSnapshotsAllWithContext", ctx, p)
+	if m.ListSnapshotsAllWithContextFunc != nil {
+		return m.ListSnapshotsAllWithContextFunc(ctx, p)
+	}
+	if m.ListSnapshotsAllFunc != nil {
+		return m.ListSnapshotsAllFunc(p)
+	}
+	r1 = notMocked("SnapshotService.ListSnapshotsAllWithContext")
+	return
+}
+
+// NewListVMSnapshotParams records the call and calls NewListVMSnapshotParamsFunc
+func (m *MockSnapshotService) NewListVMSnapshotParams() (r0 *cloudstack.ListVMSnapshotParams) {
+	m.record("NewListVMSnapshotParams")
+	if m.NewListVMSnapshotParamsFunc != nil {
+		return m.NewListVMSnapshotParamsFunc()
+	}
+	return cloudstack.NewSnapshotService(nil).NewListVMSnapshotParams()
+}
+
+// GetVMSnapshotID records the call and calls GetVMSnapshotIDFunc, or GetVMSnapshotIDWithContextFunc if only that is set
+func (m *MockSnapshotService) GetVMSnapshotID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVMSnapshotID", name, opts)
+	if m.GetVMSnapshotIDFunc != nil {
+		return m.GetVMSnapshotIDFunc(name, opts...)
+	}
+	if m.GetVMSnapshotIDWithContextFunc != nil {
+		return m.GetVMSnapshotIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("SnapshotService.GetVMSnapshotID")
+	return
+}
+
+// GetVMSnapshotIDWithContext records the call and calls GetVMSnapshotIDWithContextFunc, or GetVMSnapshotIDFunc if only that is set
+func (m *MockSnapshotService) GetVMSnapshotIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVMSnapshotIDWithContext", ctx, name, opts)
+	if m.GetVMSnapshotIDWithContextFunc != nil {
+		return m.GetVMSnapshotIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetVMSnapshotIDFunc != nil {
+		return m.GetVMSnapshotIDFunc(name, opts...)
+	}
+	r2 = notMocked("SnapshotService.GetVMSnapshotIDWithContext")
+	return
+}
+
+// ListVMSnapshot records the call and calls ListVMSnapshotFunc, or ListVMSnapshotWithContextFunc if only that is set
+func (m *MockSnapshotService) ListVMSnapshot(p *cloudstack.ListVMSnapshotParams) (r0 *cloudstack.ListVMSnapshotResponse, r1 error) {
+	m.record("ListVMSnapshot", p)
+	if m.ListVMSnapshotFunc != nil {
+		return m.ListVMSnapshotFunc(p)
+	}
+	if m.ListVMSnapshotWithContextFunc != nil {
+		return m.ListVMSnapshotWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.ListVMSnapshot")
+	return
+}
+
+// ListVMSnapshotWithContext records the call and calls ListVMSnapshotWithContextFunc, or ListVMSnapshotFunc if only that is set
+func (m *MockSnapshotService) ListVMSnapshotWithContext(ctx context.Context, p *cloudstack.ListVMSnapshotParams) (r0 *cloudstack.ListVMSnapshotResponse, r1 error) {
+	m.record("ListVMSnapshotWithContext", ctx, p)
+	if m.ListVMSnapshotWithContextFunc != nil {
+		return m.ListVMSnapshotWithContextFunc(ctx, p)
+	}
+	if m.ListVMSnapshotFunc != nil {
+		return m.ListVMSnapshotFunc(p)
+	}
+	r1 = notMocked("SnapshotService.ListVMSnapshotWithContext")
+	return
+}
+
+// NewListVMSnapshotPager records the call and calls NewListVMSnapshotPagerFunc
+func (m *MockSnapshotService) NewListVMSnapshotPager(p *cloudstack.ListVMSnapshotParams) (r0 *cloudstack.ListVMSnapshotPager) {
+	m.record("NewListVMSnapshotPager", p)
+	if m.NewListVMSnapshotPagerFunc != nil {
+		return m.NewListVMSnapshotPagerFunc(p)
+	}
+	return
+}
+
+// ListVMSnapshotAll records the call and calls ListVMSnapshotAllFunc, or ListVMSnapshotAllWithContextFunc if only that is set
+func (m *MockSnapshotService) ListVMSnapshotAll(p *cloudstack.ListVMSnapshotParams) (r0 *cloudstack.ListVMSnapshotResponse, r1 error) {
+	m.record("ListVMSnapshotAll", p)
+	if m.ListVMSnapshotAllFunc != nil {
+		return m.ListVMSnapshotAllFunc(p)
+	}
+	if m.ListVMSnapshotAllWithContextFunc != nil {
+		return m.ListVMSnapshotAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.ListVMSnapshotAll")
+	return
+}
+
+// ListVMSnapshotAllWithContext records the call and calls ListVMSnapshotAllWithContextFunc, or ListVMSnapshotAllFunc if only that is set
+func (m *MockSnapshotService) ListVMSnapshotAllWithContext(ctx context.Context, p *cloudstack.ListVMSnapshotParams) (r0 *cloudstack.ListVMSnapshotResponse, r1 error) {
+	m.record("ListVMSnapshotAllWithContext", ctx, p)
+	if m.ListVMSnapshotAllWithContextFunc != nil {
+		return m.ListVMSnapshotAllWithContextFunc(ctx, p)
+	}
+	if m.ListVMSnapshotAllFunc != nil {
+		return m.ListVMSnapshotAllFunc(p)
+	}
+	r1 = notMocked("SnapshotService.ListVMSnapshotAllWithContext")
+	return
+}
+
+// NewRevertSnapshotParams records the call and calls NewRevertSnapshotParamsFunc
+func (m *MockSnapshotService) NewRevertSnapshotParams(id string) (r0 *cloudstack.RevertSnapshotParams) {
+	m.record("NewRevertSnapshotParams", id)
+	if m.NewRevertSnapshotParamsFunc != nil {
+		return m.NewRevertSnapshotParamsFunc(id)
+	}
+	return cloudstack.NewSnapshotService(nil).NewRevertSnapshotParams(id)
+}
+
+// RevertSnapshot records the call and calls RevertSnapshotFunc, or RevertSnapshotWithContextFunc if only that is set
+func (m *MockSnapshotService) RevertSnapshot(p *cloudstack.RevertSnapshotParams) (r0 *cloudstack.RevertSnapshotResponse, r1 error) {
+	m.record("RevertSnapshot", p)
+	if m.RevertSnapshotFunc != nil {
+		return m.RevertSnapshotFunc(p)
+	}
+	if m.RevertSnapshotWithContextFunc != nil {
+		return m.RevertSnapshotWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.RevertSnapshot")
+	return
+}
+
+// RevertSnapshotWithContext records the call and calls RevertSnapshotWithContextFunc, or RevertSnapshotFunc if only that is set
+func (m *MockSnapshotService) RevertSnapshotWithContext(ctx context.Context, p *cloudstack.RevertSnapshotParams) (r0 *cloudstack.RevertSnapshotResponse, r1 error) {
+	m.record("RevertSnapshotWithContext", ctx, p)
+	if m.RevertSnapshotWithContextFunc != nil {
+		return m.RevertSnapshotWithContextFunc(ctx, p)
+	}
+	if m.RevertSnapshotFunc != nil {
+		return m.RevertSnapshotFunc(p)
+	}
+	r1 = notMocked("SnapshotService.RevertSnapshotWithContext")
+	return
+}
+
+// RevertSnapshotAsync records the call and calls RevertSnapshotAsyncFunc, or RevertSnapshotAsyncWithContextFunc if only that is set
+func (m *MockSnapshotService) RevertSnapshotAsync(p *cloudstack.RevertSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RevertSnapshotAsync", p)
+	if m.RevertSnapshotAsyncFunc != nil {
+		return m.RevertSnapshotAsyncFunc(p)
+	}
+	if m.RevertSnapshotAsyncWithContextFunc != nil {
+		return m.RevertSnapshotAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.RevertSnapshotAsync")
+	return
+}
+
+// RevertSnapshotAsyncWithContext records the call and calls RevertSnapshotAsyncWithContextFunc, or RevertSnapshotAsyncFunc if only that is set
+func (m *MockSnapshotService) RevertSnapshotAsyncWithContext(ctx context.Context, p *cloudstack.RevertSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RevertSnapshotAsyncWithContext", ctx, p)
+	if m.RevertSnapshotAsyncWithContextFunc != nil {
+		return m.RevertSnapshotAsyncWithContextFunc(ctx, p)
+	}
+	if m.RevertSnapshotAsyncFunc != nil {
+		return m.RevertSnapshotAsyncFunc(p)
+	}
+	r1 = notMocked("SnapshotService.RevertSnapshotAsyncWithContext")
+	return
+}
+
+// NewRevertToVMSnapshotParams records the call and calls NewRevertToVMSnapshotParamsFunc
+func (m *MockSnapshotService) NewRevertToVMSnapshotParams(vmsnapshotid string) (r0 *cloudstack.RevertToVMSnapshotParams) {
+	m.record("NewRevertToVMSnapshotParams", vmsnapshotid)
+	if m.NewRevertToVMSnapshotParamsFunc != nil {
+		return m.NewRevertToVMSnapshotParamsFunc(vmsnapshotid)
+	}
+	return cloudstack.NewSnapshotService(nil).NewRevertToVMSnapshotParams(vmsnapshotid)
+}
+
+// RevertToVMSnapshot records the call and calls RevertToVMSnapshotFunc, or RevertToVMSnapshotWithContextFunc if only that is set
+func (m *MockSnapshotService) RevertToVMSnapshot(p *cloudstack.RevertToVMSnapshotParams) (r0 *cloudstack.RevertToVMSnapshotResponse, r1 error) {
+	m.record("RevertToVMSnapshot", p)
+	if m.RevertToVMSnapshotFunc != nil {
+		return m.RevertToVMSnapshotFunc(p)
+	}
+	if m.RevertToVMSnapshotWithContextFunc != nil {
+		return m.RevertToVMSnapshotWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.RevertToVMSnapshot")
+	return
+}
+
+// RevertToVMSnapshotWithContext records the call and calls RevertToVMSnapshotWithContextFunc, or RevertToVMSnapshotFunc if only that is set
+func (m *MockSnapshotService) RevertToVMSnapshotWithContext(ctx context.Context, p *cloudstack.RevertToVMSnapshotParams) (r0 *cloudstack.RevertToVMSnapshotResponse, r1 error) {
+	m.record("RevertToVMSnapshotWithContext", ctx, p)
+	if m.RevertToVMSnapshotWithContextFunc != nil {
+		return m.RevertToVMSnapshotWithContextFunc(ctx, p)
+	}
+	if m.RevertToVMSnapshotFunc != nil {
+		return m.RevertToVMSnapshotFunc(p)
+	}
+	r1 = notMocked("SnapshotService.RevertToVMSnapshotWithContext")
+	return
+}
+
+// RevertToVMSnapshotAsync records the call and calls RevertToVMSnapshotAsyncFunc, or RevertToVMSnapshotAsyncWithContextFunc if only that is set
+func (m *MockSnapshotService) RevertToVMSnapshotAsync(p *cloudstack.RevertToVMSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RevertToVMSnapshotAsync", p)
+	if m.RevertToVMSnapshotAsyncFunc != nil {
+		return m.RevertToVMSnapshotAsyncFunc(p)
+	}
+	if m.RevertToVMSnapshotAsyncWithContextFunc != nil {
+		return m.RevertToVMSnapshotAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.RevertToVMSnapshotAsync")
+	return
+}
+
+// RevertToVMSnapshotAsyncWithContext records the call and calls RevertToVMSnapshotAsyncWithContextFunc, or RevertToVMSnapshotAsyncFunc if only that is set
+func (m *MockSnapshotService) RevertToVMSnapshotAsyncWithContext(ctx context.Context, p *cloudstack.RevertToVMSnapshotParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RevertToVMSnapshotAsyncWithContext", ctx, p)
+	if m.RevertToVMSnapshotAsyncWithContextFunc != nil {
+		return m.RevertToVMSnapshotAsyncWithContextFunc(ctx, p)
+	}
+	if m.RevertToVMSnapshotAsyncFunc != nil {
+		return m.RevertToVMSnapshotAsyncFunc(p)
+	}
+	r1 = notMocked("SnapshotService.RevertToVMSnapshotAsyncWithContext")
+	return
+}
+
+// NewUpdateSnapshotPolicyParams records the call and calls NewUpdateSnapshotPolicyParamsFunc
+func (m *MockSnapshotService) NewUpdateSnapshotPolicyParams() (r0 *cloudstack.UpdateSnapshotPolicyParams) {
+	m.record("NewUpdateSnapshotPolicyParams")
+	if m.NewUpdateSnapshotPolicyParamsFunc != nil {
+		return m.NewUpdateSnapshotPolicyParamsFunc()
+	}
+	return cloudstack.NewSnapshotService(nil).NewUpdateSnapshotPolicyParams()
+}
+
+// UpdateSnapshotPolicy records the call and calls UpdateSnapshotPolicyFunc, or UpdateSnapshotPolicyWithContextFunc if only that is set
+func (m *MockSnapshotService) UpdateSnapshotPolicy(p *cloudstack.UpdateSnapshotPolicyParams) (r0 *cloudstack.UpdateSnapshotPolicyResponse, r1 error) {
+	m.record("UpdateSnapshotPolicy", p)
+	if m.UpdateSnapshotPolicyFunc != nil {
+		return m.UpdateSnapshotPolicyFunc(p)
+	}
+	if m.UpdateSnapshotPolicyWithContextFunc != nil {
+		return m.UpdateSnapshotPolicyWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.UpdateSnapshotPolicy")
+	return
+}
+
+// UpdateSnapshotPolicyWithContext records the call and calls UpdateSnapshotPolicyWithContextFunc, or UpdateSnapshotPolicyFunc if only that is set
+func (m *MockSnapshotService) UpdateSnapshotPolicyWithContext(ctx context.Context, p *cloudstack.UpdateSnapshotPolicyParams) (r0 *cloudstack.UpdateSnapshotPolicyResponse, r1 error) {
+	m.record("UpdateSnapshotPolicyWithContext", ctx, p)
+	if m.UpdateSnapshotPolicyWithContextFunc != nil {
+		return m.UpdateSnapshotPolicyWithContextFunc(ctx, p)
+	}
+	if m.UpdateSnapshotPolicyFunc != nil {
+		return m.UpdateSnapshotPolicyFunc(p)
+	}
+	r1 = notMocked("SnapshotService.UpdateSnapshotPolicyWithContext")
+	return
+}
+
+// UpdateSnapshotPolicyAsync records the call and calls UpdateSnapshotPolicyAsyncFunc, or UpdateSnapshotPolicyAsyncWithContextFunc if only that is set
+func (m *MockSnapshotService) UpdateSnapshotPolicyAsync(p *cloudstack.UpdateSnapshotPolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateSnapshotPolicyAsync", p)
+	if m.UpdateSnapshotPolicyAsyncFunc != nil {
+		return m.UpdateSnapshotPolicyAsyncFunc(p)
+	}
+	if m.UpdateSnapshotPolicyAsyncWithContextFunc != nil {
+		return m.UpdateSnapshotPolicyAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SnapshotService.UpdateSnapshotPolicyAsync")
+	return
+}
+
+// UpdateSnapshotPolicyAsyncWithContext records the call and calls UpdateSnapshotPolicyAsyncWithContextFunc, or UpdateSnapshotPolicyAsyncFunc if only that is set
+func (m *MockSnapshotService) UpdateSnapshotPolicyAsyncWithContext(ctx context.Context, p *cloudstack.UpdateSnapshotPolicyParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateSnapshotPolicyAsyncWithContext", ctx, p)
+	if m.UpdateSnapshotPolicyAsyncWithContextFunc != nil {
+		return m.UpdateSnapshotPolicyAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateSnapshotPolicyAsyncFunc != nil {
+		return m.UpdateSnapshotPolicyAsyncFunc(p)
+	}
+	r1 = notMocked("SnapshotService.UpdateSnapshotPolicyAsyncWithContext")
+	return
+}
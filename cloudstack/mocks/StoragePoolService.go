@@ -0,0 +1,247 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockStoragePoolService is a mock of cloudstack.StoragePoolServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockStoragePoolService struct {
+	Recorder
+
+	NewCancelStorageMaintenanceParamsFunc        func(id string) *cloudstack.CancelStorageMaintenanceParams
+	CancelStorageMaintenanceFunc                 func(p *cloudstack.CancelStorageMaintenanceParams) (*cloudstack.CancelStorageMaintenanceResponse, error)
+	CancelStorageMaintenanceWithContextFunc      func(ctx context.Context, p *cloudstack.CancelStorageMaintenanceParams) (*cloudstack.CancelStorageMaintenanceResponse, error)
+	CancelStorageMaintenanceAsyncFunc            func(p *cloudstack.CancelStorageMaintenanceParams) (*cloudstack.Job, error)
+	CancelStorageMaintenanceAsyncWithContextFunc func(ctx context.Context, p *cloudstack.CancelStorageMaintenanceParams) (*cloudstack.Job, error)
+	NewEnableStorageMaintenanceParamsFunc        func(id string) *cloudstack.EnableStorageMaintenanceParams
+	EnableStorageMaintenanceFunc                 func(p *cloudstack.EnableStorageMaintenanceParams) (*cloudstack.EnableStorageMaintenanceResponse, error)
+	EnableStorageMaintenanceWithContextFunc      func(ctx context.Context, p *cloudstack.EnableStorageMaintenanceParams) (*cloudstack.EnableStorageMaintenanceResponse, error)
+	EnableStorageMaintenanceAsyncFunc            func(p *cloudstack.EnableStorageMaintenanceParams) (*cloudstack.Job, error)
+	EnableStorageMaintenanceAsyncWithContextFunc func(ctx context.Context, p *cloudstack.EnableStorageMaintenanceParams) (*cloudstack.Job, error)
+	NewListStorageProvidersParamsFunc            func(storagePoolType string) *cloudstack.ListStorageProvidersParams
+	ListStorageProvidersFunc                     func(p *cloudstack.ListStorageProvidersParams) (*cloudstack.ListStorageProvidersResponse, error)
+	ListStorageProvidersWithContextFunc          func(ctx context.Context, p *cloudstack.ListStorageProvidersParams) (*cloudstack.ListStorageProvidersResponse, error)
+	NewListStorageProvidersPagerFunc             func(p *cloudstack.ListStorageProvidersParams) *cloudstack.ListStorageProvidersPager
+	ListStorageProvidersAllFunc                  func(p *cloudstack.ListStorageProvidersParams) (*cloudstack.ListStorageProvidersResponse, error)
+	ListStorageProvidersAllWithContextFunc       func(ctx context.Context, p *cloudstack.ListStorageProvidersParams) (*cloudstack.ListStorageProvidersResponse, error)
+}
+
+var _ cloudstack.StoragePoolServiceIface = (*MockStoragePoolService)(nil)
+
+// NewCancelStorageMaintenanceParams records the call and calls NewCancelStorageMaintenanceParamsFunc
+func (m *MockStoragePoolService) NewCancelStorageMaintenanceParams(id string) (r0 *cloudstack.CancelStorageMaintenanceParams) {
+	m.record("NewCancelStorageMaintenanceParams", id)
+	if m.NewCancelStorageMaintenanceParamsFunc != nil {
+		return m.NewCancelStorageMaintenanceParamsFunc(id)
+	}
+	return cloudstack.NewStoragePoolService(nil).NewCancelStorageMaintenanceParams(id)
+}
+
+// CancelStorageMaintenance records the call and calls CancelStorageMaintenanceFunc, or CancelStorageMaintenanceWithContextFunc if only that is set
+func (m *MockStoragePoolService) CancelStorageMaintenance(p *cloudstack.CancelStorageMaintenanceParams) (r0 *cloudstack.CancelStorageMaintenanceResponse, r1 error) {
+	m.record("CancelStorageMaintenance", p)
+	if m.CancelStorageMaintenanceFunc != nil {
+		return m.CancelStorageMaintenanceFunc(p)
+	}
+	if m.CancelStorageMaintenanceWithContextFunc != nil {
+		return m.CancelStorageMaintenanceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("StoragePoolService.CancelStorageMaintenance")
+	return
+}
+
+// CancelStorageMaintenanceWithContext records the call and calls CancelStorageMaintenanceWithContextFunc, or CancelStorageMaintenanceFunc if only that is set
+func (m *MockStoragePoolService) CancelStorageMaintenanceWithContext(ctx context.Context, p *cloudstack.CancelStorageMaintenanceParams) (r0 *cloudstack.CancelStorageMaintenanceResponse, r1 error) {
+	m.record("CancelStorageMaintenanceWithContext", ctx, p)
+	if m.CancelStorageMaintenanceWithContextFunc != nil {
+		return m.CancelStorageMaintenanceWithContextFunc(ctx, p)
+	}
+	if m.CancelStorageMaintenanceFunc != nil {
+		return m.CancelStorageMaintenanceFunc(p)
+	}
+	r1 = notMocked("StoragePoolService.CancelStorageMaintenanceWithContext")
+	return
+}
+
+// CancelStorageMaintenanceAsync records the call and calls CancelStorageMaintenanceAsyncFunc, or CancelStorageMaintenanceAsyncWithContextFunc if only that is set
+func (m *MockStoragePoolService) CancelStorageMaintenanceAsync(p *cloudstack.CancelStorageMaintenanceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CancelStorageMaintenanceAsync", p)
+	if m.CancelStorageMaintenanceAsyncFunc != nil {
+		return m.CancelStorageMaintenanceAsyncFunc(p)
+	}
+	if m.CancelStorageMaintenanceAsyncWithContextFunc != nil {
+		return m.CancelStorageMaintenanceAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("StoragePoolService.CancelStorageMaintenanceAsync")
+	return
+}
+
+// CancelStorageMaintenanceAsyncWithContext records the call and calls CancelStorageMaintenanceAsyncWithContextFunc, or CancelStorageMaintenanceAsyncFunc if only that is set
+func (m *MockStoragePoolService) CancelStorageMaintenanceAsyncWithContext(ctx context.Context, p *cloudstack.CancelStorageMaintenanceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CancelStorageMaintenanceAsyncWithContext", ctx, p)
+	if m.CancelStorageMaintenanceAsyncWithContextFunc != nil {
+		return m.CancelStorageMaintenanceAsyncWithContextFunc(ctx, p)
+	}
+	if m.CancelStorageMaintenanceAsyncFunc != nil {
+		return m.CancelStorageMaintenanceAsyncFunc(p)
+	}
+	r1 = notMocked("StoragePoolService.CancelStorageMaintenanceAsyncWithContext")
+	return
+}
+
+// NewEnableStorageMaintenanceParams records the call and calls NewEnableStorageMaintenanceParamsFunc
+func (m *MockStoragePoolService) NewEnableStorageMaintenanceParams(id string) (r0 *cloudstack.EnableStorageMaintenanceParams) {
+	m.record("NewEnableStorageMaintenanceParams", id)
+	if m.NewEnableStorageMaintenanceParamsFunc != nil {
+		return m.NewEnableStorageMaintenanceParamsFunc(id)
+	}
+	return cloudstack.NewStoragePoolService(nil).NewEnableStorageMaintenanceParams(id)
+}
+
+// EnableStorageMaintenance records the call and calls EnableStorageMaintenanceFunc, or EnableStorageMaintenanceWithContextFunc if only that is set
+func (m *MockStoragePoolService) EnableStorageMaintenance(p *cloudstack.EnableStorageMaintenanceParams) (r0 *cloudstack.EnableStorageMaintenanceResponse, r1 error) {
+	m.record("EnableStorageMaintenance", p)
+	if m.EnableStorageMaintenanceFunc != nil {
+		return m.EnableStorageMaintenanceFunc(p)
+	}
+	if m.EnableStorageMaintenanceWithContextFunc != nil {
+		return m.EnableStorageMaintenanceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("StoragePoolService.EnableStorageMaintenance")
+	return
+}
+
+// EnableStorageMaintenanceWithContext records the call and calls EnableStorageMaintenanceWithContextFunc, or EnableStorageMaintenanceFunc if only that is set
+func (m *MockStoragePoolService) EnableStorageMaintenanceWithContext(ctx context.Context, p *cloudstack.EnableStorageMaintenanceParams) (r0 *cloudstack.EnableStorageMaintenanceResponse, r1 error) {
+	m.record("EnableStorageMaintenanceWithContext", ctx, p)
+	if m.EnableStorageMaintenanceWithContextFunc != nil {
+		return m.EnableStorageMaintenanceWithContextFunc(ctx, p)
+	}
+	if m.EnableStorageMaintenanceFunc != nil {
+		return m.EnableStorageMaintenanceFunc(p)
+	}
+	r1 = notMocked("StoragePoolService.EnableStorageMaintenanceWithContext")
+	return
+}
+
+// EnableStorageMaintenanceAsync records the call and calls EnableStorageMaintenanceAsyncFunc, or EnableStorageMaintenanceAsyncWithContextFunc if only that is set
+func (m *MockStoragePoolService) EnableStorageMaintenanceAsync(p *cloudstack.EnableStorageMaintenanceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableStorageMaintenanceAsync", p)
+	if m.EnableStorageMaintenanceAsyncFunc != nil {
+		return m.EnableStorageMaintenanceAsyncFunc(p)
+	}
+	if m.EnableStorageMaintenanceAsyncWithContextFunc != nil {
+		return m.EnableStorageMaintenanceAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("StoragePoolService.EnableStorageMaintenanceAsync")
+	return
+}
+
+// EnableStorageMaintenanceAsyncWithContext records the call and calls EnableStorageMaintenanceAsyncWithContextFunc, or EnableStorageMaintenanceAsyncFunc if only that is set
+func (m *MockStoragePoolService) EnableStorageMaintenanceAsyncWithContext(ctx context.Context, p *cloudstack.EnableStorageMaintenanceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableStorageMaintenanceAsyncWithContext", ctx, p)
+	if m.EnableStorageMaintenanceAsyncWithContextFunc != nil {
+		return m.EnableStorageMaintenanceAsyncWithContextFunc(ctx, p)
+	}
+	if m.EnableStorageMaintenanceAsyncFunc != nil {
+		return m.EnableStorageMaintenanceAsyncFunc(p)
+	}
+	r1 = notMocked("StoragePoolService.EnableStorageMaintenanceAsyncWithContext")
+	return
+}
+
+// NewListStorageProvidersParams records the call and calls NewListStorageProvidersParamsFunc
+func (m *MockStoragePoolService) NewListStorageProvidersParams(storagePoolType string) (r0 *cloudstack.ListStorageProvidersParams) {
+	m.record("NewListStorageProvidersParams", storagePoolType)
+	if m.NewListStorageProvidersParamsFunc != nil {
+		return m.NewListStorageProvidersParamsFunc(storagePoolType)
+	}
+	return cloudstack.NewStoragePoolService(nil).NewListStorageProvidersParams(storagePoolType)
+}
+
+// ListStorageProviders records the call and calls ListStorageProvidersFunc, or ListStorageProvidersWithContextFunc if only that is set
+func (m *MockStoragePoolService) ListStorageProviders(p *cloudstack.ListStorageProvidersParams) (r0 *cloudstack.ListStorageProvidersResponse, r1 error) {
+	m.record("ListStorageProviders", p)
+	if m.ListStorageProvidersFunc != nil {
+		return m.ListStorageProvidersFunc(p)
+	}
+	if m.ListStorageProvidersWithContextFunc != nil {
+		return m.ListStorageProvidersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("StoragePoolService.ListStorageProviders")
+	return
+}
+
+// ListStorageProvidersWithContext records the call and calls ListStorageProvidersWithContextFunc, or ListStorageProvidersFunc if only that is set
+func (m *MockStoragePoolService) ListStorageProvidersWithContext(ctx context.Context, p *cloudstack.ListStorageProvidersParams) (r0 *cloudstack.ListStorageProvidersResponse, r1 error) {
+	m.record("ListStorageProvidersWithContext", ctx, p)
+	if m.ListStorageProvidersWithContextFunc != nil {
+		return m.ListStorageProvidersWithContextFunc(ctx, p)
+	}
+	if m.ListStorageProvidersFunc != nil {
+		return m.ListStorageProvidersFunc(p)
+	}
+	r1 = notMocked("StoragePoolService.ListStorageProvidersWithContext")
+	return
+}
+
+// NewListStorageProvidersPager records the call and calls NewListStorageProvidersPagerFunc
+func (m *MockStoragePoolService) NewListStorageProvidersPager(p *cloudstack.ListStorageProvidersParams) (r0 *cloudstack.ListStorageProvidersPager) {
+	m.record("NewListStorageProvidersPager", p)
+	if m.NewListStorageProvidersPagerFunc != nil {
+		return m.NewListStorageProvidersPagerFunc(p)
+	}
+	return
+}
+
+// ListStorageProvidersAll records the call and calls ListStorageProvidersAllFunc, or ListStorageProvidersAllWithContextFunc if only that is set
+func (m *MockStoragePoolService) ListStorageProvidersAll(p *cloudstack.ListStorageProvidersParams) (r0 *cloudstack.ListStorageProvidersResponse, r1 error) {
+	m.record("ListStorageProvidersAll", p)
+	if m.ListStorageProvidersAllFunc != nil {
+		return m.ListStorageProvidersAllFunc(p)
+	}
+	if m.ListStorageProvidersAllWithContextFunc != nil {
+		return m.ListStorageProvidersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("StoragePoolService.ListStorageProvidersAll")
+	return
+}
+
+// ListStorageProvidersAllWithContext records the call and calls ListStorageProvidersAllWithContextFunc, or ListStorageProvidersAllFunc if only that is set
+func (m *MockStoragePoolService) ListStorageProvidersAllWithContext(ctx context.Context, p *cloudstack.ListStorageProvidersParams) (r0 *cloudstack.ListStorageProvidersResponse, r1 error) {
+	m.record("ListStorageProvidersAllWithContext", ctx, p)
+	if m.ListStorageProvidersAllWithContextFunc != nil {
+		return m.ListStorageProvidersAllWithContextFunc(ctx, p)
+	}
+	if m.ListStorageProvidersAllFunc != nil {
+		return m.ListStorageProvidersAllFunc(p)
+	}
+	r1 = notMocked("StoragePoolService.ListStorageProvidersAllWithContext")
+	return
+}
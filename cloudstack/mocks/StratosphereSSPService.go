@@ -0,0 +1,115 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockStratosphereSSPService is a mock of cloudstack.StratosphereSSPServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockStratosphereSSPService struct {
+	Recorder
+
+	NewAddStratosphereSspParamsFunc      func(name string, url string, zoneid string) *cloudstack.AddStratosphereSspParams
+	AddStratosphereSspFunc               func(p *cloudstack.AddStratosphereSspParams) (*cloudstack.AddStratosphereSspResponse, error)
+	AddStratosphereSspWithContextFunc    func(ctx context.Context, p *cloudstack.AddStratosphereSspParams) (*cloudstack.AddStratosphereSspResponse, error)
+	NewDeleteStratosphereSspParamsFunc   func(hostid string) *cloudstack.DeleteStratosphereSspParams
+	DeleteStratosphereSspFunc            func(p *cloudstack.DeleteStratosphereSspParams) (*cloudstack.DeleteStratosphereSspResponse, error)
+	DeleteStratosphereSspWithContextFunc func(ctx context.Context, p *cloudstack.DeleteStratosphereSspParams) (*cloudstack.DeleteStratosphereSspResponse, error)
+}
+
+var _ cloudstack.StratosphereSSPServiceIface = (*MockStratosphereSSPService)(nil)
+
+// NewAddStratosphereSspParams records the call and calls NewAddStratosphereSspParamsFunc
+func (m *MockStratosphereSSPService) NewAddStratosphereSspParams(name string, url string, zoneid string) (r0 *cloudstack.AddStratosphereSspParams) {
+	m.record("NewAddStratosphereSspParams", name, url, zoneid)
+	if m.NewAddStratosphereSspParamsFunc != nil {
+		return m.NewAddStratosphereSspParamsFunc(name, url, zoneid)
+	}
+	return cloudstack.NewStratosphereSSPService(nil).NewAddStratosphereSspParams(name, url, zoneid)
+}
+
+// AddStratosphereSsp records the call and calls AddStratosphereSspFunc, or AddStratosphereSspWithContextFunc if only that is set
+func (m *MockStratosphereSSPService) AddStratosphereSsp(p *cloudstack.AddStratosphereSspParams) (r0 *cloudstack.AddStratosphereSspResponse, r1 error) {
+	m.record("AddStratosphereSsp", p)
+	if m.AddStratosphereSspFunc != nil {
+		return m.AddStratosphereSspFunc(p)
+	}
+	if m.AddStratosphereSspWithContextFunc != nil {
+		return m.AddStratosphereSspWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("StratosphereSSPService.AddStratosphereSsp")
+	return
+}
+
+// AddStratosphereSspWithContext records the call and calls AddStratosphereSspWithContextFunc, or AddStratosphereSspFunc if only that is set
+func (m *MockStratosphereSSPService) AddStratosphereSspWithContext(ctx context.Context, p *cloudstack.AddStratosphereSspParams) (r0 *cloudstack.AddStratosphereSspResponse, r1 error) {
+	m.record("AddStratosphereSspWithContext", ctx, p)
+	if m.AddStratosphereSspWithContextFunc != nil {
+		return m.AddStratosphereSspWithContextFunc(ctx, p)
+	}
+	if m.AddStratosphereSspFunc != nil {
+		return m.AddStratosphereSspFunc(p)
+	}
+	r1 = notMocked("StratosphereSSPService.AddStratosphereSspWithContext")
+	return
+}
+
+// NewDeleteStratosphereSspParams records the call and calls NewDeleteStratosphereSspParamsFunc
+func (m *MockStratosphereSSPService) NewDeleteStratosphereSspParams(hostid string) (r0 *cloudstack.DeleteStratosphereSspParams) {
+	m.record("NewDeleteStratosphereSspParams", hostid)
+	if m.NewDeleteStratosphereSspParamsFunc != nil {
+		return m.NewDeleteStratosphereSspParamsFunc(hostid)
+	}
+	return cloudstack.NewStratosphereSSPService(nil).NewDeleteStratosphereSspParams(hostid)
+}
+
+// DeleteStratosphereSsp records the call and calls DeleteStratosphereSspFunc, or DeleteStratosphereSspWithContextFunc if only that is set
+func (m *MockStratosphereSSPService) DeleteStratosphereSsp(p *cloudstack.DeleteStratosphereSspParams) (r0 *cloudstack.DeleteStratosphereSspResponse, r1 error) {
+	m.record("DeleteStratosphereSsp", p)
+	if m.DeleteStratosphereSspFunc != nil {
+		return m.DeleteStratosphereSspFunc(p)
+	}
+	if m.DeleteStratosphereSspWithContextFunc != nil {
+		return m.DeleteStratosphereSspWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("StratosphereSSPService.DeleteStratosphereSsp")
+	return
+}
+
+// DeleteStratosphereSspWithContext records the call and calls DeleteStratosphereSspWithContextFunc, or DeleteStratosphereSspFunc if only that is set
+func (m *MockStratosphereSSPService) DeleteStratosphereSspWithContext(ctx context.Context, p *cloudstack.DeleteStratosphereSspParams) (r0 *cloudstack.DeleteStratosphereSspResponse, r1 error) {
+	m.record("DeleteStratosphereSspWithContext", ctx, p)
+	if m.DeleteStratosphereSspWithContextFunc != nil {
+		return m.DeleteStratosphereSspWithContextFunc(ctx, p)
+	}
+	if m.DeleteStratosphereSspFunc != nil {
+		return m.DeleteStratosphereSspFunc(p)
+	}
+	r1 = notMocked("StratosphereSSPService.DeleteStratosphereSspWithContext")
+	return
+}
@@ -0,0 +1,181 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockSwiftService is a mock of cloudstack.SwiftServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockSwiftService struct {
+	Recorder
+
+	NewAddSwiftParamsFunc        func(url string) *cloudstack.AddSwiftParams
+	AddSwiftFunc                 func(p *cloudstack.AddSwiftParams) (*cloudstack.AddSwiftResponse, error)
+	AddSwiftWithContextFunc      func(ctx context.Context, p *cloudstack.AddSwiftParams) (*cloudstack.AddSwiftResponse, error)
+	NewListSwiftsParamsFunc      func() *cloudstack.ListSwiftsParams
+	GetSwiftIDFunc               func(keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetSwiftIDWithContextFunc    func(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListSwiftsFunc               func(p *cloudstack.ListSwiftsParams) (*cloudstack.ListSwiftsResponse, error)
+	ListSwiftsWithContextFunc    func(ctx context.Context, p *cloudstack.ListSwiftsParams) (*cloudstack.ListSwiftsResponse, error)
+	NewListSwiftsPagerFunc       func(p *cloudstack.ListSwiftsParams) *cloudstack.ListSwiftsPager
+	ListSwiftsAllFunc            func(p *cloudstack.ListSwiftsParams) (*cloudstack.ListSwiftsResponse, error)
+	ListSwiftsAllWithContextFunc func(ctx context.Context, p *cloudstack.ListSwiftsParams) (*cloudstack.ListSwiftsResponse, error)
+}
+
+var _ cloudstack.SwiftServiceIface = (*MockSwiftService)(nil)
+
+// NewAddSwiftParams records the call and calls NewAddSwiftParamsFunc
+func (m *MockSwiftService) NewAddSwiftParams(url string) (r0 *cloudstack.AddSwiftParams) {
+	m.record("NewAddSwiftParams", url)
+	if m.NewAddSwiftParamsFunc != nil {
+		return m.NewAddSwiftParamsFunc(url)
+	}
+	return cloudstack.NewSwiftService(nil).NewAddSwiftParams(url)
+}
+
+// AddSwift records the call and calls AddSwiftFunc, or AddSwiftWithContextFunc if only that is set
+func (m *MockSwiftService) AddSwift(p *cloudstack.AddSwiftParams) (r0 *cloudstack.AddSwiftResponse, r1 error) {
+	m.record("AddSwift", p)
+	if m.AddSwiftFunc != nil {
+		return m.AddSwiftFunc(p)
+	}
+	if m.AddSwiftWithContextFunc != nil {
+		return m.AddSwiftWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SwiftService.AddSwift")
+	return
+}
+
+// AddSwiftWithContext records the call and calls AddSwiftWithContextFunc, or AddSwiftFunc if only that is set
+func (m *MockSwiftService) AddSwiftWithContext(ctx context.Context, p *cloudstack.AddSwiftParams) (r0 *cloudstack.AddSwiftResponse, r1 error) {
+	m.record("AddSwiftWithContext", ctx, p)
+	if m.AddSwiftWithContextFunc != nil {
+		return m.AddSwiftWithContextFunc(ctx, p)
+	}
+	if m.AddSwiftFunc != nil {
+		return m.AddSwiftFunc(p)
+	}
+	r1 = notMocked("SwiftService.AddSwiftWithContext")
+	return
+}
+
+// NewListSwiftsParams records the call and calls NewListSwiftsParamsFunc
+func (m *MockSwiftService) NewListSwiftsParams() (r0 *cloudstack.ListSwiftsParams) {
+	m.record("NewListSwiftsParams")
+	if m.NewListSwiftsParamsFunc != nil {
+		return m.NewListSwiftsParamsFunc()
+	}
+	return cloudstack.NewSwiftService(nil).NewListSwiftsParams()
+}
+
+// GetSwiftID records the call and calls GetSwiftIDFunc, or GetSwiftIDWithContextFunc if only that is set
+func (m *MockSwiftService) GetSwiftID(keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetSwiftID", keyword, opts)
+	if m.GetSwiftIDFunc != nil {
+		return m.GetSwiftIDFunc(keyword, opts...)
+	}
+	if m.GetSwiftIDWithContextFunc != nil {
+		return m.GetSwiftIDWithContextFunc(context.Background(), keyword, opts...)
+	}
+	r2 = notMocked("SwiftService.GetSwiftID")
+	return
+}
+
+// GetSwiftIDWithContext records the call and calls GetSwiftIDWithContextFunc, or GetSwiftIDFunc if only that is set
+func (m *MockSwiftService) GetSwiftIDWithContext(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetSwiftIDWithContext", ctx, keyword, opts)
+	if m.GetSwiftIDWithContextFunc != nil {
+		return m.GetSwiftIDWithContextFunc(ctx, keyword, opts...)
+	}
+	if m.GetSwiftIDFunc != nil {
+		return m.GetSwiftIDFunc(keyword, opts...)
+	}
+	r2 = notMocked("SwiftService.GetSwiftIDWithContext")
+	return
+}
+
+// ListSwifts records the call and calls ListSwiftsFunc, or ListSwiftsWithContextFunc if only that is set
+func (m *MockSwiftService) ListSwifts(p *cloudstack.ListSwiftsParams) (r0 *cloudstack.ListSwiftsResponse, r1 error) {
+	m.record("ListSwifts", p)
+	if m.ListSwiftsFunc != nil {
+		return m.ListSwiftsFunc(p)
+	}
+	if m.ListSwiftsWithContextFunc != nil {
+		return m.ListSwiftsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SwiftService.ListSwifts")
+	return
+}
+
+// ListSwiftsWithContext records the call and calls ListSwiftsWithContextFunc, or ListSwiftsFunc if only that is set
+func (m *MockSwiftService) ListSwiftsWithContext(ctx context.Context, p *cloudstack.ListSwiftsParams) (r0 *cloudstack.ListSwiftsResponse, r1 error) {
+	m.record("ListSwiftsWithContext", ctx, p)
+	if m.ListSwiftsWithContextFunc != nil {
+		return m.ListSwiftsWithContextFunc(ctx, p)
+	}
+	if m.ListSwiftsFunc != nil {
+		return m.ListSwiftsFunc(p)
+	}
+	r1 = notMocked("SwiftService.ListSwiftsWithContext")
+	return
+}
+
+// NewListSwiftsPager records the call and calls NewListSwiftsPagerFunc
+func (m *MockSwiftService) NewListSwiftsPager(p *cloudstack.ListSwiftsParams) (r0 *cloudstack.ListSwiftsPager) {
+	m.record("NewListSwiftsPager", p)
+	if m.NewListSwiftsPagerFunc != nil {
+		return m.NewListSwiftsPagerFunc(p)
+	}
+	return
+}
+
+// ListSwiftsAll records the call and calls ListSwiftsAllFunc, or ListSwiftsAllWithContextFunc if only that is set
+func (m *MockSwiftService) ListSwiftsAll(p *cloudstack.ListSwiftsParams) (r0 *cloudstack.ListSwiftsResponse, r1 error) {
+	m.record("ListSwiftsAll", p)
+	if m.ListSwiftsAllFunc != nil {
+		return m.ListSwiftsAllFunc(p)
+	}
+	if m.ListSwiftsAllWithContextFunc != nil {
+		return m.ListSwiftsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SwiftService.ListSwiftsAll")
+	return
+}
+
+// ListSwiftsAllWithContext records the call and calls ListSwiftsAllWithContextFunc, or ListSwiftsAllFunc if only that is set
+func (m *MockSwiftService) ListSwiftsAllWithContext(ctx context.Context, p *cloudstack.ListSwiftsParams) (r0 *cloudstack.ListSwiftsResponse, r1 error) {
+	m.record("ListSwiftsAllWithContext", ctx, p)
+	if m.ListSwiftsAllWithContextFunc != nil {
+		return m.ListSwiftsAllWithContextFunc(ctx, p)
+	}
+	if m.ListSwiftsAllFunc != nil {
+		return m.ListSwiftsAllFunc(p)
+	}
+	r1 = notMocked("SwiftService.ListSwiftsAllWithContext")
+	return
+}
@@ -0,0 +1,115 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockSystemCapacityService is a mock of cloudstack.SystemCapacityServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockSystemCapacityService struct {
+	Recorder
+
+	NewListCapacityParamsFunc      func() *cloudstack.ListCapacityParams
+	ListCapacityFunc               func(p *cloudstack.ListCapacityParams) (*cloudstack.ListCapacityResponse, error)
+	ListCapacityWithContextFunc    func(ctx context.Context, p *cloudstack.ListCapacityParams) (*cloudstack.ListCapacityResponse, error)
+	NewListCapacityPagerFunc       func(p *cloudstack.ListCapacityParams) *cloudstack.ListCapacityPager
+	ListCapacityAllFunc            func(p *cloudstack.ListCapacityParams) (*cloudstack.ListCapacityResponse, error)
+	ListCapacityAllWithContextFunc func(ctx context.Context, p *cloudstack.ListCapacityParams) (*cloudstack.ListCapacityResponse, error)
+}
+
+var _ cloudstack.SystemCapacityServiceIface = (*MockSystemCapacityService)(nil)
+
+// NewListCapacityParams records the call and calls NewListCapacityParamsFunc
+func (m *MockSystemCapacityService) NewListCapacityParams() (r0 *cloudstack.ListCapacityParams) {
+	m.record("NewListCapacityParams")
+	if m.NewListCapacityParamsFunc != nil {
+		return m.NewListCapacityParamsFunc()
+	}
+	return cloudstack.NewSystemCapacityService(nil).NewListCapacityParams()
+}
+
+// ListCapacity records the call and calls ListCapacityFunc, or ListCapacityWithContextFunc if only that is set
+func (m *MockSystemCapacityService) ListCapacity(p *cloudstack.ListCapacityParams) (r0 *cloudstack.ListCapacityResponse, r1 error) {
+	m.record("ListCapacity", p)
+	if m.ListCapacityFunc != nil {
+		return m.ListCapacityFunc(p)
+	}
+	if m.ListCapacityWithContextFunc != nil {
+		return m.ListCapacityWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemCapacityService.ListCapacity")
+	return
+}
+
+// ListCapacityWithContext records the call and calls ListCapacityWithContextFunc, or ListCapacityFunc if only that is set
+func (m *MockSystemCapacityService) ListCapacityWithContext(ctx context.Context, p *cloudstack.ListCapacityParams) (r0 *cloudstack.ListCapacityResponse, r1 error) {
+	m.record("ListCapacityWithContext", ctx, p)
+	if m.ListCapacityWithContextFunc != nil {
+		return m.ListCapacityWithContextFunc(ctx, p)
+	}
+	if m.ListCapacityFunc != nil {
+		return m.ListCapacityFunc(p)
+	}
+	r1 = notMocked("SystemCapacityService.ListCapacityWithContext")
+	return
+}
+
+// NewListCapacityPager records the call and calls NewListCapacityPagerFunc
+func (m *MockSystemCapacityService) NewListCapacityPager(p *cloudstack.ListCapacityParams) (r0 *cloudstack.ListCapacityPager) {
+	m.record("NewListCapacityPager", p)
+	if m.NewListCapacityPagerFunc != nil {
+		return m.NewListCapacityPagerFunc(p)
+	}
+	return
+}
+
+// ListCapacityAll records the call and calls ListCapacityAllFunc, or ListCapacityAllWithContextFunc if only that is set
+func (m *MockSystemCapacityService) ListCapacityAll(p *cloudstack.ListCapacityParams) (r0 *cloudstack.ListCapacityResponse, r1 error) {
+	m.record("ListCapacityAll", p)
+	if m.ListCapacityAllFunc != nil {
+		return m.ListCapacityAllFunc(p)
+	}
+	if m.ListCapacityAllWithContextFunc != nil {
+		return m.ListCapacityAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemCapacityService.ListCapacityAll")
+	return
+}
+
+// ListCapacityAllWithContext records the call and calls ListCapacityAllWithContextFunc, or ListCapacityAllFunc if only that is set
+func (m *MockSystemCapacityService) ListCapacityAllWithContext(ctx context.Context, p *cloudstack.ListCapacityParams) (r0 *cloudstack.ListCapacityResponse, r1 error) {
+	m.record("ListCapacityAllWithContext", ctx, p)
+	if m.ListCapacityAllWithContextFunc != nil {
+		return m.ListCapacityAllWithContextFunc(ctx, p)
+	}
+	if m.ListCapacityAllFunc != nil {
+		return m.ListCapacityAllFunc(p)
+	}
+	r1 = notMocked("SystemCapacityService.ListCapacityAllWithContext")
+	return
+}
@@ -0,0 +1,633 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockSystemVMService is a mock of cloudstack.SystemVMServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockSystemVMService struct {
+	Recorder
+
+	NewChangeServiceForSystemVmParamsFunc   func(id string, serviceofferingid string) *cloudstack.ChangeServiceForSystemVmParams
+	ChangeServiceForSystemVmFunc            func(p *cloudstack.ChangeServiceForSystemVmParams) (*cloudstack.ChangeServiceForSystemVmResponse, error)
+	ChangeServiceForSystemVmWithContextFunc func(ctx context.Context, p *cloudstack.ChangeServiceForSystemVmParams) (*cloudstack.ChangeServiceForSystemVmResponse, error)
+	NewDestroySystemVmParamsFunc            func(id string) *cloudstack.DestroySystemVmParams
+	DestroySystemVmFunc                     func(p *cloudstack.DestroySystemVmParams) (*cloudstack.DestroySystemVmResponse, error)
+	DestroySystemVmWithContextFunc          func(ctx context.Context, p *cloudstack.DestroySystemVmParams) (*cloudstack.DestroySystemVmResponse, error)
+	DestroySystemVmAsyncFunc                func(p *cloudstack.DestroySystemVmParams) (*cloudstack.Job, error)
+	DestroySystemVmAsyncWithContextFunc     func(ctx context.Context, p *cloudstack.DestroySystemVmParams) (*cloudstack.Job, error)
+	NewListSystemVmsParamsFunc              func() *cloudstack.ListSystemVmsParams
+	GetSystemVmIDFunc                       func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetSystemVmIDWithContextFunc            func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetSystemVmByNameFunc                   func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.SystemVm, int, error)
+	GetSystemVmByNameWithContextFunc        func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.SystemVm, int, error)
+	GetSystemVmByIDFunc                     func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.SystemVm, int, error)
+	GetSystemVmByIDWithContextFunc          func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.SystemVm, int, error)
+	ListSystemVmsFunc                       func(p *cloudstack.ListSystemVmsParams) (*cloudstack.ListSystemVmsResponse, error)
+	ListSystemVmsWithContextFunc            func(ctx context.Context, p *cloudstack.ListSystemVmsParams) (*cloudstack.ListSystemVmsResponse, error)
+	NewListSystemVmsPagerFunc               func(p *cloudstack.ListSystemVmsParams) *cloudstack.ListSystemVmsPager
+	ListSystemVmsAllFunc                    func(p *cloudstack.ListSystemVmsParams) (*cloudstack.ListSystemVmsResponse, error)
+	ListSystemVmsAllWithContextFunc         func(ctx context.Context, p *cloudstack.ListSystemVmsParams) (*cloudstack.ListSystemVmsResponse, error)
+	NewMigrateSystemVmParamsFunc            func(hostid string, virtualmachineid string) *cloudstack.MigrateSystemVmParams
+	MigrateSystemVmFunc                     func(p *cloudstack.MigrateSystemVmParams) (*cloudstack.MigrateSystemVmResponse, error)
+	MigrateSystemVmWithContextFunc          func(ctx context.Context, p *cloudstack.MigrateSystemVmParams) (*cloudstack.MigrateSystemVmResponse, error)
+	MigrateSystemVmAsyncFunc                func(p *cloudstack.MigrateSystemVmParams) (*cloudstack.Job, error)
+	MigrateSystemVmAsyncWithContextFunc     func(ctx context.Context, p *cloudstack.MigrateSystemVmParams) (*cloudstack.Job, error)
+	NewRebootSystemVmParamsFunc             func(id string) *cloudstack.RebootSystemVmParams
+	RebootSystemVmFunc                      func(p *cloudstack.RebootSystemVmParams) (*cloudstack.RebootSystemVmResponse, error)
+	RebootSystemVmWithContextFunc           func(ctx context.Context, p *cloudstack.RebootSystemVmParams) (*cloudstack.RebootSystemVmResponse, error)
+	RebootSystemVmAsyncFunc                 func(p *cloudstack.RebootSystemVmParams) (*cloudstack.Job, error)
+	RebootSystemVmAsyncWithContextFunc      func(ctx context.Context, p *cloudstack.RebootSystemVmParams) (*cloudstack.Job, error)
+	NewScaleSystemVmParamsFunc              func(id string, serviceofferingid string) *cloudstack.ScaleSystemVmParams
+	ScaleSystemVmFunc                       func(p *cloudstack.ScaleSystemVmParams) (*cloudstack.ScaleSystemVmResponse, error)
+	ScaleSystemVmWithContextFunc            func(ctx context.Context, p *cloudstack.ScaleSystemVmParams) (*cloudstack.ScaleSystemVmResponse, error)
+	ScaleSystemVmAsyncFunc                  func(p *cloudstack.ScaleSystemVmParams) (*cloudstack.Job, error)
+	ScaleSystemVmAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.ScaleSystemVmParams) (*cloudstack.Job, error)
+	NewStartSystemVmParamsFunc              func(id string) *cloudstack.StartSystemVmParams
+	StartSystemVmFunc                       func(p *cloudstack.StartSystemVmParams) (*cloudstack.StartSystemVmResponse, error)
+	StartSystemVmWithContextFunc            func(ctx context.Context, p *cloudstack.StartSystemVmParams) (*cloudstack.StartSystemVmResponse, error)
+	StartSystemVmAsyncFunc                  func(p *cloudstack.StartSystemVmParams) (*cloudstack.Job, error)
+	StartSystemVmAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.StartSystemVmParams) (*cloudstack.Job, error)
+	NewStopSystemVmParamsFunc               func(id string) *cloudstack.StopSystemVmParams
+	StopSystemVmFunc                        func(p *cloudstack.StopSystemVmParams) (*cloudstack.StopSystemVmResponse, error)
+	StopSystemVmWithContextFunc             func(ctx context.Context, p *cloudstack.StopSystemVmParams) (*cloudstack.StopSystemVmResponse, error)
+	StopSystemVmAsyncFunc                   func(p *cloudstack.StopSystemVmParams) (*cloudstack.Job, error)
+	StopSystemVmAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.StopSystemVmParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.SystemVMServiceIface = (*MockSystemVMService)(nil)
+
+// NewChangeServiceForSystemVmParams records the call and calls NewChangeServiceForSystemVmParamsFunc
+func (m *MockSystemVMService) NewChangeServiceForSystemVmParams(id string, serviceofferingid string) (r0 *cloudstack.ChangeServiceForSystemVmParams) {
+	m.record("NewChangeServiceForSystemVmParams", id, serviceofferingid)
+	if m.NewChangeServiceForSystemVmParamsFunc != nil {
+		return m.NewChangeServiceForSystemVmParamsFunc(id, serviceofferingid)
+	}
+	return cloudstack.NewSystemVMService(nil).NewChangeServiceForSystemVmParams(id, serviceofferingid)
+}
+
+// ChangeServiceForSystemVm records the call and calls ChangeServiceForSystemVmFunc, or ChangeServiceForSystemVmWithContextFunc if only that is set
+func (m *MockSystemVMService) ChangeServiceForSystemVm(p *cloudstack.ChangeServiceForSystemVmParams) (r0 *cloudstack.ChangeServiceForSystemVmResponse, r1 error) {
+	m.record("ChangeServiceForSystemVm", p)
+	if m.ChangeServiceForSystemVmFunc != nil {
+		return m.ChangeServiceForSystemVmFunc(p)
+	}
+	if m.ChangeServiceForSystemVmWithContextFunc != nil {
+		return m.ChangeServiceForSystemVmWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.ChangeServiceForSystemVm")
+	return
+}
+
+// ChangeServiceForSystemVmWithContext records the call and calls ChangeServiceForSystemVmWithContextFunc, or ChangeServiceForSystemVmFunc if only that is set
+func (m *MockSystemVMService) ChangeServiceForSystemVmWithContext(ctx context.Context, p *cloudstack.ChangeServiceForSystemVmParams) (r0 *cloudstack.ChangeServiceForSystemVmResponse, r1 error) {
+	m.record("ChangeServiceForSystemVmWithContext", ctx, p)
+	if m.ChangeServiceForSystemVmWithContextFunc != nil {
+		return m.ChangeServiceForSystemVmWithContextFunc(ctx, p)
+	}
+	if m.ChangeServiceForSystemVmFunc != nil {
+		return m.ChangeServiceForSystemVmFunc(p)
+	}
+	r1 = notMocked("SystemVMService.ChangeServiceForSystemVmWithContext")
+	return
+}
+
+// NewDestroySystemVmParams records the call and calls NewDestroySystemVmParamsFunc
+func (m *MockSystemVMService) NewDestroySystemVmParams(id string) (r0 *cloudstack.DestroySystemVmParams) {
+	m.record("NewDestroySystemVmParams", id)
+	if m.NewDestroySystemVmParamsFunc != nil {
+		return m.NewDestroySystemVmParamsFunc(id)
+	}
+	return cloudstack.NewSystemVMService(nil).NewDestroySystemVmParams(id)
+}
+
+// DestroySystemVm records the call and calls DestroySystemVmFunc, or DestroySystemVmWithContextFunc if only that is set
+func (m *MockSystemVMService) DestroySystemVm(p *cloudstack.DestroySystemVmParams) (r0 *cloudstack.DestroySystemVmResponse, r1 error) {
+	m.record("DestroySystemVm", p)
+	if m.DestroySystemVmFunc != nil {
+		return m.DestroySystemVmFunc(p)
+	}
+	if m.DestroySystemVmWithContextFunc != nil {
+		return m.DestroySystemVmWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.DestroySystemVm")
+	return
+}
+
+// DestroySystemVmWithContext records the call and calls DestroySystemVmWithContextFunc, or DestroySystemVmFunc if only that is set
+func (m *MockSystemVMService) DestroySystemVmWithContext(ctx context.Context, p *cloudstack.DestroySystemVmParams) (r0 *cloudstack.DestroySystemVmResponse, r1 error) {
+	m.record("DestroySystemVmWithContext", ctx, p)
+	if m.DestroySystemVmWithContextFunc != nil {
+		return m.DestroySystemVmWithContextFunc(ctx, p)
+	}
+	if m.DestroySystemVmFunc != nil {
+		return m.DestroySystemVmFunc(p)
+	}
+	r1 = notMocked("SystemVMService.DestroySystemVmWithContext")
+	return
+}
+
+// DestroySystemVmAsync records the call and calls DestroySystemVmAsyncFunc, or DestroySystemVmAsyncWithContextFunc if only that is set
+func (m *MockSystemVMService) DestroySystemVmAsync(p *cloudstack.DestroySystemVmParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DestroySystemVmAsync", p)
+	if m.DestroySystemVmAsyncFunc != nil {
+		return m.DestroySystemVmAsyncFunc(p)
+	}
+	if m.DestroySystemVmAsyncWithContextFunc != nil {
+		return m.DestroySystemVmAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.DestroySystemVmAsync")
+	return
+}
+
+// DestroySystemVmAsyncWithContext records the call and calls DestroySystemVmAsyncWithContextFunc, or DestroySystemVmAsyncFunc if only that is set
+func (m *MockSystemVMService) DestroySystemVmAsyncWithContext(ctx context.Context, p *cloudstack.DestroySystemVmParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DestroySystemVmAsyncWithContext", ctx, p)
+	if m.DestroySystemVmAsyncWithContextFunc != nil {
+		return m.DestroySystemVmAsyncWithContextFunc(ctx, p)
+	}
+	if m.DestroySystemVmAsyncFunc != nil {
+		return m.DestroySystemVmAsyncFunc(p)
+	}
+	r1 = notMocked("SystemVMService.DestroySystemVmAsyncWithContext")
+	return
+}
+
+// NewListSystemVmsParams records the call and calls NewListSystemVmsParamsFunc
+func (m *MockSystemVMService) NewListSystemVmsParams() (r0 *cloudstack.ListSystemVmsParams) {
+	m.record("NewListSystemVmsParams")
+	if m.NewListSystemVmsParamsFunc != nil {
+		return m.NewListSystemVmsParamsFunc()
+	}
+	return cloudstack.NewSystemVMService(nil).NewListSystemVmsParams()
+}
+
+// GetSystemVmID records the call and calls GetSystemVmIDFunc, or GetSystemVmIDWithContextFunc if only that is set
+func (m *MockSystemVMService) GetSystemVmID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetSystemVmID", name, opts)
+	if m.GetSystemVmIDFunc != nil {
+		return m.GetSystemVmIDFunc(name, opts...)
+	}
+	if m.GetSystemVmIDWithContextFunc != nil {
+		return m.GetSystemVmIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("SystemVMService.GetSystemVmID")
+	return
+}
+
+// GetSystemVmIDWithContext records the call and calls GetSystemVmIDWithContextFunc, or GetSystemVmIDFunc if only that is set
+func (m *MockSystemVMService) GetSystemVmIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetSystemVmIDWithContext", ctx, name, opts)
+	if m.GetSystemVmIDWithContextFunc != nil {
+		return m.GetSystemVmIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetSystemVmIDFunc != nil {
+		return m.GetSystemVmIDFunc(name, opts...)
+	}
+	r2 = notMocked("SystemVMService.GetSystemVmIDWithContext")
+	return
+}
+
+// GetSystemVmByName records the call and calls GetSystemVmByNameFunc, or GetSystemVmByNameWithContextFunc if only that is set
+func (m *MockSystemVMService) GetSystemVmByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.SystemVm, r1 int, r2 error) {
+	m.record("GetSystemVmByName", name, opts)
+	if m.GetSystemVmByNameFunc != nil {
+		return m.GetSystemVmByNameFunc(name, opts...)
+	}
+	if m.GetSystemVmByNameWithContextFunc != nil {
+		return m.GetSystemVmByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("SystemVMService.GetSystemVmByName")
+	return
+}
+
+// GetSystemVmByNameWithContext records the call and calls GetSystemVmByNameWithContextFunc, or GetSystemVmByNameFunc if only that is set
+func (m *MockSystemVMService) GetSystemVmByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.SystemVm, r1 int, r2 error) {
+	m.record("GetSystemVmByNameWithContext", ctx, name, opts)
+	if m.GetSystemVmByNameWithContextFunc != nil {
+		return m.GetSystemVmByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetSystemVmByNameFunc != nil {
+		return m.GetSystemVmByNameFunc(name, opts...)
+	}
+	r2 = notMocked("SystemVMService.GetSystemVmByNameWithContext")
+	return
+}
+
+// GetSystemVmByID records the call and calls GetSystemVmByIDFunc, or GetSystemVmByIDWithContextFunc if only that is set
+func (m *MockSystemVMService) GetSystemVmByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.SystemVm, r1 int, r2 error) {
+	m.record("GetSystemVmByID", id, opts)
+	if m.GetSystemVmByIDFunc != nil {
+		return m.GetSystemVmByIDFunc(id, opts...)
+	}
+	if m.GetSystemVmByIDWithContextFunc != nil {
+		return m.GetSystemVmByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("SystemVMService.GetSystemVmByID")
+	return
+}
+
+// GetSystemVmByIDWithContext records the call and calls GetSystemVmByIDWithContextFunc, or GetSystemVmByIDFunc if only that is set
+func (m *MockSystemVMService) GetSystemVmByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.SystemVm, r1 int, r2 error) {
+	m.record("GetSystemVmByIDWithContext", ctx, id, opts)
+	if m.GetSystemVmByIDWithContextFunc != nil {
+		return m.GetSystemVmByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetSystemVmByIDFunc != nil {
+		return m.GetSystemVmByIDFunc(id, opts...)
+	}
+	r2 = notMocked("SystemVMService.GetSystemVmByIDWithContext")
+	return
+}
+
+// ListSystemVms records the call and calls ListSystemVmsFunc, or ListSystemVmsWithContextFunc if only that is set
+func (m *MockSystemVMService) ListSystemVms(p *cloudstack.ListSystemVmsParams) (r0 *cloudstack.ListSystemVmsResponse, r1 error) {
+	m.record("ListSystemVms", p)
+	if m.ListSystemVmsFunc != nil {
+		return m.ListSystemVmsFunc(p)
+	}
+	if m.ListSystemVmsWithContextFunc != nil {
+		return m.ListSystemVmsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.ListSystemVms")
+	return
+}
+
+// ListSystemVmsWithContext records the call and calls ListSystemVmsWithContextFunc, or ListSystemVmsFunc if only that is set
+func (m *MockSystemVMService) ListSystemVmsWithContext(ctx context.Context, p *cloudstack.ListSystemVmsParams) (r0 *cloudstack.ListSystemVmsResponse, r1 error) {
+	m.record("ListSystemVmsWithContext", ctx, p)
+	if m.ListSystemVmsWithContextFunc != nil {
+		return m.ListSystemVmsWithContextFunc(ctx, p)
+	}
+	if m.ListSystemVmsFunc != nil {
+		return m.ListSystemVmsFunc(p)
+	}
+	r1 = notMocked("SystemVMService.ListSystemVmsWithContext")
+	return
+}
+
+// NewListSystemVmsPager records the call and calls NewListSystemVmsPagerFunc
+func (m *MockSystemVMService) NewListSystemVmsPager(p *cloudstack.ListSystemVmsParams) (r0 *cloudstack.ListSystemVmsPager) {
+	m.record("NewListSystemVmsPager", p)
+	if m.NewListSystemVmsPagerFunc != nil {
+		return m.NewListSystemVmsPagerFunc(p)
+	}
+	return
+}
+
+// ListSystemVmsAll records the call and calls ListSystemVmsAllFunc, or ListSystemVmsAllWithContextFunc if only that is set
+func (m *MockSystemVMService) ListSystemVmsAll(p *cloudstack.ListSystemVmsParams) (r0 *cloudstack.ListSystemVmsResponse, r1 error) {
+	m.record("ListSystemVmsAll", p)
+	if m.ListSystemVmsAllFunc != nil {
+		return m.ListSystemVmsAllFunc(p)
+	}
+	if m.ListSystemVmsAllWithContextFunc != nil {
+		return m.ListSystemVmsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.ListSystemVmsAll")
+	return
+}
+
+// ListSystemVmsAllWithContext records the call and calls ListSystemVmsAllWithContextFunc, or ListSystemVmsAllFunc if only that is set
+func (m *MockSystemVMService) ListSystemVmsAllWithContext(ctx context.Context, p *cloudstack.ListSystemVmsParams) (r0 *cloudstack.ListSystemVmsResponse, r1 error) {
+	m.record("ListSystemVmsAllWithContext", ctx, p)
+	if m.ListSystemVmsAllWithContextFunc != nil {
+		return m.ListSystemVmsAllWithContextFunc(ctx, p)
+	}
+	if m.ListSystemVmsAllFunc != nil {
+		return m.ListSystemVmsAllFunc(p)
+	}
+	r1 = notMocked("SystemVMService.ListSystemVmsAllWithContext")
+	return
+}
+
+// NewMigrateSystemVmParams records the call and calls NewMigrateSystemVmParamsFunc
+func (m *MockSystemVMService) NewMigrateSystemVmParams(hostid string, virtualmachineid string) (r0 *cloudstack.MigrateSystemVmParams) {
+	m.record("NewMigrateSystemVmParams", hostid, virtualmachineid)
+	if m.NewMigrateSystemVmParamsFunc != nil {
+		return m.NewMigrateSystemVmParamsFunc(hostid, virtualmachineid)
+	}
+	return cloudstack.NewSystemVMService(nil).NewMigrateSystemVmParams(hostid, virtualmachineid)
+}
+
+// MigrateSystemVm records the call and calls MigrateSystemVmFunc, or MigrateSystemVmWithContextFunc if only that is set
+func (m *MockSystemVMService) MigrateSystemVm(p *cloudstack.MigrateSystemVmParams) (r0 *cloudstack.MigrateSystemVmResponse, r1 error) {
+	m.record("MigrateSystemVm", p)
+	if m.MigrateSystemVmFunc != nil {
+		return m.MigrateSystemVmFunc(p)
+	}
+	if m.MigrateSystemVmWithContextFunc != nil {
+		return m.MigrateSystemVmWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.MigrateSystemVm")
+	return
+}
+
+// MigrateSystemVmWithContext records the call and calls MigrateSystemVmWithContextFunc, or MigrateSystemVmFunc if only that is set
+func (m *MockSystemVMService) MigrateSystemVmWithContext(ctx context.Context, p *cloudstack.MigrateSystemVmParams) (r0 *cloudstack.MigrateSystemVmResponse, r1 error) {
+	m.record("MigrateSystemVmWithContext", ctx, p)
+	if m.MigrateSystemVmWithContextFunc != nil {
+		return m.MigrateSystemVmWithContextFunc(ctx, p)
+	}
+	if m.MigrateSystemVmFunc != nil {
+		return m.MigrateSystemVmFunc(p)
+	}
+	r1 = notMocked("SystemVMService.MigrateSystemVmWithContext")
+	return
+}
+
+// MigrateSystemVmAsync records the call and calls MigrateSystemVmAsyncFunc, or MigrateSystemVmAsyncWithContextFunc if only that is set
+func (m *MockSystemVMService) MigrateSystemVmAsync(p *cloudstack.MigrateSystemVmParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MigrateSystemVmAsync", p)
+	if m.MigrateSystemVmAsyncFunc != nil {
+		return m.MigrateSystemVmAsyncFunc(p)
+	}
+	if m.MigrateSystemVmAsyncWithContextFunc != nil {
+		return m.MigrateSystemVmAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.MigrateSystemVmAsync")
+	return
+}
+
+// MigrateSystemVmAsyncWithContext records the call and calls MigrateSystemVmAsyncWithContextFunc, or MigrateSystemVmAsyncFunc if only that is set
+func (m *MockSystemVMService) MigrateSystemVmAsyncWithContext(ctx context.Context, p *cloudstack.MigrateSystemVmParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MigrateSystemVmAsyncWithContext", ctx, p)
+	if m.MigrateSystemVmAsyncWithContextFunc != nil {
+		return m.MigrateSystemVmAsyncWithContextFunc(ctx, p)
+	}
+	if m.MigrateSystemVmAsyncFunc != nil {
+		return m.MigrateSystemVmAsyncFunc(p)
+	}
+	r1 = notMocked("SystemVMService.MigrateSystemVmAsyncWithContext")
+	return
+}
+
+// NewRebootSystemVmParams records the call and calls NewRebootSystemVmParamsFunc
+func (m *MockSystemVMService) NewRebootSystemVmParams(id string) (r0 *cloudstack.RebootSystemVmParams) {
+	m.record("NewRebootSystemVmParams", id)
+	if m.NewRebootSystemVmParamsFunc != nil {
+		return m.NewRebootSystemVmParamsFunc(id)
+	}
+	return cloudstack.NewSystemVMService(nil).NewRebootSystemVmParams(id)
+}
+
+// RebootSystemVm records the call and calls RebootSystemVmFunc, or RebootSystemVmWithContextFunc if only that is set
+func (m *MockSystemVMService) RebootSystemVm(p *cloudstack.RebootSystemVmParams) (r0 *cloudstack.RebootSystemVmResponse, r1 error) {
+	m.record("RebootSystemVm", p)
+	if m.RebootSystemVmFunc != nil {
+		return m.RebootSystemVmFunc(p)
+	}
+	if m.RebootSystemVmWithContextFunc != nil {
+		return m.RebootSystemVmWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.RebootSystemVm")
+	return
+}
+
+// RebootSystemVmWithContext records the call and calls RebootSystemVmWithContextFunc, or RebootSystemVmFunc if only that is set
+func (m *MockSystemVMService) RebootSystemVmWithContext(ctx context.Context, p *cloudstack.RebootSystemVmParams) (r0 *cloudstack.RebootSystemVmResponse, r1 error) {
+	m.record("RebootSystemVmWithContext", ctx, p)
+	if m.RebootSystemVmWithContextFunc != nil {
+		return m.RebootSystemVmWithContextFunc(ctx, p)
+	}
+	if m.RebootSystemVmFunc != nil {
+		return m.RebootSystemVmFunc(p)
+	}
+	r1 = notMocked("SystemVMService.RebootSystemVmWithContext")
+	return
+}
+
+// RebootSystemVmAsync records the call and calls RebootSystemVmAsyncFunc, or RebootSystemVmAsyncWithContextFunc if only that is set
+func (m *MockSystemVMService) RebootSystemVmAsync(p *cloudstack.RebootSystemVmParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RebootSystemVmAsync", p)
+	if m.RebootSystemVmAsyncFunc != nil {
+		return m.RebootSystemVmAsyncFunc(p)
+	}
+	if m.RebootSystemVmAsyncWithContextFunc != nil {
+		return m.RebootSystemVmAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.RebootSystemVmAsync")
+	return
+}
+
+// RebootSystemVmAsyncWithContext records the call and calls RebootSystemVmAsyncWithContextFunc, or RebootSystemVmAsyncFunc if only that is set
+func (m *MockSystemVMService) RebootSystemVmAsyncWithContext(ctx context.Context, p *cloudstack.RebootSystemVmParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RebootSystemVmAsyncWithContext", ctx, p)
+	if m.RebootSystemVmAsyncWithContextFunc != nil {
+		return m.RebootSystemVmAsyncWithContextFunc(ctx, p)
+	}
+	if m.RebootSystemVmAsyncFunc != nil {
+		return m.RebootSystemVmAsyncFunc(p)
+	}
+	r1 = notMocked("SystemVMService.RebootSystemVmAsyncWithContext")
+	return
+}
+
+// NewScaleSystemVmParams records the call and calls NewScaleSystemVmParamsFunc
+func (m *MockSystemVMService) NewScaleSystemVmParams(id string, serviceofferingid string) (r0 *cloudstack.ScaleSystemVmParams) {
+	m.record("NewScaleSystemVmParams", id, serviceofferingid)
+	if m.NewScaleSystemVmParamsFunc != nil {
+		return m.NewScaleSystemVmParamsFunc(id, serviceofferingid)
+	}
+	return cloudstack.NewSystemVMService(nil).NewScaleSystemVmParams(id, serviceofferingid)
+}
+
+// ScaleSystemVm records the call and calls ScaleSystemVmFunc, or ScaleSystemVmWithContextFunc if only that is set
+func (m *MockSystemVMService) ScaleSystemVm(p *cloudstack.ScaleSystemVmParams) (r0 *cloudstack.ScaleSystemVmResponse, r1 error) {
+	m.record("ScaleSystemVm", p)
+	if m.ScaleSystemVmFunc != nil {
+		return m.ScaleSystemVmFunc(p)
+	}
+	if m.ScaleSystemVmWithContextFunc != nil {
+		return m.ScaleSystemVmWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.ScaleSystemVm")
+	return
+}
+
+// ScaleSystemVmWithContext records the call and calls ScaleSystemVmWithContextFunc, or ScaleSystemVmFunc if only that is set
+func (m *MockSystemVMService) ScaleSystemVmWithContext(ctx context.Context, p *cloudstack.ScaleSystemVmParams) (r0 *cloudstack.ScaleSystemVmResponse, r1 error) {
+	m.record("ScaleSystemVmWithContext", ctx, p)
+	if m.ScaleSystemVmWithContextFunc != nil {
+		return m.ScaleSystemVmWithContextFunc(ctx, p)
+	}
+	if m.ScaleSystemVmFunc != nil {
+		return m.ScaleSystemVmFunc(p)
+	}
+	r1 = notMocked("SystemVMService.ScaleSystemVmWithContext")
+	return
+}
+
+// ScaleSystemVmAsync records the call and calls ScaleSystemVmAsyncFunc, or ScaleSystemVmAsyncWithContextFunc if only that is set
+func (m *MockSystemVMService) ScaleSystemVmAsync(p *cloudstack.ScaleSystemVmParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ScaleSystemVmAsync", p)
+	if m.ScaleSystemVmAsyncFunc != nil {
+		return m.ScaleSystemVmAsyncFunc(p)
+	}
+	if m.ScaleSystemVmAsyncWithContextFunc != nil {
+		return m.ScaleSystemVmAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.ScaleSystemVmAsync")
+	return
+}
+
+// ScaleSystemVmAsyncWithContext records the call and calls ScaleSystemVmAsyncWithContextFunc, or ScaleSystemVmAsyncFunc if only that is set
+func (m *MockSystemVMService) ScaleSystemVmAsyncWithContext(ctx context.Context, p *cloudstack.ScaleSystemVmParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ScaleSystemVmAsyncWithContext", ctx, p)
+	if m.ScaleSystemVmAsyncWithContextFunc != nil {
+		return m.ScaleSystemVmAsyncWithContextFunc(ctx, p)
+	}
+	if m.ScaleSystemVmAsyncFunc != nil {
+		return m.ScaleSystemVmAsyncFunc(p)
+	}
+	r1 = notMocked("SystemVMService.ScaleSystemVmAsyncWithContext")
+	return
+}
+
+// NewStartSystemVmParams records the call and calls NewStartSystemVmParamsFunc
+func (m *MockSystemVMService) NewStartSystemVmParams(id string) (r0 *cloudstack.StartSystemVmParams) {
+	m.record("NewStartSystemVmParams", id)
+	if m.NewStartSystemVmParamsFunc != nil {
+		return m.NewStartSystemVmParamsFunc(id)
+	}
+	return cloudstack.NewSystemVMService(nil).NewStartSystemVmParams(id)
+}
+
+// StartSystemVm records the call and calls StartSystemVmFunc, or StartSystemVmWithContextFunc if only that is set
+func (m *MockSystemVMService) StartSystemVm(p *cloudstack.StartSystemVmParams) (r0 *cloudstack.StartSystemVmResponse, r1 error) {
+	m.record("StartSystemVm", p)
+	if m.StartSystemVmFunc != nil {
+		return m.StartSystemVmFunc(p)
+	}
+	if m.StartSystemVmWithContextFunc != nil {
+		return m.StartSystemVmWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.StartSystemVm")
+	return
+}
+
+// StartSystemVmWithContext records the call and calls StartSystemVmWithContextFunc, or StartSystemVmFunc if only that is set
+func (m *MockSystemVMService) StartSystemVmWithContext(ctx context.Context, p *cloudstack.StartSystemVmParams) (r0 *cloudstack.StartSystemVmResponse, r1 error) {
+	m.record("StartSystemVmWithContext", ctx, p)
+	if m.StartSystemVmWithContextFunc != nil {
+		return m.StartSystemVmWithContextFunc(ctx, p)
+	}
+	if m.StartSystemVmFunc != nil {
+		return m.StartSystemVmFunc(p)
+	}
+	r1 = notMocked("SystemVMService.StartSystemVmWithContext")
+	return
+}
+
+// StartSystemVmAsync records the call and calls StartSystemVmAsyncFunc, or StartSystemVmAsyncWithContextFunc if only that is set
+func (m *MockSystemVMService) StartSystemVmAsync(p *cloudstack.StartSystemVmParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StartSystemVmAsync", p)
+	if m.StartSystemVmAsyncFunc != nil {
+		return m.StartSystemVmAsyncFunc(p)
+	}
+	if m.StartSystemVmAsyncWithContextFunc != nil {
+		return m.StartSystemVmAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.StartSystemVmAsync")
+	return
+}
+
+// StartSystemVmAsyncWithContext records the call and calls StartSystemVmAsyncWithContextFunc, or StartSystemVmAsyncFunc if only that is set
+func (m *MockSystemVMService) StartSystemVmAsyncWithContext(ctx context.Context, p *cloudstack.StartSystemVmParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StartSystemVmAsyncWithContext", ctx, p)
+	if m.StartSystemVmAsyncWithContextFunc != nil {
+		return m.StartSystemVmAsyncWithContextFunc(ctx, p)
+	}
+	if m.StartSystemVmAsyncFunc != nil {
+		return m.StartSystemVmAsyncFunc(p)
+	}
+	r1 = notMocked("SystemVMService.StartSystemVmAsyncWithContext")
+	return
+}
+
+// NewStopSystemVmParams records the call and calls NewStopSystemVmParamsFunc
+func (m *MockSystemVMService) NewStopSystemVmParams(id string) (r0 *cloudstack.StopSystemVmParams) {
+	m.record("NewStopSystemVmParams", id)
+	if m.NewStopSystemVmParamsFunc != nil {
+		return m.NewStopSystemVmParamsFunc(id)
+	}
+	return cloudstack.NewSystemVMService(nil).NewStopSystemVmParams(id)
+}
+
+// StopSystemVm records the call and calls StopSystemVmFunc, or StopSystemVmWithContextFunc if only that is set
+func (m *MockSystemVMService) StopSystemVm(p *cloudstack.StopSystemVmParams) (r0 *cloudstack.StopSystemVmResponse, r1 error) {
+	m.record("StopSystemVm", p)
+	if m.StopSystemVmFunc != nil {
+		return m.StopSystemVmFunc(p)
+	}
+	if m.StopSystemVmWithContextFunc != nil {
+		return m.StopSystemVmWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.StopSystemVm")
+	return
+}
+
+// StopSystemVmWithContext records the call and calls StopSystemVmWithContextFunc, or StopSystemVmFunc if only that is set
+func (m *MockSystemVMService) StopSystemVmWithContext(ctx context.Context, p *cloudstack.StopSystemVmParams) (r0 *cloudstack.StopSystemVmResponse, r1 error) {
+	m.record("StopSystemVmWithContext", ctx, p)
+	if m.StopSystemVmWithContextFunc != nil {
+		return m.StopSystemVmWithContextFunc(ctx, p)
+	}
+	if m.StopSystemVmFunc != nil {
+		return m.StopSystemVmFunc(p)
+	}
+	r1 = notMocked("SystemVMService.StopSystemVmWithContext")
+	return
+}
+
+// StopSystemVmAsync records the call and calls StopSystemVmAsyncFunc, or StopSystemVmAsyncWithContextFunc if only that is set
+func (m *MockSystemVMService) StopSystemVmAsync(p *cloudstack.StopSystemVmParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StopSystemVmAsync", p)
+	if m.StopSystemVmAsyncFunc != nil {
+		return m.StopSystemVmAsyncFunc(p)
+	}
+	if m.StopSystemVmAsyncWithContextFunc != nil {
+		return m.StopSystemVmAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("SystemVMService.StopSystemVmAsync")
+	return
+}
+
+// StopSystemVmAsyncWithContext records the call and calls StopSystemVmAsyncWithContextFunc, or StopSystemVmAsyncFunc if only that is set
+func (m *MockSystemVMService) StopSystemVmAsyncWithContext(ctx context.Context, p *cloudstack.StopSystemVmParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StopSystemVmAsyncWithContext", ctx, p)
+	if m.StopSystemVmAsyncWithContextFunc != nil {
+		return m.StopSystemVmAsyncWithContextFunc(ctx, p)
+	}
+	if m.StopSystemVmAsyncFunc != nil {
+		return m.StopSystemVmAsyncFunc(p)
+	}
+	r1 = notMocked("SystemVMService.StopSystemVmAsyncWithContext")
+	return
+}
@@ -0,0 +1,757 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockTemplateService is a mock of cloudstack.TemplateServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockTemplateService struct {
+	Recorder
+
+	NewCopyTemplateParamsFunc                 func(id string) *cloudstack.CopyTemplateParams
+	CopyTemplateFunc                          func(p *cloudstack.CopyTemplateParams) (*cloudstack.CopyTemplateResponse, error)
+	CopyTemplateWithContextFunc               func(ctx context.Context, p *cloudstack.CopyTemplateParams) (*cloudstack.CopyTemplateResponse, error)
+	CopyTemplateAsyncFunc                     func(p *cloudstack.CopyTemplateParams) (*cloudstack.Job, error)
+	CopyTemplateAsyncWithContextFunc          func(ctx context.Context, p *cloudstack.CopyTemplateParams) (*cloudstack.Job, error)
+	NewCreateTemplateParamsFunc               func(displaytext string, name string, ostypeid string) *cloudstack.CreateTemplateParams
+	CreateTemplateFunc                        func(p *cloudstack.CreateTemplateParams) (*cloudstack.CreateTemplateResponse, error)
+	CreateTemplateWithContextFunc             func(ctx context.Context, p *cloudstack.CreateTemplateParams) (*cloudstack.CreateTemplateResponse, error)
+	CreateTemplateAsyncFunc                   func(p *cloudstack.CreateTemplateParams) (*cloudstack.Job, error)
+	CreateTemplateAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.CreateTemplateParams) (*cloudstack.Job, error)
+	NewDeleteTemplateParamsFunc               func(id string) *cloudstack.DeleteTemplateParams
+	DeleteTemplateFunc                        func(p *cloudstack.DeleteTemplateParams) (*cloudstack.DeleteTemplateResponse, error)
+	DeleteTemplateWithContextFunc             func(ctx context.Context, p *cloudstack.DeleteTemplateParams) (*cloudstack.DeleteTemplateResponse, error)
+	DeleteTemplateAsyncFunc                   func(p *cloudstack.DeleteTemplateParams) (*cloudstack.Job, error)
+	DeleteTemplateAsyncWithContextFunc        func(ctx context.Context, p *cloudstack.DeleteTemplateParams) (*cloudstack.Job, error)
+	NewExtractTemplateParamsFunc              func(id string, mode string) *cloudstack.ExtractTemplateParams
+	ExtractTemplateFunc                       func(p *cloudstack.ExtractTemplateParams) (*cloudstack.ExtractTemplateResponse, error)
+	ExtractTemplateWithContextFunc            func(ctx context.Context, p *cloudstack.ExtractTemplateParams) (*cloudstack.ExtractTemplateResponse, error)
+	ExtractTemplateAsyncFunc                  func(p *cloudstack.ExtractTemplateParams) (*cloudstack.Job, error)
+	ExtractTemplateAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.ExtractTemplateParams) (*cloudstack.Job, error)
+	NewGetUploadParamsForTemplateParamsFunc   func(displaytext string, format string, hypervisor string, name string, zoneid string) *cloudstack.GetUploadParamsForTemplateParams
+	GetUploadParamsForTemplateFunc            func(p *cloudstack.GetUploadParamsForTemplateParams) (*cloudstack.GetUploadParamsForTemplateResponse, error)
+	GetUploadParamsForTemplateWithContextFunc func(ctx context.Context, p *cloudstack.GetUploadParamsForTemplateParams) (*cloudstack.GetUploadParamsForTemplateResponse, error)
+	NewListTemplatePermissionsParamsFunc      func(id string) *cloudstack.ListTemplatePermissionsParams
+	GetTemplatePermissionByIDFunc             func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.TemplatePermission, int, error)
+	GetTemplatePermissionByIDWithContextFunc  func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.TemplatePermission, int, error)
+	ListTemplatePermissionsFunc               func(p *cloudstack.ListTemplatePermissionsParams) (*cloudstack.ListTemplatePermissionsResponse, error)
+	ListTemplatePermissionsWithContextFunc    func(ctx context.Context, p *cloudstack.ListTemplatePermissionsParams) (*cloudstack.ListTemplatePermissionsResponse, error)
+	NewListTemplatesParamsFunc                func(templatefilter string) *cloudstack.ListTemplatesParams
+	GetTemplateIDFunc                         func(name string, templatefilter string, zoneid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetTemplateIDWithContextFunc              func(ctx context.Context, name string, templatefilter string, zoneid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetTemplateByNameFunc                     func(name string, templatefilter string, zoneid string, opts ...cloudstack.OptionFunc) (*cloudstack.Template, int, error)
+	GetTemplateByNameWithContextFunc          func(ctx context.Context, name string, templatefilter string, zoneid string, opts ...cloudstack.OptionFunc) (*cloudstack.Template, int, error)
+	GetTemplateByIDFunc                       func(id string, templatefilter string, opts ...cloudstack.OptionFunc) (*cloudstack.Template, int, error)
+	GetTemplateByIDWithContextFunc            func(ctx context.Context, id string, templatefilter string, opts ...cloudstack.OptionFunc) (*cloudstack.Template, int, error)
+	ListTemplatesFunc                         func(p *cloudstack.ListTemplatesParams) (*cloudstack.ListTemplatesResponse, error)
+	ListTemplatesWithContextFunc              func(ctx context.Context, p *cloudstack.ListTemplatesParams) (*cloudstack.ListTemplatesResponse, error)
+	NewListTemplatesPagerFunc                 func(p *cloudstack.ListTemplatesParams) *cloudstack.ListTemplatesPager
+	ListTemplatesAllFunc                      func(p *cloudstack.ListTemplatesParams) (*cloudstack.ListTemplatesResponse, error)
+	ListTemplatesAllWithContextFunc           func(ctx context.Context, p *cloudstack.ListTemplatesParams) (*cloudstack.ListTemplatesResponse, error)
+	NewPrepareTemplateParamsFunc              func(templateid string, zoneid string) *cloudstack.PrepareTemplateParams
+	PrepareTemplateFunc                       func(p *cloudstack.PrepareTemplateParams) (*cloudstack.PrepareTemplateResponse, error)
+	PrepareTemplateWithContextFunc            func(ctx context.Context, p *cloudstack.PrepareTemplateParams) (*cloudstack.PrepareTemplateResponse, error)
+	NewRegisterTemplateParamsFunc             func(displaytext string, format string, hypervisor string, name string, url string) *cloudstack.RegisterTemplateParams
+	RegisterTemplateFunc                      func(p *cloudstack.RegisterTemplateParams) (*cloudstack.RegisterTemplateResponse, error)
+	RegisterTemplateWithContextFunc           func(ctx context.Context, p *cloudstack.RegisterTemplateParams) (*cloudstack.RegisterTemplateResponse, error)
+	NewUpdateTemplateParamsFunc               func(id string) *cloudstack.UpdateTemplateParams
+	UpdateTemplateFunc                        func(p *cloudstack.UpdateTemplateParams) (*cloudstack.UpdateTemplateResponse, error)
+	UpdateTemplateWithContextFunc             func(ctx context.Context, p *cloudstack.UpdateTemplateParams) (*cloudstack.UpdateTemplateResponse, error)
+	NewUpdateTemplatePermissionsParamsFunc    func(id string) *cloudstack.UpdateTemplatePermissionsParams
+	UpdateTemplatePermissionsFunc             func(p *cloudstack.UpdateTemplatePermissionsParams) (*cloudstack.UpdateTemplatePermissionsResponse, error)
+	UpdateTemplatePermissionsWithContextFunc  func(ctx context.Context, p *cloudstack.UpdateTemplatePermissionsParams) (*cloudstack.UpdateTemplatePermissionsResponse, error)
+	NewUpgradeRouterTemplateParamsFunc        func() *cloudstack.UpgradeRouterTemplateParams
+	UpgradeRouterTemplateFunc                 func(p *cloudstack.UpgradeRouterTemplateParams) (*cloudstack.UpgradeRouterTemplateResponse, error)
+	UpgradeRouterTemplateWithContextFunc      func(ctx context.Context, p *cloudstack.UpgradeRouterTemplateParams) (*cloudstack.UpgradeRouterTemplateResponse, error)
+}
+
+var _ cloudstack.TemplateServiceIface = (*MockTemplateService)(nil)
+
+// NewCopyTemplateParams records the call and calls NewCopyTemplateParamsFunc
+func (m *MockTemplateService) NewCopyTemplateParams(id string) (r0 *cloudstack.CopyTemplateParams) {
+	m.record("NewCopyTemplateParams", id)
+	if m.NewCopyTemplateParamsFunc != nil {
+		return m.NewCopyTemplateParamsFunc(id)
+	}
+	return cloudstack.NewTemplateService(nil).NewCopyTemplateParams(id)
+}
+
+// CopyTemplate records the call and calls CopyTemplateFunc, or CopyTemplateWithContextFunc if only that is set
+func (m *MockTemplateService) CopyTemplate(p *cloudstack.CopyTemplateParams) (r0 *cloudstack.CopyTemplateResponse, r1 error) {
+	m.record("CopyTemplate", p)
+	if m.CopyTemplateFunc != nil {
+		return m.CopyTemplateFunc(p)
+	}
+	if m.CopyTemplateWithContextFunc != nil {
+		return m.CopyTemplateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.CopyTemplate")
+	return
+}
+
+// CopyTemplateWithContext records the call and calls CopyTemplateWithContextFunc, or CopyTemplateFunc if only that is set
+func (m *MockTemplateService) CopyTemplateWithContext(ctx context.Context, p *cloudstack.CopyTemplateParams) (r0 *cloudstack.CopyTemplateResponse, r1 error) {
+	m.record("CopyTemplateWithContext", ctx, p)
+	if m.CopyTemplateWithContextFunc != nil {
+		return m.CopyTemplateWithContextFunc(ctx, p)
+	}
+	if m.CopyTemplateFunc != nil {
+		return m.CopyTemplateFunc(p)
+	}
+	r1 = notMocked("TemplateService.CopyTemplateWithContext")
+	return
+}
+
+// CopyTemplateAsync records the call and calls CopyTemplateAsyncFunc, or CopyTemplateAsyncWithContextFunc if only that is set
+func (m *MockTemplateService) CopyTemplateAsync(p *cloudstack.CopyTemplateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CopyTemplateAsync", p)
+	if m.CopyTemplateAsyncFunc != nil {
+		return m.CopyTemplateAsyncFunc(p)
+	}
+	if m.CopyTemplateAsyncWithContextFunc != nil {
+		return m.CopyTemplateAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.CopyTemplateAsync")
+	return
+}
+
+// CopyTemplateAsyncWithContext records the call and calls CopyTemplateAsyncWithContextFunc, or CopyTemplateAsyncFunc if only that is set
+func (m *MockTemplateService) CopyTemplateAsyncWithContext(ctx context.Context, p *cloudstack.CopyTemplateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CopyTemplateAsyncWithContext", ctx, p)
+	if m.CopyTemplateAsyncWithContextFunc != nil {
+		return m.CopyTemplateAsyncWithContextFunc(ctx, p)
+	}
+	if m.CopyTemplateAsyncFunc != nil {
+		return m.CopyTemplateAsyncFunc(p)
+	}
+	r1 = notMocked("TemplateService.CopyTemplateAsyncWithContext")
+	return
+}
+
+// NewCreateTemplateParams records the call and calls NewCreateTemplateParamsFunc
+func (m *MockTemplateService) NewCreateTemplateParams(displaytext string, name string, ostypeid string) (r0 *cloudstack.CreateTemplateParams) {
+	m.record("NewCreateTemplateParams", displaytext, name, ostypeid)
+	if m.NewCreateTemplateParamsFunc != nil {
+		return m.NewCreateTemplateParamsFunc(displaytext, name, ostypeid)
+	}
+	return cloudstack.NewTemplateService(nil).NewCreateTemplateParams(displaytext, name, ostypeid)
+}
+
+// CreateTemplate records the call and calls CreateTemplateFunc, or CreateTemplateWithContextFunc if only that is set
+func (m *MockTemplateService) CreateTemplate(p *cloudstack.CreateTemplateParams) (r0 *cloudstack.CreateTemplateResponse, r1 error) {
+	m.record("CreateTemplate", p)
+	if m.CreateTemplateFunc != nil {
+		return m.CreateTemplateFunc(p)
+	}
+	if m.CreateTemplateWithContextFunc != nil {
+		return m.CreateTemplateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.CreateTemplate")
+	return
+}
+
+// CreateTemplateWithContext records the call and calls CreateTemplateWithContextFunc, or CreateTemplateFunc if only that is set
+func (m *MockTemplateService) CreateTemplateWithContext(ctx context.Context, p *cloudstack.CreateTemplateParams) (r0 *cloudstack.CreateTemplateResponse, r1 error) {
+	m.record("CreateTemplateWithContext", ctx, p)
+	if m.CreateTemplateWithContextFunc != nil {
+		return m.CreateTemplateWithContextFunc(ctx, p)
+	}
+	if m.CreateTemplateFunc != nil {
+		return m.CreateTemplateFunc(p)
+	}
+	r1 = notMocked("TemplateService.CreateTemplateWithContext")
+	return
+}
+
+// CreateTemplateAsync records the call and calls CreateTemplateAsyncFunc, or CreateTemplateAsyncWithContextFunc if only that is set
+func (m *MockTemplateService) CreateTemplateAsync(p *cloudstack.CreateTemplateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateTemplateAsync", p)
+	if m.CreateTemplateAsyncFunc != nil {
+		return m.CreateTemplateAsyncFunc(p)
+	}
+	if m.CreateTemplateAsyncWithContextFunc != nil {
+		return m.CreateTemplateAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.CreateTemplateAsync")
+	return
+}
+
+// CreateTemplateAsyncWithContext records the call and calls CreateTemplateAsyncWithContextFunc, or CreateTemplateAsyncFunc if only that is set
+func (m *MockTemplateService) CreateTemplateAsyncWithContext(ctx context.Context, p *cloudstack.CreateTemplateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateTemplateAsyncWithContext", ctx, p)
+	if m.CreateTemplateAsyncWithContextFunc != nil {
+		return m.CreateTemplateAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateTemplateAsyncFunc != nil {
+		return m.CreateTemplateAsyncFunc(p)
+	}
+	r1 = notMocked("TemplateService.CreateTemplateAsyncWithContext")
+	return
+}
+
+// NewDeleteTemplateParams records the call and calls NewDeleteTemplateParamsFunc
+func (m *MockTemplateService) NewDeleteTemplateParams(id string) (r0 *cloudstack.DeleteTemplateParams) {
+	m.record("NewDeleteTemplateParams", id)
+	if m.NewDeleteTemplateParamsFunc != nil {
+		return m.NewDeleteTemplateParamsFunc(id)
+	}
+	return cloudstack.NewTemplateService(nil).NewDeleteTemplateParams(id)
+}
+
+// DeleteTemplate records the call and calls DeleteTemplateFunc, or DeleteTemplateWithContextFunc if only that is set
+func (m *MockTemplateService) DeleteTemplate(p *cloudstack.DeleteTemplateParams) (r0 *cloudstack.DeleteTemplateResponse, r1 error) {
+	m.record("DeleteTemplate", p)
+	if m.DeleteTemplateFunc != nil {
+		return m.DeleteTemplateFunc(p)
+	}
+	if m.DeleteTemplateWithContextFunc != nil {
+		return m.DeleteTemplateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.DeleteTemplate")
+	return
+}
+
+// DeleteTemplateWithContext records the call and calls DeleteTemplateWithContextFunc, or DeleteTemplateFunc if only that is set
+func (m *MockTemplateService) DeleteTemplateWithContext(ctx context.Context, p *cloudstack.DeleteTemplateParams) (r0 *cloudstack.DeleteTemplateResponse, r1 error) {
+	m.record("DeleteTemplateWithContext", ctx, p)
+	if m.DeleteTemplateWithContextFunc != nil {
+		return m.DeleteTemplateWithContextFunc(ctx, p)
+	}
+	if m.DeleteTemplateFunc != nil {
+		return m.DeleteTemplateFunc(p)
+	}
+	r1 = notMocked("TemplateService.DeleteTemplateWithContext")
+	return
+}
+
+// DeleteTemplateAsync records the call and calls DeleteTemplateAsyncFunc, or DeleteTemplateAsyncWithContextFunc if only that is set
+func (m *MockTemplateService) DeleteTemplateAsync(p *cloudstack.DeleteTemplateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteTemplateAsync", p)
+	if m.DeleteTemplateAsyncFunc != nil {
+		return m.DeleteTemplateAsyncFunc(p)
+	}
+	if m.DeleteTemplateAsyncWithContextFunc != nil {
+		return m.DeleteTemplateAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.DeleteTemplateAsync")
+	return
+}
+
+// DeleteTemplateAsyncWithContext records the call and calls DeleteTemplateAsyncWithContextFunc, or DeleteTemplateAsyncFunc if only that is set
+func (m *MockTemplateService) DeleteTemplateAsyncWithContext(ctx context.Context, p *cloudstack.DeleteTemplateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteTemplateAsyncWithContext", ctx, p)
+	if m.DeleteTemplateAsyncWithContextFunc != nil {
+		return m.DeleteTemplateAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteTemplateAsyncFunc != nil {
+		return m.DeleteTemplateAsyncFunc(p)
+	}
+	r1 = notMocked("TemplateService.DeleteTemplateAsyncWithContext")
+	return
+}
+
+// NewExtractTemplateParams records the call and calls NewExtractTemplateParamsFunc
+func (m *MockTemplateService) NewExtractTemplateParams(id string, mode string) (r0 *cloudstack.ExtractTemplateParams) {
+	m.record("NewExtractTemplateParams", id, mode)
+	if m.NewExtractTemplateParamsFunc != nil {
+		return m.NewExtractTemplateParamsFunc(id, mode)
+	}
+	return cloudstack.NewTemplateService(nil).NewExtractTemplateParams(id, mode)
+}
+
+// ExtractTemplate records the call and calls ExtractTemplateFunc, or ExtractTemplateWithContextFunc if only that is set
+func (m *MockTemplateService) ExtractTemplate(p *cloudstack.ExtractTemplateParams) (r0 *cloudstack.ExtractTemplateResponse, r1 error) {
+	m.record("ExtractTemplate", p)
+	if m.ExtractTemplateFunc != nil {
+		return m.ExtractTemplateFunc(p)
+	}
+	if m.ExtractTemplateWithContextFunc != nil {
+		return m.ExtractTemplateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.ExtractTemplate")
+	return
+}
+
+// ExtractTemplateWithContext records the call and calls ExtractTemplateWithContextFunc, or ExtractTemplateFunc if only that is set
+func (m *MockTemplateService) ExtractTemplateWithContext(ctx context.Context, p *cloudstack.ExtractTemplateParams) (r0 *cloudstack.ExtractTemplateResponse, r1 error) {
+	m.record("ExtractTemplateWithContext", ctx, p)
+	if m.ExtractTemplateWithContextFunc != nil {
+		return m.ExtractTemplateWithContextFunc(ctx, p)
+	}
+	if m.ExtractTemplateFunc != nil {
+		return m.ExtractTemplateFunc(p)
+	}
+	r1 = notMocked("TemplateService.ExtractTemplateWithContext")
+	return
+}
+
+// ExtractTemplateAsync records the call and calls ExtractTemplateAsyncFunc, or ExtractTemplateAsyncWithContextFunc if only that is set
+func (m *MockTemplateService) ExtractTemplateAsync(p *cloudstack.ExtractTemplateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ExtractTemplateAsync", p)
+	if m.ExtractTemplateAsyncFunc != nil {
+		return m.ExtractTemplateAsyncFunc(p)
+	}
+	if m.ExtractTemplateAsyncWithContextFunc != nil {
+		return m.ExtractTemplateAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.ExtractTemplateAsync")
+	return
+}
+
+// ExtractTemplateAsyncWithContext records the call and calls ExtractTemplateAsyncWithContextFunc, or ExtractTemplateAsyncFunc if only that is set
+func (m *MockTemplateService) ExtractTemplateAsyncWithContext(ctx context.Context, p *cloudstack.ExtractTemplateParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ExtractTemplateAsyncWithContext", ctx, p)
+	if m.ExtractTemplateAsyncWithContextFunc != nil {
+		return m.ExtractTemplateAsyncWithContextFunc(ctx, p)
+	}
+	if m.ExtractTemplateAsyncFunc != nil {
+		return m.ExtractTemplateAsyncFunc(p)
+	}
+	r1 = notMocked("TemplateService.ExtractTemplateAsyncWithContext")
+	return
+}
+
+// NewGetUploadParamsForTemplateParams records the call and calls NewGetUploadParamsForTemplateParamsFunc
+func (m *MockTemplateService) NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor string, name string, zoneid string) (r0 *cloudstack.GetUploadParamsForTemplateParams) {
+	m.record("NewGetUploadParamsForTemplateParams", displaytext, format, hypervisor, name, zoneid)
+	if m.NewGetUploadParamsForTemplateParamsFunc != nil {
+		return m.NewGetUploadParamsForTemplateParamsFunc(displaytext, format, hypervisor, name, zoneid)
+	}
+	return cloudstack.NewTemplateService(nil).NewGetUploadParamsForTemplateParams(displaytext, format, hypervisor, name, zoneid)
+}
+
+// GetUploadParamsForTemplate records the call and calls GetUploadParamsForTemplateFunc, or GetUploadParamsForTemplateWithContextFunc if only that is set
+func (m *MockTemplateService) GetUploadParamsForTemplate(p *cloudstack.GetUploadParamsForTemplateParams) (r0 *cloudstack.GetUploadParamsForTemplateResponse, r1 error) {
+	m.record("GetUploadParamsForTemplate", p)
+	if m.GetUploadParamsForTemplateFunc != nil {
+		return m.GetUploadParamsForTemplateFunc(p)
+	}
+	if m.GetUploadParamsForTemplateWithContextFunc != nil {
+		return m.GetUploadParamsForTemplateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.GetUploadParamsForTemplate")
+	return
+}
+
+// GetUploadParamsForTemplateWithContext records the call and calls GetUploadParamsForTemplateWithContextFunc, or GetUploadParamsForTemplateFunc if only that is set
+func (m *MockTemplateService) GetUploadParamsForTemplateWithContext(ctx context.Context, p *cloudstack.GetUploadParamsForTemplateParams) (r0 *cloudstack.GetUploadParamsForTemplateResponse, r1 error) {
+	m.record("GetUploadParamsForTemplateWithContext", ctx, p)
+	if m.GetUploadParamsForTemplateWithContextFunc != nil {
+		return m.GetUploadParamsForTemplateWithContextFunc(ctx, p)
+	}
+	if m.GetUploadParamsForTemplateFunc != nil {
+		return m.GetUploadParamsForTemplateFunc(p)
+	}
+	r1 = notMocked("TemplateService.GetUploadParamsForTemplateWithContext")
+	return
+}
+
+// NewListTemplatePermissionsParams records the call and calls NewListTemplatePermissionsParamsFunc
+func (m *MockTemplateService) NewListTemplatePermissionsParams(id string) (r0 *cloudstack.ListTemplatePermissionsParams) {
+	m.record("NewListTemplatePermissionsParams", id)
+	if m.NewListTemplatePermissionsParamsFunc != nil {
+		return m.NewListTemplatePermissionsParamsFunc(id)
+	}
+	return cloudstack.NewTemplateService(nil).NewListTemplatePermissionsParams(id)
+}
+
+// GetTemplatePermissionByID records the call and calls GetTemplatePermissionByIDFunc, or GetTemplatePermissionByIDWithContextFunc if only that is set
+func (m *MockTemplateService) GetTemplatePermissionByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.TemplatePermission, r1 int, r2 error) {
+	m.record("GetTemplatePermissionByID", id, opts)
+	if m.GetTemplatePermissionByIDFunc != nil {
+		return m.GetTemplatePermissionByIDFunc(id, opts...)
+	}
+	if m.GetTemplatePermissionByIDWithContextFunc != nil {
+		return m.GetTemplatePermissionByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("TemplateService.GetTemplatePermissionByID")
+	return
+}
+
+// GetTemplatePermissionByIDWithContext records the call and calls GetTemplatePermissionByIDWithContextFunc, or GetTemplatePermissionByIDFunc if only that is set
+func (m *MockTemplateService) GetTemplatePermissionByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.TemplatePermission, r1 int, r2 error) {
+	m.record("GetTemplatePermissionByIDWithContext", ctx, id, opts)
+	if m.GetTemplatePermissionByIDWithContextFunc != nil {
+		return m.GetTemplatePermissionByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetTemplatePermissionByIDFunc != nil {
+		return m.GetTemplatePermissionByIDFunc(id, opts...)
+	}
+	r2 = notMocked("TemplateService.GetTemplatePermissionByIDWithContext")
+	return
+}
+
+// ListTemplatePermissions records the call and calls ListTemplatePermissionsFunc, or ListTemplatePermissionsWithContextFunc if only that is set
+func (m *MockTemplateService) ListTemplatePermissions(p *cloudstack.ListTemplatePermissionsParams) (r0 *cloudstack.ListTemplatePermissionsResponse, r1 error) {
+	m.record("ListTemplatePermissions", p)
+	if m.ListTemplatePermissionsFunc != nil {
+		return m.ListTemplatePermissionsFunc(p)
+	}
+	if m.ListTemplatePermissionsWithContextFunc != nil {
+		return m.ListTemplatePermissionsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.ListTemplatePermissions")
+	return
+}
+
+// ListTemplatePermissionsWithContext records the call and calls ListTemplatePermissionsWithContextFunc, or ListTemplatePermissionsFunc if only that is set
+func (m *MockTemplateService) ListTemplatePermissionsWithContext(ctx context.Context, p *cloudstack.ListTemplatePermissionsParams) (r0 *cloudstack.ListTemplatePermissionsResponse, r1 error) {
+	m.record("ListTemplatePermissionsWithContext", ctx, p)
+	if m.ListTemplatePermissionsWithContextFunc != nil {
+		return m.ListTemplatePermissionsWithContextFunc(ctx, p)
+	}
+	if m.ListTemplatePermissionsFunc != nil {
+		return m.ListTemplatePermissionsFunc(p)
+	}
+	r1 = notMocked("TemplateService.ListTemplatePermissionsWithContext")
+	return
+}
+
+// NewListTemplatesParams records the call and calls NewListTemplatesParamsFunc
+func (m *MockTemplateService) NewListTemplatesParams(templatefilter string) (r0 *cloudstack.ListTemplatesParams) {
+	m.record("NewListTemplatesParams", templatefilter)
+	if m.NewListTemplatesParamsFunc != nil {
+		return m.NewListTemplatesParamsFunc(templatefilter)
+	}
+	return cloudstack.NewTemplateService(nil).NewListTemplatesParams(templatefilter)
+}
+
+// GetTemplateID records the call and calls GetTemplateIDFunc, or GetTemplateIDWithContextFunc if only that is set
+func (m *MockTemplateService) GetTemplateID(name string, templatefilter string, zoneid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetTemplateID", name, templatefilter, zoneid, opts)
+	if m.GetTemplateIDFunc != nil {
+		return m.GetTemplateIDFunc(name, templatefilter, zoneid, opts...)
+	}
+	if m.GetTemplateIDWithContextFunc != nil {
+		return m.GetTemplateIDWithContextFunc(context.Background(), name, templatefilter, zoneid, opts...)
+	}
+	r2 = notMocked("TemplateService.GetTemplateID")
+	return
+}
+
+// GetTemplateIDWithContext records the call and calls GetTemplateIDWithContextFunc, or GetTemplateIDFunc if only that is set
+func (m *MockTemplateService) GetTemplateIDWithContext(ctx context.Context, name string, templatefilter string, zoneid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetTemplateIDWithContext", ctx, name, templatefilter, zoneid, opts)
+	if m.GetTemplateIDWithContextFunc != nil {
+		return m.GetTemplateIDWithContextFunc(ctx, name, templatefilter, zoneid, opts...)
+	}
+	if m.GetTemplateIDFunc != nil {
+		return m.GetTemplateIDFunc(name, templatefilter, zoneid, opts...)
+	}
+	r2 = notMocked("TemplateService.GetTemplateIDWithContext")
+	return
+}
+
+// GetTemplateByName records the call and calls GetTemplateByNameFunc, or GetTemplateByNameWithContextFunc if only that is set
+func (m *MockTemplateService) GetTemplateByName(name string, templatefilter string, zoneid string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Template, r1 int, r2 error) {
+	m.record("GetTemplateByName", name, templatefilter, zoneid, opts)
+	if m.GetTemplateByNameFunc != nil {
+		return m.GetTemplateByNameFunc(name, templatefilter, zoneid, opts...)
+	}
+	if m.GetTemplateByNameWithContextFunc != nil {
+		return m.GetTemplateByNameWithContextFunc(context.Background(), name, templatefilter, zoneid, opts...)
+	}
+	r2 = notMocked("TemplateService.GetTemplateByName")
+	return
+}
+
+// GetTemplateByNameWithContext records the call and calls GetTemplateByNameWithContextFunc, or GetTemplateByNameFunc if only that is set
+func (m *MockTemplateService) GetTemplateByNameWithContext(ctx context.Context, name string, templatefilter string, zoneid string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Template, r1 int, r2 error) {
+	m.record("GetTemplateByNameWithContext", ctx, name, templatefilter, zoneid, opts)
+	if m.GetTemplateByNameWithContextFunc != nil {
+		return m.GetTemplateByNameWithContextFunc(ctx, name, templatefilter, zoneid, opts...)
+	}
+	if m.GetTemplateByNameFunc != nil {
+		return m.GetTemplateByNameFunc(name, templatefilter, zoneid, opts...)
+	}
+	r2 = notMocked("TemplateService.GetTemplateByNameWithContext")
+	return
+}
+
+// GetTemplateByID records the call and calls GetTemplateByIDFunc, or GetTemplateByIDWithContextFunc if only that is set
+func (m *MockTemplateService) GetTemplateByID(id string, templatefilter string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Template, r1 int, r2 error) {
+	m.record("GetTemplateByID", id, templatefilter, opts)
+	if m.GetTemplateByIDFunc != nil {
+		return m.GetTemplateByIDFunc(id, templatefilter, opts...)
+	}
+	if m.GetTemplateByIDWithContextFunc != nil {
+		return m.GetTemplateByIDWithContextFunc(context.Background(), id, templatefilter, opts...)
+	}
+	r2 = notMocked("TemplateService.GetTemplateByID")
+	return
+}
+
+// GetTemplateByIDWithContext records the call and calls GetTemplateByIDWithContextFunc, or GetTemplateByIDFunc if only that is set
+func (m *MockTemplateService) GetTemplateByIDWithContext(ctx context.Context, id string, templatefilter string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Template, r1 int, r2 error) {
+	m.record("GetTemplateByIDWithContext", ctx, id, templatefilter, opts)
+	if m.GetTemplateByIDWithContextFunc != nil {
+		return m.GetTemplateByIDWithContextFunc(ctx, id, templatefilter, opts...)
+	}
+	if m.GetTemplateByIDFunc != nil {
+		return m.GetTemplateByIDFunc(id, templatefilter, opts...)
+	}
+	r2 = notMocked("TemplateService.GetTemplateByIDWithContext")
+	return
+}
+
+// ListTemplates records the call and calls ListTemplatesFunc, or ListTemplatesWithContextFunc if only that is set
+func (m *MockTemplateService) ListTemplates(p *cloudstack.ListTemplatesParams) (r0 *cloudstack.ListTemplatesResponse, r1 error) {
+	m.record("ListTemplates", p)
+	if m.ListTemplatesFunc != nil {
+		return m.ListTemplatesFunc(p)
+	}
+	if m.ListTemplatesWithContextFunc != nil {
+		return m.ListTemplatesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.ListTemplates")
+	return
+}
+
+// ListTemplatesWithContext records the call and calls ListTemplatesWithContextFunc, or ListTemplatesFunc if only that is set
+func (m *MockTemplateService) ListTemplatesWithContext(ctx context.Context, p *cloudstack.ListTemplatesParams) (r0 *cloudstack.ListTemplatesResponse, r1 error) {
+	m.record("ListTemplatesWithContext", ctx, p)
+	if m.ListTemplatesWithContextFunc != nil {
+		return m.ListTemplatesWithContextFunc(ctx, p)
+	}
+	if m.ListTemplatesFunc != nil {
+		return m.ListTemplatesFunc(p)
+	}
+	r1 = notMocked("TemplateService.ListTemplatesWithContext")
+	return
+}
+
+// NewListTemplatesPager records the call and calls NewListTemplatesPagerFunc
+func (m *MockTemplateService) NewListTemplatesPager(p *cloudstack.ListTemplatesParams) (r0 *cloudstack.ListTemplatesPager) {
+	m.record("NewListTemplatesPager", p)
+	if m.NewListTemplatesPagerFunc != nil {
+		return m.NewListTemplatesPagerFunc(p)
+	}
+	return
+}
+
+// ListTemplatesAll records the call and calls ListTemplatesAllFunc, or ListTemplatesAllWithContextFunc if only that is set
+func (m *MockTemplateService) ListTemplatesAll(p *cloudstack.ListTemplatesParams) (r0 *cloudstack.ListTemplatesResponse, r1 error) {
+	m.record("ListTemplatesAll", p)
+	if m.ListTemplatesAllFunc != nil {
+		return m.ListTemplatesAllFunc(p)
+	}
+	if m.ListTemplatesAllWithContextFunc != nil {
+		return m.ListTemplatesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.ListTemplatesAll")
+	return
+}
+
+// ListTemplatesAllWithContext records the call and calls ListTemplatesAllWithContextFunc, or ListTemplatesAllFunc if only that is set
+func (m *MockTemplateService) ListTemplatesAllWithContext(ctx context.Context, p *cloudstack.ListTemplatesParams) (r0 *cloudstack.ListTemplatesResponse, r1 error) {
+	m.record("ListTemplatesAllWithContext", ctx, p)
+	if m.ListTemplatesAllWithContextFunc != nil {
+		return m.ListTemplatesAllWithContextFunc(ctx, p)
+	}
+	if m.ListTemplatesAllFunc != nil {
+		return m.ListTemplatesAllFunc(p)
+	}
+	r1 = notMocked("TemplateService.ListTemplatesAllWithContext")
+	return
+}
+
+// NewPrepareTemplateParams records the call and calls NewPrepareTemplateParamsFunc
+func (m *MockTemplateService) NewPrepareTemplateParams(templateid string, zoneid string) (r0 *cloudstack.PrepareTemplateParams) {
+	m.record("NewPrepareTemplateParams", templateid, zoneid)
+	if m.NewPrepareTemplateParamsFunc != nil {
+		return m.NewPrepareTemplateParamsFunc(templateid, zoneid)
+	}
+	return cloudstack.NewTemplateService(nil).NewPrepareTemplateParams(templateid, zoneid)
+}
+
+// PrepareTemplate records the call and calls PrepareTemplateFunc, or PrepareTemplateWithContextFunc if only that is set
+func (m *MockTemplateService) PrepareTemplate(p *cloudstack.PrepareTemplateParams) (r0 *cloudstack.PrepareTemplateResponse, r1 error) {
+	m.record("PrepareTemplate", p)
+	if m.PrepareTemplateFunc != nil {
+		return m.PrepareTemplateFunc(p)
+	}
+	if m.PrepareTemplateWithContextFunc != nil {
+		return m.PrepareTemplateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.PrepareTemplate")
+	return
+}
+
+// PrepareTemplateWithContext records the call and calls PrepareTemplateWithContextFunc, or PrepareTemplateFunc if only that is set
+func (m *MockTemplateService) PrepareTemplateWithContext(ctx context.Context, p *cloudstack.PrepareTemplateParams) (r0 *cloudstack.PrepareTemplateResponse, r1 error) {
+	m.record("PrepareTemplateWithContext", ctx, p)
+	if m.PrepareTemplateWithContextFunc != nil {
+		return m.PrepareTemplateWithContextFunc(ctx, p)
+	}
+	if m.PrepareTemplateFunc != nil {
+		return m.PrepareTemplateFunc(p)
+	}
+	r1 = notMocked("TemplateService.PrepareTemplateWithContext")
+	return
+}
+
+// NewRegisterTemplateParams records the call and calls NewRegisterTemplateParamsFunc
+func (m *MockTemplateService) NewRegisterTemplateParams(displaytext string, format string, hypervisor string, name string, url string) (r0 *cloudstack.RegisterTemplateParams) {
+	m.record("NewRegisterTemplateParams", displaytext, format, hypervisor, name, url)
+	if m.NewRegisterTemplateParamsFunc != nil {
+		return m.NewRegisterTemplateParamsFunc(displaytext, format, hypervisor, name, url)
+	}
+	return cloudstack.NewTemplateService(nil).NewRegisterTemplateParams(displaytext, format, hypervisor, name, url)
+}
+
+// RegisterTemplate records the call and calls RegisterTemplateFunc, or RegisterTemplateWithContextFunc if only that is set
+func (m *MockTemplateService) RegisterTemplate(p *cloudstack.RegisterTemplateParams) (r0 *cloudstack.RegisterTemplateResponse, r1 error) {
+	m.record("RegisterTemplate", p)
+	if m.RegisterTemplateFunc != nil {
+		return m.RegisterTemplateFunc(p)
+	}
+	if m.RegisterTemplateWithContextFunc != nil {
+		return m.RegisterTemplateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.RegisterTemplate")
+	return
+}
+
+// RegisterTemplateWithContext records the call and calls RegisterTemplateWithContextFunc, or RegisterTemplateFunc if only that is set
+func (m *MockTemplateService) RegisterTemplateWithContext(ctx context.Context, p *cloudstack.RegisterTemplateParams) (r0 *cloudstack.RegisterTemplateResponse, r1 error) {
+	m.record("RegisterTemplateWithContext", ctx, p)
+	if m.RegisterTemplateWithContextFunc != nil {
+		return m.RegisterTemplateWithContextFunc(ctx, p)
+	}
+	if m.RegisterTemplateFunc != nil {
+		return m.RegisterTemplateFunc(p)
+	}
+	r1 = notMocked("TemplateService.RegisterTemplateWithContext")
+	return
+}
+
+// NewUpdateTemplateParams records the call and calls NewUpdateTemplateParamsFunc
+func (m *MockTemplateService) NewUpdateTemplateParams(id string) (r0 *cloudstack.UpdateTemplateParams) {
+	m.record("NewUpdateTemplateParams", id)
+	if m.NewUpdateTemplateParamsFunc != nil {
+		return m.NewUpdateTemplateParamsFunc(id)
+	}
+	return cloudstack.NewTemplateService(nil).NewUpdateTemplateParams(id)
+}
+
+// UpdateTemplate records the call and calls UpdateTemplateFunc, or UpdateTemplateWithContextFunc if only that is set
+func (m *MockTemplateService) UpdateTemplate(p *cloudstack.UpdateTemplateParams) (r0 *cloudstack.UpdateTemplateResponse, r1 error) {
+	m.record("UpdateTemplate", p)
+	if m.UpdateTemplateFunc != nil {
+		return m.UpdateTemplateFunc(p)
+	}
+	if m.UpdateTemplateWithContextFunc != nil {
+		return m.UpdateTemplateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.UpdateTemplate")
+	return
+}
+
+// UpdateTemplateWithContext records the call and calls UpdateTemplateWithContextFunc, or UpdateTemplateFunc if only that is set
+func (m *MockTemplateService) UpdateTemplateWithContext(ctx context.Context, p *cloudstack.UpdateTemplateParams) (r0 *cloudstack.UpdateTemplateResponse, r1 error) {
+	m.record("UpdateTemplateWithContext", ctx, p)
+	if m.UpdateTemplateWithContextFunc != nil {
+		return m.UpdateTemplateWithContextFunc(ctx, p)
+	}
+	if m.UpdateTemplateFunc != nil {
+		return m.UpdateTemplateFunc(p)
+	}
+	r1 = notMocked("TemplateService.UpdateTemplateWithContext")
+	return
+}
+
+// NewUpdateTemplatePermissionsParams records the call and calls NewUpdateTemplatePermissionsParamsFunc
+func (m *MockTemplateService) NewUpdateTemplatePermissionsParams(id string) (r0 *cloudstack.UpdateTemplatePermissionsParams) {
+	m.record("NewUpdateTemplatePermissionsParams", id)
+	if m.NewUpdateTemplatePermissionsParamsFunc != nil {
+		return m.NewUpdateTemplatePermissionsParamsFunc(id)
+	}
+	return cloudstack.NewTemplateService(nil).NewUpdateTemplatePermissionsParams(id)
+}
+
+// UpdateTemplatePermissions records the call and calls UpdateTemplatePermissionsFunc, or UpdateTemplatePermissionsWithContextFunc if only that is set
+func (m *MockTemplateService) UpdateTemplatePermissions(p *cloudstack.UpdateTemplatePermissionsParams) (r0 *cloudstack.UpdateTemplatePermissionsResponse, r1 error) {
+	m.record("UpdateTemplatePermissions", p)
+	if m.UpdateTemplatePermissionsFunc != nil {
+		return m.UpdateTemplatePermissionsFunc(p)
+	}
+	if m.UpdateTemplatePermissionsWithContextFunc != nil {
+		return m.UpdateTemplatePermissionsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.UpdateTemplatePermissions")
+	return
+}
+
+// UpdateTemplatePermissionsWithContext records the call and calls UpdateTemplatePermissionsWithContextFunc, or UpdateTemplatePermissionsFunc if only that is set
+func (m *MockTemplateService) UpdateTemplatePermissionsWithContext(ctx context.Context, p *cloudstack.UpdateTemplatePermissionsParams) (r0 *cloudstack.UpdateTemplatePermissionsResponse, r1 error) {
+	m.record("UpdateTemplatePermissionsWithContext", ctx, p)
+	if m.UpdateTemplatePermissionsWithContextFunc != nil {
+		return m.UpdateTemplatePermissionsWithContextFunc(ctx, p)
+	}
+	if m.UpdateTemplatePermissionsFunc != nil {
+		return m.UpdateTemplatePermissionsFunc(p)
+	}
+	r1 = notMocked("TemplateService.UpdateTemplatePermissionsWithContext")
+	return
+}
+
+// NewUpgradeRouterTemplateParams records the call and calls NewUpgradeRouterTemplateParamsFunc
+func (m *MockTemplateService) NewUpgradeRouterTemplateParams() (r0 *cloudstack.UpgradeRouterTemplateParams) {
+	m.record("NewUpgradeRouterTemplateParams")
+	if m.NewUpgradeRouterTemplateParamsFunc != nil {
+		return m.NewUpgradeRouterTemplateParamsFunc()
+	}
+	return cloudstack.NewTemplateService(nil).NewUpgradeRouterTemplateParams()
+}
+
+// UpgradeRouterTemplate records the call and calls UpgradeRouterTemplateFunc, or UpgradeRouterTemplateWithContextFunc if only that is set
+func (m *MockTemplateService) UpgradeRouterTemplate(p *cloudstack.UpgradeRouterTemplateParams) (r0 *cloudstack.UpgradeRouterTemplateResponse, r1 error) {
+	m.record("UpgradeRouterTemplate", p)
+	if m.UpgradeRouterTemplateFunc != nil {
+		return m.UpgradeRouterTemplateFunc(p)
+	}
+	if m.UpgradeRouterTemplateWithContextFunc != nil {
+		return m.UpgradeRouterTemplateWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("TemplateService.UpgradeRouterTemplate")
+	return
+}
+
+// UpgradeRouterTemplateWithContext records the call and calls UpgradeRouterTemplateWithContextFunc, or UpgradeRouterTemplateFunc if only that is set
+func (m *MockTemplateService) UpgradeRouterTemplateWithContext(ctx context.Context, p *cloudstack.UpgradeRouterTemplateParams) (r0 *cloudstack.UpgradeRouterTemplateResponse, r1 error) {
+	m.record("UpgradeRouterTemplateWithContext", ctx, p)
+	if m.UpgradeRouterTemplateWithContextFunc != nil {
+		return m.UpgradeRouterTemplateWithContextFunc(ctx, p)
+	}
+	if m.UpgradeRouterTemplateFunc != nil {
+		return m.UpgradeRouterTemplateFunc(p)
+	}
+	r1 = notMocked("TemplateService.UpgradeRouterTemplateWithContext")
+	return
+}
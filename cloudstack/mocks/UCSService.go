@@ -0,0 +1,493 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockUCSService is a mock of cloudstack.UCSServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockUCSService struct {
+	Recorder
+
+	NewAddUcsManagerParamsFunc                     func(password string, url string, username string, zoneid string) *cloudstack.AddUcsManagerParams
+	AddUcsManagerFunc                              func(p *cloudstack.AddUcsManagerParams) (*cloudstack.AddUcsManagerResponse, error)
+	AddUcsManagerWithContextFunc                   func(ctx context.Context, p *cloudstack.AddUcsManagerParams) (*cloudstack.AddUcsManagerResponse, error)
+	NewAssociateUcsProfileToBladeParamsFunc        func(bladeid string, profiledn string, ucsmanagerid string) *cloudstack.AssociateUcsProfileToBladeParams
+	AssociateUcsProfileToBladeFunc                 func(p *cloudstack.AssociateUcsProfileToBladeParams) (*cloudstack.AssociateUcsProfileToBladeResponse, error)
+	AssociateUcsProfileToBladeWithContextFunc      func(ctx context.Context, p *cloudstack.AssociateUcsProfileToBladeParams) (*cloudstack.AssociateUcsProfileToBladeResponse, error)
+	AssociateUcsProfileToBladeAsyncFunc            func(p *cloudstack.AssociateUcsProfileToBladeParams) (*cloudstack.Job, error)
+	AssociateUcsProfileToBladeAsyncWithContextFunc func(ctx context.Context, p *cloudstack.AssociateUcsProfileToBladeParams) (*cloudstack.Job, error)
+	NewDeleteUcsManagerParamsFunc                  func(ucsmanagerid string) *cloudstack.DeleteUcsManagerParams
+	DeleteUcsManagerFunc                           func(p *cloudstack.DeleteUcsManagerParams) (*cloudstack.DeleteUcsManagerResponse, error)
+	DeleteUcsManagerWithContextFunc                func(ctx context.Context, p *cloudstack.DeleteUcsManagerParams) (*cloudstack.DeleteUcsManagerResponse, error)
+	NewListUcsBladesParamsFunc                     func(ucsmanagerid string) *cloudstack.ListUcsBladesParams
+	ListUcsBladesFunc                              func(p *cloudstack.ListUcsBladesParams) (*cloudstack.ListUcsBladesResponse, error)
+	ListUcsBladesWithContextFunc                   func(ctx context.Context, p *cloudstack.ListUcsBladesParams) (*cloudstack.ListUcsBladesResponse, error)
+	NewListUcsBladesPagerFunc                      func(p *cloudstack.ListUcsBladesParams) *cloudstack.ListUcsBladesPager
+	ListUcsBladesAllFunc                           func(p *cloudstack.ListUcsBladesParams) (*cloudstack.ListUcsBladesResponse, error)
+	ListUcsBladesAllWithContextFunc                func(ctx context.Context, p *cloudstack.ListUcsBladesParams) (*cloudstack.ListUcsBladesResponse, error)
+	NewListUcsManagersParamsFunc                   func() *cloudstack.ListUcsManagersParams
+	GetUcsManagerIDFunc                            func(keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetUcsManagerIDWithContextFunc                 func(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetUcsManagerByNameFunc                        func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.UcsManager, int, error)
+	GetUcsManagerByNameWithContextFunc             func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.UcsManager, int, error)
+	GetUcsManagerByIDFunc                          func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.UcsManager, int, error)
+	GetUcsManagerByIDWithContextFunc               func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.UcsManager, int, error)
+	ListUcsManagersFunc                            func(p *cloudstack.ListUcsManagersParams) (*cloudstack.ListUcsManagersResponse, error)
+	ListUcsManagersWithContextFunc                 func(ctx context.Context, p *cloudstack.ListUcsManagersParams) (*cloudstack.ListUcsManagersResponse, error)
+	NewListUcsManagersPagerFunc                    func(p *cloudstack.ListUcsManagersParams) *cloudstack.ListUcsManagersPager
+	ListUcsManagersAllFunc                         func(p *cloudstack.ListUcsManagersParams) (*cloudstack.ListUcsManagersResponse, error)
+	ListUcsManagersAllWithContextFunc              func(ctx context.Context, p *cloudstack.ListUcsManagersParams) (*cloudstack.ListUcsManagersResponse, error)
+	NewListUcsProfilesParamsFunc                   func(ucsmanagerid string) *cloudstack.ListUcsProfilesParams
+	ListUcsProfilesFunc                            func(p *cloudstack.ListUcsProfilesParams) (*cloudstack.ListUcsProfilesResponse, error)
+	ListUcsProfilesWithContextFunc                 func(ctx context.Context, p *cloudstack.ListUcsProfilesParams) (*cloudstack.ListUcsProfilesResponse, error)
+	NewListUcsProfilesPagerFunc                    func(p *cloudstack.ListUcsProfilesParams) *cloudstack.ListUcsProfilesPager
+	ListUcsProfilesAllFunc                         func(p *cloudstack.ListUcsProfilesParams) (*cloudstack.ListUcsProfilesResponse, error)
+	ListUcsProfilesAllWithContextFunc              func(ctx context.Context, p *cloudstack.ListUcsProfilesParams) (*cloudstack.ListUcsProfilesResponse, error)
+}
+
+var _ cloudstack.UCSServiceIface = (*MockUCSService)(nil)
+
+// NewAddUcsManagerParams records the call and calls NewAddUcsManagerParamsFunc
+func (m *MockUCSService) NewAddUcsManagerParams(password string, url string, username string, zoneid string) (r0 *cloudstack.AddUcsManagerParams) {
+	m.record("NewAddUcsManagerParams", password, url, username, zoneid)
+	if m.NewAddUcsManagerParamsFunc != nil {
+		return m.NewAddUcsManagerParamsFunc(password, url, username, zoneid)
+	}
+	return cloudstack.NewUCSService(nil).NewAddUcsManagerParams(password, url, username, zoneid)
+}
+
+// AddUcsManager records the call and calls AddUcsManagerFunc, or AddUcsManagerWithContextFunc if only that is set
+func (m *MockUCSService) AddUcsManager(p *cloudstack.AddUcsManagerParams) (r0 *cloudstack.AddUcsManagerResponse, r1 error) {
+	m.record("AddUcsManager", p)
+	if m.AddUcsManagerFunc != nil {
+		return m.AddUcsManagerFunc(p)
+	}
+	if m.AddUcsManagerWithContextFunc != nil {
+		return m.AddUcsManagerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UCSService.AddUcsManager")
+	return
+}
+
+// AddUcsManagerWithContext records the call and calls AddUcsManagerWithContextFunc, or AddUcsManagerFunc if only that is set
+func (m *MockUCSService) AddUcsManagerWithContext(ctx context.Context, p *cloudstack.AddUcsManagerParams) (r0 *cloudstack.AddUcsManagerResponse, r1 error) {
+	m.record("AddUcsManagerWithContext", ctx, p)
+	if m.AddUcsManagerWithContextFunc != nil {
+		return m.AddUcsManagerWithContextFunc(ctx, p)
+	}
+	if m.AddUcsManagerFunc != nil {
+		return m.AddUcsManagerFunc(p)
+	}
+	r1 = notMocked("UCSService.AddUcsManagerWithContext")
+	return
+}
+
+// NewAssociateUcsProfileToBladeParams records the call and calls NewAssociateUcsProfileToBladeParamsFunc
+func (m *MockUCSService) NewAssociateUcsProfileToBladeParams(bladeid string, profiledn string, ucsmanagerid string) (r0 *cloudstack.AssociateUcsProfileToBladeParams) {
+	m.record("NewAssociateUcsProfileToBladeParams", bladeid, profiledn, ucsmanagerid)
+	if m.NewAssociateUcsProfileToBladeParamsFunc != nil {
+		return m.NewAssociateUcsProfileToBladeParamsFunc(bladeid, profiledn, ucsmanagerid)
+	}
+	return cloudstack.NewUCSService(nil).NewAssociateUcsProfileToBladeParams(bladeid, profiledn, ucsmanagerid)
+}
+
+// AssociateUcsProfileToBlade records the call and calls AssociateUcsProfileToBladeFunc, or AssociateUcsProfileToBladeWithContextFunc if only that is set
+func (m *MockUCSService) AssociateUcsProfileToBlade(p *cloudstack.AssociateUcsProfileToBladeParams) (r0 *cloudstack.AssociateUcsProfileToBladeResponse, r1 error) {
+	m.record("AssociateUcsProfileToBlade", p)
+	if m.AssociateUcsProfileToBladeFunc != nil {
+		return m.AssociateUcsProfileToBladeFunc(p)
+	}
+	if m.AssociateUcsProfileToBladeWithContextFunc != nil {
+		return m.AssociateUcsProfileToBladeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UCSService.AssociateUcsProfileToBlade")
+	return
+}
+
+// AssociateUcsProfileToBladeWithContext records the call and calls AssociateUcsProfileToBladeWithContextFunc, or AssociateUcsProfileToBladeFunc if only that is set
+func (m *MockUCSService) AssociateUcsProfileToBladeWithContext(ctx context.Context, p *cloudstack.AssociateUcsProfileToBladeParams) (r0 *cloudstack.AssociateUcsProfileToBladeResponse, r1 error) {
+	m.record("AssociateUcsProfileToBladeWithContext", ctx, p)
+	if m.AssociateUcsProfileToBladeWithContextFunc != nil {
+		return m.AssociateUcsProfileToBladeWithContextFunc(ctx, p)
+	}
+	if m.AssociateUcsProfileToBladeFunc != nil {
+		return m.AssociateUcsProfileToBladeFunc(p)
+	}
+	r1 = notMocked("UCSService.AssociateUcsProfileToBladeWithContext")
+	return
+}
+
+// AssociateUcsProfileToBladeAsync records the call and calls AssociateUcsProfileToBladeAsyncFunc, or AssociateUcsProfileToBladeAsyncWithContextFunc if only that is set
+func (m *MockUCSService) AssociateUcsProfileToBladeAsync(p *cloudstack.AssociateUcsProfileToBladeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AssociateUcsProfileToBladeAsync", p)
+	if m.AssociateUcsProfileToBladeAsyncFunc != nil {
+		return m.AssociateUcsProfileToBladeAsyncFunc(p)
+	}
+	if m.AssociateUcsProfileToBladeAsyncWithContextFunc != nil {
+		return m.AssociateUcsProfileToBladeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UCSService.AssociateUcsProfileToBladeAsync")
+	return
+}
+
+// AssociateUcsProfileToBladeAsyncWithContext records the call and calls AssociateUcsProfileToBladeAsyncWithContextFunc, or AssociateUcsProfileToBladeAsyncFunc if only that is set
+func (m *MockUCSService) AssociateUcsProfileToBladeAsyncWithContext(ctx context.Context, p *cloudstack.AssociateUcsProfileToBladeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AssociateUcsProfileToBladeAsyncWithContext", ctx, p)
+	if m.AssociateUcsProfileToBladeAsyncWithContextFunc != nil {
+		return m.AssociateUcsProfileToBladeAsyncWithContextFunc(ctx, p)
+	}
+	if m.AssociateUcsProfileToBladeAsyncFunc != nil {
+		return m.AssociateUcsProfileToBladeAsyncFunc(p)
+	}
+	r1 = notMocked("UCSService.AssociateUcsProfileToBladeAsyncWithContext")
+	return
+}
+
+// NewDeleteUcsManagerParams records the call and calls NewDeleteUcsManagerParamsFunc
+func (m *MockUCSService) NewDeleteUcsManagerParams(ucsmanagerid string) (r0 *cloudstack.DeleteUcsManagerParams) {
+	m.record("NewDeleteUcsManagerParams", ucsmanagerid)
+	if m.NewDeleteUcsManagerParamsFunc != nil {
+		return m.NewDeleteUcsManagerParamsFunc(ucsmanagerid)
+	}
+	return cloudstack.NewUCSService(nil).NewDeleteUcsManagerParams(ucsmanagerid)
+}
+
+// DeleteUcsManager records the call and calls DeleteUcsManagerFunc, or DeleteUcsManagerWithContextFunc if only that is set
+func (m *MockUCSService) DeleteUcsManager(p *cloudstack.DeleteUcsManagerParams) (r0 *cloudstack.DeleteUcsManagerResponse, r1 error) {
+	m.record("DeleteUcsManager", p)
+	if m.DeleteUcsManagerFunc != nil {
+		return m.DeleteUcsManagerFunc(p)
+	}
+	if m.DeleteUcsManagerWithContextFunc != nil {
+		return m.DeleteUcsManagerWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UCSService.DeleteUcsManager")
+	return
+}
+
+// DeleteUcsManagerWithContext records the call and calls DeleteUcsManagerWithContextFunc, or DeleteUcsManagerFunc if only that is set
+func (m *MockUCSService) DeleteUcsManagerWithContext(ctx context.Context, p *cloudstack.DeleteUcsManagerParams) (r0 *cloudstack.DeleteUcsManagerResponse, r1 error) {
+	m.record("DeleteUcsManagerWithContext", ctx, p)
+	if m.DeleteUcsManagerWithContextFunc != nil {
+		return m.DeleteUcsManagerWithContextFunc(ctx, p)
+	}
+	if m.DeleteUcsManagerFunc != nil {
+		return m.DeleteUcsManagerFunc(p)
+	}
+	r1 = notMocked("UCSService.DeleteUcsManagerWithContext")
+	return
+}
+
+// NewListUcsBladesParams records the call and calls NewListUcsBladesParamsFunc
+func (m *MockUCSService) NewListUcsBladesParams(ucsmanagerid string) (r0 *cloudstack.ListUcsBladesParams) {
+	m.record("NewListUcsBladesParams", ucsmanagerid)
+	if m.NewListUcsBladesParamsFunc != nil {
+		return m.NewListUcsBladesParamsFunc(ucsmanagerid)
+	}
+	return cloudstack.NewUCSService(nil).NewListUcsBladesParams(ucsmanagerid)
+}
+
+// ListUcsBlades records the call and calls ListUcsBladesFunc, or ListUcsBladesWithContextFunc if only that is set
+func (m *MockUCSService) ListUcsBlades(p *cloudstack.ListUcsBladesParams) (r0 *cloudstack.ListUcsBladesResponse, r1 error) {
+	m.record("ListUcsBlades", p)
+	if m.ListUcsBladesFunc != nil {
+		return m.ListUcsBladesFunc(p)
+	}
+	if m.ListUcsBladesWithContextFunc != nil {
+		return m.ListUcsBladesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UCSService.ListUcsBlades")
+	return
+}
+
+// ListUcsBladesWithContext records the call and calls ListUcsBladesWithContextFunc, or ListUcsBladesFunc if only that is set
+func (m *MockUCSService) ListUcsBladesWithContext(ctx context.Context, p *cloudstack.ListUcsBladesParams) (r0 *cloudstack.ListUcsBladesResponse, r1 error) {
+	m.record("ListUcsBladesWithContext", ctx, p)
+	if m.ListUcsBladesWithContextFunc != nil {
+		return m.ListUcsBladesWithContextFunc(ctx, p)
+	}
+	if m.ListUcsBladesFunc != nil {
+		return m.ListUcsBladesFunc(p)
+	}
+	r1 = notMocked("UCSService.ListUcsBladesWithContext")
+	return
+}
+
+// NewListUcsBladesPager records the call and calls NewListUcsBladesPagerFunc
+func (m *MockUCSService) NewListUcsBladesPager(p *cloudstack.ListUcsBladesParams) (r0 *cloudstack.ListUcsBladesPager) {
+	m.record("NewListUcsBladesPager", p)
+	if m.NewListUcsBladesPagerFunc != nil {
+		return m.NewListUcsBladesPagerFunc(p)
+	}
+	return
+}
+
+// ListUcsBladesAll records the call and calls ListUcsBladesAllFunc, or ListUcsBladesAllWithContextFunc if only that is set
+func (m *MockUCSService) ListUcsBladesAll(p *cloudstack.ListUcsBladesParams) (r0 *cloudstack.ListUcsBladesResponse, r1 error) {
+	m.record("ListUcsBladesAll", p)
+	if m.ListUcsBladesAllFunc != nil {
+		return m.ListUcsBladesAllFunc(p)
+	}
+	if m.ListUcsBladesAllWithContextFunc != nil {
+		return m.ListUcsBladesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UCSService.ListUcsBladesAll")
+	return
+}
+
+// ListUcsBladesAllWithContext records the call and calls ListUcsBladesAllWithContextFunc, or ListUcsBladesAllFunc if only that is set
+func (m *MockUCSService) ListUcsBladesAllWithContext(ctx context.Context, p *cloudstack.ListUcsBladesParams) (r0 *cloudstack.ListUcsBladesResponse, r1 error) {
+	m.record("ListUcsBladesAllWithContext", ctx, p)
+	if m.ListUcsBladesAllWithContextFunc != nil {
+		return m.ListUcsBladesAllWithContextFunc(ctx, p)
+	}
+	if m.ListUcsBladesAllFunc != nil {
+		return m.ListUcsBladesAllFunc(p)
+	}
+	r1 = notMocked("UCSService.ListUcsBladesAllWithContext")
+	return
+}
+
+// NewListUcsManagersParams records the call and calls NewListUcsManagersParamsFunc
+func (m *MockUCSService) NewListUcsManagersParams() (r0 *cloudstack.ListUcsManagersParams) {
+	m.record("NewListUcsManagersParams")
+	if m.NewListUcsManagersParamsFunc != nil {
+		return m.NewListUcsManagersParamsFunc()
+	}
+	return cloudstack.NewUCSService(nil).NewListUcsManagersParams()
+}
+
+// GetUcsManagerID records the call and calls GetUcsManagerIDFunc, or GetUcsManagerIDWithContextFunc if only that is set
+func (m *MockUCSService) GetUcsManagerID(keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetUcsManagerID", keyword, opts)
+	if m.GetUcsManagerIDFunc != nil {
+		return m.GetUcsManagerIDFunc(keyword, opts...)
+	}
+	if m.GetUcsManagerIDWithContextFunc != nil {
+		return m.GetUcsManagerIDWithContextFunc(context.Background(), keyword, opts...)
+	}
+	r2 = notMocked("UCSService.GetUcsManagerID")
+	return
+}
+
+// GetUcsManagerIDWithContext records the call and calls GetUcsManagerIDWithContextFunc, or GetUcsManagerIDFunc if only that is set
+func (m *MockUCSService) GetUcsManagerIDWithContext(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetUcsManagerIDWithContext", ctx, keyword, opts)
+	if m.GetUcsManagerIDWithContextFunc != nil {
+		return m.GetUcsManagerIDWithContextFunc(ctx, keyword, opts...)
+	}
+	if m.GetUcsManagerIDFunc != nil {
+		return m.GetUcsManagerIDFunc(keyword, opts...)
+	}
+	r2 = notMocked("UCSService.GetUcsManagerIDWithContext")
+	return
+}
+
+// GetUcsManagerByName records the call and calls GetUcsManagerByNameFunc, or GetUcsManagerByNameWithContextFunc if only that is set
+func (m *MockUCSService) GetUcsManagerByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.UcsManager, r1 int, r2 error) {
+	m.record("GetUcsManagerByName", name, opts)
+	if m.GetUcsManagerByNameFunc != nil {
+		return m.GetUcsManagerByNameFunc(name, opts...)
+	}
+	if m.GetUcsManagerByNameWithContextFunc != nil {
+		return m.GetUcsManagerByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("UCSService.GetUcsManagerByName")
+	return
+}
+
+// GetUcsManagerByNameWithContext records the call and calls GetUcsManagerByNameWithContextFunc, or GetUcsManagerByNameFunc if only that is set
+func (m *MockUCSService) GetUcsManagerByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.UcsManager, r1 int, r2 error) {
+	m.record("GetUcsManagerByNameWithContext", ctx, name, opts)
+	if m.GetUcsManagerByNameWithContextFunc != nil {
+		return m.GetUcsManagerByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetUcsManagerByNameFunc != nil {
+		return m.GetUcsManagerByNameFunc(name, opts...)
+	}
+	r2 = notMocked("UCSService.GetUcsManagerByNameWithContext")
+	return
+}
+
+// GetUcsManagerByID records the call and calls GetUcsManagerByIDFunc, or GetUcsManagerByIDWithContextFunc if only that is set
+func (m *MockUCSService) GetUcsManagerByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.UcsManager, r1 int, r2 error) {
+	m.record("GetUcsManagerByID", id, opts)
+	if m.GetUcsManagerByIDFunc != nil {
+		return m.GetUcsManagerByIDFunc(id, opts...)
+	}
+	if m.GetUcsManagerByIDWithContextFunc != nil {
+		return m.GetUcsManagerByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("UCSService.GetUcsManagerByID")
+	return
+}
+
+// GetUcsManagerByIDWithContext records the call and calls GetUcsManagerByIDWithContextFunc, or GetUcsManagerByIDFunc if only that is set
+func (m *MockUCSService) GetUcsManagerByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.UcsManager, r1 int, r2 error) {
+	m.record("GetUcsManagerByIDWithContext", ctx, id, opts)
+	if m.GetUcsManagerByIDWithContextFunc != nil {
+		return m.GetUcsManagerByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetUcsManagerByIDFunc != nil {
+		return m.GetUcsManagerByIDFunc(id, opts...)
+	}
+	r2 = notMocked("UCSService.GetUcsManagerByIDWithContext")
+	return
+}
+
+// ListUcsManagers records the call and calls ListUcsManagersFunc, or ListUcsManagersWithContextFunc if only that is set
+func (m *MockUCSService) ListUcsManagers(p *cloudstack.ListUcsManagersParams) (r0 *cloudstack.ListUcsManagersResponse, r1 error) {
+	m.record("ListUcsManagers", p)
+	if m.ListUcsManagersFunc != nil {
+		return m.ListUcsManagersFunc(p)
+	}
+	if m.ListUcsManagersWithContextFunc != nil {
+		return m.ListUcsManagersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UCSService.ListUcsManagers")
+	return
+}
+
+// ListUcsManagersWithContext records the call and calls ListUcsManagersWithContextFunc, or ListUcsManagersFunc if only that is set
+func (m *MockUCSService) ListUcsManagersWithContext(ctx context.Context, p *cloudstack.ListUcsManagersParams) (r0 *cloudstack.ListUcsManagersResponse, r1 error) {
+	m.record("ListUcsManagersWithContext", ctx, p)
+	if m.ListUcsManagersWithContextFunc != nil {
+		return m.ListUcsManagersWithContextFunc(ctx, p)
+	}
+	if m.ListUcsManagersFunc != nil {
+		return m.ListUcsManagersFunc(p)
+	}
+	r1 = notMocked("UCSService.ListUcsManagersWithContext")
+	return
+}
+
+// NewListUcsManagersPager records the call and calls NewListUcsManagersPagerFunc
+func (m *MockUCSService) NewListUcsManagersPager(p *cloudstack.ListUcsManagersParams) (r0 *cloudstack.ListUcsManagersPager) {
+	m.record("NewListUcsManagersPager", p)
+	if m.NewListUcsManagersPagerFunc != nil {
+		return m.NewListUcsManagersPagerFunc(p)
+	}
+	return
+}
+
+// ListUcsManagersAll records the call and calls ListUcsManagersAllFunc, or ListUcsManagersAllWithContextFunc if only that is set
+func (m *MockUCSService) ListUcsManagersAll(p *cloudstack.ListUcsManagersParams) (r0 *cloudstack.ListUcsManagersResponse, r1 error) {
+	m.record("ListUcsManagersAll", p)
+	if m.ListUcsManagersAllFunc != nil {
+		return m.ListUcsManagersAllFunc(p)
+	}
+	if m.ListUcsManagersAllWithContextFunc != nil {
+		return m.ListUcsManagersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UCSService.ListUcsManagersAll")
+	return
+}
+
+// ListUcsManagersAllWithContext records the call and calls ListUcsManagersAllWithContextFunc, or ListUcsManagersAllFunc if only that is set
+func (m *MockUCSService) ListUcsManagersAllWithContext(ctx context.Context, p *cloudstack.ListUcsManagersParams) (r0 *cloudstack.ListUcsManagersResponse, r1 error) {
+	m.record("ListUcsManagersAllWithContext", ctx, p)
+	if m.ListUcsManagersAllWithContextFunc != nil {
+		return m.ListUcsManagersAllWithContextFunc(ctx, p)
+	}
+	if m.ListUcsManagersAllFunc != nil {
+		return m.ListUcsManagersAllFunc(p)
+	}
+	r1 = notMocked("UCSService.ListUcsManagersAllWithContext")
+	return
+}
+
+// NewListUcsProfilesParams records the call and calls NewListUcsProfilesParamsFunc
+func (m *MockUCSService) NewListUcsProfilesParams(ucsmanagerid string) (r0 *cloudstack.ListUcsProfilesParams) {
+	m.record("NewListUcsProfilesParams", ucsmanagerid)
+	if m.NewListUcsProfilesParamsFunc != nil {
+		return m.NewListUcsProfilesParamsFunc(ucsmanagerid)
+	}
+	return cloudstack.NewUCSService(nil).NewListUcsProfilesParams(ucsmanagerid)
+}
+
+// ListUcsProfiles records the call and calls ListUcsProfilesFunc, or ListUcsProfilesWithContextFunc if only that is set
+func (m *MockUCSService) ListUcsProfiles(p *cloudstack.ListUcsProfilesParams) (r0 *cloudstack.ListUcsProfilesResponse, r1 error) {
+	m.record("ListUcsProfiles", p)
+	if m.ListUcsProfilesFunc != nil {
+		return m.ListUcsProfilesFunc(p)
+	}
+	if m.ListUcsProfilesWithContextFunc != nil {
+		return m.ListUcsProfilesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UCSService.ListUcsProfiles")
+	return
+}
+
+// ListUcsProfilesWithContext records the call and calls ListUcsProfilesWithContextFunc, or ListUcsProfilesFunc if only that is set
+func (m *MockUCSService) ListUcsProfilesWithContext(ctx context.Context, p *cloudstack.ListUcsProfilesParams) (r0 *cloudstack.ListUcsProfilesResponse, r1 error) {
+	m.record("ListUcsProfilesWithContext", ctx, p)
+	if m.ListUcsProfilesWithContextFunc != nil {
+		return m.ListUcsProfilesWithContextFunc(ctx, p)
+	}
+	if m.ListUcsProfilesFunc != nil {
+		return m.ListUcsProfilesFunc(p)
+	}
+	r1 = notMocked("UCSService.ListUcsProfilesWithContext")
+	return
+}
+
+// NewListUcsProfilesPager records the call and calls NewListUcsProfilesPagerFunc
+func (m *MockUCSService) NewListUcsProfilesPager(p *cloudstack.ListUcsProfilesParams) (r0 *cloudstack.ListUcsProfilesPager) {
+	m.record("NewListUcsProfilesPager", p)
+	if m.NewListUcsProfilesPagerFunc != nil {
+		return m.NewListUcsProfilesPagerFunc(p)
+	}
+	return
+}
+
+// ListUcsProfilesAll records the call and calls ListUcsProfilesAllFunc, or ListUcsProfilesAllWithContextFunc if only that is set
+func (m *MockUCSService) ListUcsProfilesAll(p *cloudstack.ListUcsProfilesParams) (r0 *cloudstack.ListUcsProfilesResponse, r1 error) {
+	m.record("ListUcsProfilesAll", p)
+	if m.ListUcsProfilesAllFunc != nil {
+		return m.ListUcsProfilesAllFunc(p)
+	}
+	if m.ListUcsProfilesAllWithContextFunc != nil {
+		return m.ListUcsProfilesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UCSService.ListUcsProfilesAll")
+	return
+}
+
+// ListUcsProfilesAllWithContext records the call and calls ListUcsProfilesAllWithContextFunc, or ListUcsProfilesAllFunc if only that is set
+func (m *MockUCSService) ListUcsProfilesAllWithContext(ctx context.Context, p *cloudstack.ListUcsProfilesParams) (r0 *cloudstack.ListUcsProfilesResponse, r1 error) {
+	m.record("ListUcsProfilesAllWithContext", ctx, p)
+	if m.ListUcsProfilesAllWithContextFunc != nil {
+		return m.ListUcsProfilesAllWithContextFunc(ctx, p)
+	}
+	if m.ListUcsProfilesAllFunc != nil {
+		return m.ListUcsProfilesAllFunc(p)
+	}
+	r1 = notMocked("UCSService.ListUcsProfilesAllWithContext")
+	return
+}
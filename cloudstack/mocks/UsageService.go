@@ -0,0 +1,759 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockUsageService is a mock of cloudstack.UsageServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockUsageService struct {
+	Recorder
+
+	NewAddTrafficMonitorParamsFunc                func(url string, zoneid string) *cloudstack.AddTrafficMonitorParams
+	AddTrafficMonitorFunc                         func(p *cloudstack.AddTrafficMonitorParams) (*cloudstack.AddTrafficMonitorResponse, error)
+	AddTrafficMonitorWithContextFunc              func(ctx context.Context, p *cloudstack.AddTrafficMonitorParams) (*cloudstack.AddTrafficMonitorResponse, error)
+	NewAddTrafficTypeParamsFunc                   func(physicalnetworkid string, traffictype string) *cloudstack.AddTrafficTypeParams
+	AddTrafficTypeFunc                            func(p *cloudstack.AddTrafficTypeParams) (*cloudstack.AddTrafficTypeResponse, error)
+	AddTrafficTypeWithContextFunc                 func(ctx context.Context, p *cloudstack.AddTrafficTypeParams) (*cloudstack.AddTrafficTypeResponse, error)
+	AddTrafficTypeAsyncFunc                       func(p *cloudstack.AddTrafficTypeParams) (*cloudstack.Job, error)
+	AddTrafficTypeAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.AddTrafficTypeParams) (*cloudstack.Job, error)
+	NewDeleteTrafficMonitorParamsFunc             func(id string) *cloudstack.DeleteTrafficMonitorParams
+	DeleteTrafficMonitorFunc                      func(p *cloudstack.DeleteTrafficMonitorParams) (*cloudstack.DeleteTrafficMonitorResponse, error)
+	DeleteTrafficMonitorWithContextFunc           func(ctx context.Context, p *cloudstack.DeleteTrafficMonitorParams) (*cloudstack.DeleteTrafficMonitorResponse, error)
+	NewDeleteTrafficTypeParamsFunc                func(id string) *cloudstack.DeleteTrafficTypeParams
+	DeleteTrafficTypeFunc                         func(p *cloudstack.DeleteTrafficTypeParams) (*cloudstack.DeleteTrafficTypeResponse, error)
+	DeleteTrafficTypeWithContextFunc              func(ctx context.Context, p *cloudstack.DeleteTrafficTypeParams) (*cloudstack.DeleteTrafficTypeResponse, error)
+	DeleteTrafficTypeAsyncFunc                    func(p *cloudstack.DeleteTrafficTypeParams) (*cloudstack.Job, error)
+	DeleteTrafficTypeAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.DeleteTrafficTypeParams) (*cloudstack.Job, error)
+	NewGenerateUsageRecordsParamsFunc             func(enddate string, startdate string) *cloudstack.GenerateUsageRecordsParams
+	GenerateUsageRecordsFunc                      func(p *cloudstack.GenerateUsageRecordsParams) (*cloudstack.GenerateUsageRecordsResponse, error)
+	GenerateUsageRecordsWithContextFunc           func(ctx context.Context, p *cloudstack.GenerateUsageRecordsParams) (*cloudstack.GenerateUsageRecordsResponse, error)
+	NewListTrafficMonitorsParamsFunc              func(zoneid string) *cloudstack.ListTrafficMonitorsParams
+	ListTrafficMonitorsFunc                       func(p *cloudstack.ListTrafficMonitorsParams) (*cloudstack.ListTrafficMonitorsResponse, error)
+	ListTrafficMonitorsWithContextFunc            func(ctx context.Context, p *cloudstack.ListTrafficMonitorsParams) (*cloudstack.ListTrafficMonitorsResponse, error)
+	NewListTrafficMonitorsPagerFunc               func(p *cloudstack.ListTrafficMonitorsParams) *cloudstack.ListTrafficMonitorsPager
+	ListTrafficMonitorsAllFunc                    func(p *cloudstack.ListTrafficMonitorsParams) (*cloudstack.ListTrafficMonitorsResponse, error)
+	ListTrafficMonitorsAllWithContextFunc         func(ctx context.Context, p *cloudstack.ListTrafficMonitorsParams) (*cloudstack.ListTrafficMonitorsResponse, error)
+	NewListTrafficTypeImplementorsParamsFunc      func() *cloudstack.ListTrafficTypeImplementorsParams
+	ListTrafficTypeImplementorsFunc               func(p *cloudstack.ListTrafficTypeImplementorsParams) (*cloudstack.ListTrafficTypeImplementorsResponse, error)
+	ListTrafficTypeImplementorsWithContextFunc    func(ctx context.Context, p *cloudstack.ListTrafficTypeImplementorsParams) (*cloudstack.ListTrafficTypeImplementorsResponse, error)
+	NewListTrafficTypeImplementorsPagerFunc       func(p *cloudstack.ListTrafficTypeImplementorsParams) *cloudstack.ListTrafficTypeImplementorsPager
+	ListTrafficTypeImplementorsAllFunc            func(p *cloudstack.ListTrafficTypeImplementorsParams) (*cloudstack.ListTrafficTypeImplementorsResponse, error)
+	ListTrafficTypeImplementorsAllWithContextFunc func(ctx context.Context, p *cloudstack.ListTrafficTypeImplementorsParams) (*cloudstack.ListTrafficTypeImplementorsResponse, error)
+	NewListTrafficTypesParamsFunc                 func(physicalnetworkid string) *cloudstack.ListTrafficTypesParams
+	GetTrafficTypeIDFunc                          func(keyword string, physicalnetworkid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetTrafficTypeIDWithContextFunc               func(ctx context.Context, keyword string, physicalnetworkid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListTrafficTypesFunc                          func(p *cloudstack.ListTrafficTypesParams) (*cloudstack.ListTrafficTypesResponse, error)
+	ListTrafficTypesWithContextFunc               func(ctx context.Context, p *cloudstack.ListTrafficTypesParams) (*cloudstack.ListTrafficTypesResponse, error)
+	NewListTrafficTypesPagerFunc                  func(p *cloudstack.ListTrafficTypesParams) *cloudstack.ListTrafficTypesPager
+	ListTrafficTypesAllFunc                       func(p *cloudstack.ListTrafficTypesParams) (*cloudstack.ListTrafficTypesResponse, error)
+	ListTrafficTypesAllWithContextFunc            func(ctx context.Context, p *cloudstack.ListTrafficTypesParams) (*cloudstack.ListTrafficTypesResponse, error)
+	NewListUsageRecordsParamsFunc                 func(enddate string, startdate string) *cloudstack.ListUsageRecordsParams
+	ListUsageRecordsFunc                          func(p *cloudstack.ListUsageRecordsParams) (*cloudstack.ListUsageRecordsResponse, error)
+	ListUsageRecordsWithContextFunc               func(ctx context.Context, p *cloudstack.ListUsageRecordsParams) (*cloudstack.ListUsageRecordsResponse, error)
+	NewListUsageRecordsPagerFunc                  func(p *cloudstack.ListUsageRecordsParams) *cloudstack.ListUsageRecordsPager
+	ListUsageRecordsAllFunc                       func(p *cloudstack.ListUsageRecordsParams) (*cloudstack.ListUsageRecordsResponse, error)
+	ListUsageRecordsAllWithContextFunc            func(ctx context.Context, p *cloudstack.ListUsageRecordsParams) (*cloudstack.ListUsageRecordsResponse, error)
+	NewListUsageTypesParamsFunc                   func() *cloudstack.ListUsageTypesParams
+	ListUsageTypesFunc                            func(p *cloudstack.ListUsageTypesParams) (*cloudstack.ListUsageTypesResponse, error)
+	ListUsageTypesWithContextFunc                 func(ctx context.Context, p *cloudstack.ListUsageTypesParams) (*cloudstack.ListUsageTypesResponse, error)
+	NewRemoveRawUsageRecordsParamsFunc            func(interval int) *cloudstack.RemoveRawUsageRecordsParams
+	RemoveRawUsageRecordsFunc                     func(p *cloudstack.RemoveRawUsageRecordsParams) (*cloudstack.RemoveRawUsageRecordsResponse, error)
+	RemoveRawUsageRecordsWithContextFunc          func(ctx context.Context, p *cloudstack.RemoveRawUsageRecordsParams) (*cloudstack.RemoveRawUsageRecordsResponse, error)
+	NewUpdateTrafficTypeParamsFunc                func(id string) *cloudstack.UpdateTrafficTypeParams
+	UpdateTrafficTypeFunc                         func(p *cloudstack.UpdateTrafficTypeParams) (*cloudstack.UpdateTrafficTypeResponse, error)
+	UpdateTrafficTypeWithContextFunc              func(ctx context.Context, p *cloudstack.UpdateTrafficTypeParams) (*cloudstack.UpdateTrafficTypeResponse, error)
+	UpdateTrafficTypeAsyncFunc                    func(p *cloudstack.UpdateTrafficTypeParams) (*cloudstack.Job, error)
+	UpdateTrafficTypeAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.UpdateTrafficTypeParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.UsageServiceIface = (*MockUsageService)(nil)
+
+// NewAddTrafficMonitorParams records the call and calls NewAddTrafficMonitorParamsFunc
+func (m *MockUsageService) NewAddTrafficMonitorParams(url string, zoneid string) (r0 *cloudstack.AddTrafficMonitorParams) {
+	m.record("NewAddTrafficMonitorParams", url, zoneid)
+	if m.NewAddTrafficMonitorParamsFunc != nil {
+		return m.NewAddTrafficMonitorParamsFunc(url, zoneid)
+	}
+	return cloudstack.NewUsageService(nil).NewAddTrafficMonitorParams(url, zoneid)
+}
+
+// AddTrafficMonitor records the call and calls AddTrafficMonitorFunc, or AddTrafficMonitorWithContextFunc if only that is set
+func (m *MockUsageService) AddTrafficMonitor(p *cloudstack.AddTrafficMonitorParams) (r0 *cloudstack.AddTrafficMonitorResponse, r1 error) {
+	m.record("AddTrafficMonitor", p)
+	if m.AddTrafficMonitorFunc != nil {
+		return m.AddTrafficMonitorFunc(p)
+	}
+	if m.AddTrafficMonitorWithContextFunc != nil {
+		return m.AddTrafficMonitorWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.AddTrafficMonitor")
+	return
+}
+
+// AddTrafficMonitorWithContext records the call and calls AddTrafficMonitorWithContextFunc, or AddTrafficMonitorFunc if only that is set
+func (m *MockUsageService) AddTrafficMonitorWithContext(ctx context.Context, p *cloudstack.AddTrafficMonitorParams) (r0 *cloudstack.AddTrafficMonitorResponse, r1 error) {
+	m.record("AddTrafficMonitorWithContext", ctx, p)
+	if m.AddTrafficMonitorWithContextFunc != nil {
+		return m.AddTrafficMonitorWithContextFunc(ctx, p)
+	}
+	if m.AddTrafficMonitorFunc != nil {
+		return m.AddTrafficMonitorFunc(p)
+	}
+	r1 = notMocked("UsageService.AddTrafficMonitorWithContext")
+	return
+}
+
+// NewAddTrafficTypeParams records the call and calls NewAddTrafficTypeParamsFunc
+func (m *MockUsageService) NewAddTrafficTypeParams(physicalnetworkid string, traffictype string) (r0 *cloudstack.AddTrafficTypeParams) {
+	m.record("NewAddTrafficTypeParams", physicalnetworkid, traffictype)
+	if m.NewAddTrafficTypeParamsFunc != nil {
+		return m.NewAddTrafficTypeParamsFunc(physicalnetworkid, traffictype)
+	}
+	return cloudstack.NewUsageService(nil).NewAddTrafficTypeParams(physicalnetworkid, traffictype)
+}
+
+// AddTrafficType records the call and calls AddTrafficTypeFunc, or AddTrafficTypeWithContextFunc if only that is set
+func (m *MockUsageService) AddTrafficType(p *cloudstack.AddTrafficTypeParams) (r0 *cloudstack.AddTrafficTypeResponse, r1 error) {
+	m.record("AddTrafficType", p)
+	if m.AddTrafficTypeFunc != nil {
+		return m.AddTrafficTypeFunc(p)
+	}
+	if m.AddTrafficTypeWithContextFunc != nil {
+		return m.AddTrafficTypeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.AddTrafficType")
+	return
+}
+
+// AddTrafficTypeWithContext records the call and calls AddTrafficTypeWithContextFunc, or AddTrafficTypeFunc if only that is set
+func (m *MockUsageService) AddTrafficTypeWithContext(ctx context.Context, p *cloudstack.AddTrafficTypeParams) (r0 *cloudstack.AddTrafficTypeResponse, r1 error) {
+	m.record("AddTrafficTypeWithContext", ctx, p)
+	if m.AddTrafficTypeWithContextFunc != nil {
+		return m.AddTrafficTypeWithContextFunc(ctx, p)
+	}
+	if m.AddTrafficTypeFunc != nil {
+		return m.AddTrafficTypeFunc(p)
+	}
+	r1 = notMocked("UsageService.AddTrafficTypeWithContext")
+	return
+}
+
+// AddTrafficTypeAsync records the call and calls AddTrafficTypeAsyncFunc, or AddTrafficTypeAsyncWithContextFunc if only that is set
+func (m *MockUsageService) AddTrafficTypeAsync(p *cloudstack.AddTrafficTypeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddTrafficTypeAsync", p)
+	if m.AddTrafficTypeAsyncFunc != nil {
+		return m.AddTrafficTypeAsyncFunc(p)
+	}
+	if m.AddTrafficTypeAsyncWithContextFunc != nil {
+		return m.AddTrafficTypeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.AddTrafficTypeAsync")
+	return
+}
+
+// AddTrafficTypeAsyncWithContext records the call and calls AddTrafficTypeAsyncWithContextFunc, or AddTrafficTypeAsyncFunc if only that is set
+func (m *MockUsageService) AddTrafficTypeAsyncWithContext(ctx context.Context, p *cloudstack.AddTrafficTypeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddTrafficTypeAsyncWithContext", ctx, p)
+	if m.AddTrafficTypeAsyncWithContextFunc != nil {
+		return m.AddTrafficTypeAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddTrafficTypeAsyncFunc != nil {
+		return m.AddTrafficTypeAsyncFunc(p)
+	}
+	r1 = notMocked("UsageService.AddTrafficTypeAsyncWithContext")
+	return
+}
+
+// NewDeleteTrafficMonitorParams records the call and calls NewDeleteTrafficMonitorParamsFunc
+func (m *MockUsageService) NewDeleteTrafficMonitorParams(id string) (r0 *cloudstack.DeleteTrafficMonitorParams) {
+	m.record("NewDeleteTrafficMonitorParams", id)
+	if m.NewDeleteTrafficMonitorParamsFunc != nil {
+		return m.NewDeleteTrafficMonitorParamsFunc(id)
+	}
+	return cloudstack.NewUsageService(nil).NewDeleteTrafficMonitorParams(id)
+}
+
+// DeleteTrafficMonitor records the call and calls DeleteTrafficMonitorFunc, or DeleteTrafficMonitorWithContextFunc if only that is set
+func (m *MockUsageService) DeleteTrafficMonitor(p *cloudstack.DeleteTrafficMonitorParams) (r0 *cloudstack.DeleteTrafficMonitorResponse, r1 error) {
+	m.record("DeleteTrafficMonitor", p)
+	if m.DeleteTrafficMonitorFunc != nil {
+		return m.DeleteTrafficMonitorFunc(p)
+	}
+	if m.DeleteTrafficMonitorWithContextFunc != nil {
+		return m.DeleteTrafficMonitorWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.DeleteTrafficMonitor")
+	return
+}
+
+// DeleteTrafficMonitorWithContext records the call and calls DeleteTrafficMonitorWithContextFunc, or DeleteTrafficMonitorFunc if only that is set
+func (m *MockUsageService) DeleteTrafficMonitorWithContext(ctx context.Context, p *cloudstack.DeleteTrafficMonitorParams) (r0 *cloudstack.DeleteTrafficMonitorResponse, r1 error) {
+	m.record("DeleteTrafficMonitorWithContext", ctx, p)
+	if m.DeleteTrafficMonitorWithContextFunc != nil {
+		return m.DeleteTrafficMonitorWithContextFunc(ctx, p)
+	}
+	if m.DeleteTrafficMonitorFunc != nil {
+		return m.DeleteTrafficMonitorFunc(p)
+	}
+	r1 = notMocked("UsageService.DeleteTrafficMonitorWithContext")
+	return
+}
+
+// NewDeleteTrafficTypeParams records the call and calls NewDeleteTrafficTypeParamsFunc
+func (m *MockUsageService) NewDeleteTrafficTypeParams(id string) (r0 *cloudstack.DeleteTrafficTypeParams) {
+	m.record("NewDeleteTrafficTypeParams", id)
+	if m.NewDeleteTrafficTypeParamsFunc != nil {
+		return m.NewDeleteTrafficTypeParamsFunc(id)
+	}
+	return cloudstack.NewUsageService(nil).NewDeleteTrafficTypeParams(id)
+}
+
+// DeleteTrafficType records the call and calls DeleteTrafficTypeFunc, or DeleteTrafficTypeWithContextFunc if only that is set
+func (m *MockUsageService) DeleteTrafficType(p *cloudstack.DeleteTrafficTypeParams) (r0 *cloudstack.DeleteTrafficTypeResponse, r1 error) {
+	m.record("DeleteTrafficType", p)
+	if m.DeleteTrafficTypeFunc != nil {
+		return m.DeleteTrafficTypeFunc(p)
+	}
+	if m.DeleteTrafficTypeWithContextFunc != nil {
+		return m.DeleteTrafficTypeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.DeleteTrafficType")
+	return
+}
+
+// DeleteTrafficTypeWithContext records the call and calls DeleteTrafficTypeWithContextFunc, or DeleteTrafficTypeFunc if only that is set
+func (m *MockUsageService) DeleteTrafficTypeWithContext(ctx context.Context, p *cloudstack.DeleteTrafficTypeParams) (r0 *cloudstack.DeleteTrafficTypeResponse, r1 error) {
+	m.record("DeleteTrafficTypeWithContext", ctx, p)
+	if m.DeleteTrafficTypeWithContextFunc != nil {
+		return m.DeleteTrafficTypeWithContextFunc(ctx, p)
+	}
+	if m.DeleteTrafficTypeFunc != nil {
+		return m.DeleteTrafficTypeFunc(p)
+	}
+	r1 = notMocked("UsageService.DeleteTrafficTypeWithContext")
+	return
+}
+
+// DeleteTrafficTypeAsync records the call and calls DeleteTrafficTypeAsyncFunc, or DeleteTrafficTypeAsyncWithContextFunc if only that is set
+func (m *MockUsageService) DeleteTrafficTypeAsync(p *cloudstack.DeleteTrafficTypeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteTrafficTypeAsync", p)
+	if m.DeleteTrafficTypeAsyncFunc != nil {
+		return m.DeleteTrafficTypeAsyncFunc(p)
+	}
+	if m.DeleteTrafficTypeAsyncWithContextFunc != nil {
+		return m.DeleteTrafficTypeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.DeleteTrafficTypeAsync")
+	return
+}
+
+// DeleteTrafficTypeAsyncWithContext records the call and calls DeleteTrafficTypeAsyncWithContextFunc, or DeleteTrafficTypeAsyncFunc if only that is set
+func (m *MockUsageService) DeleteTrafficTypeAsyncWithContext(ctx context.Context, p *cloudstack.DeleteTrafficTypeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteTrafficTypeAsyncWithContext", ctx, p)
+	if m.DeleteTrafficTypeAsyncWithContextFunc != nil {
+		return m.DeleteTrafficTypeAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteTrafficTypeAsyncFunc != nil {
+		return m.DeleteTrafficTypeAsyncFunc(p)
+	}
+	r1 = notMocked("UsageService.DeleteTrafficTypeAsyncWithContext")
+	return
+}
+
+// NewGenerateUsageRecordsParams records the call and calls NewGenerateUsageRecordsParamsFunc
+func (m *MockUsageService) NewGenerateUsageRecordsParams(enddate string, startdate string) (r0 *cloudstack.GenerateUsageRecordsParams) {
+	m.record("NewGenerateUsageRecordsParams", enddate, startdate)
+	if m.NewGenerateUsageRecordsParamsFunc != nil {
+		return m.NewGenerateUsageRecordsParamsFunc(enddate, startdate)
+	}
+	return cloudstack.NewUsageService(nil).NewGenerateUsageRecordsParams(enddate, startdate)
+}
+
+// GenerateUsageRecords records the call and calls GenerateUsageRecordsFunc, or GenerateUsageRecordsWithContextFunc if only that is set
+func (m *MockUsageService) GenerateUsageRecords(p *cloudstack.GenerateUsageRecordsParams) (r0 *cloudstack.GenerateUsageRecordsResponse, r1 error) {
+	m.record("GenerateUsageRecords", p)
+	if m.GenerateUsageRecordsFunc != nil {
+		return m.GenerateUsageRecordsFunc(p)
+	}
+	if m.GenerateUsageRecordsWithContextFunc != nil {
+		return m.GenerateUsageRecordsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.GenerateUsageRecords")
+	return
+}
+
+// GenerateUsageRecordsWithContext records the call and calls GenerateUsageRecordsWithContextFunc, or GenerateUsageRecordsFunc if only that is set
+func (m *MockUsageService) GenerateUsageRecordsWithContext(ctx context.Context, p *cloudstack.GenerateUsageRecordsParams) (r0 *cloudstack.GenerateUsageRecordsResponse, r1 error) {
+	m.record("GenerateUsageRecordsWithContext", ctx, p)
+	if m.GenerateUsageRecordsWithContextFunc != nil {
+		return m.GenerateUsageRecordsWithContextFunc(ctx, p)
+	}
+	if m.GenerateUsageRecordsFunc != nil {
+		return m.GenerateUsageRecordsFunc(p)
+	}
+	r1 = notMocked("UsageService.GenerateUsageRecordsWithContext")
+	return
+}
+
+// NewListTrafficMonitorsParams records the call and calls NewListTrafficMonitorsParamsFunc
+func (m *MockUsageService) NewListTrafficMonitorsParams(zoneid string) (r0 *cloudstack.ListTrafficMonitorsParams) {
+	m.record("NewListTrafficMonitorsParams", zoneid)
+	if m.NewListTrafficMonitorsParamsFunc != nil {
+		return m.NewListTrafficMonitorsParamsFunc(zoneid)
+	}
+	return cloudstack.NewUsageService(nil).NewListTrafficMonitorsParams(zoneid)
+}
+
+// ListTrafficMonitors records the call and calls ListTrafficMonitorsFunc, or ListTrafficMonitorsWithContextFunc if only that is set
+func (m *MockUsageService) ListTrafficMonitors(p *cloudstack.ListTrafficMonitorsParams) (r0 *cloudstack.ListTrafficMonitorsResponse, r1 error) {
+	m.record("ListTrafficMonitors", p)
+	if m.ListTrafficMonitorsFunc != nil {
+		return m.ListTrafficMonitorsFunc(p)
+	}
+	if m.ListTrafficMonitorsWithContextFunc != nil {
+		return m.ListTrafficMonitorsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.ListTrafficMonitors")
+	return
+}
+
+// ListTrafficMonitorsWithContext records the call and calls ListTrafficMonitorsWithContextFunc, or ListTrafficMonitorsFunc if only that is set
+func (m *MockUsageService) ListTrafficMonitorsWithContext(ctx context.Context, p *cloudstack.ListTrafficMonitorsParams) (r0 *cloudstack.ListTrafficMonitorsResponse, r1 error) {
+	m.record("ListTrafficMonitorsWithContext", ctx, p)
+	if m.ListTrafficMonitorsWithContextFunc != nil {
+		return m.ListTrafficMonitorsWithContextFunc(ctx, p)
+	}
+	if m.ListTrafficMonitorsFunc != nil {
+		return m.ListTrafficMonitorsFunc(p)
+	}
+	r1 = notMocked("UsageService.ListTrafficMonitorsWithContext")
+	return
+}
+
+// NewListTrafficMonitorsPager records the call and calls NewListTrafficMonitorsPagerFunc
+func (m *MockUsageService) NewListTrafficMonitorsPager(p *cloudstack.ListTrafficMonitorsParams) (r0 *cloudstack.ListTrafficMonitorsPager) {
+	m.record("NewListTrafficMonitorsPager", p)
+	if m.NewListTrafficMonitorsPagerFunc != nil {
+		return m.NewListTrafficMonitorsPagerFunc(p)
+	}
+	return
+}
+
+// ListTrafficMonitorsAll records the call and calls ListTrafficMonitorsAllFunc, or ListTrafficMonitorsAllWithContextFunc if only that is set
+func (m *MockUsageService) ListTrafficMonitorsAll(p *cloudstack.ListTrafficMonitorsParams) (r0 *cloudstack.ListTrafficMonitorsResponse, r1 error) {
+	m.record("ListTrafficMonitorsAll", p)
+	if m.ListTrafficMonitorsAllFunc != nil {
+		return m.ListTrafficMonitorsAllFunc(p)
+	}
+	if m.ListTrafficMonitorsAllWithContextFunc != nil {
+		return m.ListTrafficMonitorsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.ListTrafficMonitorsAll")
+	return
+}
+
+// ListTrafficMonitorsAllWithContext records the call and calls ListTrafficMonitorsAllWithContextFunc, or ListTrafficMonitorsAllFunc if only that is set
+func (m *MockUsageService) ListTrafficMonitorsAllWithContext(ctx context.Context, p *cloudstack.ListTrafficMonitorsParams) (r0 *cloudstack.ListTrafficMonitorsResponse, r1 error) {
+	m.record("ListTrafficMonitorsAllWithContext", ctx, p)
+	if m.ListTrafficMonitorsAllWithContextFunc != nil {
+		return m.ListTrafficMonitorsAllWithContextFunc(ctx, p)
+	}
+	if m.ListTrafficMonitorsAllFunc != nil {
+		return m.ListTrafficMonitorsAllFunc(p)
+	}
+	r1 = notMocked("UsageService.ListTrafficMonitorsAllWithContext")
+	return
+}
+
+// NewListTrafficTypeImplementorsParams records the call and calls NewListTrafficTypeImplementorsParamsFunc
+func (m *MockUsageService) NewListTrafficTypeImplementorsParams() (r0 *cloudstack.ListTrafficTypeImplementorsParams) {
+	m.record("NewListTrafficTypeImplementorsParams")
+	if m.NewListTrafficTypeImplementorsParamsFunc != nil {
+		return m.NewListTrafficTypeImplementorsParamsFunc()
+	}
+	return cloudstack.NewUsageService(nil).NewListTrafficTypeImplementorsParams()
+}
+
+// ListTrafficTypeImplementors records the call and calls ListTrafficTypeImplementorsFunc, or ListTrafficTypeImplementorsWithContextFunc if only that is set
+func (m *MockUsageService) ListTrafficTypeImplementors(p *cloudstack.ListTrafficTypeImplementorsParams) (r0 *cloudstack.ListTrafficTypeImplementorsResponse, r1 error) {
+	m.record("ListTrafficTypeImplementors", p)
+	if m.ListTrafficTypeImplementorsFunc != nil {
+		return m.ListTrafficTypeImplementorsFunc(p)
+	}
+	if m.ListTrafficTypeImplementorsWithContextFunc != nil {
+		return m.ListTrafficTypeImplementorsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.ListTrafficTypeImplementors")
+	return
+}
+
+// ListTrafficTypeImplementorsWithContext records the call and calls ListTrafficTypeImplementorsWithContextFunc, or ListTrafficTypeImplementorsFunc if only that is set
+func (m *MockUsageService) ListTrafficTypeImplementorsWithContext(ctx context.Context, p *cloudstack.ListTrafficTypeImplementorsParams) (r0 *cloudstack.ListTrafficTypeImplementorsResponse, r1 error) {
+	m.record("ListTrafficTypeImplementorsWithContext", ctx, p)
+	if m.ListTrafficTypeImplementorsWithContextFunc != nil {
+		return m.ListTrafficTypeImplementorsWithContextFunc(ctx, p)
+	}
+	if m.ListTrafficTypeImplementorsFunc != nil {
+		return m.ListTrafficTypeImplementorsFunc(p)
+	}
+	r1 = notMocked("UsageService.ListTrafficTypeImplementorsWithContext")
+	return
+}
+
+// NewListTrafficTypeImplementorsPager records the call and calls NewListTrafficTypeImplementorsPagerFunc
+func (m *MockUsageService) NewListTrafficTypeImplementorsPager(p *cloudstack.ListTrafficTypeImplementorsParams) (r0 *cloudstack.ListTrafficTypeImplementorsPager) {
+	m.record("NewListTrafficTypeImplementorsPager", p)
+	if m.NewListTrafficTypeImplementorsPagerFunc != nil {
+		return m.NewListTrafficTypeImplementorsPagerFunc(p)
+	}
+	return
+}
+
+// ListTrafficTypeImplementorsAll records the call and calls ListTrafficTypeImplementorsAllFunc, or ListTrafficTypeImplementorsAllWithContextFunc if only that is set
+func (m *MockUsageService) ListTrafficTypeImplementorsAll(p *cloudstack.ListTrafficTypeImplementorsParams) (r0 *cloudstack.ListTrafficTypeImplementorsResponse, r1 error) {
+	m.record("ListTrafficTypeImplementorsAll", p)
+	if m.ListTrafficTypeImplementorsAllFunc != nil {
+		return m.ListTrafficTypeImplementorsAllFunc(p)
+	}
+	if m.ListTrafficTypeImplementorsAllWithContextFunc != nil {
+		return m.ListTrafficTypeImplementorsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.ListTrafficTypeImplementorsAll")
+	return
+}
+
+// ListTrafficTypeImplementorsAllWithContext records the call and calls ListTrafficTypeImplementorsAllWithContextFunc, or ListTrafficTypeImplementorsAllFunc if only that is set
+func (m *MockUsageService) ListTrafficTypeImplementorsAllWithContext(ctx context.Context, p *cloudstack.ListTrafficTypeImplementorsParams) (r0 *cloudstack.ListTrafficTypeImplementorsResponse, r1 error) {
+	m.record("ListTrafficTypeImplementorsAllWithContext", ctx, p)
+	if m.ListTrafficTypeImplementorsAllWithContextFunc != nil {
+		return m.ListTrafficTypeImplementorsAllWithContextFunc(ctx, p)
+	}
+	if m.ListTrafficTypeImplementorsAllFunc != nil {
+		return m.ListTrafficTypeImplementorsAllFunc(p)
+	}
+	r1 = notMocked("UsageService.ListTrafficTypeImplementorsAllWithContext")
+	return
+}
+
+// NewListTrafficTypesParams records the call and calls NewListTrafficTypesParamsFunc
+func (m *MockUsageService) NewListTrafficTypesParams(physicalnetworkid string) (r0 *cloudstack.ListTrafficTypesParams) {
+	m.record("NewListTrafficTypesParams", physicalnetworkid)
+	if m.NewListTrafficTypesParamsFunc != nil {
+		return m.NewListTrafficTypesParamsFunc(physicalnetworkid)
+	}
+	return cloudstack.NewUsageService(nil).NewListTrafficTypesParams(physicalnetworkid)
+}
+
+// GetTrafficTypeID records the call and calls GetTrafficTypeIDFunc, or GetTrafficTypeIDWithContextFunc if only that is set
+func (m *MockUsageService) GetTrafficTypeID(keyword string, physicalnetworkid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetTrafficTypeID", keyword, physicalnetworkid, opts)
+	if m.GetTrafficTypeIDFunc != nil {
+		return m.GetTrafficTypeIDFunc(keyword, physicalnetworkid, opts...)
+	}
+	if m.GetTrafficTypeIDWithContextFunc != nil {
+		return m.GetTrafficTypeIDWithContextFunc(context.Background(), keyword, physicalnetworkid, opts...)
+	}
+	r2 = notMocked("UsageService.GetTrafficTypeID")
+	return
+}
+
+// GetTrafficTypeIDWithContext records the call and calls GetTrafficTypeIDWithContextFunc, or GetTrafficTypeIDFunc if only that is set
+func (m *MockUsageService) GetTrafficTypeIDWithContext(ctx context.Context, keyword string, physicalnetworkid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetTrafficTypeIDWithContext", ctx, keyword, physicalnetworkid, opts)
+	if m.GetTrafficTypeIDWithContextFunc != nil {
+		return m.GetTrafficTypeIDWithContextFunc(ctx, keyword, physicalnetworkid, opts...)
+	}
+	if m.GetTrafficTypeIDFunc != nil {
+		return m.GetTrafficTypeIDFunc(keyword, physicalnetworkid, opts...)
+	}
+	r2 = notMocked("UsageService.GetTrafficTypeIDWithContext")
+	return
+}
+
+// ListTrafficTypes records the call and calls ListTrafficTypesFunc, or ListTrafficTypesWithContextFunc if only that is set
+func (m *MockUsageService) ListTrafficTypes(p *cloudstack.ListTrafficTypesParams) (r0 *cloudstack.ListTrafficTypesResponse, r1 error) {
+	m.record("ListTrafficTypes", p)
+	if m.ListTrafficTypesFunc != nil {
+		return m.ListTrafficTypesFunc(p)
+	}
+	if m.ListTrafficTypesWithContextFunc != nil {
+		return m.ListTrafficTypesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.ListTrafficTypes")
+	return
+}
+
+// ListTrafficTypesWithContext records the call and calls ListTrafficTypesWithContextFunc, or ListTrafficTypesFunc if only that is set
+func (m *MockUsageService) ListTrafficTypesWithContext(ctx context.Context, p *cloudstack.ListTrafficTypesParams) (r0 *cloudstack.ListTrafficTypesResponse, r1 error) {
+	m.record("ListTrafficTypesWithContext", ctx, p)
+	if m.ListTrafficTypesWithContextFunc != nil {
+		return m.ListTrafficTypesWithContextFunc(ctx, p)
+	}
+	if m.ListTrafficTypesFunc != nil {
+		return m.ListTrafficTypesFunc(p)
+	}
+	r1 = notMocked("UsageService.ListTrafficTypesWithContext")
+	return
+}
+
+// NewListTrafficTypesPager records the call and calls NewListTrafficTypesPagerFunc
+func (m *MockUsageService) NewListTrafficTypesPager(p *cloudstack.ListTrafficTypesParams) (r0 *cloudstack.ListTrafficTypesPager) {
+	m.record("NewListTrafficTypesPager", p)
+	if m.NewListTrafficTypesPagerFunc != nil {
+		return m.NewListTrafficTypesPagerFunc(p)
+	}
+	return
+}
+
+// ListTrafficTypesAll records the call and calls ListTrafficTypesAllFunc, or ListTrafficTypesAllWithContextFunc if only that is set
+func (m *MockUsageService) ListTrafficTypesAll(p *cloudstack.ListTrafficTypesParams) (r0 *cloudstack.ListTrafficTypesResponse, r1 error) {
+	m.record("ListTrafficTypesAll", p)
+	if m.ListTrafficTypesAllFunc != nil {
+		return m.ListTrafficTypesAllFunc(p)
+	}
+	if m.ListTrafficTypesAllWithContextFunc != nil {
+		return m.ListTrafficTypesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.ListTrafficTypesAll")
+	return
+}
+
+// ListTrafficTypesAllWithContext records the call and calls ListTrafficTypesAllWithContextFunc, or ListTrafficTypesAllFunc if only that is set
+func (m *MockUsageService) ListTrafficTypesAllWithContext(ctx context.Context, p *cloudstack.ListTrafficTypesParams) (r0 *cloudstack.ListTrafficTypesResponse, r1 error) {
+	m.record("ListTrafficTypesAllWithContext", ctx, p)
+	if m.ListTrafficTypesAllWithContextFunc != nil {
+		return m.ListTrafficTypesAllWithContextFunc(ctx, p)
+	}
+	if m.ListTrafficTypesAllFunc != nil {
+		return m.ListTrafficTypesAllFunc(p)
+	}
+	r1 = notMocked("UsageService.ListTrafficTypesAllWithContext")
+	return
+}
+
+// NewListUsageRecordsParams records the call and calls NewListUsageRecordsParamsFunc
+func (m *MockUsageService) NewListUsageRecordsParams(enddate string, startdate string) (r0 *cloudstack.ListUsageRecordsParams) {
+	m.record("NewListUsageRecordsParams", enddate, startdate)
+	if m.NewListUsageRecordsParamsFunc != nil {
+		return m.NewListUsageRecordsParamsFunc(enddate, startdate)
+	}
+	return cloudstack.NewUsageService(nil).NewListUsageRecordsParams(enddate, startdate)
+}
+
+// ListUsageRecords records the call and calls ListUsageRecordsFunc, or ListUsageRecordsWithContextFunc if only that is set
+func (m *MockUsageService) ListUsageRecords(p *cloudstack.ListUsageRecordsParams) (r0 *cloudstack.ListUsageRecordsResponse, r1 error) {
+	m.record("ListUsageRecords", p)
+	if m.ListUsageRecordsFunc != nil {
+		return m.ListUsageRecordsFunc(p)
+	}
+	if m.ListUsageRecordsWithContextFunc != nil {
+		return m.ListUsageRecordsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.ListUsageRecords")
+	return
+}
+
+// ListUsageRecordsWithContext records the call and calls ListUsageRecordsWithContextFunc, or ListUsageRecordsFunc if only that is set
+func (m *MockUsageService) ListUsageRecordsWithContext(ctx context.Context, p *cloudstack.ListUsageRecordsParams) (r0 *cloudstack.ListUsageRecordsResponse, r1 error) {
+	m.record("ListUsageRecordsWithContext", ctx, p)
+	if m.ListUsageRecordsWithContextFunc != nil {
+		return m.ListUsageRecordsWithContextFunc(ctx, p)
+	}
+	if m.ListUsageRecordsFunc != nil {
+		return m.ListUsageRecordsFunc(p)
+	}
+	r1 = notMocked("UsageService.ListUsageRecordsWithContext")
+	return
+}
+
+// NewListUsageRecordsPager records the call and calls NewListUsageRecordsPagerFunc
+func (m *MockUsageService) NewListUsageRecordsPager(p *cloudstack.ListUsageRecordsParams) (r0 *cloudstack.ListUsageRecordsPager) {
+	m.record("NewListUsageRecordsPager", p)
+	if m.NewListUsageRecordsPagerFunc != nil {
+		return m.NewListUsageRecordsPagerFunc(p)
+	}
+	return
+}
+
+// ListUsageRecordsAll records the call and calls ListUsageRecordsAllFunc, or ListUsageRecordsAllWithContextFunc if only that is set
+func (m *MockUsageService) ListUsageRecordsAll(p *cloudstack.ListUsageRecordsParams) (r0 *cloudstack.ListUsageRecordsResponse, r1 error) {
+	m.record("ListUsageRecordsAll", p)
+	if m.ListUsageRecordsAllFunc != nil {
+		return m.ListUsageRecordsAllFunc(p)
+	}
+	if m.ListUsageRecordsAllWithContextFunc != nil {
+		return m.ListUsageRecordsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.ListUsageRecordsAll")
+	return
+}
+
+// ListUsageRecordsAllWithContext records the call and calls ListUsageRecordsAllWithContextFunc, or ListUsageRecordsAllFunc if only that is set
+func (m *MockUsageService) ListUsageRecordsAllWithContext(ctx context.Context, p *cloudstack.ListUsageRecordsParams) (r0 *cloudstack.ListUsageRecordsResponse, r1 error) {
+	m.record("ListUsageRecordsAllWithContext", ctx, p)
+	if m.ListUsageRecordsAllWithContextFunc != nil {
+		return m.ListUsageRecordsAllWithContextFunc(ctx, p)
+	}
+	if m.ListUsageRecordsAllFunc != nil {
+		return m.ListUsageRecordsAllFunc(p)
+	}
+	r1 = notMocked("UsageService.ListUsageRecordsAllWithContext")
+	return
+}
+
+// NewListUsageTypesParams records the call and calls NewListUsageTypesParamsFunc
+func (m *MockUsageService) NewListUsageTypesParams() (r0 *cloudstack.ListUsageTypesParams) {
+	m.record("NewListUsageTypesParams")
+	if m.NewListUsageTypesParamsFunc != nil {
+		return m.NewListUsageTypesParamsFunc()
+	}
+	return cloudstack.NewUsageService(nil).NewListUsageTypesParams()
+}
+
+// ListUsageTypes records the call and calls ListUsageTypesFunc, or ListUsageTypesWithContextFunc if only that is set
+func (m *MockUsageService) ListUsageTypes(p *cloudstack.ListUsageTypesParams) (r0 *cloudstack.ListUsageTypesResponse, r1 error) {
+	m.record("ListUsageTypes", p)
+	if m.ListUsageTypesFunc != nil {
+		return m.ListUsageTypesFunc(p)
+	}
+	if m.ListUsageTypesWithContextFunc != nil {
+		return m.ListUsageTypesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.ListUsageTypes")
+	return
+}
+
+// ListUsageTypesWithContext records the call and calls ListUsageTypesWithContextFunc, or ListUsageTypesFunc if only that is set
+func (m *MockUsageService) ListUsageTypesWithContext(ctx context.Context, p *cloudstack.ListUsageTypesParams) (r0 *cloudstack.ListUsageTypesResponse, r1 error) {
+	m.record("ListUsageTypesWithContext", ctx, p)
+	if m.ListUsageTypesWithContextFunc != nil {
+		return m.ListUsageTypesWithContextFunc(ctx, p)
+	}
+	if m.ListUsageTypesFunc != nil {
+		return m.ListUsageTypesFunc(p)
+	}
+	r1 = notMocked("UsageService.ListUsageTypesWithContext")
+	return
+}
+
+// NewRemoveRawUsageRecordsParams records the call and calls NewRemoveRawUsageRecordsParamsFunc
+func (m *MockUsageService) NewRemoveRawUsageRecordsParams(interval int) (r0 *cloudstack.RemoveRawUsageRecordsParams) {
+	m.record("NewRemoveRawUsageRecordsParams", interval)
+	if m.NewRemoveRawUsageRecordsParamsFunc != nil {
+		return m.NewRemoveRawUsageRecordsParamsFunc(interval)
+	}
+	return cloudstack.NewUsageService(nil).NewRemoveRawUsageRecordsParams(interval)
+}
+
+// RemoveRawUsageRecords records the call and calls RemoveRawUsageRecordsFunc, or RemoveRawUsageRecordsWithContextFunc if only that is set
+func (m *MockUsageService) RemoveRawUsageRecords(p *cloudstack.RemoveRawUsageRecordsParams) (r0 *cloudstack.RemoveRawUsageRecordsResponse, r1 error) {
+	m.record("RemoveRawUsageRecords", p)
+	if m.RemoveRawUsageRecordsFunc != nil {
+		return m.RemoveRawUsageRecordsFunc(p)
+	}
+	if m.RemoveRawUsageRecordsWithContextFunc != nil {
+		return m.RemoveRawUsageRecordsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.RemoveRawUsageRecords")
+	return
+}
+
+// RemoveRawUsageRecordsWithContext records the call and calls RemoveRawUsageRecordsWithContextFunc, or RemoveRawUsageRecordsFunc if only that is set
+func (m *MockUsageService) RemoveRawUsageRecordsWithContext(ctx context.Context, p *cloudstack.RemoveRawUsageRecordsParams) (r0 *cloudstack.RemoveRawUsageRecordsResponse, r1 error) {
+	m.record("RemoveRawUsageRecordsWithContext", ctx, p)
+	if m.RemoveRawUsageRecordsWithContextFunc != nil {
+		return m.RemoveRawUsageRecordsWithContextFunc(ctx, p)
+	}
+	if m.RemoveRawUsageRecordsFunc != nil {
+		return m.RemoveRawUsageRecordsFunc(p)
+	}
+	r1 = notMocked("UsageService.RemoveRawUsageRecordsWithContext")
+	return
+}
+
+// NewUpdateTrafficTypeParams records the call and calls NewUpdateTrafficTypeParamsFunc
+func (m *MockUsageService) NewUpdateTrafficTypeParams(id string) (r0 *cloudstack.UpdateTrafficTypeParams) {
+	m.record("NewUpdateTrafficTypeParams", id)
+	if m.NewUpdateTrafficTypeParamsFunc != nil {
+		return m.NewUpdateTrafficTypeParamsFunc(id)
+	}
+	return cloudstack.NewUsageService(nil).NewUpdateTrafficTypeParams(id)
+}
+
+// UpdateTrafficType records the call and calls UpdateTrafficTypeFunc, or UpdateTrafficTypeWithContextFunc if only that is set
+func (m *MockUsageService) UpdateTrafficType(p *cloudstack.UpdateTrafficTypeParams) (r0 *cloudstack.UpdateTrafficTypeResponse, r1 error) {
+	m.record("UpdateTrafficType", p)
+	if m.UpdateTrafficTypeFunc != nil {
+		return m.UpdateTrafficTypeFunc(p)
+	}
+	if m.UpdateTrafficTypeWithContextFunc != nil {
+		return m.UpdateTrafficTypeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.UpdateTrafficType")
+	return
+}
+
+// UpdateTrafficTypeWithContext records the call and calls UpdateTrafficTypeWithContextFunc, or UpdateTrafficTypeFunc if only that is set
+func (m *MockUsageService) UpdateTrafficTypeWithContext(ctx context.Context, p *cloudstack.UpdateTrafficTypeParams) (r0 *cloudstack.UpdateTrafficTypeResponse, r1 error) {
+	m.record("UpdateTrafficTypeWithContext", ctx, p)
+	if m.UpdateTrafficTypeWithContextFunc != nil {
+		return m.UpdateTrafficTypeWithContextFunc(ctx, p)
+	}
+	if m.UpdateTrafficTypeFunc != nil {
+		return m.UpdateTrafficTypeFunc(p)
+	}
+	r1 = notMocked("UsageService.UpdateTrafficTypeWithContext")
+	return
+}
+
+// UpdateTrafficTypeAsync records the call and calls UpdateTrafficTypeAsyncFunc, or UpdateTrafficTypeAsyncWithContextFunc if only that is set
+func (m *MockUsageService) UpdateTrafficTypeAsync(p *cloudstack.UpdateTrafficTypeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateTrafficTypeAsync", p)
+	if m.UpdateTrafficTypeAsyncFunc != nil {
+		return m.UpdateTrafficTypeAsyncFunc(p)
+	}
+	if m.UpdateTrafficTypeAsyncWithContextFunc != nil {
+		return m.UpdateTrafficTypeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UsageService.UpdateTrafficTypeAsync")
+	return
+}
+
+// UpdateTrafficTypeAsyncWithContext records the call and calls UpdateTrafficTypeAsyncWithContextFunc, or UpdateTrafficTypeAsyncFunc if only that is set
+func (m *MockUsageService) UpdateTrafficTypeAsyncWithContext(ctx context.Context, p *cloudstack.UpdateTrafficTypeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateTrafficTypeAsyncWithContext", ctx, p)
+	if m.UpdateTrafficTypeAsyncWithContextFunc != nil {
+		return m.UpdateTrafficTypeAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateTrafficTypeAsyncFunc != nil {
+		return m.UpdateTrafficTypeAsyncFunc(p)
+	}
+	r1 = notMocked("UsageService.UpdateTrafficTypeAsyncWithContext")
+	return
+}
@@ -0,0 +1,589 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockUserService is a mock of cloudstack.UserServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockUserService struct {
+	Recorder
+
+	NewCreateUserParamsFunc                  func(account string, email string, firstname string, lastname string, password string, username string) *cloudstack.CreateUserParams
+	CreateUserFunc                           func(p *cloudstack.CreateUserParams) (*cloudstack.CreateUserResponse, error)
+	CreateUserWithContextFunc                func(ctx context.Context, p *cloudstack.CreateUserParams) (*cloudstack.CreateUserResponse, error)
+	NewDeleteUserParamsFunc                  func(id string) *cloudstack.DeleteUserParams
+	DeleteUserFunc                           func(p *cloudstack.DeleteUserParams) (*cloudstack.DeleteUserResponse, error)
+	DeleteUserWithContextFunc                func(ctx context.Context, p *cloudstack.DeleteUserParams) (*cloudstack.DeleteUserResponse, error)
+	NewDisableUserParamsFunc                 func(id string) *cloudstack.DisableUserParams
+	DisableUserFunc                          func(p *cloudstack.DisableUserParams) (*cloudstack.DisableUserResponse, error)
+	DisableUserWithContextFunc               func(ctx context.Context, p *cloudstack.DisableUserParams) (*cloudstack.DisableUserResponse, error)
+	DisableUserAsyncFunc                     func(p *cloudstack.DisableUserParams) (*cloudstack.Job, error)
+	DisableUserAsyncWithContextFunc          func(ctx context.Context, p *cloudstack.DisableUserParams) (*cloudstack.Job, error)
+	NewEnableUserParamsFunc                  func(id string) *cloudstack.EnableUserParams
+	EnableUserFunc                           func(p *cloudstack.EnableUserParams) (*cloudstack.EnableUserResponse, error)
+	EnableUserWithContextFunc                func(ctx context.Context, p *cloudstack.EnableUserParams) (*cloudstack.EnableUserResponse, error)
+	NewGetUserParamsFunc                     func(userapikey string) *cloudstack.GetUserParams
+	GetUserFunc                              func(p *cloudstack.GetUserParams) (*cloudstack.GetUserResponse, error)
+	GetUserWithContextFunc                   func(ctx context.Context, p *cloudstack.GetUserParams) (*cloudstack.GetUserResponse, error)
+	NewGetUserKeysParamsFunc                 func(id string) *cloudstack.GetUserKeysParams
+	GetUserKeysFunc                          func(p *cloudstack.GetUserKeysParams) (*cloudstack.GetUserKeysResponse, error)
+	GetUserKeysWithContextFunc               func(ctx context.Context, p *cloudstack.GetUserKeysParams) (*cloudstack.GetUserKeysResponse, error)
+	NewGetVirtualMachineUserDataParamsFunc   func(virtualmachineid string) *cloudstack.GetVirtualMachineUserDataParams
+	GetVirtualMachineUserDataFunc            func(p *cloudstack.GetVirtualMachineUserDataParams) (*cloudstack.GetVirtualMachineUserDataResponse, error)
+	GetVirtualMachineUserDataWithContextFunc func(ctx context.Context, p *cloudstack.GetVirtualMachineUserDataParams) (*cloudstack.GetVirtualMachineUserDataResponse, error)
+	NewListUsersParamsFunc                   func() *cloudstack.ListUsersParams
+	GetUserByIDFunc                          func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.User, int, error)
+	GetUserByIDWithContextFunc               func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.User, int, error)
+	ListUsersFunc                            func(p *cloudstack.ListUsersParams) (*cloudstack.ListUsersResponse, error)
+	ListUsersWithContextFunc                 func(ctx context.Context, p *cloudstack.ListUsersParams) (*cloudstack.ListUsersResponse, error)
+	NewListUsersPagerFunc                    func(p *cloudstack.ListUsersParams) *cloudstack.ListUsersPager
+	ListUsersAllFunc                         func(p *cloudstack.ListUsersParams) (*cloudstack.ListUsersResponse, error)
+	ListUsersAllWithContextFunc              func(ctx context.Context, p *cloudstack.ListUsersParams) (*cloudstack.ListUsersResponse, error)
+	NewLockUserParamsFunc                    func(id string) *cloudstack.LockUserParams
+	LockUserFunc                             func(p *cloudstack.LockUserParams) (*cloudstack.LockUserResponse, error)
+	LockUserWithContextFunc                  func(ctx context.Context, p *cloudstack.LockUserParams) (*cloudstack.LockUserResponse, error)
+	NewMoveUserParamsFunc                    func(id string) *cloudstack.MoveUserParams
+	MoveUserFunc                             func(p *cloudstack.MoveUserParams) (*cloudstack.MoveUserResponse, error)
+	MoveUserWithContextFunc                  func(ctx context.Context, p *cloudstack.MoveUserParams) (*cloudstack.MoveUserResponse, error)
+	NewRegisterUserKeysParamsFunc            func(id string) *cloudstack.RegisterUserKeysParams
+	RegisterUserKeysFunc                     func(p *cloudstack.RegisterUserKeysParams) (*cloudstack.RegisterUserKeysResponse, error)
+	RegisterUserKeysWithContextFunc          func(ctx context.Context, p *cloudstack.RegisterUserKeysParams) (*cloudstack.RegisterUserKeysResponse, error)
+	NewUpdateUserParamsFunc                  func(id string) *cloudstack.UpdateUserParams
+	UpdateUserFunc                           func(p *cloudstack.UpdateUserParams) (*cloudstack.UpdateUserResponse, error)
+	UpdateUserWithContextFunc                func(ctx context.Context, p *cloudstack.UpdateUserParams) (*cloudstack.UpdateUserResponse, error)
+}
+
+var _ cloudstack.UserServiceIface = (*MockUserService)(nil)
+
+// NewCreateUserParams records the call and calls NewCreateUserParamsFunc
+func (m *MockUserService) NewCreateUserParams(account string, email string, firstname string, lastname string, password string, username string) (r0 *cloudstack.CreateUserParams) {
+	m.record("NewCreateUserParams", account, email, firstname, lastname, password, username)
+	if m.NewCreateUserParamsFunc != nil {
+		return m.NewCreateUserParamsFunc(account, email, firstname, lastname, password, username)
+	}
+	return cloudstack.NewUserService(nil).NewCreateUserParams(account, email, firstname, lastname, password, username)
+}
+
+// CreateUser records the call and calls CreateUserFunc, or CreateUserWithContextFunc if only that is set
+func (m *MockUserService) CreateUser(p *cloudstack.CreateUserParams) (r0 *cloudstack.CreateUserResponse, r1 error) {
+	m.record("CreateUser", p)
+	if m.CreateUserFunc != nil {
+		return m.CreateUserFunc(p)
+	}
+	if m.CreateUserWithContextFunc != nil {
+		return m.CreateUserWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UserService.CreateUser")
+	return
+}
+
+// CreateUserWithContext records the call and calls CreateUserWithContextFunc, or CreateUserFunc if only that is set
+func (m *MockUserService) CreateUserWithContext(ctx context.Context, p *cloudstack.CreateUserParams) (r0 *cloudstack.CreateUserResponse, r1 error) {
+	m.record("CreateUserWithContext", ctx, p)
+	if m.CreateUserWithContextFunc != nil {
+		return m.CreateUserWithContextFunc(ctx, p)
+	}
+	if m.CreateUserFunc != nil {
+		return m.CreateUserFunc(p)
+	}
+	r1 = notMocked("UserService.CreateUserWithContext")
+	return
+}
+
+// NewDeleteUserParams records the call and calls NewDeleteUserParamsFunc
+func (m *MockUserService) NewDeleteUserParams(id string) (r0 *cloudstack.DeleteUserParams) {
+	m.record("NewDeleteUserParams", id)
+	if m.NewDeleteUserParamsFunc != nil {
+		return m.NewDeleteUserParamsFunc(id)
+	}
+	return cloudstack.NewUserService(nil).NewDeleteUserParams(id)
+}
+
+// DeleteUser records the call and calls DeleteUserFunc, or DeleteUserWithContextFunc if only that is set
+func (m *MockUserService) DeleteUser(p *cloudstack.DeleteUserParams) (r0 *cloudstack.DeleteUserResponse, r1 error) {
+	m.record("DeleteUser", p)
+	if m.DeleteUserFunc != nil {
+		return m.DeleteUserFunc(p)
+	}
+	if m.DeleteUserWithContextFunc != nil {
+		return m.DeleteUserWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UserService.DeleteUser")
+	return
+}
+
+// DeleteUserWithContext records the call and calls DeleteUserWithContextFunc, or DeleteUserFunc if only that is set
+func (m *MockUserService) DeleteUserWithContext(ctx context.Context, p *cloudstack.DeleteUserParams) (r0 *cloudstack.DeleteUserResponse, r1 error) {
+	m.record("DeleteUserWithContext", ctx, p)
+	if m.DeleteUserWithContextFunc != nil {
+		return m.DeleteUserWithContextFunc(ctx, p)
+	}
+	if m.DeleteUserFunc != nil {
+		return m.DeleteUserFunc(p)
+	}
+	r1 = notMocked("UserService.DeleteUserWithContext")
+	return
+}
+
+// NewDisableUserParams records the call and calls NewDisableUserParamsFunc
+func (m *MockUserService) NewDisableUserParams(id string) (r0 *cloudstack.DisableUserParams) {
+	m.record("NewDisableUserParams", id)
+	if m.NewDisableUserParamsFunc != nil {
+		return m.NewDisableUserParamsFunc(id)
+	}
+	return cloudstack.NewUserService(nil).NewDisableUserParams(id)
+}
+
+// DisableUser records the call and calls DisableUserFunc, or DisableUserWithContextFunc if only that is set
+func (m *MockUserService) DisableUser(p *cloudstack.DisableUserParams) (r0 *cloudstack.DisableUserResponse, r1 error) {
+	m.record("DisableUser", p)
+	if m.DisableUserFunc != nil {
+		return m.DisableUserFunc(p)
+	}
+	if m.DisableUserWithContextFunc != nil {
+		return m.DisableUserWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UserService.DisableUser")
+	return
+}
+
+// DisableUserWithContext records the call and calls DisableUserWithContextFunc, or DisableUserFunc if only that is set
+func (m *MockUserService) DisableUserWithContext(ctx context.Context, p *cloudstack.DisableUserParams) (r0 *cloudstack.DisableUserResponse, r1 error) {
+	m.record("DisableUserWithContext", ctx, p)
+	if m.DisableUserWithContextFunc != nil {
+		return m.DisableUserWithContextFunc(ctx, p)
+	}
+	if m.DisableUserFunc != nil {
+		return m.DisableUserFunc(p)
+	}
+	r1 = notMocked("UserService.DisableUserWithContext")
+	return
+}
+
+// DisableUserAsync records the call and calls DisableUserAsyncFunc, or DisableUserAsyncWithContextFunc if only that is set
+func (m *MockUserService) DisableUserAsync(p *cloudstack.DisableUserParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableUserAsync", p)
+	if m.DisableUserAsyncFunc != nil {
+		return m.DisableUserAsyncFunc(p)
+	}
+	if m.DisableUserAsyncWithContextFunc != nil {
+		return m.DisableUserAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UserService.DisableUserAsync")
+	return
+}
+
+// DisableUserAsyncWithContext records the call and calls DisableUserAsyncWithContextFunc, or DisableUserAsyncFunc if only that is set
+func (m *MockUserService) DisableUserAsyncWithContext(ctx context.Context, p *cloudstack.DisableUserParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableUserAsyncWithContext", ctx, p)
+	if m.DisableUserAsyncWithContextFunc != nil {
+		return m.DisableUserAsyncWithContextFunc(ctx, p)
+	}
+	if m.DisableUserAsyncFunc != nil {
+		return m.DisableUserAsyncFunc(p)
+	}
+	r1 = notMocked("UserService.DisableUserAsyncWithContext")
+	return
+}
+
+// NewEnableUserParams records the call and calls NewEnableUserParamsFunc
+func (m *MockUserService) NewEnableUserParams(id string) (r0 *cloudstack.EnableUserParams) {
+	m.record("NewEnableUserParams", id)
+	if m.NewEnableUserParamsFunc != nil {
+		return m.NewEnableUserParamsFunc(id)
+	}
+	return cloudstack.NewUserService(nil).NewEnableUserParams(id)
+}
+
+// EnableUser records the call and calls EnableUserFunc, or EnableUserWithContextFunc if only that is set
+func (m *MockUserService) EnableUser(p *cloudstack.EnableUserParams) (r0 *cloudstack.EnableUserResponse, r1 error) {
+	m.record("EnableUser", p)
+	if m.EnableUserFunc != nil {
+		return m.EnableUserFunc(p)
+	}
+	if m.EnableUserWithContextFunc != nil {
+		return m.EnableUserWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UserService.EnableUser")
+	return
+}
+
+// EnableUserWithContext records the call and calls EnableUserWithContextFunc, or EnableUserFunc if only that is set
+func (m *MockUserService) EnableUserWithContext(ctx context.Context, p *cloudstack.EnableUserParams) (r0 *cloudstack.EnableUserResponse, r1 error) {
+	m.record("EnableUserWithContext", ctx, p)
+	if m.EnableUserWithContextFunc != nil {
+		return m.EnableUserWithContextFunc(ctx, p)
+	}
+	if m.EnableUserFunc != nil {
+		return m.EnableUserFunc(p)
+	}
+	r1 = notMocked("UserService.EnableUserWithContext")
+	return
+}
+
+// NewGetUserParams records the call and calls NewGetUserParamsFunc
+func (m *MockUserService) NewGetUserParams(userapikey string) (r0 *cloudstack.GetUserParams) {
+	m.record("NewGetUserParams", userapikey)
+	if m.NewGetUserParamsFunc != nil {
+		return m.NewGetUserParamsFunc(userapikey)
+	}
+	return cloudstack.NewUserService(nil).NewGetUserParams(userapikey)
+}
+
+// GetUser records the call and calls GetUserFunc, or GetUserWithContextFunc if only that is set
+func (m *MockUserService) GetUser(p *cloudstack.GetUserParams) (r0 *cloudstack.GetUserResponse, r1 error) {
+	m.record("GetUser", p)
+	if m.GetUserFunc != nil {
+		return m.GetUserFunc(p)
+	}
+	if m.GetUserWithContextFunc != nil {
+		return m.GetUserWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UserService.GetUser")
+	return
+}
+
+// GetUserWithContext records the call and calls GetUserWithContextFunc, or GetUserFunc if only that is set
+func (m *MockUserService) GetUserWithContext(ctx context.Context, p *cloudstack.GetUserParams) (r0 *cloudstack.GetUserResponse, r1 error) {
+	m.record("GetUserWithContext", ctx, p)
+	if m.GetUserWithContextFunc != nil {
+		return m.GetUserWithContextFunc(ctx, p)
+	}
+	if m.GetUserFunc != nil {
+		return m.GetUserFunc(p)
+	}
+	r1 = notMocked("UserService.GetUserWithContext")
+	return
+}
+
+// NewGetUserKeysParams records the call and calls NewGetUserKeysParamsFunc
+func (m *MockUserService) NewGetUserKeysParams(id string) (r0 *cloudstack.GetUserKeysParams) {
+	m.record("NewGetUserKeysParams", id)
+	if m.NewGetUserKeysParamsFunc != nil {
+		return m.NewGetUserKeysParamsFunc(id)
+	}
+	return cloudstack.NewUserService(nil).NewGetUserKeysParams(id)
+}
+
+// GetUserKeys records the call and calls GetUserKeysFunc, or GetUserKeysWithContextFunc if only that is set
+func (m *MockUserService) GetUserKeys(p *cloudstack.GetUserKeysParams) (r0 *cloudstack.GetUserKeysResponse, r1 error) {
+	m.record("GetUserKeys", p)
+	if m.GetUserKeysFunc != nil {
+		return m.GetUserKeysFunc(p)
+	}
+	if m.GetUserKeysWithContextFunc != nil {
+		return m.GetUserKeysWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UserService.GetUserKeys")
+	return
+}
+
+// GetUserKeysWithContext records the call and calls GetUserKeysWithContextFunc, or GetUserKeysFunc if only that is set
+func (m *MockUserService) GetUserKeysWithContext(ctx context.Context, p *cloudstack.GetUserKeysParams) (r0 *cloudstack.GetUserKeysResponse, r1 error) {
+	m.record("GetUserKeysWithContext", ctx, p)
+	if m.GetUserKeysWithContextFunc != nil {
+		return m.GetUserKeysWithContextFunc(ctx, p)
+	}
+	if m.GetUserKeysFunc != nil {
+		return m.GetUserKeysFunc(p)
+	}
+	r1 = notMocked("UserService.GetUserKeysWithContext")
+	return
+}
+
+// NewGetVirtualMachineUserDataParams records the call and calls NewGetVirtualMachineUserDataParamsFunc
+func (m *MockUserService) NewGetVirtualMachineUserDataParams(virtualmachineid string) (r0 *cloudstack.GetVirtualMachineUserDataParams) {
+	m.record("NewGetVirtualMachineUserDataParams", virtualmachineid)
+	if m.NewGetVirtualMachineUserDataParamsFunc != nil {
+		return m.NewGetVirtualMachineUserDataParamsFunc(virtualmachineid)
+	}
+	return cloudstack.NewUserService(nil).NewGetVirtualMachineUserDataParams(virtualmachineid)
+}
+
+// GetVirtualMachineUserData records the call and calls GetVirtualMachineUserDataFunc, or GetVirtualMachineUserDataWithContextFunc if only that is set
+func (m *MockUserService) GetVirtualMachineUserData(p *cloudstack.GetVirtualMachineUserDataParams) (r0 *cloudstack.GetVirtualMachineUserDataResponse, r1 error) {
+	m.record("GetVirtualMachineUserData", p)
+	if m.GetVirtualMachineUserDataFunc != nil {
+		return m.GetVirtualMachineUserDataFunc(p)
+	}
+	if m.GetVirtualMachineUserDataWithContextFunc != nil {
+		return m.GetVirtualMachineUserDataWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UserService.GetVirtualMachineUserData")
+	return
+}
+
+// GetVirtualMachineUserDataWithContext records the call and calls GetVirtualMachineUserDataWithContextFunc, or GetVirtualMachineUserDataFunc if only that is set
+func (m *MockUserService) GetVirtualMachineUserDataWithContext(ctx context.Context, p *cloudstack.GetVirtualMachineUserDataParams) (r0 *cloudstack.GetVirtualMachineUserDataResponse, r1 error) {
+	m.record("GetVirtualMachineUserDataWithContext", ctx, p)
+	if m.GetVirtualMachineUserDataWithContextFunc != nil {
+		return m.GetVirtualMachineUserDataWithContextFunc(ctx, p)
+	}
+	if m.GetVirtualMachineUserDataFunc != nil {
+		return m.GetVirtualMachineUserDataFunc(p)
+	}
+	r1 = notMocked("UserService.GetVirtualMachineUserDataWithContext")
+	return
+}
+
+// NewListUsersParams records the call and calls NewListUsersParamsFunc
+func (m *MockUserService) NewListUsersParams() (r0 *cloudstack.ListUsersParams) {
+	m.record("NewListUsersParams")
+	if m.NewListUsersParamsFunc != nil {
+		return m.NewListUsersParamsFunc()
+	}
+	return cloudstack.NewUserService(nil).NewListUsersParams()
+}
+
+// GetUserByID records the call and calls GetUserByIDFunc, or GetUserByIDWithContextFunc if only that is set
+func (m *MockUserService) GetUserByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.User, r1 int, r2 error) {
+	m.record("GetUserByID", id, opts)
+	if m.GetUserByIDFunc != nil {
+		return m.GetUserByIDFunc(id, opts...)
+	}
+	if m.GetUserByIDWithContextFunc != nil {
+		return m.GetUserByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("UserService.GetUserByID")
+	return
+}
+
+// GetUserByIDWithContext records the call and calls GetUserByIDWithContextFunc, or GetUserByIDFunc if only that is set
+func (m *MockUserService) GetUserByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.User, r1 int, r2 error) {
+	m.record("GetUserByIDWithContext", ctx, id, opts)
+	if m.GetUserByIDWithContextFunc != nil {
+		return m.GetUserByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetUserByIDFunc != nil {
+		return m.GetUserByIDFunc(id, opts...)
+	}
+	r2 = notMocked("UserService.GetUserByIDWithContext")
+	return
+}
+
+// ListUsers records the call and calls ListUsersFunc, or ListUsersWithContextFunc if only that is set
+func (m *MockUserService) ListUsers(p *cloudstack.ListUsersParams) (r0 *cloudstack.ListUsersResponse, r1 error) {
+	m.record("ListUsers", p)
+	if m.ListUsersFunc != nil {
+		return m.ListUsersFunc(p)
+	}
+	if m.ListUsersWithContextFunc != nil {
+		return m.ListUsersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UserService.ListUsers")
+	return
+}
+
+// ListUsersWithContext records the call and calls ListUsersWithContextFunc, or ListUsersFunc if only that is set
+func (m *MockUserService) ListUsersWithContext(ctx context.Context, p *cloudstack.ListUsersParams) (r0 *cloudstack.ListUsersResponse, r1 error) {
+	m.record("ListUsersWithContext", ctx, p)
+	if m.ListUsersWithContextFunc != nil {
+		return m.ListUsersWithContextFunc(ctx, p)
+	}
+	if m.ListUsersFunc != nil {
+		return m.ListUsersFunc(p)
+	}
+	r1 = notMocked("UserService.ListUsersWithContext")
+	return
+}
+
+// NewListUsersPager records the call and calls NewListUsersPagerFunc
+func (m *MockUserService) NewListUsersPager(p *cloudstack.ListUsersParams) (r0 *cloudstack.ListUsersPager) {
+	m.record("NewListUsersPager", p)
+	if m.NewListUsersPagerFunc != nil {
+		return m.NewListUsersPagerFunc(p)
+	}
+	return
+}
+
+// ListUsersAll records the call and calls ListUsersAllFunc, or ListUsersAllWithContextFunc if only that is set
+func (m *MockUserService) ListUsersAll(p *cloudstack.ListUsersParams) (r0 *cloudstack.ListUsersResponse, r1 error) {
+	m.record("ListUsersAll", p)
+	if m.ListUsersAllFunc != nil {
+		return m.ListUsersAllFunc(p)
+	}
+	if m.ListUsersAllWithContextFunc != nil {
+		return m.ListUsersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UserService.ListUsersAll")
+	return
+}
+
+// ListUsersAllWithContext records the call and calls ListUsersAllWithContextFunc, or ListUsersAllFunc if only that is set
+func (m *MockUserService) ListUsersAllWithContext(ctx context.Context, p *cloudstack.ListUsersParams) (r0 *cloudstack.ListUsersResponse, r1 error) {
+	m.record("ListUsersAllWithContext", ctx, p)
+	if m.ListUsersAllWithContextFunc != nil {
+		return m.ListUsersAllWithContextFunc(ctx, p)
+	}
+	if m.ListUsersAllFunc != nil {
+		return m.ListUsersAllFunc(p)
+	}
+	r1 = notMocked("UserService.ListUsersAllWithContext")
+	return
+}
+
+// NewLockUserParams records the call and calls NewLockUserParamsFunc
+func (m *MockUserService) NewLockUserParams(id string) (r0 *cloudstack.LockUserParams) {
+	m.record("NewLockUserParams", id)
+	if m.NewLockUserParamsFunc != nil {
+		return m.NewLockUserParamsFunc(id)
+	}
+	return cloudstack.NewUserService(nil).NewLockUserParams(id)
+}
+
+// LockUser records the call and calls LockUserFunc, or LockUserWithContextFunc if only that is set
+func (m *MockUserService) LockUser(p *cloudstack.LockUserParams) (r0 *cloudstack.LockUserResponse, r1 error) {
+	m.record("LockUser", p)
+	if m.LockUserFunc != nil {
+		return m.LockUserFunc(p)
+	}
+	if m.LockUserWithContextFunc != nil {
+		return m.LockUserWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UserService.LockUser")
+	return
+}
+
+// LockUserWithContext records the call and calls LockUserWithContextFunc, or LockUserFunc if only that is set
+func (m *MockUserService) LockUserWithContext(ctx context.Context, p *cloudstack.LockUserParams) (r0 *cloudstack.LockUserResponse, r1 error) {
+	m.record("LockUserWithContext", ctx, p)
+	if m.LockUserWithContextFunc != nil {
+		return m.LockUserWithContextFunc(ctx, p)
+	}
+	if m.LockUserFunc != nil {
+		return m.LockUserFunc(p)
+	}
+	r1 = notMocked("UserService.LockUserWithContext")
+	return
+}
+
+// NewMoveUserParams records the call and calls NewMoveUserParamsFunc
+func (m *MockUserService) NewMoveUserParams(id string) (r0 *cloudstack.MoveUserParams) {
+	m.record("NewMoveUserParams", id)
+	if m.NewMoveUserParamsFunc != nil {
+		return m.NewMoveUserParamsFunc(id)
+	}
+	return cloudstack.NewUserService(nil).NewMoveUserParams(id)
+}
+
+// MoveUser records the call and calls MoveUserFunc, or MoveUserWithContextFunc if only that is set
+func (m *MockUserService) MoveUser(p *cloudstack.MoveUserParams) (r0 *cloudstack.MoveUserResponse, r1 error) {
+	m.record("MoveUser", p)
+	if m.MoveUserFunc != nil {
+		return m.MoveUserFunc(p)
+	}
+	if m.MoveUserWithContextFunc != nil {
+		return m.MoveUserWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UserService.MoveUser")
+	return
+}
+
+// MoveUserWithContext records the call and calls MoveUserWithContextFunc, or MoveUserFunc if only that is set
+func (m *MockUserService) MoveUserWithContext(ctx context.Context, p *cloudstack.MoveUserParams) (r0 *cloudstack.MoveUserResponse, r1 error) {
+	m.record("MoveUserWithContext", ctx, p)
+	if m.MoveUserWithContextFunc != nil {
+		return m.MoveUserWithContextFunc(ctx, p)
+	}
+	if m.MoveUserFunc != nil {
+		return m.MoveUserFunc(p)
+	}
+	r1 = notMocked("UserService.MoveUserWithContext")
+	return
+}
+
+// NewRegisterUserKeysParams records the call and calls NewRegisterUserKeysParamsFunc
+func (m *MockUserService) NewRegisterUserKeysParams(id string) (r0 *cloudstack.RegisterUserKeysParams) {
+	m.record("NewRegisterUserKeysParams", id)
+	if m.NewRegisterUserKeysParamsFunc != nil {
+		return m.NewRegisterUserKeysParamsFunc(id)
+	}
+	return cloudstack.NewUserService(nil).NewRegisterUserKeysParams(id)
+}
+
+// RegisterUserKeys records the call and calls RegisterUserKeysFunc, or RegisterUserKeysWithContextFunc if only that is set
+func (m *MockUserService) RegisterUserKeys(p *cloudstack.RegisterUserKeysParams) (r0 *cloudstack.RegisterUserKeysResponse, r1 error) {
+	m.record("RegisterUserKeys", p)
+	if m.RegisterUserKeysFunc != nil {
+		return m.RegisterUserKeysFunc(p)
+	}
+	if m.RegisterUserKeysWithContextFunc != nil {
+		return m.RegisterUserKeysWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UserService.RegisterUserKeys")
+	return
+}
+
+// RegisterUserKeysWithContext records the call and calls RegisterUserKeysWithContextFunc, or RegisterUserKeysFunc if only that is set
+func (m *MockUserService) RegisterUserKeysWithContext(ctx context.Context, p *cloudstack.RegisterUserKeysParams) (r0 *cloudstack.RegisterUserKeysResponse, r1 error) {
+	m.record("RegisterUserKeysWithContext", ctx, p)
+	if m.RegisterUserKeysWithContextFunc != nil {
+		return m.RegisterUserKeysWithContextFunc(ctx, p)
+	}
+	if m.RegisterUserKeysFunc != nil {
+		return m.RegisterUserKeysFunc(p)
+	}
+	r1 = notMocked("UserService.RegisterUserKeysWithContext")
+	return
+}
+
+// NewUpdateUserParams records the call and calls NewUpdateUserParamsFunc
+func (m *MockUserService) NewUpdateUserParams(id string) (r0 *cloudstack.UpdateUserParams) {
+	m.record("NewUpdateUserParams", id)
+	if m.NewUpdateUserParamsFunc != nil {
+		return m.NewUpdateUserParamsFunc(id)
+	}
+	return cloudstack.NewUserService(nil).NewUpdateUserParams(id)
+}
+
+// UpdateUser records the call and calls UpdateUserFunc, or UpdateUserWithContextFunc if only that is set
+func (m *MockUserService) UpdateUser(p *cloudstack.UpdateUserParams) (r0 *cloudstack.UpdateUserResponse, r1 error) {
+	m.record("UpdateUser", p)
+	if m.UpdateUserFunc != nil {
+		return m.UpdateUserFunc(p)
+	}
+	if m.UpdateUserWithContextFunc != nil {
+		return m.UpdateUserWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("UserService.UpdateUser")
+	return
+}
+
+// UpdateUserWithContext records the call and calls UpdateUserWithContextFunc, or UpdateUserFunc if only that is set
+func (m *MockUserService) UpdateUserWithContext(ctx context.Context, p *cloudstack.UpdateUserParams) (r0 *cloudstack.UpdateUserResponse, r1 error) {
+	m.record("UpdateUserWithContext", ctx, p)
+	if m.UpdateUserWithContextFunc != nil {
+		return m.UpdateUserWithContextFunc(ctx, p)
+	}
+	if m.UpdateUserFunc != nil {
+		return m.UpdateUserFunc(p)
+	}
+	r1 = notMocked("UserService.UpdateUserWithContext")
+	return
+}
@@ -0,0 +1,427 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockVLANService is a mock of cloudstack.VLANServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockVLANService struct {
+	Recorder
+
+	NewCreateVlanIpRangeParamsFunc                     func() *cloudstack.CreateVlanIpRangeParams
+	CreateVlanIpRangeFunc                              func(p *cloudstack.CreateVlanIpRangeParams) (*cloudstack.CreateVlanIpRangeResponse, error)
+	CreateVlanIpRangeWithContextFunc                   func(ctx context.Context, p *cloudstack.CreateVlanIpRangeParams) (*cloudstack.CreateVlanIpRangeResponse, error)
+	NewDedicateGuestVlanRangeParamsFunc                func(physicalnetworkid string, vlanrange string) *cloudstack.DedicateGuestVlanRangeParams
+	DedicateGuestVlanRangeFunc                         func(p *cloudstack.DedicateGuestVlanRangeParams) (*cloudstack.DedicateGuestVlanRangeResponse, error)
+	DedicateGuestVlanRangeWithContextFunc              func(ctx context.Context, p *cloudstack.DedicateGuestVlanRangeParams) (*cloudstack.DedicateGuestVlanRangeResponse, error)
+	NewDeleteVlanIpRangeParamsFunc                     func(id string) *cloudstack.DeleteVlanIpRangeParams
+	DeleteVlanIpRangeFunc                              func(p *cloudstack.DeleteVlanIpRangeParams) (*cloudstack.DeleteVlanIpRangeResponse, error)
+	DeleteVlanIpRangeWithContextFunc                   func(ctx context.Context, p *cloudstack.DeleteVlanIpRangeParams) (*cloudstack.DeleteVlanIpRangeResponse, error)
+	NewListDedicatedGuestVlanRangesParamsFunc          func() *cloudstack.ListDedicatedGuestVlanRangesParams
+	GetDedicatedGuestVlanRangeByIDFunc                 func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.DedicatedGuestVlanRange, int, error)
+	GetDedicatedGuestVlanRangeByIDWithContextFunc      func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.DedicatedGuestVlanRange, int, error)
+	ListDedicatedGuestVlanRangesFunc                   func(p *cloudstack.ListDedicatedGuestVlanRangesParams) (*cloudstack.ListDedicatedGuestVlanRangesResponse, error)
+	ListDedicatedGuestVlanRangesWithContextFunc        func(ctx context.Context, p *cloudstack.ListDedicatedGuestVlanRangesParams) (*cloudstack.ListDedicatedGuestVlanRangesResponse, error)
+	NewListDedicatedGuestVlanRangesPagerFunc           func(p *cloudstack.ListDedicatedGuestVlanRangesParams) *cloudstack.ListDedicatedGuestVlanRangesPager
+	ListDedicatedGuestVlanRangesAllFunc                func(p *cloudstack.ListDedicatedGuestVlanRangesParams) (*cloudstack.ListDedicatedGuestVlanRangesResponse, error)
+	ListDedicatedGuestVlanRangesAllWithContextFunc     func(ctx context.Context, p *cloudstack.ListDedicatedGuestVlanRangesParams) (*cloudstack.ListDedicatedGuestVlanRangesResponse, error)
+	NewListVlanIpRangesParamsFunc                      func() *cloudstack.ListVlanIpRangesParams
+	GetVlanIpRangeByIDFunc                             func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.VlanIpRange, int, error)
+	GetVlanIpRangeByIDWithContextFunc                  func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.VlanIpRange, int, error)
+	ListVlanIpRangesFunc                               func(p *cloudstack.ListVlanIpRangesParams) (*cloudstack.ListVlanIpRangesResponse, error)
+	ListVlanIpRangesWithContextFunc                    func(ctx context.Context, p *cloudstack.ListVlanIpRangesParams) (*cloudstack.ListVlanIpRangesResponse, error)
+	NewListVlanIpRangesPagerFunc                       func(p *cloudstack.ListVlanIpRangesParams) *cloudstack.ListVlanIpRangesPager
+	ListVlanIpRangesAllFunc                            func(p *cloudstack.ListVlanIpRangesParams) (*cloudstack.ListVlanIpRangesResponse, error)
+	ListVlanIpRangesAllWithContextFunc                 func(ctx context.Context, p *cloudstack.ListVlanIpRangesParams) (*cloudstack.ListVlanIpRangesResponse, error)
+	NewReleaseDedicatedGuestVlanRangeParamsFunc        func(id string) *cloudstack.ReleaseDedicatedGuestVlanRangeParams
+	ReleaseDedicatedGuestVlanRangeFunc                 func(p *cloudstack.ReleaseDedicatedGuestVlanRangeParams) (*cloudstack.ReleaseDedicatedGuestVlanRangeResponse, error)
+	ReleaseDedicatedGuestVlanRangeWithContextFunc      func(ctx context.Context, p *cloudstack.ReleaseDedicatedGuestVlanRangeParams) (*cloudstack.ReleaseDedicatedGuestVlanRangeResponse, error)
+	ReleaseDedicatedGuestVlanRangeAsyncFunc            func(p *cloudstack.ReleaseDedicatedGuestVlanRangeParams) (*cloudstack.Job, error)
+	ReleaseDedicatedGuestVlanRangeAsyncWithContextFunc func(ctx context.Context, p *cloudstack.ReleaseDedicatedGuestVlanRangeParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.VLANServiceIface = (*MockVLANService)(nil)
+
+// NewCreateVlanIpRangeParams records the call and calls NewCreateVlanIpRangeParamsFunc
+func (m *MockVLANService) NewCreateVlanIpRangeParams() (r0 *cloudstack.CreateVlanIpRangeParams) {
+	m.record("NewCreateVlanIpRangeParams")
+	if m.NewCreateVlanIpRangeParamsFunc != nil {
+		return m.NewCreateVlanIpRangeParamsFunc()
+	}
+	return cloudstack.NewVLANService(nil).NewCreateVlanIpRangeParams()
+}
+
+// CreateVlanIpRange records the call and calls CreateVlanIpRangeFunc, or CreateVlanIpRangeWithContextFunc if only that is set
+func (m *MockVLANService) CreateVlanIpRange(p *cloudstack.CreateVlanIpRangeParams) (r0 *cloudstack.CreateVlanIpRangeResponse, r1 error) {
+	m.record("CreateVlanIpRange", p)
+	if m.CreateVlanIpRangeFunc != nil {
+		return m.CreateVlanIpRangeFunc(p)
+	}
+	if m.CreateVlanIpRangeWithContextFunc != nil {
+		return m.CreateVlanIpRangeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VLANService.CreateVlanIpRange")
+	return
+}
+
+// CreateVlanIpRangeWithContext records the call and calls CreateVlanIpRangeWithContextFunc, or CreateVlanIpRangeFunc if only that is set
+func (m *MockVLANService) CreateVlanIpRangeWithContext(ctx context.Context, p *cloudstack.CreateVlanIpRangeParams) (r0 *cloudstack.CreateVlanIpRangeResponse, r1 error) {
+	m.record("CreateVlanIpRangeWithContext", ctx, p)
+	if m.CreateVlanIpRangeWithContextFunc != nil {
+		return m.CreateVlanIpRangeWithContextFunc(ctx, p)
+	}
+	if m.CreateVlanIpRangeFunc != nil {
+		return m.CreateVlanIpRangeFunc(p)
+	}
+	r1 = notMocked("VLANService.CreateVlanIpRangeWithContext")
+	return
+}
+
+// NewDedicateGuestVlanRangeParams records the call and calls NewDedicateGuestVlanRangeParamsFunc
+func (m *MockVLANService) NewDedicateGuestVlanRangeParams(physicalnetworkid string, vlanrange string) (r0 *cloudstack.DedicateGuestVlanRangeParams) {
+	m.record("NewDedicateGuestVlanRangeParams", physicalnetworkid, vlanrange)
+	if m.NewDedicateGuestVlanRangeParamsFunc != nil {
+		return m.NewDedicateGuestVlanRangeParamsFunc(physicalnetworkid, vlanrange)
+	}
+	return cloudstack.NewVLANService(nil).NewDedicateGuestVlanRangeParams(physicalnetworkid, vlanrange)
+}
+
+// DedicateGuestVlanRange records the call and calls DedicateGuestVlanRangeFunc, or DedicateGuestVlanRangeWithContextFunc if only that is set
+func (m *MockVLANService) DedicateGuestVlanRange(p *cloudstack.DedicateGuestVlanRangeParams) (r0 *cloudstack.DedicateGuestVlanRangeResponse, r1 error) {
+	m.record("DedicateGuestVlanRange", p)
+	if m.DedicateGuestVlanRangeFunc != nil {
+		return m.DedicateGuestVlanRangeFunc(p)
+	}
+	if m.DedicateGuestVlanRangeWithContextFunc != nil {
+		return m.DedicateGuestVlanRangeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VLANService.DedicateGuestVlanRange")
+	return
+}
+
+// DedicateGuestVlanRangeWithContext records the call and calls DedicateGuestVlanRangeWithContextFunc, or DedicateGuestVlanRangeFunc if only that is set
+func (m *MockVLANService) DedicateGuestVlanRangeWithContext(ctx context.Context, p *cloudstack.DedicateGuestVlanRangeParams) (r0 *cloudstack.DedicateGuestVlanRangeResponse, r1 error) {
+	m.record("DedicateGuestVlanRangeWithContext", ctx, p)
+	if m.DedicateGuestVlanRangeWithContextFunc != nil {
+		return m.DedicateGuestVlanRangeWithContextFunc(ctx, p)
+	}
+	if m.DedicateGuestVlanRangeFunc != nil {
+		return m.DedicateGuestVlanRangeFunc(p)
+	}
+	r1 = notMocked("VLANService.DedicateGuestVlanRangeWithContext")
+	return
+}
+
+// NewDeleteVlanIpRangeParams records the call and calls NewDeleteVlanIpRangeParamsFunc
+func (m *MockVLANService) NewDeleteVlanIpRangeParams(id string) (r0 *cloudstack.DeleteVlanIpRangeParams) {
+	m.record("NewDeleteVlanIpRangeParams", id)
+	if m.NewDeleteVlanIpRangeParamsFunc != nil {
+		return m.NewDeleteVlanIpRangeParamsFunc(id)
+	}
+	return cloudstack.NewVLANService(nil).NewDeleteVlanIpRangeParams(id)
+}
+
+// DeleteVlanIpRange records the call and calls DeleteVlanIpRangeFunc, or DeleteVlanIpRangeWithContextFunc if only that is set
+func (m *MockVLANService) DeleteVlanIpRange(p *cloudstack.DeleteVlanIpRangeParams) (r0 *cloudstack.DeleteVlanIpRangeResponse, r1 error) {
+	m.record("DeleteVlanIpRange", p)
+	if m.DeleteVlanIpRangeFunc != nil {
+		return m.DeleteVlanIpRangeFunc(p)
+	}
+	if m.DeleteVlanIpRangeWithContextFunc != nil {
+		return m.DeleteVlanIpRangeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VLANService.DeleteVlanIpRange")
+	return
+}
+
+// DeleteVlanIpRangeWithContext records the call and calls DeleteVlanIpRangeWithContextFunc, or DeleteVlanIpRangeFunc if only that is set
+func (m *MockVLANService) DeleteVlanIpRangeWithContext(ctx context.Context, p *cloudstack.DeleteVlanIpRangeParams) (r0 *cloudstack.DeleteVlanIpRangeResponse, r1 error) {
+	m.record("DeleteVlanIpRangeWithContext", ctx, p)
+	if m.DeleteVlanIpRangeWithContextFunc != nil {
+		return m.DeleteVlanIpRangeWithContextFunc(ctx, p)
+	}
+	if m.DeleteVlanIpRangeFunc != nil {
+		return m.DeleteVlanIpRangeFunc(p)
+	}
+	r1 = notMocked("VLANService.DeleteVlanIpRangeWithContext")
+	return
+}
+
+// NewListDedicatedGuestVlanRangesParams records the call and calls NewListDedicatedGuestVlanRangesParamsFunc
+func (m *MockVLANService) NewListDedicatedGuestVlanRangesParams() (r0 *cloudstack.ListDedicatedGuestVlanRangesParams) {
+	m.record("NewListDedicatedGuestVlanRangesParams")
+	if m.NewListDedicatedGuestVlanRangesParamsFunc != nil {
+		return m.NewListDedicatedGuestVlanRangesParamsFunc()
+	}
+	return cloudstack.NewVLANService(nil).NewListDedicatedGuestVlanRangesParams()
+}
+
+// GetDedicatedGuestVlanRangeByID records the call and calls GetDedicatedGuestVlanRangeByIDFunc, or GetDedicatedGuestVlanRangeByIDWithContextFunc if only that is set
+func (m *MockVLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.DedicatedGuestVlanRange, r1 int, r2 error) {
+	m.record("GetDedicatedGuestVlanRangeByID", id, opts)
+	if m.GetDedicatedGuestVlanRangeByIDFunc != nil {
+		return m.GetDedicatedGuestVlanRangeByIDFunc(id, opts...)
+	}
+	if m.GetDedicatedGuestVlanRangeByIDWithContextFunc != nil {
+		return m.GetDedicatedGuestVlanRangeByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VLANService.GetDedicatedGuestVlanRangeByID")
+	return
+}
+
+// GetDedicatedGuestVlanRangeByIDWithContext records the call and calls GetDedicatedGuestVlanRangeByIDWithContextFunc, or GetDedicatedGuestVlanRangeByIDFunc if only that is set
+func (m *MockVLANService) GetDedicatedGuestVlanRangeByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.DedicatedGuestVlanRange, r1 int, r2 error) {
+	m.record("GetDedicatedGuestVlanRangeByIDWithContext", ctx, id, opts)
+	if m.GetDedicatedGuestVlanRangeByIDWithContextFunc != nil {
+		return m.GetDedicatedGuestVlanRangeByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetDedicatedGuestVlanRangeByIDFunc != nil {
+		return m.GetDedicatedGuestVlanRangeByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VLANService.GetDedicatedGuestVlanRangeByIDWithContext")
+	return
+}
+
+// ListDedicatedGuestVlanRanges records the call and calls ListDedicatedGuestVlanRangesFunc, or ListDedicatedGuestVlanRangesWithContextFunc if only that is set
+func (m *MockVLANService) ListDedicatedGuestVlanRanges(p *cloudstack.ListDedicatedGuestVlanRangesParams) (r0 *cloudstack.ListDedicatedGuestVlanRangesResponse, r1 error) {
+	m.record("ListDedicatedGuestVlanRanges", p)
+	if m.ListDedicatedGuestVlanRangesFunc != nil {
+		return m.ListDedicatedGuestVlanRangesFunc(p)
+	}
+	if m.ListDedicatedGuestVlanRangesWithContextFunc != nil {
+		return m.ListDedicatedGuestVlanRangesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VLANService.ListDedicatedGuestVlanRanges")
+	return
+}
+
+// ListDedicatedGuestVlanRangesWithContext records the call and calls ListDedicatedGuestVlanRangesWithContextFunc, or ListDedicatedGuestVlanRangesFunc if only that is set
+func (m *MockVLANService) ListDedicatedGuestVlanRangesWithContext(ctx context.Context, p *cloudstack.ListDedicatedGuestVlanRangesParams) (r0 *cloudstack.ListDedicatedGuestVlanRangesResponse, r1 error) {
+	m.record("ListDedicatedGuestVlanRangesWithContext", ctx, p)
+	if m.ListDedicatedGuestVlanRangesWithContextFunc != nil {
+		return m.ListDedicatedGuestVlanRangesWithContextFunc(ctx, p)
+	}
+	if m.ListDedicatedGuestVlanRangesFunc != nil {
+		return m.ListDedicatedGuestVlanRangesFunc(p)
+	}
+	r1 = notMocked("VLANService.ListDedicatedGuestVlanRangesWithContext")
+	return
+}
+
+// NewListDedicatedGuestVlanRangesPager records the call and calls NewListDedicatedGuestVlanRangesPagerFunc
+func (m *MockVLANService) NewListDedicatedGuestVlanRangesPager(p *cloudstack.ListDedicatedGuestVlanRangesParams) (r0 *cloudstack.ListDedicatedGuestVlanRangesPager) {
+	m.record("NewListDedicatedGuestVlanRangesPager", p)
+	if m.NewListDedicatedGuestVlanRangesPagerFunc != nil {
+		return m.NewListDedicatedGuestVlanRangesPagerFunc(p)
+	}
+	return
+}
+
+// ListDedicatedGuestVlanRangesAll records the call and calls ListDedicatedGuestVlanRangesAllFunc, or ListDedicatedGuestVlanRangesAllWithContextFunc if only that is set
+func (m *MockVLANService) ListDedicatedGuestVlanRangesAll(p *cloudstack.ListDedicatedGuestVlanRangesParams) (r0 *cloudstack.ListDedicatedGuestVlanRangesResponse, r1 error) {
+	m.record("ListDedicatedGuestVlanRangesAll", p)
+	if m.ListDedicatedGuestVlanRangesAllFunc != nil {
+		return m.ListDedicatedGuestVlanRangesAllFunc(p)
+	}
+	if m.ListDedicatedGuestVlanRangesAllWithContextFunc != nil {
+		return m.ListDedicatedGuestVlanRangesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VLANService.ListDedicatedGuestVlanRangesAll")
+	return
+}
+
+// ListDedicatedGuestVlanRangesAllWithContext records the call and calls ListDedicatedGuestVlanRangesAllWithContextFunc, or ListDedicatedGuestVlanRangesAllFunc if only that is set
+func (m *MockVLANService) ListDedicatedGuestVlanRangesAllWithContext(ctx context.Context, p *cloudstack.ListDedicatedGuestVlanRangesParams) (r0 *cloudstack.ListDedicatedGuestVlanRangesResponse, r1 error) {
+	m.record("ListDedicatedGuestVlanRangesAllWithContext", ctx, p)
+	if m.ListDedicatedGuestVlanRangesAllWithContextFunc != nil {
+		return m.ListDedicatedGuestVlanRangesAllWithContextFunc(ctx, p)
+	}
+	if m.ListDedicatedGuestVlanRangesAllFunc != nil {
+		return m.ListDedicatedGuestVlanRangesAllFunc(p)
+	}
+	r1 = notMocked("VLANService.ListDedicatedGuestVlanRangesAllWithContext")
+	return
+}
+
+// NewListVlanIpRangesParams records the call and calls NewListVlanIpRangesParamsFunc
+func (m *MockVLANService) NewListVlanIpRangesParams() (r0 *cloudstack.ListVlanIpRangesParams) {
+	m.record("NewListVlanIpRangesParams")
+	if m.NewListVlanIpRangesParamsFunc != nil {
+		return m.NewListVlanIpRangesParamsFunc()
+	}
+	return cloudstack.NewVLANService(nil).NewListVlanIpRangesParams()
+}
+
+// GetVlanIpRangeByID records the call and calls GetVlanIpRangeByIDFunc, or GetVlanIpRangeByIDWithContextFunc if only that is set
+func (m *MockVLANService) GetVlanIpRangeByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VlanIpRange, r1 int, r2 error) {
+	m.record("GetVlanIpRangeByID", id, opts)
+	if m.GetVlanIpRangeByIDFunc != nil {
+		return m.GetVlanIpRangeByIDFunc(id, opts...)
+	}
+	if m.GetVlanIpRangeByIDWithContextFunc != nil {
+		return m.GetVlanIpRangeByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VLANService.GetVlanIpRangeByID")
+	return
+}
+
+// GetVlanIpRangeByIDWithContext records the call and calls GetVlanIpRangeByIDWithContextFunc, or GetVlanIpRangeByIDFunc if only that is set
+func (m *MockVLANService) GetVlanIpRangeByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VlanIpRange, r1 int, r2 error) {
+	m.record("GetVlanIpRangeByIDWithContext", ctx, id, opts)
+	if m.GetVlanIpRangeByIDWithContextFunc != nil {
+		return m.GetVlanIpRangeByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetVlanIpRangeByIDFunc != nil {
+		return m.GetVlanIpRangeByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VLANService.GetVlanIpRangeByIDWithContext")
+	return
+}
+
+// ListVlanIpRanges records the call and calls ListVlanIpRangesFunc, or ListVlanIpRangesWithContextFunc if only that is set
+func (m *MockVLANService) ListVlanIpRanges(p *cloudstack.ListVlanIpRangesParams) (r0 *cloudstack.ListVlanIpRangesResponse, r1 error) {
+	m.record("ListVlanIpRanges", p)
+	if m.ListVlanIpRangesFunc != nil {
+		return m.ListVlanIpRangesFunc(p)
+	}
+	if m.ListVlanIpRangesWithContextFunc != nil {
+		return m.ListVlanIpRangesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VLANService.ListVlanIpRanges")
+	return
+}
+
+// ListVlanIpRangesWithContext records the call and calls ListVlanIpRangesWithContextFunc, or ListVlanIpRangesFunc if only that is set
+func (m *MockVLANService) ListVlanIpRangesWithContext(ctx context.Context, p *cloudstack.ListVlanIpRangesParams) (r0 *cloudstack.ListVlanIpRangesResponse, r1 error) {
+	m.record("ListVlanIpRangesWithContext", ctx, p)
+	if m.ListVlanIpRangesWithContextFunc != nil {
+		return m.ListVlanIpRangesWithContextFunc(ctx, p)
+	}
+	if m.ListVlanIpRangesFunc != nil {
+		return m.ListVlanIpRangesFunc(p)
+	}
+	r1 = notMocked("VLANService.ListVlanIpRangesWithContext")
+	return
+}
+
+// NewListVlanIpRangesPager records the call and calls NewListVlanIpRangesPagerFunc
+func (m *MockVLANService) NewListVlanIpRangesPager(p *cloudstack.ListVlanIpRangesParams) (r0 *cloudstack.ListVlanIpRangesPager) {
+	m.record("NewListVlanIpRangesPager", p)
+	if m.NewListVlanIpRangesPagerFunc != nil {
+		return m.NewListVlanIpRangesPagerFunc(p)
+	}
+	return
+}
+
+// ListVlanIpRangesAll records the call and calls ListVlanIpRangesAllFunc, or ListVlanIpRangesAllWithContextFunc if only that is set
+func (m *MockVLANService) ListVlanIpRangesAll(p *cloudstack.ListVlanIpRangesParams) (r0 *cloudstack.ListVlanIpRangesResponse, r1 error) {
+	m.record("ListVlanIpRangesAll", p)
+	if m.ListVlanIpRangesAllFunc != nil {
+		return m.ListVlanIpRangesAllFunc(p)
+	}
+	if m.ListVlanIpRangesAllWithContextFunc != nil {
+		return m.ListVlanIpRangesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VLANService.ListVlanIpRangesAll")
+	return
+}
+
+// ListVlanIpRangesAllWithContext records the call and calls ListVlanIpRangesAllWithContextFunc, or ListVlanIpRangesAllFunc if only that is set
+func (m *MockVLANService) ListVlanIpRangesAllWithContext(ctx context.Context, p *cloudstack.ListVlanIpRangesParams) (r0 *cloudstack.ListVlanIpRangesResponse, r1 error) {
+	m.record("ListVlanIpRangesAllWithContext", ctx, p)
+	if m.ListVlanIpRangesAllWithContextFunc != nil {
+		return m.ListVlanIpRangesAllWithContextFunc(ctx, p)
+	}
+	if m.ListVlanIpRangesAllFunc != nil {
+		return m.ListVlanIpRangesAllFunc(p)
+	}
+	r1 = notMocked("VLANService.ListVlanIpRangesAllWithContext")
+	return
+}
+
+// NewReleaseDedicatedGuestVlanRangeParams records the call and calls NewReleaseDedicatedGuestVlanRangeParamsFunc
+func (m *MockVLANService) NewReleaseDedicatedGuestVlanRangeParams(id string) (r0 *cloudstack.ReleaseDedicatedGuestVlanRangeParams) {
+	m.record("NewReleaseDedicatedGuestVlanRangeParams", id)
+	if m.NewReleaseDedicatedGuestVlanRangeParamsFunc != nil {
+		return m.NewReleaseDedicatedGuestVlanRangeParamsFunc(id)
+	}
+	return cloudstack.NewVLANService(nil).NewReleaseDedicatedGuestVlanRangeParams(id)
+}
+
+// ReleaseDedicatedGuestVlanRange records the call and calls ReleaseDedicatedGuestVlanRangeFunc, or ReleaseDedicatedGuestVlanRangeWithContextFunc if only that is set
+func (m *MockVLANService) ReleaseDedicatedGuestVlanRange(p *cloudstack.ReleaseDedicatedGuestVlanRangeParams) (r0 *cloudstack.ReleaseDedicatedGuestVlanRangeResponse, r1 error) {
+	m.record("ReleaseDedicatedGuestVlanRange", p)
+	if m.ReleaseDedicatedGuestVlanRangeFunc != nil {
+		return m.ReleaseDedicatedGuestVlanRangeFunc(p)
+	}
+	if m.ReleaseDedicatedGuestVlanRangeWithContextFunc != nil {
+		return m.ReleaseDedicatedGuestVlanRangeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VLANService.ReleaseDedicatedGuestVlanRange")
+	return
+}
+
+// ReleaseDedicatedGuestVlanRangeWithContext records the call and calls ReleaseDedicatedGuestVlanRangeWithContextFunc, or ReleaseDedicatedGuestVlanRangeFunc if only that is set
+func (m *MockVLANService) ReleaseDedicatedGuestVlanRangeWithContext(ctx context.Context, p *cloudstack.ReleaseDedicatedGuestVlanRangeParams) (r0 *cloudstack.ReleaseDedicatedGuestVlanRangeResponse, r1 error) {
+	m.record("ReleaseDedicatedGuestVlanRangeWithContext", ctx, p)
+	if m.ReleaseDedicatedGuestVlanRangeWithContextFunc != nil {
+		return m.ReleaseDedicatedGuestVlanRangeWithContextFunc(ctx, p)
+	}
+	if m.ReleaseDedicatedGuestVlanRangeFunc != nil {
+		return m.ReleaseDedicatedGuestVlanRangeFunc(p)
+	}
+	r1 = notMocked("VLANService.ReleaseDedicatedGuestVlanRangeWithContext")
+	return
+}
+
+// ReleaseDedicatedGuestVlanRangeAsync records the call and calls ReleaseDedicatedGuestVlanRangeAsyncFunc, or ReleaseDedicatedGuestVlanRangeAsyncWithContextFunc if only that is set
+func (m *MockVLANService) ReleaseDedicatedGuestVlanRangeAsync(p *cloudstack.ReleaseDedicatedGuestVlanRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReleaseDedicatedGuestVlanRangeAsync", p)
+	if m.ReleaseDedicatedGuestVlanRangeAsyncFunc != nil {
+		return m.ReleaseDedicatedGuestVlanRangeAsyncFunc(p)
+	}
+	if m.ReleaseDedicatedGuestVlanRangeAsyncWithContextFunc != nil {
+		return m.ReleaseDedicatedGuestVlanRangeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VLANService.ReleaseDedicatedGuestVlanRangeAsync")
+	return
+}
+
+// ReleaseDedicatedGuestVlanRangeAsyncWithContext records the call and calls ReleaseDedicatedGuestVlanRangeAsyncWithContextFunc, or ReleaseDedicatedGuestVlanRangeAsyncFunc if only that is set
+func (m *MockVLANService) ReleaseDedicatedGuestVlanRangeAsyncWithContext(ctx context.Context, p *cloudstack.ReleaseDedicatedGuestVlanRangeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReleaseDedicatedGuestVlanRangeAsyncWithContext", ctx, p)
+	if m.ReleaseDedicatedGuestVlanRangeAsyncWithContextFunc != nil {
+		return m.ReleaseDedicatedGuestVlanRangeAsyncWithContextFunc(ctx, p)
+	}
+	if m.ReleaseDedicatedGuestVlanRangeAsyncFunc != nil {
+		return m.ReleaseDedicatedGuestVlanRangeAsyncFunc(p)
+	}
+	r1 = notMocked("VLANService.ReleaseDedicatedGuestVlanRangeAsyncWithContext")
+	return
+}
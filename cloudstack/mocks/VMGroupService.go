@@ -0,0 +1,313 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockVMGroupService is a mock of cloudstack.VMGroupServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockVMGroupService struct {
+	Recorder
+
+	NewCreateInstanceGroupParamsFunc      func(name string) *cloudstack.CreateInstanceGroupParams
+	CreateInstanceGroupFunc               func(p *cloudstack.CreateInstanceGroupParams) (*cloudstack.CreateInstanceGroupResponse, error)
+	CreateInstanceGroupWithContextFunc    func(ctx context.Context, p *cloudstack.CreateInstanceGroupParams) (*cloudstack.CreateInstanceGroupResponse, error)
+	NewDeleteInstanceGroupParamsFunc      func(id string) *cloudstack.DeleteInstanceGroupParams
+	DeleteInstanceGroupFunc               func(p *cloudstack.DeleteInstanceGroupParams) (*cloudstack.DeleteInstanceGroupResponse, error)
+	DeleteInstanceGroupWithContextFunc    func(ctx context.Context, p *cloudstack.DeleteInstanceGroupParams) (*cloudstack.DeleteInstanceGroupResponse, error)
+	NewListInstanceGroupsParamsFunc       func() *cloudstack.ListInstanceGroupsParams
+	GetInstanceGroupIDFunc                func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetInstanceGroupIDWithContextFunc     func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetInstanceGroupByNameFunc            func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.InstanceGroup, int, error)
+	GetInstanceGroupByNameWithContextFunc func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.InstanceGroup, int, error)
+	GetInstanceGroupByIDFunc              func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.InstanceGroup, int, error)
+	GetInstanceGroupByIDWithContextFunc   func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.InstanceGroup, int, error)
+	ListInstanceGroupsFunc                func(p *cloudstack.ListInstanceGroupsParams) (*cloudstack.ListInstanceGroupsResponse, error)
+	ListInstanceGroupsWithContextFunc     func(ctx context.Context, p *cloudstack.ListInstanceGroupsParams) (*cloudstack.ListInstanceGroupsResponse, error)
+	NewListInstanceGroupsPagerFunc        func(p *cloudstack.ListInstanceGroupsParams) *cloudstack.ListInstanceGroupsPager
+	ListInstanceGroupsAllFunc             func(p *cloudstack.ListInstanceGroupsParams) (*cloudstack.ListInstanceGroupsResponse, error)
+	ListInstanceGroupsAllWithContextFunc  func(ctx context.Context, p *cloudstack.ListInstanceGroupsParams) (*cloudstack.ListInstanceGroupsResponse, error)
+	NewUpdateInstanceGroupParamsFunc      func(id string) *cloudstack.UpdateInstanceGroupParams
+	UpdateInstanceGroupFunc               func(p *cloudstack.UpdateInstanceGroupParams) (*cloudstack.UpdateInstanceGroupResponse, error)
+	UpdateInstanceGroupWithContextFunc    func(ctx context.Context, p *cloudstack.UpdateInstanceGroupParams) (*cloudstack.UpdateInstanceGroupResponse, error)
+}
+
+var _ cloudstack.VMGroupServiceIface = (*MockVMGroupService)(nil)
+
+// NewCreateInstanceGroupParams records the call and calls NewCreateInstanceGroupParamsFunc
+func (m *MockVMGroupService) NewCreateInstanceGroupParams(name string) (r0 *cloudstack.CreateInstanceGroupParams) {
+	m.record("NewCreateInstanceGroupParams", name)
+	if m.NewCreateInstanceGroupParamsFunc != nil {
+		return m.NewCreateInstanceGroupParamsFunc(name)
+	}
+	return cloudstack.NewVMGroupService(nil).NewCreateInstanceGroupParams(name)
+}
+
+// CreateInstanceGroup records the call and calls CreateInstanceGroupFunc, or CreateInstanceGroupWithContextFunc if only that is set
+func (m *MockVMGroupService) CreateInstanceGroup(p *cloudstack.CreateInstanceGroupParams) (r0 *cloudstack.CreateInstanceGroupResponse, r1 error) {
+	m.record("CreateInstanceGroup", p)
+	if m.CreateInstanceGroupFunc != nil {
+		return m.CreateInstanceGroupFunc(p)
+	}
+	if m.CreateInstanceGroupWithContextFunc != nil {
+		return m.CreateInstanceGroupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VMGroupService.CreateInstanceGroup")
+	return
+}
+
+// CreateInstanceGroupWithContext records the call and calls CreateInstanceGroupWithContextFunc, or CreateInstanceGroupFunc if only that is set
+func (m *MockVMGroupService) CreateInstanceGroupWithContext(ctx context.Context, p *cloudstack.CreateInstanceGroupParams) (r0 *cloudstack.CreateInstanceGroupResponse, r1 error) {
+	m.record("CreateInstanceGroupWithContext", ctx, p)
+	if m.CreateInstanceGroupWithContextFunc != nil {
+		return m.CreateInstanceGroupWithContextFunc(ctx, p)
+	}
+	if m.CreateInstanceGroupFunc != nil {
+		return m.CreateInstanceGroupFunc(p)
+	}
+	r1 = notMocked("VMGroupService.CreateInstanceGroupWithContext")
+	return
+}
+
+// NewDeleteInstanceGroupParams records the call and calls NewDeleteInstanceGroupParamsFunc
+func (m *MockVMGroupService) NewDeleteInstanceGroupParams(id string) (r0 *cloudstack.DeleteInstanceGroupParams) {
+	m.record("NewDeleteInstanceGroupParams", id)
+	if m.NewDeleteInstanceGroupParamsFunc != nil {
+		return m.NewDeleteInstanceGroupParamsFunc(id)
+	}
+	return cloudstack.NewVMGroupService(nil).NewDeleteInstanceGroupParams(id)
+}
+
+// DeleteInstanceGroup records the call and calls DeleteInstanceGroupFunc, or DeleteInstanceGroupWithContextFunc if only that is set
+func (m *MockVMGroupService) DeleteInstanceGroup(p *cloudstack.DeleteInstanceGroupParams) (r0 *cloudstack.DeleteInstanceGroupResponse, r1 error) {
+	m.record("DeleteInstanceGroup", p)
+	if m.DeleteInstanceGroupFunc != nil {
+		return m.DeleteInstanceGroupFunc(p)
+	}
+	if m.DeleteInstanceGroupWithContextFunc != nil {
+		return m.DeleteInstanceGroupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VMGroupService.DeleteInstanceGroup")
+	return
+}
+
+// DeleteInstanceGroupWithContext records the call and calls DeleteInstanceGroupWithContextFunc, or DeleteInstanceGroupFunc if only that is set
+func (m *MockVMGroupService) DeleteInstanceGroupWithContext(ctx context.Context, p *cloudstack.DeleteInstanceGroupParams) (r0 *cloudstack.DeleteInstanceGroupResponse, r1 error) {
+	m.record("DeleteInstanceGroupWithContext", ctx, p)
+	if m.DeleteInstanceGroupWithContextFunc != nil {
+		return m.DeleteInstanceGroupWithContextFunc(ctx, p)
+	}
+	if m.DeleteInstanceGroupFunc != nil {
+		return m.DeleteInstanceGroupFunc(p)
+	}
+	r1 = notMocked("VMGroupService.DeleteInstanceGroupWithContext")
+	return
+}
+
+// NewListInstanceGroupsParams records the call and calls NewListInstanceGroupsParamsFunc
+func (m *MockVMGroupService) NewListInstanceGroupsParams() (r0 *cloudstack.ListInstanceGroupsParams) {
+	m.record("NewListInstanceGroupsParams")
+	if m.NewListInstanceGroupsParamsFunc != nil {
+		return m.NewListInstanceGroupsParamsFunc()
+	}
+	return cloudstack.NewVMGroupService(nil).NewListInstanceGroupsParams()
+}
+
+// GetInstanceGroupID records the call and calls GetInstanceGroupIDFunc, or GetInstanceGroupIDWithContextFunc if only that is set
+func (m *MockVMGroupService) GetInstanceGroupID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetInstanceGroupID", name, opts)
+	if m.GetInstanceGroupIDFunc != nil {
+		return m.GetInstanceGroupIDFunc(name, opts...)
+	}
+	if m.GetInstanceGroupIDWithContextFunc != nil {
+		return m.GetInstanceGroupIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VMGroupService.GetInstanceGroupID")
+	return
+}
+
+// GetInstanceGroupIDWithContext records the call and calls GetInstanceGroupIDWithContextFunc, or GetInstanceGroupIDFunc if only that is set
+func (m *MockVMGroupService) GetInstanceGroupIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetInstanceGroupIDWithContext", ctx, name, opts)
+	if m.GetInstanceGroupIDWithContextFunc != nil {
+		return m.GetInstanceGroupIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetInstanceGroupIDFunc != nil {
+		return m.GetInstanceGroupIDFunc(name, opts...)
+	}
+	r2 = notMocked("VMGroupService.GetInstanceGroupIDWithContext")
+	return
+}
+
+// GetInstanceGroupByName records the call and calls GetInstanceGroupByNameFunc, or GetInstanceGroupByNameWithContextFunc if only that is set
+func (m *MockVMGroupService) GetInstanceGroupByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.InstanceGroup, r1 int, r2 error) {
+	m.record("GetInstanceGroupByName", name, opts)
+	if m.GetInstanceGroupByNameFunc != nil {
+		return m.GetInstanceGroupByNameFunc(name, opts...)
+	}
+	if m.GetInstanceGroupByNameWithContextFunc != nil {
+		return m.GetInstanceGroupByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VMGroupService.GetInstanceGroupByName")
+	return
+}
+
+// GetInstanceGroupByNameWithContext records the call and calls GetInstanceGroupByNameWithContextFunc, or GetInstanceGroupByNameFunc if only that is set
+func (m *MockVMGroupService) GetInstanceGroupByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.InstanceGroup, r1 int, r2 error) {
+	m.record("GetInstanceGroupByNameWithContext", ctx, name, opts)
+	if m.GetInstanceGroupByNameWithContextFunc != nil {
+		return m.GetInstanceGroupByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetInstanceGroupByNameFunc != nil {
+		return m.GetInstanceGroupByNameFunc(name, opts...)
+	}
+	r2 = notMocked("VMGroupService.GetInstanceGroupByNameWithContext")
+	return
+}
+
+// GetInstanceGroupByID records the call and calls GetInstanceGroupByIDFunc, or GetInstanceGroupByIDWithContextFunc if only that is set
+func (m *MockVMGroupService) GetInstanceGroupByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.InstanceGroup, r1 int, r2 error) {
+	m.record("GetInstanceGroupByID", id, opts)
+	if m.GetInstanceGroupByIDFunc != nil {
+		return m.GetInstanceGroupByIDFunc(id, opts...)
+	}
+	if m.GetInstanceGroupByIDWithContextFunc != nil {
+		return m.GetInstanceGroupByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VMGroupService.GetInstanceGroupByID")
+	return
+}
+
+// GetInstanceGroupByIDWithContext records the call and calls GetInstanceGroupByIDWithContextFunc, or GetInstanceGroupByIDFunc if only that is set
+func (m *MockVMGroupService) GetInstanceGroupByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.InstanceGroup, r1 int, r2 error) {
+	m.record("GetInstanceGroupByIDWithContext", ctx, id, opts)
+	if m.GetInstanceGroupByIDWithContextFunc != nil {
+		return m.GetInstanceGroupByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetInstanceGroupByIDFunc != nil {
+		return m.GetInstanceGroupByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VMGroupService.GetInstanceGroupByIDWithContext")
+	return
+}
+
+// ListInstanceGroups records the call and calls ListInstanceGroupsFunc, or ListInstanceGroupsWithContextFunc if only that is set
+func (m *MockVMGroupService) ListInstanceGroups(p *cloudstack.ListInstanceGroupsParams) (r0 *cloudstack.ListInstanceGroupsResponse, r1 error) {
+	m.record("ListInstanceGroups", p)
+	if m.ListInstanceGroupsFunc != nil {
+		return m.ListInstanceGroupsFunc(p)
+	}
+	if m.ListInstanceGroupsWithContextFunc != nil {
+		return m.ListInstanceGroupsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VMGroupService.ListInstanceGroups")
+	return
+}
+
+// ListInstanceGroupsWithContext records the call and calls ListInstanceGroupsWithContextFunc, or ListInstanceGroupsFunc if only that is set
+func (m *MockVMGroupService) ListInstanceGroupsWithContext(ctx context.Context, p *cloudstack.ListInstanceGroupsParams) (r0 *cloudstack.ListInstanceGroupsResponse, r1 error) {
+	m.record("ListInstanceGroupsWithContext", ctx, p)
+	if m.ListInstanceGroupsWithContextFunc != nil {
+		return m.ListInstanceGroupsWithContextFunc(ctx, p)
+	}
+	if m.ListInstanceGroupsFunc != nil {
+		return m.ListInstanceGroupsFunc(p)
+	}
+	r1 = notMocked("VMGroupService.ListInstanceGroupsWithContext")
+	return
+}
+
+// NewListInstanceGroupsPager records the call and calls NewListInstanceGroupsPagerFunc
+func (m *MockVMGroupService) NewListInstanceGroupsPager(p *cloudstack.ListInstanceGroupsParams) (r0 *cloudstack.ListInstanceGroupsPager) {
+	m.record("NewListInstanceGroupsPager", p)
+	if m.NewListInstanceGroupsPagerFunc != nil {
+		return m.NewListInstanceGroupsPagerFunc(p)
+	}
+	return
+}
+
+// ListInstanceGroupsAll records the call and calls ListInstanceGroupsAllFunc, or ListInstanceGroupsAllWithContextFunc if only that is set
+func (m *MockVMGroupService) ListInstanceGroupsAll(p *cloudstack.ListInstanceGroupsParams) (r0 *cloudstack.ListInstanceGroupsResponse, r1 error) {
+	m.record("ListInstanceGroupsAll", p)
+	if m.ListInstanceGroupsAllFunc != nil {
+		return m.ListInstanceGroupsAllFunc(p)
+	}
+	if m.ListInstanceGroupsAllWithContextFunc != nil {
+		return m.ListInstanceGroupsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VMGroupService.ListInstanceGroupsAll")
+	return
+}
+
+// ListInstanceGroupsAllWithContext records the call and calls ListInstanceGroupsAllWithContextFunc, or ListInstanceGroupsAllFunc if only that is set
+func (m *MockVMGroupService) ListInstanceGroupsAllWithContext(ctx context.Context, p *cloudstack.ListInstanceGroupsParams) (r0 *cloudstack.ListInstanceGroupsResponse, r1 error) {
+	m.record("ListInstanceGroupsAllWithContext", ctx, p)
+	if m.ListInstanceGroupsAllWithContextFunc != nil {
+		return m.ListInstanceGroupsAllWithContextFunc(ctx, p)
+	}
+	if m.ListInstanceGroupsAllFunc != nil {
+		return m.ListInstanceGroupsAllFunc(p)
+	}
+	r1 = notMocked("VMGroupService.ListInstanceGroupsAllWithContext")
+	return
+}
+
+// NewUpdateInstanceGroupParams records the call and calls NewUpdateInstanceGroupParamsFunc
+func (m *MockVMGroupService) NewUpdateInstanceGroupParams(id string) (r0 *cloudstack.UpdateInstanceGroupParams) {
+	m.record("NewUpdateInstanceGroupParams", id)
+	if m.NewUpdateInstanceGroupParamsFunc != nil {
+		return m.NewUpdateInstanceGroupParamsFunc(id)
+	}
+	return cloudstack.NewVMGroupService(nil).NewUpdateInstanceGroupParams(id)
+}
+
+// UpdateInstanceGroup records the call and calls UpdateInstanceGroupFunc, or UpdateInstanceGroupWithContextFunc if only that is set
+func (m *MockVMGroupService) UpdateInstanceGroup(p *cloudstack.UpdateInstanceGroupParams) (r0 *cloudstack.UpdateInstanceGroupResponse, r1 error) {
+	m.record("UpdateInstanceGroup", p)
+	if m.UpdateInstanceGroupFunc != nil {
+		return m.UpdateInstanceGroupFunc(p)
+	}
+	if m.UpdateInstanceGroupWithContextFunc != nil {
+		return m.UpdateInstanceGroupWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VMGroupService.UpdateInstanceGroup")
+	return
+}
+
+// UpdateInstanceGroupWithContext records the call and calls UpdateInstanceGroupWithContextFunc, or UpdateInstanceGroupFunc if only that is set
+func (m *MockVMGroupService) UpdateInstanceGroupWithContext(ctx context.Context, p *cloudstack.UpdateInstanceGroupParams) (r0 *cloudstack.UpdateInstanceGroupResponse, r1 error) {
+	m.record("UpdateInstanceGroupWithContext", ctx, p)
+	if m.UpdateInstanceGroupWithContextFunc != nil {
+		return m.UpdateInstanceGroupWithContextFunc(ctx, p)
+	}
+	if m.UpdateInstanceGroupFunc != nil {
+		return m.UpdateInstanceGroupFunc(p)
+	}
+	r1 = notMocked("VMGroupService.UpdateInstanceGroupWithContext")
+	return
+}
@@ -0,0 +1,437 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockVMWareService is a mock of cloudstack.VMWareServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockVMWareService struct {
+	Recorder
+
+	NewAddVmwareDcParamsFunc                                  func(name string, vcenter string, zoneid string) *cloudstack.AddVmwareDcParams
+	AddVmwareDcFunc                                           func(p *cloudstack.AddVmwareDcParams) (*cloudstack.AddVmwareDcResponse, error)
+	AddVmwareDcWithContextFunc                                func(ctx context.Context, p *cloudstack.AddVmwareDcParams) (*cloudstack.AddVmwareDcResponse, error)
+	NewImportVsphereStoragePoliciesParamsFunc                 func() *cloudstack.ImportVsphereStoragePoliciesParams
+	ImportVsphereStoragePoliciesFunc                          func(p *cloudstack.ImportVsphereStoragePoliciesParams) (*cloudstack.ImportVsphereStoragePoliciesResponse, error)
+	ImportVsphereStoragePoliciesWithContextFunc               func(ctx context.Context, p *cloudstack.ImportVsphereStoragePoliciesParams) (*cloudstack.ImportVsphereStoragePoliciesResponse, error)
+	NewListVmwareDcsParamsFunc                                func(zoneid string) *cloudstack.ListVmwareDcsParams
+	GetVmwareDcIDFunc                                         func(keyword string, zoneid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVmwareDcIDWithContextFunc                              func(ctx context.Context, keyword string, zoneid string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListVmwareDcsFunc                                         func(p *cloudstack.ListVmwareDcsParams) (*cloudstack.ListVmwareDcsResponse, error)
+	ListVmwareDcsWithContextFunc                              func(ctx context.Context, p *cloudstack.ListVmwareDcsParams) (*cloudstack.ListVmwareDcsResponse, error)
+	NewListVmwareDcsPagerFunc                                 func(p *cloudstack.ListVmwareDcsParams) *cloudstack.ListVmwareDcsPager
+	ListVmwareDcsAllFunc                                      func(p *cloudstack.ListVmwareDcsParams) (*cloudstack.ListVmwareDcsResponse, error)
+	ListVmwareDcsAllWithContextFunc                           func(ctx context.Context, p *cloudstack.ListVmwareDcsParams) (*cloudstack.ListVmwareDcsResponse, error)
+	NewListVsphereStoragePoliciesParamsFunc                   func() *cloudstack.ListVsphereStoragePoliciesParams
+	ListVsphereStoragePoliciesFunc                            func(p *cloudstack.ListVsphereStoragePoliciesParams) (*cloudstack.ListVsphereStoragePoliciesResponse, error)
+	ListVsphereStoragePoliciesWithContextFunc                 func(ctx context.Context, p *cloudstack.ListVsphereStoragePoliciesParams) (*cloudstack.ListVsphereStoragePoliciesResponse, error)
+	NewListVsphereStoragePolicyCompatiblePoolsParamsFunc      func() *cloudstack.ListVsphereStoragePolicyCompatiblePoolsParams
+	GetVsphereStoragePolicyCompatiblePoolIDFunc               func(keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVsphereStoragePolicyCompatiblePoolIDWithContextFunc    func(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	ListVsphereStoragePolicyCompatiblePoolsFunc               func(p *cloudstack.ListVsphereStoragePolicyCompatiblePoolsParams) (*cloudstack.ListVsphereStoragePolicyCompatiblePoolsResponse, error)
+	ListVsphereStoragePolicyCompatiblePoolsWithContextFunc    func(ctx context.Context, p *cloudstack.ListVsphereStoragePolicyCompatiblePoolsParams) (*cloudstack.ListVsphereStoragePolicyCompatiblePoolsResponse, error)
+	NewListVsphereStoragePolicyCompatiblePoolsPagerFunc       func(p *cloudstack.ListVsphereStoragePolicyCompatiblePoolsParams) *cloudstack.ListVsphereStoragePolicyCompatiblePoolsPager
+	ListVsphereStoragePolicyCompatiblePoolsAllFunc            func(p *cloudstack.ListVsphereStoragePolicyCompatiblePoolsParams) (*cloudstack.ListVsphereStoragePolicyCompatiblePoolsResponse, error)
+	ListVsphereStoragePolicyCompatiblePoolsAllWithContextFunc func(ctx context.Context, p *cloudstack.ListVsphereStoragePolicyCompatiblePoolsParams) (*cloudstack.ListVsphereStoragePolicyCompatiblePoolsResponse, error)
+	NewRemoveVmwareDcParamsFunc                               func(zoneid string) *cloudstack.RemoveVmwareDcParams
+	RemoveVmwareDcFunc                                        func(p *cloudstack.RemoveVmwareDcParams) (*cloudstack.RemoveVmwareDcResponse, error)
+	RemoveVmwareDcWithContextFunc                             func(ctx context.Context, p *cloudstack.RemoveVmwareDcParams) (*cloudstack.RemoveVmwareDcResponse, error)
+	NewUpdateVmwareDcParamsFunc                               func(zoneid string) *cloudstack.UpdateVmwareDcParams
+	UpdateVmwareDcFunc                                        func(p *cloudstack.UpdateVmwareDcParams) (*cloudstack.UpdateVmwareDcResponse, error)
+	UpdateVmwareDcWithContextFunc                             func(ctx context.Context, p *cloudstack.UpdateVmwareDcParams) (*cloudstack.UpdateVmwareDcResponse, error)
+}
+
+var _ cloudstack.VMWareServiceIface = (*MockVMWareService)(nil)
+
+// NewAddVmwareDcParams records the call and calls NewAddVmwareDcParamsFunc
+func (m *MockVMWareService) NewAddVmwareDcParams(name string, vcenter string, zoneid string) (r0 *cloudstack.AddVmwareDcParams) {
+	m.record("NewAddVmwareDcParams", name, vcenter, zoneid)
+	if m.NewAddVmwareDcParamsFunc != nil {
+		return m.NewAddVmwareDcParamsFunc(name, vcenter, zoneid)
+	}
+	return cloudstack.NewVMWareService(nil).NewAddVmwareDcParams(name, vcenter, zoneid)
+}
+
+// AddVmwareDc records the call and calls AddVmwareDcFunc, or AddVmwareDcWithContextFunc if only that is set
+func (m *MockVMWareService) AddVmwareDc(p *cloudstack.AddVmwareDcParams) (r0 *cloudstack.AddVmwareDcResponse, r1 error) {
+	m.record("AddVmwareDc", p)
+	if m.AddVmwareDcFunc != nil {
+		return m.AddVmwareDcFunc(p)
+	}
+	if m.AddVmwareDcWithContextFunc != nil {
+		return m.AddVmwareDcWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VMWareService.AddVmwareDc")
+	return
+}
+
+// AddVmwareDcWithContext records the call and calls AddVmwareDcWithContextFunc, or AddVmwareDcFunc if only that is set
+func (m *MockVMWareService) AddVmwareDcWithContext(ctx context.Context, p *cloudstack.AddVmwareDcParams) (r0 *cloudstack.AddVmwareDcResponse, r1 error) {
+	m.record("AddVmwareDcWithContext", ctx, p)
+	if m.AddVmwareDcWithContextFunc != nil {
+		return m.AddVmwareDcWithContextFunc(ctx, p)
+	}
+	if m.AddVmwareDcFunc != nil {
+		return m.AddVmwareDcFunc(p)
+	}
+	r1 = notMocked("VMWareService.AddVmwareDcWithContext")
+	return
+}
+
+// NewImportVsphereStoragePoliciesParams records the call and calls NewImportVsphereStoragePoliciesParamsFunc
+func (m *MockVMWareService) NewImportVsphereStoragePoliciesParams() (r0 *cloudstack.ImportVsphereStoragePoliciesParams) {
+	m.record("NewImportVsphereStoragePoliciesParams")
+	if m.NewImportVsphereStoragePoliciesParamsFunc != nil {
+		return m.NewImportVsphereStoragePoliciesParamsFunc()
+	}
+	return cloudstack.NewVMWareService(nil).NewImportVsphereStoragePoliciesParams()
+}
+
+// ImportVsphereStoragePolicies records the call and calls ImportVsphereStoragePoliciesFunc, or ImportVsphereStoragePoliciesWithContextFunc if only that is set
+func (m *MockVMWareService) ImportVsphereStoragePolicies(p *cloudstack.ImportVsphereStoragePoliciesParams) (r0 *cloudstack.ImportVsphereStoragePoliciesResponse, r1 error) {
+	m.record("ImportVsphereStoragePolicies", p)
+	if m.ImportVsphereStoragePoliciesFunc != nil {
+		return m.ImportVsphereStoragePoliciesFunc(p)
+	}
+	if m.ImportVsphereStoragePoliciesWithContextFunc != nil {
+		return m.ImportVsphereStoragePoliciesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VMWareService.ImportVsphereStoragePolicies")
+	return
+}
+
+// ImportVsphereStoragePoliciesWithContext records the call and calls ImportVsphereStoragePoliciesWithContextFunc, or ImportVsphereStoragePoliciesFunc if only that is set
+func (m *MockVMWareService) ImportVsphereStoragePoliciesWithContext(ctx context.Context, p *cloudstack.ImportVsphereStoragePoliciesParams) (r0 *cloudstack.ImportVsphereStoragePoliciesResponse, r1 error) {
+	m.record("ImportVsphereStoragePoliciesWithContext", ctx, p)
+	if m.ImportVsphereStoragePoliciesWithContextFunc != nil {
+		return m.ImportVsphereStoragePoliciesWithContextFunc(ctx, p)
+	}
+	if m.ImportVsphereStoragePoliciesFunc != nil {
+		return m.ImportVsphereStoragePoliciesFunc(p)
+	}
+	r1 = notMocked("VMWareService.ImportVsphereStoragePoliciesWithContext")
+	return
+}
+
+// NewListVmwareDcsParams records the call and calls NewListVmwareDcsParamsFunc
+func (m *MockVMWareService) NewListVmwareDcsParams(zoneid string) (r0 *cloudstack.ListVmwareDcsParams) {
+	m.record("NewListVmwareDcsParams", zoneid)
+	if m.NewListVmwareDcsParamsFunc != nil {
+		return m.NewListVmwareDcsParamsFunc(zoneid)
+	}
+	return cloudstack.NewVMWareService(nil).NewListVmwareDcsParams(zoneid)
+}
+
+// GetVmwareDcID records the call and calls GetVmwareDcIDFunc, or GetVmwareDcIDWithContextFunc if only that is set
+func (m *MockVMWareService) GetVmwareDcID(keyword string, zoneid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVmwareDcID", keyword, zoneid, opts)
+	if m.GetVmwareDcIDFunc != nil {
+		return m.GetVmwareDcIDFunc(keyword, zoneid, opts...)
+	}
+	if m.GetVmwareDcIDWithContextFunc != nil {
+		return m.GetVmwareDcIDWithContextFunc(context.Background(), keyword, zoneid, opts...)
+	}
+	r2 = notMocked("VMWareService.GetVmwareDcID")
+	return
+}
+
+// GetVmwareDcIDWithContext records the call and calls GetVmwareDcIDWithContextFunc, or GetVmwareDcIDFunc if only that is set
+func (m *MockVMWareService) GetVmwareDcIDWithContext(ctx context.Context, keyword string, zoneid string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVmwareDcIDWithContext", ctx, keyword, zoneid, opts)
+	if m.GetVmwareDcIDWithContextFunc != nil {
+		return m.GetVmwareDcIDWithContextFunc(ctx, keyword, zoneid, opts...)
+	}
+	if m.GetVmwareDcIDFunc != nil {
+		return m.GetVmwareDcIDFunc(keyword, zoneid, opts...)
+	}
+	r2 = notMocked("VMWareService.GetVmwareDcIDWithContext")
+	return
+}
+
+// ListVmwareDcs records the call and calls ListVmwareDcsFunc, or ListVmwareDcsWithContextFunc if only that is set
+func (m *MockVMWareService) ListVmwareDcs(p *cloudstack.ListVmwareDcsParams) (r0 *cloudstack.ListVmwareDcsResponse, r1 error) {
+	m.record("ListVmwareDcs", p)
+	if m.ListVmwareDcsFunc != nil {
+		return m.ListVmwareDcsFunc(p)
+	}
+	if m.ListVmwareDcsWithContextFunc != nil {
+		return m.ListVmwareDcsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VMWareService.ListVmwareDcs")
+	return
+}
+
+// ListVmwareDcsWithContext records the call and calls ListVmwareDcsWithContextFunc, or ListVmwareDcsFunc if only that is set
+func (m *MockVMWareService) ListVmwareDcsWithContext(ctx context.Context, p *cloudstack.ListVmwareDcsParams) (r0 *cloudstack.ListVmwareDcsResponse, r1 error) {
+	m.record("ListVmwareDcsWithContext", ctx, p)
+	if m.ListVmwareDcsWithContextFunc != nil {
+		return m.ListVmwareDcsWithContextFunc(ctx, p)
+	}
+	if m.ListVmwareDcsFunc != nil {
+		return m.ListVmwareDcsFunc(p)
+	}
+	r1 = notMocked("VMWareService.ListVmwareDcsWithContext")
+	return
+}
+
+// NewListVmwareDcsPager records the call and calls NewListVmwareDcsPagerFunc
+func (m *MockVMWareService) NewListVmwareDcsPager(p *cloudstack.ListVmwareDcsParams) (r0 *cloudstack.ListVmwareDcsPager) {
+	m.record("NewListVmwareDcsPager", p)
+	if m.NewListVmwareDcsPagerFunc != nil {
+		return m.NewListVmwareDcsPagerFunc(p)
+	}
+	return
+}
+
+// ListVmwareDcsAll records the call and calls ListVmwareDcsAllFunc, or ListVmwareDcsAllWithContextFunc if only that is set
+func (m *MockVMWareService) ListVmwareDcsAll(p *cloudstack.ListVmwareDcsParams) (r0 *cloudstack.ListVmwareDcsResponse, r1 error) {
+	m.record("ListVmwareDcsAll", p)
+	if m.ListVmwareDcsAllFunc != nil {
+		return m.ListVmwareDcsAllFunc(p)
+	}
+	if m.ListVmwareDcsAllWithContextFunc != nil {
+		return m.ListVmwareDcsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VMWareService.ListVmwareDcsAll")
+	return
+}
+
+// ListVmwareDcsAllWithContext records the call and calls ListVmwareDcsAllWithContextFunc, or ListVmwareDcsAllFunc if only that is set
+func (m *MockVMWareService) ListVmwareDcsAllWithContext(ctx context.Context, p *cloudstack.ListVmwareDcsParams) (r0 *cloudstack.ListVmwareDcsResponse, r1 error) {
+	m.record("ListVmwareDcsAllWithContext", ctx, p)
+	if m.ListVmwareDcsAllWithContextFunc != nil {
+		return m.ListVmwareDcsAllWithContextFunc(ctx, p)
+	}
+	if m.ListVmwareDcsAllFunc != nil {
+		return m.ListVmwareDcsAllFunc(p)
+	}
+	r1 = notMocked("VMWareService.ListVmwareDcsAllWithContext")
+	return
+}
+
+// NewListVsphereStoragePoliciesParams records the call and calls NewListVsphereStoragePoliciesParamsFunc
+func (m *MockVMWareService) NewListVsphereStoragePoliciesParams() (r0 *cloudstack.ListVsphereStoragePoliciesParams) {
+	m.record("NewListVsphereStoragePoliciesParams")
+	if m.NewListVsphereStoragePoliciesParamsFunc != nil {
+		return m.NewListVsphereStoragePoliciesParamsFunc()
+	}
+	return cloudstack.NewVMWareService(nil).NewListVsphereStoragePoliciesParams()
+}
+
+// ListVsphereStoragePolicies records the call and calls ListVsphereStoragePoliciesFunc, or ListVsphereStoragePoliciesWithContextFunc if only that is set
+func (m *MockVMWareService) ListVsphereStoragePolicies(p *cloudstack.ListVsphereStoragePoliciesParams) (r0 *cloudstack.ListVsphereStoragePoliciesResponse, r1 error) {
+	m.record("ListVsphereStoragePolicies", p)
+	if m.ListVsphereStoragePoliciesFunc != nil {
+		return m.ListVsphereStoragePoliciesFunc(p)
+	}
+	if m.ListVsphereStoragePoliciesWithContextFunc != nil {
+		return m.ListVsphereStoragePoliciesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VMWareService.ListVsphereStoragePolicies")
+	return
+}
+
+// ListVsphereStoragePoliciesWithContext records the call and calls ListVsphereStoragePoliciesWithContextFunc, or ListVsphereStoragePoliciesFunc if only that is set
+func (m *MockVMWareService) ListVsphereStoragePoliciesWithContext(ctx context.Context, p *cloudstack.ListVsphereStoragePoliciesParams) (r0 *cloudstack.ListVsphereStoragePoliciesResponse, r1 error) {
+	m.record("ListVsphereStoragePoliciesWithContext", ctx, p)
+	if m.ListVsphereStoragePoliciesWithContextFunc != nil {
+		return m.ListVsphereStoragePoliciesWithContextFunc(ctx, p)
+	}
+	if m.ListVsphereStoragePoliciesFunc != nil {
+		return m.ListVsphereStoragePoliciesFunc(p)
+	}
+	r1 = notMocked("VMWareService.ListVsphereStoragePoliciesWithContext")
+	return
+}
+
+// NewListVsphereStoragePolicyCompatiblePoolsParams records the call and calls NewListVsphereStoragePolicyCompatiblePoolsParamsFunc
+func (m *MockVMWareService) NewListVsphereStoragePolicyCompatiblePoolsParams() (r0 *cloudstack.ListVsphereStoragePolicyCompatiblePoolsParams) {
+	m.record("NewListVsphereStoragePolicyCompatiblePoolsParams")
+	if m.NewListVsphereStoragePolicyCompatiblePoolsParamsFunc != nil {
+		return m.NewListVsphereStoragePolicyCompatiblePoolsParamsFunc()
+	}
+	return cloudstack.NewVMWareService(nil).NewListVsphereStoragePolicyCompatiblePoolsParams()
+}
+
+// GetVsphereStoragePolicyCompatiblePoolID records the call and calls GetVsphereStoragePolicyCompatiblePoolIDFunc, or GetVsphereStoragePolicyCompatiblePoolIDWithContextFunc if only that is set
+func (m *MockVMWareService) GetVsphereStoragePolicyCompatiblePoolID(keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVsphereStoragePolicyCompatiblePoolID", keyword, opts)
+	if m.GetVsphereStoragePolicyCompatiblePoolIDFunc != nil {
+		return m.GetVsphereStoragePolicyCompatiblePoolIDFunc(keyword, opts...)
+	}
+	if m.GetVsphereStoragePolicyCompatiblePoolIDWithContextFunc != nil {
+		return m.GetVsphereStoragePolicyCompatiblePoolIDWithContextFunc(context.Background(), keyword, opts...)
+	}
+	r2 = notMocked("VMWareService.GetVsphereStoragePolicyCompatiblePoolID")
+	return
+}
+
+// GetVsphereStoragePolicyCompatiblePoolIDWithContext records the call and calls GetVsphereStoragePolicyCompatiblePoolIDWithContextFunc, or GetVsphereStoragePolicyCompatiblePoolIDFunc if only that is set
+func (m *MockVMWareService) GetVsphereStoragePolicyCompatiblePoolIDWithContext(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVsphereStoragePolicyCompatiblePoolIDWithContext", ctx, keyword, opts)
+	if m.GetVsphereStoragePolicyCompatiblePoolIDWithContextFunc != nil {
+		return m.GetVsphereStoragePolicyCompatiblePoolIDWithContextFunc(ctx, keyword, opts...)
+	}
+	if m.GetVsphereStoragePolicyCompatiblePoolIDFunc != nil {
+		return m.GetVsphereStoragePolicyCompatiblePoolIDFunc(keyword, opts...)
+	}
+	r2 = notMocked("VMWareService.GetVsphereStoragePolicyCompatiblePoolIDWithContext")
+	return
+}
+
+// ListVsphereStoragePolicyCompatiblePools records the call and calls ListVsphereStoragePolicyCompatiblePoolsFunc, or ListVsphereStoragePolicyCompatiblePoolsWithContextFunc if only that is set
+func (m *MockVMWareService) ListVsphereStoragePolicyCompatiblePools(p *cloudstack.ListVsphereStoragePolicyCompatiblePoolsParams) (r0 *cloudstack.ListVsphereStoragePolicyCompatiblePoolsResponse, r1 error) {
+	m.record("ListVsphereStoragePolicyCompatiblePools", p)
+	if m.ListVsphereStoragePolicyCompatiblePoolsFunc != nil {
+		return m.ListVsphereStoragePolicyCompatiblePoolsFunc(p)
+	}
+	if m.ListVsphereStoragePolicyCompatiblePoolsWithContextFunc != nil {
+		return m.ListVsphereStoragePolicyCompatiblePoolsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VMWareService.ListVsphereStoragePolicyCompatiblePools")
+	return
+}
+
+// ListVsphereStoragePolicyCompatiblePoolsWithContext records the call and calls ListVsphereStoragePolicyCompatiblePoolsWithContextFunc, or ListVsphereStoragePolicyCompatiblePoolsFunc if only that is set
+func (m *MockVMWareService) ListVsphereStoragePolicyCompatiblePoolsWithContext(ctx context.Context, p *cloudstack.ListVsphereStoragePolicyCompatiblePoolsParams) (r0 *cloudstack.ListVsphereStoragePolicyCompatiblePoolsResponse, r1 error) {
+	m.record("ListVsphereStoragePolicyCompatiblePoolsWithContext", ctx, p)
+	if m.ListVsphereStoragePolicyCompatiblePoolsWithContextFunc != nil {
+		return m.ListVsphereStoragePolicyCompatiblePoolsWithContextFunc(ctx, p)
+	}
+	if m.ListVsphereStoragePolicyCompatiblePoolsFunc != nil {
+		return m.ListVsphereStoragePolicyCompatiblePoolsFunc(p)
+	}
+	r1 = notMocked("VMWareService.ListVsphereStoragePolicyCompatiblePoolsWithContext")
+	return
+}
+
+// NewListVsphereStoragePolicyCompatiblePoolsPager records the call and calls NewListVsphereStoragePolicyCompatiblePoolsPagerFunc
+func (m *MockVMWareService) NewListVsphereStoragePolicyCompatiblePoolsPager(p *cloudstack.ListVsphereStoragePolicyCompatiblePoolsParams) (r0 *cloudstack.ListVsphereStoragePolicyCompatiblePoolsPager) {
+	m.record("NewListVsphereStoragePolicyCompatiblePoolsPager", p)
+	if m.NewListVsphereStoragePolicyCompatiblePoolsPagerFunc != nil {
+		return m.NewListVsphereStoragePolicyCompatiblePoolsPagerFunc(p)
+	}
+	return
+}
+
+// ListVsphereStoragePolicyCompatiblePoolsAll records the call and calls ListVsphereStoragePolicyCompatiblePoolsAllFunc, or ListVsphereStoragePolicyCompatiblePoolsAllWithContextFunc if only that is set
+func (m *MockVMWareService) ListVsphereStoragePolicyCompatiblePoolsAll(p *cloudstack.ListVsphereStoragePolicyCompatiblePoolsParams) (r0 *cloudstack.ListVsphereStoragePolicyCompatiblePoolsResponse, r1 error) {
+	m.record("ListVsphereStoragePolicyCompatiblePoolsAll", p)
+	if m.ListVsphereStoragePolicyCompatiblePoolsAllFunc != nil {
+		return m.ListVsphereStoragePolicyCompatiblePoolsAllFunc(p)
+	}
+	if m.ListVsphereStoragePolicyCompatiblePoolsAllWithContextFunc != nil {
+		return m.ListVsphereStoragePolicyCompatiblePoolsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VMWareService.ListVsphereStoragePolicyCompatiblePoolsAll")
+	return
+}
+
+// ListVsphereStoragePolicyCompatiblePoolsAllWithContext records the call and calls ListVsphereStoragePolicyCompatiblePoolsAllWithContextFunc, or ListVsphereStoragePolicyCompatiblePoolsAllFunc if only that is set
+func (m *MockVMWareService) ListVsphereStoragePolicyCompatiblePoolsAllWithContext(ctx context.Context, p *cloudstack.ListVsphereStoragePolicyCompatiblePoolsParams) (r0 *cloudstack.ListVsphereStoragePolicyCompatiblePoolsResponse, r1 error) {
+	m.record("ListVsphereStoragePolicyCompatiblePoolsAllWithContext", ctx, p)
+	if m.ListVsphereStoragePolicyCompatiblePoolsAllWithContextFunc != nil {
+		return m.ListVsphereStoragePolicyCompatiblePoolsAllWithContextFunc(ctx, p)
+	}
+	if m.ListVsphereStoragePolicyCompatiblePoolsAllFunc != nil {
+		return m.ListVsphereStoragePolicyCompatiblePoolsAllFunc(p)
+	}
+	r1 = notMocked("VMWareService.ListVsphereStoragePolicyCompatiblePoolsAllWithContext")
+	return
+}
+
+// NewRemoveVmwareDcParams records the call and calls NewRemoveVmwareDcParamsFunc
+func (m *MockVMWareService) NewRemoveVmwareDcParams(zoneid string) (r0 *cloudstack.RemoveVmwareDcParams) {
+	m.record("NewRemoveVmwareDcParams", zoneid)
+	if m.NewRemoveVmwareDcParamsFunc != nil {
+		return m.NewRemoveVmwareDcParamsFunc(zoneid)
+	}
+	return cloudstack.NewVMWareService(nil).NewRemoveVmwareDcParams(zoneid)
+}
+
+// RemoveVmwareDc records the call and calls RemoveVmwareDcFunc, or RemoveVmwareDcWithContextFunc if only that is set
+func (m *MockVMWareService) RemoveVmwareDc(p *cloudstack.RemoveVmwareDcParams) (r0 *cloudstack.RemoveVmwareDcResponse, r1 error) {
+	m.record("RemoveVmwareDc", p)
+	if m.RemoveVmwareDcFunc != nil {
+		return m.RemoveVmwareDcFunc(p)
+	}
+	if m.RemoveVmwareDcWithContextFunc != nil {
+		return m.RemoveVmwareDcWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VMWareService.RemoveVmwareDc")
+	return
+}
+
+// RemoveVmwareDcWithContext records the call and calls RemoveVmwareDcWithContextFunc, or RemoveVmwareDcFunc if only that is set
+func (m *MockVMWareService) RemoveVmwareDcWithContext(ctx context.Context, p *cloudstack.RemoveVmwareDcParams) (r0 *cloudstack.RemoveVmwareDcResponse, r1 error) {
+	m.record("RemoveVmwareDcWithContext", ctx, p)
+	if m.RemoveVmwareDcWithContextFunc != nil {
+		return m.RemoveVmwareDcWithContextFunc(ctx, p)
+	}
+	if m.RemoveVmwareDcFunc != nil {
+		return m.RemoveVmwareDcFunc(p)
+	}
+	r1 = notMocked("VMWareService.RemoveVmwareDcWithContext")
+	return
+}
+
+// NewUpdateVmwareDcParams records the call and calls NewUpdateVmwareDcParamsFunc
+func (m *MockVMWareService) NewUpdateVmwareDcParams(zoneid string) (r0 *cloudstack.UpdateVmwareDcParams) {
+	m.record("NewUpdateVmwareDcParams", zoneid)
+	if m.NewUpdateVmwareDcParamsFunc != nil {
+		return m.NewUpdateVmwareDcParamsFunc(zoneid)
+	}
+	return cloudstack.NewVMWareService(nil).NewUpdateVmwareDcParams(zoneid)
+}
+
+// UpdateVmwareDc records the call and calls UpdateVmwareDcFunc, or UpdateVmwareDcWithContextFunc if only that is set
+func (m *MockVMWareService) UpdateVmwareDc(p *cloudstack.UpdateVmwareDcParams) (r0 *cloudstack.UpdateVmwareDcResponse, r1 error) {
+	m.record("UpdateVmwareDc", p)
+	if m.UpdateVmwareDcFunc != nil {
+		return m.UpdateVmwareDcFunc(p)
+	}
+	if m.UpdateVmwareDcWithContextFunc != nil {
+		return m.UpdateVmwareDcWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VMWareService.UpdateVmwareDc")
+	return
+}
+
+// UpdateVmwareDcWithContext records the call and calls UpdateVmwareDcWithContextFunc, or UpdateVmwareDcFunc if only that is set
+func (m *MockVMWareService) UpdateVmwareDcWithContext(ctx context.Context, p *cloudstack.UpdateVmwareDcParams) (r0 *cloudstack.UpdateVmwareDcResponse, r1 error) {
+	m.record("UpdateVmwareDcWithContext", ctx, p)
+	if m.UpdateVmwareDcWithContextFunc != nil {
+		return m.UpdateVmwareDcWithContextFunc(ctx, p)
+	}
+	if m.UpdateVmwareDcFunc != nil {
+		return m.UpdateVmwareDcFunc(p)
+	}
+	r1 = notMocked("VMWareService.UpdateVmwareDcWithContext")
+	return
+}
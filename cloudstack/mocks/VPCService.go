@@ -0,0 +1,1359 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockVPCService is a mock of cloudstack.VPCServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockVPCService struct {
+	Recorder
+
+	NewCreatePrivateGatewayParamsFunc        func(gateway string, ipaddress string, netmask string, vlan string, vpcid string) *cloudstack.CreatePrivateGatewayParams
+	CreatePrivateGatewayFunc                 func(p *cloudstack.CreatePrivateGatewayParams) (*cloudstack.CreatePrivateGatewayResponse, error)
+	CreatePrivateGatewayWithContextFunc      func(ctx context.Context, p *cloudstack.CreatePrivateGatewayParams) (*cloudstack.CreatePrivateGatewayResponse, error)
+	CreatePrivateGatewayAsyncFunc            func(p *cloudstack.CreatePrivateGatewayParams) (*cloudstack.Job, error)
+	CreatePrivateGatewayAsyncWithContextFunc func(ctx context.Context, p *cloudstack.CreatePrivateGatewayParams) (*cloudstack.Job, error)
+	NewCreateStaticRouteParamsFunc           func(cidr string, gatewayid string) *cloudstack.CreateStaticRouteParams
+	CreateStaticRouteFunc                    func(p *cloudstack.CreateStaticRouteParams) (*cloudstack.CreateStaticRouteResponse, error)
+	CreateStaticRouteWithContextFunc         func(ctx context.Context, p *cloudstack.CreateStaticRouteParams) (*cloudstack.CreateStaticRouteResponse, error)
+	CreateStaticRouteAsyncFunc               func(p *cloudstack.CreateStaticRouteParams) (*cloudstack.Job, error)
+	CreateStaticRouteAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.CreateStaticRouteParams) (*cloudstack.Job, error)
+	NewCreateVPCParamsFunc                   func(cidr string, displaytext string, name string, vpcofferingid string, zoneid string) *cloudstack.CreateVPCParams
+	CreateVPCFunc                            func(p *cloudstack.CreateVPCParams) (*cloudstack.CreateVPCResponse, error)
+	CreateVPCWithContextFunc                 func(ctx context.Context, p *cloudstack.CreateVPCParams) (*cloudstack.CreateVPCResponse, error)
+	CreateVPCAsyncFunc                       func(p *cloudstack.CreateVPCParams) (*cloudstack.Job, error)
+	CreateVPCAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.CreateVPCParams) (*cloudstack.Job, error)
+	NewCreateVPCOfferingParamsFunc           func(displaytext string, name string, supportedservices []string) *cloudstack.CreateVPCOfferingParams
+	CreateVPCOfferingFunc                    func(p *cloudstack.CreateVPCOfferingParams) (*cloudstack.CreateVPCOfferingResponse, error)
+	CreateVPCOfferingWithContextFunc         func(ctx context.Context, p *cloudstack.CreateVPCOfferingParams) (*cloudstack.CreateVPCOfferingResponse, error)
+	CreateVPCOfferingAsyncFunc               func(p *cloudstack.CreateVPCOfferingParams) (*cloudstack.Job, error)
+	CreateVPCOfferingAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.CreateVPCOfferingParams) (*cloudstack.Job, error)
+	NewDeletePrivateGatewayParamsFunc        func(id string) *cloudstack.DeletePrivateGatewayParams
+	DeletePrivateGatewayFunc                 func(p *cloudstack.DeletePrivateGatewayParams) (*cloudstack.DeletePrivateGatewayResponse, error)
+	DeletePrivateGatewayWithContextFunc      func(ctx context.Context, p *cloudstack.DeletePrivateGatewayParams) (*cloudstack.DeletePrivateGatewayResponse, error)
+	DeletePrivateGatewayAsyncFunc            func(p *cloudstack.DeletePrivateGatewayParams) (*cloudstack.Job, error)
+	DeletePrivateGatewayAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DeletePrivateGatewayParams) (*cloudstack.Job, error)
+	NewDeleteStaticRouteParamsFunc           func(id string) *cloudstack.DeleteStaticRouteParams
+	DeleteStaticRouteFunc                    func(p *cloudstack.DeleteStaticRouteParams) (*cloudstack.DeleteStaticRouteResponse, error)
+	DeleteStaticRouteWithContextFunc         func(ctx context.Context, p *cloudstack.DeleteStaticRouteParams) (*cloudstack.DeleteStaticRouteResponse, error)
+	DeleteStaticRouteAsyncFunc               func(p *cloudstack.DeleteStaticRouteParams) (*cloudstack.Job, error)
+	DeleteStaticRouteAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.DeleteStaticRouteParams) (*cloudstack.Job, error)
+	NewDeleteVPCParamsFunc                   func(id string) *cloudstack.DeleteVPCParams
+	DeleteVPCFunc                            func(p *cloudstack.DeleteVPCParams) (*cloudstack.DeleteVPCResponse, error)
+	DeleteVPCWithContextFunc                 func(ctx context.Context, p *cloudstack.DeleteVPCParams) (*cloudstack.DeleteVPCResponse, error)
+	DeleteVPCAsyncFunc                       func(p *cloudstack.DeleteVPCParams) (*cloudstack.Job, error)
+	DeleteVPCAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.DeleteVPCParams) (*cloudstack.Job, error)
+	NewDeleteVPCOfferingParamsFunc           func(id string) *cloudstack.DeleteVPCOfferingParams
+	DeleteVPCOfferingFunc                    func(p *cloudstack.DeleteVPCOfferingParams) (*cloudstack.DeleteVPCOfferingResponse, error)
+	DeleteVPCOfferingWithContextFunc         func(ctx context.Context, p *cloudstack.DeleteVPCOfferingParams) (*cloudstack.DeleteVPCOfferingResponse, error)
+	DeleteVPCOfferingAsyncFunc               func(p *cloudstack.DeleteVPCOfferingParams) (*cloudstack.Job, error)
+	DeleteVPCOfferingAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.DeleteVPCOfferingParams) (*cloudstack.Job, error)
+	NewListPrivateGatewaysParamsFunc         func() *cloudstack.ListPrivateGatewaysParams
+	GetPrivateGatewayByIDFunc                func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.PrivateGateway, int, error)
+	GetPrivateGatewayByIDWithContextFunc     func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.PrivateGateway, int, error)
+	ListPrivateGatewaysFunc                  func(p *cloudstack.ListPrivateGatewaysParams) (*cloudstack.ListPrivateGatewaysResponse, error)
+	ListPrivateGatewaysWithContextFunc       func(ctx context.Context, p *cloudstack.ListPrivateGatewaysParams) (*cloudstack.ListPrivateGatewaysResponse, error)
+	NewListPrivateGatewaysPagerFunc          func(p *cloudstack.ListPrivateGatewaysParams) *cloudstack.ListPrivateGatewaysPager
+	ListPrivateGatewaysAllFunc               func(p *cloudstack.ListPrivateGatewaysParams) (*cloudstack.ListPrivateGatewaysResponse, error)
+	ListPrivateGatewaysAllWithContextFunc    func(ctx context.Context, p *cloudstack.ListPrivateGatewaysParams) (*cloudstack.ListPrivateGatewaysResponse, error)
+	NewListStaticRoutesParamsFunc            func() *cloudstack.ListStaticRoutesParams
+	GetStaticRouteByIDFunc                   func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.StaticRoute, int, error)
+	GetStaticRouteByIDWithContextFunc        func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.StaticRoute, int, error)
+	ListStaticRoutesFunc                     func(p *cloudstack.ListStaticRoutesParams) (*cloudstack.ListStaticRoutesResponse, error)
+	ListStaticRoutesWithContextFunc          func(ctx context.Context, p *cloudstack.ListStaticRoutesParams) (*cloudstack.ListStaticRoutesResponse, error)
+	NewListStaticRoutesPagerFunc             func(p *cloudstack.ListStaticRoutesParams) *cloudstack.ListStaticRoutesPager
+	ListStaticRoutesAllFunc                  func(p *cloudstack.ListStaticRoutesParams) (*cloudstack.ListStaticRoutesResponse, error)
+	ListStaticRoutesAllWithContextFunc       func(ctx context.Context, p *cloudstack.ListStaticRoutesParams) (*cloudstack.ListStaticRoutesResponse, error)
+	NewListVPCOfferingsParamsFunc            func() *cloudstack.ListVPCOfferingsParams
+	GetVPCOfferingIDFunc                     func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVPCOfferingIDWithContextFunc          func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVPCOfferingByNameFunc                 func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.VPCOffering, int, error)
+	GetVPCOfferingByNameWithContextFunc      func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.VPCOffering, int, error)
+	GetVPCOfferingByIDFunc                   func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.VPCOffering, int, error)
+	GetVPCOfferingByIDWithContextFunc        func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.VPCOffering, int, error)
+	ListVPCOfferingsFunc                     func(p *cloudstack.ListVPCOfferingsParams) (*cloudstack.ListVPCOfferingsResponse, error)
+	ListVPCOfferingsWithContextFunc          func(ctx context.Context, p *cloudstack.ListVPCOfferingsParams) (*cloudstack.ListVPCOfferingsResponse, error)
+	NewListVPCOfferingsPagerFunc             func(p *cloudstack.ListVPCOfferingsParams) *cloudstack.ListVPCOfferingsPager
+	ListVPCOfferingsAllFunc                  func(p *cloudstack.ListVPCOfferingsParams) (*cloudstack.ListVPCOfferingsResponse, error)
+	ListVPCOfferingsAllWithContextFunc       func(ctx context.Context, p *cloudstack.ListVPCOfferingsParams) (*cloudstack.ListVPCOfferingsResponse, error)
+	NewListVPCsParamsFunc                    func() *cloudstack.ListVPCsParams
+	GetVPCIDFunc                             func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVPCIDWithContextFunc                  func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVPCByNameFunc                         func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.VPC, int, error)
+	GetVPCByNameWithContextFunc              func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.VPC, int, error)
+	GetVPCByIDFunc                           func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.VPC, int, error)
+	GetVPCByIDWithContextFunc                func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.VPC, int, error)
+	ListVPCsFunc                             func(p *cloudstack.ListVPCsParams) (*cloudstack.ListVPCsResponse, error)
+	ListVPCsWithContextFunc                  func(ctx context.Context, p *cloudstack.ListVPCsParams) (*cloudstack.ListVPCsResponse, error)
+	NewListVPCsPagerFunc                     func(p *cloudstack.ListVPCsParams) *cloudstack.ListVPCsPager
+	ListVPCsAllFunc                          func(p *cloudstack.ListVPCsParams) (*cloudstack.ListVPCsResponse, error)
+	ListVPCsAllWithContextFunc               func(ctx context.Context, p *cloudstack.ListVPCsParams) (*cloudstack.ListVPCsResponse, error)
+	NewMigrateVPCParamsFunc                  func(vpcid string, vpcofferingid string) *cloudstack.MigrateVPCParams
+	MigrateVPCFunc                           func(p *cloudstack.MigrateVPCParams) (*cloudstack.MigrateVPCResponse, error)
+	MigrateVPCWithContextFunc                func(ctx context.Context, p *cloudstack.MigrateVPCParams) (*cloudstack.MigrateVPCResponse, error)
+	MigrateVPCAsyncFunc                      func(p *cloudstack.MigrateVPCParams) (*cloudstack.Job, error)
+	MigrateVPCAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.MigrateVPCParams) (*cloudstack.Job, error)
+	NewRestartVPCParamsFunc                  func(id string) *cloudstack.RestartVPCParams
+	RestartVPCFunc                           func(p *cloudstack.RestartVPCParams) (*cloudstack.RestartVPCResponse, error)
+	RestartVPCWithContextFunc                func(ctx context.Context, p *cloudstack.RestartVPCParams) (*cloudstack.RestartVPCResponse, error)
+	RestartVPCAsyncFunc                      func(p *cloudstack.RestartVPCParams) (*cloudstack.Job, error)
+	RestartVPCAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.RestartVPCParams) (*cloudstack.Job, error)
+	NewUpdateVPCParamsFunc                   func(id string) *cloudstack.UpdateVPCParams
+	UpdateVPCFunc                            func(p *cloudstack.UpdateVPCParams) (*cloudstack.UpdateVPCResponse, error)
+	UpdateVPCWithContextFunc                 func(ctx context.Context, p *cloudstack.UpdateVPCParams) (*cloudstack.UpdateVPCResponse, error)
+	UpdateVPCAsyncFunc                       func(p *cloudstack.UpdateVPCParams) (*cloudstack.Job, error)
+	UpdateVPCAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.UpdateVPCParams) (*cloudstack.Job, error)
+	NewUpdateVPCOfferingParamsFunc           func(id string) *cloudstack.UpdateVPCOfferingParams
+	UpdateVPCOfferingFunc                    func(p *cloudstack.UpdateVPCOfferingParams) (*cloudstack.UpdateVPCOfferingResponse, error)
+	UpdateVPCOfferingWithContextFunc         func(ctx context.Context, p *cloudstack.UpdateVPCOfferingParams) (*cloudstack.UpdateVPCOfferingResponse, error)
+	UpdateVPCOfferingAsyncFunc               func(p *cloudstack.UpdateVPCOfferingParams) (*cloudstack.Job, error)
+	UpdateVPCOfferingAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.UpdateVPCOfferingParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.VPCServiceIface = (*MockVPCService)(nil)
+
+// NewCreatePrivateGatewayParams records the call and calls NewCreatePrivateGatewayParamsFunc
+func (m *MockVPCService) NewCreatePrivateGatewayParams(gateway string, ipaddress string, netmask string, vlan string, vpcid string) (r0 *cloudstack.CreatePrivateGatewayParams) {
+	m.record("NewCreatePrivateGatewayParams", gateway, ipaddress, netmask, vlan, vpcid)
+	if m.NewCreatePrivateGatewayParamsFunc != nil {
+		return m.NewCreatePrivateGatewayParamsFunc(gateway, ipaddress, netmask, vlan, vpcid)
+	}
+	return cloudstack.NewVPCService(nil).NewCreatePrivateGatewayParams(gateway, ipaddress, netmask, vlan, vpcid)
+}
+
+// CreatePrivateGateway records the call and calls CreatePrivateGatewayFunc, or CreatePrivateGatewayWithContextFunc if only that is set
+func (m *MockVPCService) CreatePrivateGateway(p *cloudstack.CreatePrivateGatewayParams) (r0 *cloudstack.CreatePrivateGatewayResponse, r1 error) {
+	m.record("CreatePrivateGateway", p)
+	if m.CreatePrivateGatewayFunc != nil {
+		return m.CreatePrivateGatewayFunc(p)
+	}
+	if m.CreatePrivateGatewayWithContextFunc != nil {
+		return m.CreatePrivateGatewayWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.CreatePrivateGateway")
+	return
+}
+
+// CreatePrivateGatewayWithContext records the call and calls CreatePrivateGatewayWithContextFunc, or CreatePrivateGatewayFunc if only that is set
+func (m *MockVPCService) CreatePrivateGatewayWithContext(ctx context.Context, p *cloudstack.CreatePrivateGatewayParams) (r0 *cloudstack.CreatePrivateGatewayResponse, r1 error) {
+	m.record("CreatePrivateGatewayWithContext", ctx, p)
+	if m.CreatePrivateGatewayWithContextFunc != nil {
+		return m.CreatePrivateGatewayWithContextFunc(ctx, p)
+	}
+	if m.CreatePrivateGatewayFunc != nil {
+		return m.CreatePrivateGatewayFunc(p)
+	}
+	r1 = notMocked("VPCService.CreatePrivateGatewayWithContext")
+	return
+}
+
+// CreatePrivateGatewayAsync records the call and calls CreatePrivateGatewayAsyncFunc, or CreatePrivateGatewayAsyncWithContextFunc if only that is set
+func (m *MockVPCService) CreatePrivateGatewayAsync(p *cloudstack.CreatePrivateGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreatePrivateGatewayAsync", p)
+	if m.CreatePrivateGatewayAsyncFunc != nil {
+		return m.CreatePrivateGatewayAsyncFunc(p)
+	}
+	if m.CreatePrivateGatewayAsyncWithContextFunc != nil {
+		return m.CreatePrivateGatewayAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.CreatePrivateGatewayAsync")
+	return
+}
+
+// CreatePrivateGatewayAsyncWithContext records the call and calls CreatePrivateGatewayAsyncWithContextFunc, or CreatePrivateGatewayAsyncFunc if only that is set
+func (m *MockVPCService) CreatePrivateGatewayAsyncWithContext(ctx context.Context, p *cloudstack.CreatePrivateGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreatePrivateGatewayAsyncWithContext", ctx, p)
+	if m.CreatePrivateGatewayAsyncWithContextFunc != nil {
+		return m.CreatePrivateGatewayAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreatePrivateGatewayAsyncFunc != nil {
+		return m.CreatePrivateGatewayAsyncFunc(p)
+	}
+	r1 = notMocked("VPCService.CreatePrivateGatewayAsyncWithContext")
+	return
+}
+
+// NewCreateStaticRouteParams records the call and calls NewCreateStaticRouteParamsFunc
+func (m *MockVPCService) NewCreateStaticRouteParams(cidr string, gatewayid string) (r0 *cloudstack.CreateStaticRouteParams) {
+	m.record("NewCreateStaticRouteParams", cidr, gatewayid)
+	if m.NewCreateStaticRouteParamsFunc != nil {
+		return m.NewCreateStaticRouteParamsFunc(cidr, gatewayid)
+	}
+	return cloudstack.NewVPCService(nil).NewCreateStaticRouteParams(cidr, gatewayid)
+}
+
+// CreateStaticRoute records the call and calls CreateStaticRouteFunc, or CreateStaticRouteWithContextFunc if only that is set
+func (m *MockVPCService) CreateStaticRoute(p *cloudstack.CreateStaticRouteParams) (r0 *cloudstack.CreateStaticRouteResponse, r1 error) {
+	m.record("CreateStaticRoute", p)
+	if m.CreateStaticRouteFunc != nil {
+		return m.CreateStaticRouteFunc(p)
+	}
+	if m.CreateStaticRouteWithContextFunc != nil {
+		return m.CreateStaticRouteWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.CreateStaticRoute")
+	return
+}
+
+// CreateStaticRouteWithContext records the call and calls CreateStaticRouteWithContextFunc, or CreateStaticRouteFunc if only that is set
+func (m *MockVPCService) CreateStaticRouteWithContext(ctx context.Context, p *cloudstack.CreateStaticRouteParams) (r0 *cloudstack.CreateStaticRouteResponse, r1 error) {
+	m.record("CreateStaticRouteWithContext", ctx, p)
+	if m.CreateStaticRouteWithContextFunc != nil {
+		return m.CreateStaticRouteWithContextFunc(ctx, p)
+	}
+	if m.CreateStaticRouteFunc != nil {
+		return m.CreateStaticRouteFunc(p)
+	}
+	r1 = notMocked("VPCService.CreateStaticRouteWithContext")
+	return
+}
+
+// CreateStaticRouteAsync records the call and calls CreateStaticRouteAsyncFunc, or CreateStaticRouteAsyncWithContextFunc if only that is set
+func (m *MockVPCService) CreateStaticRouteAsync(p *cloudstack.CreateStaticRouteParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateStaticRouteAsync", p)
+	if m.CreateStaticRouteAsyncFunc != nil {
+		return m.CreateStaticRouteAsyncFunc(p)
+	}
+	if m.CreateStaticRouteAsyncWithContextFunc != nil {
+		return m.CreateStaticRouteAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.CreateStaticRouteAsync")
+	return
+}
+
+// CreateStaticRouteAsyncWithContext records the call and calls CreateStaticRouteAsyncWithContextFunc, or CreateStaticRouteAsyncFunc if only that is set
+func (m *MockVPCService) CreateStaticRouteAsyncWithContext(ctx context.Context, p *cloudstack.CreateStaticRouteParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateStaticRouteAsyncWithContext", ctx, p)
+	if m.CreateStaticRouteAsyncWithContextFunc != nil {
+		return m.CreateStaticRouteAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateStaticRouteAsyncFunc != nil {
+		return m.CreateStaticRouteAsyncFunc(p)
+	}
+	r1 = notMocked("VPCService.CreateStaticRouteAsyncWithContext")
+	return
+}
+
+// NewCreateVPCParams records the call and calls NewCreateVPCParamsFunc
+func (m *MockVPCService) NewCreateVPCParams(cidr string, displaytext string, name string, vpcofferingid string, zoneid string) (r0 *cloudstack.CreateVPCParams) {
+	m.record("NewCreateVPCParams", cidr, displaytext, name, vpcofferingid, zoneid)
+	if m.NewCreateVPCParamsFunc != nil {
+		return m.NewCreateVPCParamsFunc(cidr, displaytext, name, vpcofferingid, zoneid)
+	}
+	return cloudstack.NewVPCService(nil).NewCreateVPCParams(cidr, displaytext, name, vpcofferingid, zoneid)
+}
+
+// CreateVPC records the call and calls CreateVPCFunc, or CreateVPCWithContextFunc if only that is set
+func (m *MockVPCService) CreateVPC(p *cloudstack.CreateVPCParams) (r0 *cloudstack.CreateVPCResponse, r1 error) {
+	m.record("CreateVPC", p)
+	if m.CreateVPCFunc != nil {
+		return m.CreateVPCFunc(p)
+	}
+	if m.CreateVPCWithContextFunc != nil {
+		return m.CreateVPCWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.CreateVPC")
+	return
+}
+
+// CreateVPCWithContext records the call and calls CreateVPCWithContextFunc, or CreateVPCFunc if only that is set
+func (m *MockVPCService) CreateVPCWithContext(ctx context.Context, p *cloudstack.CreateVPCParams) (r0 *cloudstack.CreateVPCResponse, r1 error) {
+	m.record("CreateVPCWithContext", ctx, p)
+	if m.CreateVPCWithContextFunc != nil {
+		return m.CreateVPCWithContextFunc(ctx, p)
+	}
+	if m.CreateVPCFunc != nil {
+		return m.CreateVPCFunc(p)
+	}
+	r1 = notMocked("VPCService.CreateVPCWithContext")
+	return
+}
+
+// CreateVPCAsync records the call and calls CreateVPCAsyncFunc, or CreateVPCAsyncWithContextFunc if only that is set
+func (m *MockVPCService) CreateVPCAsync(p *cloudstack.CreateVPCParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVPCAsync", p)
+	if m.CreateVPCAsyncFunc != nil {
+		return m.CreateVPCAsyncFunc(p)
+	}
+	if m.CreateVPCAsyncWithContextFunc != nil {
+		return m.CreateVPCAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.CreateVPCAsync")
+	return
+}
+
+// CreateVPCAsyncWithContext records the call and calls CreateVPCAsyncWithContextFunc, or CreateVPCAsyncFunc if only that is set
+func (m *MockVPCService) CreateVPCAsyncWithContext(ctx context.Context, p *cloudstack.CreateVPCParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVPCAsyncWithContext", ctx, p)
+	if m.CreateVPCAsyncWithContextFunc != nil {
+		return m.CreateVPCAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateVPCAsyncFunc != nil {
+		return m.CreateVPCAsyncFunc(p)
+	}
+	r1 = notMocked("VPCService.CreateVPCAsyncWithContext")
+	return
+}
+
+// NewCreateVPCOfferingParams records the call and calls NewCreateVPCOfferingParamsFunc
+func (m *MockVPCService) NewCreateVPCOfferingParams(displaytext string, name string, supportedservices []string) (r0 *cloudstack.CreateVPCOfferingParams) {
+	m.record("NewCreateVPCOfferingParams", displaytext, name, supportedservices)
+	if m.NewCreateVPCOfferingParamsFunc != nil {
+		return m.NewCreateVPCOfferingParamsFunc(displaytext, name, supportedservices)
+	}
+	return cloudstack.NewVPCService(nil).NewCreateVPCOfferingParams(displaytext, name, supportedservices)
+}
+
+// CreateVPCOffering records the call and calls CreateVPCOfferingFunc, or CreateVPCOfferingWithContextFunc if only that is set
+func (m *MockVPCService) CreateVPCOffering(p *cloudstack.CreateVPCOfferingParams) (r0 *cloudstack.CreateVPCOfferingResponse, r1 error) {
+	m.record("CreateVPCOffering", p)
+	if m.CreateVPCOfferingFunc != nil {
+		return m.CreateVPCOfferingFunc(p)
+	}
+	if m.CreateVPCOfferingWithContextFunc != nil {
+		return m.CreateVPCOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.CreateVPCOffering")
+	return
+}
+
+// CreateVPCOfferingWithContext records the call and calls CreateVPCOfferingWithContextFunc, or CreateVPCOfferingFunc if only that is set
+func (m *MockVPCService) CreateVPCOfferingWithContext(ctx context.Context, p *cloudstack.CreateVPCOfferingParams) (r0 *cloudstack.CreateVPCOfferingResponse, r1 error) {
+	m.record("CreateVPCOfferingWithContext", ctx, p)
+	if m.CreateVPCOfferingWithContextFunc != nil {
+		return m.CreateVPCOfferingWithContextFunc(ctx, p)
+	}
+	if m.CreateVPCOfferingFunc != nil {
+		return m.CreateVPCOfferingFunc(p)
+	}
+	r1 = notMocked("VPCService.CreateVPCOfferingWithContext")
+	return
+}
+
+// CreateVPCOfferingAsync records the call and calls CreateVPCOfferingAsyncFunc, or CreateVPCOfferingAsyncWithContextFunc if only that is set
+func (m *MockVPCService) CreateVPCOfferingAsync(p *cloudstack.CreateVPCOfferingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVPCOfferingAsync", p)
+	if m.CreateVPCOfferingAsyncFunc != nil {
+		return m.CreateVPCOfferingAsyncFunc(p)
+	}
+	if m.CreateVPCOfferingAsyncWithContextFunc != nil {
+		return m.CreateVPCOfferingAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.CreateVPCOfferingAsync")
+	return
+}
+
+// CreateVPCOfferingAsyncWithContext records the call and calls CreateVPCOfferingAsyncWithContextFunc, or CreateVPCOfferingAsyncFunc if only that is set
+func (m *MockVPCService) CreateVPCOfferingAsyncWithContext(ctx context.Context, p *cloudstack.CreateVPCOfferingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVPCOfferingAsyncWithContext", ctx, p)
+	if m.CreateVPCOfferingAsyncWithContextFunc != nil {
+		return m.CreateVPCOfferingAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateVPCOfferingAsyncFunc != nil {
+		return m.CreateVPCOfferingAsyncFunc(p)
+	}
+	r1 = notMocked("VPCService.CreateVPCOfferingAsyncWithContext")
+	return
+}
+
+// NewDeletePrivateGatewayParams records the call and calls NewDeletePrivateGatewayParamsFunc
+func (m *MockVPCService) NewDeletePrivateGatewayParams(id string) (r0 *cloudstack.DeletePrivateGatewayParams) {
+	m.record("NewDeletePrivateGatewayParams", id)
+	if m.NewDeletePrivateGatewayParamsFunc != nil {
+		return m.NewDeletePrivateGatewayParamsFunc(id)
+	}
+	return cloudstack.NewVPCService(nil).NewDeletePrivateGatewayParams(id)
+}
+
+// DeletePrivateGateway records the call and calls DeletePrivateGatewayFunc, or DeletePrivateGatewayWithContextFunc if only that is set
+func (m *MockVPCService) DeletePrivateGateway(p *cloudstack.DeletePrivateGatewayParams) (r0 *cloudstack.DeletePrivateGatewayResponse, r1 error) {
+	m.record("DeletePrivateGateway", p)
+	if m.DeletePrivateGatewayFunc != nil {
+		return m.DeletePrivateGatewayFunc(p)
+	}
+	if m.DeletePrivateGatewayWithContextFunc != nil {
+		return m.DeletePrivateGatewayWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.DeletePrivateGateway")
+	return
+}
+
+// DeletePrivateGatewayWithContext records the call and calls DeletePrivateGatewayWithContextFunc, or DeletePrivateGatewayFunc if only that is set
+func (m *MockVPCService) DeletePrivateGatewayWithContext(ctx context.Context, p *cloudstack.DeletePrivateGatewayParams) (r0 *cloudstack.DeletePrivateGatewayResponse, r1 error) {
+	m.record("DeletePrivateGatewayWithContext", ctx, p)
+	if m.DeletePrivateGatewayWithContextFunc != nil {
+		return m.DeletePrivateGatewayWithContextFunc(ctx, p)
+	}
+	if m.DeletePrivateGatewayFunc != nil {
+		return m.DeletePrivateGatewayFunc(p)
+	}
+	r1 = notMocked("VPCService.DeletePrivateGatewayWithContext")
+	return
+}
+
+// DeletePrivateGatewayAsync records the call and calls DeletePrivateGatewayAsyncFunc, or DeletePrivateGatewayAsyncWithContextFunc if only that is set
+func (m *MockVPCService) DeletePrivateGatewayAsync(p *cloudstack.DeletePrivateGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeletePrivateGatewayAsync", p)
+	if m.DeletePrivateGatewayAsyncFunc != nil {
+		return m.DeletePrivateGatewayAsyncFunc(p)
+	}
+	if m.DeletePrivateGatewayAsyncWithContextFunc != nil {
+		return m.DeletePrivateGatewayAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.DeletePrivateGatewayAsync")
+	return
+}
+
+// DeletePrivateGatewayAsyncWithContext records the call and calls DeletePrivateGatewayAsyncWithContextFunc, or DeletePrivateGatewayAsyncFunc if only that is set
+func (m *MockVPCService) DeletePrivateGatewayAsyncWithContext(ctx context.Context, p *cloudstack.DeletePrivateGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeletePrivateGatewayAsyncWithContext", ctx, p)
+	if m.DeletePrivateGatewayAsyncWithContextFunc != nil {
+		return m.DeletePrivateGatewayAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeletePrivateGatewayAsyncFunc != nil {
+		return m.DeletePrivateGatewayAsyncFunc(p)
+	}
+	r1 = notMocked("VPCService.DeletePrivateGatewayAsyncWithContext")
+	return
+}
+
+// NewDeleteStaticRouteParams records the call and calls NewDeleteStaticRouteParamsFunc
+func (m *MockVPCService) NewDeleteStaticRouteParams(id string) (r0 *cloudstack.DeleteStaticRouteParams) {
+	m.record("NewDeleteStaticRouteParams", id)
+	if m.NewDeleteStaticRouteParamsFunc != nil {
+		return m.NewDeleteStaticRouteParamsFunc(id)
+	}
+	return cloudstack.NewVPCService(nil).NewDeleteStaticRouteParams(id)
+}
+
+// DeleteStaticRoute records the call and calls DeleteStaticRouteFunc, or DeleteStaticRouteWithContextFunc if only that is set
+func (m *MockVPCService) DeleteStaticRoute(p *cloudstack.DeleteStaticRouteParams) (r0 *cloudstack.DeleteStaticRouteResponse, r1 error) {
+	m.record("DeleteStaticRoute", p)
+	if m.DeleteStaticRouteFunc != nil {
+		return m.DeleteStaticRouteFunc(p)
+	}
+	if m.DeleteStaticRouteWithContextFunc != nil {
+		return m.DeleteStaticRouteWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.DeleteStaticRoute")
+	return
+}
+
+// DeleteStaticRouteWithContext records the call and calls DeleteStaticRouteWithContextFunc, or DeleteStaticRouteFunc if only that is set
+func (m *MockVPCService) DeleteStaticRouteWithContext(ctx context.Context, p *cloudstack.DeleteStaticRouteParams) (r0 *cloudstack.DeleteStaticRouteResponse, r1 error) {
+	m.record("DeleteStaticRouteWithContext", ctx, p)
+	if m.DeleteStaticRouteWithContextFunc != nil {
+		return m.DeleteStaticRouteWithContextFunc(ctx, p)
+	}
+	if m.DeleteStaticRouteFunc != nil {
+		return m.DeleteStaticRouteFunc(p)
+	}
+	r1 = notMocked("VPCService.DeleteStaticRouteWithContext")
+	return
+}
+
+// DeleteStaticRouteAsync records the call and calls DeleteStaticRouteAsyncFunc, or DeleteStaticRouteAsyncWithContextFunc if only that is set
+func (m *MockVPCService) DeleteStaticRouteAsync(p *cloudstack.DeleteStaticRouteParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteStaticRouteAsync", p)
+	if m.DeleteStaticRouteAsyncFunc != nil {
+		return m.DeleteStaticRouteAsyncFunc(p)
+	}
+	if m.DeleteStaticRouteAsyncWithContextFunc != nil {
+		return m.DeleteStaticRouteAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.DeleteStaticRouteAsync")
+	return
+}
+
+// DeleteStaticRouteAsyncWithContext records the call and calls DeleteStaticRouteAsyncWithContextFunc, or DeleteStaticRouteAsyncFunc if only that is set
+func (m *MockVPCService) DeleteStaticRouteAsyncWithContext(ctx context.Context, p *cloudstack.DeleteStaticRouteParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteStaticRouteAsyncWithContext", ctx, p)
+	if m.DeleteStaticRouteAsyncWithContextFunc != nil {
+		return m.DeleteStaticRouteAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteStaticRouteAsyncFunc != nil {
+		return m.DeleteStaticRouteAsyncFunc(p)
+	}
+	r1 = notMocked("VPCService.DeleteStaticRouteAsyncWithContext")
+	return
+}
+
+// NewDeleteVPCParams records the call and calls NewDeleteVPCParamsFunc
+func (m *MockVPCService) NewDeleteVPCParams(id string) (r0 *cloudstack.DeleteVPCParams) {
+	m.record("NewDeleteVPCParams", id)
+	if m.NewDeleteVPCParamsFunc != nil {
+		return m.NewDeleteVPCParamsFunc(id)
+	}
+	return cloudstack.NewVPCService(nil).NewDeleteVPCParams(id)
+}
+
+// DeleteVPC records the call and calls DeleteVPCFunc, or DeleteVPCWithContextFunc if only that is set
+func (m *MockVPCService) DeleteVPC(p *cloudstack.DeleteVPCParams) (r0 *cloudstack.DeleteVPCResponse, r1 error) {
+	m.record("DeleteVPC", p)
+	if m.DeleteVPCFunc != nil {
+		return m.DeleteVPCFunc(p)
+	}
+	if m.DeleteVPCWithContextFunc != nil {
+		return m.DeleteVPCWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.DeleteVPC")
+	return
+}
+
+// DeleteVPCWithContext records the call and calls DeleteVPCWithContextFunc, or DeleteVPCFunc if only that is set
+func (m *MockVPCService) DeleteVPCWithContext(ctx context.Context, p *cloudstack.DeleteVPCParams) (r0 *cloudstack.DeleteVPCResponse, r1 error) {
+	m.record("DeleteVPCWithContext", ctx, p)
+	if m.DeleteVPCWithContextFunc != nil {
+		return m.DeleteVPCWithContextFunc(ctx, p)
+	}
+	if m.DeleteVPCFunc != nil {
+		return m.DeleteVPCFunc(p)
+	}
+	r1 = notMocked("VPCService.DeleteVPCWithContext")
+	return
+}
+
+// DeleteVPCAsync records the call and calls DeleteVPCAsyncFunc, or DeleteVPCAsyncWithContextFunc if only that is set
+func (m *MockVPCService) DeleteVPCAsync(p *cloudstack.DeleteVPCParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteVPCAsync", p)
+	if m.DeleteVPCAsyncFunc != nil {
+		return m.DeleteVPCAsyncFunc(p)
+	}
+	if m.DeleteVPCAsyncWithContextFunc != nil {
+		return m.DeleteVPCAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.DeleteVPCAsync")
+	return
+}
+
+// DeleteVPCAsyncWithContext records the call and calls DeleteVPCAsyncWithContextFunc, or DeleteVPCAsyncFunc if only that is set
+func (m *MockVPCService) DeleteVPCAsyncWithContext(ctx context.Context, p *cloudstack.DeleteVPCParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteVPCAsyncWithContext", ctx, p)
+	if m.DeleteVPCAsyncWithContextFunc != nil {
+		return m.DeleteVPCAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteVPCAsyncFunc != nil {
+		return m.DeleteVPCAsyncFunc(p)
+	}
+	r1 = notMocked("VPCService.DeleteVPCAsyncWithContext")
+	return
+}
+
+// NewDeleteVPCOfferingParams records the call and calls NewDeleteVPCOfferingParamsFunc
+func (m *MockVPCService) NewDeleteVPCOfferingParams(id string) (r0 *cloudstack.DeleteVPCOfferingParams) {
+	m.record("NewDeleteVPCOfferingParams", id)
+	if m.NewDeleteVPCOfferingParamsFunc != nil {
+		return m.NewDeleteVPCOfferingParamsFunc(id)
+	}
+	return cloudstack.NewVPCService(nil).NewDeleteVPCOfferingParams(id)
+}
+
+// DeleteVPCOffering records the call and calls DeleteVPCOfferingFunc, or DeleteVPCOfferingWithContextFunc if only that is set
+func (m *MockVPCService) DeleteVPCOffering(p *cloudstack.DeleteVPCOfferingParams) (r0 *cloudstack.DeleteVPCOfferingResponse, r1 error) {
+	m.record("DeleteVPCOffering", p)
+	if m.DeleteVPCOfferingFunc != nil {
+		return m.DeleteVPCOfferingFunc(p)
+	}
+	if m.DeleteVPCOfferingWithContextFunc != nil {
+		return m.DeleteVPCOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.DeleteVPCOffering")
+	return
+}
+
+// DeleteVPCOfferingWithContext records the call and calls DeleteVPCOfferingWithContextFunc, or DeleteVPCOfferingFunc if only that is set
+func (m *MockVPCService) DeleteVPCOfferingWithContext(ctx context.Context, p *cloudstack.DeleteVPCOfferingParams) (r0 *cloudstack.DeleteVPCOfferingResponse, r1 error) {
+	m.record("DeleteVPCOfferingWithContext", ctx, p)
+	if m.DeleteVPCOfferingWithContextFunc != nil {
+		return m.DeleteVPCOfferingWithContextFunc(ctx, p)
+	}
+	if m.DeleteVPCOfferingFunc != nil {
+		return m.DeleteVPCOfferingFunc(p)
+	}
+	r1 = notMocked("VPCService.DeleteVPCOfferingWithContext")
+	return
+}
+
+// DeleteVPCOfferingAsync records the call and calls DeleteVPCOfferingAsyncFunc, or DeleteVPCOfferingAsyncWithContextFunc if only that is set
+func (m *MockVPCService) DeleteVPCOfferingAsync(p *cloudstack.DeleteVPCOfferingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteVPCOfferingAsync", p)
+	if m.DeleteVPCOfferingAsyncFunc != nil {
+		return m.DeleteVPCOfferingAsyncFunc(p)
+	}
+	if m.DeleteVPCOfferingAsyncWithContextFunc != nil {
+		return m.DeleteVPCOfferingAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.DeleteVPCOfferingAsync")
+	return
+}
+
+// DeleteVPCOfferingAsyncWithContext records the call and calls DeleteVPCOfferingAsyncWithContextFunc, or DeleteVPCOfferingAsyncFunc if only that is set
+func (m *MockVPCService) DeleteVPCOfferingAsyncWithContext(ctx context.Context, p *cloudstack.DeleteVPCOfferingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteVPCOfferingAsyncWithContext", ctx, p)
+	if m.DeleteVPCOfferingAsyncWithContextFunc != nil {
+		return m.DeleteVPCOfferingAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteVPCOfferingAsyncFunc != nil {
+		return m.DeleteVPCOfferingAsyncFunc(p)
+	}
+	r1 = notMocked("VPCService.DeleteVPCOfferingAsyncWithContext")
+	return
+}
+
+// NewListPrivateGatewaysParams records the call and calls NewListPrivateGatewaysParamsFunc
+func (m *MockVPCService) NewListPrivateGatewaysParams() (r0 *cloudstack.ListPrivateGatewaysParams) {
+	m.record("NewListPrivateGatewaysParams")
+	if m.NewListPrivateGatewaysParamsFunc != nil {
+		return m.NewListPrivateGatewaysParamsFunc()
+	}
+	return cloudstack.NewVPCService(nil).NewListPrivateGatewaysParams()
+}
+
+// GetPrivateGatewayByID records the call and calls GetPrivateGatewayByIDFunc, or GetPrivateGatewayByIDWithContextFunc if only that is set
+func (m *MockVPCService) GetPrivateGatewayByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.PrivateGateway, r1 int, r2 error) {
+	m.record("GetPrivateGatewayByID", id, opts)
+	if m.GetPrivateGatewayByIDFunc != nil {
+		return m.GetPrivateGatewayByIDFunc(id, opts...)
+	}
+	if m.GetPrivateGatewayByIDWithContextFunc != nil {
+		return m.GetPrivateGatewayByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VPCService.GetPrivateGatewayByID")
+	return
+}
+
+// GetPrivateGatewayByIDWithContext records the call and calls GetPrivateGatewayByIDWithContextFunc, or GetPrivateGatewayByIDFunc if only that is set
+func (m *MockVPCService) GetPrivateGatewayByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.PrivateGateway, r1 int, r2 error) {
+	m.record("GetPrivateGatewayByIDWithContext", ctx, id, opts)
+	if m.GetPrivateGatewayByIDWithContextFunc != nil {
+		return m.GetPrivateGatewayByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetPrivateGatewayByIDFunc != nil {
+		return m.GetPrivateGatewayByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VPCService.GetPrivateGatewayByIDWithContext")
+	return
+}
+
+// ListPrivateGateways records the call and calls ListPrivateGatewaysFunc, or ListPrivateGatewaysWithContextFunc if only that is set
+func (m *MockVPCService) ListPrivateGateways(p *cloudstack.ListPrivateGatewaysParams) (r0 *cloudstack.ListPrivateGatewaysResponse, r1 error) {
+	m.record("ListPrivateGateways", p)
+	if m.ListPrivateGatewaysFunc != nil {
+		return m.ListPrivateGatewaysFunc(p)
+	}
+	if m.ListPrivateGatewaysWithContextFunc != nil {
+		return m.ListPrivateGatewaysWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.ListPrivateGateways")
+	return
+}
+
+// ListPrivateGatewaysWithContext records the call and calls ListPrivateGatewaysWithContextFunc, or ListPrivateGatewaysFunc if only that is set
+func (m *MockVPCService) ListPrivateGatewaysWithContext(ctx context.Context, p *cloudstack.ListPrivateGatewaysParams) (r0 *cloudstack.ListPrivateGatewaysResponse, r1 error) {
+	m.record("ListPrivateGatewaysWithContext", ctx, p)
+	if m.ListPrivateGatewaysWithContextFunc != nil {
+		return m.ListPrivateGatewaysWithContextFunc(ctx, p)
+	}
+	if m.ListPrivateGatewaysFunc != nil {
+		return m.ListPrivateGatewaysFunc(p)
+	}
+	r1 = notMocked("VPCService.ListPrivateGatewaysWithContext")
+	return
+}
+
+// NewListPrivateGatewaysPager records the call and calls NewListPrivateGatewaysPagerFunc
+func (m *MockVPCService) NewListPrivateGatewaysPager(p *cloudstack.ListPrivateGatewaysParams) (r0 *cloudstack.ListPrivateGatewaysPager) {
+	m.record("NewListPrivateGatewaysPager", p)
+	if m.NewListPrivateGatewaysPagerFunc != nil {
+		return m.NewListPrivateGatewaysPagerFunc(p)
+	}
+	return
+}
+
+// ListPrivateGatewaysAll records the call and calls ListPrivateGatewaysAllFunc, or ListPrivateGatewaysAllWithContextFunc if only that is set
+func (m *MockVPCService) ListPrivateGatewaysAll(p *cloudstack.ListPrivateGatewaysParams) (r0 *cloudstack.ListPrivateGatewaysResponse, r1 error) {
+	m.record("ListPrivateGatewaysAll", p)
+	if m.ListPrivateGatewaysAllFunc != nil {
+		return m.ListPrivateGatewaysAllFunc(p)
+	}
+	if m.ListPrivateGatewaysAllWithContextFunc != nil {
+		return m.ListPrivateGatewaysAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.ListPrivateGatewaysAll")
+	return
+}
+
+// ListPrivateGatewaysAllWithContext records the call and calls ListPrivateGatewaysAllWithContextFunc, or ListPrivateGatewaysAllFunc if only that is set
+func (m *MockVPCService) ListPrivateGatewaysAllWithContext(ctx context.Context, p *cloudstack.ListPrivateGatewaysParams) (r0 *cloudstack.ListPrivateGatewaysResponse, r1 error) {
+	m.record("ListPrivateGatewaysAllWithContext", ctx, p)
+	if m.ListPrivateGatewaysAllWithContextFunc != nil {
+		return m.ListPrivateGatewaysAllWithContextFunc(ctx, p)
+	}
+	if m.ListPrivateGatewaysAllFunc != nil {
+		return m.ListPrivateGatewaysAllFunc(p)
+	}
+	r1 = notMocked("VPCService.ListPrivateGatewaysAllWithContext")
+	return
+}
+
+// NewListStaticRoutesParams records the call and calls NewListStaticRoutesParamsFunc
+func (m *MockVPCService) NewListStaticRoutesParams() (r0 *cloudstack.ListStaticRoutesParams) {
+	m.record("NewListStaticRoutesParams")
+	if m.NewListStaticRoutesParamsFunc != nil {
+		return m.NewListStaticRoutesParamsFunc()
+	}
+	return cloudstack.NewVPCService(nil).NewListStaticRoutesParams()
+}
+
+// GetStaticRouteByID records the call and calls GetStaticRouteByIDFunc, or GetStaticRouteByIDWithContextFunc if only that is set
+func (m *MockVPCService) GetStaticRouteByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.StaticRoute, r1 int, r2 error) {
+	m.record("GetStaticRouteByID", id, opts)
+	if m.GetStaticRouteByIDFunc != nil {
+		return m.GetStaticRouteByIDFunc(id, opts...)
+	}
+	if m.GetStaticRouteByIDWithContextFunc != nil {
+		return m.GetStaticRouteByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VPCService.GetStaticRouteByID")
+	return
+}
+
+// GetStaticRouteByIDWithContext records the call and calls GetStaticRouteByIDWithContextFunc, or GetStaticRouteByIDFunc if only that is set
+func (m *MockVPCService) GetStaticRouteByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.StaticRoute, r1 int, r2 error) {
+	m.record("GetStaticRouteByIDWithContext", ctx, id, opts)
+	if m.GetStaticRouteByIDWithContextFunc != nil {
+		return m.GetStaticRouteByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetStaticRouteByIDFunc != nil {
+		return m.GetStaticRouteByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VPCService.GetStaticRouteByIDWithContext")
+	return
+}
+
+// ListStaticRoutes records the call and calls ListStaticRoutesFunc, or ListStaticRoutesWithContextFunc if only that is set
+func (m *MockVPCService) ListStaticRoutes(p *cloudstack.ListStaticRoutesParams) (r0 *cloudstack.ListStaticRoutesResponse, r1 error) {
+	m.record("ListStaticRoutes", p)
+	if m.ListStaticRoutesFunc != nil {
+		return m.ListStaticRoutesFunc(p)
+	}
+	if m.ListStaticRoutesWithContextFunc != nil {
+		return m.ListStaticRoutesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.ListStaticRoutes")
+	return
+}
+
+// ListStaticRoutesWithContext records the call and calls ListStaticRoutesWithContextFunc, or ListStaticRoutesFunc if only that is set
+func (m *MockVPCService) ListStaticRoutesWithContext(ctx context.Context, p *cloudstack.ListStaticRoutesParams) (r0 *cloudstack.ListStaticRoutesResponse, r1 error) {
+	m.record("ListStaticRoutesWithContext", ctx, p)
+	if m.ListStaticRoutesWithContextFunc != nil {
+		return m.ListStaticRoutesWithContextFunc(ctx, p)
+	}
+	if m.ListStaticRoutesFunc != nil {
+		return m.ListStaticRoutesFunc(p)
+	}
+	r1 = notMocked("VPCService.ListStaticRoutesWithContext")
+	return
+}
+
+// NewListStaticRoutesPager records the call and calls NewListStaticRoutesPagerFunc
+func (m *MockVPCService) NewListStaticRoutesPager(p *cloudstack.ListStaticRoutesParams) (r0 *cloudstack.ListStaticRoutesPager) {
+	m.record("NewListStaticRoutesPager", p)
+	if m.NewListStaticRoutesPagerFunc != nil {
+		return m.NewListStaticRoutesPagerFunc(p)
+	}
+	return
+}
+
+// ListStaticRoutesAll records the call and calls ListStaticRoutesAllFunc, or ListStaticRoutesAllWithContextFunc if only that is set
+func (m *MockVPCService) ListStaticRoutesAll(p *cloudstack.ListStaticRoutesParams) (r0 *cloudstack.ListStaticRoutesResponse, r1 error) {
+	m.record("ListStaticRoutesAll", p)
+	if m.ListStaticRoutesAllFunc != nil {
+		return m.ListStaticRoutesAllFunc(p)
+	}
+	if m.ListStaticRoutesAllWithContextFunc != nil {
+		return m.ListStaticRoutesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.ListStaticRoutesAll")
+	return
+}
+
+// ListStaticRoutesAllWithContext records the call and calls ListStaticRoutesAllWithContextFunc, or ListStaticRoutesAllFunc if only that is set
+func (m *MockVPCService) ListStaticRoutesAllWithContext(ctx context.Context, p *cloudstack.ListStaticRoutesParams) (r0 *cloudstack.ListStaticRoutesResponse, r1 error) {
+	m.record("ListStaticRoutesAllWithContext", ctx, p)
+	if m.ListStaticRoutesAllWithContextFunc != nil {
+		return m.ListStaticRoutesAllWithContextFunc(ctx, p)
+	}
+	if m.ListStaticRoutesAllFunc != nil {
+		return m.ListStaticRoutesAllFunc(p)
+	}
+	r1 = notMocked("VPCService.ListStaticRoutesAllWithContext")
+	return
+}
+
+// NewListVPCOfferingsParams records the call and calls NewListVPCOfferingsParamsFunc
+func (m *MockVPCService) NewListVPCOfferingsParams() (r0 *cloudstack.ListVPCOfferingsParams) {
+	m.record("NewListVPCOfferingsParams")
+	if m.NewListVPCOfferingsParamsFunc != nil {
+		return m.NewListVPCOfferingsParamsFunc()
+	}
+	return cloudstack.NewVPCService(nil).NewListVPCOfferingsParams()
+}
+
+// GetVPCOfferingID records the call and calls GetVPCOfferingIDFunc, or GetVPCOfferingIDWithContextFunc if only that is set
+func (m *MockVPCService) GetVPCOfferingID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVPCOfferingID", name, opts)
+	if m.GetVPCOfferingIDFunc != nil {
+		return m.GetVPCOfferingIDFunc(name, opts...)
+	}
+	if m.GetVPCOfferingIDWithContextFunc != nil {
+		return m.GetVPCOfferingIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VPCService.GetVPCOfferingID")
+	return
+}
+
+// GetVPCOfferingIDWithContext records the call and calls GetVPCOfferingIDWithContextFunc, or GetVPCOfferingIDFunc if only that is set
+func (m *MockVPCService) GetVPCOfferingIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVPCOfferingIDWithContext", ctx, name, opts)
+	if m.GetVPCOfferingIDWithContextFunc != nil {
+		return m.GetVPCOfferingIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetVPCOfferingIDFunc != nil {
+		return m.GetVPCOfferingIDFunc(name, opts...)
+	}
+	r2 = notMocked("VPCService.GetVPCOfferingIDWithContext")
+	return
+}
+
+// GetVPCOfferingByName records the call and calls GetVPCOfferingByNameFunc, or GetVPCOfferingByNameWithContextFunc if only that is set
+func (m *MockVPCService) GetVPCOfferingByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VPCOffering, r1 int, r2 error) {
+	m.record("GetVPCOfferingByName", name, opts)
+	if m.GetVPCOfferingByNameFunc != nil {
+		return m.GetVPCOfferingByNameFunc(name, opts...)
+	}
+	if m.GetVPCOfferingByNameWithContextFunc != nil {
+		return m.GetVPCOfferingByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VPCService.GetVPCOfferingByName")
+	return
+}
+
+// GetVPCOfferingByNameWithContext records the call and calls GetVPCOfferingByNameWithContextFunc, or GetVPCOfferingByNameFunc if only that is set
+func (m *MockVPCService) GetVPCOfferingByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VPCOffering, r1 int, r2 error) {
+	m.record("GetVPCOfferingByNameWithContext", ctx, name, opts)
+	if m.GetVPCOfferingByNameWithContextFunc != nil {
+		return m.GetVPCOfferingByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetVPCOfferingByNameFunc != nil {
+		return m.GetVPCOfferingByNameFunc(name, opts...)
+	}
+	r2 = notMocked("VPCService.GetVPCOfferingByNameWithContext")
+	return
+}
+
+// GetVPCOfferingByID records the call and calls GetVPCOfferingByIDFunc, or GetVPCOfferingByIDWithContextFunc if only that is set
+func (m *MockVPCService) GetVPCOfferingByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VPCOffering, r1 int, r2 error) {
+	m.record("GetVPCOfferingByID", id, opts)
+	if m.GetVPCOfferingByIDFunc != nil {
+		return m.GetVPCOfferingByIDFunc(id, opts...)
+	}
+	if m.GetVPCOfferingByIDWithContextFunc != nil {
+		return m.GetVPCOfferingByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VPCService.GetVPCOfferingByID")
+	return
+}
+
+// GetVPCOfferingByIDWithContext records the call and calls GetVPCOfferingByIDWithContextFunc, or GetVPCOfferingByIDFunc if only that is set
+func (m *MockVPCService) GetVPCOfferingByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VPCOffering, r1 int, r2 error) {
+	m.record("GetVPCOfferingByIDWithContext", ctx, id, opts)
+	if m.GetVPCOfferingByIDWithContextFunc != nil {
+		return m.GetVPCOfferingByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetVPCOfferingByIDFunc != nil {
+		return m.GetVPCOfferingByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VPCService.GetVPCOfferingByIDWithContext")
+	return
+}
+
+// ListVPCOfferings records the call and calls ListVPCOfferingsFunc, or ListVPCOfferingsWithContextFunc if only that is set
+func (m *MockVPCService) ListVPCOfferings(p *cloudstack.ListVPCOfferingsParams) (r0 *cloudstack.ListVPCOfferingsResponse, r1 error) {
+	m.record("ListVPCOfferings", p)
+	if m.ListVPCOfferingsFunc != nil {
+		return m.ListVPCOfferingsFunc(p)
+	}
+	if m.ListVPCOfferingsWithContextFunc != nil {
+		return m.ListVPCOfferingsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.ListVPCOfferings")
+	return
+}
+
+// ListVPCOfferingsWithContext records the call and calls ListVPCOfferingsWithContextFunc, or ListVPCOfferingsFunc if only that is set
+func (m *MockVPCService) ListVPCOfferingsWithContext(ctx context.Context, p *cloudstack.ListVPCOfferingsParams) (r0 *cloudstack.ListVPCOfferingsResponse, r1 error) {
+	m.record("ListVPCOfferingsWithContext", ctx, p)
+	if m.ListVPCOfferingsWithContextFunc != nil {
+		return m.ListVPCOfferingsWithContextFunc(ctx, p)
+	}
+	if m.ListVPCOfferingsFunc != nil {
+		return m.ListVPCOfferingsFunc(p)
+	}
+	r1 = notMocked("VPCService.ListVPCOfferingsWithContext")
+	return
+}
+
+// NewListVPCOfferingsPager records the call and calls NewListVPCOfferingsPagerFunc
+func (m *MockVPCService) NewListVPCOfferingsPager(p *cloudstack.ListVPCOfferingsParams) (r0 *cloudstack.ListVPCOfferingsPager) {
+	m.record("NewListVPCOfferingsPager", p)
+	if m.NewListVPCOfferingsPagerFunc != nil {
+		return m.NewListVPCOfferingsPagerFunc(p)
+	}
+	return
+}
+
+// ListVPCOfferingsAll records the call and calls ListVPCOfferingsAllFunc, or ListVPCOfferingsAllWithContextFunc if only that is set
+func (m *MockVPCService) ListVPCOfferingsAll(p *cloudstack.ListVPCOfferingsParams) (r0 *cloudstack.ListVPCOfferingsResponse, r1 error) {
+	m.record("ListVPCOfferingsAll", p)
+	if m.ListVPCOfferingsAllFunc != nil {
+		return m.ListVPCOfferingsAllFunc(p)
+	}
+	if m.ListVPCOfferingsAllWithContextFunc != nil {
+		return m.ListVPCOfferingsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.ListVPCOfferingsAll")
+	return
+}
+
+// ListVPCOfferingsAllWithContext records the call and calls ListVPCOfferingsAllWithContextFunc, or ListVPCOfferingsAllFunc if only that is set
+func (m *MockVPCService) ListVPCOfferingsAllWithContext(ctx context.Context, p *cloudstack.ListVPCOfferingsParams) (r0 *cloudstack.ListVPCOfferingsResponse, r1 error) {
+	m.record("ListVPCOfferingsAllWithContext", ctx, p)
+	if m.ListVPCOfferingsAllWithContextFunc != nil {
+		return m.ListVPCOfferingsAllWithContextFunc(ctx, p)
+	}
+	if m.ListVPCOfferingsAllFunc != nil {
+		return m.ListVPCOfferingsAllFunc(p)
+	}
+	r1 = notMocked("VPCService.ListVPCOfferingsAllWithContext")
+	return
+}
+
+// NewListVPCsParams records the call and calls NewListVPCsParamsFunc
+func (m *MockVPCService) NewListVPCsParams() (r0 *cloudstack.ListVPCsParams) {
+	m.record("NewListVPCsParams")
+	if m.NewListVPCsParamsFunc != nil {
+		return m.NewListVPCsParamsFunc()
+	}
+	return cloudstack.NewVPCService(nil).NewListVPCsParams()
+}
+
+// GetVPCID records the call and calls GetVPCIDFunc, or GetVPCIDWithContextFunc if only that is set
+func (m *MockVPCService) GetVPCID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVPCID", name, opts)
+	if m.GetVPCIDFunc != nil {
+		return m.GetVPCIDFunc(name, opts...)
+	}
+	if m.GetVPCIDWithContextFunc != nil {
+		return m.GetVPCIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VPCService.GetVPCID")
+	return
+}
+
+// GetVPCIDWithContext records the call and calls GetVPCIDWithContextFunc, or GetVPCIDFunc if only that is set
+func (m *MockVPCService) GetVPCIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVPCIDWithContext", ctx, name, opts)
+	if m.GetVPCIDWithContextFunc != nil {
+		return m.GetVPCIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetVPCIDFunc != nil {
+		return m.GetVPCIDFunc(name, opts...)
+	}
+	r2 = notMocked("VPCService.GetVPCIDWithContext")
+	return
+}
+
+// GetVPCByName records the call and calls GetVPCByNameFunc, or GetVPCByNameWithContextFunc if only that is set
+func (m *MockVPCService) GetVPCByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VPC, r1 int, r2 error) {
+	m.record("GetVPCByName", name, opts)
+	if m.GetVPCByNameFunc != nil {
+		return m.GetVPCByNameFunc(name, opts...)
+	}
+	if m.GetVPCByNameWithContextFunc != nil {
+		return m.GetVPCByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VPCService.GetVPCByName")
+	return
+}
+
+// GetVPCByNameWithContext records the call and calls GetVPCByNameWithContextFunc, or GetVPCByNameFunc if only that is set
+func (m *MockVPCService) GetVPCByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VPC, r1 int, r2 error) {
+	m.record("GetVPCByNameWithContext", ctx, name, opts)
+	if m.GetVPCByNameWithContextFunc != nil {
+		return m.GetVPCByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetVPCByNameFunc != nil {
+		return m.GetVPCByNameFunc(name, opts...)
+	}
+	r2 = notMocked("VPCService.GetVPCByNameWithContext")
+	return
+}
+
+// GetVPCByID records the call and calls GetVPCByIDFunc, or GetVPCByIDWithContextFunc if only that is set
+func (m *MockVPCService) GetVPCByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VPC, r1 int, r2 error) {
+	m.record("GetVPCByID", id, opts)
+	if m.GetVPCByIDFunc != nil {
+		return m.GetVPCByIDFunc(id, opts...)
+	}
+	if m.GetVPCByIDWithContextFunc != nil {
+		return m.GetVPCByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VPCService.GetVPCByID")
+	return
+}
+
+// GetVPCByIDWithContext records the call and calls GetVPCByIDWithContextFunc, or GetVPCByIDFunc if only that is set
+func (m *MockVPCService) GetVPCByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VPC, r1 int, r2 error) {
+	m.record("GetVPCByIDWithContext", ctx, id, opts)
+	if m.GetVPCByIDWithContextFunc != nil {
+		return m.GetVPCByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetVPCByIDFunc != nil {
+		return m.GetVPCByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VPCService.GetVPCByIDWithContext")
+	return
+}
+
+// ListVPCs records the call and calls ListVPCsFunc, or ListVPCsWithContextFunc if only that is set
+func (m *MockVPCService) ListVPCs(p *cloudstack.ListVPCsParams) (r0 *cloudstack.ListVPCsResponse, r1 error) {
+	m.record("ListVPCs", p)
+	if m.ListVPCsFunc != nil {
+		return m.ListVPCsFunc(p)
+	}
+	if m.ListVPCsWithContextFunc != nil {
+		return m.ListVPCsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.ListVPCs")
+	return
+}
+
+// ListVPCsWithContext records the call and calls ListVPCsWithContextFunc, or ListVPCsFunc if only that is set
+func (m *MockVPCService) ListVPCsWithContext(ctx context.Context, p *cloudstack.ListVPCsParams) (r0 *cloudstack.ListVPCsResponse, r1 error) {
+	m.record("ListVPCsWithContext", ctx, p)
+	if m.ListVPCsWithContextFunc != nil {
+		return m.ListVPCsWithContextFunc(ctx, p)
+	}
+	if m.ListVPCsFunc != nil {
+		return m.ListVPCsFunc(p)
+	}
+	r1 = notMocked("VPCService.ListVPCsWithContext")
+	return
+}
+
+// NewListVPCsPager records the call and calls NewListVPCsPagerFunc
+func (m *MockVPCService) NewListVPCsPager(p *cloudstack.ListVPCsParams) (r0 *cloudstack.ListVPCsPager) {
+	m.record("NewListVPCsPager", p)
+	if m.NewListVPCsPagerFunc != nil {
+		return m.NewListVPCsPagerFunc(p)
+	}
+	return
+}
+
+// ListVPCsAll records the call and calls ListVPCsAllFunc, or ListVPCsAllWithContextFunc if only that is set
+func (m *MockVPCService) ListVPCsAll(p *cloudstack.ListVPCsParams) (r0 *cloudstack.ListVPCsResponse, r1 error) {
+	m.record("ListVPCsAll", p)
+	if m.ListVPCsAllFunc != nil {
+		return m.ListVPCsAllFunc(p)
+	}
+	if m.ListVPCsAllWithContextFunc != nil {
+		return m.ListVPCsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.ListVPCsAll")
+	return
+}
+
+// ListVPCsAllWithContext records the call and calls ListVPCsAllWithContextFunc, or ListVPCsAllFunc if only that is set
+func (m *MockVPCService) ListVPCsAllWithContext(ctx context.Context, p *cloudstack.ListVPCsParams) (r0 *cloudstack.ListVPCsResponse, r1 error) {
+	m.record("ListVPCsAllWithContext", ctx, p)
+	if m.ListVPCsAllWithContextFunc != nil {
+		return m.ListVPCsAllWithContextFunc(ctx, p)
+	}
+	if m.ListVPCsAllFunc != nil {
+		return m.ListVPCsAllFunc(p)
+	}
+	r1 = notMocked("VPCService.ListVPCsAllWithContext")
+	return
+}
+
+// NewMigrateVPCParams records the call and calls NewMigrateVPCParamsFunc
+func (m *MockVPCService) NewMigrateVPCParams(vpcid string, vpcofferingid string) (r0 *cloudstack.MigrateVPCParams) {
+	m.record("NewMigrateVPCParams", vpcid, vpcofferingid)
+	if m.NewMigrateVPCParamsFunc != nil {
+		return m.NewMigrateVPCParamsFunc(vpcid, vpcofferingid)
+	}
+	return cloudstack.NewVPCService(nil).NewMigrateVPCParams(vpcid, vpcofferingid)
+}
+
+// MigrateVPC records the call and calls MigrateVPCFunc, or MigrateVPCWithContextFunc if only that is set
+func (m *MockVPCService) MigrateVPC(p *cloudstack.MigrateVPCParams) (r0 *cloudstack.MigrateVPCResponse, r1 error) {
+	m.record("MigrateVPC", p)
+	if m.MigrateVPCFunc != nil {
+		return m.MigrateVPCFunc(p)
+	}
+	if m.MigrateVPCWithContextFunc != nil {
+		return m.MigrateVPCWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.MigrateVPC")
+	return
+}
+
+// MigrateVPCWithContext records the call and calls MigrateVPCWithContextFunc, or MigrateVPCFunc if only that is set
+func (m *MockVPCService) MigrateVPCWithContext(ctx context.Context, p *cloudstack.MigrateVPCParams) (r0 *cloudstack.MigrateVPCResponse, r1 error) {
+	m.record("MigrateVPCWithContext", ctx, p)
+	if m.MigrateVPCWithContextFunc != nil {
+		return m.MigrateVPCWithContextFunc(ctx, p)
+	}
+	if m.MigrateVPCFunc != nil {
+		return m.MigrateVPCFunc(p)
+	}
+	r1 = notMocked("VPCService.MigrateVPCWithContext")
+	return
+}
+
+// MigrateVPCAsync records the call and calls MigrateVPCAsyncFunc, or MigrateVPCAsyncWithContextFunc if only that is set
+func (m *MockVPCService) MigrateVPCAsync(p *cloudstack.MigrateVPCParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MigrateVPCAsync", p)
+	if m.MigrateVPCAsyncFunc != nil {
+		return m.MigrateVPCAsyncFunc(p)
+	}
+	if m.MigrateVPCAsyncWithContextFunc != nil {
+		return m.MigrateVPCAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.MigrateVPCAsync")
+	return
+}
+
+// MigrateVPCAsyncWithContext records the call and calls MigrateVPCAsyncWithContextFunc, or MigrateVPCAsyncFunc if only that is set
+func (m *MockVPCService) MigrateVPCAsyncWithContext(ctx context.Context, p *cloudstack.MigrateVPCParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MigrateVPCAsyncWithContext", ctx, p)
+	if m.MigrateVPCAsyncWithContextFunc != nil {
+		return m.MigrateVPCAsyncWithContextFunc(ctx, p)
+	}
+	if m.MigrateVPCAsyncFunc != nil {
+		return m.MigrateVPCAsyncFunc(p)
+	}
+	r1 = notMocked("VPCService.MigrateVPCAsyncWithContext")
+	return
+}
+
+// NewRestartVPCParams records the call and calls NewRestartVPCParamsFunc
+func (m *MockVPCService) NewRestartVPCParams(id string) (r0 *cloudstack.RestartVPCParams) {
+	m.record("NewRestartVPCParams", id)
+	if m.NewRestartVPCParamsFunc != nil {
+		return m.NewRestartVPCParamsFunc(id)
+	}
+	return cloudstack.NewVPCService(nil).NewRestartVPCParams(id)
+}
+
+// RestartVPC records the call and calls RestartVPCFunc, or RestartVPCWithContextFunc if only that is set
+func (m *MockVPCService) RestartVPC(p *cloudstack.RestartVPCParams) (r0 *cloudstack.RestartVPCResponse, r1 error) {
+	m.record("RestartVPC", p)
+	if m.RestartVPCFunc != nil {
+		return m.RestartVPCFunc(p)
+	}
+	if m.RestartVPCWithContextFunc != nil {
+		return m.RestartVPCWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.RestartVPC")
+	return
+}
+
+// RestartVPCWithContext records the call and calls RestartVPCWithContextFunc, or RestartVPCFunc if only that is set
+func (m *MockVPCService) RestartVPCWithContext(ctx context.Context, p *cloudstack.RestartVPCParams) (r0 *cloudstack.RestartVPCResponse, r1 error) {
+	m.record("RestartVPCWithContext", ctx, p)
+	if m.RestartVPCWithContextFunc != nil {
+		return m.RestartVPCWithContextFunc(ctx, p)
+	}
+	if m.RestartVPCFunc != nil {
+		return m.RestartVPCFunc(p)
+	}
+	r1 = notMocked("VPCService.RestartVPCWithContext")
+	return
+}
+
+// RestartVPCAsync records the call and calls RestartVPCAsyncFunc, or RestartVPCAsyncWithContextFunc if only that is set
+func (m *MockVPCService) RestartVPCAsync(p *cloudstack.RestartVPCParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RestartVPCAsync", p)
+	if m.RestartVPCAsyncFunc != nil {
+		return m.RestartVPCAsyncFunc(p)
+	}
+	if m.RestartVPCAsyncWithContextFunc != nil {
+		return m.RestartVPCAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.RestartVPCAsync")
+	return
+}
+
+// RestartVPCAsyncWithContext records the call and calls RestartVPCAsyncWithContextFunc, or RestartVPCAsyncFunc if only that is set
+func (m *MockVPCService) RestartVPCAsyncWithContext(ctx context.Context, p *cloudstack.RestartVPCParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RestartVPCAsyncWithContext", ctx, p)
+	if m.RestartVPCAsyncWithContextFunc != nil {
+		return m.RestartVPCAsyncWithContextFunc(ctx, p)
+	}
+	if m.RestartVPCAsyncFunc != nil {
+		return m.RestartVPCAsyncFunc(p)
+	}
+	r1 = notMocked("VPCService.RestartVPCAsyncWithContext")
+	return
+}
+
+// NewUpdateVPCParams records the call and calls NewUpdateVPCParamsFunc
+func (m *MockVPCService) NewUpdateVPCParams(id string) (r0 *cloudstack.UpdateVPCParams) {
+	m.record("NewUpdateVPCParams", id)
+	if m.NewUpdateVPCParamsFunc != nil {
+		return m.NewUpdateVPCParamsFunc(id)
+	}
+	return cloudstack.NewVPCService(nil).NewUpdateVPCParams(id)
+}
+
+// UpdateVPC records the call and calls UpdateVPCFunc, or UpdateVPCWithContextFunc if only that is set
+func (m *MockVPCService) UpdateVPC(p *cloudstack.UpdateVPCParams) (r0 *cloudstack.UpdateVPCResponse, r1 error) {
+	m.record("UpdateVPC", p)
+	if m.UpdateVPCFunc != nil {
+		return m.UpdateVPCFunc(p)
+	}
+	if m.UpdateVPCWithContextFunc != nil {
+		return m.UpdateVPCWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.UpdateVPC")
+	return
+}
+
+// UpdateVPCWithContext records the call and calls UpdateVPCWithContextFunc, or UpdateVPCFunc if only that is set
+func (m *MockVPCService) UpdateVPCWithContext(ctx context.Context, p *cloudstack.UpdateVPCParams) (r0 *cloudstack.UpdateVPCResponse, r1 error) {
+	m.record("UpdateVPCWithContext", ctx, p)
+	if m.UpdateVPCWithContextFunc != nil {
+		return m.UpdateVPCWithContextFunc(ctx, p)
+	}
+	if m.UpdateVPCFunc != nil {
+		return m.UpdateVPCFunc(p)
+	}
+	r1 = notMocked("VPCService.UpdateVPCWithContext")
+	return
+}
+
+// UpdateVPCAsync records the call and calls UpdateVPCAsyncFunc, or UpdateVPCAsyncWithContextFunc if only that is set
+func (m *MockVPCService) UpdateVPCAsync(p *cloudstack.UpdateVPCParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVPCAsync", p)
+	if m.UpdateVPCAsyncFunc != nil {
+		return m.UpdateVPCAsyncFunc(p)
+	}
+	if m.UpdateVPCAsyncWithContextFunc != nil {
+		return m.UpdateVPCAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.UpdateVPCAsync")
+	return
+}
+
+// UpdateVPCAsyncWithContext records the call and calls UpdateVPCAsyncWithContextFunc, or UpdateVPCAsyncFunc if only that is set
+func (m *MockVPCService) UpdateVPCAsyncWithContext(ctx context.Context, p *cloudstack.UpdateVPCParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVPCAsyncWithContext", ctx, p)
+	if m.UpdateVPCAsyncWithContextFunc != nil {
+		return m.UpdateVPCAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateVPCAsyncFunc != nil {
+		return m.UpdateVPCAsyncFunc(p)
+	}
+	r1 = notMocked("VPCService.UpdateVPCAsyncWithContext")
+	return
+}
+
+// NewUpdateVPCOfferingParams records the call and calls NewUpdateVPCOfferingParamsFunc
+func (m *MockVPCService) NewUpdateVPCOfferingParams(id string) (r0 *cloudstack.UpdateVPCOfferingParams) {
+	m.record("NewUpdateVPCOfferingParams", id)
+	if m.NewUpdateVPCOfferingParamsFunc != nil {
+		return m.NewUpdateVPCOfferingParamsFunc(id)
+	}
+	return cloudstack.NewVPCService(nil).NewUpdateVPCOfferingParams(id)
+}
+
+// UpdateVPCOffering records the call and calls UpdateVPCOfferingFunc, or UpdateVPCOfferingWithContextFunc if only that is set
+func (m *MockVPCService) UpdateVPCOffering(p *cloudstack.UpdateVPCOfferingParams) (r0 *cloudstack.UpdateVPCOfferingResponse, r1 error) {
+	m.record("UpdateVPCOffering", p)
+	if m.UpdateVPCOfferingFunc != nil {
+		return m.UpdateVPCOfferingFunc(p)
+	}
+	if m.UpdateVPCOfferingWithContextFunc != nil {
+		return m.UpdateVPCOfferingWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.UpdateVPCOffering")
+	return
+}
+
+// UpdateVPCOfferingWithContext records the call and calls UpdateVPCOfferingWithContextFunc, or UpdateVPCOfferingFunc if only that is set
+func (m *MockVPCService) UpdateVPCOfferingWithContext(ctx context.Context, p *cloudstack.UpdateVPCOfferingParams) (r0 *cloudstack.UpdateVPCOfferingResponse, r1 error) {
+	m.record("UpdateVPCOfferingWithContext", ctx, p)
+	if m.UpdateVPCOfferingWithContextFunc != nil {
+		return m.UpdateVPCOfferingWithContextFunc(ctx, p)
+	}
+	if m.UpdateVPCOfferingFunc != nil {
+		return m.UpdateVPCOfferingFunc(p)
+	}
+	r1 = notMocked("VPCService.UpdateVPCOfferingWithContext")
+	return
+}
+
+// UpdateVPCOfferingAsync records the call and calls UpdateVPCOfferingAsyncFunc, or UpdateVPCOfferingAsyncWithContextFunc if only that is set
+func (m *MockVPCService) UpdateVPCOfferingAsync(p *cloudstack.UpdateVPCOfferingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVPCOfferingAsync", p)
+	if m.UpdateVPCOfferingAsyncFunc != nil {
+		return m.UpdateVPCOfferingAsyncFunc(p)
+	}
+	if m.UpdateVPCOfferingAsyncWithContextFunc != nil {
+		return m.UpdateVPCOfferingAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPCService.UpdateVPCOfferingAsync")
+	return
+}
+
+// UpdateVPCOfferingAsyncWithContext records the call and calls UpdateVPCOfferingAsyncWithContextFunc, or UpdateVPCOfferingAsyncFunc if only that is set
+func (m *MockVPCService) UpdateVPCOfferingAsyncWithContext(ctx context.Context, p *cloudstack.UpdateVPCOfferingParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVPCOfferingAsyncWithContext", ctx, p)
+	if m.UpdateVPCOfferingAsyncWithContextFunc != nil {
+		return m.UpdateVPCOfferingAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateVPCOfferingAsyncFunc != nil {
+		return m.UpdateVPCOfferingAsyncFunc(p)
+	}
+	r1 = notMocked("VPCService.UpdateVPCOfferingAsyncWithContext")
+	return
+}
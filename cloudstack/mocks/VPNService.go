@@ -0,0 +1,1605 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockVPNService is a mock of cloudstack.VPNServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockVPNService struct {
+	Recorder
+
+	NewAddVpnUserParamsFunc                      func(password string, username string) *cloudstack.AddVpnUserParams
+	AddVpnUserFunc                               func(p *cloudstack.AddVpnUserParams) (*cloudstack.AddVpnUserResponse, error)
+	AddVpnUserWithContextFunc                    func(ctx context.Context, p *cloudstack.AddVpnUserParams) (*cloudstack.AddVpnUserResponse, error)
+	AddVpnUserAsyncFunc                          func(p *cloudstack.AddVpnUserParams) (*cloudstack.Job, error)
+	AddVpnUserAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.AddVpnUserParams) (*cloudstack.Job, error)
+	NewCreateRemoteAccessVpnParamsFunc           func(publicipid string) *cloudstack.CreateRemoteAccessVpnParams
+	CreateRemoteAccessVpnFunc                    func(p *cloudstack.CreateRemoteAccessVpnParams) (*cloudstack.CreateRemoteAccessVpnResponse, error)
+	CreateRemoteAccessVpnWithContextFunc         func(ctx context.Context, p *cloudstack.CreateRemoteAccessVpnParams) (*cloudstack.CreateRemoteAccessVpnResponse, error)
+	CreateRemoteAccessVpnAsyncFunc               func(p *cloudstack.CreateRemoteAccessVpnParams) (*cloudstack.Job, error)
+	CreateRemoteAccessVpnAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.CreateRemoteAccessVpnParams) (*cloudstack.Job, error)
+	NewCreateVpnConnectionParamsFunc             func(s2scustomergatewayid string, s2svpngatewayid string) *cloudstack.CreateVpnConnectionParams
+	CreateVpnConnectionFunc                      func(p *cloudstack.CreateVpnConnectionParams) (*cloudstack.CreateVpnConnectionResponse, error)
+	CreateVpnConnectionWithContextFunc           func(ctx context.Context, p *cloudstack.CreateVpnConnectionParams) (*cloudstack.CreateVpnConnectionResponse, error)
+	CreateVpnConnectionAsyncFunc                 func(p *cloudstack.CreateVpnConnectionParams) (*cloudstack.Job, error)
+	CreateVpnConnectionAsyncWithContextFunc      func(ctx context.Context, p *cloudstack.CreateVpnConnectionParams) (*cloudstack.Job, error)
+	NewCreateVpnCustomerGatewayParamsFunc        func(cidrlist string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string) *cloudstack.CreateVpnCustomerGatewayParams
+	CreateVpnCustomerGatewayFunc                 func(p *cloudstack.CreateVpnCustomerGatewayParams) (*cloudstack.CreateVpnCustomerGatewayResponse, error)
+	CreateVpnCustomerGatewayWithContextFunc      func(ctx context.Context, p *cloudstack.CreateVpnCustomerGatewayParams) (*cloudstack.CreateVpnCustomerGatewayResponse, error)
+	CreateVpnCustomerGatewayAsyncFunc            func(p *cloudstack.CreateVpnCustomerGatewayParams) (*cloudstack.Job, error)
+	CreateVpnCustomerGatewayAsyncWithContextFunc func(ctx context.Context, p *cloudstack.CreateVpnCustomerGatewayParams) (*cloudstack.Job, error)
+	NewCreateVpnGatewayParamsFunc                func(vpcid string) *cloudstack.CreateVpnGatewayParams
+	CreateVpnGatewayFunc                         func(p *cloudstack.CreateVpnGatewayParams) (*cloudstack.CreateVpnGatewayResponse, error)
+	CreateVpnGatewayWithContextFunc              func(ctx context.Context, p *cloudstack.CreateVpnGatewayParams) (*cloudstack.CreateVpnGatewayResponse, error)
+	CreateVpnGatewayAsyncFunc                    func(p *cloudstack.CreateVpnGatewayParams) (*cloudstack.Job, error)
+	CreateVpnGatewayAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.CreateVpnGatewayParams) (*cloudstack.Job, error)
+	NewDeleteRemoteAccessVpnParamsFunc           func(publicipid string) *cloudstack.DeleteRemoteAccessVpnParams
+	DeleteRemoteAccessVpnFunc                    func(p *cloudstack.DeleteRemoteAccessVpnParams) (*cloudstack.DeleteRemoteAccessVpnResponse, error)
+	DeleteRemoteAccessVpnWithContextFunc         func(ctx context.Context, p *cloudstack.DeleteRemoteAccessVpnParams) (*cloudstack.DeleteRemoteAccessVpnResponse, error)
+	DeleteRemoteAccessVpnAsyncFunc               func(p *cloudstack.DeleteRemoteAccessVpnParams) (*cloudstack.Job, error)
+	DeleteRemoteAccessVpnAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.DeleteRemoteAccessVpnParams) (*cloudstack.Job, error)
+	NewDeleteVpnConnectionParamsFunc             func(id string) *cloudstack.DeleteVpnConnectionParams
+	DeleteVpnConnectionFunc                      func(p *cloudstack.DeleteVpnConnectionParams) (*cloudstack.DeleteVpnConnectionResponse, error)
+	DeleteVpnConnectionWithContextFunc           func(ctx context.Context, p *cloudstack.DeleteVpnConnectionParams) (*cloudstack.DeleteVpnConnectionResponse, error)
+	DeleteVpnConnectionAsyncFunc                 func(p *cloudstack.DeleteVpnConnectionParams) (*cloudstack.Job, error)
+	DeleteVpnConnectionAsyncWithContextFunc      func(ctx context.Context, p *cloudstack.DeleteVpnConnectionParams) (*cloudstack.Job, error)
+	NewDeleteVpnCustomerGatewayParamsFunc        func(id string) *cloudstack.DeleteVpnCustomerGatewayParams
+	DeleteVpnCustomerGatewayFunc                 func(p *cloudstack.DeleteVpnCustomerGatewayParams) (*cloudstack.DeleteVpnCustomerGatewayResponse, error)
+	DeleteVpnCustomerGatewayWithContextFunc      func(ctx context.Context, p *cloudstack.DeleteVpnCustomerGatewayParams) (*cloudstack.DeleteVpnCustomerGatewayResponse, error)
+	DeleteVpnCustomerGatewayAsyncFunc            func(p *cloudstack.DeleteVpnCustomerGatewayParams) (*cloudstack.Job, error)
+	DeleteVpnCustomerGatewayAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DeleteVpnCustomerGatewayParams) (*cloudstack.Job, error)
+	NewDeleteVpnGatewayParamsFunc                func(id string) *cloudstack.DeleteVpnGatewayParams
+	DeleteVpnGatewayFunc                         func(p *cloudstack.DeleteVpnGatewayParams) (*cloudstack.DeleteVpnGatewayResponse, error)
+	DeleteVpnGatewayWithContextFunc              func(ctx context.Context, p *cloudstack.DeleteVpnGatewayParams) (*cloudstack.DeleteVpnGatewayResponse, error)
+	DeleteVpnGatewayAsyncFunc                    func(p *cloudstack.DeleteVpnGatewayParams) (*cloudstack.Job, error)
+	DeleteVpnGatewayAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.DeleteVpnGatewayParams) (*cloudstack.Job, error)
+	NewListRemoteAccessVpnsParamsFunc            func() *cloudstack.ListRemoteAccessVpnsParams
+	GetRemoteAccessVpnByIDFunc                   func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.RemoteAccessVpn, int, error)
+	GetRemoteAccessVpnByIDWithContextFunc        func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.RemoteAccessVpn, int, error)
+	ListRemoteAccessVpnsFunc                     func(p *cloudstack.ListRemoteAccessVpnsParams) (*cloudstack.ListRemoteAccessVpnsResponse, error)
+	ListRemoteAccessVpnsWithContextFunc          func(ctx context.Context, p *cloudstack.ListRemoteAccessVpnsParams) (*cloudstack.ListRemoteAccessVpnsResponse, error)
+	NewListRemoteAccessVpnsPagerFunc             func(p *cloudstack.ListRemoteAccessVpnsParams) *cloudstack.ListRemoteAccessVpnsPager
+	ListRemoteAccessVpnsAllFunc                  func(p *cloudstack.ListRemoteAccessVpnsParams) (*cloudstack.ListRemoteAccessVpnsResponse, error)
+	ListRemoteAccessVpnsAllWithContextFunc       func(ctx context.Context, p *cloudstack.ListRemoteAccessVpnsParams) (*cloudstack.ListRemoteAccessVpnsResponse, error)
+	NewListVpnConnectionsParamsFunc              func() *cloudstack.ListVpnConnectionsParams
+	GetVpnConnectionByIDFunc                     func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.VpnConnection, int, error)
+	GetVpnConnectionByIDWithContextFunc          func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.VpnConnection, int, error)
+	ListVpnConnectionsFunc                       func(p *cloudstack.ListVpnConnectionsParams) (*cloudstack.ListVpnConnectionsResponse, error)
+	ListVpnConnectionsWithContextFunc            func(ctx context.Context, p *cloudstack.ListVpnConnectionsParams) (*cloudstack.ListVpnConnectionsResponse, error)
+	NewListVpnConnectionsPagerFunc               func(p *cloudstack.ListVpnConnectionsParams) *cloudstack.ListVpnConnectionsPager
+	ListVpnConnectionsAllFunc                    func(p *cloudstack.ListVpnConnectionsParams) (*cloudstack.ListVpnConnectionsResponse, error)
+	ListVpnConnectionsAllWithContextFunc         func(ctx context.Context, p *cloudstack.ListVpnConnectionsParams) (*cloudstack.ListVpnConnectionsResponse, error)
+	NewListVpnCustomerGatewaysParamsFunc         func() *cloudstack.ListVpnCustomerGatewaysParams
+	GetVpnCustomerGatewayIDFunc                  func(keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVpnCustomerGatewayIDWithContextFunc       func(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVpnCustomerGatewayByNameFunc              func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.VpnCustomerGateway, int, error)
+	GetVpnCustomerGatewayByNameWithContextFunc   func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.VpnCustomerGateway, int, error)
+	GetVpnCustomerGatewayByIDFunc                func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.VpnCustomerGateway, int, error)
+	GetVpnCustomerGatewayByIDWithContextFunc     func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.VpnCustomerGateway, int, error)
+	ListVpnCustomerGatewaysFunc                  func(p *cloudstack.ListVpnCustomerGatewaysParams) (*cloudstack.ListVpnCustomerGatewaysResponse, error)
+	ListVpnCustomerGatewaysWithContextFunc       func(ctx context.Context, p *cloudstack.ListVpnCustomerGatewaysParams) (*cloudstack.ListVpnCustomerGatewaysResponse, error)
+	NewListVpnCustomerGatewaysPagerFunc          func(p *cloudstack.ListVpnCustomerGatewaysParams) *cloudstack.ListVpnCustomerGatewaysPager
+	ListVpnCustomerGatewaysAllFunc               func(p *cloudstack.ListVpnCustomerGatewaysParams) (*cloudstack.ListVpnCustomerGatewaysResponse, error)
+	ListVpnCustomerGatewaysAllWithContextFunc    func(ctx context.Context, p *cloudstack.ListVpnCustomerGatewaysParams) (*cloudstack.ListVpnCustomerGatewaysResponse, error)
+	NewListVpnGatewaysParamsFunc                 func() *cloudstack.ListVpnGatewaysParams
+	GetVpnGatewayByIDFunc                        func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.VpnGateway, int, error)
+	GetVpnGatewayByIDWithContextFunc             func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.VpnGateway, int, error)
+	ListVpnGatewaysFunc                          func(p *cloudstack.ListVpnGatewaysParams) (*cloudstack.ListVpnGatewaysResponse, error)
+	ListVpnGatewaysWithContextFunc               func(ctx context.Context, p *cloudstack.ListVpnGatewaysParams) (*cloudstack.ListVpnGatewaysResponse, error)
+	NewListVpnGatewaysPagerFunc                  func(p *cloudstack.ListVpnGatewaysParams) *cloudstack.ListVpnGatewaysPager
+	ListVpnGatewaysAllFunc                       func(p *cloudstack.ListVpnGatewaysParams) (*cloudstack.ListVpnGatewaysResponse, error)
+	ListVpnGatewaysAllWithContextFunc            func(ctx context.Context, p *cloudstack.ListVpnGatewaysParams) (*cloudstack.ListVpnGatewaysResponse, error)
+	NewListVpnUsersParamsFunc                    func() *cloudstack.ListVpnUsersParams
+	GetVpnUserByIDFunc                           func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.VpnUser, int, error)
+	GetVpnUserByIDWithContextFunc                func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.VpnUser, int, error)
+	ListVpnUsersFunc                             func(p *cloudstack.ListVpnUsersParams) (*cloudstack.ListVpnUsersResponse, error)
+	ListVpnUsersWithContextFunc                  func(ctx context.Context, p *cloudstack.ListVpnUsersParams) (*cloudstack.ListVpnUsersResponse, error)
+	NewListVpnUsersPagerFunc                     func(p *cloudstack.ListVpnUsersParams) *cloudstack.ListVpnUsersPager
+	ListVpnUsersAllFunc                          func(p *cloudstack.ListVpnUsersParams) (*cloudstack.ListVpnUsersResponse, error)
+	ListVpnUsersAllWithContextFunc               func(ctx context.Context, p *cloudstack.ListVpnUsersParams) (*cloudstack.ListVpnUsersResponse, error)
+	NewRemoveVpnUserParamsFunc                   func(username string) *cloudstack.RemoveVpnUserParams
+	RemoveVpnUserFunc                            func(p *cloudstack.RemoveVpnUserParams) (*cloudstack.RemoveVpnUserResponse, error)
+	RemoveVpnUserWithContextFunc                 func(ctx context.Context, p *cloudstack.RemoveVpnUserParams) (*cloudstack.RemoveVpnUserResponse, error)
+	RemoveVpnUserAsyncFunc                       func(p *cloudstack.RemoveVpnUserParams) (*cloudstack.Job, error)
+	RemoveVpnUserAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.RemoveVpnUserParams) (*cloudstack.Job, error)
+	NewResetVpnConnectionParamsFunc              func(id string) *cloudstack.ResetVpnConnectionParams
+	ResetVpnConnectionFunc                       func(p *cloudstack.ResetVpnConnectionParams) (*cloudstack.ResetVpnConnectionResponse, error)
+	ResetVpnConnectionWithContextFunc            func(ctx context.Context, p *cloudstack.ResetVpnConnectionParams) (*cloudstack.ResetVpnConnectionResponse, error)
+	ResetVpnConnectionAsyncFunc                  func(p *cloudstack.ResetVpnConnectionParams) (*cloudstack.Job, error)
+	ResetVpnConnectionAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.ResetVpnConnectionParams) (*cloudstack.Job, error)
+	NewUpdateRemoteAccessVpnParamsFunc           func(id string) *cloudstack.UpdateRemoteAccessVpnParams
+	UpdateRemoteAccessVpnFunc                    func(p *cloudstack.UpdateRemoteAccessVpnParams) (*cloudstack.UpdateRemoteAccessVpnResponse, error)
+	UpdateRemoteAccessVpnWithContextFunc         func(ctx context.Context, p *cloudstack.UpdateRemoteAccessVpnParams) (*cloudstack.UpdateRemoteAccessVpnResponse, error)
+	UpdateRemoteAccessVpnAsyncFunc               func(p *cloudstack.UpdateRemoteAccessVpnParams) (*cloudstack.Job, error)
+	UpdateRemoteAccessVpnAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.UpdateRemoteAccessVpnParams) (*cloudstack.Job, error)
+	NewUpdateVpnConnectionParamsFunc             func(id string) *cloudstack.UpdateVpnConnectionParams
+	UpdateVpnConnectionFunc                      func(p *cloudstack.UpdateVpnConnectionParams) (*cloudstack.UpdateVpnConnectionResponse, error)
+	UpdateVpnConnectionWithContextFunc           func(ctx context.Context, p *cloudstack.UpdateVpnConnectionParams) (*cloudstack.UpdateVpnConnectionResponse, error)
+	UpdateVpnConnectionAsyncFunc                 func(p *cloudstack.UpdateVpnConnectionParams) (*cloudstack.Job, error)
+	UpdateVpnConnectionAsyncWithContextFunc      func(ctx context.Context, p *cloudstack.UpdateVpnConnectionParams) (*cloudstack.Job, error)
+	NewUpdateVpnCustomerGatewayParamsFunc        func(cidrlist string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string) *cloudstack.UpdateVpnCustomerGatewayParams
+	UpdateVpnCustomerGatewayFunc                 func(p *cloudstack.UpdateVpnCustomerGatewayParams) (*cloudstack.UpdateVpnCustomerGatewayResponse, error)
+	UpdateVpnCustomerGatewayWithContextFunc      func(ctx context.Context, p *cloudstack.UpdateVpnCustomerGatewayParams) (*cloudstack.UpdateVpnCustomerGatewayResponse, error)
+	UpdateVpnCustomerGatewayAsyncFunc            func(p *cloudstack.UpdateVpnCustomerGatewayParams) (*cloudstack.Job, error)
+	UpdateVpnCustomerGatewayAsyncWithContextFunc func(ctx context.Context, p *cloudstack.UpdateVpnCustomerGatewayParams) (*cloudstack.Job, error)
+	NewUpdateVpnGatewayParamsFunc                func(id string) *cloudstack.UpdateVpnGatewayParams
+	UpdateVpnGatewayFunc                         func(p *cloudstack.UpdateVpnGatewayParams) (*cloudstack.UpdateVpnGatewayResponse, error)
+	UpdateVpnGatewayWithContextFunc              func(ctx context.Context, p *cloudstack.UpdateVpnGatewayParams) (*cloudstack.UpdateVpnGatewayResponse, error)
+	UpdateVpnGatewayAsyncFunc                    func(p *cloudstack.UpdateVpnGatewayParams) (*cloudstack.Job, error)
+	UpdateVpnGatewayAsyncWithContextFunc         func(ctx context.Context, p *cloudstack.UpdateVpnGatewayParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.VPNServiceIface = (*MockVPNService)(nil)
+
+// NewAddVpnUserParams records the call and calls NewAddVpnUserParamsFunc
+func (m *MockVPNService) NewAddVpnUserParams(password string, username string) (r0 *cloudstack.AddVpnUserParams) {
+	m.record("NewAddVpnUserParams", password, username)
+	if m.NewAddVpnUserParamsFunc != nil {
+		return m.NewAddVpnUserParamsFunc(password, username)
+	}
+	return cloudstack.NewVPNService(nil).NewAddVpnUserParams(password, username)
+}
+
+// AddVpnUser records the call and calls AddVpnUserFunc, or AddVpnUserWithContextFunc if only that is set
+func (m *MockVPNService) AddVpnUser(p *cloudstack.AddVpnUserParams) (r0 *cloudstack.AddVpnUserResponse, r1 error) {
+	m.record("AddVpnUser", p)
+	if m.AddVpnUserFunc != nil {
+		return m.AddVpnUserFunc(p)
+	}
+	if m.AddVpnUserWithContextFunc != nil {
+		return m.AddVpnUserWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.AddVpnUser")
+	return
+}
+
+// AddVpnUserWithContext records the call and calls AddVpnUserWithContextFunc, or AddVpnUserFunc if only that is set
+func (m *MockVPNService) AddVpnUserWithContext(ctx context.Context, p *cloudstack.AddVpnUserParams) (r0 *cloudstack.AddVpnUserResponse, r1 error) {
+	m.record("AddVpnUserWithContext", ctx, p)
+	if m.AddVpnUserWithContextFunc != nil {
+		return m.AddVpnUserWithContextFunc(ctx, p)
+	}
+	if m.AddVpnUserFunc != nil {
+		return m.AddVpnUserFunc(p)
+	}
+	r1 = notMocked("VPNService.AddVpnUserWithContext")
+	return
+}
+
+// AddVpnUserAsync records the call and calls AddVpnUserAsyncFunc, or AddVpnUserAsyncWithContextFunc if only that is set
+func (m *MockVPNService) AddVpnUserAsync(p *cloudstack.AddVpnUserParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddVpnUserAsync", p)
+	if m.AddVpnUserAsyncFunc != nil {
+		return m.AddVpnUserAsyncFunc(p)
+	}
+	if m.AddVpnUserAsyncWithContextFunc != nil {
+		return m.AddVpnUserAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.AddVpnUserAsync")
+	return
+}
+
+// AddVpnUserAsyncWithContext records the call and calls AddVpnUserAsyncWithContextFunc, or AddVpnUserAsyncFunc if only that is set
+func (m *MockVPNService) AddVpnUserAsyncWithContext(ctx context.Context, p *cloudstack.AddVpnUserParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddVpnUserAsyncWithContext", ctx, p)
+	if m.AddVpnUserAsyncWithContextFunc != nil {
+		return m.AddVpnUserAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddVpnUserAsyncFunc != nil {
+		return m.AddVpnUserAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.AddVpnUserAsyncWithContext")
+	return
+}
+
+// NewCreateRemoteAccessVpnParams records the call and calls NewCreateRemoteAccessVpnParamsFunc
+func (m *MockVPNService) NewCreateRemoteAccessVpnParams(publicipid string) (r0 *cloudstack.CreateRemoteAccessVpnParams) {
+	m.record("NewCreateRemoteAccessVpnParams", publicipid)
+	if m.NewCreateRemoteAccessVpnParamsFunc != nil {
+		return m.NewCreateRemoteAccessVpnParamsFunc(publicipid)
+	}
+	return cloudstack.NewVPNService(nil).NewCreateRemoteAccessVpnParams(publicipid)
+}
+
+// CreateRemoteAccessVpn records the call and calls CreateRemoteAccessVpnFunc, or CreateRemoteAccessVpnWithContextFunc if only that is set
+func (m *MockVPNService) CreateRemoteAccessVpn(p *cloudstack.CreateRemoteAccessVpnParams) (r0 *cloudstack.CreateRemoteAccessVpnResponse, r1 error) {
+	m.record("CreateRemoteAccessVpn", p)
+	if m.CreateRemoteAccessVpnFunc != nil {
+		return m.CreateRemoteAccessVpnFunc(p)
+	}
+	if m.CreateRemoteAccessVpnWithContextFunc != nil {
+		return m.CreateRemoteAccessVpnWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.CreateRemoteAccessVpn")
+	return
+}
+
+// CreateRemoteAccessVpnWithContext records the call and calls CreateRemoteAccessVpnWithContextFunc, or CreateRemoteAccessVpnFunc if only that is set
+func (m *MockVPNService) CreateRemoteAccessVpnWithContext(ctx context.Context, p *cloudstack.CreateRemoteAccessVpnParams) (r0 *cloudstack.CreateRemoteAccessVpnResponse, r1 error) {
+	m.record("CreateRemoteAccessVpnWithContext", ctx, p)
+	if m.CreateRemoteAccessVpnWithContextFunc != nil {
+		return m.CreateRemoteAccessVpnWithContextFunc(ctx, p)
+	}
+	if m.CreateRemoteAccessVpnFunc != nil {
+		return m.CreateRemoteAccessVpnFunc(p)
+	}
+	r1 = notMocked("VPNService.CreateRemoteAccessVpnWithContext")
+	return
+}
+
+// CreateRemoteAccessVpnAsync records the call and calls CreateRemoteAccessVpnAsyncFunc, or CreateRemoteAccessVpnAsyncWithContextFunc if only that is set
+func (m *MockVPNService) CreateRemoteAccessVpnAsync(p *cloudstack.CreateRemoteAccessVpnParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateRemoteAccessVpnAsync", p)
+	if m.CreateRemoteAccessVpnAsyncFunc != nil {
+		return m.CreateRemoteAccessVpnAsyncFunc(p)
+	}
+	if m.CreateRemoteAccessVpnAsyncWithContextFunc != nil {
+		return m.CreateRemoteAccessVpnAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.CreateRemoteAccessVpnAsync")
+	return
+}
+
+// CreateRemoteAccessVpnAsyncWithContext records the call and calls CreateRemoteAccessVpnAsyncWithContextFunc, or CreateRemoteAccessVpnAsyncFunc if only that is set
+func (m *MockVPNService) CreateRemoteAccessVpnAsyncWithContext(ctx context.Context, p *cloudstack.CreateRemoteAccessVpnParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateRemoteAccessVpnAsyncWithContext", ctx, p)
+	if m.CreateRemoteAccessVpnAsyncWithContextFunc != nil {
+		return m.CreateRemoteAccessVpnAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateRemoteAccessVpnAsyncFunc != nil {
+		return m.CreateRemoteAccessVpnAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.CreateRemoteAccessVpnAsyncWithContext")
+	return
+}
+
+// NewCreateVpnConnectionParams records the call and calls NewCreateVpnConnectionParamsFunc
+func (m *MockVPNService) NewCreateVpnConnectionParams(s2scustomergatewayid string, s2svpngatewayid string) (r0 *cloudstack.CreateVpnConnectionParams) {
+	m.record("NewCreateVpnConnectionParams", s2scustomergatewayid, s2svpngatewayid)
+	if m.NewCreateVpnConnectionParamsFunc != nil {
+		return m.NewCreateVpnConnectionParamsFunc(s2scustomergatewayid, s2svpngatewayid)
+	}
+	return cloudstack.NewVPNService(nil).NewCreateVpnConnectionParams(s2scustomergatewayid, s2svpngatewayid)
+}
+
+// CreateVpnConnection records the call and calls CreateVpnConnectionFunc, or CreateVpnConnectionWithContextFunc if only that is set
+func (m *MockVPNService) CreateVpnConnection(p *cloudstack.CreateVpnConnectionParams) (r0 *cloudstack.CreateVpnConnectionResponse, r1 error) {
+	m.record("CreateVpnConnection", p)
+	if m.CreateVpnConnectionFunc != nil {
+		return m.CreateVpnConnectionFunc(p)
+	}
+	if m.CreateVpnConnectionWithContextFunc != nil {
+		return m.CreateVpnConnectionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.CreateVpnConnection")
+	return
+}
+
+// CreateVpnConnectionWithContext records the call and calls CreateVpnConnectionWithContextFunc, or CreateVpnConnectionFunc if only that is set
+func (m *MockVPNService) CreateVpnConnectionWithContext(ctx context.Context, p *cloudstack.CreateVpnConnectionParams) (r0 *cloudstack.CreateVpnConnectionResponse, r1 error) {
+	m.record("CreateVpnConnectionWithContext", ctx, p)
+	if m.CreateVpnConnectionWithContextFunc != nil {
+		return m.CreateVpnConnectionWithContextFunc(ctx, p)
+	}
+	if m.CreateVpnConnectionFunc != nil {
+		return m.CreateVpnConnectionFunc(p)
+	}
+	r1 = notMocked("VPNService.CreateVpnConnectionWithContext")
+	return
+}
+
+// CreateVpnConnectionAsync records the call and calls CreateVpnConnectionAsyncFunc, or CreateVpnConnectionAsyncWithContextFunc if only that is set
+func (m *MockVPNService) CreateVpnConnectionAsync(p *cloudstack.CreateVpnConnectionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVpnConnectionAsync", p)
+	if m.CreateVpnConnectionAsyncFunc != nil {
+		return m.CreateVpnConnectionAsyncFunc(p)
+	}
+	if m.CreateVpnConnectionAsyncWithContextFunc != nil {
+		return m.CreateVpnConnectionAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.CreateVpnConnectionAsync")
+	return
+}
+
+// CreateVpnConnectionAsyncWithContext records the call and calls CreateVpnConnectionAsyncWithContextFunc, or CreateVpnConnectionAsyncFunc if only that is set
+func (m *MockVPNService) CreateVpnConnectionAsyncWithContext(ctx context.Context, p *cloudstack.CreateVpnConnectionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVpnConnectionAsyncWithContext", ctx, p)
+	if m.CreateVpnConnectionAsyncWithContextFunc != nil {
+		return m.CreateVpnConnectionAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateVpnConnectionAsyncFunc != nil {
+		return m.CreateVpnConnectionAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.CreateVpnConnectionAsyncWithContext")
+	return
+}
+
+// NewCreateVpnCustomerGatewayParams records the call and calls NewCreateVpnCustomerGatewayParamsFunc
+func (m *MockVPNService) NewCreateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string) (r0 *cloudstack.CreateVpnCustomerGatewayParams) {
+	m.record("NewCreateVpnCustomerGatewayParams", cidrlist, esppolicy, gateway, ikepolicy, ipsecpsk)
+	if m.NewCreateVpnCustomerGatewayParamsFunc != nil {
+		return m.NewCreateVpnCustomerGatewayParamsFunc(cidrlist, esppolicy, gateway, ikepolicy, ipsecpsk)
+	}
+	return cloudstack.NewVPNService(nil).NewCreateVpnCustomerGatewayParams(cidrlist, esppolicy, gateway, ikepolicy, ipsecpsk)
+}
+
+// CreateVpnCustomerGateway records the call and calls CreateVpnCustomerGatewayFunc, or CreateVpnCustomerGatewayWithContextFunc if only that is set
+func (m *MockVPNService) CreateVpnCustomerGateway(p *cloudstack.CreateVpnCustomerGatewayParams) (r0 *cloudstack.CreateVpnCustomerGatewayResponse, r1 error) {
+	m.record("CreateVpnCustomerGateway", p)
+	if m.CreateVpnCustomerGatewayFunc != nil {
+		return m.CreateVpnCustomerGatewayFunc(p)
+	}
+	if m.CreateVpnCustomerGatewayWithContextFunc != nil {
+		return m.CreateVpnCustomerGatewayWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.CreateVpnCustomerGateway")
+	return
+}
+
+// CreateVpnCustomerGatewayWithContext records the call and calls CreateVpnCustomerGatewayWithContextFunc, or CreateVpnCustomerGatewayFunc if only that is set
+func (m *MockVPNService) CreateVpnCustomerGatewayWithContext(ctx context.Context, p *cloudstack.CreateVpnCustomerGatewayParams) (r0 *cloudstack.CreateVpnCustomerGatewayResponse, r1 error) {
+	m.record("CreateVpnCustomerGatewayWithContext", ctx, p)
+	if m.CreateVpnCustomerGatewayWithContextFunc != nil {
+		return m.CreateVpnCustomerGatewayWithContextFunc(ctx, p)
+	}
+	if m.CreateVpnCustomerGatewayFunc != nil {
+		return m.CreateVpnCustomerGatewayFunc(p)
+	}
+	r1 = notMocked("VPNService.CreateVpnCustomerGatewayWithContext")
+	return
+}
+
+// CreateVpnCustomerGatewayAsync records the call and calls CreateVpnCustomerGatewayAsyncFunc, or CreateVpnCustomerGatewayAsyncWithContextFunc if only that is set
+func (m *MockVPNService) CreateVpnCustomerGatewayAsync(p *cloudstack.CreateVpnCustomerGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVpnCustomerGatewayAsync", p)
+	if m.CreateVpnCustomerGatewayAsyncFunc != nil {
+		return m.CreateVpnCustomerGatewayAsyncFunc(p)
+	}
+	if m.CreateVpnCustomerGatewayAsyncWithContextFunc != nil {
+		return m.CreateVpnCustomerGatewayAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.CreateVpnCustomerGatewayAsync")
+	return
+}
+
+// CreateVpnCustomerGatewayAsyncWithContext records the call and calls CreateVpnCustomerGatewayAsyncWithContextFunc, or CreateVpnCustomerGatewayAsyncFunc if only that is set
+func (m *MockVPNService) CreateVpnCustomerGatewayAsyncWithContext(ctx context.Context, p *cloudstack.CreateVpnCustomerGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVpnCustomerGatewayAsyncWithContext", ctx, p)
+	if m.CreateVpnCustomerGatewayAsyncWithContextFunc != nil {
+		return m.CreateVpnCustomerGatewayAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateVpnCustomerGatewayAsyncFunc != nil {
+		return m.CreateVpnCustomerGatewayAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.CreateVpnCustomerGatewayAsyncWithContext")
+	return
+}
+
+// NewCreateVpnGatewayParams records the call and calls NewCreateVpnGatewayParamsFunc
+func (m *MockVPNService) NewCreateVpnGatewayParams(vpcid string) (r0 *cloudstack.CreateVpnGatewayParams) {
+	m.record("NewCreateVpnGatewayParams", vpcid)
+	if m.NewCreateVpnGatewayParamsFunc != nil {
+		return m.NewCreateVpnGatewayParamsFunc(vpcid)
+	}
+	return cloudstack.NewVPNService(nil).NewCreateVpnGatewayParams(vpcid)
+}
+
+// CreateVpnGateway records the call and calls CreateVpnGatewayFunc, or CreateVpnGatewayWithContextFunc if only that is set
+func (m *MockVPNService) CreateVpnGateway(p *cloudstack.CreateVpnGatewayParams) (r0 *cloudstack.CreateVpnGatewayResponse, r1 error) {
+	m.record("CreateVpnGateway", p)
+	if m.CreateVpnGatewayFunc != nil {
+		return m.CreateVpnGatewayFunc(p)
+	}
+	if m.CreateVpnGatewayWithContextFunc != nil {
+		return m.CreateVpnGatewayWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.CreateVpnGateway")
+	return
+}
+
+// CreateVpnGatewayWithContext records the call and calls CreateVpnGatewayWithContextFunc, or CreateVpnGatewayFunc if only that is set
+func (m *MockVPNService) CreateVpnGatewayWithContext(ctx context.Context, p *cloudstack.CreateVpnGatewayParams) (r0 *cloudstack.CreateVpnGatewayResponse, r1 error) {
+	m.record("CreateVpnGatewayWithContext", ctx, p)
+	if m.CreateVpnGatewayWithContextFunc != nil {
+		return m.CreateVpnGatewayWithContextFunc(ctx, p)
+	}
+	if m.CreateVpnGatewayFunc != nil {
+		return m.CreateVpnGatewayFunc(p)
+	}
+	r1 = notMocked("VPNService.CreateVpnGatewayWithContext")
+	return
+}
+
+// CreateVpnGatewayAsync records the call and calls CreateVpnGatewayAsyncFunc, or CreateVpnGatewayAsyncWithContextFunc if only that is set
+func (m *MockVPNService) CreateVpnGatewayAsync(p *cloudstack.CreateVpnGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVpnGatewayAsync", p)
+	if m.CreateVpnGatewayAsyncFunc != nil {
+		return m.CreateVpnGatewayAsyncFunc(p)
+	}
+	if m.CreateVpnGatewayAsyncWithContextFunc != nil {
+		return m.CreateVpnGatewayAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.CreateVpnGatewayAsync")
+	return
+}
+
+// CreateVpnGatewayAsyncWithContext records the call and calls CreateVpnGatewayAsyncWithContextFunc, or CreateVpnGatewayAsyncFunc if only that is set
+func (m *MockVPNService) CreateVpnGatewayAsyncWithContext(ctx context.Context, p *cloudstack.CreateVpnGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVpnGatewayAsyncWithContext", ctx, p)
+	if m.CreateVpnGatewayAsyncWithContextFunc != nil {
+		return m.CreateVpnGatewayAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateVpnGatewayAsyncFunc != nil {
+		return m.CreateVpnGatewayAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.CreateVpnGatewayAsyncWithContext")
+	return
+}
+
+// NewDeleteRemoteAccessVpnParams records the call and calls NewDeleteRemoteAccessVpnParamsFunc
+func (m *MockVPNService) NewDeleteRemoteAccessVpnParams(publicipid string) (r0 *cloudstack.DeleteRemoteAccessVpnParams) {
+	m.record("NewDeleteRemoteAccessVpnParams", publicipid)
+	if m.NewDeleteRemoteAccessVpnParamsFunc != nil {
+		return m.NewDeleteRemoteAccessVpnParamsFunc(publicipid)
+	}
+	return cloudstack.NewVPNService(nil).NewDeleteRemoteAccessVpnParams(publicipid)
+}
+
+// DeleteRemoteAccessVpn records the call and calls DeleteRemoteAccessVpnFunc, or DeleteRemoteAccessVpnWithContextFunc if only that is set
+func (m *MockVPNService) DeleteRemoteAccessVpn(p *cloudstack.DeleteRemoteAccessVpnParams) (r0 *cloudstack.DeleteRemoteAccessVpnResponse, r1 error) {
+	m.record("DeleteRemoteAccessVpn", p)
+	if m.DeleteRemoteAccessVpnFunc != nil {
+		return m.DeleteRemoteAccessVpnFunc(p)
+	}
+	if m.DeleteRemoteAccessVpnWithContextFunc != nil {
+		return m.DeleteRemoteAccessVpnWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.DeleteRemoteAccessVpn")
+	return
+}
+
+// DeleteRemoteAccessVpnWithContext records the call and calls DeleteRemoteAccessVpnWithContextFunc, or DeleteRemoteAccessVpnFunc if only that is set
+func (m *MockVPNService) DeleteRemoteAccessVpnWithContext(ctx context.Context, p *cloudstack.DeleteRemoteAccessVpnParams) (r0 *cloudstack.DeleteRemoteAccessVpnResponse, r1 error) {
+	m.record("DeleteRemoteAccessVpnWithContext", ctx, p)
+	if m.DeleteRemoteAccessVpnWithContextFunc != nil {
+		return m.DeleteRemoteAccessVpnWithContextFunc(ctx, p)
+	}
+	if m.DeleteRemoteAccessVpnFunc != nil {
+		return m.DeleteRemoteAccessVpnFunc(p)
+	}
+	r1 = notMocked("VPNService.DeleteRemoteAccessVpnWithContext")
+	return
+}
+
+// DeleteRemoteAccessVpnAsync records the call and calls DeleteRemoteAccessVpnAsyncFunc, or DeleteRemoteAccessVpnAsyncWithContextFunc if only that is set
+func (m *MockVPNService) DeleteRemoteAccessVpnAsync(p *cloudstack.DeleteRemoteAccessVpnParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteRemoteAccessVpnAsync", p)
+	if m.DeleteRemoteAccessVpnAsyncFunc != nil {
+		return m.DeleteRemoteAccessVpnAsyncFunc(p)
+	}
+	if m.DeleteRemoteAccessVpnAsyncWithContextFunc != nil {
+		return m.DeleteRemoteAccessVpnAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.DeleteRemoteAccessVpnAsync")
+	return
+}
+
+// DeleteRemoteAccessVpnAsyncWithContext records the call and calls DeleteRemoteAccessVpnAsyncWithContextFunc, or DeleteRemoteAccessVpnAsyncFunc if only that is set
+func (m *MockVPNService) DeleteRemoteAccessVpnAsyncWithContext(ctx context.Context, p *cloudstack.DeleteRemoteAccessVpnParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteRemoteAccessVpnAsyncWithContext", ctx, p)
+	if m.DeleteRemoteAccessVpnAsyncWithContextFunc != nil {
+		return m.DeleteRemoteAccessVpnAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteRemoteAccessVpnAsyncFunc != nil {
+		return m.DeleteRemoteAccessVpnAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.DeleteRemoteAccessVpnAsyncWithContext")
+	return
+}
+
+// NewDeleteVpnConnectionParams records the call and calls NewDeleteVpnConnectionParamsFunc
+func (m *MockVPNService) NewDeleteVpnConnectionParams(id string) (r0 *cloudstack.DeleteVpnConnectionParams) {
+	m.record("NewDeleteVpnConnectionParams", id)
+	if m.NewDeleteVpnConnectionParamsFunc != nil {
+		return m.NewDeleteVpnConnectionParamsFunc(id)
+	}
+	return cloudstack.NewVPNService(nil).NewDeleteVpnConnectionParams(id)
+}
+
+// DeleteVpnConnection records the call and calls DeleteVpnConnectionFunc, or DeleteVpnConnectionWithContextFunc if only that is set
+func (m *MockVPNService) DeleteVpnConnection(p *cloudstack.DeleteVpnConnectionParams) (r0 *cloudstack.DeleteVpnConnectionResponse, r1 error) {
+	m.record("DeleteVpnConnection", p)
+	if m.DeleteVpnConnectionFunc != nil {
+		return m.DeleteVpnConnectionFunc(p)
+	}
+	if m.DeleteVpnConnectionWithContextFunc != nil {
+		return m.DeleteVpnConnectionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.DeleteVpnConnection")
+	return
+}
+
+// DeleteVpnConnectionWithContext records the call and calls DeleteVpnConnectionWithContextFunc, or DeleteVpnConnectionFunc if only that is set
+func (m *MockVPNService) DeleteVpnConnectionWithContext(ctx context.Context, p *cloudstack.DeleteVpnConnectionParams) (r0 *cloudstack.DeleteVpnConnectionResponse, r1 error) {
+	m.record("DeleteVpnConnectionWithContext", ctx, p)
+	if m.DeleteVpnConnectionWithContextFunc != nil {
+		return m.DeleteVpnConnectionWithContextFunc(ctx, p)
+	}
+	if m.DeleteVpnConnectionFunc != nil {
+		return m.DeleteVpnConnectionFunc(p)
+	}
+	r1 = notMocked("VPNService.DeleteVpnConnectionWithContext")
+	return
+}
+
+// DeleteVpnConnectionAsync records the call and calls DeleteVpnConnectionAsyncFunc, or DeleteVpnConnectionAsyncWithContextFunc if only that is set
+func (m *MockVPNService) DeleteVpnConnectionAsync(p *cloudstack.DeleteVpnConnectionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteVpnConnectionAsync", p)
+	if m.DeleteVpnConnectionAsyncFunc != nil {
+		return m.DeleteVpnConnectionAsyncFunc(p)
+	}
+	if m.DeleteVpnConnectionAsyncWithContextFunc != nil {
+		return m.DeleteVpnConnectionAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.DeleteVpnConnectionAsync")
+	return
+}
+
+// DeleteVpnConnectionAsyncWithContext records the call and calls DeleteVpnConnectionAsyncWithContextFunc, or DeleteVpnConnectionAsyncFunc if only that is set
+func (m *MockVPNService) DeleteVpnConnectionAsyncWithContext(ctx context.Context, p *cloudstack.DeleteVpnConnectionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteVpnConnectionAsyncWithContext", ctx, p)
+	if m.DeleteVpnConnectionAsyncWithContextFunc != nil {
+		return m.DeleteVpnConnectionAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteVpnConnectionAsyncFunc != nil {
+		return m.DeleteVpnConnectionAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.DeleteVpnConnectionAsyncWithContext")
+	return
+}
+
+// NewDeleteVpnCustomerGatewayParams records the call and calls NewDeleteVpnCustomerGatewayParamsFunc
+func (m *MockVPNService) NewDeleteVpnCustomerGatewayParams(id string) (r0 *cloudstack.DeleteVpnCustomerGatewayParams) {
+	m.record("NewDeleteVpnCustomerGatewayParams", id)
+	if m.NewDeleteVpnCustomerGatewayParamsFunc != nil {
+		return m.NewDeleteVpnCustomerGatewayParamsFunc(id)
+	}
+	return cloudstack.NewVPNService(nil).NewDeleteVpnCustomerGatewayParams(id)
+}
+
+// DeleteVpnCustomerGateway records the call and calls DeleteVpnCustomerGatewayFunc, or DeleteVpnCustomerGatewayWithContextFunc if only that is set
+func (m *MockVPNService) DeleteVpnCustomerGateway(p *cloudstack.DeleteVpnCustomerGatewayParams) (r0 *cloudstack.DeleteVpnCustomerGatewayResponse, r1 error) {
+	m.record("DeleteVpnCustomerGateway", p)
+	if m.DeleteVpnCustomerGatewayFunc != nil {
+		return m.DeleteVpnCustomerGatewayFunc(p)
+	}
+	if m.DeleteVpnCustomerGatewayWithContextFunc != nil {
+		return m.DeleteVpnCustomerGatewayWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.DeleteVpnCustomerGateway")
+	return
+}
+
+// DeleteVpnCustomerGatewayWithContext records the call and calls DeleteVpnCustomerGatewayWithContextFunc, or DeleteVpnCustomerGatewayFunc if only that is set
+func (m *MockVPNService) DeleteVpnCustomerGatewayWithContext(ctx context.Context, p *cloudstack.DeleteVpnCustomerGatewayParams) (r0 *cloudstack.DeleteVpnCustomerGatewayResponse, r1 error) {
+	m.record("DeleteVpnCustomerGatewayWithContext", ctx, p)
+	if m.DeleteVpnCustomerGatewayWithContextFunc != nil {
+		return m.DeleteVpnCustomerGatewayWithContextFunc(ctx, p)
+	}
+	if m.DeleteVpnCustomerGatewayFunc != nil {
+		return m.DeleteVpnCustomerGatewayFunc(p)
+	}
+	r1 = notMocked("VPNService.DeleteVpnCustomerGatewayWithContext")
+	return
+}
+
+// DeleteVpnCustomerGatewayAsync records the call and calls DeleteVpnCustomerGatewayAsyncFunc, or DeleteVpnCustomerGatewayAsyncWithContextFunc if only that is set
+func (m *MockVPNService) DeleteVpnCustomerGatewayAsync(p *cloudstack.DeleteVpnCustomerGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteVpnCustomerGatewayAsync", p)
+	if m.DeleteVpnCustomerGatewayAsyncFunc != nil {
+		return m.DeleteVpnCustomerGatewayAsyncFunc(p)
+	}
+	if m.DeleteVpnCustomerGatewayAsyncWithContextFunc != nil {
+		return m.DeleteVpnCustomerGatewayAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.DeleteVpnCustomerGatewayAsync")
+	return
+}
+
+// DeleteVpnCustomerGatewayAsyncWithContext records the call and calls DeleteVpnCustomerGatewayAsyncWithContextFunc, or DeleteVpnCustomerGatewayAsyncFunc if only that is set
+func (m *MockVPNService) DeleteVpnCustomerGatewayAsyncWithContext(ctx context.Context, p *cloudstack.DeleteVpnCustomerGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteVpnCustomerGatewayAsyncWithContext", ctx, p)
+	if m.DeleteVpnCustomerGatewayAsyncWithContextFunc != nil {
+		return m.DeleteVpnCustomerGatewayAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteVpnCustomerGatewayAsyncFunc != nil {
+		return m.DeleteVpnCustomerGatewayAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.DeleteVpnCustomerGatewayAsyncWithContext")
+	return
+}
+
+// NewDeleteVpnGatewayParams records the call and calls NewDeleteVpnGatewayParamsFunc
+func (m *MockVPNService) NewDeleteVpnGatewayParams(id string) (r0 *cloudstack.DeleteVpnGatewayParams) {
+	m.record("NewDeleteVpnGatewayParams", id)
+	if m.NewDeleteVpnGatewayParamsFunc != nil {
+		return m.NewDeleteVpnGatewayParamsFunc(id)
+	}
+	return cloudstack.NewVPNService(nil).NewDeleteVpnGatewayParams(id)
+}
+
+// DeleteVpnGateway records the call and calls DeleteVpnGatewayFunc, or DeleteVpnGatewayWithContextFunc if only that is set
+func (m *MockVPNService) DeleteVpnGateway(p *cloudstack.DeleteVpnGatewayParams) (r0 *cloudstack.DeleteVpnGatewayResponse, r1 error) {
+	m.record("DeleteVpnGateway", p)
+	if m.DeleteVpnGatewayFunc != nil {
+		return m.DeleteVpnGatewayFunc(p)
+	}
+	if m.DeleteVpnGatewayWithContextFunc != nil {
+		return m.DeleteVpnGatewayWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.DeleteVpnGateway")
+	return
+}
+
+// DeleteVpnGatewayWithContext records the call and calls DeleteVpnGatewayWithContextFunc, or DeleteVpnGatewayFunc if only that is set
+func (m *MockVPNService) DeleteVpnGatewayWithContext(ctx context.Context, p *cloudstack.DeleteVpnGatewayParams) (r0 *cloudstack.DeleteVpnGatewayResponse, r1 error) {
+	m.record("DeleteVpnGatewayWithContext", ctx, p)
+	if m.DeleteVpnGatewayWithContextFunc != nil {
+		return m.DeleteVpnGatewayWithContextFunc(ctx, p)
+	}
+	if m.DeleteVpnGatewayFunc != nil {
+		return m.DeleteVpnGatewayFunc(p)
+	}
+	r1 = notMocked("VPNService.DeleteVpnGatewayWithContext")
+	return
+}
+
+// DeleteVpnGatewayAsync records the call and calls DeleteVpnGatewayAsyncFunc, or DeleteVpnGatewayAsyncWithContextFunc if only that is set
+func (m *MockVPNService) DeleteVpnGatewayAsync(p *cloudstack.DeleteVpnGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteVpnGatewayAsync", p)
+	if m.DeleteVpnGatewayAsyncFunc != nil {
+		return m.DeleteVpnGatewayAsyncFunc(p)
+	}
+	if m.DeleteVpnGatewayAsyncWithContextFunc != nil {
+		return m.DeleteVpnGatewayAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.DeleteVpnGatewayAsync")
+	return
+}
+
+// DeleteVpnGatewayAsyncWithContext records the call and calls DeleteVpnGatewayAsyncWithContextFunc, or DeleteVpnGatewayAsyncFunc if only that is set
+func (m *MockVPNService) DeleteVpnGatewayAsyncWithContext(ctx context.Context, p *cloudstack.DeleteVpnGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeleteVpnGatewayAsyncWithContext", ctx, p)
+	if m.DeleteVpnGatewayAsyncWithContextFunc != nil {
+		return m.DeleteVpnGatewayAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeleteVpnGatewayAsyncFunc != nil {
+		return m.DeleteVpnGatewayAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.DeleteVpnGatewayAsyncWithContext")
+	return
+}
+
+// NewListRemoteAccessVpnsParams records the call and calls NewListRemoteAccessVpnsParamsFunc
+func (m *MockVPNService) NewListRemoteAccessVpnsParams() (r0 *cloudstack.ListRemoteAccessVpnsParams) {
+	m.record("NewListRemoteAccessVpnsParams")
+	if m.NewListRemoteAccessVpnsParamsFunc != nil {
+		return m.NewListRemoteAccessVpnsParamsFunc()
+	}
+	return cloudstack.NewVPNService(nil).NewListRemoteAccessVpnsParams()
+}
+
+// GetRemoteAccessVpnByID records the call and calls GetRemoteAccessVpnByIDFunc, or GetRemoteAccessVpnByIDWithContextFunc if only that is set
+func (m *MockVPNService) GetRemoteAccessVpnByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.RemoteAccessVpn, r1 int, r2 error) {
+	m.record("GetRemoteAccessVpnByID", id, opts)
+	if m.GetRemoteAccessVpnByIDFunc != nil {
+		return m.GetRemoteAccessVpnByIDFunc(id, opts...)
+	}
+	if m.GetRemoteAccessVpnByIDWithContextFunc != nil {
+		return m.GetRemoteAccessVpnByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VPNService.GetRemoteAccessVpnByID")
+	return
+}
+
+// GetRemoteAccessVpnByIDWithContext records the call and calls GetRemoteAccessVpnByIDWithContextFunc, or GetRemoteAccessVpnByIDFunc if only that is set
+func (m *MockVPNService) GetRemoteAccessVpnByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.RemoteAccessVpn, r1 int, r2 error) {
+	m.record("GetRemoteAccessVpnByIDWithContext", ctx, id, opts)
+	if m.GetRemoteAccessVpnByIDWithContextFunc != nil {
+		return m.GetRemoteAccessVpnByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetRemoteAccessVpnByIDFunc != nil {
+		return m.GetRemoteAccessVpnByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VPNService.GetRemoteAccessVpnByIDWithContext")
+	return
+}
+
+// ListRemoteAccessVpns records the call and calls ListRemoteAccessVpnsFunc, or ListRemoteAccessVpnsWithContextFunc if only that is set
+func (m *MockVPNService) ListRemoteAccessVpns(p *cloudstack.ListRemoteAccessVpnsParams) (r0 *cloudstack.ListRemoteAccessVpnsResponse, r1 error) {
+	m.record("ListRemoteAccessVpns", p)
+	if m.ListRemoteAccessVpnsFunc != nil {
+		return m.ListRemoteAccessVpnsFunc(p)
+	}
+	if m.ListRemoteAccessVpnsWithContextFunc != nil {
+		return m.ListRemoteAccessVpnsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.ListRemoteAccessVpns")
+	return
+}
+
+// ListRemoteAccessVpnsWithContext records the call and calls ListRemoteAccessVpnsWithContextFunc, or ListRemoteAccessVpnsFunc if only that is set
+func (m *MockVPNService) ListRemoteAccessVpnsWithContext(ctx context.Context, p *cloudstack.ListRemoteAccessVpnsParams) (r0 *cloudstack.ListRemoteAccessVpnsResponse, r1 error) {
+	m.record("ListRemoteAccessVpnsWithContext", ctx, p)
+	if m.ListRemoteAccessVpnsWithContextFunc != nil {
+		return m.ListRemoteAccessVpnsWithContextFunc(ctx, p)
+	}
+	if m.ListRemoteAccessVpnsFunc != nil {
+		return m.ListRemoteAccessVpnsFunc(p)
+	}
+	r1 = notMocked("VPNService.ListRemoteAccessVpnsWithContext")
+	return
+}
+
+// NewListRemoteAccessVpnsPager records the call and calls NewListRemoteAccessVpnsPagerFunc
+func (m *MockVPNService) NewListRemoteAccessVpnsPager(p *cloudstack.ListRemoteAccessVpnsParams) (r0 *cloudstack.ListRemoteAccessVpnsPager) {
+	m.record("NewListRemoteAccessVpnsPager", p)
+	if m.NewListRemoteAccessVpnsPagerFunc != nil {
+		return m.NewListRemoteAccessVpnsPagerFunc(p)
+	}
+	return
+}
+
+// ListRemoteAccessVpnsAll records the call and calls ListRemoteAccessVpnsAllFunc, or ListRemoteAccessVpnsAllWithContextFunc if only that is set
+func (m *MockVPNService) ListRemoteAccessVpnsAll(p *cloudstack.ListRemoteAccessVpnsParams) (r0 *cloudstack.ListRemoteAccessVpnsResponse, r1 error) {
+	m.record("ListRemoteAccessVpnsAll", p)
+	if m.ListRemoteAccessVpnsAllFunc != nil {
+		return m.ListRemoteAccessVpnsAllFunc(p)
+	}
+	if m.ListRemoteAccessVpnsAllWithContextFunc != nil {
+		return m.ListRemoteAccessVpnsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.ListRemoteAccessVpnsAll")
+	return
+}
+
+// ListRemoteAccessVpnsAllWithContext records the call and calls ListRemoteAccessVpnsAllWithContextFunc, or ListRemoteAccessVpnsAllFunc if only that is set
+func (m *MockVPNService) ListRemoteAccessVpnsAllWithContext(ctx context.Context, p *cloudstack.ListRemoteAccessVpnsParams) (r0 *cloudstack.ListRemoteAccessVpnsResponse, r1 error) {
+	m.record("ListRemoteAccessVpnsAllWithContext", ctx, p)
+	if m.ListRemoteAccessVpnsAllWithContextFunc != nil {
+		return m.ListRemoteAccessVpnsAllWithContextFunc(ctx, p)
+	}
+	if m.ListRemoteAccessVpnsAllFunc != nil {
+		return m.ListRemoteAccessVpnsAllFunc(p)
+	}
+	r1 = notMocked("VPNService.ListRemoteAccessVpnsAllWithContext")
+	return
+}
+
+// NewListVpnConnectionsParams records the call and calls NewListVpnConnectionsParamsFunc
+func (m *MockVPNService) NewListVpnConnectionsParams() (r0 *cloudstack.ListVpnConnectionsParams) {
+	m.record("NewListVpnConnectionsParams")
+	if m.NewListVpnConnectionsParamsFunc != nil {
+		return m.NewListVpnConnectionsParamsFunc()
+	}
+	return cloudstack.NewVPNService(nil).NewListVpnConnectionsParams()
+}
+
+// GetVpnConnectionByID records the call and calls GetVpnConnectionByIDFunc, or GetVpnConnectionByIDWithContextFunc if only that is set
+func (m *MockVPNService) GetVpnConnectionByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VpnConnection, r1 int, r2 error) {
+	m.record("GetVpnConnectionByID", id, opts)
+	if m.GetVpnConnectionByIDFunc != nil {
+		return m.GetVpnConnectionByIDFunc(id, opts...)
+	}
+	if m.GetVpnConnectionByIDWithContextFunc != nil {
+		return m.GetVpnConnectionByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VPNService.GetVpnConnectionByID")
+	return
+}
+
+// GetVpnConnectionByIDWithContext records the call and calls GetVpnConnectionByIDWithContextFunc, or GetVpnConnectionByIDFunc if only that is set
+func (m *MockVPNService) GetVpnConnectionByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VpnConnection, r1 int, r2 error) {
+	m.record("GetVpnConnectionByIDWithContext", ctx, id, opts)
+	if m.GetVpnConnectionByIDWithContextFunc != nil {
+		return m.GetVpnConnectionByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetVpnConnectionByIDFunc != nil {
+		return m.GetVpnConnectionByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VPNService.GetVpnConnectionByIDWithContext")
+	return
+}
+
+// ListVpnConnections records the call and calls ListVpnConnectionsFunc, or ListVpnConnectionsWithContextFunc if only that is set
+func (m *MockVPNService) ListVpnConnections(p *cloudstack.ListVpnConnectionsParams) (r0 *cloudstack.ListVpnConnectionsResponse, r1 error) {
+	m.record("ListVpnConnections", p)
+	if m.ListVpnConnectionsFunc != nil {
+		return m.ListVpnConnectionsFunc(p)
+	}
+	if m.ListVpnConnectionsWithContextFunc != nil {
+		return m.ListVpnConnectionsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.ListVpnConnections")
+	return
+}
+
+// ListVpnConnectionsWithContext records the call and calls ListVpnConnectionsWithContextFunc, or ListVpnConnectionsFunc if only that is set
+func (m *MockVPNService) ListVpnConnectionsWithContext(ctx context.Context, p *cloudstack.ListVpnConnectionsParams) (r0 *cloudstack.ListVpnConnectionsResponse, r1 error) {
+	m.record("ListVpnConnectionsWithContext", ctx, p)
+	if m.ListVpnConnectionsWithContextFunc != nil {
+		return m.ListVpnConnectionsWithContextFunc(ctx, p)
+	}
+	if m.ListVpnConnectionsFunc != nil {
+		return m.ListVpnConnectionsFunc(p)
+	}
+	r1 = notMocked("VPNService.ListVpnConnectionsWithContext")
+	return
+}
+
+// NewListVpnConnectionsPager records the call and calls NewListVpnConnectionsPagerFunc
+func (m *MockVPNService) NewListVpnConnectionsPager(p *cloudstack.ListVpnConnectionsParams) (r0 *cloudstack.ListVpnConnectionsPager) {
+	m.record("NewListVpnConnectionsPager", p)
+	if m.NewListVpnConnectionsPagerFunc != nil {
+		return m.NewListVpnConnectionsPagerFunc(p)
+	}
+	return
+}
+
+// ListVpnConnectionsAll records the call and calls ListVpnConnectionsAllFunc, or ListVpnConnectionsAllWithContextFunc if only that is set
+func (m *MockVPNService) ListVpnConnectionsAll(p *cloudstack.ListVpnConnectionsParams) (r0 *cloudstack.ListVpnConnectionsResponse, r1 error) {
+	m.record("ListVpnConnectionsAll", p)
+	if m.ListVpnConnectionsAllFunc != nil {
+		return m.ListVpnConnectionsAllFunc(p)
+	}
+	if m.ListVpnConnectionsAllWithContextFunc != nil {
+		return m.ListVpnConnectionsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.ListVpnConnectionsAll")
+	return
+}
+
+// ListVpnConnectionsAllWithContext records the call and calls ListVpnConnectionsAllWithContextFunc, or ListVpnConnectionsAllFunc if only that is set
+func (m *MockVPNService) ListVpnConnectionsAllWithContext(ctx context.Context, p *cloudstack.ListVpnConnectionsParams) (r0 *cloudstack.ListVpnConnectionsResponse, r1 error) {
+	m.record("ListVpnConnectionsAllWithContext", ctx, p)
+	if m.ListVpnConnectionsAllWithContextFunc != nil {
+		return m.ListVpnConnectionsAllWithContextFunc(ctx, p)
+	}
+	if m.ListVpnConnectionsAllFunc != nil {
+		return m.ListVpnConnectionsAllFunc(p)
+	}
+	r1 = notMocked("VPNService.ListVpnConnectionsAllWithContext")
+	return
+}
+
+// NewListVpnCustomerGatewaysParams records the call and calls NewListVpnCustomerGatewaysParamsFunc
+func (m *MockVPNService) NewListVpnCustomerGatewaysParams() (r0 *cloudstack.ListVpnCustomerGatewaysParams) {
+	m.record("NewListVpnCustomerGatewaysParams")
+	if m.NewListVpnCustomerGatewaysParamsFunc != nil {
+		return m.NewListVpnCustomerGatewaysParamsFunc()
+	}
+	return cloudstack.NewVPNService(nil).NewListVpnCustomerGatewaysParams()
+}
+
+// GetVpnCustomerGatewayID records the call and calls GetVpnCustomerGatewayIDFunc, or GetVpnCustomerGatewayIDWithContextFunc if only that is set
+func (m *MockVPNService) GetVpnCustomerGatewayID(keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVpnCustomerGatewayID", keyword, opts)
+	if m.GetVpnCustomerGatewayIDFunc != nil {
+		return m.GetVpnCustomerGatewayIDFunc(keyword, opts...)
+	}
+	if m.GetVpnCustomerGatewayIDWithContextFunc != nil {
+		return m.GetVpnCustomerGatewayIDWithContextFunc(context.Background(), keyword, opts...)
+	}
+	r2 = notMocked("VPNService.GetVpnCustomerGatewayID")
+	return
+}
+
+// GetVpnCustomerGatewayIDWithContext records the call and calls GetVpnCustomerGatewayIDWithContextFunc, or GetVpnCustomerGatewayIDFunc if only that is set
+func (m *MockVPNService) GetVpnCustomerGatewayIDWithContext(ctx context.Context, keyword string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVpnCustomerGatewayIDWithContext", ctx, keyword, opts)
+	if m.GetVpnCustomerGatewayIDWithContextFunc != nil {
+		return m.GetVpnCustomerGatewayIDWithContextFunc(ctx, keyword, opts...)
+	}
+	if m.GetVpnCustomerGatewayIDFunc != nil {
+		return m.GetVpnCustomerGatewayIDFunc(keyword, opts...)
+	}
+	r2 = notMocked("VPNService.GetVpnCustomerGatewayIDWithContext")
+	return
+}
+
+// GetVpnCustomerGatewayByName records the call and calls GetVpnCustomerGatewayByNameFunc, or GetVpnCustomerGatewayByNameWithContextFunc if only that is set
+func (m *MockVPNService) GetVpnCustomerGatewayByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VpnCustomerGateway, r1 int, r2 error) {
+	m.record("GetVpnCustomerGatewayByName", name, opts)
+	if m.GetVpnCustomerGatewayByNameFunc != nil {
+		return m.GetVpnCustomerGatewayByNameFunc(name, opts...)
+	}
+	if m.GetVpnCustomerGatewayByNameWithContextFunc != nil {
+		return m.GetVpnCustomerGatewayByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VPNService.GetVpnCustomerGatewayByName")
+	return
+}
+
+// GetVpnCustomerGatewayByNameWithContext records the call and calls GetVpnCustomerGatewayByNameWithContextFunc, or GetVpnCustomerGatewayByNameFunc if only that is set
+func (m *MockVPNService) GetVpnCustomerGatewayByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VpnCustomerGateway, r1 int, r2 error) {
+	m.record("GetVpnCustomerGatewayByNameWithContext", ctx, name, opts)
+	if m.GetVpnCustomerGatewayByNameWithContextFunc != nil {
+		return m.GetVpnCustomerGatewayByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetVpnCustomerGatewayByNameFunc != nil {
+		return m.GetVpnCustomerGatewayByNameFunc(name, opts...)
+	}
+	r2 = notMocked("VPNService.GetVpnCustomerGatewayByNameWithContext")
+	return
+}
+
+// GetVpnCustomerGatewayByID records the call and calls GetVpnCustomerGatewayByIDFunc, or GetVpnCustomerGatewayByIDWithContextFunc if only that is set
+func (m *MockVPNService) GetVpnCustomerGatewayByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VpnCustomerGateway, r1 int, r2 error) {
+	m.record("GetVpnCustomerGatewayByID", id, opts)
+	if m.GetVpnCustomerGatewayByIDFunc != nil {
+		return m.GetVpnCustomerGatewayByIDFunc(id, opts...)
+	}
+	if m.GetVpnCustomerGatewayByIDWithContextFunc != nil {
+		return m.GetVpnCustomerGatewayByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VPNService.GetVpnCustomerGatewayByID")
+	return
+}
+
+// GetVpnCustomerGatewayByIDWithContext records the call and calls GetVpnCustomerGatewayByIDWithContextFunc, or GetVpnCustomerGatewayByIDFunc if only that is set
+func (m *MockVPNService) GetVpnCustomerGatewayByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VpnCustomerGateway, r1 int, r2 error) {
+	m.record("GetVpnCustomerGatewayByIDWithContext", ctx, id, opts)
+	if m.GetVpnCustomerGatewayByIDWithContextFunc != nil {
+		return m.GetVpnCustomerGatewayByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetVpnCustomerGatewayByIDFunc != nil {
+		return m.GetVpnCustomerGatewayByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VPNService.GetVpnCustomerGatewayByIDWithContext")
+	return
+}
+
+// ListVpnCustomerGateways records the call and calls ListVpnCustomerGatewaysFunc, or ListVpnCustomerGatewaysWithContextFunc if only that is set
+func (m *MockVPNService) ListVpnCustomerGateways(p *cloudstack.ListVpnCustomerGatewaysParams) (r0 *cloudstack.ListVpnCustomerGatewaysResponse, r1 error) {
+	m.record("ListVpnCustomerGateways", p)
+	if m.ListVpnCustomerGatewaysFunc != nil {
+		return m.ListVpnCustomerGatewaysFunc(p)
+	}
+	if m.ListVpnCustomerGatewaysWithContextFunc != nil {
+		return m.ListVpnCustomerGatewaysWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.ListVpnCustomerGateways")
+	return
+}
+
+// ListVpnCustomerGatewaysWithContext records the call and calls ListVpnCustomerGatewaysWithContextFunc, or ListVpnCustomerGatewaysFunc if only that is set
+func (m *MockVPNService) ListVpnCustomerGatewaysWithContext(ctx context.Context, p *cloudstack.ListVpnCustomerGatewaysParams) (r0 *cloudstack.ListVpnCustomerGatewaysResponse, r1 error) {
+	m.record("ListVpnCustomerGatewaysWithContext", ctx, p)
+	if m.ListVpnCustomerGatewaysWithContextFunc != nil {
+		return m.ListVpnCustomerGatewaysWithContextFunc(ctx, p)
+	}
+	if m.ListVpnCustomerGatewaysFunc != nil {
+		return m.ListVpnCustomerGatewaysFunc(p)
+	}
+	r1 = notMocked("VPNService.ListVpnCustomerGatewaysWithContext")
+	return
+}
+
+// NewListVpnCustomerGatewaysPager records the call and calls NewListVpnCustomerGatewaysPagerFunc
+func (m *MockVPNService) NewListVpnCustomerGatewaysPager(p *cloudstack.ListVpnCustomerGatewaysParams) (r0 *cloudstack.ListVpnCustomerGatewaysPager) {
+	m.record("NewListVpnCustomerGatewaysPager", p)
+	if m.NewListVpnCustomerGatewaysPagerFunc != nil {
+		return m.NewListVpnCustomerGatewaysPagerFunc(p)
+	}
+	return
+}
+
+// ListVpnCustomerGatewaysAll records the call and calls ListVpnCustomerGatewaysAllFunc, or ListVpnCustomerGatewaysAllWithContextFunc if only that is set
+func (m *MockVPNService) ListVpnCustomerGatewaysAll(p *cloudstack.ListVpnCustomerGatewaysParams) (r0 *cloudstack.ListVpnCustomerGatewaysResponse, r1 error) {
+	m.record("ListVpnCustomerGatewaysAll", p)
+	if m.ListVpnCustomerGatewaysAllFunc != nil {
+		return m.ListVpnCustomerGatewaysAllFunc(p)
+	}
+	if m.ListVpnCustomerGatewaysAllWithContextFunc != nil {
+		return m.ListVpnCustomerGatewaysAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.ListVpnCustomerGatewaysAll")
+	return
+}
+
+// ListVpnCustomerGatewaysAllWithContext records the call and calls ListVpnCustomerGatewaysAllWithContextFunc, or ListVpnCustomerGatewaysAllFunc if only that is set
+func (m *MockVPNService) ListVpnCustomerGatewaysAllWithContext(ctx context.Context, p *cloudstack.ListVpnCustomerGatewaysParams) (r0 *cloudstack.ListVpnCustomerGatewaysResponse, r1 error) {
+	m.record("ListVpnCustomerGatewaysAllWithContext", ctx, p)
+	if m.ListVpnCustomerGatewaysAllWithContextFunc != nil {
+		return m.ListVpnCustomerGatewaysAllWithContextFunc(ctx, p)
+	}
+	if m.ListVpnCustomerGatewaysAllFunc != nil {
+		return m.ListVpnCustomerGatewaysAllFunc(p)
+	}
+	r1 = notMocked("VPNService.ListVpnCustomerGatewaysAllWithContext")
+	return
+}
+
+// NewListVpnGatewaysParams records the call and calls NewListVpnGatewaysParamsFunc
+func (m *MockVPNService) NewListVpnGatewaysParams() (r0 *cloudstack.ListVpnGatewaysParams) {
+	m.record("NewListVpnGatewaysParams")
+	if m.NewListVpnGatewaysParamsFunc != nil {
+		return m.NewListVpnGatewaysParamsFunc()
+	}
+	return cloudstack.NewVPNService(nil).NewListVpnGatewaysParams()
+}
+
+// GetVpnGatewayByID records the call and calls GetVpnGatewayByIDFunc, or GetVpnGatewayByIDWithContextFunc if only that is set
+func (m *MockVPNService) GetVpnGatewayByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VpnGateway, r1 int, r2 error) {
+	m.record("GetVpnGatewayByID", id, opts)
+	if m.GetVpnGatewayByIDFunc != nil {
+		return m.GetVpnGatewayByIDFunc(id, opts...)
+	}
+	if m.GetVpnGatewayByIDWithContextFunc != nil {
+		return m.GetVpnGatewayByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VPNService.GetVpnGatewayByID")
+	return
+}
+
+// GetVpnGatewayByIDWithContext records the call and calls GetVpnGatewayByIDWithContextFunc, or GetVpnGatewayByIDFunc if only that is set
+func (m *MockVPNService) GetVpnGatewayByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VpnGateway, r1 int, r2 error) {
+	m.record("GetVpnGatewayByIDWithContext", ctx, id, opts)
+	if m.GetVpnGatewayByIDWithContextFunc != nil {
+		return m.GetVpnGatewayByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetVpnGatewayByIDFunc != nil {
+		return m.GetVpnGatewayByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VPNService.GetVpnGatewayByIDWithContext")
+	return
+}
+
+// ListVpnGateways records the call and calls ListVpnGatewaysFunc, or ListVpnGatewaysWithContextFunc if only that is set
+func (m *MockVPNService) ListVpnGateways(p *cloudstack.ListVpnGatewaysParams) (r0 *cloudstack.ListVpnGatewaysResponse, r1 error) {
+	m.record("ListVpnGateways", p)
+	if m.ListVpnGatewaysFunc != nil {
+		return m.ListVpnGatewaysFunc(p)
+	}
+	if m.ListVpnGatewaysWithContextFunc != nil {
+		return m.ListVpnGatewaysWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.ListVpnGateways")
+	return
+}
+
+// ListVpnGatewaysWithContext records the call and calls ListVpnGatewaysWithContextFunc, or ListVpnGatewaysFunc if only that is set
+func (m *MockVPNService) ListVpnGatewaysWithContext(ctx context.Context, p *cloudstack.ListVpnGatewaysParams) (r0 *cloudstack.ListVpnGatewaysResponse, r1 error) {
+	m.record("ListVpnGatewaysWithContext", ctx, p)
+	if m.ListVpnGatewaysWithContextFunc != nil {
+		return m.ListVpnGatewaysWithContextFunc(ctx, p)
+	}
+	if m.ListVpnGatewaysFunc != nil {
+		return m.ListVpnGatewaysFunc(p)
+	}
+	r1 = notMocked("VPNService.ListVpnGatewaysWithContext")
+	return
+}
+
+// NewListVpnGatewaysPager records the call and calls NewListVpnGatewaysPagerFunc
+func (m *MockVPNService) NewListVpnGatewaysPager(p *cloudstack.ListVpnGatewaysParams) (r0 *cloudstack.ListVpnGatewaysPager) {
+	m.record("NewListVpnGatewaysPager", p)
+	if m.NewListVpnGatewaysPagerFunc != nil {
+		return m.NewListVpnGatewaysPagerFunc(p)
+	}
+	return
+}
+
+// ListVpnGatewaysAll records the call and calls ListVpnGatewaysAllFunc, or ListVpnGatewaysAllWithContextFunc if only that is set
+func (m *MockVPNService) ListVpnGatewaysAll(p *cloudstack.ListVpnGatewaysParams) (r0 *cloudstack.ListVpnGatewaysResponse, r1 error) {
+	m.record("ListVpnGatewaysAll", p)
+	if m.ListVpnGatewaysAllFunc != nil {
+		return m.ListVpnGatewaysAllFunc(p)
+	}
+	if m.ListVpnGatewaysAllWithContextFunc != nil {
+		return m.ListVpnGatewaysAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.ListVpnGatewaysAll")
+	return
+}
+
+// ListVpnGatewaysAllWithContext records the call and calls ListVpnGatewaysAllWithContextFunc, or ListVpnGatewaysAllFunc if only that is set
+func (m *MockVPNService) ListVpnGatewaysAllWithContext(ctx context.Context, p *cloudstack.ListVpnGatewaysParams) (r0 *cloudstack.ListVpnGatewaysResponse, r1 error) {
+	m.record("ListVpnGatewaysAllWithContext", ctx, p)
+	if m.ListVpnGatewaysAllWithContextFunc != nil {
+		return m.ListVpnGatewaysAllWithContextFunc(ctx, p)
+	}
+	if m.ListVpnGatewaysAllFunc != nil {
+		return m.ListVpnGatewaysAllFunc(p)
+	}
+	r1 = notMocked("VPNService.ListVpnGatewaysAllWithContext")
+	return
+}
+
+// NewListVpnUsersParams records the call and calls NewListVpnUsersParamsFunc
+func (m *MockVPNService) NewListVpnUsersParams() (r0 *cloudstack.ListVpnUsersParams) {
+	m.record("NewListVpnUsersParams")
+	if m.NewListVpnUsersParamsFunc != nil {
+		return m.NewListVpnUsersParamsFunc()
+	}
+	return cloudstack.NewVPNService(nil).NewListVpnUsersParams()
+}
+
+// GetVpnUserByID records the call and calls GetVpnUserByIDFunc, or GetVpnUserByIDWithContextFunc if only that is set
+func (m *MockVPNService) GetVpnUserByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VpnUser, r1 int, r2 error) {
+	m.record("GetVpnUserByID", id, opts)
+	if m.GetVpnUserByIDFunc != nil {
+		return m.GetVpnUserByIDFunc(id, opts...)
+	}
+	if m.GetVpnUserByIDWithContextFunc != nil {
+		return m.GetVpnUserByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VPNService.GetVpnUserByID")
+	return
+}
+
+// GetVpnUserByIDWithContext records the call and calls GetVpnUserByIDWithContextFunc, or GetVpnUserByIDFunc if only that is set
+func (m *MockVPNService) GetVpnUserByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VpnUser, r1 int, r2 error) {
+	m.record("GetVpnUserByIDWithContext", ctx, id, opts)
+	if m.GetVpnUserByIDWithContextFunc != nil {
+		return m.GetVpnUserByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetVpnUserByIDFunc != nil {
+		return m.GetVpnUserByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VPNService.GetVpnUserByIDWithContext")
+	return
+}
+
+// ListVpnUsers records the call and calls ListVpnUsersFunc, or ListVpnUsersWithContextFunc if only that is set
+func (m *MockVPNService) ListVpnUsers(p *cloudstack.ListVpnUsersParams) (r0 *cloudstack.ListVpnUsersResponse, r1 error) {
+	m.record("ListVpnUsers", p)
+	if m.ListVpnUsersFunc != nil {
+		return m.ListVpnUsersFunc(p)
+	}
+	if m.ListVpnUsersWithContextFunc != nil {
+		return m.ListVpnUsersWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.ListVpnUsers")
+	return
+}
+
+// ListVpnUsersWithContext records the call and calls ListVpnUsersWithContextFunc, or ListVpnUsersFunc if only that is set
+func (m *MockVPNService) ListVpnUsersWithContext(ctx context.Context, p *cloudstack.ListVpnUsersParams) (r0 *cloudstack.ListVpnUsersResponse, r1 error) {
+	m.record("ListVpnUsersWithContext", ctx, p)
+	if m.ListVpnUsersWithContextFunc != nil {
+		return m.ListVpnUsersWithContextFunc(ctx, p)
+	}
+	if m.ListVpnUsersFunc != nil {
+		return m.ListVpnUsersFunc(p)
+	}
+	r1 = notMocked("VPNService.ListVpnUsersWithContext")
+	return
+}
+
+// NewListVpnUsersPager records the call and calls NewListVpnUsersPagerFunc
+func (m *MockVPNService) NewListVpnUsersPager(p *cloudstack.ListVpnUsersParams) (r0 *cloudstack.ListVpnUsersPager) {
+	m.record("NewListVpnUsersPager", p)
+	if m.NewListVpnUsersPagerFunc != nil {
+		return m.NewListVpnUsersPagerFunc(p)
+	}
+	return
+}
+
+// ListVpnUsersAll records the call and calls ListVpnUsersAllFunc, or ListVpnUsersAllWithContextFunc if only that is set
+func (m *MockVPNService) ListVpnUsersAll(p *cloudstack.ListVpnUsersParams) (r0 *cloudstack.ListVpnUsersResponse, r1 error) {
+	m.record("ListVpnUsersAll", p)
+	if m.ListVpnUsersAllFunc != nil {
+		return m.ListVpnUsersAllFunc(p)
+	}
+	if m.ListVpnUsersAllWithContextFunc != nil {
+		return m.ListVpnUsersAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.ListVpnUsersAll")
+	return
+}
+
+// ListVpnUsersAllWithContext records the call and calls ListVpnUsersAllWithContextFunc, or ListVpnUsersAllFunc if only that is set
+func (m *MockVPNService) ListVpnUsersAllWithContext(ctx context.Context, p *cloudstack.ListVpnUsersParams) (r0 *cloudstack.ListVpnUsersResponse, r1 error) {
+	m.record("ListVpnUsersAllWithContext", ctx, p)
+	if m.ListVpnUsersAllWithContextFunc != nil {
+		return m.ListVpnUsersAllWithContextFunc(ctx, p)
+	}
+	if m.ListVpnUsersAllFunc != nil {
+		return m.ListVpnUsersAllFunc(p)
+	}
+	r1 = notMocked("VPNService.ListVpnUsersAllWithContext")
+	return
+}
+
+// NewRemoveVpnUserParams records the call and calls NewRemoveVpnUserParamsFunc
+func (m *MockVPNService) NewRemoveVpnUserParams(username string) (r0 *cloudstack.RemoveVpnUserParams) {
+	m.record("NewRemoveVpnUserParams", username)
+	if m.NewRemoveVpnUserParamsFunc != nil {
+		return m.NewRemoveVpnUserParamsFunc(username)
+	}
+	return cloudstack.NewVPNService(nil).NewRemoveVpnUserParams(username)
+}
+
+// RemoveVpnUser records the call and calls RemoveVpnUserFunc, or RemoveVpnUserWithContextFunc if only that is set
+func (m *MockVPNService) RemoveVpnUser(p *cloudstack.RemoveVpnUserParams) (r0 *cloudstack.RemoveVpnUserResponse, r1 error) {
+	m.record("RemoveVpnUser", p)
+	if m.RemoveVpnUserFunc != nil {
+		return m.RemoveVpnUserFunc(p)
+	}
+	if m.RemoveVpnUserWithContextFunc != nil {
+		return m.RemoveVpnUserWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.RemoveVpnUser")
+	return
+}
+
+// RemoveVpnUserWithContext records the call and calls RemoveVpnUserWithContextFunc, or RemoveVpnUserFunc if only that is set
+func (m *MockVPNService) RemoveVpnUserWithContext(ctx context.Context, p *cloudstack.RemoveVpnUserParams) (r0 *cloudstack.RemoveVpnUserResponse, r1 error) {
+	m.record("RemoveVpnUserWithContext", ctx, p)
+	if m.RemoveVpnUserWithContextFunc != nil {
+		return m.RemoveVpnUserWithContextFunc(ctx, p)
+	}
+	if m.RemoveVpnUserFunc != nil {
+		return m.RemoveVpnUserFunc(p)
+	}
+	r1 = notMocked("VPNService.RemoveVpnUserWithContext")
+	return
+}
+
+// RemoveVpnUserAsync records the call and calls RemoveVpnUserAsyncFunc, or RemoveVpnUserAsyncWithContextFunc if only that is set
+func (m *MockVPNService) RemoveVpnUserAsync(p *cloudstack.RemoveVpnUserParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveVpnUserAsync", p)
+	if m.RemoveVpnUserAsyncFunc != nil {
+		return m.RemoveVpnUserAsyncFunc(p)
+	}
+	if m.RemoveVpnUserAsyncWithContextFunc != nil {
+		return m.RemoveVpnUserAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.RemoveVpnUserAsync")
+	return
+}
+
+// RemoveVpnUserAsyncWithContext records the call and calls RemoveVpnUserAsyncWithContextFunc, or RemoveVpnUserAsyncFunc if only that is set
+func (m *MockVPNService) RemoveVpnUserAsyncWithContext(ctx context.Context, p *cloudstack.RemoveVpnUserParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveVpnUserAsyncWithContext", ctx, p)
+	if m.RemoveVpnUserAsyncWithContextFunc != nil {
+		return m.RemoveVpnUserAsyncWithContextFunc(ctx, p)
+	}
+	if m.RemoveVpnUserAsyncFunc != nil {
+		return m.RemoveVpnUserAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.RemoveVpnUserAsyncWithContext")
+	return
+}
+
+// NewResetVpnConnectionParams records the call and calls NewResetVpnConnectionParamsFunc
+func (m *MockVPNService) NewResetVpnConnectionParams(id string) (r0 *cloudstack.ResetVpnConnectionParams) {
+	m.record("NewResetVpnConnectionParams", id)
+	if m.NewResetVpnConnectionParamsFunc != nil {
+		return m.NewResetVpnConnectionParamsFunc(id)
+	}
+	return cloudstack.NewVPNService(nil).NewResetVpnConnectionParams(id)
+}
+
+// ResetVpnConnection records the call and calls ResetVpnConnectionFunc, or ResetVpnConnectionWithContextFunc if only that is set
+func (m *MockVPNService) ResetVpnConnection(p *cloudstack.ResetVpnConnectionParams) (r0 *cloudstack.ResetVpnConnectionResponse, r1 error) {
+	m.record("ResetVpnConnection", p)
+	if m.ResetVpnConnectionFunc != nil {
+		return m.ResetVpnConnectionFunc(p)
+	}
+	if m.ResetVpnConnectionWithContextFunc != nil {
+		return m.ResetVpnConnectionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.ResetVpnConnection")
+	return
+}
+
+// ResetVpnConnectionWithContext records the call and calls ResetVpnConnectionWithContextFunc, or ResetVpnConnectionFunc if only that is set
+func (m *MockVPNService) ResetVpnConnectionWithContext(ctx context.Context, p *cloudstack.ResetVpnConnectionParams) (r0 *cloudstack.ResetVpnConnectionResponse, r1 error) {
+	m.record("ResetVpnConnectionWithContext", ctx, p)
+	if m.ResetVpnConnectionWithContextFunc != nil {
+		return m.ResetVpnConnectionWithContextFunc(ctx, p)
+	}
+	if m.ResetVpnConnectionFunc != nil {
+		return m.ResetVpnConnectionFunc(p)
+	}
+	r1 = notMocked("VPNService.ResetVpnConnectionWithContext")
+	return
+}
+
+// ResetVpnConnectionAsync records the call and calls ResetVpnConnectionAsyncFunc, or ResetVpnConnectionAsyncWithContextFunc if only that is set
+func (m *MockVPNService) ResetVpnConnectionAsync(p *cloudstack.ResetVpnConnectionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ResetVpnConnectionAsync", p)
+	if m.ResetVpnConnectionAsyncFunc != nil {
+		return m.ResetVpnConnectionAsyncFunc(p)
+	}
+	if m.ResetVpnConnectionAsyncWithContextFunc != nil {
+		return m.ResetVpnConnectionAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.ResetVpnConnectionAsync")
+	return
+}
+
+// ResetVpnConnectionAsyncWithContext records the call and calls ResetVpnConnectionAsyncWithContextFunc, or ResetVpnConnectionAsyncFunc if only that is set
+func (m *MockVPNService) ResetVpnConnectionAsyncWithContext(ctx context.Context, p *cloudstack.ResetVpnConnectionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ResetVpnConnectionAsyncWithContext", ctx, p)
+	if m.ResetVpnConnectionAsyncWithContextFunc != nil {
+		return m.ResetVpnConnectionAsyncWithContextFunc(ctx, p)
+	}
+	if m.ResetVpnConnectionAsyncFunc != nil {
+		return m.ResetVpnConnectionAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.ResetVpnConnectionAsyncWithContext")
+	return
+}
+
+// NewUpdateRemoteAccessVpnParams records the call and calls NewUpdateRemoteAccessVpnParamsFunc
+func (m *MockVPNService) NewUpdateRemoteAccessVpnParams(id string) (r0 *cloudstack.UpdateRemoteAccessVpnParams) {
+	m.record("NewUpdateRemoteAccessVpnParams", id)
+	if m.NewUpdateRemoteAccessVpnParamsFunc != nil {
+		return m.NewUpdateRemoteAccessVpnParamsFunc(id)
+	}
+	return cloudstack.NewVPNService(nil).NewUpdateRemoteAccessVpnParams(id)
+}
+
+// UpdateRemoteAccessVpn records the call and calls UpdateRemoteAccessVpnFunc, or UpdateRemoteAccessVpnWithContextFunc if only that is set
+func (m *MockVPNService) UpdateRemoteAccessVpn(p *cloudstack.UpdateRemoteAccessVpnParams) (r0 *cloudstack.UpdateRemoteAccessVpnResponse, r1 error) {
+	m.record("UpdateRemoteAccessVpn", p)
+	if m.UpdateRemoteAccessVpnFunc != nil {
+		return m.UpdateRemoteAccessVpnFunc(p)
+	}
+	if m.UpdateRemoteAccessVpnWithContextFunc != nil {
+		return m.UpdateRemoteAccessVpnWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.UpdateRemoteAccessVpn")
+	return
+}
+
+// UpdateRemoteAccessVpnWithContext records the call and calls UpdateRemoteAccessVpnWithContextFunc, or UpdateRemoteAccessVpnFunc if only that is set
+func (m *MockVPNService) UpdateRemoteAccessVpnWithContext(ctx context.Context, p *cloudstack.UpdateRemoteAccessVpnParams) (r0 *cloudstack.UpdateRemoteAccessVpnResponse, r1 error) {
+	m.record("UpdateRemoteAccessVpnWithContext", ctx, p)
+	if m.UpdateRemoteAccessVpnWithContextFunc != nil {
+		return m.UpdateRemoteAccessVpnWithContextFunc(ctx, p)
+	}
+	if m.UpdateRemoteAccessVpnFunc != nil {
+		return m.UpdateRemoteAccessVpnFunc(p)
+	}
+	r1 = notMocked("VPNService.UpdateRemoteAccessVpnWithContext")
+	return
+}
+
+// UpdateRemoteAccessVpnAsync records the call and calls UpdateRemoteAccessVpnAsyncFunc, or UpdateRemoteAccessVpnAsyncWithContextFunc if only that is set
+func (m *MockVPNService) UpdateRemoteAccessVpnAsync(p *cloudstack.UpdateRemoteAccessVpnParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateRemoteAccessVpnAsync", p)
+	if m.UpdateRemoteAccessVpnAsyncFunc != nil {
+		return m.UpdateRemoteAccessVpnAsyncFunc(p)
+	}
+	if m.UpdateRemoteAccessVpnAsyncWithContextFunc != nil {
+		return m.UpdateRemoteAccessVpnAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.UpdateRemoteAccessVpnAsync")
+	return
+}
+
+// UpdateRemoteAccessVpnAsyncWithContext records the call and calls UpdateRemoteAccessVpnAsyncWithContextFunc, or UpdateRemoteAccessVpnAsyncFunc if only that is set
+func (m *MockVPNService) UpdateRemoteAccessVpnAsyncWithContext(ctx context.Context, p *cloudstack.UpdateRemoteAccessVpnParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateRemoteAccessVpnAsyncWithContext", ctx, p)
+	if m.UpdateRemoteAccessVpnAsyncWithContextFunc != nil {
+		return m.UpdateRemoteAccessVpnAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateRemoteAccessVpnAsyncFunc != nil {
+		return m.UpdateRemoteAccessVpnAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.UpdateRemoteAccessVpnAsyncWithContext")
+	return
+}
+
+// NewUpdateVpnConnectionParams records the call and calls NewUpdateVpnConnectionParamsFunc
+func (m *MockVPNService) NewUpdateVpnConnectionParams(id string) (r0 *cloudstack.UpdateVpnConnectionParams) {
+	m.record("NewUpdateVpnConnectionParams", id)
+	if m.NewUpdateVpnConnectionParamsFunc != nil {
+		return m.NewUpdateVpnConnectionParamsFunc(id)
+	}
+	return cloudstack.NewVPNService(nil).NewUpdateVpnConnectionParams(id)
+}
+
+// UpdateVpnConnection records the call and calls UpdateVpnConnectionFunc, or UpdateVpnConnectionWithContextFunc if only that is set
+func (m *MockVPNService) UpdateVpnConnection(p *cloudstack.UpdateVpnConnectionParams) (r0 *cloudstack.UpdateVpnConnectionResponse, r1 error) {
+	m.record("UpdateVpnConnection", p)
+	if m.UpdateVpnConnectionFunc != nil {
+		return m.UpdateVpnConnectionFunc(p)
+	}
+	if m.UpdateVpnConnectionWithContextFunc != nil {
+		return m.UpdateVpnConnectionWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.UpdateVpnConnection")
+	return
+}
+
+// UpdateVpnConnectionWithContext records the call and calls UpdateVpnConnectionWithContextFunc, or UpdateVpnConnectionFunc if only that is set
+func (m *MockVPNService) UpdateVpnConnectionWithContext(ctx context.Context, p *cloudstack.UpdateVpnConnectionParams) (r0 *cloudstack.UpdateVpnConnectionResponse, r1 error) {
+	m.record("UpdateVpnConnectionWithContext", ctx, p)
+	if m.UpdateVpnConnectionWithContextFunc != nil {
+		return m.UpdateVpnConnectionWithContextFunc(ctx, p)
+	}
+	if m.UpdateVpnConnectionFunc != nil {
+		return m.UpdateVpnConnectionFunc(p)
+	}
+	r1 = notMocked("VPNService.UpdateVpnConnectionWithContext")
+	return
+}
+
+// UpdateVpnConnectionAsync records the call and calls UpdateVpnConnectionAsyncFunc, or UpdateVpnConnectionAsyncWithContextFunc if only that is set
+func (m *MockVPNService) UpdateVpnConnectionAsync(p *cloudstack.UpdateVpnConnectionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVpnConnectionAsync", p)
+	if m.UpdateVpnConnectionAsyncFunc != nil {
+		return m.UpdateVpnConnectionAsyncFunc(p)
+	}
+	if m.UpdateVpnConnectionAsyncWithContextFunc != nil {
+		return m.UpdateVpnConnectionAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.UpdateVpnConnectionAsync")
+	return
+}
+
+// UpdateVpnConnectionAsyncWithContext records the call and calls UpdateVpnConnectionAsyncWithContextFunc, or UpdateVpnConnectionAsyncFunc if only that is set
+func (m *MockVPNService) UpdateVpnConnectionAsyncWithContext(ctx context.Context, p *cloudstack.UpdateVpnConnectionParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVpnConnectionAsyncWithContext", ctx, p)
+	if m.UpdateVpnConnectionAsyncWithContextFunc != nil {
+		return m.UpdateVpnConnectionAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateVpnConnectionAsyncFunc != nil {
+		return m.UpdateVpnConnectionAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.UpdateVpnConnectionAsyncWithContext")
+	return
+}
+
+// NewUpdateVpnCustomerGatewayParams records the call and calls NewUpdateVpnCustomerGatewayParamsFunc
+func (m *MockVPNService) NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string) (r0 *cloudstack.UpdateVpnCustomerGatewayParams) {
+	m.record("NewUpdateVpnCustomerGatewayParams", cidrlist, esppolicy, gateway, id, ikepolicy, ipsecpsk)
+	if m.NewUpdateVpnCustomerGatewayParamsFunc != nil {
+		return m.NewUpdateVpnCustomerGatewayParamsFunc(cidrlist, esppolicy, gateway, id, ikepolicy, ipsecpsk)
+	}
+	return cloudstack.NewVPNService(nil).NewUpdateVpnCustomerGatewayParams(cidrlist, esppolicy, gateway, id, ikepolicy, ipsecpsk)
+}
+
+// UpdateVpnCustomerGateway records the call and calls UpdateVpnCustomerGatewayFunc, or UpdateVpnCustomerGatewayWithContextFunc if only that is set
+func (m *MockVPNService) UpdateVpnCustomerGateway(p *cloudstack.UpdateVpnCustomerGatewayParams) (r0 *cloudstack.UpdateVpnCustomerGatewayResponse, r1 error) {
+	m.record("UpdateVpnCustomerGateway", p)
+	if m.UpdateVpnCustomerGatewayFunc != nil {
+		return m.UpdateVpnCustomerGatewayFunc(p)
+	}
+	if m.UpdateVpnCustomerGatewayWithContextFunc != nil {
+		return m.UpdateVpnCustomerGatewayWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.UpdateVpnCustomerGateway")
+	return
+}
+
+// UpdateVpnCustomerGatewayWithContext records the call and calls UpdateVpnCustomerGatewayWithContextFunc, or UpdateVpnCustomerGatewayFunc if only that is set
+func (m *MockVPNService) UpdateVpnCustomerGatewayWithContext(ctx context.Context, p *cloudstack.UpdateVpnCustomerGatewayParams) (r0 *cloudstack.UpdateVpnCustomerGatewayResponse, r1 error) {
+	m.record("UpdateVpnCustomerGatewayWithContext", ctx, p)
+	if m.UpdateVpnCustomerGatewayWithContextFunc != nil {
+		return m.UpdateVpnCustomerGatewayWithContextFunc(ctx, p)
+	}
+	if m.UpdateVpnCustomerGatewayFunc != nil {
+		return m.UpdateVpnCustomerGatewayFunc(p)
+	}
+	r1 = notMocked("VPNService.UpdateVpnCustomerGatewayWithContext")
+	return
+}
+
+// UpdateVpnCustomerGatewayAsync records the call and calls UpdateVpnCustomerGatewayAsyncFunc, or UpdateVpnCustomerGatewayAsyncWithContextFunc if only that is set
+func (m *MockVPNService) UpdateVpnCustomerGatewayAsync(p *cloudstack.UpdateVpnCustomerGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVpnCustomerGatewayAsync", p)
+	if m.UpdateVpnCustomerGatewayAsyncFunc != nil {
+		return m.UpdateVpnCustomerGatewayAsyncFunc(p)
+	}
+	if m.UpdateVpnCustomerGatewayAsyncWithContextFunc != nil {
+		return m.UpdateVpnCustomerGatewayAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.UpdateVpnCustomerGatewayAsync")
+	return
+}
+
+// UpdateVpnCustomerGatewayAsyncWithContext records the call and calls UpdateVpnCustomerGatewayAsyncWithContextFunc, or UpdateVpnCustomerGatewayAsyncFunc if only that is set
+func (m *MockVPNService) UpdateVpnCustomerGatewayAsyncWithContext(ctx context.Context, p *cloudstack.UpdateVpnCustomerGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVpnCustomerGatewayAsyncWithContext", ctx, p)
+	if m.UpdateVpnCustomerGatewayAsyncWithContextFunc != nil {
+		return m.UpdateVpnCustomerGatewayAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateVpnCustomerGatewayAsyncFunc != nil {
+		return m.UpdateVpnCustomerGatewayAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.UpdateVpnCustomerGatewayAsyncWithContext")
+	return
+}
+
+// NewUpdateVpnGatewayParams records the call and calls NewUpdateVpnGatewayParamsFunc
+func (m *MockVPNService) NewUpdateVpnGatewayParams(id string) (r0 *cloudstack.UpdateVpnGatewayParams) {
+	m.record("NewUpdateVpnGatewayParams", id)
+	if m.NewUpdateVpnGatewayParamsFunc != nil {
+		return m.NewUpdateVpnGatewayParamsFunc(id)
+	}
+	return cloudstack.NewVPNService(nil).NewUpdateVpnGatewayParams(id)
+}
+
+// UpdateVpnGateway records the call and calls UpdateVpnGatewayFunc, or UpdateVpnGatewayWithContextFunc if only that is set
+func (m *MockVPNService) UpdateVpnGateway(p *cloudstack.UpdateVpnGatewayParams) (r0 *cloudstack.UpdateVpnGatewayResponse, r1 error) {
+	m.record("UpdateVpnGateway", p)
+	if m.UpdateVpnGatewayFunc != nil {
+		return m.UpdateVpnGatewayFunc(p)
+	}
+	if m.UpdateVpnGatewayWithContextFunc != nil {
+		return m.UpdateVpnGatewayWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.UpdateVpnGateway")
+	return
+}
+
+// UpdateVpnGatewayWithContext records the call and calls UpdateVpnGatewayWithContextFunc, or UpdateVpnGatewayFunc if only that is set
+func (m *MockVPNService) UpdateVpnGatewayWithContext(ctx context.Context, p *cloudstack.UpdateVpnGatewayParams) (r0 *cloudstack.UpdateVpnGatewayResponse, r1 error) {
+	m.record("UpdateVpnGatewayWithContext", ctx, p)
+	if m.UpdateVpnGatewayWithContextFunc != nil {
+		return m.UpdateVpnGatewayWithContextFunc(ctx, p)
+	}
+	if m.UpdateVpnGatewayFunc != nil {
+		return m.UpdateVpnGatewayFunc(p)
+	}
+	r1 = notMocked("VPNService.UpdateVpnGatewayWithContext")
+	return
+}
+
+// UpdateVpnGatewayAsync records the call and calls UpdateVpnGatewayAsyncFunc, or UpdateVpnGatewayAsyncWithContextFunc if only that is set
+func (m *MockVPNService) UpdateVpnGatewayAsync(p *cloudstack.UpdateVpnGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVpnGatewayAsync", p)
+	if m.UpdateVpnGatewayAsyncFunc != nil {
+		return m.UpdateVpnGatewayAsyncFunc(p)
+	}
+	if m.UpdateVpnGatewayAsyncWithContextFunc != nil {
+		return m.UpdateVpnGatewayAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VPNService.UpdateVpnGatewayAsync")
+	return
+}
+
+// UpdateVpnGatewayAsyncWithContext records the call and calls UpdateVpnGatewayAsyncWithContextFunc, or UpdateVpnGatewayAsyncFunc if only that is set
+func (m *MockVPNService) UpdateVpnGatewayAsyncWithContext(ctx context.Context, p *cloudstack.UpdateVpnGatewayParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVpnGatewayAsyncWithContext", ctx, p)
+	if m.UpdateVpnGatewayAsyncWithContextFunc != nil {
+		return m.UpdateVpnGatewayAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateVpnGatewayAsyncFunc != nil {
+		return m.UpdateVpnGatewayAsyncFunc(p)
+	}
+	r1 = notMocked("VPNService.UpdateVpnGatewayAsyncWithContext")
+	return
+}
@@ -0,0 +1,1747 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockVirtualMachineService is a mock of cloudstack.VirtualMachineServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockVirtualMachineService struct {
+	Recorder
+
+	NewAddNicToVirtualMachineParamsFunc                   func(networkid string, virtualmachineid string) *cloudstack.AddNicToVirtualMachineParams
+	AddNicToVirtualMachineFunc                            func(p *cloudstack.AddNicToVirtualMachineParams) (*cloudstack.AddNicToVirtualMachineResponse, error)
+	AddNicToVirtualMachineWithContextFunc                 func(ctx context.Context, p *cloudstack.AddNicToVirtualMachineParams) (*cloudstack.AddNicToVirtualMachineResponse, error)
+	AddNicToVirtualMachineAsyncFunc                       func(p *cloudstack.AddNicToVirtualMachineParams) (*cloudstack.Job, error)
+	AddNicToVirtualMachineAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.AddNicToVirtualMachineParams) (*cloudstack.Job, error)
+	NewAssignVirtualMachineParamsFunc                     func(virtualmachineid string) *cloudstack.AssignVirtualMachineParams
+	AssignVirtualMachineFunc                              func(p *cloudstack.AssignVirtualMachineParams) (*cloudstack.AssignVirtualMachineResponse, error)
+	AssignVirtualMachineWithContextFunc                   func(ctx context.Context, p *cloudstack.AssignVirtualMachineParams) (*cloudstack.AssignVirtualMachineResponse, error)
+	NewChangeServiceForVirtualMachineParamsFunc           func(id string, serviceofferingid string) *cloudstack.ChangeServiceForVirtualMachineParams
+	ChangeServiceForVirtualMachineFunc                    func(p *cloudstack.ChangeServiceForVirtualMachineParams) (*cloudstack.ChangeServiceForVirtualMachineResponse, error)
+	ChangeServiceForVirtualMachineWithContextFunc         func(ctx context.Context, p *cloudstack.ChangeServiceForVirtualMachineParams) (*cloudstack.ChangeServiceForVirtualMachineResponse, error)
+	NewCleanVMReservationsParamsFunc                      func() *cloudstack.CleanVMReservationsParams
+	CleanVMReservationsFunc                               func(p *cloudstack.CleanVMReservationsParams) (*cloudstack.CleanVMReservationsResponse, error)
+	CleanVMReservationsWithContextFunc                    func(ctx context.Context, p *cloudstack.CleanVMReservationsParams) (*cloudstack.CleanVMReservationsResponse, error)
+	CleanVMReservationsAsyncFunc                          func(p *cloudstack.CleanVMReservationsParams) (*cloudstack.Job, error)
+	CleanVMReservationsAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.CleanVMReservationsParams) (*cloudstack.Job, error)
+	NewDeployVirtualMachineParamsFunc                     func(serviceofferingid string, templateid string, zoneid string) *cloudstack.DeployVirtualMachineParams
+	DeployVirtualMachineFunc                              func(p *cloudstack.DeployVirtualMachineParams) (*cloudstack.DeployVirtualMachineResponse, error)
+	DeployVirtualMachineWithContextFunc                   func(ctx context.Context, p *cloudstack.DeployVirtualMachineParams) (*cloudstack.DeployVirtualMachineResponse, error)
+	DeployVirtualMachineAsyncFunc                         func(p *cloudstack.DeployVirtualMachineParams) (*cloudstack.Job, error)
+	DeployVirtualMachineAsyncWithContextFunc              func(ctx context.Context, p *cloudstack.DeployVirtualMachineParams) (*cloudstack.Job, error)
+	NewDestroyVirtualMachineParamsFunc                    func(id string) *cloudstack.DestroyVirtualMachineParams
+	DestroyVirtualMachineFunc                             func(p *cloudstack.DestroyVirtualMachineParams) (*cloudstack.DestroyVirtualMachineResponse, error)
+	DestroyVirtualMachineWithContextFunc                  func(ctx context.Context, p *cloudstack.DestroyVirtualMachineParams) (*cloudstack.DestroyVirtualMachineResponse, error)
+	DestroyVirtualMachineAsyncFunc                        func(p *cloudstack.DestroyVirtualMachineParams) (*cloudstack.Job, error)
+	DestroyVirtualMachineAsyncWithContextFunc             func(ctx context.Context, p *cloudstack.DestroyVirtualMachineParams) (*cloudstack.Job, error)
+	NewExpungeVirtualMachineParamsFunc                    func(id string) *cloudstack.ExpungeVirtualMachineParams
+	ExpungeVirtualMachineFunc                             func(p *cloudstack.ExpungeVirtualMachineParams) (*cloudstack.ExpungeVirtualMachineResponse, error)
+	ExpungeVirtualMachineWithContextFunc                  func(ctx context.Context, p *cloudstack.ExpungeVirtualMachineParams) (*cloudstack.ExpungeVirtualMachineResponse, error)
+	ExpungeVirtualMachineAsyncFunc                        func(p *cloudstack.ExpungeVirtualMachineParams) (*cloudstack.Job, error)
+	ExpungeVirtualMachineAsyncWithContextFunc             func(ctx context.Context, p *cloudstack.ExpungeVirtualMachineParams) (*cloudstack.Job, error)
+	NewGetVMPasswordParamsFunc                            func(id string) *cloudstack.GetVMPasswordParams
+	GetVMPasswordFunc                                     func(p *cloudstack.GetVMPasswordParams) (*cloudstack.GetVMPasswordResponse, error)
+	GetVMPasswordWithContextFunc                          func(ctx context.Context, p *cloudstack.GetVMPasswordParams) (*cloudstack.GetVMPasswordResponse, error)
+	NewImportUnmanagedInstanceParamsFunc                  func(clusterid string, name string, serviceofferingid string) *cloudstack.ImportUnmanagedInstanceParams
+	ImportUnmanagedInstanceFunc                           func(p *cloudstack.ImportUnmanagedInstanceParams) (*cloudstack.ImportUnmanagedInstanceResponse, error)
+	ImportUnmanagedInstanceWithContextFunc                func(ctx context.Context, p *cloudstack.ImportUnmanagedInstanceParams) (*cloudstack.ImportUnmanagedInstanceResponse, error)
+	ImportUnmanagedInstanceAsyncFunc                      func(p *cloudstack.ImportUnmanagedInstanceParams) (*cloudstack.Job, error)
+	ImportUnmanagedInstanceAsyncWithContextFunc           func(ctx context.Context, p *cloudstack.ImportUnmanagedInstanceParams) (*cloudstack.Job, error)
+	NewListUnmanagedInstancesParamsFunc                   func(clusterid string) *cloudstack.ListUnmanagedInstancesParams
+	ListUnmanagedInstancesFunc                            func(p *cloudstack.ListUnmanagedInstancesParams) (*cloudstack.ListUnmanagedInstancesResponse, error)
+	ListUnmanagedInstancesWithContextFunc                 func(ctx context.Context, p *cloudstack.ListUnmanagedInstancesParams) (*cloudstack.ListUnmanagedInstancesResponse, error)
+	NewListUnmanagedInstancesPagerFunc                    func(p *cloudstack.ListUnmanagedInstancesParams) *cloudstack.ListUnmanagedInstancesPager
+	ListUnmanagedInstancesAllFunc                         func(p *cloudstack.ListUnmanagedInstancesParams) (*cloudstack.ListUnmanagedInstancesResponse, error)
+	ListUnmanagedInstancesAllWithContextFunc              func(ctx context.Context, p *cloudstack.ListUnmanagedInstancesParams) (*cloudstack.ListUnmanagedInstancesResponse, error)
+	NewListVirtualMachinesParamsFunc                      func() *cloudstack.ListVirtualMachinesParams
+	GetVirtualMachineIDFunc                               func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVirtualMachineIDWithContextFunc                    func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVirtualMachineByNameFunc                           func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualMachine, int, error)
+	GetVirtualMachineByNameWithContextFunc                func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualMachine, int, error)
+	GetVirtualMachineByIDFunc                             func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualMachine, int, error)
+	GetVirtualMachineByIDWithContextFunc                  func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualMachine, int, error)
+	ListVirtualMachinesFunc                               func(p *cloudstack.ListVirtualMachinesParams) (*cloudstack.ListVirtualMachinesResponse, error)
+	ListVirtualMachinesWithContextFunc                    func(ctx context.Context, p *cloudstack.ListVirtualMachinesParams) (*cloudstack.ListVirtualMachinesResponse, error)
+	NewListVirtualMachinesPagerFunc                       func(p *cloudstack.ListVirtualMachinesParams) *cloudstack.ListVirtualMachinesPager
+	ListVirtualMachinesAllFunc                            func(p *cloudstack.ListVirtualMachinesParams) (*cloudstack.ListVirtualMachinesResponse, error)
+	ListVirtualMachinesAllWithContextFunc                 func(ctx context.Context, p *cloudstack.ListVirtualMachinesParams) (*cloudstack.ListVirtualMachinesResponse, error)
+	NewListVirtualMachinesMetricsParamsFunc               func() *cloudstack.ListVirtualMachinesMetricsParams
+	GetVirtualMachinesMetricIDFunc                        func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVirtualMachinesMetricIDWithContextFunc             func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVirtualMachinesMetricByNameFunc                    func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualMachinesMetric, int, error)
+	GetVirtualMachinesMetricByNameWithContextFunc         func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualMachinesMetric, int, error)
+	GetVirtualMachinesMetricByIDFunc                      func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualMachinesMetric, int, error)
+	GetVirtualMachinesMetricByIDWithContextFunc           func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.VirtualMachinesMetric, int, error)
+	ListVirtualMachinesMetricsFunc                        func(p *cloudstack.ListVirtualMachinesMetricsParams) (*cloudstack.ListVirtualMachinesMetricsResponse, error)
+	ListVirtualMachinesMetricsWithContextFunc             func(ctx context.Context, p *cloudstack.ListVirtualMachinesMetricsParams) (*cloudstack.ListVirtualMachinesMetricsResponse, error)
+	NewListVirtualMachinesMetricsPagerFunc                func(p *cloudstack.ListVirtualMachinesMetricsParams) *cloudstack.ListVirtualMachinesMetricsPager
+	ListVirtualMachinesMetricsAllFunc                     func(p *cloudstack.ListVirtualMachinesMetricsParams) (*cloudstack.ListVirtualMachinesMetricsResponse, error)
+	ListVirtualMachinesMetricsAllWithContextFunc          func(ctx context.Context, p *cloudstack.ListVirtualMachinesMetricsParams) (*cloudstack.ListVirtualMachinesMetricsResponse, error)
+	NewMigrateVirtualMachineParamsFunc                    func(virtualmachineid string) *cloudstack.MigrateVirtualMachineParams
+	MigrateVirtualMachineFunc                             func(p *cloudstack.MigrateVirtualMachineParams) (*cloudstack.MigrateVirtualMachineResponse, error)
+	MigrateVirtualMachineWithContextFunc                  func(ctx context.Context, p *cloudstack.MigrateVirtualMachineParams) (*cloudstack.MigrateVirtualMachineResponse, error)
+	MigrateVirtualMachineAsyncFunc                        func(p *cloudstack.MigrateVirtualMachineParams) (*cloudstack.Job, error)
+	MigrateVirtualMachineAsyncWithContextFunc             func(ctx context.Context, p *cloudstack.MigrateVirtualMachineParams) (*cloudstack.Job, error)
+	NewMigrateVirtualMachineWithVolumeParamsFunc          func(hostid string, virtualmachineid string) *cloudstack.MigrateVirtualMachineWithVolumeParams
+	MigrateVirtualMachineWithVolumeFunc                   func(p *cloudstack.MigrateVirtualMachineWithVolumeParams) (*cloudstack.MigrateVirtualMachineWithVolumeResponse, error)
+	MigrateVirtualMachineWithVolumeWithContextFunc        func(ctx context.Context, p *cloudstack.MigrateVirtualMachineWithVolumeParams) (*cloudstack.MigrateVirtualMachineWithVolumeResponse, error)
+	MigrateVirtualMachineWithVolumeAsyncFunc              func(p *cloudstack.MigrateVirtualMachineWithVolumeParams) (*cloudstack.Job, error)
+	MigrateVirtualMachineWithVolumeAsyncWithContextFunc   func(ctx context.Context, p *cloudstack.MigrateVirtualMachineWithVolumeParams) (*cloudstack.Job, error)
+	NewRebootVirtualMachineParamsFunc                     func(id string) *cloudstack.RebootVirtualMachineParams
+	RebootVirtualMachineFunc                              func(p *cloudstack.RebootVirtualMachineParams) (*cloudstack.RebootVirtualMachineResponse, error)
+	RebootVirtualMachineWithContextFunc                   func(ctx context.Context, p *cloudstack.RebootVirtualMachineParams) (*cloudstack.RebootVirtualMachineResponse, error)
+	RebootVirtualMachineAsyncFunc                         func(p *cloudstack.RebootVirtualMachineParams) (*cloudstack.Job, error)
+	RebootVirtualMachineAsyncWithContextFunc              func(ctx context.Context, p *cloudstack.RebootVirtualMachineParams) (*cloudstack.Job, error)
+	NewRecoverVirtualMachineParamsFunc                    func(id string) *cloudstack.RecoverVirtualMachineParams
+	RecoverVirtualMachineFunc                             func(p *cloudstack.RecoverVirtualMachineParams) (*cloudstack.RecoverVirtualMachineResponse, error)
+	RecoverVirtualMachineWithContextFunc                  func(ctx context.Context, p *cloudstack.RecoverVirtualMachineParams) (*cloudstack.RecoverVirtualMachineResponse, error)
+	NewRemoveNicFromVirtualMachineParamsFunc              func(nicid string, virtualmachineid string) *cloudstack.RemoveNicFromVirtualMachineParams
+	RemoveNicFromVirtualMachineFunc                       func(p *cloudstack.RemoveNicFromVirtualMachineParams) (*cloudstack.RemoveNicFromVirtualMachineResponse, error)
+	RemoveNicFromVirtualMachineWithContextFunc            func(ctx context.Context, p *cloudstack.RemoveNicFromVirtualMachineParams) (*cloudstack.RemoveNicFromVirtualMachineResponse, error)
+	RemoveNicFromVirtualMachineAsyncFunc                  func(p *cloudstack.RemoveNicFromVirtualMachineParams) (*cloudstack.Job, error)
+	RemoveNicFromVirtualMachineAsyncWithContextFunc       func(ctx context.Context, p *cloudstack.RemoveNicFromVirtualMachineParams) (*cloudstack.Job, error)
+	NewResetPasswordForVirtualMachineParamsFunc           func(id string) *cloudstack.ResetPasswordForVirtualMachineParams
+	ResetPasswordForVirtualMachineFunc                    func(p *cloudstack.ResetPasswordForVirtualMachineParams) (*cloudstack.ResetPasswordForVirtualMachineResponse, error)
+	ResetPasswordForVirtualMachineWithContextFunc         func(ctx context.Context, p *cloudstack.ResetPasswordForVirtualMachineParams) (*cloudstack.ResetPasswordForVirtualMachineResponse, error)
+	ResetPasswordForVirtualMachineAsyncFunc               func(p *cloudstack.ResetPasswordForVirtualMachineParams) (*cloudstack.Job, error)
+	ResetPasswordForVirtualMachineAsyncWithContextFunc    func(ctx context.Context, p *cloudstack.ResetPasswordForVirtualMachineParams) (*cloudstack.Job, error)
+	NewRestoreVirtualMachineParamsFunc                    func(virtualmachineid string) *cloudstack.RestoreVirtualMachineParams
+	RestoreVirtualMachineFunc                             func(p *cloudstack.RestoreVirtualMachineParams) (*cloudstack.RestoreVirtualMachineResponse, error)
+	RestoreVirtualMachineWithContextFunc                  func(ctx context.Context, p *cloudstack.RestoreVirtualMachineParams) (*cloudstack.RestoreVirtualMachineResponse, error)
+	RestoreVirtualMachineAsyncFunc                        func(p *cloudstack.RestoreVirtualMachineParams) (*cloudstack.Job, error)
+	RestoreVirtualMachineAsyncWithContextFunc             func(ctx context.Context, p *cloudstack.RestoreVirtualMachineParams) (*cloudstack.Job, error)
+	NewScaleVirtualMachineParamsFunc                      func(id string, serviceofferingid string) *cloudstack.ScaleVirtualMachineParams
+	ScaleVirtualMachineFunc                               func(p *cloudstack.ScaleVirtualMachineParams) (*cloudstack.ScaleVirtualMachineResponse, error)
+	ScaleVirtualMachineWithContextFunc                    func(ctx context.Context, p *cloudstack.ScaleVirtualMachineParams) (*cloudstack.ScaleVirtualMachineResponse, error)
+	ScaleVirtualMachineAsyncFunc                          func(p *cloudstack.ScaleVirtualMachineParams) (*cloudstack.Job, error)
+	ScaleVirtualMachineAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.ScaleVirtualMachineParams) (*cloudstack.Job, error)
+	NewStartVirtualMachineParamsFunc                      func(id string) *cloudstack.StartVirtualMachineParams
+	StartVirtualMachineFunc                               func(p *cloudstack.StartVirtualMachineParams) (*cloudstack.StartVirtualMachineResponse, error)
+	StartVirtualMachineWithContextFunc                    func(ctx context.Context, p *cloudstack.StartVirtualMachineParams) (*cloudstack.StartVirtualMachineResponse, error)
+	StartVirtualMachineAsyncFunc                          func(p *cloudstack.StartVirtualMachineParams) (*cloudstack.Job, error)
+	StartVirtualMachineAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.StartVirtualMachineParams) (*cloudstack.Job, error)
+	NewStopVirtualMachineParamsFunc                       func(id string) *cloudstack.StopVirtualMachineParams
+	StopVirtualMachineFunc                                func(p *cloudstack.StopVirtualMachineParams) (*cloudstack.StopVirtualMachineResponse, error)
+	StopVirtualMachineWithContextFunc                     func(ctx context.Context, p *cloudstack.StopVirtualMachineParams) (*cloudstack.StopVirtualMachineResponse, error)
+	StopVirtualMachineAsyncFunc                           func(p *cloudstack.StopVirtualMachineParams) (*cloudstack.Job, error)
+	StopVirtualMachineAsyncWithContextFunc                func(ctx context.Context, p *cloudstack.StopVirtualMachineParams) (*cloudstack.Job, error)
+	NewUnmanageVirtualMachineParamsFunc                   func(id string) *cloudstack.UnmanageVirtualMachineParams
+	UnmanageVirtualMachineFunc                            func(p *cloudstack.UnmanageVirtualMachineParams) (*cloudstack.UnmanageVirtualMachineResponse, error)
+	UnmanageVirtualMachineWithContextFunc                 func(ctx context.Context, p *cloudstack.UnmanageVirtualMachineParams) (*cloudstack.UnmanageVirtualMachineResponse, error)
+	UnmanageVirtualMachineAsyncFunc                       func(p *cloudstack.UnmanageVirtualMachineParams) (*cloudstack.Job, error)
+	UnmanageVirtualMachineAsyncWithContextFunc            func(ctx context.Context, p *cloudstack.UnmanageVirtualMachineParams) (*cloudstack.Job, error)
+	NewUpdateDefaultNicForVirtualMachineParamsFunc        func(nicid string, virtualmachineid string) *cloudstack.UpdateDefaultNicForVirtualMachineParams
+	UpdateDefaultNicForVirtualMachineFunc                 func(p *cloudstack.UpdateDefaultNicForVirtualMachineParams) (*cloudstack.UpdateDefaultNicForVirtualMachineResponse, error)
+	UpdateDefaultNicForVirtualMachineWithContextFunc      func(ctx context.Context, p *cloudstack.UpdateDefaultNicForVirtualMachineParams) (*cloudstack.UpdateDefaultNicForVirtualMachineResponse, error)
+	UpdateDefaultNicForVirtualMachineAsyncFunc            func(p *cloudstack.UpdateDefaultNicForVirtualMachineParams) (*cloudstack.Job, error)
+	UpdateDefaultNicForVirtualMachineAsyncWithContextFunc func(ctx context.Context, p *cloudstack.UpdateDefaultNicForVirtualMachineParams) (*cloudstack.Job, error)
+	NewUpdateVirtualMachineParamsFunc                     func(id string) *cloudstack.UpdateVirtualMachineParams
+	UpdateVirtualMachineFunc                              func(p *cloudstack.UpdateVirtualMachineParams) (*cloudstack.UpdateVirtualMachineResponse, error)
+	UpdateVirtualMachineWithContextFunc                   func(ctx context.Context, p *cloudstack.UpdateVirtualMachineParams) (*cloudstack.UpdateVirtualMachineResponse, error)
+}
+
+var _ cloudstack.VirtualMachineServiceIface = (*MockVirtualMachineService)(nil)
+
+// NewAddNicToVirtualMachineParams records the call and calls NewAddNicToVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewAddNicToVirtualMachineParams(networkid string, virtualmachineid string) (r0 *cloudstack.AddNicToVirtualMachineParams) {
+	m.record("NewAddNicToVirtualMachineParams", networkid, virtualmachineid)
+	if m.NewAddNicToVirtualMachineParamsFunc != nil {
+		return m.NewAddNicToVirtualMachineParamsFunc(networkid, virtualmachineid)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewAddNicToVirtualMachineParams(networkid, virtualmachineid)
+}
+
+// AddNicToVirtualMachine records the call and calls AddNicToVirtualMachineFunc, or AddNicToVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) AddNicToVirtualMachine(p *cloudstack.AddNicToVirtualMachineParams) (r0 *cloudstack.AddNicToVirtualMachineResponse, r1 error) {
+	m.record("AddNicToVirtualMachine", p)
+	if m.AddNicToVirtualMachineFunc != nil {
+		return m.AddNicToVirtualMachineFunc(p)
+	}
+	if m.AddNicToVirtualMachineWithContextFunc != nil {
+		return m.AddNicToVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.AddNicToVirtualMachine")
+	return
+}
+
+// AddNicToVirtualMachineWithContext records the call and calls AddNicToVirtualMachineWithContextFunc, or AddNicToVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) AddNicToVirtualMachineWithContext(ctx context.Context, p *cloudstack.AddNicToVirtualMachineParams) (r0 *cloudstack.AddNicToVirtualMachineResponse, r1 error) {
+	m.record("AddNicToVirtualMachineWithContext", ctx, p)
+	if m.AddNicToVirtualMachineWithContextFunc != nil {
+		return m.AddNicToVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.AddNicToVirtualMachineFunc != nil {
+		return m.AddNicToVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.AddNicToVirtualMachineWithContext")
+	return
+}
+
+// AddNicToVirtualMachineAsync records the call and calls AddNicToVirtualMachineAsyncFunc, or AddNicToVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) AddNicToVirtualMachineAsync(p *cloudstack.AddNicToVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddNicToVirtualMachineAsync", p)
+	if m.AddNicToVirtualMachineAsyncFunc != nil {
+		return m.AddNicToVirtualMachineAsyncFunc(p)
+	}
+	if m.AddNicToVirtualMachineAsyncWithContextFunc != nil {
+		return m.AddNicToVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.AddNicToVirtualMachineAsync")
+	return
+}
+
+// AddNicToVirtualMachineAsyncWithContext records the call and calls AddNicToVirtualMachineAsyncWithContextFunc, or AddNicToVirtualMachineAsyncFunc if only that is set
+func (m *MockVirtualMachineService) AddNicToVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.AddNicToVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AddNicToVirtualMachineAsyncWithContext", ctx, p)
+	if m.AddNicToVirtualMachineAsyncWithContextFunc != nil {
+		return m.AddNicToVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.AddNicToVirtualMachineAsyncFunc != nil {
+		return m.AddNicToVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.AddNicToVirtualMachineAsyncWithContext")
+	return
+}
+
+// NewAssignVirtualMachineParams records the call and calls NewAssignVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewAssignVirtualMachineParams(virtualmachineid string) (r0 *cloudstack.AssignVirtualMachineParams) {
+	m.record("NewAssignVirtualMachineParams", virtualmachineid)
+	if m.NewAssignVirtualMachineParamsFunc != nil {
+		return m.NewAssignVirtualMachineParamsFunc(virtualmachineid)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewAssignVirtualMachineParams(virtualmachineid)
+}
+
+// AssignVirtualMachine records the call and calls AssignVirtualMachineFunc, or AssignVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) AssignVirtualMachine(p *cloudstack.AssignVirtualMachineParams) (r0 *cloudstack.AssignVirtualMachineResponse, r1 error) {
+	m.record("AssignVirtualMachine", p)
+	if m.AssignVirtualMachineFunc != nil {
+		return m.AssignVirtualMachineFunc(p)
+	}
+	if m.AssignVirtualMachineWithContextFunc != nil {
+		return m.AssignVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.AssignVirtualMachine")
+	return
+}
+
+// AssignVirtualMachineWithContext records the call and calls AssignVirtualMachineWithContextFunc, or AssignVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) AssignVirtualMachineWithContext(ctx context.Context, p *cloudstack.AssignVirtualMachineParams) (r0 *cloudstack.AssignVirtualMachineResponse, r1 error) {
+	m.record("AssignVirtualMachineWithContext", ctx, p)
+	if m.AssignVirtualMachineWithContextFunc != nil {
+		return m.AssignVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.AssignVirtualMachineFunc != nil {
+		return m.AssignVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.AssignVirtualMachineWithContext")
+	return
+}
+
+// NewChangeServiceForVirtualMachineParams records the call and calls NewChangeServiceForVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewChangeServiceForVirtualMachineParams(id string, serviceofferingid string) (r0 *cloudstack.ChangeServiceForVirtualMachineParams) {
+	m.record("NewChangeServiceForVirtualMachineParams", id, serviceofferingid)
+	if m.NewChangeServiceForVirtualMachineParamsFunc != nil {
+		return m.NewChangeServiceForVirtualMachineParamsFunc(id, serviceofferingid)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewChangeServiceForVirtualMachineParams(id, serviceofferingid)
+}
+
+// ChangeServiceForVirtualMachine records the call and calls ChangeServiceForVirtualMachineFunc, or ChangeServiceForVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ChangeServiceForVirtualMachine(p *cloudstack.ChangeServiceForVirtualMachineParams) (r0 *cloudstack.ChangeServiceForVirtualMachineResponse, r1 error) {
+	m.record("ChangeServiceForVirtualMachine", p)
+	if m.ChangeServiceForVirtualMachineFunc != nil {
+		return m.ChangeServiceForVirtualMachineFunc(p)
+	}
+	if m.ChangeServiceForVirtualMachineWithContextFunc != nil {
+		return m.ChangeServiceForVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ChangeServiceForVirtualMachine")
+	return
+}
+
+// ChangeServiceForVirtualMachineWithContext records the call and calls ChangeServiceForVirtualMachineWithContextFunc, or ChangeServiceForVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) ChangeServiceForVirtualMachineWithContext(ctx context.Context, p *cloudstack.ChangeServiceForVirtualMachineParams) (r0 *cloudstack.ChangeServiceForVirtualMachineResponse, r1 error) {
+	m.record("ChangeServiceForVirtualMachineWithContext", ctx, p)
+	if m.ChangeServiceForVirtualMachineWithContextFunc != nil {
+		return m.ChangeServiceForVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.ChangeServiceForVirtualMachineFunc != nil {
+		return m.ChangeServiceForVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ChangeServiceForVirtualMachineWithContext")
+	return
+}
+
+// NewCleanVMReservationsParams records the call and calls NewCleanVMReservationsParamsFunc
+func (m *MockVirtualMachineService) NewCleanVMReservationsParams() (r0 *cloudstack.CleanVMReservationsParams) {
+	m.record("NewCleanVMReservationsParams")
+	if m.NewCleanVMReservationsParamsFunc != nil {
+		return m.NewCleanVMReservationsParamsFunc()
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewCleanVMReservationsParams()
+}
+
+// CleanVMReservations records the call and calls CleanVMReservationsFunc, or CleanVMReservationsWithContextFunc if only that is set
+func (m *MockVirtualMachineService) CleanVMReservations(p *cloudstack.CleanVMReservationsParams) (r0 *cloudstack.CleanVMReservationsResponse, r1 error) {
+	m.record("CleanVMReservations", p)
+	if m.CleanVMReservationsFunc != nil {
+		return m.CleanVMReservationsFunc(p)
+	}
+	if m.CleanVMReservationsWithContextFunc != nil {
+		return m.CleanVMReservationsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.CleanVMReservations")
+	return
+}
+
+// CleanVMReservationsWithContext records the call and calls CleanVMReservationsWithContextFunc, or CleanVMReservationsFunc if only that is set
+func (m *MockVirtualMachineService) CleanVMReservationsWithContext(ctx context.Context, p *cloudstack.CleanVMReservationsParams) (r0 *cloudstack.CleanVMReservationsResponse, r1 error) {
+	m.record("CleanVMReservationsWithContext", ctx, p)
+	if m.CleanVMReservationsWithContextFunc != nil {
+		return m.CleanVMReservationsWithContextFunc(ctx, p)
+	}
+	if m.CleanVMReservationsFunc != nil {
+		return m.CleanVMReservationsFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.CleanVMReservationsWithContext")
+	return
+}
+
+// CleanVMReservationsAsync records the call and calls CleanVMReservationsAsyncFunc, or CleanVMReservationsAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) CleanVMReservationsAsync(p *cloudstack.CleanVMReservationsParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CleanVMReservationsAsync", p)
+	if m.CleanVMReservationsAsyncFunc != nil {
+		return m.CleanVMReservationsAsyncFunc(p)
+	}
+	if m.CleanVMReservationsAsyncWithContextFunc != nil {
+		return m.CleanVMReservationsAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.CleanVMReservationsAsync")
+	return
+}
+
+// CleanVMReservationsAsyncWithContext records the call and calls CleanVMReservationsAsyncWithContextFunc, or CleanVMReservationsAsyncFunc if only that is set
+func (m *MockVirtualMachineService) CleanVMReservationsAsyncWithContext(ctx context.Context, p *cloudstack.CleanVMReservationsParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CleanVMReservationsAsyncWithContext", ctx, p)
+	if m.CleanVMReservationsAsyncWithContextFunc != nil {
+		return m.CleanVMReservationsAsyncWithContextFunc(ctx, p)
+	}
+	if m.CleanVMReservationsAsyncFunc != nil {
+		return m.CleanVMReservationsAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.CleanVMReservationsAsyncWithContext")
+	return
+}
+
+// NewDeployVirtualMachineParams records the call and calls NewDeployVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string) (r0 *cloudstack.DeployVirtualMachineParams) {
+	m.record("NewDeployVirtualMachineParams", serviceofferingid, templateid, zoneid)
+	if m.NewDeployVirtualMachineParamsFunc != nil {
+		return m.NewDeployVirtualMachineParamsFunc(serviceofferingid, templateid, zoneid)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewDeployVirtualMachineParams(serviceofferingid, templateid, zoneid)
+}
+
+// DeployVirtualMachine records the call and calls DeployVirtualMachineFunc, or DeployVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) DeployVirtualMachine(p *cloudstack.DeployVirtualMachineParams) (r0 *cloudstack.DeployVirtualMachineResponse, r1 error) {
+	m.record("DeployVirtualMachine", p)
+	if m.DeployVirtualMachineFunc != nil {
+		return m.DeployVirtualMachineFunc(p)
+	}
+	if m.DeployVirtualMachineWithContextFunc != nil {
+		return m.DeployVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.DeployVirtualMachine")
+	return
+}
+
+// DeployVirtualMachineWithContext records the call and calls DeployVirtualMachineWithContextFunc, or DeployVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) DeployVirtualMachineWithContext(ctx context.Context, p *cloudstack.DeployVirtualMachineParams) (r0 *cloudstack.DeployVirtualMachineResponse, r1 error) {
+	m.record("DeployVirtualMachineWithContext", ctx, p)
+	if m.DeployVirtualMachineWithContextFunc != nil {
+		return m.DeployVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.DeployVirtualMachineFunc != nil {
+		return m.DeployVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.DeployVirtualMachineWithContext")
+	return
+}
+
+// DeployVirtualMachineAsync records the call and calls DeployVirtualMachineAsyncFunc, or DeployVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) DeployVirtualMachineAsync(p *cloudstack.DeployVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeployVirtualMachineAsync", p)
+	if m.DeployVirtualMachineAsyncFunc != nil {
+		return m.DeployVirtualMachineAsyncFunc(p)
+	}
+	if m.DeployVirtualMachineAsyncWithContextFunc != nil {
+		return m.DeployVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.DeployVirtualMachineAsync")
+	return
+}
+
+// DeployVirtualMachineAsyncWithContext records the call and calls DeployVirtualMachineAsyncWithContextFunc, or DeployVirtualMachineAsyncFunc if only that is set
+func (m *MockVirtualMachineService) DeployVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.DeployVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DeployVirtualMachineAsyncWithContext", ctx, p)
+	if m.DeployVirtualMachineAsyncWithContextFunc != nil {
+		return m.DeployVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.DeployVirtualMachineAsyncFunc != nil {
+		return m.DeployVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.DeployVirtualMachineAsyncWithContext")
+	return
+}
+
+// NewDestroyVirtualMachineParams records the call and calls NewDestroyVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewDestroyVirtualMachineParams(id string) (r0 *cloudstack.DestroyVirtualMachineParams) {
+	m.record("NewDestroyVirtualMachineParams", id)
+	if m.NewDestroyVirtualMachineParamsFunc != nil {
+		return m.NewDestroyVirtualMachineParamsFunc(id)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewDestroyVirtualMachineParams(id)
+}
+
+// DestroyVirtualMachine records the call and calls DestroyVirtualMachineFunc, or DestroyVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) DestroyVirtualMachine(p *cloudstack.DestroyVirtualMachineParams) (r0 *cloudstack.DestroyVirtualMachineResponse, r1 error) {
+	m.record("DestroyVirtualMachine", p)
+	if m.DestroyVirtualMachineFunc != nil {
+		return m.DestroyVirtualMachineFunc(p)
+	}
+	if m.DestroyVirtualMachineWithContextFunc != nil {
+		return m.DestroyVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.DestroyVirtualMachine")
+	return
+}
+
+// DestroyVirtualMachineWithContext records the call and calls DestroyVirtualMachineWithContextFunc, or DestroyVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) DestroyVirtualMachineWithContext(ctx context.Context, p *cloudstack.DestroyVirtualMachineParams) (r0 *cloudstack.DestroyVirtualMachineResponse, r1 error) {
+	m.record("DestroyVirtualMachineWithContext", ctx, p)
+	if m.DestroyVirtualMachineWithContextFunc != nil {
+		return m.DestroyVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.DestroyVirtualMachineFunc != nil {
+		return m.DestroyVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.DestroyVirtualMachineWithContext")
+	return
+}
+
+// DestroyVirtualMachineAsync records the call and calls DestroyVirtualMachineAsyncFunc, or DestroyVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) DestroyVirtualMachineAsync(p *cloudstack.DestroyVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DestroyVirtualMachineAsync", p)
+	if m.DestroyVirtualMachineAsyncFunc != nil {
+		return m.DestroyVirtualMachineAsyncFunc(p)
+	}
+	if m.DestroyVirtualMachineAsyncWithContextFunc != nil {
+		return m.DestroyVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.DestroyVirtualMachineAsync")
+	return
+}
+
+// DestroyVirtualMachineAsyncWithContext records the call and calls DestroyVirtualMachineAsyncWithContextFunc, or DestroyVirtualMachineAsyncFunc if only that is set
+func (m *MockVirtualMachineService) DestroyVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.DestroyVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DestroyVirtualMachineAsyncWithContext", ctx, p)
+	if m.DestroyVirtualMachineAsyncWithContextFunc != nil {
+		return m.DestroyVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.DestroyVirtualMachineAsyncFunc != nil {
+		return m.DestroyVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.DestroyVirtualMachineAsyncWithContext")
+	return
+}
+
+// NewExpungeVirtualMachineParams records the call and calls NewExpungeVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewExpungeVirtualMachineParams(id string) (r0 *cloudstack.ExpungeVirtualMachineParams) {
+	m.record("NewExpungeVirtualMachineParams", id)
+	if m.NewExpungeVirtualMachineParamsFunc != nil {
+		return m.NewExpungeVirtualMachineParamsFunc(id)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewExpungeVirtualMachineParams(id)
+}
+
+// ExpungeVirtualMachine records the call and calls ExpungeVirtualMachineFunc, or ExpungeVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ExpungeVirtualMachine(p *cloudstack.ExpungeVirtualMachineParams) (r0 *cloudstack.ExpungeVirtualMachineResponse, r1 error) {
+	m.record("ExpungeVirtualMachine", p)
+	if m.ExpungeVirtualMachineFunc != nil {
+		return m.ExpungeVirtualMachineFunc(p)
+	}
+	if m.ExpungeVirtualMachineWithContextFunc != nil {
+		return m.ExpungeVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ExpungeVirtualMachine")
+	return
+}
+
+// ExpungeVirtualMachineWithContext records the call and calls ExpungeVirtualMachineWithContextFunc, or ExpungeVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) ExpungeVirtualMachineWithContext(ctx context.Context, p *cloudstack.ExpungeVirtualMachineParams) (r0 *cloudstack.ExpungeVirtualMachineResponse, r1 error) {
+	m.record("ExpungeVirtualMachineWithContext", ctx, p)
+	if m.ExpungeVirtualMachineWithContextFunc != nil {
+		return m.ExpungeVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.ExpungeVirtualMachineFunc != nil {
+		return m.ExpungeVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ExpungeVirtualMachineWithContext")
+	return
+}
+
+// ExpungeVirtualMachineAsync records the call and calls ExpungeVirtualMachineAsyncFunc, or ExpungeVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ExpungeVirtualMachineAsync(p *cloudstack.ExpungeVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ExpungeVirtualMachineAsync", p)
+	if m.ExpungeVirtualMachineAsyncFunc != nil {
+		return m.ExpungeVirtualMachineAsyncFunc(p)
+	}
+	if m.ExpungeVirtualMachineAsyncWithContextFunc != nil {
+		return m.ExpungeVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ExpungeVirtualMachineAsync")
+	return
+}
+
+// ExpungeVirtualMachineAsyncWithContext records the call and calls ExpungeVirtualMachineAsyncWithContextFunc, or ExpungeVirtualMachineAsyncFunc if only that is set
+func (m *MockVirtualMachineService) ExpungeVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.ExpungeVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ExpungeVirtualMachineAsyncWithContext", ctx, p)
+	if m.ExpungeVirtualMachineAsyncWithContextFunc != nil {
+		return m.ExpungeVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.ExpungeVirtualMachineAsyncFunc != nil {
+		return m.ExpungeVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ExpungeVirtualMachineAsyncWithContext")
+	return
+}
+
+// NewGetVMPasswordParams records the call and calls NewGetVMPasswordParamsFunc
+func (m *MockVirtualMachineService) NewGetVMPasswordParams(id string) (r0 *cloudstack.GetVMPasswordParams) {
+	m.record("NewGetVMPasswordParams", id)
+	if m.NewGetVMPasswordParamsFunc != nil {
+		return m.NewGetVMPasswordParamsFunc(id)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewGetVMPasswordParams(id)
+}
+
+// GetVMPassword records the call and calls GetVMPasswordFunc, or GetVMPasswordWithContextFunc if only that is set
+func (m *MockVirtualMachineService) GetVMPassword(p *cloudstack.GetVMPasswordParams) (r0 *cloudstack.GetVMPasswordResponse, r1 error) {
+	m.record("GetVMPassword", p)
+	if m.GetVMPasswordFunc != nil {
+		return m.GetVMPasswordFunc(p)
+	}
+	if m.GetVMPasswordWithContextFunc != nil {
+		return m.GetVMPasswordWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.GetVMPassword")
+	return
+}
+
+// GetVMPasswordWithContext records the call and calls GetVMPasswordWithContextFunc, or GetVMPasswordFunc if only that is set
+func (m *MockVirtualMachineService) GetVMPasswordWithContext(ctx context.Context, p *cloudstack.GetVMPasswordParams) (r0 *cloudstack.GetVMPasswordResponse, r1 error) {
+	m.record("GetVMPasswordWithContext", ctx, p)
+	if m.GetVMPasswordWithContextFunc != nil {
+		return m.GetVMPasswordWithContextFunc(ctx, p)
+	}
+	if m.GetVMPasswordFunc != nil {
+		return m.GetVMPasswordFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.GetVMPasswordWithContext")
+	return
+}
+
+// NewImportUnmanagedInstanceParams records the call and calls NewImportUnmanagedInstanceParamsFunc
+func (m *MockVirtualMachineService) NewImportUnmanagedInstanceParams(clusterid string, name string, serviceofferingid string) (r0 *cloudstack.ImportUnmanagedInstanceParams) {
+	m.record("NewImportUnmanagedInstanceParams", clusterid, name, serviceofferingid)
+	if m.NewImportUnmanagedInstanceParamsFunc != nil {
+		return m.NewImportUnmanagedInstanceParamsFunc(clusterid, name, serviceofferingid)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewImportUnmanagedInstanceParams(clusterid, name, serviceofferingid)
+}
+
+// ImportUnmanagedInstance records the call and calls ImportUnmanagedInstanceFunc, or ImportUnmanagedInstanceWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ImportUnmanagedInstance(p *cloudstack.ImportUnmanagedInstanceParams) (r0 *cloudstack.ImportUnmanagedInstanceResponse, r1 error) {
+	m.record("ImportUnmanagedInstance", p)
+	if m.ImportUnmanagedInstanceFunc != nil {
+		return m.ImportUnmanagedInstanceFunc(p)
+	}
+	if m.ImportUnmanagedInstanceWithContextFunc != nil {
+		return m.ImportUnmanagedInstanceWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ImportUnmanagedInstance")
+	return
+}
+
+// ImportUnmanagedInstanceWithContext records the call and calls ImportUnmanagedInstanceWithContextFunc, or ImportUnmanagedInstanceFunc if only that is set
+func (m *MockVirtualMachineService) ImportUnmanagedInstanceWithContext(ctx context.Context, p *cloudstack.ImportUnmanagedInstanceParams) (r0 *cloudstack.ImportUnmanagedInstanceResponse, r1 error) {
+	m.record("ImportUnmanagedInstanceWithContext", ctx, p)
+	if m.ImportUnmanagedInstanceWithContextFunc != nil {
+		return m.ImportUnmanagedInstanceWithContextFunc(ctx, p)
+	}
+	if m.ImportUnmanagedInstanceFunc != nil {
+		return m.ImportUnmanagedInstanceFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ImportUnmanagedInstanceWithContext")
+	return
+}
+
+// ImportUnmanagedInstanceAsync records the call and calls ImportUnmanagedInstanceAsyncFunc, or ImportUnmanagedInstanceAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ImportUnmanagedInstanceAsync(p *cloudstack.ImportUnmanagedInstanceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ImportUnmanagedInstanceAsync", p)
+	if m.ImportUnmanagedInstanceAsyncFunc != nil {
+		return m.ImportUnmanagedInstanceAsyncFunc(p)
+	}
+	if m.ImportUnmanagedInstanceAsyncWithContextFunc != nil {
+		return m.ImportUnmanagedInstanceAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ImportUnmanagedInstanceAsync")
+	return
+}
+
+// ImportUnmanagedInstanceAsyncWithContext records the call and calls ImportUnmanagedInstanceAsyncWithContextFunc, or ImportUnmanagedInstanceAsyncFunc if only that is set
+func (m *MockVirtualMachineService) ImportUnmanagedInstanceAsyncWithContext(ctx context.Context, p *cloudstack.ImportUnmanagedInstanceParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ImportUnmanagedInstanceAsyncWithContext", ctx, p)
+	if m.ImportUnmanagedInstanceAsyncWithContextFunc != nil {
+		return m.ImportUnmanagedInstanceAsyncWithContextFunc(ctx, p)
+	}
+	if m.ImportUnmanagedInstanceAsyncFunc != nil {
+		return m.ImportUnmanagedInstanceAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ImportUnmanagedInstanceAsyncWithContext")
+	return
+}
+
+// NewListUnmanagedInstancesParams records the call and calls NewListUnmanagedInstancesParamsFunc
+func (m *MockVirtualMachineService) NewListUnmanagedInstancesParams(clusterid string) (r0 *cloudstack.ListUnmanagedInstancesParams) {
+	m.record("NewListUnmanagedInstancesParams", clusterid)
+	if m.NewListUnmanagedInstancesParamsFunc != nil {
+		return m.NewListUnmanagedInstancesParamsFunc(clusterid)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewListUnmanagedInstancesParams(clusterid)
+}
+
+// ListUnmanagedInstances records the call and calls ListUnmanagedInstancesFunc, or ListUnmanagedInstancesWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ListUnmanagedInstances(p *cloudstack.ListUnmanagedInstancesParams) (r0 *cloudstack.ListUnmanagedInstancesResponse, r1 error) {
+	m.record("ListUnmanagedInstances", p)
+	if m.ListUnmanagedInstancesFunc != nil {
+		return m.ListUnmanagedInstancesFunc(p)
+	}
+	if m.ListUnmanagedInstancesWithContextFunc != nil {
+		return m.ListUnmanagedInstancesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ListUnmanagedInstances")
+	return
+}
+
+// ListUnmanagedInstancesWithContext records the call and calls ListUnmanagedInstancesWithContextFunc, or ListUnmanagedInstancesFunc if only that is set
+func (m *MockVirtualMachineService) ListUnmanagedInstancesWithContext(ctx context.Context, p *cloudstack.ListUnmanagedInstancesParams) (r0 *cloudstack.ListUnmanagedInstancesResponse, r1 error) {
+	m.record("ListUnmanagedInstancesWithContext", ctx, p)
+	if m.ListUnmanagedInstancesWithContextFunc != nil {
+		return m.ListUnmanagedInstancesWithContextFunc(ctx, p)
+	}
+	if m.ListUnmanagedInstancesFunc != nil {
+		return m.ListUnmanagedInstancesFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ListUnmanagedInstancesWithContext")
+	return
+}
+
+// NewListUnmanagedInstancesPager records the call and calls NewListUnmanagedInstancesPagerFunc
+func (m *MockVirtualMachineService) NewListUnmanagedInstancesPager(p *cloudstack.ListUnmanagedInstancesParams) (r0 *cloudstack.ListUnmanagedInstancesPager) {
+	m.record("NewListUnmanagedInstancesPager", p)
+	if m.NewListUnmanagedInstancesPagerFunc != nil {
+		return m.NewListUnmanagedInstancesPagerFunc(p)
+	}
+	return
+}
+
+// ListUnmanagedInstancesAll records the call and calls ListUnmanagedInstancesAllFunc, or ListUnmanagedInstancesAllWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ListUnmanagedInstancesAll(p *cloudstack.ListUnmanagedInstancesParams) (r0 *cloudstack.ListUnmanagedInstancesResponse, r1 error) {
+	m.record("ListUnmanagedInstancesAll", p)
+	if m.ListUnmanagedInstancesAllFunc != nil {
+		return m.ListUnmanagedInstancesAllFunc(p)
+	}
+	if m.ListUnmanagedInstancesAllWithContextFunc != nil {
+		return m.ListUnmanagedInstancesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ListUnmanagedInstancesAll")
+	return
+}
+
+// ListUnmanagedInstancesAllWithContext records the call and calls ListUnmanagedInstancesAllWithContextFunc, or ListUnmanagedInstancesAllFunc if only that is set
+func (m *MockVirtualMachineService) ListUnmanagedInstancesAllWithContext(ctx context.Context, p *cloudstack.ListUnmanagedInstancesParams) (r0 *cloudstack.ListUnmanagedInstancesResponse, r1 error) {
+	m.record("ListUnmanagedInstancesAllWithContext", ctx, p)
+	if m.ListUnmanagedInstancesAllWithContextFunc != nil {
+		return m.ListUnmanagedInstancesAllWithContextFunc(ctx, p)
+	}
+	if m.ListUnmanagedInstancesAllFunc != nil {
+		return m.ListUnmanagedInstancesAllFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ListUnmanagedInstancesAllWithContext")
+	return
+}
+
+// NewListVirtualMachinesParams records the call and calls NewListVirtualMachinesParamsFunc
+func (m *MockVirtualMachineService) NewListVirtualMachinesParams() (r0 *cloudstack.ListVirtualMachinesParams) {
+	m.record("NewListVirtualMachinesParams")
+	if m.NewListVirtualMachinesParamsFunc != nil {
+		return m.NewListVirtualMachinesParamsFunc()
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewListVirtualMachinesParams()
+}
+
+// GetVirtualMachineID records the call and calls GetVirtualMachineIDFunc, or GetVirtualMachineIDWithContextFunc if only that is set
+func (m *MockVirtualMachineService) GetVirtualMachineID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVirtualMachineID", name, opts)
+	if m.GetVirtualMachineIDFunc != nil {
+		return m.GetVirtualMachineIDFunc(name, opts...)
+	}
+	if m.GetVirtualMachineIDWithContextFunc != nil {
+		return m.GetVirtualMachineIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VirtualMachineService.GetVirtualMachineID")
+	return
+}
+
+// GetVirtualMachineIDWithContext records the call and calls GetVirtualMachineIDWithContextFunc, or GetVirtualMachineIDFunc if only that is set
+func (m *MockVirtualMachineService) GetVirtualMachineIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVirtualMachineIDWithContext", ctx, name, opts)
+	if m.GetVirtualMachineIDWithContextFunc != nil {
+		return m.GetVirtualMachineIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetVirtualMachineIDFunc != nil {
+		return m.GetVirtualMachineIDFunc(name, opts...)
+	}
+	r2 = notMocked("VirtualMachineService.GetVirtualMachineIDWithContext")
+	return
+}
+
+// GetVirtualMachineByName records the call and calls GetVirtualMachineByNameFunc, or GetVirtualMachineByNameWithContextFunc if only that is set
+func (m *MockVirtualMachineService) GetVirtualMachineByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VirtualMachine, r1 int, r2 error) {
+	m.record("GetVirtualMachineByName", name, opts)
+	if m.GetVirtualMachineByNameFunc != nil {
+		return m.GetVirtualMachineByNameFunc(name, opts...)
+	}
+	if m.GetVirtualMachineByNameWithContextFunc != nil {
+		return m.GetVirtualMachineByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VirtualMachineService.GetVirtualMachineByName")
+	return
+}
+
+// GetVirtualMachineByNameWithContext records the call and calls GetVirtualMachineByNameWithContextFunc, or GetVirtualMachineByNameFunc if only that is set
+func (m *MockVirtualMachineService) GetVirtualMachineByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VirtualMachine, r1 int, r2 error) {
+	m.record("GetVirtualMachineByNameWithContext", ctx, name, opts)
+	if m.GetVirtualMachineByNameWithContextFunc != nil {
+		return m.GetVirtualMachineByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetVirtualMachineByNameFunc != nil {
+		return m.GetVirtualMachineByNameFunc(name, opts...)
+	}
+	r2 = notMocked("VirtualMachineService.GetVirtualMachineByNameWithContext")
+	return
+}
+
+// GetVirtualMachineByID records the call and calls GetVirtualMachineByIDFunc, or GetVirtualMachineByIDWithContextFunc if only that is set
+func (m *MockVirtualMachineService) GetVirtualMachineByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VirtualMachine, r1 int, r2 error) {
+	m.record("GetVirtualMachineByID", id, opts)
+	if m.GetVirtualMachineByIDFunc != nil {
+		return m.GetVirtualMachineByIDFunc(id, opts...)
+	}
+	if m.GetVirtualMachineByIDWithContextFunc != nil {
+		return m.GetVirtualMachineByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VirtualMachineService.GetVirtualMachineByID")
+	return
+}
+
+// GetVirtualMachineByIDWithContext records the call and calls GetVirtualMachineByIDWithContextFunc, or GetVirtualMachineByIDFunc if only that is set
+func (m *MockVirtualMachineService) GetVirtualMachineByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VirtualMachine, r1 int, r2 error) {
+	m.record("GetVirtualMachineByIDWithContext", ctx, id, opts)
+	if m.GetVirtualMachineByIDWithContextFunc != nil {
+		return m.GetVirtualMachineByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetVirtualMachineByIDFunc != nil {
+		return m.GetVirtualMachineByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VirtualMachineService.GetVirtualMachineByIDWithContext")
+	return
+}
+
+// ListVirtualMachines records the call and calls ListVirtualMachinesFunc, or ListVirtualMachinesWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ListVirtualMachines(p *cloudstack.ListVirtualMachinesParams) (r0 *cloudstack.ListVirtualMachinesResponse, r1 error) {
+	m.record("ListVirtualMachines", p)
+	if m.ListVirtualMachinesFunc != nil {
+		return m.ListVirtualMachinesFunc(p)
+	}
+	if m.ListVirtualMachinesWithContextFunc != nil {
+		return m.ListVirtualMachinesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ListVirtualMachines")
+	return
+}
+
+// ListVirtualMachinesWithContext records the call and calls ListVirtualMachinesWithContextFunc, or ListVirtualMachinesFunc if only that is set
+func (m *MockVirtualMachineService) ListVirtualMachinesWithContext(ctx context.Context, p *cloudstack.ListVirtualMachinesParams) (r0 *cloudstack.ListVirtualMachinesResponse, r1 error) {
+	m.record("ListVirtualMachinesWithContext", ctx, p)
+	if m.ListVirtualMachinesWithContextFunc != nil {
+		return m.ListVirtualMachinesWithContextFunc(ctx, p)
+	}
+	if m.ListVirtualMachinesFunc != nil {
+		return m.ListVirtualMachinesFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ListVirtualMachinesWithContext")
+	return
+}
+
+// NewListVirtualMachinesPager records the call and calls NewListVirtualMachinesPagerFunc
+func (m *MockVirtualMachineService) NewListVirtualMachinesPager(p *cloudstack.ListVirtualMachinesParams) (r0 *cloudstack.ListVirtualMachinesPager) {
+	m.record("NewListVirtualMachinesPager", p)
+	if m.NewListVirtualMachinesPagerFunc != nil {
+		return m.NewListVirtualMachinesPagerFunc(p)
+	}
+	return
+}
+
+// ListVirtualMachinesAll records the call and calls ListVirtualMachinesAllFunc, or ListVirtualMachinesAllWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ListVirtualMachinesAll(p *cloudstack.ListVirtualMachinesParams) (r0 *cloudstack.ListVirtualMachinesResponse, r1 error) {
+	m.record("ListVirtualMachinesAll", p)
+	if m.ListVirtualMachinesAllFunc != nil {
+		return m.ListVirtualMachinesAllFunc(p)
+	}
+	if m.ListVirtualMachinesAllWithContextFunc != nil {
+		return m.ListVirtualMachinesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ListVirtualMachinesAll")
+	return
+}
+
+// ListVirtualMachinesAllWithContext records the call and calls ListVirtualMachinesAllWithContextFunc, or ListVirtualMachinesAllFunc if only that is set
+func (m *MockVirtualMachineService) ListVirtualMachinesAllWithContext(ctx context.Context, p *cloudstack.ListVirtualMachinesParams) (r0 *cloudstack.ListVirtualMachinesResponse, r1 error) {
+	m.record("ListVirtualMachinesAllWithContext", ctx, p)
+	if m.ListVirtualMachinesAllWithContextFunc != nil {
+		return m.ListVirtualMachinesAllWithContextFunc(ctx, p)
+	}
+	if m.ListVirtualMachinesAllFunc != nil {
+		return m.ListVirtualMachinesAllFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ListVirtualMachinesAllWithContext")
+	return
+}
+
+// NewListVirtualMachinesMetricsParams records the call and calls NewListVirtualMachinesMetricsParamsFunc
+func (m *MockVirtualMachineService) NewListVirtualMachinesMetricsParams() (r0 *cloudstack.ListVirtualMachinesMetricsParams) {
+	m.record("NewListVirtualMachinesMetricsParams")
+	if m.NewListVirtualMachinesMetricsParamsFunc != nil {
+		return m.NewListVirtualMachinesMetricsParamsFunc()
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewListVirtualMachinesMetricsParams()
+}
+
+// GetVirtualMachinesMetricID records the call and calls GetVirtualMachinesMetricIDFunc, or GetVirtualMachinesMetricIDWithContextFunc if only that is set
+func (m *MockVirtualMachineService) GetVirtualMachinesMetricID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVirtualMachinesMetricID", name, opts)
+	if m.GetVirtualMachinesMetricIDFunc != nil {
+		return m.GetVirtualMachinesMetricIDFunc(name, opts...)
+	}
+	if m.GetVirtualMachinesMetricIDWithContextFunc != nil {
+		return m.GetVirtualMachinesMetricIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VirtualMachineService.GetVirtualMachinesMetricID")
+	return
+}
+
+// GetVirtualMachinesMetricIDWithContext records the call and calls GetVirtualMachinesMetricIDWithContextFunc, or GetVirtualMachinesMetricIDFunc if only that is set
+func (m *MockVirtualMachineService) GetVirtualMachinesMetricIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVirtualMachinesMetricIDWithContext", ctx, name, opts)
+	if m.GetVirtualMachinesMetricIDWithContextFunc != nil {
+		return m.GetVirtualMachinesMetricIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetVirtualMachinesMetricIDFunc != nil {
+		return m.GetVirtualMachinesMetricIDFunc(name, opts...)
+	}
+	r2 = notMocked("VirtualMachineService.GetVirtualMachinesMetricIDWithContext")
+	return
+}
+
+// GetVirtualMachinesMetricByName records the call and calls GetVirtualMachinesMetricByNameFunc, or GetVirtualMachinesMetricByNameWithContextFunc if only that is set
+func (m *MockVirtualMachineService) GetVirtualMachinesMetricByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VirtualMachinesMetric, r1 int, r2 error) {
+	m.record("GetVirtualMachinesMetricByName", name, opts)
+	if m.GetVirtualMachinesMetricByNameFunc != nil {
+		return m.GetVirtualMachinesMetricByNameFunc(name, opts...)
+	}
+	if m.GetVirtualMachinesMetricByNameWithContextFunc != nil {
+		return m.GetVirtualMachinesMetricByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VirtualMachineService.GetVirtualMachinesMetricByName")
+	return
+}
+
+// GetVirtualMachinesMetricByNameWithContext records the call and calls GetVirtualMachinesMetricByNameWithContextFunc, or GetVirtualMachinesMetricByNameFunc if only that is set
+func (m *MockVirtualMachineService) GetVirtualMachinesMetricByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VirtualMachinesMetric, r1 int, r2 error) {
+	m.record("GetVirtualMachinesMetricByNameWithContext", ctx, name, opts)
+	if m.GetVirtualMachinesMetricByNameWithContextFunc != nil {
+		return m.GetVirtualMachinesMetricByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetVirtualMachinesMetricByNameFunc != nil {
+		return m.GetVirtualMachinesMetricByNameFunc(name, opts...)
+	}
+	r2 = notMocked("VirtualMachineService.GetVirtualMachinesMetricByNameWithContext")
+	return
+}
+
+// GetVirtualMachinesMetricByID records the call and calls GetVirtualMachinesMetricByIDFunc, or GetVirtualMachinesMetricByIDWithContextFunc if only that is set
+func (m *MockVirtualMachineService) GetVirtualMachinesMetricByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VirtualMachinesMetric, r1 int, r2 error) {
+	m.record("GetVirtualMachinesMetricByID", id, opts)
+	if m.GetVirtualMachinesMetricByIDFunc != nil {
+		return m.GetVirtualMachinesMetricByIDFunc(id, opts...)
+	}
+	if m.GetVirtualMachinesMetricByIDWithContextFunc != nil {
+		return m.GetVirtualMachinesMetricByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VirtualMachineService.GetVirtualMachinesMetricByID")
+	return
+}
+
+// GetVirtualMachinesMetricByIDWithContext records the call and calls GetVirtualMachinesMetricByIDWithContextFunc, or GetVirtualMachinesMetricByIDFunc if only that is set
+func (m *MockVirtualMachineService) GetVirtualMachinesMetricByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VirtualMachinesMetric, r1 int, r2 error) {
+	m.record("GetVirtualMachinesMetricByIDWithContext", ctx, id, opts)
+	if m.GetVirtualMachinesMetricByIDWithContextFunc != nil {
+		return m.GetVirtualMachinesMetricByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetVirtualMachinesMetricByIDFunc != nil {
+		return m.GetVirtualMachinesMetricByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VirtualMachineService.GetVirtualMachinesMetricByIDWithContext")
+	return
+}
+
+// ListVirtualMachinesMetrics records the call and calls ListVirtualMachinesMetricsFunc, or ListVirtualMachinesMetricsWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ListVirtualMachinesMetrics(p *cloudstack.ListVirtualMachinesMetricsParams) (r0 *cloudstack.ListVirtualMachinesMetricsResponse, r1 error) {
+	m.record("ListVirtualMachinesMetrics", p)
+	if m.ListVirtualMachinesMetricsFunc != nil {
+		return m.ListVirtualMachinesMetricsFunc(p)
+	}
+	if m.ListVirtualMachinesMetricsWithContextFunc != nil {
+		return m.ListVirtualMachinesMetricsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ListVirtualMachinesMetrics")
+	return
+}
+
+// ListVirtualMachinesMetricsWithContext records the call and calls ListVirtualMachinesMetricsWithContextFunc, or ListVirtualMachinesMetricsFunc if only that is set
+func (m *MockVirtualMachineService) ListVirtualMachinesMetricsWithContext(ctx context.Context, p *cloudstack.ListVirtualMachinesMetricsParams) (r0 *cloudstack.ListVirtualMachinesMetricsResponse, r1 error) {
+	m.record("ListVirtualMachinesMetricsWithContext", ctx, p)
+	if m.ListVirtualMachinesMetricsWithContextFunc != nil {
+		return m.ListVirtualMachinesMetricsWithContextFunc(ctx, p)
+	}
+	if m.ListVirtualMachinesMetricsFunc != nil {
+		return m.ListVirtualMachinesMetricsFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ListVirtualMachinesMetricsWithContext")
+	return
+}
+
+// NewListVirtualMachinesMetricsPager records the call and calls NewListVirtualMachinesMetricsPagerFunc
+func (m *MockVirtualMachineService) NewListVirtualMachinesMetricsPager(p *cloudstack.ListVirtualMachinesMetricsParams) (r0 *cloudstack.ListVirtualMachinesMetricsPager) {
+	m.record("NewListVirtualMachinesMetricsPager", p)
+	if m.NewListVirtualMachinesMetricsPagerFunc != nil {
+		return m.NewListVirtualMachinesMetricsPagerFunc(p)
+	}
+	return
+}
+
+// ListVirtualMachinesMetricsAll records the call and calls ListVirtualMachinesMetricsAllFunc, or ListVirtualMachinesMetricsAllWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ListVirtualMachinesMetricsAll(p *cloudstack.ListVirtualMachinesMetricsParams) (r0 *cloudstack.ListVirtualMachinesMetricsResponse, r1 error) {
+	m.record("ListVirtualMachinesMetricsAll", p)
+	if m.ListVirtualMachinesMetricsAllFunc != nil {
+		return m.ListVirtualMachinesMetricsAllFunc(p)
+	}
+	if m.ListVirtualMachinesMetricsAllWithContextFunc != nil {
+		return m.ListVirtualMachinesMetricsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ListVirtualMachinesMetricsAll")
+	return
+}
+
+// ListVirtualMachinesMetricsAllWithContext records the call and calls ListVirtualMachinesMetricsAllWithContextFunc, or ListVirtualMachinesMetricsAllFunc if only that is set
+func (m *MockVirtualMachineService) ListVirtualMachinesMetricsAllWithContext(ctx context.Context, p *cloudstack.ListVirtualMachinesMetricsParams) (r0 *cloudstack.ListVirtualMachinesMetricsResponse, r1 error) {
+	m.record("ListVirtualMachinesMetricsAllWithContext", ctx, p)
+	if m.ListVirtualMachinesMetricsAllWithContextFunc != nil {
+		return m.ListVirtualMachinesMetricsAllWithContextFunc(ctx, p)
+	}
+	if m.ListVirtualMachinesMetricsAllFunc != nil {
+		return m.ListVirtualMachinesMetricsAllFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ListVirtualMachinesMetricsAllWithContext")
+	return
+}
+
+// NewMigrateVirtualMachineParams records the call and calls NewMigrateVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewMigrateVirtualMachineParams(virtualmachineid string) (r0 *cloudstack.MigrateVirtualMachineParams) {
+	m.record("NewMigrateVirtualMachineParams", virtualmachineid)
+	if m.NewMigrateVirtualMachineParamsFunc != nil {
+		return m.NewMigrateVirtualMachineParamsFunc(virtualmachineid)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewMigrateVirtualMachineParams(virtualmachineid)
+}
+
+// MigrateVirtualMachine records the call and calls MigrateVirtualMachineFunc, or MigrateVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) MigrateVirtualMachine(p *cloudstack.MigrateVirtualMachineParams) (r0 *cloudstack.MigrateVirtualMachineResponse, r1 error) {
+	m.record("MigrateVirtualMachine", p)
+	if m.MigrateVirtualMachineFunc != nil {
+		return m.MigrateVirtualMachineFunc(p)
+	}
+	if m.MigrateVirtualMachineWithContextFunc != nil {
+		return m.MigrateVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.MigrateVirtualMachine")
+	return
+}
+
+// MigrateVirtualMachineWithContext records the call and calls MigrateVirtualMachineWithContextFunc, or MigrateVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) MigrateVirtualMachineWithContext(ctx context.Context, p *cloudstack.MigrateVirtualMachineParams) (r0 *cloudstack.MigrateVirtualMachineResponse, r1 error) {
+	m.record("MigrateVirtualMachineWithContext", ctx, p)
+	if m.MigrateVirtualMachineWithContextFunc != nil {
+		return m.MigrateVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.MigrateVirtualMachineFunc != nil {
+		return m.MigrateVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.MigrateVirtualMachineWithContext")
+	return
+}
+
+// MigrateVirtualMachineAsync records the call and calls MigrateVirtualMachineAsyncFunc, or MigrateVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) MigrateVirtualMachineAsync(p *cloudstack.MigrateVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MigrateVirtualMachineAsync", p)
+	if m.MigrateVirtualMachineAsyncFunc != nil {
+		return m.MigrateVirtualMachineAsyncFunc(p)
+	}
+	if m.MigrateVirtualMachineAsyncWithContextFunc != nil {
+		return m.MigrateVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.MigrateVirtualMachineAsync")
+	return
+}
+
+// MigrateVirtualMachineAsyncWithContext records the call and calls MigrateVirtualMachineAsyncWithContextFunc, or MigrateVirtualMachineAsyncFunc if only that is set
+func (m *MockVirtualMachineService) MigrateVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.MigrateVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MigrateVirtualMachineAsyncWithContext", ctx, p)
+	if m.MigrateVirtualMachineAsyncWithContextFunc != nil {
+		return m.MigrateVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.MigrateVirtualMachineAsyncFunc != nil {
+		return m.MigrateVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.MigrateVirtualMachineAsyncWithContext")
+	return
+}
+
+// NewMigrateVirtualMachineWithVolumeParams records the call and calls NewMigrateVirtualMachineWithVolumeParamsFunc
+func (m *MockVirtualMachineService) NewMigrateVirtualMachineWithVolumeParams(hostid string, virtualmachineid string) (r0 *cloudstack.MigrateVirtualMachineWithVolumeParams) {
+	m.record("NewMigrateVirtualMachineWithVolumeParams", hostid, virtualmachineid)
+	if m.NewMigrateVirtualMachineWithVolumeParamsFunc != nil {
+		return m.NewMigrateVirtualMachineWithVolumeParamsFunc(hostid, virtualmachineid)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewMigrateVirtualMachineWithVolumeParams(hostid, virtualmachineid)
+}
+
+// MigrateVirtualMachineWithVolume records the call and calls MigrateVirtualMachineWithVolumeFunc, or MigrateVirtualMachineWithVolumeWithContextFunc if only that is set
+func (m *MockVirtualMachineService) MigrateVirtualMachineWithVolume(p *cloudstack.MigrateVirtualMachineWithVolumeParams) (r0 *cloudstack.MigrateVirtualMachineWithVolumeResponse, r1 error) {
+	m.record("MigrateVirtualMachineWithVolume", p)
+	if m.MigrateVirtualMachineWithVolumeFunc != nil {
+		return m.MigrateVirtualMachineWithVolumeFunc(p)
+	}
+	if m.MigrateVirtualMachineWithVolumeWithContextFunc != nil {
+		return m.MigrateVirtualMachineWithVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.MigrateVirtualMachineWithVolume")
+	return
+}
+
+// MigrateVirtualMachineWithVolumeWithContext records the call and calls MigrateVirtualMachineWithVolumeWithContextFunc, or MigrateVirtualMachineWithVolumeFunc if only that is set
+func (m *MockVirtualMachineService) MigrateVirtualMachineWithVolumeWithContext(ctx context.Context, p *cloudstack.MigrateVirtualMachineWithVolumeParams) (r0 *cloudstack.MigrateVirtualMachineWithVolumeResponse, r1 error) {
+	m.record("MigrateVirtualMachineWithVolumeWithContext", ctx, p)
+	if m.MigrateVirtualMachineWithVolumeWithContextFunc != nil {
+		return m.MigrateVirtualMachineWithVolumeWithContextFunc(ctx, p)
+	}
+	if m.MigrateVirtualMachineWithVolumeFunc != nil {
+		return m.MigrateVirtualMachineWithVolumeFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.MigrateVirtualMachineWithVolumeWithContext")
+	return
+}
+
+// MigrateVirtualMachineWithVolumeAsync records the call and calls MigrateVirtualMachineWithVolumeAsyncFunc, or MigrateVirtualMachineWithVolumeAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) MigrateVirtualMachineWithVolumeAsync(p *cloudstack.MigrateVirtualMachineWithVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MigrateVirtualMachineWithVolumeAsync", p)
+	if m.MigrateVirtualMachineWithVolumeAsyncFunc != nil {
+		return m.MigrateVirtualMachineWithVolumeAsyncFunc(p)
+	}
+	if m.MigrateVirtualMachineWithVolumeAsyncWithContextFunc != nil {
+		return m.MigrateVirtualMachineWithVolumeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.MigrateVirtualMachineWithVolumeAsync")
+	return
+}
+
+// MigrateVirtualMachineWithVolumeAsyncWithContext records the call and calls MigrateVirtualMachineWithVolumeAsyncWithContextFunc, or MigrateVirtualMachineWithVolumeAsyncFunc if only that is set
+func (m *MockVirtualMachineService) MigrateVirtualMachineWithVolumeAsyncWithContext(ctx context.Context, p *cloudstack.MigrateVirtualMachineWithVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MigrateVirtualMachineWithVolumeAsyncWithContext", ctx, p)
+	if m.MigrateVirtualMachineWithVolumeAsyncWithContextFunc != nil {
+		return m.MigrateVirtualMachineWithVolumeAsyncWithContextFunc(ctx, p)
+	}
+	if m.MigrateVirtualMachineWithVolumeAsyncFunc != nil {
+		return m.MigrateVirtualMachineWithVolumeAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.MigrateVirtualMachineWithVolumeAsyncWithContext")
+	return
+}
+
+// NewRebootVirtualMachineParams records the call and calls NewRebootVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewRebootVirtualMachineParams(id string) (r0 *cloudstack.RebootVirtualMachineParams) {
+	m.record("NewRebootVirtualMachineParams", id)
+	if m.NewRebootVirtualMachineParamsFunc != nil {
+		return m.NewRebootVirtualMachineParamsFunc(id)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewRebootVirtualMachineParams(id)
+}
+
+// RebootVirtualMachine records the call and calls RebootVirtualMachineFunc, or RebootVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) RebootVirtualMachine(p *cloudstack.RebootVirtualMachineParams) (r0 *cloudstack.RebootVirtualMachineResponse, r1 error) {
+	m.record("RebootVirtualMachine", p)
+	if m.RebootVirtualMachineFunc != nil {
+		return m.RebootVirtualMachineFunc(p)
+	}
+	if m.RebootVirtualMachineWithContextFunc != nil {
+		return m.RebootVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.RebootVirtualMachine")
+	return
+}
+
+// RebootVirtualMachineWithContext records the call and calls RebootVirtualMachineWithContextFunc, or RebootVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) RebootVirtualMachineWithContext(ctx context.Context, p *cloudstack.RebootVirtualMachineParams) (r0 *cloudstack.RebootVirtualMachineResponse, r1 error) {
+	m.record("RebootVirtualMachineWithContext", ctx, p)
+	if m.RebootVirtualMachineWithContextFunc != nil {
+		return m.RebootVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.RebootVirtualMachineFunc != nil {
+		return m.RebootVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.RebootVirtualMachineWithContext")
+	return
+}
+
+// RebootVirtualMachineAsync records the call and calls RebootVirtualMachineAsyncFunc, or RebootVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) RebootVirtualMachineAsync(p *cloudstack.RebootVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RebootVirtualMachineAsync", p)
+	if m.RebootVirtualMachineAsyncFunc != nil {
+		return m.RebootVirtualMachineAsyncFunc(p)
+	}
+	if m.RebootVirtualMachineAsyncWithContextFunc != nil {
+		return m.RebootVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.RebootVirtualMachineAsync")
+	return
+}
+
+// RebootVirtualMachineAsyncWithContext records the call and calls RebootVirtualMachineAsyncWithContextFunc, or RebootVirtualMachineAsyncFunc if only that is set
+func (m *MockVirtualMachineService) RebootVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.RebootVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RebootVirtualMachineAsyncWithContext", ctx, p)
+	if m.RebootVirtualMachineAsyncWithContextFunc != nil {
+		return m.RebootVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.RebootVirtualMachineAsyncFunc != nil {
+		return m.RebootVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.RebootVirtualMachineAsyncWithContext")
+	return
+}
+
+// NewRecoverVirtualMachineParams records the call and calls NewRecoverVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewRecoverVirtualMachineParams(id string) (r0 *cloudstack.RecoverVirtualMachineParams) {
+	m.record("NewRecoverVirtualMachineParams", id)
+	if m.NewRecoverVirtualMachineParamsFunc != nil {
+		return m.NewRecoverVirtualMachineParamsFunc(id)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewRecoverVirtualMachineParams(id)
+}
+
+// RecoverVirtualMachine records the call and calls RecoverVirtualMachineFunc, or RecoverVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) RecoverVirtualMachine(p *cloudstack.RecoverVirtualMachineParams) (r0 *cloudstack.RecoverVirtualMachineResponse, r1 error) {
+	m.record("RecoverVirtualMachine", p)
+	if m.RecoverVirtualMachineFunc != nil {
+		return m.RecoverVirtualMachineFunc(p)
+	}
+	if m.RecoverVirtualMachineWithContextFunc != nil {
+		return m.RecoverVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.RecoverVirtualMachine")
+	return
+}
+
+// RecoverVirtualMachineWithContext records the call and calls RecoverVirtualMachineWithContextFunc, or RecoverVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) RecoverVirtualMachineWithContext(ctx context.Context, p *cloudstack.RecoverVirtualMachineParams) (r0 *cloudstack.RecoverVirtualMachineResponse, r1 error) {
+	m.record("RecoverVirtualMachineWithContext", ctx, p)
+	if m.RecoverVirtualMachineWithContextFunc != nil {
+		return m.RecoverVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.RecoverVirtualMachineFunc != nil {
+		return m.RecoverVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.RecoverVirtualMachineWithContext")
+	return
+}
+
+// NewRemoveNicFromVirtualMachineParams records the call and calls NewRemoveNicFromVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewRemoveNicFromVirtualMachineParams(nicid string, virtualmachineid string) (r0 *cloudstack.RemoveNicFromVirtualMachineParams) {
+	m.record("NewRemoveNicFromVirtualMachineParams", nicid, virtualmachineid)
+	if m.NewRemoveNicFromVirtualMachineParamsFunc != nil {
+		return m.NewRemoveNicFromVirtualMachineParamsFunc(nicid, virtualmachineid)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewRemoveNicFromVirtualMachineParams(nicid, virtualmachineid)
+}
+
+// RemoveNicFromVirtualMachine records the call and calls RemoveNicFromVirtualMachineFunc, or RemoveNicFromVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) RemoveNicFromVirtualMachine(p *cloudstack.RemoveNicFromVirtualMachineParams) (r0 *cloudstack.RemoveNicFromVirtualMachineResponse, r1 error) {
+	m.record("RemoveNicFromVirtualMachine", p)
+	if m.RemoveNicFromVirtualMachineFunc != nil {
+		return m.RemoveNicFromVirtualMachineFunc(p)
+	}
+	if m.RemoveNicFromVirtualMachineWithContextFunc != nil {
+		return m.RemoveNicFromVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.RemoveNicFromVirtualMachine")
+	return
+}
+
+// RemoveNicFromVirtualMachineWithContext records the call and calls RemoveNicFromVirtualMachineWithContextFunc, or RemoveNicFromVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) RemoveNicFromVirtualMachineWithContext(ctx context.Context, p *cloudstack.RemoveNicFromVirtualMachineParams) (r0 *cloudstack.RemoveNicFromVirtualMachineResponse, r1 error) {
+	m.record("RemoveNicFromVirtualMachineWithContext", ctx, p)
+	if m.RemoveNicFromVirtualMachineWithContextFunc != nil {
+		return m.RemoveNicFromVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.RemoveNicFromVirtualMachineFunc != nil {
+		return m.RemoveNicFromVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.RemoveNicFromVirtualMachineWithContext")
+	return
+}
+
+// RemoveNicFromVirtualMachineAsync records the call and calls RemoveNicFromVirtualMachineAsyncFunc, or RemoveNicFromVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) RemoveNicFromVirtualMachineAsync(p *cloudstack.RemoveNicFromVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveNicFromVirtualMachineAsync", p)
+	if m.RemoveNicFromVirtualMachineAsyncFunc != nil {
+		return m.RemoveNicFromVirtualMachineAsyncFunc(p)
+	}
+	if m.RemoveNicFromVirtualMachineAsyncWithContextFunc != nil {
+		return m.RemoveNicFromVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.RemoveNicFromVirtualMachineAsync")
+	return
+}
+
+// RemoveNicFromVirtualMachineAsyncWithContext records the call and calls RemoveNicFromVirtualMachineAsyncWithContextFunc, or RemoveNicFromVirtualMachineAsyncFunc if only that is set
+func (m *MockVirtualMachineService) RemoveNicFromVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.RemoveNicFromVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RemoveNicFromVirtualMachineAsyncWithContext", ctx, p)
+	if m.RemoveNicFromVirtualMachineAsyncWithContextFunc != nil {
+		return m.RemoveNicFromVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.RemoveNicFromVirtualMachineAsyncFunc != nil {
+		return m.RemoveNicFromVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.RemoveNicFromVirtualMachineAsyncWithContext")
+	return
+}
+
+// NewResetPasswordForVirtualMachineParams records the call and calls NewResetPasswordForVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewResetPasswordForVirtualMachineParams(id string) (r0 *cloudstack.ResetPasswordForVirtualMachineParams) {
+	m.record("NewResetPasswordForVirtualMachineParams", id)
+	if m.NewResetPasswordForVirtualMachineParamsFunc != nil {
+		return m.NewResetPasswordForVirtualMachineParamsFunc(id)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewResetPasswordForVirtualMachineParams(id)
+}
+
+// ResetPasswordForVirtualMachine records the call and calls ResetPasswordForVirtualMachineFunc, or ResetPasswordForVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ResetPasswordForVirtualMachine(p *cloudstack.ResetPasswordForVirtualMachineParams) (r0 *cloudstack.ResetPasswordForVirtualMachineResponse, r1 error) {
+	m.record("ResetPasswordForVirtualMachine", p)
+	if m.ResetPasswordForVirtualMachineFunc != nil {
+		return m.ResetPasswordForVirtualMachineFunc(p)
+	}
+	if m.ResetPasswordForVirtualMachineWithContextFunc != nil {
+		return m.ResetPasswordForVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ResetPasswordForVirtualMachine")
+	return
+}
+
+// ResetPasswordForVirtualMachineWithContext records the call and calls ResetPasswordForVirtualMachineWithContextFunc, or ResetPasswordForVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) ResetPasswordForVirtualMachineWithContext(ctx context.Context, p *cloudstack.ResetPasswordForVirtualMachineParams) (r0 *cloudstack.ResetPasswordForVirtualMachineResponse, r1 error) {
+	m.record("ResetPasswordForVirtualMachineWithContext", ctx, p)
+	if m.ResetPasswordForVirtualMachineWithContextFunc != nil {
+		return m.ResetPasswordForVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.ResetPasswordForVirtualMachineFunc != nil {
+		return m.ResetPasswordForVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ResetPasswordForVirtualMachineWithContext")
+	return
+}
+
+// ResetPasswordForVirtualMachineAsync records the call and calls ResetPasswordForVirtualMachineAsyncFunc, or ResetPasswordForVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ResetPasswordForVirtualMachineAsync(p *cloudstack.ResetPasswordForVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ResetPasswordForVirtualMachineAsync", p)
+	if m.ResetPasswordForVirtualMachineAsyncFunc != nil {
+		return m.ResetPasswordForVirtualMachineAsyncFunc(p)
+	}
+	if m.ResetPasswordForVirtualMachineAsyncWithContextFunc != nil {
+		return m.ResetPasswordForVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ResetPasswordForVirtualMachineAsync")
+	return
+}
+
+// ResetPasswordForVirtualMachineAsyncWithContext records the call and calls ResetPasswordForVirtualMachineAsyncWithContextFunc, or ResetPasswordForVirtualMachineAsyncFunc if only that is set
+func (m *MockVirtualMachineService) ResetPasswordForVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.ResetPasswordForVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ResetPasswordForVirtualMachineAsyncWithContext", ctx, p)
+	if m.ResetPasswordForVirtualMachineAsyncWithContextFunc != nil {
+		return m.ResetPasswordForVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.ResetPasswordForVirtualMachineAsyncFunc != nil {
+		return m.ResetPasswordForVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ResetPasswordForVirtualMachineAsyncWithContext")
+	return
+}
+
+// NewRestoreVirtualMachineParams records the call and calls NewRestoreVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewRestoreVirtualMachineParams(virtualmachineid string) (r0 *cloudstack.RestoreVirtualMachineParams) {
+	m.record("NewRestoreVirtualMachineParams", virtualmachineid)
+	if m.NewRestoreVirtualMachineParamsFunc != nil {
+		return m.NewRestoreVirtualMachineParamsFunc(virtualmachineid)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewRestoreVirtualMachineParams(virtualmachineid)
+}
+
+// RestoreVirtualMachine records the call and calls RestoreVirtualMachineFunc, or RestoreVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) RestoreVirtualMachine(p *cloudstack.RestoreVirtualMachineParams) (r0 *cloudstack.RestoreVirtualMachineResponse, r1 error) {
+	m.record("RestoreVirtualMachine", p)
+	if m.RestoreVirtualMachineFunc != nil {
+		return m.RestoreVirtualMachineFunc(p)
+	}
+	if m.RestoreVirtualMachineWithContextFunc != nil {
+		return m.RestoreVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.RestoreVirtualMachine")
+	return
+}
+
+// RestoreVirtualMachineWithContext records the call and calls RestoreVirtualMachineWithContextFunc, or RestoreVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) RestoreVirtualMachineWithContext(ctx context.Context, p *cloudstack.RestoreVirtualMachineParams) (r0 *cloudstack.RestoreVirtualMachineResponse, r1 error) {
+	m.record("RestoreVirtualMachineWithContext", ctx, p)
+	if m.RestoreVirtualMachineWithContextFunc != nil {
+		return m.RestoreVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.RestoreVirtualMachineFunc != nil {
+		return m.RestoreVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.RestoreVirtualMachineWithContext")
+	return
+}
+
+// RestoreVirtualMachineAsync records the call and calls RestoreVirtualMachineAsyncFunc, or RestoreVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) RestoreVirtualMachineAsync(p *cloudstack.RestoreVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RestoreVirtualMachineAsync", p)
+	if m.RestoreVirtualMachineAsyncFunc != nil {
+		return m.RestoreVirtualMachineAsyncFunc(p)
+	}
+	if m.RestoreVirtualMachineAsyncWithContextFunc != nil {
+		return m.RestoreVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.RestoreVirtualMachineAsync")
+	return
+}
+
+// RestoreVirtualMachineAsyncWithContext records the call and calls RestoreVirtualMachineAsyncWithContextFunc, or RestoreVirtualMachineAsyncFunc if only that is set
+func (m *MockVirtualMachineService) RestoreVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.RestoreVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("RestoreVirtualMachineAsyncWithContext", ctx, p)
+	if m.RestoreVirtualMachineAsyncWithContextFunc != nil {
+		return m.RestoreVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.RestoreVirtualMachineAsyncFunc != nil {
+		return m.RestoreVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.RestoreVirtualMachineAsyncWithContext")
+	return
+}
+
+// NewScaleVirtualMachineParams records the call and calls NewScaleVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewScaleVirtualMachineParams(id string, serviceofferingid string) (r0 *cloudstack.ScaleVirtualMachineParams) {
+	m.record("NewScaleVirtualMachineParams", id, serviceofferingid)
+	if m.NewScaleVirtualMachineParamsFunc != nil {
+		return m.NewScaleVirtualMachineParamsFunc(id, serviceofferingid)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewScaleVirtualMachineParams(id, serviceofferingid)
+}
+
+// ScaleVirtualMachine records the call and calls ScaleVirtualMachineFunc, or ScaleVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ScaleVirtualMachine(p *cloudstack.ScaleVirtualMachineParams) (r0 *cloudstack.ScaleVirtualMachineResponse, r1 error) {
+	m.record("ScaleVirtualMachine", p)
+	if m.ScaleVirtualMachineFunc != nil {
+		return m.ScaleVirtualMachineFunc(p)
+	}
+	if m.ScaleVirtualMachineWithContextFunc != nil {
+		return m.ScaleVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ScaleVirtualMachine")
+	return
+}
+
+// ScaleVirtualMachineWithContext records the call and calls ScaleVirtualMachineWithContextFunc, or ScaleVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) ScaleVirtualMachineWithContext(ctx context.Context, p *cloudstack.ScaleVirtualMachineParams) (r0 *cloudstack.ScaleVirtualMachineResponse, r1 error) {
+	m.record("ScaleVirtualMachineWithContext", ctx, p)
+	if m.ScaleVirtualMachineWithContextFunc != nil {
+		return m.ScaleVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.ScaleVirtualMachineFunc != nil {
+		return m.ScaleVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ScaleVirtualMachineWithContext")
+	return
+}
+
+// ScaleVirtualMachineAsync records the call and calls ScaleVirtualMachineAsyncFunc, or ScaleVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) ScaleVirtualMachineAsync(p *cloudstack.ScaleVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ScaleVirtualMachineAsync", p)
+	if m.ScaleVirtualMachineAsyncFunc != nil {
+		return m.ScaleVirtualMachineAsyncFunc(p)
+	}
+	if m.ScaleVirtualMachineAsyncWithContextFunc != nil {
+		return m.ScaleVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.ScaleVirtualMachineAsync")
+	return
+}
+
+// ScaleVirtualMachineAsyncWithContext records the call and calls ScaleVirtualMachineAsyncWithContextFunc, or ScaleVirtualMachineAsyncFunc if only that is set
+func (m *MockVirtualMachineService) ScaleVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.ScaleVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ScaleVirtualMachineAsyncWithContext", ctx, p)
+	if m.ScaleVirtualMachineAsyncWithContextFunc != nil {
+		return m.ScaleVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.ScaleVirtualMachineAsyncFunc != nil {
+		return m.ScaleVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.ScaleVirtualMachineAsyncWithContext")
+	return
+}
+
+// NewStartVirtualMachineParams records the call and calls NewStartVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewStartVirtualMachineParams(id string) (r0 *cloudstack.StartVirtualMachineParams) {
+	m.record("NewStartVirtualMachineParams", id)
+	if m.NewStartVirtualMachineParamsFunc != nil {
+		return m.NewStartVirtualMachineParamsFunc(id)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewStartVirtualMachineParams(id)
+}
+
+// StartVirtualMachine records the call and calls StartVirtualMachineFunc, or StartVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) StartVirtualMachine(p *cloudstack.StartVirtualMachineParams) (r0 *cloudstack.StartVirtualMachineResponse, r1 error) {
+	m.record("StartVirtualMachine", p)
+	if m.StartVirtualMachineFunc != nil {
+		return m.StartVirtualMachineFunc(p)
+	}
+	if m.StartVirtualMachineWithContextFunc != nil {
+		return m.StartVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.StartVirtualMachine")
+	return
+}
+
+// StartVirtualMachineWithContext records the call and calls StartVirtualMachineWithContextFunc, or StartVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) StartVirtualMachineWithContext(ctx context.Context, p *cloudstack.StartVirtualMachineParams) (r0 *cloudstack.StartVirtualMachineResponse, r1 error) {
+	m.record("StartVirtualMachineWithContext", ctx, p)
+	if m.StartVirtualMachineWithContextFunc != nil {
+		return m.StartVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.StartVirtualMachineFunc != nil {
+		return m.StartVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.StartVirtualMachineWithContext")
+	return
+}
+
+// StartVirtualMachineAsync records the call and calls StartVirtualMachineAsyncFunc, or StartVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) StartVirtualMachineAsync(p *cloudstack.StartVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StartVirtualMachineAsync", p)
+	if m.StartVirtualMachineAsyncFunc != nil {
+		return m.StartVirtualMachineAsyncFunc(p)
+	}
+	if m.StartVirtualMachineAsyncWithContextFunc != nil {
+		return m.StartVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.StartVirtualMachineAsync")
+	return
+}
+
+// StartVirtualMachineAsyncWithContext records the call and calls StartVirtualMachineAsyncWithContextFunc, or StartVirtualMachineAsyncFunc if only that is set
+func (m *MockVirtualMachineService) StartVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.StartVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StartVirtualMachineAsyncWithContext", ctx, p)
+	if m.StartVirtualMachineAsyncWithContextFunc != nil {
+		return m.StartVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.StartVirtualMachineAsyncFunc != nil {
+		return m.StartVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.StartVirtualMachineAsyncWithContext")
+	return
+}
+
+// NewStopVirtualMachineParams records the call and calls NewStopVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewStopVirtualMachineParams(id string) (r0 *cloudstack.StopVirtualMachineParams) {
+	m.record("NewStopVirtualMachineParams", id)
+	if m.NewStopVirtualMachineParamsFunc != nil {
+		return m.NewStopVirtualMachineParamsFunc(id)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewStopVirtualMachineParams(id)
+}
+
+// StopVirtualMachine records the call and calls StopVirtualMachineFunc, or StopVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) StopVirtualMachine(p *cloudstack.StopVirtualMachineParams) (r0 *cloudstack.StopVirtualMachineResponse, r1 error) {
+	m.record("StopVirtualMachine", p)
+	if m.StopVirtualMachineFunc != nil {
+		return m.StopVirtualMachineFunc(p)
+	}
+	if m.StopVirtualMachineWithContextFunc != nil {
+		return m.StopVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.StopVirtualMachine")
+	return
+}
+
+// StopVirtualMachineWithContext records the call and calls StopVirtualMachineWithContextFunc, or StopVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) StopVirtualMachineWithContext(ctx context.Context, p *cloudstack.StopVirtualMachineParams) (r0 *cloudstack.StopVirtualMachineResponse, r1 error) {
+	m.record("StopVirtualMachineWithContext", ctx, p)
+	if m.StopVirtualMachineWithContextFunc != nil {
+		return m.StopVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.StopVirtualMachineFunc != nil {
+		return m.StopVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.StopVirtualMachineWithContext")
+	return
+}
+
+// StopVirtualMachineAsync records the call and calls StopVirtualMachineAsyncFunc, or StopVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) StopVirtualMachineAsync(p *cloudstack.StopVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StopVirtualMachineAsync", p)
+	if m.StopVirtualMachineAsyncFunc != nil {
+		return m.StopVirtualMachineAsyncFunc(p)
+	}
+	if m.StopVirtualMachineAsyncWithContextFunc != nil {
+		return m.StopVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.StopVirtualMachineAsync")
+	return
+}
+
+// StopVirtualMachineAsyncWithContext records the call and calls StopVirtualMachineAsyncWithContextFunc, or StopVirtualMachineAsyncFunc if only that is set
+func (m *MockVirtualMachineService) StopVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.StopVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("StopVirtualMachineAsyncWithContext", ctx, p)
+	if m.StopVirtualMachineAsyncWithContextFunc != nil {
+		return m.StopVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.StopVirtualMachineAsyncFunc != nil {
+		return m.StopVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.StopVirtualMachineAsyncWithContext")
+	return
+}
+
+// NewUnmanageVirtualMachineParams records the call and calls NewUnmanageVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewUnmanageVirtualMachineParams(id string) (r0 *cloudstack.UnmanageVirtualMachineParams) {
+	m.record("NewUnmanageVirtualMachineParams", id)
+	if m.NewUnmanageVirtualMachineParamsFunc != nil {
+		return m.NewUnmanageVirtualMachineParamsFunc(id)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewUnmanageVirtualMachineParams(id)
+}
+
+// UnmanageVirtualMachine records the call and calls UnmanageVirtualMachineFunc, or UnmanageVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) UnmanageVirtualMachine(p *cloudstack.UnmanageVirtualMachineParams) (r0 *cloudstack.UnmanageVirtualMachineResponse, r1 error) {
+	m.record("UnmanageVirtualMachine", p)
+	if m.UnmanageVirtualMachineFunc != nil {
+		return m.UnmanageVirtualMachineFunc(p)
+	}
+	if m.UnmanageVirtualMachineWithContextFunc != nil {
+		return m.UnmanageVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.UnmanageVirtualMachine")
+	return
+}
+
+// UnmanageVirtualMachineWithContext records the call and calls UnmanageVirtualMachineWithContextFunc, or UnmanageVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) UnmanageVirtualMachineWithContext(ctx context.Context, p *cloudstack.UnmanageVirtualMachineParams) (r0 *cloudstack.UnmanageVirtualMachineResponse, r1 error) {
+	m.record("UnmanageVirtualMachineWithContext", ctx, p)
+	if m.UnmanageVirtualMachineWithContextFunc != nil {
+		return m.UnmanageVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.UnmanageVirtualMachineFunc != nil {
+		return m.UnmanageVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.UnmanageVirtualMachineWithContext")
+	return
+}
+
+// UnmanageVirtualMachineAsync records the call and calls UnmanageVirtualMachineAsyncFunc, or UnmanageVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) UnmanageVirtualMachineAsync(p *cloudstack.UnmanageVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UnmanageVirtualMachineAsync", p)
+	if m.UnmanageVirtualMachineAsyncFunc != nil {
+		return m.UnmanageVirtualMachineAsyncFunc(p)
+	}
+	if m.UnmanageVirtualMachineAsyncWithContextFunc != nil {
+		return m.UnmanageVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.UnmanageVirtualMachineAsync")
+	return
+}
+
+// UnmanageVirtualMachineAsyncWithContext records the call and calls UnmanageVirtualMachineAsyncWithContextFunc, or UnmanageVirtualMachineAsyncFunc if only that is set
+func (m *MockVirtualMachineService) UnmanageVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.UnmanageVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UnmanageVirtualMachineAsyncWithContext", ctx, p)
+	if m.UnmanageVirtualMachineAsyncWithContextFunc != nil {
+		return m.UnmanageVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.UnmanageVirtualMachineAsyncFunc != nil {
+		return m.UnmanageVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.UnmanageVirtualMachineAsyncWithContext")
+	return
+}
+
+// NewUpdateDefaultNicForVirtualMachineParams records the call and calls NewUpdateDefaultNicForVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewUpdateDefaultNicForVirtualMachineParams(nicid string, virtualmachineid string) (r0 *cloudstack.UpdateDefaultNicForVirtualMachineParams) {
+	m.record("NewUpdateDefaultNicForVirtualMachineParams", nicid, virtualmachineid)
+	if m.NewUpdateDefaultNicForVirtualMachineParamsFunc != nil {
+		return m.NewUpdateDefaultNicForVirtualMachineParamsFunc(nicid, virtualmachineid)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewUpdateDefaultNicForVirtualMachineParams(nicid, virtualmachineid)
+}
+
+// UpdateDefaultNicForVirtualMachine records the call and calls UpdateDefaultNicForVirtualMachineFunc, or UpdateDefaultNicForVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) UpdateDefaultNicForVirtualMachine(p *cloudstack.UpdateDefaultNicForVirtualMachineParams) (r0 *cloudstack.UpdateDefaultNicForVirtualMachineResponse, r1 error) {
+	m.record("UpdateDefaultNicForVirtualMachine", p)
+	if m.UpdateDefaultNicForVirtualMachineFunc != nil {
+		return m.UpdateDefaultNicForVirtualMachineFunc(p)
+	}
+	if m.UpdateDefaultNicForVirtualMachineWithContextFunc != nil {
+		return m.UpdateDefaultNicForVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.UpdateDefaultNicForVirtualMachine")
+	return
+}
+
+// UpdateDefaultNicForVirtualMachineWithContext records the call and calls UpdateDefaultNicForVirtualMachineWithContextFunc, or UpdateDefaultNicForVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) UpdateDefaultNicForVirtualMachineWithContext(ctx context.Context, p *cloudstack.UpdateDefaultNicForVirtualMachineParams) (r0 *cloudstack.UpdateDefaultNicForVirtualMachineResponse, r1 error) {
+	m.record("UpdateDefaultNicForVirtualMachineWithContext", ctx, p)
+	if m.UpdateDefaultNicForVirtualMachineWithContextFunc != nil {
+		return m.UpdateDefaultNicForVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.UpdateDefaultNicForVirtualMachineFunc != nil {
+		return m.UpdateDefaultNicForVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.UpdateDefaultNicForVirtualMachineWithContext")
+	return
+}
+
+// UpdateDefaultNicForVirtualMachineAsync records the call and calls UpdateDefaultNicForVirtualMachineAsyncFunc, or UpdateDefaultNicForVirtualMachineAsyncWithContextFunc if only that is set
+func (m *MockVirtualMachineService) UpdateDefaultNicForVirtualMachineAsync(p *cloudstack.UpdateDefaultNicForVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateDefaultNicForVirtualMachineAsync", p)
+	if m.UpdateDefaultNicForVirtualMachineAsyncFunc != nil {
+		return m.UpdateDefaultNicForVirtualMachineAsyncFunc(p)
+	}
+	if m.UpdateDefaultNicForVirtualMachineAsyncWithContextFunc != nil {
+		return m.UpdateDefaultNicForVirtualMachineAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.UpdateDefaultNicForVirtualMachineAsync")
+	return
+}
+
+// UpdateDefaultNicForVirtualMachineAsyncWithContext records the call and calls UpdateDefaultNicForVirtualMachineAsyncWithContextFunc, or UpdateDefaultNicForVirtualMachineAsyncFunc if only that is set
+func (m *MockVirtualMachineService) UpdateDefaultNicForVirtualMachineAsyncWithContext(ctx context.Context, p *cloudstack.UpdateDefaultNicForVirtualMachineParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateDefaultNicForVirtualMachineAsyncWithContext", ctx, p)
+	if m.UpdateDefaultNicForVirtualMachineAsyncWithContextFunc != nil {
+		return m.UpdateDefaultNicForVirtualMachineAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateDefaultNicForVirtualMachineAsyncFunc != nil {
+		return m.UpdateDefaultNicForVirtualMachineAsyncFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.UpdateDefaultNicForVirtualMachineAsyncWithContext")
+	return
+}
+
+// NewUpdateVirtualMachineParams records the call and calls NewUpdateVirtualMachineParamsFunc
+func (m *MockVirtualMachineService) NewUpdateVirtualMachineParams(id string) (r0 *cloudstack.UpdateVirtualMachineParams) {
+	m.record("NewUpdateVirtualMachineParams", id)
+	if m.NewUpdateVirtualMachineParamsFunc != nil {
+		return m.NewUpdateVirtualMachineParamsFunc(id)
+	}
+	return cloudstack.NewVirtualMachineService(nil).NewUpdateVirtualMachineParams(id)
+}
+
+// UpdateVirtualMachine records the call and calls UpdateVirtualMachineFunc, or UpdateVirtualMachineWithContextFunc if only that is set
+func (m *MockVirtualMachineService) UpdateVirtualMachine(p *cloudstack.UpdateVirtualMachineParams) (r0 *cloudstack.UpdateVirtualMachineResponse, r1 error) {
+	m.record("UpdateVirtualMachine", p)
+	if m.UpdateVirtualMachineFunc != nil {
+		return m.UpdateVirtualMachineFunc(p)
+	}
+	if m.UpdateVirtualMachineWithContextFunc != nil {
+		return m.UpdateVirtualMachineWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VirtualMachineService.UpdateVirtualMachine")
+	return
+}
+
+// UpdateVirtualMachineWithContext records the call and calls UpdateVirtualMachineWithContextFunc, or UpdateVirtualMachineFunc if only that is set
+func (m *MockVirtualMachineService) UpdateVirtualMachineWithContext(ctx context.Context, p *cloudstack.UpdateVirtualMachineParams) (r0 *cloudstack.UpdateVirtualMachineResponse, r1 error) {
+	m.record("UpdateVirtualMachineWithContext", ctx, p)
+	if m.UpdateVirtualMachineWithContextFunc != nil {
+		return m.UpdateVirtualMachineWithContextFunc(ctx, p)
+	}
+	if m.UpdateVirtualMachineFunc != nil {
+		return m.UpdateVirtualMachineFunc(p)
+	}
+	r1 = notMocked("VirtualMachineService.UpdateVirtualMachineWithContext")
+	return
+}
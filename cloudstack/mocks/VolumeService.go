@@ -0,0 +1,1219 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockVolumeService is a mock of cloudstack.VolumeServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockVolumeService struct {
+	Recorder
+
+	NewAttachVolumeParamsFunc                       func(id string, virtualmachineid string) *cloudstack.AttachVolumeParams
+	AttachVolumeFunc                                func(p *cloudstack.AttachVolumeParams) (*cloudstack.AttachVolumeResponse, error)
+	AttachVolumeWithContextFunc                     func(ctx context.Context, p *cloudstack.AttachVolumeParams) (*cloudstack.AttachVolumeResponse, error)
+	AttachVolumeAsyncFunc                           func(p *cloudstack.AttachVolumeParams) (*cloudstack.Job, error)
+	AttachVolumeAsyncWithContextFunc                func(ctx context.Context, p *cloudstack.AttachVolumeParams) (*cloudstack.Job, error)
+	NewCreateVolumeParamsFunc                       func() *cloudstack.CreateVolumeParams
+	CreateVolumeFunc                                func(p *cloudstack.CreateVolumeParams) (*cloudstack.CreateVolumeResponse, error)
+	CreateVolumeWithContextFunc                     func(ctx context.Context, p *cloudstack.CreateVolumeParams) (*cloudstack.CreateVolumeResponse, error)
+	CreateVolumeAsyncFunc                           func(p *cloudstack.CreateVolumeParams) (*cloudstack.Job, error)
+	CreateVolumeAsyncWithContextFunc                func(ctx context.Context, p *cloudstack.CreateVolumeParams) (*cloudstack.Job, error)
+	NewDeleteVolumeParamsFunc                       func(id string) *cloudstack.DeleteVolumeParams
+	DeleteVolumeFunc                                func(p *cloudstack.DeleteVolumeParams) (*cloudstack.DeleteVolumeResponse, error)
+	DeleteVolumeWithContextFunc                     func(ctx context.Context, p *cloudstack.DeleteVolumeParams) (*cloudstack.DeleteVolumeResponse, error)
+	NewDestroyVolumeParamsFunc                      func(id string) *cloudstack.DestroyVolumeParams
+	DestroyVolumeFunc                               func(p *cloudstack.DestroyVolumeParams) (*cloudstack.DestroyVolumeResponse, error)
+	DestroyVolumeWithContextFunc                    func(ctx context.Context, p *cloudstack.DestroyVolumeParams) (*cloudstack.DestroyVolumeResponse, error)
+	DestroyVolumeAsyncFunc                          func(p *cloudstack.DestroyVolumeParams) (*cloudstack.Job, error)
+	DestroyVolumeAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.DestroyVolumeParams) (*cloudstack.Job, error)
+	NewDetachVolumeParamsFunc                       func() *cloudstack.DetachVolumeParams
+	DetachVolumeFunc                                func(p *cloudstack.DetachVolumeParams) (*cloudstack.DetachVolumeResponse, error)
+	DetachVolumeWithContextFunc                     func(ctx context.Context, p *cloudstack.DetachVolumeParams) (*cloudstack.DetachVolumeResponse, error)
+	DetachVolumeAsyncFunc                           func(p *cloudstack.DetachVolumeParams) (*cloudstack.Job, error)
+	DetachVolumeAsyncWithContextFunc                func(ctx context.Context, p *cloudstack.DetachVolumeParams) (*cloudstack.Job, error)
+	NewExtractVolumeParamsFunc                      func(id string, mode string, zoneid string) *cloudstack.ExtractVolumeParams
+	ExtractVolumeFunc                               func(p *cloudstack.ExtractVolumeParams) (*cloudstack.ExtractVolumeResponse, error)
+	ExtractVolumeWithContextFunc                    func(ctx context.Context, p *cloudstack.ExtractVolumeParams) (*cloudstack.ExtractVolumeResponse, error)
+	ExtractVolumeAsyncFunc                          func(p *cloudstack.ExtractVolumeParams) (*cloudstack.Job, error)
+	ExtractVolumeAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.ExtractVolumeParams) (*cloudstack.Job, error)
+	NewGetPathForVolumeParamsFunc                   func(volumeid string) *cloudstack.GetPathForVolumeParams
+	GetPathForVolumeFunc                            func(p *cloudstack.GetPathForVolumeParams) (*cloudstack.GetPathForVolumeResponse, error)
+	GetPathForVolumeWithContextFunc                 func(ctx context.Context, p *cloudstack.GetPathForVolumeParams) (*cloudstack.GetPathForVolumeResponse, error)
+	NewGetSolidFireVolumeAccessGroupIdsParamsFunc   func(clusterid string, storageid string) *cloudstack.GetSolidFireVolumeAccessGroupIdsParams
+	GetSolidFireVolumeAccessGroupIdsFunc            func(p *cloudstack.GetSolidFireVolumeAccessGroupIdsParams) (*cloudstack.GetSolidFireVolumeAccessGroupIdsResponse, error)
+	GetSolidFireVolumeAccessGroupIdsWithContextFunc func(ctx context.Context, p *cloudstack.GetSolidFireVolumeAccessGroupIdsParams) (*cloudstack.GetSolidFireVolumeAccessGroupIdsResponse, error)
+	NewGetSolidFireVolumeSizeParamsFunc             func(volumeid string) *cloudstack.GetSolidFireVolumeSizeParams
+	GetSolidFireVolumeSizeFunc                      func(p *cloudstack.GetSolidFireVolumeSizeParams) (*cloudstack.GetSolidFireVolumeSizeResponse, error)
+	GetSolidFireVolumeSizeWithContextFunc           func(ctx context.Context, p *cloudstack.GetSolidFireVolumeSizeParams) (*cloudstack.GetSolidFireVolumeSizeResponse, error)
+	NewGetUploadParamsForVolumeParamsFunc           func(format string, name string, zoneid string) *cloudstack.GetUploadParamsForVolumeParams
+	GetUploadParamsForVolumeFunc                    func(p *cloudstack.GetUploadParamsForVolumeParams) (*cloudstack.GetUploadParamsForVolumeResponse, error)
+	GetUploadParamsForVolumeWithContextFunc         func(ctx context.Context, p *cloudstack.GetUploadParamsForVolumeParams) (*cloudstack.GetUploadParamsForVolumeResponse, error)
+	NewGetVolumeiScsiNameParamsFunc                 func(volumeid string) *cloudstack.GetVolumeiScsiNameParams
+	GetVolumeiScsiNameFunc                          func(p *cloudstack.GetVolumeiScsiNameParams) (*cloudstack.GetVolumeiScsiNameResponse, error)
+	GetVolumeiScsiNameWithContextFunc               func(ctx context.Context, p *cloudstack.GetVolumeiScsiNameParams) (*cloudstack.GetVolumeiScsiNameResponse, error)
+	NewListVolumesParamsFunc                        func() *cloudstack.ListVolumesParams
+	GetVolumeIDFunc                                 func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVolumeIDWithContextFunc                      func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVolumeByNameFunc                             func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Volume, int, error)
+	GetVolumeByNameWithContextFunc                  func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.Volume, int, error)
+	GetVolumeByIDFunc                               func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Volume, int, error)
+	GetVolumeByIDWithContextFunc                    func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Volume, int, error)
+	ListVolumesFunc                                 func(p *cloudstack.ListVolumesParams) (*cloudstack.ListVolumesResponse, error)
+	ListVolumesWithContextFunc                      func(ctx context.Context, p *cloudstack.ListVolumesParams) (*cloudstack.ListVolumesResponse, error)
+	NewListVolumesPagerFunc                         func(p *cloudstack.ListVolumesParams) *cloudstack.ListVolumesPager
+	ListVolumesAllFunc                              func(p *cloudstack.ListVolumesParams) (*cloudstack.ListVolumesResponse, error)
+	ListVolumesAllWithContextFunc                   func(ctx context.Context, p *cloudstack.ListVolumesParams) (*cloudstack.ListVolumesResponse, error)
+	NewListVolumesMetricsParamsFunc                 func() *cloudstack.ListVolumesMetricsParams
+	GetVolumesMetricIDFunc                          func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVolumesMetricIDWithContextFunc               func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetVolumesMetricByNameFunc                      func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.VolumesMetric, int, error)
+	GetVolumesMetricByNameWithContextFunc           func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.VolumesMetric, int, error)
+	GetVolumesMetricByIDFunc                        func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.VolumesMetric, int, error)
+	GetVolumesMetricByIDWithContextFunc             func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.VolumesMetric, int, error)
+	ListVolumesMetricsFunc                          func(p *cloudstack.ListVolumesMetricsParams) (*cloudstack.ListVolumesMetricsResponse, error)
+	ListVolumesMetricsWithContextFunc               func(ctx context.Context, p *cloudstack.ListVolumesMetricsParams) (*cloudstack.ListVolumesMetricsResponse, error)
+	NewListVolumesMetricsPagerFunc                  func(p *cloudstack.ListVolumesMetricsParams) *cloudstack.ListVolumesMetricsPager
+	ListVolumesMetricsAllFunc                       func(p *cloudstack.ListVolumesMetricsParams) (*cloudstack.ListVolumesMetricsResponse, error)
+	ListVolumesMetricsAllWithContextFunc            func(ctx context.Context, p *cloudstack.ListVolumesMetricsParams) (*cloudstack.ListVolumesMetricsResponse, error)
+	NewMigrateVolumeParamsFunc                      func(storageid string, volumeid string) *cloudstack.MigrateVolumeParams
+	MigrateVolumeFunc                               func(p *cloudstack.MigrateVolumeParams) (*cloudstack.MigrateVolumeResponse, error)
+	MigrateVolumeWithContextFunc                    func(ctx context.Context, p *cloudstack.MigrateVolumeParams) (*cloudstack.MigrateVolumeResponse, error)
+	MigrateVolumeAsyncFunc                          func(p *cloudstack.MigrateVolumeParams) (*cloudstack.Job, error)
+	MigrateVolumeAsyncWithContextFunc               func(ctx context.Context, p *cloudstack.MigrateVolumeParams) (*cloudstack.Job, error)
+	NewRecoverVolumeParamsFunc                      func(id string) *cloudstack.RecoverVolumeParams
+	RecoverVolumeFunc                               func(p *cloudstack.RecoverVolumeParams) (*cloudstack.RecoverVolumeResponse, error)
+	RecoverVolumeWithContextFunc                    func(ctx context.Context, p *cloudstack.RecoverVolumeParams) (*cloudstack.RecoverVolumeResponse, error)
+	NewResizeVolumeParamsFunc                       func(id string) *cloudstack.ResizeVolumeParams
+	ResizeVolumeFunc                                func(p *cloudstack.ResizeVolumeParams) (*cloudstack.ResizeVolumeResponse, error)
+	ResizeVolumeWithContextFunc                     func(ctx context.Context, p *cloudstack.ResizeVolumeParams) (*cloudstack.ResizeVolumeResponse, error)
+	ResizeVolumeAsyncFunc                           func(p *cloudstack.ResizeVolumeParams) (*cloudstack.Job, error)
+	ResizeVolumeAsyncWithContextFunc                func(ctx context.Context, p *cloudstack.ResizeVolumeParams) (*cloudstack.Job, error)
+	NewUpdateVolumeParamsFunc                       func() *cloudstack.UpdateVolumeParams
+	UpdateVolumeFunc                                func(p *cloudstack.UpdateVolumeParams) (*cloudstack.UpdateVolumeResponse, error)
+	UpdateVolumeWithContextFunc                     func(ctx context.Context, p *cloudstack.UpdateVolumeParams) (*cloudstack.UpdateVolumeResponse, error)
+	UpdateVolumeAsyncFunc                           func(p *cloudstack.UpdateVolumeParams) (*cloudstack.Job, error)
+	UpdateVolumeAsyncWithContextFunc                func(ctx context.Context, p *cloudstack.UpdateVolumeParams) (*cloudstack.Job, error)
+	NewUploadVolumeParamsFunc                       func(format string, name string, url string, zoneid string) *cloudstack.UploadVolumeParams
+	UploadVolumeFunc                                func(p *cloudstack.UploadVolumeParams) (*cloudstack.UploadVolumeResponse, error)
+	UploadVolumeWithContextFunc                     func(ctx context.Context, p *cloudstack.UploadVolumeParams) (*cloudstack.UploadVolumeResponse, error)
+	UploadVolumeAsyncFunc                           func(p *cloudstack.UploadVolumeParams) (*cloudstack.Job, error)
+	UploadVolumeAsyncWithContextFunc                func(ctx context.Context, p *cloudstack.UploadVolumeParams) (*cloudstack.Job, error)
+}
+
+var _ cloudstack.VolumeServiceIface = (*MockVolumeService)(nil)
+
+// NewAttachVolumeParams records the call and calls NewAttachVolumeParamsFunc
+func (m *MockVolumeService) NewAttachVolumeParams(id string, virtualmachineid string) (r0 *cloudstack.AttachVolumeParams) {
+	m.record("NewAttachVolumeParams", id, virtualmachineid)
+	if m.NewAttachVolumeParamsFunc != nil {
+		return m.NewAttachVolumeParamsFunc(id, virtualmachineid)
+	}
+	return cloudstack.NewVolumeService(nil).NewAttachVolumeParams(id, virtualmachineid)
+}
+
+// AttachVolume records the call and calls AttachVolumeFunc, or AttachVolumeWithContextFunc if only that is set
+func (m *MockVolumeService) AttachVolume(p *cloudstack.AttachVolumeParams) (r0 *cloudstack.AttachVolumeResponse, r1 error) {
+	m.record("AttachVolume", p)
+	if m.AttachVolumeFunc != nil {
+		return m.AttachVolumeFunc(p)
+	}
+	if m.AttachVolumeWithContextFunc != nil {
+		return m.AttachVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.AttachVolume")
+	return
+}
+
+// AttachVolumeWithContext records the call and calls AttachVolumeWithContextFunc, or AttachVolumeFunc if only that is set
+func (m *MockVolumeService) AttachVolumeWithContext(ctx context.Context, p *cloudstack.AttachVolumeParams) (r0 *cloudstack.AttachVolumeResponse, r1 error) {
+	m.record("AttachVolumeWithContext", ctx, p)
+	if m.AttachVolumeWithContextFunc != nil {
+		return m.AttachVolumeWithContextFunc(ctx, p)
+	}
+	if m.AttachVolumeFunc != nil {
+		return m.AttachVolumeFunc(p)
+	}
+	r1 = notMocked("VolumeService.AttachVolumeWithContext")
+	return
+}
+
+// AttachVolumeAsync records the call and calls AttachVolumeAsyncFunc, or AttachVolumeAsyncWithContextFunc if only that is set
+func (m *MockVolumeService) AttachVolumeAsync(p *cloudstack.AttachVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AttachVolumeAsync", p)
+	if m.AttachVolumeAsyncFunc != nil {
+		return m.AttachVolumeAsyncFunc(p)
+	}
+	if m.AttachVolumeAsyncWithContextFunc != nil {
+		return m.AttachVolumeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.AttachVolumeAsync")
+	return
+}
+
+// AttachVolumeAsyncWithContext records the call and calls AttachVolumeAsyncWithContextFunc, or AttachVolumeAsyncFunc if only that is set
+func (m *MockVolumeService) AttachVolumeAsyncWithContext(ctx context.Context, p *cloudstack.AttachVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("AttachVolumeAsyncWithContext", ctx, p)
+	if m.AttachVolumeAsyncWithContextFunc != nil {
+		return m.AttachVolumeAsyncWithContextFunc(ctx, p)
+	}
+	if m.AttachVolumeAsyncFunc != nil {
+		return m.AttachVolumeAsyncFunc(p)
+	}
+	r1 = notMocked("VolumeService.AttachVolumeAsyncWithContext")
+	return
+}
+
+// NewCreateVolumeParams records the call and calls NewCreateVolumeParamsFunc
+func (m *MockVolumeService) NewCreateVolumeParams() (r0 *cloudstack.CreateVolumeParams) {
+	m.record("NewCreateVolumeParams")
+	if m.NewCreateVolumeParamsFunc != nil {
+		return m.NewCreateVolumeParamsFunc()
+	}
+	return cloudstack.NewVolumeService(nil).NewCreateVolumeParams()
+}
+
+// CreateVolume records the call and calls CreateVolumeFunc, or CreateVolumeWithContextFunc if only that is set
+func (m *MockVolumeService) CreateVolume(p *cloudstack.CreateVolumeParams) (r0 *cloudstack.CreateVolumeResponse, r1 error) {
+	m.record("CreateVolume", p)
+	if m.CreateVolumeFunc != nil {
+		return m.CreateVolumeFunc(p)
+	}
+	if m.CreateVolumeWithContextFunc != nil {
+		return m.CreateVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.CreateVolume")
+	return
+}
+
+// CreateVolumeWithContext records the call and calls CreateVolumeWithContextFunc, or CreateVolumeFunc if only that is set
+func (m *MockVolumeService) CreateVolumeWithContext(ctx context.Context, p *cloudstack.CreateVolumeParams) (r0 *cloudstack.CreateVolumeResponse, r1 error) {
+	m.record("CreateVolumeWithContext", ctx, p)
+	if m.CreateVolumeWithContextFunc != nil {
+		return m.CreateVolumeWithContextFunc(ctx, p)
+	}
+	if m.CreateVolumeFunc != nil {
+		return m.CreateVolumeFunc(p)
+	}
+	r1 = notMocked("VolumeService.CreateVolumeWithContext")
+	return
+}
+
+// CreateVolumeAsync records the call and calls CreateVolumeAsyncFunc, or CreateVolumeAsyncWithContextFunc if only that is set
+func (m *MockVolumeService) CreateVolumeAsync(p *cloudstack.CreateVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVolumeAsync", p)
+	if m.CreateVolumeAsyncFunc != nil {
+		return m.CreateVolumeAsyncFunc(p)
+	}
+	if m.CreateVolumeAsyncWithContextFunc != nil {
+		return m.CreateVolumeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.CreateVolumeAsync")
+	return
+}
+
+// CreateVolumeAsyncWithContext records the call and calls CreateVolumeAsyncWithContextFunc, or CreateVolumeAsyncFunc if only that is set
+func (m *MockVolumeService) CreateVolumeAsyncWithContext(ctx context.Context, p *cloudstack.CreateVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("CreateVolumeAsyncWithContext", ctx, p)
+	if m.CreateVolumeAsyncWithContextFunc != nil {
+		return m.CreateVolumeAsyncWithContextFunc(ctx, p)
+	}
+	if m.CreateVolumeAsyncFunc != nil {
+		return m.CreateVolumeAsyncFunc(p)
+	}
+	r1 = notMocked("VolumeService.CreateVolumeAsyncWithContext")
+	return
+}
+
+// NewDeleteVolumeParams records the call and calls NewDeleteVolumeParamsFunc
+func (m *MockVolumeService) NewDeleteVolumeParams(id string) (r0 *cloudstack.DeleteVolumeParams) {
+	m.record("NewDeleteVolumeParams", id)
+	if m.NewDeleteVolumeParamsFunc != nil {
+		return m.NewDeleteVolumeParamsFunc(id)
+	}
+	return cloudstack.NewVolumeService(nil).NewDeleteVolumeParams(id)
+}
+
+// DeleteVolume records the call and calls DeleteVolumeFunc, or DeleteVolumeWithContextFunc if only that is set
+func (m *MockVolumeService) DeleteVolume(p *cloudstack.DeleteVolumeParams) (r0 *cloudstack.DeleteVolumeResponse, r1 error) {
+	m.record("DeleteVolume", p)
+	if m.DeleteVolumeFunc != nil {
+		return m.DeleteVolumeFunc(p)
+	}
+	if m.DeleteVolumeWithContextFunc != nil {
+		return m.DeleteVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.DeleteVolume")
+	return
+}
+
+// DeleteVolumeWithContext records the call and calls DeleteVolumeWithContextFunc, or DeleteVolumeFunc if only that is set
+func (m *MockVolumeService) DeleteVolumeWithContext(ctx context.Context, p *cloudstack.DeleteVolumeParams) (r0 *cloudstack.DeleteVolumeResponse, r1 error) {
+	m.record("DeleteVolumeWithContext", ctx, p)
+	if m.DeleteVolumeWithContextFunc != nil {
+		return m.DeleteVolumeWithContextFunc(ctx, p)
+	}
+	if m.DeleteVolumeFunc != nil {
+		return m.DeleteVolumeFunc(p)
+	}
+	r1 = notMocked("VolumeService.DeleteVolumeWithContext")
+	return
+}
+
+// NewDestroyVolumeParams records the call and calls NewDestroyVolumeParamsFunc
+func (m *MockVolumeService) NewDestroyVolumeParams(id string) (r0 *cloudstack.DestroyVolumeParams) {
+	m.record("NewDestroyVolumeParams", id)
+	if m.NewDestroyVolumeParamsFunc != nil {
+		return m.NewDestroyVolumeParamsFunc(id)
+	}
+	return cloudstack.NewVolumeService(nil).NewDestroyVolumeParams(id)
+}
+
+// DestroyVolume records the call and calls DestroyVolumeFunc, or DestroyVolumeWithContextFunc if only that is set
+func (m *MockVolumeService) DestroyVolume(p *cloudstack.DestroyVolumeParams) (r0 *cloudstack.DestroyVolumeResponse, r1 error) {
+	m.record("DestroyVolume", p)
+	if m.DestroyVolumeFunc != nil {
+		return m.DestroyVolumeFunc(p)
+	}
+	if m.DestroyVolumeWithContextFunc != nil {
+		return m.DestroyVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.DestroyVolume")
+	return
+}
+
+// DestroyVolumeWithContext records the call and calls DestroyVolumeWithContextFunc, or DestroyVolumeFunc if only that is set
+func (m *MockVolumeService) DestroyVolumeWithContext(ctx context.Context, p *cloudstack.DestroyVolumeParams) (r0 *cloudstack.DestroyVolumeResponse, r1 error) {
+	m.record("DestroyVolumeWithContext", ctx, p)
+	if m.DestroyVolumeWithContextFunc != nil {
+		return m.DestroyVolumeWithContextFunc(ctx, p)
+	}
+	if m.DestroyVolumeFunc != nil {
+		return m.DestroyVolumeFunc(p)
+	}
+	r1 = notMocked("VolumeService.DestroyVolumeWithContext")
+	return
+}
+
+// DestroyVolumeAsync records the call and calls DestroyVolumeAsyncFunc, or DestroyVolumeAsyncWithContextFunc if only that is set
+func (m *MockVolumeService) DestroyVolumeAsync(p *cloudstack.DestroyVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DestroyVolumeAsync", p)
+	if m.DestroyVolumeAsyncFunc != nil {
+		return m.DestroyVolumeAsyncFunc(p)
+	}
+	if m.DestroyVolumeAsyncWithContextFunc != nil {
+		return m.DestroyVolumeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.DestroyVolumeAsync")
+	return
+}
+
+// DestroyVolumeAsyncWithContext records the call and calls DestroyVolumeAsyncWithContextFunc, or DestroyVolumeAsyncFunc if only that is set
+func (m *MockVolumeService) DestroyVolumeAsyncWithContext(ctx context.Context, p *cloudstack.DestroyVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DestroyVolumeAsyncWithContext", ctx, p)
+	if m.DestroyVolumeAsyncWithContextFunc != nil {
+		return m.DestroyVolumeAsyncWithContextFunc(ctx, p)
+	}
+	if m.DestroyVolumeAsyncFunc != nil {
+		return m.DestroyVolumeAsyncFunc(p)
+	}
+	r1 = notMocked("VolumeService.DestroyVolumeAsyncWithContext")
+	return
+}
+
+// NewDetachVolumeParams records the call and calls NewDetachVolumeParamsFunc
+func (m *MockVolumeService) NewDetachVolumeParams() (r0 *cloudstack.DetachVolumeParams) {
+	m.record("NewDetachVolumeParams")
+	if m.NewDetachVolumeParamsFunc != nil {
+		return m.NewDetachVolumeParamsFunc()
+	}
+	return cloudstack.NewVolumeService(nil).NewDetachVolumeParams()
+}
+
+// DetachVolume records the call and calls DetachVolumeFunc, or DetachVolumeWithContextFunc if only that is set
+func (m *MockVolumeService) DetachVolume(p *cloudstack.DetachVolumeParams) (r0 *cloudstack.DetachVolumeResponse, r1 error) {
+	m.record("DetachVolume", p)
+	if m.DetachVolumeFunc != nil {
+		return m.DetachVolumeFunc(p)
+	}
+	if m.DetachVolumeWithContextFunc != nil {
+		return m.DetachVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.DetachVolume")
+	return
+}
+
+// DetachVolumeWithContext records the call and calls DetachVolumeWithContextFunc, or DetachVolumeFunc if only that is set
+func (m *MockVolumeService) DetachVolumeWithContext(ctx context.Context, p *cloudstack.DetachVolumeParams) (r0 *cloudstack.DetachVolumeResponse, r1 error) {
+	m.record("DetachVolumeWithContext", ctx, p)
+	if m.DetachVolumeWithContextFunc != nil {
+		return m.DetachVolumeWithContextFunc(ctx, p)
+	}
+	if m.DetachVolumeFunc != nil {
+		return m.DetachVolumeFunc(p)
+	}
+	r1 = notMocked("VolumeService.DetachVolumeWithContext")
+	return
+}
+
+// DetachVolumeAsync records the call and calls DetachVolumeAsyncFunc, or DetachVolumeAsyncWithContextFunc if only that is set
+func (m *MockVolumeService) DetachVolumeAsync(p *cloudstack.DetachVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DetachVolumeAsync", p)
+	if m.DetachVolumeAsyncFunc != nil {
+		return m.DetachVolumeAsyncFunc(p)
+	}
+	if m.DetachVolumeAsyncWithContextFunc != nil {
+		return m.DetachVolumeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.DetachVolumeAsync")
+	return
+}
+
+// DetachVolumeAsyncWithContext records the call and calls DetachVolumeAsyncWithContextFunc, or DetachVolumeAsyncFunc if only that is set
+func (m *MockVolumeService) DetachVolumeAsyncWithContext(ctx context.Context, p *cloudstack.DetachVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DetachVolumeAsyncWithContext", ctx, p)
+	if m.DetachVolumeAsyncWithContextFunc != nil {
+		return m.DetachVolumeAsyncWithContextFunc(ctx, p)
+	}
+	if m.DetachVolumeAsyncFunc != nil {
+		return m.DetachVolumeAsyncFunc(p)
+	}
+	r1 = notMocked("VolumeService.DetachVolumeAsyncWithContext")
+	return
+}
+
+// NewExtractVolumeParams records the call and calls NewExtractVolumeParamsFunc
+func (m *MockVolumeService) NewExtractVolumeParams(id string, mode string, zoneid string) (r0 *cloudstack.ExtractVolumeParams) {
+	m.record("NewExtractVolumeParams", id, mode, zoneid)
+	if m.NewExtractVolumeParamsFunc != nil {
+		return m.NewExtractVolumeParamsFunc(id, mode, zoneid)
+	}
+	return cloudstack.NewVolumeService(nil).NewExtractVolumeParams(id, mode, zoneid)
+}
+
+// ExtractVolume records the call and calls ExtractVolumeFunc, or ExtractVolumeWithContextFunc if only that is set
+func (m *MockVolumeService) ExtractVolume(p *cloudstack.ExtractVolumeParams) (r0 *cloudstack.ExtractVolumeResponse, r1 error) {
+	m.record("ExtractVolume", p)
+	if m.ExtractVolumeFunc != nil {
+		return m.ExtractVolumeFunc(p)
+	}
+	if m.ExtractVolumeWithContextFunc != nil {
+		return m.ExtractVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.ExtractVolume")
+	return
+}
+
+// ExtractVolumeWithContext records the call and calls ExtractVolumeWithContextFunc, or ExtractVolumeFunc if only that is set
+func (m *MockVolumeService) ExtractVolumeWithContext(ctx context.Context, p *cloudstack.ExtractVolumeParams) (r0 *cloudstack.ExtractVolumeResponse, r1 error) {
+	m.record("ExtractVolumeWithContext", ctx, p)
+	if m.ExtractVolumeWithContextFunc != nil {
+		return m.ExtractVolumeWithContextFunc(ctx, p)
+	}
+	if m.ExtractVolumeFunc != nil {
+		return m.ExtractVolumeFunc(p)
+	}
+	r1 = notMocked("VolumeService.ExtractVolumeWithContext")
+	return
+}
+
+// ExtractVolumeAsync records the call and calls ExtractVolumeAsyncFunc, or ExtractVolumeAsyncWithContextFunc if only that is set
+func (m *MockVolumeService) ExtractVolumeAsync(p *cloudstack.ExtractVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ExtractVolumeAsync", p)
+	if m.ExtractVolumeAsyncFunc != nil {
+		return m.ExtractVolumeAsyncFunc(p)
+	}
+	if m.ExtractVolumeAsyncWithContextFunc != nil {
+		return m.ExtractVolumeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.ExtractVolumeAsync")
+	return
+}
+
+// ExtractVolumeAsyncWithContext records the call and calls ExtractVolumeAsyncWithContextFunc, or ExtractVolumeAsyncFunc if only that is set
+func (m *MockVolumeService) ExtractVolumeAsyncWithContext(ctx context.Context, p *cloudstack.ExtractVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ExtractVolumeAsyncWithContext", ctx, p)
+	if m.ExtractVolumeAsyncWithContextFunc != nil {
+		return m.ExtractVolumeAsyncWithContextFunc(ctx, p)
+	}
+	if m.ExtractVolumeAsyncFunc != nil {
+		return m.ExtractVolumeAsyncFunc(p)
+	}
+	r1 = notMocked("VolumeService.ExtractVolumeAsyncWithContext")
+	return
+}
+
+// NewGetPathForVolumeParams records the call and calls NewGetPathForVolumeParamsFunc
+func (m *MockVolumeService) NewGetPathForVolumeParams(volumeid string) (r0 *cloudstack.GetPathForVolumeParams) {
+	m.record("NewGetPathForVolumeParams", volumeid)
+	if m.NewGetPathForVolumeParamsFunc != nil {
+		return m.NewGetPathForVolumeParamsFunc(volumeid)
+	}
+	return cloudstack.NewVolumeService(nil).NewGetPathForVolumeParams(volumeid)
+}
+
+// GetPathForVolume records the call and calls GetPathForVolumeFunc, or GetPathForVolumeWithContextFunc if only that is set
+func (m *MockVolumeService) GetPathForVolume(p *cloudstack.GetPathForVolumeParams) (r0 *cloudstack.GetPathForVolumeResponse, r1 error) {
+	m.record("GetPathForVolume", p)
+	if m.GetPathForVolumeFunc != nil {
+		return m.GetPathForVolumeFunc(p)
+	}
+	if m.GetPathForVolumeWithContextFunc != nil {
+		return m.GetPathForVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.GetPathForVolume")
+	return
+}
+
+// GetPathForVolumeWithContext records the call and calls GetPathForVolumeWithContextFunc, or GetPathForVolumeFunc if only that is set
+func (m *MockVolumeService) GetPathForVolumeWithContext(ctx context.Context, p *cloudstack.GetPathForVolumeParams) (r0 *cloudstack.GetPathForVolumeResponse, r1 error) {
+	m.record("GetPathForVolumeWithContext", ctx, p)
+	if m.GetPathForVolumeWithContextFunc != nil {
+		return m.GetPathForVolumeWithContextFunc(ctx, p)
+	}
+	if m.GetPathForVolumeFunc != nil {
+		return m.GetPathForVolumeFunc(p)
+	}
+	r1 = notMocked("VolumeService.GetPathForVolumeWithContext")
+	return
+}
+
+// NewGetSolidFireVolumeAccessGroupIdsParams records the call and calls NewGetSolidFireVolumeAccessGroupIdsParamsFunc
+func (m *MockVolumeService) NewGetSolidFireVolumeAccessGroupIdsParams(clusterid string, storageid string) (r0 *cloudstack.GetSolidFireVolumeAccessGroupIdsParams) {
+	m.record("NewGetSolidFireVolumeAccessGroupIdsParams", clusterid, storageid)
+	if m.NewGetSolidFireVolumeAccessGroupIdsParamsFunc != nil {
+		return m.NewGetSolidFireVolumeAccessGroupIdsParamsFunc(clusterid, storageid)
+	}
+	return cloudstack.NewVolumeService(nil).NewGetSolidFireVolumeAccessGroupIdsParams(clusterid, storageid)
+}
+
+// GetSolidFireVolumeAccessGroupIds records the call and calls GetSolidFireVolumeAccessGroupIdsFunc, or GetSolidFireVolumeAccessGroupIdsWithContextFunc if only that is set
+func (m *MockVolumeService) GetSolidFireVolumeAccessGroupIds(p *cloudstack.GetSolidFireVolumeAccessGroupIdsParams) (r0 *cloudstack.GetSolidFireVolumeAccessGroupIdsResponse, r1 error) {
+	m.record("GetSolidFireVolumeAccessGroupIds", p)
+	if m.GetSolidFireVolumeAccessGroupIdsFunc != nil {
+		return m.GetSolidFireVolumeAccessGroupIdsFunc(p)
+	}
+	if m.GetSolidFireVolumeAccessGroupIdsWithContextFunc != nil {
+		return m.GetSolidFireVolumeAccessGroupIdsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.GetSolidFireVolumeAccessGroupIds")
+	return
+}
+
+// GetSolidFireVolumeAccessGroupIdsWithContext records the call and calls GetSolidFireVolumeAccessGroupIdsWithContextFunc, or GetSolidFireVolumeAccessGroupIdsFunc if only that is set
+func (m *MockVolumeService) GetSolidFireVolumeAccessGroupIdsWithContext(ctx context.Context, p *cloudstack.GetSolidFireVolumeAccessGroupIdsParams) (r0 *cloudstack.GetSolidFireVolumeAccessGroupIdsResponse, r1 error) {
+	m.record("GetSolidFireVolumeAccessGroupIdsWithContext", ctx, p)
+	if m.GetSolidFireVolumeAccessGroupIdsWithContextFunc != nil {
+		return m.GetSolidFireVolumeAccessGroupIdsWithContextFunc(ctx, p)
+	}
+	if m.GetSolidFireVolumeAccessGroupIdsFunc != nil {
+		return m.GetSolidFireVolumeAccessGroupIdsFunc(p)
+	}
+	r1 = notMocked("VolumeService.GetSolidFireVolumeAccessGroupIdsWithContext")
+	return
+}
+
+// NewGetSolidFireVolumeSizeParams records the call and calls NewGetSolidFireVolumeSizeParamsFunc
+func (m *MockVolumeService) NewGetSolidFireVolumeSizeParams(volumeid string) (r0 *cloudstack.GetSolidFireVolumeSizeParams) {
+	m.record("NewGetSolidFireVolumeSizeParams", volumeid)
+	if m.NewGetSolidFireVolumeSizeParamsFunc != nil {
+		return m.NewGetSolidFireVolumeSizeParamsFunc(volumeid)
+	}
+	return cloudstack.NewVolumeService(nil).NewGetSolidFireVolumeSizeParams(volumeid)
+}
+
+// GetSolidFireVolumeSize records the call and calls GetSolidFireVolumeSizeFunc, or GetSolidFireVolumeSizeWithContextFunc if only that is set
+func (m *MockVolumeService) GetSolidFireVolumeSize(p *cloudstack.GetSolidFireVolumeSizeParams) (r0 *cloudstack.GetSolidFireVolumeSizeResponse, r1 error) {
+	m.record("GetSolidFireVolumeSize", p)
+	if m.GetSolidFireVolumeSizeFunc != nil {
+		return m.GetSolidFireVolumeSizeFunc(p)
+	}
+	if m.GetSolidFireVolumeSizeWithContextFunc != nil {
+		return m.GetSolidFireVolumeSizeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.GetSolidFireVolumeSize")
+	return
+}
+
+// GetSolidFireVolumeSizeWithContext records the call and calls GetSolidFireVolumeSizeWithContextFunc, or GetSolidFireVolumeSizeFunc if only that is set
+func (m *MockVolumeService) GetSolidFireVolumeSizeWithContext(ctx context.Context, p *cloudstack.GetSolidFireVolumeSizeParams) (r0 *cloudstack.GetSolidFireVolumeSizeResponse, r1 error) {
+	m.record("GetSolidFireVolumeSizeWithContext", ctx, p)
+	if m.GetSolidFireVolumeSizeWithContextFunc != nil {
+		return m.GetSolidFireVolumeSizeWithContextFunc(ctx, p)
+	}
+	if m.GetSolidFireVolumeSizeFunc != nil {
+		return m.GetSolidFireVolumeSizeFunc(p)
+	}
+	r1 = notMocked("VolumeService.GetSolidFireVolumeSizeWithContext")
+	return
+}
+
+// NewGetUploadParamsForVolumeParams records the call and calls NewGetUploadParamsForVolumeParamsFunc
+func (m *MockVolumeService) NewGetUploadParamsForVolumeParams(format string, name string, zoneid string) (r0 *cloudstack.GetUploadParamsForVolumeParams) {
+	m.record("NewGetUploadParamsForVolumeParams", format, name, zoneid)
+	if m.NewGetUploadParamsForVolumeParamsFunc != nil {
+		return m.NewGetUploadParamsForVolumeParamsFunc(format, name, zoneid)
+	}
+	return cloudstack.NewVolumeService(nil).NewGetUploadParamsForVolumeParams(format, name, zoneid)
+}
+
+// GetUploadParamsForVolume records the call and calls GetUploadParamsForVolumeFunc, or GetUploadParamsForVolumeWithContextFunc if only that is set
+func (m *MockVolumeService) GetUploadParamsForVolume(p *cloudstack.GetUploadParamsForVolumeParams) (r0 *cloudstack.GetUploadParamsForVolumeResponse, r1 error) {
+	m.record("GetUploadParamsForVolume", p)
+	if m.GetUploadParamsForVolumeFunc != nil {
+		return m.GetUploadParamsForVolumeFunc(p)
+	}
+	if m.GetUploadParamsForVolumeWithContextFunc != nil {
+		return m.GetUploadParamsForVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.GetUploadParamsForVolume")
+	return
+}
+
+// GetUploadParamsForVolumeWithContext records the call and calls GetUploadParamsForVolumeWithContextFunc, or GetUploadParamsForVolumeFunc if only that is set
+func (m *MockVolumeService) GetUploadParamsForVolumeWithContext(ctx context.Context, p *cloudstack.GetUploadParamsForVolumeParams) (r0 *cloudstack.GetUploadParamsForVolumeResponse, r1 error) {
+	m.record("GetUploadParamsForVolumeWithContext", ctx, p)
+	if m.GetUploadParamsForVolumeWithContextFunc != nil {
+		return m.GetUploadParamsForVolumeWithContextFunc(ctx, p)
+	}
+	if m.GetUploadParamsForVolumeFunc != nil {
+		return m.GetUploadParamsForVolumeFunc(p)
+	}
+	r1 = notMocked("VolumeService.GetUploadParamsForVolumeWithContext")
+	return
+}
+
+// NewGetVolumeiScsiNameParams records the call and calls NewGetVolumeiScsiNameParamsFunc
+func (m *MockVolumeService) NewGetVolumeiScsiNameParams(volumeid string) (r0 *cloudstack.GetVolumeiScsiNameParams) {
+	m.record("NewGetVolumeiScsiNameParams", volumeid)
+	if m.NewGetVolumeiScsiNameParamsFunc != nil {
+		return m.NewGetVolumeiScsiNameParamsFunc(volumeid)
+	}
+	return cloudstack.NewVolumeService(nil).NewGetVolumeiScsiNameParams(volumeid)
+}
+
+// GetVolumeiScsiName records the call and calls GetVolumeiScsiNameFunc, or GetVolumeiScsiNameWithContextFunc if only that is set
+func (m *MockVolumeService) GetVolumeiScsiName(p *cloudstack.GetVolumeiScsiNameParams) (r0 *cloudstack.GetVolumeiScsiNameResponse, r1 error) {
+	m.record("GetVolumeiScsiName", p)
+	if m.GetVolumeiScsiNameFunc != nil {
+		return m.GetVolumeiScsiNameFunc(p)
+	}
+	if m.GetVolumeiScsiNameWithContextFunc != nil {
+		return m.GetVolumeiScsiNameWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.GetVolumeiScsiName")
+	return
+}
+
+// GetVolumeiScsiNameWithContext records the call and calls GetVolumeiScsiNameWithContextFunc, or GetVolumeiScsiNameFunc if only that is set
+func (m *MockVolumeService) GetVolumeiScsiNameWithContext(ctx context.Context, p *cloudstack.GetVolumeiScsiNameParams) (r0 *cloudstack.GetVolumeiScsiNameResponse, r1 error) {
+	m.record("GetVolumeiScsiNameWithContext", ctx, p)
+	if m.GetVolumeiScsiNameWithContextFunc != nil {
+		return m.GetVolumeiScsiNameWithContextFunc(ctx, p)
+	}
+	if m.GetVolumeiScsiNameFunc != nil {
+		return m.GetVolumeiScsiNameFunc(p)
+	}
+	r1 = notMocked("VolumeService.GetVolumeiScsiNameWithContext")
+	return
+}
+
+// NewListVolumesParams records the call and calls NewListVolumesParamsFunc
+func (m *MockVolumeService) NewListVolumesParams() (r0 *cloudstack.ListVolumesParams) {
+	m.record("NewListVolumesParams")
+	if m.NewListVolumesParamsFunc != nil {
+		return m.NewListVolumesParamsFunc()
+	}
+	return cloudstack.NewVolumeService(nil).NewListVolumesParams()
+}
+
+// GetVolumeID records the call and calls GetVolumeIDFunc, or GetVolumeIDWithContextFunc if only that is set
+func (m *MockVolumeService) GetVolumeID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVolumeID", name, opts)
+	if m.GetVolumeIDFunc != nil {
+		return m.GetVolumeIDFunc(name, opts...)
+	}
+	if m.GetVolumeIDWithContextFunc != nil {
+		return m.GetVolumeIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VolumeService.GetVolumeID")
+	return
+}
+
+// GetVolumeIDWithContext records the call and calls GetVolumeIDWithContextFunc, or GetVolumeIDFunc if only that is set
+func (m *MockVolumeService) GetVolumeIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVolumeIDWithContext", ctx, name, opts)
+	if m.GetVolumeIDWithContextFunc != nil {
+		return m.GetVolumeIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetVolumeIDFunc != nil {
+		return m.GetVolumeIDFunc(name, opts...)
+	}
+	r2 = notMocked("VolumeService.GetVolumeIDWithContext")
+	return
+}
+
+// GetVolumeByName records the call and calls GetVolumeByNameFunc, or GetVolumeByNameWithContextFunc if only that is set
+func (m *MockVolumeService) GetVolumeByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Volume, r1 int, r2 error) {
+	m.record("GetVolumeByName", name, opts)
+	if m.GetVolumeByNameFunc != nil {
+		return m.GetVolumeByNameFunc(name, opts...)
+	}
+	if m.GetVolumeByNameWithContextFunc != nil {
+		return m.GetVolumeByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VolumeService.GetVolumeByName")
+	return
+}
+
+// GetVolumeByNameWithContext records the call and calls GetVolumeByNameWithContextFunc, or GetVolumeByNameFunc if only that is set
+func (m *MockVolumeService) GetVolumeByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Volume, r1 int, r2 error) {
+	m.record("GetVolumeByNameWithContext", ctx, name, opts)
+	if m.GetVolumeByNameWithContextFunc != nil {
+		return m.GetVolumeByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetVolumeByNameFunc != nil {
+		return m.GetVolumeByNameFunc(name, opts...)
+	}
+	r2 = notMocked("VolumeService.GetVolumeByNameWithContext")
+	return
+}
+
+// GetVolumeByID records the call and calls GetVolumeByIDFunc, or GetVolumeByIDWithContextFunc if only that is set
+func (m *MockVolumeService) GetVolumeByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Volume, r1 int, r2 error) {
+	m.record("GetVolumeByID", id, opts)
+	if m.GetVolumeByIDFunc != nil {
+		return m.GetVolumeByIDFunc(id, opts...)
+	}
+	if m.GetVolumeByIDWithContextFunc != nil {
+		return m.GetVolumeByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VolumeService.GetVolumeByID")
+	return
+}
+
+// GetVolumeByIDWithContext records the call and calls GetVolumeByIDWithContextFunc, or GetVolumeByIDFunc if only that is set
+func (m *MockVolumeService) GetVolumeByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Volume, r1 int, r2 error) {
+	m.record("GetVolumeByIDWithContext", ctx, id, opts)
+	if m.GetVolumeByIDWithContextFunc != nil {
+		return m.GetVolumeByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetVolumeByIDFunc != nil {
+		return m.GetVolumeByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VolumeService.GetVolumeByIDWithContext")
+	return
+}
+
+// ListVolumes records the call and calls ListVolumesFunc, or ListVolumesWithContextFunc if only that is set
+func (m *MockVolumeService) ListVolumes(p *cloudstack.ListVolumesParams) (r0 *cloudstack.ListVolumesResponse, r1 error) {
+	m.record("ListVolumes", p)
+	if m.ListVolumesFunc != nil {
+		return m.ListVolumesFunc(p)
+	}
+	if m.ListVolumesWithContextFunc != nil {
+		return m.ListVolumesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.ListVolumes")
+	return
+}
+
+// ListVolumesWithContext records the call and calls ListVolumesWithContextFunc, or ListVolumesFunc if only that is set
+func (m *MockVolumeService) ListVolumesWithContext(ctx context.Context, p *cloudstack.ListVolumesParams) (r0 *cloudstack.ListVolumesResponse, r1 error) {
+	m.record("ListVolumesWithContext", ctx, p)
+	if m.ListVolumesWithContextFunc != nil {
+		return m.ListVolumesWithContextFunc(ctx, p)
+	}
+	if m.ListVolumesFunc != nil {
+		return m.ListVolumesFunc(p)
+	}
+	r1 = notMocked("VolumeService.ListVolumesWithContext")
+	return
+}
+
+// NewListVolumesPager records the call and calls NewListVolumesPagerFunc
+func (m *MockVolumeService) NewListVolumesPager(p *cloudstack.ListVolumesParams) (r0 *cloudstack.ListVolumesPager) {
+	m.record("NewListVolumesPager", p)
+	if m.NewListVolumesPagerFunc != nil {
+		return m.NewListVolumesPagerFunc(p)
+	}
+	return
+}
+
+// ListVolumesAll records the call and calls ListVolumesAllFunc, or ListVolumesAllWithContextFunc if only that is set
+func (m *MockVolumeService) ListVolumesAll(p *cloudstack.ListVolumesParams) (r0 *cloudstack.ListVolumesResponse, r1 error) {
+	m.record("ListVolumesAll", p)
+	if m.ListVolumesAllFunc != nil {
+		return m.ListVolumesAllFunc(p)
+	}
+	if m.ListVolumesAllWithContextFunc != nil {
+		return m.ListVolumesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.ListVolumesAll")
+	return
+}
+
+// ListVolumesAllWithContext records the call and calls ListVolumesAllWithContextFunc, or ListVolumesAllFunc if only that is set
+func (m *MockVolumeService) ListVolumesAllWithContext(ctx context.Context, p *cloudstack.ListVolumesParams) (r0 *cloudstack.ListVolumesResponse, r1 error) {
+	m.record("ListVolumesAllWithContext", ctx, p)
+	if m.ListVolumesAllWithContextFunc != nil {
+		return m.ListVolumesAllWithContextFunc(ctx, p)
+	}
+	if m.ListVolumesAllFunc != nil {
+		return m.ListVolumesAllFunc(p)
+	}
+	r1 = notMocked("VolumeService.ListVolumesAllWithContext")
+	return
+}
+
+// NewListVolumesMetricsParams records the call and calls NewListVolumesMetricsParamsFunc
+func (m *MockVolumeService) NewListVolumesMetricsParams() (r0 *cloudstack.ListVolumesMetricsParams) {
+	m.record("NewListVolumesMetricsParams")
+	if m.NewListVolumesMetricsParamsFunc != nil {
+		return m.NewListVolumesMetricsParamsFunc()
+	}
+	return cloudstack.NewVolumeService(nil).NewListVolumesMetricsParams()
+}
+
+// GetVolumesMetricID records the call and calls GetVolumesMetricIDFunc, or GetVolumesMetricIDWithContextFunc if only that is set
+func (m *MockVolumeService) GetVolumesMetricID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVolumesMetricID", name, opts)
+	if m.GetVolumesMetricIDFunc != nil {
+		return m.GetVolumesMetricIDFunc(name, opts...)
+	}
+	if m.GetVolumesMetricIDWithContextFunc != nil {
+		return m.GetVolumesMetricIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VolumeService.GetVolumesMetricID")
+	return
+}
+
+// GetVolumesMetricIDWithContext records the call and calls GetVolumesMetricIDWithContextFunc, or GetVolumesMetricIDFunc if only that is set
+func (m *MockVolumeService) GetVolumesMetricIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetVolumesMetricIDWithContext", ctx, name, opts)
+	if m.GetVolumesMetricIDWithContextFunc != nil {
+		return m.GetVolumesMetricIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetVolumesMetricIDFunc != nil {
+		return m.GetVolumesMetricIDFunc(name, opts...)
+	}
+	r2 = notMocked("VolumeService.GetVolumesMetricIDWithContext")
+	return
+}
+
+// GetVolumesMetricByName records the call and calls GetVolumesMetricByNameFunc, or GetVolumesMetricByNameWithContextFunc if only that is set
+func (m *MockVolumeService) GetVolumesMetricByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VolumesMetric, r1 int, r2 error) {
+	m.record("GetVolumesMetricByName", name, opts)
+	if m.GetVolumesMetricByNameFunc != nil {
+		return m.GetVolumesMetricByNameFunc(name, opts...)
+	}
+	if m.GetVolumesMetricByNameWithContextFunc != nil {
+		return m.GetVolumesMetricByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("VolumeService.GetVolumesMetricByName")
+	return
+}
+
+// GetVolumesMetricByNameWithContext records the call and calls GetVolumesMetricByNameWithContextFunc, or GetVolumesMetricByNameFunc if only that is set
+func (m *MockVolumeService) GetVolumesMetricByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VolumesMetric, r1 int, r2 error) {
+	m.record("GetVolumesMetricByNameWithContext", ctx, name, opts)
+	if m.GetVolumesMetricByNameWithContextFunc != nil {
+		return m.GetVolumesMetricByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetVolumesMetricByNameFunc != nil {
+		return m.GetVolumesMetricByNameFunc(name, opts...)
+	}
+	r2 = notMocked("VolumeService.GetVolumesMetricByNameWithContext")
+	return
+}
+
+// GetVolumesMetricByID records the call and calls GetVolumesMetricByIDFunc, or GetVolumesMetricByIDWithContextFunc if only that is set
+func (m *MockVolumeService) GetVolumesMetricByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VolumesMetric, r1 int, r2 error) {
+	m.record("GetVolumesMetricByID", id, opts)
+	if m.GetVolumesMetricByIDFunc != nil {
+		return m.GetVolumesMetricByIDFunc(id, opts...)
+	}
+	if m.GetVolumesMetricByIDWithContextFunc != nil {
+		return m.GetVolumesMetricByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("VolumeService.GetVolumesMetricByID")
+	return
+}
+
+// GetVolumesMetricByIDWithContext records the call and calls GetVolumesMetricByIDWithContextFunc, or GetVolumesMetricByIDFunc if only that is set
+func (m *MockVolumeService) GetVolumesMetricByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.VolumesMetric, r1 int, r2 error) {
+	m.record("GetVolumesMetricByIDWithContext", ctx, id, opts)
+	if m.GetVolumesMetricByIDWithContextFunc != nil {
+		return m.GetVolumesMetricByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetVolumesMetricByIDFunc != nil {
+		return m.GetVolumesMetricByIDFunc(id, opts...)
+	}
+	r2 = notMocked("VolumeService.GetVolumesMetricByIDWithContext")
+	return
+}
+
+// ListVolumesMetrics records the call and calls ListVolumesMetricsFunc, or ListVolumesMetricsWithContextFunc if only that is set
+func (m *MockVolumeService) ListVolumesMetrics(p *cloudstack.ListVolumesMetricsParams) (r0 *cloudstack.ListVolumesMetricsResponse, r1 error) {
+	m.record("ListVolumesMetrics", p)
+	if m.ListVolumesMetricsFunc != nil {
+		return m.ListVolumesMetricsFunc(p)
+	}
+	if m.ListVolumesMetricsWithContextFunc != nil {
+		return m.ListVolumesMetricsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.ListVolumesMetrics")
+	return
+}
+
+// ListVolumesMetricsWithContext records the call and calls ListVolumesMetricsWithContextFunc, or ListVolumesMetricsFunc if only that is set
+func (m *MockVolumeService) ListVolumesMetricsWithContext(ctx context.Context, p *cloudstack.ListVolumesMetricsParams) (r0 *cloudstack.ListVolumesMetricsResponse, r1 error) {
+	m.record("ListVolumesMetricsWithContext", ctx, p)
+	if m.ListVolumesMetricsWithContextFunc != nil {
+		return m.ListVolumesMetricsWithContextFunc(ctx, p)
+	}
+	if m.ListVolumesMetricsFunc != nil {
+		return m.ListVolumesMetricsFunc(p)
+	}
+	r1 = notMocked("VolumeService.ListVolumesMetricsWithContext")
+	return
+}
+
+// NewListVolumesMetricsPager records the call and calls NewListVolumesMetricsPagerFunc
+func (m *MockVolumeService) NewListVolumesMetricsPager(p *cloudstack.ListVolumesMetricsParams) (r0 *cloudstack.ListVolumesMetricsPager) {
+	m.record("NewListVolumesMetricsPager", p)
+	if m.NewListVolumesMetricsPagerFunc != nil {
+		return m.NewListVolumesMetricsPagerFunc(p)
+	}
+	return
+}
+
+// ListVolumesMetricsAll records the call and calls ListVolumesMetricsAllFunc, or ListVolumesMetricsAllWithContextFunc if only that is set
+func (m *MockVolumeService) ListVolumesMetricsAll(p *cloudstack.ListVolumesMetricsParams) (r0 *cloudstack.ListVolumesMetricsResponse, r1 error) {
+	m.record("ListVolumesMetricsAll", p)
+	if m.ListVolumesMetricsAllFunc != nil {
+		return m.ListVolumesMetricsAllFunc(p)
+	}
+	if m.ListVolumesMetricsAllWithContextFunc != nil {
+		return m.ListVolumesMetricsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.ListVolumesMetricsAll")
+	return
+}
+
+// ListVolumesMetricsAllWithContext records the call and calls ListVolumesMetricsAllWithContextFunc, or ListVolumesMetricsAllFunc if only that is set
+func (m *MockVolumeService) ListVolumesMetricsAllWithContext(ctx context.Context, p *cloudstack.ListVolumesMetricsParams) (r0 *cloudstack.ListVolumesMetricsResponse, r1 error) {
+	m.record("ListVolumesMetricsAllWithContext", ctx, p)
+	if m.ListVolumesMetricsAllWithContextFunc != nil {
+		return m.ListVolumesMetricsAllWithContextFunc(ctx, p)
+	}
+	if m.ListVolumesMetricsAllFunc != nil {
+		return m.ListVolumesMetricsAllFunc(p)
+	}
+	r1 = notMocked("VolumeService.ListVolumesMetricsAllWithContext")
+	return
+}
+
+// NewMigrateVolumeParams records the call and calls NewMigrateVolumeParamsFunc
+func (m *MockVolumeService) NewMigrateVolumeParams(storageid string, volumeid string) (r0 *cloudstack.MigrateVolumeParams) {
+	m.record("NewMigrateVolumeParams", storageid, volumeid)
+	if m.NewMigrateVolumeParamsFunc != nil {
+		return m.NewMigrateVolumeParamsFunc(storageid, volumeid)
+	}
+	return cloudstack.NewVolumeService(nil).NewMigrateVolumeParams(storageid, volumeid)
+}
+
+// MigrateVolume records the call and calls MigrateVolumeFunc, or MigrateVolumeWithContextFunc if only that is set
+func (m *MockVolumeService) MigrateVolume(p *cloudstack.MigrateVolumeParams) (r0 *cloudstack.MigrateVolumeResponse, r1 error) {
+	m.record("MigrateVolume", p)
+	if m.MigrateVolumeFunc != nil {
+		return m.MigrateVolumeFunc(p)
+	}
+	if m.MigrateVolumeWithContextFunc != nil {
+		return m.MigrateVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.MigrateVolume")
+	return
+}
+
+// MigrateVolumeWithContext records the call and calls MigrateVolumeWithContextFunc, or MigrateVolumeFunc if only that is set
+func (m *MockVolumeService) MigrateVolumeWithContext(ctx context.Context, p *cloudstack.MigrateVolumeParams) (r0 *cloudstack.MigrateVolumeResponse, r1 error) {
+	m.record("MigrateVolumeWithContext", ctx, p)
+	if m.MigrateVolumeWithContextFunc != nil {
+		return m.MigrateVolumeWithContextFunc(ctx, p)
+	}
+	if m.MigrateVolumeFunc != nil {
+		return m.MigrateVolumeFunc(p)
+	}
+	r1 = notMocked("VolumeService.MigrateVolumeWithContext")
+	return
+}
+
+// MigrateVolumeAsync records the call and calls MigrateVolumeAsyncFunc, or MigrateVolumeAsyncWithContextFunc if only that is set
+func (m *MockVolumeService) MigrateVolumeAsync(p *cloudstack.MigrateVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MigrateVolumeAsync", p)
+	if m.MigrateVolumeAsyncFunc != nil {
+		return m.MigrateVolumeAsyncFunc(p)
+	}
+	if m.MigrateVolumeAsyncWithContextFunc != nil {
+		return m.MigrateVolumeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.MigrateVolumeAsync")
+	return
+}
+
+// MigrateVolumeAsyncWithContext records the call and calls MigrateVolumeAsyncWithContextFunc, or MigrateVolumeAsyncFunc if only that is set
+func (m *MockVolumeService) MigrateVolumeAsyncWithContext(ctx context.Context, p *cloudstack.MigrateVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("MigrateVolumeAsyncWithContext", ctx, p)
+	if m.MigrateVolumeAsyncWithContextFunc != nil {
+		return m.MigrateVolumeAsyncWithContextFunc(ctx, p)
+	}
+	if m.MigrateVolumeAsyncFunc != nil {
+		return m.MigrateVolumeAsyncFunc(p)
+	}
+	r1 = notMocked("VolumeService.MigrateVolumeAsyncWithContext")
+	return
+}
+
+// NewRecoverVolumeParams records the call and calls NewRecoverVolumeParamsFunc
+func (m *MockVolumeService) NewRecoverVolumeParams(id string) (r0 *cloudstack.RecoverVolumeParams) {
+	m.record("NewRecoverVolumeParams", id)
+	if m.NewRecoverVolumeParamsFunc != nil {
+		return m.NewRecoverVolumeParamsFunc(id)
+	}
+	return cloudstack.NewVolumeService(nil).NewRecoverVolumeParams(id)
+}
+
+// RecoverVolume records the call and calls RecoverVolumeFunc, or RecoverVolumeWithContextFunc if only that is set
+func (m *MockVolumeService) RecoverVolume(p *cloudstack.RecoverVolumeParams) (r0 *cloudstack.RecoverVolumeResponse, r1 error) {
+	m.record("RecoverVolume", p)
+	if m.RecoverVolumeFunc != nil {
+		return m.RecoverVolumeFunc(p)
+	}
+	if m.RecoverVolumeWithContextFunc != nil {
+		return m.RecoverVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.RecoverVolume")
+	return
+}
+
+// RecoverVolumeWithContext records the call and calls RecoverVolumeWithContextFunc, or RecoverVolumeFunc if only that is set
+func (m *MockVolumeService) RecoverVolumeWithContext(ctx context.Context, p *cloudstack.RecoverVolumeParams) (r0 *cloudstack.RecoverVolumeResponse, r1 error) {
+	m.record("RecoverVolumeWithContext", ctx, p)
+	if m.RecoverVolumeWithContextFunc != nil {
+		return m.RecoverVolumeWithContextFunc(ctx, p)
+	}
+	if m.RecoverVolumeFunc != nil {
+		return m.RecoverVolumeFunc(p)
+	}
+	r1 = notMocked("VolumeService.RecoverVolumeWithContext")
+	return
+}
+
+// NewResizeVolumeParams records the call and calls NewResizeVolumeParamsFunc
+func (m *MockVolumeService) NewResizeVolumeParams(id string) (r0 *cloudstack.ResizeVolumeParams) {
+	m.record("NewResizeVolumeParams", id)
+	if m.NewResizeVolumeParamsFunc != nil {
+		return m.NewResizeVolumeParamsFunc(id)
+	}
+	return cloudstack.NewVolumeService(nil).NewResizeVolumeParams(id)
+}
+
+// ResizeVolume records the call and calls ResizeVolumeFunc, or ResizeVolumeWithContextFunc if only that is set
+func (m *MockVolumeService) ResizeVolume(p *cloudstack.ResizeVolumeParams) (r0 *cloudstack.ResizeVolumeResponse, r1 error) {
+	m.record("ResizeVolume", p)
+	if m.ResizeVolumeFunc != nil {
+		return m.ResizeVolumeFunc(p)
+	}
+	if m.ResizeVolumeWithContextFunc != nil {
+		return m.ResizeVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.ResizeVolume")
+	return
+}
+
+// ResizeVolumeWithContext records the call and calls ResizeVolumeWithContextFunc, or ResizeVolumeFunc if only that is set
+func (m *MockVolumeService) ResizeVolumeWithContext(ctx context.Context, p *cloudstack.ResizeVolumeParams) (r0 *cloudstack.ResizeVolumeResponse, r1 error) {
+	m.record("ResizeVolumeWithContext", ctx, p)
+	if m.ResizeVolumeWithContextFunc != nil {
+		return m.ResizeVolumeWithContextFunc(ctx, p)
+	}
+	if m.ResizeVolumeFunc != nil {
+		return m.ResizeVolumeFunc(p)
+	}
+	r1 = notMocked("VolumeService.ResizeVolumeWithContext")
+	return
+}
+
+// ResizeVolumeAsync records the call and calls ResizeVolumeAsyncFunc, or ResizeVolumeAsyncWithContextFunc if only that is set
+func (m *MockVolumeService) ResizeVolumeAsync(p *cloudstack.ResizeVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ResizeVolumeAsync", p)
+	if m.ResizeVolumeAsyncFunc != nil {
+		return m.ResizeVolumeAsyncFunc(p)
+	}
+	if m.ResizeVolumeAsyncWithContextFunc != nil {
+		return m.ResizeVolumeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.ResizeVolumeAsync")
+	return
+}
+
+// ResizeVolumeAsyncWithContext records the call and calls ResizeVolumeAsyncWithContextFunc, or ResizeVolumeAsyncFunc if only that is set
+func (m *MockVolumeService) ResizeVolumeAsyncWithContext(ctx context.Context, p *cloudstack.ResizeVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ResizeVolumeAsyncWithContext", ctx, p)
+	if m.ResizeVolumeAsyncWithContextFunc != nil {
+		return m.ResizeVolumeAsyncWithContextFunc(ctx, p)
+	}
+	if m.ResizeVolumeAsyncFunc != nil {
+		return m.ResizeVolumeAsyncFunc(p)
+	}
+	r1 = notMocked("VolumeService.ResizeVolumeAsyncWithContext")
+	return
+}
+
+// NewUpdateVolumeParams records the call and calls NewUpdateVolumeParamsFunc
+func (m *MockVolumeService) NewUpdateVolumeParams() (r0 *cloudstack.UpdateVolumeParams) {
+	m.record("NewUpdateVolumeParams")
+	if m.NewUpdateVolumeParamsFunc != nil {
+		return m.NewUpdateVolumeParamsFunc()
+	}
+	return cloudstack.NewVolumeService(nil).NewUpdateVolumeParams()
+}
+
+// UpdateVolume records the call and calls UpdateVolumeFunc, or UpdateVolumeWithContextFunc if only that is set
+func (m *MockVolumeService) UpdateVolume(p *cloudstack.UpdateVolumeParams) (r0 *cloudstack.UpdateVolumeResponse, r1 error) {
+	m.record("UpdateVolume", p)
+	if m.UpdateVolumeFunc != nil {
+		return m.UpdateVolumeFunc(p)
+	}
+	if m.UpdateVolumeWithContextFunc != nil {
+		return m.UpdateVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.UpdateVolume")
+	return
+}
+
+// UpdateVolumeWithContext records the call and calls UpdateVolumeWithContextFunc, or UpdateVolumeFunc if only that is set
+func (m *MockVolumeService) UpdateVolumeWithContext(ctx context.Context, p *cloudstack.UpdateVolumeParams) (r0 *cloudstack.UpdateVolumeResponse, r1 error) {
+	m.record("UpdateVolumeWithContext", ctx, p)
+	if m.UpdateVolumeWithContextFunc != nil {
+		return m.UpdateVolumeWithContextFunc(ctx, p)
+	}
+	if m.UpdateVolumeFunc != nil {
+		return m.UpdateVolumeFunc(p)
+	}
+	r1 = notMocked("VolumeService.UpdateVolumeWithContext")
+	return
+}
+
+// UpdateVolumeAsync records the call and calls UpdateVolumeAsyncFunc, or UpdateVolumeAsyncWithContextFunc if only that is set
+func (m *MockVolumeService) UpdateVolumeAsync(p *cloudstack.UpdateVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVolumeAsync", p)
+	if m.UpdateVolumeAsyncFunc != nil {
+		return m.UpdateVolumeAsyncFunc(p)
+	}
+	if m.UpdateVolumeAsyncWithContextFunc != nil {
+		return m.UpdateVolumeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.UpdateVolumeAsync")
+	return
+}
+
+// UpdateVolumeAsyncWithContext records the call and calls UpdateVolumeAsyncWithContextFunc, or UpdateVolumeAsyncFunc if only that is set
+func (m *MockVolumeService) UpdateVolumeAsyncWithContext(ctx context.Context, p *cloudstack.UpdateVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UpdateVolumeAsyncWithContext", ctx, p)
+	if m.UpdateVolumeAsyncWithContextFunc != nil {
+		return m.UpdateVolumeAsyncWithContextFunc(ctx, p)
+	}
+	if m.UpdateVolumeAsyncFunc != nil {
+		return m.UpdateVolumeAsyncFunc(p)
+	}
+	r1 = notMocked("VolumeService.UpdateVolumeAsyncWithContext")
+	return
+}
+
+// NewUploadVolumeParams records the call and calls NewUploadVolumeParamsFunc
+func (m *MockVolumeService) NewUploadVolumeParams(format string, name string, url string, zoneid string) (r0 *cloudstack.UploadVolumeParams) {
+	m.record("NewUploadVolumeParams", format, name, url, zoneid)
+	if m.NewUploadVolumeParamsFunc != nil {
+		return m.NewUploadVolumeParamsFunc(format, name, url, zoneid)
+	}
+	return cloudstack.NewVolumeService(nil).NewUploadVolumeParams(format, name, url, zoneid)
+}
+
+// UploadVolume records the call and calls UploadVolumeFunc, or UploadVolumeWithContextFunc if only that is set
+func (m *MockVolumeService) UploadVolume(p *cloudstack.UploadVolumeParams) (r0 *cloudstack.UploadVolumeResponse, r1 error) {
+	m.record("UploadVolume", p)
+	if m.UploadVolumeFunc != nil {
+		return m.UploadVolumeFunc(p)
+	}
+	if m.UploadVolumeWithContextFunc != nil {
+		return m.UploadVolumeWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.UploadVolume")
+	return
+}
+
+// UploadVolumeWithContext records the call and calls UploadVolumeWithContextFunc, or UploadVolumeFunc if only that is set
+func (m *MockVolumeService) UploadVolumeWithContext(ctx context.Context, p *cloudstack.UploadVolumeParams) (r0 *cloudstack.UploadVolumeResponse, r1 error) {
+	m.record("UploadVolumeWithContext", ctx, p)
+	if m.UploadVolumeWithContextFunc != nil {
+		return m.UploadVolumeWithContextFunc(ctx, p)
+	}
+	if m.UploadVolumeFunc != nil {
+		return m.UploadVolumeFunc(p)
+	}
+	r1 = notMocked("VolumeService.UploadVolumeWithContext")
+	return
+}
+
+// UploadVolumeAsync records the call and calls UploadVolumeAsyncFunc, or UploadVolumeAsyncWithContextFunc if only that is set
+func (m *MockVolumeService) UploadVolumeAsync(p *cloudstack.UploadVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UploadVolumeAsync", p)
+	if m.UploadVolumeAsyncFunc != nil {
+		return m.UploadVolumeAsyncFunc(p)
+	}
+	if m.UploadVolumeAsyncWithContextFunc != nil {
+		return m.UploadVolumeAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("VolumeService.UploadVolumeAsync")
+	return
+}
+
+// UploadVolumeAsyncWithContext records the call and calls UploadVolumeAsyncWithContextFunc, or UploadVolumeAsyncFunc if only that is set
+func (m *MockVolumeService) UploadVolumeAsyncWithContext(ctx context.Context, p *cloudstack.UploadVolumeParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("UploadVolumeAsyncWithContext", ctx, p)
+	if m.UploadVolumeAsyncWithContextFunc != nil {
+		return m.UploadVolumeAsyncWithContextFunc(ctx, p)
+	}
+	if m.UploadVolumeAsyncFunc != nil {
+		return m.UploadVolumeAsyncFunc(p)
+	}
+	r1 = notMocked("VolumeService.UploadVolumeAsyncWithContext")
+	return
+}
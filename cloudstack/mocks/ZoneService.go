@@ -0,0 +1,813 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// MockZoneService is a mock of cloudstack.ZoneServiceIface.
+// Every call is recorded, and is handled by the matching ...Func field if it
+// is set. A method and its ...WithContext variant fall back to each other's
+// ...Func field, so only one of them needs to be set. If neither is set, the
+// New...Params methods return the same params as the real service, and the
+// other methods return zero values and an error wrapping ErrNotMocked.
+type MockZoneService struct {
+	Recorder
+
+	NewCreateZoneParamsFunc                               func(dns1 string, internaldns1 string, name string, networktype string) *cloudstack.CreateZoneParams
+	CreateZoneFunc                                        func(p *cloudstack.CreateZoneParams) (*cloudstack.CreateZoneResponse, error)
+	CreateZoneWithContextFunc                             func(ctx context.Context, p *cloudstack.CreateZoneParams) (*cloudstack.CreateZoneResponse, error)
+	NewDedicateZoneParamsFunc                             func(domainid string, zoneid string) *cloudstack.DedicateZoneParams
+	DedicateZoneFunc                                      func(p *cloudstack.DedicateZoneParams) (*cloudstack.DedicateZoneResponse, error)
+	DedicateZoneWithContextFunc                           func(ctx context.Context, p *cloudstack.DedicateZoneParams) (*cloudstack.DedicateZoneResponse, error)
+	DedicateZoneAsyncFunc                                 func(p *cloudstack.DedicateZoneParams) (*cloudstack.Job, error)
+	DedicateZoneAsyncWithContextFunc                      func(ctx context.Context, p *cloudstack.DedicateZoneParams) (*cloudstack.Job, error)
+	NewDeleteZoneParamsFunc                               func(id string) *cloudstack.DeleteZoneParams
+	DeleteZoneFunc                                        func(p *cloudstack.DeleteZoneParams) (*cloudstack.DeleteZoneResponse, error)
+	DeleteZoneWithContextFunc                             func(ctx context.Context, p *cloudstack.DeleteZoneParams) (*cloudstack.DeleteZoneResponse, error)
+	NewDisableOutOfBandManagementForZoneParamsFunc        func(zoneid string) *cloudstack.DisableOutOfBandManagementForZoneParams
+	DisableOutOfBandManagementForZoneFunc                 func(p *cloudstack.DisableOutOfBandManagementForZoneParams) (*cloudstack.DisableOutOfBandManagementForZoneResponse, error)
+	DisableOutOfBandManagementForZoneWithContextFunc      func(ctx context.Context, p *cloudstack.DisableOutOfBandManagementForZoneParams) (*cloudstack.DisableOutOfBandManagementForZoneResponse, error)
+	DisableOutOfBandManagementForZoneAsyncFunc            func(p *cloudstack.DisableOutOfBandManagementForZoneParams) (*cloudstack.Job, error)
+	DisableOutOfBandManagementForZoneAsyncWithContextFunc func(ctx context.Context, p *cloudstack.DisableOutOfBandManagementForZoneParams) (*cloudstack.Job, error)
+	NewEnableOutOfBandManagementForZoneParamsFunc         func(zoneid string) *cloudstack.EnableOutOfBandManagementForZoneParams
+	EnableOutOfBandManagementForZoneFunc                  func(p *cloudstack.EnableOutOfBandManagementForZoneParams) (*cloudstack.EnableOutOfBandManagementForZoneResponse, error)
+	EnableOutOfBandManagementForZoneWithContextFunc       func(ctx context.Context, p *cloudstack.EnableOutOfBandManagementForZoneParams) (*cloudstack.EnableOutOfBandManagementForZoneResponse, error)
+	EnableOutOfBandManagementForZoneAsyncFunc             func(p *cloudstack.EnableOutOfBandManagementForZoneParams) (*cloudstack.Job, error)
+	EnableOutOfBandManagementForZoneAsyncWithContextFunc  func(ctx context.Context, p *cloudstack.EnableOutOfBandManagementForZoneParams) (*cloudstack.Job, error)
+	NewListDedicatedZonesParamsFunc                       func() *cloudstack.ListDedicatedZonesParams
+	ListDedicatedZonesFunc                                func(p *cloudstack.ListDedicatedZonesParams) (*cloudstack.ListDedicatedZonesResponse, error)
+	ListDedicatedZonesWithContextFunc                     func(ctx context.Context, p *cloudstack.ListDedicatedZonesParams) (*cloudstack.ListDedicatedZonesResponse, error)
+	NewListDedicatedZonesPagerFunc                        func(p *cloudstack.ListDedicatedZonesParams) *cloudstack.ListDedicatedZonesPager
+	ListDedicatedZonesAllFunc                             func(p *cloudstack.ListDedicatedZonesParams) (*cloudstack.ListDedicatedZonesResponse, error)
+	ListDedicatedZonesAllWithContextFunc                  func(ctx context.Context, p *cloudstack.ListDedicatedZonesParams) (*cloudstack.ListDedicatedZonesResponse, error)
+	NewListZonesParamsFunc                                func() *cloudstack.ListZonesParams
+	GetZoneIDFunc                                         func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetZoneIDWithContextFunc                              func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetZoneByNameFunc                                     func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.Zone, int, error)
+	GetZoneByNameWithContextFunc                          func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.Zone, int, error)
+	GetZoneByIDFunc                                       func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.Zone, int, error)
+	GetZoneByIDWithContextFunc                            func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.Zone, int, error)
+	ListZonesFunc                                         func(p *cloudstack.ListZonesParams) (*cloudstack.ListZonesResponse, error)
+	ListZonesWithContextFunc                              func(ctx context.Context, p *cloudstack.ListZonesParams) (*cloudstack.ListZonesResponse, error)
+	NewListZonesPagerFunc                                 func(p *cloudstack.ListZonesParams) *cloudstack.ListZonesPager
+	ListZonesAllFunc                                      func(p *cloudstack.ListZonesParams) (*cloudstack.ListZonesResponse, error)
+	ListZonesAllWithContextFunc                           func(ctx context.Context, p *cloudstack.ListZonesParams) (*cloudstack.ListZonesResponse, error)
+	NewListZonesMetricsParamsFunc                         func() *cloudstack.ListZonesMetricsParams
+	GetZonesMetricIDFunc                                  func(name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetZonesMetricIDWithContextFunc                       func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (string, int, error)
+	GetZonesMetricByNameFunc                              func(name string, opts ...cloudstack.OptionFunc) (*cloudstack.ZonesMetric, int, error)
+	GetZonesMetricByNameWithContextFunc                   func(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (*cloudstack.ZonesMetric, int, error)
+	GetZonesMetricByIDFunc                                func(id string, opts ...cloudstack.OptionFunc) (*cloudstack.ZonesMetric, int, error)
+	GetZonesMetricByIDWithContextFunc                     func(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (*cloudstack.ZonesMetric, int, error)
+	ListZonesMetricsFunc                                  func(p *cloudstack.ListZonesMetricsParams) (*cloudstack.ListZonesMetricsResponse, error)
+	ListZonesMetricsWithContextFunc                       func(ctx context.Context, p *cloudstack.ListZonesMetricsParams) (*cloudstack.ListZonesMetricsResponse, error)
+	NewListZonesMetricsPagerFunc                          func(p *cloudstack.ListZonesMetricsParams) *cloudstack.ListZonesMetricsPager
+	ListZonesMetricsAllFunc                               func(p *cloudstack.ListZonesMetricsParams) (*cloudstack.ListZonesMetricsResponse, error)
+	ListZonesMetricsAllWithContextFunc                    func(ctx context.Context, p *cloudstack.ListZonesMetricsParams) (*cloudstack.ListZonesMetricsResponse, error)
+	NewReleaseDedicatedZoneParamsFunc                     func(zoneid string) *cloudstack.ReleaseDedicatedZoneParams
+	ReleaseDedicatedZoneFunc                              func(p *cloudstack.ReleaseDedicatedZoneParams) (*cloudstack.ReleaseDedicatedZoneResponse, error)
+	ReleaseDedicatedZoneWithContextFunc                   func(ctx context.Context, p *cloudstack.ReleaseDedicatedZoneParams) (*cloudstack.ReleaseDedicatedZoneResponse, error)
+	ReleaseDedicatedZoneAsyncFunc                         func(p *cloudstack.ReleaseDedicatedZoneParams) (*cloudstack.Job, error)
+	ReleaseDedicatedZoneAsyncWithContextFunc              func(ctx context.Context, p *cloudstack.ReleaseDedicatedZoneParams) (*cloudstack.Job, error)
+	NewUpdateZoneParamsFunc                               func(id string) *cloudstack.UpdateZoneParams
+	UpdateZoneFunc                                        func(p *cloudstack.UpdateZoneParams) (*cloudstack.UpdateZoneResponse, error)
+	UpdateZoneWithContextFunc                             func(ctx context.Context, p *cloudstack.UpdateZoneParams) (*cloudstack.UpdateZoneResponse, error)
+}
+
+var _ cloudstack.ZoneServiceIface = (*MockZoneService)(nil)
+
+// NewCreateZoneParams records the call and calls NewCreateZoneParamsFunc
+func (m *MockZoneService) NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string) (r0 *cloudstack.CreateZoneParams) {
+	m.record("NewCreateZoneParams", dns1, internaldns1, name, networktype)
+	if m.NewCreateZoneParamsFunc != nil {
+		return m.NewCreateZoneParamsFunc(dns1, internaldns1, name, networktype)
+	}
+	return cloudstack.NewZoneService(nil).NewCreateZoneParams(dns1, internaldns1, name, networktype)
+}
+
+// CreateZone records the call and calls CreateZoneFunc, or CreateZoneWithContextFunc if only that is set
+func (m *MockZoneService) CreateZone(p *cloudstack.CreateZoneParams) (r0 *cloudstack.CreateZoneResponse, r1 error) {
+	m.record("CreateZone", p)
+	if m.CreateZoneFunc != nil {
+		return m.CreateZoneFunc(p)
+	}
+	if m.CreateZoneWithContextFunc != nil {
+		return m.CreateZoneWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.CreateZone")
+	return
+}
+
+// CreateZoneWithContext records the call and calls CreateZoneWithContextFunc, or CreateZoneFunc if only that is set
+func (m *MockZoneService) CreateZoneWithContext(ctx context.Context, p *cloudstack.CreateZoneParams) (r0 *cloudstack.CreateZoneResponse, r1 error) {
+	m.record("CreateZoneWithContext", ctx, p)
+	if m.CreateZoneWithContextFunc != nil {
+		return m.CreateZoneWithContextFunc(ctx, p)
+	}
+	if m.CreateZoneFunc != nil {
+		return m.CreateZoneFunc(p)
+	}
+	r1 = notMocked("ZoneService.CreateZoneWithContext")
+	return
+}
+
+// NewDedicateZoneParams records the call and calls NewDedicateZoneParamsFunc
+func (m *MockZoneService) NewDedicateZoneParams(domainid string, zoneid string) (r0 *cloudstack.DedicateZoneParams) {
+	m.record("NewDedicateZoneParams", domainid, zoneid)
+	if m.NewDedicateZoneParamsFunc != nil {
+		return m.NewDedicateZoneParamsFunc(domainid, zoneid)
+	}
+	return cloudstack.NewZoneService(nil).NewDedicateZoneParams(domainid, zoneid)
+}
+
+// DedicateZone records the call and calls DedicateZoneFunc, or DedicateZoneWithContextFunc if only that is set
+func (m *MockZoneService) DedicateZone(p *cloudstack.DedicateZoneParams) (r0 *cloudstack.DedicateZoneResponse, r1 error) {
+	m.record("DedicateZone", p)
+	if m.DedicateZoneFunc != nil {
+		return m.DedicateZoneFunc(p)
+	}
+	if m.DedicateZoneWithContextFunc != nil {
+		return m.DedicateZoneWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.DedicateZone")
+	return
+}
+
+// DedicateZoneWithContext records the call and calls DedicateZoneWithContextFunc, or DedicateZoneFunc if only that is set
+func (m *MockZoneService) DedicateZoneWithContext(ctx context.Context, p *cloudstack.DedicateZoneParams) (r0 *cloudstack.DedicateZoneResponse, r1 error) {
+	m.record("DedicateZoneWithContext", ctx, p)
+	if m.DedicateZoneWithContextFunc != nil {
+		return m.DedicateZoneWithContextFunc(ctx, p)
+	}
+	if m.DedicateZoneFunc != nil {
+		return m.DedicateZoneFunc(p)
+	}
+	r1 = notMocked("ZoneService.DedicateZoneWithContext")
+	return
+}
+
+// DedicateZoneAsync records the call and calls DedicateZoneAsyncFunc, or DedicateZoneAsyncWithContextFunc if only that is set
+func (m *MockZoneService) DedicateZoneAsync(p *cloudstack.DedicateZoneParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DedicateZoneAsync", p)
+	if m.DedicateZoneAsyncFunc != nil {
+		return m.DedicateZoneAsyncFunc(p)
+	}
+	if m.DedicateZoneAsyncWithContextFunc != nil {
+		return m.DedicateZoneAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.DedicateZoneAsync")
+	return
+}
+
+// DedicateZoneAsyncWithContext records the call and calls DedicateZoneAsyncWithContextFunc, or DedicateZoneAsyncFunc if only that is set
+func (m *MockZoneService) DedicateZoneAsyncWithContext(ctx context.Context, p *cloudstack.DedicateZoneParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DedicateZoneAsyncWithContext", ctx, p)
+	if m.DedicateZoneAsyncWithContextFunc != nil {
+		return m.DedicateZoneAsyncWithContextFunc(ctx, p)
+	}
+	if m.DedicateZoneAsyncFunc != nil {
+		return m.DedicateZoneAsyncFunc(p)
+	}
+	r1 = notMocked("ZoneService.DedicateZoneAsyncWithContext")
+	return
+}
+
+// NewDeleteZoneParams records the call and calls NewDeleteZoneParamsFunc
+func (m *MockZoneService) NewDeleteZoneParams(id string) (r0 *cloudstack.DeleteZoneParams) {
+	m.record("NewDeleteZoneParams", id)
+	if m.NewDeleteZoneParamsFunc != nil {
+		return m.NewDeleteZoneParamsFunc(id)
+	}
+	return cloudstack.NewZoneService(nil).NewDeleteZoneParams(id)
+}
+
+// DeleteZone records the call and calls DeleteZoneFunc, or DeleteZoneWithContextFunc if only that is set
+func (m *MockZoneService) DeleteZone(p *cloudstack.DeleteZoneParams) (r0 *cloudstack.DeleteZoneResponse, r1 error) {
+	m.record("DeleteZone", p)
+	if m.DeleteZoneFunc != nil {
+		return m.DeleteZoneFunc(p)
+	}
+	if m.DeleteZoneWithContextFunc != nil {
+		return m.DeleteZoneWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.DeleteZone")
+	return
+}
+
+// DeleteZoneWithContext records the call and calls DeleteZoneWithContextFunc, or DeleteZoneFunc if only that is set
+func (m *MockZoneService) DeleteZoneWithContext(ctx context.Context, p *cloudstack.DeleteZoneParams) (r0 *cloudstack.DeleteZoneResponse, r1 error) {
+	m.record("DeleteZoneWithContext", ctx, p)
+	if m.DeleteZoneWithContextFunc != nil {
+		return m.DeleteZoneWithContextFunc(ctx, p)
+	}
+	if m.DeleteZoneFunc != nil {
+		return m.DeleteZoneFunc(p)
+	}
+	r1 = notMocked("ZoneService.DeleteZoneWithContext")
+	return
+}
+
+// NewDisableOutOfBandManagementForZoneParams records the call and calls NewDisableOutOfBandManagementForZoneParamsFunc
+func (m *MockZoneService) NewDisableOutOfBandManagementForZoneParams(zoneid string) (r0 *cloudstack.DisableOutOfBandManagementForZoneParams) {
+	m.record("NewDisableOutOfBandManagementForZoneParams", zoneid)
+	if m.NewDisableOutOfBandManagementForZoneParamsFunc != nil {
+		return m.NewDisableOutOfBandManagementForZoneParamsFunc(zoneid)
+	}
+	return cloudstack.NewZoneService(nil).NewDisableOutOfBandManagementForZoneParams(zoneid)
+}
+
+// DisableOutOfBandManagementForZone records the call and calls DisableOutOfBandManagementForZoneFunc, or DisableOutOfBandManagementForZoneWithContextFunc if only that is set
+func (m *MockZoneService) DisableOutOfBandManagementForZone(p *cloudstack.DisableOutOfBandManagementForZoneParams) (r0 *cloudstack.DisableOutOfBandManagementForZoneResponse, r1 error) {
+	m.record("DisableOutOfBandManagementForZone", p)
+	if m.DisableOutOfBandManagementForZoneFunc != nil {
+		return m.DisableOutOfBandManagementForZoneFunc(p)
+	}
+	if m.DisableOutOfBandManagementForZoneWithContextFunc != nil {
+		return m.DisableOutOfBandManagementForZoneWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.DisableOutOfBandManagementForZone")
+	return
+}
+
+// DisableOutOfBandManagementForZoneWithContext records the call and calls DisableOutOfBandManagementForZoneWithContextFunc, or DisableOutOfBandManagementForZoneFunc if only that is set
+func (m *MockZoneService) DisableOutOfBandManagementForZoneWithContext(ctx context.Context, p *cloudstack.DisableOutOfBandManagementForZoneParams) (r0 *cloudstack.DisableOutOfBandManagementForZoneResponse, r1 error) {
+	m.record("DisableOutOfBandManagementForZoneWithContext", ctx, p)
+	if m.DisableOutOfBandManagementForZoneWithContextFunc != nil {
+		return m.DisableOutOfBandManagementForZoneWithContextFunc(ctx, p)
+	}
+	if m.DisableOutOfBandManagementForZoneFunc != nil {
+		return m.DisableOutOfBandManagementForZoneFunc(p)
+	}
+	r1 = notMocked("ZoneService.DisableOutOfBandManagementForZoneWithContext")
+	return
+}
+
+// DisableOutOfBandManagementForZoneAsync records the call and calls DisableOutOfBandManagementForZoneAsyncFunc, or DisableOutOfBandManagementForZoneAsyncWithContextFunc if only that is set
+func (m *MockZoneService) DisableOutOfBandManagementForZoneAsync(p *cloudstack.DisableOutOfBandManagementForZoneParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableOutOfBandManagementForZoneAsync", p)
+	if m.DisableOutOfBandManagementForZoneAsyncFunc != nil {
+		return m.DisableOutOfBandManagementForZoneAsyncFunc(p)
+	}
+	if m.DisableOutOfBandManagementForZoneAsyncWithContextFunc != nil {
+		return m.DisableOutOfBandManagementForZoneAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.DisableOutOfBandManagementForZoneAsync")
+	return
+}
+
+// DisableOutOfBandManagementForZoneAsyncWithContext records the call and calls DisableOutOfBandManagementForZoneAsyncWithContextFunc, or DisableOutOfBandManagementForZoneAsyncFunc if only that is set
+func (m *MockZoneService) DisableOutOfBandManagementForZoneAsyncWithContext(ctx context.Context, p *cloudstack.DisableOutOfBandManagementForZoneParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("DisableOutOfBandManagementForZoneAsyncWithContext", ctx, p)
+	if m.DisableOutOfBandManagementForZoneAsyncWithContextFunc != nil {
+		return m.DisableOutOfBandManagementForZoneAsyncWithContextFunc(ctx, p)
+	}
+	if m.DisableOutOfBandManagementForZoneAsyncFunc != nil {
+		return m.DisableOutOfBandManagementForZoneAsyncFunc(p)
+	}
+	r1 = notMocked("ZoneService.DisableOutOfBandManagementForZoneAsyncWithContext")
+	return
+}
+
+// NewEnableOutOfBandManagementForZoneParams records the call and calls NewEnableOutOfBandManagementForZoneParamsFunc
+func (m *MockZoneService) NewEnableOutOfBandManagementForZoneParams(zoneid string) (r0 *cloudstack.EnableOutOfBandManagementForZoneParams) {
+	m.record("NewEnableOutOfBandManagementForZoneParams", zoneid)
+	if m.NewEnableOutOfBandManagementForZoneParamsFunc != nil {
+		return m.NewEnableOutOfBandManagementForZoneParamsFunc(zoneid)
+	}
+	return cloudstack.NewZoneService(nil).NewEnableOutOfBandManagementForZoneParams(zoneid)
+}
+
+// EnableOutOfBandManagementForZone records the call and calls EnableOutOfBandManagementForZoneFunc, or EnableOutOfBandManagementForZoneWithContextFunc if only that is set
+func (m *MockZoneService) EnableOutOfBandManagementForZone(p *cloudstack.EnableOutOfBandManagementForZoneParams) (r0 *cloudstack.EnableOutOfBandManagementForZoneResponse, r1 error) {
+	m.record("EnableOutOfBandManagementForZone", p)
+	if m.EnableOutOfBandManagementForZoneFunc != nil {
+		return m.EnableOutOfBandManagementForZoneFunc(p)
+	}
+	if m.EnableOutOfBandManagementForZoneWithContextFunc != nil {
+		return m.EnableOutOfBandManagementForZoneWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.EnableOutOfBandManagementForZone")
+	return
+}
+
+// EnableOutOfBandManagementForZoneWithContext records the call and calls EnableOutOfBandManagementForZoneWithContextFunc, or EnableOutOfBandManagementForZoneFunc if only that is set
+func (m *MockZoneService) EnableOutOfBandManagementForZoneWithContext(ctx context.Context, p *cloudstack.EnableOutOfBandManagementForZoneParams) (r0 *cloudstack.EnableOutOfBandManagementForZoneResponse, r1 error) {
+	m.record("EnableOutOfBandManagementForZoneWithContext", ctx, p)
+	if m.EnableOutOfBandManagementForZoneWithContextFunc != nil {
+		return m.EnableOutOfBandManagementForZoneWithContextFunc(ctx, p)
+	}
+	if m.EnableOutOfBandManagementForZoneFunc != nil {
+		return m.EnableOutOfBandManagementForZoneFunc(p)
+	}
+	r1 = notMocked("ZoneService.EnableOutOfBandManagementForZoneWithContext")
+	return
+}
+
+// EnableOutOfBandManagementForZoneAsync records the call and calls EnableOutOfBandManagementForZoneAsyncFunc, or EnableOutOfBandManagementForZoneAsyncWithContextFunc if only that is set
+func (m *MockZoneService) EnableOutOfBandManagementForZoneAsync(p *cloudstack.EnableOutOfBandManagementForZoneParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableOutOfBandManagementForZoneAsync", p)
+	if m.EnableOutOfBandManagementForZoneAsyncFunc != nil {
+		return m.EnableOutOfBandManagementForZoneAsyncFunc(p)
+	}
+	if m.EnableOutOfBandManagementForZoneAsyncWithContextFunc != nil {
+		return m.EnableOutOfBandManagementForZoneAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.EnableOutOfBandManagementForZoneAsync")
+	return
+}
+
+// EnableOutOfBandManagementForZoneAsyncWithContext records the call and calls EnableOutOfBandManagementForZoneAsyncWithContextFunc, or EnableOutOfBandManagementForZoneAsyncFunc if only that is set
+func (m *MockZoneService) EnableOutOfBandManagementForZoneAsyncWithContext(ctx context.Context, p *cloudstack.EnableOutOfBandManagementForZoneParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("EnableOutOfBandManagementForZoneAsyncWithContext", ctx, p)
+	if m.EnableOutOfBandManagementForZoneAsyncWithContextFunc != nil {
+		return m.EnableOutOfBandManagementForZoneAsyncWithContextFunc(ctx, p)
+	}
+	if m.EnableOutOfBandManagementForZoneAsyncFunc != nil {
+		return m.EnableOutOfBandManagementForZoneAsyncFunc(p)
+	}
+	r1 = notMocked("ZoneService.EnableOutOfBandManagementForZoneAsyncWithContext")
+	return
+}
+
+// NewListDedicatedZonesParams records the call and calls NewListDedicatedZonesParamsFunc
+func (m *MockZoneService) NewListDedicatedZonesParams() (r0 *cloudstack.ListDedicatedZonesParams) {
+	m.record("NewListDedicatedZonesParams")
+	if m.NewListDedicatedZonesParamsFunc != nil {
+		return m.NewListDedicatedZonesParamsFunc()
+	}
+	return cloudstack.NewZoneService(nil).NewListDedicatedZonesParams()
+}
+
+// ListDedicatedZones records the call and calls ListDedicatedZonesFunc, or ListDedicatedZonesWithContextFunc if only that is set
+func (m *MockZoneService) ListDedicatedZones(p *cloudstack.ListDedicatedZonesParams) (r0 *cloudstack.ListDedicatedZonesResponse, r1 error) {
+	m.record("ListDedicatedZones", p)
+	if m.ListDedicatedZonesFunc != nil {
+		return m.ListDedicatedZonesFunc(p)
+	}
+	if m.ListDedicatedZonesWithContextFunc != nil {
+		return m.ListDedicatedZonesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.ListDedicatedZones")
+	return
+}
+
+// ListDedicatedZonesWithContext records the call and calls ListDedicatedZonesWithContextFunc, or ListDedicatedZonesFunc if only that is set
+func (m *MockZoneService) ListDedicatedZonesWithContext(ctx context.Context, p *cloudstack.ListDedicatedZonesParams) (r0 *cloudstack.ListDedicatedZonesResponse, r1 error) {
+	m.record("ListDedicatedZonesWithContext", ctx, p)
+	if m.ListDedicatedZonesWithContextFunc != nil {
+		return m.ListDedicatedZonesWithContextFunc(ctx, p)
+	}
+	if m.ListDedicatedZonesFunc != nil {
+		return m.ListDedicatedZonesFunc(p)
+	}
+	r1 = notMocked("ZoneService.ListDedicatedZonesWithContext")
+	return
+}
+
+// NewListDedicatedZonesPager records the call and calls NewListDedicatedZonesPagerFunc
+func (m *MockZoneService) NewListDedicatedZonesPager(p *cloudstack.ListDedicatedZonesParams) (r0 *cloudstack.ListDedicatedZonesPager) {
+	m.record("NewListDedicatedZonesPager", p)
+	if m.NewListDedicatedZonesPagerFunc != nil {
+		return m.NewListDedicatedZonesPagerFunc(p)
+	}
+	return
+}
+
+// ListDedicatedZonesAll records the call and calls ListDedicatedZonesAllFunc, or ListDedicatedZonesAllWithContextFunc if only that is set
+func (m *MockZoneService) ListDedicatedZonesAll(p *cloudstack.ListDedicatedZonesParams) (r0 *cloudstack.ListDedicatedZonesResponse, r1 error) {
+	m.record("ListDedicatedZonesAll", p)
+	if m.ListDedicatedZonesAllFunc != nil {
+		return m.ListDedicatedZonesAllFunc(p)
+	}
+	if m.ListDedicatedZonesAllWithContextFunc != nil {
+		return m.ListDedicatedZonesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.ListDedicatedZonesAll")
+	return
+}
+
+// ListDedicatedZonesAllWithContext records the call and calls ListDedicatedZonesAllWithContextFunc, or ListDedicatedZonesAllFunc if only that is set
+func (m *MockZoneService) ListDedicatedZonesAllWithContext(ctx context.Context, p *cloudstack.ListDedicatedZonesParams) (r0 *cloudstack.ListDedicatedZonesResponse, r1 error) {
+	m.record("ListDedicatedZonesAllWithContext", ctx, p)
+	if m.ListDedicatedZonesAllWithContextFunc != nil {
+		return m.ListDedicatedZonesAllWithContextFunc(ctx, p)
+	}
+	if m.ListDedicatedZonesAllFunc != nil {
+		return m.ListDedicatedZonesAllFunc(p)
+	}
+	r1 = notMocked("ZoneService.ListDedicatedZonesAllWithContext")
+	return
+}
+
+// NewListZonesParams records the call and calls NewListZonesParamsFunc
+func (m *MockZoneService) NewListZonesParams() (r0 *cloudstack.ListZonesParams) {
+	m.record("NewListZonesParams")
+	if m.NewListZonesParamsFunc != nil {
+		return m.NewListZonesParamsFunc()
+	}
+	return cloudstack.NewZoneService(nil).NewListZonesParams()
+}
+
+// GetZoneID records the call and calls GetZoneIDFunc, or GetZoneIDWithContextFunc if only that is set
+func (m *MockZoneService) GetZoneID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetZoneID", name, opts)
+	if m.GetZoneIDFunc != nil {
+		return m.GetZoneIDFunc(name, opts...)
+	}
+	if m.GetZoneIDWithContextFunc != nil {
+		return m.GetZoneIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ZoneService.GetZoneID")
+	return
+}
+
+// GetZoneIDWithContext records the call and calls GetZoneIDWithContextFunc, or GetZoneIDFunc if only that is set
+func (m *MockZoneService) GetZoneIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetZoneIDWithContext", ctx, name, opts)
+	if m.GetZoneIDWithContextFunc != nil {
+		return m.GetZoneIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetZoneIDFunc != nil {
+		return m.GetZoneIDFunc(name, opts...)
+	}
+	r2 = notMocked("ZoneService.GetZoneIDWithContext")
+	return
+}
+
+// GetZoneByName records the call and calls GetZoneByNameFunc, or GetZoneByNameWithContextFunc if only that is set
+func (m *MockZoneService) GetZoneByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Zone, r1 int, r2 error) {
+	m.record("GetZoneByName", name, opts)
+	if m.GetZoneByNameFunc != nil {
+		return m.GetZoneByNameFunc(name, opts...)
+	}
+	if m.GetZoneByNameWithContextFunc != nil {
+		return m.GetZoneByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ZoneService.GetZoneByName")
+	return
+}
+
+// GetZoneByNameWithContext records the call and calls GetZoneByNameWithContextFunc, or GetZoneByNameFunc if only that is set
+func (m *MockZoneService) GetZoneByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Zone, r1 int, r2 error) {
+	m.record("GetZoneByNameWithContext", ctx, name, opts)
+	if m.GetZoneByNameWithContextFunc != nil {
+		return m.GetZoneByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetZoneByNameFunc != nil {
+		return m.GetZoneByNameFunc(name, opts...)
+	}
+	r2 = notMocked("ZoneService.GetZoneByNameWithContext")
+	return
+}
+
+// GetZoneByID records the call and calls GetZoneByIDFunc, or GetZoneByIDWithContextFunc if only that is set
+func (m *MockZoneService) GetZoneByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Zone, r1 int, r2 error) {
+	m.record("GetZoneByID", id, opts)
+	if m.GetZoneByIDFunc != nil {
+		return m.GetZoneByIDFunc(id, opts...)
+	}
+	if m.GetZoneByIDWithContextFunc != nil {
+		return m.GetZoneByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("ZoneService.GetZoneByID")
+	return
+}
+
+// GetZoneByIDWithContext records the call and calls GetZoneByIDWithContextFunc, or GetZoneByIDFunc if only that is set
+func (m *MockZoneService) GetZoneByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.Zone, r1 int, r2 error) {
+	m.record("GetZoneByIDWithContext", ctx, id, opts)
+	if m.GetZoneByIDWithContextFunc != nil {
+		return m.GetZoneByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetZoneByIDFunc != nil {
+		return m.GetZoneByIDFunc(id, opts...)
+	}
+	r2 = notMocked("ZoneService.GetZoneByIDWithContext")
+	return
+}
+
+// ListZones records the call and calls ListZonesFunc, or ListZonesWithContextFunc if only that is set
+func (m *MockZoneService) ListZones(p *cloudstack.ListZonesParams) (r0 *cloudstack.ListZonesResponse, r1 error) {
+	m.record("ListZones", p)
+	if m.ListZonesFunc != nil {
+		return m.ListZonesFunc(p)
+	}
+	if m.ListZonesWithContextFunc != nil {
+		return m.ListZonesWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.ListZones")
+	return
+}
+
+// ListZonesWithContext records the call and calls ListZonesWithContextFunc, or ListZonesFunc if only that is set
+func (m *MockZoneService) ListZonesWithContext(ctx context.Context, p *cloudstack.ListZonesParams) (r0 *cloudstack.ListZonesResponse, r1 error) {
+	m.record("ListZonesWithContext", ctx, p)
+	if m.ListZonesWithContextFunc != nil {
+		return m.ListZonesWithContextFunc(ctx, p)
+	}
+	if m.ListZonesFunc != nil {
+		return m.ListZonesFunc(p)
+	}
+	r1 = notMocked("ZoneService.ListZonesWithContext")
+	return
+}
+
+// NewListZonesPager records the call and calls NewListZonesPagerFunc
+func (m *MockZoneService) NewListZonesPager(p *cloudstack.ListZonesParams) (r0 *cloudstack.ListZonesPager) {
+	m.record("NewListZonesPager", p)
+	if m.NewListZonesPagerFunc != nil {
+		return m.NewListZonesPagerFunc(p)
+	}
+	return
+}
+
+// ListZonesAll records the call and calls ListZonesAllFunc, or ListZonesAllWithContextFunc if only that is set
+func (m *MockZoneService) ListZonesAll(p *cloudstack.ListZonesParams) (r0 *cloudstack.ListZonesResponse, r1 error) {
+	m.record("ListZonesAll", p)
+	if m.ListZonesAllFunc != nil {
+		return m.ListZonesAllFunc(p)
+	}
+	if m.ListZonesAllWithContextFunc != nil {
+		return m.ListZonesAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.ListZonesAll")
+	return
+}
+
+// ListZonesAllWithContext records the call and calls ListZonesAllWithContextFunc, or ListZonesAllFunc if only that is set
+func (m *MockZoneService) ListZonesAllWithContext(ctx context.Context, p *cloudstack.ListZonesParams) (r0 *cloudstack.ListZonesResponse, r1 error) {
+	m.record("ListZonesAllWithContext", ctx, p)
+	if m.ListZonesAllWithContextFunc != nil {
+		return m.ListZonesAllWithContextFunc(ctx, p)
+	}
+	if m.ListZonesAllFunc != nil {
+		return m.ListZonesAllFunc(p)
+	}
+	r1 = notMocked("ZoneService.ListZonesAllWithContext")
+	return
+}
+
+// NewListZonesMetricsParams records the call and calls NewListZonesMetricsParamsFunc
+func (m *MockZoneService) NewListZonesMetricsParams() (r0 *cloudstack.ListZonesMetricsParams) {
+	m.record("NewListZonesMetricsParams")
+	if m.NewListZonesMetricsParamsFunc != nil {
+		return m.NewListZonesMetricsParamsFunc()
+	}
+	return cloudstack.NewZoneService(nil).NewListZonesMetricsParams()
+}
+
+// GetZonesMetricID records the call and calls GetZonesMetricIDFunc, or GetZonesMetricIDWithContextFunc if only that is set
+func (m *MockZoneService) GetZonesMetricID(name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetZonesMetricID", name, opts)
+	if m.GetZonesMetricIDFunc != nil {
+		return m.GetZonesMetricIDFunc(name, opts...)
+	}
+	if m.GetZonesMetricIDWithContextFunc != nil {
+		return m.GetZonesMetricIDWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ZoneService.GetZonesMetricID")
+	return
+}
+
+// GetZonesMetricIDWithContext records the call and calls GetZonesMetricIDWithContextFunc, or GetZonesMetricIDFunc if only that is set
+func (m *MockZoneService) GetZonesMetricIDWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 string, r1 int, r2 error) {
+	m.record("GetZonesMetricIDWithContext", ctx, name, opts)
+	if m.GetZonesMetricIDWithContextFunc != nil {
+		return m.GetZonesMetricIDWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetZonesMetricIDFunc != nil {
+		return m.GetZonesMetricIDFunc(name, opts...)
+	}
+	r2 = notMocked("ZoneService.GetZonesMetricIDWithContext")
+	return
+}
+
+// GetZonesMetricByName records the call and calls GetZonesMetricByNameFunc, or GetZonesMetricByNameWithContextFunc if only that is set
+func (m *MockZoneService) GetZonesMetricByName(name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ZonesMetric, r1 int, r2 error) {
+	m.record("GetZonesMetricByName", name, opts)
+	if m.GetZonesMetricByNameFunc != nil {
+		return m.GetZonesMetricByNameFunc(name, opts...)
+	}
+	if m.GetZonesMetricByNameWithContextFunc != nil {
+		return m.GetZonesMetricByNameWithContextFunc(context.Background(), name, opts...)
+	}
+	r2 = notMocked("ZoneService.GetZonesMetricByName")
+	return
+}
+
+// GetZonesMetricByNameWithContext records the call and calls GetZonesMetricByNameWithContextFunc, or GetZonesMetricByNameFunc if only that is set
+func (m *MockZoneService) GetZonesMetricByNameWithContext(ctx context.Context, name string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ZonesMetric, r1 int, r2 error) {
+	m.record("GetZonesMetricByNameWithContext", ctx, name, opts)
+	if m.GetZonesMetricByNameWithContextFunc != nil {
+		return m.GetZonesMetricByNameWithContextFunc(ctx, name, opts...)
+	}
+	if m.GetZonesMetricByNameFunc != nil {
+		return m.GetZonesMetricByNameFunc(name, opts...)
+	}
+	r2 = notMocked("ZoneService.GetZonesMetricByNameWithContext")
+	return
+}
+
+// GetZonesMetricByID records the call and calls GetZonesMetricByIDFunc, or GetZonesMetricByIDWithContextFunc if only that is set
+func (m *MockZoneService) GetZonesMetricByID(id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ZonesMetric, r1 int, r2 error) {
+	m.record("GetZonesMetricByID", id, opts)
+	if m.GetZonesMetricByIDFunc != nil {
+		return m.GetZonesMetricByIDFunc(id, opts...)
+	}
+	if m.GetZonesMetricByIDWithContextFunc != nil {
+		return m.GetZonesMetricByIDWithContextFunc(context.Background(), id, opts...)
+	}
+	r2 = notMocked("ZoneService.GetZonesMetricByID")
+	return
+}
+
+// GetZonesMetricByIDWithContext records the call and calls GetZonesMetricByIDWithContextFunc, or GetZonesMetricByIDFunc if only that is set
+func (m *MockZoneService) GetZonesMetricByIDWithContext(ctx context.Context, id string, opts ...cloudstack.OptionFunc) (r0 *cloudstack.ZonesMetric, r1 int, r2 error) {
+	m.record("GetZonesMetricByIDWithContext", ctx, id, opts)
+	if m.GetZonesMetricByIDWithContextFunc != nil {
+		return m.GetZonesMetricByIDWithContextFunc(ctx, id, opts...)
+	}
+	if m.GetZonesMetricByIDFunc != nil {
+		return m.GetZonesMetricByIDFunc(id, opts...)
+	}
+	r2 = notMocked("ZoneService.GetZonesMetricByIDWithContext")
+	return
+}
+
+// ListZonesMetrics records the call and calls ListZonesMetricsFunc, or ListZonesMetricsWithContextFunc if only that is set
+func (m *MockZoneService) ListZonesMetrics(p *cloudstack.ListZonesMetricsParams) (r0 *cloudstack.ListZonesMetricsResponse, r1 error) {
+	m.record("ListZonesMetrics", p)
+	if m.ListZonesMetricsFunc != nil {
+		return m.ListZonesMetricsFunc(p)
+	}
+	if m.ListZonesMetricsWithContextFunc != nil {
+		return m.ListZonesMetricsWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.ListZonesMetrics")
+	return
+}
+
+// ListZonesMetricsWithContext records the call and calls ListZonesMetricsWithContextFunc, or ListZonesMetricsFunc if only that is set
+func (m *MockZoneService) ListZonesMetricsWithContext(ctx context.Context, p *cloudstack.ListZonesMetricsParams) (r0 *cloudstack.ListZonesMetricsResponse, r1 error) {
+	m.record("ListZonesMetricsWithContext", ctx, p)
+	if m.ListZonesMetricsWithContextFunc != nil {
+		return m.ListZonesMetricsWithContextFunc(ctx, p)
+	}
+	if m.ListZonesMetricsFunc != nil {
+		return m.ListZonesMetricsFunc(p)
+	}
+	r1 = notMocked("ZoneService.ListZonesMetricsWithContext")
+	return
+}
+
+// NewListZonesMetricsPager records the call and calls NewListZonesMetricsPagerFunc
+func (m *MockZoneService) NewListZonesMetricsPager(p *cloudstack.ListZonesMetricsParams) (r0 *cloudstack.ListZonesMetricsPager) {
+	m.record("NewListZonesMetricsPager", p)
+	if m.NewListZonesMetricsPagerFunc != nil {
+		return m.NewListZonesMetricsPagerFunc(p)
+	}
+	return
+}
+
+// ListZonesMetricsAll records the call and calls ListZonesMetricsAllFunc, or ListZonesMetricsAllWithContextFunc if only that is set
+func (m *MockZoneService) ListZonesMetricsAll(p *cloudstack.ListZonesMetricsParams) (r0 *cloudstack.ListZonesMetricsResponse, r1 error) {
+	m.record("ListZonesMetricsAll", p)
+	if m.ListZonesMetricsAllFunc != nil {
+		return m.ListZonesMetricsAllFunc(p)
+	}
+	if m.ListZonesMetricsAllWithContextFunc != nil {
+		return m.ListZonesMetricsAllWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.ListZonesMetricsAll")
+	return
+}
+
+// ListZonesMetricsAllWithContext records the call and calls ListZonesMetricsAllWithContextFunc, or ListZonesMetricsAllFunc if only that is set
+func (m *MockZoneService) ListZonesMetricsAllWithContext(ctx context.Context, p *cloudstack.ListZonesMetricsParams) (r0 *cloudstack.ListZonesMetricsResponse, r1 error) {
+	m.record("ListZonesMetricsAllWithContext", ctx, p)
+	if m.ListZonesMetricsAllWithContextFunc != nil {
+		return m.ListZonesMetricsAllWithContextFunc(ctx, p)
+	}
+	if m.ListZonesMetricsAllFunc != nil {
+		return m.ListZonesMetricsAllFunc(p)
+	}
+	r1 = notMocked("ZoneService.ListZonesMetricsAllWithContext")
+	return
+}
+
+// NewReleaseDedicatedZoneParams records the call and calls NewReleaseDedicatedZoneParamsFunc
+func (m *MockZoneService) NewReleaseDedicatedZoneParams(zoneid string) (r0 *cloudstack.ReleaseDedicatedZoneParams) {
+	m.record("NewReleaseDedicatedZoneParams", zoneid)
+	if m.NewReleaseDedicatedZoneParamsFunc != nil {
+		return m.NewReleaseDedicatedZoneParamsFunc(zoneid)
+	}
+	return cloudstack.NewZoneService(nil).NewReleaseDedicatedZoneParams(zoneid)
+}
+
+// ReleaseDedicatedZone records the call and calls ReleaseDedicatedZoneFunc, or ReleaseDedicatedZoneWithContextFunc if only that is set
+func (m *MockZoneService) ReleaseDedicatedZone(p *cloudstack.ReleaseDedicatedZoneParams) (r0 *cloudstack.ReleaseDedicatedZoneResponse, r1 error) {
+	m.record("ReleaseDedicatedZone", p)
+	if m.ReleaseDedicatedZoneFunc != nil {
+		return m.ReleaseDedicatedZoneFunc(p)
+	}
+	if m.ReleaseDedicatedZoneWithContextFunc != nil {
+		return m.ReleaseDedicatedZoneWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.ReleaseDedicatedZone")
+	return
+}
+
+// ReleaseDedicatedZoneWithContext records the call and calls ReleaseDedicatedZoneWithContextFunc, or ReleaseDedicatedZoneFunc if only that is set
+func (m *MockZoneService) ReleaseDedicatedZoneWithContext(ctx context.Context, p *cloudstack.ReleaseDedicatedZoneParams) (r0 *cloudstack.ReleaseDedicatedZoneResponse, r1 error) {
+	m.record("ReleaseDedicatedZoneWithContext", ctx, p)
+	if m.ReleaseDedicatedZoneWithContextFunc != nil {
+		return m.ReleaseDedicatedZoneWithContextFunc(ctx, p)
+	}
+	if m.ReleaseDedicatedZoneFunc != nil {
+		return m.ReleaseDedicatedZoneFunc(p)
+	}
+	r1 = notMocked("ZoneService.ReleaseDedicatedZoneWithContext")
+	return
+}
+
+// ReleaseDedicatedZoneAsync records the call and calls ReleaseDedicatedZoneAsyncFunc, or ReleaseDedicatedZoneAsyncWithContextFunc if only that is set
+func (m *MockZoneService) ReleaseDedicatedZoneAsync(p *cloudstack.ReleaseDedicatedZoneParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReleaseDedicatedZoneAsync", p)
+	if m.ReleaseDedicatedZoneAsyncFunc != nil {
+		return m.ReleaseDedicatedZoneAsyncFunc(p)
+	}
+	if m.ReleaseDedicatedZoneAsyncWithContextFunc != nil {
+		return m.ReleaseDedicatedZoneAsyncWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.ReleaseDedicatedZoneAsync")
+	return
+}
+
+// ReleaseDedicatedZoneAsyncWithContext records the call and calls ReleaseDedicatedZoneAsyncWithContextFunc, or ReleaseDedicatedZoneAsyncFunc if only that is set
+func (m *MockZoneService) ReleaseDedicatedZoneAsyncWithContext(ctx context.Context, p *cloudstack.ReleaseDedicatedZoneParams) (r0 *cloudstack.Job, r1 error) {
+	m.record("ReleaseDedicatedZoneAsyncWithContext", ctx, p)
+	if m.ReleaseDedicatedZoneAsyncWithContextFunc != nil {
+		return m.ReleaseDedicatedZoneAsyncWithContextFunc(ctx, p)
+	}
+	if m.ReleaseDedicatedZoneAsyncFunc != nil {
+		return m.ReleaseDedicatedZoneAsyncFunc(p)
+	}
+	r1 = notMocked("ZoneService.ReleaseDedicatedZoneAsyncWithContext")
+	return
+}
+
+// NewUpdateZoneParams records the call and calls NewUpdateZoneParamsFunc
+func (m *MockZoneService) NewUpdateZoneParams(id string) (r0 *cloudstack.UpdateZoneParams) {
+	m.record("NewUpdateZoneParams", id)
+	if m.NewUpdateZoneParamsFunc != nil {
+		return m.NewUpdateZoneParamsFunc(id)
+	}
+	return cloudstack.NewZoneService(nil).NewUpdateZoneParams(id)
+}
+
+// UpdateZone records the call and calls UpdateZoneFunc, or UpdateZoneWithContextFunc if only that is set
+func (m *MockZoneService) UpdateZone(p *cloudstack.UpdateZoneParams) (r0 *cloudstack.UpdateZoneResponse, r1 error) {
+	m.record("UpdateZone", p)
+	if m.UpdateZoneFunc != nil {
+		return m.UpdateZoneFunc(p)
+	}
+	if m.UpdateZoneWithContextFunc != nil {
+		return m.UpdateZoneWithContextFunc(context.Background(), p)
+	}
+	r1 = notMocked("ZoneService.UpdateZone")
+	return
+}
+
+// UpdateZoneWithContext records the call and calls UpdateZoneWithContextFunc, or UpdateZoneFunc if only that is set
+func (m *MockZoneService) UpdateZoneWithContext(ctx context.Context, p *cloudstack.UpdateZoneParams) (r0 *cloudstack.UpdateZoneResponse, r1 error) {
+	m.record("UpdateZoneWithContext", ctx, p)
+	if m.UpdateZoneWithContextFunc != nil {
+		return m.UpdateZoneWithContextFunc(ctx, p)
+	}
+	if m.UpdateZoneFunc != nil {
+		return m.UpdateZoneFunc(p)
+	}
+	r1 = notMocked("ZoneService.UpdateZoneWithContext")
+	return
+}
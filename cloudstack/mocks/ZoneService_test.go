@@ -0,0 +1,83 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package mocks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+	"github.com/apache/cloudstack-go/v2/cloudstack/mocks"
+)
+
+func TestMockContextFallback(t *testing.T) {
+	want := &cloudstack.ListZonesResponse{Count: 1, Zones: []*cloudstack.Zone{{Id: "zone1"}}}
+
+	tests := []struct {
+		name string
+		set  func(m *mocks.MockZoneService)
+	}{
+		{
+			name: "without context",
+			set: func(m *mocks.MockZoneService) {
+				m.ListZonesFunc = func(p *cloudstack.ListZonesParams) (*cloudstack.ListZonesResponse, error) {
+					return want, nil
+				}
+			},
+		},
+		{
+			name: "with context",
+			set: func(m *mocks.MockZoneService) {
+				m.ListZonesWithContextFunc = func(ctx context.Context, p *cloudstack.ListZonesParams) (*cloudstack.ListZonesResponse, error) {
+					return want, nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, m := mocks.NewMockClient()
+			tt.set(m.Zone)
+
+			p := cs.Zone.NewListZonesParams()
+			if r, err := cs.Zone.ListZones(p); err != nil || r != want {
+				t.Errorf("Expected ListZones to return %v, got %v and %v", want, r, err)
+			}
+			if r, err := cs.Zone.ListZonesWithContext(context.Background(), p); err != nil || r != want {
+				t.Errorf("Expected ListZonesWithContext to return %v, got %v and %v", want, r, err)
+			}
+
+			// NewListZonesParams, ListZones and ListZonesWithContext; a fallback is not recorded again
+			if n := len(m.Zone.Calls()); n != 3 {
+				t.Errorf("Expected 3 recorded calls, got %d", n)
+			}
+		})
+	}
+}
+
+func TestMockNotMocked(t *testing.T) {
+	cs, _ := mocks.NewMockClient()
+
+	if _, err := cs.Zone.ListZonesWithContext(context.Background(), cs.Zone.NewListZonesParams()); !errors.Is(err, mocks.ErrNotMocked) {
+		t.Errorf("Expected an error wrapping %v, got %v", mocks.ErrNotMocked, err)
+	}
+}
@@ -67,6 +67,14 @@ func (e *apiInfoNotFoundError) Error() string {
 	return fmt.Sprintf("Could not find API details for: %s", e.api)
 }
 
+type apiNotMappedError struct {
+	api string
+}
+
+func (e *apiNotMappedError) Error() string {
+	return fmt.Sprintf("API %s is not mapped to any service in layout.go", e.api)
+}
+
 type generateError struct {
 	service *service
 	error   error
@@ -88,6 +96,8 @@ type service struct {
 	name string
 	apis []*API
 
+	methods []*method // The exported methods, parsed from the generated code
+
 	p  func(format string, args ...interface{}) // print raw
 	pn func(format string, args ...interface{}) // print with indent and newline
 }
@@ -140,6 +150,7 @@ type APIParam struct {
 	Description string `json:"description"`
 	Type        string `json:"type"`
 	Required    bool   `json:"required"`
+	Length      int    `json:"length"`
 }
 
 // APIResponse represents a API response
@@ -177,6 +188,10 @@ func main() {
 		log.Fatal(err)
 	}
 
+	if err = as.checkParamEnums(); err != nil {
+		log.Fatal(err)
+	}
+
 	if err = as.WriteGeneralCode(); err != nil {
 		log.Fatal(err)
 	}
@@ -187,6 +202,10 @@ func main() {
 		}
 	}
 
+	if err = as.WriteMockCode(); err != nil {
+		log.Fatal(err)
+	}
+
 	outdir, err := sourceDir()
 	if err != nil {
 		log.Fatal(err)
@@ -253,6 +272,9 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("")
 	pn("package %s", pkg)
 	pn("")
+	// The rand package is ambiguous for goimports, so import it explicitly
+	pn("import \"crypto/rand\"")
+	pn("")
 	pn("// UnlimitedResourceID is a special ID to define an unlimited resource")
 	pn("const UnlimitedResourceID = \"-1\"")
 	pn("")
@@ -269,14 +291,70 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("// OptionFunc can be passed to the courtesy helper functions to set additional parameters")
 	pn("type OptionFunc func(*CloudStackClient, interface{}) error")
 	pn("")
+	pn("// CSError is returned when the CloudStack API responds with an error. Use errors.As to get")
+	pn("// hold of it, or one of the IsNotFound, IsPermissionDenied or IsConcurrentOperation helpers")
+	pn("// to check for some common failures.")
 	pn("type CSError struct {")
-	pn("	ErrorCode   int    `json:\"errorcode\"`")
-	pn("	CSErrorCode int    `json:\"cserrorcode\"`")
-	pn("	ErrorText   string `json:\"errortext\"`")
+	pn("	StatusCode  int           `json:\"-\"`           // The HTTP status code of the response")
+	pn("	Command     string        `json:\"-\"`           // The API command that failed")
+	pn("	ErrorCode   int           `json:\"errorcode\"`")
+	pn("	CSErrorCode int           `json:\"cserrorcode\"`")
+	pn("	ErrorText   string        `json:\"errortext\"`")
+	pn("	UUIDList    []CSErrorUUID `json:\"uuidList\"`  // The UUIDs of the entities CloudStack related to the error")
+	pn("	RequestID   string        `json:\"-\"`           // The ID of the request, sent using the X-Request-Id header")
+	pn("}")
+	pn("")
+	pn("// CSErrorUUID is a single entry of the UUID list that can be part of a CloudStack error")
+	pn("type CSErrorUUID struct {")
+	pn("	UUID        string `json:\"uuid\"`")
+	pn("	Description string `json:\"description\"`")
+	pn("}")
+	pn("")
+	pn("func (e *CSError) Error() string {")
+	pn("	return fmt.Sprintf(\"CloudStack API error %%d (CSExceptionErrorCode: %%d): %%s\", e.ErrorCode, e.CSErrorCode, e.ErrorText)")
+	pn("}")
+	pn("")
+	pn("// Is makes it possible to compare a CSError with one of the sentinel errors using errors.Is")
+	pn("func (e *CSError) Is(target error) bool {")
+	pn("	text := strings.ToLower(e.ErrorText)")
+	pn("")
+	pn("	switch target {")
+	pn("	case ErrNotFound:")
+	pn("		return e.ErrorCode == 431 && (strings.Contains(text, \"does not exist\") ||")
+	pn("			strings.Contains(text, \"unable to find\") || strings.Contains(text, \"not found\"))")
+	pn("	case ErrPermissionDenied:")
+	pn("		return e.ErrorCode == 401 || e.ErrorCode == 531 || strings.Contains(text, \"does not have permission\") ||")
+	pn("			strings.Contains(text, \"permission denied\")")
+	pn("	case ErrConcurrentOperation:")
+	pn("		return strings.Contains(text, \"concurrent operation\")")
+	pn("	}")
+	pn("	return false")
+	pn("}")
+	pn("")
+	pn("var (")
+	pn("	// ErrNotFound matches errors caused by referencing an entity that does not exist")
+	pn("	ErrNotFound = errors.New(\"entity does not exist\")")
+	pn("")
+	pn("	// ErrPermissionDenied matches errors caused by missing credentials or permissions")
+	pn("	ErrPermissionDenied = errors.New(\"permission denied\")")
+	pn("")
+	pn("	// ErrConcurrentOperation matches errors caused by another operation running on the same entity")
+	pn("	ErrConcurrentOperation = errors.New(\"concurrent operation\")")
+	pn(")")
+	pn("")
+	pn("// IsNotFound returns true if err reports that the requested entity does not exist")
+	pn("func IsNotFound(err error) bool {")
+	pn("	return errors.Is(err, ErrNotFound)")
 	pn("}")
 	pn("")
-	pn("func (e *CSError) Error() error {")
-	pn("	return fmt.Errorf(\"CloudStack API error %%d (CSExceptionErrorCode: %%d): %%s\", e.ErrorCode, e.CSErrorCode, e.ErrorText)")
+	pn("// IsPermissionDenied returns true if err reports missing credentials or permissions")
+	pn("func IsPermissionDenied(err error) bool {")
+	pn("	return errors.Is(err, ErrPermissionDenied)")
+	pn("}")
+	pn("")
+	pn("// IsConcurrentOperation returns true if err reports that another operation is running on the same entity")
+	pn("func IsConcurrentOperation(err error) bool {")
+	pn("	return errors.Is(err, ErrConcurrentOperation)")
 	pn("}")
 	pn("")
 	pn("type CloudStackClient struct {")
@@ -289,9 +367,20 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("	async   bool         // Wait for async calls to finish")
 	pn("	options []OptionFunc // A list of option functions to apply to all API calls")
 	pn("	timeout int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds")
+	pn("	watcher *JobWatcher  // If set, async jobs are polled in batches by the watcher")
+	pn("	retry   RetryPolicy  // The policy used to retry failed requests")
+	pn("	concurrency int      // Max number of pages fetched concurrently by the List...All helpers")
+	pn("	session *session     // If set, requests are authenticated using a session key")
+	pn("	expiry  time.Duration // If set, requests use signature version 3 and expire after this duration")
+	pn("	middleware []Middleware // The middleware wrapping every request, see WithMiddleware")
+	pn("	traces     []*ClientTrace // The traces notified about every request and async job")
+	pn("	limiter    *RateLimiter   // The optional rate limiter, see WithRateLimiter")
+	pn("	methods    map[string]string // The HTTP method used per (lowercased) command, see WithHTTPMethod")
+	pn("	maxQueryLength int           // Requests with a longer query use POST, see WithMaxGETQueryLength")
+	pn("	validate       bool          // If set, the params of every request are validated, see WithValidation")
 	pn("")
 	for _, s := range as.services {
-		pn("  %s *%s", strings.TrimSuffix(s.name, "Service"), s.name)
+		pn("  %s %sIface", strings.TrimSuffix(s.name, "Service"), s.name)
 	}
 	pn("}")
 	pn("")
@@ -322,6 +411,7 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("		async:   async,")
 	pn("		options: []OptionFunc{},")
 	pn("		timeout: 300,")
+	pn("		maxQueryLength: DefaultMaxGETQueryLength,")
 	pn("	}")
 	pn("")
 	pn("	for _, fn := range options {")
@@ -370,17 +460,40 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("var AsyncTimeoutErr = errors.New(\"Timeout while waiting for async job to finish\")")
 	pn("")
 	pn("// A helper function that you can use to get the result of a running async job. If the job is not finished within the configured")
-	pn("// timeout, the async job returns a AsyncTimeoutErr.")
+	pn("// timeout, the async job returns a AsyncTimeoutErr. If the job failed, an *AsyncJobError is returned.")
 	pn("func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (json.RawMessage, error) {")
+	pn("	return cs.GetAsyncJobResultWithContext(context.Background(), jobid, timeout)")
+	pn("}")
+	pn("")
+	pn("// GetAsyncJobResultWithContext is the same as GetAsyncJobResult, but it stops polling and returns the context")
+	pn("// error as soon as ctx is cancelled or its deadline expires.")
+	pn("func (cs *CloudStackClient) GetAsyncJobResultWithContext(ctx context.Context, jobid string, timeout int64) (json.RawMessage, error) {")
+	pn("	ctx = cs.traceJobWaitStart(ctx, jobid)")
+	pn("	start := time.Now()")
+	pn("")
+	pn("	b, err := cs.getAsyncJobResult(ctx, jobid, timeout)")
+	pn("	cs.traceJobWaitDone(ctx, &JobWaitInfo{JobID: jobid, Duration: time.Since(start), Err: err})")
+	pn("")
+	pn("	return b, err")
+	pn("}")
+	pn("")
+	pn("func (cs *CloudStackClient) getAsyncJobResult(ctx context.Context, jobid string, timeout int64) (json.RawMessage, error) {")
+	pn("	if cs.watcher != nil {")
+	pn("		return cs.watcher.Wait(ctx, jobid, timeout)")
+	pn("	}")
+	pn("")
 	pn("	var timer time.Duration")
 	pn("	currentTime := time.Now().Unix()")
 	pn("")
 	pn("		for {")
 	pn("		p := cs.Asyncjob.NewQueryAsyncJobResultParams(jobid)")
-	pn("		r, err := cs.Asyncjob.QueryAsyncJobResult(p)")
+	pn("		pctx := cs.traceJobPollStart(ctx, jobid)")
+	pn("		r, err := cs.Asyncjob.QueryAsyncJobResultWithContext(pctx, p)")
 	pn("		if err != nil {")
+	pn("			cs.traceJobPollDone(pctx, &JobPollInfo{JobID: jobid, Err: err})")
 	pn("			return nil, err")
 	pn("		}")
+	pn("		cs.traceJobPollDone(pctx, &JobPollInfo{JobID: jobid, Status: r.Jobstatus})")
 	pn("")
 	pn("		// Status 1 means the job is finished successfully")
 	pn("		if r.Jobstatus == 1 {")
@@ -389,11 +502,7 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("")
 	pn("		// When the status is 2, the job has failed")
 	pn("		if r.Jobstatus == 2 {")
-	pn("			if r.Jobresulttype == \"text\" {")
-	pn("				return nil, fmt.Errorf(string(r.Jobresult))")
-	pn("			} else {")
-	pn("				return nil, fmt.Errorf(\"Undefined error: %%s\", string(r.Jobresult))")
-	pn("			}")
+	pn("			return nil, newAsyncJobError(r)")
 	pn("		}")
 	pn("")
 	pn("		if time.Now().Unix()-currentTime > timeout {")
@@ -406,18 +515,155 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("			timer++")
 	pn("		}")
 	pn("")
-	pn("		time.Sleep(timer * time.Second)")
+	pn("		if err := sleepWithContext(ctx, timer*time.Second); err != nil {")
+	pn("			return nil, err")
+	pn("		}")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// AsyncJobError is returned when an async job finished, but failed to complete successfully")
+	pn("type AsyncJobError struct {")
+	pn("	JobID           string          // The ID of the failed job")
+	pn("	Cmd             string          // The command executed by the job")
+	pn("	Jobresultcode   int             // The result code of the job")
+	pn("	Jobinstancetype string          // The type of the instance the job was working on")
+	pn("	Jobinstanceid   string          // The ID of the instance the job was working on")
+	pn("	ErrorCode       int             // The error code parsed from the job result")
+	pn("	CSErrorCode     int             // The CS exception error code parsed from the job result")
+	pn("	ErrorText       string          // The error text parsed from the job result")
+	pn("	Jobresult       json.RawMessage // The unparsed job result")
+	pn("}")
+	pn("")
+	pn("func newAsyncJobError(r *QueryAsyncJobResultResponse) *AsyncJobError {")
+	pn("	e := &AsyncJobError{")
+	pn("		JobID:           r.JobID,")
+	pn("		Cmd:             r.Cmd,")
+	pn("		Jobresultcode:   r.Jobresultcode,")
+	pn("		Jobinstancetype: r.Jobinstancetype,")
+	pn("		Jobinstanceid:   r.Jobinstanceid,")
+	pn("		Jobresult:       r.Jobresult,")
+	pn("	}")
+	pn("")
+	pn("	var result struct {")
+	pn("		ErrorCode   int    `json:\"errorcode\"`")
+	pn("		CSErrorCode int    `json:\"cserrorcode\"`")
+	pn("		ErrorText   string `json:\"errortext\"`")
+	pn("	}")
+	pn("	var text string")
+	pn("")
+	pn("	switch {")
+	pn("	case json.Unmarshal(r.Jobresult, &result) == nil:")
+	pn("		e.ErrorCode = result.ErrorCode")
+	pn("		e.CSErrorCode = result.CSErrorCode")
+	pn("		e.ErrorText = result.ErrorText")
+	pn("	case json.Unmarshal(r.Jobresult, &text) == nil:")
+	pn("		e.ErrorText = text")
+	pn("	default:")
+	pn("		e.ErrorText = string(r.Jobresult)")
+	pn("	}")
+	pn("")
+	pn("	return e")
+	pn("}")
+	pn("")
+	pn("func (e *AsyncJobError) Error() string {")
+	pn("	if e.ErrorCode == 0 {")
+	pn("		return fmt.Sprintf(\"Async job %%s (%%s) failed: %%s\", e.JobID, e.Cmd, e.ErrorText)")
+	pn("	}")
+	pn("	return fmt.Sprintf(\"Async job %%s (%%s) failed with error %%d (CSExceptionErrorCode: %%d): %%s\",")
+	pn("		e.JobID, e.Cmd, e.ErrorCode, e.CSErrorCode, e.ErrorText)")
+	pn("}")
+	pn("")
+	pn("// Is makes it possible to compare an AsyncJobError with one of the sentinel errors using errors.Is")
+	pn("func (e *AsyncJobError) Is(target error) bool {")
+	pn("	return (&CSError{ErrorCode: e.ErrorCode, CSErrorCode: e.CSErrorCode, ErrorText: e.ErrorText}).Is(target)")
+	pn("}")
+	pn("")
+	pn("// sleepWithContext pauses the current goroutine for at least the duration d, or until")
+	pn("// ctx is done in which case the context error is returned.")
+	pn("func sleepWithContext(ctx context.Context, d time.Duration) error {")
+	pn("	t := time.NewTimer(d)")
+	pn("	defer t.Stop()")
+	pn("")
+	pn("	select {")
+	pn("	case <-ctx.Done():")
+	pn("		return ctx.Err()")
+	pn("	case <-t.C:")
+	pn("		return nil")
 	pn("	}")
 	pn("}")
 	pn("")
 	pn("// Execute the request against a CS API. Will return the raw JSON data returned by the API and nil if")
-	pn("// no error occured. If the API returns an error the result will be nil and the HTTP error code and CS")
-	pn("// error details. If a processing (code) error occurs the result will be nil and the generated error")
+	pn("// no error occured. If the API returns an error the result will be nil and a *CSError containing the HTTP")
+	pn("// status and CS error details. If a processing (code) error occurs the result will be nil and the generated error")
 	pn("func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawMessage, error) {")
-	pn("	params.Set(\"apiKey\", cs.apiKey)")
+	pn("	return cs.newRequestWithContext(context.Background(), api, params)")
+	pn("}")
+	pn("")
+	pn("// Same as newRequest, but the HTTP request is bound to ctx so it can be cancelled")
+	pn("func (cs *CloudStackClient) newRequestWithContext(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {")
+	pn("	// The first middleware is the outermost one, so it is the first to see the request")
+	pn("	next := RequestFunc(cs.retryRequest)")
+	pn("	for i := len(cs.middleware) - 1; i >= 0; i-- {")
+	pn("		next = cs.middleware[i](next)")
+	pn("	}")
+	pn("	return next(ctx, api, params)")
+	pn("}")
+	pn("")
+	pn("// Executes the request, and retries failed attempts according to the retry policy")
+	pn("func (cs *CloudStackClient) retryRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {")
+	pn("	ctx = cs.traceRequestStart(ctx, api, params)")
+	pn("	ctx, state := withRequestState(ctx)")
+	pn("	start := time.Now()")
+	pn("")
+	pn("	for attempt := 1; ; attempt++ {")
+	pn("		b, err := cs.doRequest(ctx, api, params)")
+	pn("		if err == nil || ctx.Err() != nil || !cs.retry.shouldRetry(api, attempt, err) {")
+	pn("			cs.traceRequestDone(ctx, newRequestInfo(cs, api, params, state, attempt, start, b, err))")
+	pn("			return b, err")
+	pn("		}")
+	pn("		cs.traceRequestRetry(ctx, newRequestInfo(cs, api, params, state, attempt, start, b, err))")
+	pn("")
+	pn("		if err := sleepWithContext(ctx, cs.retry.backoff(attempt)); err != nil {")
+	pn("			cs.traceRequestDone(ctx, newRequestInfo(cs, api, params, state, attempt, start, nil, err))")
+	pn("			return nil, err")
+	pn("		}")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// Executes a single attempt of a request, see newRequest")
+	pn("func (cs *CloudStackClient) doRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {")
+	pn("	// Add the transport and auth fields to a copy, so the caller, the middleware and the trace hooks")
+	pn("	// never see any credentials, and every attempt starts from the original params")
+	pn("	params = cloneValues(params)")
 	pn("	params.Set(\"command\", api)")
 	pn("	params.Set(\"response\", \"json\")")
 	pn("")
+	pn("	// A session client authenticates using a session key instead of a signature")
+	pn("	if cs.session != nil {")
+	pn("		return cs.session.doRequest(ctx, cs, api, params)")
+	pn("	}")
+	pn("")
+	pn("	params.Set(\"apiKey\", cs.apiKey)")
+	pn("")
+	pn("	// Use signature version 3, so the signed request expires")
+	pn("	if cs.expiry > 0 {")
+	pn("		setExpires(params, cs.expiry)")
+	pn("	}")
+	pn("")
+	pn("	return cs.sendRequest(ctx, api, cs.signQuery(params))")
+	pn("}")
+	pn("")
+	pn("// Returns a copy of params, so fields can be added to the copy without changing params")
+	pn("func cloneValues(params url.Values) url.Values {")
+	pn("	c := make(url.Values, len(params))")
+	pn("	for k, v := range params {")
+	pn("		c[k] = append([]string(nil), v...)")
+	pn("	}")
+	pn("	return c")
+	pn("}")
+	pn("")
+	pn("// Returns the encoded query string of params, including the signature")
+	pn("func (cs *CloudStackClient) signQuery(params url.Values) string {")
 	pn("	// Generate signature for API call")
 	pn("	// * Serialize parameters, URL encoding only values and sort them by key, done by encodeValues")
 	pn("	// * Convert the entire argument string to lowercase")
@@ -431,48 +677,121 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("	mac.Write([]byte(s3))")
 	pn("	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))")
 	pn("")
-	pn("	var err error")
-	pn("	var resp *http.Response")
-	pn("	if !cs.HTTPGETOnly && (api == \"deployVirtualMachine\" || api == \"login\" || api == \"updateVirtualMachine\") {")
-	pn("		// The deployVirtualMachine API should be called using a POST call")
-	pn("  	// so we don't have to worry about the userdata size")
+	pn("	// Add the signature to the query, without adding it to the params so the")
+	pn("	// params can be signed again when the request is retried")
+	pn("	return s + \"&signature=\" + url.QueryEscape(signature)")
+	pn("}")
+	pn("")
+	pn("// Returns the HTTP method used to execute the api with the encoded query")
+	pn("func (cs *CloudStackClient) httpMethod(api string, query string) string {")
+	pn("	switch {")
+	pn("	case cs.HTTPGETOnly:")
+	pn("		return http.MethodGet")
+	pn("	case secretCommands[api]:")
+	pn("		// Secrets are always sent using POST, so they don't end up in any access logs")
+	pn("		return http.MethodPost")
+	pn("	}")
 	pn("")
-	pn("		// Add the unescaped signature to the POST params")
-	pn("		params.Set(\"signature\", signature)")
+	pn("	if m, ok := cs.methods[strings.ToLower(api)]; ok {")
+	pn("		return m")
+	pn("	}")
 	pn("")
+	pn("	// Switch to POST when the URL would get too long for the server")
+	pn("	if cs.maxQueryLength > 0 && len(query) > cs.maxQueryLength {")
+	pn("		return http.MethodPost")
+	pn("	}")
+	pn("	return http.MethodGet")
+	pn("}")
+	pn("")
+	pn("// The commands with params carrying secrets (like passwords, private keys or user data),")
+	pn("// which are always sent using POST")
+	pn("var secretCommands = map[string]bool{")
+	for _, api := range as.secretCommands() {
+		pn("	\"%s\": true,", api)
+	}
+	pn("}")
+	pn("")
+	pn("// Sends the encoded query to CloudStack and returns the raw JSON data returned by the API")
+	pn("func (cs *CloudStackClient) sendRequest(ctx context.Context, api string, query string) (json.RawMessage, error) {")
+	pn("	method := cs.httpMethod(api, query)")
+	pn("	recordRequestMethod(ctx, method)")
+	pn("")
+	pn("	var req *http.Request")
+	pn("	var err error")
+	pn("	if method == http.MethodPost {")
 	pn("		// Make a POST call")
-	pn("		resp, err = cs.client.PostForm(cs.baseURL, params)")
+	pn("		req, err = http.NewRequestWithContext(ctx, http.MethodPost, cs.baseURL, strings.NewReader(query))")
+	pn("		if err != nil {")
+	pn("			return nil, err")
+	pn("		}")
+	pn("		req.Header.Set(\"Content-Type\", \"application/x-www-form-urlencoded\")")
 	pn("	} else {")
 	pn("		// Create the final URL before we issue the request")
-	pn("		url := cs.baseURL + \"?\" + s + \"&signature=\" + url.QueryEscape(signature)")
+	pn("		url := cs.baseURL + \"?\" + query")
 	pn("")
 	pn("		// Make a GET call")
-	pn("		resp, err = cs.client.Get(url)")
+	pn("		req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil)")
+	pn("		if err != nil {")
+	pn("			return nil, err")
+	pn("		}")
 	pn("	}")
-	pn("	if err != nil {")
-	pn("		return nil, err")
+	pn("")
+	pn("	// Identify the request, so it can be correlated with the logs of proxies or load balancers")
+	pn("	requestID := newRequestID()")
+	pn("	req.Header.Set(\"X-Request-Id\", requestID)")
+	pn("")
+	pn("	// Wait until the rate limiter allows another request")
+	pn("	if cs.limiter != nil {")
+	pn("		if err := cs.limiter.Wait(ctx); err != nil {")
+	pn("			return nil, err")
+	pn("		}")
 	pn("	}")
-	pn("	defer resp.Body.Close()")
 	pn("")
-	pn("	b, err := ioutil.ReadAll(resp.Body)")
+	pn("	resp, err := cs.client.Do(req)")
 	pn("	if err != nil {")
 	pn("		return nil, err")
 	pn("	}")
+	pn("	defer resp.Body.Close()")
 	pn("")
-	pn("	// Need to get the raw value to make the result play nice")
-	pn("	b, err = getRawValue(b)")
+	pn("	b, err := ioutil.ReadAll(resp.Body)")
 	pn("	if err != nil {")
 	pn("		return nil, err")
 	pn("	}")
 	pn("")
 	pn("	if resp.StatusCode != 200 {")
-	pn("		var e CSError")
-	pn("		if err := json.Unmarshal(b, &e); err != nil {")
-	pn("			return nil, err")
+	pn("		e := &CSError{StatusCode: resp.StatusCode, Command: api, RequestID: requestID}")
+	pn("")
+	pn("		// Prefer the request ID of a proxy or load balancer that replaced ours")
+	pn("		if id := resp.Header.Get(\"X-Request-Id\"); id != \"\" {")
+	pn("			e.RequestID = id")
 	pn("		}")
-	pn("		return nil, e.Error()")
+	pn("")
+	pn("		// Not every failing response is a CloudStack error (e.g. when a proxy is")
+	pn("		// in between), so fall back to using the body as the error text")
+	pn("		if raw, err := getRawValue(b); err != nil || json.Unmarshal(raw, e) != nil {")
+	pn("			e.ErrorText = strings.TrimSpace(string(b))")
+	pn("		}")
+	pn("")
+	pn("		// Stop sending requests until the API throttling quota is reset")
+	pn("		if cs.limiter != nil && (e.StatusCode == 429 || e.ErrorCode == 429) {")
+	pn("			cs.limiter.throttle()")
+	pn("		}")
+	pn("		return nil, e")
+	pn("	}")
+	pn("")
+	pn("	// Need to get the raw value to make the result play nice")
+	pn("	return getRawValue(b)")
+	pn("}")
+	pn("")
+	pn("// Returns a random (version 4) UUID that identifies a request")
+	pn("func newRequestID() string {")
+	pn("	var b [16]byte")
+	pn("	if _, err := rand.Read(b[:]); err != nil {")
+	pn("		return \"\"")
 	pn("	}")
-	pn("	return b, nil")
+	pn("	b[6] = b[6]&0x0f | 0x40")
+	pn("	b[8] = b[8]&0x3f | 0x80")
+	pn("	return fmt.Sprintf(\"%%x-%%x-%%x-%%x-%%x\", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])")
 	pn("}")
 	pn("")
 	pn("// Custom version of net/url Encode that only URL escapes values")
@@ -647,6 +966,15 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("	}")
 	pn("}")
 	pn("")
+	for _, ms := range sortedMapSchemas() {
+		pn("// %s", ms.doc)
+		pn("type %s struct {", ms.name)
+		for _, f := range ms.fields {
+			pn("	%s %s", f.name, f.typ)
+		}
+		pn("}")
+		pn("")
+	}
 	for _, s := range as.services {
 		pn("type %s struct {", s.name)
 		pn("  cs *CloudStackClient")
@@ -665,6 +993,41 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	return clean, err
 }
 
+// Returns the sorted names of the APIs with params carrying secrets
+func (as *allServices) secretCommands() []string {
+	var apis []string
+	for _, s := range as.services {
+		for _, a := range s.apis {
+			for _, ap := range a.Params {
+				if isSecretParam(ap.Name) {
+					apis = append(apis, a.Name)
+					break
+				}
+			}
+		}
+	}
+	sort.Strings(apis)
+	return apis
+}
+
+// Returns true if the value of the param is a secret
+func isSecretParam(name string) bool {
+	name = strings.ToLower(name)
+	switch name {
+	case "passwordenabled":
+		return false
+	case "token":
+		return true
+	}
+
+	for _, s := range []string{"ipsecpsk", "password", "privatekey", "secret", "userdata"} {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *service) WriteGeneratedCode() error {
 	outdir, err := sourceDir()
 	if err != nil {
@@ -801,12 +1164,43 @@ func (s *service) GenerateCode() ([]byte, error) {
 		pn("}")
 		pn("")
 	}
+	if s.name == "KubernetesService" {
+		pn("// GetKubernetesClusterKubeconfig returns the kubeconfig of the Kubernetes cluster with the given ID")
+		pn("func (s *KubernetesService) GetKubernetesClusterKubeconfig(id string, opts ...OptionFunc) ([]byte, error) {")
+		pn("	return s.GetKubernetesClusterKubeconfigWithContext(context.Background(), id, opts...)")
+		pn("}")
+		pn("")
+		pn("// GetKubernetesClusterKubeconfigWithContext is the same as GetKubernetesClusterKubeconfig, but uses ctx to cancel the request")
+		pn("func (s *KubernetesService) GetKubernetesClusterKubeconfigWithContext(ctx context.Context, id string, opts ...OptionFunc) ([]byte, error) {")
+		pn("	p := s.NewGetKubernetesClusterConfigParams()")
+		pn("	p.SetId(id)")
+		pn("")
+		pn("	for _, fn := range append(s.cs.options, opts...) {")
+		pn("		if err := fn(s.cs, p); err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("	}")
+		pn("")
+		pn("	r, err := s.GetKubernetesClusterConfigWithContext(ctx, p)")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+		pn("	if r.Configdata == \"\" {")
+		pn("		return nil, fmt.Errorf(\"No kubeconfig found for Kubernetes cluster %%s\", id)")
+		pn("	}")
+		pn("")
+		pn("	return []byte(r.Configdata), nil")
+		pn("}")
+		pn("")
+	}
 	if s.name == "CustomService" {
 		pn("type CustomServiceParams struct {")
 		pn("	p map[string]interface{}")
 		pn("}")
 		pn("")
-		pn("func (p *CustomServiceParams) toURLValues() url.Values {")
+		pn("// ToURLValues returns the params encoded as they are sent to CloudStack")
+		pn("func (p *CustomServiceParams) ToURLValues() url.Values {")
 		pn("	u := url.Values{}")
 		pn("	if p.p == nil {")
 		pn("		return u")
@@ -845,7 +1239,12 @@ func (s *service) GenerateCode() ([]byte, error) {
 		pn("}")
 		pn("")
 		pn("func (s *CustomService) CustomRequest(api string, p *CustomServiceParams, result interface{}) error {")
-		pn("	resp, err := s.cs.newRequest(api, p.toURLValues())")
+		pn("	return s.CustomRequestWithContext(context.Background(), api, p, result)")
+		pn("}")
+		pn("")
+		pn("// CustomRequestWithContext is the same as CustomRequest, but uses ctx to cancel the request")
+		pn("func (s *CustomService) CustomRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error {")
+		pn("	resp, err := s.cs.newRequestWithContext(ctx, api, p.ToURLValues())")
 		pn("	if err != nil {")
 		pn("		return err")
 		pn("	}")
@@ -857,10 +1256,14 @@ func (s *service) GenerateCode() ([]byte, error) {
 	for _, a := range s.apis {
 		s.generateParamType(a)
 		s.generateToURLValuesFunc(a)
+		s.generateValidateFunc(a)
+		s.generateMarshalJSONFunc(a)
+		s.generateUnmarshalJSONFunc(a)
 		s.generateParamSettersFunc(a)
 		s.generateNewParamTypeFunc(a)
 		s.generateHelperFuncs(a)
 		s.generateNewAPICallFunc(a)
+		s.generatePagerFuncs(a)
 		s.generateResponseType(a)
 	}
 
@@ -869,7 +1272,7 @@ func (s *service) GenerateCode() ([]byte, error) {
 		buf.WriteTo(os.Stdout)
 		return buf.Bytes(), err
 	}
-	return clean, nil
+	return s.addInterface(clean)
 }
 
 func (s *service) generateParamType(a *API) {
@@ -883,14 +1286,19 @@ func (s *service) generateParamType(a *API) {
 func (s *service) generateToURLValuesFunc(a *API) {
 	pn := s.pn
 
-	pn("func (p *%s) toURLValues() url.Values {", capitalize(a.Name+"Params"))
+	pn("// ToURLValues returns the params encoded as they are sent to CloudStack")
+	pn("func (p *%s) ToURLValues() url.Values {", capitalize(a.Name+"Params"))
 	pn("	u := url.Values{}")
 	pn("	if p.p == nil {")
 	pn("		return u")
 	pn("	}")
 	for _, ap := range a.Params {
 		pn("	if v, found := p.p[\"%s\"]; found {", ap.Name)
-		s.generateConvertCode(a.Name, ap.Name, mapType(ap.Type))
+		if ms := getMapSchema(a.Name, ap); ms != nil {
+			s.generateMapSchemaConvertCode(ap.Name, ms)
+		} else {
+			s.generateConvertCode(a.Name, ap.Name, mapType(ap.Type))
+		}
 		pn("	}")
 	}
 	pn("	return u")
@@ -898,6 +1306,78 @@ func (s *service) generateToURLValuesFunc(a *API) {
 	pn("")
 }
 
+func (s *service) generateMarshalJSONFunc(a *API) {
+	pn := s.pn
+
+	pn("// MarshalJSON returns the params that are set as a JSON object")
+	pn("func (p *%s) MarshalJSON() ([]byte, error) {", capitalize(a.Name+"Params"))
+	pn("	if p.p == nil {")
+	pn("		return []byte(\"{}\"), nil")
+	pn("	}")
+	pn("	return json.Marshal(p.p)")
+	pn("}")
+	pn("")
+}
+
+func (s *service) generateUnmarshalJSONFunc(a *API) {
+	pn := s.pn
+
+	// Group the params by their Go type, so every type is decoded by a single case
+	var types []string
+	names := make(map[string][]string)
+	found := make(map[string]bool)
+	for _, ap := range a.Params {
+		if found[ap.Name] {
+			continue
+		}
+		found[ap.Name] = true
+
+		typ := paramType(a.Name, ap)
+		if _, ok := names[typ]; !ok {
+			types = append(types, typ)
+		}
+		names[typ] = append(names[typ], fmt.Sprintf("%q", ap.Name))
+	}
+
+	pn("// UnmarshalJSON sets the params from a JSON object as returned by MarshalJSON, decoding every")
+	pn("// param into the same type as used by its setter")
+	pn("func (p *%s) UnmarshalJSON(b []byte) error {", capitalize(a.Name+"Params"))
+	pn("	var m map[string]json.RawMessage")
+	pn("	if err := json.Unmarshal(b, &m); err != nil {")
+	pn("		return err")
+	pn("	}")
+	pn("")
+	pn("	p.p = make(map[string]interface{}, len(m))")
+	if len(types) == 0 {
+		pn("	for k := range m {")
+		pn("		return fmt.Errorf(\"Unknown param %%s for %s\", k)", a.Name)
+		pn("	}")
+		pn("	return nil")
+		pn("}")
+		pn("")
+		return
+	}
+	pn("	for k, raw := range m {")
+	pn("		var err error")
+	pn("		switch k {")
+	for _, typ := range types {
+		pn("		case %s:", strings.Join(names[typ], ", "))
+		pn("			var v %s", typ)
+		pn("			err = json.Unmarshal(raw, &v)")
+		pn("			p.p[k] = v")
+	}
+	pn("		default:")
+	pn("			return fmt.Errorf(\"Unknown param %%s for %s\", k)", a.Name)
+	pn("		}")
+	pn("		if err != nil {")
+	pn("			return fmt.Errorf(\"Invalid value for param %%s of %s: %%w\", k, err)", a.Name)
+	pn("		}")
+	pn("	}")
+	pn("	return nil")
+	pn("}")
+	pn("")
+}
+
 func (s *service) generateConvertCode(cmd, name, typ string) {
 	pn := s.pn
 
@@ -927,12 +1407,6 @@ func (s *service) generateConvertCode(cmd, name, typ string) {
 			} else {
 				pn("	u.Set(fmt.Sprintf(\"%s[%%d].%%s\", i, k), m[k])", name)
 			}
-		case "serviceproviderlist":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].service\", i), k)", name)
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].provider\", i), m[k])", name)
-		case "usersecuritygrouplist":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].account\", i), k)", name)
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].group\", i), m[k])", name)
 		default:
 			pn("	u.Set(fmt.Sprintf(\"%s[%%d].key\", i), k)", name)
 			pn("	u.Set(fmt.Sprintf(\"%s[%%d].value\", i), m[k])", name)
@@ -941,6 +1415,25 @@ func (s *service) generateConvertCode(cmd, name, typ string) {
 	}
 }
 
+func (s *service) generateMapSchemaConvertCode(name string, ms *mapSchema) {
+	pn := s.pn
+
+	pn("for i, item := range v.([]%s) {", ms.name)
+	for _, f := range ms.fields {
+		switch f.typ {
+		case "int":
+			pn("	if item.%s != 0 {", f.name)
+			pn("		u.Set(fmt.Sprintf(\"%s[%%d].%s\", i), strconv.Itoa(item.%s))", name, f.key, f.name)
+			pn("	}")
+		default:
+			pn("	if item.%s != \"\" {", f.name)
+			pn("		u.Set(fmt.Sprintf(\"%s[%%d].%s\", i), item.%s)", name, f.key, f.name)
+			pn("	}")
+		}
+	}
+	pn("}")
+}
+
 func (s *service) parseParamName(name string) string {
 	if name != "type" {
 		return name
@@ -954,13 +1447,22 @@ func (s *service) generateParamSettersFunc(a *API) {
 
 	for _, ap := range a.Params {
 		if !found[ap.Name] {
-			pn("func (p *%s) Set%s(v %s) {", capitalize(a.Name+"Params"), capitalize(ap.Name), mapType(ap.Type))
+			pn("func (p *%s) Set%s(v %s) {", capitalize(a.Name+"Params"), capitalize(ap.Name), paramType(a.Name, ap))
 			pn("	if p.p == nil {")
 			pn("		p.p = make(map[string]interface{})")
 			pn("	}")
 			pn("	p.p[\"%s\"] = v", ap.Name)
 			pn("}")
 			pn("")
+			pn("func (p *%s) Reset%s() {", capitalize(a.Name+"Params"), capitalize(ap.Name))
+			pn("	delete(p.p, \"%s\")", ap.Name)
+			pn("}")
+			pn("")
+			pn("func (p *%s) Get%s() (%s, bool) {", capitalize(a.Name+"Params"), capitalize(ap.Name), paramType(a.Name, ap))
+			pn("	value, ok := p.p[\"%s\"].(%s)", ap.Name, paramType(a.Name, ap))
+			pn("	return value, ok")
+			pn("}")
+			pn("")
 			found[ap.Name] = true
 		}
 	}
@@ -978,7 +1480,7 @@ func (s *service) generateNewParamTypeFunc(a *API) {
 	for _, ap := range a.Params {
 		if ap.Required {
 			rp = append(rp, ap)
-			p("%s %s, ", s.parseParamName(ap.Name), mapType(ap.Type))
+			p("%s %s, ", s.parseParamName(ap.Name), paramType(a.Name, ap))
 		}
 	}
 	pn(") *%s {", tn)
@@ -996,7 +1498,7 @@ func (s *service) generateNewParamTypeFunc(a *API) {
 }
 
 func (s *service) generateHelperFuncs(a *API) {
-	p, pn := s.p, s.pn
+	pn := s.pn
 
 	if strings.HasPrefix(a.Name, "list") {
 		v, found := hasNameOrKeywordParamField(a.Params)
@@ -1010,21 +1512,37 @@ func (s *service) generateHelperFuncs(a *API) {
 				}
 			}
 
-			// Generate the function signature
-			pn("// This is a courtesy helper function, which in some cases may not work as expected!")
-			p("func (s *%s) Get%sID(%s string, ", s.name, parseSingular(ln), v)
+			// Collect the params and the arguments of the helper functions
+			var params, args []string
 			for _, ap := range a.Params {
 				if ap.Required {
-					p("%s %s, ", s.parseParamName(ap.Name), mapType(ap.Type))
+					params = append(params, fmt.Sprintf("%s %s", s.parseParamName(ap.Name), paramType(a.Name, ap)))
+					args = append(args, s.parseParamName(ap.Name))
 				}
 			}
+			byIDArgs := args
 			if parseSingular(ln) == "Iso" {
-				p("isofilter string, ")
+				params = append(params, "isofilter string")
+				args = append(args, "isofilter")
 			}
 			if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
-				p("zoneid string, ")
+				params = append(params, "zoneid string")
+				args = append(args, "zoneid")
 			}
-			pn("opts ...OptionFunc) (string, int, error) {")
+			params = append(params, "opts ...OptionFunc")
+			args = append(args, "opts...")
+			byIDArgs = append(byIDArgs, "opts...")
+
+			// Generate the function signature
+			pn("// This is a courtesy helper function, which in some cases may not work as expected!")
+			pn("func (s *%s) Get%sID(%s) (string, int, error) {", s.name, parseSingular(ln), strings.Join(append([]string{v + " string"}, params...), ", "))
+			pn("	return s.Get%sIDWithContext(%s)", parseSingular(ln), strings.Join(append([]string{"context.Background()", v}, args...), ", "))
+			pn("}")
+			pn("")
+
+			// Generate the context aware function signature
+			pn("// Get%sIDWithContext is the same as Get%sID, but uses ctx to cancel the request", parseSingular(ln), parseSingular(ln))
+			pn("func (s *%s) Get%sIDWithContext(%s) (string, int, error) {", s.name, parseSingular(ln), strings.Join(append([]string{"ctx context.Context", v + " string"}, params...), ", "))
 
 			// Generate the function body
 			pn("	p := &List%sParams{}", ln)
@@ -1049,7 +1567,7 @@ func (s *service) generateHelperFuncs(a *API) {
 			pn("		}")
 			pn("	}")
 			pn("")
-			pn("	l, err := s.List%s(p)", ln)
+			pn("	l, err := s.List%sWithContext(ctx, p)", ln)
 			pn("	if err != nil {")
 			pn("		return \"\", -1, err")
 			pn("	}")
@@ -1082,45 +1600,22 @@ func (s *service) generateHelperFuncs(a *API) {
 			if hasIDParamField(a.Params) {
 				// Generate the function signature
 				pn("// This is a courtesy helper function, which in some cases may not work as expected!")
-				p("func (s *%s) Get%sByName(name string, ", s.name, parseSingular(ln))
-				for _, ap := range a.Params {
-					if ap.Required {
-						p("%s %s, ", s.parseParamName(ap.Name), mapType(ap.Type))
-					}
-				}
-				if parseSingular(ln) == "Iso" {
-					p("isofilter string, ")
-				}
-				if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
-					p("zoneid string, ")
-				}
-				pn("opts ...OptionFunc) (*%s, int, error) {", parseSingular(ln))
+				pn("func (s *%s) Get%sByName(%s) (*%s, int, error) {", s.name, parseSingular(ln), strings.Join(append([]string{"name string"}, params...), ", "), parseSingular(ln))
+				pn("	return s.Get%sByNameWithContext(%s)", parseSingular(ln), strings.Join(append([]string{"context.Background()", "name"}, args...), ", "))
+				pn("}")
+				pn("")
+
+				// Generate the context aware function signature
+				pn("// Get%sByNameWithContext is the same as Get%sByName, but uses ctx to cancel the requests", parseSingular(ln), parseSingular(ln))
+				pn("func (s *%s) Get%sByNameWithContext(%s) (*%s, int, error) {", s.name, parseSingular(ln), strings.Join(append([]string{"ctx context.Context", "name string"}, params...), ", "), parseSingular(ln))
 
 				// Generate the function body
-				p("  id, count, err := s.Get%sID(name, ", parseSingular(ln))
-				for _, ap := range a.Params {
-					if ap.Required {
-						p("%s, ", s.parseParamName(ap.Name))
-					}
-				}
-				if parseSingular(ln) == "Iso" {
-					p("isofilter, ")
-				}
-				if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
-					p("zoneid, ")
-				}
-				pn("opts...)")
+				pn("  id, count, err := s.Get%sIDWithContext(%s)", parseSingular(ln), strings.Join(append([]string{"ctx", "name"}, args...), ", "))
 				pn("  if err != nil {")
 				pn("    return nil, count, err")
 				pn("  }")
 				pn("")
-				p("  r, count, err := s.Get%sByID(id, ", parseSingular(ln))
-				for _, ap := range a.Params {
-					if ap.Required {
-						p("%s, ", s.parseParamName(ap.Name))
-					}
-				}
-				pn("opts...)")
+				pn("  r, count, err := s.Get%sByIDWithContext(%s)", parseSingular(ln), strings.Join(append([]string{"ctx", "id"}, byIDArgs...), ", "))
 				pn("  if err != nil {")
 				pn("    return nil, count, err")
 				pn("  }")
@@ -1133,20 +1628,34 @@ func (s *service) generateHelperFuncs(a *API) {
 		if hasIDParamField(a.Params) {
 			ln := strings.TrimPrefix(a.Name, "list")
 
-			// Generate the function signature
-			pn("// This is a courtesy helper function, which in some cases may not work as expected!")
-			p("func (s *%s) Get%sByID(id string, ", s.name, parseSingular(ln))
+			// Collect the params and the arguments of the helper functions
+			params := []string{"id string"}
+			args := []string{"id"}
 			for _, ap := range a.Params {
 				if ap.Required && s.parseParamName(ap.Name) != "id" {
-					p("%s %s, ", ap.Name, mapType(ap.Type))
+					params = append(params, fmt.Sprintf("%s %s", s.parseParamName(ap.Name), paramType(a.Name, ap)))
+					args = append(args, s.parseParamName(ap.Name))
 				}
 			}
+			params = append(params, "opts ...OptionFunc")
+			args = append(args, "opts...")
+
+			rt := parseSingular(ln)
 			if ln == "LoadBalancerRuleInstances" {
-				pn("opts ...OptionFunc) (*VirtualMachine, int, error) {")
-			} else {
-				pn("opts ...OptionFunc) (*%s, int, error) {", parseSingular(ln))
+				rt = "VirtualMachine"
 			}
 
+			// Generate the function signature
+			pn("// This is a courtesy helper function, which in some cases may not work as expected!")
+			pn("func (s *%s) Get%sByID(%s) (*%s, int, error) {", s.name, parseSingular(ln), strings.Join(params, ", "), rt)
+			pn("	return s.Get%sByIDWithContext(%s)", parseSingular(ln), strings.Join(append([]string{"context.Background()"}, args...), ", "))
+			pn("}")
+			pn("")
+
+			// Generate the context aware function signature
+			pn("// Get%sByIDWithContext is the same as Get%sByID, but uses ctx to cancel the request", parseSingular(ln), parseSingular(ln))
+			pn("func (s *%s) Get%sByIDWithContext(%s) (*%s, int, error) {", s.name, parseSingular(ln), strings.Join(append([]string{"ctx context.Context"}, params...), ", "), rt)
+
 			// Generate the function body
 			pn("	p := &List%sParams{}", ln)
 			pn("	p.p = make(map[string]interface{})")
@@ -1164,12 +1673,10 @@ func (s *service) generateHelperFuncs(a *API) {
 			pn("		}")
 			pn("	}")
 			pn("")
-			pn("	l, err := s.List%s(p)", ln)
+			pn("	l, err := s.List%sWithContext(ctx, p)", ln)
 			pn("	if err != nil {")
-			pn("		if strings.Contains(err.Error(), fmt.Sprintf(")
-			pn("			\"Invalid parameter id value=%%s due to incorrect long value format, \"+")
-			pn("				\"or entity does not exist\", id)) {")
-			pn("			return nil, 0, fmt.Errorf(\"No match found for %%s: %%+v\", id, l)")
+			pn("		if IsNotFound(err) {")
+			pn("			return nil, 0, fmt.Errorf(\"No match found for %%s: %%w\", id, err)")
 			pn("		}")
 			pn("		return nil, -1, err")
 			pn("	}")
@@ -1181,7 +1688,7 @@ func (s *service) generateHelperFuncs(a *API) {
 				pn("")
 			}
 			pn("	if l.Count == 0 {")
-			pn("	  return nil, l.Count, fmt.Errorf(\"No match found for %%s: %%+v\", id, l)")
+			pn("	  return nil, l.Count, fmt.Errorf(\"No match found for %%s: %%w\", id, ErrNotFound)")
 			pn("	}")
 			pn("")
 			pn("	if l.Count == 1 {")
@@ -1239,23 +1746,20 @@ func (s *service) generateNewAPICallFunc(a *API) {
 	// Generate the function signature
 	pn("// %s", a.Description)
 	pn("func (s *%s) %s(p *%s) (*%s, error) {", s.name, n, n+"Params", strings.TrimPrefix(n, "Configure")+"Response")
+	pn("	return s.%sWithContext(context.Background(), p)", n)
+	pn("}")
+	pn("")
+
+	// Generate the context aware function signature
+	pn("// %sWithContext is the same as %s, but uses ctx to cancel the request and any async job polling", n, n)
+	pn("func (s *%s) %sWithContext(ctx context.Context, p *%s) (*%s, error) {", s.name, n, n+"Params", strings.TrimPrefix(n, "Configure")+"Response")
 
 	// Generate the function body
-	if n == "QueryAsyncJobResult" {
-		pn("	var resp json.RawMessage")
-		pn("	var err error")
-		pn("")
-		pn("	// We should be able to retry on failure as this call is idempotent")
-		pn("	for i := 0; i < 3; i++ {")
-		pn("		resp, err = s.cs.newRequest(\"%s\", p.toURLValues())", a.Name)
-		pn("		if err == nil {")
-		pn("			break")
-		pn("		}")
-		pn("		time.Sleep(500 * time.Millisecond)")
-		pn("	}")
-	} else {
-		pn("	resp, err := s.cs.newRequest(\"%s\", p.toURLValues())", a.Name)
-	}
+	pn("	if err := s.cs.validateParams(p); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	resp, err := s.cs.newRequestWithContext(ctx, \"%s\", p.ToURLValues())", a.Name)
 	pn("	if err != nil {")
 	pn("		return nil, err")
 	pn("	}")
@@ -1269,6 +1773,8 @@ func (s *service) generateNewAPICallFunc(a *API) {
 		"CreateSecurityGroup",
 		"CreateServiceOffering",
 		"CreateUser",
+		"GetApiLimit",
+		"GetKubernetesClusterConfig",
 		"GetVirtualMachineUserData",
 		"RegisterSSHKeyPair",
 		"RegisterUserKeys":
@@ -1292,7 +1798,7 @@ func (s *service) generateNewAPICallFunc(a *API) {
 	if a.Isasync {
 		pn("	// If we have a async client, we need to wait for the async result")
 		pn("	if s.cs.async {")
-		pn("		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)")
+		pn("		b, err := s.cs.GetAsyncJobResultWithContext(ctx, r.JobID, s.cs.timeout)")
 		pn("		if err != nil {")
 		pn("			if err == AsyncTimeoutErr {")
 		pn("				return &r, err")
@@ -1300,34 +1806,7 @@ func (s *service) generateNewAPICallFunc(a *API) {
 		pn("			return nil, err")
 		pn("		}")
 		pn("")
-		if !isSuccessOnlyResponse(a.Response) {
-			pn("		b, err = getRawValue(b)")
-			pn("		if err != nil {")
-			pn("		  return nil, err")
-			pn("		}")
-			pn("")
-		}
-		if s.name == "FirewallService" {
-			pn("		b, err = convertFirewallServiceResponse(b)")
-			pn("		if err != nil {")
-			pn("			return nil, err")
-			pn("		}")
-			pn("")
-		}
-		if n == "AuthorizeSecurityGroupIngress" {
-			pn("		b, err = convertAuthorizeSecurityGroupIngressResponse(b)")
-			pn("		if err != nil {")
-			pn("			return nil, err")
-			pn("		}")
-			pn("")
-		}
-		if n == "AuthorizeSecurityGroupEgress" {
-			pn("		b, err = convertAuthorizeSecurityGroupEgressResponse(b)")
-			pn("		if err != nil {")
-			pn("			return nil, err")
-			pn("		}")
-			pn("")
-		}
+		s.generateAsyncResultConvertCode(a)
 		pn("		if err := json.Unmarshal(b, &r); err != nil {")
 		pn("			return nil, err")
 		pn("		}")
@@ -1337,6 +1816,226 @@ func (s *service) generateNewAPICallFunc(a *API) {
 	pn("	return &r, nil")
 	pn("}")
 	pn("")
+
+	if a.Isasync {
+		s.generateNewAsyncJobFunc(a)
+	}
+}
+
+// Generates the code that converts the result of a finished async job, so it can be
+// unmarshalled into the response type of the API. The code expects the result to be
+// stored in `b` and an `err` variable to be declared.
+func (s *service) generateAsyncResultConvertCode(a *API) {
+	pn := s.pn
+	n := capitalize(a.Name)
+
+	if !isSuccessOnlyResponse(a.Response) {
+		pn("		b, err = getRawValue(b)")
+		pn("		if err != nil {")
+		pn("		  return nil, err")
+		pn("		}")
+		pn("")
+	}
+	if s.name == "FirewallService" {
+		pn("		b, err = convertFirewallServiceResponse(b)")
+		pn("		if err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("")
+	}
+	if n == "AuthorizeSecurityGroupIngress" {
+		pn("		b, err = convertAuthorizeSecurityGroupIngressResponse(b)")
+		pn("		if err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("")
+	}
+	if n == "AuthorizeSecurityGroupEgress" {
+		pn("		b, err = convertAuthorizeSecurityGroupEgressResponse(b)")
+		pn("		if err != nil {")
+		pn("			return nil, err")
+		pn("		}")
+		pn("")
+	}
+}
+
+func (s *service) hasAsyncResultConvertCode(a *API) bool {
+	n := capitalize(a.Name)
+	return !isSuccessOnlyResponse(a.Response) || s.name == "FirewallService" ||
+		n == "AuthorizeSecurityGroupIngress" || n == "AuthorizeSecurityGroupEgress"
+}
+
+func (s *service) generateNewAsyncJobFunc(a *API) {
+	pn := s.pn
+	n := capitalize(a.Name)
+	rn := strings.TrimPrefix(n, "Configure") + "Response"
+
+	// Generate the function signature
+	pn("// %sAsync starts the async job for %s and returns a handle to the job, without waiting for it to finish.", n, n)
+	pn("// When the job is finished, the result can be decoded into a %s using Job.Result", rn)
+	pn("func (s *%s) %sAsync(p *%s) (*Job, error) {", s.name, n, n+"Params")
+	pn("	return s.%sAsyncWithContext(context.Background(), p)", n)
+	pn("}")
+	pn("")
+
+	// Generate the context aware function signature
+	pn("// %sAsyncWithContext is the same as %sAsync, but uses ctx to cancel the request and to bound the polling of the job", n, n)
+	pn("func (s *%s) %sAsyncWithContext(ctx context.Context, p *%s) (*Job, error) {", s.name, n, n+"Params")
+
+	// Generate the function body
+	pn("	if err := s.cs.validateParams(p); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	resp, err := s.cs.newRequestWithContext(ctx, \"%s\", p.ToURLValues())", a.Name)
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	var r %s", rn)
+	pn("	if err := json.Unmarshal(resp, &r); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	if s.hasAsyncResultConvertCode(a) {
+		pn("	return newJob(ctx, s.cs, r.JobID, func(b json.RawMessage) (json.RawMessage, error) {")
+		pn("		var err error")
+		pn("")
+		s.generateAsyncResultConvertCode(a)
+		pn("		return b, nil")
+		pn("	}), nil")
+	} else {
+		pn("	return newJob(ctx, s.cs, r.JobID, nil), nil")
+	}
+	pn("}")
+	pn("")
+}
+
+// Returns true if the API is a list API that supports paging and has a response with a
+// single list of items, so all pages can be fetched using the generated pager functions
+func isPageableListAPI(a *API) bool {
+	if !strings.HasPrefix(a.Name, "list") {
+		return false
+	}
+
+	switch a.Name {
+	case "listCapabilities", "listLoadBalancerRuleInstances":
+		return false
+	}
+
+	page, pagesize := false, false
+	for _, ap := range a.Params {
+		switch ap.Name {
+		case "page":
+			page = true
+		case "pagesize":
+			pagesize = true
+		}
+	}
+	return page && pagesize
+}
+
+func (s *service) generatePagerFuncs(a *API) {
+	if !isPageableListAPI(a) {
+		return
+	}
+
+	pn := s.pn
+	n := capitalize(a.Name)
+	ln := capitalize(strings.TrimPrefix(a.Name, "list"))
+	tn := n + "Pager"
+
+	// Generate the pager type
+	pn("// %s iterates over all pages of a %s call. Use Next to fetch the next page until it", tn, n)
+	pn("// returns false, and check Err afterwards to see if all pages were fetched successfully.")
+	pn("type %s struct {", tn)
+	pn("	pager")
+	pn("")
+	pn("	s *%s", s.name)
+	pn("	p *%s", n+"Params")
+	pn("	r *%s", n+"Response")
+	pn("}")
+	pn("")
+
+	// Generate the pager functions
+	pn("// New%s returns a pager for all pages of a %s call with the given params", tn, n)
+	pn("func (s *%s) New%s(p *%s) *%s {", s.name, tn, n+"Params", tn)
+	pn("	return &%s{pager: newPager(p.p), s: s, p: p}", tn)
+	pn("}")
+	pn("")
+	pn("// Next fetches the next page and returns true if the page contains any items")
+	pn("func (pg *%s) Next() bool {", tn)
+	pn("	return pg.NextWithContext(context.Background())")
+	pn("}")
+	pn("")
+	pn("// NextWithContext is the same as Next, but uses ctx to cancel the request")
+	pn("func (pg *%s) NextWithContext(ctx context.Context) bool {", tn)
+	pn("	if !pg.next() {")
+	pn("		return false")
+	pn("	}")
+	pn("")
+	pn("	p := &%s{p: copyParams(pg.p.p)}", n+"Params")
+	pn("	p.SetPage(pg.page)")
+	pn("	p.SetPagesize(pg.pagesize)")
+	pn("")
+	pn("	r, err := pg.s.%sWithContext(ctx, p)", n)
+	pn("	if err != nil {")
+	pn("		return pg.update(0, err)")
+	pn("	}")
+	pn("	pg.r = r")
+	pn("")
+	pn("	return pg.update(len(r.%s), nil)", ln)
+	pn("}")
+	pn("")
+	pn("// Page returns the page fetched by the last call to Next")
+	pn("func (pg *%s) Page() *%s {", tn, n+"Response")
+	pn("	return pg.r")
+	pn("}")
+	pn("")
+
+	// Generate the list all functions
+	pn("// %sAll fetches all pages of a %s call and returns all items in a single response.", n, n)
+	pn("// Use the WithListConcurrency option to fetch multiple pages concurrently.")
+	pn("func (s *%s) %sAll(p *%s) (*%s, error) {", s.name, n, n+"Params", n+"Response")
+	pn("	return s.%sAllWithContext(context.Background(), p)", n)
+	pn("}")
+	pn("")
+	pn("// %sAllWithContext is the same as %sAll, but uses ctx to cancel the requests", n, n)
+	pn("func (s *%s) %sAllWithContext(ctx context.Context, p *%s) (*%s, error) {", s.name, n, n+"Params", n+"Response")
+	pn("	pagesize := pageSize(p.p)")
+	pn("")
+	pn("	var mu sync.Mutex")
+	pn("	pages := make(map[int][]*%s)", parseSingular(ln))
+	pn("")
+	pn("	err := fetchAllPages(ctx, s.cs.concurrency, pagesize, func(ctx context.Context, page int) (int, int, error) {")
+	pn("		pp := &%s{p: copyParams(p.p)}", n+"Params")
+	pn("		pp.SetPage(page)")
+	pn("		pp.SetPagesize(pagesize)")
+	pn("")
+	pn("		l, err := s.%sWithContext(ctx, pp)", n)
+	pn("		if err != nil {")
+	pn("			return 0, 0, err")
+	pn("		}")
+	pn("")
+	pn("		mu.Lock()")
+	pn("		pages[page] = l.%s", ln)
+	pn("		mu.Unlock()")
+	pn("")
+	pn("		return len(l.%s), l.Count, nil", ln)
+	pn("	})")
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	r := &%s{}", n+"Response")
+	pn("	for page := 1; page <= len(pages); page++ {")
+	pn("		r.%s = append(r.%s, pages[page]...)", ln, ln)
+	pn("	}")
+	pn("	r.Count = len(r.%s)", ln)
+	pn("")
+	pn("	return r, nil")
+	pn("}")
+	pn("")
 }
 
 func isSuccessOnlyResponse(resp APIResponses) bool {
@@ -1370,6 +2069,14 @@ func (s *service) generateResponseType(a *API) {
 		case "listAsyncJobs":
 			pn("	Count int `json:\"count\"`")
 			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "asyncjobs")
+		case "listBackupProviderOfferings":
+			pn("	Count int `json:\"count\"`")
+			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "backupoffering")
+		case "listBackupProviders":
+			pn("	Count int `json:\"count\"`")
+			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "providers")
+		case "listBackupSchedule":
+			pn("	%s *%s `json:\"%s\"`", ln, parseSingular(ln), "backupschedule")
 		case "listCapabilities":
 			pn("    %s *%s `json:\"%s\"`", ln, parseSingular(ln), "capability")
 		case "listEgressFirewallRules":
@@ -1379,9 +2086,18 @@ func (s *service) generateResponseType(a *API) {
 			pn("	Count int `json:\"count\"`")
 			pn("	LBRuleVMIDIPs []*%s `json:\"%s\"`", parseSingular(ln), "lbrulevmidip")
 			pn("	LoadBalancerRuleInstances []*VirtualMachine `json:\"%s\"`", strings.ToLower(parseSingular(ln)))
+		case "listStoragePoolsMetrics":
+			pn("	Count int `json:\"count\"`")
+			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "storagepool")
 		case "listVirtualMachinesMetrics":
 			pn("	Count int `json:\"count\"`")
 			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "virtualmachine")
+		case "listVolumesMetrics":
+			pn("	Count int `json:\"count\"`")
+			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "volume")
+		case "listZonesMetrics":
+			pn("	Count int `json:\"count\"`")
+			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "zone")
 		case "registerTemplate":
 			pn("	Count int `json:\"count\"`")
 			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "template")
@@ -1528,10 +2244,12 @@ func getAllServices(listApis string) (*allServices, []error, error) {
 	// Generate a complete set of services with their methods (APIs)
 	as := &allServices{}
 	errors := []error{}
+	mapped := make(map[string]bool)
 	for sn, apis := range layout {
 		typeNames[sn] = true
 		s := &service{name: sn}
 		for _, api := range apis {
+			mapped[api] = true
 			a, found := ai[api]
 			if !found {
 				errors = append(errors, &apiInfoNotFoundError{api})
@@ -1541,10 +2259,23 @@ func getAllServices(listApis string) (*allServices, []error, error) {
 		}
 		for _, apis := range s.apis {
 			sort.Sort(apis.Params)
+			apis.Params = uniqueParams(apis.Params)
 		}
 		as.services = append(as.services, s)
 	}
 
+	// Make sure we don't silently skip any APIs that are not mapped to a service
+	var names []string
+	for api := range ai {
+		if !mapped[api] {
+			names = append(names, api)
+		}
+	}
+	sort.Strings(names)
+	for _, api := range names {
+		errors = append(errors, &apiNotMappedError{api})
+	}
+
 	// Add an extra field to enable adding a custom service
 	as.services = append(as.services, &service{name: "CustomService"})
 	sort.Sort(as.services)
@@ -1552,6 +2283,19 @@ func getAllServices(listApis string) (*allServices, []error, error) {
 	return as, errors, nil
 }
 
+// Some APIs list the same param more than once, so only keep the first one
+func uniqueParams(params APIParams) APIParams {
+	found := make(map[string]bool)
+	unique := APIParams{}
+	for _, ap := range params {
+		if !found[ap.Name] {
+			unique = append(unique, ap)
+			found[ap.Name] = true
+		}
+	}
+	return unique
+}
+
 func getAPIInfo(listApis string) (map[string]*API, error) {
 	apis, err := ioutil.ReadFile(listApis)
 	if err != nil {
@@ -1587,6 +2331,14 @@ func sourceDir() (string, error) {
 	return outdir, nil
 }
 
+// Returns the Go type of a parameter of the given command
+func paramType(cmd string, ap *APIParam) string {
+	if ms := getMapSchema(cmd, ap); ms != nil {
+		return "[]" + ms.name
+	}
+	return mapType(ap.Type)
+}
+
 func mapType(t string) string {
 	switch t {
 	case "boolean":
@@ -0,0 +1,469 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+const mockPkg = "mocks"
+
+const licenseHeader = `//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+`
+
+// The import paths of the packages that can be used in the signatures of the
+// service methods, keyed by package name.
+var importPaths = map[string]string{
+	"context": "context",
+	"json":    "encoding/json",
+	"time":    "time",
+	"url":     "net/url",
+}
+
+// method describes an exported method of a service. The methods are parsed
+// from the generated code of a service, so the interface and the mock of the
+// service always match the methods that are actually generated.
+type method struct {
+	name     string
+	params   []*methodParam
+	results  []ast.Expr
+	variadic bool
+}
+
+type methodParam struct {
+	name string
+	typ  ast.Expr
+}
+
+// Parses the exported methods of the named type from the source code, in the
+// order in which they are defined.
+func parseMethods(src []byte, recv string) ([]*method, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var methods []*method
+	for _, d := range f.Decls {
+		fd, ok := d.(*ast.FuncDecl)
+		if !ok || fd.Recv == nil || !fd.Name.IsExported() {
+			continue
+		}
+		star, ok := fd.Recv.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		if id, ok := star.X.(*ast.Ident); !ok || id.Name != recv {
+			continue
+		}
+
+		m := &method{name: fd.Name.Name}
+		for _, fl := range fd.Type.Params.List {
+			if len(fl.Names) == 0 {
+				return nil, fmt.Errorf("method %s.%s has unnamed params", recv, m.name)
+			}
+			typ := fl.Type
+			if e, ok := typ.(*ast.Ellipsis); ok {
+				m.variadic = true
+				typ = e.Elt
+			}
+			for _, n := range fl.Names {
+				m.params = append(m.params, &methodParam{name: n.Name, typ: typ})
+			}
+		}
+		if fd.Type.Results != nil {
+			for _, fl := range fd.Type.Results.List {
+				for i := 0; i < len(fl.Names) || i == 0; i++ {
+					m.results = append(m.results, fl.Type)
+				}
+			}
+		}
+		methods = append(methods, m)
+	}
+
+	return methods, nil
+}
+
+// Returns the type expression as Go code. If qualifier is set, all exported
+// identifiers are qualified with it, and the packages used are added to imports.
+func typeString(e ast.Expr, qualifier string, imports map[string]bool) string {
+	switch t := e.(type) {
+	case *ast.Ident:
+		if qualifier != "" && t.IsExported() {
+			return qualifier + "." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X, qualifier, imports)
+	case *ast.ArrayType:
+		if t.Len != nil {
+			panic(fmt.Sprintf("unsupported array type: %#v", t))
+		}
+		return "[]" + typeString(t.Elt, qualifier, imports)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key, qualifier, imports) + "]" + typeString(t.Value, qualifier, imports)
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		if _, ok := importPaths[pkg]; !ok {
+			panic(fmt.Sprintf("unknown import path of package %s", pkg))
+		}
+		if imports != nil {
+			imports[pkg] = true
+		}
+		return pkg + "." + t.Sel.Name
+	case *ast.InterfaceType:
+		if len(t.Methods.List) > 0 {
+			panic(fmt.Sprintf("unsupported interface type: %#v", t))
+		}
+		return "interface{}"
+	default:
+		panic(fmt.Sprintf("unsupported type: %#v", t))
+	}
+}
+
+// Returns the params of the method, like `(p *Params, opts ...OptionFunc)`
+func (m *method) paramList(qualifier string, imports map[string]bool) string {
+	params := make([]string, len(m.params))
+	for i, mp := range m.params {
+		typ := typeString(mp.typ, qualifier, imports)
+		if m.variadic && i == len(m.params)-1 {
+			typ = "..." + typ
+		}
+		params[i] = mp.name + " " + typ
+	}
+	return "(" + strings.Join(params, ", ") + ")"
+}
+
+// Returns the results of the method. If named is set the results are named r0,
+// r1... so they can be returned as zero values using a naked return.
+func (m *method) resultList(qualifier string, imports map[string]bool, named bool) string {
+	results := make([]string, len(m.results))
+	for i, r := range m.results {
+		results[i] = typeString(r, qualifier, imports)
+		if named {
+			results[i] = fmt.Sprintf("r%d %s", i, results[i])
+		}
+	}
+
+	switch {
+	case len(results) == 0:
+		return ""
+	case len(results) == 1 && !named:
+		return " " + results[0]
+	default:
+		return " (" + strings.Join(results, ", ") + ")"
+	}
+}
+
+// Returns the arguments to pass the params of the method on to another call
+func (m *method) argList() string {
+	args := make([]string, len(m.params))
+	for i, mp := range m.params {
+		args[i] = mp.name
+		if m.variadic && i == len(m.params)-1 {
+			args[i] += "..."
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// Returns true if m is the same as the method without the context, except for its first param
+func (m *method) withContextOf(base *method) bool {
+	if len(m.params) == 0 || m.name != base.name+"WithContext" {
+		return false
+	}
+	rest := &method{params: m.params[1:], results: m.results, variadic: m.variadic}
+	return rest.paramList("", nil)+rest.resultList("", nil, false) == base.paramList("", nil)+base.resultList("", nil, false)
+}
+
+// Returns true if the last result of the method is an error
+func (m *method) returnsError() bool {
+	if len(m.results) == 0 {
+		return false
+	}
+	id, ok := m.results[len(m.results)-1].(*ast.Ident)
+	return ok && id.Name == "error"
+}
+
+// Returns the interface of the service, containing all its methods
+func (s *service) interfaceCode() []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// %sIface is the interface implemented by %s, which makes it\n", s.name, s.name)
+	fmt.Fprintf(&buf, "// possible to replace the service with a mock, see the mocks package\n")
+	fmt.Fprintf(&buf, "type %sIface interface {\n", s.name)
+	for _, m := range s.methods {
+		fmt.Fprintf(&buf, "\t%s%s%s\n", m.name, m.paramList("", nil), m.resultList("", nil, false))
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+	return buf.Bytes()
+}
+
+// Adds the interface of the service to the generated code of the service,
+// right after the package clause.
+func (s *service) addInterface(code []byte) ([]byte, error) {
+	methods, err := parseMethods(code, s.name)
+	if err != nil {
+		return code, err
+	}
+	s.methods = methods
+
+	clause := []byte(fmt.Sprintf("package %s\n\n", pkg))
+	i := bytes.Index(code, clause)
+	if i < 0 {
+		return code, fmt.Errorf("package clause not found")
+	}
+	i += len(clause)
+
+	var buf bytes.Buffer
+	buf.Write(code[:i])
+	buf.Write(s.interfaceCode())
+	buf.Write(code[i:])
+
+	return format.Source(buf.Bytes())
+}
+
+func (as *allServices) WriteMockCode() error {
+	outdir, err := sourceDir()
+	if err != nil {
+		return err
+	}
+	outdir = path.Join(outdir, mockPkg)
+
+	if err := os.MkdirAll(outdir, 0755); err != nil {
+		return fmt.Errorf("Failed to Mkdir %s: %v", outdir, err)
+	}
+
+	for _, s := range as.services {
+		code, err := s.MockCode()
+		if err != nil {
+			return fmt.Errorf("failed to generate the mock of %s: %v", s.name, err)
+		}
+		if err := ioutil.WriteFile(path.Join(outdir, s.name+".go"), code, 0644); err != nil {
+			return err
+		}
+	}
+
+	code, err := as.MockClientCode()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(outdir, "MockClient.go"), code, 0644)
+}
+
+// Writes the license header and the package clause and imports of a mock file
+func writeMockHeader(buf *bytes.Buffer, imports map[string]bool) {
+	buf.WriteString(licenseHeader)
+	fmt.Fprintf(buf, "\npackage %s\n\n", mockPkg)
+
+	paths := []string{"github.com/apache/cloudstack-go/v2/cloudstack"}
+	for pkg := range imports {
+		paths = append(paths, importPaths[pkg])
+	}
+	sort.Strings(paths)
+
+	buf.WriteString("import (\n")
+	for _, p := range paths {
+		fmt.Fprintf(buf, "\t%q\n", p)
+	}
+	buf.WriteString(")\n\n")
+}
+
+// Returns the mock of the service. Every call is recorded, and is handled by
+// the matching ...Func field of the mock if it is set. A method with a context
+// variant falls back to the ...Func field of the other variant, so a mock only
+// needs to set one of them. Otherwise the New...Params methods return the same
+// params as the real service, and all other methods return zero values and an
+// error wrapping ErrNotMocked (if they return an error).
+func (s *service) MockCode() ([]byte, error) {
+	var buf bytes.Buffer
+	p := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format+"\n", args...)
+	}
+
+	imports := make(map[string]bool)
+	mock := "Mock" + s.name
+
+	p("// %s is a mock of cloudstack.%sIface.", mock, s.name)
+	p("// Every call is recorded, and is handled by the matching ...Func field if it")
+	p("// is set. A method and its ...WithContext variant fall back to each other's")
+	p("// ...Func field, so only one of them needs to be set. If neither is set, the")
+	p("// New...Params methods return the same params as the real service, and the")
+	p("// other methods return zero values and an error wrapping ErrNotMocked.")
+	p("type %s struct {", mock)
+	p("	Recorder")
+	p("")
+	for _, m := range s.methods {
+		p("	%sFunc func%s%s", m.name, m.paramList("cloudstack", imports), m.resultList("cloudstack", imports, false))
+	}
+	p("}")
+	p("")
+	p("var _ cloudstack.%sIface = (*%s)(nil)", s.name, mock)
+	p("")
+
+	// The methods with a context variant and the other way around
+	byName := make(map[string]*method, len(s.methods))
+	for _, m := range s.methods {
+		byName[m.name] = m
+	}
+	withContext := make(map[string]*method)
+	withoutContext := make(map[string]*method)
+	for _, m := range s.methods {
+		if base, ok := byName[strings.TrimSuffix(m.name, "WithContext")]; ok && m.withContextOf(base) {
+			withContext[base.name] = m
+			withoutContext[m.name] = base
+		}
+	}
+
+	call := func(fn string, args string, results bool) {
+		if results {
+			p("		return m.%s(%s)", fn, args)
+		} else {
+			p("		m.%s(%s)", fn, args)
+			p("		return")
+		}
+	}
+
+	for _, m := range s.methods {
+		for _, mp := range m.params {
+			if mp.name == "m" || (len(mp.name) > 1 && mp.name[0] == 'r' && strings.Trim(mp.name[1:], "0123456789") == "") {
+				return nil, fmt.Errorf("param %s of %s conflicts with the mock", mp.name, m.name)
+			}
+		}
+
+		args := make([]string, len(m.params))
+		for i, mp := range m.params {
+			args[i] = mp.name
+		}
+		record := fmt.Sprintf("%q", m.name)
+		if len(args) > 0 {
+			record += ", " + strings.Join(args, ", ")
+		}
+
+		other := withContext[m.name]
+		if other == nil {
+			other = withoutContext[m.name]
+		}
+
+		if other != nil {
+			p("// %s records the call and calls %sFunc, or %sFunc if only that is set", m.name, m.name, other.name)
+		} else {
+			p("// %s records the call and calls %sFunc", m.name, m.name)
+		}
+		p("func (m *%s) %s%s%s {", mock, m.name, m.paramList("cloudstack", imports), m.resultList("cloudstack", imports, true))
+		p("	m.record(%s)", record)
+		p("	if m.%sFunc != nil {", m.name)
+		call(m.name+"Func", m.argList(), len(m.results) > 0)
+		p("	}")
+		if ctxm := withContext[m.name]; ctxm != nil {
+			imports["context"] = true
+			args := "context.Background()"
+			if a := m.argList(); a != "" {
+				args += ", " + a
+			}
+			p("	if m.%sFunc != nil {", ctxm.name)
+			call(ctxm.name+"Func", args, len(m.results) > 0)
+			p("	}")
+		}
+		if base := withoutContext[m.name]; base != nil {
+			p("	if m.%sFunc != nil {", base.name)
+			call(base.name+"Func", (&method{params: m.params[1:], variadic: m.variadic}).argList(), len(m.results) > 0)
+			p("	}")
+		}
+		switch {
+		case strings.HasPrefix(m.name, "New") && strings.HasSuffix(m.name, "Params"):
+			p("	return cloudstack.New%s(nil).%s(%s)", s.name, m.name, m.argList())
+		case m.returnsError():
+			p("	r%d = notMocked(%q)", len(m.results)-1, s.name+"."+m.name)
+			p("	return")
+		default:
+			p("	return")
+		}
+		p("}")
+		p("")
+	}
+
+	var out bytes.Buffer
+	writeMockHeader(&out, imports)
+	buf.WriteTo(&out)
+
+	return format.Source(out.Bytes())
+}
+
+// Returns the MockClient type, which contains the mocks of all services
+func (as *allServices) MockClientCode() ([]byte, error) {
+	var buf bytes.Buffer
+	writeMockHeader(&buf, nil)
+	p := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format+"\n", args...)
+	}
+
+	p("// MockClient contains a mock of every service of a CloudStackClient")
+	p("type MockClient struct {")
+	for _, s := range as.services {
+		p("	%s *Mock%s", strings.TrimSuffix(s.name, "Service"), s.name)
+	}
+	p("}")
+	p("")
+	p("// NewMockClient returns a client of which all services are replaced by mocks, together")
+	p("// with the mocks so their behavior can be set and their calls can be inspected")
+	p("func NewMockClient() (*cloudstack.CloudStackClient, *MockClient) {")
+	p("	m := &MockClient{")
+	for _, s := range as.services {
+		p("		%s: &Mock%s{},", strings.TrimSuffix(s.name, "Service"), s.name)
+	}
+	p("	}")
+	p("")
+	p("	cs := cloudstack.NewClient(\"\", \"\", \"\", false)")
+	for _, s := range as.services {
+		p("	cs.%s = m.%s", strings.TrimSuffix(s.name, "Service"), strings.TrimSuffix(s.name, "Service"))
+	}
+	p("")
+	p("	return cs, m")
+	p("}")
+
+	return format.Source(buf.Bytes())
+}
@@ -21,16 +21,21 @@ package main
 
 var layout = apiInfo{
 	"LoadBalancerService": {
+		"addExternalLoadBalancer",
+		"addF5LoadBalancer",
 		"addNetscalerLoadBalancer",
 		"assignCertToLoadBalancer",
 		"assignToGlobalLoadBalancerRule",
 		"assignToLoadBalancerRule",
+		"configureF5LoadBalancer",
 		"configureNetscalerLoadBalancer",
 		"createGlobalLoadBalancerRule",
 		"createLBHealthCheckPolicy",
 		"createLBStickinessPolicy",
 		"createLoadBalancer",
 		"createLoadBalancerRule",
+		"deleteExternalLoadBalancer",
+		"deleteF5LoadBalancer",
 		"deleteGlobalLoadBalancerRule",
 		"deleteLBHealthCheckPolicy",
 		"deleteLBStickinessPolicy",
@@ -38,6 +43,9 @@ var layout = apiInfo{
 		"deleteLoadBalancerRule",
 		"deleteNetscalerLoadBalancer",
 		"deleteSslCert",
+		"listExternalLoadBalancers",
+		"listF5LoadBalancerNetworks",
+		"listF5LoadBalancers",
 		"listGlobalLoadBalancerRules",
 		"listLBHealthCheckPolicies",
 		"listLBStickinessPolicies",
@@ -79,6 +87,7 @@ var layout = apiInfo{
 		"listPhysicalNetworks",
 		"listStorageNetworkIpRange",
 		"listSupportedNetworkServices",
+		"migrateNetwork",
 		"releasePublicIpRange",
 		"restartNetwork",
 		"updateNetwork",
@@ -95,6 +104,8 @@ var layout = apiInfo{
 		"destroyVirtualMachine",
 		"expungeVirtualMachine",
 		"getVMPassword",
+		"importUnmanagedInstance",
+		"listUnmanagedInstances",
 		"listVirtualMachines",
 		"listVirtualMachinesMetrics",
 		"migrateVirtualMachine",
@@ -107,6 +118,7 @@ var layout = apiInfo{
 		"scaleVirtualMachine",
 		"startVirtualMachine",
 		"stopVirtualMachine",
+		"unmanageVirtualMachine",
 		"updateDefaultNicForVirtualMachine",
 		"updateVirtualMachine",
 	},
@@ -133,19 +145,33 @@ var layout = apiInfo{
 		"updateVpnGateway",
 	},
 	"FirewallService": {
+		"addCiscoAsa1000vResource",
+		"addCiscoVnmcResource",
+		"addExternalFirewall",
 		"addPaloAltoFirewall",
+		"addSrxFirewall",
 		"configurePaloAltoFirewall",
+		"configureSrxFirewall",
 		"createEgressFirewallRule",
 		"createFirewallRule",
 		"createPortForwardingRule",
+		"deleteCiscoAsa1000vResource",
+		"deleteCiscoVnmcResource",
 		"deleteEgressFirewallRule",
+		"deleteExternalFirewall",
 		"deleteFirewallRule",
 		"deletePaloAltoFirewall",
 		"deletePortForwardingRule",
+		"deleteSrxFirewall",
+		"listCiscoAsa1000vResources",
+		"listCiscoVnmcResources",
 		"listEgressFirewallRules",
+		"listExternalFirewalls",
 		"listFirewallRules",
 		"listPaloAltoFirewalls",
 		"listPortForwardingRules",
+		"listSrxFirewallNetworks",
+		"listSrxFirewalls",
 		"updateEgressFirewallRule",
 		"updateFirewallRule",
 		"updatePortForwardingRule",
@@ -178,12 +204,21 @@ var layout = apiInfo{
 		"addHost",
 		"addSecondaryStorage",
 		"cancelHostMaintenance",
+		"configureHAForHost",
 		"dedicateHost",
 		"deleteHost",
+		"disableHAForCluster",
+		"disableHAForHost",
+		"disableHAForZone",
 		"disableOutOfBandManagementForHost",
+		"enableHAForCluster",
+		"enableHAForHost",
+		"enableHAForZone",
 		"enableOutOfBandManagementForHost",
 		"findHostsForMigration",
 		"listDedicatedHosts",
+		"listHostHAProviders",
+		"listHostHAResources",
 		"listHostTags",
 		"listHosts",
 		"listHostsMetrics",
@@ -191,6 +226,7 @@ var layout = apiInfo{
 		"reconnectHost",
 		"releaseDedicatedHost",
 		"releaseHostReservation",
+		"startRollingMaintenance",
 		"updateHost",
 		"updateHostPassword",
 	},
@@ -198,14 +234,18 @@ var layout = apiInfo{
 		"attachVolume",
 		"createVolume",
 		"deleteVolume",
+		"destroyVolume",
 		"detachVolume",
 		"extractVolume",
 		"getPathForVolume",
+		"getSolidFireVolumeAccessGroupIds",
 		"getSolidFireVolumeSize",
 		"getUploadParamsForVolume",
 		"getVolumeiScsiName",
 		"listVolumes",
+		"listVolumesMetrics",
 		"migrateVolume",
+		"recoverVolume",
 		"resizeVolume",
 		"updateVolume",
 		"uploadVolume",
@@ -223,6 +263,7 @@ var layout = apiInfo{
 		"listStaticRoutes",
 		"listVPCOfferings",
 		"listVPCs",
+		"migrateVPC",
 		"restartVPC",
 		"updateVPC",
 		"updateVPCOffering",
@@ -263,6 +304,7 @@ var layout = apiInfo{
 		"enableOutOfBandManagementForZone",
 		"listDedicatedZones",
 		"listZones",
+		"listZonesMetrics",
 		"releaseDedicatedZone",
 		"updateZone",
 	},
@@ -281,7 +323,9 @@ var layout = apiInfo{
 		"updateTrafficType",
 	},
 	"SnapshotService": {
+		"archiveSnapshot",
 		"createSnapshot",
+		"createSnapshotFromVMSnapshot",
 		"createSnapshotPolicy",
 		"createVMSnapshot",
 		"deleteSnapshot",
@@ -300,9 +344,11 @@ var layout = apiInfo{
 		"disableUser",
 		"enableUser",
 		"getUser",
+		"getUserKeys",
 		"getVirtualMachineUserData",
 		"listUsers",
 		"lockUser",
+		"moveUser",
 		"registerUserKeys",
 		"updateUser",
 	},
@@ -313,6 +359,7 @@ var layout = apiInfo{
 		"ldapConfig",
 		"ldapCreateAccount",
 		"ldapRemove",
+		"linkAccountToLdap",
 		"linkDomainToLdap",
 		"listLdapConfigurations",
 		"listLdapUsers",
@@ -324,6 +371,7 @@ var layout = apiInfo{
 		"deleteIso",
 		"detachIso",
 		"extractIso",
+		"getUploadParamsForIso",
 		"listIsoPermissions",
 		"listIsos",
 		"registerIso",
@@ -335,6 +383,7 @@ var layout = apiInfo{
 		"configureVirtualRouterElement",
 		"createVirtualRouterElement",
 		"destroyRouter",
+		"getRouterHealthCheckResults",
 		"listRouters",
 		"listVirtualRouterElements",
 		"rebootRouter",
@@ -343,20 +392,36 @@ var layout = apiInfo{
 	},
 	"ProjectService": {
 		"activateProject",
+		"addUserToProject",
 		"createProject",
+		"createProjectRole",
+		"createProjectRolePermission",
 		"deleteProject",
 		"deleteProjectInvitation",
+		"deleteProjectRole",
+		"deleteProjectRolePermission",
+		"deleteUserFromProject",
 		"listProjectInvitations",
+		"listProjectRolePermissions",
+		"listProjectRoles",
 		"listProjects",
 		"suspendProject",
 		"updateProject",
 		"updateProjectInvitation",
+		"updateProjectRole",
+		"updateProjectRolePermission",
 	},
 	"PoolService": {
 		"createStoragePool",
 		"deleteStoragePool",
 		"findStoragePoolsForMigration",
+		"listElastistorInterface",
+		"listElastistorPool",
+		"listElastistorVolume",
 		"listStoragePools",
+		"listStoragePoolsMetrics",
+		"syncStoragePool",
+		"updateSiocInfo",
 		"updateStoragePool",
 	},
 	"NetworkACLService": {
@@ -366,6 +431,7 @@ var layout = apiInfo{
 		"deleteNetworkACLList",
 		"listNetworkACLLists",
 		"listNetworkACLs",
+		"moveNetworkAclItem",
 		"replaceNetworkACLList",
 		"updateNetworkACLItem",
 		"updateNetworkACLList",
@@ -419,6 +485,7 @@ var layout = apiInfo{
 		"createRolePermission",
 		"deleteRole",
 		"deleteRolePermission",
+		"importRole",
 		"listRolePermissions",
 		"listRoles",
 		"updateRole",
@@ -432,11 +499,20 @@ var layout = apiInfo{
 		"deleteSecondaryStagingStore",
 		"listImageStores",
 		"listSecondaryStagingStores",
+		"migrateSecondaryStorageData",
 		"updateCloudToUseObjectStore",
+		"updateImageStore",
 	},
 	"AuthenticationService": {
+		"authorizeSamlSso",
+		"getSPMetadata",
+		"listAndSwitchSamlAccount",
+		"listIdps",
+		"listSamlAuthorization",
 		"login",
 		"logout",
+		"samlSlo",
+		"samlSso",
 	},
 	"SecurityGroupService": {
 		"authorizeSecurityGroupEgress",
@@ -446,17 +522,22 @@ var layout = apiInfo{
 		"listSecurityGroups",
 		"revokeSecurityGroupEgress",
 		"revokeSecurityGroupIngress",
+		"updateSecurityGroup",
 	},
 	"QuotaService": {
 		"quotaIsEnabled",
 	},
 	"PodService": {
+		"acquirePodIpAddress",
+		"createManagementNetworkIpRange",
 		"createPod",
 		"dedicatePod",
+		"deleteManagementNetworkIpRange",
 		"deletePod",
 		"listDedicatedPods",
 		"listPods",
 		"releaseDedicatedPod",
+		"releasePodIpAddress",
 		"updatePod",
 	},
 	"VLANService": {
@@ -539,6 +620,7 @@ var layout = apiInfo{
 	"ResourcemetadataService": {
 		"addResourceDetail",
 		"getVolumeSnapshotDetails",
+		"listDetailOptions",
 		"listResourceDetails",
 		"removeResourceDetail",
 	},
@@ -573,6 +655,7 @@ var layout = apiInfo{
 		"updateDiskOffering",
 	},
 	"ConfigurationService": {
+		"cloudianIsEnabled",
 		"listCapabilities",
 		"listConfigurations",
 		"listDeploymentPlanners",
@@ -618,7 +701,11 @@ var layout = apiInfo{
 	},
 	"NetworkDeviceService": {
 		"addNetworkDevice",
+		"deleteCiscoNexusVSM",
 		"deleteNetworkDevice",
+		"disableCiscoNexusVSM",
+		"enableCiscoNexusVSM",
+		"listCiscoNexusVSMs",
 		"listNetworkDevice",
 	},
 	"HypervisorService": {
@@ -654,9 +741,87 @@ var layout = apiInfo{
 		"getCloudIdentifier",
 	},
 	"CertificateService": {
+		"issueCertificate",
+		"listCAProviders",
+		"listCaCertificate",
+		"provisionCertificate",
+		"revokeCertificate",
+		"revokeTemplateDirectDownloadCertificate",
 		"uploadCustomCertificate",
+		"uploadTemplateDirectDownloadCertificate",
 	},
 	"APIDiscoveryService": {
 		"listApis",
 	},
+	"AnnotationService": {
+		"addAnnotation",
+		"listAnnotations",
+		"removeAnnotation",
+	},
+	"BackupService": {
+		"assignVirtualMachineToBackupOffering",
+		"createBackup",
+		"createBackupSchedule",
+		"deleteBackup",
+		"deleteBackupOffering",
+		"deleteBackupSchedule",
+		"importBackupOffering",
+		"listBackupOfferings",
+		"listBackupProviderOfferings",
+		"listBackupProviders",
+		"listBackupSchedule",
+		"listBackups",
+		"removeVirtualMachineFromBackupOffering",
+		"restoreBackup",
+		"restoreVolumeFromBackupAndAttachToVM",
+		"updateBackupSchedule",
+	},
+	"DiagnosticsService": {
+		"getDiagnosticsData",
+		"runDiagnostics",
+	},
+	"KubernetesService": {
+		"addKubernetesSupportedVersion",
+		"createKubernetesCluster",
+		"deleteKubernetesCluster",
+		"deleteKubernetesSupportedVersion",
+		"getKubernetesClusterConfig",
+		"listKubernetesClusters",
+		"listKubernetesSupportedVersions",
+		"scaleKubernetesCluster",
+		"startKubernetesCluster",
+		"stopKubernetesCluster",
+		"updateKubernetesSupportedVersion",
+		"upgradeKubernetesCluster",
+	},
+	"ManagementService": {
+		"listInfrastructure",
+		"listManagementServers",
+	},
+	"NetscalerService": {
+		"deleteNetscalerControlCenter",
+		"deleteServicePackageOffering",
+		"deployNetscalerVpx",
+		"listNetscalerControlCenter",
+		"listRegisteredServicePackages",
+		"registerNetscalerControlCenter",
+		"registerNetscalerServicePackage",
+		"stopNetScalerVpx",
+	},
+	"SimulatorService": {
+		"cleanupSimulatorMock",
+		"configureSimulator",
+		"configureSimulatorHAProviderState",
+		"listSimulatorHAStateTransitions",
+		"querySimulatorMock",
+	},
+	"VMWareService": {
+		"addVmwareDc",
+		"importVsphereStoragePolicies",
+		"listVmwareDcs",
+		"listVsphereStoragePolicies",
+		"listVsphereStoragePolicyCompatiblePools",
+		"removeVmwareDc",
+		"updateVmwareDc",
+	},
 }
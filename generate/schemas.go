@@ -0,0 +1,262 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import "sort"
+
+// mapSchema describes a map parameter that takes a list of structured items,
+// like `iptonetworklist[0].networkid=...&iptonetworklist[0].ip=...`, instead
+// of a list of key/value pairs. For every schema a struct type is generated,
+// and the parameter is generated as a slice of that type.
+type mapSchema struct {
+	name   string // The name of the generated type
+	doc    string // The doc comment of the generated type
+	fields []*mapField
+}
+
+// mapField describes a single key of a structured map item. Zero values are
+// not sent to CloudStack, so all fields are optional.
+type mapField struct {
+	name string // The name of the struct field
+	key  string // The key used by CloudStack
+	typ  string // The Go type of the struct field, either string or int
+}
+
+var (
+	counterParam = &mapSchema{
+		name: "CounterParam",
+		doc:  "CounterParam is a name/value pair used to configure the counters of an autoscale VM profile",
+		fields: []*mapField{
+			{name: "Name", key: "name", typ: "string"},
+			{name: "Value", key: "value", typ: "string"},
+		},
+	}
+
+	dataDiskTemplateToOffering = &mapSchema{
+		name: "DataDiskTemplateToOffering",
+		doc:  "DataDiskTemplateToOffering maps a datadisk template to the disk offering used to create the data disk",
+		fields: []*mapField{
+			{name: "DataDiskTemplateID", key: "datadisktemplateid", typ: "string"},
+			{name: "DiskOfferingID", key: "diskofferingid", typ: "string"},
+		},
+	}
+
+	diskToDiskOffering = &mapSchema{
+		name: "DiskToDiskOffering",
+		doc:  "DiskToDiskOffering maps a disk of an unmanaged instance to the disk offering used for the imported volume",
+		fields: []*mapField{
+			{name: "Disk", key: "disk", typ: "string"},
+			{name: "DiskOffering", key: "diskOffering", typ: "string"},
+		},
+	}
+
+	gslbLBRuleWeight = &mapSchema{
+		name: "GSLBLBRuleWeight",
+		doc:  "GSLBLBRuleWeight sets the weight (between 1-100) of a load balancer rule in a GSLB rule",
+		fields: []*mapField{
+			{name: "LoadBalancerID", key: "loadbalancerid", typ: "string"},
+			{name: "Weight", key: "weight", typ: "int"},
+		},
+	}
+
+	importRoleRule = &mapSchema{
+		name: "ImportRoleRule",
+		doc:  "ImportRoleRule is a rule of a role that is imported, where the rule and the permission are required",
+		fields: []*mapField{
+			{name: "Rule", key: "rule", typ: "string"},
+			{name: "Permission", key: "permission", typ: "string"},
+			{name: "Description", key: "description", typ: "string"},
+		},
+	}
+
+	ipToNetwork = &mapSchema{
+		name: "IPToNetwork",
+		doc:  "IPToNetwork requests a specific IP address, IPv6 address and/or MAC address for the NIC in a network",
+		fields: []*mapField{
+			{name: "NetworkID", key: "networkid", typ: "string"},
+			{name: "IP", key: "ip", typ: "string"},
+			{name: "IPv6", key: "ipv6", typ: "string"},
+			{name: "MAC", key: "mac", typ: "string"},
+		},
+	}
+
+	nicIPAddress = &mapSchema{
+		name: "NICIPAddress",
+		doc:  "NICIPAddress maps a NIC of an unmanaged instance to the IPv4 address it should get",
+		fields: []*mapField{
+			{name: "NIC", key: "nic", typ: "string"},
+			{name: "IP4Address", key: "ip4Address", typ: "string"},
+		},
+	}
+
+	nicNetwork = &mapSchema{
+		name: "NICNetwork",
+		doc:  "NICNetwork maps a NIC of a VM to the network it should be connected to",
+		fields: []*mapField{
+			{name: "NIC", key: "nic", typ: "string"},
+			{name: "Network", key: "network", typ: "string"},
+		},
+	}
+
+	serviceCapability = &mapSchema{
+		name: "ServiceCapability",
+		doc:  "ServiceCapability sets a capability of a service that is offered by a network or VPC offering",
+		fields: []*mapField{
+			{name: "Service", key: "service", typ: "string"},
+			{name: "CapabilityType", key: "capabilitytype", typ: "string"},
+			{name: "CapabilityValue", key: "capabilityvalue", typ: "string"},
+		},
+	}
+
+	serviceProvider = &mapSchema{
+		name: "ServiceProvider",
+		doc:  "ServiceProvider maps a service that is offered by a network or VPC offering to the provider of the service",
+		fields: []*mapField{
+			{name: "Service", key: "service", typ: "string"},
+			{name: "Provider", key: "provider", typ: "string"},
+		},
+	}
+
+	stickinessPolicyParam = &mapSchema{
+		name: "StickinessPolicyParam",
+		doc:  "StickinessPolicyParam is a name/value pair used to configure a load balancer stickiness policy",
+		fields: []*mapField{
+			{name: "Name", key: "name", typ: "string"},
+			{name: "Value", key: "value", typ: "string"},
+		},
+	}
+
+	tierNetworkOffering = &mapSchema{
+		name: "TierNetworkOffering",
+		doc:  "TierNetworkOffering maps a network tier of a VPC to the network offering it should use",
+		fields: []*mapField{
+			{name: "NetworkID", key: "networkid", typ: "string"},
+			{name: "NetworkOfferingID", key: "networkofferingid", typ: "string"},
+		},
+	}
+
+	userSecurityGroup = &mapSchema{
+		name: "UserSecurityGroup",
+		doc:  "UserSecurityGroup is a security group of an account that is allowed access by a security group rule",
+		fields: []*mapField{
+			{name: "Account", key: "account", typ: "string"},
+			{name: "Group", key: "group", typ: "string"},
+		},
+	}
+
+	vmIDIP = &mapSchema{
+		name: "VMIDIP",
+		doc:  "VMIDIP maps a VM to one of its IP addresses, used when assigning a specific VM IP to a load balancer rule",
+		fields: []*mapField{
+			{name: "VMID", key: "vmid", typ: "string"},
+			{name: "VMIP", key: "vmip", typ: "string"},
+		},
+	}
+
+	volumeToPool = &mapSchema{
+		name: "VolumeToPool",
+		doc:  "VolumeToPool maps a volume to the storage pool it should be migrated to",
+		fields: []*mapField{
+			{name: "Volume", key: "volume", typ: "string"},
+			{name: "Pool", key: "pool", typ: "string"},
+		},
+	}
+)
+
+// mapSchemas contains the schemas of all structured map parameters, by command and parameter name
+var mapSchemas = map[string]map[string]*mapSchema{
+	"assignToGlobalLoadBalancerRule": {
+		"gslblbruleweightsmap": gslbLBRuleWeight,
+	},
+	"assignToLoadBalancerRule": {
+		"vmidipmap": vmIDIP,
+	},
+	"authorizeSecurityGroupEgress": {
+		"usersecuritygrouplist": userSecurityGroup,
+	},
+	"authorizeSecurityGroupIngress": {
+		"usersecuritygrouplist": userSecurityGroup,
+	},
+	"createAutoScaleVmProfile": {
+		"counterparam": counterParam,
+	},
+	"createLBStickinessPolicy": {
+		"param": stickinessPolicyParam,
+	},
+	"createNetworkOffering": {
+		"servicecapabilitylist": serviceCapability,
+		"serviceproviderlist":   serviceProvider,
+	},
+	"createVPCOffering": {
+		"servicecapabilitylist": serviceCapability,
+		"serviceproviderlist":   serviceProvider,
+	},
+	"deployVirtualMachine": {
+		"datadiskofferinglist": dataDiskTemplateToOffering,
+		"iptonetworklist":      ipToNetwork,
+		"nicnetworklist":       nicNetwork,
+	},
+	"importRole": {
+		"rules": importRoleRule,
+	},
+	"importUnmanagedInstance": {
+		"datadiskofferinglist": diskToDiskOffering,
+		"nicipaddresslist":     nicIPAddress,
+		"nicnetworklist":       nicNetwork,
+	},
+	"migrateVPC": {
+		"tiernetworkofferings": tierNetworkOffering,
+	},
+	"migrateVirtualMachineWithVolume": {
+		"migrateto": volumeToPool,
+	},
+	"removeFromLoadBalancerRule": {
+		"vmidipmap": vmIDIP,
+	},
+	"updateAutoScaleVmProfile": {
+		"counterparam": counterParam,
+	},
+}
+
+// Returns the schema of a structured map parameter, or nil if the parameter is not a structured map
+func getMapSchema(cmd string, ap *APIParam) *mapSchema {
+	if ap.Type != "map" {
+		return nil
+	}
+	return mapSchemas[cmd][ap.Name]
+}
+
+// Returns all unique schemas sorted by name
+func sortedMapSchemas() []*mapSchema {
+	seen := make(map[*mapSchema]bool)
+	var schemas []*mapSchema
+
+	for _, params := range mapSchemas {
+		for _, ms := range params {
+			if !seen[ms] {
+				seen[ms] = true
+				schemas = append(schemas, ms)
+			}
+		}
+	}
+
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].name < schemas[j].name })
+	return schemas
+}
@@ -0,0 +1,128 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+var intervalTypes = []string{"HOURLY", "DAILY", "WEEKLY", "MONTHLY"}
+var roleTypes = []string{"Admin", "ResourceAdmin", "DomainAdmin", "User"}
+var templateFilters = []string{"featured", "self", "selfexecutable", "sharedexecutable", "executable", "community", "all"}
+
+// paramEnums is a prefilled map with the allowed values of string params, per
+// command. The API spec doesn't contain the allowed values, so they are taken
+// from the documentation of the params.
+var paramEnums = map[string]map[string][]string{
+	"createBackupSchedule":     {"intervaltype": intervalTypes},
+	"createDiskOffering":       {"provisioningtype": {"thin", "sparse", "fat"}, "storagetype": {"local", "shared"}},
+	"createNetwork":            {"acltype": {"account", "domain"}},
+	"createRole":               {"type": roleTypes},
+	"createServiceOffering":    {"provisioningtype": {"thin", "sparse", "fat"}, "storagetype": {"local", "shared"}},
+	"createSnapshotPolicy":     {"intervaltype": intervalTypes},
+	"createVpnCustomerGateway": {"ikeversion": {"ike", "ikev1", "ikev2"}},
+	"deployVirtualMachine": {"keyboard": {
+		"de", "de-ch", "es", "fi", "fr", "fr-be", "fr-ch", "is", "it", "jp", "nl-be", "no", "pt", "uk", "us",
+	}},
+	"importRole":               {"type": roleTypes},
+	"listIsos":                 {"isofilter": templateFilters},
+	"listNetworks":             {"acltype": {"account", "domain"}},
+	"listRoles":                {"type": roleTypes},
+	"listSnapshots":            {"intervaltype": intervalTypes},
+	"listTemplates":            {"templatefilter": templateFilters},
+	"runDiagnostics":           {"type": {"ping", "traceroute", "arping"}},
+	"updateBackupSchedule":     {"intervaltype": intervalTypes},
+	"updateRole":               {"type": roleTypes},
+	"updateVpnCustomerGateway": {"ikeversion": {"ike", "ikev1", "ikev2"}},
+}
+
+func (s *service) generateValidateFunc(a *API) {
+	pn := s.pn
+
+	var required, ids []string
+	var checks []string
+	for _, ap := range a.Params {
+		if ap.Required {
+			required = append(required, fmt.Sprintf("%q", ap.Name))
+		}
+		if ap.Type == "uuid" {
+			ids = append(ids, fmt.Sprintf("%q", ap.Name))
+		}
+		if ap.Type == "string" && ap.Length > 0 {
+			checks = append(checks, fmt.Sprintf("v.maxLength(%q, %d)", ap.Name, ap.Length))
+		}
+		if values, ok := paramEnums[a.Name][ap.Name]; ok {
+			quoted := make([]string, len(values))
+			for i, value := range values {
+				quoted[i] = fmt.Sprintf("%q", value)
+			}
+			checks = append(checks, fmt.Sprintf("v.oneOf(%q, %s)", ap.Name, strings.Join(quoted, ", ")))
+		}
+	}
+
+	pn("// Validate returns an error if a required param is missing or empty, or if a param has an invalid value")
+	pn("func (p *%s) Validate() error {", capitalize(a.Name+"Params"))
+	if len(required) == 0 && len(ids) == 0 && len(checks) == 0 {
+		pn("	return nil")
+		pn("}")
+		pn("")
+		return
+	}
+	pn("	v := newParamValidator(\"%s\", p.p)", a.Name)
+	if len(required) > 0 {
+		pn("	v.required(%s)", strings.Join(required, ", "))
+	}
+	if len(ids) > 0 {
+		pn("	v.ids(%s)", strings.Join(ids, ", "))
+	}
+	for _, c := range checks {
+		pn("	%s", c)
+	}
+	pn("	return v.err()")
+	pn("}")
+	pn("")
+}
+
+// Checks if all params in paramEnums exist, so the map is updated when the API changes
+func (as *allServices) checkParamEnums() error {
+	found := make(map[string]bool)
+	for _, s := range as.services {
+		for _, a := range s.apis {
+			for _, ap := range a.Params {
+				if _, ok := paramEnums[a.Name][ap.Name]; ok {
+					if ap.Type != "string" {
+						return fmt.Errorf("enum param %s of %s is not a string", ap.Name, a.Name)
+					}
+					found[a.Name+"."+ap.Name] = true
+				}
+			}
+		}
+	}
+
+	for cmd, params := range paramEnums {
+		for name := range params {
+			if !found[cmd+"."+name] {
+				return fmt.Errorf("enum param %s of %s does not exist", name, cmd)
+			}
+		}
+	}
+	return nil
+}
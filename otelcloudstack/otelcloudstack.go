@@ -0,0 +1,194 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package otelcloudstack adds OpenTelemetry tracing to a CloudStackClient. It is a separate module,
+// so the cloudstack package itself doesn't depend on OpenTelemetry.
+//
+// Every request creates a span named after the command, which is a child of the span found in the
+// context passed to the API call. Waiting for an async job creates an "asyncjob" span, with an
+// "asyncjob.poll" child span for every poll of the job, which is the parent of the span of the
+// queryAsyncJobResult request.
+package otelcloudstack
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The name of the instrumentation library, used to create the tracer
+const instrumentationName = "github.com/apache/cloudstack-go/v2/otelcloudstack"
+
+// The keys of the span attributes
+const (
+	CommandKey     = attribute.Key("cloudstack.command")
+	MethodKey      = attribute.Key("http.request.method")
+	StatusCodeKey  = attribute.Key("http.response.status_code")
+	ErrorCodeKey   = attribute.Key("cloudstack.errorcode")
+	CSErrorCodeKey = attribute.Key("cloudstack.cserrorcode")
+	JobIDKey       = attribute.Key("cloudstack.jobid")
+	JobStatusKey   = attribute.Key("cloudstack.jobstatus")
+	AttemptKey     = attribute.Key("cloudstack.attempt")
+)
+
+// Option can be passed to NewClientTrace or WithTracing to set custom options
+type Option func(*config)
+
+type config struct {
+	provider trace.TracerProvider
+	attrs    []attribute.KeyValue
+}
+
+// WithTracerProvider sets the provider used to create the tracer; defaults to the global provider
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		if provider != nil {
+			c.provider = provider
+		}
+	}
+}
+
+// WithAttributes sets attributes that are added to every span, like the name of the management server
+func WithAttributes(attrs ...attribute.KeyValue) Option {
+	return func(c *config) {
+		c.attrs = append(c.attrs, attrs...)
+	}
+}
+
+// WithTracing returns a ClientOption that adds tracing to the client
+func WithTracing(opts ...Option) cloudstack.ClientOption {
+	return cloudstack.WithClientTrace(NewClientTrace(opts...))
+}
+
+// NewClientTrace returns a ClientTrace that creates a span for every request and async job
+func NewClientTrace(opts ...Option) *cloudstack.ClientTrace {
+	c := &config{provider: otel.GetTracerProvider()}
+	for _, fn := range opts {
+		fn(c)
+	}
+
+	t := &tracer{
+		tracer: c.provider.Tracer(instrumentationName),
+		attrs:  c.attrs,
+	}
+
+	return &cloudstack.ClientTrace{
+		RequestStart: t.requestStart,
+		RequestRetry: t.requestRetry,
+		RequestDone:  t.requestDone,
+		JobWaitStart: t.jobWaitStart,
+		JobPollStart: t.jobPollStart,
+		JobPollDone:  t.jobPollDone,
+		JobWaitDone:  t.jobWaitDone,
+	}
+}
+
+type tracer struct {
+	tracer trace.Tracer
+	attrs  []attribute.KeyValue
+}
+
+func (t *tracer) requestStart(ctx context.Context, command string, params url.Values) context.Context {
+	ctx, _ = t.tracer.Start(ctx, command,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(t.attrs...),
+		trace.WithAttributes(CommandKey.String(command)),
+	)
+	return ctx
+}
+
+func (t *tracer) requestRetry(ctx context.Context, info *cloudstack.RequestInfo) {
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("retry", trace.WithAttributes(
+		AttemptKey.Int(info.Attempt),
+		StatusCodeKey.Int(info.StatusCode),
+		attribute.String("error", info.Err.Error()),
+	))
+}
+
+func (t *tracer) requestDone(ctx context.Context, info *cloudstack.RequestInfo) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.SetAttributes(MethodKey.String(info.Method), AttemptKey.Int(info.Attempt))
+	if info.StatusCode != 0 {
+		span.SetAttributes(StatusCodeKey.Int(info.StatusCode))
+	}
+	if info.JobID != "" {
+		span.SetAttributes(JobIDKey.String(info.JobID))
+	}
+
+	if info.Err != nil {
+		if info.ErrorCode != 0 {
+			span.SetAttributes(ErrorCodeKey.Int(info.ErrorCode), CSErrorCodeKey.Int(info.CSErrorCode))
+		}
+		span.RecordError(info.Err)
+		span.SetStatus(codes.Error, info.Err.Error())
+	}
+}
+
+func (t *tracer) jobWaitStart(ctx context.Context, jobid string) context.Context {
+	ctx, _ = t.tracer.Start(ctx, "asyncjob",
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(t.attrs...),
+		trace.WithAttributes(JobIDKey.String(jobid)),
+	)
+	return ctx
+}
+
+func (t *tracer) jobPollStart(ctx context.Context, jobid string) context.Context {
+	ctx, _ = t.tracer.Start(ctx, "asyncjob.poll",
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(t.attrs...),
+		trace.WithAttributes(JobIDKey.String(jobid)),
+	)
+	return ctx
+}
+
+func (t *tracer) jobPollDone(ctx context.Context, info *cloudstack.JobPollInfo) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if info.Err != nil {
+		span.RecordError(info.Err)
+		span.SetStatus(codes.Error, info.Err.Error())
+		return
+	}
+	span.SetAttributes(JobStatusKey.Int(info.Status))
+}
+
+func (t *tracer) jobWaitDone(ctx context.Context, info *cloudstack.JobWaitInfo) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if info.Err != nil {
+		var jerr *cloudstack.AsyncJobError
+		if errors.As(info.Err, &jerr) {
+			span.SetAttributes(ErrorCodeKey.Int(jerr.ErrorCode), CSErrorCodeKey.Int(jerr.CSErrorCode))
+		}
+		span.RecordError(info.Err)
+		span.SetStatus(codes.Error, info.Err.Error())
+	}
+}
@@ -0,0 +1,287 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package otelcloudstack_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+	"github.com/apache/cloudstack-go/v2/cloudstack/cloudstacktest"
+	"github.com/apache/cloudstack-go/v2/otelcloudstack"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Returns a client of the test server that records all spans in the returned exporter, and
+// a context with a root span that is the parent of all spans created by the client
+func newTracedClient(t *testing.T, s *cloudstacktest.Server, opts ...cloudstack.ClientOption) (*cloudstack.CloudStackClient, context.Context, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	t.Cleanup(func() { provider.Shutdown(context.Background()) })
+
+	cs := s.NewClient(append(opts, otelcloudstack.WithTracing(
+		otelcloudstack.WithTracerProvider(provider),
+		otelcloudstack.WithAttributes(attribute.String("server", "test")),
+	))...)
+
+	ctx, root := provider.Tracer("test").Start(context.Background(), "root")
+	t.Cleanup(func() { root.End() })
+
+	return cs, ctx, exporter
+}
+
+// Returns the attributes of the span as a map
+func attributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+// Returns the spans with the given name
+func spansNamed(spans tracetest.SpanStubs, name string) []tracetest.SpanStub {
+	var found []tracetest.SpanStub
+	for _, span := range spans {
+		if span.Name == name {
+			found = append(found, span)
+		}
+	}
+	return found
+}
+
+func TestRequestSpans(t *testing.T) {
+	tests := []struct {
+		name      string
+		fail      int
+		wantCode  codes.Code
+		wantError int64
+	}{
+		{name: "succeeded", wantCode: codes.Unset},
+		{name: "failed", fail: 431, wantCode: codes.Error, wantError: 431},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := cloudstacktest.NewServer()
+			defer s.Close()
+			s.AddZone("zone1")
+
+			cs, ctx, exporter := newTracedClient(t, s)
+			if tt.fail != 0 {
+				s.FailNext("listZones", tt.fail, "Invalid parameter")
+			}
+
+			_, err := cs.Zone.ListZonesWithContext(ctx, cs.Zone.NewListZonesParams())
+			if (err != nil) != (tt.fail != 0) {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			spans := spansNamed(exporter.GetSpans(), "listZones")
+			if len(spans) != 1 {
+				t.Fatalf("Expected 1 listZones span, got %d", len(spans))
+			}
+			span := spans[0]
+
+			if span.Parent.SpanID() != trace.SpanContextFromContext(ctx).SpanID() {
+				t.Errorf("Expected the span to be a child of the root span")
+			}
+			if span.SpanKind != trace.SpanKindClient {
+				t.Errorf("Expected a client span, got %v", span.SpanKind)
+			}
+			if span.Status.Code != tt.wantCode {
+				t.Errorf("Expected status %v, got %v", tt.wantCode, span.Status.Code)
+			}
+
+			attrs := attributes(span)
+			if got := attrs[otelcloudstack.CommandKey].AsString(); got != "listZones" {
+				t.Errorf("Expected command listZones, got %q", got)
+			}
+			if got := attrs[otelcloudstack.MethodKey].AsString(); got != "GET" {
+				t.Errorf("Expected method GET, got %q", got)
+			}
+			if got := attrs["server"].AsString(); got != "test" {
+				t.Errorf("Expected the custom attribute to be set, got %q", got)
+			}
+			if got := attrs[otelcloudstack.ErrorCodeKey].AsInt64(); got != tt.wantError {
+				t.Errorf("Expected error code %d, got %d", tt.wantError, got)
+			}
+		})
+	}
+}
+
+func TestRequestSpanMethod(t *testing.T) {
+	s := cloudstacktest.NewServer()
+	defer s.Close()
+	z := s.AddZone("zone1")
+	so := s.AddServiceOffering("small", 1, 512)
+	tmpl := s.AddTemplate("template", z.Id)
+
+	cs, ctx, exporter := newTracedClient(t, s)
+
+	// Commands with secrets are always sent using POST
+	p := cs.VirtualMachine.NewDeployVirtualMachineParams(so.Id, tmpl.Id, z.Id)
+	if _, err := cs.VirtualMachine.DeployVirtualMachineWithContext(ctx, p); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	spans := spansNamed(exporter.GetSpans(), "deployVirtualMachine")
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 deployVirtualMachine span, got %d", len(spans))
+	}
+	if got := attributes(spans[0])[otelcloudstack.MethodKey].AsString(); got != "POST" {
+		t.Errorf("Expected method POST, got %q", got)
+	}
+}
+
+func TestAsyncJobSpans(t *testing.T) {
+	tests := []struct {
+		name     string
+		fail     bool
+		wantCode codes.Code
+	}{
+		{name: "succeeded", wantCode: codes.Unset},
+		{name: "failed", fail: true, wantCode: codes.Error},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := cloudstacktest.NewServer()
+			defer s.Close()
+			z := s.AddZone("zone1")
+			so := s.AddServiceOffering("small", 1, 512)
+			tmpl := s.AddTemplate("template", z.Id)
+
+			cs, ctx, exporter := newTracedClient(t, s)
+			if tt.fail {
+				s.FailNextJob("deployVirtualMachine", 530, "Insufficient capacity")
+			}
+
+			p := cs.VirtualMachine.NewDeployVirtualMachineParams(so.Id, tmpl.Id, z.Id)
+			job, err := cs.VirtualMachine.DeployVirtualMachineAsyncWithContext(ctx, p)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err := job.Wait(ctx); (err != nil) != tt.fail {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			spans := exporter.GetSpans()
+
+			waits := spansNamed(spans, "asyncjob")
+			if len(waits) != 1 {
+				t.Fatalf("Expected 1 asyncjob span, got %d", len(waits))
+			}
+			wait := waits[0]
+			if wait.Parent.SpanID() != trace.SpanContextFromContext(ctx).SpanID() {
+				t.Errorf("Expected the asyncjob span to be a child of the root span")
+			}
+			if got := attributes(wait)[otelcloudstack.JobIDKey].AsString(); got != job.JobID {
+				t.Errorf("Expected job ID %s, got %q", job.JobID, got)
+			}
+			if wait.Status.Code != tt.wantCode {
+				t.Errorf("Expected status %v, got %v", tt.wantCode, wait.Status.Code)
+			}
+
+			polls := spansNamed(spans, "asyncjob.poll")
+			if len(polls) == 0 {
+				t.Fatalf("Expected at least 1 asyncjob.poll span")
+			}
+			queries := spansNamed(spans, "queryAsyncJobResult")
+			if len(queries) != len(polls) {
+				t.Fatalf("Expected a queryAsyncJobResult span for every poll, got %d for %d polls", len(queries), len(polls))
+			}
+
+			for i, poll := range polls {
+				if poll.Parent.SpanID() != wait.SpanContext.SpanID() {
+					t.Errorf("Expected the poll span to be a child of the asyncjob span")
+				}
+				if queries[i].Parent.SpanID() != poll.SpanContext.SpanID() {
+					t.Errorf("Expected the queryAsyncJobResult span to be a child of the poll span")
+				}
+			}
+
+			last := attributes(polls[len(polls)-1])[otelcloudstack.JobStatusKey].AsInt64()
+			if want := map[bool]int64{false: 1, true: 2}[tt.fail]; last != want {
+				t.Errorf("Expected the last poll to have status %d, got %d", want, last)
+			}
+		})
+	}
+}
+
+func TestAsyncJobSpansWithJobWatcher(t *testing.T) {
+	s := cloudstacktest.NewServer(cloudstacktest.WithJobDelay(100 * time.Millisecond))
+	defer s.Close()
+	z := s.AddZone("zone1")
+	so := s.AddServiceOffering("small", 1, 512)
+	tmpl := s.AddTemplate("template", z.Id)
+
+	cs, ctx, exporter := newTracedClient(t, s,
+		cloudstack.WithJobWatcher(cloudstack.WithPollInterval(20*time.Millisecond, 20*time.Millisecond)))
+
+	var jobs []*cloudstack.Job
+	for i := 0; i < 2; i++ {
+		p := cs.VirtualMachine.NewDeployVirtualMachineParams(so.Id, tmpl.Id, z.Id)
+		job, err := cs.VirtualMachine.DeployVirtualMachineAsyncWithContext(ctx, p)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		jobs = append(jobs, job)
+	}
+	if err := cloudstack.WaitAll(ctx, jobs...); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if n := len(spansNamed(spans, "queryAsyncJobResult")); n != 0 {
+		t.Errorf("Expected the jobs to be polled by the watcher, got %d queryAsyncJobResult spans", n)
+	}
+
+	// Every job should have its own poll spans below its asyncjob span
+	waits := make(map[trace.SpanID]string)
+	for _, wait := range spansNamed(spans, "asyncjob") {
+		waits[wait.SpanContext.SpanID()] = attributes(wait)[otelcloudstack.JobIDKey].AsString()
+	}
+	last := make(map[string]int64)
+	for _, poll := range spansNamed(spans, "asyncjob.poll") {
+		jobid, ok := waits[poll.Parent.SpanID()]
+		if !ok {
+			t.Fatalf("Expected the poll span to be a child of an asyncjob span")
+		}
+		if got := attributes(poll)[otelcloudstack.JobIDKey].AsString(); got != jobid {
+			t.Errorf("Expected the poll span of job %s, got %q", jobid, got)
+		}
+		last[jobid] = attributes(poll)[otelcloudstack.JobStatusKey].AsInt64()
+	}
+
+	for _, job := range jobs {
+		status, ok := last[job.JobID]
+		if !ok {
+			t.Errorf("Expected asyncjob.poll spans for job %s", job.JobID)
+		} else if status != 1 {
+			t.Errorf("Expected the last poll of job %s to have status 1, got %d", job.JobID, status)
+		}
+	}
+}
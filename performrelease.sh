@@ -30,7 +30,7 @@ usage(){
     echo "  -b sets the branch (defaults to 'master')"
     echo "  -s sets the source directory (defaults to $sourcedir)"
     echo "  -o sets the output directory (defaults to $outputdir)"
-    echo "  -t tags the git repo with the version"
+    echo "  -t tags the git repo with the version, including the otelcloudstack and promcloudstack modules"
     echo "  -u sets the certificate ID to sign with (if not provided, the default key is attempted)"
     echo "  -c commits build artifacts to cloudstack dev dist dir in svn"
     echo "  -h"
@@ -119,6 +119,19 @@ if [ "$tag" == "yes" ]; then
   else
       git tag -u $certid -s $version -m "Tagging release $version on branch $branch."
   fi
+
+  # The nested Go modules need a tag prefixed with their directory and a v prefixed version
+  case "$version" in
+    v*) modversion="$version";;
+    *)  modversion="v$version";;
+  esac
+  for module in otelcloudstack promcloudstack; do
+    if [ "$certid" == "X" ]; then
+        git tag -s $module/$modversion -m "Tagging release $module/$modversion on branch $branch."
+    else
+        git tag -u $certid -s $module/$modversion -m "Tagging release $module/$modversion on branch $branch."
+    fi
+  done
 fi
 
 if [ "$committosvn" == "yes" ]; then
@@ -0,0 +1,231 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package promcloudstack exposes Prometheus metrics about the requests and async jobs of a
+// CloudStackClient. It is a separate module, so the cloudstack package itself doesn't depend
+// on Prometheus.
+//
+// The collected metrics are:
+//
+//	cloudstack_requests_total{command, outcome}         Number of requests
+//	cloudstack_request_duration_seconds{command}        Latency of the requests, including retries
+//	cloudstack_request_retries_total{command}           Number of retried attempts
+//	cloudstack_async_jobs_in_flight                     Number of async jobs being waited for
+//	cloudstack_async_job_duration_seconds{outcome}      Time spent waiting for async jobs
+//
+// The outcome of a request is one of "success", "api_error", "throttled" or "error", and the outcome
+// of an async job is one of "success", "failed", "timeout" or "error".
+package promcloudstack
+
+import (
+	"context"
+	"errors"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The outcomes used as label values
+const (
+	OutcomeSuccess   = "success"
+	OutcomeAPIError  = "api_error"
+	OutcomeThrottled = "throttled"
+	OutcomeError     = "error"
+	OutcomeFailed    = "failed"
+	OutcomeTimeout   = "timeout"
+)
+
+// Option can be passed to NewCollector to set custom options
+type Option func(*config)
+
+type config struct {
+	namespace      string
+	constLabels    prometheus.Labels
+	requestBuckets []float64
+	jobBuckets     []float64
+}
+
+// WithNamespace sets the namespace (prefix) of the metric names; defaults to "cloudstack"
+func WithNamespace(namespace string) Option {
+	return func(c *config) {
+		c.namespace = namespace
+	}
+}
+
+// WithConstLabels sets labels that are added to all metrics, for example to tell apart
+// multiple clients that are registered using different collectors
+func WithConstLabels(labels prometheus.Labels) Option {
+	return func(c *config) {
+		c.constLabels = labels
+	}
+}
+
+// WithRequestBuckets sets the buckets of the request latency histogram; defaults to prometheus.DefBuckets
+func WithRequestBuckets(buckets []float64) Option {
+	return func(c *config) {
+		if len(buckets) > 0 {
+			c.requestBuckets = buckets
+		}
+	}
+}
+
+// WithJobBuckets sets the buckets of the async job duration histogram; defaults to 1 second up to 30 minutes
+func WithJobBuckets(buckets []float64) Option {
+	return func(c *config) {
+		if len(buckets) > 0 {
+			c.jobBuckets = buckets
+		}
+	}
+}
+
+// Collector is a prometheus.Collector that collects metrics about the requests and async jobs of
+// one or more clients. Add the collector to a client using the option returned by ClientOption.
+type Collector struct {
+	requests    *prometheus.CounterVec
+	latency     *prometheus.HistogramVec
+	retries     *prometheus.CounterVec
+	jobs        prometheus.Gauge
+	jobDuration *prometheus.HistogramVec
+}
+
+// NewCollector returns a new Collector, which still needs to be registered with Prometheus
+func NewCollector(opts ...Option) *Collector {
+	c := &config{
+		namespace:      "cloudstack",
+		requestBuckets: prometheus.DefBuckets,
+		jobBuckets:     []float64{1, 2, 5, 10, 30, 60, 120, 300, 600, 1200, 1800},
+	}
+	for _, fn := range opts {
+		fn(c)
+	}
+
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   c.namespace,
+			Name:        "requests_total",
+			Help:        "Number of CloudStack API requests by command and outcome.",
+			ConstLabels: c.constLabels,
+		}, []string{"command", "outcome"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   c.namespace,
+			Name:        "request_duration_seconds",
+			Help:        "Latency of CloudStack API requests by command, including retries.",
+			ConstLabels: c.constLabels,
+			Buckets:     c.requestBuckets,
+		}, []string{"command"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   c.namespace,
+			Name:        "request_retries_total",
+			Help:        "Number of retried CloudStack API request attempts by command.",
+			ConstLabels: c.constLabels,
+		}, []string{"command"}),
+		jobs: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace:   c.namespace,
+			Name:        "async_jobs_in_flight",
+			Help:        "Number of async jobs the client is waiting for.",
+			ConstLabels: c.constLabels,
+		}),
+		jobDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   c.namespace,
+			Name:        "async_job_duration_seconds",
+			Help:        "Time spent waiting for async jobs by outcome.",
+			ConstLabels: c.constLabels,
+			Buckets:     c.jobBuckets,
+		}, []string{"outcome"}),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.latency.Describe(ch)
+	c.retries.Describe(ch)
+	c.jobs.Describe(ch)
+	c.jobDuration.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.latency.Collect(ch)
+	c.retries.Collect(ch)
+	c.jobs.Collect(ch)
+	c.jobDuration.Collect(ch)
+}
+
+// ClientOption returns a ClientOption that makes a client report its metrics to the collector
+func (c *Collector) ClientOption() cloudstack.ClientOption {
+	return cloudstack.WithClientTrace(c.ClientTrace())
+}
+
+// ClientTrace returns the hooks used to collect the metrics of a client
+func (c *Collector) ClientTrace() *cloudstack.ClientTrace {
+	return &cloudstack.ClientTrace{
+		RequestRetry: c.requestRetry,
+		RequestDone:  c.requestDone,
+		JobWaitStart: c.jobWaitStart,
+		JobWaitDone:  c.jobWaitDone,
+	}
+}
+
+func (c *Collector) requestRetry(ctx context.Context, info *cloudstack.RequestInfo) {
+	c.retries.WithLabelValues(info.Command).Inc()
+}
+
+func (c *Collector) requestDone(ctx context.Context, info *cloudstack.RequestInfo) {
+	c.requests.WithLabelValues(info.Command, requestOutcome(info)).Inc()
+	c.latency.WithLabelValues(info.Command).Observe(info.Duration.Seconds())
+}
+
+func (c *Collector) jobWaitStart(ctx context.Context, jobid string) context.Context {
+	c.jobs.Inc()
+	return ctx
+}
+
+func (c *Collector) jobWaitDone(ctx context.Context, info *cloudstack.JobWaitInfo) {
+	c.jobs.Dec()
+	c.jobDuration.WithLabelValues(jobOutcome(info.Err)).Observe(info.Duration.Seconds())
+}
+
+func requestOutcome(info *cloudstack.RequestInfo) string {
+	switch {
+	case info.Err == nil:
+		return OutcomeSuccess
+	case info.StatusCode == 429 || info.ErrorCode == 429:
+		return OutcomeThrottled
+	case info.ErrorCode != 0:
+		return OutcomeAPIError
+	default:
+		return OutcomeError
+	}
+}
+
+func jobOutcome(err error) string {
+	var jerr *cloudstack.AsyncJobError
+	switch {
+	case err == nil:
+		return OutcomeSuccess
+	case errors.As(err, &jerr):
+		return OutcomeFailed
+	case errors.Is(err, cloudstack.AsyncTimeoutErr):
+		return OutcomeTimeout
+	default:
+		return OutcomeError
+	}
+}
@@ -0,0 +1,200 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package promcloudstack
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+	"github.com/apache/cloudstack-go/v2/cloudstack/cloudstacktest"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// Retry at most once and without waiting, to keep the tests fast
+var testRetryPolicy = cloudstack.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+
+func TestRequestMetrics(t *testing.T) {
+	tests := []struct {
+		name        string
+		failures    []int // The error codes of the failing attempts
+		wantOutcome string
+		wantRetries float64
+	}{
+		{name: "success", wantOutcome: OutcomeSuccess},
+		{name: "api error", failures: []int{431}, wantOutcome: OutcomeAPIError},
+		{name: "throttled", failures: []int{429, 429}, wantOutcome: OutcomeThrottled, wantRetries: 1},
+		{name: "retried", failures: []int{530}, wantOutcome: OutcomeSuccess, wantRetries: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := cloudstacktest.NewServer()
+			defer s.Close()
+			s.AddZone("zone1")
+			for _, code := range tt.failures {
+				s.FailNext("listZones", code, "Failed")
+			}
+
+			c := NewCollector()
+			cs := s.NewClient(c.ClientOption(), cloudstack.WithRetryPolicy(testRetryPolicy))
+			cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+
+			if got := testutil.ToFloat64(c.requests.WithLabelValues("listZones", tt.wantOutcome)); got != 1 {
+				t.Errorf("Expected 1 request with outcome %s, got %v", tt.wantOutcome, got)
+			}
+			if got := testutil.CollectAndCount(c.requests); got != 1 {
+				t.Errorf("Expected a single requests_total series, got %d", got)
+			}
+			if got := testutil.ToFloat64(c.retries.WithLabelValues("listZones")); got != tt.wantRetries {
+				t.Errorf("Expected %v retries, got %v", tt.wantRetries, got)
+			}
+			if got := sampleCount(t, c.latency.WithLabelValues("listZones")); got != 1 {
+				t.Errorf("Expected 1 request duration, got %d", got)
+			}
+		})
+	}
+}
+
+func TestRequestMetricsWithoutResponse(t *testing.T) {
+	s := cloudstacktest.NewServer()
+	c := NewCollector()
+	cs := s.NewClient(c.ClientOption(), cloudstack.WithRetryPolicy(cloudstack.RetryPolicy{MaxAttempts: 1}))
+	s.Close()
+
+	if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err == nil {
+		t.Fatal("Expected an error when the server is closed")
+	}
+	if got := testutil.ToFloat64(c.requests.WithLabelValues("listZones", OutcomeError)); got != 1 {
+		t.Errorf("Expected 1 request with outcome %s, got %v", OutcomeError, got)
+	}
+}
+
+func TestJobMetrics(t *testing.T) {
+	tests := []struct {
+		name        string
+		failJob     bool
+		jobDelay    time.Duration
+		timeout     time.Duration
+		wantOutcome string
+	}{
+		{name: "success", wantOutcome: OutcomeSuccess},
+		{name: "failed", failJob: true, wantOutcome: OutcomeFailed},
+		{name: "cancelled", jobDelay: time.Hour, timeout: 50 * time.Millisecond, wantOutcome: OutcomeError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := cloudstacktest.NewServer(cloudstacktest.WithJobDelay(tt.jobDelay))
+			defer s.Close()
+			z := s.AddZone("zone1")
+			so := s.AddServiceOffering("small", 1, 512)
+			tmpl := s.AddTemplate("template", z.Id)
+			if tt.failJob {
+				s.FailNextJob("deployVirtualMachine", 530, "Insufficient capacity")
+			}
+
+			c := NewCollector()
+			cs := s.NewClient(c.ClientOption())
+
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			p := cs.VirtualMachine.NewDeployVirtualMachineParams(so.Id, tmpl.Id, z.Id)
+			job, err := cs.VirtualMachine.DeployVirtualMachineAsyncWithContext(ctx, p)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			job.Wait(context.Background())
+
+			// The job wait hook is called by the polling goroutine, which can be after Wait returns
+			deadline := time.Now().Add(time.Second)
+			for testutil.CollectAndCount(c.jobDuration) == 0 && time.Now().Before(deadline) {
+				time.Sleep(time.Millisecond)
+			}
+
+			if got := testutil.ToFloat64(c.jobs); got != 0 {
+				t.Errorf("Expected no async jobs in flight, got %v", got)
+			}
+			if got := testutil.CollectAndCount(c.jobDuration); got != 1 {
+				t.Fatalf("Expected a single async_job_duration_seconds series, got %d", got)
+			}
+
+			if got := sampleCount(t, c.jobDuration.WithLabelValues(tt.wantOutcome)); got != 1 {
+				t.Errorf("Expected 1 async job with outcome %s, got %d", tt.wantOutcome, got)
+			}
+		})
+	}
+}
+
+// Returns the number of observations of a histogram
+func sampleCount(t *testing.T, o prometheus.Observer) uint64 {
+	m := &dto.Metric{}
+	if err := o.(prometheus.Metric).Write(m); err != nil {
+		t.Fatalf("Unable to read the histogram: %v", err)
+	}
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestJobOutcome(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{err: nil, want: OutcomeSuccess},
+		{err: &cloudstack.AsyncJobError{JobID: "1"}, want: OutcomeFailed},
+		{err: cloudstack.AsyncTimeoutErr, want: OutcomeTimeout},
+		{err: context.Canceled, want: OutcomeError},
+	}
+
+	for _, tt := range tests {
+		if got := jobOutcome(tt.err); got != tt.want {
+			t.Errorf("jobOutcome(%v) = %s, want %s", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestCollectorRegistration(t *testing.T) {
+	c := NewCollector(WithNamespace("test"), WithConstLabels(prometheus.Labels{"client": "a"}))
+
+	reg := prometheus.NewPedanticRegistry()
+	if err := reg.Register(c); err != nil {
+		t.Fatalf("Unable to register the collector: %v", err)
+	}
+
+	c.requestDone(context.Background(), &cloudstack.RequestInfo{Command: "listZones", Duration: time.Second})
+
+	want := `
+# HELP test_requests_total Number of CloudStack API requests by command and outcome.
+# TYPE test_requests_total counter
+test_requests_total{client="a",command="listZones",outcome="success"} 1
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(want), "test_requests_total"); err != nil {
+		t.Error(err)
+	}
+}